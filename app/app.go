@@ -1,6 +1,7 @@
 package app
 
 import (
+	"context"
 	"encoding/json"
 	"io"
 	"io/fs"
@@ -105,6 +106,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	gogogrpc "github.com/cosmos/gogoproto/grpc"
 	"github.com/cosmos/gogoproto/proto"
 	icq "github.com/cosmos/ibc-apps/modules/async-icq/v8"
 	icqkeeper "github.com/cosmos/ibc-apps/modules/async-icq/v8/keeper"
@@ -130,11 +132,14 @@ import (
 	simappparams "github.com/provenance-io/provenance/app/params"
 	"github.com/provenance-io/provenance/client/docs"
 	"github.com/provenance-io/provenance/internal/antewrapper"
+	"github.com/provenance-io/provenance/internal/errcodes"
 	piohandlers "github.com/provenance-io/provenance/internal/handlers"
 	"github.com/provenance-io/provenance/internal/pioconfig"
 	"github.com/provenance-io/provenance/internal/provwasm"
+	"github.com/provenance-io/provenance/internal/querylimit"
 	"github.com/provenance-io/provenance/x/attribute"
 	attributekeeper "github.com/provenance-io/provenance/x/attribute/keeper"
+	attributesubscription "github.com/provenance-io/provenance/x/attribute/subscription"
 	attributetypes "github.com/provenance-io/provenance/x/attribute/types"
 	"github.com/provenance-io/provenance/x/exchange"
 	exchangekeeper "github.com/provenance-io/provenance/x/exchange/keeper"
@@ -151,6 +156,8 @@ import (
 	"github.com/provenance-io/provenance/x/marker"
 	markerkeeper "github.com/provenance-io/provenance/x/marker/keeper"
 	markertypes "github.com/provenance-io/provenance/x/marker/types"
+	"github.com/provenance-io/provenance/x/meta"
+	metakeeper "github.com/provenance-io/provenance/x/meta/keeper"
 	"github.com/provenance-io/provenance/x/metadata"
 	metadatakeeper "github.com/provenance-io/provenance/x/metadata/keeper"
 	metadatatypes "github.com/provenance-io/provenance/x/metadata/types"
@@ -169,9 +176,15 @@ import (
 	"github.com/provenance-io/provenance/x/sanction"
 	sanctionkeeper "github.com/provenance-io/provenance/x/sanction/keeper"
 	sanctionmodule "github.com/provenance-io/provenance/x/sanction/module"
+	"github.com/provenance-io/provenance/x/stakingvesting"
+	stakingvestingkeeper "github.com/provenance-io/provenance/x/stakingvesting/keeper"
+	stakingvestingmodule "github.com/provenance-io/provenance/x/stakingvesting/module"
 	triggerkeeper "github.com/provenance-io/provenance/x/trigger/keeper"
 	triggermodule "github.com/provenance-io/provenance/x/trigger/module"
 	triggertypes "github.com/provenance-io/provenance/x/trigger/types"
+	provwasmkeeper "github.com/provenance-io/provenance/x/wasm/keeper"
+	provwasmmodule "github.com/provenance-io/provenance/x/wasm/module"
+	provwasmtypes "github.com/provenance-io/provenance/x/wasm/types"
 )
 
 var (
@@ -201,6 +214,25 @@ var (
 		oracletypes.ModuleName:    nil,
 		metadatatypes.ModuleName:  {authtypes.Minter, authtypes.Burner},
 	}
+
+	// provenanceModuleNames are the names of the modules defined in this repo, which are described by the meta query service.
+	provenanceModuleNames = []string{
+		attributetypes.ModuleName,
+		exchange.ModuleName,
+		hold.ModuleName,
+		ibchookstypes.ModuleName,
+		ibcratelimit.ModuleName,
+		markertypes.ModuleName,
+		metadatatypes.ModuleName,
+		msgfeestypes.ModuleName,
+		nametypes.ModuleName,
+		oracletypes.ModuleName,
+		provwasmtypes.ModuleName,
+		quarantine.ModuleName,
+		sanction.ModuleName,
+		stakingvesting.ModuleName,
+		triggertypes.ModuleName,
+	}
 )
 
 var (
@@ -265,14 +297,16 @@ type App struct {
 	ICQKeeper          icqkeeper.Keeper
 	RateLimitingKeeper *ibcratelimitkeeper.Keeper
 
-	MarkerKeeper    markerkeeper.Keeper
-	MetadataKeeper  metadatakeeper.Keeper
-	AttributeKeeper attributekeeper.Keeper
-	NameKeeper      namekeeper.Keeper
-	HoldKeeper      holdkeeper.Keeper
-	ExchangeKeeper  exchangekeeper.Keeper
-	WasmKeeper      *wasmkeeper.Keeper
-	ContractKeeper  *wasmkeeper.PermissionedKeeper
+	MarkerKeeper         markerkeeper.Keeper
+	MetadataKeeper       metadatakeeper.Keeper
+	AttributeKeeper      attributekeeper.Keeper
+	NameKeeper           namekeeper.Keeper
+	HoldKeeper           holdkeeper.Keeper
+	ExchangeKeeper       exchangekeeper.Keeper
+	StakingVestingKeeper stakingvestingkeeper.Keeper
+	WasmKeeper           *wasmkeeper.Keeper
+	ContractKeeper       *wasmkeeper.PermissionedKeeper
+	ProvWasmKeeper       provwasmkeeper.Keeper
 
 	// make scoped keepers public for test purposes
 	ScopedIBCKeeper      capabilitykeeper.ScopedKeeper
@@ -297,6 +331,12 @@ type App struct {
 
 	// module configurator
 	configurator module.Configurator
+
+	// queryLimits are the rate limits and slow-query logging applied to the provenance gRPC query services.
+	queryLimits querylimit.Config
+
+	// eventsClient is the node's client used by the gRPC streaming services. It's nil if the node services aren't registered.
+	eventsClient attributesubscription.EventsClient
 }
 
 func init() {
@@ -377,8 +417,9 @@ func New(
 		oracletypes.StoreKey,
 		hold.StoreKey,
 		exchange.StoreKey,
+		provwasmtypes.StoreKey,
 	)
-	tkeys := storetypes.NewTransientStoreKeys()
+	tkeys := storetypes.NewTransientStoreKeys(attributetypes.TStoreKey)
 	memKeys := storetypes.NewMemoryStoreKeys(capabilitytypes.MemStoreKey)
 
 	govAuthority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
@@ -399,6 +440,11 @@ func New(
 		os.Exit(1)
 	}
 
+	app.queryLimits, err = querylimit.ConfigFromAppOptions(appOpts)
+	if err != nil {
+		panic("error while reading query limits config: " + err.Error())
+	}
+
 	// set the BaseApp's parameter store
 
 	app.ConsensusParamsKeeper = consensusparamkeeper.NewKeeper(
@@ -548,41 +594,42 @@ func New(
 	hooksTransferModule := ibchooks.NewIBCMiddleware(app.RateLimitMiddleware, &app.HooksICS4Wrapper)
 	app.TransferStack = &hooksTransferModule
 
-	app.NameKeeper = namekeeper.NewKeeper(appCodec, keys[nametypes.StoreKey])
+	app.NameKeeper = namekeeper.NewKeeper(appCodec, keys[nametypes.StoreKey], app.AccountKeeper, app.BankKeeper)
 
 	app.AttributeKeeper = attributekeeper.NewKeeper(
-		appCodec, keys[attributetypes.StoreKey], app.AccountKeeper, &app.NameKeeper,
+		appCodec, keys[attributetypes.StoreKey], tkeys[attributetypes.TStoreKey], app.AccountKeeper, &app.NameKeeper,
 	)
-
-	markerReqAttrBypassAddrs := []sdk.AccAddress{
-		authtypes.NewModuleAddress(authtypes.FeeCollectorName),     // Allow collecting fees in restricted coins.
-		authtypes.NewModuleAddress(quarantine.ModuleName),          // Allow quarantine to hold onto restricted coins.
-		authtypes.NewModuleAddress(govtypes.ModuleName),            // Allow restricted coins in deposits.
-		authtypes.NewModuleAddress(distrtypes.ModuleName),          // Allow fee denoms to be restricted coins.
-		authtypes.NewModuleAddress(stakingtypes.BondedPoolName),    // Allow bond denom to be a restricted coin.
-		authtypes.NewModuleAddress(stakingtypes.NotBondedPoolName), // Allow bond denom to be a restricted coin.
-	}
+	app.NameKeeper.SetHooks(nametypes.NewMultiNameHooks(app.AttributeKeeper.NameHooks()))
+	pioMsgFeesRouter.SetContractLifecycleHooks(app.NameKeeper.ContractLifecycleHooks())
 
 	app.MarkerKeeper = markerkeeper.NewKeeper(
 		appCodec, keys[markertypes.StoreKey], app.AccountKeeper,
 		app.BankKeeper, app.AuthzKeeper, app.FeeGrantKeeper,
 		app.AttributeKeeper, app.NameKeeper, app.TransferKeeper,
-		markerReqAttrBypassAddrs, NewGroupCheckerFunc(app.GroupKeeper),
-	)
-
-	app.MetadataKeeper = metadatakeeper.NewKeeper(
-		appCodec, keys[metadatatypes.StoreKey], app.AccountKeeper, app.AuthzKeeper, app.AttributeKeeper, app.MarkerKeeper, app.BankKeeper,
+		stakingkeeper.NewMsgServerImpl(app.StakingKeeper), distrkeeper.NewMsgServerImpl(app.DistrKeeper),
+		NewGroupCheckerFunc(app.GroupKeeper),
 	)
 
 	app.HoldKeeper = holdkeeper.NewKeeper(
 		appCodec, keys[hold.StoreKey], app.BankKeeper,
 	)
 
+	app.MetadataKeeper = metadatakeeper.NewKeeper(
+		appCodec, keys[metadatatypes.StoreKey], app.AccountKeeper, app.AuthzKeeper, app.AttributeKeeper, app.MarkerKeeper, app.BankKeeper,
+		app.HoldKeeper, &app.IBCKeeper.ClientKeeper, &app.IBCKeeper.ConnectionKeeper,
+	)
+	app.MarkerKeeper.SetMetadataKeeper(app.MetadataKeeper)
+	app.MarkerKeeper.SetHoldKeeper(app.HoldKeeper)
+	app.NameKeeper.SetAddressRotators(app.AttributeKeeper, &app.MarkerKeeper)
+
 	app.ExchangeKeeper = exchangekeeper.NewKeeper(
 		appCodec, keys[exchange.StoreKey], authtypes.FeeCollectorName,
 		app.AccountKeeper, app.AttributeKeeper, app.BankKeeper, app.HoldKeeper, app.MarkerKeeper,
 		app.MetadataKeeper,
 	)
+	app.HoldKeeper.AppendHoldDetailsGetter(app.MetadataKeeper.GetHoldDetails, app.ExchangeKeeper.GetHoldDetails, app.MarkerKeeper.GetHoldDetails)
+
+	app.StakingVestingKeeper = stakingvestingkeeper.NewKeeper(app.AccountKeeper, app.BankKeeper)
 
 	pioMessageRouter := MessageRouterFunc(func(msg sdk.Msg) baseapp.MsgServiceHandler {
 		return pioMsgFeesRouter.Handler(msg)
@@ -607,6 +654,7 @@ func New(
 	icqIBCModule := icq.NewIBCModule(app.ICQKeeper)
 
 	// Init CosmWasm module
+	app.ProvWasmKeeper = provwasmkeeper.NewKeeper(appCodec, keys[provwasmtypes.StoreKey])
 	wasmDir := filepath.Join(homePath, "data", "wasm")
 
 	wasmWrap := WasmWrapper{Wasm: wasmtypes.DefaultWasmConfig()}
@@ -640,7 +688,8 @@ func New(
 		wasmConfig,
 		supportedFeatures,
 		govAuthority,
-		wasmkeeper.WithQueryPlugins(provwasm.QueryPlugins(*app.GRPCQueryRouter(), appCodec)),
+		wasmkeeper.WithQueryPlugins(provwasm.QueryPlugins(*app.GRPCQueryRouter(), appCodec, app.ProvWasmKeeper)),
+		wasmkeeper.WithMessageHandlerDecorator(provwasm.MessageHandlerDecorator(app.ProvWasmKeeper)),
 	)
 	app.WasmKeeper = &wasmKeeperInstance
 
@@ -649,7 +698,11 @@ func New(
 	app.Ics20WasmHooks.ContractKeeper = app.WasmKeeper // app.ContractKeeper -- this changes in the next version of wasm to a permissioned keeper
 	app.IBCHooksKeeper.ContractKeeper = app.ContractKeeper
 	app.Ics20MarkerHooks.MarkerKeeper = &app.MarkerKeeper
+	if err := app.MarkerKeeper.GetIBCMemoHandlers().Register(ibchookstypes.MarkerMemoActionWasm, app.Ics20WasmHooks); err != nil {
+		panic(err)
+	}
 	app.RateLimitingKeeper.PermissionedKeeper = app.ContractKeeper
+	app.AttributeKeeper.SetContractKeeper(app.ContractKeeper, app.WasmKeeper)
 
 	app.IbcHooks.SendPacketPreProcessors = []ibchookstypes.PreSendPacketDataProcessingFn{app.Ics20MarkerHooks.SetupMarkerMemoFn, app.Ics20WasmHooks.GetWasmSendPacketPreProcessor}
 
@@ -747,12 +800,14 @@ func New(
 		attribute.NewAppModule(appCodec, app.AttributeKeeper, app.AccountKeeper, app.BankKeeper, app.NameKeeper),
 		msgfeesmodule.NewAppModule(appCodec, app.MsgFeesKeeper, app.interfaceRegistry),
 		wasm.NewAppModule(appCodec, app.WasmKeeper, app.StakingKeeper, app.AccountKeeper, app.BankKeeper, app.MsgServiceRouter(), nil),
+		provwasmmodule.NewAppModule(appCodec, app.ProvWasmKeeper),
 		triggermodule.NewAppModule(appCodec, app.TriggerKeeper, app.AccountKeeper, app.BankKeeper),
 		oracleModule,
 		holdmodule.NewAppModule(appCodec, app.HoldKeeper),
 		exchangemodule.NewAppModule(appCodec, app.ExchangeKeeper),
 		quarantinemodule.NewAppModule(appCodec, app.QuarantineKeeper, app.AccountKeeper, app.BankKeeper, app.interfaceRegistry),
 		sanctionmodule.NewAppModule(appCodec, app.SanctionKeeper, app.AccountKeeper, app.BankKeeper, app.GovKeeper, app.interfaceRegistry),
+		stakingvestingmodule.NewAppModule(appCodec, app.StakingVestingKeeper),
 
 		// IBC
 		ibc.NewAppModule(app.IBCKeeper),
@@ -804,6 +859,7 @@ func New(
 		markertypes.ModuleName,
 		attributetypes.ModuleName,
 		authz.ModuleName,
+		msgfeestypes.ModuleName,
 		triggertypes.ModuleName,
 	)
 
@@ -813,6 +869,9 @@ func New(
 		stakingtypes.ModuleName,
 		feegrant.ModuleName,
 		group.ModuleName,
+		markertypes.ModuleName,
+		nametypes.ModuleName,
+		metadatatypes.ModuleName,
 		triggertypes.ModuleName,
 	)
 
@@ -862,6 +921,7 @@ func New(
 		ibcratelimit.ModuleName,
 		ibchookstypes.ModuleName,
 		wasmtypes.ModuleName, // must be after ibctransfer.
+		provwasmtypes.ModuleName,
 		triggertypes.ModuleName,
 		oracletypes.ModuleName,
 	}
@@ -891,6 +951,7 @@ func New(
 		sanction.ModuleName,
 		hold.ModuleName,
 		exchange.ModuleName,
+		stakingvesting.ModuleName,
 		consensusparamtypes.ModuleName,
 		circuittypes.ModuleName,
 
@@ -899,6 +960,7 @@ func New(
 		icatypes.ModuleName,
 		icqtypes.ModuleName,
 		wasmtypes.ModuleName,
+		provwasmtypes.ModuleName,
 
 		attributetypes.ModuleName,
 		markertypes.ModuleName,
@@ -921,6 +983,13 @@ func New(
 
 	autocliv1.RegisterQueryServer(app.GRPCQueryRouter(), runtimeservices.NewAutoCLIQueryService(app.mm.Modules))
 
+	// The meta query service describes the provenance modules, so it's given just those.
+	metaModules := make(map[string]interface{}, len(provenanceModuleNames))
+	for _, name := range provenanceModuleNames {
+		metaModules[name] = app.mm.Modules[name]
+	}
+	meta.RegisterQueryServer(app.GRPCQueryRouter(), metakeeper.NewKeeper(metaModules, app.MsgServiceRouter(), &app.CircuitKeeper))
+
 	reflectionSvc, err := runtimeservices.NewReflectionService()
 	if err != nil {
 		panic(err)
@@ -943,6 +1012,7 @@ func New(
 	// initialize BaseApp
 	app.SetInitChainer(app.InitChainer)
 	app.SetPreBlocker(app.PreBlocker)
+	app.setVoteExtensionHandlers(appOpts)
 	app.SetBeginBlocker(app.BeginBlocker)
 	app.SetEndBlocker(app.EndBlocker)
 	app.setAnteHandler()
@@ -966,6 +1036,20 @@ func New(
 }
 
 func (app *App) setAnteHandler() {
+	// Modules can provide decorators for the ante handler. They're collected in genesis order so that
+	// decorators without ordering constraints between them are always chained the same way.
+	// The same goes for the tx extension options they accept.
+	var decorators []antewrapper.DecoratorRegistration
+	var extOpts []antewrapper.ExtensionOptionRegistration
+	for _, moduleName := range app.mm.OrderInitGenesis {
+		if mod, ok := app.mm.Modules[moduleName].(antewrapper.HasAnteDecorators); ok {
+			decorators = append(decorators, mod.AnteDecorators()...)
+		}
+		if mod, ok := app.mm.Modules[moduleName].(antewrapper.HasExtensionOptions); ok {
+			extOpts = append(extOpts, mod.ExtensionOptions()...)
+		}
+	}
+
 	anteHandler, err := antewrapper.NewAnteHandler(
 		antewrapper.HandlerOptions{
 			AccountKeeper:       app.AccountKeeper,
@@ -975,6 +1059,8 @@ func (app *App) setAnteHandler() {
 			MsgFeesKeeper:       app.MsgFeesKeeper,
 			CircuitKeeper:       &app.CircuitKeeper,
 			SigGasConsumer:      ante.DefaultSigVerificationGasConsumer,
+			Decorators:          decorators,
+			ExtensionOptions:    extOpts,
 		})
 	if err != nil {
 		panic(err)
@@ -1074,9 +1160,37 @@ func (app *App) GetTxConfig() client.TxConfig {
 // Name returns the name of the App
 func (app *App) Name() string { return app.BaseApp.Name() }
 
+// ModuleVersions returns the consensus version of each module compiled into this app.
+// During an upgrade, the migrations of any module whose version differs from the one in state are run.
+func (app *App) ModuleVersions() module.VersionMap {
+	return app.mm.GetVersionMap()
+}
+
 // PreBlocker application updates every pre block
-func (app *App) PreBlocker(ctx sdk.Context, _ *abci.RequestFinalizeBlock) (*sdk.ResponsePreBlock, error) {
-	return app.mm.PreBlock(ctx)
+func (app *App) PreBlocker(ctx sdk.Context, req *abci.RequestFinalizeBlock) (*sdk.ResponsePreBlock, error) {
+	resp, err := app.mm.PreBlock(ctx)
+	if err != nil {
+		return resp, err
+	}
+	if err = app.OracleKeeper.CommitVoteExtensionPrices(ctx, req); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// setVoteExtensionHandlers sets the handlers that commit the validators' price observations using vote extensions.
+// They don't do anything until vote extensions are enabled in the consensus params.
+func (app *App) setVoteExtensionHandlers(appOpts servertypes.AppOptions) {
+	var priceSource oracletypes.PriceSource
+	if priceFile := cast.ToString(appOpts.Get(oracletypes.FlagPriceFile)); len(priceFile) > 0 {
+		priceSource = oracletypes.FilePriceSource{Path: priceFile}
+	}
+	app.SetExtendVoteHandler(app.OracleKeeper.ExtendVoteHandler(priceSource))
+	app.SetVerifyVoteExtensionHandler(app.OracleKeeper.VerifyVoteExtensionHandler())
+
+	proposalHandler := baseapp.NewDefaultProposalHandler(app.Mempool(), app)
+	app.SetPrepareProposal(app.OracleKeeper.PrepareProposalHandler(app.StakingKeeper, proposalHandler.PrepareProposalHandler()))
+	app.SetProcessProposal(app.OracleKeeper.ProcessProposalHandler(app.StakingKeeper, proposalHandler.ProcessProposalHandler()))
 }
 
 // BeginBlocker application updates every begin block
@@ -1229,6 +1343,9 @@ func (app *App) RegisterAPIRoutes(apiSvr *api.Server, apiConfig serverconfig.API
 
 	// Register grpc-gateway routes for all modules.
 	app.BasicModuleManager.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
+	if err := meta.RegisterQueryHandlerClient(context.Background(), apiSvr.GRPCGatewayRouter, meta.NewQueryClient(clientCtx)); err != nil {
+		panic(err)
+	}
 
 	// Register swagger API
 	if err := RegisterSwaggerAPI(apiSvr.ClientCtx, apiSvr.Router, apiConfig.Swagger); err != nil {
@@ -1247,8 +1364,24 @@ func (app *App) RegisterTendermintService(clientCtx client.Context) {
 }
 
 // RegisterNodeService registers the node query server.
+// The node's client is also kept for the gRPC streaming services, which are registered after this.
 func (app *App) RegisterNodeService(clientCtx client.Context, cfg serverconfig.Config) {
 	nodeservice.RegisterNodeService(clientCtx, app.GRPCQueryRouter(), cfg)
+	if eventsClient, ok := clientCtx.Client.(attributesubscription.EventsClient); ok {
+		app.eventsClient = eventsClient
+	}
+}
+
+// RegisterGRPCServer registers the app's gRPC services with the given server.
+// Errors registered by the provenance modules will have an ErrorInfo detail in their gRPC status.
+// Requests to the provenance query services are subject to the configured query limits.
+// The gRPC streaming services are also registered here.
+func (app *App) RegisterGRPCServer(server gogogrpc.Server) {
+	server = querylimit.NewGRPCServer(server, app.queryLimits, app.Logger())
+	app.BaseApp.RegisterGRPCServer(errcodes.NewGRPCServer(server))
+
+	// The streaming services aren't query services, so they're registered directly instead of with the query router.
+	attributetypes.RegisterSubscriptionServer(server, attributesubscription.NewServer(app.eventsClient))
 }
 
 // AutoCliOpts returns the autocli options for the app.
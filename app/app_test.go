@@ -1,6 +1,7 @@
 package app
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"sort"
@@ -72,6 +73,11 @@ func TestSimAppExportAndBlockedAddrs(t *testing.T) {
 		_, err = app2.ExportAppStateAndValidators(true, nil, nil)
 	}, "exporting app state at zero height")
 	require.NoError(t, err, "ExportAppStateAndValidators at zero height")
+
+	require.NotPanics(t, func() {
+		err = app2.AssertGenesisRoundTrip()
+	}, "AssertGenesisRoundTrip")
+	require.NoError(t, err, "AssertGenesisRoundTrip")
 }
 
 func TestExportAppStateAndValidators(t *testing.T) {
@@ -234,6 +240,53 @@ func TestExportAppStateAndValidators(t *testing.T) {
 	})
 }
 
+func TestExportAppStateAndValidatorsTo(t *testing.T) {
+	opts := SetupOptions{
+		Logger:  log.NewTestLogger(t),
+		DB:      dbm.NewMemDB(),
+		AppOpts: simtestutil.NewAppOptionsWithFlagHome(t.TempDir()),
+	}
+	app := NewAppWithCustomOptions(t, false, opts)
+	ctx := app.BaseApp.NewContext(false)
+
+	addrs := AddTestAddrs(app, ctx, 3, sdkmath.NewInt(5_000))
+	for i, addr := range addrs {
+		name := fmt.Sprintf("name%d", i)
+		require.NoError(t, app.NameKeeper.SetNameRecord(ctx, name, addr, false), "SetNameRecord(%q)", name)
+	}
+
+	_, err := app.FinalizeBlock(&abci.RequestFinalizeBlock{Height: 1})
+	require.NoError(t, err, "FinalizeBlock")
+	_, err = app.Commit()
+	require.NoError(t, err, "Commit")
+
+	exported, err := app.ExportAppStateAndValidators(false, nil, nil)
+	require.NoError(t, err, "ExportAppStateAndValidators")
+	var expState map[string]json.RawMessage
+	require.NoError(t, json.Unmarshal(exported.AppState, &expState), "unmarshalling exported app state")
+
+	var buf bytes.Buffer
+	streamed, err := app.ExportAppStateAndValidatorsTo(&buf, false, nil, nil)
+	require.NoError(t, err, "ExportAppStateAndValidatorsTo")
+	assert.Nil(t, streamed.AppState, "streamed AppState")
+	assert.Equal(t, exported.Height, streamed.Height, "streamed Height")
+	assert.Equal(t, exported.Validators, streamed.Validators, "streamed Validators")
+	var actState map[string]json.RawMessage
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &actState), "unmarshalling streamed app state")
+
+	require.Len(t, actState, len(expState), "number of modules in streamed app state")
+	for moduleName, expBz := range expState {
+		actBz, found := actState[moduleName]
+		if !assert.True(t, found, "%s in streamed app state", moduleName) {
+			continue
+		}
+		var expCompact, actCompact bytes.Buffer
+		require.NoError(t, json.Compact(&expCompact, expBz), "compacting exported %s state", moduleName)
+		require.NoError(t, json.Compact(&actCompact, actBz), "compacting streamed %s state", moduleName)
+		assert.Equal(t, expCompact.String(), actCompact.String(), "streamed %s state", moduleName)
+	}
+}
+
 func logAccounts(t *testing.T, accts []sdk.AccountI, name string) {
 	t.Helper()
 	for i, acctI := range accts {
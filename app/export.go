@@ -3,12 +3,15 @@ package app
 import (
 	"encoding/json"
 	"fmt"
+	"io"
 	"log"
+	"sort"
 
 	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
 
 	storetypes "cosmossdk.io/store/types"
 
+	"github.com/cosmos/cosmos-sdk/codec"
 	sdkcodec "github.com/cosmos/cosmos-sdk/codec/types"
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -19,11 +22,105 @@ import (
 
 	internalsdk "github.com/provenance-io/provenance/internal/sdk"
 	markertypes "github.com/provenance-io/provenance/x/marker/types"
+	nametypes "github.com/provenance-io/provenance/x/name/types"
 )
 
+// GenesisStreamer is a module that can write its exported genesis state to a writer as it's read from state.
+type GenesisStreamer interface {
+	ExportGenesisTo(ctx sdk.Context, cdc codec.JSONCodec, w io.Writer) error
+}
+
 // ExportAppStateAndValidators exports the state of the application for a genesis
 // file.
 func (app *App) ExportAppStateAndValidators(forZeroHeight bool, jailAllowedAddrs, modulesToExport []string) (servertypes.ExportedApp, error) {
+	ctx, height := app.prepForExport(forZeroHeight, jailAllowedAddrs)
+
+	genState, err := app.mm.ExportGenesisForModules(ctx, app.appCodec, modulesToExport)
+	if err != nil {
+		return servertypes.ExportedApp{}, err
+	}
+	app.filterMarkerAccounts(genState)
+
+	appState, err := json.MarshalIndent(genState, "", "  ")
+	if err != nil {
+		return servertypes.ExportedApp{}, err
+	}
+
+	return app.exportValidators(ctx, height, appState)
+}
+
+// ExportAppStateAndValidatorsTo is like ExportAppStateAndValidators, except the app state is written to w
+// instead of being returned. The modules that are GenesisStreamers write their state to w as it's read,
+// so their full genesis state is never in memory at once. The returned ExportedApp does not have an AppState.
+func (app *App) ExportAppStateAndValidatorsTo(w io.Writer, forZeroHeight bool, jailAllowedAddrs, modulesToExport []string) (servertypes.ExportedApp, error) {
+	if len(modulesToExport) == 0 {
+		modulesToExport = app.mm.OrderExportGenesis
+	}
+	var toExport, toStream []string
+	for _, moduleName := range modulesToExport {
+		mod, found := app.mm.Modules[moduleName]
+		if !found {
+			return servertypes.ExportedApp{}, fmt.Errorf("module %s does not exist", moduleName)
+		}
+		if _, ok := mod.(GenesisStreamer); ok {
+			toStream = append(toStream, moduleName)
+		} else {
+			toExport = append(toExport, moduleName)
+		}
+	}
+
+	ctx, height := app.prepForExport(forZeroHeight, jailAllowedAddrs)
+
+	genState := make(map[string]json.RawMessage)
+	if len(toExport) > 0 {
+		var err error
+		genState, err = app.mm.ExportGenesisForModules(ctx, app.appCodec, toExport)
+		if err != nil {
+			return servertypes.ExportedApp{}, err
+		}
+		app.filterMarkerAccounts(genState)
+	}
+
+	// Write the modules in order of their names so the output is the same as marshaling the whole map.
+	moduleNames := make([]string, 0, len(genState)+len(toStream))
+	for moduleName := range genState {
+		moduleNames = append(moduleNames, moduleName)
+	}
+	moduleNames = append(moduleNames, toStream...)
+	sort.Strings(moduleNames)
+
+	if _, err := io.WriteString(w, "{"); err != nil {
+		return servertypes.ExportedApp{}, err
+	}
+	for i, moduleName := range moduleNames {
+		if i > 0 {
+			if _, err := io.WriteString(w, ","); err != nil {
+				return servertypes.ExportedApp{}, err
+			}
+		}
+		if _, err := fmt.Fprintf(w, "%q:", moduleName); err != nil {
+			return servertypes.ExportedApp{}, err
+		}
+		if bz, ok := genState[moduleName]; ok {
+			if _, err := w.Write(bz); err != nil {
+				return servertypes.ExportedApp{}, err
+			}
+			continue
+		}
+		mod := app.mm.Modules[moduleName].(GenesisStreamer)
+		if err := mod.ExportGenesisTo(ctx.WithGasMeter(storetypes.NewInfiniteGasMeter()), app.appCodec, w); err != nil {
+			return servertypes.ExportedApp{}, fmt.Errorf("genesis export error in %s: %w", moduleName, err)
+		}
+	}
+	if _, err := io.WriteString(w, "}"); err != nil {
+		return servertypes.ExportedApp{}, err
+	}
+
+	return app.exportValidators(ctx, height, nil)
+}
+
+// prepForExport returns the context to export with and the height the exported genesis will start at.
+func (app *App) prepForExport(forZeroHeight bool, jailAllowedAddrs []string) (sdk.Context, int64) {
 	// as if they could withdraw from the start of the next block
 	ctx := app.NewContextLegacy(true, cmtproto.Header{Height: app.LastBlockHeight()})
 
@@ -34,36 +131,33 @@ func (app *App) ExportAppStateAndValidators(forZeroHeight bool, jailAllowedAddrs
 		height = 0
 		app.prepForZeroHeightGenesis(ctx, jailAllowedAddrs)
 	}
+	return ctx, height
+}
 
-	genState, err := app.mm.ExportGenesisForModules(ctx, app.appCodec, modulesToExport)
-	if err != nil {
-		return servertypes.ExportedApp{}, err
+// filterMarkerAccounts replaces the marker accounts in the exported auth genesis state with their base accounts.
+func (app *App) filterMarkerAccounts(genState map[string]json.RawMessage) {
+	if genState[auth.ModuleName] == nil {
+		return
 	}
-
-	// filter out marker accounts from auth module export
-	if genState[auth.ModuleName] != nil {
-		var authGenState auth.GenesisState
-		app.appCodec.MustUnmarshalJSON(genState[auth.ModuleName], &authGenState)
-		var regular = make([]*sdkcodec.Any, 0)
-		for _, acct := range authGenState.Accounts {
-			if acct.TypeUrl == "/provenance.marker.v1.MarkerAccount" {
-				regular = append(regular, sdkcodec.UnsafePackAny(
-					acct.GetCachedValue().(*markertypes.MarkerAccount).BaseAccount))
-			} else {
-				regular = append(regular, acct)
-			}
+	var authGenState auth.GenesisState
+	app.appCodec.MustUnmarshalJSON(genState[auth.ModuleName], &authGenState)
+	var regular = make([]*sdkcodec.Any, 0)
+	for _, acct := range authGenState.Accounts {
+		if acct.TypeUrl == "/provenance.marker.v1.MarkerAccount" {
+			regular = append(regular, sdkcodec.UnsafePackAny(
+				acct.GetCachedValue().(*markertypes.MarkerAccount).BaseAccount))
+		} else {
+			regular = append(regular, acct)
 		}
-
-		authGenState.Accounts = regular
-		delete(genState, auth.ModuleName)
-		genState[auth.ModuleName] = app.appCodec.MustMarshalJSON(&authGenState)
 	}
 
-	appState, err := json.MarshalIndent(genState, "", "  ")
-	if err != nil {
-		return servertypes.ExportedApp{}, err
-	}
+	authGenState.Accounts = regular
+	delete(genState, auth.ModuleName)
+	genState[auth.ModuleName] = app.appCodec.MustMarshalJSON(&authGenState)
+}
 
+// exportValidators returns the ExportedApp with the provided app state and the current validators.
+func (app *App) exportValidators(ctx sdk.Context, height int64, appState json.RawMessage) (servertypes.ExportedApp, error) {
 	validators, err := staking.WriteValidators(ctx, app.StakingKeeper)
 	return servertypes.ExportedApp{
 		AppState:        appState,
@@ -73,6 +167,16 @@ func (app *App) ExportAppStateAndValidators(forZeroHeight bool, jailAllowedAddrs
 	}, err
 }
 
+// AssertGenesisRoundTrip re-imports the exported genesis state of each module that supports it and returns an
+// error if exporting it again does not give back exactly the same thing. No state is changed by this.
+func (app *App) AssertGenesisRoundTrip() error {
+	ctx := app.NewContextLegacy(true, cmtproto.Header{Height: app.LastBlockHeight()})
+	if err := app.NameKeeper.VerifyGenesisRoundTrip(ctx, app.appCodec); err != nil {
+		return fmt.Errorf("%s: %w", nametypes.ModuleName, err)
+	}
+	return nil
+}
+
 // prepare for fresh start at zero height
 // NOTE: zero height genesis is a temporary feature which will be deprecated in favor of export at a block height
 func (app *App) prepForZeroHeightGenesis(ctx sdk.Context, jailAllowedAddrs []string) {
@@ -12,6 +12,8 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	ibctmmigrations "github.com/cosmos/ibc-go/v8/modules/light-clients/07-tendermint/migrations"
+
+	namekeeper "github.com/provenance-io/provenance/x/name/keeper"
 )
 
 // appUpgrade is an internal structure for defining all things for an upgrade.
@@ -44,9 +46,13 @@ var upgrades = map[string]appUpgrade{
 			if err = pruneIBCExpiredConsensusStates(ctx, app); err != nil {
 				return nil, err
 			}
+			// This includes the x/name 6 to 7 migration that removes the name record copies from the address index.
 			if vm, err = runModuleMigrations(ctx, app, vm); err != nil {
 				return nil, err
 			}
+			if err = verifyNameAddressIndex(ctx, app); err != nil {
+				return nil, err
+			}
 			removeInactiveValidatorDelegations(ctx, app)
 			if err = updateValidatorCommissions(ctx, app); err != nil {
 				return nil, err
@@ -63,9 +69,13 @@ var upgrades = map[string]appUpgrade{
 			if err = pruneIBCExpiredConsensusStates(ctx, app); err != nil {
 				return nil, err
 			}
+			// This includes the x/name 6 to 7 migration that removes the name record copies from the address index.
 			if vm, err = runModuleMigrations(ctx, app, vm); err != nil {
 				return nil, err
 			}
+			if err = verifyNameAddressIndex(ctx, app); err != nil {
+				return nil, err
+			}
 			removeInactiveValidatorDelegations(ctx, app)
 			if err = updateValidatorCommissions(ctx, app); err != nil {
 				return nil, err
@@ -237,6 +247,18 @@ var (
 	_ = pruneIBCExpiredConsensusStates
 )
 
+// verifyNameAddressIndex makes sure that every address -> name index entry matches its name record, and that every
+// name record is indexed. Part of the wisteria upgrade, to check the results of the x/name 6 to 7 migration.
+func verifyNameAddressIndex(ctx sdk.Context, app *App) error {
+	ctx.Logger().Info("Verifying the name address index.")
+	if msg, broken := namekeeper.AddressIndexInvariant(app.NameKeeper)(ctx); broken {
+		ctx.Logger().Error("The name address index is invalid.", "invariant", msg)
+		return fmt.Errorf("name address index is invalid: %s", msg)
+	}
+	ctx.Logger().Info("Done verifying the name address index.")
+	return nil
+}
+
 // updateValidatorCommissions updates all the validators to have 60% commission rate, with a max of 60% too.
 // Part of the wisteria upgrade.
 func updateValidatorCommissions(ctx sdk.Context, app *App) error {
@@ -22,6 +22,7 @@ import (
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	internalsdk "github.com/provenance-io/provenance/internal/sdk"
+	nametypes "github.com/provenance-io/provenance/x/name/types"
 )
 
 type UpgradeTestSuite struct {
@@ -589,6 +590,8 @@ func (s *UpgradeTestSuite) TestRemoveInactiveValidatorDelegations() {
 func (s *UpgradeTestSuite) TestWisteriaRC1() {
 	expInLog := []string{
 		"INF Pruning expired consensus states for IBC.",
+		"INF Verifying the name address index.",
+		"INF Done verifying the name address index.",
 		"INF Removing inactive validator delegations.",
 		"INF Updating the commissions for all validators to 60% with 60% max.",
 		"INF Setting minimum commission to 60%.",
@@ -599,6 +602,8 @@ func (s *UpgradeTestSuite) TestWisteriaRC1() {
 func (s *UpgradeTestSuite) TestWisteria() {
 	expInLog := []string{
 		"INF Pruning expired consensus states for IBC.",
+		"INF Verifying the name address index.",
+		"INF Done verifying the name address index.",
 		"INF Removing inactive validator delegations.",
 		"INF Updating the commissions for all validators to 60% with 60% max.",
 		"INF Setting minimum commission to 60%.",
@@ -687,3 +692,26 @@ func (s *UpgradeTestSuite) TestIncreaseMinCommission() {
 	s.Require().NoError(err, "StakingKeeper.GetParams")
 	s.Assert().Equal(sixtyPct, params.MinCommissionRate, "MinCommissionRate")
 }
+
+func (s *UpgradeTestSuite) TestVerifyNameAddressIndex() {
+	var err error
+	testFunc := func() {
+		err = verifyNameAddressIndex(s.ctx, s.app)
+	}
+	s.Require().NotPanics(testFunc, "verifyNameAddressIndex")
+	s.Require().NoError(err, "verifyNameAddressIndex error")
+
+	// An index entry for a name that isn't bound.
+	addrPrefix, err := nametypes.GetAddressKeyPrefix(sdk.AccAddress("addr1_______________"))
+	s.Require().NoError(err, "GetAddressKeyPrefix")
+	nameKey, err := nametypes.GetNameKeyPrefix("unbound")
+	s.Require().NoError(err, "GetNameKeyPrefix")
+	ctx, _ := s.ctx.CacheContext()
+	ctx.KVStore(s.app.GetKey(nametypes.StoreKey)).Set(append(addrPrefix, nameKey...), []byte{})
+
+	testFunc = func() {
+		err = verifyNameAddressIndex(ctx, s.app)
+	}
+	s.Require().NotPanics(testFunc, "verifyNameAddressIndex")
+	s.Assert().ErrorContains(err, "name address index is invalid", "verifyNameAddressIndex error")
+}
@@ -0,0 +1,104 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/version"
+
+	"github.com/provenance-io/provenance/cmd/provenanced/config"
+	"github.com/provenance-io/provenance/internal/bech32util"
+)
+
+// GetBech32Cmd returns the bech32 address utility cobra Command.
+func GetBech32Cmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bech32",
+		Short: "Convert and validate bech32 account addresses",
+		Long: fmt.Sprintf(`Convert and validate bech32 account addresses.
+
+The known HRPs are the chain's account HRP and any provided using --%[1]s (or the PIO_%[2]s env var).`,
+			config.AdditionalHRPsFlag, strings.ToUpper(strings.ReplaceAll(config.AdditionalHRPsFlag, "-", "_"))),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		GetBech32ConvertCmd(),
+		GetBech32ValidateCmd(),
+		GetBech32HRPsCmd(),
+	)
+
+	return cmd
+}
+
+// GetBech32ConvertCmd returns the bech32 address converter cobra Command.
+func GetBech32ConvertCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "convert <address> [hrp]",
+		Short: "Convert an address to use a different known HRP",
+		Long: `Convert an address to use a different known HRP.
+
+If no hrp is provided, the address is converted to each of the known HRPs.`,
+		Example: fmt.Sprintf(`%[1]s bech32 convert tp1v4uxzmtsd3j47ctyv3ex2umnta047h6lc5txah pb --%[2]s tp
+%[1]s bech32 convert pb1v4uxzmtsd3j47ctyv3ex2umnta047h6ltlw2la --%[2]s tp`,
+			version.AppName, config.AdditionalHRPsFlag),
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			hrps := bech32util.GetKnownHRPs()
+			if len(args) > 1 {
+				hrps = []string{args[1]}
+			}
+			for _, hrp := range hrps {
+				addr, err := bech32util.ConvertHRP(args[0], hrp)
+				if err != nil {
+					return err
+				}
+				cmd.Printf("%s\n", addr)
+			}
+			return nil
+		},
+	}
+	return cmd
+}
+
+// GetBech32ValidateCmd returns the bech32 address validator cobra Command.
+func GetBech32ValidateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validate <address>",
+		Short: "Check that an address is a valid account address for this chain",
+		Long: `Check that an address is a valid account address for this chain.
+
+The address must use the chain's account HRP and be in its canonical (lowercase) form.`,
+		Example: fmt.Sprintf(`%[1]s bech32 validate pb1v4uxzmtsd3j47ctyv3ex2umnta047h6ltlw2la`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if _, err := bech32util.ValidateAccAddress(args[0]); err != nil {
+				cmd.SilenceUsage = true
+				return err
+			}
+			cmd.Printf("%s is a valid account address\n", args[0])
+			return nil
+		},
+	}
+	return cmd
+}
+
+// GetBech32HRPsCmd returns the cobra Command that lists the known HRPs.
+func GetBech32HRPsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "hrps",
+		Short:   "List the known HRPs, starting with the chain's account HRP",
+		Example: fmt.Sprintf(`%[1]s bech32 hrps --%[2]s tp,pb`, version.AppName, config.AdditionalHRPsFlag),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			cmd.Printf("%s\n", strings.Join(bech32util.GetKnownHRPs(), "\n"))
+			return nil
+		},
+	}
+	return cmd
+}
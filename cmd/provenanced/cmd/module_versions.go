@@ -0,0 +1,189 @@
+package cmd
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os/exec"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/types/module"
+)
+
+const (
+	// FlagBuild is the flag for using the module versions compiled into this binary instead of those in state.
+	FlagBuild = "build"
+	// FlagTarget is the flag for the binary whose module versions should be compared with the current ones.
+	FlagTarget = "target"
+)
+
+// ModuleVersionChange is the consensus version of a module that differs between the current and target versions.
+// A version of 0 indicates that the module does not exist in that version.
+type ModuleVersionChange struct {
+	Name    string `json:"name"`
+	Current uint64 `json:"current"`
+	Target  uint64 `json:"target"`
+}
+
+// ModuleVersionChanges are all the modules that have a different consensus version in the target.
+type ModuleVersionChanges struct {
+	Changes []ModuleVersionChange `json:"changes"`
+}
+
+// GetQueryModuleVersionsCmd returns the query upgrade module-versions command.
+// It replaces the one that comes with the upgrade module, adding the ability to get the module versions
+// compiled into a binary, and to compare them with those of a target binary.
+func GetQueryModuleVersionsCmd(buildVersions module.VersionMap) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "module-versions [module_name] [--" + FlagBuild + "] [--" + FlagTarget + " <binary>]",
+		Aliases: []string{"module_versions"},
+		Short:   "Query the list of module versions",
+		Long: `Gets a list of module names and their respective consensus versions.
+Following the command with a specific module name will return only that module's information.
+
+By default, the module versions in state are used.
+If the --` + FlagBuild + ` flag is provided, the module versions compiled into this binary are used instead.
+
+If a --` + FlagTarget + ` binary is provided, the module versions compiled into it are compared with the current
+ones (either from state or this binary), and only the modules with a different consensus version are output.
+Those are the modules that will have state migrations run if that binary is used for an upgrade.
+A version of 0 indicates that a module does not exist on that side.`,
+		Example: fmt.Sprintf(`$ %[1]s
+$ %[1]s marker
+$ %[1]s --%[2]s
+$ %[1]s --%[3]s /path/to/new/provenanced`,
+			"provenanced query upgrade module-versions", FlagBuild, FlagTarget),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			moduleName := ""
+			if len(args) > 0 {
+				moduleName = args[0]
+			}
+			useBuild, _ := cmd.Flags().GetBool(FlagBuild)
+			target, _ := cmd.Flags().GetString(FlagTarget)
+
+			var current []*upgradetypes.ModuleVersion
+			if useBuild {
+				current = versionMapToList(buildVersions, moduleName)
+			} else {
+				queryClient := upgradetypes.NewQueryClient(clientCtx)
+				res, qErr := queryClient.ModuleVersions(cmd.Context(), &upgradetypes.QueryModuleVersionsRequest{ModuleName: moduleName})
+				if qErr != nil {
+					return qErr
+				}
+				current = res.ModuleVersions
+			}
+
+			if len(target) == 0 {
+				return clientCtx.PrintProto(&upgradetypes.QueryModuleVersionsResponse{ModuleVersions: current})
+			}
+
+			targetVersions, err := getTargetModuleVersions(cmd, clientCtx, target, moduleName)
+			if err != nil {
+				return err
+			}
+			output, err := json.Marshal(DiffModuleVersions(current, targetVersions))
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintRaw(output)
+		},
+	}
+
+	cmd.Flags().Bool(FlagBuild, false, "Use the module versions compiled into this binary instead of those in state")
+	cmd.Flags().String(FlagTarget, "", "A binary to compare module versions with")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// versionMapToList converts the version map into a list sorted by module name.
+// If a module name is provided, only that module is included.
+func versionMapToList(versions module.VersionMap, moduleName string) []*upgradetypes.ModuleVersion {
+	rv := make([]*upgradetypes.ModuleVersion, 0, len(versions))
+	for name, version := range versions {
+		if len(moduleName) == 0 || name == moduleName {
+			rv = append(rv, &upgradetypes.ModuleVersion{Name: name, Version: version})
+		}
+	}
+	sort.Slice(rv, func(i, j int) bool {
+		return rv[i].Name < rv[j].Name
+	})
+	return rv
+}
+
+// getTargetModuleVersions runs the target binary to get the module versions compiled into it.
+func getTargetModuleVersions(cmd *cobra.Command, clientCtx client.Context, target, moduleName string) ([]*upgradetypes.ModuleVersion, error) {
+	args := []string{"query", "upgrade", "module-versions", "--" + FlagBuild, "--" + flags.FlagOutput, flags.OutputFormatJSON}
+	if len(moduleName) > 0 {
+		args = append(args, moduleName)
+	}
+	out, err := exec.CommandContext(cmd.Context(), target, args...).Output()
+	if err != nil {
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) && len(exitErr.Stderr) > 0 {
+			return nil, fmt.Errorf("could not get module versions from %s: %w: %s", target, err, strings.TrimSpace(string(exitErr.Stderr)))
+		}
+		return nil, fmt.Errorf("could not get module versions from %s: %w", target, err)
+	}
+	var res upgradetypes.QueryModuleVersionsResponse
+	if err = clientCtx.Codec.UnmarshalJSON(out, &res); err != nil {
+		return nil, fmt.Errorf("could not parse module versions from %s: %w", target, err)
+	}
+	return res.ModuleVersions, nil
+}
+
+// DiffModuleVersions gets the modules with a different consensus version in the target, sorted by module name.
+func DiffModuleVersions(current, target []*upgradetypes.ModuleVersion) ModuleVersionChanges {
+	versions := make(map[string]*ModuleVersionChange)
+	get := func(name string) *ModuleVersionChange {
+		if versions[name] == nil {
+			versions[name] = &ModuleVersionChange{Name: name}
+		}
+		return versions[name]
+	}
+	for _, mv := range current {
+		get(mv.Name).Current = mv.Version
+	}
+	for _, mv := range target {
+		get(mv.Name).Target = mv.Version
+	}
+
+	rv := ModuleVersionChanges{Changes: []ModuleVersionChange{}}
+	for _, change := range versions {
+		if change.Current != change.Target {
+			rv.Changes = append(rv.Changes, *change)
+		}
+	}
+	sort.Slice(rv.Changes, func(i, j int) bool {
+		return rv.Changes[i].Name < rv.Changes[j].Name
+	})
+	return rv
+}
+
+// replaceQueryModuleVersionsCmd replaces the query upgrade module-versions command with ours.
+func replaceQueryModuleVersionsCmd(rootCmd *cobra.Command, buildVersions module.VersionMap) {
+	upgradeCmd, _, err := rootCmd.Find([]string{"query", "upgrade"})
+	if err != nil || upgradeCmd == nil || upgradeCmd.Name() != "upgrade" {
+		// If the command doesn't exist, there's nothing to do.
+		return
+	}
+	for _, sub := range upgradeCmd.Commands() {
+		if sub.Name() == "module-versions" {
+			upgradeCmd.RemoveCommand(sub)
+		}
+	}
+	upgradeCmd.AddCommand(GetQueryModuleVersionsCmd(buildVersions))
+}
@@ -0,0 +1,61 @@
+package cmd_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+
+	"github.com/provenance-io/provenance/cmd/provenanced/cmd"
+)
+
+func TestDiffModuleVersions(t *testing.T) {
+	mv := func(name string, version uint64) *upgradetypes.ModuleVersion {
+		return &upgradetypes.ModuleVersion{Name: name, Version: version}
+	}
+	change := func(name string, current, target uint64) cmd.ModuleVersionChange {
+		return cmd.ModuleVersionChange{Name: name, Current: current, Target: target}
+	}
+
+	tests := []struct {
+		name    string
+		current []*upgradetypes.ModuleVersion
+		target  []*upgradetypes.ModuleVersion
+		exp     []cmd.ModuleVersionChange
+	}{
+		{
+			name: "both empty",
+			exp:  []cmd.ModuleVersionChange{},
+		},
+		{
+			name:    "same versions",
+			current: []*upgradetypes.ModuleVersion{mv("bank", 4), mv("marker", 5)},
+			target:  []*upgradetypes.ModuleVersion{mv("marker", 5), mv("bank", 4)},
+			exp:     []cmd.ModuleVersionChange{},
+		},
+		{
+			name:    "one changed",
+			current: []*upgradetypes.ModuleVersion{mv("bank", 4), mv("marker", 5)},
+			target:  []*upgradetypes.ModuleVersion{mv("bank", 4), mv("marker", 6)},
+			exp:     []cmd.ModuleVersionChange{change("marker", 5, 6)},
+		},
+		{
+			name:    "added, removed, and changed",
+			current: []*upgradetypes.ModuleVersion{mv("name", 2), mv("bank", 4), mv("marker", 5)},
+			target:  []*upgradetypes.ModuleVersion{mv("marker", 6), mv("exchange", 1), mv("bank", 4)},
+			exp: []cmd.ModuleVersionChange{
+				change("exchange", 0, 1),
+				change("marker", 5, 6),
+				change("name", 2, 0),
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			actual := cmd.DiffModuleVersions(tc.current, tc.target)
+			assert.Equal(t, tc.exp, actual.Changes, "DiffModuleVersions changes")
+		})
+	}
+}
@@ -38,6 +38,7 @@ import (
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	authcmd "github.com/cosmos/cosmos-sdk/x/auth/client/cli"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
@@ -49,6 +50,7 @@ import (
 	"github.com/provenance-io/provenance/app/params"
 	"github.com/provenance-io/provenance/cmd/provenanced/config"
 	"github.com/provenance-io/provenance/internal/pioconfig"
+	metacli "github.com/provenance-io/provenance/x/meta/client/cli"
 )
 
 // NewRootCmd creates a new root command for provenanced. It is called once in the main function.
@@ -133,6 +135,7 @@ func NewRootCmd(sealConfig bool) (*cobra.Command, params.EncodingConfig) {
 
 	fixTxWasmInstantiate2Aliases(rootCmd)
 	fixQueryWasmBuildAddressFlags(rootCmd)
+	replaceQueryModuleVersionsCmd(rootCmd, tempApp.ModuleVersions())
 
 	return rootCmd, encodingConfig
 }
@@ -157,6 +160,8 @@ func Execute(rootCmd *cobra.Command) error {
 	rootCmd.PersistentFlags().String(config.CustomDenomFlag, "", "Indicates if a custom denom is to be used, and the name of it (default nhash)")
 	// Custom msgFee floor price flag added to root command
 	rootCmd.PersistentFlags().Int64(config.CustomMsgFeeFloorPriceFlag, 0, "Custom msgfee floor price, optional (default 1905)")
+	// Additional bech32 HRPs flag added to root command
+	rootCmd.PersistentFlags().StringSlice(config.AdditionalHRPsFlag, nil, "Bech32 HRPs of other networks (e.g. tp or pb) that addresses can be converted to or from")
 
 	executor := cmtcli.PrepareBaseCmd(rootCmd, "", app.DefaultNodeHome)
 	return executor.ExecuteContext(ctx)
@@ -170,6 +175,7 @@ func initRootCmd(rootCmd *cobra.Command, encodingConfig params.EncodingConfig, b
 		debug.Cmd(),
 		ConfigCmd(),
 		AddMetaAddressCmd(),
+		GetBech32Cmd(),
 		snapshot.Cmd(newApp),
 		GetPreUpgradeCmd(),
 		GetDocGenCmd(),
@@ -180,6 +186,8 @@ func initRootCmd(rootCmd *cobra.Command, encodingConfig params.EncodingConfig, b
 	fixDebugPubkeyRawTypeFlag(rootCmd)
 
 	server.AddCommands(rootCmd, app.DefaultNodeHome, newApp, createAppAndExport, addModuleInitFlags)
+	addExportRoundTripFlag(rootCmd)
+	useStreamingExport(rootCmd)
 
 	// add keybase, auxiliary RPC, query, and tx child commands
 	rootCmd.AddCommand(
@@ -219,6 +227,7 @@ func queryCommand() *cobra.Command {
 		server.QueryBlocksCmd(),
 		authcmd.QueryTxCmd(),
 		server.QueryBlockResultsCmd(),
+		metacli.QueryCmd(),
 	)
 
 	cmd.PersistentFlags().String(flags.FlagChainID, "", "The network chain ID")
@@ -320,9 +329,26 @@ func newApp(logger log.Logger, db dbm.DB, traceStore io.Writer, appOpts serverty
 		baseapp.SetIAVLCacheSize(getIAVLCacheSize(appOpts)),
 		baseapp.SetIAVLDisableFastNode(cast.ToBool(appOpts.Get(server.FlagDisableIAVLFastNode))),
 		baseapp.SetChainID(chainID),
+		baseapp.SetMempool(getMempool(appOpts)),
 	)
 }
 
+// getMempool returns the app-side mempool to use.
+// If the mempool.max-txs config value is negative (the default), a no-op mempool is used, leaving tx ordering to CometBFT.
+// Otherwise, a priority mempool is used so that txs with a higher priority (e.g. from the msgfees msg priorities)
+// are included in blocks before the others.
+func getMempool(appOpts servertypes.AppOptions) mempool.Mempool {
+	maxTxs := cast.ToInt(appOpts.Get(server.FlagMempoolMaxTxs))
+	if maxTxs < 0 {
+		return mempool.NoOpMempool{}
+	}
+	return mempool.NewPriorityMempool(mempool.PriorityNonceMempoolConfig[int64]{
+		TxPriority:      mempool.NewDefaultTxPriority(),
+		MaxTx:           maxTxs,
+		SignerExtractor: mempool.NewDefaultSignerExtractionAdapter(),
+	})
+}
+
 // warnAboutSettings logs warnings about any settings that might cause problems.
 func warnAboutSettings(logger log.Logger, appOpts servertypes.AppOptions) {
 	defer func() {
@@ -358,19 +384,33 @@ func createAppAndExport(
 	appOpts servertypes.AppOptions,
 	modulesToExport []string,
 ) (servertypes.ExportedApp, error) {
-	var a *app.App
-
-	if height != -1 {
-		a = app.New(logger, db, traceStore, false, appOpts)
+	a, err := loadAppForExport(logger, db, traceStore, height, appOpts)
+	if err != nil {
+		return servertypes.ExportedApp{}, err
+	}
 
-		if err := a.LoadHeight(height); err != nil {
-			return servertypes.ExportedApp{}, err
-		}
-	} else {
-		a = app.New(logger, db, traceStore, true, appOpts)
+	exported, err := a.ExportAppStateAndValidators(forZeroHeight, jailAllowedAddrs, modulesToExport)
+	if err != nil || !cast.ToBool(appOpts.Get(FlagAssertRoundTrip)) {
+		return exported, err
+	}
+	if err = a.AssertGenesisRoundTrip(); err != nil {
+		return servertypes.ExportedApp{}, fmt.Errorf("genesis round trip failed: %w", err)
 	}
+	return exported, nil
+}
+
+// FlagAssertRoundTrip is the flag on the export command that verifies the exported state can be re-imported unchanged.
+const FlagAssertRoundTrip = "assert-roundtrip"
 
-	return a.ExportAppStateAndValidators(forZeroHeight, jailAllowedAddrs, modulesToExport)
+// addExportRoundTripFlag adds the --assert-roundtrip flag to the export command.
+func addExportRoundTripFlag(rootCmd *cobra.Command) {
+	exportCmd, _, err := rootCmd.Find([]string{"export"})
+	if err != nil || exportCmd == nil {
+		// If the command doesn't exist, there's nothing to do.
+		return
+	}
+	exportCmd.Flags().Bool(FlagAssertRoundTrip, false,
+		"After exporting, re-import the state of the modules that support it and fail if exporting it again gives a different hash")
 }
 
 // fixDebugPubkeyRawTypeFlag removes the -t shorthand option of the --type flag from the debug pubkey-raw command.
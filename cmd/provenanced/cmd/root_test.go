@@ -253,6 +253,16 @@ func TestFixTxWasmInstantiate2AliasesCanary(t *testing.T) {
 	assert.Equal(t, i1Aliases, i2Aliases, "instantiate2 aliases")
 }
 
+func TestExportCmdHasAssertRoundTripFlag(t *testing.T) {
+	rootCmd, _ := NewRootCmd(false)
+	exportCmd, _, err := rootCmd.Find([]string{"export"})
+	require.NoError(t, err, "Finding the export command")
+	require.NotNil(t, exportCmd, "The export command")
+	flag := exportCmd.Flags().Lookup(FlagAssertRoundTrip)
+	require.NotNil(t, flag, "--%s flag", FlagAssertRoundTrip)
+	assert.Equal(t, "false", flag.DefValue, "--%s flag default", FlagAssertRoundTrip)
+}
+
 func TestCmdsWithPersistentPreRun(t *testing.T) {
 	// Our root command has a PersistentPreRunE. If a sub-command has one, the root one will not
 	// be run for that sub-command and any of its children. That is almost certainly not good.
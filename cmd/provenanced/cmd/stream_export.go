@@ -0,0 +1,146 @@
+package cmd
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+
+	"cosmossdk.io/log"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+
+	"github.com/provenance-io/provenance/app"
+)
+
+// flagTraceStore is the name of the (sdk's private) flag that has the file to write store traces to.
+const flagTraceStore = "trace-store"
+
+// useStreamingExport changes the export command so that the genesis file is written as the state is read.
+// The SDK's export command holds the whole app state in memory (a few times over), which is
+// more than some nodes have for a mainnet export.
+func useStreamingExport(rootCmd *cobra.Command) {
+	exportCmd, _, err := rootCmd.Find([]string{"export"})
+	if err != nil || exportCmd == nil {
+		// If the command doesn't exist, there's nothing to do.
+		return
+	}
+	exportCmd.RunE = runStreamingExport
+}
+
+// runStreamingExport is the RunE for the export command that streams the exported genesis to the output.
+func runStreamingExport(cmd *cobra.Command, _ []string) error {
+	serverCtx := server.GetServerContextFromCmd(cmd)
+	config := serverCtx.Config
+
+	homeDir, _ := cmd.Flags().GetString(flags.FlagHome)
+	config.SetRoot(homeDir)
+
+	if _, err := os.Stat(config.GenesisFile()); os.IsNotExist(err) {
+		return err
+	}
+
+	db, err := dbm.NewDB("application", server.GetAppDBBackend(serverCtx.Viper), filepath.Join(config.RootDir, "data"))
+	if err != nil {
+		return err
+	}
+
+	var traceWriter io.WriteCloser
+	if traceWriterFile, _ := cmd.Flags().GetString(flagTraceStore); len(traceWriterFile) > 0 {
+		traceWriter, err = os.OpenFile(traceWriterFile, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0o666)
+		if err != nil {
+			return err
+		}
+	}
+
+	height, _ := cmd.Flags().GetInt64(server.FlagHeight)
+	forZeroHeight, _ := cmd.Flags().GetBool(server.FlagForZeroHeight)
+	jailAllowedAddrs, _ := cmd.Flags().GetStringSlice(server.FlagJailAllowedAddrs)
+	modulesToExport, _ := cmd.Flags().GetStringSlice(server.FlagModulesToExport)
+	outputDocument, _ := cmd.Flags().GetString(flags.FlagOutputDocument)
+
+	appGenesis, err := genutiltypes.AppGenesisFromFile(config.GenesisFile())
+	if err != nil {
+		return err
+	}
+
+	a, err := loadAppForExport(serverCtx.Logger, db, traceWriter, height, serverCtx.Viper)
+	if err != nil {
+		return fmt.Errorf("error exporting state: %w", err)
+	}
+	if cast.ToBool(serverCtx.Viper.Get(FlagAssertRoundTrip)) {
+		if err = a.AssertGenesisRoundTrip(); err != nil {
+			return fmt.Errorf("genesis round trip failed: %w", err)
+		}
+	}
+
+	out := cmd.OutOrStdout()
+	if len(outputDocument) > 0 {
+		file, ferr := os.OpenFile(outputDocument, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0o600)
+		if ferr != nil {
+			return ferr
+		}
+		defer file.Close()
+		out = file
+	}
+
+	bw := bufio.NewWriter(out)
+	if err = writeExportedGenesis(bw, a, appGenesis, forZeroHeight, jailAllowedAddrs, modulesToExport); err != nil {
+		return fmt.Errorf("error exporting state: %w", err)
+	}
+	return bw.Flush()
+}
+
+// loadAppForExport creates the app to export, loading the requested height (or the latest if it's -1).
+func loadAppForExport(logger log.Logger, db dbm.DB, traceStore io.Writer, height int64, appOpts servertypes.AppOptions) (*app.App, error) {
+	if height == -1 {
+		return app.New(logger, db, traceStore, true, appOpts), nil
+	}
+	a := app.New(logger, db, traceStore, false, appOpts)
+	if err := a.LoadHeight(height); err != nil {
+		return nil, err
+	}
+	return a, nil
+}
+
+// writeExportedGenesis writes the genesis file of the exported app to w.
+// The app state is written first (as it's read from state), followed by the rest of the provided genesis fields.
+func writeExportedGenesis(w io.Writer, a *app.App, appGenesis *genutiltypes.AppGenesis,
+	forZeroHeight bool, jailAllowedAddrs, modulesToExport []string,
+) error {
+	if _, err := io.WriteString(w, `{"app_state":`); err != nil {
+		return err
+	}
+	exported, err := a.ExportAppStateAndValidatorsTo(w, forZeroHeight, jailAllowedAddrs, modulesToExport)
+	if err != nil {
+		return err
+	}
+
+	appGenesis.AppName = version.AppName
+	appGenesis.AppVersion = version.Version
+	appGenesis.AppState = nil
+	appGenesis.InitialHeight = exported.Height
+	appGenesis.Consensus = genutiltypes.NewConsensusGenesis(exported.ConsensusParams, exported.Validators)
+
+	// Without an app state, this is a json object of just the other fields,
+	// so we can replace its opening brace with a comma to put them after the app state.
+	rest, err := json.Marshal(appGenesis)
+	if err != nil {
+		return err
+	}
+	if _, err = io.WriteString(w, ","); err != nil {
+		return err
+	}
+	_, err = w.Write(rest[1:])
+	return err
+}
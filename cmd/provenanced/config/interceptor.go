@@ -11,6 +11,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/server"
 
+	"github.com/provenance-io/provenance/internal/bech32util"
 	"github.com/provenance-io/provenance/internal/pioconfig"
 )
 
@@ -23,6 +24,8 @@ const (
 	EnvTypeFlag = "testnet"
 	// CoinTypeFlag is a flag for indicating coin type.
 	CoinTypeFlag = "coin-type"
+	// AdditionalHRPsFlag is a flag for providing bech32 HRPs (other than the chain's own) that addresses can be converted to or from.
+	AdditionalHRPsFlag = "additional-hrps"
 
 	ConsensusTimeoutCommitKey       = "consensus.timeout_commit"
 	ConsensusTimeoutCommitValue     = "3.5s"
@@ -50,6 +53,9 @@ func InterceptConfigsPreRunHandler(cmd *cobra.Command) error {
 
 	// Set the pio config now so that the proper default is set for the rest of the stuff.
 	SetPioConfigFromFlags(cmd.Flags())
+	if err := SetAdditionalHRPsFromFlags(cmd.Flags()); err != nil {
+		return err
+	}
 
 	// Create a new Server context with the same viper as the client context, a default config, and no logger.
 	serverCtx := server.NewContext(vpr, DefaultCmtConfig(), nil)
@@ -79,6 +85,16 @@ func SetPioConfigFromFlags(flagSet *pflag.FlagSet) {
 	pioconfig.SetProvenanceConfig(customDenom, customMsgFeeFloor)
 }
 
+// SetAdditionalHRPsFromFlags sets the additional bech32 HRPs using the value of the AdditionalHRPsFlag.
+func SetAdditionalHRPsFromFlags(flagSet *pflag.FlagSet) error {
+	// Ignoring the error here in the off chance that the flag wasn't defined originally.
+	hrps, _ := flagSet.GetStringSlice(AdditionalHRPsFlag)
+	if err := bech32util.SetAdditionalHRPs(hrps...); err != nil {
+		return fmt.Errorf("invalid --%s value: %w", AdditionalHRPsFlag, err)
+	}
+	return nil
+}
+
 // Binds viper flags using the PIO ENV prefix.
 func bindFlagsAndEnv(cmd *cobra.Command, v *viper.Viper) (err error) {
 	defer func() {
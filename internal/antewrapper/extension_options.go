@@ -0,0 +1,165 @@
+package antewrapper
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/cosmos/gogoproto/proto"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	cosmosante "github.com/cosmos/cosmos-sdk/x/auth/ante"
+)
+
+// ExtensionOptionRegistration is a tx extension option that provenance accepts.
+// The option's type must also be registered in the interface registry as a tx.TxExtensionOptionI
+// so that it is unpacked when a tx is decoded.
+type ExtensionOptionRegistration struct {
+	// Option is an empty instance of the extension option's type.
+	Option proto.Message
+	// Validate is an optional check of an option provided in a tx. It's run after the option's ValidateBasic (if it has one).
+	Validate func(ctx sdk.Context, tx sdk.Tx, opt proto.Message) error
+}
+
+// HasExtensionOptions is implemented by modules that provide tx extension options.
+type HasExtensionOptions interface {
+	ExtensionOptions() []ExtensionOptionRegistration
+}
+
+// ExtensionOptionRegistry collects the tx extension options that provenance accepts.
+type ExtensionOptionRegistry struct {
+	registrations map[string]ExtensionOptionRegistration
+}
+
+// NewExtensionOptionRegistry creates a new, empty ExtensionOptionRegistry.
+func NewExtensionOptionRegistry() *ExtensionOptionRegistry {
+	return &ExtensionOptionRegistry{registrations: make(map[string]ExtensionOptionRegistration)}
+}
+
+// Register adds extension options to this registry.
+// An error is returned if an option is nil or its type is already registered.
+func (r *ExtensionOptionRegistry) Register(regs ...ExtensionOptionRegistration) error {
+	for _, reg := range regs {
+		if reg.Option == nil {
+			return fmt.Errorf("extension option cannot be nil")
+		}
+		typeURL := sdk.MsgTypeURL(reg.Option)
+		if _, found := r.registrations[typeURL]; found {
+			return fmt.Errorf("extension option %q is already registered", typeURL)
+		}
+		r.registrations[typeURL] = reg
+	}
+	return nil
+}
+
+// TypeURLs returns the sorted type urls of the registered extension options.
+func (r *ExtensionOptionRegistry) TypeURLs() []string {
+	rv := make([]string, 0, len(r.registrations))
+	for typeURL := range r.registrations {
+		rv = append(rv, typeURL)
+	}
+	sort.Strings(rv)
+	return rv
+}
+
+// TxExtensionOptions are the parsed extension options of a tx, by type url.
+type TxExtensionOptions map[string]proto.Message
+
+// Has returns true if these extension options contain one of the same type as the one provided.
+func (o TxExtensionOptions) Has(opt proto.Message) bool {
+	_, found := o[sdk.MsgTypeURL(opt)]
+	return found
+}
+
+// Get returns the extension option with the provided type url, or nil if there isn't one.
+func (o TxExtensionOptions) Get(typeURL string) proto.Message {
+	return o[typeURL]
+}
+
+// txExtensionOptionsContextKey is the key used in an sdk.Context to set/get the TxExtensionOptions.
+const txExtensionOptionsContextKey = "txExtensionOptionsContextKey"
+
+// GetTxExtensionOptions gets the parsed extension options of the current tx from the context.
+// It returns nil if the extension options decorator has not added them to the context.
+func GetTxExtensionOptions(ctx sdk.Context) TxExtensionOptions {
+	if opts, ok := ctx.Value(txExtensionOptionsContextKey).(TxExtensionOptions); ok {
+		return opts
+	}
+	return nil
+}
+
+// ExtensionOptionsDecorator parses and validates a tx's extension options and adds them to the context.
+// Critical extension options must be registered; unknown non-critical ones are ignored.
+// Each extension option type can only be provided once in a tx.
+type ExtensionOptionsDecorator struct {
+	registry *ExtensionOptionRegistry
+}
+
+func NewExtensionOptionsDecorator(registry *ExtensionOptionRegistry) ExtensionOptionsDecorator {
+	if registry == nil {
+		registry = NewExtensionOptionRegistry()
+	}
+	return ExtensionOptionsDecorator{registry: registry}
+}
+
+func (d ExtensionOptionsDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	opts, err := d.parse(ctx, tx)
+	if err != nil {
+		return ctx, err
+	}
+	return next(ctx.WithValue(txExtensionOptionsContextKey, opts), tx, simulate)
+}
+
+// parse unpacks and validates the registered extension options in the provided tx.
+func (d ExtensionOptionsDecorator) parse(ctx sdk.Context, tx sdk.Tx) (TxExtensionOptions, error) {
+	rv := TxExtensionOptions{}
+	extTx, ok := tx.(cosmosante.HasExtensionOptionsTx)
+	if !ok {
+		return rv, nil
+	}
+
+	addOpt := func(optAny *codectypes.Any, critical bool) error {
+		if optAny == nil {
+			return sdkerrors.ErrUnknownExtensionOptions.Wrap("extension option cannot be nil")
+		}
+		reg, found := d.registry.registrations[optAny.TypeUrl]
+		if !found {
+			if critical {
+				return sdkerrors.ErrUnknownExtensionOptions.Wrapf("%q", optAny.TypeUrl)
+			}
+			return nil
+		}
+		if _, dup := rv[optAny.TypeUrl]; dup {
+			return sdkerrors.ErrInvalidRequest.Wrapf("duplicate extension option %q", optAny.TypeUrl)
+		}
+		opt, ok := optAny.GetCachedValue().(proto.Message)
+		if !ok {
+			return sdkerrors.ErrInvalidRequest.Wrapf("could not unpack extension option %q", optAny.TypeUrl)
+		}
+		if vb, ok := opt.(interface{ ValidateBasic() error }); ok {
+			if err := vb.ValidateBasic(); err != nil {
+				return sdkerrors.ErrInvalidRequest.Wrapf("invalid extension option %q: %v", optAny.TypeUrl, err)
+			}
+		}
+		if reg.Validate != nil {
+			if err := reg.Validate(ctx, tx, opt); err != nil {
+				return sdkerrors.ErrInvalidRequest.Wrapf("invalid extension option %q: %v", optAny.TypeUrl, err)
+			}
+		}
+		rv[optAny.TypeUrl] = opt
+		return nil
+	}
+
+	for _, optAny := range extTx.GetExtensionOptions() {
+		if err := addOpt(optAny, true); err != nil {
+			return nil, err
+		}
+	}
+	for _, optAny := range extTx.GetNonCriticalExtensionOptions() {
+		if err := addOpt(optAny, false); err != nil {
+			return nil, err
+		}
+	}
+	return rv, nil
+}
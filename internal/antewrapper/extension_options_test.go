@@ -0,0 +1,208 @@
+package antewrapper_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+
+	"github.com/provenance-io/provenance/app"
+	"github.com/provenance-io/provenance/internal/antewrapper"
+	nametypes "github.com/provenance-io/provenance/x/name/types"
+)
+
+func TestExtensionOptionRegistry(t *testing.T) {
+	resolveNames := antewrapper.ExtensionOptionRegistration{Option: &nametypes.ExtensionOptionResolveNames{}}
+	bindName := antewrapper.ExtensionOptionRegistration{Option: &nametypes.MsgBindNameRequest{}}
+
+	tests := []struct {
+		name        string
+		regs        []antewrapper.ExtensionOptionRegistration
+		expErr      string
+		expTypeURLs []string
+	}{
+		{
+			name:        "nothing registered",
+			expTypeURLs: []string{},
+		},
+		{
+			name:   "nil option",
+			regs:   []antewrapper.ExtensionOptionRegistration{{}},
+			expErr: "extension option cannot be nil",
+		},
+		{
+			name:   "duplicate option",
+			regs:   []antewrapper.ExtensionOptionRegistration{resolveNames, bindName, resolveNames},
+			expErr: `extension option "/provenance.name.v1.ExtensionOptionResolveNames" is already registered`,
+		},
+		{
+			name: "two options",
+			regs: []antewrapper.ExtensionOptionRegistration{resolveNames, bindName},
+			expTypeURLs: []string{
+				"/provenance.name.v1.ExtensionOptionResolveNames",
+				"/provenance.name.v1.MsgBindNameRequest",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			registry := antewrapper.NewExtensionOptionRegistry()
+			err := registry.Register(tc.regs...)
+			if len(tc.expErr) > 0 {
+				assert.EqualError(t, err, tc.expErr, "Register")
+				return
+			}
+			require.NoError(t, err, "Register")
+			assert.Equal(t, tc.expTypeURLs, registry.TypeURLs(), "TypeURLs")
+		})
+	}
+}
+
+func TestExtensionOptionsDecorator(t *testing.T) {
+	pioApp := app.Setup(t)
+	ctx := pioApp.BaseApp.NewContext(false)
+	txConfig := pioApp.GetTxConfig()
+
+	newAny := func(opt proto.Message) *codectypes.Any {
+		rv, err := codectypes.NewAnyWithValue(opt)
+		require.NoError(t, err, "NewAnyWithValue(%T)", opt)
+		return rv
+	}
+	resolveNames := newAny(&nametypes.ExtensionOptionResolveNames{})
+	validBind := newAny(&nametypes.MsgBindNameRequest{
+		Parent: nametypes.NameRecord{Name: "pb", Address: sdk.AccAddress("parent______________").String()},
+		Record: nametypes.NameRecord{Name: "child", Address: sdk.AccAddress("child_______________").String()},
+	})
+	invalidBind := newAny(&nametypes.MsgBindNameRequest{})
+	unknown := newAny(&nametypes.QueryParamsRequest{})
+
+	errRejected := errors.New("rejected by validate")
+	registry := antewrapper.NewExtensionOptionRegistry()
+	require.NoError(t, registry.Register(
+		antewrapper.NewResolveNamesExtensionOptionRegistration(),
+		antewrapper.ExtensionOptionRegistration{
+			Option: &nametypes.MsgBindNameRequest{},
+			Validate: func(_ sdk.Context, _ sdk.Tx, opt proto.Message) error {
+				if opt.(*nametypes.MsgBindNameRequest).Record.Name == "rejected" {
+					return errRejected
+				}
+				return nil
+			},
+		},
+	), "Register")
+	rejectedBind := newAny(&nametypes.MsgBindNameRequest{
+		Parent: nametypes.NameRecord{Name: "pb", Address: sdk.AccAddress("parent______________").String()},
+		Record: nametypes.NameRecord{Name: "rejected", Address: sdk.AccAddress("child_______________").String()},
+	})
+
+	newTx := func(critical, nonCritical []*codectypes.Any) sdk.Tx {
+		builder := txConfig.NewTxBuilder()
+		extBuilder := builder.(authtx.ExtensionOptionsTxBuilder)
+		extBuilder.SetExtensionOptions(critical...)
+		extBuilder.SetNonCriticalExtensionOptions(nonCritical...)
+		return builder.GetTx()
+	}
+
+	tests := []struct {
+		name        string
+		critical    []*codectypes.Any
+		nonCritical []*codectypes.Any
+		expErr      string
+		expErrIs    error
+		expTypeURLs []string
+	}{
+		{
+			name:        "no options",
+			expTypeURLs: nil,
+		},
+		{
+			name:        "registered critical option",
+			critical:    []*codectypes.Any{resolveNames},
+			expTypeURLs: []string{resolveNames.TypeUrl},
+		},
+		{
+			name:        "registered non-critical option",
+			nonCritical: []*codectypes.Any{resolveNames},
+			expTypeURLs: []string{resolveNames.TypeUrl},
+		},
+		{
+			name:        "two registered options",
+			critical:    []*codectypes.Any{resolveNames},
+			nonCritical: []*codectypes.Any{validBind},
+			expTypeURLs: []string{resolveNames.TypeUrl, validBind.TypeUrl},
+		},
+		{
+			name:     "unknown critical option",
+			critical: []*codectypes.Any{resolveNames, unknown},
+			expErr:   `"/provenance.name.v1.QueryParamsRequest": unknown extension options`,
+			expErrIs: sdkerrors.ErrUnknownExtensionOptions,
+		},
+		{
+			name:        "unknown non-critical option",
+			nonCritical: []*codectypes.Any{unknown, resolveNames},
+			expTypeURLs: []string{resolveNames.TypeUrl},
+		},
+		{
+			name:        "duplicate option",
+			critical:    []*codectypes.Any{resolveNames},
+			nonCritical: []*codectypes.Any{resolveNames},
+			expErr:      `duplicate extension option "/provenance.name.v1.ExtensionOptionResolveNames": invalid request`,
+			expErrIs:    sdkerrors.ErrInvalidRequest,
+		},
+		{
+			name:     "option fails ValidateBasic",
+			critical: []*codectypes.Any{invalidBind},
+			expErr: `invalid extension option "/provenance.name.v1.MsgBindNameRequest": ` +
+				"parent name cannot be empty: invalid request",
+			expErrIs: sdkerrors.ErrInvalidRequest,
+		},
+		{
+			name:     "option fails Validate",
+			critical: []*codectypes.Any{rejectedBind},
+			expErr: `invalid extension option "/provenance.name.v1.MsgBindNameRequest": ` +
+				"rejected by validate: invalid request",
+			expErrIs: sdkerrors.ErrInvalidRequest,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			theTx := newTx(tc.critical, tc.nonCritical)
+			terminator := NewTestTerminator()
+			decorator := antewrapper.NewExtensionOptionsDecorator(registry)
+			_, err := decorator.AnteHandle(ctx, theTx, false, terminator.AnteHandler)
+			if len(tc.expErr) > 0 {
+				assert.EqualError(t, err, tc.expErr, "AnteHandle error")
+				assert.ErrorIs(t, err, tc.expErrIs, "AnteHandle error")
+				assert.False(t, terminator.isTerminated, "whether next was called")
+				return
+			}
+			require.NoError(t, err, "AnteHandle")
+			require.True(t, terminator.isTerminated, "whether next was called")
+
+			opts := antewrapper.GetTxExtensionOptions(terminator.ctx)
+			require.NotNil(t, opts, "GetTxExtensionOptions")
+			var typeURLs []string
+			for _, typeURL := range registry.TypeURLs() {
+				if opts.Get(typeURL) != nil {
+					typeURLs = append(typeURLs, typeURL)
+				}
+			}
+			assert.Equal(t, tc.expTypeURLs, typeURLs, "type urls of the parsed extension options")
+			assert.Equal(t, len(tc.expTypeURLs) > 0 && tc.expTypeURLs[0] == resolveNames.TypeUrl,
+				opts.Has(&nametypes.ExtensionOptionResolveNames{}), "Has(ExtensionOptionResolveNames)")
+		})
+	}
+
+	t.Run("without the decorator", func(t *testing.T) {
+		assert.Nil(t, antewrapper.GetTxExtensionOptions(ctx), "GetTxExtensionOptions")
+	})
+}
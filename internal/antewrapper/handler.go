@@ -17,14 +17,17 @@ import (
 
 // HandlerOptions are the options required for constructing a default SDK AnteHandler.
 type HandlerOptions struct {
-	AccountKeeper          cosmosante.AccountKeeper
-	BankKeeper             banktypes.Keeper
-	ExtensionOptionChecker cosmosante.ExtensionOptionChecker
-	FeegrantKeeper         msgfeestypes.FeegrantKeeper
-	MsgFeesKeeper          msgfeestypes.MsgFeesKeeper
-	CircuitKeeper          circuitante.CircuitBreaker
-	TxSigningHandlerMap    *txsigning.HandlerMap
-	SigGasConsumer         func(meter storetypes.GasMeter, sig signing.SignatureV2, params types.Params) error
+	AccountKeeper       cosmosante.AccountKeeper
+	BankKeeper          banktypes.Keeper
+	FeegrantKeeper      msgfeestypes.FeegrantKeeper
+	MsgFeesKeeper       msgfeestypes.MsgFeesKeeper
+	CircuitKeeper       circuitante.CircuitBreaker
+	TxSigningHandlerMap *txsigning.HandlerMap
+	SigGasConsumer      func(meter storetypes.GasMeter, sig signing.SignatureV2, params types.Params) error
+	// Decorators are additional decorators to use, e.g. the ones provided by modules.
+	Decorators []DecoratorRegistration
+	// ExtensionOptions are the tx extension options to accept, e.g. the ones provided by modules.
+	ExtensionOptions []ExtensionOptionRegistration
 }
 
 func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
@@ -45,24 +48,45 @@ func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
 		sigGasConsumer = cosmosante.DefaultSigVerificationGasConsumer
 	}
 
-	decorators := []sdk.AnteDecorator{
-		cosmosante.NewSetUpContextDecorator(), // outermost AnteDecorator. SetUpContext must be called first
-		circuitante.NewCircuitBreakerDecorator(options.CircuitKeeper),
-		NewFeeMeterContextDecorator(), // NOTE : fee gas meter also has the functionality of GasTracerContextDecorator in previous versions
-		NewTxGasLimitDecorator(),
-		NewMinGasPricesDecorator(),
-		NewMsgFeesDecorator(options.MsgFeesKeeper),
-		cosmosante.NewExtensionOptionsDecorator(options.ExtensionOptionChecker),
-		cosmosante.NewValidateBasicDecorator(),
-		cosmosante.NewTxTimeoutHeightDecorator(),
-		cosmosante.NewValidateMemoDecorator(options.AccountKeeper),
-		cosmosante.NewConsumeGasForTxSizeDecorator(options.AccountKeeper),
-		NewProvenanceDeductFeeDecorator(options.AccountKeeper, options.BankKeeper, options.FeegrantKeeper, options.MsgFeesKeeper),
-		cosmosante.NewSetPubKeyDecorator(options.AccountKeeper), // SetPubKeyDecorator must be called before all signature verification decorators
-		cosmosante.NewValidateSigCountDecorator(options.AccountKeeper),
-		cosmosante.NewSigGasConsumeDecorator(options.AccountKeeper, sigGasConsumer),
-		cosmosante.NewSigVerificationDecorator(options.AccountKeeper, options.TxSigningHandlerMap),
-		cosmosante.NewIncrementSequenceDecorator(options.AccountKeeper),
+	extOpts := NewExtensionOptionRegistry()
+	if err := extOpts.Register(options.ExtensionOptions...); err != nil {
+		return nil, sdkerrors.ErrLogic.Wrap(err.Error())
+	}
+
+	// These decorators are always used, and run in this order.
+	// Decorators provided in the options are placed among them according to their constraints.
+	core := []DecoratorRegistration{
+		{Name: AnteSetUpContext, Decorator: cosmosante.NewSetUpContextDecorator()}, // outermost AnteDecorator. SetUpContext must be called first
+		{Name: AnteCircuitBreaker, Decorator: circuitante.NewCircuitBreakerDecorator(options.CircuitKeeper)},
+		{Name: AnteFeeMeterContext, Decorator: NewFeeMeterContextDecorator()}, // NOTE : fee gas meter also has the functionality of GasTracerContextDecorator in previous versions
+		{Name: AnteTxGasLimit, Decorator: NewTxGasLimitDecorator()},
+		{Name: AnteMinGasPrices, Decorator: NewMinGasPricesDecorator()},
+		{Name: AnteExtensionOptions, Decorator: NewExtensionOptionsDecorator(extOpts)},
+		{Name: AnteValidateBasic, Decorator: cosmosante.NewValidateBasicDecorator()},
+		{Name: AnteTxTimeoutHeight, Decorator: cosmosante.NewTxTimeoutHeightDecorator()},
+		{Name: AnteValidateMemo, Decorator: cosmosante.NewValidateMemoDecorator(options.AccountKeeper)},
+		{Name: AnteConsumeGasForTxSize, Decorator: cosmosante.NewConsumeGasForTxSizeDecorator(options.AccountKeeper)},
+		{Name: AnteDeductFee, Decorator: NewProvenanceDeductFeeDecorator(options.AccountKeeper, options.BankKeeper, options.FeegrantKeeper, options.MsgFeesKeeper)},
+		{Name: AnteSetPubKey, Decorator: cosmosante.NewSetPubKeyDecorator(options.AccountKeeper)}, // SetPubKeyDecorator must be called before all signature verification decorators
+		{Name: AnteValidateSigCount, Decorator: cosmosante.NewValidateSigCountDecorator(options.AccountKeeper)},
+		{Name: AnteSigGasConsume, Decorator: cosmosante.NewSigGasConsumeDecorator(options.AccountKeeper, sigGasConsumer)},
+		{Name: AnteSigVerification, Decorator: cosmosante.NewSigVerificationDecorator(options.AccountKeeper, options.TxSigningHandlerMap)},
+		{Name: AnteIncrementSequence, Decorator: cosmosante.NewIncrementSequenceDecorator(options.AccountKeeper)},
+	}
+	for i := 1; i < len(core); i++ {
+		core[i].After = []string{core[i-1].Name}
+	}
+
+	registry := NewDecoratorRegistry()
+	if err := registry.Register(core...); err != nil {
+		return nil, sdkerrors.ErrLogic.Wrap(err.Error())
+	}
+	if err := registry.Register(options.Decorators...); err != nil {
+		return nil, sdkerrors.ErrLogic.Wrap(err.Error())
+	}
+	decorators, err := registry.Decorators()
+	if err != nil {
+		return nil, sdkerrors.ErrLogic.Wrap(err.Error())
 	}
 
 	return sdk.ChainAnteDecorators(decorators...), nil
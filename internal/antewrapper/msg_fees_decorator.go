@@ -32,6 +32,17 @@ func NewMsgFeesDecorator(msgFeeKeeper msgfeestypes.MsgFeesKeeper) MsgFeesDecorat
 	}
 }
 
+// NewMsgFeesDecoratorRegistration returns the registration of a MsgFeesDecorator for the ante handler.
+// It runs right after the min gas prices are checked, and before any tx contents are validated.
+func NewMsgFeesDecoratorRegistration(msgFeeKeeper msgfeestypes.MsgFeesKeeper) DecoratorRegistration {
+	return DecoratorRegistration{
+		Name:      AnteMsgFees,
+		Decorator: NewMsgFeesDecorator(msgFeeKeeper),
+		After:     []string{AnteMinGasPrices},
+		Before:    []string{AnteExtensionOptions},
+	}
+}
+
 type MsgFeesDistribution struct {
 	AdditionalModuleFees   sdk.Coins
 	RecipientDistributions map[string]sdk.Coin
@@ -0,0 +1,84 @@
+package antewrapper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+
+	msgfeestypes "github.com/provenance-io/provenance/x/msgfees/types"
+)
+
+// MsgPriorityKeeper defines the msgfees keeper functionality needed to know the priorities of msgs.
+type MsgPriorityKeeper interface {
+	GetMsgPriorities(ctx sdk.Context) []msgfeestypes.MsgPriority
+}
+
+// MsgPriorityDecorator sets the priority of a tx during CheckTx using the msg priorities in the msgfees params.
+// A tx gets the highest priority of its msgs (including those wrapped in an authz MsgExec).
+// The app's mempool uses that priority to decide which txs to include in a block first.
+// Txs without any prioritized msgs keep the priority they already have.
+type MsgPriorityDecorator struct {
+	keeper MsgPriorityKeeper
+}
+
+func NewMsgPriorityDecorator(keeper MsgPriorityKeeper) MsgPriorityDecorator {
+	return MsgPriorityDecorator{keeper: keeper}
+}
+
+// NewMsgPriorityDecoratorRegistration returns the registration of a MsgPriorityDecorator for the ante handler.
+// It runs after the fee is deducted so that a tx only gets its priority once it's known to be paid for.
+func NewMsgPriorityDecoratorRegistration(keeper MsgPriorityKeeper) DecoratorRegistration {
+	return DecoratorRegistration{
+		Name:      AnteMsgPriority,
+		Decorator: NewMsgPriorityDecorator(keeper),
+		After:     []string{AnteDeductFee},
+		Before:    []string{AnteSetPubKey},
+	}
+}
+
+func (d MsgPriorityDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if !ctx.IsCheckTx() {
+		return next(ctx, tx, simulate)
+	}
+	msgPriorities := d.keeper.GetMsgPriorities(ctx)
+	if len(msgPriorities) == 0 {
+		return next(ctx, tx, simulate)
+	}
+
+	priorities := make(map[string]int64, len(msgPriorities))
+	for _, msgPriority := range msgPriorities {
+		priorities[msgPriority.MsgTypeUrl] = msgPriority.Priority
+	}
+	priority, err := getMaxMsgPriority(priorities, tx.GetMsgs())
+	if err != nil {
+		return ctx, err
+	}
+	if priority > ctx.Priority() {
+		ctx = ctx.WithPriority(priority)
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// getMaxMsgPriority returns the highest priority of the provided msgs and the msgs that they execute.
+func getMaxMsgPriority(priorities map[string]int64, msgs []sdk.Msg) (int64, error) {
+	var rv int64
+	for _, msg := range msgs {
+		if priority := priorities[sdk.MsgTypeURL(msg)]; priority > rv {
+			rv = priority
+		}
+		if exec, ok := msg.(*authz.MsgExec); ok {
+			execMsgs, err := exec.GetMessages()
+			if err != nil {
+				return 0, err
+			}
+			priority, err := getMaxMsgPriority(priorities, execMsgs)
+			if err != nil {
+				return 0, err
+			}
+			if priority > rv {
+				rv = priority
+			}
+		}
+	}
+	return rv, nil
+}
@@ -0,0 +1,117 @@
+package antewrapper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/provenance-io/provenance/app"
+	"github.com/provenance-io/provenance/internal/antewrapper"
+	msgfeestypes "github.com/provenance-io/provenance/x/msgfees/types"
+)
+
+func TestMsgPriorityDecorator(t *testing.T) {
+	pioApp := app.Setup(t)
+	ctx := pioApp.BaseApp.NewContext(false)
+	txConfig := pioApp.GetTxConfig()
+
+	sender := sdk.AccAddress("sender______________")
+	coins := sdk.NewCoins(sdk.NewInt64Coin("nhash", 5))
+	send := &banktypes.MsgSend{FromAddress: sender.String(), ToAddress: sender.String(), Amount: coins}
+	multiSend := &banktypes.MsgMultiSend{
+		Inputs:  []banktypes.Input{{Address: sender.String(), Coins: coins}},
+		Outputs: []banktypes.Output{{Address: sender.String(), Coins: coins}},
+	}
+	exec := authz.NewMsgExec(sender, []sdk.Msg{send})
+	priorities := []msgfeestypes.MsgPriority{
+		msgfeestypes.NewMsgPriority(sdk.MsgTypeURL(send), 100),
+		msgfeestypes.NewMsgPriority(sdk.MsgTypeURL(multiSend), 50),
+	}
+
+	newTx := func(msgs ...sdk.Msg) sdk.Tx {
+		builder := txConfig.NewTxBuilder()
+		require.NoError(t, builder.SetMsgs(msgs...), "SetMsgs")
+		return builder.GetTx()
+	}
+
+	tests := []struct {
+		name        string
+		priorities  []msgfeestypes.MsgPriority
+		isCheckTx   bool
+		ctxPriority int64
+		msgs        []sdk.Msg
+		expPriority int64
+	}{
+		{
+			name:        "no priorities",
+			isCheckTx:   true,
+			msgs:        []sdk.Msg{send},
+			expPriority: 0,
+		},
+		{
+			name:        "not check tx",
+			priorities:  priorities,
+			isCheckTx:   false,
+			msgs:        []sdk.Msg{send},
+			expPriority: 0,
+		},
+		{
+			name:        "one prioritized msg",
+			priorities:  priorities,
+			isCheckTx:   true,
+			msgs:        []sdk.Msg{multiSend},
+			expPriority: 50,
+		},
+		{
+			name:        "highest of several msgs",
+			priorities:  priorities,
+			isCheckTx:   true,
+			msgs:        []sdk.Msg{multiSend, send},
+			expPriority: 100,
+		},
+		{
+			name:        "prioritized msg in authz exec",
+			priorities:  priorities,
+			isCheckTx:   true,
+			msgs:        []sdk.Msg{&exec},
+			expPriority: 100,
+		},
+		{
+			name:        "msg without a priority",
+			priorities:  priorities[1:],
+			isCheckTx:   true,
+			ctxPriority: 7,
+			msgs:        []sdk.Msg{send},
+			expPriority: 7,
+		},
+		{
+			name:        "existing priority is higher",
+			priorities:  priorities,
+			isCheckTx:   true,
+			ctxPriority: 500,
+			msgs:        []sdk.Msg{send},
+			expPriority: 500,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			params := pioApp.MsgFeesKeeper.GetParams(ctx)
+			params.MsgPriorities = tc.priorities
+			pioApp.MsgFeesKeeper.SetParams(ctx, params)
+
+			terminator := NewTestTerminator()
+			decorator := antewrapper.NewMsgPriorityDecorator(pioApp.MsgFeesKeeper)
+			testCtx := ctx.WithIsCheckTx(tc.isCheckTx).WithPriority(tc.ctxPriority)
+			_, err := decorator.AnteHandle(testCtx, newTx(tc.msgs...), false, terminator.AnteHandler)
+			require.NoError(t, err, "AnteHandle")
+			require.True(t, terminator.isTerminated, "whether next was called")
+			assert.Equal(t, tc.expPriority, terminator.ctx.Priority(), "priority provided to next")
+		})
+	}
+}
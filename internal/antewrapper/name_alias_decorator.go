@@ -0,0 +1,67 @@
+package antewrapper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	nametypes "github.com/provenance-io/provenance/x/name/types"
+)
+
+// NameKeeper defines the name keeper functionality needed to resolve name aliases.
+type NameKeeper interface {
+	ResolveRecord(ctx sdk.Context, name string) (*nametypes.NameRecord, error)
+}
+
+// resolveNamesTypeURL is the type url of the extension option that opts a tx into name alias resolution.
+var resolveNamesTypeURL = sdk.MsgTypeURL(&nametypes.ExtensionOptionResolveNames{})
+
+// NewResolveNamesExtensionOptionRegistration returns the registration of the ExtensionOptionResolveNames tx extension option.
+func NewResolveNamesExtensionOptionRegistration() ExtensionOptionRegistration {
+	return ExtensionOptionRegistration{Option: &nametypes.ExtensionOptionResolveNames{}}
+}
+
+// NameAliasDecorator replaces "name:" prefixed values in the address fields of a tx's msgs
+// with the addresses that those names resolve to.
+// It only does anything when the tx has the ExtensionOptionResolveNames extension option.
+// CONTRACT: Must come after the extension options decorator since it looks for the option in the context.
+// CONTRACT: Must come after signature verification since the msgs are updated in place.
+type NameAliasDecorator struct {
+	nameKeeper NameKeeper
+}
+
+func NewNameAliasDecorator(nameKeeper NameKeeper) NameAliasDecorator {
+	return NameAliasDecorator{nameKeeper: nameKeeper}
+}
+
+// NewNameAliasDecoratorRegistration returns the registration of a NameAliasDecorator for the ante handler.
+// It runs after signature verification since it alters the msgs, and before the sequence is incremented.
+func NewNameAliasDecoratorRegistration(nameKeeper NameKeeper) DecoratorRegistration {
+	return DecoratorRegistration{
+		Name:      AnteNameAlias,
+		Decorator: NewNameAliasDecorator(nameKeeper),
+		After:     []string{AnteSigVerification},
+		Before:    []string{AnteIncrementSequence},
+	}
+}
+
+func (d NameAliasDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if d.nameKeeper == nil || GetTxExtensionOptions(ctx).Get(resolveNamesTypeURL) == nil {
+		return next(ctx, tx, simulate)
+	}
+
+	resolve := func(name string) (string, error) {
+		record, err := d.nameKeeper.ResolveRecord(ctx, name)
+		if err != nil {
+			return "", err
+		}
+		return record.Address, nil
+	}
+	for i, msg := range tx.GetMsgs() {
+		if err := nametypes.ResolveNameAliases(msg, resolve); err != nil {
+			return ctx, errorsmod.Wrapf(err, "msg %d", i)
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
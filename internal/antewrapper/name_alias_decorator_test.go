@@ -0,0 +1,113 @@
+package antewrapper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/provenance-io/provenance/app"
+	"github.com/provenance-io/provenance/internal/antewrapper"
+	nametypes "github.com/provenance-io/provenance/x/name/types"
+)
+
+func TestNameAliasDecorator(t *testing.T) {
+	pioApp := app.Setup(t)
+	ctx := pioApp.BaseApp.NewContext(false)
+	txConfig := pioApp.GetTxConfig()
+
+	sender := sdk.AccAddress("sender______________")
+	treasury := sdk.AccAddress("treasury____________")
+	require.NoError(t, pioApp.NameKeeper.SetNameRecord(ctx, "treasury.pb", treasury, false), "SetNameRecord")
+
+	resolveNames, err := codectypes.NewAnyWithValue(&nametypes.ExtensionOptionResolveNames{})
+	require.NoError(t, err, "NewAnyWithValue(ExtensionOptionResolveNames)")
+
+	extOpts := antewrapper.NewExtensionOptionRegistry()
+	require.NoError(t, extOpts.Register(antewrapper.NewResolveNamesExtensionOptionRegistration()), "Register")
+	extOptsDecorator := antewrapper.NewExtensionOptionsDecorator(extOpts)
+
+	coins := sdk.NewCoins(sdk.NewInt64Coin("nhash", 5))
+	newTx := func(withOption bool, msgs ...sdk.Msg) sdk.Tx {
+		builder := txConfig.NewTxBuilder()
+		require.NoError(t, builder.SetMsgs(msgs...), "SetMsgs")
+		if withOption {
+			builder.(authtx.ExtensionOptionsTxBuilder).SetExtensionOptions(resolveNames)
+		}
+		return builder.GetTx()
+	}
+
+	tests := []struct {
+		name     string
+		withOpt  bool
+		msg      sdk.Msg
+		expMsg   sdk.Msg
+		expInErr []string
+	}{
+		{
+			name:    "without option: alias not resolved",
+			withOpt: false,
+			msg:     &banktypes.MsgSend{FromAddress: sender.String(), ToAddress: "name:treasury.pb", Amount: coins},
+			expMsg:  &banktypes.MsgSend{FromAddress: sender.String(), ToAddress: "name:treasury.pb", Amount: coins},
+		},
+		{
+			name:    "with option: alias resolved",
+			withOpt: true,
+			msg:     &banktypes.MsgSend{FromAddress: sender.String(), ToAddress: "name:treasury.pb", Amount: coins},
+			expMsg:  &banktypes.MsgSend{FromAddress: sender.String(), ToAddress: treasury.String(), Amount: coins},
+		},
+		{
+			name:    "with option: normal address unchanged",
+			withOpt: true,
+			msg:     &banktypes.MsgSend{FromAddress: sender.String(), ToAddress: treasury.String(), Amount: coins},
+			expMsg:  &banktypes.MsgSend{FromAddress: sender.String(), ToAddress: treasury.String(), Amount: coins},
+		},
+		{
+			name:    "with option: nested address resolved",
+			withOpt: true,
+			msg: &banktypes.MsgMultiSend{
+				Inputs:  []banktypes.Input{{Address: sender.String(), Coins: coins}},
+				Outputs: []banktypes.Output{{Address: "name:treasury.pb", Coins: coins}},
+			},
+			expMsg: &banktypes.MsgMultiSend{
+				Inputs:  []banktypes.Input{{Address: sender.String(), Coins: coins}},
+				Outputs: []banktypes.Output{{Address: treasury.String(), Coins: coins}},
+			},
+		},
+		{
+			name:     "with option: unbound name",
+			withOpt:  true,
+			msg:      &banktypes.MsgSend{FromAddress: sender.String(), ToAddress: "name:nope.pb", Amount: coins},
+			expInErr: []string{"msg 0", `could not resolve to_address "name:nope.pb"`, "no address bound to name"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			theTx := newTx(tc.withOpt, tc.msg)
+			terminator := NewTestTerminator()
+			decorator := antewrapper.NewNameAliasDecorator(pioApp.NameKeeper)
+			// The name alias decorator gets the extension options from the context, so they're parsed first.
+			_, err := extOptsDecorator.AnteHandle(ctx, theTx, false, func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+				return decorator.AnteHandle(ctx, tx, simulate, terminator.AnteHandler)
+			})
+			if len(tc.expInErr) > 0 {
+				require.Error(t, err, "AnteHandle")
+				for _, exp := range tc.expInErr {
+					assert.ErrorContains(t, err, exp, "AnteHandle error")
+				}
+				assert.ErrorIs(t, err, nametypes.ErrNameNotBound, "AnteHandle error")
+				assert.False(t, terminator.isTerminated, "whether next was called")
+				return
+			}
+			require.NoError(t, err, "AnteHandle")
+			assert.True(t, terminator.isTerminated, "whether next was called")
+			assert.Equal(t, []sdk.Msg{tc.expMsg}, theTx.GetMsgs(), "tx msgs after AnteHandle")
+		})
+	}
+}
@@ -0,0 +1,81 @@
+package antewrapper
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+
+	nametypes "github.com/provenance-io/provenance/x/name/types"
+)
+
+// NameBindFilterKeeper defines the name keeper functionality needed to know which names are already bound.
+type NameBindFilterKeeper interface {
+	NameExists(ctx sdk.Context, name string) bool
+}
+
+// NameBindFilterDecorator rejects txs during CheckTx that try to bind a name that's already bound.
+// Such txs would fail anyway, so this keeps them out of the mempool before any fees are charged for them.
+// Msgs wrapped in an authz MsgExec are also checked. A name deleted by an earlier msg in the same tx is not treated as
+// bound, so it can be rebound. It does nothing outside of CheckTx (and ReCheckTx).
+type NameBindFilterDecorator struct {
+	keeper NameBindFilterKeeper
+}
+
+func NewNameBindFilterDecorator(keeper NameBindFilterKeeper) NameBindFilterDecorator {
+	return NameBindFilterDecorator{keeper: keeper}
+}
+
+// NewNameBindFilterDecoratorRegistration returns the registration of a NameBindFilterDecorator for the ante handler.
+// It runs after the msgs are validated, and before the fee is deducted.
+func NewNameBindFilterDecoratorRegistration(keeper NameBindFilterKeeper) DecoratorRegistration {
+	return DecoratorRegistration{
+		Name:      AnteNameBindFilter,
+		Decorator: NewNameBindFilterDecorator(keeper),
+		After:     []string{AnteValidateBasic},
+		Before:    []string{AnteDeductFee},
+	}
+}
+
+func (d NameBindFilterDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if d.keeper == nil || !ctx.IsCheckTx() {
+		return next(ctx, tx, simulate)
+	}
+
+	deleted := make(map[string]bool)
+	for i, msg := range tx.GetMsgs() {
+		if err := d.checkNoBoundNames(ctx, msg, deleted); err != nil {
+			return ctx, errorsmod.Wrapf(err, "msg %d", i)
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// checkNoBoundNames returns an error if the provided msg, or any msg that it executes, binds a name that's already bound.
+// The deleted names are those deleted by earlier msgs; names deleted by this msg are added to it.
+func (d NameBindFilterDecorator) checkNoBoundNames(ctx sdk.Context, msg sdk.Msg, deleted map[string]bool) error {
+	switch m := msg.(type) {
+	case *nametypes.MsgBindNameRequest:
+		name := nametypes.NormalizeName(fmt.Sprintf("%s.%s", m.Record.Name, m.Parent.Name))
+		if !deleted[name] && d.keeper.NameExists(ctx, name) {
+			return nametypes.ErrNameAlreadyBound.Wrapf("%q", name)
+		}
+		delete(deleted, name)
+	case *nametypes.MsgDeleteNameRequest:
+		deleted[nametypes.NormalizeName(m.Record.Name)] = true
+	case *authz.MsgExec:
+		execMsgs, err := m.GetMessages()
+		if err != nil {
+			return err
+		}
+		for _, execMsg := range execMsgs {
+			if err = d.checkNoBoundNames(ctx, execMsg, deleted); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
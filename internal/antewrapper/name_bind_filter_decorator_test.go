@@ -0,0 +1,125 @@
+package antewrapper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/provenance-io/provenance/app"
+	"github.com/provenance-io/provenance/internal/antewrapper"
+	nametypes "github.com/provenance-io/provenance/x/name/types"
+)
+
+func TestNameBindFilterDecorator(t *testing.T) {
+	pioApp := app.Setup(t)
+	ctx := pioApp.BaseApp.NewContext(true)
+	txConfig := pioApp.GetTxConfig()
+
+	sender := sdk.AccAddress("sender______________")
+	require.NoError(t, pioApp.NameKeeper.SetNameRecord(ctx, "taken.pb", sender, false), "SetNameRecord")
+
+	parent := nametypes.NewNameRecord("pb", sender, false)
+	bindTaken := nametypes.NewMsgBindNameRequest(nametypes.NewNameRecord("taken", sender, false), parent)
+	bindTakenUpper := nametypes.NewMsgBindNameRequest(nametypes.NewNameRecord(" Taken ", sender, false), parent)
+	bindFree := nametypes.NewMsgBindNameRequest(nametypes.NewNameRecord("free", sender, false), parent)
+	send := &banktypes.MsgSend{FromAddress: sender.String(), ToAddress: sender.String(), Amount: sdk.NewCoins(sdk.NewInt64Coin("nhash", 5))}
+	deleteTaken := nametypes.NewMsgDeleteNameRequest(nametypes.NewNameRecord(" Taken.PB ", sender, false))
+	execTaken := authz.NewMsgExec(sender, []sdk.Msg{bindTaken})
+	execDeleteTaken := authz.NewMsgExec(sender, []sdk.Msg{deleteTaken})
+	execFree := authz.NewMsgExec(sender, []sdk.Msg{bindFree})
+
+	newTx := func(msgs ...sdk.Msg) sdk.Tx {
+		builder := txConfig.NewTxBuilder()
+		require.NoError(t, builder.SetMsgs(msgs...), "SetMsgs")
+		return builder.GetTx()
+	}
+
+	tests := []struct {
+		name    string
+		checkTx bool
+		msgs    []sdk.Msg
+		expErr  string
+	}{
+		{
+			name:    "no bind msgs",
+			checkTx: true,
+			msgs:    []sdk.Msg{send},
+		},
+		{
+			name:    "unbound name",
+			checkTx: true,
+			msgs:    []sdk.Msg{bindFree},
+		},
+		{
+			name:    "bound name",
+			checkTx: true,
+			msgs:    []sdk.Msg{send, bindTaken},
+			expErr:  `msg 1: "taken.pb": name is already bound to an address`,
+		},
+		{
+			name:    "bound name that needs normalizing",
+			checkTx: true,
+			msgs:    []sdk.Msg{bindTakenUpper},
+			expErr:  `msg 0: "taken.pb": name is already bound to an address`,
+		},
+		{
+			name:    "unbound name in authz exec",
+			checkTx: true,
+			msgs:    []sdk.Msg{&execFree},
+		},
+		{
+			name:    "bound name in authz exec",
+			checkTx: true,
+			msgs:    []sdk.Msg{bindFree, &execTaken},
+			expErr:  `msg 1: "taken.pb": name is already bound to an address`,
+		},
+		{
+			name:    "bound name deleted earlier in the tx",
+			checkTx: true,
+			msgs:    []sdk.Msg{deleteTaken, bindTaken},
+		},
+		{
+			name:    "bound name deleted earlier in an authz exec",
+			checkTx: true,
+			msgs:    []sdk.Msg{&execDeleteTaken, &execTaken},
+		},
+		{
+			name:    "bound name deleted later in the tx",
+			checkTx: true,
+			msgs:    []sdk.Msg{bindTaken, deleteTaken},
+			expErr:  `msg 0: "taken.pb": name is already bound to an address`,
+		},
+		{
+			name:    "bound name deleted and then bound twice",
+			checkTx: true,
+			msgs:    []sdk.Msg{deleteTaken, bindTaken, bindTakenUpper},
+			expErr:  `msg 2: "taken.pb": name is already bound to an address`,
+		},
+		{
+			name:    "bound name outside of check tx",
+			checkTx: false,
+			msgs:    []sdk.Msg{bindTaken},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			terminator := NewTestTerminator()
+			decorator := antewrapper.NewNameBindFilterDecorator(pioApp.NameKeeper)
+			_, err := decorator.AnteHandle(ctx.WithIsCheckTx(tc.checkTx), newTx(tc.msgs...), false, terminator.AnteHandler)
+			if len(tc.expErr) > 0 {
+				assert.EqualError(t, err, tc.expErr, "AnteHandle error")
+				assert.ErrorIs(t, err, nametypes.ErrNameAlreadyBound, "AnteHandle error")
+				assert.False(t, terminator.isTerminated, "whether next was called")
+				return
+			}
+			assert.NoError(t, err, "AnteHandle error")
+			assert.True(t, terminator.isTerminated, "whether next was called")
+		})
+	}
+}
@@ -0,0 +1,159 @@
+package antewrapper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// The names of the decorators in the ante handler.
+const (
+	AnteSetUpContext        = "set-up-context"
+	AnteCircuitBreaker      = "circuit-breaker"
+	AnteMsgPriority         = "msg-priority"
+	AnteFeeMeterContext     = "fee-meter-context"
+	AnteTxGasLimit          = "tx-gas-limit"
+	AnteMinGasPrices        = "min-gas-prices"
+	AnteMsgFees             = "msg-fees"
+	AnteExtensionOptions    = "extension-options"
+	AnteValidateBasic       = "validate-basic"
+	AnteNameBindFilter      = "name-bind-filter"
+	AnteTxTimeoutHeight     = "tx-timeout-height"
+	AnteValidateMemo        = "validate-memo"
+	AnteConsumeGasForTxSize = "consume-gas-for-tx-size"
+	AnteDeductFee           = "deduct-fee"
+	AnteSetPubKey           = "set-pub-key"
+	AnteValidateSigCount    = "validate-sig-count"
+	AnteSigGasConsume       = "sig-gas-consume"
+	AnteSigVerification     = "sig-verification"
+	AnteNameAlias           = "name-alias"
+	AnteIncrementSequence   = "increment-sequence"
+)
+
+// DecoratorRegistration is an ante decorator along with the constraints on where it goes in the ante handler.
+type DecoratorRegistration struct {
+	// Name uniquely identifies the decorator so that other decorators can be ordered relative to it.
+	Name string
+	// Decorator is the ante decorator to use.
+	Decorator sdk.AnteDecorator
+	// After is the names of the decorators that must run before this one.
+	After []string
+	// Before is the names of the decorators that must run after this one.
+	Before []string
+}
+
+// HasAnteDecorators is implemented by modules that provide decorators for the ante handler.
+type HasAnteDecorators interface {
+	AnteDecorators() []DecoratorRegistration
+}
+
+// DecoratorRegistry collects ante decorators and orders them according to their constraints.
+type DecoratorRegistry struct {
+	registrations []DecoratorRegistration
+	indexes       map[string]int
+}
+
+// NewDecoratorRegistry creates a new, empty DecoratorRegistry.
+func NewDecoratorRegistry() *DecoratorRegistry {
+	return &DecoratorRegistry{indexes: make(map[string]int)}
+}
+
+// Register adds decorators to this registry.
+// An error is returned if a decorator doesn't have a name or decorator, or its name is already registered.
+func (r *DecoratorRegistry) Register(regs ...DecoratorRegistration) error {
+	for _, reg := range regs {
+		if len(reg.Name) == 0 {
+			return fmt.Errorf("ante decorator name cannot be empty")
+		}
+		if reg.Decorator == nil {
+			return fmt.Errorf("ante decorator %q cannot be nil", reg.Name)
+		}
+		if _, found := r.indexes[reg.Name]; found {
+			return fmt.Errorf("ante decorator %q is already registered", reg.Name)
+		}
+		r.indexes[reg.Name] = len(r.registrations)
+		r.registrations = append(r.registrations, reg)
+	}
+	return nil
+}
+
+// Names returns the names of the registered decorators in the order they will be run.
+func (r *DecoratorRegistry) Names() ([]string, error) {
+	order, err := r.order()
+	if err != nil {
+		return nil, err
+	}
+	rv := make([]string, len(order))
+	for i, index := range order {
+		rv[i] = r.registrations[index].Name
+	}
+	return rv, nil
+}
+
+// Decorators returns the registered decorators in the order they should be run.
+// Decorators are kept in the order they were registered unless their constraints require otherwise.
+// An error is returned if a constraint refers to an unknown decorator, or the constraints cannot all be satisfied.
+func (r *DecoratorRegistry) Decorators() ([]sdk.AnteDecorator, error) {
+	order, err := r.order()
+	if err != nil {
+		return nil, err
+	}
+	rv := make([]sdk.AnteDecorator, len(order))
+	for i, index := range order {
+		rv[i] = r.registrations[index].Decorator
+	}
+	return rv, nil
+}
+
+// order returns the indexes of the registrations in the order that the decorators should be run.
+func (r *DecoratorRegistry) order() ([]int, error) {
+	// runsAfter[i] is the indexes of the decorators that must run after decorator i.
+	runsAfter := make([][]int, len(r.registrations))
+	// waitingOn[i] is the number of decorators that must run before decorator i.
+	waitingOn := make([]int, len(r.registrations))
+	for i, reg := range r.registrations {
+		for _, name := range reg.After {
+			j, found := r.indexes[name]
+			if !found {
+				return nil, fmt.Errorf("ante decorator %q must come after unknown decorator %q", reg.Name, name)
+			}
+			runsAfter[j] = append(runsAfter[j], i)
+			waitingOn[i]++
+		}
+		for _, name := range reg.Before {
+			j, found := r.indexes[name]
+			if !found {
+				return nil, fmt.Errorf("ante decorator %q must come before unknown decorator %q", reg.Name, name)
+			}
+			runsAfter[i] = append(runsAfter[i], j)
+			waitingOn[j]++
+		}
+	}
+
+	rv := make([]int, 0, len(r.registrations))
+	used := make([]bool, len(r.registrations))
+	for len(rv) < len(r.registrations) {
+		next := -1
+		for i := range r.registrations {
+			if !used[i] && waitingOn[i] == 0 {
+				next = i
+				break
+			}
+		}
+		if next < 0 {
+			var stuck []string
+			for i, reg := range r.registrations {
+				if !used[i] {
+					stuck = append(stuck, reg.Name)
+				}
+			}
+			return nil, fmt.Errorf("ante decorator ordering constraints cannot be satisfied for: %q", stuck)
+		}
+		used[next] = true
+		rv = append(rv, next)
+		for _, j := range runsAfter[next] {
+			waitingOn[j]--
+		}
+	}
+	return rv, nil
+}
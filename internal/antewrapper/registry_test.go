@@ -0,0 +1,113 @@
+package antewrapper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/internal/antewrapper"
+)
+
+// noOpDecorator is an ante decorator that just calls the next ante handler.
+type noOpDecorator struct{}
+
+func (noOpDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	return next(ctx, tx, simulate)
+}
+
+func TestDecoratorRegistry(t *testing.T) {
+	reg := func(name string, after, before []string) antewrapper.DecoratorRegistration {
+		return antewrapper.DecoratorRegistration{Name: name, Decorator: noOpDecorator{}, After: after, Before: before}
+	}
+	chain := []antewrapper.DecoratorRegistration{
+		reg("a", nil, nil),
+		reg("b", []string{"a"}, nil),
+		reg("c", []string{"b"}, nil),
+	}
+
+	tests := []struct {
+		name      string
+		regs      []antewrapper.DecoratorRegistration
+		expRegErr string
+		expErr    string
+		expNames  []string
+	}{
+		{
+			name:     "no constraints keeps registration order",
+			regs:     []antewrapper.DecoratorRegistration{reg("x", nil, nil), reg("a", nil, nil), reg("m", nil, nil)},
+			expNames: []string{"x", "a", "m"},
+		},
+		{
+			name:     "after and before place a decorator in the middle of a chain",
+			regs:     append(append([]antewrapper.DecoratorRegistration{}, chain...), reg("z", []string{"a"}, []string{"b"})),
+			expNames: []string{"a", "z", "b", "c"},
+		},
+		{
+			name:     "only after",
+			regs:     append([]antewrapper.DecoratorRegistration{reg("z", []string{"c"}, nil)}, chain...),
+			expNames: []string{"a", "b", "c", "z"},
+		},
+		{
+			name:     "only before",
+			regs:     append(append([]antewrapper.DecoratorRegistration{}, chain...), reg("z", nil, []string{"a"})),
+			expNames: []string{"z", "a", "b", "c"},
+		},
+		{
+			name:      "duplicate name",
+			regs:      append(append([]antewrapper.DecoratorRegistration{}, chain...), reg("b", nil, nil)),
+			expRegErr: `ante decorator "b" is already registered`,
+		},
+		{
+			name:      "empty name",
+			regs:      []antewrapper.DecoratorRegistration{reg("", nil, nil)},
+			expRegErr: "ante decorator name cannot be empty",
+		},
+		{
+			name:      "nil decorator",
+			regs:      []antewrapper.DecoratorRegistration{{Name: "a"}},
+			expRegErr: `ante decorator "a" cannot be nil`,
+		},
+		{
+			name:   "unknown after",
+			regs:   append(append([]antewrapper.DecoratorRegistration{}, chain...), reg("z", []string{"q"}, nil)),
+			expErr: `ante decorator "z" must come after unknown decorator "q"`,
+		},
+		{
+			name:   "unknown before",
+			regs:   append(append([]antewrapper.DecoratorRegistration{}, chain...), reg("z", nil, []string{"q"})),
+			expErr: `ante decorator "z" must come before unknown decorator "q"`,
+		},
+		{
+			name:   "unsatisfiable constraints",
+			regs:   append(append([]antewrapper.DecoratorRegistration{}, chain...), reg("z", []string{"c"}, []string{"b"})),
+			expErr: `ante decorator ordering constraints cannot be satisfied for: ["b" "c" "z"]`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			registry := antewrapper.NewDecoratorRegistry()
+			err := registry.Register(tc.regs...)
+			if len(tc.expRegErr) > 0 {
+				require.EqualError(t, err, tc.expRegErr, "Register")
+				return
+			}
+			require.NoError(t, err, "Register")
+
+			names, err := registry.Names()
+			decorators, decErr := registry.Decorators()
+			if len(tc.expErr) > 0 {
+				assert.EqualError(t, err, tc.expErr, "Names")
+				assert.EqualError(t, decErr, tc.expErr, "Decorators")
+				return
+			}
+			require.NoError(t, err, "Names")
+			require.NoError(t, decErr, "Decorators")
+			assert.Equal(t, tc.expNames, names, "Names")
+			assert.Len(t, decorators, len(tc.expNames), "Decorators")
+		})
+	}
+}
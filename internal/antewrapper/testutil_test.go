@@ -85,6 +85,14 @@ func (s *AnteTestSuite) SetupTest(isCheckTx bool) {
 			SigGasConsumer:      ante.DefaultSigVerificationGasConsumer,
 			MsgFeesKeeper:       s.app.MsgFeesKeeper,
 			CircuitKeeper:       &s.app.CircuitKeeper,
+			Decorators: []antewrapper.DecoratorRegistration{
+				antewrapper.NewMsgFeesDecoratorRegistration(s.app.MsgFeesKeeper),
+				antewrapper.NewMsgPriorityDecoratorRegistration(s.app.MsgFeesKeeper),
+				antewrapper.NewNameAliasDecoratorRegistration(s.app.NameKeeper),
+			},
+			ExtensionOptions: []antewrapper.ExtensionOptionRegistration{
+				antewrapper.NewResolveNamesExtensionOptionRegistration(),
+			},
 		},
 	)
 
@@ -0,0 +1,122 @@
+// Package bech32util has utilities for working with the human-readable parts (HRPs) of bech32 account addresses.
+//
+// The chain's own account HRP comes from the sdk config (e.g. "pb" for mainnet or "tp" for testnet).
+// Additional HRPs can be configured so that addresses from other networks can be recognized and
+// converted, but those are never accepted as valid account addresses for this chain.
+package bech32util
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+)
+
+// maxHRPLen is the maximum length of a bech32 human-readable part.
+const maxHRPLen = 83
+
+// additionalHRPs are the configured HRPs (other than the chain's own) that addresses can be converted to or from.
+var additionalHRPs []string
+
+// SetAdditionalHRPs sets the HRPs (other than the chain's own) that addresses can be converted to or from.
+// Duplicate entries are ignored. Providing no HRPs clears the list.
+func SetAdditionalHRPs(hrps ...string) error {
+	var rv []string
+	for _, hrp := range hrps {
+		if err := ValidateHRP(hrp); err != nil {
+			return err
+		}
+		if !slices.Contains(rv, hrp) {
+			rv = append(rv, hrp)
+		}
+	}
+	additionalHRPs = rv
+	return nil
+}
+
+// GetAdditionalHRPs returns a copy of the configured additional HRPs.
+func GetAdditionalHRPs() []string {
+	return slices.Clone(additionalHRPs)
+}
+
+// GetAccountHRP returns the HRP used for account addresses on this chain.
+func GetAccountHRP() string {
+	return sdk.GetConfig().GetBech32AccountAddrPrefix()
+}
+
+// GetKnownHRPs returns the chain's account HRP followed by any additional HRPs (that are different from it).
+func GetKnownHRPs() []string {
+	accHRP := GetAccountHRP()
+	rv := []string{accHRP}
+	for _, hrp := range additionalHRPs {
+		if hrp != accHRP {
+			rv = append(rv, hrp)
+		}
+	}
+	return rv
+}
+
+// IsKnownHRP returns true if the provided hrp is either the chain's account HRP or one of the additional HRPs.
+func IsKnownHRP(hrp string) bool {
+	return slices.Contains(GetKnownHRPs(), hrp)
+}
+
+// ValidateHRP returns an error if the provided string cannot be used as a bech32 human-readable part.
+// HRPs are also required to be lowercase so that there's only one way to write each address.
+func ValidateHRP(hrp string) error {
+	if len(hrp) == 0 {
+		return errors.New("hrp cannot be empty")
+	}
+	if len(hrp) > maxHRPLen {
+		return fmt.Errorf("hrp %q length %d exceeds max length %d", hrp, len(hrp), maxHRPLen)
+	}
+	for _, c := range hrp {
+		if c < 33 || c > 126 {
+			return fmt.Errorf("hrp %q contains invalid character %q", hrp, c)
+		}
+	}
+	if hrp != strings.ToLower(hrp) {
+		return fmt.Errorf("hrp %q must be lowercase", hrp)
+	}
+	return nil
+}
+
+// ConvertHRP decodes the provided bech32 address and re-encodes it using the provided hrp.
+// The address must use one of the known HRPs, and the hrp must also be a known HRP.
+func ConvertHRP(addr, hrp string) (string, error) {
+	if !IsKnownHRP(hrp) {
+		return "", fmt.Errorf("unknown hrp %q, known: %s", hrp, strings.Join(GetKnownHRPs(), ", "))
+	}
+	addrHRP, bz, err := bech32.DecodeAndConvert(addr)
+	if err != nil {
+		return "", err
+	}
+	if !IsKnownHRP(addrHRP) {
+		return "", fmt.Errorf("address %q has unknown hrp %q, known: %s", addr, addrHRP, strings.Join(GetKnownHRPs(), ", "))
+	}
+	if err = sdk.VerifyAddressFormat(bz); err != nil {
+		return "", err
+	}
+	return bech32.ConvertAndEncode(hrp, bz)
+}
+
+// ValidateAccAddress is a strict version of sdk.AccAddressFromBech32.
+// In addition to the standard checks, the address must be in its canonical (lowercase) form,
+// and addresses that use one of the additional HRPs get an error that identifies the mix-up.
+func ValidateAccAddress(addr string) (sdk.AccAddress, error) {
+	accHRP := GetAccountHRP()
+	if addrHRP, _, err := bech32.DecodeAndConvert(addr); err == nil && addrHRP != accHRP && IsKnownHRP(addrHRP) {
+		return nil, fmt.Errorf("address %q has the %q hrp but this chain uses %q", addr, addrHRP, accHRP)
+	}
+	rv, err := sdk.AccAddressFromBech32(addr)
+	if err != nil {
+		return nil, err
+	}
+	if canonical := rv.String(); canonical != addr {
+		return nil, fmt.Errorf("address %q is not in canonical form, expected %q", addr, canonical)
+	}
+	return rv, nil
+}
@@ -0,0 +1,147 @@
+package bech32util
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+)
+
+// withAdditionalHRPs sets the additional HRPs for the duration of a test.
+func withAdditionalHRPs(t *testing.T, hrps ...string) {
+	orig := additionalHRPs
+	t.Cleanup(func() {
+		additionalHRPs = orig
+	})
+	additionalHRPs = hrps
+}
+
+// encode creates a bech32 string from the provided hrp and bytes.
+func encode(t *testing.T, hrp string, bz []byte) string {
+	rv, err := bech32.ConvertAndEncode(hrp, bz)
+	require.NoError(t, err, "ConvertAndEncode(%q, %v)", hrp, bz)
+	return rv
+}
+
+func TestSetAdditionalHRPs(t *testing.T) {
+	tests := []struct {
+		name   string
+		hrps   []string
+		exp    []string
+		expErr string
+	}{
+		{name: "nil", hrps: nil, exp: nil},
+		{name: "one", hrps: []string{"tp"}, exp: []string{"tp"}},
+		{name: "two", hrps: []string{"tp", "pb"}, exp: []string{"tp", "pb"}},
+		{name: "duplicates", hrps: []string{"tp", "pb", "tp"}, exp: []string{"tp", "pb"}},
+		{name: "empty entry", hrps: []string{"tp", ""}, expErr: "hrp cannot be empty"},
+		{name: "uppercase", hrps: []string{"TP"}, expErr: "hrp \"TP\" must be lowercase"},
+		{name: "space", hrps: []string{"t p"}, expErr: "hrp \"t p\" contains invalid character ' '"},
+		{name: "too long", hrps: []string{strings.Repeat("p", 84)}, expErr: "length 84 exceeds max length 83"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			withAdditionalHRPs(t, "orig")
+			err := SetAdditionalHRPs(tc.hrps...)
+			if len(tc.expErr) > 0 {
+				require.ErrorContains(t, err, tc.expErr, "SetAdditionalHRPs error")
+				assert.Equal(t, []string{"orig"}, GetAdditionalHRPs(), "GetAdditionalHRPs after failed set")
+				return
+			}
+			require.NoError(t, err, "SetAdditionalHRPs error")
+			assert.Equal(t, tc.exp, GetAdditionalHRPs(), "GetAdditionalHRPs")
+		})
+	}
+}
+
+func TestGetKnownHRPs(t *testing.T) {
+	accHRP := GetAccountHRP()
+	withAdditionalHRPs(t, "tp", accHRP, "pb")
+	assert.Equal(t, []string{accHRP, "tp", "pb"}, GetKnownHRPs(), "GetKnownHRPs")
+	assert.True(t, IsKnownHRP(accHRP), "IsKnownHRP(%q)", accHRP)
+	assert.True(t, IsKnownHRP("tp"), "IsKnownHRP(%q)", "tp")
+	assert.False(t, IsKnownHRP("cosmosvaloper"), "IsKnownHRP(%q)", "cosmosvaloper")
+}
+
+func TestConvertHRP(t *testing.T) {
+	accHRP := GetAccountHRP()
+	addrBz := sdk.AccAddress("addrBz______________")
+	withAdditionalHRPs(t, "tp", "pb")
+
+	tests := []struct {
+		name   string
+		addr   string
+		hrp    string
+		exp    string
+		expErr string
+	}{
+		{name: "chain to additional", addr: encode(t, accHRP, addrBz), hrp: "tp", exp: encode(t, "tp", addrBz)},
+		{name: "additional to chain", addr: encode(t, "pb", addrBz), hrp: accHRP, exp: encode(t, accHRP, addrBz)},
+		{name: "additional to additional", addr: encode(t, "pb", addrBz), hrp: "tp", exp: encode(t, "tp", addrBz)},
+		{name: "same hrp", addr: encode(t, "tp", addrBz), hrp: "tp", exp: encode(t, "tp", addrBz)},
+		{
+			name:   "unknown target hrp",
+			addr:   encode(t, "tp", addrBz),
+			hrp:    "other",
+			expErr: "unknown hrp \"other\", known: " + accHRP + ", tp, pb",
+		},
+		{
+			name:   "unknown address hrp",
+			addr:   encode(t, "other", addrBz),
+			hrp:    "tp",
+			expErr: "has unknown hrp \"other\"",
+		},
+		{name: "not bech32", addr: "notbech32", hrp: "tp", expErr: "decoding bech32 failed"},
+		{name: "bad length", addr: encode(t, "tp", make([]byte, 256)), hrp: "pb", expErr: "address max length is 255"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			actual, err := ConvertHRP(tc.addr, tc.hrp)
+			if len(tc.expErr) > 0 {
+				require.ErrorContains(t, err, tc.expErr, "ConvertHRP error")
+			} else {
+				require.NoError(t, err, "ConvertHRP error")
+			}
+			assert.Equal(t, tc.exp, actual, "ConvertHRP result")
+		})
+	}
+}
+
+func TestValidateAccAddress(t *testing.T) {
+	accHRP := GetAccountHRP()
+	addrBz := sdk.AccAddress("addrBz______________")
+	addr := encode(t, accHRP, addrBz)
+	withAdditionalHRPs(t, "tp")
+
+	tests := []struct {
+		name   string
+		addr   string
+		expErr string
+	}{
+		{name: "valid", addr: addr},
+		{name: "empty", addr: "", expErr: "empty address string is not allowed"},
+		{name: "uppercase", addr: strings.ToUpper(addr), expErr: "is not in canonical form, expected \"" + addr + "\""},
+		{name: "additional hrp", addr: encode(t, "tp", addrBz), expErr: "has the \"tp\" hrp but this chain uses \"" + accHRP + "\""},
+		{name: "unknown hrp", addr: encode(t, "other", addrBz), expErr: "invalid Bech32 prefix; expected " + accHRP + ", got other"},
+		{name: "not bech32", addr: "notbech32", expErr: "decoding bech32 failed"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			actual, err := ValidateAccAddress(tc.addr)
+			if len(tc.expErr) > 0 {
+				require.ErrorContains(t, err, tc.expErr, "ValidateAccAddress error")
+				assert.Nil(t, actual, "ValidateAccAddress result")
+				return
+			}
+			require.NoError(t, err, "ValidateAccAddress error")
+			assert.Equal(t, addrBz, actual, "ValidateAccAddress result")
+		})
+	}
+}
@@ -0,0 +1,177 @@
+// Package errcodes is the registry of the errors registered by the provenance modules.
+//
+// Each registered error has a codespace and a numeric code that are stable across releases.
+// They are reported in the codespace and code fields of ABCI results, and in an ErrorInfo detail of gRPC statuses,
+// so that clients can branch on them instead of parsing error messages.
+package errcodes
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+
+	ibchookstypes "github.com/provenance-io/provenance/x/ibchooks/types"
+	"github.com/provenance-io/provenance/x/ibcratelimit"
+	markertypes "github.com/provenance-io/provenance/x/marker/types"
+	metadatatypes "github.com/provenance-io/provenance/x/metadata/types"
+	msgfeestypes "github.com/provenance-io/provenance/x/msgfees/types"
+	nametypes "github.com/provenance-io/provenance/x/name/types"
+	oracletypes "github.com/provenance-io/provenance/x/oracle/types"
+	quarantineerrs "github.com/provenance-io/provenance/x/quarantine/errors"
+	sanctionerrs "github.com/provenance-io/provenance/x/sanction/errors"
+	triggertypes "github.com/provenance-io/provenance/x/trigger/types"
+	wasmtypes "github.com/provenance-io/provenance/x/wasm/types"
+)
+
+// Code is an error registered by one of the provenance modules.
+type Code struct {
+	// Name is the name of the variable holding the error, e.g. "ErrNameAlreadyBound".
+	Name string
+	// Err is the registered error.
+	Err *errorsmod.Error
+}
+
+// Codespace returns the codespace that the error was registered in.
+func (c Code) Codespace() string {
+	return c.Err.Codespace()
+}
+
+// ABCICode returns the numeric code of the error in its codespace.
+func (c Code) ABCICode() uint32 {
+	return c.Err.ABCICode()
+}
+
+// codes are all of the errors registered by the provenance modules.
+// Once an error is released, its codespace and code must never change, and they must not be reused.
+var codes = []Code{
+	{Name: "ErrMsgValidation", Err: ibchookstypes.ErrMsgValidation},
+	{Name: "ErrMarshaling", Err: ibchookstypes.ErrMarshaling},
+	{Name: "ErrInvalidPacket", Err: ibchookstypes.ErrInvalidPacket},
+	{Name: "ErrBadResponse", Err: ibchookstypes.ErrBadResponse},
+	{Name: "ErrWasmError", Err: ibchookstypes.ErrWasmError},
+	{Name: "ErrBadSender", Err: ibchookstypes.ErrBadSender},
+	{Name: "ErrAckFromContract", Err: ibchookstypes.ErrAckFromContract},
+	{Name: "ErrAsyncAckNotAllowed", Err: ibchookstypes.ErrAsyncAckNotAllowed},
+	{Name: "ErrAckPacketMismatch", Err: ibchookstypes.ErrAckPacketMismatch},
+	{Name: "ErrInvalidContractAddr", Err: ibchookstypes.ErrInvalidContractAddr},
+	{Name: "ErrMarkerError", Err: ibchookstypes.ErrMarkerError},
+
+	{Name: "ErrRateLimitExceeded", Err: ibcratelimit.ErrRateLimitExceeded},
+	{Name: "ErrBadMessage", Err: ibcratelimit.ErrBadMessage},
+	{Name: "ErrContractError", Err: ibcratelimit.ErrContractError},
+
+	{Name: "ErrEmptyAccessGrantAddress", Err: markertypes.ErrEmptyAccessGrantAddress},
+	{Name: "ErrAccessTypeInvalid", Err: markertypes.ErrAccessTypeInvalid},
+	{Name: "ErrDuplicateAccessEntry", Err: markertypes.ErrDuplicateAccessEntry},
+	{Name: "ErrInvalidMarkerStatus", Err: markertypes.ErrInvalidMarkerStatus},
+	{Name: "ErrAccessTypeNotGranted", Err: markertypes.ErrAccessTypeNotGranted},
+	{Name: "ErrMarkerNotFound", Err: markertypes.ErrMarkerNotFound},
+	{Name: "ErrDuplicateEntry", Err: markertypes.ErrDuplicateEntry},
+	{Name: "ErrUnknownIBCMemoAction", Err: markertypes.ErrUnknownIBCMemoAction},
+
+	{Name: "ErrOSLocatorAlreadyBound", Err: metadatatypes.ErrOSLocatorAlreadyBound},
+	{Name: "ErrInvalidAddress", Err: metadatatypes.ErrInvalidAddress},
+	{Name: "ErrAddressNotBound", Err: metadatatypes.ErrAddressNotBound},
+	{Name: "ErrOSLocatorURIToolong", Err: metadatatypes.ErrOSLocatorURIToolong},
+	{Name: "ErrNoRecordsFound", Err: metadatatypes.ErrNoRecordsFound},
+	{Name: "ErrOSLocatorURIInvalid", Err: metadatatypes.ErrOSLocatorURIInvalid},
+
+	{Name: "ErrEmptyMsgType", Err: msgfeestypes.ErrEmptyMsgType},
+	{Name: "ErrInvalidFee", Err: msgfeestypes.ErrInvalidFee},
+	{Name: "ErrMsgFeeAlreadyExists", Err: msgfeestypes.ErrMsgFeeAlreadyExists},
+	{Name: "ErrMsgFeeDoesNotExist", Err: msgfeestypes.ErrMsgFeeDoesNotExist},
+	{Name: "ErrInvalidFeeProposal", Err: msgfeestypes.ErrInvalidFeeProposal},
+	{Name: "ErrInvalidBipsValue", Err: msgfeestypes.ErrInvalidBipsValue},
+
+	{Name: "ErrNameNotBound", Err: nametypes.ErrNameNotBound},
+	{Name: "ErrNameAlreadyBound", Err: nametypes.ErrNameAlreadyBound},
+	{Name: "ErrNameInvalid", Err: nametypes.ErrNameInvalid},
+	{Name: "ErrNameSegmentTooShort", Err: nametypes.ErrNameSegmentTooShort},
+	{Name: "ErrNameSegmentTooLong", Err: nametypes.ErrNameSegmentTooLong},
+	{Name: "ErrNameHasTooManySegments", Err: nametypes.ErrNameHasTooManySegments},
+	{Name: "ErrInvalidAddress", Err: nametypes.ErrInvalidAddress},
+	{Name: "ErrNameContainsSegments", Err: nametypes.ErrNameContainsSegments},
+	{Name: "ErrParentNameNotBound", Err: nametypes.ErrParentNameNotBound},
+	{Name: "ErrParentNameRestricted", Err: nametypes.ErrParentNameRestricted},
+	{Name: "ErrNamePendingDeletion", Err: nametypes.ErrNamePendingDeletion},
+	{Name: "ErrNameBindingMismatch", Err: nametypes.ErrNameBindingMismatch},
+	{Name: "ErrNameHasTooManyUUIDSegments", Err: nametypes.ErrNameHasTooManyUUIDSegments},
+	{Name: "ErrChildQuotaExceeded", Err: nametypes.ErrChildQuotaExceeded},
+	{Name: "ErrNamedAccountExists", Err: nametypes.ErrNamedAccountExists},
+	{Name: "ErrNamedAccountSend", Err: nametypes.ErrNamedAccountSend},
+
+	{Name: "ErrInvalidPacketTimeout", Err: oracletypes.ErrInvalidPacketTimeout},
+	{Name: "ErrInvalidVersion", Err: oracletypes.ErrInvalidVersion},
+	{Name: "ErrMissingOracleAddress", Err: oracletypes.ErrMissingOracleAddress},
+
+	{Name: "ErrInvalidValue", Err: quarantineerrs.ErrInvalidValue},
+
+	{Name: "ErrInvalidParams", Err: sanctionerrs.ErrInvalidParams},
+	{Name: "ErrUnsanctionableAddr", Err: sanctionerrs.ErrUnsanctionableAddr},
+	{Name: "ErrInvalidTempStatus", Err: sanctionerrs.ErrInvalidTempStatus},
+	{Name: "ErrSanctionedAccount", Err: sanctionerrs.ErrSanctionedAccount},
+
+	{Name: "ErrTriggerNotFound", Err: triggertypes.ErrTriggerNotFound},
+	{Name: "ErrEventNotFound", Err: triggertypes.ErrEventNotFound},
+	{Name: "ErrQueueIndexNotFound", Err: triggertypes.ErrQueueIndexNotFound},
+	{Name: "ErrQueueEmpty", Err: triggertypes.ErrQueueEmpty},
+	{Name: "ErrGasLimitNotFound", Err: triggertypes.ErrGasLimitNotFound},
+	{Name: "ErrTriggerGasLimitExceeded", Err: triggertypes.ErrTriggerGasLimitExceeded},
+	{Name: "ErrInvalidTriggerAuthority", Err: triggertypes.ErrInvalidTriggerAuthority},
+	{Name: "ErrNoTriggerEvent", Err: triggertypes.ErrNoTriggerEvent},
+	{Name: "ErrInvalidBlockHeight", Err: triggertypes.ErrInvalidBlockHeight},
+	{Name: "ErrInvalidBlockTime", Err: triggertypes.ErrInvalidBlockTime},
+
+	{Name: "ErrMsgNotAllowed", Err: wasmtypes.ErrMsgNotAllowed},
+	{Name: "ErrMsgTooLarge", Err: wasmtypes.ErrMsgTooLarge},
+}
+
+// All returns all of the errors registered by the provenance modules, ordered by codespace, then code.
+func All() []Code {
+	rv := make([]Code, len(codes))
+	copy(rv, codes)
+	sort.SliceStable(rv, func(i, j int) bool {
+		if rv[i].Codespace() != rv[j].Codespace() {
+			return rv[i].Codespace() < rv[j].Codespace()
+		}
+		return rv[i].ABCICode() < rv[j].ABCICode()
+	})
+	return rv
+}
+
+// Lookup returns the registered error with the given codespace and code, and whether it was found.
+func Lookup(codespace string, code uint32) (Code, bool) {
+	for _, c := range codes {
+		if c.Codespace() == codespace && c.ABCICode() == code {
+			return c, true
+		}
+	}
+	return Code{}, false
+}
+
+// Markdown returns the documentation of all of the registered errors.
+func Markdown() string {
+	var sb strings.Builder
+	sb.WriteString("# Error Codes\n\n")
+	sb.WriteString("These are the errors registered by the provenance modules.\n")
+	sb.WriteString("The codespace and code of an error are stable: they are never changed or reused once released.\n\n")
+	sb.WriteString("When a tx fails with one of these errors, the `codespace` and `code` fields of its result identify it.\n")
+	sb.WriteString("When a gRPC query fails with one of these errors, the status has a `google.rpc.ErrorInfo` detail with the\n")
+	sb.WriteString("codespace as its `domain`, the error's name as its `reason`, and the code in its `metadata`.\n\n")
+	sb.WriteString("Failures that are not listed here are reported using the codes of the Cosmos SDK (codespace `sdk`).\n")
+	sb.WriteString("This file is generated by `go test ./internal/errcodes -update`.\n")
+
+	codespace := ""
+	for _, c := range All() {
+		if c.Codespace() != codespace {
+			codespace = c.Codespace()
+			sb.WriteString(fmt.Sprintf("\n## %s\n\n", codespace))
+			sb.WriteString("| Code | Name | Description |\n")
+			sb.WriteString("|------|------|-------------|\n")
+		}
+		sb.WriteString(fmt.Sprintf("| %d | `%s` | %s |\n", c.ABCICode(), c.Name, c.Err.Error()))
+	}
+	return sb.String()
+}
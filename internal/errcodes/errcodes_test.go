@@ -0,0 +1,179 @@
+package errcodes_test
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/provenance-io/provenance/internal/errcodes"
+	nametypes "github.com/provenance-io/provenance/x/name/types"
+)
+
+var update = flag.Bool("update", false, "update the error codes documentation")
+
+// docFile is the documentation of the error codes, relative to this package.
+const docFile = "../../docs/error-codes.md"
+
+func TestCodesAreUnique(t *testing.T) {
+	seen := make(map[string]string)
+	for _, c := range errcodes.All() {
+		key := fmt.Sprintf("%s/%d", c.Codespace(), c.ABCICode())
+		if prev, found := seen[key]; found {
+			t.Errorf("%s and %s both have codespace %q code %d", prev, c.Name, c.Codespace(), c.ABCICode())
+		}
+		seen[key] = c.Name
+		assert.NotEmpty(t, c.Name, "name of %s", key)
+		assert.Greater(t, c.ABCICode(), uint32(1), "code of %s: codes 0 and 1 are reserved", c.Name)
+	}
+}
+
+func TestLookup(t *testing.T) {
+	c, found := errcodes.Lookup(nametypes.ModuleName, 3)
+	if assert.True(t, found, "found name 3") {
+		assert.Equal(t, "ErrNameAlreadyBound", c.Name, "name 3")
+		assert.Equal(t, nametypes.ErrNameAlreadyBound, c.Err, "name 3 error")
+	}
+
+	_, found = errcodes.Lookup(nametypes.ModuleName, 999)
+	assert.False(t, found, "found name 999")
+	_, found = errcodes.Lookup(sdkerrors.RootCodespace, sdkerrors.ErrInvalidRequest.ABCICode())
+	assert.False(t, found, "found sdk invalid request")
+}
+
+func TestMarkdown(t *testing.T) {
+	exp := errcodes.Markdown()
+	if *update {
+		require.NoError(t, os.WriteFile(docFile, []byte(exp), 0o644), "writing %s", docFile)
+	}
+	act, err := os.ReadFile(docFile)
+	require.NoError(t, err, "reading %s", docFile)
+	assert.Equal(t, exp, string(act), "%s is out of date: run go test ./internal/errcodes -update", docFile)
+}
+
+func TestWithStatusDetails(t *testing.T) {
+	tests := []struct {
+		name         string
+		err          error
+		expCode      codes.Code
+		expMsg       string
+		expInfo      *errdetails.ErrorInfo
+		expUnchanged bool
+	}{
+		{
+			name:         "nil",
+			err:          nil,
+			expUnchanged: true,
+		},
+		{
+			name:         "standard error",
+			err:          fmt.Errorf("plain error"),
+			expUnchanged: true,
+		},
+		{
+			name:         "sdk error",
+			err:          sdkerrors.ErrInvalidRequest.Wrap("bad"),
+			expUnchanged: true,
+		},
+		{
+			name:    "registered error",
+			err:     nametypes.ErrNameNotBound,
+			expCode: codes.Unknown,
+			expMsg:  "codespace name code 2: no address bound to name",
+			expInfo: &errdetails.ErrorInfo{
+				Reason:   "ErrNameNotBound",
+				Domain:   "name",
+				Metadata: map[string]string{"codespace": "name", "code": "2"},
+			},
+		},
+		{
+			name:    "wrapped registered error",
+			err:     nametypes.ErrNameAlreadyBound.Wrapf("%q", "test.pb"),
+			expCode: codes.Unknown,
+			expMsg:  `codespace name code 3: name is already bound to an address: "test.pb"`,
+			expInfo: &errdetails.ErrorInfo{
+				Reason:   "ErrNameAlreadyBound",
+				Domain:   "name",
+				Metadata: map[string]string{"codespace": "name", "code": "3"},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := errcodes.WithStatusDetails(tc.err)
+			if tc.expUnchanged {
+				assert.Equal(t, tc.err, err, "WithStatusDetails result")
+				return
+			}
+			st, ok := status.FromError(err)
+			require.True(t, ok, "status.FromError ok")
+			assert.Equal(t, tc.expCode, st.Code(), "status code")
+			assert.Equal(t, tc.expMsg, st.Message(), "status message")
+			details := st.Details()
+			require.Len(t, details, 1, "status details")
+			info, ok := details[0].(*errdetails.ErrorInfo)
+			require.True(t, ok, "details[0] is %T, expected %T", details[0], info)
+			assert.Equal(t, tc.expInfo.Reason, info.Reason, "ErrorInfo.Reason")
+			assert.Equal(t, tc.expInfo.Domain, info.Domain, "ErrorInfo.Domain")
+			assert.Equal(t, tc.expInfo.Metadata, info.Metadata, "ErrorInfo.Metadata")
+		})
+	}
+}
+
+// mockServer is a gogogrpc.Server that records the service registered with it.
+type mockServer struct {
+	desc *grpc.ServiceDesc
+}
+
+func (s *mockServer) RegisterService(sd *grpc.ServiceDesc, _ interface{}) {
+	s.desc = sd
+}
+
+func TestNewGRPCServer(t *testing.T) {
+	handler := func(retErr error) grpc.MethodDesc {
+		return grpc.MethodDesc{
+			MethodName: "Method",
+			Handler: func(interface{}, context.Context, func(interface{}) error, grpc.UnaryServerInterceptor) (interface{}, error) {
+				if retErr != nil {
+					return nil, retErr
+				}
+				return "response", nil
+			},
+		}
+	}
+
+	mock := &mockServer{}
+	server := errcodes.NewGRPCServer(mock)
+	server.RegisterService(&grpc.ServiceDesc{
+		ServiceName: "test.Service",
+		Methods:     []grpc.MethodDesc{handler(nil), handler(nametypes.ErrNameNotBound)},
+	}, nil)
+
+	require.NotNil(t, mock.desc, "registered service")
+	assert.Equal(t, "test.Service", mock.desc.ServiceName, "ServiceName")
+	require.Len(t, mock.desc.Methods, 2, "Methods")
+
+	resp, err := mock.desc.Methods[0].Handler(nil, context.Background(), nil, nil)
+	assert.NoError(t, err, "Methods[0] error")
+	assert.Equal(t, "response", resp, "Methods[0] response")
+
+	resp, err = mock.desc.Methods[1].Handler(nil, context.Background(), nil, nil)
+	assert.Nil(t, resp, "Methods[1] response")
+	st, ok := status.FromError(err)
+	require.True(t, ok, "status.FromError ok")
+	require.Len(t, st.Details(), 1, "status details")
+	info, ok := st.Details()[0].(*errdetails.ErrorInfo)
+	require.True(t, ok, "details[0] is %T, expected %T", st.Details()[0], info)
+	assert.Equal(t, "ErrNameNotBound", info.Reason, "ErrorInfo.Reason")
+}
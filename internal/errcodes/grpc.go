@@ -0,0 +1,95 @@
+package errcodes
+
+import (
+	"context"
+	"strconv"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+
+	errorsmod "cosmossdk.io/errors"
+
+	gogogrpc "github.com/cosmos/gogoproto/grpc"
+)
+
+// The keys of the metadata in the ErrorInfo details added to gRPC statuses.
+const (
+	MetadataKeyCodespace = "codespace"
+	MetadataKeyCode      = "code"
+)
+
+// ErrorInfo returns the ErrorInfo that identifies the given error,
+// and whether the error is (or wraps) one registered by the provenance modules.
+func ErrorInfo(err error) (*errdetails.ErrorInfo, bool) {
+	if err == nil {
+		return nil, false
+	}
+	codespace, code, _ := errorsmod.ABCIInfo(err, false)
+	c, found := Lookup(codespace, code)
+	if !found {
+		return nil, false
+	}
+	return &errdetails.ErrorInfo{
+		Reason: c.Name,
+		Domain: c.Codespace(),
+		Metadata: map[string]string{
+			MetadataKeyCodespace: c.Codespace(),
+			MetadataKeyCode:      strconv.FormatUint(uint64(c.ABCICode()), 10),
+		},
+	}, true
+}
+
+// WithStatusDetails converts the given error into a gRPC status error.
+// If the error is one registered by the provenance modules, the status will have an ErrorInfo detail identifying it.
+// Other errors are returned unchanged.
+func WithStatusDetails(err error) error {
+	info, ok := ErrorInfo(err)
+	if !ok {
+		return err
+	}
+	st, withDetailsErr := status.Convert(err).WithDetails(info)
+	if withDetailsErr != nil {
+		return err
+	}
+	return st.Err()
+}
+
+// grpcServer is a gogogrpc.Server that adds ErrorInfo details to the errors returned by the services registered with it.
+type grpcServer struct {
+	gogogrpc.Server
+}
+
+var _ gogogrpc.Server = (*grpcServer)(nil)
+
+// NewGRPCServer wraps the provided server so that errors registered by
+// the provenance modules have an ErrorInfo detail in their gRPC status.
+func NewGRPCServer(server gogogrpc.Server) gogogrpc.Server {
+	return &grpcServer{Server: server}
+}
+
+// RegisterService registers the service with the underlying server, wrapping each of its method handlers.
+func (s *grpcServer) RegisterService(sd *grpc.ServiceDesc, ss interface{}) {
+	methods := make([]grpc.MethodDesc, len(sd.Methods))
+	for i, method := range sd.Methods {
+		handler := method.Handler
+		methods[i] = grpc.MethodDesc{
+			MethodName: method.MethodName,
+			Handler: func(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+				resp, err := handler(srv, ctx, dec, interceptor)
+				if err != nil {
+					return nil, WithStatusDetails(err)
+				}
+				return resp, nil
+			},
+		}
+	}
+
+	s.Server.RegisterService(&grpc.ServiceDesc{
+		ServiceName: sd.ServiceName,
+		HandlerType: sd.HandlerType,
+		Methods:     methods,
+		Streams:     sd.Streams,
+		Metadata:    sd.Metadata,
+	}, ss)
+}
@@ -0,0 +1,59 @@
+package handlers
+
+import (
+	"fmt"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ContractLifecycleHooks defines the functions that get called after a wasm contract lifecycle msg is successfully handled.
+// Returning an error causes the msg to fail.
+type ContractLifecycleHooks interface {
+	// AfterContractMigrated is called after a contract is migrated by its admin.
+	AfterContractMigrated(ctx sdk.Context, contract, admin sdk.AccAddress) error
+	// AfterContractAdminCleared is called after a contract's admin has been cleared by that admin.
+	AfterContractAdminCleared(ctx sdk.Context, contract, admin sdk.AccAddress) error
+}
+
+// SetContractLifecycleHooks sets the hooks that get called after wasm contract lifecycle msgs.
+func (msr *PioMsgServiceRouter) SetContractLifecycleHooks(hooks ContractLifecycleHooks) {
+	msr.contractLifecycleHooks = hooks
+}
+
+// callContractLifecycleHooks calls the applicable contract lifecycle hook for the provided (successfully handled) req.
+func (msr *PioMsgServiceRouter) callContractLifecycleHooks(ctx sdk.Context, req sdk.Msg) error {
+	if msr.contractLifecycleHooks == nil {
+		return nil
+	}
+
+	switch msg := req.(type) {
+	case *wasmtypes.MsgMigrateContract:
+		contract, admin, err := contractAndAdmin(msg.Contract, msg.Sender)
+		if err != nil {
+			return err
+		}
+		return msr.contractLifecycleHooks.AfterContractMigrated(ctx, contract, admin)
+	case *wasmtypes.MsgClearAdmin:
+		contract, admin, err := contractAndAdmin(msg.Contract, msg.Sender)
+		if err != nil {
+			return err
+		}
+		return msr.contractLifecycleHooks.AfterContractAdminCleared(ctx, contract, admin)
+	}
+	return nil
+}
+
+// contractAndAdmin converts the provided bech32 contract and admin strings into addresses.
+func contractAndAdmin(contract, admin string) (sdk.AccAddress, sdk.AccAddress, error) {
+	contractAddr, err := sdk.AccAddressFromBech32(contract)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid contract address %q: %w", contract, err)
+	}
+	adminAddr, err := sdk.AccAddressFromBech32(admin)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid admin address %q: %w", admin, err)
+	}
+	return contractAddr, adminAddr, nil
+}
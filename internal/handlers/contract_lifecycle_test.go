@@ -0,0 +1,94 @@
+package handlers_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/provenance-io/provenance/internal/handlers"
+)
+
+// mockContractLifecycleHooks records the calls made to it and returns the configured error.
+type mockContractLifecycleHooks struct {
+	calls []string
+	err   error
+}
+
+func (m *mockContractLifecycleHooks) AfterContractMigrated(_ sdk.Context, contract, admin sdk.AccAddress) error {
+	m.calls = append(m.calls, "migrated "+contract.String()+" "+admin.String())
+	return m.err
+}
+
+func (m *mockContractLifecycleHooks) AfterContractAdminCleared(_ sdk.Context, contract, admin sdk.AccAddress) error {
+	m.calls = append(m.calls, "admin cleared "+contract.String()+" "+admin.String())
+	return m.err
+}
+
+func TestCallContractLifecycleHooks(t *testing.T) {
+	contract := sdk.AccAddress("contract____________").String()
+	admin := sdk.AccAddress("admin_______________").String()
+
+	tests := []struct {
+		name     string
+		hooksErr error
+		msg      sdk.Msg
+		expCalls []string
+		expErr   string
+	}{
+		{
+			name:     "migrate contract",
+			msg:      &wasmtypes.MsgMigrateContract{Sender: admin, Contract: contract},
+			expCalls: []string{"migrated " + contract + " " + admin},
+		},
+		{
+			name:     "clear admin",
+			msg:      &wasmtypes.MsgClearAdmin{Sender: admin, Contract: contract},
+			expCalls: []string{"admin cleared " + contract + " " + admin},
+		},
+		{
+			name: "other msg",
+			msg:  &banktypes.MsgSend{FromAddress: admin, ToAddress: contract},
+		},
+		{
+			name:     "hook error",
+			hooksErr: errors.New("hook failed"),
+			msg:      &wasmtypes.MsgClearAdmin{Sender: admin, Contract: contract},
+			expCalls: []string{"admin cleared " + contract + " " + admin},
+			expErr:   "hook failed",
+		},
+		{
+			name:   "invalid contract",
+			msg:    &wasmtypes.MsgMigrateContract{Sender: admin, Contract: "bad"},
+			expErr: `invalid contract address "bad": decoding bech32 failed: invalid bech32 string length 3`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			hooks := &mockContractLifecycleHooks{err: tc.hooksErr}
+			msr := handlers.NewPioMsgServiceRouter(nil)
+			msr.SetContractLifecycleHooks(hooks)
+
+			err := msr.CallContractLifecycleHooks(sdk.Context{}, tc.msg)
+			if len(tc.expErr) > 0 {
+				require.EqualError(t, err, tc.expErr, "CallContractLifecycleHooks error")
+			} else {
+				require.NoError(t, err, "CallContractLifecycleHooks error")
+			}
+			assert.Equal(t, tc.expCalls, hooks.calls, "hook calls")
+		})
+	}
+
+	t.Run("no hooks", func(t *testing.T) {
+		msr := handlers.NewPioMsgServiceRouter(nil)
+		err := msr.CallContractLifecycleHooks(sdk.Context{}, &wasmtypes.MsgClearAdmin{Sender: admin, Contract: contract})
+		require.NoError(t, err, "CallContractLifecycleHooks error")
+	})
+}
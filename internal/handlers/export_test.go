@@ -0,0 +1,11 @@
+package handlers
+
+import sdk "github.com/cosmos/cosmos-sdk/types"
+
+// This file is available only to unit tests and exposes private things
+// so that they can be used in unit tests.
+
+// CallContractLifecycleHooks is a TEST ONLY exposure of callContractLifecycleHooks.
+func (msr *PioMsgServiceRouter) CallContractLifecycleHooks(ctx sdk.Context, req sdk.Msg) error {
+	return msr.callContractLifecycleHooks(ctx, req)
+}
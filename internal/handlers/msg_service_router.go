@@ -19,6 +19,7 @@ import (
 
 	"github.com/provenance-io/provenance/internal/antewrapper"
 	"github.com/provenance-io/provenance/internal/protocompat"
+	"github.com/provenance-io/provenance/internal/provutils"
 	internalsdk "github.com/provenance-io/provenance/internal/sdk"
 	msgfeeskeeper "github.com/provenance-io/provenance/x/msgfees/keeper"
 )
@@ -31,6 +32,8 @@ type PioMsgServiceRouter struct {
 	msgFeesKeeper     msgfeeskeeper.Keeper
 	decoder           sdk.TxDecoder
 	circuitBreaker    baseapp.CircuitBreaker
+
+	contractLifecycleHooks ContractLifecycleHooks
 }
 
 var _ gogogrpc.Server = &PioMsgServiceRouter{}
@@ -155,7 +158,11 @@ func (msr *PioMsgServiceRouter) registerMsgServiceHandler(sd *grpc.ServiceDesc,
 		)
 	}
 
+	cdc := codec.NewProtoCodec(msr.interfaceRegistry)
 	msr.routes[requestTypeName] = func(ctx sdk.Context, req sdk.Msg) (*sdk.Result, error) {
+		// provenance specific modification so that everything logged while handling the msg identifies it.
+		ctx = withMsgLogContext(ctx, cdc, req)
+
 		// provenance specific modification to msg service router that handles x/msgfee distribution
 		err := msr.consumeMsgFees(ctx, req)
 		if err != nil {
@@ -199,6 +206,11 @@ func (msr *PioMsgServiceRouter) registerMsgServiceHandler(sd *grpc.ServiceDesc,
 			return nil, sdkerrors.ErrInvalidType.Wrapf("Expecting proto.Message, got %T", resMsg)
 		}
 
+		// provenance specific modification that lets other modules react to wasm contract lifecycle changes.
+		if err = msr.callContractLifecycleHooks(ctx, req); err != nil {
+			return nil, err
+		}
+
 		return sdk.WrapServiceResult(ctx, resMsg, err)
 	}
 }
@@ -285,6 +297,20 @@ func (msr *PioMsgServiceRouter) consumeMsgFees(ctx sdk.Context, req sdk.Msg) err
 	return nil
 }
 
+// withMsgLogContext adds the type and signers of the provided msg to the context's logger.
+// If the signers can't be determined, the signer log field is left empty; the msg will fail validation elsewhere.
+func withMsgLogContext(ctx sdk.Context, cdc *codec.ProtoCodec, req sdk.Msg) sdk.Context {
+	var signers []string
+	signerBzs, _, err := cdc.GetMsgV1Signers(req)
+	if err == nil {
+		signers = make([]string, len(signerBzs))
+		for i, bz := range signerBzs {
+			signers[i] = sdk.AccAddress(bz).String()
+		}
+	}
+	return provutils.WithMsgLogContext(ctx, sdk.MsgTypeURL(req), signers)
+}
+
 // sortedKeys gets the keys of a map, sorts them and returns them as a slice.
 func sortedKeys[K constraints.Ordered, V any](m map[K]V) []K {
 	keys := make([]K, 0, len(m))
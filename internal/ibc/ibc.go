@@ -2,7 +2,6 @@ package ibc
 
 import (
 	"encoding/json"
-	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
@@ -30,7 +29,7 @@ func EmitIBCErrorEvents(ctx sdk.Context, err error, errorContexts []string) {
 	attributes[0] = sdk.NewAttribute("error", err.Error())
 	for i, s := range errorContexts {
 		attributes[i+1] = sdk.NewAttribute("error-context", s)
-		logger.Error(fmt.Sprintf("error-context: %v", s))
+		logger.Error("ibc acknowledgement error", "error", err, "error_context", s)
 	}
 
 	ctx.EventManager().EmitEvents(sdk.Events{
@@ -0,0 +1,62 @@
+package provcli
+
+import (
+	"bytes"
+
+	"github.com/cosmos/gogoproto/jsonpb"
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+)
+
+// OutputFormatProtoJSON is the --output value that causes query results to be printed as canonical proto3 JSON.
+const OutputFormatProtoJSON = "proto-json"
+
+// AddQueryFlagsToCmd adds the standard query flags to a command, noting
+// in the --output flag's usage that the proto-json format is also available.
+// Commands that use this should print their results using PrintProto.
+func AddQueryFlagsToCmd(cmd *cobra.Command) {
+	flags.AddQueryFlagsToCmd(cmd)
+	if outputFlag := cmd.Flags().Lookup(flags.FlagOutput); outputFlag != nil {
+		outputFlag.Usage = "Output format (text|json|" + OutputFormatProtoJSON + ")"
+	}
+}
+
+// PrintProto outputs the provided message using the client context's output format.
+//
+// When the output format is proto-json, the message is encoded as canonical proto3 JSON:
+// lowerCamelCase field names, default values omitted, and Any values resolved using the
+// client's interface registry. That form can be read by any proto3 JSON parser, e.g. tx building tools.
+// Otherwise, this is the same as clientCtx.PrintProto.
+func PrintProto(clientCtx client.Context, msg proto.Message) error {
+	if clientCtx.OutputFormat != OutputFormatProtoJSON {
+		return clientCtx.PrintProto(msg)
+	}
+	var resolver jsonpb.AnyResolver
+	if clientCtx.InterfaceRegistry != nil {
+		resolver = clientCtx.InterfaceRegistry
+	}
+	out, err := MarshalProtoJSON(msg, resolver)
+	if err != nil {
+		return err
+	}
+	return clientCtx.PrintRaw(out)
+}
+
+// MarshalProtoJSON encodes the provided message as canonical proto3 JSON.
+// The resolver is used to look up the types of Any values, and can be nil if the message doesn't have any.
+func MarshalProtoJSON(msg proto.Message, resolver jsonpb.AnyResolver) ([]byte, error) {
+	jm := &jsonpb.Marshaler{AnyResolver: resolver}
+	if err := codectypes.UnpackInterfaces(msg, codectypes.ProtoJSONPacker{JSONPBMarshaler: jm}); err != nil {
+		return nil, err
+	}
+
+	buf := new(bytes.Buffer)
+	if err := jm.Marshal(buf, msg); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
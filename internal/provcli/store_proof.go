@@ -0,0 +1,65 @@
+package provcli
+
+import (
+	"context"
+	"fmt"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtcrypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+)
+
+// GetProofHeight returns the height of the state to get proofs of.
+// It's the client context's height if one was requested. Otherwise, it's the height before the latest block since
+// the state at a height is committed to by the header of the next block, so the latest state can't be verified yet.
+func GetProofHeight(clientCtx client.Context) (int64, error) {
+	if clientCtx.Height > 0 {
+		return clientCtx.Height, nil
+	}
+	node, err := clientCtx.GetNode()
+	if err != nil {
+		return 0, err
+	}
+	status, err := node.Status(context.Background())
+	if err != nil {
+		return 0, fmt.Errorf("could not get node status: %w", err)
+	}
+	if status.SyncInfo.LatestBlockHeight < 2 {
+		return 0, fmt.Errorf("cannot get proofs until there are at least 2 blocks")
+	}
+	return status.SyncInfo.LatestBlockHeight - 1, nil
+}
+
+// QueryStoreProof gets the value of a key in a module's store at a height, along with the merkle proof of it.
+// If the key is not in the store, the value is empty and the proof is of its absence.
+func QueryStoreProof(clientCtx client.Context, storeName string, key []byte, height int64) ([]byte, *cmtcrypto.ProofOps, error) {
+	resp, err := clientCtx.QueryABCI(abci.RequestQuery{
+		Path:   fmt.Sprintf("/store/%s/key", storeName),
+		Data:   key,
+		Height: height,
+		Prove:  true,
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not query %s store at height %d: %w", storeName, height, err)
+	}
+	if resp.ProofOps == nil {
+		return nil, nil, fmt.Errorf("no proof returned from %s store at height %d", storeName, height)
+	}
+	return resp.Value, resp.ProofOps, nil
+}
+
+// QueryCommittingHeader gets the signed header that commits to the state at a height, i.e. the header of the next block.
+func QueryCommittingHeader(clientCtx client.Context, height int64) (*cmtproto.SignedHeader, error) {
+	node, err := clientCtx.GetNode()
+	if err != nil {
+		return nil, err
+	}
+	headerHeight := height + 1
+	commit, err := node.Commit(context.Background(), &headerHeight)
+	if err != nil {
+		return nil, fmt.Errorf("could not get signed header at height %d: %w", headerHeight, err)
+	}
+	return commit.SignedHeader.ToProto(), nil
+}
@@ -0,0 +1,78 @@
+package provutils
+
+import (
+	"bufio"
+	"io"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/gogoproto/proto"
+)
+
+// GenesisListField is a (small) repeated field of a genesis state that is written after the streamed repeated field.
+type GenesisListField struct {
+	// Name is the json name of the field.
+	Name string
+	// Entries are the entries of the field.
+	Entries []proto.Message
+}
+
+// StreamGenesisJSON writes a genesis state with a params field and one repeated field to w, one entry at a time.
+// The iterate func should call emit once for each entry of the repeated field (named by listField).
+// Any extraFields are written (all at once) after the streamed field, in the order provided.
+// The output is the same as marshaling the whole genesis state with the codec, but without ever
+// having all the entries decoded at once. Whether all of their JSON is in memory at once depends on w.
+func StreamGenesisJSON(w io.Writer, cdc codec.JSONCodec, params proto.Message, listField string,
+	iterate func(emit func(entry proto.Message) error) error, extraFields ...GenesisListField,
+) error {
+	bw := bufio.NewWriter(w)
+
+	paramsBz, err := cdc.MarshalJSON(params)
+	if err != nil {
+		return err
+	}
+	if _, err = bw.WriteString(`{"params":`); err != nil {
+		return err
+	}
+	if _, err = bw.Write(paramsBz); err != nil {
+		return err
+	}
+	if _, err = bw.WriteString(`,"` + listField + `":[`); err != nil {
+		return err
+	}
+
+	first := true
+	emit := func(entry proto.Message) error {
+		bz, merr := cdc.MarshalJSON(entry)
+		if merr != nil {
+			return merr
+		}
+		if !first {
+			if werr := bw.WriteByte(','); werr != nil {
+				return werr
+			}
+		}
+		first = false
+		_, werr := bw.Write(bz)
+		return werr
+	}
+	if err = iterate(emit); err != nil {
+		return err
+	}
+
+	for _, field := range extraFields {
+		if _, err = bw.WriteString(`],"` + field.Name + `":[`); err != nil {
+			return err
+		}
+		first = true
+		for _, entry := range field.Entries {
+			if err = emit(entry); err != nil {
+				return err
+			}
+		}
+	}
+
+	if _, err = bw.WriteString("]}"); err != nil {
+		return err
+	}
+	return bw.Flush()
+}
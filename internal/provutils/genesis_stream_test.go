@@ -0,0 +1,97 @@
+package provutils
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+)
+
+// errWriter is an io.Writer that always returns an error.
+type errWriter struct{}
+
+func (errWriter) Write([]byte) (int, error) {
+	return 0, errors.New("write failed")
+}
+
+func TestStreamGenesisJSON(t *testing.T) {
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	params := sdk.NewInt64Coin("params", 1)
+	entries := []sdk.Coin{sdk.NewInt64Coin("one", 1), sdk.NewInt64Coin("two", 2)}
+
+	iterateEntries := func(count int) func(emit func(entry proto.Message) error) error {
+		return func(emit func(entry proto.Message) error) error {
+			for i := 0; i < count; i++ {
+				if err := emit(&entries[i]); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}
+
+	tests := []struct {
+		name    string
+		iterate func(emit func(entry proto.Message) error) error
+		exp     string
+		expErr  string
+	}{
+		{
+			name:    "no entries",
+			iterate: iterateEntries(0),
+			exp:     `{"params":{"denom":"params","amount":"1"},"coins":[]}`,
+		},
+		{
+			name:    "one entry",
+			iterate: iterateEntries(1),
+			exp:     `{"params":{"denom":"params","amount":"1"},"coins":[{"denom":"one","amount":"1"}]}`,
+		},
+		{
+			name:    "two entries",
+			iterate: iterateEntries(2),
+			exp:     `{"params":{"denom":"params","amount":"1"},"coins":[{"denom":"one","amount":"1"},{"denom":"two","amount":"2"}]}`,
+		},
+		{
+			name:    "iterate error",
+			iterate: func(_ func(entry proto.Message) error) error { return errors.New("iterate failed") },
+			expErr:  "iterate failed",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			err := StreamGenesisJSON(&buf, cdc, &params, "coins", tc.iterate)
+			if len(tc.expErr) > 0 {
+				require.EqualError(t, err, tc.expErr, "StreamGenesisJSON error")
+				return
+			}
+			require.NoError(t, err, "StreamGenesisJSON error")
+			assert.Equal(t, tc.exp, buf.String(), "StreamGenesisJSON output")
+		})
+	}
+
+	t.Run("extra fields", func(t *testing.T) {
+		var buf bytes.Buffer
+		err := StreamGenesisJSON(&buf, cdc, &params, "coins", iterateEntries(1),
+			GenesisListField{Name: "empty"},
+			GenesisListField{Name: "more", Entries: []proto.Message{&entries[1], &entries[0]}},
+		)
+		require.NoError(t, err, "StreamGenesisJSON error")
+		exp := `{"params":{"denom":"params","amount":"1"},"coins":[{"denom":"one","amount":"1"}],"empty":[],` +
+			`"more":[{"denom":"two","amount":"2"},{"denom":"one","amount":"1"}]}`
+		assert.Equal(t, exp, buf.String(), "StreamGenesisJSON output")
+	})
+
+	t.Run("writer error", func(t *testing.T) {
+		err := StreamGenesisJSON(errWriter{}, cdc, &params, "coins", iterateEntries(2))
+		assert.EqualError(t, err, "write failed", "StreamGenesisJSON error")
+	})
+}
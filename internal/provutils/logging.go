@@ -0,0 +1,32 @@
+package provutils
+
+import (
+	"strings"
+
+	"cosmossdk.io/log"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// LogKeyModule is the log field containing the name of the module doing the logging, e.g. "x/marker".
+	LogKeyModule = "module"
+	// LogKeyMsgType is the log field containing the type url of the Msg being processed.
+	LogKeyMsgType = "msg_type"
+	// LogKeySigner is the log field containing the (comma separated) bech32 signers of the Msg being processed.
+	LogKeySigner = "signer"
+)
+
+// ModuleLogger returns the context's logger with the module field set to "x/<moduleName>".
+// Keepers should use this in their Logger method instead of building the logger themselves.
+//
+// If the context came from the msg service router, the logger will also already have the
+// msg type and signer fields, so log lines can be correlated to the tx that caused them.
+func ModuleLogger(ctx sdk.Context, moduleName string) log.Logger {
+	return ctx.Logger().With(LogKeyModule, "x/"+moduleName)
+}
+
+// WithMsgLogContext returns a context whose logger includes the msg type and signer fields.
+func WithMsgLogContext(ctx sdk.Context, msgTypeURL string, signers []string) sdk.Context {
+	return ctx.WithLogger(ctx.Logger().With(LogKeyMsgType, msgTypeURL, LogKeySigner, strings.Join(signers, ",")))
+}
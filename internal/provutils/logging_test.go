@@ -0,0 +1,56 @@
+package provutils
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/internal"
+)
+
+func TestModuleLogger(t *testing.T) {
+	var buffer bytes.Buffer
+	ctx := sdk.Context{}.WithLogger(internal.NewBufferedInfoLogger(&buffer))
+
+	ModuleLogger(ctx, "marker").Info("something happened", "denom", "banana")
+	expected := []string{"INF something happened denom=banana module=x/marker"}
+	assert.Equal(t, expected, internal.SplitLogLines(buffer.String()), "log lines")
+}
+
+func TestWithMsgLogContext(t *testing.T) {
+	tests := []struct {
+		name     string
+		signers  []string
+		expected string
+	}{
+		{
+			name:     "no signers",
+			signers:  nil,
+			expected: "INF something happened denom=banana module=x/marker msg_type=/provenance.marker.v1.MsgMintRequest signer=",
+		},
+		{
+			name:     "one signer",
+			signers:  []string{"signer1"},
+			expected: "INF something happened denom=banana module=x/marker msg_type=/provenance.marker.v1.MsgMintRequest signer=signer1",
+		},
+		{
+			name:     "two signers",
+			signers:  []string{"signer1", "signer2"},
+			expected: "INF something happened denom=banana module=x/marker msg_type=/provenance.marker.v1.MsgMintRequest signer=signer1,signer2",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var buffer bytes.Buffer
+			ctx := sdk.Context{}.WithLogger(internal.NewBufferedInfoLogger(&buffer))
+			ctx = WithMsgLogContext(ctx, "/provenance.marker.v1.MsgMintRequest", tc.signers)
+
+			ModuleLogger(ctx, "marker").Info("something happened", "denom", "banana")
+			assert.Equal(t, []string{tc.expected}, internal.SplitLogLines(buffer.String()), "log lines")
+		})
+	}
+}
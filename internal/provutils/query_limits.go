@@ -0,0 +1,48 @@
+package provutils
+
+import (
+	"github.com/cosmos/gogoproto/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+// LimitPageRequest returns a page request whose limit is no more than maxResults, and whether the limit was reduced.
+// A nil page request (or one without a limit) is treated as having the SDK's default limit.
+// The provided page request is not altered. A maxResults of zero means there is no limit.
+func LimitPageRequest(pageReq *query.PageRequest, maxResults uint32) (*query.PageRequest, bool) {
+	if maxResults == 0 {
+		return pageReq, false
+	}
+	limit := uint64(query.DefaultLimit)
+	if pageReq != nil && pageReq.Limit != 0 {
+		limit = pageReq.Limit
+	}
+	if limit <= uint64(maxResults) {
+		return pageReq, false
+	}
+
+	rv := &query.PageRequest{}
+	if pageReq != nil {
+		rv.Key = pageReq.Key
+		rv.Offset = pageReq.Offset
+		rv.CountTotal = pageReq.CountTotal
+		rv.Reverse = pageReq.Reverse
+	}
+	rv.Limit = uint64(maxResults)
+	return rv, true
+}
+
+// ValidateQueryResponseSize returns a ResourceExhausted error if the provided query response is larger than maxBytes.
+// A maxBytes of zero means there is no limit.
+func ValidateQueryResponseSize(resp proto.Message, maxBytes uint64) error {
+	if maxBytes == 0 {
+		return nil
+	}
+	size := proto.Size(resp)
+	if uint64(size) > maxBytes { //nolint:gosec // G115: A size is never negative.
+		return status.Errorf(codes.ResourceExhausted, "query response size %d exceeds the maximum of %d bytes: use a smaller page limit", size, maxBytes)
+	}
+	return nil
+}
@@ -0,0 +1,124 @@
+package provutils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+func TestLimitPageRequest(t *testing.T) {
+	tests := []struct {
+		name       string
+		pageReq    *query.PageRequest
+		maxResults uint32
+		exp        *query.PageRequest
+		expReduced bool
+	}{
+		{
+			name:       "nil request, no max",
+			pageReq:    nil,
+			maxResults: 0,
+			exp:        nil,
+		},
+		{
+			name:       "nil request, max more than default",
+			pageReq:    nil,
+			maxResults: 500,
+			exp:        nil,
+		},
+		{
+			name:       "nil request, max equals default",
+			pageReq:    nil,
+			maxResults: uint32(query.DefaultLimit),
+			exp:        nil,
+		},
+		{
+			name:       "nil request, max less than default",
+			pageReq:    nil,
+			maxResults: 10,
+			exp:        &query.PageRequest{Limit: 10},
+			expReduced: true,
+		},
+		{
+			name:       "no limit, max less than default",
+			pageReq:    &query.PageRequest{Key: []byte("key"), Reverse: true},
+			maxResults: 10,
+			exp:        &query.PageRequest{Key: []byte("key"), Limit: 10, Reverse: true},
+			expReduced: true,
+		},
+		{
+			name:       "limit less than max",
+			pageReq:    &query.PageRequest{Limit: 5},
+			maxResults: 10,
+			exp:        &query.PageRequest{Limit: 5},
+		},
+		{
+			name:       "limit equals max",
+			pageReq:    &query.PageRequest{Limit: 10},
+			maxResults: 10,
+			exp:        &query.PageRequest{Limit: 10},
+		},
+		{
+			name:       "limit more than max",
+			pageReq:    &query.PageRequest{Offset: 3, Limit: 11, CountTotal: true},
+			maxResults: 10,
+			exp:        &query.PageRequest{Offset: 3, Limit: 10, CountTotal: true},
+			expReduced: true,
+		},
+		{
+			name:       "large limit, no max",
+			pageReq:    &query.PageRequest{Limit: 5000},
+			maxResults: 0,
+			exp:        &query.PageRequest{Limit: 5000},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var orig *query.PageRequest
+			if tc.pageReq != nil {
+				orig = &query.PageRequest{}
+				*orig = *tc.pageReq
+			}
+			actual, reduced := LimitPageRequest(tc.pageReq, tc.maxResults)
+			assert.Equal(t, tc.exp, actual, "LimitPageRequest page request")
+			assert.Equal(t, tc.expReduced, reduced, "LimitPageRequest reduced")
+			assert.Equal(t, orig, tc.pageReq, "provided page request after LimitPageRequest")
+		})
+	}
+}
+
+func TestValidateQueryResponseSize(t *testing.T) {
+	resp := &query.PageResponse{NextKey: []byte("0123456789"), Total: 5}
+	size := uint64(resp.Size())
+	require.Greater(t, size, uint64(10), "response size")
+
+	tests := []struct {
+		name     string
+		maxBytes uint64
+		expErr   string
+	}{
+		{name: "no max", maxBytes: 0},
+		{name: "max more than size", maxBytes: size + 1},
+		{name: "max equals size", maxBytes: size},
+		{
+			name:     "max less than size",
+			maxBytes: size - 1,
+			expErr:   "rpc error: code = ResourceExhausted desc = query response size 14 exceeds the maximum of 13 bytes: use a smaller page limit",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := ValidateQueryResponseSize(resp, tc.maxBytes)
+			if len(tc.expErr) > 0 {
+				assert.EqualError(t, err, tc.expErr, "ValidateQueryResponseSize")
+			} else {
+				assert.NoError(t, err, "ValidateQueryResponseSize")
+			}
+		})
+	}
+}
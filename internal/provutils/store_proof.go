@@ -0,0 +1,55 @@
+package provutils
+
+import (
+	"fmt"
+
+	"github.com/cometbft/cometbft/crypto/merkle"
+	cmtcrypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	"cosmossdk.io/store/rootmulti"
+)
+
+// StoreProofKeyPath returns the merkle key path of a key in a module's store, as used to verify proofs of it.
+func StoreProofKeyPath(storeName string, key []byte) string {
+	return merkle.KeyPath{}.
+		AppendKey([]byte(storeName), merkle.KeyEncodingURL).
+		AppendKey(key, merkle.KeyEncodingURL).
+		String()
+}
+
+// VerifyStoreProof checks that the proof shows that the key has the value in the module's store, in the state with the app hash.
+// If the value is empty, the proof must show that the key is absent from the store instead.
+func VerifyStoreProof(proof *cmtcrypto.ProofOps, appHash []byte, storeName string, key, value []byte) error {
+	if proof == nil {
+		return fmt.Errorf("proof cannot be empty")
+	}
+	prt := rootmulti.DefaultProofRuntime()
+	keyPath := StoreProofKeyPath(storeName, key)
+	if len(value) == 0 {
+		if err := prt.VerifyAbsence(proof, appHash, keyPath); err != nil {
+			return fmt.Errorf("invalid proof of absence of %X from the %s store: %w", key, storeName, err)
+		}
+		return nil
+	}
+	if err := prt.VerifyValue(proof, appHash, keyPath, value); err != nil {
+		return fmt.Errorf("invalid proof of %X in the %s store: %w", key, storeName, err)
+	}
+	return nil
+}
+
+// VerifyStoreProofInHeader checks that the proof is of the state at the height, and that the header is the one
+// that commits to that state, i.e. the header of the next block. See also: VerifyStoreProof.
+//
+// This does not verify the header's commit signatures; it is up to the light client to check those
+// against a validator set that it trusts.
+func VerifyStoreProofInHeader(header *cmtproto.SignedHeader, height int64, proof *cmtcrypto.ProofOps, storeName string, key, value []byte) error {
+	if header == nil || header.Header == nil {
+		return fmt.Errorf("signed header cannot be empty")
+	}
+	if header.Header.Height != height+1 {
+		return fmt.Errorf("signed header height %d does not commit to the state at height %d: expected height %d",
+			header.Header.Height, height, height+1)
+	}
+	return VerifyStoreProof(proof, header.Header.AppHash, storeName, key, value)
+}
@@ -0,0 +1,181 @@
+package provutils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cmtcrypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	dbm "github.com/cosmos/cosmos-db"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/metrics"
+	"cosmossdk.io/store/rootmulti"
+	storetypes "cosmossdk.io/store/types"
+)
+
+// newProofTestStore creates a committed multistore with a "test" store containing the key "present" = "value".
+// It returns the store and the app hash of the committed state.
+func newProofTestStore(t *testing.T) (*rootmulti.Store, []byte) {
+	storeKey := storetypes.NewKVStoreKey("test")
+	ms := rootmulti.NewStore(dbm.NewMemDB(), log.NewNopLogger(), metrics.NewNoOpMetrics())
+	ms.MountStoreWithDB(storeKey, storetypes.StoreTypeIAVL, nil)
+	require.NoError(t, ms.LoadLatestVersion(), "LoadLatestVersion")
+	ms.GetKVStore(storeKey).Set([]byte("present"), []byte("value"))
+	commitID := ms.Commit()
+	return ms, commitID.Hash
+}
+
+// queryProof gets the proof of the key from the "test" store.
+func queryProof(t *testing.T, ms *rootmulti.Store, key string) *cmtcrypto.ProofOps {
+	resp, err := ms.Query(&storetypes.RequestQuery{Path: "/test/key", Data: []byte(key), Prove: true})
+	require.NoError(t, err, "Query(%q)", key)
+	require.NotNil(t, resp.ProofOps, "Query(%q) ProofOps", key)
+	return resp.ProofOps
+}
+
+func TestStoreProofKeyPath(t *testing.T) {
+	assert.Equal(t, "/test/%01%02", StoreProofKeyPath("test", []byte{1, 2}), "StoreProofKeyPath")
+}
+
+func TestVerifyStoreProof(t *testing.T) {
+	ms, appHash := newProofTestStore(t)
+	presentProof := queryProof(t, ms, "present")
+	absentProof := queryProof(t, ms, "absent")
+
+	tests := []struct {
+		name    string
+		proof   *cmtcrypto.ProofOps
+		appHash []byte
+		store   string
+		key     string
+		value   string
+		expErr  string
+	}{
+		{
+			name:    "value present",
+			proof:   presentProof,
+			appHash: appHash,
+			store:   "test",
+			key:     "present",
+			value:   "value",
+		},
+		{
+			name:    "value absent",
+			proof:   absentProof,
+			appHash: appHash,
+			store:   "test",
+			key:     "absent",
+		},
+		{
+			name:    "nil proof",
+			appHash: appHash,
+			store:   "test",
+			key:     "present",
+			value:   "value",
+			expErr:  "proof cannot be empty",
+		},
+		{
+			name:    "wrong value",
+			proof:   presentProof,
+			appHash: appHash,
+			store:   "test",
+			key:     "present",
+			value:   "other",
+			expErr:  "invalid proof of 70726573656E74 in the test store",
+		},
+		{
+			name:    "wrong app hash",
+			proof:   presentProof,
+			appHash: []byte("not the app hash"),
+			store:   "test",
+			key:     "present",
+			value:   "value",
+			expErr:  "invalid proof of 70726573656E74 in the test store",
+		},
+		{
+			name:    "wrong store",
+			proof:   presentProof,
+			appHash: appHash,
+			store:   "other",
+			key:     "present",
+			value:   "value",
+			expErr:  "invalid proof of 70726573656E74 in the other store",
+		},
+		{
+			name:    "absence proof of a present key",
+			proof:   presentProof,
+			appHash: appHash,
+			store:   "test",
+			key:     "present",
+			expErr:  "invalid proof of absence of 70726573656E74 from the test store",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var value []byte
+			if len(tc.value) > 0 {
+				value = []byte(tc.value)
+			}
+			err := VerifyStoreProof(tc.proof, tc.appHash, tc.store, []byte(tc.key), value)
+			if len(tc.expErr) > 0 {
+				assert.ErrorContains(t, err, tc.expErr, "VerifyStoreProof")
+			} else {
+				assert.NoError(t, err, "VerifyStoreProof")
+			}
+		})
+	}
+}
+
+func TestVerifyStoreProofInHeader(t *testing.T) {
+	ms, appHash := newProofTestStore(t)
+	proof := queryProof(t, ms, "present")
+	header := func(height int64) *cmtproto.SignedHeader {
+		return &cmtproto.SignedHeader{Header: &cmtproto.Header{Height: height, AppHash: appHash}}
+	}
+
+	tests := []struct {
+		name   string
+		header *cmtproto.SignedHeader
+		height int64
+		expErr string
+	}{
+		{
+			name:   "next block header",
+			header: header(6),
+			height: 5,
+		},
+		{
+			name:   "nil header",
+			height: 5,
+			expErr: "signed header cannot be empty",
+		},
+		{
+			name:   "nil inner header",
+			header: &cmtproto.SignedHeader{},
+			height: 5,
+			expErr: "signed header cannot be empty",
+		},
+		{
+			name:   "same block header",
+			header: header(5),
+			height: 5,
+			expErr: "signed header height 5 does not commit to the state at height 5: expected height 6",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := VerifyStoreProofInHeader(tc.header, tc.height, proof, "test", []byte("present"), []byte("value"))
+			if len(tc.expErr) > 0 {
+				assert.EqualError(t, err, tc.expErr, "VerifyStoreProofInHeader")
+			} else {
+				assert.NoError(t, err, "VerifyStoreProofInHeader")
+			}
+		})
+	}
+}
@@ -0,0 +1,41 @@
+package provwasm
+
+import (
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	provwasmtypes "github.com/provenance-io/provenance/x/wasm/types"
+)
+
+// MessageHandlerDecorator returns a wasm message handler decorator that only lets contracts send the any (stargate)
+// msgs that are allowed by the provwasm params. All other kinds of msgs are passed on as-is.
+func MessageHandlerDecorator(allowList AllowListKeeper) func(old wasmkeeper.Messenger) wasmkeeper.Messenger {
+	return func(old wasmkeeper.Messenger) wasmkeeper.Messenger {
+		return allowListMessenger{wrapped: old, allowList: allowList}
+	}
+}
+
+// allowListMessenger is a wasmkeeper.Messenger that checks any msgs against the allowed msgs and max msg size.
+type allowListMessenger struct {
+	wrapped   wasmkeeper.Messenger
+	allowList AllowListKeeper
+}
+
+var _ wasmkeeper.Messenger = allowListMessenger{}
+
+// DispatchMsg makes sure an any msg is allowed before dispatching it using the wrapped messenger.
+func (m allowListMessenger) DispatchMsg(ctx sdk.Context, contractAddr sdk.AccAddress, contractIBCPortID string, msg wasmvmtypes.CosmosMsg) ([]sdk.Event, [][]byte, [][]*codectypes.Any, error) {
+	if msg.Any != nil {
+		params := m.allowList.GetParams(ctx)
+		if !params.IsMsgAllowed(msg.Any.TypeURL) {
+			return nil, nil, nil, provwasmtypes.ErrMsgNotAllowed.Wrapf("%q", msg.Any.TypeURL)
+		}
+		if err := params.ValidateMsgSize(len(msg.Any.Value)); err != nil {
+			return nil, nil, nil, err
+		}
+	}
+	return m.wrapped.DispatchMsg(ctx, contractAddr, contractIBCPortID, msg)
+}
@@ -41,8 +41,13 @@ func (qr *QuerierRegistry) RegisterQuerier(route string, querier Querier) {
 	qr.queriers[route] = querier
 }
 
+// AllowListKeeper defines the functions needed to look up the governance-managed allow-lists and size limits.
+type AllowListKeeper interface {
+	GetParams(ctx sdk.Context) provwasmtypes.Params
+}
+
 // QueryPlugins provides provenance query support for smart contracts.
-func QueryPlugins(queryRouter baseapp.GRPCQueryRouter, cdc codec.Codec) *wasmkeeper.QueryPlugins {
+func QueryPlugins(queryRouter baseapp.GRPCQueryRouter, cdc codec.Codec, allowList AllowListKeeper) *wasmkeeper.QueryPlugins {
 	protoCdc, ok := cdc.(*codec.ProtoCodec)
 	if !ok {
 		panic(fmt.Errorf("codec must be *codec.ProtoCodec type: actual: %T", cdc))
@@ -51,28 +56,21 @@ func QueryPlugins(queryRouter baseapp.GRPCQueryRouter, cdc codec.Codec) *wasmkee
 	stargateCdc := codec.NewProtoCodec(provwasmtypes.NewWasmInterfaceRegistry(protoCdc.InterfaceRegistry()))
 
 	return &wasmkeeper.QueryPlugins{
-		Stargate: StargateQuerier(queryRouter, stargateCdc),
-		Grpc:     GrpcQuerier(queryRouter),
+		Stargate: StargateQuerier(queryRouter, stargateCdc, allowList),
+		Grpc:     GrpcQuerier(queryRouter, allowList),
 	}
 }
 
-// StargateQuerier dispatches whitelisted stargate queries
-func StargateQuerier(queryRouter baseapp.GRPCQueryRouter, cdc codec.Codec) func(ctx sdk.Context, request *wasmvmtypes.StargateQuery) ([]byte, error) {
+// StargateQuerier dispatches whitelisted and allowed stargate queries
+func StargateQuerier(queryRouter baseapp.GRPCQueryRouter, cdc codec.Codec, allowList AllowListKeeper) func(ctx sdk.Context, request *wasmvmtypes.StargateQuery) ([]byte, error) {
 	return func(ctx sdk.Context, request *wasmvmtypes.StargateQuery) ([]byte, error) {
-		protoResponseType, err := GetWhitelistedQuery(request.Path)
+		params := allowList.GetParams(ctx)
+		protoResponseType, err := GetAllowedQuery(params, request.Path)
 		if err != nil {
 			return nil, err
 		}
 
-		route := queryRouter.Route(request.Path)
-		if route == nil {
-			return nil, wasmvmtypes.UnsupportedRequest{Kind: fmt.Sprintf("No route to query '%s'", request.Path)}
-		}
-
-		res, err := route(ctx, &abci.RequestQuery{
-			Data: request.Data,
-			Path: request.Path,
-		})
+		res, err := routeAllowedQuery(ctx, queryRouter, params, request.Path, request.Data)
 		if err != nil {
 			return nil, err
 		}
@@ -86,29 +84,43 @@ func StargateQuerier(queryRouter baseapp.GRPCQueryRouter, cdc codec.Codec) func(
 	}
 }
 
-// GrpcQuerier dispatches whitelisted queries and returns protobuf encoded responses
-func GrpcQuerier(queryRouter baseapp.GRPCQueryRouter) func(ctx sdk.Context, request *wasmvmtypes.GrpcQuery) (proto.Message, error) {
+// GrpcQuerier dispatches whitelisted and allowed queries and returns protobuf encoded responses
+func GrpcQuerier(queryRouter baseapp.GRPCQueryRouter, allowList AllowListKeeper) func(ctx sdk.Context, request *wasmvmtypes.GrpcQuery) (proto.Message, error) {
 	return func(ctx sdk.Context, request *wasmvmtypes.GrpcQuery) (proto.Message, error) {
-		_, err := GetWhitelistedQuery(request.Path)
+		params := allowList.GetParams(ctx)
+		_, err := GetAllowedQuery(params, request.Path)
 		if err != nil {
 			return nil, err
 		}
 
-		route := queryRouter.Route(request.Path)
-		if route == nil {
-			return nil, wasmvmtypes.UnsupportedRequest{Kind: fmt.Sprintf("No route to query '%s'", request.Path)}
-		}
+		return routeAllowedQuery(ctx, queryRouter, params, request.Path, request.Data)
+	}
+}
 
-		res, err := route(ctx, &abci.RequestQuery{
-			Data: request.Data,
-			Path: request.Path,
-		})
-		if err != nil {
-			return nil, err
-		}
+// routeAllowedQuery runs the query at the provided path, making sure that the request and response sizes are within the limits.
+func routeAllowedQuery(ctx sdk.Context, queryRouter baseapp.GRPCQueryRouter, params provwasmtypes.Params, path string, data []byte) (*abci.ResponseQuery, error) {
+	if err := params.ValidateQueryRequestSize(len(data)); err != nil {
+		return nil, wasmvmtypes.UnsupportedRequest{Kind: err.Error()}
+	}
+
+	route := queryRouter.Route(path)
+	if route == nil {
+		return nil, wasmvmtypes.UnsupportedRequest{Kind: fmt.Sprintf("No route to query '%s'", path)}
+	}
 
-		return res, nil
+	res, err := route(ctx, &abci.RequestQuery{
+		Data: data,
+		Path: path,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err = params.ValidateQueryResponseSize(len(res.Value)); err != nil {
+		return nil, wasmvmtypes.UnsupportedRequest{Kind: err.Error()}
 	}
+
+	return res, nil
 }
 
 // ConvertProtoToJsonMarshal  unmarshals the given bytes into a proto message and then marshals it to json.
@@ -0,0 +1,121 @@
+package provwasm
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	nametypes "github.com/provenance-io/provenance/x/name/types"
+	provwasmtypes "github.com/provenance-io/provenance/x/wasm/types"
+)
+
+func TestGetAllowedQuery(t *testing.T) {
+	params := provwasmtypes.NewParams([]string{"/provenance.name.v1.Query/NameStats", "/provenance.foo.*"}, nil, 0, 0, 0)
+
+	tests := []struct {
+		name   string
+		path   string
+		exp    interface{}
+		expErr string
+	}{
+		{
+			name: "whitelisted by the chain",
+			path: "/provenance.name.v1.Query/Resolve",
+			exp:  &nametypes.QueryResolveResponse{},
+		},
+		{
+			name: "allowed by params",
+			path: "/provenance.name.v1.Query/NameStats",
+			exp:  &nametypes.QueryNameStatsResponse{},
+		},
+		{
+			name:   "not allowed",
+			path:   "/provenance.name.v1.Query/PendingDeletions",
+			expErr: "unsupported request: '/provenance.name.v1.Query/PendingDeletions' path is not allowed from the contract",
+		},
+		{
+			name:   "allowed but unknown service",
+			path:   "/provenance.foo.v1.Query/Bar",
+			expErr: "unsupported request: No route to query '/provenance.foo.v1.Query/Bar'",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			resp, err := GetAllowedQuery(params, tc.path)
+			if len(tc.expErr) > 0 {
+				assert.EqualError(t, err, tc.expErr, "GetAllowedQuery error")
+				return
+			}
+			require.NoError(t, err, "GetAllowedQuery error")
+			assert.Equal(t, tc.exp, resp, "GetAllowedQuery result")
+		})
+	}
+}
+
+// staticAllowList is an AllowListKeeper that always returns the same params.
+type staticAllowList provwasmtypes.Params
+
+func (s staticAllowList) GetParams(_ sdk.Context) provwasmtypes.Params {
+	return provwasmtypes.Params(s)
+}
+
+// countingMessenger is a wasmkeeper.Messenger that counts the msgs dispatched to it.
+type countingMessenger struct {
+	count int
+}
+
+func (m *countingMessenger) DispatchMsg(_ sdk.Context, _ sdk.AccAddress, _ string, _ wasmvmtypes.CosmosMsg) ([]sdk.Event, [][]byte, [][]*codectypes.Any, error) {
+	m.count++
+	return nil, nil, nil, nil
+}
+
+func TestMessageHandlerDecorator(t *testing.T) {
+	allowList := staticAllowList(provwasmtypes.NewParams(nil, []string{"/provenance.*"}, 0, 0, 4))
+
+	tests := []struct {
+		name   string
+		msg    wasmvmtypes.CosmosMsg
+		expErr string
+	}{
+		{
+			name: "allowed any msg",
+			msg:  wasmvmtypes.CosmosMsg{Any: &wasmvmtypes.AnyMsg{TypeURL: "/provenance.name.v1.MsgBindNameRequest", Value: []byte{1, 2, 3, 4}}},
+		},
+		{
+			name:   "any msg not allowed",
+			msg:    wasmvmtypes.CosmosMsg{Any: &wasmvmtypes.AnyMsg{TypeURL: "/cosmos.bank.v1beta1.MsgSend"}},
+			expErr: `"/cosmos.bank.v1beta1.MsgSend": msg not allowed from a contract`,
+		},
+		{
+			name:   "any msg too large",
+			msg:    wasmvmtypes.CosmosMsg{Any: &wasmvmtypes.AnyMsg{TypeURL: "/provenance.name.v1.MsgBindNameRequest", Value: []byte{1, 2, 3, 4, 5}}},
+			expErr: "msg size 5 exceeds the maximum of 4 bytes: msg too large",
+		},
+		{
+			name: "not an any msg",
+			msg:  wasmvmtypes.CosmosMsg{Bank: &wasmvmtypes.BankMsg{Send: &wasmvmtypes.SendMsg{ToAddress: "addr"}}},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			wrapped := &countingMessenger{}
+			messenger := MessageHandlerDecorator(allowList)(wrapped)
+			_, _, _, err := messenger.DispatchMsg(sdk.Context{}, nil, "", tc.msg)
+			if len(tc.expErr) > 0 {
+				assert.EqualError(t, err, tc.expErr, "DispatchMsg error")
+				assert.Equal(t, 0, wrapped.count, "number of msgs dispatched")
+				return
+			}
+			assert.NoError(t, err, "DispatchMsg error")
+			assert.Equal(t, 1, wrapped.count, "number of msgs dispatched")
+		})
+	}
+}
@@ -2,6 +2,8 @@ package provwasm
 
 import (
 	"fmt"
+	"reflect"
+	"strings"
 	"sync"
 
 	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
@@ -25,6 +27,7 @@ import (
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/cosmos/gogoproto/proto"
 	ibctransfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	"google.golang.org/protobuf/reflect/protoreflect"
 
 	attributetypes "github.com/provenance-io/provenance/x/attribute/types"
 	"github.com/provenance-io/provenance/x/exchange"
@@ -39,6 +42,7 @@ import (
 	"github.com/provenance-io/provenance/x/quarantine"
 	"github.com/provenance-io/provenance/x/sanction"
 	triggertypes "github.com/provenance-io/provenance/x/trigger/types"
+	provwasmtypes "github.com/provenance-io/provenance/x/wasm/types"
 )
 
 // stargateWhitelist keeps whitelist and its deterministic
@@ -326,6 +330,47 @@ func GetWhitelistedQuery(queryPath string) (proto.Message, error) {
 	return protoResponseType, nil
 }
 
+// GetAllowedQuery returns a new response message for the query at the provided path.
+// The query must either be whitelisted by the chain, or be in the allowed queries of the provided params.
+func GetAllowedQuery(params provwasmtypes.Params, queryPath string) (proto.Message, error) {
+	if _, isWhitelisted := stargateWhitelist.Load(queryPath); isWhitelisted {
+		return GetWhitelistedQuery(queryPath)
+	}
+	if !params.IsQueryAllowed(queryPath) {
+		return nil, wasmvmtypes.UnsupportedRequest{Kind: fmt.Sprintf("'%s' path is not allowed from the contract", queryPath)}
+	}
+	return newQueryResponse(queryPath)
+}
+
+// newQueryResponse uses the proto registry to create a new response message for the query at the provided path.
+func newQueryResponse(queryPath string) (proto.Message, error) {
+	service, method, ok := strings.Cut(strings.TrimPrefix(queryPath, "/"), "/")
+	if !ok {
+		return nil, wasmvmtypes.UnsupportedRequest{Kind: fmt.Sprintf("'%s' is not a valid query path", queryPath)}
+	}
+	desc, err := proto.HybridResolver.FindDescriptorByName(protoreflect.FullName(service))
+	if err != nil {
+		return nil, wasmvmtypes.UnsupportedRequest{Kind: fmt.Sprintf("No route to query '%s'", queryPath)}
+	}
+	serviceDesc, ok := desc.(protoreflect.ServiceDescriptor)
+	if !ok {
+		return nil, wasmvmtypes.UnsupportedRequest{Kind: fmt.Sprintf("No route to query '%s'", queryPath)}
+	}
+	methodDesc := serviceDesc.Methods().ByName(protoreflect.Name(method))
+	if methodDesc == nil {
+		return nil, wasmvmtypes.UnsupportedRequest{Kind: fmt.Sprintf("No route to query '%s'", queryPath)}
+	}
+	responseType := proto.MessageType(string(methodDesc.Output().FullName()))
+	if responseType == nil {
+		return nil, wasmvmtypes.Unknown{}
+	}
+	protoResponseType, ok := reflect.New(responseType.Elem()).Interface().(proto.Message)
+	if !ok {
+		return nil, wasmvmtypes.Unknown{}
+	}
+	return protoResponseType, nil
+}
+
 func setWhitelistedQuery(queryPath string, protoType proto.Message) {
 	stargateWhitelist.Store(queryPath, protoType)
 }
@@ -0,0 +1,223 @@
+// Package querylimit limits the rate of requests to the provenance gRPC query services, and logs the slow ones.
+//
+// It's configured using the [query-limits] section of app.toml (or custom.toml). It only applies to requests
+// made to the node's gRPC server (and the gRPC gateway, which uses it). Queries made through ABCI, or
+// by smart contracts, are not limited since they need to act the same on every node.
+package querylimit
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cast"
+
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+)
+
+// These are the app options (i.e. app.toml fields) used to configure the query limits.
+const (
+	// FlagEnabled is whether the query limits and slow-query logging are used at all.
+	FlagEnabled = "query-limits.enabled"
+	// FlagDefaultRate is the number of requests per second allowed to each endpoint that doesn't have its own limit.
+	FlagDefaultRate = "query-limits.default-rate"
+	// FlagDefaultBurst is the number of requests that can be made at once to each endpoint that doesn't have its own limit.
+	FlagDefaultBurst = "query-limits.default-burst"
+	// FlagEndpoints is a list of endpoint specific limits, each with the format "<full method>=<rate>[:<burst>]".
+	FlagEndpoints = "query-limits.endpoints"
+	// FlagSlowQueryThreshold is how long a query can take before it is logged as slow.
+	FlagSlowQueryThreshold = "query-limits.slow-query-threshold"
+)
+
+// Limit is the rate limit for an endpoint.
+type Limit struct {
+	// Rate is the number of requests per second allowed. Zero means there is no limit.
+	Rate float64
+	// Burst is the number of requests that can be made at once. Zero means the rate (rounded up).
+	Burst int
+}
+
+// IsUnlimited returns true if this limit does not restrict anything.
+func (l Limit) IsUnlimited() bool {
+	return l.Rate == 0
+}
+
+// GetBurst returns the number of requests that can be made at once.
+// If a burst isn't defined, it's the rate rounded up, so that any rate allows at least one request.
+func (l Limit) GetBurst() int {
+	if l.Burst > 0 {
+		return l.Burst
+	}
+	return int(math.Ceil(l.Rate))
+}
+
+// String returns the "<rate>[:<burst>]" representation of this limit.
+func (l Limit) String() string {
+	rv := strconv.FormatFloat(l.Rate, 'f', -1, 64)
+	if l.Burst > 0 {
+		rv += ":" + strconv.Itoa(l.Burst)
+	}
+	return rv
+}
+
+// Validate returns an error if this limit has a negative rate or burst.
+func (l Limit) Validate() error {
+	if l.Rate < 0 || math.IsNaN(l.Rate) || math.IsInf(l.Rate, 0) {
+		return fmt.Errorf("invalid rate %v: must be a finite number that is zero or more", l.Rate)
+	}
+	if l.Burst < 0 {
+		return fmt.Errorf("invalid burst %d: cannot be negative", l.Burst)
+	}
+	return nil
+}
+
+// Config is the configuration of the query limits.
+type Config struct {
+	// Enabled is whether the query limits and slow-query logging are used at all.
+	Enabled bool
+	// Default is the limit for each endpoint that doesn't have its own.
+	Default Limit
+	// Endpoints are the limits of specific endpoints, keyed by full method name, e.g. "/provenance.metadata.v1.Query/Scope".
+	Endpoints map[string]Limit
+	// SlowQueryThreshold is how long a query can take before it is logged as slow. Zero turns off slow-query logging.
+	SlowQueryThreshold time.Duration
+}
+
+// DefaultConfig returns the default query limits config, which has everything turned off.
+func DefaultConfig() Config {
+	return Config{}
+}
+
+// LimitFor returns the limit that applies to the provided full method name.
+func (c Config) LimitFor(fullMethod string) Limit {
+	if limit, ok := c.Endpoints[fullMethod]; ok {
+		return limit
+	}
+	return c.Default
+}
+
+// Validate returns an error if there's anything wrong with this config.
+func (c Config) Validate() error {
+	var errs []error
+	if err := c.Default.Validate(); err != nil {
+		errs = append(errs, fmt.Errorf("default limit: %w", err))
+	}
+	methods := make([]string, 0, len(c.Endpoints))
+	for method := range c.Endpoints {
+		methods = append(methods, method)
+	}
+	sort.Strings(methods)
+	for _, method := range methods {
+		if err := validateFullMethod(method); err != nil {
+			errs = append(errs, err)
+		}
+		if err := c.Endpoints[method].Validate(); err != nil {
+			errs = append(errs, fmt.Errorf("endpoint %s limit: %w", method, err))
+		}
+	}
+	if c.SlowQueryThreshold < 0 {
+		errs = append(errs, fmt.Errorf("invalid slow query threshold %s: cannot be negative", c.SlowQueryThreshold))
+	}
+	return errors.Join(errs...)
+}
+
+// validateFullMethod returns an error if the provided string is not the full method name of a provenance query endpoint.
+func validateFullMethod(fullMethod string) error {
+	parts := strings.Split(fullMethod, "/")
+	if len(parts) != 3 || len(parts[0]) != 0 || len(parts[2]) == 0 || !IsLimitedService(parts[1]) {
+		return fmt.Errorf("invalid endpoint %q: must have the format /provenance.<module>.<version>.Query/<method>", fullMethod)
+	}
+	return nil
+}
+
+// ParseLimit parses a "<rate>[:<burst>]" string into a Limit.
+func ParseLimit(str string) (Limit, error) {
+	rateStr, burstStr, hasBurst := strings.Cut(strings.TrimSpace(str), ":")
+	var rv Limit
+	var err error
+	rv.Rate, err = strconv.ParseFloat(strings.TrimSpace(rateStr), 64)
+	if err != nil {
+		return Limit{}, fmt.Errorf("invalid rate %q: %w", rateStr, err)
+	}
+	if hasBurst {
+		rv.Burst, err = strconv.Atoi(strings.TrimSpace(burstStr))
+		if err != nil {
+			return Limit{}, fmt.Errorf("invalid burst %q: %w", burstStr, err)
+		}
+	}
+	return rv, rv.Validate()
+}
+
+// ParseEndpointLimit parses a "<full method>=<rate>[:<burst>]" string into the full method name and its limit.
+func ParseEndpointLimit(str string) (string, Limit, error) {
+	method, limitStr, ok := strings.Cut(str, "=")
+	if !ok {
+		return "", Limit{}, fmt.Errorf("invalid endpoint limit %q: must have the format <full method>=<rate>[:<burst>]", str)
+	}
+	method = strings.TrimSpace(method)
+	if err := validateFullMethod(method); err != nil {
+		return "", Limit{}, err
+	}
+	limit, err := ParseLimit(limitStr)
+	if err != nil {
+		return "", Limit{}, fmt.Errorf("invalid endpoint limit %q: %w", str, err)
+	}
+	return method, limit, nil
+}
+
+// ConfigFromAppOptions reads the query limits config from the provided app options.
+func ConfigFromAppOptions(appOpts servertypes.AppOptions) (Config, error) {
+	rv := DefaultConfig()
+	if appOpts == nil {
+		return rv, nil
+	}
+
+	var err error
+	if rv.Enabled, err = cast.ToBoolE(valueOr(appOpts.Get(FlagEnabled), false)); err != nil {
+		return rv, fmt.Errorf("invalid %s: %w", FlagEnabled, err)
+	}
+	if rv.Default.Rate, err = cast.ToFloat64E(valueOr(appOpts.Get(FlagDefaultRate), 0)); err != nil {
+		return rv, fmt.Errorf("invalid %s: %w", FlagDefaultRate, err)
+	}
+	if rv.Default.Burst, err = cast.ToIntE(valueOr(appOpts.Get(FlagDefaultBurst), 0)); err != nil {
+		return rv, fmt.Errorf("invalid %s: %w", FlagDefaultBurst, err)
+	}
+	if rv.SlowQueryThreshold, err = cast.ToDurationE(valueOr(appOpts.Get(FlagSlowQueryThreshold), "0s")); err != nil {
+		return rv, fmt.Errorf("invalid %s: %w", FlagSlowQueryThreshold, err)
+	}
+
+	endpoints, err := cast.ToStringSliceE(valueOr(appOpts.Get(FlagEndpoints), []string{}))
+	if err != nil {
+		return rv, fmt.Errorf("invalid %s: %w", FlagEndpoints, err)
+	}
+	for _, entry := range endpoints {
+		if len(strings.TrimSpace(entry)) == 0 {
+			continue
+		}
+		method, limit, err := ParseEndpointLimit(entry)
+		if err != nil {
+			return rv, fmt.Errorf("invalid %s: %w", FlagEndpoints, err)
+		}
+		if _, dup := rv.Endpoints[method]; dup {
+			return rv, fmt.Errorf("invalid %s: duplicate endpoint %s", FlagEndpoints, method)
+		}
+		if rv.Endpoints == nil {
+			rv.Endpoints = make(map[string]Limit)
+		}
+		rv.Endpoints[method] = limit
+	}
+
+	return rv, rv.Validate()
+}
+
+// valueOr returns the value, or the default if the value is nil.
+func valueOr(value, def interface{}) interface{} {
+	if value == nil {
+		return def
+	}
+	return value
+}
@@ -0,0 +1,225 @@
+package querylimit_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+
+	"github.com/provenance-io/provenance/internal/querylimit"
+)
+
+func TestParseLimit(t *testing.T) {
+	tests := []struct {
+		name   string
+		str    string
+		exp    querylimit.Limit
+		expErr string
+	}{
+		{name: "rate only", str: "5", exp: querylimit.Limit{Rate: 5}},
+		{name: "fractional rate", str: "0.5", exp: querylimit.Limit{Rate: 0.5}},
+		{name: "rate and burst", str: " 2.5 : 10 ", exp: querylimit.Limit{Rate: 2.5, Burst: 10}},
+		{name: "zero", str: "0", exp: querylimit.Limit{}},
+		{name: "empty", str: "", expErr: `invalid rate "": strconv.ParseFloat: parsing "": invalid syntax`},
+		{name: "bad rate", str: "fast", expErr: `invalid rate "fast": strconv.ParseFloat: parsing "fast": invalid syntax`},
+		{name: "bad burst", str: "5:lots", expErr: `invalid burst "lots": strconv.Atoi: parsing "lots": invalid syntax`},
+		{name: "negative rate", str: "-1", expErr: "invalid rate -1: must be a finite number that is zero or more"},
+		{name: "negative burst", str: "1:-1", expErr: "invalid burst -1: cannot be negative"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			limit, err := querylimit.ParseLimit(tc.str)
+			if len(tc.expErr) > 0 {
+				assert.EqualError(t, err, tc.expErr, "ParseLimit(%q) error", tc.str)
+				return
+			}
+			require.NoError(t, err, "ParseLimit(%q) error", tc.str)
+			assert.Equal(t, tc.exp, limit, "ParseLimit(%q) result", tc.str)
+		})
+	}
+}
+
+func TestLimitGetBurst(t *testing.T) {
+	tests := []struct {
+		limit querylimit.Limit
+		exp   int
+	}{
+		{limit: querylimit.Limit{Rate: 0.25}, exp: 1},
+		{limit: querylimit.Limit{Rate: 3}, exp: 3},
+		{limit: querylimit.Limit{Rate: 3.5}, exp: 4},
+		{limit: querylimit.Limit{Rate: 3, Burst: 10}, exp: 10},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.limit.String(), func(t *testing.T) {
+			assert.Equal(t, tc.exp, tc.limit.GetBurst(), "GetBurst")
+		})
+	}
+}
+
+func TestParseEndpointLimit(t *testing.T) {
+	tests := []struct {
+		name      string
+		str       string
+		expMethod string
+		expLimit  querylimit.Limit
+		expErr    string
+	}{
+		{
+			name:      "valid",
+			str:       "/provenance.metadata.v1.Query/Scope=5:10",
+			expMethod: "/provenance.metadata.v1.Query/Scope",
+			expLimit:  querylimit.Limit{Rate: 5, Burst: 10},
+		},
+		{
+			name:      "spaces",
+			str:       " /provenance.name.v1.Query/Resolve = 20 ",
+			expMethod: "/provenance.name.v1.Query/Resolve",
+			expLimit:  querylimit.Limit{Rate: 20},
+		},
+		{
+			name:   "no limit",
+			str:    "/provenance.metadata.v1.Query/Scope",
+			expErr: `invalid endpoint limit "/provenance.metadata.v1.Query/Scope": must have the format <full method>=<rate>[:<burst>]`,
+		},
+		{
+			name:   "no leading slash",
+			str:    "provenance.metadata.v1.Query/Scope=5",
+			expErr: `invalid endpoint "provenance.metadata.v1.Query/Scope": must have the format /provenance.<module>.<version>.Query/<method>`,
+		},
+		{
+			name:   "not a provenance service",
+			str:    "/cosmos.bank.v1beta1.Query/Balance=5",
+			expErr: `invalid endpoint "/cosmos.bank.v1beta1.Query/Balance": must have the format /provenance.<module>.<version>.Query/<method>`,
+		},
+		{
+			name:   "not a query service",
+			str:    "/provenance.metadata.v1.Msg/WriteScope=5",
+			expErr: `invalid endpoint "/provenance.metadata.v1.Msg/WriteScope": must have the format /provenance.<module>.<version>.Query/<method>`,
+		},
+		{
+			name:   "no method",
+			str:    "/provenance.metadata.v1.Query/=5",
+			expErr: `invalid endpoint "/provenance.metadata.v1.Query/": must have the format /provenance.<module>.<version>.Query/<method>`,
+		},
+		{
+			name:   "bad limit",
+			str:    "/provenance.metadata.v1.Query/Scope=-5",
+			expErr: `invalid endpoint limit "/provenance.metadata.v1.Query/Scope=-5": invalid rate -5: must be a finite number that is zero or more`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			method, limit, err := querylimit.ParseEndpointLimit(tc.str)
+			if len(tc.expErr) > 0 {
+				assert.EqualError(t, err, tc.expErr, "ParseEndpointLimit(%q) error", tc.str)
+				return
+			}
+			require.NoError(t, err, "ParseEndpointLimit(%q) error", tc.str)
+			assert.Equal(t, tc.expMethod, method, "ParseEndpointLimit(%q) method", tc.str)
+			assert.Equal(t, tc.expLimit, limit, "ParseEndpointLimit(%q) limit", tc.str)
+		})
+	}
+}
+
+func TestConfigFromAppOptions(t *testing.T) {
+	tests := []struct {
+		name    string
+		appOpts simtestutil.AppOptionsMap
+		exp     querylimit.Config
+		expErr  string
+	}{
+		{
+			name:    "nothing set",
+			appOpts: simtestutil.AppOptionsMap{},
+			exp:     querylimit.DefaultConfig(),
+		},
+		{
+			name: "everything set",
+			appOpts: simtestutil.AppOptionsMap{
+				querylimit.FlagEnabled:            true,
+				querylimit.FlagDefaultRate:        "50",
+				querylimit.FlagDefaultBurst:       100,
+				querylimit.FlagSlowQueryThreshold: "2s",
+				querylimit.FlagEndpoints: []interface{}{
+					"/provenance.metadata.v1.Query/Scope=5:10",
+					"",
+					"/provenance.metadata.v1.Query/ScopesAll=0.5",
+				},
+			},
+			exp: querylimit.Config{
+				Enabled: true,
+				Default: querylimit.Limit{Rate: 50, Burst: 100},
+				Endpoints: map[string]querylimit.Limit{
+					"/provenance.metadata.v1.Query/Scope":     {Rate: 5, Burst: 10},
+					"/provenance.metadata.v1.Query/ScopesAll": {Rate: 0.5},
+				},
+				SlowQueryThreshold: 2 * time.Second,
+			},
+		},
+		{
+			name:    "bad enabled",
+			appOpts: simtestutil.AppOptionsMap{querylimit.FlagEnabled: "maybe"},
+			expErr:  `invalid query-limits.enabled: strconv.ParseBool: parsing "maybe": invalid syntax`,
+		},
+		{
+			name:    "bad default rate",
+			appOpts: simtestutil.AppOptionsMap{querylimit.FlagDefaultRate: "fast"},
+			expErr:  `invalid query-limits.default-rate: unable to cast "fast" of type string to float64`,
+		},
+		{
+			name:    "negative default rate",
+			appOpts: simtestutil.AppOptionsMap{querylimit.FlagDefaultRate: -3},
+			expErr:  "default limit: invalid rate -3: must be a finite number that is zero or more",
+		},
+		{
+			name:    "bad slow query threshold",
+			appOpts: simtestutil.AppOptionsMap{querylimit.FlagSlowQueryThreshold: "slow"},
+			expErr:  `invalid query-limits.slow-query-threshold: time: invalid duration "slow"`,
+		},
+		{
+			name:    "negative slow query threshold",
+			appOpts: simtestutil.AppOptionsMap{querylimit.FlagSlowQueryThreshold: "-1s"},
+			expErr:  "invalid slow query threshold -1s: cannot be negative",
+		},
+		{
+			name:    "bad endpoint",
+			appOpts: simtestutil.AppOptionsMap{querylimit.FlagEndpoints: []string{"/provenance.metadata.v1.Query/Scope"}},
+			expErr:  `invalid query-limits.endpoints: invalid endpoint limit "/provenance.metadata.v1.Query/Scope": must have the format <full method>=<rate>[:<burst>]`,
+		},
+		{
+			name: "duplicate endpoint",
+			appOpts: simtestutil.AppOptionsMap{querylimit.FlagEndpoints: []string{
+				"/provenance.metadata.v1.Query/Scope=5",
+				"/provenance.metadata.v1.Query/Scope=6",
+			}},
+			expErr: "invalid query-limits.endpoints: duplicate endpoint /provenance.metadata.v1.Query/Scope",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg, err := querylimit.ConfigFromAppOptions(tc.appOpts)
+			if len(tc.expErr) > 0 {
+				assert.EqualError(t, err, tc.expErr, "ConfigFromAppOptions error")
+				return
+			}
+			require.NoError(t, err, "ConfigFromAppOptions error")
+			assert.Equal(t, tc.exp, cfg, "ConfigFromAppOptions result")
+		})
+	}
+}
+
+func TestConfigLimitFor(t *testing.T) {
+	cfg := querylimit.Config{
+		Default:   querylimit.Limit{Rate: 50},
+		Endpoints: map[string]querylimit.Limit{"/provenance.metadata.v1.Query/Scope": {Rate: 5}},
+	}
+	assert.Equal(t, querylimit.Limit{Rate: 5}, cfg.LimitFor("/provenance.metadata.v1.Query/Scope"), "LimitFor(Scope)")
+	assert.Equal(t, querylimit.Limit{Rate: 50}, cfg.LimitFor("/provenance.metadata.v1.Query/Record"), "LimitFor(Record)")
+}
@@ -0,0 +1,91 @@
+package querylimit
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"golang.org/x/time/rate"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"cosmossdk.io/log"
+
+	gogogrpc "github.com/cosmos/gogoproto/grpc"
+)
+
+// IsLimitedService returns true if the provided gRPC service is one of the provenance query services.
+func IsLimitedService(serviceName string) bool {
+	return strings.HasPrefix(serviceName, "provenance.") && strings.HasSuffix(serviceName, ".Query")
+}
+
+// grpcServer is a gogogrpc.Server that applies the query limits to the provenance query services registered with it.
+type grpcServer struct {
+	gogogrpc.Server
+
+	config Config
+	logger log.Logger
+}
+
+var _ gogogrpc.Server = (*grpcServer)(nil)
+
+// NewGRPCServer wraps the provided server so that requests to the provenance query services are rate limited
+// and slow ones are logged. If the config isn't enabled, the server is returned unchanged.
+func NewGRPCServer(server gogogrpc.Server, config Config, logger log.Logger) gogogrpc.Server {
+	if !config.Enabled {
+		return server
+	}
+	return &grpcServer{Server: server, config: config, logger: logger.With("module", "query-limits")}
+}
+
+// RegisterService registers the service with the underlying server.
+// If it's a provenance query service, each of its method handlers is wrapped to apply the query limits.
+func (s *grpcServer) RegisterService(sd *grpc.ServiceDesc, ss interface{}) {
+	if !IsLimitedService(sd.ServiceName) {
+		s.Server.RegisterService(sd, ss)
+		return
+	}
+
+	methods := make([]grpc.MethodDesc, len(sd.Methods))
+	for i, method := range sd.Methods {
+		methods[i] = grpc.MethodDesc{
+			MethodName: method.MethodName,
+			Handler:    s.wrapHandler("/"+sd.ServiceName+"/"+method.MethodName, method.Handler),
+		}
+	}
+
+	s.Server.RegisterService(&grpc.ServiceDesc{
+		ServiceName: sd.ServiceName,
+		HandlerType: sd.HandlerType,
+		Methods:     methods,
+		Streams:     sd.Streams,
+		Metadata:    sd.Metadata,
+	}, ss)
+}
+
+// wrapHandler wraps a method handler so that requests beyond the endpoint's rate limit are rejected,
+// and requests that take longer than the slow-query threshold are logged.
+func (s *grpcServer) wrapHandler(fullMethod string, handler grpc.MethodHandler) grpc.MethodHandler {
+	var limiter *rate.Limiter
+	if limit := s.config.LimitFor(fullMethod); !limit.IsUnlimited() {
+		limiter = rate.NewLimiter(rate.Limit(limit.Rate), limit.GetBurst())
+	}
+	threshold := s.config.SlowQueryThreshold
+
+	return func(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+		if limiter != nil && !limiter.Allow() {
+			return nil, status.Errorf(codes.ResourceExhausted, "rate limit exceeded for %s, try again later", fullMethod)
+		}
+		if threshold == 0 {
+			return handler(srv, ctx, dec, interceptor)
+		}
+
+		start := time.Now()
+		resp, err := handler(srv, ctx, dec, interceptor)
+		if elapsed := time.Since(start); elapsed >= threshold {
+			s.logger.Warn("Slow query.", "method", fullMethod, "duration", elapsed.String(), "failed", err != nil)
+		}
+		return resp, err
+	}
+}
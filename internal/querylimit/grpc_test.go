@@ -0,0 +1,137 @@
+package querylimit_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"cosmossdk.io/log"
+
+	"github.com/provenance-io/provenance/internal/querylimit"
+)
+
+// mockServer is a gogogrpc.Server that records the services registered with it.
+type mockServer struct {
+	descs []*grpc.ServiceDesc
+}
+
+func (s *mockServer) RegisterService(sd *grpc.ServiceDesc, _ interface{}) {
+	s.descs = append(s.descs, sd)
+}
+
+// newMethod creates a method that waits for the provided duration, then returns "response".
+func newMethod(name string, delay time.Duration) grpc.MethodDesc {
+	return grpc.MethodDesc{
+		MethodName: name,
+		Handler: func(interface{}, context.Context, func(interface{}) error, grpc.UnaryServerInterceptor) (interface{}, error) {
+			time.Sleep(delay)
+			return "response", nil
+		},
+	}
+}
+
+func TestIsLimitedService(t *testing.T) {
+	tests := []struct {
+		name string
+		exp  bool
+	}{
+		{name: "provenance.metadata.v1.Query", exp: true},
+		{name: "provenance.name.v1.Query", exp: true},
+		{name: "provenance.metadata.v1.Msg", exp: false},
+		{name: "cosmos.bank.v1beta1.Query", exp: false},
+		{name: "", exp: false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.exp, querylimit.IsLimitedService(tc.name), "IsLimitedService(%q)", tc.name)
+		})
+	}
+}
+
+func TestNewGRPCServerDisabled(t *testing.T) {
+	mock := &mockServer{}
+	server := querylimit.NewGRPCServer(mock, querylimit.Config{Default: querylimit.Limit{Rate: 1}}, log.NewNopLogger())
+	assert.Same(t, mock, server, "NewGRPCServer result when not enabled")
+}
+
+func TestNewGRPCServerRateLimits(t *testing.T) {
+	cfg := querylimit.Config{
+		Enabled:   true,
+		Default:   querylimit.Limit{Rate: 0.001, Burst: 2},
+		Endpoints: map[string]querylimit.Limit{"/provenance.metadata.v1.Query/Unlimited": {}},
+	}
+	mock := &mockServer{}
+	server := querylimit.NewGRPCServer(mock, cfg, log.NewNopLogger())
+	server.RegisterService(&grpc.ServiceDesc{
+		ServiceName: "provenance.metadata.v1.Query",
+		Methods:     []grpc.MethodDesc{newMethod("Scope", 0), newMethod("Record", 0), newMethod("Unlimited", 0)},
+	}, nil)
+	server.RegisterService(&grpc.ServiceDesc{
+		ServiceName: "cosmos.bank.v1beta1.Query",
+		Methods:     []grpc.MethodDesc{newMethod("Balance", 0)},
+	}, nil)
+	require.Len(t, mock.descs, 2, "registered services")
+	require.Len(t, mock.descs[0].Methods, 3, "provenance service methods")
+	require.Len(t, mock.descs[1].Methods, 1, "cosmos service methods")
+
+	call := func(method grpc.MethodDesc) error {
+		_, err := method.Handler(nil, context.Background(), nil, nil)
+		return err
+	}
+
+	scope, record, unlimited := mock.descs[0].Methods[0], mock.descs[0].Methods[1], mock.descs[0].Methods[2]
+	assert.NoError(t, call(scope), "Scope call 1")
+	assert.NoError(t, call(scope), "Scope call 2")
+	err := call(scope)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err), "Scope call 3 status code")
+	assert.EqualError(t, err, "rpc error: code = ResourceExhausted desc = rate limit exceeded for "+
+		"/provenance.metadata.v1.Query/Scope, try again later", "Scope call 3 error")
+
+	// Each endpoint has its own limit, so Record still has its whole burst available.
+	assert.NoError(t, call(record), "Record call 1")
+	assert.NoError(t, call(record), "Record call 2")
+	assert.Error(t, call(record), "Record call 3")
+
+	for i := 1; i <= 10; i++ {
+		assert.NoError(t, call(unlimited), "Unlimited call %d", i)
+	}
+
+	// Services other than the provenance query services are not wrapped.
+	for i := 1; i <= 10; i++ {
+		assert.NoError(t, call(mock.descs[1].Methods[0]), "Balance call %d", i)
+	}
+}
+
+func TestNewGRPCServerSlowQueries(t *testing.T) {
+	var buf bytes.Buffer
+	logger := log.NewLogger(&buf, log.ColorOption(false))
+	cfg := querylimit.Config{Enabled: true, SlowQueryThreshold: 20 * time.Millisecond}
+	mock := &mockServer{}
+	server := querylimit.NewGRPCServer(mock, cfg, logger)
+	server.RegisterService(&grpc.ServiceDesc{
+		ServiceName: "provenance.metadata.v1.Query",
+		Methods:     []grpc.MethodDesc{newMethod("Fast", 0), newMethod("Slow", 30*time.Millisecond)},
+	}, nil)
+	require.Len(t, mock.descs, 1, "registered services")
+
+	resp, err := mock.descs[0].Methods[0].Handler(nil, context.Background(), nil, nil)
+	require.NoError(t, err, "Fast call")
+	assert.Equal(t, "response", resp, "Fast response")
+	assert.Empty(t, buf.String(), "logged after Fast call")
+
+	resp, err = mock.descs[0].Methods[1].Handler(nil, context.Background(), nil, nil)
+	require.NoError(t, err, "Slow call")
+	assert.Equal(t, "response", resp, "Slow response")
+	logged := buf.String()
+	assert.Contains(t, logged, "Slow query.", "logged after Slow call")
+	assert.Contains(t, logged, "method=/provenance.metadata.v1.Query/Slow", "logged after Slow call")
+	assert.Contains(t, logged, "module=query-limits", "logged after Slow call")
+}
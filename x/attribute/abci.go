@@ -13,6 +13,7 @@ const MaxExpiredAttributionCount = 100_000
 
 // BeginBlocker is called at the beginning of every block
 func BeginBlocker(ctx sdk.Context, keeper keeper.Keeper) {
+	keeper.EmitExpiringAttributeNotices(ctx, MaxExpiredAttributionCount)
 	deleted := keeper.DeleteExpiredAttributes(ctx, MaxExpiredAttributionCount)
 	if deleted > 0 {
 		ctx.EventManager().EmitEvent(
@@ -361,7 +361,7 @@ func (s *IntegrationTestSuite) TestGetAccountAttributeCmd() {
 		{
 			name:           "should get attribute by name with json output",
 			args:           []string{s.account1Addr.String(), "example.attribute", fmt.Sprintf("--%s=json", cmtcli.OutputFlag)},
-			expectedOutput: fmt.Sprintf(`{"account":"%s","attributes":[{"name":"example.attribute","value":"ZXhhbXBsZSBhdHRyaWJ1dGUgdmFsdWUgc3RyaW5n","attribute_type":"ATTRIBUTE_TYPE_STRING","address":"%s","expiration_date":null}],"pagination":{"next_key":null,"total":"0"}}`, s.account1Addr.String(), s.account1Addr.String()),
+			expectedOutput: fmt.Sprintf(`{"account":"%s","attributes":[{"name":"example.attribute","value":"ZXhhbXBsZSBhdHRyaWJ1dGUgdmFsdWUgc3RyaW5n","attribute_type":"ATTRIBUTE_TYPE_STRING","address":"%s","expiration_date":null,"origin":null}],"pagination":{"next_key":null,"total":"0"}}`, s.account1Addr.String(), s.account1Addr.String()),
 		},
 		{
 			name: "should get attribute by name with text output",
@@ -372,6 +372,7 @@ attributes:
   attribute_type: ATTRIBUTE_TYPE_STRING
   expiration_date: null
   name: example.attribute
+  origin: null
   value: ZXhhbXBsZSBhdHRyaWJ1dGUgdmFsdWUgc3RyaW5n
 pagination:
   next_key: null
@@ -405,6 +406,68 @@ pagination:
 	}
 }
 
+func (s *IntegrationTestSuite) TestGetAccountAttributeProofCmd() {
+	// Proofs are of the state before the latest block, so there needs to be at least two.
+	s.Require().NoError(s.testnet.WaitForNextBlock(), "WaitForNextBlock")
+
+	testCases := []struct {
+		name     string
+		args     []string
+		expErr   string
+		expAttrs []string
+	}{
+		{
+			name:     "attribute on account",
+			args:     []string{s.account1Addr.String(), "example.attribute"},
+			expAttrs: []string{"example attribute value string"},
+		},
+		{
+			name: "attribute not on account",
+			args: []string{s.account1Addr.String(), "example.none"},
+		},
+		{
+			name:   "invalid address",
+			args:   []string{"notanaddress", "example.attribute"},
+			expErr: `invalid account address "notanaddress"`,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			cmd := cli.GetAccountAttributeProofCmd()
+			clientCtx := s.testnet.Validators[0].ClientCtx
+
+			args := append(tc.args, fmt.Sprintf("--%s=json", cmtcli.OutputFlag))
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, args)
+			if len(tc.expErr) > 0 {
+				s.Require().EqualError(err, tc.expErr)
+				return
+			}
+			s.Require().NoError(err)
+
+			var proofs attributetypes.AccountAttributeProofs
+			s.Require().NoError(s.cfg.Codec.UnmarshalJSON(out.Bytes(), &proofs), "UnmarshalJSON")
+			s.Assert().NoError(proofs.Verify(), "Verify")
+			s.Assert().Equal(tc.args[0], proofs.Account, "account")
+			s.Assert().Equal(tc.args[1], proofs.Name, "name")
+			s.Assert().Positive(proofs.Height, "height")
+			s.Require().NotNil(proofs.SignedHeader, "signed header")
+			s.Require().NotNil(proofs.SignedHeader.Header, "signed header header")
+			s.Assert().Equal(proofs.Height+1, proofs.SignedHeader.Header.Height, "signed header height")
+			var values []string
+			for _, proof := range proofs.Proofs {
+				values = append(values, string(proof.Attribute.Value))
+			}
+			s.Assert().Equal(tc.expAttrs, values, "attribute values")
+
+			if len(proofs.Proofs) > 0 {
+				proofs.Proofs[0].Attribute.Value = []byte("tampered")
+				s.Assert().EqualError(proofs.Verify(), "proofs[0]: attribute does not match its value", "Verify after changing the attribute")
+			}
+		})
+	}
+}
+
 func (s *IntegrationTestSuite) TestScanAccountAttributesCmd() {
 	testCases := []struct {
 		name           string
@@ -414,7 +477,7 @@ func (s *IntegrationTestSuite) TestScanAccountAttributesCmd() {
 		{
 			name:           "should get attribute by suffix with json output",
 			args:           []string{s.account1Addr.String(), "attribute", fmt.Sprintf("--%s=json", cmtcli.OutputFlag)},
-			expectedOutput: fmt.Sprintf(`{"account":"%s","attributes":[{"name":"example.attribute","value":"ZXhhbXBsZSBhdHRyaWJ1dGUgdmFsdWUgc3RyaW5n","attribute_type":"ATTRIBUTE_TYPE_STRING","address":"%s","expiration_date":null}],"pagination":{"next_key":null,"total":"0"}}`, s.account1Addr.String(), s.account1Addr.String()),
+			expectedOutput: fmt.Sprintf(`{"account":"%s","attributes":[{"name":"example.attribute","value":"ZXhhbXBsZSBhdHRyaWJ1dGUgdmFsdWUgc3RyaW5n","attribute_type":"ATTRIBUTE_TYPE_STRING","address":"%s","expiration_date":null,"origin":null}],"pagination":{"next_key":null,"total":"0"}}`, s.account1Addr.String(), s.account1Addr.String()),
 		},
 		{
 			name: "should get attribute by suffix with text output",
@@ -425,6 +488,7 @@ attributes:
   attribute_type: ATTRIBUTE_TYPE_STRING
   expiration_date: null
   name: example.attribute
+  origin: null
   value: ZXhhbXBsZSBhdHRyaWJ1dGUgdmFsdWUgc3RyaW5n
 pagination:
   next_key: null
@@ -467,7 +531,7 @@ func (s *IntegrationTestSuite) TestListAccountAttributesCmd() {
 		{
 			name:           "should list all attributes for account with json output",
 			args:           []string{s.account1Addr.String(), fmt.Sprintf("--%s=json", cmtcli.OutputFlag)},
-			expectedOutput: fmt.Sprintf(`{"account":"%[1]s","attributes":[{"name":"example.attribute.count","value":"Mg==","attribute_type":"ATTRIBUTE_TYPE_INT","address":"%[1]s","expiration_date":null},{"name":"example.attribute","value":"ZXhhbXBsZSBhdHRyaWJ1dGUgdmFsdWUgc3RyaW5n","attribute_type":"ATTRIBUTE_TYPE_STRING","address":"%[1]s","expiration_date":null},{"name":"accountdata","value":"YWNjb3VudGRhdGEgc2V0IGF0IGdlbmVzaXM=","attribute_type":"ATTRIBUTE_TYPE_STRING","address":"%[1]s","expiration_date":null}],"pagination":{"next_key":null,"total":"0"}}`, s.account1Addr.String()),
+			expectedOutput: fmt.Sprintf(`{"account":"%[1]s","attributes":[{"name":"example.attribute.count","value":"Mg==","attribute_type":"ATTRIBUTE_TYPE_INT","address":"%[1]s","expiration_date":null,"origin":null},{"name":"example.attribute","value":"ZXhhbXBsZSBhdHRyaWJ1dGUgdmFsdWUgc3RyaW5n","attribute_type":"ATTRIBUTE_TYPE_STRING","address":"%[1]s","expiration_date":null,"origin":null},{"name":"accountdata","value":"YWNjb3VudGRhdGEgc2V0IGF0IGdlbmVzaXM=","attribute_type":"ATTRIBUTE_TYPE_STRING","address":"%[1]s","expiration_date":null,"origin":null}],"pagination":{"next_key":null,"total":"0"}}`, s.account1Addr.String()),
 		},
 		{
 			name: "should list all attributes for account text output",
@@ -478,16 +542,19 @@ attributes:
   attribute_type: ATTRIBUTE_TYPE_INT
   expiration_date: null
   name: example.attribute.count
+  origin: null
   value: Mg==
 - address: %[1]s
   attribute_type: ATTRIBUTE_TYPE_STRING
   expiration_date: null
   name: example.attribute
+  origin: null
   value: ZXhhbXBsZSBhdHRyaWJ1dGUgdmFsdWUgc3RyaW5n
 - address: %[1]s
   attribute_type: ATTRIBUTE_TYPE_STRING
   expiration_date: null
   name: accountdata
+  origin: null
   value: YWNjb3VudGRhdGEgc2V0IGF0IGdlbmVzaXM=
 pagination:
   next_key: null
@@ -517,12 +584,12 @@ func (s *IntegrationTestSuite) TestGetAttributeParamsCmd() {
 		{
 			name:           "json output",
 			args:           []string{fmt.Sprintf("--%s=json", cmtcli.OutputFlag)},
-			expectedOutput: "{\"max_value_length\":128}",
+			expectedOutput: "{\"max_value_length\":128,\"max_query_results\":0,\"max_query_response_bytes\":\"0\",\"max_names_per_account\":0,\"max_values_per_name\":0,\"hook_gas_limit\":\"0\",\"allow_issuer_revocation\":false,\"expiration_notice_blocks\":0}",
 		},
 		{
 			name:           "text output",
 			args:           []string{fmt.Sprintf("--%s=text", cmtcli.OutputFlag)},
-			expectedOutput: "max_value_length: 128",
+			expectedOutput: "allow_issuer_revocation: false\nexpiration_notice_blocks: 0\nhook_gas_limit: \"0\"\nmax_names_per_account: 0\nmax_query_response_bytes: \"0\"\nmax_query_results: 0\nmax_value_length: 128\nmax_values_per_name: 0",
 		},
 	}
 
@@ -547,7 +614,7 @@ func (s *IntegrationTestSuite) TestAttributeAccountsCmd() {
 		{
 			name:           "successfully output result for attribute",
 			args:           []string{"example.attribute"},
-			expectedOutput: fmt.Sprintf("{\"accounts\":[\"%s\"],\"pagination\":{\"next_key\":null,\"total\":\"0\"}}", s.account1Addr),
+			expectedOutput: fmt.Sprintf("{\"accounts\":[\"%s\"],\"truncated\":false,\"pagination\":{\"next_key\":null,\"total\":\"0\"}}", s.account1Addr),
 		},
 	}
 
@@ -675,6 +742,57 @@ func (s *IntegrationTestSuite) TestAttributeTxCommands() {
 			respType:     &sdk.TxResponse{},
 			expectedCode: 1,
 		},
+		{
+			name: "set attribute, valid int64",
+			cmd:  cli.NewAddAccountAttributeCmd(),
+			args: []string{
+				"txtest.attribute",
+				s.testnet.Validators[0].Address.String(),
+				"int64",
+				"42",
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.testnet.Validators[0].Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewInt64Coin(s.cfg.BondDenom, 10)).String()),
+			},
+			expectErr:    false,
+			respType:     &sdk.TxResponse{},
+			expectedCode: 0,
+		},
+		{
+			name: "set attribute, valid timestamp",
+			cmd:  cli.NewAddAccountAttributeCmd(),
+			args: []string{
+				"txtest.attribute",
+				s.testnet.Validators[0].Address.String(),
+				"timestamp",
+				"2050-01-15T00:00:00Z",
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.testnet.Validators[0].Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewInt64Coin(s.cfg.BondDenom, 10)).String()),
+			},
+			expectErr:    false,
+			respType:     &sdk.TxResponse{},
+			expectedCode: 0,
+		},
+		{
+			name: "set attribute, invalid int64",
+			cmd:  cli.NewAddAccountAttributeCmd(),
+			args: []string{
+				"txtest.attribute",
+				s.testnet.Validators[0].Address.String(),
+				"int64",
+				"3.14159",
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.testnet.Validators[0].Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewInt64Coin(s.cfg.BondDenom, 10)).String()),
+			},
+			expectErr:    true,
+			respType:     &sdk.TxResponse{},
+			expectedCode: 1,
+		},
 		{
 			name: "set attribute, cannot encode",
 			cmd:  cli.NewAddAccountAttributeCmd(),
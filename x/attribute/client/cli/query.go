@@ -2,6 +2,7 @@ package cli
 
 import (
 	"context"
+	"encoding/hex"
 	"fmt"
 	"strings"
 
@@ -9,8 +10,10 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/version"
 
+	"github.com/provenance-io/provenance/internal/provcli"
 	"github.com/provenance-io/provenance/x/attribute/types"
 )
 
@@ -30,7 +33,13 @@ func GetQueryCmd() *cobra.Command {
 		ListAccountAttributesCmd(),
 		ScanAccountAttributesCmd(),
 		GetAttributeAccountsCmd(),
+		GetAttributeAccountsByValueCmd(),
+		GetAttributeAccountsByValueRangeCmd(),
 		GetAccountDataCmd(),
+		GetAccountAttributeProofCmd(),
+		GetAttributeQuotaCmd(),
+		GetAttributeHookCmd(),
+		GetBulkAttributesCmd(),
 	)
 
 	return queryCmd
@@ -55,11 +64,11 @@ func GetAttributeParamsCmd() *cobra.Command {
 				return err
 			}
 
-			return clientCtx.PrintProto(&res.Params)
+			return provcli.PrintProto(clientCtx, &res.Params)
 		},
 	}
 
-	flags.AddQueryFlagsToCmd(cmd)
+	provcli.AddQueryFlagsToCmd(cmd)
 
 	return cmd
 }
@@ -100,12 +109,12 @@ func GetAccountAttributeCmd() *cobra.Command {
 				fmt.Printf("failed to query account \"%s\" attributes for name \"%s\": %v\n", address, name, err)
 				return nil
 			}
-			return clientCtx.PrintProto(response)
+			return provcli.PrintProto(clientCtx, response)
 		},
 	}
 
 	flags.AddPaginationFlagsToCmd(cmd, "get")
-	flags.AddQueryFlagsToCmd(cmd)
+	provcli.AddQueryFlagsToCmd(cmd)
 
 	return cmd
 }
@@ -144,12 +153,12 @@ func ListAccountAttributesCmd() *cobra.Command {
 				fmt.Printf("failed to query account \"%s\" attributes: %v\n", address, err)
 				return nil
 			}
-			return clientCtx.PrintProto(response)
+			return provcli.PrintProto(clientCtx, response)
 		},
 	}
 
 	flags.AddPaginationFlagsToCmd(cmd, "list")
-	flags.AddQueryFlagsToCmd(cmd)
+	provcli.AddQueryFlagsToCmd(cmd)
 
 	return cmd
 }
@@ -189,12 +198,12 @@ func ScanAccountAttributesCmd() *cobra.Command {
 				fmt.Printf("failed to query account \"%s\" attributes for suffix \"%s\": %v\n", address, suffix, err)
 				return nil
 			}
-			return clientCtx.PrintProto(response)
+			return provcli.PrintProto(clientCtx, response)
 		},
 	}
 
 	flags.AddPaginationFlagsToCmd(cmd, "scan")
-	flags.AddQueryFlagsToCmd(cmd)
+	provcli.AddQueryFlagsToCmd(cmd)
 
 	return cmd
 }
@@ -233,12 +242,131 @@ func GetAttributeAccountsCmd() *cobra.Command {
 				fmt.Printf("failed to query attribute name \"%s\" : %v\n", attributeName, err)
 				return nil
 			}
-			return clientCtx.PrintProto(response)
+			return provcli.PrintProto(clientCtx, response)
 		},
 	}
 
 	flags.AddPaginationFlagsToCmd(cmd, "accounts")
-	flags.AddQueryFlagsToCmd(cmd)
+	provcli.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetAttributeAccountsByValueCmd returns the command handler for listing accounts with an attribute name and value hash.
+func GetAttributeAccountsByValueCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "accounts-by-value <name> <value-hash>",
+		Short: "List account addresses that have an attribute with name and a value with the given hash",
+		Long: strings.TrimSpace(`List account addresses that have an attribute with name and a value with the given hash.
+The value hash is the hex encoded sha256 hash of the attribute value.`),
+		Example: strings.TrimSpace(
+			fmt.Sprintf(`
+				$ %[1]s query attribute accounts-by-value example.provenance.io 2c26b46b68ffc68ff99b453c1d30413413422d706483bfa0f98a5e886266e7ae
+				$ %[1]s query attribute accounts-by-value example.provenance.io 2c26b46b68ffc68ff99b453c1d30413413422d706483bfa0f98a5e886266e7ae --page=2 --limit=100
+				`,
+				version.AppName,
+			)),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequestWithPageKeyDecoded(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			attributeName := strings.ToLower(strings.TrimSpace(args[0]))
+			valueHash, err := hex.DecodeString(strings.TrimSpace(args[1]))
+			if err != nil {
+				return fmt.Errorf("invalid value hash %q: %w", args[1], err)
+			}
+
+			var response *types.QueryAttributeAccountsByValueResponse
+			if response, err = queryClient.AttributeAccountsByValue(
+				context.Background(),
+				&types.QueryAttributeAccountsByValueRequest{AttributeName: attributeName, ValueHash: valueHash, Pagination: pageReq},
+			); err != nil {
+				fmt.Printf("failed to query attribute name \"%s\" by value hash %s: %v\n", attributeName, args[1], err)
+				return nil
+			}
+			return provcli.PrintProto(clientCtx, response)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "accounts-by-value")
+	provcli.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetAttributeAccountsByValueRangeCmd returns the command handler for listing attributes with a name and a typed value in a range.
+func GetAttributeAccountsByValueRangeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "accounts-by-range <name> <type> [--min <min>] [--max <max>]",
+		Short: "List attributes with name and a value of the given type within a range",
+		Long: strings.TrimSpace(`List attributes with name and a value of the given type within a range.
+The type must be one of int64, float64, or timestamp.
+The --min and --max values are inclusive, and either (or both) can be omitted for an open-ended range.
+An int64 or float64 bound is a base-10 number, and a timestamp bound is an RFC 3339 formatted time.`),
+		Example: strings.TrimSpace(
+			fmt.Sprintf(`
+				$ %[1]s query attribute accounts-by-range example.provenance.io int64 --min 18 --max 65
+				$ %[1]s query attribute accounts-by-range accreditation.provenance.io timestamp --max 2025-01-01T00:00:00Z
+				$ %[1]s query attribute accounts-by-range example.provenance.io float64 --min 0.5 --page=2 --limit=100
+				`,
+				version.AppName,
+			)),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequestWithPageKeyDecoded(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			attributeName := strings.ToLower(strings.TrimSpace(args[0]))
+			valueType, err := types.AttributeTypeFromString(strings.TrimSpace(args[1]))
+			if err != nil {
+				return fmt.Errorf("invalid value type: %w", err)
+			}
+			minVal, err := cmd.Flags().GetString(FlagMin)
+			if err != nil {
+				return err
+			}
+			maxVal, err := cmd.Flags().GetString(FlagMax)
+			if err != nil {
+				return err
+			}
+
+			var response *types.QueryAttributeAccountsByValueRangeResponse
+			if response, err = queryClient.AttributeAccountsByValueRange(
+				context.Background(),
+				&types.QueryAttributeAccountsByValueRangeRequest{
+					AttributeName: attributeName,
+					ValueType:     valueType,
+					Min:           minVal,
+					Max:           maxVal,
+					Pagination:    pageReq,
+				},
+			); err != nil {
+				fmt.Printf("failed to query attribute name \"%s\" by %s value range: %v\n", attributeName, args[1], err)
+				return nil
+			}
+			return provcli.PrintProto(clientCtx, response)
+		},
+	}
+
+	cmd.Flags().String(FlagMin, "", "The inclusive lower bound of the value range")
+	cmd.Flags().String(FlagMax, "", "The inclusive upper bound of the value range")
+	flags.AddPaginationFlagsToCmd(cmd, "accounts-by-range")
+	provcli.AddQueryFlagsToCmd(cmd)
 
 	return cmd
 }
@@ -265,11 +393,199 @@ func GetAccountDataCmd() *cobra.Command {
 				return fmt.Errorf("failed to query account data for %q: %w", req.Account, err)
 			}
 
-			return clientCtx.PrintProto(response)
+			return provcli.PrintProto(clientCtx, response)
+		},
+	}
+
+	provcli.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetAttributeQuotaCmd gets how many more attribute names (and values of a name) an account can have.
+func GetAttributeQuotaCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "quota <address> [name]",
+		Short: "Get how many more attributes an account can have",
+		Long: `Get how many more distinct attribute names an account can have.
+If a name is provided, also get how many more values the account can have for that name.`,
+		Example: fmt.Sprintf(`$ %[1]s query attribute quota pb1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk
+$ %[1]s query attribute quota pb1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk attrib.name`, version.AppName),
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryAttributeQuotaRequest{Account: strings.TrimSpace(args[0])}
+			if len(args) > 1 {
+				req.Name = strings.TrimSpace(args[1])
+			}
+
+			response, err := queryClient.AttributeQuota(context.Background(), req)
+			if err != nil {
+				return fmt.Errorf("failed to query attribute quota for %q: %w", req.Account, err)
+			}
+
+			return provcli.PrintProto(clientCtx, response)
+		},
+	}
+
+	provcli.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetAttributeHookCmd gets the wasm contract hook registered for an attribute name.
+func GetAttributeHookCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "hook <name>",
+		Short:   "Get the wasm contract hook registered for an attribute name",
+		Example: fmt.Sprintf(`$ %[1]s query attribute hook attrib.name`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryAttributeHookRequest{Name: strings.TrimSpace(args[0])}
+			response, err := queryClient.AttributeHook(context.Background(), req)
+			if err != nil {
+				return fmt.Errorf("failed to query attribute hook for %q: %w", req.Name, err)
+			}
+
+			return provcli.PrintProto(clientCtx, response)
+		},
+	}
+
+	provcli.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetAccountAttributeProofCmd gets account attributes by name along with the proofs of them.
+func GetAccountAttributeProofCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "proof <address> <name>",
+		Short: "Get account attributes by name along with the merkle proofs of them and the signed header that commits to them",
+		Long: `Get account attributes by name along with the merkle proofs of them and the signed header that commits to them.
+
+The proofs are of the state at the requested --height, or the height before the latest block if not provided.
+The signed header is of the block after that height since that's the one whose app hash commits to the state.
+The proofs are verified against the app hash before being output, but the header's commit signatures are not checked.`,
+		Example: fmt.Sprintf(`$ %[1]s query attribute proof pb1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk attrib.name
+$ %[1]s query attribute proof pb1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk attrib.name --height=1000`, version.AppName),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			address := strings.ToLower(strings.TrimSpace(args[0]))
+			name := strings.ToLower(strings.TrimSpace(args[1]))
+			proofs, err := QueryAccountAttributeProofs(clientCtx, address, name)
+			if err != nil {
+				return err
+			}
+			if err = proofs.Verify(); err != nil {
+				return fmt.Errorf("could not verify proofs: %w", err)
+			}
+
+			return provcli.PrintProto(clientCtx, proofs)
+		},
+	}
+
+	provcli.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// QueryAccountAttributeProofs gets an account's attributes with a name along with the proofs of them and the signed header that commits to them.
+func QueryAccountAttributeProofs(clientCtx client.Context, address, name string) (*types.AccountAttributeProofs, error) {
+	addrBz := types.GetAttributeAddressBytes(address)
+	if len(addrBz) == 0 {
+		return nil, fmt.Errorf("invalid account address %q", address)
+	}
+
+	height, err := provcli.GetProofHeight(clientCtx)
+	if err != nil {
+		return nil, err
+	}
+	rv := &types.AccountAttributeProofs{Height: height, Account: address, Name: name}
+
+	// The attributes are looked up at the same height as the proofs so that they match.
+	queryClient := types.NewQueryClient(clientCtx.WithHeight(height))
+	pageReq := &query.PageRequest{}
+	for {
+		resp, qErr := queryClient.Attribute(context.Background(), &types.QueryAttributeRequest{Account: address, Name: name, Pagination: pageReq})
+		if qErr != nil {
+			return nil, fmt.Errorf("failed to query account %q attributes for name %q: %w", address, name, qErr)
+		}
+		for _, attr := range resp.Attributes {
+			proof := types.AttributeProof{Attribute: attr, Key: types.AddrAttributeKey(addrBz, attr)}
+			proof.Value, proof.Proof, err = provcli.QueryStoreProof(clientCtx, types.StoreKey, proof.Key, height)
+			if err != nil {
+				return nil, err
+			}
+			rv.Proofs = append(rv.Proofs, proof)
+		}
+		if resp.Pagination == nil || len(resp.Pagination.NextKey) == 0 {
+			break
+		}
+		pageReq = &query.PageRequest{Key: resp.Pagination.NextKey}
+	}
+
+	rv.SignedHeader, err = provcli.QueryCommittingHeader(clientCtx, height)
+	if err != nil {
+		return nil, err
+	}
+	return rv, nil
+}
+
+// GetBulkAttributesCmd gets the attributes of each of several accounts.
+func GetBulkAttributesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bulk <address> [<address> ...] [--" + FlagName + " <name>]",
+		Short: "Get the attributes of several accounts",
+		Long: fmt.Sprintf(`Get the attributes of each of several accounts. Up to %d addresses can be provided.
+If a name is provided, only the attributes with that name are returned.`, types.MaxBulkAttributeAccounts),
+		Example: fmt.Sprintf(`$ %[1]s query attribute bulk pb1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk pb1sh49f6ze3vn7cdl2amh2gnc70z5mten3dpvr42
+$ %[1]s query attribute bulk pb1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk pb1sh49f6ze3vn7cdl2amh2gnc70z5mten3dpvr42 --%[2]s attrib.name`,
+			version.AppName, FlagName),
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryBulkAttributesRequest{Accounts: make([]string, len(args))}
+			for i, arg := range args {
+				req.Accounts[i] = strings.TrimSpace(arg)
+			}
+			name, err := cmd.Flags().GetString(FlagName)
+			if err != nil {
+				return err
+			}
+			req.Name = strings.TrimSpace(name)
+
+			response, err := queryClient.BulkAttributes(context.Background(), req)
+			if err != nil {
+				return fmt.Errorf("failed to query attributes of %d accounts: %w", len(req.Accounts), err)
+			}
+
+			return provcli.PrintProto(clientCtx, response)
 		},
 	}
 
-	flags.AddQueryFlagsToCmd(cmd)
+	cmd.Flags().String(FlagName, "", "Only get attributes with this name")
+	provcli.AddQueryFlagsToCmd(cmd)
 
 	return cmd
 }
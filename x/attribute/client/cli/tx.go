@@ -19,6 +19,32 @@ import (
 	"github.com/provenance-io/provenance/x/attribute/types"
 )
 
+const (
+	// FlagMaxQueryResults is the flag for the maximum number of results in a page of an attribute accounts query
+	FlagMaxQueryResults = "max-query-results"
+
+	// FlagMaxQueryResponseBytes is the flag for the maximum size of an attribute accounts query response
+	FlagMaxQueryResponseBytes = "max-query-response-bytes"
+
+	// FlagMaxNamesPerAccount is the flag for the maximum number of distinct attribute names an account can have
+	FlagMaxNamesPerAccount = "max-names-per-account"
+
+	// FlagMaxValuesPerName is the flag for the maximum number of values an account can have for one attribute name
+	FlagMaxValuesPerName = "max-values-per-name"
+
+	// FlagHookGasLimit is the flag for the maximum amount of gas a single attribute hook contract call can use
+	FlagHookGasLimit = "hook-gas-limit"
+
+	// FlagAllowIssuerRevocation is the flag for whether name owners can revoke the attributes they issued
+	FlagAllowIssuerRevocation = "allow-issuer-revocation"
+
+	// FlagExpirationNoticeBlocks is the flag for the number of blocks before an attribute expires that a notice is emitted
+	FlagExpirationNoticeBlocks = "expiration-notice-blocks"
+
+	// FlagReason is the flag for the reason an attribute is being revoked
+	FlagReason = "reason"
+)
+
 // NewTxCmd is the top-level command for attribute CLI transactions.
 func NewTxCmd() *cobra.Command {
 	txCmd := &cobra.Command{
@@ -35,8 +61,13 @@ func NewTxCmd() *cobra.Command {
 		NewDeleteDistinctAccountAttributeCmd(),
 		NewDeleteAccountAttributeCmd(),
 		NewSetAccountDataCmd(),
+		NewSetAttributeUnlistedCmd(),
+		NewSetAttributeHookCmd(),
+		NewRemoveAttributeHookCmd(),
+		NewRevokeAccountAttributeCmd(),
 		NewUpdateAccountAttributeExpirationCmd(),
 		NewUpdateParamsCmd(),
+		NewPurgeOrphanedAttributesCmd(),
 	)
 	return txCmd
 }
@@ -149,10 +180,15 @@ func NewUpdateAccountAttributeCmd() *cobra.Command {
 				origAttributeType,
 				updateAttributeType,
 			)
+			msg.OriginalValueHash, err = ReadOriginalValueHashFlag(cmd.Flags())
+			if err != nil {
+				return err
+			}
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
+	AddOriginalValueHashFlag(cmd)
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -160,12 +196,15 @@ func NewUpdateAccountAttributeCmd() *cobra.Command {
 
 func encodeAttributeValue(value string, attrType types.AttributeType) ([]byte, error) {
 	var encodedValue []byte
-	if attrType == types.AttributeType_Bytes || attrType == types.AttributeType_Proto {
+	switch {
+	case attrType == types.AttributeType_Bytes || attrType == types.AttributeType_Proto:
 		var err error
 		if encodedValue, err = base64.StdEncoding.DecodeString(value); err != nil {
 			return nil, err
 		}
-	} else {
+	case types.IsRangeType(attrType):
+		return types.ParseRangeTypeValue(attrType, value)
+	default:
 		encodedValue = []byte(value)
 	}
 	return encodedValue, nil
@@ -230,10 +269,15 @@ func NewDeleteAccountAttributeCmd() *cobra.Command {
 				clientCtx.GetFromAddress(),
 				args[0],
 			)
+			msg.OriginalValueHash, err = ReadOriginalValueHashFlag(cmd.Flags())
+			if err != nil {
+				return err
+			}
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
+	AddOriginalValueHashFlag(cmd)
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -319,6 +363,132 @@ $ %[1]s tx attribute account-data --%s
 	return cmd
 }
 
+// NewSetAttributeUnlistedCmd creates a command for marking an attribute name of the signer as unlisted (or listed).
+func NewSetAttributeUnlistedCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "set-unlisted <name> <true|false>",
+		Aliases: []string{"unlisted"},
+		Short:   "Mark one of your attribute names as unlisted (true) or listed (false)",
+		Long: strings.TrimSpace(`Mark one of your attribute names as unlisted (true) or listed (false).
+Unlisted attributes are left out of the queries that enumerate attributes or accounts,
+but can still be looked up directly by name and account.`),
+		Example: fmt.Sprintf(`$ %[1]s tx attribute set-unlisted "kyc.attest.example" true --from mykey
+$ %[1]s tx attribute set-unlisted "kyc.attest.example" false --from mykey`, version.AppName),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			unlisted, err := strconv.ParseBool(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid unlisted value %q: %w", args[1], err)
+			}
+
+			msg := types.NewMsgSetAttributeUnlistedRequest(clientCtx.GetFromAddress(), args[0], unlisted)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewSetAttributeHookCmd creates a command for registering a wasm contract to call when attributes with a name are added or deleted.
+func NewSetAttributeHookCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-hook <name> <contract> <notify|veto>",
+		Short: "Register a wasm contract to call whenever an attribute with a name you own is added or deleted",
+		Long: strings.TrimSpace(`Register a wasm contract to call whenever an attribute with a name you own is added or deleted.
+In notify mode, a failure of the contract call is ignored and the attribute is still added or deleted.
+In veto mode, a failure of the contract call prevents the attribute from being added or deleted.
+A name can only have one hook; setting it again replaces the existing one.`),
+		Example: fmt.Sprintf(`$ %[1]s tx attribute set-hook "kyc.attest.example" pb14hj2tavq8fpesdwxxcu44rty3hh90vhujrvcmstl4zr3txmfvw9s9p2vla veto --from mykey`, version.AppName),
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			mode, err := types.AttributeHookModeFromString(strings.TrimSpace(args[2]))
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetAttributeHookRequest(clientCtx.GetFromAddress(), args[0], strings.TrimSpace(args[1]), mode)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewRemoveAttributeHookCmd creates a command for removing the wasm contract hook of an attribute name.
+func NewRemoveAttributeHookCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "remove-hook <name>",
+		Short:   "Remove the wasm contract hook of an attribute name you own",
+		Example: fmt.Sprintf(`$ %[1]s tx attribute remove-hook "kyc.attest.example" --from mykey`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetAttributeHookRequest(clientCtx.GetFromAddress(), args[0], "", types.AttributeHookMode_Unspecified)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewRevokeAccountAttributeCmd creates a command for the owner of an attribute name to revoke the attributes it issued.
+func NewRevokeAccountAttributeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke <name> <address> [value]",
+		Short: "Revoke the attributes with a name you own that you issued to an account",
+		Long: strings.TrimSpace(`Revoke the attributes with a name you own that you issued to an account.
+If a value is provided, only that value is revoked. Otherwise, all values you issued with the name are revoked.
+Attributes set by a previous owner of the name are not revoked. This requires that issuer revocation is enabled.`),
+		Example: fmt.Sprintf(`$ %[1]s tx attribute revoke "kyc.attest.example" tp1jypkeck8vywptdltjnwspwzulkqu7jv6ey90dx --reason "expired credential" --from mykey
+$ %[1]s tx attribute revoke "kyc.attest.example" tp1jypkeck8vywptdltjnwspwzulkqu7jv6ey90dx "approved" --from mykey`, version.AppName),
+		Args: cobra.RangeArgs(2, 3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			reason, err := cmd.Flags().GetString(FlagReason)
+			if err != nil {
+				return err
+			}
+
+			var value []byte
+			if len(args) > 2 {
+				value = []byte(args[2])
+			}
+
+			msg := types.NewMsgRevokeAttributeRequest(strings.TrimSpace(args[1]), clientCtx.GetFromAddress(), args[0], value, reason)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagReason, "", "The reason the attributes are being revoked")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // NewUpdateParamsCmd creates a command to update the attribute module's params via governance proposal.
 func NewUpdateParamsCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -341,6 +511,77 @@ func NewUpdateParamsCmd() *cobra.Command {
 			}
 			maxValueLength32 := uint32(maxValueLength) //nolint:gosec // G115: ParseUint bitsize is 32, so we know this is okay.
 			msg := types.NewMsgUpdateParamsRequest(authority, maxValueLength32)
+			msg.Params.MaxQueryResults, err = flagSet.GetUint32(FlagMaxQueryResults)
+			if err != nil {
+				return err
+			}
+			msg.Params.MaxQueryResponseBytes, err = flagSet.GetUint64(FlagMaxQueryResponseBytes)
+			if err != nil {
+				return err
+			}
+			msg.Params.MaxNamesPerAccount, err = flagSet.GetUint32(FlagMaxNamesPerAccount)
+			if err != nil {
+				return err
+			}
+			msg.Params.MaxValuesPerName, err = flagSet.GetUint32(FlagMaxValuesPerName)
+			if err != nil {
+				return err
+			}
+			msg.Params.HookGasLimit, err = flagSet.GetUint64(FlagHookGasLimit)
+			if err != nil {
+				return err
+			}
+			msg.Params.AllowIssuerRevocation, err = flagSet.GetBool(FlagAllowIssuerRevocation)
+			if err != nil {
+				return err
+			}
+			msg.Params.ExpirationNoticeBlocks, err = flagSet.GetUint32(FlagExpirationNoticeBlocks)
+			if err != nil {
+				return err
+			}
+			return provcli.GenerateOrBroadcastTxCLIAsGovProp(clientCtx, flagSet, msg)
+		},
+	}
+
+	cmd.Flags().Uint32(FlagMaxQueryResults, 0, "The maximum number of results in a page of an attribute accounts query (0 = no limit)")
+	cmd.Flags().Uint64(FlagMaxQueryResponseBytes, 0, "The maximum size (in bytes) of an attribute accounts query response (0 = no limit)")
+	cmd.Flags().Uint32(FlagMaxNamesPerAccount, 0, "The maximum number of distinct attribute names an account can have (0 = no limit)")
+	cmd.Flags().Uint32(FlagMaxValuesPerName, 0, "The maximum number of values an account can have for one attribute name (0 = no limit)")
+	cmd.Flags().Uint64(FlagHookGasLimit, 0, "The maximum amount of gas a single attribute hook contract call can use (0 = hooks are not called)")
+	cmd.Flags().Bool(FlagAllowIssuerRevocation, false, "Allow name owners to revoke the attributes they issued from any account")
+	cmd.Flags().Uint32(FlagExpirationNoticeBlocks, 0, "The number of blocks before an attribute expires that a notice is emitted for it (0 = no notices)")
+	govcli.AddGovPropFlagsToCmd(cmd)
+	provcli.AddAuthorityFlagToCmd(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewPurgeOrphanedAttributesCmd creates a command for removing the attributes of accounts that do not exist via governance proposal.
+func NewPurgeOrphanedAttributesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "purge-orphaned-attributes [max-accounts]",
+		Short: "Remove the attributes of accounts that do not exist via governance proposal",
+		Long: `Submit a proposal to remove all attributes (and their indexes) bound to account addresses that do not have an account.
+If max-accounts is provided, at most that many accounts will have their attributes removed. Otherwise there is no limit.`,
+		Args:    cobra.MaximumNArgs(1),
+		Example: fmt.Sprintf(`%[1]s tx attribute purge-orphaned-attributes 100 --deposit 50000nhash`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			flagSet := cmd.Flags()
+			authority := provcli.GetAuthority(flagSet)
+			var maxAccounts uint64
+			if len(args) > 0 {
+				maxAccounts, err = strconv.ParseUint(args[0], 10, 32)
+				if err != nil {
+					return fmt.Errorf("invalid max accounts: %w", err)
+				}
+			}
+			msg := types.NewMsgPurgeOrphanedAttributesRequest(authority, uint32(maxAccounts)) //nolint:gosec // G115: ParseUint bitsize is 32, so we know this is okay.
 			return provcli.GenerateOrBroadcastTxCLIAsGovProp(clientCtx, flagSet, msg)
 		},
 	}
@@ -1,6 +1,8 @@
 package cli
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"os"
 	"strings"
@@ -22,6 +24,14 @@ const (
 	FlagDelete = "delete"
 	// flagDeleteUse is a use string for the delete flag.
 	flagDeleteUse = "--" + FlagDelete
+	// FlagMin is a flag name for defining the lower bound of a range.
+	FlagMin = "min"
+	// FlagMax is a flag name for defining the upper bound of a range.
+	FlagMax = "max"
+	// FlagOriginalValueHash is a flag name for defining the hash that a stored attribute value must have.
+	FlagOriginalValueHash = "original-value-hash"
+	// FlagName is a flag name for defining an attribute name.
+	FlagName = "name"
 
 	// AccountDataFlagsUse is a use string for the mutually exclusive account data flags.
 	AccountDataFlagsUse = "{" + flagValueUse + "|" + flagFileUse + "|" + flagDeleteUse + "}"
@@ -87,3 +97,26 @@ func ReadAccountDataFlags(flagSet *flag.FlagSet) (string, error) {
 	}
 	return string(bz), nil
 }
+
+// AddOriginalValueHashFlag adds the flag for providing the hash that a stored attribute value must have.
+// See also: ReadOriginalValueHashFlag
+func AddOriginalValueHashFlag(cmd *cobra.Command) {
+	cmd.Flags().String(FlagOriginalValueHash, "", "The hex-encoded sha256 hash that the stored attribute value must have")
+}
+
+// ReadOriginalValueHashFlag parses the original value hash flag, returning nil if it wasn't provided.
+// See also: AddOriginalValueHashFlag
+func ReadOriginalValueHashFlag(flagSet *flag.FlagSet) ([]byte, error) {
+	hashStr, err := flagSet.GetString(FlagOriginalValueHash)
+	if err != nil || len(hashStr) == 0 {
+		return nil, err
+	}
+	hash, err := hex.DecodeString(hashStr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid --%s: %w", FlagOriginalValueHash, err)
+	}
+	if len(hash) != sha256.Size {
+		return nil, fmt.Errorf("invalid --%s: expected %d bytes, got %d", FlagOriginalValueHash, sha256.Size, len(hash))
+	}
+	return hash, nil
+}
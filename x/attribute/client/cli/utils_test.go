@@ -1,6 +1,8 @@
 package cli_test
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -301,3 +303,62 @@ func TestReadAccountDataFlags(t *testing.T) {
 		})
 	}
 }
+
+func TestReadOriginalValueHashFlag(t *testing.T) {
+	hash := sha256.Sum256([]byte("some value"))
+	hashHex := hex.EncodeToString(hash[:])
+
+	tests := []struct {
+		name   string
+		args   []string
+		expVal []byte
+		expErr string
+	}{
+		{
+			name:   "not provided",
+			args:   []string{},
+			expVal: nil,
+		},
+		{
+			name:   "valid hash",
+			args:   []string{"--" + cli.FlagOriginalValueHash, hashHex},
+			expVal: hash[:],
+		},
+		{
+			name:   "upper case hash",
+			args:   []string{"--" + cli.FlagOriginalValueHash, strings.ToUpper(hashHex)},
+			expVal: hash[:],
+		},
+		{
+			name:   "not hex",
+			args:   []string{"--" + cli.FlagOriginalValueHash, "nothex"},
+			expErr: "invalid --original-value-hash: encoding/hex: invalid byte: U+006E 'n'",
+		},
+		{
+			name:   "wrong length",
+			args:   []string{"--" + cli.FlagOriginalValueHash, "0102"},
+			expErr: "invalid --original-value-hash: expected 32 bytes, got 2",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			cmd := &cobra.Command{
+				Use: "dummy",
+				Run: func(cmd *cobra.Command, args []string) {
+					panic("this dummy command should not be executed")
+				},
+			}
+			cli.AddOriginalValueHashFlag(cmd)
+			require.NoError(t, cmd.ParseFlags(tc.args), "ParseFlags(%q)", tc.args)
+
+			val, err := cli.ReadOriginalValueHashFlag(cmd.Flags())
+			if len(tc.expErr) > 0 {
+				assert.EqualError(t, err, tc.expErr, "ReadOriginalValueHashFlag error")
+			} else {
+				assert.NoError(t, err, "ReadOriginalValueHashFlag error")
+			}
+			assert.Equal(t, tc.expVal, val, "ReadOriginalValueHashFlag value")
+		})
+	}
+}
@@ -0,0 +1,84 @@
+package keeper
+
+import (
+	"bytes"
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/attribute/types"
+)
+
+// EmitExpiringAttributeNotices emits an EventAttributeExpiring for each attribute that will expire within the next
+// ExpirationNoticeBlocks blocks, returning the number emitted. Expirations are times, so the notice period is estimated
+// from the duration of the previous block. An attribute is only noticed once, even if the period is lengthened.
+// Attributes that are set with (or updated to) an expiration that has already been noticed do not get a notice.
+// limit sets the max amount of notices to emit in a call, 0 for no limit.
+func (k Keeper) EmitExpiringAttributeNotices(ctx sdk.Context, limit int) int {
+	store := ctx.KVStore(k.storeKey)
+	blockTime := ctx.BlockTime()
+	lastBlockTime, hadLast := k.getLastBlockTime(ctx)
+	store.Set(types.LastBlockTimeKey, sdk.FormatTimeBytes(blockTime))
+
+	noticeBlocks := k.GetParams(ctx).ExpirationNoticeBlocks
+	if noticeBlocks == 0 || !hadLast || !blockTime.After(lastBlockTime) {
+		return 0
+	}
+
+	noticeEnd := blockTime.Add(blockTime.Sub(lastBlockTime) * time.Duration(noticeBlocks))
+	// Expired attributes are deleted this block, so there's no need to notice anything before now.
+	start := types.GetAttributeExpireTimePrefix(blockTime)
+	if lastNoticed := store.Get(types.AttributeExpirationNoticeKey); bytes.Compare(lastNoticed, start) >= 0 {
+		start = append(bytes.Clone(lastNoticed), 0x00)
+	}
+	// The end is exclusive and the keys only have second precision, so the end is the start of the next second.
+	end := types.GetAttributeExpireTimePrefix(noticeEnd.Add(time.Second))
+	if bytes.Compare(start, end) >= 0 {
+		return 0
+	}
+
+	var lastKey []byte
+	count := 0
+	iterator := store.Iterator(start, end)
+	for ; iterator.Valid(); iterator.Next() {
+		lastKey = bytes.Clone(iterator.Key())
+		attrKey := types.GetAddrAttributeKeyFromExpireKey(lastKey)
+		bz := store.Get(attrKey)
+		if bz == nil {
+			continue
+		}
+		var attribute types.Attribute
+		if err := types.UnmarshalStoredAttribute(k.cdc, bz, &attribute); err != nil {
+			k.Logger(ctx).Error("unable to unmarshal expiring attribute", "key", fmt.Sprintf("%X", attrKey), "error", err)
+			continue
+		}
+		if err := ctx.EventManager().EmitTypedEvent(types.NewEventAttributeExpiring(attribute)); err != nil {
+			k.Logger(ctx).Error("failed to emit typed event", "name", attribute.Name, "account", attribute.Address, "error", err)
+		}
+		count++
+		if limit != 0 && count >= limit {
+			break
+		}
+	}
+	iterator.Close()
+
+	if lastKey != nil {
+		store.Set(types.AttributeExpirationNoticeKey, lastKey)
+	}
+	return count
+}
+
+// getLastBlockTime gets the time of the previous block, and whether it has been recorded.
+func (k Keeper) getLastBlockTime(ctx sdk.Context) (time.Time, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.LastBlockTimeKey)
+	if len(bz) == 0 {
+		return time.Time{}, false
+	}
+	rv, err := sdk.ParseTimeBytes(bz)
+	if err != nil {
+		k.Logger(ctx).Error("unable to parse last block time", "value", fmt.Sprintf("%X", bz), "error", err)
+		return time.Time{}, false
+	}
+	return rv, true
+}
@@ -0,0 +1,65 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/attribute/types"
+)
+
+func (s *KeeperTestSuite) TestEmitExpiringAttributeNotices() {
+	t0 := s.startBlockTime
+	newAttr := func(name string, expiresIn time.Duration) types.Attribute {
+		s.Require().NoError(s.app.NameKeeper.SetNameRecord(s.ctx, name, s.user1Addr, false), "SetNameRecord %s", name)
+		attr := types.NewAttribute(name, s.user1, types.AttributeType_String, []byte("value"), nil)
+		if expiresIn > 0 {
+			expiration := t0.Add(expiresIn)
+			attr.ExpirationDate = &expiration
+		}
+		s.Require().NoError(s.app.AttributeKeeper.SetAttribute(s.ctx, attr, s.user1Addr), "SetAttribute %s", name)
+		return attr
+	}
+	// runBlock calls EmitExpiringAttributeNotices at the given time and checks the events emitted.
+	runBlock := func(name string, blockTime time.Time, limit int, expected ...types.Attribute) {
+		s.Run(name, func() {
+			s.ctx = s.ctx.WithBlockTime(blockTime).WithEventManager(sdk.NewEventManager())
+			count := s.app.AttributeKeeper.EmitExpiringAttributeNotices(s.ctx, limit)
+			s.Assert().Equal(len(expected), count, "EmitExpiringAttributeNotices")
+
+			var expEvents sdk.Events
+			for _, attr := range expected {
+				event, err := sdk.TypedEventToEvent(types.NewEventAttributeExpiring(attr))
+				s.Require().NoError(err, "TypedEventToEvent %s", attr.Name)
+				expEvents = append(expEvents, event)
+			}
+			s.Assert().Equal(expEvents, s.ctx.EventManager().Events(), "events")
+		})
+	}
+
+	params := s.app.AttributeKeeper.GetParams(s.ctx)
+	params.ExpirationNoticeBlocks = 10
+	s.app.AttributeKeeper.SetParams(s.ctx, params)
+
+	soon := newAttr("soon.expiring.testing", 30*time.Second)
+	edge := newAttr("edge.expiring.testing", 55*time.Second)
+	newAttr("later.expiring.testing", 2*time.Hour)
+	newAttr("never.expiring.testing", 0)
+
+	runBlock("first block without a previous block time", t0, 0)
+	// The previous block took 5s, so the notice period is 50s.
+	runBlock("attributes expiring within the notice period", t0.Add(5*time.Second), 0, soon, edge)
+	runBlock("already noticed attributes", t0.Add(10*time.Second), 0)
+
+	first := newAttr("first.expiring.testing", 90*time.Second)
+	second := newAttr("second.expiring.testing", 91*time.Second)
+	// The previous block took 10s, so the notice period is 100s.
+	runBlock("limited", t0.Add(20*time.Second), 1, first)
+	// The previous block took 5s, so the notice period (50s) is already covered.
+	runBlock("notice period already covered", t0.Add(25*time.Second), 0)
+	runBlock("rest of the notice period", t0.Add(35*time.Second), 0, second)
+
+	params.ExpirationNoticeBlocks = 0
+	s.app.AttributeKeeper.SetParams(s.ctx, params)
+	runBlock("notices disabled", t0.Add(time.Hour), 0)
+}
@@ -2,11 +2,15 @@ package keeper
 
 import (
 	"fmt"
+	"io"
 
 	"cosmossdk.io/log"
 
+	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
 
+	"github.com/provenance-io/provenance/internal/provutils"
 	"github.com/provenance-io/provenance/x/attribute/types"
 )
 
@@ -21,6 +25,13 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *types.GenesisState) {
 			panic(err)
 		}
 	}
+	store := ctx.KVStore(k.storeKey)
+	for _, unlisted := range data.UnlistedAttributes {
+		store.Set(types.UnlistedAttributeKey(types.GetAttributeAddressBytes(unlisted.Account), unlisted.Name), []byte(unlisted.Name))
+	}
+	for _, hook := range data.AttributeHooks {
+		k.setAttributeHook(ctx, hook)
+	}
 
 	if err := EnsureModuleAccountAndAccountDataNameRecord(ctx.WithLogger(log.NewNopLogger()), k.authKeeper, k.nameKeeper); err != nil {
 		panic(err)
@@ -74,7 +85,7 @@ func EnsureModuleAccountAndAccountDataNameRecord(ctx sdk.Context, accountK types
 		return nil
 	}
 	ctx.Logger().Info(fmt.Sprintf("Updating existing %q name record.", types.AccountDataName))
-	err = nameK.UpdateNameRecord(ctx, types.AccountDataName, attrModAccAddr, true)
+	err = nameK.UpdateNameRecord(ctx, types.AccountDataName, attrModAccAddr, true, nil)
 	if err != nil {
 		return err
 	}
@@ -97,5 +108,52 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) (data *types.GenesisState) {
 		panic(err)
 	}
 
-	return types.NewGenesisState(params, attrs)
+	genState := types.NewGenesisState(params, attrs)
+	genState.UnlistedAttributes = k.getAllUnlistedAttributesForGenesis(ctx)
+	genState.AttributeHooks = k.getAllAttributeHooksForGenesis(ctx)
+	return genState
+}
+
+// ExportGenesisTo writes the current keeper state of the attribute module to the writer as genesis JSON.
+// The attributes are encoded as they're iterated over, so they're never all decoded into a genesis state at once.
+// Whatever the writer does with the JSON is up to it, e.g. a bytes.Buffer will still hold all of it.
+func (k Keeper) ExportGenesisTo(ctx sdk.Context, cdc codec.JSONCodec, w io.Writer) error {
+	params := k.GetParams(ctx)
+	unlistedAttributes := provutils.GenesisListField{Name: "unlisted_attributes"}
+	for _, unlisted := range k.getAllUnlistedAttributesForGenesis(ctx) {
+		unlistedAttributes.Entries = append(unlistedAttributes.Entries, &unlisted)
+	}
+	attributeHooks := provutils.GenesisListField{Name: "attribute_hooks"}
+	for _, hook := range k.getAllAttributeHooksForGenesis(ctx) {
+		attributeHooks.Entries = append(attributeHooks.Entries, &hook)
+	}
+	return provutils.StreamGenesisJSON(w, cdc, &params, "attributes", func(emit func(entry proto.Message) error) error {
+		return k.IterateRecords(ctx, types.AttributeKeyPrefix, func(record types.Attribute) error {
+			return emit(&record)
+		})
+	}, unlistedAttributes, attributeHooks)
+}
+
+// getAllUnlistedAttributesForGenesis returns all the unlisted attribute entries, panicking if they can't be read.
+func (k Keeper) getAllUnlistedAttributesForGenesis(ctx sdk.Context) []types.UnlistedAttribute {
+	unlisted, err := k.GetAllUnlistedAttributes(ctx)
+	if err != nil {
+		panic(err)
+	}
+	if unlisted == nil {
+		unlisted = []types.UnlistedAttribute{}
+	}
+	return unlisted
+}
+
+// getAllAttributeHooksForGenesis returns all the registered attribute hooks, panicking if they can't be read.
+func (k Keeper) getAllAttributeHooksForGenesis(ctx sdk.Context) []types.AttributeHook {
+	hooks, err := k.GetAllAttributeHooks(ctx)
+	if err != nil {
+		panic(err)
+	}
+	if hooks == nil {
+		hooks = []types.AttributeHook{}
+	}
+	return hooks
 }
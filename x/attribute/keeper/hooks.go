@@ -0,0 +1,183 @@
+package keeper
+
+import (
+	"fmt"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/attribute/types"
+)
+
+// hookContractKeeper holds the keeper used to call attribute hook contracts.
+// The wasm keeper is created after this keeper has been provided to other keepers, so
+// all copies of this keeper share one of these, allowing it to be set later.
+type hookContractKeeper struct {
+	keeper     types.ContractKeeper
+	infoKeeper types.ContractInfoKeeper
+}
+
+// SetContractKeeper sets the keepers used to look up and call attribute hook contracts.
+func (k Keeper) SetContractKeeper(contractKeeper types.ContractKeeper, infoKeeper types.ContractInfoKeeper) {
+	k.hookContracts.keeper = contractKeeper
+	k.hookContracts.infoKeeper = infoKeeper
+}
+
+// GetAttributeHook returns the hook registered for an attribute name, or nil if it doesn't have one.
+func (k Keeper) GetAttributeHook(ctx sdk.Context, name string) (*types.AttributeHook, error) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.AttributeHookKey(name))
+	if len(bz) == 0 {
+		return nil, nil
+	}
+	var hook types.AttributeHook
+	if err := k.cdc.Unmarshal(bz, &hook); err != nil {
+		return nil, err
+	}
+	return &hook, nil
+}
+
+// SetAttributeHook registers a hook contract for an attribute name. The name must resolve to the owner.
+// The owner must also be the contract's creator or admin, since the contract is called using sudo.
+func (k Keeper) SetAttributeHook(ctx sdk.Context, hook types.AttributeHook, owner sdk.AccAddress) error {
+	if err := hook.ValidateBasic(); err != nil {
+		return err
+	}
+	if !k.resolvesTo(ctx, hook.Name, owner) {
+		return fmt.Errorf("%q does not resolve to address %q", hook.Name, owner.String())
+	}
+	if err := k.validateHookContract(ctx, hook.Contract, owner); err != nil {
+		return err
+	}
+	k.setAttributeHook(ctx, hook)
+	return ctx.EventManager().EmitTypedEvent(types.NewEventAttributeHookUpdated(hook, owner.String()))
+}
+
+// RemoveAttributeHook removes the hook registered for an attribute name. The name must resolve to the owner.
+func (k Keeper) RemoveAttributeHook(ctx sdk.Context, name string, owner sdk.AccAddress) error {
+	if !k.resolvesTo(ctx, name, owner) {
+		return fmt.Errorf("%q does not resolve to address %q", name, owner.String())
+	}
+	store := ctx.KVStore(k.storeKey)
+	key := types.AttributeHookKey(name)
+	if !store.Has(key) {
+		return fmt.Errorf("no attribute hook registered for %q", name)
+	}
+	store.Delete(key)
+	return ctx.EventManager().EmitTypedEvent(types.NewEventAttributeHookUpdated(types.AttributeHook{Name: name}, owner.String()))
+}
+
+// validateHookContract returns an error unless the contract exists and was created by, or is administered by, the owner.
+// Without this, a name owner could have the chain sudo any contract with a message of their choosing.
+func (k Keeper) validateHookContract(ctx sdk.Context, contract string, owner sdk.AccAddress) error {
+	if k.hookContracts == nil || k.hookContracts.infoKeeper == nil {
+		return fmt.Errorf("cannot look up attribute hook contract %s", contract)
+	}
+	contractAddr, err := sdk.AccAddressFromBech32(contract)
+	if err != nil {
+		return err
+	}
+	info := k.hookContracts.infoKeeper.GetContractInfo(ctx, contractAddr)
+	if info == nil {
+		return fmt.Errorf("attribute hook contract %s does not exist", contract)
+	}
+	if info.Creator != owner.String() && info.Admin != owner.String() {
+		return fmt.Errorf("attribute hook contract %s was not created by and is not administered by %s", contract, owner.String())
+	}
+	return nil
+}
+
+// setAttributeHook writes an attribute hook to state without any checks.
+func (k Keeper) setAttributeHook(ctx sdk.Context, hook types.AttributeHook) {
+	bz := k.cdc.MustMarshal(&hook)
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.AttributeHookKey(hook.Name), bz)
+}
+
+// IterateAttributeHooks iterates over all the registered attribute hooks.
+func (k Keeper) IterateAttributeHooks(ctx sdk.Context, handler func(hook types.AttributeHook) (stop bool)) error {
+	store := ctx.KVStore(k.storeKey)
+	it := storetypes.KVStorePrefixIterator(store, types.AttributeHookKeyPrefix)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		var hook types.AttributeHook
+		if err := k.cdc.Unmarshal(it.Value(), &hook); err != nil {
+			return fmt.Errorf("invalid attribute hook %X: %w", it.Key(), err)
+		}
+		if handler(hook) {
+			break
+		}
+	}
+	return nil
+}
+
+// GetAllAttributeHooks returns all the registered attribute hooks.
+func (k Keeper) GetAllAttributeHooks(ctx sdk.Context) ([]types.AttributeHook, error) {
+	var rv []types.AttributeHook
+	err := k.IterateAttributeHooks(ctx, func(hook types.AttributeHook) bool {
+		rv = append(rv, hook)
+		return false
+	})
+	return rv, err
+}
+
+// runAttributeHook calls the hook contract registered for the attribute's name (if there is one).
+// An error is only returned if the hook is in veto mode and the contract call fails.
+// Failures of notify mode hooks are logged and emitted as events.
+func (k Keeper) runAttributeHook(ctx sdk.Context, action string, attr types.Attribute, owner sdk.AccAddress) error {
+	if k.hookContracts == nil || k.hookContracts.keeper == nil {
+		return nil
+	}
+	gasLimit := k.GetParams(ctx).HookGasLimit
+	if gasLimit == 0 {
+		return nil
+	}
+	hook, err := k.GetAttributeHook(ctx, attr.Name)
+	if err != nil || hook == nil {
+		return err
+	}
+
+	err = k.callAttributeHook(ctx, gasLimit, *hook, action, attr, owner)
+	if err == nil {
+		return nil
+	}
+	if hook.Mode == types.AttributeHookMode_Veto {
+		return fmt.Errorf("attribute hook contract %s rejected %s of %q on %s: %w", hook.Contract, action, attr.Name, attr.Address, err)
+	}
+	k.Logger(ctx).Error("attribute hook contract call failed",
+		"name", attr.Name, "contract", hook.Contract, "action", action, "account", attr.Address, "error", err)
+	return ctx.EventManager().EmitTypedEvent(types.NewEventAttributeHookFailed(*hook, action, attr.Address, err))
+}
+
+// callAttributeHook calls a hook contract using a cache context limited to the provided amount of gas.
+// The contract's state changes are only kept if the call succeeds.
+// The gas used by the call is always consumed from the provided context's gas meter.
+func (k Keeper) callAttributeHook(ctx sdk.Context, gasLimit uint64, hook types.AttributeHook, action string, attr types.Attribute, owner sdk.AccAddress) (err error) {
+	contractAddr, err := sdk.AccAddressFromBech32(hook.Contract)
+	if err != nil {
+		return err
+	}
+	msg, err := types.NewAttributeHookSudoMsg(action, attr, owner.String())
+	if err != nil {
+		return err
+	}
+
+	cacheCtx, writeCache := ctx.WithGasMeter(storetypes.NewGasMeter(gasLimit)).CacheContext()
+	defer func() {
+		if r := recover(); r != nil {
+			oog, isOOG := r.(storetypes.ErrorOutOfGas)
+			if !isOOG {
+				panic(r)
+			}
+			err = fmt.Errorf("out of gas (limit %d) in location: %s", gasLimit, oog.Descriptor)
+		}
+		ctx.GasMeter().ConsumeGas(cacheCtx.GasMeter().GasConsumedToLimit(), "attribute hook")
+	}()
+
+	if _, err = k.hookContracts.keeper.Sudo(cacheCtx, contractAddr, msg); err != nil {
+		return err
+	}
+	writeCache()
+	return nil
+}
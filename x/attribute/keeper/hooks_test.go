@@ -0,0 +1,294 @@
+package keeper_test
+
+import (
+	"time"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/attribute/types"
+)
+
+// hasEventType returns true if the context's event manager has an event of the provided type.
+func hasEventType(ctx sdk.Context, eventType string) bool {
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *KeeperTestSuite) TestSetAttributeHook() {
+	contract := sdk.AccAddress("hookContract________").String()
+	adminContract := sdk.AccAddress("adminHookContract___").String()
+	otherContract := sdk.AccAddress("otherContract_______").String()
+	contractKeeper := &mockContractKeeper{Infos: map[string]*wasmtypes.ContractInfo{
+		contract:      {Creator: s.user1},
+		adminContract: {Creator: s.user2, Admin: s.user1},
+		otherContract: {Creator: s.user2, Admin: s.user2},
+	}}
+	s.app.AttributeKeeper.SetContractKeeper(contractKeeper, contractKeeper)
+
+	tests := []struct {
+		name   string
+		hook   types.AttributeHook
+		owner  sdk.AccAddress
+		expErr string
+	}{
+		{
+			name:   "invalid contract",
+			hook:   types.NewAttributeHook("example.attribute", "notabech32", types.AttributeHookMode_Notify),
+			owner:  s.user1Addr,
+			expErr: `invalid attribute hook contract "notabech32": decoding bech32 failed: invalid separator index -1`,
+		},
+		{
+			name:   "invalid mode",
+			hook:   types.NewAttributeHook("example.attribute", contract, types.AttributeHookMode_Unspecified),
+			owner:  s.user1Addr,
+			expErr: "invalid attribute hook mode: ATTRIBUTE_HOOK_MODE_UNSPECIFIED",
+		},
+		{
+			name:   "not the owner",
+			hook:   types.NewAttributeHook("example.attribute", contract, types.AttributeHookMode_Notify),
+			owner:  s.user2Addr,
+			expErr: `"example.attribute" does not resolve to address "` + s.user2 + `"`,
+		},
+		{
+			name:   "contract does not exist",
+			hook:   types.NewAttributeHook("example.attribute", sdk.AccAddress("noContract__________").String(), types.AttributeHookMode_Notify),
+			owner:  s.user1Addr,
+			expErr: "attribute hook contract " + sdk.AccAddress("noContract__________").String() + " does not exist",
+		},
+		{
+			name:   "contract not created or administered by owner",
+			hook:   types.NewAttributeHook("example.attribute", otherContract, types.AttributeHookMode_Notify),
+			owner:  s.user1Addr,
+			expErr: "attribute hook contract " + otherContract + " was not created by and is not administered by " + s.user1,
+		},
+		{
+			name:  "contract administered by owner",
+			hook:  types.NewAttributeHook("example.attribute", adminContract, types.AttributeHookMode_Notify),
+			owner: s.user1Addr,
+		},
+		{
+			name:  "notify",
+			hook:  types.NewAttributeHook("example.attribute", contract, types.AttributeHookMode_Notify),
+			owner: s.user1Addr,
+		},
+		{
+			name:  "replace with veto",
+			hook:  types.NewAttributeHook("example.attribute", contract, types.AttributeHookMode_Veto),
+			owner: s.user1Addr,
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			ctx := s.ctx.WithEventManager(sdk.NewEventManager())
+			err := s.app.AttributeKeeper.SetAttributeHook(ctx, tc.hook, tc.owner)
+			if len(tc.expErr) > 0 {
+				s.Require().EqualError(err, tc.expErr, "SetAttributeHook")
+				return
+			}
+			s.Require().NoError(err, "SetAttributeHook")
+			hook, err := s.app.AttributeKeeper.GetAttributeHook(ctx, tc.hook.Name)
+			s.Require().NoError(err, "GetAttributeHook")
+			s.Assert().Equal(&tc.hook, hook, "GetAttributeHook")
+			s.Assert().True(hasEventType(ctx, "provenance.attribute.v1.EventAttributeHookUpdated"), "has EventAttributeHookUpdated")
+		})
+	}
+
+	s.Run("remove by non-owner", func() {
+		err := s.app.AttributeKeeper.RemoveAttributeHook(s.ctx, "example.attribute", s.user2Addr)
+		s.Require().EqualError(err, `"example.attribute" does not resolve to address "`+s.user2+`"`, "RemoveAttributeHook")
+	})
+	s.Run("remove", func() {
+		err := s.app.AttributeKeeper.RemoveAttributeHook(s.ctx, "example.attribute", s.user1Addr)
+		s.Require().NoError(err, "RemoveAttributeHook")
+		hook, err := s.app.AttributeKeeper.GetAttributeHook(s.ctx, "example.attribute")
+		s.Require().NoError(err, "GetAttributeHook")
+		s.Assert().Nil(hook, "GetAttributeHook after remove")
+	})
+	s.Run("remove again", func() {
+		err := s.app.AttributeKeeper.RemoveAttributeHook(s.ctx, "example.attribute", s.user1Addr)
+		s.Require().EqualError(err, `no attribute hook registered for "example.attribute"`, "RemoveAttributeHook")
+	})
+}
+
+func (s *KeeperTestSuite) TestRunAttributeHooks() {
+	contractAddr := sdk.AccAddress("hookContract________")
+	newAttr := func(value string) types.Attribute {
+		return types.NewAttribute("example.attribute", s.user1, types.AttributeType_String, []byte(value), nil)
+	}
+	setup := func(mode types.AttributeHookMode, gasLimit uint64) *mockContractKeeper {
+		contractKeeper := &mockContractKeeper{Infos: map[string]*wasmtypes.ContractInfo{contractAddr.String(): {Creator: s.user1}}}
+		s.app.AttributeKeeper.SetContractKeeper(contractKeeper, contractKeeper)
+		params := s.app.AttributeKeeper.GetParams(s.ctx)
+		params.HookGasLimit = gasLimit
+		s.app.AttributeKeeper.SetParams(s.ctx, params)
+		hook := types.NewAttributeHook("example.attribute", contractAddr.String(), mode)
+		s.Require().NoError(s.app.AttributeKeeper.SetAttributeHook(s.ctx, hook, s.user1Addr), "SetAttributeHook")
+		return contractKeeper
+	}
+	getValues := func(ctx sdk.Context) []string {
+		attrs, err := s.app.AttributeKeeper.GetAttributes(ctx, s.user1, "example.attribute")
+		s.Require().NoError(err, "GetAttributes")
+		var rv []string
+		for _, attr := range attrs {
+			rv = append(rv, string(attr.Value))
+		}
+		return rv
+	}
+
+	s.Run("hooks disabled", func() {
+		contractKeeper := setup(types.AttributeHookMode_Veto, 0)
+		contractKeeper.SudoErr = "nope"
+		ctx, _ := s.ctx.CacheContext()
+		err := s.app.AttributeKeeper.SetAttribute(ctx, newAttr("1"), s.user1Addr)
+		s.Require().NoError(err, "SetAttribute")
+		s.Assert().Empty(contractKeeper.Calls, "contract calls")
+	})
+
+	s.Run("notify add and delete", func() {
+		contractKeeper := setup(types.AttributeHookMode_Notify, 100_000)
+		ctx, _ := s.ctx.CacheContext()
+		err := s.app.AttributeKeeper.SetAttribute(ctx, newAttr("1"), s.user1Addr)
+		s.Require().NoError(err, "SetAttribute")
+		err = s.app.AttributeKeeper.DeleteAttribute(ctx, s.user1, "example.attribute", nil, s.user1Addr)
+		s.Require().NoError(err, "DeleteAttribute")
+
+		s.Require().Len(contractKeeper.Calls, 2, "contract calls")
+		s.Assert().Equal(contractAddr, contractKeeper.Contract, "contract called")
+		add := contractKeeper.Calls[0].AttributeHook
+		s.Assert().Equal(types.AttributeHookActionAdd, add.Action, "first call action")
+		s.Assert().Equal("example.attribute", add.Name, "first call name")
+		s.Assert().Equal(s.user1, add.Account, "first call account")
+		s.Assert().Equal([]byte("1"), add.Value, "first call value")
+		s.Assert().Equal("ATTRIBUTE_TYPE_STRING", add.AttributeType, "first call attribute type")
+		s.Assert().Equal(s.user1, add.Owner, "first call owner")
+		s.Assert().Equal(types.AttributeHookActionDelete, contractKeeper.Calls[1].AttributeHook.Action, "second call action")
+	})
+
+	s.Run("notify failure", func() {
+		contractKeeper := setup(types.AttributeHookMode_Notify, 100_000)
+		contractKeeper.SudoErr = "nope"
+		ctx, _ := s.ctx.CacheContext()
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+		err := s.app.AttributeKeeper.SetAttribute(ctx, newAttr("1"), s.user1Addr)
+		s.Require().NoError(err, "SetAttribute")
+		s.Assert().Equal([]string{"1"}, getValues(ctx), "attribute values")
+		s.Assert().True(hasEventType(ctx, "provenance.attribute.v1.EventAttributeHookFailed"), "has EventAttributeHookFailed")
+	})
+
+	s.Run("veto failure on add", func() {
+		contractKeeper := setup(types.AttributeHookMode_Veto, 100_000)
+		contractKeeper.SudoErr = "nope"
+		ctx, _ := s.ctx.CacheContext()
+		err := s.app.AttributeKeeper.SetAttribute(ctx, newAttr("1"), s.user1Addr)
+		s.Require().EqualError(err, "attribute hook contract "+contractAddr.String()+
+			` rejected add of "example.attribute" on `+s.user1+": nope", "SetAttribute")
+	})
+
+	s.Run("veto failure on delete", func() {
+		contractKeeper := setup(types.AttributeHookMode_Veto, 100_000)
+		ctx, _ := s.ctx.CacheContext()
+		err := s.app.AttributeKeeper.SetAttribute(ctx, newAttr("1"), s.user1Addr)
+		s.Require().NoError(err, "SetAttribute")
+		contractKeeper.SudoErr = "nope"
+		value := []byte("1")
+		err = s.app.AttributeKeeper.DeleteAttribute(ctx, s.user1, "example.attribute", &value, s.user1Addr)
+		s.Require().EqualError(err, "attribute hook contract "+contractAddr.String()+
+			` rejected delete of "example.attribute" on `+s.user1+": nope", "DeleteAttribute")
+	})
+
+	s.Run("notify update", func() {
+		contractKeeper := setup(types.AttributeHookMode_Notify, 100_000)
+		ctx, _ := s.ctx.CacheContext()
+		err := s.app.AttributeKeeper.SetAttribute(ctx, newAttr("1"), s.user1Addr)
+		s.Require().NoError(err, "SetAttribute")
+		err = s.app.AttributeKeeper.UpdateAttribute(ctx, newAttr("1"), newAttr("2"), s.user1Addr)
+		s.Require().NoError(err, "UpdateAttribute")
+
+		s.Require().Len(contractKeeper.Calls, 3, "contract calls")
+		del, add := contractKeeper.Calls[1].AttributeHook, contractKeeper.Calls[2].AttributeHook
+		s.Assert().Equal(types.AttributeHookActionDelete, del.Action, "second call action")
+		s.Assert().Equal([]byte("1"), del.Value, "second call value")
+		s.Assert().Equal(types.AttributeHookActionAdd, add.Action, "third call action")
+		s.Assert().Equal([]byte("2"), add.Value, "third call value")
+	})
+
+	s.Run("veto failure on update", func() {
+		contractKeeper := setup(types.AttributeHookMode_Veto, 100_000)
+		ctx, _ := s.ctx.CacheContext()
+		err := s.app.AttributeKeeper.SetAttribute(ctx, newAttr("1"), s.user1Addr)
+		s.Require().NoError(err, "SetAttribute")
+		contractKeeper.SudoErr = "nope"
+		err = s.app.AttributeKeeper.UpdateAttribute(ctx, newAttr("1"), newAttr("2"), s.user1Addr)
+		s.Require().EqualError(err, "attribute hook contract "+contractAddr.String()+
+			` rejected delete of "example.attribute" on `+s.user1+": nope", "UpdateAttribute")
+	})
+
+	s.Run("veto failure on rotation", func() {
+		contractKeeper := setup(types.AttributeHookMode_Veto, 100_000)
+		ctx, _ := s.ctx.CacheContext()
+		err := s.app.AttributeKeeper.SetAttribute(ctx, newAttr("1"), s.user1Addr)
+		s.Require().NoError(err, "SetAttribute")
+		contractKeeper.SudoErr = "nope"
+		err = s.app.AttributeKeeper.RotateAddress(ctx, s.user1Addr, s.user2Addr)
+		s.Require().EqualError(err, "attribute hook contract "+contractAddr.String()+
+			` rejected delete of "example.attribute" on `+s.user1+": nope", "RotateAddress")
+	})
+
+	s.Run("veto failure on purge", func() {
+		contractKeeper := setup(types.AttributeHookMode_Veto, 100_000)
+		ctx, _ := s.ctx.CacheContext()
+		orphanAttr := types.NewAttribute("example.attribute", s.user2, types.AttributeType_String, []byte("1"), nil)
+		err := s.app.AttributeKeeper.SetAttribute(ctx, orphanAttr, s.user1Addr)
+		s.Require().NoError(err, "SetAttribute")
+		contractKeeper.SudoErr = "nope"
+		err = s.app.AttributeKeeper.PurgeAccountAttributes(ctx, s.user2)
+		s.Require().EqualError(err, "attribute hook contract "+contractAddr.String()+
+			` rejected delete of "example.attribute" on `+s.user2+": nope", "PurgeAccountAttributes")
+	})
+
+	s.Run("veto failure on expiration", func() {
+		contractKeeper := setup(types.AttributeHookMode_Veto, 100_000)
+		ctx, _ := s.ctx.CacheContext()
+		expireTime := s.startBlockTime.Add(time.Hour).UTC()
+		expiring := newAttr("1")
+		expiring.ExpirationDate = &expireTime
+		err := s.app.AttributeKeeper.SetAttribute(ctx, expiring, s.user1Addr)
+		s.Require().NoError(err, "SetAttribute")
+		contractKeeper.SudoErr = "nope"
+
+		ctx = ctx.WithBlockTime(expireTime.Add(time.Second))
+		s.Assert().Equal(0, s.app.AttributeKeeper.DeleteExpiredAttributes(ctx, 0), "DeleteExpiredAttributes")
+		s.Assert().Equal([]string{"1"}, getValues(ctx), "attribute values after vetoed expiration")
+		s.Assert().Len(contractKeeper.Calls, 2, "contract calls after first DeleteExpiredAttributes")
+		s.Assert().Equal(0, s.app.AttributeKeeper.DeleteExpiredAttributes(ctx, 0), "second DeleteExpiredAttributes")
+		s.Assert().Len(contractKeeper.Calls, 2, "contract calls after second DeleteExpiredAttributes")
+	})
+
+	s.Run("veto out of gas", func() {
+		contractKeeper := setup(types.AttributeHookMode_Veto, 1_000)
+		contractKeeper.UseGas = 5_000
+		ctx, _ := s.ctx.CacheContext()
+		ctx = ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+		err := s.app.AttributeKeeper.SetAttribute(ctx, newAttr("1"), s.user1Addr)
+		s.Require().ErrorContains(err, "out of gas (limit 1000) in location: mock contract", "SetAttribute")
+		s.Assert().GreaterOrEqual(ctx.GasMeter().GasConsumed(), uint64(1_000), "gas consumed")
+	})
+
+	s.Run("name deleted", func() {
+		setup(types.AttributeHookMode_Veto, 100_000)
+		ctx, _ := s.ctx.CacheContext()
+		s.Require().NoError(s.app.NameKeeper.DeleteRecord(ctx, "example.attribute"), "DeleteRecord")
+		hook, err := s.app.AttributeKeeper.GetAttributeHook(ctx, "example.attribute")
+		s.Require().NoError(err, "GetAttributeHook")
+		s.Assert().Nil(hook, "GetAttributeHook after name deleted")
+	})
+}
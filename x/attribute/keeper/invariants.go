@@ -0,0 +1,43 @@
+package keeper
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/attribute/types"
+)
+
+const orphanedAttributesInvariant = "Orphaned-Attributes"
+
+// RegisterInvariants registers all attribute invariants.
+func RegisterInvariants(ir sdk.InvariantRegistry, keeper Keeper) {
+	ir.RegisterRoute(types.ModuleName, orphanedAttributesInvariant, OrphanedAttributesInvariant(keeper))
+}
+
+// OrphanedAttributesInvariant checks that there aren't any attributes on account addresses that do not exist in x/auth.
+// Such attributes can be removed using a MsgPurgeOrphanedAttributesRequest.
+func OrphanedAttributesInvariant(keeper Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		msg, broken := orphanedAttributesInvariantHelper(ctx, keeper)
+		return sdk.FormatInvariant(types.ModuleName, orphanedAttributesInvariant, msg), broken
+	}
+}
+
+// orphanedAttributesInvariantHelper does all the heavy lifting for OrphanedAttributesInvariant.
+func orphanedAttributesInvariantHelper(ctx sdk.Context, keeper Keeper) (string, bool) {
+	orphans, err := keeper.GetOrphanedAttributeAccounts(ctx, 0)
+	if err != nil {
+		return fmt.Sprintf("Failed to look up accounts with orphaned attributes: %v", err), true
+	}
+
+	switch len(orphans) {
+	case 0:
+		return "No accounts have orphaned attributes.", false
+	case 1:
+		return fmt.Sprintf("1 account that does not exist has attributes: %s", orphans[0]), true
+	default:
+		return fmt.Sprintf("%d accounts that do not exist have attributes:\n%s", len(orphans), strings.Join(orphans, "\n")), true
+	}
+}
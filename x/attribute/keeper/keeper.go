@@ -6,6 +6,8 @@ import (
 	"fmt"
 	"strings"
 
+	cmttypes "github.com/cometbft/cometbft/types"
+
 	"cosmossdk.io/log"
 	storetypes "cosmossdk.io/store/types"
 
@@ -15,6 +17,7 @@ import (
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
+	"github.com/provenance-io/provenance/internal/provutils"
 	"github.com/provenance-io/provenance/x/attribute/types"
 )
 
@@ -30,6 +33,11 @@ type Keeper struct {
 
 	// Key to access the key-value store from sdk.Context.
 	storeKey storetypes.StoreKey
+	// Key to access the transient store used for caching name ownership checks.
+	tStoreKey storetypes.StoreKey
+
+	// The keeper used to call attribute hook contracts (shared by all copies of this keeper).
+	hookContracts *hookContractKeeper
 
 	// The codec for binary encoding/decoding.
 	cdc codec.BinaryCodec
@@ -46,16 +54,18 @@ type Keeper struct {
 //
 // CONTRACT: the parameter Subspace must have the param key table already initialized
 func NewKeeper(
-	cdc codec.BinaryCodec, key storetypes.StoreKey,
+	cdc codec.BinaryCodec, key storetypes.StoreKey, tKey storetypes.StoreKey,
 	authKeeper types.AccountKeeper, nameKeeper types.NameKeeper,
 ) Keeper {
 	keeper := Keeper{
-		storeKey:   key,
-		authKeeper: authKeeper,
-		nameKeeper: nameKeeper,
-		cdc:        cdc,
-		modAddr:    authtypes.NewModuleAddress(types.ModuleName),
-		authority:  authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		storeKey:      key,
+		tStoreKey:     tKey,
+		hookContracts: &hookContractKeeper{},
+		authKeeper:    authKeeper,
+		nameKeeper:    nameKeeper,
+		cdc:           cdc,
+		modAddr:       authtypes.NewModuleAddress(types.ModuleName),
+		authority:     authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	}
 	nameKeeper.SetAttributeKeeper(keeper)
 	return keeper
@@ -81,7 +91,7 @@ func (k Keeper) ValidateAuthority(addr string) error {
 
 // Logger returns a module-specific logger.
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
-	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+	return provutils.ModuleLogger(ctx, types.ModuleName)
 }
 
 // GetAllAttributes gets all attributes for an address.
@@ -113,16 +123,33 @@ func (k Keeper) GetAttributes(ctx sdk.Context, addr string, name string) ([]type
 }
 
 // IterateRecords iterates over all the stored attribute records and passes them to a callback function.
+// Records are provided in ascending order of their store keys.
 func (k Keeper) IterateRecords(ctx sdk.Context, prefix []byte, handle Handler) error {
+	return k.iterateRecords(ctx, prefix, false, handle)
+}
+
+// IterateRecordsReverse is the same as IterateRecords except records are provided in descending order of their store keys.
+func (k Keeper) IterateRecordsReverse(ctx sdk.Context, prefix []byte, handle Handler) error {
+	return k.iterateRecords(ctx, prefix, true, handle)
+}
+
+// iterateRecords iterates over the stored attribute records with the given prefix, in key order (or reverse key order),
+// and passes them to a callback function.
+func (k Keeper) iterateRecords(ctx sdk.Context, prefix []byte, reverse bool, handle Handler) error {
 	// Init an attribute record iterator
 	store := ctx.KVStore(k.storeKey)
-	iterator := storetypes.KVStorePrefixIterator(store, prefix)
+	var iterator storetypes.Iterator
+	if reverse {
+		iterator = storetypes.KVStoreReversePrefixIterator(store, prefix)
+	} else {
+		iterator = storetypes.KVStorePrefixIterator(store, prefix)
+	}
 	defer iterator.Close()
 	// Iterate over records, processing callbacks.
 	for ; iterator.Valid(); iterator.Next() {
 		record := types.Attribute{}
 		// get proto objects for legacy prefix with legacy amino codec.
-		if err := k.cdc.Unmarshal(iterator.Value(), &record); err != nil {
+		if err := types.UnmarshalStoredAttribute(k.cdc, iterator.Value(), &record); err != nil {
 			return err
 		}
 		if err := handle(record); err != nil {
@@ -163,11 +190,16 @@ func (k Keeper) SetAttribute(
 		return fmt.Errorf("no account found for owner address %q", owner.String())
 	}
 	// Verify name resolves to owner
-	if !k.nameKeeper.ResolvesTo(ctx, attr.Name, owner) {
+	if !k.resolvesTo(ctx, attr.Name, owner) {
 		return fmt.Errorf("%q does not resolve to address %q", attr.Name, owner.String())
 	}
+	// Make sure the account has room for another attribute
+	if err = k.validateAttributeQuota(ctx, attr); err != nil {
+		return err
+	}
+	attr.Origin = newAttributeOrigin(ctx, owner)
 	// Store the sanitized account attribute
-	bz, err := k.cdc.Marshal(&attr)
+	bz, err := types.MarshalStoredAttribute(k.cdc, attr)
 	if err != nil {
 		return err
 	}
@@ -177,13 +209,30 @@ func (k Keeper) SetAttribute(
 	store := ctx.KVStore(k.storeKey)
 	store.Set(key, bz)
 	k.IncAttrNameAddressLookup(ctx, attr.Name, attr.GetAddressBytes())
+	k.addAttributeValueLookup(store, attr)
 	k.addAttributeExpireLookup(store, attr)
 
+	if err = k.runAttributeHook(ctx, types.AttributeHookActionAdd, attr, owner); err != nil {
+		return err
+	}
+
 	attributeAddEvent := types.NewEventAttributeAdd(attr, owner.String())
 
 	return ctx.EventManager().EmitTypedEvent(attributeAddEvent)
 }
 
+// newAttributeOrigin creates the origin for an attribute being set by the provided owner in the current transaction.
+func newAttributeOrigin(ctx sdk.Context, owner sdk.AccAddress) *types.AttributeOrigin {
+	rv := &types.AttributeOrigin{
+		Setter:      owner.String(),
+		BlockHeight: ctx.BlockHeight(),
+	}
+	if txBytes := ctx.TxBytes(); len(txBytes) > 0 {
+		rv.TxHash = fmt.Sprintf("%X", cmttypes.Tx(txBytes).Hash())
+	}
+	return rv
+}
+
 // IncAttrNameAddressLookup increments the count of name to address lookups
 func (k Keeper) IncAttrNameAddressLookup(ctx sdk.Context, name string, addrBytes []byte) {
 	store := ctx.KVStore(k.storeKey)
@@ -246,12 +295,13 @@ func (k Keeper) UpdateAttribute(ctx sdk.Context, originalAttribute types.Attribu
 	}
 
 	updateAttribute.Name = normalizedName
+	updateAttribute.Origin = newAttributeOrigin(ctx, owner)
 
 	if ownerAcc := k.authKeeper.GetAccount(ctx, owner); ownerAcc == nil {
 		return fmt.Errorf("no account found for owner address %q", owner.String())
 	}
 
-	if !k.nameKeeper.ResolvesTo(ctx, updateAttribute.Name, owner) {
+	if !k.resolvesTo(ctx, updateAttribute.Name, owner) {
 		return fmt.Errorf("%q does not resolve to address %q", updateAttribute.Name, owner.String())
 	}
 
@@ -263,7 +313,7 @@ func (k Keeper) UpdateAttribute(ctx sdk.Context, originalAttribute types.Attribu
 	var found bool
 	if currentAttr != nil {
 		attr := types.Attribute{}
-		if err := k.cdc.Unmarshal(currentAttr, &attr); err != nil {
+		if err := types.UnmarshalStoredAttribute(k.cdc, currentAttr, &attr); err != nil {
 			return err
 		}
 
@@ -272,17 +322,26 @@ func (k Keeper) UpdateAttribute(ctx sdk.Context, originalAttribute types.Attribu
 
 			store.Delete(attrKey)
 			k.DecAttrNameAddressLookup(ctx, attr.Name, addrBz)
+			k.deleteAttributeValueLookup(store, attr)
 			k.deleteAttributeExpireLookup(store, attr)
 
-			bz, err := k.cdc.Marshal(&updateAttribute)
+			bz, err := types.MarshalStoredAttribute(k.cdc, updateAttribute)
 			if err != nil {
 				return err
 			}
 			updatedKey := types.AddrAttributeKey(addrBz, updateAttribute)
 			store.Set(updatedKey, bz)
 			k.IncAttrNameAddressLookup(ctx, updateAttribute.Name, updateAttribute.GetAddressBytes())
+			k.addAttributeValueLookup(store, updateAttribute)
 			k.addAttributeExpireLookup(store, updateAttribute)
 
+			if err = k.runAttributeHook(ctx, types.AttributeHookActionDelete, attr, owner); err != nil {
+				return err
+			}
+			if err = k.runAttributeHook(ctx, types.AttributeHookActionAdd, updateAttribute, owner); err != nil {
+				return err
+			}
+
 			attributeUpdateEvent := types.NewEventAttributeUpdate(originalAttribute, updateAttribute, owner.String())
 			if err := ctx.EventManager().EmitTypedEvent(attributeUpdateEvent); err != nil {
 				return err
@@ -315,7 +374,7 @@ func (k Keeper) UpdateAttributeExpiration(ctx sdk.Context, updateAttribute types
 		return fmt.Errorf("no account found for owner address %q", owner.String())
 	}
 
-	if !k.nameKeeper.ResolvesTo(ctx, updateAttribute.Name, owner) {
+	if !k.resolvesTo(ctx, updateAttribute.Name, owner) {
 		return fmt.Errorf("%q does not resolve to address %q", updateAttribute.Name, owner.String())
 	}
 
@@ -324,7 +383,7 @@ func (k Keeper) UpdateAttributeExpiration(ctx sdk.Context, updateAttribute types
 	currentAttr := store.Get(attrKey)
 	if currentAttr != nil {
 		attr := types.Attribute{}
-		if err := k.cdc.Unmarshal(currentAttr, &attr); err != nil {
+		if err := types.UnmarshalStoredAttribute(k.cdc, currentAttr, &attr); err != nil {
 			return err
 		}
 
@@ -332,7 +391,7 @@ func (k Keeper) UpdateAttributeExpiration(ctx sdk.Context, updateAttribute types
 
 		originalExpiration := attr.ExpirationDate
 		attr.ExpirationDate = updateAttribute.ExpirationDate
-		bz, err := k.cdc.Marshal(&attr)
+		bz, err := types.MarshalStoredAttribute(k.cdc, attr)
 		if err != nil {
 			return err
 		}
@@ -346,7 +405,7 @@ func (k Keeper) UpdateAttributeExpiration(ctx sdk.Context, updateAttribute types
 		}
 	} else {
 		errorMessage := "no attributes updated"
-		ctx.Logger().Error(errorMessage, "name", updateAttribute.Name, "value", string(updateAttribute.Value))
+		k.Logger(ctx).Error(errorMessage, "name", updateAttribute.Name, "value", string(updateAttribute.Value))
 		return fmt.Errorf("%s with name %q : value %q : type: %s", errorMessage, updateAttribute.Name, string(updateAttribute.Value), updateAttribute.AttributeType.String())
 	}
 
@@ -382,7 +441,7 @@ func (k Keeper) DeleteAttribute(ctx sdk.Context, addr string, name string, value
 		return fmt.Errorf("no account found for owner address %q", owner.String())
 	}
 
-	if !k.nameKeeper.ResolvesTo(ctx, name, owner) {
+	if !k.resolvesTo(ctx, name, owner) {
 		if k.nameKeeper.NameExists(ctx, name) {
 			return fmt.Errorf("%q does not resolve to address %q", name, owner.String())
 		}
@@ -400,7 +459,7 @@ func (k Keeper) DeleteAttribute(ctx sdk.Context, addr string, name string, value
 	attrToDelete := []types.Attribute{} // do delete logic outside of iterator
 	for ; iter.Valid(); iter.Next() {
 		attr := types.Attribute{}
-		if err := k.cdc.Unmarshal(iter.Value(), &attr); err != nil {
+		if err := types.UnmarshalStoredAttribute(k.cdc, iter.Value(), &attr); err != nil {
 			return err
 		}
 
@@ -415,7 +474,11 @@ func (k Keeper) DeleteAttribute(ctx sdk.Context, addr string, name string, value
 		addrBz := attr.GetAddressBytes()
 		store.Delete(types.AddrAttributeKey(addrBz, attr))
 		k.DecAttrNameAddressLookup(ctx, attr.Name, addrBz)
+		k.deleteAttributeValueLookup(store, attr)
 		k.deleteAttributeExpireLookup(store, attr)
+		if err := k.runAttributeHook(ctx, types.AttributeHookActionDelete, attr, owner); err != nil {
+			return err
+		}
 		if !deleteDistinct {
 			deleteEvent := types.NewEventAttributeDelete(name, addr, owner.String())
 			if err := ctx.EventManager().EmitTypedEvent(deleteEvent); err != nil {
@@ -439,13 +502,43 @@ func (k Keeper) DeleteAttribute(ctx sdk.Context, addr string, name string, value
 	return nil
 }
 
+// CheckOriginalValueHash returns an error if the stored value of an attribute no longer has the provided hash.
+// If a value is provided, only the attribute with that value is checked (and it's not an error if there isn't one).
+// Otherwise, the account must have exactly one attribute with the name, and its value must have the hash.
+// An empty hash is not checked.
+func (k Keeper) CheckOriginalValueHash(ctx sdk.Context, addr string, name string, value []byte, hash []byte) error {
+	if len(hash) == 0 {
+		return nil
+	}
+
+	name = strings.ToLower(strings.TrimSpace(name))
+	pred := func(s string) bool { return s == name }
+	attrs, err := k.prefixScan(ctx, types.AddrStrAttributesNameKeyPrefix(addr, name), pred)
+	if err != nil {
+		return err
+	}
+
+	if value == nil && len(attrs) > 1 {
+		return fmt.Errorf("account %s has %d attributes with name %q, expected one to match the original value hash", addr, len(attrs), name)
+	}
+	for _, attr := range attrs {
+		if value != nil && !bytes.Equal(value, attr.Value) {
+			continue
+		}
+		if actual := attr.Hash(); !bytes.Equal(hash, actual) {
+			return fmt.Errorf("attribute %q on account %s has value hash %X, expected original value hash %X", name, addr, actual, hash)
+		}
+	}
+	return nil
+}
+
 // PurgeAttribute removes attributes under the given account from the state store.
 func (k Keeper) PurgeAttribute(ctx sdk.Context, name string, owner sdk.AccAddress) error {
 	if ownerAcc := k.authKeeper.GetAccount(ctx, owner); ownerAcc == nil {
 		return fmt.Errorf("no account found for owner address %q", owner.String())
 	}
 
-	if !k.nameKeeper.ResolvesTo(ctx, name, owner) {
+	if !k.resolvesTo(ctx, name, owner) {
 		if k.nameKeeper.NameExists(ctx, name) {
 			return fmt.Errorf("%q does not resolve to address %q", name, owner.String())
 		}
@@ -460,8 +553,13 @@ func (k Keeper) PurgeAttribute(ctx sdk.Context, name string, owner sdk.AccAddres
 	for _, acct := range accts {
 		attrToDelete := k.getAddrAttributesKeysByName(store, acct, name)
 		for _, key := range attrToDelete {
+			var attr types.Attribute
+			if err = types.UnmarshalStoredAttribute(k.cdc, store.Get(key), &attr); err != nil {
+				return err
+			}
 			store.Delete(key)
 			k.DecAttrNameAddressLookup(ctx, name, acct)
+			k.deleteAttributeValueLookup(store, attr)
 		}
 	}
 	return nil
@@ -487,7 +585,7 @@ func (k Keeper) prefixScan(ctx sdk.Context, prefix []byte, f namePred) (attrs []
 	defer it.Close()
 	for ; it.Valid(); it.Next() {
 		attr := types.Attribute{}
-		if err = k.cdc.Unmarshal(it.Value(), &attr); err != nil {
+		if err = types.UnmarshalStoredAttribute(k.cdc, it.Value(), &attr); err != nil {
 			return
 		}
 		if f(attr.Name) {
@@ -515,7 +613,7 @@ func (k Keeper) importAttribute(ctx sdk.Context, attr types.Attribute) error {
 		return fmt.Errorf("unable to normalize attribute name %q: %w", attrNameOrig, err)
 	}
 	// Store the sanitized account attribute
-	bz, err := k.cdc.Marshal(&attr)
+	bz, err := types.MarshalStoredAttribute(k.cdc, attr)
 	if err != nil {
 		return err
 	}
@@ -523,12 +621,15 @@ func (k Keeper) importAttribute(ctx sdk.Context, attr types.Attribute) error {
 	store := ctx.KVStore(k.storeKey)
 	store.Set(key, bz)
 	k.IncAttrNameAddressLookup(ctx, attr.Name, attr.GetAddressBytes())
+	k.addAttributeValueLookup(store, attr)
 	k.addAttributeExpireLookup(store, attr)
 	return nil
 }
 
 // DeleteExpiredAttributes find and delete expired attributes returns the total deleted
 // limit sets the max amount to delete in a call, 0 for not limit
+// If a veto mode hook contract rejects the deletion of an expired attribute, the attribute is
+// left in place, but it is no longer scheduled for deletion, so it must be deleted by its owner.
 func (k Keeper) DeleteExpiredAttributes(ctx sdk.Context, limit int) int {
 	expirationKeys := [][]byte{}
 	store := ctx.KVStore(k.storeKey)
@@ -545,19 +646,25 @@ func (k Keeper) DeleteExpiredAttributes(ctx sdk.Context, limit int) int {
 		bz := store.Get(attrKey)
 		if bz != nil {
 			var attribute types.Attribute
-			if err := k.cdc.Unmarshal(bz, &attribute); err == nil {
+			if err := types.UnmarshalStoredAttribute(k.cdc, bz, &attribute); err == nil {
+				if err = k.runAttributeHook(ctx, types.AttributeHookActionDelete, attribute, nil); err != nil {
+					k.Logger(ctx).Error("expired attribute not deleted", "name", attribute.Name, "account", attribute.Address, "error", err)
+					store.Delete(expirationKey)
+					continue
+				}
 				// delete attribute from store
 				store.Delete(attrKey)
 				// dec name to address lookup table count
 				k.DecAttrNameAddressLookup(ctx, attribute.Name, attribute.GetAddressBytes())
+				k.deleteAttributeValueLookup(store, attribute)
 
 				deleteExpirationEvent := types.NewEventAttributeExpired(attribute)
 				if err = ctx.EventManager().EmitTypedEvent(deleteExpirationEvent); err != nil {
-					ctx.Logger().Error(fmt.Sprintf("failed to emit typed event %v", err))
+					k.Logger(ctx).Error("failed to emit typed event", "name", attribute.Name, "account", attribute.Address, "error", err)
 				}
 				count++
 			} else {
-				ctx.Logger().Error(fmt.Sprintf("unable to unmarshal attribute to delete key: %v error: %v", attrKey, err))
+				k.Logger(ctx).Error("unable to unmarshal expired attribute", "key", fmt.Sprintf("%X", attrKey), "error", err)
 			}
 		}
 
@@ -570,6 +677,74 @@ func (k Keeper) DeleteExpiredAttributes(ctx sdk.Context, limit int) int {
 	return count
 }
 
+// AccountsByAttributeValue returns the addresses that have an attribute with the given name and value hash.
+func (k Keeper) AccountsByAttributeValue(ctx sdk.Context, name string, valueHash []byte) []sdk.AccAddress {
+	var addresses []sdk.AccAddress
+	store := ctx.KVStore(k.storeKey)
+	keyPrefix := types.AttributeNameValueKeyPrefix(name, valueHash)
+	it := storetypes.KVStorePrefixIterator(store, keyPrefix)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		addrBz := it.Key()[len(keyPrefix):]
+		addresses = append(addresses, addrBz[1:int(addrBz[0])+1])
+	}
+	return addresses
+}
+
+// addAttributeValueLookup adds the attribute name and value hash to address lookup entry for an attribute.
+// If the attribute has an INT64, FLOAT64, or TIMESTAMP value, its range lookup entry is also added.
+func (k Keeper) addAttributeValueLookup(store storetypes.KVStore, attr types.Attribute) {
+	store.Set(types.AttributeNameValueAddrKey(attr.Name, attr.Hash(), attr.GetAddressBytes()), []byte{})
+	if rangeKey := getAttributeRangeKey(attr); rangeKey != nil {
+		store.Set(rangeKey, attr.Value)
+	}
+}
+
+// deleteAttributeValueLookup removes the attribute name and value hash to address lookup entry for an attribute.
+// If the attribute has an INT64, FLOAT64, or TIMESTAMP value, its range lookup entry is also removed.
+func (k Keeper) deleteAttributeValueLookup(store storetypes.KVStore, attr types.Attribute) {
+	store.Delete(types.AttributeNameValueAddrKey(attr.Name, attr.Hash(), attr.GetAddressBytes()))
+	if rangeKey := getAttributeRangeKey(attr); rangeKey != nil {
+		store.Delete(rangeKey)
+	}
+}
+
+// getAttributeRangeKey returns the range lookup key for an attribute, or nil if the attribute's value cannot be looked up by range.
+func getAttributeRangeKey(attr types.Attribute) []byte {
+	if !types.IsRangeType(attr.AttributeType) {
+		return nil
+	}
+	rangeValue, err := types.GetRangeLookupValue(attr.AttributeType, attr.Value)
+	if err != nil {
+		return nil
+	}
+	return types.AttributeRangeAddrKey(attr.Name, attr.AttributeType, rangeValue, attr.GetAddressBytes())
+}
+
+// RebuildAttributeValueLookups recreates the attribute name and value hash to address lookup entries
+// from the attributes in state.
+func (k Keeper) RebuildAttributeValueLookups(ctx sdk.Context) (int, error) {
+	store := ctx.KVStore(k.storeKey)
+
+	var oldKeys [][]byte
+	it := storetypes.KVStorePrefixIterator(store, types.AttributeValueLookupPrefix)
+	for ; it.Valid(); it.Next() {
+		oldKeys = append(oldKeys, it.Key())
+	}
+	it.Close()
+	for _, key := range oldKeys {
+		store.Delete(key)
+	}
+
+	count := 0
+	err := k.IterateRecords(ctx, types.AttributeKeyPrefix, func(attr types.Attribute) error {
+		k.addAttributeValueLookup(store, attr)
+		count++
+		return nil
+	})
+	return count, err
+}
+
 // addAttributeExpireLookup safely adds attribute expire key to store, if expire date exists, else no-op
 func (k Keeper) addAttributeExpireLookup(store storetypes.KVStore, attr types.Attribute) {
 	expireKey := types.AttributeExpireKey(attr)
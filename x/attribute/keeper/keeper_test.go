@@ -1,6 +1,8 @@
 package keeper_test
 
 import (
+	"bytes"
+	"crypto/sha256"
 	"encoding/binary"
 	"fmt"
 	"strings"
@@ -11,6 +13,8 @@ import (
 
 	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
 
+	storetypes "cosmossdk.io/store/types"
+
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -18,6 +22,7 @@ import (
 	"github.com/provenance-io/provenance/app"
 	simapp "github.com/provenance-io/provenance/app"
 	"github.com/provenance-io/provenance/internal/pioconfig"
+	"github.com/provenance-io/provenance/x/attribute/keeper"
 	"github.com/provenance-io/provenance/x/attribute/types"
 	nametypes "github.com/provenance-io/provenance/x/name/types"
 )
@@ -73,6 +78,11 @@ func (s *KeeperTestSuite) SetupTest() {
 	s.app.AccountKeeper.SetAccount(s.ctx, s.app.AccountKeeper.NewAccountWithAddress(s.ctx, s.user1Addr))
 }
 
+// newOrigin returns the origin expected on an attribute set by the provided address using s.ctx.
+func (s *KeeperTestSuite) newOrigin(setter string) *types.AttributeOrigin {
+	return &types.AttributeOrigin{Setter: setter, BlockHeight: s.ctx.BlockHeight()}
+}
+
 func (s *KeeperTestSuite) TestSetAttribute() {
 	past := time.Now().Add(-2 * time.Hour)
 
@@ -714,6 +724,86 @@ func (s *KeeperTestSuite) TestDeleteDistinctAttribute() {
 	}
 }
 
+func (s *KeeperTestSuite) TestCheckOriginalValueHash() {
+	single := types.NewAttribute("attribute", s.user1, types.AttributeType_String, []byte("single"), nil)
+	multi1 := types.NewAttribute("example.attribute", s.user1, types.AttributeType_String, []byte("multi1"), nil)
+	multi2 := types.NewAttribute("example.attribute", s.user1, types.AttributeType_String, []byte("multi2"), nil)
+	for _, attr := range []types.Attribute{single, multi1, multi2} {
+		s.Require().NoError(s.app.AttributeKeeper.SetAttribute(s.ctx, attr, s.user1Addr), "SetAttribute(%s)", attr)
+	}
+	otherHash := sha256.Sum256([]byte("other"))
+
+	tests := []struct {
+		name   string
+		attr   string
+		value  []byte
+		hash   []byte
+		expErr string
+	}{
+		{
+			name: "no hash",
+			attr: "example.attribute",
+		},
+		{
+			name: "single value matches",
+			attr: "attribute",
+			hash: single.Hash(),
+		},
+		{
+			name: "single value with upper case name matches",
+			attr: "ATTRIBUTE",
+			hash: single.Hash(),
+		},
+		{
+			name:   "single value does not match",
+			attr:   "attribute",
+			hash:   otherHash[:],
+			expErr: fmt.Sprintf("attribute \"attribute\" on account %s has value hash %X, expected original value hash %X", s.user1, single.Hash(), otherHash[:]),
+		},
+		{
+			name:   "multiple values",
+			attr:   "example.attribute",
+			hash:   multi1.Hash(),
+			expErr: fmt.Sprintf("account %s has 2 attributes with name \"example.attribute\", expected one to match the original value hash", s.user1),
+		},
+		{
+			name:  "specific value matches",
+			attr:  "example.attribute",
+			value: multi2.Value,
+			hash:  multi2.Hash(),
+		},
+		{
+			name:   "specific value does not match",
+			attr:   "example.attribute",
+			value:  multi2.Value,
+			hash:   multi1.Hash(),
+			expErr: fmt.Sprintf("attribute \"example.attribute\" on account %s has value hash %X, expected original value hash %X", s.user1, multi2.Hash(), multi1.Hash()),
+		},
+		{
+			name:  "specific value not found",
+			attr:  "example.attribute",
+			value: []byte("unknown"),
+			hash:  otherHash[:],
+		},
+		{
+			name: "no attributes with name",
+			attr: "unknown.attribute",
+			hash: otherHash[:],
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			err := s.app.AttributeKeeper.CheckOriginalValueHash(s.ctx, s.user1, tc.attr, tc.value, tc.hash)
+			if len(tc.expErr) > 0 {
+				s.Assert().EqualError(err, tc.expErr, "CheckOriginalValueHash error")
+			} else {
+				s.Assert().NoError(err, "CheckOriginalValueHash error")
+			}
+		})
+	}
+}
+
 func (s *KeeperTestSuite) TestGetAllAttributes() {
 	s.runGetAllAttributesTests("GetAllAttributes", func() ([]types.Attribute, error) {
 		return s.app.AttributeKeeper.GetAllAttributes(s.ctx, s.user1)
@@ -734,6 +824,7 @@ func (s *KeeperTestSuite) runGetAllAttributesTests(funcName string, attrGetter f
 			Value:         []byte(strings.Repeat(fmt.Sprintf("%d", i), 10)),
 			Address:       s.user1,
 			AttributeType: types.AttributeType_String,
+			Origin:        s.newOrigin(s.user1),
 		}
 	}
 	attrsSetUp := uint(0)
@@ -891,6 +982,223 @@ func (s *KeeperTestSuite) TestGetAddressesByName() {
 	s.Assert().ElementsMatch([]sdk.AccAddress{s.user1Addr, s.user2Addr}, addrs)
 }
 
+func (s *KeeperTestSuite) TestAccountsByAttributeValue() {
+	attr := types.Attribute{
+		Name:          "example.attribute",
+		Value:         []byte("0123456789"),
+		Address:       s.user1,
+		AttributeType: types.AttributeType_String,
+	}
+	otherAttr := attr
+	otherAttr.Value = []byte("9876543210")
+	otherAttr.Address = s.user2
+
+	valueHash := attr.Hash()
+	otherHash := otherAttr.Hash()
+	s.Require().NoError(s.app.AttributeKeeper.SetAttribute(s.ctx, attr, s.user1Addr), "SetAttribute user1")
+	s.Require().NoError(s.app.AttributeKeeper.SetAttribute(s.ctx, otherAttr, s.user1Addr), "SetAttribute user2")
+	s.Assert().ElementsMatch([]sdk.AccAddress{s.user1Addr}, s.app.AttributeKeeper.AccountsByAttributeValue(s.ctx, attr.Name, valueHash), "after set: value")
+	s.Assert().ElementsMatch([]sdk.AccAddress{s.user2Addr}, s.app.AttributeKeeper.AccountsByAttributeValue(s.ctx, attr.Name, otherHash), "after set: other value")
+	s.Assert().Empty(s.app.AttributeKeeper.AccountsByAttributeValue(s.ctx, "attribute", valueHash), "after set: other name")
+
+	updatedAttr := otherAttr
+	updatedAttr.Value = attr.Value
+	s.Require().NoError(s.app.AttributeKeeper.UpdateAttribute(s.ctx, otherAttr, updatedAttr, s.user1Addr), "UpdateAttribute")
+	s.Assert().ElementsMatch([]sdk.AccAddress{s.user1Addr, s.user2Addr}, s.app.AttributeKeeper.AccountsByAttributeValue(s.ctx, attr.Name, valueHash), "after update: value")
+	s.Assert().Empty(s.app.AttributeKeeper.AccountsByAttributeValue(s.ctx, attr.Name, otherHash), "after update: other value")
+
+	s.Require().NoError(s.app.AttributeKeeper.DeleteAttribute(s.ctx, s.user1, attr.Name, &attr.Value, s.user1Addr), "DeleteAttribute")
+	s.Assert().ElementsMatch([]sdk.AccAddress{s.user2Addr}, s.app.AttributeKeeper.AccountsByAttributeValue(s.ctx, attr.Name, valueHash), "after delete: value")
+
+	store := s.ctx.KVStore(s.app.GetKey(types.StoreKey))
+	store.Delete(types.AttributeNameValueAddrKey(attr.Name, valueHash, s.user2Addr))
+	s.Assert().Empty(s.app.AttributeKeeper.AccountsByAttributeValue(s.ctx, attr.Name, valueHash), "after removing lookup entry")
+	count, err := s.app.AttributeKeeper.RebuildAttributeValueLookups(s.ctx)
+	s.Require().NoError(err, "RebuildAttributeValueLookups")
+	s.Assert().Equal(1, count, "RebuildAttributeValueLookups count")
+	s.Assert().ElementsMatch([]sdk.AccAddress{s.user2Addr}, s.app.AttributeKeeper.AccountsByAttributeValue(s.ctx, attr.Name, valueHash), "after rebuild: value")
+
+	s.Require().NoError(s.app.AttributeKeeper.PurgeAttribute(s.ctx, attr.Name, s.user1Addr), "PurgeAttribute")
+	s.Assert().Empty(s.app.AttributeKeeper.AccountsByAttributeValue(s.ctx, attr.Name, valueHash), "after purge: value")
+
+	expireTime := s.startBlockTime.Add(time.Hour)
+	expiringAttr := attr
+	expiringAttr.ExpirationDate = &expireTime
+	s.Require().NoError(s.app.AttributeKeeper.SetAttribute(s.ctx, expiringAttr, s.user1Addr), "SetAttribute expiring")
+	s.Assert().ElementsMatch([]sdk.AccAddress{s.user1Addr}, s.app.AttributeKeeper.AccountsByAttributeValue(s.ctx, attr.Name, valueHash), "after set: expiring")
+	s.ctx = s.ctx.WithBlockTime(expireTime.Add(time.Second))
+	s.Assert().Equal(1, s.app.AttributeKeeper.DeleteExpiredAttributes(s.ctx, 0), "DeleteExpiredAttributes")
+	s.Assert().Empty(s.app.AttributeKeeper.AccountsByAttributeValue(s.ctx, attr.Name, valueHash), "after expiration")
+}
+
+func (s *KeeperTestSuite) TestAttributeOrigin() {
+	txBytes := []byte("some transaction bytes")
+	txHash := fmt.Sprintf("%X", sha256.Sum256(txBytes))
+	ctx := s.ctx.WithBlockHeight(42).WithTxBytes(txBytes)
+
+	attr := types.NewAttribute("example.attribute", s.user2, types.AttributeType_String, []byte("first"), nil)
+	attr.Origin = &types.AttributeOrigin{Setter: s.user2, TxHash: "ignored", BlockHeight: 1}
+	s.Require().NoError(s.app.AttributeKeeper.SetAttribute(ctx, attr, s.user1Addr), "SetAttribute")
+	expOrigin := &types.AttributeOrigin{Setter: s.user1, TxHash: txHash, BlockHeight: 42}
+	attrs, err := s.app.AttributeKeeper.GetAttributes(ctx, s.user2, attr.Name)
+	s.Require().NoError(err, "GetAttributes after set")
+	s.Require().Len(attrs, 1, "attributes after set")
+	s.Assert().Equal(expOrigin, attrs[0].Origin, "origin after set")
+
+	expireTime := s.startBlockTime.Add(time.Hour).UTC()
+	expiring := attrs[0]
+	expiring.ExpirationDate = &expireTime
+	s.Require().NoError(s.app.AttributeKeeper.UpdateAttributeExpiration(s.ctx.WithBlockHeight(43), expiring, s.user1Addr), "UpdateAttributeExpiration")
+	attrs, err = s.app.AttributeKeeper.GetAttributes(ctx, s.user2, attr.Name)
+	s.Require().NoError(err, "GetAttributes after expiration update")
+	s.Require().Len(attrs, 1, "attributes after expiration update")
+	s.Assert().Equal(expOrigin, attrs[0].Origin, "origin after expiration update")
+
+	updated := attrs[0]
+	updated.Value = []byte("second")
+	ctx = s.ctx.WithBlockHeight(44)
+	s.Require().NoError(s.app.AttributeKeeper.UpdateAttribute(ctx, attrs[0], updated, s.user1Addr), "UpdateAttribute")
+	attrs, err = s.app.AttributeKeeper.GetAttributes(ctx, s.user2, attr.Name)
+	s.Require().NoError(err, "GetAttributes after update")
+	s.Require().Len(attrs, 1, "attributes after update")
+	s.Assert().Equal(&types.AttributeOrigin{Setter: s.user1, BlockHeight: 44}, attrs[0].Origin, "origin after update")
+}
+
+func (s *KeeperTestSuite) TestRotateAddress() {
+	newAttr := func(value string, addr string, expiration *time.Time) types.Attribute {
+		return types.Attribute{
+			Name:           "example.attribute",
+			Value:          []byte(value),
+			Address:        addr,
+			AttributeType:  types.AttributeType_String,
+			ExpirationDate: expiration,
+			Origin:         s.newOrigin(s.user1),
+		}
+	}
+	expireTime := s.startBlockTime.Add(time.Hour).UTC()
+	moved := newAttr("moved", s.user1, nil)
+	shared := newAttr("shared", s.user1, nil)
+	expiring := newAttr("expiring", s.user1, &expireTime)
+	for _, attr := range []types.Attribute{moved, shared, expiring, newAttr("shared", s.user2, nil)} {
+		s.Require().NoError(s.app.AttributeKeeper.SetAttribute(s.ctx, attr, s.user1Addr), "SetAttribute(%q, %s)", attr.Value, attr.Address)
+	}
+
+	// An attestation from another name owner stays on the old account.
+	issuerAddr := sdk.AccAddress("attestation_issuer__")
+	s.app.AccountKeeper.SetAccount(s.ctx, s.app.AccountKeeper.NewAccountWithAddress(s.ctx, issuerAddr))
+	s.Require().NoError(s.app.NameKeeper.SetNameRecord(s.ctx, "attest", issuerAddr, false), "SetNameRecord(attest)")
+	attested := types.Attribute{
+		Name:          "attest",
+		Value:         []byte("kyc"),
+		Address:       s.user1,
+		AttributeType: types.AttributeType_String,
+		Origin:        s.newOrigin(issuerAddr.String()),
+	}
+	s.Require().NoError(s.app.AttributeKeeper.SetAttribute(s.ctx, attested, issuerAddr), "SetAttribute(attested)")
+
+	ctx := s.ctx.WithBlockTime(expireTime.Add(time.Second))
+	s.Require().NoError(s.app.AttributeKeeper.RotateAddress(ctx, s.user1Addr, s.user2Addr), "RotateAddress")
+
+	user1Attrs, err := s.app.AttributeKeeper.GetAllAttributes(ctx, s.user1)
+	s.Require().NoError(err, "GetAllAttributes(user1)")
+	s.Assert().ElementsMatch([]types.Attribute{expiring, attested}, user1Attrs, "user1 attributes after rotation")
+	user2Attrs, err := s.app.AttributeKeeper.GetAllAttributes(ctx, s.user2)
+	s.Require().NoError(err, "GetAllAttributes(user2)")
+	s.Assert().ElementsMatch([]types.Attribute{newAttr("moved", s.user2, nil), newAttr("shared", s.user2, nil)}, user2Attrs, "user2 attributes after rotation")
+
+	s.Assert().ElementsMatch([]sdk.AccAddress{s.user2Addr}, s.app.AttributeKeeper.AccountsByAttributeValue(ctx, moved.Name, moved.Hash()), "accounts with moved value")
+	s.Assert().ElementsMatch([]sdk.AccAddress{s.user2Addr}, s.app.AttributeKeeper.AccountsByAttributeValue(ctx, shared.Name, shared.Hash()), "accounts with shared value")
+	accounts, err := s.app.AttributeKeeper.AccountsByAttribute(ctx, moved.Name)
+	s.Require().NoError(err, "AccountsByAttribute")
+	s.Assert().ElementsMatch([]sdk.AccAddress{s.user1Addr, s.user2Addr}, accounts, "accounts with attribute")
+
+	s.Assert().Equal(1, s.app.AttributeKeeper.DeleteExpiredAttributes(ctx, 0), "DeleteExpiredAttributes")
+	accounts, err = s.app.AttributeKeeper.AccountsByAttribute(ctx, moved.Name)
+	s.Require().NoError(err, "AccountsByAttribute after expiration")
+	s.Assert().ElementsMatch([]sdk.AccAddress{s.user2Addr}, accounts, "accounts with attribute after expiration")
+}
+
+func (s *KeeperTestSuite) TestAttributeRangeLookups() {
+	attr := types.Attribute{
+		Name:          "example.attribute",
+		Value:         types.Int64Value(-7),
+		Address:       s.user1,
+		AttributeType: types.AttributeType_Int64,
+	}
+	rangeValue, err := types.GetRangeLookupValue(attr.AttributeType, attr.Value)
+	s.Require().NoError(err, "GetRangeLookupValue")
+	rangeKey := types.AttributeRangeAddrKey(attr.Name, attr.AttributeType, rangeValue, s.user1Addr)
+	store := s.ctx.KVStore(s.app.GetKey(types.StoreKey))
+
+	s.Require().NoError(s.app.AttributeKeeper.SetAttribute(s.ctx, attr, s.user1Addr), "SetAttribute")
+	s.Assert().Equal(attr.Value, store.Get(rangeKey), "range lookup value after set")
+
+	s.Require().NoError(s.app.AttributeKeeper.PurgeAttribute(s.ctx, attr.Name, s.user1Addr), "PurgeAttribute")
+	s.Assert().False(store.Has(rangeKey), "range lookup exists after purge")
+
+	expireTime := s.startBlockTime.Add(time.Hour)
+	expiringAttr := attr
+	expiringAttr.ExpirationDate = &expireTime
+	s.Require().NoError(s.app.AttributeKeeper.SetAttribute(s.ctx, expiringAttr, s.user1Addr), "SetAttribute expiring")
+	s.Assert().True(store.Has(rangeKey), "range lookup exists after set: expiring")
+	s.ctx = s.ctx.WithBlockTime(expireTime.Add(time.Second))
+	s.Assert().Equal(1, s.app.AttributeKeeper.DeleteExpiredAttributes(s.ctx, 0), "DeleteExpiredAttributes")
+	s.Assert().False(store.Has(rangeKey), "range lookup exists after expiration")
+
+	stringAttr := types.Attribute{
+		Name:          "example.attribute",
+		Value:         []byte("not ranged"),
+		Address:       s.user1,
+		AttributeType: types.AttributeType_String,
+	}
+	s.ctx = s.ctx.WithBlockTime(s.startBlockTime)
+	s.Require().NoError(s.app.AttributeKeeper.SetAttribute(s.ctx, stringAttr, s.user1Addr), "SetAttribute string")
+	it := storetypes.KVStorePrefixIterator(store, types.AttributeRangeLookupPrefix)
+	defer it.Close()
+	s.Assert().False(it.Valid(), "range lookups exist after setting a string attribute")
+}
+
+func (s *KeeperTestSuite) TestCompressedAttributeValue() {
+	params := s.app.AttributeKeeper.GetParams(s.ctx)
+	params.MaxValueLength = 10_000
+	s.app.AttributeKeeper.SetParams(s.ctx, params)
+
+	value := []byte(`{"attestations":[` + strings.Repeat(`{"kind":"kyc","level":"full","verified":true},`, 99) + `{"kind":"aml"}]}`)
+	attr := types.Attribute{
+		Name:          "example.attribute",
+		Value:         value,
+		Address:       s.user1,
+		AttributeType: types.AttributeType_JSON,
+	}
+	s.Require().NoError(s.app.AttributeKeeper.SetAttribute(s.ctx, attr, s.user1Addr), "SetAttribute")
+	attr.Origin = s.newOrigin(s.user1)
+
+	store := s.ctx.KVStore(s.app.GetKey(types.StoreKey))
+	bz := store.Get(types.AddrAttributeKey(s.user1Addr, attr))
+	s.Require().NotNil(bz, "stored attribute record")
+	s.Assert().Equal([]byte{0x00, byte(types.ValueCodecDeflate)}, bz[:2], "stored record marker and codec")
+	s.Assert().Less(len(bz), len(value), "stored record length")
+
+	attrs, err := s.app.AttributeKeeper.GetAttributes(s.ctx, s.user1, attr.Name)
+	s.Require().NoError(err, "GetAttributes")
+	s.Assert().Equal([]types.Attribute{attr}, attrs, "GetAttributes result")
+	resp, err := s.app.AttributeKeeper.Attribute(s.ctx, &types.QueryAttributeRequest{Account: s.user1, Name: attr.Name})
+	s.Require().NoError(err, "Attribute query")
+	s.Assert().Equal([]types.Attribute{attr}, resp.Attributes, "Attribute query attributes")
+	s.Assert().Equal([]sdk.AccAddress{s.user1Addr}, s.app.AttributeKeeper.AccountsByAttributeValue(s.ctx, attr.Name, attr.Hash()), "accounts with value")
+
+	updated := attr
+	updated.Value = append([]byte{}, value...)
+	updated.Value[len(updated.Value)-6] = 'x'
+	s.Require().NoError(s.app.AttributeKeeper.UpdateAttribute(s.ctx, attr, updated, s.user1Addr), "UpdateAttribute")
+	attrs, err = s.app.AttributeKeeper.GetAttributes(s.ctx, s.user1, attr.Name)
+	s.Require().NoError(err, "GetAttributes after update")
+	s.Assert().Equal([]types.Attribute{updated}, attrs, "GetAttributes result after update")
+
+	s.Require().NoError(s.app.AttributeKeeper.DeleteAttribute(s.ctx, s.user1, attr.Name, &updated.Value, s.user1Addr), "DeleteAttribute")
+	s.Assert().False(store.Has(types.AddrAttributeKey(s.user1Addr, updated)), "stored attribute exists after delete")
+}
+
 func (s *KeeperTestSuite) TestInitGenesisAddingAttributes() {
 	genAttr := types.Attribute{
 		Name:          "example.attribute",
@@ -919,6 +1227,25 @@ func (s *KeeperTestSuite) TestInitGenesisAddingAttributes() {
 	s.Assert().Panics(func() { s.app.AttributeKeeper.InitGenesis(s.ctx, &attributeData) })
 }
 
+func (s *KeeperTestSuite) TestExportGenesisTo() {
+	attr := types.Attribute{
+		Name:          "example.attribute",
+		Value:         []byte("0123456789"),
+		Address:       s.user1,
+		AttributeType: types.AttributeType_String,
+	}
+	s.Require().NoError(s.app.AttributeKeeper.SetAttribute(s.ctx, attr, s.user1Addr), "SetAttribute")
+	cdc := s.app.AppCodec()
+
+	var buf bytes.Buffer
+	err := s.app.AttributeKeeper.ExportGenesisTo(s.ctx, cdc, &buf)
+	s.Require().NoError(err, "ExportGenesisTo")
+
+	expJSON, err := cdc.MarshalJSON(s.app.AttributeKeeper.ExportGenesis(s.ctx))
+	s.Require().NoError(err, "MarshalJSON attribute genesis")
+	s.Assert().Equal(string(expJSON), buf.String(), "streamed genesis JSON")
+}
+
 func (s *KeeperTestSuite) TestIterateRecord() {
 	s.Run("iterate attribute's", func() {
 		attr := types.Attribute{
@@ -939,6 +1266,36 @@ func (s *KeeperTestSuite) TestIterateRecord() {
 		s.Require().NoError(err)
 		s.Require().Equal(1, len(records))
 	})
+	s.Run("iterate attributes in key order and reverse key order", func() {
+		for _, val := range []string{"a", "b", "c"} {
+			for _, acct := range []string{s.user1, s.user2} {
+				attr := types.NewAttribute("attribute", acct, types.AttributeType_String, []byte(val), nil)
+				s.Require().NoError(s.app.AttributeKeeper.SetAttribute(s.ctx, attr, s.user1Addr), "SetAttribute(%s, %s)", acct, val)
+			}
+		}
+
+		var keys [][]byte
+		err := s.app.AttributeKeeper.IterateRecords(s.ctx, types.AttributeKeyPrefix, func(record types.Attribute) error {
+			keys = append(keys, types.AddrAttributeKey(record.GetAddressBytes(), record))
+			return nil
+		})
+		s.Require().NoError(err, "IterateRecords")
+		s.Require().Len(keys, 7, "keys")
+		for i := 1; i < len(keys); i++ {
+			s.Assert().Equal(-1, bytes.Compare(keys[i-1], keys[i]), "key %d (%X) compared to key %d (%X)", i-1, keys[i-1], i, keys[i])
+		}
+
+		var revKeys [][]byte
+		err = s.app.AttributeKeeper.IterateRecordsReverse(s.ctx, types.AttributeKeyPrefix, func(record types.Attribute) error {
+			revKeys = append(revKeys, types.AddrAttributeKey(record.GetAddressBytes(), record))
+			return nil
+		})
+		s.Require().NoError(err, "IterateRecordsReverse")
+		s.Require().Len(revKeys, len(keys), "reverse keys")
+		for i := range keys {
+			s.Assert().Equal(keys[len(keys)-1-i], revKeys[i], "reverse key %d", i)
+		}
+	})
 }
 
 func (s *KeeperTestSuite) TestPurgeAttributes() {
@@ -1279,3 +1636,82 @@ func (s *KeeperTestSuite) TestSetAccountData() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestPurgeOrphanedAttributes() {
+	orphanAttr1 := types.NewAttribute("example.attribute", s.user2, types.AttributeType_String, []byte("orphan1"), nil)
+	orphanAttr2 := types.NewAttribute("attribute", s.user2, types.AttributeType_String, []byte("orphan2"), nil)
+	keptAttr := types.NewAttribute("example.attribute", s.user1, types.AttributeType_String, []byte("kept"), nil)
+	for _, attr := range []types.Attribute{orphanAttr1, orphanAttr2, keptAttr} {
+		s.Require().NoError(s.app.AttributeKeeper.SetAttribute(s.ctx, attr, s.user1Addr), "SetAttribute(%s, %s)", attr.Name, attr.Address)
+	}
+	keptAttr.Origin = s.newOrigin(s.user1)
+
+	s.Assert().True(s.app.AttributeKeeper.IsOrphanedAttributeAddress(s.ctx, s.user2), "IsOrphanedAttributeAddress(user2)")
+	s.Assert().False(s.app.AttributeKeeper.IsOrphanedAttributeAddress(s.ctx, s.user1), "IsOrphanedAttributeAddress(user1)")
+	s.Assert().False(s.app.AttributeKeeper.IsOrphanedAttributeAddress(s.ctx, "not-an-address"), "IsOrphanedAttributeAddress(not-an-address)")
+
+	orphans, err := s.app.AttributeKeeper.GetOrphanedAttributeAccounts(s.ctx, 0)
+	s.Require().NoError(err, "GetOrphanedAttributeAccounts")
+	s.Assert().Equal([]string{s.user2}, orphans, "GetOrphanedAttributeAccounts")
+
+	msg, broken := keeper.OrphanedAttributesInvariant(s.app.AttributeKeeper)(s.ctx)
+	s.Assert().True(broken, "invariant broken before purge")
+	s.Assert().Contains(msg, "1 account that does not exist has attributes: "+s.user2, "invariant message before purge")
+
+	err = s.app.AttributeKeeper.PurgeAccountAttributes(s.ctx, s.user1)
+	s.Assert().EqualError(err, fmt.Sprintf("cannot purge attributes of %q: address is not an account address without an account", s.user1), "PurgeAccountAttributes(user1)")
+
+	s.ctx = s.ctx.WithEventManager(sdk.NewEventManager())
+	purged, err := s.app.AttributeKeeper.PurgeOrphanedAttributes(s.ctx, 0)
+	s.Require().NoError(err, "PurgeOrphanedAttributes")
+	s.Assert().Equal([]string{s.user2}, purged, "PurgeOrphanedAttributes accounts")
+
+	expEvent, err := sdk.TypedEventToEvent(types.NewEventAccountAttributesPurged(s.user2, 2))
+	s.Require().NoError(err, "TypedEventToEvent")
+	s.Assert().Equal(sdk.Events{expEvent}, s.ctx.EventManager().Events(), "PurgeOrphanedAttributes events")
+
+	attrs, err := s.app.AttributeKeeper.GetAllAttributes(s.ctx, s.user2)
+	s.Require().NoError(err, "GetAllAttributes(user2)")
+	s.Assert().Empty(attrs, "user2 attributes after purge")
+	attrStore := s.ctx.KVStore(s.app.GetKey(types.StoreKey))
+	for _, attr := range []types.Attribute{orphanAttr1, orphanAttr2} {
+		s.Assert().False(attrStore.Has(types.AttributeNameAddrKeyPrefix(attr.Name, attr.GetAddressBytes())), "has %s name address lookup", attr.Name)
+	}
+	s.Assert().Empty(s.app.AttributeKeeper.AccountsByAttributeValue(s.ctx, orphanAttr1.Name, orphanAttr1.Hash()), "accounts with orphaned attribute value")
+
+	attrs, err = s.app.AttributeKeeper.GetAllAttributes(s.ctx, s.user1)
+	s.Require().NoError(err, "GetAllAttributes(user1)")
+	s.Assert().Equal([]types.Attribute{keptAttr}, attrs, "user1 attributes after purge")
+
+	msg, broken = keeper.OrphanedAttributesInvariant(s.app.AttributeKeeper)(s.ctx)
+	s.Assert().False(broken, "invariant broken after purge")
+	s.Assert().Contains(msg, "No accounts have orphaned attributes.", "invariant message after purge")
+}
+
+func (s *KeeperTestSuite) TestPurgeOrphanedAttributesMaxAccounts() {
+	addrs := []sdk.AccAddress{
+		sdk.AccAddress("orphan_account_1____"),
+		sdk.AccAddress("orphan_account_2____"),
+		sdk.AccAddress("orphan_account_3____"),
+	}
+	for _, addr := range addrs {
+		attr := types.NewAttribute("example.attribute", addr.String(), types.AttributeType_String, []byte("orphan"), nil)
+		s.Require().NoError(s.app.AttributeKeeper.SetAttribute(s.ctx, attr, s.user1Addr), "SetAttribute(%s)", addr)
+	}
+
+	purged, err := s.app.AttributeKeeper.PurgeOrphanedAttributes(s.ctx, 2)
+	s.Require().NoError(err, "PurgeOrphanedAttributes(2)")
+	s.Assert().Len(purged, 2, "first purge accounts")
+
+	orphans, err := s.app.AttributeKeeper.GetOrphanedAttributeAccounts(s.ctx, 0)
+	s.Require().NoError(err, "GetOrphanedAttributeAccounts after first purge")
+	s.Assert().Len(orphans, 1, "orphans after first purge")
+
+	purged, err = s.app.AttributeKeeper.PurgeOrphanedAttributes(s.ctx, 2)
+	s.Require().NoError(err, "PurgeOrphanedAttributes(2) again")
+	s.Assert().Equal(orphans, purged, "second purge accounts")
+
+	purged, err = s.app.AttributeKeeper.PurgeOrphanedAttributes(s.ctx, 2)
+	s.Require().NoError(err, "PurgeOrphanedAttributes(2) third time")
+	s.Assert().Empty(purged, "third purge accounts")
+}
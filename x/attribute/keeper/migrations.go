@@ -1,5 +1,9 @@
 package keeper
 
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 // Migrator is a struct for handling in-place store migrations.
 type Migrator struct {
 	keeper Keeper
@@ -9,3 +13,17 @@ type Migrator struct {
 func NewMigrator(keeper Keeper) Migrator {
 	return Migrator{keeper: keeper}
 }
+
+// Migrate2To3 will update the attribute store from version 2 to version 3.
+// It populates the attribute name and value hash to address lookups from the attributes that are already in state.
+func (m Migrator) Migrate2To3(ctx sdk.Context) error {
+	logger := m.keeper.Logger(ctx)
+	logger.Info("Starting migration of x/attribute from 2 to 3.")
+	count, err := m.keeper.RebuildAttributeValueLookups(ctx)
+	if err != nil {
+		logger.Error("Error building attribute value lookups.", "error", err)
+		return err
+	}
+	logger.Info("Done migrating x/attribute from 2 to 3.", "attributes", count)
+	return nil
+}
@@ -1,8 +1,12 @@
 package keeper_test
 
 import (
+	"context"
+	"encoding/json"
 	"errors"
 
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/provenance-io/provenance/x/attribute/types"
@@ -80,14 +84,49 @@ func (k *mockNameKeeper) SetNameRecord(ctx sdk.Context, name string, addr sdk.Ac
 }
 
 // UpdateNameRecord returns an error if desired, otherwise calls parent's UpdateNameRecord function.
-func (k *mockNameKeeper) UpdateNameRecord(ctx sdk.Context, name string, addr sdk.AccAddress, restrict bool) error {
+func (k *mockNameKeeper) UpdateNameRecord(ctx sdk.Context, name string, addr sdk.AccAddress, restrict bool, expectedCurrentAddr sdk.AccAddress) error {
 	if len(k.UpdateNameRecordError) > 0 {
 		return errors.New(k.UpdateNameRecordError)
 	}
-	return k.Parent.UpdateNameRecord(ctx, name, addr, restrict)
+	return k.Parent.UpdateNameRecord(ctx, name, addr, restrict, expectedCurrentAddr)
 }
 
 // IterateRecords calls the parent's IterateRecords function.
 func (k *mockNameKeeper) IterateRecords(ctx sdk.Context, prefix []byte, handle func(nametypes.NameRecord) error) error {
 	return k.Parent.IterateRecords(ctx, prefix, handle)
 }
+
+// mockContractKeeper is a mocked contract keeper that records the sudo calls made to it.
+type mockContractKeeper struct {
+	Calls    []types.AttributeHookSudoMsg
+	SudoErr  string
+	UseGas   uint64
+	Contract sdk.AccAddress
+	// Infos are the contract infos to return from GetContractInfo, by contract address.
+	Infos map[string]*wasmtypes.ContractInfo
+}
+
+var (
+	_ types.ContractKeeper     = (*mockContractKeeper)(nil)
+	_ types.ContractInfoKeeper = (*mockContractKeeper)(nil)
+)
+
+// GetContractInfo returns the entry in Infos for the contract address (or nil if there isn't one).
+func (k *mockContractKeeper) GetContractInfo(_ context.Context, contractAddress sdk.AccAddress) *wasmtypes.ContractInfo {
+	return k.Infos[contractAddress.String()]
+}
+
+// Sudo records the call, consumes the desired amount of gas, then returns an error if desired.
+func (k *mockContractKeeper) Sudo(ctx sdk.Context, contractAddress sdk.AccAddress, msg []byte) ([]byte, error) {
+	var call types.AttributeHookSudoMsg
+	if err := json.Unmarshal(msg, &call); err != nil {
+		return nil, err
+	}
+	k.Calls = append(k.Calls, call)
+	k.Contract = contractAddress
+	ctx.GasMeter().ConsumeGas(k.UseGas, "mock contract")
+	if len(k.SudoErr) > 0 {
+		return nil, errors.New(k.SudoErr)
+	}
+	return nil, nil
+}
@@ -95,6 +95,11 @@ func (k msgServer) UpdateAttribute(goCtx context.Context, msg *types.MsgUpdateAt
 		return nil, err
 	}
 
+	err = k.Keeper.CheckOriginalValueHash(ctx, msg.Account, msg.Name, msg.OriginalValue, msg.OriginalValueHash)
+	if err != nil {
+		return nil, err
+	}
+
 	err = k.Keeper.UpdateAttribute(ctx, originalAttribute, updateAttribute, ownerAddr)
 	if err != nil {
 		return nil, err
@@ -175,6 +180,11 @@ func (k msgServer) DeleteAttribute(goCtx context.Context, msg *types.MsgDeleteAt
 		return nil, err
 	}
 
+	err = k.Keeper.CheckOriginalValueHash(ctx, msg.Account, msg.Name, nil, msg.OriginalValueHash)
+	if err != nil {
+		return nil, err
+	}
+
 	err = k.Keeper.DeleteAttribute(ctx, msg.Account, msg.Name, nil, ownerAddr)
 	if err != nil {
 		return nil, err
@@ -257,6 +267,56 @@ func (k msgServer) SetAccountData(goCtx context.Context, msg *types.MsgSetAccoun
 	return &types.MsgSetAccountDataResponse{}, nil
 }
 
+// SetAttributeUnlisted defines a method for an account to mark one of its attribute names as unlisted (or listed).
+func (k msgServer) SetAttributeUnlisted(goCtx context.Context, msg *types.MsgSetAttributeUnlistedRequest) (*types.MsgSetAttributeUnlistedResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	err := k.Keeper.SetAttributeUnlisted(ctx, msg.Account, msg.Name, msg.Unlisted)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetAttributeUnlistedResponse{}, nil
+}
+
+// SetAttributeHook defines a method for the owner of an attribute name to register (or remove) a hook contract.
+func (k msgServer) SetAttributeHook(goCtx context.Context, msg *types.MsgSetAttributeHookRequest) (*types.MsgSetAttributeHookResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	ownerAddr, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(msg.Contract) == 0 {
+		err = k.Keeper.RemoveAttributeHook(ctx, msg.Name, ownerAddr)
+	} else {
+		err = k.Keeper.SetAttributeHook(ctx, msg.GetAttributeHook(), ownerAddr)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetAttributeHookResponse{}, nil
+}
+
+// RevokeAttribute revokes the attributes an issuer set on an account.
+func (k msgServer) RevokeAttribute(goCtx context.Context, msg *types.MsgRevokeAttributeRequest) (*types.MsgRevokeAttributeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	issuerAddr, err := sdk.AccAddressFromBech32(msg.Issuer)
+	if err != nil {
+		return nil, err
+	}
+
+	revoked, err := k.Keeper.RevokeAttribute(ctx, msg.Account, msg.Name, msg.Value, issuerAddr, msg.Reason)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRevokeAttributeResponse{Revoked: revoked}, nil
+}
+
 // UpdateParams is a governance proposal endpoint for updating the attribute module's params.
 func (k msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParamsRequest) (*types.MsgUpdateParamsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
@@ -272,3 +332,19 @@ func (k msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParam
 
 	return &types.MsgUpdateParamsResponse{}, nil
 }
+
+// PurgeOrphanedAttributes is a governance proposal endpoint for removing the attributes of accounts that do not exist.
+func (k msgServer) PurgeOrphanedAttributes(goCtx context.Context, msg *types.MsgPurgeOrphanedAttributesRequest) (*types.MsgPurgeOrphanedAttributesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.ValidateAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	purged, err := k.Keeper.PurgeOrphanedAttributes(ctx, msg.MaxAccounts)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgPurgeOrphanedAttributesResponse{PurgedAccounts: purged}, nil
+}
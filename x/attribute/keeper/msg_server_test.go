@@ -1,10 +1,12 @@
 package keeper_test
 
 import (
+	"crypto/sha256"
 	"fmt"
 	"testing"
 	"time"
 
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
 	abci "github.com/cometbft/cometbft/abci/types"
 	"github.com/cosmos/gogoproto/proto"
 	"github.com/stretchr/testify/assert"
@@ -191,6 +193,12 @@ func (s *MsgServerTestSuite) TestMsgUpdateAttributeRequest() {
 	attrData.Params.MaxValueLength = 100
 	s.app.AttributeKeeper.InitGenesis(s.ctx, &attrData)
 
+	wrongHash := sha256.Sum256([]byte("wrong"))
+	withHash := func(msg *types.MsgUpdateAttributeRequest, hash []byte) *types.MsgUpdateAttributeRequest {
+		msg.OriginalValueHash = hash
+		return msg
+	}
+
 	testcases := []struct {
 		name          string
 		msg           *types.MsgUpdateAttributeRequest
@@ -198,6 +206,48 @@ func (s *MsgServerTestSuite) TestMsgUpdateAttributeRequest() {
 		errorMsg      string
 		expectedEvent proto.Message
 	}{
+		{
+			name: "should fail to update attribute when the original value hash does not match",
+			msg: withHash(types.NewMsgUpdateAttributeRequest(
+				s.owner1,
+				s.owner1Addr, "example.name",
+				[]byte("value"), []byte("1"),
+				types.AttributeType_String,
+				types.AttributeType_Int), wrongHash[:]),
+			signers:  []string{s.owner1},
+			errorMsg: fmt.Sprintf("attribute \"example.name\" on account %s has value hash %X, expected original value hash %X", s.owner1, testAttr.Hash(), wrongHash[:]),
+		},
+		{
+			name: "should successfully update attribute when the original value hash matches",
+			msg: withHash(types.NewMsgUpdateAttributeRequest(
+				s.owner1,
+				s.owner1Addr, "example.name",
+				[]byte("value"), []byte("value2"),
+				types.AttributeType_String,
+				types.AttributeType_String), testAttr.Hash()),
+			signers: []string{s.owner1},
+		},
+		{
+			name: "should fail to update attribute with a stale original value hash",
+			msg: withHash(types.NewMsgUpdateAttributeRequest(
+				s.owner1,
+				s.owner1Addr, "example.name",
+				[]byte("value"), []byte("value3"),
+				types.AttributeType_String,
+				types.AttributeType_String), testAttr.Hash()),
+			signers:  []string{s.owner1},
+			errorMsg: `no attributes updated with name "example.name" : value "value" : type: ATTRIBUTE_TYPE_STRING`,
+		},
+		{
+			name: "should successfully update attribute back",
+			msg: types.NewMsgUpdateAttributeRequest(
+				s.owner1,
+				s.owner1Addr, "example.name",
+				[]byte("value2"), []byte("value"),
+				types.AttributeType_String,
+				types.AttributeType_String),
+			signers: []string{s.owner1},
+		},
 		{
 			name: "should successfully update attribute",
 			msg: types.NewMsgUpdateAttributeRequest(
@@ -289,10 +339,17 @@ func (s *MsgServerTestSuite) TestMsgDeleteAttributeRequest() {
 		Value:         []byte("value"),
 		AttributeType: types.AttributeType_String,
 	}
+	otherAttr := types.Attribute{
+		Address:       s.owner1,
+		Name:          "name",
+		Value:         []byte("other value"),
+		AttributeType: types.AttributeType_String,
+	}
 	var attrData types.GenesisState
-	attrData.Attributes = append(attrData.Attributes, testAttr)
+	attrData.Attributes = append(attrData.Attributes, testAttr, otherAttr)
 	attrData.Params.MaxValueLength = 100
 	s.app.AttributeKeeper.InitGenesis(s.ctx, &attrData)
+	wrongHash := sha256.Sum256([]byte("wrong"))
 
 	testcases := []struct {
 		name          string
@@ -301,6 +358,26 @@ func (s *MsgServerTestSuite) TestMsgDeleteAttributeRequest() {
 		errorMsg      string
 		expectedEvent proto.Message
 	}{
+		{
+			name: "should fail to delete attribute when the original value hash does not match",
+			msg: func() *types.MsgDeleteAttributeRequest {
+				msg := types.NewMsgDeleteAttributeRequest(s.owner1, s.owner1Addr, "example.name")
+				msg.OriginalValueHash = wrongHash[:]
+				return msg
+			}(),
+			signers:  []string{s.owner1},
+			errorMsg: fmt.Sprintf("attribute \"example.name\" on account %s has value hash %X, expected original value hash %X", s.owner1, testAttr.Hash(), wrongHash[:]),
+		},
+		{
+			name: "should successfully delete attribute when the original value hash matches",
+			msg: func() *types.MsgDeleteAttributeRequest {
+				msg := types.NewMsgDeleteAttributeRequest(s.owner1, s.owner1Addr, "name")
+				msg.OriginalValueHash = otherAttr.Hash()
+				return msg
+			}(),
+			signers:       []string{s.owner1},
+			expectedEvent: types.NewEventAttributeDelete("name", s.owner1, s.owner1),
+		},
 		{
 			name:          "should successfully add new attribute",
 			msg:           types.NewMsgDeleteAttributeRequest(s.owner1, s.owner1Addr, "example.name"),
@@ -371,3 +448,96 @@ func (s *MsgServerTestSuite) TestUpdateParams() {
 		})
 	}
 }
+
+func (s *MsgServerTestSuite) TestSetAttributeUnlisted() {
+	name := "unlisted.example.attribute"
+	msg := types.NewMsgSetAttributeUnlistedRequest(s.owner1Addr, name, true)
+
+	s.ctx = s.ctx.WithEventManager(sdk.NewEventManager())
+	response, err := s.msgServer.SetAttributeUnlisted(s.ctx, msg)
+	s.Require().NoError(err, "SetAttributeUnlisted")
+	s.Assert().NotNil(response, "SetAttributeUnlisted response")
+	s.Assert().True(s.app.AttributeKeeper.IsAttributeUnlisted(s.ctx, s.owner1Addr, name), "IsAttributeUnlisted")
+	expEvent := types.NewEventAttributeUnlistedUpdated(s.owner1, name, true)
+	s.True(s.containsMessage(s.ctx.EventManager().ABCIEvents(), expEvent), fmt.Sprintf("Expected typed event was not found: %v", expEvent))
+
+	msg.Unlisted = false
+	_, err = s.msgServer.SetAttributeUnlisted(s.ctx, msg)
+	s.Require().NoError(err, "SetAttributeUnlisted(false)")
+	s.Assert().False(s.app.AttributeKeeper.IsAttributeUnlisted(s.ctx, s.owner1Addr, name), "IsAttributeUnlisted after listing")
+}
+
+func (s *MsgServerTestSuite) TestSetAttributeHook() {
+	name := "example.name"
+	contract := sdk.AccAddress("hookContract________").String()
+	contractKeeper := &mockContractKeeper{Infos: map[string]*wasmtypes.ContractInfo{contract: {Creator: s.owner1}}}
+	s.app.AttributeKeeper.SetContractKeeper(contractKeeper, contractKeeper)
+	msg := types.NewMsgSetAttributeHookRequest(s.owner1Addr, name, contract, types.AttributeHookMode_Veto)
+
+	s.ctx = s.ctx.WithEventManager(sdk.NewEventManager())
+	response, err := s.msgServer.SetAttributeHook(s.ctx, msg)
+	s.Require().NoError(err, "SetAttributeHook")
+	s.Assert().NotNil(response, "SetAttributeHook response")
+	hook, err := s.app.AttributeKeeper.GetAttributeHook(s.ctx, name)
+	s.Require().NoError(err, "GetAttributeHook")
+	expHook := msg.GetAttributeHook()
+	s.Assert().Equal(&expHook, hook, "GetAttributeHook")
+	expEvent := types.NewEventAttributeHookUpdated(expHook, s.owner1)
+	s.True(s.containsMessage(s.ctx.EventManager().ABCIEvents(), expEvent), fmt.Sprintf("Expected typed event was not found: %v", expEvent))
+
+	msg.Contract = ""
+	msg.Mode = types.AttributeHookMode_Unspecified
+	_, err = s.msgServer.SetAttributeHook(s.ctx, msg)
+	s.Require().NoError(err, "SetAttributeHook(remove)")
+	hook, err = s.app.AttributeKeeper.GetAttributeHook(s.ctx, name)
+	s.Require().NoError(err, "GetAttributeHook after remove")
+	s.Assert().Nil(hook, "GetAttributeHook after remove")
+}
+
+func (s *MsgServerTestSuite) TestPurgeOrphanedAttributes() {
+	orphanAddr := sdk.AccAddress("orphan_account______").String()
+	attr := types.NewAttribute("example.name", orphanAddr, types.AttributeType_String, []byte("orphan"), nil)
+	s.Require().NoError(s.app.AttributeKeeper.SetAttribute(s.ctx, attr, s.owner1Addr), "SetAttribute")
+
+	tests := []struct {
+		name      string
+		msg       types.MsgPurgeOrphanedAttributesRequest
+		errorMsg  string
+		expPurged []string
+	}{
+		{
+			name:     "Should fail due to invalid authority",
+			msg:      types.MsgPurgeOrphanedAttributesRequest{Authority: "invalid-authority"},
+			errorMsg: `expected "cosmos10d07y265gmmuvt4z0w9aw880jnsr700j6zn9kn" got "invalid-authority": expected gov account as only signer for proposal message`,
+		},
+		{
+			name:      "Should succeed",
+			msg:       types.MsgPurgeOrphanedAttributesRequest{Authority: authtypes.NewModuleAddress("gov").String()},
+			expPurged: []string{orphanAddr},
+		},
+		{
+			name: "Should succeed with nothing left to purge",
+			msg:  types.MsgPurgeOrphanedAttributesRequest{Authority: authtypes.NewModuleAddress("gov").String(), MaxAccounts: 5},
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			s.ctx = s.ctx.WithEventManager(sdk.NewEventManager())
+			response, err := s.msgServer.PurgeOrphanedAttributes(s.ctx, &tt.msg)
+			if len(tt.errorMsg) > 0 {
+				s.Assert().Error(err)
+				s.Assert().Equal(tt.errorMsg, err.Error())
+				s.Assert().Nil(response)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().NotNil(response)
+			s.Assert().Equal(tt.expPurged, response.PurgedAccounts, "PurgedAccounts")
+			for _, addr := range tt.expPurged {
+				expEvent := types.NewEventAccountAttributesPurged(addr, 1)
+				s.True(s.containsMessage(s.ctx.EventManager().ABCIEvents(), expEvent), fmt.Sprintf("Expected typed event was not found: %v", expEvent))
+			}
+		})
+	}
+}
@@ -0,0 +1,91 @@
+package keeper
+
+import (
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/attribute/types"
+	nametypes "github.com/provenance-io/provenance/x/name/types"
+)
+
+var (
+	// nameAuthCacheTrue is the transient store value indicating that a name resolves to an owner.
+	nameAuthCacheTrue = []byte{0x01}
+	// nameAuthCacheFalse is the transient store value indicating that a name does not resolve to an owner.
+	nameAuthCacheFalse = []byte{0x00}
+)
+
+// resolvesTo returns true if the provided name resolves to the owner.
+//
+// Results are cached in the transient store so that repeated checks of the same
+// (name, owner) pair in a block (e.g. bulk attribute loads) only look up the name record once.
+// Since the cache lives in the transient store, it's cleared every block, and entries
+// added during a failed tx are discarded along with the rest of that tx's state changes.
+// Entries are invalidated by the name hooks (see NameHooks) whenever a name record changes.
+func (k Keeper) resolvesTo(ctx sdk.Context, name string, owner sdk.AccAddress) bool {
+	if k.tStoreKey == nil || len(name) == 0 || name != nametypes.NormalizeName(name) {
+		return k.nameKeeper.ResolvesTo(ctx, name, owner)
+	}
+
+	store := ctx.TransientStore(k.tStoreKey)
+	key := types.NameAuthCacheKey(name, owner)
+	if bz := store.Get(key); len(bz) == 1 {
+		return bz[0] == nameAuthCacheTrue[0]
+	}
+
+	rv := k.nameKeeper.ResolvesTo(ctx, name, owner)
+	if rv {
+		store.Set(key, nameAuthCacheTrue)
+	} else {
+		store.Set(key, nameAuthCacheFalse)
+	}
+	return rv
+}
+
+// invalidateNameAuthCache removes all cached ownership checks for the provided name.
+func (k Keeper) invalidateNameAuthCache(ctx sdk.Context, name string) {
+	if k.tStoreKey == nil || len(name) == 0 {
+		return
+	}
+
+	store := ctx.TransientStore(k.tStoreKey)
+	var keys [][]byte
+	iter := storetypes.KVStorePrefixIterator(store, types.NameAuthCacheNameKeyPrefix(name))
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	iter.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// NameHooks is a wrapper around the attribute keeper that implements the name module's hooks.
+type NameHooks struct {
+	k Keeper
+}
+
+var _ nametypes.NameHooks = NameHooks{}
+
+// NameHooks returns the name hooks needed by the attribute keeper.
+func (k Keeper) NameHooks() NameHooks {
+	return NameHooks{k: k}
+}
+
+// AfterNameBound clears any cached ownership checks of the newly bound name.
+func (h NameHooks) AfterNameBound(ctx sdk.Context, name string, _ sdk.AccAddress) {
+	h.k.invalidateNameAuthCache(ctx, name)
+}
+
+// AfterNameUpdated clears any cached ownership checks of the updated name.
+func (h NameHooks) AfterNameUpdated(ctx sdk.Context, name string, _, _ sdk.AccAddress) {
+	h.k.invalidateNameAuthCache(ctx, name)
+}
+
+// AfterNameDeleted clears any cached ownership checks of the deleted name, and removes its attribute hook.
+func (h NameHooks) AfterNameDeleted(ctx sdk.Context, name string, _ sdk.AccAddress) {
+	h.k.invalidateNameAuthCache(ctx, name)
+	ctx.KVStore(h.k.storeKey).Delete(types.AttributeHookKey(name))
+}
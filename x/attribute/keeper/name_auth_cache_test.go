@@ -0,0 +1,59 @@
+package keeper_test
+
+import (
+	"fmt"
+
+	"github.com/provenance-io/provenance/x/attribute/types"
+)
+
+func (s *KeeperTestSuite) TestNameAuthCache() {
+	s.app.AccountKeeper.SetAccount(s.ctx, s.app.AccountKeeper.NewAccountWithAddress(s.ctx, s.user2Addr))
+
+	tStore := s.ctx.TransientStore(s.app.GetTKey(types.TStoreKey))
+	cacheKey := func(name string) []byte {
+		return types.NameAuthCacheKey(name, s.user1Addr)
+	}
+	newAttr := func(value string) types.Attribute {
+		return types.NewAttribute("example.attribute", s.user1, types.AttributeType_String, []byte(value), nil)
+	}
+
+	s.Require().False(tStore.Has(cacheKey("example.attribute")), "cache entry before any attribute is set")
+	s.Require().NoError(s.app.AttributeKeeper.SetAttribute(s.ctx, newAttr("one"), s.user1Addr), "SetAttribute one")
+	s.Require().Equal([]byte{0x01}, tStore.Get(cacheKey("example.attribute")), "cache entry after setting attribute")
+	s.Require().NoError(s.app.AttributeKeeper.SetAttribute(s.ctx, newAttr("two"), s.user1Addr), "SetAttribute two")
+
+	s.Run("update name owner clears cache", func() {
+		err := s.app.NameKeeper.UpdateNameRecord(s.ctx, "example.attribute", s.user2Addr, false, nil)
+		s.Require().NoError(err, "UpdateNameRecord")
+		s.Assert().False(tStore.Has(cacheKey("example.attribute")), "cache entry after name update")
+
+		err = s.app.AttributeKeeper.SetAttribute(s.ctx, newAttr("three"), s.user1Addr)
+		s.Assert().EqualError(err, fmt.Sprintf("%q does not resolve to address %q", "example.attribute", s.user1), "SetAttribute by old owner")
+		s.Assert().Equal([]byte{0x00}, tStore.Get(cacheKey("example.attribute")), "cache entry after failed set")
+
+		err = s.app.AttributeKeeper.SetAttribute(s.ctx, newAttr("three"), s.user2Addr)
+		s.Assert().NoError(err, "SetAttribute by new owner")
+	})
+
+	s.Run("rebind name to original owner clears cache", func() {
+		err := s.app.NameKeeper.UpdateNameRecord(s.ctx, "example.attribute", s.user1Addr, false, nil)
+		s.Require().NoError(err, "UpdateNameRecord")
+		s.Assert().False(tStore.Has(cacheKey("example.attribute")), "cache entry after name update")
+
+		err = s.app.AttributeKeeper.SetAttribute(s.ctx, newAttr("four"), s.user1Addr)
+		s.Assert().NoError(err, "SetAttribute by original owner")
+	})
+
+	s.Run("delete name clears cache", func() {
+		s.Require().NoError(s.app.NameKeeper.SetNameRecord(s.ctx, "cached", s.user1Addr, false), "SetNameRecord")
+		attr := types.NewAttribute("cached", s.user1, types.AttributeType_String, []byte("value"), nil)
+		s.Require().NoError(s.app.AttributeKeeper.SetAttribute(s.ctx, attr, s.user1Addr), "SetAttribute")
+		s.Require().True(tStore.Has(cacheKey("cached")), "cache entry after set")
+
+		s.Require().NoError(s.app.NameKeeper.DeleteRecord(s.ctx, "cached"), "DeleteRecord")
+		s.Assert().False(tStore.Has(cacheKey("cached")), "cache entry after name deletion")
+
+		err := s.app.AttributeKeeper.SetAttribute(s.ctx, attr, s.user1Addr)
+		s.Assert().Error(err, "SetAttribute after name deletion")
+	})
+}
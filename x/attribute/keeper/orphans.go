@@ -0,0 +1,91 @@
+package keeper
+
+import (
+	"fmt"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/attribute/types"
+)
+
+// IsOrphanedAttributeAddress returns true if the provided attribute address is an account address
+// that does not have an account in x/auth. Metadata addresses are never orphaned.
+func (k Keeper) IsOrphanedAttributeAddress(ctx sdk.Context, addr string) bool {
+	accAddr, err := sdk.AccAddressFromBech32(addr)
+	if err != nil {
+		return false
+	}
+	return k.authKeeper.GetAccount(ctx, accAddr) == nil
+}
+
+// GetOrphanedAttributeAccounts returns the addresses of the accounts that have attributes but do not exist in x/auth.
+// The limit is the maximum number of addresses to return. Zero means no limit.
+func (k Keeper) GetOrphanedAttributeAccounts(ctx sdk.Context, limit int) ([]string, error) {
+	var orphans []string
+	lastAddr := ""
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.AttributeKeyPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var attr types.Attribute
+		if err := types.UnmarshalStoredAttribute(k.cdc, iterator.Value(), &attr); err != nil {
+			return nil, err
+		}
+		// All of an address's attributes are stored together, so we only need to check each address once.
+		if attr.Address == lastAddr {
+			continue
+		}
+		lastAddr = attr.Address
+		if k.IsOrphanedAttributeAddress(ctx, attr.Address) {
+			orphans = append(orphans, attr.Address)
+			if limit > 0 && len(orphans) >= limit {
+				break
+			}
+		}
+	}
+	return orphans, nil
+}
+
+// PurgeAccountAttributes removes all attributes (and their indexes) from an account address.
+// The account must not exist in x/auth. An error is returned if a veto mode hook contract rejects any of the deletions.
+func (k Keeper) PurgeAccountAttributes(ctx sdk.Context, addr string) error {
+	if !k.IsOrphanedAttributeAddress(ctx, addr) {
+		return fmt.Errorf("cannot purge attributes of %q: address is not an account address without an account", addr)
+	}
+
+	attrs, err := k.GetAllAttributes(ctx, addr)
+	if err != nil {
+		return err
+	}
+	store := ctx.KVStore(k.storeKey)
+	for _, attr := range attrs {
+		addrBz := attr.GetAddressBytes()
+		store.Delete(types.AddrAttributeKey(addrBz, attr))
+		k.DecAttrNameAddressLookup(ctx, attr.Name, addrBz)
+		k.deleteAttributeValueLookup(store, attr)
+		k.deleteAttributeExpireLookup(store, attr)
+		if err = k.runAttributeHook(ctx, types.AttributeHookActionDelete, attr, nil); err != nil {
+			return err
+		}
+	}
+
+	return ctx.EventManager().EmitTypedEvent(types.NewEventAccountAttributesPurged(addr, len(attrs)))
+}
+
+// PurgeOrphanedAttributes removes all attributes (and their indexes) from accounts that do not exist in x/auth.
+// The maxAccounts is the maximum number of accounts to purge. Zero means no limit.
+// The addresses of the purged accounts are returned.
+func (k Keeper) PurgeOrphanedAttributes(ctx sdk.Context, maxAccounts uint32) ([]string, error) {
+	orphans, err := k.GetOrphanedAttributeAccounts(ctx, int(maxAccounts))
+	if err != nil {
+		return nil, err
+	}
+	for _, addr := range orphans {
+		if err = k.PurgeAccountAttributes(ctx, addr); err != nil {
+			return nil, err
+		}
+	}
+	return orphans, nil
+}
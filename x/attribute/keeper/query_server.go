@@ -1,7 +1,9 @@
 package keeper
 
 import (
+	"bytes"
 	"context"
+	"crypto/sha256"
 	"strings"
 
 	"google.golang.org/grpc/codes"
@@ -12,6 +14,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
 
+	"github.com/provenance-io/provenance/internal/provutils"
 	"github.com/provenance-io/provenance/x/attribute/types"
 )
 
@@ -40,7 +43,7 @@ func (k Keeper) Attribute(c context.Context, req *types.QueryAttributeRequest) (
 	attributeStore := prefix.NewStore(store, types.AddrStrAttributesNameKeyPrefix(req.Account, req.Name))
 	pageRes, err := query.FilteredPaginate(attributeStore, req.Pagination, func(_ []byte, value []byte, accumulate bool) (bool, error) {
 		var result types.Attribute
-		err := k.cdc.Unmarshal(value, &result)
+		err := types.UnmarshalStoredAttribute(k.cdc, value, &result)
 		if err != nil {
 			return false, err
 		}
@@ -75,7 +78,7 @@ func (k Keeper) Attributes(c context.Context, req *types.QueryAttributesRequest)
 
 	pageRes, err := query.FilteredPaginate(attributeStore, req.Pagination, func(_ []byte, value []byte, accumulate bool) (bool, error) {
 		var result types.Attribute
-		err := k.cdc.Unmarshal(value, &result)
+		err := types.UnmarshalStoredAttribute(k.cdc, value, &result)
 		if err != nil {
 			return false, err
 		}
@@ -83,6 +86,9 @@ func (k Keeper) Attributes(c context.Context, req *types.QueryAttributesRequest)
 		if result.ExpirationDate != nil && ctx.BlockTime().UTC().After(result.ExpirationDate.UTC()) {
 			return false, nil
 		}
+		if k.IsAttributeUnlisted(ctx, result.GetAddressBytes(), result.Name) {
+			return false, nil
+		}
 
 		if accumulate {
 			attributes = append(attributes, result)
@@ -115,13 +121,16 @@ func (k Keeper) Scan(c context.Context, req *types.QueryScanRequest) (*types.Que
 
 	pageRes, err := query.FilteredPaginate(attributeStore, req.Pagination, func(_ []byte, value []byte, accumulate bool) (bool, error) {
 		var result types.Attribute
-		err := k.cdc.Unmarshal(value, &result)
+		err := types.UnmarshalStoredAttribute(k.cdc, value, &result)
 		if err != nil {
 			return false, err
 		}
 		if !strings.HasSuffix(result.Name, req.Suffix) || (result.ExpirationDate != nil && ctx.BlockTime().UTC().After(result.ExpirationDate.UTC())) {
 			return false, nil
 		}
+		if k.IsAttributeUnlisted(ctx, result.GetAddressBytes(), result.Name) {
+			return false, nil
+		}
 		if accumulate {
 			attributes = append(attributes, result)
 		}
@@ -145,10 +154,15 @@ func (k Keeper) AttributeAccounts(c context.Context, req *types.QueryAttributeAc
 	store := ctx.KVStore(k.storeKey)
 	keyPrefix := types.AttributeNameKeyPrefix(req.AttributeName)
 	attributeStore := prefix.NewStore(store, keyPrefix)
+	params := k.GetParams(ctx)
+	pageReq, truncated := provutils.LimitPageRequest(req.Pagination, params.MaxQueryResults)
 
-	pageRes, err := query.FilteredPaginate(attributeStore, req.Pagination, func(key []byte, _ []byte, accumulate bool) (bool, error) {
+	pageRes, err := query.FilteredPaginate(attributeStore, pageReq, func(key []byte, _ []byte, accumulate bool) (bool, error) {
 		addressLength := int32(key[0])
 		address := sdk.AccAddress(key[1 : addressLength+1])
+		if k.IsAttributeUnlisted(ctx, address, req.AttributeName) {
+			return false, nil
+		}
 		for _, account := range accounts {
 			if account == address.String() {
 				return false, nil
@@ -164,7 +178,120 @@ func (k Keeper) AttributeAccounts(c context.Context, req *types.QueryAttributeAc
 		return nil, err
 	}
 
-	return &types.QueryAttributeAccountsResponse{Accounts: accounts, Pagination: pageRes}, nil
+	resp := &types.QueryAttributeAccountsResponse{Accounts: accounts, Truncated: truncated, Pagination: pageRes}
+	if err = provutils.ValidateQueryResponseSize(resp, params.MaxQueryResponseBytes); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// AttributeAccountsByValue queries for all accounts that have an attribute with the given name and value hash.
+func (k Keeper) AttributeAccountsByValue(c context.Context, req *types.QueryAttributeAccountsByValueRequest) (*types.QueryAttributeAccountsByValueResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if len(strings.TrimSpace(req.AttributeName)) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "attribute name cannot be empty")
+	}
+	if len(req.ValueHash) != sha256.Size {
+		return nil, status.Errorf(codes.InvalidArgument, "value hash must be %d bytes, got %d", sha256.Size, len(req.ValueHash))
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	accounts := make([]string, 0)
+	store := ctx.KVStore(k.storeKey)
+	lookupStore := prefix.NewStore(store, types.AttributeNameValueKeyPrefix(req.AttributeName, req.ValueHash))
+
+	pageRes, err := query.FilteredPaginate(lookupStore, req.Pagination, func(key []byte, _ []byte, accumulate bool) (bool, error) {
+		addressLength := int32(key[0])
+		address := sdk.AccAddress(key[1 : addressLength+1])
+		if k.IsAttributeUnlisted(ctx, address, req.AttributeName) {
+			return false, nil
+		}
+		if accumulate {
+			accounts = append(accounts, address.String())
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryAttributeAccountsByValueResponse{Accounts: accounts, Pagination: pageRes}, nil
+}
+
+// AttributeAccountsByValueRange queries for all attributes with the given name and a typed value within a range.
+func (k Keeper) AttributeAccountsByValueRange(c context.Context, req *types.QueryAttributeAccountsByValueRangeRequest) (*types.QueryAttributeAccountsByValueRangeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if len(strings.TrimSpace(req.AttributeName)) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "attribute name cannot be empty")
+	}
+	if !types.IsRangeType(req.ValueType) {
+		return nil, status.Errorf(codes.InvalidArgument, "value type %s does not support range queries", req.ValueType)
+	}
+	minBz, err := getRangeBound(req.ValueType, req.Min)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid min: %v", err)
+	}
+	maxBz, err := getRangeBound(req.ValueType, req.Max)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid max: %v", err)
+	}
+	if minBz != nil && maxBz != nil && bytes.Compare(minBz, maxBz) > 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "min %q cannot be greater than max %q", req.Min, req.Max)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	blockTime := ctx.BlockTime().UTC()
+	attributes := make([]types.Attribute, 0)
+	store := ctx.KVStore(k.storeKey)
+	rangeStore := prefix.NewStore(store, types.AttributeRangeKeyPrefix(req.AttributeName, req.ValueType))
+
+	// Each key is [range lookup value (8)][length + address bytes], and the value is the attribute value.
+	pageRes, err := query.FilteredPaginate(rangeStore, req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		rangeValue := key[:8]
+		if (minBz != nil && bytes.Compare(rangeValue, minBz) < 0) || (maxBz != nil && bytes.Compare(rangeValue, maxBz) > 0) {
+			return false, nil
+		}
+		addrBz := key[9 : 9+int(key[8])]
+		if k.IsAttributeUnlisted(ctx, addrBz, req.AttributeName) {
+			return false, nil
+		}
+		bz := store.Get(types.AddrAttributeKey(addrBz, types.Attribute{Name: req.AttributeName, Value: value}))
+		if bz == nil {
+			return false, nil
+		}
+		var attr types.Attribute
+		if err := types.UnmarshalStoredAttribute(k.cdc, bz, &attr); err != nil {
+			return false, err
+		}
+		if attr.AttributeType != req.ValueType || (attr.ExpirationDate != nil && blockTime.After(attr.ExpirationDate.UTC())) {
+			return false, nil
+		}
+		if accumulate {
+			attributes = append(attributes, attr)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryAttributeAccountsByValueRangeResponse{Attributes: attributes, Pagination: pageRes}, nil
+}
+
+// getRangeBound converts the provided range query bound into a range lookup value.
+// Returns nil (without error) if the bound is empty.
+func getRangeBound(valueType types.AttributeType, bound string) ([]byte, error) {
+	if len(strings.TrimSpace(bound)) == 0 {
+		return nil, nil
+	}
+	value, err := types.ParseRangeTypeValue(valueType, bound)
+	if err != nil {
+		return nil, err
+	}
+	return types.GetRangeLookupValue(valueType, value)
 }
 
 // AccountData returns the accountdata for a specified account.
@@ -184,3 +311,106 @@ func (k Keeper) AccountData(c context.Context, req *types.QueryAccountDataReques
 	}
 	return resp, nil
 }
+
+// AttributeQuota returns how many more attribute names an account can have, and
+// (if a name is provided) how many more values it can have for that name.
+func (k Keeper) AttributeQuota(c context.Context, req *types.QueryAttributeQuotaRequest) (*types.QueryAttributeQuotaResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if err := types.ValidateAttributeAddress(req.Account); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid account address: %v", err)
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	addr := types.GetAttributeAddressBytes(req.Account)
+
+	resp := &types.QueryAttributeQuotaResponse{Names: k.GetAttributeNameQuota(ctx, addr)}
+	if len(strings.TrimSpace(req.Name)) > 0 {
+		name, err := k.nameKeeper.Normalize(ctx, req.Name)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid attribute name %q: %v", req.Name, err)
+		}
+		values := k.GetAttributeValueQuota(ctx, addr, name)
+		resp.Values = &values
+	}
+	return resp, nil
+}
+
+// AttributeHook returns the wasm contract hook registered for an attribute name.
+func (k Keeper) AttributeHook(c context.Context, req *types.QueryAttributeHookRequest) (*types.QueryAttributeHookResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if len(strings.TrimSpace(req.Name)) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "name cannot be empty")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	name, err := k.nameKeeper.Normalize(ctx, req.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid attribute name %q: %v", req.Name, err)
+	}
+	hook, err := k.GetAttributeHook(ctx, name)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryAttributeHookResponse{Hook: hook}, nil
+}
+
+// BulkAttributes returns the attributes (optionally only those with a given name) of each of several accounts.
+func (k Keeper) BulkAttributes(c context.Context, req *types.QueryBulkAttributesRequest) (*types.QueryBulkAttributesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if len(req.Accounts) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "at least one account is required")
+	}
+	if len(req.Accounts) > types.MaxBulkAttributeAccounts {
+		return nil, status.Errorf(codes.InvalidArgument, "too many accounts: %d, max is %d", len(req.Accounts), types.MaxBulkAttributeAccounts)
+	}
+	seen := make(map[string]bool, len(req.Accounts))
+	for _, account := range req.Accounts {
+		if err := types.ValidateAttributeAddress(account); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid account address %q: %v", account, err)
+		}
+		if seen[account] {
+			return nil, status.Errorf(codes.InvalidArgument, "duplicate account address %q", account)
+		}
+		seen[account] = true
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	name := ""
+	if len(strings.TrimSpace(req.Name)) > 0 {
+		var err error
+		if name, err = k.nameKeeper.Normalize(ctx, req.Name); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid attribute name %q: %v", req.Name, err)
+		}
+	}
+
+	resp := &types.QueryBulkAttributesResponse{Accounts: make([]types.AccountAttributes, 0, len(req.Accounts))}
+	for _, account := range req.Accounts {
+		keyPrefix := types.AddrStrAttributesKeyPrefix(account)
+		pred := func(string) bool { return true }
+		if len(name) > 0 {
+			keyPrefix = types.AddrStrAttributesNameKeyPrefix(account, name)
+			pred = func(attrName string) bool { return attrName == name }
+		}
+		attrs, err := k.prefixScan(ctx, keyPrefix, pred)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		entry := types.AccountAttributes{Account: account, Attributes: make([]types.Attribute, 0, len(attrs))}
+		for _, attr := range attrs {
+			if attr.ExpirationDate != nil && ctx.BlockTime().UTC().After(attr.ExpirationDate.UTC()) {
+				continue
+			}
+			if k.IsAttributeUnlisted(ctx, attr.GetAddressBytes(), attr.Name) {
+				continue
+			}
+			entry.Attributes = append(entry.Attributes, attr)
+		}
+		resp.Accounts = append(resp.Accounts, entry)
+	}
+	return resp, nil
+}
@@ -1,7 +1,11 @@
 package keeper_test
 
 import (
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/suite"
@@ -118,6 +122,253 @@ func (s *QueryServerTestSuite) TestAttributeAccountsQuery() {
 	allResults = append(allResults, results.Accounts...)
 
 	s.Assert().ElementsMatch(accounts, allResults)
+	s.Assert().False(results.Truncated, "truncated without max query results")
+
+	params := s.app.AttributeKeeper.GetParams(s.ctx)
+	params.MaxQueryResults = 30
+	s.app.AttributeKeeper.SetParams(s.ctx, params)
+	results, err = s.queryClient.AttributeAccounts(s.ctx, &types.QueryAttributeAccountsRequest{AttributeName: name1})
+	s.Require().NoError(err, "max query results: no page request")
+	s.Assert().Len(results.Accounts, 30, "max query results: no page request")
+	s.Assert().True(results.Truncated, "max query results: no page request: truncated")
+	results, err = s.queryClient.AttributeAccounts(s.ctx, &types.QueryAttributeAccountsRequest{AttributeName: name1, Pagination: &query.PageRequest{Limit: 20}})
+	s.Require().NoError(err, "max query results: limit 20")
+	s.Assert().Len(results.Accounts, 20, "max query results: limit 20")
+	s.Assert().False(results.Truncated, "max query results: limit 20: truncated")
+
+	params.MaxQueryResponseBytes = 1000
+	s.app.AttributeKeeper.SetParams(s.ctx, params)
+	_, err = s.queryClient.AttributeAccounts(s.ctx, &types.QueryAttributeAccountsRequest{AttributeName: name1})
+	s.Assert().ErrorContains(err, "exceeds the maximum of 1000 bytes: use a smaller page limit", "max query response bytes: 30 results")
+	results, err = s.queryClient.AttributeAccounts(s.ctx, &types.QueryAttributeAccountsRequest{AttributeName: name1, Pagination: &query.PageRequest{Limit: 10}})
+	s.Require().NoError(err, "max query response bytes: limit 10")
+	s.Assert().Len(results.Accounts, 10, "max query response bytes: limit 10")
+}
+
+func (s *QueryServerTestSuite) TestAttributeAccountsByValueQuery() {
+	name := "example.attribute"
+	s.Require().NoError(s.app.NameKeeper.SetNameRecord(s.ctx, name, s.owner1Addr, false))
+	value := []byte("0123456789")
+	valueHash := sha256.Sum256(value)
+	var accounts []string
+	for i := 0; i < 20; i++ {
+		acct := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+		attrValue := value
+		if i%2 == 1 {
+			attrValue = []byte("other")
+		} else {
+			accounts = append(accounts, acct)
+		}
+		s.Require().NoError(s.app.AttributeKeeper.SetAttribute(s.ctx, types.Attribute{
+			Name:          name,
+			Value:         attrValue,
+			Address:       acct,
+			AttributeType: types.AttributeType_String,
+		}, s.owner1Addr))
+	}
+
+	results, err := s.queryClient.AttributeAccountsByValue(s.ctx, &types.QueryAttributeAccountsByValueRequest{AttributeName: name, ValueHash: valueHash[:]})
+	s.Require().NoError(err)
+	s.Assert().ElementsMatch(accounts, results.Accounts)
+
+	var allResults []string
+	results, err = s.queryClient.AttributeAccountsByValue(s.ctx, &types.QueryAttributeAccountsByValueRequest{AttributeName: name, ValueHash: valueHash[:], Pagination: &query.PageRequest{Limit: 5}})
+	s.Require().NoError(err)
+	s.Assert().Len(results.Accounts, 5)
+	allResults = append(allResults, results.Accounts...)
+	results, err = s.queryClient.AttributeAccountsByValue(s.ctx, &types.QueryAttributeAccountsByValueRequest{AttributeName: name, ValueHash: valueHash[:], Pagination: &query.PageRequest{
+		Key:   results.Pagination.NextKey,
+		Limit: 5}})
+	s.Require().NoError(err)
+	s.Assert().Len(results.Accounts, 5)
+	allResults = append(allResults, results.Accounts...)
+	s.Assert().ElementsMatch(accounts, allResults)
+
+	unknownHash := sha256.Sum256([]byte("unknown"))
+	results, err = s.queryClient.AttributeAccountsByValue(s.ctx, &types.QueryAttributeAccountsByValueRequest{AttributeName: name, ValueHash: unknownHash[:]})
+	s.Require().NoError(err)
+	s.Assert().Empty(results.Accounts)
+
+	_, err = s.queryClient.AttributeAccountsByValue(s.ctx, &types.QueryAttributeAccountsByValueRequest{ValueHash: valueHash[:]})
+	s.Assert().ErrorContains(err, "attribute name cannot be empty")
+	_, err = s.queryClient.AttributeAccountsByValue(s.ctx, &types.QueryAttributeAccountsByValueRequest{AttributeName: name, ValueHash: value})
+	s.Assert().ErrorContains(err, "value hash must be 32 bytes, got 10")
+}
+
+func (s *QueryServerTestSuite) TestAttributeAccountsByValueRangeQuery() {
+	name := "range.attribute"
+	s.Require().NoError(s.app.NameKeeper.SetNameRecord(s.ctx, name, s.owner1Addr, false))
+	accounts := make([]string, 10)
+	for i := range accounts {
+		accounts[i] = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+		// Values from -5 to 4, added out of order.
+		s.Require().NoError(s.app.AttributeKeeper.SetAttribute(s.ctx, types.Attribute{
+			Name:          name,
+			Value:         types.Int64Value(int64((i*7)%10 - 5)),
+			Address:       accounts[i],
+			AttributeType: types.AttributeType_Int64,
+		}, s.owner1Addr))
+	}
+	// Other types with the same name shouldn't show up in the int64 results.
+	s.Require().NoError(s.app.AttributeKeeper.SetAttribute(s.ctx, types.Attribute{
+		Name:          name,
+		Value:         types.Float64Value(1),
+		Address:       accounts[0],
+		AttributeType: types.AttributeType_Float64,
+	}, s.owner1Addr))
+	deadline := time.Date(2030, 6, 1, 0, 0, 0, 0, time.UTC)
+	s.Require().NoError(s.app.AttributeKeeper.SetAttribute(s.ctx, types.Attribute{
+		Name:          name,
+		Value:         types.TimestampValue(deadline),
+		Address:       accounts[1],
+		AttributeType: types.AttributeType_Timestamp,
+	}, s.owner1Addr))
+
+	getValues := func(attrs []types.Attribute) []int64 {
+		var rv []int64
+		for _, attr := range attrs {
+			s.Require().Equal(types.AttributeType_Int64, attr.AttributeType, "attribute type")
+			rv = append(rv, int64(binary.BigEndian.Uint64(attr.Value)))
+		}
+		return rv
+	}
+
+	results, err := s.queryClient.AttributeAccountsByValueRange(s.ctx, &types.QueryAttributeAccountsByValueRangeRequest{
+		AttributeName: name, ValueType: types.AttributeType_Int64,
+	})
+	s.Require().NoError(err, "no bounds")
+	s.Assert().Equal([]int64{-5, -4, -3, -2, -1, 0, 1, 2, 3, 4}, getValues(results.Attributes), "no bounds")
+
+	results, err = s.queryClient.AttributeAccountsByValueRange(s.ctx, &types.QueryAttributeAccountsByValueRangeRequest{
+		AttributeName: name, ValueType: types.AttributeType_Int64, Min: "-2", Max: "1",
+	})
+	s.Require().NoError(err, "min and max")
+	s.Assert().Equal([]int64{-2, -1, 0, 1}, getValues(results.Attributes), "min and max")
+
+	results, err = s.queryClient.AttributeAccountsByValueRange(s.ctx, &types.QueryAttributeAccountsByValueRangeRequest{
+		AttributeName: name, ValueType: types.AttributeType_Int64, Min: "3",
+	})
+	s.Require().NoError(err, "min only")
+	s.Assert().Equal([]int64{3, 4}, getValues(results.Attributes), "min only")
+
+	results, err = s.queryClient.AttributeAccountsByValueRange(s.ctx, &types.QueryAttributeAccountsByValueRangeRequest{
+		AttributeName: name, ValueType: types.AttributeType_Int64, Max: "-4", Pagination: &query.PageRequest{Limit: 1},
+	})
+	s.Require().NoError(err, "max only page 1")
+	s.Assert().Equal([]int64{-5}, getValues(results.Attributes), "max only page 1")
+	results, err = s.queryClient.AttributeAccountsByValueRange(s.ctx, &types.QueryAttributeAccountsByValueRangeRequest{
+		AttributeName: name, ValueType: types.AttributeType_Int64, Max: "-4", Pagination: &query.PageRequest{Key: results.Pagination.NextKey, Limit: 1},
+	})
+	s.Require().NoError(err, "max only page 2")
+	s.Assert().Equal([]int64{-4}, getValues(results.Attributes), "max only page 2")
+
+	results, err = s.queryClient.AttributeAccountsByValueRange(s.ctx, &types.QueryAttributeAccountsByValueRangeRequest{
+		AttributeName: name, ValueType: types.AttributeType_Int64, Pagination: &query.PageRequest{Reverse: true},
+	})
+	s.Require().NoError(err, "reverse")
+	s.Assert().Equal([]int64{4, 3, 2, 1, 0, -1, -2, -3, -4, -5}, getValues(results.Attributes), "reverse")
+	results, err = s.queryClient.AttributeAccountsByValueRange(s.ctx, &types.QueryAttributeAccountsByValueRangeRequest{
+		AttributeName: name, ValueType: types.AttributeType_Int64, Min: "-1", Pagination: &query.PageRequest{Limit: 3, Reverse: true},
+	})
+	s.Require().NoError(err, "reverse min page 1")
+	s.Assert().Equal([]int64{4, 3, 2}, getValues(results.Attributes), "reverse min page 1")
+	results, err = s.queryClient.AttributeAccountsByValueRange(s.ctx, &types.QueryAttributeAccountsByValueRangeRequest{
+		AttributeName: name, ValueType: types.AttributeType_Int64, Min: "-1", Pagination: &query.PageRequest{Key: results.Pagination.NextKey, Limit: 3, Reverse: true},
+	})
+	s.Require().NoError(err, "reverse min page 2")
+	s.Assert().Equal([]int64{1, 0, -1}, getValues(results.Attributes), "reverse min page 2")
+
+	results, err = s.queryClient.AttributeAccountsByValueRange(s.ctx, &types.QueryAttributeAccountsByValueRangeRequest{
+		AttributeName: name, ValueType: types.AttributeType_Timestamp, Max: "2030-06-01T00:00:00Z",
+	})
+	s.Require().NoError(err, "timestamp")
+	s.Require().Len(results.Attributes, 1, "timestamp")
+	s.Assert().Equal(accounts[1], results.Attributes[0].Address, "timestamp result address")
+	results, err = s.queryClient.AttributeAccountsByValueRange(s.ctx, &types.QueryAttributeAccountsByValueRangeRequest{
+		AttributeName: name, ValueType: types.AttributeType_Timestamp, Max: "2030-05-31T23:59:59Z",
+	})
+	s.Require().NoError(err, "timestamp before")
+	s.Assert().Empty(results.Attributes, "timestamp before")
+
+	// Deleted and updated attributes should no longer be found by their old values.
+	val := types.Int64Value(-5)
+	s.Require().NoError(s.app.AttributeKeeper.DeleteAttribute(s.ctx, accounts[0], name, &val, s.owner1Addr), "DeleteAttribute")
+	s.Require().NoError(s.app.AttributeKeeper.UpdateAttribute(s.ctx,
+		types.Attribute{Name: name, Value: types.Int64Value(2), Address: accounts[1], AttributeType: types.AttributeType_Int64},
+		types.Attribute{Name: name, Value: types.Int64Value(20), Address: accounts[1], AttributeType: types.AttributeType_Int64},
+		s.owner1Addr), "UpdateAttribute")
+	results, err = s.queryClient.AttributeAccountsByValueRange(s.ctx, &types.QueryAttributeAccountsByValueRangeRequest{
+		AttributeName: name, ValueType: types.AttributeType_Int64,
+	})
+	s.Require().NoError(err, "after delete and update")
+	s.Assert().Equal([]int64{-4, -3, -2, -1, 0, 1, 3, 4, 20}, getValues(results.Attributes), "after delete and update")
+
+	_, err = s.queryClient.AttributeAccountsByValueRange(s.ctx, &types.QueryAttributeAccountsByValueRangeRequest{ValueType: types.AttributeType_Int64})
+	s.Assert().ErrorContains(err, "attribute name cannot be empty")
+	_, err = s.queryClient.AttributeAccountsByValueRange(s.ctx, &types.QueryAttributeAccountsByValueRangeRequest{AttributeName: name, ValueType: types.AttributeType_Int})
+	s.Assert().ErrorContains(err, "value type ATTRIBUTE_TYPE_INT does not support range queries")
+	_, err = s.queryClient.AttributeAccountsByValueRange(s.ctx, &types.QueryAttributeAccountsByValueRangeRequest{AttributeName: name, ValueType: types.AttributeType_Int64, Min: "x"})
+	s.Assert().ErrorContains(err, "invalid min: invalid ATTRIBUTE_TYPE_INT64 value \"x\"")
+	_, err = s.queryClient.AttributeAccountsByValueRange(s.ctx, &types.QueryAttributeAccountsByValueRangeRequest{AttributeName: name, ValueType: types.AttributeType_Int64, Max: "1.5"})
+	s.Assert().ErrorContains(err, "invalid max: invalid ATTRIBUTE_TYPE_INT64 value \"1.5\"")
+	_, err = s.queryClient.AttributeAccountsByValueRange(s.ctx, &types.QueryAttributeAccountsByValueRangeRequest{AttributeName: name, ValueType: types.AttributeType_Int64, Min: "2", Max: "1"})
+	s.Assert().ErrorContains(err, "min \"2\" cannot be greater than max \"1\"")
+}
+
+func (s *QueryServerTestSuite) TestUnlistedAttributes() {
+	name := "unlisted.attribute"
+	s.Require().NoError(s.app.NameKeeper.SetNameRecord(s.ctx, name, s.owner1Addr, false))
+	listedAddr := sdk.AccAddress("listedAddr__________")
+	unlistedAddr := sdk.AccAddress("unlistedAddr________")
+	value := types.Int64Value(5)
+	valueHash := sha256.Sum256(value)
+	for _, addr := range []sdk.AccAddress{listedAddr, unlistedAddr} {
+		s.Require().NoError(s.app.AttributeKeeper.SetAttribute(s.ctx, types.Attribute{
+			Name:          name,
+			Value:         value,
+			Address:       addr.String(),
+			AttributeType: types.AttributeType_Int64,
+		}, s.owner1Addr), "SetAttribute(%s)", addr)
+	}
+	s.Require().NoError(s.app.AttributeKeeper.SetAttributeUnlisted(s.ctx, unlistedAddr.String(), name, true), "SetAttributeUnlisted")
+
+	getAddrs := func(attrs []types.Attribute) []string {
+		var rv []string
+		for _, attr := range attrs {
+			rv = append(rv, attr.Address)
+		}
+		return rv
+	}
+	assertQueries := func(expAccounts []string, msg string) {
+		attrResp, err := s.queryClient.Attribute(s.ctx, &types.QueryAttributeRequest{Account: unlistedAddr.String(), Name: name})
+		s.Require().NoError(err, "%s: Attribute", msg)
+		s.Assert().Len(attrResp.Attributes, 1, "%s: Attribute: attributes", msg)
+
+		attrsResp, err := s.queryClient.Attributes(s.ctx, &types.QueryAttributesRequest{Account: unlistedAddr.String()})
+		s.Require().NoError(err, "%s: Attributes", msg)
+		s.Assert().Equal(len(expAccounts) == 2, len(attrsResp.Attributes) == 1, "%s: Attributes: has attribute", msg)
+
+		scanResp, err := s.queryClient.Scan(s.ctx, &types.QueryScanRequest{Account: unlistedAddr.String(), Suffix: "attribute"})
+		s.Require().NoError(err, "%s: Scan", msg)
+		s.Assert().Equal(len(expAccounts) == 2, len(scanResp.Attributes) == 1, "%s: Scan: has attribute", msg)
+
+		accountsResp, err := s.queryClient.AttributeAccounts(s.ctx, &types.QueryAttributeAccountsRequest{AttributeName: name})
+		s.Require().NoError(err, "%s: AttributeAccounts", msg)
+		s.Assert().ElementsMatch(expAccounts, accountsResp.Accounts, "%s: AttributeAccounts", msg)
+
+		byValueResp, err := s.queryClient.AttributeAccountsByValue(s.ctx, &types.QueryAttributeAccountsByValueRequest{AttributeName: name, ValueHash: valueHash[:]})
+		s.Require().NoError(err, "%s: AttributeAccountsByValue", msg)
+		s.Assert().ElementsMatch(expAccounts, byValueResp.Accounts, "%s: AttributeAccountsByValue", msg)
+
+		byRangeResp, err := s.queryClient.AttributeAccountsByValueRange(s.ctx, &types.QueryAttributeAccountsByValueRangeRequest{AttributeName: name, ValueType: types.AttributeType_Int64})
+		s.Require().NoError(err, "%s: AttributeAccountsByValueRange", msg)
+		s.Assert().ElementsMatch(expAccounts, getAddrs(byRangeResp.Attributes), "%s: AttributeAccountsByValueRange", msg)
+	}
+
+	assertQueries([]string{listedAddr.String()}, "unlisted")
+
+	s.Require().NoError(s.app.AttributeKeeper.SetAttributeUnlisted(s.ctx, unlistedAddr.String(), name, false), "SetAttributeUnlisted(false)")
+	assertQueries([]string{listedAddr.String(), unlistedAddr.String()}, "listed again")
 }
 
 func (s *QueryServerTestSuite) TestAccountData() {
@@ -198,3 +449,180 @@ func (s *QueryServerTestSuite) TestAccountData() {
 		})
 	}
 }
+
+func (s *QueryServerTestSuite) TestAttributeQuota() {
+	s.Require().NoError(s.app.NameKeeper.SetNameRecord(s.ctx, "quota.attribute", s.owner1Addr, false), "SetNameRecord")
+	params := s.app.AttributeKeeper.GetParams(s.ctx)
+	params.MaxNamesPerAccount = 3
+	params.MaxValuesPerName = 5
+	s.app.AttributeKeeper.SetParams(s.ctx, params)
+
+	addr := sdk.AccAddress("quota_account_______").String()
+	for _, value := range []string{"a", "b"} {
+		attr := types.NewAttribute("quota.attribute", addr, types.AttributeType_String, []byte(value), nil)
+		s.Require().NoError(s.app.AttributeKeeper.SetAttribute(s.ctx, attr, s.owner1Addr), "SetAttribute(%q)", value)
+	}
+
+	tests := []struct {
+		name   string
+		req    *types.QueryAttributeQuotaRequest
+		resp   *types.QueryAttributeQuotaResponse
+		expErr string
+	}{
+		{
+			name:   "nil request",
+			req:    nil,
+			expErr: "rpc error: code = InvalidArgument desc = invalid request",
+		},
+		{
+			name:   "invalid account",
+			req:    &types.QueryAttributeQuotaRequest{Account: "invalid"},
+			expErr: `rpc error: code = InvalidArgument desc = invalid account address: must be either an account address or scope metadata address: "invalid"`,
+		},
+		{
+			name: "account without attributes",
+			req:  &types.QueryAttributeQuotaRequest{Account: s.owner1},
+			resp: &types.QueryAttributeQuotaResponse{Names: types.AttributeQuota{Max: 3, Remaining: 3}},
+		},
+		{
+			name: "account with attributes",
+			req:  &types.QueryAttributeQuotaRequest{Account: addr},
+			resp: &types.QueryAttributeQuotaResponse{Names: types.AttributeQuota{Max: 3, Used: 1, Remaining: 2}},
+		},
+		{
+			name: "with name",
+			req:  &types.QueryAttributeQuotaRequest{Account: addr, Name: " Quota.Attribute "},
+			resp: &types.QueryAttributeQuotaResponse{
+				Names:  types.AttributeQuota{Max: 3, Used: 1, Remaining: 2},
+				Values: &types.AttributeQuota{Max: 5, Used: 2, Remaining: 3},
+			},
+		},
+		{
+			name: "with name that the account does not have",
+			req:  &types.QueryAttributeQuotaRequest{Account: addr, Name: "other.attribute"},
+			resp: &types.QueryAttributeQuotaResponse{
+				Names:  types.AttributeQuota{Max: 3, Used: 1, Remaining: 2},
+				Values: &types.AttributeQuota{Max: 5, Remaining: 5},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			resp, err := s.app.AttributeKeeper.AttributeQuota(s.ctx, tc.req)
+			if len(tc.expErr) > 0 {
+				s.Assert().EqualError(err, tc.expErr, "AttributeQuota")
+			} else {
+				s.Assert().NoError(err, "AttributeQuota")
+			}
+			s.Assert().Equal(tc.resp, resp, "AttributeQuota response")
+		})
+	}
+}
+
+func (s *QueryServerTestSuite) TestBulkAttributes() {
+	s.Require().NoError(s.app.NameKeeper.SetNameRecord(s.ctx, "kyc.bulk", s.owner1Addr, false), "SetNameRecord kyc.bulk")
+	s.Require().NoError(s.app.NameKeeper.SetNameRecord(s.ctx, "risk.bulk", s.owner1Addr, false), "SetNameRecord risk.bulk")
+
+	addr1 := sdk.AccAddress("bulk_account_1______").String()
+	addr2 := sdk.AccAddress("bulk_account_2______").String()
+	addr3 := sdk.AccAddress("bulk_account_3______").String()
+	expiration := s.ctx.BlockTime().Add(time.Hour)
+	for _, attr := range []types.Attribute{
+		types.NewAttribute("kyc.bulk", addr1, types.AttributeType_String, []byte("passed"), nil),
+		types.NewAttribute("risk.bulk", addr1, types.AttributeType_String, []byte("low"), nil),
+		types.NewAttribute("kyc.bulk", addr2, types.AttributeType_String, []byte("failed"), nil),
+		types.NewAttribute("risk.bulk", addr2, types.AttributeType_String, []byte("high"), &expiration),
+	} {
+		s.Require().NoError(s.app.AttributeKeeper.SetAttribute(s.ctx, attr, s.owner1Addr), "SetAttribute(%q, %q)", attr.Name, attr.Address)
+	}
+	// The query is made after the expiration, so the expired attribute is not returned.
+	queryCtx := s.ctx.WithBlockTime(expiration.Add(time.Minute))
+
+	tooMany := make([]string, types.MaxBulkAttributeAccounts+1)
+	for i := range tooMany {
+		tooMany[i] = sdk.AccAddress(fmt.Sprintf("bulk_account_%07d", i)).String()
+	}
+
+	// summarize converts the response into account -> "name=value" entries.
+	summarize := func(resp *types.QueryBulkAttributesResponse) map[string][]string {
+		if resp == nil {
+			return nil
+		}
+		rv := make(map[string][]string, len(resp.Accounts))
+		for _, entry := range resp.Accounts {
+			values := make([]string, 0, len(entry.Attributes))
+			for _, attr := range entry.Attributes {
+				values = append(values, attr.Name+"="+string(attr.Value))
+			}
+			rv[entry.Account] = values
+		}
+		return rv
+	}
+
+	tests := []struct {
+		name   string
+		req    *types.QueryBulkAttributesRequest
+		exp    map[string][]string
+		expErr string
+	}{
+		{
+			name:   "nil request",
+			req:    nil,
+			expErr: "rpc error: code = InvalidArgument desc = invalid request",
+		},
+		{
+			name:   "no accounts",
+			req:    &types.QueryBulkAttributesRequest{},
+			expErr: "rpc error: code = InvalidArgument desc = at least one account is required",
+		},
+		{
+			name:   "too many accounts",
+			req:    &types.QueryBulkAttributesRequest{Accounts: tooMany},
+			expErr: "rpc error: code = InvalidArgument desc = too many accounts: 101, max is 100",
+		},
+		{
+			name:   "invalid account",
+			req:    &types.QueryBulkAttributesRequest{Accounts: []string{addr1, "invalid"}},
+			expErr: `rpc error: code = InvalidArgument desc = invalid account address "invalid": must be either an account address or scope metadata address: "invalid"`,
+		},
+		{
+			name:   "duplicate account",
+			req:    &types.QueryBulkAttributesRequest{Accounts: []string{addr1, addr1}},
+			expErr: `rpc error: code = InvalidArgument desc = duplicate account address "` + addr1 + `"`,
+		},
+		{
+			name: "all attributes",
+			req:  &types.QueryBulkAttributesRequest{Accounts: []string{addr1, addr2, addr3}},
+			exp: map[string][]string{
+				addr1: {"kyc.bulk=passed", "risk.bulk=low"},
+				addr2: {"kyc.bulk=failed"},
+				addr3: {},
+			},
+		},
+		{
+			name: "with name",
+			req:  &types.QueryBulkAttributesRequest{Accounts: []string{addr1, addr2}, Name: " KYC.bulk "},
+			exp: map[string][]string{
+				addr1: {"kyc.bulk=passed"},
+				addr2: {"kyc.bulk=failed"},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			resp, err := s.app.AttributeKeeper.BulkAttributes(queryCtx, tc.req)
+			if len(tc.expErr) > 0 {
+				s.Assert().EqualError(err, tc.expErr, "BulkAttributes")
+			} else {
+				s.Require().NoError(err, "BulkAttributes")
+				s.Require().Len(resp.Accounts, len(tc.req.Accounts), "number of accounts")
+				for i, account := range tc.req.Accounts {
+					s.Assert().Equal(account, resp.Accounts[i].Account, "accounts[%d]", i)
+				}
+			}
+			s.Assert().Equal(tc.exp, summarize(resp), "BulkAttributes response")
+		})
+	}
+}
@@ -0,0 +1,101 @@
+package keeper
+
+import (
+	"bytes"
+	"fmt"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/attribute/types"
+)
+
+// nameKeyLen is the length of the attribute name hash that is part of each account attribute key.
+const nameKeyLen = 32
+
+// countAttributeNames returns the number of distinct attribute names that an account has.
+func (k Keeper) countAttributeNames(ctx sdk.Context, addr []byte) uint64 {
+	pre := types.AddrAttributesKeyPrefix(addr)
+	it := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), pre)
+	defer it.Close()
+
+	var rv uint64
+	var lastNameKey []byte
+	for ; it.Valid(); it.Next() {
+		// key: [prefix][length + address bytes][name hash][value hash]
+		key := it.Key()
+		if len(key) < len(pre)+nameKeyLen {
+			continue
+		}
+		// Keys are sorted, so all the values for a name are next to each other.
+		nameKey := key[len(pre) : len(pre)+nameKeyLen]
+		if !bytes.Equal(nameKey, lastNameKey) {
+			rv++
+			lastNameKey = nameKey
+		}
+	}
+	return rv
+}
+
+// countAttributeValues returns the number of values an account has for an attribute name.
+func (k Keeper) countAttributeValues(ctx sdk.Context, addr []byte, name string) uint64 {
+	it := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.AddrAttributesNameKeyPrefix(addr, name))
+	defer it.Close()
+
+	var rv uint64
+	for ; it.Valid(); it.Next() {
+		rv++
+	}
+	return rv
+}
+
+// newAttributeQuota creates a new AttributeQuota for the given limit and usage.
+func newAttributeQuota(maxCount uint32, used uint64) types.AttributeQuota {
+	rv := types.AttributeQuota{Max: maxCount, Used: used, Unlimited: maxCount == 0}
+	if !rv.Unlimited && used < uint64(maxCount) {
+		rv.Remaining = uint64(maxCount) - used
+	}
+	return rv
+}
+
+// GetAttributeNameQuota returns the usage of an account's distinct attribute names limit.
+func (k Keeper) GetAttributeNameQuota(ctx sdk.Context, addr []byte) types.AttributeQuota {
+	return newAttributeQuota(k.GetParams(ctx).MaxNamesPerAccount, k.countAttributeNames(ctx, addr))
+}
+
+// GetAttributeValueQuota returns the usage of an account's limit on values for an attribute name.
+func (k Keeper) GetAttributeValueQuota(ctx sdk.Context, addr []byte, name string) types.AttributeQuota {
+	return newAttributeQuota(k.GetParams(ctx).MaxValuesPerName, k.countAttributeValues(ctx, addr, name))
+}
+
+// validateAttributeQuota returns an error if adding the provided attribute would put its account over
+// the max names per account or max values per name limits.
+// Replacing an existing attribute (same name and value) doesn't use any more quota, so it's always allowed.
+// Attributes are never evicted to make room: if a limit is lowered, accounts that are already over it keep
+// their attributes, but cannot add any more until they're back under the limit.
+func (k Keeper) validateAttributeQuota(ctx sdk.Context, attr types.Attribute) error {
+	params := k.GetParams(ctx)
+	if params.MaxNamesPerAccount == 0 && params.MaxValuesPerName == 0 {
+		return nil
+	}
+
+	addr := attr.GetAddressBytes()
+	store := ctx.KVStore(k.storeKey)
+	if store.Has(types.AddrAttributeKey(addr, attr)) {
+		return nil
+	}
+
+	valueCount := k.countAttributeValues(ctx, addr, attr.Name)
+	if valueCount == 0 {
+		if params.MaxNamesPerAccount > 0 && k.countAttributeNames(ctx, addr) >= uint64(params.MaxNamesPerAccount) {
+			return fmt.Errorf("account %s already has the maximum of %d attribute names", attr.Address, params.MaxNamesPerAccount)
+		}
+		return nil
+	}
+
+	if params.MaxValuesPerName > 0 && valueCount >= uint64(params.MaxValuesPerName) {
+		return fmt.Errorf("account %s already has the maximum of %d values for attribute %q", attr.Address, params.MaxValuesPerName, attr.Name)
+	}
+	return nil
+}
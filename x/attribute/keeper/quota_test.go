@@ -0,0 +1,88 @@
+package keeper_test
+
+import (
+	"github.com/provenance-io/provenance/x/attribute/types"
+)
+
+// setQuotaParams sets the max names per account and max values per name params.
+func (s *KeeperTestSuite) setQuotaParams(maxNames, maxValues uint32) {
+	params := s.app.AttributeKeeper.GetParams(s.ctx)
+	params.MaxNamesPerAccount = maxNames
+	params.MaxValuesPerName = maxValues
+	s.app.AttributeKeeper.SetParams(s.ctx, params)
+}
+
+// newQuotaAttr creates a new string attribute for user2 with the provided name and value.
+func (s *KeeperTestSuite) newQuotaAttr(name, value string) types.Attribute {
+	return types.Attribute{
+		Name:          name,
+		Value:         []byte(value),
+		Address:       s.user2,
+		AttributeType: types.AttributeType_String,
+	}
+}
+
+func (s *KeeperTestSuite) TestSetAttributeQuota() {
+	for _, name := range []string{"one.attribute", "two.attribute", "three.attribute"} {
+		s.Require().NoError(s.app.NameKeeper.SetNameRecord(s.ctx, name, s.user1Addr, false), "SetNameRecord(%q)", name)
+	}
+	s.setQuotaParams(2, 2)
+
+	tests := []struct {
+		name   string
+		attr   types.Attribute
+		expErr string
+	}{
+		{name: "first name", attr: s.newQuotaAttr("one.attribute", "a")},
+		{name: "second value of first name", attr: s.newQuotaAttr("one.attribute", "b")},
+		{
+			name:   "third value of first name",
+			attr:   s.newQuotaAttr("one.attribute", "c"),
+			expErr: `account ` + s.user2 + ` already has the maximum of 2 values for attribute "one.attribute"`,
+		},
+		{name: "replace existing value at limit", attr: s.newQuotaAttr("one.attribute", "b")},
+		{name: "second name", attr: s.newQuotaAttr("two.attribute", "a")},
+		{
+			name:   "third name",
+			attr:   s.newQuotaAttr("three.attribute", "a"),
+			expErr: "account " + s.user2 + " already has the maximum of 2 attribute names",
+		},
+		{name: "second value of second name", attr: s.newQuotaAttr("two.attribute", "b")},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			err := s.app.AttributeKeeper.SetAttribute(s.ctx, tc.attr, s.user1Addr)
+			if len(tc.expErr) > 0 {
+				s.Assert().EqualError(err, tc.expErr, "SetAttribute")
+			} else {
+				s.Assert().NoError(err, "SetAttribute")
+			}
+		})
+	}
+
+	s.Run("limits lowered", func() {
+		// Attributes are not evicted when the limits are lowered, but no more can be added.
+		s.setQuotaParams(1, 1)
+		attrs, err := s.app.AttributeKeeper.GetAllAttributes(s.ctx, s.user2)
+		s.Require().NoError(err, "GetAllAttributes")
+		s.Assert().Len(attrs, 4, "attributes after lowering limits")
+		s.Assert().Equal(types.AttributeQuota{Max: 1, Used: 2}, s.app.AttributeKeeper.GetAttributeNameQuota(s.ctx, s.user2Addr), "GetAttributeNameQuota")
+
+		err = s.app.AttributeKeeper.SetAttribute(s.ctx, s.newQuotaAttr("two.attribute", "c"), s.user1Addr)
+		s.Assert().EqualError(err, `account `+s.user2+` already has the maximum of 1 values for attribute "two.attribute"`, "SetAttribute new value")
+		s.Require().NoError(s.app.AttributeKeeper.DeleteAttribute(s.ctx, s.user2, "one.attribute", nil, s.user1Addr), "DeleteAttribute")
+		err = s.app.AttributeKeeper.SetAttribute(s.ctx, s.newQuotaAttr("three.attribute", "a"), s.user1Addr)
+		s.Assert().EqualError(err, "account "+s.user2+" already has the maximum of 1 attribute names", "SetAttribute new name after delete")
+	})
+
+	s.Run("no limits", func() {
+		s.setQuotaParams(0, 0)
+		err := s.app.AttributeKeeper.SetAttribute(s.ctx, s.newQuotaAttr("three.attribute", "a"), s.user1Addr)
+		s.Assert().NoError(err, "SetAttribute new name")
+		err = s.app.AttributeKeeper.SetAttribute(s.ctx, s.newQuotaAttr("two.attribute", "c"), s.user1Addr)
+		s.Assert().NoError(err, "SetAttribute new value")
+		s.Assert().Equal(types.AttributeQuota{Used: 2, Unlimited: true}, s.app.AttributeKeeper.GetAttributeNameQuota(s.ctx, s.user2Addr), "GetAttributeNameQuota")
+		s.Assert().Equal(types.AttributeQuota{Used: 3, Unlimited: true}, s.app.AttributeKeeper.GetAttributeValueQuota(s.ctx, s.user2Addr, "two.attribute"), "GetAttributeValueQuota")
+	})
+}
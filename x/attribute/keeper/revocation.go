@@ -0,0 +1,65 @@
+package keeper
+
+import (
+	"bytes"
+	"fmt"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/attribute/types"
+)
+
+// RevokeAttribute removes the attributes with the provided name that the issuer set on an account.
+// If a value is provided, only the attribute with that value is revoked.
+// The name must resolve to the issuer, and the allow_issuer_revocation param must be enabled.
+// Attributes without a recorded origin are treated as being issued by the current owner of the name.
+// Returns the number of attributes revoked.
+func (k Keeper) RevokeAttribute(ctx sdk.Context, addr string, name string, value []byte, issuer sdk.AccAddress, reason string) (uint32, error) {
+	if !k.GetParams(ctx).AllowIssuerRevocation {
+		return 0, fmt.Errorf("attribute revocation by issuer is not enabled")
+	}
+	if !k.resolvesTo(ctx, name, issuer) {
+		return 0, fmt.Errorf("%q does not resolve to address %q", name, issuer.String())
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	var toRevoke []types.Attribute
+	iter := storetypes.KVStorePrefixIterator(store, types.AddrStrAttributesNameKeyPrefix(addr, name))
+	for ; iter.Valid(); iter.Next() {
+		var attr types.Attribute
+		if err := types.UnmarshalStoredAttribute(k.cdc, iter.Value(), &attr); err != nil {
+			iter.Close()
+			return 0, err
+		}
+		if attr.Name != name || (len(value) > 0 && !bytes.Equal(value, attr.Value)) {
+			continue
+		}
+		if attr.Origin != nil && len(attr.Origin.Setter) > 0 && attr.Origin.Setter != issuer.String() {
+			continue
+		}
+		toRevoke = append(toRevoke, attr)
+	}
+	iter.Close()
+
+	if len(toRevoke) == 0 {
+		return 0, fmt.Errorf("no attributes with name %q issued by %s found on %s", name, issuer.String(), addr)
+	}
+
+	for _, attr := range toRevoke {
+		addrBz := attr.GetAddressBytes()
+		store.Delete(types.AddrAttributeKey(addrBz, attr))
+		k.DecAttrNameAddressLookup(ctx, attr.Name, addrBz)
+		k.deleteAttributeValueLookup(store, attr)
+		k.deleteAttributeExpireLookup(store, attr)
+		if err := k.runAttributeHook(ctx, types.AttributeHookActionDelete, attr, issuer); err != nil {
+			return 0, err
+		}
+		if err := ctx.EventManager().EmitTypedEvent(types.NewEventAttributeRevoked(attr, issuer.String(), reason)); err != nil {
+			return 0, err
+		}
+	}
+
+	return uint32(len(toRevoke)), nil //nolint:gosec // G115: An account can't have anywhere near 2^32 values for one name.
+}
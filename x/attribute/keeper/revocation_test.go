@@ -0,0 +1,75 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/attribute/types"
+)
+
+func (s *KeeperTestSuite) TestRevokeAttribute() {
+	name := "example.attribute"
+	getValues := func(ctx sdk.Context) []string {
+		attrs, err := s.app.AttributeKeeper.GetAttributes(ctx, s.user2, name)
+		s.Require().NoError(err, "GetAttributes")
+		var rv []string
+		for _, attr := range attrs {
+			rv = append(rv, string(attr.Value))
+		}
+		return rv
+	}
+	setValue := func(ctx sdk.Context, value string, owner sdk.AccAddress) {
+		attr := types.NewAttribute(name, s.user2, types.AttributeType_String, []byte(value), nil)
+		s.Require().NoError(s.app.AttributeKeeper.SetAttribute(ctx, attr, owner), "SetAttribute %q", value)
+	}
+	setAllowed := func(ctx sdk.Context, allowed bool) {
+		params := s.app.AttributeKeeper.GetParams(ctx)
+		params.AllowIssuerRevocation = allowed
+		s.app.AttributeKeeper.SetParams(ctx, params)
+	}
+
+	ctx, _ := s.ctx.CacheContext()
+	s.app.AccountKeeper.SetAccount(ctx, s.app.AccountKeeper.NewAccountWithAddress(ctx, s.user2Addr))
+	setValue(ctx, "one", s.user1Addr)
+	setValue(ctx, "two", s.user1Addr)
+	setValue(ctx, "three", s.user1Addr)
+
+	s.Run("not enabled", func() {
+		setAllowed(ctx, false)
+		_, err := s.app.AttributeKeeper.RevokeAttribute(ctx, s.user2, name, nil, s.user1Addr, "")
+		s.Require().EqualError(err, "attribute revocation by issuer is not enabled", "RevokeAttribute")
+		s.Assert().ElementsMatch([]string{"one", "three", "two"}, getValues(ctx), "values after failed revoke")
+	})
+
+	setAllowed(ctx, true)
+
+	s.Run("not the name owner", func() {
+		_, err := s.app.AttributeKeeper.RevokeAttribute(ctx, s.user2, name, nil, s.user2Addr, "")
+		s.Require().EqualError(err, `"example.attribute" does not resolve to address "`+s.user2+`"`, "RevokeAttribute")
+	})
+
+	s.Run("unknown value", func() {
+		_, err := s.app.AttributeKeeper.RevokeAttribute(ctx, s.user2, name, []byte("four"), s.user1Addr, "")
+		s.Require().EqualError(err, `no attributes with name "example.attribute" issued by `+s.user1+" found on "+s.user2, "RevokeAttribute")
+	})
+
+	s.Run("one value", func() {
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+		revoked, err := s.app.AttributeKeeper.RevokeAttribute(ctx, s.user2, name, []byte("two"), s.user1Addr, "fraud")
+		s.Require().NoError(err, "RevokeAttribute")
+		s.Assert().Equal(uint32(1), revoked, "revoked count")
+		s.Assert().ElementsMatch([]string{"one", "three"}, getValues(ctx), "values after revoke")
+		s.Assert().True(hasEventType(ctx, "provenance.attribute.v1.EventAttributeRevoked"), "has EventAttributeRevoked")
+	})
+
+	s.Run("only values issued by the issuer", func() {
+		s.Require().NoError(s.app.NameKeeper.UpdateNameRecord(ctx, name, s.user2Addr, false, s.user1Addr), "UpdateNameRecord")
+		setValue(ctx, "four", s.user2Addr)
+		revoked, err := s.app.AttributeKeeper.RevokeAttribute(ctx, s.user2, name, nil, s.user2Addr, "")
+		s.Require().NoError(err, "RevokeAttribute")
+		s.Assert().Equal(uint32(1), revoked, "revoked count")
+		s.Assert().ElementsMatch([]string{"one", "three"}, getValues(ctx), "values after revoke")
+
+		_, err = s.app.AttributeKeeper.RevokeAttribute(ctx, s.user2, name, []byte("one"), s.user2Addr, "")
+		s.Require().EqualError(err, `no attributes with name "example.attribute" issued by `+s.user2+" found on "+s.user2, "RevokeAttribute value issued by previous owner")
+	})
+}
@@ -0,0 +1,75 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/attribute/types"
+	nametypes "github.com/provenance-io/provenance/x/name/types"
+)
+
+var _ nametypes.AddressRotator = Keeper{}
+
+// RotateAddress moves the (unexpired) attributes on the old account that are owned by the rotating accounts to the new account.
+// An attribute is owned by them if its name resolves to the old or new address (the names are re-bound before this is called).
+// Attributes with names owned by anyone else (e.g. attestations by other accounts) are left on the old account since
+// only their issuer can give them to a different account. Expired attributes are also left on the old account
+// to be cleaned up as usual.
+// If the new account already has an identical attribute, the old account's copy is just removed.
+// The moved attributes still count towards the new account's attribute quota.
+// The old address is used as the owner in the emitted events and attribute hook calls.
+// An error is returned if a veto mode hook contract rejects the removal from the old account or addition to the new one.
+func (k Keeper) RotateAddress(ctx sdk.Context, oldAddr, newAddr sdk.AccAddress) error {
+	attrs, err := k.GetAllAttributesAddr(ctx, oldAddr)
+	if err != nil {
+		return fmt.Errorf("could not get attributes on %s: %w", oldAddr, err)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	owner := oldAddr.String()
+	for _, attr := range attrs {
+		if k.ValidateExpirationDate(ctx, attr) != nil {
+			continue
+		}
+		if !k.resolvesTo(ctx, attr.Name, oldAddr) && !k.resolvesTo(ctx, attr.Name, newAddr) {
+			continue
+		}
+
+		store.Delete(types.AddrAttributeKey(oldAddr, attr))
+		k.DecAttrNameAddressLookup(ctx, attr.Name, oldAddr)
+		k.deleteAttributeValueLookup(store, attr)
+		k.deleteAttributeExpireLookup(store, attr)
+		if err = k.runAttributeHook(ctx, types.AttributeHookActionDelete, attr, oldAddr); err != nil {
+			return err
+		}
+		if err = ctx.EventManager().EmitTypedEvent(types.NewEventDistinctAttributeDelete(attr.Name, string(attr.Value), attr.Address, owner)); err != nil {
+			return err
+		}
+
+		attr.Address = newAddr.String()
+		newKey := types.AddrAttributeKey(newAddr, attr)
+		if store.Has(newKey) {
+			// The new account already has this exact attribute; keep that one.
+			continue
+		}
+		if err = k.validateAttributeQuota(ctx, attr); err != nil {
+			return fmt.Errorf("could not move attribute %q to %s: %w", attr.Name, newAddr, err)
+		}
+		bz, err := types.MarshalStoredAttribute(k.cdc, attr)
+		if err != nil {
+			return err
+		}
+		store.Set(newKey, bz)
+		k.IncAttrNameAddressLookup(ctx, attr.Name, newAddr)
+		k.addAttributeValueLookup(store, attr)
+		k.addAttributeExpireLookup(store, attr)
+		if err = k.runAttributeHook(ctx, types.AttributeHookActionAdd, attr, oldAddr); err != nil {
+			return err
+		}
+		if err = ctx.EventManager().EmitTypedEvent(types.NewEventAttributeAdd(attr, owner)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
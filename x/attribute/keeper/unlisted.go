@@ -0,0 +1,68 @@
+package keeper
+
+import (
+	"fmt"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/attribute/types"
+)
+
+// IsAttributeUnlisted returns true if the account has marked the attribute name as unlisted.
+func (k Keeper) IsAttributeUnlisted(ctx sdk.Context, addr []byte, name string) bool {
+	if len(addr) == 0 {
+		return false
+	}
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.UnlistedAttributeKey(addr, name))
+}
+
+// SetAttributeUnlisted marks (or unmarks) an attribute name of an account as unlisted.
+// Unlisted attributes are left out of the enumeration queries, but can still be looked up directly.
+func (k Keeper) SetAttributeUnlisted(ctx sdk.Context, addr string, name string, unlisted bool) error {
+	u := types.UnlistedAttribute{Account: addr, Name: name}
+	if err := u.ValidateBasic(); err != nil {
+		return err
+	}
+	addrBz := types.GetAttributeAddressBytes(addr)
+	store := ctx.KVStore(k.storeKey)
+	key := types.UnlistedAttributeKey(addrBz, name)
+	if unlisted {
+		// The name is stored as the value since the key only has its hash.
+		store.Set(key, []byte(name))
+	} else {
+		store.Delete(key)
+	}
+	return ctx.EventManager().EmitTypedEvent(types.NewEventAttributeUnlistedUpdated(addr, name, unlisted))
+}
+
+// IterateUnlistedAttributes iterates over all the attribute names that accounts have marked as unlisted.
+func (k Keeper) IterateUnlistedAttributes(ctx sdk.Context, handler func(unlisted types.UnlistedAttribute) (stop bool)) error {
+	store := ctx.KVStore(k.storeKey)
+	it := storetypes.KVStorePrefixIterator(store, types.UnlistedAttributeKeyPrefix)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		// key: [prefix][length + address bytes][name hash]
+		key := it.Key()[len(types.UnlistedAttributeKeyPrefix):]
+		if len(key) == 0 || len(key) < 1+int(key[0]) {
+			return fmt.Errorf("invalid unlisted attribute key %X", it.Key())
+		}
+		addr := sdk.AccAddress(key[1 : 1+int(key[0])])
+		if handler(types.UnlistedAttribute{Account: addr.String(), Name: string(it.Value())}) {
+			break
+		}
+	}
+	return nil
+}
+
+// GetAllUnlistedAttributes returns all the attribute names that accounts have marked as unlisted.
+func (k Keeper) GetAllUnlistedAttributes(ctx sdk.Context) ([]types.UnlistedAttribute, error) {
+	var rv []types.UnlistedAttribute
+	err := k.IterateUnlistedAttributes(ctx, func(unlisted types.UnlistedAttribute) bool {
+		rv = append(rv, unlisted)
+		return false
+	})
+	return rv, err
+}
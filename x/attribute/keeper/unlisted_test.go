@@ -0,0 +1,106 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/attribute/types"
+)
+
+func (s *KeeperTestSuite) TestSetAttributeUnlisted() {
+	name := "example.attribute"
+	addr := sdk.AccAddress("unlistedAddr________")
+
+	tests := []struct {
+		name     string
+		addr     string
+		attrName string
+		unlisted bool
+		expErr   string
+	}{
+		{
+			name:     "invalid address",
+			addr:     "notabech32",
+			attrName: name,
+			unlisted: true,
+			expErr:   `invalid unlisted attribute account "notabech32": decoding bech32 failed: invalid separator index -1`,
+		},
+		{
+			name:     "empty name",
+			addr:     addr.String(),
+			attrName: "",
+			unlisted: true,
+			expErr:   "invalid unlisted attribute name: empty",
+		},
+		{
+			name:     "unlist",
+			addr:     addr.String(),
+			attrName: name,
+			unlisted: true,
+		},
+		{
+			name:     "unlist again",
+			addr:     addr.String(),
+			attrName: name,
+			unlisted: true,
+		},
+		{
+			name:     "list",
+			addr:     addr.String(),
+			attrName: name,
+			unlisted: false,
+		},
+		{
+			name:     "list when not unlisted",
+			addr:     addr.String(),
+			attrName: name,
+			unlisted: false,
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			em := sdk.NewEventManager()
+			ctx := s.ctx.WithEventManager(em)
+			err := s.app.AttributeKeeper.SetAttributeUnlisted(ctx, tc.addr, tc.attrName, tc.unlisted)
+			if len(tc.expErr) > 0 {
+				s.Assert().EqualError(err, tc.expErr, "SetAttributeUnlisted")
+				s.Assert().Empty(em.Events(), "events emitted during SetAttributeUnlisted")
+				return
+			}
+			s.Require().NoError(err, "SetAttributeUnlisted")
+			s.Assert().Equal(tc.unlisted, s.app.AttributeKeeper.IsAttributeUnlisted(s.ctx, addr, tc.attrName), "IsAttributeUnlisted")
+
+			expEvent, err := sdk.TypedEventToEvent(types.NewEventAttributeUnlistedUpdated(tc.addr, tc.attrName, tc.unlisted))
+			s.Require().NoError(err, "TypedEventToEvent")
+			s.Assert().Equal(sdk.Events{expEvent}, em.Events(), "events emitted during SetAttributeUnlisted")
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestUnlistedAttributesGenesis() {
+	attr := types.Attribute{
+		Name:          "example.attribute",
+		Value:         []byte("0123456789"),
+		Address:       s.user1,
+		AttributeType: types.AttributeType_String,
+	}
+	s.Require().NoError(s.app.AttributeKeeper.SetAttribute(s.ctx, attr, s.user1Addr), "SetAttribute")
+	s.Require().NoError(s.app.AttributeKeeper.SetAttributeUnlisted(s.ctx, s.user1, attr.Name, true), "SetAttributeUnlisted(user1)")
+	// An attribute name can be unlisted before the account has any attributes with that name.
+	s.Require().NoError(s.app.AttributeKeeper.SetAttributeUnlisted(s.ctx, s.user2, "other.attribute", true), "SetAttributeUnlisted(user2)")
+
+	expUnlisted := []types.UnlistedAttribute{
+		{Account: s.user1, Name: attr.Name},
+		{Account: s.user2, Name: "other.attribute"},
+	}
+	genState := s.app.AttributeKeeper.ExportGenesis(s.ctx)
+	s.Assert().ElementsMatch(expUnlisted, genState.UnlistedAttributes, "exported unlisted attributes")
+
+	ctx, _ := s.ctx.CacheContext()
+	s.Require().NoError(s.app.AttributeKeeper.SetAttributeUnlisted(ctx, s.user1, attr.Name, false), "SetAttributeUnlisted(user1, false)")
+	s.Require().NoError(s.app.AttributeKeeper.SetAttributeUnlisted(ctx, s.user2, "other.attribute", false), "SetAttributeUnlisted(user2, false)")
+	s.Require().Empty(s.app.AttributeKeeper.ExportGenesis(ctx).UnlistedAttributes, "unlisted attributes after clearing them")
+	s.Require().NotPanics(func() { s.app.AttributeKeeper.InitGenesis(ctx, genState) }, "InitGenesis")
+	s.Assert().True(s.app.AttributeKeeper.IsAttributeUnlisted(ctx, s.user1Addr, attr.Name), "IsAttributeUnlisted(user1) after InitGenesis")
+	s.Assert().True(s.app.AttributeKeeper.IsAttributeUnlisted(ctx, s.user2Addr, "other.attribute"), "IsAttributeUnlisted(user2) after InitGenesis")
+}
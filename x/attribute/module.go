@@ -1,9 +1,11 @@
 package attribute
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"math/rand"
 
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
@@ -124,13 +126,20 @@ func (AppModule) Name() string {
 	return types.ModuleName
 }
 
-// RegisterInvariants does nothing.
-func (am AppModule) RegisterInvariants(_ sdk.InvariantRegistry) {}
+// RegisterInvariants registers the invariants for the attribute module.
+func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
+	keeper.RegisterInvariants(ir, am.keeper)
+}
 
 // RegisterServices registers module services.
 func (am AppModule) RegisterServices(cfg module.Configurator) {
 	types.RegisterMsgServer(cfg.MsgServer(), keeper.NewMsgServerImpl(am.keeper))
 	types.RegisterQueryServer(cfg.QueryServer(), am.keeper)
+
+	m := keeper.NewMigrator(am.keeper)
+	if err := cfg.RegisterMigration(types.ModuleName, 2, m.Migrate2To3); err != nil {
+		panic(fmt.Sprintf("failed to register x/attribute migration from version 2 to 3: %v", err))
+	}
 }
 
 // InitGenesis performs genesis initialization for the attribute module. It returns no validator updates.
@@ -142,9 +151,18 @@ func (am AppModule) InitGenesis(ctx sdk.Context, cdc codec.JSONCodec, data json.
 }
 
 // ExportGenesis returns the exported genesis state as raw bytes for the attribute module.
+// The full JSON is returned, so it is all in memory at once. The export command uses ExportGenesisTo instead.
 func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.RawMessage {
-	gs := am.keeper.ExportGenesis(ctx)
-	return cdc.MustMarshalJSON(gs)
+	var buf bytes.Buffer
+	if err := am.ExportGenesisTo(ctx, cdc, &buf); err != nil {
+		panic(err)
+	}
+	return buf.Bytes()
+}
+
+// ExportGenesisTo writes the exported genesis state of the attribute module to the writer as it's read from state.
+func (am AppModule) ExportGenesisTo(ctx sdk.Context, cdc codec.JSONCodec, w io.Writer) error {
+	return am.keeper.ExportGenesisTo(ctx, cdc, w)
 }
 
 // BeginBlock returns the begin blocker for the attribute module.
@@ -178,4 +196,9 @@ func (am AppModule) WeightedOperations(simState module.SimulationState) []simtyp
 }
 
 // ConsensusVersion implements AppModule/ConsensusVersion.
-func (AppModule) ConsensusVersion() uint64 { return 2 }
+func (AppModule) ConsensusVersion() uint64 { return 3 }
+
+// Features returns the names of the module's optional features that are currently enabled.
+func (am AppModule) Features(ctx sdk.Context) []string {
+	return am.keeper.GetParams(ctx).Features()
+}
@@ -18,8 +18,12 @@ func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
 		case bytes.Equal(kvA.Key[:1], types.AttributeKeyPrefix):
 			var attribA, attribB types.Attribute
 
-			cdc.MustUnmarshal(kvA.Value, &attribA)
-			cdc.MustUnmarshal(kvB.Value, &attribB)
+			if err := types.UnmarshalStoredAttribute(cdc, kvA.Value, &attribA); err != nil {
+				panic(err)
+			}
+			if err := types.UnmarshalStoredAttribute(cdc, kvB.Value, &attribB); err != nil {
+				panic(err)
+			}
 
 			return fmt.Sprintf("%v\n%v", attribA, attribB)
 		default:
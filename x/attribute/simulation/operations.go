@@ -3,6 +3,7 @@ package simulation
 import (
 	"fmt"
 	"math/rand"
+	"time"
 
 	"github.com/google/uuid"
 
@@ -81,7 +82,7 @@ func SimulateMsgAddAttribute(simState module.SimulationState, _ keeper.Keeper, a
 			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(&types.MsgAddAttributeRequest{}), "no name records available to create under"), nil, nil
 		}
 
-		t := types.AttributeType(r.Intn(9)) //nolint:gosec // G115: r.Intn(9) will always fit in an int32 (implicit cast here).
+		t := types.AttributeType(r.Intn(12)) //nolint:gosec // G115: r.Intn(12) will always fit in an int32 (implicit cast here).
 		msg := types.NewMsgAddAttributeRequest(
 			randomRecord.GetAddress(),
 			simAccount.Address,
@@ -107,7 +108,7 @@ func SimulateMsgUpdateAttribute(simState module.SimulationState, k keeper.Keeper
 			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(&types.MsgUpdateAttributeRequest{}), "no attributes available to delete"), nil, nil
 		}
 
-		t := types.AttributeType(r.Intn(9)) //nolint:gosec // G115: r.Intn(9) will always fit in an int32 (implicit cast here).
+		t := types.AttributeType(r.Intn(12)) //nolint:gosec // G115: r.Intn(12) will always fit in an int32 (implicit cast here).
 		msg := types.NewMsgUpdateAttributeRequest(
 			randomAttribute.GetAddress(),
 			simAccount.Address,
@@ -205,6 +206,12 @@ func getRandomValueOfType(r *rand.Rand, t types.AttributeType) []byte {
 		return []byte("http://www.example.com/")
 	case types.AttributeType_JSON:
 		return []byte(`{"id":"value"}`)
+	case types.AttributeType_Int64:
+		return types.Int64Value(r.Int63() - r.Int63())
+	case types.AttributeType_Float64:
+		return types.Float64Value(r.NormFloat64())
+	case types.AttributeType_Timestamp:
+		return types.TimestampValue(time.Unix(r.Int63n(1<<33), 0))
 	case types.AttributeType_Unspecified:
 		return nil
 	}
@@ -0,0 +1,254 @@
+package subscription
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync/atomic"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/provenance-io/provenance/x/attribute/types"
+	nametypes "github.com/provenance-io/provenance/x/name/types"
+)
+
+// EventsClient defines the CometBFT client functionality needed to subscribe to the node's events.
+type EventsClient interface {
+	Subscribe(ctx context.Context, subscriber, query string, outCapacity ...int) (out <-chan coretypes.ResultEvent, err error)
+	UnsubscribeAll(ctx context.Context, subscriber string) error
+}
+
+// subscriptionCapacity is the number of results that can be waiting to be streamed before the node cancels a subscription.
+const subscriptionCapacity = 100
+
+// changeEvent is an attribute event type that is streamed.
+type changeEvent struct {
+	// eventType is the type of the typed event, i.e. its proto message name.
+	eventType string
+	// inBlock is true if the event is emitted outside of txs, false if it's emitted by txs.
+	inBlock bool
+	// toResponse puts the event into a response.
+	toResponse func(resp *types.AttributeChangesResponse, event proto.Message)
+}
+
+// changeEvents are the attribute event types that are streamed.
+var changeEvents = []changeEvent{
+	{
+		eventType: proto.MessageName(&types.EventAttributeAdd{}),
+		toResponse: func(resp *types.AttributeChangesResponse, event proto.Message) {
+			resp.Event = &types.AttributeChangesResponse_Add{Add: event.(*types.EventAttributeAdd)}
+		},
+	},
+	{
+		eventType: proto.MessageName(&types.EventAttributeUpdate{}),
+		toResponse: func(resp *types.AttributeChangesResponse, event proto.Message) {
+			resp.Event = &types.AttributeChangesResponse_Update{Update: event.(*types.EventAttributeUpdate)}
+		},
+	},
+	{
+		eventType: proto.MessageName(&types.EventAttributeExpirationUpdate{}),
+		toResponse: func(resp *types.AttributeChangesResponse, event proto.Message) {
+			resp.Event = &types.AttributeChangesResponse_ExpirationUpdate{ExpirationUpdate: event.(*types.EventAttributeExpirationUpdate)}
+		},
+	},
+	{
+		eventType: proto.MessageName(&types.EventAttributeDelete{}),
+		toResponse: func(resp *types.AttributeChangesResponse, event proto.Message) {
+			resp.Event = &types.AttributeChangesResponse_Delete{Delete: event.(*types.EventAttributeDelete)}
+		},
+	},
+	{
+		eventType: proto.MessageName(&types.EventAttributeDistinctDelete{}),
+		toResponse: func(resp *types.AttributeChangesResponse, event proto.Message) {
+			resp.Event = &types.AttributeChangesResponse_DistinctDelete{DistinctDelete: event.(*types.EventAttributeDistinctDelete)}
+		},
+	},
+	{
+		eventType: proto.MessageName(&types.EventAttributeExpired{}),
+		inBlock:   true,
+		toResponse: func(resp *types.AttributeChangesResponse, event proto.Message) {
+			resp.Event = &types.AttributeChangesResponse_Expired{Expired: event.(*types.EventAttributeExpired)}
+		},
+	},
+}
+
+// attributeEvent is implemented by all of the attribute events that are streamed.
+type attributeEvent interface {
+	proto.Message
+	GetAccount() string
+	GetName() string
+}
+
+// Server streams attribute changes using the events of a node's event bus.
+type Server struct {
+	client EventsClient
+}
+
+var _ types.SubscriptionServer = (*Server)(nil)
+
+// lastSubscriberID is used to give each subscription a unique subscriber name.
+var lastSubscriberID atomic.Uint64
+
+// NewServer creates a new Server that uses the provided client to subscribe to events.
+// If the client is nil, the server is still created, but all requests fail as unavailable.
+func NewServer(client EventsClient) *Server {
+	return &Server{client: client}
+}
+
+// AttributeChanges streams the attribute change events that match the request until the client disconnects.
+func (s *Server) AttributeChanges(req *types.AttributeChangesRequest, stream types.Subscription_AttributeChangesServer) error {
+	if s.client == nil {
+		return status.Error(codes.Unavailable, "event subscriptions are not available on this node")
+	}
+	f, err := newFilter(req)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx, cancel := context.WithCancel(stream.Context())
+	defer cancel()
+	subscriber := fmt.Sprintf("attribute-changes-%d", lastSubscriberID.Add(1))
+	defer s.client.UnsubscribeAll(context.Background(), subscriber) //nolint:errcheck // Nothing can be done about it here.
+
+	// CometBFT queries can't have alternative conditions, so there's a subscription for each event type.
+	// Each result is only checked for events of the type it's subscribed to so that no change is streamed twice.
+	type typedResult struct {
+		change changeEvent
+		result coretypes.ResultEvent
+		closed bool
+	}
+	results := make(chan typedResult)
+	for _, change := range changeEvents {
+		out, err := s.client.Subscribe(ctx, subscriber, f.query(change), subscriptionCapacity)
+		if err != nil {
+			return status.Errorf(codes.Unavailable, "could not subscribe to %s events: %v", change.eventType, err)
+		}
+		go func(change changeEvent) {
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case result, ok := <-out:
+					select {
+					case results <- typedResult{change: change, result: result, closed: !ok}:
+					case <-ctx.Done():
+					}
+					if !ok {
+						return
+					}
+				}
+			}
+		}(change)
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case tr := <-results:
+			if tr.closed {
+				return status.Errorf(codes.Unavailable, "subscription to %s events was cancelled by the node", tr.change.eventType)
+			}
+			for _, resp := range f.responses(tr.change, tr.result) {
+				if err = stream.Send(resp); err != nil {
+					return err
+				}
+			}
+		}
+	}
+}
+
+// filter identifies the attribute changes to stream.
+type filter struct {
+	account string
+	name    string
+}
+
+// newFilter creates the filter for the provided request, returning an error if the request is invalid.
+func newFilter(req *types.AttributeChangesRequest) (*filter, error) {
+	if req == nil {
+		return nil, fmt.Errorf("empty request")
+	}
+	rv := &filter{account: strings.TrimSpace(req.Account), name: nametypes.NormalizeName(req.Name)}
+	if len(rv.account) == 0 && len(rv.name) == 0 {
+		return nil, fmt.Errorf("an account or name is required")
+	}
+	if len(rv.account) > 0 {
+		if err := types.ValidateAttributeAddress(rv.account); err != nil {
+			return nil, fmt.Errorf("invalid account: %w", err)
+		}
+	}
+	if len(rv.name) > 0 {
+		if err := nametypes.ValidateName(rv.name); err != nil {
+			return nil, err
+		}
+	}
+	return rv, nil
+}
+
+// query returns the CometBFT query for the results with events of the given type that match this filter.
+// Typed event attribute values are json, so the strings being matched are quoted.
+func (f filter) query(change changeEvent) string {
+	parts := []string{cmttypes.EventQueryTx.String()}
+	if change.inBlock {
+		parts[0] = cmttypes.EventQueryNewBlockEvents.String()
+	}
+	if len(f.account) > 0 {
+		parts = append(parts, fmt.Sprintf(`%s.account = '"%s"'`, change.eventType, f.account))
+	}
+	if len(f.name) > 0 {
+		parts = append(parts, fmt.Sprintf(`%s.name = '"%s"'`, change.eventType, f.name))
+	}
+	return strings.Join(parts, " AND ")
+}
+
+// matches returns true if the provided event is for this filter's account and name.
+func (f filter) matches(event attributeEvent) bool {
+	return (len(f.account) == 0 || event.GetAccount() == f.account) &&
+		(len(f.name) == 0 || event.GetName() == f.name)
+}
+
+// responses returns a response for each event of the given type in the result that matches this filter.
+func (f filter) responses(change changeEvent, result coretypes.ResultEvent) []*types.AttributeChangesResponse {
+	var height int64
+	var txHash string
+	var events []abci.Event
+	switch data := result.Data.(type) {
+	case cmttypes.EventDataTx:
+		height = data.Height
+		txHash = fmt.Sprintf("%X", cmttypes.Tx(data.Tx).Hash())
+		events = data.Result.Events
+	case cmttypes.EventDataNewBlockEvents:
+		height = data.Height
+		events = data.Events
+	default:
+		return nil
+	}
+
+	var rv []*types.AttributeChangesResponse
+	for _, abciEvent := range events {
+		if abciEvent.Type != change.eventType {
+			continue
+		}
+		msg, err := sdk.ParseTypedEvent(abciEvent)
+		if err != nil {
+			continue
+		}
+		event, ok := msg.(attributeEvent)
+		if !ok || !f.matches(event) {
+			continue
+		}
+		resp := &types.AttributeChangesResponse{Height: height, TxHash: txHash}
+		change.toResponse(resp, event)
+		rv = append(rv, resp)
+	}
+	return rv
+}
@@ -0,0 +1,282 @@
+package subscription
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/provenance-io/provenance/x/attribute/types"
+)
+
+// fakeEventsClient is an EventsClient that records subscriptions so that tests can publish results to them.
+type fakeEventsClient struct {
+	mu           sync.Mutex
+	subs         map[string]chan coretypes.ResultEvent
+	subscriber   string
+	unsubscribed []string
+	subscribeErr error
+}
+
+var _ EventsClient = (*fakeEventsClient)(nil)
+
+func newFakeEventsClient() *fakeEventsClient {
+	return &fakeEventsClient{subs: make(map[string]chan coretypes.ResultEvent)}
+}
+
+func (c *fakeEventsClient) Subscribe(_ context.Context, subscriber, query string, outCapacity ...int) (<-chan coretypes.ResultEvent, error) {
+	if c.subscribeErr != nil {
+		return nil, c.subscribeErr
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.subscriber = subscriber
+	c.subs[query] = make(chan coretypes.ResultEvent, outCapacity[0])
+	return c.subs[query], nil
+}
+
+func (c *fakeEventsClient) UnsubscribeAll(_ context.Context, subscriber string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.unsubscribed = append(c.unsubscribed, subscriber)
+	return nil
+}
+
+func (c *fakeEventsClient) getSub(query string) chan coretypes.ResultEvent {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.subs[query]
+}
+
+func (c *fakeEventsClient) subCount() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.subs)
+}
+
+func (c *fakeEventsClient) getUnsubscribed() []string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.unsubscribed
+}
+
+// fakeStream is a Subscription_AttributeChangesServer that collects the responses sent to it.
+type fakeStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	sent chan *types.AttributeChangesResponse
+}
+
+func newFakeStream(ctx context.Context) *fakeStream {
+	return &fakeStream{ctx: ctx, sent: make(chan *types.AttributeChangesResponse, 10)}
+}
+
+func (s *fakeStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *fakeStream) Send(resp *types.AttributeChangesResponse) error {
+	s.sent <- resp
+	return nil
+}
+
+func TestAttributeChangesInvalid(t *testing.T) {
+	account := sdk.AccAddress("account_____________").String()
+
+	tests := []struct {
+		name   string
+		client EventsClient
+		req    *types.AttributeChangesRequest
+		expErr error
+	}{
+		{
+			name:   "no client",
+			client: nil,
+			req:    &types.AttributeChangesRequest{Account: account},
+			expErr: status.Error(codes.Unavailable, "event subscriptions are not available on this node"),
+		},
+		{
+			name:   "nil request",
+			client: newFakeEventsClient(),
+			req:    nil,
+			expErr: status.Error(codes.InvalidArgument, "empty request"),
+		},
+		{
+			name:   "no account or name",
+			client: newFakeEventsClient(),
+			req:    &types.AttributeChangesRequest{Account: " ", Name: " "},
+			expErr: status.Error(codes.InvalidArgument, "an account or name is required"),
+		},
+		{
+			name:   "invalid account",
+			client: newFakeEventsClient(),
+			req:    &types.AttributeChangesRequest{Account: "notanaddress"},
+			expErr: status.Error(codes.InvalidArgument, `invalid account: must be either an account address or scope metadata address: "notanaddress"`),
+		},
+		{
+			name:   "invalid name",
+			client: newFakeEventsClient(),
+			req:    &types.AttributeChangesRequest{Name: `kyc'.pb`},
+			expErr: status.Error(codes.InvalidArgument, `invalid name "kyc'.pb": illegal character "'" in name segment "kyc'"`),
+		},
+		{
+			name:   "subscribe fails",
+			client: &fakeEventsClient{subs: make(map[string]chan coretypes.ResultEvent), subscribeErr: errors.New("too many subscriptions")},
+			req:    &types.AttributeChangesRequest{Account: account},
+			expErr: status.Error(codes.Unavailable, "could not subscribe to provenance.attribute.v1.EventAttributeAdd events: too many subscriptions"),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := NewServer(tc.client).AttributeChanges(tc.req, newFakeStream(context.Background()))
+			assert.Equal(t, tc.expErr, err, "AttributeChanges error")
+		})
+	}
+}
+
+func TestFilterQuery(t *testing.T) {
+	account := sdk.AccAddress("account_____________").String()
+	add := changeEvents[0]
+	expired := changeEvents[len(changeEvents)-1]
+
+	tests := []struct {
+		name   string
+		filter filter
+		change changeEvent
+		exp    string
+	}{
+		{
+			name:   "account: tx event",
+			filter: filter{account: account},
+			change: add,
+			exp:    `tm.event = 'Tx' AND provenance.attribute.v1.EventAttributeAdd.account = '"` + account + `"'`,
+		},
+		{
+			name:   "name: tx event",
+			filter: filter{name: "kyc.pb"},
+			change: add,
+			exp:    `tm.event = 'Tx' AND provenance.attribute.v1.EventAttributeAdd.name = '"kyc.pb"'`,
+		},
+		{
+			name:   "account and name: block event",
+			filter: filter{account: account, name: "kyc.pb"},
+			change: expired,
+			exp: `tm.event = 'NewBlockEvents' AND provenance.attribute.v1.EventAttributeExpired.account = '"` + account + `"'` +
+				` AND provenance.attribute.v1.EventAttributeExpired.name = '"kyc.pb"'`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.exp, tc.filter.query(tc.change), "query")
+		})
+	}
+}
+
+func TestAttributeChanges(t *testing.T) {
+	account := sdk.AccAddress("account_____________").String()
+	other := sdk.AccAddress("other_______________").String()
+	toABCI := func(event proto.Message) abci.Event {
+		rv, err := sdk.TypedEventToEvent(event)
+		require.NoError(t, err, "TypedEventToEvent(%T)", event)
+		return abci.Event(rv)
+	}
+	addKYC := &types.EventAttributeAdd{Name: "kyc.pb", Value: "approved", Account: account, Owner: other}
+	addOther := &types.EventAttributeAdd{Name: "kyc.pb", Value: "approved", Account: other, Owner: other}
+	deleteKYC := &types.EventAttributeDelete{Name: "kyc.pb", Account: account, Owner: other}
+	expiredKYC := &types.EventAttributeExpired{Name: "kyc.pb", ValueHash: "abc", Account: account}
+	txBz := cmttypes.Tx("some tx bytes")
+	txResult := coretypes.ResultEvent{Data: cmttypes.EventDataTx{TxResult: abci.TxResult{
+		Height: 5,
+		Tx:     txBz,
+		Result: abci.ExecTxResult{Events: []abci.Event{
+			{Type: "message", Attributes: []abci.EventAttribute{{Key: "module", Value: "attribute"}}},
+			toABCI(addKYC), toABCI(addOther), toABCI(deleteKYC),
+		}},
+	}}}
+	blockResult := coretypes.ResultEvent{Data: cmttypes.EventDataNewBlockEvents{
+		Height: 6,
+		Events: []abci.Event{toABCI(expiredKYC)},
+	}}
+
+	client := newFakeEventsClient()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	stream := newFakeStream(ctx)
+	done := make(chan error, 1)
+	go func() {
+		done <- NewServer(client).AttributeChanges(&types.AttributeChangesRequest{Account: account}, stream)
+	}()
+	require.Eventually(t, func() bool { return client.subCount() == len(changeEvents) }, time.Second, time.Millisecond, "subscription count")
+
+	f := filter{account: account}
+	// The tx has an add and a delete in it, so it's published to both of those subscriptions.
+	client.getSub(f.query(changeEvents[0])) <- txResult
+	client.getSub(f.query(changeEvents[3])) <- txResult
+	client.getSub(f.query(changeEvents[5])) <- blockResult
+
+	txHash := fmt.Sprintf("%X", txBz.Hash())
+	exp := []*types.AttributeChangesResponse{
+		{Height: 5, TxHash: txHash, Event: &types.AttributeChangesResponse_Add{Add: addKYC}},
+		{Height: 5, TxHash: txHash, Event: &types.AttributeChangesResponse_Delete{Delete: deleteKYC}},
+		{Height: 6, TxHash: "", Event: &types.AttributeChangesResponse_Expired{Expired: expiredKYC}},
+	}
+	var actual []*types.AttributeChangesResponse
+	for len(actual) < len(exp) {
+		select {
+		case resp := <-stream.sent:
+			actual = append(actual, resp)
+		case <-time.After(time.Second):
+			require.FailNow(t, "timeout waiting for responses", "received %d of %d", len(actual), len(exp))
+		}
+	}
+	// The subscriptions are independent, so the order they're streamed in isn't guaranteed.
+	assert.ElementsMatch(t, exp, actual, "responses")
+
+	cancel()
+	select {
+	case err := <-done:
+		assert.NoError(t, err, "AttributeChanges error")
+	case <-time.After(time.Second):
+		require.FailNow(t, "timeout waiting for AttributeChanges to return")
+	}
+	assert.Equal(t, []string{client.subscriber}, client.getUnsubscribed(), "unsubscribed")
+	assert.Empty(t, stream.sent, "extra responses")
+}
+
+func TestAttributeChangesSubscriptionCancelled(t *testing.T) {
+	client := newFakeEventsClient()
+	stream := newFakeStream(context.Background())
+	req := &types.AttributeChangesRequest{Name: "kyc.pb"}
+	done := make(chan error, 1)
+	go func() {
+		done <- NewServer(client).AttributeChanges(req, stream)
+	}()
+	require.Eventually(t, func() bool { return client.subCount() == len(changeEvents) }, time.Second, time.Millisecond, "subscription count")
+
+	close(client.getSub(filter{name: "kyc.pb"}.query(changeEvents[1])))
+	select {
+	case err := <-done:
+		expErr := status.Error(codes.Unavailable, "subscription to provenance.attribute.v1.EventAttributeUpdate events was cancelled by the node")
+		assert.Equal(t, expErr, err, "AttributeChanges error")
+	case <-time.After(time.Second):
+		require.FailNow(t, "timeout waiting for AttributeChanges to return")
+	}
+	assert.Equal(t, []string{client.subscriber}, client.getUnsubscribed(), "unsubscribed")
+}
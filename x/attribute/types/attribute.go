@@ -3,11 +3,14 @@ package types
 import (
 	"crypto/sha256"
 	"encoding/base64"
+	"encoding/binary"
 	"encoding/json"
 	"errors"
 	fmt "fmt"
+	"math"
 	"math/big"
 	"net/url"
+	"strconv"
 	"strings"
 	time "time"
 
@@ -18,10 +21,13 @@ import (
 	metadatatypes "github.com/provenance-io/provenance/x/metadata/types"
 )
 
+// MaxBulkAttributeAccounts is the maximum number of accounts that can be provided in a BulkAttributes query.
+const MaxBulkAttributeAccounts = 100
+
 // NewAttribute creates a new instance of an Attribute
 func NewAttribute(name string, address string, attrType AttributeType, value []byte, expirationDate *time.Time) Attribute {
 	// Ensure string type values are trimmed.
-	if attrType != AttributeType_Bytes && attrType != AttributeType_Proto {
+	if !isBinaryValueType(attrType) {
 		trimmed := strings.TrimSpace(string(value))
 		value = []byte(trimmed)
 	}
@@ -130,6 +136,20 @@ func isValidValueForType(attrType AttributeType, value []byte) bool {
 		return true // Treat proto as just a special tag for bytes
 	case AttributeType_Bytes:
 		return true
+	case AttributeType_Int64, AttributeType_Timestamp:
+		return len(value) == 8
+	case AttributeType_Float64:
+		return isValidFloat64(value)
+	default:
+		return false
+	}
+}
+
+// isBinaryValueType returns true if values of the given type are bytes instead of strings.
+func isBinaryValueType(attrType AttributeType) bool {
+	switch attrType {
+	case AttributeType_Bytes, AttributeType_Proto, AttributeType_Int64, AttributeType_Float64, AttributeType_Timestamp:
+		return true
 	default:
 		return false
 	}
@@ -171,6 +191,98 @@ func isValidFloat(value []byte) bool {
 	return ok
 }
 
+// Ensure a byte array is an 8 byte float64 that is a number.
+func isValidFloat64(value []byte) bool {
+	if len(value) != 8 {
+		return false
+	}
+	return !math.IsNaN(math.Float64frombits(binary.BigEndian.Uint64(value)))
+}
+
+// IsRangeType returns true if attribute values of the given type can be looked up by range.
+func IsRangeType(attrType AttributeType) bool {
+	return attrType == AttributeType_Int64 || attrType == AttributeType_Float64 || attrType == AttributeType_Timestamp
+}
+
+// Int64Value returns the encoded attribute value of an INT64 attribute.
+func Int64Value(v int64) []byte {
+	return binary.BigEndian.AppendUint64(nil, uint64(v))
+}
+
+// Float64Value returns the encoded attribute value of a FLOAT64 attribute.
+func Float64Value(v float64) []byte {
+	return binary.BigEndian.AppendUint64(nil, math.Float64bits(v))
+}
+
+// TimestampValue returns the encoded attribute value of a TIMESTAMP attribute.
+func TimestampValue(t time.Time) []byte {
+	return Int64Value(t.UnixNano())
+}
+
+// ParseRangeTypeValue converts the string representation of an INT64, FLOAT64, or TIMESTAMP value into its
+// encoded attribute value. INT64 and FLOAT64 values are base-10 numbers, and TIMESTAMP values are RFC 3339 formatted.
+func ParseRangeTypeValue(attrType AttributeType, str string) ([]byte, error) {
+	str = strings.TrimSpace(str)
+	switch attrType {
+	case AttributeType_Int64:
+		v, err := strconv.ParseInt(str, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s value %q: %w", attrType, str, err)
+		}
+		return Int64Value(v), nil
+	case AttributeType_Float64:
+		v, err := strconv.ParseFloat(str, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s value %q: %w", attrType, str, err)
+		}
+		if math.IsNaN(v) {
+			return nil, fmt.Errorf("invalid %s value %q: must be a number", attrType, str)
+		}
+		return Float64Value(v), nil
+	case AttributeType_Timestamp:
+		t, err := time.Parse(time.RFC3339Nano, str)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s value %q: %w", attrType, str, err)
+		}
+		// UnixNano is undefined outside of these years, so make sure we're inside them.
+		if t.Year() < 1678 || t.Year() > 2261 {
+			return nil, fmt.Errorf("invalid %s value %q: year must be between 1678 and 2261", attrType, str)
+		}
+		return TimestampValue(t), nil
+	default:
+		return nil, fmt.Errorf("attribute type %s does not support range lookups", attrType)
+	}
+}
+
+// GetRangeLookupValue converts an INT64, FLOAT64, or TIMESTAMP attribute value into 8 bytes that
+// sort (byte-wise) in the same order as the values they represent.
+func GetRangeLookupValue(attrType AttributeType, value []byte) ([]byte, error) {
+	if !IsRangeType(attrType) {
+		return nil, fmt.Errorf("attribute type %s does not support range lookups", attrType)
+	}
+	if !isValidValueForType(attrType, value) {
+		return nil, fmt.Errorf("invalid attribute value for assigned type: %s", attrType)
+	}
+	bits := binary.BigEndian.Uint64(value)
+	switch attrType {
+	case AttributeType_Float64:
+		switch {
+		case math.Float64frombits(bits) == 0:
+			// Negative zero is the same as zero.
+			bits = 1 << 63
+		case bits&(1<<63) != 0:
+			// Negative numbers: flip all the bits so that larger magnitudes come first.
+			bits = ^bits
+		default:
+			bits |= 1 << 63
+		}
+	default:
+		// Flip the sign bit so that negative numbers come before positive ones.
+		bits ^= 1 << 63
+	}
+	return binary.BigEndian.AppendUint64(nil, bits), nil
+}
+
 // AttributeTypeFromString returns a AttributeType from a string. It returns an error
 // if the string is invalid.
 func AttributeTypeFromString(str string) (AttributeType, error) {
@@ -191,7 +303,10 @@ func ValidAttributeType(attributeType AttributeType) bool {
 		attributeType == AttributeType_Int ||
 		attributeType == AttributeType_Float ||
 		attributeType == AttributeType_Proto ||
-		attributeType == AttributeType_Bytes {
+		attributeType == AttributeType_Bytes ||
+		attributeType == AttributeType_Int64 ||
+		attributeType == AttributeType_Float64 ||
+		attributeType == AttributeType_Timestamp {
 		return true
 	}
 	return false
@@ -49,18 +49,28 @@ const (
 	AttributeType_Proto AttributeType = 7
 	// ATTRIBUTE_TYPE_BYTES defines an attribute value that contains an untyped array of bytes
 	AttributeType_Bytes AttributeType = 8
+	// ATTRIBUTE_TYPE_INT64 defines an attribute value that contains a signed 64-bit integer encoded as 8 big-endian bytes
+	AttributeType_Int64 AttributeType = 9
+	// ATTRIBUTE_TYPE_FLOAT64 defines an attribute value that contains an IEEE 754 64-bit float encoded as 8 big-endian bytes
+	AttributeType_Float64 AttributeType = 10
+	// ATTRIBUTE_TYPE_TIMESTAMP defines an attribute value that contains a point in time encoded as the number of
+	// nanoseconds since the unix epoch (signed 64-bit integer) in 8 big-endian bytes
+	AttributeType_Timestamp AttributeType = 11
 )
 
 var AttributeType_name = map[int32]string{
-	0: "ATTRIBUTE_TYPE_UNSPECIFIED",
-	1: "ATTRIBUTE_TYPE_UUID",
-	2: "ATTRIBUTE_TYPE_JSON",
-	3: "ATTRIBUTE_TYPE_STRING",
-	4: "ATTRIBUTE_TYPE_URI",
-	5: "ATTRIBUTE_TYPE_INT",
-	6: "ATTRIBUTE_TYPE_FLOAT",
-	7: "ATTRIBUTE_TYPE_PROTO",
-	8: "ATTRIBUTE_TYPE_BYTES",
+	0:  "ATTRIBUTE_TYPE_UNSPECIFIED",
+	1:  "ATTRIBUTE_TYPE_UUID",
+	2:  "ATTRIBUTE_TYPE_JSON",
+	3:  "ATTRIBUTE_TYPE_STRING",
+	4:  "ATTRIBUTE_TYPE_URI",
+	5:  "ATTRIBUTE_TYPE_INT",
+	6:  "ATTRIBUTE_TYPE_FLOAT",
+	7:  "ATTRIBUTE_TYPE_PROTO",
+	8:  "ATTRIBUTE_TYPE_BYTES",
+	9:  "ATTRIBUTE_TYPE_INT64",
+	10: "ATTRIBUTE_TYPE_FLOAT64",
+	11: "ATTRIBUTE_TYPE_TIMESTAMP",
 }
 
 var AttributeType_value = map[string]int32{
@@ -73,6 +83,9 @@ var AttributeType_value = map[string]int32{
 	"ATTRIBUTE_TYPE_FLOAT":       6,
 	"ATTRIBUTE_TYPE_PROTO":       7,
 	"ATTRIBUTE_TYPE_BYTES":       8,
+	"ATTRIBUTE_TYPE_INT64":       9,
+	"ATTRIBUTE_TYPE_FLOAT64":     10,
+	"ATTRIBUTE_TYPE_TIMESTAMP":   11,
 }
 
 func (x AttributeType) String() string {
@@ -83,10 +96,59 @@ func (AttributeType) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_14fe7eb43c711f5e, []int{0}
 }
 
+// AttributeHookMode defines how a failure of an attribute hook contract call is handled.
+type AttributeHookMode int32
+
+const (
+	// ATTRIBUTE_HOOK_MODE_UNSPECIFIED defines an unknown/invalid mode
+	AttributeHookMode_Unspecified AttributeHookMode = 0
+	// ATTRIBUTE_HOOK_MODE_NOTIFY defines a hook whose failures are ignored (i.e. the attribute is still added or deleted)
+	AttributeHookMode_Notify AttributeHookMode = 1
+	// ATTRIBUTE_HOOK_MODE_VETO defines a hook whose failures prevent the attribute from being added or deleted
+	AttributeHookMode_Veto AttributeHookMode = 2
+)
+
+var AttributeHookMode_name = map[int32]string{
+	0: "ATTRIBUTE_HOOK_MODE_UNSPECIFIED",
+	1: "ATTRIBUTE_HOOK_MODE_NOTIFY",
+	2: "ATTRIBUTE_HOOK_MODE_VETO",
+}
+
+var AttributeHookMode_value = map[string]int32{
+	"ATTRIBUTE_HOOK_MODE_UNSPECIFIED": 0,
+	"ATTRIBUTE_HOOK_MODE_NOTIFY":      1,
+	"ATTRIBUTE_HOOK_MODE_VETO":        2,
+}
+
+func (x AttributeHookMode) String() string {
+	return proto.EnumName(AttributeHookMode_name, int32(x))
+}
+
+func (AttributeHookMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_14fe7eb43c711f5e, []int{1}
+}
+
 // Params defines the set of params for the attribute module.
 type Params struct {
 	// maximum length of data to allow in an attribute value
 	MaxValueLength uint32 `protobuf:"varint,1,opt,name=max_value_length,json=maxValueLength,proto3" json:"max_value_length,omitempty"`
+	// the maximum number of results a single page of the AttributeAccounts query can return. Requests for more are
+	// truncated. Zero means no limit.
+	MaxQueryResults uint32 `protobuf:"varint,2,opt,name=max_query_results,json=maxQueryResults,proto3" json:"max_query_results,omitempty"`
+	// the maximum size (in bytes) of an AttributeAccounts query response. Larger responses are rejected.
+	// Zero means no limit.
+	MaxQueryResponseBytes uint64 `protobuf:"varint,3,opt,name=max_query_response_bytes,json=maxQueryResponseBytes,proto3" json:"max_query_response_bytes,omitempty"`
+	// the maximum number of distinct attribute names a single account can have. Zero means no limit.
+	MaxNamesPerAccount uint32 `protobuf:"varint,4,opt,name=max_names_per_account,json=maxNamesPerAccount,proto3" json:"max_names_per_account,omitempty"`
+	// the maximum number of values a single account can have for one attribute name. Zero means no limit.
+	MaxValuesPerName uint32 `protobuf:"varint,5,opt,name=max_values_per_name,json=maxValuesPerName,proto3" json:"max_values_per_name,omitempty"`
+	// the maximum amount of gas a single attribute hook contract call can use. Zero means attribute hooks are not called.
+	HookGasLimit uint64 `protobuf:"varint,6,opt,name=hook_gas_limit,json=hookGasLimit,proto3" json:"hook_gas_limit,omitempty"`
+	// whether the owner of an attribute name can revoke the attributes it issued from any account.
+	AllowIssuerRevocation bool `protobuf:"varint,7,opt,name=allow_issuer_revocation,json=allowIssuerRevocation,proto3" json:"allow_issuer_revocation,omitempty"`
+	// the number of blocks before an attribute expires that an EventAttributeExpiring is emitted for it.
+	// Zero means no expiring notices are emitted.
+	ExpirationNoticeBlocks uint32 `protobuf:"varint,8,opt,name=expiration_notice_blocks,json=expirationNoticeBlocks,proto3" json:"expiration_notice_blocks,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -129,6 +191,55 @@ func (m *Params) GetMaxValueLength() uint32 {
 	return 0
 }
 
+func (m *Params) GetMaxQueryResults() uint32 {
+	if m != nil {
+		return m.MaxQueryResults
+	}
+	return 0
+}
+
+func (m *Params) GetMaxQueryResponseBytes() uint64 {
+	if m != nil {
+		return m.MaxQueryResponseBytes
+	}
+	return 0
+}
+
+func (m *Params) GetMaxNamesPerAccount() uint32 {
+	if m != nil {
+		return m.MaxNamesPerAccount
+	}
+	return 0
+}
+
+func (m *Params) GetMaxValuesPerName() uint32 {
+	if m != nil {
+		return m.MaxValuesPerName
+	}
+	return 0
+}
+
+func (m *Params) GetHookGasLimit() uint64 {
+	if m != nil {
+		return m.HookGasLimit
+	}
+	return 0
+}
+
+func (m *Params) GetAllowIssuerRevocation() bool {
+	if m != nil {
+		return m.AllowIssuerRevocation
+	}
+	return false
+}
+
+func (m *Params) GetExpirationNoticeBlocks() uint32 {
+	if m != nil {
+		return m.ExpirationNoticeBlocks
+	}
+	return 0
+}
+
 // Attribute holds a typed key/value structure for data associated with an account
 type Attribute struct {
 	// The attribute name.
@@ -141,6 +252,9 @@ type Attribute struct {
 	Address string `protobuf:"bytes,4,opt,name=address,proto3" json:"address,omitempty"`
 	// Time that an attribute will expire.
 	ExpirationDate *time.Time `protobuf:"bytes,5,opt,name=expiration_date,json=expirationDate,proto3,stdtime" json:"expiration_date,omitempty"`
+	// Where the attribute came from. This is set by the chain when the attribute is added or updated;
+	// any value provided by a client is ignored. It is empty for attributes that were added before it was recorded.
+	Origin *AttributeOrigin `protobuf:"bytes,6,opt,name=origin,proto3" json:"origin,omitempty"`
 }
 
 func (m *Attribute) Reset()      { *m = Attribute{} }
@@ -210,6 +324,142 @@ func (m *Attribute) GetExpirationDate() *time.Time {
 	return nil
 }
 
+func (m *Attribute) GetOrigin() *AttributeOrigin {
+	if m != nil {
+		return m.Origin
+	}
+	return nil
+}
+
+// AttributeOrigin records who set an attribute and the transaction that set it.
+type AttributeOrigin struct {
+	// setter is the bech32 address of the account that added or last updated the attribute, i.e. the owner of its name.
+	Setter string `protobuf:"bytes,1,opt,name=setter,proto3" json:"setter,omitempty"`
+	// tx_hash is the hex-encoded hash of the transaction that set the attribute.
+	// It is empty if the attribute was not set in a transaction (e.g. during an upgrade).
+	TxHash string `protobuf:"bytes,2,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	// block_height is the height of the block in which the attribute was set.
+	BlockHeight int64 `protobuf:"varint,3,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+}
+
+func (m *AttributeOrigin) Reset()         { *m = AttributeOrigin{} }
+func (m *AttributeOrigin) String() string { return proto.CompactTextString(m) }
+func (*AttributeOrigin) ProtoMessage()    {}
+func (*AttributeOrigin) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14fe7eb43c711f5e, []int{2}
+}
+func (m *AttributeOrigin) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AttributeOrigin) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AttributeOrigin.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AttributeOrigin) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AttributeOrigin.Merge(m, src)
+}
+func (m *AttributeOrigin) XXX_Size() int {
+	return m.Size()
+}
+func (m *AttributeOrigin) XXX_DiscardUnknown() {
+	xxx_messageInfo_AttributeOrigin.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AttributeOrigin proto.InternalMessageInfo
+
+func (m *AttributeOrigin) GetSetter() string {
+	if m != nil {
+		return m.Setter
+	}
+	return ""
+}
+
+func (m *AttributeOrigin) GetTxHash() string {
+	if m != nil {
+		return m.TxHash
+	}
+	return ""
+}
+
+func (m *AttributeOrigin) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+// AttributeHook is a wasm contract that is called whenever an attribute with a given name is added or deleted.
+type AttributeHook struct {
+	// name is the attribute name that the hook is registered for.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// contract is the bech32 address of the wasm contract to call.
+	Contract string `protobuf:"bytes,2,opt,name=contract,proto3" json:"contract,omitempty"`
+	// mode defines how a failure of the contract call is handled.
+	Mode AttributeHookMode `protobuf:"varint,3,opt,name=mode,proto3,enum=provenance.attribute.v1.AttributeHookMode" json:"mode,omitempty"`
+}
+
+func (m *AttributeHook) Reset()         { *m = AttributeHook{} }
+func (m *AttributeHook) String() string { return proto.CompactTextString(m) }
+func (*AttributeHook) ProtoMessage()    {}
+func (*AttributeHook) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14fe7eb43c711f5e, []int{3}
+}
+func (m *AttributeHook) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AttributeHook) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AttributeHook.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AttributeHook) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AttributeHook.Merge(m, src)
+}
+func (m *AttributeHook) XXX_Size() int {
+	return m.Size()
+}
+func (m *AttributeHook) XXX_DiscardUnknown() {
+	xxx_messageInfo_AttributeHook.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AttributeHook proto.InternalMessageInfo
+
+func (m *AttributeHook) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *AttributeHook) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+func (m *AttributeHook) GetMode() AttributeHookMode {
+	if m != nil {
+		return m.Mode
+	}
+	return AttributeHookMode_Unspecified
+}
+
 // EventAttributeAdd event emitted when attribute is added
 type EventAttributeAdd struct {
 	Name       string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
@@ -224,7 +474,7 @@ func (m *EventAttributeAdd) Reset()         { *m = EventAttributeAdd{} }
 func (m *EventAttributeAdd) String() string { return proto.CompactTextString(m) }
 func (*EventAttributeAdd) ProtoMessage()    {}
 func (*EventAttributeAdd) Descriptor() ([]byte, []int) {
-	return fileDescriptor_14fe7eb43c711f5e, []int{2}
+	return fileDescriptor_14fe7eb43c711f5e, []int{4}
 }
 func (m *EventAttributeAdd) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -310,7 +560,7 @@ func (m *EventAttributeUpdate) Reset()         { *m = EventAttributeUpdate{} }
 func (m *EventAttributeUpdate) String() string { return proto.CompactTextString(m) }
 func (*EventAttributeUpdate) ProtoMessage()    {}
 func (*EventAttributeUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_14fe7eb43c711f5e, []int{3}
+	return fileDescriptor_14fe7eb43c711f5e, []int{5}
 }
 func (m *EventAttributeUpdate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -402,7 +652,7 @@ func (m *EventAttributeExpirationUpdate) Reset()         { *m = EventAttributeEx
 func (m *EventAttributeExpirationUpdate) String() string { return proto.CompactTextString(m) }
 func (*EventAttributeExpirationUpdate) ProtoMessage()    {}
 func (*EventAttributeExpirationUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_14fe7eb43c711f5e, []int{4}
+	return fileDescriptor_14fe7eb43c711f5e, []int{6}
 }
 func (m *EventAttributeExpirationUpdate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -484,7 +734,7 @@ func (m *EventAttributeDelete) Reset()         { *m = EventAttributeDelete{} }
 func (m *EventAttributeDelete) String() string { return proto.CompactTextString(m) }
 func (*EventAttributeDelete) ProtoMessage()    {}
 func (*EventAttributeDelete) Descriptor() ([]byte, []int) {
-	return fileDescriptor_14fe7eb43c711f5e, []int{5}
+	return fileDescriptor_14fe7eb43c711f5e, []int{7}
 }
 func (m *EventAttributeDelete) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -547,7 +797,7 @@ func (m *EventAttributeDistinctDelete) Reset()         { *m = EventAttributeDist
 func (m *EventAttributeDistinctDelete) String() string { return proto.CompactTextString(m) }
 func (*EventAttributeDistinctDelete) ProtoMessage()    {}
 func (*EventAttributeDistinctDelete) Descriptor() ([]byte, []int) {
-	return fileDescriptor_14fe7eb43c711f5e, []int{6}
+	return fileDescriptor_14fe7eb43c711f5e, []int{8}
 }
 func (m *EventAttributeDistinctDelete) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -611,6 +861,91 @@ func (m *EventAttributeDistinctDelete) GetOwner() string {
 	return ""
 }
 
+// EventAttributeRevoked event emitted when an attribute is revoked by the owner of its name.
+type EventAttributeRevoked struct {
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	ValueHash     string `protobuf:"bytes,2,opt,name=value_hash,json=valueHash,proto3" json:"value_hash,omitempty"`
+	AttributeType string `protobuf:"bytes,3,opt,name=attribute_type,json=attributeType,proto3" json:"attribute_type,omitempty"`
+	Account       string `protobuf:"bytes,4,opt,name=account,proto3" json:"account,omitempty"`
+	Issuer        string `protobuf:"bytes,5,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	Reason        string `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *EventAttributeRevoked) Reset()         { *m = EventAttributeRevoked{} }
+func (m *EventAttributeRevoked) String() string { return proto.CompactTextString(m) }
+func (*EventAttributeRevoked) ProtoMessage()    {}
+func (*EventAttributeRevoked) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14fe7eb43c711f5e, []int{9}
+}
+func (m *EventAttributeRevoked) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventAttributeRevoked) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventAttributeRevoked.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventAttributeRevoked) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventAttributeRevoked.Merge(m, src)
+}
+func (m *EventAttributeRevoked) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventAttributeRevoked) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventAttributeRevoked.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventAttributeRevoked proto.InternalMessageInfo
+
+func (m *EventAttributeRevoked) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *EventAttributeRevoked) GetValueHash() string {
+	if m != nil {
+		return m.ValueHash
+	}
+	return ""
+}
+
+func (m *EventAttributeRevoked) GetAttributeType() string {
+	if m != nil {
+		return m.AttributeType
+	}
+	return ""
+}
+
+func (m *EventAttributeRevoked) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+func (m *EventAttributeRevoked) GetIssuer() string {
+	if m != nil {
+		return m.Issuer
+	}
+	return ""
+}
+
+func (m *EventAttributeRevoked) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
 // EventAttributeExpired event emitted when attribute has expired and been deleted in BeginBlocker
 type EventAttributeExpired struct {
 	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
@@ -624,7 +959,7 @@ func (m *EventAttributeExpired) Reset()         { *m = EventAttributeExpired{} }
 func (m *EventAttributeExpired) String() string { return proto.CompactTextString(m) }
 func (*EventAttributeExpired) ProtoMessage()    {}
 func (*EventAttributeExpired) Descriptor() ([]byte, []int) {
-	return fileDescriptor_14fe7eb43c711f5e, []int{7}
+	return fileDescriptor_14fe7eb43c711f5e, []int{10}
 }
 func (m *EventAttributeExpired) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -688,6 +1023,84 @@ func (m *EventAttributeExpired) GetExpiration() string {
 	return ""
 }
 
+// EventAttributeExpiring event emitted in BeginBlocker when an attribute will expire in about expiration_notice_blocks
+// blocks, so that it can be renewed before it is deleted.
+type EventAttributeExpiring struct {
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	ValueHash     string `protobuf:"bytes,2,opt,name=value_hash,json=valueHash,proto3" json:"value_hash,omitempty"`
+	AttributeType string `protobuf:"bytes,3,opt,name=attribute_type,json=attributeType,proto3" json:"attribute_type,omitempty"`
+	Account       string `protobuf:"bytes,4,opt,name=account,proto3" json:"account,omitempty"`
+	Expiration    string `protobuf:"bytes,5,opt,name=expiration,proto3" json:"expiration,omitempty"`
+}
+
+func (m *EventAttributeExpiring) Reset()         { *m = EventAttributeExpiring{} }
+func (m *EventAttributeExpiring) String() string { return proto.CompactTextString(m) }
+func (*EventAttributeExpiring) ProtoMessage()    {}
+func (*EventAttributeExpiring) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14fe7eb43c711f5e, []int{11}
+}
+func (m *EventAttributeExpiring) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventAttributeExpiring) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventAttributeExpiring.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventAttributeExpiring) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventAttributeExpiring.Merge(m, src)
+}
+func (m *EventAttributeExpiring) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventAttributeExpiring) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventAttributeExpiring.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventAttributeExpiring proto.InternalMessageInfo
+
+func (m *EventAttributeExpiring) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *EventAttributeExpiring) GetValueHash() string {
+	if m != nil {
+		return m.ValueHash
+	}
+	return ""
+}
+
+func (m *EventAttributeExpiring) GetAttributeType() string {
+	if m != nil {
+		return m.AttributeType
+	}
+	return ""
+}
+
+func (m *EventAttributeExpiring) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+func (m *EventAttributeExpiring) GetExpiration() string {
+	if m != nil {
+		return m.Expiration
+	}
+	return ""
+}
+
 // EventAccountDataUpdated event emitted when accountdata is set, updated, or deleted.
 type EventAccountDataUpdated struct {
 	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
@@ -697,7 +1110,7 @@ func (m *EventAccountDataUpdated) Reset()         { *m = EventAccountDataUpdated
 func (m *EventAccountDataUpdated) String() string { return proto.CompactTextString(m) }
 func (*EventAccountDataUpdated) ProtoMessage()    {}
 func (*EventAccountDataUpdated) Descriptor() ([]byte, []int) {
-	return fileDescriptor_14fe7eb43c711f5e, []int{8}
+	return fileDescriptor_14fe7eb43c711f5e, []int{12}
 }
 func (m *EventAccountDataUpdated) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -733,16 +1146,137 @@ func (m *EventAccountDataUpdated) GetAccount() string {
 	return ""
 }
 
-// EventAttributeParamsUpdated event emitted when attribute params are updated.
+// EventAttributeUnlistedUpdated event emitted when an account marks one of its attribute names as unlisted or listed.
+type EventAttributeUnlistedUpdated struct {
+	Account  string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	Name     string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Unlisted string `protobuf:"bytes,3,opt,name=unlisted,proto3" json:"unlisted,omitempty"`
+}
+
+func (m *EventAttributeUnlistedUpdated) Reset()         { *m = EventAttributeUnlistedUpdated{} }
+func (m *EventAttributeUnlistedUpdated) String() string { return proto.CompactTextString(m) }
+func (*EventAttributeUnlistedUpdated) ProtoMessage()    {}
+func (*EventAttributeUnlistedUpdated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14fe7eb43c711f5e, []int{13}
+}
+func (m *EventAttributeUnlistedUpdated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventAttributeUnlistedUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventAttributeUnlistedUpdated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventAttributeUnlistedUpdated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventAttributeUnlistedUpdated.Merge(m, src)
+}
+func (m *EventAttributeUnlistedUpdated) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventAttributeUnlistedUpdated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventAttributeUnlistedUpdated.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventAttributeUnlistedUpdated proto.InternalMessageInfo
+
+func (m *EventAttributeUnlistedUpdated) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+func (m *EventAttributeUnlistedUpdated) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *EventAttributeUnlistedUpdated) GetUnlisted() string {
+	if m != nil {
+		return m.Unlisted
+	}
+	return ""
+}
+
+// EventAccountAttributesPurged event emitted when all attributes of an account that does not exist are removed.
+type EventAccountAttributesPurged struct {
+	Account        string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	AttributeCount string `protobuf:"bytes,2,opt,name=attribute_count,json=attributeCount,proto3" json:"attribute_count,omitempty"`
+}
+
+func (m *EventAccountAttributesPurged) Reset()         { *m = EventAccountAttributesPurged{} }
+func (m *EventAccountAttributesPurged) String() string { return proto.CompactTextString(m) }
+func (*EventAccountAttributesPurged) ProtoMessage()    {}
+func (*EventAccountAttributesPurged) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14fe7eb43c711f5e, []int{14}
+}
+func (m *EventAccountAttributesPurged) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventAccountAttributesPurged) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventAccountAttributesPurged.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventAccountAttributesPurged) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventAccountAttributesPurged.Merge(m, src)
+}
+func (m *EventAccountAttributesPurged) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventAccountAttributesPurged) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventAccountAttributesPurged.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventAccountAttributesPurged proto.InternalMessageInfo
+
+func (m *EventAccountAttributesPurged) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+func (m *EventAccountAttributesPurged) GetAttributeCount() string {
+	if m != nil {
+		return m.AttributeCount
+	}
+	return ""
+}
+
+// EventAttributeParamsUpdated event emitted when attribute params are updated.
 type EventAttributeParamsUpdated struct {
-	MaxValueLength string `protobuf:"bytes,1,opt,name=max_value_length,json=maxValueLength,proto3" json:"max_value_length,omitempty"`
+	MaxValueLength         string `protobuf:"bytes,1,opt,name=max_value_length,json=maxValueLength,proto3" json:"max_value_length,omitempty"`
+	MaxQueryResults        string `protobuf:"bytes,2,opt,name=max_query_results,json=maxQueryResults,proto3" json:"max_query_results,omitempty"`
+	MaxQueryResponseBytes  string `protobuf:"bytes,3,opt,name=max_query_response_bytes,json=maxQueryResponseBytes,proto3" json:"max_query_response_bytes,omitempty"`
+	MaxNamesPerAccount     string `protobuf:"bytes,4,opt,name=max_names_per_account,json=maxNamesPerAccount,proto3" json:"max_names_per_account,omitempty"`
+	MaxValuesPerName       string `protobuf:"bytes,5,opt,name=max_values_per_name,json=maxValuesPerName,proto3" json:"max_values_per_name,omitempty"`
+	HookGasLimit           string `protobuf:"bytes,6,opt,name=hook_gas_limit,json=hookGasLimit,proto3" json:"hook_gas_limit,omitempty"`
+	AllowIssuerRevocation  string `protobuf:"bytes,7,opt,name=allow_issuer_revocation,json=allowIssuerRevocation,proto3" json:"allow_issuer_revocation,omitempty"`
+	ExpirationNoticeBlocks string `protobuf:"bytes,8,opt,name=expiration_notice_blocks,json=expirationNoticeBlocks,proto3" json:"expiration_notice_blocks,omitempty"`
 }
 
 func (m *EventAttributeParamsUpdated) Reset()         { *m = EventAttributeParamsUpdated{} }
 func (m *EventAttributeParamsUpdated) String() string { return proto.CompactTextString(m) }
 func (*EventAttributeParamsUpdated) ProtoMessage()    {}
 func (*EventAttributeParamsUpdated) Descriptor() ([]byte, []int) {
-	return fileDescriptor_14fe7eb43c711f5e, []int{9}
+	return fileDescriptor_14fe7eb43c711f5e, []int{15}
 }
 func (m *EventAttributeParamsUpdated) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -778,18 +1312,222 @@ func (m *EventAttributeParamsUpdated) GetMaxValueLength() string {
 	return ""
 }
 
+func (m *EventAttributeParamsUpdated) GetMaxQueryResults() string {
+	if m != nil {
+		return m.MaxQueryResults
+	}
+	return ""
+}
+
+func (m *EventAttributeParamsUpdated) GetMaxQueryResponseBytes() string {
+	if m != nil {
+		return m.MaxQueryResponseBytes
+	}
+	return ""
+}
+
+func (m *EventAttributeParamsUpdated) GetMaxNamesPerAccount() string {
+	if m != nil {
+		return m.MaxNamesPerAccount
+	}
+	return ""
+}
+
+func (m *EventAttributeParamsUpdated) GetMaxValuesPerName() string {
+	if m != nil {
+		return m.MaxValuesPerName
+	}
+	return ""
+}
+
+func (m *EventAttributeParamsUpdated) GetHookGasLimit() string {
+	if m != nil {
+		return m.HookGasLimit
+	}
+	return ""
+}
+
+func (m *EventAttributeParamsUpdated) GetAllowIssuerRevocation() string {
+	if m != nil {
+		return m.AllowIssuerRevocation
+	}
+	return ""
+}
+
+func (m *EventAttributeParamsUpdated) GetExpirationNoticeBlocks() string {
+	if m != nil {
+		return m.ExpirationNoticeBlocks
+	}
+	return ""
+}
+
+// EventAttributeHookUpdated event emitted when an attribute hook is registered, changed, or removed.
+type EventAttributeHookUpdated struct {
+	Name     string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Contract string `protobuf:"bytes,2,opt,name=contract,proto3" json:"contract,omitempty"`
+	Mode     string `protobuf:"bytes,3,opt,name=mode,proto3" json:"mode,omitempty"`
+	Owner    string `protobuf:"bytes,4,opt,name=owner,proto3" json:"owner,omitempty"`
+}
+
+func (m *EventAttributeHookUpdated) Reset()         { *m = EventAttributeHookUpdated{} }
+func (m *EventAttributeHookUpdated) String() string { return proto.CompactTextString(m) }
+func (*EventAttributeHookUpdated) ProtoMessage()    {}
+func (*EventAttributeHookUpdated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14fe7eb43c711f5e, []int{16}
+}
+func (m *EventAttributeHookUpdated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventAttributeHookUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventAttributeHookUpdated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventAttributeHookUpdated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventAttributeHookUpdated.Merge(m, src)
+}
+func (m *EventAttributeHookUpdated) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventAttributeHookUpdated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventAttributeHookUpdated.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventAttributeHookUpdated proto.InternalMessageInfo
+
+func (m *EventAttributeHookUpdated) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *EventAttributeHookUpdated) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+func (m *EventAttributeHookUpdated) GetMode() string {
+	if m != nil {
+		return m.Mode
+	}
+	return ""
+}
+
+func (m *EventAttributeHookUpdated) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+// EventAttributeHookFailed event emitted when a notify mode attribute hook contract call fails.
+type EventAttributeHookFailed struct {
+	Name     string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Contract string `protobuf:"bytes,2,opt,name=contract,proto3" json:"contract,omitempty"`
+	Action   string `protobuf:"bytes,3,opt,name=action,proto3" json:"action,omitempty"`
+	Account  string `protobuf:"bytes,4,opt,name=account,proto3" json:"account,omitempty"`
+	Error    string `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *EventAttributeHookFailed) Reset()         { *m = EventAttributeHookFailed{} }
+func (m *EventAttributeHookFailed) String() string { return proto.CompactTextString(m) }
+func (*EventAttributeHookFailed) ProtoMessage()    {}
+func (*EventAttributeHookFailed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14fe7eb43c711f5e, []int{17}
+}
+func (m *EventAttributeHookFailed) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventAttributeHookFailed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventAttributeHookFailed.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventAttributeHookFailed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventAttributeHookFailed.Merge(m, src)
+}
+func (m *EventAttributeHookFailed) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventAttributeHookFailed) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventAttributeHookFailed.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventAttributeHookFailed proto.InternalMessageInfo
+
+func (m *EventAttributeHookFailed) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *EventAttributeHookFailed) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+func (m *EventAttributeHookFailed) GetAction() string {
+	if m != nil {
+		return m.Action
+	}
+	return ""
+}
+
+func (m *EventAttributeHookFailed) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+func (m *EventAttributeHookFailed) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterEnum("provenance.attribute.v1.AttributeType", AttributeType_name, AttributeType_value)
+	proto.RegisterEnum("provenance.attribute.v1.AttributeHookMode", AttributeHookMode_name, AttributeHookMode_value)
 	proto.RegisterType((*Params)(nil), "provenance.attribute.v1.Params")
 	proto.RegisterType((*Attribute)(nil), "provenance.attribute.v1.Attribute")
+	proto.RegisterType((*AttributeOrigin)(nil), "provenance.attribute.v1.AttributeOrigin")
+	proto.RegisterType((*AttributeHook)(nil), "provenance.attribute.v1.AttributeHook")
 	proto.RegisterType((*EventAttributeAdd)(nil), "provenance.attribute.v1.EventAttributeAdd")
 	proto.RegisterType((*EventAttributeUpdate)(nil), "provenance.attribute.v1.EventAttributeUpdate")
 	proto.RegisterType((*EventAttributeExpirationUpdate)(nil), "provenance.attribute.v1.EventAttributeExpirationUpdate")
 	proto.RegisterType((*EventAttributeDelete)(nil), "provenance.attribute.v1.EventAttributeDelete")
 	proto.RegisterType((*EventAttributeDistinctDelete)(nil), "provenance.attribute.v1.EventAttributeDistinctDelete")
+	proto.RegisterType((*EventAttributeRevoked)(nil), "provenance.attribute.v1.EventAttributeRevoked")
 	proto.RegisterType((*EventAttributeExpired)(nil), "provenance.attribute.v1.EventAttributeExpired")
+	proto.RegisterType((*EventAttributeExpiring)(nil), "provenance.attribute.v1.EventAttributeExpiring")
 	proto.RegisterType((*EventAccountDataUpdated)(nil), "provenance.attribute.v1.EventAccountDataUpdated")
+	proto.RegisterType((*EventAttributeUnlistedUpdated)(nil), "provenance.attribute.v1.EventAttributeUnlistedUpdated")
+	proto.RegisterType((*EventAccountAttributesPurged)(nil), "provenance.attribute.v1.EventAccountAttributesPurged")
 	proto.RegisterType((*EventAttributeParamsUpdated)(nil), "provenance.attribute.v1.EventAttributeParamsUpdated")
+	proto.RegisterType((*EventAttributeHookUpdated)(nil), "provenance.attribute.v1.EventAttributeHookUpdated")
+	proto.RegisterType((*EventAttributeHookFailed)(nil), "provenance.attribute.v1.EventAttributeHookFailed")
 }
 
 func init() {
@@ -797,61 +1535,97 @@ func init() {
 }
 
 var fileDescriptor_14fe7eb43c711f5e = []byte{
-	// 853 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0xcd, 0x6e, 0xdb, 0x46,
-	0x10, 0xd6, 0x5a, 0x3f, 0x36, 0xc7, 0xb6, 0xc2, 0x6c, 0x1c, 0x44, 0x60, 0x5b, 0x89, 0x51, 0xe0,
-	0x56, 0x28, 0x10, 0x11, 0x71, 0xd0, 0x4b, 0x6f, 0x56, 0x25, 0xa7, 0x2a, 0x12, 0x5b, 0xa0, 0xa8,
-	0x02, 0xc9, 0x85, 0x58, 0x4b, 0x1b, 0x89, 0x80, 0xf8, 0x03, 0x72, 0xa5, 0xda, 0xaf, 0xa0, 0x53,
-	0x8e, 0xbd, 0x08, 0x6d, 0xcf, 0x7d, 0x91, 0x1c, 0x73, 0x2c, 0x7a, 0x48, 0x0b, 0xfb, 0xd6, 0x6b,
-	0x5f, 0xa0, 0xd0, 0xae, 0xf9, 0x23, 0x9a, 0x72, 0x51, 0xf4, 0xb6, 0x33, 0xfb, 0xed, 0xcc, 0xf7,
-	0xcd, 0xec, 0xce, 0xc2, 0x17, 0x9e, 0xef, 0xce, 0xa9, 0x43, 0x9c, 0x21, 0xd5, 0x08, 0x63, 0xbe,
-	0x75, 0x3e, 0x63, 0x54, 0x9b, 0x3f, 0x8b, 0x8d, 0xa6, 0xe7, 0xbb, 0xcc, 0xc5, 0x8f, 0x62, 0x60,
-	0x33, 0xde, 0x9b, 0x3f, 0x53, 0x0e, 0xc6, 0xee, 0xd8, 0xe5, 0x18, 0x6d, 0xb5, 0x12, 0x70, 0xa5,
-	0x36, 0x76, 0xdd, 0xf1, 0x94, 0x6a, 0xdc, 0x3a, 0x9f, 0xbd, 0xd5, 0x98, 0x65, 0xd3, 0x80, 0x11,
-	0xdb, 0x13, 0x80, 0xfa, 0x11, 0x94, 0x7a, 0xc4, 0x27, 0x76, 0x80, 0x1b, 0x20, 0xdb, 0xe4, 0xc2,
-	0x9c, 0x93, 0xe9, 0x8c, 0x9a, 0x53, 0xea, 0x8c, 0xd9, 0xa4, 0x82, 0x54, 0xd4, 0xd8, 0xd7, 0xcb,
-	0x36, 0xb9, 0xf8, 0x7e, 0xe5, 0x7e, 0xc9, 0xbd, 0xf5, 0xbf, 0x11, 0x48, 0xc7, 0x61, 0x6e, 0x8c,
-	0xa1, 0xe0, 0x10, 0x9b, 0x72, 0xac, 0xa4, 0xf3, 0x35, 0x3e, 0x80, 0x22, 0x8f, 0x53, 0xd9, 0x52,
-	0x51, 0x63, 0x4f, 0x17, 0x06, 0x7e, 0x05, 0xe5, 0x88, 0xb2, 0xc9, 0x2e, 0x3d, 0x5a, 0xc9, 0xab,
-	0xa8, 0x51, 0x3e, 0xfa, 0xbc, 0xb9, 0x41, 0x54, 0x33, 0xca, 0x62, 0x5c, 0x7a, 0x54, 0xdf, 0x27,
-	0x49, 0x13, 0x57, 0x60, 0x9b, 0x8c, 0x46, 0x3e, 0x0d, 0x82, 0x4a, 0x81, 0xe7, 0x0e, 0x4d, 0xfc,
-	0x0a, 0xee, 0xd1, 0x0b, 0xcf, 0xf2, 0x09, 0xb3, 0x5c, 0xc7, 0x1c, 0x11, 0x46, 0x2b, 0x45, 0x15,
-	0x35, 0x76, 0x8f, 0x94, 0xa6, 0xa8, 0x47, 0x33, 0xac, 0x47, 0xd3, 0x08, 0xeb, 0xd1, 0xda, 0x79,
-	0xff, 0xb1, 0x86, 0xde, 0xfd, 0x51, 0x43, 0x7a, 0x39, 0x3e, 0xdc, 0x26, 0x8c, 0x7e, 0x5d, 0xf8,
-	0xf1, 0xe7, 0x5a, 0xae, 0xfe, 0x0b, 0x82, 0xfb, 0x9d, 0x39, 0x75, 0x58, 0x44, 0xea, 0x78, 0x34,
-	0xfa, 0x77, 0xf5, 0x52, 0xa8, 0x1e, 0x43, 0x21, 0xd2, 0x2c, 0xe9, 0x7c, 0xcd, 0x25, 0x0c, 0x87,
-	0xee, 0xcc, 0x61, 0x91, 0x04, 0x61, 0xae, 0x62, 0xb8, 0x3f, 0x38, 0xd4, 0xe7, 0xc4, 0x25, 0x5d,
-	0x18, 0xb8, 0x0a, 0x10, 0x73, 0xab, 0x94, 0xf8, 0x56, 0xc2, 0x53, 0xff, 0x0b, 0xc1, 0xc1, 0x3a,
-	0xc7, 0x81, 0xb7, 0x92, 0x9f, 0x49, 0xf3, 0x10, 0xca, 0xae, 0x6f, 0x8d, 0x2d, 0x87, 0x4c, 0xcd,
-	0x24, 0xdf, 0xfd, 0xd0, 0xcb, 0x7b, 0x8e, 0x9f, 0x40, 0xe4, 0x30, 0x13, 0x02, 0xf6, 0x42, 0x27,
-	0xef, 0xc5, 0x63, 0xd8, 0x9b, 0xf1, 0x4c, 0x37, 0x91, 0x84, 0x9a, 0x5d, 0xe1, 0x13, 0x71, 0x6a,
-	0x70, 0x63, 0x8a, 0x28, 0x42, 0x17, 0x08, 0x97, 0x91, 0x2a, 0x46, 0x69, 0x43, 0x31, 0xb6, 0x13,
-	0xc5, 0xa8, 0xff, 0x8e, 0xa0, 0xba, 0x2e, 0xb6, 0x13, 0x55, 0xe2, 0x0e, 0xd9, 0xd9, 0xdd, 0x49,
-	0x24, 0xcf, 0x6f, 0x48, 0x5e, 0x48, 0x76, 0x42, 0x83, 0x07, 0x51, 0x55, 0x12, 0x2d, 0x11, 0xaa,
-	0x70, 0xb8, 0x15, 0x13, 0xc2, 0x4f, 0x01, 0x0b, 0xad, 0x23, 0xf3, 0x56, 0x0b, 0xef, 0xdf, 0xec,
-	0xc4, 0xf0, 0xfa, 0x9b, 0x74, 0x23, 0xdb, 0x74, 0x4a, 0x37, 0x28, 0x4a, 0x70, 0xdf, 0xda, 0xc0,
-	0x3d, 0x9f, 0x2c, 0xdc, 0x4f, 0x08, 0x3e, 0x4d, 0x05, 0xb7, 0x02, 0x66, 0x39, 0x43, 0x76, 0x47,
-	0x92, 0xec, 0xb2, 0x1d, 0x66, 0x3e, 0x69, 0x29, 0xeb, 0xa9, 0xfe, 0x87, 0x7b, 0x5e, 0xff, 0x15,
-	0xc1, 0xc3, 0x8c, 0xd6, 0xd2, 0xec, 0xf7, 0xf6, 0x19, 0x80, 0x98, 0x5a, 0x13, 0x12, 0x4c, 0x6e,
-	0xf8, 0x49, 0xdc, 0xf3, 0x2d, 0x09, 0x26, 0xff, 0x9f, 0xe3, 0xfa, 0xab, 0x2b, 0xde, 0x7a, 0x75,
-	0xcf, 0xe1, 0x91, 0x20, 0x2b, 0xf0, 0x6d, 0xc2, 0x88, 0xb8, 0x7f, 0xa3, 0x64, 0x50, 0xb4, 0x16,
-	0xb4, 0xfe, 0x02, 0x3e, 0x59, 0x57, 0x28, 0xc6, 0x70, 0x78, 0x70, 0xd3, 0x34, 0x96, 0xd2, 0xd3,
-	0xf8, 0xcb, 0x8f, 0x5b, 0xb0, 0xbf, 0x36, 0x27, 0xb1, 0x06, 0xca, 0xb1, 0x61, 0xe8, 0xdd, 0xd6,
-	0xc0, 0xe8, 0x98, 0xc6, 0xeb, 0x5e, 0xc7, 0x1c, 0x9c, 0xf6, 0x7b, 0x9d, 0x6f, 0xba, 0x27, 0xdd,
-	0x4e, 0x5b, 0xce, 0x29, 0xf7, 0x16, 0x4b, 0x75, 0x77, 0xe0, 0x04, 0x1e, 0x1d, 0x5a, 0x6f, 0x2d,
-	0x3a, 0xc2, 0x8f, 0xe1, 0x41, 0xfa, 0xc0, 0xa0, 0xdb, 0x96, 0x91, 0xb2, 0xb3, 0x58, 0xaa, 0x85,
-	0xd5, 0x3a, 0x03, 0xf2, 0x5d, 0xff, 0xec, 0x54, 0xde, 0x12, 0x90, 0xd5, 0x1a, 0x1f, 0xc2, 0xc3,
-	0x14, 0xa4, 0x6f, 0xe8, 0xdd, 0xd3, 0x17, 0x72, 0x5e, 0x81, 0xc5, 0x52, 0x2d, 0xf5, 0x99, 0x6f,
-	0x39, 0x63, 0x5c, 0x03, 0x9c, 0x4e, 0xa6, 0x77, 0xe5, 0x82, 0xb2, 0xbd, 0x58, 0xaa, 0xf9, 0x81,
-	0x6f, 0x65, 0x00, 0xba, 0xa7, 0x86, 0x5c, 0x14, 0x80, 0xae, 0xc3, 0xf0, 0x13, 0x38, 0x48, 0x01,
-	0x4e, 0x5e, 0x9e, 0x1d, 0x1b, 0x72, 0x49, 0x91, 0x16, 0x4b, 0xb5, 0x78, 0x32, 0x75, 0x49, 0x16,
-	0xa8, 0xa7, 0x9f, 0x19, 0x67, 0xf2, 0xb6, 0x00, 0xf5, 0xf8, 0x6f, 0x7a, 0x1b, 0xd4, 0x7a, 0x6d,
-	0x74, 0xfa, 0xf2, 0x8e, 0x00, 0xb5, 0x2e, 0x19, 0x0d, 0x5a, 0xf6, 0xfb, 0xab, 0x2a, 0xfa, 0x70,
-	0x55, 0x45, 0x7f, 0x5e, 0x55, 0xd1, 0xbb, 0xeb, 0x6a, 0xee, 0xc3, 0x75, 0x35, 0xf7, 0xdb, 0x75,
-	0x35, 0x07, 0x8a, 0xe5, 0x6e, 0xfa, 0xba, 0x7a, 0xe8, 0xcd, 0x57, 0x63, 0x8b, 0x4d, 0x66, 0xe7,
-	0xcd, 0xa1, 0x6b, 0x6b, 0x31, 0xea, 0xa9, 0xe5, 0x26, 0x2c, 0xed, 0x22, 0xf1, 0xdd, 0xaf, 0xee,
-	0x66, 0x70, 0x5e, 0xe2, 0x7f, 0xd3, 0xf3, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0x36, 0x7d, 0x76,
-	0x18, 0x13, 0x08, 0x00, 0x00,
+	// 1440 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0xbd, 0x73, 0xdb, 0xc6,
+	0x12, 0x17, 0x44, 0x8a, 0x12, 0x56, 0x5f, 0xd0, 0x59, 0x1f, 0x78, 0x78, 0xcf, 0x14, 0x4d, 0x3f,
+	0xdb, 0x1a, 0x65, 0x4c, 0x8e, 0x6d, 0x45, 0xc9, 0xa4, 0xc8, 0x44, 0xb2, 0x28, 0x8b, 0x89, 0x45,
+	0x32, 0x10, 0xe4, 0x19, 0xbb, 0xc1, 0x9c, 0xc8, 0x33, 0x89, 0x11, 0x89, 0x63, 0x80, 0xa3, 0x4c,
+	0x55, 0xe9, 0x55, 0x39, 0xa9, 0xd2, 0x70, 0x92, 0x34, 0x69, 0x92, 0x36, 0x55, 0xfe, 0x01, 0x97,
+	0x2e, 0x93, 0x14, 0x49, 0xc6, 0xee, 0xf2, 0x57, 0x64, 0x70, 0x47, 0x80, 0x20, 0x09, 0x4a, 0x96,
+	0x53, 0x24, 0x1d, 0x77, 0xf7, 0xb7, 0xb7, 0x9f, 0xb7, 0xb7, 0x20, 0xdc, 0x6a, 0x3a, 0xf4, 0x84,
+	0xd8, 0xd8, 0x2e, 0x93, 0x2c, 0x66, 0xcc, 0xb1, 0x8e, 0x5a, 0x8c, 0x64, 0x4f, 0xee, 0xf4, 0x88,
+	0x4c, 0xd3, 0xa1, 0x8c, 0xa2, 0x95, 0x1e, 0x30, 0xd3, 0x93, 0x9d, 0xdc, 0xd1, 0x16, 0xab, 0xb4,
+	0x4a, 0x39, 0x26, 0xeb, 0xfd, 0x12, 0x70, 0x6d, 0xb5, 0x4a, 0x69, 0xb5, 0x4e, 0xb2, 0x9c, 0x3a,
+	0x6a, 0x3d, 0xcd, 0x32, 0xab, 0x41, 0x5c, 0x86, 0x1b, 0x4d, 0x01, 0x48, 0x7f, 0x11, 0x83, 0x44,
+	0x09, 0x3b, 0xb8, 0xe1, 0xa2, 0x35, 0x50, 0x1a, 0xb8, 0x6d, 0x9e, 0xe0, 0x7a, 0x8b, 0x98, 0x75,
+	0x62, 0x57, 0x59, 0x4d, 0x95, 0x52, 0xd2, 0xda, 0xac, 0x3e, 0xd7, 0xc0, 0xed, 0x47, 0x1e, 0xfb,
+	0x21, 0xe7, 0xa2, 0x75, 0x58, 0xf0, 0x90, 0x9f, 0xb5, 0x88, 0x73, 0x6a, 0x3a, 0xc4, 0x6d, 0xd5,
+	0x99, 0xab, 0x8e, 0x73, 0xe8, 0x7c, 0x03, 0xb7, 0x3f, 0xf5, 0xf8, 0xba, 0x60, 0xa3, 0xf7, 0x40,
+	0xed, 0xc3, 0x36, 0xa9, 0xed, 0x12, 0xf3, 0xe8, 0x94, 0x11, 0x57, 0x8d, 0xa5, 0xa4, 0xb5, 0xb8,
+	0xbe, 0x14, 0x52, 0xe1, 0xd2, 0x6d, 0x4f, 0x88, 0xee, 0x80, 0x27, 0x30, 0x6d, 0xdc, 0x20, 0xae,
+	0xd9, 0x24, 0x8e, 0x89, 0xcb, 0x65, 0xda, 0xb2, 0x99, 0x1a, 0xe7, 0x86, 0x50, 0x03, 0xb7, 0x0b,
+	0x9e, 0xac, 0x44, 0x9c, 0x2d, 0x21, 0x41, 0xb7, 0xe1, 0x4a, 0x10, 0x81, 0xd0, 0xf1, 0xb4, 0xd5,
+	0x09, 0xae, 0xa0, 0xf8, 0x41, 0x78, 0x1a, 0x9e, 0x26, 0xfa, 0x3f, 0xcc, 0xd5, 0x28, 0x3d, 0x36,
+	0xab, 0xd8, 0x35, 0xeb, 0x56, 0xc3, 0x62, 0x6a, 0x82, 0x3b, 0x34, 0xe3, 0x71, 0x1f, 0x60, 0xf7,
+	0xa1, 0xc7, 0x43, 0x9b, 0xb0, 0x82, 0xeb, 0x75, 0xfa, 0xcc, 0xb4, 0x5c, 0xb7, 0x45, 0x1c, 0xd3,
+	0x21, 0x27, 0xb4, 0x8c, 0x99, 0x45, 0x6d, 0x75, 0x32, 0x25, 0xad, 0x4d, 0xe9, 0x4b, 0x5c, 0x9c,
+	0xe7, 0x52, 0x3d, 0x10, 0xa2, 0xf7, 0x41, 0x25, 0xed, 0xa6, 0xe5, 0x70, 0xca, 0xb4, 0x29, 0xb3,
+	0xca, 0xc4, 0x3c, 0xaa, 0xd3, 0xf2, 0xb1, 0xab, 0x4e, 0x71, 0x8f, 0x96, 0x7b, 0xf2, 0x02, 0x17,
+	0x6f, 0x73, 0x69, 0xfa, 0xc7, 0x71, 0x90, 0xb7, 0xfc, 0xda, 0x22, 0x04, 0x71, 0x1e, 0x85, 0x57,
+	0x0a, 0x59, 0xe7, 0xbf, 0xd1, 0x22, 0x4c, 0xf0, 0x20, 0x79, 0xd2, 0x67, 0x74, 0x41, 0xa0, 0x7d,
+	0x98, 0x0b, 0x5a, 0xc2, 0x64, 0xa7, 0x4d, 0xc2, 0x13, 0x3c, 0x77, 0xf7, 0x66, 0x66, 0x44, 0xd3,
+	0x64, 0x02, 0x2b, 0xc6, 0x69, 0x93, 0xe8, 0xb3, 0x38, 0x4c, 0x22, 0x15, 0x26, 0x71, 0xa5, 0xe2,
+	0x10, 0xd7, 0xe5, 0x29, 0x97, 0x75, 0x9f, 0x44, 0xfb, 0x30, 0x1f, 0x0a, 0xad, 0x82, 0x99, 0xc8,
+	0xf1, 0xf4, 0x5d, 0x2d, 0x23, 0xfa, 0x2d, 0xe3, 0xf7, 0x5b, 0xc6, 0xf0, 0xfb, 0x6d, 0x7b, 0xea,
+	0xc5, 0x6f, 0xab, 0xd2, 0xf3, 0xdf, 0x57, 0x25, 0x7d, 0xae, 0xa7, 0xbc, 0x83, 0x19, 0x41, 0x1f,
+	0x41, 0x82, 0x3a, 0x56, 0xd5, 0xb2, 0x79, 0xfe, 0xa7, 0xef, 0xae, 0x5d, 0xec, 0x6f, 0x91, 0xe3,
+	0xf5, 0xae, 0xde, 0x07, 0xf1, 0xaf, 0xbe, 0x59, 0x1d, 0x4b, 0x13, 0x98, 0x1f, 0x00, 0xa0, 0x65,
+	0x48, 0xb8, 0x84, 0x31, 0xe2, 0x74, 0xd3, 0xd7, 0xa5, 0xd0, 0x0a, 0x4c, 0xb2, 0xb6, 0x59, 0xc3,
+	0x6e, 0x8d, 0xa7, 0x50, 0xd6, 0x13, 0xac, 0xbd, 0x87, 0xdd, 0x1a, 0xba, 0x06, 0x33, 0xbc, 0x46,
+	0x66, 0x8d, 0x58, 0xd5, 0x1a, 0xe3, 0x19, 0x8c, 0xe9, 0xd3, 0x9c, 0xb7, 0xc7, 0x59, 0xe9, 0xcf,
+	0x61, 0x36, 0x30, 0xb3, 0x47, 0xe9, 0x71, 0x64, 0x85, 0x34, 0x98, 0x2a, 0x53, 0x9b, 0x39, 0xb8,
+	0xcc, 0xba, 0x16, 0x02, 0x1a, 0x7d, 0x08, 0xf1, 0x06, 0xad, 0xf8, 0xd5, 0x59, 0xbf, 0x38, 0x5a,
+	0xcf, 0xca, 0x3e, 0xad, 0x10, 0x9d, 0xeb, 0xa5, 0xbf, 0x95, 0x60, 0x21, 0x77, 0x42, 0x6c, 0x16,
+	0x00, 0xb6, 0x2a, 0x95, 0x8b, 0xfb, 0x44, 0xf6, 0xfb, 0x04, 0x41, 0x3c, 0xe8, 0x0e, 0x59, 0x8f,
+	0x33, 0xbf, 0xd8, 0xa1, 0xfb, 0x25, 0xeb, 0x3e, 0xe9, 0x9d, 0x41, 0x9f, 0xd9, 0xc4, 0xe1, 0x25,
+	0x96, 0x75, 0x41, 0xa0, 0x24, 0x40, 0xaf, 0x8a, 0xbc, 0x6e, 0xb2, 0x1e, 0xe2, 0xa4, 0xff, 0x94,
+	0x60, 0xb1, 0xdf, 0xc7, 0xc3, 0xa6, 0xd7, 0x28, 0x91, 0x6e, 0xde, 0x80, 0x39, 0x51, 0x48, 0x5c,
+	0x37, 0xc3, 0xfe, 0xce, 0xfa, 0x5c, 0x7e, 0x6f, 0xd1, 0x75, 0x08, 0x18, 0x66, 0x28, 0x80, 0x19,
+	0x9f, 0xc9, 0xbb, 0xf6, 0x1a, 0xcc, 0xb4, 0xb8, 0xa5, 0xee, 0x49, 0x22, 0x9a, 0x69, 0xc1, 0x13,
+	0xe7, 0xac, 0x42, 0x97, 0x14, 0xa7, 0x88, 0xb8, 0x40, 0xb0, 0x8c, 0x81, 0x64, 0x24, 0x46, 0x24,
+	0x63, 0x32, 0x94, 0x8c, 0xf4, 0xaf, 0x12, 0x24, 0xfb, 0x83, 0xcd, 0x05, 0x99, 0x38, 0x27, 0xec,
+	0xe8, 0xea, 0x84, 0x8c, 0xc7, 0x46, 0x18, 0x8f, 0x87, 0x2b, 0x91, 0x85, 0x2b, 0x41, 0x56, 0x42,
+	0x25, 0x11, 0x51, 0x21, 0x5f, 0xd4, 0x73, 0x08, 0xdd, 0x06, 0x24, 0x62, 0xad, 0x98, 0x43, 0x25,
+	0x5c, 0xe8, 0x4a, 0x7a, 0xf0, 0xf4, 0x93, 0xc1, 0x42, 0xee, 0x90, 0x3a, 0x19, 0x11, 0x51, 0xc8,
+	0xf7, 0xf1, 0x11, 0xbe, 0xc7, 0xc2, 0x89, 0xfb, 0x5a, 0x82, 0xff, 0x0d, 0x1c, 0x6e, 0xb9, 0xcc,
+	0xb2, 0xcb, 0xec, 0x1c, 0x23, 0xd1, 0x69, 0xbb, 0x11, 0x39, 0xfc, 0xe4, 0xa8, 0xa1, 0x76, 0x89,
+	0x3e, 0x4f, 0xff, 0x24, 0xc1, 0x52, 0xbf, 0x87, 0xde, 0x88, 0x3f, 0x26, 0xd1, 0xf7, 0xed, 0x2a,
+	0x80, 0x78, 0x3e, 0x43, 0x93, 0x45, 0xe6, 0x1c, 0x3e, 0x5c, 0xfe, 0xb6, 0x8f, 0xcb, 0x90, 0x10,
+	0xaf, 0x50, 0xd7, 0xc9, 0x2e, 0xe5, 0xf1, 0x1d, 0x82, 0xdd, 0xa0, 0x8c, 0x5d, 0x2a, 0xfd, 0xfd,
+	0x90, 0xf7, 0xbc, 0xb0, 0xff, 0x90, 0xf7, 0xfd, 0x33, 0x63, 0x62, 0x68, 0x66, 0xfc, 0x20, 0xc1,
+	0x72, 0x84, 0xb7, 0x96, 0x5d, 0xfd, 0x57, 0xba, 0x7b, 0x0f, 0x56, 0x84, 0xb7, 0x02, 0xbf, 0x83,
+	0x19, 0x16, 0x97, 0xbd, 0x12, 0x3e, 0x54, 0xea, 0x3b, 0x34, 0x6d, 0xc1, 0xd5, 0x81, 0xb1, 0x68,
+	0xd7, 0x2d, 0x97, 0x91, 0xca, 0x85, 0xaa, 0x41, 0x0e, 0xc6, 0xfb, 0x9f, 0x99, 0x56, 0xf7, 0x80,
+	0x6e, 0x78, 0x01, 0x9d, 0xc6, 0xfe, 0xdd, 0x12, 0xfa, 0x81, 0x45, 0xb7, 0xd4, 0x72, 0xaa, 0xe7,
+	0x5a, 0xba, 0x05, 0xf3, 0xbd, 0xd4, 0x85, 0xaf, 0x73, 0x2f, 0xa3, 0xf7, 0x79, 0x34, 0xdf, 0xc5,
+	0xe0, 0xbf, 0xfd, 0xe1, 0x88, 0x5d, 0xd2, 0x0f, 0x66, 0xd4, 0x4a, 0x29, 0xbf, 0xf9, 0x4a, 0x29,
+	0x5f, 0x7e, 0xa5, 0x94, 0xdf, 0x6a, 0xa5, 0x94, 0x2f, 0xbb, 0x52, 0xca, 0x6f, 0xbc, 0x52, 0xca,
+	0x97, 0x5b, 0x29, 0xe5, 0xb7, 0x5d, 0x29, 0xe5, 0x91, 0x2b, 0x65, 0x0b, 0xfe, 0xd3, 0x5f, 0x27,
+	0x6f, 0xa5, 0xf0, 0xab, 0x74, 0xd9, 0xfd, 0x05, 0x85, 0xf6, 0x17, 0x59, 0xec, 0x24, 0xd1, 0x6f,
+	0x53, 0xfa, 0x4b, 0x09, 0xd4, 0x61, 0xbb, 0xbb, 0xd8, 0xaa, 0xbf, 0x85, 0xd9, 0x65, 0x48, 0xe0,
+	0x32, 0x4f, 0x92, 0x30, 0xdc, 0xa5, 0xce, 0x1f, 0xe9, 0xc4, 0x71, 0x68, 0x30, 0xd2, 0x39, 0xb1,
+	0xfe, 0x4b, 0x2c, 0xb4, 0xc0, 0xf1, 0x19, 0x90, 0x05, 0x6d, 0xcb, 0x30, 0xf4, 0xfc, 0xf6, 0xa1,
+	0x91, 0x33, 0x8d, 0xc7, 0xa5, 0x9c, 0x79, 0x58, 0x38, 0x28, 0xe5, 0xee, 0xe7, 0x77, 0xf3, 0xb9,
+	0x1d, 0x65, 0x4c, 0x9b, 0x3f, 0xeb, 0xa4, 0xa6, 0x0f, 0x6d, 0xb7, 0x49, 0xca, 0xd6, 0x53, 0x8b,
+	0x54, 0xd0, 0x35, 0xb8, 0x32, 0xa8, 0x70, 0x98, 0xdf, 0x51, 0x24, 0x6d, 0xea, 0xac, 0x93, 0x8a,
+	0x7b, 0xbf, 0x23, 0x20, 0x1f, 0x1f, 0x14, 0x0b, 0xca, 0xb8, 0x80, 0x78, 0xbf, 0xd1, 0x0d, 0x58,
+	0x1a, 0x80, 0x1c, 0x18, 0x7a, 0xbe, 0xf0, 0x40, 0x89, 0x69, 0x70, 0xd6, 0x49, 0x25, 0x0e, 0x18,
+	0x9f, 0x7d, 0xab, 0x80, 0x06, 0x8d, 0xe9, 0x79, 0x25, 0xae, 0x4d, 0x9e, 0x75, 0x52, 0xb1, 0x43,
+	0xc7, 0x8a, 0x00, 0xe4, 0x0b, 0x86, 0x32, 0x21, 0x00, 0x79, 0x9b, 0xa1, 0xeb, 0xb0, 0x38, 0x00,
+	0xd8, 0x7d, 0x58, 0xdc, 0x32, 0x94, 0x84, 0x26, 0x9f, 0x75, 0x52, 0x13, 0xbb, 0x75, 0x8a, 0xa3,
+	0x40, 0x25, 0xbd, 0x68, 0x14, 0x95, 0x49, 0x01, 0x2a, 0xf1, 0xcf, 0xcf, 0x61, 0xd0, 0xf6, 0x63,
+	0x23, 0x77, 0xa0, 0x4c, 0x09, 0x90, 0xb8, 0x66, 0xc3, 0xa0, 0x7c, 0xc1, 0xd8, 0xdc, 0x50, 0x64,
+	0x01, 0xca, 0xdb, 0x6c, 0x73, 0x03, 0xdd, 0x82, 0xe5, 0x28, 0x9f, 0x36, 0x37, 0x14, 0xd0, 0xa6,
+	0xcf, 0x3a, 0xa9, 0x49, 0xee, 0xd5, 0xe6, 0x06, 0x7a, 0x07, 0xd4, 0x01, 0xa0, 0x91, 0xdf, 0xcf,
+	0x1d, 0x18, 0x5b, 0xfb, 0x25, 0x65, 0x5a, 0x9b, 0x3d, 0xeb, 0xa4, 0xe4, 0xe0, 0x23, 0x63, 0xbd,
+	0x23, 0xc1, 0xc2, 0xd0, 0xda, 0x8c, 0x36, 0x60, 0xb5, 0x77, 0xc4, 0x5e, 0xb1, 0xf8, 0x89, 0xb9,
+	0x5f, 0xdc, 0xb9, 0xb0, 0xc8, 0xeb, 0xa0, 0x45, 0x69, 0x15, 0x8a, 0x46, 0x7e, 0xf7, 0xb1, 0x22,
+	0x89, 0x1a, 0x79, 0xb7, 0xec, 0xe9, 0x29, 0xba, 0x09, 0x6a, 0x14, 0xf6, 0x51, 0xce, 0x28, 0xfa,
+	0x25, 0x7f, 0x44, 0x18, 0xdd, 0x6e, 0xbc, 0x78, 0x95, 0x94, 0x5e, 0xbe, 0x4a, 0x4a, 0x7f, 0xbc,
+	0x4a, 0x4a, 0xcf, 0x5f, 0x27, 0xc7, 0x5e, 0xbe, 0x4e, 0x8e, 0xfd, 0xfc, 0x3a, 0x39, 0x06, 0x9a,
+	0x45, 0x47, 0x7d, 0x08, 0x94, 0xa4, 0x27, 0xef, 0x56, 0x2d, 0x56, 0x6b, 0x1d, 0x65, 0xca, 0xb4,
+	0x91, 0xed, 0xa1, 0x6e, 0x5b, 0x34, 0x44, 0x65, 0xdb, 0xa1, 0xbf, 0x0e, 0xbc, 0x07, 0xcf, 0x3d,
+	0x4a, 0xf0, 0xef, 0xb0, 0x7b, 0x7f, 0x0d, 0x00, 0x88, 0xfc, 0x7a, 0x4e, 0x5f, 0x10, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -874,6 +1648,46 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ExpirationNoticeBlocks != 0 {
+		i = encodeVarintAttribute(dAtA, i, uint64(m.ExpirationNoticeBlocks))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.AllowIssuerRevocation {
+		i--
+		if m.AllowIssuerRevocation {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.HookGasLimit != 0 {
+		i = encodeVarintAttribute(dAtA, i, uint64(m.HookGasLimit))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.MaxValuesPerName != 0 {
+		i = encodeVarintAttribute(dAtA, i, uint64(m.MaxValuesPerName))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.MaxNamesPerAccount != 0 {
+		i = encodeVarintAttribute(dAtA, i, uint64(m.MaxNamesPerAccount))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MaxQueryResponseBytes != 0 {
+		i = encodeVarintAttribute(dAtA, i, uint64(m.MaxQueryResponseBytes))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MaxQueryResults != 0 {
+		i = encodeVarintAttribute(dAtA, i, uint64(m.MaxQueryResults))
+		i--
+		dAtA[i] = 0x10
+	}
 	if m.MaxValueLength != 0 {
 		i = encodeVarintAttribute(dAtA, i, uint64(m.MaxValueLength))
 		i--
@@ -902,13 +1716,25 @@ func (m *Attribute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Origin != nil {
+		{
+			size, err := m.Origin.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAttribute(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
 	if m.ExpirationDate != nil {
-		n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ExpirationDate, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpirationDate):])
-		if err1 != nil {
-			return 0, err1
+		n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ExpirationDate, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpirationDate):])
+		if err2 != nil {
+			return 0, err2
 		}
-		i -= n1
-		i = encodeVarintAttribute(dAtA, i, uint64(n1))
+		i -= n2
+		i = encodeVarintAttribute(dAtA, i, uint64(n2))
 		i--
 		dAtA[i] = 0x2a
 	}
@@ -941,7 +1767,7 @@ func (m *Attribute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *EventAttributeAdd) Marshal() (dAtA []byte, err error) {
+func (m *AttributeOrigin) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -951,43 +1777,127 @@ func (m *EventAttributeAdd) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventAttributeAdd) MarshalTo(dAtA []byte) (int, error) {
+func (m *AttributeOrigin) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventAttributeAdd) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *AttributeOrigin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Expiration) > 0 {
-		i -= len(m.Expiration)
-		copy(dAtA[i:], m.Expiration)
-		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Expiration)))
+	if m.BlockHeight != 0 {
+		i = encodeVarintAttribute(dAtA, i, uint64(m.BlockHeight))
 		i--
-		dAtA[i] = 0x32
+		dAtA[i] = 0x18
 	}
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Owner)))
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.TxHash)))
 		i--
-		dAtA[i] = 0x2a
+		dAtA[i] = 0x12
 	}
-	if len(m.Account) > 0 {
-		i -= len(m.Account)
-		copy(dAtA[i:], m.Account)
-		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Account)))
+	if len(m.Setter) > 0 {
+		i -= len(m.Setter)
+		copy(dAtA[i:], m.Setter)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Setter)))
 		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0xa
 	}
-	if len(m.Type) > 0 {
-		i -= len(m.Type)
-		copy(dAtA[i:], m.Type)
-		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Type)))
-		i--
-		dAtA[i] = 0x1a
+	return len(dAtA) - i, nil
+}
+
+func (m *AttributeHook) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AttributeHook) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AttributeHook) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Mode != 0 {
+		i = encodeVarintAttribute(dAtA, i, uint64(m.Mode))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventAttributeAdd) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventAttributeAdd) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventAttributeAdd) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Expiration) > 0 {
+		i -= len(m.Expiration)
+		copy(dAtA[i:], m.Expiration)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Expiration)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0x1a
 	}
 	if len(m.Value) > 0 {
 		i -= len(m.Value)
@@ -1245,6 +2155,71 @@ func (m *EventAttributeDistinctDelete) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
+func (m *EventAttributeRevoked) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventAttributeRevoked) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventAttributeRevoked) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Issuer) > 0 {
+		i -= len(m.Issuer)
+		copy(dAtA[i:], m.Issuer)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Issuer)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.AttributeType) > 0 {
+		i -= len(m.AttributeType)
+		copy(dAtA[i:], m.AttributeType)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.AttributeType)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ValueHash) > 0 {
+		i -= len(m.ValueHash)
+		copy(dAtA[i:], m.ValueHash)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.ValueHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *EventAttributeExpired) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1303,7 +2278,7 @@ func (m *EventAttributeExpired) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *EventAccountDataUpdated) Marshal() (dAtA []byte, err error) {
+func (m *EventAttributeExpiring) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1313,27 +2288,55 @@ func (m *EventAccountDataUpdated) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventAccountDataUpdated) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventAttributeExpiring) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventAccountDataUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventAttributeExpiring) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.Expiration) > 0 {
+		i -= len(m.Expiration)
+		copy(dAtA[i:], m.Expiration)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Expiration)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if len(m.Account) > 0 {
 		i -= len(m.Account)
 		copy(dAtA[i:], m.Account)
 		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Account)))
 		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.AttributeType) > 0 {
+		i -= len(m.AttributeType)
+		copy(dAtA[i:], m.AttributeType)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.AttributeType)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ValueHash) > 0 {
+		i -= len(m.ValueHash)
+		copy(dAtA[i:], m.ValueHash)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.ValueHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Name)))
+		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *EventAttributeParamsUpdated) Marshal() (dAtA []byte, err error) {
+func (m *EventAccountDataUpdated) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1343,148 +2346,340 @@ func (m *EventAttributeParamsUpdated) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventAttributeParamsUpdated) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventAccountDataUpdated) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventAttributeParamsUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventAccountDataUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.MaxValueLength) > 0 {
-		i -= len(m.MaxValueLength)
-		copy(dAtA[i:], m.MaxValueLength)
-		i = encodeVarintAttribute(dAtA, i, uint64(len(m.MaxValueLength)))
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Account)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintAttribute(dAtA []byte, offset int, v uint64) int {
-	offset -= sovAttribute(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *EventAttributeUnlistedUpdated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.MaxValueLength != 0 {
-		n += 1 + sovAttribute(uint64(m.MaxValueLength))
-	}
-	return n
+
+func (m *EventAttributeUnlistedUpdated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Attribute) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *EventAttributeUnlistedUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovAttribute(uint64(l))
-	}
-	l = len(m.Value)
-	if l > 0 {
-		n += 1 + l + sovAttribute(uint64(l))
-	}
-	if m.AttributeType != 0 {
-		n += 1 + sovAttribute(uint64(m.AttributeType))
+	if len(m.Unlisted) > 0 {
+		i -= len(m.Unlisted)
+		copy(dAtA[i:], m.Unlisted)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Unlisted)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovAttribute(uint64(l))
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.ExpirationDate != nil {
-		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpirationDate)
-		n += 1 + l + sovAttribute(uint64(l))
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventAttributeAdd) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovAttribute(uint64(l))
+func (m *EventAccountAttributesPurged) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.Value)
-	if l > 0 {
-		n += 1 + l + sovAttribute(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *EventAccountAttributesPurged) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventAccountAttributesPurged) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AttributeCount) > 0 {
+		i -= len(m.AttributeCount)
+		copy(dAtA[i:], m.AttributeCount)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.AttributeCount)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Type)
-	if l > 0 {
-		n += 1 + l + sovAttribute(uint64(l))
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
 	}
-	l = len(m.Account)
-	if l > 0 {
-		n += 1 + l + sovAttribute(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *EventAttributeParamsUpdated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovAttribute(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *EventAttributeParamsUpdated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventAttributeParamsUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ExpirationNoticeBlocks) > 0 {
+		i -= len(m.ExpirationNoticeBlocks)
+		copy(dAtA[i:], m.ExpirationNoticeBlocks)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.ExpirationNoticeBlocks)))
+		i--
+		dAtA[i] = 0x42
 	}
-	l = len(m.Expiration)
-	if l > 0 {
-		n += 1 + l + sovAttribute(uint64(l))
+	if len(m.AllowIssuerRevocation) > 0 {
+		i -= len(m.AllowIssuerRevocation)
+		copy(dAtA[i:], m.AllowIssuerRevocation)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.AllowIssuerRevocation)))
+		i--
+		dAtA[i] = 0x3a
 	}
-	return n
+	if len(m.HookGasLimit) > 0 {
+		i -= len(m.HookGasLimit)
+		copy(dAtA[i:], m.HookGasLimit)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.HookGasLimit)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.MaxValuesPerName) > 0 {
+		i -= len(m.MaxValuesPerName)
+		copy(dAtA[i:], m.MaxValuesPerName)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.MaxValuesPerName)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.MaxNamesPerAccount) > 0 {
+		i -= len(m.MaxNamesPerAccount)
+		copy(dAtA[i:], m.MaxNamesPerAccount)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.MaxNamesPerAccount)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.MaxQueryResponseBytes) > 0 {
+		i -= len(m.MaxQueryResponseBytes)
+		copy(dAtA[i:], m.MaxQueryResponseBytes)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.MaxQueryResponseBytes)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.MaxQueryResults) > 0 {
+		i -= len(m.MaxQueryResults)
+		copy(dAtA[i:], m.MaxQueryResults)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.MaxQueryResults)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MaxValueLength) > 0 {
+		i -= len(m.MaxValueLength)
+		copy(dAtA[i:], m.MaxValueLength)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.MaxValueLength)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *EventAttributeUpdate) Size() (n int) {
+func (m *EventAttributeHookUpdated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventAttributeHookUpdated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventAttributeHookUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Mode) > 0 {
+		i -= len(m.Mode)
+		copy(dAtA[i:], m.Mode)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Mode)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventAttributeHookFailed) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventAttributeHookFailed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventAttributeHookFailed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Action) > 0 {
+		i -= len(m.Action)
+		copy(dAtA[i:], m.Action)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Action)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAttribute(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAttribute(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovAttribute(uint64(l))
+	if m.MaxValueLength != 0 {
+		n += 1 + sovAttribute(uint64(m.MaxValueLength))
 	}
-	l = len(m.OriginalValue)
-	if l > 0 {
-		n += 1 + l + sovAttribute(uint64(l))
+	if m.MaxQueryResults != 0 {
+		n += 1 + sovAttribute(uint64(m.MaxQueryResults))
 	}
-	l = len(m.OriginalType)
-	if l > 0 {
-		n += 1 + l + sovAttribute(uint64(l))
+	if m.MaxQueryResponseBytes != 0 {
+		n += 1 + sovAttribute(uint64(m.MaxQueryResponseBytes))
 	}
-	l = len(m.UpdateValue)
-	if l > 0 {
-		n += 1 + l + sovAttribute(uint64(l))
+	if m.MaxNamesPerAccount != 0 {
+		n += 1 + sovAttribute(uint64(m.MaxNamesPerAccount))
 	}
-	l = len(m.UpdateType)
-	if l > 0 {
-		n += 1 + l + sovAttribute(uint64(l))
+	if m.MaxValuesPerName != 0 {
+		n += 1 + sovAttribute(uint64(m.MaxValuesPerName))
 	}
-	l = len(m.Account)
-	if l > 0 {
-		n += 1 + l + sovAttribute(uint64(l))
+	if m.HookGasLimit != 0 {
+		n += 1 + sovAttribute(uint64(m.HookGasLimit))
 	}
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovAttribute(uint64(l))
+	if m.AllowIssuerRevocation {
+		n += 2
+	}
+	if m.ExpirationNoticeBlocks != 0 {
+		n += 1 + sovAttribute(uint64(m.ExpirationNoticeBlocks))
 	}
 	return n
 }
 
-func (m *EventAttributeExpirationUpdate) Size() (n int) {
+func (m *Attribute) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -1498,26 +2693,45 @@ func (m *EventAttributeExpirationUpdate) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovAttribute(uint64(l))
 	}
-	l = len(m.Account)
+	if m.AttributeType != 0 {
+		n += 1 + sovAttribute(uint64(m.AttributeType))
+	}
+	l = len(m.Address)
 	if l > 0 {
 		n += 1 + l + sovAttribute(uint64(l))
 	}
-	l = len(m.Owner)
-	if l > 0 {
+	if m.ExpirationDate != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpirationDate)
 		n += 1 + l + sovAttribute(uint64(l))
 	}
-	l = len(m.OriginalExpiration)
+	if m.Origin != nil {
+		l = m.Origin.Size()
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	return n
+}
+
+func (m *AttributeOrigin) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Setter)
 	if l > 0 {
 		n += 1 + l + sovAttribute(uint64(l))
 	}
-	l = len(m.UpdatedExpiration)
+	l = len(m.TxHash)
 	if l > 0 {
 		n += 1 + l + sovAttribute(uint64(l))
 	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovAttribute(uint64(m.BlockHeight))
+	}
 	return n
 }
 
-func (m *EventAttributeDelete) Size() (n int) {
+func (m *AttributeHook) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -1527,18 +2741,17 @@ func (m *EventAttributeDelete) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovAttribute(uint64(l))
 	}
-	l = len(m.Account)
+	l = len(m.Contract)
 	if l > 0 {
 		n += 1 + l + sovAttribute(uint64(l))
 	}
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovAttribute(uint64(l))
+	if m.Mode != 0 {
+		n += 1 + sovAttribute(uint64(m.Mode))
 	}
 	return n
 }
 
-func (m *EventAttributeDistinctDelete) Size() (n int) {
+func (m *EventAttributeAdd) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -1552,7 +2765,7 @@ func (m *EventAttributeDistinctDelete) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovAttribute(uint64(l))
 	}
-	l = len(m.AttributeType)
+	l = len(m.Type)
 	if l > 0 {
 		n += 1 + l + sovAttribute(uint64(l))
 	}
@@ -1564,10 +2777,14 @@ func (m *EventAttributeDistinctDelete) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovAttribute(uint64(l))
 	}
+	l = len(m.Expiration)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
 	return n
 }
 
-func (m *EventAttributeExpired) Size() (n int) {
+func (m *EventAttributeUpdate) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -1577,11 +2794,19 @@ func (m *EventAttributeExpired) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovAttribute(uint64(l))
 	}
-	l = len(m.ValueHash)
+	l = len(m.OriginalValue)
 	if l > 0 {
 		n += 1 + l + sovAttribute(uint64(l))
 	}
-	l = len(m.AttributeType)
+	l = len(m.OriginalType)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	l = len(m.UpdateValue)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	l = len(m.UpdateType)
 	if l > 0 {
 		n += 1 + l + sovAttribute(uint64(l))
 	}
@@ -1589,14 +2814,222 @@ func (m *EventAttributeExpired) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovAttribute(uint64(l))
 	}
-	l = len(m.Expiration)
+	l = len(m.Owner)
 	if l > 0 {
 		n += 1 + l + sovAttribute(uint64(l))
 	}
 	return n
 }
 
-func (m *EventAccountDataUpdated) Size() (n int) {
+func (m *EventAttributeExpirationUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	l = len(m.OriginalExpiration)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	l = len(m.UpdatedExpiration)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	return n
+}
+
+func (m *EventAttributeDelete) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	return n
+}
+
+func (m *EventAttributeDistinctDelete) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	l = len(m.AttributeType)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	return n
+}
+
+func (m *EventAttributeRevoked) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	l = len(m.ValueHash)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	l = len(m.AttributeType)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	l = len(m.Issuer)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	return n
+}
+
+func (m *EventAttributeExpired) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	l = len(m.ValueHash)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	l = len(m.AttributeType)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	l = len(m.Expiration)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	return n
+}
+
+func (m *EventAttributeExpiring) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	l = len(m.ValueHash)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	l = len(m.AttributeType)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	l = len(m.Expiration)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	return n
+}
+
+func (m *EventAccountDataUpdated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	return n
+}
+
+func (m *EventAttributeUnlistedUpdated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	l = len(m.Unlisted)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	return n
+}
+
+func (m *EventAccountAttributesPurged) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -1606,29 +3039,1727 @@ func (m *EventAccountDataUpdated) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovAttribute(uint64(l))
 	}
-	return n
-}
+	l = len(m.AttributeCount)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	return n
+}
+
+func (m *EventAttributeParamsUpdated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MaxValueLength)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	l = len(m.MaxQueryResults)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	l = len(m.MaxQueryResponseBytes)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	l = len(m.MaxNamesPerAccount)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	l = len(m.MaxValuesPerName)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	l = len(m.HookGasLimit)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	l = len(m.AllowIssuerRevocation)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	l = len(m.ExpirationNoticeBlocks)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	return n
+}
+
+func (m *EventAttributeHookUpdated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	l = len(m.Mode)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	return n
+}
+
+func (m *EventAttributeHookFailed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	l = len(m.Action)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	return n
+}
+
+func sovAttribute(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAttribute(x uint64) (n int) {
+	return sovAttribute(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAttribute
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxValueLength", wireType)
+			}
+			m.MaxValueLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxValueLength |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxQueryResults", wireType)
+			}
+			m.MaxQueryResults = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxQueryResults |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxQueryResponseBytes", wireType)
+			}
+			m.MaxQueryResponseBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxQueryResponseBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxNamesPerAccount", wireType)
+			}
+			m.MaxNamesPerAccount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxNamesPerAccount |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxValuesPerName", wireType)
+			}
+			m.MaxValuesPerName = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxValuesPerName |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HookGasLimit", wireType)
+			}
+			m.HookGasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HookGasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowIssuerRevocation", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllowIssuerRevocation = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationNoticeBlocks", wireType)
+			}
+			m.ExpirationNoticeBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpirationNoticeBlocks |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAttribute(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Attribute) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAttribute
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Attribute: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Attribute: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttributeType", wireType)
+			}
+			m.AttributeType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AttributeType |= AttributeType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationDate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpirationDate == nil {
+				m.ExpirationDate = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.ExpirationDate, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Origin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Origin == nil {
+				m.Origin = &AttributeOrigin{}
+			}
+			if err := m.Origin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAttribute(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AttributeOrigin) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAttribute
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AttributeOrigin: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AttributeOrigin: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Setter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Setter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAttribute(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AttributeHook) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAttribute
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AttributeHook: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AttributeHook: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
+			}
+			m.Mode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Mode |= AttributeHookMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAttribute(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventAttributeAdd) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAttribute
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventAttributeAdd: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventAttributeAdd: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Expiration = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAttribute(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventAttributeUpdate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAttribute
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventAttributeUpdate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventAttributeUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginalValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OriginalValue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginalType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OriginalType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpdateValue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpdateType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAttribute(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventAttributeExpirationUpdate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAttribute
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventAttributeExpirationUpdate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventAttributeExpirationUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginalExpiration", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OriginalExpiration = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedExpiration", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpdatedExpiration = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAttribute(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventAttributeDelete) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAttribute
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventAttributeDelete: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventAttributeDelete: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAttribute(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *EventAttributeParamsUpdated) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.MaxValueLength)
-	if l > 0 {
-		n += 1 + l + sovAttribute(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovAttribute(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozAttribute(x uint64) (n int) {
-	return sovAttribute(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *EventAttributeDistinctDelete) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1651,17 +4782,17 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventAttributeDistinctDelete: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventAttributeDistinctDelete: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxValueLength", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			m.MaxValueLength = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAttribute
@@ -1671,11 +4802,152 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxValueLength |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttributeType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AttributeType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAttribute(dAtA[iNdEx:])
@@ -1697,7 +4969,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Attribute) Unmarshal(dAtA []byte) error {
+func (m *EventAttributeRevoked) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1720,10 +4992,10 @@ func (m *Attribute) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Attribute: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventAttributeRevoked: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Attribute: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventAttributeRevoked: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1760,9 +5032,9 @@ func (m *Attribute) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValueHash", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAttribute
@@ -1772,31 +5044,29 @@ func (m *Attribute) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthAttribute
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthAttribute
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
-			if m.Value == nil {
-				m.Value = []byte{}
-			}
+			m.ValueHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
+			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field AttributeType", wireType)
 			}
-			m.AttributeType = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAttribute
@@ -1806,14 +5076,59 @@ func (m *Attribute) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AttributeType |= AttributeType(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AttributeType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Issuer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1841,13 +5156,13 @@ func (m *Attribute) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Issuer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationDate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAttribute
@@ -1857,27 +5172,23 @@ func (m *Attribute) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthAttribute
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthAttribute
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ExpirationDate == nil {
-				m.ExpirationDate = new(time.Time)
-			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.ExpirationDate, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1900,7 +5211,7 @@ func (m *Attribute) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventAttributeAdd) Unmarshal(dAtA []byte) error {
+func (m *EventAttributeExpired) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1923,10 +5234,10 @@ func (m *EventAttributeAdd) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventAttributeAdd: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventAttributeExpired: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventAttributeAdd: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventAttributeExpired: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1963,7 +5274,7 @@ func (m *EventAttributeAdd) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValueHash", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1991,11 +5302,11 @@ func (m *EventAttributeAdd) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			m.ValueHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AttributeType", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2023,7 +5334,7 @@ func (m *EventAttributeAdd) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = string(dAtA[iNdEx:postIndex])
+			m.AttributeType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
@@ -2058,38 +5369,6 @@ func (m *EventAttributeAdd) Unmarshal(dAtA []byte) error {
 			m.Account = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAttribute
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthAttribute
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthAttribute
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
 			}
@@ -2142,7 +5421,7 @@ func (m *EventAttributeAdd) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventAttributeUpdate) Unmarshal(dAtA []byte) error {
+func (m *EventAttributeExpiring) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2165,10 +5444,10 @@ func (m *EventAttributeUpdate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventAttributeUpdate: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventAttributeExpiring: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventAttributeUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventAttributeExpiring: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2205,7 +5484,7 @@ func (m *EventAttributeUpdate) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OriginalValue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValueHash", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2233,11 +5512,11 @@ func (m *EventAttributeUpdate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OriginalValue = string(dAtA[iNdEx:postIndex])
+			m.ValueHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OriginalType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AttributeType", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2265,11 +5544,11 @@ func (m *EventAttributeUpdate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OriginalType = string(dAtA[iNdEx:postIndex])
+			m.AttributeType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdateValue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2297,11 +5576,11 @@ func (m *EventAttributeUpdate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UpdateValue = string(dAtA[iNdEx:postIndex])
+			m.Account = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdateType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2329,43 +5608,61 @@ func (m *EventAttributeUpdate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UpdateType = string(dAtA[iNdEx:postIndex])
+			m.Expiration = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAttribute
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAttribute(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthAttribute
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthAttribute
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventAccountDataUpdated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAttribute
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Account = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 7:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventAccountDataUpdated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventAccountDataUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2393,7 +5690,7 @@ func (m *EventAttributeUpdate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			m.Account = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2416,7 +5713,7 @@ func (m *EventAttributeUpdate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventAttributeExpirationUpdate) Unmarshal(dAtA []byte) error {
+func (m *EventAttributeUnlistedUpdated) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2439,15 +5736,15 @@ func (m *EventAttributeExpirationUpdate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventAttributeExpirationUpdate: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventAttributeUnlistedUpdated: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventAttributeExpirationUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventAttributeUnlistedUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2475,11 +5772,11 @@ func (m *EventAttributeExpirationUpdate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Account = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2507,11 +5804,11 @@ func (m *EventAttributeExpirationUpdate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Unlisted", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2539,43 +5836,61 @@ func (m *EventAttributeExpirationUpdate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Account = string(dAtA[iNdEx:postIndex])
+			m.Unlisted = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAttribute
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAttribute(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthAttribute
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthAttribute
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventAccountAttributesPurged) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAttribute
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventAccountAttributesPurged: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventAccountAttributesPurged: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OriginalExpiration", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2603,11 +5918,11 @@ func (m *EventAttributeExpirationUpdate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OriginalExpiration = string(dAtA[iNdEx:postIndex])
+			m.Account = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedExpiration", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AttributeCount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2635,7 +5950,7 @@ func (m *EventAttributeExpirationUpdate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UpdatedExpiration = string(dAtA[iNdEx:postIndex])
+			m.AttributeCount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2658,7 +5973,7 @@ func (m *EventAttributeExpirationUpdate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventAttributeDelete) Unmarshal(dAtA []byte) error {
+func (m *EventAttributeParamsUpdated) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2681,15 +5996,15 @@ func (m *EventAttributeDelete) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventAttributeDelete: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventAttributeParamsUpdated: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventAttributeDelete: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventAttributeParamsUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxValueLength", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2717,11 +6032,11 @@ func (m *EventAttributeDelete) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.MaxValueLength = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxQueryResults", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2749,11 +6064,11 @@ func (m *EventAttributeDelete) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Account = string(dAtA[iNdEx:postIndex])
+			m.MaxQueryResults = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxQueryResponseBytes", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2781,61 +6096,11 @@ func (m *EventAttributeDelete) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			m.MaxQueryResponseBytes = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipAttribute(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthAttribute
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *EventAttributeDistinctDelete) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowAttribute
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EventAttributeDistinctDelete: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventAttributeDistinctDelete: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxNamesPerAccount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2863,11 +6128,11 @@ func (m *EventAttributeDistinctDelete) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.MaxNamesPerAccount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxValuesPerName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2895,11 +6160,11 @@ func (m *EventAttributeDistinctDelete) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			m.MaxValuesPerName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AttributeType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HookGasLimit", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2927,11 +6192,11 @@ func (m *EventAttributeDistinctDelete) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AttributeType = string(dAtA[iNdEx:postIndex])
+			m.HookGasLimit = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowIssuerRevocation", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2959,11 +6224,11 @@ func (m *EventAttributeDistinctDelete) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Account = string(dAtA[iNdEx:postIndex])
+			m.AllowIssuerRevocation = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationNoticeBlocks", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2991,7 +6256,7 @@ func (m *EventAttributeDistinctDelete) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			m.ExpirationNoticeBlocks = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3014,7 +6279,7 @@ func (m *EventAttributeDistinctDelete) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventAttributeExpired) Unmarshal(dAtA []byte) error {
+func (m *EventAttributeHookUpdated) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3037,10 +6302,10 @@ func (m *EventAttributeExpired) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventAttributeExpired: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventAttributeHookUpdated: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventAttributeExpired: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventAttributeHookUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3077,7 +6342,7 @@ func (m *EventAttributeExpired) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValueHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3105,11 +6370,11 @@ func (m *EventAttributeExpired) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValueHash = string(dAtA[iNdEx:postIndex])
+			m.Contract = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AttributeType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3137,43 +6402,11 @@ func (m *EventAttributeExpired) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AttributeType = string(dAtA[iNdEx:postIndex])
+			m.Mode = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAttribute
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthAttribute
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthAttribute
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Account = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3201,7 +6434,7 @@ func (m *EventAttributeExpired) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Expiration = string(dAtA[iNdEx:postIndex])
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3224,7 +6457,7 @@ func (m *EventAttributeExpired) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventAccountDataUpdated) Unmarshal(dAtA []byte) error {
+func (m *EventAttributeHookFailed) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3247,15 +6480,15 @@ func (m *EventAccountDataUpdated) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventAccountDataUpdated: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventAttributeHookFailed: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventAccountDataUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventAttributeHookFailed: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3283,61 +6516,107 @@ func (m *EventAccountDataUpdated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Account = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipAttribute(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthAttribute
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *EventAttributeParamsUpdated) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowAttribute
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
 			}
-			if iNdEx >= l {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Action = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EventAttributeParamsUpdated: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventAttributeParamsUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxValueLength", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3365,7 +6644,7 @@ func (m *EventAttributeParamsUpdated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MaxValueLength = string(dAtA[iNdEx:postIndex])
+			m.Error = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
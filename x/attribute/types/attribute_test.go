@@ -1,7 +1,10 @@
 package types
 
 import (
+	"bytes"
+	"math"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 )
@@ -180,6 +183,66 @@ func (s *AttributeTestSuite) TestAttributeValidateBasic() {
 			false,
 			"",
 		},
+		"should fail to validate basic attribute for type int64 with wrong length": {
+			Attribute{
+				Name:          "int64",
+				Value:         []byte("10"),
+				Address:       "cosmos1v57fx2l2rt6ehujuu99u2fw05779m5e2ux4z2h",
+				AttributeType: AttributeType_Int64,
+			},
+			true,
+			"invalid attribute value for assigned type: ATTRIBUTE_TYPE_INT64",
+		},
+		"should succeed to validate basic attribute for type int64": {
+			Attribute{
+				Name:          "int64",
+				Value:         Int64Value(-10),
+				Address:       "cosmos1v57fx2l2rt6ehujuu99u2fw05779m5e2ux4z2h",
+				AttributeType: AttributeType_Int64,
+			},
+			false,
+			"",
+		},
+		"should fail to validate basic attribute for type float64 nan": {
+			Attribute{
+				Name:          "float64",
+				Value:         Float64Value(math.NaN()),
+				Address:       "cosmos1v57fx2l2rt6ehujuu99u2fw05779m5e2ux4z2h",
+				AttributeType: AttributeType_Float64,
+			},
+			true,
+			"invalid attribute value for assigned type: ATTRIBUTE_TYPE_FLOAT64",
+		},
+		"should succeed to validate basic attribute for type float64": {
+			Attribute{
+				Name:          "float64",
+				Value:         Float64Value(3.5),
+				Address:       "cosmos1v57fx2l2rt6ehujuu99u2fw05779m5e2ux4z2h",
+				AttributeType: AttributeType_Float64,
+			},
+			false,
+			"",
+		},
+		"should fail to validate basic attribute for type timestamp with wrong length": {
+			Attribute{
+				Name:          "timestamp",
+				Value:         []byte("2024-01-01T00:00:00Z"),
+				Address:       "cosmos1v57fx2l2rt6ehujuu99u2fw05779m5e2ux4z2h",
+				AttributeType: AttributeType_Timestamp,
+			},
+			true,
+			"invalid attribute value for assigned type: ATTRIBUTE_TYPE_TIMESTAMP",
+		},
+		"should succeed to validate basic attribute for type timestamp": {
+			Attribute{
+				Name:          "timestamp",
+				Value:         TimestampValue(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)),
+				Address:       "cosmos1v57fx2l2rt6ehujuu99u2fw05779m5e2ux4z2h",
+				AttributeType: AttributeType_Timestamp,
+			},
+			false,
+			"",
+		},
 		"should succeed to validate basic attribute for type bytes": {
 			Attribute{
 				Name:          "bytes",
@@ -205,3 +268,90 @@ func (s *AttributeTestSuite) TestAttributeValidateBasic() {
 		})
 	}
 }
+
+func (s *AttributeTestSuite) TestNewAttributeDoesNotTrimRangeTypes() {
+	// 0x20 is a space, which would be trimmed from a string value.
+	value := Int64Value(0x20)
+	attr := NewAttribute("int64", "cosmos1v57fx2l2rt6ehujuu99u2fw05779m5e2ux4z2h", AttributeType_Int64, value, nil)
+	s.Assert().Equal(value, attr.Value)
+}
+
+func (s *AttributeTestSuite) TestParseRangeTypeValue() {
+	cases := []struct {
+		name     string
+		attrType AttributeType
+		str      string
+		expected []byte
+		expErr   string
+	}{
+		{name: "int64 positive", attrType: AttributeType_Int64, str: "42", expected: Int64Value(42)},
+		{name: "int64 negative", attrType: AttributeType_Int64, str: " -42 ", expected: Int64Value(-42)},
+		{name: "int64 not a number", attrType: AttributeType_Int64, str: "4.2", expErr: `invalid ATTRIBUTE_TYPE_INT64 value "4.2"`},
+		{name: "int64 too big", attrType: AttributeType_Int64, str: "9223372036854775808", expErr: "value out of range"},
+		{name: "float64", attrType: AttributeType_Float64, str: "-4.25", expected: Float64Value(-4.25)},
+		{name: "float64 nan", attrType: AttributeType_Float64, str: "NaN", expErr: "must be a number"},
+		{name: "float64 not a number", attrType: AttributeType_Float64, str: "abc", expErr: `invalid ATTRIBUTE_TYPE_FLOAT64 value "abc"`},
+		{
+			name:     "timestamp",
+			attrType: AttributeType_Timestamp,
+			str:      "2024-03-01T12:30:00.5Z",
+			expected: TimestampValue(time.Date(2024, 3, 1, 12, 30, 0, 500000000, time.UTC)),
+		},
+		{name: "timestamp not rfc 3339", attrType: AttributeType_Timestamp, str: "2024-03-01", expErr: `invalid ATTRIBUTE_TYPE_TIMESTAMP value "2024-03-01"`},
+		{name: "timestamp too late", attrType: AttributeType_Timestamp, str: "2300-01-01T00:00:00Z", expErr: "year must be between 1678 and 2261"},
+		{name: "string", attrType: AttributeType_String, str: "abc", expErr: "attribute type ATTRIBUTE_TYPE_STRING does not support range lookups"},
+	}
+
+	for _, tc := range cases {
+		s.Run(tc.name, func() {
+			actual, err := ParseRangeTypeValue(tc.attrType, tc.str)
+			if len(tc.expErr) > 0 {
+				s.Assert().ErrorContains(err, tc.expErr, "ParseRangeTypeValue error")
+			} else {
+				s.Assert().NoError(err, "ParseRangeTypeValue error")
+			}
+			s.Assert().Equal(tc.expected, actual, "ParseRangeTypeValue result")
+		})
+	}
+}
+
+func (s *AttributeTestSuite) TestGetRangeLookupValueOrdering() {
+	assertOrdered := func(attrType AttributeType, values [][]byte) {
+		var prev []byte
+		for i, value := range values {
+			actual, err := GetRangeLookupValue(attrType, value)
+			s.Require().NoError(err, "GetRangeLookupValue(%s, values[%d])", attrType, i)
+			s.Require().Len(actual, 8, "GetRangeLookupValue(%s, values[%d])", attrType, i)
+			if prev != nil {
+				s.Assert().Equal(-1, bytes.Compare(prev, actual), "GetRangeLookupValue(%s, values[%d]) should be after values[%d]", attrType, i, i-1)
+			}
+			prev = actual
+		}
+	}
+
+	assertOrdered(AttributeType_Int64, [][]byte{
+		Int64Value(math.MinInt64), Int64Value(-100), Int64Value(-1), Int64Value(0), Int64Value(1), Int64Value(100), Int64Value(math.MaxInt64),
+	})
+	assertOrdered(AttributeType_Float64, [][]byte{
+		Float64Value(math.Inf(-1)), Float64Value(-1e100), Float64Value(-2.5), Float64Value(-1e-100), Float64Value(0),
+		Float64Value(1e-100), Float64Value(2.5), Float64Value(1e100), Float64Value(math.Inf(1)),
+	})
+	assertOrdered(AttributeType_Timestamp, [][]byte{
+		TimestampValue(time.Date(1900, 1, 1, 0, 0, 0, 0, time.UTC)),
+		TimestampValue(time.Date(1969, 12, 31, 23, 59, 59, 999999999, time.UTC)),
+		TimestampValue(time.Unix(0, 0)),
+		TimestampValue(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)),
+		TimestampValue(time.Date(2024, 1, 1, 0, 0, 0, 1, time.UTC)),
+	})
+
+	negZero, err := GetRangeLookupValue(AttributeType_Float64, Float64Value(math.Copysign(0, -1)))
+	s.Require().NoError(err, "GetRangeLookupValue(-0)")
+	zero, err := GetRangeLookupValue(AttributeType_Float64, Float64Value(0))
+	s.Require().NoError(err, "GetRangeLookupValue(0)")
+	s.Assert().Equal(zero, negZero, "range lookup values of 0 and -0")
+
+	_, err = GetRangeLookupValue(AttributeType_Int, []byte("1"))
+	s.Assert().EqualError(err, "attribute type ATTRIBUTE_TYPE_INT does not support range lookups")
+	_, err = GetRangeLookupValue(AttributeType_Int64, []byte("1"))
+	s.Assert().EqualError(err, "invalid attribute value for assigned type: ATTRIBUTE_TYPE_INT64")
+}
@@ -0,0 +1,115 @@
+package types
+
+import (
+	"bytes"
+	"compress/flate"
+	"errors"
+	"fmt"
+	"io"
+
+	pinnedflate "github.com/klauspost/compress/flate"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+const (
+	// CompressionThreshold is the value length (in bytes) above which an attribute value is compressed in state.
+	CompressionThreshold = 1024
+
+	// compressedRecordMarker is the first byte of a stored attribute record with a compressed value.
+	// A proto encoded attribute can never start with 0x00 since field number 0 is not allowed,
+	// so records stored before compression was added can be read as-is.
+	compressedRecordMarker byte = 0x00
+)
+
+// ValueCodec identifies how an attribute value is encoded in state.
+type ValueCodec byte
+
+const (
+	// ValueCodecNone is for values that are stored as provided.
+	ValueCodecNone ValueCodec = 0x00
+	// ValueCodecDeflate is for values that are stored compressed with DEFLATE (RFC 1951).
+	ValueCodecDeflate ValueCodec = 0x01
+)
+
+// String returns a human-readable name of this codec.
+func (c ValueCodec) String() string {
+	switch c {
+	case ValueCodecNone:
+		return "none"
+	case ValueCodecDeflate:
+		return "deflate"
+	default:
+		return fmt.Sprintf("unknown(0x%02x)", byte(c))
+	}
+}
+
+// MarshalStoredAttribute encodes an attribute for storage in state.
+// Values longer than CompressionThreshold are compressed, and stored as [marker][codec][proto attribute].
+// A value is only compressed if doing so actually makes it smaller. Otherwise, it's just the proto attribute.
+func MarshalStoredAttribute(cdc codec.BinaryCodec, attr Attribute) ([]byte, error) {
+	if len(attr.Value) > CompressionThreshold {
+		compressed, err := compressValue(attr.Value)
+		if err != nil {
+			return nil, fmt.Errorf("could not compress value of attribute %q: %w", attr.Name, err)
+		}
+		if len(compressed) < len(attr.Value) {
+			attr.Value = compressed
+			bz, err := cdc.Marshal(&attr)
+			if err != nil {
+				return nil, err
+			}
+			return append([]byte{compressedRecordMarker, byte(ValueCodecDeflate)}, bz...), nil
+		}
+	}
+	return cdc.Marshal(&attr)
+}
+
+// UnmarshalStoredAttribute decodes an attribute from state, decompressing its value if needed.
+func UnmarshalStoredAttribute(cdc codec.BinaryCodec, bz []byte, attr *Attribute) error {
+	if len(bz) == 0 || bz[0] != compressedRecordMarker {
+		return cdc.Unmarshal(bz, attr)
+	}
+	if len(bz) < 2 {
+		return errors.New("stored attribute record is missing its value codec")
+	}
+	valueCodec := ValueCodec(bz[1])
+	if valueCodec != ValueCodecDeflate {
+		return fmt.Errorf("unsupported attribute value codec %s", valueCodec)
+	}
+	if err := cdc.Unmarshal(bz[2:], attr); err != nil {
+		return err
+	}
+	value, err := decompressValue(attr.Value)
+	if err != nil {
+		return fmt.Errorf("could not decompress value of attribute %q: %w", attr.Name, err)
+	}
+	attr.Value = value
+	return nil
+}
+
+// compressValue compresses the provided value using DEFLATE.
+// The compressed bytes are stored in state, so every node must produce exactly the same ones. The standard library's
+// compressor isn't used for this since its output can change between Go versions. This one is pinned in go.mod, and
+// its output is checked against known results in the unit tests, so any change is caught before it's released.
+func compressValue(value []byte) ([]byte, error) {
+	var buf bytes.Buffer
+	w, err := pinnedflate.NewWriter(&buf, pinnedflate.BestCompression)
+	if err != nil {
+		return nil, err
+	}
+	if _, err = w.Write(value); err != nil {
+		return nil, err
+	}
+	if err = w.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// decompressValue decompresses the provided DEFLATE compressed value.
+func decompressValue(compressed []byte) ([]byte, error) {
+	r := flate.NewReader(bytes.NewReader(compressed))
+	defer r.Close()
+	return io.ReadAll(r)
+}
@@ -0,0 +1,133 @@
+package types_test
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+
+	"github.com/provenance-io/provenance/testutil/assertions"
+	. "github.com/provenance-io/provenance/x/attribute/types"
+)
+
+func TestMarshalStoredAttribute(t *testing.T) {
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	newAttr := func(value []byte) Attribute {
+		return Attribute{
+			Name:          "example.attribute",
+			Value:         value,
+			AttributeType: AttributeType_Bytes,
+			Address:       "cosmos1v57fx2l2rt6ehujuu99u2fw05779m5e2ux4z2h",
+		}
+	}
+	// A value that doesn't compress well: each byte is different from the ones around it.
+	incompressible := make([]byte, CompressionThreshold+1)
+	x := uint32(2463534242)
+	for i := range incompressible {
+		x ^= x << 13
+		x ^= x >> 17
+		x ^= x << 5
+		incompressible[i] = byte(x)
+	}
+
+	tests := []struct {
+		name        string
+		value       []byte
+		expCompress bool
+	}{
+		{name: "empty value", value: nil},
+		{name: "small value", value: []byte("small")},
+		{name: "at threshold", value: []byte(strings.Repeat("a", CompressionThreshold))},
+		{name: "above threshold", value: []byte(strings.Repeat("a", CompressionThreshold+1)), expCompress: true},
+		{name: "large json", value: []byte(`[` + strings.Repeat(`{"kyc":true},`, 200) + `{}]`), expCompress: true},
+		{name: "above threshold incompressible", value: incompressible},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			attr := newAttr(tc.value)
+			bz, err := MarshalStoredAttribute(cdc, attr)
+			require.NoError(t, err, "MarshalStoredAttribute")
+			if tc.expCompress {
+				assert.Equal(t, []byte{0x00, byte(ValueCodecDeflate)}, bz[:2], "stored record marker and codec")
+				assert.Less(t, len(bz), len(tc.value), "stored record length")
+			} else {
+				legacy, lErr := cdc.Marshal(&attr)
+				require.NoError(t, lErr, "cdc.Marshal")
+				assert.Equal(t, legacy, bz, "stored record")
+			}
+
+			var actual Attribute
+			err = UnmarshalStoredAttribute(cdc, bz, &actual)
+			require.NoError(t, err, "UnmarshalStoredAttribute")
+			assert.Equal(t, attr.Name, actual.Name, "Name")
+			assert.Equal(t, len(attr.Value), len(actual.Value), "Value length")
+			assert.Equal(t, attr.Value, actual.Value, "Value")
+		})
+	}
+}
+
+func TestMarshalStoredAttributeCompressedValue(t *testing.T) {
+	// The compressed values are stored in state, so they must never change for the same input.
+	// If this test fails after a dependency update, the update is consensus breaking, and can't be used as-is.
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	tests := []struct {
+		name     string
+		value    string
+		expValue string
+	}{
+		{
+			name:     "repeated letter",
+			value:    strings.Repeat("a", CompressionThreshold+1),
+			expValue: "4a1c05a360148c5c001800",
+		},
+		{
+			name:     "large json",
+			value:    `[` + strings.Repeat(`{"kyc":true},`, 200) + `{}]`,
+			expValue: "8aae56caae4c56b22a292a4dadd519e58c724639a39c51ce28679433ca19e58c72609cda58c000",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			attr := Attribute{Name: "example.attribute", Value: []byte(tc.value), AttributeType: AttributeType_String}
+			bz, err := MarshalStoredAttribute(cdc, attr)
+			require.NoError(t, err, "MarshalStoredAttribute")
+			require.Equal(t, []byte{0x00, byte(ValueCodecDeflate)}, bz[:2], "stored record marker and codec")
+			var stored Attribute
+			require.NoError(t, cdc.Unmarshal(bz[2:], &stored), "cdc.Unmarshal")
+			assert.Equal(t, tc.expValue, hex.EncodeToString(stored.Value), "stored compressed value")
+		})
+	}
+}
+
+func TestUnmarshalStoredAttributeErrors(t *testing.T) {
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	tests := []struct {
+		name   string
+		bz     []byte
+		expErr string
+	}{
+		{name: "marker without codec", bz: []byte{0x00}, expErr: "stored attribute record is missing its value codec"},
+		{name: "uncompressed codec", bz: []byte{0x00, 0x00}, expErr: "unsupported attribute value codec none"},
+		{name: "unknown codec", bz: []byte{0x00, 0x07}, expErr: "unsupported attribute value codec unknown(0x07)"},
+		{
+			name:   "bad compressed value",
+			bz:     []byte{0x00, byte(ValueCodecDeflate), 0x0a, 0x01, 'a', 0x12, 0x02, 0xff, 0xff},
+			expErr: `could not decompress value of attribute "a": flate: corrupt input before offset 1`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var attr Attribute
+			err := UnmarshalStoredAttribute(cdc, tc.bz, &attr)
+			assertions.AssertErrorValue(t, err, tc.expErr, "UnmarshalStoredAttribute")
+		})
+	}
+}
@@ -2,6 +2,7 @@ package types
 
 import (
 	"encoding/base64"
+	"encoding/hex"
 	"strconv"
 	time "time"
 )
@@ -123,6 +124,76 @@ func NewEventAttributeExpired(attribute Attribute) *EventAttributeExpired {
 	}
 }
 
+// NewEventAttributeExpiring creates a new EventAttributeExpiring for an attribute that will expire soon.
+func NewEventAttributeExpiring(attribute Attribute) *EventAttributeExpiring {
+	var expiration string
+	if attribute.ExpirationDate != nil {
+		expiration = attribute.ExpirationDate.String()
+	}
+
+	return &EventAttributeExpiring{
+		Name:          attribute.Name,
+		ValueHash:     string(attribute.Hash()),
+		AttributeType: attribute.AttributeType.String(),
+		Account:       attribute.Address,
+		Expiration:    expiration,
+	}
+}
+
+func NewEventAttributeRevoked(attribute Attribute, issuer string, reason string) *EventAttributeRevoked {
+	return &EventAttributeRevoked{
+		Name:          attribute.Name,
+		ValueHash:     hex.EncodeToString(attribute.Hash()),
+		AttributeType: attribute.AttributeType.String(),
+		Account:       attribute.Address,
+		Issuer:        issuer,
+		Reason:        reason,
+	}
+}
+
+func NewEventAttributeUnlistedUpdated(account string, name string, unlisted bool) *EventAttributeUnlistedUpdated {
+	return &EventAttributeUnlistedUpdated{
+		Account:  account,
+		Name:     name,
+		Unlisted: strconv.FormatBool(unlisted),
+	}
+}
+
 func NewEventAttributeParamsUpdated(params Params) *EventAttributeParamsUpdated {
-	return &EventAttributeParamsUpdated{MaxValueLength: strconv.FormatUint(uint64(params.MaxValueLength), 10)}
+	return &EventAttributeParamsUpdated{
+		MaxValueLength:         strconv.FormatUint(uint64(params.MaxValueLength), 10),
+		MaxQueryResults:        strconv.FormatUint(uint64(params.MaxQueryResults), 10),
+		MaxQueryResponseBytes:  strconv.FormatUint(params.MaxQueryResponseBytes, 10),
+		MaxNamesPerAccount:     strconv.FormatUint(uint64(params.MaxNamesPerAccount), 10),
+		MaxValuesPerName:       strconv.FormatUint(uint64(params.MaxValuesPerName), 10),
+		HookGasLimit:           strconv.FormatUint(params.HookGasLimit, 10),
+		AllowIssuerRevocation:  strconv.FormatBool(params.AllowIssuerRevocation),
+		ExpirationNoticeBlocks: strconv.FormatUint(uint64(params.ExpirationNoticeBlocks), 10),
+	}
+}
+
+func NewEventAttributeHookUpdated(hook AttributeHook, owner string) *EventAttributeHookUpdated {
+	return &EventAttributeHookUpdated{
+		Name:     hook.Name,
+		Contract: hook.Contract,
+		Mode:     hook.Mode.String(),
+		Owner:    owner,
+	}
+}
+
+func NewEventAttributeHookFailed(hook AttributeHook, action string, account string, err error) *EventAttributeHookFailed {
+	return &EventAttributeHookFailed{
+		Name:     hook.Name,
+		Contract: hook.Contract,
+		Action:   action,
+		Account:  account,
+		Error:    err.Error(),
+	}
+}
+
+func NewEventAccountAttributesPurged(account string, attributeCount int) *EventAccountAttributesPurged {
+	return &EventAccountAttributesPurged{
+		Account:        account,
+		AttributeCount: strconv.Itoa(attributeCount),
+	}
 }
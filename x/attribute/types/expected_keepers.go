@@ -3,6 +3,8 @@ package types
 import (
 	"context"
 
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	nametypes "github.com/provenance-io/provenance/x/name/types"
@@ -22,6 +24,16 @@ type NameKeeper interface {
 	NameExists(ctx sdk.Context, name string) bool
 	SetAttributeKeeper(attrKeeper nametypes.AttributeKeeper)
 	SetNameRecord(ctx sdk.Context, name string, addr sdk.AccAddress, restrict bool) error
-	UpdateNameRecord(ctx sdk.Context, name string, addr sdk.AccAddress, restrict bool) error
+	UpdateNameRecord(ctx sdk.Context, name string, addr sdk.AccAddress, restrict bool, expectedCurrentAddr sdk.AccAddress) error
 	IterateRecords(ctx sdk.Context, prefix []byte, handle func(nametypes.NameRecord) error) error
 }
+
+// ContractKeeper defines the expected wasm keeper used to call attribute hook contracts.
+type ContractKeeper interface {
+	Sudo(ctx sdk.Context, contractAddress sdk.AccAddress, msg []byte) ([]byte, error)
+}
+
+// ContractInfoKeeper defines the expected wasm keeper used to look up attribute hook contracts.
+type ContractInfoKeeper interface {
+	GetContractInfo(ctx context.Context, contractAddress sdk.AccAddress) *wasmtypes.ContractInfo
+}
@@ -1,5 +1,12 @@
 package types
 
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 // NewGenesisState creates a new GenesisState object
 func NewGenesisState(params Params, attributes []Attribute) *GenesisState {
 	return &GenesisState{
@@ -15,6 +22,41 @@ func (state GenesisState) ValidateBasic() error {
 			return err
 		}
 	}
+	seen := make(map[string]bool, len(state.UnlistedAttributes))
+	for _, u := range state.UnlistedAttributes {
+		if err := u.ValidateBasic(); err != nil {
+			return err
+		}
+		key := u.Account + " " + u.Name
+		if seen[key] {
+			return fmt.Errorf("duplicate unlisted attribute %q for %s", u.Name, u.Account)
+		}
+		seen[key] = true
+	}
+	seenHooks := make(map[string]bool, len(state.AttributeHooks))
+	for _, h := range state.AttributeHooks {
+		if err := h.ValidateBasic(); err != nil {
+			return err
+		}
+		if seenHooks[h.Name] {
+			return fmt.Errorf("duplicate attribute hook for %q", h.Name)
+		}
+		seenHooks[h.Name] = true
+	}
+	return nil
+}
+
+// ValidateBasic returns an error if this unlisted attribute entry is not valid.
+func (u UnlistedAttribute) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(u.Account); err != nil {
+		return fmt.Errorf("invalid unlisted attribute account %q: %w", u.Account, err)
+	}
+	if len(strings.TrimSpace(u.Name)) == 0 {
+		return fmt.Errorf("invalid unlisted attribute name: empty")
+	}
+	if u.Name != strings.ToLower(strings.TrimSpace(u.Name)) {
+		return fmt.Errorf("invalid unlisted attribute name %q: must be lowercase without surrounding whitespace", u.Name)
+	}
 	return nil
 }
 
@@ -29,6 +29,10 @@ type GenesisState struct {
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 	// deposits defines all the deposits present at genesis.
 	Attributes []Attribute `protobuf:"bytes,2,rep,name=attributes,proto3" json:"attributes"`
+	// unlisted_attributes defines all the attribute names that accounts have marked as unlisted.
+	UnlistedAttributes []UnlistedAttribute `protobuf:"bytes,3,rep,name=unlisted_attributes,json=unlistedAttributes,proto3" json:"unlisted_attributes"`
+	// attribute_hooks defines all the registered attribute hooks.
+	AttributeHooks []AttributeHook `protobuf:"bytes,4,rep,name=attribute_hooks,json=attributeHooks,proto3" json:"attribute_hooks"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -64,8 +68,64 @@ func (m *GenesisState) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_GenesisState proto.InternalMessageInfo
 
+// UnlistedAttribute identifies an attribute name that an account has marked as unlisted.
+type UnlistedAttribute struct {
+	// account is the address of the account that has the attribute.
+	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	// name is the attribute name.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (m *UnlistedAttribute) Reset()         { *m = UnlistedAttribute{} }
+func (m *UnlistedAttribute) String() string { return proto.CompactTextString(m) }
+func (*UnlistedAttribute) ProtoMessage()    {}
+func (*UnlistedAttribute) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7690f9b78d391c2d, []int{1}
+}
+func (m *UnlistedAttribute) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UnlistedAttribute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UnlistedAttribute.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UnlistedAttribute) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnlistedAttribute.Merge(m, src)
+}
+func (m *UnlistedAttribute) XXX_Size() int {
+	return m.Size()
+}
+func (m *UnlistedAttribute) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnlistedAttribute.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnlistedAttribute proto.InternalMessageInfo
+
+func (m *UnlistedAttribute) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+func (m *UnlistedAttribute) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "provenance.attribute.v1.GenesisState")
+	proto.RegisterType((*UnlistedAttribute)(nil), "provenance.attribute.v1.UnlistedAttribute")
 }
 
 func init() {
@@ -73,23 +133,29 @@ func init() {
 }
 
 var fileDescriptor_7690f9b78d391c2d = []byte{
-	// 247 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x2d, 0x28, 0xca, 0x2f,
-	0x4b, 0xcd, 0x4b, 0xcc, 0x4b, 0x4e, 0xd5, 0x4f, 0x2c, 0x29, 0x29, 0xca, 0x4c, 0x2a, 0x2d, 0x49,
-	0xd5, 0x2f, 0x33, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f,
-	0xc9, 0x17, 0x12, 0x47, 0x28, 0xd3, 0x83, 0x2b, 0xd3, 0x2b, 0x33, 0x94, 0x12, 0x49, 0xcf, 0x4f,
-	0xcf, 0x07, 0xab, 0xd1, 0x07, 0xb1, 0x20, 0xca, 0xa5, 0xd4, 0x71, 0x99, 0x8a, 0xd0, 0x0b, 0x56,
-	0xa8, 0xb4, 0x90, 0x91, 0x8b, 0xc7, 0x1d, 0x62, 0x53, 0x70, 0x49, 0x62, 0x49, 0xaa, 0x90, 0x2d,
-	0x17, 0x5b, 0x41, 0x62, 0x51, 0x62, 0x6e, 0xb1, 0x04, 0xa3, 0x02, 0xa3, 0x06, 0xb7, 0x91, 0xbc,
-	0x1e, 0x0e, 0x9b, 0xf5, 0x02, 0xc0, 0xca, 0x9c, 0x58, 0x4e, 0xdc, 0x93, 0x67, 0x08, 0x82, 0x6a,
-	0x12, 0xf2, 0xe0, 0xe2, 0x82, 0x2b, 0x2a, 0x96, 0x60, 0x52, 0x60, 0xd6, 0xe0, 0x36, 0x52, 0xc2,
-	0x69, 0x84, 0x23, 0x8c, 0x03, 0x35, 0x05, 0x49, 0xaf, 0x15, 0x47, 0xc7, 0x02, 0x79, 0x86, 0x17,
-	0x0b, 0xe4, 0x19, 0x9c, 0x72, 0x4f, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23,
-	0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x81, 0x4b,
-	0x2a, 0x33, 0x1f, 0x97, 0xd9, 0x01, 0x8c, 0x51, 0xa6, 0xe9, 0x99, 0x25, 0x19, 0xa5, 0x49, 0x7a,
-	0xc9, 0xf9, 0xb9, 0xfa, 0x08, 0x55, 0xba, 0x99, 0xf9, 0x48, 0x3c, 0xfd, 0x0a, 0xa4, 0xf0, 0x29,
-	0xa9, 0x2c, 0x48, 0x2d, 0x4e, 0x62, 0x03, 0x87, 0x8c, 0x31, 0x20, 0x00, 0x00, 0xff, 0xff, 0x2c,
-	0xfc, 0x9c, 0x6e, 0x9a, 0x01, 0x00, 0x00,
+	// 346 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0xc1, 0x4a, 0xf3, 0x40,
+	0x10, 0xc7, 0x93, 0xb6, 0xf4, 0xfb, 0xdc, 0x8a, 0xe2, 0x2a, 0x18, 0x7a, 0x48, 0x4a, 0x41, 0x2d,
+	0x82, 0x59, 0x5a, 0xf1, 0x22, 0x78, 0x68, 0x2f, 0xf6, 0x58, 0x2a, 0xbd, 0x78, 0x29, 0xdb, 0xb8,
+	0xa4, 0x4b, 0xcd, 0x4e, 0xc8, 0x6e, 0x8a, 0xbe, 0x81, 0x47, 0x1f, 0xa1, 0x8f, 0xd3, 0x63, 0x8f,
+	0x82, 0x20, 0xd2, 0x5e, 0x7c, 0x0c, 0x71, 0x9b, 0xb6, 0xa1, 0x12, 0xbc, 0xed, 0x4c, 0x7e, 0xff,
+	0xdf, 0x0c, 0x61, 0xd0, 0x49, 0x18, 0xc1, 0x98, 0x09, 0x2a, 0x3c, 0x46, 0xa8, 0x52, 0x11, 0x1f,
+	0xc4, 0x8a, 0x91, 0x71, 0x9d, 0xf8, 0x4c, 0x30, 0xc9, 0xa5, 0x1b, 0x46, 0xa0, 0x00, 0x1f, 0x6f,
+	0x30, 0x77, 0x8d, 0xb9, 0xe3, 0x7a, 0xf9, 0xc8, 0x07, 0x1f, 0x34, 0x43, 0x7e, 0x5e, 0x4b, 0xbc,
+	0x7c, 0x96, 0x65, 0xdd, 0x64, 0x35, 0x58, 0x7d, 0xcf, 0xa1, 0xdd, 0xdb, 0xe5, 0xa4, 0x3b, 0x45,
+	0x15, 0xc3, 0x37, 0xa8, 0x18, 0xd2, 0x88, 0x06, 0xd2, 0x32, 0x2b, 0x66, 0xad, 0xd4, 0x70, 0xdc,
+	0x8c, 0xc9, 0x6e, 0x47, 0x63, 0xad, 0xc2, 0xf4, 0xc3, 0x31, 0xba, 0x49, 0x08, 0xb7, 0x11, 0x5a,
+	0x43, 0xd2, 0xca, 0x55, 0xf2, 0xb5, 0x52, 0xa3, 0x9a, 0xa9, 0x68, 0xae, 0x8a, 0xc4, 0x92, 0xca,
+	0x62, 0x8a, 0x0e, 0x63, 0xf1, 0xc8, 0xa5, 0x62, 0x0f, 0xfd, 0x94, 0x32, 0xaf, 0x95, 0xe7, 0x99,
+	0xca, 0x5e, 0x92, 0xd9, 0x56, 0xe3, 0x78, 0xfb, 0x83, 0xc4, 0x3d, 0xb4, 0xbf, 0xce, 0xf6, 0x87,
+	0x00, 0x23, 0x69, 0x15, 0xb4, 0xfe, 0xf4, 0xef, 0x8d, 0xdb, 0x00, 0xa3, 0x44, 0xbd, 0x47, 0xd3,
+	0x4d, 0x79, 0xfd, 0xff, 0x65, 0xe2, 0x18, 0x5f, 0x13, 0xc7, 0xa8, 0x36, 0xd1, 0xc1, 0xaf, 0x7d,
+	0xb0, 0x85, 0xfe, 0x51, 0xcf, 0x83, 0x58, 0x28, 0xfd, 0x8b, 0x77, 0xba, 0xab, 0x12, 0x63, 0x54,
+	0x10, 0x34, 0x60, 0x56, 0x4e, 0xb7, 0xf5, 0xbb, 0x15, 0x4c, 0xe7, 0xb6, 0x39, 0x9b, 0xdb, 0xe6,
+	0xe7, 0xdc, 0x36, 0x5f, 0x17, 0xb6, 0x31, 0x5b, 0xd8, 0xc6, 0xdb, 0xc2, 0x36, 0x50, 0x99, 0x43,
+	0xd6, 0x9a, 0x1d, 0xf3, 0xfe, 0xca, 0xe7, 0x6a, 0x18, 0x0f, 0x5c, 0x0f, 0x02, 0xb2, 0xa1, 0x2e,
+	0x38, 0xa4, 0x2a, 0xf2, 0x94, 0x3a, 0x0e, 0xf5, 0x1c, 0x32, 0x39, 0x28, 0xea, 0xb3, 0xb8, 0xfc,
+	0x1e, 0x00, 0x07, 0x8b, 0xb6, 0xfc, 0x97, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -112,6 +178,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.AttributeHooks) > 0 {
+		for iNdEx := len(m.AttributeHooks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AttributeHooks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.UnlistedAttributes) > 0 {
+		for iNdEx := len(m.UnlistedAttributes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UnlistedAttributes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.Attributes) > 0 {
 		for iNdEx := len(m.Attributes) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -139,6 +233,43 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *UnlistedAttribute) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UnlistedAttribute) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnlistedAttribute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -164,6 +295,35 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.UnlistedAttributes) > 0 {
+		for _, e := range m.UnlistedAttributes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AttributeHooks) > 0 {
+		for _, e := range m.AttributeHooks {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *UnlistedAttribute) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
 	return n
 }
 
@@ -269,6 +429,188 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnlistedAttributes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnlistedAttributes = append(m.UnlistedAttributes, UnlistedAttribute{})
+			if err := m.UnlistedAttributes[len(m.UnlistedAttributes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttributeHooks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AttributeHooks = append(m.AttributeHooks, AttributeHook{})
+			if err := m.AttributeHooks[len(m.AttributeHooks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UnlistedAttribute) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnlistedAttribute: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnlistedAttribute: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
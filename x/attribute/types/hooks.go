@@ -0,0 +1,97 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/provenance-io/provenance/internal/bech32util"
+)
+
+const (
+	// AttributeHookActionAdd is the action provided to a hook contract when an attribute is added.
+	AttributeHookActionAdd = "add"
+	// AttributeHookActionDelete is the action provided to a hook contract when an attribute is deleted.
+	AttributeHookActionDelete = "delete"
+)
+
+// NewAttributeHook creates a new instance of an AttributeHook.
+func NewAttributeHook(name string, contract string, mode AttributeHookMode) AttributeHook {
+	return AttributeHook{
+		Name:     strings.ToLower(strings.TrimSpace(name)),
+		Contract: contract,
+		Mode:     mode,
+	}
+}
+
+// ValidateBasic returns an error if this attribute hook is not valid.
+func (h AttributeHook) ValidateBasic() error {
+	if len(strings.TrimSpace(h.Name)) == 0 {
+		return fmt.Errorf("invalid attribute hook name: empty")
+	}
+	if h.Name != strings.ToLower(strings.TrimSpace(h.Name)) {
+		return fmt.Errorf("invalid attribute hook name %q: must be lowercase without surrounding whitespace", h.Name)
+	}
+	if _, err := bech32util.ValidateAccAddress(h.Contract); err != nil {
+		return fmt.Errorf("invalid attribute hook contract %q: %w", h.Contract, err)
+	}
+	if !ValidAttributeHookMode(h.Mode) {
+		return fmt.Errorf("invalid attribute hook mode: %s", h.Mode)
+	}
+	return nil
+}
+
+// ValidAttributeHookMode returns true if the provided mode is one that a hook can have.
+func ValidAttributeHookMode(mode AttributeHookMode) bool {
+	return mode == AttributeHookMode_Notify || mode == AttributeHookMode_Veto
+}
+
+// AttributeHookModeFromString returns an AttributeHookMode from a string (e.g. "notify" or "veto").
+func AttributeHookModeFromString(str string) (AttributeHookMode, error) {
+	str = "ATTRIBUTE_HOOK_MODE_" + strings.ToUpper(str)
+	option, ok := AttributeHookMode_value[str]
+	if !ok {
+		return AttributeHookMode_Unspecified, fmt.Errorf("'%s' is not a valid attribute hook mode option", str)
+	}
+	return AttributeHookMode(option), nil
+}
+
+// AttributeHookSudoMsg is the sudo message provided to an attribute hook contract.
+type AttributeHookSudoMsg struct {
+	AttributeHook AttributeHookCall `json:"attribute_hook"`
+}
+
+// AttributeHookCall contains the details of the attribute that was added or deleted.
+type AttributeHookCall struct {
+	// Action is either "add" or "delete".
+	Action string `json:"action"`
+	// Name is the attribute name.
+	Name string `json:"name"`
+	// Account is the address the attribute is (or was) bound to.
+	Account string `json:"account"`
+	// Value is the attribute value (base64 encoded in the JSON).
+	Value []byte `json:"value"`
+	// AttributeType is the attribute value type, e.g. "ATTRIBUTE_TYPE_STRING".
+	AttributeType string `json:"attribute_type"`
+	// ExpirationDate is the time the attribute expires (if it has one).
+	ExpirationDate *time.Time `json:"expiration_date,omitempty"`
+	// Owner is the address that added or deleted the attribute.
+	// It is empty when the attribute was deleted by the chain, e.g. because it expired or its account was purged.
+	Owner string `json:"owner"`
+}
+
+// NewAttributeHookSudoMsg creates the JSON sudo message for a hook contract about an attribute being added or deleted.
+func NewAttributeHookSudoMsg(action string, attr Attribute, owner string) ([]byte, error) {
+	return json.Marshal(AttributeHookSudoMsg{
+		AttributeHook: AttributeHookCall{
+			Action:         action,
+			Name:           attr.Name,
+			Account:        attr.Address,
+			Value:          attr.Value,
+			AttributeType:  attr.AttributeType.String(),
+			ExpirationDate: attr.ExpirationDate,
+			Owner:          owner,
+		},
+	})
+}
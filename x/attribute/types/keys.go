@@ -18,6 +18,9 @@ const (
 	// StoreKey is the store key string for account
 	StoreKey = ModuleName
 
+	// TStoreKey is the transient store key string for the attribute module.
+	TStoreKey = "transient_" + ModuleName
+
 	// RouterKey is the message route for account
 	RouterKey = ModuleName
 
@@ -32,6 +35,17 @@ var (
 	AttributeAddrLookupKeyPrefix = []byte{0x03}
 	AttributeExpirationKeyPrefix = []byte{0x04}
 	AttributeParamPrefix         = []byte{0x05}
+	AttributeValueLookupPrefix   = []byte{0x06}
+	AttributeRangeLookupPrefix   = []byte{0x07}
+	UnlistedAttributeKeyPrefix   = []byte{0x08}
+	AttributeHookKeyPrefix       = []byte{0x09}
+	// AttributeExpirationNoticeKey holds the expiration key of the last attribute that an expiring notice was emitted for.
+	AttributeExpirationNoticeKey = []byte{0x0A}
+	// LastBlockTimeKey holds the time of the previous block, used to estimate how long the expiring notice period is.
+	LastBlockTimeKey = []byte{0x0B}
+
+	// NameAuthCacheKeyPrefix is the transient store prefix for cached name ownership checks.
+	NameAuthCacheKeyPrefix = []byte{0x01}
 )
 
 // AddrAttributeKey creates a key for an account attribute
@@ -80,6 +94,26 @@ func AddrStrAttributesNameKeyPrefix(addr string, attributeName string) []byte {
 	return AddrAttributesNameKeyPrefix(GetAttributeAddressBytes(addr), attributeName)
 }
 
+// UnlistedAttributeAddrKeyPrefix returns a prefix key for all unlisted attribute names of an account
+// [UnlistedAttributeKeyPrefix][length + address bytes].
+func UnlistedAttributeAddrKeyPrefix(addr []byte) []byte {
+	key := UnlistedAttributeKeyPrefix
+	return append(key, address.MustLengthPrefix(addr)...)
+}
+
+// UnlistedAttributeKey returns the key indicating that an account has marked an attribute name as unlisted
+// [UnlistedAttributeKeyPrefix][length + address bytes][name hash].
+func UnlistedAttributeKey(addr []byte, attributeName string) []byte {
+	return append(UnlistedAttributeAddrKeyPrefix(addr), GetNameKeyBytes(attributeName)...)
+}
+
+// AttributeHookKey returns the key of the hook registered for an attribute name
+// [AttributeHookKeyPrefix][name hash].
+func AttributeHookKey(attributeName string) []byte {
+	key := AttributeHookKeyPrefix
+	return append(key, GetNameKeyBytes(attributeName)...)
+}
+
 // AttributeNameKeyPrefix returns a prefix key for all addresses with attribute name
 func AttributeNameKeyPrefix(attributeName string) []byte {
 	key := AttributeAddrLookupKeyPrefix
@@ -93,6 +127,53 @@ func AttributeNameAddrKeyPrefix(attributeName string, addr []byte) []byte {
 	return append(key, address.MustLengthPrefix(addr)...)
 }
 
+// AttributeNameValueKeyPrefix returns a prefix key for all addresses with an attribute name and value hash
+// [AttributeValueLookupPrefix][name hash][value hash].
+func AttributeNameValueKeyPrefix(attributeName string, valueHash []byte) []byte {
+	key := AttributeValueLookupPrefix
+	key = append(key, GetNameKeyBytes(attributeName)...)
+	return append(key, valueHash...)
+}
+
+// AttributeNameValueAddrKey returns the value lookup key for an attribute
+// [AttributeValueLookupPrefix][name hash][value hash][length + address bytes].
+func AttributeNameValueAddrKey(attributeName string, valueHash []byte, addr []byte) []byte {
+	return append(AttributeNameValueKeyPrefix(attributeName, valueHash), address.MustLengthPrefix(addr)...)
+}
+
+// AttributeRangeKeyPrefix returns a prefix key for all range lookup entries for an attribute name and value type
+// [AttributeRangeLookupPrefix][name hash][attribute type].
+func AttributeRangeKeyPrefix(attributeName string, attrType AttributeType) []byte {
+	key := AttributeRangeLookupPrefix
+	key = append(key, GetNameKeyBytes(attributeName)...)
+	return append(key, byte(attrType))
+}
+
+// AttributeRangeAddrKey returns the range lookup key for an attribute
+// [AttributeRangeLookupPrefix][name hash][attribute type][range lookup value][length + address bytes].
+func AttributeRangeAddrKey(attributeName string, attrType AttributeType, rangeValue []byte, addr []byte) []byte {
+	key := AttributeRangeKeyPrefix(attributeName, attrType)
+	key = append(key, rangeValue...)
+	return append(key, address.MustLengthPrefix(addr)...)
+}
+
+// GetValueHashFromAddrAttributeKey returns the value hash from a full attribute key ([prefix][length + address bytes][name hash][value hash]).
+func GetValueHashFromAddrAttributeKey(key []byte) []byte {
+	return key[len(key)-sha256.Size:]
+}
+
+// NameAuthCacheNameKeyPrefix returns a transient store prefix for all cached ownership checks of an attribute name.
+func NameAuthCacheNameKeyPrefix(attributeName string) []byte {
+	key := NameAuthCacheKeyPrefix
+	return append(key, GetNameKeyBytes(attributeName)...)
+}
+
+// NameAuthCacheKey returns the transient store key for the cached ownership check of an attribute name and owner
+// [NameAuthCacheKeyPrefix][name hash][length + owner bytes].
+func NameAuthCacheKey(attributeName string, owner []byte) []byte {
+	return append(NameAuthCacheNameKeyPrefix(attributeName), address.MustLengthPrefix(owner)...)
+}
+
 // GetAddressFromKey returns the AccAddress from full attribute address key ([prefix][name hash][length + AccAddress bytes][attribute hash])
 func GetAddressFromKey(nameAddrKey []byte) (sdk.AccAddress, error) {
 	// start index of slice is [prefix (1)] + [name hash (32)] + [address len prefix (1)]
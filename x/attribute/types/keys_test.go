@@ -70,6 +70,39 @@ func TestAddrAttributeKey(t *testing.T) {
 	assert.Equal(t, expected, actual)
 }
 
+func TestAttributeNameValueAddrKey(t *testing.T) {
+	attr1 := Attribute{
+		Name:          "long.address.name",
+		Value:         []byte("0123456789"),
+		Address:       "cosmos1qyqszqgpqyqszqgpqyqszqgpqyqszqgpqyqszqgpqyqszqgpqyqs2m6sx4",
+		AttributeType: AttributeType_String,
+	}
+	actual := AttributeNameValueAddrKey(attr1.Name, attr1.Hash(), attr1.GetAddressBytes())
+	expected := AttributeValueLookupPrefix
+	expected = append(expected, GetNameKeyBytes(attr1.Name)...)
+	expected = append(expected, attr1.Hash()...)
+	assert.Equal(t, expected, AttributeNameValueKeyPrefix(attr1.Name, attr1.Hash()), "AttributeNameValueKeyPrefix")
+	expected = append(expected, address.MustLengthPrefix(attr1.GetAddressBytes())...)
+	assert.Equal(t, expected, actual, "AttributeNameValueAddrKey")
+
+	attrKey := AddrAttributeKey(attr1.GetAddressBytes(), attr1)
+	assert.Equal(t, attr1.Hash(), GetValueHashFromAddrAttributeKey(attrKey), "GetValueHashFromAddrAttributeKey")
+}
+
+func TestAttributeRangeAddrKey(t *testing.T) {
+	name := "long.address.name"
+	addr := GetAttributeAddressBytes("cosmos1qyqszqgpqyqszqgpqyqszqgpqyqszqgpqyqszqgpqyqszqgpqyqs2m6sx4")
+	rangeValue := []byte{0x80, 0, 0, 0, 0, 0, 0, 0x2a}
+	actual := AttributeRangeAddrKey(name, AttributeType_Int64, rangeValue, addr)
+	expected := AttributeRangeLookupPrefix
+	expected = append(expected, GetNameKeyBytes(name)...)
+	expected = append(expected, byte(AttributeType_Int64))
+	assert.Equal(t, expected, AttributeRangeKeyPrefix(name, AttributeType_Int64), "AttributeRangeKeyPrefix")
+	expected = append(expected, rangeValue...)
+	expected = append(expected, address.MustLengthPrefix(addr)...)
+	assert.Equal(t, expected, actual, "AttributeRangeAddrKey")
+}
+
 func TestGetAddrAttributeKeyFromExpireKey(t *testing.T) {
 	now := time.Now()
 	attr1 := Attribute{
@@ -1,11 +1,14 @@
 package types
 
 import (
+	"crypto/sha256"
 	"fmt"
 	"strings"
 	time "time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/internal/bech32util"
 )
 
 // AllRequestMsgs defines all the Msg*Request messages.
@@ -16,7 +19,11 @@ var AllRequestMsgs = []sdk.Msg{
 	(*MsgDeleteAttributeRequest)(nil),
 	(*MsgDeleteDistinctAttributeRequest)(nil),
 	(*MsgSetAccountDataRequest)(nil),
+	(*MsgSetAttributeUnlistedRequest)(nil),
+	(*MsgSetAttributeHookRequest)(nil),
+	(*MsgRevokeAttributeRequest)(nil),
 	(*MsgUpdateParamsRequest)(nil),
+	(*MsgPurgeOrphanedAttributesRequest)(nil),
 }
 
 func NewMsgAddAttributeRequest(account string, owner sdk.AccAddress, name string, attributeType AttributeType, value []byte) *MsgAddAttributeRequest {
@@ -33,7 +40,7 @@ func (msg MsgAddAttributeRequest) ValidateBasic() error {
 	if len(msg.Owner) == 0 {
 		return fmt.Errorf("empty owner address")
 	}
-	if _, err := sdk.AccAddressFromBech32(msg.Owner); err != nil {
+	if _, err := bech32util.ValidateAccAddress(msg.Owner); err != nil {
 		return err
 	}
 	a := NewAttribute(msg.Name, msg.Account, msg.AttributeType, msg.Value, msg.ExpirationDate)
@@ -56,7 +63,10 @@ func (msg MsgUpdateAttributeRequest) ValidateBasic() error {
 	if len(msg.Owner) == 0 {
 		return fmt.Errorf("empty owner address")
 	}
-	if _, err := sdk.AccAddressFromBech32(msg.Owner); err != nil {
+	if _, err := bech32util.ValidateAccAddress(msg.Owner); err != nil {
+		return err
+	}
+	if err := ValidateOriginalValueHash(msg.OriginalValueHash); err != nil {
 		return err
 	}
 	a := NewAttribute(msg.Name, msg.Account, msg.UpdateAttributeType, msg.UpdateValue, nil)
@@ -74,7 +84,7 @@ func NewMsgUpdateAttributeExpirationRequest(account, name, value string, expirat
 }
 
 func (msg MsgUpdateAttributeExpirationRequest) ValidateBasic() error {
-	if _, err := sdk.AccAddressFromBech32(msg.Owner); err != nil {
+	if _, err := bech32util.ValidateAccAddress(msg.Owner); err != nil {
 		return err
 	}
 	if strings.TrimSpace(msg.Name) == "" {
@@ -109,10 +119,10 @@ func (msg MsgDeleteAttributeRequest) ValidateBasic() error {
 	if len(msg.Owner) == 0 {
 		return fmt.Errorf("empty owner address")
 	}
-	if _, err := sdk.AccAddressFromBech32(msg.Owner); err != nil {
+	if _, err := bech32util.ValidateAccAddress(msg.Owner); err != nil {
 		return err
 	}
-	return nil
+	return ValidateOriginalValueHash(msg.OriginalValueHash)
 }
 
 func NewMsgDeleteDistinctAttributeRequest(account string, owner sdk.AccAddress, name string, value []byte) *MsgDeleteDistinctAttributeRequest {
@@ -137,7 +147,7 @@ func (msg MsgDeleteDistinctAttributeRequest) ValidateBasic() error {
 	if len(msg.Owner) == 0 {
 		return fmt.Errorf("empty owner address")
 	}
-	if _, err := sdk.AccAddressFromBech32(msg.Owner); err != nil {
+	if _, err := bech32util.ValidateAccAddress(msg.Owner); err != nil {
 		return err
 	}
 	return nil
@@ -145,9 +155,91 @@ func (msg MsgDeleteDistinctAttributeRequest) ValidateBasic() error {
 
 func (msg MsgSetAccountDataRequest) ValidateBasic() error {
 	// This message is only for regular account addresses. No need to allow for scopes or others.
-	if _, err := sdk.AccAddressFromBech32(msg.Account); err != nil {
+	if _, err := bech32util.ValidateAccAddress(msg.Account); err != nil {
+		return fmt.Errorf("invalid account: %w", err)
+	}
+	return nil
+}
+
+// NewMsgSetAttributeUnlistedRequest creates a new SetAttributeUnlistedRequest message.
+func NewMsgSetAttributeUnlistedRequest(account sdk.AccAddress, name string, unlisted bool) *MsgSetAttributeUnlistedRequest {
+	return &MsgSetAttributeUnlistedRequest{
+		Account:  account.String(),
+		Name:     strings.ToLower(strings.TrimSpace(name)),
+		Unlisted: unlisted,
+	}
+}
+
+// ValidateBasic runs stateless validation checks on the message.
+func (msg MsgSetAttributeUnlistedRequest) ValidateBasic() error {
+	// Only the account itself can set this, so it must be a regular account address.
+	if _, err := bech32util.ValidateAccAddress(msg.Account); err != nil {
 		return fmt.Errorf("invalid account: %w", err)
 	}
+	return UnlistedAttribute{Account: msg.Account, Name: msg.Name}.ValidateBasic()
+}
+
+// NewMsgSetAttributeHookRequest creates a new SetAttributeHookRequest message.
+func NewMsgSetAttributeHookRequest(owner sdk.AccAddress, name string, contract string, mode AttributeHookMode) *MsgSetAttributeHookRequest {
+	return &MsgSetAttributeHookRequest{
+		Name:     strings.ToLower(strings.TrimSpace(name)),
+		Contract: contract,
+		Mode:     mode,
+		Owner:    owner.String(),
+	}
+}
+
+// ValidateBasic runs stateless validation checks on the message.
+func (msg MsgSetAttributeHookRequest) ValidateBasic() error {
+	if _, err := bech32util.ValidateAccAddress(msg.Owner); err != nil {
+		return fmt.Errorf("invalid owner: %w", err)
+	}
+	// An empty contract removes the hook, in which case there shouldn't be a mode either.
+	if len(msg.Contract) == 0 {
+		if len(strings.TrimSpace(msg.Name)) == 0 {
+			return fmt.Errorf("invalid attribute hook name: empty")
+		}
+		if msg.Mode != AttributeHookMode_Unspecified {
+			return fmt.Errorf("attribute hook mode must be unspecified when removing a hook, got %s", msg.Mode)
+		}
+		return nil
+	}
+	return msg.GetAttributeHook().ValidateBasic()
+}
+
+// GetAttributeHook returns the attribute hook that this message is setting.
+func (msg MsgSetAttributeHookRequest) GetAttributeHook() AttributeHook {
+	return AttributeHook{Name: msg.Name, Contract: msg.Contract, Mode: msg.Mode}
+}
+
+// MaxRevocationReasonLength is the maximum length of the reason provided when revoking an attribute.
+const MaxRevocationReasonLength = 256
+
+// NewMsgRevokeAttributeRequest creates a new RevokeAttributeRequest message.
+func NewMsgRevokeAttributeRequest(account string, issuer sdk.AccAddress, name string, value []byte, reason string) *MsgRevokeAttributeRequest {
+	return &MsgRevokeAttributeRequest{
+		Name:    strings.ToLower(strings.TrimSpace(name)),
+		Account: account,
+		Value:   value,
+		Issuer:  issuer.String(),
+		Reason:  reason,
+	}
+}
+
+// ValidateBasic runs stateless validation checks on the message.
+func (msg MsgRevokeAttributeRequest) ValidateBasic() error {
+	if strings.TrimSpace(msg.Name) == "" {
+		return fmt.Errorf("empty name")
+	}
+	if err := ValidateAttributeAddress(msg.Account); err != nil {
+		return fmt.Errorf("invalid account address: %w", err)
+	}
+	if _, err := bech32util.ValidateAccAddress(msg.Issuer); err != nil {
+		return fmt.Errorf("invalid issuer: %w", err)
+	}
+	if len(msg.Reason) > MaxRevocationReasonLength {
+		return fmt.Errorf("revocation reason length %d exceeds maximum length of %d", len(msg.Reason), MaxRevocationReasonLength)
+	}
 	return nil
 }
 
@@ -161,8 +253,32 @@ func NewMsgUpdateParamsRequest(authority string, maxValueLength uint32) *MsgUpda
 
 // ValidateBasic runs stateless validation checks on the message.
 func (m MsgUpdateParamsRequest) ValidateBasic() error {
-	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+	if _, err := bech32util.ValidateAccAddress(m.Authority); err != nil {
 		return fmt.Errorf("invalid authority: %w", err)
 	}
 	return nil
 }
+
+// NewMsgPurgeOrphanedAttributesRequest creates a new PurgeOrphanedAttributesRequest message.
+func NewMsgPurgeOrphanedAttributesRequest(authority string, maxAccounts uint32) *MsgPurgeOrphanedAttributesRequest {
+	return &MsgPurgeOrphanedAttributesRequest{
+		Authority:   authority,
+		MaxAccounts: maxAccounts,
+	}
+}
+
+// ValidateBasic runs stateless validation checks on the message.
+func (m MsgPurgeOrphanedAttributesRequest) ValidateBasic() error {
+	if _, err := bech32util.ValidateAccAddress(m.Authority); err != nil {
+		return fmt.Errorf("invalid authority: %w", err)
+	}
+	return nil
+}
+
+// ValidateOriginalValueHash returns an error if the provided original value hash is set, but is not a sha256 hash.
+func ValidateOriginalValueHash(hash []byte) error {
+	if len(hash) != 0 && len(hash) != sha256.Size {
+		return fmt.Errorf("invalid original value hash: expected %d bytes, got %d", sha256.Size, len(hash))
+	}
+	return nil
+}
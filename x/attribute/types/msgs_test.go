@@ -1,6 +1,8 @@
 package types_test
 
 import (
+	"crypto/sha256"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -31,7 +33,11 @@ func TestAllMsgsGetSigners(t *testing.T) {
 		func(signer string) sdk.Msg { return &MsgDeleteAttributeRequest{Owner: signer} },
 		func(signer string) sdk.Msg { return &MsgDeleteDistinctAttributeRequest{Owner: signer} },
 		func(signer string) sdk.Msg { return &MsgSetAccountDataRequest{Account: signer} },
+		func(signer string) sdk.Msg { return &MsgSetAttributeUnlistedRequest{Account: signer} },
+		func(signer string) sdk.Msg { return &MsgSetAttributeHookRequest{Owner: signer} },
+		func(signer string) sdk.Msg { return &MsgRevokeAttributeRequest{Issuer: signer} },
 		func(signer string) sdk.Msg { return &MsgUpdateParamsRequest{Authority: signer} },
+		func(signer string) sdk.Msg { return &MsgPurgeOrphanedAttributesRequest{Authority: signer} },
 	}
 
 	testutil.RunGetSignersTests(t, AllRequestMsgs, msgMakers, nil)
@@ -100,6 +106,38 @@ func TestMsgUpdateAttribute(t *testing.T) {
 	}
 }
 
+func TestOriginalValueHashValidateBasic(t *testing.T) {
+	validHash := sha256.Sum256([]byte("original"))
+	tests := []struct {
+		name   string
+		hash   []byte
+		expErr string
+	}{
+		{name: "nil", hash: nil},
+		{name: "empty", hash: []byte{}},
+		{name: "valid", hash: validHash[:]},
+		{name: "too short", hash: validHash[:31], expErr: "invalid original value hash: expected 32 bytes, got 31"},
+		{name: "too long", hash: append(validHash[:], 0), expErr: "invalid original value hash: expected 32 bytes, got 33"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			updateMsg := NewMsgUpdateAttributeRequest(addrs[0].String(), addrs[1], "example", []byte("original"), []byte("update"), AttributeType_String, AttributeType_String)
+			updateMsg.OriginalValueHash = tc.hash
+			deleteMsg := NewMsgDeleteAttributeRequest(addrs[0].String(), addrs[1], "example")
+			deleteMsg.OriginalValueHash = tc.hash
+
+			if len(tc.expErr) > 0 {
+				assert.EqualError(t, updateMsg.ValidateBasic(), tc.expErr, "MsgUpdateAttributeRequest.ValidateBasic")
+				assert.EqualError(t, deleteMsg.ValidateBasic(), tc.expErr, "MsgDeleteAttributeRequest.ValidateBasic")
+			} else {
+				assert.NoError(t, updateMsg.ValidateBasic(), "MsgUpdateAttributeRequest.ValidateBasic")
+				assert.NoError(t, deleteMsg.ValidateBasic(), "MsgDeleteAttributeRequest.ValidateBasic")
+			}
+		})
+	}
+}
+
 // test ValidateBasic for TestMsgDeleteDistinctAttribute
 func TestMsgDeleteDistinctAttribute(t *testing.T) {
 	tests := []struct {
@@ -161,6 +199,176 @@ func TestMsgSetAccountDataRequest_ValidateBasic(t *testing.T) {
 	}
 }
 
+func TestMsgSetAttributeUnlistedRequest_ValidateBasic(t *testing.T) {
+	addr := sdk.AccAddress("unlisted").String()
+	tests := []struct {
+		name string
+		msg  MsgSetAttributeUnlistedRequest
+		exp  string
+	}{
+		{
+			name: "control",
+			msg:  MsgSetAttributeUnlistedRequest{Account: addr, Name: "kyc.attest", Unlisted: true},
+			exp:  "",
+		},
+		{
+			name: "not unlisted",
+			msg:  MsgSetAttributeUnlistedRequest{Account: addr, Name: "kyc.attest", Unlisted: false},
+			exp:  "",
+		},
+		{
+			name: "bad account",
+			msg:  MsgSetAttributeUnlistedRequest{Account: "notabech32", Name: "kyc.attest", Unlisted: true},
+			exp:  "invalid account: decoding bech32 failed: invalid separator index -1",
+		},
+		{
+			name: "empty name",
+			msg:  MsgSetAttributeUnlistedRequest{Account: addr, Name: " ", Unlisted: true},
+			exp:  "invalid unlisted attribute name: empty",
+		},
+		{
+			name: "name not normalized",
+			msg:  MsgSetAttributeUnlistedRequest{Account: addr, Name: "KYC.attest", Unlisted: true},
+			exp:  `invalid unlisted attribute name "KYC.attest": must be lowercase without surrounding whitespace`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if len(tc.exp) > 0 {
+				assert.EqualError(t, err, tc.exp, "ValidateBasic error")
+			} else {
+				assert.NoError(t, err, "ValidateBasic error")
+			}
+		})
+	}
+}
+
+func TestMsgSetAttributeHookRequest_ValidateBasic(t *testing.T) {
+	owner := sdk.AccAddress("hookOwner").String()
+	contract := sdk.AccAddress("hookContract").String()
+	tests := []struct {
+		name string
+		msg  MsgSetAttributeHookRequest
+		exp  string
+	}{
+		{
+			name: "set notify",
+			msg:  MsgSetAttributeHookRequest{Owner: owner, Name: "kyc.attest", Contract: contract, Mode: AttributeHookMode_Notify},
+			exp:  "",
+		},
+		{
+			name: "set veto",
+			msg:  MsgSetAttributeHookRequest{Owner: owner, Name: "kyc.attest", Contract: contract, Mode: AttributeHookMode_Veto},
+			exp:  "",
+		},
+		{
+			name: "remove",
+			msg:  MsgSetAttributeHookRequest{Owner: owner, Name: "kyc.attest"},
+			exp:  "",
+		},
+		{
+			name: "bad owner",
+			msg:  MsgSetAttributeHookRequest{Owner: "notabech32", Name: "kyc.attest", Contract: contract, Mode: AttributeHookMode_Veto},
+			exp:  "invalid owner: decoding bech32 failed: invalid separator index -1",
+		},
+		{
+			name: "empty name",
+			msg:  MsgSetAttributeHookRequest{Owner: owner, Name: " ", Contract: contract, Mode: AttributeHookMode_Veto},
+			exp:  "invalid attribute hook name: empty",
+		},
+		{
+			name: "name not normalized",
+			msg:  MsgSetAttributeHookRequest{Owner: owner, Name: "KYC.attest", Contract: contract, Mode: AttributeHookMode_Veto},
+			exp:  `invalid attribute hook name "KYC.attest": must be lowercase without surrounding whitespace`,
+		},
+		{
+			name: "bad contract",
+			msg:  MsgSetAttributeHookRequest{Owner: owner, Name: "kyc.attest", Contract: "notabech32", Mode: AttributeHookMode_Veto},
+			exp:  `invalid attribute hook contract "notabech32": decoding bech32 failed: invalid separator index -1`,
+		},
+		{
+			name: "unspecified mode",
+			msg:  MsgSetAttributeHookRequest{Owner: owner, Name: "kyc.attest", Contract: contract},
+			exp:  "invalid attribute hook mode: ATTRIBUTE_HOOK_MODE_UNSPECIFIED",
+		},
+		{
+			name: "remove with empty name",
+			msg:  MsgSetAttributeHookRequest{Owner: owner, Name: ""},
+			exp:  "invalid attribute hook name: empty",
+		},
+		{
+			name: "remove with mode",
+			msg:  MsgSetAttributeHookRequest{Owner: owner, Name: "kyc.attest", Mode: AttributeHookMode_Notify},
+			exp:  "attribute hook mode must be unspecified when removing a hook, got ATTRIBUTE_HOOK_MODE_NOTIFY",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if len(tc.exp) > 0 {
+				assert.EqualError(t, err, tc.exp, "ValidateBasic error")
+			} else {
+				assert.NoError(t, err, "ValidateBasic error")
+			}
+		})
+	}
+}
+
+func TestMsgRevokeAttributeRequest_ValidateBasic(t *testing.T) {
+	issuer := sdk.AccAddress("attrIssuer").String()
+	account := sdk.AccAddress("attrHolder").String()
+	tests := []struct {
+		name string
+		msg  MsgRevokeAttributeRequest
+		exp  string
+	}{
+		{
+			name: "all values",
+			msg:  MsgRevokeAttributeRequest{Name: "kyc.attest", Account: account, Issuer: issuer},
+			exp:  "",
+		},
+		{
+			name: "one value with reason",
+			msg:  MsgRevokeAttributeRequest{Name: "kyc.attest", Account: account, Value: []byte("approved"), Issuer: issuer, Reason: "fraud"},
+			exp:  "",
+		},
+		{
+			name: "empty name",
+			msg:  MsgRevokeAttributeRequest{Name: " ", Account: account, Issuer: issuer},
+			exp:  "empty name",
+		},
+		{
+			name: "bad account",
+			msg:  MsgRevokeAttributeRequest{Name: "kyc.attest", Account: "notabech32", Issuer: issuer},
+			exp:  `invalid account address: must be either an account address or scope metadata address: "notabech32"`,
+		},
+		{
+			name: "bad issuer",
+			msg:  MsgRevokeAttributeRequest{Name: "kyc.attest", Account: account, Issuer: "notabech32"},
+			exp:  "invalid issuer: decoding bech32 failed: invalid separator index -1",
+		},
+		{
+			name: "reason too long",
+			msg:  MsgRevokeAttributeRequest{Name: "kyc.attest", Account: account, Issuer: issuer, Reason: strings.Repeat("r", MaxRevocationReasonLength+1)},
+			exp:  "revocation reason length 257 exceeds maximum length of 256",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if len(tc.exp) > 0 {
+				assert.EqualError(t, err, tc.exp, "ValidateBasic error")
+			} else {
+				assert.NoError(t, err, "ValidateBasic error")
+			}
+		})
+	}
+}
+
 func TestMsgUpdateParamsRequest(t *testing.T) {
 	tests := []struct {
 		name           string
@@ -198,3 +406,39 @@ func TestMsgUpdateParamsRequest(t *testing.T) {
 		})
 	}
 }
+
+func TestMsgPurgeOrphanedAttributesRequest(t *testing.T) {
+	tests := []struct {
+		name          string
+		authority     string
+		maxAccounts   uint32
+		expectedError string
+	}{
+		{
+			name:      "valid authority",
+			authority: sdk.AccAddress(priv1.PubKey().Address()).String(),
+		},
+		{
+			name:        "valid authority with max accounts",
+			authority:   sdk.AccAddress(priv1.PubKey().Address()).String(),
+			maxAccounts: 10,
+		},
+		{
+			name:          "invalid authority",
+			authority:     "invalid-authority",
+			expectedError: "invalid authority: decoding bech32 failed: invalid separator index -1",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			msg := NewMsgPurgeOrphanedAttributesRequest(tc.authority, tc.maxAccounts)
+			err := msg.ValidateBasic()
+			if len(tc.expectedError) == 0 {
+				require.NoError(t, err, "ValidateBasic")
+			} else {
+				assert.EqualError(t, err, tc.expectedError, "ValidateBasic")
+			}
+		})
+	}
+}
@@ -19,3 +19,22 @@ func DefaultParams() Params {
 		DefaultMaxValueLength,
 	)
 }
+
+const (
+	// FeatureAttributeHooks is the feature name used when attribute hook contracts are called.
+	FeatureAttributeHooks = "attribute-hooks"
+	// FeatureIssuerRevocation is the feature name used when name owners can revoke the attributes they issued.
+	FeatureIssuerRevocation = "issuer-revocation"
+)
+
+// Features returns the names of the optional attribute features that are enabled by these params.
+func (p Params) Features() []string {
+	var rv []string
+	if p.HookGasLimit > 0 {
+		rv = append(rv, FeatureAttributeHooks)
+	}
+	if p.AllowIssuerRevocation {
+		rv = append(rv, FeatureIssuerRevocation)
+	}
+	return rv
+}
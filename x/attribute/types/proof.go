@@ -0,0 +1,30 @@
+package types
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/provenance-io/provenance/internal/provutils"
+)
+
+// Verify checks that each of the proofs shows its attribute in the state committed to by the signed header.
+// It does not verify the signed header's commit signatures; a light client must check those against a trusted validator set.
+func (p AccountAttributeProofs) Verify() error {
+	for i, proof := range p.Proofs {
+		if proof.Attribute.Address != p.Account || proof.Attribute.Name != p.Name {
+			return fmt.Errorf("proofs[%d]: attribute %q on %s does not match %q on %s",
+				i, proof.Attribute.Name, proof.Attribute.Address, p.Name, p.Account)
+		}
+		bz, err := proof.Attribute.Marshal()
+		if err != nil {
+			return fmt.Errorf("proofs[%d]: could not encode attribute: %w", i, err)
+		}
+		if !bytes.Equal(bz, proof.Value) {
+			return fmt.Errorf("proofs[%d]: attribute does not match its value", i)
+		}
+		if err = provutils.VerifyStoreProofInHeader(p.SignedHeader, p.Height, proof.Proof, StoreKey, proof.Key, proof.Value); err != nil {
+			return fmt.Errorf("proofs[%d]: %w", i, err)
+		}
+	}
+	return nil
+}
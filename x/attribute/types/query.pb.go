@@ -547,6 +547,8 @@ func (m *QueryAttributeAccountsRequest) GetPagination() *query.PageRequest {
 type QueryAttributeAccountsResponse struct {
 	// list of account addresses that have attributes of request name
 	Accounts []string `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts,omitempty"`
+	// truncated is true if the requested page limit was reduced to the max_query_results param.
+	Truncated bool `protobuf:"varint,2,opt,name=truncated,proto3" json:"truncated,omitempty"`
 	// pagination defines an optional pagination for the request.
 	Pagination *query.PageResponse `protobuf:"bytes,99,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
@@ -591,6 +593,13 @@ func (m *QueryAttributeAccountsResponse) GetAccounts() []string {
 	return nil
 }
 
+func (m *QueryAttributeAccountsResponse) GetTruncated() bool {
+	if m != nil {
+		return m.Truncated
+	}
+	return false
+}
+
 func (m *QueryAttributeAccountsResponse) GetPagination() *query.PageResponse {
 	if m != nil {
 		return m.Pagination
@@ -598,6 +607,273 @@ func (m *QueryAttributeAccountsResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QueryAttributeAccountsByValueRequest is the request type for the Query/AttributeAccountsByValue method.
+type QueryAttributeAccountsByValueRequest struct {
+	// attribute_name is the attribute name to query for.
+	AttributeName string `protobuf:"bytes,1,opt,name=attribute_name,json=attributeName,proto3" json:"attribute_name,omitempty"`
+	// value_hash is the sha256 hash of the attribute value to query for.
+	ValueHash []byte `protobuf:"bytes,2,opt,name=value_hash,json=valueHash,proto3" json:"value_hash,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,99,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAttributeAccountsByValueRequest) Reset()         { *m = QueryAttributeAccountsByValueRequest{} }
+func (m *QueryAttributeAccountsByValueRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAttributeAccountsByValueRequest) ProtoMessage()    {}
+func (*QueryAttributeAccountsByValueRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_79f9aff39a1796c1, []int{10}
+}
+func (m *QueryAttributeAccountsByValueRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAttributeAccountsByValueRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAttributeAccountsByValueRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAttributeAccountsByValueRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAttributeAccountsByValueRequest.Merge(m, src)
+}
+func (m *QueryAttributeAccountsByValueRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAttributeAccountsByValueRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAttributeAccountsByValueRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAttributeAccountsByValueRequest proto.InternalMessageInfo
+
+func (m *QueryAttributeAccountsByValueRequest) GetAttributeName() string {
+	if m != nil {
+		return m.AttributeName
+	}
+	return ""
+}
+
+func (m *QueryAttributeAccountsByValueRequest) GetValueHash() []byte {
+	if m != nil {
+		return m.ValueHash
+	}
+	return nil
+}
+
+func (m *QueryAttributeAccountsByValueRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryAttributeAccountsByValueResponse is the response type for the Query/AttributeAccountsByValue method.
+type QueryAttributeAccountsByValueResponse struct {
+	// accounts are the addresses that have an attribute with the requested name and value hash.
+	Accounts []string `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts,omitempty"`
+	// pagination defines an optional pagination for the response.
+	Pagination *query.PageResponse `protobuf:"bytes,99,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAttributeAccountsByValueResponse) Reset()         { *m = QueryAttributeAccountsByValueResponse{} }
+func (m *QueryAttributeAccountsByValueResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAttributeAccountsByValueResponse) ProtoMessage()    {}
+func (*QueryAttributeAccountsByValueResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_79f9aff39a1796c1, []int{11}
+}
+func (m *QueryAttributeAccountsByValueResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAttributeAccountsByValueResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAttributeAccountsByValueResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAttributeAccountsByValueResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAttributeAccountsByValueResponse.Merge(m, src)
+}
+func (m *QueryAttributeAccountsByValueResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAttributeAccountsByValueResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAttributeAccountsByValueResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAttributeAccountsByValueResponse proto.InternalMessageInfo
+
+func (m *QueryAttributeAccountsByValueResponse) GetAccounts() []string {
+	if m != nil {
+		return m.Accounts
+	}
+	return nil
+}
+
+func (m *QueryAttributeAccountsByValueResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryAttributeAccountsByValueRangeRequest is the request type for the Query/AttributeAccountsByValueRange method.
+type QueryAttributeAccountsByValueRangeRequest struct {
+	// attribute_name is the attribute name to query for.
+	AttributeName string `protobuf:"bytes,1,opt,name=attribute_name,json=attributeName,proto3" json:"attribute_name,omitempty"`
+	// value_type is the type of attribute value to query for. Must be INT64, FLOAT64, or TIMESTAMP.
+	ValueType AttributeType `protobuf:"varint,2,opt,name=value_type,json=valueType,proto3,enum=provenance.attribute.v1.AttributeType" json:"value_type,omitempty"`
+	// min is the (inclusive) lower bound of the value range, or empty for no lower bound.
+	// An INT64 or FLOAT64 bound is a base-10 number, and a TIMESTAMP bound is an RFC 3339 formatted time.
+	Min string `protobuf:"bytes,3,opt,name=min,proto3" json:"min,omitempty"`
+	// max is the (inclusive) upper bound of the value range, or empty for no upper bound.
+	// An INT64 or FLOAT64 bound is a base-10 number, and a TIMESTAMP bound is an RFC 3339 formatted time.
+	Max string `protobuf:"bytes,4,opt,name=max,proto3" json:"max,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,99,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAttributeAccountsByValueRangeRequest) Reset() {
+	*m = QueryAttributeAccountsByValueRangeRequest{}
+}
+func (m *QueryAttributeAccountsByValueRangeRequest) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryAttributeAccountsByValueRangeRequest) ProtoMessage() {}
+func (*QueryAttributeAccountsByValueRangeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_79f9aff39a1796c1, []int{12}
+}
+func (m *QueryAttributeAccountsByValueRangeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAttributeAccountsByValueRangeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAttributeAccountsByValueRangeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAttributeAccountsByValueRangeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAttributeAccountsByValueRangeRequest.Merge(m, src)
+}
+func (m *QueryAttributeAccountsByValueRangeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAttributeAccountsByValueRangeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAttributeAccountsByValueRangeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAttributeAccountsByValueRangeRequest proto.InternalMessageInfo
+
+func (m *QueryAttributeAccountsByValueRangeRequest) GetAttributeName() string {
+	if m != nil {
+		return m.AttributeName
+	}
+	return ""
+}
+
+func (m *QueryAttributeAccountsByValueRangeRequest) GetValueType() AttributeType {
+	if m != nil {
+		return m.ValueType
+	}
+	return AttributeType_Unspecified
+}
+
+func (m *QueryAttributeAccountsByValueRangeRequest) GetMin() string {
+	if m != nil {
+		return m.Min
+	}
+	return ""
+}
+
+func (m *QueryAttributeAccountsByValueRangeRequest) GetMax() string {
+	if m != nil {
+		return m.Max
+	}
+	return ""
+}
+
+func (m *QueryAttributeAccountsByValueRangeRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryAttributeAccountsByValueRangeResponse is the response type for the Query/AttributeAccountsByValueRange method.
+type QueryAttributeAccountsByValueRangeResponse struct {
+	// attributes are the attributes with the requested name and a value in the requested range, ordered by value.
+	// The account of each is its address.
+	Attributes []Attribute `protobuf:"bytes,1,rep,name=attributes,proto3" json:"attributes"`
+	// pagination defines an optional pagination for the response.
+	Pagination *query.PageResponse `protobuf:"bytes,99,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAttributeAccountsByValueRangeResponse) Reset() {
+	*m = QueryAttributeAccountsByValueRangeResponse{}
+}
+func (m *QueryAttributeAccountsByValueRangeResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryAttributeAccountsByValueRangeResponse) ProtoMessage() {}
+func (*QueryAttributeAccountsByValueRangeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_79f9aff39a1796c1, []int{13}
+}
+func (m *QueryAttributeAccountsByValueRangeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAttributeAccountsByValueRangeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAttributeAccountsByValueRangeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAttributeAccountsByValueRangeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAttributeAccountsByValueRangeResponse.Merge(m, src)
+}
+func (m *QueryAttributeAccountsByValueRangeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAttributeAccountsByValueRangeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAttributeAccountsByValueRangeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAttributeAccountsByValueRangeResponse proto.InternalMessageInfo
+
+func (m *QueryAttributeAccountsByValueRangeResponse) GetAttributes() []Attribute {
+	if m != nil {
+		return m.Attributes
+	}
+	return nil
+}
+
+func (m *QueryAttributeAccountsByValueRangeResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 // QueryAccountDataRequest is the request type for the Query/AccountData method.
 type QueryAccountDataRequest struct {
 	// account is the bech32 address of the account to get the data for
@@ -608,7 +884,7 @@ func (m *QueryAccountDataRequest) Reset()         { *m = QueryAccountDataRequest
 func (m *QueryAccountDataRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAccountDataRequest) ProtoMessage()    {}
 func (*QueryAccountDataRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_79f9aff39a1796c1, []int{10}
+	return fileDescriptor_79f9aff39a1796c1, []int{14}
 }
 func (m *QueryAccountDataRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -654,7 +930,7 @@ func (m *QueryAccountDataResponse) Reset()         { *m = QueryAccountDataRespon
 func (m *QueryAccountDataResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAccountDataResponse) ProtoMessage()    {}
 func (*QueryAccountDataResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_79f9aff39a1796c1, []int{11}
+	return fileDescriptor_79f9aff39a1796c1, []int{15}
 }
 func (m *QueryAccountDataResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -690,1092 +966,3528 @@ func (m *QueryAccountDataResponse) GetValue() string {
 	return ""
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "provenance.attribute.v1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "provenance.attribute.v1.QueryParamsResponse")
-	proto.RegisterType((*QueryAttributeRequest)(nil), "provenance.attribute.v1.QueryAttributeRequest")
-	proto.RegisterType((*QueryAttributeResponse)(nil), "provenance.attribute.v1.QueryAttributeResponse")
-	proto.RegisterType((*QueryAttributesRequest)(nil), "provenance.attribute.v1.QueryAttributesRequest")
-	proto.RegisterType((*QueryAttributesResponse)(nil), "provenance.attribute.v1.QueryAttributesResponse")
-	proto.RegisterType((*QueryScanRequest)(nil), "provenance.attribute.v1.QueryScanRequest")
-	proto.RegisterType((*QueryScanResponse)(nil), "provenance.attribute.v1.QueryScanResponse")
-	proto.RegisterType((*QueryAttributeAccountsRequest)(nil), "provenance.attribute.v1.QueryAttributeAccountsRequest")
-	proto.RegisterType((*QueryAttributeAccountsResponse)(nil), "provenance.attribute.v1.QueryAttributeAccountsResponse")
-	proto.RegisterType((*QueryAccountDataRequest)(nil), "provenance.attribute.v1.QueryAccountDataRequest")
-	proto.RegisterType((*QueryAccountDataResponse)(nil), "provenance.attribute.v1.QueryAccountDataResponse")
+// QueryAttributeQuotaRequest is the request type for the Query/AttributeQuota method.
+type QueryAttributeQuotaRequest struct {
+	// account is the bech32 address of the account to get the quota of.
+	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	// name is an optional attribute name to get the value quota of.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 }
 
-func init() {
-	proto.RegisterFile("provenance/attribute/v1/query.proto", fileDescriptor_79f9aff39a1796c1)
+func (m *QueryAttributeQuotaRequest) Reset()         { *m = QueryAttributeQuotaRequest{} }
+func (m *QueryAttributeQuotaRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAttributeQuotaRequest) ProtoMessage()    {}
+func (*QueryAttributeQuotaRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_79f9aff39a1796c1, []int{16}
 }
-
-var fileDescriptor_79f9aff39a1796c1 = []byte{
-	// 761 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x96, 0xc1, 0x4f, 0x13, 0x4f,
-	0x14, 0xc7, 0x3b, 0x05, 0xca, 0x8f, 0x47, 0x7e, 0xbf, 0xfc, 0x1c, 0x11, 0x9a, 0x8d, 0x16, 0x5c,
-	0xa3, 0x20, 0xca, 0x0e, 0x2d, 0x82, 0x09, 0xea, 0x01, 0x62, 0xd4, 0x93, 0xc1, 0xea, 0xc9, 0x8b,
-	0x99, 0xae, 0xcb, 0xba, 0x09, 0xdd, 0x59, 0x3a, 0xbb, 0x0d, 0xd8, 0xf4, 0x62, 0xe2, 0x0d, 0x8d,
-	0x89, 0x7f, 0x81, 0x17, 0x13, 0xfd, 0x2b, 0xbc, 0x68, 0x38, 0x92, 0x78, 0xf1, 0x64, 0x0c, 0xf8,
-	0x7f, 0x68, 0x76, 0x66, 0xba, 0xdd, 0xb6, 0x2e, 0x5b, 0x88, 0x17, 0x6e, 0x3b, 0xd3, 0xf7, 0xe6,
-	0x7d, 0xde, 0x77, 0xde, 0x7b, 0x53, 0xb8, 0xe0, 0xd5, 0x58, 0xdd, 0x72, 0xa9, 0x6b, 0x5a, 0x84,
-	0xfa, 0x7e, 0xcd, 0xa9, 0x04, 0xbe, 0x45, 0xea, 0x45, 0xb2, 0x19, 0x58, 0xb5, 0x6d, 0xc3, 0xab,
-	0x31, 0x9f, 0xe1, 0x89, 0xb6, 0x91, 0x11, 0x19, 0x19, 0xf5, 0xa2, 0x36, 0x6b, 0x32, 0x5e, 0x65,
-	0x9c, 0x54, 0x28, 0xb7, 0xa4, 0x07, 0xa9, 0x17, 0x2b, 0x96, 0x4f, 0x8b, 0xc4, 0xa3, 0xb6, 0xe3,
-	0x52, 0xdf, 0x61, 0xae, 0x3c, 0x44, 0x1b, 0xb3, 0x99, 0xcd, 0xc4, 0x27, 0x09, 0xbf, 0xd4, 0xee,
-	0x59, 0x9b, 0x31, 0x7b, 0xc3, 0x22, 0xd4, 0x73, 0x08, 0x75, 0x5d, 0xe6, 0x0b, 0x17, 0xae, 0x7e,
-	0x9d, 0x4e, 0xa2, 0x6b, 0x53, 0x08, 0x43, 0x7d, 0x0c, 0xf0, 0x83, 0x30, 0xfc, 0x1a, 0xad, 0xd1,
-	0x2a, 0x2f, 0x5b, 0x9b, 0x81, 0xc5, 0x7d, 0xfd, 0x11, 0x9c, 0xee, 0xd8, 0xe5, 0x1e, 0x73, 0xb9,
-	0x85, 0x6f, 0x41, 0xce, 0x13, 0x3b, 0x79, 0x34, 0x85, 0x66, 0x46, 0x4b, 0x93, 0x46, 0x42, 0x7e,
-	0x86, 0x74, 0x5c, 0x1d, 0xdc, 0xfd, 0x3e, 0x99, 0x29, 0x2b, 0x27, 0xfd, 0x15, 0x82, 0x33, 0xe2,
-	0xd8, 0x95, 0x96, 0xa9, 0x8a, 0x87, 0xf3, 0x30, 0x4c, 0x4d, 0x93, 0x05, 0xae, 0x2f, 0x4e, 0x1e,
-	0x29, 0xb7, 0x96, 0x18, 0xc3, 0xa0, 0x4b, 0xab, 0x56, 0x3e, 0x2b, 0xb6, 0xc5, 0x37, 0xbe, 0x03,
-	0xd0, 0x16, 0x29, 0x3f, 0x20, 0x50, 0x2e, 0x19, 0x52, 0x51, 0x23, 0x54, 0xd4, 0x90, 0x77, 0xa0,
-	0x14, 0x35, 0xd6, 0xa8, 0xdd, 0x8a, 0x54, 0x8e, 0x79, 0xea, 0x9f, 0x11, 0x8c, 0x77, 0xf3, 0xa8,
-	0x4c, 0x93, 0x81, 0xee, 0x01, 0x44, 0x99, 0xf2, 0x7c, 0x76, 0x6a, 0x60, 0x66, 0xb4, 0xa4, 0x27,
-	0xea, 0x10, 0x9d, 0xac, 0xa4, 0x88, 0xf9, 0xe2, 0xbb, 0x7f, 0x48, 0x63, 0x3a, 0x35, 0x0d, 0x09,
-	0xd8, 0x91, 0xc7, 0xf3, 0xee, 0x34, 0x78, 0xba, 0xae, 0x9d, 0x1a, 0x66, 0x8f, 0xad, 0xe1, 0x17,
-	0x04, 0x13, 0x3d, 0xc1, 0x4f, 0xa2, 0x88, 0x3b, 0x08, 0xfe, 0x17, 0x89, 0x3c, 0x34, 0xa9, 0x9b,
-	0xae, 0xdf, 0x38, 0xe4, 0x78, 0xb0, 0xbe, 0xee, 0x6c, 0xa9, 0xca, 0x54, 0xab, 0xbf, 0x56, 0x9b,
-	0x9f, 0x10, 0x9c, 0x8a, 0xe1, 0x9c, 0x44, 0x45, 0x5f, 0x23, 0x38, 0xd7, 0x59, 0x1a, 0x2b, 0x12,
-	0x36, 0x2a, 0xcf, 0x8b, 0xf0, 0x5f, 0x14, 0xf8, 0x89, 0x68, 0x73, 0x99, 0xd5, 0xbf, 0xd1, 0xee,
-	0xfd, 0xde, 0x7e, 0x37, 0x8f, 0xad, 0xe9, 0x4b, 0x04, 0x85, 0x24, 0x20, 0x25, 0xb0, 0x06, 0xff,
-	0x28, 0x45, 0xc3, 0x19, 0x37, 0x30, 0x33, 0x52, 0x8e, 0xd6, 0x5d, 0xc2, 0x98, 0xc7, 0x17, 0x66,
-	0xa1, 0xd5, 0x32, 0xf2, 0xe4, 0xdb, 0xd4, 0xa7, 0xa9, 0x05, 0xa7, 0xcf, 0x43, 0xbe, 0xd7, 0x49,
-	0x51, 0x8f, 0xc1, 0x50, 0x9d, 0x6e, 0x04, 0x2d, 0xf9, 0xe4, 0xa2, 0xf4, 0x6b, 0x18, 0x86, 0x84,
-	0x0b, 0xde, 0x41, 0x90, 0x93, 0x13, 0x19, 0x5f, 0x49, 0xac, 0x89, 0xde, 0x67, 0x40, 0xbb, 0xda,
-	0x9f, 0xb1, 0xa4, 0xd0, 0xa7, 0x5f, 0x7c, 0xfd, 0xf9, 0x36, 0x7b, 0x1e, 0x4f, 0x92, 0xa4, 0xc7,
-	0x47, 0xbe, 0x03, 0xf8, 0x03, 0x82, 0x91, 0xe8, 0x0a, 0xb0, 0x71, 0x78, 0x90, 0xee, 0xb7, 0x42,
-	0x23, 0x7d, 0xdb, 0x2b, 0xae, 0x1b, 0x82, 0x6b, 0x11, 0x2f, 0x90, 0xd4, 0x47, 0x91, 0x34, 0x94,
-	0xdc, 0x4d, 0xd2, 0x08, 0xeb, 0xb1, 0x89, 0xdf, 0x23, 0x80, 0xf6, 0x68, 0xc3, 0xfd, 0x06, 0x8f,
-	0x24, 0x9c, 0xef, 0xdf, 0x41, 0xe1, 0x2e, 0x0a, 0x5c, 0x82, 0xe7, 0xd2, 0x71, 0x79, 0x9b, 0x17,
-	0xbf, 0x43, 0x30, 0x18, 0xce, 0x0a, 0x7c, 0xf9, 0xf0, 0x88, 0xb1, 0xf1, 0xa6, 0xcd, 0xf6, 0x63,
-	0xaa, 0xb0, 0x56, 0x05, 0xd6, 0x4d, 0xbc, 0x7c, 0x24, 0x15, 0xb9, 0x49, 0x5d, 0xd2, 0x90, 0xb3,
-	0xb1, 0x89, 0xc3, 0xa1, 0xd6, 0xd3, 0x7b, 0x78, 0xa9, 0x4f, 0x89, 0xba, 0xa6, 0x87, 0x76, 0xfd,
-	0xc8, 0x7e, 0x2a, 0x95, 0x65, 0x91, 0xca, 0x35, 0x5c, 0x4a, 0x4e, 0x45, 0xb9, 0x90, 0x46, 0xe7,
-	0x7c, 0x6a, 0xe2, 0x8f, 0x08, 0x46, 0x63, 0x2d, 0x88, 0xd3, 0xee, 0xb7, 0xa7, 0xc5, 0xb5, 0xe2,
-	0x11, 0x3c, 0x14, 0xf0, 0x92, 0x00, 0x9e, 0xc7, 0x46, 0x1a, 0xf0, 0x53, 0xea, 0xd3, 0xb6, 0xfa,
-	0xab, 0xd5, 0xdd, 0xfd, 0x02, 0xda, 0xdb, 0x2f, 0xa0, 0x1f, 0xfb, 0x05, 0xf4, 0xe6, 0xa0, 0x90,
-	0xd9, 0x3b, 0x28, 0x64, 0xbe, 0x1d, 0x14, 0x32, 0xa0, 0x39, 0x2c, 0x09, 0x63, 0x0d, 0x3d, 0x5e,
-	0xb4, 0x1d, 0xff, 0x59, 0x50, 0x31, 0x4c, 0x56, 0x8d, 0x45, 0x9c, 0x73, 0x58, 0x3c, 0xfe, 0x56,
-	0x8c, 0xc0, 0xdf, 0xf6, 0x2c, 0x5e, 0xc9, 0x89, 0xbf, 0x94, 0x0b, 0xbf, 0x03, 0x00, 0x00, 0xff,
-	0xff, 0x70, 0x45, 0xc2, 0xe9, 0x1b, 0x0b, 0x00, 0x00,
+func (m *QueryAttributeQuotaRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Params queries params of the attribute module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// Attribute queries attributes on a given account (address) for one (or more) with the given name
-	Attribute(ctx context.Context, in *QueryAttributeRequest, opts ...grpc.CallOption) (*QueryAttributeResponse, error)
-	// Attributes queries attributes on a given account (address) for any defined attributes
-	Attributes(ctx context.Context, in *QueryAttributesRequest, opts ...grpc.CallOption) (*QueryAttributesResponse, error)
-	// Scan queries attributes on a given account (address) for any that match the provided suffix
-	Scan(ctx context.Context, in *QueryScanRequest, opts ...grpc.CallOption) (*QueryScanResponse, error)
-	// AttributeAccounts queries accounts on a given attribute name
-	AttributeAccounts(ctx context.Context, in *QueryAttributeAccountsRequest, opts ...grpc.CallOption) (*QueryAttributeAccountsResponse, error)
-	// AccountData returns the accountdata for a specified account.
-	AccountData(ctx context.Context, in *QueryAccountDataRequest, opts ...grpc.CallOption) (*QueryAccountDataResponse, error)
+func (m *QueryAttributeQuotaRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAttributeQuotaRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryAttributeQuotaRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAttributeQuotaRequest.Merge(m, src)
 }
-
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryAttributeQuotaRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAttributeQuotaRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAttributeQuotaRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/provenance.attribute.v1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAttributeQuotaRequest proto.InternalMessageInfo
+
+func (m *QueryAttributeQuotaRequest) GetAccount() string {
+	if m != nil {
+		return m.Account
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) Attribute(ctx context.Context, in *QueryAttributeRequest, opts ...grpc.CallOption) (*QueryAttributeResponse, error) {
-	out := new(QueryAttributeResponse)
-	err := c.cc.Invoke(ctx, "/provenance.attribute.v1.Query/Attribute", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryAttributeQuotaRequest) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) Attributes(ctx context.Context, in *QueryAttributesRequest, opts ...grpc.CallOption) (*QueryAttributesResponse, error) {
-	out := new(QueryAttributesResponse)
-	err := c.cc.Invoke(ctx, "/provenance.attribute.v1.Query/Attributes", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// QueryAttributeQuotaResponse is the response type for the Query/AttributeQuota method.
+type QueryAttributeQuotaResponse struct {
+	// names is the quota of distinct attribute names on the account.
+	Names AttributeQuota `protobuf:"bytes,1,opt,name=names,proto3" json:"names"`
+	// values is the quota of values for the requested attribute name on the account.
+	// It is only populated when a name was provided in the request.
+	Values *AttributeQuota `protobuf:"bytes,2,opt,name=values,proto3" json:"values,omitempty"`
 }
 
-func (c *queryClient) Scan(ctx context.Context, in *QueryScanRequest, opts ...grpc.CallOption) (*QueryScanResponse, error) {
-	out := new(QueryScanResponse)
-	err := c.cc.Invoke(ctx, "/provenance.attribute.v1.Query/Scan", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryAttributeQuotaResponse) Reset()         { *m = QueryAttributeQuotaResponse{} }
+func (m *QueryAttributeQuotaResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAttributeQuotaResponse) ProtoMessage()    {}
+func (*QueryAttributeQuotaResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_79f9aff39a1796c1, []int{17}
 }
-
-func (c *queryClient) AttributeAccounts(ctx context.Context, in *QueryAttributeAccountsRequest, opts ...grpc.CallOption) (*QueryAttributeAccountsResponse, error) {
-	out := new(QueryAttributeAccountsResponse)
-	err := c.cc.Invoke(ctx, "/provenance.attribute.v1.Query/AttributeAccounts", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryAttributeQuotaResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAttributeQuotaResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAttributeQuotaResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryAttributeQuotaResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAttributeQuotaResponse.Merge(m, src)
+}
+func (m *QueryAttributeQuotaResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAttributeQuotaResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAttributeQuotaResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) AccountData(ctx context.Context, in *QueryAccountDataRequest, opts ...grpc.CallOption) (*QueryAccountDataResponse, error) {
-	out := new(QueryAccountDataResponse)
-	err := c.cc.Invoke(ctx, "/provenance.attribute.v1.Query/AccountData", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAttributeQuotaResponse proto.InternalMessageInfo
+
+func (m *QueryAttributeQuotaResponse) GetNames() AttributeQuota {
+	if m != nil {
+		return m.Names
 	}
-	return out, nil
+	return AttributeQuota{}
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Params queries params of the attribute module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// Attribute queries attributes on a given account (address) for one (or more) with the given name
-	Attribute(context.Context, *QueryAttributeRequest) (*QueryAttributeResponse, error)
-	// Attributes queries attributes on a given account (address) for any defined attributes
-	Attributes(context.Context, *QueryAttributesRequest) (*QueryAttributesResponse, error)
-	// Scan queries attributes on a given account (address) for any that match the provided suffix
-	Scan(context.Context, *QueryScanRequest) (*QueryScanResponse, error)
-	// AttributeAccounts queries accounts on a given attribute name
-	AttributeAccounts(context.Context, *QueryAttributeAccountsRequest) (*QueryAttributeAccountsResponse, error)
-	// AccountData returns the accountdata for a specified account.
-	AccountData(context.Context, *QueryAccountDataRequest) (*QueryAccountDataResponse, error)
+func (m *QueryAttributeQuotaResponse) GetValues() *AttributeQuota {
+	if m != nil {
+		return m.Values
+	}
+	return nil
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+// AttributeQuota is the usage of one of the attribute limits.
+type AttributeQuota struct {
+	// max is the limit. Zero means there is no limit.
+	Max uint32 `protobuf:"varint,1,opt,name=max,proto3" json:"max,omitempty"`
+	// used is how many are currently in use.
+	Used uint64 `protobuf:"varint,2,opt,name=used,proto3" json:"used,omitempty"`
+	// remaining is how many more can be added. If there is no limit, this is zero and unlimited is true.
+	// If the limit was lowered after they were added, used can be more than max, and remaining will be zero.
+	Remaining uint64 `protobuf:"varint,3,opt,name=remaining,proto3" json:"remaining,omitempty"`
+	// unlimited is true if there is no limit.
+	Unlimited bool `protobuf:"varint,4,opt,name=unlimited,proto3" json:"unlimited,omitempty"`
 }
 
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *AttributeQuota) Reset()         { *m = AttributeQuota{} }
+func (m *AttributeQuota) String() string { return proto.CompactTextString(m) }
+func (*AttributeQuota) ProtoMessage()    {}
+func (*AttributeQuota) Descriptor() ([]byte, []int) {
+	return fileDescriptor_79f9aff39a1796c1, []int{18}
 }
-func (*UnimplementedQueryServer) Attribute(ctx context.Context, req *QueryAttributeRequest) (*QueryAttributeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Attribute not implemented")
+func (m *AttributeQuota) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) Attributes(ctx context.Context, req *QueryAttributesRequest) (*QueryAttributesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Attributes not implemented")
+func (m *AttributeQuota) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AttributeQuota.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) Scan(ctx context.Context, req *QueryScanRequest) (*QueryScanResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Scan not implemented")
+func (m *AttributeQuota) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AttributeQuota.Merge(m, src)
 }
-func (*UnimplementedQueryServer) AttributeAccounts(ctx context.Context, req *QueryAttributeAccountsRequest) (*QueryAttributeAccountsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AttributeAccounts not implemented")
+func (m *AttributeQuota) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) AccountData(ctx context.Context, req *QueryAccountDataRequest) (*QueryAccountDataResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AccountData not implemented")
+func (m *AttributeQuota) XXX_DiscardUnknown() {
+	xxx_messageInfo_AttributeQuota.DiscardUnknown(m)
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
-}
+var xxx_messageInfo_AttributeQuota proto.InternalMessageInfo
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.attribute.v1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+func (m *AttributeQuota) GetMax() uint32 {
+	if m != nil {
+		return m.Max
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_Attribute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAttributeRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Attribute(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.attribute.v1.Query/Attribute",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Attribute(ctx, req.(*QueryAttributeRequest))
+func (m *AttributeQuota) GetUsed() uint64 {
+	if m != nil {
+		return m.Used
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_Attributes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAttributesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Attributes(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.attribute.v1.Query/Attributes",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Attributes(ctx, req.(*QueryAttributesRequest))
+func (m *AttributeQuota) GetRemaining() uint64 {
+	if m != nil {
+		return m.Remaining
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_Scan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryScanRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Scan(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.attribute.v1.Query/Scan",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Scan(ctx, req.(*QueryScanRequest))
+func (m *AttributeQuota) GetUnlimited() bool {
+	if m != nil {
+		return m.Unlimited
 	}
-	return interceptor(ctx, in, info, handler)
+	return false
 }
 
-func _Query_AttributeAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAttributeAccountsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).AttributeAccounts(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.attribute.v1.Query/AttributeAccounts",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).AttributeAccounts(ctx, req.(*QueryAttributeAccountsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryAttributeHookRequest is the request type for the Query/AttributeHook method.
+type QueryAttributeHookRequest struct {
+	// name is the attribute name to get the hook of.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 }
 
-func _Query_AccountData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAccountDataRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).AccountData(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.attribute.v1.Query/AccountData",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).AccountData(ctx, req.(*QueryAccountDataRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *QueryAttributeHookRequest) Reset()         { *m = QueryAttributeHookRequest{} }
+func (m *QueryAttributeHookRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAttributeHookRequest) ProtoMessage()    {}
+func (*QueryAttributeHookRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_79f9aff39a1796c1, []int{19}
 }
-
-var Query_serviceDesc = _Query_serviceDesc
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "provenance.attribute.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "Attribute",
-			Handler:    _Query_Attribute_Handler,
-		},
-		{
-			MethodName: "Attributes",
-			Handler:    _Query_Attributes_Handler,
-		},
-		{
-			MethodName: "Scan",
-			Handler:    _Query_Scan_Handler,
-		},
-		{
-			MethodName: "AttributeAccounts",
-			Handler:    _Query_AttributeAccounts_Handler,
-		},
-		{
-			MethodName: "AccountData",
-			Handler:    _Query_AccountData_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "provenance/attribute/v1/query.proto",
+func (m *QueryAttributeHookRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryAttributeHookRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAttributeHookRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryAttributeHookRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAttributeHookRequest.Merge(m, src)
 }
-
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryAttributeHookRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAttributeHookRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAttributeHookRequest.DiscardUnknown(m)
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAttributeHookRequest proto.InternalMessageInfo
+
+func (m *QueryAttributeHookRequest) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryAttributeHookResponse is the response type for the Query/AttributeHook method.
+type QueryAttributeHookResponse struct {
+	// hook is the hook registered for the requested name. It is not populated if the name does not have a hook.
+	Hook *AttributeHook `protobuf:"bytes,1,opt,name=hook,proto3" json:"hook,omitempty"`
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryAttributeHookResponse) Reset()         { *m = QueryAttributeHookResponse{} }
+func (m *QueryAttributeHookResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAttributeHookResponse) ProtoMessage()    {}
+func (*QueryAttributeHookResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_79f9aff39a1796c1, []int{20}
+}
+func (m *QueryAttributeHookResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAttributeHookResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAttributeHookResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryAttributeHookResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAttributeHookResponse.Merge(m, src)
+}
+func (m *QueryAttributeHookResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAttributeHookResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAttributeHookResponse.DiscardUnknown(m)
 }
 
-func (m *QueryAttributeRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAttributeHookResponse proto.InternalMessageInfo
+
+func (m *QueryAttributeHookResponse) GetHook() *AttributeHook {
+	if m != nil {
+		return m.Hook
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryAttributeRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryBulkAttributesRequest is the request type for the Query/BulkAttributes method.
+type QueryBulkAttributesRequest struct {
+	// accounts are the addresses to get the attributes of. Up to 100 can be provided.
+	Accounts []string `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts,omitempty"`
+	// name is an optional attribute name. If provided, only attributes with this name are returned.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 }
 
-func (m *QueryAttributeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryBulkAttributesRequest) Reset()         { *m = QueryBulkAttributesRequest{} }
+func (m *QueryBulkAttributesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBulkAttributesRequest) ProtoMessage()    {}
+func (*QueryBulkAttributesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_79f9aff39a1796c1, []int{21}
+}
+func (m *QueryBulkAttributesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBulkAttributesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBulkAttributesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.Account) > 0 {
-		i -= len(m.Account)
-		copy(dAtA[i:], m.Account)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Account)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *QueryBulkAttributesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBulkAttributesRequest.Merge(m, src)
+}
+func (m *QueryBulkAttributesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBulkAttributesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBulkAttributesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBulkAttributesRequest proto.InternalMessageInfo
+
+func (m *QueryBulkAttributesRequest) GetAccounts() []string {
+	if m != nil {
+		return m.Accounts
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryAttributeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryBulkAttributesRequest) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryAttributeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryBulkAttributesResponse is the response type for the Query/BulkAttributes method.
+type QueryBulkAttributesResponse struct {
+	// accounts contains the attributes of each requested account, in the order requested.
+	Accounts []AccountAttributes `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts"`
 }
 
-func (m *QueryAttributeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Attributes) > 0 {
-		for iNdEx := len(m.Attributes) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Attributes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
+func (m *QueryBulkAttributesResponse) Reset()         { *m = QueryBulkAttributesResponse{} }
+func (m *QueryBulkAttributesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBulkAttributesResponse) ProtoMessage()    {}
+func (*QueryBulkAttributesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_79f9aff39a1796c1, []int{22}
+}
+func (m *QueryBulkAttributesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBulkAttributesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBulkAttributesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Account) > 0 {
-		i -= len(m.Account)
-		copy(dAtA[i:], m.Account)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Account)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryBulkAttributesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBulkAttributesResponse.Merge(m, src)
+}
+func (m *QueryBulkAttributesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBulkAttributesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBulkAttributesResponse.DiscardUnknown(m)
 }
 
-func (m *QueryAttributesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryBulkAttributesResponse proto.InternalMessageInfo
+
+func (m *QueryBulkAttributesResponse) GetAccounts() []AccountAttributes {
+	if m != nil {
+		return m.Accounts
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryAttributesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// AccountAttributes is the attributes of an account.
+type AccountAttributes struct {
+	// account is the address the attributes are assigned to.
+	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	// attributes are the account's attributes.
+	Attributes []Attribute `protobuf:"bytes,2,rep,name=attributes,proto3" json:"attributes"`
 }
 
-func (m *QueryAttributesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *AccountAttributes) Reset()         { *m = AccountAttributes{} }
+func (m *AccountAttributes) String() string { return proto.CompactTextString(m) }
+func (*AccountAttributes) ProtoMessage()    {}
+func (*AccountAttributes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_79f9aff39a1796c1, []int{23}
+}
+func (m *AccountAttributes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccountAttributes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AccountAttributes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.Account) > 0 {
-		i -= len(m.Account)
-		copy(dAtA[i:], m.Account)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Account)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *AccountAttributes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountAttributes.Merge(m, src)
+}
+func (m *AccountAttributes) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccountAttributes) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountAttributes.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountAttributes proto.InternalMessageInfo
+
+func (m *AccountAttributes) GetAccount() string {
+	if m != nil {
+		return m.Account
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryAttributesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *AccountAttributes) GetAttributes() []Attribute {
+	if m != nil {
+		return m.Attributes
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryAttributesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "provenance.attribute.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "provenance.attribute.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryAttributeRequest)(nil), "provenance.attribute.v1.QueryAttributeRequest")
+	proto.RegisterType((*QueryAttributeResponse)(nil), "provenance.attribute.v1.QueryAttributeResponse")
+	proto.RegisterType((*QueryAttributesRequest)(nil), "provenance.attribute.v1.QueryAttributesRequest")
+	proto.RegisterType((*QueryAttributesResponse)(nil), "provenance.attribute.v1.QueryAttributesResponse")
+	proto.RegisterType((*QueryScanRequest)(nil), "provenance.attribute.v1.QueryScanRequest")
+	proto.RegisterType((*QueryScanResponse)(nil), "provenance.attribute.v1.QueryScanResponse")
+	proto.RegisterType((*QueryAttributeAccountsRequest)(nil), "provenance.attribute.v1.QueryAttributeAccountsRequest")
+	proto.RegisterType((*QueryAttributeAccountsResponse)(nil), "provenance.attribute.v1.QueryAttributeAccountsResponse")
+	proto.RegisterType((*QueryAttributeAccountsByValueRequest)(nil), "provenance.attribute.v1.QueryAttributeAccountsByValueRequest")
+	proto.RegisterType((*QueryAttributeAccountsByValueResponse)(nil), "provenance.attribute.v1.QueryAttributeAccountsByValueResponse")
+	proto.RegisterType((*QueryAttributeAccountsByValueRangeRequest)(nil), "provenance.attribute.v1.QueryAttributeAccountsByValueRangeRequest")
+	proto.RegisterType((*QueryAttributeAccountsByValueRangeResponse)(nil), "provenance.attribute.v1.QueryAttributeAccountsByValueRangeResponse")
+	proto.RegisterType((*QueryAccountDataRequest)(nil), "provenance.attribute.v1.QueryAccountDataRequest")
+	proto.RegisterType((*QueryAccountDataResponse)(nil), "provenance.attribute.v1.QueryAccountDataResponse")
+	proto.RegisterType((*QueryAttributeQuotaRequest)(nil), "provenance.attribute.v1.QueryAttributeQuotaRequest")
+	proto.RegisterType((*QueryAttributeQuotaResponse)(nil), "provenance.attribute.v1.QueryAttributeQuotaResponse")
+	proto.RegisterType((*AttributeQuota)(nil), "provenance.attribute.v1.AttributeQuota")
+	proto.RegisterType((*QueryAttributeHookRequest)(nil), "provenance.attribute.v1.QueryAttributeHookRequest")
+	proto.RegisterType((*QueryAttributeHookResponse)(nil), "provenance.attribute.v1.QueryAttributeHookResponse")
+	proto.RegisterType((*QueryBulkAttributesRequest)(nil), "provenance.attribute.v1.QueryBulkAttributesRequest")
+	proto.RegisterType((*QueryBulkAttributesResponse)(nil), "provenance.attribute.v1.QueryBulkAttributesResponse")
+	proto.RegisterType((*AccountAttributes)(nil), "provenance.attribute.v1.AccountAttributes")
 }
 
-func (m *QueryAttributesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Attributes) > 0 {
-		for iNdEx := len(m.Attributes) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Attributes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if len(m.Account) > 0 {
-		i -= len(m.Account)
-		copy(dAtA[i:], m.Account)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Account)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func init() {
+	proto.RegisterFile("provenance/attribute/v1/query.proto", fileDescriptor_79f9aff39a1796c1)
 }
 
-func (m *QueryScanRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+var fileDescriptor_79f9aff39a1796c1 = []byte{
+	// 1238 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x58, 0xcf, 0x6f, 0xdc, 0x44,
+	0x14, 0xce, 0x6c, 0x36, 0xa1, 0xfb, 0x42, 0x42, 0x3b, 0x84, 0x76, 0x71, 0x9b, 0x4d, 0x31, 0x6d,
+	0x92, 0x86, 0xd6, 0x93, 0x6c, 0x92, 0x82, 0x02, 0x05, 0x9a, 0x52, 0x12, 0xa1, 0x82, 0x52, 0x53,
+	0x21, 0xc4, 0x25, 0x9a, 0xdd, 0xba, 0x1b, 0x2b, 0x59, 0xcf, 0x76, 0x6d, 0x87, 0x84, 0x28, 0x17,
+	0x24, 0x6e, 0x01, 0x21, 0xf1, 0x17, 0x20, 0xa4, 0x4a, 0x20, 0x71, 0xe3, 0x0e, 0x17, 0x50, 0x8f,
+	0x95, 0xb8, 0x70, 0x42, 0x55, 0xc2, 0x01, 0xf1, 0x57, 0x20, 0xcf, 0x8c, 0xbd, 0x76, 0x76, 0x1d,
+	0xdb, 0xdb, 0x55, 0xa5, 0xde, 0xc6, 0xb3, 0xf3, 0xbd, 0xf7, 0xbd, 0x6f, 0x7e, 0x7d, 0xb3, 0xf0,
+	0x6a, 0xa3, 0xc9, 0xb6, 0x0c, 0x8b, 0x5a, 0x55, 0x83, 0x50, 0xc7, 0x69, 0x9a, 0x15, 0xd7, 0x31,
+	0xc8, 0xd6, 0x2c, 0xb9, 0xef, 0x1a, 0xcd, 0x1d, 0xad, 0xd1, 0x64, 0x0e, 0xc3, 0x67, 0x5a, 0x83,
+	0xb4, 0x60, 0x90, 0xb6, 0x35, 0xab, 0x4c, 0x57, 0x99, 0x5d, 0x67, 0x36, 0xa9, 0x50, 0xdb, 0x10,
+	0x08, 0xb2, 0x35, 0x5b, 0x31, 0x1c, 0x3a, 0x4b, 0x1a, 0xb4, 0x66, 0x5a, 0xd4, 0x31, 0x99, 0x25,
+	0x82, 0x28, 0xa3, 0x35, 0x56, 0x63, 0xbc, 0x49, 0xbc, 0x96, 0xec, 0x3d, 0x57, 0x63, 0xac, 0xb6,
+	0x69, 0x10, 0xda, 0x30, 0x09, 0xb5, 0x2c, 0xe6, 0x70, 0x88, 0x2d, 0x7f, 0x9d, 0x8c, 0x63, 0xd7,
+	0x62, 0xc1, 0x07, 0xaa, 0xa3, 0x80, 0x6f, 0x7b, 0xe9, 0x57, 0x69, 0x93, 0xd6, 0x6d, 0xdd, 0xb8,
+	0xef, 0x1a, 0xb6, 0xa3, 0xde, 0x81, 0x17, 0x23, 0xbd, 0x76, 0x83, 0x59, 0xb6, 0x81, 0xaf, 0xc1,
+	0x60, 0x83, 0xf7, 0x14, 0xd1, 0x79, 0x34, 0x35, 0x54, 0x1e, 0xd7, 0x62, 0xea, 0xd3, 0x04, 0x70,
+	0x29, 0xff, 0xf0, 0xef, 0xf1, 0x3e, 0x5d, 0x82, 0xd4, 0xaf, 0x11, 0xbc, 0xc4, 0xc3, 0x5e, 0xf7,
+	0x87, 0xca, 0x7c, 0xb8, 0x08, 0xcf, 0xd1, 0x6a, 0x95, 0xb9, 0x96, 0xc3, 0x23, 0x17, 0x74, 0xff,
+	0x13, 0x63, 0xc8, 0x5b, 0xb4, 0x6e, 0x14, 0x73, 0xbc, 0x9b, 0xb7, 0xf1, 0xfb, 0x00, 0x2d, 0x91,
+	0x8a, 0xfd, 0x9c, 0xca, 0x84, 0x26, 0x14, 0xd5, 0x3c, 0x45, 0x35, 0x31, 0x07, 0x52, 0x51, 0x6d,
+	0x95, 0xd6, 0xfc, 0x4c, 0x7a, 0x08, 0xa9, 0xfe, 0x8e, 0xe0, 0xf4, 0x51, 0x3e, 0xb2, 0xd2, 0x78,
+	0x42, 0x2b, 0x00, 0x41, 0xa5, 0x76, 0x31, 0x77, 0xbe, 0x7f, 0x6a, 0xa8, 0xac, 0xc6, 0xea, 0x10,
+	0x44, 0x96, 0x52, 0x84, 0xb0, 0x78, 0xb9, 0x43, 0x19, 0x93, 0x89, 0x65, 0x08, 0x82, 0x91, 0x3a,
+	0xbe, 0x38, 0x5a, 0x86, 0x9d, 0xac, 0x6b, 0x54, 0xc3, 0x5c, 0xd7, 0x1a, 0xfe, 0x81, 0xe0, 0x4c,
+	0x5b, 0xf2, 0x67, 0x51, 0xc4, 0x7d, 0x04, 0x27, 0x79, 0x21, 0x1f, 0x57, 0xa9, 0x95, 0xac, 0xdf,
+	0x69, 0x18, 0xb4, 0xdd, 0x7b, 0xf7, 0xcc, 0x6d, 0xb9, 0x32, 0xe5, 0x57, 0xcf, 0xd6, 0xe6, 0x6f,
+	0x08, 0x4e, 0x85, 0xe8, 0x3c, 0x8b, 0x8a, 0x7e, 0x83, 0x60, 0x2c, 0xba, 0x34, 0xae, 0x0b, 0xb2,
+	0xc1, 0xf2, 0xbc, 0x08, 0x23, 0x41, 0xe2, 0x35, 0xbe, 0xcd, 0x45, 0x55, 0xc3, 0x41, 0xef, 0x47,
+	0xed, 0xfb, 0xbd, 0xda, 0xb5, 0xa6, 0x3f, 0x20, 0x28, 0xc5, 0x11, 0x92, 0x02, 0x2b, 0x70, 0x42,
+	0x2a, 0xea, 0x9d, 0x71, 0xfd, 0x53, 0x05, 0x3d, 0xf8, 0xc6, 0xe7, 0xa0, 0xe0, 0x34, 0x5d, 0xab,
+	0x4a, 0x1d, 0xe3, 0x2e, 0x9f, 0xf5, 0x13, 0x7a, 0xab, 0x03, 0x2f, 0x77, 0x20, 0xd9, 0x95, 0x6c,
+	0xbf, 0x20, 0xb8, 0xd0, 0x99, 0xe5, 0xd2, 0xce, 0x27, 0x74, 0xd3, 0x35, 0x32, 0xaa, 0x37, 0x06,
+	0xb0, 0xe5, 0xc1, 0xd6, 0xd6, 0xa9, 0xbd, 0xce, 0x79, 0x3f, 0xaf, 0x17, 0x78, 0xcf, 0x0a, 0xb5,
+	0xd7, 0x7b, 0x26, 0xee, 0x3e, 0x82, 0x8b, 0x09, 0xb4, 0x53, 0x68, 0xdc, 0x33, 0x15, 0xbf, 0xca,
+	0xc1, 0xa5, 0xe3, 0xe9, 0x50, 0xab, 0x96, 0x55, 0xca, 0x9b, 0xbe, 0x94, 0xce, 0x4e, 0x43, 0x5c,
+	0x49, 0x23, 0xe5, 0x89, 0xe4, 0x4d, 0x76, 0x67, 0xa7, 0x61, 0x48, 0xc9, 0xbd, 0x26, 0x3e, 0x09,
+	0xfd, 0x75, 0x53, 0x6c, 0xad, 0x82, 0xee, 0x35, 0x79, 0x0f, 0xdd, 0x2e, 0xe6, 0x65, 0x0f, 0xdd,
+	0xee, 0xd9, 0xb4, 0xfc, 0x8a, 0x60, 0x3a, 0x8d, 0x0e, 0x72, 0x6e, 0xa2, 0xc7, 0x08, 0xea, 0xd9,
+	0x31, 0xf2, 0x04, 0x33, 0x39, 0xe7, 0x5f, 0x30, 0x82, 0xf7, 0x7b, 0xd4, 0xa1, 0x89, 0xc7, 0xb3,
+	0x3a, 0x03, 0xc5, 0x76, 0x90, 0xac, 0x71, 0x14, 0x06, 0xf8, 0x5c, 0x48, 0x8c, 0xf8, 0x50, 0x3f,
+	0x00, 0x25, 0xaa, 0xd3, 0x6d, 0x97, 0x39, 0xb4, 0x2b, 0x83, 0xe2, 0x1d, 0x34, 0x67, 0x3b, 0x06,
+	0x93, 0x0c, 0x6e, 0xc0, 0x80, 0x37, 0xce, 0xb7, 0x51, 0x93, 0xc9, 0x02, 0x73, 0xbc, 0x54, 0x59,
+	0x60, 0xf1, 0x3b, 0x30, 0xc8, 0x99, 0xdb, 0xc5, 0x5c, 0xa6, 0x28, 0xba, 0x84, 0xa9, 0x4d, 0x18,
+	0x89, 0xfe, 0xe2, 0x2f, 0x43, 0x8f, 0xd5, 0xb0, 0x58, 0x86, 0x18, 0xf2, 0xae, 0x2d, 0x8f, 0xbb,
+	0xbc, 0xce, 0xdb, 0xde, 0x39, 0xd8, 0x34, 0xea, 0xd4, 0xb4, 0x4c, 0xab, 0xc6, 0x17, 0x71, 0x5e,
+	0x6f, 0x75, 0x78, 0xbf, 0xba, 0xd6, 0xa6, 0x59, 0x37, 0xbd, 0x53, 0x32, 0x2f, 0x4e, 0xc9, 0xa0,
+	0x43, 0x25, 0xf0, 0x72, 0x54, 0x98, 0x15, 0xc6, 0x36, 0x7c, 0x91, 0x7d, 0x29, 0x51, 0x48, 0xca,
+	0x4f, 0x41, 0xe9, 0x04, 0x90, 0x42, 0x2e, 0x42, 0x7e, 0x9d, 0xb1, 0x0d, 0xa9, 0x63, 0x8a, 0xad,
+	0xc8, 0xd1, 0x1c, 0xa3, 0xde, 0x92, 0x91, 0x97, 0xdc, 0xcd, 0x8d, 0x76, 0xe7, 0x74, 0xdc, 0x21,
+	0xd5, 0x69, 0xca, 0x37, 0xe0, 0x6c, 0xc7, 0x68, 0x92, 0xe8, 0xad, 0x23, 0xe1, 0x86, 0xca, 0xd3,
+	0xf1, 0x64, 0xc5, 0xc0, 0x56, 0x14, 0x39, 0xef, 0x41, 0x04, 0xf5, 0x73, 0x38, 0xd5, 0x36, 0xe8,
+	0x69, 0x78, 0x83, 0xf2, 0xe3, 0x17, 0x60, 0x80, 0x97, 0x89, 0xf7, 0x11, 0x0c, 0x0a, 0x93, 0x8f,
+	0x5f, 0x8b, 0x0d, 0xd5, 0xfe, 0xb2, 0x50, 0x2e, 0xa7, 0x1b, 0x2c, 0x64, 0x53, 0x27, 0xbf, 0xfc,
+	0xf3, 0x9f, 0xef, 0x72, 0xaf, 0xe0, 0x71, 0x12, 0xf7, 0x9e, 0x11, 0x4f, 0x0b, 0xfc, 0x23, 0x82,
+	0x42, 0x40, 0x1c, 0x6b, 0xc7, 0x27, 0x39, 0xfa, 0xfc, 0x50, 0x48, 0xea, 0xf1, 0x92, 0xd7, 0x9b,
+	0x9c, 0xd7, 0x02, 0x9e, 0x23, 0x89, 0xef, 0x2c, 0xb2, 0x2b, 0xa7, 0x61, 0x8f, 0xec, 0x7a, 0x2b,
+	0x65, 0x0f, 0x3f, 0x40, 0x00, 0xa1, 0x79, 0x4b, 0x9b, 0x3c, 0x90, 0x70, 0x26, 0x3d, 0x40, 0xd2,
+	0x5d, 0xe0, 0x74, 0x09, 0xbe, 0x92, 0x4c, 0xd7, 0x6e, 0xf1, 0xc5, 0xdf, 0x23, 0xc8, 0x7b, 0xf6,
+	0x13, 0x5f, 0x3a, 0x3e, 0x63, 0xc8, 0x31, 0x2b, 0xd3, 0x69, 0x86, 0x4a, 0x5a, 0x4b, 0x9c, 0xd6,
+	0x5b, 0x78, 0x31, 0x93, 0x8a, 0x76, 0x95, 0x5a, 0x64, 0x57, 0xd8, 0xed, 0x3d, 0xec, 0xf9, 0xe4,
+	0xb6, 0xab, 0x0d, 0x5f, 0x4d, 0x29, 0xd1, 0x11, 0x43, 0xaa, 0xbc, 0x9e, 0x19, 0x27, 0x4b, 0x59,
+	0xe4, 0xa5, 0xcc, 0xe3, 0x72, 0x7c, 0x29, 0x12, 0x42, 0x76, 0xa3, 0x4e, 0x63, 0x0f, 0xff, 0x8b,
+	0xa0, 0x18, 0x77, 0x3b, 0xe3, 0x6b, 0x19, 0x19, 0x45, 0x3d, 0xa2, 0xf2, 0x76, 0xb7, 0x70, 0x59,
+	0xd7, 0x87, 0xbc, 0xae, 0x65, 0x7c, 0x33, 0x7b, 0x5d, 0x84, 0x5f, 0x33, 0x64, 0xb7, 0x65, 0x3e,
+	0xf7, 0xf0, 0x7f, 0x08, 0xc6, 0x8e, 0x35, 0x22, 0x78, 0xa9, 0x4b, 0xc2, 0x21, 0x37, 0xa7, 0xdc,
+	0x78, 0xa2, 0x18, 0xb2, 0xf2, 0x77, 0x79, 0xe5, 0x8b, 0xf8, 0x8d, 0x2e, 0x2a, 0x6f, 0xf2, 0x52,
+	0x7e, 0x42, 0x30, 0x14, 0xf2, 0x1f, 0x38, 0x69, 0xdf, 0xb6, 0xf9, 0x1b, 0x65, 0x36, 0x03, 0x42,
+	0xd2, 0xbe, 0xca, 0x69, 0xcf, 0x60, 0x2d, 0x89, 0xf6, 0x5d, 0xea, 0xd0, 0xd0, 0x5e, 0xff, 0x19,
+	0xb5, 0xb9, 0x81, 0xb9, 0x94, 0x32, 0x86, 0x8d, 0x92, 0x32, 0x9f, 0x0d, 0x24, 0x59, 0xcf, 0x70,
+	0xd6, 0xd3, 0x78, 0x8a, 0xc4, 0xff, 0xab, 0xc6, 0x22, 0x7c, 0x1f, 0x20, 0x18, 0x8e, 0xdc, 0xea,
+	0xb8, 0x9c, 0x32, 0x73, 0xc8, 0x71, 0x28, 0x73, 0x99, 0x30, 0x92, 0xec, 0x65, 0x4e, 0x76, 0x02,
+	0x5f, 0x88, 0x25, 0xeb, 0xf9, 0x0b, 0xff, 0xb4, 0xf7, 0x84, 0x8d, 0x9a, 0x82, 0x24, 0x61, 0x3b,
+	0x1a, 0x12, 0x65, 0x3e, 0x1b, 0x28, 0xb5, 0xb0, 0x15, 0x77, 0x73, 0x63, 0x8d, 0xb6, 0xbc, 0x46,
+	0xfd, 0xe1, 0x41, 0x09, 0x3d, 0x3a, 0x28, 0xa1, 0xc7, 0x07, 0x25, 0xf4, 0xed, 0x61, 0xa9, 0xef,
+	0xd1, 0x61, 0xa9, 0xef, 0xaf, 0xc3, 0x52, 0x1f, 0x28, 0x26, 0x8b, 0xe3, 0xb0, 0x8a, 0x3e, 0x5b,
+	0xa8, 0x99, 0xce, 0xba, 0x5b, 0xd1, 0xaa, 0xac, 0x1e, 0xca, 0x75, 0xc5, 0x64, 0xe1, 0xcc, 0xdb,
+	0xa1, 0xdc, 0xde, 0x1b, 0xca, 0xae, 0x0c, 0xf2, 0xbf, 0x21, 0xe7, 0xfe, 0x1f, 0x00, 0x1d, 0xcf,
+	0xf9, 0x51, 0x4f, 0x15, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Params queries params of the attribute module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Attribute queries attributes on a given account (address) for one (or more) with the given name
+	Attribute(ctx context.Context, in *QueryAttributeRequest, opts ...grpc.CallOption) (*QueryAttributeResponse, error)
+	// Attributes queries attributes on a given account (address) for any defined attributes
+	Attributes(ctx context.Context, in *QueryAttributesRequest, opts ...grpc.CallOption) (*QueryAttributesResponse, error)
+	// Scan queries attributes on a given account (address) for any that match the provided suffix
+	Scan(ctx context.Context, in *QueryScanRequest, opts ...grpc.CallOption) (*QueryScanResponse, error)
+	// AttributeAccounts queries accounts on a given attribute name
+	AttributeAccounts(ctx context.Context, in *QueryAttributeAccountsRequest, opts ...grpc.CallOption) (*QueryAttributeAccountsResponse, error)
+	// AttributeAccountsByValue queries accounts that have an attribute with a given name and value hash.
+	// The value hash is the sha256 hash of the attribute's value.
+	AttributeAccountsByValue(ctx context.Context, in *QueryAttributeAccountsByValueRequest, opts ...grpc.CallOption) (*QueryAttributeAccountsByValueResponse, error)
+	// AttributeAccountsByValueRange returns the attributes with the given name and a typed value within a range.
+	// Only INT64, FLOAT64, and TIMESTAMP attribute values can be queried by range.
+	AttributeAccountsByValueRange(ctx context.Context, in *QueryAttributeAccountsByValueRangeRequest, opts ...grpc.CallOption) (*QueryAttributeAccountsByValueRangeResponse, error)
+	// AccountData returns the accountdata for a specified account.
+	AccountData(ctx context.Context, in *QueryAccountDataRequest, opts ...grpc.CallOption) (*QueryAccountDataResponse, error)
+	// AttributeQuota returns how many more attribute names an account can have, and
+	// (optionally) how many more values it can have for an attribute name.
+	AttributeQuota(ctx context.Context, in *QueryAttributeQuotaRequest, opts ...grpc.CallOption) (*QueryAttributeQuotaResponse, error)
+	// AttributeHook returns the wasm contract hook registered for an attribute name.
+	AttributeHook(ctx context.Context, in *QueryAttributeHookRequest, opts ...grpc.CallOption) (*QueryAttributeHookResponse, error)
+	// BulkAttributes returns the attributes (optionally only those with a given name) of each of several accounts.
+	BulkAttributes(ctx context.Context, in *QueryBulkAttributesRequest, opts ...grpc.CallOption) (*QueryBulkAttributesResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/provenance.attribute.v1.Query/Params", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryScanRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) Attribute(ctx context.Context, in *QueryAttributeRequest, opts ...grpc.CallOption) (*QueryAttributeResponse, error) {
+	out := new(QueryAttributeResponse)
+	err := c.cc.Invoke(ctx, "/provenance.attribute.v1.Query/Attribute", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryScanRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Suffix) > 0 {
-		i -= len(m.Suffix)
-		copy(dAtA[i:], m.Suffix)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Suffix)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Account) > 0 {
-		i -= len(m.Account)
-		copy(dAtA[i:], m.Account)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Account)))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) Attributes(ctx context.Context, in *QueryAttributesRequest, opts ...grpc.CallOption) (*QueryAttributesResponse, error) {
+	out := new(QueryAttributesResponse)
+	err := c.cc.Invoke(ctx, "/provenance.attribute.v1.Query/Attributes", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryScanResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) Scan(ctx context.Context, in *QueryScanRequest, opts ...grpc.CallOption) (*QueryScanResponse, error) {
+	out := new(QueryScanResponse)
+	err := c.cc.Invoke(ctx, "/provenance.attribute.v1.Query/Scan", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryScanResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) AttributeAccounts(ctx context.Context, in *QueryAttributeAccountsRequest, opts ...grpc.CallOption) (*QueryAttributeAccountsResponse, error) {
+	out := new(QueryAttributeAccountsResponse)
+	err := c.cc.Invoke(ctx, "/provenance.attribute.v1.Query/AttributeAccounts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryScanResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Attributes) > 0 {
-		for iNdEx := len(m.Attributes) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Attributes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if len(m.Account) > 0 {
-		i -= len(m.Account)
-		copy(dAtA[i:], m.Account)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Account)))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) AttributeAccountsByValue(ctx context.Context, in *QueryAttributeAccountsByValueRequest, opts ...grpc.CallOption) (*QueryAttributeAccountsByValueResponse, error) {
+	out := new(QueryAttributeAccountsByValueResponse)
+	err := c.cc.Invoke(ctx, "/provenance.attribute.v1.Query/AttributeAccountsByValue", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryAttributeAccountsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) AttributeAccountsByValueRange(ctx context.Context, in *QueryAttributeAccountsByValueRangeRequest, opts ...grpc.CallOption) (*QueryAttributeAccountsByValueRangeResponse, error) {
+	out := new(QueryAttributeAccountsByValueRangeResponse)
+	err := c.cc.Invoke(ctx, "/provenance.attribute.v1.Query/AttributeAccountsByValueRange", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryAttributeAccountsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) AccountData(ctx context.Context, in *QueryAccountDataRequest, opts ...grpc.CallOption) (*QueryAccountDataResponse, error) {
+	out := new(QueryAccountDataResponse)
+	err := c.cc.Invoke(ctx, "/provenance.attribute.v1.Query/AccountData", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryAttributeAccountsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0x9a
+func (c *queryClient) AttributeQuota(ctx context.Context, in *QueryAttributeQuotaRequest, opts ...grpc.CallOption) (*QueryAttributeQuotaResponse, error) {
+	out := new(QueryAttributeQuotaResponse)
+	err := c.cc.Invoke(ctx, "/provenance.attribute.v1.Query/AttributeQuota", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.AttributeName) > 0 {
-		i -= len(m.AttributeName)
-		copy(dAtA[i:], m.AttributeName)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.AttributeName)))
-		i--
-		dAtA[i] = 0xa
+	return out, nil
+}
+
+func (c *queryClient) AttributeHook(ctx context.Context, in *QueryAttributeHookRequest, opts ...grpc.CallOption) (*QueryAttributeHookResponse, error) {
+	out := new(QueryAttributeHookResponse)
+	err := c.cc.Invoke(ctx, "/provenance.attribute.v1.Query/AttributeHook", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryAttributeAccountsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) BulkAttributes(ctx context.Context, in *QueryBulkAttributesRequest, opts ...grpc.CallOption) (*QueryBulkAttributesResponse, error) {
+	out := new(QueryBulkAttributesResponse)
+	err := c.cc.Invoke(ctx, "/provenance.attribute.v1.Query/BulkAttributes", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryAttributeAccountsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Params queries params of the attribute module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Attribute queries attributes on a given account (address) for one (or more) with the given name
+	Attribute(context.Context, *QueryAttributeRequest) (*QueryAttributeResponse, error)
+	// Attributes queries attributes on a given account (address) for any defined attributes
+	Attributes(context.Context, *QueryAttributesRequest) (*QueryAttributesResponse, error)
+	// Scan queries attributes on a given account (address) for any that match the provided suffix
+	Scan(context.Context, *QueryScanRequest) (*QueryScanResponse, error)
+	// AttributeAccounts queries accounts on a given attribute name
+	AttributeAccounts(context.Context, *QueryAttributeAccountsRequest) (*QueryAttributeAccountsResponse, error)
+	// AttributeAccountsByValue queries accounts that have an attribute with a given name and value hash.
+	// The value hash is the sha256 hash of the attribute's value.
+	AttributeAccountsByValue(context.Context, *QueryAttributeAccountsByValueRequest) (*QueryAttributeAccountsByValueResponse, error)
+	// AttributeAccountsByValueRange returns the attributes with the given name and a typed value within a range.
+	// Only INT64, FLOAT64, and TIMESTAMP attribute values can be queried by range.
+	AttributeAccountsByValueRange(context.Context, *QueryAttributeAccountsByValueRangeRequest) (*QueryAttributeAccountsByValueRangeResponse, error)
+	// AccountData returns the accountdata for a specified account.
+	AccountData(context.Context, *QueryAccountDataRequest) (*QueryAccountDataResponse, error)
+	// AttributeQuota returns how many more attribute names an account can have, and
+	// (optionally) how many more values it can have for an attribute name.
+	AttributeQuota(context.Context, *QueryAttributeQuotaRequest) (*QueryAttributeQuotaResponse, error)
+	// AttributeHook returns the wasm contract hook registered for an attribute name.
+	AttributeHook(context.Context, *QueryAttributeHookRequest) (*QueryAttributeHookResponse, error)
+	// BulkAttributes returns the attributes (optionally only those with a given name) of each of several accounts.
+	BulkAttributes(context.Context, *QueryBulkAttributesRequest) (*QueryBulkAttributesResponse, error)
 }
 
-func (m *QueryAttributeAccountsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0x9a
-	}
-	if len(m.Accounts) > 0 {
-		for iNdEx := len(m.Accounts) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Accounts[iNdEx])
-			copy(dAtA[i:], m.Accounts[iNdEx])
-			i = encodeVarintQuery(dAtA, i, uint64(len(m.Accounts[iNdEx])))
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
 }
 
-func (m *QueryAccountDataRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
-
-func (m *QueryAccountDataRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedQueryServer) Attribute(ctx context.Context, req *QueryAttributeRequest) (*QueryAttributeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Attribute not implemented")
 }
-
-func (m *QueryAccountDataRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Account) > 0 {
-		i -= len(m.Account)
-		copy(dAtA[i:], m.Account)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Account)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (*UnimplementedQueryServer) Attributes(ctx context.Context, req *QueryAttributesRequest) (*QueryAttributesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Attributes not implemented")
 }
-
-func (m *QueryAccountDataResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedQueryServer) Scan(ctx context.Context, req *QueryScanRequest) (*QueryScanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Scan not implemented")
 }
-
-func (m *QueryAccountDataResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedQueryServer) AttributeAccounts(ctx context.Context, req *QueryAttributeAccountsRequest) (*QueryAttributeAccountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AttributeAccounts not implemented")
+}
+func (*UnimplementedQueryServer) AttributeAccountsByValue(ctx context.Context, req *QueryAttributeAccountsByValueRequest) (*QueryAttributeAccountsByValueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AttributeAccountsByValue not implemented")
+}
+func (*UnimplementedQueryServer) AttributeAccountsByValueRange(ctx context.Context, req *QueryAttributeAccountsByValueRangeRequest) (*QueryAttributeAccountsByValueRangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AttributeAccountsByValueRange not implemented")
+}
+func (*UnimplementedQueryServer) AccountData(ctx context.Context, req *QueryAccountDataRequest) (*QueryAccountDataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccountData not implemented")
+}
+func (*UnimplementedQueryServer) AttributeQuota(ctx context.Context, req *QueryAttributeQuotaRequest) (*QueryAttributeQuotaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AttributeQuota not implemented")
+}
+func (*UnimplementedQueryServer) AttributeHook(ctx context.Context, req *QueryAttributeHookRequest) (*QueryAttributeHookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AttributeHook not implemented")
+}
+func (*UnimplementedQueryServer) BulkAttributes(ctx context.Context, req *QueryBulkAttributesRequest) (*QueryBulkAttributesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BulkAttributes not implemented")
 }
 
-func (m *QueryAccountDataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Value) > 0 {
-		i -= len(m.Value)
-		copy(dAtA[i:], m.Value)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Value)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
 	}
-	var l int
-	_ = l
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.attribute.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_Attribute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAttributeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	if interceptor == nil {
+		return srv.(QueryServer).Attribute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.attribute.v1.Query/Attribute",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Attribute(ctx, req.(*QueryAttributeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAttributeRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_Attributes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAttributesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Account)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).Attributes(ctx, in)
 	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.attribute.v1.Query/Attributes",
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Attributes(ctx, req.(*QueryAttributesRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAttributeResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_Scan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryScanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Account)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).Scan(ctx, in)
 	}
-	if len(m.Attributes) > 0 {
-		for _, e := range m.Attributes {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.attribute.v1.Query/Scan",
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Scan(ctx, req.(*QueryScanRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAttributesRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_AttributeAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAttributeAccountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Account)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).AttributeAccounts(ctx, in)
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.attribute.v1.Query/AttributeAccounts",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AttributeAccounts(ctx, req.(*QueryAttributeAccountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAttributesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_AttributeAccountsByValue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAttributeAccountsByValueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Account)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).AttributeAccountsByValue(ctx, in)
 	}
-	if len(m.Attributes) > 0 {
-		for _, e := range m.Attributes {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.attribute.v1.Query/AttributeAccountsByValue",
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AttributeAccountsByValue(ctx, req.(*QueryAttributeAccountsByValueRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryScanRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_AttributeAccountsByValueRange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAttributeAccountsByValueRangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Account)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).AttributeAccountsByValueRange(ctx, in)
 	}
-	l = len(m.Suffix)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.attribute.v1.Query/AttributeAccountsByValueRange",
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AttributeAccountsByValueRange(ctx, req.(*QueryAttributeAccountsByValueRangeRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryScanResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_AccountData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAccountDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Account)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).AccountData(ctx, in)
 	}
-	if len(m.Attributes) > 0 {
-		for _, e := range m.Attributes {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.attribute.v1.Query/AccountData",
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AccountData(ctx, req.(*QueryAccountDataRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAttributeAccountsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_AttributeQuota_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAttributeQuotaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.AttributeName)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).AttributeQuota(ctx, in)
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 2 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.attribute.v1.Query/AttributeQuota",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AttributeQuota(ctx, req.(*QueryAttributeQuotaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAttributeAccountsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_AttributeHook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAttributeHookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Accounts) > 0 {
-		for _, s := range m.Accounts {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).AttributeHook(ctx, in)
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 2 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.attribute.v1.Query/AttributeHook",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AttributeHook(ctx, req.(*QueryAttributeHookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAccountDataRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_BulkAttributes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBulkAttributesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BulkAttributes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.attribute.v1.Query/BulkAttributes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BulkAttributes(ctx, req.(*QueryBulkAttributesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "provenance.attribute.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "Attribute",
+			Handler:    _Query_Attribute_Handler,
+		},
+		{
+			MethodName: "Attributes",
+			Handler:    _Query_Attributes_Handler,
+		},
+		{
+			MethodName: "Scan",
+			Handler:    _Query_Scan_Handler,
+		},
+		{
+			MethodName: "AttributeAccounts",
+			Handler:    _Query_AttributeAccounts_Handler,
+		},
+		{
+			MethodName: "AttributeAccountsByValue",
+			Handler:    _Query_AttributeAccountsByValue_Handler,
+		},
+		{
+			MethodName: "AttributeAccountsByValueRange",
+			Handler:    _Query_AttributeAccountsByValueRange_Handler,
+		},
+		{
+			MethodName: "AccountData",
+			Handler:    _Query_AccountData_Handler,
+		},
+		{
+			MethodName: "AttributeQuota",
+			Handler:    _Query_AttributeQuota_Handler,
+		},
+		{
+			MethodName: "AttributeHook",
+			Handler:    _Query_AttributeHook_Handler,
+		},
+		{
+			MethodName: "BulkAttributes",
+			Handler:    _Query_BulkAttributes_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "provenance/attribute/v1/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Account)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAccountDataResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Value)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *QueryAttributeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *QueryAttributeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAttributeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAttributeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAttributeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAttributeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Attributes) > 0 {
+		for iNdEx := len(m.Attributes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Attributes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAttributesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAttributesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAttributesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAttributesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAttributesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAttributesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Attributes) > 0 {
+		for iNdEx := len(m.Attributes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Attributes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryScanRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryScanRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryScanRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Suffix) > 0 {
+		i -= len(m.Suffix)
+		copy(dAtA[i:], m.Suffix)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Suffix)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryScanResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryScanResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryScanResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Attributes) > 0 {
+		for iNdEx := len(m.Attributes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Attributes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAttributeAccountsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAttributeAccountsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAttributeAccountsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
+	}
+	if len(m.AttributeName) > 0 {
+		i -= len(m.AttributeName)
+		copy(dAtA[i:], m.AttributeName)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AttributeName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAttributeAccountsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAttributeAccountsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAttributeAccountsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
+	}
+	if m.Truncated {
+		i--
+		if m.Truncated {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Accounts) > 0 {
+		for iNdEx := len(m.Accounts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Accounts[iNdEx])
+			copy(dAtA[i:], m.Accounts[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Accounts[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAttributeAccountsByValueRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAttributeAccountsByValueRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAttributeAccountsByValueRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
+	}
+	if len(m.ValueHash) > 0 {
+		i -= len(m.ValueHash)
+		copy(dAtA[i:], m.ValueHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValueHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.AttributeName) > 0 {
+		i -= len(m.AttributeName)
+		copy(dAtA[i:], m.AttributeName)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AttributeName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAttributeAccountsByValueResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAttributeAccountsByValueResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAttributeAccountsByValueResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
+	}
+	if len(m.Accounts) > 0 {
+		for iNdEx := len(m.Accounts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Accounts[iNdEx])
+			copy(dAtA[i:], m.Accounts[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Accounts[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAttributeAccountsByValueRangeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAttributeAccountsByValueRangeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAttributeAccountsByValueRangeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
+	}
+	if len(m.Max) > 0 {
+		i -= len(m.Max)
+		copy(dAtA[i:], m.Max)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Max)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Min) > 0 {
+		i -= len(m.Min)
+		copy(dAtA[i:], m.Min)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Min)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ValueType != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ValueType))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.AttributeName) > 0 {
+		i -= len(m.AttributeName)
+		copy(dAtA[i:], m.AttributeName)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AttributeName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAttributeAccountsByValueRangeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAttributeAccountsByValueRangeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAttributeAccountsByValueRangeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
+	}
+	if len(m.Attributes) > 0 {
+		for iNdEx := len(m.Attributes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Attributes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAccountDataRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccountDataRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccountDataRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAccountDataResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccountDataResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccountDataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAttributeQuotaRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAttributeQuotaRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAttributeQuotaRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAttributeQuotaResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAttributeQuotaResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAttributeQuotaResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Values != nil {
+		{
+			size, err := m.Values.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Names.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *AttributeQuota) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AttributeQuota) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AttributeQuota) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Unlimited {
+		i--
+		if m.Unlimited {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Remaining != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Remaining))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Used != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Used))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Max != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Max))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAttributeHookRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAttributeHookRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAttributeHookRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAttributeHookResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAttributeHookResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAttributeHookResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Hook != nil {
+		{
+			size, err := m.Hook.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBulkAttributesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBulkAttributesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBulkAttributesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Accounts) > 0 {
+		for iNdEx := len(m.Accounts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Accounts[iNdEx])
+			copy(dAtA[i:], m.Accounts[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Accounts[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBulkAttributesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBulkAttributesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBulkAttributesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Accounts) > 0 {
+		for iNdEx := len(m.Accounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Accounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AccountAttributes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccountAttributes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccountAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Attributes) > 0 {
+		for iNdEx := len(m.Attributes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Attributes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAttributeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAttributeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Attributes) > 0 {
+		for _, e := range m.Attributes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAttributesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAttributesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Attributes) > 0 {
+		for _, e := range m.Attributes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryScanRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Suffix)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryScanResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Attributes) > 0 {
+		for _, e := range m.Attributes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAttributeAccountsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AttributeName)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAttributeAccountsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Accounts) > 0 {
+		for _, s := range m.Accounts {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Truncated {
+		n += 2
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAttributeAccountsByValueRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AttributeName)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ValueHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAttributeAccountsByValueResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Accounts) > 0 {
+		for _, s := range m.Accounts {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAttributeAccountsByValueRangeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AttributeName)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ValueType != 0 {
+		n += 1 + sovQuery(uint64(m.ValueType))
+	}
+	l = len(m.Min)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Max)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAttributeAccountsByValueRangeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Attributes) > 0 {
+		for _, e := range m.Attributes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAccountDataRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAccountDataResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAttributeQuotaRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAttributeQuotaResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Names.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Values != nil {
+		l = m.Values.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *AttributeQuota) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Max != 0 {
+		n += 1 + sovQuery(uint64(m.Max))
+	}
+	if m.Used != 0 {
+		n += 1 + sovQuery(uint64(m.Used))
+	}
+	if m.Remaining != 0 {
+		n += 1 + sovQuery(uint64(m.Remaining))
+	}
+	if m.Unlimited {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryAttributeHookRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAttributeHookResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Hook != nil {
+		l = m.Hook.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBulkAttributesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Accounts) > 0 {
+		for _, s := range m.Accounts {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBulkAttributesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Accounts) > 0 {
+		for _, e := range m.Accounts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *AccountAttributes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Attributes) > 0 {
+		for _, e := range m.Attributes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAttributeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAttributeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAttributeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAttributeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAttributeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAttributeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attributes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attributes = append(m.Attributes, Attribute{})
+			if err := m.Attributes[len(m.Attributes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAttributesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAttributesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAttributesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAttributesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAttributesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAttributesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attributes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attributes = append(m.Attributes, Attribute{})
+			if err := m.Attributes[len(m.Attributes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryScanRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryScanRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryScanRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Suffix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Suffix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryScanResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryScanResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryScanResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attributes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attributes = append(m.Attributes, Attribute{})
+			if err := m.Attributes[len(m.Attributes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAttributeAccountsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1798,12 +4510,80 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAttributeAccountsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAttributeAccountsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttributeName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AttributeName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 99:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1825,7 +4605,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAttributeAccountsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1848,15 +4628,67 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAttributeAccountsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAttributeAccountsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Accounts", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Accounts = append(m.Accounts, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Truncated", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Truncated = bool(v != 0)
+		case 99:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1883,7 +4715,10 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1908,7 +4743,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAttributeRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAttributeAccountsByValueRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1931,15 +4766,15 @@ func (m *QueryAttributeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAttributeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAttributeAccountsByValueRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAttributeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAttributeAccountsByValueRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AttributeName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1967,11 +4802,131 @@ func (m *QueryAttributeRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Account = string(dAtA[iNdEx:postIndex])
+			m.AttributeName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValueHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValueHash = append(m.ValueHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.ValueHash == nil {
+				m.ValueHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 99:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAttributeAccountsByValueResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAttributeAccountsByValueResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAttributeAccountsByValueResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accounts", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1999,9 +4954,9 @@ func (m *QueryAttributeRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Accounts = append(m.Accounts, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 3:
+		case 99:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -2031,7 +4986,7 @@ func (m *QueryAttributeRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+				m.Pagination = &query.PageResponse{}
 			}
 			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -2058,7 +5013,7 @@ func (m *QueryAttributeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAttributeResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAttributeAccountsByValueRangeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2081,15 +5036,15 @@ func (m *QueryAttributeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAttributeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAttributeAccountsByValueRangeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAttributeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAttributeAccountsByValueRangeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AttributeName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2117,13 +5072,32 @@ func (m *QueryAttributeResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Account = string(dAtA[iNdEx:postIndex])
+			m.AttributeName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValueType", wireType)
+			}
+			m.ValueType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValueType |= AttributeType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Attributes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Min", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2133,27 +5107,57 @@ func (m *QueryAttributeResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Attributes = append(m.Attributes, Attribute{})
-			if err := m.Attributes[len(m.Attributes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Min = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Max", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Max = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 99:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -2183,7 +5187,7 @@ func (m *QueryAttributeResponse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+				m.Pagination = &query.PageRequest{}
 			}
 			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -2210,7 +5214,7 @@ func (m *QueryAttributeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAttributesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAttributeAccountsByValueRangeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2233,17 +5237,17 @@ func (m *QueryAttributesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAttributesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAttributeAccountsByValueRangeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAttributesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAttributeAccountsByValueRangeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Attributes", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2253,25 +5257,27 @@ func (m *QueryAttributesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Account = string(dAtA[iNdEx:postIndex])
+			m.Attributes = append(m.Attributes, Attribute{})
+			if err := m.Attributes[len(m.Attributes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 99:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -2301,7 +5307,7 @@ func (m *QueryAttributesRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+				m.Pagination = &query.PageResponse{}
 			}
 			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -2328,7 +5334,7 @@ func (m *QueryAttributesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAttributesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAccountDataRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2351,10 +5357,10 @@ func (m *QueryAttributesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAttributesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAccountDataRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAttributesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAccountDataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2389,45 +5395,61 @@ func (m *QueryAttributesResponse) Unmarshal(dAtA []byte) error {
 			}
 			m.Account = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Attributes", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAccountDataResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Attributes = append(m.Attributes, Attribute{})
-			if err := m.Attributes[len(m.Attributes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 3:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAccountDataResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAccountDataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2437,27 +5459,23 @@ func (m *QueryAttributesResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2480,7 +5498,7 @@ func (m *QueryAttributesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryScanRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAttributeQuotaRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2503,10 +5521,10 @@ func (m *QueryScanRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryScanRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAttributeQuotaRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryScanRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAttributeQuotaRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2536,16 +5554,98 @@ func (m *QueryScanRequest) Unmarshal(dAtA []byte) error {
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAttributeQuotaResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Account = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAttributeQuotaResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAttributeQuotaResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Suffix", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Names", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2555,27 +5655,28 @@ func (m *QueryScanRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Suffix = string(dAtA[iNdEx:postIndex])
+			if err := m.Names.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2602,10 +5703,10 @@ func (m *QueryScanRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+			if m.Values == nil {
+				m.Values = &AttributeQuota{}
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Values.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2630,7 +5731,7 @@ func (m *QueryScanRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryScanResponse) Unmarshal(dAtA []byte) error {
+func (m *AttributeQuota) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2653,17 +5754,17 @@ func (m *QueryScanResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryScanResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: AttributeQuota: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryScanResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AttributeQuota: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Max", wireType)
 			}
-			var stringLen uint64
+			m.Max = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2673,29 +5774,16 @@ func (m *QueryScanResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Max |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Account = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Attributes", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Used", wireType)
 			}
-			var msglen int
+			m.Used = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2705,31 +5793,16 @@ func (m *QueryScanResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Used |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Attributes = append(m.Attributes, Attribute{})
-			if err := m.Attributes[len(m.Attributes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Remaining", wireType)
 			}
-			var msglen int
+			m.Remaining = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2739,28 +5812,31 @@ func (m *QueryScanResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Remaining |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unlimited", wireType)
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
+			m.Unlimited = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2782,7 +5858,7 @@ func (m *QueryScanResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAttributeAccountsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAttributeHookRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2805,15 +5881,15 @@ func (m *QueryAttributeAccountsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAttributeAccountsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAttributeHookRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAttributeAccountsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAttributeHookRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AttributeName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2841,11 +5917,61 @@ func (m *QueryAttributeAccountsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AttributeName = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 99:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAttributeHookResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAttributeHookResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAttributeHookResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Hook", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2872,10 +5998,10 @@ func (m *QueryAttributeAccountsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+			if m.Hook == nil {
+				m.Hook = &AttributeHook{}
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Hook.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2900,7 +6026,7 @@ func (m *QueryAttributeAccountsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAttributeAccountsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryBulkAttributesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2923,10 +6049,10 @@ func (m *QueryAttributeAccountsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAttributeAccountsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBulkAttributesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAttributeAccountsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBulkAttributesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2961,11 +6087,11 @@ func (m *QueryAttributeAccountsResponse) Unmarshal(dAtA []byte) error {
 			}
 			m.Accounts = append(m.Accounts, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 99:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2975,27 +6101,23 @@ func (m *QueryAttributeAccountsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3018,7 +6140,7 @@ func (m *QueryAttributeAccountsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAccountDataRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryBulkAttributesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3041,17 +6163,17 @@ func (m *QueryAccountDataRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAccountDataRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBulkAttributesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAccountDataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBulkAttributesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Accounts", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3061,23 +6183,25 @@ func (m *QueryAccountDataRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Account = string(dAtA[iNdEx:postIndex])
+			m.Accounts = append(m.Accounts, AccountAttributes{})
+			if err := m.Accounts[len(m.Accounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3100,7 +6224,7 @@ func (m *QueryAccountDataRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAccountDataResponse) Unmarshal(dAtA []byte) error {
+func (m *AccountAttributes) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3123,15 +6247,15 @@ func (m *QueryAccountDataResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAccountDataResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: AccountAttributes: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAccountDataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AccountAttributes: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3159,7 +6283,41 @@ func (m *QueryAccountDataResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attributes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attributes = append(m.Attributes, Attribute{})
+			if err := m.Attributes[len(m.Attributes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -0,0 +1,1237 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: provenance/attribute/v1/subscription.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// AttributeChangesRequest is the request type for the Subscription/AttributeChanges RPC method.
+// At least one of account and name must be provided. When both are provided, an event must match both.
+type AttributeChangesRequest struct {
+	// account is the address of the account whose attribute changes should be streamed.
+	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	// name is the attribute name whose changes should be streamed.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (m *AttributeChangesRequest) Reset()         { *m = AttributeChangesRequest{} }
+func (m *AttributeChangesRequest) String() string { return proto.CompactTextString(m) }
+func (*AttributeChangesRequest) ProtoMessage()    {}
+func (*AttributeChangesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a95a3f48eeddfa43, []int{0}
+}
+func (m *AttributeChangesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AttributeChangesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AttributeChangesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AttributeChangesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AttributeChangesRequest.Merge(m, src)
+}
+func (m *AttributeChangesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *AttributeChangesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AttributeChangesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AttributeChangesRequest proto.InternalMessageInfo
+
+func (m *AttributeChangesRequest) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+func (m *AttributeChangesRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+// AttributeChangesResponse is the response type for the Subscription/AttributeChanges RPC method.
+// Each response has exactly one attribute change event.
+type AttributeChangesResponse struct {
+	// height is the height of the block that the change happened in.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// tx_hash is the hash of the tx that made the change. It is empty for changes made outside of a tx (e.g. expirations).
+	TxHash string `protobuf:"bytes,2,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	// event is the attribute change event.
+	//
+	// Types that are valid to be assigned to Event:
+	//	*AttributeChangesResponse_Add
+	//	*AttributeChangesResponse_Update
+	//	*AttributeChangesResponse_ExpirationUpdate
+	//	*AttributeChangesResponse_Delete
+	//	*AttributeChangesResponse_DistinctDelete
+	//	*AttributeChangesResponse_Expired
+	Event isAttributeChangesResponse_Event `protobuf_oneof:"event"`
+}
+
+func (m *AttributeChangesResponse) Reset()         { *m = AttributeChangesResponse{} }
+func (m *AttributeChangesResponse) String() string { return proto.CompactTextString(m) }
+func (*AttributeChangesResponse) ProtoMessage()    {}
+func (*AttributeChangesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a95a3f48eeddfa43, []int{1}
+}
+func (m *AttributeChangesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AttributeChangesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AttributeChangesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AttributeChangesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AttributeChangesResponse.Merge(m, src)
+}
+func (m *AttributeChangesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *AttributeChangesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AttributeChangesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AttributeChangesResponse proto.InternalMessageInfo
+
+type isAttributeChangesResponse_Event interface {
+	isAttributeChangesResponse_Event()
+	MarshalTo([]byte) (int, error)
+	Size() int
+}
+
+type AttributeChangesResponse_Add struct {
+	Add *EventAttributeAdd `protobuf:"bytes,3,opt,name=add,proto3,oneof" json:"add,omitempty"`
+}
+type AttributeChangesResponse_Update struct {
+	Update *EventAttributeUpdate `protobuf:"bytes,4,opt,name=update,proto3,oneof" json:"update,omitempty"`
+}
+type AttributeChangesResponse_ExpirationUpdate struct {
+	ExpirationUpdate *EventAttributeExpirationUpdate `protobuf:"bytes,5,opt,name=expiration_update,json=expirationUpdate,proto3,oneof" json:"expiration_update,omitempty"`
+}
+type AttributeChangesResponse_Delete struct {
+	Delete *EventAttributeDelete `protobuf:"bytes,6,opt,name=delete,proto3,oneof" json:"delete,omitempty"`
+}
+type AttributeChangesResponse_DistinctDelete struct {
+	DistinctDelete *EventAttributeDistinctDelete `protobuf:"bytes,7,opt,name=distinct_delete,json=distinctDelete,proto3,oneof" json:"distinct_delete,omitempty"`
+}
+type AttributeChangesResponse_Expired struct {
+	Expired *EventAttributeExpired `protobuf:"bytes,8,opt,name=expired,proto3,oneof" json:"expired,omitempty"`
+}
+
+func (*AttributeChangesResponse_Add) isAttributeChangesResponse_Event()              {}
+func (*AttributeChangesResponse_Update) isAttributeChangesResponse_Event()           {}
+func (*AttributeChangesResponse_ExpirationUpdate) isAttributeChangesResponse_Event() {}
+func (*AttributeChangesResponse_Delete) isAttributeChangesResponse_Event()           {}
+func (*AttributeChangesResponse_DistinctDelete) isAttributeChangesResponse_Event()   {}
+func (*AttributeChangesResponse_Expired) isAttributeChangesResponse_Event()          {}
+
+func (m *AttributeChangesResponse) GetEvent() isAttributeChangesResponse_Event {
+	if m != nil {
+		return m.Event
+	}
+	return nil
+}
+
+func (m *AttributeChangesResponse) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *AttributeChangesResponse) GetTxHash() string {
+	if m != nil {
+		return m.TxHash
+	}
+	return ""
+}
+
+func (m *AttributeChangesResponse) GetAdd() *EventAttributeAdd {
+	if x, ok := m.GetEvent().(*AttributeChangesResponse_Add); ok {
+		return x.Add
+	}
+	return nil
+}
+
+func (m *AttributeChangesResponse) GetUpdate() *EventAttributeUpdate {
+	if x, ok := m.GetEvent().(*AttributeChangesResponse_Update); ok {
+		return x.Update
+	}
+	return nil
+}
+
+func (m *AttributeChangesResponse) GetExpirationUpdate() *EventAttributeExpirationUpdate {
+	if x, ok := m.GetEvent().(*AttributeChangesResponse_ExpirationUpdate); ok {
+		return x.ExpirationUpdate
+	}
+	return nil
+}
+
+func (m *AttributeChangesResponse) GetDelete() *EventAttributeDelete {
+	if x, ok := m.GetEvent().(*AttributeChangesResponse_Delete); ok {
+		return x.Delete
+	}
+	return nil
+}
+
+func (m *AttributeChangesResponse) GetDistinctDelete() *EventAttributeDistinctDelete {
+	if x, ok := m.GetEvent().(*AttributeChangesResponse_DistinctDelete); ok {
+		return x.DistinctDelete
+	}
+	return nil
+}
+
+func (m *AttributeChangesResponse) GetExpired() *EventAttributeExpired {
+	if x, ok := m.GetEvent().(*AttributeChangesResponse_Expired); ok {
+		return x.Expired
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*AttributeChangesResponse) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*AttributeChangesResponse_Add)(nil),
+		(*AttributeChangesResponse_Update)(nil),
+		(*AttributeChangesResponse_ExpirationUpdate)(nil),
+		(*AttributeChangesResponse_Delete)(nil),
+		(*AttributeChangesResponse_DistinctDelete)(nil),
+		(*AttributeChangesResponse_Expired)(nil),
+	}
+}
+
+func init() {
+	proto.RegisterType((*AttributeChangesRequest)(nil), "provenance.attribute.v1.AttributeChangesRequest")
+	proto.RegisterType((*AttributeChangesResponse)(nil), "provenance.attribute.v1.AttributeChangesResponse")
+}
+
+func init() {
+	proto.RegisterFile("provenance/attribute/v1/subscription.proto", fileDescriptor_a95a3f48eeddfa43)
+}
+
+var fileDescriptor_a95a3f48eeddfa43 = []byte{
+	// 443 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0xc1, 0x6e, 0xd3, 0x40,
+	0x10, 0x86, 0xbd, 0x24, 0xb5, 0x61, 0x41, 0x50, 0xf6, 0x40, 0xac, 0x1c, 0xac, 0xaa, 0x17, 0xaa,
+	0x4a, 0xb5, 0x9b, 0xa2, 0x8a, 0x1b, 0x52, 0x0b, 0x55, 0x23, 0x4e, 0xc8, 0x88, 0x0b, 0x97, 0xb0,
+	0xf1, 0x0e, 0xf1, 0x4a, 0x64, 0xd7, 0x78, 0xc7, 0x91, 0xfb, 0x08, 0xdc, 0x78, 0x20, 0x1e, 0x80,
+	0x63, 0x8f, 0x1c, 0x51, 0xf2, 0x22, 0xc8, 0x1b, 0xc7, 0x09, 0xad, 0x2c, 0x25, 0x37, 0xcf, 0xe8,
+	0xff, 0xbf, 0x1d, 0xef, 0xfe, 0x43, 0x8f, 0xb3, 0x5c, 0xcf, 0x40, 0x71, 0x95, 0x40, 0xc4, 0x11,
+	0x73, 0x39, 0x2e, 0x10, 0xa2, 0xd9, 0x20, 0x32, 0xc5, 0xd8, 0x24, 0xb9, 0xcc, 0x50, 0x6a, 0x15,
+	0x66, 0xb9, 0x46, 0xcd, 0x7a, 0x6b, 0x6d, 0xd8, 0x68, 0xc3, 0xd9, 0xa0, 0xff, 0xb2, 0x0d, 0xb2,
+	0x56, 0x59, 0xc2, 0xe1, 0x35, 0xed, 0x5d, 0xac, 0x5a, 0x6f, 0x53, 0xae, 0x26, 0x60, 0x62, 0xf8,
+	0x5e, 0x80, 0x41, 0xe6, 0x53, 0x8f, 0x27, 0x89, 0x2e, 0x14, 0xfa, 0xe4, 0x80, 0x1c, 0x3d, 0x8a,
+	0x57, 0x25, 0x63, 0xb4, 0xab, 0xf8, 0x14, 0xfc, 0x07, 0xb6, 0x6d, 0xbf, 0x0f, 0x7f, 0x75, 0xa9,
+	0x7f, 0x9f, 0x64, 0x32, 0xad, 0x0c, 0xb0, 0x17, 0xd4, 0x4d, 0x41, 0x4e, 0xd2, 0x25, 0xa9, 0x13,
+	0xd7, 0x15, 0xeb, 0x51, 0x0f, 0xcb, 0x51, 0xca, 0x4d, 0x5a, 0xb3, 0x5c, 0x2c, 0x87, 0xdc, 0xa4,
+	0xec, 0x0d, 0xed, 0x70, 0x21, 0xfc, 0xce, 0x01, 0x39, 0x7a, 0x7c, 0x76, 0x1c, 0xb6, 0xfc, 0x66,
+	0x78, 0x35, 0x03, 0x85, 0xcd, 0xa9, 0x17, 0x42, 0x0c, 0x9d, 0xb8, 0x32, 0xb2, 0x6b, 0xea, 0x16,
+	0x99, 0xe0, 0x08, 0x7e, 0xd7, 0x22, 0x4e, 0xb6, 0x44, 0x7c, 0xb2, 0xa6, 0xa1, 0x13, 0xd7, 0x76,
+	0xf6, 0x95, 0x3e, 0x87, 0x32, 0x93, 0x39, 0xaf, 0x6e, 0x7d, 0x54, 0x33, 0xf7, 0x2c, 0xf3, 0xf5,
+	0x96, 0xcc, 0xab, 0xc6, 0xdf, 0xd0, 0xf7, 0xe1, 0x4e, 0xaf, 0x1a, 0x58, 0xc0, 0x37, 0x40, 0xf0,
+	0xdd, 0x9d, 0x06, 0x7e, 0x67, 0x4d, 0xd5, 0xc0, 0x4b, 0x3b, 0xfb, 0x42, 0x9f, 0x09, 0x69, 0x50,
+	0xaa, 0x04, 0x47, 0x35, 0xd1, 0xb3, 0xc4, 0xf3, 0x6d, 0x89, 0xb5, 0xbb, 0x21, 0x3f, 0x15, 0xff,
+	0x75, 0xd8, 0x7b, 0xea, 0xd9, 0xf1, 0x41, 0xf8, 0x0f, 0x2d, 0x39, 0xdc, 0xe5, 0x22, 0xa0, 0x7a,
+	0xa3, 0x15, 0xe0, 0xd2, 0xa3, 0x7b, 0x50, 0x69, 0xce, 0x7e, 0x10, 0xfa, 0xe4, 0xe3, 0x46, 0xc0,
+	0xd9, 0x0d, 0xdd, 0xbf, 0x1b, 0x27, 0x76, 0xda, 0x7a, 0x50, 0x4b, 0x86, 0xfb, 0x83, 0x1d, 0x1c,
+	0xcb, 0xac, 0x9e, 0x92, 0xcb, 0xe9, 0xef, 0x79, 0x40, 0x6e, 0xe7, 0x01, 0xf9, 0x3b, 0x0f, 0xc8,
+	0xcf, 0x45, 0xe0, 0xdc, 0x2e, 0x02, 0xe7, 0xcf, 0x22, 0x70, 0x68, 0x5f, 0xea, 0x36, 0xe0, 0x07,
+	0xf2, 0xf9, 0x7c, 0x22, 0x31, 0x2d, 0xc6, 0x61, 0xa2, 0xa7, 0xd1, 0x5a, 0x75, 0x22, 0xf5, 0x46,
+	0x15, 0x95, 0x1b, 0xfb, 0x88, 0x37, 0x19, 0x98, 0xb1, 0x6b, 0x37, 0xf1, 0xd5, 0xbf, 0x00, 0x00,
+	0x00, 0xff, 0xff, 0x37, 0xda, 0xab, 0xe1, 0xf9, 0x03, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// SubscriptionClient is the client API for Subscription service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type SubscriptionClient interface {
+	// AttributeChanges streams the attribute add, update, and delete events for an account and/or attribute name.
+	AttributeChanges(ctx context.Context, in *AttributeChangesRequest, opts ...grpc.CallOption) (Subscription_AttributeChangesClient, error)
+}
+
+type subscriptionClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewSubscriptionClient(cc grpc1.ClientConn) SubscriptionClient {
+	return &subscriptionClient{cc}
+}
+
+func (c *subscriptionClient) AttributeChanges(ctx context.Context, in *AttributeChangesRequest, opts ...grpc.CallOption) (Subscription_AttributeChangesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Subscription_serviceDesc.Streams[0], "/provenance.attribute.v1.Subscription/AttributeChanges", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &subscriptionAttributeChangesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Subscription_AttributeChangesClient interface {
+	Recv() (*AttributeChangesResponse, error)
+	grpc.ClientStream
+}
+
+type subscriptionAttributeChangesClient struct {
+	grpc.ClientStream
+}
+
+func (x *subscriptionAttributeChangesClient) Recv() (*AttributeChangesResponse, error) {
+	m := new(AttributeChangesResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// SubscriptionServer is the server API for Subscription service.
+type SubscriptionServer interface {
+	// AttributeChanges streams the attribute add, update, and delete events for an account and/or attribute name.
+	AttributeChanges(*AttributeChangesRequest, Subscription_AttributeChangesServer) error
+}
+
+// UnimplementedSubscriptionServer can be embedded to have forward compatible implementations.
+type UnimplementedSubscriptionServer struct {
+}
+
+func (*UnimplementedSubscriptionServer) AttributeChanges(req *AttributeChangesRequest, srv Subscription_AttributeChangesServer) error {
+	return status.Errorf(codes.Unimplemented, "method AttributeChanges not implemented")
+}
+
+func RegisterSubscriptionServer(s grpc1.Server, srv SubscriptionServer) {
+	s.RegisterService(&_Subscription_serviceDesc, srv)
+}
+
+func _Subscription_AttributeChanges_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(AttributeChangesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SubscriptionServer).AttributeChanges(m, &subscriptionAttributeChangesServer{stream})
+}
+
+type Subscription_AttributeChangesServer interface {
+	Send(*AttributeChangesResponse) error
+	grpc.ServerStream
+}
+
+type subscriptionAttributeChangesServer struct {
+	grpc.ServerStream
+}
+
+func (x *subscriptionAttributeChangesServer) Send(m *AttributeChangesResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var Subscription_serviceDesc = _Subscription_serviceDesc
+var _Subscription_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "provenance.attribute.v1.Subscription",
+	HandlerType: (*SubscriptionServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "AttributeChanges",
+			Handler:       _Subscription_AttributeChanges_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "provenance/attribute/v1/subscription.proto",
+}
+
+func (m *AttributeChangesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AttributeChangesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AttributeChangesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintSubscription(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintSubscription(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AttributeChangesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AttributeChangesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AttributeChangesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Event != nil {
+		{
+			size := m.Event.Size()
+			i -= size
+			if _, err := m.Event.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintSubscription(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Height != 0 {
+		i = encodeVarintSubscription(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AttributeChangesResponse_Add) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AttributeChangesResponse_Add) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Add != nil {
+		{
+			size, err := m.Add.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSubscription(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *AttributeChangesResponse_Update) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AttributeChangesResponse_Update) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Update != nil {
+		{
+			size, err := m.Update.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSubscription(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	return len(dAtA) - i, nil
+}
+func (m *AttributeChangesResponse_ExpirationUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AttributeChangesResponse_ExpirationUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.ExpirationUpdate != nil {
+		{
+			size, err := m.ExpirationUpdate.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSubscription(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *AttributeChangesResponse_Delete) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AttributeChangesResponse_Delete) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Delete != nil {
+		{
+			size, err := m.Delete.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSubscription(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	return len(dAtA) - i, nil
+}
+func (m *AttributeChangesResponse_DistinctDelete) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AttributeChangesResponse_DistinctDelete) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.DistinctDelete != nil {
+		{
+			size, err := m.DistinctDelete.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSubscription(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *AttributeChangesResponse_Expired) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AttributeChangesResponse_Expired) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Expired != nil {
+		{
+			size, err := m.Expired.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSubscription(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	return len(dAtA) - i, nil
+}
+func encodeVarintSubscription(dAtA []byte, offset int, v uint64) int {
+	offset -= sovSubscription(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *AttributeChangesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovSubscription(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovSubscription(uint64(l))
+	}
+	return n
+}
+
+func (m *AttributeChangesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovSubscription(uint64(m.Height))
+	}
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovSubscription(uint64(l))
+	}
+	if m.Event != nil {
+		n += m.Event.Size()
+	}
+	return n
+}
+
+func (m *AttributeChangesResponse_Add) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Add != nil {
+		l = m.Add.Size()
+		n += 1 + l + sovSubscription(uint64(l))
+	}
+	return n
+}
+func (m *AttributeChangesResponse_Update) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Update != nil {
+		l = m.Update.Size()
+		n += 1 + l + sovSubscription(uint64(l))
+	}
+	return n
+}
+func (m *AttributeChangesResponse_ExpirationUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ExpirationUpdate != nil {
+		l = m.ExpirationUpdate.Size()
+		n += 1 + l + sovSubscription(uint64(l))
+	}
+	return n
+}
+func (m *AttributeChangesResponse_Delete) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Delete != nil {
+		l = m.Delete.Size()
+		n += 1 + l + sovSubscription(uint64(l))
+	}
+	return n
+}
+func (m *AttributeChangesResponse_DistinctDelete) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DistinctDelete != nil {
+		l = m.DistinctDelete.Size()
+		n += 1 + l + sovSubscription(uint64(l))
+	}
+	return n
+}
+func (m *AttributeChangesResponse_Expired) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Expired != nil {
+		l = m.Expired.Size()
+		n += 1 + l + sovSubscription(uint64(l))
+	}
+	return n
+}
+
+func sovSubscription(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozSubscription(x uint64) (n int) {
+	return sovSubscription(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *AttributeChangesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSubscription
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AttributeChangesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AttributeChangesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubscription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubscription
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubscription
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubscription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubscription
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubscription
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSubscription(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSubscription
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AttributeChangesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSubscription
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AttributeChangesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AttributeChangesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubscription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubscription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubscription
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubscription
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Add", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubscription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSubscription
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubscription
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &EventAttributeAdd{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Event = &AttributeChangesResponse_Add{v}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Update", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubscription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSubscription
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubscription
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &EventAttributeUpdate{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Event = &AttributeChangesResponse_Update{v}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationUpdate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubscription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSubscription
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubscription
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &EventAttributeExpirationUpdate{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Event = &AttributeChangesResponse_ExpirationUpdate{v}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delete", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubscription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSubscription
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubscription
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &EventAttributeDelete{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Event = &AttributeChangesResponse_Delete{v}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistinctDelete", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubscription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSubscription
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubscription
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &EventAttributeDistinctDelete{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Event = &AttributeChangesResponse_DistinctDelete{v}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expired", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubscription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSubscription
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubscription
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &EventAttributeExpired{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Event = &AttributeChangesResponse_Expired{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSubscription(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSubscription
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipSubscription(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowSubscription
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSubscription
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSubscription
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthSubscription
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupSubscription
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthSubscription
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthSubscription        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowSubscription          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupSubscription = fmt.Errorf("proto: unexpected end of group")
+)
@@ -180,6 +180,9 @@ type MsgUpdateAttributeRequest struct {
 	Account string `protobuf:"bytes,6,opt,name=account,proto3" json:"account,omitempty"`
 	// The address that the name must resolve to.
 	Owner string `protobuf:"bytes,7,opt,name=owner,proto3" json:"owner,omitempty"`
+	// The optional sha256 hash of the stored attribute value. When provided, the attribute being updated must have a
+	// value with this hash, otherwise the update fails. This protects against clobbering a concurrent change.
+	OriginalValueHash []byte `protobuf:"bytes,8,opt,name=original_value_hash,json=originalValueHash,proto3" json:"original_value_hash,omitempty"`
 }
 
 func (m *MsgUpdateAttributeRequest) Reset()         { *m = MsgUpdateAttributeRequest{} }
@@ -264,6 +267,13 @@ func (m *MsgUpdateAttributeRequest) GetOwner() string {
 	return ""
 }
 
+func (m *MsgUpdateAttributeRequest) GetOriginalValueHash() []byte {
+	if m != nil {
+		return m.OriginalValueHash
+	}
+	return nil
+}
+
 // MsgUpdateAttributeResponse defines the Msg/UpdateAttribute response type.
 type MsgUpdateAttributeResponse struct {
 }
@@ -430,6 +440,10 @@ type MsgDeleteAttributeRequest struct {
 	Account string `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
 	// The address that the name must resolve to.
 	Owner string `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty"`
+	// The optional sha256 hash of the stored attribute value. When provided, the account must have exactly one attribute
+	// with the name, and its value must have this hash, otherwise the delete fails. This protects against clobbering a
+	// concurrent change.
+	OriginalValueHash []byte `protobuf:"bytes,4,opt,name=original_value_hash,json=originalValueHash,proto3" json:"original_value_hash,omitempty"`
 }
 
 func (m *MsgDeleteAttributeRequest) Reset()         { *m = MsgDeleteAttributeRequest{} }
@@ -486,6 +500,13 @@ func (m *MsgDeleteAttributeRequest) GetOwner() string {
 	return ""
 }
 
+func (m *MsgDeleteAttributeRequest) GetOriginalValueHash() []byte {
+	if m != nil {
+		return m.OriginalValueHash
+	}
+	return nil
+}
+
 // MsgDeleteAttributeResponse defines the Msg/DeleteAttribute response type.
 type MsgDeleteAttributeResponse struct {
 }
@@ -724,6 +745,350 @@ func (m *MsgSetAccountDataResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgSetAccountDataResponse proto.InternalMessageInfo
 
+// MsgSetAttributeUnlistedRequest defines a message for an account to mark one of its attribute names as unlisted.
+// The flag can be set regardless of whether the account currently has any attributes with that name.
+type MsgSetAttributeUnlistedRequest struct {
+	// The account that has (or will have) the attribute.
+	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	// The attribute name.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Whether the attribute should be unlisted (true) or listed (false).
+	Unlisted bool `protobuf:"varint,3,opt,name=unlisted,proto3" json:"unlisted,omitempty"`
+}
+
+func (m *MsgSetAttributeUnlistedRequest) Reset()         { *m = MsgSetAttributeUnlistedRequest{} }
+func (m *MsgSetAttributeUnlistedRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgSetAttributeUnlistedRequest) ProtoMessage()    {}
+func (*MsgSetAttributeUnlistedRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5de344c1a12714be, []int{12}
+}
+func (m *MsgSetAttributeUnlistedRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetAttributeUnlistedRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetAttributeUnlistedRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetAttributeUnlistedRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetAttributeUnlistedRequest.Merge(m, src)
+}
+func (m *MsgSetAttributeUnlistedRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetAttributeUnlistedRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetAttributeUnlistedRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetAttributeUnlistedRequest proto.InternalMessageInfo
+
+func (m *MsgSetAttributeUnlistedRequest) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+func (m *MsgSetAttributeUnlistedRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *MsgSetAttributeUnlistedRequest) GetUnlisted() bool {
+	if m != nil {
+		return m.Unlisted
+	}
+	return false
+}
+
+// MsgSetAttributeUnlistedResponse defines the Msg/SetAttributeUnlisted response type.
+type MsgSetAttributeUnlistedResponse struct {
+}
+
+func (m *MsgSetAttributeUnlistedResponse) Reset()         { *m = MsgSetAttributeUnlistedResponse{} }
+func (m *MsgSetAttributeUnlistedResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetAttributeUnlistedResponse) ProtoMessage()    {}
+func (*MsgSetAttributeUnlistedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5de344c1a12714be, []int{13}
+}
+func (m *MsgSetAttributeUnlistedResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetAttributeUnlistedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetAttributeUnlistedResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetAttributeUnlistedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetAttributeUnlistedResponse.Merge(m, src)
+}
+func (m *MsgSetAttributeUnlistedResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetAttributeUnlistedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetAttributeUnlistedResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetAttributeUnlistedResponse proto.InternalMessageInfo
+
+// MsgSetAttributeHookRequest defines a message for the owner of an attribute name to register a wasm contract
+// that is called whenever an attribute with that name is added or deleted.
+// An empty contract removes the name's hook.
+type MsgSetAttributeHookRequest struct {
+	// The attribute name. It must resolve to the owner.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The bech32 address of the wasm contract to call. Leave empty to remove the hook.
+	Contract string `protobuf:"bytes,2,opt,name=contract,proto3" json:"contract,omitempty"`
+	// How a failure of the contract call is handled. Must be unspecified when removing the hook.
+	Mode AttributeHookMode `protobuf:"varint,3,opt,name=mode,proto3,enum=provenance.attribute.v1.AttributeHookMode" json:"mode,omitempty"`
+	// The address that the name must resolve to.
+	Owner string `protobuf:"bytes,4,opt,name=owner,proto3" json:"owner,omitempty"`
+}
+
+func (m *MsgSetAttributeHookRequest) Reset()         { *m = MsgSetAttributeHookRequest{} }
+func (m *MsgSetAttributeHookRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgSetAttributeHookRequest) ProtoMessage()    {}
+func (*MsgSetAttributeHookRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5de344c1a12714be, []int{14}
+}
+func (m *MsgSetAttributeHookRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetAttributeHookRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetAttributeHookRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetAttributeHookRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetAttributeHookRequest.Merge(m, src)
+}
+func (m *MsgSetAttributeHookRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetAttributeHookRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetAttributeHookRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetAttributeHookRequest proto.InternalMessageInfo
+
+func (m *MsgSetAttributeHookRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *MsgSetAttributeHookRequest) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+func (m *MsgSetAttributeHookRequest) GetMode() AttributeHookMode {
+	if m != nil {
+		return m.Mode
+	}
+	return AttributeHookMode_Unspecified
+}
+
+func (m *MsgSetAttributeHookRequest) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+// MsgSetAttributeHookResponse defines the Msg/SetAttributeHook response type.
+type MsgSetAttributeHookResponse struct {
+}
+
+func (m *MsgSetAttributeHookResponse) Reset()         { *m = MsgSetAttributeHookResponse{} }
+func (m *MsgSetAttributeHookResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetAttributeHookResponse) ProtoMessage()    {}
+func (*MsgSetAttributeHookResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5de344c1a12714be, []int{15}
+}
+func (m *MsgSetAttributeHookResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetAttributeHookResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetAttributeHookResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetAttributeHookResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetAttributeHookResponse.Merge(m, src)
+}
+func (m *MsgSetAttributeHookResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetAttributeHookResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetAttributeHookResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetAttributeHookResponse proto.InternalMessageInfo
+
+// MsgRevokeAttributeRequest defines a message for the owner of an attribute name to revoke the attributes it issued.
+// Only attributes that were set by the issuer (according to their origin) are revoked. Attributes without a recorded
+// origin are treated as being issued by the current owner of the name.
+type MsgRevokeAttributeRequest struct {
+	// The attribute name. It must resolve to the issuer.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The account to revoke the attributes from.
+	Account string `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
+	// The optional attribute value to revoke. If empty, all values issued by the issuer are revoked.
+	Value []byte `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	// The address that the name must resolve to.
+	Issuer string `protobuf:"bytes,4,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	// An optional reason for the revocation, recorded in the revocation events.
+	Reason string `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *MsgRevokeAttributeRequest) Reset()         { *m = MsgRevokeAttributeRequest{} }
+func (m *MsgRevokeAttributeRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeAttributeRequest) ProtoMessage()    {}
+func (*MsgRevokeAttributeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5de344c1a12714be, []int{16}
+}
+func (m *MsgRevokeAttributeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevokeAttributeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokeAttributeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRevokeAttributeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeAttributeRequest.Merge(m, src)
+}
+func (m *MsgRevokeAttributeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevokeAttributeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeAttributeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRevokeAttributeRequest proto.InternalMessageInfo
+
+func (m *MsgRevokeAttributeRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *MsgRevokeAttributeRequest) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+func (m *MsgRevokeAttributeRequest) GetValue() []byte {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (m *MsgRevokeAttributeRequest) GetIssuer() string {
+	if m != nil {
+		return m.Issuer
+	}
+	return ""
+}
+
+func (m *MsgRevokeAttributeRequest) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+// MsgRevokeAttributeResponse defines the Msg/RevokeAttribute response type.
+type MsgRevokeAttributeResponse struct {
+	// revoked is the number of attribute values that were revoked.
+	Revoked uint32 `protobuf:"varint,1,opt,name=revoked,proto3" json:"revoked,omitempty"`
+}
+
+func (m *MsgRevokeAttributeResponse) Reset()         { *m = MsgRevokeAttributeResponse{} }
+func (m *MsgRevokeAttributeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeAttributeResponse) ProtoMessage()    {}
+func (*MsgRevokeAttributeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5de344c1a12714be, []int{17}
+}
+func (m *MsgRevokeAttributeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevokeAttributeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokeAttributeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRevokeAttributeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeAttributeResponse.Merge(m, src)
+}
+func (m *MsgRevokeAttributeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevokeAttributeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeAttributeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRevokeAttributeResponse proto.InternalMessageInfo
+
+func (m *MsgRevokeAttributeResponse) GetRevoked() uint32 {
+	if m != nil {
+		return m.Revoked
+	}
+	return 0
+}
+
 // MsgUpdateParamsRequest is a request message for the UpdateParams endpoint.
 type MsgUpdateParamsRequest struct {
 	// authority should be the governance module account address.
@@ -736,7 +1101,7 @@ func (m *MsgUpdateParamsRequest) Reset()         { *m = MsgUpdateParamsRequest{}
 func (m *MsgUpdateParamsRequest) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParamsRequest) ProtoMessage()    {}
 func (*MsgUpdateParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5de344c1a12714be, []int{12}
+	return fileDescriptor_5de344c1a12714be, []int{18}
 }
 func (m *MsgUpdateParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -787,7 +1152,7 @@ func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse
 func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParamsResponse) ProtoMessage()    {}
 func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5de344c1a12714be, []int{13}
+	return fileDescriptor_5de344c1a12714be, []int{19}
 }
 func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -816,88 +1181,216 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgAddAttributeRequest)(nil), "provenance.attribute.v1.MsgAddAttributeRequest")
-	proto.RegisterType((*MsgAddAttributeResponse)(nil), "provenance.attribute.v1.MsgAddAttributeResponse")
-	proto.RegisterType((*MsgUpdateAttributeRequest)(nil), "provenance.attribute.v1.MsgUpdateAttributeRequest")
-	proto.RegisterType((*MsgUpdateAttributeResponse)(nil), "provenance.attribute.v1.MsgUpdateAttributeResponse")
-	proto.RegisterType((*MsgUpdateAttributeExpirationRequest)(nil), "provenance.attribute.v1.MsgUpdateAttributeExpirationRequest")
-	proto.RegisterType((*MsgUpdateAttributeExpirationResponse)(nil), "provenance.attribute.v1.MsgUpdateAttributeExpirationResponse")
-	proto.RegisterType((*MsgDeleteAttributeRequest)(nil), "provenance.attribute.v1.MsgDeleteAttributeRequest")
-	proto.RegisterType((*MsgDeleteAttributeResponse)(nil), "provenance.attribute.v1.MsgDeleteAttributeResponse")
-	proto.RegisterType((*MsgDeleteDistinctAttributeRequest)(nil), "provenance.attribute.v1.MsgDeleteDistinctAttributeRequest")
-	proto.RegisterType((*MsgDeleteDistinctAttributeResponse)(nil), "provenance.attribute.v1.MsgDeleteDistinctAttributeResponse")
-	proto.RegisterType((*MsgSetAccountDataRequest)(nil), "provenance.attribute.v1.MsgSetAccountDataRequest")
-	proto.RegisterType((*MsgSetAccountDataResponse)(nil), "provenance.attribute.v1.MsgSetAccountDataResponse")
-	proto.RegisterType((*MsgUpdateParamsRequest)(nil), "provenance.attribute.v1.MsgUpdateParamsRequest")
-	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "provenance.attribute.v1.MsgUpdateParamsResponse")
+// MsgPurgeOrphanedAttributesRequest is a request message for the PurgeOrphanedAttributes endpoint.
+type MsgPurgeOrphanedAttributesRequest struct {
+	// authority should be the governance module account address.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// max_accounts is the maximum number of orphaned accounts to purge. Zero means no limit.
+	MaxAccounts uint32 `protobuf:"varint,2,opt,name=max_accounts,json=maxAccounts,proto3" json:"max_accounts,omitempty"`
 }
 
-func init() { proto.RegisterFile("provenance/attribute/v1/tx.proto", fileDescriptor_5de344c1a12714be) }
-
-var fileDescriptor_5de344c1a12714be = []byte{
-	// 850 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0xcf, 0x6f, 0xe3, 0x44,
-	0x14, 0xce, 0xe4, 0x57, 0xd9, 0x69, 0x36, 0x2b, 0x0d, 0x5d, 0xe2, 0x18, 0x94, 0x64, 0xc3, 0xb2,
-	0x44, 0x2b, 0xad, 0x4d, 0xb3, 0x82, 0x43, 0xa0, 0x87, 0x44, 0xe1, 0x18, 0xa9, 0x4a, 0x0b, 0x42,
-	0x3d, 0x10, 0x4d, 0x92, 0xc1, 0xb5, 0x88, 0x3d, 0xae, 0x67, 0x9c, 0xb6, 0x9c, 0x10, 0x37, 0x6e,
-	0x15, 0x5c, 0x38, 0x20, 0xf1, 0x2f, 0xf4, 0xc0, 0x1f, 0xd1, 0x63, 0xc5, 0x09, 0x71, 0x28, 0xa8,
-	0x3d, 0xf4, 0xcc, 0x7f, 0x80, 0xe2, 0x19, 0x27, 0xce, 0x0f, 0x9b, 0x26, 0x7b, 0xf3, 0x9b, 0x79,
-	0xef, 0xfb, 0x3e, 0x7f, 0x6f, 0xde, 0xd8, 0xb0, 0xe2, 0xb8, 0x74, 0x4c, 0x6c, 0x6c, 0x0f, 0x88,
-	0x8e, 0x39, 0x77, 0xcd, 0xbe, 0xc7, 0x89, 0x3e, 0xde, 0xd5, 0xf9, 0x99, 0xe6, 0xb8, 0x94, 0x53,
-	0x54, 0x98, 0x65, 0x68, 0xd3, 0x0c, 0x6d, 0xbc, 0xab, 0x16, 0x06, 0x94, 0x59, 0x94, 0xe9, 0x16,
-	0x33, 0x26, 0x05, 0x16, 0x33, 0x44, 0x85, 0x5a, 0x14, 0x1b, 0x3d, 0x3f, 0xd2, 0x45, 0x20, 0xb7,
-	0x76, 0x0c, 0x6a, 0x50, 0xb1, 0x3e, 0x79, 0x92, 0xab, 0x65, 0x83, 0x52, 0x63, 0x44, 0x74, 0x3f,
-	0xea, 0x7b, 0xdf, 0xe8, 0xdc, 0xb4, 0x08, 0xe3, 0xd8, 0x72, 0x64, 0xc2, 0x87, 0x51, 0x2a, 0x67,
-	0x82, 0xfc, 0xc4, 0xea, 0xaf, 0x49, 0xf8, 0x4e, 0x87, 0x19, 0xcd, 0xe1, 0xb0, 0x19, 0xec, 0x74,
-	0xc9, 0x89, 0x47, 0x18, 0x47, 0x08, 0xa6, 0x6d, 0x6c, 0x11, 0x05, 0x54, 0x40, 0xed, 0x51, 0xd7,
-	0x7f, 0x46, 0x3b, 0x30, 0x33, 0xc6, 0x23, 0x8f, 0x28, 0xc9, 0x0a, 0xa8, 0xe5, 0xba, 0x22, 0x40,
-	0x1d, 0x98, 0x9f, 0xe2, 0xf6, 0xf8, 0xb9, 0x43, 0x94, 0x54, 0x05, 0xd4, 0xf2, 0xf5, 0x17, 0x5a,
-	0x84, 0x15, 0xda, 0x94, 0xec, 0xf0, 0xdc, 0x21, 0xdd, 0xc7, 0x38, 0x1c, 0x22, 0x05, 0x6e, 0xe1,
-	0xc1, 0x80, 0x7a, 0x36, 0x57, 0xd2, 0x3e, 0x77, 0x10, 0x4e, 0xe8, 0xe9, 0xa9, 0x4d, 0x5c, 0x25,
-	0xe3, 0xaf, 0x8b, 0x00, 0x75, 0xe0, 0x13, 0x72, 0xe6, 0x98, 0x2e, 0xe6, 0x26, 0xb5, 0x7b, 0x43,
-	0xcc, 0x89, 0x92, 0xad, 0x80, 0xda, 0x76, 0x5d, 0xd5, 0x84, 0x4f, 0x5a, 0xe0, 0x93, 0x76, 0x18,
-	0xf8, 0xd4, 0x7a, 0xeb, 0xea, 0xa6, 0x0c, 0x2e, 0xfe, 0x2e, 0x83, 0x6e, 0x7e, 0x56, 0xdc, 0xc6,
-	0x9c, 0x34, 0xe0, 0x0f, 0xf7, 0x97, 0x2f, 0x05, 0x74, 0xb5, 0x08, 0x0b, 0x4b, 0xee, 0x30, 0x87,
-	0xda, 0x8c, 0x54, 0xff, 0x4d, 0xc2, 0x62, 0x87, 0x19, 0x5f, 0x38, 0x13, 0xc2, 0x07, 0x99, 0xf7,
-	0x01, 0xcc, 0x53, 0xd7, 0x34, 0x4c, 0x1b, 0x8f, 0x7a, 0x61, 0x17, 0x1f, 0x07, 0xab, 0x5f, 0xfa,
-	0x6e, 0x3e, 0x83, 0x39, 0xcf, 0x07, 0x95, 0x49, 0x29, 0x3f, 0x69, 0x5b, 0xac, 0x89, 0x94, 0xaf,
-	0x61, 0x61, 0x8a, 0xb4, 0xe0, 0x7c, 0x7a, 0x2d, 0xe7, 0x9f, 0x06, 0x30, 0x73, 0xcb, 0xe8, 0x08,
-	0x3e, 0x95, 0x12, 0x16, 0xd0, 0x33, 0x6b, 0xa1, 0xbf, 0xed, 0xcd, 0x9b, 0xb3, 0xd8, 0xdd, 0x6c,
-	0x44, 0x77, 0xb7, 0x42, 0xdd, 0x9d, 0x6b, 0xc7, 0x7b, 0x50, 0x5d, 0x65, 0xb9, 0xec, 0xc8, 0x5f,
-	0x00, 0xbe, 0xbf, 0xbc, 0xfd, 0xf9, 0xb4, 0xbb, 0x9b, 0x1c, 0xec, 0xa5, 0x93, 0x95, 0xda, 0xfc,
-	0x64, 0xad, 0x7b, 0xb0, 0xe7, 0x5e, 0xfd, 0x05, 0x7c, 0x1e, 0xff, 0x6e, 0xd2, 0x84, 0x6f, 0xfd,
-	0x53, 0xd9, 0x26, 0x23, 0xf2, 0xc0, 0x53, 0x19, 0x12, 0x95, 0x8c, 0x10, 0x95, 0x8a, 0xef, 0xc7,
-	0x12, 0x99, 0x94, 0xf2, 0x23, 0x80, 0xcf, 0xa6, 0xdb, 0x6d, 0x93, 0x71, 0xd3, 0x1e, 0xf0, 0x37,
-	0xb8, 0x66, 0x42, 0x4a, 0x53, 0x11, 0x4a, 0xd3, 0x51, 0x4a, 0x9f, 0xc3, 0x6a, 0x9c, 0x14, 0xa9,
-	0xf8, 0x2b, 0xa8, 0x74, 0x98, 0x71, 0x40, 0x78, 0x53, 0x00, 0xb7, 0x31, 0xc7, 0x81, 0xce, 0xa9,
-	0x26, 0x21, 0x74, 0x59, 0xd3, 0xbc, 0x7b, 0x8d, 0xdc, 0x84, 0x3d, 0x88, 0xaa, 0xef, 0xfa, 0x6d,
-	0x59, 0x44, 0x96, 0xb4, 0xbf, 0x01, 0xff, 0x12, 0x16, 0xcd, 0xdd, 0xc7, 0x2e, 0xb6, 0x58, 0xc0,
-	0xfa, 0x09, 0x7c, 0x84, 0x3d, 0x7e, 0x4c, 0x5d, 0x93, 0x9f, 0x0b, 0xe6, 0x96, 0xf2, 0xc7, 0xef,
-	0xaf, 0x76, 0xe4, 0x47, 0xa2, 0x39, 0x1c, 0xba, 0x84, 0xb1, 0x03, 0xee, 0x9a, 0xb6, 0xd1, 0x9d,
-	0xa5, 0xa2, 0x3d, 0x98, 0x75, 0x7c, 0x20, 0x5f, 0xd6, 0x76, 0xbd, 0x1c, 0x39, 0xb2, 0x82, 0xaf,
-	0x95, 0xbe, 0xba, 0x29, 0x27, 0xba, 0xb2, 0xa8, 0x91, 0x9f, 0x88, 0x9f, 0xc1, 0xc9, 0x7b, 0x70,
-	0x5e, 0xa0, 0x10, 0x5f, 0xff, 0x79, 0x0b, 0xa6, 0x3a, 0xcc, 0x40, 0x27, 0x30, 0x17, 0xbe, 0x27,
-	0x91, 0x1e, 0xc9, 0xb8, 0xfa, 0x7b, 0xa3, 0x7e, 0xf4, 0xf0, 0x02, 0x41, 0x8d, 0xbe, 0x83, 0x4f,
-	0x16, 0x06, 0x02, 0xd5, 0xe3, 0x40, 0x56, 0xdf, 0xd5, 0xea, 0xeb, 0xb5, 0x6a, 0x24, 0xf7, 0x2f,
-	0x00, 0x16, 0x23, 0xa7, 0x11, 0x7d, 0xb6, 0x06, 0xe4, 0xd2, 0x05, 0xa5, 0xee, 0x6d, 0x58, 0x3d,
-	0xb3, 0x65, 0x61, 0x24, 0xe3, 0x6d, 0x59, 0x7d, 0x59, 0xc4, 0xdb, 0x12, 0x31, 0xf3, 0xe8, 0x27,
-	0x00, 0x0b, 0x11, 0x53, 0x86, 0x1a, 0xff, 0x0f, 0x18, 0x75, 0x4b, 0xa8, 0x9f, 0x6e, 0x54, 0x2b,
-	0x45, 0x9d, 0xc2, 0xfc, 0xfc, 0xe4, 0xa1, 0xdd, 0x38, 0xb8, 0x95, 0xf3, 0xaf, 0xd6, 0xd7, 0x29,
-	0x91, 0xc4, 0x27, 0x30, 0x17, 0x9e, 0x99, 0xf8, 0x99, 0x58, 0x31, 0xfe, 0xf1, 0x33, 0xb1, 0x6a,
-	0x1c, 0xd5, 0xcc, 0xf7, 0xf7, 0x97, 0x2f, 0x41, 0xcb, 0xba, 0xba, 0x2d, 0x81, 0xeb, 0xdb, 0x12,
-	0xf8, 0xe7, 0xb6, 0x04, 0x2e, 0xee, 0x4a, 0x89, 0xeb, 0xbb, 0x52, 0xe2, 0xcf, 0xbb, 0x52, 0x02,
-	0xaa, 0x26, 0x8d, 0x02, 0xdd, 0x07, 0x47, 0x1f, 0x1b, 0x26, 0x3f, 0xf6, 0xfa, 0xda, 0x80, 0x5a,
-	0xfa, 0x2c, 0xeb, 0x95, 0x49, 0x43, 0x91, 0x7e, 0x16, 0xfa, 0xa7, 0x9c, 0xfc, 0x16, 0xb0, 0x7e,
-	0xd6, 0xff, 0x0e, 0xbe, 0xfe, 0x2f, 0x00, 0x00, 0xff, 0xff, 0xc4, 0xc7, 0x08, 0x30, 0x1e, 0x0b,
-	0x00, 0x00,
+func (m *MsgPurgeOrphanedAttributesRequest) Reset()         { *m = MsgPurgeOrphanedAttributesRequest{} }
+func (m *MsgPurgeOrphanedAttributesRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgPurgeOrphanedAttributesRequest) ProtoMessage()    {}
+func (*MsgPurgeOrphanedAttributesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5de344c1a12714be, []int{20}
+}
+func (m *MsgPurgeOrphanedAttributesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPurgeOrphanedAttributesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPurgeOrphanedAttributesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgPurgeOrphanedAttributesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPurgeOrphanedAttributesRequest.Merge(m, src)
+}
+func (m *MsgPurgeOrphanedAttributesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPurgeOrphanedAttributesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPurgeOrphanedAttributesRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+var xxx_messageInfo_MsgPurgeOrphanedAttributesRequest proto.InternalMessageInfo
 
-// This is a compile-time assertion to ensure that this generated file
+func (m *MsgPurgeOrphanedAttributesRequest) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgPurgeOrphanedAttributesRequest) GetMaxAccounts() uint32 {
+	if m != nil {
+		return m.MaxAccounts
+	}
+	return 0
+}
+
+// MsgPurgeOrphanedAttributesResponse is a response message for the PurgeOrphanedAttributes endpoint.
+type MsgPurgeOrphanedAttributesResponse struct {
+	// purged_accounts are the accounts that had their attributes removed.
+	PurgedAccounts []string `protobuf:"bytes,1,rep,name=purged_accounts,json=purgedAccounts,proto3" json:"purged_accounts,omitempty"`
+}
+
+func (m *MsgPurgeOrphanedAttributesResponse) Reset()         { *m = MsgPurgeOrphanedAttributesResponse{} }
+func (m *MsgPurgeOrphanedAttributesResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgPurgeOrphanedAttributesResponse) ProtoMessage()    {}
+func (*MsgPurgeOrphanedAttributesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5de344c1a12714be, []int{21}
+}
+func (m *MsgPurgeOrphanedAttributesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPurgeOrphanedAttributesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPurgeOrphanedAttributesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgPurgeOrphanedAttributesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPurgeOrphanedAttributesResponse.Merge(m, src)
+}
+func (m *MsgPurgeOrphanedAttributesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPurgeOrphanedAttributesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPurgeOrphanedAttributesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPurgeOrphanedAttributesResponse proto.InternalMessageInfo
+
+func (m *MsgPurgeOrphanedAttributesResponse) GetPurgedAccounts() []string {
+	if m != nil {
+		return m.PurgedAccounts
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*MsgAddAttributeRequest)(nil), "provenance.attribute.v1.MsgAddAttributeRequest")
+	proto.RegisterType((*MsgAddAttributeResponse)(nil), "provenance.attribute.v1.MsgAddAttributeResponse")
+	proto.RegisterType((*MsgUpdateAttributeRequest)(nil), "provenance.attribute.v1.MsgUpdateAttributeRequest")
+	proto.RegisterType((*MsgUpdateAttributeResponse)(nil), "provenance.attribute.v1.MsgUpdateAttributeResponse")
+	proto.RegisterType((*MsgUpdateAttributeExpirationRequest)(nil), "provenance.attribute.v1.MsgUpdateAttributeExpirationRequest")
+	proto.RegisterType((*MsgUpdateAttributeExpirationResponse)(nil), "provenance.attribute.v1.MsgUpdateAttributeExpirationResponse")
+	proto.RegisterType((*MsgDeleteAttributeRequest)(nil), "provenance.attribute.v1.MsgDeleteAttributeRequest")
+	proto.RegisterType((*MsgDeleteAttributeResponse)(nil), "provenance.attribute.v1.MsgDeleteAttributeResponse")
+	proto.RegisterType((*MsgDeleteDistinctAttributeRequest)(nil), "provenance.attribute.v1.MsgDeleteDistinctAttributeRequest")
+	proto.RegisterType((*MsgDeleteDistinctAttributeResponse)(nil), "provenance.attribute.v1.MsgDeleteDistinctAttributeResponse")
+	proto.RegisterType((*MsgSetAccountDataRequest)(nil), "provenance.attribute.v1.MsgSetAccountDataRequest")
+	proto.RegisterType((*MsgSetAccountDataResponse)(nil), "provenance.attribute.v1.MsgSetAccountDataResponse")
+	proto.RegisterType((*MsgSetAttributeUnlistedRequest)(nil), "provenance.attribute.v1.MsgSetAttributeUnlistedRequest")
+	proto.RegisterType((*MsgSetAttributeUnlistedResponse)(nil), "provenance.attribute.v1.MsgSetAttributeUnlistedResponse")
+	proto.RegisterType((*MsgSetAttributeHookRequest)(nil), "provenance.attribute.v1.MsgSetAttributeHookRequest")
+	proto.RegisterType((*MsgSetAttributeHookResponse)(nil), "provenance.attribute.v1.MsgSetAttributeHookResponse")
+	proto.RegisterType((*MsgRevokeAttributeRequest)(nil), "provenance.attribute.v1.MsgRevokeAttributeRequest")
+	proto.RegisterType((*MsgRevokeAttributeResponse)(nil), "provenance.attribute.v1.MsgRevokeAttributeResponse")
+	proto.RegisterType((*MsgUpdateParamsRequest)(nil), "provenance.attribute.v1.MsgUpdateParamsRequest")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "provenance.attribute.v1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgPurgeOrphanedAttributesRequest)(nil), "provenance.attribute.v1.MsgPurgeOrphanedAttributesRequest")
+	proto.RegisterType((*MsgPurgeOrphanedAttributesResponse)(nil), "provenance.attribute.v1.MsgPurgeOrphanedAttributesResponse")
+}
+
+func init() { proto.RegisterFile("provenance/attribute/v1/tx.proto", fileDescriptor_5de344c1a12714be) }
+
+var fileDescriptor_5de344c1a12714be = []byte{
+	// 1162 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0x41, 0x6f, 0x1b, 0x45,
+	0x14, 0xce, 0xc4, 0x4e, 0x9a, 0x3e, 0x3b, 0x2e, 0x6c, 0xd3, 0xc4, 0xd9, 0x82, 0xed, 0x98, 0xd2,
+	0x46, 0x91, 0x6a, 0x37, 0x0e, 0x14, 0x94, 0x52, 0xa4, 0x44, 0x41, 0xea, 0xc5, 0x22, 0xda, 0xb6,
+	0x08, 0xf5, 0x80, 0x35, 0xb1, 0x87, 0xf5, 0xaa, 0xd9, 0x9d, 0xcd, 0xce, 0x6c, 0xea, 0x20, 0x21,
+	0x21, 0x6e, 0xbd, 0xa0, 0x8a, 0x13, 0x12, 0x95, 0x10, 0x7f, 0x00, 0x7a, 0xe0, 0x47, 0xe4, 0x58,
+	0x71, 0x42, 0x1c, 0x0a, 0x4a, 0x0e, 0xfd, 0x1b, 0x68, 0x77, 0x66, 0xd7, 0x6b, 0x7b, 0x77, 0x63,
+	0xa7, 0xdc, 0xfc, 0x66, 0xde, 0xfb, 0xde, 0xf7, 0xbe, 0x37, 0x33, 0x6f, 0x0d, 0x15, 0xdb, 0xa1,
+	0x87, 0xc4, 0xc2, 0x56, 0x9b, 0xd4, 0x31, 0xe7, 0x8e, 0xb1, 0xe7, 0x72, 0x52, 0x3f, 0x5c, 0xaf,
+	0xf3, 0x5e, 0xcd, 0x76, 0x28, 0xa7, 0xca, 0x52, 0xdf, 0xa3, 0x16, 0x7a, 0xd4, 0x0e, 0xd7, 0xd5,
+	0xa5, 0x36, 0x65, 0x26, 0x65, 0x75, 0x93, 0xe9, 0x5e, 0x80, 0xc9, 0x74, 0x11, 0xa1, 0x2e, 0x8b,
+	0x8d, 0x96, 0x6f, 0xd5, 0x85, 0x21, 0xb7, 0x16, 0x74, 0xaa, 0x53, 0xb1, 0xee, 0xfd, 0x92, 0xab,
+	0x65, 0x9d, 0x52, 0x7d, 0x9f, 0xd4, 0x7d, 0x6b, 0xcf, 0xfd, 0xba, 0xce, 0x0d, 0x93, 0x30, 0x8e,
+	0x4d, 0x5b, 0x3a, 0xdc, 0x48, 0x62, 0xd9, 0x27, 0xe4, 0x3b, 0x56, 0x9f, 0x4f, 0xc3, 0x62, 0x93,
+	0xe9, 0x5b, 0x9d, 0xce, 0x56, 0xb0, 0xa3, 0x91, 0x03, 0x97, 0x30, 0xae, 0x28, 0x90, 0xb5, 0xb0,
+	0x49, 0x8a, 0xa8, 0x82, 0x56, 0x2f, 0x6a, 0xfe, 0x6f, 0x65, 0x01, 0x66, 0x0e, 0xf1, 0xbe, 0x4b,
+	0x8a, 0xd3, 0x15, 0xb4, 0x9a, 0xd7, 0x84, 0xa1, 0x34, 0xa1, 0x10, 0xe2, 0xb6, 0xf8, 0x91, 0x4d,
+	0x8a, 0x99, 0x0a, 0x5a, 0x2d, 0x34, 0xae, 0xd7, 0x12, 0xa4, 0xa8, 0x85, 0xc9, 0x1e, 0x1c, 0xd9,
+	0x44, 0x9b, 0xc7, 0x51, 0x53, 0x29, 0xc2, 0x05, 0xdc, 0x6e, 0x53, 0xd7, 0xe2, 0xc5, 0xac, 0x9f,
+	0x3b, 0x30, 0xbd, 0xf4, 0xf4, 0x89, 0x45, 0x9c, 0xe2, 0x8c, 0xbf, 0x2e, 0x0c, 0xa5, 0x09, 0x97,
+	0x48, 0xcf, 0x36, 0x1c, 0xcc, 0x0d, 0x6a, 0xb5, 0x3a, 0x98, 0x93, 0xe2, 0x6c, 0x05, 0xad, 0xe6,
+	0x1a, 0x6a, 0x4d, 0xe8, 0x54, 0x0b, 0x74, 0xaa, 0x3d, 0x08, 0x74, 0xda, 0x9e, 0x3b, 0x7e, 0x55,
+	0x46, 0xcf, 0xfe, 0x29, 0x23, 0xad, 0xd0, 0x0f, 0xde, 0xc1, 0x9c, 0x6c, 0xc2, 0xf7, 0xaf, 0x5f,
+	0xac, 0x09, 0xe8, 0xea, 0x32, 0x2c, 0x8d, 0xa8, 0xc3, 0x6c, 0x6a, 0x31, 0x52, 0xfd, 0x35, 0x03,
+	0xcb, 0x4d, 0xa6, 0x3f, 0xb4, 0xbd, 0x84, 0x63, 0x89, 0xf7, 0x3e, 0x14, 0xa8, 0x63, 0xe8, 0x86,
+	0x85, 0xf7, 0x5b, 0x51, 0x15, 0xe7, 0x83, 0xd5, 0x2f, 0x7c, 0x35, 0x57, 0x20, 0xef, 0xfa, 0xa0,
+	0xd2, 0x29, 0xe3, 0x3b, 0xe5, 0xc4, 0x9a, 0x70, 0xf9, 0x0a, 0x96, 0x42, 0xa4, 0x21, 0xe5, 0xb3,
+	0x13, 0x29, 0x7f, 0x25, 0x80, 0x19, 0x58, 0x56, 0x1e, 0xc1, 0x15, 0x49, 0x61, 0x08, 0x7d, 0x66,
+	0x22, 0xf4, 0xcb, 0xee, 0xa0, 0x38, 0xc3, 0xdd, 0x9d, 0x4d, 0xe8, 0xee, 0x85, 0x68, 0x77, 0x6b,
+	0x70, 0x79, 0x50, 0xb5, 0x56, 0x17, 0xb3, 0x6e, 0x71, 0xce, 0x57, 0xe5, 0xed, 0x01, 0xe9, 0xee,
+	0x61, 0xd6, 0x1d, 0x68, 0xdf, 0x3b, 0xa0, 0xc6, 0xb5, 0x48, 0x76, 0xf0, 0x6f, 0x04, 0xef, 0x8d,
+	0x6e, 0x7f, 0x16, 0x9e, 0x86, 0xf3, 0x5c, 0x84, 0x91, 0x93, 0x98, 0x39, 0xff, 0x49, 0x9c, 0xf4,
+	0x22, 0x0c, 0x94, 0x7e, 0x1d, 0xae, 0xa5, 0xd7, 0x26, 0x45, 0xf8, 0x19, 0xf9, 0xc7, 0x78, 0x87,
+	0xec, 0x93, 0x31, 0x8f, 0x71, 0x84, 0xd5, 0x74, 0x02, 0xab, 0xcc, 0x18, 0x0d, 0xcc, 0x8e, 0xdf,
+	0xc0, 0x11, 0x72, 0x92, 0xfb, 0x53, 0x04, 0x2b, 0xe1, 0xf6, 0x8e, 0xc1, 0xb8, 0x61, 0xb5, 0xf9,
+	0x1b, 0xbc, 0x63, 0x91, 0xca, 0x32, 0x09, 0x95, 0x65, 0x93, 0xf4, 0xbe, 0x06, 0xd5, 0x34, 0x2a,
+	0x92, 0xf1, 0x97, 0x50, 0x6c, 0x32, 0xfd, 0x3e, 0xe1, 0x5b, 0x02, 0x78, 0x07, 0x73, 0x1c, 0xf0,
+	0x0c, 0x39, 0x09, 0xa2, 0xa3, 0x9c, 0x06, 0xd5, 0xde, 0xcc, 0x7b, 0xd9, 0x03, 0xab, 0x7a, 0x15,
+	0x96, 0x63, 0x90, 0x65, 0xda, 0x1e, 0x94, 0xe4, 0x66, 0xc0, 0xe8, 0xa1, 0xb5, 0x6f, 0x30, 0x4e,
+	0x3a, 0x41, 0xf2, 0x48, 0x1a, 0x34, 0x58, 0x7a, 0x20, 0xdf, 0x74, 0x44, 0x3e, 0x15, 0xe6, 0x5c,
+	0x09, 0xe0, 0x2b, 0x35, 0xa7, 0x85, 0xf6, 0x10, 0xad, 0x15, 0x28, 0x27, 0x66, 0x96, 0xe4, 0x7e,
+	0x43, 0xa0, 0x0e, 0xf9, 0xdc, 0xa3, 0xf4, 0x71, 0x5a, 0xfb, 0x54, 0x98, 0x6b, 0x53, 0x8b, 0x3b,
+	0xb8, 0x1d, 0xa8, 0x12, 0xda, 0xca, 0xa7, 0x90, 0x35, 0x69, 0x27, 0x18, 0x41, 0x6b, 0x67, 0x3f,
+	0x55, 0x5e, 0xb2, 0x26, 0xed, 0x10, 0xcd, 0x8f, 0x1b, 0xa3, 0xd5, 0xef, 0xc2, 0xd5, 0x58, 0xbe,
+	0xb2, 0x9e, 0xe7, 0xe2, 0x46, 0x69, 0xe4, 0x90, 0x3e, 0xfe, 0x1f, 0x6e, 0x54, 0x74, 0x08, 0x08,
+	0x43, 0x59, 0x84, 0x59, 0x83, 0x31, 0x37, 0xe4, 0x28, 0x2d, 0x6f, 0xdd, 0x21, 0x98, 0x51, 0x4b,
+	0x3e, 0x0b, 0xd2, 0xda, 0xcc, 0x79, 0xe4, 0xa5, 0x53, 0xf5, 0x36, 0xa8, 0x71, 0xec, 0x04, 0x79,
+	0x8f, 0x8a, 0xe3, 0x6f, 0x75, 0x7c, 0x86, 0xf3, 0x5a, 0x60, 0x56, 0x7f, 0x41, 0xb0, 0x18, 0xbe,
+	0x28, 0xbb, 0xd8, 0xc1, 0x26, 0x0b, 0x6a, 0xba, 0x0d, 0x17, 0xb1, 0xcb, 0xbb, 0xd4, 0x31, 0xf8,
+	0x91, 0x28, 0x6c, 0xbb, 0xf8, 0xe7, 0x1f, 0x37, 0x17, 0xe4, 0x97, 0xcc, 0x56, 0xa7, 0xe3, 0x10,
+	0xc6, 0xee, 0x73, 0xc7, 0xb0, 0x74, 0xad, 0xef, 0xaa, 0xdc, 0x85, 0x59, 0xdb, 0x07, 0xf2, 0xcb,
+	0xce, 0x35, 0xca, 0x89, 0xcd, 0x12, 0xf9, 0xb6, 0xb3, 0xc7, 0xaf, 0xca, 0x53, 0x9a, 0x0c, 0xda,
+	0x2c, 0x78, 0x65, 0xf5, 0xe1, 0xe4, 0xb0, 0x1e, 0x24, 0x28, 0x7b, 0xf2, 0x83, 0x78, 0x29, 0x76,
+	0x5d, 0x47, 0x27, 0x9f, 0x3b, 0x76, 0x17, 0x5b, 0xa4, 0x3f, 0xd2, 0xdf, 0xb8, 0x8e, 0x15, 0xc8,
+	0x9b, 0xb8, 0xd7, 0x92, 0x4d, 0x13, 0xd5, 0xcc, 0x6b, 0x39, 0x13, 0xf7, 0xe4, 0x65, 0x1c, 0xe5,
+	0xda, 0x84, 0x6a, 0x1a, 0x1f, 0xd9, 0x8d, 0x1b, 0x70, 0xc9, 0xf6, 0x5c, 0x3a, 0x7d, 0x6c, 0x54,
+	0xc9, 0xac, 0x5e, 0xd4, 0x0a, 0x62, 0x39, 0x80, 0x6f, 0xfc, 0x9e, 0x83, 0x4c, 0x93, 0xe9, 0xca,
+	0x01, 0xe4, 0xa3, 0x1f, 0x2b, 0x4a, 0x3d, 0x51, 0xd1, 0xf8, 0x8f, 0x3e, 0xf5, 0xd6, 0xf8, 0x01,
+	0x92, 0xe3, 0x37, 0x70, 0x69, 0x68, 0xca, 0x28, 0x8d, 0x34, 0x90, 0xf8, 0x0f, 0x26, 0x75, 0x63,
+	0xa2, 0x18, 0x99, 0xfb, 0x27, 0x04, 0xcb, 0x89, 0x23, 0x4e, 0xf9, 0x64, 0x02, 0xc8, 0x91, 0xa9,
+	0xaf, 0xde, 0x3d, 0x67, 0x74, 0x5f, 0x96, 0xa1, 0xb1, 0x95, 0x2e, 0x4b, 0xfc, 0x00, 0x56, 0x37,
+	0x26, 0x8a, 0x91, 0xb9, 0x7f, 0x44, 0xb0, 0x94, 0x30, 0x89, 0x94, 0xcd, 0xb3, 0x01, 0x93, 0x26,
+	0xa9, 0x7a, 0xe7, 0x5c, 0xb1, 0x92, 0xd4, 0x13, 0x28, 0x0c, 0x4e, 0x27, 0x65, 0x3d, 0x0d, 0x2e,
+	0x76, 0x46, 0xaa, 0x8d, 0x49, 0x42, 0x64, 0xe2, 0xa7, 0x08, 0x16, 0xe2, 0x06, 0x90, 0xf2, 0xd1,
+	0x59, 0x60, 0x09, 0xc3, 0x52, 0xfd, 0x78, 0xf2, 0x40, 0xc9, 0xe5, 0x5b, 0x78, 0x6b, 0x78, 0x6e,
+	0x28, 0x1b, 0xe3, 0xa2, 0x45, 0xa6, 0xa2, 0xfa, 0xc1, 0x64, 0x41, 0xfd, 0x43, 0x39, 0xf4, 0xf0,
+	0xa7, 0x1f, 0xca, 0xf8, 0x19, 0xa6, 0x6e, 0x4c, 0x14, 0x23, 0x73, 0x1f, 0x40, 0x3e, 0xfa, 0x34,
+	0xa7, 0x3f, 0x4d, 0x31, 0x53, 0x46, 0xbd, 0x35, 0x7e, 0x40, 0xe4, 0x1e, 0x24, 0x3c, 0xb1, 0xe9,
+	0xf7, 0x20, 0x7d, 0x4e, 0xa8, 0x77, 0xce, 0x15, 0x2b, 0x48, 0xa9, 0x33, 0xdf, 0xbd, 0x7e, 0xb1,
+	0x86, 0xb6, 0xcd, 0xe3, 0x93, 0x12, 0x7a, 0x79, 0x52, 0x42, 0xff, 0x9e, 0x94, 0xd0, 0xb3, 0xd3,
+	0xd2, 0xd4, 0xcb, 0xd3, 0xd2, 0xd4, 0x5f, 0xa7, 0xa5, 0x29, 0x50, 0x0d, 0x9a, 0x84, 0xbf, 0x8b,
+	0x1e, 0x7d, 0xa8, 0x1b, 0xbc, 0xeb, 0xee, 0xd5, 0xda, 0xd4, 0xac, 0xf7, 0xbd, 0x6e, 0x1a, 0x34,
+	0x62, 0xd5, 0x7b, 0x91, 0x3f, 0xfd, 0xde, 0xff, 0x36, 0xb6, 0x37, 0xeb, 0xff, 0xf1, 0xd8, 0xf8,
+	0x6f, 0x00, 0x64, 0x26, 0x8b, 0xb7, 0xbf, 0x10, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
 // is compatible with the grpc package it is being compiled against.
 const _ = grpc.SupportPackageIsVersion4
 
@@ -917,8 +1410,20 @@ type MsgClient interface {
 	DeleteDistinctAttribute(ctx context.Context, in *MsgDeleteDistinctAttributeRequest, opts ...grpc.CallOption) (*MsgDeleteDistinctAttributeResponse, error)
 	// SetAccountData defines a method for setting/updating an account's accountdata attribute.
 	SetAccountData(ctx context.Context, in *MsgSetAccountDataRequest, opts ...grpc.CallOption) (*MsgSetAccountDataResponse, error)
+	// SetAttributeUnlisted defines a method for an account to mark one of its attribute names as unlisted (or listed).
+	// Unlisted attributes are left out of the enumeration queries but can still be looked up directly by name.
+	SetAttributeUnlisted(ctx context.Context, in *MsgSetAttributeUnlistedRequest, opts ...grpc.CallOption) (*MsgSetAttributeUnlistedResponse, error)
+	// SetAttributeHook defines a method for the owner of an attribute name to register (or remove) a wasm contract
+	// that is called whenever an attribute with that name is added or deleted.
+	SetAttributeHook(ctx context.Context, in *MsgSetAttributeHookRequest, opts ...grpc.CallOption) (*MsgSetAttributeHookResponse, error)
+	// RevokeAttribute defines a method for the owner of an attribute name to revoke the attributes it issued on an
+	// account, without needing the account's cooperation. It is only available when enabled by the params.
+	RevokeAttribute(ctx context.Context, in *MsgRevokeAttributeRequest, opts ...grpc.CallOption) (*MsgRevokeAttributeResponse, error)
 	// UpdateParams is a governance proposal endpoint for updating the attribute module's params.
 	UpdateParams(ctx context.Context, in *MsgUpdateParamsRequest, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// PurgeOrphanedAttributes is a governance proposal endpoint for removing all attributes (and their indexes)
+	// bound to account addresses that do not have an account in x/auth.
+	PurgeOrphanedAttributes(ctx context.Context, in *MsgPurgeOrphanedAttributesRequest, opts ...grpc.CallOption) (*MsgPurgeOrphanedAttributesResponse, error)
 }
 
 type msgClient struct {
@@ -983,6 +1488,33 @@ func (c *msgClient) SetAccountData(ctx context.Context, in *MsgSetAccountDataReq
 	return out, nil
 }
 
+func (c *msgClient) SetAttributeUnlisted(ctx context.Context, in *MsgSetAttributeUnlistedRequest, opts ...grpc.CallOption) (*MsgSetAttributeUnlistedResponse, error) {
+	out := new(MsgSetAttributeUnlistedResponse)
+	err := c.cc.Invoke(ctx, "/provenance.attribute.v1.Msg/SetAttributeUnlisted", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetAttributeHook(ctx context.Context, in *MsgSetAttributeHookRequest, opts ...grpc.CallOption) (*MsgSetAttributeHookResponse, error) {
+	out := new(MsgSetAttributeHookResponse)
+	err := c.cc.Invoke(ctx, "/provenance.attribute.v1.Msg/SetAttributeHook", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RevokeAttribute(ctx context.Context, in *MsgRevokeAttributeRequest, opts ...grpc.CallOption) (*MsgRevokeAttributeResponse, error) {
+	out := new(MsgRevokeAttributeResponse)
+	err := c.cc.Invoke(ctx, "/provenance.attribute.v1.Msg/RevokeAttribute", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParamsRequest, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
 	out := new(MsgUpdateParamsResponse)
 	err := c.cc.Invoke(ctx, "/provenance.attribute.v1.Msg/UpdateParams", in, out, opts...)
@@ -992,6 +1524,15 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParamsRequest
 	return out, nil
 }
 
+func (c *msgClient) PurgeOrphanedAttributes(ctx context.Context, in *MsgPurgeOrphanedAttributesRequest, opts ...grpc.CallOption) (*MsgPurgeOrphanedAttributesResponse, error) {
+	out := new(MsgPurgeOrphanedAttributesResponse)
+	err := c.cc.Invoke(ctx, "/provenance.attribute.v1.Msg/PurgeOrphanedAttributes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// AddAttribute defines a method to verify a particular invariance.
@@ -1006,8 +1547,20 @@ type MsgServer interface {
 	DeleteDistinctAttribute(context.Context, *MsgDeleteDistinctAttributeRequest) (*MsgDeleteDistinctAttributeResponse, error)
 	// SetAccountData defines a method for setting/updating an account's accountdata attribute.
 	SetAccountData(context.Context, *MsgSetAccountDataRequest) (*MsgSetAccountDataResponse, error)
+	// SetAttributeUnlisted defines a method for an account to mark one of its attribute names as unlisted (or listed).
+	// Unlisted attributes are left out of the enumeration queries but can still be looked up directly by name.
+	SetAttributeUnlisted(context.Context, *MsgSetAttributeUnlistedRequest) (*MsgSetAttributeUnlistedResponse, error)
+	// SetAttributeHook defines a method for the owner of an attribute name to register (or remove) a wasm contract
+	// that is called whenever an attribute with that name is added or deleted.
+	SetAttributeHook(context.Context, *MsgSetAttributeHookRequest) (*MsgSetAttributeHookResponse, error)
+	// RevokeAttribute defines a method for the owner of an attribute name to revoke the attributes it issued on an
+	// account, without needing the account's cooperation. It is only available when enabled by the params.
+	RevokeAttribute(context.Context, *MsgRevokeAttributeRequest) (*MsgRevokeAttributeResponse, error)
 	// UpdateParams is a governance proposal endpoint for updating the attribute module's params.
 	UpdateParams(context.Context, *MsgUpdateParamsRequest) (*MsgUpdateParamsResponse, error)
+	// PurgeOrphanedAttributes is a governance proposal endpoint for removing all attributes (and their indexes)
+	// bound to account addresses that do not have an account in x/auth.
+	PurgeOrphanedAttributes(context.Context, *MsgPurgeOrphanedAttributesRequest) (*MsgPurgeOrphanedAttributesResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -1032,9 +1585,21 @@ func (*UnimplementedMsgServer) DeleteDistinctAttribute(ctx context.Context, req
 func (*UnimplementedMsgServer) SetAccountData(ctx context.Context, req *MsgSetAccountDataRequest) (*MsgSetAccountDataResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetAccountData not implemented")
 }
+func (*UnimplementedMsgServer) SetAttributeUnlisted(ctx context.Context, req *MsgSetAttributeUnlistedRequest) (*MsgSetAttributeUnlistedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAttributeUnlisted not implemented")
+}
+func (*UnimplementedMsgServer) SetAttributeHook(ctx context.Context, req *MsgSetAttributeHookRequest) (*MsgSetAttributeHookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAttributeHook not implemented")
+}
+func (*UnimplementedMsgServer) RevokeAttribute(ctx context.Context, req *MsgRevokeAttributeRequest) (*MsgRevokeAttributeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeAttribute not implemented")
+}
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParamsRequest) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (*UnimplementedMsgServer) PurgeOrphanedAttributes(ctx context.Context, req *MsgPurgeOrphanedAttributesRequest) (*MsgPurgeOrphanedAttributesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurgeOrphanedAttributes not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -1148,6 +1713,60 @@ func _Msg_SetAccountData_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetAttributeUnlisted_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetAttributeUnlistedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetAttributeUnlisted(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.attribute.v1.Msg/SetAttributeUnlisted",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetAttributeUnlisted(ctx, req.(*MsgSetAttributeUnlistedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetAttributeHook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetAttributeHookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetAttributeHook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.attribute.v1.Msg/SetAttributeHook",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetAttributeHook(ctx, req.(*MsgSetAttributeHookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RevokeAttribute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevokeAttributeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevokeAttribute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.attribute.v1.Msg/RevokeAttribute",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevokeAttribute(ctx, req.(*MsgRevokeAttributeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgUpdateParamsRequest)
 	if err := dec(in); err != nil {
@@ -1166,6 +1785,24 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_PurgeOrphanedAttributes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPurgeOrphanedAttributesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).PurgeOrphanedAttributes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.attribute.v1.Msg/PurgeOrphanedAttributes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PurgeOrphanedAttributes(ctx, req.(*MsgPurgeOrphanedAttributesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Msg_serviceDesc = _Msg_serviceDesc
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "provenance.attribute.v1.Msg",
@@ -1195,10 +1832,26 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SetAccountData",
 			Handler:    _Msg_SetAccountData_Handler,
 		},
+		{
+			MethodName: "SetAttributeUnlisted",
+			Handler:    _Msg_SetAttributeUnlisted_Handler,
+		},
+		{
+			MethodName: "SetAttributeHook",
+			Handler:    _Msg_SetAttributeHook_Handler,
+		},
+		{
+			MethodName: "RevokeAttribute",
+			Handler:    _Msg_RevokeAttribute_Handler,
+		},
 		{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "PurgeOrphanedAttributes",
+			Handler:    _Msg_PurgeOrphanedAttributes_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "provenance/attribute/v1/tx.proto",
@@ -1313,6 +1966,13 @@ func (m *MsgUpdateAttributeRequest) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	_ = i
 	var l int
 	_ = l
+	if len(m.OriginalValueHash) > 0 {
+		i -= len(m.OriginalValueHash)
+		copy(dAtA[i:], m.OriginalValueHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OriginalValueHash)))
+		i--
+		dAtA[i] = 0x42
+	}
 	if len(m.Owner) > 0 {
 		i -= len(m.Owner)
 		copy(dAtA[i:], m.Owner)
@@ -1488,6 +2148,13 @@ func (m *MsgDeleteAttributeRequest) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	_ = i
 	var l int
 	_ = l
+	if len(m.OriginalValueHash) > 0 {
+		i -= len(m.OriginalValueHash)
+		copy(dAtA[i:], m.OriginalValueHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OriginalValueHash)))
+		i--
+		dAtA[i] = 0x22
+	}
 	if len(m.Owner) > 0 {
 		i -= len(m.Owner)
 		copy(dAtA[i:], m.Owner)
@@ -1669,7 +2336,7 @@ func (m *MsgSetAccountDataResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateParamsRequest) Marshal() (dAtA []byte, err error) {
+func (m *MsgSetAttributeUnlistedRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1679,37 +2346,44 @@ func (m *MsgUpdateParamsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgSetAttributeUnlistedRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgSetAttributeUnlistedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if m.Unlisted {
+		i--
+		if m.Unlisted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x18
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Account)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgSetAttributeUnlistedResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1719,12 +2393,12 @@ func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgSetAttributeUnlistedResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgSetAttributeUnlistedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -1732,54 +2406,342 @@ func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgSetAttributeHookRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgAddAttributeRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgSetAttributeHookRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAttributeHookRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Value)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.AttributeType != 0 {
-		n += 1 + sovTx(uint64(m.AttributeType))
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.Account)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.Mode != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Mode))
+		i--
+		dAtA[i] = 0x18
 	}
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.ExpirationDate != nil {
-		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpirationDate)
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgAddAttributeResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
+func (m *MsgSetAttributeHookResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetAttributeHookResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAttributeHookResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokeAttributeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeAttributeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeAttributeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Issuer) > 0 {
+		i -= len(m.Issuer)
+		copy(dAtA[i:], m.Issuer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Issuer)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokeAttributeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeAttributeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeAttributeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Revoked != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Revoked))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPurgeOrphanedAttributesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPurgeOrphanedAttributesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPurgeOrphanedAttributesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxAccounts != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MaxAccounts))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPurgeOrphanedAttributesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPurgeOrphanedAttributesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPurgeOrphanedAttributesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PurgedAccounts) > 0 {
+		for iNdEx := len(m.PurgedAccounts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PurgedAccounts[iNdEx])
+			copy(dAtA[i:], m.PurgedAccounts[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.PurgedAccounts[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgAddAttributeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.AttributeType != 0 {
+		n += 1 + sovTx(uint64(m.AttributeType))
+	}
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ExpirationDate != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpirationDate)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAddAttributeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
 	_ = l
 	return n
 }
@@ -1816,6 +2778,10 @@ func (m *MsgUpdateAttributeRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	l = len(m.OriginalValueHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -1884,8 +2850,12 @@ func (m *MsgDeleteAttributeRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	return n
-}
+	l = len(m.OriginalValueHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
 
 func (m *MsgDeleteAttributeResponse) Size() (n int) {
 	if m == nil {
@@ -1956,6 +2926,109 @@ func (m *MsgSetAccountDataResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgSetAttributeUnlistedRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Unlisted {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgSetAttributeUnlistedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetAttributeHookRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Mode != 0 {
+		n += 1 + sovTx(uint64(m.Mode))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetAttributeHookResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRevokeAttributeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Issuer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRevokeAttributeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Revoked != 0 {
+		n += 1 + sovTx(uint64(m.Revoked))
+	}
+	return n
+}
+
 func (m *MsgUpdateParamsRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1980,6 +3053,37 @@ func (m *MsgUpdateParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgPurgeOrphanedAttributesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.MaxAccounts != 0 {
+		n += 1 + sovTx(uint64(m.MaxAccounts))
+	}
+	return n
+}
+
+func (m *MsgPurgeOrphanedAttributesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PurgedAccounts) > 0 {
+		for _, s := range m.PurgedAccounts {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2049,9 +3153,864 @@ func (m *MsgAddAttributeRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttributeType", wireType)
+			}
+			m.AttributeType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AttributeType |= AttributeType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationDate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpirationDate == nil {
+				m.ExpirationDate = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.ExpirationDate, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddAttributeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddAttributeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddAttributeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateAttributeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateAttributeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateAttributeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginalValue", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OriginalValue = append(m.OriginalValue[:0], dAtA[iNdEx:postIndex]...)
+			if m.OriginalValue == nil {
+				m.OriginalValue = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateValue", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpdateValue = append(m.UpdateValue[:0], dAtA[iNdEx:postIndex]...)
+			if m.UpdateValue == nil {
+				m.UpdateValue = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginalAttributeType", wireType)
+			}
+			m.OriginalAttributeType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OriginalAttributeType |= AttributeType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateAttributeType", wireType)
+			}
+			m.UpdateAttributeType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpdateAttributeType |= AttributeType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginalValueHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OriginalValueHash = append(m.OriginalValueHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.OriginalValueHash == nil {
+				m.OriginalValueHash = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateAttributeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateAttributeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateAttributeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateAttributeExpirationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateAttributeExpirationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateAttributeExpirationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationDate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpirationDate == nil {
+				m.ExpirationDate = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.ExpirationDate, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateAttributeExpirationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateAttributeExpirationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateAttributeExpirationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeleteAttributeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteAttributeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteAttributeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2061,46 +4020,25 @@ func (m *MsgAddAttributeRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
-			if m.Value == nil {
-				m.Value = []byte{}
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AttributeType", wireType)
-			}
-			m.AttributeType = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.AttributeType |= AttributeType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
 			}
@@ -2132,7 +4070,7 @@ func (m *MsgAddAttributeRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Account = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
@@ -2164,11 +4102,11 @@ func (m *MsgAddAttributeRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationDate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginalValueHash", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2178,26 +4116,24 @@ func (m *MsgAddAttributeRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ExpirationDate == nil {
-				m.ExpirationDate = new(time.Time)
-			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.ExpirationDate, dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.OriginalValueHash = append(m.OriginalValueHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.OriginalValueHash == nil {
+				m.OriginalValueHash = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -2221,7 +4157,7 @@ func (m *MsgAddAttributeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddAttributeResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgDeleteAttributeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2244,10 +4180,10 @@ func (m *MsgAddAttributeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddAttributeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDeleteAttributeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddAttributeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDeleteAttributeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2271,7 +4207,7 @@ func (m *MsgAddAttributeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateAttributeRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgDeleteDistinctAttributeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2294,10 +4230,10 @@ func (m *MsgUpdateAttributeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateAttributeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDeleteDistinctAttributeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateAttributeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDeleteDistinctAttributeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2334,7 +4270,7 @@ func (m *MsgUpdateAttributeRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OriginalValue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -2361,84 +4297,12 @@ func (m *MsgUpdateAttributeRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OriginalValue = append(m.OriginalValue[:0], dAtA[iNdEx:postIndex]...)
-			if m.OriginalValue == nil {
-				m.OriginalValue = []byte{}
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
 			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdateValue", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.UpdateValue = append(m.UpdateValue[:0], dAtA[iNdEx:postIndex]...)
-			if m.UpdateValue == nil {
-				m.UpdateValue = []byte{}
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OriginalAttributeType", wireType)
-			}
-			m.OriginalAttributeType = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.OriginalAttributeType |= AttributeType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdateAttributeType", wireType)
-			}
-			m.UpdateAttributeType = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.UpdateAttributeType |= AttributeType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 6:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
 			}
@@ -2470,7 +4334,7 @@ func (m *MsgUpdateAttributeRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Account = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
@@ -2523,7 +4387,7 @@ func (m *MsgUpdateAttributeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateAttributeResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgDeleteDistinctAttributeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2546,10 +4410,10 @@ func (m *MsgUpdateAttributeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateAttributeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDeleteDistinctAttributeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateAttributeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDeleteDistinctAttributeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2573,7 +4437,7 @@ func (m *MsgUpdateAttributeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateAttributeExpirationRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgSetAccountDataRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2596,15 +4460,15 @@ func (m *MsgUpdateAttributeExpirationRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateAttributeExpirationRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetAccountDataRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateAttributeExpirationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetAccountDataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2632,13 +4496,13 @@ func (m *MsgUpdateAttributeExpirationRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2648,31 +4512,129 @@ func (m *MsgUpdateAttributeExpirationRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetAccountDataResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetAccountDataResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetAccountDataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetAttributeUnlistedRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
-			if m.Value == nil {
-				m.Value = []byte{}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 3:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetAttributeUnlistedRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetAttributeUnlistedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationDate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2682,31 +4644,27 @@ func (m *MsgUpdateAttributeExpirationRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ExpirationDate == nil {
-				m.ExpirationDate = new(time.Time)
-			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.ExpirationDate, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Account = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2734,13 +4692,13 @@ func (m *MsgUpdateAttributeExpirationRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Account = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unlisted", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2750,24 +4708,12 @@ func (m *MsgUpdateAttributeExpirationRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.Unlisted = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2789,7 +4735,7 @@ func (m *MsgUpdateAttributeExpirationRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateAttributeExpirationResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetAttributeUnlistedResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2812,10 +4758,10 @@ func (m *MsgUpdateAttributeExpirationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateAttributeExpirationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetAttributeUnlistedResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateAttributeExpirationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetAttributeUnlistedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2839,7 +4785,7 @@ func (m *MsgUpdateAttributeExpirationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteAttributeRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgSetAttributeHookRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2862,10 +4808,10 @@ func (m *MsgDeleteAttributeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteAttributeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetAttributeHookRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteAttributeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetAttributeHookRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2902,7 +4848,7 @@ func (m *MsgDeleteAttributeRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2930,9 +4876,28 @@ func (m *MsgDeleteAttributeRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Account = string(dAtA[iNdEx:postIndex])
+			m.Contract = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
+			}
+			m.Mode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Mode |= AttributeHookMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
@@ -2985,7 +4950,7 @@ func (m *MsgDeleteAttributeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteAttributeResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetAttributeHookResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3008,10 +4973,10 @@ func (m *MsgDeleteAttributeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteAttributeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetAttributeHookResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteAttributeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetAttributeHookResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3035,7 +5000,7 @@ func (m *MsgDeleteAttributeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteDistinctAttributeRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgRevokeAttributeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3058,10 +5023,10 @@ func (m *MsgDeleteDistinctAttributeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteDistinctAttributeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRevokeAttributeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteDistinctAttributeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRevokeAttributeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3097,6 +5062,38 @@ func (m *MsgDeleteDistinctAttributeRequest) Unmarshal(dAtA []byte) error {
 			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
@@ -3130,9 +5127,9 @@ func (m *MsgDeleteDistinctAttributeRequest) Unmarshal(dAtA []byte) error {
 				m.Value = []byte{}
 			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Issuer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3160,11 +5157,11 @@ func (m *MsgDeleteDistinctAttributeRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Account = string(dAtA[iNdEx:postIndex])
+			m.Issuer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3192,7 +5189,7 @@ func (m *MsgDeleteDistinctAttributeRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3215,7 +5212,7 @@ func (m *MsgDeleteDistinctAttributeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteDistinctAttributeResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRevokeAttributeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3238,12 +5235,31 @@ func (m *MsgDeleteDistinctAttributeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteDistinctAttributeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRevokeAttributeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteDistinctAttributeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRevokeAttributeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revoked", wireType)
+			}
+			m.Revoked = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Revoked |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3265,7 +5281,7 @@ func (m *MsgDeleteDistinctAttributeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetAccountDataRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateParamsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3288,15 +5304,15 @@ func (m *MsgSetAccountDataRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetAccountDataRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateParamsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetAccountDataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3324,13 +5340,13 @@ func (m *MsgSetAccountDataRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3340,23 +5356,24 @@ func (m *MsgSetAccountDataRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Account = string(dAtA[iNdEx:postIndex])
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3379,7 +5396,7 @@ func (m *MsgSetAccountDataRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetAccountDataResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3402,10 +5419,10 @@ func (m *MsgSetAccountDataResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetAccountDataResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetAccountDataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3429,7 +5446,7 @@ func (m *MsgSetAccountDataResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgPurgeOrphanedAttributesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3452,10 +5469,10 @@ func (m *MsgUpdateParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgPurgeOrphanedAttributesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgPurgeOrphanedAttributesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3491,10 +5508,10 @@ func (m *MsgUpdateParamsRequest) Unmarshal(dAtA []byte) error {
 			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAccounts", wireType)
 			}
-			var msglen int
+			m.MaxAccounts = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3504,25 +5521,11 @@ func (m *MsgUpdateParamsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.MaxAccounts |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3544,7 +5547,7 @@ func (m *MsgUpdateParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgPurgeOrphanedAttributesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3567,12 +5570,44 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgPurgeOrphanedAttributesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgPurgeOrphanedAttributesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PurgedAccounts", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PurgedAccounts = append(m.PurgedAccounts, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -66,6 +66,7 @@ const (
 	FlagFile                 = "file"
 	FlagGrant                = "grant"
 	FlagIcon                 = "icon"
+	FlagInKind               = "in-kind"
 	FlagInputs               = "inputs"
 	FlagMarket               = "market"
 	FlagName                 = "name"
@@ -103,6 +104,7 @@ const (
 	FlagTargetAmount         = "target-amount"
 	FlagTo                   = "to"
 	FlagUnsetBips            = "unset-bips"
+	FlagUnsetInKind          = "unset-in-kind"
 	FlagURL                  = "url"
 )
 
@@ -1325,7 +1325,7 @@ func TestSetupCmdQueryValidateCreateMarket(t *testing.T) {
 			cli.FlagSellerFlat, cli.FlagSellerRatios, cli.FlagBuyerFlat, cli.FlagBuyerRatios,
 			cli.FlagAcceptingOrders, cli.FlagAllowUserSettle, cli.FlagAcceptingCommitments, cli.FlagAccessGrants,
 			cli.FlagReqAttrAsk, cli.FlagReqAttrBid, cli.FlagReqAttrCommitment,
-			cli.FlagBips, cli.FlagDenom,
+			cli.FlagBips, cli.FlagDenom, cli.FlagInKind,
 			cli.FlagProposal,
 		},
 		expInUse: []string{
@@ -1337,7 +1337,7 @@ func TestSetupCmdQueryValidateCreateMarket(t *testing.T) {
 			"[--accepting-orders]", "[--allow-user-settle]", "[--accepting-commitments]",
 			"[--access-grants <access grants>]",
 			"[--req-attr-ask <attrs>]", "[--req-attr-bid <attrs>]", "[--req-attr-commitment <attrs>]",
-			"[--bips <bips>]", "[--denom <denom>]",
+			"[--bips <bips>]", "[--denom <denom>]", "[--in-kind]",
 			"[--proposal <json filename>",
 			cli.AuthorityDesc, cli.RepeatableDesc, cli.AccessGrantsDesc, cli.FeeRatioDesc,
 			cli.ProposalFileDesc(&exchange.MsgGovCreateMarketRequest{}),
@@ -1350,7 +1350,7 @@ func TestSetupCmdQueryValidateCreateMarket(t *testing.T) {
 		cli.FlagSellerFlat, cli.FlagSellerRatios, cli.FlagBuyerFlat, cli.FlagBuyerRatios,
 		cli.FlagAcceptingOrders, cli.FlagAllowUserSettle, cli.FlagAcceptingCommitments, cli.FlagAccessGrants,
 		cli.FlagReqAttrAsk, cli.FlagReqAttrBid, cli.FlagReqAttrCommitment,
-		cli.FlagBips, cli.FlagDenom,
+		cli.FlagBips, cli.FlagDenom, cli.FlagInKind,
 		cli.FlagProposal,
 	}
 	oneReqVal := strings.Join(oneReqFlags, " ")
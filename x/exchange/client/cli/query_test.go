@@ -576,6 +576,7 @@ market:
     - PERMISSION_ATTRIBUTES
   allow_user_settlement: true
   commitment_settlement_bips: 50
+  commitment_settlement_fee_in_kind: false
   fee_buyer_settlement_flat:
   - amount: "105"
     denom: peach
@@ -772,6 +773,7 @@ converted_total: []
 exchange_fees:
 - amount: "3890"
   denom: ` + s.feeDenom + `
+in_kind_fees: []
 input_total: []
 to_fee_nav: null
 `,
@@ -973,6 +973,7 @@ func SetupCmdTxGovCreateMarket(cmd *cobra.Command) {
 	cmd.Flags().Uint32(FlagBips, 0, "The commitment settlement bips (min=0, max=10,000)")
 	cmd.Flags().String(FlagDenom, "", "The intermediary denom")
 	cmd.Flags().StringSlice(FlagReqAttrCommitment, nil, "Attributes required to create commitments (repeatable)")
+	cmd.Flags().Bool(FlagInKind, false, "The market's commitment settlement fee should be collected in kind")
 
 	cmd.MarkFlagsOneRequired(
 		FlagMarket, FlagName, FlagDescription, FlagURL, FlagIcon,
@@ -980,7 +981,7 @@ func SetupCmdTxGovCreateMarket(cmd *cobra.Command) {
 		FlagSellerFlat, FlagSellerRatios, FlagBuyerFlat, FlagBuyerRatios,
 		FlagAcceptingOrders, FlagAllowUserSettle, FlagAcceptingCommitments, FlagAccessGrants,
 		FlagReqAttrAsk, FlagReqAttrBid, FlagReqAttrCommitment,
-		FlagBips, FlagDenom,
+		FlagBips, FlagDenom, FlagInKind,
 		FlagProposal,
 	)
 
@@ -1015,6 +1016,7 @@ func SetupCmdTxGovCreateMarket(cmd *cobra.Command) {
 		UseFlagsBreak,
 		OptFlagUse(FlagBips, "bips"),
 		OptFlagUse(FlagDenom, "denom"),
+		OptFlagUse(FlagInKind, ""),
 		UseFlagsBreak,
 		OptFlagUse(FlagProposal, "json filename"),
 	)
@@ -1031,7 +1033,7 @@ func SetupCmdTxGovCreateMarket(cmd *cobra.Command) {
 func MakeMsgGovCreateMarket(clientCtx client.Context, flagSet *pflag.FlagSet, _ []string) (*exchange.MsgGovCreateMarketRequest, error) {
 	var msg *exchange.MsgGovCreateMarketRequest
 
-	errs := make([]error, 20)
+	errs := make([]error, 21)
 	msg, errs[0] = ReadMsgGovCreateMarketRequestFromProposalFlag(clientCtx, flagSet)
 	msg.Authority, errs[1] = ReadFlagAuthorityOrDefault(flagSet, msg.Authority)
 	msg.Market.MarketId, errs[2] = ReadFlagUint32OrDefault(flagSet, FlagMarket, msg.Market.MarketId)
@@ -1052,6 +1054,7 @@ func MakeMsgGovCreateMarket(clientCtx client.Context, flagSet *pflag.FlagSet, _
 	msg.Market.ReqAttrCreateCommitment, errs[17] = ReadFlagStringSliceOrDefault(flagSet, FlagReqAttrCommitment, msg.Market.ReqAttrCreateCommitment)
 	msg.Market.CommitmentSettlementBips, errs[18] = ReadFlagUint32OrDefault(flagSet, FlagBips, msg.Market.CommitmentSettlementBips)
 	msg.Market.IntermediaryDenom, errs[19] = ReadFlagStringOrDefault(flagSet, FlagDenom, msg.Market.IntermediaryDenom)
+	msg.Market.CommitmentSettlementFeeInKind, errs[20] = ReadFlagBoolOrDefault(flagSet, FlagInKind, msg.Market.CommitmentSettlementFeeInKind)
 
 	return msg, errors.Join(errs...)
 }
@@ -1076,6 +1079,8 @@ func SetupCmdTxGovManageFees(cmd *cobra.Command) {
 	cmd.Flags().StringSlice(FlagCommitmentRemove, nil, "Create-commitment flat fee options to remove, e.g. 10nhash (repeatable)")
 	cmd.Flags().Uint32(FlagBips, 0, "Commitment settlement bips")
 	cmd.Flags().Bool(FlagUnsetBips, false, "Unset the commitment settlement bips")
+	cmd.Flags().Bool(FlagInKind, false, "Collect the commitment settlement fee in kind")
+	cmd.Flags().Bool(FlagUnsetInKind, false, "Stop collecting the commitment settlement fee in kind")
 	cmd.Flags().String(FlagProposal, "", "a json file of a Tx with a gov proposal with a MsgGovManageFeesRequest")
 
 	MarkFlagsRequired(cmd, FlagMarket)
@@ -1083,7 +1088,7 @@ func SetupCmdTxGovManageFees(cmd *cobra.Command) {
 		FlagAskAdd, FlagAskRemove, FlagBidAdd, FlagBidRemove,
 		FlagSellerFlatAdd, FlagSellerFlatRemove, FlagSellerRatiosAdd, FlagSellerRatiosRemove,
 		FlagBuyerFlatAdd, FlagBuyerFlatRemove, FlagBuyerRatiosAdd, FlagBuyerRatiosRemove,
-		FlagCommitmentAdd, FlagCommitmentRemove, FlagBips, FlagUnsetBips,
+		FlagCommitmentAdd, FlagCommitmentRemove, FlagBips, FlagUnsetBips, FlagInKind, FlagUnsetInKind,
 		FlagProposal,
 	)
 
@@ -1114,6 +1119,8 @@ func SetupCmdTxGovManageFees(cmd *cobra.Command) {
 		UseFlagsBreak,
 		OptFlagUse(FlagBips, "bips"),
 		OptFlagUse(FlagUnsetBips, ""),
+		OptFlagUse(FlagInKind, ""),
+		OptFlagUse(FlagUnsetInKind, ""),
 		UseFlagsBreak,
 		OptFlagUse(FlagProposal, "json filename"),
 	)
@@ -1130,7 +1137,7 @@ func SetupCmdTxGovManageFees(cmd *cobra.Command) {
 func MakeMsgGovManageFees(clientCtx client.Context, flagSet *pflag.FlagSet, _ []string) (*exchange.MsgGovManageFeesRequest, error) {
 	var msg *exchange.MsgGovManageFeesRequest
 
-	errs := make([]error, 19)
+	errs := make([]error, 21)
 	msg, errs[0] = ReadMsgGovManageFeesRequestFromProposalFlag(clientCtx, flagSet)
 	msg.Authority, errs[1] = ReadFlagAuthorityOrDefault(flagSet, msg.Authority)
 	msg.MarketId, errs[2] = ReadFlagUint32OrDefault(flagSet, FlagMarket, msg.MarketId)
@@ -1150,6 +1157,8 @@ func MakeMsgGovManageFees(clientCtx client.Context, flagSet *pflag.FlagSet, _ []
 	msg.RemoveFeeBuyerSettlementRatios, errs[16] = ReadFeeRatiosFlag(flagSet, FlagBuyerRatiosRemove, msg.RemoveFeeBuyerSettlementRatios)
 	msg.SetFeeCommitmentSettlementBips, errs[17] = ReadFlagUint32OrDefault(flagSet, FlagBips, msg.SetFeeCommitmentSettlementBips)
 	msg.UnsetFeeCommitmentSettlementBips, errs[18] = ReadFlagBoolOrDefault(flagSet, FlagUnsetBips, msg.UnsetFeeCommitmentSettlementBips)
+	msg.SetFeeCommitmentSettlementInKind, errs[19] = ReadFlagBoolOrDefault(flagSet, FlagInKind, msg.SetFeeCommitmentSettlementInKind)
+	msg.UnsetFeeCommitmentSettlementInKind, errs[20] = ReadFlagBoolOrDefault(flagSet, FlagUnsetInKind, msg.UnsetFeeCommitmentSettlementInKind)
 
 	return msg, errors.Join(errs...)
 }
@@ -2272,7 +2272,7 @@ func TestSetupCmdTxGovCreateMarket(t *testing.T) {
 			cli.FlagSellerFlat, cli.FlagSellerRatios, cli.FlagBuyerFlat, cli.FlagBuyerRatios,
 			cli.FlagAcceptingOrders, cli.FlagAllowUserSettle, cli.FlagAcceptingCommitments, cli.FlagAccessGrants,
 			cli.FlagReqAttrAsk, cli.FlagReqAttrBid, cli.FlagReqAttrCommitment,
-			cli.FlagBips, cli.FlagDenom,
+			cli.FlagBips, cli.FlagDenom, cli.FlagInKind,
 			cli.FlagProposal,
 		},
 		expInUse: []string{
@@ -2284,7 +2284,7 @@ func TestSetupCmdTxGovCreateMarket(t *testing.T) {
 			"[--accepting-orders]", "[--allow-user-settle]", "[--accepting-commitments]",
 			"[--access-grants <access grants>]",
 			"[--req-attr-ask <attrs>]", "[--req-attr-bid <attrs>]", "[--req-attr-commitment <attrs>]",
-			"[--bips <bips>]", "[--denom <denom>]",
+			"[--bips <bips>]", "[--denom <denom>]", "[--in-kind]",
 			"[--proposal <json filename>",
 			cli.AuthorityDesc, cli.RepeatableDesc, cli.AccessGrantsDesc, cli.FeeRatioDesc,
 			cli.ProposalFileDesc(&exchange.MsgGovCreateMarketRequest{}),
@@ -2297,7 +2297,7 @@ func TestSetupCmdTxGovCreateMarket(t *testing.T) {
 		cli.FlagSellerFlat, cli.FlagSellerRatios, cli.FlagBuyerFlat, cli.FlagBuyerRatios,
 		cli.FlagAcceptingOrders, cli.FlagAllowUserSettle, cli.FlagAcceptingCommitments, cli.FlagAccessGrants,
 		cli.FlagReqAttrAsk, cli.FlagReqAttrBid, cli.FlagReqAttrCommitment,
-		cli.FlagBips, cli.FlagDenom,
+		cli.FlagBips, cli.FlagDenom, cli.FlagInKind,
 		cli.FlagProposal,
 	}
 	oneReqVal := strings.Join(oneReqFlags, " ")
@@ -2354,11 +2354,12 @@ func TestMakeMsgGovCreateMarket(t *testing.T) {
 			ReqAttrCreateAsk: []string{"ask.create"},
 			ReqAttrCreateBid: []string{"bid.create"},
 
-			AcceptingCommitments:     true,
-			FeeCreateCommitmentFlat:  []sdk.Coin{sdk.NewInt64Coin("elderberry", 5)},
-			CommitmentSettlementBips: 84,
-			IntermediaryDenom:        "fig",
-			ReqAttrCreateCommitment:  []string{"commitment.create"},
+			AcceptingCommitments:          true,
+			FeeCreateCommitmentFlat:       []sdk.Coin{sdk.NewInt64Coin("elderberry", 5)},
+			CommitmentSettlementBips:      84,
+			IntermediaryDenom:             "fig",
+			ReqAttrCreateCommitment:       []string{"commitment.create"},
+			CommitmentSettlementFeeInKind: true,
 		},
 	}
 	prop := newGovProp(t, fileMsg)
@@ -2401,7 +2402,7 @@ func TestMakeMsgGovCreateMarket(t *testing.T) {
 				"--name", "Special market", "--description", "This market is special.",
 				"--url", "https://example.com", "--icon", "https://example.com/icon",
 				"--access-grants", "addr3:all",
-				"--bips", "47", "--denom", "raisin",
+				"--bips", "47", "--denom", "raisin", "--in-kind",
 			},
 			expMsg: &exchange.MsgGovCreateMarketRequest{
 				Authority: cli.AuthorityAddr.String(),
@@ -2442,11 +2443,12 @@ func TestMakeMsgGovCreateMarket(t *testing.T) {
 					ReqAttrCreateAsk: []string{"seller.kyc"},
 					ReqAttrCreateBid: []string{"buyer.kyc"},
 
-					AcceptingCommitments:     true,
-					FeeCreateCommitmentFlat:  []sdk.Coin{sdk.NewInt64Coin("honeydew", 7)},
-					CommitmentSettlementBips: 47,
-					IntermediaryDenom:        "raisin",
-					ReqAttrCreateCommitment:  []string{"com.kyc"},
+					AcceptingCommitments:          true,
+					FeeCreateCommitmentFlat:       []sdk.Coin{sdk.NewInt64Coin("honeydew", 7)},
+					CommitmentSettlementBips:      47,
+					IntermediaryDenom:             "raisin",
+					ReqAttrCreateCommitment:       []string{"com.kyc"},
+					CommitmentSettlementFeeInKind: true,
 				},
 			},
 		},
@@ -2481,6 +2483,8 @@ func TestMakeMsgGovCreateMarket(t *testing.T) {
 					CommitmentSettlementBips:  fileMsg.Market.CommitmentSettlementBips,
 					IntermediaryDenom:         fileMsg.Market.IntermediaryDenom,
 					ReqAttrCreateCommitment:   fileMsg.Market.ReqAttrCreateCommitment,
+
+					CommitmentSettlementFeeInKind: fileMsg.Market.CommitmentSettlementFeeInKind,
 				},
 			},
 		},
@@ -2503,6 +2507,7 @@ func TestSetupCmdTxGovManageFees(t *testing.T) {
 			cli.FlagSellerFlatAdd, cli.FlagSellerFlatRemove, cli.FlagSellerRatiosAdd, cli.FlagSellerRatiosRemove,
 			cli.FlagBuyerFlatAdd, cli.FlagBuyerFlatRemove, cli.FlagBuyerRatiosAdd, cli.FlagBuyerRatiosRemove,
 			cli.FlagCommitmentAdd, cli.FlagCommitmentRemove, cli.FlagBips, cli.FlagUnsetBips,
+			cli.FlagInKind, cli.FlagUnsetInKind,
 			cli.FlagProposal,
 		},
 		expAnnotations: map[string]map[string][]string{
@@ -2518,6 +2523,7 @@ func TestSetupCmdTxGovManageFees(t *testing.T) {
 			"[--buyer-flat-add <coins>]", "[--buyer-flat-remove <coins>]",
 			"[--buyer-ratios-add <fee ratios>]", "[--buyer-ratios-remove <fee ratios>]",
 			"[--bips <bips>]", "[--unset-bips]",
+			"[--in-kind]", "[--unset-in-kind]",
 			"[--proposal <json filename>",
 			cli.AuthorityDesc, cli.RepeatableDesc, cli.FeeRatioDesc,
 			cli.ProposalFileDesc(&exchange.MsgGovManageFeesRequest{}),
@@ -2529,6 +2535,7 @@ func TestSetupCmdTxGovManageFees(t *testing.T) {
 		cli.FlagSellerFlatAdd, cli.FlagSellerFlatRemove, cli.FlagSellerRatiosAdd, cli.FlagSellerRatiosRemove,
 		cli.FlagBuyerFlatAdd, cli.FlagBuyerFlatRemove, cli.FlagBuyerRatiosAdd, cli.FlagBuyerRatiosRemove,
 		cli.FlagCommitmentAdd, cli.FlagCommitmentRemove, cli.FlagBips, cli.FlagUnsetBips,
+		cli.FlagInKind, cli.FlagUnsetInKind,
 		cli.FlagProposal,
 	}
 	oneReqVal := strings.Join(oneReqFlags, " ")
@@ -2580,6 +2587,8 @@ func TestMakeMsgGovManageFees(t *testing.T) {
 		AddFeeCreateCommitmentFlat:     []sdk.Coin{sdk.NewInt64Coin("lemon", 13)},
 		RemoveFeeCreateCommitmentFlat:  []sdk.Coin{sdk.NewInt64Coin("lime", 14)},
 		SetFeeCommitmentSettlementBips: 15,
+
+		SetFeeCommitmentSettlementInKind: true,
 	}
 	prop := newGovProp(t, fileMsg)
 	tx := newTx(t, prop)
@@ -2613,7 +2622,7 @@ func TestMakeMsgGovManageFees(t *testing.T) {
 				"--buyer-flat-add", "59prune", "--buyer-flat-remove", "57prune",
 				"--buyer-ratios-add", "107prune:1prune", "--buyer-ratios-remove", "43prune:2prune",
 				"--commitment-add", "20lychee", "--commitment-remove", "21lingonberry",
-				"--bips", "87", "--unset-bips",
+				"--bips", "87", "--unset-bips", "--in-kind", "--unset-in-kind",
 			},
 			expMsg: &exchange.MsgGovManageFeesRequest{
 				Authority:                     cli.AuthorityAddr.String(),
@@ -2642,6 +2651,9 @@ func TestMakeMsgGovManageFees(t *testing.T) {
 				RemoveFeeCreateCommitmentFlat:    []sdk.Coin{sdk.NewInt64Coin("lingonberry", 21)},
 				SetFeeCommitmentSettlementBips:   87,
 				UnsetFeeCommitmentSettlementBips: true,
+
+				SetFeeCommitmentSettlementInKind:   true,
+				UnsetFeeCommitmentSettlementInKind: true,
 			},
 		},
 		{
@@ -2673,6 +2685,9 @@ func TestMakeMsgGovManageFees(t *testing.T) {
 				RemoveFeeCreateCommitmentFlat:    fileMsg.RemoveFeeCreateCommitmentFlat,
 				SetFeeCommitmentSettlementBips:   fileMsg.SetFeeCommitmentSettlementBips,
 				UnsetFeeCommitmentSettlementBips: fileMsg.UnsetFeeCommitmentSettlementBips,
+
+				SetFeeCommitmentSettlementInKind:   fileMsg.SetFeeCommitmentSettlementInKind,
+				UnsetFeeCommitmentSettlementInKind: fileMsg.UnsetFeeCommitmentSettlementInKind,
 			},
 			expErr: "",
 		},
@@ -0,0 +1,10 @@
+package exchange
+
+const (
+	// HoldReasonCodeOrder is the hold reason code for funds held for an order.
+	HoldReasonCodeOrder = "exchange_order"
+	// HoldReasonCodeCommitment is the hold reason code for funds committed to a market.
+	HoldReasonCodeCommitment = "exchange_commitment"
+	// HoldReasonCodePayment is the hold reason code for funds held for a payment.
+	HoldReasonCodePayment = "exchange_payment"
+)
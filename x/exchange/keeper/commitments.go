@@ -229,8 +229,8 @@ func (k Keeper) ReleaseAllCommitmentsForMarket(ctx sdk.Context, marketID uint32)
 	}
 
 	if len(errs) > 0 {
-		k.logErrorf(ctx, "%d error(s) encountered releasing all commitments for market %d:\n%v",
-			len(errs), marketID, errors.Join(errs...))
+		k.logError(ctx, "error(s) encountered releasing all commitments",
+			"market_id", marketID, "count", len(errs), "error", errors.Join(errs...))
 	}
 }
 
@@ -274,9 +274,22 @@ func (k Keeper) lookupNav(ctx sdk.Context, assetsDenom, priceDenom string, known
 	return k.GetNav(ctx, assetsDenom, priceDenom)
 }
 
+// canCollectInKind returns true if a commitment settlement fee can be collected in the given denom.
+// Restricted marker denoms cannot be sent to the fee collector, so they cannot be collected in kind.
+func (k Keeper) canCollectInKind(ctx sdk.Context, denom string) bool {
+	markerAddr, err := markertypes.MarkerAddress(denom)
+	if err != nil {
+		return false
+	}
+	marker, _ := k.markerKeeper.GetMarker(ctx, markerAddr)
+	return marker == nil || marker.GetMarkerType() != markertypes.MarkerType_RestrictedCoin
+}
+
 // CalculateCommitmentSettlementFee calculates the fee that the exchange must be paid (by the market) for the provided
 // commitment settlement request. If the market does not have a bips defined, an empty result is returned (without error).
 // If no inputs are given, the result will only have the ToFeeNav field (if it exists).
+// If the market collects the fee in kind, the InKindFees field has the fee for each input denom that can be
+// collected in kind, and the rest of the inputs are converted to get the ExchangeFees (in the fee denom).
 func (k Keeper) CalculateCommitmentSettlementFee(ctx sdk.Context, req *exchange.MsgMarketCommitmentSettleRequest) (*exchange.QueryCommitmentSettlementFeeCalcResponse, error) {
 	if req == nil {
 		return nil, errors.New("settlement request cannot be nil")
@@ -314,9 +327,25 @@ func (k Keeper) CalculateCommitmentSettlementFee(ctx sdk.Context, req *exchange.
 
 	rv.InputTotal = exchange.SumAccountAmounts(req.Inputs)
 
+	// Both the assets and price funds are in the inputs. So the sum of them is twice what
+	// we usually think of as the "value of a trade." As we apply the bips, we will divide
+	// by 20,000 (instead of 10,000) in order to account for that doubling.
+	toConvert := rv.InputTotal
+	if isCommitmentSettlementFeeInKind(store, req.MarketId) {
+		toConvert = nil
+		for _, coin := range rv.InputTotal {
+			if !k.canCollectInKind(ctx, coin.Denom) {
+				toConvert = toConvert.Add(coin)
+				continue
+			}
+			inKindAmt := exchange.QuoIntRoundUp(coin.Amount.MulRaw(int64(bips)), TwentyKInt)
+			rv.InKindFees = rv.InKindFees.Add(sdk.NewCoin(coin.Denom, inKindAmt))
+		}
+	}
+
 	var errs []error
 	convDecAmt := sdkmath.LegacyZeroDec()
-	for _, coin := range rv.InputTotal {
+	for _, coin := range toConvert {
 		switch coin.Denom {
 		case feeDenom:
 			rv.ConvertedTotal = rv.ConvertedTotal.Add(coin)
@@ -358,9 +387,7 @@ func (k Keeper) CalculateCommitmentSettlementFee(ctx sdk.Context, req *exchange.
 		}
 	}
 
-	// Both the assets and price funds are in the inputs. So the sum of them is twice what
-	// we usually think of as the "value of a trade." As we apply the bips, we will divide
-	// by 20,000 (instead of 10,000) in order to account for that doubling.
+	// Like with the in-kind fees, we divide by 20,000 to account for the doubling.
 	feeAmt := exchange.QuoIntRoundUp(feeDenomTotal.MulRaw(int64(bips)), TwentyKInt)
 	rv.ExchangeFees = sdk.NewCoins(sdk.NewCoin(feeDenom, feeAmt))
 
@@ -423,6 +450,20 @@ func (k Keeper) consumeCommitmentSettlementFee(ctx sdk.Context, req *exchange.Ms
 	if err != nil {
 		return fmt.Errorf("could not calculate commitment settlement fees: %w", err)
 	}
+	if !exchangeFees.InKindFees.IsZero() {
+		admin, err := sdk.AccAddressFromBech32(req.Admin)
+		if err != nil {
+			return fmt.Errorf("invalid admin %q: %w", req.Admin, err)
+		}
+		// The in-kind fees are a normal send so that the marker send restrictions are applied.
+		xFerCtx := markertypes.WithTransferAgents(ctx, admin)
+		marketAddr := exchange.GetMarketAddress(req.MarketId)
+		err = k.bankKeeper.SendCoinsFromAccountToModule(xFerCtx, marketAddr, k.feeCollectorName, exchangeFees.InKindFees)
+		if err != nil {
+			return fmt.Errorf("error collecting in-kind commitment settlement fee %s from market %d: %w",
+				exchangeFees.InKindFees, req.MarketId, err)
+		}
+	}
 	antewrapper.ConsumeMsgFee(ctx, exchangeFees.ExchangeFees, req, "")
 	return nil
 }
@@ -1344,6 +1344,7 @@ func (s *TestSuite) TestKeeper_CalculateCommitmentSettlementFee() {
 		name         string
 		setup        func()
 		markerKeeper *MockMarkerKeeper
+		expGetMarker []sdk.AccAddress
 		expGetNav    []*GetNetAssetValueArgs
 		req          *exchange.MsgMarketCommitmentSettleRequest
 		expResp      *exchange.QueryCommitmentSettlementFeeCalcResponse
@@ -1766,6 +1767,47 @@ func (s *TestSuite) TestKeeper_CalculateCommitmentSettlementFee() {
 				ToFeeNav: &exchange.NetAssetPrice{Assets: s.coin("10cherry"), Price: s.coin("31nhash")},
 			},
 		},
+		{
+			name: "in kind with a restricted denom",
+			setup: func() {
+				s.requireCreateMarket(exchange.Market{
+					MarketId:                      2,
+					CommitmentSettlementBips:      25,
+					IntermediaryDenom:             "cherry",
+					CommitmentSettlementFeeInKind: true,
+				})
+			},
+			markerKeeper: NewMockMarkerKeeper().
+				WithGetMarkerAccount(s.markerAccount("1000apple")).
+				WithGetNetAssetValueResult(s.coin("10cherry"), s.coin("31nhash")).
+				WithGetNetAssetValueResult(s.coin("21apple"), s.coin("500cherry")),
+			expGetMarker: []sdk.AccAddress{s.markerAddr("apple"), s.markerAddr("banana"), s.markerAddr("nhash")},
+			expGetNav: []*GetNetAssetValueArgs{
+				{markerDenom: "cherry", priceDenom: "nhash"},
+				{markerDenom: "apple", priceDenom: "cherry"},
+			},
+			req: &exchange.MsgMarketCommitmentSettleRequest{
+				MarketId: 2,
+				Inputs: []exchange.AccountAmount{
+					{Account: s.addr2.String(), Amount: s.coins("12apple,40banana")},
+					{Account: s.addr3.String(), Amount: s.coins("18apple,400nhash")},
+				},
+				Outputs: []exchange.AccountAmount{{Account: s.addr4.String(), Amount: s.coins("30apple,40banana,400nhash")}},
+			},
+			expResp: &exchange.QueryCommitmentSettlementFeeCalcResponse{
+				InputTotal: s.coins("30apple,40banana,400nhash"),
+				// apple is restricted, so it's converted: 30apple*500cherry/21apple = 714.285714285714 => 715cherry
+				ConvertedTotal: s.coins("715cherry"),
+				// 715cherry*31nhash/10cherry = 2216.5 => 2217nhash
+				// 2217nhash * 25/20000 = 2.77125 => 3nhash
+				ExchangeFees:   s.coins("3nhash"),
+				ConversionNavs: []exchange.NetAssetPrice{{Assets: s.coin("21apple"), Price: s.coin("500cherry")}},
+				ToFeeNav:       &exchange.NetAssetPrice{Assets: s.coin("10cherry"), Price: s.coin("31nhash")},
+				// 40banana * 25/20000 = 0.05 => 1banana
+				// 400nhash * 25/20000 = 0.5 => 1nhash
+				InKindFees: s.coins("1banana,1nhash"),
+			},
+		},
 	}
 
 	for _, tc := range tests {
@@ -1775,7 +1817,7 @@ func (s *TestSuite) TestKeeper_CalculateCommitmentSettlementFee() {
 				tc.setup()
 			}
 
-			expMarkerCalls := MarkerCalls{GetNetAssetValue: tc.expGetNav}
+			expMarkerCalls := MarkerCalls{GetMarker: tc.expGetMarker, GetNetAssetValue: tc.expGetNav}
 
 			if tc.markerKeeper == nil {
 				tc.markerKeeper = NewMockMarkerKeeper()
@@ -1793,6 +1835,7 @@ func (s *TestSuite) TestKeeper_CalculateCommitmentSettlementFee() {
 				s.Assert().Equal(tc.expResp.ExchangeFees.String(), resp.ExchangeFees.String(), "ExchangeFees")
 				s.Assert().Equal(tc.expResp.InputTotal.String(), resp.InputTotal.String(), "InputTotal")
 				s.Assert().Equal(tc.expResp.ConvertedTotal.String(), resp.ConvertedTotal.String(), "ConvertedTotal")
+				s.Assert().Equal(tc.expResp.InKindFees.String(), resp.InKindFees.String(), "InKindFees")
 				assertEqualSlice(s, tc.expResp.ConversionNavs, resp.ConversionNavs, exchange.NetAssetPrice.String, "ConversionNavs")
 				if !s.Assert().Equal(tc.expResp.ToFeeNav, resp.ToFeeNav, "ToFeeNav") && tc.expResp.ToFeeNav != nil && resp.ToFeeNav != nil {
 					s.Assert().Equal(tc.expResp.ToFeeNav.String(), resp.ToFeeNav.String(), "ToFeeNav strings")
@@ -123,6 +123,8 @@ var (
 	SetCommitmentSettlementBips = setCommitmentSettlementBips
 	// SetIntermediaryDenom is a test-only exposure of setIntermediaryDenom.
 	SetIntermediaryDenom = setIntermediaryDenom
+	// SetCommitmentSettlementFeeInKind is a test-only exposure of setCommitmentSettlementFeeInKind.
+	SetCommitmentSettlementFeeInKind = setCommitmentSettlementFeeInKind
 	// SetMarketAcceptingOrders is a test-only exposure of setMarketAcceptingOrders.
 	SetMarketAcceptingOrders = setMarketAcceptingOrders
 	// SetUserSettlementAllowed is a test-only exposure of setUserSettlementAllowed.
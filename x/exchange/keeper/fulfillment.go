@@ -340,8 +340,8 @@ func (k Keeper) recordNAVs(ctx sdk.Context, marketID uint32, navs []exchange.Net
 		isMetadataDenom := strings.HasPrefix(nav.Assets.Denom, metadatatypes.DenomPrefix)
 
 		if !nav.Assets.Amount.IsUint64() {
-			k.logErrorf(ctx, "could not record net-asset-value of %q at a price of %q: asset volume greater than max uint64",
-				nav.Assets, nav.Price)
+			k.logError(ctx, "could not record net-asset-value: asset volume greater than max uint64",
+				"denom", nav.Assets.Denom, "assets", nav.Assets.String(), "price", nav.Price.String())
 			if isMetadataDenom {
 				k.emitEvent(ctx, &metadatatypes.EventSetNetAssetValue{
 					ScopeId: strings.TrimPrefix(nav.Assets.Denom, metadatatypes.DenomPrefix),
@@ -385,13 +385,13 @@ func (k Keeper) recordNAVs(ctx sdk.Context, marketID uint32, navs []exchange.Net
 	for _, denom := range metadataDenoms {
 		scopeID, err := metadatatypes.MetadataAddressFromDenom(denom)
 		if err != nil {
-			k.logErrorf(ctx, "error getting metadata address: %v", err)
+			k.logError(ctx, "error getting metadata address", "denom", denom, "error", err)
 			k.emitMetadataNAVEvents(ctx, scopeID, metadataNAVs[denom], source)
 			continue
 		}
 		err = k.metadataKeeper.AddSetNetAssetValues(ctx, scopeID, metadataNAVs[denom], source)
 		if err != nil {
-			k.logErrorf(ctx, "error setting net-asset-values for %q: %v", denom, err)
+			k.logError(ctx, "error setting net-asset-values for scope", "denom", denom, "error", err)
 		}
 	}
 
@@ -399,25 +399,25 @@ func (k Keeper) recordNAVs(ctx sdk.Context, marketID uint32, navs []exchange.Net
 	for _, denom := range markerDenoms {
 		markerAddr, err := markertypes.MarkerAddress(denom)
 		if err != nil {
-			k.logErrorf(ctx, "error creating marker address for asset denom %q: %v", denom, err)
+			k.logError(ctx, "error creating marker address for asset denom", "denom", denom, "error", err)
 			k.emitMarkerNAVEvents(ctx, denom, markerNAVs[denom], source)
 			continue
 		}
 		marker, err := k.markerKeeper.GetMarker(ctx, markerAddr)
 		if err != nil {
-			k.logErrorf(ctx, "error getting asset marker %q: %v", denom, err)
+			k.logError(ctx, "error getting asset marker", "denom", denom, "error", err)
 			k.emitMarkerNAVEvents(ctx, denom, markerNAVs[denom], source)
 			continue
 		}
 		if marker == nil {
-			k.logInfof(ctx, "no marker found for asset denom %q", denom)
+			k.logInfo(ctx, "no marker found for asset denom", "denom", denom)
 			k.emitMarkerNAVEvents(ctx, denom, markerNAVs[denom], source)
 			continue
 		}
 
 		err = k.markerKeeper.AddSetNetAssetValues(ctx, marker, markerNAVs[denom], source)
 		if err != nil {
-			k.logErrorf(ctx, "error setting net-asset-values for marker %q: %v", denom, err)
+			k.logError(ctx, "error setting net-asset-values for marker", "denom", denom, "error", err)
 		}
 	}
 }
@@ -545,7 +545,7 @@ func (s *TestSuite) TestKeeper_FillBids() {
 			expMarkerCalls: MarkerCalls{
 				GetMarker: []sdk.AccAddress{appleMarker.GetAddress()},
 			},
-			expLog: []string{"ERR error getting asset marker \"apple\": just a dummy error module=x/exchange"},
+			expLog: []string{"ERR error getting asset marker error=\"just a dummy error\" denom=apple module=x/exchange"},
 		},
 		{
 			name: "one order: no fees, no asset marker",
@@ -576,7 +576,7 @@ func (s *TestSuite) TestKeeper_FillBids() {
 			expMarkerCalls: MarkerCalls{
 				GetMarker: []sdk.AccAddress{appleMarker.GetAddress()},
 			},
-			expLog: []string{"INF no marker found for asset denom \"apple\" module=x/exchange"},
+			expLog: []string{"INF no marker found for asset denom denom=apple module=x/exchange"},
 		},
 		{
 			name:         "one order: no fees, very large amount",
@@ -606,8 +606,8 @@ func (s *TestSuite) TestKeeper_FillBids() {
 				},
 			},
 			expLog: []string{
-				"ERR could not record net-asset-value of \"184467440737095516150apple\" at a " +
-					"price of \"60plum\": asset volume greater than max uint64 module=x/exchange",
+				"ERR could not record net-asset-value: asset volume greater than max uint64 " +
+					"assets=184467440737095516150apple denom=apple module=x/exchange price=60plum",
 			},
 		},
 		{
@@ -648,7 +648,7 @@ func (s *TestSuite) TestKeeper_FillBids() {
 					},
 				},
 			},
-			expLog: []string{"ERR error setting net-asset-values for marker \"apple\": oh no, it is an error module=x/exchange"},
+			expLog: []string{"ERR error setting net-asset-values for marker error=\"oh no, it is an error\" denom=apple module=x/exchange"},
 		},
 		{
 			name:         "one order: all the fees",
@@ -1408,7 +1408,7 @@ func (s *TestSuite) TestKeeper_FillAsks() {
 			expMarkerCalls: MarkerCalls{
 				GetMarker: []sdk.AccAddress{appleMarker.GetAddress()},
 			},
-			expLog: []string{"ERR error getting asset marker \"apple\": uncomfortable marker error module=x/exchange"},
+			expLog: []string{"ERR error getting asset marker error=\"uncomfortable marker error\" denom=apple module=x/exchange"},
 		},
 		{
 			name: "one order: no fees, no asset marker",
@@ -1439,7 +1439,7 @@ func (s *TestSuite) TestKeeper_FillAsks() {
 			expMarkerCalls: MarkerCalls{
 				GetMarker: []sdk.AccAddress{appleMarker.GetAddress()},
 			},
-			expLog: []string{"INF no marker found for asset denom \"apple\" module=x/exchange"},
+			expLog: []string{"INF no marker found for asset denom denom=apple module=x/exchange"},
 		},
 		{
 			name: "one order: no fees, very large amount",
@@ -1468,8 +1468,8 @@ func (s *TestSuite) TestKeeper_FillAsks() {
 				},
 			},
 			expLog: []string{
-				"ERR could not record net-asset-value of \"184467440737095516150apple\" at a " +
-					"price of \"60plum\": asset volume greater than max uint64 module=x/exchange",
+				"ERR could not record net-asset-value: asset volume greater than max uint64 " +
+					"assets=184467440737095516150apple denom=apple module=x/exchange price=60plum",
 			},
 		},
 		{
@@ -1510,7 +1510,7 @@ func (s *TestSuite) TestKeeper_FillAsks() {
 					},
 				},
 			},
-			expLog: []string{"ERR error setting net-asset-values for marker \"apple\": nav error, an error from nav module=x/exchange"},
+			expLog: []string{"ERR error setting net-asset-values for marker error=\"nav error, an error from nav\" denom=apple module=x/exchange"},
 		},
 		{
 			name:         "one order: all the fees",
@@ -2118,7 +2118,7 @@ func (s *TestSuite) TestKeeper_SettleOrders() {
 			expMarkerCalls: MarkerCalls{
 				GetMarker: []sdk.AccAddress{appleMarker.GetAddress()},
 			},
-			expLog: []string{"ERR error getting asset marker \"apple\": sample apple error module=x/exchange"},
+			expLog: []string{"ERR error getting asset marker error=\"sample apple error\" denom=apple module=x/exchange"},
 		},
 		{
 			name: "one ask one bid: both full, no fees, no asset marker",
@@ -2157,7 +2157,7 @@ func (s *TestSuite) TestKeeper_SettleOrders() {
 			expMarkerCalls: MarkerCalls{
 				GetMarker: []sdk.AccAddress{appleMarker.GetAddress()},
 			},
-			expLog: []string{"INF no marker found for asset denom \"apple\" module=x/exchange"},
+			expLog: []string{"INF no marker found for asset denom denom=apple module=x/exchange"},
 		},
 		{
 			name:         "one ask one bid: both full, no fees, very large amount",
@@ -2194,7 +2194,7 @@ func (s *TestSuite) TestKeeper_SettleOrders() {
 					{ctxHasQuarantineBypass: true, fromAddr: s.addr4, toAddr: s.addr3, amt: s.coins("5peach")},
 				},
 			},
-			expLog: []string{"ERR could not record net-asset-value of \"184467440737095516150apple\" at a price of \"5peach\": asset volume greater than max uint64 module=x/exchange"},
+			expLog: []string{"ERR could not record net-asset-value: asset volume greater than max uint64 assets=184467440737095516150apple denom=apple module=x/exchange price=5peach"},
 		},
 		{
 			name: "one ask one bid: both full, no fees, error setting nav",
@@ -2242,7 +2242,7 @@ func (s *TestSuite) TestKeeper_SettleOrders() {
 					},
 				},
 			},
-			expLog: []string{"ERR error setting net-asset-values for marker \"apple\": this error is fake module=x/exchange"},
+			expLog: []string{"ERR error setting net-asset-values for marker error=\"this error is fake\" denom=apple module=x/exchange"},
 		},
 		{
 			name:         "one ask one bid: both full, all the fees",
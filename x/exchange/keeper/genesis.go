@@ -102,7 +102,7 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *exchange.GenesisState {
 		return false
 	})
 	if err != nil {
-		k.logErrorf(ctx, "error (ignored) while reading orders: %v", err)
+		k.logError(ctx, "error (ignored) while reading orders", "error", err)
 	}
 
 	k.IterateCommitments(ctx, func(commitment exchange.Commitment) bool {
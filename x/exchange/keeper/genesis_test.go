@@ -453,8 +453,9 @@ func (s *TestSuite) TestKeeper_InitAndExportGenesis() {
 				Orders:      []exchange.Order{askOrder(1, 1, ""), bidOrder(4, 1, "")},
 				LastOrderId: 4,
 			},
-			expExportLog: "ERR error (ignored) while reading orders: failed to read order 2: unknown type byte 0x8\n" +
-				"failed to read order 3: unknown type byte 0x8 module=x/exchange\n",
+			expExportLog: "ERR error (ignored) while reading orders " +
+				"error=\"failed to read order 2: unknown type byte 0x8\\nfailed to read order 3: unknown type byte 0x8\" " +
+				"module=x/exchange\n",
 		},
 		{
 			name:       "one commitment",
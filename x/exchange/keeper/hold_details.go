@@ -0,0 +1,61 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/exchange"
+	"github.com/provenance-io/provenance/x/hold"
+)
+
+// GetHoldDetails gets the details of the holds that the exchange module has placed on an account's funds
+// for its orders, commitments, and payments. It satisfies the hold.HoldDetailsGetter type.
+func (k Keeper) GetHoldDetails(ctx sdk.Context, addr sdk.AccAddress) ([]hold.HoldDetail, error) {
+	var rv []hold.HoldDetail
+	var errs []error
+
+	store := k.getStore(ctx)
+	k.IterateAddressOrders(ctx, addr, func(orderID uint64, _ byte) bool {
+		order, err := k.getOrderFromStore(store, orderID)
+		if err != nil {
+			errs = append(errs, err)
+			return false
+		}
+		if order == nil {
+			return false
+		}
+		rv = append(rv, hold.NewHoldDetail(exchange.HoldReasonCodeOrder, exchange.ModuleName,
+			exchange.GetMarketAddress(order.GetMarketID()).String(), fmt.Sprintf("order %d", orderID),
+			order.GetHoldAmount(), "released when the order is filled or cancelled"))
+		return false
+	})
+
+	k.IterateKnownMarketIDs(ctx, func(marketID uint32) bool {
+		amount := getCommitmentAmount(store, marketID, addr)
+		if !amount.IsZero() {
+			rv = append(rv, hold.NewHoldDetail(exchange.HoldReasonCodeCommitment, exchange.ModuleName,
+				exchange.GetMarketAddress(marketID).String(), fmt.Sprintf("market %d", marketID),
+				amount, "released when the market settles or releases the commitment"))
+		}
+		return false
+	})
+
+	k.iterate(ctx, GetKeyPrefixPaymentsForSource(addr), func(_, value []byte) bool {
+		payment, err := k.parsePaymentStoreValue(value)
+		if err != nil {
+			errs = append(errs, err)
+			return false
+		}
+		if payment == nil || payment.SourceAmount.IsZero() {
+			return false
+		}
+		rv = append(rv, hold.NewHoldDetail(exchange.HoldReasonCodePayment, exchange.ModuleName,
+			payment.Target, fmt.Sprintf("payment %q", payment.ExternalId),
+			payment.SourceAmount, "released when the payment is accepted, rejected, or cancelled"))
+		return false
+	})
+
+	return rv, errors.Join(errs...)
+}
@@ -0,0 +1,50 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/exchange"
+	"github.com/provenance-io/provenance/x/exchange/keeper"
+	"github.com/provenance-io/provenance/x/hold"
+)
+
+func (s *TestSuite) TestKeeper_GetHoldDetails() {
+	s.clearExchangeState()
+	defer s.clearExchangeState()
+
+	store := s.getStore()
+	s.requireSetOrderInStore(store, exchange.NewOrder(1).WithAsk(&exchange.AskOrder{
+		MarketId: 3,
+		Seller:   s.addr1.String(),
+		Assets:   s.coin("10apple"),
+		Price:    s.coin("80prune"),
+	}))
+	s.requireSetOrderInStore(store, exchange.NewOrder(2).WithBid(&exchange.BidOrder{
+		MarketId: 3,
+		Buyer:    s.addr2.String(),
+		Assets:   s.coin("10apple"),
+		Price:    s.coin("80prune"),
+	}))
+	keeper.SetMarketKnown(store, 3)
+	keeper.SetMarketKnown(store, 5)
+	keeper.SetCommitmentAmount(store, 5, s.addr1, s.coins("7acorn"))
+	keeper.SetCommitmentAmount(store, 5, s.addr2, s.coins("9acorn"))
+	payment := s.newTestPayment(s.addr1, "12strawberry", s.addr3, "55tangerine", "buddy")
+	s.requireSetPaymentsInStore(payment, s.newTestPayment(s.addr1, "", s.addr3, "5tangerine", "nothing held"))
+
+	expected := []hold.HoldDetail{
+		hold.NewHoldDetail(exchange.HoldReasonCodeOrder, exchange.ModuleName, exchange.GetMarketAddress(3).String(),
+			"order 1", s.coins("10apple"), "released when the order is filled or cancelled"),
+		hold.NewHoldDetail(exchange.HoldReasonCodeCommitment, exchange.ModuleName, exchange.GetMarketAddress(5).String(),
+			"market 5", s.coins("7acorn"), "released when the market settles or releases the commitment"),
+		hold.NewHoldDetail(exchange.HoldReasonCodePayment, exchange.ModuleName, s.addr3.String(),
+			`payment "buddy"`, s.coins("12strawberry"), "released when the payment is accepted, rejected, or cancelled"),
+	}
+	actual, err := s.k.GetHoldDetails(s.ctx, s.addr1)
+	s.Require().NoError(err, "GetHoldDetails(addr1)")
+	s.Assert().Equal(expected, actual, "GetHoldDetails(addr1)")
+
+	actual, err = s.k.GetHoldDetails(s.ctx, sdk.AccAddress("no_holds_here_______"))
+	s.Require().NoError(err, "GetHoldDetails(no holds)")
+	s.Assert().Empty(actual, "GetHoldDetails(no holds)")
+}
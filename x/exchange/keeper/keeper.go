@@ -16,6 +16,7 @@ import (
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/cosmos/gogoproto/proto"
 
+	"github.com/provenance-io/provenance/internal/provutils"
 	"github.com/provenance-io/provenance/x/exchange"
 	"github.com/provenance-io/provenance/x/quarantine"
 )
@@ -65,7 +66,7 @@ func NewKeeper(cdc codec.BinaryCodec, storeKey storetypes.StoreKey, feeCollector
 
 // getLogger gets a logger for the exchange module.
 func (k Keeper) getLogger(ctx sdk.Context) log.Logger {
-	return ctx.Logger().With("module", "x/"+exchange.ModuleName)
+	return provutils.ModuleLogger(ctx, exchange.ModuleName)
 }
 
 // logEndpointError logs an error for an endpoint.
@@ -74,16 +75,14 @@ func (k Keeper) logEndpointError(ctx sdk.Context, endpoint, msg string, keyVals
 	k.getLogger(ctx).With("endpoint", endpoint).Error(msg, keyVals...)
 }
 
-// logErrorf uses fmt.Sprintf to combine the msg and args, and logs the result as an error from this module.
-// Note that this is different from the logging .Error(msg string, keyvals ...interface{}) syntax.
-func (k Keeper) logErrorf(ctx sdk.Context, msg string, args ...interface{}) {
-	k.getLogger(ctx).Error(fmt.Sprintf(msg, args...))
+// logError logs an error from this module using the standard key+val logging argument pattern.
+func (k Keeper) logError(ctx sdk.Context, msg string, keyVals ...interface{}) {
+	k.getLogger(ctx).Error(msg, keyVals...)
 }
 
-// logInfof uses fmt.Sprintf to combine the msg and args, and logs the result as info from this module.
-// Note that this is different from the logging .Info(msg string, keyvals ...interface{}) syntax.
-func (k Keeper) logInfof(ctx sdk.Context, msg string, args ...interface{}) {
-	k.getLogger(ctx).Info(fmt.Sprintf(msg, args...))
+// logInfo logs info from this module using the standard key+val logging argument pattern.
+func (k Keeper) logInfo(ctx sdk.Context, msg string, keyVals ...interface{}) {
+	k.getLogger(ctx).Info(msg, keyVals...)
 }
 
 // emitEvent emits the provided event and writes any error to the error log.
@@ -91,7 +90,7 @@ func (k Keeper) logInfof(ctx sdk.Context, msg string, args ...interface{}) {
 func (k Keeper) emitEvent(ctx sdk.Context, event proto.Message) {
 	err := ctx.EventManager().EmitTypedEvent(event)
 	if err != nil {
-		k.logErrorf(ctx, "error emitting event %#v: %v", event, err)
+		k.logError(ctx, "error emitting event", "event", event, "error", err)
 	}
 }
 
@@ -102,7 +101,7 @@ func (k Keeper) emitEvent(ctx sdk.Context, event proto.Message) {
 func (k Keeper) emitEvents(ctx sdk.Context, events []proto.Message) {
 	err := ctx.EventManager().EmitTypedEvents(events...)
 	if err != nil {
-		k.logErrorf(ctx, "error emitting events %#v: %v", events, err)
+		k.logError(ctx, "error emitting events", "events", events, "error", err)
 	}
 }
 
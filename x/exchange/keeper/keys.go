@@ -53,6 +53,7 @@ import (
 //   Market Create-Commitment Flat Fee: 0x01 | <market_id> | 0x11 | <denom> => <amount> (string)
 //   Market Commitment Settlement Bips: 0x01 | <market_id> | 0x12 => uint16
 //   Market Intermediary Denom: 0x01 | <market_id> | 0x13 => <denom>
+//   Market commitment settlement fee in kind indicator: 0x01 | <market_id> | 0x14 => nil
 //
 //   The <permission_type_byte> is a single byte as uint8 with the same values as the enum entries.
 //   The <req_attr_type_byte> is either an order type byte or 0x63 (= 'c' for commitments).
@@ -144,6 +145,8 @@ const (
 	MarketKeyTypeCommitmentSettlementBips = byte(0x12)
 	// MarketKeyTypeIntermediaryDenom is the market-specific type byte for the intermediary denom used in fee calcs.
 	MarketKeyTypeIntermediaryDenom = byte(0x13)
+	// MarketKeyTypeCommitmentSettlementFeeInKind is the market-specific type byte for the commitment settlement fee in kind indicators.
+	MarketKeyTypeCommitmentSettlementFeeInKind = byte(0x14)
 
 	// OrderKeyTypeAsk is the order-specific type byte for ask orders.
 	OrderKeyTypeAsk = exchange.OrderTypeByteAsk
@@ -613,6 +616,12 @@ func MakeKeyMarketIntermediaryDenom(marketID uint32) []byte {
 	return keyPrefixMarketType(marketID, MarketKeyTypeIntermediaryDenom, 0)
 }
 
+// MakeKeyMarketCommitmentSettlementFeeInKind creates the key to use to indicate that a market's commitment
+// settlement fee is collected in kind.
+func MakeKeyMarketCommitmentSettlementFeeInKind(marketID uint32) []byte {
+	return keyPrefixMarketType(marketID, MarketKeyTypeCommitmentSettlementFeeInKind, 0)
+}
+
 // keyPrefixOrder creates the key prefix for orders with the provided extra capacity for additional elements.
 func keyPrefixOrder(extraCap int) []byte {
 	return prepKey(KeyTypeOrder, nil, extraCap)
@@ -704,6 +704,34 @@ func setIntermediaryDenom(store storetypes.KVStore, marketID uint32, denom strin
 	}
 }
 
+// isCommitmentSettlementFeeInKind gets whether the market's commitment settlement fee is collected in kind.
+func isCommitmentSettlementFeeInKind(store storetypes.KVStore, marketID uint32) bool {
+	key := MakeKeyMarketCommitmentSettlementFeeInKind(marketID)
+	return store.Has(key)
+}
+
+// setCommitmentSettlementFeeInKind sets whether the market's commitment settlement fee is collected in kind.
+func setCommitmentSettlementFeeInKind(store storetypes.KVStore, marketID uint32, inKind bool) {
+	key := MakeKeyMarketCommitmentSettlementFeeInKind(marketID)
+	if inKind {
+		store.Set(key, []byte{})
+	} else {
+		store.Delete(key)
+	}
+}
+
+// updateCommitmentSettlementFeeInKind updates whether the market's commitment settlement fee is collected in kind.
+// If unset is true, the indicator is deleted. If set is true, the indicator is set.
+// If both are false, this does nothing.
+func updateCommitmentSettlementFeeInKind(store storetypes.KVStore, marketID uint32, set, unset bool) {
+	if unset {
+		setCommitmentSettlementFeeInKind(store, marketID, false)
+	}
+	if set {
+		setCommitmentSettlementFeeInKind(store, marketID, true)
+	}
+}
+
 // GetCreateAskFlatFees gets the create-ask flat fee options for a market.
 func (k Keeper) GetCreateAskFlatFees(ctx sdk.Context, marketID uint32) []sdk.Coin {
 	return getCreateAskFlatFees(k.getStore(ctx), marketID)
@@ -744,6 +772,11 @@ func (k Keeper) GetCommitmentSettlementBips(ctx sdk.Context, marketID uint32) ui
 	return getCommitmentSettlementBips(k.getStore(ctx), marketID)
 }
 
+// IsCommitmentSettlementFeeInKind returns true if the market's commitment settlement fee is collected in kind.
+func (k Keeper) IsCommitmentSettlementFeeInKind(ctx sdk.Context, marketID uint32) bool {
+	return isCommitmentSettlementFeeInKind(k.getStore(ctx), marketID)
+}
+
 // GetIntermediaryDenom gets a market's intermediary denom.
 func (k Keeper) GetIntermediaryDenom(ctx sdk.Context, marketID uint32) string {
 	return getIntermediaryDenom(k.getStore(ctx), marketID)
@@ -801,6 +834,7 @@ func (k Keeper) UpdateFees(ctx sdk.Context, msg *exchange.MsgGovManageFeesReques
 	updateBuyerSettlementFlatFees(store, msg.MarketId, msg.RemoveFeeBuyerSettlementFlat, msg.AddFeeBuyerSettlementFlat)
 	updateBuyerSettlementRatios(store, msg.MarketId, msg.RemoveFeeBuyerSettlementRatios, msg.AddFeeBuyerSettlementRatios)
 	updateCommitmentSettlementBips(store, msg.MarketId, msg.SetFeeCommitmentSettlementBips, msg.UnsetFeeCommitmentSettlementBips)
+	updateCommitmentSettlementFeeInKind(store, msg.MarketId, msg.SetFeeCommitmentSettlementInKind, msg.UnsetFeeCommitmentSettlementInKind)
 
 	k.emitEvent(ctx, exchange.NewEventMarketFeesUpdated(msg.MarketId))
 }
@@ -1414,6 +1448,7 @@ func storeMarket(store storetypes.KVStore, market exchange.Market) {
 	setMarketAcceptingCommitments(store, marketID, market.AcceptingCommitments)
 	setCommitmentSettlementBips(store, marketID, market.CommitmentSettlementBips)
 	setIntermediaryDenom(store, marketID, market.IntermediaryDenom)
+	setCommitmentSettlementFeeInKind(store, marketID, market.CommitmentSettlementFeeInKind)
 }
 
 // initMarket is similar to CreateMarket but assumes the market has already been
@@ -1506,6 +1541,7 @@ func (k Keeper) GetMarket(ctx sdk.Context, marketID uint32) *exchange.Market {
 	market.AcceptingCommitments = isMarketAcceptingCommitments(store, marketID)
 	market.CommitmentSettlementBips = getCommitmentSettlementBips(store, marketID)
 	market.IntermediaryDenom = getIntermediaryDenom(store, marketID)
+	market.CommitmentSettlementFeeInKind = isCommitmentSettlementFeeInKind(store, marketID)
 
 	if marketAcc := k.GetMarketAccount(ctx, marketID); marketAcc != nil {
 		market.MarketDetails = marketAcc.MarketDetails
@@ -2723,6 +2723,7 @@ func (s *TestSuite) TestKeeper_UpdateFees() {
 		buyerFlat   string
 		buyerRatio  string
 		comBips     string
+		comInKind   bool
 	}
 	getMarketFees := func(marketID uint32) marketFees {
 		rv := marketFees{
@@ -2734,6 +2735,7 @@ func (s *TestSuite) TestKeeper_UpdateFees() {
 			sellerRatio: exchange.FeeRatiosString(s.k.GetSellerSettlementRatios(s.ctx, marketID)),
 			buyerFlat:   sdk.Coins(s.k.GetBuyerSettlementFlatFees(s.ctx, marketID)).String(),
 			buyerRatio:  exchange.FeeRatiosString(s.k.GetBuyerSettlementRatios(s.ctx, marketID)),
+			comInKind:   s.k.IsCommitmentSettlementFeeInKind(s.ctx, marketID),
 		}
 		bips := s.k.GetCommitmentSettlementBips(s.ctx, marketID)
 		if bips != 0 {
@@ -3597,6 +3599,34 @@ func (s *TestSuite) TestKeeper_UpdateFees() {
 			expNoChange: []uint32{1, 3},
 		},
 
+		// only commitment settlement fee in kind
+		{
+			name: "in kind: setting",
+			setup: func() {
+				keeper.SetCommitmentSettlementFeeInKind(s.getStore(), 1, true)
+			},
+			msg: &exchange.MsgGovManageFeesRequest{
+				MarketId:                         2,
+				SetFeeCommitmentSettlementInKind: true,
+			},
+			expFees:     marketFees{marketID: 2, comInKind: true},
+			expNoChange: []uint32{1, 3},
+		},
+		{
+			name: "in kind: unsetting",
+			setup: func() {
+				store := s.getStore()
+				keeper.SetCommitmentSettlementFeeInKind(store, 1, true)
+				keeper.SetCommitmentSettlementFeeInKind(store, 2, true)
+			},
+			msg: &exchange.MsgGovManageFeesRequest{
+				MarketId:                           2,
+				UnsetFeeCommitmentSettlementInKind: true,
+			},
+			expFees:     marketFees{marketID: 2},
+			expNoChange: []uint32{1, 3},
+		},
+
 		// combo
 		{
 			name: "a little bit of everything",
@@ -833,7 +833,7 @@ func (k Keeper) CancelAllOrdersForMarket(ctx sdk.Context, marketID uint32, signe
 	}
 
 	if len(errs) > 0 {
-		k.logErrorf(ctx, "%d error(s) encountered canceling all orders for market %d:\n%v",
-			len(errs), marketID, errors.Join(errs...))
+		k.logError(ctx, "error(s) encountered canceling all orders",
+			"market_id", marketID, "count", len(errs), "error", errors.Join(errs...))
 	}
 }
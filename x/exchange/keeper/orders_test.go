@@ -3064,9 +3064,10 @@ func (s *TestSuite) TestKeeper_CancelAllOrdersForMarket() {
 			marketID: 3,
 			signer:   s.addr2.String(),
 			expLog: []string{
-				"ERR 2 error(s) encountered canceling all orders for market 3:",
-				"account " + s.addr2.String() + " does not have permission to cancel order 3",
-				"account " + s.addr2.String() + " does not have permission to cancel order 33 module=x/exchange",
+				"ERR error(s) encountered canceling all orders " +
+					"error=\"account " + s.addr2.String() + " does not have permission to cancel order 3\\n" +
+					"account " + s.addr2.String() + " does not have permission to cancel order 33\" " +
+					"count=2 market_id=3 module=x/exchange",
 			},
 		},
 		{
@@ -3122,9 +3123,10 @@ func (s *TestSuite) TestKeeper_CancelAllOrdersForMarket() {
 			marketID:   3,
 			signer:     s.k.GetAuthority(),
 			expLog: []string{
-				"ERR 2 error(s) encountered canceling all orders for market 3:",
-				"unable to release hold on order 4 funds: injected error for 4",
-				"unable to release hold on order 16 funds: injected error for 16 module=x/exchange",
+				"ERR error(s) encountered canceling all orders " +
+					"error=\"unable to release hold on order 4 funds: injected error for 4\\n" +
+					"unable to release hold on order 16 funds: injected error for 16\" " +
+					"count=2 market_id=3 module=x/exchange",
 			},
 			expHoldCalls: &HoldCalls{
 				ReleaseHold: []*ReleaseHoldArgs{
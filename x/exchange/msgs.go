@@ -713,6 +713,9 @@ func (m MsgGovManageFeesRequest) ValidateBasic() error {
 				"invalid commitment settlement bips %d: must be zero when unset_fee_commitment_settlement_bips is true",
 				m.SetFeeCommitmentSettlementBips))
 		}
+		if m.SetFeeCommitmentSettlementInKind && m.UnsetFeeCommitmentSettlementInKind {
+			errs = append(errs, errors.New("cannot both set and unset the commitment settlement fee in kind"))
+		}
 	} else {
 		errs = append(errs, errors.New("no updates"))
 	}
@@ -729,7 +732,8 @@ func (m MsgGovManageFeesRequest) HasUpdates() bool {
 		len(m.AddFeeBuyerSettlementFlat) > 0 || len(m.RemoveFeeBuyerSettlementFlat) > 0 ||
 		len(m.AddFeeBuyerSettlementRatios) > 0 || len(m.RemoveFeeBuyerSettlementRatios) > 0 ||
 		len(m.AddFeeCreateCommitmentFlat) > 0 || len(m.RemoveFeeCreateCommitmentFlat) > 0 ||
-		m.SetFeeCommitmentSettlementBips != 0 || m.UnsetFeeCommitmentSettlementBips
+		m.SetFeeCommitmentSettlementBips != 0 || m.UnsetFeeCommitmentSettlementBips ||
+		m.SetFeeCommitmentSettlementInKind || m.UnsetFeeCommitmentSettlementInKind
 }
 
 func (m MsgGovCloseMarketRequest) ValidateBasic() error {
@@ -3436,6 +3436,15 @@ func TestMsgGovManageFeesRequest_ValidateBasic(t *testing.T) {
 			},
 			expErr: []string{"invalid commitment settlement bips 1: must be zero when unset_fee_commitment_settlement_bips is true"},
 		},
+		{
+			name: "set and unset fee commitment settlement in kind",
+			msg: MsgGovManageFeesRequest{
+				Authority:                          authority,
+				SetFeeCommitmentSettlementInKind:   true,
+				UnsetFeeCommitmentSettlementInKind: true,
+			},
+			expErr: []string{"cannot both set and unset the commitment settlement fee in kind"},
+		},
 		{
 			name: "multiple errors",
 			msg: MsgGovManageFeesRequest{
@@ -3588,6 +3597,16 @@ func TestMsgGovManageFeesRequest_HasUpdates(t *testing.T) {
 			msg:  MsgGovManageFeesRequest{UnsetFeeCommitmentSettlementBips: true},
 			exp:  true,
 		},
+		{
+			name: "set fee commitment settlement in kind",
+			msg:  MsgGovManageFeesRequest{SetFeeCommitmentSettlementInKind: true},
+			exp:  true,
+		},
+		{
+			name: "unset fee commitment settlement in kind",
+			msg:  MsgGovManageFeesRequest{UnsetFeeCommitmentSettlementInKind: true},
+			exp:  true,
+		},
 	}
 
 	for _, tc := range tests {
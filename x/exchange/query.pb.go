@@ -1595,7 +1595,7 @@ func (m *QueryCommitmentSettlementFeeCalcRequest) GetIncludeBreakdownFields() bo
 
 // QueryCommitmentSettlementFeeCalcResponse is a response message for the CommitmentSettlementFeeCalc query.
 type QueryCommitmentSettlementFeeCalcResponse struct {
-	// exchange_fees is the total that the exchange would currently pay for the provided settlement.
+	// exchange_fees is the total (in the fee denom) that the exchange would currently pay for the provided settlement.
 	ExchangeFees github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=exchange_fees,json=exchangeFees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"exchange_fees"`
 	// input_total is the sum of all the inputs in the provided settlement.
 	InputTotal github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=input_total,json=inputTotal,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"input_total"`
@@ -1605,6 +1605,10 @@ type QueryCommitmentSettlementFeeCalcResponse struct {
 	ConversionNavs []NetAssetPrice `protobuf:"bytes,4,rep,name=conversion_navs,json=conversionNavs,proto3" json:"conversion_navs"`
 	// to_fee_nav is the NAV used to convert the converted_total into the fee denom.
 	ToFeeNav *NetAssetPrice `protobuf:"bytes,5,opt,name=to_fee_nav,json=toFeeNav,proto3" json:"to_fee_nav,omitempty"`
+	// in_kind_fees is the part of the fee that would be collected in kind from the market's account.
+	// It is only used when the market's commitment_settlement_fee_in_kind is true. The exchange_fees are the
+	// rest of the fee (in the fee denom) for the inputs that could not be collected in kind.
+	InKindFees github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=in_kind_fees,json=inKindFees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"in_kind_fees"`
 }
 
 func (m *QueryCommitmentSettlementFeeCalcResponse) Reset() {
@@ -1677,6 +1681,13 @@ func (m *QueryCommitmentSettlementFeeCalcResponse) GetToFeeNav() *NetAssetPrice
 	return nil
 }
 
+func (m *QueryCommitmentSettlementFeeCalcResponse) GetInKindFees() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.InKindFees
+	}
+	return nil
+}
+
 // QueryValidateCreateMarketRequest is a request message for the ValidateCreateMarket query.
 type QueryValidateCreateMarketRequest struct {
 	// create_market_request is the request to run validation on.
@@ -2557,158 +2568,158 @@ func init() {
 
 var fileDescriptor_00949b75b1c10bfe = []byte{
 	// 2424 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5a, 0xcb, 0x6f, 0xdc, 0xd6,
-	0xf5, 0xf6, 0x95, 0x2d, 0x59, 0x3a, 0xb6, 0x65, 0xf8, 0x5a, 0xf6, 0x6f, 0x34, 0xb6, 0x47, 0x32,
-	0xfd, 0x12, 0x64, 0x7b, 0x68, 0x49, 0xb6, 0x22, 0xe7, 0x07, 0xd7, 0x91, 0x94, 0x4a, 0x30, 0xd0,
-	0xd8, 0xca, 0x58, 0xa8, 0x03, 0x01, 0xed, 0x84, 0xe2, 0x5c, 0x8d, 0x09, 0x71, 0xc8, 0x09, 0x49,
-	0x8d, 0x25, 0x08, 0x02, 0xda, 0xf4, 0x11, 0x24, 0x8b, 0xa2, 0x40, 0x17, 0x4d, 0x1b, 0x34, 0x5d,
-	0xb8, 0x68, 0x8b, 0x6c, 0xe2, 0x45, 0xbb, 0x2a, 0x8a, 0x2c, 0xba, 0xa8, 0x37, 0x05, 0x82, 0x76,
-	0xd3, 0x02, 0x45, 0x1b, 0xd8, 0x05, 0xb2, 0x69, 0xff, 0x85, 0xa2, 0xe0, 0xbd, 0x87, 0x43, 0x72,
-	0x86, 0xaf, 0x51, 0x26, 0x86, 0x36, 0x1e, 0x91, 0x3c, 0xe7, 0x9e, 0xef, 0x7c, 0xf7, 0x71, 0xee,
-	0xfd, 0xae, 0x41, 0xaa, 0x5b, 0x66, 0x83, 0x19, 0x8a, 0xa1, 0x32, 0x99, 0x6d, 0xaa, 0x0f, 0x15,
-	0xa3, 0xca, 0xe4, 0xc6, 0x84, 0xfc, 0xd6, 0x06, 0xb3, 0xb6, 0x8a, 0x75, 0xcb, 0x74, 0x4c, 0x7a,
-	0xd2, 0xb7, 0x29, 0x7a, 0x36, 0xc5, 0xc6, 0x44, 0xfe, 0x98, 0x52, 0xd3, 0x0c, 0x53, 0xe6, 0xff,
-	0x0a, 0xd3, 0xfc, 0xb0, 0x6a, 0xda, 0x35, 0xd3, 0x2e, 0xf3, 0x27, 0x59, 0x3c, 0xe0, 0xa7, 0x71,
-	0xf1, 0x24, 0xaf, 0x2a, 0x36, 0x13, 0xcd, 0xcb, 0x8d, 0x89, 0x55, 0xe6, 0x28, 0x13, 0x72, 0x5d,
-	0xa9, 0x6a, 0x86, 0xe2, 0x68, 0xa6, 0x81, 0xb6, 0x85, 0xa0, 0xad, 0x67, 0xa5, 0x9a, 0x9a, 0xf7,
-	0xfd, 0x74, 0xd5, 0x34, 0xab, 0x3a, 0x93, 0x95, 0xba, 0x26, 0x2b, 0x86, 0x61, 0x3a, 0xdc, 0xd9,
-	0x8b, 0x34, 0x54, 0x35, 0xab, 0xa6, 0x40, 0xe0, 0xfe, 0x85, 0x6f, 0xc7, 0x62, 0x32, 0x55, 0xcd,
-	0x5a, 0x4d, 0x73, 0x6a, 0xcc, 0x70, 0x3c, 0xff, 0x73, 0x31, 0x96, 0x35, 0xc5, 0x5a, 0x67, 0x4e,
-	0x8a, 0x91, 0x69, 0x55, 0x98, 0x95, 0xd6, 0x52, 0x5d, 0xb1, 0x94, 0x9a, 0x67, 0x74, 0x21, 0xd6,
-	0x68, 0x2b, 0x88, 0x6a, 0x24, 0xc6, 0xcc, 0xd9, 0x14, 0x06, 0xd2, 0xfb, 0x04, 0x72, 0xaf, 0xbb,
-	0xbc, 0xde, 0x73, 0x21, 0x2c, 0x30, 0x36, 0xaf, 0xe8, 0x6a, 0x89, 0xbd, 0xb5, 0xc1, 0x6c, 0x87,
-	0xde, 0x82, 0x01, 0xc5, 0x5e, 0x2f, 0x73, 0x74, 0xb9, 0x9e, 0x51, 0x32, 0x76, 0x68, 0x72, 0xb4,
-	0x18, 0xdd, 0xaf, 0xc5, 0x59, 0x7b, 0x9d, 0x37, 0x51, 0xea, 0x57, 0xf0, 0x2f, 0xd7, 0x7d, 0x55,
-	0xab, 0xa0, 0xfb, 0xfe, 0x64, 0xf7, 0x39, 0xad, 0x82, 0xee, 0xab, 0xf8, 0x97, 0xf4, 0xa4, 0x07,
-	0x86, 0x23, 0xa0, 0xd9, 0x75, 0xd3, 0xb0, 0x19, 0x7d, 0x1d, 0x86, 0x54, 0x8b, 0xf1, 0x2e, 0x2c,
-	0xaf, 0x31, 0x56, 0x36, 0xeb, 0xbc, 0x37, 0x73, 0x64, 0x74, 0xff, 0xd8, 0xa1, 0xc9, 0xe1, 0x22,
-	0x0e, 0x23, 0x77, 0x30, 0x14, 0x71, 0x30, 0x14, 0xe7, 0x4d, 0xcd, 0x98, 0x3b, 0xf0, 0xf4, 0x1f,
-	0x23, 0xfb, 0x4a, 0xd4, 0x73, 0x5e, 0x60, 0xec, 0x9e, 0x70, 0xa5, 0xdf, 0x84, 0x53, 0x36, 0x73,
-	0x1c, 0x9d, 0xb9, 0x0c, 0x96, 0xd7, 0x74, 0xc5, 0x09, 0xb5, 0xdc, 0x93, 0xad, 0xe5, 0x9c, 0xdf,
-	0xc6, 0x82, 0xae, 0x38, 0x81, 0xf6, 0xdf, 0x84, 0xd3, 0x81, 0xf6, 0x2d, 0x37, 0x7c, 0x28, 0xc0,
-	0xfe, 0x6c, 0x01, 0x86, 0xfd, 0x46, 0x4a, 0x6e, 0x1b, 0x7e, 0x04, 0x69, 0x02, 0x86, 0x38, 0x63,
-	0x8b, 0xcc, 0x11, 0x6c, 0x62, 0x47, 0x0e, 0x43, 0x3f, 0xef, 0x85, 0xb2, 0x56, 0xc9, 0x91, 0x51,
-	0x32, 0x76, 0xa0, 0x74, 0x90, 0x3f, 0xdf, 0xa9, 0x48, 0x5f, 0x83, 0x13, 0x2d, 0x2e, 0x48, 0xf0,
-	0x14, 0xf4, 0x8a, 0x9e, 0x23, 0xbc, 0xe7, 0xce, 0xc4, 0xf5, 0x9c, 0xf0, 0x12, 0xb6, 0xd2, 0x9b,
-	0x30, 0x1a, 0x6a, 0x6d, 0x6e, 0xeb, 0xab, 0x9b, 0x0e, 0xb3, 0x0c, 0x45, 0xbf, 0xf3, 0xaa, 0x07,
-	0xe6, 0x14, 0x0c, 0x88, 0x49, 0xe1, 0xa1, 0x39, 0x52, 0xea, 0x17, 0x2f, 0xee, 0x54, 0xe8, 0x08,
-	0x1c, 0x62, 0xe8, 0xe1, 0x7e, 0x76, 0x07, 0xdd, 0x40, 0x09, 0xbc, 0x57, 0x77, 0x2a, 0xd2, 0x1b,
-	0x70, 0x36, 0x21, 0xc2, 0x17, 0xc1, 0xfe, 0x47, 0x02, 0xa7, 0xbc, 0xa6, 0x5f, 0xe3, 0x78, 0xf8,
-	0x67, 0x3b, 0x13, 0xee, 0x33, 0x00, 0x82, 0x61, 0x67, 0xab, 0xce, 0x10, 0xf6, 0x00, 0x7f, 0xb3,
-	0xbc, 0x55, 0x67, 0xf4, 0x3c, 0x0c, 0x2a, 0x6b, 0x0e, 0xb3, 0xca, 0xcd, 0x6e, 0xd8, 0xcf, 0xbb,
-	0xe1, 0x30, 0x7f, 0x7b, 0x4f, 0xf4, 0x05, 0x5d, 0x00, 0xf0, 0x57, 0xb5, 0x9c, 0xca, 0xb1, 0x5f,
-	0x0c, 0x0d, 0x07, 0xb1, 0xc2, 0x7a, 0x83, 0x62, 0x49, 0xa9, 0x32, 0x44, 0x57, 0x0a, 0x78, 0x4a,
-	0x1f, 0x12, 0x38, 0x1d, 0x9d, 0x09, 0xf2, 0x73, 0x03, 0xfa, 0xc4, 0x92, 0x83, 0xd3, 0x25, 0x85,
-	0x20, 0x34, 0xa6, 0x8b, 0x11, 0xf8, 0x2e, 0xa5, 0xe2, 0x13, 0x31, 0x43, 0x00, 0xff, 0x46, 0x20,
-	0xdf, 0xec, 0xc5, 0x47, 0x06, 0x32, 0xd0, 0x64, 0xba, 0x08, 0xbd, 0xa6, 0xfb, 0x96, 0xb3, 0x3c,
-	0x30, 0x97, 0xfb, 0xf3, 0x6f, 0xae, 0x0e, 0x61, 0x94, 0xd9, 0x4a, 0xc5, 0x62, 0xb6, 0x7d, 0xdf,
-	0xb1, 0x34, 0xa3, 0x5a, 0x12, 0x66, 0x7b, 0x8b, 0xfc, 0x9f, 0x05, 0x86, 0x51, 0x28, 0xb7, 0x3d,
-	0xc2, 0xfd, 0x27, 0x01, 0xee, 0x67, 0x6d, 0xbb, 0x75, 0x94, 0x0f, 0x41, 0xaf, 0xe2, 0xbe, 0x15,
-	0xdc, 0x97, 0xc4, 0xc3, 0xde, 0x65, 0x38, 0x94, 0xc1, 0x1e, 0x61, 0x78, 0x15, 0x4b, 0xaa, 0x0b,
-	0x4f, 0xd7, 0xc3, 0xf4, 0x76, 0x8b, 0x83, 0x0f, 0x08, 0x16, 0xc7, 0x70, 0x90, 0x3d, 0xc2, 0x80,
-	0xee, 0x83, 0x9b, 0x6f, 0xee, 0x94, 0x3c, 0x0a, 0x26, 0xe1, 0xa0, 0xa2, 0xaa, 0xe6, 0x86, 0xe1,
-	0xa4, 0xce, 0x6f, 0xcf, 0x30, 0xbc, 0xf6, 0xf6, 0x84, 0xd7, 0x5e, 0xe9, 0xc7, 0x81, 0x11, 0x1d,
-	0x0c, 0x87, 0x64, 0x6c, 0x41, 0x9f, 0x52, 0xc3, 0x70, 0x29, 0x05, 0x76, 0xc1, 0x2d, 0xb0, 0x1f,
-	0xfd, 0x73, 0x64, 0xac, 0xaa, 0x39, 0x0f, 0x37, 0x56, 0x8b, 0xaa, 0x59, 0xc3, 0xfd, 0x28, 0xfe,
-	0x5c, 0xb5, 0x2b, 0xeb, 0xb2, 0x3b, 0x07, 0x6c, 0xee, 0x60, 0xff, 0xf4, 0xf3, 0x27, 0xe3, 0x87,
-	0x75, 0x56, 0x55, 0xd4, 0xad, 0xb2, 0xbb, 0xd5, 0xb4, 0x7f, 0xfd, 0xf9, 0x93, 0x71, 0x52, 0xc2,
-	0x80, 0xd2, 0x03, 0xbf, 0x58, 0xcd, 0x8a, 0x4c, 0x7c, 0x7c, 0xf6, 0x17, 0xe0, 0x43, 0xd2, 0x41,
-	0x4a, 0x6a, 0x18, 0x33, 0x5f, 0x80, 0x43, 0x81, 0x8d, 0x2a, 0xa6, 0x7f, 0x3e, 0x6e, 0x2c, 0x88,
-	0x4a, 0x31, 0xcb, 0x91, 0x97, 0x82, 0x8e, 0xd2, 0x3b, 0xc4, 0x2f, 0xeb, 0xc2, 0x2a, 0x22, 0x8d,
-	0xc4, 0xf2, 0xd8, 0xad, 0x61, 0xff, 0x5b, 0xe2, 0x33, 0x1a, 0x81, 0x04, 0xf3, 0x5e, 0x8c, 0xca,
-	0xfb, 0x42, 0xec, 0xce, 0x55, 0x10, 0x18, 0x91, 0x78, 0xf7, 0x26, 0x44, 0x15, 0xce, 0x04, 0x66,
-	0x6b, 0x04, 0x7b, 0xdd, 0x22, 0xe8, 0x63, 0x02, 0x85, 0xb8, 0x48, 0xc8, 0xce, 0xab, 0x51, 0xec,
-	0x48, 0x71, 0xec, 0x04, 0x26, 0xd4, 0x97, 0x43, 0xcd, 0x75, 0x7f, 0x03, 0x2a, 0x7a, 0x34, 0xcb,
-	0x80, 0x92, 0xbe, 0x4b, 0xe0, 0x64, 0xab, 0x1b, 0xe6, 0xe7, 0xce, 0x27, 0x31, 0x6b, 0x32, 0xcc,
-	0x27, 0xf1, 0x48, 0xa7, 0xa1, 0x4f, 0x34, 0x8d, 0xc7, 0x9c, 0x42, 0xf2, 0x24, 0x29, 0xa1, 0xb5,
-	0xa4, 0x86, 0x56, 0x61, 0xf1, 0xb1, 0xeb, 0x7d, 0xfa, 0x8b, 0x60, 0xc5, 0x0e, 0x44, 0xc1, 0x7c,
-	0x6f, 0xc1, 0x41, 0x81, 0xc6, 0xeb, 0xcb, 0x73, 0xc9, 0xe0, 0xe7, 0x2c, 0x8d, 0xad, 0x95, 0x3c,
-	0x9f, 0xee, 0x75, 0xe4, 0x10, 0x50, 0x8e, 0x72, 0x89, 0x9f, 0x53, 0x31, 0x11, 0xe9, 0x35, 0x38,
-	0x1e, 0x7a, 0x8b, 0xa0, 0xa7, 0xa1, 0x4f, 0x9c, 0x67, 0x71, 0x8b, 0x1e, 0x4b, 0x38, 0xfa, 0xa1,
-	0xb5, 0xf4, 0x7b, 0x02, 0x97, 0x78, 0x7b, 0xfe, 0xb8, 0xbc, 0xef, 0x9f, 0xb7, 0xc2, 0xc7, 0xd7,
-	0x37, 0x00, 0xfc, 0xa3, 0x12, 0xc6, 0x99, 0x89, 0xe5, 0xc6, 0xae, 0xb6, 0x2e, 0x28, 0xa2, 0xe1,
-	0x66, 0x8f, 0xf8, 0x6d, 0xd1, 0x19, 0xc8, 0x69, 0x86, 0xaa, 0x6f, 0x54, 0x58, 0x79, 0xd5, 0x62,
-	0xca, 0x7a, 0xc5, 0x7c, 0x64, 0x94, 0xd7, 0x34, 0xa6, 0x57, 0x6c, 0x3e, 0x80, 0xfa, 0x4b, 0x27,
-	0xf1, 0xfb, 0x9c, 0xf7, 0x79, 0x81, 0x7f, 0x95, 0x3e, 0x3b, 0x00, 0x63, 0xe9, 0xf8, 0x91, 0xa4,
-	0xef, 0x13, 0x38, 0xe2, 0x61, 0x74, 0x4f, 0x8a, 0xf6, 0x8b, 0xab, 0x60, 0x87, 0xbd, 0xb8, 0x0b,
-	0x8c, 0xd9, 0xf4, 0x6d, 0x02, 0x87, 0x34, 0xa3, 0xbe, 0xe1, 0x94, 0x1d, 0xd3, 0x51, 0xf4, 0xf4,
-	0xa3, 0x70, 0xb7, 0x60, 0x00, 0x8f, 0xba, 0xec, 0x06, 0xa5, 0xef, 0x11, 0x38, 0xaa, 0x9a, 0x46,
-	0x83, 0x59, 0x0e, 0xab, 0x20, 0x90, 0xfd, 0x2f, 0x0a, 0xc8, 0x60, 0x33, 0xb2, 0x00, 0xb3, 0xec,
-	0x61, 0xb1, 0x35, 0xd3, 0x28, 0x1b, 0x4a, 0xc3, 0xce, 0x1d, 0x48, 0x2e, 0x33, 0x77, 0x71, 0xb3,
-	0xba, 0x64, 0x69, 0x2a, 0xc3, 0xa3, 0xfc, 0xa0, 0xdf, 0xc6, 0x5d, 0xa5, 0x61, 0xd3, 0x79, 0x00,
-	0x47, 0x68, 0x02, 0x86, 0xd2, 0xc8, 0xf5, 0xf2, 0x11, 0x9b, 0xad, 0xc1, 0x52, 0xbf, 0x63, 0x2e,
-	0x30, 0x76, 0x57, 0x69, 0x48, 0xef, 0x7a, 0xd5, 0xfa, 0xeb, 0x8a, 0xae, 0x55, 0x14, 0x87, 0xcd,
-	0x5b, 0x4c, 0x71, 0x58, 0x78, 0x71, 0x65, 0x70, 0x82, 0x2b, 0x20, 0xac, 0x8c, 0x6b, 0xac, 0x25,
-	0x3e, 0xe0, 0x34, 0x99, 0x48, 0x98, 0x26, 0x8b, 0x66, 0x23, 0xa2, 0xc5, 0xd2, 0x71, 0xb5, 0xfd,
-	0xa5, 0xb4, 0x86, 0xe5, 0x3a, 0x1a, 0x0a, 0x0e, 0xf3, 0x21, 0xe8, 0x65, 0x96, 0x65, 0x5a, 0xde,
-	0x91, 0x83, 0x3f, 0xd0, 0xcb, 0x40, 0xab, 0x66, 0xa3, 0x5c, 0xb7, 0xcc, 0x7a, 0xf9, 0x91, 0xa6,
-	0xeb, 0xe5, 0xba, 0x62, 0x7b, 0xb3, 0xeb, 0x68, 0xd5, 0x6c, 0x2c, 0x59, 0x66, 0xfd, 0x81, 0xa6,
-	0xeb, 0x4b, 0x8a, 0x6d, 0x4b, 0x37, 0x71, 0x85, 0xf4, 0xe2, 0x74, 0x50, 0x49, 0xa6, 0xf0, 0x30,
-	0xd1, 0xea, 0x9a, 0x04, 0x4e, 0xfa, 0xb6, 0x57, 0x66, 0x7d, 0x2f, 0x43, 0x11, 0x93, 0xc5, 0x0b,
-	0x5a, 0x86, 0xe3, 0x35, 0xfe, 0x92, 0xcf, 0xdc, 0x16, 0x7e, 0xe5, 0x64, 0x7e, 0xdb, 0x5a, 0x2b,
-	0x1d, 0xab, 0xb5, 0xbe, 0x92, 0x2a, 0x30, 0x12, 0x0b, 0xa1, 0x7b, 0xcc, 0xae, 0xfb, 0x75, 0x76,
-	0x49, 0x68, 0x8b, 0x5e, 0x82, 0xd7, 0xa0, 0xcf, 0x36, 0x37, 0x2c, 0x95, 0xa5, 0x96, 0x59, 0xb4,
-	0x4b, 0x17, 0x77, 0x96, 0xe1, 0xff, 0xda, 0x82, 0x61, 0x2a, 0x37, 0xe1, 0x20, 0x6a, 0x9b, 0x48,
-	0xe1, 0x48, 0x7c, 0xc5, 0x10, 0x9e, 0x9e, 0xbd, 0x7b, 0x5e, 0x3c, 0xdb, 0xd2, 0xac, 0xfd, 0x40,
-	0x73, 0x1e, 0xde, 0xe7, 0xa8, 0x76, 0x9f, 0x4e, 0xb7, 0xea, 0xfb, 0x47, 0xc4, 0xdf, 0xcd, 0x47,
-	0xe1, 0x43, 0x06, 0xfe, 0x1f, 0xfa, 0x3d, 0x75, 0x17, 0xeb, 0x40, 0x2a, 0x05, 0x4d, 0x87, 0xee,
-	0x55, 0xf9, 0x38, 0x32, 0x97, 0x15, 0xab, 0xca, 0x82, 0x63, 0xc3, 0xe1, 0x2f, 0xd2, 0xc9, 0x14,
-	0x76, 0x5f, 0x3a, 0x99, 0x1e, 0xbe, 0x3d, 0x45, 0x66, 0x25, 0xb4, 0xb1, 0xf3, 0xe0, 0x76, 0x7b,
-	0xff, 0xf8, 0x38, 0xa8, 0x97, 0x04, 0xc3, 0xec, 0x29, 0x2e, 0xbe, 0x81, 0x5c, 0x60, 0x88, 0x96,
-	0xbd, 0xdc, 0xed, 0x4e, 0xa7, 0x3f, 0x56, 0xd8, 0xe6, 0x22, 0xf0, 0xb8, 0x07, 0x49, 0x68, 0x6d,
-	0x1f, 0x49, 0xf8, 0x16, 0x01, 0x70, 0x0b, 0xaf, 0xa8, 0x62, 0x2f, 0x6e, 0xa3, 0x35, 0xb0, 0xc6,
-	0xb0, 0x2a, 0x36, 0x21, 0x28, 0xaa, 0xca, 0xea, 0xce, 0x8b, 0xdb, 0x64, 0xb9, 0x10, 0x66, 0x79,
-	0xcc, 0xc9, 0x5f, 0x9e, 0x83, 0x5e, 0xce, 0x12, 0xfd, 0x39, 0x81, 0xc3, 0xc1, 0x8b, 0x17, 0x7a,
-	0x2d, 0x8e, 0xf0, 0xb8, 0xeb, 0xa3, 0xfc, 0x44, 0x07, 0x1e, 0xa2, 0x17, 0xa4, 0xf1, 0xb7, 0xff,
-	0xf2, 0xaf, 0x1f, 0xf5, 0x9c, 0xa7, 0x92, 0x1c, 0x73, 0x71, 0xe5, 0xd6, 0x52, 0x71, 0x5d, 0x46,
-	0x7f, 0x42, 0xa0, 0xdf, 0xbb, 0x05, 0xa0, 0x57, 0x12, 0x63, 0xb5, 0xdc, 0x87, 0xe4, 0xaf, 0x66,
-	0xb4, 0x46, 0x54, 0xd7, 0x38, 0xaa, 0x71, 0x3a, 0x26, 0x27, 0xdd, 0xdf, 0xc9, 0xdb, 0x9e, 0xfa,
-	0xb9, 0x43, 0xdf, 0xef, 0x81, 0xa1, 0xa8, 0x1b, 0x0a, 0x3a, 0x93, 0x29, 0x72, 0xc4, 0xb5, 0x49,
-	0xfe, 0xe6, 0x2e, 0x3c, 0x11, 0xff, 0x7b, 0x84, 0x27, 0xf0, 0x1d, 0xb2, 0xf2, 0x0a, 0xfd, 0x8a,
-	0x9c, 0x78, 0x51, 0x29, 0x6f, 0x37, 0x77, 0x4a, 0x3b, 0x5e, 0x5a, 0x81, 0x9a, 0xbd, 0x43, 0x6f,
-	0x27, 0x72, 0x60, 0x47, 0x35, 0x13, 0x6e, 0xe0, 0xdf, 0x04, 0x8e, 0xb6, 0xdc, 0x4b, 0xd0, 0xa9,
-	0xb4, 0xdc, 0x22, 0xee, 0x63, 0xf2, 0xd7, 0x3b, 0x73, 0x42, 0x2e, 0x0c, 0x4e, 0xc5, 0xc3, 0x95,
-	0x29, 0x3a, 0xd1, 0x29, 0x13, 0x76, 0xbc, 0x4b, 0x6c, 0xf2, 0xf4, 0x63, 0x02, 0x83, 0xe1, 0x9b,
-	0x00, 0x3a, 0x99, 0xda, 0x93, 0x6d, 0x57, 0x22, 0xf9, 0xa9, 0x8e, 0x7c, 0x30, 0xd7, 0xeb, 0x3c,
-	0xd7, 0x22, 0xbd, 0x92, 0x02, 0x9b, 0xdf, 0xa2, 0xc8, 0xdb, 0xfc, 0xa7, 0x89, 0x38, 0xa0, 0xac,
-	0xa7, 0x23, 0x6e, 0xbf, 0x48, 0x48, 0x47, 0x1c, 0x21, 0xdd, 0x67, 0x46, 0xcc, 0x6f, 0x25, 0xe4,
-	0x6d, 0xfe, 0xb3, 0x43, 0x3f, 0x20, 0x70, 0x38, 0xa8, 0x83, 0xa7, 0xac, 0x55, 0x11, 0xba, 0x7c,
-	0xca, 0x5a, 0x15, 0x25, 0xb2, 0x4b, 0x17, 0x39, 0xd6, 0x51, 0x5a, 0x48, 0xc6, 0x4a, 0x3f, 0x21,
-	0x70, 0x24, 0xa4, 0x4c, 0xd3, 0xd4, 0x60, 0x6d, 0xa2, 0x79, 0x7e, 0xb2, 0x13, 0x17, 0x04, 0xb8,
-	0xc8, 0x01, 0xce, 0xc6, 0x4f, 0xd9, 0x88, 0x81, 0xee, 0x4b, 0x7c, 0xf2, 0x36, 0x8a, 0xcd, 0x3b,
-	0xf4, 0x4f, 0x04, 0x4e, 0x44, 0x2a, 0xcd, 0x34, 0x75, 0x51, 0x8a, 0x95, 0xbd, 0xf3, 0x2f, 0xef,
-	0xc6, 0x15, 0x33, 0xbb, 0xc5, 0x33, 0x7b, 0x89, 0xde, 0x90, 0xd3, 0xff, 0x7f, 0x86, 0x8c, 0x69,
-	0x04, 0xf2, 0xf9, 0x9e, 0x58, 0x9d, 0xdb, 0x04, 0xe4, 0xf4, 0xd5, 0x39, 0x4e, 0xfd, 0x4e, 0x5f,
-	0x9d, 0x63, 0xd5, 0x6a, 0x69, 0x93, 0x27, 0x63, 0xad, 0xcc, 0xd0, 0xe9, 0x5d, 0x75, 0x94, 0x1d,
-	0xef, 0x17, 0xa4, 0x21, 0x7a, 0x6d, 0x3a, 0xd6, 0xa6, 0x13, 0xd3, 0x1b, 0x19, 0xa6, 0x42, 0x04,
-	0x03, 0xd3, 0x9d, 0xba, 0x61, 0xfa, 0x97, 0x79, 0xfa, 0x17, 0xe8, 0xb9, 0x0c, 0x49, 0xd0, 0x0f,
-	0x09, 0x0c, 0x34, 0xc9, 0xa4, 0x57, 0xb3, 0x91, 0xee, 0x21, 0x2c, 0x66, 0x35, 0x47, 0x64, 0x93,
-	0x1c, 0xd9, 0x15, 0x3a, 0x9e, 0xbd, 0x5b, 0xdc, 0x6d, 0xd3, 0x91, 0x90, 0x4c, 0x4b, 0xb3, 0xac,
-	0x2c, 0x61, 0xe1, 0x38, 0x7d, 0xb2, 0xb7, 0xab, 0xc0, 0xd2, 0x25, 0x0e, 0xf6, 0x2c, 0x1d, 0x49,
-	0x06, 0x6b, 0xd3, 0x77, 0x09, 0xf4, 0x09, 0x51, 0x95, 0x8e, 0x27, 0xc6, 0x09, 0xe9, 0xb8, 0xf9,
-	0xcb, 0x99, 0x6c, 0xb3, 0x2e, 0x8d, 0x42, 0xcd, 0xa5, 0x7f, 0x27, 0x70, 0x2a, 0x41, 0x08, 0xa5,
-	0xb7, 0x13, 0x83, 0xa6, 0x4b, 0xc0, 0xf9, 0x57, 0x76, 0xdf, 0x00, 0xa6, 0xf2, 0x32, 0x4f, 0xe5,
-	0x3a, 0x9d, 0x4c, 0xdc, 0x91, 0xfa, 0x63, 0xb4, 0x1c, 0x90, 0x89, 0xff, 0x40, 0x60, 0x28, 0x4a,
-	0xf9, 0x4a, 0x59, 0x67, 0x12, 0x74, 0xbb, 0x94, 0x75, 0x26, 0x49, 0x66, 0x93, 0xa6, 0x79, 0x26,
-	0xd7, 0x68, 0x31, 0x2e, 0x93, 0x06, 0x7a, 0xcb, 0x21, 0x65, 0x90, 0xfe, 0x87, 0xc0, 0x60, 0x58,
-	0x1c, 0x4b, 0xd9, 0x0f, 0x44, 0x8a, 0x70, 0x29, 0xfb, 0x81, 0x68, 0xf5, 0x4d, 0xb2, 0x38, 0x66,
-	0x7d, 0xe5, 0x06, 0x9d, 0xea, 0x60, 0x6d, 0xf4, 0x12, 0x89, 0x77, 0x6a, 0xa6, 0x1a, 0x31, 0x85,
-	0x7f, 0x47, 0x80, 0xb6, 0x6b, 0x6a, 0x74, 0x3a, 0x23, 0xfe, 0x16, 0x99, 0x2e, 0xff, 0x52, 0xc7,
-	0x7e, 0x59, 0xf7, 0x42, 0x81, 0x24, 0x9a, 0x3a, 0x23, 0xfd, 0x2f, 0x01, 0xf0, 0xa5, 0x0f, 0x9a,
-	0xba, 0xe6, 0x85, 0x45, 0xbd, 0xbc, 0x9c, 0xd9, 0x1e, 0x51, 0xfe, 0x40, 0x9c, 0x2d, 0xde, 0x21,
-	0x2b, 0x09, 0xe7, 0x23, 0x3c, 0x84, 0xcb, 0xdb, 0x42, 0x39, 0xdb, 0x49, 0xaa, 0x75, 0xad, 0xb6,
-	0x2d, 0xc7, 0x87, 0x91, 0x14, 0x3f, 0xfa, 0x54, 0x6c, 0x56, 0xda, 0x85, 0xb4, 0xf4, 0xcd, 0x4a,
-	0xac, 0x38, 0x98, 0xbe, 0x59, 0x89, 0xd7, 0xed, 0xa4, 0x19, 0x4e, 0xd0, 0x24, 0xbd, 0x96, 0x82,
-	0xdc, 0x96, 0x45, 0xc6, 0xcd, 0xcc, 0xa3, 0x52, 0x11, 0x32, 0x56, 0x67, 0xa9, 0x84, 0xa4, 0xb9,
-	0xce, 0x52, 0x09, 0xab, 0x66, 0x1d, 0xa4, 0x22, 0x54, 0x3d, 0x79, 0x5b, 0xfc, 0xee, 0xd0, 0xc7,
-	0x78, 0xa8, 0xf0, 0xe5, 0x27, 0x9a, 0xa5, 0xca, 0xb5, 0x48, 0x62, 0x19, 0x0e, 0x15, 0xed, 0xfa,
-	0x96, 0x34, 0xc6, 0x51, 0x4b, 0x74, 0x34, 0x0d, 0x35, 0xfd, 0x15, 0x81, 0xc1, 0xb0, 0x3e, 0x94,
-	0x82, 0x32, 0x52, 0xac, 0x4a, 0x41, 0x19, 0x2d, 0x40, 0x49, 0x57, 0x38, 0xca, 0x8b, 0xf4, 0x7c,
-	0x62, 0xa1, 0x41, 0xa8, 0x73, 0xec, 0xe9, 0xb3, 0x02, 0xf9, 0xf4, 0x59, 0x81, 0x7c, 0xf6, 0xac,
-	0x40, 0x7e, 0xf8, 0xbc, 0xb0, 0xef, 0xd3, 0xe7, 0x85, 0x7d, 0x7f, 0x7d, 0x5e, 0xd8, 0x07, 0xc3,
-	0x9a, 0x19, 0x13, 0x7e, 0x89, 0xac, 0x14, 0x03, 0x52, 0x91, 0x6f, 0x74, 0x55, 0x33, 0x83, 0x41,
-	0x37, 0x9b, 0x61, 0x57, 0xfb, 0xf8, 0xff, 0x12, 0x9e, 0xfa, 0x5f, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0xf2, 0x8a, 0xf8, 0x1c, 0xf2, 0x2d, 0x00, 0x00,
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5a, 0xcd, 0x6f, 0x1c, 0x67,
+	0x19, 0xcf, 0xeb, 0xd4, 0x8e, 0xfd, 0xc4, 0x71, 0x94, 0x37, 0x4e, 0x58, 0x4f, 0x12, 0xdb, 0x99,
+	0x7c, 0x59, 0x4e, 0xb2, 0x13, 0x7b, 0x13, 0x37, 0x09, 0x0a, 0xa9, 0x9d, 0xe2, 0x28, 0x82, 0x26,
+	0xee, 0x26, 0x22, 0x95, 0x25, 0xd8, 0x8e, 0x67, 0x5f, 0x6f, 0x46, 0x9e, 0x9d, 0xd9, 0xce, 0x8c,
+	0x37, 0xb1, 0x2c, 0x4b, 0xd0, 0x02, 0x55, 0x7b, 0x40, 0x48, 0x1c, 0x28, 0x54, 0xb4, 0x87, 0x20,
+	0x40, 0xbd, 0x34, 0x07, 0x38, 0x21, 0xd4, 0x03, 0x07, 0x72, 0x41, 0xaa, 0xe0, 0x02, 0x12, 0x02,
+	0x94, 0x20, 0x7a, 0x81, 0x7f, 0x01, 0xa1, 0x79, 0xdf, 0x67, 0x76, 0x66, 0xd6, 0xf3, 0xb5, 0xee,
+	0xd6, 0xf2, 0x25, 0xde, 0x99, 0x79, 0x3e, 0x7e, 0xcf, 0xef, 0xfd, 0xfe, 0xbd, 0x01, 0xb9, 0x61,
+	0x5b, 0x4d, 0x66, 0xaa, 0xa6, 0xc6, 0x14, 0xf6, 0x48, 0x7b, 0xa0, 0x9a, 0x35, 0xa6, 0x34, 0xa7,
+	0x94, 0x37, 0x56, 0x99, 0xbd, 0x56, 0x6c, 0xd8, 0x96, 0x6b, 0xd1, 0xc3, 0x81, 0x4d, 0xd1, 0xb7,
+	0x29, 0x36, 0xa7, 0xa4, 0x03, 0x6a, 0x5d, 0x37, 0x2d, 0x85, 0xff, 0x2b, 0x4c, 0xa5, 0x11, 0xcd,
+	0x72, 0xea, 0x96, 0x53, 0xe1, 0x4f, 0x8a, 0x78, 0xc0, 0x4f, 0x93, 0xe2, 0x49, 0x59, 0x52, 0x1d,
+	0x26, 0xc2, 0x2b, 0xcd, 0xa9, 0x25, 0xe6, 0xaa, 0x53, 0x4a, 0x43, 0xad, 0xe9, 0xa6, 0xea, 0xea,
+	0x96, 0x89, 0xb6, 0xa3, 0x61, 0x5b, 0xdf, 0x4a, 0xb3, 0x74, 0xff, 0xfb, 0xd1, 0x9a, 0x65, 0xd5,
+	0x0c, 0xa6, 0xa8, 0x0d, 0x5d, 0x51, 0x4d, 0xd3, 0x72, 0xb9, 0xb3, 0x9f, 0x69, 0xb8, 0x66, 0xd5,
+	0x2c, 0x81, 0xc0, 0xfb, 0x85, 0x6f, 0x27, 0x12, 0x2a, 0xd5, 0xac, 0x7a, 0x5d, 0x77, 0xeb, 0xcc,
+	0x74, 0x7d, 0xff, 0x13, 0x09, 0x96, 0x75, 0xd5, 0x5e, 0x61, 0x6e, 0x86, 0x91, 0x65, 0x57, 0x99,
+	0x9d, 0x15, 0xa9, 0xa1, 0xda, 0x6a, 0xdd, 0x37, 0x3a, 0x95, 0x68, 0xb4, 0x16, 0x46, 0x35, 0x96,
+	0x60, 0xe6, 0x3e, 0x12, 0x06, 0xf2, 0x7b, 0x04, 0x0a, 0xaf, 0x7a, 0xbc, 0xde, 0xf1, 0x20, 0xcc,
+	0x33, 0x76, 0x43, 0x35, 0xb4, 0x32, 0x7b, 0x63, 0x95, 0x39, 0x2e, 0xbd, 0x06, 0x03, 0xaa, 0xb3,
+	0x52, 0xe1, 0xe8, 0x0a, 0x3d, 0xe3, 0x64, 0x62, 0xef, 0xf4, 0x78, 0x31, 0xbe, 0x5d, 0x8b, 0xb3,
+	0xce, 0x0a, 0x0f, 0x51, 0xee, 0x57, 0xf1, 0x97, 0xe7, 0xbe, 0xa4, 0x57, 0xd1, 0x7d, 0x77, 0xba,
+	0xfb, 0x9c, 0x5e, 0x45, 0xf7, 0x25, 0xfc, 0x25, 0x3f, 0xe9, 0x81, 0x91, 0x18, 0x68, 0x4e, 0xc3,
+	0x32, 0x1d, 0x46, 0x5f, 0x85, 0x61, 0xcd, 0x66, 0xbc, 0x09, 0x2b, 0xcb, 0x8c, 0x55, 0xac, 0x86,
+	0xf7, 0xd3, 0x29, 0x90, 0xf1, 0xdd, 0x13, 0x7b, 0xa7, 0x47, 0x8a, 0xd8, 0x8d, 0xbc, 0xce, 0x50,
+	0xc4, 0xce, 0x50, 0xbc, 0x61, 0xe9, 0xe6, 0xdc, 0x0b, 0x4f, 0xff, 0x3e, 0xb6, 0xab, 0x4c, 0x7d,
+	0xe7, 0x79, 0xc6, 0xee, 0x08, 0x57, 0xfa, 0x2d, 0x38, 0xe2, 0x30, 0xd7, 0x35, 0x98, 0xc7, 0x60,
+	0x65, 0xd9, 0x50, 0xdd, 0x48, 0xe4, 0x9e, 0x7c, 0x91, 0x0b, 0x41, 0x8c, 0x79, 0x43, 0x75, 0x43,
+	0xf1, 0x5f, 0x87, 0xa3, 0xa1, 0xf8, 0xb6, 0x97, 0x3e, 0x92, 0x60, 0x77, 0xbe, 0x04, 0x23, 0x41,
+	0x90, 0xb2, 0x17, 0x23, 0xc8, 0x20, 0x4f, 0xc1, 0x30, 0x67, 0xec, 0x26, 0x73, 0x05, 0x9b, 0xd8,
+	0x90, 0x23, 0xd0, 0xcf, 0x5b, 0xa1, 0xa2, 0x57, 0x0b, 0x64, 0x9c, 0x4c, 0xbc, 0x50, 0xde, 0xc3,
+	0x9f, 0x6f, 0x55, 0xe5, 0xaf, 0xc3, 0xa1, 0x36, 0x17, 0x24, 0xb8, 0x04, 0xbd, 0xa2, 0xe5, 0x08,
+	0x6f, 0xb9, 0x63, 0x49, 0x2d, 0x27, 0xbc, 0x84, 0xad, 0xfc, 0x3a, 0x8c, 0x47, 0xa2, 0xcd, 0xad,
+	0x7d, 0xf5, 0x91, 0xcb, 0x6c, 0x53, 0x35, 0x6e, 0xbd, 0xec, 0x83, 0x39, 0x02, 0x03, 0x62, 0x50,
+	0xf8, 0x68, 0xf6, 0x95, 0xfb, 0xc5, 0x8b, 0x5b, 0x55, 0x3a, 0x06, 0x7b, 0x19, 0x7a, 0x78, 0x9f,
+	0xbd, 0x4e, 0x37, 0x50, 0x06, 0xff, 0xd5, 0xad, 0xaa, 0xfc, 0x1a, 0x1c, 0x4f, 0xc9, 0xf0, 0x79,
+	0xb0, 0xff, 0x81, 0xc0, 0x11, 0x3f, 0xf4, 0x2b, 0x1c, 0x0f, 0xff, 0xec, 0xe4, 0xc2, 0x7d, 0x0c,
+	0x40, 0x30, 0xec, 0xae, 0x35, 0x18, 0xc2, 0x1e, 0xe0, 0x6f, 0xee, 0xad, 0x35, 0x18, 0x3d, 0x09,
+	0x43, 0xea, 0xb2, 0xcb, 0xec, 0x4a, 0xab, 0x19, 0x76, 0xf3, 0x66, 0x18, 0xe4, 0x6f, 0xef, 0x88,
+	0xb6, 0xa0, 0xf3, 0x00, 0xc1, 0xac, 0x56, 0xd0, 0x38, 0xf6, 0xd3, 0x91, 0xee, 0x20, 0x66, 0x58,
+	0xbf, 0x53, 0x2c, 0xa8, 0x35, 0x86, 0xe8, 0xca, 0x21, 0x4f, 0xf9, 0x03, 0x02, 0x47, 0xe3, 0x2b,
+	0x41, 0x7e, 0x2e, 0x41, 0x9f, 0x98, 0x72, 0x70, 0xb8, 0x64, 0x10, 0x84, 0xc6, 0xf4, 0x66, 0x0c,
+	0xbe, 0x33, 0x99, 0xf8, 0x44, 0xce, 0x08, 0xc0, 0xbf, 0x12, 0x90, 0x5a, 0xad, 0xf8, 0xd0, 0x64,
+	0x76, 0x94, 0xe9, 0x22, 0xf4, 0x5a, 0xde, 0x5b, 0xce, 0xf2, 0xc0, 0x5c, 0xe1, 0x4f, 0xbf, 0x3e,
+	0x3f, 0x8c, 0x59, 0x66, 0xab, 0x55, 0x9b, 0x39, 0xce, 0x5d, 0xd7, 0xd6, 0xcd, 0x5a, 0x59, 0x98,
+	0xed, 0x2c, 0xf2, 0x7f, 0x16, 0xea, 0x46, 0x91, 0xda, 0x76, 0x08, 0xf7, 0x9f, 0x84, 0xb8, 0x9f,
+	0x75, 0x9c, 0xf6, 0x5e, 0x3e, 0x0c, 0xbd, 0xaa, 0xf7, 0x56, 0x70, 0x5f, 0x16, 0x0f, 0x3b, 0x97,
+	0xe1, 0x48, 0x05, 0x3b, 0x84, 0xe1, 0x25, 0x28, 0xb4, 0xe0, 0x19, 0x46, 0x94, 0xde, 0x6e, 0x71,
+	0xf0, 0x3e, 0x81, 0x91, 0x98, 0x24, 0x3b, 0x84, 0x01, 0x23, 0x00, 0x77, 0xa3, 0xb5, 0x53, 0xf2,
+	0x29, 0x98, 0x86, 0x3d, 0xaa, 0xa6, 0x59, 0xab, 0xa6, 0x9b, 0x39, 0xbe, 0x7d, 0xc3, 0xe8, 0xdc,
+	0xdb, 0x13, 0x9d, 0x7b, 0xe5, 0x1f, 0x87, 0x7a, 0x74, 0x38, 0x1d, 0x92, 0xb1, 0x06, 0x7d, 0x6a,
+	0x1d, 0xd3, 0x65, 0x2c, 0xb0, 0xf3, 0xde, 0x02, 0xfb, 0xd1, 0x3f, 0xc6, 0x26, 0x6a, 0xba, 0xfb,
+	0x60, 0x75, 0xa9, 0xa8, 0x59, 0x75, 0xdc, 0x8f, 0xe2, 0x9f, 0xf3, 0x4e, 0x75, 0x45, 0xf1, 0xc6,
+	0x80, 0xc3, 0x1d, 0x9c, 0x9f, 0x7e, 0xf6, 0x64, 0x72, 0xd0, 0x60, 0x35, 0x55, 0x5b, 0xab, 0x78,
+	0x5b, 0x4d, 0xe7, 0x57, 0x9f, 0x3d, 0x99, 0x24, 0x65, 0x4c, 0x28, 0xdf, 0x0f, 0x16, 0xab, 0x59,
+	0x51, 0x49, 0x80, 0xcf, 0xf9, 0x1c, 0x7c, 0xc8, 0x06, 0xc8, 0x69, 0x81, 0xb1, 0xf2, 0x79, 0xd8,
+	0x1b, 0xda, 0xa8, 0x62, 0xf9, 0x27, 0x93, 0xfa, 0x82, 0x58, 0x29, 0x66, 0x39, 0xf2, 0x72, 0xd8,
+	0x51, 0x7e, 0x9b, 0x04, 0xcb, 0xba, 0xb0, 0x8a, 0x29, 0x23, 0x75, 0x79, 0xec, 0x56, 0xb7, 0xff,
+	0x0d, 0x81, 0xe3, 0x29, 0x48, 0xb0, 0xee, 0x9b, 0x71, 0x75, 0x9f, 0x4a, 0xdc, 0xb9, 0x0a, 0x02,
+	0x63, 0x0a, 0xef, 0xde, 0x80, 0xa8, 0xc1, 0xb1, 0xd0, 0x68, 0x8d, 0x61, 0xaf, 0x5b, 0x04, 0x7d,
+	0x4c, 0x60, 0x34, 0x29, 0x13, 0xb2, 0xf3, 0x72, 0x1c, 0x3b, 0x72, 0x12, 0x3b, 0xa1, 0x01, 0xf5,
+	0xc5, 0x50, 0x73, 0x11, 0x0e, 0x45, 0x5b, 0x34, 0x4f, 0x87, 0x92, 0xbf, 0x4b, 0xe0, 0x70, 0xbb,
+	0x1b, 0xd6, 0xe7, 0x8d, 0x27, 0x31, 0x6a, 0x72, 0x8c, 0x27, 0xf1, 0x48, 0x67, 0xa0, 0x4f, 0x84,
+	0xc6, 0x63, 0xce, 0x68, 0xfa, 0x20, 0x29, 0xa3, 0xb5, 0xac, 0x45, 0x66, 0x61, 0xf1, 0xb1, 0xeb,
+	0x6d, 0xfa, 0xf3, 0xf0, 0x8a, 0x1d, 0xca, 0x82, 0xf5, 0x5e, 0x83, 0x3d, 0x02, 0x8d, 0xdf, 0x96,
+	0x27, 0xd2, 0xc1, 0xcf, 0xd9, 0x3a, 0x5b, 0x2e, 0xfb, 0x3e, 0xdd, 0x6b, 0xc8, 0x61, 0xa0, 0x1c,
+	0xe5, 0x02, 0x3f, 0xa7, 0x62, 0x21, 0xf2, 0x2b, 0x70, 0x30, 0xf2, 0x16, 0x41, 0xcf, 0x40, 0x9f,
+	0x38, 0xcf, 0x16, 0x48, 0x3a, 0xe1, 0xe8, 0x87, 0xd6, 0xf2, 0xef, 0x08, 0x9c, 0xe1, 0xf1, 0x82,
+	0x7e, 0x79, 0x37, 0x38, 0x6f, 0x45, 0x8f, 0xaf, 0xaf, 0x01, 0x04, 0x47, 0x25, 0xcc, 0x73, 0x39,
+	0x91, 0x1b, 0xa7, 0xd6, 0x3e, 0xa1, 0x88, 0xc0, 0xad, 0x16, 0x09, 0x62, 0xd1, 0xcb, 0x50, 0xd0,
+	0x4d, 0xcd, 0x58, 0xad, 0xb2, 0xca, 0x92, 0xcd, 0xd4, 0x95, 0xaa, 0xf5, 0xd0, 0xac, 0x2c, 0xeb,
+	0xcc, 0xa8, 0x3a, 0xbc, 0x03, 0xf5, 0x97, 0x0f, 0xe3, 0xf7, 0x39, 0xff, 0xf3, 0x3c, 0xff, 0x2a,
+	0xff, 0xbb, 0x17, 0x26, 0xb2, 0xf1, 0x23, 0x49, 0xdf, 0x27, 0xb0, 0xcf, 0xc7, 0xe8, 0x9d, 0x14,
+	0x9d, 0xed, 0x5b, 0xc1, 0x06, 0xfd, 0xbc, 0xf3, 0x8c, 0x39, 0xf4, 0x4d, 0x02, 0x7b, 0x75, 0xb3,
+	0xb1, 0xea, 0x56, 0x5c, 0xcb, 0x55, 0x8d, 0x42, 0xcf, 0x76, 0xc1, 0x00, 0x9e, 0xf5, 0x9e, 0x97,
+	0x94, 0xbe, 0x4b, 0x60, 0xbf, 0x66, 0x99, 0x4d, 0x66, 0xbb, 0xac, 0x8a, 0x40, 0x76, 0x6f, 0x17,
+	0x90, 0xa1, 0x56, 0x66, 0x01, 0xe6, 0x9e, 0x8f, 0xc5, 0xf1, 0x04, 0x08, 0x53, 0x6d, 0x3a, 0x85,
+	0x17, 0xd2, 0x97, 0x99, 0xdb, 0xb8, 0x59, 0x5d, 0xb0, 0x75, 0x8d, 0xe1, 0x51, 0x7e, 0x28, 0x88,
+	0x71, 0x5b, 0x6d, 0x3a, 0xf4, 0x06, 0x80, 0x2b, 0x34, 0x01, 0x53, 0x6d, 0x16, 0x7a, 0xc7, 0x49,
+	0xee, 0x80, 0xe5, 0x7e, 0xd7, 0x13, 0x02, 0x6e, 0xab, 0x4d, 0xfa, 0x16, 0x81, 0x41, 0xdd, 0xac,
+	0xac, 0xe8, 0x66, 0x55, 0x74, 0x9a, 0xbe, 0x6d, 0x6c, 0xad, 0xaf, 0xe9, 0x66, 0xd5, 0xeb, 0x32,
+	0xf2, 0x3b, 0xfe, 0x9e, 0xe1, 0x1b, 0xaa, 0xa1, 0x57, 0x55, 0x97, 0xdd, 0xb0, 0x99, 0xea, 0xb2,
+	0xe8, 0x14, 0xcf, 0xe0, 0x10, 0xd7, 0x61, 0x58, 0x05, 0x67, 0x7a, 0x5b, 0x7c, 0xc0, 0xc1, 0x3a,
+	0x95, 0x32, 0x58, 0x6f, 0x5a, 0xcd, 0x98, 0x88, 0xe5, 0x83, 0xda, 0xe6, 0x97, 0xf2, 0x32, 0x1c,
+	0x4f, 0x81, 0x82, 0x83, 0x6d, 0x18, 0x7a, 0x99, 0x6d, 0x5b, 0xb6, 0x7f, 0xf0, 0xe1, 0x0f, 0xf4,
+	0x2c, 0xd0, 0x9a, 0xd5, 0xf4, 0xa4, 0xc9, 0x46, 0xe5, 0xa1, 0x6e, 0x18, 0x95, 0x86, 0xea, 0xf8,
+	0x63, 0x7c, 0x7f, 0xcd, 0x6a, 0x2e, 0xd8, 0x56, 0xe3, 0xbe, 0x6e, 0x18, 0x0b, 0xaa, 0xe3, 0xc8,
+	0x57, 0x40, 0x8a, 0xe4, 0xe9, 0x60, 0x3d, 0x2b, 0xc1, 0x91, 0x58, 0xd7, 0x34, 0x70, 0xf2, 0x77,
+	0xfc, 0xc5, 0x3e, 0xf0, 0x32, 0x55, 0x31, 0x64, 0xfd, 0xa4, 0x15, 0x38, 0x58, 0xe7, 0x2f, 0x79,
+	0x57, 0x68, 0xe3, 0x57, 0x49, 0xe7, 0x77, 0x53, 0xb4, 0xf2, 0x81, 0x7a, 0xfb, 0x2b, 0xb9, 0x0a,
+	0x63, 0x89, 0x10, 0xba, 0xc7, 0xec, 0x4a, 0xb0, 0xda, 0x2f, 0x08, 0x85, 0xd3, 0x2f, 0xf0, 0x02,
+	0xf4, 0x39, 0xd6, 0xaa, 0xad, 0xb1, 0xcc, 0xc5, 0x1e, 0xed, 0xb2, 0x25, 0xa6, 0x7b, 0xf0, 0xa5,
+	0x4d, 0xc9, 0xb0, 0x94, 0x2b, 0xb0, 0x07, 0x15, 0x56, 0xa4, 0x70, 0x2c, 0x79, 0xdd, 0x12, 0x9e,
+	0xbe, 0xbd, 0x77, 0x6a, 0x3d, 0xde, 0x16, 0xd6, 0xb9, 0xaf, 0xbb, 0x0f, 0xee, 0x72, 0x54, 0x5b,
+	0x2f, 0xa7, 0x5b, 0xbb, 0x8c, 0x8f, 0x08, 0xc8, 0x69, 0xf8, 0x90, 0x81, 0x2f, 0x43, 0x3f, 0x56,
+	0xe4, 0xaf, 0x46, 0x99, 0x14, 0xb4, 0x1c, 0xba, 0xb7, 0xd7, 0x48, 0x22, 0xf3, 0x9e, 0x6a, 0xd7,
+	0x58, 0xb8, 0x6f, 0xb8, 0xfc, 0x45, 0x36, 0x99, 0xc2, 0xee, 0x0b, 0x27, 0xd3, 0xc7, 0xb7, 0xa3,
+	0xc8, 0xac, 0x46, 0xb6, 0x97, 0x3e, 0xdc, 0x6e, 0xef, 0x62, 0x1f, 0x87, 0x55, 0x9b, 0x70, 0x9a,
+	0x1d, 0xc5, 0xc5, 0x37, 0x91, 0x0b, 0x4c, 0xd1, 0xb6, 0xa3, 0xbc, 0xde, 0xe9, 0xf0, 0xc7, 0x75,
+	0xbe, 0x35, 0x09, 0x3c, 0xee, 0x41, 0x12, 0xda, 0xe3, 0x23, 0x09, 0xdf, 0x26, 0x00, 0xde, 0xf2,
+	0x2f, 0x56, 0xb1, 0xed, 0xdb, 0xee, 0x0d, 0x2c, 0x33, 0x5c, 0x15, 0x5b, 0x10, 0x54, 0x4d, 0x63,
+	0x0d, 0xb7, 0xd0, 0xb3, 0x9d, 0x10, 0x66, 0x79, 0xce, 0xe9, 0x5f, 0x9c, 0x80, 0x5e, 0xce, 0x12,
+	0xfd, 0x90, 0xc0, 0x60, 0xf8, 0xfa, 0x87, 0x5e, 0x48, 0x22, 0x3c, 0xe9, 0x12, 0x4b, 0x9a, 0xea,
+	0xc0, 0x43, 0xb4, 0x82, 0x3c, 0xf9, 0xe6, 0x9f, 0xff, 0xf5, 0xa3, 0x9e, 0x93, 0x54, 0x56, 0x12,
+	0xae, 0xcf, 0xbc, 0xb5, 0x54, 0x5c, 0xda, 0xd1, 0x9f, 0x10, 0xe8, 0xf7, 0xef, 0x22, 0xe8, 0xb9,
+	0xd4, 0x5c, 0x6d, 0xb7, 0x32, 0xd2, 0xf9, 0x9c, 0xd6, 0x88, 0xea, 0x02, 0x47, 0x35, 0x49, 0x27,
+	0x94, 0xb4, 0x5b, 0x44, 0x65, 0xdd, 0xd7, 0x60, 0x37, 0xe8, 0x7b, 0x3d, 0x30, 0x1c, 0x77, 0x4f,
+	0x42, 0x2f, 0xe7, 0xca, 0x1c, 0x73, 0x79, 0x23, 0x5d, 0xd9, 0x82, 0x27, 0xe2, 0x7f, 0x97, 0xf0,
+	0x02, 0xde, 0x22, 0x8b, 0x2f, 0xd1, 0xaf, 0x28, 0xa9, 0xd7, 0xa5, 0xca, 0x7a, 0x6b, 0xa7, 0xb4,
+	0xe1, 0x97, 0x15, 0x5a, 0xb3, 0x37, 0xe8, 0xf5, 0x54, 0x0e, 0x9c, 0xb8, 0x30, 0xd1, 0x00, 0xff,
+	0x21, 0xb0, 0xbf, 0xed, 0x76, 0x84, 0x96, 0xb2, 0x6a, 0x8b, 0xb9, 0x15, 0x92, 0x2e, 0x76, 0xe6,
+	0x84, 0x5c, 0x98, 0x9c, 0x8a, 0x07, 0x8b, 0x25, 0x3a, 0xd5, 0x29, 0x13, 0x4e, 0xb2, 0x4b, 0x62,
+	0xf1, 0xf4, 0x63, 0x02, 0x43, 0xd1, 0xfb, 0x08, 0x3a, 0x9d, 0xd9, 0x92, 0x9b, 0x2e, 0x66, 0xa4,
+	0x52, 0x47, 0x3e, 0x58, 0xeb, 0x45, 0x5e, 0x6b, 0x91, 0x9e, 0xcb, 0x80, 0xcd, 0xef, 0x72, 0x94,
+	0x75, 0xfe, 0xa7, 0x85, 0x38, 0xa4, 0xef, 0x67, 0x23, 0xde, 0x7c, 0x9d, 0x21, 0x95, 0x3a, 0xf2,
+	0xe9, 0x10, 0x31, 0xbf, 0x1b, 0x51, 0xd6, 0xf9, 0x9f, 0x0d, 0xfa, 0x3e, 0x81, 0xc1, 0xb0, 0x1a,
+	0x9f, 0x31, 0x57, 0xc5, 0xdc, 0x0e, 0x48, 0x53, 0x1d, 0x78, 0x20, 0xd6, 0xd3, 0x1c, 0xeb, 0x38,
+	0x1d, 0x4d, 0xc7, 0x4a, 0x3f, 0x21, 0xb0, 0x2f, 0xa2, 0x8f, 0xd3, 0xcc, 0x64, 0x9b, 0xa4, 0x7b,
+	0x69, 0xba, 0x13, 0x17, 0x04, 0x78, 0x93, 0x03, 0x9c, 0x4d, 0x1e, 0xb2, 0x31, 0x1d, 0x3d, 0x10,
+	0x1a, 0x95, 0x75, 0x94, 0xbc, 0x37, 0xe8, 0x1f, 0x09, 0x1c, 0x8a, 0xd5, 0xbb, 0x69, 0xe6, 0xa4,
+	0x94, 0x28, 0xbe, 0x4b, 0x57, 0xb7, 0xe2, 0x8a, 0x95, 0x5d, 0xe3, 0x95, 0xbd, 0x48, 0x2f, 0x29,
+	0xd9, 0xff, 0x4b, 0x44, 0xc1, 0x32, 0x42, 0xf5, 0x7c, 0x4f, 0xcc, 0xce, 0x9b, 0x64, 0xec, 0xec,
+	0xd9, 0x39, 0x49, 0x83, 0x97, 0xae, 0x6c, 0xc1, 0x13, 0x8b, 0x79, 0xc4, 0x8b, 0xb1, 0x17, 0x2f,
+	0xd3, 0x99, 0x2d, 0x35, 0x94, 0x93, 0xec, 0x17, 0xa6, 0x21, 0x7e, 0x6e, 0x3a, 0xb0, 0x49, 0xad,
+	0xa6, 0x97, 0x72, 0x0c, 0x85, 0x18, 0x06, 0x66, 0x3a, 0x75, 0xc3, 0xf2, 0xcf, 0xf2, 0xf2, 0x4f,
+	0xd1, 0x13, 0x39, 0x8a, 0xa0, 0x1f, 0x10, 0x18, 0x68, 0x91, 0x49, 0xcf, 0xe7, 0x23, 0xdd, 0x47,
+	0x58, 0xcc, 0x6b, 0x8e, 0xc8, 0xa6, 0x39, 0xb2, 0x73, 0x74, 0x32, 0x7f, 0xb3, 0xd0, 0x0f, 0xc5,
+	0x60, 0x0f, 0xc4, 0x62, 0x9a, 0x67, 0x66, 0x89, 0xca, 0xd7, 0xd2, 0x74, 0x27, 0x2e, 0x08, 0xf6,
+	0x0c, 0x07, 0x7b, 0x9c, 0x8e, 0xa5, 0x83, 0x75, 0xe8, 0x3b, 0x04, 0xfa, 0x84, 0xb4, 0x4b, 0x27,
+	0x53, 0xf3, 0x44, 0xd4, 0x64, 0xe9, 0x6c, 0x2e, 0xdb, 0xbc, 0x53, 0xa3, 0xd0, 0x94, 0xe9, 0xdf,
+	0x08, 0x1c, 0x49, 0x91, 0x63, 0xe9, 0xf5, 0xd4, 0xa4, 0xd9, 0x42, 0xb4, 0xf4, 0xd2, 0xd6, 0x03,
+	0x60, 0x29, 0x57, 0x79, 0x29, 0x17, 0xe9, 0x74, 0xea, 0x8e, 0x34, 0xe8, 0xa3, 0x95, 0x90, 0x58,
+	0xfd, 0x7b, 0x02, 0xc3, 0x71, 0xca, 0x57, 0xc6, 0x3c, 0x93, 0xa2, 0xdb, 0x49, 0x57, 0xb6, 0xe0,
+	0x89, 0x95, 0xcc, 0xf0, 0x4a, 0x2e, 0xd0, 0x62, 0x52, 0x25, 0x4d, 0xf4, 0x56, 0x22, 0xca, 0x20,
+	0xfd, 0x2f, 0x81, 0xa1, 0xa8, 0x38, 0x96, 0xb1, 0x1f, 0x88, 0x15, 0xe1, 0xa4, 0x52, 0x47, 0x3e,
+	0x88, 0xd9, 0xe6, 0x98, 0x8d, 0xc5, 0x4b, 0xb4, 0xd4, 0xc1, 0xdc, 0xe8, 0x17, 0x92, 0xec, 0xd4,
+	0x2a, 0x35, 0x66, 0x08, 0xff, 0x96, 0x00, 0xdd, 0xac, 0xa9, 0xd1, 0x99, 0x9c, 0xf8, 0xdb, 0x64,
+	0x3a, 0xe9, 0xc5, 0x8e, 0xfd, 0xf2, 0xee, 0x85, 0x42, 0x45, 0xb4, 0x74, 0x46, 0xfa, 0x3f, 0x02,
+	0x10, 0x48, 0x1f, 0x34, 0x73, 0xce, 0x8b, 0x8a, 0x7a, 0x92, 0x92, 0xdb, 0x1e, 0x51, 0xfe, 0x40,
+	0x9c, 0x2d, 0xde, 0x26, 0x8b, 0x29, 0xe7, 0x23, 0x3c, 0x84, 0x2b, 0xeb, 0x42, 0x39, 0xdb, 0x48,
+	0x5b, 0xeb, 0xda, 0x6d, 0xdb, 0x8e, 0x0f, 0x63, 0x19, 0x7e, 0xf4, 0xa9, 0xd8, 0xac, 0x6c, 0x16,
+	0xd2, 0xb2, 0x37, 0x2b, 0x89, 0xe2, 0xa0, 0x74, 0x75, 0x2b, 0xae, 0xc8, 0xd0, 0x65, 0x4e, 0xd0,
+	0x34, 0xbd, 0x90, 0x81, 0xdc, 0x51, 0x44, 0xc5, 0xad, 0xca, 0xe3, 0x4a, 0x11, 0x32, 0x56, 0x67,
+	0xa5, 0x44, 0xa4, 0x39, 0xe9, 0xea, 0x56, 0x5c, 0x3b, 0x2e, 0x45, 0xa8, 0x7a, 0xca, 0xba, 0xf8,
+	0xbb, 0x41, 0x1f, 0xe3, 0xa1, 0x22, 0x90, 0x9f, 0x68, 0x9e, 0x55, 0xae, 0x4d, 0x12, 0x93, 0x4a,
+	0x1d, 0xf9, 0x20, 0xea, 0x09, 0x8e, 0x5a, 0xa6, 0xe3, 0x59, 0xa8, 0xe9, 0x2f, 0x09, 0x0c, 0x45,
+	0xf5, 0xa1, 0x0c, 0x94, 0xb1, 0x62, 0x95, 0x54, 0xea, 0xc8, 0x07, 0x51, 0x9e, 0xe3, 0x28, 0x4f,
+	0xd3, 0x93, 0xa9, 0x0b, 0x0d, 0x42, 0x9d, 0x63, 0x4f, 0x9f, 0x8d, 0x92, 0x4f, 0x9f, 0x8d, 0x92,
+	0x7f, 0x3e, 0x1b, 0x25, 0x3f, 0x7c, 0x3e, 0xba, 0xeb, 0xd3, 0xe7, 0xa3, 0xbb, 0xfe, 0xf2, 0x7c,
+	0x74, 0x17, 0x8c, 0xe8, 0x56, 0x42, 0xfa, 0x05, 0xb2, 0x58, 0x0c, 0x49, 0x45, 0x81, 0xd1, 0x79,
+	0xdd, 0x0a, 0x27, 0x7d, 0xd4, 0x4a, 0xbb, 0xd4, 0xc7, 0xff, 0xaf, 0x72, 0xe9, 0xff, 0x03, 0x00,
+	0x29, 0xf3, 0x5e, 0x5e, 0x78, 0x2e, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -4883,6 +4894,20 @@ func (m *QueryCommitmentSettlementFeeCalcResponse) MarshalToSizedBuffer(dAtA []b
 	_ = i
 	var l int
 	_ = l
+	if len(m.InKindFees) > 0 {
+		for iNdEx := len(m.InKindFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.InKindFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if m.ToFeeNav != nil {
 		{
 			size, err := m.ToFeeNav.MarshalToSizedBuffer(dAtA[:i])
@@ -6124,6 +6149,12 @@ func (m *QueryCommitmentSettlementFeeCalcResponse) Size() (n int) {
 		l = m.ToFeeNav.Size()
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	if len(m.InKindFees) > 0 {
+		for _, e := range m.InKindFees {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -9676,6 +9707,40 @@ func (m *QueryCommitmentSettlementFeeCalcResponse) Unmarshal(dAtA []byte) error
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InKindFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InKindFees = append(m.InKindFees, types.Coin{})
+			if err := m.InKindFees[len(m.InKindFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2889,6 +2889,12 @@ type MsgGovManageFeesRequest struct {
 	// unset_fee_commitment_settlement_bips, if true, sets the fee_commitment_settlement_bips to zero.
 	// If false, it is ignored.
 	UnsetFeeCommitmentSettlementBips bool `protobuf:"varint,18,opt,name=unset_fee_commitment_settlement_bips,json=unsetFeeCommitmentSettlementBips,proto3" json:"unset_fee_commitment_settlement_bips,omitempty"`
+	// set_fee_commitment_settlement_in_kind, if true, sets the market's commitment_settlement_fee_in_kind to true.
+	// If false, it is ignored.
+	SetFeeCommitmentSettlementInKind bool `protobuf:"varint,19,opt,name=set_fee_commitment_settlement_in_kind,json=setFeeCommitmentSettlementInKind,proto3" json:"set_fee_commitment_settlement_in_kind,omitempty"`
+	// unset_fee_commitment_settlement_in_kind, if true, sets the market's commitment_settlement_fee_in_kind to false.
+	// If false, it is ignored.
+	UnsetFeeCommitmentSettlementInKind bool `protobuf:"varint,20,opt,name=unset_fee_commitment_settlement_in_kind,json=unsetFeeCommitmentSettlementInKind,proto3" json:"unset_fee_commitment_settlement_in_kind,omitempty"`
 }
 
 func (m *MsgGovManageFeesRequest) Reset()         { *m = MsgGovManageFeesRequest{} }
@@ -3050,6 +3056,20 @@ func (m *MsgGovManageFeesRequest) GetUnsetFeeCommitmentSettlementBips() bool {
 	return false
 }
 
+func (m *MsgGovManageFeesRequest) GetSetFeeCommitmentSettlementInKind() bool {
+	if m != nil {
+		return m.SetFeeCommitmentSettlementInKind
+	}
+	return false
+}
+
+func (m *MsgGovManageFeesRequest) GetUnsetFeeCommitmentSettlementInKind() bool {
+	if m != nil {
+		return m.UnsetFeeCommitmentSettlementInKind
+	}
+	return false
+}
+
 // MsgGovManageFeesResponse is a response message for the GovManageFees endpoint.
 type MsgGovManageFeesResponse struct {
 }
@@ -3435,184 +3455,185 @@ func init() {
 func init() { proto.RegisterFile("provenance/exchange/v1/tx.proto", fileDescriptor_e333fcffc093bd1b) }
 
 var fileDescriptor_e333fcffc093bd1b = []byte{
-	// 2830 bytes of a gzipped FileDescriptorProto
+	// 2843 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5b, 0xdd, 0x6f, 0x1c, 0x57,
-	0x15, 0xcf, 0x78, 0xfd, 0xb5, 0xc7, 0x1f, 0x4d, 0xae, 0xed, 0x64, 0x3d, 0xae, 0xd7, 0x9b, 0x4d,
-	0x03, 0xc6, 0xc5, 0xbb, 0xb1, 0x2b, 0x5a, 0x30, 0x2d, 0xa9, 0xd7, 0x89, 0x23, 0x57, 0x4a, 0xb0,
-	0x36, 0x09, 0x48, 0xe5, 0x61, 0x75, 0xbd, 0x73, 0xb3, 0x19, 0x3c, 0x3b, 0xb3, 0x99, 0x3b, 0xeb,
-	0xd8, 0x12, 0x08, 0x84, 0x2a, 0x01, 0x0f, 0x95, 0x2a, 0x21, 0x5e, 0x10, 0x42, 0x02, 0x24, 0x04,
-	0xe4, 0x81, 0x20, 0x10, 0xe2, 0xe3, 0x91, 0x97, 0x3e, 0xf4, 0xa1, 0xe2, 0x89, 0x27, 0xa8, 0x12,
-	0x89, 0xfc, 0x17, 0x08, 0xdd, 0x8f, 0xd9, 0x9d, 0xef, 0x99, 0x75, 0xb3, 0x11, 0x2f, 0x6d, 0x76,
-	0xe6, 0x9c, 0xf3, 0x3b, 0xbf, 0x73, 0xee, 0x9d, 0x7b, 0xe6, 0x9c, 0x31, 0xac, 0x74, 0x6c, 0xeb,
-	0x88, 0x98, 0xd8, 0x6c, 0x92, 0x2a, 0x39, 0x6e, 0xde, 0xc7, 0x66, 0x8b, 0x54, 0x8f, 0x36, 0xaa,
-	0xce, 0x71, 0xa5, 0x63, 0x5b, 0x8e, 0x85, 0xce, 0xf7, 0x05, 0x2a, 0xae, 0x40, 0xe5, 0x68, 0x43,
-	0x3d, 0x87, 0xdb, 0xba, 0x69, 0x55, 0xf9, 0x7f, 0x85, 0xa8, 0x5a, 0x6c, 0x5a, 0xb4, 0x6d, 0xd1,
-	0xea, 0x01, 0xa6, 0xcc, 0xc6, 0x01, 0x71, 0xf0, 0x46, 0xb5, 0x69, 0xe9, 0xa6, 0xbc, 0x7f, 0x41,
-	0xde, 0x6f, 0xd3, 0x16, 0x83, 0x68, 0xd3, 0x96, 0xbc, 0xb1, 0x28, 0x6e, 0x34, 0xf8, 0xaf, 0xaa,
-	0xf8, 0x21, 0x6f, 0xcd, 0xb7, 0xac, 0x96, 0x25, 0xae, 0xb3, 0x7f, 0xc9, 0xab, 0xab, 0x31, 0x5e,
-	0x37, 0xad, 0x76, 0x5b, 0x77, 0xda, 0xc4, 0x74, 0x5c, 0xfd, 0x4b, 0x31, 0x92, 0x6d, 0x6c, 0x1f,
-	0x12, 0x27, 0x45, 0xc8, 0xb2, 0x35, 0x62, 0xa7, 0x59, 0xea, 0x60, 0x1b, 0xb7, 0x5d, 0xa1, 0xcb,
-	0xb1, 0x42, 0x27, 0x1e, 0xaf, 0xca, 0x7f, 0x50, 0x60, 0xee, 0x26, 0x6d, 0xed, 0xd8, 0x04, 0x3b,
-	0x64, 0x9b, 0x1e, 0xd6, 0xc9, 0x83, 0x2e, 0xa1, 0x0e, 0xda, 0x81, 0x3c, 0xa6, 0x87, 0x0d, 0x8e,
-	0x5b, 0x50, 0x4a, 0xca, 0xea, 0xd4, 0x66, 0xa9, 0x12, 0x9d, 0x80, 0xca, 0x36, 0x3d, 0xfc, 0x2a,
-	0x93, 0xab, 0x8d, 0x7e, 0xf8, 0xaf, 0x95, 0x33, 0xf5, 0x49, 0x2c, 0x7f, 0xa3, 0x1b, 0x80, 0xb8,
-	0x81, 0x46, 0x93, 0x99, 0xd7, 0x2d, 0xb3, 0x71, 0x8f, 0x90, 0xc2, 0x08, 0xb7, 0xb6, 0x58, 0x91,
-	0xd1, 0x65, 0x39, 0xaa, 0xc8, 0x1c, 0x55, 0x76, 0x2c, 0xdd, 0xac, 0x9f, 0xe5, 0x4a, 0x3b, 0x52,
-	0x67, 0x97, 0x90, 0xad, 0xd9, 0xef, 0x3d, 0x7b, 0xbc, 0xd6, 0x77, 0xa8, 0xbc, 0x01, 0xf3, 0x7e,
-	0xa7, 0x69, 0xc7, 0x32, 0x29, 0x41, 0x8b, 0x30, 0x29, 0x00, 0x75, 0x8d, 0x3b, 0x3d, 0x5a, 0x9f,
-	0xe0, 0xbf, 0xf7, 0x34, 0x3f, 0xd1, 0x9a, 0xae, 0x79, 0x88, 0x1e, 0xe8, 0x5a, 0x36, 0xa2, 0x35,
-	0x5d, 0xf3, 0x11, 0x3d, 0x90, 0xbf, 0x9f, 0x37, 0xd1, 0x9e, 0x43, 0x3e, 0xa2, 0xdc, 0xe9, 0x74,
-	0xa2, 0x1f, 0x8d, 0xc0, 0x02, 0xd3, 0xe1, 0x0b, 0x70, 0xb7, 0x6b, 0x6a, 0xd4, 0xa5, 0xba, 0x09,
-	0x13, 0xb8, 0xd9, 0xb4, 0xba, 0xa6, 0xc3, 0x75, 0xf2, 0xb5, 0xc2, 0x3f, 0xfe, 0xb8, 0x3e, 0x2f,
-	0xbd, 0xdb, 0xd6, 0x34, 0x9b, 0x50, 0x7a, 0xdb, 0xb1, 0x75, 0xb3, 0x55, 0x77, 0x05, 0xd1, 0x12,
-	0xe4, 0xc5, 0x02, 0x65, 0x48, 0x8c, 0xd0, 0x4c, 0x7d, 0x52, 0x5c, 0xd8, 0xd3, 0xd0, 0x09, 0x8c,
-	0xe3, 0x36, 0xb7, 0x97, 0x2b, 0xe5, 0x12, 0xa9, 0xd6, 0x76, 0x59, 0xc4, 0x7e, 0xfb, 0xef, 0x95,
-	0xd5, 0x96, 0xee, 0xdc, 0xef, 0x1e, 0x54, 0x9a, 0x56, 0x5b, 0x6e, 0x2f, 0xf9, 0xbf, 0x75, 0xaa,
-	0x1d, 0x56, 0x9d, 0x93, 0x0e, 0xa1, 0x5c, 0x81, 0xfe, 0xe4, 0xd9, 0xe3, 0xb5, 0x69, 0x83, 0xb4,
-	0x70, 0xf3, 0xa4, 0xc1, 0x76, 0x2e, 0xfd, 0xf5, 0xb3, 0xc7, 0x6b, 0x4a, 0x5d, 0x02, 0xa2, 0x37,
-	0x61, 0xda, 0x17, 0xeb, 0xd1, 0xb4, 0x58, 0x4f, 0x35, 0xfb, 0x61, 0x66, 0xac, 0xc8, 0x11, 0x31,
-	0x9d, 0x86, 0x83, 0x5b, 0x85, 0x31, 0x16, 0x8b, 0xfa, 0x24, 0xbf, 0x70, 0x07, 0xb7, 0xb6, 0xa6,
-	0x59, 0x0e, 0xdc, 0x00, 0x94, 0x0b, 0x70, 0x3e, 0x18, 0x4d, 0x91, 0x83, 0xf2, 0x03, 0x11, 0x67,
-	0xb6, 0x4a, 0x0c, 0xbe, 0x0c, 0xdc, 0x38, 0x5f, 0x81, 0x71, 0xaa, 0xb7, 0x4c, 0xb9, 0x9e, 0x92,
-	0xc2, 0x2c, 0xe5, 0x7c, 0xe9, 0x1c, 0xf1, 0xa5, 0x73, 0x6b, 0x8a, 0x79, 0x23, 0xe5, 0x5c, 0x67,
-	0xbc, 0x90, 0xd2, 0x99, 0xbf, 0xe7, 0x00, 0xdd, 0xa4, 0xad, 0x5d, 0xdd, 0x30, 0x6a, 0x7a, 0x3f,
-	0xe5, 0xcc, 0x15, 0x62, 0x18, 0x99, 0x5c, 0xe1, 0x72, 0xc9, 0x09, 0x7f, 0x4f, 0x81, 0x69, 0xc7,
-	0x72, 0xb0, 0xd1, 0xc0, 0x94, 0x12, 0x87, 0xbe, 0xb8, 0xbc, 0x4f, 0x71, 0xd8, 0x6d, 0x8e, 0x8a,
-	0xca, 0x30, 0xd3, 0xdb, 0x22, 0x0d, 0x5d, 0xa3, 0x85, 0xd1, 0x52, 0x6e, 0x75, 0xb4, 0x3e, 0xe5,
-	0xee, 0xc7, 0x3d, 0x8d, 0xa2, 0xaf, 0x81, 0x2a, 0x18, 0x35, 0x28, 0x71, 0x1c, 0x83, 0xb0, 0x87,
-	0x5e, 0xe3, 0x9e, 0x81, 0x1d, 0xbe, 0x5c, 0xc6, 0xd2, 0x96, 0xcb, 0x05, 0xa1, 0x7c, 0xbb, 0xa7,
-	0xbb, 0x6b, 0x60, 0x87, 0x2d, 0x9d, 0x5b, 0x70, 0xbe, 0xf7, 0x1c, 0xf2, 0x6f, 0xf7, 0xf1, 0x34,
-	0x9b, 0x73, 0xee, 0x83, 0xd1, 0xbb, 0xe3, 0x65, 0x7e, 0x39, 0x5a, 0x79, 0x81, 0x3f, 0xa3, 0xfa,
-	0x49, 0x94, 0xc9, 0xfd, 0x5b, 0x3f, 0xb9, 0xdb, 0xf4, 0xb0, 0x97, 0xdc, 0x0a, 0x8c, 0x1d, 0x74,
-	0x4f, 0x32, 0xe4, 0x56, 0x88, 0x25, 0xa7, 0xf6, 0x6d, 0x10, 0x21, 0x6e, 0x74, 0x6c, 0xbd, 0x49,
-	0x0a, 0xb9, 0x14, 0x32, 0xf2, 0x11, 0x08, 0x5c, 0x67, 0x9f, 0xa9, 0xb0, 0xac, 0xf4, 0x23, 0xe3,
-	0xc9, 0x8a, 0xcb, 0x9a, 0x65, 0xe5, 0xc7, 0x0a, 0x2c, 0x70, 0x67, 0x7c, 0x59, 0x21, 0x84, 0x16,
-	0xc6, 0x5e, 0xd4, 0x4a, 0x9a, 0xe3, 0xf8, 0x9e, 0xc4, 0x12, 0x42, 0x59, 0x56, 0xfb, 0x2b, 0x6a,
-	0xc0, 0xac, 0xba, 0xab, 0xce, 0x9b, 0x55, 0x60, 0x59, 0x15, 0x61, 0xf7, 0x24, 0x55, 0x24, 0x4f,
-	0x26, 0xf5, 0x13, 0x85, 0x6f, 0xe6, 0x9b, 0x3c, 0x01, 0xc2, 0x1d, 0x4f, 0x62, 0xb1, 0xd6, 0xd6,
-	0xcd, 0xf4, 0xc4, 0x72, 0xb1, 0xe4, 0xc4, 0x86, 0xd2, 0x92, 0x0b, 0xa7, 0x25, 0xcb, 0x86, 0xba,
-	0x0c, 0xb3, 0xe4, 0xb8, 0x43, 0x9a, 0x4e, 0xa3, 0x83, 0x6d, 0x47, 0xc7, 0x06, 0xdf, 0x44, 0x93,
-	0xf5, 0x19, 0x71, 0x75, 0x5f, 0x5c, 0x94, 0xcc, 0xb9, 0x5f, 0xe5, 0x45, 0xb8, 0x10, 0x62, 0x28,
-	0xd9, 0xff, 0x2a, 0x07, 0xa5, 0xde, 0xbd, 0x9d, 0x5e, 0xb1, 0x34, 0xc4, 0x38, 0xec, 0xc0, 0xb8,
-	0x6e, 0x76, 0xba, 0xbd, 0x87, 0xd6, 0xe5, 0xd8, 0x72, 0x46, 0x3c, 0xf9, 0xb7, 0xf9, 0x41, 0x23,
-	0xd7, 0xb9, 0x54, 0x45, 0xd7, 0x61, 0xc2, 0xea, 0x3a, 0xdc, 0xca, 0xe8, 0xe0, 0x56, 0x5c, 0x5d,
-	0x74, 0x15, 0x46, 0x3d, 0x8b, 0x7e, 0x20, 0x1b, 0x5c, 0x91, 0x19, 0x30, 0xf1, 0x11, 0x2d, 0x8c,
-	0x27, 0x1b, 0xb8, 0x45, 0x1c, 0xfe, 0xc8, 0xe4, 0x1b, 0xd4, 0x35, 0xc0, 0x14, 0xfd, 0x27, 0xe0,
-	0x44, 0xe0, 0x04, 0xf4, 0xe6, 0xf0, 0x12, 0x5c, 0x4c, 0xc8, 0x93, 0xcc, 0xe6, 0x7f, 0x14, 0x28,
-	0xf7, 0xa4, 0xea, 0xc4, 0x20, 0x98, 0x92, 0xbe, 0x30, 0x1d, 0x4a, 0x3e, 0xdf, 0x01, 0x70, 0xac,
-	0x86, 0x2d, 0xc0, 0x4e, 0x93, 0xd3, 0xbc, 0x63, 0x49, 0x57, 0xfd, 0xd1, 0x18, 0x4d, 0x88, 0xc6,
-	0x65, 0xb8, 0x94, 0xc8, 0x53, 0xc6, 0xe3, 0x2f, 0xde, 0x78, 0xdc, 0x26, 0x0e, 0xdf, 0x44, 0xd7,
-	0x8f, 0x1d, 0x62, 0x9b, 0xd8, 0xd8, 0xbb, 0x36, 0x94, 0x78, 0x78, 0x6b, 0x88, 0x9c, 0xaf, 0x86,
-	0x40, 0x2b, 0x30, 0x45, 0x24, 0x38, 0xbb, 0x2b, 0x08, 0x82, 0x7b, 0x69, 0x4f, 0x8b, 0xa5, 0x18,
-	0xe5, 0xba, 0xa4, 0xf8, 0xfe, 0x08, 0x14, 0x7a, 0x72, 0x5f, 0xd7, 0x9d, 0xfb, 0x9a, 0x8d, 0x1f,
-	0x0e, 0x85, 0xd8, 0x32, 0x4f, 0x34, 0x16, 0x7a, 0x9c, 0x5a, 0x9e, 0xe5, 0x4e, 0x1a, 0xf2, 0x14,
-	0xa1, 0xa3, 0x2f, 0xb8, 0x08, 0xf5, 0x85, 0x6d, 0x09, 0x16, 0x23, 0xc2, 0x21, 0x83, 0xf5, 0x91,
-	0x02, 0xcb, 0xbd, 0xbb, 0x77, 0x3b, 0x1a, 0x76, 0xc8, 0x35, 0xe2, 0x60, 0xdd, 0x18, 0xce, 0xd6,
-	0xa8, 0xc3, 0xac, 0xbc, 0xa9, 0x09, 0x14, 0x79, 0x9c, 0xc7, 0x6e, 0x0f, 0xe1, 0x98, 0x74, 0x49,
-	0x6e, 0x8f, 0x99, 0xb6, 0xf7, 0xa2, 0x8f, 0x6b, 0x09, 0x8a, 0x71, 0x6c, 0x24, 0xe1, 0xdf, 0x85,
-	0x09, 0x5f, 0x37, 0xf1, 0x81, 0x41, 0xb4, 0x7e, 0x65, 0xea, 0x23, 0xac, 0xc6, 0x11, 0x2e, 0x28,
-	0x2e, 0xe5, 0x95, 0x10, 0xe5, 0xda, 0x48, 0x41, 0xf1, 0xd0, 0x5e, 0x87, 0xb3, 0xb8, 0xd9, 0x24,
-	0x1d, 0x47, 0x37, 0x5b, 0xe2, 0x2c, 0x13, 0xc4, 0x27, 0xb9, 0xdc, 0x4b, 0xbd, 0x7b, 0x7c, 0x49,
-	0x53, 0x51, 0xe7, 0xbb, 0x4e, 0x94, 0x5f, 0x09, 0x71, 0xea, 0x39, 0x2c, 0x38, 0x6d, 0x8d, 0x14,
-	0x94, 0xf2, 0x23, 0x05, 0x2e, 0x07, 0xc4, 0xb6, 0xfd, 0x66, 0x87, 0x92, 0xd0, 0xcf, 0xc5, 0x31,
-	0x0b, 0xb3, 0xf2, 0xe6, 0x69, 0x15, 0x3e, 0x93, 0xe6, 0x6c, 0x3f, 0x5f, 0xa5, 0x80, 0xe8, 0x5d,
-	0xea, 0x56, 0x49, 0x43, 0xa1, 0xb4, 0x09, 0x0b, 0xd8, 0x30, 0xac, 0x87, 0x8d, 0x2e, 0xf5, 0x55,
-	0x83, 0x92, 0xd7, 0x1c, 0xbf, 0xd9, 0xf7, 0x81, 0xdd, 0x8a, 0x3d, 0x97, 0xc2, 0x0e, 0x4b, 0x5a,
-	0x7f, 0x55, 0x60, 0x2d, 0x2e, 0x02, 0xc3, 0x3e, 0x9f, 0x5e, 0x83, 0x85, 0x7e, 0xce, 0x3c, 0xed,
-	0x20, 0x49, 0x70, 0x1e, 0x47, 0x38, 0xe2, 0x63, 0xb8, 0x0e, 0xaf, 0x66, 0xf2, 0x5d, 0x72, 0xfd,
-	0xbd, 0x02, 0x9f, 0x0d, 0xc8, 0xef, 0x99, 0x0e, 0xb1, 0xdb, 0x44, 0xd3, 0xb1, 0x7d, 0x72, 0x8d,
-	0x98, 0x56, 0x7b, 0x28, 0x44, 0xd7, 0x01, 0xe9, 0x1e, 0xa0, 0x86, 0xc6, 0x90, 0xe4, 0x73, 0xfa,
-	0x9c, 0x1e, 0x74, 0xc1, 0x47, 0x71, 0x0d, 0x56, 0xd3, 0x5d, 0x96, 0xfc, 0x7e, 0x33, 0xe2, 0xc9,
-	0xf8, 0x4d, 0x6c, 0xe2, 0x16, 0xd9, 0x27, 0x76, 0x5b, 0xa7, 0x54, 0xb7, 0x4c, 0x3a, 0xac, 0x93,
-	0xc7, 0x26, 0x47, 0xd6, 0x21, 0x69, 0x60, 0xc3, 0xe0, 0x25, 0x46, 0xbe, 0x9e, 0x17, 0x57, 0xb6,
-	0x0d, 0x03, 0xed, 0x42, 0x9e, 0x57, 0x20, 0xec, 0xb7, 0x3c, 0x7c, 0x2e, 0x25, 0x14, 0x20, 0x84,
-	0xd2, 0x1b, 0x36, 0xee, 0x95, 0x1f, 0x93, 0xac, 0xfc, 0x60, 0xaa, 0xe8, 0x1a, 0x4c, 0x3a, 0x56,
-	0xa3, 0xc5, 0xee, 0xc9, 0x8a, 0x70, 0x00, 0x33, 0x13, 0x8e, 0xc5, 0x7f, 0xfa, 0xe2, 0xfa, 0x8a,
-	0xa7, 0xfc, 0x88, 0x08, 0x95, 0x1b, 0xd1, 0x9c, 0xe7, 0x99, 0x27, 0xc4, 0xea, 0xe4, 0xc1, 0xb6,
-	0xe3, 0x0c, 0xed, 0x29, 0x76, 0x8e, 0xbf, 0x5a, 0x91, 0x06, 0x7b, 0x21, 0x11, 0x67, 0xba, 0x8c,
-	0xea, 0x6c, 0xd3, 0xed, 0xe5, 0xdd, 0x61, 0x07, 0x3b, 0xaa, 0xc2, 0xbc, 0x5f, 0xd4, 0x26, 0x6d,
-	0xeb, 0x48, 0x44, 0x39, 0x5f, 0x3f, 0xe7, 0x91, 0xae, 0xf3, 0x1b, 0x1e, 0xdb, 0xec, 0x45, 0x46,
-	0xda, 0x1e, 0xf3, 0xda, 0xae, 0xe9, 0x5a, 0xd0, 0xb6, 0x14, 0x95, 0xb6, 0xc7, 0xbd, 0xb6, 0xb9,
-	0xb4, 0xb4, 0xfd, 0x06, 0x14, 0xa4, 0x42, 0x7f, 0x1b, 0xbb, 0x10, 0x13, 0x5c, 0x69, 0x41, 0xdc,
-	0xef, 0x6f, 0x4b, 0x81, 0xf4, 0x16, 0x2c, 0x45, 0x2a, 0x4a, 0xc0, 0x49, 0xae, 0x5b, 0x08, 0xeb,
-	0x0a, 0x5c, 0x5f, 0x46, 0x2f, 0xc2, 0x4a, 0x6c, 0xaa, 0x64, 0x3a, 0xdf, 0xe5, 0x6f, 0x5b, 0xa2,
-	0x57, 0xb8, 0x2f, 0xba, 0xbc, 0x6e, 0x1a, 0xaf, 0xc2, 0x84, 0xec, 0xfb, 0xca, 0x16, 0xe7, 0x4a,
-	0xdc, 0x02, 0x93, 0x8a, 0xee, 0xe2, 0x92, 0x5a, 0x65, 0x95, 0x17, 0x7b, 0x01, 0xdb, 0x3e, 0x5c,
-	0xf1, 0x6c, 0x1a, 0x0e, 0x6e, 0xc0, 0xb6, 0xc4, 0x7d, 0xa4, 0x70, 0xe0, 0x3a, 0xf9, 0x26, 0x7f,
-	0xfd, 0xf4, 0x01, 0x5f, 0x81, 0x71, 0x07, 0xdb, 0x2d, 0x92, 0xde, 0xe9, 0x94, 0x72, 0xbc, 0x53,
-	0x66, 0x75, 0xed, 0xa6, 0x68, 0xdb, 0x26, 0x77, 0xca, 0xb8, 0x5c, 0xb0, 0xaa, 0xce, 0x85, 0xaa,
-	0x6a, 0xd1, 0xda, 0x11, 0xf6, 0x25, 0x93, 0x80, 0xb3, 0x6e, 0x2d, 0xad, 0x84, 0x6f, 0xd2, 0xd3,
-	0x53, 0xd9, 0x84, 0x09, 0xe1, 0x22, 0x2d, 0x8c, 0xb0, 0x25, 0x96, 0xd4, 0xe7, 0x95, 0x82, 0x7e,
-	0x5f, 0x45, 0x2d, 0x1b, 0x74, 0x47, 0x3a, 0xfb, 0x2d, 0xb1, 0x14, 0x78, 0x0f, 0x32, 0xc2, 0x57,
-	0x19, 0x44, 0x25, 0x63, 0x10, 0x2f, 0xc2, 0xb4, 0x27, 0x88, 0xd2, 0xe1, 0xfa, 0x54, 0x3f, 0x8a,
-	0xae, 0x6b, 0x42, 0x5e, 0xba, 0x16, 0x44, 0x97, 0xae, 0xfd, 0x59, 0x54, 0x9d, 0x3b, 0x7c, 0x55,
-	0xc9, 0xbb, 0x77, 0x38, 0xa5, 0xd3, 0x3b, 0x18, 0xc8, 0xf2, 0x48, 0x30, 0xcb, 0xe8, 0x0d, 0x00,
-	0x93, 0x3c, 0x6c, 0xc8, 0x1c, 0xe5, 0x52, 0xcc, 0xe6, 0x4d, 0xf2, 0x50, 0xb8, 0xe4, 0xe7, 0x25,
-	0x4a, 0xea, 0x48, 0xcf, 0x25, 0xb9, 0x9f, 0x2b, 0x9c, 0xfa, 0x0d, 0xeb, 0x48, 0x6c, 0x43, 0xf7,
-	0x25, 0x54, 0x10, 0x7b, 0x1d, 0xf2, 0xb8, 0xeb, 0xdc, 0xb7, 0x6c, 0xdd, 0x39, 0x49, 0xe5, 0xd6,
-	0x17, 0x45, 0x6f, 0xc2, 0xb8, 0x78, 0x3e, 0xcb, 0x69, 0x45, 0x31, 0xf9, 0x15, 0xc1, 0x6d, 0x87,
-	0x08, 0x1d, 0x77, 0x2e, 0xe3, 0x5a, 0x2b, 0xbf, 0x0c, 0x6a, 0x94, 0x8b, 0x92, 0xc1, 0x9f, 0x66,
-	0xf8, 0x86, 0xbd, 0x61, 0x1d, 0x89, 0x27, 0xd8, 0x2e, 0x21, 0xf4, 0xd3, 0xfa, 0x9f, 0x78, 0xe0,
-	0xdc, 0x85, 0x0b, 0x58, 0xd3, 0x1a, 0xf7, 0x08, 0x69, 0x78, 0x4e, 0x93, 0x7b, 0x06, 0xce, 0x30,
-	0xb0, 0x10, 0x44, 0xe7, 0xb0, 0xa6, 0xed, 0x12, 0xd2, 0x9b, 0x34, 0xed, 0x1a, 0xd8, 0x41, 0xdf,
-	0x00, 0x55, 0x3c, 0xc1, 0x23, 0x2d, 0x8f, 0x66, 0xb3, 0x7c, 0x5e, 0x98, 0x08, 0x19, 0x0f, 0xfb,
-	0xcc, 0x4e, 0x29, 0x6e, 0x79, 0xec, 0x14, 0x3e, 0xd7, 0x74, 0x2d, 0xde, 0xe7, 0x9e, 0xe5, 0xf1,
-	0xd3, 0xf9, 0xec, 0x1a, 0x6f, 0x42, 0xd1, 0xf5, 0x39, 0xba, 0xe7, 0xce, 0x8f, 0xc9, 0x0c, 0x00,
-	0xaa, 0x70, 0xfd, 0x76, 0x44, 0xef, 0x1d, 0xe9, 0x70, 0xd1, 0xc3, 0x20, 0x06, 0x67, 0x32, 0x1b,
-	0xce, 0x72, 0x8f, 0x48, 0x24, 0x94, 0x09, 0xa5, 0x78, 0x3e, 0x36, 0x76, 0x74, 0x8b, 0x16, 0xf2,
-	0x1c, 0x29, 0x76, 0x54, 0xb8, 0x4b, 0x48, 0x9d, 0x09, 0x4a, 0xc0, 0x97, 0xa3, 0x89, 0x71, 0x11,
-	0x8a, 0x1c, 0xb8, 0x94, 0x48, 0x4d, 0x42, 0xc2, 0x40, 0x90, 0x2b, 0xb1, 0x1c, 0x25, 0x2a, 0x86,
-	0x65, 0x97, 0x65, 0xb8, 0x25, 0xcf, 0x82, 0x39, 0x95, 0x2d, 0x98, 0x8b, 0x82, 0x5b, 0x2d, 0xd0,
-	0x56, 0x67, 0x81, 0x6c, 0x41, 0xc9, 0x43, 0x2c, 0x1a, 0x65, 0x3a, 0x1b, 0xca, 0xcb, 0x3d, 0x3a,
-	0x51, 0x40, 0x06, 0xac, 0xc4, 0x72, 0x91, 0xd1, 0x9b, 0x19, 0x28, 0x7a, 0x4b, 0x91, 0xa4, 0x64,
-	0xe4, 0x6c, 0x28, 0x27, 0xd1, 0x92, 0x80, 0xb3, 0x03, 0x01, 0x16, 0xe3, 0xf8, 0x49, 0x4c, 0xcf,
-	0x1e, 0x0b, 0xd7, 0x94, 0x3c, 0x90, 0x2f, 0x0d, 0xb4, 0xc7, 0x76, 0x02, 0x55, 0x67, 0xc4, 0x1e,
-	0x8b, 0xc1, 0x39, 0x3b, 0xe8, 0x1e, 0x8b, 0x84, 0x7a, 0x07, 0xca, 0x94, 0x38, 0x02, 0xa7, 0x0f,
-	0xe0, 0x89, 0xe2, 0x81, 0xde, 0xa1, 0x85, 0x73, 0xfc, 0x89, 0x5e, 0xa4, 0xc4, 0x61, 0x76, 0x02,
-	0xed, 0x67, 0x5e, 0x30, 0xea, 0x1d, 0x8a, 0x6e, 0xc1, 0x2b, 0x5d, 0x33, 0x83, 0x35, 0xc4, 0xdf,
-	0xbc, 0x4b, 0x5c, 0x36, 0xc1, 0x5e, 0xe8, 0x58, 0x13, 0xb5, 0x5b, 0xe0, 0xdc, 0x92, 0x87, 0xda,
-	0x77, 0xdc, 0x7b, 0x3b, 0x86, 0x45, 0x9f, 0xd3, 0xa1, 0x9c, 0x74, 0xa8, 0x85, 0x9c, 0x5b, 0xea,
-	0x95, 0x05, 0x5e, 0x07, 0xa4, 0x77, 0xbf, 0xec, 0x15, 0x0d, 0xe2, 0xf5, 0x7a, 0x9f, 0x7f, 0x22,
-	0xf2, 0x1c, 0x8a, 0x06, 0xf1, 0xad, 0x49, 0x5a, 0xd1, 0x20, 0xe0, 0xdc, 0xa2, 0x41, 0xe8, 0x6c,
-	0x9d, 0xf5, 0x13, 0x28, 0x28, 0xe5, 0x92, 0x5b, 0x36, 0xf8, 0x9d, 0xf4, 0xf4, 0xdd, 0x7e, 0x26,
-	0x86, 0x65, 0xff, 0x3f, 0x24, 0x82, 0x59, 0x10, 0xa3, 0xae, 0x28, 0xff, 0x37, 0xff, 0xbb, 0x0c,
-	0xb9, 0x9b, 0xb4, 0x85, 0xee, 0x41, 0xbe, 0x77, 0xd4, 0xa3, 0x57, 0x63, 0xeb, 0xac, 0xf0, 0xc7,
-	0x38, 0xea, 0xe7, 0xb3, 0x09, 0xcb, 0x6f, 0x43, 0x7a, 0x38, 0x35, 0x5d, 0xcb, 0x80, 0xd3, 0xff,
-	0x16, 0x26, 0x03, 0x8e, 0xf7, 0x1b, 0x14, 0x03, 0xa6, 0x3c, 0x9f, 0x45, 0xa0, 0xf5, 0x24, 0xe5,
-	0xd0, 0xc7, 0x28, 0x6a, 0x25, 0xab, 0xb8, 0x07, 0xad, 0xff, 0xdd, 0x43, 0x32, 0x5a, 0xe8, 0x93,
-	0x8c, 0x64, 0xb4, 0xf0, 0xe7, 0x14, 0xa8, 0x09, 0x93, 0xee, 0x14, 0x1e, 0xad, 0x25, 0xe8, 0x06,
-	0xbe, 0xb7, 0x50, 0x5f, 0xcd, 0x24, 0xeb, 0x07, 0xd9, 0xa6, 0x87, 0xe9, 0x20, 0x9e, 0xb9, 0x7f,
-	0x2a, 0x88, 0x77, 0xcc, 0x8c, 0x2c, 0x98, 0xf6, 0x0e, 0x60, 0x51, 0x52, 0x24, 0x22, 0x66, 0xd1,
-	0x6a, 0x35, 0xb3, 0xbc, 0x04, 0x7c, 0x9f, 0x6d, 0xd5, 0xc8, 0x71, 0x21, 0xfa, 0x62, 0xaa, 0xad,
-	0x98, 0x49, 0xb0, 0xfa, 0xa5, 0x53, 0x68, 0x4a, 0x7f, 0x7e, 0xc4, 0x5e, 0xae, 0x63, 0x06, 0x76,
-	0x68, 0x2b, 0xd5, 0x6e, 0xec, 0x34, 0x53, 0xfd, 0xf2, 0xa9, 0x74, 0x43, 0x5e, 0x85, 0x67, 0x6c,
-	0x19, 0xbc, 0x8a, 0x9d, 0x29, 0x66, 0xf0, 0x2a, 0x7e, 0xa8, 0x87, 0xba, 0x30, 0xeb, 0x9f, 0x60,
-	0xa1, 0x2b, 0xa9, 0xe6, 0x02, 0xb3, 0x3f, 0x75, 0x63, 0x00, 0x0d, 0x09, 0xfb, 0x9e, 0x02, 0x73,
-	0x11, 0xd3, 0x24, 0xf4, 0x85, 0x54, 0x53, 0x51, 0xb3, 0x34, 0xf5, 0xf5, 0x41, 0xd5, 0xa4, 0x1b,
-	0x3f, 0x0c, 0xb8, 0x21, 0x07, 0x40, 0x99, 0xdd, 0xf0, 0x4f, 0xb8, 0x32, 0xbb, 0x11, 0x98, 0x33,
-	0x95, 0x73, 0x3f, 0x18, 0x51, 0xd0, 0x4f, 0x15, 0x58, 0x4a, 0x18, 0xdc, 0xa0, 0xb7, 0x32, 0x1a,
-	0x8f, 0x9e, 0x4e, 0xa9, 0x5f, 0x39, 0xad, 0x7a, 0x68, 0x93, 0x07, 0x67, 0x2f, 0x19, 0x36, 0x79,
-	0xcc, 0x7c, 0x29, 0xc3, 0x26, 0x8f, 0x1b, 0xf4, 0xa0, 0x47, 0x0a, 0x94, 0xd2, 0x26, 0x25, 0xa8,
-	0x36, 0x28, 0xe9, 0x88, 0x4d, 0xbf, 0xf3, 0xa9, 0x6c, 0x48, 0x6f, 0x7f, 0xa1, 0xc0, 0x72, 0xe2,
-	0xd0, 0x03, 0x5d, 0xcd, 0x08, 0x13, 0x37, 0xe1, 0x51, 0xdf, 0x3e, 0xbd, 0x01, 0xe9, 0xe4, 0x07,
-	0x0a, 0x5c, 0x88, 0x99, 0x20, 0xa0, 0xf4, 0x4c, 0xc5, 0x0d, 0x68, 0xd4, 0xad, 0xd3, 0xa8, 0x4a,
-	0x97, 0xbe, 0xaf, 0xc0, 0x7c, 0x54, 0x0b, 0x1c, 0xbd, 0x9e, 0xd1, 0x68, 0x60, 0xbc, 0xa1, 0xbe,
-	0x31, 0xb0, 0x9e, 0xf4, 0xc4, 0x86, 0x19, 0x5f, 0x33, 0x1c, 0x55, 0x53, 0x4b, 0x27, 0x7f, 0x87,
-	0x5a, 0xbd, 0x92, 0x5d, 0xa1, 0x8f, 0xe9, 0x6b, 0x84, 0x27, 0x62, 0x46, 0xb5, 0xe3, 0x13, 0x31,
-	0x23, 0x7b, 0xec, 0x0c, 0xd3, 0xd7, 0x06, 0x4e, 0xc4, 0x8c, 0xea, 0xc4, 0x27, 0x62, 0x46, 0x76,
-	0xc3, 0xd9, 0x21, 0xe4, 0x6f, 0x3d, 0xa3, 0xcc, 0x36, 0x68, 0x96, 0x43, 0x28, 0xba, 0xaf, 0xcd,
-	0x60, 0xfd, 0x6d, 0xe5, 0x44, 0xd8, 0xc8, 0xfe, 0x77, 0x22, 0x6c, 0x74, 0xcf, 0x9a, 0x9f, 0x7d,
-	0x11, 0x6d, 0xdf, 0xc4, 0x43, 0x27, 0xbe, 0xc1, 0x9d, 0x78, 0xe8, 0x24, 0x74, 0x97, 0xd1, 0x31,
-	0xbc, 0x14, 0x68, 0xdb, 0xa2, 0x24, 0x32, 0xd1, 0x5d, 0x68, 0x75, 0x73, 0x10, 0x95, 0xfe, 0x12,
-	0xf3, 0xbd, 0x59, 0x27, 0x2e, 0xb1, 0xa8, 0xde, 0x71, 0xe2, 0x12, 0x8b, 0x7c, 0x69, 0x67, 0xb9,
-	0xf6, 0xbf, 0x30, 0xa3, 0x14, 0x1b, 0xe1, 0x97, 0x7b, 0x75, 0x63, 0x00, 0x0d, 0x09, 0xfb, 0x6d,
-	0x1e, 0x64, 0xef, 0x4b, 0x62, 0x5a, 0x90, 0x23, 0x5e, 0x78, 0xd3, 0x82, 0x1c, 0xf5, 0x0e, 0x2a,
-	0x6a, 0x0a, 0x0b, 0xa6, 0x7d, 0xd8, 0x49, 0xaf, 0x02, 0x51, 0xc0, 0xd5, 0xcc, 0xf2, 0x02, 0x55,
-	0x1d, 0xfb, 0xee, 0xb3, 0xc7, 0x6b, 0x4a, 0x8d, 0x7c, 0xf8, 0xa4, 0xa8, 0x7c, 0xfc, 0xa4, 0xa8,
-	0x7c, 0xf2, 0xa4, 0xa8, 0x7c, 0xf0, 0xb4, 0x78, 0xe6, 0xe3, 0xa7, 0xc5, 0x33, 0xff, 0x7c, 0x5a,
-	0x3c, 0x03, 0x8b, 0xba, 0x15, 0x63, 0x73, 0x5f, 0x79, 0xb7, 0xe2, 0xf9, 0x4a, 0xab, 0x2f, 0xb4,
-	0xae, 0x5b, 0x9e, 0x5f, 0xd5, 0xe3, 0xde, 0x1f, 0xb7, 0x1c, 0x8c, 0xf3, 0xbf, 0x68, 0x79, 0xed,
-	0x7f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xd1, 0x40, 0x23, 0x7a, 0x49, 0x34, 0x00, 0x00,
+	0x15, 0xcf, 0x78, 0xfd, 0xb5, 0xc7, 0x8e, 0x9b, 0x8c, 0x9d, 0x64, 0x3d, 0x69, 0xd6, 0x9b, 0x4d,
+	0x4d, 0x8d, 0x53, 0xef, 0xda, 0xae, 0x48, 0xa9, 0xdb, 0xd2, 0x7a, 0x9d, 0x3a, 0x72, 0x51, 0x5a,
+	0x6b, 0xd3, 0x82, 0x54, 0x1e, 0x56, 0xe3, 0x9d, 0xdb, 0xcd, 0xe0, 0xd9, 0x99, 0xed, 0xdc, 0x59,
+	0xc7, 0x96, 0x40, 0x20, 0x54, 0x09, 0x78, 0xa8, 0x54, 0x09, 0xf1, 0x82, 0x10, 0x12, 0x20, 0x21,
+	0xa0, 0x0f, 0x14, 0xc1, 0x03, 0x1f, 0x8f, 0xbc, 0xf4, 0xa1, 0x0f, 0x15, 0x4f, 0x3c, 0x20, 0xa8,
+	0x5a, 0x89, 0xfe, 0x17, 0x08, 0xdd, 0x7b, 0xcf, 0xec, 0x7c, 0x7f, 0xec, 0xb6, 0x1b, 0xf1, 0xd2,
+	0x66, 0xe7, 0x9e, 0x8f, 0xdf, 0xef, 0x9c, 0xb9, 0x73, 0xcf, 0x9c, 0x33, 0x86, 0x95, 0x9e, 0x6d,
+	0x9d, 0x10, 0x53, 0x35, 0xdb, 0xa4, 0x4e, 0x4e, 0xdb, 0xf7, 0x55, 0xb3, 0x43, 0xea, 0x27, 0x5b,
+	0x75, 0xe7, 0xb4, 0xd6, 0xb3, 0x2d, 0xc7, 0x92, 0x2f, 0x7b, 0x02, 0x35, 0x57, 0xa0, 0x76, 0xb2,
+	0xa5, 0x5c, 0x54, 0xbb, 0xba, 0x69, 0xd5, 0xf9, 0x7f, 0x85, 0xa8, 0x52, 0x6e, 0x5b, 0xb4, 0x6b,
+	0xd1, 0xfa, 0x91, 0x4a, 0x99, 0x8d, 0x23, 0xe2, 0xa8, 0x5b, 0xf5, 0xb6, 0xa5, 0x9b, 0xb8, 0x7e,
+	0x05, 0xd7, 0xbb, 0xb4, 0xc3, 0x5c, 0x74, 0x69, 0x07, 0x17, 0x96, 0xc5, 0x42, 0x8b, 0xff, 0xaa,
+	0x8b, 0x1f, 0xb8, 0xb4, 0xd4, 0xb1, 0x3a, 0x96, 0xb8, 0xce, 0xfe, 0x85, 0x57, 0xd7, 0x12, 0x50,
+	0xb7, 0xad, 0x6e, 0x57, 0x77, 0xba, 0xc4, 0x74, 0x5c, 0xfd, 0x1b, 0x09, 0x92, 0x5d, 0xd5, 0x3e,
+	0x26, 0x4e, 0x86, 0x90, 0x65, 0x6b, 0xc4, 0xce, 0xb2, 0xd4, 0x53, 0x6d, 0xb5, 0xeb, 0x0a, 0xad,
+	0x26, 0x0a, 0x9d, 0xf9, 0x50, 0x55, 0xff, 0x20, 0xc1, 0xe2, 0x5d, 0xda, 0xd9, 0xb3, 0x89, 0xea,
+	0x90, 0x5d, 0x7a, 0xdc, 0x24, 0x6f, 0xf6, 0x09, 0x75, 0xe4, 0x3d, 0x28, 0xaa, 0xf4, 0xb8, 0xc5,
+	0xfd, 0x96, 0xa4, 0x8a, 0xb4, 0x36, 0xb7, 0x5d, 0xa9, 0xc5, 0x27, 0xa0, 0xb6, 0x4b, 0x8f, 0x5f,
+	0x61, 0x72, 0x8d, 0xc9, 0xf7, 0xff, 0xb5, 0x72, 0xae, 0x39, 0xab, 0xe2, 0x6f, 0xf9, 0x0e, 0xc8,
+	0xdc, 0x40, 0xab, 0xcd, 0xcc, 0xeb, 0x96, 0xd9, 0x7a, 0x83, 0x90, 0xd2, 0x04, 0xb7, 0xb6, 0x5c,
+	0xc3, 0xe8, 0xb2, 0x1c, 0xd5, 0x30, 0x47, 0xb5, 0x3d, 0x4b, 0x37, 0x9b, 0x17, 0xb8, 0xd2, 0x1e,
+	0xea, 0xec, 0x13, 0xb2, 0xb3, 0xf0, 0xbd, 0x4f, 0xdf, 0x5b, 0xf7, 0x00, 0x55, 0xb7, 0x60, 0x29,
+	0x08, 0x9a, 0xf6, 0x2c, 0x93, 0x12, 0x79, 0x19, 0x66, 0x85, 0x43, 0x5d, 0xe3, 0xa0, 0x27, 0x9b,
+	0x33, 0xfc, 0xf7, 0x81, 0x16, 0x24, 0xda, 0xd0, 0x35, 0x1f, 0xd1, 0x23, 0x5d, 0xcb, 0x47, 0xb4,
+	0xa1, 0x6b, 0x01, 0xa2, 0x47, 0xba, 0x36, 0x16, 0xa2, 0x03, 0x40, 0x01, 0xa2, 0x1c, 0x74, 0x36,
+	0xd1, 0x0f, 0x26, 0xe0, 0x12, 0xd3, 0xe1, 0x37, 0xe0, 0x7e, 0xdf, 0xd4, 0xa8, 0x4b, 0x75, 0x1b,
+	0x66, 0xd4, 0x76, 0xdb, 0xea, 0x9b, 0x0e, 0xd7, 0x29, 0x36, 0x4a, 0x7f, 0xff, 0xe3, 0xc6, 0x12,
+	0xa2, 0xdb, 0xd5, 0x34, 0x9b, 0x50, 0x7a, 0xcf, 0xb1, 0x75, 0xb3, 0xd3, 0x74, 0x05, 0xe5, 0xab,
+	0x50, 0x14, 0x37, 0x28, 0xf3, 0xc4, 0x08, 0x9d, 0x6f, 0xce, 0x8a, 0x0b, 0x07, 0x9a, 0x7c, 0x06,
+	0xd3, 0x6a, 0x97, 0xdb, 0x2b, 0x54, 0x0a, 0xa9, 0x54, 0x1b, 0xfb, 0x2c, 0x62, 0xbf, 0xfd, 0xf7,
+	0xca, 0x5a, 0x47, 0x77, 0xee, 0xf7, 0x8f, 0x6a, 0x6d, 0xab, 0x8b, 0xdb, 0x0b, 0xff, 0xb7, 0x41,
+	0xb5, 0xe3, 0xba, 0x73, 0xd6, 0x23, 0x94, 0x2b, 0xd0, 0x9f, 0x7c, 0xfa, 0xde, 0xfa, 0xbc, 0x41,
+	0x3a, 0x6a, 0xfb, 0xac, 0xc5, 0x76, 0x2e, 0xfd, 0xf5, 0xa7, 0xef, 0xad, 0x4b, 0x4d, 0x74, 0x28,
+	0x3f, 0x0b, 0xf3, 0x81, 0x58, 0x4f, 0x66, 0xc5, 0x7a, 0xae, 0xed, 0x85, 0x99, 0xb1, 0x22, 0x27,
+	0xc4, 0x74, 0x5a, 0x8e, 0xda, 0x29, 0x4d, 0xb1, 0x58, 0x34, 0x67, 0xf9, 0x85, 0x57, 0xd5, 0xce,
+	0xce, 0x3c, 0xcb, 0x81, 0x1b, 0x80, 0x6a, 0x09, 0x2e, 0x87, 0xa3, 0x29, 0x72, 0x50, 0x7d, 0x53,
+	0xc4, 0x99, 0xdd, 0x25, 0x06, 0xbf, 0x0d, 0xdc, 0x38, 0x6f, 0xc2, 0x34, 0xd5, 0x3b, 0x26, 0xb1,
+	0x33, 0xc3, 0x8c, 0x72, 0x81, 0x74, 0x4e, 0x04, 0xd2, 0xb9, 0x33, 0xc7, 0xd0, 0xa0, 0x9c, 0x0b,
+	0xc6, 0xef, 0x12, 0xc1, 0xfc, 0xad, 0x00, 0xf2, 0x5d, 0xda, 0xd9, 0xd7, 0x0d, 0xa3, 0xa1, 0x6b,
+	0xd4, 0x0f, 0x85, 0x18, 0x46, 0x2e, 0x28, 0x5c, 0x2e, 0x3d, 0xe1, 0x6f, 0x49, 0x30, 0xef, 0x58,
+	0x8e, 0x6a, 0xb4, 0x54, 0x4a, 0x89, 0x43, 0x1f, 0x5e, 0xde, 0xe7, 0xb8, 0xdb, 0x5d, 0xee, 0x55,
+	0xae, 0xc2, 0xf9, 0xc1, 0x16, 0x69, 0xe9, 0x1a, 0x2d, 0x4d, 0x56, 0x0a, 0x6b, 0x93, 0xcd, 0x39,
+	0x77, 0x3f, 0x1e, 0x68, 0x54, 0xfe, 0x1a, 0x28, 0x82, 0x51, 0x8b, 0x12, 0xc7, 0x31, 0x48, 0x97,
+	0xa5, 0xfb, 0x0d, 0x43, 0x75, 0xf8, 0xed, 0x32, 0x95, 0x75, 0xbb, 0x5c, 0x11, 0xca, 0xf7, 0x06,
+	0xba, 0xfb, 0x86, 0xea, 0xb0, 0x5b, 0xe7, 0x65, 0xb8, 0x3c, 0x78, 0x0e, 0x05, 0xb7, 0xfb, 0x74,
+	0x96, 0xcd, 0x45, 0xf7, 0xc1, 0xe8, 0xdf, 0xf1, 0x98, 0x5f, 0xee, 0xad, 0x7a, 0x09, 0x16, 0x03,
+	0x49, 0xc4, 0xe4, 0xfe, 0xd5, 0x4b, 0xee, 0x2e, 0x3d, 0x1e, 0x24, 0xb7, 0x06, 0x53, 0x47, 0xfd,
+	0xb3, 0x1c, 0xb9, 0x15, 0x62, 0xe9, 0xa9, 0x7d, 0x01, 0x44, 0x88, 0x5b, 0x3d, 0x5b, 0x6f, 0x93,
+	0x52, 0x21, 0x83, 0x0c, 0x3e, 0x02, 0x81, 0xeb, 0x1c, 0x32, 0x15, 0x96, 0x15, 0x2f, 0x32, 0xbe,
+	0xac, 0xb8, 0xac, 0x59, 0x56, 0x7e, 0x2c, 0xc1, 0x25, 0x0e, 0x26, 0x90, 0x15, 0x42, 0x68, 0x69,
+	0xea, 0x61, 0xdd, 0x49, 0x8b, 0xdc, 0xbf, 0x2f, 0xb1, 0x84, 0x50, 0x96, 0x55, 0xef, 0x8e, 0x1a,
+	0x32, 0xab, 0xee, 0x5d, 0xe7, 0xcf, 0x2a, 0xb0, 0xac, 0x8a, 0xb0, 0xfb, 0x92, 0x2a, 0x92, 0x87,
+	0x49, 0xfd, 0x48, 0xe2, 0x9b, 0xf9, 0x2e, 0x4f, 0x80, 0x80, 0xe3, 0x4b, 0xac, 0xaa, 0x75, 0x75,
+	0x33, 0x3b, 0xb1, 0x5c, 0x2c, 0x3d, 0xb1, 0x91, 0xb4, 0x14, 0xa2, 0x69, 0xc9, 0xb3, 0xa1, 0x56,
+	0x61, 0x81, 0x9c, 0xf6, 0x48, 0xdb, 0x69, 0xf5, 0x54, 0xdb, 0xd1, 0x55, 0x83, 0x6f, 0xa2, 0xd9,
+	0xe6, 0x79, 0x71, 0xf5, 0x50, 0x5c, 0x44, 0xe6, 0x1c, 0x57, 0x75, 0x19, 0xae, 0x44, 0x18, 0x22,
+	0xfb, 0x5f, 0x15, 0xa0, 0x32, 0x58, 0xdb, 0x1b, 0x14, 0x4b, 0x63, 0x8c, 0xc3, 0x1e, 0x4c, 0xeb,
+	0x66, 0xaf, 0x3f, 0x78, 0x68, 0xad, 0x26, 0x96, 0x33, 0xe2, 0xc9, 0xbf, 0xcb, 0x0f, 0x1a, 0xbc,
+	0xcf, 0x51, 0x55, 0x7e, 0x11, 0x66, 0xac, 0xbe, 0xc3, 0xad, 0x4c, 0x0e, 0x6f, 0xc5, 0xd5, 0x95,
+	0x9f, 0x87, 0x49, 0xdf, 0x4d, 0x3f, 0x94, 0x0d, 0xae, 0xc8, 0x0c, 0x98, 0xea, 0x09, 0x2d, 0x4d,
+	0xa7, 0x1b, 0x78, 0x99, 0x38, 0xfc, 0x91, 0xc9, 0x37, 0xa8, 0x6b, 0x80, 0x29, 0x06, 0x4f, 0xc0,
+	0x99, 0xd0, 0x09, 0xe8, 0xcf, 0xe1, 0x0d, 0xb8, 0x9e, 0x92, 0x27, 0xcc, 0xe6, 0x7f, 0x24, 0xa8,
+	0x0e, 0xa4, 0x9a, 0xc4, 0x20, 0x2a, 0x25, 0x9e, 0x30, 0x1d, 0x4b, 0x3e, 0x5f, 0x02, 0x70, 0xac,
+	0x96, 0x2d, 0x9c, 0x8d, 0x92, 0xd3, 0xa2, 0x63, 0x21, 0xd4, 0x60, 0x34, 0x26, 0x53, 0xa2, 0xb1,
+	0x0a, 0x37, 0x52, 0x79, 0x62, 0x3c, 0xfe, 0xec, 0x8f, 0xc7, 0x3d, 0xe2, 0xf0, 0x4d, 0xf4, 0xe2,
+	0xa9, 0x43, 0x6c, 0x53, 0x35, 0x0e, 0x6e, 0x8f, 0x25, 0x1e, 0xfe, 0x1a, 0xa2, 0x10, 0xa8, 0x21,
+	0xe4, 0x15, 0x98, 0x23, 0xe8, 0x9c, 0xad, 0x0a, 0x82, 0xe0, 0x5e, 0x3a, 0xd0, 0x12, 0x29, 0xc6,
+	0x41, 0x47, 0x8a, 0x6f, 0x4f, 0x40, 0x69, 0x20, 0xf7, 0x75, 0xdd, 0xb9, 0xaf, 0xd9, 0xea, 0x83,
+	0xb1, 0x10, 0xbb, 0xc6, 0x13, 0xad, 0x0a, 0x3d, 0x4e, 0xad, 0xc8, 0x72, 0x87, 0x86, 0x7c, 0x45,
+	0xe8, 0xe4, 0x43, 0x2e, 0x42, 0x03, 0x61, 0xbb, 0x0a, 0xcb, 0x31, 0xe1, 0xc0, 0x60, 0x7d, 0x20,
+	0xc1, 0xb5, 0xc1, 0xea, 0x6b, 0x3d, 0x4d, 0x75, 0xc8, 0x6d, 0xe2, 0xa8, 0xba, 0x31, 0x9e, 0xad,
+	0xd1, 0x84, 0x05, 0x5c, 0xd4, 0x84, 0x17, 0x3c, 0xce, 0x13, 0xb7, 0x87, 0x00, 0x86, 0x90, 0x70,
+	0x7b, 0x9c, 0xef, 0xfa, 0x2f, 0x06, 0xb8, 0x56, 0xa0, 0x9c, 0xc4, 0x06, 0x09, 0xff, 0x2e, 0x4a,
+	0xf8, 0x45, 0x53, 0x3d, 0x32, 0x88, 0xe6, 0x55, 0xa6, 0x01, 0xc2, 0x4a, 0x12, 0xe1, 0x92, 0xe4,
+	0x52, 0x5e, 0x89, 0x50, 0x6e, 0x4c, 0x94, 0x24, 0x1f, 0xed, 0x0d, 0xb8, 0xa0, 0xb6, 0xdb, 0xa4,
+	0xe7, 0xe8, 0x66, 0x47, 0x9c, 0x65, 0x82, 0xf8, 0x2c, 0x97, 0x7b, 0x64, 0xb0, 0xc6, 0x6f, 0x69,
+	0x2a, 0xea, 0x7c, 0x17, 0x44, 0xf5, 0x31, 0x28, 0x27, 0x01, 0x16, 0x9c, 0x76, 0x26, 0x4a, 0x52,
+	0xf5, 0x5d, 0x09, 0x56, 0x43, 0x62, 0xbb, 0x41, 0xb3, 0x63, 0x49, 0xe8, 0x17, 0x93, 0x98, 0x45,
+	0x59, 0xf9, 0xf3, 0xb4, 0x06, 0x5f, 0xc8, 0x02, 0xeb, 0xe5, 0xab, 0x12, 0x12, 0x7d, 0x8d, 0xba,
+	0x55, 0xd2, 0x58, 0x28, 0x6d, 0xc3, 0x25, 0xd5, 0x30, 0xac, 0x07, 0xad, 0x3e, 0x0d, 0x54, 0x83,
+	0xc8, 0x6b, 0x91, 0x2f, 0x7a, 0x18, 0xd8, 0x52, 0xe2, 0xb9, 0x14, 0x05, 0x8c, 0xb4, 0xfe, 0x22,
+	0xc1, 0x7a, 0x52, 0x04, 0xc6, 0x7d, 0x3e, 0x3d, 0x09, 0x97, 0xbc, 0x9c, 0xf9, 0xda, 0x41, 0x48,
+	0x70, 0x49, 0x8d, 0x01, 0x12, 0x60, 0xb8, 0x01, 0x37, 0x73, 0x61, 0x47, 0xae, 0xbf, 0x97, 0xe0,
+	0xf1, 0x90, 0xfc, 0x81, 0xe9, 0x10, 0xbb, 0x4b, 0x34, 0x5d, 0xb5, 0xcf, 0x6e, 0x13, 0xd3, 0xea,
+	0x8e, 0x85, 0xe8, 0x06, 0xc8, 0xba, 0xcf, 0x51, 0x4b, 0x63, 0x9e, 0xf0, 0x39, 0x7d, 0x51, 0x0f,
+	0x43, 0x08, 0x50, 0x5c, 0x87, 0xb5, 0x6c, 0xc8, 0xc8, 0xef, 0x37, 0x13, 0xbe, 0x8c, 0xdf, 0x55,
+	0x4d, 0xb5, 0x43, 0x0e, 0x89, 0xdd, 0xd5, 0x29, 0xd5, 0x2d, 0x93, 0x8e, 0xeb, 0xe4, 0xb1, 0xc9,
+	0x89, 0x75, 0x4c, 0x5a, 0xaa, 0x61, 0xf0, 0x12, 0xa3, 0xd8, 0x2c, 0x8a, 0x2b, 0xbb, 0x86, 0x21,
+	0xef, 0x43, 0x91, 0x57, 0x20, 0xec, 0x37, 0x1e, 0x3e, 0x37, 0x52, 0x0a, 0x10, 0x42, 0xe9, 0x1d,
+	0x5b, 0x1d, 0x94, 0x1f, 0xb3, 0xac, 0xfc, 0x60, 0xaa, 0xf2, 0x6d, 0x98, 0x75, 0xac, 0x56, 0x87,
+	0xad, 0x95, 0xa6, 0x86, 0x35, 0x33, 0xe3, 0x58, 0xfc, 0x67, 0x20, 0xae, 0x8f, 0x41, 0x35, 0x2d,
+	0x54, 0x6e, 0x44, 0x0b, 0x50, 0x0e, 0x89, 0x35, 0xc9, 0x9b, 0xbb, 0x8e, 0x33, 0xb6, 0xa7, 0xd8,
+	0x45, 0xfe, 0x6a, 0x45, 0x5a, 0xec, 0x85, 0x44, 0x9c, 0xe9, 0x18, 0xd5, 0x85, 0xb6, 0xdb, 0xcb,
+	0x7b, 0x95, 0x1d, 0xec, 0x72, 0x1d, 0x96, 0x82, 0xa2, 0x36, 0xe9, 0x5a, 0x27, 0x22, 0xca, 0xc5,
+	0xe6, 0x45, 0x9f, 0x74, 0x93, 0x2f, 0xf8, 0x6c, 0xb3, 0x17, 0x19, 0xb4, 0x3d, 0xe5, 0xb7, 0xdd,
+	0xd0, 0xb5, 0xb0, 0x6d, 0x14, 0x45, 0xdb, 0xd3, 0x7e, 0xdb, 0x5c, 0x1a, 0x6d, 0x3f, 0x05, 0x25,
+	0x54, 0xf0, 0xb6, 0xb1, 0xeb, 0x62, 0x86, 0x2b, 0x5d, 0x12, 0xeb, 0xde, 0xb6, 0x14, 0x9e, 0x9e,
+	0x83, 0xab, 0xb1, 0x8a, 0xe8, 0x70, 0x96, 0xeb, 0x96, 0xa2, 0xba, 0xc2, 0x6f, 0x20, 0xa3, 0xd7,
+	0x61, 0x25, 0x31, 0x55, 0x98, 0xce, 0xd7, 0xf9, 0xdb, 0x96, 0xe8, 0x15, 0x1e, 0x8a, 0x2e, 0xaf,
+	0x9b, 0xc6, 0xe7, 0x61, 0x06, 0xfb, 0xbe, 0xd8, 0xe2, 0x5c, 0x49, 0xba, 0xc1, 0x50, 0xd1, 0xbd,
+	0xb9, 0x50, 0xab, 0xaa, 0x40, 0x29, 0x6a, 0x3b, 0xe0, 0x57, 0x3c, 0x9b, 0xc6, 0xe3, 0x37, 0x64,
+	0x1b, 0xfd, 0xbe, 0x2b, 0x71, 0xc7, 0x4d, 0xf2, 0x4d, 0xd2, 0xf6, 0x16, 0x07, 0x7d, 0x2f, 0x47,
+	0xb5, 0x3b, 0x24, 0xbb, 0xd3, 0x89, 0x72, 0x4c, 0x83, 0x5a, 0x7d, 0xbb, 0x2d, 0xda, 0xb6, 0xa9,
+	0x1a, 0x42, 0x2e, 0x5c, 0x55, 0x17, 0x22, 0x55, 0xb5, 0x68, 0xed, 0x08, 0xfb, 0xc8, 0x24, 0x04,
+	0xd6, 0xad, 0xa5, 0xa5, 0xe8, 0x22, 0x1d, 0x9d, 0xca, 0x36, 0xcc, 0x08, 0x88, 0xb4, 0x34, 0x51,
+	0x29, 0xa4, 0xaa, 0xb8, 0x82, 0x41, 0xac, 0xa2, 0x96, 0x0d, 0xc3, 0x41, 0xb0, 0xdf, 0x12, 0xb7,
+	0x02, 0xef, 0x41, 0xc6, 0x60, 0xc5, 0x20, 0x4a, 0x39, 0x83, 0x78, 0x1d, 0xe6, 0x7d, 0x41, 0x44,
+	0xc0, 0xcd, 0x39, 0x2f, 0x8a, 0x2e, 0x34, 0x21, 0x8f, 0xd0, 0xc2, 0xde, 0x11, 0xda, 0x9f, 0x44,
+	0xd5, 0xb9, 0xc7, 0xef, 0x2a, 0x5c, 0x7d, 0x95, 0x53, 0x1a, 0x1d, 0x60, 0x28, 0xcb, 0x13, 0xe1,
+	0x2c, 0xcb, 0x4f, 0x01, 0x98, 0xe4, 0x41, 0x0b, 0x73, 0x54, 0xc8, 0x30, 0x5b, 0x34, 0xc9, 0x03,
+	0x01, 0x29, 0xc8, 0x4b, 0x94, 0xd4, 0xb1, 0xc8, 0x91, 0xdc, 0xcf, 0x25, 0x4e, 0xfd, 0x8e, 0x75,
+	0x22, 0xb6, 0xa1, 0xfb, 0x12, 0x2a, 0x88, 0xdd, 0x82, 0xa2, 0xda, 0x77, 0xee, 0x5b, 0xb6, 0xee,
+	0x9c, 0x65, 0x72, 0xf3, 0x44, 0xe5, 0x67, 0x61, 0x5a, 0x3c, 0x9f, 0x71, 0x5a, 0x51, 0x4e, 0x7f,
+	0x45, 0x70, 0xdb, 0x21, 0x42, 0xc7, 0x9d, 0xcb, 0xb8, 0xd6, 0xaa, 0x8f, 0x82, 0x12, 0x07, 0x11,
+	0x19, 0xfc, 0x73, 0x81, 0x6f, 0xd8, 0x3b, 0xd6, 0x89, 0x78, 0x82, 0xed, 0x13, 0x42, 0x3f, 0x2b,
+	0xfe, 0xd4, 0x03, 0xe7, 0x35, 0xb8, 0xa2, 0x6a, 0x1a, 0x6b, 0xe3, 0xb5, 0x7c, 0xa7, 0x09, 0x6b,
+	0x02, 0x67, 0x37, 0xae, 0x05, 0xd1, 0x45, 0x55, 0xd3, 0xf6, 0x09, 0x19, 0x4c, 0x9a, 0x58, 0x17,
+	0x58, 0xfe, 0x06, 0x28, 0xe2, 0x09, 0x1e, 0x6b, 0x79, 0x32, 0x9f, 0xe5, 0xcb, 0xc2, 0x44, 0xc4,
+	0x78, 0x14, 0x33, 0x3b, 0xa5, 0xb8, 0xe5, 0xa9, 0x11, 0x30, 0x37, 0x74, 0x2d, 0x19, 0xf3, 0xc0,
+	0xf2, 0xf4, 0x68, 0x98, 0x5d, 0xe3, 0x6d, 0x28, 0xbb, 0x98, 0xe3, 0x7b, 0xee, 0xa5, 0x99, 0x7c,
+	0x0e, 0x14, 0x01, 0xfd, 0x5e, 0x4c, 0xef, 0x5d, 0xd6, 0xe1, 0xba, 0x8f, 0x41, 0x82, 0x9f, 0xd9,
+	0x7c, 0x7e, 0xae, 0x0d, 0x88, 0xc4, 0xba, 0x32, 0xa1, 0x92, 0xcc, 0xc7, 0x56, 0x1d, 0xdd, 0xa2,
+	0xa5, 0x62, 0xa5, 0x90, 0x36, 0x2a, 0xdc, 0x27, 0xa4, 0xc9, 0x04, 0xd1, 0xe1, 0xa3, 0xf1, 0xc4,
+	0xb8, 0x08, 0x95, 0x1d, 0xb8, 0x91, 0x4a, 0x0d, 0x5d, 0xc2, 0x50, 0x2e, 0x57, 0x12, 0x39, 0xa2,
+	0x57, 0x15, 0xae, 0xb9, 0x2c, 0xa3, 0x2d, 0x79, 0x16, 0xcc, 0xb9, 0x7c, 0xc1, 0x5c, 0x16, 0xdc,
+	0x1a, 0xfd, 0xb3, 0x48, 0x20, 0x3b, 0x50, 0xf1, 0x11, 0x8b, 0xf7, 0x32, 0x9f, 0xcf, 0xcb, 0xa3,
+	0x03, 0x3a, 0x71, 0x8e, 0x0c, 0x58, 0x49, 0xe4, 0x82, 0xd1, 0x3b, 0x3f, 0x54, 0xf4, 0xae, 0xc6,
+	0x92, 0xc2, 0xc8, 0xd9, 0x50, 0x4d, 0xa3, 0x85, 0x0e, 0x17, 0x86, 0x72, 0x58, 0x4e, 0xe2, 0x87,
+	0x3e, 0x7d, 0x7b, 0x2c, 0x5a, 0x53, 0xf2, 0x40, 0x3e, 0x32, 0xd4, 0x1e, 0xdb, 0x0b, 0x55, 0x9d,
+	0x31, 0x7b, 0x2c, 0xc1, 0xcf, 0x85, 0x61, 0xf7, 0x58, 0xac, 0xab, 0x97, 0xa0, 0x4a, 0x89, 0x23,
+	0xfc, 0x78, 0x0e, 0x7c, 0x51, 0x3c, 0xd2, 0x7b, 0xb4, 0x74, 0x91, 0x3f, 0xd1, 0xcb, 0x94, 0x38,
+	0xcc, 0x4e, 0xa8, 0xfd, 0xcc, 0xfe, 0xd5, 0xd0, 0x7b, 0x6c, 0x7a, 0xf3, 0x58, 0xdf, 0xcc, 0x61,
+	0x4d, 0xe6, 0x6f, 0xde, 0x95, 0xbe, 0x99, 0x61, 0xef, 0x15, 0x58, 0x4d, 0xb7, 0xa6, 0x9b, 0xad,
+	0x63, 0xdd, 0xd4, 0x4a, 0x8b, 0xc2, 0x60, 0xb2, 0xb9, 0x03, 0xf3, 0xab, 0xba, 0xa9, 0xc9, 0xf7,
+	0xe0, 0xf1, 0xbe, 0x99, 0xcf, 0xe4, 0x12, 0x37, 0x59, 0xed, 0x9b, 0x59, 0x46, 0x23, 0x87, 0xaf,
+	0xa8, 0x30, 0x43, 0xa7, 0x2b, 0x1e, 0xbd, 0xdf, 0x71, 0xd7, 0xf6, 0x0c, 0x8b, 0x7e, 0x4e, 0xa5,
+	0x43, 0xda, 0xd1, 0x1b, 0x01, 0x77, 0x15, 0x96, 0x63, 0x00, 0x20, 0xba, 0x5f, 0x0e, 0x4a, 0x1b,
+	0xd1, 0x04, 0x38, 0xe4, 0x1f, 0xb2, 0x7c, 0x0e, 0xa5, 0x8d, 0xf8, 0x22, 0x26, 0xab, 0xb4, 0x11,
+	0xee, 0xdc, 0xd2, 0x46, 0xe8, 0xec, 0x5c, 0x08, 0x12, 0x28, 0x49, 0xd5, 0x0a, 0x28, 0x71, 0x20,
+	0x7d, 0xdd, 0xc1, 0x9f, 0x89, 0x91, 0xde, 0xff, 0x0f, 0x89, 0x70, 0x16, 0xc4, 0x40, 0x2e, 0x0e,
+	0xff, 0xf6, 0x7f, 0xaf, 0x41, 0xe1, 0x2e, 0xed, 0xc8, 0x6f, 0x40, 0x71, 0x50, 0x90, 0xc8, 0x37,
+	0x13, 0xab, 0xc1, 0xe8, 0x27, 0x43, 0xca, 0x13, 0xf9, 0x84, 0x85, 0x3f, 0xcf, 0x4f, 0x43, 0xd7,
+	0x72, 0xf8, 0xf1, 0xbe, 0xd8, 0x51, 0x9e, 0xc8, 0x27, 0x8c, 0x7e, 0x0c, 0x98, 0xf3, 0x7d, 0xbc,
+	0x21, 0x6f, 0xa4, 0x29, 0x47, 0x3e, 0x99, 0x51, 0x6a, 0x79, 0xc5, 0x7d, 0xde, 0xbc, 0xaf, 0x33,
+	0xd2, 0xbd, 0x45, 0x3e, 0x1c, 0x51, 0x6a, 0x79, 0xc5, 0xd1, 0x5b, 0x1b, 0x66, 0xdd, 0x6f, 0x05,
+	0xe4, 0xf5, 0x14, 0xdd, 0xd0, 0x57, 0x21, 0xca, 0xcd, 0x5c, 0xb2, 0x41, 0x27, 0x6c, 0x76, 0x9d,
+	0xe9, 0xc4, 0xf7, 0x75, 0x82, 0x72, 0x33, 0x97, 0x2c, 0x3a, 0xb1, 0x60, 0xde, 0x3f, 0x26, 0x96,
+	0xd3, 0x22, 0x11, 0x33, 0x31, 0x57, 0xea, 0xb9, 0xe5, 0xd1, 0xe1, 0xdb, 0x6c, 0xab, 0xc6, 0x0e,
+	0x35, 0xe5, 0x2f, 0x67, 0xda, 0x4a, 0x98, 0x57, 0x2b, 0x4f, 0x8f, 0xa0, 0x89, 0x78, 0x7e, 0xc4,
+	0x5a, 0x00, 0x09, 0x63, 0x45, 0x79, 0x27, 0xd3, 0x6e, 0xe2, 0xcc, 0x55, 0x79, 0x66, 0x24, 0xdd,
+	0x08, 0xaa, 0xe8, 0x24, 0x30, 0x07, 0xaa, 0xc4, 0xc9, 0xa7, 0xf2, 0xcc, 0x48, 0xba, 0x88, 0xaa,
+	0x0f, 0x0b, 0xc1, 0x39, 0x9b, 0xbc, 0x99, 0x69, 0x2e, 0x34, 0xa1, 0x54, 0xb6, 0x86, 0xd0, 0x40,
+	0xb7, 0x6f, 0xb1, 0x2f, 0x08, 0xa3, 0x33, 0x2f, 0xf9, 0x4b, 0x99, 0xa6, 0xe2, 0x26, 0x7e, 0xca,
+	0xad, 0x61, 0xd5, 0x10, 0xc6, 0x0f, 0x43, 0x30, 0x70, 0x4c, 0x95, 0x1b, 0x46, 0x70, 0x0e, 0xa7,
+	0xdc, 0x1a, 0x56, 0x0d, 0xcf, 0xec, 0xc2, 0x0f, 0x26, 0x24, 0xf9, 0xa7, 0x12, 0x5c, 0x4d, 0x19,
+	0x2f, 0xc9, 0xcf, 0xe5, 0x34, 0x1e, 0x3f, 0x43, 0x53, 0xbe, 0x32, 0xaa, 0x7a, 0x64, 0x93, 0x87,
+	0x27, 0x44, 0x39, 0x36, 0x79, 0xc2, 0x14, 0x4c, 0x79, 0x7a, 0x04, 0x4d, 0xc4, 0xf3, 0x2e, 0x9b,
+	0xb2, 0x65, 0xcc, 0x73, 0xe4, 0xc6, 0xb0, 0xa4, 0x63, 0x36, 0xfd, 0xde, 0x67, 0xb2, 0x81, 0x68,
+	0x7f, 0xc1, 0xba, 0x69, 0x69, 0xa3, 0x19, 0xf9, 0xf9, 0x9c, 0x6e, 0x92, 0xe6, 0x50, 0xca, 0x0b,
+	0xa3, 0x1b, 0x40, 0x90, 0xef, 0xb0, 0x26, 0x70, 0xfc, 0x9c, 0x43, 0xce, 0xce, 0x54, 0xd2, 0x18,
+	0x49, 0xd9, 0x19, 0x45, 0x15, 0x21, 0x7d, 0x5f, 0x82, 0xa5, 0xb8, 0x46, 0xbd, 0x7c, 0x2b, 0xa7,
+	0xd1, 0xd0, 0x10, 0x46, 0x79, 0x6a, 0x68, 0x3d, 0x44, 0x62, 0xc3, 0xf9, 0x40, 0xcb, 0x5e, 0xae,
+	0x67, 0x96, 0x4e, 0xc1, 0x3e, 0xba, 0xb2, 0x99, 0x5f, 0xc1, 0xf3, 0x19, 0x68, 0xd7, 0xa7, 0xfa,
+	0x8c, 0x1b, 0x1a, 0x28, 0x9b, 0xf9, 0x15, 0x3c, 0x9f, 0x81, 0x66, 0x75, 0xaa, 0xcf, 0xb8, 0x79,
+	0x81, 0xb2, 0x99, 0x5f, 0xc1, 0x3b, 0x84, 0x02, 0x0b, 0x54, 0xce, 0x6d, 0x83, 0xe6, 0x39, 0x84,
+	0xe2, 0xbb, 0xef, 0xcc, 0x6d, 0xb0, 0xf9, 0x9d, 0xea, 0x36, 0xb6, 0x4b, 0xaf, 0x6c, 0x0d, 0xa1,
+	0xe1, 0x3b, 0xfb, 0x62, 0x9a, 0xd3, 0xa9, 0x87, 0x4e, 0x72, 0x1b, 0x5e, 0xb9, 0x35, 0xac, 0x1a,
+	0xc2, 0x38, 0x85, 0x47, 0x42, 0xcd, 0x65, 0x39, 0x8d, 0x4c, 0x7c, 0xaf, 0x5c, 0xd9, 0x1e, 0x46,
+	0xc5, 0xbb, 0xc5, 0x02, 0x6f, 0xd6, 0xa9, 0xb7, 0x58, 0x5c, 0x87, 0x5b, 0xd9, 0xcc, 0xaf, 0xe0,
+	0xe5, 0x3a, 0xf8, 0xc2, 0x2c, 0x67, 0xd8, 0x88, 0xbe, 0xdc, 0x2b, 0x5b, 0x43, 0x68, 0xa0, 0xdb,
+	0x6f, 0xf3, 0x20, 0xfb, 0x5f, 0x12, 0xb3, 0x82, 0x1c, 0xf3, 0xc2, 0xab, 0x6c, 0x0f, 0xa3, 0xe2,
+	0xaf, 0x29, 0x2c, 0x98, 0x0f, 0xf8, 0x4e, 0x7b, 0x15, 0x88, 0x73, 0x5c, 0xcf, 0x2d, 0x2f, 0xbc,
+	0x2a, 0x53, 0xdf, 0x65, 0x1f, 0x75, 0x35, 0xc8, 0xfb, 0x1f, 0x97, 0xa5, 0x0f, 0x3f, 0x2e, 0x4b,
+	0x1f, 0x7d, 0x5c, 0x96, 0xde, 0xf9, 0xa4, 0x7c, 0xee, 0xc3, 0x4f, 0xca, 0xe7, 0xfe, 0xf1, 0x49,
+	0xf9, 0x1c, 0x2c, 0xeb, 0x56, 0x82, 0xcd, 0x43, 0xe9, 0xf5, 0x9a, 0xef, 0x5b, 0x32, 0x4f, 0x68,
+	0x43, 0xb7, 0x7c, 0xbf, 0xea, 0xa7, 0x83, 0x3f, 0xc1, 0x39, 0x9a, 0xe6, 0x7f, 0x77, 0xf3, 0xe4,
+	0xff, 0x06, 0x00, 0xfa, 0x84, 0x4b, 0x81, 0xef, 0x34, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -6847,6 +6868,30 @@ func (m *MsgGovManageFeesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if m.UnsetFeeCommitmentSettlementInKind {
+		i--
+		if m.UnsetFeeCommitmentSettlementInKind {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
+	if m.SetFeeCommitmentSettlementInKind {
+		i--
+		if m.SetFeeCommitmentSettlementInKind {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
 	if m.UnsetFeeCommitmentSettlementBips {
 		i--
 		if m.UnsetFeeCommitmentSettlementBips {
@@ -8257,6 +8302,12 @@ func (m *MsgGovManageFeesRequest) Size() (n int) {
 	if m.UnsetFeeCommitmentSettlementBips {
 		n += 3
 	}
+	if m.SetFeeCommitmentSettlementInKind {
+		n += 3
+	}
+	if m.UnsetFeeCommitmentSettlementInKind {
+		n += 3
+	}
 	return n
 }
 
@@ -14460,6 +14511,46 @@ func (m *MsgGovManageFeesRequest) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.UnsetFeeCommitmentSettlementBips = bool(v != 0)
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SetFeeCommitmentSettlementInKind", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SetFeeCommitmentSettlementInKind = bool(v != 0)
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnsetFeeCommitmentSettlementInKind", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.UnsetFeeCommitmentSettlementInKind = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -6,6 +6,21 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+// HoldDetailsGetter returns the details of the holds that a module has placed on an account's funds.
+type HoldDetailsGetter func(ctx sdk.Context, addr sdk.AccAddress) ([]HoldDetail, error)
+
+// NewHoldDetail creates a new HoldDetail for funds a module has placed on hold.
+func NewHoldDetail(reasonCode, module, releaseAuthority, reference string, amount sdk.Coins, releaseConditions string) HoldDetail {
+	return HoldDetail{
+		ReasonCode:        reasonCode,
+		Module:            module,
+		ReleaseAuthority:  releaseAuthority,
+		Reference:         reference,
+		Amount:            amount,
+		ReleaseConditions: releaseConditions,
+	}
+}
+
 func (e AccountHold) Validate() error {
 	if _, err := sdk.AccAddressFromBech32(e.Address); err != nil {
 		return fmt.Errorf("invalid address: %w", err)
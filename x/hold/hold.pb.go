@@ -81,33 +81,133 @@ func (m *AccountHold) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
 	return nil
 }
 
+// HoldDetail describes some funds that a module has placed on hold in an account, why, and what will release them.
+type HoldDetail struct {
+	// reason_code is a machine-readable code for why the funds are on hold, e.g. "exchange_order".
+	ReasonCode string `protobuf:"bytes,1,opt,name=reason_code,json=reasonCode,proto3" json:"reason_code,omitempty"`
+	// module is the name of the module that placed the hold.
+	Module string `protobuf:"bytes,2,opt,name=module,proto3" json:"module,omitempty"`
+	// release_authority is the bech32 address string of an account (other than the one with the funds) that can have
+	// the hold released, e.g. a market's account. It is empty if there isn't one.
+	ReleaseAuthority string `protobuf:"bytes,3,opt,name=release_authority,json=releaseAuthority,proto3" json:"release_authority,omitempty"`
+	// reference identifies what the funds are held for within the module, e.g. an order id.
+	Reference string `protobuf:"bytes,4,opt,name=reference,proto3" json:"reference,omitempty"`
+	// amount is the funds on hold for this reason.
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	// release_conditions is a human-readable description of what will release the hold.
+	ReleaseConditions string `protobuf:"bytes,6,opt,name=release_conditions,json=releaseConditions,proto3" json:"release_conditions,omitempty"`
+}
+
+func (m *HoldDetail) Reset()         { *m = HoldDetail{} }
+func (m *HoldDetail) String() string { return proto.CompactTextString(m) }
+func (*HoldDetail) ProtoMessage()    {}
+func (*HoldDetail) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cfc6e4f15dd47e2b, []int{1}
+}
+func (m *HoldDetail) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HoldDetail) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HoldDetail.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HoldDetail) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HoldDetail.Merge(m, src)
+}
+func (m *HoldDetail) XXX_Size() int {
+	return m.Size()
+}
+func (m *HoldDetail) XXX_DiscardUnknown() {
+	xxx_messageInfo_HoldDetail.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HoldDetail proto.InternalMessageInfo
+
+func (m *HoldDetail) GetReasonCode() string {
+	if m != nil {
+		return m.ReasonCode
+	}
+	return ""
+}
+
+func (m *HoldDetail) GetModule() string {
+	if m != nil {
+		return m.Module
+	}
+	return ""
+}
+
+func (m *HoldDetail) GetReleaseAuthority() string {
+	if m != nil {
+		return m.ReleaseAuthority
+	}
+	return ""
+}
+
+func (m *HoldDetail) GetReference() string {
+	if m != nil {
+		return m.Reference
+	}
+	return ""
+}
+
+func (m *HoldDetail) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func (m *HoldDetail) GetReleaseConditions() string {
+	if m != nil {
+		return m.ReleaseConditions
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*AccountHold)(nil), "provenance.hold.v1.AccountHold")
+	proto.RegisterType((*HoldDetail)(nil), "provenance.hold.v1.HoldDetail")
 }
 
 func init() { proto.RegisterFile("provenance/hold/v1/hold.proto", fileDescriptor_cfc6e4f15dd47e2b) }
 
 var fileDescriptor_cfc6e4f15dd47e2b = []byte{
-	// 298 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x2d, 0x28, 0xca, 0x2f,
-	0x4b, 0xcd, 0x4b, 0xcc, 0x4b, 0x4e, 0xd5, 0xcf, 0xc8, 0xcf, 0x49, 0xd1, 0x2f, 0x33, 0x04, 0xd3,
-	0x7a, 0x05, 0x45, 0xf9, 0x25, 0xf9, 0x42, 0x42, 0x08, 0x69, 0x3d, 0xb0, 0x70, 0x99, 0xa1, 0x94,
-	0x60, 0x62, 0x6e, 0x66, 0x5e, 0xbe, 0x3e, 0x98, 0x84, 0x28, 0x93, 0x92, 0x4b, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x4f, 0x4a, 0x2c, 0x4e, 0xd5, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4,
-	0x4f, 0xce, 0xcf, 0xcc, 0x83, 0xca, 0x8b, 0xa4, 0xe7, 0xa7, 0xe7, 0x83, 0x99, 0xfa, 0x20, 0x16,
-	0x44, 0x54, 0x69, 0x11, 0x23, 0x17, 0xb7, 0x63, 0x72, 0x72, 0x7e, 0x69, 0x5e, 0x89, 0x47, 0x7e,
-	0x4e, 0x8a, 0x90, 0x04, 0x17, 0x7b, 0x62, 0x4a, 0x4a, 0x51, 0x6a, 0x71, 0xb1, 0x04, 0xa3, 0x02,
-	0xa3, 0x06, 0x67, 0x10, 0x8c, 0x2b, 0x54, 0xc9, 0xc5, 0x96, 0x98, 0x0b, 0x52, 0x27, 0xc1, 0xa4,
-	0xc0, 0xac, 0xc1, 0x6d, 0x24, 0xa9, 0x07, 0xb1, 0x50, 0x0f, 0x64, 0xa1, 0x1e, 0xd4, 0x42, 0x3d,
-	0xe7, 0xfc, 0xcc, 0x3c, 0x27, 0xb7, 0x13, 0xf7, 0xe4, 0x19, 0x56, 0xdd, 0x97, 0xd7, 0x48, 0xcf,
-	0x2c, 0xc9, 0x28, 0x4d, 0xd2, 0x4b, 0xce, 0xcf, 0xd5, 0x87, 0xba, 0x0e, 0x42, 0xe9, 0x16, 0xa7,
-	0x64, 0xeb, 0x97, 0x54, 0x16, 0xa4, 0x16, 0x83, 0x35, 0x14, 0xcf, 0x7a, 0xbe, 0x41, 0x8b, 0x27,
-	0x27, 0x35, 0x3d, 0x31, 0xb9, 0x32, 0x1e, 0xe4, 0xe4, 0xe2, 0x15, 0xcf, 0x37, 0x68, 0x31, 0x06,
-	0x41, 0x2d, 0x74, 0x8a, 0x3d, 0xf1, 0x48, 0x8e, 0xf1, 0xc2, 0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4,
-	0x18, 0x27, 0x3c, 0x96, 0x63, 0xb8, 0xf0, 0x58, 0x8e, 0xe1, 0xc6, 0x63, 0x39, 0x06, 0x2e, 0xd1,
-	0x4c, 0xb0, 0x47, 0xd0, 0x82, 0x27, 0x80, 0x31, 0x4a, 0x0b, 0xc9, 0x6a, 0x84, 0x02, 0xdd, 0xcc,
-	0x7c, 0x24, 0x9e, 0x7e, 0x05, 0x38, 0x98, 0x93, 0xd8, 0xc0, 0x41, 0x61, 0x0c, 0x08, 0x00, 0x00,
-	0xff, 0xff, 0x01, 0x23, 0xb7, 0xfe, 0x88, 0x01, 0x00, 0x00,
+	// 410 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x52, 0xb1, 0x8e, 0x13, 0x31,
+	0x10, 0x8d, 0x73, 0x10, 0x74, 0x0e, 0x05, 0x67, 0x01, 0x5a, 0x4e, 0xb0, 0x39, 0x5d, 0x15, 0x05,
+	0xc5, 0x56, 0xe0, 0x0b, 0xee, 0x82, 0x10, 0x25, 0xba, 0x12, 0x09, 0x45, 0x8e, 0x3d, 0x24, 0x16,
+	0xbb, 0x9e, 0xc8, 0x76, 0x56, 0xec, 0x5f, 0x50, 0x53, 0x52, 0x21, 0xaa, 0xab, 0xf9, 0x82, 0x2b,
+	0xaf, 0xa4, 0x02, 0x94, 0x14, 0xf7, 0x1b, 0x68, 0xbd, 0xbb, 0x24, 0x12, 0xfd, 0x35, 0xeb, 0x99,
+	0xf7, 0xde, 0xea, 0xbd, 0x19, 0x0d, 0x7d, 0xb6, 0x72, 0x58, 0x80, 0x95, 0x56, 0x81, 0x58, 0x62,
+	0xa6, 0x45, 0x31, 0x89, 0x2f, 0x5f, 0x39, 0x0c, 0xc8, 0xd8, 0x8e, 0xe6, 0x11, 0x2e, 0x26, 0xc7,
+	0x47, 0x32, 0x37, 0x16, 0x45, 0xfc, 0xd6, 0xb2, 0xe3, 0x54, 0xa1, 0xcf, 0xd1, 0x8b, 0xb9, 0xf4,
+	0x20, 0x8a, 0xc9, 0x1c, 0x82, 0x9c, 0x08, 0x85, 0xc6, 0x36, 0xfc, 0xc3, 0x05, 0x2e, 0x30, 0x96,
+	0xa2, 0xaa, 0x6a, 0xf4, 0xf4, 0x2b, 0xa1, 0xfd, 0x33, 0xa5, 0x70, 0x6d, 0xc3, 0x1b, 0xcc, 0x34,
+	0x4b, 0xe8, 0x3d, 0xa9, 0xb5, 0x03, 0xef, 0x13, 0x72, 0x42, 0x86, 0x87, 0x17, 0x6d, 0xcb, 0x4a,
+	0xda, 0x93, 0x79, 0xa5, 0x4b, 0xba, 0x27, 0x07, 0xc3, 0xfe, 0x8b, 0x27, 0xbc, 0x36, 0xe4, 0x95,
+	0x21, 0x6f, 0x0c, 0xf9, 0x14, 0x8d, 0x3d, 0x7f, 0x7d, 0xf5, 0x6b, 0xd0, 0xf9, 0xfe, 0x7b, 0x30,
+	0x5c, 0x98, 0xb0, 0x5c, 0xcf, 0xb9, 0xc2, 0x5c, 0x34, 0xe9, 0xea, 0x67, 0xec, 0xf5, 0x47, 0x11,
+	0xca, 0x15, 0xf8, 0xf8, 0x83, 0xff, 0x72, 0x73, 0x39, 0xba, 0x9f, 0xc1, 0x42, 0xaa, 0x72, 0x56,
+	0x45, 0xf6, 0xdf, 0x6e, 0x2e, 0x47, 0xe4, 0xa2, 0x31, 0x3c, 0xfd, 0xd1, 0xa5, 0xb4, 0x4a, 0xf7,
+	0x0a, 0x82, 0x34, 0x19, 0x1b, 0xd0, 0xbe, 0x03, 0xe9, 0xd1, 0xce, 0x14, 0x6a, 0x68, 0x72, 0xd2,
+	0x1a, 0x9a, 0xa2, 0x06, 0xf6, 0x98, 0xf6, 0x72, 0xd4, 0xeb, 0x0c, 0x92, 0x6e, 0xe4, 0x9a, 0x8e,
+	0x3d, 0xa7, 0x47, 0x0e, 0x32, 0x90, 0x1e, 0x66, 0x72, 0x1d, 0x96, 0xe8, 0x4c, 0x28, 0x93, 0x83,
+	0x28, 0x79, 0xd0, 0x10, 0x67, 0x2d, 0xce, 0x9e, 0xd2, 0x43, 0x07, 0x1f, 0xc0, 0x81, 0x55, 0x90,
+	0xdc, 0x89, 0xa2, 0x1d, 0xb0, 0xb7, 0x8d, 0xbb, 0xb7, 0xbc, 0x0d, 0x36, 0xa6, 0xac, 0x9d, 0x42,
+	0xa1, 0xd5, 0x26, 0x18, 0xb4, 0x3e, 0xe9, 0xc5, 0x84, 0xed, 0x7c, 0xd3, 0x7f, 0xc4, 0xf9, 0xfb,
+	0xab, 0x4d, 0x4a, 0xae, 0x37, 0x29, 0xf9, 0xb3, 0x49, 0xc9, 0xe7, 0x6d, 0xda, 0xb9, 0xde, 0xa6,
+	0x9d, 0x9f, 0xdb, 0xb4, 0x43, 0x1f, 0x19, 0xe4, 0xff, 0xdf, 0xd6, 0x5b, 0xf2, 0x6e, 0xb4, 0x97,
+	0x74, 0x27, 0x18, 0x1b, 0xdc, 0xeb, 0xc4, 0xa7, 0x78, 0xa3, 0xf3, 0x5e, 0xbc, 0xa3, 0x97, 0x7f,
+	0x07, 0x00, 0xdc, 0x13, 0xec, 0x19, 0xc5, 0x02, 0x00, 0x00,
 }
 
 func (m *AccountHold) Marshal() (dAtA []byte, err error) {
@@ -154,6 +254,78 @@ func (m *AccountHold) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *HoldDetail) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HoldDetail) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HoldDetail) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ReleaseConditions) > 0 {
+		i -= len(m.ReleaseConditions)
+		copy(dAtA[i:], m.ReleaseConditions)
+		i = encodeVarintHold(dAtA, i, uint64(len(m.ReleaseConditions)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintHold(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Reference) > 0 {
+		i -= len(m.Reference)
+		copy(dAtA[i:], m.Reference)
+		i = encodeVarintHold(dAtA, i, uint64(len(m.Reference)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ReleaseAuthority) > 0 {
+		i -= len(m.ReleaseAuthority)
+		copy(dAtA[i:], m.ReleaseAuthority)
+		i = encodeVarintHold(dAtA, i, uint64(len(m.ReleaseAuthority)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Module) > 0 {
+		i -= len(m.Module)
+		copy(dAtA[i:], m.Module)
+		i = encodeVarintHold(dAtA, i, uint64(len(m.Module)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ReasonCode) > 0 {
+		i -= len(m.ReasonCode)
+		copy(dAtA[i:], m.ReasonCode)
+		i = encodeVarintHold(dAtA, i, uint64(len(m.ReasonCode)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintHold(dAtA []byte, offset int, v uint64) int {
 	offset -= sovHold(v)
 	base := offset
@@ -184,6 +356,41 @@ func (m *AccountHold) Size() (n int) {
 	return n
 }
 
+func (m *HoldDetail) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ReasonCode)
+	if l > 0 {
+		n += 1 + l + sovHold(uint64(l))
+	}
+	l = len(m.Module)
+	if l > 0 {
+		n += 1 + l + sovHold(uint64(l))
+	}
+	l = len(m.ReleaseAuthority)
+	if l > 0 {
+		n += 1 + l + sovHold(uint64(l))
+	}
+	l = len(m.Reference)
+	if l > 0 {
+		n += 1 + l + sovHold(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovHold(uint64(l))
+		}
+	}
+	l = len(m.ReleaseConditions)
+	if l > 0 {
+		n += 1 + l + sovHold(uint64(l))
+	}
+	return n
+}
+
 func sovHold(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -306,6 +513,250 @@ func (m *AccountHold) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *HoldDetail) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHold
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HoldDetail: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HoldDetail: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReasonCode", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHold
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHold
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHold
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReasonCode = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Module", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHold
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHold
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHold
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Module = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReleaseAuthority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHold
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHold
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHold
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReleaseAuthority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reference", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHold
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHold
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHold
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reference = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHold
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHold
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHold
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReleaseConditions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHold
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHold
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHold
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReleaseConditions = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHold(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHold
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipHold(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
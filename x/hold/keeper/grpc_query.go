@@ -37,6 +37,10 @@ func (k Keeper) GetHolds(goCtx context.Context, req *hold.GetHoldsRequest) (*hol
 	if err != nil {
 		return nil, err
 	}
+	resp.Details, err = k.GetHoldDetails(ctx, addr)
+	if err != nil {
+		return nil, err
+	}
 	return resp, err
 }
 
@@ -19,6 +19,8 @@ type Keeper struct {
 	storeKey storetypes.StoreKey
 
 	bankKeeper hold.BankKeeper
+
+	detailsGetters []hold.HoldDetailsGetter
 }
 
 func NewKeeper(cdc codec.BinaryCodec, storeKey storetypes.StoreKey, bankKeeper hold.BankKeeper) Keeper {
@@ -31,6 +33,26 @@ func NewKeeper(cdc codec.BinaryCodec, storeKey storetypes.StoreKey, bankKeeper h
 	return rv
 }
 
+// AppendHoldDetailsGetter adds getters of the details of holds placed by other modules.
+// They are used to describe an account's holds in the GetHolds query.
+func (k *Keeper) AppendHoldDetailsGetter(getters ...hold.HoldDetailsGetter) {
+	k.detailsGetters = append(k.detailsGetters, getters...)
+}
+
+// GetHoldDetails gets the details of the holds placed on an account's funds from each of the hold details getters.
+func (k Keeper) GetHoldDetails(ctx sdk.Context, addr sdk.AccAddress) ([]hold.HoldDetail, error) {
+	var rv []hold.HoldDetail
+	var errs []error
+	for _, getter := range k.detailsGetters {
+		details, err := getter(ctx, addr)
+		if err != nil {
+			errs = append(errs, err)
+		}
+		rv = append(rv, details...)
+	}
+	return rv, errors.Join(errs...)
+}
+
 // setHoldCoinAmount updates the store with the provided hold info.
 // If the amount is zero, the hold coin entry for addr+denom is deleted.
 // Otherwise, the hold coin entry for addr+denom is created/updated in the provided amount.
@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"errors"
 	"fmt"
 	"maps"
 	"slices"
@@ -1316,6 +1317,35 @@ func (s *TestSuite) TestKeeper_GetAllAccountHolds() {
 	})
 }
 
+func (s *TestSuite) TestKeeper_GetHoldDetails() {
+	detail1 := hold.NewHoldDetail("test_order", "test", s.addr5.String(), "order 1", s.coins("10banana"), "when filled")
+	detail2 := hold.NewHoldDetail("test_escrow", "test", "", "escrow 2", s.coins("3cucumber"), "when closed")
+	getter := func(details []hold.HoldDetail, errStr string) hold.HoldDetailsGetter {
+		return func(_ sdk.Context, addr sdk.AccAddress) ([]hold.HoldDetail, error) {
+			if !addr.Equals(s.addr1) {
+				return nil, nil
+			}
+			var err error
+			if len(errStr) > 0 {
+				err = errors.New(errStr)
+			}
+			return details, err
+		}
+	}
+
+	k := s.keeper
+	k.AppendHoldDetailsGetter(getter([]hold.HoldDetail{detail1}, ""), getter(nil, "getter error"))
+	k.AppendHoldDetailsGetter(getter([]hold.HoldDetail{detail2}, ""))
+
+	details, err := k.GetHoldDetails(s.ctx, s.addr1)
+	s.Assert().EqualError(err, "getter error", "GetHoldDetails(addr1) error")
+	s.Assert().Equal([]hold.HoldDetail{detail1, detail2}, details, "GetHoldDetails(addr1) details")
+
+	details, err = k.GetHoldDetails(s.ctx, s.addr2)
+	s.Assert().NoError(err, "GetHoldDetails(addr2) error")
+	s.Assert().Empty(details, "GetHoldDetails(addr2) details")
+}
+
 func (s *TestSuite) TestVestingAndHoldOverTime() {
 	// This is a bit of a complex test that tracks a vesting account over time
 	// while adding, removing, delegating, undelegating, holding, and releasing funds.
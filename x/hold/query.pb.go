@@ -76,6 +76,9 @@ var xxx_messageInfo_GetHoldsRequest proto.InternalMessageInfo
 type GetHoldsResponse struct {
 	// amount is the total on hold for the requested address.
 	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	// details describe the holds placed on the address's funds by each module, and what will release them.
+	// Funds placed on hold by something that does not provide details are only included in the amount.
+	Details []HoldDetail `protobuf:"bytes,2,rep,name=details,proto3" json:"details"`
 }
 
 func (m *GetHoldsResponse) Reset()         { *m = GetHoldsResponse{} }
@@ -215,41 +218,43 @@ func init() {
 func init() { proto.RegisterFile("provenance/hold/v1/query.proto", fileDescriptor_e41c9f383440a9df) }
 
 var fileDescriptor_e41c9f383440a9df = []byte{
-	// 539 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x93, 0x3f, 0x6f, 0xd3, 0x4e,
-	0x18, 0xc7, 0x7d, 0xfd, 0xa9, 0xfd, 0x95, 0x0b, 0x12, 0x70, 0x80, 0x94, 0x06, 0x70, 0x42, 0x0a,
-	0x6d, 0x14, 0xd4, 0x3b, 0x25, 0xa8, 0x0b, 0x5b, 0x8b, 0xd4, 0x30, 0x96, 0x8c, 0x48, 0x08, 0x5d,
-	0xec, 0xab, 0x6b, 0xe1, 0xdc, 0xe3, 0xe6, 0xce, 0x11, 0x11, 0x62, 0xa0, 0x13, 0x63, 0x25, 0x84,
-	0x90, 0x98, 0x3a, 0x22, 0xa6, 0xbe, 0x8c, 0x8e, 0x95, 0x58, 0x98, 0x00, 0x25, 0x48, 0xe5, 0x65,
-	0x20, 0xdb, 0x67, 0xc5, 0x29, 0x2e, 0x5d, 0xec, 0xb3, 0x9f, 0xef, 0xe3, 0xef, 0xe7, 0xf9, 0x63,
-	0x6c, 0x87, 0x03, 0x18, 0x0a, 0xc9, 0xa5, 0x23, 0xd8, 0x2e, 0x04, 0x2e, 0x1b, 0xb6, 0xd8, 0x5e,
-	0x24, 0x06, 0x23, 0x1a, 0x0e, 0x40, 0x03, 0x21, 0xd3, 0x38, 0x8d, 0xe3, 0x74, 0xd8, 0xaa, 0x5c,
-	0xe3, 0x7d, 0x5f, 0x02, 0x4b, 0xae, 0xa9, 0xac, 0xd2, 0x74, 0x40, 0xf5, 0x41, 0xb1, 0x1e, 0x57,
-	0x22, 0xcd, 0x67, 0xc3, 0x56, 0x4f, 0x68, 0xde, 0x62, 0x21, 0xf7, 0x7c, 0xc9, 0xb5, 0x0f, 0xd2,
-	0x68, 0xed, 0xbc, 0x36, 0x53, 0x39, 0xe0, 0x67, 0xf1, 0x1b, 0x1e, 0x78, 0x90, 0x1c, 0x59, 0x7c,
-	0x32, 0x6f, 0x6f, 0x7b, 0x00, 0x5e, 0x20, 0x18, 0x0f, 0x7d, 0xc6, 0xa5, 0x04, 0x9d, 0x7c, 0x52,
-	0x99, 0xe8, 0x9d, 0x82, 0x32, 0x12, 0xdc, 0x24, 0x5c, 0x5f, 0xc7, 0x57, 0x3a, 0x42, 0x3f, 0x81,
-	0xc0, 0x55, 0x5d, 0xb1, 0x17, 0x09, 0xa5, 0x49, 0x19, 0xff, 0xcf, 0x5d, 0x77, 0x20, 0x94, 0x2a,
-	0xa3, 0x1a, 0x6a, 0x5c, 0xea, 0x66, 0x8f, 0x8f, 0x16, 0xdf, 0x1d, 0x56, 0xad, 0xdf, 0x87, 0x55,
-	0xab, 0xfe, 0x11, 0xe1, 0xab, 0xd3, 0x3c, 0x15, 0x82, 0x54, 0x82, 0x8c, 0xf0, 0x02, 0xef, 0x43,
-	0x24, 0x75, 0x19, 0xd5, 0xfe, 0x6b, 0x94, 0xda, 0x4b, 0x34, 0xad, 0x87, 0xc6, 0xf5, 0x50, 0x53,
-	0x0f, 0x7d, 0x0c, 0xbe, 0xdc, 0xdc, 0x3a, 0xfe, 0x5e, 0xb5, 0xbe, 0xfc, 0xa8, 0x36, 0x3c, 0x5f,
-	0xef, 0x46, 0x3d, 0xea, 0x40, 0x9f, 0x99, 0xe2, 0xd3, 0xdb, 0x9a, 0x72, 0x5f, 0x32, 0x3d, 0x0a,
-	0x85, 0x4a, 0x12, 0xd4, 0xa7, 0xd3, 0xa3, 0xe6, 0xe5, 0x40, 0x78, 0xdc, 0x19, 0xbd, 0x88, 0x3b,
-	0xa2, 0x3e, 0x9f, 0x1e, 0x35, 0x51, 0xd7, 0x18, 0xe6, 0xc8, 0x76, 0x30, 0xe9, 0x08, 0xbd, 0x11,
-	0x04, 0x33, 0x35, 0x6d, 0x61, 0x3c, 0xed, 0x76, 0xd9, 0xa9, 0xa1, 0x46, 0xa9, 0xbd, 0x32, 0x83,
-	0x97, 0x8e, 0x36, 0x83, 0xdc, 0xe6, 0x9e, 0x30, 0xb9, 0xdd, 0x5c, 0x66, 0xce, 0xe7, 0x03, 0xc2,
-	0xd7, 0x67, 0x8c, 0x4c, 0x13, 0xd6, 0xf1, 0x7c, 0xdc, 0x5e, 0x65, 0x7a, 0x50, 0xa5, 0x7f, 0xaf,
-	0x09, 0xdd, 0x70, 0x9c, 0x98, 0x3a, 0x4e, 0xec, 0xa6, 0x6a, 0xd2, 0x29, 0x00, 0x5c, 0xbd, 0x10,
-	0x30, 0xf5, 0xcc, 0x13, 0xb6, 0x0f, 0xe6, 0xf0, 0xfc, 0xd3, 0x58, 0x4a, 0xf6, 0x11, 0x5e, 0xcc,
-	0x66, 0x44, 0x96, 0x8b, 0x38, 0xce, 0x4c, 0xbe, 0x72, 0xef, 0xdf, 0xa2, 0xd4, 0xad, 0xfe, 0x60,
-	0xff, 0xeb, 0xaf, 0xf7, 0x73, 0xf7, 0xc9, 0x32, 0x2b, 0x58, 0xad, 0x9d, 0x48, 0xba, 0x8a, 0xbd,
-	0x36, 0x1b, 0xf3, 0x86, 0xbc, 0x45, 0xb8, 0x94, 0x6b, 0x13, 0x59, 0x39, 0xc7, 0xe2, 0xcc, 0xc0,
-	0x2a, 0xab, 0x17, 0xea, 0x0c, 0xcd, 0xdd, 0x84, 0xe6, 0x16, 0x59, 0x3a, 0x97, 0x66, 0xf3, 0xf9,
-	0xf1, 0xd8, 0x46, 0x27, 0x63, 0x1b, 0xfd, 0x1c, 0xdb, 0xe8, 0x60, 0x62, 0x5b, 0x27, 0x13, 0xdb,
-	0xfa, 0x36, 0xb1, 0x2d, 0x7c, 0xd3, 0x87, 0x02, 0x9f, 0x6d, 0xf4, 0xac, 0x99, 0xdb, 0xcb, 0xa9,
-	0x60, 0xcd, 0x87, 0xbc, 0xcb, 0xab, 0xc4, 0xa7, 0xb7, 0x90, 0xfc, 0x49, 0x0f, 0xff, 0x04, 0x00,
-	0x00, 0xff, 0xff, 0xe6, 0x68, 0x91, 0x92, 0x31, 0x04, 0x00, 0x00,
+	// 562 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x53, 0x3f, 0x6f, 0xd3, 0x4e,
+	0x18, 0xf6, 0xe5, 0xf7, 0xeb, 0x1f, 0x2e, 0x48, 0xc0, 0x01, 0x52, 0x1a, 0xc0, 0x09, 0x29, 0xb4,
+	0x51, 0x50, 0x7d, 0x4a, 0x50, 0x17, 0x06, 0xa4, 0x06, 0xd4, 0x30, 0x16, 0x8f, 0x48, 0x08, 0x5d,
+	0xec, 0xab, 0x6b, 0xe1, 0xf8, 0x75, 0x73, 0x97, 0x88, 0x08, 0x31, 0xd0, 0x89, 0xb1, 0x12, 0x62,
+	0x61, 0xea, 0x88, 0x98, 0xfa, 0x31, 0x3a, 0x56, 0x62, 0x80, 0x09, 0x50, 0x82, 0x54, 0x3e, 0x06,
+	0xf2, 0xf9, 0xac, 0x38, 0xc5, 0xa5, 0x8b, 0x7d, 0xf6, 0xfb, 0x3c, 0x7e, 0x9e, 0xe7, 0x7d, 0x5f,
+	0x63, 0x33, 0xea, 0xc3, 0x90, 0x87, 0x2c, 0x74, 0x38, 0xdd, 0x81, 0xc0, 0xa5, 0xc3, 0x26, 0xdd,
+	0x1d, 0xf0, 0xfe, 0xc8, 0x8a, 0xfa, 0x20, 0x81, 0x90, 0x69, 0xdd, 0x8a, 0xeb, 0xd6, 0xb0, 0x59,
+	0xbe, 0xc2, 0x7a, 0x7e, 0x08, 0x54, 0x5d, 0x13, 0x58, 0xb9, 0xe1, 0x80, 0xe8, 0x81, 0xa0, 0x5d,
+	0x26, 0x78, 0xc2, 0xa7, 0xc3, 0x66, 0x97, 0x4b, 0xd6, 0xa4, 0x11, 0xf3, 0xfc, 0x90, 0x49, 0x1f,
+	0x42, 0x8d, 0x35, 0xb3, 0xd8, 0x14, 0xe5, 0x80, 0x9f, 0xd6, 0xaf, 0x79, 0xe0, 0x81, 0x3a, 0xd2,
+	0xf8, 0xa4, 0xdf, 0xde, 0xf4, 0x00, 0xbc, 0x80, 0x53, 0x16, 0xf9, 0x94, 0x85, 0x21, 0x48, 0xf5,
+	0x49, 0xa1, 0xab, 0xb7, 0x72, 0x62, 0x28, 0xbb, 0xaa, 0x5c, 0x5b, 0xc7, 0x97, 0x3a, 0x5c, 0x3e,
+	0x81, 0xc0, 0x15, 0x36, 0xdf, 0x1d, 0x70, 0x21, 0x49, 0x09, 0x2f, 0x30, 0xd7, 0xed, 0x73, 0x21,
+	0x4a, 0xa8, 0x8a, 0xea, 0x17, 0xec, 0xf4, 0xf1, 0xc1, 0xe2, 0xbb, 0x83, 0x8a, 0xf1, 0xfb, 0xa0,
+	0x62, 0xd4, 0xbe, 0x22, 0x7c, 0x79, 0xca, 0x13, 0x11, 0x84, 0x82, 0x93, 0x11, 0x9e, 0x67, 0x3d,
+	0x18, 0x84, 0xb2, 0x84, 0xaa, 0xff, 0xd5, 0x8b, 0xad, 0x25, 0x2b, 0xc9, 0x63, 0xc5, 0x79, 0x2c,
+	0x9d, 0xc7, 0x7a, 0x04, 0x7e, 0xd8, 0xde, 0x3c, 0xfa, 0x5e, 0x31, 0x3e, 0xff, 0xa8, 0xd4, 0x3d,
+	0x5f, 0xee, 0x0c, 0xba, 0x96, 0x03, 0x3d, 0xaa, 0xc3, 0x27, 0xb7, 0x35, 0xe1, 0xbe, 0xa4, 0x72,
+	0x14, 0x71, 0xa1, 0x08, 0xe2, 0xe3, 0xc9, 0x61, 0xe3, 0x62, 0xc0, 0x3d, 0xe6, 0x8c, 0x5e, 0xc4,
+	0x1d, 0x11, 0x9f, 0x4e, 0x0e, 0x1b, 0xc8, 0xd6, 0x82, 0xe4, 0x21, 0x5e, 0x70, 0xb9, 0x64, 0x7e,
+	0x20, 0x4a, 0x05, 0xa5, 0x6d, 0x5a, 0x7f, 0x8f, 0xc7, 0x8a, 0xed, 0x3e, 0x56, 0xb0, 0xf6, 0xff,
+	0xb1, 0x01, 0x3b, 0x25, 0x65, 0x92, 0x6d, 0x63, 0xd2, 0xe1, 0x72, 0x23, 0x08, 0x66, 0x7a, 0xb2,
+	0x89, 0xf1, 0x74, 0x5a, 0x25, 0xa7, 0x8a, 0xea, 0xc5, 0xd6, 0xca, 0x4c, 0xbc, 0x64, 0x35, 0xd2,
+	0x90, 0x5b, 0xcc, 0xe3, 0x9a, 0x6b, 0x67, 0x98, 0x19, 0x9d, 0x0f, 0x08, 0x5f, 0x9d, 0x11, 0xd2,
+	0x4d, 0x5c, 0xc7, 0x73, 0xb1, 0x5d, 0xa1, 0x7b, 0x58, 0xc9, 0xcb, 0xb1, 0xe1, 0x38, 0x71, 0xea,
+	0x98, 0x68, 0x27, 0x68, 0xd2, 0xc9, 0x31, 0xb8, 0x7a, 0xae, 0xc1, 0x44, 0x33, 0xeb, 0xb0, 0xb5,
+	0x5f, 0xc0, 0x73, 0x4f, 0x63, 0x28, 0xd9, 0x43, 0x78, 0x31, 0x9d, 0x31, 0x59, 0xce, 0xf3, 0x71,
+	0x6a, 0x73, 0xca, 0x77, 0xfe, 0x0d, 0x4a, 0xd4, 0x6a, 0xf7, 0xf6, 0xbe, 0xfc, 0x7a, 0x5f, 0xb8,
+	0x4b, 0x96, 0x69, 0xce, 0x6a, 0x6e, 0x0f, 0x42, 0x57, 0xd0, 0xd7, 0x7a, 0xe3, 0xde, 0x90, 0xb7,
+	0x08, 0x17, 0x33, 0x6d, 0x22, 0x2b, 0x67, 0x48, 0x9c, 0x1a, 0x58, 0x79, 0xf5, 0x5c, 0x9c, 0x76,
+	0x73, 0x5b, 0xb9, 0xb9, 0x41, 0x96, 0xce, 0x74, 0xd3, 0x7e, 0x7e, 0x34, 0x36, 0xd1, 0xf1, 0xd8,
+	0x44, 0x3f, 0xc7, 0x26, 0xda, 0x9f, 0x98, 0xc6, 0xf1, 0xc4, 0x34, 0xbe, 0x4d, 0x4c, 0x03, 0x5f,
+	0xf7, 0x21, 0x47, 0x67, 0x0b, 0x3d, 0x6b, 0x64, 0xf6, 0x7a, 0x0a, 0x58, 0xf3, 0x21, 0xab, 0xf2,
+	0x4a, 0xe9, 0x74, 0xe7, 0xd5, 0x9f, 0x78, 0xff, 0xcf, 0x00, 0x9e, 0xcc, 0xd1, 0x41, 0x71, 0x04,
+	0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -423,6 +428,20 @@ func (m *GetHoldsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Details) > 0 {
+		for iNdEx := len(m.Details) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Details[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if len(m.Amount) > 0 {
 		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -564,6 +583,12 @@ func (m *GetHoldsResponse) Size() (n int) {
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
+	if len(m.Details) > 0 {
+		for _, e := range m.Details {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -750,6 +775,40 @@ func (m *GetHoldsResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Details = append(m.Details, HoldDetail{})
+			if err := m.Details[len(m.Details)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -57,7 +57,16 @@ func (h IbcHooks) OnRecvPacketOverride(im IBCMiddleware, ctx sdktypes.Context, p
 	if err := h.markerHooks.AddUpdateMarker(ctx, packet, h.ibcKeeper); err != nil {
 		return NewEmitErrorAcknowledgement(ctx, types.ErrMarkerError, err.Error())
 	}
-	return h.wasmHooks.OnRecvPacketOverride(im, ctx, packet, relayer)
+	ack := h.wasmHooks.OnRecvPacketOverride(im, ctx, packet, relayer)
+	if ack == nil || !ack.Success() {
+		return ack
+	}
+	// The memo actions are run after the funds have been received so that they can be used by the actions.
+	// If one fails, the error ack causes the whole receive to be reverted.
+	if err := h.markerHooks.ExecuteMemoActions(ctx, packet); err != nil {
+		return NewEmitErrorAcknowledgement(ctx, types.ErrMarkerError, err.Error())
+	}
+	return ack
 }
 
 // SendPacketAfterHook function is executed after ibc's SendPacket
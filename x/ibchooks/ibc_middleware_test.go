@@ -298,6 +298,35 @@ func (suite *HooksTestSuite) TestFundsAreTransferredToTheContract() {
 	suite.Require().Equal(sdkmath.NewInt(1), balance.Amount)
 }
 
+func (suite *HooksTestSuite) TestMarkerMemoActions() {
+	chainA := suite.chainA.GetProvenanceApp()
+	receiver := suite.chainA.SenderAccount.GetAddress()
+	localDenom := ibchooks.MustExtractDenomFromPacketOnRecv(suite.makeMockPacket("", "", 0))
+	markerAddr := markertypes.MustGetMarkerAddress(localDenom)
+	err := chainA.NameKeeper.SetNameRecord(suite.chainA.GetContext(), "subscribed", markerAddr, false)
+	suite.Require().NoError(err, "SetNameRecord")
+
+	// An action that the marker hasn't allowed should cause an error ack and the funds should not be received.
+	ackBytes := suite.receivePacket(receiver.String(), `{"marker":{},"marker-actions":{"attribute":{"name":"subscribed","value":"gold"}}}`)
+	suite.Require().Contains(string(ackBytes), "error", "ack with action that is not allowed")
+	balance := chainA.BankKeeper.GetBalance(suite.chainA.GetContext(), receiver, localDenom)
+	suite.Require().Equal(sdkmath.NewInt(0), balance.Amount, "balance after action that is not allowed")
+
+	config := markertypes.NewIBCMemoActionConfig(localDenom, markertypes.NewIBCMemoAction(markertypes.IBCMemoActionAttribute, "subscribed", ""))
+	err = chainA.MarkerKeeper.SetIBCMemoActionConfig(suite.chainA.GetContext(), markerAddr, config)
+	suite.Require().NoError(err, "SetIBCMemoActionConfig")
+
+	// Once allowed, the attribute action should stamp the attribute on the receiver.
+	ackBytes = suite.receivePacketWithSequence(receiver.String(), `{"marker":{},"marker-actions":{"attribute":{"name":"subscribed","value":"gold"}}}`, 1)
+	suite.Require().NotContains(string(ackBytes), "error", "ack with attribute action")
+	balance = chainA.BankKeeper.GetBalance(suite.chainA.GetContext(), receiver, localDenom)
+	suite.Require().Equal(sdkmath.NewInt(1), balance.Amount, "balance after attribute action")
+	attrs, err := chainA.AttributeKeeper.GetAttributes(suite.chainA.GetContext(), receiver.String(), "subscribed")
+	suite.Require().NoError(err, "GetAttributes")
+	suite.Require().Len(attrs, 1, "receiver attributes")
+	suite.Require().Equal("gold", string(attrs[0].Value), "attribute value")
+}
+
 // If the wasm call wails, the contract acknowledgement should be an error and the funds returned
 func (suite *HooksTestSuite) TestFundsAreReturnedOnFailedContractExec() {
 	// Setup contract
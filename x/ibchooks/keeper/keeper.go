@@ -21,6 +21,7 @@ import (
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
 
+	"github.com/provenance-io/provenance/internal/provutils"
 	"github.com/provenance-io/provenance/x/ibchooks/types"
 )
 
@@ -54,7 +55,7 @@ func NewKeeper(
 
 // Logger returns a logger for the x/tokenfactory module
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
-	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+	return provutils.ModuleLogger(ctx, types.ModuleName)
 }
 
 // GetAuthority is signer of the proposal
@@ -290,7 +291,7 @@ func NewSuccessAckError(ctx sdk.Context, err error, errorContent []byte, errorCo
 	attributes[0] = sdk.NewAttribute("error", err.Error())
 	for i, s := range errorContexts {
 		attributes[i+1] = sdk.NewAttribute("error-context", s)
-		logger.Error(fmt.Sprintf("error-context: %v", s))
+		logger.Error("ibc acknowledgement error", "error", err, "error_context", s)
 	}
 
 	ctx.EventManager().EmitEvents(sdk.Events{
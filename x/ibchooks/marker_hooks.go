@@ -2,10 +2,12 @@ package ibchooks
 
 import (
 	"encoding/json"
+	"fmt"
 	"strconv"
 	"strings"
 
 	sdkerrors "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
 
 	sdktypes "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
@@ -66,6 +68,43 @@ func (h MarkerHooks) AddUpdateMarker(ctx sdktypes.Context, packet exported.Packe
 	return h.createNewIbcMarker(ctx, data, ibcDenom, coinType, transferAuthAddrs, allowForceTransfer, packet, ibcKeeper)
 }
 
+// ExecuteMemoActions runs the marker actions requested in the packet's memo using the marker keeper's memo handlers.
+func (h MarkerHooks) ExecuteMemoActions(ctx sdktypes.Context, packet exported.PacketI) error {
+	var data transfertypes.FungibleTokenPacketData
+	if err := json.Unmarshal(packet.GetData(), &data); err != nil {
+		return err
+	}
+	if found, _ := jsonStringHasKey(data.GetMemo(), markertypes.IBCMemoActionsKey); !found {
+		return nil
+	}
+
+	denom := MustExtractDenomFromPacketOnRecv(packet)
+	amount, ok := sdkmath.NewIntFromString(data.Amount)
+	if !ok {
+		return fmt.Errorf("invalid packet amount %q", data.Amount)
+	}
+	receiver, err := sdktypes.AccAddressFromBech32(data.Receiver)
+	if err != nil {
+		return err
+	}
+	markerAddress, err := markertypes.MarkerAddress(denom)
+	if err != nil {
+		return err
+	}
+	marker, err := h.MarkerKeeper.GetMarker(ctx, markerAddress)
+	if err != nil {
+		return err
+	}
+
+	info := markertypes.IBCReceiveInfo{
+		Marker:   marker,
+		Sender:   data.Sender,
+		Receiver: receiver,
+		Amount:   sdktypes.NewCoin(denom, amount),
+	}
+	return h.MarkerKeeper.HandleIBCMemoActions(ctx, info, data.GetMemo())
+}
+
 func (h MarkerHooks) updateMarkerProperties(ctx sdktypes.Context, transferAuthAddrs []sdktypes.AccAddress, marker markertypes.MarkerAccountI, allowForceTransfer bool) error {
 	if marker.GetMarkerType() != markertypes.MarkerType_RestrictedCoin {
 		return nil
@@ -139,6 +139,31 @@ func NewMarkerPayload(transferAuthAddrs []sdk.AccAddress, allowForceTransfer boo
 	}
 }
 
+// MarkerMemoActionWasm is the name of the marker memo action that executes a wasm contract.
+const MarkerMemoActionWasm = "wasm"
+
+// MarkerWasmActionPayload is the payload of the wasm marker memo action.
+// The contract must be allowed by the marker, and the msg can only be provided if the marker doesn't set it.
+type MarkerWasmActionPayload struct {
+	Contract string          `json:"contract"`
+	Msg      json.RawMessage `json:"msg,omitempty"`
+}
+
+// MarkerIBCReceiveMsg is the message a contract is executed with for the wasm marker memo action
+type MarkerIBCReceiveMsg struct {
+	MarkerIBCReceive MarkerIBCReceive `json:"marker_ibc_receive"`
+}
+
+// MarkerIBCReceive describes marker funds received over ibc for the wasm marker memo action
+type MarkerIBCReceive struct {
+	Denom  string `json:"denom"`
+	Amount string `json:"amount"`
+	// Sender is the sender on the source chain as reported in the packet. It is not verified by this chain.
+	Sender   string    `json:"sender"`
+	Receiver string    `json:"receiver"`
+	Msg      JSONBytes `json:"msg"`
+}
+
 // NewIbcLifecycleCompleteAck returns a new ibc lifecycle complete acknowledgment object for json serialization
 func NewIbcLifecycleCompleteAck(sourceChannel string, sequence uint64, ackAsJSON []byte, success bool) IbcLifecycleComplete {
 	ibcLifecycleCompleteAck := IbcLifecycleCompleteAck{
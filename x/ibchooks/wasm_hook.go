@@ -19,6 +19,7 @@ import (
 
 	"github.com/provenance-io/provenance/x/ibchooks/keeper"
 	"github.com/provenance-io/provenance/x/ibchooks/types"
+	markertypes "github.com/provenance-io/provenance/x/marker/types"
 )
 
 type WasmHooks struct {
@@ -136,6 +137,57 @@ func (h WasmHooks) OnRecvPacketOverride(im IBCMiddleware, ctx sdktypes.Context,
 	return channeltypes.NewResultAcknowledgement(bz)
 }
 
+// HandleIBCMemoAction executes a contract for the wasm marker memo action.
+// The contract is the target and must be allowed by the marker. The msg is the value, so the memo can only provide
+// it when the marker doesn't set it. The contract must also have transfer access on the marker, and is executed by
+// the marker's account without any funds.
+func (h WasmHooks) HandleIBCMemoAction(ctx sdktypes.Context, info markertypes.IBCReceiveInfo, allowed []markertypes.IBCMemoAction, payload json.RawMessage) error {
+	if !h.ProperlyConfigured() {
+		return fmt.Errorf("wasm hooks are not properly configured")
+	}
+	var wasmPayload types.MarkerWasmActionPayload
+	if err := json.Unmarshal(payload, &wasmPayload); err != nil {
+		return fmt.Errorf("invalid payload: %w", err)
+	}
+	msg, err := markertypes.SelectIBCMemoAction(allowed, wasmPayload.Contract, string(wasmPayload.Msg))
+	if err != nil {
+		return err
+	}
+	if len(msg) > 0 && !json.Valid([]byte(msg)) {
+		return fmt.Errorf("invalid msg for contract %s: not json", wasmPayload.Contract)
+	}
+	contractAddr, err := sdktypes.AccAddressFromBech32(wasmPayload.Contract)
+	if err != nil {
+		return sdkerrors.Wrap(types.ErrInvalidContractAddr, wasmPayload.Contract)
+	}
+	if !info.Marker.HasAccess(contractAddr.String(), markertypes.Access_Transfer) {
+		return fmt.Errorf("contract %s does not have %s access on marker %s", contractAddr, markertypes.Access_Transfer, info.Marker.GetDenom())
+	}
+
+	msgBytes, err := json.Marshal(types.MarkerIBCReceiveMsg{
+		MarkerIBCReceive: types.MarkerIBCReceive{
+			Denom:    info.Amount.Denom,
+			Amount:   info.Amount.Amount.String(),
+			Sender:   info.Sender,
+			Receiver: info.Receiver.String(),
+			Msg:      types.JSONBytes(msg),
+		},
+	})
+	if err != nil {
+		return sdkerrors.Wrap(types.ErrMarshaling, err.Error())
+	}
+
+	execMsg := wasmtypes.MsgExecuteContract{
+		Sender:   info.Marker.GetAddress().String(),
+		Contract: contractAddr.String(),
+		Msg:      msgBytes,
+	}
+	if _, err = h.execWasmMsg(ctx, &execMsg); err != nil {
+		return sdkerrors.Wrap(types.ErrWasmError, err.Error())
+	}
+	return nil
+}
+
 func (h WasmHooks) execWasmMsg(ctx sdktypes.Context, execMsg *wasmtypes.MsgExecuteContract) (*wasmtypes.MsgExecuteContractResponse, error) {
 	if err := execMsg.ValidateBasic(); err != nil {
 		return nil, fmt.Errorf(types.ErrBadExecutionMsg, err.Error())
@@ -483,7 +535,7 @@ func NewEmitErrorAcknowledgement(ctx sdktypes.Context, err error, errorContexts
 	attributes[0] = sdktypes.NewAttribute("error", err.Error())
 	for i, s := range errorContexts {
 		attributes[i+1] = sdktypes.NewAttribute("error-context", s)
-		logger.Error(fmt.Sprintf("error-context: %v", s))
+		logger.Error("ibc acknowledgement error", "error", err, "error_context", s)
 	}
 
 	ctx.EventManager().EmitEvents(sdktypes.Events{
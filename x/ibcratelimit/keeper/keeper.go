@@ -10,6 +10,7 @@ import (
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/cosmos/gogoproto/proto"
 
+	"github.com/provenance-io/provenance/internal/provutils"
 	"github.com/provenance-io/provenance/x/ibcratelimit"
 )
 
@@ -37,7 +38,7 @@ func NewKeeper(
 
 // Logger Creates a new logger for the module.
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
-	return ctx.Logger().With("module", "x/"+ibcratelimit.ModuleName)
+	return provutils.ModuleLogger(ctx, ibcratelimit.ModuleName)
 }
 
 // GetParams Gets the params for the module.
@@ -91,6 +92,6 @@ func (k Keeper) ValidateAuthority(addr string) error {
 func (k Keeper) emitEvent(ctx sdk.Context, event proto.Message) {
 	err := ctx.EventManager().EmitTypedEvent(event)
 	if err != nil {
-		k.Logger(ctx).Error("error emitting event %#v: %v", event, err)
+		k.Logger(ctx).Error("error emitting event", "event", event, "error", err)
 	}
 }
@@ -50,3 +50,14 @@ func BeginBlocker(ctx sdk.Context, k keeper.Keeper, bk bankkeeper.Keeper) {
 		panic(err)
 	}
 }
+
+// EndBlocker returns the end blocker for the marker module.
+func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, telemetry.Now(), telemetry.MetricKeyEndBlocker)
+	// Pay out the next batch of holders for each distribution that has started.
+	k.ProcessDistributions(ctx)
+	// Burn the marker escrow of each scheduled burn that has reached its burn height.
+	k.ProcessScheduledBurns(ctx)
+	// Remove the access grants that have expired.
+	k.ProcessExpiredAccessGrants(ctx)
+}
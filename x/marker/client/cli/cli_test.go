@@ -458,7 +458,7 @@ func (s *IntegrationTestSuite) TestMarkerQueryCommands() {
 			[]string{
 				fmt.Sprintf("--%s=json", cmtcli.OutputFlag),
 			},
-			`{"max_total_supply":"1000000","enable_governance":true,"unrestricted_denom_regex":"[a-zA-Z][a-zA-Z0-9\\-\\.]{2,83}","max_supply":"1000000"}`,
+			`{"max_total_supply":"1000000","enable_governance":true,"unrestricted_denom_regex":"[a-zA-Z][a-zA-Z0-9\\-\\.]{2,83}","max_supply":"1000000","max_query_results":0,"max_query_response_bytes":"0","supply_history_max_entries":100,"supply_history_retention_blocks":"0","max_distribution_holders_per_block":0,"distribution_fee":[]}`,
 		},
 		{
 			"get testcoin marker json",
@@ -467,7 +467,7 @@ func (s *IntegrationTestSuite) TestMarkerQueryCommands() {
 				"testcoin",
 				fmt.Sprintf("--%s=json", cmtcli.OutputFlag),
 			},
-			`{"marker":{"@type":"/provenance.marker.v1.MarkerAccount","base_account":{"address":"cosmos1p3sl9tll0ygj3flwt5r2w0n6fx9p5ngq2tu6mq","pub_key":null,"account_number":"8","sequence":"0"},"manager":"","access_control":[],"status":"MARKER_STATUS_ACTIVE","denom":"testcoin","supply":"1000","marker_type":"MARKER_TYPE_COIN","supply_fixed":true,"allow_governance_control":false,"allow_forced_transfer":false,"required_attributes":[]}}`,
+			`{"marker":{"@type":"/provenance.marker.v1.MarkerAccount","base_account":{"address":"cosmos1p3sl9tll0ygj3flwt5r2w0n6fx9p5ngq2tu6mq","pub_key":null,"account_number":"8","sequence":"0"},"manager":"","access_control":[],"status":"MARKER_STATUS_ACTIVE","denom":"testcoin","supply":"1000","marker_type":"MARKER_TYPE_COIN","supply_fixed":true,"allow_governance_control":false,"allow_forced_transfer":false,"required_attributes":[],"required_sender_attributes":[]}}`,
 		},
 		{
 			"get testcoin marker test",
@@ -490,10 +490,20 @@ func (s *IntegrationTestSuite) TestMarkerQueryCommands() {
   manager: ""
   marker_type: MARKER_TYPE_COIN
   required_attributes: []
+  required_sender_attributes: []
   status: MARKER_STATUS_ACTIVE
   supply: "1000"
   supply_fixed: true`,
 		},
+		{
+			"get testcoin marker proto-json",
+			markercli.MarkerCmd(),
+			[]string{
+				"testcoin",
+				fmt.Sprintf("--%s=proto-json", cmtcli.OutputFlag),
+			},
+			`{"marker":{"@type":"/provenance.marker.v1.MarkerAccount","baseAccount":{"address":"cosmos1p3sl9tll0ygj3flwt5r2w0n6fx9p5ngq2tu6mq","accountNumber":"8"},"status":"MARKER_STATUS_ACTIVE","denom":"testcoin","supply":"1000","markerType":"MARKER_TYPE_COIN","supplyFixed":true}}`,
+		},
 		{
 			"query non existent marker",
 			markercli.MarkerCmd(),
@@ -509,7 +519,7 @@ func (s *IntegrationTestSuite) TestMarkerQueryCommands() {
 				"lockedcoin",
 				fmt.Sprintf("--%s=json", cmtcli.OutputFlag),
 			},
-			`{"marker":{"@type":"/provenance.marker.v1.MarkerAccount","base_account":{"address":"cosmos16437wt0xtqtuw0pn4vt8rlf8gr2plz2det0mt2","pub_key":null,"account_number":"9","sequence":"0"},"manager":"","access_control":[],"status":"MARKER_STATUS_ACTIVE","denom":"lockedcoin","supply":"1000","marker_type":"MARKER_TYPE_RESTRICTED","supply_fixed":true,"allow_governance_control":false,"allow_forced_transfer":false,"required_attributes":[]}}`,
+			`{"marker":{"@type":"/provenance.marker.v1.MarkerAccount","base_account":{"address":"cosmos16437wt0xtqtuw0pn4vt8rlf8gr2plz2det0mt2","pub_key":null,"account_number":"9","sequence":"0"},"manager":"","access_control":[],"status":"MARKER_STATUS_ACTIVE","denom":"lockedcoin","supply":"1000","marker_type":"MARKER_TYPE_RESTRICTED","supply_fixed":true,"allow_governance_control":false,"allow_forced_transfer":false,"required_attributes":[],"required_sender_attributes":[]}}`,
 		},
 		{
 			"get restricted coin marker with forced transfer",
@@ -532,6 +542,7 @@ func (s *IntegrationTestSuite) TestMarkerQueryCommands() {
   manager: ""
   marker_type: MARKER_TYPE_RESTRICTED
   required_attributes: []
+  required_sender_attributes: []
   status: MARKER_STATUS_ACTIVE
   supply: "3000"
   supply_fixed: false`,
@@ -542,7 +553,7 @@ func (s *IntegrationTestSuite) TestMarkerQueryCommands() {
 			[]string{
 				s.cfg.BondDenom,
 			},
-			"accounts: []",
+			"accounts: []\nexpirations: []",
 		},
 		{
 			"query escrow",
@@ -2622,6 +2633,50 @@ func (s *IntegrationTestSuite) TestUpdateMarkerParamsCmd() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestUpdateSendRestrictionBypassesCmd() {
+	addr := s.accountAddresses[0].String()
+	testCases := []struct {
+		name         string
+		args         []string
+		expectErr    string
+		expectedCode uint32
+	}{
+		{
+			name:         "set and remove, should succeed",
+			args:         []string{"--add", addr + ",sender,testing", "--remove", s.accountAddresses[1].String()},
+			expectedCode: 0,
+		},
+		{
+			name:      "invalid bypass type",
+			args:      []string{"--add", addr + ",everything"},
+			expectErr: `invalid send restriction bypass type "everything": expected "required-attributes" or "sender"`,
+		},
+		{
+			name:      "missing bypass type",
+			args:      []string{"--add", addr},
+			expectErr: `invalid send restriction bypass "` + addr + `": expected format <address>,<type>[,<reason>]`,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			tc.args = append(tc.args,
+				"--title", fmt.Sprintf("title: %v", tc.name),
+				"--summary", fmt.Sprintf("summary: %v", tc.name),
+				"--deposit=1000000stake",
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.testnet.Validators[0].Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewInt64Coin(s.cfg.BondDenom, 10)).String()),
+			)
+			testcli.NewTxExecutor(markercli.GetCmdUpdateSendRestrictionBypasses(), tc.args).
+				WithExpErrMsg(tc.expectErr).
+				WithExpCode(tc.expectedCode).
+				Execute(s.T(), s.testnet)
+		})
+	}
+}
+
 func (s *IntegrationTestSuite) TestPayingWithFeegrant() {
 	curClientCtx := s.testnet.Validators[0].ClientCtx
 	defer func() {
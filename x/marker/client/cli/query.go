@@ -3,6 +3,7 @@ package cli
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/spf13/cobra"
@@ -11,6 +12,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/version"
 
+	"github.com/provenance-io/provenance/internal/provcli"
 	"github.com/provenance-io/provenance/x/marker/types"
 )
 
@@ -33,6 +35,25 @@ func GetQueryCmd() *cobra.Command {
 		MarkerSupplyCmd(),
 		AccountDataCmd(),
 		NetAssetValuesCmd(),
+		MintAllowancesCmd(),
+		DistributionCmd(),
+		DistributionsCmd(),
+		DistributionClaimsCmd(),
+		EscrowLedgersCmd(),
+		EscrowWithdrawLimitsCmd(),
+		ScheduledBurnsCmd(),
+		AccountOverviewCmd(),
+		ActivationChecklistCmd(),
+		SendRestrictionBypassesCmd(),
+		SupplyHistoryCmd(),
+		DenomOwnerCmd(),
+		DepositAllowListCmd(),
+		FeeSponsorshipCmd(),
+		BridgeInfoCmd(),
+		MintAttestationsCmd(),
+		BasketInfoCmd(),
+		IBCMemoActionConfigCmd(),
+		CanTransferCmd(),
 	)
 	return queryCmd
 }
@@ -57,11 +78,11 @@ func QueryParamsCmd() *cobra.Command {
 				return err
 			}
 
-			return clientCtx.PrintProto(&res.Params)
+			return provcli.PrintProto(clientCtx, &res.Params)
 		},
 	}
 
-	flags.AddQueryFlagsToCmd(cmd)
+	provcli.AddQueryFlagsToCmd(cmd)
 
 	return cmd
 }
@@ -103,12 +124,12 @@ func AllMarkersCmd() *cobra.Command {
 				fmt.Printf("failed to query markers: %s\n", err.Error())
 				return nil
 			}
-			return clientCtx.PrintProto(response)
+			return provcli.PrintProto(clientCtx, response)
 		},
 	}
 
 	flags.AddPaginationFlagsToCmd(cmd, "markers")
-	flags.AddQueryFlagsToCmd(cmd)
+	provcli.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
 
@@ -143,12 +164,12 @@ func AllHoldersCmd() *cobra.Command {
 				fmt.Printf("failed to query blockchain balances for \"%s\": %v\n", id, err)
 				return nil
 			}
-			return clientCtx.PrintProto(response)
+			return provcli.PrintProto(clientCtx, response)
 		},
 	}
 
 	flags.AddPaginationFlagsToCmd(cmd, "markers")
-	flags.AddQueryFlagsToCmd(cmd)
+	provcli.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
 
@@ -176,10 +197,10 @@ func MarkerCmd() *cobra.Command {
 				fmt.Printf("failed to query marker \"%s\" details: %v\n", id, err)
 				return nil
 			}
-			return clientCtx.PrintProto(response)
+			return provcli.PrintProto(clientCtx, response)
 		},
 	}
-	flags.AddQueryFlagsToCmd(cmd)
+	provcli.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
 
@@ -206,10 +227,10 @@ func MarkerAccessCmd() *cobra.Command {
 				fmt.Printf("failed to query marker \"%s\" for access control list: %v\n", id, err)
 				return nil
 			}
-			return clientCtx.PrintProto(response)
+			return provcli.PrintProto(clientCtx, response)
 		},
 	}
-	flags.AddQueryFlagsToCmd(cmd)
+	provcli.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
 
@@ -236,10 +257,10 @@ func MarkerEscrowCmd() *cobra.Command {
 				fmt.Printf("failed to query marker \"%s\" for escrow balances: %v\n", id, err)
 				return nil
 			}
-			return clientCtx.PrintProto(response)
+			return provcli.PrintProto(clientCtx, response)
 		},
 	}
-	flags.AddQueryFlagsToCmd(cmd)
+	provcli.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
 
@@ -266,10 +287,10 @@ func MarkerSupplyCmd() *cobra.Command {
 				fmt.Printf("failed to query marker \"%s\" for total supply configuration: %v\n", id, err)
 				return nil
 			}
-			return clientCtx.PrintProto(response)
+			return provcli.PrintProto(clientCtx, response)
 		},
 	}
-	flags.AddQueryFlagsToCmd(cmd)
+	provcli.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
 
@@ -295,11 +316,11 @@ func AccountDataCmd() *cobra.Command {
 				return fmt.Errorf("failed to query account data for marker %q: %w", denom, err)
 			}
 
-			return clientCtx.PrintProto(resp)
+			return provcli.PrintProto(clientCtx, resp)
 		},
 	}
 
-	flags.AddQueryFlagsToCmd(cmd)
+	provcli.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
 
@@ -328,9 +349,613 @@ func NetAssetValuesCmd() *cobra.Command {
 				fmt.Printf("failed to query marker %q net asset values details: %v\n", id, err)
 				return nil
 			}
-			return clientCtx.PrintProto(response)
+			return provcli.PrintProto(clientCtx, response)
 		},
 	}
-	flags.AddQueryFlagsToCmd(cmd)
+	provcli.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// MintAllowancesCmd is the CLI command for querying the mint allowances given to accounts for a marker.
+func MintAllowancesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "mint-allowances [address|denom]",
+		Aliases: []string{"ma"},
+		Short:   "Get the mint allowances given to accounts for a marker",
+		Long:    `Note: the address is for the base_account of the denom should you choose to use the address rather than the denom name`,
+		Example: fmt.Sprintf(`$ %s query marker mint-allowances "hotdogcoin"`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			id := strings.TrimSpace(args[0])
+
+			var response *types.QueryMintAllowancesResponse
+			if response, err = queryClient.MintAllowances(
+				context.Background(),
+				&types.QueryMintAllowancesRequest{Id: id},
+			); err != nil {
+				return fmt.Errorf("failed to query marker %q mint allowances: %w", id, err)
+			}
+			return provcli.PrintProto(clientCtx, response)
+		},
+	}
+	provcli.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// DistributionCmd is the CLI command for querying a distribution and its progress.
+func DistributionCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "distribution <distribution id>",
+		Short:   "Get a distribution to marker holders and its progress",
+		Example: fmt.Sprintf(`$ %s query marker distribution 3`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid distribution id %q: %w", args[0], err)
+			}
+
+			var response *types.QueryDistributionResponse
+			if response, err = queryClient.Distribution(
+				context.Background(),
+				&types.QueryDistributionRequest{DistributionId: id},
+			); err != nil {
+				return fmt.Errorf("failed to query distribution %d: %w", id, err)
+			}
+			return provcli.PrintProto(clientCtx, response)
+		},
+	}
+	provcli.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// DistributionsCmd is the CLI command for querying the distributions to the holders of a marker.
+func DistributionsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "distributions [address|denom]",
+		Short:   "Get the distributions to the holders of a marker",
+		Long:    `Note: the address is for the base_account of the denom should you choose to use the address rather than the denom name`,
+		Example: fmt.Sprintf(`$ %s query marker distributions "hotdogcoin"`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			id := strings.TrimSpace(args[0])
+
+			pageReq, err := client.ReadPageRequestWithPageKeyDecoded(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			var response *types.QueryDistributionsResponse
+			if response, err = queryClient.Distributions(
+				context.Background(),
+				&types.QueryDistributionsRequest{Id: id, Pagination: pageReq},
+			); err != nil {
+				return fmt.Errorf("failed to query marker %q distributions: %w", id, err)
+			}
+			return provcli.PrintProto(clientCtx, response)
+		},
+	}
+	flags.AddPaginationFlagsToCmd(cmd, "distributions")
+	provcli.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// DistributionClaimsCmd is the CLI command for querying the distribution claims waiting to be claimed by an account.
+func DistributionClaimsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "distribution-claims <address>",
+		Short:   "Get the distribution claims waiting to be claimed by an account",
+		Example: fmt.Sprintf(`$ %s query marker distribution-claims pb1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			address := strings.TrimSpace(args[0])
+
+			var response *types.QueryDistributionClaimsResponse
+			if response, err = queryClient.DistributionClaims(
+				context.Background(),
+				&types.QueryDistributionClaimsRequest{Address: address},
+			); err != nil {
+				return fmt.Errorf("failed to query distribution claims for %q: %w", address, err)
+			}
+			return provcli.PrintProto(clientCtx, response)
+		},
+	}
+	provcli.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// EscrowLedgersCmd is the CLI command for querying the escrow ledgers of a marker.
+func EscrowLedgersCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "escrow-ledgers <address|denom>",
+		Short:   "Get the escrow ledgers of a marker and the funds earmarked in each",
+		Example: fmt.Sprintf(`$ %s query marker escrow-ledgers hotdogcoin`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			id := strings.TrimSpace(args[0])
+
+			var response *types.QueryEscrowLedgersResponse
+			if response, err = queryClient.EscrowLedgers(
+				context.Background(),
+				&types.QueryEscrowLedgersRequest{Id: id},
+			); err != nil {
+				return fmt.Errorf("failed to query marker %q escrow ledgers: %w", id, err)
+			}
+			return provcli.PrintProto(clientCtx, response)
+		},
+	}
+	provcli.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// EscrowWithdrawLimitsCmd is the CLI command for querying the withdraw limits of one of a marker's escrow ledgers.
+func EscrowWithdrawLimitsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "escrow-withdraw-limits <address|denom> <ledger>",
+		Short:   "Get the withdraw limits given to accounts for one of a marker's escrow ledgers",
+		Example: fmt.Sprintf(`$ %s query marker escrow-withdraw-limits hotdogcoin operations`, version.AppName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			id := strings.TrimSpace(args[0])
+			ledger := strings.TrimSpace(args[1])
+
+			var response *types.QueryEscrowWithdrawLimitsResponse
+			if response, err = queryClient.EscrowWithdrawLimits(
+				context.Background(),
+				&types.QueryEscrowWithdrawLimitsRequest{Id: id, Ledger: ledger},
+			); err != nil {
+				return fmt.Errorf("failed to query marker %q escrow ledger %q withdraw limits: %w", id, ledger, err)
+			}
+			return provcli.PrintProto(clientCtx, response)
+		},
+	}
+	provcli.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// ScheduledBurnsCmd is the CLI command for querying the scheduled burns of a marker's escrow.
+func ScheduledBurnsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "scheduled-burns <address|denom>",
+		Short:   "Get the burns of a marker's escrow that have been scheduled but not yet executed",
+		Example: fmt.Sprintf(`$ %s query marker scheduled-burns hotdogcoin`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			id := strings.TrimSpace(args[0])
+
+			var response *types.QueryScheduledBurnsResponse
+			if response, err = queryClient.ScheduledBurns(
+				context.Background(),
+				&types.QueryScheduledBurnsRequest{Id: id},
+			); err != nil {
+				return fmt.Errorf("failed to query marker %q scheduled burns: %w", id, err)
+			}
+			return provcli.PrintProto(clientCtx, response)
+		},
+	}
+	provcli.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// AccountOverviewCmd is the CLI command for querying an overview of everything associated with an account.
+func AccountOverviewCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "account-overview <address>",
+		Short: "Get the names, attributes, marker access, marker balances, and scopes associated with an account",
+		Long: strings.TrimSpace(fmt.Sprintf(`Get the names, attributes, marker access, marker balances, and scopes associated with an account.
+The --%[1]s flag limits the number of entries returned in each list (default %[2]d, max %[3]d).
+If any list was limited, the truncated field of the response will be true.`,
+			FlagLimit, types.DefaultAccountOverviewLimit, types.MaxAccountOverviewLimit)),
+		Example: fmt.Sprintf(`$ %[1]s query marker account-overview pb1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk
+$ %[1]s query marker account-overview pb1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk --%[2]s 10`,
+			version.AppName, FlagLimit),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			limit, err := cmd.Flags().GetUint32(FlagLimit)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			address := strings.TrimSpace(args[0])
+
+			var response *types.QueryAccountOverviewResponse
+			if response, err = queryClient.AccountOverview(
+				context.Background(),
+				&types.QueryAccountOverviewRequest{Address: address, Limit: limit},
+			); err != nil {
+				return fmt.Errorf("failed to query account overview for %q: %w", address, err)
+			}
+			return provcli.PrintProto(clientCtx, response)
+		},
+	}
+	cmd.Flags().Uint32(FlagLimit, 0, "The maximum number of entries to return in each list")
+	provcli.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// ActivationChecklistCmd is the CLI command for querying the preconditions for activating a marker.
+func ActivationChecklistCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "activation-checklist <address|denom>",
+		Aliases: []string{"activation-checks"},
+		Short:   "Get the preconditions for activating a proposed or finalized marker, and which are unmet",
+		Example: fmt.Sprintf(`$ %s query marker activation-checklist hotdogcoin`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			id := strings.TrimSpace(args[0])
+
+			var response *types.QueryActivationChecklistResponse
+			if response, err = queryClient.ActivationChecklist(
+				context.Background(),
+				&types.QueryActivationChecklistRequest{Id: id},
+			); err != nil {
+				return fmt.Errorf("failed to query marker %q activation checklist: %w", id, err)
+			}
+			return provcli.PrintProto(clientCtx, response)
+		},
+	}
+	provcli.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// SendRestrictionBypassesCmd is the CLI command for querying the accounts that are exempt from marker send restrictions.
+func SendRestrictionBypassesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "send-restriction-bypasses",
+		Aliases: []string{"bypasses"},
+		Short:   "Get the accounts that are exempt from some of the marker send restrictions",
+		Example: fmt.Sprintf(`$ %s query marker send-restriction-bypasses`, version.AppName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			var response *types.QuerySendRestrictionBypassesResponse
+			if response, err = queryClient.SendRestrictionBypasses(
+				context.Background(),
+				&types.QuerySendRestrictionBypassesRequest{},
+			); err != nil {
+				return fmt.Errorf("failed to query send restriction bypasses: %w", err)
+			}
+			return provcli.PrintProto(clientCtx, response)
+		},
+	}
+	provcli.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// SupplyHistoryCmd is the CLI command for querying the recorded mints and burns of a marker's coin.
+func SupplyHistoryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "supply-history <address|denom>",
+		Short:   "Get the recorded mints and burns of a marker's coin, oldest first",
+		Example: fmt.Sprintf(`$ %s query marker supply-history hotdogcoin`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			id := strings.TrimSpace(args[0])
+
+			pageReq, err := client.ReadPageRequestWithPageKeyDecoded(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			var response *types.QuerySupplyHistoryResponse
+			if response, err = queryClient.SupplyHistory(
+				context.Background(),
+				&types.QuerySupplyHistoryRequest{Id: id, Pagination: pageReq},
+			); err != nil {
+				return fmt.Errorf("failed to query marker %q supply history: %w", id, err)
+			}
+			return provcli.PrintProto(clientCtx, response)
+		},
+	}
+	flags.AddPaginationFlagsToCmd(cmd, "supply changes")
+	provcli.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// DenomOwnerCmd is the CLI command for querying what controls a denom.
+func DenomOwnerCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "denom-owner <denom>",
+		Short:   "Get what controls a denom: the marker that manages it, the IBC trace it came in on, or nothing",
+		Example: fmt.Sprintf(`$ %s query marker denom-owner hotdogcoin`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			denom := strings.TrimSpace(args[0])
+
+			var response *types.QueryDenomOwnerResponse
+			if response, err = queryClient.DenomOwner(
+				context.Background(),
+				&types.QueryDenomOwnerRequest{Denom: denom},
+			); err != nil {
+				return fmt.Errorf("failed to query denom %q owner: %w", denom, err)
+			}
+			return provcli.PrintProto(clientCtx, response)
+		},
+	}
+	provcli.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// DepositAllowListCmd is the CLI command for querying the accounts allowed to deposit funds into a marker.
+func DepositAllowListCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "deposit-allow-list [address|denom]",
+		Aliases: []string{"dal"},
+		Short:   "Get the accounts allowed to deposit funds into a marker",
+		Long: `Get the accounts allowed to deposit funds into a marker. If the list is empty, anyone can deposit funds into the marker.
+Note: the address is for the base_account of the denom should you choose to use the address rather than the denom name`,
+		Example: fmt.Sprintf(`$ %s query marker deposit-allow-list "hotdogcoin"`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			id := strings.TrimSpace(args[0])
+
+			var response *types.QueryDepositAllowListResponse
+			if response, err = queryClient.DepositAllowList(
+				context.Background(),
+				&types.QueryDepositAllowListRequest{Id: id},
+			); err != nil {
+				return fmt.Errorf("failed to query marker %q deposit allow list: %w", id, err)
+			}
+			return provcli.PrintProto(clientCtx, response)
+		},
+	}
+	provcli.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// FeeSponsorshipCmd is the CLI command for querying a marker's fee sponsorship.
+func FeeSponsorshipCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "fee-sponsorship [address|denom] [holder]",
+		Aliases: []string{"fs"},
+		Short:   "Get the fee sponsorship of a marker",
+		Long: `Get the fee sponsorship of a marker. If a holder address is provided, the result includes whether they have already claimed a fee grant from it.
+Note: the address is for the base_account of the denom should you choose to use the address rather than the denom name`,
+		Example: fmt.Sprintf(`$ %[1]s query marker fee-sponsorship "hotdogcoin"
+$ %[1]s query marker fee-sponsorship "hotdogcoin" pb1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk`, version.AppName),
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.QueryFeeSponsorshipRequest{Id: strings.TrimSpace(args[0])}
+			if len(args) > 1 {
+				req.Holder = strings.TrimSpace(args[1])
+			}
+
+			var response *types.QueryFeeSponsorshipResponse
+			if response, err = queryClient.FeeSponsorship(context.Background(), req); err != nil {
+				return fmt.Errorf("failed to query marker %q fee sponsorship: %w", req.Id, err)
+			}
+			return provcli.PrintProto(clientCtx, response)
+		},
+	}
+	provcli.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// BridgeInfoCmd is the CLI command for querying a wrapped-asset marker's bridge info.
+func BridgeInfoCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "bridge-info [address|denom]",
+		Aliases: []string{"bi"},
+		Short:   "Get the bridge info of a wrapped-asset marker",
+		Long: `Get the bridge info of a wrapped-asset marker.
+Note: the address is for the base_account of the denom should you choose to use the address rather than the denom name`,
+		Example: fmt.Sprintf(`$ %s query marker bridge-info "wethcoin"`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			id := strings.TrimSpace(args[0])
+
+			var response *types.QueryBridgeInfoResponse
+			if response, err = queryClient.BridgeInfo(
+				context.Background(),
+				&types.QueryBridgeInfoRequest{Id: id},
+			); err != nil {
+				return fmt.Errorf("failed to query marker %q bridge info: %w", id, err)
+			}
+			return provcli.PrintProto(clientCtx, response)
+		},
+	}
+	provcli.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// MintAttestationsCmd is the CLI command for querying the attested mints of a wrapped-asset marker's coin.
+func MintAttestationsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "mint-attestations <address|denom>",
+		Aliases: []string{"mas"},
+		Short:   "Get the attested mints of a wrapped-asset marker's coin, oldest first",
+		Example: fmt.Sprintf(`$ %s query marker mint-attestations wethcoin`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			id := strings.TrimSpace(args[0])
+
+			pageReq, err := client.ReadPageRequestWithPageKeyDecoded(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			var response *types.QueryMintAttestationsResponse
+			if response, err = queryClient.MintAttestations(
+				context.Background(),
+				&types.QueryMintAttestationsRequest{Id: id, Pagination: pageReq},
+			); err != nil {
+				return fmt.Errorf("failed to query marker %q mint attestations: %w", id, err)
+			}
+			return provcli.PrintProto(clientCtx, response)
+		},
+	}
+	flags.AddPaginationFlagsToCmd(cmd, "mint attestations")
+	provcli.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// BasketInfoCmd is the CLI command for querying a basket marker's components and the coins backing its supply.
+func BasketInfoCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "basket-info <address|denom>",
+		Aliases: []string{"bsk"},
+		Short:   "Get the components of a basket marker and the coins backing its supply",
+		Example: fmt.Sprintf(`$ %s query marker basket-info indexcoin`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			id := strings.TrimSpace(args[0])
+
+			var response *types.QueryBasketInfoResponse
+			if response, err = queryClient.BasketInfo(
+				context.Background(),
+				&types.QueryBasketInfoRequest{Id: id},
+			); err != nil {
+				return fmt.Errorf("failed to query marker %q basket info: %w", id, err)
+			}
+			return provcli.PrintProto(clientCtx, response)
+		},
+	}
+	provcli.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// IBCMemoActionConfigCmd is the CLI command for querying the ibc memo actions allowed for a marker's coin.
+func IBCMemoActionConfigCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "ibc-memo-action-config <address|denom>",
+		Aliases: []string{"imac"},
+		Short:   "Get the actions that ICS-20 memos are allowed to request for a marker's coin",
+		Example: fmt.Sprintf(`$ %s query marker ibc-memo-action-config hotdogcoin`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			id := strings.TrimSpace(args[0])
+
+			var response *types.QueryIBCMemoActionConfigResponse
+			if response, err = queryClient.IBCMemoActionConfig(
+				context.Background(),
+				&types.QueryIBCMemoActionConfigRequest{Id: id},
+			); err != nil {
+				return fmt.Errorf("failed to query marker %q ibc memo action config: %w", id, err)
+			}
+			return provcli.PrintProto(clientCtx, response)
+		},
+	}
+	provcli.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// CanTransferCmd is the CLI command for checking whether a bank send would be allowed.
+func CanTransferCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "can-transfer <from_address> <to_address> <amount>",
+		Aliases: []string{"ct"},
+		Short:   "Check whether a bank send would be allowed, and which send restriction would prevent it",
+		Example: fmt.Sprintf(`$ %s query marker can-transfer pb1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk pb1y8ma9p8t5kemhx2hmn64syl0cw5d3vvnrga5a8 10mycoin`, version.AppName),
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.QueryCanTransferRequest{
+				FromAddress: strings.TrimSpace(args[0]),
+				ToAddress:   strings.TrimSpace(args[1]),
+				Amount:      strings.TrimSpace(args[2]),
+			}
+
+			var response *types.QueryCanTransferResponse
+			if response, err = queryClient.CanTransfer(context.Background(), req); err != nil {
+				return fmt.Errorf("failed to check transfer of %q from %s to %s: %w", req.Amount, req.FromAddress, req.ToAddress, err)
+			}
+			return provcli.PrintProto(clientCtx, response)
+		},
+	}
+	provcli.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
@@ -52,10 +52,26 @@ const (
 	FlagAllowForceTransfer     = "allow-force-transfer"
 	FlagAdd                    = "add"
 	FlagRemove                 = "remove"
+	FlagAddSender              = "add-sender"
+	FlagRemoveSender           = "remove-sender"
 	FlagGovProposal            = "gov-proposal"
 	FlagUsdMills               = "usd-mills"
 	FlagVolume                 = "volume"
 	FlagTargetAddress          = "target-address"
+	FlagUnrestricted           = "unrestrict"
+	FlagStartHeight            = "start-height"
+	FlagBatchSize              = "batch-size"
+	FlagMinPayout              = "min-payout"
+	FlagLimit                  = "limit"
+	FlagMaxQueryResults        = "max-query-results"
+	FlagMaxQueryResponseBytes  = "max-query-response-bytes"
+	FlagSupplyHistoryEntries   = "supply-history-max-entries"
+	FlagSupplyHistoryRetention = "supply-history-retention-blocks"
+	FlagCancelWindow           = "cancel-window"
+	FlagGrantDuration          = "grant-duration"
+	FlagMinBalance             = "min-balance"
+	FlagDistributionHolders    = "max-distribution-holders-per-block"
+	FlagDistributionFee        = "distribution-fee"
 )
 
 // NewTxCmd returns the top-level command for marker CLI transactions.
@@ -89,7 +105,35 @@ func NewTxCmd() *cobra.Command {
 		GetCmdUpdateForcedTransfer(),
 		GetCmdSetAccountData(),
 		GetCmdUpdateSendDenyListRequest(),
+		GetCmdUpdateDepositAllowList(),
+		GetCmdSetFeeSponsorship(),
+		GetCmdRemoveFeeSponsorship(),
+		GetCmdClaimFeeSponsorship(),
+		GetCmdReleaseFeeSponsorshipHold(),
+		GetCmdSetBridgeInfo(),
+		GetCmdAttestedMint(),
+		GetCmdSetBasketInfo(),
+		GetCmdSetIBCMemoActionConfig(),
+		GetCmdBasketDeposit(),
+		GetCmdBasketWithdraw(),
 		GetCmdAddNetAssetValues(),
+		GetCmdBindMarkerName(),
+		GetCmdDeleteMarkerName(),
+		GetCmdDelegateEscrow(),
+		GetCmdUndelegateEscrow(),
+		GetCmdCollectEscrowRewards(),
+		GetCmdSetMintAllowance(),
+		GetCmdMintFromAllowance(),
+		GetCmdScheduleDistribution(),
+		GetCmdCancelDistribution(),
+		GetCmdClaimDistribution(),
+		GetCmdAllocateEscrow(),
+		GetCmdReleaseEscrow(),
+		GetCmdSetEscrowWithdrawLimit(),
+		GetCmdWithdrawFromEscrow(),
+		GetCmdScheduleBurn(),
+		GetCmdCancelScheduledBurn(),
+		GetCmdExchange(),
 		GetCmdSupplyDecreaseProposal(),
 		GetCmdSupplyIncreaseProposal(),
 		GetCmdSetAdministratorProposal(),
@@ -97,6 +141,8 @@ func NewTxCmd() *cobra.Command {
 		GetCmdChangeStatusProposal(),
 		GetCmdWithdrawEscrowProposal(),
 		GetUpdateMarkerParamsCmd(),
+		GetCmdUpdateSendRestrictionBypasses(),
+		GetCmdChangeMarkerTypeProposal(),
 	)
 	return txCmd
 }
@@ -342,7 +388,9 @@ func GetCmdAddAccess() *cobra.Command {
 		Short:   "Grant access to a marker for the address coins from the marker",
 		Long: strings.TrimSpace(`Grant administrative access to a marker.  From Address must have appropriate
 existing access.  Permissions are appended to any existing access grant.  Valid permissions
-are one of [mint, burn, deposit, withdraw, delete, admin, transfer].`),
+are one of [mint, burn, deposit, withdraw, delete, admin, transfer, delegate].
+The grant expires at the --expiration time if provided, otherwise it does not expire. This replaces any
+expiration of an existing access grant for the address.`),
 		Example: fmt.Sprintf(`$ %s tx marker grant pb1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj coindenom burn --from mykey`, version.AppName),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
@@ -358,12 +406,24 @@ are one of [mint, burn, deposit, withdraw, delete, admin, transfer].`),
 			if err = grant.Validate(); err != nil {
 				return cerrs.Wrapf(err, "invalid access grant permission: %s", args[2])
 			}
+			exp, err := cmd.Flags().GetString(FlagExpiration)
+			if err != nil {
+				return err
+			}
+			if exp != "" {
+				expiresAtTime, perr := time.Parse(time.RFC3339, exp)
+				if perr != nil {
+					return cerrs.Wrapf(perr, "invalid access grant expiration: %s", exp)
+				}
+				grant.Expiration = &expiresAtTime
+			}
 			callerAddr := clientCtx.GetFromAddress()
 			msg := types.NewMsgAddAccessRequest(args[1], callerAddr, *grant)
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().String(FlagExpiration, "", "The RFC 3339 timestamp at which the access grant expires")
 	return cmd
 }
 
@@ -896,11 +956,15 @@ func GetCmdUpdateRequiredAttributes() *cobra.Command {
 		Args:    cobra.ExactArgs(1),
 		Short:   "Update required attributes on an existing restricted marker",
 		Long: strings.TrimSpace(`Updates the required attributes of an existing restricted marker.
+The --add and --remove flags update the attributes required of the receiver of a transfer.
+The --add-sender and --remove-sender flags update the attributes required of the sender of a transfer.
 `),
-		Example: fmt.Sprintf(`$ %s tx marker update-required-attributes hotdogcoin --%s=attr.one,*.attr.two,... --%s=attr.one,*.attr.two,...`,
+		Example: fmt.Sprintf(`$ %[1]s tx marker update-required-attributes hotdogcoin --%[2]s=attr.one,*.attr.two,... --%[3]s=attr.one,*.attr.two,...
+$ %[1]s tx marker update-required-attributes hotdogcoin --%[4]s=lockup.attr.one`,
 			version.AppName,
 			FlagAdd,
 			FlagRemove,
+			FlagAddSender,
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
@@ -921,6 +985,16 @@ func GetCmdUpdateRequiredAttributes() *cobra.Command {
 				return fmt.Errorf("incorrect value for %s flag.  Accepted: comma delimited list of attributes Error: %w", FlagRemove, err)
 			}
 
+			msg.AddRequiredSenderAttributes, err = flagSet.GetStringSlice(FlagAddSender)
+			if err != nil {
+				return fmt.Errorf("incorrect value for %s flag.  Accepted: comma delimited list of attributes Error: %w", FlagAddSender, err)
+			}
+
+			msg.RemoveRequiredSenderAttributes, err = flagSet.GetStringSlice(FlagRemoveSender)
+			if err != nil {
+				return fmt.Errorf("incorrect value for %s flag.  Accepted: comma delimited list of attributes Error: %w", FlagRemoveSender, err)
+			}
+
 			authSetter := func(authority string) {
 				msg.TransferAuthority = authority
 			}
@@ -930,6 +1004,8 @@ func GetCmdUpdateRequiredAttributes() *cobra.Command {
 	}
 	cmd.Flags().StringSlice(FlagAdd, []string{}, "comma delimited list of required attributes to be added to restricted marker")
 	cmd.Flags().StringSlice(FlagRemove, []string{}, "comma delimited list of required attributes to be removed from restricted marker")
+	cmd.Flags().StringSlice(FlagAddSender, []string{}, "comma delimited list of required sender attributes to be added to restricted marker")
+	cmd.Flags().StringSlice(FlagRemoveSender, []string{}, "comma delimited list of required sender attributes to be removed from restricted marker")
 	addOptGovPropFlags(cmd)
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
@@ -1011,17 +1087,22 @@ func GetCmdUpdateSendDenyListRequest() *cobra.Command {
 	return cmd
 }
 
-// GetCmdSetAccountData returns a CLI command for setting a marker's account data.
-func GetCmdSetAccountData() *cobra.Command {
+// GetCmdUpdateDepositAllowList returns a CLI command for adding and removing accounts on a marker's deposit allow list.
+func GetCmdUpdateDepositAllowList() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:     "account-data <denom> " + attrcli.AccountDataFlagsUse,
-		Aliases: []string{"accountdata", "ad"},
-		Short:   "Set a marker's account data",
-		Example: fmt.Sprintf(`$ %[1]s tx marker account-data hotdogcoin --%[2]s "This is hotdogcoin's marker data.'"
-$ %[1]s tx marker account-data hotdogcoin --%[3]s hotdogcoin-account-data.json
-$ %[1]s tx marker account-data hotdogcoin --%[4]s`,
-			version.AppName, attrcli.FlagValue, attrcli.FlagFile, attrcli.FlagDelete),
-		Args: cobra.ExactArgs(1),
+		Use:     "update-deposit-allow-list <denom>",
+		Aliases: []string{"udal", "deposit-allow-list"},
+		Args:    cobra.ExactArgs(1),
+		Short:   "Update the list of accounts allowed to deposit funds into a marker",
+		Long: strings.TrimSpace(`Update the list of accounts allowed to deposit funds into a marker.
+Once a marker has a deposit allow list, only the accounts on it (or with deposit access) can send funds to the marker.
+Removing all of the accounts from the list allows anyone to deposit funds into the marker again.
+`),
+		Example: fmt.Sprintf(`$ %s tx marker update-deposit-allow-list hotdogcoin --%s=bech32addr1,bech32addrs2,... --%s=bech32addr1,bech32addrs2,...`,
+			version.AppName,
+			FlagAdd,
+			FlagRemove,
+		),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -1029,273 +1110,1335 @@ $ %[1]s tx marker account-data hotdogcoin --%[4]s`,
 			}
 			flagSet := cmd.Flags()
 
-			msg := &types.MsgSetAccountDataRequest{Denom: strings.TrimSpace(args[0])}
+			msg := &types.MsgUpdateDepositAllowListRequest{Denom: args[0]}
 
-			msg.Value, err = attrcli.ReadAccountDataFlags(flagSet)
+			msg.AddAllowedAddresses, err = flagSet.GetStringSlice(FlagAdd)
 			if err != nil {
-				return err
+				return fmt.Errorf("incorrect value for %s flag.  Accepted: comma delimited list of bech32 addresses Error: %w", FlagAdd, err)
 			}
 
-			setSigner := func(signer string) {
-				msg.Signer = signer
+			msg.RemoveAllowedAddresses, err = flagSet.GetStringSlice(FlagRemove)
+			if err != nil {
+				return fmt.Errorf("incorrect value for %s flag.  Accepted: comma delimited list of bech32 addresses Error: %w", FlagRemove, err)
 			}
 
-			return generateOrBroadcastOptGovProp(clientCtx, flagSet, setSigner, msg)
+			authSetter := func(authority string) {
+				msg.Administrator = authority
+			}
+
+			return generateOrBroadcastOptGovProp(clientCtx, flagSet, authSetter, msg)
 		},
 	}
-
-	attrcli.AddAccountDataFlagsToCmd(cmd)
+	cmd.Flags().StringSlice(FlagAdd, []string{}, "comma delimited list of bech32 addresses to be added to the marker's deposit allow list")
+	cmd.Flags().StringSlice(FlagRemove, []string{}, "comma delimited list of bech32 addresses to be removed from the marker's deposit allow list")
 	addOptGovPropFlags(cmd)
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
 
-// GetCmdAddNetAssetValues returns a CLI command for adding/updating marker net asset values.
-func GetCmdAddNetAssetValues() *cobra.Command {
+// GetCmdSetFeeSponsorship returns a CLI command for creating or updating a marker's fee sponsorship.
+func GetCmdSetFeeSponsorship() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:     "add-net-asset-values <denom> <valuation[;valuation...]>",
-		Aliases: []string{"add-navs", "anavs"},
-		Short:   "Provide net asset values for a marker",
-		Long: `
-Provide net asset valuations for a marker. Net asset values are used to establish 
-the relative value of the marker in relation to other assets or currencies.
-
-Net asset values are expressed as a ratio between an amount of coin paid (price) 
-and a volume of the marker's tokens which are considered equivalent in value.
-
-The denomination of the amount paid (price) must either:
-1) Exist on-chain as a separate marker, or
-2) Be supplied as [1000usd], an integer valued in mils (1000 mils = $1 USD).
-
-The volume is supplied as an integer count of the current marker's tokens.
-
-IMPORTANT: All values must be represented as whole integers. If a decimal value 
-is required, adjust the ratio between the price and volume to achieve the 
-desired precision.
-`,
-		Example: fmt.Sprintf(`
-  Set a value of $1 = 1markercoin (Note USD is denominated in mils)
-  $ %[1]s tx %[2]s add-net-asset-values markercoin 1000usd,1
-  
-  Provide more than one valuation in a single call
-  $ %[1]s tx %[2]s add-net-asset-values markercoin 1000usd,1;5000000000nhash,1
-
-  Valuations for larger trades with volumes greater than 1
-  $ %[1]s tx %[2]s add-net-asset-values markercoin 100000usd,199;1000stake,19
-
-  All values must be represented as whole integers.  If a decimal value is required
-  then the ratio between the price and volume must be adjusted to achieve the decimal
-  required.
-
-  Note: When the valuations are recorded, each will indicate the address of the admin
-  who provided the value. This will be published in the associated event data and 
-  captured in the NAV record.  For NAVs set by other modules such as x/exchange the
-  protocol will indicate these sources.  This separates established values from
-  the owner (self-attestation) from those set through blockchain transactions.
-		`,
-			version.AppName, types.ModuleName),
-		Args: cobra.ExactArgs(2),
+		Use:     "set-fee-sponsorship <denom> <spend limit>",
+		Aliases: []string{"sfs"},
+		Args:    cobra.ExactArgs(2),
+		Short:   "Create or update a marker's fee sponsorship",
+		Long: strings.TrimSpace(`Create or update a marker's fee sponsorship.
+Holders that meet the sponsorship's criteria can each claim one fee grant, paid from the marker's account, that can spend up to the spend limit.
+A holder must have at least the min balance of the marker's coin and all of the required attributes.
+At least one of --min-balance or --required-attributes must be provided.
+`),
+		Example: fmt.Sprintf(`$ %[1]s tx marker set-fee-sponsorship hotdogcoin 1000000nhash --%[2]s=100 --%[3]s=kyc.provenance.io --%[4]s=720h`,
+			version.AppName,
+			FlagMinBalance,
+			FlagRequiredAttributes,
+			FlagGrantDuration,
+		),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return err
 			}
+			flagSet := cmd.Flags()
 
-			denom := strings.TrimSpace(args[0])
-			netAssetValues, err := ParseNetAssetValueString(args[1])
+			spendLimit, err := sdk.ParseCoinsNormalized(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid spend limit %q: %w", args[1], err)
+			}
+
+			minBalance := sdkmath.ZeroInt()
+			minBalanceStr, err := flagSet.GetString(FlagMinBalance)
 			if err != nil {
 				return err
 			}
+			if len(minBalanceStr) > 0 {
+				var ok bool
+				minBalance, ok = sdkmath.NewIntFromString(minBalanceStr)
+				if !ok {
+					return fmt.Errorf("invalid %s value %q: must be an integer", FlagMinBalance, minBalanceStr)
+				}
+			}
 
-			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), types.NewMsgAddNetAssetValuesRequest(denom, clientCtx.GetFromAddress().String(), netAssetValues))
+			requiredAttributes, err := flagSet.GetStringSlice(FlagRequiredAttributes)
+			if err != nil {
+				return fmt.Errorf("incorrect value for %s flag.  Accepted: comma delimited list of attributes Error: %w", FlagRequiredAttributes, err)
+			}
+
+			grantDuration, err := flagSet.GetDuration(FlagGrantDuration)
+			if err != nil {
+				return err
+			}
+
+			sponsorship := types.NewFeeSponsorship(args[0], spendLimit, grantDuration, minBalance, requiredAttributes)
+			msg := types.NewMsgSetFeeSponsorshipRequest(sponsorship, "")
+
+			authSetter := func(authority string) {
+				msg.Administrator = authority
+			}
+
+			return generateOrBroadcastOptGovProp(clientCtx, flagSet, authSetter, msg)
 		},
 	}
+	cmd.Flags().String(FlagMinBalance, "", "the amount of the marker's coin that a holder must have to claim a fee grant")
+	cmd.Flags().StringSlice(FlagRequiredAttributes, []string{}, "comma delimited list of attributes that a holder must have to claim a fee grant")
+	cmd.Flags().Duration(FlagGrantDuration, 0, "how long each fee grant lasts after it is claimed (default is no expiration)")
+	addOptGovPropFlags(cmd)
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
 
-// GetCmdSupplyDecreaseProposal returns a CLI command for submitting a supply decrease proposal.
-func GetCmdSupplyDecreaseProposal() *cobra.Command {
+// GetCmdRemoveFeeSponsorship returns a CLI command for removing a marker's fee sponsorship.
+func GetCmdRemoveFeeSponsorship() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:     "supply-decrease-proposal <amount>",
-		Aliases: []string{"sdp", "s-d-p"},
+		Use:     "remove-fee-sponsorship <denom>",
+		Aliases: []string{"rfs"},
 		Args:    cobra.ExactArgs(1),
-		Short:   "Submit a supply decrease proposal of amount to decrease supply by along with a title, summary, and deposit",
-		Long:    strings.TrimSpace(`Submit a supply decrease proposal of amount to decrease supply by along with a title, summary, and deposit.`),
-		Example: fmt.Sprintf(`$ %[1]s tx marker supply-decrease-proposal 1000mycoin --title "My Title" --summary "My summary" --deposit 1000000000nhash 
-$ %[1]s tx marker sdp 100stake --title "My Title" --summary "My summary" --deposit 1000000000nhash
-`, version.AppName),
+		Short:   "Remove a marker's fee sponsorship",
+		Long: strings.TrimSpace(`Remove a marker's fee sponsorship.
+Fee grants that holders have already claimed are not revoked.
+`),
+		Example: fmt.Sprintf(`$ %s tx marker remove-fee-sponsorship hotdogcoin`, version.AppName),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return err
 			}
 			flagSet := cmd.Flags()
-			authority := provcli.GetAuthority(flagSet)
-			coin, err := sdk.ParseCoinNormalized(args[0])
-			if err != nil {
-				return fmt.Errorf("invalid coin %s", args[0])
+
+			msg := types.NewMsgRemoveFeeSponsorshipRequest(args[0], "")
+
+			authSetter := func(authority string) {
+				msg.Administrator = authority
 			}
-			msg := types.NewMsgSupplyDecreaseProposalRequest(coin, authority)
-			return provcli.GenerateOrBroadcastTxCLIAsGovProp(clientCtx, flagSet, msg)
+
+			return generateOrBroadcastOptGovProp(clientCtx, flagSet, authSetter, msg)
 		},
 	}
+	addOptGovPropFlags(cmd)
 	flags.AddTxFlagsToCmd(cmd)
-	govcli.AddGovPropFlagsToCmd(cmd)
-	provcli.AddAuthorityFlagToCmd(cmd)
 	return cmd
 }
 
-// GetCmdSupplyIncreaseProposal returns a CLI command for submitting a supply increase proposal.
-func GetCmdSupplyIncreaseProposal() *cobra.Command {
+// GetCmdClaimFeeSponsorship returns a CLI command for claiming a fee grant from a marker's fee sponsorship.
+func GetCmdClaimFeeSponsorship() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:     "supply-increase-proposal <amount>",
-		Aliases: []string{"sip", "s-i-p"},
+		Use:     "claim-fee-sponsorship <denom>",
+		Aliases: []string{"cfs"},
 		Args:    cobra.ExactArgs(1),
-		Short:   "Submit a supply increase proposal of amount to increase supply by along with a title, summary, and deposit",
-		Long:    strings.TrimSpace(`Submit a supply increase proposal of amount to decrease supply by along with a title, summary, and deposit.`),
-		Example: fmt.Sprintf(`$ %[1]s tx marker supply-increase-proposal 1000mycoin --title "My Title" --summary "My summary" --deposit 1000000000nhash 
-$ %[1]s tx marker sdp 100stake --title "My Title" --summary "My summary" --deposit 1000000000nhash
-$ %[1]s tx marker sdp 100stake --target-address pb1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj --title "My Title" --summary "My summary" --deposit 1000000000nhash
-`, version.AppName),
+		Short:   "Claim a fee grant from a marker's fee sponsorship",
+		Long: strings.TrimSpace(`Claim a fee grant from a marker's fee sponsorship.
+The --from account must meet the sponsorship's criteria and can only claim one fee grant from it.
+Once claimed, use --fee-granter with the marker's address to have the marker pay a transaction's fees.
+`),
+		Example: fmt.Sprintf(`$ %s tx marker claim-fee-sponsorship hotdogcoin --from mykey`, version.AppName),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return err
 			}
-			flagSet := cmd.Flags()
-			authority := provcli.GetAuthority(flagSet)
-			coin, err := sdk.ParseCoinNormalized(args[0])
-			if err != nil {
-				return fmt.Errorf("invalid coin %s", args[0])
-			}
-			targetAddress, err := flagSet.GetString(FlagTargetAddress)
+			msg := types.NewMsgClaimFeeSponsorshipRequest(args[0], clientCtx.GetFromAddress().String())
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdReleaseFeeSponsorshipHold returns a CLI command for releasing the funds held for a fee sponsorship claim.
+func GetCmdReleaseFeeSponsorshipHold() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "release-fee-sponsorship-hold <denom>",
+		Aliases: []string{"rfsh"},
+		Args:    cobra.ExactArgs(1),
+		Short:   "Release the funds held for a fee grant claimed from a marker's fee sponsorship",
+		Long: strings.TrimSpace(`Release the funds held for a fee grant claimed from a marker's fee sponsorship.
+The --from account must have claimed the fee grant, and the fee grant must have been used up or have expired.
+`),
+		Example: fmt.Sprintf(`$ %s tx marker release-fee-sponsorship-hold hotdogcoin --from mykey`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return err
 			}
-			if len(targetAddress) > 0 {
-				_, err = sdk.AccAddressFromBech32(targetAddress)
-				if err != nil {
-					return fmt.Errorf("invalid target address %v: %w", targetAddress, err)
-				}
-			}
-			msg := types.NewMsgSupplyIncreaseProposalRequest(coin, targetAddress, authority)
-			return provcli.GenerateOrBroadcastTxCLIAsGovProp(clientCtx, flagSet, msg)
+			msg := types.NewMsgReleaseFeeSponsorshipHoldRequest(args[0], clientCtx.GetFromAddress().String())
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
-	cmd.Flags().String(FlagTargetAddress, "", "optional address to send minted coins")
 	flags.AddTxFlagsToCmd(cmd)
-	govcli.AddGovPropFlagsToCmd(cmd)
-	provcli.AddAuthorityFlagToCmd(cmd)
 	return cmd
 }
 
-// GetCmdSetAdministratorProposal returns a CLI command for submitting a set administrator proposal.
-func GetCmdSetAdministratorProposal() *cobra.Command {
+// GetCmdSetBridgeInfo returns a CLI command for creating or updating a wrapped-asset marker's bridge info.
+func GetCmdSetBridgeInfo() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:     "set-administrator-proposal <denom> <access-grants>",
-		Aliases: []string{"sap", "s-a-p"},
-		Args:    cobra.ExactArgs(2),
-		Short:   "Submit a set administrator proposal along with a title, summary, and deposit",
-		Long: strings.TrimSpace(`Submit a set administrator proposal along with a title, summary, and deposit.
-<denom> is the marker denomination.
-<access-grants> is a comma-separated list of access grants in the format address,permissions;address,permissions.
-Example: pb1...,mint,burn;pb2...,admin,transfer
+		Use:     "set-bridge-info <denom> <origin chain> <origin asset id> <custodian>",
+		Aliases: []string{"sbi"},
+		Args:    cobra.ExactArgs(4),
+		Short:   "Create or update a wrapped-asset marker's bridge info",
+		Long: strings.TrimSpace(`Create or update a wrapped-asset marker's bridge info.
+The bridge info identifies the chain the wrapped asset comes from, the asset on that chain, and who holds it there.
+A marker must have bridge info before coin can be minted using attested-mint.
 `),
-		Example: fmt.Sprintf(`$ %[1]s tx marker set-administrator-proposal mycoin "pb1...,mint,burn;pb2...,admin,transfer" --title "My Title" --summary "My summary" --deposit 1000000000nhash
-$ %[1]s tx marker sap mycoin "pb1...,mint,burn" --title "My Title" --summary "My summary" --deposit 1000000000nhash
-`, version.AppName),
+		Example: fmt.Sprintf(`$ %s tx marker set-bridge-info wethcoin ethereum 0xC02aaA39b223FE8D0A0e5C4F27eAD9083C756Cc2 0x8EB8a3b98659Cce290402893d0123abb75E3ab28`, version.AppName),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return err
 			}
 			flagSet := cmd.Flags()
-			authority := provcli.GetAuthority(flagSet)
 
-			denom := args[0]
-			accessGrantsStr := args[1]
-			var accessGrants []types.AccessGrant
-			func() {
-				defer func() {
-					if r := recover(); r != nil {
-						err = fmt.Errorf("invalid access grants %s: %v", accessGrantsStr, r)
-					}
-				}()
-				accessGrants = ParseAccessGrantFromString(accessGrantsStr)
-			}()
+			info := types.NewBridgeInfo(args[0], args[1], args[2], args[3])
+			msg := types.NewMsgSetBridgeInfoRequest(info, "")
 
-			if err != nil {
-				return err
+			authSetter := func(authority string) {
+				msg.Administrator = authority
 			}
 
-			msg := types.NewMsgSetAdministratorProposalRequest(denom, accessGrants, authority)
-			return provcli.GenerateOrBroadcastTxCLIAsGovProp(clientCtx, flagSet, msg)
+			return generateOrBroadcastOptGovProp(clientCtx, flagSet, authSetter, msg)
 		},
 	}
+	addOptGovPropFlags(cmd)
 	flags.AddTxFlagsToCmd(cmd)
-	govcli.AddGovPropFlagsToCmd(cmd)
-	provcli.AddAuthorityFlagToCmd(cmd)
 	return cmd
 }
 
-// GetCmdRemoveAdministratorProposal returns a CLI command for submitting a remove administrator proposal.
-func GetCmdRemoveAdministratorProposal() *cobra.Command {
+// GetCmdAttestedMint returns a CLI command for minting coin of a wrapped-asset marker with an attestation reference.
+func GetCmdAttestedMint() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:     "remove-administrator-proposal <denom> <removed-addresses>",
-		Aliases: []string{"rap", "r-a-p"},
+		Use:     "attested-mint <coin> <reference>",
+		Aliases: []string{"am"},
 		Args:    cobra.ExactArgs(2),
-		Short:   "Submit a remove administrator proposal for a marker along with a title, summary, and deposit",
-		Long:    strings.TrimSpace(`Submit a remove administrator proposal for a marker along with a title, summary, and deposit.`),
-		Example: fmt.Sprintf(`$ %[1]s tx marker remove-administrator-proposal mycoin "address1,address2" --title "My Title" --summary "My summary" --deposit 1000000000nhash`, version.AppName),
+		Short:   "Mint coins of a wrapped-asset marker and record the proof of their backing",
+		Long: strings.TrimSpace(`Mints coins of a wrapped-asset marker's denomination and places them in the marker's account under escrow.
+The reference identifies the proof of the backing for the mint (e.g. the deposit transaction hash on the origin chain) and is recorded with the mint.
+Caller must possess the mint permission and the marker must have bridge info.
+`),
+		Example: fmt.Sprintf(`$ %s tx marker attested-mint 1000wethcoin 0x5c504ed432cb51138bcf09aa5e8a410dd4a1e204ef84bfed1be16dfba1b22060 --from mykey`, version.AppName),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return err
 			}
-			flagSet := cmd.Flags()
-			authority := provcli.GetAuthority(flagSet)
-			denom := args[0]
-			removedAddressesStr := args[1]
 
-			removedAddresses := strings.Split(removedAddressesStr, ",")
-			for _, addr := range removedAddresses {
-				_, err := sdk.AccAddressFromBech32(addr)
-				if err != nil {
-					return fmt.Errorf("invalid address %s: %w", addr, err)
-				}
+			coin, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return sdkErrors.ErrInvalidCoins.Wrapf("invalid coin %s", args[0])
 			}
-
-			msg := types.NewMsgRemoveAdministratorProposalRequest(denom, removedAddresses, authority)
-			return provcli.GenerateOrBroadcastTxCLIAsGovProp(clientCtx, flagSet, msg)
+			msg := types.NewMsgAttestedMintRequest(coin, args[1], clientCtx.GetFromAddress().String())
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 	flags.AddTxFlagsToCmd(cmd)
-	govcli.AddGovPropFlagsToCmd(cmd)
-	provcli.AddAuthorityFlagToCmd(cmd)
 	return cmd
 }
 
-// GetCmdChangeStatusProposal returns a CLI command for submitting a change status proposal.
-func GetCmdChangeStatusProposal() *cobra.Command {
+// GetCmdSetBasketInfo returns a CLI command for setting the components that back a basket marker's coin.
+func GetCmdSetBasketInfo() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:     "change-status-proposal <denom> <new-status>",
-		Aliases: []string{"csp", "c-s-p"},
-		Args:    cobra.ExactArgs(2),
-		Short:   "Submit a change status proposal for a marker along with a title, summary, and deposit",
-		Long:    strings.TrimSpace(`Submit a change status proposal for a marker along with a title, summary, and deposit.`),
-		Example: fmt.Sprintf(`$ %[1]s tx marker change-status-proposal mycoin ACTIVE --title "My Title" --summary "My summary" --deposit 1000000000nhash`, version.AppName),
+		Use:     "set-basket-info <denom> <components> <basket amount>",
+		Aliases: []string{"sbsk"},
+		Args:    cobra.ExactArgs(3),
+		Short:   "Set the components that back a basket marker's coin",
+		Long: strings.TrimSpace(`Set the components that back a basket marker's coin.
+The components are the amounts of the underlying coins that back each <basket amount> of the basket coin.
+Once set, basket coin can only be minted by depositing its components (using basket-deposit),
+and burned by withdrawing them (using basket-withdraw).
+The marker must be active, and none of its coin can be in circulation.
+`),
+		Example: fmt.Sprintf(`$ %s tx marker set-basket-info indexcoin 5acoin,2bcoin 1`, version.AppName),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return err
 			}
 			flagSet := cmd.Flags()
-			authority := provcli.GetAuthority(flagSet)
-			denom := args[0]
-			newStatusStr := strings.ToUpper(args[1])
 
-			var newStatus types.MarkerStatus
+			components, err := sdk.ParseCoinsNormalized(args[1])
+			if err != nil {
+				return sdkErrors.ErrInvalidCoins.Wrapf("invalid components %s", args[1])
+			}
+			basketAmount, ok := sdkmath.NewIntFromString(args[2])
+			if !ok {
+				return fmt.Errorf("invalid basket amount %q", args[2])
+			}
+			info := types.NewBasketInfo(args[0], components, basketAmount)
+			msg := types.NewMsgSetBasketInfoRequest(info, "")
+
+			authSetter := func(authority string) {
+				msg.Administrator = authority
+			}
+
+			return generateOrBroadcastOptGovProp(clientCtx, flagSet, authSetter, msg)
+		},
+	}
+	addOptGovPropFlags(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdSetIBCMemoActionConfig returns a CLI command for setting the ibc memo actions allowed for a marker's coin.
+func GetCmdSetIBCMemoActionConfig() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "set-ibc-memo-action-config <denom> [<action>:<target>[=<value>] ...]",
+		Aliases: []string{"simac"},
+		Args:    cobra.MinimumNArgs(1),
+		Short:   "Set the actions that ICS-20 memos are allowed to request for a marker's coin",
+		Long: strings.TrimSpace(`Set the actions that ICS-20 memos are allowed to request when a marker's coin is received over IBC.
+Each action is the name of the memo action and its target, e.g. the attribute name for "attribute",
+or the contract address for "wasm". If a value is provided, it is always used and the memo cannot provide one.
+If no actions are provided, the marker's config is removed and memos cannot request any actions.
+`),
+		Example: fmt.Sprintf(`$ %[1]s tx marker set-ibc-memo-action-config hotdogcoin attribute:subscribed.hotdog
+$ %[1]s tx marker set-ibc-memo-action-config hotdogcoin attribute:tier.hotdog=gold wasm:pb14hj2tavq8fpesdwxxcu44rty3hh90vhujrvcmstl4zr3txmfvw9s9p2vla
+$ %[1]s tx marker set-ibc-memo-action-config hotdogcoin`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			flagSet := cmd.Flags()
+
+			config := types.NewIBCMemoActionConfig(args[0])
+			for _, arg := range args[1:] {
+				action, target, found := strings.Cut(arg, ":")
+				if !found {
+					return fmt.Errorf("invalid ibc memo action %q: expected <action>:<target>[=<value>]", arg)
+				}
+				target, value, _ := strings.Cut(target, "=")
+				config.Actions = append(config.Actions, types.NewIBCMemoAction(action, target, value))
+			}
+			msg := types.NewMsgSetIBCMemoActionConfigRequest(config, "")
+
+			authSetter := func(authority string) {
+				msg.Administrator = authority
+			}
+
+			return generateOrBroadcastOptGovProp(clientCtx, flagSet, authSetter, msg)
+		},
+	}
+	addOptGovPropFlags(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdBasketDeposit returns a CLI command for minting basket coin in exchange for a deposit of its components.
+func GetCmdBasketDeposit() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "basket-deposit <coin>",
+		Aliases: []string{"bskd"},
+		Args:    cobra.ExactArgs(1),
+		Short:   "Mint basket coin in exchange for a deposit of its components",
+		Long: strings.TrimSpace(`Mint basket coin in exchange for a deposit of its components.
+The components needed to back the coin are taken from the signer's account, and the coin is given to the signer.
+The amount must be a multiple of the basket's basket amount.
+`),
+		Example: fmt.Sprintf(`$ %s tx marker basket-deposit 10indexcoin --from mykey`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			coin, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return sdkErrors.ErrInvalidCoins.Wrapf("invalid coin %s", args[0])
+			}
+			msg := types.NewMsgBasketDepositRequest(coin, clientCtx.GetFromAddress().String())
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdBasketWithdraw returns a CLI command for burning basket coin in exchange for a withdrawal of its components.
+func GetCmdBasketWithdraw() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "basket-withdraw <coin>",
+		Aliases: []string{"bskw"},
+		Args:    cobra.ExactArgs(1),
+		Short:   "Burn basket coin in exchange for a withdrawal of its components",
+		Long: strings.TrimSpace(`Burn basket coin in exchange for a withdrawal of its components.
+The coin is taken from the signer's account and burned, and the components that backed it are given to the signer.
+The amount must be a multiple of the basket's basket amount.
+`),
+		Example: fmt.Sprintf(`$ %s tx marker basket-withdraw 10indexcoin --from mykey`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			coin, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return sdkErrors.ErrInvalidCoins.Wrapf("invalid coin %s", args[0])
+			}
+			msg := types.NewMsgBasketWithdrawRequest(coin, clientCtx.GetFromAddress().String())
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdSetAccountData returns a CLI command for setting a marker's account data.
+func GetCmdSetAccountData() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "account-data <denom> " + attrcli.AccountDataFlagsUse,
+		Aliases: []string{"accountdata", "ad"},
+		Short:   "Set a marker's account data",
+		Example: fmt.Sprintf(`$ %[1]s tx marker account-data hotdogcoin --%[2]s "This is hotdogcoin's marker data.'"
+$ %[1]s tx marker account-data hotdogcoin --%[3]s hotdogcoin-account-data.json
+$ %[1]s tx marker account-data hotdogcoin --%[4]s`,
+			version.AppName, attrcli.FlagValue, attrcli.FlagFile, attrcli.FlagDelete),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			flagSet := cmd.Flags()
+
+			msg := &types.MsgSetAccountDataRequest{Denom: strings.TrimSpace(args[0])}
+
+			msg.Value, err = attrcli.ReadAccountDataFlags(flagSet)
+			if err != nil {
+				return err
+			}
+
+			setSigner := func(signer string) {
+				msg.Signer = signer
+			}
+
+			return generateOrBroadcastOptGovProp(clientCtx, flagSet, setSigner, msg)
+		},
+	}
+
+	attrcli.AddAccountDataFlagsToCmd(cmd)
+	addOptGovPropFlags(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdAddNetAssetValues returns a CLI command for adding/updating marker net asset values.
+func GetCmdAddNetAssetValues() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "add-net-asset-values <denom> <valuation[;valuation...]>",
+		Aliases: []string{"add-navs", "anavs"},
+		Short:   "Provide net asset values for a marker",
+		Long: `
+Provide net asset valuations for a marker. Net asset values are used to establish 
+the relative value of the marker in relation to other assets or currencies.
+
+Net asset values are expressed as a ratio between an amount of coin paid (price) 
+and a volume of the marker's tokens which are considered equivalent in value.
+
+The denomination of the amount paid (price) must either:
+1) Exist on-chain as a separate marker, or
+2) Be supplied as [1000usd], an integer valued in mils (1000 mils = $1 USD).
+
+The volume is supplied as an integer count of the current marker's tokens.
+
+IMPORTANT: All values must be represented as whole integers. If a decimal value 
+is required, adjust the ratio between the price and volume to achieve the 
+desired precision.
+`,
+		Example: fmt.Sprintf(`
+  Set a value of $1 = 1markercoin (Note USD is denominated in mils)
+  $ %[1]s tx %[2]s add-net-asset-values markercoin 1000usd,1
+  
+  Provide more than one valuation in a single call
+  $ %[1]s tx %[2]s add-net-asset-values markercoin 1000usd,1;5000000000nhash,1
+
+  Valuations for larger trades with volumes greater than 1
+  $ %[1]s tx %[2]s add-net-asset-values markercoin 100000usd,199;1000stake,19
+
+  All values must be represented as whole integers.  If a decimal value is required
+  then the ratio between the price and volume must be adjusted to achieve the decimal
+  required.
+
+  Note: When the valuations are recorded, each will indicate the address of the admin
+  who provided the value. This will be published in the associated event data and 
+  captured in the NAV record.  For NAVs set by other modules such as x/exchange the
+  protocol will indicate these sources.  This separates established values from
+  the owner (self-attestation) from those set through blockchain transactions.
+		`,
+			version.AppName, types.ModuleName),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			denom := strings.TrimSpace(args[0])
+			netAssetValues, err := ParseNetAssetValueString(args[1])
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), types.NewMsgAddNetAssetValuesRequest(denom, clientCtx.GetFromAddress().String(), netAssetValues))
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdBindMarkerName returns a CLI command for binding a name under a name owned by a marker.
+func GetCmdBindMarkerName() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "bind-name <denom> <name> <address> <parent>",
+		Aliases: []string{"bn"},
+		Short:   "Bind a name to an address under a name owned by a marker",
+		Long: strings.TrimSpace(`Bind a name to an address under a parent name that resolves to the marker's address.
+The signer must have admin access on the marker, or the message must be submitted as a gov proposal.
+`),
+		Example: fmt.Sprintf(`$ %[1]s tx marker bind-name hotdogcoin treasury pb1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk hotdog.pb
+$ %[1]s tx marker bind-name hotdogcoin shop pb1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk hotdog.pb --%[2]s`,
+			version.AppName, FlagUnrestricted),
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			flagSet := cmd.Flags()
+
+			address, err := sdk.AccAddressFromBech32(args[2])
+			if err != nil {
+				return fmt.Errorf("invalid address %q: %w", args[2], err)
+			}
+			unrestricted, err := flagSet.GetBool(FlagUnrestricted)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgBindMarkerNameRequest(
+				strings.TrimSpace(args[0]),
+				strings.ToLower(strings.TrimSpace(args[3])),
+				strings.ToLower(strings.TrimSpace(args[1])),
+				address,
+				!unrestricted,
+				"",
+			)
+
+			setAdmin := func(admin string) {
+				msg.Administrator = admin
+			}
+
+			return generateOrBroadcastOptGovProp(clientCtx, flagSet, setAdmin, msg)
+		},
+	}
+
+	cmd.Flags().Bool(FlagUnrestricted, false, "Allow child name creation by everyone")
+	addOptGovPropFlags(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdDeleteMarkerName returns a CLI command for deleting a name owned by a marker.
+func GetCmdDeleteMarkerName() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "delete-name <denom> <name>",
+		Aliases: []string{"dn"},
+		Short:   "Delete a name owned by a marker",
+		Long: strings.TrimSpace(`Delete a name that resolves to the marker's address.
+The signer must have admin access on the marker, or the message must be submitted as a gov proposal.
+`),
+		Example: fmt.Sprintf(`$ %[1]s tx marker delete-name hotdogcoin treasury.hotdog.pb`, version.AppName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgDeleteMarkerNameRequest(
+				strings.TrimSpace(args[0]),
+				strings.ToLower(strings.TrimSpace(args[1])),
+				"",
+			)
+
+			setAdmin := func(admin string) {
+				msg.Administrator = admin
+			}
+
+			return generateOrBroadcastOptGovProp(clientCtx, cmd.Flags(), setAdmin, msg)
+		},
+	}
+
+	addOptGovPropFlags(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdDelegateEscrow returns a CLI command for staking some of a marker's escrowed funds with a validator.
+func GetCmdDelegateEscrow() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delegate-escrow <denom> <validator> <amount>",
+		Short: "Delegate some of a marker's escrowed funds to a validator",
+		Long: strings.TrimSpace(`Stake some of a marker's escrowed funds with a validator. The marker must be active.
+The signer must have delegate access on the marker, or the message must be submitted as a gov proposal.
+`),
+		Example: fmt.Sprintf(`$ %s tx marker delegate-escrow hotdogcoin pbvaloper1tgq6cpu6hmsrvkvdu82j99tsxxw7qqajn843fe 1000000000nhash`, version.AppName),
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			validator, err := sdk.ValAddressFromBech32(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid validator address %q: %w", args[1], err)
+			}
+			amount, err := sdk.ParseCoinNormalized(args[2])
+			if err != nil {
+				return sdkErrors.ErrInvalidCoins.Wrapf("invalid coin %s", args[2])
+			}
+
+			msg := types.NewMsgDelegateEscrowRequest(strings.TrimSpace(args[0]), validator, amount, "")
+
+			setAdmin := func(admin string) {
+				msg.Administrator = admin
+			}
+
+			return generateOrBroadcastOptGovProp(clientCtx, cmd.Flags(), setAdmin, msg)
+		},
+	}
+
+	addOptGovPropFlags(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdUndelegateEscrow returns a CLI command for unstaking some of a marker's funds from a validator.
+func GetCmdUndelegateEscrow() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "undelegate-escrow <denom> <validator> <amount>",
+		Short: "Undelegate some of a marker's funds from a validator",
+		Long: strings.TrimSpace(`Unstake some of a marker's funds from a validator.
+The funds are returned to the marker's escrow once the unbonding period is over.
+The signer must have delegate access on the marker, or the message must be submitted as a gov proposal.
+`),
+		Example: fmt.Sprintf(`$ %s tx marker undelegate-escrow hotdogcoin pbvaloper1tgq6cpu6hmsrvkvdu82j99tsxxw7qqajn843fe 1000000000nhash`, version.AppName),
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			validator, err := sdk.ValAddressFromBech32(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid validator address %q: %w", args[1], err)
+			}
+			amount, err := sdk.ParseCoinNormalized(args[2])
+			if err != nil {
+				return sdkErrors.ErrInvalidCoins.Wrapf("invalid coin %s", args[2])
+			}
+
+			msg := types.NewMsgUndelegateEscrowRequest(strings.TrimSpace(args[0]), validator, amount, "")
+
+			setAdmin := func(admin string) {
+				msg.Administrator = admin
+			}
+
+			return generateOrBroadcastOptGovProp(clientCtx, cmd.Flags(), setAdmin, msg)
+		},
+	}
+
+	addOptGovPropFlags(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdCollectEscrowRewards returns a CLI command for withdrawing a marker's staking rewards into its escrow.
+func GetCmdCollectEscrowRewards() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "collect-escrow-rewards <denom> <validator>",
+		Short: "Collect a marker's staking rewards from a validator into the marker's escrow",
+		Long: strings.TrimSpace(`Withdraw the staking rewards that a marker has earned from a validator into the marker's escrow.
+The signer must have delegate access on the marker, or the message must be submitted as a gov proposal.
+`),
+		Example: fmt.Sprintf(`$ %s tx marker collect-escrow-rewards hotdogcoin pbvaloper1tgq6cpu6hmsrvkvdu82j99tsxxw7qqajn843fe`, version.AppName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			validator, err := sdk.ValAddressFromBech32(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid validator address %q: %w", args[1], err)
+			}
+
+			msg := types.NewMsgCollectEscrowRewardsRequest(strings.TrimSpace(args[0]), validator, "")
+
+			setAdmin := func(admin string) {
+				msg.Administrator = admin
+			}
+
+			return generateOrBroadcastOptGovProp(clientCtx, cmd.Flags(), setAdmin, msg)
+		},
+	}
+
+	addOptGovPropFlags(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdSetMintAllowance returns a CLI command for setting how much of a marker's coin an account can mint.
+func GetCmdSetMintAllowance() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-mint-allowance <minter> <coin>",
+		Short: "Set the amount of a marker's coin that an account is allowed to mint",
+		Long: strings.TrimSpace(`Set the total amount of a marker's coin that an account is allowed to mint without having mint access.
+This replaces any existing allowance for that account. An amount of 0 removes the allowance.
+The signer must have mint access on the marker, or the message must be submitted as a gov proposal.
+`),
+		Example: fmt.Sprintf(`$ %s tx marker set-mint-allowance pb1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk 5000hotdogcoin`, version.AppName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			minter, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid minter address %q: %w", args[0], err)
+			}
+			coin, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return sdkErrors.ErrInvalidCoins.Wrapf("invalid coin %s", args[1])
+			}
+
+			msg := types.NewMsgSetMintAllowanceRequest(coin, minter, "")
+
+			setAdmin := func(admin string) {
+				msg.Administrator = admin
+			}
+
+			return generateOrBroadcastOptGovProp(clientCtx, cmd.Flags(), setAdmin, msg)
+		},
+	}
+
+	addOptGovPropFlags(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdMintFromAllowance returns a CLI command for minting coin against the signer's mint allowance.
+func GetCmdMintFromAllowance() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "mint-from-allowance <coin>",
+		Short: "Mint coins to yourself against your mint allowance",
+		Long: strings.TrimSpace(`Mint coins of a marker and send them to the signer, reducing the signer's mint allowance by the amount minted.
+If the marker has required attributes, the signer must have them. The marker must be in the active status.
+`),
+		Example: fmt.Sprintf(`$ %s tx marker mint-from-allowance 1000hotdogcoin --from mykey`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			coin, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return sdkErrors.ErrInvalidCoins.Wrapf("invalid coin %s", args[0])
+			}
+
+			msg := types.NewMsgMintFromAllowanceRequest(coin, clientCtx.GetFromAddress())
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdScheduleDistribution returns a CLI command for scheduling a pro-rata payout to the holders of a marker's coin.
+func GetCmdScheduleDistribution() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "schedule-distribution <denom> <amount>",
+		Short: "Schedule a pro-rata payout of funds to the holders of a marker's coin",
+		Long: strings.TrimSpace(`Schedule a pro-rata payout of funds to the holders of a marker's coin.
+The amount is taken from the signer when the distribution is scheduled. Starting at the start height, a batch of
+holders is paid out each block. Payouts smaller than the min payout are recorded as claims that the holder can claim.
+Whatever isn't paid out or claimable once all holders are processed is refunded to the signer.
+The signer must have admin access on the marker.
+`),
+		Example: fmt.Sprintf(`$ %s tx marker schedule-distribution hotdogcoin 1000000nhash --start-height 12345 --batch-size 200 --min-payout 10nhash --from mykey`, version.AppName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			coin, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return sdkErrors.ErrInvalidCoins.Wrapf("invalid coin %s", args[1])
+			}
+			startHeight, err := cmd.Flags().GetInt64(FlagStartHeight)
+			if err != nil {
+				return err
+			}
+			batchSize, err := cmd.Flags().GetUint32(FlagBatchSize)
+			if err != nil {
+				return err
+			}
+			minPayoutStr, err := cmd.Flags().GetString(FlagMinPayout)
+			if err != nil {
+				return err
+			}
+			var minPayout *sdk.Coin
+			if len(minPayoutStr) > 0 {
+				minPayoutCoin, err := sdk.ParseCoinNormalized(minPayoutStr)
+				if err != nil {
+					return sdkErrors.ErrInvalidCoins.Wrapf("invalid %s %s", FlagMinPayout, minPayoutStr)
+				}
+				minPayout = &minPayoutCoin
+			}
+
+			msg := types.NewMsgScheduleDistributionRequest(args[0], coin, startHeight, batchSize, minPayout, clientCtx.GetFromAddress())
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Int64(FlagStartHeight, 0, "The block height to start paying out at (default is the current block)")
+	cmd.Flags().Uint32(FlagBatchSize, 0, fmt.Sprintf("The number of holders to pay out each block (default %d)", types.DefaultDistributionBatchSize))
+	cmd.Flags().String(FlagMinPayout, "", "The smallest amount to send directly to a holder; smaller amounts must be claimed")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdCancelDistribution returns a CLI command for cancelling a distribution that has not started yet.
+func GetCmdCancelDistribution() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-distribution <distribution id>",
+		Short: "Cancel a distribution that has not started yet",
+		Long: strings.TrimSpace(`Cancel a distribution that has not started yet, refunding its funds to the account that scheduled it.
+The signer must have admin access on the marker.
+`),
+		Example: fmt.Sprintf(`$ %s tx marker cancel-distribution 3 --from mykey`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid distribution id %q: %w", args[0], err)
+			}
+
+			msg := types.NewMsgCancelDistributionRequest(id, clientCtx.GetFromAddress())
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdClaimDistribution returns a CLI command for claiming the funds recorded for the signer in a distribution.
+func GetCmdClaimDistribution() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "claim-distribution <distribution id>",
+		Short:   "Claim the funds recorded for you in a distribution",
+		Example: fmt.Sprintf(`$ %s tx marker claim-distribution 3 --from mykey`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid distribution id %q: %w", args[0], err)
+			}
+
+			msg := types.NewMsgClaimDistributionRequest(id, clientCtx.GetFromAddress())
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdAllocateEscrow returns a CLI command for earmarking some of a marker's escrowed funds in an escrow ledger.
+func GetCmdAllocateEscrow() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "allocate-escrow <denom> <ledger> <coins>",
+		Short: "Earmark some of a marker's escrowed funds in an escrow ledger",
+		Long: strings.TrimSpace(`Earmark some of a marker's escrowed funds for the purpose of an escrow ledger, e.g. operations, reserves, or fees.
+The marker must hold the funds and they cannot already be earmarked in another escrow ledger.
+Earmarked funds can only be withdrawn from the marker using the escrow ledger's withdraw limits.
+The signer must have admin access on the marker.
+`),
+		Example: fmt.Sprintf(`$ %s tx marker allocate-escrow hotdogcoin reserves 5000nhash --from mykey`, version.AppName),
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			coins, err := sdk.ParseCoinsNormalized(args[2])
+			if err != nil {
+				return sdkErrors.ErrInvalidCoins.Wrapf("invalid coins %s", args[2])
+			}
+
+			msg := types.NewMsgAllocateEscrowRequest(args[0], args[1], coins, clientCtx.GetFromAddress())
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdReleaseEscrow returns a CLI command for returning funds earmarked in an escrow ledger to a marker's unearmarked escrow.
+func GetCmdReleaseEscrow() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "release-escrow <denom> <ledger> <coins>",
+		Short: "Submit a governance proposal to release funds earmarked in one of a marker's escrow ledgers",
+		Long: strings.TrimSpace(`Submit a governance proposal to return funds earmarked in one of a marker's escrow ledgers to the
+marker's unearmarked escrow. The marker must allow governance control.
+`),
+		Example: fmt.Sprintf(`$ %s tx marker release-escrow hotdogcoin reserves 5000nhash --deposit 50000nhash --from mykey`, version.AppName),
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			coins, err := sdk.ParseCoinsNormalized(args[2])
+			if err != nil {
+				return sdkErrors.ErrInvalidCoins.Wrapf("invalid coins %s", args[2])
+			}
+
+			flagSet := cmd.Flags()
+			authority, err := sdk.AccAddressFromBech32(provcli.GetAuthority(flagSet))
+			if err != nil {
+				return fmt.Errorf("invalid authority: %w", err)
+			}
+			msg := types.NewMsgReleaseEscrowRequest(args[0], args[1], coins, authority)
+			return provcli.GenerateOrBroadcastTxCLIAsGovProp(clientCtx, flagSet, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	govcli.AddGovPropFlagsToCmd(cmd)
+	provcli.AddAuthorityFlagToCmd(cmd)
+	return cmd
+}
+
+// GetCmdSetEscrowWithdrawLimit returns a CLI command for setting how much an account can withdraw from an escrow ledger.
+func GetCmdSetEscrowWithdrawLimit() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-escrow-withdraw-limit <denom> <ledger> <grantee> [<coins>]",
+		Short: "Submit a governance proposal to set how much an account is allowed to withdraw from one of a marker's escrow ledgers",
+		Long: strings.TrimSpace(`Submit a governance proposal to set the total amount an account is allowed to withdraw from one of a
+marker's escrow ledgers. This replaces any existing limit for that account. Omitting the coins removes the limit.
+The grantee must also have withdraw access on the marker. The marker must allow governance control.
+`),
+		Example: fmt.Sprintf(`$ %s tx marker set-escrow-withdraw-limit hotdogcoin operations pb1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk 1000nhash --deposit 50000nhash --from mykey`, version.AppName),
+		Args:    cobra.RangeArgs(3, 4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			grantee, err := sdk.AccAddressFromBech32(args[2])
+			if err != nil {
+				return fmt.Errorf("invalid grantee address %q: %w", args[2], err)
+			}
+			var limit sdk.Coins
+			if len(args) == 4 {
+				limit, err = sdk.ParseCoinsNormalized(args[3])
+				if err != nil {
+					return sdkErrors.ErrInvalidCoins.Wrapf("invalid coins %s", args[3])
+				}
+			}
+
+			flagSet := cmd.Flags()
+			authority, err := sdk.AccAddressFromBech32(provcli.GetAuthority(flagSet))
+			if err != nil {
+				return fmt.Errorf("invalid authority: %w", err)
+			}
+			msg := types.NewMsgSetEscrowWithdrawLimitRequest(args[0], args[1], grantee, limit, authority)
+			return provcli.GenerateOrBroadcastTxCLIAsGovProp(clientCtx, flagSet, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	govcli.AddGovPropFlagsToCmd(cmd)
+	provcli.AddAuthorityFlagToCmd(cmd)
+	return cmd
+}
+
+// GetCmdWithdrawFromEscrow returns a CLI command for withdrawing funds from one of a marker's escrow ledgers.
+func GetCmdWithdrawFromEscrow() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "withdraw-escrow <denom> <ledger> <coins> [<recipient>]",
+		Short: "Withdraw funds from one of a marker's escrow ledgers",
+		Long: strings.TrimSpace(`Withdraw funds earmarked in one of a marker's escrow ledgers, reducing the signer's withdraw limit for that ledger.
+If the recipient is not provided then the funds are sent to the signer.
+The signer must have withdraw access on the marker and a withdraw limit for the escrow ledger.
+`),
+		Example: fmt.Sprintf(`$ %s tx marker withdraw-escrow hotdogcoin operations 100nhash pb1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk --from mykey`, version.AppName),
+		Args:    cobra.RangeArgs(3, 4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			coins, err := sdk.ParseCoinsNormalized(args[2])
+			if err != nil {
+				return sdkErrors.ErrInvalidCoins.Wrapf("invalid coins %s", args[2])
+			}
+			var recipient sdk.AccAddress
+			if len(args) == 4 {
+				recipient, err = sdk.AccAddressFromBech32(args[3])
+				if err != nil {
+					return fmt.Errorf("invalid recipient address %q: %w", args[3], err)
+				}
+			}
+
+			msg := types.NewMsgWithdrawFromEscrowRequest(args[0], args[1], coins, recipient, clientCtx.GetFromAddress())
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdScheduleBurn returns a CLI command for scheduling a burn of some of a marker's escrowed coin.
+func GetCmdScheduleBurn() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "schedule-burn <amount> <burn height>",
+		Short: "Schedule a burn of some of a marker's escrowed coin at a future block height",
+		Long: strings.TrimSpace(`Schedule a burn of some of a marker's escrowed coin at the end of the burn height block.
+The amount is reserved in the marker's escrow until it is burned or the burn is cancelled.
+The burn can be cancelled during the cancel window, which is the provided number of blocks starting with the current one.
+The signer must have burn access on the marker.
+`),
+		Example: fmt.Sprintf(`$ %s tx marker schedule-burn 1000hotdogcoin 12345 --cancel-window 100 --from mykey`, version.AppName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			coin, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return sdkErrors.ErrInvalidCoins.Wrapf("invalid coin %s", args[0])
+			}
+			burnHeight, err := strconv.ParseInt(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid burn height %q: %w", args[1], err)
+			}
+			cancelWindow, err := cmd.Flags().GetUint64(FlagCancelWindow)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgScheduleBurnRequest(coin, burnHeight, cancelWindow, clientCtx.GetFromAddress())
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Uint64(FlagCancelWindow, 0, "The number of blocks in which the burn can be cancelled (default is not cancellable)")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdCancelScheduledBurn returns a CLI command for cancelling a scheduled burn whose cancel window is still open.
+func GetCmdCancelScheduledBurn() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-scheduled-burn <burn id>",
+		Short: "Cancel a scheduled burn whose cancel window is still open",
+		Long: strings.TrimSpace(`Cancel a scheduled burn whose cancel window is still open, releasing the coin reserved for it.
+The signer must have burn access on the marker.
+`),
+		Example: fmt.Sprintf(`$ %s tx marker cancel-scheduled-burn 3 --from mykey`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid burn id %q: %w", args[0], err)
+			}
+
+			msg := types.NewMsgCancelScheduledBurnRequest(id, clientCtx.GetFromAddress())
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdExchange returns a CLI command for atomically swapping marker coins with another account.
+func GetCmdExchange() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "exchange <amount> <counterparty> <counterparty amount>",
+		Short: "Atomically swap marker coins with another account",
+		Long: strings.TrimSpace(`Atomically swap marker coins with another account.
+The <amount> is sent from the --from account to the <counterparty>, and the <counterparty amount> is sent from the
+<counterparty> to the --from account. Both denoms must have a marker, and both sends are subject to the usual send
+restrictions. Both accounts must sign the tx, so it's usually generated with --generate-only, then signed by each.
+`),
+		Example: fmt.Sprintf(`$ %s tx marker exchange 100mycoin pb1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj 25othercoin --from mykey --generate-only > exchange.json`,
+			version.AppName),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid amount %q: %w", args[0], err)
+			}
+			counterparty, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid counterparty %q: %w", args[1], err)
+			}
+			counterpartyAmount, err := sdk.ParseCoinNormalized(args[2])
+			if err != nil {
+				return fmt.Errorf("invalid counterparty amount %q: %w", args[2], err)
+			}
+
+			msg := types.NewMsgExchangeRequest(clientCtx.GetFromAddress(), amount, counterparty, counterpartyAmount)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdSupplyDecreaseProposal returns a CLI command for submitting a supply decrease proposal.
+func GetCmdSupplyDecreaseProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "supply-decrease-proposal <amount>",
+		Aliases: []string{"sdp", "s-d-p"},
+		Args:    cobra.ExactArgs(1),
+		Short:   "Submit a supply decrease proposal of amount to decrease supply by along with a title, summary, and deposit",
+		Long:    strings.TrimSpace(`Submit a supply decrease proposal of amount to decrease supply by along with a title, summary, and deposit.`),
+		Example: fmt.Sprintf(`$ %[1]s tx marker supply-decrease-proposal 1000mycoin --title "My Title" --summary "My summary" --deposit 1000000000nhash 
+$ %[1]s tx marker sdp 100stake --title "My Title" --summary "My summary" --deposit 1000000000nhash
+`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			flagSet := cmd.Flags()
+			authority := provcli.GetAuthority(flagSet)
+			coin, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid coin %s", args[0])
+			}
+			msg := types.NewMsgSupplyDecreaseProposalRequest(coin, authority)
+			return provcli.GenerateOrBroadcastTxCLIAsGovProp(clientCtx, flagSet, msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	govcli.AddGovPropFlagsToCmd(cmd)
+	provcli.AddAuthorityFlagToCmd(cmd)
+	return cmd
+}
+
+// GetCmdSupplyIncreaseProposal returns a CLI command for submitting a supply increase proposal.
+func GetCmdSupplyIncreaseProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "supply-increase-proposal <amount>",
+		Aliases: []string{"sip", "s-i-p"},
+		Args:    cobra.ExactArgs(1),
+		Short:   "Submit a supply increase proposal of amount to increase supply by along with a title, summary, and deposit",
+		Long:    strings.TrimSpace(`Submit a supply increase proposal of amount to decrease supply by along with a title, summary, and deposit.`),
+		Example: fmt.Sprintf(`$ %[1]s tx marker supply-increase-proposal 1000mycoin --title "My Title" --summary "My summary" --deposit 1000000000nhash 
+$ %[1]s tx marker sdp 100stake --title "My Title" --summary "My summary" --deposit 1000000000nhash
+$ %[1]s tx marker sdp 100stake --target-address pb1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj --title "My Title" --summary "My summary" --deposit 1000000000nhash
+`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			flagSet := cmd.Flags()
+			authority := provcli.GetAuthority(flagSet)
+			coin, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid coin %s", args[0])
+			}
+			targetAddress, err := flagSet.GetString(FlagTargetAddress)
+			if err != nil {
+				return err
+			}
+			if len(targetAddress) > 0 {
+				_, err = sdk.AccAddressFromBech32(targetAddress)
+				if err != nil {
+					return fmt.Errorf("invalid target address %v: %w", targetAddress, err)
+				}
+			}
+			msg := types.NewMsgSupplyIncreaseProposalRequest(coin, targetAddress, authority)
+			return provcli.GenerateOrBroadcastTxCLIAsGovProp(clientCtx, flagSet, msg)
+		},
+	}
+	cmd.Flags().String(FlagTargetAddress, "", "optional address to send minted coins")
+	flags.AddTxFlagsToCmd(cmd)
+	govcli.AddGovPropFlagsToCmd(cmd)
+	provcli.AddAuthorityFlagToCmd(cmd)
+	return cmd
+}
+
+// GetCmdSetAdministratorProposal returns a CLI command for submitting a set administrator proposal.
+func GetCmdSetAdministratorProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "set-administrator-proposal <denom> <access-grants>",
+		Aliases: []string{"sap", "s-a-p"},
+		Args:    cobra.ExactArgs(2),
+		Short:   "Submit a set administrator proposal along with a title, summary, and deposit",
+		Long: strings.TrimSpace(`Submit a set administrator proposal along with a title, summary, and deposit.
+<denom> is the marker denomination.
+<access-grants> is a comma-separated list of access grants in the format address,permissions;address,permissions.
+Example: pb1...,mint,burn;pb2...,admin,transfer
+`),
+		Example: fmt.Sprintf(`$ %[1]s tx marker set-administrator-proposal mycoin "pb1...,mint,burn;pb2...,admin,transfer" --title "My Title" --summary "My summary" --deposit 1000000000nhash
+$ %[1]s tx marker sap mycoin "pb1...,mint,burn" --title "My Title" --summary "My summary" --deposit 1000000000nhash
+`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			flagSet := cmd.Flags()
+			authority := provcli.GetAuthority(flagSet)
+
+			denom := args[0]
+			accessGrantsStr := args[1]
+			var accessGrants []types.AccessGrant
+			func() {
+				defer func() {
+					if r := recover(); r != nil {
+						err = fmt.Errorf("invalid access grants %s: %v", accessGrantsStr, r)
+					}
+				}()
+				accessGrants = ParseAccessGrantFromString(accessGrantsStr)
+			}()
+
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetAdministratorProposalRequest(denom, accessGrants, authority)
+			return provcli.GenerateOrBroadcastTxCLIAsGovProp(clientCtx, flagSet, msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	govcli.AddGovPropFlagsToCmd(cmd)
+	provcli.AddAuthorityFlagToCmd(cmd)
+	return cmd
+}
+
+// GetCmdRemoveAdministratorProposal returns a CLI command for submitting a remove administrator proposal.
+func GetCmdRemoveAdministratorProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "remove-administrator-proposal <denom> <removed-addresses>",
+		Aliases: []string{"rap", "r-a-p"},
+		Args:    cobra.ExactArgs(2),
+		Short:   "Submit a remove administrator proposal for a marker along with a title, summary, and deposit",
+		Long:    strings.TrimSpace(`Submit a remove administrator proposal for a marker along with a title, summary, and deposit.`),
+		Example: fmt.Sprintf(`$ %[1]s tx marker remove-administrator-proposal mycoin "address1,address2" --title "My Title" --summary "My summary" --deposit 1000000000nhash`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			flagSet := cmd.Flags()
+			authority := provcli.GetAuthority(flagSet)
+			denom := args[0]
+			removedAddressesStr := args[1]
+
+			removedAddresses := strings.Split(removedAddressesStr, ",")
+			for _, addr := range removedAddresses {
+				_, err := sdk.AccAddressFromBech32(addr)
+				if err != nil {
+					return fmt.Errorf("invalid address %s: %w", addr, err)
+				}
+			}
+
+			msg := types.NewMsgRemoveAdministratorProposalRequest(denom, removedAddresses, authority)
+			return provcli.GenerateOrBroadcastTxCLIAsGovProp(clientCtx, flagSet, msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	govcli.AddGovPropFlagsToCmd(cmd)
+	provcli.AddAuthorityFlagToCmd(cmd)
+	return cmd
+}
+
+// GetCmdChangeStatusProposal returns a CLI command for submitting a change status proposal.
+func GetCmdChangeStatusProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "change-status-proposal <denom> <new-status>",
+		Aliases: []string{"csp", "c-s-p"},
+		Args:    cobra.ExactArgs(2),
+		Short:   "Submit a change status proposal for a marker along with a title, summary, and deposit",
+		Long:    strings.TrimSpace(`Submit a change status proposal for a marker along with a title, summary, and deposit.`),
+		Example: fmt.Sprintf(`$ %[1]s tx marker change-status-proposal mycoin ACTIVE --title "My Title" --summary "My summary" --deposit 1000000000nhash`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			flagSet := cmd.Flags()
+			authority := provcli.GetAuthority(flagSet)
+			denom := args[0]
+			newStatusStr := strings.ToUpper(args[1])
+
+			var newStatus types.MarkerStatus
 			switch newStatusStr {
 			case "PROPOSED":
 				newStatus = types.StatusProposed
@@ -1625,13 +2768,158 @@ func GetUpdateMarkerParamsCmd() *cobra.Command {
 				maxSupply,
 				authority,
 			)
+			msg.Params.MaxQueryResults, err = flagSet.GetUint32(FlagMaxQueryResults)
+			if err != nil {
+				return err
+			}
+			msg.Params.MaxQueryResponseBytes, err = flagSet.GetUint64(FlagMaxQueryResponseBytes)
+			if err != nil {
+				return err
+			}
+			msg.Params.SupplyHistoryMaxEntries, err = flagSet.GetUint32(FlagSupplyHistoryEntries)
+			if err != nil {
+				return err
+			}
+			msg.Params.SupplyHistoryRetentionBlocks, err = flagSet.GetUint64(FlagSupplyHistoryRetention)
+			if err != nil {
+				return err
+			}
+			msg.Params.MaxDistributionHoldersPerBlock, err = flagSet.GetUint32(FlagDistributionHolders)
+			if err != nil {
+				return err
+			}
+			feeStr, err := flagSet.GetString(FlagDistributionFee)
+			if err != nil {
+				return err
+			}
+			msg.Params.DistributionFee, err = sdk.ParseCoinsNormalized(feeStr)
+			if err != nil {
+				return fmt.Errorf("invalid distribution fee %q: %w", feeStr, err)
+			}
+			return provcli.GenerateOrBroadcastTxCLIAsGovProp(clientCtx, flagSet, msg)
+		},
+	}
+
+	cmd.Flags().Uint32(FlagMaxQueryResults, 0, "The maximum number of results in a page of a holding query (0 = no limit)")
+	cmd.Flags().Uint64(FlagMaxQueryResponseBytes, 0, "The maximum size (in bytes) of a holding query response (0 = no limit)")
+	cmd.Flags().Uint32(FlagSupplyHistoryEntries, 0, "The maximum number of entries in each marker's supply history (0 = not recorded)")
+	cmd.Flags().Uint64(FlagSupplyHistoryRetention, 0, "The number of blocks that supply history entries are kept (0 = no limit)")
+	cmd.Flags().Uint32(FlagDistributionHolders, 0, "The maximum number of holders processed across all distributions in a block (0 = the default)")
+	cmd.Flags().String(FlagDistributionFee, "", "The fee an admin pays to schedule a distribution")
+
+	govcli.AddGovPropFlagsToCmd(cmd)
+	provcli.AddAuthorityFlagToCmd(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdChangeMarkerTypeProposal returns a CLI command for submitting a proposal to change a marker's type.
+func GetCmdChangeMarkerTypeProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "change-type-proposal <denom> {coin|restricted}",
+		Aliases: []string{"ctp", "c-t-p"},
+		Args:    cobra.ExactArgs(2),
+		Short:   "Submit a governance proposal to change a marker between the coin and restricted types",
+		Long: strings.TrimSpace(`Submit a governance proposal to change a marker between the coin and restricted types.
+Changing a restricted marker to a coin marker removes the transfer and force transfer permissions from its access grants,
+clears its required attributes and send deny list, and turns off forced transfers.
+`),
+		Example: fmt.Sprintf(`$ %[1]s tx marker change-type-proposal mycoin restricted --title "My Title" --summary "My summary" --deposit 1000000000nhash`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			flagSet := cmd.Flags()
+			newType, err := types.MarkerTypeFromString(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgChangeMarkerTypeProposalRequest(args[0], newType, provcli.GetAuthority(flagSet))
+			return provcli.GenerateOrBroadcastTxCLIAsGovProp(clientCtx, flagSet, msg)
+		},
+	}
+
+	govcli.AddGovPropFlagsToCmd(cmd)
+	provcli.AddAuthorityFlagToCmd(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdUpdateSendRestrictionBypasses returns a cmd for updating the send restriction bypass registry via governance proposal.
+func GetCmdUpdateSendRestrictionBypasses() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "gov-update-send-restriction-bypasses",
+		Short: "Update the accounts that are exempt from marker send restrictions via governance proposal",
+		Long: strings.TrimSpace(`Update the accounts that are exempt from marker send restrictions via governance proposal.
+Each --` + FlagAdd + ` value has the format <address>,<type>[,<reason>] where <type> is either "required-attributes" or "sender".
+An account with a required-attributes bypass is exempt from the required attributes check of restricted markers.
+Sends from an account with a sender bypass are exempt from all marker send restrictions.
+`),
+		Args: cobra.NoArgs,
+		Example: fmt.Sprintf(`$ %[1]s tx marker gov-update-send-restriction-bypasses --%[2]s "pb1...,required-attributes,Allow escrow of restricted coins." --deposit 50000nhash
+$ %[1]s tx marker gov-update-send-restriction-bypasses --%[3]s pb1... --deposit 50000nhash`,
+			version.AppName, FlagAdd, FlagRemove),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			flagSet := cmd.Flags()
+			toAdd, err := flagSet.GetStringArray(FlagAdd)
+			if err != nil {
+				return err
+			}
+			set := make([]types.SendRestrictionBypass, len(toAdd))
+			for i, val := range toAdd {
+				set[i], err = ParseSendRestrictionBypass(val)
+				if err != nil {
+					return err
+				}
+			}
+			remove, err := flagSet.GetStringSlice(FlagRemove)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUpdateSendRestrictionBypassesRequest(provcli.GetAuthority(flagSet), set, remove)
 			return provcli.GenerateOrBroadcastTxCLIAsGovProp(clientCtx, flagSet, msg)
 		},
 	}
 
+	cmd.Flags().StringArray(FlagAdd, nil, "a send restriction bypass to add or update, <address>,<type>[,<reason>] (repeatable)")
+	cmd.Flags().StringSlice(FlagRemove, nil, "comma delimited list of bech32 addresses to remove from the send restriction bypass registry")
+
 	govcli.AddGovPropFlagsToCmd(cmd)
 	provcli.AddAuthorityFlagToCmd(cmd)
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
 }
+
+// ParseSendRestrictionBypass parses a send restriction bypass from a string with the format <address>,<type>[,<reason>].
+func ParseSendRestrictionBypass(val string) (types.SendRestrictionBypass, error) {
+	parts := strings.SplitN(val, ",", 3)
+	if len(parts) < 2 {
+		return types.SendRestrictionBypass{}, fmt.Errorf("invalid send restriction bypass %q: expected format <address>,<type>[,<reason>]", val)
+	}
+	rv := types.SendRestrictionBypass{Address: strings.TrimSpace(parts[0])}
+	switch strings.ToLower(strings.TrimSpace(parts[1])) {
+	case "required-attributes", "required_attributes", "send_restriction_bypass_type_required_attributes":
+		rv.BypassType = types.BypassTypeRequiredAttributes
+	case "sender", "send_restriction_bypass_type_sender":
+		rv.BypassType = types.BypassTypeSender
+	default:
+		return types.SendRestrictionBypass{}, fmt.Errorf("invalid send restriction bypass type %q: expected \"required-attributes\" or \"sender\"", parts[1])
+	}
+	if len(parts) == 3 {
+		rv.Reason = strings.TrimSpace(parts[2])
+	}
+	if _, err := sdk.AccAddressFromBech32(rv.Address); err != nil {
+		return types.SendRestrictionBypass{}, fmt.Errorf("invalid send restriction bypass address %q: %w", rv.Address, err)
+	}
+	return rv, nil
+}
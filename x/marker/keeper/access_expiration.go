@@ -0,0 +1,97 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+// removeExpiredAccess takes the access grants out of the marker that have expired as of the block time.
+// The marker is not saved. The removed grants are returned.
+func removeExpiredAccess(ctx sdk.Context, marker types.MarkerAccountI) []types.AccessGrant {
+	var expired []types.AccessGrant
+	for _, grant := range marker.GetAccessList() {
+		if grant.IsExpired(ctx.BlockTime()) {
+			expired = append(expired, grant)
+		}
+	}
+	for _, grant := range expired {
+		// The only possible error is for an invalid address, and grants in state have valid addresses.
+		_ = marker.RevokeAccess(grant.GetAddress())
+	}
+	return expired
+}
+
+// setAccessGrantExpirations indexes the marker by the expiration time of each of its expiring access grants.
+// Index entries for grants that have since been changed or removed are cleaned up when they're processed.
+func (k Keeper) setAccessGrantExpirations(ctx sdk.Context, marker types.MarkerAccountI) {
+	store := ctx.KVStore(k.storeKey)
+	for _, grant := range marker.GetAccessList() {
+		if grant.Expiration != nil {
+			store.Set(types.AccessGrantExpirationKey(*grant.Expiration, marker.GetAddress()), []byte{})
+		}
+	}
+}
+
+// validateAccessGrantExpiration returns an error if the grant has an expiration that isn't after the block time.
+func validateAccessGrantExpiration(ctx sdk.Context, grant types.AccessGrantI) error {
+	if expiration := grant.GetExpiration(); expiration != nil && !ctx.BlockTime().Before(*expiration) {
+		return fmt.Errorf("access grant expiration %s must be after the current block time %s",
+			expiration.UTC(), ctx.BlockTime().UTC())
+	}
+	return nil
+}
+
+// getAccessGrantExpirations returns the remaining validity of each of the marker's access grants that expire.
+func getAccessGrantExpirations(ctx sdk.Context, marker types.MarkerAccountI) []types.AccessGrantExpiration {
+	var rv []types.AccessGrantExpiration
+	for _, grant := range marker.GetAccessList() {
+		if grant.Expiration != nil {
+			rv = append(rv, types.AccessGrantExpiration{
+				Address:    grant.Address,
+				Expiration: *grant.Expiration,
+				Remaining:  grant.Expiration.Sub(ctx.BlockTime()),
+			})
+		}
+	}
+	return rv
+}
+
+// ProcessExpiredAccessGrants removes the access grants that expired before the current second from their markers.
+// An EventMarkerAccessExpired is emitted for each grant removed.
+func (k Keeper) ProcessExpiredAccessGrants(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	var keys [][]byte
+	it := store.Iterator(types.AccessGrantExpirationPrefix, types.AccessGrantExpirationTimePrefix(ctx.BlockTime()))
+	for ; it.Valid(); it.Next() {
+		keys = append(keys, it.Key())
+	}
+	it.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+		markerAddr := types.GetAccessGrantExpirationKeyMarker(key)
+		marker, err := k.getMarkerWithExpiredAccess(ctx, markerAddr)
+		if err != nil || marker == nil {
+			// The marker has been removed since the grant was indexed.
+			continue
+		}
+		expired := removeExpiredAccess(ctx, marker)
+		if len(expired) == 0 {
+			// The grant has been changed or removed since it was indexed.
+			continue
+		}
+		if err = marker.Validate(); err != nil {
+			k.Logger(ctx).Error("could not remove expired access grants", "denom", marker.GetDenom(), "err", err)
+			continue
+		}
+		k.SetMarker(ctx, marker)
+		for _, grant := range expired {
+			if err = ctx.EventManager().EmitTypedEvent(types.NewEventMarkerAccessExpired(grant, marker.GetDenom())); err != nil {
+				k.Logger(ctx).Error("could not emit marker access expired event", "denom", marker.GetDenom(), "err", err)
+			}
+		}
+	}
+}
@@ -0,0 +1,89 @@
+package keeper
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+// GetActivationChecks evaluates the preconditions for activating a marker.
+// A proposed marker is checked as if it were finalized, since finalizing it has the same preconditions.
+func (k Keeper) GetActivationChecks(ctx sdk.Context, marker types.MarkerAccountI) []types.ActivationCheck {
+	return []types.ActivationCheck{
+		types.NewActivationCheck(types.ActivationCheckStatus, true, activationStatusProblem(marker)),
+		types.NewActivationCheck(types.ActivationCheckManager, true, activationManagerProblem(marker)),
+		types.NewActivationCheck(types.ActivationCheckGrants, true, activationGrantsProblem(marker)),
+		types.NewActivationCheck(types.ActivationCheckSupply, true, k.activationSupplyProblem(ctx, marker)),
+		types.NewActivationCheck(types.ActivationCheckDenomMetadata, false, k.activationDenomMetadataProblem(ctx, marker)),
+		types.NewActivationCheck(types.ActivationCheckGovernance, false, k.activationGovernanceProblem(marker)),
+	}
+}
+
+// activationStatusProblem returns a description of why the marker's status prevents activation, or "" if it doesn't.
+func activationStatusProblem(marker types.MarkerAccountI) string {
+	switch marker.GetStatus() {
+	case types.StatusFinalized:
+		return ""
+	case types.StatusProposed:
+		return "marker must be finalized before it can be activated"
+	default:
+		return fmt.Sprintf("marker has status %s: can only activate markeraccounts in the Finalized status", marker.GetStatus())
+	}
+}
+
+// activationManagerProblem returns a description of why no one can activate the marker, or "" if the manager can.
+func activationManagerProblem(marker types.MarkerAccountI) string {
+	if marker.GetManager().Empty() {
+		return "marker has no manager: only the manager can finalize and activate a marker"
+	}
+	return ""
+}
+
+// activationGrantsProblem returns a description of the problems with the marker's access grants, or "" if there are none.
+func activationGrantsProblem(marker types.MarkerAccountI) string {
+	var problems []string
+	if err := types.ValidateGrantsForMarkerType(marker.GetMarkerType(), marker.GetAccessList()...); err != nil {
+		problems = append(problems, err.Error())
+	}
+	if selfGrant := types.GrantsForAddress(marker.GetAddress(), marker.GetAccessList()...).GetAccessList(); len(selfGrant) > 0 {
+		problems = append(problems, fmt.Sprintf("permissions cannot be granted to the marker account: %v", selfGrant))
+	}
+	if marker.GetSupply().IsZero() && len(marker.AddressListForPermission(types.Access_Mint)) == 0 {
+		problems = append(problems, "marker has zero total supply and no one has ACCESS_MINT")
+	}
+	if ma, ok := marker.(*types.MarkerAccount); ok {
+		if err := types.ValidateIbcDenom(*ma); err != nil {
+			problems = append(problems, err.Error())
+		}
+	}
+	return strings.Join(problems, "; ")
+}
+
+// activationSupplyProblem returns a description of why the marker's supply cannot be minted, or "" if it can.
+func (k Keeper) activationSupplyProblem(ctx sdk.Context, marker types.MarkerAccountI) string {
+	supplyRequest := marker.GetSupply()
+	preexistingCoin := sdk.NewCoin(marker.GetDenom(), k.bankKeeper.GetSupply(ctx, marker.GetDenom()).Amount)
+	if supplyRequest.IsLT(preexistingCoin) {
+		return fmt.Sprintf("marker supply %v is less than pre-existing supply %v", supplyRequest, preexistingCoin)
+	}
+	return ""
+}
+
+// activationDenomMetadataProblem returns a description of the missing denom metadata, or "" if it has been set.
+func (k Keeper) activationDenomMetadataProblem(ctx sdk.Context, marker types.MarkerAccountI) string {
+	if _, found := k.bankKeeper.GetDenomMetaData(ctx, marker.GetDenom()); !found {
+		return "marker has no denom metadata: it is not required for activation, but wallets and explorers rely on it"
+	}
+	return ""
+}
+
+// activationGovernanceProblem returns a description of the governance needed to activate the marker, or "" if none is.
+func (k Keeper) activationGovernanceProblem(marker types.MarkerAccountI) string {
+	if manager := marker.GetManager(); !manager.Empty() && manager.String() == k.GetAuthority() {
+		return "marker manager is the governance module account: it can only be finalized and activated through a governance proposal"
+	}
+	return ""
+}
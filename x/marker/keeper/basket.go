@@ -0,0 +1,165 @@
+package keeper
+
+import (
+	"fmt"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+// GetBasketInfo returns a marker's basket info, or nil if it isn't a basket marker.
+func (k Keeper) GetBasketInfo(ctx sdk.Context, markerAddr sdk.AccAddress) (*types.BasketInfo, error) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.BasketInfoKey(markerAddr))
+	if len(bz) == 0 {
+		return nil, nil
+	}
+	var info types.BasketInfo
+	if err := k.cdc.Unmarshal(bz, &info); err != nil {
+		return nil, fmt.Errorf("could not read basket info: %w", err)
+	}
+	return &info, nil
+}
+
+// SetBasketInfo stores a marker's basket info.
+func (k Keeper) SetBasketInfo(ctx sdk.Context, markerAddr sdk.AccAddress, info types.BasketInfo) error {
+	bz, err := k.cdc.Marshal(&info)
+	if err != nil {
+		return err
+	}
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.BasketInfoKey(markerAddr), bz)
+	return nil
+}
+
+// RemoveBasketInfo removes a marker's basket info.
+func (k Keeper) RemoveBasketInfo(ctx sdk.Context, markerAddr sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.BasketInfoKey(markerAddr))
+}
+
+// IterateBasketInfos iterates over the basket infos of all markers.
+func (k Keeper) IterateBasketInfos(ctx sdk.Context, handler func(info types.BasketInfo) (stop bool)) error {
+	store := ctx.KVStore(k.storeKey)
+	it := storetypes.KVStorePrefixIterator(store, types.BasketInfoPrefix)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		var info types.BasketInfo
+		if err := k.cdc.Unmarshal(it.Value(), &info); err != nil {
+			return err
+		}
+		if handler(info) {
+			break
+		}
+	}
+	return nil
+}
+
+// GetBasketBacking returns the amount of the components a basket marker must hold to back the current supply of its coin.
+// If the marker isn't a basket marker, an empty set of coins is returned.
+func (k Keeper) GetBasketBacking(ctx sdk.Context, markerAddr sdk.AccAddress) (sdk.Coins, error) {
+	info, err := k.GetBasketInfo(ctx, markerAddr)
+	if err != nil || info == nil {
+		return sdk.Coins{}, err
+	}
+	return info.GetBacking(k.bankKeeper.GetSupply(ctx, info.Denom).Amount), nil
+}
+
+// validateNotBasket returns an error if the marker is a basket marker.
+// The supply of a basket marker's coin can only be changed through deposits and withdrawals of its components.
+func (k Keeper) validateNotBasket(ctx sdk.Context, marker types.MarkerAccountI) error {
+	info, err := k.GetBasketInfo(ctx, marker.GetAddress())
+	if err != nil {
+		return err
+	}
+	if info != nil {
+		return fmt.Errorf("%s is a basket marker: its supply can only be changed by depositing or withdrawing its components", marker.GetDenom())
+	}
+	return nil
+}
+
+// UpdateBasketInfo sets the components that back a basket marker's coin.
+// The marker must be active and none of its coin can be in circulation.
+func (k Keeper) UpdateBasketInfo(ctx sdk.Context, marker types.MarkerAccountI, info types.BasketInfo) error {
+	if marker.GetStatus() != types.StatusActive {
+		return fmt.Errorf("cannot set basket info for %s marker with status %s", marker.GetDenom(), marker.GetStatus())
+	}
+	if supply := k.bankKeeper.GetSupply(ctx, marker.GetDenom()); !supply.IsZero() {
+		return fmt.Errorf("cannot set basket info for %s marker with a supply of %s", marker.GetDenom(), supply)
+	}
+	return k.SetBasketInfo(ctx, marker.GetAddress(), info)
+}
+
+// BasketDeposit moves the components needed to back the provided amount of basket coin from the depositor into the
+// basket marker's account, then mints that basket coin and gives it to the depositor. The deposited components are returned.
+func (k Keeper) BasketDeposit(ctx sdk.Context, depositor sdk.AccAddress, amount sdk.Coin) (sdk.Coins, error) {
+	marker, info, err := k.getActiveBasket(ctx, amount.Denom)
+	if err != nil {
+		return nil, err
+	}
+	components, err := info.GetComponentsFor(amount)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = k.bankKeeper.SendCoins(ctx, depositor, marker.GetAddress(), components); err != nil {
+		return nil, fmt.Errorf("could not deposit %s basket components: %w", amount.Denom, err)
+	}
+	if err = k.increaseSupply(ctx, marker, amount); err != nil {
+		return nil, err
+	}
+	if err = k.bankKeeper.SendCoins(types.WithBypass(ctx), marker.GetAddress(), depositor, sdk.NewCoins(amount)); err != nil {
+		return nil, err
+	}
+
+	return components, ctx.EventManager().EmitTypedEvent(types.NewEventBasketDeposit(amount, components, depositor.String()))
+}
+
+// BasketWithdraw takes the provided amount of basket coin from the holder and burns it, then gives the holder the
+// components that backed it. The withdrawn components are returned.
+func (k Keeper) BasketWithdraw(ctx sdk.Context, holder sdk.AccAddress, amount sdk.Coin) (sdk.Coins, error) {
+	marker, info, err := k.getActiveBasket(ctx, amount.Denom)
+	if err != nil {
+		return nil, err
+	}
+	components, err := info.GetComponentsFor(amount)
+	if err != nil {
+		return nil, err
+	}
+
+	bypassCtx := types.WithBypass(ctx)
+	if err = k.bankKeeper.SendCoins(bypassCtx, holder, marker.GetAddress(), sdk.NewCoins(amount)); err != nil {
+		return nil, fmt.Errorf("could not return %s basket coin: %w", amount.Denom, err)
+	}
+	if err = k.decreaseSupply(ctx, marker, amount); err != nil {
+		return nil, err
+	}
+	if err = k.bankKeeper.SendCoins(bypassCtx, marker.GetAddress(), holder, components); err != nil {
+		return nil, fmt.Errorf("could not withdraw %s basket components: %w", amount.Denom, err)
+	}
+
+	return components, ctx.EventManager().EmitTypedEvent(types.NewEventBasketWithdraw(amount, components, holder.String()))
+}
+
+// getActiveBasket returns the marker and basket info for the provided denom.
+// An error is returned if the marker is not an active basket marker.
+func (k Keeper) getActiveBasket(ctx sdk.Context, denom string) (types.MarkerAccountI, *types.BasketInfo, error) {
+	marker, err := k.GetMarkerByDenom(ctx, denom)
+	if err != nil {
+		return nil, nil, fmt.Errorf("marker not found for %s: %w", denom, err)
+	}
+	info, err := k.GetBasketInfo(ctx, marker.GetAddress())
+	if err != nil {
+		return nil, nil, err
+	}
+	if info == nil {
+		return nil, nil, fmt.Errorf("%s is not a basket marker", denom)
+	}
+	if marker.GetStatus() != types.StatusActive {
+		return nil, nil, fmt.Errorf("cannot use %s basket marker with status %s", denom, marker.GetStatus())
+	}
+	return marker, info, nil
+}
@@ -0,0 +1,130 @@
+package keeper
+
+import (
+	"fmt"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+// GetBridgeInfo returns a marker's bridge info, or nil if the marker doesn't have any.
+func (k Keeper) GetBridgeInfo(ctx sdk.Context, markerAddr sdk.AccAddress) (*types.BridgeInfo, error) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.BridgeInfoKey(markerAddr))
+	if len(bz) == 0 {
+		return nil, nil
+	}
+	var info types.BridgeInfo
+	if err := k.cdc.Unmarshal(bz, &info); err != nil {
+		return nil, fmt.Errorf("could not read bridge info: %w", err)
+	}
+	return &info, nil
+}
+
+// SetBridgeInfo stores a marker's bridge info.
+func (k Keeper) SetBridgeInfo(ctx sdk.Context, markerAddr sdk.AccAddress, info types.BridgeInfo) error {
+	bz, err := k.cdc.Marshal(&info)
+	if err != nil {
+		return err
+	}
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.BridgeInfoKey(markerAddr), bz)
+	return nil
+}
+
+// RemoveBridgeInfo removes a marker's bridge info. Its mint attestations are kept.
+func (k Keeper) RemoveBridgeInfo(ctx sdk.Context, markerAddr sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.BridgeInfoKey(markerAddr))
+}
+
+// IterateBridgeInfos iterates over the bridge infos of all markers.
+func (k Keeper) IterateBridgeInfos(ctx sdk.Context, handler func(info types.BridgeInfo) (stop bool)) error {
+	store := ctx.KVStore(k.storeKey)
+	it := storetypes.KVStorePrefixIterator(store, types.BridgeInfoPrefix)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		var info types.BridgeInfo
+		if err := k.cdc.Unmarshal(it.Value(), &info); err != nil {
+			return err
+		}
+		if handler(info) {
+			break
+		}
+	}
+	return nil
+}
+
+// SetMintAttestation stores a mint attestation of a marker.
+func (k Keeper) SetMintAttestation(ctx sdk.Context, attestation types.MintAttestation) error {
+	markerAddr, err := types.MarkerAddress(attestation.Denom)
+	if err != nil {
+		return err
+	}
+	bz, err := k.cdc.Marshal(&attestation)
+	if err != nil {
+		return err
+	}
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.MintAttestationKey(markerAddr, attestation.Sequence), bz)
+	return nil
+}
+
+// IterateAllMintAttestations iterates over the mint attestations of all markers.
+func (k Keeper) IterateAllMintAttestations(ctx sdk.Context, handler func(attestation types.MintAttestation) (stop bool)) error {
+	store := ctx.KVStore(k.storeKey)
+	it := storetypes.KVStorePrefixIterator(store, types.MintAttestationPrefix)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		var attestation types.MintAttestation
+		if err := k.cdc.Unmarshal(it.Value(), &attestation); err != nil {
+			return err
+		}
+		if handler(attestation) {
+			break
+		}
+	}
+	return nil
+}
+
+// getLastMintAttestationSequence gets the sequence of the newest mint attestation of a marker, or zero if it has none.
+func (k Keeper) getLastMintAttestationSequence(ctx sdk.Context, markerAddr sdk.AccAddress) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	pre := types.MintAttestationKeyPrefix(markerAddr)
+	it := storetypes.KVStoreReversePrefixIterator(store, pre)
+	defer it.Close()
+	if !it.Valid() {
+		return 0
+	}
+	return sdk.BigEndianToUint64(it.Key()[len(pre):])
+}
+
+// AttestedMint mints coin of a marker that has bridge info and records the reference to the proof of its backing.
+// The caller must have mint access on the marker. The sequence of the recorded attestation is returned.
+func (k Keeper) AttestedMint(ctx sdk.Context, caller sdk.AccAddress, coin sdk.Coin, reference string) (uint64, error) {
+	markerAddr, err := types.MarkerAddress(coin.Denom)
+	if err != nil {
+		return 0, err
+	}
+	info, err := k.GetBridgeInfo(ctx, markerAddr)
+	if err != nil {
+		return 0, err
+	}
+	if info == nil {
+		return 0, fmt.Errorf("%s marker does not have bridge info", coin.Denom)
+	}
+
+	if err = k.MintCoin(ctx, caller, coin); err != nil {
+		return 0, err
+	}
+
+	seq := k.getLastMintAttestationSequence(ctx, markerAddr) + 1
+	attestation := types.NewMintAttestation(coin.Denom, seq, ctx.BlockHeight(), coin.Amount, reference, caller.String())
+	if err = k.SetMintAttestation(ctx, attestation); err != nil {
+		return 0, fmt.Errorf("could not record %s mint attestation: %w", coin.Denom, err)
+	}
+	return seq, ctx.EventManager().EmitTypedEvent(types.NewEventMintAttested(attestation))
+}
@@ -0,0 +1,87 @@
+package keeper
+
+import (
+	"fmt"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+// IsDepositAllowListed returns true if the depositor is on the marker's deposit allow list.
+func (k Keeper) IsDepositAllowListed(ctx sdk.Context, markerAddr, depositor sdk.AccAddress) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.DepositAllowKey(markerAddr, depositor))
+}
+
+// HasDepositAllowList returns true if the marker has at least one entry in its deposit allow list.
+func (k Keeper) HasDepositAllowList(ctx sdk.Context, markerAddr sdk.AccAddress) bool {
+	store := ctx.KVStore(k.storeKey)
+	it := storetypes.KVStorePrefixIterator(store, types.DepositAllowKeyPrefix(markerAddr))
+	defer it.Close()
+	return it.Valid()
+}
+
+// AddDepositAllowed adds the depositor to the marker's deposit allow list.
+func (k Keeper) AddDepositAllowed(ctx sdk.Context, markerAddr, depositor sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.DepositAllowKey(markerAddr, depositor), []byte{})
+}
+
+// RemoveDepositAllowed removes the depositor from the marker's deposit allow list.
+func (k Keeper) RemoveDepositAllowed(ctx sdk.Context, markerAddr, depositor sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.DepositAllowKey(markerAddr, depositor))
+}
+
+// GetDepositAllowList returns the addresses on the marker's deposit allow list.
+func (k Keeper) GetDepositAllowList(ctx sdk.Context, markerAddr sdk.AccAddress) []sdk.AccAddress {
+	store := ctx.KVStore(k.storeKey)
+	it := storetypes.KVStorePrefixIterator(store, types.DepositAllowKeyPrefix(markerAddr))
+	defer it.Close()
+	list := []sdk.AccAddress{}
+	for ; it.Valid(); it.Next() {
+		_, depositor := types.GetDepositAllowAddresses(it.Key())
+		list = append(list, depositor)
+	}
+	return list
+}
+
+// IterateAllDepositAllowLists iterates over the deposit allow list entries of all markers.
+func (k Keeper) IterateAllDepositAllowLists(ctx sdk.Context, handler func(markerAddr, depositor sdk.AccAddress) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	it := storetypes.KVStorePrefixIterator(store, types.DepositAllowPrefix)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		if handler(types.GetDepositAllowAddresses(it.Key())) {
+			break
+		}
+	}
+}
+
+// ClearDepositAllowList removes all entries of a marker's deposit allow list.
+func (k Keeper) ClearDepositAllowList(ctx sdk.Context, markerAddr sdk.AccAddress) {
+	for _, depositor := range k.GetDepositAllowList(ctx, markerAddr) {
+		k.RemoveDepositAllowed(ctx, markerAddr, depositor)
+	}
+}
+
+// validateDepositAllowed returns an error if the marker has a deposit allow list and none of the
+// fromAddr and admins are on it or have deposit access on the marker.
+func (k Keeper) validateDepositAllowed(ctx sdk.Context, toMarker types.MarkerAccountI, fromAddr sdk.AccAddress, admins []sdk.AccAddress) error {
+	markerAddr := toMarker.GetAddress()
+	if !k.HasDepositAllowList(ctx, markerAddr) {
+		return nil
+	}
+	addrs := make([]sdk.AccAddress, 0, 1+len(admins))
+	addrs = append(addrs, fromAddr)
+	addrs = append(addrs, admins...)
+	for _, addr := range addrs {
+		if k.IsDepositAllowListed(ctx, markerAddr, addr) || toMarker.AddressHasAccess(addr, types.Access_Deposit) {
+			return nil
+		}
+	}
+	return fmt.Errorf("%s is not allowed to deposit funds into %s marker (%s)", fromAddr, toMarker.GetDenom(), markerAddr)
+}
@@ -0,0 +1,419 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+// GetDistribution returns the distribution with the given id, or nil if it doesn't exist.
+func (k Keeper) GetDistribution(ctx sdk.Context, id uint64) (*types.Distribution, error) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.DistributionKey(id))
+	if len(bz) == 0 {
+		return nil, nil
+	}
+	var distribution types.Distribution
+	if err := k.cdc.Unmarshal(bz, &distribution); err != nil {
+		return nil, fmt.Errorf("could not read distribution %d: %w", id, err)
+	}
+	return &distribution, nil
+}
+
+// SetDistribution stores a distribution and updates the indexes of its marker, whether it's active, and whether it's in progress.
+func (k Keeper) SetDistribution(ctx sdk.Context, distribution types.Distribution) error {
+	markerAddr, err := types.MarkerAddress(distribution.Denom)
+	if err != nil {
+		return err
+	}
+	bz, err := k.cdc.Marshal(&distribution)
+	if err != nil {
+		return err
+	}
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.DistributionKey(distribution.Id), bz)
+	store.Set(types.DistributionMarkerIndexKey(markerAddr, distribution.Id), []byte{})
+	if distribution.IsActive() {
+		store.Set(types.ActiveDistributionKey(distribution.Id), []byte{})
+	} else {
+		store.Delete(types.ActiveDistributionKey(distribution.Id))
+	}
+	if distribution.Status == types.DistributionInProgress {
+		store.Set(types.InProgressDistributionKey(markerAddr, distribution.Id), []byte{})
+	} else {
+		store.Delete(types.InProgressDistributionKey(markerAddr, distribution.Id))
+	}
+	return nil
+}
+
+// HasDistributionInProgress returns true if a distribution to the holders of a marker's coin has started paying out
+// but has not completed yet.
+func (k Keeper) HasDistributionInProgress(ctx sdk.Context, markerAddr sdk.AccAddress) bool {
+	store := ctx.KVStore(k.storeKey)
+	it := storetypes.KVStorePrefixIterator(store, types.InProgressDistributionKeyPrefix(markerAddr))
+	defer it.Close()
+	return it.Valid()
+}
+
+// IterateDistributions iterates over all distributions in order of id.
+func (k Keeper) IterateDistributions(ctx sdk.Context, handler func(distribution types.Distribution) (stop bool)) error {
+	store := ctx.KVStore(k.storeKey)
+	it := storetypes.KVStorePrefixIterator(store, types.DistributionPrefix)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		var distribution types.Distribution
+		if err := k.cdc.Unmarshal(it.Value(), &distribution); err != nil {
+			return err
+		}
+		if handler(distribution) {
+			break
+		}
+	}
+	return nil
+}
+
+// getActiveDistributionIDs returns the ids of all distributions that are pending or in progress.
+func (k Keeper) getActiveDistributionIDs(ctx sdk.Context) []uint64 {
+	store := ctx.KVStore(k.storeKey)
+	it := storetypes.KVStorePrefixIterator(store, types.ActiveDistributionPrefix)
+	defer it.Close()
+	var ids []uint64
+	for ; it.Valid(); it.Next() {
+		ids = append(ids, sdk.BigEndianToUint64(it.Key()[len(types.ActiveDistributionPrefix):]))
+	}
+	return ids
+}
+
+// getLastDistributionID gets the id of the last distribution scheduled.
+func (k Keeper) getLastDistributionID(ctx sdk.Context) uint64 {
+	return sdk.BigEndianToUint64(ctx.KVStore(k.storeKey).Get(types.LastDistributionIDKey))
+}
+
+// setLastDistributionID sets the id of the last distribution scheduled.
+func (k Keeper) setLastDistributionID(ctx sdk.Context, id uint64) {
+	ctx.KVStore(k.storeKey).Set(types.LastDistributionIDKey, sdk.Uint64ToBigEndian(id))
+}
+
+// GetDistributionClaim returns the amount from a distribution waiting to be claimed by an account.
+func (k Keeper) GetDistributionClaim(ctx sdk.Context, claimant sdk.AccAddress, distributionID uint64) sdkmath.Int {
+	store := ctx.KVStore(k.storeKey)
+	return readStoredInt(store.Get(types.DistributionClaimKey(claimant, distributionID)))
+}
+
+// setDistributionClaim sets the amount from a distribution waiting to be claimed by an account.
+// An amount of zero removes the claim.
+func (k Keeper) setDistributionClaim(ctx sdk.Context, claimant sdk.AccAddress, distributionID uint64, amount sdkmath.Int) error {
+	store := ctx.KVStore(k.storeKey)
+	key := types.DistributionClaimKey(claimant, distributionID)
+	if amount.IsZero() {
+		store.Delete(key)
+		return nil
+	}
+	bz, err := amount.Marshal()
+	if err != nil {
+		return err
+	}
+	store.Set(key, bz)
+	return nil
+}
+
+// IterateDistributionClaims iterates over the distribution claims waiting to be claimed by an account.
+func (k Keeper) IterateDistributionClaims(ctx sdk.Context, claimant sdk.AccAddress, handler func(distributionID uint64, amount sdkmath.Int) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	it := storetypes.KVStorePrefixIterator(store, types.DistributionClaimKeyPrefix(claimant))
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		_, distributionID := types.GetDistributionClaimKeyParts(it.Key())
+		if handler(distributionID, readStoredInt(it.Value())) {
+			break
+		}
+	}
+}
+
+// IterateAllDistributionClaims iterates over the distribution claims of all accounts.
+func (k Keeper) IterateAllDistributionClaims(ctx sdk.Context, handler func(claimant sdk.AccAddress, distributionID uint64, amount sdkmath.Int) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	it := storetypes.KVStorePrefixIterator(store, types.DistributionClaimPrefix)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		claimant, distributionID := types.GetDistributionClaimKeyParts(it.Key())
+		if handler(claimant, distributionID, readStoredInt(it.Value())) {
+			break
+		}
+	}
+}
+
+// ScheduleDistribution takes the amount from the administrator and schedules it to be paid out
+// to the holders of the marker's coin. Returns the id of the new distribution.
+func (k Keeper) ScheduleDistribution(
+	ctx sdk.Context,
+	marker types.MarkerAccountI,
+	amount sdk.Coin,
+	startHeight int64,
+	batchSize uint32,
+	minPayout sdkmath.Int,
+	administrator sdk.AccAddress,
+) (uint64, error) {
+	if marker.GetStatus() != types.StatusActive {
+		return 0, fmt.Errorf("cannot schedule a distribution for %s marker with status %s", marker.GetDenom(), marker.GetStatus())
+	}
+	// Payouts are sent from the marker module account, which bypasses the restricted coin checks.
+	amountMarker, err := k.GetMarkerByDenom(ctx, amount.Denom)
+	if err == nil && amountMarker.GetMarkerType() == types.MarkerType_RestrictedCoin {
+		return 0, fmt.Errorf("cannot distribute restricted coin %s", amount.Denom)
+	}
+	if startHeight == 0 {
+		startHeight = ctx.BlockHeight()
+	}
+	if startHeight < ctx.BlockHeight() {
+		return 0, fmt.Errorf("start height %d cannot be before the current block height %d", startHeight, ctx.BlockHeight())
+	}
+	if batchSize == 0 {
+		batchSize = types.DefaultDistributionBatchSize
+	}
+
+	if fee := k.GetParams(ctx).DistributionFee; !fee.IsZero() {
+		if err = k.bankKeeper.SendCoins(ctx, administrator, k.feeCollectorAddr, fee); err != nil {
+			return 0, fmt.Errorf("could not pay distribution fee %s: %w", fee, err)
+		}
+	}
+	if err = k.bankKeeper.SendCoinsFromAccountToModule(ctx, administrator, types.CoinPoolName, sdk.NewCoins(amount)); err != nil {
+		return 0, fmt.Errorf("could not fund distribution: %w", err)
+	}
+
+	id := k.getLastDistributionID(ctx) + 1
+	k.setLastDistributionID(ctx, id)
+	distribution := types.NewDistribution(id, marker.GetDenom(), amount, administrator.String(), startHeight, batchSize, minPayout)
+	if err = k.SetDistribution(ctx, distribution); err != nil {
+		return 0, err
+	}
+
+	return id, ctx.EventManager().EmitTypedEvent(types.NewEventDistributionScheduled(distribution))
+}
+
+// CancelDistribution cancels a distribution that has not started yet, refunding its funds to the account that scheduled it.
+func (k Keeper) CancelDistribution(ctx sdk.Context, distribution types.Distribution, administrator sdk.AccAddress) error {
+	if distribution.Status != types.DistributionPending {
+		return fmt.Errorf("cannot cancel distribution %d with status %s", distribution.Id, distribution.Status)
+	}
+
+	funder := sdk.MustAccAddressFromBech32(distribution.Administrator)
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.CoinPoolName, funder, sdk.NewCoins(distribution.Amount)); err != nil {
+		return fmt.Errorf("could not refund distribution %d: %w", distribution.Id, err)
+	}
+
+	distribution.Refunded = distribution.Amount.Amount
+	distribution.Status = types.DistributionCancelled
+	if err := k.SetDistribution(ctx, distribution); err != nil {
+		return err
+	}
+
+	return ctx.EventManager().EmitTypedEvent(types.NewEventDistributionCancelled(distribution, administrator.String()))
+}
+
+// ClaimDistribution sends an account the funds waiting to be claimed by them in a distribution.
+func (k Keeper) ClaimDistribution(ctx sdk.Context, distributionID uint64, claimant sdk.AccAddress) (sdk.Coin, error) {
+	distribution, err := k.GetDistribution(ctx, distributionID)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	if distribution == nil {
+		return sdk.Coin{}, fmt.Errorf("distribution %d not found", distributionID)
+	}
+
+	amount := k.GetDistributionClaim(ctx, claimant, distributionID)
+	if !amount.IsPositive() {
+		return sdk.Coin{}, fmt.Errorf("%s does not have a claim in distribution %d", claimant, distributionID)
+	}
+
+	coin := sdk.NewCoin(distribution.Amount.Denom, amount)
+	if err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.CoinPoolName, claimant, sdk.NewCoins(coin)); err != nil {
+		return sdk.Coin{}, fmt.Errorf("could not send %s to %s: %w", coin, claimant, err)
+	}
+	if err = k.setDistributionClaim(ctx, claimant, distributionID, sdkmath.ZeroInt()); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	distribution.Claimable = distribution.Claimable.Sub(amount)
+	distribution.Paid = distribution.Paid.Add(amount)
+	if err = k.SetDistribution(ctx, *distribution); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	return coin, ctx.EventManager().EmitTypedEvent(types.NewEventDistributionClaimed(distributionID, claimant.String(), coin))
+}
+
+// ProcessDistributions pays out the next batch of holders for each distribution that has reached its start height.
+// Distributions are processed in order of id until the MaxDistributionHoldersPerBlock param has been used up,
+// and the rest wait for a later block. Each batch is all-or-nothing: if one fails, none of it is kept and
+// the distribution is marked as failed.
+func (k Keeper) ProcessDistributions(ctx sdk.Context) {
+	remaining := k.GetParams(ctx).DistributionHoldersPerBlock()
+	for _, id := range k.getActiveDistributionIDs(ctx) {
+		if remaining == 0 {
+			return
+		}
+		distribution, err := k.GetDistribution(ctx, id)
+		if err != nil || distribution == nil {
+			k.Logger(ctx).Error("could not get active distribution", "id", id, "err", err)
+			continue
+		}
+		if distribution.StartHeight > ctx.BlockHeight() {
+			continue
+		}
+		limit := min(distribution.BatchSize, remaining)
+		cacheCtx, writeCache := ctx.CacheContext()
+		processed, err := k.processDistribution(cacheCtx, *distribution, limit)
+		remaining -= processed
+		if err != nil {
+			k.Logger(ctx).Error("could not process distribution", "id", id, "err", err)
+			if err = k.failDistribution(ctx, *distribution, err); err != nil {
+				k.Logger(ctx).Error("could not mark distribution as failed", "id", id, "err", err)
+			}
+			continue
+		}
+		writeCache()
+	}
+}
+
+// processDistribution pays out the next batch of (up to limit) holders of a distribution, completing it once all
+// holders are processed. Returns the number of holders looked up.
+func (k Keeper) processDistribution(ctx sdk.Context, distribution types.Distribution, limit uint32) (uint32, error) {
+	markerAddr := types.MustGetMarkerAddress(distribution.Denom)
+
+	if distribution.Status == types.DistributionPending {
+		// The coin held by the marker itself doesn't get a share.
+		totalHeld := k.bankKeeper.GetSupply(ctx, distribution.Denom).Amount.
+			Sub(k.bankKeeper.GetBalance(ctx, markerAddr, distribution.Denom).Amount).
+			Sub(k.bankKeeper.GetBalance(ctx, k.markerModuleAddr, distribution.Denom).Amount)
+		if !totalHeld.IsPositive() {
+			return 0, k.completeDistribution(ctx, distribution)
+		}
+		distribution.TotalHeld = totalHeld
+		distribution.Status = types.DistributionInProgress
+	}
+
+	resp, err := k.bankKeeper.DenomOwners(ctx, &banktypes.QueryDenomOwnersRequest{
+		Denom:      distribution.Denom,
+		Pagination: &query.PageRequest{Key: distribution.NextKey, Limit: uint64(limit)},
+	})
+	if err != nil {
+		return 0, fmt.Errorf("could not get %s holders: %w", distribution.Denom, err)
+	}
+	processed := uint32(len(resp.DenomOwners))
+
+	for _, owner := range resp.DenomOwners {
+		holder, err := sdk.AccAddressFromBech32(owner.Address)
+		if err != nil {
+			return processed, err
+		}
+		// The marker and module accounts (e.g. the marker module) don't get payouts, so their share is refunded.
+		if holder.Equals(markerAddr) || holder.Equals(k.markerModuleAddr) || k.bankKeeper.BlockedAddr(holder) {
+			continue
+		}
+		distribution.HoldersProcessed++
+
+		// Transfers of the marker's coin are blocked while a distribution is in progress, so the balances
+		// can't change. The cap on what's left is just in case.
+		share := sdkmath.MinInt(
+			distribution.Amount.Amount.Mul(owner.Balance.Amount).Quo(distribution.TotalHeld),
+			distribution.Unallocated(),
+		)
+		if !share.IsPositive() {
+			continue
+		}
+		if share.LT(distribution.MinPayout) || !k.trySendFromDistribution(ctx, holder, sdk.NewCoin(distribution.Amount.Denom, share)) {
+			if err = k.addDistributionClaim(ctx, &distribution, holder, share); err != nil {
+				return processed, err
+			}
+			continue
+		}
+		distribution.Paid = distribution.Paid.Add(share)
+	}
+
+	distribution.NextKey = nil
+	if resp.Pagination != nil {
+		distribution.NextKey = resp.Pagination.NextKey
+	}
+	if len(distribution.NextKey) == 0 {
+		return processed, k.completeDistribution(ctx, distribution)
+	}
+	return processed, k.SetDistribution(ctx, distribution)
+}
+
+// completeDistribution refunds whatever wasn't allocated to holders and marks the distribution as completed.
+func (k Keeper) completeDistribution(ctx sdk.Context, distribution types.Distribution) error {
+	remainder := distribution.Unallocated()
+	if remainder.IsPositive() {
+		funder := sdk.MustAccAddressFromBech32(distribution.Administrator)
+		if k.trySendFromDistribution(ctx, funder, sdk.NewCoin(distribution.Amount.Denom, remainder)) {
+			distribution.Refunded = distribution.Refunded.Add(remainder)
+		} else if err := k.addDistributionClaim(ctx, &distribution, funder, remainder); err != nil {
+			return err
+		}
+	}
+
+	distribution.Status = types.DistributionCompleted
+	distribution.NextKey = nil
+	if err := k.SetDistribution(ctx, distribution); err != nil {
+		return err
+	}
+
+	return ctx.EventManager().EmitTypedEvent(types.NewEventDistributionCompleted(distribution))
+}
+
+// failDistribution stops a distribution that could not be processed, refunding whatever wasn't allocated to holders
+// and marking it as failed. Nothing is changed if that fails.
+func (k Keeper) failDistribution(ctx sdk.Context, distribution types.Distribution, cause error) error {
+	cacheCtx, writeCache := ctx.CacheContext()
+	remainder := distribution.Unallocated()
+	if remainder.IsPositive() {
+		funder := sdk.MustAccAddressFromBech32(distribution.Administrator)
+		if k.trySendFromDistribution(cacheCtx, funder, sdk.NewCoin(distribution.Amount.Denom, remainder)) {
+			distribution.Refunded = distribution.Refunded.Add(remainder)
+		} else if err := k.addDistributionClaim(cacheCtx, &distribution, funder, remainder); err != nil {
+			return err
+		}
+	}
+
+	distribution.Status = types.DistributionFailed
+	distribution.NextKey = nil
+	if err := k.SetDistribution(cacheCtx, distribution); err != nil {
+		return err
+	}
+	if err := cacheCtx.EventManager().EmitTypedEvent(types.NewEventDistributionFailed(distribution, cause)); err != nil {
+		return err
+	}
+
+	writeCache()
+	return nil
+}
+
+// addDistributionClaim records an amount from a distribution that the account will need to claim.
+func (k Keeper) addDistributionClaim(ctx sdk.Context, distribution *types.Distribution, claimant sdk.AccAddress, amount sdkmath.Int) error {
+	total := k.GetDistributionClaim(ctx, claimant, distribution.Id).Add(amount)
+	if err := k.setDistributionClaim(ctx, claimant, distribution.Id, total); err != nil {
+		return err
+	}
+	distribution.Claimable = distribution.Claimable.Add(amount)
+	return nil
+}
+
+// trySendFromDistribution sends coin held for distributions to an account, returning false if it couldn't be sent.
+// Nothing is changed if the send fails.
+func (k Keeper) trySendFromDistribution(ctx sdk.Context, to sdk.AccAddress, coin sdk.Coin) bool {
+	cacheCtx, writeCache := ctx.CacheContext()
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(cacheCtx, types.CoinPoolName, to, sdk.NewCoins(coin)); err != nil {
+		k.Logger(ctx).Debug("could not send distribution funds", "to", to.String(), "amount", coin.String(), "err", err)
+		return false
+	}
+	writeCache()
+	return true
+}
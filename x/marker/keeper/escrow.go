@@ -0,0 +1,323 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+// GetEscrowLedger returns one of a marker's escrow ledgers, or nil if the marker doesn't have an escrow ledger with that name.
+func (k Keeper) GetEscrowLedger(ctx sdk.Context, markerAddr sdk.AccAddress, name string) (*types.EscrowLedger, error) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.EscrowLedgerKey(markerAddr, name))
+	if len(bz) == 0 {
+		return nil, nil
+	}
+	var ledger types.EscrowLedger
+	if err := k.cdc.Unmarshal(bz, &ledger); err != nil {
+		return nil, fmt.Errorf("could not read escrow ledger %q: %w", name, err)
+	}
+	return &ledger, nil
+}
+
+// SetEscrowLedger stores one of a marker's escrow ledgers. An escrow ledger with an empty balance is removed.
+func (k Keeper) SetEscrowLedger(ctx sdk.Context, markerAddr sdk.AccAddress, ledger types.EscrowLedger) error {
+	store := ctx.KVStore(k.storeKey)
+	key := types.EscrowLedgerKey(markerAddr, ledger.Name)
+	if ledger.Balance.IsZero() {
+		store.Delete(key)
+		return nil
+	}
+	bz, err := k.cdc.Marshal(&ledger)
+	if err != nil {
+		return err
+	}
+	store.Set(key, bz)
+	return nil
+}
+
+// IterateEscrowLedgers iterates over the escrow ledgers of a marker.
+func (k Keeper) IterateEscrowLedgers(ctx sdk.Context, markerAddr sdk.AccAddress, handler func(ledger types.EscrowLedger) (stop bool)) error {
+	return k.iterateEscrowLedgers(ctx, types.EscrowLedgerKeyPrefix(markerAddr), handler)
+}
+
+// IterateAllEscrowLedgers iterates over the escrow ledgers of all markers.
+func (k Keeper) IterateAllEscrowLedgers(ctx sdk.Context, handler func(ledger types.EscrowLedger) (stop bool)) error {
+	return k.iterateEscrowLedgers(ctx, types.EscrowLedgerPrefix, handler)
+}
+
+// iterateEscrowLedgers iterates over the escrow ledgers with keys that start with the provided prefix.
+func (k Keeper) iterateEscrowLedgers(ctx sdk.Context, prefix []byte, handler func(ledger types.EscrowLedger) (stop bool)) error {
+	store := ctx.KVStore(k.storeKey)
+	it := storetypes.KVStorePrefixIterator(store, prefix)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		var ledger types.EscrowLedger
+		if err := k.cdc.Unmarshal(it.Value(), &ledger); err != nil {
+			return err
+		}
+		if handler(ledger) {
+			break
+		}
+	}
+	return nil
+}
+
+// GetEarmarkedEscrow returns the total of a marker's escrowed funds that are earmarked in its escrow ledgers,
+// reserved for its scheduled burns, or backing its basket coin.
+func (k Keeper) GetEarmarkedEscrow(ctx sdk.Context, markerAddr sdk.AccAddress) (sdk.Coins, error) {
+	earmarked := sdk.Coins{}
+	err := k.IterateEscrowLedgers(ctx, markerAddr, func(ledger types.EscrowLedger) bool {
+		earmarked = earmarked.Add(ledger.Balance...)
+		return false
+	})
+	if err != nil {
+		return nil, err
+	}
+	reserved, err := k.GetReservedForBurns(ctx, markerAddr)
+	if err != nil {
+		return nil, err
+	}
+	backing, err := k.GetBasketBacking(ctx, markerAddr)
+	if err != nil {
+		return nil, err
+	}
+	return earmarked.Add(reserved...).Add(backing...), nil
+}
+
+// GetUnearmarkedEscrow returns the funds held by a marker that are not earmarked in any of its escrow ledgers,
+// reserved for its scheduled burns, or backing its basket coin.
+func (k Keeper) GetUnearmarkedEscrow(ctx sdk.Context, markerAddr sdk.AccAddress) (sdk.Coins, error) {
+	earmarked, err := k.GetEarmarkedEscrow(ctx, markerAddr)
+	if err != nil {
+		return nil, err
+	}
+	rv := sdk.Coins{}
+	for _, coin := range k.bankKeeper.GetAllBalances(ctx, markerAddr) {
+		// The balance can be less than the earmarked amount if funds were taken out by governance.
+		if amount := coin.Amount.Sub(earmarked.AmountOf(coin.Denom)); amount.IsPositive() {
+			rv = rv.Add(sdk.NewCoin(coin.Denom, amount))
+		}
+	}
+	return rv, nil
+}
+
+// validateNotEarmarked returns an error if taking the provided funds out of a marker would use funds that are
+// earmarked in its escrow ledgers, reserved for its scheduled burns, or backing its basket coin.
+// Denoms without earmarked funds are not checked.
+func (k Keeper) validateNotEarmarked(ctx sdk.Context, marker types.MarkerAccountI, coins sdk.Coins) error {
+	earmarked, err := k.GetEarmarkedEscrow(ctx, marker.GetAddress())
+	if err != nil {
+		return err
+	}
+	for _, coin := range coins {
+		earmarkedAmt := earmarked.AmountOf(coin.Denom)
+		if earmarkedAmt.IsZero() {
+			continue
+		}
+		available := k.bankKeeper.GetBalance(ctx, marker.GetAddress(), coin.Denom).Amount.Sub(earmarkedAmt)
+		if available.LT(coin.Amount) {
+			if available.IsNegative() {
+				available = sdkmath.ZeroInt()
+			}
+			return fmt.Errorf("cannot use %s from %s marker escrow: only %s%s is not earmarked in escrow ledgers, reserved for scheduled burns, or backing basket coin",
+				coin, marker.GetDenom(), available, coin.Denom)
+		}
+	}
+	return nil
+}
+
+// GetEscrowWithdrawLimit returns the amount that the grantee is still allowed to withdraw from one of a marker's escrow ledgers.
+func (k Keeper) GetEscrowWithdrawLimit(ctx sdk.Context, markerAddr sdk.AccAddress, ledger string, grantee sdk.AccAddress) (sdk.Coins, error) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.EscrowWithdrawLimitKey(markerAddr, ledger, grantee))
+	if len(bz) == 0 {
+		return sdk.Coins{}, nil
+	}
+	var limit types.EscrowWithdrawLimit
+	if err := k.cdc.Unmarshal(bz, &limit); err != nil {
+		return nil, fmt.Errorf("could not read escrow ledger %q withdraw limit for %s: %w", ledger, grantee, err)
+	}
+	return limit.Remaining, nil
+}
+
+// SetEscrowWithdrawLimit sets the amount that the grantee is allowed to withdraw from one of a marker's escrow ledgers.
+// An empty limit removes it.
+func (k Keeper) SetEscrowWithdrawLimit(ctx sdk.Context, markerAddr sdk.AccAddress, limit types.EscrowWithdrawLimit) error {
+	grantee, err := sdk.AccAddressFromBech32(limit.Grantee)
+	if err != nil {
+		return fmt.Errorf("invalid grantee: %w", err)
+	}
+	store := ctx.KVStore(k.storeKey)
+	key := types.EscrowWithdrawLimitKey(markerAddr, limit.Ledger, grantee)
+	if limit.Remaining.IsZero() {
+		store.Delete(key)
+		return nil
+	}
+	bz, err := k.cdc.Marshal(&limit)
+	if err != nil {
+		return err
+	}
+	store.Set(key, bz)
+	return nil
+}
+
+// IterateEscrowWithdrawLimits iterates over the withdraw limits of one of a marker's escrow ledgers.
+func (k Keeper) IterateEscrowWithdrawLimits(ctx sdk.Context, markerAddr sdk.AccAddress, ledger string, handler func(limit types.EscrowWithdrawLimit) (stop bool)) error {
+	return k.iterateEscrowWithdrawLimits(ctx, types.EscrowWithdrawLimitKeyPrefix(markerAddr, ledger), handler)
+}
+
+// IterateAllEscrowWithdrawLimits iterates over the escrow ledger withdraw limits of all markers.
+func (k Keeper) IterateAllEscrowWithdrawLimits(ctx sdk.Context, handler func(limit types.EscrowWithdrawLimit) (stop bool)) error {
+	return k.iterateEscrowWithdrawLimits(ctx, types.EscrowWithdrawLimitPrefix, handler)
+}
+
+// iterateEscrowWithdrawLimits iterates over the escrow ledger withdraw limits with keys that start with the provided prefix.
+func (k Keeper) iterateEscrowWithdrawLimits(ctx sdk.Context, prefix []byte, handler func(limit types.EscrowWithdrawLimit) (stop bool)) error {
+	store := ctx.KVStore(k.storeKey)
+	it := storetypes.KVStorePrefixIterator(store, prefix)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		var limit types.EscrowWithdrawLimit
+		if err := k.cdc.Unmarshal(it.Value(), &limit); err != nil {
+			return err
+		}
+		if handler(limit) {
+			break
+		}
+	}
+	return nil
+}
+
+// RemoveEscrowLedgers removes all escrow ledgers and escrow ledger withdraw limits for a marker.
+func (k Keeper) RemoveEscrowLedgers(ctx sdk.Context, markerAddr sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	var keys [][]byte
+	for _, prefix := range [][]byte{types.EscrowLedgerKeyPrefix(markerAddr), types.EscrowWithdrawLimitMarkerKeyPrefix(markerAddr)} {
+		it := storetypes.KVStorePrefixIterator(store, prefix)
+		for ; it.Valid(); it.Next() {
+			keys = append(keys, it.Key())
+		}
+		it.Close()
+	}
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// AllocateEscrow earmarks some of a marker's escrowed funds in one of its escrow ledgers.
+// The funds must be held by the marker and not already be earmarked.
+func (k Keeper) AllocateEscrow(ctx sdk.Context, marker types.MarkerAccountI, name string, amount sdk.Coins, admin sdk.AccAddress) error {
+	available, err := k.GetUnearmarkedEscrow(ctx, marker.GetAddress())
+	if err != nil {
+		return err
+	}
+	if !available.IsAllGTE(amount) {
+		return fmt.Errorf("cannot earmark %s in %s escrow ledger %q: only %q is not already earmarked",
+			amount, marker.GetDenom(), name, available)
+	}
+
+	ledger, err := k.GetEscrowLedger(ctx, marker.GetAddress(), name)
+	if err != nil {
+		return err
+	}
+	if ledger == nil {
+		ledger = &types.EscrowLedger{Denom: marker.GetDenom(), Name: name}
+	}
+	ledger.Balance = ledger.Balance.Add(amount...)
+	if err = k.SetEscrowLedger(ctx, marker.GetAddress(), *ledger); err != nil {
+		return err
+	}
+
+	return ctx.EventManager().EmitTypedEvent(types.NewEventEscrowAllocated(marker.GetDenom(), name, amount, admin.String()))
+}
+
+// ReleaseEscrow returns funds earmarked in one of a marker's escrow ledgers to the marker's unearmarked escrow.
+func (k Keeper) ReleaseEscrow(ctx sdk.Context, marker types.MarkerAccountI, name string, amount sdk.Coins, admin sdk.AccAddress) error {
+	ledger, err := k.GetEscrowLedger(ctx, marker.GetAddress(), name)
+	if err != nil {
+		return err
+	}
+	if ledger == nil {
+		return fmt.Errorf("%s marker does not have an escrow ledger named %q", marker.GetDenom(), name)
+	}
+	balance, hasNeg := ledger.Balance.SafeSub(amount...)
+	if hasNeg {
+		return fmt.Errorf("cannot release %s from %s escrow ledger %q: balance is %q", amount, marker.GetDenom(), name, ledger.Balance)
+	}
+	ledger.Balance = balance
+	if err = k.SetEscrowLedger(ctx, marker.GetAddress(), *ledger); err != nil {
+		return err
+	}
+
+	return ctx.EventManager().EmitTypedEvent(types.NewEventEscrowReleased(marker.GetDenom(), name, amount, admin.String()))
+}
+
+// WithdrawFromEscrow sends funds earmarked in one of a marker's escrow ledgers to the recipient.
+// The caller must have withdraw access on the marker and a withdraw limit for the escrow ledger of at least the amount.
+// Returns the amount the caller is still allowed to withdraw from the escrow ledger.
+func (k Keeper) WithdrawFromEscrow(
+	ctx sdk.Context, caller sdk.AccAddress, recipient sdk.AccAddress, denom string, name string, amount sdk.Coins,
+) (sdk.Coins, error) {
+	m, err := k.GetMarkerByDenom(ctx, denom)
+	if err != nil {
+		return nil, fmt.Errorf("marker not found for %s: %w", denom, err)
+	}
+	if err = m.ValidateAddressHasAccess(caller, types.Access_Withdraw); err != nil {
+		return nil, err
+	}
+	if m.GetStatus() != types.StatusActive {
+		return nil, fmt.Errorf("cannot withdraw from the escrow ledgers of a marker that is not in Active status")
+	}
+
+	limit, err := k.GetEscrowWithdrawLimit(ctx, m.GetAddress(), name, caller)
+	if err != nil {
+		return nil, err
+	}
+	remaining, hasNeg := limit.SafeSub(amount...)
+	if hasNeg {
+		return nil, fmt.Errorf("%s cannot withdraw %s from %s escrow ledger %q: withdraw limit is %q", caller, amount, denom, name, limit)
+	}
+
+	ledger, err := k.GetEscrowLedger(ctx, m.GetAddress(), name)
+	if err != nil {
+		return nil, err
+	}
+	if ledger == nil {
+		return nil, fmt.Errorf("%s marker does not have an escrow ledger named %q", denom, name)
+	}
+	balance, hasNeg := ledger.Balance.SafeSub(amount...)
+	if hasNeg {
+		return nil, fmt.Errorf("cannot withdraw %s from %s escrow ledger %q: balance is %q", amount, denom, name, ledger.Balance)
+	}
+
+	if recipient.Empty() {
+		recipient = caller
+	}
+	if err = k.validateSendToMarker(ctx, recipient, caller); err != nil {
+		return nil, err
+	}
+	if k.bankKeeper.BlockedAddr(recipient) {
+		return nil, fmt.Errorf("%s is not allowed to receive funds", recipient)
+	}
+
+	ledger.Balance = balance
+	if err = k.SetEscrowLedger(ctx, m.GetAddress(), *ledger); err != nil {
+		return nil, err
+	}
+	if err = k.SetEscrowWithdrawLimit(ctx, m.GetAddress(), types.NewEscrowWithdrawLimit(denom, name, caller, remaining)); err != nil {
+		return nil, err
+	}
+
+	if err = k.bankKeeper.SendCoins(types.WithBypass(ctx), m.GetAddress(), recipient, amount); err != nil {
+		return nil, err
+	}
+
+	return remaining, ctx.EventManager().EmitTypedEvent(types.NewEventEscrowWithdraw(denom, name, amount, recipient.String(), caller.String(), remaining))
+}
@@ -0,0 +1,30 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+// Exchange atomically swaps marker coins between two accounts: amountA is sent from partyA to partyB,
+// and amountB is sent from partyB to partyA. Both denoms must have a marker.
+// The sends are normal bank sends, so all send restrictions (e.g. required attributes) apply to both of them.
+// If either send fails, an error is returned and the caller is expected to not commit any state changes.
+func (k Keeper) Exchange(ctx sdk.Context, partyA sdk.AccAddress, amountA sdk.Coin, partyB sdk.AccAddress, amountB sdk.Coin) error {
+	for _, denom := range []string{amountA.Denom, amountB.Denom} {
+		if _, err := k.GetMarkerByDenom(ctx, denom); err != nil {
+			return fmt.Errorf("could not get %s marker: %w", denom, err)
+		}
+	}
+
+	if err := k.bankKeeper.SendCoins(ctx, partyA, partyB, sdk.NewCoins(amountA)); err != nil {
+		return fmt.Errorf("could not send %s from %s to %s: %w", amountA, partyA, partyB, err)
+	}
+	if err := k.bankKeeper.SendCoins(ctx, partyB, partyA, sdk.NewCoins(amountB)); err != nil {
+		return fmt.Errorf("could not send %s from %s to %s: %w", amountB, partyB, partyA, err)
+	}
+
+	return ctx.EventManager().EmitTypedEvent(types.NewEventMarkerExchange(partyA, amountA, partyB, amountB))
+}
@@ -20,11 +20,6 @@ func (k Keeper) GetMarkerModuleAddr() sdk.AccAddress {
 	return k.markerModuleAddr
 }
 
-// GetIbcTransferModuleAddr is a TEST ONLY exposure of the ibcTransferModuleAddr value.
-func (k Keeper) GetIbcTransferModuleAddr() sdk.AccAddress {
-	return k.ibcTransferModuleAddr
-}
-
 // GetFeeCollectorAddr is a TEST ONLY exposure of the feeCollectorAddr value.
 func (k Keeper) GetFeeCollectorAddr() sdk.AccAddress {
 	return k.feeCollectorAddr
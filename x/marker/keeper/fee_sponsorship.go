@@ -0,0 +1,228 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/feegrant"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+// GetFeeSponsorship returns a marker's fee sponsorship, or nil if the marker doesn't have one.
+func (k Keeper) GetFeeSponsorship(ctx sdk.Context, markerAddr sdk.AccAddress) (*types.FeeSponsorship, error) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.FeeSponsorshipKey(markerAddr))
+	if len(bz) == 0 {
+		return nil, nil
+	}
+	var sponsorship types.FeeSponsorship
+	if err := k.cdc.Unmarshal(bz, &sponsorship); err != nil {
+		return nil, fmt.Errorf("could not read fee sponsorship: %w", err)
+	}
+	return &sponsorship, nil
+}
+
+// SetFeeSponsorship stores a marker's fee sponsorship. Holders that have already claimed a fee grant
+// from a previous version of the sponsorship cannot claim another one.
+func (k Keeper) SetFeeSponsorship(ctx sdk.Context, markerAddr sdk.AccAddress, sponsorship types.FeeSponsorship) error {
+	bz, err := k.cdc.Marshal(&sponsorship)
+	if err != nil {
+		return err
+	}
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.FeeSponsorshipKey(markerAddr), bz)
+	return nil
+}
+
+// RemoveFeeSponsorship removes a marker's fee sponsorship and the record of which holders have claimed fee grants from it,
+// releasing the funds that are still on hold for those claims. Fee grants that have already been claimed are left in place.
+func (k Keeper) RemoveFeeSponsorship(ctx sdk.Context, markerAddr sdk.AccAddress) error {
+	sponsorship, err := k.GetFeeSponsorship(ctx, markerAddr)
+	if err != nil {
+		return err
+	}
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.FeeSponsorshipKey(markerAddr))
+	for _, claim := range k.getFeeSponsorshipClaims(ctx, markerAddr) {
+		if sponsorship != nil && claim.held.IsPositive() {
+			if err = k.releaseFeeSponsorshipHold(ctx, sponsorship.Denom, claim.holder, claim.held); err != nil {
+				return err
+			}
+		}
+		store.Delete(types.FeeSponsorshipClaimKey(markerAddr, claim.holder))
+	}
+	return nil
+}
+
+// IterateFeeSponsorships iterates over the fee sponsorships of all markers.
+func (k Keeper) IterateFeeSponsorships(ctx sdk.Context, handler func(sponsorship types.FeeSponsorship) (stop bool)) error {
+	store := ctx.KVStore(k.storeKey)
+	it := storetypes.KVStorePrefixIterator(store, types.FeeSponsorshipPrefix)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		var sponsorship types.FeeSponsorship
+		if err := k.cdc.Unmarshal(it.Value(), &sponsorship); err != nil {
+			return err
+		}
+		if handler(sponsorship) {
+			break
+		}
+	}
+	return nil
+}
+
+// HasClaimedFeeSponsorship returns true if the holder has claimed a fee grant from the marker's fee sponsorship.
+func (k Keeper) HasClaimedFeeSponsorship(ctx sdk.Context, markerAddr, holder sdk.AccAddress) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.FeeSponsorshipClaimKey(markerAddr, holder))
+}
+
+// SetFeeSponsorshipClaimed records that the holder has claimed a fee grant from the marker's fee sponsorship,
+// and the amount of the marker's coin that is on hold for the claim.
+func (k Keeper) SetFeeSponsorshipClaimed(ctx sdk.Context, markerAddr, holder sdk.AccAddress, held sdkmath.Int) error {
+	bz := []byte{}
+	if !held.IsNil() && !held.IsZero() {
+		var err error
+		if bz, err = held.Marshal(); err != nil {
+			return err
+		}
+	}
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.FeeSponsorshipClaimKey(markerAddr, holder), bz)
+	return nil
+}
+
+// GetFeeSponsorshipHeld returns the amount of the marker's coin that is on hold for the holder's fee sponsorship claim.
+func (k Keeper) GetFeeSponsorshipHeld(ctx sdk.Context, markerAddr, holder sdk.AccAddress) sdkmath.Int {
+	store := ctx.KVStore(k.storeKey)
+	return readStoredInt(store.Get(types.FeeSponsorshipClaimKey(markerAddr, holder)))
+}
+
+// feeSponsorshipClaim is a holder that has claimed a fee grant from a marker's fee sponsorship,
+// and the amount of the marker's coin that is on hold for it.
+type feeSponsorshipClaim struct {
+	holder sdk.AccAddress
+	held   sdkmath.Int
+}
+
+// getFeeSponsorshipClaims returns the holders that have claimed fee grants from the marker's fee sponsorship.
+func (k Keeper) getFeeSponsorshipClaims(ctx sdk.Context, markerAddr sdk.AccAddress) []feeSponsorshipClaim {
+	store := ctx.KVStore(k.storeKey)
+	it := storetypes.KVStorePrefixIterator(store, types.FeeSponsorshipClaimKeyPrefix(markerAddr))
+	defer it.Close()
+	var claims []feeSponsorshipClaim
+	for ; it.Valid(); it.Next() {
+		_, holder := types.GetFeeSponsorshipClaimAddresses(it.Key())
+		claims = append(claims, feeSponsorshipClaim{holder: holder, held: readStoredInt(it.Value())})
+	}
+	return claims
+}
+
+// IterateAllFeeSponsorshipClaims iterates over the fee sponsorship claims of all markers.
+func (k Keeper) IterateAllFeeSponsorshipClaims(ctx sdk.Context, handler func(markerAddr, holder sdk.AccAddress, held sdkmath.Int) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	it := storetypes.KVStorePrefixIterator(store, types.FeeSponsorshipClaimPrefix)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		markerAddr, holder := types.GetFeeSponsorshipClaimAddresses(it.Key())
+		if handler(markerAddr, holder, readStoredInt(it.Value())) {
+			break
+		}
+	}
+}
+
+// GrantSponsoredAllowance grants the holder a fee allowance from the marker's account as defined by the marker's fee sponsorship.
+// The marker must be active, and the holder must meet the sponsorship's criteria and not have claimed a grant from it before.
+// The sponsorship's min balance is put on hold so that it can't be moved to another account to claim another grant.
+func (k Keeper) GrantSponsoredAllowance(ctx sdk.Context, marker types.MarkerAccountI, holder sdk.AccAddress) error {
+	denom := marker.GetDenom()
+	if marker.GetStatus() != types.StatusActive {
+		return fmt.Errorf("cannot claim fee sponsorship from %s marker with status %s", denom, marker.GetStatus())
+	}
+	markerAddr := marker.GetAddress()
+	sponsorship, err := k.GetFeeSponsorship(ctx, markerAddr)
+	if err != nil {
+		return err
+	}
+	if sponsorship == nil {
+		return fmt.Errorf("%s marker does not have a fee sponsorship", denom)
+	}
+	if k.HasClaimedFeeSponsorship(ctx, markerAddr, holder) {
+		return fmt.Errorf("%s has already claimed a fee grant from the %s marker", holder, denom)
+	}
+
+	if sponsorship.MinBalance.IsPositive() {
+		balance := k.bankKeeper.GetBalance(ctx, holder, denom)
+		if balance.Amount.LT(sponsorship.MinBalance) {
+			return fmt.Errorf("%s balance %s is less than the %s fee sponsorship min balance %s%s",
+				holder, balance, denom, sponsorship.MinBalance, denom)
+		}
+	}
+	if len(sponsorship.RequiredAttributes) > 0 {
+		if err = k.validateHasRequiredAttributes(ctx, holder, denom, sponsorship.RequiredAttributes, "fee sponsorship "); err != nil {
+			return err
+		}
+	}
+
+	held := sdkmath.ZeroInt()
+	if sponsorship.MinBalance.IsPositive() {
+		held = sponsorship.MinBalance
+		if err = k.holdKeeper.AddHold(ctx, holder, sdk.NewCoins(sdk.NewCoin(denom, held)), fmt.Sprintf("x/marker: %s fee sponsorship", denom)); err != nil {
+			return err
+		}
+	}
+
+	expiration := sponsorship.GetGrantExpiration(ctx.BlockTime())
+	allowance := &feegrant.BasicAllowance{
+		SpendLimit: sponsorship.SpendLimit,
+		Expiration: expiration,
+	}
+	if err = k.feegrantKeeper.GrantAllowance(ctx, markerAddr, holder, allowance); err != nil {
+		return err
+	}
+	if err = k.SetFeeSponsorshipClaimed(ctx, markerAddr, holder, held); err != nil {
+		return err
+	}
+
+	return ctx.EventManager().EmitTypedEvent(types.NewEventFeeSponsorshipClaimed(denom, holder.String(), sponsorship.SpendLimit, expiration, held))
+}
+
+// ReleaseSponsoredAllowanceHold releases the funds put on hold when the holder claimed a fee grant from the marker's
+// fee sponsorship. The fee grant must have been used up or have expired.
+func (k Keeper) ReleaseSponsoredAllowanceHold(ctx sdk.Context, markerAddr sdk.AccAddress, denom string, holder sdk.AccAddress) error {
+	held := k.GetFeeSponsorshipHeld(ctx, markerAddr, holder)
+	if !held.IsPositive() {
+		return fmt.Errorf("%s does not have funds on hold for a fee grant from the %s marker", holder, denom)
+	}
+	if k.hasUnexpiredAllowance(ctx, markerAddr, holder) {
+		return fmt.Errorf("%s fee grant from the %s marker has not been used up or expired", holder, denom)
+	}
+	if err := k.releaseFeeSponsorshipHold(ctx, denom, holder, held); err != nil {
+		return err
+	}
+	// The claim is kept (without anything held) so that the holder can't claim another grant.
+	return k.SetFeeSponsorshipClaimed(ctx, markerAddr, holder, sdkmath.ZeroInt())
+}
+
+// hasUnexpiredAllowance returns true if the holder still has a fee allowance from the marker that hasn't expired.
+// Fee allowances are removed once they've been used up.
+func (k Keeper) hasUnexpiredAllowance(ctx sdk.Context, markerAddr, holder sdk.AccAddress) bool {
+	allowance, err := k.feegrantKeeper.GetAllowance(ctx, markerAddr, holder)
+	if err != nil || allowance == nil {
+		return false
+	}
+	expiration, err := allowance.ExpiresAt()
+	return err != nil || expiration == nil || expiration.After(ctx.BlockTime())
+}
+
+// releaseFeeSponsorshipHold releases the funds on hold for a holder's fee sponsorship claim.
+func (k Keeper) releaseFeeSponsorshipHold(ctx sdk.Context, denom string, holder sdk.AccAddress, held sdkmath.Int) error {
+	if err := k.holdKeeper.ReleaseHold(ctx, holder, sdk.NewCoins(sdk.NewCoin(denom, held))); err != nil {
+		return fmt.Errorf("could not release %s%s held for %s fee sponsorship claim: %w", held, denom, holder, err)
+	}
+	return ctx.EventManager().EmitTypedEvent(types.NewEventFeeSponsorshipHoldReleased(denom, holder.String(), held))
+}
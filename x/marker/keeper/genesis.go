@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	sdkmath "cosmossdk.io/math"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
@@ -49,6 +51,41 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *types.GenesisState) {
 		denyAddress := sdk.MustAccAddressFromBech32(denyAddress.DenyAddress)
 		k.AddSendDeny(ctx, markerAddr, denyAddress)
 	}
+	for _, allow := range data.DepositAllowAddresses {
+		markerAddr := sdk.MustAccAddressFromBech32(allow.MarkerAddress)
+		depositor := sdk.MustAccAddressFromBech32(allow.AllowAddress)
+		k.AddDepositAllowed(ctx, markerAddr, depositor)
+	}
+	for _, sponsorship := range data.FeeSponsorships {
+		if err := k.SetFeeSponsorship(ctx, types.MustGetMarkerAddress(sponsorship.Denom), sponsorship); err != nil {
+			panic(err)
+		}
+	}
+	for _, claim := range data.FeeSponsorshipClaims {
+		if err := k.SetFeeSponsorshipClaimed(ctx, types.MustGetMarkerAddress(claim.Denom), sdk.MustAccAddressFromBech32(claim.Holder), claim.Held); err != nil {
+			panic(err)
+		}
+	}
+	for _, info := range data.BridgeInfos {
+		if err := k.SetBridgeInfo(ctx, types.MustGetMarkerAddress(info.Denom), info); err != nil {
+			panic(err)
+		}
+	}
+	for _, attestation := range data.MintAttestations {
+		if err := k.SetMintAttestation(ctx, attestation); err != nil {
+			panic(err)
+		}
+	}
+	for _, info := range data.BasketInfos {
+		if err := k.SetBasketInfo(ctx, types.MustGetMarkerAddress(info.Denom), info); err != nil {
+			panic(err)
+		}
+	}
+	for _, config := range data.IbcMemoActionConfigs {
+		if err := k.SetIBCMemoActionConfig(ctx, types.MustGetMarkerAddress(config.Denom), config); err != nil {
+			panic(err)
+		}
+	}
 	for _, mNavs := range data.NetAssetValues {
 		for _, nav := range mNavs.NetAssetValues {
 			navCopy := nav
@@ -60,6 +97,55 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *types.GenesisState) {
 			store.Set(types.NetAssetValueKey(address, navCopy.Price.Denom), bz)
 		}
 	}
+	for _, allowance := range data.MintAllowances {
+		markerAddr := types.MustGetMarkerAddress(allowance.Denom)
+		minter := sdk.MustAccAddressFromBech32(allowance.Minter)
+		if err := k.SetMintAllowance(ctx, markerAddr, minter, allowance.Remaining); err != nil {
+			panic(err)
+		}
+	}
+	for _, distribution := range data.Distributions {
+		if err := k.SetDistribution(ctx, distribution); err != nil {
+			panic(err)
+		}
+		if distribution.Id > k.getLastDistributionID(ctx) {
+			k.setLastDistributionID(ctx, distribution.Id)
+		}
+	}
+	for _, claim := range data.DistributionClaims {
+		claimant := sdk.MustAccAddressFromBech32(claim.Claimant)
+		if err := k.setDistributionClaim(ctx, claimant, claim.DistributionId, claim.Amount.Amount); err != nil {
+			panic(err)
+		}
+	}
+	for _, ledger := range data.EscrowLedgers {
+		if err := k.SetEscrowLedger(ctx, types.MustGetMarkerAddress(ledger.Denom), ledger); err != nil {
+			panic(err)
+		}
+	}
+	for _, limit := range data.EscrowWithdrawLimits {
+		if err := k.SetEscrowWithdrawLimit(ctx, types.MustGetMarkerAddress(limit.Denom), limit); err != nil {
+			panic(err)
+		}
+	}
+	for _, burn := range data.ScheduledBurns {
+		if err := k.SetScheduledBurn(ctx, burn); err != nil {
+			panic(err)
+		}
+		if burn.Id > k.getLastScheduledBurnID(ctx) {
+			k.setLastScheduledBurnID(ctx, burn.Id)
+		}
+	}
+	for _, bypass := range data.SendRestrictionBypasses {
+		if err := k.SetSendRestrictionBypass(ctx, bypass); err != nil {
+			panic(err)
+		}
+	}
+	for _, change := range data.SupplyHistory {
+		if err := k.SetSupplyChange(ctx, change); err != nil {
+			panic(err)
+		}
+	}
 }
 
 // ExportGenesis exports the current keeper state of the marker module.ExportGenesis
@@ -75,16 +161,17 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) (data *types.GenesisState) {
 				AccountNumber: marker.GetAccountNumber(),
 				Sequence:      0,
 			},
-			Manager:                marker.GetManager().String(),
-			AccessControl:          marker.GetAccessList(),
-			Status:                 marker.GetStatus(),
-			Denom:                  marker.GetDenom(),
-			Supply:                 marker.GetSupply().Amount,
-			MarkerType:             marker.GetMarkerType(),
-			SupplyFixed:            marker.HasFixedSupply(),
-			AllowGovernanceControl: marker.HasGovernanceEnabled(),
-			AllowForcedTransfer:    marker.AllowsForcedTransfer(),
-			RequiredAttributes:     marker.GetRequiredAttributes(),
+			Manager:                  marker.GetManager().String(),
+			AccessControl:            marker.GetAccessList(),
+			Status:                   marker.GetStatus(),
+			Denom:                    marker.GetDenom(),
+			Supply:                   marker.GetSupply().Amount,
+			MarkerType:               marker.GetMarkerType(),
+			SupplyFixed:              marker.HasFixedSupply(),
+			AllowGovernanceControl:   marker.HasGovernanceEnabled(),
+			AllowForcedTransfer:      marker.AllowsForcedTransfer(),
+			RequiredAttributes:       marker.GetRequiredAttributes(),
+			RequiredSenderAttributes: marker.GetRequiredSenderAttributes(),
 		})
 		return false
 	}
@@ -98,6 +185,62 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) (data *types.GenesisState) {
 	}
 	k.IterateSendDeny(ctx, handleDenyList)
 
+	var depositAllowAddresses []types.DepositAllowAddress
+	k.IterateAllDepositAllowLists(ctx, func(markerAddr, depositor sdk.AccAddress) bool {
+		depositAllowAddresses = append(depositAllowAddresses, types.DepositAllowAddress{MarkerAddress: markerAddr.String(), AllowAddress: depositor.String()})
+		return false
+	})
+
+	var feeSponsorships []types.FeeSponsorship
+	if err := k.IterateFeeSponsorships(ctx, func(sponsorship types.FeeSponsorship) bool {
+		feeSponsorships = append(feeSponsorships, sponsorship)
+		return false
+	}); err != nil {
+		panic(err)
+	}
+
+	var feeSponsorshipClaims []types.FeeSponsorshipClaim
+	k.IterateAllFeeSponsorshipClaims(ctx, func(markerAddr, holder sdk.AccAddress, held sdkmath.Int) bool {
+		marker, err := k.GetMarker(ctx, markerAddr)
+		if err != nil || marker == nil {
+			return false
+		}
+		feeSponsorshipClaims = append(feeSponsorshipClaims, types.FeeSponsorshipClaim{Denom: marker.GetDenom(), Holder: holder.String(), Held: held})
+		return false
+	})
+
+	var bridgeInfos []types.BridgeInfo
+	if err := k.IterateBridgeInfos(ctx, func(info types.BridgeInfo) bool {
+		bridgeInfos = append(bridgeInfos, info)
+		return false
+	}); err != nil {
+		panic(err)
+	}
+
+	var mintAttestations []types.MintAttestation
+	if err := k.IterateAllMintAttestations(ctx, func(attestation types.MintAttestation) bool {
+		mintAttestations = append(mintAttestations, attestation)
+		return false
+	}); err != nil {
+		panic(err)
+	}
+
+	var basketInfos []types.BasketInfo
+	if err := k.IterateBasketInfos(ctx, func(info types.BasketInfo) bool {
+		basketInfos = append(basketInfos, info)
+		return false
+	}); err != nil {
+		panic(err)
+	}
+
+	var ibcMemoActionConfigs []types.IBCMemoActionConfig
+	if err := k.IterateIBCMemoActionConfigs(ctx, func(config types.IBCMemoActionConfig) bool {
+		ibcMemoActionConfigs = append(ibcMemoActionConfigs, config)
+		return false
+	}); err != nil {
+		panic(err)
+	}
+
 	markerNetAssetValues := make([]types.MarkerNetAssetValues, len(markers))
 	for i := range markers {
 		var markerNavs types.MarkerNetAssetValues
@@ -114,5 +257,91 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) (data *types.GenesisState) {
 		markerNetAssetValues[i] = markerNavs
 	}
 
-	return types.NewGenesisState(params, markers, denyAddresses, markerNetAssetValues)
+	var mintAllowances []types.MintAllowance
+	for i := range markers {
+		k.IterateMintAllowances(ctx, markers[i].GetAddress(), func(minter sdk.AccAddress, remaining sdkmath.Int) bool {
+			mintAllowances = append(mintAllowances, types.NewMintAllowance(markers[i].Denom, minter, remaining))
+			return false
+		})
+	}
+
+	var distributions []types.Distribution
+	distDenoms := make(map[uint64]string)
+	err := k.IterateDistributions(ctx, func(distribution types.Distribution) bool {
+		distributions = append(distributions, distribution)
+		distDenoms[distribution.Id] = distribution.Amount.Denom
+		return false
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	var distClaims []types.DistributionClaim
+	k.IterateAllDistributionClaims(ctx, func(claimant sdk.AccAddress, distributionID uint64, amount sdkmath.Int) bool {
+		distClaims = append(distClaims, types.NewDistributionClaim(distributionID, claimant, sdk.NewCoin(distDenoms[distributionID], amount)))
+		return false
+	})
+
+	var escrowLedgers []types.EscrowLedger
+	err = k.IterateAllEscrowLedgers(ctx, func(ledger types.EscrowLedger) bool {
+		escrowLedgers = append(escrowLedgers, ledger)
+		return false
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	var escrowLimits []types.EscrowWithdrawLimit
+	err = k.IterateAllEscrowWithdrawLimits(ctx, func(limit types.EscrowWithdrawLimit) bool {
+		escrowLimits = append(escrowLimits, limit)
+		return false
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	var scheduledBurns []types.ScheduledBurn
+	err = k.IterateAllScheduledBurns(ctx, func(burn types.ScheduledBurn) bool {
+		scheduledBurns = append(scheduledBurns, burn)
+		return false
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	var bypasses []types.SendRestrictionBypass
+	err = k.IterateSendRestrictionBypasses(ctx, func(bypass types.SendRestrictionBypass) bool {
+		bypasses = append(bypasses, bypass)
+		return false
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	var supplyHistory []types.SupplyChange
+	err = k.IterateAllSupplyHistory(ctx, func(change types.SupplyChange) bool {
+		supplyHistory = append(supplyHistory, change)
+		return false
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	genState := types.NewGenesisState(params, markers, denyAddresses, markerNetAssetValues)
+	genState.MintAllowances = mintAllowances
+	genState.Distributions = distributions
+	genState.DistributionClaims = distClaims
+	genState.EscrowLedgers = escrowLedgers
+	genState.EscrowWithdrawLimits = escrowLimits
+	genState.ScheduledBurns = scheduledBurns
+	genState.SendRestrictionBypasses = bypasses
+	genState.SupplyHistory = supplyHistory
+	genState.DepositAllowAddresses = depositAllowAddresses
+	genState.FeeSponsorships = feeSponsorships
+	genState.FeeSponsorshipClaims = feeSponsorshipClaims
+	genState.BridgeInfos = bridgeInfos
+	genState.MintAttestations = mintAttestations
+	genState.BasketInfos = basketInfos
+	genState.IbcMemoActionConfigs = ibcMemoActionConfigs
+	return genState
 }
@@ -0,0 +1,35 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/hold"
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+// GetHoldDetails gets the details of the holds that the marker module has placed on an account's funds
+// for the fee grants it has claimed from fee sponsorships. It satisfies the hold.HoldDetailsGetter type.
+func (k Keeper) GetHoldDetails(ctx sdk.Context, addr sdk.AccAddress) ([]hold.HoldDetail, error) {
+	onHold, err := k.holdKeeper.GetHoldCoins(ctx, addr)
+	if err != nil {
+		return nil, err
+	}
+
+	var rv []hold.HoldDetail
+	for _, coin := range onHold {
+		markerAddr, err := types.MarkerAddress(coin.Denom)
+		if err != nil {
+			continue
+		}
+		held := k.GetFeeSponsorshipHeld(ctx, markerAddr, addr)
+		if !held.IsPositive() {
+			continue
+		}
+		rv = append(rv, hold.NewHoldDetail(types.HoldReasonCodeFeeSponsorship, types.ModuleName,
+			addr.String(), fmt.Sprintf("%s fee sponsorship", coin.Denom), sdk.NewCoins(sdk.NewCoin(coin.Denom, held)),
+			"released by the holder once the fee grant is used up or expires, or when the fee sponsorship is removed"))
+	}
+	return rv, nil
+}
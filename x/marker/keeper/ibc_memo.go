@@ -0,0 +1,151 @@
+package keeper
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	attrtypes "github.com/provenance-io/provenance/x/attribute/types"
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+// GetIBCMemoHandlers returns the registry of handlers for marker actions requested in ICS-20 memos.
+// Additional handlers should be registered during app setup.
+func (k Keeper) GetIBCMemoHandlers() *types.IBCMemoHandlerRegistry {
+	return k.ibcMemoHandlers
+}
+
+// GetIBCMemoActionConfig returns the ibc memo actions allowed for a marker's coin, or nil if there aren't any.
+func (k Keeper) GetIBCMemoActionConfig(ctx sdk.Context, markerAddr sdk.AccAddress) (*types.IBCMemoActionConfig, error) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.IBCMemoActionConfigKey(markerAddr))
+	if len(bz) == 0 {
+		return nil, nil
+	}
+	var config types.IBCMemoActionConfig
+	if err := k.cdc.Unmarshal(bz, &config); err != nil {
+		return nil, fmt.Errorf("could not read ibc memo action config: %w", err)
+	}
+	return &config, nil
+}
+
+// SetIBCMemoActionConfig stores the ibc memo actions allowed for a marker's coin.
+// A config without any actions is removed.
+func (k Keeper) SetIBCMemoActionConfig(ctx sdk.Context, markerAddr sdk.AccAddress, config types.IBCMemoActionConfig) error {
+	store := ctx.KVStore(k.storeKey)
+	key := types.IBCMemoActionConfigKey(markerAddr)
+	if len(config.Actions) == 0 {
+		store.Delete(key)
+		return nil
+	}
+	bz, err := k.cdc.Marshal(&config)
+	if err != nil {
+		return err
+	}
+	store.Set(key, bz)
+	return nil
+}
+
+// RemoveIBCMemoActionConfig removes the ibc memo actions allowed for a marker's coin.
+func (k Keeper) RemoveIBCMemoActionConfig(ctx sdk.Context, markerAddr sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.IBCMemoActionConfigKey(markerAddr))
+}
+
+// IterateIBCMemoActionConfigs iterates over the ibc memo action configs of all markers.
+func (k Keeper) IterateIBCMemoActionConfigs(ctx sdk.Context, handler func(config types.IBCMemoActionConfig) (stop bool)) error {
+	store := ctx.KVStore(k.storeKey)
+	it := storetypes.KVStorePrefixIterator(store, types.IBCMemoActionConfigPrefix)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		var config types.IBCMemoActionConfig
+		if err := k.cdc.Unmarshal(it.Value(), &config); err != nil {
+			return err
+		}
+		if handler(config) {
+			break
+		}
+	}
+	return nil
+}
+
+// UpdateIBCMemoActionConfig sets the ibc memo actions allowed for a marker's coin.
+// Each action must have a registered handler.
+func (k Keeper) UpdateIBCMemoActionConfig(ctx sdk.Context, marker types.MarkerAccountI, config types.IBCMemoActionConfig) error {
+	for _, action := range config.Actions {
+		if _, found := k.ibcMemoHandlers.Get(action.Action); !found {
+			return types.ErrUnknownIBCMemoAction.Wrapf("%q", action.Action)
+		}
+	}
+	return k.SetIBCMemoActionConfig(ctx, marker.GetAddress(), config)
+}
+
+// HandleIBCMemoActions executes each of the marker actions in the provided ICS-20 memo.
+// Actions are executed in order of their names. An error is returned if any requested action
+// is not allowed by the marker's ibc memo action config or does not have a registered handler,
+// or if any of the handlers fail.
+func (k Keeper) HandleIBCMemoActions(ctx sdk.Context, info types.IBCReceiveInfo, memo string) error {
+	actions, err := types.ParseIBCMemoActions(memo)
+	if err != nil {
+		return err
+	}
+	if len(actions) == 0 {
+		return nil
+	}
+	if info.Marker == nil {
+		return types.ErrMarkerNotFound.Wrapf("cannot execute ibc memo actions for %q", info.Amount.Denom)
+	}
+
+	config, err := k.GetIBCMemoActionConfig(ctx, info.Marker.GetAddress())
+	if err != nil {
+		return err
+	}
+	if config == nil {
+		config = &types.IBCMemoActionConfig{Denom: info.Marker.GetDenom()}
+	}
+
+	names := make([]string, 0, len(actions))
+	for name := range actions {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		allowed := config.GetAllowed(name)
+		if len(allowed) == 0 {
+			return types.ErrIBCMemoActionNotAllowed.Wrapf("%q for %s", name, info.Marker.GetDenom())
+		}
+		handler, found := k.ibcMemoHandlers.Get(name)
+		if !found {
+			return types.ErrUnknownIBCMemoAction.Wrapf("%q", name)
+		}
+		if err = handler.HandleIBCMemoAction(ctx, info, allowed, actions[name]); err != nil {
+			return fmt.Errorf("ibc memo action %q failed: %w", name, err)
+		}
+	}
+	return nil
+}
+
+// handleIBCMemoAttributeAction stamps an attribute on the receiver of a marker's funds.
+// The attribute name is the target, and must be allowed by the marker. It must also resolve to the marker's address,
+// so only names bound to the marker can be used.
+func (k Keeper) handleIBCMemoAttributeAction(ctx sdk.Context, info types.IBCReceiveInfo, allowed []types.IBCMemoAction, payload json.RawMessage) error {
+	var attrPayload types.IBCMemoAttributePayload
+	if err := json.Unmarshal(payload, &attrPayload); err != nil {
+		return fmt.Errorf("invalid payload: %w", err)
+	}
+	value, err := types.SelectIBCMemoAction(allowed, attrPayload.Name, attrPayload.Value)
+	if err != nil {
+		return err
+	}
+	if info.Marker.GetStatus() != types.StatusActive {
+		return fmt.Errorf("marker %s is not active", info.Marker.GetDenom())
+	}
+
+	attr := attrtypes.NewAttribute(attrPayload.Name, info.Receiver.String(), attrtypes.AttributeType_String, []byte(value), nil)
+	return k.attrKeeper.SetAttribute(ctx, attr, info.Marker.GetAddress())
+}
@@ -0,0 +1,213 @@
+package keeper_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	simapp "github.com/provenance-io/provenance/app"
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+func TestHandleIBCMemoActions(t *testing.T) {
+	app := simapp.Setup(t)
+	ctx := app.BaseApp.NewContext(false)
+
+	receiver := testUserAddress("receiver")
+	manager := testUserAddress("manager")
+	app.AccountKeeper.SetAccount(ctx, app.AccountKeeper.NewAccountWithAddress(ctx, receiver))
+
+	newMarker := func(denom string, status types.MarkerStatus) types.MarkerAccountI {
+		marker := types.NewMarkerAccount(
+			app.AccountKeeper.NewAccountWithAddress(ctx, types.MustGetMarkerAddress(denom)).(*authtypes.BaseAccount),
+			sdk.NewInt64Coin(denom, 1000),
+			manager,
+			[]types.AccessGrant{*types.NewAccessGrant(manager, types.AccessList{types.Access_Admin})},
+			status,
+			types.MarkerType_Coin,
+			false, // supply fixed
+			false, // allow gov
+			false, // allow forced transfer
+			[]string{},
+		)
+		app.MarkerKeeper.SetMarker(ctx, marker)
+		return marker
+	}
+	activeMarker := newMarker("ibcmemoactive", types.StatusActive)
+	proposedMarker := newMarker("ibcmemoproposed", types.StatusProposed)
+	noConfigMarker := newMarker("ibcmemonoconfig", types.StatusActive)
+	require.NoError(t, app.NameKeeper.SetNameRecord(ctx, "subscribed", activeMarker.GetAddress(), false), "SetNameRecord subscribed")
+	require.NoError(t, app.NameKeeper.SetNameRecord(ctx, "tier.subscribed", activeMarker.GetAddress(), false), "SetNameRecord tier.subscribed")
+	require.NoError(t, app.NameKeeper.SetNameRecord(ctx, "proposed", proposedMarker.GetAddress(), false), "SetNameRecord proposed")
+	require.NoError(t, app.NameKeeper.SetNameRecord(ctx, "notmine", manager, false), "SetNameRecord notmine")
+
+	var calledWith []string
+	require.NoError(t, app.MarkerKeeper.GetIBCMemoHandlers().Register("record",
+		types.IBCMemoHandlerFn(func(_ sdk.Context, info types.IBCReceiveInfo, allowed []types.IBCMemoAction, payload json.RawMessage) error {
+			calledWith = append(calledWith, info.Amount.String()+" "+allowed[0].Target+" "+string(payload))
+			return nil
+		})), "Register record")
+	require.NoError(t, app.MarkerKeeper.GetIBCMemoHandlers().Register("zfail",
+		types.IBCMemoHandlerFn(func(_ sdk.Context, _ types.IBCReceiveInfo, _ []types.IBCMemoAction, _ json.RawMessage) error {
+			return errors.New("handler failure")
+		})), "Register zfail")
+
+	activeConfig := types.NewIBCMemoActionConfig(activeMarker.GetDenom(),
+		types.NewIBCMemoAction("record", "anything", ""),
+		types.NewIBCMemoAction("zfail", "anything", ""),
+		types.NewIBCMemoAction("unregistered", "anything", ""),
+		types.NewIBCMemoAction(types.IBCMemoActionAttribute, "subscribed", ""),
+		types.NewIBCMemoAction(types.IBCMemoActionAttribute, "tier.subscribed", "gold"),
+		types.NewIBCMemoAction(types.IBCMemoActionAttribute, "notmine", ""),
+	)
+	require.NoError(t, app.MarkerKeeper.SetIBCMemoActionConfig(ctx, activeMarker.GetAddress(), activeConfig), "SetIBCMemoActionConfig active")
+	proposedConfig := types.NewIBCMemoActionConfig(proposedMarker.GetDenom(), types.NewIBCMemoAction(types.IBCMemoActionAttribute, "proposed", ""))
+	require.NoError(t, app.MarkerKeeper.SetIBCMemoActionConfig(ctx, proposedMarker.GetAddress(), proposedConfig), "SetIBCMemoActionConfig proposed")
+
+	newInfo := func(marker types.MarkerAccountI) types.IBCReceiveInfo {
+		rv := types.IBCReceiveInfo{Sender: "othersender", Receiver: receiver}
+		if marker != nil {
+			rv.Marker = marker
+			rv.Amount = sdk.NewCoin(marker.GetDenom(), sdkmath.NewInt(5))
+		}
+		return rv
+	}
+
+	tests := []struct {
+		name      string
+		info      types.IBCReceiveInfo
+		memo      string
+		expErr    string
+		expCalled []string
+		attrName  string
+		expAttr   string
+	}{
+		{
+			name: "empty memo",
+			info: newInfo(activeMarker),
+			memo: "",
+		},
+		{
+			name: "memo is not json",
+			info: newInfo(activeMarker),
+			memo: "just a note",
+		},
+		{
+			name: "memo without marker actions",
+			info: newInfo(nil),
+			memo: `{"marker":{}}`,
+		},
+		{
+			name:   "marker actions is not an object",
+			info:   newInfo(activeMarker),
+			memo:   `{"marker-actions":["attribute"]}`,
+			expErr: `invalid "marker-actions" memo value: json: cannot unmarshal array into Go value of type map[string]json.RawMessage`,
+		},
+		{
+			name:   "no marker",
+			info:   newInfo(nil),
+			memo:   `{"marker-actions":{"record":{}}}`,
+			expErr: `cannot execute ibc memo actions for "": marker not found`,
+		},
+		{
+			name:   "marker without config",
+			info:   newInfo(noConfigMarker),
+			memo:   `{"marker-actions":{"record":{}}}`,
+			expErr: `"record" for ibcmemonoconfig: ibc memo action not allowed`,
+		},
+		{
+			name:   "action not in config",
+			info:   newInfo(activeMarker),
+			memo:   `{"marker-actions":{"nope":{}}}`,
+			expErr: `"nope" for ibcmemoactive: ibc memo action not allowed`,
+		},
+		{
+			name:   "configured action without handler",
+			info:   newInfo(activeMarker),
+			memo:   `{"marker-actions":{"unregistered":{}}}`,
+			expErr: `"unregistered": unknown ibc memo action`,
+		},
+		{
+			name:      "actions run in name order until one fails",
+			info:      newInfo(activeMarker),
+			memo:      `{"marker-actions":{"zfail":{},"record":{"a":1}}}`,
+			expErr:    `ibc memo action "zfail" failed: handler failure`,
+			expCalled: []string{`5ibcmemoactive anything {"a":1}`},
+		},
+		{
+			name:   "attribute: name not in config",
+			info:   newInfo(activeMarker),
+			memo:   `{"marker-actions":{"attribute":{"name":"other.subscribed","value":"gold"}}}`,
+			expErr: `ibc memo action "attribute" failed: target "other.subscribed" is not allowed`,
+		},
+		{
+			name:   "attribute: value set by marker",
+			info:   newInfo(activeMarker),
+			memo:   `{"marker-actions":{"attribute":{"name":"tier.subscribed","value":"platinum"}}}`,
+			expErr: `ibc memo action "attribute" failed: target "tier.subscribed" value is set by the marker and cannot be provided`,
+		},
+		{
+			name:   "attribute: name not owned by marker",
+			info:   newInfo(activeMarker),
+			memo:   `{"marker-actions":{"attribute":{"name":"notmine","value":"gold"}}}`,
+			expErr: `ibc memo action "attribute" failed: "notmine" does not resolve to address "` + activeMarker.GetAddress().String() + `"`,
+		},
+		{
+			name:   "attribute: marker not active",
+			info:   newInfo(proposedMarker),
+			memo:   `{"marker-actions":{"attribute":{"name":"proposed","value":"gold"}}}`,
+			expErr: `ibc memo action "attribute" failed: marker ibcmemoproposed is not active`,
+		},
+		{
+			name:   "attribute: bad payload",
+			info:   newInfo(activeMarker),
+			memo:   `{"marker-actions":{"attribute":"subscribed"}}`,
+			expErr: `ibc memo action "attribute" failed: invalid payload: json: cannot unmarshal string into Go value of type types.IBCMemoAttributePayload`,
+		},
+		{
+			name:    "attribute: stamped",
+			info:    newInfo(activeMarker),
+			memo:    `{"marker-actions":{"attribute":{"name":"subscribed","value":"gold"}},"other":"thing"}`,
+			expAttr: "gold",
+		},
+		{
+			name:     "attribute: stamped with value set by marker",
+			info:     newInfo(activeMarker),
+			memo:     `{"marker-actions":{"attribute":{"name":"tier.subscribed"}}}`,
+			attrName: "tier.subscribed",
+			expAttr:  "gold",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			calledWith = nil
+			err := app.MarkerKeeper.HandleIBCMemoActions(ctx, tc.info, tc.memo)
+			if len(tc.expErr) > 0 {
+				assert.EqualError(t, err, tc.expErr, "HandleIBCMemoActions error")
+			} else {
+				assert.NoError(t, err, "HandleIBCMemoActions error")
+			}
+			assert.Equal(t, tc.expCalled, calledWith, "handlers called")
+
+			if len(tc.expAttr) > 0 {
+				attrName := tc.attrName
+				if len(attrName) == 0 {
+					attrName = "subscribed"
+				}
+				attrs, err := app.AttributeKeeper.GetAttributes(ctx, receiver.String(), attrName)
+				require.NoError(t, err, "GetAttributes")
+				require.Len(t, attrs, 1, "receiver attributes")
+				assert.Equal(t, tc.expAttr, string(attrs[0].Value), "attribute value")
+			}
+		})
+	}
+}
@@ -37,13 +37,12 @@ func supplyInvariant(mk Keeper, bk bankkeeper.Keeper) sdk.Invariant {
 
 				// Just log the supply status
 				if !requiredSupply.Equal(currentSupply) {
-					ctx.Logger().Error(
-						fmt.Sprintf("Current %s supply is NOT at the required amount",
-							requiredSupply.Denom), invariantName, currentSupply)
+					ctx.Logger().Error("current supply is NOT at the required amount",
+						"invariant", invariantName, "denom", requiredSupply.Denom, "current", currentSupply)
 					isBroken = true
 				} else {
-					ctx.Logger().Info(fmt.Sprintf("Current %s supply is at the required amount",
-						requiredSupply.Denom), invariantName, currentSupply)
+					ctx.Logger().Info("current supply is at the required amount",
+						"invariant", invariantName, "denom", requiredSupply.Denom, "current", currentSupply)
 				}
 				msg := fmt.Sprintf("invalid %s supply: required (%+v) current (%+v)\n",
 					requiredSupply.Denom, requiredSupply.Amount, currentSupply)
@@ -12,8 +12,8 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
-	ibctypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
 
+	"github.com/provenance-io/provenance/internal/provutils"
 	"github.com/provenance-io/provenance/x/marker/types"
 )
 
@@ -71,22 +71,29 @@ type Keeper struct {
 
 	markerModuleAddr sdk.AccAddress
 
-	ibcTransferModuleAddr sdk.AccAddress
-
 	feeCollectorAddr sdk.AccAddress
 
 	// Used to transfer the ibc marker
 	ibcTransferServer types.IbcTransferMsgServer
 
-	// reqAttrBypassAddrs is a set of addresses that are allowed to bypass the required attribute check.
-	// When sending to one of these, if there are required attributes, it behaves as if the addr has them;
-	// if there aren't required attributes, the sender still needs transfer permission.
-	// When sending from one of these, if there are required attributes, the destination must have them;
-	// if there aren't required attributes, it behaves as if the sender has transfer permission.
-	reqAttrBypassAddrs types.ImmutableAccAddresses
+	// Used to stake a marker's escrowed funds.
+	stakingMsgServer types.StakingMsgServer
+	distrMsgServer   types.DistrMsgServer
 
 	// groupChecker provides a way to check if an account is in a group.
 	groupChecker types.GroupChecker
+
+	// ibcMemoHandlers are the handlers for marker actions requested in ICS-20 memos.
+	// It's a pointer so that handlers registered after this keeper is copied are still available.
+	ibcMemoHandlers *types.IBCMemoHandlerRegistry
+
+	// To look up the scopes of an account for the account overview query.
+	// It's set after creation since the metadata keeper needs this keeper.
+	metadataKeeper types.MetadataKeeper
+
+	// To hold the funds of holders that claim fee grants from fee sponsorships.
+	// It's set after creation since the hold keeper is created after this keeper.
+	holdKeeper types.HoldKeeper
 }
 
 // NewKeeper returns a marker keeper. It handles:
@@ -104,33 +111,50 @@ func NewKeeper(
 	attrKeeper types.AttrKeeper,
 	nameKeeper types.NameKeeper,
 	ibcTransferServer types.IbcTransferMsgServer,
-	reqAttrBypassAddrs []sdk.AccAddress,
+	stakingMsgServer types.StakingMsgServer,
+	distrMsgServer types.DistrMsgServer,
 	checker types.GroupChecker,
 ) Keeper {
 	rv := Keeper{
-		authKeeper:            authKeeper,
-		authzKeeper:           authzKeeper,
-		bankKeeper:            bankKeeper,
-		feegrantKeeper:        feegrantKeeper,
-		attrKeeper:            attrKeeper,
-		nameKeeper:            nameKeeper,
-		storeKey:              key,
-		cdc:                   cdc,
-		authority:             authtypes.NewModuleAddress(govtypes.ModuleName).String(),
-		markerModuleAddr:      authtypes.NewModuleAddress(types.CoinPoolName),
-		ibcTransferModuleAddr: authtypes.NewModuleAddress(ibctypes.ModuleName),
-		feeCollectorAddr:      authtypes.NewModuleAddress(authtypes.FeeCollectorName),
-		ibcTransferServer:     ibcTransferServer,
-		reqAttrBypassAddrs:    types.NewImmutableAccAddresses(reqAttrBypassAddrs),
-		groupChecker:          checker,
+		authKeeper:        authKeeper,
+		authzKeeper:       authzKeeper,
+		bankKeeper:        bankKeeper,
+		feegrantKeeper:    feegrantKeeper,
+		attrKeeper:        attrKeeper,
+		nameKeeper:        nameKeeper,
+		storeKey:          key,
+		cdc:               cdc,
+		authority:         authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		markerModuleAddr:  authtypes.NewModuleAddress(types.CoinPoolName),
+		feeCollectorAddr:  authtypes.NewModuleAddress(authtypes.FeeCollectorName),
+		ibcTransferServer: ibcTransferServer,
+		stakingMsgServer:  stakingMsgServer,
+		distrMsgServer:    distrMsgServer,
+		groupChecker:      checker,
+		ibcMemoHandlers:   types.NewIBCMemoHandlerRegistry(),
 	}
 	bankKeeper.AppendSendRestriction(rv.SendRestrictionFn)
+	if err := rv.ibcMemoHandlers.Register(types.IBCMemoActionAttribute, types.IBCMemoHandlerFn(rv.handleIBCMemoAttributeAction)); err != nil {
+		panic(err)
+	}
 	return rv
 }
 
+// SetMetadataKeeper sets the metadata keeper used to look up an account's scopes.
+// It must be called before this keeper is provided to the marker module.
+func (k *Keeper) SetMetadataKeeper(metadataKeeper types.MetadataKeeper) {
+	k.metadataKeeper = metadataKeeper
+}
+
+// SetHoldKeeper sets the hold keeper used to hold the funds of fee sponsorship claims.
+// It must be called before this keeper is provided to the marker module.
+func (k *Keeper) SetHoldKeeper(holdKeeper types.HoldKeeper) {
+	k.holdKeeper = holdKeeper
+}
+
 // Logger returns a module-specific logger.
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
-	return ctx.Logger().With("module", "x/"+types.ModuleName)
+	return provutils.ModuleLogger(ctx, types.ModuleName)
 }
 
 var _ MarkerKeeperI = &Keeper{}
@@ -140,8 +164,17 @@ func (k Keeper) NewMarker(ctx sdk.Context, marker types.MarkerAccountI) types.Ma
 	return k.authKeeper.NewAccount(ctx, marker).(types.MarkerAccountI)
 }
 
-// GetMarker looks up a marker by a given address
+// GetMarker looks up a marker by a given address. Access grants that have expired are left out of it.
 func (k Keeper) GetMarker(ctx sdk.Context, address sdk.AccAddress) (types.MarkerAccountI, error) {
+	marker, err := k.getMarkerWithExpiredAccess(ctx, address)
+	if marker != nil {
+		removeExpiredAccess(ctx, marker)
+	}
+	return marker, err
+}
+
+// getMarkerWithExpiredAccess looks up a marker by a given address, including any of its access grants that have expired.
+func (k Keeper) getMarkerWithExpiredAccess(ctx sdk.Context, address sdk.AccAddress) (types.MarkerAccountI, error) {
 	mac := k.authKeeper.GetAccount(ctx, address)
 	if mac != nil {
 		macc, ok := mac.(types.MarkerAccountI)
@@ -163,6 +196,7 @@ func (k Keeper) SetMarker(ctx sdk.Context, marker types.MarkerAccountI) {
 	}
 	k.authKeeper.SetAccount(ctx, marker)
 	store.Set(types.MarkerStoreKey(marker.GetAddress()), marker.GetAddress())
+	k.setAccessGrantExpirations(ctx, marker)
 }
 
 // RemoveMarker removes a marker from the auth account store. Note: if the account holds coins this will
@@ -173,10 +207,19 @@ func (k Keeper) RemoveMarker(ctx sdk.Context, marker types.MarkerAccountI) {
 
 	k.RemoveNetAssetValues(ctx, marker.GetAddress())
 	k.ClearSendDeny(ctx, marker.GetAddress())
+	k.ClearDepositAllowList(ctx, marker.GetAddress())
+	if err := k.RemoveFeeSponsorship(ctx, marker.GetAddress()); err != nil {
+		k.Logger(ctx).Error("could not remove fee sponsorship", "denom", marker.GetDenom(), "err", err)
+	}
+	k.RemoveBridgeInfo(ctx, marker.GetAddress())
+	k.RemoveBasketInfo(ctx, marker.GetAddress())
+	k.RemoveIBCMemoActionConfig(ctx, marker.GetAddress())
+	k.RemoveMintAllowances(ctx, marker.GetAddress())
+	k.RemoveEscrowLedgers(ctx, marker.GetAddress())
 	store.Delete(types.MarkerStoreKey(marker.GetAddress()))
 }
 
-// IterateMarkers iterates all markers with the given handler function.
+// IterateMarkers iterates all markers with the given handler function. Access grants that have expired are left out of them.
 func (k Keeper) IterateMarkers(ctx sdk.Context, cb func(marker types.MarkerAccountI) (stop bool)) {
 	store := ctx.KVStore(k.storeKey)
 	iterator := storetypes.KVStorePrefixIterator(store, types.MarkerStoreKeyPrefix)
@@ -188,6 +231,7 @@ func (k Keeper) IterateMarkers(ctx sdk.Context, cb func(marker types.MarkerAccou
 		if !ok {
 			panic(fmt.Errorf("invalid account type in marker account registry"))
 		}
+		removeExpiredAccess(ctx, ma)
 		if cb(ma) {
 			break
 		}
@@ -418,16 +462,6 @@ func (k Keeper) RemoveNetAssetValues(ctx sdk.Context, markerAddr sdk.AccAddress)
 	}
 }
 
-// GetReqAttrBypassAddrs returns a deep copy of the addresses that bypass the required attributes checking.
-func (k Keeper) GetReqAttrBypassAddrs() []sdk.AccAddress {
-	return k.reqAttrBypassAddrs.GetSlice()
-}
-
-// IsReqAttrBypassAddr returns true if the provided addr can bypass the required attributes checking.
-func (k Keeper) IsReqAttrBypassAddr(addr sdk.AccAddress) bool {
-	return k.reqAttrBypassAddrs.Has(addr)
-}
-
 // IsMarkerAccount returns true if the provided address is one for a marker account.
 func (k Keeper) IsMarkerAccount(ctx sdk.Context, addr sdk.AccAddress) bool {
 	if len(addr) == 0 {
@@ -40,6 +40,11 @@ func (k Keeper) AddMarkerAccount(ctx sdk.Context, marker types.MarkerAccountI) e
 	if err := marker.Validate(); err != nil {
 		return err
 	}
+	for _, grant := range marker.GetAccessList() {
+		if err := validateAccessGrantExpiration(ctx, &grant); err != nil {
+			return err
+		}
+	}
 	markerAddress := types.MustGetMarkerAddress(marker.GetDenom())
 
 	if !marker.GetAddress().Equals(markerAddress) {
@@ -105,6 +110,9 @@ func (k Keeper) AddAccess(
 		if !mgr.Equals(caller) && m.GetStatus() == types.StatusProposed {
 			return fmt.Errorf("updates to pending marker %s can only be made by %s", m.GetDenom(), mgr)
 		}
+		if err = validateAccessGrantExpiration(ctx, grant); err != nil {
+			return fmt.Errorf("access grant failed: %w", err)
+		}
 		if err = m.GrantAccess(grant); err != nil {
 			return fmt.Errorf("access grant failed: %w", err)
 		}
@@ -191,6 +199,11 @@ func (k Keeper) WithdrawCoins(
 		return fmt.Errorf("cannot withdraw marker created coins from a marker that is not in Active status")
 	}
 
+	// funds earmarked in escrow ledgers can only be withdrawn using the escrow ledger withdraw limits
+	if err = k.validateNotEarmarked(ctx, m, coins); err != nil {
+		return err
+	}
+
 	if recipient.Empty() {
 		recipient = caller
 	}
@@ -222,6 +235,9 @@ func (k Keeper) MintCoin(ctx sdk.Context, caller sdk.AccAddress, coin sdk.Coin)
 	if err = m.ValidateAddressHasAccess(caller, types.Access_Mint); err != nil {
 		return err
 	}
+	if err = k.validateNotBasket(ctx, m); err != nil {
+		return err
+	}
 
 	switch {
 	// For proposed, finalized accounts we allow adjusting the total_supply of the marker but we do not
@@ -262,6 +278,9 @@ func (k Keeper) BurnCoin(ctx sdk.Context, caller sdk.AccAddress, coin sdk.Coin)
 	if err = m.ValidateAddressHasAccess(caller, types.Access_Burn); err != nil {
 		return err
 	}
+	if err = k.validateNotBasket(ctx, m); err != nil {
+		return err
+	}
 
 	switch {
 	// For proposed, finalized accounts we allow adjusting the total_supply of the marker but we do not
@@ -278,6 +297,9 @@ func (k Keeper) BurnCoin(ctx sdk.Context, caller sdk.AccAddress, coin sdk.Coin)
 	case m.GetStatus() != types.StatusActive:
 		return fmt.Errorf("cannot burn coin for a marker that is not in Active status")
 	default:
+		if err = k.validateNotEarmarked(ctx, m, sdk.NewCoins(coin)); err != nil {
+			return err
+		}
 		err = k.DecreaseSupply(ctx, m, coin)
 		if err != nil {
 			return err
@@ -299,8 +321,17 @@ func (k Keeper) CurrentEscrow(ctx sdk.Context, marker types.MarkerAccountI) sdk.
 	return k.bankKeeper.GetAllBalances(ctx, marker.GetAddress())
 }
 
-// AdjustCirculation will mint/burn coin if required to ensure desired supply matches amount in circulation
+// AdjustCirculation will mint/burn coin if required to ensure desired supply matches amount in circulation.
+// The circulation of a basket marker's coin cannot be adjusted this way; see BasketDeposit and BasketWithdraw.
 func (k Keeper) AdjustCirculation(ctx sdk.Context, marker types.MarkerAccountI, desiredSupply sdk.Coin) error {
+	if err := k.validateNotBasket(ctx, marker); err != nil {
+		return err
+	}
+	return k.adjustCirculation(ctx, marker, desiredSupply)
+}
+
+// adjustCirculation mints/burns coin to match the desired supply without checking whether the marker is a basket marker.
+func (k Keeper) adjustCirculation(ctx sdk.Context, marker types.MarkerAccountI, desiredSupply sdk.Coin) error {
 	defer telemetry.MeasureSince(telemetry.Now(), types.ModuleName, "adjust_circulation")
 
 	currentSupply := k.bankKeeper.GetSupply(ctx, marker.GetDenom()).Amount
@@ -310,9 +341,7 @@ func (k Keeper) AdjustCirculation(ctx sdk.Context, marker types.MarkerAccountI,
 	ctx = types.WithBypass(ctx)
 	if desiredSupply.Amount.GT(currentSupply) { // not enough coin in circulation, mint more.
 		offset := sdk.NewCoin(marker.GetDenom(), desiredSupply.Amount.Sub(currentSupply))
-		ctx.Logger().Info(
-			fmt.Sprintf("Adjusting %s circulation: increasing supply by %s",
-				marker.GetDenom(), offset))
+		k.Logger(ctx).Info("adjusting circulation: increasing supply", "denom", marker.GetDenom(), "amount", offset.String())
 		if err := k.bankKeeper.MintCoins(ctx, types.CoinPoolName, sdk.NewCoins(offset)); err != nil {
 			return err
 		}
@@ -321,11 +350,12 @@ func (k Keeper) AdjustCirculation(ctx sdk.Context, marker types.MarkerAccountI,
 		); err != nil {
 			return err
 		}
+		if err := k.recordSupplyChange(ctx, marker, types.SupplyChangeMint, offset.Amount, desiredSupply.Amount); err != nil {
+			return err
+		}
 	} else if desiredSupply.Amount.LT(currentSupply) { // too much coin in circulation, attempt to burn from marker account.
 		offset := sdk.NewCoin(marker.GetDenom(), currentSupply.Sub(desiredSupply.Amount))
-		ctx.Logger().Info(
-			fmt.Sprintf("Adjusting %s circulation: decreasing supply by %s",
-				marker.GetDenom(), offset))
+		k.Logger(ctx).Info("adjusting circulation: decreasing supply", "denom", marker.GetDenom(), "amount", offset.String())
 		if err := k.bankKeeper.SendCoinsFromAccountToModule(
 			ctx, marker.GetAddress(), types.CoinPoolName, sdk.NewCoins(offset),
 		); err != nil {
@@ -335,12 +365,24 @@ func (k Keeper) AdjustCirculation(ctx sdk.Context, marker types.MarkerAccountI,
 		if err := k.bankKeeper.BurnCoins(ctx, types.CoinPoolName, sdk.NewCoins(offset)); err != nil {
 			return fmt.Errorf("could not burn coin %v %w", offset, err)
 		}
+		if err := k.recordSupplyChange(ctx, marker, types.SupplyChangeBurn, offset.Amount, desiredSupply.Amount); err != nil {
+			return err
+		}
 	}
 	return nil
 }
 
 // IncreaseSupply will mint coins to the marker module coin pool account, then send these to the marker account
+// The supply of a basket marker cannot be increased this way; see BasketDeposit.
 func (k Keeper) IncreaseSupply(ctx sdk.Context, marker types.MarkerAccountI, coin sdk.Coin) error {
+	if err := k.validateNotBasket(ctx, marker); err != nil {
+		return err
+	}
+	return k.increaseSupply(ctx, marker, coin)
+}
+
+// increaseSupply mints coins to the marker account without checking whether the marker is a basket marker.
+func (k Keeper) increaseSupply(ctx sdk.Context, marker types.MarkerAccountI, coin sdk.Coin) error {
 	defer telemetry.MeasureSince(telemetry.Now(), types.ModuleName, "increase_supply")
 
 	inCirculation := sdk.NewCoin(marker.GetDenom(), k.bankKeeper.GetSupply(ctx, marker.GetDenom()).Amount)
@@ -362,11 +404,20 @@ func (k Keeper) IncreaseSupply(ctx sdk.Context, marker types.MarkerAccountI, coi
 		k.SetMarker(ctx, marker)
 	}
 
-	return k.AdjustCirculation(ctx, marker, total)
+	return k.adjustCirculation(ctx, marker, total)
 }
 
 // DecreaseSupply will move a given amount of coin from the marker to the markermodule coin pool account then burn it.
+// The supply of a basket marker cannot be decreased this way; see BasketWithdraw.
 func (k Keeper) DecreaseSupply(ctx sdk.Context, marker types.MarkerAccountI, coin sdk.Coin) error {
+	if err := k.validateNotBasket(ctx, marker); err != nil {
+		return err
+	}
+	return k.decreaseSupply(ctx, marker, coin)
+}
+
+// decreaseSupply burns coins from the marker account without checking whether the marker is a basket marker.
+func (k Keeper) decreaseSupply(ctx sdk.Context, marker types.MarkerAccountI, coin sdk.Coin) error {
 	defer telemetry.MeasureSince(telemetry.Now(), types.ModuleName, "decrease_supply")
 
 	inCirculation := sdk.NewCoin(marker.GetDenom(), k.bankKeeper.GetSupply(ctx, marker.GetDenom()).Amount)
@@ -394,7 +445,7 @@ func (k Keeper) DecreaseSupply(ctx sdk.Context, marker types.MarkerAccountI, coi
 	}
 
 	// Adjust circulation to match configured supply.
-	if err := k.AdjustCirculation(ctx, marker, inCirculation); err != nil {
+	if err := k.adjustCirculation(ctx, marker, inCirculation); err != nil {
 		panic(err)
 	}
 
@@ -591,7 +642,8 @@ func (k Keeper) DeleteMarker(ctx sdk.Context, caller sdk.AccAddress, denom strin
 			" ensure marker account holds the entire supply of %s", inCirculation, totalSupply, denom)
 	}
 
-	err = k.DecreaseSupply(ctx, m, sdk.NewCoin(denom, totalSupply))
+	// This is also used for basket markers; any components left in escrow will prevent the deletion below.
+	err = k.decreaseSupply(ctx, m, sdk.NewCoin(denom, totalSupply))
 	if err != nil {
 		return fmt.Errorf("could not decrease marker supply %s: %w", denom, err)
 	}
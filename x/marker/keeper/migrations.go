@@ -1,5 +1,11 @@
 package keeper
 
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
 // Migrator is a struct for handling in-place store migrations.
 type Migrator struct {
 	keeper Keeper
@@ -9,3 +15,18 @@ type Migrator struct {
 func NewMigrator(keeper Keeper) Migrator {
 	return Migrator{keeper: keeper}
 }
+
+// Migrate2To3 will update the marker store from version 2 to version 3.
+// It populates the send restriction bypass registry with the addresses that used to be hardcoded.
+func (m Migrator) Migrate2To3(ctx sdk.Context) error {
+	logger := m.keeper.Logger(ctx)
+	logger.Info("Starting migration of x/marker from 2 to 3.")
+	for _, bypass := range types.DefaultSendRestrictionBypasses() {
+		if err := m.keeper.SetSendRestrictionBypass(ctx, bypass); err != nil {
+			logger.Error("Error setting send restriction bypass.", "address", bypass.Address, "error", err)
+			return err
+		}
+	}
+	logger.Info("Done migrating x/marker from 2 to 3.")
+	return nil
+}
@@ -0,0 +1,165 @@
+package keeper
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-metrics"
+
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+// GetMintAllowance returns the amount of a marker's coin that the minter is still allowed to mint.
+func (k Keeper) GetMintAllowance(ctx sdk.Context, markerAddr, minter sdk.AccAddress) sdkmath.Int {
+	store := ctx.KVStore(k.storeKey)
+	return readStoredInt(store.Get(types.MintAllowanceKey(markerAddr, minter)))
+}
+
+// SetMintAllowance sets the amount of a marker's coin that the minter is allowed to mint.
+// An amount of zero removes the allowance.
+func (k Keeper) SetMintAllowance(ctx sdk.Context, markerAddr, minter sdk.AccAddress, amount sdkmath.Int) error {
+	if amount.IsNil() || amount.IsNegative() {
+		return fmt.Errorf("mint allowance cannot be negative")
+	}
+	store := ctx.KVStore(k.storeKey)
+	key := types.MintAllowanceKey(markerAddr, minter)
+	if amount.IsZero() {
+		store.Delete(key)
+		return nil
+	}
+	bz, err := amount.Marshal()
+	if err != nil {
+		return err
+	}
+	store.Set(key, bz)
+	return nil
+}
+
+// IterateMintAllowances iterates over the mint allowances of a marker.
+func (k Keeper) IterateMintAllowances(ctx sdk.Context, markerAddr sdk.AccAddress, handler func(minter sdk.AccAddress, remaining sdkmath.Int) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	it := storetypes.KVStorePrefixIterator(store, types.MintAllowanceKeyPrefix(markerAddr))
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		_, minter := types.GetMintAllowanceAddresses(it.Key())
+		if handler(minter, readStoredInt(it.Value())) {
+			break
+		}
+	}
+}
+
+// IterateAllMintAllowances iterates over the mint allowances of all markers.
+func (k Keeper) IterateAllMintAllowances(ctx sdk.Context, handler func(markerAddr, minter sdk.AccAddress, remaining sdkmath.Int) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	it := storetypes.KVStorePrefixIterator(store, types.MintAllowancePrefix)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		markerAddr, minter := types.GetMintAllowanceAddresses(it.Key())
+		if handler(markerAddr, minter, readStoredInt(it.Value())) {
+			break
+		}
+	}
+}
+
+// RemoveMintAllowances removes all mint allowances for a marker.
+func (k Keeper) RemoveMintAllowances(ctx sdk.Context, markerAddr sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	it := storetypes.KVStorePrefixIterator(store, types.MintAllowanceKeyPrefix(markerAddr))
+	var keys [][]byte
+	for ; it.Valid(); it.Next() {
+		keys = append(keys, it.Key())
+	}
+	it.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// MintFromAllowance mints coin against the minter's allowance and sends it to the minter.
+// If the marker has required attributes, the minter must have them too.
+// Returns the amount the minter is still allowed to mint.
+func (k Keeper) MintFromAllowance(ctx sdk.Context, minter sdk.AccAddress, coin sdk.Coin) (sdkmath.Int, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), types.ModuleName, "mint_from_allowance")
+
+	m, err := k.GetMarkerByDenom(ctx, coin.Denom)
+	if err != nil {
+		return sdkmath.Int{}, fmt.Errorf("marker not found for %s: %w", coin.Denom, err)
+	}
+	if m.GetStatus() != types.StatusActive {
+		return sdkmath.Int{}, fmt.Errorf("cannot mint coin for a marker that is not in Active status")
+	}
+
+	allowance := k.GetMintAllowance(ctx, m.GetAddress(), minter)
+	if allowance.LT(coin.Amount) {
+		return sdkmath.Int{}, fmt.Errorf("%s cannot mint %s: mint allowance is %s", minter, coin, allowance)
+	}
+
+	if err = k.validateMinterAttributes(ctx, m, minter); err != nil {
+		return sdkmath.Int{}, err
+	}
+
+	if err = k.IncreaseSupply(ctx, m, coin); err != nil {
+		return sdkmath.Int{}, err
+	}
+	if err = k.bankKeeper.SendCoins(types.WithBypass(ctx), m.GetAddress(), minter, sdk.NewCoins(coin)); err != nil {
+		return sdkmath.Int{}, err
+	}
+
+	remaining := allowance.Sub(coin.Amount)
+	if err = k.SetMintAllowance(ctx, m.GetAddress(), minter, remaining); err != nil {
+		return sdkmath.Int{}, err
+	}
+
+	defer func() {
+		telemetry.IncrCounterWithLabels(
+			[]string{types.ModuleName, types.EventTelemetryKeyMint},
+			1,
+			[]metrics.Label{
+				telemetry.NewLabel(types.EventTelemetryLabelDenom, coin.Denom),
+				telemetry.NewLabel(types.EventTelemetryLabelAddress, minter.String()),
+			},
+		)
+	}()
+
+	return remaining, ctx.EventManager().EmitTypedEvent(types.NewEventMintFromAllowance(coin, minter.String(), remaining))
+}
+
+// validateMinterAttributes returns an error if the marker has required attributes that the minter doesn't have.
+func (k Keeper) validateMinterAttributes(ctx sdk.Context, marker types.MarkerAccountI, minter sdk.AccAddress) error {
+	reqAttr := marker.GetRequiredAttributes()
+	if len(reqAttr) == 0 || k.IsReqAttrBypassAddr(ctx, minter) {
+		return nil
+	}
+	attributes, err := k.attrKeeper.GetAllAttributesAddr(ctx, minter)
+	if err != nil {
+		return fmt.Errorf("could not get attributes for %s: %w", minter.String(), err)
+	}
+	missing := findMissingAttributes(reqAttr, attributes)
+	if len(missing) != 0 {
+		pl := ""
+		if len(missing) != 1 {
+			pl = "s"
+		}
+		return fmt.Errorf("minter %s does not contain the %q required attribute%s: \"%s\"", minter.String(), marker.GetDenom(), pl, strings.Join(missing, `", "`))
+	}
+	return nil
+}
+
+// readStoredInt converts a stored Int value (e.g. a mint allowance) into an Int, treating a missing value as zero.
+func readStoredInt(bz []byte) sdkmath.Int {
+	if len(bz) == 0 {
+		return sdkmath.ZeroInt()
+	}
+	var rv sdkmath.Int
+	if err := rv.Unmarshal(bz); err != nil {
+		return sdkmath.ZeroInt()
+	}
+	return rv
+}
@@ -8,6 +8,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
 	attrtypes "github.com/provenance-io/provenance/x/attribute/types"
 	"github.com/provenance-io/provenance/x/marker/types"
@@ -17,6 +18,7 @@ import (
 type WrappedBankKeeper struct {
 	types.BankKeeper
 	SendCoinsErrs     []string
+	DenomOwnersErrs   []string
 	ExtraBlockedAddrs []sdk.AccAddress
 }
 
@@ -43,6 +45,13 @@ func (w *WrappedBankKeeper) WithSendCoinsErrs(errs ...string) *WrappedBankKeeper
 	return w
 }
 
+// WithDenomOwnersErrs adds the provided error strings to the list of errors that will be returned by DenomOwners.
+// They are used the same way as the SendCoinsErrs.
+func (w *WrappedBankKeeper) WithDenomOwnersErrs(errs ...string) *WrappedBankKeeper {
+	w.DenomOwnersErrs = append(w.DenomOwnersErrs, errs...)
+	return w
+}
+
 // WithExtraBlockedAddrs adds the provided addresses to the list of addresses that this WrappedBankKeeper will return
 // BlockedAddr = true for. These are on top of any that the parent bank keeper already has.
 func (w *WrappedBankKeeper) WithExtraBlockedAddrs(addrs ...sdk.AccAddress) *WrappedBankKeeper {
@@ -62,6 +71,18 @@ func (w *WrappedBankKeeper) SendCoins(ctx context.Context, fromAddr, toAddr sdk.
 	return w.BankKeeper.SendCoins(ctx, fromAddr, toAddr, amt)
 }
 
+// DenomOwners either returns a pre-defined error, or, if there isn't one, calls DenomOwners on the parent.
+func (w *WrappedBankKeeper) DenomOwners(ctx context.Context, req *banktypes.QueryDenomOwnersRequest) (*banktypes.QueryDenomOwnersResponse, error) {
+	if len(w.DenomOwnersErrs) > 0 {
+		rv := w.DenomOwnersErrs[0]
+		w.DenomOwnersErrs = w.DenomOwnersErrs[1:]
+		if len(rv) > 0 {
+			return nil, errors.New(rv)
+		}
+	}
+	return w.BankKeeper.DenomOwners(ctx, req)
+}
+
 // BlockedAddr returns true if the address is in the list of extra blocked addresses.
 // Otherwise, it calls BlockedAddr on the parent.
 func (w *WrappedBankKeeper) BlockedAddr(addr sdk.AccAddress) bool {
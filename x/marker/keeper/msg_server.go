@@ -110,7 +110,7 @@ func (k msgServer) AddMarker(goCtx context.Context, msg *types.MsgAddMarkerReque
 	)
 
 	if err = k.Keeper.AddMarkerAccount(ctx, ma); err != nil {
-		ctx.Logger().Error("unable to add marker", "err", err)
+		k.Logger(ctx).Error("unable to add marker", "denom", msg.Amount.Denom, "err", err)
 		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
 	}
 
@@ -150,7 +150,7 @@ func (k msgServer) AddAccess(goCtx context.Context, msg *types.MsgAddAccessReque
 	for i := range msg.Access {
 		access := msg.Access[i]
 		if err := k.Keeper.AddAccess(ctx, admin, msg.Denom, &access); err != nil {
-			ctx.Logger().Error("unable to add access grant to marker", "err", err)
+			k.Logger(ctx).Error("unable to add access grant to marker", "denom", msg.Denom, "err", err)
 			return nil, sdkerrors.ErrUnauthorized.Wrap(err.Error())
 		}
 	}
@@ -171,7 +171,7 @@ func (k msgServer) DeleteAccess(goCtx context.Context, msg *types.MsgDeleteAcces
 	addr := sdk.MustAccAddressFromBech32(msg.RemovedAddress)
 
 	if err := k.Keeper.RemoveAccess(ctx, admin, msg.Denom, addr); err != nil {
-		ctx.Logger().Error("unable to remove access grant from marker", "err", err)
+		k.Logger(ctx).Error("unable to remove access grant from marker", "denom", msg.Denom, "err", err)
 		return nil, sdkerrors.ErrUnauthorized.Wrap(err.Error())
 	}
 
@@ -190,7 +190,7 @@ func (k msgServer) Finalize(goCtx context.Context, msg *types.MsgFinalizeRequest
 	admin := sdk.MustAccAddressFromBech32(msg.Administrator)
 
 	if err := k.Keeper.FinalizeMarker(ctx, admin, msg.Denom); err != nil {
-		ctx.Logger().Error("unable to finalize marker", "err", err)
+		k.Logger(ctx).Error("unable to finalize marker", "denom", msg.Denom, "err", err)
 		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
 	}
 
@@ -209,7 +209,7 @@ func (k msgServer) Activate(goCtx context.Context, msg *types.MsgActivateRequest
 	admin := sdk.MustAccAddressFromBech32(msg.Administrator)
 
 	if err := k.Keeper.ActivateMarker(ctx, admin, msg.Denom); err != nil {
-		ctx.Logger().Error("unable to activate marker", "err", err)
+		k.Logger(ctx).Error("unable to activate marker", "denom", msg.Denom, "err", err)
 		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
 	}
 
@@ -228,7 +228,7 @@ func (k msgServer) Cancel(goCtx context.Context, msg *types.MsgCancelRequest) (*
 	admin := sdk.MustAccAddressFromBech32(msg.Administrator)
 
 	if err := k.Keeper.CancelMarker(ctx, admin, msg.Denom); err != nil {
-		ctx.Logger().Error("unable to cancel marker", "err", err)
+		k.Logger(ctx).Error("unable to cancel marker", "denom", msg.Denom, "err", err)
 		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
 	}
 
@@ -247,7 +247,7 @@ func (k msgServer) Delete(goCtx context.Context, msg *types.MsgDeleteRequest) (*
 	admin := sdk.MustAccAddressFromBech32(msg.Administrator)
 
 	if err := k.Keeper.DeleteMarker(ctx, admin, msg.Denom); err != nil {
-		ctx.Logger().Error("unable to delete marker", "err", err)
+		k.Logger(ctx).Error("unable to delete marker", "denom", msg.Denom, "err", err)
 		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
 	}
 
@@ -266,7 +266,7 @@ func (k msgServer) Mint(goCtx context.Context, msg *types.MsgMintRequest) (*type
 	admin := sdk.MustAccAddressFromBech32(msg.Administrator)
 
 	if err := k.Keeper.MintCoin(ctx, admin, msg.Amount); err != nil {
-		ctx.Logger().Error("unable to mint coin for marker", "err", err)
+		k.Logger(ctx).Error("unable to mint coin for marker", "denom", msg.Amount.Denom, "err", err)
 		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
 	}
 
@@ -303,7 +303,7 @@ func (k msgServer) Burn(goCtx context.Context, msg *types.MsgBurnRequest) (*type
 	admin := sdk.MustAccAddressFromBech32(msg.Administrator)
 
 	if err := k.Keeper.BurnCoin(ctx, admin, msg.Amount); err != nil {
-		ctx.Logger().Error("unable to burn coin from marker", "err", err)
+		k.Logger(ctx).Error("unable to burn coin from marker", "denom", msg.Amount.Denom, "err", err)
 		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
 	}
 
@@ -341,7 +341,7 @@ func (k msgServer) Withdraw(goCtx context.Context, msg *types.MsgWithdrawRequest
 	to := sdk.MustAccAddressFromBech32(msg.ToAddress)
 
 	if err := k.Keeper.WithdrawCoins(ctx, admin, to, msg.Denom, msg.Amount); err != nil {
-		ctx.Logger().Error("unable to withdraw coins from marker", "err", err)
+		k.Logger(ctx).Error("unable to withdraw coins from marker", "denom", msg.Denom, "err", err)
 		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
 	}
 
@@ -533,7 +533,7 @@ func (k msgServer) AddFinalizeActivateMarker(goCtx context.Context, msg *types.M
 	}
 
 	if err := k.Keeper.AddFinalizeAndActivateMarker(ctx, ma); err != nil {
-		ctx.Logger().Error("unable to add, finalize and activate marker", "err", err)
+		k.Logger(ctx).Error("unable to add, finalize and activate marker", "denom", msg.Amount.Denom, "err", err)
 		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
 	}
 
@@ -598,28 +598,42 @@ func (k msgServer) UpdateRequiredAttributes(goCtx context.Context, msg *types.Ms
 		return nil, fmt.Errorf("caller does not have authority to update required attributes %s", msg.TransferAuthority)
 	}
 
-	removeList, err := k.NormalizeRequiredAttributes(ctx, msg.RemoveRequiredAttributes)
+	reqAttrs, err := k.updateRequiredAttributes(ctx, m.GetRequiredAttributes(), msg.RemoveRequiredAttributes, msg.AddRequiredAttributes)
 	if err != nil {
 		return nil, err
 	}
-	addList, err := k.NormalizeRequiredAttributes(ctx, msg.AddRequiredAttributes)
+	reqSenderAttrs, err := k.updateRequiredAttributes(ctx, m.GetRequiredSenderAttributes(), msg.RemoveRequiredSenderAttributes, msg.AddRequiredSenderAttributes)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("invalid required sender attributes: %w", err)
 	}
 
-	reqAttrs, err := types.RemoveFromRequiredAttributes(m.GetRequiredAttributes(), removeList)
+	m.SetRequiredAttributes(reqAttrs)
+	m.SetRequiredSenderAttributes(reqSenderAttrs)
+	k.SetMarker(ctx, m)
+
+	return &types.MsgUpdateRequiredAttributesResponse{}, nil
+}
+
+// updateRequiredAttributes normalizes the provided lists, then removes and adds them to the current required attributes.
+// If there's nothing to remove or add, the current list is returned unchanged.
+func (k msgServer) updateRequiredAttributes(ctx sdk.Context, current, toRemove, toAdd []string) ([]string, error) {
+	if len(toRemove) == 0 && len(toAdd) == 0 {
+		return current, nil
+	}
+	removeList, err := k.NormalizeRequiredAttributes(ctx, toRemove)
 	if err != nil {
 		return nil, err
 	}
-	reqAttrs, err = types.AddToRequiredAttributes(reqAttrs, addList)
+	addList, err := k.NormalizeRequiredAttributes(ctx, toAdd)
 	if err != nil {
 		return nil, err
 	}
 
-	m.SetRequiredAttributes(reqAttrs)
-	k.SetMarker(ctx, m)
-
-	return &types.MsgUpdateRequiredAttributesResponse{}, nil
+	reqAttrs, err := types.RemoveFromRequiredAttributes(current, removeList)
+	if err != nil {
+		return nil, err
+	}
+	return types.AddToRequiredAttributes(reqAttrs, addList)
 }
 
 // UpdateForcedTransfer updates the allow_forced_transfer field of a marker via governance proposal.
@@ -726,6 +740,289 @@ func (k msgServer) UpdateSendDenyList(goCtx context.Context, msg *types.MsgUpdat
 	return &types.MsgUpdateSendDenyListResponse{}, nil
 }
 
+// UpdateDepositAllowList updates the list of accounts allowed to deposit funds into a marker.
+// Signer must have deposit access or be gov proposal.
+func (k msgServer) UpdateDepositAllowList(goCtx context.Context, msg *types.MsgUpdateDepositAllowListRequest) (*types.MsgUpdateDepositAllowListResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	marker, err := k.GetMarkerByDenom(ctx, msg.Denom)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("could not get %s marker: %v", msg.Denom, err)
+	}
+
+	if msg.Administrator == k.GetAuthority() {
+		if !marker.HasGovernanceEnabled() {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf("%s marker does not allow governance control", msg.Denom)
+		}
+	} else if err = marker.ValidateHasAccess(msg.Administrator, types.Access_Deposit); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	markerAddr := marker.GetAddress()
+	for _, addr := range msg.RemoveAllowedAddresses {
+		depositor := sdk.MustAccAddressFromBech32(addr)
+		if !k.IsDepositAllowListed(ctx, markerAddr, depositor) {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf("%s is not on the %s deposit allow list", addr, msg.Denom)
+		}
+		k.RemoveDepositAllowed(ctx, markerAddr, depositor)
+	}
+
+	for _, addr := range msg.AddAllowedAddresses {
+		depositor := sdk.MustAccAddressFromBech32(addr)
+		if k.IsDepositAllowListed(ctx, markerAddr, depositor) {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf("%s is already on the %s deposit allow list", addr, msg.Denom)
+		}
+		k.AddDepositAllowed(ctx, markerAddr, depositor)
+	}
+
+	event := types.NewEventDepositAllowListUpdated(msg.Denom, msg.AddAllowedAddresses, msg.RemoveAllowedAddresses, msg.Administrator)
+	if err = ctx.EventManager().EmitTypedEvent(event); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateDepositAllowListResponse{}, nil
+}
+
+// SetFeeSponsorship creates or updates the fee sponsorship of a marker.
+// Signer must have admin access or be gov proposal.
+func (k msgServer) SetFeeSponsorship(goCtx context.Context, msg *types.MsgSetFeeSponsorshipRequest) (*types.MsgSetFeeSponsorshipResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sponsorship := msg.Sponsorship
+	marker, err := k.validateMarkerAdminOrGov(ctx, sponsorship.Denom, msg.Administrator)
+	if err != nil {
+		return nil, err
+	}
+
+	sponsorship.RequiredAttributes, err = k.NormalizeRequiredAttributes(ctx, sponsorship.RequiredAttributes)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+	if err = sponsorship.Validate(); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+	if err = k.Keeper.SetFeeSponsorship(ctx, marker.GetAddress(), sponsorship); err != nil {
+		return nil, err
+	}
+
+	if err = ctx.EventManager().EmitTypedEvent(types.NewEventFeeSponsorshipSet(sponsorship, msg.Administrator)); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetFeeSponsorshipResponse{}, nil
+}
+
+// RemoveFeeSponsorship removes the fee sponsorship of a marker.
+// Signer must have admin access or be gov proposal.
+func (k msgServer) RemoveFeeSponsorship(goCtx context.Context, msg *types.MsgRemoveFeeSponsorshipRequest) (*types.MsgRemoveFeeSponsorshipResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	marker, err := k.validateMarkerAdminOrGov(ctx, msg.Denom, msg.Administrator)
+	if err != nil {
+		return nil, err
+	}
+
+	sponsorship, err := k.GetFeeSponsorship(ctx, marker.GetAddress())
+	if err != nil {
+		return nil, err
+	}
+	if sponsorship == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("%s marker does not have a fee sponsorship", msg.Denom)
+	}
+	if err = k.Keeper.RemoveFeeSponsorship(ctx, marker.GetAddress()); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	if err = ctx.EventManager().EmitTypedEvent(types.NewEventFeeSponsorshipRemoved(msg.Denom, msg.Administrator)); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRemoveFeeSponsorshipResponse{}, nil
+}
+
+// validateMarkerAdminOrGov returns the marker for the denom if the administrator has admin access, or is the
+// governance authority and the marker allows governance control.
+func (k msgServer) validateMarkerAdminOrGov(ctx sdk.Context, denom string, administrator string) (types.MarkerAccountI, error) {
+	marker, err := k.GetMarkerByDenom(ctx, denom)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("could not get %s marker: %v", denom, err)
+	}
+
+	if administrator == k.GetAuthority() {
+		if !marker.HasGovernanceEnabled() {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf("%s marker does not allow governance control", denom)
+		}
+	} else if err = marker.ValidateHasAccess(administrator, types.Access_Admin); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+	return marker, nil
+}
+
+// validateMarkerGov returns the marker for the denom if the administrator is the governance authority and the
+// marker allows governance control. Unlike validateMarkerAdminOrGov, accounts with admin access are not allowed.
+func (k msgServer) validateMarkerGov(ctx sdk.Context, denom string, administrator string) (types.MarkerAccountI, error) {
+	if administrator != k.GetAuthority() {
+		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "expected %s got %s", k.GetAuthority(), administrator)
+	}
+
+	marker, err := k.GetMarkerByDenom(ctx, denom)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("could not get %s marker: %v", denom, err)
+	}
+	if !marker.HasGovernanceEnabled() {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("%s marker does not allow governance control", denom)
+	}
+	return marker, nil
+}
+
+// ClaimFeeSponsorship grants the signer a fee allowance from a marker's account if they meet its fee sponsorship criteria.
+func (k msgServer) ClaimFeeSponsorship(goCtx context.Context, msg *types.MsgClaimFeeSponsorshipRequest) (*types.MsgClaimFeeSponsorshipResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	marker, err := k.GetMarkerByDenom(ctx, msg.Denom)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("could not get %s marker: %v", msg.Denom, err)
+	}
+	holder, err := sdk.AccAddressFromBech32(msg.Holder)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrap(err.Error())
+	}
+	if err = k.GrantSponsoredAllowance(ctx, marker, holder); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	return &types.MsgClaimFeeSponsorshipResponse{}, nil
+}
+
+// ReleaseFeeSponsorshipHold releases the funds put on hold when the signer claimed a fee grant from a marker's fee sponsorship.
+func (k msgServer) ReleaseFeeSponsorshipHold(goCtx context.Context, msg *types.MsgReleaseFeeSponsorshipHoldRequest) (*types.MsgReleaseFeeSponsorshipHoldResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	markerAddr, err := types.MarkerAddress(msg.Denom)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+	holder, err := sdk.AccAddressFromBech32(msg.Holder)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrap(err.Error())
+	}
+	if err = k.ReleaseSponsoredAllowanceHold(ctx, markerAddr, msg.Denom, holder); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	return &types.MsgReleaseFeeSponsorshipHoldResponse{}, nil
+}
+
+// SetBridgeInfo creates or updates the bridge info of a wrapped-asset marker.
+// Signer must have admin access or be gov proposal.
+func (k msgServer) SetBridgeInfo(goCtx context.Context, msg *types.MsgSetBridgeInfoRequest) (*types.MsgSetBridgeInfoResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	marker, err := k.validateMarkerAdminOrGov(ctx, msg.BridgeInfo.Denom, msg.Administrator)
+	if err != nil {
+		return nil, err
+	}
+	if err = k.Keeper.SetBridgeInfo(ctx, marker.GetAddress(), msg.BridgeInfo); err != nil {
+		return nil, err
+	}
+
+	if err = ctx.EventManager().EmitTypedEvent(types.NewEventBridgeInfoSet(msg.BridgeInfo, msg.Administrator)); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetBridgeInfoResponse{}, nil
+}
+
+// AttestedMint mints coin of a wrapped-asset marker and records a reference to the proof of its backing.
+// Signer must have mint access.
+func (k msgServer) AttestedMint(goCtx context.Context, msg *types.MsgAttestedMintRequest) (*types.MsgAttestedMintResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	admin, err := sdk.AccAddressFromBech32(msg.Administrator)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrap(err.Error())
+	}
+	seq, err := k.Keeper.AttestedMint(ctx, admin, msg.Amount, msg.Reference)
+	if err != nil {
+		k.Logger(ctx).Error("unable to mint attested coin for marker", "denom", msg.Amount.Denom, "err", err)
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	return &types.MsgAttestedMintResponse{Sequence: seq}, nil
+}
+
+// SetBasketInfo sets the components that back a basket marker's coin.
+// Signer must have admin access or be gov proposal.
+func (k msgServer) SetBasketInfo(goCtx context.Context, msg *types.MsgSetBasketInfoRequest) (*types.MsgSetBasketInfoResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	marker, err := k.validateMarkerAdminOrGov(ctx, msg.BasketInfo.Denom, msg.Administrator)
+	if err != nil {
+		return nil, err
+	}
+	if err = k.Keeper.UpdateBasketInfo(ctx, marker, msg.BasketInfo); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	if err = ctx.EventManager().EmitTypedEvent(types.NewEventBasketInfoSet(msg.BasketInfo, msg.Administrator)); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetBasketInfoResponse{}, nil
+}
+
+// SetIBCMemoActionConfig sets the actions that ICS-20 memos are allowed to request when a marker's coin is received over IBC.
+// Signer must have admin access or be gov proposal.
+func (k msgServer) SetIBCMemoActionConfig(goCtx context.Context, msg *types.MsgSetIBCMemoActionConfigRequest) (*types.MsgSetIBCMemoActionConfigResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	marker, err := k.validateMarkerAdminOrGov(ctx, msg.Config.Denom, msg.Administrator)
+	if err != nil {
+		return nil, err
+	}
+	if err = k.Keeper.UpdateIBCMemoActionConfig(ctx, marker, msg.Config); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	if err = ctx.EventManager().EmitTypedEvent(types.NewEventIBCMemoActionConfigSet(msg.Config, msg.Administrator)); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetIBCMemoActionConfigResponse{}, nil
+}
+
+// BasketDeposit mints basket coin for the signer in exchange for a deposit of its components.
+func (k msgServer) BasketDeposit(goCtx context.Context, msg *types.MsgBasketDepositRequest) (*types.MsgBasketDepositResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	depositor, err := sdk.AccAddressFromBech32(msg.Depositor)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrap(err.Error())
+	}
+	components, err := k.Keeper.BasketDeposit(ctx, depositor, msg.Amount)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	return &types.MsgBasketDepositResponse{Components: components}, nil
+}
+
+// BasketWithdraw burns the signer's basket coin in exchange for a withdrawal of its components.
+func (k msgServer) BasketWithdraw(goCtx context.Context, msg *types.MsgBasketWithdrawRequest) (*types.MsgBasketWithdrawResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	holder, err := sdk.AccAddressFromBech32(msg.Holder)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrap(err.Error())
+	}
+	components, err := k.Keeper.BasketWithdraw(ctx, holder, msg.Amount)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	return &types.MsgBasketWithdrawResponse{Components: components}, nil
+}
+
 // AddNetAssetValues adds net asset values to a marker
 func (k msgServer) AddNetAssetValues(goCtx context.Context, msg *types.MsgAddNetAssetValuesRequest) (*types.MsgAddNetAssetValuesResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
@@ -753,6 +1050,387 @@ func (k msgServer) AddNetAssetValues(goCtx context.Context, msg *types.MsgAddNet
 	return &types.MsgAddNetAssetValuesResponse{}, nil
 }
 
+// BindMarkerName binds a new name under a name owned by a marker. Signer must be admin or gov proposal.
+func (k msgServer) BindMarkerName(goCtx context.Context, msg *types.MsgBindMarkerNameRequest) (*types.MsgBindMarkerNameResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	marker, err := k.GetMarkerByDenom(ctx, msg.Denom)
+	if err != nil {
+		return nil, fmt.Errorf("could not get %s marker: %w", msg.Denom, err)
+	}
+
+	if msg.Administrator == k.GetAuthority() {
+		if !marker.HasGovernanceEnabled() {
+			return nil, fmt.Errorf("%s marker does not allow governance control", msg.Denom)
+		}
+	} else if err = marker.ValidateHasAccess(msg.Administrator, types.Access_Admin); err != nil {
+		return nil, err
+	}
+
+	addr, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrap(err.Error())
+	}
+
+	if err = k.AddMarkerNameRecord(ctx, marker, msg.Parent, msg.Name, addr, msg.Restricted); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	return &types.MsgBindMarkerNameResponse{}, nil
+}
+
+// DeleteMarkerName deletes a name owned by a marker. Signer must be admin or gov proposal.
+func (k msgServer) DeleteMarkerName(goCtx context.Context, msg *types.MsgDeleteMarkerNameRequest) (*types.MsgDeleteMarkerNameResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	marker, err := k.GetMarkerByDenom(ctx, msg.Denom)
+	if err != nil {
+		return nil, fmt.Errorf("could not get %s marker: %w", msg.Denom, err)
+	}
+
+	if msg.Administrator == k.GetAuthority() {
+		if !marker.HasGovernanceEnabled() {
+			return nil, fmt.Errorf("%s marker does not allow governance control", msg.Denom)
+		}
+	} else if err = marker.ValidateHasAccess(msg.Administrator, types.Access_Admin); err != nil {
+		return nil, err
+	}
+
+	if err = k.DeleteMarkerNameRecord(ctx, marker, msg.Name); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	return &types.MsgDeleteMarkerNameResponse{}, nil
+}
+
+// DelegateEscrow stakes some of a marker's escrowed funds with a validator. Signer must have delegate access or be gov proposal.
+func (k msgServer) DelegateEscrow(goCtx context.Context, msg *types.MsgDelegateEscrowRequest) (*types.MsgDelegateEscrowResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	marker, err := k.getMarkerForEscrowStaking(ctx, msg.Denom, msg.Administrator)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = k.Keeper.DelegateEscrow(ctx, marker, msg.ValidatorAddress, msg.Amount); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	return &types.MsgDelegateEscrowResponse{}, nil
+}
+
+// UndelegateEscrow unstakes some of a marker's funds from a validator. Signer must have delegate access or be gov proposal.
+func (k msgServer) UndelegateEscrow(goCtx context.Context, msg *types.MsgUndelegateEscrowRequest) (*types.MsgUndelegateEscrowResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	marker, err := k.getMarkerForEscrowStaking(ctx, msg.Denom, msg.Administrator)
+	if err != nil {
+		return nil, err
+	}
+
+	completionTime, err := k.Keeper.UndelegateEscrow(ctx, marker, msg.ValidatorAddress, msg.Amount)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	return &types.MsgUndelegateEscrowResponse{CompletionTime: completionTime}, nil
+}
+
+// CollectEscrowRewards withdraws a marker's staking rewards into its escrow. Signer must have delegate access or be gov proposal.
+func (k msgServer) CollectEscrowRewards(goCtx context.Context, msg *types.MsgCollectEscrowRewardsRequest) (*types.MsgCollectEscrowRewardsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	marker, err := k.getMarkerForEscrowStaking(ctx, msg.Denom, msg.Administrator)
+	if err != nil {
+		return nil, err
+	}
+
+	amount, err := k.Keeper.CollectEscrowRewards(ctx, marker, msg.ValidatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	return &types.MsgCollectEscrowRewardsResponse{Amount: amount}, nil
+}
+
+// getMarkerForEscrowStaking gets the marker with the provided denom, making sure that the
+// administrator is allowed to manage the staking of its escrowed funds.
+func (k msgServer) getMarkerForEscrowStaking(ctx sdk.Context, denom, administrator string) (types.MarkerAccountI, error) {
+	marker, err := k.GetMarkerByDenom(ctx, denom)
+	if err != nil {
+		return nil, fmt.Errorf("could not get %s marker: %w", denom, err)
+	}
+
+	if administrator == k.GetAuthority() {
+		if !marker.HasGovernanceEnabled() {
+			return nil, fmt.Errorf("%s marker does not allow governance control", denom)
+		}
+	} else if err = marker.ValidateHasAccess(administrator, types.Access_Delegate); err != nil {
+		return nil, err
+	}
+
+	return marker, nil
+}
+
+// SetMintAllowance sets how much of a marker's coin an account is allowed to mint. Signer must have mint access or be gov proposal.
+func (k msgServer) SetMintAllowance(goCtx context.Context, msg *types.MsgSetMintAllowanceRequest) (*types.MsgSetMintAllowanceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	denom := msg.Amount.Denom
+	marker, err := k.GetMarkerByDenom(ctx, denom)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("could not get %s marker: %v", denom, err)
+	}
+
+	if msg.Administrator == k.GetAuthority() {
+		if !marker.HasGovernanceEnabled() {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf("%s marker does not allow governance control", denom)
+		}
+	} else if err = marker.ValidateHasAccess(msg.Administrator, types.Access_Mint); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	minter := sdk.MustAccAddressFromBech32(msg.Minter)
+	if err = k.Keeper.SetMintAllowance(ctx, marker.GetAddress(), minter, msg.Amount.Amount); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	if err = ctx.EventManager().EmitTypedEvent(types.NewEventSetMintAllowance(denom, msg.Minter, msg.Amount.Amount, msg.Administrator)); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetMintAllowanceResponse{}, nil
+}
+
+// MintFromAllowance mints coin of a marker to the signer, reducing the signer's mint allowance by the amount minted.
+func (k msgServer) MintFromAllowance(goCtx context.Context, msg *types.MsgMintFromAllowanceRequest) (*types.MsgMintFromAllowanceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	minter := sdk.MustAccAddressFromBech32(msg.Minter)
+	remaining, err := k.Keeper.MintFromAllowance(ctx, minter, msg.Amount)
+	if err != nil {
+		k.Logger(ctx).Error("unable to mint coin from allowance", "denom", msg.Amount.Denom, "err", err)
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	return &types.MsgMintFromAllowanceResponse{Remaining: sdk.NewCoin(msg.Amount.Denom, remaining)}, nil
+}
+
+// ScheduleDistribution schedules a pro-rata payout of the signer's funds to the holders of a marker's coin. Signer must have admin access.
+func (k msgServer) ScheduleDistribution(goCtx context.Context, msg *types.MsgScheduleDistributionRequest) (*types.MsgScheduleDistributionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	marker, err := k.GetMarkerByDenom(ctx, msg.Denom)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("could not get %s marker: %v", msg.Denom, err)
+	}
+	if err = marker.ValidateHasAccess(msg.Administrator, types.Access_Admin); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	minPayout := sdkmath.ZeroInt()
+	if msg.MinPayout != nil {
+		minPayout = msg.MinPayout.Amount
+	}
+
+	admin := sdk.MustAccAddressFromBech32(msg.Administrator)
+	id, err := k.Keeper.ScheduleDistribution(ctx, marker, msg.Amount, msg.StartHeight, msg.BatchSize, minPayout, admin)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	return &types.MsgScheduleDistributionResponse{DistributionId: id}, nil
+}
+
+// CancelDistribution cancels a distribution that has not started yet. Signer must have admin access.
+func (k msgServer) CancelDistribution(goCtx context.Context, msg *types.MsgCancelDistributionRequest) (*types.MsgCancelDistributionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	distribution, err := k.GetDistribution(ctx, msg.DistributionId)
+	if err != nil {
+		return nil, err
+	}
+	if distribution == nil {
+		return nil, sdkerrors.ErrNotFound.Wrapf("distribution %d not found", msg.DistributionId)
+	}
+
+	marker, err := k.GetMarkerByDenom(ctx, distribution.Denom)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("could not get %s marker: %v", distribution.Denom, err)
+	}
+	if err = marker.ValidateHasAccess(msg.Administrator, types.Access_Admin); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	admin := sdk.MustAccAddressFromBech32(msg.Administrator)
+	if err = k.Keeper.CancelDistribution(ctx, *distribution, admin); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	return &types.MsgCancelDistributionResponse{}, nil
+}
+
+// ClaimDistribution sends the signer the funds waiting to be claimed by them in a distribution.
+func (k msgServer) ClaimDistribution(goCtx context.Context, msg *types.MsgClaimDistributionRequest) (*types.MsgClaimDistributionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	claimant := sdk.MustAccAddressFromBech32(msg.Claimant)
+	amount, err := k.Keeper.ClaimDistribution(ctx, msg.DistributionId, claimant)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	return &types.MsgClaimDistributionResponse{Amount: amount}, nil
+}
+
+// AllocateEscrow earmarks some of a marker's escrowed funds in one of its escrow ledgers. Signer must have admin access.
+func (k msgServer) AllocateEscrow(goCtx context.Context, msg *types.MsgAllocateEscrowRequest) (*types.MsgAllocateEscrowResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	marker, err := k.GetMarkerByDenom(ctx, msg.Denom)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("could not get %s marker: %v", msg.Denom, err)
+	}
+	if err = marker.ValidateHasAccess(msg.Administrator, types.Access_Admin); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	admin := sdk.MustAccAddressFromBech32(msg.Administrator)
+	if err = k.Keeper.AllocateEscrow(ctx, marker, msg.Ledger, msg.Amount, admin); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	return &types.MsgAllocateEscrowResponse{}, nil
+}
+
+// ReleaseEscrow returns funds earmarked in one of a marker's escrow ledgers to the marker's unearmarked escrow.
+// Signer must be the governance authority.
+func (k msgServer) ReleaseEscrow(goCtx context.Context, msg *types.MsgReleaseEscrowRequest) (*types.MsgReleaseEscrowResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	marker, err := k.validateMarkerGov(ctx, msg.Denom, msg.Administrator)
+	if err != nil {
+		return nil, err
+	}
+
+	admin := sdk.MustAccAddressFromBech32(msg.Administrator)
+	if err = k.Keeper.ReleaseEscrow(ctx, marker, msg.Ledger, msg.Amount, admin); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	return &types.MsgReleaseEscrowResponse{}, nil
+}
+
+// SetEscrowWithdrawLimit sets how much an account is allowed to withdraw from one of a marker's escrow ledgers.
+// Signer must be the governance authority.
+func (k msgServer) SetEscrowWithdrawLimit(goCtx context.Context, msg *types.MsgSetEscrowWithdrawLimitRequest) (*types.MsgSetEscrowWithdrawLimitResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	marker, err := k.validateMarkerGov(ctx, msg.Denom, msg.Administrator)
+	if err != nil {
+		return nil, err
+	}
+
+	grantee := sdk.MustAccAddressFromBech32(msg.Grantee)
+	limit := types.NewEscrowWithdrawLimit(msg.Denom, msg.Ledger, grantee, msg.Limit)
+	if err = k.Keeper.SetEscrowWithdrawLimit(ctx, marker.GetAddress(), limit); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	if err = ctx.EventManager().EmitTypedEvent(types.NewEventSetEscrowWithdrawLimit(msg.Denom, msg.Ledger, msg.Grantee, msg.Limit, msg.Administrator)); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetEscrowWithdrawLimitResponse{}, nil
+}
+
+// WithdrawFromEscrow withdraws funds from one of a marker's escrow ledgers, reducing the signer's withdraw limit.
+func (k msgServer) WithdrawFromEscrow(goCtx context.Context, msg *types.MsgWithdrawFromEscrowRequest) (*types.MsgWithdrawFromEscrowResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	admin := sdk.MustAccAddressFromBech32(msg.Administrator)
+	var to sdk.AccAddress
+	if msg.ToAddress != "" {
+		to = sdk.MustAccAddressFromBech32(msg.ToAddress)
+	}
+
+	remaining, err := k.Keeper.WithdrawFromEscrow(ctx, admin, to, msg.Denom, msg.Ledger, msg.Amount)
+	if err != nil {
+		k.Logger(ctx).Error("unable to withdraw coins from marker escrow ledger", "denom", msg.Denom, "err", err)
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	return &types.MsgWithdrawFromEscrowResponse{RemainingLimit: remaining}, nil
+}
+
+// ScheduleBurn schedules a burn of some of a marker's escrowed coin at the end of a future block.
+// Signer must have burn access.
+func (k msgServer) ScheduleBurn(goCtx context.Context, msg *types.MsgScheduleBurnRequest) (*types.MsgScheduleBurnResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	marker, err := k.GetMarkerByDenom(ctx, msg.Amount.Denom)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("could not get %s marker: %v", msg.Amount.Denom, err)
+	}
+	if err = marker.ValidateHasAccess(msg.Administrator, types.Access_Burn); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	admin := sdk.MustAccAddressFromBech32(msg.Administrator)
+	id, err := k.Keeper.ScheduleBurn(ctx, marker, msg.Amount, msg.BurnHeight, msg.CancelWindow, admin)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	return &types.MsgScheduleBurnResponse{BurnId: id}, nil
+}
+
+// CancelScheduledBurn cancels a scheduled burn whose cancel window is still open. Signer must have burn access.
+func (k msgServer) CancelScheduledBurn(goCtx context.Context, msg *types.MsgCancelScheduledBurnRequest) (*types.MsgCancelScheduledBurnResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	burn, err := k.GetScheduledBurn(ctx, msg.BurnId)
+	if err != nil {
+		return nil, err
+	}
+	if burn == nil {
+		return nil, sdkerrors.ErrNotFound.Wrapf("scheduled burn %d not found", msg.BurnId)
+	}
+
+	marker, err := k.GetMarkerByDenom(ctx, burn.Amount.Denom)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("could not get %s marker: %v", burn.Amount.Denom, err)
+	}
+	if err = marker.ValidateHasAccess(msg.Administrator, types.Access_Burn); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	admin := sdk.MustAccAddressFromBech32(msg.Administrator)
+	if err = k.Keeper.CancelScheduledBurn(ctx, *burn, admin); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	return &types.MsgCancelScheduledBurnResponse{}, nil
+}
+
+// Exchange atomically swaps marker coins between two accounts.
+// Both accounts must sign, and both sends are subject to all the usual send restrictions.
+func (k msgServer) Exchange(goCtx context.Context, msg *types.MsgExchangeRequest) (*types.MsgExchangeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	partyA := sdk.MustAccAddressFromBech32(msg.PartyA)
+	partyB := sdk.MustAccAddressFromBech32(msg.PartyB)
+	if err := k.Keeper.Exchange(ctx, partyA, msg.AmountA, partyB, msg.AmountB); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	return &types.MsgExchangeResponse{}, nil
+}
+
 // SetAdministratorProposal can only be called via gov proposal
 func (k msgServer) SetAdministratorProposal(goCtx context.Context, msg *types.MsgSetAdministratorProposalRequest) (*types.MsgSetAdministratorProposalResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
@@ -842,9 +1520,41 @@ func (k msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParam
 	}
 
 	k.SetParams(ctx, msg.Params)
-	if err := ctx.EventManager().EmitTypedEvent(types.NewEventMarkerParamsUpdated(msg.Params.EnableGovernance, msg.Params.GetUnrestrictedDenomRegex(), msg.Params.MaxSupply)); err != nil {
+	if err := ctx.EventManager().EmitTypedEvent(types.NewEventMarkerParamsUpdated(msg.Params.EnableGovernance, msg.Params.GetUnrestrictedDenomRegex(), msg.Params.MaxSupply,
+		msg.Params.MaxQueryResults, msg.Params.MaxQueryResponseBytes, msg.Params.SupplyHistoryMaxEntries, msg.Params.SupplyHistoryRetentionBlocks,
+		msg.Params.MaxDistributionHoldersPerBlock, msg.Params.DistributionFee)); err != nil {
 		return nil, err
 	}
 
 	return &types.MsgUpdateParamsResponse{}, nil
 }
+
+// UpdateSendRestrictionBypasses is a governance proposal endpoint for updating the send restriction bypass registry.
+func (k msgServer) UpdateSendRestrictionBypasses(goCtx context.Context, msg *types.MsgUpdateSendRestrictionBypassesRequest) (*types.MsgUpdateSendRestrictionBypassesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.ValidateAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.UpdateSendRestrictionBypasses(ctx, msg.Set, msg.Remove); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	return &types.MsgUpdateSendRestrictionBypassesResponse{}, nil
+}
+
+// ChangeMarkerTypeProposal can only be called via gov proposal
+func (k msgServer) ChangeMarkerTypeProposal(goCtx context.Context, msg *types.MsgChangeMarkerTypeProposalRequest) (*types.MsgChangeMarkerTypeProposalResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.ValidateAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.HandleChangeMarkerTypeProposal(ctx, msg.Denom, msg.NewType); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgChangeMarkerTypeProposalResponse{}, nil
+}
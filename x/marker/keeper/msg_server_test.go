@@ -2,9 +2,11 @@ package keeper_test
 
 import (
 	"fmt"
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/suite"
 
@@ -12,19 +14,24 @@ import (
 	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
 
 	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/x/feegrant"
 
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/cosmos/gogoproto/proto"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
 
 	simapp "github.com/provenance-io/provenance/app"
 	attrtypes "github.com/provenance-io/provenance/x/attribute/types"
+	"github.com/provenance-io/provenance/x/hold"
 	markerkeeper "github.com/provenance-io/provenance/x/marker/keeper"
 	"github.com/provenance-io/provenance/x/marker/types"
+	metadatatypes "github.com/provenance-io/provenance/x/metadata/types"
 )
 
 type MsgServerTestSuite struct {
@@ -1376,6 +1383,1430 @@ func (s *MsgServerTestSuite) TestMsgSetAccountDataRequest() {
 	}
 }
 
+func (s *MsgServerTestSuite) TestBindAndDeleteMarkerName() {
+	denom := "namecoin"
+	otherDenom := "othernamecoin"
+	markerAddr := types.MustGetMarkerAddress(denom)
+	authority := s.app.MarkerKeeper.GetAuthority()
+	other := sdk.AccAddress("other_______________")
+
+	for _, d := range []string{denom, otherDenom} {
+		msg := types.NewMsgAddFinalizeActivateMarkerRequest(
+			d, sdkmath.NewInt(100),
+			s.owner1Addr, s.owner1Addr, // From and Manager.
+			types.MarkerType_Coin,
+			true,       // Supply fixed
+			true,       // Allow gov
+			false,      // don't allow forced transfer
+			[]string{}, // No required attributes.
+			[]types.AccessGrant{
+				{Address: s.owner1, Permissions: []types.Access{types.Access_Admin}},
+				{Address: s.owner2, Permissions: []types.Access{types.Access_Mint}},
+			},
+			0,
+			0,
+		)
+		_, err := s.msgServer.AddFinalizeActivateMarker(s.ctx, msg)
+		s.Require().NoError(err, "AddFinalizeActivateMarker(%q)", d)
+	}
+
+	// The marker owns a restricted root name, and someone else owns another name.
+	s.Require().NoError(s.app.NameKeeper.CreateRootName(s.ctx, "namecoin", markerAddr.String(), true), "CreateRootName namecoin")
+	s.Require().NoError(s.app.NameKeeper.SetNameRecord(s.ctx, "notmarker", other, false), "SetNameRecord notmarker")
+
+	bindTests := []struct {
+		name     string
+		msg      *types.MsgBindMarkerNameRequest
+		errorMsg string
+	}{
+		{
+			name:     "unknown marker",
+			msg:      types.NewMsgBindMarkerNameRequest("unknowncoin", "namecoin", "child", other, true, s.owner1),
+			errorMsg: "could not get unknowncoin marker: marker unknowncoin not found for address: " + types.MustGetMarkerAddress("unknowncoin").String(),
+		},
+		{
+			name:     "signer does not have admin",
+			msg:      types.NewMsgBindMarkerNameRequest(denom, "namecoin", "child", other, true, s.owner2),
+			errorMsg: s.noAccessErr(s.owner2, types.Access_Admin, denom),
+		},
+		{
+			name:     "parent not owned by marker",
+			msg:      types.NewMsgBindMarkerNameRequest(denom, "notmarker", "child", other, true, s.owner1),
+			errorMsg: `parent name "notmarker" is not owned by the namecoin marker: invalid request`,
+		},
+		{
+			name:     "parent owned by another marker",
+			msg:      types.NewMsgBindMarkerNameRequest(otherDenom, "namecoin", "child", other, true, s.owner1),
+			errorMsg: `parent name "namecoin" is not owned by the othernamecoin marker: invalid request`,
+		},
+		{
+			name: "admin binds child to other address",
+			msg:  types.NewMsgBindMarkerNameRequest(denom, "namecoin", "Child", other, true, s.owner1),
+		},
+		{
+			name:     "child already bound",
+			msg:      types.NewMsgBindMarkerNameRequest(denom, "namecoin", "child", other, true, s.owner1),
+			errorMsg: `could not bind name "child.namecoin": name is already bound to an address: invalid request`,
+		},
+		{
+			name: "gov binds child to marker",
+			msg:  types.NewMsgBindMarkerNameRequest(denom, "namecoin", "treasury", markerAddr, false, authority),
+		},
+		{
+			name: "admin binds grandchild under name owned by marker",
+			msg:  types.NewMsgBindMarkerNameRequest(denom, "treasury.namecoin", "ops", other, false, s.owner1),
+		},
+	}
+
+	for _, tc := range bindTests {
+		s.Run("bind: "+tc.name, func() {
+			_, err := s.msgServer.BindMarkerName(s.ctx, tc.msg)
+			if len(tc.errorMsg) > 0 {
+				s.Require().EqualError(err, tc.errorMsg, "BindMarkerName error")
+				return
+			}
+			s.Require().NoError(err, "BindMarkerName error")
+			full := strings.ToLower(tc.msg.Name + "." + tc.msg.Parent)
+			s.Assert().True(s.app.NameKeeper.ResolvesTo(s.ctx, full, sdk.MustAccAddressFromBech32(tc.msg.Address)), "ResolvesTo(%q, %s)", full, tc.msg.Address)
+		})
+	}
+
+	deleteTests := []struct {
+		name     string
+		msg      *types.MsgDeleteMarkerNameRequest
+		errorMsg string
+	}{
+		{
+			name:     "signer does not have admin",
+			msg:      types.NewMsgDeleteMarkerNameRequest(denom, "treasury.namecoin", s.owner2),
+			errorMsg: s.noAccessErr(s.owner2, types.Access_Admin, denom),
+		},
+		{
+			name:     "name does not exist",
+			msg:      types.NewMsgDeleteMarkerNameRequest(denom, "nope.namecoin", s.owner1),
+			errorMsg: `name "nope.namecoin" does not exist: invalid request`,
+		},
+		{
+			name:     "name not owned by marker",
+			msg:      types.NewMsgDeleteMarkerNameRequest(denom, "child.namecoin", s.owner1),
+			errorMsg: `name "child.namecoin" is not owned by the namecoin marker: invalid request`,
+		},
+		{
+			name: "admin deletes name owned by marker",
+			msg:  types.NewMsgDeleteMarkerNameRequest(denom, "treasury.namecoin", s.owner1),
+		},
+	}
+
+	for _, tc := range deleteTests {
+		s.Run("delete: "+tc.name, func() {
+			_, err := s.msgServer.DeleteMarkerName(s.ctx, tc.msg)
+			if len(tc.errorMsg) > 0 {
+				s.Require().EqualError(err, tc.errorMsg, "DeleteMarkerName error")
+				return
+			}
+			s.Require().NoError(err, "DeleteMarkerName error")
+			s.Assert().False(s.app.NameKeeper.NameExists(s.ctx, tc.msg.Name), "NameExists(%q)", tc.msg.Name)
+		})
+	}
+
+	s.Run("delete with a delete delay", func() {
+		nameParams := s.app.NameKeeper.GetParams(s.ctx)
+		defer s.app.NameKeeper.SetParams(s.ctx, nameParams)
+		delayed := nameParams
+		delayed.DeleteDelayBlocks = 5
+		s.app.NameKeeper.SetParams(s.ctx, delayed)
+
+		_, err := s.msgServer.BindMarkerName(s.ctx, types.NewMsgBindMarkerNameRequest(denom, "namecoin", "vault", markerAddr, false, authority))
+		s.Require().NoError(err, "BindMarkerName vault")
+		_, err = s.msgServer.DeleteMarkerName(s.ctx, types.NewMsgDeleteMarkerNameRequest(denom, "vault.namecoin", s.owner1))
+		s.Require().NoError(err, "DeleteMarkerName vault")
+		s.Assert().True(s.app.NameKeeper.NameExists(s.ctx, "vault.namecoin"), "NameExists(vault.namecoin)")
+		pending, err := s.app.NameKeeper.GetPendingDeletion(s.ctx, "vault.namecoin")
+		s.Require().NoError(err, "GetPendingDeletion(vault.namecoin)")
+		s.Require().NotNil(pending, "GetPendingDeletion(vault.namecoin)")
+		s.Assert().Equal(s.ctx.BlockHeight()+5, pending.DeleteHeight, "pending delete height")
+
+		_, err = s.msgServer.DeleteMarkerName(s.ctx, types.NewMsgDeleteMarkerNameRequest(denom, "vault.namecoin", s.owner1))
+		s.Assert().ErrorContains(err, "could not delete name \"vault.namecoin\"", "DeleteMarkerName vault again")
+	})
+}
+
+func (s *MsgServerTestSuite) TestEscrowStaking() {
+	denom := "stakecoin"
+	markerAddr := types.MustGetMarkerAddress(denom)
+	authority := s.app.MarkerKeeper.GetAuthority()
+
+	msg := types.NewMsgAddFinalizeActivateMarkerRequest(
+		denom, sdkmath.NewInt(100),
+		s.owner1Addr, s.owner1Addr, // From and Manager.
+		types.MarkerType_RestrictedCoin,
+		true,       // Supply fixed
+		true,       // Allow gov
+		false,      // don't allow forced transfer
+		[]string{}, // No required attributes.
+		[]types.AccessGrant{
+			{Address: s.owner1, Permissions: []types.Access{types.Access_Delegate}},
+			{Address: s.owner2, Permissions: []types.Access{types.Access_Admin, types.Access_Withdraw}},
+		},
+		0,
+		0,
+	)
+	_, err := s.msgServer.AddFinalizeActivateMarker(s.ctx, msg)
+	s.Require().NoError(err, "AddFinalizeActivateMarker(%q)", denom)
+
+	bondDenom, err := s.app.StakingKeeper.BondDenom(s.ctx)
+	s.Require().NoError(err, "BondDenom")
+	escrow := sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 1_000_000))
+	s.Require().NoError(testutil.FundAccount(types.WithBypass(s.ctx), s.app.BankKeeper, markerAddr, escrow), "FundAccount(marker)")
+
+	validators, err := s.app.StakingKeeper.GetAllValidators(s.ctx)
+	s.Require().NoError(err, "GetAllValidators")
+	s.Require().NotEmpty(validators, "GetAllValidators")
+	valAddr, err := sdk.ValAddressFromBech32(validators[0].GetOperator())
+	s.Require().NoError(err, "ValAddressFromBech32(%q)", validators[0].GetOperator())
+
+	delegated := func() sdkmath.Int {
+		del, err := s.app.StakingKeeper.GetDelegation(s.ctx, markerAddr, valAddr)
+		if err != nil {
+			return sdkmath.ZeroInt()
+		}
+		val, err := s.app.StakingKeeper.GetValidator(s.ctx, valAddr)
+		s.Require().NoError(err, "GetValidator")
+		return val.TokensFromShares(del.Shares).TruncateInt()
+	}
+
+	s.Run("delegate: signer does not have delegate", func() {
+		_, err := s.msgServer.DelegateEscrow(s.ctx, types.NewMsgDelegateEscrowRequest(denom, valAddr, sdk.NewInt64Coin(bondDenom, 100), s.owner2))
+		s.Assert().EqualError(err, s.noAccessErr(s.owner2, types.Access_Delegate, denom), "DelegateEscrow error")
+	})
+
+	s.Run("delegate: not the bond denom", func() {
+		_, err := s.msgServer.DelegateEscrow(s.ctx, types.NewMsgDelegateEscrowRequest(denom, valAddr, sdk.NewInt64Coin(denom, 10), s.owner1))
+		s.Assert().ErrorContains(err, "could not delegate 10stakecoin from stakecoin marker", "DelegateEscrow error")
+	})
+
+	s.Run("delegate: more than escrow", func() {
+		_, err := s.msgServer.DelegateEscrow(s.ctx, types.NewMsgDelegateEscrowRequest(denom, valAddr, sdk.NewInt64Coin(bondDenom, 2_000_000), s.owner1))
+		s.Assert().ErrorContains(err, "insufficient funds", "DelegateEscrow error")
+	})
+
+	s.Run("delegate: signer has delegate", func() {
+		_, err := s.msgServer.DelegateEscrow(s.ctx, types.NewMsgDelegateEscrowRequest(denom, valAddr, sdk.NewInt64Coin(bondDenom, 600_000), s.owner1))
+		s.Require().NoError(err, "DelegateEscrow error")
+		s.Assert().Equal(sdkmath.NewInt(600_000), delegated(), "delegated tokens")
+		s.Assert().Equal(sdkmath.NewInt(400_000), s.app.BankKeeper.GetBalance(s.ctx, markerAddr, bondDenom).Amount, "escrow balance")
+	})
+
+	s.Run("delegate: gov", func() {
+		_, err := s.msgServer.DelegateEscrow(s.ctx, types.NewMsgDelegateEscrowRequest(denom, valAddr, sdk.NewInt64Coin(bondDenom, 100_000), authority))
+		s.Require().NoError(err, "DelegateEscrow error")
+		s.Assert().Equal(sdkmath.NewInt(700_000), delegated(), "delegated tokens")
+	})
+
+	s.Run("collect rewards: signer does not have delegate", func() {
+		_, err := s.msgServer.CollectEscrowRewards(s.ctx, types.NewMsgCollectEscrowRewardsRequest(denom, valAddr, s.owner2))
+		s.Assert().EqualError(err, s.noAccessErr(s.owner2, types.Access_Delegate, denom), "CollectEscrowRewards error")
+	})
+
+	s.Run("collect rewards: signer has delegate", func() {
+		_, err := s.msgServer.CollectEscrowRewards(s.ctx, types.NewMsgCollectEscrowRewardsRequest(denom, valAddr, s.owner1))
+		s.Require().NoError(err, "CollectEscrowRewards error")
+	})
+
+	s.Run("undelegate: signer does not have delegate", func() {
+		_, err := s.msgServer.UndelegateEscrow(s.ctx, types.NewMsgUndelegateEscrowRequest(denom, valAddr, sdk.NewInt64Coin(bondDenom, 100), s.owner2))
+		s.Assert().EqualError(err, s.noAccessErr(s.owner2, types.Access_Delegate, denom), "UndelegateEscrow error")
+	})
+
+	s.Run("undelegate: signer has delegate", func() {
+		unbondingTime, err := s.app.StakingKeeper.UnbondingTime(s.ctx)
+		s.Require().NoError(err, "UnbondingTime")
+		resp, err := s.msgServer.UndelegateEscrow(s.ctx, types.NewMsgUndelegateEscrowRequest(denom, valAddr, sdk.NewInt64Coin(bondDenom, 200_000), s.owner1))
+		s.Require().NoError(err, "UndelegateEscrow error")
+		s.Assert().Equal(s.ctx.BlockTime().Add(unbondingTime).UTC(), resp.CompletionTime.UTC(), "CompletionTime")
+		s.Assert().Equal(sdkmath.NewInt(500_000), delegated(), "delegated tokens")
+	})
+}
+
+func (s *MsgServerTestSuite) TestMintAllowance() {
+	denom := "allowancecoin"
+	markerAddr := types.MustGetMarkerAddress(denom)
+	minter := sdk.AccAddress("minter______________")
+	authority := s.app.MarkerKeeper.GetAuthority()
+
+	attrOwner := sdk.AccAddress("attr_owner__________")
+	s.app.AccountKeeper.SetAccount(s.ctx, s.app.AccountKeeper.NewAccountWithAddress(s.ctx, attrOwner))
+	s.Require().NoError(s.app.NameKeeper.SetNameRecord(s.ctx, "kyc.allowance.io", attrOwner, false), "SetNameRecord kyc.allowance.io")
+
+	msg := types.NewMsgAddFinalizeActivateMarkerRequest(
+		denom, sdkmath.NewInt(100),
+		s.owner1Addr, s.owner1Addr, // From and Manager.
+		types.MarkerType_RestrictedCoin,
+		false,                        // Supply not fixed
+		true,                         // Allow gov
+		false,                        // don't allow forced transfer
+		[]string{"kyc.allowance.io"}, // Minters must have this attribute.
+		[]types.AccessGrant{
+			{Address: s.owner1, Permissions: []types.Access{types.Access_Mint}},
+			{Address: s.owner2, Permissions: []types.Access{types.Access_Admin, types.Access_Burn}},
+		},
+		0,
+		0,
+	)
+	_, err := s.msgServer.AddFinalizeActivateMarker(s.ctx, msg)
+	s.Require().NoError(err, "AddFinalizeActivateMarker(%q)", denom)
+
+	s.Run("set: signer does not have mint", func() {
+		_, err := s.msgServer.SetMintAllowance(s.ctx, types.NewMsgSetMintAllowanceRequest(sdk.NewInt64Coin(denom, 50), minter, s.owner2))
+		s.Assert().EqualError(err, s.noAccessErr(s.owner2, types.Access_Mint, denom)+": invalid request", "SetMintAllowance error")
+	})
+
+	s.Run("set: unknown marker", func() {
+		_, err := s.msgServer.SetMintAllowance(s.ctx, types.NewMsgSetMintAllowanceRequest(sdk.NewInt64Coin("nosuchcoin", 50), minter, s.owner1))
+		s.Assert().ErrorContains(err, "could not get nosuchcoin marker", "SetMintAllowance error")
+	})
+
+	s.Run("set: signer has mint", func() {
+		_, err := s.msgServer.SetMintAllowance(s.ctx, types.NewMsgSetMintAllowanceRequest(sdk.NewInt64Coin(denom, 50), minter, s.owner1))
+		s.Require().NoError(err, "SetMintAllowance error")
+		s.Assert().Equal(sdkmath.NewInt(50), s.app.MarkerKeeper.GetMintAllowance(s.ctx, markerAddr, minter), "GetMintAllowance")
+	})
+
+	s.Run("mint: minter missing required attribute", func() {
+		_, err := s.msgServer.MintFromAllowance(s.ctx, types.NewMsgMintFromAllowanceRequest(sdk.NewInt64Coin(denom, 10), minter))
+		s.Assert().EqualError(err, fmt.Sprintf("minter %s does not contain the %q required attribute: \"kyc.allowance.io\": invalid request", minter, denom), "MintFromAllowance error")
+	})
+
+	s.Require().NoError(s.app.AttributeKeeper.SetAttribute(s.ctx,
+		attrtypes.Attribute{
+			Name:          "kyc.allowance.io",
+			Value:         []byte("approved"),
+			Address:       minter.String(),
+			AttributeType: attrtypes.AttributeType_String,
+		},
+		attrOwner,
+	), "SetAttribute kyc.allowance.io")
+
+	s.Run("mint: more than allowance", func() {
+		_, err := s.msgServer.MintFromAllowance(s.ctx, types.NewMsgMintFromAllowanceRequest(sdk.NewInt64Coin(denom, 51), minter))
+		s.Assert().EqualError(err, fmt.Sprintf("%s cannot mint 51%s: mint allowance is 50: invalid request", minter, denom), "MintFromAllowance error")
+	})
+
+	s.Run("mint: no allowance", func() {
+		_, err := s.msgServer.MintFromAllowance(s.ctx, types.NewMsgMintFromAllowanceRequest(sdk.NewInt64Coin(denom, 1), s.owner2Addr))
+		s.Assert().EqualError(err, fmt.Sprintf("%s cannot mint 1%s: mint allowance is 0: invalid request", s.owner2, denom), "MintFromAllowance error")
+	})
+
+	s.Run("mint: within allowance", func() {
+		resp, err := s.msgServer.MintFromAllowance(s.ctx, types.NewMsgMintFromAllowanceRequest(sdk.NewInt64Coin(denom, 30), minter))
+		s.Require().NoError(err, "MintFromAllowance error")
+		s.Assert().Equal(sdk.NewInt64Coin(denom, 20), resp.Remaining, "Remaining")
+		s.Assert().Equal(sdkmath.NewInt(30), s.app.BankKeeper.GetBalance(s.ctx, minter, denom).Amount, "minter balance")
+		s.Assert().Equal(sdkmath.NewInt(130), s.app.BankKeeper.GetSupply(s.ctx, denom).Amount, "supply")
+	})
+
+	s.Run("query allowances", func() {
+		resp, err := s.app.MarkerKeeper.MintAllowances(s.ctx, &types.QueryMintAllowancesRequest{Id: denom})
+		s.Require().NoError(err, "MintAllowances error")
+		s.Assert().Equal([]types.MintAllowance{types.NewMintAllowance(denom, minter, sdkmath.NewInt(20))}, resp.MintAllowances, "MintAllowances")
+	})
+
+	s.Run("export genesis", func() {
+		genState := s.app.MarkerKeeper.ExportGenesis(s.ctx)
+		s.Assert().Contains(genState.MintAllowances, types.NewMintAllowance(denom, minter, sdkmath.NewInt(20)), "MintAllowances")
+	})
+
+	s.Run("mint: rest of allowance", func() {
+		resp, err := s.msgServer.MintFromAllowance(s.ctx, types.NewMsgMintFromAllowanceRequest(sdk.NewInt64Coin(denom, 20), minter))
+		s.Require().NoError(err, "MintFromAllowance error")
+		s.Assert().True(resp.Remaining.IsZero(), "Remaining zero")
+		s.Assert().True(s.app.MarkerKeeper.GetMintAllowance(s.ctx, markerAddr, minter).IsZero(), "GetMintAllowance zero")
+	})
+
+	s.Run("set: gov", func() {
+		_, err := s.msgServer.SetMintAllowance(s.ctx, types.NewMsgSetMintAllowanceRequest(sdk.NewInt64Coin(denom, 5), minter, authority))
+		s.Require().NoError(err, "SetMintAllowance error")
+		s.Assert().Equal(sdkmath.NewInt(5), s.app.MarkerKeeper.GetMintAllowance(s.ctx, markerAddr, minter), "GetMintAllowance")
+	})
+
+	s.Run("set: zero removes", func() {
+		_, err := s.msgServer.SetMintAllowance(s.ctx, types.NewMsgSetMintAllowanceRequest(sdk.NewInt64Coin(denom, 0), minter, s.owner1))
+		s.Require().NoError(err, "SetMintAllowance error")
+		resp, err := s.app.MarkerKeeper.MintAllowances(s.ctx, &types.QueryMintAllowancesRequest{Id: denom})
+		s.Require().NoError(err, "MintAllowances error")
+		s.Assert().Empty(resp.MintAllowances, "MintAllowances")
+	})
+}
+
+func (s *MsgServerTestSuite) TestDepositAllowList() {
+	denom := "depositcoin"
+	junkDenom := "junkcoin"
+	markerAddr := types.MustGetMarkerAddress(denom)
+	depositor := sdk.AccAddress("depositor___________")
+	stranger := sdk.AccAddress("stranger____________")
+	authority := s.app.MarkerKeeper.GetAuthority()
+
+	msg := types.NewMsgAddFinalizeActivateMarkerRequest(
+		denom, sdkmath.NewInt(100),
+		s.owner1Addr, s.owner1Addr, // From and Manager.
+		types.MarkerType_Coin,
+		false, // Supply not fixed
+		true,  // Allow gov
+		false, // don't allow forced transfer
+		[]string{},
+		[]types.AccessGrant{
+			{Address: s.owner1, Permissions: []types.Access{types.Access_Deposit}},
+			{Address: s.owner2, Permissions: []types.Access{types.Access_Admin}},
+		},
+		0,
+		0,
+	)
+	_, err := s.msgServer.AddFinalizeActivateMarker(s.ctx, msg)
+	s.Require().NoError(err, "AddFinalizeActivateMarker(%q)", denom)
+	junk := sdk.NewCoins(sdk.NewInt64Coin(junkDenom, 100))
+	for _, addr := range []sdk.AccAddress{depositor, stranger, s.owner1Addr} {
+		s.Require().NoError(testutil.FundAccount(s.ctx, s.app.BankKeeper, addr, junk), "FundAccount(%s)", addr)
+	}
+	deposit := func(from sdk.AccAddress) error {
+		return s.app.BankKeeper.SendCoins(s.ctx, from, markerAddr, sdk.NewCoins(sdk.NewInt64Coin(junkDenom, 1)))
+	}
+
+	s.Run("no list: anyone can deposit", func() {
+		s.Require().NoError(deposit(stranger), "deposit from stranger")
+	})
+
+	s.Run("update: signer does not have deposit", func() {
+		_, err := s.msgServer.UpdateDepositAllowList(s.ctx, types.NewMsgUpdateDepositAllowListRequest(denom, s.owner2, nil, []string{depositor.String()}))
+		s.Assert().EqualError(err, s.noAccessErr(s.owner2, types.Access_Deposit, denom)+": invalid request", "UpdateDepositAllowList error")
+	})
+
+	s.Run("update: remove address not on list", func() {
+		_, err := s.msgServer.UpdateDepositAllowList(s.ctx, types.NewMsgUpdateDepositAllowListRequest(denom, s.owner1, []string{depositor.String()}, nil))
+		s.Assert().EqualError(err, fmt.Sprintf("%s is not on the %s deposit allow list: invalid request", depositor, denom), "UpdateDepositAllowList error")
+	})
+
+	s.Run("update: add depositor", func() {
+		_, err := s.msgServer.UpdateDepositAllowList(s.ctx, types.NewMsgUpdateDepositAllowListRequest(denom, s.owner1, nil, []string{depositor.String()}))
+		s.Require().NoError(err, "UpdateDepositAllowList error")
+		s.Assert().True(s.app.MarkerKeeper.IsDepositAllowListed(s.ctx, markerAddr, depositor), "IsDepositAllowListed(depositor)")
+	})
+
+	s.Run("update: add address already on list", func() {
+		_, err := s.msgServer.UpdateDepositAllowList(s.ctx, types.NewMsgUpdateDepositAllowListRequest(denom, authority, nil, []string{depositor.String()}))
+		s.Assert().EqualError(err, fmt.Sprintf("%s is already on the %s deposit allow list: invalid request", depositor, denom), "UpdateDepositAllowList error")
+	})
+
+	s.Run("list: depositor can deposit", func() {
+		s.Require().NoError(deposit(depositor), "deposit from depositor")
+	})
+
+	s.Run("list: account with deposit access can deposit", func() {
+		s.Require().NoError(deposit(s.owner1Addr), "deposit from owner1")
+	})
+
+	s.Run("list: stranger cannot deposit", func() {
+		err := deposit(stranger)
+		s.Assert().EqualError(err, fmt.Sprintf("%s is not allowed to deposit funds into %s marker (%s)", stranger, denom, markerAddr), "deposit from stranger")
+	})
+
+	s.Run("query list", func() {
+		resp, err := s.app.MarkerKeeper.DepositAllowList(s.ctx, &types.QueryDepositAllowListRequest{Id: denom})
+		s.Require().NoError(err, "DepositAllowList error")
+		s.Assert().Equal([]string{depositor.String()}, resp.Addresses, "DepositAllowList addresses")
+	})
+
+	s.Run("export genesis", func() {
+		genState := s.app.MarkerKeeper.ExportGenesis(s.ctx)
+		s.Assert().Contains(genState.DepositAllowAddresses, types.DepositAllowAddress{MarkerAddress: markerAddr.String(), AllowAddress: depositor.String()}, "DepositAllowAddresses")
+	})
+
+	s.Run("update: gov removes depositor", func() {
+		_, err := s.msgServer.UpdateDepositAllowList(s.ctx, types.NewMsgUpdateDepositAllowListRequest(denom, authority, []string{depositor.String()}, nil))
+		s.Require().NoError(err, "UpdateDepositAllowList error")
+		s.Assert().False(s.app.MarkerKeeper.HasDepositAllowList(s.ctx, markerAddr), "HasDepositAllowList")
+	})
+
+	s.Run("emptied list: anyone can deposit", func() {
+		s.Require().NoError(deposit(stranger), "deposit from stranger")
+	})
+}
+
+func (s *MsgServerTestSuite) TestFeeSponsorship() {
+	denom := "sponsorcoin"
+	markerAddr := types.MustGetMarkerAddress(denom)
+	holder := sdk.AccAddress("holder______________")
+	smallHolder := sdk.AccAddress("smallHolder_________")
+	expiredHolder := sdk.AccAddress("expiredHolder_______")
+	authority := s.app.MarkerKeeper.GetAuthority()
+
+	msg := types.NewMsgAddFinalizeActivateMarkerRequest(
+		denom, sdkmath.NewInt(1000),
+		s.owner1Addr, s.owner1Addr, // From and Manager.
+		types.MarkerType_Coin,
+		false, // Supply not fixed
+		true,  // Allow gov
+		false, // don't allow forced transfer
+		[]string{},
+		[]types.AccessGrant{
+			{Address: s.owner1, Permissions: []types.Access{types.Access_Admin, types.Access_Withdraw}},
+		},
+		0,
+		0,
+	)
+	_, err := s.msgServer.AddFinalizeActivateMarker(s.ctx, msg)
+	s.Require().NoError(err, "AddFinalizeActivateMarker(%q)", denom)
+	_, err = s.msgServer.Withdraw(s.ctx, types.NewMsgWithdrawRequest(s.owner1Addr, holder, denom, sdk.NewCoins(sdk.NewInt64Coin(denom, 100))))
+	s.Require().NoError(err, "Withdraw to holder")
+	_, err = s.msgServer.Withdraw(s.ctx, types.NewMsgWithdrawRequest(s.owner1Addr, smallHolder, denom, sdk.NewCoins(sdk.NewInt64Coin(denom, 5))))
+	s.Require().NoError(err, "Withdraw to smallHolder")
+	_, err = s.msgServer.Withdraw(s.ctx, types.NewMsgWithdrawRequest(s.owner1Addr, expiredHolder, denom, sdk.NewCoins(sdk.NewInt64Coin(denom, 10))))
+	s.Require().NoError(err, "Withdraw to expiredHolder")
+
+	spendLimit := sdk.NewCoins(sdk.NewInt64Coin("nhash", 5000))
+	sponsorship := types.NewFeeSponsorship(denom, spendLimit, time.Hour, sdkmath.NewInt(10), nil)
+
+	s.Run("claim without sponsorship", func() {
+		_, err := s.msgServer.ClaimFeeSponsorship(s.ctx, types.NewMsgClaimFeeSponsorshipRequest(denom, holder.String()))
+		s.Assert().EqualError(err, denom+" marker does not have a fee sponsorship: invalid request", "ClaimFeeSponsorship error")
+	})
+
+	s.Run("set: signer does not have admin", func() {
+		_, err := s.msgServer.SetFeeSponsorship(s.ctx, types.NewMsgSetFeeSponsorshipRequest(sponsorship, s.owner2))
+		s.Assert().EqualError(err, s.noAccessErr(s.owner2, types.Access_Admin, denom)+": invalid request", "SetFeeSponsorship error")
+	})
+
+	s.Run("set: admin", func() {
+		_, err := s.msgServer.SetFeeSponsorship(s.ctx, types.NewMsgSetFeeSponsorshipRequest(sponsorship, s.owner1))
+		s.Require().NoError(err, "SetFeeSponsorship error")
+		resp, err := s.app.MarkerKeeper.FeeSponsorship(s.ctx, &types.QueryFeeSponsorshipRequest{Id: denom, Holder: holder.String()})
+		s.Require().NoError(err, "FeeSponsorship error")
+		s.Assert().Equal(&sponsorship, resp.Sponsorship, "FeeSponsorship sponsorship")
+		s.Assert().False(resp.Claimed, "FeeSponsorship claimed")
+	})
+
+	s.Run("claim: balance too low", func() {
+		_, err := s.msgServer.ClaimFeeSponsorship(s.ctx, types.NewMsgClaimFeeSponsorshipRequest(denom, smallHolder.String()))
+		s.Assert().EqualError(err, fmt.Sprintf("%s balance 5%s is less than the %s fee sponsorship min balance 10%s: invalid request",
+			smallHolder, denom, denom, denom), "ClaimFeeSponsorship error")
+	})
+
+	s.Run("claim: holder", func() {
+		_, err := s.msgServer.ClaimFeeSponsorship(s.ctx, types.NewMsgClaimFeeSponsorshipRequest(denom, holder.String()))
+		s.Require().NoError(err, "ClaimFeeSponsorship error")
+		grant, err := s.app.FeeGrantKeeper.GetAllowance(s.ctx, markerAddr, holder)
+		s.Require().NoError(err, "GetAllowance error")
+		basic, ok := grant.(*feegrant.BasicAllowance)
+		s.Require().True(ok, "allowance type %T", grant)
+		s.Assert().Equal(spendLimit, basic.SpendLimit, "allowance spend limit")
+		s.Require().NotNil(basic.Expiration, "allowance expiration")
+		s.Assert().Equal(s.ctx.BlockTime().Add(time.Hour), *basic.Expiration, "allowance expiration")
+		onHold, err := s.app.HoldKeeper.GetHoldCoins(s.ctx, holder)
+		s.Require().NoError(err, "GetHoldCoins error")
+		s.Assert().Equal(sdk.NewCoins(sdk.NewInt64Coin(denom, 10)).String(), onHold.String(), "funds on hold")
+		s.Assert().Equal(sdk.NewInt64Coin(denom, 90).String(), s.app.BankKeeper.SpendableCoin(s.ctx, holder, denom).String(), "spendable balance")
+	})
+
+	s.Run("claim: holder again", func() {
+		_, err := s.msgServer.ClaimFeeSponsorship(s.ctx, types.NewMsgClaimFeeSponsorshipRequest(denom, holder.String()))
+		s.Assert().EqualError(err, fmt.Sprintf("%s has already claimed a fee grant from the %s marker: invalid request", holder, denom), "ClaimFeeSponsorship error")
+	})
+
+	s.Run("hold details", func() {
+		details, err := s.app.MarkerKeeper.GetHoldDetails(s.ctx, holder)
+		s.Require().NoError(err, "GetHoldDetails error")
+		expected := []hold.HoldDetail{
+			hold.NewHoldDetail(types.HoldReasonCodeFeeSponsorship, types.ModuleName, holder.String(),
+				denom+" fee sponsorship", sdk.NewCoins(sdk.NewInt64Coin(denom, 10)),
+				"released by the holder once the fee grant is used up or expires, or when the fee sponsorship is removed"),
+		}
+		s.Assert().Equal(expected, details, "GetHoldDetails")
+	})
+
+	s.Run("release hold: grant not expired", func() {
+		_, err := s.msgServer.ReleaseFeeSponsorshipHold(s.ctx, types.NewMsgReleaseFeeSponsorshipHoldRequest(denom, holder.String()))
+		s.Assert().EqualError(err, fmt.Sprintf("%s fee grant from the %s marker has not been used up or expired: invalid request", holder, denom), "ReleaseFeeSponsorshipHold error")
+	})
+
+	s.Run("release hold: nothing held", func() {
+		_, err := s.msgServer.ReleaseFeeSponsorshipHold(s.ctx, types.NewMsgReleaseFeeSponsorshipHoldRequest(denom, smallHolder.String()))
+		s.Assert().EqualError(err, fmt.Sprintf("%s does not have funds on hold for a fee grant from the %s marker: invalid request", smallHolder, denom), "ReleaseFeeSponsorshipHold error")
+	})
+
+	s.Run("release hold: grant expired", func() {
+		_, err := s.msgServer.ClaimFeeSponsorship(s.ctx, types.NewMsgClaimFeeSponsorshipRequest(denom, expiredHolder.String()))
+		s.Require().NoError(err, "ClaimFeeSponsorship error")
+		s.Assert().True(s.app.BankKeeper.SpendableCoin(s.ctx, expiredHolder, denom).IsZero(), "spendable balance after claim")
+		laterCtx := s.ctx.WithBlockTime(s.ctx.BlockTime().Add(2 * time.Hour))
+		_, err = s.msgServer.ReleaseFeeSponsorshipHold(laterCtx, types.NewMsgReleaseFeeSponsorshipHoldRequest(denom, expiredHolder.String()))
+		s.Require().NoError(err, "ReleaseFeeSponsorshipHold error")
+		s.Assert().Equal(sdk.NewInt64Coin(denom, 10).String(), s.app.BankKeeper.SpendableCoin(s.ctx, expiredHolder, denom).String(), "spendable balance after release")
+		s.Assert().True(s.app.MarkerKeeper.HasClaimedFeeSponsorship(s.ctx, markerAddr, expiredHolder), "HasClaimedFeeSponsorship")
+		_, err = s.msgServer.ClaimFeeSponsorship(laterCtx, types.NewMsgClaimFeeSponsorshipRequest(denom, expiredHolder.String()))
+		s.Assert().EqualError(err, fmt.Sprintf("%s has already claimed a fee grant from the %s marker: invalid request", expiredHolder, denom), "ClaimFeeSponsorship error")
+	})
+
+	s.Run("export genesis", func() {
+		genState := s.app.MarkerKeeper.ExportGenesis(s.ctx)
+		s.Assert().Contains(genState.FeeSponsorships, sponsorship, "FeeSponsorships")
+		s.Assert().Contains(genState.FeeSponsorshipClaims, types.FeeSponsorshipClaim{Denom: denom, Holder: holder.String(), Held: sdkmath.NewInt(10)}, "FeeSponsorshipClaims")
+		s.Assert().Contains(genState.FeeSponsorshipClaims, types.FeeSponsorshipClaim{Denom: denom, Holder: expiredHolder.String(), Held: sdkmath.ZeroInt()}, "FeeSponsorshipClaims")
+	})
+
+	s.Run("remove: gov", func() {
+		_, err := s.msgServer.RemoveFeeSponsorship(s.ctx, types.NewMsgRemoveFeeSponsorshipRequest(denom, authority))
+		s.Require().NoError(err, "RemoveFeeSponsorship error")
+		sponsorship, err := s.app.MarkerKeeper.GetFeeSponsorship(s.ctx, markerAddr)
+		s.Require().NoError(err, "GetFeeSponsorship error")
+		s.Assert().Nil(sponsorship, "GetFeeSponsorship")
+		s.Assert().False(s.app.MarkerKeeper.HasClaimedFeeSponsorship(s.ctx, markerAddr, holder), "HasClaimedFeeSponsorship")
+		onHold, err := s.app.HoldKeeper.GetHoldCoins(s.ctx, holder)
+		s.Require().NoError(err, "GetHoldCoins error")
+		s.Assert().True(onHold.IsZero(), "funds on hold after removal: %s", onHold)
+	})
+
+	s.Run("remove: again", func() {
+		_, err := s.msgServer.RemoveFeeSponsorship(s.ctx, types.NewMsgRemoveFeeSponsorshipRequest(denom, s.owner1))
+		s.Assert().EqualError(err, denom+" marker does not have a fee sponsorship: invalid request", "RemoveFeeSponsorship error")
+	})
+}
+
+func (s *MsgServerTestSuite) TestBridgeInfo() {
+	denom := "wrappedcoin"
+	markerAddr := types.MustGetMarkerAddress(denom)
+	other := sdk.AccAddress("other_______________").String()
+	authority := s.app.MarkerKeeper.GetAuthority()
+
+	msg := types.NewMsgAddFinalizeActivateMarkerRequest(
+		denom, sdkmath.NewInt(1000),
+		s.owner1Addr, s.owner1Addr, // From and Manager.
+		types.MarkerType_Coin,
+		false, // Supply not fixed
+		true,  // Allow gov
+		false, // don't allow forced transfer
+		[]string{},
+		[]types.AccessGrant{
+			{Address: s.owner1, Permissions: []types.Access{types.Access_Admin, types.Access_Mint}},
+			{Address: s.owner2, Permissions: []types.Access{types.Access_Mint}},
+		},
+		0,
+		0,
+	)
+	_, err := s.msgServer.AddFinalizeActivateMarker(s.ctx, msg)
+	s.Require().NoError(err, "AddFinalizeActivateMarker(%q)", denom)
+
+	info := types.NewBridgeInfo(denom, "ethereum", "0xC02aaA39b223FE8D0A0e5C4F27eAD9083C756Cc2", "0x8EB8a3b98659Cce290402893d0123abb75E3ab28")
+	amount := sdk.NewInt64Coin(denom, 500)
+
+	s.Run("mint without bridge info", func() {
+		_, err := s.msgServer.AttestedMint(s.ctx, types.NewMsgAttestedMintRequest(amount, "0x01", s.owner1))
+		s.Assert().EqualError(err, denom+" marker does not have bridge info: invalid request", "AttestedMint error")
+	})
+
+	s.Run("set: signer does not have admin", func() {
+		_, err := s.msgServer.SetBridgeInfo(s.ctx, types.NewMsgSetBridgeInfoRequest(info, s.owner2))
+		s.Assert().EqualError(err, s.noAccessErr(s.owner2, types.Access_Admin, denom)+": invalid request", "SetBridgeInfo error")
+	})
+
+	s.Run("set: admin", func() {
+		_, err := s.msgServer.SetBridgeInfo(s.ctx, types.NewMsgSetBridgeInfoRequest(info, s.owner1))
+		s.Require().NoError(err, "SetBridgeInfo error")
+		resp, err := s.app.MarkerKeeper.BridgeInfo(s.ctx, &types.QueryBridgeInfoRequest{Id: denom})
+		s.Require().NoError(err, "BridgeInfo error")
+		s.Assert().Equal(&info, resp.BridgeInfo, "BridgeInfo bridge info")
+	})
+
+	s.Run("set: gov", func() {
+		info.Custodian = "0x40ec5B33f54e0E8A33A975908C5BA1c14e5BbbDf"
+		_, err := s.msgServer.SetBridgeInfo(s.ctx, types.NewMsgSetBridgeInfoRequest(info, authority))
+		s.Require().NoError(err, "SetBridgeInfo error")
+		got, err := s.app.MarkerKeeper.GetBridgeInfo(s.ctx, markerAddr)
+		s.Require().NoError(err, "GetBridgeInfo error")
+		s.Assert().Equal(&info, got, "GetBridgeInfo")
+	})
+
+	s.Run("mint: signer does not have mint", func() {
+		_, err := s.msgServer.AttestedMint(s.ctx, types.NewMsgAttestedMintRequest(amount, "0x01", other))
+		s.Assert().EqualError(err, s.noAccessErr(other, types.Access_Mint, denom)+": invalid request", "AttestedMint error")
+	})
+
+	s.Run("mint: minters", func() {
+		resp, err := s.msgServer.AttestedMint(s.ctx, types.NewMsgAttestedMintRequest(amount, "0x01", s.owner1))
+		s.Require().NoError(err, "AttestedMint error")
+		s.Assert().Equal(uint64(1), resp.Sequence, "AttestedMint sequence")
+		resp, err = s.msgServer.AttestedMint(s.ctx, types.NewMsgAttestedMintRequest(amount, "0x02", s.owner2))
+		s.Require().NoError(err, "AttestedMint error")
+		s.Assert().Equal(uint64(2), resp.Sequence, "AttestedMint sequence")
+
+		supply := s.app.BankKeeper.GetSupply(s.ctx, denom)
+		s.Assert().Equal(sdkmath.NewInt(2000), supply.Amount, "supply")
+
+		expected := []types.MintAttestation{
+			types.NewMintAttestation(denom, 1, s.ctx.BlockHeight(), amount.Amount, "0x01", s.owner1),
+			types.NewMintAttestation(denom, 2, s.ctx.BlockHeight(), amount.Amount, "0x02", s.owner2),
+		}
+		qResp, err := s.app.MarkerKeeper.MintAttestations(s.ctx, &types.QueryMintAttestationsRequest{Id: denom})
+		s.Require().NoError(err, "MintAttestations error")
+		s.Assert().Equal(expected, qResp.Attestations, "MintAttestations attestations")
+	})
+
+	s.Run("export genesis", func() {
+		genState := s.app.MarkerKeeper.ExportGenesis(s.ctx)
+		s.Assert().Contains(genState.BridgeInfos, info, "BridgeInfos")
+		s.Assert().Contains(genState.MintAttestations, types.NewMintAttestation(denom, 2, s.ctx.BlockHeight(), amount.Amount, "0x02", s.owner2), "MintAttestations")
+	})
+}
+
+func (s *MsgServerTestSuite) TestBasket() {
+	denom := "basketcoin"
+	markerAddr := types.MustGetMarkerAddress(denom)
+	authority := s.app.MarkerKeeper.GetAuthority()
+
+	msg := types.NewMsgAddFinalizeActivateMarkerRequest(
+		denom, sdkmath.NewInt(0),
+		s.owner1Addr, s.owner1Addr, // From and Manager.
+		types.MarkerType_Coin,
+		false, // Supply not fixed
+		true,  // Allow gov
+		false, // don't allow forced transfer
+		[]string{},
+		[]types.AccessGrant{
+			{Address: s.owner1, Permissions: []types.Access{types.Access_Admin, types.Access_Mint, types.Access_Burn, types.Access_Withdraw}},
+		},
+		0,
+		0,
+	)
+	_, err := s.msgServer.AddFinalizeActivateMarker(s.ctx, msg)
+	s.Require().NoError(err, "AddFinalizeActivateMarker(%q)", denom)
+
+	components := sdk.NewCoins(sdk.NewInt64Coin("acoin", 5), sdk.NewInt64Coin("bcoin", 2))
+	info := types.NewBasketInfo(denom, components, sdkmath.NewInt(10))
+	s.Require().NoError(testutil.FundAccount(s.ctx, s.app.BankKeeper, s.owner2Addr, sdk.NewCoins(sdk.NewInt64Coin("acoin", 100), sdk.NewInt64Coin("bcoin", 100))), "FundAccount(owner2)")
+	balances := func(addr sdk.AccAddress) sdk.Coins {
+		return s.app.BankKeeper.GetAllBalances(s.ctx, addr)
+	}
+
+	s.Run("deposit: not a basket", func() {
+		_, err := s.msgServer.BasketDeposit(s.ctx, types.NewMsgBasketDepositRequest(sdk.NewInt64Coin(denom, 10), s.owner2))
+		s.Assert().EqualError(err, denom+" is not a basket marker: invalid request", "BasketDeposit error")
+	})
+
+	s.Run("set: signer does not have admin", func() {
+		_, err := s.msgServer.SetBasketInfo(s.ctx, types.NewMsgSetBasketInfoRequest(info, s.owner2))
+		s.Assert().EqualError(err, s.noAccessErr(s.owner2, types.Access_Admin, denom)+": invalid request", "SetBasketInfo error")
+	})
+
+	s.Run("set: admin", func() {
+		_, err := s.msgServer.SetBasketInfo(s.ctx, types.NewMsgSetBasketInfoRequest(info, s.owner1))
+		s.Require().NoError(err, "SetBasketInfo error")
+		got, err := s.app.MarkerKeeper.GetBasketInfo(s.ctx, markerAddr)
+		s.Require().NoError(err, "GetBasketInfo error")
+		s.Assert().Equal(&info, got, "GetBasketInfo")
+	})
+
+	s.Run("mint: not allowed on a basket", func() {
+		_, err := s.msgServer.Mint(s.ctx, types.NewMsgMintRequest(s.owner1Addr, sdk.NewInt64Coin(denom, 10)))
+		s.Assert().ErrorContains(err, denom+" is a basket marker: its supply can only be changed by depositing or withdrawing its components", "Mint error")
+	})
+
+	s.Run("mint from allowance: not allowed on a basket", func() {
+		_, err := s.msgServer.SetMintAllowance(s.ctx, types.NewMsgSetMintAllowanceRequest(sdk.NewInt64Coin(denom, 10), s.owner2Addr, s.owner1))
+		s.Require().NoError(err, "SetMintAllowance error")
+		_, err = s.msgServer.MintFromAllowance(s.ctx, types.NewMsgMintFromAllowanceRequest(sdk.NewInt64Coin(denom, 10), s.owner2Addr))
+		s.Assert().ErrorContains(err, denom+" is a basket marker: its supply can only be changed by depositing or withdrawing its components", "MintFromAllowance error")
+		s.Assert().True(s.app.BankKeeper.GetSupply(s.ctx, denom).IsZero(), "supply")
+	})
+
+	s.Run("supply changes: not allowed on a basket", func() {
+		marker, err := s.app.MarkerKeeper.GetMarker(s.ctx, markerAddr)
+		s.Require().NoError(err, "GetMarker error")
+		err = s.app.MarkerKeeper.IncreaseSupply(s.ctx, marker, sdk.NewInt64Coin(denom, 10))
+		s.Assert().ErrorContains(err, denom+" is a basket marker", "IncreaseSupply error")
+		err = s.app.MarkerKeeper.DecreaseSupply(s.ctx, marker, sdk.NewInt64Coin(denom, 10))
+		s.Assert().ErrorContains(err, denom+" is a basket marker", "DecreaseSupply error")
+		err = s.app.MarkerKeeper.AdjustCirculation(s.ctx, marker, sdk.NewInt64Coin(denom, 10))
+		s.Assert().ErrorContains(err, denom+" is a basket marker", "AdjustCirculation error")
+		s.Assert().True(s.app.BankKeeper.GetSupply(s.ctx, denom).IsZero(), "supply")
+	})
+
+	s.Run("deposit: not a multiple of the basket amount", func() {
+		_, err := s.msgServer.BasketDeposit(s.ctx, types.NewMsgBasketDepositRequest(sdk.NewInt64Coin(denom, 15), s.owner2))
+		s.Assert().EqualError(err, "invalid basketcoin basket amount 15: must be a positive multiple of 10: invalid request", "BasketDeposit error")
+	})
+
+	s.Run("deposit", func() {
+		resp, err := s.msgServer.BasketDeposit(s.ctx, types.NewMsgBasketDepositRequest(sdk.NewInt64Coin(denom, 30), s.owner2))
+		s.Require().NoError(err, "BasketDeposit error")
+		expComponents := sdk.NewCoins(sdk.NewInt64Coin("acoin", 15), sdk.NewInt64Coin("bcoin", 6))
+		s.Assert().Equal(expComponents, resp.Components, "BasketDeposit components")
+		s.Assert().Equal("85acoin,94bcoin,30basketcoin", balances(s.owner2Addr).String(), "owner2 balances")
+		s.Assert().Equal(expComponents.String(), balances(markerAddr).String(), "marker balances")
+		s.Assert().Equal(sdkmath.NewInt(30), s.app.BankKeeper.GetSupply(s.ctx, denom).Amount, "supply")
+
+		qResp, err := s.app.MarkerKeeper.BasketInfo(s.ctx, &types.QueryBasketInfoRequest{Id: denom})
+		s.Require().NoError(err, "BasketInfo error")
+		s.Assert().Equal(&info, qResp.BasketInfo, "BasketInfo basket info")
+		s.Assert().Equal(expComponents, qResp.Backing, "BasketInfo backing")
+	})
+
+	s.Run("set: supply in circulation", func() {
+		_, err := s.msgServer.SetBasketInfo(s.ctx, types.NewMsgSetBasketInfoRequest(info, authority))
+		s.Assert().EqualError(err, "cannot set basket info for basketcoin marker with a supply of 30basketcoin: invalid request", "SetBasketInfo error")
+	})
+
+	s.Run("withdraw: backing cannot be taken by admin", func() {
+		_, err := s.msgServer.Withdraw(s.ctx, types.NewMsgWithdrawRequest(s.owner1Addr, s.owner1Addr, denom, sdk.NewCoins(sdk.NewInt64Coin("acoin", 1))))
+		s.Assert().EqualError(err, "cannot use 1acoin from basketcoin marker escrow: only 0acoin is not earmarked in escrow ledgers, reserved for scheduled burns, or backing basket coin: invalid request", "Withdraw error")
+	})
+
+	s.Run("withdraw", func() {
+		resp, err := s.msgServer.BasketWithdraw(s.ctx, types.NewMsgBasketWithdrawRequest(sdk.NewInt64Coin(denom, 20), s.owner2))
+		s.Require().NoError(err, "BasketWithdraw error")
+		expComponents := sdk.NewCoins(sdk.NewInt64Coin("acoin", 10), sdk.NewInt64Coin("bcoin", 4))
+		s.Assert().Equal(expComponents, resp.Components, "BasketWithdraw components")
+		s.Assert().Equal("95acoin,98bcoin,10basketcoin", balances(s.owner2Addr).String(), "owner2 balances")
+		s.Assert().Equal("5acoin,2bcoin", balances(markerAddr).String(), "marker balances")
+		s.Assert().Equal(sdkmath.NewInt(10), s.app.BankKeeper.GetSupply(s.ctx, denom).Amount, "supply")
+	})
+
+	s.Run("withdraw: more than held", func() {
+		_, err := s.msgServer.BasketWithdraw(s.ctx, types.NewMsgBasketWithdrawRequest(sdk.NewInt64Coin(denom, 20), s.owner2))
+		s.Assert().ErrorContains(err, "could not return basketcoin basket coin", "BasketWithdraw error")
+	})
+
+	s.Run("export genesis", func() {
+		genState := s.app.MarkerKeeper.ExportGenesis(s.ctx)
+		s.Assert().Contains(genState.BasketInfos, info, "BasketInfos")
+	})
+}
+
+func (s *MsgServerTestSuite) TestSetIBCMemoActionConfig() {
+	denom := "memocoin"
+	markerAddr := types.MustGetMarkerAddress(denom)
+	authority := s.app.MarkerKeeper.GetAuthority()
+
+	msg := types.NewMsgAddFinalizeActivateMarkerRequest(
+		denom, sdkmath.NewInt(100),
+		s.owner1Addr, s.owner1Addr, // From and Manager.
+		types.MarkerType_Coin,
+		false, // Supply not fixed
+		true,  // Allow gov
+		false, // don't allow forced transfer
+		[]string{},
+		[]types.AccessGrant{
+			{Address: s.owner1, Permissions: []types.Access{types.Access_Admin}},
+		},
+		0,
+		0,
+	)
+	_, err := s.msgServer.AddFinalizeActivateMarker(s.ctx, msg)
+	s.Require().NoError(err, "AddFinalizeActivateMarker(%q)", denom)
+
+	config := types.NewIBCMemoActionConfig(denom,
+		types.NewIBCMemoAction(types.IBCMemoActionAttribute, "subscribed.memocoin", ""),
+		types.NewIBCMemoAction(types.IBCMemoActionAttribute, "tier.memocoin", "gold"),
+	)
+
+	s.Run("set: signer does not have admin", func() {
+		_, err := s.msgServer.SetIBCMemoActionConfig(s.ctx, types.NewMsgSetIBCMemoActionConfigRequest(config, s.owner2))
+		s.Assert().EqualError(err, s.noAccessErr(s.owner2, types.Access_Admin, denom)+": invalid request", "SetIBCMemoActionConfig error")
+	})
+
+	s.Run("set: action without a handler", func() {
+		unknown := types.NewIBCMemoActionConfig(denom, types.NewIBCMemoAction("nope", "thing", ""))
+		_, err := s.msgServer.SetIBCMemoActionConfig(s.ctx, types.NewMsgSetIBCMemoActionConfigRequest(unknown, s.owner1))
+		s.Assert().EqualError(err, `"nope": unknown ibc memo action: invalid request`, "SetIBCMemoActionConfig error")
+	})
+
+	s.Run("set: admin", func() {
+		_, err := s.msgServer.SetIBCMemoActionConfig(s.ctx, types.NewMsgSetIBCMemoActionConfigRequest(config, s.owner1))
+		s.Require().NoError(err, "SetIBCMemoActionConfig error")
+		resp, err := s.app.MarkerKeeper.IBCMemoActionConfig(s.ctx, &types.QueryIBCMemoActionConfigRequest{Id: denom})
+		s.Require().NoError(err, "IBCMemoActionConfig error")
+		s.Assert().Equal(&config, resp.Config, "IBCMemoActionConfig config")
+	})
+
+	s.Run("export genesis", func() {
+		genState := s.app.MarkerKeeper.ExportGenesis(s.ctx)
+		s.Assert().Contains(genState.IbcMemoActionConfigs, config, "IbcMemoActionConfigs")
+	})
+
+	s.Run("remove: gov", func() {
+		_, err := s.msgServer.SetIBCMemoActionConfig(s.ctx, types.NewMsgSetIBCMemoActionConfigRequest(types.NewIBCMemoActionConfig(denom), authority))
+		s.Require().NoError(err, "SetIBCMemoActionConfig error")
+		got, err := s.app.MarkerKeeper.GetIBCMemoActionConfig(s.ctx, markerAddr)
+		s.Require().NoError(err, "GetIBCMemoActionConfig error")
+		s.Assert().Nil(got, "GetIBCMemoActionConfig")
+	})
+}
+
+func (s *MsgServerTestSuite) TestDistribution() {
+	denom := "divcoin"
+	payDenom := "paycoin"
+	holders := []sdk.AccAddress{
+		sdk.AccAddress("holder1_____________"),
+		sdk.AccAddress("holder2_____________"),
+		sdk.AccAddress("holder3_____________"),
+		sdk.AccAddress("holder4_____________"),
+	}
+	holdings := []int64{600, 300, 99, 1}
+	minPayout := sdk.NewInt64Coin(payDenom, 5)
+	s.ctx = s.ctx.WithBlockHeight(10)
+
+	msg := types.NewMsgAddFinalizeActivateMarkerRequest(
+		denom, sdkmath.NewInt(1000),
+		s.owner1Addr, s.owner1Addr, // From and Manager.
+		types.MarkerType_Coin,
+		false, // Supply not fixed
+		true,  // Allow gov
+		false, // don't allow forced transfer
+		[]string{},
+		[]types.AccessGrant{{Address: s.owner1, Permissions: []types.Access{types.Access_Admin, types.Access_Mint}}},
+		0,
+		0,
+	)
+	_, err := s.msgServer.AddFinalizeActivateMarker(s.ctx, msg)
+	s.Require().NoError(err, "AddFinalizeActivateMarker(%q)", denom)
+	for i, holder := range holders {
+		s.Require().NoError(testutil.FundAccount(s.ctx, s.app.BankKeeper, holder, sdk.NewCoins(sdk.NewInt64Coin(denom, holdings[i]))), "FundAccount(holder%d)", i+1)
+	}
+	s.Require().NoError(testutil.FundAccount(s.ctx, s.app.BankKeeper, s.owner1Addr, sdk.NewCoins(sdk.NewInt64Coin(payDenom, 2000))), "FundAccount(owner1)")
+
+	getDist := func(id uint64) types.Distribution {
+		resp, err := s.app.MarkerKeeper.Distribution(s.ctx, &types.QueryDistributionRequest{DistributionId: id})
+		s.Require().NoError(err, "Distribution(%d) error", id)
+		return resp.Distribution
+	}
+	payBal := func(addr sdk.AccAddress) int64 {
+		return s.app.BankKeeper.GetBalance(s.ctx, addr, payDenom).Amount.Int64()
+	}
+
+	s.Run("schedule: signer does not have admin", func() {
+		_, err := s.msgServer.ScheduleDistribution(s.ctx, types.NewMsgScheduleDistributionRequest(denom, sdk.NewInt64Coin(payDenom, 1003), 0, 0, nil, s.owner2Addr))
+		s.Assert().EqualError(err, s.noAccessErr(s.owner2, types.Access_Admin, denom)+": invalid request", "ScheduleDistribution error")
+	})
+
+	s.Run("schedule: start height already passed", func() {
+		_, err := s.msgServer.ScheduleDistribution(s.ctx, types.NewMsgScheduleDistributionRequest(denom, sdk.NewInt64Coin(payDenom, 1003), 9, 0, nil, s.owner1Addr))
+		s.Assert().EqualError(err, "start height 9 cannot be before the current block height 10: invalid request", "ScheduleDistribution error")
+	})
+
+	s.Run("schedule: success", func() {
+		resp, err := s.msgServer.ScheduleDistribution(s.ctx, types.NewMsgScheduleDistributionRequest(denom, sdk.NewInt64Coin(payDenom, 1003), 12, 2, &minPayout, s.owner1Addr))
+		s.Require().NoError(err, "ScheduleDistribution error")
+		s.Assert().Equal(uint64(1), resp.DistributionId, "DistributionId")
+		s.Assert().Equal(int64(997), payBal(s.owner1Addr), "owner1 balance")
+		dist := getDist(1)
+		s.Assert().Equal(types.DistributionPending, dist.Status, "Status")
+		s.Assert().Equal(uint32(2), dist.BatchSize, "BatchSize")
+	})
+
+	s.Run("cancel: not pending", func() {
+		resp, err := s.msgServer.ScheduleDistribution(s.ctx, types.NewMsgScheduleDistributionRequest(denom, sdk.NewInt64Coin(payDenom, 500), 0, 0, nil, s.owner1Addr))
+		s.Require().NoError(err, "ScheduleDistribution error")
+		s.Assert().Equal(uint64(2), resp.DistributionId, "DistributionId")
+		s.Assert().Equal(types.DefaultDistributionBatchSize, getDist(2).BatchSize, "BatchSize")
+
+		_, err = s.msgServer.CancelDistribution(s.ctx, types.NewMsgCancelDistributionRequest(2, s.owner2Addr))
+		s.Assert().EqualError(err, s.noAccessErr(s.owner2, types.Access_Admin, denom)+": invalid request", "CancelDistribution error")
+		_, err = s.msgServer.CancelDistribution(s.ctx, types.NewMsgCancelDistributionRequest(2, s.owner1Addr))
+		s.Require().NoError(err, "CancelDistribution error")
+		s.Assert().Equal(int64(997), payBal(s.owner1Addr), "owner1 balance")
+		s.Assert().Equal(types.DistributionCancelled, getDist(2).Status, "Status")
+		_, err = s.msgServer.CancelDistribution(s.ctx, types.NewMsgCancelDistributionRequest(2, s.owner1Addr))
+		s.Assert().EqualError(err, "cannot cancel distribution 2 with status DISTRIBUTION_STATUS_CANCELLED: invalid request", "CancelDistribution error")
+		_, err = s.msgServer.CancelDistribution(s.ctx, types.NewMsgCancelDistributionRequest(3, s.owner1Addr))
+		s.Assert().EqualError(err, "distribution 3 not found: not found", "CancelDistribution error")
+	})
+
+	s.Run("process: before start height", func() {
+		s.app.MarkerKeeper.ProcessDistributions(s.ctx)
+		s.Assert().Equal(types.DistributionPending, getDist(1).Status, "Status")
+	})
+
+	s.ctx = s.ctx.WithBlockHeight(12)
+	s.Run("process: first batch", func() {
+		s.app.MarkerKeeper.ProcessDistributions(s.ctx)
+		dist := getDist(1)
+		s.Assert().Equal(types.DistributionInProgress, dist.Status, "Status")
+		s.Assert().Equal(sdkmath.NewInt(1000), dist.TotalHeld, "TotalHeld")
+		// The batch can include the marker account, which doesn't get a payout.
+		s.Assert().NotZero(dist.HoldersProcessed, "HoldersProcessed")
+		s.Assert().LessOrEqual(dist.HoldersProcessed, uint64(2), "HoldersProcessed")
+		s.Assert().Equal(int64(601), payBal(holders[0]), "holder1 balance")
+		s.Assert().Equal(int64(0), payBal(holders[2]), "holder3 balance")
+	})
+
+	s.Run("send while in progress", func() {
+		err := s.app.BankKeeper.SendCoins(s.ctx, holders[2], holders[0], sdk.NewCoins(sdk.NewInt64Coin(denom, 99)))
+		s.Assert().ErrorContains(err, "cannot send divcoin coins while a distribution to its holders is in progress", "SendCoins error")
+		s.Assert().Equal(types.SendRuleDistribution, types.GetSendRule(err), "GetSendRule")
+	})
+
+	s.Run("process: until completed", func() {
+		for i := 0; i < 3 && getDist(1).Status == types.DistributionInProgress; i++ {
+			s.app.MarkerKeeper.ProcessDistributions(s.ctx)
+		}
+		dist := getDist(1)
+		s.Assert().Equal(types.DistributionCompleted, dist.Status, "Status")
+		s.Assert().Equal(uint64(4), dist.HoldersProcessed, "HoldersProcessed")
+		s.Assert().Equal(sdkmath.NewInt(1000), dist.Paid, "Paid")
+		s.Assert().Equal(sdkmath.NewInt(1), dist.Claimable, "Claimable")
+		s.Assert().Equal(sdkmath.NewInt(2), dist.Refunded, "Refunded")
+		s.Assert().Equal(int64(300), payBal(holders[1]), "holder2 balance")
+		s.Assert().Equal(int64(99), payBal(holders[2]), "holder3 balance")
+		s.Assert().Equal(int64(0), payBal(holders[3]), "holder4 balance")
+		s.Assert().Equal(int64(999), payBal(s.owner1Addr), "owner1 balance")
+
+		err := s.app.BankKeeper.SendCoins(s.ctx, holders[3], holders[2], sdk.NewCoins(sdk.NewInt64Coin(denom, 1)))
+		s.Assert().NoError(err, "SendCoins after completion")
+	})
+
+	s.Run("query claims", func() {
+		resp, err := s.app.MarkerKeeper.DistributionClaims(s.ctx, &types.QueryDistributionClaimsRequest{Address: holders[3].String()})
+		s.Require().NoError(err, "DistributionClaims error")
+		s.Assert().Equal([]types.DistributionClaim{types.NewDistributionClaim(1, holders[3], sdk.NewInt64Coin(payDenom, 1))}, resp.Claims, "Claims")
+	})
+
+	s.Run("query distributions", func() {
+		resp, err := s.app.MarkerKeeper.Distributions(s.ctx, &types.QueryDistributionsRequest{Id: denom})
+		s.Require().NoError(err, "Distributions error")
+		s.Require().Len(resp.Distributions, 2, "Distributions")
+		s.Assert().Equal(uint64(1), resp.Distributions[0].Id, "Distributions[0].Id")
+		s.Assert().Equal(uint64(2), resp.Distributions[1].Id, "Distributions[1].Id")
+	})
+
+	s.Run("export genesis", func() {
+		genState := s.app.MarkerKeeper.ExportGenesis(s.ctx)
+		s.Assert().Len(genState.Distributions, 2, "Distributions")
+		s.Assert().Equal([]types.DistributionClaim{types.NewDistributionClaim(1, holders[3], sdk.NewInt64Coin(payDenom, 1))}, genState.DistributionClaims, "DistributionClaims")
+	})
+
+	s.Run("claim", func() {
+		_, err := s.msgServer.ClaimDistribution(s.ctx, types.NewMsgClaimDistributionRequest(1, holders[2]))
+		s.Assert().EqualError(err, fmt.Sprintf("%s does not have a claim in distribution 1: invalid request", holders[2]), "ClaimDistribution error")
+
+		resp, err := s.msgServer.ClaimDistribution(s.ctx, types.NewMsgClaimDistributionRequest(1, holders[3]))
+		s.Require().NoError(err, "ClaimDistribution error")
+		s.Assert().Equal(sdk.NewInt64Coin(payDenom, 1), resp.Amount, "Amount")
+		s.Assert().Equal(int64(1), payBal(holders[3]), "holder4 balance")
+		dist := getDist(1)
+		s.Assert().Equal(sdkmath.NewInt(1001), dist.Paid, "Paid")
+		s.Assert().True(dist.Claimable.IsZero(), "Claimable zero")
+		s.Assert().True(s.app.BankKeeper.GetBalance(s.ctx, authtypes.NewModuleAddress(types.ModuleName), payDenom).IsZero(), "module balance zero")
+
+		_, err = s.msgServer.ClaimDistribution(s.ctx, types.NewMsgClaimDistributionRequest(1, holders[3]))
+		s.Assert().EqualError(err, fmt.Sprintf("%s does not have a claim in distribution 1: invalid request", holders[3]), "ClaimDistribution error")
+	})
+
+	s.Run("process: holders per block", func() {
+		params := s.app.MarkerKeeper.GetParams(s.ctx)
+		params.MaxDistributionHoldersPerBlock = 2
+		s.app.MarkerKeeper.SetParams(s.ctx, params)
+		for _, id := range []uint64{3, 4} {
+			resp, err := s.msgServer.ScheduleDistribution(s.ctx, types.NewMsgScheduleDistributionRequest(denom, sdk.NewInt64Coin(payDenom, 10), 0, 2, nil, s.owner1Addr))
+			s.Require().NoError(err, "ScheduleDistribution error")
+			s.Require().Equal(id, resp.DistributionId, "DistributionId")
+		}
+
+		// The first distribution uses up the holders that can be processed in the block.
+		s.app.MarkerKeeper.ProcessDistributions(s.ctx)
+		s.Assert().Equal(types.DistributionInProgress, getDist(3).Status, "distribution 3 Status")
+		s.Assert().Equal(types.DistributionPending, getDist(4).Status, "distribution 4 Status")
+
+		params.MaxDistributionHoldersPerBlock = 0
+		s.app.MarkerKeeper.SetParams(s.ctx, params)
+		for i := 0; i < 3 && getDist(4).Status != types.DistributionCompleted; i++ {
+			s.app.MarkerKeeper.ProcessDistributions(s.ctx)
+		}
+		s.Assert().Equal(types.DistributionCompleted, getDist(3).Status, "distribution 3 Status")
+		s.Assert().Equal(types.DistributionCompleted, getDist(4).Status, "distribution 4 Status")
+	})
+
+	s.Run("process: batch fails", func() {
+		ownerBal := payBal(s.owner1Addr)
+		resp, err := s.msgServer.ScheduleDistribution(s.ctx, types.NewMsgScheduleDistributionRequest(denom, sdk.NewInt64Coin(payDenom, 10), 0, 2, nil, s.owner1Addr))
+		s.Require().NoError(err, "ScheduleDistribution error")
+		s.Require().Equal(ownerBal-10, payBal(s.owner1Addr), "owner1 balance after schedule")
+
+		bankKeeper := NewWrappedBankKeeper().WithParent(s.app.BankKeeper).WithDenomOwnersErrs("injected error")
+		s.ctx = s.ctx.WithEventManager(sdk.NewEventManager())
+		s.app.MarkerKeeper.WithBankKeeper(bankKeeper).ProcessDistributions(s.ctx)
+
+		dist := getDist(resp.DistributionId)
+		s.Assert().Equal(types.DistributionFailed, dist.Status, "Status")
+		// Nothing from the failed batch is kept.
+		s.Assert().True(dist.TotalHeld.IsZero(), "TotalHeld zero")
+		s.Assert().Equal(sdkmath.NewInt(10), dist.Refunded, "Refunded")
+		s.Assert().Equal(ownerBal, payBal(s.owner1Addr), "owner1 balance after failure")
+		expEvent, err := sdk.TypedEventToEvent(&types.EventDistributionFailed{
+			DistributionId: resp.DistributionId,
+			Denom:          denom,
+			Refunded:       "10" + payDenom,
+			Error:          "could not get divcoin holders: injected error",
+		})
+		s.Require().NoError(err, "TypedEventToEvent")
+		s.Assert().Contains(s.ctx.EventManager().Events(), expEvent, "events")
+
+		err = s.app.BankKeeper.SendCoins(s.ctx, holders[2], holders[3], sdk.NewCoins(sdk.NewInt64Coin(denom, 1)))
+		s.Assert().NoError(err, "SendCoins after failure")
+	})
+
+	s.Run("schedule: distribution fee", func() {
+		feeCollector := authtypes.NewModuleAddress(authtypes.FeeCollectorName)
+		params := s.app.MarkerKeeper.GetParams(s.ctx)
+		params.DistributionFee = sdk.NewCoins(sdk.NewInt64Coin(payDenom, 7))
+		s.app.MarkerKeeper.SetParams(s.ctx, params)
+		ownerBal := payBal(s.owner1Addr)
+		feeBal := payBal(feeCollector)
+
+		resp, err := s.msgServer.ScheduleDistribution(s.ctx, types.NewMsgScheduleDistributionRequest(denom, sdk.NewInt64Coin(payDenom, 10), 100, 0, nil, s.owner1Addr))
+		s.Require().NoError(err, "ScheduleDistribution error")
+		s.Assert().Equal(ownerBal-17, payBal(s.owner1Addr), "owner1 balance after schedule")
+		s.Assert().Equal(feeBal+7, payBal(feeCollector), "fee collector balance after schedule")
+
+		// The fee is not refunded when the distribution is cancelled.
+		_, err = s.msgServer.CancelDistribution(s.ctx, types.NewMsgCancelDistributionRequest(resp.DistributionId, s.owner1Addr))
+		s.Require().NoError(err, "CancelDistribution error")
+		s.Assert().Equal(ownerBal-7, payBal(s.owner1Addr), "owner1 balance after cancel")
+	})
+}
+
+func (s *MsgServerTestSuite) TestEscrowLedgers() {
+	denom := "ledgercoin"
+	msg := types.NewMsgAddFinalizeActivateMarkerRequest(
+		denom, sdkmath.NewInt(1000),
+		s.owner1Addr, s.owner1Addr, // From and Manager.
+		types.MarkerType_Coin,
+		false, // Supply not fixed
+		true,  // Allow gov
+		false, // don't allow forced transfer
+		[]string{},
+		[]types.AccessGrant{
+			{Address: s.owner1, Permissions: []types.Access{types.Access_Admin, types.Access_Withdraw, types.Access_Burn}},
+			{Address: s.owner2, Permissions: []types.Access{types.Access_Withdraw}},
+		},
+		0,
+		0,
+	)
+	_, err := s.msgServer.AddFinalizeActivateMarker(s.ctx, msg)
+	s.Require().NoError(err, "AddFinalizeActivateMarker(%q)", denom)
+	markerAddr := types.MustGetMarkerAddress(denom)
+	authority := s.app.MarkerKeeper.GetAuthority()
+	authorityAddr := sdk.MustAccAddressFromBech32(authority)
+
+	coins := func(amount int64) sdk.Coins {
+		return sdk.NewCoins(sdk.NewInt64Coin(denom, amount))
+	}
+	getLedgers := func() *types.QueryEscrowLedgersResponse {
+		resp, err := s.app.MarkerKeeper.EscrowLedgers(s.ctx, &types.QueryEscrowLedgersRequest{Id: denom})
+		s.Require().NoError(err, "EscrowLedgers error")
+		return resp
+	}
+
+	s.Run("allocate: signer does not have admin", func() {
+		_, err := s.msgServer.AllocateEscrow(s.ctx, types.NewMsgAllocateEscrowRequest(denom, "reserves", coins(600), s.owner2Addr))
+		s.Assert().EqualError(err, s.noAccessErr(s.owner2, types.Access_Admin, denom)+": invalid request", "AllocateEscrow error")
+	})
+
+	s.Run("allocate: success", func() {
+		_, err := s.msgServer.AllocateEscrow(s.ctx, types.NewMsgAllocateEscrowRequest(denom, "reserves", coins(600), s.owner1Addr))
+		s.Require().NoError(err, "AllocateEscrow error")
+		resp := getLedgers()
+		s.Assert().Equal([]types.EscrowLedger{types.NewEscrowLedger(denom, "reserves", coins(600))}, resp.Ledgers, "Ledgers")
+		s.Assert().Equal(coins(400).String(), resp.Unearmarked.String(), "Unearmarked")
+	})
+
+	s.Run("allocate: funds already earmarked", func() {
+		_, err := s.msgServer.AllocateEscrow(s.ctx, types.NewMsgAllocateEscrowRequest(denom, "operations", coins(500), s.owner1Addr))
+		s.Assert().EqualError(err, `cannot earmark 500ledgercoin in ledgercoin escrow ledger "operations": only "400ledgercoin" is not already earmarked: invalid request`, "AllocateEscrow error")
+	})
+
+	s.Run("withdraw: earmarked funds", func() {
+		_, err := s.msgServer.Withdraw(s.ctx, types.NewMsgWithdrawRequest(s.owner1Addr, s.owner1Addr, denom, coins(500)))
+		s.Assert().EqualError(err, `cannot use 500ledgercoin from ledgercoin marker escrow: only 400ledgercoin is not earmarked in escrow ledgers, reserved for scheduled burns, or backing basket coin: invalid request`, "Withdraw error")
+		_, err = s.msgServer.Withdraw(s.ctx, types.NewMsgWithdrawRequest(s.owner1Addr, s.owner1Addr, denom, coins(100)))
+		s.Require().NoError(err, "Withdraw error")
+	})
+
+	s.Run("burn: earmarked funds", func() {
+		_, err := s.msgServer.Burn(s.ctx, types.NewMsgBurnRequest(s.owner1Addr, sdk.NewInt64Coin(denom, 400)))
+		s.Assert().EqualError(err, `cannot use 400ledgercoin from ledgercoin marker escrow: only 300ledgercoin is not earmarked in escrow ledgers, reserved for scheduled burns, or backing basket coin: invalid request`, "Burn error")
+	})
+
+	s.Run("withdraw from escrow: no limit", func() {
+		_, err := s.msgServer.WithdrawFromEscrow(s.ctx, types.NewMsgWithdrawFromEscrowRequest(denom, "reserves", coins(150), nil, s.owner2Addr))
+		s.Assert().EqualError(err, s.owner2+` cannot withdraw 150ledgercoin from ledgercoin escrow ledger "reserves": withdraw limit is "": invalid request`, "WithdrawFromEscrow error")
+	})
+
+	s.Run("withdraw from escrow: success", func() {
+		_, err := s.msgServer.SetEscrowWithdrawLimit(s.ctx, types.NewMsgSetEscrowWithdrawLimitRequest(denom, "reserves", s.owner2Addr, coins(200), s.owner1Addr))
+		s.Assert().EqualError(err, fmt.Sprintf("expected %s got %s: expected gov account as only signer for proposal message", authority, s.owner1), "SetEscrowWithdrawLimit error")
+		_, err = s.msgServer.SetEscrowWithdrawLimit(s.ctx, types.NewMsgSetEscrowWithdrawLimitRequest(denom, "reserves", s.owner2Addr, coins(200), authorityAddr))
+		s.Require().NoError(err, "SetEscrowWithdrawLimit error")
+
+		resp, err := s.msgServer.WithdrawFromEscrow(s.ctx, types.NewMsgWithdrawFromEscrowRequest(denom, "reserves", coins(150), nil, s.owner2Addr))
+		s.Require().NoError(err, "WithdrawFromEscrow error")
+		s.Assert().Equal(coins(50).String(), resp.RemainingLimit.String(), "RemainingLimit")
+		s.Assert().Equal(int64(150), s.app.BankKeeper.GetBalance(s.ctx, s.owner2Addr, denom).Amount.Int64(), "owner2 balance")
+		s.Assert().Equal(coins(450).String(), getLedgers().Ledgers[0].Balance.String(), "reserves balance")
+
+		limits, err := s.app.MarkerKeeper.EscrowWithdrawLimits(s.ctx, &types.QueryEscrowWithdrawLimitsRequest{Id: denom, Ledger: "reserves"})
+		s.Require().NoError(err, "EscrowWithdrawLimits error")
+		s.Assert().Equal([]types.EscrowWithdrawLimit{types.NewEscrowWithdrawLimit(denom, "reserves", s.owner2Addr, coins(50))}, limits.WithdrawLimits, "WithdrawLimits")
+	})
+
+	s.Run("withdraw from escrow: over limit", func() {
+		_, err := s.msgServer.WithdrawFromEscrow(s.ctx, types.NewMsgWithdrawFromEscrowRequest(denom, "reserves", coins(100), nil, s.owner2Addr))
+		s.Assert().EqualError(err, s.owner2+` cannot withdraw 100ledgercoin from ledgercoin escrow ledger "reserves": withdraw limit is "50ledgercoin": invalid request`, "WithdrawFromEscrow error")
+	})
+
+	s.Run("release: admin cannot release", func() {
+		_, err := s.msgServer.ReleaseEscrow(s.ctx, types.NewMsgReleaseEscrowRequest(denom, "reserves", coins(450), s.owner1Addr))
+		s.Assert().EqualError(err, fmt.Sprintf("expected %s got %s: expected gov account as only signer for proposal message", authority, s.owner1), "ReleaseEscrow error")
+	})
+
+	s.Run("release", func() {
+		_, err := s.msgServer.ReleaseEscrow(s.ctx, types.NewMsgReleaseEscrowRequest(denom, "reserves", coins(500), authorityAddr))
+		s.Assert().EqualError(err, `cannot release 500ledgercoin from ledgercoin escrow ledger "reserves": balance is "450ledgercoin": invalid request`, "ReleaseEscrow error")
+		_, err = s.msgServer.ReleaseEscrow(s.ctx, types.NewMsgReleaseEscrowRequest(denom, "reserves", coins(450), authorityAddr))
+		s.Require().NoError(err, "ReleaseEscrow error")
+		resp := getLedgers()
+		s.Assert().Empty(resp.Ledgers, "Ledgers")
+		s.Assert().Equal(coins(750).String(), resp.Unearmarked.String(), "Unearmarked")
+		s.Assert().Equal(int64(750), s.app.BankKeeper.GetBalance(s.ctx, markerAddr, denom).Amount.Int64(), "marker balance")
+	})
+}
+
+func (s *MsgServerTestSuite) TestScheduledBurns() {
+	denom := "burncoin"
+	s.ctx = s.ctx.WithBlockHeight(20)
+	msg := types.NewMsgAddFinalizeActivateMarkerRequest(
+		denom, sdkmath.NewInt(1000),
+		s.owner1Addr, s.owner1Addr, // From and Manager.
+		types.MarkerType_Coin,
+		false, // Supply not fixed
+		true,  // Allow gov
+		false, // don't allow forced transfer
+		[]string{},
+		[]types.AccessGrant{
+			{Address: s.owner1, Permissions: []types.Access{types.Access_Admin, types.Access_Withdraw, types.Access_Burn}},
+			{Address: s.owner2, Permissions: []types.Access{types.Access_Withdraw}},
+		},
+		0,
+		0,
+	)
+	_, err := s.msgServer.AddFinalizeActivateMarker(s.ctx, msg)
+	s.Require().NoError(err, "AddFinalizeActivateMarker(%q)", denom)
+	markerAddr := types.MustGetMarkerAddress(denom)
+
+	coin := func(amount int64) sdk.Coin {
+		return sdk.NewInt64Coin(denom, amount)
+	}
+	getBurns := func() []types.ScheduledBurn {
+		resp, err := s.app.MarkerKeeper.ScheduledBurns(s.ctx, &types.QueryScheduledBurnsRequest{Id: denom})
+		s.Require().NoError(err, "ScheduledBurns error")
+		return resp.Burns
+	}
+	supply := func() int64 {
+		return s.app.BankKeeper.GetSupply(s.ctx, denom).Amount.Int64()
+	}
+
+	s.Run("schedule: signer does not have burn", func() {
+		_, err := s.msgServer.ScheduleBurn(s.ctx, types.NewMsgScheduleBurnRequest(coin(100), 25, 0, s.owner2Addr))
+		s.Assert().EqualError(err, s.noAccessErr(s.owner2, types.Access_Burn, denom)+": invalid request", "ScheduleBurn error")
+	})
+
+	s.Run("schedule: burn height not in the future", func() {
+		_, err := s.msgServer.ScheduleBurn(s.ctx, types.NewMsgScheduleBurnRequest(coin(100), 20, 0, s.owner1Addr))
+		s.Assert().EqualError(err, "burn height 20 must be after the current block height 20: invalid request", "ScheduleBurn error")
+	})
+
+	s.Run("schedule: cancel window closes after burn height", func() {
+		_, err := s.msgServer.ScheduleBurn(s.ctx, types.NewMsgScheduleBurnRequest(coin(100), 25, 6, s.owner1Addr))
+		s.Assert().EqualError(err, "cancel window of 6 blocks cannot close after burn height 25: invalid request", "ScheduleBurn error")
+	})
+
+	s.Run("schedule: more than escrow", func() {
+		_, err := s.msgServer.ScheduleBurn(s.ctx, types.NewMsgScheduleBurnRequest(coin(1001), 25, 0, s.owner1Addr))
+		s.Assert().EqualError(err, "cannot schedule a burn of 1001burncoin: burncoin marker escrow only holds 1000burncoin: invalid request", "ScheduleBurn error")
+	})
+
+	s.Run("schedule: success", func() {
+		s.ctx = s.ctx.WithEventManager(sdk.NewEventManager())
+		resp, err := s.msgServer.ScheduleBurn(s.ctx, types.NewMsgScheduleBurnRequest(coin(600), 25, 3, s.owner1Addr))
+		s.Require().NoError(err, "ScheduleBurn error")
+		s.Assert().Equal(uint64(1), resp.BurnId, "BurnId")
+		expBurn := types.NewScheduledBurn(1, coin(600), s.owner1, 20, 25, 23)
+		s.Assert().Equal([]types.ScheduledBurn{expBurn}, getBurns(), "ScheduledBurns")
+		s.Assert().True(s.containsMessage(s.ctx.EventManager().ABCIEvents(), types.NewEventBurnScheduled(expBurn)), "EventBurnScheduled emitted")
+	})
+
+	s.Run("reserved: withdraw and burn", func() {
+		_, err := s.msgServer.Withdraw(s.ctx, types.NewMsgWithdrawRequest(s.owner1Addr, s.owner1Addr, denom, sdk.NewCoins(coin(500))))
+		s.Assert().EqualError(err, `cannot use 500burncoin from burncoin marker escrow: only 400burncoin is not earmarked in escrow ledgers, reserved for scheduled burns, or backing basket coin: invalid request`, "Withdraw error")
+		_, err = s.msgServer.Burn(s.ctx, types.NewMsgBurnRequest(s.owner1Addr, coin(500)))
+		s.Assert().EqualError(err, `cannot use 500burncoin from burncoin marker escrow: only 400burncoin is not earmarked in escrow ledgers, reserved for scheduled burns, or backing basket coin: invalid request`, "Burn error")
+		_, err = s.msgServer.ScheduleBurn(s.ctx, types.NewMsgScheduleBurnRequest(coin(500), 25, 0, s.owner1Addr))
+		s.Assert().EqualError(err, `cannot use 500burncoin from burncoin marker escrow: only 400burncoin is not earmarked in escrow ledgers, reserved for scheduled burns, or backing basket coin: invalid request`, "ScheduleBurn error")
+	})
+
+	s.Run("cancel", func() {
+		resp, err := s.msgServer.ScheduleBurn(s.ctx, types.NewMsgScheduleBurnRequest(coin(100), 30, 1, s.owner1Addr))
+		s.Require().NoError(err, "ScheduleBurn error")
+		s.Assert().Equal(uint64(2), resp.BurnId, "BurnId")
+
+		_, err = s.msgServer.CancelScheduledBurn(s.ctx, types.NewMsgCancelScheduledBurnRequest(2, s.owner2Addr))
+		s.Assert().EqualError(err, s.noAccessErr(s.owner2, types.Access_Burn, denom)+": invalid request", "CancelScheduledBurn error")
+		_, err = s.msgServer.CancelScheduledBurn(s.ctx, types.NewMsgCancelScheduledBurnRequest(2, s.owner1Addr))
+		s.Require().NoError(err, "CancelScheduledBurn error")
+		s.Assert().Len(getBurns(), 1, "ScheduledBurns")
+		_, err = s.msgServer.CancelScheduledBurn(s.ctx, types.NewMsgCancelScheduledBurnRequest(2, s.owner1Addr))
+		s.Assert().EqualError(err, "scheduled burn 2 not found: not found", "CancelScheduledBurn error")
+	})
+
+	s.Run("cancel: window closed", func() {
+		resp, err := s.msgServer.ScheduleBurn(s.ctx, types.NewMsgScheduleBurnRequest(coin(100), 22, 0, s.owner1Addr))
+		s.Require().NoError(err, "ScheduleBurn error")
+		s.Assert().Equal(uint64(3), resp.BurnId, "BurnId")
+		_, err = s.msgServer.CancelScheduledBurn(s.ctx, types.NewMsgCancelScheduledBurnRequest(3, s.owner1Addr))
+		s.Assert().EqualError(err, "cannot cancel scheduled burn 3: cancel window closed at height 20: invalid request", "CancelScheduledBurn error")
+	})
+
+	s.Run("export genesis", func() {
+		genState := s.app.MarkerKeeper.ExportGenesis(s.ctx)
+		s.Assert().Len(genState.ScheduledBurns, 2, "ScheduledBurns")
+	})
+
+	s.Run("process: before burn height", func() {
+		s.app.MarkerKeeper.ProcessScheduledBurns(s.ctx)
+		s.Assert().Len(getBurns(), 2, "ScheduledBurns")
+		s.Assert().Equal(int64(1000), supply(), "supply")
+	})
+
+	s.ctx = s.ctx.WithBlockHeight(22)
+	s.Run("process: burn with proof", func() {
+		s.ctx = s.ctx.WithEventManager(sdk.NewEventManager())
+		s.app.MarkerKeeper.ProcessScheduledBurns(s.ctx)
+		s.Assert().Equal([]types.ScheduledBurn{types.NewScheduledBurn(1, coin(600), s.owner1, 20, 25, 23)}, getBurns(), "ScheduledBurns")
+		s.Assert().Equal(int64(900), supply(), "supply")
+		s.Assert().Equal(int64(900), s.app.BankKeeper.GetBalance(s.ctx, markerAddr, denom).Amount.Int64(), "marker balance")
+		expEvent := types.NewEventMarkerBurnProof(types.NewScheduledBurn(3, coin(100), s.owner1, 20, 22, 20), coin(1000), coin(900))
+		s.Assert().True(s.containsMessage(s.ctx.EventManager().ABCIEvents(), expEvent), "EventMarkerBurnProof emitted")
+	})
+
+	s.ctx = s.ctx.WithBlockHeight(26)
+	s.Run("process: insufficient escrow", func() {
+		// Governance can take reserved coin out of the marker, which makes the burn fail.
+		s.Require().NoError(s.app.MarkerKeeper.HandleWithdrawEscrowProposal(s.ctx, denom, s.owner1, sdk.NewCoins(coin(400))), "HandleWithdrawEscrowProposal")
+		s.ctx = s.ctx.WithEventManager(sdk.NewEventManager())
+		s.app.MarkerKeeper.ProcessScheduledBurns(s.ctx)
+		s.Assert().Empty(getBurns(), "ScheduledBurns")
+		s.Assert().Equal(int64(900), supply(), "supply")
+		expEvent := types.NewEventScheduledBurnFailed(types.NewScheduledBurn(1, coin(600), s.owner1, 20, 25, 23),
+			fmt.Errorf("marker account contains insufficient funds to burn burncoin, 600"))
+		s.Assert().True(s.containsMessage(s.ctx.EventManager().ABCIEvents(), expEvent), "EventScheduledBurnFailed emitted")
+	})
+}
+
+func (s *MsgServerTestSuite) TestExchange() {
+	coinDenom := "swapcoin"
+	restrictedDenom := "swaprestricted"
+	attrOwner := sdk.AccAddress("attr_owner__________")
+	s.app.AccountKeeper.SetAccount(s.ctx, s.app.AccountKeeper.NewAccountWithAddress(s.ctx, attrOwner))
+	s.Require().NoError(s.app.NameKeeper.SetNameRecord(s.ctx, "kyc.swap.io", attrOwner, false), "SetNameRecord kyc.swap.io")
+
+	for _, denom := range []string{coinDenom, restrictedDenom} {
+		markerType, reqAttrs := types.MarkerType_Coin, []string{}
+		if denom == restrictedDenom {
+			markerType, reqAttrs = types.MarkerType_RestrictedCoin, []string{"kyc.swap.io"}
+		}
+		msg := types.NewMsgAddFinalizeActivateMarkerRequest(
+			denom, sdkmath.NewInt(1000),
+			s.owner1Addr, s.owner1Addr, // From and Manager.
+			markerType,
+			false, // Supply not fixed
+			true,  // Allow gov
+			false, // don't allow forced transfer
+			reqAttrs,
+			[]types.AccessGrant{{Address: s.owner1, Permissions: []types.Access{types.Access_Admin, types.Access_Withdraw}}},
+			0,
+			0,
+		)
+		_, err := s.msgServer.AddFinalizeActivateMarker(s.ctx, msg)
+		s.Require().NoError(err, "AddFinalizeActivateMarker(%q)", denom)
+	}
+	fundCtx := types.WithBypass(s.ctx)
+	s.Require().NoError(testutil.FundAccount(fundCtx, s.app.BankKeeper, s.owner1Addr, sdk.NewCoins(sdk.NewInt64Coin(coinDenom, 100))), "FundAccount(owner1)")
+	s.Require().NoError(testutil.FundAccount(fundCtx, s.app.BankKeeper, s.owner2Addr, sdk.NewCoins(sdk.NewInt64Coin(restrictedDenom, 50))), "FundAccount(owner2)")
+
+	balances := func(addr sdk.AccAddress) sdk.Coins {
+		return s.app.BankKeeper.GetAllBalances(s.ctx, addr)
+	}
+	coins := func(coinAmt, restrictedAmt int64) sdk.Coins {
+		return sdk.NewCoins(sdk.NewInt64Coin(coinDenom, coinAmt), sdk.NewInt64Coin(restrictedDenom, restrictedAmt))
+	}
+	// runExchange runs the exchange in a cache context that's only written if the exchange succeeds, like in a tx.
+	runExchange := func(msg *types.MsgExchangeRequest) error {
+		cacheCtx, writeCache := s.ctx.CacheContext()
+		_, err := s.msgServer.Exchange(cacheCtx, msg)
+		if err == nil {
+			writeCache()
+			s.ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+		}
+		return err
+	}
+
+	s.Run("invalid msg", func() {
+		msg := types.NewMsgExchangeRequest(s.owner1Addr, sdk.NewInt64Coin(coinDenom, 20), s.owner1Addr, sdk.NewInt64Coin(restrictedDenom, 10))
+		err := runExchange(msg)
+		s.Assert().EqualError(err, "party a and party b cannot be the same account: invalid request", "Exchange error")
+	})
+
+	s.Run("denom without a marker", func() {
+		msg := types.NewMsgExchangeRequest(s.owner1Addr, sdk.NewInt64Coin(coinDenom, 20), s.owner2Addr, sdk.NewInt64Coin("nosuchcoin", 10))
+		err := runExchange(msg)
+		s.Assert().ErrorContains(err, "could not get nosuchcoin marker", "Exchange error")
+	})
+
+	s.Run("insufficient funds", func() {
+		msg := types.NewMsgExchangeRequest(s.owner1Addr, sdk.NewInt64Coin(coinDenom, 101), s.owner2Addr, sdk.NewInt64Coin(restrictedDenom, 10))
+		err := runExchange(msg)
+		s.Assert().ErrorContains(err, "insufficient funds", "Exchange error")
+		s.Assert().Equal(coins(100, 0), balances(s.owner1Addr), "owner1 balances")
+		s.Assert().Equal(coins(0, 50), balances(s.owner2Addr), "owner2 balances")
+	})
+
+	s.Run("second send fails required attributes", func() {
+		msg := types.NewMsgExchangeRequest(s.owner1Addr, sdk.NewInt64Coin(coinDenom, 20), s.owner2Addr, sdk.NewInt64Coin(restrictedDenom, 10))
+		err := runExchange(msg)
+		s.Assert().ErrorContains(err, fmt.Sprintf("could not send 10%s from %s to %s: ", restrictedDenom, s.owner2, s.owner1), "Exchange error")
+		s.Assert().ErrorContains(err, `required attribute: "kyc.swap.io"`, "Exchange error")
+		s.Assert().Equal(coins(100, 0), balances(s.owner1Addr), "owner1 balances")
+		s.Assert().Equal(coins(0, 50), balances(s.owner2Addr), "owner2 balances")
+	})
+
+	s.Require().NoError(s.app.AttributeKeeper.SetAttribute(s.ctx,
+		attrtypes.Attribute{
+			Name:          "kyc.swap.io",
+			Value:         []byte("approved"),
+			Address:       s.owner1,
+			AttributeType: attrtypes.AttributeType_String,
+		},
+		attrOwner,
+	), "SetAttribute kyc.swap.io")
+
+	s.Run("success", func() {
+		s.ctx = s.ctx.WithEventManager(sdk.NewEventManager())
+		msg := types.NewMsgExchangeRequest(s.owner1Addr, sdk.NewInt64Coin(coinDenom, 20), s.owner2Addr, sdk.NewInt64Coin(restrictedDenom, 10))
+		err := runExchange(msg)
+		s.Require().NoError(err, "Exchange error")
+		s.Assert().Equal(coins(80, 10), balances(s.owner1Addr), "owner1 balances")
+		s.Assert().Equal(coins(20, 40), balances(s.owner2Addr), "owner2 balances")
+		expEvent := types.NewEventMarkerExchange(s.owner1Addr, msg.AmountA, s.owner2Addr, msg.AmountB)
+		s.Assert().True(s.containsMessage(s.ctx.EventManager().ABCIEvents(), expEvent), "EventMarkerExchange emitted")
+	})
+}
+
 func (s *MsgServerTestSuite) TestSetAdministratorProposal() {
 	hotdogMarker := types.NewMarkerAccount(
 		authtypes.NewBaseAccountWithAddress(types.MustGetMarkerAddress("hotdog")),
@@ -1684,6 +3115,59 @@ func (s *MsgServerTestSuite) TestChangeStatusProposal() {
 	}
 }
 
+func (s *MsgServerTestSuite) TestChangeMarkerTypeProposal() {
+	hotdogMarker := types.NewMarkerAccount(
+		authtypes.NewBaseAccountWithAddress(types.MustGetMarkerAddress("hotdog")),
+		sdk.NewInt64Coin("hotdog", 1000),
+		s.owner1Addr,
+		[]types.AccessGrant{
+			{Address: s.owner1Addr.String(), Permissions: types.AccessList{types.Access_Admin, types.Access_Mint}},
+		},
+		types.StatusFinalized,
+		types.MarkerType_Coin,
+		true,
+		true,
+		false,
+		[]string{},
+	)
+	s.Require().NoError(s.app.MarkerKeeper.AddMarkerAccount(s.ctx, hotdogMarker), "Failed to add 'hotdog' marker for tests")
+
+	testCases := []struct {
+		name   string
+		msg    *types.MsgChangeMarkerTypeProposalRequest
+		expErr string
+	}{
+		{
+			name:   "wrong authority",
+			msg:    types.NewMsgChangeMarkerTypeProposalRequest("hotdog", types.MarkerType_RestrictedCoin, s.owner1Addr.String()),
+			expErr: fmt.Sprintf("expected %q got %q: expected gov account as only signer for proposal message", s.app.MarkerKeeper.GetAuthority(), s.owner1Addr.String()),
+		},
+		{
+			name:   "marker does not exist",
+			msg:    types.NewMsgChangeMarkerTypeProposalRequest("nonexistent", types.MarkerType_RestrictedCoin, s.app.MarkerKeeper.GetAuthority()),
+			expErr: "nonexistent marker does not exist",
+		},
+		{
+			name: "success",
+			msg:  types.NewMsgChangeMarkerTypeProposalRequest("hotdog", types.MarkerType_RestrictedCoin, s.app.MarkerKeeper.GetAuthority()),
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			_, err := s.msgServer.ChangeMarkerTypeProposal(s.ctx, tc.msg)
+			if len(tc.expErr) > 0 {
+				s.Assert().EqualError(err, tc.expErr, "ChangeMarkerTypeProposal error")
+				return
+			}
+			s.Require().NoError(err, "ChangeMarkerTypeProposal error")
+			marker, err := s.app.MarkerKeeper.GetMarkerByDenom(s.ctx, tc.msg.Denom)
+			s.Require().NoError(err, "GetMarkerByDenom(%q)", tc.msg.Denom)
+			s.Assert().Equal(tc.msg.NewType, marker.GetMarkerType(), "marker type after ChangeMarkerTypeProposal")
+		})
+	}
+}
+
 func (s *MsgServerTestSuite) TestWithdrawEscrowProposal() {
 	hotdogMarker := types.NewMarkerAccount(
 		authtypes.NewBaseAccountWithAddress(types.MustGetMarkerAddress("hotdog")),
@@ -1870,3 +3354,351 @@ func (s *MsgServerTestSuite) TestMsgUpdateParamsRequest() {
 		})
 	}
 }
+
+func (s *MsgServerTestSuite) TestUpdateSendRestrictionBypasses() {
+	authority := s.app.MarkerKeeper.GetAuthority()
+	newBypass := types.NewSendRestrictionBypass(s.owner1Addr, types.BypassTypeSender, "testing")
+	feeCollector := authtypes.NewModuleAddress(authtypes.FeeCollectorName)
+	untypeEvent := func(tev proto.Message) sdk.Event {
+		rv, err := sdk.TypedEventToEvent(tev)
+		s.Require().NoError(err, "TypedEventToEvent(%T)", tev)
+		return rv
+	}
+
+	testCases := []struct {
+		name      string
+		msg       *types.MsgUpdateSendRestrictionBypassesRequest
+		expErr    string
+		expEvents sdk.Events
+	}{
+		{
+			name: "invalid authority",
+			msg: types.NewMsgUpdateSendRestrictionBypassesRequest("invalidAuthority",
+				[]types.SendRestrictionBypass{newBypass}, nil),
+			expErr: `expected "` + authority + `" got "invalidAuthority": expected gov account as only signer for proposal message`,
+		},
+		{
+			name: "remove unknown address",
+			msg: types.NewMsgUpdateSendRestrictionBypassesRequest(authority,
+				nil, []string{s.owner2Addr.String()}),
+			expErr: "no send restriction bypass found for " + s.owner2Addr.String() + ": invalid request",
+		},
+		{
+			name: "set and remove",
+			msg: types.NewMsgUpdateSendRestrictionBypassesRequest(authority,
+				[]types.SendRestrictionBypass{newBypass}, []string{feeCollector.String()}),
+			expEvents: sdk.Events{
+				untypeEvent(types.NewEventSendRestrictionBypassRemoved(feeCollector.String())),
+				untypeEvent(types.NewEventSendRestrictionBypassSet(newBypass)),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			ctx, _ := s.ctx.CacheContext()
+			ctx = ctx.WithEventManager(sdk.NewEventManager())
+			res, err := s.msgServer.UpdateSendRestrictionBypasses(ctx, tc.msg)
+			if len(tc.expErr) > 0 {
+				s.Require().EqualError(err, tc.expErr, "UpdateSendRestrictionBypasses error")
+				s.Require().Nil(res, "UpdateSendRestrictionBypasses response")
+				return
+			}
+			s.Require().NoError(err, "UpdateSendRestrictionBypasses error")
+			s.Require().NotNil(res, "UpdateSendRestrictionBypasses response")
+			s.Assert().Equal(tc.expEvents, ctx.EventManager().Events(), "events emitted")
+			s.Assert().True(s.app.MarkerKeeper.IsSenderBypassAddr(ctx, s.owner1Addr), "IsSenderBypassAddr(owner1)")
+			s.Assert().False(s.app.MarkerKeeper.IsReqAttrBypassAddr(ctx, feeCollector), "IsReqAttrBypassAddr(fee collector)")
+		})
+	}
+}
+
+func (s *MsgServerTestSuite) TestAccountOverview() {
+	denom := "overviewcoin"
+	msg := types.NewMsgAddFinalizeActivateMarkerRequest(
+		denom, sdkmath.NewInt(1000),
+		s.owner1Addr, s.owner1Addr, // From and Manager.
+		types.MarkerType_Coin,
+		false, // Supply not fixed
+		true,  // Allow gov
+		false, // don't allow forced transfer
+		[]string{},
+		[]types.AccessGrant{
+			{Address: s.owner1, Permissions: []types.Access{types.Access_Admin, types.Access_Withdraw}},
+			{Address: s.owner2, Permissions: []types.Access{types.Access_Deposit}},
+		},
+		0,
+		0,
+	)
+	_, err := s.msgServer.AddFinalizeActivateMarker(s.ctx, msg)
+	s.Require().NoError(err, "AddFinalizeActivateMarker(%q)", denom)
+	_, err = s.msgServer.Withdraw(s.ctx, types.NewMsgWithdrawRequest(s.owner1Addr, s.owner2Addr, denom, sdk.NewCoins(sdk.NewInt64Coin(denom, 25))))
+	s.Require().NoError(err, "Withdraw error")
+	s.Require().NoError(testutil.FundAccount(s.ctx, s.app.BankKeeper, s.owner2Addr, sdk.NewCoins(sdk.NewInt64Coin("nonmarkercoin", 5))), "FundAccount")
+
+	for _, name := range []string{"overview", "alias.overview"} {
+		s.Require().NoError(s.app.NameKeeper.SetNameRecord(s.ctx, name, s.owner2Addr, false), "SetNameRecord(%q)", name)
+	}
+	attr := attrtypes.NewAttribute("overview", s.owner2, attrtypes.AttributeType_String, []byte("details"), nil)
+	s.Require().NoError(s.app.AttributeKeeper.SetAttribute(s.ctx, attr, s.owner2Addr), "SetAttribute")
+
+	scopeUUID := uuid.New()
+	scope := metadatatypes.NewScope(metadatatypes.ScopeMetadataAddress(scopeUUID), nil,
+		[]metadatatypes.Party{{Address: s.owner2, Role: metadatatypes.PartyType_PARTY_TYPE_OWNER}}, nil, s.owner2, false)
+	s.Require().NoError(s.app.MetadataKeeper.SetScope(s.ctx, *scope), "SetScope")
+
+	s.Run("invalid address", func() {
+		_, err := s.app.MarkerKeeper.AccountOverview(s.ctx, &types.QueryAccountOverviewRequest{Address: "invalid"})
+		s.Assert().ErrorContains(err, "invalid address", "AccountOverview error")
+	})
+
+	s.Run("limit too large", func() {
+		_, err := s.app.MarkerKeeper.AccountOverview(s.ctx, &types.QueryAccountOverviewRequest{Address: s.owner2, Limit: types.MaxAccountOverviewLimit + 1})
+		s.Assert().ErrorContains(err, "limit 1001 cannot be greater than 1000", "AccountOverview error")
+	})
+
+	s.Run("everything", func() {
+		resp, err := s.app.MarkerKeeper.AccountOverview(s.ctx, &types.QueryAccountOverviewRequest{Address: s.owner2})
+		s.Require().NoError(err, "AccountOverview error")
+		s.Assert().Equal(s.owner2, resp.Address, "Address")
+		s.Assert().ElementsMatch([]string{"overview", "alias.overview"}, resp.Names, "Names")
+		s.Assert().Equal([]attrtypes.Attribute{attr}, resp.Attributes, "Attributes")
+		s.Assert().Equal([]types.MarkerAccess{{Denom: denom, Permissions: types.AccessList{types.Access_Deposit}}}, resp.MarkerAccess, "MarkerAccess")
+		s.Assert().Equal(sdk.NewCoins(sdk.NewInt64Coin(denom, 25)).String(), resp.MarkerBalances.String(), "MarkerBalances")
+		s.Assert().Equal([]string{scopeUUID.String()}, resp.ScopeUuids, "ScopeUuids")
+		s.Assert().Equal([]string{scopeUUID.String()}, resp.ValueOwnerScopeUuids, "ValueOwnerScopeUuids")
+		s.Assert().False(resp.Truncated, "Truncated")
+	})
+
+	s.Run("limited", func() {
+		resp, err := s.app.MarkerKeeper.AccountOverview(s.ctx, &types.QueryAccountOverviewRequest{Address: s.owner2, Limit: 1})
+		s.Require().NoError(err, "AccountOverview error")
+		s.Assert().Len(resp.Names, 1, "Names")
+		s.Assert().Len(resp.ScopeUuids, 1, "ScopeUuids")
+		s.Assert().True(resp.Truncated, "Truncated")
+	})
+}
+
+func (s *MsgServerTestSuite) TestActivationChecklist() {
+	govAddr := sdk.MustAccAddressFromBech32(s.app.MarkerKeeper.GetAuthority())
+	addMarker := func(denom string, supply int64, manager sdk.AccAddress, status types.MarkerStatus, grants ...types.AccessGrant) {
+		marker := types.NewMarkerAccount(
+			authtypes.NewBaseAccountWithAddress(types.MustGetMarkerAddress(denom)),
+			sdk.NewInt64Coin(denom, supply), manager, grants, status,
+			types.MarkerType_Coin, false, true, false, nil,
+		)
+		s.Require().NoError(s.app.MarkerKeeper.AddMarkerAccount(s.ctx, marker), "AddMarkerAccount(%q)", denom)
+	}
+	setDenomMetadata := func(denom string) {
+		s.app.BankKeeper.SetDenomMetaData(s.ctx, banktypes.Metadata{
+			Base:       denom,
+			DenomUnits: []*banktypes.DenomUnit{{Denom: denom}},
+			Display:    denom,
+			Name:       denom,
+			Symbol:     denom,
+		})
+	}
+	mintGrant := types.AccessGrant{Address: s.owner1, Permissions: types.AccessList{types.Access_Mint}}
+	met := func(name string, required bool) types.ActivationCheck {
+		return types.NewActivationCheck(name, required, "")
+	}
+
+	addMarker("checkproposed", 0, s.owner1Addr, types.StatusProposed)
+
+	addMarker("checkready", 100, s.owner1Addr, types.StatusFinalized, mintGrant)
+	setDenomMetadata("checkready")
+
+	s.Require().NoError(testutil.FundAccount(s.ctx, s.app.BankKeeper, s.owner2Addr, sdk.NewCoins(sdk.NewInt64Coin("checksupply", 500))), "FundAccount")
+	addMarker("checksupply", 100, s.owner1Addr, types.StatusFinalized, mintGrant)
+	setDenomMetadata("checksupply")
+
+	addMarker("checkgov", 100, govAddr, types.StatusFinalized)
+	setDenomMetadata("checkgov")
+
+	tests := []struct {
+		name      string
+		id        string
+		expErr    string
+		expReady  bool
+		expChecks []types.ActivationCheck
+	}{
+		{
+			name:   "unknown marker",
+			id:     "unknowncoin",
+			expErr: "invalid denom or address: marker not found",
+		},
+		{
+			name:     "proposed with zero supply and no mint grant",
+			id:       "checkproposed",
+			expReady: false,
+			expChecks: []types.ActivationCheck{
+				types.NewActivationCheck(types.ActivationCheckStatus, true, "marker must be finalized before it can be activated"),
+				met(types.ActivationCheckManager, true),
+				types.NewActivationCheck(types.ActivationCheckGrants, true, "marker has zero total supply and no one has ACCESS_MINT"),
+				met(types.ActivationCheckSupply, true),
+				types.NewActivationCheck(types.ActivationCheckDenomMetadata, false,
+					"marker has no denom metadata: it is not required for activation, but wallets and explorers rely on it"),
+				met(types.ActivationCheckGovernance, false),
+			},
+		},
+		{
+			name:     "ready by address",
+			id:       types.MustGetMarkerAddress("checkready").String(),
+			expReady: true,
+			expChecks: []types.ActivationCheck{
+				met(types.ActivationCheckStatus, true),
+				met(types.ActivationCheckManager, true),
+				met(types.ActivationCheckGrants, true),
+				met(types.ActivationCheckSupply, true),
+				met(types.ActivationCheckDenomMetadata, false),
+				met(types.ActivationCheckGovernance, false),
+			},
+		},
+		{
+			name:     "supply less than pre-existing supply",
+			id:       "checksupply",
+			expReady: false,
+			expChecks: []types.ActivationCheck{
+				met(types.ActivationCheckStatus, true),
+				met(types.ActivationCheckManager, true),
+				met(types.ActivationCheckGrants, true),
+				types.NewActivationCheck(types.ActivationCheckSupply, true, "marker supply 100checksupply is less than pre-existing supply 500checksupply"),
+				met(types.ActivationCheckDenomMetadata, false),
+				met(types.ActivationCheckGovernance, false),
+			},
+		},
+		{
+			name:     "managed by governance",
+			id:       "checkgov",
+			expReady: true,
+			expChecks: []types.ActivationCheck{
+				met(types.ActivationCheckStatus, true),
+				met(types.ActivationCheckManager, true),
+				met(types.ActivationCheckGrants, true),
+				met(types.ActivationCheckSupply, true),
+				met(types.ActivationCheckDenomMetadata, false),
+				types.NewActivationCheck(types.ActivationCheckGovernance, false,
+					"marker manager is the governance module account: it can only be finalized and activated through a governance proposal"),
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			resp, err := s.app.MarkerKeeper.ActivationChecklist(s.ctx, &types.QueryActivationChecklistRequest{Id: tc.id})
+			if len(tc.expErr) > 0 {
+				s.Assert().EqualError(err, tc.expErr, "ActivationChecklist error")
+				return
+			}
+			s.Require().NoError(err, "ActivationChecklist error")
+			s.Assert().Equal(tc.expReady, resp.Ready, "Ready")
+			s.Assert().Equal(tc.expChecks, resp.Checks, "Checks")
+		})
+	}
+
+	s.Run("activate ready marker", func() {
+		_, err := s.msgServer.Activate(s.ctx, types.NewMsgActivateRequest("checkready", s.owner1Addr))
+		s.Assert().NoError(err, "Activate error")
+	})
+}
+
+func (s *MsgServerTestSuite) TestDenomOwner() {
+	addMarker := func(denom string, markerType types.MarkerType, grants ...types.AccessGrant) {
+		marker := types.NewMarkerAccount(
+			authtypes.NewBaseAccountWithAddress(types.MustGetMarkerAddress(denom)),
+			sdk.NewInt64Coin(denom, 100), s.owner1Addr, grants, types.StatusActive,
+			markerType, false, true, false, nil,
+		)
+		s.Require().NoError(s.app.MarkerKeeper.AddMarkerAccount(s.ctx, marker), "AddMarkerAccount(%q)", denom)
+	}
+
+	addMarker("ownedcoin", types.MarkerType_Coin,
+		types.AccessGrant{Address: s.owner1, Permissions: types.AccessList{types.Access_Admin, types.Access_Mint}},
+		types.AccessGrant{Address: s.owner2, Permissions: types.AccessList{types.Access_Withdraw}},
+	)
+
+	trace := transfertypes.DenomTrace{Path: "transfer/channel-0", BaseDenom: "uatom"}
+	s.app.TransferKeeper.SetDenomTrace(s.ctx, trace)
+	restrictedTrace := transfertypes.DenomTrace{Path: "transfer/channel-1", BaseDenom: "uusdc"}
+	s.app.TransferKeeper.SetDenomTrace(s.ctx, restrictedTrace)
+	addMarker(restrictedTrace.IBCDenom(), types.MarkerType_RestrictedCoin,
+		types.AccessGrant{Address: s.owner2, Permissions: types.AccessList{types.Access_Admin, types.Access_Transfer}},
+	)
+	unknownTrace := transfertypes.DenomTrace{Path: "transfer/channel-9", BaseDenom: "unknown"}
+
+	tests := []struct {
+		name    string
+		denom   string
+		expErr  string
+		expResp *types.QueryDenomOwnerResponse
+	}{
+		{
+			name:   "invalid denom",
+			denom:  "1x",
+			expErr: "rpc error: code = InvalidArgument desc = invalid denom: 1x",
+		},
+		{
+			name:   "invalid ibc hash",
+			denom:  "ibc/nothex",
+			expErr: "rpc error: code = InvalidArgument desc = encoding/hex: invalid byte: U+006E 'n'",
+		},
+		{
+			name:   "unknown ibc trace",
+			denom:  unknownTrace.IBCDenom(),
+			expErr: "rpc error: code = NotFound desc = denom trace not found for " + unknownTrace.IBCDenom(),
+		},
+		{
+			name:    "unmanaged",
+			denom:   "nonmarkercoin",
+			expResp: &types.QueryDenomOwnerResponse{Denom: "nonmarkercoin", OwnerType: types.DenomOwnerType_Unmanaged},
+		},
+		{
+			name:  "marker",
+			denom: "ownedcoin",
+			expResp: &types.QueryDenomOwnerResponse{
+				Denom:         "ownedcoin",
+				OwnerType:     types.DenomOwnerType_Marker,
+				MarkerAddress: types.MustGetMarkerAddress("ownedcoin").String(),
+				MarkerType:    types.MarkerType_Coin,
+				MarkerStatus:  types.StatusActive,
+				Admins:        []string{s.owner1},
+			},
+		},
+		{
+			name:  "ibc voucher",
+			denom: trace.IBCDenom(),
+			expResp: &types.QueryDenomOwnerResponse{
+				Denom:        trace.IBCDenom(),
+				OwnerType:    types.DenomOwnerType_IBC,
+				IbcPath:      "transfer/channel-0",
+				IbcBaseDenom: "uatom",
+			},
+		},
+		{
+			name:  "ibc voucher with a marker",
+			denom: restrictedTrace.IBCDenom(),
+			expResp: &types.QueryDenomOwnerResponse{
+				Denom:         restrictedTrace.IBCDenom(),
+				OwnerType:     types.DenomOwnerType_Marker,
+				MarkerAddress: types.MustGetMarkerAddress(restrictedTrace.IBCDenom()).String(),
+				MarkerType:    types.MarkerType_RestrictedCoin,
+				MarkerStatus:  types.StatusActive,
+				Admins:        []string{s.owner2},
+				IbcPath:       "transfer/channel-1",
+				IbcBaseDenom:  "uusdc",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			resp, err := s.app.MarkerKeeper.DenomOwner(s.ctx, &types.QueryDenomOwnerRequest{Denom: tc.denom})
+			if len(tc.expErr) > 0 {
+				s.Assert().EqualError(err, tc.expErr, "DenomOwner error")
+				return
+			}
+			s.Require().NoError(err, "DenomOwner error")
+			s.Assert().Equal(tc.expResp, resp, "DenomOwner response")
+		})
+	}
+}
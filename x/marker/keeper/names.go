@@ -0,0 +1,56 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+// AddMarkerNameRecord binds a new name under a parent name that is owned by the marker.
+// The marker acts as the parent name's owner, so it doesn't matter if the parent is restricted.
+func (k Keeper) AddMarkerNameRecord(ctx sdk.Context, marker types.MarkerAccountI, parent, name string, addr sdk.AccAddress, restricted bool) error {
+	normParent, err := k.nameKeeper.Normalize(ctx, parent)
+	if err != nil {
+		return fmt.Errorf("invalid parent name %q: %w", parent, err)
+	}
+	if !k.nameKeeper.ResolvesTo(ctx, normParent, marker.GetAddress()) {
+		return fmt.Errorf("parent name %q is not owned by the %s marker", normParent, marker.GetDenom())
+	}
+
+	full, err := k.nameKeeper.Normalize(ctx, name+"."+normParent)
+	if err != nil {
+		return fmt.Errorf("invalid name %q: %w", name, err)
+	}
+	if err = k.nameKeeper.BindNameRecord(ctx, full, addr, restricted, marker.GetAddress()); err != nil {
+		return fmt.Errorf("could not bind name %q: %w", full, err)
+	}
+	return nil
+}
+
+// DeleteMarkerNameRecord deletes a name that is owned by the marker, and removes the attributes with that name.
+// If the name module has a delete delay, the name is only marked for deletion, the same as with MsgDeleteName.
+func (k Keeper) DeleteMarkerNameRecord(ctx sdk.Context, marker types.MarkerAccountI, name string) error {
+	normName, err := k.nameKeeper.Normalize(ctx, name)
+	if err != nil {
+		return fmt.Errorf("invalid name %q: %w", name, err)
+	}
+	if !k.nameKeeper.NameExists(ctx, normName) {
+		return fmt.Errorf("name %q does not exist", normName)
+	}
+	if !k.nameKeeper.ResolvesTo(ctx, normName, marker.GetAddress()) {
+		return fmt.Errorf("name %q is not owned by the %s marker", normName, marker.GetDenom())
+	}
+
+	if delay := k.nameKeeper.GetParams(ctx).DeleteDelayBlocks; delay > 0 {
+		if err = k.nameKeeper.AddPendingDeletion(ctx, normName, marker.GetAddress(), ctx.BlockHeight()+int64(delay)); err != nil {
+			return fmt.Errorf("could not delete name %q: %w", normName, err)
+		}
+		return nil
+	}
+	if err = k.nameKeeper.DeleteRecord(ctx, normName); err != nil {
+		return fmt.Errorf("could not delete name %q: %w", normName, err)
+	}
+	return k.attrKeeper.PurgeAttribute(ctx, normName, marker.GetAddress())
+}
@@ -108,7 +108,12 @@ func (k Keeper) HandleSetAdministratorProposal(ctx sdk.Context, denom string, ac
 		return fmt.Errorf("%s marker does not allow governance control", denom)
 	}
 	for _, a := range accessGrants {
-		if err := m.GrantAccess(types.NewAccessGrant(a.GetAddress(), a.Permissions)); err != nil {
+		grant := types.NewAccessGrant(a.GetAddress(), a.Permissions)
+		grant.Expiration = a.Expiration
+		if err := validateAccessGrantExpiration(ctx, grant); err != nil {
+			return err
+		}
+		if err := m.GrantAccess(grant); err != nil {
 			return err
 		}
 		logger := k.Logger(ctx)
@@ -269,3 +274,48 @@ func (k Keeper) HandleSetDenomMetadataProposal(ctx sdk.Context, metadata banktyp
 	k.Logger(ctx).Info("denom metadata set for marker", "marker", metadata.Base, "denom metadata", metadata.String())
 	return nil
 }
+
+// HandleChangeMarkerTypeProposal handles a Change Marker Type governance proposal request.
+// When a restricted marker is changed to a coin marker, its send deny list is also cleared.
+func (k Keeper) HandleChangeMarkerTypeProposal(ctx sdk.Context, denom string, newType types.MarkerType) error {
+	addr, err := types.MarkerAddress(denom)
+	if err != nil {
+		return err
+	}
+	m, err := k.GetMarker(ctx, addr)
+	if err != nil {
+		return err
+	}
+	if m == nil {
+		return fmt.Errorf("%s marker does not exist", denom)
+	}
+	if !m.HasGovernanceEnabled() {
+		return fmt.Errorf("%s marker does not allow governance control", denom)
+	}
+	if !m.GetStatus().IsOneOf(types.StatusProposed, types.StatusFinalized, types.StatusActive) {
+		return fmt.Errorf("cannot change the type of %s marker with status %s", denom, m.GetStatus())
+	}
+
+	ma, ok := m.(*types.MarkerAccount)
+	if !ok {
+		return fmt.Errorf("cannot change the type of %s marker: unexpected marker account type %T", denom, m)
+	}
+	previousType := ma.GetMarkerType()
+	if err = ma.ChangeMarkerType(newType); err != nil {
+		return err
+	}
+	if err = ma.Validate(); err != nil {
+		return err
+	}
+
+	k.SetMarker(ctx, ma)
+	if newType == types.MarkerType_Coin {
+		k.ClearSendDeny(ctx, addr)
+	}
+
+	if err = ctx.EventManager().EmitTypedEvent(types.NewEventMarkerTypeChanged(denom, previousType, newType)); err != nil {
+		return err
+	}
+	k.Logger(ctx).Info("changed marker type", "marker", denom, "previous type", previousType.String(), "new type", newType.String())
+	return nil
+}
@@ -538,6 +538,125 @@ func (s *KeeperTestSuite) TestSetDenomMetadataProposal() {
 	}
 }
 
+func (s *KeeperTestSuite) TestChangeMarkerTypeProposal() {
+	coinMarker := s.createTestMarker("typecoin")
+	nonGovernanceMarker := s.createTestMarker("nongovtypecoin")
+	nonGovernanceMarker.AllowGovernanceControl = false
+	s.app.MarkerKeeper.SetMarker(s.ctx, nonGovernanceMarker)
+	cancelledMarker := s.createTestMarker("cancelledtypecoin")
+	s.Require().NoError(cancelledMarker.SetStatus(types.StatusCancelled), "SetStatus(cancelled)")
+	s.app.MarkerKeeper.SetMarker(s.ctx, cancelledMarker)
+
+	restrictedMarker := types.NewMarkerAccount(
+		authtypes.NewBaseAccountWithAddress(types.MustGetMarkerAddress("typerestricted")),
+		sdk.NewInt64Coin("typerestricted", 1000),
+		s.user1Addr,
+		[]types.AccessGrant{
+			{Address: s.user1, Permissions: types.AccessList{types.Access_Admin, types.Access_Transfer}},
+			{Address: s.user2, Permissions: types.AccessList{types.Access_ForceTransfer}},
+		},
+		types.StatusProposed,
+		types.MarkerType_RestrictedCoin,
+		true,
+		true,
+		true,
+		[]string{"kyc.provenance.io"},
+	)
+	s.Require().NoError(s.app.MarkerKeeper.AddSetNetAssetValues(s.ctx, restrictedMarker, []types.NetAssetValue{types.NewNetAssetValue(sdk.NewInt64Coin(types.UsdDenom, 1), 1)}, types.ModuleName), "AddSetNetAssetValues(typerestricted)")
+	s.Require().NoError(s.app.MarkerKeeper.AddFinalizeAndActivateMarker(s.ctx, restrictedMarker), "AddFinalizeAndActivateMarker(typerestricted)")
+	s.app.MarkerKeeper.AddSendDeny(s.ctx, restrictedMarker.GetAddress(), s.user2Addr)
+
+	tests := []struct {
+		name      string
+		denom     string
+		newType   types.MarkerType
+		expectErr string
+		check     func(marker types.MarkerAccountI)
+	}{
+		{
+			name:      "marker does not exist",
+			denom:     "nosuchdenom",
+			newType:   types.MarkerType_RestrictedCoin,
+			expectErr: "nosuchdenom marker does not exist",
+		},
+		{
+			name:      "marker does not allow governance control",
+			denom:     nonGovernanceMarker.Denom,
+			newType:   types.MarkerType_RestrictedCoin,
+			expectErr: "nongovtypecoin marker does not allow governance control",
+		},
+		{
+			name:      "cancelled marker",
+			denom:     cancelledMarker.Denom,
+			newType:   types.MarkerType_RestrictedCoin,
+			expectErr: "cannot change the type of cancelledtypecoin marker with status cancelled",
+		},
+		{
+			name:      "unknown marker type",
+			denom:     coinMarker.Denom,
+			newType:   types.MarkerType_Unknown,
+			expectErr: "cannot change marker type to MARKER_TYPE_UNSPECIFIED",
+		},
+		{
+			name:      "already the new type",
+			denom:     coinMarker.Denom,
+			newType:   types.MarkerType_Coin,
+			expectErr: "marker typecoin is already type MARKER_TYPE_COIN",
+		},
+		{
+			name:    "coin to restricted",
+			denom:   coinMarker.Denom,
+			newType: types.MarkerType_RestrictedCoin,
+			check: func(marker types.MarkerAccountI) {
+				s.Assert().Equal(coinMarker.GetAccessList(), marker.GetAccessList(), "access list")
+				s.Assert().False(marker.AllowsForcedTransfer(), "AllowsForcedTransfer")
+			},
+		},
+		{
+			name:    "restricted to coin",
+			denom:   restrictedMarker.Denom,
+			newType: types.MarkerType_Coin,
+			check: func(marker types.MarkerAccountI) {
+				expGrants := []types.AccessGrant{{Address: s.user1, Permissions: types.AccessList{types.Access_Admin}}}
+				s.Assert().Equal(expGrants, marker.GetAccessList(), "access list")
+				s.Assert().Empty(marker.GetRequiredAttributes(), "required attributes")
+				s.Assert().False(marker.AllowsForcedTransfer(), "AllowsForcedTransfer")
+				s.Assert().False(s.app.MarkerKeeper.IsSendDeny(s.ctx, marker.GetAddress(), s.user2Addr), "IsSendDeny(user2)")
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			em := sdk.NewEventManager()
+			ctx := s.ctx.WithEventManager(em)
+			var before types.MarkerAccountI
+			if addr, err := types.MarkerAddress(tc.denom); err == nil {
+				before, _ = s.app.MarkerKeeper.GetMarker(ctx, addr)
+			}
+
+			err := s.app.MarkerKeeper.HandleChangeMarkerTypeProposal(ctx, tc.denom, tc.newType)
+			if len(tc.expectErr) > 0 {
+				s.Assert().EqualError(err, tc.expectErr, "HandleChangeMarkerTypeProposal error")
+				s.Assert().Empty(em.Events(), "events emitted")
+				return
+			}
+			s.Require().NoError(err, "HandleChangeMarkerTypeProposal error")
+
+			marker, err := s.app.MarkerKeeper.GetMarkerByDenom(ctx, tc.denom)
+			s.Require().NoError(err, "GetMarkerByDenom(%q)", tc.denom)
+			s.Assert().Equal(tc.newType, marker.GetMarkerType(), "marker type")
+			s.Assert().Equal(before.GetStatus(), marker.GetStatus(), "marker status")
+			s.Assert().Equal(before.GetSupply(), marker.GetSupply(), "marker supply")
+			tc.check(marker)
+
+			expEvent, err := sdk.TypedEventToEvent(types.NewEventMarkerTypeChanged(tc.denom, before.GetMarkerType(), tc.newType))
+			s.Require().NoError(err, "TypedEventToEvent")
+			s.Assert().Equal(sdk.Events{expEvent}, em.Events(), "events emitted")
+		})
+	}
+}
+
 func (s *KeeperTestSuite) createTestMarker(denom string) *types.MarkerAccount {
 	marker := types.NewMarkerAccount(
 		authtypes.NewBaseAccountWithAddress(types.MustGetMarkerAddress(denom)),
@@ -2,18 +2,24 @@ package keeper
 
 import (
 	"context"
+	"fmt"
+	"strings"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	sdkmath "cosmossdk.io/math"
 	"cosmossdk.io/store/prefix"
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
 
+	"github.com/provenance-io/provenance/internal/provutils"
 	"github.com/provenance-io/provenance/x/marker/types"
+	metadatatypes "github.com/provenance-io/provenance/x/metadata/types"
 )
 
 var _ types.QueryServer = Keeper{}
@@ -79,9 +85,11 @@ func (k Keeper) Holding(c context.Context, req *types.QueryHoldingRequest) (*typ
 	}
 
 	denom := marker.GetDenom()
+	params := k.GetParams(ctx)
+	pageReq, truncated := provutils.LimitPageRequest(req.Pagination, params.MaxQueryResults)
 	denomOwners, err := k.bankKeeper.DenomOwners(c, &banktypes.QueryDenomOwnersRequest{
 		Denom:      denom,
-		Pagination: req.Pagination,
+		Pagination: pageReq,
 	})
 	if err != nil {
 		return nil, err
@@ -95,10 +103,15 @@ func (k Keeper) Holding(c context.Context, req *types.QueryHoldingRequest) (*typ
 		}
 	}
 
-	return &types.QueryHoldingResponse{
+	resp := &types.QueryHoldingResponse{
 		Balances:   balances,
 		Pagination: denomOwners.Pagination,
-	}, nil
+		Truncated:  truncated,
+	}
+	if err = provutils.ValidateQueryResponseSize(resp, params.MaxQueryResponseBytes); err != nil {
+		return nil, err
+	}
+	return resp, nil
 }
 
 // Supply query for supply of coin on a marker account
@@ -139,7 +152,10 @@ func (k Keeper) Access(c context.Context, req *types.QueryAccessRequest) (*types
 	if err != nil {
 		return nil, err
 	}
-	return &types.QueryAccessResponse{Accounts: marker.GetAccessList()}, nil
+	return &types.QueryAccessResponse{
+		Accounts:    marker.GetAccessList(),
+		Expirations: getAccessGrantExpirations(ctx, marker),
+	}, nil
 }
 
 // DenomMetadata query for metadata on denom
@@ -203,6 +219,289 @@ func (k Keeper) NetAssetValues(c context.Context, req *types.QueryNetAssetValues
 	return &types.QueryNetAssetValuesResponse{NetAssetValues: navs}, nil
 }
 
+// MintAllowances returns the mint allowances given to accounts for a marker
+func (k Keeper) MintAllowances(c context.Context, req *types.QueryMintAllowancesRequest) (*types.QueryMintAllowancesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	marker, err := accountForDenomOrAddress(ctx, k, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	var allowances []types.MintAllowance
+	k.IterateMintAllowances(ctx, marker.GetAddress(), func(minter sdk.AccAddress, remaining sdkmath.Int) (stop bool) {
+		allowances = append(allowances, types.NewMintAllowance(marker.GetDenom(), minter, remaining))
+		return false
+	})
+
+	return &types.QueryMintAllowancesResponse{MintAllowances: allowances}, nil
+}
+
+// Distribution returns a distribution and its progress
+func (k Keeper) Distribution(c context.Context, req *types.QueryDistributionRequest) (*types.QueryDistributionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	distribution, err := k.GetDistribution(ctx, req.DistributionId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if distribution == nil {
+		return nil, status.Errorf(codes.NotFound, "distribution %d not found", req.DistributionId)
+	}
+
+	return &types.QueryDistributionResponse{Distribution: *distribution}, nil
+}
+
+// Distributions returns the distributions to the holders of a marker
+func (k Keeper) Distributions(c context.Context, req *types.QueryDistributionsRequest) (*types.QueryDistributionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	marker, err := accountForDenomOrAddress(ctx, k, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	var distributions []types.Distribution
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.DistributionMarkerIndexKeyPrefix(marker.GetAddress()))
+	pageRes, err := query.Paginate(indexStore, req.Pagination, func(key []byte, _ []byte) error {
+		distribution, err := k.GetDistribution(ctx, sdk.BigEndianToUint64(key))
+		if err != nil {
+			return err
+		}
+		if distribution != nil {
+			distributions = append(distributions, *distribution)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryDistributionsResponse{Distributions: distributions, Pagination: pageRes}, nil
+}
+
+// DistributionClaims returns the distribution claims waiting to be claimed by an account
+func (k Keeper) DistributionClaims(c context.Context, req *types.QueryDistributionClaimsRequest) (*types.QueryDistributionClaimsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	claimant, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %v", err)
+	}
+
+	var claims []types.DistributionClaim
+	var distErr error
+	k.IterateDistributionClaims(ctx, claimant, func(distributionID uint64, amount sdkmath.Int) (stop bool) {
+		distribution, err := k.GetDistribution(ctx, distributionID)
+		if err != nil || distribution == nil {
+			distErr = fmt.Errorf("could not get distribution %d: %w", distributionID, err)
+			return true
+		}
+		claims = append(claims, types.NewDistributionClaim(distributionID, claimant, sdk.NewCoin(distribution.Amount.Denom, amount)))
+		return false
+	})
+	if distErr != nil {
+		return nil, status.Error(codes.Internal, distErr.Error())
+	}
+
+	return &types.QueryDistributionClaimsResponse{Claims: claims}, nil
+}
+
+// EscrowLedgers returns the escrow ledgers of a marker and the funds earmarked in each
+func (k Keeper) EscrowLedgers(c context.Context, req *types.QueryEscrowLedgersRequest) (*types.QueryEscrowLedgersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	marker, err := accountForDenomOrAddress(ctx, k, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	var ledgers []types.EscrowLedger
+	err = k.IterateEscrowLedgers(ctx, marker.GetAddress(), func(ledger types.EscrowLedger) (stop bool) {
+		ledgers = append(ledgers, ledger)
+		return false
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	unearmarked, err := k.GetUnearmarkedEscrow(ctx, marker.GetAddress())
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryEscrowLedgersResponse{Ledgers: ledgers, Unearmarked: unearmarked}, nil
+}
+
+// EscrowWithdrawLimits returns the withdraw limits given to accounts for one of a marker's escrow ledgers
+func (k Keeper) EscrowWithdrawLimits(c context.Context, req *types.QueryEscrowWithdrawLimitsRequest) (*types.QueryEscrowWithdrawLimitsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if err := types.ValidateEscrowLedgerName(req.Ledger); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	marker, err := accountForDenomOrAddress(ctx, k, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	var limits []types.EscrowWithdrawLimit
+	err = k.IterateEscrowWithdrawLimits(ctx, marker.GetAddress(), req.Ledger, func(limit types.EscrowWithdrawLimit) (stop bool) {
+		limits = append(limits, limit)
+		return false
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryEscrowWithdrawLimitsResponse{WithdrawLimits: limits}, nil
+}
+
+// ScheduledBurns returns the burns of a marker's escrow that have been scheduled but not yet executed
+func (k Keeper) ScheduledBurns(c context.Context, req *types.QueryScheduledBurnsRequest) (*types.QueryScheduledBurnsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	marker, err := accountForDenomOrAddress(ctx, k, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	var burns []types.ScheduledBurn
+	err = k.IterateScheduledBurns(ctx, marker.GetAddress(), func(burn types.ScheduledBurn) (stop bool) {
+		burns = append(burns, burn)
+		return false
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryScheduledBurnsResponse{Burns: burns}, nil
+}
+
+// AccountOverview returns the names, attributes, marker access grants, marker balances, and scopes of an account
+func (k Keeper) AccountOverview(c context.Context, req *types.QueryAccountOverviewRequest) (*types.QueryAccountOverviewResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %v", err)
+	}
+	limit := int(req.Limit)
+	switch {
+	case req.Limit == 0:
+		limit = int(types.DefaultAccountOverviewLimit)
+	case req.Limit > types.MaxAccountOverviewLimit:
+		return nil, status.Errorf(codes.InvalidArgument, "limit %d cannot be greater than %d", req.Limit, types.MaxAccountOverviewLimit)
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	resp := &types.QueryAccountOverviewResponse{Address: addr.String()}
+
+	records, err := k.nameKeeper.GetRecordsByAddress(ctx, addr)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "could not get names: %v", err)
+	}
+	for _, record := range records {
+		if len(resp.Names) >= limit {
+			resp.Truncated = true
+			break
+		}
+		resp.Names = append(resp.Names, record.Name)
+	}
+
+	attributes, err := k.attrKeeper.GetAllAttributesAddr(ctx, addr)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "could not get attributes: %v", err)
+	}
+	for _, attr := range attributes {
+		// Attributes the account has unlisted are left out since this is an enumeration of the account.
+		if k.attrKeeper.IsAttributeUnlisted(ctx, addr, attr.Name) {
+			continue
+		}
+		if len(resp.Attributes) >= limit {
+			resp.Truncated = true
+			break
+		}
+		resp.Attributes = append(resp.Attributes, attr)
+	}
+
+	k.IterateMarkers(ctx, func(marker types.MarkerAccountI) (stop bool) {
+		for _, grant := range marker.GetAccessList() {
+			if grant.Address != resp.Address {
+				continue
+			}
+			if len(resp.MarkerAccess) >= limit {
+				resp.Truncated = true
+				return true
+			}
+			resp.MarkerAccess = append(resp.MarkerAccess, types.MarkerAccess{Denom: marker.GetDenom(), Permissions: grant.Permissions})
+			break
+		}
+		return false
+	})
+
+	for _, balance := range k.bankKeeper.GetAllBalances(ctx, addr) {
+		markerAddr, addrErr := types.MarkerAddress(balance.Denom)
+		if addrErr != nil || !k.IsMarkerAccount(ctx, markerAddr) {
+			continue
+		}
+		if len(resp.MarkerBalances) >= limit {
+			resp.Truncated = true
+			break
+		}
+		resp.MarkerBalances = append(resp.MarkerBalances, balance)
+	}
+
+	if k.metadataKeeper != nil {
+		ownership, oErr := k.metadataKeeper.Ownership(ctx, &metadatatypes.OwnershipRequest{
+			Address:    resp.Address,
+			Pagination: &query.PageRequest{Limit: uint64(limit)},
+		})
+		if oErr != nil {
+			return nil, status.Errorf(codes.Internal, "could not get scopes: %v", oErr)
+		}
+		resp.ScopeUuids = ownership.ScopeUuids
+		if ownership.Pagination != nil && len(ownership.Pagination.NextKey) > 0 {
+			resp.Truncated = true
+		}
+
+		valueOwnership, voErr := k.metadataKeeper.ValueOwnership(ctx, &metadatatypes.ValueOwnershipRequest{
+			Address:    resp.Address,
+			Pagination: &query.PageRequest{Limit: uint64(limit)},
+		})
+		if voErr != nil {
+			return nil, status.Errorf(codes.Internal, "could not get value owner scopes: %v", voErr)
+		}
+		resp.ValueOwnerScopeUuids = valueOwnership.ScopeUuids
+		if valueOwnership.Pagination != nil && len(valueOwnership.Pagination.NextKey) > 0 {
+			resp.Truncated = true
+		}
+	}
+
+	return resp, nil
+}
+
 // accountForDenomOrAddress attempts to first get a marker by account address and then by denom.
 func accountForDenomOrAddress(ctx sdk.Context, keeper Keeper, lookup string) (types.MarkerAccountI, error) {
 	var addrErr, err error
@@ -220,3 +519,279 @@ func accountForDenomOrAddress(ctx sdk.Context, keeper Keeper, lookup string) (ty
 	}
 	return account, nil
 }
+
+// ActivationChecklist returns the preconditions for activating a proposed or finalized marker, and which are unmet
+func (k Keeper) ActivationChecklist(c context.Context, req *types.QueryActivationChecklistRequest) (*types.QueryActivationChecklistResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	marker, err := accountForDenomOrAddress(ctx, k, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	checks := k.GetActivationChecks(ctx, marker)
+	return &types.QueryActivationChecklistResponse{Ready: types.ActivationReady(checks), Checks: checks}, nil
+}
+
+// SendRestrictionBypasses returns the accounts that are exempt from some of the marker send restrictions
+func (k Keeper) SendRestrictionBypasses(c context.Context, req *types.QuerySendRestrictionBypassesRequest) (*types.QuerySendRestrictionBypassesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var bypasses []types.SendRestrictionBypass
+	err := k.IterateSendRestrictionBypasses(ctx, func(bypass types.SendRestrictionBypass) bool {
+		bypasses = append(bypasses, bypass)
+		return false
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QuerySendRestrictionBypassesResponse{Bypasses: bypasses}, nil
+}
+
+// SupplyHistory returns the recorded mints and burns of a marker's coin, oldest first
+func (k Keeper) SupplyHistory(c context.Context, req *types.QuerySupplyHistoryRequest) (*types.QuerySupplyHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	marker, err := accountForDenomOrAddress(ctx, k, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	params := k.GetParams(ctx)
+	var changes []types.SupplyChange
+	historyStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.SupplyHistoryKeyPrefix(marker.GetAddress()))
+	pageRes, err := query.FilteredPaginate(historyStore, req.Pagination, func(_ []byte, value []byte, accumulate bool) (bool, error) {
+		var change types.SupplyChange
+		if err := k.cdc.Unmarshal(value, &change); err != nil {
+			return false, err
+		}
+		// Expired entries are only pruned when the marker's supply next changes, so they're filtered out here.
+		if isSupplyChangeExpired(ctx, params, change) {
+			return false, nil
+		}
+		if accumulate {
+			changes = append(changes, change)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QuerySupplyHistoryResponse{Changes: changes, Pagination: pageRes}, nil
+}
+
+// DenomOwner returns what controls a denom: the marker that manages it, the IBC trace it came in on, or nothing
+func (k Keeper) DenomOwner(c context.Context, req *types.QueryDenomOwnerRequest) (*types.QueryDenomOwnerResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if err := sdk.ValidateDenom(req.Denom); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	resp := &types.QueryDenomOwnerResponse{Denom: req.Denom, OwnerType: types.DenomOwnerType_Unmanaged}
+
+	// Ibc vouchers can also have a marker (e.g. to restrict them), so the trace is looked up either way.
+	if hexHash, isIbc := strings.CutPrefix(req.Denom, transfertypes.DenomPrefix+"/"); isIbc {
+		hash, err := transfertypes.ParseHexHash(hexHash)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		trace, found := k.ibcTransferServer.GetDenomTrace(ctx, hash)
+		if !found {
+			return nil, status.Errorf(codes.NotFound, "denom trace not found for %s", req.Denom)
+		}
+		resp.OwnerType = types.DenomOwnerType_IBC
+		resp.IbcPath = trace.Path
+		resp.IbcBaseDenom = trace.BaseDenom
+	}
+
+	if marker, err := k.GetMarkerByDenom(ctx, req.Denom); err == nil {
+		resp.OwnerType = types.DenomOwnerType_Marker
+		resp.MarkerAddress = marker.GetAddress().String()
+		resp.MarkerType = marker.GetMarkerType()
+		resp.MarkerStatus = marker.GetStatus()
+		for _, addr := range marker.AddressListForPermission(types.Access_Admin) {
+			resp.Admins = append(resp.Admins, addr.String())
+		}
+	}
+
+	return resp, nil
+}
+
+// DepositAllowList returns the accounts allowed to deposit funds into a marker
+func (k Keeper) DepositAllowList(c context.Context, req *types.QueryDepositAllowListRequest) (*types.QueryDepositAllowListResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	marker, err := accountForDenomOrAddress(ctx, k, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &types.QueryDepositAllowListResponse{}
+	for _, addr := range k.GetDepositAllowList(ctx, marker.GetAddress()) {
+		resp.Addresses = append(resp.Addresses, addr.String())
+	}
+	return resp, nil
+}
+
+// FeeSponsorship returns the fee sponsorship of a marker
+func (k Keeper) FeeSponsorship(c context.Context, req *types.QueryFeeSponsorshipRequest) (*types.QueryFeeSponsorshipResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	marker, err := accountForDenomOrAddress(ctx, k, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &types.QueryFeeSponsorshipResponse{}
+	resp.Sponsorship, err = k.GetFeeSponsorship(ctx, marker.GetAddress())
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if len(req.Holder) > 0 {
+		holder, err := sdk.AccAddressFromBech32(req.Holder)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid holder %q: %v", req.Holder, err)
+		}
+		resp.Claimed = k.HasClaimedFeeSponsorship(ctx, marker.GetAddress(), holder)
+	}
+	return resp, nil
+}
+
+// BridgeInfo returns the bridge info of a wrapped-asset marker
+func (k Keeper) BridgeInfo(c context.Context, req *types.QueryBridgeInfoRequest) (*types.QueryBridgeInfoResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	marker, err := accountForDenomOrAddress(ctx, k, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	info, err := k.GetBridgeInfo(ctx, marker.GetAddress())
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryBridgeInfoResponse{BridgeInfo: info}, nil
+}
+
+// MintAttestations returns the attested mints of a wrapped-asset marker's coin, oldest first
+func (k Keeper) MintAttestations(c context.Context, req *types.QueryMintAttestationsRequest) (*types.QueryMintAttestationsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	marker, err := accountForDenomOrAddress(ctx, k, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	var attestations []types.MintAttestation
+	attestationStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.MintAttestationKeyPrefix(marker.GetAddress()))
+	pageRes, err := query.Paginate(attestationStore, req.Pagination, func(_ []byte, value []byte) error {
+		var attestation types.MintAttestation
+		if err := k.cdc.Unmarshal(value, &attestation); err != nil {
+			return err
+		}
+		attestations = append(attestations, attestation)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryMintAttestationsResponse{Attestations: attestations, Pagination: pageRes}, nil
+}
+
+// BasketInfo returns the components of a basket marker and the coins backing its supply
+func (k Keeper) BasketInfo(c context.Context, req *types.QueryBasketInfoRequest) (*types.QueryBasketInfoResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	marker, err := accountForDenomOrAddress(ctx, k, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	info, err := k.GetBasketInfo(ctx, marker.GetAddress())
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	resp := &types.QueryBasketInfoResponse{BasketInfo: info}
+	if info != nil {
+		resp.Backing = info.GetBacking(k.bankKeeper.GetSupply(ctx, info.Denom).Amount)
+	}
+	return resp, nil
+}
+
+// IBCMemoActionConfig returns the actions that ICS-20 memos are allowed to request for a marker's coin
+func (k Keeper) IBCMemoActionConfig(c context.Context, req *types.QueryIBCMemoActionConfigRequest) (*types.QueryIBCMemoActionConfigResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	marker, err := accountForDenomOrAddress(ctx, k, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	config, err := k.GetIBCMemoActionConfig(ctx, marker.GetAddress())
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryIBCMemoActionConfigResponse{Config: config}, nil
+}
+
+// CanTransfer returns whether a bank send would be allowed, and if not, which send restriction would prevent it
+func (k Keeper) CanTransfer(c context.Context, req *types.QueryCanTransferRequest) (*types.QueryCanTransferResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	fromAddr, err := sdk.AccAddressFromBech32(req.FromAddress)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid from address: %v", err)
+	}
+	toAddr, err := sdk.AccAddressFromBech32(req.ToAddress)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid to address: %v", err)
+	}
+	amt, err := sdk.ParseCoinsNormalized(req.Amount)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid amount: %v", err)
+	}
+	if amt.IsZero() {
+		return nil, status.Error(codes.InvalidArgument, "amount cannot be zero")
+	}
+
+	if err = k.CheckTransfer(ctx, fromAddr, toAddr, amt); err != nil {
+		return &types.QueryCanTransferResponse{FailingRule: types.GetSendRule(err), Error: err.Error()}, nil
+	}
+	return &types.QueryCanTransferResponse{CanTransfer: true}, nil
+}
@@ -0,0 +1,86 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/marker/types"
+	nametypes "github.com/provenance-io/provenance/x/name/types"
+)
+
+var _ nametypes.AddressRotator = Keeper{}
+
+// RotateAddress moves the old address's access grants (and manager role) on all modifiable markers to the new address.
+// If the new address already has access on a marker, the old address's permissions are added to it.
+// Markers that are cancelled or destroyed are left alone, since they can no longer be changed.
+// The old address is used as the administrator in the emitted events.
+func (k Keeper) RotateAddress(ctx sdk.Context, oldAddr, newAddr sdk.AccAddress) error {
+	var toUpdate []types.MarkerAccountI
+	k.IterateMarkers(ctx, func(marker types.MarkerAccountI) bool {
+		switch marker.GetStatus() {
+		case types.StatusProposed, types.StatusFinalized, types.StatusActive:
+		default:
+			return false
+		}
+		if marker.GetManager().Equals(oldAddr) || hasGrantFor(marker, oldAddr) {
+			toUpdate = append(toUpdate, marker)
+		}
+		return false
+	})
+
+	for _, marker := range toUpdate {
+		denom := marker.GetDenom()
+		if marker.GetManager().Equals(oldAddr) {
+			ma, ok := marker.(*types.MarkerAccount)
+			if !ok {
+				return fmt.Errorf("could not change manager of %s marker: unexpected marker type %T", denom, marker)
+			}
+			// SetManager only allows proposed markers, but finalized ones can still have a manager too.
+			ma.Manager = newAddr.String()
+		}
+
+		var grant types.AccessGrantI
+		for _, ag := range marker.GetAccessList() {
+			if ag.GetAddress().Equals(oldAddr) {
+				newGrant := types.NewAccessGrant(newAddr, ag.GetAccessList())
+				newGrant.Expiration = ag.Expiration
+				grant = newGrant
+				break
+			}
+		}
+		if grant != nil {
+			if err := marker.GrantAccess(grant); err != nil {
+				return fmt.Errorf("could not grant access on %s marker to %s: %w", denom, newAddr, err)
+			}
+			if err := marker.RevokeAccess(oldAddr); err != nil {
+				return fmt.Errorf("could not revoke access on %s marker from %s: %w", denom, oldAddr, err)
+			}
+		}
+
+		if err := marker.Validate(); err != nil {
+			return fmt.Errorf("invalid %s marker after rotating %s to %s: %w", denom, oldAddr, newAddr, err)
+		}
+		k.SetMarker(ctx, marker)
+
+		if grant != nil {
+			if err := ctx.EventManager().EmitTypedEvent(types.NewEventMarkerAddAccess(grant, denom, oldAddr.String())); err != nil {
+				return err
+			}
+			if err := ctx.EventManager().EmitTypedEvent(types.NewEventMarkerDeleteAccess(oldAddr.String(), denom, oldAddr.String())); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// hasGrantFor returns true if the marker has an access grant for the provided address.
+func hasGrantFor(marker types.MarkerAccountI, addr sdk.AccAddress) bool {
+	for _, ag := range marker.GetAccessList() {
+		if ag.GetAddress().Equals(addr) {
+			return true
+		}
+	}
+	return false
+}
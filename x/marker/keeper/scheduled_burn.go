@@ -0,0 +1,212 @@
+package keeper
+
+import (
+	"fmt"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+// GetScheduledBurn returns the scheduled burn with the given id, or nil if it doesn't exist.
+func (k Keeper) GetScheduledBurn(ctx sdk.Context, id uint64) (*types.ScheduledBurn, error) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ScheduledBurnKey(id))
+	if len(bz) == 0 {
+		return nil, nil
+	}
+	var burn types.ScheduledBurn
+	if err := k.cdc.Unmarshal(bz, &burn); err != nil {
+		return nil, fmt.Errorf("could not read scheduled burn %d: %w", id, err)
+	}
+	return &burn, nil
+}
+
+// SetScheduledBurn stores a scheduled burn and updates the indexes of its marker and burn height.
+func (k Keeper) SetScheduledBurn(ctx sdk.Context, burn types.ScheduledBurn) error {
+	markerAddr, err := types.MarkerAddress(burn.Amount.Denom)
+	if err != nil {
+		return err
+	}
+	bz, err := k.cdc.Marshal(&burn)
+	if err != nil {
+		return err
+	}
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ScheduledBurnKey(burn.Id), bz)
+	store.Set(types.ScheduledBurnMarkerIndexKey(markerAddr, burn.Id), []byte{})
+	store.Set(types.ScheduledBurnHeightIndexKey(burn.BurnHeight, burn.Id), []byte{})
+	return nil
+}
+
+// deleteScheduledBurn removes a scheduled burn and its index entries.
+func (k Keeper) deleteScheduledBurn(ctx sdk.Context, burn types.ScheduledBurn) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.ScheduledBurnKey(burn.Id))
+	store.Delete(types.ScheduledBurnMarkerIndexKey(types.MustGetMarkerAddress(burn.Amount.Denom), burn.Id))
+	store.Delete(types.ScheduledBurnHeightIndexKey(burn.BurnHeight, burn.Id))
+}
+
+// IterateScheduledBurns iterates over the scheduled burns of a marker in order of id.
+func (k Keeper) IterateScheduledBurns(ctx sdk.Context, markerAddr sdk.AccAddress, handler func(burn types.ScheduledBurn) (stop bool)) error {
+	store := ctx.KVStore(k.storeKey)
+	prefix := types.ScheduledBurnMarkerIndexKeyPrefix(markerAddr)
+	it := storetypes.KVStorePrefixIterator(store, prefix)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		id := sdk.BigEndianToUint64(it.Key()[len(prefix):])
+		burn, err := k.GetScheduledBurn(ctx, id)
+		if err != nil {
+			return err
+		}
+		if burn == nil {
+			return fmt.Errorf("scheduled burn %d not found", id)
+		}
+		if handler(*burn) {
+			break
+		}
+	}
+	return nil
+}
+
+// IterateAllScheduledBurns iterates over the scheduled burns of all markers in order of id.
+func (k Keeper) IterateAllScheduledBurns(ctx sdk.Context, handler func(burn types.ScheduledBurn) (stop bool)) error {
+	store := ctx.KVStore(k.storeKey)
+	it := storetypes.KVStorePrefixIterator(store, types.ScheduledBurnPrefix)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		var burn types.ScheduledBurn
+		if err := k.cdc.Unmarshal(it.Value(), &burn); err != nil {
+			return err
+		}
+		if handler(burn) {
+			break
+		}
+	}
+	return nil
+}
+
+// getLastScheduledBurnID gets the id of the last burn scheduled.
+func (k Keeper) getLastScheduledBurnID(ctx sdk.Context) uint64 {
+	return sdk.BigEndianToUint64(ctx.KVStore(k.storeKey).Get(types.LastScheduledBurnIDKey))
+}
+
+// setLastScheduledBurnID sets the id of the last burn scheduled.
+func (k Keeper) setLastScheduledBurnID(ctx sdk.Context, id uint64) {
+	ctx.KVStore(k.storeKey).Set(types.LastScheduledBurnIDKey, sdk.Uint64ToBigEndian(id))
+}
+
+// GetReservedForBurns returns the total of a marker's escrowed coin that is reserved for its scheduled burns.
+func (k Keeper) GetReservedForBurns(ctx sdk.Context, markerAddr sdk.AccAddress) (sdk.Coins, error) {
+	reserved := sdk.Coins{}
+	err := k.IterateScheduledBurns(ctx, markerAddr, func(burn types.ScheduledBurn) bool {
+		reserved = reserved.Add(burn.Amount)
+		return false
+	})
+	return reserved, err
+}
+
+// ScheduleBurn reserves some of a marker's escrowed coin to be burned at the end of the burn height block.
+// The burn can be cancelled in the cancel window, which starts with the current block. Returns the id of the new burn.
+func (k Keeper) ScheduleBurn(
+	ctx sdk.Context,
+	marker types.MarkerAccountI,
+	amount sdk.Coin,
+	burnHeight int64,
+	cancelWindow uint64,
+	administrator sdk.AccAddress,
+) (uint64, error) {
+	if marker.GetStatus() != types.StatusActive {
+		return 0, fmt.Errorf("cannot schedule a burn for %s marker with status %s", marker.GetDenom(), marker.GetStatus())
+	}
+	if amount.Denom != marker.GetDenom() {
+		return 0, fmt.Errorf("cannot schedule a burn of %s for %s marker", amount.Denom, marker.GetDenom())
+	}
+	if burnHeight <= ctx.BlockHeight() {
+		return 0, fmt.Errorf("burn height %d must be after the current block height %d", burnHeight, ctx.BlockHeight())
+	}
+	cancelDeadline := ctx.BlockHeight() + int64(cancelWindow)
+	if cancelWindow > uint64(burnHeight) || cancelDeadline > burnHeight {
+		return 0, fmt.Errorf("cancel window of %d blocks cannot close after burn height %d", cancelWindow, burnHeight)
+	}
+	if err := k.validateNotEarmarked(ctx, marker, sdk.NewCoins(amount)); err != nil {
+		return 0, err
+	}
+	if escrow := k.bankKeeper.GetBalance(ctx, marker.GetAddress(), amount.Denom); escrow.IsLT(amount) {
+		return 0, fmt.Errorf("cannot schedule a burn of %s: %s marker escrow only holds %s", amount, marker.GetDenom(), escrow)
+	}
+
+	id := k.getLastScheduledBurnID(ctx) + 1
+	k.setLastScheduledBurnID(ctx, id)
+	burn := types.NewScheduledBurn(id, amount, administrator.String(), ctx.BlockHeight(), burnHeight, cancelDeadline)
+	if err := k.SetScheduledBurn(ctx, burn); err != nil {
+		return 0, err
+	}
+
+	return id, ctx.EventManager().EmitTypedEvent(types.NewEventBurnScheduled(burn))
+}
+
+// CancelScheduledBurn cancels a scheduled burn whose cancel window is still open, releasing its reserved coin.
+func (k Keeper) CancelScheduledBurn(ctx sdk.Context, burn types.ScheduledBurn, administrator sdk.AccAddress) error {
+	if !burn.CanCancel(ctx.BlockHeight()) {
+		return fmt.Errorf("cannot cancel scheduled burn %d: cancel window closed at height %d", burn.Id, burn.CancelDeadline)
+	}
+	k.deleteScheduledBurn(ctx, burn)
+	return ctx.EventManager().EmitTypedEvent(types.NewEventScheduledBurnCancelled(burn, administrator.String()))
+}
+
+// ProcessScheduledBurns executes the scheduled burns with a burn height at or before the current block height.
+// A burn that fails is removed, and an EventScheduledBurnFailed is emitted for it.
+func (k Keeper) ProcessScheduledBurns(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	var ids []uint64
+	it := store.Iterator(types.ScheduledBurnHeightIndexPrefix, types.ScheduledBurnHeightIndexKeyPrefix(ctx.BlockHeight()+1))
+	for ; it.Valid(); it.Next() {
+		_, id := types.GetScheduledBurnHeightIndexKeyParts(it.Key())
+		ids = append(ids, id)
+	}
+	it.Close()
+
+	for _, id := range ids {
+		burn, err := k.GetScheduledBurn(ctx, id)
+		if err != nil || burn == nil {
+			k.Logger(ctx).Error("could not get scheduled burn", "id", id, "err", err)
+			continue
+		}
+		// The burn is removed first so that its coin is no longer reserved when it's burned.
+		k.deleteScheduledBurn(ctx, *burn)
+		if err = k.executeScheduledBurn(ctx, *burn); err != nil {
+			k.Logger(ctx).Error("could not execute scheduled burn", "id", id, "err", err)
+			if err = ctx.EventManager().EmitTypedEvent(types.NewEventScheduledBurnFailed(*burn, err)); err != nil {
+				k.Logger(ctx).Error("could not emit scheduled burn failed event", "id", id, "err", err)
+			}
+		}
+	}
+}
+
+// executeScheduledBurn burns the coin of a scheduled burn from its marker's escrow and emits the burn proof.
+// Nothing is changed if the burn fails.
+func (k Keeper) executeScheduledBurn(ctx sdk.Context, burn types.ScheduledBurn) error {
+	marker, err := k.GetMarkerByDenom(ctx, burn.Amount.Denom)
+	if err != nil {
+		return fmt.Errorf("marker not found for %s: %w", burn.Amount.Denom, err)
+	}
+	if marker.GetStatus() != types.StatusActive {
+		return fmt.Errorf("cannot burn coin for a marker that is not in Active status")
+	}
+
+	cacheCtx, writeCache := ctx.CacheContext()
+	if err = k.validateNotEarmarked(cacheCtx, marker, sdk.NewCoins(burn.Amount)); err != nil {
+		return err
+	}
+	supplyBefore := k.bankKeeper.GetSupply(cacheCtx, burn.Amount.Denom)
+	if err = k.DecreaseSupply(cacheCtx, marker, burn.Amount); err != nil {
+		return err
+	}
+	supplyAfter := k.bankKeeper.GetSupply(cacheCtx, burn.Amount.Denom)
+	writeCache()
+
+	return ctx.EventManager().EmitTypedEvent(types.NewEventMarkerBurnProof(burn, supplyBefore, supplyAfter))
+}
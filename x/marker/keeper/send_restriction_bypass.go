@@ -0,0 +1,126 @@
+package keeper
+
+import (
+	"fmt"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+// GetSendRestrictionBypass returns the send restriction bypass registry entry for an address, or nil if there isn't one.
+func (k Keeper) GetSendRestrictionBypass(ctx sdk.Context, addr sdk.AccAddress) (*types.SendRestrictionBypass, error) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.SendRestrictionBypassKey(addr))
+	if len(bz) == 0 {
+		return nil, nil
+	}
+	var bypass types.SendRestrictionBypass
+	if err := k.cdc.Unmarshal(bz, &bypass); err != nil {
+		return nil, fmt.Errorf("could not read send restriction bypass for %s: %w", addr, err)
+	}
+	return &bypass, nil
+}
+
+// SetSendRestrictionBypass adds or replaces an entry in the send restriction bypass registry.
+// Marker accounts cannot be given a bypass.
+func (k Keeper) SetSendRestrictionBypass(ctx sdk.Context, bypass types.SendRestrictionBypass) error {
+	if err := bypass.Validate(); err != nil {
+		return err
+	}
+	addr := sdk.MustAccAddressFromBech32(bypass.Address)
+	if k.IsMarkerAccount(ctx, addr) {
+		return fmt.Errorf("marker account %s cannot have a send restriction bypass", bypass.Address)
+	}
+	bz, err := k.cdc.Marshal(&bypass)
+	if err != nil {
+		return err
+	}
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.SendRestrictionBypassKey(addr), bz)
+	return nil
+}
+
+// RemoveSendRestrictionBypass removes an address from the send restriction bypass registry.
+func (k Keeper) RemoveSendRestrictionBypass(ctx sdk.Context, addr sdk.AccAddress) error {
+	store := ctx.KVStore(k.storeKey)
+	key := types.SendRestrictionBypassKey(addr)
+	if !store.Has(key) {
+		return fmt.Errorf("no send restriction bypass found for %s", addr)
+	}
+	store.Delete(key)
+	return nil
+}
+
+// IterateSendRestrictionBypasses iterates over all entries in the send restriction bypass registry.
+func (k Keeper) IterateSendRestrictionBypasses(ctx sdk.Context, handler func(bypass types.SendRestrictionBypass) (stop bool)) error {
+	store := ctx.KVStore(k.storeKey)
+	it := storetypes.KVStorePrefixIterator(store, types.SendRestrictionBypassPrefix)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		var bypass types.SendRestrictionBypass
+		if err := k.cdc.Unmarshal(it.Value(), &bypass); err != nil {
+			return err
+		}
+		if handler(bypass) {
+			break
+		}
+	}
+	return nil
+}
+
+// UpdateSendRestrictionBypasses removes and then sets entries in the send restriction bypass registry.
+func (k Keeper) UpdateSendRestrictionBypasses(ctx sdk.Context, set []types.SendRestrictionBypass, remove []string) error {
+	for _, addrStr := range remove {
+		addr, err := sdk.AccAddressFromBech32(addrStr)
+		if err != nil {
+			return fmt.Errorf("invalid address to remove %q: %w", addrStr, err)
+		}
+		if err = k.RemoveSendRestrictionBypass(ctx, addr); err != nil {
+			return err
+		}
+		if err = ctx.EventManager().EmitTypedEvent(types.NewEventSendRestrictionBypassRemoved(addrStr)); err != nil {
+			return err
+		}
+	}
+	for _, bypass := range set {
+		if err := k.SetSendRestrictionBypass(ctx, bypass); err != nil {
+			return err
+		}
+		if err := ctx.EventManager().EmitTypedEvent(types.NewEventSendRestrictionBypassSet(bypass)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// getSendRestrictionBypassType returns the type of send restriction bypass that the address has.
+// If the address isn't in the registry (or its entry can't be read), BypassTypeUnspecified is returned.
+// This is looked up during almost every send, so it does not consume gas; that way, having the
+// registry in state instead of in code does not increase the cost of every send.
+func (k Keeper) getSendRestrictionBypassType(ctx sdk.Context, addr sdk.AccAddress) types.SendRestrictionBypassType {
+	if len(addr) == 0 {
+		return types.BypassTypeUnspecified
+	}
+	bypass, err := k.GetSendRestrictionBypass(ctx.WithGasMeter(storetypes.NewInfiniteGasMeter()), addr)
+	if err != nil || bypass == nil {
+		return types.BypassTypeUnspecified
+	}
+	return bypass.BypassType
+}
+
+// IsReqAttrBypassAddr returns true if the provided addr can bypass the required attributes checking.
+// When sending to one of these, if there are required attributes, it behaves as if the addr has them;
+// if there aren't required attributes, the sender still needs transfer permission.
+// When sending from one of these, if there are required attributes, the destination must have them;
+// if there aren't required attributes, it behaves as if the sender has transfer permission.
+func (k Keeper) IsReqAttrBypassAddr(ctx sdk.Context, addr sdk.AccAddress) bool {
+	return k.getSendRestrictionBypassType(ctx, addr) == types.BypassTypeRequiredAttributes
+}
+
+// IsSenderBypassAddr returns true if sends from the provided addr skip the marker send restrictions.
+func (k Keeper) IsSenderBypassAddr(ctx sdk.Context, addr sdk.AccAddress) bool {
+	return k.getSendRestrictionBypassType(ctx, addr) == types.BypassTypeSender
+}
@@ -0,0 +1,84 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	simapp "github.com/provenance-io/provenance/app"
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+func TestSendRestrictionBypassRegistry(t *testing.T) {
+	app := simapp.Setup(t)
+	ctx := app.BaseApp.NewContext(false)
+	addr := sdk.AccAddress("bypass_addr_________")
+	other := sdk.AccAddress("other_addr__________")
+
+	bypass, err := app.MarkerKeeper.GetSendRestrictionBypass(ctx, addr)
+	require.NoError(t, err, "GetSendRestrictionBypass before set")
+	assert.Nil(t, bypass, "GetSendRestrictionBypass before set")
+	assert.EqualError(t, app.MarkerKeeper.RemoveSendRestrictionBypass(ctx, addr),
+		"no send restriction bypass found for "+addr.String(), "RemoveSendRestrictionBypass before set")
+
+	expected := types.NewSendRestrictionBypass(addr, types.BypassTypeRequiredAttributes, "testing")
+	require.NoError(t, app.MarkerKeeper.SetSendRestrictionBypass(ctx, expected), "SetSendRestrictionBypass")
+	bypass, err = app.MarkerKeeper.GetSendRestrictionBypass(ctx, addr)
+	require.NoError(t, err, "GetSendRestrictionBypass after set")
+	assert.Equal(t, &expected, bypass, "GetSendRestrictionBypass after set")
+	assert.True(t, app.MarkerKeeper.IsReqAttrBypassAddr(ctx, addr), "IsReqAttrBypassAddr after set")
+	assert.False(t, app.MarkerKeeper.IsSenderBypassAddr(ctx, addr), "IsSenderBypassAddr after set")
+	assert.False(t, app.MarkerKeeper.IsReqAttrBypassAddr(ctx, other), "IsReqAttrBypassAddr(other)")
+
+	expected.BypassType = types.BypassTypeSender
+	require.NoError(t, app.MarkerKeeper.SetSendRestrictionBypass(ctx, expected), "SetSendRestrictionBypass as sender")
+	assert.False(t, app.MarkerKeeper.IsReqAttrBypassAddr(ctx, addr), "IsReqAttrBypassAddr after update")
+	assert.True(t, app.MarkerKeeper.IsSenderBypassAddr(ctx, addr), "IsSenderBypassAddr after update")
+
+	require.NoError(t, app.MarkerKeeper.RemoveSendRestrictionBypass(ctx, addr), "RemoveSendRestrictionBypass")
+	assert.False(t, app.MarkerKeeper.IsSenderBypassAddr(ctx, addr), "IsSenderBypassAddr after remove")
+
+	err = app.MarkerKeeper.SetSendRestrictionBypass(ctx, types.NewSendRestrictionBypass(addr, types.BypassTypeUnspecified, ""))
+	assert.EqualError(t, err, "invalid send restriction bypass type for "+addr.String()+": SEND_RESTRICTION_BYPASS_TYPE_UNSPECIFIED",
+		"SetSendRestrictionBypass unspecified type")
+
+	marker := types.NewEmptyMarkerAccount("bypasscoin", addr.String(), nil)
+	marker.Supply = sdkmath.NewInt(100)
+	require.NoError(t, app.MarkerKeeper.AddMarkerAccount(ctx, marker), "AddMarkerAccount")
+	err = app.MarkerKeeper.SetSendRestrictionBypass(ctx, types.NewSendRestrictionBypass(marker.GetAddress(), types.BypassTypeSender, ""))
+	assert.EqualError(t, err, "marker account "+marker.GetAddress().String()+" cannot have a send restriction bypass",
+		"SetSendRestrictionBypass marker account")
+}
+
+func TestSendRestrictionFnWithSenderBypass(t *testing.T) {
+	app := simapp.Setup(t)
+	ctx := app.BaseApp.NewContext(false)
+	owner := sdk.AccAddress("owner_address_______")
+	sender := sdk.AccAddress("sender_address______")
+	receiver := sdk.AccAddress("receiver_address____")
+
+	marker := types.NewEmptyMarkerAccount("senderbypasscoin", owner.String(),
+		[]types.AccessGrant{*types.NewAccessGrant(owner, []types.Access{types.Access_Mint, types.Access_Admin, types.Access_Withdraw})})
+	marker.MarkerType = types.MarkerType_RestrictedCoin
+	marker.Supply = sdkmath.NewInt(1000)
+	require.NoError(t, app.MarkerKeeper.AddFinalizeAndActivateMarker(ctx, marker), "AddFinalizeAndActivateMarker")
+	amt := sdk.NewCoins(sdk.NewInt64Coin(marker.Denom, 1))
+
+	_, err := app.MarkerKeeper.SendRestrictionFn(ctx, sender, receiver, amt)
+	assert.EqualError(t, err, sender.String()+" does not have transfer permissions for "+marker.Denom, "SendRestrictionFn without bypass")
+
+	require.NoError(t, app.MarkerKeeper.SetSendRestrictionBypass(ctx, types.NewSendRestrictionBypass(sender, types.BypassTypeSender, "")),
+		"SetSendRestrictionBypass")
+	newTo, err := app.MarkerKeeper.SendRestrictionFn(ctx, sender, receiver, amt)
+	require.NoError(t, err, "SendRestrictionFn with sender bypass")
+	assert.Equal(t, receiver, newTo, "SendRestrictionFn with sender bypass result")
+
+	_, err = app.MarkerKeeper.SendRestrictionFn(ctx, sender, authtypes.NewModuleAddress(authtypes.FeeCollectorName), amt)
+	assert.EqualError(t, err, "cannot send restricted denom "+marker.Denom+" to the fee collector", "SendRestrictionFn to fee collector with sender bypass")
+}
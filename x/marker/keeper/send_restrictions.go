@@ -2,24 +2,37 @@ package keeper
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
 	internalsdk "github.com/provenance-io/provenance/internal/sdk"
 	attrTypes "github.com/provenance-io/provenance/x/attribute/types"
 	"github.com/provenance-io/provenance/x/marker/types"
+	sanctionerrors "github.com/provenance-io/provenance/x/sanction/errors"
 )
 
 var _ banktypes.SendRestrictionFn = Keeper{}.SendRestrictionFn
 
 func (k Keeper) SendRestrictionFn(goCtx context.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) (sdk.AccAddress, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
+	// The shares of a distribution come from the holders' balances as they're paid out, so those balances
+	// can't change until it completes. This applies to everyone, bypass or not.
+	for _, coin := range amt {
+		if k.HasDistributionInProgress(ctx, types.MustGetMarkerAddress(coin.Denom)) {
+			return nil, types.NewSendRuleError(types.SendRuleDistribution,
+				fmt.Errorf("cannot send %s coins while a distribution to its holders is in progress", coin.Denom))
+		}
+	}
+
 	// In some cases, it might not be possible to add a bypass to the context.
-	// If it's from either the Marker or IBC Transfer module accounts, assume proper validation has been done elsewhere.
-	if types.HasBypass(ctx) || fromAddr.Equals(k.markerModuleAddr) || fromAddr.Equals(k.ibcTransferModuleAddr) {
+	// If it's from the Marker module account, or an account with a sender bypass
+	// (e.g. the IBC Transfer module account), assume proper validation has been done elsewhere.
+	if types.HasBypass(ctx) || fromAddr.Equals(k.markerModuleAddr) || k.IsSenderBypassAddr(ctx, fromAddr) {
 		// But still don't let restricted denoms get sent to the fee collector.
 		if toAddr.Equals(k.feeCollectorAddr) {
 			for _, coin := range amt {
@@ -29,7 +42,16 @@ func (k Keeper) SendRestrictionFn(goCtx context.Context, fromAddr, toAddr sdk.Ac
 					return nil, err
 				}
 				if marker != nil && marker.GetMarkerType() == types.MarkerType_RestrictedCoin {
-					return nil, fmt.Errorf("cannot send restricted denom %s to the fee collector", coin.Denom)
+					return nil, types.NewSendRuleError(types.SendRuleFeeCollector,
+						fmt.Errorf("cannot send restricted denom %s to the fee collector", coin.Denom))
+				}
+			}
+		}
+		// Deposits from accounts with a sender bypass (e.g. incoming IBC transfers) are still subject to deposit allow lists.
+		if !types.HasBypass(ctx) && !fromAddr.Equals(k.markerModuleAddr) {
+			if toMarker, _ := k.GetMarker(ctx, toAddr); toMarker != nil {
+				if err := k.validateDepositAllowed(ctx, toMarker, fromAddr, nil); err != nil {
+					return nil, types.NewSendRuleError(types.SendRuleMarkerDeposit, err)
 				}
 			}
 		}
@@ -41,21 +63,25 @@ func (k Keeper) SendRestrictionFn(goCtx context.Context, fromAddr, toAddr sdk.Ac
 	if fromMarker, _ := k.GetMarker(ctx, fromAddr); fromMarker != nil {
 		// The only ways to legitimately send from a marker account is to have a transfer agent with
 		// withdraw permissions, or through a feegrant. The only way to have a feegrant from
-		// a marker account is if an admin creates one using the marker module's GrantAllowance endpoint.
+		// a marker account is if an admin creates one using the marker module's GrantAllowance endpoint,
+		// or a holder claims one from a fee sponsorship that an admin gave the marker.
 		// So if a feegrant is in use, that means the sending of these funds is authorized.
 		// We also assume that other stuff is handling the actual checking and use of that feegrant,
 		// so we don't need to worry about its details in here, and that HasFeeGrantInUse is only ever
 		// true when collecting fees.
 		if !internalsdk.HasFeeGrantInUse(ctx) {
 			if len(admins) == 0 {
-				return nil, fmt.Errorf("cannot withdraw from marker account %s (%s)",
-					fromAddr.String(), fromMarker.GetDenom())
+				return nil, types.NewSendRuleError(types.SendRuleMarkerWithdraw,
+					fmt.Errorf("cannot withdraw from marker account %s (%s)", fromAddr.String(), fromMarker.GetDenom()))
 			}
 
 			// Need at least one admin that can make withdrawals.
 			if err := types.ValidateAtLeastOneAddrHasAccess(fromMarker, admins, types.Access_Withdraw); err != nil {
-				return nil, err
+				return nil, types.NewSendRuleError(types.SendRuleMarkerWithdraw, err)
 			}
+		} else if err := k.validateNotEarmarked(ctx, fromMarker, amt); err != nil {
+			// Fees paid through a feegrant can't come out of funds that are earmarked for something else.
+			return nil, types.NewSendRuleError(types.SendRuleMarkerWithdraw, err)
 		}
 
 		// Check to see if marker is active; the coins created by a marker can only be withdrawn when it is active.
@@ -63,23 +89,30 @@ func (k Keeper) SendRestrictionFn(goCtx context.Context, fromAddr, toAddr sdk.Ac
 		if fromMarker.GetStatus() != types.StatusActive {
 			hasFromCoin, fromAmt := amt.Find(fromMarker.GetDenom())
 			if hasFromCoin && !fromAmt.IsZero() {
-				return nil, fmt.Errorf("cannot withdraw %s from %s marker (%s): marker status (%s) is not %s",
-					fromAmt, fromMarker.GetDenom(), fromAddr, fromMarker.GetStatus(), types.StatusActive)
+				return nil, types.NewSendRuleError(types.SendRuleMarkerStatus,
+					fmt.Errorf("cannot withdraw %s from %s marker (%s): marker status (%s) is not %s",
+						fromAmt, fromMarker.GetDenom(), fromAddr, fromMarker.GetStatus(), types.StatusActive))
 			}
 		}
 	}
 
+	// If it's going to a marker with a deposit allow list, fromAddr or an admin must be on it (or have deposit access).
 	// If it's going to a restricted marker, either an admin (if there is one) or
 	// fromAddr (if there isn't an admin) must have deposit access on that marker.
 	toMarker, _ := k.GetMarker(ctx, toAddr)
+	if toMarker != nil {
+		if err := k.validateDepositAllowed(ctx, toMarker, fromAddr, admins); err != nil {
+			return nil, types.NewSendRuleError(types.SendRuleMarkerDeposit, err)
+		}
+	}
 	if toMarker != nil && toMarker.GetMarkerType() == types.MarkerType_RestrictedCoin {
 		if len(admins) > 0 {
 			if err := types.ValidateAtLeastOneAddrHasAccess(toMarker, admins, types.Access_Deposit); err != nil {
-				return nil, err
+				return nil, types.NewSendRuleError(types.SendRuleMarkerDeposit, err)
 			}
 		} else {
 			if err := toMarker.ValidateAddressHasAccess(fromAddr, types.Access_Deposit); err != nil {
-				return nil, err
+				return nil, types.NewSendRuleError(types.SendRuleMarkerDeposit, err)
 			}
 		}
 	}
@@ -94,6 +127,35 @@ func (k Keeper) SendRestrictionFn(goCtx context.Context, fromAddr, toAddr sdk.Ac
 	return toAddr, nil
 }
 
+// CheckTransfer returns an error if a bank send of the amount from fromAddr to toAddr would fail.
+// The returned error identifies the failing send restriction (see types.GetSendRule).
+// The send is attempted in a cache context that is discarded, so no state is changed.
+func (k Keeper) CheckTransfer(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error {
+	if k.bankKeeper.BlockedAddr(toAddr) {
+		return types.NewSendRuleError(types.SendRuleBlockedAddress,
+			fmt.Errorf("%s is not allowed to receive funds", toAddr.String()))
+	}
+	if err := k.bankKeeper.IsSendEnabledCoins(ctx, amt...); err != nil {
+		return types.NewSendRuleError(types.SendRuleSendDisabled, err)
+	}
+	if _, err := k.SendRestrictionFn(ctx, fromAddr, toAddr, amt); err != nil {
+		return err
+	}
+
+	// The other send restrictions (e.g. sanctions) and the balance are checked by actually doing the send.
+	cacheCtx, _ := ctx.CacheContext()
+	err := k.bankKeeper.SendCoins(cacheCtx, fromAddr, toAddr, amt)
+	switch {
+	case err == nil:
+		return nil
+	case errors.Is(err, sanctionerrors.ErrSanctionedAccount):
+		return types.NewSendRuleError(types.SendRuleSanction, err)
+	case errors.Is(err, sdkerrors.ErrInsufficientFunds):
+		return types.NewSendRuleError(types.SendRuleBalance, err)
+	}
+	return err
+}
+
 // validateSendDenom makes sure a send of the given denom is allowed for the given addresses.
 // This is NOT the validation that is needed for the marker Transfer endpoint.
 func (k Keeper) validateSendDenom(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, admins []sdk.AccAddress, denom string, toMarker types.MarkerAccountI) error {
@@ -105,7 +167,8 @@ func (k Keeper) validateSendDenom(ctx sdk.Context, fromAddr, toAddr sdk.AccAddre
 
 	// If there's a marker, it must be active.
 	if marker != nil && marker.GetStatus() != types.StatusActive {
-		return fmt.Errorf("cannot send %s coins: marker status (%s) is not %s", denom, marker.GetStatus(), types.StatusActive)
+		return types.NewSendRuleError(types.SendRuleMarkerStatus,
+			fmt.Errorf("cannot send %s coins: marker status (%s) is not %s", denom, marker.GetStatus(), types.StatusActive))
 	}
 
 	// If there's no marker for the denom, or it's not a restricted marker, there's nothing more to do here.
@@ -115,7 +178,8 @@ func (k Keeper) validateSendDenom(ctx sdk.Context, fromAddr, toAddr sdk.AccAddre
 
 	// We can't allow restricted coins to end up with the fee collector.
 	if toAddr.Equals(k.feeCollectorAddr) {
-		return fmt.Errorf("restricted denom %s cannot be sent to the fee collector", denom)
+		return types.NewSendRuleError(types.SendRuleFeeCollector,
+			fmt.Errorf("restricted denom %s cannot be sent to the fee collector", denom))
 	}
 
 	// If there's an admin that has transfer access, it's not a normal bank send and there's nothing more to do here.
@@ -128,7 +192,8 @@ func (k Keeper) validateSendDenom(ctx sdk.Context, fromAddr, toAddr sdk.AccAddre
 	// They can either take themselves off the list and do the send again, or just use the transfer endpoint.
 	// But for normal sends (without a transfer agent), we want the send-deny list enforced first.
 	if k.IsSendDeny(ctx, markerAddr, fromAddr) {
-		return fmt.Errorf("%s is on deny list for sending restricted marker", fromAddr.String())
+		return types.NewSendRuleError(types.SendRuleSendDenyList,
+			fmt.Errorf("%s is on deny list for sending restricted marker", fromAddr.String()))
 	}
 
 	// If the fromAddr has transfer access, there's nothing left to check.
@@ -139,50 +204,66 @@ func (k Keeper) validateSendDenom(ctx sdk.Context, fromAddr, toAddr sdk.AccAddre
 	// If going to a marker, transfer permission is required regardless of whether it's coming from a bypass.
 	// If someone wants to deposit funds from a bypass account, they can either send the funds to a valid
 	// intermediary account and deposit them from there, or give the bypass account deposit and transfer permissions.
-	// It's assumed that a marker address cannot be in the bypass list.
+	// A marker address cannot be in the bypass registry.
 	if toMarker != nil {
 		if len(admins) == 0 {
-			return fmt.Errorf("%s does not have %s on %s marker (%s)",
-				fromAddr, types.Access_Transfer, denom, marker.GetAddress())
+			return types.NewSendRuleError(types.SendRuleTransferPermission,
+				fmt.Errorf("%s does not have %s on %s marker (%s)", fromAddr, types.Access_Transfer, denom, marker.GetAddress()))
 		}
 		addrs := make([]string, 1+len(admins))
 		addrs[0] = fromAddr.String()
 		for i, admin := range admins {
 			addrs[i+1] = admin.String()
 		}
-		return fmt.Errorf("none of %q have %s on %s marker (%s)",
-			addrs, types.Access_Transfer, denom, marker.GetAddress())
+		return types.NewSendRuleError(types.SendRuleTransferPermission,
+			fmt.Errorf("none of %q have %s on %s marker (%s)", addrs, types.Access_Transfer, denom, marker.GetAddress()))
 	}
 
 	// If there aren't any required attributes, transfer permission is required unless coming from a bypass account.
 	// It's assumed that the only way the restricted coins without required attributes can get into a bypass
 	// account is by someone with transfer permission, which is then conveyed for this transfer too.
 	reqAttr := marker.GetRequiredAttributes()
-	if len(reqAttr) == 0 {
-		if k.IsReqAttrBypassAddr(fromAddr) {
+	reqSenderAttr := marker.GetRequiredSenderAttributes()
+	if len(reqAttr) == 0 && len(reqSenderAttr) == 0 {
+		if k.IsReqAttrBypassAddr(ctx, fromAddr) {
 			return nil
 		}
-		return fmt.Errorf("%s does not have transfer permissions for %s", fromAddr.String(), denom)
+		return types.NewSendRuleError(types.SendRuleTransferPermission,
+			fmt.Errorf("%s does not have transfer permissions for %s", fromAddr.String(), denom))
 	}
 
 	// At this point, we know there are required attributes and that fromAddr does not have transfer permission.
+	// If the fromAddress has a bypass, skip checking its attributes; it's assumed that the coins were checked on the
+	// way into the bypass account. Otherwise, it must have all of the required sender attributes.
+	if len(reqSenderAttr) > 0 && !k.IsReqAttrBypassAddr(ctx, fromAddr) {
+		if err = k.validateHasRequiredAttributes(ctx, fromAddr, denom, reqSenderAttr, "sender "); err != nil {
+			return types.NewSendRuleError(types.SendRuleRequiredAttributes, err)
+		}
+	}
+
 	// If the toAddress has a bypass, skip checking the attributes and allow the transfer.
 	// When these funds are then being moved out of the bypass account, attributes are checked on that destination.
-	if k.IsReqAttrBypassAddr(toAddr) {
+	if len(reqAttr) == 0 || k.IsReqAttrBypassAddr(ctx, toAddr) {
 		return nil
 	}
 
-	attributes, err := k.attrKeeper.GetAllAttributesAddr(ctx, toAddr)
+	return types.NewSendRuleError(types.SendRuleRequiredAttributes, k.validateHasRequiredAttributes(ctx, toAddr, denom, reqAttr, ""))
+}
+
+// validateHasRequiredAttributes returns an error if the address does not have an attribute matching each of the
+// required attributes. The kind is included in the error message just before "required attribute" (e.g. "sender ").
+func (k Keeper) validateHasRequiredAttributes(ctx sdk.Context, addr sdk.AccAddress, denom string, required []string, kind string) error {
+	attributes, err := k.attrKeeper.GetAllAttributesAddr(ctx, addr)
 	if err != nil {
-		return fmt.Errorf("could not get attributes for %s: %w", toAddr.String(), err)
+		return fmt.Errorf("could not get attributes for %s: %w", addr.String(), err)
 	}
-	missing := findMissingAttributes(reqAttr, attributes)
+	missing := findMissingAttributes(required, attributes)
 	if len(missing) != 0 {
 		pl := ""
 		if len(missing) != 1 {
 			pl = "s"
 		}
-		return fmt.Errorf("address %s does not contain the %q required attribute%s: \"%s\"", toAddr.String(), denom, pl, strings.Join(missing, `", "`))
+		return fmt.Errorf("address %s does not contain the %q required %sattribute%s: \"%s\"", addr.String(), denom, kind, pl, strings.Join(missing, `", "`))
 	}
 
 	return nil
@@ -13,6 +13,7 @@ import (
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
 
 	simapp "github.com/provenance-io/provenance/app"
 	internalsdk "github.com/provenance-io/provenance/internal/sdk"
@@ -76,7 +77,14 @@ func TestSendRestrictionFn(t *testing.T) {
 	addrOther2 := sdk.AccAddress("addrOther2__________")              // cosmos1v9jxguj0w35x2u3jta047h6lta047h6lucvw6t
 
 	addrFeeCollector := app.MarkerKeeper.GetFeeCollectorAddr() // cosmos17xpfvakm2amg962yls6f84z3kell8c5lserqta
-	bypassAddrs := app.MarkerKeeper.GetReqAttrBypassAddrs()
+	var bypassAddrs []sdk.AccAddress
+	err := app.MarkerKeeper.IterateSendRestrictionBypasses(ctx, func(bypass types.SendRestrictionBypass) bool {
+		if bypass.BypassType == types.BypassTypeRequiredAttributes {
+			bypassAddrs = append(bypassAddrs, sdk.MustAccAddressFromBech32(bypass.Address))
+		}
+		return false
+	})
+	require.NoError(t, err, "IterateSendRestrictionBypasses")
 	var addrWithBypass, addrWithBypassNoDep sdk.AccAddress
 	for _, addr := range bypassAddrs {
 		if addr.Equals(addrFeeCollector) {
@@ -166,6 +174,14 @@ func TestSendRestrictionFn(t *testing.T) {
 	require.NoError(t,
 		testutil.FundAccount(types.WithBypass(ctx), app.BankKeeper, gMarker.GetAddress(), cz(c(5000, denomOther))),
 		"Adding funds to %s marker account", gDenom)
+	// And some funds that are mostly earmarked in an escrow ledger.
+	denomEarmarked := "earmarkedcoin"
+	require.NoError(t,
+		testutil.FundAccount(types.WithBypass(ctx), app.BankKeeper, gMarker.GetAddress(), cz(c(100, denomEarmarked))),
+		"Adding earmarked funds to %s marker account", gDenom)
+	require.NoError(t,
+		app.MarkerKeeper.SetEscrowLedger(ctx, gMarker.GetAddress(), types.EscrowLedger{Denom: gDenom, Name: "reserves", Balance: cz(c(90, denomEarmarked))}),
+		"SetEscrowLedger(%s, reserves)", gDenom)
 
 	rDenomNoAttr := "restrictedmarkernoreqattributes" // cosmos1y4lw4mu35znxeuu00t2waemytpprkv9kazmkp2
 	rMarkerNoAttr := newMarker(rDenomNoAttr, restricted, nil)
@@ -195,6 +211,27 @@ func TestSendRestrictionFn(t *testing.T) {
 	rDenomProposed := "stillproposed" // cosmos1cjq467qkvef5gu4nczt42fl3q8pgmrnesx4647
 	rMarkerProposed := newProposedMarker(rDenomProposed, restricted, nil)
 
+	addrWithKyc := sdk.AccAddress("addr_with_kyc_only__")
+	require.NoError(t, app.AttributeKeeper.SetAttribute(ctx,
+		attrTypes.Attribute{
+			Name:          "kyc.provenance.io",
+			Value:         []byte("string value"),
+			Address:       addrWithKyc.String(),
+			AttributeType: attrTypes.AttributeType_String,
+		},
+		owner,
+	), "SetAttribute kyc.provenance.io on addrWithKyc")
+
+	rDenomSenderAttr := "restrictedmarkersenderattributes"
+	rMarkerSenderAttr := newMarkerAcc(rDenomSenderAttr, restricted, []string{"kyc.provenance.io"})
+	rMarkerSenderAttr.RequiredSenderAttributes = []string{"not-kyc.provenance.io"}
+	createActiveMarker(rMarkerSenderAttr)
+
+	rDenomOnlySenderAttr := "restrictedmarkeronlysenderattributes"
+	rMarkerOnlySenderAttr := newMarkerAcc(rDenomOnlySenderAttr, restricted, nil)
+	rMarkerOnlySenderAttr.RequiredSenderAttributes = []string{"kyc.provenance.io"}
+	createActiveMarker(rMarkerOnlySenderAttr)
+
 	noAccessErr := func(addr sdk.AccAddress, role types.Access, denom string) string {
 		mAddr, err := types.MarkerAddress(denom)
 		require.NoError(t, err, "MarkerAddress(%q)", denom)
@@ -255,7 +292,7 @@ func TestSendRestrictionFn(t *testing.T) {
 			name: "restricted to fee collector from ibc transfer module account",
 			// include a transfer agent just to make sure that doesn't bypass anything.
 			ctx:    ctxP(types.WithTransferAgents(ctx, addrWithTransfer)),
-			from:   app.MarkerKeeper.GetIbcTransferModuleAddr(),
+			from:   authtypes.NewModuleAddress(ibctransfertypes.ModuleName),
 			to:     addrFeeCollector,
 			amt:    cz(c(1, rDenomNoAttr)),
 			expErr: "cannot send restricted denom " + rDenomNoAttr + " to the fee collector",
@@ -323,7 +360,7 @@ func TestSendRestrictionFn(t *testing.T) {
 		},
 		{
 			name:   "from ibc transfer module account",
-			from:   app.MarkerKeeper.GetIbcTransferModuleAddr(),
+			from:   authtypes.NewModuleAddress(ibctransfertypes.ModuleName),
 			to:     addrWithAttrs,
 			amt:    cz(c(1, rDenom1AttrNoOneHas)),
 			expErr: "",
@@ -545,6 +582,21 @@ func TestSendRestrictionFn(t *testing.T) {
 			to:   addrWithAttrs,
 			amt:  cz(c(3, denomOther)),
 		},
+		{
+			name: "from grant marker: feegrant in use, funds not earmarked",
+			ctx:  ctxP(internalsdk.WithFeeGrantInUse(ctx)),
+			from: gMarker.GetAddress(),
+			to:   addrWithAttrs,
+			amt:  cz(c(10, denomEarmarked)),
+		},
+		{
+			name:   "from grant marker: feegrant in use, funds earmarked",
+			ctx:    ctxP(internalsdk.WithFeeGrantInUse(ctx)),
+			from:   gMarker.GetAddress(),
+			to:     addrWithAttrs,
+			amt:    cz(c(11, denomEarmarked)),
+			expErr: "cannot use 11" + denomEarmarked + " from " + gDenom + " marker escrow: only 10" + denomEarmarked + " is not earmarked in escrow ledgers, reserved for scheduled burns, or backing basket coin",
+		},
 		{
 			name:   "from grant marker: no admin, without feegrant in use",
 			ctx:    ctxP(internalsdk.WithoutFeeGrantInUse(ctx)),
@@ -760,6 +812,54 @@ func TestSendRestrictionFn(t *testing.T) {
 			to:   rMarker2Attrs.GetAddress(),
 			amt:  cz(c(1, rDenomNoAttr)),
 		},
+		{
+			name: "required sender attributes: sender and receiver have them",
+			from: addrWithAttrs,
+			to:   addrWithKyc,
+			amt:  cz(c(1, rDenomSenderAttr)),
+		},
+		{
+			name: "required sender attributes: sender does not have them",
+			from: addrWithKyc,
+			to:   addrWithAttrs,
+			amt:  cz(c(1, rDenomSenderAttr)),
+			expErr: fmt.Sprintf("address %s does not contain the %q required sender attribute: \"not-kyc.provenance.io\"",
+				addrWithKyc, rDenomSenderAttr),
+		},
+		{
+			name: "required sender attributes: sender has them, receiver does not",
+			from: addrWithAttrs,
+			to:   addrWithoutAttrs,
+			amt:  cz(c(1, rDenomSenderAttr)),
+			expErr: fmt.Sprintf("address %s does not contain the %q required attribute: \"kyc.provenance.io\"",
+				addrWithoutAttrs, rDenomSenderAttr),
+		},
+		{
+			name: "required sender attributes: sender has bypass",
+			from: addrWithBypass,
+			to:   addrWithKyc,
+			amt:  cz(c(1, rDenomSenderAttr)),
+		},
+		{
+			name: "required sender attributes: sender has transfer",
+			from: addrWithTransfer,
+			to:   addrWithoutAttrs,
+			amt:  cz(c(1, rDenomSenderAttr)),
+		},
+		{
+			name: "only required sender attributes: sender has them",
+			from: addrWithKyc,
+			to:   addrWithoutAttrs,
+			amt:  cz(c(1, rDenomOnlySenderAttr)),
+		},
+		{
+			name: "only required sender attributes: sender does not have them",
+			from: addrWithoutAttrs,
+			to:   addrWithAttrs,
+			amt:  cz(c(1, rDenomOnlySenderAttr)),
+			expErr: fmt.Sprintf("address %s does not contain the %q required sender attribute: \"kyc.provenance.io\"",
+				addrWithoutAttrs, rDenomOnlySenderAttr),
+		},
 	}
 
 	for _, tc := range testCases {
@@ -1394,3 +1494,138 @@ func TestQuarantineOfRestrictedCoins(t *testing.T) {
 		require.EqualError(t, err, noAttrErr(toAddr))
 	})
 }
+
+func TestCanTransfer(t *testing.T) {
+	app := simapp.Setup(t)
+	ctx := app.BaseApp.NewContext(false)
+	owner := sdk.AccAddress("owner_address_______")
+	app.AccountKeeper.SetAccount(ctx, app.AccountKeeper.NewAccountWithAddress(ctx, owner))
+	reqAttr := "cantransfer.provenance.io"
+	require.NoError(t, app.NameKeeper.SetNameRecord(ctx, reqAttr, owner, false), "SetNameRecord(%q)", reqAttr)
+
+	addrWithTransfer := sdk.AccAddress("addrWithTransfer____")
+	addrWithWithdraw := sdk.AccAddress("addrWithWithdraw____")
+	holder := sdk.AccAddress("holder______________")
+	deniedHolder := sdk.AccAddress("deniedHolder________")
+	sanctioned := sdk.AccAddress("sanctioned__________")
+	addrWithAttr := sdk.AccAddress("addrWithAttr________")
+	addrWithoutAttr := sdk.AccAddress("addrWithoutAttr_____")
+
+	denom := "cantransfercoin"
+	marker := types.NewMarkerAccount(
+		app.AccountKeeper.NewAccountWithAddress(ctx, types.MustGetMarkerAddress(denom)).(*authtypes.BaseAccount),
+		sdk.NewInt64Coin(denom, 1000),
+		owner,
+		[]types.AccessGrant{
+			{Address: addrWithTransfer.String(), Permissions: types.AccessList{types.Access_Transfer}},
+			{Address: addrWithWithdraw.String(), Permissions: types.AccessList{types.Access_Withdraw}},
+		},
+		types.StatusProposed,
+		types.MarkerType_RestrictedCoin,
+		true,  // supply fixed
+		true,  // allow gov
+		false, // no force transfer
+		[]string{reqAttr},
+	)
+	nav := []types.NetAssetValue{types.NewNetAssetValue(sdk.NewInt64Coin(types.UsdDenom, int64(1)), 1)}
+	require.NoError(t, app.MarkerKeeper.AddSetNetAssetValues(ctx, marker, nav, types.ModuleName), "AddSetNetAssetValues")
+	require.NoError(t, app.MarkerKeeper.AddFinalizeAndActivateMarker(ctx, marker), "AddFinalizeAndActivateMarker")
+
+	for _, addr := range []sdk.AccAddress{addrWithTransfer, holder, deniedHolder, sanctioned} {
+		coins := sdk.NewCoins(sdk.NewInt64Coin(denom, 100))
+		require.NoError(t, app.MarkerKeeper.WithdrawCoins(ctx, addrWithWithdraw, addr, denom, coins), "WithdrawCoins(%q)", string(addr))
+	}
+	app.MarkerKeeper.AddSendDeny(ctx, marker.GetAddress(), deniedHolder)
+	require.NoError(t, app.SanctionKeeper.SanctionAddresses(ctx, sanctioned), "SanctionAddresses")
+	attr := attrTypes.Attribute{
+		Name:          reqAttr,
+		Value:         []byte("string value"),
+		Address:       addrWithAttr.String(),
+		AttributeType: attrTypes.AttributeType_String,
+	}
+	require.NoError(t, app.AttributeKeeper.SetAttribute(ctx, attr, owner), "SetAttribute")
+
+	tests := []struct {
+		name    string
+		req     *types.QueryCanTransferRequest
+		exp     *types.QueryCanTransferResponse
+		expErr  string
+		expRule string
+	}{
+		{
+			name:   "nil request",
+			expErr: "invalid request",
+		},
+		{
+			name:   "invalid from address",
+			req:    &types.QueryCanTransferRequest{FromAddress: "bad", ToAddress: addrWithAttr.String(), Amount: "1" + denom},
+			expErr: "invalid from address",
+		},
+		{
+			name:   "invalid amount",
+			req:    &types.QueryCanTransferRequest{FromAddress: holder.String(), ToAddress: addrWithAttr.String(), Amount: "x"},
+			expErr: "invalid amount",
+		},
+		{
+			name: "allowed with required attributes",
+			req:  &types.QueryCanTransferRequest{FromAddress: holder.String(), ToAddress: addrWithAttr.String(), Amount: "10" + denom},
+			exp:  &types.QueryCanTransferResponse{CanTransfer: true},
+		},
+		{
+			name: "allowed with transfer permission",
+			req:  &types.QueryCanTransferRequest{FromAddress: addrWithTransfer.String(), ToAddress: addrWithoutAttr.String(), Amount: "10" + denom},
+			exp:  &types.QueryCanTransferResponse{CanTransfer: true},
+		},
+		{
+			name:    "missing required attributes",
+			req:     &types.QueryCanTransferRequest{FromAddress: holder.String(), ToAddress: addrWithoutAttr.String(), Amount: "10" + denom},
+			expRule: types.SendRuleRequiredAttributes,
+		},
+		{
+			name:    "sender on deny list",
+			req:     &types.QueryCanTransferRequest{FromAddress: deniedHolder.String(), ToAddress: addrWithAttr.String(), Amount: "10" + denom},
+			expRule: types.SendRuleSendDenyList,
+		},
+		{
+			name:    "sanctioned sender",
+			req:     &types.QueryCanTransferRequest{FromAddress: sanctioned.String(), ToAddress: addrWithAttr.String(), Amount: "10" + denom},
+			expRule: types.SendRuleSanction,
+		},
+		{
+			name:    "insufficient funds",
+			req:     &types.QueryCanTransferRequest{FromAddress: holder.String(), ToAddress: addrWithAttr.String(), Amount: "1000" + denom},
+			expRule: types.SendRuleBalance,
+		},
+		{
+			name: "blocked recipient",
+			req: &types.QueryCanTransferRequest{
+				FromAddress: holder.String(),
+				ToAddress:   app.AccountKeeper.GetModuleAddress("distribution").String(),
+				Amount:      "10" + denom,
+			},
+			expRule: types.SendRuleBlockedAddress,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			resp, err := app.MarkerKeeper.CanTransfer(ctx, tc.req)
+			if len(tc.expErr) > 0 {
+				require.ErrorContains(t, err, tc.expErr, "CanTransfer error")
+				return
+			}
+			require.NoError(t, err, "CanTransfer error")
+			if tc.exp != nil {
+				assert.Equal(t, tc.exp, resp, "CanTransfer response")
+				return
+			}
+			assert.False(t, resp.CanTransfer, "CanTransfer can_transfer")
+			assert.Equal(t, tc.expRule, resp.FailingRule, "CanTransfer failing_rule")
+			assert.NotEmpty(t, resp.Error, "CanTransfer error message")
+		})
+	}
+
+	// The checks must not change any balances.
+	assert.Equal(t, "100"+denom, app.BankKeeper.GetBalance(ctx, holder, denom).String(), "holder balance")
+	assert.True(t, app.BankKeeper.GetBalance(ctx, addrWithAttr, denom).IsZero(), "recipient balance")
+}
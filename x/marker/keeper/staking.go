@@ -0,0 +1,50 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+// DelegateEscrow stakes some of the marker's escrowed funds with a validator.
+// The marker is the delegator, so any rewards paid out during the delegation go to the marker's escrow.
+func (k Keeper) DelegateEscrow(ctx sdk.Context, marker types.MarkerAccountI, validator string, amount sdk.Coin) error {
+	if marker.GetStatus() != types.StatusActive {
+		return fmt.Errorf("cannot delegate escrow of %s marker with status %s", marker.GetDenom(), marker.GetStatus())
+	}
+	if err := k.validateNotEarmarked(ctx, marker, sdk.NewCoins(amount)); err != nil {
+		return err
+	}
+	msg := stakingtypes.NewMsgDelegate(marker.GetAddress().String(), validator, amount)
+	// Rewards are withdrawn when the delegation changes, so the bypass is needed for them to get to a restricted marker.
+	if _, err := k.stakingMsgServer.Delegate(types.WithBypass(ctx), msg); err != nil {
+		return fmt.Errorf("could not delegate %s from %s marker: %w", amount, marker.GetDenom(), err)
+	}
+	return nil
+}
+
+// UndelegateEscrow unstakes some of the marker's funds from a validator.
+// It returns the time at which the funds will be back in the marker's escrow.
+func (k Keeper) UndelegateEscrow(ctx sdk.Context, marker types.MarkerAccountI, validator string, amount sdk.Coin) (time.Time, error) {
+	msg := stakingtypes.NewMsgUndelegate(marker.GetAddress().String(), validator, amount)
+	resp, err := k.stakingMsgServer.Undelegate(types.WithBypass(ctx), msg)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("could not undelegate %s from %s marker: %w", amount, marker.GetDenom(), err)
+	}
+	return resp.CompletionTime, nil
+}
+
+// CollectEscrowRewards withdraws the marker's staking rewards from a validator into the marker's escrow.
+func (k Keeper) CollectEscrowRewards(ctx sdk.Context, marker types.MarkerAccountI, validator string) (sdk.Coins, error) {
+	msg := distrtypes.NewMsgWithdrawDelegatorReward(marker.GetAddress().String(), validator)
+	resp, err := k.distrMsgServer.WithdrawDelegatorReward(types.WithBypass(ctx), msg)
+	if err != nil {
+		return nil, fmt.Errorf("could not collect rewards for %s marker: %w", marker.GetDenom(), err)
+	}
+	return resp.Amount, nil
+}
@@ -0,0 +1,125 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+// SetSupplyChange stores an entry in a marker's supply history.
+func (k Keeper) SetSupplyChange(ctx sdk.Context, change types.SupplyChange) error {
+	markerAddr, err := types.MarkerAddress(change.Denom)
+	if err != nil {
+		return err
+	}
+	bz, err := k.cdc.Marshal(&change)
+	if err != nil {
+		return err
+	}
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.SupplyHistoryKey(markerAddr, change.Sequence), bz)
+	return nil
+}
+
+// IterateSupplyHistory iterates over the supply history of a marker, oldest first.
+// Entries that are older than the retention period, but haven't been pruned yet, are included.
+func (k Keeper) IterateSupplyHistory(ctx sdk.Context, markerAddr sdk.AccAddress, handler func(change types.SupplyChange) (stop bool)) error {
+	store := ctx.KVStore(k.storeKey)
+	it := storetypes.KVStorePrefixIterator(store, types.SupplyHistoryKeyPrefix(markerAddr))
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		var change types.SupplyChange
+		if err := k.cdc.Unmarshal(it.Value(), &change); err != nil {
+			return err
+		}
+		if handler(change) {
+			break
+		}
+	}
+	return nil
+}
+
+// IterateAllSupplyHistory iterates over the supply history of all markers.
+func (k Keeper) IterateAllSupplyHistory(ctx sdk.Context, handler func(change types.SupplyChange) (stop bool)) error {
+	store := ctx.KVStore(k.storeKey)
+	it := storetypes.KVStorePrefixIterator(store, types.SupplyHistoryPrefix)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		var change types.SupplyChange
+		if err := k.cdc.Unmarshal(it.Value(), &change); err != nil {
+			return err
+		}
+		if handler(change) {
+			break
+		}
+	}
+	return nil
+}
+
+// getLastSupplyChangeSequence gets the sequence of the newest entry in a marker's supply history, or zero if it has none.
+func (k Keeper) getLastSupplyChangeSequence(ctx sdk.Context, markerAddr sdk.AccAddress) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	pre := types.SupplyHistoryKeyPrefix(markerAddr)
+	it := storetypes.KVStoreReversePrefixIterator(store, pre)
+	defer it.Close()
+	if !it.Valid() {
+		return 0
+	}
+	return sdk.BigEndianToUint64(it.Key()[len(pre):])
+}
+
+// isSupplyChangeExpired returns true if the supply change is older than the retention period.
+func isSupplyChangeExpired(ctx sdk.Context, params types.Params, change types.SupplyChange) bool {
+	if params.SupplyHistoryRetentionBlocks == 0 {
+		return false
+	}
+	age := ctx.BlockHeight() - change.BlockHeight
+	return age >= 0 && uint64(age) >= params.SupplyHistoryRetentionBlocks
+}
+
+// recordSupplyChange adds an entry to a marker's supply history, then prunes the history so that it stays within
+// the max entries and retention period params. Nothing is recorded when the max entries param is zero.
+func (k Keeper) recordSupplyChange(ctx sdk.Context, marker types.MarkerAccountI, changeType types.SupplyChangeType, amount, supply sdkmath.Int) error {
+	params := k.GetParams(ctx)
+	if params.SupplyHistoryMaxEntries == 0 {
+		return nil
+	}
+
+	markerAddr := marker.GetAddress()
+	seq := k.getLastSupplyChangeSequence(ctx, markerAddr) + 1
+	change := types.NewSupplyChange(marker.GetDenom(), seq, ctx.BlockHeight(), ctx.BlockTime(), changeType, amount, supply)
+	if err := k.SetSupplyChange(ctx, change); err != nil {
+		return fmt.Errorf("could not record %s supply change: %w", marker.GetDenom(), err)
+	}
+
+	return k.pruneSupplyHistory(ctx, params, markerAddr, seq)
+}
+
+// pruneSupplyHistory removes the oldest entries from a marker's supply history until
+// it has no more than the max entries, and none of them are older than the retention period.
+func (k Keeper) pruneSupplyHistory(ctx sdk.Context, params types.Params, markerAddr sdk.AccAddress, lastSeq uint64) error {
+	var toDelete [][]byte
+	err := k.IterateSupplyHistory(ctx, markerAddr, func(change types.SupplyChange) bool {
+		// Entries are only ever removed oldest first, so the sequences left in the history are contiguous.
+		count := lastSeq - change.Sequence + 1
+		if count <= uint64(params.SupplyHistoryMaxEntries) && !isSupplyChangeExpired(ctx, params, change) {
+			return true
+		}
+		toDelete = append(toDelete, types.SupplyHistoryKey(markerAddr, change.Sequence))
+		return false
+	})
+	if err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	for _, key := range toDelete {
+		store.Delete(key)
+	}
+	return nil
+}
@@ -0,0 +1,98 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	simapp "github.com/provenance-io/provenance/app"
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+func TestSupplyHistory(t *testing.T) {
+	app := simapp.Setup(t)
+	blockTime := time.Unix(1_700_000_000, 0).UTC()
+	ctx := app.BaseApp.NewContext(false).WithBlockHeight(10).WithBlockTime(blockTime)
+	user := testUserAddress("test")
+	denom := "historycoin"
+
+	params := types.DefaultParams()
+	params.SupplyHistoryMaxEntries = 3
+	app.MarkerKeeper.SetParams(ctx, params)
+
+	mac := types.NewEmptyMarkerAccount(denom, user.String(), []types.AccessGrant{*types.NewAccessGrant(user,
+		[]types.Access{types.Access_Mint, types.Access_Burn, types.Access_Withdraw, types.Access_Delete})})
+	require.NoError(t, mac.SetSupply(sdk.NewInt64Coin(denom, 1000)), "SetSupply")
+	require.NoError(t, app.MarkerKeeper.AddFinalizeAndActivateMarker(ctx, mac), "AddFinalizeAndActivateMarker")
+
+	change := func(seq uint64, height int64, changeType types.SupplyChangeType, amount, supply int64) types.SupplyChange {
+		return types.NewSupplyChange(denom, seq, height, blockTime, changeType, sdkmath.NewInt(amount), sdkmath.NewInt(supply))
+	}
+	getHistory := func(ctx sdk.Context) []types.SupplyChange {
+		t.Helper()
+		resp, err := app.MarkerKeeper.SupplyHistory(ctx, &types.QuerySupplyHistoryRequest{Id: denom})
+		require.NoError(t, err, "SupplyHistory(%q)", denom)
+		return resp.Changes
+	}
+
+	assert.Equal(t, []types.SupplyChange{change(1, 10, types.SupplyChangeMint, 1000, 1000)}, getHistory(ctx),
+		"history after activation")
+
+	require.NoError(t, app.MarkerKeeper.MintCoin(ctx, user, sdk.NewInt64Coin(denom, 100)), "MintCoin")
+	require.NoError(t, app.MarkerKeeper.BurnCoin(ctx, user, sdk.NewInt64Coin(denom, 50)), "BurnCoin")
+	ctx = ctx.WithBlockHeight(11)
+	require.NoError(t, app.MarkerKeeper.MintCoin(ctx, user, sdk.NewInt64Coin(denom, 10)), "MintCoin")
+	expected := []types.SupplyChange{
+		change(2, 10, types.SupplyChangeMint, 100, 1100),
+		change(3, 10, types.SupplyChangeBurn, 50, 1050),
+		change(4, 11, types.SupplyChangeMint, 10, 1060),
+	}
+	assert.Equal(t, expected, getHistory(ctx), "history after going over the max entries")
+
+	resp, err := app.MarkerKeeper.SupplyHistory(ctx, &types.QuerySupplyHistoryRequest{
+		Id:         types.MustGetMarkerAddress(denom).String(),
+		Pagination: &query.PageRequest{Offset: 1, Limit: 1, CountTotal: true},
+	})
+	require.NoError(t, err, "SupplyHistory with pagination")
+	assert.Equal(t, expected[1:2], resp.Changes, "paginated history")
+	assert.Equal(t, uint64(3), resp.Pagination.Total, "paginated history total")
+
+	genState := app.MarkerKeeper.ExportGenesis(ctx)
+	assert.Equal(t, expected, genState.SupplyHistory, "exported supply history")
+
+	// Once the retention period has passed, entries are left out of the query until they are pruned.
+	params.SupplyHistoryRetentionBlocks = 5
+	app.MarkerKeeper.SetParams(ctx, params)
+	ctx = ctx.WithBlockHeight(15)
+	assert.Equal(t, expected[2:], getHistory(ctx), "history after the first entries expire")
+	ctx = ctx.WithBlockHeight(16)
+	assert.Empty(t, getHistory(ctx), "history after all entries expire")
+	require.NoError(t, app.MarkerKeeper.BurnCoin(ctx, user, sdk.NewInt64Coin(denom, 60)), "BurnCoin")
+	assert.Equal(t, []types.SupplyChange{change(5, 16, types.SupplyChangeBurn, 60, 1000)}, getHistory(ctx),
+		"history after a burn once everything expired")
+	assert.Len(t, app.MarkerKeeper.ExportGenesis(ctx).SupplyHistory, 1, "exported supply history after pruning")
+
+	// Nothing is recorded when the history is disabled.
+	params.SupplyHistoryMaxEntries = 0
+	app.MarkerKeeper.SetParams(ctx, params)
+	require.NoError(t, app.MarkerKeeper.MintCoin(ctx, user, sdk.NewInt64Coin(denom, 5)), "MintCoin")
+	assert.Equal(t, []types.SupplyChange{change(5, 16, types.SupplyChangeBurn, 60, 1000)}, getHistory(ctx),
+		"history after a mint while disabled")
+
+	// Pruned entries can be put back with InitGenesis.
+	ctx, _ = ctx.CacheContext()
+	app.MarkerKeeper.InitGenesis(ctx, genState)
+	ctx = ctx.WithBlockHeight(11)
+	expected = append(expected, change(5, 16, types.SupplyChangeBurn, 60, 1000))
+	assert.Equal(t, expected, getHistory(ctx), "history after InitGenesis")
+
+	_, err = app.MarkerKeeper.SupplyHistory(ctx, &types.QuerySupplyHistoryRequest{Id: "nosuchcoin"})
+	assert.Error(t, err, "SupplyHistory for unknown marker")
+}
@@ -37,6 +37,7 @@ var (
 
 	_ appmodule.AppModule       = (*AppModule)(nil)
 	_ appmodule.HasBeginBlocker = (*AppModule)(nil)
+	_ appmodule.HasEndBlocker   = (*AppModule)(nil)
 )
 
 // AppModuleBasic contains non-dependent elements for the marker module.
@@ -144,6 +145,11 @@ func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
 func (am AppModule) RegisterServices(cfg module.Configurator) {
 	types.RegisterMsgServer(cfg.MsgServer(), keeper.NewMsgServerImpl(am.keeper))
 	types.RegisterQueryServer(cfg.QueryServer(), am.keeper)
+
+	m := keeper.NewMigrator(am.keeper)
+	if err := cfg.RegisterMigration(types.ModuleName, 2, m.Migrate2To3); err != nil {
+		panic(fmt.Sprintf("failed to register x/marker migration from version 2 to 3: %v", err))
+	}
 }
 
 // InitGenesis performs genesis initialization for the account module. It returns no validator updates.
@@ -166,6 +172,12 @@ func (am AppModule) BeginBlock(ctx context.Context) error {
 	return nil
 }
 
+// EndBlock returns the end blocker for the marker module.
+func (am AppModule) EndBlock(ctx context.Context) error {
+	EndBlocker(sdk.UnwrapSDKContext(ctx), am.keeper)
+	return nil
+}
+
 // ____________________________________________________________________________
 
 // AppModuleSimulation functions
@@ -194,4 +206,9 @@ func (am AppModule) WeightedOperations(simState module.SimulationState) []simtyp
 }
 
 // ConsensusVersion implements AppModule/ConsensusVersion.
-func (AppModule) ConsensusVersion() uint64 { return 2 }
+func (AppModule) ConsensusVersion() uint64 { return 3 }
+
+// Features returns the names of the module's optional features that are currently enabled.
+func (am AppModule) Features(ctx sdk.Context) []string {
+	return am.keeper.GetParams(ctx).Features()
+}
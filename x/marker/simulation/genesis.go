@@ -87,6 +87,7 @@ func RandomizedGenState(simState *module.SimulationState) {
 				RequiredAttributes:     []string{},
 			},
 		},
+		SendRestrictionBypasses: types.DefaultSendRestrictionBypasses(),
 	}
 
 	bz, err := json.MarshalIndent(&markerGenesis, "", " ")
@@ -3,6 +3,7 @@ package types
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -23,6 +24,7 @@ type AccessGrantI interface {
 
 	HasAccess(Access) bool
 	GetAccessList() []Access
+	GetExpiration() *time.Time
 
 	AddAccess(Access) error
 	RemoveAccess(Access) error
@@ -39,6 +41,13 @@ func NewAccessGrant(address sdk.AccAddress, access AccessList) *AccessGrant {
 	}
 }
 
+// NewExpiringAccessGrant creates a new AccessGrant object that expires at the provided time.
+func NewExpiringAccessGrant(address sdk.AccAddress, access AccessList, expiration time.Time) *AccessGrant {
+	rv := NewAccessGrant(address, access)
+	rv.Expiration = &expiration
+	return rv
+}
+
 // AccessByName returns the Access value given a name of the access type.  Normalizes input with
 // proper ACCESS_ prefix and case of name.
 func AccessByName(name string) Access {
@@ -88,7 +97,7 @@ func GrantsForAddress(account sdk.AccAddress, grants ...AccessGrant) AccessGrant
 			return grant
 		}
 	}
-	return AccessGrant{account.String(), []Access{}}
+	return AccessGrant{Address: account.String(), Permissions: []Access{}}
 }
 
 // GetAddress returns the account address the access grant belongs to
@@ -105,6 +114,16 @@ func (ag AccessGrant) GetAccessList() AccessList {
 	return ag.Permissions
 }
 
+// GetExpiration returns the time at which this grant expires, or nil if it does not expire.
+func (ag AccessGrant) GetExpiration() *time.Time {
+	return ag.Expiration
+}
+
+// IsExpired returns true if this grant expires at or before the provided block time.
+func (ag AccessGrant) IsExpired(blockTime time.Time) bool {
+	return ag.Expiration != nil && !blockTime.Before(*ag.Expiration)
+}
+
 // Validate performs checks to ensure this acccess grant is properly formed.
 func (ag AccessGrant) Validate() error {
 	if _, err := sdk.AccAddressFromBech32(ag.Address); err != nil {
@@ -186,6 +205,9 @@ func (ag AccessGrant) String() string {
 			result = fmt.Sprintf("%s, %s", result, perm)
 		}
 	}
+	if ag.Expiration != nil {
+		return fmt.Sprintf("AccessGrant: %s [%s] expires %s", ag.Address, result, ag.Expiration.UTC().Format(time.RFC3339Nano))
+	}
 	return fmt.Sprintf("AccessGrant: %s [%s]", ag.Address, result)
 }
 
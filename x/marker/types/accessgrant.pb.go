@@ -8,15 +8,19 @@ import (
 	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -56,6 +60,10 @@ const (
 	// ACCESS_FORCE_TRANSFER is the ability to transfer restricted coins from a 3rd-party account without their signature.
 	// This access right is only supported on RESTRICTED markers and only has meaning when allow_forced_transfer is true.
 	Access_ForceTransfer Access = 8
+	// ACCESS_DELEGATE is the ability to stake the marker's escrowed bond denom funds with validators.
+	// Accounts with this access can delegate escrowed funds, undelegate them (back into escrow),
+	// and collect the staking rewards into the marker's escrow.
+	Access_Delegate Access = 9
 )
 
 var Access_name = map[int32]string{
@@ -68,6 +76,7 @@ var Access_name = map[int32]string{
 	6: "ACCESS_ADMIN",
 	7: "ACCESS_TRANSFER",
 	8: "ACCESS_FORCE_TRANSFER",
+	9: "ACCESS_DELEGATE",
 }
 
 var Access_value = map[string]int32{
@@ -80,6 +89,7 @@ var Access_value = map[string]int32{
 	"ACCESS_ADMIN":          6,
 	"ACCESS_TRANSFER":       7,
 	"ACCESS_FORCE_TRANSFER": 8,
+	"ACCESS_DELEGATE":       9,
 }
 
 func (x Access) String() string {
@@ -94,6 +104,9 @@ func (Access) EnumDescriptor() ([]byte, []int) {
 type AccessGrant struct {
 	Address     string     `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 	Permissions AccessList `protobuf:"varint,2,rep,packed,name=permissions,proto3,enum=provenance.marker.v1.Access,castrepeated=AccessList" json:"permissions,omitempty"`
+	// expiration is the time at which this grant expires. After it, the grant is ignored, and it is removed from the
+	// marker at the end of the block. A grant without an expiration does not expire.
+	Expiration *time.Time `protobuf:"bytes,3,opt,name=expiration,proto3,stdtime" json:"expiration,omitempty"`
 }
 
 func (m *AccessGrant) Reset()      { *m = AccessGrant{} }
@@ -138,39 +151,43 @@ func init() {
 }
 
 var fileDescriptor_7242c30a84644575 = []byte{
-	// 499 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0x31, 0x6f, 0xd3, 0x4c,
-	0x18, 0xc7, 0xed, 0xb4, 0x4d, 0xd2, 0x4b, 0x9a, 0xd7, 0xef, 0xa9, 0x88, 0xd4, 0x14, 0xc7, 0x80,
-	0x84, 0x2a, 0x44, 0x6d, 0xb5, 0x6c, 0x6c, 0x4e, 0xec, 0x80, 0xa5, 0xc6, 0x8d, 0x1c, 0x47, 0x91,
-	0x58, 0x2a, 0xd7, 0x39, 0xd2, 0x53, 0xc9, 0x5d, 0x74, 0xe7, 0xa6, 0xf4, 0x1b, 0x20, 0x4f, 0x2c,
-	0x48, 0x2c, 0x96, 0x32, 0x33, 0xf7, 0x43, 0x20, 0xa6, 0x8e, 0x6c, 0xa0, 0x64, 0xe1, 0x63, 0xa0,
-	0xe4, 0x12, 0xe2, 0xa1, 0xdb, 0xf3, 0xdc, 0xff, 0x77, 0x3f, 0x3d, 0xba, 0x7b, 0xc0, 0xf3, 0x11,
-	0xa3, 0x63, 0x44, 0x42, 0x12, 0x21, 0x73, 0x18, 0xb2, 0x4b, 0xc4, 0xcc, 0xf1, 0x91, 0x19, 0x46,
-	0x11, 0xe2, 0x7c, 0xc0, 0x42, 0x12, 0x1b, 0x23, 0x46, 0x63, 0x0a, 0x77, 0xd7, 0x9c, 0x21, 0x38,
-	0x63, 0x7c, 0xa4, 0xee, 0x0e, 0xe8, 0x80, 0x2e, 0x00, 0x73, 0x5e, 0x09, 0x56, 0xdd, 0x8b, 0x28,
-	0x1f, 0x52, 0x7e, 0x26, 0x02, 0xd1, 0x88, 0xe8, 0xe9, 0x17, 0x19, 0x94, 0xac, 0x85, 0xfc, 0xcd,
-	0x5c, 0x0e, 0xab, 0xa0, 0x10, 0xf6, 0xfb, 0x0c, 0x71, 0x5e, 0x95, 0x75, 0xf9, 0x60, 0xdb, 0x5f,
-	0xb5, 0xd0, 0x03, 0xa5, 0x11, 0x62, 0x43, 0xcc, 0x39, 0xa6, 0x84, 0x57, 0x73, 0xfa, 0xc6, 0x41,
-	0xe5, 0x78, 0xdf, 0xb8, 0x6f, 0x0c, 0x43, 0x18, 0xeb, 0x95, 0x6f, 0xbf, 0x6a, 0x40, 0xd4, 0x27,
-	0x98, 0xc7, 0x7e, 0x56, 0xf0, 0x7a, 0xff, 0xd3, 0xa4, 0x26, 0x7d, 0x9d, 0xd4, 0xa4, 0x3f, 0x93,
-	0x9a, 0xfc, 0xe3, 0xf6, 0xb0, 0x9c, 0x19, 0xc3, 0x7d, 0x71, 0x9b, 0x03, 0x79, 0x71, 0x00, 0x9f,
-	0x01, 0x68, 0x35, 0x1a, 0x4e, 0xa7, 0x73, 0xd6, 0xf5, 0x3a, 0x6d, 0xa7, 0xe1, 0x36, 0x5d, 0xc7,
-	0x56, 0x24, 0xb5, 0x94, 0xa4, 0x7a, 0xa1, 0x4b, 0x2e, 0x09, 0xbd, 0x26, 0x70, 0x0f, 0x94, 0x96,
-	0x50, 0xcb, 0xf5, 0x02, 0x45, 0x56, 0x8b, 0x49, 0xaa, 0x6f, 0xb6, 0x30, 0x89, 0x33, 0x51, 0xbd,
-	0xeb, 0x7b, 0x4a, 0x4e, 0x44, 0xf5, 0x2b, 0x46, 0x60, 0x0d, 0x54, 0x96, 0x91, 0xed, 0xb4, 0x4f,
-	0x3b, 0x6e, 0xa0, 0x6c, 0x08, 0xad, 0x8d, 0x46, 0x94, 0xe3, 0x18, 0x3e, 0x01, 0xff, 0x2d, 0x81,
-	0x9e, 0x1b, 0xbc, 0xb5, 0x7d, 0xab, 0xa7, 0x6c, 0xaa, 0xe5, 0x24, 0xd5, 0x8b, 0x3d, 0x1c, 0x5f,
-	0xf4, 0x59, 0x78, 0x0d, 0x1f, 0x83, 0x9d, 0x7f, 0x8e, 0x13, 0x27, 0x70, 0x94, 0x2d, 0x15, 0x24,
-	0xa9, 0x9e, 0xb7, 0xd1, 0x07, 0x14, 0x23, 0xf8, 0x08, 0x94, 0x97, 0xb1, 0x65, 0xb7, 0x5c, 0x4f,
-	0xc9, 0xab, 0xdb, 0x49, 0xaa, 0x6f, 0x59, 0xfd, 0x21, 0x26, 0x19, 0x7d, 0xe0, 0x5b, 0x5e, 0xa7,
-	0xe9, 0xf8, 0x4a, 0x41, 0xe8, 0x03, 0x16, 0x12, 0xfe, 0x1e, 0x31, 0xf8, 0x12, 0x3c, 0x58, 0x22,
-	0xcd, 0x53, 0xbf, 0xe1, 0xac, 0xc1, 0xa2, 0xfa, 0x7f, 0x92, 0xea, 0x3b, 0x4d, 0xca, 0x22, 0xb4,
-	0xa2, 0xeb, 0x37, 0xdf, 0xa7, 0x9a, 0x7c, 0x37, 0xd5, 0xe4, 0xdf, 0x53, 0x4d, 0xfe, 0x3c, 0xd3,
-	0xa4, 0xbb, 0x99, 0x26, 0xfd, 0x9c, 0x69, 0x12, 0x78, 0x88, 0xe9, 0xbd, 0x7f, 0x55, 0x57, 0x32,
-	0xef, 0xde, 0x9e, 0xef, 0x44, 0x5b, 0x7e, 0x77, 0x3c, 0xc0, 0xf1, 0xc5, 0xd5, 0xb9, 0x11, 0xd1,
-	0xa1, 0xb9, 0xbe, 0x74, 0x88, 0x69, 0xa6, 0x33, 0x3f, 0xae, 0xf6, 0x33, 0xbe, 0x19, 0x21, 0x7e,
-	0x9e, 0x5f, 0x2c, 0xd4, 0xab, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0xb7, 0x70, 0xf5, 0x75, 0xc1,
-	0x02, 0x00, 0x00,
+	// 569 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x93, 0x4f, 0x4f, 0xdb, 0x3e,
+	0x1c, 0xc6, 0x1b, 0xfe, 0x14, 0x70, 0x81, 0x5f, 0x7e, 0x16, 0xd3, 0x4a, 0xc6, 0x9a, 0x6c, 0x93,
+	0x26, 0x34, 0x8d, 0x44, 0xb0, 0xdb, 0x4e, 0x4b, 0xdb, 0x94, 0x45, 0x82, 0x50, 0xa5, 0x41, 0x48,
+	0xbb, 0xa0, 0x90, 0x9a, 0x60, 0x41, 0xec, 0xc8, 0x36, 0xff, 0xde, 0xc1, 0x94, 0x13, 0xc7, 0x5d,
+	0x22, 0x71, 0xde, 0x79, 0x2f, 0x62, 0xda, 0x89, 0x1b, 0xbb, 0x31, 0xc1, 0x65, 0x2f, 0x63, 0x6a,
+	0x9d, 0xd2, 0x1c, 0xb8, 0xf9, 0xc9, 0xf3, 0xf1, 0xe3, 0x27, 0xf2, 0xd7, 0xe0, 0x6d, 0xca, 0xe8,
+	0x19, 0x22, 0x21, 0x89, 0x90, 0x95, 0x84, 0xec, 0x18, 0x31, 0xeb, 0x6c, 0xdd, 0x0a, 0xa3, 0x08,
+	0x71, 0x1e, 0xb3, 0x90, 0x08, 0x33, 0x65, 0x54, 0x50, 0xb8, 0x34, 0xe6, 0x4c, 0xc9, 0x99, 0x67,
+	0xeb, 0xda, 0x52, 0x4c, 0x63, 0x3a, 0x04, 0xac, 0xc1, 0x4a, 0xb2, 0xda, 0x72, 0x44, 0x79, 0x42,
+	0xf9, 0xbe, 0x34, 0xa4, 0x28, 0x2c, 0x3d, 0xa6, 0x34, 0x3e, 0x41, 0xd6, 0x50, 0x1d, 0x9c, 0x1e,
+	0x5a, 0x02, 0x27, 0x88, 0x8b, 0x30, 0x49, 0x25, 0xf0, 0xfa, 0x56, 0x01, 0x35, 0x7b, 0x78, 0xfa,
+	0xe6, 0xe0, 0x74, 0x58, 0x07, 0x33, 0x61, 0xbf, 0xcf, 0x10, 0xe7, 0x75, 0xc5, 0x50, 0x56, 0xe7,
+	0xfc, 0x91, 0x84, 0x1e, 0xa8, 0xa5, 0x88, 0x25, 0x98, 0x73, 0x4c, 0x09, 0xaf, 0x4f, 0x18, 0x93,
+	0xab, 0x8b, 0x1b, 0x2b, 0xe6, 0x53, 0x3d, 0x4d, 0x99, 0xd8, 0x5c, 0xfc, 0x7e, 0xa7, 0x03, 0xb9,
+	0xde, 0xc2, 0x5c, 0xf8, 0xe5, 0x00, 0xf8, 0x09, 0x00, 0x74, 0x91, 0x62, 0x16, 0x0a, 0x4c, 0x49,
+	0x7d, 0xd2, 0x50, 0x56, 0x6b, 0x1b, 0x9a, 0x29, 0xfb, 0x9a, 0xa3, 0xbe, 0x66, 0x30, 0xea, 0xdb,
+	0x9c, 0xba, 0xba, 0xd3, 0x15, 0xbf, 0xb4, 0xe7, 0xe3, 0xca, 0xd7, 0x6b, 0xbd, 0xf2, 0xed, 0x5a,
+	0xaf, 0xfc, 0xbd, 0xd6, 0x95, 0x5f, 0x3f, 0xd6, 0xe6, 0x4b, 0x3f, 0xe2, 0xbe, 0xbb, 0x9d, 0x00,
+	0x55, 0xf9, 0x01, 0xbe, 0x01, 0xd0, 0x6e, 0xb5, 0x9c, 0x5e, 0x6f, 0x7f, 0xd7, 0xeb, 0x75, 0x9d,
+	0x96, 0xdb, 0x71, 0x9d, 0xb6, 0x5a, 0xd1, 0x6a, 0x59, 0x6e, 0xcc, 0xec, 0x92, 0x63, 0x42, 0xcf,
+	0x09, 0x5c, 0x06, 0xb5, 0x02, 0xda, 0x76, 0xbd, 0x40, 0x55, 0xb4, 0xd9, 0x2c, 0x37, 0xa6, 0xb6,
+	0x31, 0x11, 0x25, 0xab, 0xb9, 0xeb, 0x7b, 0xea, 0x84, 0xb4, 0x9a, 0xa7, 0x8c, 0x40, 0x1d, 0x2c,
+	0x16, 0x56, 0xdb, 0xe9, 0xee, 0xf4, 0xdc, 0x40, 0x9d, 0x94, 0xb1, 0x6d, 0x94, 0x52, 0x8e, 0x05,
+	0x7c, 0x05, 0xfe, 0x2b, 0x80, 0x3d, 0x37, 0xf8, 0xdc, 0xf6, 0xed, 0x3d, 0x75, 0x4a, 0x9b, 0xcf,
+	0x72, 0x63, 0x76, 0x0f, 0x8b, 0xa3, 0x3e, 0x0b, 0xcf, 0xe1, 0x4b, 0xb0, 0xf0, 0x98, 0xb1, 0xe5,
+	0x04, 0x8e, 0x3a, 0xad, 0x81, 0x2c, 0x37, 0xaa, 0x6d, 0x74, 0x82, 0x04, 0x82, 0x2f, 0xc0, 0x7c,
+	0x61, 0xdb, 0xed, 0x6d, 0xd7, 0x53, 0xab, 0xda, 0x5c, 0x96, 0x1b, 0xd3, 0x76, 0x3f, 0xc1, 0xa4,
+	0x14, 0x1f, 0xf8, 0xb6, 0xd7, 0xeb, 0x38, 0xbe, 0x3a, 0x23, 0xe3, 0x03, 0x16, 0x12, 0x7e, 0x88,
+	0x18, 0x7c, 0x0f, 0x9e, 0x15, 0x48, 0x67, 0xc7, 0x6f, 0x39, 0x63, 0x70, 0x56, 0xfb, 0x3f, 0xcb,
+	0x8d, 0x85, 0x0e, 0x65, 0x11, 0x7a, 0xa4, 0xc7, 0x81, 0x83, 0x32, 0x9b, 0x76, 0xe0, 0xa8, 0x73,
+	0x32, 0x70, 0x50, 0x27, 0x0e, 0x05, 0x6a, 0x5e, 0xfe, 0xbc, 0x6f, 0x28, 0x37, 0xf7, 0x0d, 0xe5,
+	0xcf, 0x7d, 0x43, 0xb9, 0x7a, 0x68, 0x54, 0x6e, 0x1e, 0x1a, 0x95, 0xdf, 0x0f, 0x8d, 0x0a, 0x78,
+	0x8e, 0xe9, 0x93, 0x03, 0xd1, 0x54, 0x4b, 0x57, 0xd3, 0x1d, 0xdc, 0x6d, 0x57, 0xf9, 0xb2, 0x11,
+	0x63, 0x71, 0x74, 0x7a, 0x60, 0x46, 0x34, 0xb1, 0xc6, 0x9b, 0xd6, 0x30, 0x2d, 0x29, 0xeb, 0x62,
+	0xf4, 0x4a, 0xc4, 0x65, 0x8a, 0xf8, 0x41, 0x75, 0x38, 0x18, 0x1f, 0xfe, 0x05, 0x00, 0x00, 0xff,
+	0xff, 0x87, 0xad, 0x98, 0x67, 0x47, 0x03, 0x00, 0x00,
 }
 
 func (this *AccessGrant) Equal(that interface{}) bool {
@@ -203,6 +220,13 @@ func (this *AccessGrant) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if that1.Expiration == nil {
+		if this.Expiration != nil {
+			return false
+		}
+	} else if !this.Expiration.Equal(*that1.Expiration) {
+		return false
+	}
 	return true
 }
 func (m *AccessGrant) Marshal() (dAtA []byte, err error) {
@@ -225,21 +249,31 @@ func (m *AccessGrant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Expiration != nil {
+		n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Expiration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiration):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintAccessgrant(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.Permissions) > 0 {
-		dAtA2 := make([]byte, len(m.Permissions)*10)
-		var j1 int
+		dAtA3 := make([]byte, len(m.Permissions)*10)
+		var j2 int
 		for _, num := range m.Permissions {
 			for num >= 1<<7 {
-				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA3[j2] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j1++
+				j2++
 			}
-			dAtA2[j1] = uint8(num)
-			j1++
+			dAtA3[j2] = uint8(num)
+			j2++
 		}
-		i -= j1
-		copy(dAtA[i:], dAtA2[:j1])
-		i = encodeVarintAccessgrant(dAtA, i, uint64(j1))
+		i -= j2
+		copy(dAtA[i:], dAtA3[:j2])
+		i = encodeVarintAccessgrant(dAtA, i, uint64(j2))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -281,6 +315,10 @@ func (m *AccessGrant) Size() (n int) {
 		}
 		n += 1 + sovAccessgrant(uint64(l)) + l
 	}
+	if m.Expiration != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiration)
+		n += 1 + l + sovAccessgrant(uint64(l))
+	}
 	return n
 }
 
@@ -420,6 +458,42 @@ func (m *AccessGrant) Unmarshal(dAtA []byte) error {
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field Permissions", wireType)
 			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccessgrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAccessgrant
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccessgrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Expiration == nil {
+				m.Expiration = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Expiration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAccessgrant(dAtA[iNdEx:])
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -76,6 +77,7 @@ func TestAccessByName(t *testing.T) {
 		{name: "transfer", exp: Access_Transfer},
 		{name: "force_transfer", exp: Access_ForceTransfer},
 		{name: "forcetransfer", exp: Access_ForceTransfer},
+		{name: "delegate", exp: Access_Delegate},
 		{name: "bur", exp: Access_Unknown},
 	}
 
@@ -234,3 +236,28 @@ func TestMergeAddRemovePermissions(t *testing.T) {
 	require.Error(t, roleGrant.MergeAdd(*NewAccessGrant(otherAddr, AccessList{Access_Mint, Access_Admin})))
 	require.Error(t, roleGrant.MergeRemove(*NewAccessGrant(otherAddr, AccessList{Access_Mint, Access_Admin})))
 }
+
+func TestAccessGrantExpiration(t *testing.T) {
+	addr := testAddress()
+	expiration := time.Date(2030, 1, 2, 3, 4, 5, 0, time.UTC)
+	grant := NewExpiringAccessGrant(addr, AccessList{Access_Mint}, expiration)
+
+	require.NoError(t, grant.Validate(), "Validate")
+	require.Equal(t, &expiration, grant.GetExpiration(), "GetExpiration")
+	require.Equal(t, fmt.Sprintf("AccessGrant: %s [mint] expires 2030-01-02T03:04:05Z", addr), grant.String(), "String")
+	assert.False(t, grant.IsExpired(expiration.Add(-time.Nanosecond)), "IsExpired just before expiration")
+	assert.True(t, grant.IsExpired(expiration), "IsExpired at expiration")
+	assert.True(t, grant.IsExpired(expiration.Add(time.Hour)), "IsExpired after expiration")
+	assert.False(t, NewAccessGrant(addr, AccessList{Access_Mint}).IsExpired(expiration), "IsExpired without an expiration")
+
+	// The expiration of the latest grant replaces the expiration of an existing one.
+	marker := NewEmptyMarkerAccount("expiring", testAddress().String(), []AccessGrant{*grant})
+	require.NoError(t, marker.GrantAccess(NewAccessGrant(addr, AccessList{Access_Burn})), "GrantAccess without expiration")
+	require.Len(t, marker.AccessControl, 1, "AccessControl")
+	assert.Nil(t, marker.AccessControl[0].Expiration, "Expiration after grant without expiration")
+	assert.ElementsMatch(t, AccessList{Access_Mint, Access_Burn}, marker.AccessControl[0].Permissions, "Permissions")
+	later := expiration.Add(time.Hour)
+	require.NoError(t, marker.GrantAccess(NewExpiringAccessGrant(addr, AccessList{Access_Deposit}, later)), "GrantAccess with expiration")
+	require.Len(t, marker.AccessControl, 1, "AccessControl")
+	assert.Equal(t, &later, marker.AccessControl[0].Expiration, "Expiration after grant with expiration")
+}
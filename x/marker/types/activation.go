@@ -0,0 +1,32 @@
+package types
+
+// The names of the marker activation preconditions.
+const (
+	ActivationCheckStatus        = "status"
+	ActivationCheckManager       = "manager"
+	ActivationCheckGrants        = "grants"
+	ActivationCheckSupply        = "supply"
+	ActivationCheckDenomMetadata = "denom-metadata"
+	ActivationCheckGovernance    = "governance"
+)
+
+// NewActivationCheck returns a new instance of ActivationCheck.
+// The check is met if the detail is empty.
+func NewActivationCheck(name string, required bool, detail string) ActivationCheck {
+	return ActivationCheck{
+		Name:     name,
+		Required: required,
+		Met:      len(detail) == 0,
+		Detail:   detail,
+	}
+}
+
+// ActivationReady returns true if all of the required checks are met.
+func ActivationReady(checks []ActivationCheck) bool {
+	for _, check := range checks {
+		if check.Required && !check.Met {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,66 @@
+package types
+
+import (
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxBasketComponents is the maximum number of underlying coins that can back a basket marker's coin.
+const MaxBasketComponents = 20
+
+// NewBasketInfo returns a new instance of BasketInfo
+func NewBasketInfo(denom string, components sdk.Coins, basketAmount sdkmath.Int) BasketInfo {
+	return BasketInfo{
+		Denom:        denom,
+		Components:   components,
+		BasketAmount: basketAmount,
+	}
+}
+
+// Validate returns error if BasketInfo is not in a valid state
+func (b BasketInfo) Validate() error {
+	if err := sdk.ValidateDenom(b.Denom); err != nil {
+		return fmt.Errorf("invalid basket info denom: %w", err)
+	}
+	if len(b.Components) == 0 {
+		return fmt.Errorf("invalid %s basket info components: cannot be empty", b.Denom)
+	}
+	if len(b.Components) > MaxBasketComponents {
+		return fmt.Errorf("invalid %s basket info components: count %d exceeds max %d", b.Denom, len(b.Components), MaxBasketComponents)
+	}
+	if err := b.Components.Validate(); err != nil {
+		return fmt.Errorf("invalid %s basket info components: %w", b.Denom, err)
+	}
+	if !b.Components.AmountOf(b.Denom).IsZero() {
+		return fmt.Errorf("invalid %s basket info components: cannot include the basket denom", b.Denom)
+	}
+	if b.BasketAmount.IsNil() || !b.BasketAmount.IsPositive() {
+		return fmt.Errorf("invalid %s basket info basket amount %s: must be positive", b.Denom, b.BasketAmount)
+	}
+	return nil
+}
+
+// GetComponentsFor returns the components needed to back the provided amount of basket coin.
+// An error is returned if the amount is not a positive multiple of the basket amount.
+func (b BasketInfo) GetComponentsFor(amount sdk.Coin) (sdk.Coins, error) {
+	if amount.Denom != b.Denom {
+		return nil, fmt.Errorf("cannot use %s with %s basket", amount, b.Denom)
+	}
+	if !amount.Amount.IsPositive() || !amount.Amount.Mod(b.BasketAmount).IsZero() {
+		return nil, fmt.Errorf("invalid %s basket amount %s: must be a positive multiple of %s", b.Denom, amount.Amount, b.BasketAmount)
+	}
+	return b.Components.MulInt(amount.Amount.Quo(b.BasketAmount)), nil
+}
+
+// GetBacking returns the components needed to back the provided supply of basket coin.
+// Any remainder of the supply that is less than the basket amount is not backed.
+func (b BasketInfo) GetBacking(supply sdkmath.Int) sdk.Coins {
+	units := supply.Quo(b.BasketAmount)
+	if !units.IsPositive() {
+		return sdk.Coins{}
+	}
+	return b.Components.MulInt(units)
+}
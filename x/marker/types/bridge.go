@@ -0,0 +1,93 @@
+package types
+
+import (
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// MaxBridgeInfoFieldLength is the maximum length of each of the fields of a BridgeInfo.
+	MaxBridgeInfoFieldLength = 256
+	// MaxMintAttestationReferenceLength is the maximum length of a mint attestation reference.
+	MaxMintAttestationReferenceLength = 256
+)
+
+// NewBridgeInfo returns a new instance of BridgeInfo
+func NewBridgeInfo(denom, originChain, originAssetID, custodian string) BridgeInfo {
+	return BridgeInfo{
+		Denom:         denom,
+		OriginChain:   originChain,
+		OriginAssetId: originAssetID,
+		Custodian:     custodian,
+	}
+}
+
+// Validate returns error if BridgeInfo is not in a valid state
+func (b BridgeInfo) Validate() error {
+	if err := sdk.ValidateDenom(b.Denom); err != nil {
+		return fmt.Errorf("invalid bridge info denom: %w", err)
+	}
+	fields := []struct {
+		name  string
+		value string
+	}{
+		{name: "origin chain", value: b.OriginChain},
+		{name: "origin asset id", value: b.OriginAssetId},
+		{name: "custodian", value: b.Custodian},
+	}
+	for _, field := range fields {
+		if len(field.value) == 0 {
+			return fmt.Errorf("invalid %s bridge info %s: cannot be empty", b.Denom, field.name)
+		}
+		if len(field.value) > MaxBridgeInfoFieldLength {
+			return fmt.Errorf("invalid %s bridge info %s: length %d exceeds max %d", b.Denom, field.name, len(field.value), MaxBridgeInfoFieldLength)
+		}
+	}
+	return nil
+}
+
+// NewMintAttestation returns a new instance of MintAttestation
+func NewMintAttestation(denom string, sequence uint64, blockHeight int64, amount sdkmath.Int, reference, attestor string) MintAttestation {
+	return MintAttestation{
+		Denom:       denom,
+		Sequence:    sequence,
+		BlockHeight: blockHeight,
+		Amount:      amount,
+		Reference:   reference,
+		Attestor:    attestor,
+	}
+}
+
+// Validate returns error if MintAttestation is not in a valid state
+func (a MintAttestation) Validate() error {
+	if err := sdk.ValidateDenom(a.Denom); err != nil {
+		return fmt.Errorf("invalid mint attestation denom: %w", err)
+	}
+	if a.Sequence == 0 {
+		return fmt.Errorf("invalid %s mint attestation sequence: cannot be zero", a.Denom)
+	}
+	if a.Amount.IsNil() || !a.Amount.IsPositive() {
+		return fmt.Errorf("invalid %s mint attestation %d amount %s: must be positive", a.Denom, a.Sequence, a.Amount)
+	}
+	if err := ValidateMintAttestationReference(a.Reference); err != nil {
+		return fmt.Errorf("invalid %s mint attestation %d: %w", a.Denom, a.Sequence, err)
+	}
+	if _, err := sdk.AccAddressFromBech32(a.Attestor); err != nil {
+		return fmt.Errorf("invalid %s mint attestation %d attestor %q: %w", a.Denom, a.Sequence, a.Attestor, err)
+	}
+	return nil
+}
+
+// ValidateMintAttestationReference returns an error if the provided reference cannot be used in a mint attestation.
+func ValidateMintAttestationReference(reference string) error {
+	if len(reference) == 0 {
+		return fmt.Errorf("reference cannot be empty")
+	}
+	if len(reference) > MaxMintAttestationReferenceLength {
+		return fmt.Errorf("reference length %d exceeds max %d", len(reference), MaxMintAttestationReferenceLength)
+	}
+	return nil
+}
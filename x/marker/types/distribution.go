@@ -0,0 +1,114 @@
+package types
+
+import (
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// DefaultDistributionBatchSize is the number of holders processed in each block when a batch size isn't provided.
+	DefaultDistributionBatchSize = uint32(100)
+	// MaxDistributionBatchSize is the largest number of holders that can be processed in each block for a distribution.
+	MaxDistributionBatchSize = uint32(1000)
+)
+
+// NewDistribution returns a new, pending, instance of Distribution
+func NewDistribution(id uint64, denom string, amount sdk.Coin, administrator string, startHeight int64, batchSize uint32, minPayout sdkmath.Int) Distribution {
+	return Distribution{
+		Id:            id,
+		Denom:         denom,
+		Amount:        amount,
+		Administrator: administrator,
+		StartHeight:   startHeight,
+		BatchSize:     batchSize,
+		MinPayout:     minPayout,
+		Status:        DistributionPending,
+		TotalHeld:     sdkmath.ZeroInt(),
+		Paid:          sdkmath.ZeroInt(),
+		Claimable:     sdkmath.ZeroInt(),
+		Refunded:      sdkmath.ZeroInt(),
+	}
+}
+
+// IsActive returns true if the distribution is pending or in progress.
+func (d Distribution) IsActive() bool {
+	return d.Status == DistributionPending || d.Status == DistributionInProgress
+}
+
+// Unallocated returns the amount of the distribution that has not been paid, recorded as claims, or refunded.
+func (d Distribution) Unallocated() sdkmath.Int {
+	return d.Amount.Amount.Sub(d.Paid).Sub(d.Claimable).Sub(d.Refunded)
+}
+
+// Validate returns error if Distribution is not in a valid state
+func (d Distribution) Validate() error {
+	if d.Id == 0 {
+		return fmt.Errorf("distribution id cannot be zero")
+	}
+	if err := sdk.ValidateDenom(d.Denom); err != nil {
+		return fmt.Errorf("invalid distribution %d denom: %w", d.Id, err)
+	}
+	if err := d.Amount.Validate(); err != nil {
+		return fmt.Errorf("invalid distribution %d amount: %w", d.Id, err)
+	}
+	if !d.Amount.IsPositive() {
+		return fmt.Errorf("invalid distribution %d amount: %s must be positive", d.Id, d.Amount)
+	}
+	if _, err := sdk.AccAddressFromBech32(d.Administrator); err != nil {
+		return fmt.Errorf("invalid distribution %d administrator: %w", d.Id, err)
+	}
+	if d.BatchSize == 0 || d.BatchSize > MaxDistributionBatchSize {
+		return fmt.Errorf("invalid distribution %d batch size %d: must be between 1 and %d", d.Id, d.BatchSize, MaxDistributionBatchSize)
+	}
+	if _, ok := DistributionStatus_name[int32(d.Status)]; !ok || d.Status == DistributionUnspecified {
+		return fmt.Errorf("invalid distribution %d status: %s", d.Id, d.Status)
+	}
+	for _, field := range []struct {
+		name  string
+		value sdkmath.Int
+	}{
+		{name: "min payout", value: d.MinPayout},
+		{name: "total held", value: d.TotalHeld},
+		{name: "paid", value: d.Paid},
+		{name: "claimable", value: d.Claimable},
+		{name: "refunded", value: d.Refunded},
+	} {
+		if field.value.IsNil() || field.value.IsNegative() {
+			return fmt.Errorf("invalid distribution %d %s: cannot be negative", d.Id, field.name)
+		}
+	}
+	if d.Unallocated().IsNegative() {
+		return fmt.Errorf("invalid distribution %d: paid %s, claimable %s, and refunded %s exceed amount %s",
+			d.Id, d.Paid, d.Claimable, d.Refunded, d.Amount)
+	}
+	return nil
+}
+
+// NewDistributionClaim returns a new instance of DistributionClaim
+func NewDistributionClaim(distributionID uint64, claimant sdk.AccAddress, amount sdk.Coin) DistributionClaim {
+	return DistributionClaim{
+		DistributionId: distributionID,
+		Claimant:       claimant.String(),
+		Amount:         amount,
+	}
+}
+
+// Validate returns error if DistributionClaim is not in a valid state
+func (c DistributionClaim) Validate() error {
+	if c.DistributionId == 0 {
+		return fmt.Errorf("distribution claim id cannot be zero")
+	}
+	if _, err := sdk.AccAddressFromBech32(c.Claimant); err != nil {
+		return fmt.Errorf("invalid distribution %d claimant: %w", c.DistributionId, err)
+	}
+	if err := c.Amount.Validate(); err != nil {
+		return fmt.Errorf("invalid distribution %d claim amount: %w", c.DistributionId, err)
+	}
+	if !c.Amount.IsPositive() {
+		return fmt.Errorf("invalid distribution %d claim amount: %s must be positive", c.DistributionId, c.Amount)
+	}
+	return nil
+}
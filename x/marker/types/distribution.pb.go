@@ -0,0 +1,1252 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: provenance/marker/v1/distribution.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// DistributionStatus defines the various states a distribution can be in.
+type DistributionStatus int32
+
+const (
+	// DISTRIBUTION_STATUS_UNSPECIFIED - Unknown/Invalid distribution status.
+	DistributionUnspecified DistributionStatus = 0
+	// DISTRIBUTION_STATUS_PENDING - Scheduled, but paying out has not started yet.
+	DistributionPending DistributionStatus = 1
+	// DISTRIBUTION_STATUS_IN_PROGRESS - Holders are being paid out.
+	DistributionInProgress DistributionStatus = 2
+	// DISTRIBUTION_STATUS_COMPLETED - All holders have been processed and the remainder has been refunded.
+	DistributionCompleted DistributionStatus = 3
+	// DISTRIBUTION_STATUS_CANCELLED - Cancelled before paying out started, and the full amount has been refunded.
+	DistributionCancelled DistributionStatus = 4
+	// DISTRIBUTION_STATUS_FAILED - Paying out a batch failed, so it was stopped and the remainder has been refunded.
+	DistributionFailed DistributionStatus = 5
+)
+
+var DistributionStatus_name = map[int32]string{
+	0: "DISTRIBUTION_STATUS_UNSPECIFIED",
+	1: "DISTRIBUTION_STATUS_PENDING",
+	2: "DISTRIBUTION_STATUS_IN_PROGRESS",
+	3: "DISTRIBUTION_STATUS_COMPLETED",
+	4: "DISTRIBUTION_STATUS_CANCELLED",
+	5: "DISTRIBUTION_STATUS_FAILED",
+}
+
+var DistributionStatus_value = map[string]int32{
+	"DISTRIBUTION_STATUS_UNSPECIFIED": 0,
+	"DISTRIBUTION_STATUS_PENDING":     1,
+	"DISTRIBUTION_STATUS_IN_PROGRESS": 2,
+	"DISTRIBUTION_STATUS_COMPLETED":   3,
+	"DISTRIBUTION_STATUS_CANCELLED":   4,
+	"DISTRIBUTION_STATUS_FAILED":      5,
+}
+
+func (x DistributionStatus) String() string {
+	return proto.EnumName(DistributionStatus_name, int32(x))
+}
+
+func (DistributionStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_4957c5d2bd54c983, []int{0}
+}
+
+// Distribution defines a pro-rata payout of funds to the holders of a marker's coin.
+// The funds are held by the marker module until they are paid out, claimed, or refunded.
+type Distribution struct {
+	// id is the unique identifier of this distribution.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// denom is the denom of the marker whose holders receive the payout.
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	// amount is the total amount being distributed.
+	Amount types.Coin `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount"`
+	// administrator is the address that scheduled and funded this distribution. Any undistributed funds are refunded
+	// to this address.
+	Administrator string `protobuf:"bytes,4,opt,name=administrator,proto3" json:"administrator,omitempty"`
+	// start_height is the block height at which paying out this distribution starts.
+	StartHeight int64 `protobuf:"varint,5,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// batch_size is the maximum number of holders processed in each block.
+	BatchSize uint32 `protobuf:"varint,6,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`
+	// min_payout is the smallest amount that is sent directly to a holder. Smaller amounts are recorded as claims.
+	MinPayout cosmossdk_io_math.Int `protobuf:"bytes,7,opt,name=min_payout,json=minPayout,proto3,customtype=cosmossdk.io/math.Int" json:"min_payout"`
+	// status is the current state of this distribution.
+	Status DistributionStatus `protobuf:"varint,8,opt,name=status,proto3,enum=provenance.marker.v1.DistributionStatus" json:"status,omitempty"`
+	// total_held is the amount of the marker's coin held by accounts other than the marker when paying out started.
+	// Each holder's share is their balance divided by this amount.
+	TotalHeld cosmossdk_io_math.Int `protobuf:"bytes,9,opt,name=total_held,json=totalHeld,proto3,customtype=cosmossdk.io/math.Int" json:"total_held"`
+	// paid is the amount that has been sent to holders, including claims that have been claimed.
+	Paid cosmossdk_io_math.Int `protobuf:"bytes,10,opt,name=paid,proto3,customtype=cosmossdk.io/math.Int" json:"paid"`
+	// claimable is the amount that has been recorded as claims but not yet claimed.
+	Claimable cosmossdk_io_math.Int `protobuf:"bytes,11,opt,name=claimable,proto3,customtype=cosmossdk.io/math.Int" json:"claimable"`
+	// refunded is the amount returned to the administrator when this distribution was completed, cancelled, or failed.
+	Refunded cosmossdk_io_math.Int `protobuf:"bytes,12,opt,name=refunded,proto3,customtype=cosmossdk.io/math.Int" json:"refunded"`
+	// holders_processed is the number of holders that have been processed so far.
+	HoldersProcessed uint64 `protobuf:"varint,13,opt,name=holders_processed,json=holdersProcessed,proto3" json:"holders_processed,omitempty"`
+	// next_key is the pagination key of the next holder to process.
+	NextKey []byte `protobuf:"bytes,14,opt,name=next_key,json=nextKey,proto3" json:"next_key,omitempty"`
+}
+
+func (m *Distribution) Reset()         { *m = Distribution{} }
+func (m *Distribution) String() string { return proto.CompactTextString(m) }
+func (*Distribution) ProtoMessage()    {}
+func (*Distribution) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4957c5d2bd54c983, []int{0}
+}
+func (m *Distribution) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Distribution) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Distribution.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Distribution) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Distribution.Merge(m, src)
+}
+func (m *Distribution) XXX_Size() int {
+	return m.Size()
+}
+func (m *Distribution) XXX_DiscardUnknown() {
+	xxx_messageInfo_Distribution.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Distribution proto.InternalMessageInfo
+
+func (m *Distribution) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *Distribution) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *Distribution) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+func (m *Distribution) GetAdministrator() string {
+	if m != nil {
+		return m.Administrator
+	}
+	return ""
+}
+
+func (m *Distribution) GetStartHeight() int64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *Distribution) GetBatchSize() uint32 {
+	if m != nil {
+		return m.BatchSize
+	}
+	return 0
+}
+
+func (m *Distribution) GetStatus() DistributionStatus {
+	if m != nil {
+		return m.Status
+	}
+	return DistributionUnspecified
+}
+
+func (m *Distribution) GetHoldersProcessed() uint64 {
+	if m != nil {
+		return m.HoldersProcessed
+	}
+	return 0
+}
+
+func (m *Distribution) GetNextKey() []byte {
+	if m != nil {
+		return m.NextKey
+	}
+	return nil
+}
+
+// DistributionClaim defines an amount from a distribution that is waiting to be claimed by an account.
+type DistributionClaim struct {
+	// distribution_id is the id of the distribution this claim is part of.
+	DistributionId uint64 `protobuf:"varint,1,opt,name=distribution_id,json=distributionId,proto3" json:"distribution_id,omitempty"`
+	// claimant is the address that can claim the funds.
+	Claimant string `protobuf:"bytes,2,opt,name=claimant,proto3" json:"claimant,omitempty"`
+	// amount is the amount that can be claimed.
+	Amount types.Coin `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount"`
+}
+
+func (m *DistributionClaim) Reset()         { *m = DistributionClaim{} }
+func (m *DistributionClaim) String() string { return proto.CompactTextString(m) }
+func (*DistributionClaim) ProtoMessage()    {}
+func (*DistributionClaim) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4957c5d2bd54c983, []int{1}
+}
+func (m *DistributionClaim) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DistributionClaim) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DistributionClaim.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DistributionClaim) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DistributionClaim.Merge(m, src)
+}
+func (m *DistributionClaim) XXX_Size() int {
+	return m.Size()
+}
+func (m *DistributionClaim) XXX_DiscardUnknown() {
+	xxx_messageInfo_DistributionClaim.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DistributionClaim proto.InternalMessageInfo
+
+func (m *DistributionClaim) GetDistributionId() uint64 {
+	if m != nil {
+		return m.DistributionId
+	}
+	return 0
+}
+
+func (m *DistributionClaim) GetClaimant() string {
+	if m != nil {
+		return m.Claimant
+	}
+	return ""
+}
+
+func (m *DistributionClaim) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+func init() {
+	proto.RegisterEnum("provenance.marker.v1.DistributionStatus", DistributionStatus_name, DistributionStatus_value)
+	proto.RegisterType((*Distribution)(nil), "provenance.marker.v1.Distribution")
+	proto.RegisterType((*DistributionClaim)(nil), "provenance.marker.v1.DistributionClaim")
+}
+
+func init() {
+	proto.RegisterFile("provenance/marker/v1/distribution.proto", fileDescriptor_4957c5d2bd54c983)
+}
+
+var fileDescriptor_4957c5d2bd54c983 = []byte{
+	// 787 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0x4d, 0x6f, 0xdb, 0x36,
+	0x18, 0xc7, 0x2d, 0xc7, 0x49, 0x63, 0xe6, 0x65, 0x2e, 0x97, 0x36, 0x8c, 0x8a, 0x38, 0x5a, 0x2f,
+	0x15, 0x36, 0x54, 0x82, 0xb3, 0x61, 0x2f, 0x58, 0xb1, 0x35, 0xb1, 0x95, 0x56, 0x58, 0xe6, 0x08,
+	0x92, 0x73, 0xd9, 0x45, 0xa0, 0x45, 0x56, 0x26, 0x22, 0x91, 0x86, 0x44, 0x07, 0x4d, 0x3f, 0xc1,
+	0xe0, 0x5d, 0xf6, 0x05, 0x0c, 0x0c, 0xd8, 0x71, 0xd7, 0x7d, 0x88, 0x1e, 0x8b, 0x9d, 0x86, 0x1d,
+	0x8a, 0x21, 0xf9, 0x22, 0x83, 0x5e, 0x9a, 0x28, 0x98, 0x87, 0x19, 0xbd, 0xe9, 0x79, 0xf8, 0xfb,
+	0x3f, 0x7f, 0xea, 0xe1, 0x43, 0x82, 0x47, 0xe3, 0x44, 0x9c, 0x53, 0x8e, 0x79, 0x40, 0xcd, 0x18,
+	0x27, 0x67, 0x34, 0x31, 0xcf, 0x3b, 0x26, 0x61, 0xa9, 0x4c, 0xd8, 0x70, 0x22, 0x99, 0xe0, 0xc6,
+	0x38, 0x11, 0x52, 0xc0, 0xad, 0x1b, 0xd0, 0x28, 0x40, 0xe3, 0xbc, 0xa3, 0x6e, 0x85, 0x22, 0x14,
+	0x39, 0x60, 0x66, 0x5f, 0x05, 0xab, 0xee, 0x04, 0x22, 0x8d, 0x45, 0xea, 0x17, 0x0b, 0x45, 0x50,
+	0x2e, 0xb5, 0x8b, 0xc8, 0x1c, 0xe2, 0x94, 0x9a, 0xe7, 0x9d, 0x21, 0x95, 0xb8, 0x63, 0x06, 0x82,
+	0x95, 0x36, 0x0f, 0x7f, 0x59, 0x06, 0xeb, 0xbd, 0x8a, 0x3b, 0xdc, 0x04, 0x75, 0x46, 0x90, 0xa2,
+	0x29, 0x7a, 0xc3, 0xad, 0x33, 0x02, 0xb7, 0xc0, 0x32, 0xa1, 0x5c, 0xc4, 0xa8, 0xae, 0x29, 0x7a,
+	0xd3, 0x2d, 0x02, 0xf8, 0x05, 0x58, 0xc1, 0xb1, 0x98, 0x70, 0x89, 0x96, 0x34, 0x45, 0x5f, 0xdb,
+	0xdf, 0x31, 0x4a, 0xd7, 0xcc, 0xc7, 0x28, 0x7d, 0x8c, 0xae, 0x60, 0xfc, 0xb0, 0xf1, 0xfa, 0xed,
+	0x5e, 0xcd, 0x2d, 0x71, 0xf8, 0x0d, 0xd8, 0xc0, 0x24, 0x66, 0x3c, 0xb3, 0xc4, 0x52, 0x24, 0xa8,
+	0x91, 0x95, 0x3d, 0x44, 0x7f, 0xfc, 0xfe, 0x78, 0xab, 0x2c, 0x71, 0x40, 0x48, 0x42, 0xd3, 0xd4,
+	0x93, 0x09, 0xe3, 0xa1, 0x7b, 0x1b, 0x87, 0x1f, 0x81, 0xf5, 0x54, 0xe2, 0x44, 0xfa, 0x23, 0xca,
+	0xc2, 0x91, 0x44, 0xcb, 0x9a, 0xa2, 0x2f, 0xb9, 0x6b, 0x79, 0xee, 0x79, 0x9e, 0x82, 0xbb, 0x00,
+	0x0c, 0xb1, 0x0c, 0x46, 0x7e, 0xca, 0x5e, 0x51, 0xb4, 0xa2, 0x29, 0xfa, 0x86, 0xdb, 0xcc, 0x33,
+	0x1e, 0x7b, 0x45, 0xe1, 0x13, 0x00, 0x62, 0xc6, 0xfd, 0x31, 0xbe, 0x10, 0x13, 0x89, 0xee, 0xe4,
+	0xf6, 0xbb, 0xd9, 0x1e, 0xff, 0x7a, 0xbb, 0x77, 0xaf, 0xd8, 0x42, 0x4a, 0xce, 0x0c, 0x26, 0xcc,
+	0x18, 0xcb, 0x91, 0x61, 0x73, 0xe9, 0x36, 0x63, 0xc6, 0x9d, 0x9c, 0x87, 0x4f, 0xc1, 0x4a, 0x2a,
+	0xb1, 0x9c, 0xa4, 0x68, 0x55, 0x53, 0xf4, 0xcd, 0x7d, 0xdd, 0x98, 0x77, 0x4e, 0x46, 0xb5, 0xa5,
+	0x5e, 0xce, 0xbb, 0xa5, 0x2e, 0xf3, 0x97, 0x42, 0xe2, 0xc8, 0x1f, 0xd1, 0x88, 0xa0, 0xe6, 0x42,
+	0xfe, 0xb9, 0xe0, 0x39, 0x8d, 0x08, 0xec, 0x80, 0xc6, 0x18, 0x33, 0x82, 0xc0, 0x22, 0xba, 0x1c,
+	0x85, 0x5f, 0x83, 0x66, 0x10, 0x61, 0x16, 0xe3, 0x61, 0x44, 0xd1, 0xda, 0x42, 0x7e, 0xd7, 0x3c,
+	0xfc, 0x0a, 0xac, 0x26, 0xf4, 0xc5, 0x84, 0x13, 0x4a, 0xd0, 0xfa, 0x22, 0xda, 0x6b, 0x1c, 0x7e,
+	0x02, 0xee, 0x8e, 0x44, 0x44, 0x68, 0x92, 0x0f, 0x66, 0x40, 0xd3, 0x94, 0x12, 0xb4, 0x91, 0x0f,
+	0x56, 0xab, 0x5c, 0x70, 0xde, 0xe5, 0xe1, 0x0e, 0x58, 0xe5, 0xf4, 0xa5, 0xf4, 0xcf, 0xe8, 0x05,
+	0xda, 0xd4, 0x14, 0x7d, 0xdd, 0xbd, 0x93, 0xc5, 0xdf, 0xd1, 0x8b, 0x87, 0xbf, 0x29, 0xe0, 0x6e,
+	0xb5, 0x9f, 0xdd, 0x6c, 0x73, 0xf0, 0x11, 0xf8, 0xa0, 0x7a, 0x6b, 0xfc, 0xeb, 0xa1, 0xdd, 0xac,
+	0xa6, 0x6d, 0x02, 0x3f, 0x03, 0xab, 0xc5, 0xef, 0x70, 0x89, 0xea, 0xff, 0x33, 0x6c, 0xd7, 0xe4,
+	0x7b, 0x0f, 0xf8, 0xc7, 0x3f, 0x2d, 0x01, 0xf8, 0xef, 0xd3, 0x87, 0x4f, 0xc1, 0x5e, 0xcf, 0xf6,
+	0x06, 0xae, 0x7d, 0x78, 0x3a, 0xb0, 0x4f, 0xfa, 0xbe, 0x37, 0x38, 0x18, 0x9c, 0x7a, 0xfe, 0x69,
+	0xdf, 0x73, 0xac, 0xae, 0x7d, 0x64, 0x5b, 0xbd, 0x56, 0x4d, 0x7d, 0x30, 0x9d, 0x69, 0xdb, 0x55,
+	0xf1, 0x29, 0x4f, 0xc7, 0x34, 0x60, 0x2f, 0x18, 0x25, 0xf0, 0x4b, 0xf0, 0x60, 0x5e, 0x05, 0xc7,
+	0xea, 0xf7, 0xec, 0xfe, 0xb3, 0x96, 0xa2, 0x6e, 0x4f, 0x67, 0xda, 0x87, 0x55, 0xb5, 0x43, 0x39,
+	0x61, 0x3c, 0x84, 0xdf, 0xce, 0xf7, 0xb6, 0xfb, 0xbe, 0xe3, 0x9e, 0x3c, 0x73, 0x2d, 0xcf, 0x6b,
+	0xd5, 0x55, 0x75, 0x3a, 0xd3, 0xee, 0x57, 0xd5, 0x36, 0x77, 0x12, 0x11, 0x66, 0xcd, 0x81, 0x4f,
+	0xc0, 0xee, 0xbc, 0x02, 0xdd, 0x93, 0xef, 0x9d, 0x63, 0x6b, 0x60, 0xf5, 0x5a, 0x4b, 0xea, 0xce,
+	0x74, 0xa6, 0xdd, 0xbb, 0x75, 0x4a, 0x22, 0x1e, 0x47, 0x54, 0x52, 0xf2, 0x9f, 0xea, 0x83, 0x7e,
+	0xd7, 0x3a, 0x3e, 0xb6, 0x7a, 0xad, 0xc6, 0x1c, 0x75, 0x76, 0x9f, 0xa2, 0x88, 0x12, 0xf8, 0x39,
+	0x50, 0xe7, 0xa9, 0x8f, 0x0e, 0xec, 0x4c, 0xba, 0xac, 0xde, 0x9f, 0xce, 0xb4, 0x5b, 0x0d, 0x3f,
+	0xc2, 0x2c, 0xa2, 0x44, 0x6d, 0xfc, 0xf8, 0x6b, 0xbb, 0x76, 0x18, 0xbe, 0xbe, 0x6c, 0x2b, 0x6f,
+	0x2e, 0xdb, 0xca, 0xdf, 0x97, 0x6d, 0xe5, 0xe7, 0xab, 0x76, 0xed, 0xcd, 0x55, 0xbb, 0xf6, 0xe7,
+	0x55, 0xbb, 0x06, 0xb6, 0x99, 0x98, 0x7b, 0x75, 0x1d, 0xe5, 0x87, 0xfd, 0x90, 0xc9, 0xd1, 0x64,
+	0x68, 0x04, 0x22, 0x36, 0x6f, 0x90, 0xc7, 0x4c, 0x54, 0x22, 0xf3, 0xe5, 0xbb, 0xe7, 0x5b, 0x5e,
+	0x8c, 0x69, 0x3a, 0x5c, 0xc9, 0x9f, 0xd3, 0x4f, 0xff, 0x19, 0x00, 0x9f, 0x02, 0xfe, 0x39, 0xe0,
+	0x05, 0x00, 0x00,
+}
+
+func (m *Distribution) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Distribution) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Distribution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NextKey) > 0 {
+		i -= len(m.NextKey)
+		copy(dAtA[i:], m.NextKey)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.NextKey)))
+		i--
+		dAtA[i] = 0x72
+	}
+	if m.HoldersProcessed != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.HoldersProcessed))
+		i--
+		dAtA[i] = 0x68
+	}
+	{
+		size := m.Refunded.Size()
+		i -= size
+		if _, err := m.Refunded.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDistribution(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	{
+		size := m.Claimable.Size()
+		i -= size
+		if _, err := m.Claimable.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDistribution(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	{
+		size := m.Paid.Size()
+		i -= size
+		if _, err := m.Paid.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDistribution(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		size := m.TotalHeld.Size()
+		i -= size
+		if _, err := m.TotalHeld.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDistribution(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if m.Status != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x40
+	}
+	{
+		size := m.MinPayout.Size()
+		i -= size
+		if _, err := m.MinPayout.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDistribution(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if m.BatchSize != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.BatchSize))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintDistribution(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DistributionClaim) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DistributionClaim) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DistributionClaim) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintDistribution(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Claimant) > 0 {
+		i -= len(m.Claimant)
+		copy(dAtA[i:], m.Claimant)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.Claimant)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.DistributionId != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.DistributionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintDistribution(dAtA []byte, offset int, v uint64) int {
+	offset -= sovDistribution(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Distribution) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovDistribution(uint64(m.Id))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovDistribution(uint64(l))
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovDistribution(uint64(m.StartHeight))
+	}
+	if m.BatchSize != 0 {
+		n += 1 + sovDistribution(uint64(m.BatchSize))
+	}
+	l = m.MinPayout.Size()
+	n += 1 + l + sovDistribution(uint64(l))
+	if m.Status != 0 {
+		n += 1 + sovDistribution(uint64(m.Status))
+	}
+	l = m.TotalHeld.Size()
+	n += 1 + l + sovDistribution(uint64(l))
+	l = m.Paid.Size()
+	n += 1 + l + sovDistribution(uint64(l))
+	l = m.Claimable.Size()
+	n += 1 + l + sovDistribution(uint64(l))
+	l = m.Refunded.Size()
+	n += 1 + l + sovDistribution(uint64(l))
+	if m.HoldersProcessed != 0 {
+		n += 1 + sovDistribution(uint64(m.HoldersProcessed))
+	}
+	l = len(m.NextKey)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	return n
+}
+
+func (m *DistributionClaim) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DistributionId != 0 {
+		n += 1 + sovDistribution(uint64(m.DistributionId))
+	}
+	l = len(m.Claimant)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovDistribution(uint64(l))
+	return n
+}
+
+func sovDistribution(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozDistribution(x uint64) (n int) {
+	return sovDistribution(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Distribution) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Distribution: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Distribution: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Administrator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchSize", wireType)
+			}
+			m.BatchSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BatchSize |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinPayout", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinPayout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= DistributionStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalHeld", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalHeld.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Paid.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Claimable", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Claimable.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Refunded", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Refunded.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HoldersProcessed", wireType)
+			}
+			m.HoldersProcessed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HoldersProcessed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextKey = append(m.NextKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.NextKey == nil {
+				m.NextKey = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DistributionClaim) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DistributionClaim: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DistributionClaim: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributionId", wireType)
+			}
+			m.DistributionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DistributionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Claimant", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Claimant = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipDistribution(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthDistribution
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupDistribution
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthDistribution
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthDistribution        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowDistribution          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupDistribution = fmt.Errorf("proto: unexpected end of group")
+)
@@ -13,4 +13,6 @@ var (
 	ErrAccessTypeNotGranted    = cerrs.Register(ModuleName, 6, "access type not granted")
 	ErrMarkerNotFound          = cerrs.Register(ModuleName, 7, "marker not found")
 	ErrDuplicateEntry          = cerrs.Register(ModuleName, 8, "duplicate entry")
+	ErrUnknownIBCMemoAction    = cerrs.Register(ModuleName, 9, "unknown ibc memo action")
+	ErrIBCMemoActionNotAllowed = cerrs.Register(ModuleName, 10, "ibc memo action not allowed")
 )
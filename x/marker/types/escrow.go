@@ -0,0 +1,121 @@
+package types
+
+import (
+	"fmt"
+	"regexp"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// MaxEscrowLedgerNameLength is the maximum length of the name of a marker escrow ledger.
+	MaxEscrowLedgerNameLength = 32
+)
+
+// escrowLedgerNameRegex defines the characters allowed in the name of a marker escrow ledger.
+var escrowLedgerNameRegex = regexp.MustCompile(`^[a-z0-9][a-z0-9._-]*$`)
+
+// ValidateEscrowLedgerName returns an error if the provided name cannot be used for a marker escrow ledger.
+func ValidateEscrowLedgerName(name string) error {
+	if len(name) == 0 {
+		return fmt.Errorf("escrow ledger name cannot be empty")
+	}
+	if len(name) > MaxEscrowLedgerNameLength {
+		return fmt.Errorf("escrow ledger name %q cannot be longer than %d characters", name, MaxEscrowLedgerNameLength)
+	}
+	if !escrowLedgerNameRegex.MatchString(name) {
+		return fmt.Errorf("escrow ledger name %q must start with a lowercase letter or digit and only contain lowercase letters, digits, '.', '_', or '-'", name)
+	}
+	return nil
+}
+
+// NewEscrowLedger returns a new instance of EscrowLedger
+func NewEscrowLedger(denom string, name string, balance sdk.Coins) EscrowLedger {
+	return EscrowLedger{
+		Denom:   denom,
+		Name:    name,
+		Balance: balance,
+	}
+}
+
+// Validate returns error if EscrowLedger is not in a valid state
+func (l EscrowLedger) Validate() error {
+	if err := sdk.ValidateDenom(l.Denom); err != nil {
+		return fmt.Errorf("invalid escrow ledger denom: %w", err)
+	}
+	if err := ValidateEscrowLedgerName(l.Name); err != nil {
+		return err
+	}
+	if err := l.Balance.Validate(); err != nil {
+		return fmt.Errorf("invalid %s escrow ledger %q balance: %w", l.Denom, l.Name, err)
+	}
+	return nil
+}
+
+// NewEscrowWithdrawLimit returns a new instance of EscrowWithdrawLimit
+func NewEscrowWithdrawLimit(denom string, ledger string, grantee sdk.AccAddress, remaining sdk.Coins) EscrowWithdrawLimit {
+	return EscrowWithdrawLimit{
+		Denom:     denom,
+		Ledger:    ledger,
+		Grantee:   grantee.String(),
+		Remaining: remaining,
+	}
+}
+
+// Validate returns error if EscrowWithdrawLimit is not in a valid state
+func (l EscrowWithdrawLimit) Validate() error {
+	if err := sdk.ValidateDenom(l.Denom); err != nil {
+		return fmt.Errorf("invalid escrow withdraw limit denom: %w", err)
+	}
+	if err := ValidateEscrowLedgerName(l.Ledger); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(l.Grantee); err != nil {
+		return fmt.Errorf("invalid %s escrow ledger %q withdraw limit grantee: %w", l.Denom, l.Ledger, err)
+	}
+	if err := l.Remaining.Validate(); err != nil {
+		return fmt.Errorf("invalid %s escrow ledger %q withdraw limit: %w", l.Denom, l.Ledger, err)
+	}
+	return nil
+}
+
+// NewScheduledBurn returns a new instance of ScheduledBurn
+func NewScheduledBurn(id uint64, amount sdk.Coin, administrator string, scheduledHeight, burnHeight, cancelDeadline int64) ScheduledBurn {
+	return ScheduledBurn{
+		Id:              id,
+		Amount:          amount,
+		Administrator:   administrator,
+		ScheduledHeight: scheduledHeight,
+		BurnHeight:      burnHeight,
+		CancelDeadline:  cancelDeadline,
+	}
+}
+
+// Validate returns error if ScheduledBurn is not in a valid state
+func (b ScheduledBurn) Validate() error {
+	if b.Id == 0 {
+		return fmt.Errorf("scheduled burn id cannot be zero")
+	}
+	if err := b.Amount.Validate(); err != nil {
+		return fmt.Errorf("invalid scheduled burn %d amount: %w", b.Id, err)
+	}
+	if !b.Amount.IsPositive() {
+		return fmt.Errorf("invalid scheduled burn %d amount: %s must be positive", b.Id, b.Amount)
+	}
+	if _, err := sdk.AccAddressFromBech32(b.Administrator); err != nil {
+		return fmt.Errorf("invalid scheduled burn %d administrator: %w", b.Id, err)
+	}
+	if b.BurnHeight <= b.ScheduledHeight {
+		return fmt.Errorf("invalid scheduled burn %d: burn height %d must be after scheduled height %d", b.Id, b.BurnHeight, b.ScheduledHeight)
+	}
+	if b.CancelDeadline < b.ScheduledHeight || b.CancelDeadline > b.BurnHeight {
+		return fmt.Errorf("invalid scheduled burn %d: cancel deadline %d must be from scheduled height %d to burn height %d",
+			b.Id, b.CancelDeadline, b.ScheduledHeight, b.BurnHeight)
+	}
+	return nil
+}
+
+// CanCancel returns true if the burn's cancel window is still open at the provided block height.
+func (b ScheduledBurn) CanCancel(blockHeight int64) bool {
+	return blockHeight < b.CancelDeadline
+}
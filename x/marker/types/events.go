@@ -3,6 +3,7 @@ package types
 import (
 	"fmt"
 	"strconv"
+	"time"
 
 	sdkmath "cosmossdk.io/math"
 
@@ -185,10 +186,351 @@ func NewEventSetNetAssetValue(denom string, price sdk.Coin, volume uint64, sourc
 }
 
 // NewEventMarkerParamsUpdated returns a new instance of EventMarkerParamsUpdated
-func NewEventMarkerParamsUpdated(allowGovControl bool, denomRegex string, maxSupply sdkmath.Int) *EventMarkerParamsUpdated {
+func NewEventMarkerParamsUpdated(
+	allowGovControl bool,
+	denomRegex string,
+	maxSupply sdkmath.Int,
+	maxQueryResults uint32,
+	maxQueryResponseBytes uint64,
+	supplyHistoryMaxEntries uint32,
+	supplyHistoryRetentionBlocks uint64,
+	maxDistributionHoldersPerBlock uint32,
+	distributionFee sdk.Coins,
+) *EventMarkerParamsUpdated {
 	return &EventMarkerParamsUpdated{
-		EnableGovernance:       strconv.FormatBool(allowGovControl),
-		UnrestrictedDenomRegex: denomRegex,
-		MaxSupply:              maxSupply.String(),
+		EnableGovernance:               strconv.FormatBool(allowGovControl),
+		UnrestrictedDenomRegex:         denomRegex,
+		MaxSupply:                      maxSupply.String(),
+		MaxQueryResults:                strconv.FormatUint(uint64(maxQueryResults), 10),
+		MaxQueryResponseBytes:          strconv.FormatUint(maxQueryResponseBytes, 10),
+		SupplyHistoryMaxEntries:        strconv.FormatUint(uint64(supplyHistoryMaxEntries), 10),
+		SupplyHistoryRetentionBlocks:   strconv.FormatUint(supplyHistoryRetentionBlocks, 10),
+		MaxDistributionHoldersPerBlock: strconv.FormatUint(uint64(maxDistributionHoldersPerBlock), 10),
+		DistributionFee:                distributionFee.String(),
+	}
+}
+
+// NewEventSetMintAllowance returns a new instance of EventSetMintAllowance
+func NewEventSetMintAllowance(denom string, minter string, amount sdkmath.Int, administrator string) *EventSetMintAllowance {
+	return &EventSetMintAllowance{
+		Denom:         denom,
+		Minter:        minter,
+		Amount:        amount.String(),
+		Administrator: administrator,
+	}
+}
+
+// NewEventMintFromAllowance returns a new instance of EventMintFromAllowance
+func NewEventMintFromAllowance(amount sdk.Coin, minter string, remaining sdkmath.Int) *EventMintFromAllowance {
+	return &EventMintFromAllowance{
+		Amount:    amount.Amount.String(),
+		Denom:     amount.Denom,
+		Minter:    minter,
+		Remaining: remaining.String(),
+	}
+}
+
+// NewEventDistributionScheduled returns a new instance of EventDistributionScheduled
+func NewEventDistributionScheduled(distribution Distribution) *EventDistributionScheduled {
+	return &EventDistributionScheduled{
+		DistributionId: distribution.Id,
+		Denom:          distribution.Denom,
+		Amount:         distribution.Amount.String(),
+		StartHeight:    distribution.StartHeight,
+		Administrator:  distribution.Administrator,
+	}
+}
+
+// NewEventDistributionCancelled returns a new instance of EventDistributionCancelled
+func NewEventDistributionCancelled(distribution Distribution, administrator string) *EventDistributionCancelled {
+	return &EventDistributionCancelled{
+		DistributionId: distribution.Id,
+		Denom:          distribution.Denom,
+		Administrator:  administrator,
+	}
+}
+
+// NewEventDistributionCompleted returns a new instance of EventDistributionCompleted
+func NewEventDistributionCompleted(distribution Distribution) *EventDistributionCompleted {
+	return &EventDistributionCompleted{
+		DistributionId:   distribution.Id,
+		Denom:            distribution.Denom,
+		Paid:             sdk.NewCoin(distribution.Amount.Denom, distribution.Paid).String(),
+		Claimable:        sdk.NewCoin(distribution.Amount.Denom, distribution.Claimable).String(),
+		Refunded:         sdk.NewCoin(distribution.Amount.Denom, distribution.Refunded).String(),
+		HoldersProcessed: distribution.HoldersProcessed,
+	}
+}
+
+// NewEventDistributionFailed returns a new instance of EventDistributionFailed
+func NewEventDistributionFailed(distribution Distribution, cause error) *EventDistributionFailed {
+	return &EventDistributionFailed{
+		DistributionId: distribution.Id,
+		Denom:          distribution.Denom,
+		Refunded:       sdk.NewCoin(distribution.Amount.Denom, distribution.Refunded).String(),
+		Error:          cause.Error(),
+	}
+}
+
+// NewEventDistributionClaimed returns a new instance of EventDistributionClaimed
+func NewEventDistributionClaimed(distributionID uint64, claimant string, amount sdk.Coin) *EventDistributionClaimed {
+	return &EventDistributionClaimed{
+		DistributionId: distributionID,
+		Claimant:       claimant,
+		Amount:         amount.String(),
+	}
+}
+
+// NewEventEscrowAllocated returns a new instance of EventEscrowAllocated
+func NewEventEscrowAllocated(denom string, ledger string, amount sdk.Coins, administrator string) *EventEscrowAllocated {
+	return &EventEscrowAllocated{
+		Denom:         denom,
+		Ledger:        ledger,
+		Amount:        amount.String(),
+		Administrator: administrator,
+	}
+}
+
+// NewEventEscrowReleased returns a new instance of EventEscrowReleased
+func NewEventEscrowReleased(denom string, ledger string, amount sdk.Coins, administrator string) *EventEscrowReleased {
+	return &EventEscrowReleased{
+		Denom:         denom,
+		Ledger:        ledger,
+		Amount:        amount.String(),
+		Administrator: administrator,
+	}
+}
+
+// NewEventSetEscrowWithdrawLimit returns a new instance of EventSetEscrowWithdrawLimit
+func NewEventSetEscrowWithdrawLimit(denom string, ledger string, grantee string, limit sdk.Coins, administrator string) *EventSetEscrowWithdrawLimit {
+	return &EventSetEscrowWithdrawLimit{
+		Denom:         denom,
+		Ledger:        ledger,
+		Grantee:       grantee,
+		Limit:         limit.String(),
+		Administrator: administrator,
+	}
+}
+
+// NewEventEscrowWithdraw returns a new instance of EventEscrowWithdraw
+func NewEventEscrowWithdraw(denom string, ledger string, amount sdk.Coins, toAddress string, administrator string, remainingLimit sdk.Coins) *EventEscrowWithdraw {
+	return &EventEscrowWithdraw{
+		Denom:          denom,
+		Ledger:         ledger,
+		Amount:         amount.String(),
+		ToAddress:      toAddress,
+		Administrator:  administrator,
+		RemainingLimit: remainingLimit.String(),
+	}
+}
+
+// NewEventBurnScheduled returns a new instance of EventBurnScheduled
+func NewEventBurnScheduled(burn ScheduledBurn) *EventBurnScheduled {
+	return &EventBurnScheduled{
+		BurnId:         burn.Id,
+		Denom:          burn.Amount.Denom,
+		Amount:         burn.Amount.String(),
+		BurnHeight:     burn.BurnHeight,
+		CancelDeadline: burn.CancelDeadline,
+		Administrator:  burn.Administrator,
+	}
+}
+
+// NewEventScheduledBurnCancelled returns a new instance of EventScheduledBurnCancelled
+func NewEventScheduledBurnCancelled(burn ScheduledBurn, administrator string) *EventScheduledBurnCancelled {
+	return &EventScheduledBurnCancelled{
+		BurnId:        burn.Id,
+		Denom:         burn.Amount.Denom,
+		Amount:        burn.Amount.String(),
+		Administrator: administrator,
+	}
+}
+
+// NewEventMarkerBurnProof returns a new instance of EventMarkerBurnProof
+func NewEventMarkerBurnProof(burn ScheduledBurn, supplyBefore, supplyAfter sdk.Coin) *EventMarkerBurnProof {
+	return &EventMarkerBurnProof{
+		BurnId:          burn.Id,
+		Denom:           burn.Amount.Denom,
+		Amount:          burn.Amount.String(),
+		Administrator:   burn.Administrator,
+		ScheduledHeight: burn.ScheduledHeight,
+		BurnHeight:      burn.BurnHeight,
+		SupplyBefore:    supplyBefore.String(),
+		SupplyAfter:     supplyAfter.String(),
+	}
+}
+
+// NewEventScheduledBurnFailed returns a new instance of EventScheduledBurnFailed
+func NewEventScheduledBurnFailed(burn ScheduledBurn, err error) *EventScheduledBurnFailed {
+	return &EventScheduledBurnFailed{
+		BurnId: burn.Id,
+		Denom:  burn.Amount.Denom,
+		Amount: burn.Amount.String(),
+		Error:  err.Error(),
+	}
+}
+
+// NewEventSendRestrictionBypassSet returns a new instance of EventSendRestrictionBypassSet
+func NewEventSendRestrictionBypassSet(bypass SendRestrictionBypass) *EventSendRestrictionBypassSet {
+	return &EventSendRestrictionBypassSet{
+		Address:    bypass.Address,
+		BypassType: bypass.BypassType.String(),
+		Reason:     bypass.Reason,
+	}
+}
+
+// NewEventSendRestrictionBypassRemoved returns a new instance of EventSendRestrictionBypassRemoved
+func NewEventSendRestrictionBypassRemoved(addr string) *EventSendRestrictionBypassRemoved {
+	return &EventSendRestrictionBypassRemoved{
+		Address: addr,
+	}
+}
+
+// NewEventMarkerTypeChanged returns a new instance of EventMarkerTypeChanged
+func NewEventMarkerTypeChanged(denom string, previousType, newType MarkerType) *EventMarkerTypeChanged {
+	return &EventMarkerTypeChanged{
+		Denom:        denom,
+		PreviousType: previousType.String(),
+		NewType:      newType.String(),
+	}
+}
+
+// NewEventMarkerExchange returns a new instance of EventMarkerExchange
+func NewEventMarkerExchange(partyA sdk.AccAddress, amountA sdk.Coin, partyB sdk.AccAddress, amountB sdk.Coin) *EventMarkerExchange {
+	return &EventMarkerExchange{
+		PartyA:  partyA.String(),
+		AmountA: amountA.String(),
+		PartyB:  partyB.String(),
+		AmountB: amountB.String(),
+	}
+}
+
+// NewEventMarkerAccessExpired returns a new instance of EventMarkerAccessExpired
+func NewEventMarkerAccessExpired(grant AccessGrant, denom string) *EventMarkerAccessExpired {
+	rv := &EventMarkerAccessExpired{
+		Address: grant.Address,
+		Denom:   denom,
+	}
+	for _, permission := range grant.Permissions {
+		rv.Permissions = append(rv.Permissions, permission.String())
+	}
+	if grant.Expiration != nil {
+		rv.Expiration = grant.Expiration.UTC().Format(time.RFC3339Nano)
+	}
+	return rv
+}
+
+// NewEventDepositAllowListUpdated returns a new instance of EventDepositAllowListUpdated
+func NewEventDepositAllowListUpdated(denom string, added, removed []string, administrator string) *EventDepositAllowListUpdated {
+	return &EventDepositAllowListUpdated{
+		Denom:         denom,
+		Added:         added,
+		Removed:       removed,
+		Administrator: administrator,
+	}
+}
+
+// NewEventFeeSponsorshipSet returns a new instance of EventFeeSponsorshipSet
+func NewEventFeeSponsorshipSet(sponsorship FeeSponsorship, administrator string) *EventFeeSponsorshipSet {
+	return &EventFeeSponsorshipSet{
+		Denom:         sponsorship.Denom,
+		SpendLimit:    sponsorship.SpendLimit.String(),
+		Administrator: administrator,
+	}
+}
+
+// NewEventFeeSponsorshipRemoved returns a new instance of EventFeeSponsorshipRemoved
+func NewEventFeeSponsorshipRemoved(denom string, administrator string) *EventFeeSponsorshipRemoved {
+	return &EventFeeSponsorshipRemoved{
+		Denom:         denom,
+		Administrator: administrator,
+	}
+}
+
+// NewEventFeeSponsorshipClaimed returns a new instance of EventFeeSponsorshipClaimed
+func NewEventFeeSponsorshipClaimed(denom string, holder string, spendLimit sdk.Coins, expiration *time.Time, held sdkmath.Int) *EventFeeSponsorshipClaimed {
+	rv := &EventFeeSponsorshipClaimed{
+		Denom:      denom,
+		Holder:     holder,
+		SpendLimit: spendLimit.String(),
+		Held:       held.String(),
+	}
+	if expiration != nil {
+		rv.Expiration = expiration.UTC().Format(time.RFC3339Nano)
+	}
+	return rv
+}
+
+// NewEventFeeSponsorshipHoldReleased returns a new instance of EventFeeSponsorshipHoldReleased
+func NewEventFeeSponsorshipHoldReleased(denom string, holder string, amount sdkmath.Int) *EventFeeSponsorshipHoldReleased {
+	return &EventFeeSponsorshipHoldReleased{
+		Denom:  denom,
+		Holder: holder,
+		Amount: amount.String(),
+	}
+}
+
+// NewEventBridgeInfoSet returns a new instance of EventBridgeInfoSet
+func NewEventBridgeInfoSet(info BridgeInfo, administrator string) *EventBridgeInfoSet {
+	return &EventBridgeInfoSet{
+		Denom:         info.Denom,
+		OriginChain:   info.OriginChain,
+		OriginAssetId: info.OriginAssetId,
+		Custodian:     info.Custodian,
+		Administrator: administrator,
+	}
+}
+
+// NewEventMintAttested returns a new instance of EventMintAttested
+func NewEventMintAttested(attestation MintAttestation) *EventMintAttested {
+	return &EventMintAttested{
+		Denom:     attestation.Denom,
+		Sequence:  attestation.Sequence,
+		Amount:    attestation.Amount.String(),
+		Reference: attestation.Reference,
+		Attestor:  attestation.Attestor,
+	}
+}
+
+// NewEventBasketInfoSet returns a new instance of EventBasketInfoSet
+func NewEventBasketInfoSet(info BasketInfo, administrator string) *EventBasketInfoSet {
+	return &EventBasketInfoSet{
+		Denom:         info.Denom,
+		Components:    info.Components.String(),
+		BasketAmount:  info.BasketAmount.String(),
+		Administrator: administrator,
+	}
+}
+
+// NewEventIBCMemoActionConfigSet returns a new instance of EventIBCMemoActionConfigSet
+func NewEventIBCMemoActionConfigSet(config IBCMemoActionConfig, administrator string) *EventIBCMemoActionConfigSet {
+	actions := make([]string, len(config.Actions))
+	for i, action := range config.Actions {
+		actions[i] = action.Action + ":" + action.Target
+	}
+	return &EventIBCMemoActionConfigSet{
+		Denom:         config.Denom,
+		Actions:       actions,
+		Administrator: administrator,
+	}
+}
+
+// NewEventBasketDeposit returns a new instance of EventBasketDeposit
+func NewEventBasketDeposit(amount sdk.Coin, components sdk.Coins, depositor string) *EventBasketDeposit {
+	return &EventBasketDeposit{
+		Denom:      amount.Denom,
+		Amount:     amount.Amount.String(),
+		Components: components.String(),
+		Depositor:  depositor,
+	}
+}
+
+// NewEventBasketWithdraw returns a new instance of EventBasketWithdraw
+func NewEventBasketWithdraw(amount sdk.Coin, components sdk.Coins, holder string) *EventBasketWithdraw {
+	return &EventBasketWithdraw{
+		Denom:      amount.Denom,
+		Amount:     amount.Amount.String(),
+		Components: components.String(),
+		Holder:     holder,
 	}
 }
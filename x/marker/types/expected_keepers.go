@@ -6,12 +6,18 @@ import (
 
 	"cosmossdk.io/x/feegrant"
 
+	cmtbytes "github.com/cometbft/cometbft/libs/bytes"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/authz"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
 
 	attrtypes "github.com/provenance-io/provenance/x/attribute/types"
+	metadatatypes "github.com/provenance-io/provenance/x/metadata/types"
+	nametypes "github.com/provenance-io/provenance/x/name/types"
 )
 
 // AccountKeeper defines the auth/account functionality needed by the marker keeper.
@@ -46,6 +52,7 @@ type BankKeeper interface {
 
 	AppendSendRestriction(restriction banktypes.SendRestrictionFn)
 	BlockedAddr(addr sdk.AccAddress) bool
+	IsSendEnabledCoins(ctx context.Context, coins ...sdk.Coin) error
 
 	GetDenomMetaData(context context.Context, denom string) (banktypes.Metadata, bool)
 	SetDenomMetaData(context context.Context, denomMetaData banktypes.Metadata)
@@ -54,6 +61,14 @@ type BankKeeper interface {
 // FeeGrantKeeper defines the fee-grant functionality needed by the marker module.
 type FeeGrantKeeper interface {
 	GrantAllowance(ctx context.Context, granter, grantee sdk.AccAddress, feeAllowance feegrant.FeeAllowanceI) error
+	GetAllowance(ctx context.Context, granter, grantee sdk.AccAddress) (feegrant.FeeAllowanceI, error)
+}
+
+// HoldKeeper defines the hold functionality needed by the marker module.
+type HoldKeeper interface {
+	AddHold(ctx sdk.Context, addr sdk.AccAddress, funds sdk.Coins, reason string) error
+	ReleaseHold(ctx sdk.Context, addr sdk.AccAddress, funds sdk.Coins) error
+	GetHoldCoins(ctx sdk.Context, addr sdk.AccAddress) (sdk.Coins, error)
 }
 
 // Note: There is no IBCKeeper interface in here.
@@ -65,18 +80,46 @@ type FeeGrantKeeper interface {
 type AttrKeeper interface {
 	GetMaxValueLength(ctx sdk.Context) uint32
 	GetAllAttributesAddr(ctx sdk.Context, addr []byte) ([]attrtypes.Attribute, error)
+	IsAttributeUnlisted(ctx sdk.Context, addr []byte, name string) bool
 	GetAccountData(ctx sdk.Context, addr string) (string, error)
 	SetAccountData(ctx sdk.Context, addr string, value string) error
+	SetAttribute(ctx sdk.Context, attr attrtypes.Attribute, owner sdk.AccAddress) error
+	PurgeAttribute(ctx sdk.Context, name string, owner sdk.AccAddress) error
 }
 
 // NameKeeper defines the name keeper functionality needed by the marker module.
 type NameKeeper interface {
 	Normalize(ctx sdk.Context, name string) (string, error)
+	ResolvesTo(ctx sdk.Context, name string, addr sdk.AccAddress) bool
+	NameExists(ctx sdk.Context, name string) bool
+	BindNameRecord(ctx sdk.Context, name string, addr sdk.AccAddress, restrict bool, signer sdk.AccAddress) error
+	DeleteRecord(ctx sdk.Context, name string) error
+	AddPendingDeletion(ctx sdk.Context, name string, address sdk.AccAddress, deleteHeight int64) error
+	GetParams(ctx sdk.Context) nametypes.Params
+	GetRecordsByAddress(ctx sdk.Context, address sdk.AccAddress) (nametypes.NameRecords, error)
+}
+
+// MetadataKeeper defines the metadata functionality needed by the marker module.
+type MetadataKeeper interface {
+	Ownership(ctx context.Context, req *metadatatypes.OwnershipRequest) (*metadatatypes.OwnershipResponse, error)
+	ValueOwnership(ctx context.Context, req *metadatatypes.ValueOwnershipRequest) (*metadatatypes.ValueOwnershipResponse, error)
 }
 
-// IbcTransferMsgServer defines the message server functionality needed by the marker module.
+// IbcTransferMsgServer defines the ibc transfer functionality needed by the marker module.
 type IbcTransferMsgServer interface {
 	Transfer(goCtx context.Context, msg *transfertypes.MsgTransfer) (*transfertypes.MsgTransferResponse, error)
+	GetDenomTrace(ctx sdk.Context, denomTraceHash cmtbytes.HexBytes) (transfertypes.DenomTrace, bool)
+}
+
+// StakingMsgServer defines the staking message server functionality needed by the marker module.
+type StakingMsgServer interface {
+	Delegate(goCtx context.Context, msg *stakingtypes.MsgDelegate) (*stakingtypes.MsgDelegateResponse, error)
+	Undelegate(goCtx context.Context, msg *stakingtypes.MsgUndelegate) (*stakingtypes.MsgUndelegateResponse, error)
+}
+
+// DistrMsgServer defines the distribution message server functionality needed by the marker module.
+type DistrMsgServer interface {
+	WithdrawDelegatorReward(goCtx context.Context, msg *distrtypes.MsgWithdrawDelegatorReward) (*distrtypes.MsgWithdrawDelegatorRewardResponse, error)
 }
 
 // GroupChecker defines the functionality for checking if an account is part of a group.
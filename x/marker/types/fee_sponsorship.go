@@ -0,0 +1,67 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// HoldReasonCodeFeeSponsorship is the hold reason code for the funds held for a fee sponsorship claim.
+const HoldReasonCodeFeeSponsorship = "marker_fee_sponsorship"
+
+// NewFeeSponsorship returns a new instance of FeeSponsorship
+func NewFeeSponsorship(denom string, spendLimit sdk.Coins, grantDuration time.Duration, minBalance sdkmath.Int, requiredAttributes []string) FeeSponsorship {
+	return FeeSponsorship{
+		Denom:              denom,
+		SpendLimit:         spendLimit,
+		GrantDuration:      grantDuration,
+		MinBalance:         minBalance,
+		RequiredAttributes: requiredAttributes,
+	}
+}
+
+// Validate returns error if FeeSponsorship is not in a valid state
+func (s FeeSponsorship) Validate() error {
+	if err := sdk.ValidateDenom(s.Denom); err != nil {
+		return fmt.Errorf("invalid fee sponsorship denom: %w", err)
+	}
+	if err := s.SpendLimit.Validate(); err != nil {
+		return fmt.Errorf("invalid %s fee sponsorship spend limit: %w", s.Denom, err)
+	}
+	if s.SpendLimit.IsZero() {
+		return fmt.Errorf("invalid %s fee sponsorship spend limit: cannot be empty", s.Denom)
+	}
+	if s.GrantDuration < 0 {
+		return fmt.Errorf("invalid %s fee sponsorship grant duration %s: cannot be negative", s.Denom, s.GrantDuration)
+	}
+	if s.MinBalance.IsNil() || s.MinBalance.IsNegative() {
+		return fmt.Errorf("invalid %s fee sponsorship min balance %s: cannot be negative", s.Denom, s.MinBalance)
+	}
+	// Without any criteria, anyone could claim a grant, and the marker's funds could be drained using new accounts.
+	if s.MinBalance.IsZero() && len(s.RequiredAttributes) == 0 {
+		return fmt.Errorf("invalid %s fee sponsorship: must have a min balance or required attributes", s.Denom)
+	}
+	seen := make(map[string]bool, len(s.RequiredAttributes))
+	for _, attr := range s.RequiredAttributes {
+		if len(attr) == 0 {
+			return fmt.Errorf("invalid %s fee sponsorship: required attributes cannot be empty", s.Denom)
+		}
+		if seen[attr] {
+			return fmt.Errorf("invalid %s fee sponsorship: duplicate required attribute %q", s.Denom, attr)
+		}
+		seen[attr] = true
+	}
+	return nil
+}
+
+// GetGrantExpiration returns when a grant claimed at the provided block time expires, or nil if the grants don't expire.
+func (s FeeSponsorship) GetGrantExpiration(blockTime time.Time) *time.Time {
+	if s.GrantDuration == 0 {
+		return nil
+	}
+	rv := blockTime.Add(s.GrantDuration)
+	return &rv
+}
@@ -2,8 +2,10 @@ package types
 
 import (
 	"encoding/json"
+	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // NewGenesisState creates a new GenesisState object
@@ -30,13 +32,145 @@ func (state GenesisState) Validate() error {
 			}
 		}
 	}
+	for _, allowance := range state.MintAllowances {
+		if err := allowance.Validate(); err != nil {
+			return err
+		}
+	}
+	distIDs := make(map[uint64]bool, len(state.Distributions))
+	for _, distribution := range state.Distributions {
+		if err := distribution.Validate(); err != nil {
+			return err
+		}
+		if distIDs[distribution.Id] {
+			return fmt.Errorf("duplicate distribution id %d", distribution.Id)
+		}
+		distIDs[distribution.Id] = true
+	}
+	for _, claim := range state.DistributionClaims {
+		if err := claim.Validate(); err != nil {
+			return err
+		}
+		if !distIDs[claim.DistributionId] {
+			return fmt.Errorf("distribution claim for %s references unknown distribution %d", claim.Claimant, claim.DistributionId)
+		}
+	}
+	ledgers := make(map[string]bool, len(state.EscrowLedgers))
+	for _, ledger := range state.EscrowLedgers {
+		if err := ledger.Validate(); err != nil {
+			return err
+		}
+		key := ledger.Denom + "/" + ledger.Name
+		if ledgers[key] {
+			return fmt.Errorf("duplicate %s escrow ledger %q", ledger.Denom, ledger.Name)
+		}
+		ledgers[key] = true
+	}
+	for _, limit := range state.EscrowWithdrawLimits {
+		if err := limit.Validate(); err != nil {
+			return err
+		}
+	}
+	burnIDs := make(map[uint64]bool, len(state.ScheduledBurns))
+	for _, burn := range state.ScheduledBurns {
+		if err := burn.Validate(); err != nil {
+			return err
+		}
+		if burnIDs[burn.Id] {
+			return fmt.Errorf("duplicate scheduled burn id %d", burn.Id)
+		}
+		burnIDs[burn.Id] = true
+	}
+	if err := ValidateSendRestrictionBypasses(state.SendRestrictionBypasses); err != nil {
+		return err
+	}
+	supplyChanges := make(map[string]bool, len(state.SupplyHistory))
+	for _, change := range state.SupplyHistory {
+		if err := change.Validate(); err != nil {
+			return err
+		}
+		key := fmt.Sprintf("%s/%d", change.Denom, change.Sequence)
+		if supplyChanges[key] {
+			return fmt.Errorf("duplicate %s supply change %d", change.Denom, change.Sequence)
+		}
+		supplyChanges[key] = true
+	}
+	for _, allow := range state.DepositAllowAddresses {
+		if _, err := sdk.AccAddressFromBech32(allow.MarkerAddress); err != nil {
+			return fmt.Errorf("invalid deposit allow list marker address %q: %w", allow.MarkerAddress, err)
+		}
+		if _, err := sdk.AccAddressFromBech32(allow.AllowAddress); err != nil {
+			return fmt.Errorf("invalid deposit allow list address %q: %w", allow.AllowAddress, err)
+		}
+	}
+	sponsorships := make(map[string]bool, len(state.FeeSponsorships))
+	for _, sponsorship := range state.FeeSponsorships {
+		if err := sponsorship.Validate(); err != nil {
+			return err
+		}
+		if sponsorships[sponsorship.Denom] {
+			return fmt.Errorf("duplicate %s fee sponsorship", sponsorship.Denom)
+		}
+		sponsorships[sponsorship.Denom] = true
+	}
+	for _, claim := range state.FeeSponsorshipClaims {
+		if err := sdk.ValidateDenom(claim.Denom); err != nil {
+			return fmt.Errorf("invalid fee sponsorship claim denom: %w", err)
+		}
+		if _, err := sdk.AccAddressFromBech32(claim.Holder); err != nil {
+			return fmt.Errorf("invalid %s fee sponsorship claim holder %q: %w", claim.Denom, claim.Holder, err)
+		}
+		if !claim.Held.IsNil() && claim.Held.IsNegative() {
+			return fmt.Errorf("invalid %s fee sponsorship claim held amount %s: cannot be negative", claim.Denom, claim.Held)
+		}
+	}
+	bridgeInfos := make(map[string]bool, len(state.BridgeInfos))
+	for _, info := range state.BridgeInfos {
+		if err := info.Validate(); err != nil {
+			return err
+		}
+		if bridgeInfos[info.Denom] {
+			return fmt.Errorf("duplicate %s bridge info", info.Denom)
+		}
+		bridgeInfos[info.Denom] = true
+	}
+	for _, attestation := range state.MintAttestations {
+		if err := attestation.Validate(); err != nil {
+			return err
+		}
+	}
+	basketInfos := make(map[string]bool, len(state.BasketInfos))
+	for _, info := range state.BasketInfos {
+		if err := info.Validate(); err != nil {
+			return err
+		}
+		if basketInfos[info.Denom] {
+			return fmt.Errorf("duplicate %s basket info", info.Denom)
+		}
+		basketInfos[info.Denom] = true
+	}
+	ibcMemoActionConfigs := make(map[string]bool, len(state.IbcMemoActionConfigs))
+	for _, config := range state.IbcMemoActionConfigs {
+		if err := config.Validate(); err != nil {
+			return err
+		}
+		if len(config.Actions) == 0 {
+			return fmt.Errorf("invalid %s ibc memo action config: actions cannot be empty", config.Denom)
+		}
+		if ibcMemoActionConfigs[config.Denom] {
+			return fmt.Errorf("duplicate %s ibc memo action config", config.Denom)
+		}
+		ibcMemoActionConfigs[config.Denom] = true
+	}
 
 	return nil
 }
 
 // DefaultGenesisState returns the initial module genesis state.
 func DefaultGenesisState() *GenesisState {
-	return NewGenesisState(DefaultParams(), []MarkerAccount{}, []DenySendAddress{}, []MarkerNetAssetValues{})
+	rv := NewGenesisState(DefaultParams(), []MarkerAccount{}, []DenySendAddress{}, []MarkerNetAssetValues{})
+	rv.SendRestrictionBypasses = DefaultSendRestrictionBypasses()
+	return rv
 }
 
 // GetGenesisStateFromAppState returns x/marker GenesisState given raw application
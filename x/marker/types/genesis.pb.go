@@ -4,6 +4,7 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -33,6 +34,36 @@ type GenesisState struct {
 	NetAssetValues []MarkerNetAssetValues `protobuf:"bytes,3,rep,name=net_asset_values,json=netAssetValues,proto3" json:"net_asset_values"`
 	// list of denom based denied send addresses
 	DenySendAddresses []DenySendAddress `protobuf:"bytes,4,rep,name=deny_send_addresses,json=denySendAddresses,proto3" json:"deny_send_addresses"`
+	// list of mint allowances given to accounts
+	MintAllowances []MintAllowance `protobuf:"bytes,5,rep,name=mint_allowances,json=mintAllowances,proto3" json:"mint_allowances"`
+	// list of distributions to marker holders
+	Distributions []Distribution `protobuf:"bytes,6,rep,name=distributions,proto3" json:"distributions"`
+	// list of distribution claims waiting to be claimed
+	DistributionClaims []DistributionClaim `protobuf:"bytes,7,rep,name=distribution_claims,json=distributionClaims,proto3" json:"distribution_claims"`
+	// list of escrow ledgers of markers
+	EscrowLedgers []EscrowLedger `protobuf:"bytes,8,rep,name=escrow_ledgers,json=escrowLedgers,proto3" json:"escrow_ledgers"`
+	// list of escrow ledger withdraw limits given to accounts
+	EscrowWithdrawLimits []EscrowWithdrawLimit `protobuf:"bytes,9,rep,name=escrow_withdraw_limits,json=escrowWithdrawLimits,proto3" json:"escrow_withdraw_limits"`
+	// list of burns of marker escrow that have been scheduled but not yet executed
+	ScheduledBurns []ScheduledBurn `protobuf:"bytes,10,rep,name=scheduled_burns,json=scheduledBurns,proto3" json:"scheduled_burns"`
+	// list of accounts that are exempt from some of the marker send restrictions
+	SendRestrictionBypasses []SendRestrictionBypass `protobuf:"bytes,11,rep,name=send_restriction_bypasses,json=sendRestrictionBypasses,proto3" json:"send_restriction_bypasses"`
+	// list of the entries in the supply history of markers
+	SupplyHistory []SupplyChange `protobuf:"bytes,12,rep,name=supply_history,json=supplyHistory,proto3" json:"supply_history"`
+	// list of denom based addresses allowed to deposit into markers
+	DepositAllowAddresses []DepositAllowAddress `protobuf:"bytes,13,rep,name=deposit_allow_addresses,json=depositAllowAddresses,proto3" json:"deposit_allow_addresses"`
+	// list of marker fee sponsorships
+	FeeSponsorships []FeeSponsorship `protobuf:"bytes,14,rep,name=fee_sponsorships,json=feeSponsorships,proto3" json:"fee_sponsorships"`
+	// list of holders that have claimed fee grants from marker fee sponsorships
+	FeeSponsorshipClaims []FeeSponsorshipClaim `protobuf:"bytes,15,rep,name=fee_sponsorship_claims,json=feeSponsorshipClaims,proto3" json:"fee_sponsorship_claims"`
+	// list of wrapped-asset marker bridge infos
+	BridgeInfos []BridgeInfo `protobuf:"bytes,16,rep,name=bridge_infos,json=bridgeInfos,proto3" json:"bridge_infos"`
+	// list of attested mints of wrapped-asset markers
+	MintAttestations []MintAttestation `protobuf:"bytes,17,rep,name=mint_attestations,json=mintAttestations,proto3" json:"mint_attestations"`
+	// list of basket marker infos
+	BasketInfos []BasketInfo `protobuf:"bytes,18,rep,name=basket_infos,json=basketInfos,proto3" json:"basket_infos"`
+	// list of the ibc memo actions allowed for marker coins
+	IbcMemoActionConfigs []IBCMemoActionConfig `protobuf:"bytes,19,rep,name=ibc_memo_action_configs,json=ibcMemoActionConfigs,proto3" json:"ibc_memo_action_configs"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -109,6 +140,90 @@ func (m *DenySendAddress) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_DenySendAddress proto.InternalMessageInfo
 
+// DepositAllowAddress defines an address that is allowed to deposit funds into a marker
+type DepositAllowAddress struct {
+	// marker_address is the marker's address
+	MarkerAddress string `protobuf:"bytes,1,opt,name=marker_address,json=markerAddress,proto3" json:"marker_address,omitempty"`
+	// allow_address is the address that is allowed to deposit funds into the marker
+	AllowAddress string `protobuf:"bytes,2,opt,name=allow_address,json=allowAddress,proto3" json:"allow_address,omitempty"`
+}
+
+func (m *DepositAllowAddress) Reset()         { *m = DepositAllowAddress{} }
+func (m *DepositAllowAddress) String() string { return proto.CompactTextString(m) }
+func (*DepositAllowAddress) ProtoMessage()    {}
+func (*DepositAllowAddress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5dcc4ab7c9d2f78f, []int{2}
+}
+func (m *DepositAllowAddress) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DepositAllowAddress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DepositAllowAddress.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DepositAllowAddress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DepositAllowAddress.Merge(m, src)
+}
+func (m *DepositAllowAddress) XXX_Size() int {
+	return m.Size()
+}
+func (m *DepositAllowAddress) XXX_DiscardUnknown() {
+	xxx_messageInfo_DepositAllowAddress.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DepositAllowAddress proto.InternalMessageInfo
+
+// FeeSponsorshipClaim defines a holder that has claimed a fee grant from a marker's fee sponsorship
+type FeeSponsorshipClaim struct {
+	// denom is the marker's denom
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// holder is the address that claimed the fee grant
+	Holder string `protobuf:"bytes,2,opt,name=holder,proto3" json:"holder,omitempty"`
+	// held is the amount of the marker's coin that is on hold for the claim. It is zero once the hold has been released.
+	Held cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=held,proto3,customtype=cosmossdk.io/math.Int" json:"held"`
+}
+
+func (m *FeeSponsorshipClaim) Reset()         { *m = FeeSponsorshipClaim{} }
+func (m *FeeSponsorshipClaim) String() string { return proto.CompactTextString(m) }
+func (*FeeSponsorshipClaim) ProtoMessage()    {}
+func (*FeeSponsorshipClaim) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5dcc4ab7c9d2f78f, []int{3}
+}
+func (m *FeeSponsorshipClaim) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeSponsorshipClaim) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeSponsorshipClaim.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeSponsorshipClaim) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeSponsorshipClaim.Merge(m, src)
+}
+func (m *FeeSponsorshipClaim) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeSponsorshipClaim) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeSponsorshipClaim.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeSponsorshipClaim proto.InternalMessageInfo
+
 // MarkerNetAssetValues defines the net asset values for a marker
 type MarkerNetAssetValues struct {
 	// address defines the marker address
@@ -121,7 +236,7 @@ func (m *MarkerNetAssetValues) Reset()         { *m = MarkerNetAssetValues{} }
 func (m *MarkerNetAssetValues) String() string { return proto.CompactTextString(m) }
 func (*MarkerNetAssetValues) ProtoMessage()    {}
 func (*MarkerNetAssetValues) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5dcc4ab7c9d2f78f, []int{2}
+	return fileDescriptor_5dcc4ab7c9d2f78f, []int{4}
 }
 func (m *MarkerNetAssetValues) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -153,6 +268,8 @@ var xxx_messageInfo_MarkerNetAssetValues proto.InternalMessageInfo
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "provenance.marker.v1.GenesisState")
 	proto.RegisterType((*DenySendAddress)(nil), "provenance.marker.v1.DenySendAddress")
+	proto.RegisterType((*DepositAllowAddress)(nil), "provenance.marker.v1.DepositAllowAddress")
+	proto.RegisterType((*FeeSponsorshipClaim)(nil), "provenance.marker.v1.FeeSponsorshipClaim")
 	proto.RegisterType((*MarkerNetAssetValues)(nil), "provenance.marker.v1.MarkerNetAssetValues")
 }
 
@@ -161,33 +278,66 @@ func init() {
 }
 
 var fileDescriptor_5dcc4ab7c9d2f78f = []byte{
-	// 406 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0xcd, 0xaa, 0xda, 0x40,
-	0x18, 0x86, 0x13, 0x15, 0x6d, 0x47, 0x6b, 0xdb, 0xa9, 0xd0, 0x20, 0x25, 0xfe, 0x14, 0x41, 0x0a,
-	0x4d, 0xd0, 0xee, 0xdc, 0x69, 0x0b, 0x5d, 0xb5, 0x88, 0x42, 0x17, 0x76, 0x11, 0xc6, 0xe4, 0x23,
-	0x0d, 0xad, 0x33, 0x21, 0x33, 0x86, 0x7a, 0x07, 0xdd, 0xb5, 0x97, 0xe0, 0xe5, 0xb8, 0x94, 0xae,
-	0xce, 0xea, 0x70, 0xd0, 0xcd, 0xb9, 0x8c, 0x83, 0x93, 0x04, 0xcd, 0x61, 0x70, 0x37, 0xf3, 0xf1,
-	0xbc, 0xcf, 0x3b, 0x99, 0x0c, 0xea, 0x86, 0x11, 0x8b, 0x81, 0x12, 0xea, 0x82, 0xbd, 0x22, 0xd1,
-	0x4f, 0x88, 0xec, 0x78, 0x60, 0xfb, 0x40, 0x81, 0x07, 0xdc, 0x0a, 0x23, 0x26, 0x18, 0x6e, 0x9c,
-	0x19, 0x2b, 0x61, 0xac, 0x78, 0xd0, 0x6c, 0xf8, 0xcc, 0x67, 0x12, 0xb0, 0x4f, 0xab, 0x84, 0x6d,
-	0x76, 0x94, 0xbe, 0x34, 0x25, 0x91, 0xee, 0xff, 0x02, 0xaa, 0x7d, 0x4e, 0x0a, 0xe6, 0x82, 0x08,
-	0xc0, 0x23, 0x54, 0x0e, 0x49, 0x44, 0x56, 0xdc, 0xd0, 0xdb, 0x7a, 0xbf, 0x3a, 0x7c, 0x63, 0xa9,
-	0x0a, 0xad, 0xa9, 0x64, 0x26, 0xa5, 0xdd, 0x6d, 0x4b, 0x9b, 0xa5, 0x09, 0xfc, 0x11, 0x55, 0x12,
-	0x82, 0x1b, 0x85, 0x76, 0xb1, 0x5f, 0x1d, 0xbe, 0x55, 0x87, 0xbf, 0xc8, 0xd5, 0xd8, 0x75, 0xd9,
-	0x9a, 0x8a, 0xd4, 0x91, 0x25, 0xf1, 0x02, 0xbd, 0xa0, 0x20, 0x1c, 0xc2, 0x39, 0x08, 0x27, 0x26,
-	0xbf, 0xd6, 0xc0, 0x8d, 0xa2, 0xb4, 0xbd, 0xbb, 0x66, 0xfb, 0x0a, 0x62, 0x7c, 0x8a, 0x7c, 0x93,
-	0x89, 0x54, 0x5a, 0xa7, 0xb9, 0x29, 0xfe, 0x8e, 0x5e, 0x79, 0x40, 0x37, 0x0e, 0x07, 0xea, 0x39,
-	0xc4, 0xf3, 0x22, 0xe0, 0x1c, 0xb8, 0x51, 0x92, 0xfa, 0x9e, 0x5a, 0xff, 0x09, 0xe8, 0x66, 0x0e,
-	0xd4, 0x1b, 0x27, 0x78, 0x6a, 0x7e, 0xe9, 0xe5, 0xc7, 0xc0, 0x47, 0x4f, 0xfe, 0x6c, 0x5b, 0xda,
-	0xfd, 0xb6, 0xa5, 0x75, 0x01, 0x3d, 0x7f, 0x94, 0xc2, 0x3d, 0x54, 0x4f, 0x94, 0x59, 0xad, 0xbc,
-	0xde, 0xa7, 0xb3, 0x67, 0xc9, 0x34, 0xc3, 0x3a, 0xa8, 0x26, 0x0f, 0x98, 0x41, 0x05, 0x09, 0x55,
-	0x4f, 0xb3, 0x14, 0xb9, 0xa8, 0xf9, 0xab, 0xa3, 0x86, 0xea, 0xe3, 0xb1, 0x81, 0x2a, 0xf9, 0x96,
-	0x6c, 0x8b, 0xe7, 0x8a, 0xcb, 0xbd, 0xfa, 0xab, 0x72, 0x66, 0xf5, 0xad, 0x9e, 0x4f, 0x34, 0xf1,
-	0x77, 0x07, 0x53, 0xdf, 0x1f, 0x4c, 0xfd, 0xee, 0x60, 0xea, 0xff, 0x8e, 0xa6, 0xb6, 0x3f, 0x9a,
-	0xda, 0xcd, 0xd1, 0xd4, 0xd0, 0xeb, 0x80, 0x29, 0x0b, 0xa6, 0xfa, 0x62, 0xe8, 0x07, 0xe2, 0xc7,
-	0x7a, 0x69, 0xb9, 0x6c, 0x65, 0x9f, 0x91, 0xf7, 0x01, 0xbb, 0xd8, 0xd9, 0xbf, 0xb3, 0x07, 0x2c,
-	0x36, 0x21, 0xf0, 0x65, 0x59, 0xbe, 0xde, 0x0f, 0x0f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xc6, 0x2d,
-	0x05, 0xd5, 0x32, 0x03, 0x00, 0x00,
+	// 933 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x96, 0xcd, 0x6e, 0xdb, 0x46,
+	0x10, 0xc7, 0xa5, 0xd8, 0xb1, 0x93, 0xd5, 0x87, 0xed, 0x95, 0x12, 0xb3, 0x41, 0x2b, 0x3b, 0x4e,
+	0x83, 0xa4, 0x2d, 0x2a, 0xc1, 0xee, 0x2d, 0x37, 0x49, 0xe9, 0x87, 0x81, 0x24, 0x0d, 0x24, 0xf4,
+	0x03, 0x29, 0x50, 0x82, 0xe2, 0x8e, 0xc8, 0x85, 0xc9, 0x5d, 0x82, 0xb3, 0xb2, 0xab, 0x5b, 0x8f,
+	0xbd, 0xb5, 0x8f, 0x90, 0xbe, 0x4d, 0x8e, 0x39, 0x16, 0x3d, 0x04, 0x85, 0x7d, 0xe9, 0x63, 0x14,
+	0x5c, 0x2e, 0x23, 0xd2, 0x66, 0xd8, 0xdc, 0xc4, 0xd9, 0xff, 0xff, 0x37, 0xa3, 0xe1, 0xec, 0x2e,
+	0xc9, 0x41, 0x14, 0xcb, 0x53, 0x10, 0x8e, 0x70, 0x61, 0x10, 0x3a, 0xf1, 0x09, 0xc4, 0x83, 0xd3,
+	0xc3, 0x81, 0x07, 0x02, 0x90, 0x63, 0x3f, 0x8a, 0xa5, 0x92, 0xb4, 0xbb, 0xd2, 0xf4, 0x53, 0x4d,
+	0xff, 0xf4, 0xf0, 0x4e, 0xd7, 0x93, 0x9e, 0xd4, 0x82, 0x41, 0xf2, 0x2b, 0xd5, 0xde, 0xb9, 0x5b,
+	0xca, 0x33, 0xae, 0x54, 0xf2, 0xa0, 0x54, 0xc2, 0x38, 0xaa, 0x98, 0xcf, 0x16, 0x8a, 0x4b, 0x91,
+	0x0a, 0x0f, 0xfe, 0x6c, 0x91, 0xe6, 0xd7, 0x69, 0x25, 0x53, 0xe5, 0x28, 0xa0, 0x8f, 0xc8, 0x46,
+	0xe4, 0xc4, 0x4e, 0x88, 0x56, 0x7d, 0xbf, 0xfe, 0xb0, 0x71, 0xf4, 0x61, 0xbf, 0xac, 0xb2, 0xfe,
+	0x73, 0xad, 0x19, 0xad, 0xbf, 0x7a, 0xb3, 0x57, 0x9b, 0x18, 0x07, 0x1d, 0x93, 0xcd, 0x54, 0x81,
+	0xd6, 0xb5, 0xfd, 0xb5, 0x87, 0x8d, 0xa3, 0x7b, 0xe5, 0xe6, 0xa7, 0xfa, 0xd7, 0xd0, 0x75, 0xe5,
+	0x42, 0x28, 0xc3, 0xc8, 0x9c, 0xf4, 0x05, 0xd9, 0x16, 0xa0, 0x6c, 0x07, 0x11, 0x94, 0x7d, 0xea,
+	0x04, 0x0b, 0x40, 0x6b, 0x4d, 0xd3, 0x3e, 0xad, 0xa2, 0x3d, 0x03, 0x35, 0x4c, 0x2c, 0xdf, 0x6b,
+	0x87, 0x81, 0xb6, 0x45, 0x21, 0x4a, 0x7f, 0x22, 0x1d, 0x06, 0x62, 0x69, 0x23, 0x08, 0x66, 0x3b,
+	0x8c, 0xc5, 0x80, 0x08, 0x68, 0xad, 0x6b, 0xfc, 0xfd, 0x72, 0xfc, 0x63, 0x10, 0xcb, 0x29, 0x08,
+	0x36, 0x4c, 0xe5, 0x86, 0xbc, 0xc3, 0x8a, 0x61, 0x40, 0x3a, 0x21, 0x5b, 0x21, 0x17, 0xca, 0x76,
+	0x82, 0x40, 0x9e, 0x25, 0x10, 0xb4, 0xae, 0x57, 0x76, 0x81, 0x0b, 0x35, 0xcc, 0xb4, 0x59, 0xc1,
+	0x61, 0x3e, 0x88, 0xf4, 0x19, 0x69, 0xe5, 0x5f, 0x1a, 0x5a, 0x1b, 0x9a, 0x78, 0xf0, 0x8e, 0x52,
+	0x73, 0x52, 0x03, 0x2c, 0xda, 0xe9, 0xcf, 0xa4, 0x93, 0x0f, 0xd8, 0x6e, 0xe0, 0xf0, 0x10, 0xad,
+	0x4d, 0x4d, 0x7d, 0xf0, 0xff, 0xd4, 0x71, 0xa2, 0x37, 0x68, 0xca, 0x2e, 0x2f, 0x20, 0xfd, 0x96,
+	0xb4, 0x01, 0xdd, 0x58, 0x9e, 0xd9, 0x01, 0x30, 0x2f, 0x19, 0x84, 0x1b, 0x55, 0x05, 0x7f, 0xa9,
+	0xb5, 0x4f, 0xb4, 0x34, 0x2b, 0x18, 0x72, 0x31, 0xa4, 0x40, 0x6e, 0x1b, 0xe0, 0x19, 0x57, 0x3e,
+	0x8b, 0x9d, 0x33, 0x3b, 0xe0, 0x21, 0x57, 0x68, 0xdd, 0xd4, 0xe0, 0x4f, 0xaa, 0xc0, 0x3f, 0x18,
+	0xcb, 0x93, 0xc4, 0x61, 0xf8, 0x5d, 0xb8, 0xba, 0xa4, 0xdf, 0x1d, 0xba, 0x3e, 0xb0, 0x45, 0x00,
+	0xcc, 0x9e, 0x2d, 0x62, 0x81, 0x16, 0xa9, 0x7a, 0x77, 0xd3, 0x4c, 0x3c, 0x5a, 0xc4, 0x59, 0xab,
+	0xdb, 0x98, 0x0f, 0x22, 0x0d, 0xc9, 0x07, 0x7a, 0xce, 0x62, 0x48, 0xda, 0xe4, 0xea, 0x7e, 0xcf,
+	0x96, 0x91, 0xa3, 0x47, 0xae, 0xa1, 0xe9, 0x9f, 0xbd, 0x83, 0x0e, 0x82, 0x4d, 0x56, 0xae, 0x91,
+	0x36, 0x99, 0x2c, 0xbb, 0x58, 0xb6, 0x08, 0xba, 0xf5, 0xb8, 0x88, 0xa2, 0x60, 0x69, 0xfb, 0x1c,
+	0x95, 0x8c, 0x97, 0x56, 0xb3, 0xaa, 0xf5, 0x53, 0xad, 0x1d, 0xfb, 0x8e, 0xf0, 0xb2, 0xe1, 0x6b,
+	0xa5, 0xfe, 0x6f, 0x52, 0x3b, 0xf5, 0xc8, 0x2e, 0x83, 0x48, 0x22, 0x37, 0x23, 0x9d, 0xdb, 0x30,
+	0xad, 0xaa, 0xde, 0x3f, 0x4e, 0x4d, 0x7a, 0x8a, 0x8b, 0x9b, 0xe6, 0x16, 0xbb, 0xba, 0x04, 0x48,
+	0xbf, 0x23, 0xdb, 0x73, 0x00, 0x1b, 0x23, 0x29, 0x50, 0xc6, 0xe8, 0xf3, 0x08, 0xad, 0xb6, 0xce,
+	0xf0, 0x71, 0x79, 0x86, 0xaf, 0x00, 0xa6, 0x2b, 0xb1, 0x81, 0x6f, 0xcd, 0x0b, 0x51, 0x3d, 0x3a,
+	0x97, 0xb0, 0xd9, 0xb8, 0x6f, 0x55, 0x95, 0x5f, 0x84, 0xe7, 0x07, 0xbe, 0x3b, 0xbf, 0xba, 0x84,
+	0xf4, 0x98, 0x34, 0x67, 0x31, 0x67, 0x1e, 0xd8, 0x5c, 0xcc, 0x25, 0x5a, 0xdb, 0x1a, 0xbe, 0x5f,
+	0x0e, 0x1f, 0x69, 0xe5, 0xb1, 0x98, 0x4b, 0xc3, 0x6c, 0xcc, 0xde, 0x46, 0x90, 0xfe, 0x48, 0x76,
+	0xd2, 0x13, 0x44, 0x29, 0x40, 0xe5, 0xa4, 0x3b, 0x7e, 0xa7, 0xea, 0x70, 0xd2, 0x67, 0xc8, 0x4a,
+	0x6d, 0xa0, 0xdb, 0x61, 0x31, 0x9c, 0x16, 0xe9, 0xe0, 0x09, 0x28, 0x53, 0x24, 0xad, 0x2c, 0x52,
+	0x2b, 0x0b, 0x45, 0xbe, 0x8d, 0x20, 0x9d, 0x93, 0x5d, 0x3e, 0x73, 0xed, 0x10, 0x42, 0x69, 0x3b,
+	0xe9, 0x54, 0xbb, 0x52, 0xcc, 0xb9, 0x87, 0x56, 0xa7, 0xaa, 0xaf, 0xc7, 0xa3, 0xf1, 0x53, 0x08,
+	0xe5, 0x50, 0x5b, 0xc6, 0xda, 0x91, 0xf5, 0x95, 0xcf, 0xdc, 0xcb, 0x4b, 0xf8, 0xe8, 0xc6, 0x6f,
+	0x2f, 0xf7, 0x6a, 0xff, 0xbe, 0xdc, 0xab, 0x1d, 0x00, 0xd9, 0xba, 0x74, 0x08, 0xd3, 0xfb, 0xa4,
+	0x9d, 0x92, 0xb3, 0xa1, 0xd4, 0xb7, 0xd5, 0xcd, 0x49, 0x2b, 0x8d, 0x66, 0xb2, 0xbb, 0xa4, 0xa9,
+	0xcf, 0xfb, 0x4c, 0x74, 0x4d, 0x8b, 0x1a, 0x49, 0xcc, 0x48, 0x72, 0x69, 0x4e, 0x48, 0xa7, 0x64,
+	0x74, 0xdf, 0x37, 0xd5, 0x3d, 0xd2, 0x2a, 0xec, 0x12, 0x93, 0xab, 0xe9, 0xe4, 0x58, 0xb9, 0x64,
+	0xbf, 0xd6, 0x49, 0xa7, 0x64, 0xd2, 0x68, 0x97, 0x5c, 0x67, 0x20, 0x64, 0x68, 0x92, 0xa4, 0x0f,
+	0xf4, 0x36, 0xd9, 0xf0, 0x65, 0xc0, 0x20, 0x36, 0x54, 0xf3, 0x44, 0x0f, 0xc9, 0xba, 0x0f, 0x01,
+	0xb3, 0xd6, 0x92, 0xe8, 0xe8, 0xa3, 0xa4, 0x9b, 0x7f, 0xbf, 0xd9, 0xbb, 0xe5, 0x4a, 0x0c, 0x25,
+	0x22, 0x3b, 0xe9, 0x73, 0x39, 0x08, 0x1d, 0xe5, 0xf7, 0x8f, 0x85, 0x9a, 0x68, 0x69, 0xae, 0x84,
+	0xdf, 0xeb, 0xa4, 0x5b, 0x76, 0x77, 0x52, 0x8b, 0x6c, 0x16, 0xff, 0x6a, 0xf6, 0x48, 0xa7, 0x25,
+	0x77, 0x73, 0xe5, 0x4d, 0x5f, 0x20, 0x97, 0x5f, 0xca, 0xab, 0x8a, 0x46, 0xde, 0xab, 0xf3, 0x5e,
+	0xfd, 0xf5, 0x79, 0xaf, 0xfe, 0xcf, 0x79, 0xaf, 0xfe, 0xc7, 0x45, 0xaf, 0xf6, 0xfa, 0xa2, 0x57,
+	0xfb, 0xeb, 0xa2, 0x57, 0x23, 0xbb, 0x5c, 0x96, 0x26, 0x78, 0x5e, 0x7f, 0x71, 0xe4, 0x71, 0xe5,
+	0x2f, 0x66, 0x7d, 0x57, 0x86, 0x83, 0x95, 0xe4, 0x73, 0x2e, 0x73, 0x4f, 0x83, 0x5f, 0xb2, 0xaf,
+	0x20, 0xb5, 0x8c, 0x00, 0x67, 0x1b, 0xfa, 0xe3, 0xe7, 0x8b, 0xff, 0x06, 0x00, 0x9c, 0x00, 0xdc,
+	0x27, 0x9a, 0x09, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -210,6 +360,224 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.IbcMemoActionConfigs) > 0 {
+		for iNdEx := len(m.IbcMemoActionConfigs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.IbcMemoActionConfigs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
+		}
+	}
+	if len(m.BasketInfos) > 0 {
+		for iNdEx := len(m.BasketInfos) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BasketInfos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if len(m.MintAttestations) > 0 {
+		for iNdEx := len(m.MintAttestations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MintAttestations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if len(m.BridgeInfos) > 0 {
+		for iNdEx := len(m.BridgeInfos) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BridgeInfos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.FeeSponsorshipClaims) > 0 {
+		for iNdEx := len(m.FeeSponsorshipClaims) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeSponsorshipClaims[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if len(m.FeeSponsorships) > 0 {
+		for iNdEx := len(m.FeeSponsorships) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeSponsorships[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.DepositAllowAddresses) > 0 {
+		for iNdEx := len(m.DepositAllowAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DepositAllowAddresses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.SupplyHistory) > 0 {
+		for iNdEx := len(m.SupplyHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SupplyHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.SendRestrictionBypasses) > 0 {
+		for iNdEx := len(m.SendRestrictionBypasses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SendRestrictionBypasses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.ScheduledBurns) > 0 {
+		for iNdEx := len(m.ScheduledBurns) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ScheduledBurns[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.EscrowWithdrawLimits) > 0 {
+		for iNdEx := len(m.EscrowWithdrawLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EscrowWithdrawLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.EscrowLedgers) > 0 {
+		for iNdEx := len(m.EscrowLedgers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EscrowLedgers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.DistributionClaims) > 0 {
+		for iNdEx := len(m.DistributionClaims) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DistributionClaims[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.Distributions) > 0 {
+		for iNdEx := len(m.Distributions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Distributions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.MintAllowances) > 0 {
+		for iNdEx := len(m.MintAllowances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MintAllowances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if len(m.DenySendAddresses) > 0 {
 		for iNdEx := len(m.DenySendAddresses) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -302,7 +670,7 @@ func (m *DenySendAddress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MarkerNetAssetValues) Marshal() (dAtA []byte, err error) {
+func (m *DepositAllowAddress) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -312,123 +680,1028 @@ func (m *MarkerNetAssetValues) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MarkerNetAssetValues) MarshalTo(dAtA []byte) (int, error) {
+func (m *DepositAllowAddress) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MarkerNetAssetValues) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DepositAllowAddress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.NetAssetValues) > 0 {
-		for iNdEx := len(m.NetAssetValues) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.NetAssetValues[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
+	if len(m.AllowAddress) > 0 {
+		i -= len(m.AllowAddress)
+		copy(dAtA[i:], m.AllowAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.AllowAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MarkerAddress) > 0 {
+		i -= len(m.MarkerAddress)
+		copy(dAtA[i:], m.MarkerAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.MarkerAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FeeSponsorshipClaim) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeSponsorshipClaim) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeSponsorshipClaim) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Held.Size()
+		i -= size
+		if _, err := m.Held.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Holder) > 0 {
+		i -= len(m.Holder)
+		copy(dAtA[i:], m.Holder)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Holder)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MarkerNetAssetValues) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MarkerNetAssetValues) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MarkerNetAssetValues) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NetAssetValues) > 0 {
+		for iNdEx := len(m.NetAssetValues) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.NetAssetValues[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Markers) > 0 {
+		for _, e := range m.Markers {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.NetAssetValues) > 0 {
+		for _, e := range m.NetAssetValues {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DenySendAddresses) > 0 {
+		for _, e := range m.DenySendAddresses {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.MintAllowances) > 0 {
+		for _, e := range m.MintAllowances {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Distributions) > 0 {
+		for _, e := range m.Distributions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DistributionClaims) > 0 {
+		for _, e := range m.DistributionClaims {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.EscrowLedgers) > 0 {
+		for _, e := range m.EscrowLedgers {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.EscrowWithdrawLimits) > 0 {
+		for _, e := range m.EscrowWithdrawLimits {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ScheduledBurns) > 0 {
+		for _, e := range m.ScheduledBurns {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SendRestrictionBypasses) > 0 {
+		for _, e := range m.SendRestrictionBypasses {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SupplyHistory) > 0 {
+		for _, e := range m.SupplyHistory {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DepositAllowAddresses) > 0 {
+		for _, e := range m.DepositAllowAddresses {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.FeeSponsorships) > 0 {
+		for _, e := range m.FeeSponsorships {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.FeeSponsorshipClaims) > 0 {
+		for _, e := range m.FeeSponsorshipClaims {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.BridgeInfos) > 0 {
+		for _, e := range m.BridgeInfos {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.MintAttestations) > 0 {
+		for _, e := range m.MintAttestations {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.BasketInfos) > 0 {
+		for _, e := range m.BasketInfos {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.IbcMemoActionConfigs) > 0 {
+		for _, e := range m.IbcMemoActionConfigs {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DenySendAddress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MarkerAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.DenyAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *DepositAllowAddress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MarkerAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.AllowAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *FeeSponsorshipClaim) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Holder)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Held.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *MarkerNetAssetValues) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.NetAssetValues) > 0 {
+		for _, e := range m.NetAssetValues {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Markers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Markers = append(m.Markers, MarkerAccount{})
+			if err := m.Markers[len(m.Markers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetAssetValues", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NetAssetValues = append(m.NetAssetValues, MarkerNetAssetValues{})
+			if err := m.NetAssetValues[len(m.NetAssetValues)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenySendAddresses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenySendAddresses = append(m.DenySendAddresses, DenySendAddress{})
+			if err := m.DenySendAddresses[len(m.DenySendAddresses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintAllowances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MintAllowances = append(m.MintAllowances, MintAllowance{})
+			if err := m.MintAllowances[len(m.MintAllowances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Distributions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Distributions = append(m.Distributions, Distribution{})
+			if err := m.Distributions[len(m.Distributions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributionClaims", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DistributionClaims = append(m.DistributionClaims, DistributionClaim{})
+			if err := m.DistributionClaims[len(m.DistributionClaims)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowLedgers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EscrowLedgers = append(m.EscrowLedgers, EscrowLedger{})
+			if err := m.EscrowLedgers[len(m.EscrowLedgers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowWithdrawLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EscrowWithdrawLimits = append(m.EscrowWithdrawLimits, EscrowWithdrawLimit{})
+			if err := m.EscrowWithdrawLimits[len(m.EscrowWithdrawLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledBurns", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScheduledBurns = append(m.ScheduledBurns, ScheduledBurn{})
+			if err := m.ScheduledBurns[len(m.ScheduledBurns)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SendRestrictionBypasses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SendRestrictionBypasses = append(m.SendRestrictionBypasses, SendRestrictionBypass{})
+			if err := m.SendRestrictionBypasses[len(m.SendRestrictionBypasses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SupplyHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SupplyHistory = append(m.SupplyHistory, SupplyChange{})
+			if err := m.SupplyHistory[len(m.SupplyHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositAllowAddresses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DepositAllowAddresses = append(m.DepositAllowAddresses, DepositAllowAddress{})
+			if err := m.DepositAllowAddresses[len(m.DepositAllowAddresses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeSponsorships", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeSponsorships = append(m.FeeSponsorships, FeeSponsorship{})
+			if err := m.FeeSponsorships[len(m.FeeSponsorships)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeSponsorshipClaims", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeSponsorshipClaims = append(m.FeeSponsorshipClaims, FeeSponsorshipClaim{})
+			if err := m.FeeSponsorshipClaims[len(m.FeeSponsorshipClaims)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeInfos", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BridgeInfos = append(m.BridgeInfos, BridgeInfo{})
+			if err := m.BridgeInfos[len(m.BridgeInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintAttestations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MintAttestations = append(m.MintAttestations, MintAttestation{})
+			if err := m.MintAttestations[len(m.MintAttestations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BasketInfos", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BasketInfos = append(m.BasketInfos, BasketInfo{})
+			if err := m.BasketInfos[len(m.BasketInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IbcMemoActionConfigs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IbcMemoActionConfigs = append(m.IbcMemoActionConfigs, IBCMemoActionConfig{})
+			if err := m.IbcMemoActionConfigs[len(m.IbcMemoActionConfigs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGenesis(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *GenesisState) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	if len(m.Markers) > 0 {
-		for _, e := range m.Markers {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
-	}
-	if len(m.NetAssetValues) > 0 {
-		for _, e := range m.NetAssetValues {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
-	}
-	if len(m.DenySendAddresses) > 0 {
-		for _, e := range m.DenySendAddresses {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
-
-func (m *DenySendAddress) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.MarkerAddress)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
-	}
-	l = len(m.DenyAddress)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
-	}
-	return n
-}
 
-func (m *MarkerNetAssetValues) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
-	}
-	if len(m.NetAssetValues) > 0 {
-		for _, e := range m.NetAssetValues {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovGenesis(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozGenesis(x uint64) (n int) {
-	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *GenesisState) Unmarshal(dAtA []byte) error {
+func (m *DenySendAddress) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -451,17 +1724,17 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+			return fmt.Errorf("proto: DenySendAddress: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DenySendAddress: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MarkerAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -471,30 +1744,29 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.MarkerAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Markers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DenyAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -504,31 +1776,79 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Markers = append(m.Markers, MarkerAccount{})
-			if err := m.Markers[len(m.Markers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.DenyAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 3:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DepositAllowAddress) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DepositAllowAddress: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DepositAllowAddress: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NetAssetValues", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MarkerAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -538,31 +1858,29 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NetAssetValues = append(m.NetAssetValues, MarkerNetAssetValues{})
-			if err := m.NetAssetValues[len(m.NetAssetValues)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.MarkerAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DenySendAddresses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -572,25 +1890,23 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DenySendAddresses = append(m.DenySendAddresses, DenySendAddress{})
-			if err := m.DenySendAddresses[len(m.DenySendAddresses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.AllowAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -613,7 +1929,7 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DenySendAddress) Unmarshal(dAtA []byte) error {
+func (m *FeeSponsorshipClaim) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -636,15 +1952,15 @@ func (m *DenySendAddress) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DenySendAddress: wiretype end group for non-group")
+			return fmt.Errorf("proto: FeeSponsorshipClaim: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DenySendAddress: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FeeSponsorshipClaim: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarkerAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -672,11 +1988,11 @@ func (m *DenySendAddress) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MarkerAddress = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DenyAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Holder", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -704,7 +2020,41 @@ func (m *DenySendAddress) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DenyAddress = string(dAtA[iNdEx:postIndex])
+			m.Holder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Held", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Held.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
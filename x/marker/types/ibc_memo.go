@@ -0,0 +1,202 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// IBCMemoActionsKey is the top-level ICS-20 memo key that holds the marker actions to execute.
+	// Its value is a JSON object mapping each action name to that action's payload, e.g.
+	// {"marker-actions":{"attribute":{"name":"subscribed.example","value":"gold"}}}
+	IBCMemoActionsKey = "marker-actions"
+
+	// IBCMemoActionAttribute is the name of the built-in action that stamps an attribute on the receiver.
+	IBCMemoActionAttribute = "attribute"
+
+	// MaxIBCMemoActions is the maximum number of memo actions that can be allowed for a marker's coin.
+	MaxIBCMemoActions = 20
+)
+
+// IBCReceiveInfo describes funds of a marker's denom that have been received over IBC.
+type IBCReceiveInfo struct {
+	// Marker is the marker of the received denom.
+	Marker MarkerAccountI
+	// Sender is the address of the sender on the source chain.
+	Sender string
+	// Receiver is the account that received the funds.
+	Receiver sdk.AccAddress
+	// Amount is the amount of funds received.
+	Amount sdk.Coin
+}
+
+// IBCMemoHandler executes an action requested in the memo of an ICS-20 packet for a marker's denom.
+// The allowed entries are the ones the marker's admin configured for the action, and there is always at least one.
+// Handlers must only use a target and value allowed by one of them (see SelectIBCMemoAction).
+type IBCMemoHandler interface {
+	HandleIBCMemoAction(ctx sdk.Context, info IBCReceiveInfo, allowed []IBCMemoAction, payload json.RawMessage) error
+}
+
+// IBCMemoHandlerFn is a function that implements IBCMemoHandler.
+type IBCMemoHandlerFn func(ctx sdk.Context, info IBCReceiveInfo, allowed []IBCMemoAction, payload json.RawMessage) error
+
+var _ IBCMemoHandler = IBCMemoHandlerFn(nil)
+
+// HandleIBCMemoAction calls the function.
+func (f IBCMemoHandlerFn) HandleIBCMemoAction(ctx sdk.Context, info IBCReceiveInfo, allowed []IBCMemoAction, payload json.RawMessage) error {
+	return f(ctx, info, allowed, payload)
+}
+
+// IBCMemoHandlerRegistry holds the handlers available for marker memo actions, keyed by action name.
+type IBCMemoHandlerRegistry struct {
+	handlers map[string]IBCMemoHandler
+}
+
+// NewIBCMemoHandlerRegistry creates a new, empty IBCMemoHandlerRegistry.
+func NewIBCMemoHandlerRegistry() *IBCMemoHandlerRegistry {
+	return &IBCMemoHandlerRegistry{handlers: make(map[string]IBCMemoHandler)}
+}
+
+// Register associates the handler with the provided action name.
+// An error is returned if the action is empty or already has a handler.
+func (r *IBCMemoHandlerRegistry) Register(action string, handler IBCMemoHandler) error {
+	if len(action) == 0 {
+		return fmt.Errorf("ibc memo action name cannot be empty")
+	}
+	if handler == nil {
+		return fmt.Errorf("ibc memo action %q handler cannot be nil", action)
+	}
+	if _, found := r.handlers[action]; found {
+		return fmt.Errorf("ibc memo action %q already has a handler", action)
+	}
+	r.handlers[action] = handler
+	return nil
+}
+
+// Get returns the handler for the provided action name, and whether one was found.
+func (r *IBCMemoHandlerRegistry) Get(action string) (IBCMemoHandler, bool) {
+	handler, found := r.handlers[action]
+	return handler, found
+}
+
+// Actions returns the names of all the registered actions, sorted.
+func (r *IBCMemoHandlerRegistry) Actions() []string {
+	rv := make([]string, 0, len(r.handlers))
+	for action := range r.handlers {
+		rv = append(rv, action)
+	}
+	sort.Strings(rv)
+	return rv
+}
+
+// ParseIBCMemoActions extracts the marker actions from the provided ICS-20 memo.
+// If the memo isn't a JSON object or doesn't have any marker actions, an empty map is returned.
+func ParseIBCMemoActions(memo string) (map[string]json.RawMessage, error) {
+	var memoObj map[string]json.RawMessage
+	if len(memo) == 0 || json.Unmarshal([]byte(memo), &memoObj) != nil {
+		return map[string]json.RawMessage{}, nil
+	}
+	actionsBz, found := memoObj[IBCMemoActionsKey]
+	if !found {
+		return map[string]json.RawMessage{}, nil
+	}
+	var rv map[string]json.RawMessage
+	if err := json.Unmarshal(actionsBz, &rv); err != nil {
+		return nil, fmt.Errorf("invalid %q memo value: %w", IBCMemoActionsKey, err)
+	}
+	if rv == nil {
+		rv = map[string]json.RawMessage{}
+	}
+	return rv, nil
+}
+
+// IBCMemoAttributePayload is the payload of the built-in attribute memo action.
+type IBCMemoAttributePayload struct {
+	// Name is the attribute name to stamp on the receiver. It must be allowed by the marker and resolve to its address.
+	Name string `json:"name"`
+	// Value is the string value of the attribute. It can only be provided if the marker doesn't set it.
+	Value string `json:"value,omitempty"`
+}
+
+// NewIBCMemoActionConfig returns a new instance of IBCMemoActionConfig
+func NewIBCMemoActionConfig(denom string, actions ...IBCMemoAction) IBCMemoActionConfig {
+	return IBCMemoActionConfig{
+		Denom:   denom,
+		Actions: actions,
+	}
+}
+
+// NewIBCMemoAction returns a new instance of IBCMemoAction
+func NewIBCMemoAction(action, target, value string) IBCMemoAction {
+	return IBCMemoAction{
+		Action: action,
+		Target: target,
+		Value:  value,
+	}
+}
+
+// Validate returns error if IBCMemoActionConfig is not in a valid state. A config without any actions is valid.
+func (c IBCMemoActionConfig) Validate() error {
+	if err := sdk.ValidateDenom(c.Denom); err != nil {
+		return fmt.Errorf("invalid ibc memo action config denom: %w", err)
+	}
+	if len(c.Actions) > MaxIBCMemoActions {
+		return fmt.Errorf("invalid %s ibc memo actions: count %d exceeds max %d", c.Denom, len(c.Actions), MaxIBCMemoActions)
+	}
+	seen := make(map[string]bool, len(c.Actions))
+	for i, action := range c.Actions {
+		if err := action.Validate(); err != nil {
+			return fmt.Errorf("invalid %s ibc memo action[%d]: %w", c.Denom, i, err)
+		}
+		key := action.Action + " " + action.Target
+		if seen[key] {
+			return fmt.Errorf("invalid %s ibc memo actions: duplicate %q action with target %q", c.Denom, action.Action, action.Target)
+		}
+		seen[key] = true
+	}
+	return nil
+}
+
+// GetAllowed returns the configured entries for the provided action name.
+func (c IBCMemoActionConfig) GetAllowed(action string) []IBCMemoAction {
+	var rv []IBCMemoAction
+	for _, allowed := range c.Actions {
+		if allowed.Action == action {
+			rv = append(rv, allowed)
+		}
+	}
+	return rv
+}
+
+// Validate returns error if IBCMemoAction is not in a valid state
+func (a IBCMemoAction) Validate() error {
+	if len(strings.TrimSpace(a.Action)) == 0 {
+		return fmt.Errorf("action cannot be empty")
+	}
+	if len(strings.TrimSpace(a.Target)) == 0 {
+		return fmt.Errorf("%q action target cannot be empty", a.Action)
+	}
+	return nil
+}
+
+// SelectIBCMemoAction returns the value to use for the allowed entry with the provided target.
+// If the entry has a value, that is used and the memo cannot provide one. Otherwise, the memo's value is used.
+func SelectIBCMemoAction(allowed []IBCMemoAction, target, value string) (string, error) {
+	for _, action := range allowed {
+		if action.Target != target {
+			continue
+		}
+		if len(action.Value) == 0 {
+			return value, nil
+		}
+		if len(value) > 0 {
+			return "", fmt.Errorf("target %q value is set by the marker and cannot be provided", target)
+		}
+		return action.Value, nil
+	}
+	return "", fmt.Errorf("target %q is not allowed", target)
+}
@@ -0,0 +1,108 @@
+package types
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestIBCMemoHandlerRegistry(t *testing.T) {
+	noop := IBCMemoHandlerFn(func(_ sdk.Context, _ IBCReceiveInfo, _ []IBCMemoAction, _ json.RawMessage) error { return nil })
+
+	registry := NewIBCMemoHandlerRegistry()
+	assert.Empty(t, registry.Actions(), "Actions on new registry")
+
+	require.NoError(t, registry.Register("second", noop), "Register second")
+	require.NoError(t, registry.Register("first", noop), "Register first")
+	assert.EqualError(t, registry.Register("first", noop), `ibc memo action "first" already has a handler`, "Register first again")
+	assert.EqualError(t, registry.Register("", noop), "ibc memo action name cannot be empty", "Register empty")
+	assert.EqualError(t, registry.Register("third", nil), `ibc memo action "third" handler cannot be nil`, "Register nil handler")
+	assert.Equal(t, []string{"first", "second"}, registry.Actions(), "Actions")
+
+	_, found := registry.Get("first")
+	assert.True(t, found, "Get first")
+	_, found = registry.Get("third")
+	assert.False(t, found, "Get third")
+}
+
+func TestParseIBCMemoActions(t *testing.T) {
+	tests := []struct {
+		name   string
+		memo   string
+		exp    map[string]json.RawMessage
+		expErr string
+	}{
+		{name: "empty", memo: "", exp: map[string]json.RawMessage{}},
+		{name: "plain text", memo: "hello", exp: map[string]json.RawMessage{}},
+		{name: "json array", memo: `["marker-actions"]`, exp: map[string]json.RawMessage{}},
+		{name: "no actions key", memo: `{"wasm":{}}`, exp: map[string]json.RawMessage{}},
+		{name: "null actions", memo: `{"marker-actions":null}`, exp: map[string]json.RawMessage{}},
+		{
+			name:   "actions not an object",
+			memo:   `{"marker-actions":"attribute"}`,
+			expErr: `invalid "marker-actions" memo value: json: cannot unmarshal string into Go value of type map[string]json.RawMessage`,
+		},
+		{
+			name: "two actions",
+			memo: `{"wasm":{},"marker-actions":{"attribute":{"name":"a.b"},"other":[1]}}`,
+			exp: map[string]json.RawMessage{
+				"attribute": json.RawMessage(`{"name":"a.b"}`),
+				"other":     json.RawMessage(`[1]`),
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			actual, err := ParseIBCMemoActions(tc.memo)
+			if len(tc.expErr) > 0 {
+				assert.EqualError(t, err, tc.expErr, "ParseIBCMemoActions error")
+			} else {
+				assert.NoError(t, err, "ParseIBCMemoActions error")
+			}
+			assert.Equal(t, tc.exp, actual, "ParseIBCMemoActions result")
+		})
+	}
+}
+
+func TestSelectIBCMemoAction(t *testing.T) {
+	config := NewIBCMemoActionConfig("somedenom",
+		NewIBCMemoAction(IBCMemoActionAttribute, "open.example", ""),
+		NewIBCMemoAction(IBCMemoActionAttribute, "fixed.example", "gold"),
+		NewIBCMemoAction("wasm", "contract", ""),
+	)
+	allowed := config.GetAllowed(IBCMemoActionAttribute)
+	assert.Len(t, allowed, 2, "GetAllowed(attribute)")
+	assert.Empty(t, config.GetAllowed("other"), "GetAllowed(other)")
+
+	tests := []struct {
+		name   string
+		target string
+		value  string
+		exp    string
+		expErr string
+	}{
+		{name: "open: memo value", target: "open.example", value: "silver", exp: "silver"},
+		{name: "open: no memo value", target: "open.example", value: "", exp: ""},
+		{name: "fixed: no memo value", target: "fixed.example", value: "", exp: "gold"},
+		{name: "fixed: memo value", target: "fixed.example", value: "silver", expErr: `target "fixed.example" value is set by the marker and cannot be provided`},
+		{name: "target of another action", target: "contract", value: "", expErr: `target "contract" is not allowed`},
+		{name: "unknown target", target: "other.example", value: "gold", expErr: `target "other.example" is not allowed`},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			actual, err := SelectIBCMemoAction(allowed, tc.target, tc.value)
+			if len(tc.expErr) > 0 {
+				assert.EqualError(t, err, tc.expErr, "SelectIBCMemoAction error")
+			} else {
+				assert.NoError(t, err, "SelectIBCMemoAction error")
+			}
+			assert.Equal(t, tc.exp, actual, "SelectIBCMemoAction result")
+		})
+	}
+}
@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/cometbft/cometbft/crypto"
 
@@ -35,6 +36,75 @@ var (
 
 	// MarkerParamStoreKey key for marker module's params
 	MarkerParamStoreKey = []byte{0x05}
+
+	// MintAllowancePrefix prefix for the amounts that accounts without mint access are allowed to mint
+	MintAllowancePrefix = []byte{0x06}
+
+	// DistributionPrefix prefix for distributions to marker holders
+	DistributionPrefix = []byte{0x07}
+
+	// DistributionMarkerIndexPrefix prefix for the index of distributions by marker
+	DistributionMarkerIndexPrefix = []byte{0x08}
+
+	// ActiveDistributionPrefix prefix for the index of distributions that are pending or in progress
+	ActiveDistributionPrefix = []byte{0x09}
+
+	// DistributionClaimPrefix prefix for the amounts from distributions waiting to be claimed
+	DistributionClaimPrefix = []byte{0x0A}
+
+	// LastDistributionIDKey key for the id of the last distribution scheduled
+	LastDistributionIDKey = []byte{0x0B}
+
+	// EscrowLedgerPrefix prefix for the funds of marker escrow that are earmarked for a purpose
+	EscrowLedgerPrefix = []byte{0x0C}
+
+	// EscrowWithdrawLimitPrefix prefix for the amounts accounts are allowed to withdraw from marker escrow ledgers
+	EscrowWithdrawLimitPrefix = []byte{0x0D}
+
+	// ScheduledBurnPrefix prefix for burns of marker escrow that have been scheduled
+	ScheduledBurnPrefix = []byte{0x0E}
+
+	// ScheduledBurnMarkerIndexPrefix prefix for the index of scheduled burns by marker
+	ScheduledBurnMarkerIndexPrefix = []byte{0x0F}
+
+	// ScheduledBurnHeightIndexPrefix prefix for the index of scheduled burns by burn height
+	ScheduledBurnHeightIndexPrefix = []byte{0x10}
+
+	// LastScheduledBurnIDKey key for the id of the last burn scheduled
+	LastScheduledBurnIDKey = []byte{0x11}
+
+	// SendRestrictionBypassPrefix prefix for the accounts that are exempt from some of the marker send restrictions
+	SendRestrictionBypassPrefix = []byte{0x12}
+
+	// SupplyHistoryPrefix prefix for the recorded mints and burns of markers
+	SupplyHistoryPrefix = []byte{0x13}
+
+	// AccessGrantExpirationPrefix prefix for the index of markers by the expiration times of their access grants
+	AccessGrantExpirationPrefix = []byte{0x14}
+
+	// DepositAllowPrefix prefix for the accounts that are allowed to deposit funds into markers
+	DepositAllowPrefix = []byte{0x15}
+
+	// FeeSponsorshipPrefix prefix for the fee sponsorships of markers
+	FeeSponsorshipPrefix = []byte{0x16}
+
+	// FeeSponsorshipClaimPrefix prefix for the holders that have claimed fee grants from marker fee sponsorships
+	FeeSponsorshipClaimPrefix = []byte{0x17}
+
+	// BridgeInfoPrefix prefix for the bridge infos of wrapped-asset markers
+	BridgeInfoPrefix = []byte{0x18}
+
+	// MintAttestationPrefix prefix for the attested mints of wrapped-asset markers
+	MintAttestationPrefix = []byte{0x19}
+
+	// BasketInfoPrefix prefix for the basket infos of basket markers
+	BasketInfoPrefix = []byte{0x1A}
+
+	// InProgressDistributionPrefix prefix for the index of distributions that are in progress by marker
+	InProgressDistributionPrefix = []byte{0x1B}
+
+	// IBCMemoActionConfigPrefix prefix for the ibc memo actions allowed for marker coins
+	IBCMemoActionConfigPrefix = []byte{0x1C}
 )
 
 // MarkerAddress returns the module account address for the given denomination
@@ -103,3 +173,266 @@ func GetMarkerFromNetAssetValueKey(key []byte) sdk.AccAddress {
 	markerAddr := sdk.AccAddress(key[2 : markerKeyLen+2])
 	return markerAddr
 }
+
+// MintAllowanceKeyPrefix returns key [prefix][marker address] for the mint allowances of a marker
+func MintAllowanceKeyPrefix(markerAddr sdk.AccAddress) []byte {
+	key := make([]byte, 0, len(MintAllowancePrefix)+1+len(markerAddr))
+	key = append(key, MintAllowancePrefix...)
+	return append(key, address.MustLengthPrefix(markerAddr.Bytes())...)
+}
+
+// MintAllowanceKey returns key [prefix][marker address][minter address] for a minter's allowance of a marker
+func MintAllowanceKey(markerAddr sdk.AccAddress, minterAddr sdk.AccAddress) []byte {
+	return append(MintAllowanceKeyPrefix(markerAddr), address.MustLengthPrefix(minterAddr.Bytes())...)
+}
+
+// GetMintAllowanceAddresses returns the marker and minter addresses from a MintAllowanceKey
+func GetMintAllowanceAddresses(key []byte) (markerAddr sdk.AccAddress, minterAddr sdk.AccAddress) {
+	markerKeyLen := key[1]
+	minterKeyLen := key[markerKeyLen+2]
+	markerAddr = sdk.AccAddress(key[2 : markerKeyLen+2])
+	minterAddr = sdk.AccAddress(key[markerKeyLen+3 : markerKeyLen+3+minterKeyLen])
+	return
+}
+
+// DistributionKey returns key [prefix][distribution id] for a distribution
+func DistributionKey(id uint64) []byte {
+	return append(DistributionPrefix, sdk.Uint64ToBigEndian(id)...)
+}
+
+// DistributionMarkerIndexKeyPrefix returns key [prefix][marker address] for the distributions of a marker
+func DistributionMarkerIndexKeyPrefix(markerAddr sdk.AccAddress) []byte {
+	key := make([]byte, 0, len(DistributionMarkerIndexPrefix)+1+len(markerAddr))
+	key = append(key, DistributionMarkerIndexPrefix...)
+	return append(key, address.MustLengthPrefix(markerAddr.Bytes())...)
+}
+
+// DistributionMarkerIndexKey returns key [prefix][marker address][distribution id] for a distribution of a marker
+func DistributionMarkerIndexKey(markerAddr sdk.AccAddress, id uint64) []byte {
+	return append(DistributionMarkerIndexKeyPrefix(markerAddr), sdk.Uint64ToBigEndian(id)...)
+}
+
+// ActiveDistributionKey returns key [prefix][distribution id] for a distribution that is pending or in progress
+func ActiveDistributionKey(id uint64) []byte {
+	return append(ActiveDistributionPrefix, sdk.Uint64ToBigEndian(id)...)
+}
+
+// InProgressDistributionKeyPrefix returns key [prefix][marker address] for the in progress distributions of a marker
+func InProgressDistributionKeyPrefix(markerAddr sdk.AccAddress) []byte {
+	key := make([]byte, 0, len(InProgressDistributionPrefix)+1+len(markerAddr))
+	key = append(key, InProgressDistributionPrefix...)
+	return append(key, address.MustLengthPrefix(markerAddr.Bytes())...)
+}
+
+// InProgressDistributionKey returns key [prefix][marker address][distribution id] for an in progress distribution of a marker
+func InProgressDistributionKey(markerAddr sdk.AccAddress, id uint64) []byte {
+	return append(InProgressDistributionKeyPrefix(markerAddr), sdk.Uint64ToBigEndian(id)...)
+}
+
+// DistributionClaimKeyPrefix returns key [prefix][claimant address] for the distribution claims of an account
+func DistributionClaimKeyPrefix(claimant sdk.AccAddress) []byte {
+	key := make([]byte, 0, len(DistributionClaimPrefix)+1+len(claimant))
+	key = append(key, DistributionClaimPrefix...)
+	return append(key, address.MustLengthPrefix(claimant.Bytes())...)
+}
+
+// DistributionClaimKey returns key [prefix][claimant address][distribution id] for an account's claim in a distribution
+func DistributionClaimKey(claimant sdk.AccAddress, id uint64) []byte {
+	return append(DistributionClaimKeyPrefix(claimant), sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetDistributionClaimKeyParts returns the claimant address and distribution id from a DistributionClaimKey
+func GetDistributionClaimKeyParts(key []byte) (claimant sdk.AccAddress, id uint64) {
+	claimantLen := key[1]
+	claimant = sdk.AccAddress(key[2 : claimantLen+2])
+	id = sdk.BigEndianToUint64(key[claimantLen+2:])
+	return
+}
+
+// EscrowLedgerKeyPrefix returns key [prefix][marker address] for the escrow ledgers of a marker
+func EscrowLedgerKeyPrefix(markerAddr sdk.AccAddress) []byte {
+	key := make([]byte, 0, len(EscrowLedgerPrefix)+1+len(markerAddr))
+	key = append(key, EscrowLedgerPrefix...)
+	return append(key, address.MustLengthPrefix(markerAddr.Bytes())...)
+}
+
+// EscrowLedgerKey returns key [prefix][marker address][ledger name] for one of a marker's escrow ledgers
+func EscrowLedgerKey(markerAddr sdk.AccAddress, ledger string) []byte {
+	return append(EscrowLedgerKeyPrefix(markerAddr), address.MustLengthPrefix([]byte(ledger))...)
+}
+
+// EscrowWithdrawLimitKeyPrefix returns key [prefix][marker address][ledger name] for the withdraw limits of an escrow ledger
+func EscrowWithdrawLimitKeyPrefix(markerAddr sdk.AccAddress, ledger string) []byte {
+	key := make([]byte, 0, len(EscrowWithdrawLimitPrefix)+2+len(markerAddr)+len(ledger))
+	key = append(key, EscrowWithdrawLimitPrefix...)
+	key = append(key, address.MustLengthPrefix(markerAddr.Bytes())...)
+	return append(key, address.MustLengthPrefix([]byte(ledger))...)
+}
+
+// EscrowWithdrawLimitKey returns key [prefix][marker address][ledger name][grantee address] for a grantee's withdraw
+// limit of an escrow ledger
+func EscrowWithdrawLimitKey(markerAddr sdk.AccAddress, ledger string, grantee sdk.AccAddress) []byte {
+	return append(EscrowWithdrawLimitKeyPrefix(markerAddr, ledger), address.MustLengthPrefix(grantee.Bytes())...)
+}
+
+// EscrowWithdrawLimitMarkerKeyPrefix returns key [prefix][marker address] for the withdraw limits of all escrow
+// ledgers of a marker
+func EscrowWithdrawLimitMarkerKeyPrefix(markerAddr sdk.AccAddress) []byte {
+	key := make([]byte, 0, len(EscrowWithdrawLimitPrefix)+1+len(markerAddr))
+	key = append(key, EscrowWithdrawLimitPrefix...)
+	return append(key, address.MustLengthPrefix(markerAddr.Bytes())...)
+}
+
+// ScheduledBurnKey returns key [prefix][burn id] for a scheduled burn
+func ScheduledBurnKey(id uint64) []byte {
+	return append(ScheduledBurnPrefix, sdk.Uint64ToBigEndian(id)...)
+}
+
+// ScheduledBurnMarkerIndexKeyPrefix returns key [prefix][marker address] for the scheduled burns of a marker
+func ScheduledBurnMarkerIndexKeyPrefix(markerAddr sdk.AccAddress) []byte {
+	key := make([]byte, 0, len(ScheduledBurnMarkerIndexPrefix)+1+len(markerAddr))
+	key = append(key, ScheduledBurnMarkerIndexPrefix...)
+	return append(key, address.MustLengthPrefix(markerAddr.Bytes())...)
+}
+
+// ScheduledBurnMarkerIndexKey returns key [prefix][marker address][burn id] for a scheduled burn of a marker
+func ScheduledBurnMarkerIndexKey(markerAddr sdk.AccAddress, id uint64) []byte {
+	return append(ScheduledBurnMarkerIndexKeyPrefix(markerAddr), sdk.Uint64ToBigEndian(id)...)
+}
+
+// ScheduledBurnHeightIndexKeyPrefix returns key [prefix][burn height] for the burns scheduled at a block height
+func ScheduledBurnHeightIndexKeyPrefix(burnHeight int64) []byte {
+	return append(ScheduledBurnHeightIndexPrefix, sdk.Uint64ToBigEndian(uint64(burnHeight))...)
+}
+
+// ScheduledBurnHeightIndexKey returns key [prefix][burn height][burn id] for a burn scheduled at a block height
+func ScheduledBurnHeightIndexKey(burnHeight int64, id uint64) []byte {
+	return append(ScheduledBurnHeightIndexKeyPrefix(burnHeight), sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetScheduledBurnHeightIndexKeyParts returns the burn height and burn id from a ScheduledBurnHeightIndexKey
+func GetScheduledBurnHeightIndexKeyParts(key []byte) (burnHeight int64, id uint64) {
+	burnHeight = int64(sdk.BigEndianToUint64(key[1:9]))
+	id = sdk.BigEndianToUint64(key[9:])
+	return
+}
+
+// SendRestrictionBypassKey returns key [prefix][address] for an entry in the send restriction bypass registry
+func SendRestrictionBypassKey(addr sdk.AccAddress) []byte {
+	key := make([]byte, 0, len(SendRestrictionBypassPrefix)+1+len(addr))
+	key = append(key, SendRestrictionBypassPrefix...)
+	return append(key, address.MustLengthPrefix(addr.Bytes())...)
+}
+
+// SupplyHistoryKeyPrefix returns key [prefix][marker address] for the supply history of a marker
+func SupplyHistoryKeyPrefix(markerAddr sdk.AccAddress) []byte {
+	key := make([]byte, 0, len(SupplyHistoryPrefix)+1+len(markerAddr))
+	key = append(key, SupplyHistoryPrefix...)
+	return append(key, address.MustLengthPrefix(markerAddr.Bytes())...)
+}
+
+// SupplyHistoryKey returns key [prefix][marker address][sequence] for an entry in the supply history of a marker
+func SupplyHistoryKey(markerAddr sdk.AccAddress, sequence uint64) []byte {
+	return append(SupplyHistoryKeyPrefix(markerAddr), sdk.Uint64ToBigEndian(sequence)...)
+}
+
+// AccessGrantExpirationTimePrefix returns key [prefix][expiration epoch] for the markers with access grants that
+// expire in the second of the provided time
+func AccessGrantExpirationTimePrefix(expiration time.Time) []byte {
+	key := make([]byte, 0, len(AccessGrantExpirationPrefix)+8)
+	key = append(key, AccessGrantExpirationPrefix...)
+	return append(key, sdk.Uint64ToBigEndian(uint64(expiration.Unix()))...)
+}
+
+// AccessGrantExpirationKey returns key [prefix][expiration epoch][marker address] for a marker with an access grant
+// that expires at the provided time
+func AccessGrantExpirationKey(expiration time.Time, markerAddr sdk.AccAddress) []byte {
+	return append(AccessGrantExpirationTimePrefix(expiration), address.MustLengthPrefix(markerAddr.Bytes())...)
+}
+
+// GetAccessGrantExpirationKeyMarker returns the marker address from an AccessGrantExpirationKey
+func GetAccessGrantExpirationKeyMarker(key []byte) sdk.AccAddress {
+	return sdk.AccAddress(key[len(AccessGrantExpirationPrefix)+9:])
+}
+
+// DepositAllowKeyPrefix returns key [prefix][marker address] for the deposit allow list of a marker
+func DepositAllowKeyPrefix(markerAddr sdk.AccAddress) []byte {
+	key := make([]byte, 0, len(DepositAllowPrefix)+1+len(markerAddr))
+	key = append(key, DepositAllowPrefix...)
+	return append(key, address.MustLengthPrefix(markerAddr.Bytes())...)
+}
+
+// DepositAllowKey returns key [prefix][marker address][depositor address] for an entry in a marker's deposit allow list
+func DepositAllowKey(markerAddr sdk.AccAddress, depositor sdk.AccAddress) []byte {
+	return append(DepositAllowKeyPrefix(markerAddr), address.MustLengthPrefix(depositor.Bytes())...)
+}
+
+// GetDepositAllowAddresses returns the marker and depositor addresses from a DepositAllowKey
+func GetDepositAllowAddresses(key []byte) (markerAddr sdk.AccAddress, depositor sdk.AccAddress) {
+	markerKeyLen := key[1]
+	depositorKeyLen := key[markerKeyLen+2]
+	markerAddr = sdk.AccAddress(key[2 : markerKeyLen+2])
+	depositor = sdk.AccAddress(key[markerKeyLen+3 : markerKeyLen+3+depositorKeyLen])
+	return
+}
+
+// FeeSponsorshipKey returns key [prefix][marker address] for the fee sponsorship of a marker
+func FeeSponsorshipKey(markerAddr sdk.AccAddress) []byte {
+	key := make([]byte, 0, len(FeeSponsorshipPrefix)+1+len(markerAddr))
+	key = append(key, FeeSponsorshipPrefix...)
+	return append(key, address.MustLengthPrefix(markerAddr.Bytes())...)
+}
+
+// FeeSponsorshipClaimKeyPrefix returns key [prefix][marker address] for the fee sponsorship claims of a marker
+func FeeSponsorshipClaimKeyPrefix(markerAddr sdk.AccAddress) []byte {
+	key := make([]byte, 0, len(FeeSponsorshipClaimPrefix)+1+len(markerAddr))
+	key = append(key, FeeSponsorshipClaimPrefix...)
+	return append(key, address.MustLengthPrefix(markerAddr.Bytes())...)
+}
+
+// FeeSponsorshipClaimKey returns key [prefix][marker address][holder address] for a holder's fee sponsorship claim
+func FeeSponsorshipClaimKey(markerAddr sdk.AccAddress, holder sdk.AccAddress) []byte {
+	return append(FeeSponsorshipClaimKeyPrefix(markerAddr), address.MustLengthPrefix(holder.Bytes())...)
+}
+
+// GetFeeSponsorshipClaimAddresses returns the marker and holder addresses from a FeeSponsorshipClaimKey
+func GetFeeSponsorshipClaimAddresses(key []byte) (markerAddr sdk.AccAddress, holder sdk.AccAddress) {
+	markerKeyLen := key[1]
+	holderKeyLen := key[markerKeyLen+2]
+	markerAddr = sdk.AccAddress(key[2 : markerKeyLen+2])
+	holder = sdk.AccAddress(key[markerKeyLen+3 : markerKeyLen+3+holderKeyLen])
+	return
+}
+
+// BridgeInfoKey returns key [prefix][marker address] for the bridge info of a marker
+func BridgeInfoKey(markerAddr sdk.AccAddress) []byte {
+	key := make([]byte, 0, len(BridgeInfoPrefix)+1+len(markerAddr))
+	key = append(key, BridgeInfoPrefix...)
+	return append(key, address.MustLengthPrefix(markerAddr.Bytes())...)
+}
+
+// MintAttestationKeyPrefix returns key [prefix][marker address] for the mint attestations of a marker
+func MintAttestationKeyPrefix(markerAddr sdk.AccAddress) []byte {
+	key := make([]byte, 0, len(MintAttestationPrefix)+1+len(markerAddr))
+	key = append(key, MintAttestationPrefix...)
+	return append(key, address.MustLengthPrefix(markerAddr.Bytes())...)
+}
+
+// MintAttestationKey returns key [prefix][marker address][sequence] for a mint attestation of a marker
+func MintAttestationKey(markerAddr sdk.AccAddress, sequence uint64) []byte {
+	return append(MintAttestationKeyPrefix(markerAddr), sdk.Uint64ToBigEndian(sequence)...)
+}
+
+// BasketInfoKey returns key [prefix][marker address] for the basket info of a marker
+func BasketInfoKey(markerAddr sdk.AccAddress) []byte {
+	key := make([]byte, 0, len(BasketInfoPrefix)+1+len(markerAddr))
+	key = append(key, BasketInfoPrefix...)
+	return append(key, address.MustLengthPrefix(markerAddr.Bytes())...)
+}
+
+// IBCMemoActionConfigKey returns key [prefix][marker address] for the ibc memo action config of a marker
+func IBCMemoActionConfigKey(markerAddr sdk.AccAddress) []byte {
+	key := make([]byte, 0, len(IBCMemoActionConfigPrefix)+1+len(markerAddr))
+	key = append(key, IBCMemoActionConfigPrefix...)
+	return append(key, address.MustLengthPrefix(markerAddr.Bytes())...)
+}
@@ -71,3 +71,104 @@ func TestDenySendMarkerPrefix(t *testing.T) {
 	assert.Equal(t, uint8(3), denyKey[0], "should have correct prefix for send deny")
 	assert.Equal(t, denyKey[2:], addr.Bytes(), "should have marker address in iterable prefix")
 }
+
+func TestMintAllowanceKey(t *testing.T) {
+	addr, err := MarkerAddress("nhash")
+	require.NoError(t, err, "MarkerAddress(nhash)")
+	minter := sdk.AccAddress("minter______________")
+	key := MintAllowanceKey(addr, minter)
+	assert.Equal(t, uint8(6), key[0], "should have correct prefix for mint allowance key")
+	assert.Equal(t, MintAllowanceKeyPrefix(addr), key[:len(addr)+2], "should start with the marker's mint allowance prefix")
+	mAddr, minterAddr := GetMintAllowanceAddresses(key)
+	assert.Equal(t, addr, mAddr, "marker address")
+	assert.Equal(t, minter, minterAddr, "minter address")
+}
+
+func TestDistributionClaimKey(t *testing.T) {
+	claimant := sdk.AccAddress("claimant____________")
+	key := DistributionClaimKey(claimant, 258)
+	assert.Equal(t, uint8(0x0A), key[0], "should have correct prefix for distribution claim key")
+	assert.Equal(t, DistributionClaimKeyPrefix(claimant), key[:len(claimant)+2], "should start with the claimant's prefix")
+	claimantAddr, id := GetDistributionClaimKeyParts(key)
+	assert.Equal(t, claimant, claimantAddr, "claimant address")
+	assert.Equal(t, uint64(258), id, "distribution id")
+}
+
+func TestEscrowWithdrawLimitKey(t *testing.T) {
+	addr, err := MarkerAddress("nhash")
+	require.NoError(t, err, "MarkerAddress(nhash)")
+	grantee := sdk.AccAddress("grantee_____________")
+	key := EscrowWithdrawLimitKey(addr, "reserves", grantee)
+	assert.Equal(t, uint8(0x0D), key[0], "should have correct prefix for escrow withdraw limit key")
+	assert.Equal(t, EscrowWithdrawLimitMarkerKeyPrefix(addr), key[:len(addr)+2], "should start with the marker's prefix")
+	assert.Equal(t, EscrowWithdrawLimitKeyPrefix(addr, "reserves"), key[:len(addr)+3+len("reserves")], "should start with the ledger's prefix")
+	assert.Equal(t, grantee.Bytes(), key[len(addr)+4+len("reserves"):], "should end with the grantee address")
+	assert.NotEqual(t, EscrowLedgerKey(addr, "reserves")[0], key[0], "escrow ledger key prefix")
+}
+
+func TestScheduledBurnHeightIndexKey(t *testing.T) {
+	key := ScheduledBurnHeightIndexKey(513, 258)
+	assert.Equal(t, uint8(0x10), key[0], "should have correct prefix for scheduled burn height index key")
+	assert.Equal(t, ScheduledBurnHeightIndexKeyPrefix(513), key[:9], "should start with the burn height's prefix")
+	burnHeight, id := GetScheduledBurnHeightIndexKeyParts(key)
+	assert.Equal(t, int64(513), burnHeight, "burn height")
+	assert.Equal(t, uint64(258), id, "burn id")
+}
+
+func TestSupplyHistoryKey(t *testing.T) {
+	addr := MustGetMarkerAddress("nhash")
+	key := SupplyHistoryKey(addr, 258)
+	assert.Equal(t, uint8(0x13), key[0], "should have correct prefix for supply history key")
+	assert.Equal(t, SupplyHistoryKeyPrefix(addr), key[:len(addr)+2], "should start with the marker's prefix")
+	assert.Equal(t, uint64(258), sdk.BigEndianToUint64(key[len(addr)+2:]), "should end with the sequence")
+}
+
+func TestDepositAllowKey(t *testing.T) {
+	addr := MustGetMarkerAddress("nhash")
+	depositor := sdk.AccAddress("depositor___________")
+	key := DepositAllowKey(addr, depositor)
+	assert.Equal(t, uint8(0x15), key[0], "should have correct prefix for deposit allow key")
+	assert.Equal(t, DepositAllowKeyPrefix(addr), key[:len(addr)+2], "should start with the marker's deposit allow prefix")
+	mAddr, depositorAddr := GetDepositAllowAddresses(key)
+	assert.Equal(t, addr, mAddr, "marker address")
+	assert.Equal(t, depositor, depositorAddr, "depositor address")
+}
+
+func TestFeeSponsorshipClaimKey(t *testing.T) {
+	addr := MustGetMarkerAddress("nhash")
+	holder := sdk.AccAddress("holder______________")
+	sponsorshipKey := FeeSponsorshipKey(addr)
+	assert.Equal(t, uint8(0x16), sponsorshipKey[0], "should have correct prefix for fee sponsorship key")
+	assert.Equal(t, addr, sdk.AccAddress(sponsorshipKey[2:]), "fee sponsorship key marker address")
+	key := FeeSponsorshipClaimKey(addr, holder)
+	assert.Equal(t, uint8(0x17), key[0], "should have correct prefix for fee sponsorship claim key")
+	assert.Equal(t, FeeSponsorshipClaimKeyPrefix(addr), key[:len(addr)+2], "should start with the marker's fee sponsorship claim prefix")
+	mAddr, holderAddr := GetFeeSponsorshipClaimAddresses(key)
+	assert.Equal(t, addr, mAddr, "marker address")
+	assert.Equal(t, holder, holderAddr, "holder address")
+}
+
+func TestBridgeKeys(t *testing.T) {
+	addr := MustGetMarkerAddress("nhash")
+	infoKey := BridgeInfoKey(addr)
+	assert.Equal(t, uint8(0x18), infoKey[0], "should have correct prefix for bridge info key")
+	assert.Equal(t, addr, sdk.AccAddress(infoKey[2:]), "bridge info key marker address")
+	key := MintAttestationKey(addr, 7)
+	assert.Equal(t, uint8(0x19), key[0], "should have correct prefix for mint attestation key")
+	assert.Equal(t, MintAttestationKeyPrefix(addr), key[:len(addr)+2], "should start with the marker's mint attestation prefix")
+	assert.Equal(t, uint64(7), sdk.BigEndianToUint64(key[len(addr)+2:]), "should end with the sequence")
+}
+
+func TestBasketInfoKey(t *testing.T) {
+	addr := MustGetMarkerAddress("nhash")
+	key := BasketInfoKey(addr)
+	assert.Equal(t, uint8(0x1A), key[0], "should have correct prefix for basket info key")
+	assert.Equal(t, addr, sdk.AccAddress(key[2:]), "basket info key marker address")
+}
+
+func TestIBCMemoActionConfigKey(t *testing.T) {
+	addr := MustGetMarkerAddress("nhash")
+	key := IBCMemoActionConfigKey(addr)
+	assert.Equal(t, uint8(0x1C), key[0], "should have correct prefix for ibc memo action config key")
+	assert.Equal(t, addr, sdk.AccAddress(key[2:]), "ibc memo action config key marker address")
+}
@@ -57,6 +57,9 @@ type MarkerAccountI interface {
 
 	GetRequiredAttributes() []string
 	SetRequiredAttributes([]string)
+
+	GetRequiredSenderAttributes() []string
+	SetRequiredSenderAttributes([]string)
 }
 
 // NewEmptyMarkerAccount creates a new empty marker account in a Proposed state
@@ -198,6 +201,18 @@ func (ma *MarkerAccount) AddressListForPermission(role Access) []sdk.AccAddress
 	return addressList
 }
 
+// addressListForLastingPermission returns a list of all addresses with the provided rule
+// from grants that do not expire.
+func (ma *MarkerAccount) addressListForLastingPermission(role Access) []sdk.AccAddress {
+	var addressList []sdk.AccAddress
+	for _, g := range ma.AccessControl {
+		if g.Expiration == nil && g.HasAccess(role) {
+			addressList = append(addressList, g.GetAddress())
+		}
+	}
+	return addressList
+}
+
 // Validate performs minimal sanity checking over the current MarkerAccount instance
 func (ma MarkerAccount) Validate() error {
 	if !ValidMarkerStatus(ma.Status) {
@@ -207,10 +222,11 @@ func (ma MarkerAccount) Validate() error {
 	if ma.Supply.IsNegative() {
 		return fmt.Errorf("total supply must be greater than or equal to zero")
 	}
-	if ma.Status < StatusActive && ma.Manager == "" && len(ma.AddressListForPermission(Access_Admin)) == 0 {
+	// Grants that expire don't count toward the required permissions so that removing them can't invalidate a marker.
+	if ma.Status < StatusActive && ma.Manager == "" && len(ma.addressListForLastingPermission(Access_Admin)) == 0 {
 		return fmt.Errorf("a manager is required if there are no accounts with ACCESS_ADMIN and marker is not ACTIVE")
 	}
-	if ma.Status == StatusFinalized && len(ma.AddressListForPermission(Access_Mint)) == 0 && ma.Supply.IsZero() {
+	if ma.Status == StatusFinalized && len(ma.addressListForLastingPermission(Access_Mint)) == 0 && ma.Supply.IsZero() {
 		return fmt.Errorf("cannot create a marker with zero total supply and no authorization for minting more")
 	}
 	// unlikely as this is set using a Coin which prohibits this value.
@@ -268,14 +284,14 @@ func ValidateGrantsForMarkerType(markerType MarkerType, grants ...AccessGrant) e
 			switch markerType {
 			case MarkerType_Coin:
 				{
-					if !access.IsOneOf(Access_Admin, Access_Burn, Access_Delete, Access_Deposit, Access_Mint, Access_Withdraw) {
+					if !access.IsOneOf(Access_Admin, Access_Burn, Access_Delete, Access_Deposit, Access_Mint, Access_Withdraw, Access_Delegate) {
 						return fmt.Errorf("%v is not supported for marker type %v", access, markerType)
 					}
 				}
 			// Restricted Coins also support Transfer access
 			case MarkerType_RestrictedCoin:
 				{
-					if !access.IsOneOf(Access_Admin, Access_Burn, Access_Delete, Access_Deposit, Access_Mint, Access_Withdraw, Access_Transfer, Access_ForceTransfer) {
+					if !access.IsOneOf(Access_Admin, Access_Burn, Access_Delete, Access_Deposit, Access_Mint, Access_Withdraw, Access_Delegate, Access_Transfer, Access_ForceTransfer) {
 						return fmt.Errorf("%v is not supported for marker type %v", access, markerType)
 					}
 				}
@@ -305,6 +321,16 @@ func (ma *MarkerAccount) SetRequiredAttributes(requiredAttributes []string) {
 	ma.RequiredAttributes = requiredAttributes
 }
 
+// GetRequiredSenderAttributes returns the attributes that a sender must have to send this marker's coins.
+func (ma *MarkerAccount) GetRequiredSenderAttributes() []string {
+	return ma.RequiredSenderAttributes
+}
+
+// SetRequiredSenderAttributes sets the attributes that a sender must have to send this marker's coins.
+func (ma *MarkerAccount) SetRequiredSenderAttributes(requiredSenderAttributes []string) {
+	ma.RequiredSenderAttributes = requiredSenderAttributes
+}
+
 // GetPubKey implements authtypes.Account (but there are no public keys associated with the account for signing)
 func (ma MarkerAccount) GetPubKey() cryptotypes.PubKey {
 	return nil
@@ -410,8 +436,10 @@ func (ma *MarkerAccount) GrantAccess(access AccessGrantI) error {
 	if err := ma.RevokeAccess(access.GetAddress()); err != nil {
 		return err
 	}
-	// Append the new record
-	ma.AccessControl = append(ma.AccessControl, *NewAccessGrant(access.GetAddress(), access.GetAccessList()))
+	// Append the new record, which expires when the provided grant does.
+	grant := NewAccessGrant(access.GetAddress(), access.GetAccessList())
+	grant.Expiration = access.GetExpiration()
+	ma.AccessControl = append(ma.AccessControl, *grant)
 	return nil
 }
 
@@ -437,6 +465,40 @@ func (ma *MarkerAccount) GetAccessList() []AccessGrant {
 	return ma.AccessControl
 }
 
+// ChangeMarkerType changes this marker to the provided type (either coin or restricted coin).
+// When changing to a coin marker, the things that only apply to restricted markers are removed: the transfer and
+// force transfer permissions are taken out of the access grants (and grants left without any permissions are dropped),
+// the required (and required sender) attributes are cleared, and forced transfers are turned off.
+func (ma *MarkerAccount) ChangeMarkerType(newType MarkerType) error {
+	if newType != MarkerType_Coin && newType != MarkerType_RestrictedCoin {
+		return fmt.Errorf("cannot change marker type to %s", newType)
+	}
+	if ma.MarkerType == newType {
+		return fmt.Errorf("marker %s is already type %s", ma.Denom, newType)
+	}
+
+	ma.MarkerType = newType
+	if newType == MarkerType_Coin {
+		var accessControl []AccessGrant
+		for _, grant := range ma.AccessControl {
+			var perms AccessList
+			for _, access := range grant.Permissions {
+				if !access.IsOneOf(Access_Transfer, Access_ForceTransfer) {
+					perms = append(perms, access)
+				}
+			}
+			if len(perms) > 0 {
+				accessControl = append(accessControl, AccessGrant{Address: grant.Address, Permissions: perms})
+			}
+		}
+		ma.AccessControl = accessControl
+		ma.RequiredAttributes = nil
+		ma.RequiredSenderAttributes = nil
+		ma.AllowForcedTransfer = false
+	}
+	return nil
+}
+
 // MarkerTypeFromString returns a MarkerType from a string. It returns an error
 // if the string is invalid.
 func MarkerTypeFromString(str string) (MarkerType, error) {
@@ -515,3 +577,26 @@ func (mnav *NetAssetValue) Validate() error {
 
 	return nil
 }
+
+// NewMintAllowance returns a new instance of MintAllowance
+func NewMintAllowance(denom string, minter sdk.AccAddress, remaining sdkmath.Int) MintAllowance {
+	return MintAllowance{
+		Denom:     denom,
+		Minter:    minter.String(),
+		Remaining: remaining,
+	}
+}
+
+// Validate returns error if MintAllowance is not in a valid state
+func (ma MintAllowance) Validate() error {
+	if err := sdk.ValidateDenom(ma.Denom); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(ma.Minter); err != nil {
+		return fmt.Errorf("invalid minter: %w", err)
+	}
+	if ma.Remaining.IsNil() || !ma.Remaining.IsPositive() {
+		return fmt.Errorf("mint allowance for %s to %s must be positive", ma.Denom, ma.Minter)
+	}
+	return nil
+}
@@ -3,6 +3,7 @@ package types
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -108,6 +109,19 @@ func TestNewMarkerValidate(t *testing.T) {
 			NewMarkerAccount(baseAcc, sdk.NewInt64Coin("test", 0), manager, nil, StatusFinalized, MarkerType_Coin, true, true, false, []string{}),
 			fmt.Errorf("cannot create a marker with zero total supply and no authorization for minting more"),
 		},
+		{
+			"insufficient supply with expiring mint access",
+			NewMarkerAccount(baseAcc, sdk.NewInt64Coin("test", 0), manager,
+				[]AccessGrant{*NewExpiringAccessGrant(MustGetMarkerAddress("foo"), []Access{Access_Mint}, time.Unix(2000000000, 0))},
+				StatusFinalized, MarkerType_Coin, true, true, false, []string{}),
+			fmt.Errorf("cannot create a marker with zero total supply and no authorization for minting more"),
+		},
+		{
+			"no manager with expiring admin access",
+			NewEmptyMarkerAccount("test", "",
+				[]AccessGrant{*NewExpiringAccessGrant(MustGetMarkerAddress("foo"), []Access{Access_Admin}, time.Unix(2000000000, 0))}),
+			fmt.Errorf("a manager is required if there are no accounts with ACCESS_ADMIN and marker is not ACTIVE"),
+		},
 		{
 			"invalid status",
 			NewMarkerAccount(baseAcc, sdk.NewInt64Coin("test", 0), manager, nil, StatusUndefined, MarkerType_Coin, true, false, false, []string{}),
@@ -683,3 +697,75 @@ func TestValidateAtLeastOneAddrHasAccess(t *testing.T) {
 		})
 	}
 }
+
+func TestChangeMarkerType(t *testing.T) {
+	addr1 := sdk.AccAddress("addr1_______________").String()
+	addr2 := sdk.AccAddress("addr2_______________").String()
+	newMarker := func(markerType MarkerType) *MarkerAccount {
+		return &MarkerAccount{
+			Denom:      "testcoin",
+			MarkerType: markerType,
+			AccessControl: []AccessGrant{
+				{Address: addr1, Permissions: AccessList{Access_Admin, Access_Transfer, Access_Mint}},
+				{Address: addr2, Permissions: AccessList{Access_Transfer, Access_ForceTransfer}},
+			},
+			AllowForcedTransfer: true,
+			RequiredAttributes:  []string{"kyc.provenance.io"},
+		}
+	}
+
+	tests := []struct {
+		name    string
+		marker  *MarkerAccount
+		newType MarkerType
+		exp     *MarkerAccount
+		expErr  string
+	}{
+		{
+			name:    "unknown type",
+			marker:  newMarker(MarkerType_Coin),
+			newType: MarkerType_Unknown,
+			expErr:  "cannot change marker type to MARKER_TYPE_UNSPECIFIED",
+		},
+		{
+			name:    "already coin",
+			marker:  newMarker(MarkerType_Coin),
+			newType: MarkerType_Coin,
+			expErr:  "marker testcoin is already type MARKER_TYPE_COIN",
+		},
+		{
+			name:    "already restricted",
+			marker:  newMarker(MarkerType_RestrictedCoin),
+			newType: MarkerType_RestrictedCoin,
+			expErr:  "marker testcoin is already type MARKER_TYPE_RESTRICTED",
+		},
+		{
+			name:    "coin to restricted",
+			marker:  newMarker(MarkerType_Coin),
+			newType: MarkerType_RestrictedCoin,
+			exp:     newMarker(MarkerType_RestrictedCoin),
+		},
+		{
+			name:    "restricted to coin",
+			marker:  newMarker(MarkerType_RestrictedCoin),
+			newType: MarkerType_Coin,
+			exp: &MarkerAccount{
+				Denom:         "testcoin",
+				MarkerType:    MarkerType_Coin,
+				AccessControl: []AccessGrant{{Address: addr1, Permissions: AccessList{Access_Admin, Access_Mint}}},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.marker.ChangeMarkerType(tc.newType)
+			if len(tc.expErr) > 0 {
+				require.EqualError(t, err, tc.expErr, "ChangeMarkerType")
+				return
+			}
+			require.NoError(t, err, "ChangeMarkerType")
+			assert.Equal(t, tc.exp, tc.marker, "marker after ChangeMarkerType")
+		})
+	}
+}
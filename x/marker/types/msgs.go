@@ -3,6 +3,7 @@ package types
 import (
 	"errors"
 	"fmt"
+	"strings"
 
 	sdkmath "cosmossdk.io/math"
 	feegranttypes "cosmossdk.io/x/feegrant"
@@ -39,13 +40,43 @@ var AllRequestMsgs = []sdk.Msg{
 	(*MsgUpdateForcedTransferRequest)(nil),
 	(*MsgSetAccountDataRequest)(nil),
 	(*MsgUpdateSendDenyListRequest)(nil),
+	(*MsgUpdateDepositAllowListRequest)(nil),
+	(*MsgSetFeeSponsorshipRequest)(nil),
+	(*MsgRemoveFeeSponsorshipRequest)(nil),
+	(*MsgClaimFeeSponsorshipRequest)(nil),
+	(*MsgReleaseFeeSponsorshipHoldRequest)(nil),
+	(*MsgSetBridgeInfoRequest)(nil),
+	(*MsgAttestedMintRequest)(nil),
+	(*MsgSetBasketInfoRequest)(nil),
+	(*MsgSetIBCMemoActionConfigRequest)(nil),
+	(*MsgBasketDepositRequest)(nil),
+	(*MsgBasketWithdrawRequest)(nil),
 	(*MsgAddNetAssetValuesRequest)(nil),
+	(*MsgBindMarkerNameRequest)(nil),
+	(*MsgDeleteMarkerNameRequest)(nil),
+	(*MsgDelegateEscrowRequest)(nil),
+	(*MsgUndelegateEscrowRequest)(nil),
+	(*MsgCollectEscrowRewardsRequest)(nil),
+	(*MsgSetMintAllowanceRequest)(nil),
+	(*MsgMintFromAllowanceRequest)(nil),
+	(*MsgScheduleDistributionRequest)(nil),
+	(*MsgCancelDistributionRequest)(nil),
+	(*MsgClaimDistributionRequest)(nil),
+	(*MsgAllocateEscrowRequest)(nil),
+	(*MsgReleaseEscrowRequest)(nil),
+	(*MsgSetEscrowWithdrawLimitRequest)(nil),
+	(*MsgWithdrawFromEscrowRequest)(nil),
+	(*MsgScheduleBurnRequest)(nil),
+	(*MsgCancelScheduledBurnRequest)(nil),
+	(*MsgExchangeRequest)(nil),
 	(*MsgSetAdministratorProposalRequest)(nil),
 	(*MsgRemoveAdministratorProposalRequest)(nil),
 	(*MsgChangeStatusProposalRequest)(nil),
 	(*MsgWithdrawEscrowProposalRequest)(nil),
 	(*MsgSetDenomMetadataProposalRequest)(nil),
 	(*MsgUpdateParamsRequest)(nil),
+	(*MsgUpdateSendRestrictionBypassesRequest)(nil),
+	(*MsgChangeMarkerTypeProposalRequest)(nil),
 }
 
 func NewMsgFinalizeRequest(denom string, admin sdk.AccAddress) *MsgFinalizeRequest {
@@ -481,25 +512,36 @@ func (msg MsgUpdateRequiredAttributesRequest) ValidateBasic() error {
 	if err := sdk.ValidateDenom(msg.Denom); err != nil {
 		return err
 	}
-	if len(msg.AddRequiredAttributes) == 0 && len(msg.RemoveRequiredAttributes) == 0 {
+	if len(msg.AddRequiredAttributes) == 0 && len(msg.RemoveRequiredAttributes) == 0 &&
+		len(msg.AddRequiredSenderAttributes) == 0 && len(msg.RemoveRequiredSenderAttributes) == 0 {
 		return fmt.Errorf("both add and remove lists cannot be empty")
 	}
 
-	combined := []string{}
-	combined = append(combined, msg.AddRequiredAttributes...)
-	combined = append(combined, msg.RemoveRequiredAttributes...)
-	seen := make(map[string]bool)
-	for _, str := range combined {
-		if seen[str] {
-			return fmt.Errorf("required attribute lists contain duplicate entries")
-		}
-		seen[str] = true
+	if hasDuplicates(msg.AddRequiredAttributes, msg.RemoveRequiredAttributes) {
+		return fmt.Errorf("required attribute lists contain duplicate entries")
+	}
+	if hasDuplicates(msg.AddRequiredSenderAttributes, msg.RemoveRequiredSenderAttributes) {
+		return fmt.Errorf("required sender attribute lists contain duplicate entries")
 	}
 
 	_, err := sdk.AccAddressFromBech32(msg.TransferAuthority)
 	return err
 }
 
+// hasDuplicates returns true if any string appears more than once across the provided lists.
+func hasDuplicates(lists ...[]string) bool {
+	seen := make(map[string]bool)
+	for _, list := range lists {
+		for _, str := range list {
+			if seen[str] {
+				return true
+			}
+			seen[str] = true
+		}
+	}
+	return false
+}
+
 func NewMsgUpdateForcedTransferRequest(denom string, allowForcedTransfer bool, authority sdk.AccAddress) *MsgUpdateForcedTransferRequest {
 	return &MsgUpdateForcedTransferRequest{
 		Denom:               denom,
@@ -571,6 +613,225 @@ func (msg MsgUpdateSendDenyListRequest) ValidateBasic() error {
 	return err
 }
 
+func NewMsgUpdateDepositAllowListRequest(denom string, administrator string, removeAllowedAddresses, addAllowedAddresses []string) *MsgUpdateDepositAllowListRequest {
+	return &MsgUpdateDepositAllowListRequest{
+		Denom:                  denom,
+		RemoveAllowedAddresses: removeAllowedAddresses,
+		AddAllowedAddresses:    addAllowedAddresses,
+		Administrator:          administrator,
+	}
+}
+
+func (msg MsgUpdateDepositAllowListRequest) ValidateBasic() error {
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return err
+	}
+	if len(msg.AddAllowedAddresses) == 0 && len(msg.RemoveAllowedAddresses) == 0 {
+		return fmt.Errorf("both add and remove lists cannot be empty")
+	}
+
+	seen := make(map[string]bool)
+	for _, list := range [][]string{msg.AddAllowedAddresses, msg.RemoveAllowedAddresses} {
+		for _, addr := range list {
+			if _, err := sdk.AccAddressFromBech32(addr); err != nil {
+				return fmt.Errorf("invalid allowed address %q: %w", addr, err)
+			}
+			if seen[addr] {
+				return fmt.Errorf("allowed address lists contain duplicate entry %q", addr)
+			}
+			seen[addr] = true
+		}
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Administrator); err != nil {
+		return fmt.Errorf("invalid administrator: %w", err)
+	}
+	return nil
+}
+
+func NewMsgSetFeeSponsorshipRequest(sponsorship FeeSponsorship, administrator string) *MsgSetFeeSponsorshipRequest {
+	return &MsgSetFeeSponsorshipRequest{
+		Sponsorship:   sponsorship,
+		Administrator: administrator,
+	}
+}
+
+func (msg MsgSetFeeSponsorshipRequest) ValidateBasic() error {
+	if err := msg.Sponsorship.Validate(); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Administrator); err != nil {
+		return fmt.Errorf("invalid administrator: %w", err)
+	}
+	return nil
+}
+
+func NewMsgRemoveFeeSponsorshipRequest(denom string, administrator string) *MsgRemoveFeeSponsorshipRequest {
+	return &MsgRemoveFeeSponsorshipRequest{
+		Denom:         denom,
+		Administrator: administrator,
+	}
+}
+
+func (msg MsgRemoveFeeSponsorshipRequest) ValidateBasic() error {
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Administrator); err != nil {
+		return fmt.Errorf("invalid administrator: %w", err)
+	}
+	return nil
+}
+
+func NewMsgClaimFeeSponsorshipRequest(denom string, holder string) *MsgClaimFeeSponsorshipRequest {
+	return &MsgClaimFeeSponsorshipRequest{
+		Denom:  denom,
+		Holder: holder,
+	}
+}
+
+func (msg MsgClaimFeeSponsorshipRequest) ValidateBasic() error {
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Holder); err != nil {
+		return fmt.Errorf("invalid holder: %w", err)
+	}
+	return nil
+}
+
+func NewMsgReleaseFeeSponsorshipHoldRequest(denom string, holder string) *MsgReleaseFeeSponsorshipHoldRequest {
+	return &MsgReleaseFeeSponsorshipHoldRequest{
+		Denom:  denom,
+		Holder: holder,
+	}
+}
+
+func (msg MsgReleaseFeeSponsorshipHoldRequest) ValidateBasic() error {
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Holder); err != nil {
+		return fmt.Errorf("invalid holder: %w", err)
+	}
+	return nil
+}
+
+func NewMsgSetBridgeInfoRequest(info BridgeInfo, administrator string) *MsgSetBridgeInfoRequest {
+	return &MsgSetBridgeInfoRequest{
+		BridgeInfo:    info,
+		Administrator: administrator,
+	}
+}
+
+func (msg MsgSetBridgeInfoRequest) ValidateBasic() error {
+	if err := msg.BridgeInfo.Validate(); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Administrator); err != nil {
+		return fmt.Errorf("invalid administrator: %w", err)
+	}
+	return nil
+}
+
+func NewMsgAttestedMintRequest(amount sdk.Coin, reference string, administrator string) *MsgAttestedMintRequest {
+	return &MsgAttestedMintRequest{
+		Amount:        amount,
+		Reference:     reference,
+		Administrator: administrator,
+	}
+}
+
+func (msg MsgAttestedMintRequest) ValidateBasic() error {
+	if err := msg.Amount.Validate(); err != nil {
+		return err
+	}
+	if !msg.Amount.IsPositive() {
+		return fmt.Errorf("invalid amount %s: must be positive", msg.Amount)
+	}
+	if err := ValidateMintAttestationReference(msg.Reference); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Administrator); err != nil {
+		return fmt.Errorf("invalid administrator: %w", err)
+	}
+	return nil
+}
+
+func NewMsgSetBasketInfoRequest(info BasketInfo, administrator string) *MsgSetBasketInfoRequest {
+	return &MsgSetBasketInfoRequest{
+		BasketInfo:    info,
+		Administrator: administrator,
+	}
+}
+
+func (msg MsgSetBasketInfoRequest) ValidateBasic() error {
+	if err := msg.BasketInfo.Validate(); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Administrator); err != nil {
+		return fmt.Errorf("invalid administrator: %w", err)
+	}
+	return nil
+}
+
+func NewMsgSetIBCMemoActionConfigRequest(config IBCMemoActionConfig, administrator string) *MsgSetIBCMemoActionConfigRequest {
+	return &MsgSetIBCMemoActionConfigRequest{
+		Config:        config,
+		Administrator: administrator,
+	}
+}
+
+func (msg MsgSetIBCMemoActionConfigRequest) ValidateBasic() error {
+	if err := msg.Config.Validate(); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Administrator); err != nil {
+		return fmt.Errorf("invalid administrator: %w", err)
+	}
+	return nil
+}
+
+func NewMsgBasketDepositRequest(amount sdk.Coin, depositor string) *MsgBasketDepositRequest {
+	return &MsgBasketDepositRequest{
+		Amount:    amount,
+		Depositor: depositor,
+	}
+}
+
+func (msg MsgBasketDepositRequest) ValidateBasic() error {
+	if err := msg.Amount.Validate(); err != nil {
+		return err
+	}
+	if !msg.Amount.IsPositive() {
+		return fmt.Errorf("invalid amount %s: must be positive", msg.Amount)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Depositor); err != nil {
+		return fmt.Errorf("invalid depositor: %w", err)
+	}
+	return nil
+}
+
+func NewMsgBasketWithdrawRequest(amount sdk.Coin, holder string) *MsgBasketWithdrawRequest {
+	return &MsgBasketWithdrawRequest{
+		Amount: amount,
+		Holder: holder,
+	}
+}
+
+func (msg MsgBasketWithdrawRequest) ValidateBasic() error {
+	if err := msg.Amount.Validate(); err != nil {
+		return err
+	}
+	if !msg.Amount.IsPositive() {
+		return fmt.Errorf("invalid amount %s: must be positive", msg.Amount)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Holder); err != nil {
+		return fmt.Errorf("invalid holder: %w", err)
+	}
+	return nil
+}
+
 func NewMsgAddNetAssetValuesRequest(denom, administrator string, netAssetValues []NetAssetValue) *MsgAddNetAssetValuesRequest {
 	return &MsgAddNetAssetValuesRequest{
 		Denom:          denom,
@@ -608,6 +869,429 @@ func (msg MsgAddNetAssetValuesRequest) ValidateBasic() error {
 	return err
 }
 
+func NewMsgBindMarkerNameRequest(denom, parent, name string, address sdk.AccAddress, restricted bool, administrator string) *MsgBindMarkerNameRequest {
+	return &MsgBindMarkerNameRequest{
+		Denom:         denom,
+		Parent:        parent,
+		Name:          name,
+		Address:       address.String(),
+		Restricted:    restricted,
+		Administrator: administrator,
+	}
+}
+
+func (msg MsgBindMarkerNameRequest) ValidateBasic() error {
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return err
+	}
+	if strings.TrimSpace(msg.Parent) == "" {
+		return errors.New("parent name cannot be empty")
+	}
+	if strings.TrimSpace(msg.Name) == "" {
+		return errors.New("name cannot be empty")
+	}
+	if strings.Contains(msg.Name, ".") {
+		return errors.New("invalid name: \".\" is reserved")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Address); err != nil {
+		return fmt.Errorf("invalid address: %w", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Administrator); err != nil {
+		return fmt.Errorf("invalid administrator: %w", err)
+	}
+	return nil
+}
+
+func NewMsgDeleteMarkerNameRequest(denom, name string, administrator string) *MsgDeleteMarkerNameRequest {
+	return &MsgDeleteMarkerNameRequest{
+		Denom:         denom,
+		Name:          name,
+		Administrator: administrator,
+	}
+}
+
+func (msg MsgDeleteMarkerNameRequest) ValidateBasic() error {
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return err
+	}
+	if strings.TrimSpace(msg.Name) == "" {
+		return errors.New("name cannot be empty")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Administrator); err != nil {
+		return fmt.Errorf("invalid administrator: %w", err)
+	}
+	return nil
+}
+
+func NewMsgDelegateEscrowRequest(denom string, validator sdk.ValAddress, amount sdk.Coin, administrator string) *MsgDelegateEscrowRequest {
+	return &MsgDelegateEscrowRequest{
+		Denom:            denom,
+		ValidatorAddress: validator.String(),
+		Amount:           amount,
+		Administrator:    administrator,
+	}
+}
+
+func (msg MsgDelegateEscrowRequest) ValidateBasic() error {
+	return validateEscrowStakingMsg(msg.Denom, msg.ValidatorAddress, &msg.Amount, msg.Administrator)
+}
+
+func NewMsgUndelegateEscrowRequest(denom string, validator sdk.ValAddress, amount sdk.Coin, administrator string) *MsgUndelegateEscrowRequest {
+	return &MsgUndelegateEscrowRequest{
+		Denom:            denom,
+		ValidatorAddress: validator.String(),
+		Amount:           amount,
+		Administrator:    administrator,
+	}
+}
+
+func (msg MsgUndelegateEscrowRequest) ValidateBasic() error {
+	return validateEscrowStakingMsg(msg.Denom, msg.ValidatorAddress, &msg.Amount, msg.Administrator)
+}
+
+func NewMsgCollectEscrowRewardsRequest(denom string, validator sdk.ValAddress, administrator string) *MsgCollectEscrowRewardsRequest {
+	return &MsgCollectEscrowRewardsRequest{
+		Denom:            denom,
+		ValidatorAddress: validator.String(),
+		Administrator:    administrator,
+	}
+}
+
+func (msg MsgCollectEscrowRewardsRequest) ValidateBasic() error {
+	return validateEscrowStakingMsg(msg.Denom, msg.ValidatorAddress, nil, msg.Administrator)
+}
+
+func NewMsgSetMintAllowanceRequest(amount sdk.Coin, minter sdk.AccAddress, administrator string) *MsgSetMintAllowanceRequest {
+	return &MsgSetMintAllowanceRequest{
+		Amount:        amount,
+		Minter:        minter.String(),
+		Administrator: administrator,
+	}
+}
+
+func (msg MsgSetMintAllowanceRequest) ValidateBasic() error {
+	if err := msg.Amount.Validate(); err != nil {
+		return fmt.Errorf("invalid amount: %w", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Minter); err != nil {
+		return fmt.Errorf("invalid minter: %w", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Administrator); err != nil {
+		return fmt.Errorf("invalid administrator: %w", err)
+	}
+	return nil
+}
+
+func NewMsgMintFromAllowanceRequest(amount sdk.Coin, minter sdk.AccAddress) *MsgMintFromAllowanceRequest {
+	return &MsgMintFromAllowanceRequest{
+		Amount: amount,
+		Minter: minter.String(),
+	}
+}
+
+func (msg MsgMintFromAllowanceRequest) ValidateBasic() error {
+	if err := msg.Amount.Validate(); err != nil {
+		return fmt.Errorf("invalid amount: %w", err)
+	}
+	if !msg.Amount.IsPositive() {
+		return fmt.Errorf("invalid amount: %s must be positive", msg.Amount)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Minter); err != nil {
+		return fmt.Errorf("invalid minter: %w", err)
+	}
+	return nil
+}
+
+func NewMsgScheduleDistributionRequest(denom string, amount sdk.Coin, startHeight int64, batchSize uint32, minPayout *sdk.Coin, administrator sdk.AccAddress) *MsgScheduleDistributionRequest {
+	return &MsgScheduleDistributionRequest{
+		Denom:         denom,
+		Amount:        amount,
+		StartHeight:   startHeight,
+		BatchSize:     batchSize,
+		MinPayout:     minPayout,
+		Administrator: administrator.String(),
+	}
+}
+
+func (msg MsgScheduleDistributionRequest) ValidateBasic() error {
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return fmt.Errorf("invalid denom: %w", err)
+	}
+	if err := msg.Amount.Validate(); err != nil {
+		return fmt.Errorf("invalid amount: %w", err)
+	}
+	if !msg.Amount.IsPositive() {
+		return fmt.Errorf("invalid amount: %s must be positive", msg.Amount)
+	}
+	if msg.Amount.Denom == msg.Denom {
+		return fmt.Errorf("invalid amount: %s cannot be distributed to its own holders", msg.Denom)
+	}
+	if msg.StartHeight < 0 {
+		return fmt.Errorf("invalid start height: %d cannot be negative", msg.StartHeight)
+	}
+	if msg.BatchSize > MaxDistributionBatchSize {
+		return fmt.Errorf("invalid batch size: %d cannot be more than %d", msg.BatchSize, MaxDistributionBatchSize)
+	}
+	if msg.MinPayout != nil {
+		if err := msg.MinPayout.Validate(); err != nil {
+			return fmt.Errorf("invalid min payout: %w", err)
+		}
+		if msg.MinPayout.Denom != msg.Amount.Denom {
+			return fmt.Errorf("invalid min payout: denom %q does not match amount denom %q", msg.MinPayout.Denom, msg.Amount.Denom)
+		}
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Administrator); err != nil {
+		return fmt.Errorf("invalid administrator: %w", err)
+	}
+	return nil
+}
+
+func NewMsgCancelDistributionRequest(distributionID uint64, administrator sdk.AccAddress) *MsgCancelDistributionRequest {
+	return &MsgCancelDistributionRequest{
+		DistributionId: distributionID,
+		Administrator:  administrator.String(),
+	}
+}
+
+func (msg MsgCancelDistributionRequest) ValidateBasic() error {
+	if msg.DistributionId == 0 {
+		return fmt.Errorf("invalid distribution id: cannot be zero")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Administrator); err != nil {
+		return fmt.Errorf("invalid administrator: %w", err)
+	}
+	return nil
+}
+
+func NewMsgClaimDistributionRequest(distributionID uint64, claimant sdk.AccAddress) *MsgClaimDistributionRequest {
+	return &MsgClaimDistributionRequest{
+		DistributionId: distributionID,
+		Claimant:       claimant.String(),
+	}
+}
+
+func (msg MsgClaimDistributionRequest) ValidateBasic() error {
+	if msg.DistributionId == 0 {
+		return fmt.Errorf("invalid distribution id: cannot be zero")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Claimant); err != nil {
+		return fmt.Errorf("invalid claimant: %w", err)
+	}
+	return nil
+}
+
+func NewMsgAllocateEscrowRequest(denom string, ledger string, amount sdk.Coins, administrator sdk.AccAddress) *MsgAllocateEscrowRequest {
+	return &MsgAllocateEscrowRequest{
+		Denom:         denom,
+		Ledger:        ledger,
+		Amount:        amount,
+		Administrator: administrator.String(),
+	}
+}
+
+func (msg MsgAllocateEscrowRequest) ValidateBasic() error {
+	return validateEscrowLedgerMsg(msg.Denom, msg.Ledger, msg.Amount, msg.Administrator)
+}
+
+func NewMsgReleaseEscrowRequest(denom string, ledger string, amount sdk.Coins, administrator sdk.AccAddress) *MsgReleaseEscrowRequest {
+	return &MsgReleaseEscrowRequest{
+		Denom:         denom,
+		Ledger:        ledger,
+		Amount:        amount,
+		Administrator: administrator.String(),
+	}
+}
+
+func (msg MsgReleaseEscrowRequest) ValidateBasic() error {
+	return validateEscrowLedgerMsg(msg.Denom, msg.Ledger, msg.Amount, msg.Administrator)
+}
+
+func NewMsgSetEscrowWithdrawLimitRequest(denom string, ledger string, grantee sdk.AccAddress, limit sdk.Coins, administrator sdk.AccAddress) *MsgSetEscrowWithdrawLimitRequest {
+	return &MsgSetEscrowWithdrawLimitRequest{
+		Denom:         denom,
+		Ledger:        ledger,
+		Grantee:       grantee.String(),
+		Limit:         limit,
+		Administrator: administrator.String(),
+	}
+}
+
+func (msg MsgSetEscrowWithdrawLimitRequest) ValidateBasic() error {
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return err
+	}
+	if err := ValidateEscrowLedgerName(msg.Ledger); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Grantee); err != nil {
+		return fmt.Errorf("invalid grantee: %w", err)
+	}
+	if err := msg.Limit.Validate(); err != nil {
+		return fmt.Errorf("invalid limit: %w", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Administrator); err != nil {
+		return fmt.Errorf("invalid administrator: %w", err)
+	}
+	return nil
+}
+
+func NewMsgWithdrawFromEscrowRequest(denom string, ledger string, amount sdk.Coins, toAddress sdk.AccAddress, administrator sdk.AccAddress) *MsgWithdrawFromEscrowRequest {
+	msg := &MsgWithdrawFromEscrowRequest{
+		Denom:         denom,
+		Ledger:        ledger,
+		Amount:        amount,
+		Administrator: administrator.String(),
+	}
+	if len(toAddress) > 0 {
+		msg.ToAddress = toAddress.String()
+	}
+	return msg
+}
+
+func (msg MsgWithdrawFromEscrowRequest) ValidateBasic() error {
+	if err := validateEscrowLedgerMsg(msg.Denom, msg.Ledger, msg.Amount, msg.Administrator); err != nil {
+		return err
+	}
+	if msg.ToAddress != "" {
+		if _, err := sdk.AccAddressFromBech32(msg.ToAddress); err != nil {
+			return fmt.Errorf("invalid to address: %w", err)
+		}
+	}
+	return nil
+}
+
+func NewMsgScheduleBurnRequest(amount sdk.Coin, burnHeight int64, cancelWindow uint64, administrator sdk.AccAddress) *MsgScheduleBurnRequest {
+	return &MsgScheduleBurnRequest{
+		Amount:        amount,
+		BurnHeight:    burnHeight,
+		CancelWindow:  cancelWindow,
+		Administrator: administrator.String(),
+	}
+}
+
+func (msg MsgScheduleBurnRequest) ValidateBasic() error {
+	if err := msg.Amount.Validate(); err != nil {
+		return fmt.Errorf("invalid amount: %w", err)
+	}
+	if !msg.Amount.IsPositive() {
+		return fmt.Errorf("invalid amount: %s must be positive", msg.Amount)
+	}
+	if msg.BurnHeight <= 0 {
+		return fmt.Errorf("invalid burn height: %d must be positive", msg.BurnHeight)
+	}
+	if msg.CancelWindow > uint64(msg.BurnHeight) {
+		return fmt.Errorf("invalid cancel window: %d blocks cannot close after burn height %d", msg.CancelWindow, msg.BurnHeight)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Administrator); err != nil {
+		return fmt.Errorf("invalid administrator: %w", err)
+	}
+	return nil
+}
+
+func NewMsgCancelScheduledBurnRequest(burnID uint64, administrator sdk.AccAddress) *MsgCancelScheduledBurnRequest {
+	return &MsgCancelScheduledBurnRequest{
+		BurnId:        burnID,
+		Administrator: administrator.String(),
+	}
+}
+
+func (msg MsgCancelScheduledBurnRequest) ValidateBasic() error {
+	if msg.BurnId == 0 {
+		return fmt.Errorf("invalid burn id: cannot be zero")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Administrator); err != nil {
+		return fmt.Errorf("invalid administrator: %w", err)
+	}
+	return nil
+}
+
+func NewMsgExchangeRequest(partyA sdk.AccAddress, amountA sdk.Coin, partyB sdk.AccAddress, amountB sdk.Coin) *MsgExchangeRequest {
+	return &MsgExchangeRequest{
+		PartyA:  partyA.String(),
+		AmountA: amountA,
+		PartyB:  partyB.String(),
+		AmountB: amountB,
+	}
+}
+
+func (msg MsgExchangeRequest) ValidateBasic() error {
+	partyA, err := sdk.AccAddressFromBech32(msg.PartyA)
+	if err != nil {
+		return fmt.Errorf("invalid party a: %w", err)
+	}
+	partyB, err := sdk.AccAddressFromBech32(msg.PartyB)
+	if err != nil {
+		return fmt.Errorf("invalid party b: %w", err)
+	}
+	if partyA.Equals(partyB) {
+		return fmt.Errorf("party a and party b cannot be the same account")
+	}
+	if err = validateExchangeAmount(msg.AmountA); err != nil {
+		return fmt.Errorf("invalid amount a: %w", err)
+	}
+	if err = validateExchangeAmount(msg.AmountB); err != nil {
+		return fmt.Errorf("invalid amount b: %w", err)
+	}
+	if msg.AmountA.Denom == msg.AmountB.Denom {
+		return fmt.Errorf("amount a and amount b cannot have the same denom %q", msg.AmountA.Denom)
+	}
+	return nil
+}
+
+// validateExchangeAmount makes sure that an amount in an exchange is a valid positive coin.
+func validateExchangeAmount(amount sdk.Coin) error {
+	if err := amount.Validate(); err != nil {
+		return err
+	}
+	if !amount.IsPositive() {
+		return fmt.Errorf("%q must be positive", amount)
+	}
+	return nil
+}
+
+// validateEscrowLedgerMsg checks the fields common to the msgs for moving funds in and out of a marker's escrow ledgers.
+func validateEscrowLedgerMsg(denom, ledger string, amount sdk.Coins, administrator string) error {
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return err
+	}
+	if err := ValidateEscrowLedgerName(ledger); err != nil {
+		return err
+	}
+	if err := amount.Validate(); err != nil {
+		return fmt.Errorf("invalid amount: %w", err)
+	}
+	if amount.IsZero() {
+		return fmt.Errorf("invalid amount: cannot be empty")
+	}
+	if _, err := sdk.AccAddressFromBech32(administrator); err != nil {
+		return fmt.Errorf("invalid administrator: %w", err)
+	}
+	return nil
+}
+
+// validateEscrowStakingMsg checks the fields common to the msgs for staking a marker's escrowed funds.
+// The amount is only checked if it's not nil.
+func validateEscrowStakingMsg(denom, validator string, amount *sdk.Coin, administrator string) error {
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return err
+	}
+	if _, err := sdk.ValAddressFromBech32(validator); err != nil {
+		return fmt.Errorf("invalid validator address: %w", err)
+	}
+	if amount != nil {
+		if err := amount.Validate(); err != nil {
+			return fmt.Errorf("invalid amount: %w", err)
+		}
+		if !amount.IsPositive() {
+			return fmt.Errorf("invalid amount: %s must be positive", amount)
+		}
+	}
+	if _, err := sdk.AccAddressFromBech32(administrator); err != nil {
+		return fmt.Errorf("invalid administrator: %w", err)
+	}
+	return nil
+}
+
 func NewMsgSupplyDecreaseProposalRequest(amount sdk.Coin, authority string) *MsgSupplyDecreaseProposalRequest {
 	return &MsgSupplyDecreaseProposalRequest{
 		Amount:    amount,
@@ -747,3 +1431,64 @@ func (msg MsgUpdateParamsRequest) ValidateBasic() error {
 	_, err := sdk.AccAddressFromBech32(msg.Authority)
 	return err
 }
+
+// NewMsgUpdateSendRestrictionBypassesRequest creates a new MsgUpdateSendRestrictionBypassesRequest.
+func NewMsgUpdateSendRestrictionBypassesRequest(authority string, set []SendRestrictionBypass, remove []string) *MsgUpdateSendRestrictionBypassesRequest {
+	return &MsgUpdateSendRestrictionBypassesRequest{
+		Authority: authority,
+		Set:       set,
+		Remove:    remove,
+	}
+}
+
+func (msg MsgUpdateSendRestrictionBypassesRequest) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return fmt.Errorf("invalid authority: %w", err)
+	}
+	if len(msg.Set) == 0 && len(msg.Remove) == 0 {
+		return errors.New("at least one send restriction bypass must be set or removed")
+	}
+	if err := ValidateSendRestrictionBypasses(msg.Set); err != nil {
+		return err
+	}
+	toSet := make(map[string]bool, len(msg.Set))
+	for _, bypass := range msg.Set {
+		toSet[bypass.Address] = true
+	}
+	removed := make(map[string]bool, len(msg.Remove))
+	for _, addr := range msg.Remove {
+		if _, err := sdk.AccAddressFromBech32(addr); err != nil {
+			return fmt.Errorf("invalid address to remove %q: %w", addr, err)
+		}
+		if toSet[addr] {
+			return fmt.Errorf("cannot both set and remove send restriction bypass for %s", addr)
+		}
+		if removed[addr] {
+			return fmt.Errorf("duplicate address to remove %s", addr)
+		}
+		removed[addr] = true
+	}
+	return nil
+}
+
+// NewMsgChangeMarkerTypeProposalRequest creates a new MsgChangeMarkerTypeProposalRequest.
+func NewMsgChangeMarkerTypeProposalRequest(denom string, newType MarkerType, authority string) *MsgChangeMarkerTypeProposalRequest {
+	return &MsgChangeMarkerTypeProposalRequest{
+		Denom:     denom,
+		NewType:   newType,
+		Authority: authority,
+	}
+}
+
+func (msg MsgChangeMarkerTypeProposalRequest) ValidateBasic() error {
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return err
+	}
+	if msg.NewType != MarkerType_Coin && msg.NewType != MarkerType_RestrictedCoin {
+		return fmt.Errorf("invalid new marker type %s: must be %s or %s", msg.NewType, MarkerType_Coin, MarkerType_RestrictedCoin)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return fmt.Errorf("invalid authority: %w", err)
+	}
+	return nil
+}
@@ -2,11 +2,13 @@ package types_test
 
 import (
 	"fmt"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/protoadapt"
 
 	sdkmath "cosmossdk.io/math"
 	"cosmossdk.io/x/feegrant"
@@ -17,6 +19,7 @@ import (
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
 
+	"github.com/provenance-io/provenance/app"
 	"github.com/provenance-io/provenance/testutil"
 
 	. "github.com/provenance-io/provenance/x/marker/types"
@@ -45,16 +48,101 @@ func TestAllMsgsGetSigners(t *testing.T) {
 		func(signer string) sdk.Msg { return &MsgUpdateForcedTransferRequest{Authority: signer} },
 		func(signer string) sdk.Msg { return &MsgSetAccountDataRequest{Signer: signer} },
 		func(signer string) sdk.Msg { return &MsgUpdateSendDenyListRequest{Authority: signer} },
+		func(signer string) sdk.Msg { return &MsgUpdateDepositAllowListRequest{Administrator: signer} },
+		func(signer string) sdk.Msg { return &MsgSetFeeSponsorshipRequest{Administrator: signer} },
+		func(signer string) sdk.Msg { return &MsgRemoveFeeSponsorshipRequest{Administrator: signer} },
+		func(signer string) sdk.Msg { return &MsgClaimFeeSponsorshipRequest{Holder: signer} },
+		func(signer string) sdk.Msg { return &MsgReleaseFeeSponsorshipHoldRequest{Holder: signer} },
+		func(signer string) sdk.Msg { return &MsgSetBridgeInfoRequest{Administrator: signer} },
+		func(signer string) sdk.Msg { return &MsgAttestedMintRequest{Administrator: signer} },
+		func(signer string) sdk.Msg { return &MsgSetBasketInfoRequest{Administrator: signer} },
+		func(signer string) sdk.Msg { return &MsgSetIBCMemoActionConfigRequest{Administrator: signer} },
+		func(signer string) sdk.Msg { return &MsgBasketDepositRequest{Depositor: signer} },
+		func(signer string) sdk.Msg { return &MsgBasketWithdrawRequest{Holder: signer} },
 		func(signer string) sdk.Msg { return &MsgAddNetAssetValuesRequest{Administrator: signer} },
+		func(signer string) sdk.Msg { return &MsgBindMarkerNameRequest{Administrator: signer} },
+		func(signer string) sdk.Msg { return &MsgDeleteMarkerNameRequest{Administrator: signer} },
+		func(signer string) sdk.Msg { return &MsgDelegateEscrowRequest{Administrator: signer} },
+		func(signer string) sdk.Msg { return &MsgUndelegateEscrowRequest{Administrator: signer} },
+		func(signer string) sdk.Msg { return &MsgCollectEscrowRewardsRequest{Administrator: signer} },
+		func(signer string) sdk.Msg { return &MsgSetMintAllowanceRequest{Administrator: signer} },
+		func(signer string) sdk.Msg { return &MsgMintFromAllowanceRequest{Minter: signer} },
+		func(signer string) sdk.Msg { return &MsgScheduleDistributionRequest{Administrator: signer} },
+		func(signer string) sdk.Msg { return &MsgCancelDistributionRequest{Administrator: signer} },
+		func(signer string) sdk.Msg { return &MsgClaimDistributionRequest{Claimant: signer} },
+		func(signer string) sdk.Msg { return &MsgAllocateEscrowRequest{Administrator: signer} },
+		func(signer string) sdk.Msg { return &MsgReleaseEscrowRequest{Administrator: signer} },
+		func(signer string) sdk.Msg { return &MsgSetEscrowWithdrawLimitRequest{Administrator: signer} },
+		func(signer string) sdk.Msg { return &MsgWithdrawFromEscrowRequest{Administrator: signer} },
+		func(signer string) sdk.Msg { return &MsgScheduleBurnRequest{Administrator: signer} },
+		func(signer string) sdk.Msg { return &MsgCancelScheduledBurnRequest{Administrator: signer} },
 		func(signer string) sdk.Msg { return &MsgSetAdministratorProposalRequest{Authority: signer} },
 		func(signer string) sdk.Msg { return &MsgRemoveAdministratorProposalRequest{Authority: signer} },
 		func(signer string) sdk.Msg { return &MsgChangeStatusProposalRequest{Authority: signer} },
 		func(signer string) sdk.Msg { return &MsgWithdrawEscrowProposalRequest{Authority: signer} },
 		func(signer string) sdk.Msg { return &MsgSetDenomMetadataProposalRequest{Authority: signer} },
 		func(signer string) sdk.Msg { return &MsgUpdateParamsRequest{Authority: signer} },
+		func(signer string) sdk.Msg { return &MsgUpdateSendRestrictionBypassesRequest{Authority: signer} },
+		func(signer string) sdk.Msg { return &MsgChangeMarkerTypeProposalRequest{Authority: signer} },
 	}
 
-	testutil.RunGetSignersTests(t, AllRequestMsgs, msgMakers, nil)
+	// The MsgExchangeRequest has two signer fields, which RunGetSignersTests can't handle.
+	// It gets checked in TestMsgExchangeRequestGetSigners instead.
+	var requestMsgs []sdk.Msg
+	for _, msg := range AllRequestMsgs {
+		if _, isExchange := msg.(*MsgExchangeRequest); !isExchange {
+			requestMsgs = append(requestMsgs, msg)
+		}
+	}
+
+	testutil.RunGetSignersTests(t, requestMsgs, msgMakers, nil)
+}
+
+func TestMsgExchangeRequestGetSigners(t *testing.T) {
+	encCfg := app.MakeTestEncodingConfig(t)
+	sigCtx := encCfg.InterfaceRegistry.SigningContext()
+	partyA := sdk.AccAddress("partyA______________")
+	partyB := sdk.AccAddress("partyB______________")
+
+	tests := []struct {
+		name   string
+		msg    *MsgExchangeRequest
+		exp    [][]byte
+		expErr string
+	}{
+		{
+			name: "both good",
+			msg:  &MsgExchangeRequest{PartyA: partyA.String(), PartyB: partyB.String()},
+			exp:  [][]byte{partyA, partyB},
+		},
+		{
+			name:   "no party a",
+			msg:    &MsgExchangeRequest{PartyB: partyB.String()},
+			expErr: "empty address string is not allowed",
+		},
+		{
+			name:   "bad party b",
+			msg:    &MsgExchangeRequest{PartyA: partyA.String(), PartyB: "badaddr"},
+			expErr: "decoding bech32 failed: invalid bech32 string length 7",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var actual [][]byte
+			var err error
+			testFunc := func() {
+				actual, err = sigCtx.GetSigners(protoadapt.MessageV2Of(tc.msg))
+			}
+			require.NotPanics(t, testFunc, "GetSigners")
+			if len(tc.expErr) > 0 {
+				require.ErrorContains(t, err, tc.expErr, "GetSigners error")
+			} else {
+				require.NoError(t, err, "GetSigners error")
+			}
+			assert.Equal(t, tc.exp, actual, "GetSigners result")
+		})
+	}
 }
 
 func TestMsgGrantAllowance(t *testing.T) {
@@ -586,10 +674,23 @@ func TestMsgUpdateRequiredAttributesRequestValidateBasic(t *testing.T) {
 			msg:           *NewMsgUpdateRequiredAttributesRequest("jackthecat", sdk.AccAddress(authority), []string{"foo.provenance.io"}, []string{"foo2.provenance.io", "foo2.provenance.io"}),
 			expectedError: "required attribute lists contain duplicate entries",
 		},
+		{
+			name:          "should fail, sender lists have duplicate entries",
+			msg:           MsgUpdateRequiredAttributesRequest{Denom: "jackthecat", TransferAuthority: authority, AddRequiredSenderAttributes: []string{"foo.provenance.io"}, RemoveRequiredSenderAttributes: []string{"foo.provenance.io"}},
+			expectedError: "required sender attribute lists contain duplicate entries",
+		},
 		{
 			name: "should succeed",
 			msg:  *NewMsgUpdateRequiredAttributesRequest("jackthecat", sdk.AccAddress(authority), []string{"foo.provenance.io"}, []string{"foo2.provenance.io"}),
 		},
+		{
+			name: "should succeed, same entry in receiver and sender lists",
+			msg:  MsgUpdateRequiredAttributesRequest{Denom: "jackthecat", TransferAuthority: authority, AddRequiredAttributes: []string{"foo.provenance.io"}, AddRequiredSenderAttributes: []string{"foo.provenance.io"}},
+		},
+		{
+			name: "should succeed, only sender lists",
+			msg:  MsgUpdateRequiredAttributesRequest{Denom: "jackthecat", TransferAuthority: authority, RemoveRequiredSenderAttributes: []string{"foo.provenance.io"}},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -710,63 +811,57 @@ func TestMsgSetAccountDataRequestValidateBasic(t *testing.T) {
 	}
 }
 
-func TestMsgUpdateSendDenyListRequestValidateBasic(t *testing.T) {
+func TestMsgBindMarkerNameRequestValidateBasic(t *testing.T) {
 	addr := sdk.AccAddress("addr________________").String()
 	denom := "somedenom"
-	addAddr := sdk.AccAddress("addAddr________________").String()
-	removeAddr := sdk.AccAddress("removeAddr________________").String()
 
 	tests := []struct {
-		name   string
-		msg    MsgUpdateSendDenyListRequest
-		expErr string
+		name string
+		msg  MsgBindMarkerNameRequest
+		exp  string
 	}{
 		{
-			name: "should succeed",
-			msg:  MsgUpdateSendDenyListRequest{Denom: denom, RemoveDeniedAddresses: []string{removeAddr}, AddDeniedAddresses: []string{addAddr}, Authority: addr},
-		},
-		{
-			name:   "invalid authority address",
-			msg:    MsgUpdateSendDenyListRequest{Denom: denom, RemoveDeniedAddresses: []string{removeAddr}, AddDeniedAddresses: []string{addAddr}, Authority: "invalid-address"},
-			expErr: "decoding bech32 failed: invalid separator index -1",
+			name: "control",
+			msg:  MsgBindMarkerNameRequest{Denom: denom, Parent: "some.parent", Name: "child", Address: addr, Administrator: addr},
+			exp:  "",
 		},
 		{
-			name:   "both add and remove list are empty",
-			msg:    MsgUpdateSendDenyListRequest{Denom: denom, RemoveDeniedAddresses: []string{}, AddDeniedAddresses: []string{}, Authority: addr},
-			expErr: "both add and remove lists cannot be empty",
+			name: "invalid denom",
+			msg:  MsgBindMarkerNameRequest{Denom: "1denomcannotstartwithdigit", Parent: "parent", Name: "child", Address: addr, Administrator: addr},
+			exp:  "invalid denom: 1denomcannotstartwithdigit",
 		},
 		{
-			name:   "invalid authority address",
-			msg:    MsgUpdateSendDenyListRequest{Denom: denom, RemoveDeniedAddresses: []string{removeAddr}, AddDeniedAddresses: []string{addAddr}, Authority: "invalid-address"},
-			expErr: "decoding bech32 failed: invalid separator index -1",
+			name: "no parent",
+			msg:  MsgBindMarkerNameRequest{Denom: denom, Parent: " ", Name: "child", Address: addr, Administrator: addr},
+			exp:  "parent name cannot be empty",
 		},
 		{
-			name:   "invalid remove address",
-			msg:    MsgUpdateSendDenyListRequest{Denom: denom, RemoveDeniedAddresses: []string{"invalid-address"}, AddDeniedAddresses: []string{}, Authority: addr},
-			expErr: "decoding bech32 failed: invalid separator index -1",
+			name: "no name",
+			msg:  MsgBindMarkerNameRequest{Denom: denom, Parent: "parent", Name: "", Address: addr, Administrator: addr},
+			exp:  "name cannot be empty",
 		},
 		{
-			name:   "invalid add address",
-			msg:    MsgUpdateSendDenyListRequest{Denom: denom, RemoveDeniedAddresses: []string{removeAddr}, AddDeniedAddresses: []string{"invalid-addrs"}, Authority: addr},
-			expErr: "decoding bech32 failed: invalid separator index -1",
+			name: "name with segments",
+			msg:  MsgBindMarkerNameRequest{Denom: denom, Parent: "parent", Name: "child.too", Address: addr, Administrator: addr},
+			exp:  "invalid name: \".\" is reserved",
 		},
 		{
-			name:   "duplicate entries in list",
-			msg:    MsgUpdateSendDenyListRequest{Denom: denom, RemoveDeniedAddresses: []string{removeAddr, removeAddr}, AddDeniedAddresses: []string{}, Authority: addr},
-			expErr: "denied address lists contain duplicate entries",
+			name: "invalid address",
+			msg:  MsgBindMarkerNameRequest{Denom: denom, Parent: "parent", Name: "child", Address: "", Administrator: addr},
+			exp:  "invalid address: empty address string is not allowed",
 		},
 		{
-			name:   "invalid denom",
-			msg:    MsgUpdateSendDenyListRequest{Denom: "1", RemoveDeniedAddresses: []string{removeAddr}, AddDeniedAddresses: []string{addAddr}, Authority: addr},
-			expErr: "invalid denom: 1",
+			name: "invalid administrator",
+			msg:  MsgBindMarkerNameRequest{Denom: denom, Parent: "parent", Name: "child", Address: addr, Administrator: ""},
+			exp:  "invalid administrator: empty address string is not allowed",
 		},
 	}
 
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
 			err := tc.msg.ValidateBasic()
-			if len(tc.expErr) > 0 {
-				require.EqualErrorf(t, err, tc.expErr, "ValidateBasic error")
+			if len(tc.exp) > 0 {
+				require.EqualErrorf(t, err, tc.exp, "ValidateBasic error")
 			} else {
 				require.NoError(t, err, "ValidateBasic error")
 			}
@@ -774,60 +869,97 @@ func TestMsgUpdateSendDenyListRequestValidateBasic(t *testing.T) {
 	}
 }
 
-func TestMsgAddNetAssetValueValidateBasic(t *testing.T) {
+func TestMsgDeleteMarkerNameRequestValidateBasic(t *testing.T) {
 	addr := sdk.AccAddress("addr________________").String()
 	denom := "somedenom"
-	netAssetValue1 := NetAssetValue{Price: sdk.NewInt64Coin("jackthecat", 100), Volume: uint64(100)}
-	netAssetValue2 := NetAssetValue{Price: sdk.NewInt64Coin("hotdog", 100), Volume: uint64(100)}
-	invalidNetAssetValue := NetAssetValue{Price: sdk.NewInt64Coin("hotdog", 100), Volume: uint64(0)}
-	invalidNetAssetValue2 := NetAssetValue{Price: sdk.NewInt64Coin("hotdog", 100), Volume: uint64(1), UpdatedBlockHeight: 1}
 
 	tests := []struct {
-		name   string
-		msg    MsgAddNetAssetValuesRequest
-		expErr string
+		name string
+		msg  MsgDeleteMarkerNameRequest
+		exp  string
 	}{
 		{
-			name: "should succeed",
-			msg:  MsgAddNetAssetValuesRequest{Denom: denom, NetAssetValues: []NetAssetValue{netAssetValue1, netAssetValue2}, Administrator: addr},
+			name: "control",
+			msg:  MsgDeleteMarkerNameRequest{Denom: denom, Name: "child.parent", Administrator: addr},
+			exp:  "",
 		},
 		{
-			name:   "block height is set",
-			msg:    MsgAddNetAssetValuesRequest{Denom: denom, NetAssetValues: []NetAssetValue{invalidNetAssetValue2}, Administrator: addr},
-			expErr: "marker net asset value must not have update height set",
+			name: "invalid denom",
+			msg:  MsgDeleteMarkerNameRequest{Denom: "", Name: "child.parent", Administrator: addr},
+			exp:  "invalid denom: ",
 		},
 		{
-			name:   "validation of net asset value failure",
-			msg:    MsgAddNetAssetValuesRequest{Denom: denom, NetAssetValues: []NetAssetValue{invalidNetAssetValue}, Administrator: addr},
-			expErr: "marker net asset value volume must be positive value",
+			name: "no name",
+			msg:  MsgDeleteMarkerNameRequest{Denom: denom, Name: "", Administrator: addr},
+			exp:  "name cannot be empty",
 		},
 		{
-			name:   "duplicate net asset values",
-			msg:    MsgAddNetAssetValuesRequest{Denom: denom, NetAssetValues: []NetAssetValue{netAssetValue1, netAssetValue2, netAssetValue2}, Administrator: addr},
-			expErr: "list of net asset values contains duplicates",
+			name: "invalid administrator",
+			msg:  MsgDeleteMarkerNameRequest{Denom: denom, Name: "child.parent", Administrator: "not1validsigner"},
+			exp:  "invalid administrator: decoding bech32 failed: invalid character not part of charset: 105",
 		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if len(tc.exp) > 0 {
+				require.EqualErrorf(t, err, tc.exp, "ValidateBasic error")
+			} else {
+				require.NoError(t, err, "ValidateBasic error")
+			}
+		})
+	}
+}
+
+func TestMsgDelegateEscrowRequestValidateBasic(t *testing.T) {
+	addr := sdk.AccAddress("addr________________").String()
+	val := sdk.ValAddress("val_________________").String()
+	denom := "somedenom"
+	amount := sdk.NewInt64Coin("stake", 100)
+
+	tests := []struct {
+		name string
+		msg  MsgDelegateEscrowRequest
+		exp  string
+	}{
 		{
-			name:   "invalid denom",
-			msg:    MsgAddNetAssetValuesRequest{Denom: "", NetAssetValues: []NetAssetValue{netAssetValue1, netAssetValue2, netAssetValue2}, Administrator: addr},
-			expErr: "invalid denom: ",
+			name: "control",
+			msg:  MsgDelegateEscrowRequest{Denom: denom, ValidatorAddress: val, Amount: amount, Administrator: addr},
+			exp:  "",
 		},
 		{
-			name:   "invalid administrator address",
-			msg:    MsgAddNetAssetValuesRequest{Denom: denom, NetAssetValues: []NetAssetValue{netAssetValue1, netAssetValue2}, Administrator: "invalid address"},
-			expErr: "decoding bech32 failed: invalid character in string: ' '",
+			name: "invalid denom",
+			msg:  MsgDelegateEscrowRequest{Denom: "", ValidatorAddress: val, Amount: amount, Administrator: addr},
+			exp:  "invalid denom: ",
 		},
 		{
-			name:   "empty net asset list",
-			msg:    MsgAddNetAssetValuesRequest{Denom: denom, NetAssetValues: []NetAssetValue{}, Administrator: addr},
-			expErr: "net asset value list cannot be empty",
+			name: "invalid validator",
+			msg:  MsgDelegateEscrowRequest{Denom: denom, ValidatorAddress: addr, Amount: amount, Administrator: addr},
+			exp:  "invalid validator address: invalid Bech32 prefix; expected cosmosvaloper, got cosmos",
+		},
+		{
+			name: "zero amount",
+			msg:  MsgDelegateEscrowRequest{Denom: denom, ValidatorAddress: val, Amount: sdk.NewInt64Coin("stake", 0), Administrator: addr},
+			exp:  "invalid amount: 0stake must be positive",
+		},
+		{
+			name: "invalid amount denom",
+			msg:  MsgDelegateEscrowRequest{Denom: denom, ValidatorAddress: val, Amount: sdk.Coin{Denom: "x", Amount: sdkmath.NewInt(1)}, Administrator: addr},
+			exp:  "invalid amount: invalid denom: x",
+		},
+		{
+			name: "invalid administrator",
+			msg:  MsgDelegateEscrowRequest{Denom: denom, ValidatorAddress: val, Amount: amount, Administrator: ""},
+			exp:  "invalid administrator: empty address string is not allowed",
 		},
 	}
 
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
 			err := tc.msg.ValidateBasic()
-			if len(tc.expErr) > 0 {
-				require.EqualErrorf(t, err, tc.expErr, "ValidateBasic error")
+			if len(tc.exp) > 0 {
+				require.EqualErrorf(t, err, tc.exp, "ValidateBasic error")
 			} else {
 				require.NoError(t, err, "ValidateBasic error")
 			}
@@ -835,52 +967,49 @@ func TestMsgAddNetAssetValueValidateBasic(t *testing.T) {
 	}
 }
 
-func TestMsgSupplyDecreaseProposalRequestValidateBasic(t *testing.T) {
-	validAddress := "cosmos1sh49f6ze3vn7cdl2amh2gnc70z5mten3y08xck"
-	invalidAddress := "invalidaddr0000"
+func TestMsgUndelegateEscrowRequestValidateBasic(t *testing.T) {
+	addr := sdk.AccAddress("addr________________").String()
+	val := sdk.ValAddress("val_________________").String()
+	denom := "somedenom"
+	amount := sdk.NewInt64Coin("stake", 100)
 
-	testCases := []struct {
-		name          string
-		authority     string
-		amount        sdk.Coin
-		expectError   bool
-		expectedError string
+	tests := []struct {
+		name string
+		msg  MsgUndelegateEscrowRequest
+		exp  string
 	}{
 		{
-			name:          "valid input",
-			authority:     validAddress,
-			amount:        sdk.NewInt64Coin("testcoin", 100),
-			expectError:   false,
-			expectedError: "",
+			name: "control",
+			msg:  MsgUndelegateEscrowRequest{Denom: denom, ValidatorAddress: val, Amount: amount, Administrator: addr},
+			exp:  "",
 		},
 		{
-			name:          "negative amount",
-			authority:     validAddress,
-			amount:        sdk.Coin{Denom: "testcoin", Amount: sdkmath.NewInt(-100)},
-			expectError:   true,
-			expectedError: "amount to decrease must be greater than zero",
+			name: "invalid denom",
+			msg:  MsgUndelegateEscrowRequest{Denom: "", ValidatorAddress: val, Amount: amount, Administrator: addr},
+			exp:  "invalid denom: ",
 		},
 		{
-			name:          "invalid authority address",
-			authority:     invalidAddress,
-			amount:        sdk.NewInt64Coin("testcoin", 100),
-			expectError:   true,
-			expectedError: "decoding bech32 failed: invalid separator index -1",
+			name: "invalid validator",
+			msg:  MsgUndelegateEscrowRequest{Denom: denom, ValidatorAddress: "", Amount: amount, Administrator: addr},
+			exp:  "invalid validator address: empty address string is not allowed",
+		},
+		{
+			name: "zero amount",
+			msg:  MsgUndelegateEscrowRequest{Denom: denom, ValidatorAddress: val, Amount: sdk.NewInt64Coin("stake", 0), Administrator: addr},
+			exp:  "invalid amount: 0stake must be positive",
+		},
+		{
+			name: "invalid administrator",
+			msg:  MsgUndelegateEscrowRequest{Denom: denom, ValidatorAddress: val, Amount: amount, Administrator: "not1validsigner"},
+			exp:  "invalid administrator: decoding bech32 failed: invalid character not part of charset: 105",
 		},
 	}
 
-	for _, tc := range testCases {
-		tc := tc
+	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
-			msg := MsgSupplyDecreaseProposalRequest{
-				Authority: tc.authority,
-				Amount:    tc.amount,
-			}
-
-			err := msg.ValidateBasic()
-			if tc.expectError {
-				require.Error(t, err)
-				require.EqualError(t, err, tc.expectedError, "ValidateBasic error")
+			err := tc.msg.ValidateBasic()
+			if len(tc.exp) > 0 {
+				require.EqualErrorf(t, err, tc.exp, "ValidateBasic error")
 			} else {
 				require.NoError(t, err, "ValidateBasic error")
 			}
@@ -888,61 +1017,43 @@ func TestMsgSupplyDecreaseProposalRequestValidateBasic(t *testing.T) {
 	}
 }
 
-func TestMsgSetAdministratorProposalRequestValidateBasic(t *testing.T) {
-	validAddress := "cosmos1sh49f6ze3vn7cdl2amh2gnc70z5mten3y08xck"
-	invalidAddress := "invalidaddr0000"
-
-	validAccessGrant := AccessGrant{
-		Address:     validAddress,
-		Permissions: []Access{Access_Admin},
-	}
-	invalidAccessGrant := AccessGrant{
-		Address:     "invalidaddress",
-		Permissions: []Access{Access_Admin},
-	}
+func TestMsgCollectEscrowRewardsRequestValidateBasic(t *testing.T) {
+	addr := sdk.AccAddress("addr________________").String()
+	val := sdk.ValAddress("val_________________").String()
+	denom := "somedenom"
 
-	testCases := []struct {
-		name          string
-		denom         string
-		accessGrant   []AccessGrant
-		authority     string
-		expectError   bool
-		expectedError string
+	tests := []struct {
+		name string
+		msg  MsgCollectEscrowRewardsRequest
+		exp  string
 	}{
 		{
-			name:        "valid case",
-			denom:       "testcoin",
-			accessGrant: []AccessGrant{validAccessGrant},
-			authority:   validAddress,
-			expectError: false,
+			name: "control",
+			msg:  MsgCollectEscrowRewardsRequest{Denom: denom, ValidatorAddress: val, Administrator: addr},
+			exp:  "",
 		},
 		{
-			name:          "invalid authority address",
-			denom:         "testcoin",
-			accessGrant:   []AccessGrant{validAccessGrant},
-			authority:     invalidAddress,
-			expectError:   true,
-			expectedError: "decoding bech32 failed: invalid separator index -1",
+			name: "invalid denom",
+			msg:  MsgCollectEscrowRewardsRequest{Denom: "", ValidatorAddress: val, Administrator: addr},
+			exp:  "invalid denom: ",
 		},
 		{
-			name:          "invalid access grant",
-			denom:         "testcoin",
-			accessGrant:   []AccessGrant{invalidAccessGrant},
-			authority:     validAddress,
-			expectError:   true,
-			expectedError: "invalid access grant for administrator: invalid address: decoding bech32 failed: invalid separator index -1",
+			name: "invalid validator",
+			msg:  MsgCollectEscrowRewardsRequest{Denom: denom, ValidatorAddress: "", Administrator: addr},
+			exp:  "invalid validator address: empty address string is not allowed",
+		},
+		{
+			name: "invalid administrator",
+			msg:  MsgCollectEscrowRewardsRequest{Denom: denom, ValidatorAddress: val, Administrator: ""},
+			exp:  "invalid administrator: empty address string is not allowed",
 		},
 	}
 
-	for _, tc := range testCases {
-		tc := tc
+	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
-			msg := NewMsgSetAdministratorProposalRequest(tc.denom, tc.accessGrant, tc.authority)
-
-			err := msg.ValidateBasic()
-			if tc.expectError {
-				require.Error(t, err)
-				require.EqualError(t, err, tc.expectedError, "ValidateBasic error")
+			err := tc.msg.ValidateBasic()
+			if len(tc.exp) > 0 {
+				require.EqualErrorf(t, err, tc.exp, "ValidateBasic error")
 			} else {
 				require.NoError(t, err, "ValidateBasic error")
 			}
@@ -950,12 +1061,1061 @@ func TestMsgSetAdministratorProposalRequestValidateBasic(t *testing.T) {
 	}
 }
 
-func TestMsgRemoveAdministratorProposalRequestValidateBasic(t *testing.T) {
-	validAuthority := "cosmos1sh49f6ze3vn7cdl2amh2gnc70z5mten3y08xck"
-	invalidAuthority := "invalidauth0000"
-
-	validRemovedAddress := "cosmos1nph3cfzk6trsmfxkeu943nvach5qw4vwstnvkl"
-	invalidRemovedAddress := "invalidremoved000"
+func TestMsgSetMintAllowanceRequestValidateBasic(t *testing.T) {
+	addr := sdk.AccAddress("addr________________").String()
+	minter := sdk.AccAddress("minter______________").String()
+	amount := sdk.NewInt64Coin("somedenom", 100)
+
+	tests := []struct {
+		name string
+		msg  MsgSetMintAllowanceRequest
+		exp  string
+	}{
+		{
+			name: "control",
+			msg:  MsgSetMintAllowanceRequest{Amount: amount, Minter: minter, Administrator: addr},
+			exp:  "",
+		},
+		{
+			name: "zero amount",
+			msg:  MsgSetMintAllowanceRequest{Amount: sdk.NewInt64Coin("somedenom", 0), Minter: minter, Administrator: addr},
+			exp:  "",
+		},
+		{
+			name: "invalid denom",
+			msg:  MsgSetMintAllowanceRequest{Amount: sdk.Coin{Denom: "x", Amount: sdkmath.NewInt(1)}, Minter: minter, Administrator: addr},
+			exp:  "invalid amount: invalid denom: x",
+		},
+		{
+			name: "negative amount",
+			msg:  MsgSetMintAllowanceRequest{Amount: sdk.Coin{Denom: "somedenom", Amount: sdkmath.NewInt(-1)}, Minter: minter, Administrator: addr},
+			exp:  "invalid amount: negative coin amount: -1",
+		},
+		{
+			name: "invalid minter",
+			msg:  MsgSetMintAllowanceRequest{Amount: amount, Minter: "", Administrator: addr},
+			exp:  "invalid minter: empty address string is not allowed",
+		},
+		{
+			name: "invalid administrator",
+			msg:  MsgSetMintAllowanceRequest{Amount: amount, Minter: minter, Administrator: "not1validsigner"},
+			exp:  "invalid administrator: decoding bech32 failed: invalid character not part of charset: 105",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if len(tc.exp) > 0 {
+				require.EqualErrorf(t, err, tc.exp, "ValidateBasic error")
+			} else {
+				require.NoError(t, err, "ValidateBasic error")
+			}
+		})
+	}
+}
+
+func TestMsgMintFromAllowanceRequestValidateBasic(t *testing.T) {
+	minter := sdk.AccAddress("minter______________").String()
+
+	tests := []struct {
+		name string
+		msg  MsgMintFromAllowanceRequest
+		exp  string
+	}{
+		{
+			name: "control",
+			msg:  MsgMintFromAllowanceRequest{Amount: sdk.NewInt64Coin("somedenom", 10), Minter: minter},
+			exp:  "",
+		},
+		{
+			name: "zero amount",
+			msg:  MsgMintFromAllowanceRequest{Amount: sdk.NewInt64Coin("somedenom", 0), Minter: minter},
+			exp:  "invalid amount: 0somedenom must be positive",
+		},
+		{
+			name: "invalid amount denom",
+			msg:  MsgMintFromAllowanceRequest{Amount: sdk.Coin{Denom: "x", Amount: sdkmath.NewInt(1)}, Minter: minter},
+			exp:  "invalid amount: invalid denom: x",
+		},
+		{
+			name: "invalid minter",
+			msg:  MsgMintFromAllowanceRequest{Amount: sdk.NewInt64Coin("somedenom", 10), Minter: ""},
+			exp:  "invalid minter: empty address string is not allowed",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if len(tc.exp) > 0 {
+				require.EqualErrorf(t, err, tc.exp, "ValidateBasic error")
+			} else {
+				require.NoError(t, err, "ValidateBasic error")
+			}
+		})
+	}
+}
+
+func TestMsgScheduleDistributionRequestValidateBasic(t *testing.T) {
+	addr := sdk.AccAddress("addr________________").String()
+	amount := sdk.NewInt64Coin("paydenom", 100)
+	newMsg := func(modify func(msg *MsgScheduleDistributionRequest)) MsgScheduleDistributionRequest {
+		msg := MsgScheduleDistributionRequest{Denom: "somedenom", Amount: amount, Administrator: addr}
+		if modify != nil {
+			modify(&msg)
+		}
+		return msg
+	}
+
+	tests := []struct {
+		name string
+		msg  MsgScheduleDistributionRequest
+		exp  string
+	}{
+		{
+			name: "control",
+			msg:  newMsg(nil),
+			exp:  "",
+		},
+		{
+			name: "invalid denom",
+			msg:  newMsg(func(msg *MsgScheduleDistributionRequest) { msg.Denom = "x" }),
+			exp:  "invalid denom: invalid denom: x",
+		},
+		{
+			name: "zero amount",
+			msg:  newMsg(func(msg *MsgScheduleDistributionRequest) { msg.Amount = sdk.NewInt64Coin("paydenom", 0) }),
+			exp:  "invalid amount: 0paydenom must be positive",
+		},
+		{
+			name: "amount is the marker's coin",
+			msg:  newMsg(func(msg *MsgScheduleDistributionRequest) { msg.Amount = sdk.NewInt64Coin(msg.Denom, 10) }),
+			exp:  "invalid amount: somedenom cannot be distributed to its own holders",
+		},
+		{
+			name: "negative start height",
+			msg:  newMsg(func(msg *MsgScheduleDistributionRequest) { msg.StartHeight = -1 }),
+			exp:  "invalid start height: -1 cannot be negative",
+		},
+		{
+			name: "batch size too large",
+			msg:  newMsg(func(msg *MsgScheduleDistributionRequest) { msg.BatchSize = MaxDistributionBatchSize + 1 }),
+			exp:  "invalid batch size: 1001 cannot be more than 1000",
+		},
+		{
+			name: "with min payout",
+			msg: newMsg(func(msg *MsgScheduleDistributionRequest) {
+				minPayout := sdk.NewInt64Coin("paydenom", 5)
+				msg.MinPayout = &minPayout
+			}),
+			exp: "",
+		},
+		{
+			name: "negative min payout",
+			msg: newMsg(func(msg *MsgScheduleDistributionRequest) {
+				msg.MinPayout = &sdk.Coin{Denom: "paydenom", Amount: sdkmath.NewInt(-1)}
+			}),
+			exp: "invalid min payout: negative coin amount: -1",
+		},
+		{
+			name: "min payout denom mismatch",
+			msg: newMsg(func(msg *MsgScheduleDistributionRequest) {
+				minPayout := sdk.NewInt64Coin("otherdenom", 5)
+				msg.MinPayout = &minPayout
+			}),
+			exp: "invalid min payout: denom \"otherdenom\" does not match amount denom \"paydenom\"",
+		},
+		{
+			name: "invalid administrator",
+			msg:  newMsg(func(msg *MsgScheduleDistributionRequest) { msg.Administrator = "" }),
+			exp:  "invalid administrator: empty address string is not allowed",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if len(tc.exp) > 0 {
+				require.EqualErrorf(t, err, tc.exp, "ValidateBasic error")
+			} else {
+				require.NoError(t, err, "ValidateBasic error")
+			}
+		})
+	}
+}
+
+func TestMsgClaimDistributionRequestValidateBasic(t *testing.T) {
+	claimant := sdk.AccAddress("claimant____________").String()
+
+	tests := []struct {
+		name string
+		msg  MsgClaimDistributionRequest
+		exp  string
+	}{
+		{
+			name: "control",
+			msg:  MsgClaimDistributionRequest{DistributionId: 1, Claimant: claimant},
+			exp:  "",
+		},
+		{
+			name: "zero id",
+			msg:  MsgClaimDistributionRequest{DistributionId: 0, Claimant: claimant},
+			exp:  "invalid distribution id: cannot be zero",
+		},
+		{
+			name: "invalid claimant",
+			msg:  MsgClaimDistributionRequest{DistributionId: 1, Claimant: ""},
+			exp:  "invalid claimant: empty address string is not allowed",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if len(tc.exp) > 0 {
+				require.EqualErrorf(t, err, tc.exp, "ValidateBasic error")
+			} else {
+				require.NoError(t, err, "ValidateBasic error")
+			}
+		})
+	}
+}
+
+func TestMsgWithdrawFromEscrowRequestValidateBasic(t *testing.T) {
+	addr := sdk.AccAddress("addr________________").String()
+	amount := sdk.NewCoins(sdk.NewInt64Coin("paydenom", 100))
+	newMsg := func(modifier func(msg *MsgWithdrawFromEscrowRequest)) MsgWithdrawFromEscrowRequest {
+		msg := MsgWithdrawFromEscrowRequest{Denom: "somedenom", Ledger: "operations", Amount: amount, Administrator: addr}
+		modifier(&msg)
+		return msg
+	}
+
+	tests := []struct {
+		name string
+		msg  MsgWithdrawFromEscrowRequest
+		exp  string
+	}{
+		{
+			name: "control",
+			msg:  newMsg(func(msg *MsgWithdrawFromEscrowRequest) {}),
+			exp:  "",
+		},
+		{
+			name: "with to address",
+			msg:  newMsg(func(msg *MsgWithdrawFromEscrowRequest) { msg.ToAddress = addr }),
+			exp:  "",
+		},
+		{
+			name: "invalid denom",
+			msg:  newMsg(func(msg *MsgWithdrawFromEscrowRequest) { msg.Denom = "x" }),
+			exp:  "invalid denom: x",
+		},
+		{
+			name: "empty ledger",
+			msg:  newMsg(func(msg *MsgWithdrawFromEscrowRequest) { msg.Ledger = "" }),
+			exp:  "escrow ledger name cannot be empty",
+		},
+		{
+			name: "ledger too long",
+			msg:  newMsg(func(msg *MsgWithdrawFromEscrowRequest) { msg.Ledger = strings.Repeat("a", 33) }),
+			exp:  `escrow ledger name "` + strings.Repeat("a", 33) + `" cannot be longer than 32 characters`,
+		},
+		{
+			name: "ledger with invalid characters",
+			msg:  newMsg(func(msg *MsgWithdrawFromEscrowRequest) { msg.Ledger = "Fees" }),
+			exp:  `escrow ledger name "Fees" must start with a lowercase letter or digit and only contain lowercase letters, digits, '.', '_', or '-'`,
+		},
+		{
+			name: "empty amount",
+			msg:  newMsg(func(msg *MsgWithdrawFromEscrowRequest) { msg.Amount = nil }),
+			exp:  "invalid amount: cannot be empty",
+		},
+		{
+			name: "invalid amount",
+			msg: newMsg(func(msg *MsgWithdrawFromEscrowRequest) {
+				msg.Amount = sdk.Coins{sdk.Coin{Denom: "paydenom", Amount: sdkmath.NewInt(-1)}}
+			}),
+			exp: "invalid amount: coin -1paydenom amount is not positive",
+		},
+		{
+			name: "invalid to address",
+			msg:  newMsg(func(msg *MsgWithdrawFromEscrowRequest) { msg.ToAddress = "bad" }),
+			exp:  "invalid to address: decoding bech32 failed: invalid bech32 string length 3",
+		},
+		{
+			name: "invalid administrator",
+			msg:  newMsg(func(msg *MsgWithdrawFromEscrowRequest) { msg.Administrator = "" }),
+			exp:  "invalid administrator: empty address string is not allowed",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if len(tc.exp) > 0 {
+				require.EqualErrorf(t, err, tc.exp, "ValidateBasic error")
+			} else {
+				require.NoError(t, err, "ValidateBasic error")
+			}
+		})
+	}
+}
+
+func TestMsgScheduleBurnRequestValidateBasic(t *testing.T) {
+	addr := sdk.AccAddress("addr________________").String()
+	newMsg := func(modify func(msg *MsgScheduleBurnRequest)) MsgScheduleBurnRequest {
+		msg := MsgScheduleBurnRequest{Amount: sdk.NewInt64Coin("somedenom", 100), BurnHeight: 10, CancelWindow: 5, Administrator: addr}
+		if modify != nil {
+			modify(&msg)
+		}
+		return msg
+	}
+
+	tests := []struct {
+		name string
+		msg  MsgScheduleBurnRequest
+		exp  string
+	}{
+		{
+			name: "control",
+			msg:  newMsg(nil),
+			exp:  "",
+		},
+		{
+			name: "invalid amount",
+			msg:  newMsg(func(msg *MsgScheduleBurnRequest) { msg.Amount = sdk.Coin{Denom: "x", Amount: sdkmath.NewInt(1)} }),
+			exp:  "invalid amount: invalid denom: x",
+		},
+		{
+			name: "zero amount",
+			msg:  newMsg(func(msg *MsgScheduleBurnRequest) { msg.Amount = sdk.NewInt64Coin("somedenom", 0) }),
+			exp:  "invalid amount: 0somedenom must be positive",
+		},
+		{
+			name: "zero burn height",
+			msg:  newMsg(func(msg *MsgScheduleBurnRequest) { msg.BurnHeight = 0 }),
+			exp:  "invalid burn height: 0 must be positive",
+		},
+		{
+			name: "cancel window longer than burn height",
+			msg:  newMsg(func(msg *MsgScheduleBurnRequest) { msg.CancelWindow = 11 }),
+			exp:  "invalid cancel window: 11 blocks cannot close after burn height 10",
+		},
+		{
+			name: "no cancel window",
+			msg:  newMsg(func(msg *MsgScheduleBurnRequest) { msg.CancelWindow = 0 }),
+			exp:  "",
+		},
+		{
+			name: "invalid administrator",
+			msg:  newMsg(func(msg *MsgScheduleBurnRequest) { msg.Administrator = "" }),
+			exp:  "invalid administrator: empty address string is not allowed",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if len(tc.exp) > 0 {
+				require.EqualErrorf(t, err, tc.exp, "ValidateBasic error")
+			} else {
+				require.NoError(t, err, "ValidateBasic error")
+			}
+		})
+	}
+}
+
+func TestMsgCancelScheduledBurnRequestValidateBasic(t *testing.T) {
+	addr := sdk.AccAddress("addr________________").String()
+
+	tests := []struct {
+		name string
+		msg  MsgCancelScheduledBurnRequest
+		exp  string
+	}{
+		{
+			name: "control",
+			msg:  MsgCancelScheduledBurnRequest{BurnId: 1, Administrator: addr},
+			exp:  "",
+		},
+		{
+			name: "zero id",
+			msg:  MsgCancelScheduledBurnRequest{BurnId: 0, Administrator: addr},
+			exp:  "invalid burn id: cannot be zero",
+		},
+		{
+			name: "invalid administrator",
+			msg:  MsgCancelScheduledBurnRequest{BurnId: 1, Administrator: ""},
+			exp:  "invalid administrator: empty address string is not allowed",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if len(tc.exp) > 0 {
+				require.EqualErrorf(t, err, tc.exp, "ValidateBasic error")
+			} else {
+				require.NoError(t, err, "ValidateBasic error")
+			}
+		})
+	}
+}
+
+func TestMsgUpdateSendDenyListRequestValidateBasic(t *testing.T) {
+	addr := sdk.AccAddress("addr________________").String()
+	denom := "somedenom"
+	addAddr := sdk.AccAddress("addAddr________________").String()
+	removeAddr := sdk.AccAddress("removeAddr________________").String()
+
+	tests := []struct {
+		name   string
+		msg    MsgUpdateSendDenyListRequest
+		expErr string
+	}{
+		{
+			name: "should succeed",
+			msg:  MsgUpdateSendDenyListRequest{Denom: denom, RemoveDeniedAddresses: []string{removeAddr}, AddDeniedAddresses: []string{addAddr}, Authority: addr},
+		},
+		{
+			name:   "invalid authority address",
+			msg:    MsgUpdateSendDenyListRequest{Denom: denom, RemoveDeniedAddresses: []string{removeAddr}, AddDeniedAddresses: []string{addAddr}, Authority: "invalid-address"},
+			expErr: "decoding bech32 failed: invalid separator index -1",
+		},
+		{
+			name:   "both add and remove list are empty",
+			msg:    MsgUpdateSendDenyListRequest{Denom: denom, RemoveDeniedAddresses: []string{}, AddDeniedAddresses: []string{}, Authority: addr},
+			expErr: "both add and remove lists cannot be empty",
+		},
+		{
+			name:   "invalid authority address",
+			msg:    MsgUpdateSendDenyListRequest{Denom: denom, RemoveDeniedAddresses: []string{removeAddr}, AddDeniedAddresses: []string{addAddr}, Authority: "invalid-address"},
+			expErr: "decoding bech32 failed: invalid separator index -1",
+		},
+		{
+			name:   "invalid remove address",
+			msg:    MsgUpdateSendDenyListRequest{Denom: denom, RemoveDeniedAddresses: []string{"invalid-address"}, AddDeniedAddresses: []string{}, Authority: addr},
+			expErr: "decoding bech32 failed: invalid separator index -1",
+		},
+		{
+			name:   "invalid add address",
+			msg:    MsgUpdateSendDenyListRequest{Denom: denom, RemoveDeniedAddresses: []string{removeAddr}, AddDeniedAddresses: []string{"invalid-addrs"}, Authority: addr},
+			expErr: "decoding bech32 failed: invalid separator index -1",
+		},
+		{
+			name:   "duplicate entries in list",
+			msg:    MsgUpdateSendDenyListRequest{Denom: denom, RemoveDeniedAddresses: []string{removeAddr, removeAddr}, AddDeniedAddresses: []string{}, Authority: addr},
+			expErr: "denied address lists contain duplicate entries",
+		},
+		{
+			name:   "invalid denom",
+			msg:    MsgUpdateSendDenyListRequest{Denom: "1", RemoveDeniedAddresses: []string{removeAddr}, AddDeniedAddresses: []string{addAddr}, Authority: addr},
+			expErr: "invalid denom: 1",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if len(tc.expErr) > 0 {
+				require.EqualErrorf(t, err, tc.expErr, "ValidateBasic error")
+			} else {
+				require.NoError(t, err, "ValidateBasic error")
+			}
+		})
+	}
+}
+
+func TestMsgUpdateDepositAllowListRequestValidateBasic(t *testing.T) {
+	addr := sdk.AccAddress("addr________________").String()
+	denom := "somedenom"
+	addAddr := sdk.AccAddress("addAddr________________").String()
+	removeAddr := sdk.AccAddress("removeAddr________________").String()
+
+	tests := []struct {
+		name   string
+		msg    MsgUpdateDepositAllowListRequest
+		expErr string
+	}{
+		{
+			name: "should succeed",
+			msg:  MsgUpdateDepositAllowListRequest{Denom: denom, RemoveAllowedAddresses: []string{removeAddr}, AddAllowedAddresses: []string{addAddr}, Administrator: addr},
+		},
+		{
+			name: "only adding",
+			msg:  MsgUpdateDepositAllowListRequest{Denom: denom, AddAllowedAddresses: []string{addAddr}, Administrator: addr},
+		},
+		{
+			name:   "invalid administrator address",
+			msg:    MsgUpdateDepositAllowListRequest{Denom: denom, AddAllowedAddresses: []string{addAddr}, Administrator: "invalid-address"},
+			expErr: "invalid administrator: decoding bech32 failed: invalid separator index -1",
+		},
+		{
+			name:   "both add and remove list are empty",
+			msg:    MsgUpdateDepositAllowListRequest{Denom: denom, Administrator: addr},
+			expErr: "both add and remove lists cannot be empty",
+		},
+		{
+			name:   "invalid remove address",
+			msg:    MsgUpdateDepositAllowListRequest{Denom: denom, RemoveAllowedAddresses: []string{"invalid-address"}, Administrator: addr},
+			expErr: `invalid allowed address "invalid-address": decoding bech32 failed: invalid separator index -1`,
+		},
+		{
+			name:   "invalid add address",
+			msg:    MsgUpdateDepositAllowListRequest{Denom: denom, AddAllowedAddresses: []string{"invalid-addrs"}, Administrator: addr},
+			expErr: `invalid allowed address "invalid-addrs": decoding bech32 failed: invalid separator index -1`,
+		},
+		{
+			name:   "address in both lists",
+			msg:    MsgUpdateDepositAllowListRequest{Denom: denom, RemoveAllowedAddresses: []string{addAddr}, AddAllowedAddresses: []string{addAddr}, Administrator: addr},
+			expErr: fmt.Sprintf("allowed address lists contain duplicate entry %q", addAddr),
+		},
+		{
+			name:   "invalid denom",
+			msg:    MsgUpdateDepositAllowListRequest{Denom: "1", AddAllowedAddresses: []string{addAddr}, Administrator: addr},
+			expErr: "invalid denom: 1",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if len(tc.expErr) > 0 {
+				require.EqualErrorf(t, err, tc.expErr, "ValidateBasic error")
+			} else {
+				require.NoError(t, err, "ValidateBasic error")
+			}
+		})
+	}
+}
+
+func TestMsgSetFeeSponsorshipRequestValidateBasic(t *testing.T) {
+	addr := sdk.AccAddress("addr________________").String()
+	denom := "somedenom"
+	spendLimit := sdk.NewCoins(sdk.NewInt64Coin("nhash", 100))
+
+	tests := []struct {
+		name   string
+		msg    MsgSetFeeSponsorshipRequest
+		expErr string
+	}{
+		{
+			name: "min balance",
+			msg:  MsgSetFeeSponsorshipRequest{Sponsorship: NewFeeSponsorship(denom, spendLimit, 0, sdkmath.NewInt(1), nil), Administrator: addr},
+		},
+		{
+			name: "required attributes",
+			msg:  MsgSetFeeSponsorshipRequest{Sponsorship: NewFeeSponsorship(denom, spendLimit, time.Hour, sdkmath.ZeroInt(), []string{"kyc.pb"}), Administrator: addr},
+		},
+		{
+			name:   "invalid denom",
+			msg:    MsgSetFeeSponsorshipRequest{Sponsorship: NewFeeSponsorship("1", spendLimit, 0, sdkmath.NewInt(1), nil), Administrator: addr},
+			expErr: "invalid fee sponsorship denom: invalid denom: 1",
+		},
+		{
+			name:   "empty spend limit",
+			msg:    MsgSetFeeSponsorshipRequest{Sponsorship: NewFeeSponsorship(denom, nil, 0, sdkmath.NewInt(1), nil), Administrator: addr},
+			expErr: "invalid somedenom fee sponsorship spend limit: cannot be empty",
+		},
+		{
+			name:   "negative grant duration",
+			msg:    MsgSetFeeSponsorshipRequest{Sponsorship: NewFeeSponsorship(denom, spendLimit, -time.Hour, sdkmath.NewInt(1), nil), Administrator: addr},
+			expErr: "invalid somedenom fee sponsorship grant duration -1h0m0s: cannot be negative",
+		},
+		{
+			name:   "no criteria",
+			msg:    MsgSetFeeSponsorshipRequest{Sponsorship: NewFeeSponsorship(denom, spendLimit, 0, sdkmath.ZeroInt(), nil), Administrator: addr},
+			expErr: "invalid somedenom fee sponsorship: must have a min balance or required attributes",
+		},
+		{
+			name:   "duplicate required attribute",
+			msg:    MsgSetFeeSponsorshipRequest{Sponsorship: NewFeeSponsorship(denom, spendLimit, 0, sdkmath.ZeroInt(), []string{"kyc.pb", "kyc.pb"}), Administrator: addr},
+			expErr: `invalid somedenom fee sponsorship: duplicate required attribute "kyc.pb"`,
+		},
+		{
+			name:   "invalid administrator address",
+			msg:    MsgSetFeeSponsorshipRequest{Sponsorship: NewFeeSponsorship(denom, spendLimit, 0, sdkmath.NewInt(1), nil), Administrator: "invalid-address"},
+			expErr: "invalid administrator: decoding bech32 failed: invalid separator index -1",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if len(tc.expErr) > 0 {
+				require.EqualErrorf(t, err, tc.expErr, "ValidateBasic error")
+			} else {
+				require.NoError(t, err, "ValidateBasic error")
+			}
+		})
+	}
+}
+
+func TestMsgSetBridgeInfoRequestValidateBasic(t *testing.T) {
+	addr := sdk.AccAddress("addr________________").String()
+	denom := "somedenom"
+
+	tests := []struct {
+		name   string
+		msg    MsgSetBridgeInfoRequest
+		expErr string
+	}{
+		{
+			name: "should succeed",
+			msg:  MsgSetBridgeInfoRequest{BridgeInfo: NewBridgeInfo(denom, "ethereum", "0xabc", "0xdef"), Administrator: addr},
+		},
+		{
+			name:   "invalid denom",
+			msg:    MsgSetBridgeInfoRequest{BridgeInfo: NewBridgeInfo("1", "ethereum", "0xabc", "0xdef"), Administrator: addr},
+			expErr: "invalid bridge info denom: invalid denom: 1",
+		},
+		{
+			name:   "empty origin chain",
+			msg:    MsgSetBridgeInfoRequest{BridgeInfo: NewBridgeInfo(denom, "", "0xabc", "0xdef"), Administrator: addr},
+			expErr: "invalid somedenom bridge info origin chain: cannot be empty",
+		},
+		{
+			name:   "empty origin asset id",
+			msg:    MsgSetBridgeInfoRequest{BridgeInfo: NewBridgeInfo(denom, "ethereum", "", "0xdef"), Administrator: addr},
+			expErr: "invalid somedenom bridge info origin asset id: cannot be empty",
+		},
+		{
+			name:   "custodian too long",
+			msg:    MsgSetBridgeInfoRequest{BridgeInfo: NewBridgeInfo(denom, "ethereum", "0xabc", strings.Repeat("x", 257)), Administrator: addr},
+			expErr: "invalid somedenom bridge info custodian: length 257 exceeds max 256",
+		},
+		{
+			name:   "invalid administrator address",
+			msg:    MsgSetBridgeInfoRequest{BridgeInfo: NewBridgeInfo(denom, "ethereum", "0xabc", "0xdef"), Administrator: "invalid-address"},
+			expErr: "invalid administrator: decoding bech32 failed: invalid separator index -1",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if len(tc.expErr) > 0 {
+				require.EqualErrorf(t, err, tc.expErr, "ValidateBasic error")
+			} else {
+				require.NoError(t, err, "ValidateBasic error")
+			}
+		})
+	}
+}
+
+func TestMsgAttestedMintRequestValidateBasic(t *testing.T) {
+	addr := sdk.AccAddress("addr________________").String()
+	amount := sdk.NewInt64Coin("somedenom", 100)
+
+	tests := []struct {
+		name   string
+		msg    MsgAttestedMintRequest
+		expErr string
+	}{
+		{
+			name: "should succeed",
+			msg:  *NewMsgAttestedMintRequest(amount, "0x1234", addr),
+		},
+		{
+			name:   "zero amount",
+			msg:    *NewMsgAttestedMintRequest(sdk.NewInt64Coin("somedenom", 0), "0x1234", addr),
+			expErr: "invalid amount 0somedenom: must be positive",
+		},
+		{
+			name:   "empty reference",
+			msg:    *NewMsgAttestedMintRequest(amount, "", addr),
+			expErr: "reference cannot be empty",
+		},
+		{
+			name:   "reference too long",
+			msg:    *NewMsgAttestedMintRequest(amount, strings.Repeat("x", 257), addr),
+			expErr: "reference length 257 exceeds max 256",
+		},
+		{
+			name:   "invalid administrator address",
+			msg:    *NewMsgAttestedMintRequest(amount, "0x1234", "invalid-address"),
+			expErr: "invalid administrator: decoding bech32 failed: invalid separator index -1",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if len(tc.expErr) > 0 {
+				require.EqualErrorf(t, err, tc.expErr, "ValidateBasic error")
+			} else {
+				require.NoError(t, err, "ValidateBasic error")
+			}
+		})
+	}
+}
+
+func TestMsgSetBasketInfoRequestValidateBasic(t *testing.T) {
+	addr := sdk.AccAddress("addr________________").String()
+	denom := "somedenom"
+	components := sdk.NewCoins(sdk.NewInt64Coin("acoin", 5), sdk.NewInt64Coin("bcoin", 2))
+	one := sdkmath.OneInt()
+
+	tooMany := sdk.NewCoins()
+	for i := 0; i <= MaxBasketComponents; i++ {
+		tooMany = tooMany.Add(sdk.NewInt64Coin(fmt.Sprintf("coin%d", i), 1))
+	}
+
+	tests := []struct {
+		name   string
+		msg    MsgSetBasketInfoRequest
+		expErr string
+	}{
+		{
+			name: "should succeed",
+			msg:  *NewMsgSetBasketInfoRequest(NewBasketInfo(denom, components, one), addr),
+		},
+		{
+			name:   "invalid denom",
+			msg:    *NewMsgSetBasketInfoRequest(NewBasketInfo("1", components, one), addr),
+			expErr: "invalid basket info denom: invalid denom: 1",
+		},
+		{
+			name:   "no components",
+			msg:    *NewMsgSetBasketInfoRequest(NewBasketInfo(denom, nil, one), addr),
+			expErr: "invalid somedenom basket info components: cannot be empty",
+		},
+		{
+			name:   "too many components",
+			msg:    *NewMsgSetBasketInfoRequest(NewBasketInfo(denom, tooMany, one), addr),
+			expErr: "invalid somedenom basket info components: count 21 exceeds max 20",
+		},
+		{
+			name:   "basket denom as a component",
+			msg:    *NewMsgSetBasketInfoRequest(NewBasketInfo(denom, components.Add(sdk.NewInt64Coin(denom, 1)), one), addr),
+			expErr: "invalid somedenom basket info components: cannot include the basket denom",
+		},
+		{
+			name:   "zero basket amount",
+			msg:    *NewMsgSetBasketInfoRequest(NewBasketInfo(denom, components, sdkmath.ZeroInt()), addr),
+			expErr: "invalid somedenom basket info basket amount 0: must be positive",
+		},
+		{
+			name:   "invalid administrator address",
+			msg:    *NewMsgSetBasketInfoRequest(NewBasketInfo(denom, components, one), "invalid-address"),
+			expErr: "invalid administrator: decoding bech32 failed: invalid separator index -1",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if len(tc.expErr) > 0 {
+				require.EqualErrorf(t, err, tc.expErr, "ValidateBasic error")
+			} else {
+				require.NoError(t, err, "ValidateBasic error")
+			}
+		})
+	}
+}
+
+func TestMsgSetIBCMemoActionConfigRequestValidateBasic(t *testing.T) {
+	addr := sdk.AccAddress("addr________________").String()
+	denom := "somedenom"
+	attr := NewIBCMemoAction(IBCMemoActionAttribute, "subscribed.example", "")
+
+	tooMany := make([]IBCMemoAction, MaxIBCMemoActions+1)
+	for i := range tooMany {
+		tooMany[i] = NewIBCMemoAction(IBCMemoActionAttribute, fmt.Sprintf("name%d.example", i), "")
+	}
+
+	tests := []struct {
+		name   string
+		msg    MsgSetIBCMemoActionConfigRequest
+		expErr string
+	}{
+		{
+			name: "should succeed",
+			msg:  *NewMsgSetIBCMemoActionConfigRequest(NewIBCMemoActionConfig(denom, attr, NewIBCMemoAction("wasm", addr, `{"a":1}`)), addr),
+		},
+		{
+			name: "no actions",
+			msg:  *NewMsgSetIBCMemoActionConfigRequest(NewIBCMemoActionConfig(denom), addr),
+		},
+		{
+			name:   "invalid denom",
+			msg:    *NewMsgSetIBCMemoActionConfigRequest(NewIBCMemoActionConfig("1", attr), addr),
+			expErr: "invalid ibc memo action config denom: invalid denom: 1",
+		},
+		{
+			name:   "too many actions",
+			msg:    *NewMsgSetIBCMemoActionConfigRequest(NewIBCMemoActionConfig(denom, tooMany...), addr),
+			expErr: "invalid somedenom ibc memo actions: count 21 exceeds max 20",
+		},
+		{
+			name:   "empty action",
+			msg:    *NewMsgSetIBCMemoActionConfigRequest(NewIBCMemoActionConfig(denom, attr, NewIBCMemoAction(" ", "x", "")), addr),
+			expErr: "invalid somedenom ibc memo action[1]: action cannot be empty",
+		},
+		{
+			name:   "empty target",
+			msg:    *NewMsgSetIBCMemoActionConfigRequest(NewIBCMemoActionConfig(denom, NewIBCMemoAction("wasm", "", "")), addr),
+			expErr: `invalid somedenom ibc memo action[0]: "wasm" action target cannot be empty`,
+		},
+		{
+			name:   "duplicate action and target",
+			msg:    *NewMsgSetIBCMemoActionConfigRequest(NewIBCMemoActionConfig(denom, attr, NewIBCMemoAction(IBCMemoActionAttribute, "subscribed.example", "gold")), addr),
+			expErr: `invalid somedenom ibc memo actions: duplicate "attribute" action with target "subscribed.example"`,
+		},
+		{
+			name:   "invalid administrator address",
+			msg:    *NewMsgSetIBCMemoActionConfigRequest(NewIBCMemoActionConfig(denom, attr), "invalid-address"),
+			expErr: "invalid administrator: decoding bech32 failed: invalid separator index -1",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if len(tc.expErr) > 0 {
+				require.EqualErrorf(t, err, tc.expErr, "ValidateBasic error")
+			} else {
+				require.NoError(t, err, "ValidateBasic error")
+			}
+		})
+	}
+}
+
+func TestMsgBasketDepositAndWithdrawRequestValidateBasic(t *testing.T) {
+	addr := sdk.AccAddress("addr________________").String()
+	amount := sdk.NewInt64Coin("somedenom", 10)
+	zero := sdk.NewInt64Coin("somedenom", 0)
+
+	tests := []struct {
+		name   string
+		msg    sdk.HasValidateBasic
+		expErr string
+	}{
+		{
+			name: "deposit: should succeed",
+			msg:  NewMsgBasketDepositRequest(amount, addr),
+		},
+		{
+			name:   "deposit: zero amount",
+			msg:    NewMsgBasketDepositRequest(zero, addr),
+			expErr: "invalid amount 0somedenom: must be positive",
+		},
+		{
+			name:   "deposit: invalid depositor",
+			msg:    NewMsgBasketDepositRequest(amount, "invalid-address"),
+			expErr: "invalid depositor: decoding bech32 failed: invalid separator index -1",
+		},
+		{
+			name: "withdraw: should succeed",
+			msg:  NewMsgBasketWithdrawRequest(amount, addr),
+		},
+		{
+			name:   "withdraw: zero amount",
+			msg:    NewMsgBasketWithdrawRequest(zero, addr),
+			expErr: "invalid amount 0somedenom: must be positive",
+		},
+		{
+			name:   "withdraw: invalid holder",
+			msg:    NewMsgBasketWithdrawRequest(amount, "invalid-address"),
+			expErr: "invalid holder: decoding bech32 failed: invalid separator index -1",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if len(tc.expErr) > 0 {
+				require.EqualErrorf(t, err, tc.expErr, "ValidateBasic error")
+			} else {
+				require.NoError(t, err, "ValidateBasic error")
+			}
+		})
+	}
+}
+
+func TestMsgAddNetAssetValueValidateBasic(t *testing.T) {
+	addr := sdk.AccAddress("addr________________").String()
+	denom := "somedenom"
+	netAssetValue1 := NetAssetValue{Price: sdk.NewInt64Coin("jackthecat", 100), Volume: uint64(100)}
+	netAssetValue2 := NetAssetValue{Price: sdk.NewInt64Coin("hotdog", 100), Volume: uint64(100)}
+	invalidNetAssetValue := NetAssetValue{Price: sdk.NewInt64Coin("hotdog", 100), Volume: uint64(0)}
+	invalidNetAssetValue2 := NetAssetValue{Price: sdk.NewInt64Coin("hotdog", 100), Volume: uint64(1), UpdatedBlockHeight: 1}
+
+	tests := []struct {
+		name   string
+		msg    MsgAddNetAssetValuesRequest
+		expErr string
+	}{
+		{
+			name: "should succeed",
+			msg:  MsgAddNetAssetValuesRequest{Denom: denom, NetAssetValues: []NetAssetValue{netAssetValue1, netAssetValue2}, Administrator: addr},
+		},
+		{
+			name:   "block height is set",
+			msg:    MsgAddNetAssetValuesRequest{Denom: denom, NetAssetValues: []NetAssetValue{invalidNetAssetValue2}, Administrator: addr},
+			expErr: "marker net asset value must not have update height set",
+		},
+		{
+			name:   "validation of net asset value failure",
+			msg:    MsgAddNetAssetValuesRequest{Denom: denom, NetAssetValues: []NetAssetValue{invalidNetAssetValue}, Administrator: addr},
+			expErr: "marker net asset value volume must be positive value",
+		},
+		{
+			name:   "duplicate net asset values",
+			msg:    MsgAddNetAssetValuesRequest{Denom: denom, NetAssetValues: []NetAssetValue{netAssetValue1, netAssetValue2, netAssetValue2}, Administrator: addr},
+			expErr: "list of net asset values contains duplicates",
+		},
+		{
+			name:   "invalid denom",
+			msg:    MsgAddNetAssetValuesRequest{Denom: "", NetAssetValues: []NetAssetValue{netAssetValue1, netAssetValue2, netAssetValue2}, Administrator: addr},
+			expErr: "invalid denom: ",
+		},
+		{
+			name:   "invalid administrator address",
+			msg:    MsgAddNetAssetValuesRequest{Denom: denom, NetAssetValues: []NetAssetValue{netAssetValue1, netAssetValue2}, Administrator: "invalid address"},
+			expErr: "decoding bech32 failed: invalid character in string: ' '",
+		},
+		{
+			name:   "empty net asset list",
+			msg:    MsgAddNetAssetValuesRequest{Denom: denom, NetAssetValues: []NetAssetValue{}, Administrator: addr},
+			expErr: "net asset value list cannot be empty",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if len(tc.expErr) > 0 {
+				require.EqualErrorf(t, err, tc.expErr, "ValidateBasic error")
+			} else {
+				require.NoError(t, err, "ValidateBasic error")
+			}
+		})
+	}
+}
+
+func TestMsgSupplyDecreaseProposalRequestValidateBasic(t *testing.T) {
+	validAddress := "cosmos1sh49f6ze3vn7cdl2amh2gnc70z5mten3y08xck"
+	invalidAddress := "invalidaddr0000"
+
+	testCases := []struct {
+		name          string
+		authority     string
+		amount        sdk.Coin
+		expectError   bool
+		expectedError string
+	}{
+		{
+			name:          "valid input",
+			authority:     validAddress,
+			amount:        sdk.NewInt64Coin("testcoin", 100),
+			expectError:   false,
+			expectedError: "",
+		},
+		{
+			name:          "negative amount",
+			authority:     validAddress,
+			amount:        sdk.Coin{Denom: "testcoin", Amount: sdkmath.NewInt(-100)},
+			expectError:   true,
+			expectedError: "amount to decrease must be greater than zero",
+		},
+		{
+			name:          "invalid authority address",
+			authority:     invalidAddress,
+			amount:        sdk.NewInt64Coin("testcoin", 100),
+			expectError:   true,
+			expectedError: "decoding bech32 failed: invalid separator index -1",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			msg := MsgSupplyDecreaseProposalRequest{
+				Authority: tc.authority,
+				Amount:    tc.amount,
+			}
+
+			err := msg.ValidateBasic()
+			if tc.expectError {
+				require.Error(t, err)
+				require.EqualError(t, err, tc.expectedError, "ValidateBasic error")
+			} else {
+				require.NoError(t, err, "ValidateBasic error")
+			}
+		})
+	}
+}
+
+func TestMsgSetAdministratorProposalRequestValidateBasic(t *testing.T) {
+	validAddress := "cosmos1sh49f6ze3vn7cdl2amh2gnc70z5mten3y08xck"
+	invalidAddress := "invalidaddr0000"
+
+	validAccessGrant := AccessGrant{
+		Address:     validAddress,
+		Permissions: []Access{Access_Admin},
+	}
+	invalidAccessGrant := AccessGrant{
+		Address:     "invalidaddress",
+		Permissions: []Access{Access_Admin},
+	}
+
+	testCases := []struct {
+		name          string
+		denom         string
+		accessGrant   []AccessGrant
+		authority     string
+		expectError   bool
+		expectedError string
+	}{
+		{
+			name:        "valid case",
+			denom:       "testcoin",
+			accessGrant: []AccessGrant{validAccessGrant},
+			authority:   validAddress,
+			expectError: false,
+		},
+		{
+			name:          "invalid authority address",
+			denom:         "testcoin",
+			accessGrant:   []AccessGrant{validAccessGrant},
+			authority:     invalidAddress,
+			expectError:   true,
+			expectedError: "decoding bech32 failed: invalid separator index -1",
+		},
+		{
+			name:          "invalid access grant",
+			denom:         "testcoin",
+			accessGrant:   []AccessGrant{invalidAccessGrant},
+			authority:     validAddress,
+			expectError:   true,
+			expectedError: "invalid access grant for administrator: invalid address: decoding bech32 failed: invalid separator index -1",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			msg := NewMsgSetAdministratorProposalRequest(tc.denom, tc.accessGrant, tc.authority)
+
+			err := msg.ValidateBasic()
+			if tc.expectError {
+				require.Error(t, err)
+				require.EqualError(t, err, tc.expectedError, "ValidateBasic error")
+			} else {
+				require.NoError(t, err, "ValidateBasic error")
+			}
+		})
+	}
+}
+
+func TestMsgRemoveAdministratorProposalRequestValidateBasic(t *testing.T) {
+	validAuthority := "cosmos1sh49f6ze3vn7cdl2amh2gnc70z5mten3y08xck"
+	invalidAuthority := "invalidauth0000"
+
+	validRemovedAddress := "cosmos1nph3cfzk6trsmfxkeu943nvach5qw4vwstnvkl"
+	invalidRemovedAddress := "invalidremoved000"
 
 	testCases := []struct {
 		name           string
@@ -1283,3 +2443,217 @@ func TestMsgUpdateParamsRequestValidateBasic(t *testing.T) {
 		})
 	}
 }
+
+func TestMsgUpdateSendRestrictionBypassesRequestValidateBasic(t *testing.T) {
+	authority := sdk.AccAddress("authority___________").String()
+	addr1 := sdk.AccAddress("addr1_______________").String()
+	addr2 := sdk.AccAddress("addr2_______________").String()
+	bypass := func(addr string, bypassType SendRestrictionBypassType) SendRestrictionBypass {
+		return SendRestrictionBypass{Address: addr, BypassType: bypassType, Reason: "testing"}
+	}
+
+	tests := []struct {
+		name   string
+		msg    MsgUpdateSendRestrictionBypassesRequest
+		expErr string
+	}{
+		{
+			name: "set and remove",
+			msg: MsgUpdateSendRestrictionBypassesRequest{
+				Authority: authority,
+				Set:       []SendRestrictionBypass{bypass(addr1, BypassTypeRequiredAttributes)},
+				Remove:    []string{addr2},
+			},
+		},
+		{
+			name: "invalid authority",
+			msg: MsgUpdateSendRestrictionBypassesRequest{
+				Authority: "bad",
+				Remove:    []string{addr2},
+			},
+			expErr: "invalid authority: decoding bech32 failed: invalid bech32 string length 3",
+		},
+		{
+			name:   "nothing to do",
+			msg:    MsgUpdateSendRestrictionBypassesRequest{Authority: authority},
+			expErr: "at least one send restriction bypass must be set or removed",
+		},
+		{
+			name: "unspecified bypass type",
+			msg: MsgUpdateSendRestrictionBypassesRequest{
+				Authority: authority,
+				Set:       []SendRestrictionBypass{bypass(addr1, BypassTypeUnspecified)},
+			},
+			expErr: "invalid send restriction bypass type for " + addr1 + ": SEND_RESTRICTION_BYPASS_TYPE_UNSPECIFIED",
+		},
+		{
+			name: "unknown bypass type",
+			msg: MsgUpdateSendRestrictionBypassesRequest{
+				Authority: authority,
+				Set:       []SendRestrictionBypass{bypass(addr1, 5)},
+			},
+			expErr: "invalid send restriction bypass type for " + addr1 + ": 5",
+		},
+		{
+			name: "invalid address to set",
+			msg: MsgUpdateSendRestrictionBypassesRequest{
+				Authority: authority,
+				Set:       []SendRestrictionBypass{bypass("bad", BypassTypeSender)},
+			},
+			expErr: "invalid send restriction bypass address \"bad\": decoding bech32 failed: invalid bech32 string length 3",
+		},
+		{
+			name: "duplicate address to set",
+			msg: MsgUpdateSendRestrictionBypassesRequest{
+				Authority: authority,
+				Set:       []SendRestrictionBypass{bypass(addr1, BypassTypeSender), bypass(addr1, BypassTypeRequiredAttributes)},
+			},
+			expErr: "duplicate send restriction bypass address " + addr1,
+		},
+		{
+			name: "invalid address to remove",
+			msg: MsgUpdateSendRestrictionBypassesRequest{
+				Authority: authority,
+				Remove:    []string{"bad"},
+			},
+			expErr: "invalid address to remove \"bad\": decoding bech32 failed: invalid bech32 string length 3",
+		},
+		{
+			name: "duplicate address to remove",
+			msg: MsgUpdateSendRestrictionBypassesRequest{
+				Authority: authority,
+				Remove:    []string{addr2, addr2},
+			},
+			expErr: "duplicate address to remove " + addr2,
+		},
+		{
+			name: "set and remove same address",
+			msg: MsgUpdateSendRestrictionBypassesRequest{
+				Authority: authority,
+				Set:       []SendRestrictionBypass{bypass(addr1, BypassTypeSender)},
+				Remove:    []string{addr1},
+			},
+			expErr: "cannot both set and remove send restriction bypass for " + addr1,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if len(tc.expErr) > 0 {
+				require.EqualError(t, err, tc.expErr, "ValidateBasic")
+			} else {
+				require.NoError(t, err, "ValidateBasic")
+			}
+		})
+	}
+}
+
+func TestMsgChangeMarkerTypeProposalRequestValidateBasic(t *testing.T) {
+	goodAuthority := sdk.AccAddress("goodAddr____________").String()
+	tests := []struct {
+		name   string
+		msg    *MsgChangeMarkerTypeProposalRequest
+		expErr string
+	}{
+		{
+			name:   "invalid denom",
+			msg:    NewMsgChangeMarkerTypeProposalRequest("x", MarkerType_Coin, goodAuthority),
+			expErr: "invalid denom: x",
+		},
+		{
+			name:   "unknown marker type",
+			msg:    NewMsgChangeMarkerTypeProposalRequest("gooddenom", MarkerType_Unknown, goodAuthority),
+			expErr: "invalid new marker type MARKER_TYPE_UNSPECIFIED: must be MARKER_TYPE_COIN or MARKER_TYPE_RESTRICTED",
+		},
+		{
+			name:   "invalid authority",
+			msg:    NewMsgChangeMarkerTypeProposalRequest("gooddenom", MarkerType_Coin, "x"),
+			expErr: "invalid authority: decoding bech32 failed: invalid bech32 string length 1",
+		},
+		{
+			name: "ok to coin",
+			msg:  NewMsgChangeMarkerTypeProposalRequest("gooddenom", MarkerType_Coin, goodAuthority),
+		},
+		{
+			name: "ok to restricted",
+			msg:  NewMsgChangeMarkerTypeProposalRequest("gooddenom", MarkerType_RestrictedCoin, goodAuthority),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if len(tc.expErr) > 0 {
+				require.EqualError(t, err, tc.expErr, "ValidateBasic")
+			} else {
+				require.NoError(t, err, "ValidateBasic")
+			}
+		})
+	}
+}
+
+func TestMsgExchangeRequestValidateBasic(t *testing.T) {
+	partyA := sdk.AccAddress("partyA______________")
+	partyB := sdk.AccAddress("partyB______________")
+	coin := func(amount int64, denom string) sdk.Coin {
+		return sdk.Coin{Denom: denom, Amount: sdkmath.NewInt(amount)}
+	}
+
+	tests := []struct {
+		name   string
+		msg    *MsgExchangeRequest
+		expErr string
+	}{
+		{
+			name: "ok",
+			msg:  NewMsgExchangeRequest(partyA, coin(5, "acoin"), partyB, coin(3, "bcoin")),
+		},
+		{
+			name:   "invalid party a",
+			msg:    &MsgExchangeRequest{PartyA: "x", AmountA: coin(5, "acoin"), PartyB: partyB.String(), AmountB: coin(3, "bcoin")},
+			expErr: "invalid party a: decoding bech32 failed: invalid bech32 string length 1",
+		},
+		{
+			name:   "invalid party b",
+			msg:    &MsgExchangeRequest{PartyA: partyA.String(), AmountA: coin(5, "acoin"), PartyB: "", AmountB: coin(3, "bcoin")},
+			expErr: "invalid party b: empty address string is not allowed",
+		},
+		{
+			name:   "same parties",
+			msg:    NewMsgExchangeRequest(partyA, coin(5, "acoin"), partyA, coin(3, "bcoin")),
+			expErr: "party a and party b cannot be the same account",
+		},
+		{
+			name:   "invalid amount a denom",
+			msg:    NewMsgExchangeRequest(partyA, coin(5, "a"), partyB, coin(3, "bcoin")),
+			expErr: "invalid amount a: invalid denom: a",
+		},
+		{
+			name:   "zero amount a",
+			msg:    NewMsgExchangeRequest(partyA, coin(0, "acoin"), partyB, coin(3, "bcoin")),
+			expErr: `invalid amount a: "0acoin" must be positive`,
+		},
+		{
+			name:   "negative amount b",
+			msg:    NewMsgExchangeRequest(partyA, coin(5, "acoin"), partyB, coin(-3, "bcoin")),
+			expErr: "invalid amount b: negative coin amount: -3",
+		},
+		{
+			name:   "same denoms",
+			msg:    NewMsgExchangeRequest(partyA, coin(5, "acoin"), partyB, coin(3, "acoin")),
+			expErr: `amount a and amount b cannot have the same denom "acoin"`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if len(tc.expErr) > 0 {
+				require.EqualError(t, err, tc.expErr, "ValidateBasic")
+			} else {
+				require.NoError(t, err, "ValidateBasic")
+			}
+		})
+	}
+}
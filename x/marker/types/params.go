@@ -14,6 +14,11 @@ const (
 	DefaultMaxSupply = "100000000000000000000"
 	// DefaultUnrestrictedDenomRegex is a regex that denoms created by normal requests must pass.
 	DefaultUnrestrictedDenomRegex = `[a-zA-Z][a-zA-Z0-9\-\.]{2,83}`
+	// DefaultSupplyHistoryMaxEntries is the number of supply changes to keep in each marker's supply history.
+	DefaultSupplyHistoryMaxEntries = 100
+	// DefaultMaxDistributionHoldersPerBlock is the number of holders processed across all distributions in a block
+	// when the MaxDistributionHoldersPerBlock param is zero.
+	DefaultMaxDistributionHoldersPerBlock = uint32(1000)
 )
 
 // NewParams creates a new parameter object
@@ -31,11 +36,13 @@ func NewParams(
 
 // DefaultParams is the default parameter configuration for the bank module
 func DefaultParams() Params {
-	return NewParams(
+	rv := NewParams(
 		DefaultEnableGovernance,
 		DefaultUnrestrictedDenomRegex,
 		StringToBigInt(DefaultMaxSupply),
 	)
+	rv.SupplyHistoryMaxEntries = DefaultSupplyHistoryMaxEntries
+	return rv
 }
 
 func (p Params) Validate() error {
@@ -43,8 +50,22 @@ func (p Params) Validate() error {
 	if len(exp) > 0 && (exp[0:1] == "^" || exp[len(exp)-1:] == "$") {
 		return fmt.Errorf("invalid parameter, validation regex must not contain anchors ^,$")
 	}
-	_, err := regexp.Compile(fmt.Sprintf(`^%s$`, exp))
-	return err
+	if _, err := regexp.Compile(fmt.Sprintf(`^%s$`, exp)); err != nil {
+		return err
+	}
+	if err := p.DistributionFee.Validate(); err != nil {
+		return fmt.Errorf("invalid distribution fee %q: %w", p.DistributionFee, err)
+	}
+	return nil
+}
+
+// DistributionHoldersPerBlock returns the number of holders that can be processed across all
+// distributions in a block, using the default when the param is zero.
+func (p Params) DistributionHoldersPerBlock() uint32 {
+	if p.MaxDistributionHoldersPerBlock == 0 {
+		return DefaultMaxDistributionHoldersPerBlock
+	}
+	return p.MaxDistributionHoldersPerBlock
 }
 
 func StringToBigInt(val string) sdkmath.Int {
@@ -54,3 +75,22 @@ func StringToBigInt(val string) sdkmath.Int {
 	}
 	return res
 }
+
+const (
+	// FeatureGovernanceControl is the feature name used when governance proposals are allowed for managing markers.
+	FeatureGovernanceControl = "governance-control"
+	// FeatureSupplyHistory is the feature name used when the supply changes of markers are recorded.
+	FeatureSupplyHistory = "supply-history"
+)
+
+// Features returns the names of the optional marker features that are enabled by these params.
+func (p Params) Features() []string {
+	var rv []string
+	if p.EnableGovernance {
+		rv = append(rv, FeatureGovernanceControl)
+	}
+	if p.SupplyHistoryMaxEntries > 0 {
+		rv = append(rv, FeatureSupplyHistory)
+	}
+	return rv
+}
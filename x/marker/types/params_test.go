@@ -8,6 +8,8 @@ import (
 
 	sdkmath "cosmossdk.io/math"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	"github.com/provenance-io/provenance/testutil/assertions"
 )
 
@@ -18,8 +20,12 @@ func TestDefaultParams(t *testing.T) {
 	require.Equal(t, DefaultUnrestrictedDenomRegex, p.UnrestrictedDenomRegex)
 	require.Equal(t, DefaultEnableGovernance, p.EnableGovernance)
 	require.Equal(t, DefaultMaxSupply, p.MaxSupply.String())
+	require.Equal(t, uint32(DefaultSupplyHistoryMaxEntries), p.SupplyHistoryMaxEntries)
 
-	require.True(t, p.Equal(NewParams(DefaultEnableGovernance, DefaultUnrestrictedDenomRegex, StringToBigInt(DefaultMaxSupply))))
+	expected := NewParams(DefaultEnableGovernance, DefaultUnrestrictedDenomRegex, StringToBigInt(DefaultMaxSupply))
+	require.False(t, p.Equal(expected))
+	expected.SupplyHistoryMaxEntries = DefaultSupplyHistoryMaxEntries
+	require.True(t, p.Equal(expected))
 	require.False(t, p.Equal(NewParams(false, DefaultUnrestrictedDenomRegex, StringToBigInt(DefaultMaxSupply))))
 	require.False(t, p.Equal(NewParams(DefaultEnableGovernance, "a-z", StringToBigInt(DefaultMaxSupply))))
 	require.False(t, p.Equal(NewParams(DefaultEnableGovernance, DefaultUnrestrictedDenomRegex, StringToBigInt("1000"))))
@@ -39,7 +45,8 @@ func TestDefaultParams(t *testing.T) {
 func TestParamString(t *testing.T) {
 	expected := `enable_governance:true ` +
 		`unrestricted_denom_regex:"[a-zA-Z][a-zA-Z0-9\\-\\.]{2,83}" ` +
-		`max_supply:"100000000000000000000" `
+		`max_supply:"100000000000000000000" ` +
+		`supply_history_max_entries:100 `
 	p := DefaultParams()
 	actual := p.String()
 	require.Equal(t, expected, actual)
@@ -97,6 +104,20 @@ func TestParamsValidate(t *testing.T) {
 			},
 			expectedErr: "error parsing regexp: missing closing ):",
 		},
+		{
+			name: "valid distribution fee",
+			params: Params{
+				DistributionFee: sdk.NewCoins(sdk.NewInt64Coin("nhash", 100)),
+			},
+			expectedErr: "",
+		},
+		{
+			name: "invalid distribution fee",
+			params: Params{
+				DistributionFee: sdk.Coins{sdk.Coin{Denom: "nhash", Amount: sdkmath.NewInt(-1)}},
+			},
+			expectedErr: `invalid distribution fee "-1nhash"`,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -112,3 +133,10 @@ func TestParamsValidate(t *testing.T) {
 		})
 	}
 }
+
+func TestParamsDistributionHoldersPerBlock(t *testing.T) {
+	p := DefaultParams()
+	require.Equal(t, DefaultMaxDistributionHoldersPerBlock, p.DistributionHoldersPerBlock(), "default params")
+	p.MaxDistributionHoldersPerBlock = 5
+	require.Equal(t, uint32(5), p.DistributionHoldersPerBlock(), "param set to 5")
+}
@@ -10,6 +10,13 @@ const (
 	QueryMarkerAssets = "assets"
 )
 
+const (
+	// DefaultAccountOverviewLimit is the number of entries in each list of an account overview when a limit isn't provided.
+	DefaultAccountOverviewLimit = uint32(100)
+	// MaxAccountOverviewLimit is the largest number of entries that can be requested for each list of an account overview.
+	MaxAccountOverviewLimit = uint32(1000)
+)
+
 // QueryMarkersParams defines the params for the following legacy queries:
 // - 'custom/marker/all'
 type QueryMarkersParams struct {
@@ -0,0 +1,65 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+
+	"github.com/provenance-io/provenance/x/quarantine"
+)
+
+// NewSendRestrictionBypass creates a new entry for the send restriction bypass registry.
+func NewSendRestrictionBypass(addr sdk.AccAddress, bypassType SendRestrictionBypassType, reason string) SendRestrictionBypass {
+	return SendRestrictionBypass{
+		Address:    addr.String(),
+		BypassType: bypassType,
+		Reason:     reason,
+	}
+}
+
+// DefaultSendRestrictionBypasses returns the send restriction bypass registry entries of a new chain.
+func DefaultSendRestrictionBypasses() []SendRestrictionBypass {
+	moduleBypass := func(moduleName string, bypassType SendRestrictionBypassType, reason string) SendRestrictionBypass {
+		return NewSendRestrictionBypass(authtypes.NewModuleAddress(moduleName), bypassType, reason)
+	}
+	return []SendRestrictionBypass{
+		moduleBypass(authtypes.FeeCollectorName, BypassTypeRequiredAttributes, "Allow collecting fees in restricted coins."),
+		moduleBypass(quarantine.ModuleName, BypassTypeRequiredAttributes, "Allow quarantine to hold onto restricted coins."),
+		moduleBypass(govtypes.ModuleName, BypassTypeRequiredAttributes, "Allow restricted coins in deposits."),
+		moduleBypass(distrtypes.ModuleName, BypassTypeRequiredAttributes, "Allow fee denoms to be restricted coins."),
+		moduleBypass(stakingtypes.BondedPoolName, BypassTypeRequiredAttributes, "Allow bond denom to be a restricted coin."),
+		moduleBypass(stakingtypes.NotBondedPoolName, BypassTypeRequiredAttributes, "Allow bond denom to be a restricted coin."),
+		moduleBypass(ibctransfertypes.ModuleName, BypassTypeSender, "Sends from the ibc transfer module are validated elsewhere."),
+	}
+}
+
+// Validate returns an error if this entry in the send restriction bypass registry is not valid.
+func (b SendRestrictionBypass) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(b.Address); err != nil {
+		return fmt.Errorf("invalid send restriction bypass address %q: %w", b.Address, err)
+	}
+	if _, known := SendRestrictionBypassType_name[int32(b.BypassType)]; !known || b.BypassType == BypassTypeUnspecified {
+		return fmt.Errorf("invalid send restriction bypass type for %s: %s", b.Address, b.BypassType)
+	}
+	return nil
+}
+
+// ValidateSendRestrictionBypasses returns an error if any of the provided entries are invalid or share an address.
+func ValidateSendRestrictionBypasses(bypasses []SendRestrictionBypass) error {
+	seen := make(map[string]bool, len(bypasses))
+	for _, bypass := range bypasses {
+		if err := bypass.Validate(); err != nil {
+			return err
+		}
+		if seen[bypass.Address] {
+			return fmt.Errorf("duplicate send restriction bypass address %s", bypass.Address)
+		}
+		seen[bypass.Address] = true
+	}
+	return nil
+}
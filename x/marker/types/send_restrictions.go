@@ -2,6 +2,7 @@ package types
 
 import (
 	"context"
+	"errors"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -61,3 +62,66 @@ func GetTransferAgents[C context.Context](ctx C) []sdk.AccAddress {
 	rv, _ := val.([]sdk.AccAddress)
 	return rv
 }
+
+// The names of the send restrictions reported by the CanTransfer query.
+const (
+	SendRuleBlockedAddress     = "blocked-address"
+	SendRuleSendDisabled       = "send-disabled"
+	SendRuleMarkerWithdraw     = "marker-withdraw"
+	SendRuleMarkerStatus       = "marker-status"
+	SendRuleMarkerDeposit      = "marker-deposit"
+	SendRuleFeeCollector       = "fee-collector"
+	SendRuleSendDenyList       = "send-deny-list"
+	SendRuleTransferPermission = "transfer-permission"
+	SendRuleRequiredAttributes = "required-attributes"
+	SendRuleDistribution       = "distribution-in-progress"
+	SendRuleSanction           = "sanction"
+	SendRuleBalance            = "balance"
+	SendRuleOther              = "other"
+)
+
+// SendRuleError is an error that prevents a send along with the name of the send restriction that caused it.
+// Its message is the same as the underlying error's.
+type SendRuleError struct {
+	Rule string
+	Err  error
+}
+
+var _ error = (*SendRuleError)(nil)
+
+// NewSendRuleError wraps the provided error with the name of the send restriction that caused it.
+// Returns nil if the error is nil.
+func NewSendRuleError(rule string, err error) error {
+	if err == nil {
+		return nil
+	}
+	return &SendRuleError{Rule: rule, Err: err}
+}
+
+// Error returns the message of the underlying error.
+func (e *SendRuleError) Error() string {
+	return e.Err.Error()
+}
+
+// Unwrap returns the underlying error.
+func (e *SendRuleError) Unwrap() error {
+	return e.Err
+}
+
+// Cause returns the underlying error so that its ABCI code is used.
+func (e *SendRuleError) Cause() error {
+	return e.Err
+}
+
+// GetSendRule returns the name of the send restriction that caused the provided error.
+// Returns SendRuleOther if the error is not a SendRuleError, or an empty string if the error is nil.
+func GetSendRule(err error) string {
+	if err == nil {
+		return ""
+	}
+	var ruleErr *SendRuleError
+	if errors.As(err, &ruleErr) {
+		return ruleErr.Rule
+	}
+	return SendRuleOther
+}
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"errors"
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -8,6 +10,8 @@ import (
 
 	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
 
+	cerrs "cosmossdk.io/errors"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -248,3 +252,19 @@ func TestTransferAgentFuncsDoNotModifyProvided(t *testing.T) {
 	assert.Equal(t, expAgents, GetTransferAgents(afterWith), "GetTransferAgents(afterWith) after giving it to WithoutTransferAgents")
 	assert.Nil(t, GetTransferAgents(origCtx), "GetTransferAgents(origCtx) after giving afterWith to WithoutTransferAgents")
 }
+
+func TestGetSendRule(t *testing.T) {
+	baseErr := errors.New("base error")
+	ruleErr := NewSendRuleError(SendRuleSendDenyList, baseErr)
+
+	assert.Nil(t, NewSendRuleError(SendRuleSendDenyList, nil), "NewSendRuleError(nil)")
+	assert.EqualError(t, ruleErr, "base error", "NewSendRuleError")
+	assert.ErrorIs(t, ruleErr, baseErr, "NewSendRuleError")
+	assert.Equal(t, "", GetSendRule(nil), "GetSendRule(nil)")
+	assert.Equal(t, SendRuleOther, GetSendRule(baseErr), "GetSendRule(base error)")
+	assert.Equal(t, SendRuleSendDenyList, GetSendRule(ruleErr), "GetSendRule(rule error)")
+	assert.Equal(t, SendRuleSendDenyList, GetSendRule(fmt.Errorf("wrapped: %w", ruleErr)), "GetSendRule(wrapped rule error)")
+
+	_, code, _ := cerrs.ABCIInfo(NewSendRuleError(SendRuleMarkerDeposit, ErrAccessTypeNotGranted.Wrap("no deposit")), false)
+	assert.Equal(t, ErrAccessTypeNotGranted.ABCICode(), code, "ABCI code of a rule error")
+}
@@ -0,0 +1,51 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewSupplyChange creates a new entry for a marker's supply history.
+func NewSupplyChange(
+	denom string,
+	sequence uint64,
+	blockHeight int64,
+	blockTime time.Time,
+	changeType SupplyChangeType,
+	amount sdkmath.Int,
+	supply sdkmath.Int,
+) SupplyChange {
+	return SupplyChange{
+		Denom:       denom,
+		Sequence:    sequence,
+		BlockHeight: blockHeight,
+		BlockTime:   blockTime,
+		ChangeType:  changeType,
+		Amount:      amount,
+		Supply:      supply,
+	}
+}
+
+// Validate returns error if SupplyChange is not in a valid state
+func (c SupplyChange) Validate() error {
+	if err := sdk.ValidateDenom(c.Denom); err != nil {
+		return fmt.Errorf("invalid supply change denom: %w", err)
+	}
+	if c.Sequence == 0 {
+		return fmt.Errorf("invalid %s supply change: sequence cannot be zero", c.Denom)
+	}
+	if c.ChangeType != SupplyChangeMint && c.ChangeType != SupplyChangeBurn {
+		return fmt.Errorf("invalid %s supply change %d: unknown change type %s", c.Denom, c.Sequence, c.ChangeType)
+	}
+	if c.Amount.IsNil() || !c.Amount.IsPositive() {
+		return fmt.Errorf("invalid %s supply change %d: amount must be positive", c.Denom, c.Sequence)
+	}
+	if c.Supply.IsNil() || c.Supply.IsNegative() {
+		return fmt.Errorf("invalid %s supply change %d: supply cannot be negative", c.Denom, c.Sequence)
+	}
+	return nil
+}
@@ -17,20 +17,24 @@ import (
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	_ "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
 	github_com_cosmos_ibc_go_v8_modules_apps_transfer_types "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -1806,6 +1810,10 @@ type MsgUpdateRequiredAttributesRequest struct {
 	AddRequiredAttributes []string `protobuf:"bytes,3,rep,name=add_required_attributes,json=addRequiredAttributes,proto3" json:"add_required_attributes,omitempty"`
 	// The signer of the message.  Must have transfer authority to marker or be governance module account address.
 	TransferAuthority string `protobuf:"bytes,4,opt,name=transfer_authority,json=transferAuthority,proto3" json:"transfer_authority,omitempty"`
+	// List of required sender attributes to remove from marker.
+	RemoveRequiredSenderAttributes []string `protobuf:"bytes,5,rep,name=remove_required_sender_attributes,json=removeRequiredSenderAttributes,proto3" json:"remove_required_sender_attributes,omitempty"`
+	// List of required sender attributes to add to marker.
+	AddRequiredSenderAttributes []string `protobuf:"bytes,6,rep,name=add_required_sender_attributes,json=addRequiredSenderAttributes,proto3" json:"add_required_sender_attributes,omitempty"`
 }
 
 func (m *MsgUpdateRequiredAttributesRequest) Reset()         { *m = MsgUpdateRequiredAttributesRequest{} }
@@ -1869,6 +1877,20 @@ func (m *MsgUpdateRequiredAttributesRequest) GetTransferAuthority() string {
 	return ""
 }
 
+func (m *MsgUpdateRequiredAttributesRequest) GetRemoveRequiredSenderAttributes() []string {
+	if m != nil {
+		return m.RemoveRequiredSenderAttributes
+	}
+	return nil
+}
+
+func (m *MsgUpdateRequiredAttributesRequest) GetAddRequiredSenderAttributes() []string {
+	if m != nil {
+		return m.AddRequiredSenderAttributes
+	}
+	return nil
+}
+
 // MsgUpdateRequiredAttributesResponse defines the Msg/UpdateRequiredAttributes response type
 type MsgUpdateRequiredAttributesResponse struct {
 }
@@ -2221,25 +2243,31 @@ func (m *MsgUpdateSendDenyListResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateSendDenyListResponse proto.InternalMessageInfo
 
-// MsgAddNetAssetValuesRequest defines the Msg/AddNetAssetValues request type
-type MsgAddNetAssetValuesRequest struct {
-	Denom          string          `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
-	Administrator  string          `protobuf:"bytes,2,opt,name=administrator,proto3" json:"administrator,omitempty"`
-	NetAssetValues []NetAssetValue `protobuf:"bytes,3,rep,name=net_asset_values,json=netAssetValues,proto3" json:"net_asset_values"`
-}
-
-func (m *MsgAddNetAssetValuesRequest) Reset()         { *m = MsgAddNetAssetValuesRequest{} }
-func (m *MsgAddNetAssetValuesRequest) String() string { return proto.CompactTextString(m) }
-func (*MsgAddNetAssetValuesRequest) ProtoMessage()    {}
-func (*MsgAddNetAssetValuesRequest) Descriptor() ([]byte, []int) {
+// MsgUpdateDepositAllowListRequest defines a msg to add/remove addresses on the deposit allow list of a marker.
+// Once a marker has a deposit allow list, only the accounts on it (or with deposit access) can send funds to the marker.
+type MsgUpdateDepositAllowListRequest struct {
+	// The denomination of the marker to update.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// List of bech32 addresses to remove from the deposit allow list.
+	RemoveAllowedAddresses []string `protobuf:"bytes,2,rep,name=remove_allowed_addresses,json=removeAllowedAddresses,proto3" json:"remove_allowed_addresses,omitempty"`
+	// List of bech32 addresses to add to the deposit allow list.
+	AddAllowedAddresses []string `protobuf:"bytes,3,rep,name=add_allowed_addresses,json=addAllowedAddresses,proto3" json:"add_allowed_addresses,omitempty"`
+	// The signer of the message. Must have deposit authority or be the governance module account address.
+	Administrator string `protobuf:"bytes,4,opt,name=administrator,proto3" json:"administrator,omitempty"`
+}
+
+func (m *MsgUpdateDepositAllowListRequest) Reset()         { *m = MsgUpdateDepositAllowListRequest{} }
+func (m *MsgUpdateDepositAllowListRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateDepositAllowListRequest) ProtoMessage()    {}
+func (*MsgUpdateDepositAllowListRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_bcb203fb73175ed3, []int{42}
 }
-func (m *MsgAddNetAssetValuesRequest) XXX_Unmarshal(b []byte) error {
+func (m *MsgUpdateDepositAllowListRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgAddNetAssetValuesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgUpdateDepositAllowListRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgAddNetAssetValuesRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgUpdateDepositAllowListRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2249,55 +2277,62 @@ func (m *MsgAddNetAssetValuesRequest) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *MsgAddNetAssetValuesRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgAddNetAssetValuesRequest.Merge(m, src)
+func (m *MsgUpdateDepositAllowListRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateDepositAllowListRequest.Merge(m, src)
 }
-func (m *MsgAddNetAssetValuesRequest) XXX_Size() int {
+func (m *MsgUpdateDepositAllowListRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgAddNetAssetValuesRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgAddNetAssetValuesRequest.DiscardUnknown(m)
+func (m *MsgUpdateDepositAllowListRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateDepositAllowListRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgAddNetAssetValuesRequest proto.InternalMessageInfo
+var xxx_messageInfo_MsgUpdateDepositAllowListRequest proto.InternalMessageInfo
 
-func (m *MsgAddNetAssetValuesRequest) GetDenom() string {
+func (m *MsgUpdateDepositAllowListRequest) GetDenom() string {
 	if m != nil {
 		return m.Denom
 	}
 	return ""
 }
 
-func (m *MsgAddNetAssetValuesRequest) GetAdministrator() string {
+func (m *MsgUpdateDepositAllowListRequest) GetRemoveAllowedAddresses() []string {
 	if m != nil {
-		return m.Administrator
+		return m.RemoveAllowedAddresses
 	}
-	return ""
+	return nil
 }
 
-func (m *MsgAddNetAssetValuesRequest) GetNetAssetValues() []NetAssetValue {
+func (m *MsgUpdateDepositAllowListRequest) GetAddAllowedAddresses() []string {
 	if m != nil {
-		return m.NetAssetValues
+		return m.AddAllowedAddresses
 	}
 	return nil
 }
 
-// MsgAddNetAssetValuesResponse defines the Msg/AddNetAssetValue response type
-type MsgAddNetAssetValuesResponse struct {
+func (m *MsgUpdateDepositAllowListRequest) GetAdministrator() string {
+	if m != nil {
+		return m.Administrator
+	}
+	return ""
 }
 
-func (m *MsgAddNetAssetValuesResponse) Reset()         { *m = MsgAddNetAssetValuesResponse{} }
-func (m *MsgAddNetAssetValuesResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgAddNetAssetValuesResponse) ProtoMessage()    {}
-func (*MsgAddNetAssetValuesResponse) Descriptor() ([]byte, []int) {
+// MsgUpdateDepositAllowListResponse defines the Msg/UpdateDepositAllowList response type
+type MsgUpdateDepositAllowListResponse struct {
+}
+
+func (m *MsgUpdateDepositAllowListResponse) Reset()         { *m = MsgUpdateDepositAllowListResponse{} }
+func (m *MsgUpdateDepositAllowListResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateDepositAllowListResponse) ProtoMessage()    {}
+func (*MsgUpdateDepositAllowListResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_bcb203fb73175ed3, []int{43}
 }
-func (m *MsgAddNetAssetValuesResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgUpdateDepositAllowListResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgAddNetAssetValuesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgUpdateDepositAllowListResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgAddNetAssetValuesResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgUpdateDepositAllowListResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2307,38 +2342,38 @@ func (m *MsgAddNetAssetValuesResponse) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *MsgAddNetAssetValuesResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgAddNetAssetValuesResponse.Merge(m, src)
+func (m *MsgUpdateDepositAllowListResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateDepositAllowListResponse.Merge(m, src)
 }
-func (m *MsgAddNetAssetValuesResponse) XXX_Size() int {
+func (m *MsgUpdateDepositAllowListResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgAddNetAssetValuesResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgAddNetAssetValuesResponse.DiscardUnknown(m)
+func (m *MsgUpdateDepositAllowListResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateDepositAllowListResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgAddNetAssetValuesResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgUpdateDepositAllowListResponse proto.InternalMessageInfo
 
-// MsgSetAdministratorProposalRequest defines the Msg/SetAdministratorProposal request type
-type MsgSetAdministratorProposalRequest struct {
-	Denom  string        `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
-	Access []AccessGrant `protobuf:"bytes,2,rep,name=access,proto3" json:"access"`
-	// The signer of the message.  Must have admin authority to marker or be governance module account address.
-	Authority string `protobuf:"bytes,3,opt,name=authority,proto3" json:"authority,omitempty"`
+// MsgSetFeeSponsorshipRequest defines a msg to create or update the fee sponsorship of a marker.
+type MsgSetFeeSponsorshipRequest struct {
+	// sponsorship is the fee sponsorship to give the marker. Its denom is the denom of the marker to update.
+	Sponsorship FeeSponsorship `protobuf:"bytes,1,opt,name=sponsorship,proto3" json:"sponsorship"`
+	// The signer of the message. Must have admin authority or be the governance module account address.
+	Administrator string `protobuf:"bytes,2,opt,name=administrator,proto3" json:"administrator,omitempty"`
 }
 
-func (m *MsgSetAdministratorProposalRequest) Reset()         { *m = MsgSetAdministratorProposalRequest{} }
-func (m *MsgSetAdministratorProposalRequest) String() string { return proto.CompactTextString(m) }
-func (*MsgSetAdministratorProposalRequest) ProtoMessage()    {}
-func (*MsgSetAdministratorProposalRequest) Descriptor() ([]byte, []int) {
+func (m *MsgSetFeeSponsorshipRequest) Reset()         { *m = MsgSetFeeSponsorshipRequest{} }
+func (m *MsgSetFeeSponsorshipRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgSetFeeSponsorshipRequest) ProtoMessage()    {}
+func (*MsgSetFeeSponsorshipRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_bcb203fb73175ed3, []int{44}
 }
-func (m *MsgSetAdministratorProposalRequest) XXX_Unmarshal(b []byte) error {
+func (m *MsgSetFeeSponsorshipRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgSetAdministratorProposalRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgSetFeeSponsorshipRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgSetAdministratorProposalRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgSetFeeSponsorshipRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2348,55 +2383,48 @@ func (m *MsgSetAdministratorProposalRequest) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *MsgSetAdministratorProposalRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgSetAdministratorProposalRequest.Merge(m, src)
+func (m *MsgSetFeeSponsorshipRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetFeeSponsorshipRequest.Merge(m, src)
 }
-func (m *MsgSetAdministratorProposalRequest) XXX_Size() int {
+func (m *MsgSetFeeSponsorshipRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgSetAdministratorProposalRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgSetAdministratorProposalRequest.DiscardUnknown(m)
+func (m *MsgSetFeeSponsorshipRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetFeeSponsorshipRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgSetAdministratorProposalRequest proto.InternalMessageInfo
-
-func (m *MsgSetAdministratorProposalRequest) GetDenom() string {
-	if m != nil {
-		return m.Denom
-	}
-	return ""
-}
+var xxx_messageInfo_MsgSetFeeSponsorshipRequest proto.InternalMessageInfo
 
-func (m *MsgSetAdministratorProposalRequest) GetAccess() []AccessGrant {
+func (m *MsgSetFeeSponsorshipRequest) GetSponsorship() FeeSponsorship {
 	if m != nil {
-		return m.Access
+		return m.Sponsorship
 	}
-	return nil
+	return FeeSponsorship{}
 }
 
-func (m *MsgSetAdministratorProposalRequest) GetAuthority() string {
+func (m *MsgSetFeeSponsorshipRequest) GetAdministrator() string {
 	if m != nil {
-		return m.Authority
+		return m.Administrator
 	}
 	return ""
 }
 
-// MsgSetAdministratorProposalResponse defines the Msg/SetAdministratorProposal response type
-type MsgSetAdministratorProposalResponse struct {
+// MsgSetFeeSponsorshipResponse defines the Msg/SetFeeSponsorship response type
+type MsgSetFeeSponsorshipResponse struct {
 }
 
-func (m *MsgSetAdministratorProposalResponse) Reset()         { *m = MsgSetAdministratorProposalResponse{} }
-func (m *MsgSetAdministratorProposalResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgSetAdministratorProposalResponse) ProtoMessage()    {}
-func (*MsgSetAdministratorProposalResponse) Descriptor() ([]byte, []int) {
+func (m *MsgSetFeeSponsorshipResponse) Reset()         { *m = MsgSetFeeSponsorshipResponse{} }
+func (m *MsgSetFeeSponsorshipResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetFeeSponsorshipResponse) ProtoMessage()    {}
+func (*MsgSetFeeSponsorshipResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_bcb203fb73175ed3, []int{45}
 }
-func (m *MsgSetAdministratorProposalResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgSetFeeSponsorshipResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgSetAdministratorProposalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgSetFeeSponsorshipResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgSetAdministratorProposalResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgSetFeeSponsorshipResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2406,38 +2434,38 @@ func (m *MsgSetAdministratorProposalResponse) XXX_Marshal(b []byte, deterministi
 		return b[:n], nil
 	}
 }
-func (m *MsgSetAdministratorProposalResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgSetAdministratorProposalResponse.Merge(m, src)
+func (m *MsgSetFeeSponsorshipResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetFeeSponsorshipResponse.Merge(m, src)
 }
-func (m *MsgSetAdministratorProposalResponse) XXX_Size() int {
+func (m *MsgSetFeeSponsorshipResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgSetAdministratorProposalResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgSetAdministratorProposalResponse.DiscardUnknown(m)
+func (m *MsgSetFeeSponsorshipResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetFeeSponsorshipResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgSetAdministratorProposalResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgSetFeeSponsorshipResponse proto.InternalMessageInfo
 
-// MsgRemoveAdministratorProposalRequest defines the Msg/RemoveAdministratorProposal request type
-type MsgRemoveAdministratorProposalRequest struct {
-	Denom          string   `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
-	RemovedAddress []string `protobuf:"bytes,2,rep,name=removed_address,json=removedAddress,proto3" json:"removed_address,omitempty"`
-	// The signer of the message.  Must have admin authority to marker or be governance module account address.
-	Authority string `protobuf:"bytes,3,opt,name=authority,proto3" json:"authority,omitempty"`
+// MsgRemoveFeeSponsorshipRequest defines a msg to remove the fee sponsorship of a marker.
+type MsgRemoveFeeSponsorshipRequest struct {
+	// The denomination of the marker to update.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// The signer of the message. Must have admin authority or be the governance module account address.
+	Administrator string `protobuf:"bytes,2,opt,name=administrator,proto3" json:"administrator,omitempty"`
 }
 
-func (m *MsgRemoveAdministratorProposalRequest) Reset()         { *m = MsgRemoveAdministratorProposalRequest{} }
-func (m *MsgRemoveAdministratorProposalRequest) String() string { return proto.CompactTextString(m) }
-func (*MsgRemoveAdministratorProposalRequest) ProtoMessage()    {}
-func (*MsgRemoveAdministratorProposalRequest) Descriptor() ([]byte, []int) {
+func (m *MsgRemoveFeeSponsorshipRequest) Reset()         { *m = MsgRemoveFeeSponsorshipRequest{} }
+func (m *MsgRemoveFeeSponsorshipRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveFeeSponsorshipRequest) ProtoMessage()    {}
+func (*MsgRemoveFeeSponsorshipRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_bcb203fb73175ed3, []int{46}
 }
-func (m *MsgRemoveAdministratorProposalRequest) XXX_Unmarshal(b []byte) error {
+func (m *MsgRemoveFeeSponsorshipRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgRemoveAdministratorProposalRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgRemoveFeeSponsorshipRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgRemoveAdministratorProposalRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgRemoveFeeSponsorshipRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2447,57 +2475,48 @@ func (m *MsgRemoveAdministratorProposalRequest) XXX_Marshal(b []byte, determinis
 		return b[:n], nil
 	}
 }
-func (m *MsgRemoveAdministratorProposalRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgRemoveAdministratorProposalRequest.Merge(m, src)
+func (m *MsgRemoveFeeSponsorshipRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveFeeSponsorshipRequest.Merge(m, src)
 }
-func (m *MsgRemoveAdministratorProposalRequest) XXX_Size() int {
+func (m *MsgRemoveFeeSponsorshipRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgRemoveAdministratorProposalRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgRemoveAdministratorProposalRequest.DiscardUnknown(m)
+func (m *MsgRemoveFeeSponsorshipRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveFeeSponsorshipRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgRemoveAdministratorProposalRequest proto.InternalMessageInfo
+var xxx_messageInfo_MsgRemoveFeeSponsorshipRequest proto.InternalMessageInfo
 
-func (m *MsgRemoveAdministratorProposalRequest) GetDenom() string {
+func (m *MsgRemoveFeeSponsorshipRequest) GetDenom() string {
 	if m != nil {
 		return m.Denom
 	}
 	return ""
 }
 
-func (m *MsgRemoveAdministratorProposalRequest) GetRemovedAddress() []string {
-	if m != nil {
-		return m.RemovedAddress
-	}
-	return nil
-}
-
-func (m *MsgRemoveAdministratorProposalRequest) GetAuthority() string {
+func (m *MsgRemoveFeeSponsorshipRequest) GetAdministrator() string {
 	if m != nil {
-		return m.Authority
+		return m.Administrator
 	}
 	return ""
 }
 
-// MsgRemoveAdministratorProposalResponse defines the Msg/RemoveAdministratorProposal response type
-type MsgRemoveAdministratorProposalResponse struct {
+// MsgRemoveFeeSponsorshipResponse defines the Msg/RemoveFeeSponsorship response type
+type MsgRemoveFeeSponsorshipResponse struct {
 }
 
-func (m *MsgRemoveAdministratorProposalResponse) Reset() {
-	*m = MsgRemoveAdministratorProposalResponse{}
-}
-func (m *MsgRemoveAdministratorProposalResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgRemoveAdministratorProposalResponse) ProtoMessage()    {}
-func (*MsgRemoveAdministratorProposalResponse) Descriptor() ([]byte, []int) {
+func (m *MsgRemoveFeeSponsorshipResponse) Reset()         { *m = MsgRemoveFeeSponsorshipResponse{} }
+func (m *MsgRemoveFeeSponsorshipResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveFeeSponsorshipResponse) ProtoMessage()    {}
+func (*MsgRemoveFeeSponsorshipResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_bcb203fb73175ed3, []int{47}
 }
-func (m *MsgRemoveAdministratorProposalResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgRemoveFeeSponsorshipResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgRemoveAdministratorProposalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgRemoveFeeSponsorshipResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgRemoveAdministratorProposalResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgRemoveFeeSponsorshipResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2507,38 +2526,38 @@ func (m *MsgRemoveAdministratorProposalResponse) XXX_Marshal(b []byte, determini
 		return b[:n], nil
 	}
 }
-func (m *MsgRemoveAdministratorProposalResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgRemoveAdministratorProposalResponse.Merge(m, src)
+func (m *MsgRemoveFeeSponsorshipResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveFeeSponsorshipResponse.Merge(m, src)
 }
-func (m *MsgRemoveAdministratorProposalResponse) XXX_Size() int {
+func (m *MsgRemoveFeeSponsorshipResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgRemoveAdministratorProposalResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgRemoveAdministratorProposalResponse.DiscardUnknown(m)
+func (m *MsgRemoveFeeSponsorshipResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveFeeSponsorshipResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgRemoveAdministratorProposalResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgRemoveFeeSponsorshipResponse proto.InternalMessageInfo
 
-// MsgChangeStatusProposalRequest defines the Msg/ChangeStatusProposal request type
-type MsgChangeStatusProposalRequest struct {
-	Denom     string       `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
-	NewStatus MarkerStatus `protobuf:"varint,2,opt,name=new_status,json=newStatus,proto3,enum=provenance.marker.v1.MarkerStatus" json:"new_status,omitempty"`
-	// The signer of the message.  Must have admin authority to marker or be governance module account address.
-	Authority string `protobuf:"bytes,3,opt,name=authority,proto3" json:"authority,omitempty"`
+// MsgClaimFeeSponsorshipRequest defines a msg for a holder to claim a fee grant from a marker's fee sponsorship.
+type MsgClaimFeeSponsorshipRequest struct {
+	// The denomination of the marker sponsoring the fees.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// The address of the holder that will receive the fee grant.
+	Holder string `protobuf:"bytes,2,opt,name=holder,proto3" json:"holder,omitempty"`
 }
 
-func (m *MsgChangeStatusProposalRequest) Reset()         { *m = MsgChangeStatusProposalRequest{} }
-func (m *MsgChangeStatusProposalRequest) String() string { return proto.CompactTextString(m) }
-func (*MsgChangeStatusProposalRequest) ProtoMessage()    {}
-func (*MsgChangeStatusProposalRequest) Descriptor() ([]byte, []int) {
+func (m *MsgClaimFeeSponsorshipRequest) Reset()         { *m = MsgClaimFeeSponsorshipRequest{} }
+func (m *MsgClaimFeeSponsorshipRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimFeeSponsorshipRequest) ProtoMessage()    {}
+func (*MsgClaimFeeSponsorshipRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_bcb203fb73175ed3, []int{48}
 }
-func (m *MsgChangeStatusProposalRequest) XXX_Unmarshal(b []byte) error {
+func (m *MsgClaimFeeSponsorshipRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgChangeStatusProposalRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgClaimFeeSponsorshipRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgChangeStatusProposalRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgClaimFeeSponsorshipRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2548,55 +2567,48 @@ func (m *MsgChangeStatusProposalRequest) XXX_Marshal(b []byte, deterministic boo
 		return b[:n], nil
 	}
 }
-func (m *MsgChangeStatusProposalRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgChangeStatusProposalRequest.Merge(m, src)
+func (m *MsgClaimFeeSponsorshipRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimFeeSponsorshipRequest.Merge(m, src)
 }
-func (m *MsgChangeStatusProposalRequest) XXX_Size() int {
+func (m *MsgClaimFeeSponsorshipRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgChangeStatusProposalRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgChangeStatusProposalRequest.DiscardUnknown(m)
+func (m *MsgClaimFeeSponsorshipRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimFeeSponsorshipRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgChangeStatusProposalRequest proto.InternalMessageInfo
+var xxx_messageInfo_MsgClaimFeeSponsorshipRequest proto.InternalMessageInfo
 
-func (m *MsgChangeStatusProposalRequest) GetDenom() string {
+func (m *MsgClaimFeeSponsorshipRequest) GetDenom() string {
 	if m != nil {
 		return m.Denom
 	}
 	return ""
 }
 
-func (m *MsgChangeStatusProposalRequest) GetNewStatus() MarkerStatus {
-	if m != nil {
-		return m.NewStatus
-	}
-	return StatusUndefined
-}
-
-func (m *MsgChangeStatusProposalRequest) GetAuthority() string {
+func (m *MsgClaimFeeSponsorshipRequest) GetHolder() string {
 	if m != nil {
-		return m.Authority
+		return m.Holder
 	}
 	return ""
 }
 
-// MsgChangeStatusProposalResponse defines the Msg/ChangeStatusProposal response type
-type MsgChangeStatusProposalResponse struct {
+// MsgClaimFeeSponsorshipResponse defines the Msg/ClaimFeeSponsorship response type
+type MsgClaimFeeSponsorshipResponse struct {
 }
 
-func (m *MsgChangeStatusProposalResponse) Reset()         { *m = MsgChangeStatusProposalResponse{} }
-func (m *MsgChangeStatusProposalResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgChangeStatusProposalResponse) ProtoMessage()    {}
-func (*MsgChangeStatusProposalResponse) Descriptor() ([]byte, []int) {
+func (m *MsgClaimFeeSponsorshipResponse) Reset()         { *m = MsgClaimFeeSponsorshipResponse{} }
+func (m *MsgClaimFeeSponsorshipResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimFeeSponsorshipResponse) ProtoMessage()    {}
+func (*MsgClaimFeeSponsorshipResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_bcb203fb73175ed3, []int{49}
 }
-func (m *MsgChangeStatusProposalResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgClaimFeeSponsorshipResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgChangeStatusProposalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgClaimFeeSponsorshipResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgChangeStatusProposalResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgClaimFeeSponsorshipResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2606,39 +2618,39 @@ func (m *MsgChangeStatusProposalResponse) XXX_Marshal(b []byte, deterministic bo
 		return b[:n], nil
 	}
 }
-func (m *MsgChangeStatusProposalResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgChangeStatusProposalResponse.Merge(m, src)
+func (m *MsgClaimFeeSponsorshipResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimFeeSponsorshipResponse.Merge(m, src)
 }
-func (m *MsgChangeStatusProposalResponse) XXX_Size() int {
+func (m *MsgClaimFeeSponsorshipResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgChangeStatusProposalResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgChangeStatusProposalResponse.DiscardUnknown(m)
+func (m *MsgClaimFeeSponsorshipResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimFeeSponsorshipResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgChangeStatusProposalResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgClaimFeeSponsorshipResponse proto.InternalMessageInfo
 
-// MsgWithdrawEscrowProposalRequest defines the Msg/WithdrawEscrowProposal request type
-type MsgWithdrawEscrowProposalRequest struct {
-	Denom         string                                   `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
-	Amount        github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
-	TargetAddress string                                   `protobuf:"bytes,3,opt,name=target_address,json=targetAddress,proto3" json:"target_address,omitempty"`
-	// The signer of the message.  Must have admin authority to marker or be governance module account address.
-	Authority string `protobuf:"bytes,4,opt,name=authority,proto3" json:"authority,omitempty"`
+// MsgReleaseFeeSponsorshipHoldRequest defines a msg for a holder to release the funds put on hold for their
+// fee sponsorship claim.
+type MsgReleaseFeeSponsorshipHoldRequest struct {
+	// The denomination of the marker that sponsored the fees.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// The address of the holder that claimed the fee grant.
+	Holder string `protobuf:"bytes,2,opt,name=holder,proto3" json:"holder,omitempty"`
 }
 
-func (m *MsgWithdrawEscrowProposalRequest) Reset()         { *m = MsgWithdrawEscrowProposalRequest{} }
-func (m *MsgWithdrawEscrowProposalRequest) String() string { return proto.CompactTextString(m) }
-func (*MsgWithdrawEscrowProposalRequest) ProtoMessage()    {}
-func (*MsgWithdrawEscrowProposalRequest) Descriptor() ([]byte, []int) {
+func (m *MsgReleaseFeeSponsorshipHoldRequest) Reset()         { *m = MsgReleaseFeeSponsorshipHoldRequest{} }
+func (m *MsgReleaseFeeSponsorshipHoldRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgReleaseFeeSponsorshipHoldRequest) ProtoMessage()    {}
+func (*MsgReleaseFeeSponsorshipHoldRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_bcb203fb73175ed3, []int{50}
 }
-func (m *MsgWithdrawEscrowProposalRequest) XXX_Unmarshal(b []byte) error {
+func (m *MsgReleaseFeeSponsorshipHoldRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgWithdrawEscrowProposalRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgReleaseFeeSponsorshipHoldRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgWithdrawEscrowProposalRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgReleaseFeeSponsorshipHoldRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2648,62 +2660,48 @@ func (m *MsgWithdrawEscrowProposalRequest) XXX_Marshal(b []byte, deterministic b
 		return b[:n], nil
 	}
 }
-func (m *MsgWithdrawEscrowProposalRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgWithdrawEscrowProposalRequest.Merge(m, src)
+func (m *MsgReleaseFeeSponsorshipHoldRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReleaseFeeSponsorshipHoldRequest.Merge(m, src)
 }
-func (m *MsgWithdrawEscrowProposalRequest) XXX_Size() int {
+func (m *MsgReleaseFeeSponsorshipHoldRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgWithdrawEscrowProposalRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgWithdrawEscrowProposalRequest.DiscardUnknown(m)
+func (m *MsgReleaseFeeSponsorshipHoldRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReleaseFeeSponsorshipHoldRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgWithdrawEscrowProposalRequest proto.InternalMessageInfo
+var xxx_messageInfo_MsgReleaseFeeSponsorshipHoldRequest proto.InternalMessageInfo
 
-func (m *MsgWithdrawEscrowProposalRequest) GetDenom() string {
+func (m *MsgReleaseFeeSponsorshipHoldRequest) GetDenom() string {
 	if m != nil {
 		return m.Denom
 	}
 	return ""
 }
 
-func (m *MsgWithdrawEscrowProposalRequest) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
-	if m != nil {
-		return m.Amount
-	}
-	return nil
-}
-
-func (m *MsgWithdrawEscrowProposalRequest) GetTargetAddress() string {
-	if m != nil {
-		return m.TargetAddress
-	}
-	return ""
-}
-
-func (m *MsgWithdrawEscrowProposalRequest) GetAuthority() string {
+func (m *MsgReleaseFeeSponsorshipHoldRequest) GetHolder() string {
 	if m != nil {
-		return m.Authority
+		return m.Holder
 	}
 	return ""
 }
 
-// MsgWithdrawEscrowProposalResponse defines the Msg/WithdrawEscrowProposal response type
-type MsgWithdrawEscrowProposalResponse struct {
+// MsgReleaseFeeSponsorshipHoldResponse defines the Msg/ReleaseFeeSponsorshipHold response type
+type MsgReleaseFeeSponsorshipHoldResponse struct {
 }
 
-func (m *MsgWithdrawEscrowProposalResponse) Reset()         { *m = MsgWithdrawEscrowProposalResponse{} }
-func (m *MsgWithdrawEscrowProposalResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgWithdrawEscrowProposalResponse) ProtoMessage()    {}
-func (*MsgWithdrawEscrowProposalResponse) Descriptor() ([]byte, []int) {
+func (m *MsgReleaseFeeSponsorshipHoldResponse) Reset()         { *m = MsgReleaseFeeSponsorshipHoldResponse{} }
+func (m *MsgReleaseFeeSponsorshipHoldResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgReleaseFeeSponsorshipHoldResponse) ProtoMessage()    {}
+func (*MsgReleaseFeeSponsorshipHoldResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_bcb203fb73175ed3, []int{51}
 }
-func (m *MsgWithdrawEscrowProposalResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgReleaseFeeSponsorshipHoldResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgWithdrawEscrowProposalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgReleaseFeeSponsorshipHoldResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgWithdrawEscrowProposalResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgReleaseFeeSponsorshipHoldResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2713,37 +2711,38 @@ func (m *MsgWithdrawEscrowProposalResponse) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *MsgWithdrawEscrowProposalResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgWithdrawEscrowProposalResponse.Merge(m, src)
+func (m *MsgReleaseFeeSponsorshipHoldResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReleaseFeeSponsorshipHoldResponse.Merge(m, src)
 }
-func (m *MsgWithdrawEscrowProposalResponse) XXX_Size() int {
+func (m *MsgReleaseFeeSponsorshipHoldResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgWithdrawEscrowProposalResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgWithdrawEscrowProposalResponse.DiscardUnknown(m)
+func (m *MsgReleaseFeeSponsorshipHoldResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReleaseFeeSponsorshipHoldResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgWithdrawEscrowProposalResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgReleaseFeeSponsorshipHoldResponse proto.InternalMessageInfo
 
-// MsgSetDenomMetadataProposalRequest defines the Msg/SetDenomMetadataProposal request type
-type MsgSetDenomMetadataProposalRequest struct {
-	Metadata github_com_cosmos_cosmos_sdk_x_bank_types.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3,customtype=github.com/cosmos/cosmos-sdk/x/bank/types.Metadata" json:"metadata"`
-	// The signer of the message.  Must have admin authority to marker or be governance module account address.
-	Authority string `protobuf:"bytes,2,opt,name=authority,proto3" json:"authority,omitempty"`
+// MsgSetBridgeInfoRequest defines a msg to create or update the bridge info of a marker.
+type MsgSetBridgeInfoRequest struct {
+	// bridge_info is the bridge info to give the marker. Its denom is the denom of the marker to update.
+	BridgeInfo BridgeInfo `protobuf:"bytes,1,opt,name=bridge_info,json=bridgeInfo,proto3" json:"bridge_info"`
+	// The signer of the message. Must have admin authority or be the governance module account address.
+	Administrator string `protobuf:"bytes,2,opt,name=administrator,proto3" json:"administrator,omitempty"`
 }
 
-func (m *MsgSetDenomMetadataProposalRequest) Reset()         { *m = MsgSetDenomMetadataProposalRequest{} }
-func (m *MsgSetDenomMetadataProposalRequest) String() string { return proto.CompactTextString(m) }
-func (*MsgSetDenomMetadataProposalRequest) ProtoMessage()    {}
-func (*MsgSetDenomMetadataProposalRequest) Descriptor() ([]byte, []int) {
+func (m *MsgSetBridgeInfoRequest) Reset()         { *m = MsgSetBridgeInfoRequest{} }
+func (m *MsgSetBridgeInfoRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgSetBridgeInfoRequest) ProtoMessage()    {}
+func (*MsgSetBridgeInfoRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_bcb203fb73175ed3, []int{52}
 }
-func (m *MsgSetDenomMetadataProposalRequest) XXX_Unmarshal(b []byte) error {
+func (m *MsgSetBridgeInfoRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgSetDenomMetadataProposalRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgSetBridgeInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgSetDenomMetadataProposalRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgSetBridgeInfoRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2753,41 +2752,48 @@ func (m *MsgSetDenomMetadataProposalRequest) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *MsgSetDenomMetadataProposalRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgSetDenomMetadataProposalRequest.Merge(m, src)
+func (m *MsgSetBridgeInfoRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetBridgeInfoRequest.Merge(m, src)
 }
-func (m *MsgSetDenomMetadataProposalRequest) XXX_Size() int {
+func (m *MsgSetBridgeInfoRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgSetDenomMetadataProposalRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgSetDenomMetadataProposalRequest.DiscardUnknown(m)
+func (m *MsgSetBridgeInfoRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetBridgeInfoRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgSetDenomMetadataProposalRequest proto.InternalMessageInfo
+var xxx_messageInfo_MsgSetBridgeInfoRequest proto.InternalMessageInfo
 
-func (m *MsgSetDenomMetadataProposalRequest) GetAuthority() string {
+func (m *MsgSetBridgeInfoRequest) GetBridgeInfo() BridgeInfo {
 	if m != nil {
-		return m.Authority
+		return m.BridgeInfo
+	}
+	return BridgeInfo{}
+}
+
+func (m *MsgSetBridgeInfoRequest) GetAdministrator() string {
+	if m != nil {
+		return m.Administrator
 	}
 	return ""
 }
 
-// MsgSetDenomMetadataProposalResponse defines the Msg/SetDenomMetadataProposal response type
-type MsgSetDenomMetadataProposalResponse struct {
+// MsgSetBridgeInfoResponse defines the Msg/SetBridgeInfo response type
+type MsgSetBridgeInfoResponse struct {
 }
 
-func (m *MsgSetDenomMetadataProposalResponse) Reset()         { *m = MsgSetDenomMetadataProposalResponse{} }
-func (m *MsgSetDenomMetadataProposalResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgSetDenomMetadataProposalResponse) ProtoMessage()    {}
-func (*MsgSetDenomMetadataProposalResponse) Descriptor() ([]byte, []int) {
+func (m *MsgSetBridgeInfoResponse) Reset()         { *m = MsgSetBridgeInfoResponse{} }
+func (m *MsgSetBridgeInfoResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetBridgeInfoResponse) ProtoMessage()    {}
+func (*MsgSetBridgeInfoResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_bcb203fb73175ed3, []int{53}
 }
-func (m *MsgSetDenomMetadataProposalResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgSetBridgeInfoResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgSetDenomMetadataProposalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgSetBridgeInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgSetDenomMetadataProposalResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgSetBridgeInfoResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2797,38 +2803,39 @@ func (m *MsgSetDenomMetadataProposalResponse) XXX_Marshal(b []byte, deterministi
 		return b[:n], nil
 	}
 }
-func (m *MsgSetDenomMetadataProposalResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgSetDenomMetadataProposalResponse.Merge(m, src)
+func (m *MsgSetBridgeInfoResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetBridgeInfoResponse.Merge(m, src)
 }
-func (m *MsgSetDenomMetadataProposalResponse) XXX_Size() int {
+func (m *MsgSetBridgeInfoResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgSetDenomMetadataProposalResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgSetDenomMetadataProposalResponse.DiscardUnknown(m)
+func (m *MsgSetBridgeInfoResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetBridgeInfoResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgSetDenomMetadataProposalResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgSetBridgeInfoResponse proto.InternalMessageInfo
 
-// MsgUpdateParamsRequest is a request message for the UpdateParams endpoint.
-type MsgUpdateParamsRequest struct {
-	// authority should be the governance module account address.
-	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
-	// params are the new param values to set.
-	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+// MsgAttestedMintRequest defines a msg to mint coin of a wrapped-asset marker with a reference to the proof of its backing.
+type MsgAttestedMintRequest struct {
+	Amount types1.Coin `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount"`
+	// reference identifies the proof of the backing for this mint, e.g. a deposit transaction hash on the origin chain.
+	Reference string `protobuf:"bytes,2,opt,name=reference,proto3" json:"reference,omitempty"`
+	// The signer of the message. Must have mint authority.
+	Administrator string `protobuf:"bytes,3,opt,name=administrator,proto3" json:"administrator,omitempty"`
 }
 
-func (m *MsgUpdateParamsRequest) Reset()         { *m = MsgUpdateParamsRequest{} }
-func (m *MsgUpdateParamsRequest) String() string { return proto.CompactTextString(m) }
-func (*MsgUpdateParamsRequest) ProtoMessage()    {}
-func (*MsgUpdateParamsRequest) Descriptor() ([]byte, []int) {
+func (m *MsgAttestedMintRequest) Reset()         { *m = MsgAttestedMintRequest{} }
+func (m *MsgAttestedMintRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgAttestedMintRequest) ProtoMessage()    {}
+func (*MsgAttestedMintRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_bcb203fb73175ed3, []int{54}
 }
-func (m *MsgUpdateParamsRequest) XXX_Unmarshal(b []byte) error {
+func (m *MsgAttestedMintRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgUpdateParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgAttestedMintRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgUpdateParamsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgAttestedMintRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2838,48 +2845,57 @@ func (m *MsgUpdateParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return b[:n], nil
 	}
 }
-func (m *MsgUpdateParamsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgUpdateParamsRequest.Merge(m, src)
+func (m *MsgAttestedMintRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAttestedMintRequest.Merge(m, src)
 }
-func (m *MsgUpdateParamsRequest) XXX_Size() int {
+func (m *MsgAttestedMintRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgUpdateParamsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgUpdateParamsRequest.DiscardUnknown(m)
+func (m *MsgAttestedMintRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAttestedMintRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgUpdateParamsRequest proto.InternalMessageInfo
+var xxx_messageInfo_MsgAttestedMintRequest proto.InternalMessageInfo
 
-func (m *MsgUpdateParamsRequest) GetAuthority() string {
+func (m *MsgAttestedMintRequest) GetAmount() types1.Coin {
 	if m != nil {
-		return m.Authority
+		return m.Amount
+	}
+	return types1.Coin{}
+}
+
+func (m *MsgAttestedMintRequest) GetReference() string {
+	if m != nil {
+		return m.Reference
 	}
 	return ""
 }
 
-func (m *MsgUpdateParamsRequest) GetParams() Params {
+func (m *MsgAttestedMintRequest) GetAdministrator() string {
 	if m != nil {
-		return m.Params
+		return m.Administrator
 	}
-	return Params{}
+	return ""
 }
 
-// MsgUpdateParamsResponse is a response message for the UpdateParams endpoint.
-type MsgUpdateParamsResponse struct {
+// MsgAttestedMintResponse defines the Msg/AttestedMint response type
+type MsgAttestedMintResponse struct {
+	// sequence is the number of the attestation recorded for this mint.
+	Sequence uint64 `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
 }
 
-func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse{} }
-func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgUpdateParamsResponse) ProtoMessage()    {}
-func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
+func (m *MsgAttestedMintResponse) Reset()         { *m = MsgAttestedMintResponse{} }
+func (m *MsgAttestedMintResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAttestedMintResponse) ProtoMessage()    {}
+func (*MsgAttestedMintResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_bcb203fb73175ed3, []int{55}
 }
-func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgAttestedMintResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgAttestedMintResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgUpdateParamsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgAttestedMintResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2889,4665 +2905,18784 @@ func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *MsgUpdateParamsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgUpdateParamsResponse.Merge(m, src)
+func (m *MsgAttestedMintResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAttestedMintResponse.Merge(m, src)
 }
-func (m *MsgUpdateParamsResponse) XXX_Size() int {
+func (m *MsgAttestedMintResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgUpdateParamsResponse.DiscardUnknown(m)
+func (m *MsgAttestedMintResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAttestedMintResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgAttestedMintResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgGrantAllowanceRequest)(nil), "provenance.marker.v1.MsgGrantAllowanceRequest")
-	proto.RegisterType((*MsgGrantAllowanceResponse)(nil), "provenance.marker.v1.MsgGrantAllowanceResponse")
-	proto.RegisterType((*MsgAddMarkerRequest)(nil), "provenance.marker.v1.MsgAddMarkerRequest")
-	proto.RegisterType((*MsgAddMarkerResponse)(nil), "provenance.marker.v1.MsgAddMarkerResponse")
-	proto.RegisterType((*MsgAddAccessRequest)(nil), "provenance.marker.v1.MsgAddAccessRequest")
-	proto.RegisterType((*MsgAddAccessResponse)(nil), "provenance.marker.v1.MsgAddAccessResponse")
-	proto.RegisterType((*MsgDeleteAccessRequest)(nil), "provenance.marker.v1.MsgDeleteAccessRequest")
-	proto.RegisterType((*MsgDeleteAccessResponse)(nil), "provenance.marker.v1.MsgDeleteAccessResponse")
-	proto.RegisterType((*MsgFinalizeRequest)(nil), "provenance.marker.v1.MsgFinalizeRequest")
-	proto.RegisterType((*MsgFinalizeResponse)(nil), "provenance.marker.v1.MsgFinalizeResponse")
-	proto.RegisterType((*MsgActivateRequest)(nil), "provenance.marker.v1.MsgActivateRequest")
-	proto.RegisterType((*MsgActivateResponse)(nil), "provenance.marker.v1.MsgActivateResponse")
-	proto.RegisterType((*MsgCancelRequest)(nil), "provenance.marker.v1.MsgCancelRequest")
-	proto.RegisterType((*MsgCancelResponse)(nil), "provenance.marker.v1.MsgCancelResponse")
-	proto.RegisterType((*MsgDeleteRequest)(nil), "provenance.marker.v1.MsgDeleteRequest")
-	proto.RegisterType((*MsgDeleteResponse)(nil), "provenance.marker.v1.MsgDeleteResponse")
-	proto.RegisterType((*MsgMintRequest)(nil), "provenance.marker.v1.MsgMintRequest")
-	proto.RegisterType((*MsgMintResponse)(nil), "provenance.marker.v1.MsgMintResponse")
-	proto.RegisterType((*MsgBurnRequest)(nil), "provenance.marker.v1.MsgBurnRequest")
-	proto.RegisterType((*MsgBurnResponse)(nil), "provenance.marker.v1.MsgBurnResponse")
-	proto.RegisterType((*MsgWithdrawRequest)(nil), "provenance.marker.v1.MsgWithdrawRequest")
-	proto.RegisterType((*MsgWithdrawResponse)(nil), "provenance.marker.v1.MsgWithdrawResponse")
-	proto.RegisterType((*MsgTransferRequest)(nil), "provenance.marker.v1.MsgTransferRequest")
-	proto.RegisterType((*MsgTransferResponse)(nil), "provenance.marker.v1.MsgTransferResponse")
-	proto.RegisterType((*MsgIbcTransferRequest)(nil), "provenance.marker.v1.MsgIbcTransferRequest")
-	proto.RegisterType((*MsgIbcTransferResponse)(nil), "provenance.marker.v1.MsgIbcTransferResponse")
-	proto.RegisterType((*MsgSetDenomMetadataRequest)(nil), "provenance.marker.v1.MsgSetDenomMetadataRequest")
-	proto.RegisterType((*MsgSetDenomMetadataResponse)(nil), "provenance.marker.v1.MsgSetDenomMetadataResponse")
-	proto.RegisterType((*MsgAddFinalizeActivateMarkerRequest)(nil), "provenance.marker.v1.MsgAddFinalizeActivateMarkerRequest")
-	proto.RegisterType((*MsgAddFinalizeActivateMarkerResponse)(nil), "provenance.marker.v1.MsgAddFinalizeActivateMarkerResponse")
-	proto.RegisterType((*MsgSupplyIncreaseProposalRequest)(nil), "provenance.marker.v1.MsgSupplyIncreaseProposalRequest")
-	proto.RegisterType((*MsgSupplyIncreaseProposalResponse)(nil), "provenance.marker.v1.MsgSupplyIncreaseProposalResponse")
-	proto.RegisterType((*MsgSupplyDecreaseProposalRequest)(nil), "provenance.marker.v1.MsgSupplyDecreaseProposalRequest")
-	proto.RegisterType((*MsgSupplyDecreaseProposalResponse)(nil), "provenance.marker.v1.MsgSupplyDecreaseProposalResponse")
-	proto.RegisterType((*MsgUpdateRequiredAttributesRequest)(nil), "provenance.marker.v1.MsgUpdateRequiredAttributesRequest")
-	proto.RegisterType((*MsgUpdateRequiredAttributesResponse)(nil), "provenance.marker.v1.MsgUpdateRequiredAttributesResponse")
-	proto.RegisterType((*MsgUpdateForcedTransferRequest)(nil), "provenance.marker.v1.MsgUpdateForcedTransferRequest")
-	proto.RegisterType((*MsgUpdateForcedTransferResponse)(nil), "provenance.marker.v1.MsgUpdateForcedTransferResponse")
-	proto.RegisterType((*MsgSetAccountDataRequest)(nil), "provenance.marker.v1.MsgSetAccountDataRequest")
-	proto.RegisterType((*MsgSetAccountDataResponse)(nil), "provenance.marker.v1.MsgSetAccountDataResponse")
-	proto.RegisterType((*MsgUpdateSendDenyListRequest)(nil), "provenance.marker.v1.MsgUpdateSendDenyListRequest")
-	proto.RegisterType((*MsgUpdateSendDenyListResponse)(nil), "provenance.marker.v1.MsgUpdateSendDenyListResponse")
-	proto.RegisterType((*MsgAddNetAssetValuesRequest)(nil), "provenance.marker.v1.MsgAddNetAssetValuesRequest")
-	proto.RegisterType((*MsgAddNetAssetValuesResponse)(nil), "provenance.marker.v1.MsgAddNetAssetValuesResponse")
-	proto.RegisterType((*MsgSetAdministratorProposalRequest)(nil), "provenance.marker.v1.MsgSetAdministratorProposalRequest")
-	proto.RegisterType((*MsgSetAdministratorProposalResponse)(nil), "provenance.marker.v1.MsgSetAdministratorProposalResponse")
-	proto.RegisterType((*MsgRemoveAdministratorProposalRequest)(nil), "provenance.marker.v1.MsgRemoveAdministratorProposalRequest")
-	proto.RegisterType((*MsgRemoveAdministratorProposalResponse)(nil), "provenance.marker.v1.MsgRemoveAdministratorProposalResponse")
-	proto.RegisterType((*MsgChangeStatusProposalRequest)(nil), "provenance.marker.v1.MsgChangeStatusProposalRequest")
-	proto.RegisterType((*MsgChangeStatusProposalResponse)(nil), "provenance.marker.v1.MsgChangeStatusProposalResponse")
-	proto.RegisterType((*MsgWithdrawEscrowProposalRequest)(nil), "provenance.marker.v1.MsgWithdrawEscrowProposalRequest")
-	proto.RegisterType((*MsgWithdrawEscrowProposalResponse)(nil), "provenance.marker.v1.MsgWithdrawEscrowProposalResponse")
-	proto.RegisterType((*MsgSetDenomMetadataProposalRequest)(nil), "provenance.marker.v1.MsgSetDenomMetadataProposalRequest")
-	proto.RegisterType((*MsgSetDenomMetadataProposalResponse)(nil), "provenance.marker.v1.MsgSetDenomMetadataProposalResponse")
-	proto.RegisterType((*MsgUpdateParamsRequest)(nil), "provenance.marker.v1.MsgUpdateParamsRequest")
-	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "provenance.marker.v1.MsgUpdateParamsResponse")
+func (m *MsgAttestedMintResponse) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
 }
 
-func init() { proto.RegisterFile("provenance/marker/v1/tx.proto", fileDescriptor_bcb203fb73175ed3) }
-
-var fileDescriptor_bcb203fb73175ed3 = []byte{
-	// 2359 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5a, 0x5f, 0x6f, 0x1b, 0x59,
-	0x15, 0xef, 0x38, 0x8e, 0x37, 0x3e, 0x6e, 0xd3, 0xe6, 0x36, 0x6d, 0x27, 0x93, 0xcd, 0xdf, 0x36,
-	0xad, 0xb7, 0x6c, 0xec, 0xc6, 0x0b, 0xdd, 0x6e, 0x58, 0x09, 0x39, 0xc9, 0xa6, 0x54, 0x60, 0x54,
-	0x39, 0x0b, 0x08, 0x5e, 0xac, 0xf1, 0xcc, 0xcd, 0x64, 0x14, 0x7b, 0xc6, 0x9d, 0x7b, 0xed, 0x34,
-	0x2b, 0x21, 0x21, 0xf6, 0x69, 0x9f, 0x58, 0xf6, 0x01, 0x21, 0xc4, 0x03, 0x4f, 0x08, 0xf1, 0x54,
-	0xa1, 0x15, 0x1f, 0x00, 0x09, 0xb1, 0x80, 0x40, 0xab, 0xe5, 0x05, 0xf1, 0xb0, 0xa0, 0x56, 0xa2,
-	0x08, 0xf1, 0x19, 0x00, 0xcd, 0xbd, 0x77, 0xc6, 0x1e, 0x7b, 0xe6, 0xda, 0x49, 0x5c, 0x2d, 0x2f,
-	0x6d, 0xe6, 0x9e, 0x73, 0xee, 0x39, 0xbf, 0x73, 0xcf, 0xb9, 0xf7, 0x9c, 0x93, 0xc0, 0x42, 0xcb,
-	0x73, 0x3b, 0xd8, 0xd1, 0x1d, 0x03, 0x17, 0x9b, 0xba, 0x77, 0x88, 0xbd, 0x62, 0x67, 0xa3, 0x48,
-	0x1f, 0x17, 0x5a, 0x9e, 0x4b, 0x5d, 0x34, 0xdb, 0x25, 0x17, 0x38, 0xb9, 0xd0, 0xd9, 0xd0, 0x66,
-	0xf4, 0xa6, 0xed, 0xb8, 0x45, 0xf6, 0x2f, 0x67, 0xd4, 0xe6, 0x2c, 0xd7, 0xb5, 0x1a, 0xb8, 0xc8,
-	0xbe, 0xea, 0xed, 0xfd, 0xa2, 0xee, 0x1c, 0x07, 0x24, 0xc3, 0x25, 0x4d, 0x97, 0xd4, 0xd8, 0x57,
-	0x91, 0x7f, 0x08, 0xd2, 0xac, 0xe5, 0x5a, 0x2e, 0x5f, 0xf7, 0x7f, 0x12, 0xab, 0x8b, 0x9c, 0xa7,
-	0x58, 0xd7, 0x09, 0x2e, 0x76, 0x36, 0xea, 0x98, 0xea, 0x1b, 0x45, 0xc3, 0xb5, 0x9d, 0x01, 0xba,
-	0x73, 0x18, 0xd2, 0xfd, 0x0f, 0x41, 0xbf, 0x26, 0xe8, 0x4d, 0x62, 0xf9, 0x60, 0x9a, 0xc4, 0x12,
-	0x84, 0x35, 0xbb, 0x6e, 0x14, 0xf5, 0x56, 0xab, 0x61, 0x1b, 0x3a, 0xb5, 0x5d, 0x87, 0x14, 0xa9,
-	0xa7, 0x3b, 0x64, 0x3f, 0x0a, 0x5a, 0x5b, 0x89, 0xf5, 0x89, 0x80, 0xcf, 0x59, 0x6e, 0xc6, 0xb2,
-	0xe8, 0x86, 0x81, 0x09, 0xb1, 0x3c, 0xdd, 0xa1, 0x9c, 0x6f, 0xf5, 0x0f, 0x0a, 0xa8, 0x15, 0x62,
-	0xdd, 0xf7, 0x97, 0xca, 0x8d, 0x86, 0x7b, 0xe4, 0x4b, 0x54, 0xf1, 0xa3, 0x36, 0x26, 0x14, 0xcd,
-	0xc2, 0xa4, 0x89, 0x1d, 0xb7, 0xa9, 0x2a, 0xcb, 0x4a, 0x3e, 0x5b, 0xe5, 0x1f, 0xe8, 0x06, 0x5c,
-	0xd0, 0xcd, 0xa6, 0xed, 0xd8, 0x84, 0x7a, 0x3a, 0x75, 0x3d, 0x35, 0xc5, 0xa8, 0xd1, 0x45, 0xa4,
-	0xc2, 0x4b, 0x4c, 0x0f, 0xc6, 0xea, 0x04, 0xa3, 0x07, 0x9f, 0xe8, 0x2d, 0xc8, 0xea, 0x81, 0x26,
-	0x35, 0xbd, 0xac, 0xe4, 0x73, 0xa5, 0xd9, 0x02, 0x3f, 0x9d, 0x42, 0x70, 0x3a, 0x85, 0xb2, 0x73,
-	0xbc, 0x35, 0xf3, 0xfb, 0x0f, 0xd7, 0x2f, 0xec, 0x62, 0x1c, 0xda, 0xf5, 0xa0, 0xda, 0x95, 0xdc,
-	0x44, 0xdf, 0x7b, 0xfe, 0xe4, 0x76, 0x54, 0xe9, 0xea, 0x3c, 0xcc, 0xc5, 0x80, 0x21, 0x2d, 0xd7,
-	0x21, 0x78, 0xf5, 0xbf, 0x69, 0xb8, 0x5c, 0x21, 0x56, 0xd9, 0x34, 0x2b, 0xcc, 0x21, 0x01, 0xca,
-	0xd7, 0x21, 0xa3, 0x37, 0xdd, 0xb6, 0x43, 0x19, 0xcc, 0x5c, 0x69, 0xae, 0x20, 0x42, 0xc0, 0x3f,
-	0xde, 0x82, 0x38, 0xbe, 0xc2, 0xb6, 0x6b, 0x3b, 0x5b, 0xe9, 0x8f, 0x3e, 0x5d, 0x3a, 0x57, 0x15,
-	0xec, 0x3e, 0xc4, 0xa6, 0xee, 0xe8, 0x16, 0xf6, 0x02, 0x88, 0xe2, 0x13, 0xad, 0xc0, 0xf9, 0x7d,
-	0xcf, 0x6d, 0xd6, 0x74, 0xd3, 0xf4, 0x30, 0x21, 0x0c, 0x65, 0xb6, 0x9a, 0xf3, 0xd7, 0xca, 0x7c,
-	0x09, 0x6d, 0x42, 0x86, 0x50, 0x9d, 0xb6, 0x89, 0x3a, 0xb9, 0xac, 0xe4, 0xa7, 0x4b, 0xab, 0x85,
-	0xb8, 0x48, 0x2e, 0x70, 0x53, 0xf7, 0x18, 0x67, 0x55, 0x48, 0xa0, 0x32, 0xe4, 0x38, 0x47, 0x8d,
-	0x1e, 0xb7, 0xb0, 0x9a, 0x61, 0x1b, 0x2c, 0xcb, 0x36, 0x78, 0xfb, 0xb8, 0x85, 0xab, 0xd0, 0x0c,
-	0x7f, 0x46, 0x5f, 0x86, 0x1c, 0x0f, 0x86, 0x5a, 0xc3, 0x26, 0x54, 0x7d, 0x69, 0x79, 0x22, 0x9f,
-	0x2b, 0xad, 0xc4, 0x6f, 0x51, 0x66, 0x8c, 0xcc, 0xab, 0xc2, 0x03, 0xc0, 0x65, 0xbf, 0x6a, 0x13,
-	0xea, 0x63, 0x25, 0xed, 0x56, 0xab, 0x71, 0x5c, 0xdb, 0xb7, 0x1f, 0x63, 0x53, 0x9d, 0x5a, 0x56,
-	0xf2, 0x53, 0xd5, 0x1c, 0x5f, 0xdb, 0xf5, 0x97, 0xd0, 0x3d, 0x50, 0xd9, 0xb9, 0xd5, 0x2c, 0xb7,
-	0x83, 0x3d, 0xb6, 0x7d, 0xcd, 0x70, 0x1d, 0xea, 0xb9, 0x0d, 0x35, 0xcb, 0xd8, 0xaf, 0x32, 0xfa,
-	0xfd, 0x90, 0xbc, 0xcd, 0xa9, 0xa8, 0x04, 0x57, 0xb8, 0xe4, 0xbe, 0xeb, 0x19, 0xd8, 0xac, 0x05,
-	0xe9, 0xa0, 0x02, 0x13, 0xbb, 0xcc, 0x88, 0xbb, 0x8c, 0xf6, 0xb6, 0x20, 0xa1, 0x22, 0x5c, 0xf6,
-	0xf0, 0xa3, 0xb6, 0xed, 0x61, 0xb3, 0xa6, 0x53, 0xea, 0xd9, 0xf5, 0x36, 0xc5, 0x44, 0xcd, 0x2d,
-	0x4f, 0xe4, 0xb3, 0x55, 0x14, 0x90, 0xca, 0x21, 0x05, 0x2d, 0x41, 0xb6, 0x4d, 0xcc, 0x9a, 0x81,
-	0x1d, 0x4a, 0xd4, 0xf3, 0xcb, 0x4a, 0x3e, 0xbd, 0x95, 0x52, 0x95, 0xea, 0x54, 0x9b, 0x98, 0xdb,
-	0xfe, 0x1a, 0xba, 0x0a, 0x99, 0x8e, 0xdb, 0x68, 0x37, 0xb1, 0x7a, 0xc1, 0xa7, 0x56, 0xc5, 0x17,
-	0x9a, 0xe7, 0x82, 0x4d, 0xbb, 0xd1, 0x20, 0xea, 0x34, 0x23, 0xf9, 0x42, 0x15, 0xff, 0x7b, 0x73,
-	0xc6, 0x8f, 0xcf, 0x48, 0x18, 0xac, 0x5e, 0x85, 0xd9, 0x68, 0x00, 0x8a, 0xc8, 0xfc, 0x99, 0x12,
-	0x44, 0x26, 0x77, 0xf5, 0x38, 0xf2, 0xef, 0x4b, 0x90, 0xe1, 0x87, 0xa4, 0x4e, 0x9c, 0xec, 0x6c,
-	0x85, 0x58, 0x6c, 0x7e, 0x85, 0x00, 0x02, 0x3b, 0x05, 0x80, 0x1f, 0x28, 0x70, 0xb5, 0x42, 0xac,
-	0x1d, 0xdc, 0xc0, 0x14, 0x8f, 0x0f, 0xc3, 0x2d, 0xb8, 0xe8, 0xe1, 0xa6, 0xdb, 0xf1, 0x0f, 0x52,
-	0x64, 0x12, 0x4f, 0xb4, 0x69, 0xb1, 0x2c, 0x92, 0x29, 0xd6, 0xd6, 0x39, 0xb8, 0x36, 0x60, 0x92,
-	0x30, 0xd7, 0x04, 0x54, 0x21, 0xd6, 0xae, 0xed, 0xe8, 0x0d, 0xfb, 0x9d, 0x71, 0xdc, 0x76, 0xb1,
-	0x06, 0x5c, 0x61, 0x87, 0xda, 0xd5, 0x12, 0x51, 0x5e, 0x36, 0xa8, 0xdd, 0xd1, 0xe9, 0x0b, 0x56,
-	0xde, 0xd5, 0x22, 0x94, 0xd7, 0xe1, 0x52, 0x85, 0x58, 0xdb, 0x7e, 0x10, 0x34, 0x5e, 0x94, 0xea,
-	0xcb, 0x30, 0xd3, 0xa3, 0x23, 0xa2, 0x98, 0x9f, 0xc6, 0x8b, 0x55, 0x1c, 0xe8, 0x10, 0x8a, 0xdf,
-	0x55, 0x60, 0xba, 0x42, 0xac, 0x8a, 0xed, 0xd0, 0x33, 0x5f, 0xf8, 0xa7, 0x37, 0x6d, 0x06, 0x2e,
-	0x86, 0x46, 0x44, 0x0d, 0xdb, 0x6a, 0x7b, 0xce, 0x67, 0x6e, 0x18, 0x37, 0x42, 0x18, 0xf6, 0x1f,
-	0x85, 0x45, 0xe8, 0x37, 0x6d, 0x7a, 0x60, 0x7a, 0xfa, 0xd1, 0x38, 0x12, 0x79, 0x01, 0x80, 0xba,
-	0x7d, 0x39, 0x9c, 0xa5, 0x6e, 0xf0, 0x16, 0x1e, 0x87, 0xb8, 0xd3, 0xec, 0xae, 0x92, 0xe0, 0xde,
-	0xf5, 0x71, 0xff, 0xe2, 0x6f, 0x4b, 0x79, 0xcb, 0xa6, 0x07, 0xed, 0x7a, 0xc1, 0x70, 0x9b, 0xa2,
-	0x62, 0x13, 0xff, 0xad, 0x13, 0xf3, 0xb0, 0xe8, 0x3f, 0x8b, 0x84, 0x09, 0x90, 0x1f, 0xfb, 0xb7,
-	0x70, 0x03, 0x5b, 0xba, 0x71, 0x5c, 0xf3, 0x4b, 0x34, 0xf2, 0xf3, 0xe7, 0x4f, 0x6e, 0x2b, 0x81,
-	0xe7, 0x24, 0xb9, 0xd3, 0xc5, 0x2f, 0xfc, 0xf2, 0x3b, 0xee, 0x97, 0xe0, 0x9d, 0x19, 0xff, 0xa1,
-	0x4d, 0xc4, 0xb9, 0x6e, 0x84, 0x52, 0x22, 0xea, 0xdd, 0xc9, 0x3e, 0xef, 0x4a, 0x20, 0x76, 0xa1,
-	0x08, 0x88, 0xff, 0x50, 0xe0, 0x4a, 0x85, 0x58, 0x0f, 0xea, 0x46, 0x3f, 0xca, 0x0f, 0x14, 0x98,
-	0x0a, 0x1f, 0x5f, 0x0e, 0xf4, 0x95, 0x82, 0x5d, 0x37, 0x0a, 0xbd, 0xd5, 0x6a, 0x21, 0xe0, 0x60,
-	0x85, 0x47, 0x77, 0xff, 0xad, 0xaf, 0xf8, 0xc0, 0xff, 0xfa, 0xe9, 0xd2, 0xf6, 0xe0, 0xa9, 0xd9,
-	0x75, 0x63, 0xdd, 0x72, 0x8b, 0x9d, 0x7b, 0xc5, 0xa6, 0x6b, 0xb6, 0x1b, 0x98, 0xf8, 0xf5, 0x6f,
-	0x4f, 0xdd, 0xcb, 0x8f, 0xb2, 0xd7, 0xd8, 0xd0, 0x8e, 0x33, 0x84, 0xbd, 0xca, 0xde, 0xab, 0x08,
-	0x4e, 0xe1, 0x82, 0x3f, 0x2a, 0xa0, 0x55, 0x88, 0xb5, 0x87, 0xe9, 0x8e, 0x1f, 0xe0, 0x15, 0x4c,
-	0x75, 0x53, 0xa7, 0x7a, 0xe0, 0x87, 0x36, 0x4c, 0x35, 0xc5, 0x92, 0x70, 0xc3, 0x42, 0xf7, 0xbc,
-	0x9d, 0xc3, 0xf0, 0xbc, 0x03, 0xb9, 0xad, 0x4d, 0x01, 0xbd, 0x24, 0x0d, 0xd8, 0xc7, 0xbc, 0x57,
-	0x10, 0x60, 0x03, 0x9d, 0xa1, 0xaa, 0x33, 0x20, 0x5d, 0x80, 0xf9, 0x58, 0x38, 0x02, 0xee, 0x9f,
-	0xd3, 0x70, 0x9d, 0x3f, 0xe9, 0xc1, 0x43, 0x15, 0xbc, 0x19, 0xff, 0x0f, 0x45, 0x72, 0x5f, 0xa1,
-	0x3b, 0x79, 0xf6, 0x42, 0x37, 0x33, 0xbe, 0x42, 0xf7, 0xa5, 0x93, 0x15, 0xba, 0x53, 0xa7, 0x2b,
-	0x74, 0xb3, 0x27, 0x2e, 0x74, 0x61, 0xb4, 0x42, 0x37, 0x27, 0x2d, 0x74, 0xcf, 0x27, 0x17, 0xba,
-	0x17, 0x86, 0x17, 0xba, 0x37, 0xe1, 0x86, 0x3c, 0xa8, 0x44, 0xf4, 0xfd, 0x49, 0x81, 0x65, 0x3f,
-	0x3a, 0x99, 0x0b, 0x1f, 0x38, 0x86, 0x87, 0x75, 0x82, 0x1f, 0x7a, 0x6e, 0xcb, 0x25, 0x7a, 0xe3,
-	0xcc, 0xa1, 0xb7, 0x06, 0xd3, 0x54, 0xf7, 0x2c, 0x4c, 0xc3, 0x10, 0x13, 0x59, 0xc3, 0x57, 0x83,
-	0x20, 0xbb, 0x0b, 0x59, 0xbd, 0x4d, 0x0f, 0x5c, 0xcf, 0xa6, 0xc7, 0x3c, 0x46, 0xb7, 0xd4, 0x4f,
-	0x3e, 0x5c, 0x9f, 0x15, 0x5a, 0x04, 0xdb, 0x1e, 0xf5, 0x6c, 0xc7, 0xaa, 0x76, 0x59, 0x37, 0xd1,
-	0x3f, 0x7f, 0xba, 0xa4, 0xf8, 0xd8, 0xbb, 0x6b, 0xab, 0xd7, 0x61, 0x45, 0x82, 0x47, 0xa0, 0xfe,
-	0xa4, 0x17, 0xf5, 0x0e, 0x8e, 0x47, 0x5d, 0x1f, 0x1d, 0x75, 0x51, 0x5c, 0x31, 0xb7, 0x46, 0x7c,
-	0x13, 0x43, 0x07, 0x45, 0x90, 0xa7, 0xc6, 0x87, 0x7c, 0x10, 0x93, 0x40, 0xfe, 0xc3, 0x14, 0xac,
-	0x56, 0x88, 0xf5, 0xf5, 0x96, 0x29, 0x4a, 0xdf, 0x68, 0x80, 0xca, 0x4b, 0x8d, 0x37, 0x41, 0xe3,
-	0x65, 0x7f, 0x2d, 0x2e, 0xea, 0x53, 0x2c, 0xea, 0x55, 0xce, 0x31, 0xb8, 0x35, 0xba, 0x0b, 0xd7,
-	0x74, 0xd3, 0x8c, 0x15, 0x9d, 0x60, 0xa2, 0x57, 0x74, 0xd3, 0x8c, 0x91, 0xbb, 0x0f, 0x28, 0xc8,
-	0xc5, 0x5a, 0xd7, 0x59, 0xe9, 0x21, 0xce, 0x9a, 0x09, 0x64, 0xca, 0xa1, 0xd3, 0xe6, 0x03, 0xa7,
-	0xc5, 0xec, 0xb7, 0xba, 0xc6, 0x6e, 0xe1, 0x64, 0xbf, 0x08, 0xff, 0xfd, 0x4a, 0x81, 0xc5, 0x90,
-	0x2f, 0x7a, 0x1b, 0xc8, 0x7d, 0x97, 0x78, 0xbd, 0xa4, 0x92, 0xaf, 0x97, 0x71, 0xe6, 0xc5, 0x0a,
-	0x2c, 0x25, 0xda, 0x2d, 0xb0, 0xbd, 0xc7, 0x27, 0x51, 0x7b, 0x98, 0x96, 0x0d, 0xc3, 0x0f, 0xcf,
-	0x9d, 0x9e, 0x67, 0x37, 0x1e, 0xd5, 0x2c, 0x4c, 0x76, 0xf4, 0x46, 0x1b, 0x8b, 0xbc, 0xe6, 0x1f,
-	0xe8, 0x0e, 0x64, 0x88, 0x6d, 0x39, 0xc1, 0x83, 0x23, 0x31, 0x5a, 0xf0, 0x6d, 0x5e, 0x0c, 0x2c,
-	0x16, 0x0b, 0x62, 0x8e, 0xd4, 0x6f, 0x8a, 0x30, 0xf4, 0x5f, 0x0a, 0xbc, 0x1c, 0x82, 0xd9, 0xc3,
-	0x8e, 0xb9, 0x83, 0x9d, 0x63, 0xff, 0x85, 0x90, 0x1b, 0x7b, 0x17, 0xae, 0x89, 0xf0, 0x35, 0xb1,
-	0x63, 0x77, 0x5b, 0xda, 0x30, 0x76, 0xaf, 0x70, 0xf2, 0x0e, 0xa3, 0x96, 0x03, 0x22, 0xba, 0x03,
-	0xb3, 0x7e, 0xe0, 0x0e, 0x08, 0xf1, 0xa8, 0x45, 0xba, 0x69, 0xf6, 0x4b, 0x44, 0x0e, 0x2e, 0x7d,
-	0xb6, 0x83, 0x5b, 0x82, 0x85, 0x04, 0xac, 0xc2, 0x1b, 0xbf, 0x56, 0x58, 0x81, 0x51, 0x36, 0xcd,
-	0xaf, 0x61, 0x5a, 0x26, 0x04, 0xd3, 0x6f, 0xf8, 0xa7, 0x30, 0x96, 0xfe, 0x7f, 0x0f, 0x2e, 0x39,
-	0xfe, 0xed, 0xed, 0xef, 0x5a, 0x63, 0x87, 0x1b, 0x4c, 0x33, 0xae, 0xc7, 0x3f, 0xe0, 0x11, 0x13,
-	0xc4, 0x6b, 0x30, 0xed, 0x44, 0xec, 0x8a, 0x2d, 0x92, 0x16, 0xd9, 0x89, 0xc6, 0x60, 0x10, 0x20,
-	0x7f, 0xab, 0xb0, 0x7b, 0xcb, 0x0f, 0x88, 0x5e, 0xb9, 0xfe, 0x3b, 0x3b, 0x1e, 0x6b, 0x77, 0x12,
-	0x93, 0x3a, 0xd5, 0x24, 0x66, 0xac, 0x89, 0xc8, 0x2f, 0x9a, 0x64, 0x20, 0x02, 0xf0, 0x2f, 0x15,
-	0x58, 0xab, 0x10, 0xab, 0xca, 0x22, 0xf2, 0x14, 0x98, 0x63, 0x26, 0x37, 0x3c, 0xc8, 0xfb, 0x26,
-	0x37, 0x63, 0xc5, 0x96, 0x87, 0x9b, 0xc3, 0x6c, 0x16, 0xf0, 0x7e, 0xc3, 0xef, 0xd1, 0xed, 0x03,
-	0xdd, 0xb1, 0x30, 0x1f, 0xae, 0x8e, 0x86, 0xab, 0x0c, 0xe0, 0xe0, 0xa3, 0x9a, 0x98, 0xdc, 0xa6,
-	0x46, 0x9e, 0xdc, 0x66, 0x1d, 0x7c, 0xc4, 0x7f, 0x7c, 0x01, 0xd7, 0x6a, 0x3c, 0x0c, 0x01, 0xf5,
-	0xfd, 0x14, 0x2b, 0x36, 0x82, 0x6e, 0xf6, 0x2d, 0x62, 0x78, 0xee, 0xd1, 0x68, 0x60, 0x8d, 0xb0,
-	0x04, 0x49, 0x0d, 0x6b, 0xcb, 0xef, 0x9c, 0xb4, 0x2d, 0x97, 0x14, 0x69, 0x13, 0x43, 0x8b, 0xb4,
-	0xf4, 0x38, 0x4a, 0x95, 0x24, 0x8f, 0x08, 0xbf, 0x3d, 0x0b, 0x53, 0x3e, 0xd2, 0x38, 0xf5, 0x7b,
-	0xee, 0x33, 0xea, 0x07, 0x4f, 0x5b, 0xb9, 0x4d, 0x27, 0x5d, 0x07, 0x09, 0x20, 0x85, 0x33, 0x7e,
-	0xc2, 0xe7, 0xbb, 0xfc, 0x19, 0x78, 0xa8, 0x7b, 0x7a, 0x33, 0xbc, 0xdf, 0x23, 0x96, 0x28, 0x23,
-	0x5b, 0x82, 0x36, 0x21, 0xd3, 0x62, 0x1b, 0x31, 0xf3, 0x73, 0xa5, 0x97, 0xe3, 0xb3, 0x88, 0x2b,
-	0x0b, 0x2e, 0x44, 0x2e, 0x31, 0x80, 0x82, 0x8f, 0x7a, 0xa3, 0xd6, 0x71, 0xcb, 0x4b, 0xff, 0x9e,
-	0x83, 0x89, 0x0a, 0xb1, 0x50, 0x0d, 0xa6, 0x82, 0x5e, 0x04, 0xe5, 0x13, 0x12, 0x76, 0x60, 0x24,
-	0xac, 0xbd, 0x32, 0x02, 0x27, 0x57, 0xe4, 0x2b, 0x08, 0x9a, 0x1c, 0x89, 0x82, 0xbe, 0xb1, 0xaf,
-	0x44, 0x41, 0xff, 0xe8, 0x16, 0x7d, 0x0b, 0x32, 0x7c, 0xa6, 0x8a, 0x6e, 0x26, 0x0a, 0x45, 0x06,
-	0xbb, 0xda, 0xad, 0xa1, 0x7c, 0xdd, 0xad, 0xf9, 0xd4, 0x54, 0xb2, 0x75, 0x64, 0x74, 0x2b, 0xd9,
-	0x3a, 0x3a, 0x7e, 0x45, 0x7b, 0x90, 0xae, 0xd8, 0x0e, 0x45, 0x37, 0x12, 0x05, 0x7a, 0x26, 0xb3,
-	0xda, 0xda, 0x10, 0xae, 0xee, 0xa6, 0x5b, 0x6d, 0xcf, 0x91, 0x6c, 0xda, 0x33, 0x55, 0x95, 0x6c,
-	0xda, 0x3b, 0xf6, 0x44, 0x75, 0xc8, 0x86, 0xbf, 0xd8, 0x40, 0x92, 0x73, 0xe9, 0xfb, 0x25, 0x8d,
-	0x76, 0x7b, 0x14, 0x56, 0xa1, 0xe3, 0x10, 0xce, 0xf7, 0xfe, 0x42, 0x02, 0xbd, 0x3a, 0xc4, 0x8d,
-	0x51, 0x4d, 0xeb, 0x23, 0x72, 0x77, 0x23, 0x32, 0xb8, 0xe3, 0x24, 0x11, 0xd9, 0x37, 0xe6, 0x95,
-	0x44, 0x64, 0xff, 0x40, 0x54, 0x78, 0x8c, 0xbf, 0x73, 0x72, 0x8f, 0x45, 0x66, 0x49, 0x72, 0x8f,
-	0x45, 0x27, 0x04, 0x3e, 0x88, 0xb0, 0x21, 0x49, 0x06, 0xd1, 0xd7, 0x04, 0x49, 0x40, 0xf4, 0xb7,
-	0x1d, 0xe8, 0x00, 0x72, 0x3d, 0x63, 0x40, 0xf4, 0xb9, 0x44, 0xc9, 0xc1, 0xa1, 0xa8, 0xf6, 0xea,
-	0x68, 0xcc, 0x42, 0xd3, 0x11, 0x5c, 0xea, 0xbf, 0x68, 0xd1, 0x9d, 0xc4, 0x1d, 0x12, 0x06, 0x90,
-	0xda, 0xc6, 0x09, 0x24, 0x84, 0xe2, 0x47, 0x30, 0x1d, 0xfd, 0x95, 0x38, 0x2a, 0x24, 0x6e, 0x12,
-	0xfb, 0x87, 0x00, 0x5a, 0x71, 0x64, 0x7e, 0xa1, 0xf2, 0x03, 0x05, 0xe6, 0x12, 0xc7, 0x3f, 0xe8,
-	0x0d, 0x59, 0x00, 0x48, 0xe7, 0x90, 0xda, 0xe6, 0x69, 0x44, 0x85, 0x51, 0xef, 0x29, 0x70, 0x35,
-	0x7e, 0x34, 0x83, 0xee, 0x26, 0x7b, 0x55, 0x36, 0x9b, 0xd2, 0x5e, 0x3f, 0xb1, 0xdc, 0x80, 0x2d,
-	0xfd, 0xc3, 0x92, 0xa1, 0xb6, 0x24, 0x4c, 0x8c, 0x86, 0xda, 0x92, 0x34, 0x95, 0x41, 0xdf, 0x57,
-	0x40, 0x4d, 0x1a, 0x3d, 0xa0, 0x7b, 0x89, 0xbb, 0x0e, 0x99, 0xe2, 0x68, 0x6f, 0x9c, 0x42, 0x52,
-	0x58, 0xf4, 0xae, 0x02, 0xb3, 0x71, 0xc3, 0x02, 0xf4, 0xf9, 0x21, 0x7b, 0xc6, 0xce, 0x44, 0xb4,
-	0x2f, 0x9c, 0x50, 0xaa, 0x9b, 0x37, 0xd1, 0x11, 0x80, 0x24, 0x6f, 0x62, 0xc7, 0x16, 0x92, 0xbc,
-	0x89, 0x9f, 0x2d, 0xa0, 0xef, 0x00, 0x1a, 0xec, 0xb5, 0x51, 0x69, 0x88, 0xfd, 0x31, 0x43, 0x08,
-	0xed, 0xb5, 0x13, 0xc9, 0x08, 0xf5, 0xef, 0xc0, 0xcc, 0x40, 0x13, 0x8c, 0x36, 0x64, 0x29, 0x17,
-	0xdb, 0xf4, 0x6b, 0xa5, 0x93, 0x88, 0xf4, 0x44, 0x61, 0x52, 0x5f, 0x2a, 0x89, 0xc2, 0x21, 0x3d,
-	0xb9, 0x24, 0x0a, 0x87, 0x35, 0xc1, 0xe8, 0x47, 0x0a, 0xcc, 0x4b, 0xba, 0x49, 0xf4, 0xc5, 0xc4,
-	0xad, 0x87, 0xf7, 0xcd, 0xda, 0x9b, 0xa7, 0x13, 0xee, 0x49, 0x90, 0xb8, 0xb6, 0x4f, 0x92, 0x20,
-	0x92, 0x66, 0x57, 0x92, 0x20, 0xb2, 0xde, 0x92, 0x5d, 0x62, 0xf1, 0x6d, 0x94, 0xe4, 0x12, 0x93,
-	0x76, 0xa2, 0x92, 0x4b, 0x4c, 0xde, 0xaf, 0x05, 0xe1, 0x13, 0xdb, 0xc7, 0xc8, 0xc3, 0x47, 0xd6,
-	0xdf, 0xc9, 0xc3, 0x47, 0xda, 0x34, 0xf9, 0xc5, 0x5e, 0x6f, 0x4b, 0x22, 0x29, 0xf6, 0x62, 0xfa,
-	0x2a, 0x49, 0xb1, 0x17, 0xd7, 0xe7, 0x68, 0x93, 0xdf, 0x7d, 0xfe, 0xe4, 0xb6, 0xb2, 0x65, 0x7d,
-	0xf4, 0x74, 0x51, 0xf9, 0xf8, 0xe9, 0xa2, 0xf2, 0xf7, 0xa7, 0x8b, 0xca, 0xfb, 0xcf, 0x16, 0xcf,
-	0x7d, 0xfc, 0x6c, 0xf1, 0xdc, 0x5f, 0x9e, 0x2d, 0x9e, 0x83, 0x6b, 0xb6, 0x1b, 0xbb, 0xe3, 0x43,
-	0xe5, 0xdb, 0xbd, 0xad, 0x68, 0x97, 0x65, 0xdd, 0x76, 0x7b, 0xbe, 0x8a, 0x8f, 0x83, 0x3f, 0x23,
-	0x64, 0x3d, 0x69, 0x3d, 0xc3, 0xfe, 0x52, 0xef, 0xb5, 0xff, 0x05, 0x00, 0x00, 0xff, 0xff, 0x5c,
-	0x2e, 0xee, 0x73, 0x9f, 0x29, 0x00, 0x00,
+// MsgSetBasketInfoRequest defines a msg to set the components that back a basket marker's coin.
+type MsgSetBasketInfoRequest struct {
+	// basket_info is the basket info to give the marker. Its denom is the denom of the marker to update.
+	BasketInfo BasketInfo `protobuf:"bytes,1,opt,name=basket_info,json=basketInfo,proto3" json:"basket_info"`
+	// The signer of the message. Must have admin authority or be the governance module account address.
+	Administrator string `protobuf:"bytes,2,opt,name=administrator,proto3" json:"administrator,omitempty"`
 }
 
-func (this *MsgSupplyIncreaseProposalRequest) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
-
-	that1, ok := that.(*MsgSupplyIncreaseProposalRequest)
-	if !ok {
-		that2, ok := that.(MsgSupplyIncreaseProposalRequest)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+func (m *MsgSetBasketInfoRequest) Reset()         { *m = MsgSetBasketInfoRequest{} }
+func (m *MsgSetBasketInfoRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgSetBasketInfoRequest) ProtoMessage()    {}
+func (*MsgSetBasketInfoRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcb203fb73175ed3, []int{56}
+}
+func (m *MsgSetBasketInfoRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetBasketInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetBasketInfoRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.Amount.Equal(&that1.Amount) {
-		return false
-	}
-	if this.TargetAddress != that1.TargetAddress {
-		return false
-	}
-	if this.Authority != that1.Authority {
-		return false
-	}
-	return true
 }
-func (this *MsgSupplyDecreaseProposalRequest) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *MsgSetBasketInfoRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetBasketInfoRequest.Merge(m, src)
+}
+func (m *MsgSetBasketInfoRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetBasketInfoRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetBasketInfoRequest.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*MsgSupplyDecreaseProposalRequest)
-	if !ok {
-		that2, ok := that.(MsgSupplyDecreaseProposalRequest)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.Amount.Equal(that1.Amount) {
-		return false
-	}
-	if this.Authority != that1.Authority {
-		return false
+var xxx_messageInfo_MsgSetBasketInfoRequest proto.InternalMessageInfo
+
+func (m *MsgSetBasketInfoRequest) GetBasketInfo() BasketInfo {
+	if m != nil {
+		return m.BasketInfo
 	}
-	return true
+	return BasketInfo{}
 }
-func (this *MsgUpdateRequiredAttributesRequest) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *MsgSetBasketInfoRequest) GetAdministrator() string {
+	if m != nil {
+		return m.Administrator
 	}
+	return ""
+}
 
-	that1, ok := that.(*MsgUpdateRequiredAttributesRequest)
-	if !ok {
-		that2, ok := that.(MsgUpdateRequiredAttributesRequest)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+// MsgSetBasketInfoResponse defines the Msg/SetBasketInfo response type
+type MsgSetBasketInfoResponse struct {
+}
+
+func (m *MsgSetBasketInfoResponse) Reset()         { *m = MsgSetBasketInfoResponse{} }
+func (m *MsgSetBasketInfoResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetBasketInfoResponse) ProtoMessage()    {}
+func (*MsgSetBasketInfoResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcb203fb73175ed3, []int{57}
+}
+func (m *MsgSetBasketInfoResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetBasketInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetBasketInfoResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Denom != that1.Denom {
-		return false
-	}
-	if len(this.RemoveRequiredAttributes) != len(that1.RemoveRequiredAttributes) {
-		return false
-	}
-	for i := range this.RemoveRequiredAttributes {
-		if this.RemoveRequiredAttributes[i] != that1.RemoveRequiredAttributes[i] {
-			return false
-		}
-	}
-	if len(this.AddRequiredAttributes) != len(that1.AddRequiredAttributes) {
-		return false
-	}
-	for i := range this.AddRequiredAttributes {
-		if this.AddRequiredAttributes[i] != that1.AddRequiredAttributes[i] {
-			return false
-		}
-	}
-	if this.TransferAuthority != that1.TransferAuthority {
-		return false
-	}
-	return true
 }
-func (this *MsgUpdateForcedTransferRequest) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *MsgSetBasketInfoResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetBasketInfoResponse.Merge(m, src)
+}
+func (m *MsgSetBasketInfoResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetBasketInfoResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetBasketInfoResponse.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*MsgUpdateForcedTransferRequest)
-	if !ok {
-		that2, ok := that.(MsgUpdateForcedTransferRequest)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+var xxx_messageInfo_MsgSetBasketInfoResponse proto.InternalMessageInfo
+
+// MsgSetIBCMemoActionConfigRequest defines a msg to set the actions that ICS-20 memos are allowed to request
+// when a marker's coin is received over IBC.
+type MsgSetIBCMemoActionConfigRequest struct {
+	// config is the ibc memo action config to give the marker. Its denom is the denom of the marker to update.
+	// If it doesn't have any actions, the marker's config is removed.
+	Config IBCMemoActionConfig `protobuf:"bytes,1,opt,name=config,proto3" json:"config"`
+	// The signer of the message. Must have admin authority or be the governance module account address.
+	Administrator string `protobuf:"bytes,2,opt,name=administrator,proto3" json:"administrator,omitempty"`
+}
+
+func (m *MsgSetIBCMemoActionConfigRequest) Reset()         { *m = MsgSetIBCMemoActionConfigRequest{} }
+func (m *MsgSetIBCMemoActionConfigRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgSetIBCMemoActionConfigRequest) ProtoMessage()    {}
+func (*MsgSetIBCMemoActionConfigRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcb203fb73175ed3, []int{58}
+}
+func (m *MsgSetIBCMemoActionConfigRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetIBCMemoActionConfigRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetIBCMemoActionConfigRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Denom != that1.Denom {
-		return false
-	}
-	if this.AllowForcedTransfer != that1.AllowForcedTransfer {
-		return false
-	}
-	if this.Authority != that1.Authority {
-		return false
+}
+func (m *MsgSetIBCMemoActionConfigRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetIBCMemoActionConfigRequest.Merge(m, src)
+}
+func (m *MsgSetIBCMemoActionConfigRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetIBCMemoActionConfigRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetIBCMemoActionConfigRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetIBCMemoActionConfigRequest proto.InternalMessageInfo
+
+func (m *MsgSetIBCMemoActionConfigRequest) GetConfig() IBCMemoActionConfig {
+	if m != nil {
+		return m.Config
 	}
-	return true
+	return IBCMemoActionConfig{}
 }
-func (this *MsgSetAccountDataRequest) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *MsgSetIBCMemoActionConfigRequest) GetAdministrator() string {
+	if m != nil {
+		return m.Administrator
 	}
+	return ""
+}
 
-	that1, ok := that.(*MsgSetAccountDataRequest)
-	if !ok {
-		that2, ok := that.(MsgSetAccountDataRequest)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+// MsgSetIBCMemoActionConfigResponse defines the Msg/SetIBCMemoActionConfig response type
+type MsgSetIBCMemoActionConfigResponse struct {
+}
+
+func (m *MsgSetIBCMemoActionConfigResponse) Reset()         { *m = MsgSetIBCMemoActionConfigResponse{} }
+func (m *MsgSetIBCMemoActionConfigResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetIBCMemoActionConfigResponse) ProtoMessage()    {}
+func (*MsgSetIBCMemoActionConfigResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcb203fb73175ed3, []int{59}
+}
+func (m *MsgSetIBCMemoActionConfigResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetIBCMemoActionConfigResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetIBCMemoActionConfigResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Denom != that1.Denom {
-		return false
-	}
-	if this.Value != that1.Value {
-		return false
-	}
-	if this.Signer != that1.Signer {
-		return false
-	}
-	return true
 }
-func (this *MsgUpdateSendDenyListRequest) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *MsgSetIBCMemoActionConfigResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetIBCMemoActionConfigResponse.Merge(m, src)
+}
+func (m *MsgSetIBCMemoActionConfigResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetIBCMemoActionConfigResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetIBCMemoActionConfigResponse.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*MsgUpdateSendDenyListRequest)
-	if !ok {
-		that2, ok := that.(MsgUpdateSendDenyListRequest)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+var xxx_messageInfo_MsgSetIBCMemoActionConfigResponse proto.InternalMessageInfo
+
+// MsgBasketDepositRequest defines a msg to mint basket coin in exchange for a deposit of its components.
+type MsgBasketDepositRequest struct {
+	// amount is the basket coin to mint. It must be a multiple of the basket's basket_amount.
+	Amount types1.Coin `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount"`
+	// depositor is the account that provides the components and receives the basket coin.
+	Depositor string `protobuf:"bytes,2,opt,name=depositor,proto3" json:"depositor,omitempty"`
+}
+
+func (m *MsgBasketDepositRequest) Reset()         { *m = MsgBasketDepositRequest{} }
+func (m *MsgBasketDepositRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgBasketDepositRequest) ProtoMessage()    {}
+func (*MsgBasketDepositRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcb203fb73175ed3, []int{60}
+}
+func (m *MsgBasketDepositRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBasketDepositRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBasketDepositRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Denom != that1.Denom {
-		return false
+}
+func (m *MsgBasketDepositRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBasketDepositRequest.Merge(m, src)
+}
+func (m *MsgBasketDepositRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBasketDepositRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBasketDepositRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgBasketDepositRequest proto.InternalMessageInfo
+
+func (m *MsgBasketDepositRequest) GetAmount() types1.Coin {
+	if m != nil {
+		return m.Amount
 	}
-	if len(this.RemoveDeniedAddresses) != len(that1.RemoveDeniedAddresses) {
-		return false
+	return types1.Coin{}
+}
+
+func (m *MsgBasketDepositRequest) GetDepositor() string {
+	if m != nil {
+		return m.Depositor
 	}
-	for i := range this.RemoveDeniedAddresses {
-		if this.RemoveDeniedAddresses[i] != that1.RemoveDeniedAddresses[i] {
-			return false
+	return ""
+}
+
+// MsgBasketDepositResponse defines the Msg/BasketDeposit response type
+type MsgBasketDepositResponse struct {
+	// components are the underlying coins that were deposited.
+	Components github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=components,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"components"`
+}
+
+func (m *MsgBasketDepositResponse) Reset()         { *m = MsgBasketDepositResponse{} }
+func (m *MsgBasketDepositResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgBasketDepositResponse) ProtoMessage()    {}
+func (*MsgBasketDepositResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcb203fb73175ed3, []int{61}
+}
+func (m *MsgBasketDepositResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBasketDepositResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBasketDepositResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(this.AddDeniedAddresses) != len(that1.AddDeniedAddresses) {
-		return false
+}
+func (m *MsgBasketDepositResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBasketDepositResponse.Merge(m, src)
+}
+func (m *MsgBasketDepositResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBasketDepositResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBasketDepositResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgBasketDepositResponse proto.InternalMessageInfo
+
+func (m *MsgBasketDepositResponse) GetComponents() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Components
 	}
-	for i := range this.AddDeniedAddresses {
-		if this.AddDeniedAddresses[i] != that1.AddDeniedAddresses[i] {
-			return false
+	return nil
+}
+
+// MsgBasketWithdrawRequest defines a msg to burn basket coin in exchange for a withdrawal of its components.
+type MsgBasketWithdrawRequest struct {
+	// amount is the basket coin to burn. It must be a multiple of the basket's basket_amount.
+	Amount types1.Coin `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount"`
+	// holder is the account that provides the basket coin and receives the components.
+	Holder string `protobuf:"bytes,2,opt,name=holder,proto3" json:"holder,omitempty"`
+}
+
+func (m *MsgBasketWithdrawRequest) Reset()         { *m = MsgBasketWithdrawRequest{} }
+func (m *MsgBasketWithdrawRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgBasketWithdrawRequest) ProtoMessage()    {}
+func (*MsgBasketWithdrawRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcb203fb73175ed3, []int{62}
+}
+func (m *MsgBasketWithdrawRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBasketWithdrawRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBasketWithdrawRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if this.Authority != that1.Authority {
-		return false
+}
+func (m *MsgBasketWithdrawRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBasketWithdrawRequest.Merge(m, src)
+}
+func (m *MsgBasketWithdrawRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBasketWithdrawRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBasketWithdrawRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgBasketWithdrawRequest proto.InternalMessageInfo
+
+func (m *MsgBasketWithdrawRequest) GetAmount() types1.Coin {
+	if m != nil {
+		return m.Amount
 	}
-	return true
+	return types1.Coin{}
 }
-func (this *MsgSetAdministratorProposalRequest) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *MsgBasketWithdrawRequest) GetHolder() string {
+	if m != nil {
+		return m.Holder
 	}
+	return ""
+}
 
-	that1, ok := that.(*MsgSetAdministratorProposalRequest)
-	if !ok {
-		that2, ok := that.(MsgSetAdministratorProposalRequest)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+// MsgBasketWithdrawResponse defines the Msg/BasketWithdraw response type
+type MsgBasketWithdrawResponse struct {
+	// components are the underlying coins that were withdrawn.
+	Components github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=components,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"components"`
+}
+
+func (m *MsgBasketWithdrawResponse) Reset()         { *m = MsgBasketWithdrawResponse{} }
+func (m *MsgBasketWithdrawResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgBasketWithdrawResponse) ProtoMessage()    {}
+func (*MsgBasketWithdrawResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcb203fb73175ed3, []int{63}
+}
+func (m *MsgBasketWithdrawResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBasketWithdrawResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBasketWithdrawResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Denom != that1.Denom {
-		return false
-	}
-	if len(this.Access) != len(that1.Access) {
-		return false
-	}
-	for i := range this.Access {
-		if !this.Access[i].Equal(&that1.Access[i]) {
-			return false
-		}
-	}
-	if this.Authority != that1.Authority {
-		return false
-	}
-	return true
 }
-func (this *MsgRemoveAdministratorProposalRequest) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+func (m *MsgBasketWithdrawResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBasketWithdrawResponse.Merge(m, src)
+}
+func (m *MsgBasketWithdrawResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBasketWithdrawResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBasketWithdrawResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgBasketWithdrawResponse proto.InternalMessageInfo
+
+func (m *MsgBasketWithdrawResponse) GetComponents() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Components
 	}
+	return nil
+}
 
-	that1, ok := that.(*MsgRemoveAdministratorProposalRequest)
-	if !ok {
-		that2, ok := that.(MsgRemoveAdministratorProposalRequest)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+// MsgAddNetAssetValuesRequest defines the Msg/AddNetAssetValues request type
+type MsgAddNetAssetValuesRequest struct {
+	Denom          string          `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Administrator  string          `protobuf:"bytes,2,opt,name=administrator,proto3" json:"administrator,omitempty"`
+	NetAssetValues []NetAssetValue `protobuf:"bytes,3,rep,name=net_asset_values,json=netAssetValues,proto3" json:"net_asset_values"`
+}
+
+func (m *MsgAddNetAssetValuesRequest) Reset()         { *m = MsgAddNetAssetValuesRequest{} }
+func (m *MsgAddNetAssetValuesRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgAddNetAssetValuesRequest) ProtoMessage()    {}
+func (*MsgAddNetAssetValuesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcb203fb73175ed3, []int{64}
+}
+func (m *MsgAddNetAssetValuesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddNetAssetValuesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddNetAssetValuesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+}
+func (m *MsgAddNetAssetValuesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddNetAssetValuesRequest.Merge(m, src)
+}
+func (m *MsgAddNetAssetValuesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddNetAssetValuesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddNetAssetValuesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAddNetAssetValuesRequest proto.InternalMessageInfo
+
+func (m *MsgAddNetAssetValuesRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	if this.Denom != that1.Denom {
-		return false
+	return ""
+}
+
+func (m *MsgAddNetAssetValuesRequest) GetAdministrator() string {
+	if m != nil {
+		return m.Administrator
 	}
-	if len(this.RemovedAddress) != len(that1.RemovedAddress) {
-		return false
+	return ""
+}
+
+func (m *MsgAddNetAssetValuesRequest) GetNetAssetValues() []NetAssetValue {
+	if m != nil {
+		return m.NetAssetValues
 	}
-	for i := range this.RemovedAddress {
-		if this.RemovedAddress[i] != that1.RemovedAddress[i] {
-			return false
+	return nil
+}
+
+// MsgAddNetAssetValuesResponse defines the Msg/AddNetAssetValue response type
+type MsgAddNetAssetValuesResponse struct {
+}
+
+func (m *MsgAddNetAssetValuesResponse) Reset()         { *m = MsgAddNetAssetValuesResponse{} }
+func (m *MsgAddNetAssetValuesResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAddNetAssetValuesResponse) ProtoMessage()    {}
+func (*MsgAddNetAssetValuesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcb203fb73175ed3, []int{65}
+}
+func (m *MsgAddNetAssetValuesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddNetAssetValuesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddNetAssetValuesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if this.Authority != that1.Authority {
-		return false
-	}
-	return true
 }
-func (this *MsgChangeStatusProposalRequest) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *MsgAddNetAssetValuesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddNetAssetValuesResponse.Merge(m, src)
+}
+func (m *MsgAddNetAssetValuesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddNetAssetValuesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddNetAssetValuesResponse.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*MsgChangeStatusProposalRequest)
-	if !ok {
-		that2, ok := that.(MsgChangeStatusProposalRequest)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+var xxx_messageInfo_MsgAddNetAssetValuesResponse proto.InternalMessageInfo
+
+// MsgBindMarkerNameRequest defines a msg to bind a new name under a name owned by a marker.
+// Signer must have admin authority or be a gov proposal.
+type MsgBindMarkerNameRequest struct {
+	// The denomination of the marker that owns the parent name.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// The parent name. It must resolve to the marker's address.
+	Parent string `protobuf:"bytes,2,opt,name=parent,proto3" json:"parent,omitempty"`
+	// The new name segment to bind under the parent, e.g. "treasury" to bind "treasury.<parent>".
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// The address that the new name will resolve to.
+	Address string `protobuf:"bytes,4,opt,name=address,proto3" json:"address,omitempty"`
+	// Whether the new name is restricted, i.e. only the new name's owner can bind names under it.
+	Restricted bool `protobuf:"varint,5,opt,name=restricted,proto3" json:"restricted,omitempty"`
+	// The signer of this message. Must have admin authority or be the governance module account address.
+	Administrator string `protobuf:"bytes,6,opt,name=administrator,proto3" json:"administrator,omitempty"`
+}
+
+func (m *MsgBindMarkerNameRequest) Reset()         { *m = MsgBindMarkerNameRequest{} }
+func (m *MsgBindMarkerNameRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgBindMarkerNameRequest) ProtoMessage()    {}
+func (*MsgBindMarkerNameRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcb203fb73175ed3, []int{66}
+}
+func (m *MsgBindMarkerNameRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBindMarkerNameRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBindMarkerNameRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Denom != that1.Denom {
-		return false
-	}
-	if this.NewStatus != that1.NewStatus {
-		return false
-	}
-	if this.Authority != that1.Authority {
-		return false
+}
+func (m *MsgBindMarkerNameRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBindMarkerNameRequest.Merge(m, src)
+}
+func (m *MsgBindMarkerNameRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBindMarkerNameRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBindMarkerNameRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgBindMarkerNameRequest proto.InternalMessageInfo
+
+func (m *MsgBindMarkerNameRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return true
+	return ""
 }
-func (this *MsgWithdrawEscrowProposalRequest) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *MsgBindMarkerNameRequest) GetParent() string {
+	if m != nil {
+		return m.Parent
 	}
+	return ""
+}
 
-	that1, ok := that.(*MsgWithdrawEscrowProposalRequest)
-	if !ok {
-		that2, ok := that.(MsgWithdrawEscrowProposalRequest)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+func (m *MsgBindMarkerNameRequest) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+	return ""
+}
+
+func (m *MsgBindMarkerNameRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	if this.Denom != that1.Denom {
-		return false
+	return ""
+}
+
+func (m *MsgBindMarkerNameRequest) GetRestricted() bool {
+	if m != nil {
+		return m.Restricted
 	}
-	if len(this.Amount) != len(that1.Amount) {
-		return false
+	return false
+}
+
+func (m *MsgBindMarkerNameRequest) GetAdministrator() string {
+	if m != nil {
+		return m.Administrator
 	}
-	for i := range this.Amount {
-		if !this.Amount[i].Equal(&that1.Amount[i]) {
-			return false
+	return ""
+}
+
+// MsgBindMarkerNameResponse defines the Msg/BindMarkerName response type
+type MsgBindMarkerNameResponse struct {
+}
+
+func (m *MsgBindMarkerNameResponse) Reset()         { *m = MsgBindMarkerNameResponse{} }
+func (m *MsgBindMarkerNameResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgBindMarkerNameResponse) ProtoMessage()    {}
+func (*MsgBindMarkerNameResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcb203fb73175ed3, []int{67}
+}
+func (m *MsgBindMarkerNameResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBindMarkerNameResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBindMarkerNameResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if this.TargetAddress != that1.TargetAddress {
-		return false
-	}
-	if this.Authority != that1.Authority {
-		return false
-	}
-	return true
+}
+func (m *MsgBindMarkerNameResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBindMarkerNameResponse.Merge(m, src)
+}
+func (m *MsgBindMarkerNameResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBindMarkerNameResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBindMarkerNameResponse.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+var xxx_messageInfo_MsgBindMarkerNameResponse proto.InternalMessageInfo
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+// MsgDeleteMarkerNameRequest defines a msg to delete a name owned by a marker.
+// Signer must have admin authority or be a gov proposal.
+type MsgDeleteMarkerNameRequest struct {
+	// The denomination of the marker that owns the name.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// The name to delete. It must resolve to the marker's address.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// The signer of this message. Must have admin authority or be the governance module account address.
+	Administrator string `protobuf:"bytes,3,opt,name=administrator,proto3" json:"administrator,omitempty"`
+}
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// Finalize
-	Finalize(ctx context.Context, in *MsgFinalizeRequest, opts ...grpc.CallOption) (*MsgFinalizeResponse, error)
-	// Activate
-	Activate(ctx context.Context, in *MsgActivateRequest, opts ...grpc.CallOption) (*MsgActivateResponse, error)
-	// Cancel
-	Cancel(ctx context.Context, in *MsgCancelRequest, opts ...grpc.CallOption) (*MsgCancelResponse, error)
-	// Delete
-	Delete(ctx context.Context, in *MsgDeleteRequest, opts ...grpc.CallOption) (*MsgDeleteResponse, error)
-	// Mint
-	Mint(ctx context.Context, in *MsgMintRequest, opts ...grpc.CallOption) (*MsgMintResponse, error)
-	// Burn
-	Burn(ctx context.Context, in *MsgBurnRequest, opts ...grpc.CallOption) (*MsgBurnResponse, error)
-	// AddAccess
-	AddAccess(ctx context.Context, in *MsgAddAccessRequest, opts ...grpc.CallOption) (*MsgAddAccessResponse, error)
-	// DeleteAccess
-	DeleteAccess(ctx context.Context, in *MsgDeleteAccessRequest, opts ...grpc.CallOption) (*MsgDeleteAccessResponse, error)
-	// Withdraw
-	Withdraw(ctx context.Context, in *MsgWithdrawRequest, opts ...grpc.CallOption) (*MsgWithdrawResponse, error)
-	// AddMarker
-	AddMarker(ctx context.Context, in *MsgAddMarkerRequest, opts ...grpc.CallOption) (*MsgAddMarkerResponse, error)
-	// Transfer marker denominated coin between accounts
-	Transfer(ctx context.Context, in *MsgTransferRequest, opts ...grpc.CallOption) (*MsgTransferResponse, error)
-	// Transfer over ibc any marker(including restricted markers) between ibc accounts.
-	// The relayer is still needed to accomplish ibc middleware relays.
-	IbcTransfer(ctx context.Context, in *MsgIbcTransferRequest, opts ...grpc.CallOption) (*MsgIbcTransferResponse, error)
-	// Allows Denom Metadata (see bank module) to be set for the Marker's Denom
-	SetDenomMetadata(ctx context.Context, in *MsgSetDenomMetadataRequest, opts ...grpc.CallOption) (*MsgSetDenomMetadataResponse, error)
-	// GrantAllowance grants fee allowance to the grantee on the granter's
-	// account with the provided expiration time.
-	GrantAllowance(ctx context.Context, in *MsgGrantAllowanceRequest, opts ...grpc.CallOption) (*MsgGrantAllowanceResponse, error)
-	// AddFinalizeActivateMarker
-	AddFinalizeActivateMarker(ctx context.Context, in *MsgAddFinalizeActivateMarkerRequest, opts ...grpc.CallOption) (*MsgAddFinalizeActivateMarkerResponse, error)
-	// SupplyIncreaseProposal can only be called via gov proposal
-	SupplyIncreaseProposal(ctx context.Context, in *MsgSupplyIncreaseProposalRequest, opts ...grpc.CallOption) (*MsgSupplyIncreaseProposalResponse, error)
-	// SupplyDecreaseProposal can only be called via gov proposal
-	SupplyDecreaseProposal(ctx context.Context, in *MsgSupplyDecreaseProposalRequest, opts ...grpc.CallOption) (*MsgSupplyDecreaseProposalResponse, error)
-	// UpdateRequiredAttributes will only succeed if signer has transfer authority
-	UpdateRequiredAttributes(ctx context.Context, in *MsgUpdateRequiredAttributesRequest, opts ...grpc.CallOption) (*MsgUpdateRequiredAttributesResponse, error)
-	// UpdateForcedTransfer updates the allow_forced_transfer field of a marker via governance proposal.
-	UpdateForcedTransfer(ctx context.Context, in *MsgUpdateForcedTransferRequest, opts ...grpc.CallOption) (*MsgUpdateForcedTransferResponse, error)
-	// SetAccountData sets the accountdata for a denom. Signer must have deposit authority.
-	SetAccountData(ctx context.Context, in *MsgSetAccountDataRequest, opts ...grpc.CallOption) (*MsgSetAccountDataResponse, error)
-	// UpdateSendDenyList will only succeed if signer has admin authority
-	UpdateSendDenyList(ctx context.Context, in *MsgUpdateSendDenyListRequest, opts ...grpc.CallOption) (*MsgUpdateSendDenyListResponse, error)
-	// AddNetAssetValues set the net asset value for a marker
-	AddNetAssetValues(ctx context.Context, in *MsgAddNetAssetValuesRequest, opts ...grpc.CallOption) (*MsgAddNetAssetValuesResponse, error)
-	// SetAdministratorProposal sets administrators with specific access on the marker
-	SetAdministratorProposal(ctx context.Context, in *MsgSetAdministratorProposalRequest, opts ...grpc.CallOption) (*MsgSetAdministratorProposalResponse, error)
-	// RemoveAdministratorProposal removes administrators with specific access on the marker
-	RemoveAdministratorProposal(ctx context.Context, in *MsgRemoveAdministratorProposalRequest, opts ...grpc.CallOption) (*MsgRemoveAdministratorProposalResponse, error)
-	// ChangeStatusProposal is a governance proposal change marker status
-	ChangeStatusProposal(ctx context.Context, in *MsgChangeStatusProposalRequest, opts ...grpc.CallOption) (*MsgChangeStatusProposalResponse, error)
-	// WithdrawEscrowProposal is a governance proposal to withdraw escrow coins from a marker
-	WithdrawEscrowProposal(ctx context.Context, in *MsgWithdrawEscrowProposalRequest, opts ...grpc.CallOption) (*MsgWithdrawEscrowProposalResponse, error)
-	// SetDenomMetadataProposal is a governance proposal to set marker metadata
-	SetDenomMetadataProposal(ctx context.Context, in *MsgSetDenomMetadataProposalRequest, opts ...grpc.CallOption) (*MsgSetDenomMetadataProposalResponse, error)
-	// UpdateParams is a governance proposal endpoint for updating the marker module's params.
-	UpdateParams(ctx context.Context, in *MsgUpdateParamsRequest, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+func (m *MsgDeleteMarkerNameRequest) Reset()         { *m = MsgDeleteMarkerNameRequest{} }
+func (m *MsgDeleteMarkerNameRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgDeleteMarkerNameRequest) ProtoMessage()    {}
+func (*MsgDeleteMarkerNameRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcb203fb73175ed3, []int{68}
 }
-
-type msgClient struct {
-	cc grpc1.ClientConn
-}
-
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgDeleteMarkerNameRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *msgClient) Finalize(ctx context.Context, in *MsgFinalizeRequest, opts ...grpc.CallOption) (*MsgFinalizeResponse, error) {
-	out := new(MsgFinalizeResponse)
-	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/Finalize", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgDeleteMarkerNameRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDeleteMarkerNameRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-func (c *msgClient) Activate(ctx context.Context, in *MsgActivateRequest, opts ...grpc.CallOption) (*MsgActivateResponse, error) {
-	out := new(MsgActivateResponse)
-	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/Activate", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgDeleteMarkerNameRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeleteMarkerNameRequest.Merge(m, src)
 }
-
-func (c *msgClient) Cancel(ctx context.Context, in *MsgCancelRequest, opts ...grpc.CallOption) (*MsgCancelResponse, error) {
-	out := new(MsgCancelResponse)
-	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/Cancel", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgDeleteMarkerNameRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDeleteMarkerNameRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeleteMarkerNameRequest.DiscardUnknown(m)
 }
 
-func (c *msgClient) Delete(ctx context.Context, in *MsgDeleteRequest, opts ...grpc.CallOption) (*MsgDeleteResponse, error) {
-	out := new(MsgDeleteResponse)
-	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/Delete", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgDeleteMarkerNameRequest proto.InternalMessageInfo
+
+func (m *MsgDeleteMarkerNameRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) Mint(ctx context.Context, in *MsgMintRequest, opts ...grpc.CallOption) (*MsgMintResponse, error) {
-	out := new(MsgMintResponse)
-	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/Mint", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgDeleteMarkerNameRequest) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) Burn(ctx context.Context, in *MsgBurnRequest, opts ...grpc.CallOption) (*MsgBurnResponse, error) {
-	out := new(MsgBurnResponse)
-	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/Burn", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgDeleteMarkerNameRequest) GetAdministrator() string {
+	if m != nil {
+		return m.Administrator
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) AddAccess(ctx context.Context, in *MsgAddAccessRequest, opts ...grpc.CallOption) (*MsgAddAccessResponse, error) {
-	out := new(MsgAddAccessResponse)
-	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/AddAccess", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// MsgDeleteMarkerNameResponse defines the Msg/DeleteMarkerName response type
+type MsgDeleteMarkerNameResponse struct {
 }
 
-func (c *msgClient) DeleteAccess(ctx context.Context, in *MsgDeleteAccessRequest, opts ...grpc.CallOption) (*MsgDeleteAccessResponse, error) {
-	out := new(MsgDeleteAccessResponse)
-	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/DeleteAccess", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgDeleteMarkerNameResponse) Reset()         { *m = MsgDeleteMarkerNameResponse{} }
+func (m *MsgDeleteMarkerNameResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDeleteMarkerNameResponse) ProtoMessage()    {}
+func (*MsgDeleteMarkerNameResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcb203fb73175ed3, []int{69}
+}
+func (m *MsgDeleteMarkerNameResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDeleteMarkerNameResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDeleteMarkerNameResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgDeleteMarkerNameResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeleteMarkerNameResponse.Merge(m, src)
+}
+func (m *MsgDeleteMarkerNameResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDeleteMarkerNameResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeleteMarkerNameResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) Withdraw(ctx context.Context, in *MsgWithdrawRequest, opts ...grpc.CallOption) (*MsgWithdrawResponse, error) {
-	out := new(MsgWithdrawResponse)
-	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/Withdraw", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_MsgDeleteMarkerNameResponse proto.InternalMessageInfo
+
+// MsgDelegateEscrowRequest defines a msg to stake some of a marker's escrowed funds with a validator.
+// Signer must have delegate authority or be a gov proposal.
+type MsgDelegateEscrowRequest struct {
+	// The denomination of the marker whose escrowed funds are being delegated.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// The address of the validator to delegate to.
+	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// The amount to delegate. It must be in the bond denom.
+	Amount types1.Coin `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount"`
+	// The signer of this message. Must have delegate authority or be the governance module account address.
+	Administrator string `protobuf:"bytes,4,opt,name=administrator,proto3" json:"administrator,omitempty"`
 }
 
-func (c *msgClient) AddMarker(ctx context.Context, in *MsgAddMarkerRequest, opts ...grpc.CallOption) (*MsgAddMarkerResponse, error) {
-	out := new(MsgAddMarkerResponse)
-	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/AddMarker", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgDelegateEscrowRequest) Reset()         { *m = MsgDelegateEscrowRequest{} }
+func (m *MsgDelegateEscrowRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgDelegateEscrowRequest) ProtoMessage()    {}
+func (*MsgDelegateEscrowRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcb203fb73175ed3, []int{70}
+}
+func (m *MsgDelegateEscrowRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDelegateEscrowRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDelegateEscrowRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgDelegateEscrowRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDelegateEscrowRequest.Merge(m, src)
+}
+func (m *MsgDelegateEscrowRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDelegateEscrowRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDelegateEscrowRequest.DiscardUnknown(m)
 }
 
-func (c *msgClient) Transfer(ctx context.Context, in *MsgTransferRequest, opts ...grpc.CallOption) (*MsgTransferResponse, error) {
-	out := new(MsgTransferResponse)
-	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/Transfer", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgDelegateEscrowRequest proto.InternalMessageInfo
+
+func (m *MsgDelegateEscrowRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) IbcTransfer(ctx context.Context, in *MsgIbcTransferRequest, opts ...grpc.CallOption) (*MsgIbcTransferResponse, error) {
-	out := new(MsgIbcTransferResponse)
-	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/IbcTransfer", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgDelegateEscrowRequest) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) SetDenomMetadata(ctx context.Context, in *MsgSetDenomMetadataRequest, opts ...grpc.CallOption) (*MsgSetDenomMetadataResponse, error) {
-	out := new(MsgSetDenomMetadataResponse)
-	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/SetDenomMetadata", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgDelegateEscrowRequest) GetAmount() types1.Coin {
+	if m != nil {
+		return m.Amount
 	}
-	return out, nil
+	return types1.Coin{}
 }
 
-func (c *msgClient) GrantAllowance(ctx context.Context, in *MsgGrantAllowanceRequest, opts ...grpc.CallOption) (*MsgGrantAllowanceResponse, error) {
-	out := new(MsgGrantAllowanceResponse)
-	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/GrantAllowance", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgDelegateEscrowRequest) GetAdministrator() string {
+	if m != nil {
+		return m.Administrator
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) AddFinalizeActivateMarker(ctx context.Context, in *MsgAddFinalizeActivateMarkerRequest, opts ...grpc.CallOption) (*MsgAddFinalizeActivateMarkerResponse, error) {
-	out := new(MsgAddFinalizeActivateMarkerResponse)
-	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/AddFinalizeActivateMarker", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// MsgDelegateEscrowResponse defines the Msg/DelegateEscrow response type
+type MsgDelegateEscrowResponse struct {
 }
 
-func (c *msgClient) SupplyIncreaseProposal(ctx context.Context, in *MsgSupplyIncreaseProposalRequest, opts ...grpc.CallOption) (*MsgSupplyIncreaseProposalResponse, error) {
-	out := new(MsgSupplyIncreaseProposalResponse)
-	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/SupplyIncreaseProposal", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgDelegateEscrowResponse) Reset()         { *m = MsgDelegateEscrowResponse{} }
+func (m *MsgDelegateEscrowResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDelegateEscrowResponse) ProtoMessage()    {}
+func (*MsgDelegateEscrowResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcb203fb73175ed3, []int{71}
+}
+func (m *MsgDelegateEscrowResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDelegateEscrowResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDelegateEscrowResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgDelegateEscrowResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDelegateEscrowResponse.Merge(m, src)
+}
+func (m *MsgDelegateEscrowResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDelegateEscrowResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDelegateEscrowResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) SupplyDecreaseProposal(ctx context.Context, in *MsgSupplyDecreaseProposalRequest, opts ...grpc.CallOption) (*MsgSupplyDecreaseProposalResponse, error) {
-	out := new(MsgSupplyDecreaseProposalResponse)
-	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/SupplyDecreaseProposal", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_MsgDelegateEscrowResponse proto.InternalMessageInfo
+
+// MsgUndelegateEscrowRequest defines a msg to unstake some of a marker's funds from a validator.
+// Once the unbonding period is over, the funds are returned to the marker's escrow.
+// Signer must have delegate authority or be a gov proposal.
+type MsgUndelegateEscrowRequest struct {
+	// The denomination of the marker whose delegated funds are being undelegated.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// The address of the validator to undelegate from.
+	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// The amount to undelegate. It must be in the bond denom.
+	Amount types1.Coin `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount"`
+	// The signer of this message. Must have delegate authority or be the governance module account address.
+	Administrator string `protobuf:"bytes,4,opt,name=administrator,proto3" json:"administrator,omitempty"`
 }
 
-func (c *msgClient) UpdateRequiredAttributes(ctx context.Context, in *MsgUpdateRequiredAttributesRequest, opts ...grpc.CallOption) (*MsgUpdateRequiredAttributesResponse, error) {
-	out := new(MsgUpdateRequiredAttributesResponse)
-	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/UpdateRequiredAttributes", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgUndelegateEscrowRequest) Reset()         { *m = MsgUndelegateEscrowRequest{} }
+func (m *MsgUndelegateEscrowRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgUndelegateEscrowRequest) ProtoMessage()    {}
+func (*MsgUndelegateEscrowRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcb203fb73175ed3, []int{72}
+}
+func (m *MsgUndelegateEscrowRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUndelegateEscrowRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUndelegateEscrowRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgUndelegateEscrowRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUndelegateEscrowRequest.Merge(m, src)
+}
+func (m *MsgUndelegateEscrowRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUndelegateEscrowRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUndelegateEscrowRequest.DiscardUnknown(m)
 }
 
-func (c *msgClient) UpdateForcedTransfer(ctx context.Context, in *MsgUpdateForcedTransferRequest, opts ...grpc.CallOption) (*MsgUpdateForcedTransferResponse, error) {
-	out := new(MsgUpdateForcedTransferResponse)
-	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/UpdateForcedTransfer", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgUndelegateEscrowRequest proto.InternalMessageInfo
+
+func (m *MsgUndelegateEscrowRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) SetAccountData(ctx context.Context, in *MsgSetAccountDataRequest, opts ...grpc.CallOption) (*MsgSetAccountDataResponse, error) {
-	out := new(MsgSetAccountDataResponse)
-	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/SetAccountData", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgUndelegateEscrowRequest) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) UpdateSendDenyList(ctx context.Context, in *MsgUpdateSendDenyListRequest, opts ...grpc.CallOption) (*MsgUpdateSendDenyListResponse, error) {
-	out := new(MsgUpdateSendDenyListResponse)
-	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/UpdateSendDenyList", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgUndelegateEscrowRequest) GetAmount() types1.Coin {
+	if m != nil {
+		return m.Amount
 	}
-	return out, nil
+	return types1.Coin{}
 }
 
-func (c *msgClient) AddNetAssetValues(ctx context.Context, in *MsgAddNetAssetValuesRequest, opts ...grpc.CallOption) (*MsgAddNetAssetValuesResponse, error) {
-	out := new(MsgAddNetAssetValuesResponse)
-	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/AddNetAssetValues", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgUndelegateEscrowRequest) GetAdministrator() string {
+	if m != nil {
+		return m.Administrator
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) SetAdministratorProposal(ctx context.Context, in *MsgSetAdministratorProposalRequest, opts ...grpc.CallOption) (*MsgSetAdministratorProposalResponse, error) {
-	out := new(MsgSetAdministratorProposalResponse)
-	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/SetAdministratorProposal", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// MsgUndelegateEscrowResponse defines the Msg/UndelegateEscrow response type
+type MsgUndelegateEscrowResponse struct {
+	// The time at which the undelegated funds will be returned to the marker's escrow.
+	CompletionTime time.Time `protobuf:"bytes,1,opt,name=completion_time,json=completionTime,proto3,stdtime" json:"completion_time"`
 }
 
-func (c *msgClient) RemoveAdministratorProposal(ctx context.Context, in *MsgRemoveAdministratorProposalRequest, opts ...grpc.CallOption) (*MsgRemoveAdministratorProposalResponse, error) {
-	out := new(MsgRemoveAdministratorProposalResponse)
-	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/RemoveAdministratorProposal", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgUndelegateEscrowResponse) Reset()         { *m = MsgUndelegateEscrowResponse{} }
+func (m *MsgUndelegateEscrowResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUndelegateEscrowResponse) ProtoMessage()    {}
+func (*MsgUndelegateEscrowResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcb203fb73175ed3, []int{73}
+}
+func (m *MsgUndelegateEscrowResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUndelegateEscrowResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUndelegateEscrowResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgUndelegateEscrowResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUndelegateEscrowResponse.Merge(m, src)
+}
+func (m *MsgUndelegateEscrowResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUndelegateEscrowResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUndelegateEscrowResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) ChangeStatusProposal(ctx context.Context, in *MsgChangeStatusProposalRequest, opts ...grpc.CallOption) (*MsgChangeStatusProposalResponse, error) {
-	out := new(MsgChangeStatusProposalResponse)
-	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/ChangeStatusProposal", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgUndelegateEscrowResponse proto.InternalMessageInfo
+
+func (m *MsgUndelegateEscrowResponse) GetCompletionTime() time.Time {
+	if m != nil {
+		return m.CompletionTime
 	}
-	return out, nil
+	return time.Time{}
 }
 
-func (c *msgClient) WithdrawEscrowProposal(ctx context.Context, in *MsgWithdrawEscrowProposalRequest, opts ...grpc.CallOption) (*MsgWithdrawEscrowProposalResponse, error) {
-	out := new(MsgWithdrawEscrowProposalResponse)
-	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/WithdrawEscrowProposal", in, out, opts...)
-	if err != nil {
-		return nil, err
+// MsgCollectEscrowRewardsRequest defines a msg to withdraw a marker's staking rewards from a validator
+// into the marker's escrow. Signer must have delegate authority or be a gov proposal.
+type MsgCollectEscrowRewardsRequest struct {
+	// The denomination of the marker whose rewards are being collected.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// The address of the validator to collect the rewards from.
+	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// The signer of this message. Must have delegate authority or be the governance module account address.
+	Administrator string `protobuf:"bytes,3,opt,name=administrator,proto3" json:"administrator,omitempty"`
+}
+
+func (m *MsgCollectEscrowRewardsRequest) Reset()         { *m = MsgCollectEscrowRewardsRequest{} }
+func (m *MsgCollectEscrowRewardsRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgCollectEscrowRewardsRequest) ProtoMessage()    {}
+func (*MsgCollectEscrowRewardsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcb203fb73175ed3, []int{74}
+}
+func (m *MsgCollectEscrowRewardsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCollectEscrowRewardsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCollectEscrowRewardsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgCollectEscrowRewardsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCollectEscrowRewardsRequest.Merge(m, src)
+}
+func (m *MsgCollectEscrowRewardsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCollectEscrowRewardsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCollectEscrowRewardsRequest.DiscardUnknown(m)
 }
 
-func (c *msgClient) SetDenomMetadataProposal(ctx context.Context, in *MsgSetDenomMetadataProposalRequest, opts ...grpc.CallOption) (*MsgSetDenomMetadataProposalResponse, error) {
-	out := new(MsgSetDenomMetadataProposalResponse)
-	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/SetDenomMetadataProposal", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgCollectEscrowRewardsRequest proto.InternalMessageInfo
+
+func (m *MsgCollectEscrowRewardsRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParamsRequest, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
-	out := new(MsgUpdateParamsResponse)
-	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/UpdateParams", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgCollectEscrowRewardsRequest) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
 	}
-	return out, nil
+	return ""
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// Finalize
-	Finalize(context.Context, *MsgFinalizeRequest) (*MsgFinalizeResponse, error)
-	// Activate
-	Activate(context.Context, *MsgActivateRequest) (*MsgActivateResponse, error)
-	// Cancel
-	Cancel(context.Context, *MsgCancelRequest) (*MsgCancelResponse, error)
-	// Delete
-	Delete(context.Context, *MsgDeleteRequest) (*MsgDeleteResponse, error)
-	// Mint
-	Mint(context.Context, *MsgMintRequest) (*MsgMintResponse, error)
-	// Burn
-	Burn(context.Context, *MsgBurnRequest) (*MsgBurnResponse, error)
-	// AddAccess
-	AddAccess(context.Context, *MsgAddAccessRequest) (*MsgAddAccessResponse, error)
-	// DeleteAccess
-	DeleteAccess(context.Context, *MsgDeleteAccessRequest) (*MsgDeleteAccessResponse, error)
-	// Withdraw
-	Withdraw(context.Context, *MsgWithdrawRequest) (*MsgWithdrawResponse, error)
-	// AddMarker
-	AddMarker(context.Context, *MsgAddMarkerRequest) (*MsgAddMarkerResponse, error)
-	// Transfer marker denominated coin between accounts
-	Transfer(context.Context, *MsgTransferRequest) (*MsgTransferResponse, error)
-	// Transfer over ibc any marker(including restricted markers) between ibc accounts.
-	// The relayer is still needed to accomplish ibc middleware relays.
-	IbcTransfer(context.Context, *MsgIbcTransferRequest) (*MsgIbcTransferResponse, error)
-	// Allows Denom Metadata (see bank module) to be set for the Marker's Denom
-	SetDenomMetadata(context.Context, *MsgSetDenomMetadataRequest) (*MsgSetDenomMetadataResponse, error)
-	// GrantAllowance grants fee allowance to the grantee on the granter's
-	// account with the provided expiration time.
-	GrantAllowance(context.Context, *MsgGrantAllowanceRequest) (*MsgGrantAllowanceResponse, error)
-	// AddFinalizeActivateMarker
-	AddFinalizeActivateMarker(context.Context, *MsgAddFinalizeActivateMarkerRequest) (*MsgAddFinalizeActivateMarkerResponse, error)
-	// SupplyIncreaseProposal can only be called via gov proposal
-	SupplyIncreaseProposal(context.Context, *MsgSupplyIncreaseProposalRequest) (*MsgSupplyIncreaseProposalResponse, error)
-	// SupplyDecreaseProposal can only be called via gov proposal
-	SupplyDecreaseProposal(context.Context, *MsgSupplyDecreaseProposalRequest) (*MsgSupplyDecreaseProposalResponse, error)
-	// UpdateRequiredAttributes will only succeed if signer has transfer authority
-	UpdateRequiredAttributes(context.Context, *MsgUpdateRequiredAttributesRequest) (*MsgUpdateRequiredAttributesResponse, error)
-	// UpdateForcedTransfer updates the allow_forced_transfer field of a marker via governance proposal.
-	UpdateForcedTransfer(context.Context, *MsgUpdateForcedTransferRequest) (*MsgUpdateForcedTransferResponse, error)
-	// SetAccountData sets the accountdata for a denom. Signer must have deposit authority.
-	SetAccountData(context.Context, *MsgSetAccountDataRequest) (*MsgSetAccountDataResponse, error)
-	// UpdateSendDenyList will only succeed if signer has admin authority
-	UpdateSendDenyList(context.Context, *MsgUpdateSendDenyListRequest) (*MsgUpdateSendDenyListResponse, error)
-	// AddNetAssetValues set the net asset value for a marker
-	AddNetAssetValues(context.Context, *MsgAddNetAssetValuesRequest) (*MsgAddNetAssetValuesResponse, error)
-	// SetAdministratorProposal sets administrators with specific access on the marker
-	SetAdministratorProposal(context.Context, *MsgSetAdministratorProposalRequest) (*MsgSetAdministratorProposalResponse, error)
-	// RemoveAdministratorProposal removes administrators with specific access on the marker
-	RemoveAdministratorProposal(context.Context, *MsgRemoveAdministratorProposalRequest) (*MsgRemoveAdministratorProposalResponse, error)
-	// ChangeStatusProposal is a governance proposal change marker status
-	ChangeStatusProposal(context.Context, *MsgChangeStatusProposalRequest) (*MsgChangeStatusProposalResponse, error)
-	// WithdrawEscrowProposal is a governance proposal to withdraw escrow coins from a marker
-	WithdrawEscrowProposal(context.Context, *MsgWithdrawEscrowProposalRequest) (*MsgWithdrawEscrowProposalResponse, error)
-	// SetDenomMetadataProposal is a governance proposal to set marker metadata
-	SetDenomMetadataProposal(context.Context, *MsgSetDenomMetadataProposalRequest) (*MsgSetDenomMetadataProposalResponse, error)
-	// UpdateParams is a governance proposal endpoint for updating the marker module's params.
-	UpdateParams(context.Context, *MsgUpdateParamsRequest) (*MsgUpdateParamsResponse, error)
+func (m *MsgCollectEscrowRewardsRequest) GetAdministrator() string {
+	if m != nil {
+		return m.Administrator
+	}
+	return ""
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+// MsgCollectEscrowRewardsResponse defines the Msg/CollectEscrowRewards response type
+type MsgCollectEscrowRewardsResponse struct {
+	// The rewards that were moved into the marker's escrow.
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
 }
 
-func (*UnimplementedMsgServer) Finalize(ctx context.Context, req *MsgFinalizeRequest) (*MsgFinalizeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Finalize not implemented")
+func (m *MsgCollectEscrowRewardsResponse) Reset()         { *m = MsgCollectEscrowRewardsResponse{} }
+func (m *MsgCollectEscrowRewardsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCollectEscrowRewardsResponse) ProtoMessage()    {}
+func (*MsgCollectEscrowRewardsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcb203fb73175ed3, []int{75}
 }
-func (*UnimplementedMsgServer) Activate(ctx context.Context, req *MsgActivateRequest) (*MsgActivateResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Activate not implemented")
+func (m *MsgCollectEscrowRewardsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) Cancel(ctx context.Context, req *MsgCancelRequest) (*MsgCancelResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Cancel not implemented")
+func (m *MsgCollectEscrowRewardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCollectEscrowRewardsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) Delete(ctx context.Context, req *MsgDeleteRequest) (*MsgDeleteResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+func (m *MsgCollectEscrowRewardsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCollectEscrowRewardsResponse.Merge(m, src)
 }
-func (*UnimplementedMsgServer) Mint(ctx context.Context, req *MsgMintRequest) (*MsgMintResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Mint not implemented")
+func (m *MsgCollectEscrowRewardsResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) Burn(ctx context.Context, req *MsgBurnRequest) (*MsgBurnResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Burn not implemented")
+func (m *MsgCollectEscrowRewardsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCollectEscrowRewardsResponse.DiscardUnknown(m)
 }
-func (*UnimplementedMsgServer) AddAccess(ctx context.Context, req *MsgAddAccessRequest) (*MsgAddAccessResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddAccess not implemented")
+
+var xxx_messageInfo_MsgCollectEscrowRewardsResponse proto.InternalMessageInfo
+
+func (m *MsgCollectEscrowRewardsResponse) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
 }
-func (*UnimplementedMsgServer) DeleteAccess(ctx context.Context, req *MsgDeleteAccessRequest) (*MsgDeleteAccessResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteAccess not implemented")
+
+// MsgSetMintAllowanceRequest defines a msg to set the amount of a marker's coin that an account is allowed to mint.
+// Signer must have mint authority or be a gov proposal.
+type MsgSetMintAllowanceRequest struct {
+	// The total amount the minter is allowed to mint. The denom is the marker's denom.
+	// This replaces any existing allowance. Zero removes the allowance.
+	Amount types1.Coin `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount"`
+	// The address of the account that will be allowed to mint.
+	Minter string `protobuf:"bytes,2,opt,name=minter,proto3" json:"minter,omitempty"`
+	// The signer of this message. Must have mint authority or be the governance module account address.
+	Administrator string `protobuf:"bytes,3,opt,name=administrator,proto3" json:"administrator,omitempty"`
 }
-func (*UnimplementedMsgServer) Withdraw(ctx context.Context, req *MsgWithdrawRequest) (*MsgWithdrawResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Withdraw not implemented")
+
+func (m *MsgSetMintAllowanceRequest) Reset()         { *m = MsgSetMintAllowanceRequest{} }
+func (m *MsgSetMintAllowanceRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgSetMintAllowanceRequest) ProtoMessage()    {}
+func (*MsgSetMintAllowanceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcb203fb73175ed3, []int{76}
 }
-func (*UnimplementedMsgServer) AddMarker(ctx context.Context, req *MsgAddMarkerRequest) (*MsgAddMarkerResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddMarker not implemented")
+func (m *MsgSetMintAllowanceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) Transfer(ctx context.Context, req *MsgTransferRequest) (*MsgTransferResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Transfer not implemented")
+func (m *MsgSetMintAllowanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetMintAllowanceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) IbcTransfer(ctx context.Context, req *MsgIbcTransferRequest) (*MsgIbcTransferResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method IbcTransfer not implemented")
+func (m *MsgSetMintAllowanceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetMintAllowanceRequest.Merge(m, src)
 }
-func (*UnimplementedMsgServer) SetDenomMetadata(ctx context.Context, req *MsgSetDenomMetadataRequest) (*MsgSetDenomMetadataResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetDenomMetadata not implemented")
+func (m *MsgSetMintAllowanceRequest) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) GrantAllowance(ctx context.Context, req *MsgGrantAllowanceRequest) (*MsgGrantAllowanceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GrantAllowance not implemented")
+func (m *MsgSetMintAllowanceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetMintAllowanceRequest.DiscardUnknown(m)
 }
-func (*UnimplementedMsgServer) AddFinalizeActivateMarker(ctx context.Context, req *MsgAddFinalizeActivateMarkerRequest) (*MsgAddFinalizeActivateMarkerResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddFinalizeActivateMarker not implemented")
+
+var xxx_messageInfo_MsgSetMintAllowanceRequest proto.InternalMessageInfo
+
+func (m *MsgSetMintAllowanceRequest) GetAmount() types1.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types1.Coin{}
 }
-func (*UnimplementedMsgServer) SupplyIncreaseProposal(ctx context.Context, req *MsgSupplyIncreaseProposalRequest) (*MsgSupplyIncreaseProposalResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SupplyIncreaseProposal not implemented")
+
+func (m *MsgSetMintAllowanceRequest) GetMinter() string {
+	if m != nil {
+		return m.Minter
+	}
+	return ""
 }
-func (*UnimplementedMsgServer) SupplyDecreaseProposal(ctx context.Context, req *MsgSupplyDecreaseProposalRequest) (*MsgSupplyDecreaseProposalResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SupplyDecreaseProposal not implemented")
+
+func (m *MsgSetMintAllowanceRequest) GetAdministrator() string {
+	if m != nil {
+		return m.Administrator
+	}
+	return ""
 }
-func (*UnimplementedMsgServer) UpdateRequiredAttributes(ctx context.Context, req *MsgUpdateRequiredAttributesRequest) (*MsgUpdateRequiredAttributesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateRequiredAttributes not implemented")
+
+// MsgSetMintAllowanceResponse defines the Msg/SetMintAllowance response type
+type MsgSetMintAllowanceResponse struct {
 }
-func (*UnimplementedMsgServer) UpdateForcedTransfer(ctx context.Context, req *MsgUpdateForcedTransferRequest) (*MsgUpdateForcedTransferResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateForcedTransfer not implemented")
+
+func (m *MsgSetMintAllowanceResponse) Reset()         { *m = MsgSetMintAllowanceResponse{} }
+func (m *MsgSetMintAllowanceResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetMintAllowanceResponse) ProtoMessage()    {}
+func (*MsgSetMintAllowanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcb203fb73175ed3, []int{77}
 }
-func (*UnimplementedMsgServer) SetAccountData(ctx context.Context, req *MsgSetAccountDataRequest) (*MsgSetAccountDataResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetAccountData not implemented")
+func (m *MsgSetMintAllowanceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) UpdateSendDenyList(ctx context.Context, req *MsgUpdateSendDenyListRequest) (*MsgUpdateSendDenyListResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateSendDenyList not implemented")
+func (m *MsgSetMintAllowanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetMintAllowanceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) AddNetAssetValues(ctx context.Context, req *MsgAddNetAssetValuesRequest) (*MsgAddNetAssetValuesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddNetAssetValues not implemented")
+func (m *MsgSetMintAllowanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetMintAllowanceResponse.Merge(m, src)
 }
-func (*UnimplementedMsgServer) SetAdministratorProposal(ctx context.Context, req *MsgSetAdministratorProposalRequest) (*MsgSetAdministratorProposalResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetAdministratorProposal not implemented")
+func (m *MsgSetMintAllowanceResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) RemoveAdministratorProposal(ctx context.Context, req *MsgRemoveAdministratorProposalRequest) (*MsgRemoveAdministratorProposalResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RemoveAdministratorProposal not implemented")
+func (m *MsgSetMintAllowanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetMintAllowanceResponse.DiscardUnknown(m)
 }
-func (*UnimplementedMsgServer) ChangeStatusProposal(ctx context.Context, req *MsgChangeStatusProposalRequest) (*MsgChangeStatusProposalResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ChangeStatusProposal not implemented")
+
+var xxx_messageInfo_MsgSetMintAllowanceResponse proto.InternalMessageInfo
+
+// MsgMintFromAllowanceRequest defines a msg to mint coin of a marker using the signer's mint allowance.
+// The minted coin is sent to the minter.
+type MsgMintFromAllowanceRequest struct {
+	// The amount to mint. The denom is the marker's denom.
+	Amount types1.Coin `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount"`
+	// The signer of this message. Must have a mint allowance of at least the amount.
+	Minter string `protobuf:"bytes,2,opt,name=minter,proto3" json:"minter,omitempty"`
 }
-func (*UnimplementedMsgServer) WithdrawEscrowProposal(ctx context.Context, req *MsgWithdrawEscrowProposalRequest) (*MsgWithdrawEscrowProposalResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method WithdrawEscrowProposal not implemented")
+
+func (m *MsgMintFromAllowanceRequest) Reset()         { *m = MsgMintFromAllowanceRequest{} }
+func (m *MsgMintFromAllowanceRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgMintFromAllowanceRequest) ProtoMessage()    {}
+func (*MsgMintFromAllowanceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcb203fb73175ed3, []int{78}
 }
-func (*UnimplementedMsgServer) SetDenomMetadataProposal(ctx context.Context, req *MsgSetDenomMetadataProposalRequest) (*MsgSetDenomMetadataProposalResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetDenomMetadataProposal not implemented")
+func (m *MsgMintFromAllowanceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParamsRequest) (*MsgUpdateParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+func (m *MsgMintFromAllowanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgMintFromAllowanceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+func (m *MsgMintFromAllowanceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMintFromAllowanceRequest.Merge(m, src)
+}
+func (m *MsgMintFromAllowanceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgMintFromAllowanceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMintFromAllowanceRequest.DiscardUnknown(m)
 }
 
-func _Msg_Finalize_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgFinalizeRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).Finalize(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.marker.v1.Msg/Finalize",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).Finalize(ctx, req.(*MsgFinalizeRequest))
+var xxx_messageInfo_MsgMintFromAllowanceRequest proto.InternalMessageInfo
+
+func (m *MsgMintFromAllowanceRequest) GetAmount() types1.Coin {
+	if m != nil {
+		return m.Amount
 	}
-	return interceptor(ctx, in, info, handler)
+	return types1.Coin{}
 }
 
-func _Msg_Activate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgActivateRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).Activate(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.marker.v1.Msg/Activate",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).Activate(ctx, req.(*MsgActivateRequest))
+func (m *MsgMintFromAllowanceRequest) GetMinter() string {
+	if m != nil {
+		return m.Minter
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_Cancel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCancelRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).Cancel(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.marker.v1.Msg/Cancel",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).Cancel(ctx, req.(*MsgCancelRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// MsgMintFromAllowanceResponse defines the Msg/MintFromAllowance response type
+type MsgMintFromAllowanceResponse struct {
+	// The amount the minter is still allowed to mint.
+	Remaining types1.Coin `protobuf:"bytes,1,opt,name=remaining,proto3" json:"remaining"`
 }
 
-func _Msg_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDeleteRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).Delete(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.marker.v1.Msg/Delete",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).Delete(ctx, req.(*MsgDeleteRequest))
+func (m *MsgMintFromAllowanceResponse) Reset()         { *m = MsgMintFromAllowanceResponse{} }
+func (m *MsgMintFromAllowanceResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgMintFromAllowanceResponse) ProtoMessage()    {}
+func (*MsgMintFromAllowanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcb203fb73175ed3, []int{79}
+}
+func (m *MsgMintFromAllowanceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgMintFromAllowanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgMintFromAllowanceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgMintFromAllowanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMintFromAllowanceResponse.Merge(m, src)
+}
+func (m *MsgMintFromAllowanceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgMintFromAllowanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMintFromAllowanceResponse.DiscardUnknown(m)
 }
 
-func _Msg_Mint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgMintRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).Mint(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.marker.v1.Msg/Mint",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).Mint(ctx, req.(*MsgMintRequest))
+var xxx_messageInfo_MsgMintFromAllowanceResponse proto.InternalMessageInfo
+
+func (m *MsgMintFromAllowanceResponse) GetRemaining() types1.Coin {
+	if m != nil {
+		return m.Remaining
 	}
-	return interceptor(ctx, in, info, handler)
+	return types1.Coin{}
 }
 
-func _Msg_Burn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgBurnRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).Burn(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.marker.v1.Msg/Burn",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).Burn(ctx, req.(*MsgBurnRequest))
+// MsgScheduleDistributionRequest defines a msg to schedule a pro-rata payout of funds to the holders of a marker's coin.
+// The funds are taken from the signer when the distribution is scheduled.
+type MsgScheduleDistributionRequest struct {
+	// The denom of the marker whose holders will receive the payout.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// The total amount to distribute.
+	Amount types1.Coin `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount"`
+	// The block height at which to start paying out. Zero means the end of the current block.
+	StartHeight int64 `protobuf:"varint,3,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// The maximum number of holders to process in each block. Zero means the default.
+	BatchSize uint32 `protobuf:"varint,4,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`
+	// The smallest amount to send directly to a holder. Smaller amounts are recorded as claims.
+	// Optional. If provided, the denom must be the same as the amount's denom.
+	MinPayout *types1.Coin `protobuf:"bytes,5,opt,name=min_payout,json=minPayout,proto3" json:"min_payout,omitempty"`
+	// The signer of this message. Must have admin authority.
+	Administrator string `protobuf:"bytes,6,opt,name=administrator,proto3" json:"administrator,omitempty"`
+}
+
+func (m *MsgScheduleDistributionRequest) Reset()         { *m = MsgScheduleDistributionRequest{} }
+func (m *MsgScheduleDistributionRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgScheduleDistributionRequest) ProtoMessage()    {}
+func (*MsgScheduleDistributionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcb203fb73175ed3, []int{80}
+}
+func (m *MsgScheduleDistributionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgScheduleDistributionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgScheduleDistributionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgScheduleDistributionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgScheduleDistributionRequest.Merge(m, src)
+}
+func (m *MsgScheduleDistributionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgScheduleDistributionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgScheduleDistributionRequest.DiscardUnknown(m)
 }
 
-func _Msg_AddAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgAddAccessRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).AddAccess(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.marker.v1.Msg/AddAccess",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).AddAccess(ctx, req.(*MsgAddAccessRequest))
+var xxx_messageInfo_MsgScheduleDistributionRequest proto.InternalMessageInfo
+
+func (m *MsgScheduleDistributionRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_DeleteAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDeleteAccessRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).DeleteAccess(ctx, in)
+func (m *MsgScheduleDistributionRequest) GetAmount() types1.Coin {
+	if m != nil {
+		return m.Amount
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.marker.v1.Msg/DeleteAccess",
+	return types1.Coin{}
+}
+
+func (m *MsgScheduleDistributionRequest) GetStartHeight() int64 {
+	if m != nil {
+		return m.StartHeight
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).DeleteAccess(ctx, req.(*MsgDeleteAccessRequest))
+	return 0
+}
+
+func (m *MsgScheduleDistributionRequest) GetBatchSize() uint32 {
+	if m != nil {
+		return m.BatchSize
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Msg_Withdraw_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgWithdrawRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *MsgScheduleDistributionRequest) GetMinPayout() *types1.Coin {
+	if m != nil {
+		return m.MinPayout
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).Withdraw(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.marker.v1.Msg/Withdraw",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).Withdraw(ctx, req.(*MsgWithdrawRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Msg_AddMarker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgAddMarkerRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).AddMarker(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.marker.v1.Msg/AddMarker",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).AddMarker(ctx, req.(*MsgAddMarkerRequest))
+func (m *MsgScheduleDistributionRequest) GetAdministrator() string {
+	if m != nil {
+		return m.Administrator
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_Transfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgTransferRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).Transfer(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.marker.v1.Msg/Transfer",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).Transfer(ctx, req.(*MsgTransferRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// MsgScheduleDistributionResponse defines the Msg/ScheduleDistribution response type
+type MsgScheduleDistributionResponse struct {
+	// The id of the newly scheduled distribution.
+	DistributionId uint64 `protobuf:"varint,1,opt,name=distribution_id,json=distributionId,proto3" json:"distribution_id,omitempty"`
 }
 
-func _Msg_IbcTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgIbcTransferRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).IbcTransfer(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.marker.v1.Msg/IbcTransfer",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).IbcTransfer(ctx, req.(*MsgIbcTransferRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *MsgScheduleDistributionResponse) Reset()         { *m = MsgScheduleDistributionResponse{} }
+func (m *MsgScheduleDistributionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgScheduleDistributionResponse) ProtoMessage()    {}
+func (*MsgScheduleDistributionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcb203fb73175ed3, []int{81}
 }
-
-func _Msg_SetDenomMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSetDenomMetadataRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).SetDenomMetadata(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.marker.v1.Msg/SetDenomMetadata",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SetDenomMetadata(ctx, req.(*MsgSetDenomMetadataRequest))
+func (m *MsgScheduleDistributionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgScheduleDistributionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgScheduleDistributionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgScheduleDistributionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgScheduleDistributionResponse.Merge(m, src)
+}
+func (m *MsgScheduleDistributionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgScheduleDistributionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgScheduleDistributionResponse.DiscardUnknown(m)
 }
 
-func _Msg_GrantAllowance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgGrantAllowanceRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).GrantAllowance(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.marker.v1.Msg/GrantAllowance",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).GrantAllowance(ctx, req.(*MsgGrantAllowanceRequest))
+var xxx_messageInfo_MsgScheduleDistributionResponse proto.InternalMessageInfo
+
+func (m *MsgScheduleDistributionResponse) GetDistributionId() uint64 {
+	if m != nil {
+		return m.DistributionId
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Msg_AddFinalizeActivateMarker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgAddFinalizeActivateMarkerRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).AddFinalizeActivateMarker(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.marker.v1.Msg/AddFinalizeActivateMarker",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).AddFinalizeActivateMarker(ctx, req.(*MsgAddFinalizeActivateMarkerRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// MsgCancelDistributionRequest defines a msg to cancel a distribution that has not started yet.
+// The funds are refunded to the account that scheduled the distribution.
+type MsgCancelDistributionRequest struct {
+	// The id of the distribution to cancel.
+	DistributionId uint64 `protobuf:"varint,1,opt,name=distribution_id,json=distributionId,proto3" json:"distribution_id,omitempty"`
+	// The signer of this message. Must have admin authority.
+	Administrator string `protobuf:"bytes,2,opt,name=administrator,proto3" json:"administrator,omitempty"`
 }
 
-func _Msg_SupplyIncreaseProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSupplyIncreaseProposalRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).SupplyIncreaseProposal(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.marker.v1.Msg/SupplyIncreaseProposal",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SupplyIncreaseProposal(ctx, req.(*MsgSupplyIncreaseProposalRequest))
+func (m *MsgCancelDistributionRequest) Reset()         { *m = MsgCancelDistributionRequest{} }
+func (m *MsgCancelDistributionRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelDistributionRequest) ProtoMessage()    {}
+func (*MsgCancelDistributionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcb203fb73175ed3, []int{82}
+}
+func (m *MsgCancelDistributionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelDistributionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelDistributionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgCancelDistributionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelDistributionRequest.Merge(m, src)
+}
+func (m *MsgCancelDistributionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelDistributionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelDistributionRequest.DiscardUnknown(m)
 }
 
-func _Msg_SupplyDecreaseProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSupplyDecreaseProposalRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).SupplyDecreaseProposal(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.marker.v1.Msg/SupplyDecreaseProposal",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SupplyDecreaseProposal(ctx, req.(*MsgSupplyDecreaseProposalRequest))
+var xxx_messageInfo_MsgCancelDistributionRequest proto.InternalMessageInfo
+
+func (m *MsgCancelDistributionRequest) GetDistributionId() uint64 {
+	if m != nil {
+		return m.DistributionId
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Msg_UpdateRequiredAttributes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateRequiredAttributesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateRequiredAttributes(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.marker.v1.Msg/UpdateRequiredAttributes",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateRequiredAttributes(ctx, req.(*MsgUpdateRequiredAttributesRequest))
+func (m *MsgCancelDistributionRequest) GetAdministrator() string {
+	if m != nil {
+		return m.Administrator
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_UpdateForcedTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateForcedTransferRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateForcedTransfer(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.marker.v1.Msg/UpdateForcedTransfer",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateForcedTransfer(ctx, req.(*MsgUpdateForcedTransferRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// MsgCancelDistributionResponse defines the Msg/CancelDistribution response type
+type MsgCancelDistributionResponse struct {
 }
 
-func _Msg_SetAccountData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSetAccountDataRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).SetAccountData(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.marker.v1.Msg/SetAccountData",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SetAccountData(ctx, req.(*MsgSetAccountDataRequest))
+func (m *MsgCancelDistributionResponse) Reset()         { *m = MsgCancelDistributionResponse{} }
+func (m *MsgCancelDistributionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelDistributionResponse) ProtoMessage()    {}
+func (*MsgCancelDistributionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcb203fb73175ed3, []int{83}
+}
+func (m *MsgCancelDistributionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelDistributionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelDistributionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgCancelDistributionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelDistributionResponse.Merge(m, src)
+}
+func (m *MsgCancelDistributionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelDistributionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelDistributionResponse.DiscardUnknown(m)
 }
 
-func _Msg_UpdateSendDenyList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateSendDenyListRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateSendDenyList(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.marker.v1.Msg/UpdateSendDenyList",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateSendDenyList(ctx, req.(*MsgUpdateSendDenyListRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_MsgCancelDistributionResponse proto.InternalMessageInfo
+
+// MsgClaimDistributionRequest defines a msg to claim the funds recorded for the signer in a distribution.
+type MsgClaimDistributionRequest struct {
+	// The id of the distribution to claim from.
+	DistributionId uint64 `protobuf:"varint,1,opt,name=distribution_id,json=distributionId,proto3" json:"distribution_id,omitempty"`
+	// The signer of this message. Must have a claim in the distribution.
+	Claimant string `protobuf:"bytes,2,opt,name=claimant,proto3" json:"claimant,omitempty"`
 }
 
-func _Msg_AddNetAssetValues_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgAddNetAssetValuesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).AddNetAssetValues(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.marker.v1.Msg/AddNetAssetValues",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).AddNetAssetValues(ctx, req.(*MsgAddNetAssetValuesRequest))
+func (m *MsgClaimDistributionRequest) Reset()         { *m = MsgClaimDistributionRequest{} }
+func (m *MsgClaimDistributionRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimDistributionRequest) ProtoMessage()    {}
+func (*MsgClaimDistributionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcb203fb73175ed3, []int{84}
+}
+func (m *MsgClaimDistributionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimDistributionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimDistributionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgClaimDistributionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimDistributionRequest.Merge(m, src)
+}
+func (m *MsgClaimDistributionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimDistributionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimDistributionRequest.DiscardUnknown(m)
 }
 
-func _Msg_SetAdministratorProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSetAdministratorProposalRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).SetAdministratorProposal(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.marker.v1.Msg/SetAdministratorProposal",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SetAdministratorProposal(ctx, req.(*MsgSetAdministratorProposalRequest))
+var xxx_messageInfo_MsgClaimDistributionRequest proto.InternalMessageInfo
+
+func (m *MsgClaimDistributionRequest) GetDistributionId() uint64 {
+	if m != nil {
+		return m.DistributionId
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Msg_RemoveAdministratorProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRemoveAdministratorProposalRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).RemoveAdministratorProposal(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.marker.v1.Msg/RemoveAdministratorProposal",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RemoveAdministratorProposal(ctx, req.(*MsgRemoveAdministratorProposalRequest))
+func (m *MsgClaimDistributionRequest) GetClaimant() string {
+	if m != nil {
+		return m.Claimant
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_ChangeStatusProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgChangeStatusProposalRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).ChangeStatusProposal(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.marker.v1.Msg/ChangeStatusProposal",
+// MsgClaimDistributionResponse defines the Msg/ClaimDistribution response type
+type MsgClaimDistributionResponse struct {
+	// The amount sent to the claimant.
+	Amount types1.Coin `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount"`
+}
+
+func (m *MsgClaimDistributionResponse) Reset()         { *m = MsgClaimDistributionResponse{} }
+func (m *MsgClaimDistributionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimDistributionResponse) ProtoMessage()    {}
+func (*MsgClaimDistributionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcb203fb73175ed3, []int{85}
+}
+func (m *MsgClaimDistributionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimDistributionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimDistributionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ChangeStatusProposal(ctx, req.(*MsgChangeStatusProposalRequest))
+}
+func (m *MsgClaimDistributionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimDistributionResponse.Merge(m, src)
+}
+func (m *MsgClaimDistributionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimDistributionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimDistributionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClaimDistributionResponse proto.InternalMessageInfo
+
+func (m *MsgClaimDistributionResponse) GetAmount() types1.Coin {
+	if m != nil {
+		return m.Amount
 	}
-	return interceptor(ctx, in, info, handler)
+	return types1.Coin{}
 }
 
-func _Msg_WithdrawEscrowProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgWithdrawEscrowProposalRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+// MsgAllocateEscrowRequest defines a msg to earmark some of a marker's escrowed funds in one of its escrow ledgers.
+type MsgAllocateEscrowRequest struct {
+	// The denom of the marker.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// The name of the escrow ledger, e.g. "operations", "reserves", or "fees".
+	Ledger string `protobuf:"bytes,2,opt,name=ledger,proto3" json:"ledger,omitempty"`
+	// The funds to earmark. The marker must hold these funds and they cannot already be earmarked.
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	// The signer of this message. Must have admin authority.
+	Administrator string `protobuf:"bytes,4,opt,name=administrator,proto3" json:"administrator,omitempty"`
+}
+
+func (m *MsgAllocateEscrowRequest) Reset()         { *m = MsgAllocateEscrowRequest{} }
+func (m *MsgAllocateEscrowRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgAllocateEscrowRequest) ProtoMessage()    {}
+func (*MsgAllocateEscrowRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcb203fb73175ed3, []int{86}
+}
+func (m *MsgAllocateEscrowRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAllocateEscrowRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAllocateEscrowRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).WithdrawEscrowProposal(ctx, in)
+}
+func (m *MsgAllocateEscrowRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAllocateEscrowRequest.Merge(m, src)
+}
+func (m *MsgAllocateEscrowRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAllocateEscrowRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAllocateEscrowRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAllocateEscrowRequest proto.InternalMessageInfo
+
+func (m *MsgAllocateEscrowRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.marker.v1.Msg/WithdrawEscrowProposal",
+	return ""
+}
+
+func (m *MsgAllocateEscrowRequest) GetLedger() string {
+	if m != nil {
+		return m.Ledger
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).WithdrawEscrowProposal(ctx, req.(*MsgWithdrawEscrowProposalRequest))
+	return ""
+}
+
+func (m *MsgAllocateEscrowRequest) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Msg_SetDenomMetadataProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSetDenomMetadataProposalRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *MsgAllocateEscrowRequest) GetAdministrator() string {
+	if m != nil {
+		return m.Administrator
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).SetDenomMetadataProposal(ctx, in)
+	return ""
+}
+
+// MsgAllocateEscrowResponse defines the Msg/AllocateEscrow response type
+type MsgAllocateEscrowResponse struct {
+}
+
+func (m *MsgAllocateEscrowResponse) Reset()         { *m = MsgAllocateEscrowResponse{} }
+func (m *MsgAllocateEscrowResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAllocateEscrowResponse) ProtoMessage()    {}
+func (*MsgAllocateEscrowResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcb203fb73175ed3, []int{87}
+}
+func (m *MsgAllocateEscrowResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAllocateEscrowResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAllocateEscrowResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.marker.v1.Msg/SetDenomMetadataProposal",
+}
+func (m *MsgAllocateEscrowResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAllocateEscrowResponse.Merge(m, src)
+}
+func (m *MsgAllocateEscrowResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAllocateEscrowResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAllocateEscrowResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAllocateEscrowResponse proto.InternalMessageInfo
+
+// MsgReleaseEscrowRequest defines a msg to return funds earmarked in one of a marker's escrow ledgers to the marker's
+// unearmarked escrow.
+type MsgReleaseEscrowRequest struct {
+	// The denom of the marker.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// The name of the escrow ledger.
+	Ledger string `protobuf:"bytes,2,opt,name=ledger,proto3" json:"ledger,omitempty"`
+	// The funds to release. Cannot be more than the escrow ledger's balance.
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	// The signer of this message. Must be the governance module account.
+	Administrator string `protobuf:"bytes,4,opt,name=administrator,proto3" json:"administrator,omitempty"`
+}
+
+func (m *MsgReleaseEscrowRequest) Reset()         { *m = MsgReleaseEscrowRequest{} }
+func (m *MsgReleaseEscrowRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgReleaseEscrowRequest) ProtoMessage()    {}
+func (*MsgReleaseEscrowRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcb203fb73175ed3, []int{88}
+}
+func (m *MsgReleaseEscrowRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgReleaseEscrowRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgReleaseEscrowRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SetDenomMetadataProposal(ctx, req.(*MsgSetDenomMetadataProposalRequest))
+}
+func (m *MsgReleaseEscrowRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReleaseEscrowRequest.Merge(m, src)
+}
+func (m *MsgReleaseEscrowRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgReleaseEscrowRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReleaseEscrowRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgReleaseEscrowRequest proto.InternalMessageInfo
+
+func (m *MsgReleaseEscrowRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *MsgReleaseEscrowRequest) GetLedger() string {
+	if m != nil {
+		return m.Ledger
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateParams(ctx, in)
+	return ""
+}
+
+func (m *MsgReleaseEscrowRequest) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.marker.v1.Msg/UpdateParams",
+	return nil
+}
+
+func (m *MsgReleaseEscrowRequest) GetAdministrator() string {
+	if m != nil {
+		return m.Administrator
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParamsRequest))
+	return ""
+}
+
+// MsgReleaseEscrowResponse defines the Msg/ReleaseEscrow response type
+type MsgReleaseEscrowResponse struct {
+}
+
+func (m *MsgReleaseEscrowResponse) Reset()         { *m = MsgReleaseEscrowResponse{} }
+func (m *MsgReleaseEscrowResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgReleaseEscrowResponse) ProtoMessage()    {}
+func (*MsgReleaseEscrowResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcb203fb73175ed3, []int{89}
+}
+func (m *MsgReleaseEscrowResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgReleaseEscrowResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgReleaseEscrowResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgReleaseEscrowResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReleaseEscrowResponse.Merge(m, src)
+}
+func (m *MsgReleaseEscrowResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgReleaseEscrowResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReleaseEscrowResponse.DiscardUnknown(m)
 }
 
-var Msg_serviceDesc = _Msg_serviceDesc
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "provenance.marker.v1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Finalize",
-			Handler:    _Msg_Finalize_Handler,
-		},
-		{
-			MethodName: "Activate",
-			Handler:    _Msg_Activate_Handler,
-		},
-		{
-			MethodName: "Cancel",
-			Handler:    _Msg_Cancel_Handler,
-		},
-		{
-			MethodName: "Delete",
-			Handler:    _Msg_Delete_Handler,
-		},
-		{
-			MethodName: "Mint",
-			Handler:    _Msg_Mint_Handler,
-		},
-		{
-			MethodName: "Burn",
-			Handler:    _Msg_Burn_Handler,
-		},
-		{
-			MethodName: "AddAccess",
-			Handler:    _Msg_AddAccess_Handler,
-		},
-		{
-			MethodName: "DeleteAccess",
-			Handler:    _Msg_DeleteAccess_Handler,
-		},
-		{
-			MethodName: "Withdraw",
-			Handler:    _Msg_Withdraw_Handler,
-		},
-		{
-			MethodName: "AddMarker",
-			Handler:    _Msg_AddMarker_Handler,
-		},
-		{
-			MethodName: "Transfer",
-			Handler:    _Msg_Transfer_Handler,
-		},
-		{
-			MethodName: "IbcTransfer",
-			Handler:    _Msg_IbcTransfer_Handler,
-		},
-		{
-			MethodName: "SetDenomMetadata",
-			Handler:    _Msg_SetDenomMetadata_Handler,
-		},
-		{
-			MethodName: "GrantAllowance",
-			Handler:    _Msg_GrantAllowance_Handler,
-		},
-		{
-			MethodName: "AddFinalizeActivateMarker",
-			Handler:    _Msg_AddFinalizeActivateMarker_Handler,
-		},
-		{
-			MethodName: "SupplyIncreaseProposal",
-			Handler:    _Msg_SupplyIncreaseProposal_Handler,
-		},
-		{
-			MethodName: "SupplyDecreaseProposal",
-			Handler:    _Msg_SupplyDecreaseProposal_Handler,
-		},
-		{
-			MethodName: "UpdateRequiredAttributes",
-			Handler:    _Msg_UpdateRequiredAttributes_Handler,
-		},
-		{
-			MethodName: "UpdateForcedTransfer",
-			Handler:    _Msg_UpdateForcedTransfer_Handler,
-		},
-		{
-			MethodName: "SetAccountData",
-			Handler:    _Msg_SetAccountData_Handler,
-		},
-		{
-			MethodName: "UpdateSendDenyList",
-			Handler:    _Msg_UpdateSendDenyList_Handler,
-		},
-		{
-			MethodName: "AddNetAssetValues",
-			Handler:    _Msg_AddNetAssetValues_Handler,
-		},
-		{
-			MethodName: "SetAdministratorProposal",
-			Handler:    _Msg_SetAdministratorProposal_Handler,
-		},
-		{
-			MethodName: "RemoveAdministratorProposal",
-			Handler:    _Msg_RemoveAdministratorProposal_Handler,
-		},
-		{
-			MethodName: "ChangeStatusProposal",
-			Handler:    _Msg_ChangeStatusProposal_Handler,
-		},
-		{
-			MethodName: "WithdrawEscrowProposal",
-			Handler:    _Msg_WithdrawEscrowProposal_Handler,
-		},
-		{
-			MethodName: "SetDenomMetadataProposal",
-			Handler:    _Msg_SetDenomMetadataProposal_Handler,
-		},
-		{
-			MethodName: "UpdateParams",
-			Handler:    _Msg_UpdateParams_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "provenance/marker/v1/tx.proto",
-}
+var xxx_messageInfo_MsgReleaseEscrowResponse proto.InternalMessageInfo
 
-func (m *MsgGrantAllowanceRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// MsgSetEscrowWithdrawLimitRequest defines a msg to set how much an account is allowed to withdraw from one of a
+// marker's escrow ledgers.
+type MsgSetEscrowWithdrawLimitRequest struct {
+	// The denom of the marker.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// The name of the escrow ledger.
+	Ledger string `protobuf:"bytes,2,opt,name=ledger,proto3" json:"ledger,omitempty"`
+	// The address of the account that will be allowed to withdraw.
+	Grantee string `protobuf:"bytes,3,opt,name=grantee,proto3" json:"grantee,omitempty"`
+	// The total amount the grantee is allowed to withdraw. This replaces any existing limit. Empty removes the limit.
+	Limit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=limit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"limit"`
+	// The signer of this message. Must be the governance module account.
+	Administrator string `protobuf:"bytes,5,opt,name=administrator,proto3" json:"administrator,omitempty"`
 }
 
-func (m *MsgGrantAllowanceRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSetEscrowWithdrawLimitRequest) Reset()         { *m = MsgSetEscrowWithdrawLimitRequest{} }
+func (m *MsgSetEscrowWithdrawLimitRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgSetEscrowWithdrawLimitRequest) ProtoMessage()    {}
+func (*MsgSetEscrowWithdrawLimitRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcb203fb73175ed3, []int{90}
 }
-
-func (m *MsgGrantAllowanceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Allowance != nil {
-		{
-			size, err := m.Allowance.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
+func (m *MsgSetEscrowWithdrawLimitRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetEscrowWithdrawLimitRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetEscrowWithdrawLimitRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x22
+		return b[:n], nil
 	}
-	if len(m.Grantee) > 0 {
-		i -= len(m.Grantee)
-		copy(dAtA[i:], m.Grantee)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Grantee)))
-		i--
-		dAtA[i] = 0x1a
+}
+func (m *MsgSetEscrowWithdrawLimitRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetEscrowWithdrawLimitRequest.Merge(m, src)
+}
+func (m *MsgSetEscrowWithdrawLimitRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetEscrowWithdrawLimitRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetEscrowWithdrawLimitRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetEscrowWithdrawLimitRequest proto.InternalMessageInfo
+
+func (m *MsgSetEscrowWithdrawLimitRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	if len(m.Administrator) > 0 {
-		i -= len(m.Administrator)
-		copy(dAtA[i:], m.Administrator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Administrator)))
-		i--
-		dAtA[i] = 0x12
+	return ""
+}
+
+func (m *MsgSetEscrowWithdrawLimitRequest) GetLedger() string {
+	if m != nil {
+		return m.Ledger
 	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *MsgSetEscrowWithdrawLimitRequest) GetGrantee() string {
+	if m != nil {
+		return m.Grantee
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgGrantAllowanceResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSetEscrowWithdrawLimitRequest) GetLimit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Limit
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgGrantAllowanceResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSetEscrowWithdrawLimitRequest) GetAdministrator() string {
+	if m != nil {
+		return m.Administrator
+	}
+	return ""
 }
 
-func (m *MsgGrantAllowanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// MsgSetEscrowWithdrawLimitResponse defines the Msg/SetEscrowWithdrawLimit response type
+type MsgSetEscrowWithdrawLimitResponse struct {
 }
 
-func (m *MsgAddMarkerRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSetEscrowWithdrawLimitResponse) Reset()         { *m = MsgSetEscrowWithdrawLimitResponse{} }
+func (m *MsgSetEscrowWithdrawLimitResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetEscrowWithdrawLimitResponse) ProtoMessage()    {}
+func (*MsgSetEscrowWithdrawLimitResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcb203fb73175ed3, []int{91}
+}
+func (m *MsgSetEscrowWithdrawLimitResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetEscrowWithdrawLimitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetEscrowWithdrawLimitResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgSetEscrowWithdrawLimitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetEscrowWithdrawLimitResponse.Merge(m, src)
+}
+func (m *MsgSetEscrowWithdrawLimitResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetEscrowWithdrawLimitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetEscrowWithdrawLimitResponse.DiscardUnknown(m)
 }
 
-func (m *MsgAddMarkerRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgSetEscrowWithdrawLimitResponse proto.InternalMessageInfo
+
+// MsgWithdrawFromEscrowRequest defines a msg to withdraw funds from one of a marker's escrow ledgers.
+type MsgWithdrawFromEscrowRequest struct {
+	// The denom of the marker.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// The name of the escrow ledger.
+	Ledger string `protobuf:"bytes,2,opt,name=ledger,proto3" json:"ledger,omitempty"`
+	// The funds to withdraw. Cannot be more than the escrow ledger's balance or the signer's withdraw limit.
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	// The address to send the funds to. If empty, the funds are sent to the signer.
+	ToAddress string `protobuf:"bytes,4,opt,name=to_address,json=toAddress,proto3" json:"to_address,omitempty"`
+	// The signer of this message. Must have withdraw authority and a withdraw limit for the escrow ledger.
+	Administrator string `protobuf:"bytes,5,opt,name=administrator,proto3" json:"administrator,omitempty"`
+}
+
+func (m *MsgWithdrawFromEscrowRequest) Reset()         { *m = MsgWithdrawFromEscrowRequest{} }
+func (m *MsgWithdrawFromEscrowRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawFromEscrowRequest) ProtoMessage()    {}
+func (*MsgWithdrawFromEscrowRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcb203fb73175ed3, []int{92}
+}
+func (m *MsgWithdrawFromEscrowRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawFromEscrowRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawFromEscrowRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgWithdrawFromEscrowRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawFromEscrowRequest.Merge(m, src)
+}
+func (m *MsgWithdrawFromEscrowRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawFromEscrowRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawFromEscrowRequest.DiscardUnknown(m)
 }
 
-func (m *MsgAddMarkerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.UsdMills != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.UsdMills))
-		i--
-		dAtA[i] = 0x70
-	}
-	if m.Volume != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Volume))
-		i--
-		dAtA[i] = 0x68
-	}
-	if m.UsdCents != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.UsdCents))
-		i--
-		dAtA[i] = 0x60
-	}
-	if len(m.RequiredAttributes) > 0 {
-		for iNdEx := len(m.RequiredAttributes) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.RequiredAttributes[iNdEx])
-			copy(dAtA[i:], m.RequiredAttributes[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.RequiredAttributes[iNdEx])))
-			i--
-			dAtA[i] = 0x5a
-		}
-	}
-	if m.AllowForcedTransfer {
-		i--
-		if m.AllowForcedTransfer {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x50
-	}
-	if m.AllowGovernanceControl {
-		i--
-		if m.AllowGovernanceControl {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x48
-	}
-	if m.SupplyFixed {
-		i--
-		if m.SupplyFixed {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x40
-	}
-	if len(m.AccessList) > 0 {
-		for iNdEx := len(m.AccessList) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.AccessList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x3a
-		}
-	}
-	if m.MarkerType != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.MarkerType))
-		i--
-		dAtA[i] = 0x30
-	}
-	if m.Status != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Status))
-		i--
-		dAtA[i] = 0x28
+var xxx_messageInfo_MsgWithdrawFromEscrowRequest proto.InternalMessageInfo
+
+func (m *MsgWithdrawFromEscrowRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	if len(m.FromAddress) > 0 {
-		i -= len(m.FromAddress)
-		copy(dAtA[i:], m.FromAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.FromAddress)))
-		i--
-		dAtA[i] = 0x22
+	return ""
+}
+
+func (m *MsgWithdrawFromEscrowRequest) GetLedger() string {
+	if m != nil {
+		return m.Ledger
 	}
-	if len(m.Manager) > 0 {
-		i -= len(m.Manager)
-		copy(dAtA[i:], m.Manager)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Manager)))
-		i--
-		dAtA[i] = 0x1a
+	return ""
+}
+
+func (m *MsgWithdrawFromEscrowRequest) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
 	}
-	{
-		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+	return nil
+}
+
+func (m *MsgWithdrawFromEscrowRequest) GetToAddress() string {
+	if m != nil {
+		return m.ToAddress
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgAddMarkerResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgWithdrawFromEscrowRequest) GetAdministrator() string {
+	if m != nil {
+		return m.Administrator
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgAddMarkerResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgWithdrawFromEscrowResponse defines the Msg/WithdrawFromEscrow response type
+type MsgWithdrawFromEscrowResponse struct {
+	// The amount the signer is still allowed to withdraw from the escrow ledger.
+	RemainingLimit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=remaining_limit,json=remainingLimit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"remaining_limit"`
 }
 
-func (m *MsgAddMarkerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgWithdrawFromEscrowResponse) Reset()         { *m = MsgWithdrawFromEscrowResponse{} }
+func (m *MsgWithdrawFromEscrowResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawFromEscrowResponse) ProtoMessage()    {}
+func (*MsgWithdrawFromEscrowResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcb203fb73175ed3, []int{93}
+}
+func (m *MsgWithdrawFromEscrowResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawFromEscrowResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawFromEscrowResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgWithdrawFromEscrowResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawFromEscrowResponse.Merge(m, src)
+}
+func (m *MsgWithdrawFromEscrowResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawFromEscrowResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawFromEscrowResponse.DiscardUnknown(m)
 }
 
-func (m *MsgAddAccessRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgWithdrawFromEscrowResponse proto.InternalMessageInfo
+
+func (m *MsgWithdrawFromEscrowResponse) GetRemainingLimit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.RemainingLimit
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgAddAccessRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgScheduleBurnRequest defines a msg to schedule a burn of some of a marker's escrowed coin at the end of a future
+// block. The amount is reserved in the marker's escrow until it is burned or the burn is cancelled.
+type MsgScheduleBurnRequest struct {
+	// The marker's coin to burn. The marker must hold this amount and it cannot be earmarked or reserved.
+	Amount types1.Coin `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount"`
+	// The block height at the end of which the coin is burned. Must be after the current block height.
+	BurnHeight int64 `protobuf:"varint,2,opt,name=burn_height,json=burnHeight,proto3" json:"burn_height,omitempty"`
+	// The number of blocks, starting with the current one, in which the burn can be cancelled.
+	// Zero means the burn cannot be cancelled. The cancel window must close at or before the burn height.
+	CancelWindow uint64 `protobuf:"varint,3,opt,name=cancel_window,json=cancelWindow,proto3" json:"cancel_window,omitempty"`
+	// The signer of this message. Must have burn authority.
+	Administrator string `protobuf:"bytes,4,opt,name=administrator,proto3" json:"administrator,omitempty"`
 }
 
-func (m *MsgAddAccessRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Access) > 0 {
-		for iNdEx := len(m.Access) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Access[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
+func (m *MsgScheduleBurnRequest) Reset()         { *m = MsgScheduleBurnRequest{} }
+func (m *MsgScheduleBurnRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgScheduleBurnRequest) ProtoMessage()    {}
+func (*MsgScheduleBurnRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcb203fb73175ed3, []int{94}
+}
+func (m *MsgScheduleBurnRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgScheduleBurnRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgScheduleBurnRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Administrator) > 0 {
-		i -= len(m.Administrator)
-		copy(dAtA[i:], m.Administrator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Administrator)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgScheduleBurnRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgScheduleBurnRequest.Merge(m, src)
+}
+func (m *MsgScheduleBurnRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgScheduleBurnRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgScheduleBurnRequest.DiscardUnknown(m)
 }
 
-func (m *MsgAddAccessResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgScheduleBurnRequest proto.InternalMessageInfo
+
+func (m *MsgScheduleBurnRequest) GetAmount() types1.Coin {
+	if m != nil {
+		return m.Amount
 	}
-	return dAtA[:n], nil
+	return types1.Coin{}
 }
 
-func (m *MsgAddAccessResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgScheduleBurnRequest) GetBurnHeight() int64 {
+	if m != nil {
+		return m.BurnHeight
+	}
+	return 0
 }
 
-func (m *MsgAddAccessResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgScheduleBurnRequest) GetCancelWindow() uint64 {
+	if m != nil {
+		return m.CancelWindow
+	}
+	return 0
 }
 
-func (m *MsgDeleteAccessRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgScheduleBurnRequest) GetAdministrator() string {
+	if m != nil {
+		return m.Administrator
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgDeleteAccessRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgScheduleBurnResponse defines the Msg/ScheduleBurn response type
+type MsgScheduleBurnResponse struct {
+	// The id of the newly scheduled burn.
+	BurnId uint64 `protobuf:"varint,1,opt,name=burn_id,json=burnId,proto3" json:"burn_id,omitempty"`
 }
 
-func (m *MsgDeleteAccessRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.RemovedAddress) > 0 {
-		i -= len(m.RemovedAddress)
-		copy(dAtA[i:], m.RemovedAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.RemovedAddress)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Administrator) > 0 {
-		i -= len(m.Administrator)
-		copy(dAtA[i:], m.Administrator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Administrator)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
+func (m *MsgScheduleBurnResponse) Reset()         { *m = MsgScheduleBurnResponse{} }
+func (m *MsgScheduleBurnResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgScheduleBurnResponse) ProtoMessage()    {}
+func (*MsgScheduleBurnResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcb203fb73175ed3, []int{95}
+}
+func (m *MsgScheduleBurnResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgScheduleBurnResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgScheduleBurnResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgScheduleBurnResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgScheduleBurnResponse.Merge(m, src)
+}
+func (m *MsgScheduleBurnResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgScheduleBurnResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgScheduleBurnResponse.DiscardUnknown(m)
 }
 
-func (m *MsgDeleteAccessResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgScheduleBurnResponse proto.InternalMessageInfo
+
+func (m *MsgScheduleBurnResponse) GetBurnId() uint64 {
+	if m != nil {
+		return m.BurnId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgDeleteAccessResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgCancelScheduledBurnRequest defines a msg to cancel a scheduled burn whose cancel window is still open.
+type MsgCancelScheduledBurnRequest struct {
+	// The id of the scheduled burn to cancel.
+	BurnId uint64 `protobuf:"varint,1,opt,name=burn_id,json=burnId,proto3" json:"burn_id,omitempty"`
+	// The signer of this message. Must have burn authority.
+	Administrator string `protobuf:"bytes,2,opt,name=administrator,proto3" json:"administrator,omitempty"`
 }
 
-func (m *MsgDeleteAccessResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgCancelScheduledBurnRequest) Reset()         { *m = MsgCancelScheduledBurnRequest{} }
+func (m *MsgCancelScheduledBurnRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelScheduledBurnRequest) ProtoMessage()    {}
+func (*MsgCancelScheduledBurnRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcb203fb73175ed3, []int{96}
 }
-
-func (m *MsgFinalizeRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgCancelScheduledBurnRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelScheduledBurnRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelScheduledBurnRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgFinalizeRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgCancelScheduledBurnRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelScheduledBurnRequest.Merge(m, src)
+}
+func (m *MsgCancelScheduledBurnRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelScheduledBurnRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelScheduledBurnRequest.DiscardUnknown(m)
 }
 
-func (m *MsgFinalizeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Administrator) > 0 {
-		i -= len(m.Administrator)
-		copy(dAtA[i:], m.Administrator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Administrator)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_MsgCancelScheduledBurnRequest proto.InternalMessageInfo
+
+func (m *MsgCancelScheduledBurnRequest) GetBurnId() uint64 {
+	if m != nil {
+		return m.BurnId
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *MsgFinalizeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgCancelScheduledBurnRequest) GetAdministrator() string {
+	if m != nil {
+		return m.Administrator
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgFinalizeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgCancelScheduledBurnResponse defines the Msg/CancelScheduledBurn response type
+type MsgCancelScheduledBurnResponse struct {
 }
 
-func (m *MsgFinalizeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgCancelScheduledBurnResponse) Reset()         { *m = MsgCancelScheduledBurnResponse{} }
+func (m *MsgCancelScheduledBurnResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelScheduledBurnResponse) ProtoMessage()    {}
+func (*MsgCancelScheduledBurnResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcb203fb73175ed3, []int{97}
 }
-
-func (m *MsgActivateRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgCancelScheduledBurnResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelScheduledBurnResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelScheduledBurnResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgActivateRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgCancelScheduledBurnResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelScheduledBurnResponse.Merge(m, src)
+}
+func (m *MsgCancelScheduledBurnResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelScheduledBurnResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelScheduledBurnResponse.DiscardUnknown(m)
 }
 
-func (m *MsgActivateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Administrator) > 0 {
-		i -= len(m.Administrator)
-		copy(dAtA[i:], m.Administrator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Administrator)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+var xxx_messageInfo_MsgCancelScheduledBurnResponse proto.InternalMessageInfo
+
+// MsgExchangeRequest defines a msg to atomically swap marker coins between two accounts.
+// The amount_a is sent from party_a to party_b, and the amount_b is sent from party_b to party_a.
+type MsgExchangeRequest struct {
+	// The account sending amount_a and receiving amount_b. Must sign this message.
+	PartyA string `protobuf:"bytes,1,opt,name=party_a,json=partyA,proto3" json:"party_a,omitempty"`
+	// The coins that party_a sends to party_b. The denom must have a marker.
+	AmountA types1.Coin `protobuf:"bytes,2,opt,name=amount_a,json=amountA,proto3" json:"amount_a"`
+	// The account sending amount_b and receiving amount_a. Must sign this message.
+	PartyB string `protobuf:"bytes,3,opt,name=party_b,json=partyB,proto3" json:"party_b,omitempty"`
+	// The coins that party_b sends to party_a. The denom must have a marker, and be different from amount_a's denom.
+	AmountB types1.Coin `protobuf:"bytes,4,opt,name=amount_b,json=amountB,proto3" json:"amount_b"`
 }
 
-func (m *MsgActivateResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgExchangeRequest) Reset()         { *m = MsgExchangeRequest{} }
+func (m *MsgExchangeRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgExchangeRequest) ProtoMessage()    {}
+func (*MsgExchangeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcb203fb73175ed3, []int{98}
+}
+func (m *MsgExchangeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgExchangeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgExchangeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgActivateResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgExchangeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgExchangeRequest.Merge(m, src)
 }
-
-func (m *MsgActivateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgExchangeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgExchangeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgExchangeRequest.DiscardUnknown(m)
 }
 
-func (m *MsgCancelRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgExchangeRequest proto.InternalMessageInfo
+
+func (m *MsgExchangeRequest) GetPartyA() string {
+	if m != nil {
+		return m.PartyA
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgCancelRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgExchangeRequest) GetAmountA() types1.Coin {
+	if m != nil {
+		return m.AmountA
+	}
+	return types1.Coin{}
 }
 
-func (m *MsgCancelRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Administrator) > 0 {
-		i -= len(m.Administrator)
-		copy(dAtA[i:], m.Administrator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Administrator)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
+func (m *MsgExchangeRequest) GetPartyB() string {
+	if m != nil {
+		return m.PartyB
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgCancelResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgExchangeRequest) GetAmountB() types1.Coin {
+	if m != nil {
+		return m.AmountB
 	}
-	return dAtA[:n], nil
+	return types1.Coin{}
 }
 
-func (m *MsgCancelResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgExchangeResponse defines the Msg/Exchange response type
+type MsgExchangeResponse struct {
 }
 
-func (m *MsgCancelResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgExchangeResponse) Reset()         { *m = MsgExchangeResponse{} }
+func (m *MsgExchangeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgExchangeResponse) ProtoMessage()    {}
+func (*MsgExchangeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcb203fb73175ed3, []int{99}
 }
-
-func (m *MsgDeleteRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgExchangeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgExchangeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgExchangeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgDeleteRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgExchangeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgExchangeResponse.Merge(m, src)
 }
-
-func (m *MsgDeleteRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Administrator) > 0 {
-		i -= len(m.Administrator)
-		copy(dAtA[i:], m.Administrator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Administrator)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *MsgExchangeResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *MsgDeleteResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgExchangeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgExchangeResponse.DiscardUnknown(m)
 }
 
-func (m *MsgDeleteResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
+var xxx_messageInfo_MsgExchangeResponse proto.InternalMessageInfo
 
-func (m *MsgDeleteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// MsgSetAdministratorProposalRequest defines the Msg/SetAdministratorProposal request type
+type MsgSetAdministratorProposalRequest struct {
+	Denom  string        `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Access []AccessGrant `protobuf:"bytes,2,rep,name=access,proto3" json:"access"`
+	// The signer of the message.  Must have admin authority to marker or be governance module account address.
+	Authority string `protobuf:"bytes,3,opt,name=authority,proto3" json:"authority,omitempty"`
 }
 
-func (m *MsgMintRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgSetAdministratorProposalRequest) Reset()         { *m = MsgSetAdministratorProposalRequest{} }
+func (m *MsgSetAdministratorProposalRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgSetAdministratorProposalRequest) ProtoMessage()    {}
+func (*MsgSetAdministratorProposalRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcb203fb73175ed3, []int{100}
 }
-
-func (m *MsgMintRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSetAdministratorProposalRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *MsgMintRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Administrator) > 0 {
-		i -= len(m.Administrator)
-		copy(dAtA[i:], m.Administrator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Administrator)))
-		i--
-		dAtA[i] = 0x12
-	}
-	{
-		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgSetAdministratorProposalRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetAdministratorProposalRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgMintResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgSetAdministratorProposalRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetAdministratorProposalRequest.Merge(m, src)
+}
+func (m *MsgSetAdministratorProposalRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetAdministratorProposalRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetAdministratorProposalRequest.DiscardUnknown(m)
 }
 
-func (m *MsgMintResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgSetAdministratorProposalRequest proto.InternalMessageInfo
+
+func (m *MsgSetAdministratorProposalRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
 }
 
-func (m *MsgMintResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgSetAdministratorProposalRequest) GetAccess() []AccessGrant {
+	if m != nil {
+		return m.Access
+	}
+	return nil
 }
 
-func (m *MsgBurnRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSetAdministratorProposalRequest) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgBurnRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgSetAdministratorProposalResponse defines the Msg/SetAdministratorProposal response type
+type MsgSetAdministratorProposalResponse struct {
 }
 
-func (m *MsgBurnRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Administrator) > 0 {
-		i -= len(m.Administrator)
-		copy(dAtA[i:], m.Administrator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Administrator)))
-		i--
-		dAtA[i] = 0x12
-	}
-	{
-		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgSetAdministratorProposalResponse) Reset()         { *m = MsgSetAdministratorProposalResponse{} }
+func (m *MsgSetAdministratorProposalResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetAdministratorProposalResponse) ProtoMessage()    {}
+func (*MsgSetAdministratorProposalResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcb203fb73175ed3, []int{101}
+}
+func (m *MsgSetAdministratorProposalResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetAdministratorProposalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetAdministratorProposalResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgBurnResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgSetAdministratorProposalResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetAdministratorProposalResponse.Merge(m, src)
 }
-
-func (m *MsgBurnResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSetAdministratorProposalResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *MsgBurnResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgSetAdministratorProposalResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetAdministratorProposalResponse.DiscardUnknown(m)
 }
 
-func (m *MsgWithdrawRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
+var xxx_messageInfo_MsgSetAdministratorProposalResponse proto.InternalMessageInfo
 
-func (m *MsgWithdrawRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgRemoveAdministratorProposalRequest defines the Msg/RemoveAdministratorProposal request type
+type MsgRemoveAdministratorProposalRequest struct {
+	Denom          string   `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	RemovedAddress []string `protobuf:"bytes,2,rep,name=removed_address,json=removedAddress,proto3" json:"removed_address,omitempty"`
+	// The signer of the message.  Must have admin authority to marker or be governance module account address.
+	Authority string `protobuf:"bytes,3,opt,name=authority,proto3" json:"authority,omitempty"`
 }
 
-func (m *MsgWithdrawRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Amount) > 0 {
-		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
+func (m *MsgRemoveAdministratorProposalRequest) Reset()         { *m = MsgRemoveAdministratorProposalRequest{} }
+func (m *MsgRemoveAdministratorProposalRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveAdministratorProposalRequest) ProtoMessage()    {}
+func (*MsgRemoveAdministratorProposalRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcb203fb73175ed3, []int{102}
+}
+func (m *MsgRemoveAdministratorProposalRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveAdministratorProposalRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveAdministratorProposalRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.ToAddress) > 0 {
-		i -= len(m.ToAddress)
-		copy(dAtA[i:], m.ToAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ToAddress)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Administrator) > 0 {
-		i -= len(m.Administrator)
-		copy(dAtA[i:], m.Administrator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Administrator)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgRemoveAdministratorProposalRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveAdministratorProposalRequest.Merge(m, src)
+}
+func (m *MsgRemoveAdministratorProposalRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveAdministratorProposalRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveAdministratorProposalRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveAdministratorProposalRequest proto.InternalMessageInfo
+
+func (m *MsgRemoveAdministratorProposalRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgWithdrawResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRemoveAdministratorProposalRequest) GetRemovedAddress() []string {
+	if m != nil {
+		return m.RemovedAddress
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgWithdrawResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgRemoveAdministratorProposalRequest) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
 }
 
-func (m *MsgWithdrawResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// MsgRemoveAdministratorProposalResponse defines the Msg/RemoveAdministratorProposal response type
+type MsgRemoveAdministratorProposalResponse struct {
 }
 
-func (m *MsgTransferRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRemoveAdministratorProposalResponse) Reset() {
+	*m = MsgRemoveAdministratorProposalResponse{}
+}
+func (m *MsgRemoveAdministratorProposalResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveAdministratorProposalResponse) ProtoMessage()    {}
+func (*MsgRemoveAdministratorProposalResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcb203fb73175ed3, []int{103}
+}
+func (m *MsgRemoveAdministratorProposalResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveAdministratorProposalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveAdministratorProposalResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgRemoveAdministratorProposalResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveAdministratorProposalResponse.Merge(m, src)
+}
+func (m *MsgRemoveAdministratorProposalResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveAdministratorProposalResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveAdministratorProposalResponse.DiscardUnknown(m)
 }
 
-func (m *MsgTransferRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgRemoveAdministratorProposalResponse proto.InternalMessageInfo
+
+// MsgChangeStatusProposalRequest defines the Msg/ChangeStatusProposal request type
+type MsgChangeStatusProposalRequest struct {
+	Denom     string       `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	NewStatus MarkerStatus `protobuf:"varint,2,opt,name=new_status,json=newStatus,proto3,enum=provenance.marker.v1.MarkerStatus" json:"new_status,omitempty"`
+	// The signer of the message.  Must have admin authority to marker or be governance module account address.
+	Authority string `protobuf:"bytes,3,opt,name=authority,proto3" json:"authority,omitempty"`
 }
 
-func (m *MsgTransferRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ToAddress) > 0 {
-		i -= len(m.ToAddress)
-		copy(dAtA[i:], m.ToAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ToAddress)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.FromAddress) > 0 {
-		i -= len(m.FromAddress)
-		copy(dAtA[i:], m.FromAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.FromAddress)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Administrator) > 0 {
-		i -= len(m.Administrator)
-		copy(dAtA[i:], m.Administrator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Administrator)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	{
-		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgChangeStatusProposalRequest) Reset()         { *m = MsgChangeStatusProposalRequest{} }
+func (m *MsgChangeStatusProposalRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgChangeStatusProposalRequest) ProtoMessage()    {}
+func (*MsgChangeStatusProposalRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcb203fb73175ed3, []int{104}
+}
+func (m *MsgChangeStatusProposalRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgChangeStatusProposalRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgChangeStatusProposalRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgTransferResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgChangeStatusProposalRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgChangeStatusProposalRequest.Merge(m, src)
+}
+func (m *MsgChangeStatusProposalRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgChangeStatusProposalRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgChangeStatusProposalRequest.DiscardUnknown(m)
 }
 
-func (m *MsgTransferResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgChangeStatusProposalRequest proto.InternalMessageInfo
+
+func (m *MsgChangeStatusProposalRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
 }
 
-func (m *MsgTransferResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgChangeStatusProposalRequest) GetNewStatus() MarkerStatus {
+	if m != nil {
+		return m.NewStatus
+	}
+	return StatusUndefined
 }
 
-func (m *MsgIbcTransferRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgChangeStatusProposalRequest) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgIbcTransferRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgChangeStatusProposalResponse defines the Msg/ChangeStatusProposal response type
+type MsgChangeStatusProposalResponse struct {
 }
 
-func (m *MsgIbcTransferRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Administrator) > 0 {
-		i -= len(m.Administrator)
-		copy(dAtA[i:], m.Administrator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Administrator)))
-		i--
-		dAtA[i] = 0x12
+func (m *MsgChangeStatusProposalResponse) Reset()         { *m = MsgChangeStatusProposalResponse{} }
+func (m *MsgChangeStatusProposalResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgChangeStatusProposalResponse) ProtoMessage()    {}
+func (*MsgChangeStatusProposalResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcb203fb73175ed3, []int{105}
+}
+func (m *MsgChangeStatusProposalResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgChangeStatusProposalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgChangeStatusProposalResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	{
-		size := m.Transfer.Size()
-		i -= size
-		if _, err := m.Transfer.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+}
+func (m *MsgChangeStatusProposalResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgChangeStatusProposalResponse.Merge(m, src)
+}
+func (m *MsgChangeStatusProposalResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgChangeStatusProposalResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgChangeStatusProposalResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgChangeStatusProposalResponse proto.InternalMessageInfo
+
+// MsgWithdrawEscrowProposalRequest defines the Msg/WithdrawEscrowProposal request type
+type MsgWithdrawEscrowProposalRequest struct {
+	Denom         string                                   `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Amount        github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	TargetAddress string                                   `protobuf:"bytes,3,opt,name=target_address,json=targetAddress,proto3" json:"target_address,omitempty"`
+	// The signer of the message.  Must have admin authority to marker or be governance module account address.
+	Authority string `protobuf:"bytes,4,opt,name=authority,proto3" json:"authority,omitempty"`
+}
+
+func (m *MsgWithdrawEscrowProposalRequest) Reset()         { *m = MsgWithdrawEscrowProposalRequest{} }
+func (m *MsgWithdrawEscrowProposalRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawEscrowProposalRequest) ProtoMessage()    {}
+func (*MsgWithdrawEscrowProposalRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcb203fb73175ed3, []int{106}
+}
+func (m *MsgWithdrawEscrowProposalRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawEscrowProposalRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawEscrowProposalRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *MsgWithdrawEscrowProposalRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawEscrowProposalRequest.Merge(m, src)
+}
+func (m *MsgWithdrawEscrowProposalRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawEscrowProposalRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawEscrowProposalRequest.DiscardUnknown(m)
 }
 
-func (m *MsgIbcTransferResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgWithdrawEscrowProposalRequest proto.InternalMessageInfo
+
+func (m *MsgWithdrawEscrowProposalRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgIbcTransferResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgWithdrawEscrowProposalRequest) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
 }
 
-func (m *MsgIbcTransferResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgWithdrawEscrowProposalRequest) GetTargetAddress() string {
+	if m != nil {
+		return m.TargetAddress
+	}
+	return ""
 }
 
-func (m *MsgSetDenomMetadataRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgWithdrawEscrowProposalRequest) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgSetDenomMetadataRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgWithdrawEscrowProposalResponse defines the Msg/WithdrawEscrowProposal response type
+type MsgWithdrawEscrowProposalResponse struct {
 }
 
-func (m *MsgSetDenomMetadataRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Administrator) > 0 {
-		i -= len(m.Administrator)
-		copy(dAtA[i:], m.Administrator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Administrator)))
-		i--
-		dAtA[i] = 0x12
-	}
-	{
-		size := m.Metadata.Size()
-		i -= size
-		if _, err := m.Metadata.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *MsgWithdrawEscrowProposalResponse) Reset()         { *m = MsgWithdrawEscrowProposalResponse{} }
+func (m *MsgWithdrawEscrowProposalResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawEscrowProposalResponse) ProtoMessage()    {}
+func (*MsgWithdrawEscrowProposalResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcb203fb73175ed3, []int{107}
+}
+func (m *MsgWithdrawEscrowProposalResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawEscrowProposalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawEscrowProposalResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgSetDenomMetadataResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgWithdrawEscrowProposalResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawEscrowProposalResponse.Merge(m, src)
+}
+func (m *MsgWithdrawEscrowProposalResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawEscrowProposalResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawEscrowProposalResponse.DiscardUnknown(m)
 }
 
-func (m *MsgSetDenomMetadataResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgWithdrawEscrowProposalResponse proto.InternalMessageInfo
+
+// MsgSetDenomMetadataProposalRequest defines the Msg/SetDenomMetadataProposal request type
+type MsgSetDenomMetadataProposalRequest struct {
+	Metadata github_com_cosmos_cosmos_sdk_x_bank_types.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3,customtype=github.com/cosmos/cosmos-sdk/x/bank/types.Metadata" json:"metadata"`
+	// The signer of the message.  Must have admin authority to marker or be governance module account address.
+	Authority string `protobuf:"bytes,2,opt,name=authority,proto3" json:"authority,omitempty"`
 }
 
-func (m *MsgSetDenomMetadataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgSetDenomMetadataProposalRequest) Reset()         { *m = MsgSetDenomMetadataProposalRequest{} }
+func (m *MsgSetDenomMetadataProposalRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgSetDenomMetadataProposalRequest) ProtoMessage()    {}
+func (*MsgSetDenomMetadataProposalRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcb203fb73175ed3, []int{108}
+}
+func (m *MsgSetDenomMetadataProposalRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetDenomMetadataProposalRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetDenomMetadataProposalRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetDenomMetadataProposalRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetDenomMetadataProposalRequest.Merge(m, src)
+}
+func (m *MsgSetDenomMetadataProposalRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetDenomMetadataProposalRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetDenomMetadataProposalRequest.DiscardUnknown(m)
 }
 
-func (m *MsgAddFinalizeActivateMarkerRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgSetDenomMetadataProposalRequest proto.InternalMessageInfo
+
+func (m *MsgSetDenomMetadataProposalRequest) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgAddFinalizeActivateMarkerRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgSetDenomMetadataProposalResponse defines the Msg/SetDenomMetadataProposal response type
+type MsgSetDenomMetadataProposalResponse struct {
 }
 
-func (m *MsgAddFinalizeActivateMarkerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.UsdMills != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.UsdMills))
-		i--
-		dAtA[i] = 0x68
-	}
-	if m.Volume != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Volume))
-		i--
-		dAtA[i] = 0x60
-	}
-	if m.UsdCents != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.UsdCents))
-		i--
-		dAtA[i] = 0x58
-	}
-	if len(m.RequiredAttributes) > 0 {
-		for iNdEx := len(m.RequiredAttributes) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.RequiredAttributes[iNdEx])
-			copy(dAtA[i:], m.RequiredAttributes[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.RequiredAttributes[iNdEx])))
-			i--
-			dAtA[i] = 0x52
-		}
-	}
-	if m.AllowForcedTransfer {
-		i--
-		if m.AllowForcedTransfer {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x48
-	}
-	if m.AllowGovernanceControl {
-		i--
-		if m.AllowGovernanceControl {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x40
-	}
-	if m.SupplyFixed {
-		i--
-		if m.SupplyFixed {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x38
-	}
-	if len(m.AccessList) > 0 {
-		for iNdEx := len(m.AccessList) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.AccessList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x32
-		}
-	}
-	if m.MarkerType != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.MarkerType))
-		i--
-		dAtA[i] = 0x28
-	}
-	if len(m.FromAddress) > 0 {
-		i -= len(m.FromAddress)
-		copy(dAtA[i:], m.FromAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.FromAddress)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Manager) > 0 {
-		i -= len(m.Manager)
-		copy(dAtA[i:], m.Manager)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Manager)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	{
-		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgSetDenomMetadataProposalResponse) Reset()         { *m = MsgSetDenomMetadataProposalResponse{} }
+func (m *MsgSetDenomMetadataProposalResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetDenomMetadataProposalResponse) ProtoMessage()    {}
+func (*MsgSetDenomMetadataProposalResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcb203fb73175ed3, []int{109}
+}
+func (m *MsgSetDenomMetadataProposalResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetDenomMetadataProposalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetDenomMetadataProposalResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgAddFinalizeActivateMarkerResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgSetDenomMetadataProposalResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetDenomMetadataProposalResponse.Merge(m, src)
 }
-
-func (m *MsgAddFinalizeActivateMarkerResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSetDenomMetadataProposalResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *MsgAddFinalizeActivateMarkerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgSetDenomMetadataProposalResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetDenomMetadataProposalResponse.DiscardUnknown(m)
 }
 
-func (m *MsgSupplyIncreaseProposalRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
+var xxx_messageInfo_MsgSetDenomMetadataProposalResponse proto.InternalMessageInfo
 
-func (m *MsgSupplyIncreaseProposalRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgUpdateParamsRequest is a request message for the UpdateParams endpoint.
+type MsgUpdateParamsRequest struct {
+	// authority should be the governance module account address.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// params are the new param values to set.
+	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
 }
 
-func (m *MsgSupplyIncreaseProposalRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.TargetAddress) > 0 {
-		i -= len(m.TargetAddress)
-		copy(dAtA[i:], m.TargetAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.TargetAddress)))
-		i--
-		dAtA[i] = 0x12
-	}
-	{
-		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgUpdateParamsRequest) Reset()         { *m = MsgUpdateParamsRequest{} }
+func (m *MsgUpdateParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParamsRequest) ProtoMessage()    {}
+func (*MsgUpdateParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcb203fb73175ed3, []int{110}
+}
+func (m *MsgUpdateParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgSupplyIncreaseProposalResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgUpdateParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParamsRequest.Merge(m, src)
 }
-
-func (m *MsgSupplyIncreaseProposalResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgUpdateParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParamsRequest.DiscardUnknown(m)
 }
 
-func (m *MsgSupplyIncreaseProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_MsgUpdateParamsRequest proto.InternalMessageInfo
+
+func (m *MsgUpdateParamsRequest) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
 }
 
-func (m *MsgSupplyDecreaseProposalRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateParamsRequest) GetParams() Params {
+	if m != nil {
+		return m.Params
 	}
-	return dAtA[:n], nil
+	return Params{}
 }
 
-func (m *MsgSupplyDecreaseProposalRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgUpdateParamsResponse is a response message for the UpdateParams endpoint.
+type MsgUpdateParamsResponse struct {
 }
 
-func (m *MsgSupplyDecreaseProposalRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0x12
-	}
-	{
-		size := m.Amount.Size()
-		i -= size
-		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse{} }
+func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParamsResponse) ProtoMessage()    {}
+func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcb203fb73175ed3, []int{111}
 }
-
-func (m *MsgSupplyDecreaseProposalResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgSupplyDecreaseProposalResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgUpdateParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParamsResponse.Merge(m, src)
 }
-
-func (m *MsgSupplyDecreaseProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgUpdateParamsResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *MsgUpdateRequiredAttributesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParamsResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateRequiredAttributesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
+
+// MsgUpdateSendRestrictionBypassesRequest is a request message for the UpdateSendRestrictionBypasses endpoint.
+type MsgUpdateSendRestrictionBypassesRequest struct {
+	// authority should be the governance module account address.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// set are the bypass entries to add to the registry, replacing any existing entries for the same addresses.
+	Set []SendRestrictionBypass `protobuf:"bytes,2,rep,name=set,proto3" json:"set"`
+	// remove are the addresses to remove from the registry.
+	Remove []string `protobuf:"bytes,3,rep,name=remove,proto3" json:"remove,omitempty"`
 }
 
-func (m *MsgUpdateRequiredAttributesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.TransferAuthority) > 0 {
-		i -= len(m.TransferAuthority)
-		copy(dAtA[i:], m.TransferAuthority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.TransferAuthority)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.AddRequiredAttributes) > 0 {
-		for iNdEx := len(m.AddRequiredAttributes) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.AddRequiredAttributes[iNdEx])
-			copy(dAtA[i:], m.AddRequiredAttributes[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.AddRequiredAttributes[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if len(m.RemoveRequiredAttributes) > 0 {
-		for iNdEx := len(m.RemoveRequiredAttributes) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.RemoveRequiredAttributes[iNdEx])
-			copy(dAtA[i:], m.RemoveRequiredAttributes[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.RemoveRequiredAttributes[iNdEx])))
-			i--
-			dAtA[i] = 0x12
+func (m *MsgUpdateSendRestrictionBypassesRequest) Reset() {
+	*m = MsgUpdateSendRestrictionBypassesRequest{}
+}
+func (m *MsgUpdateSendRestrictionBypassesRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateSendRestrictionBypassesRequest) ProtoMessage()    {}
+func (*MsgUpdateSendRestrictionBypassesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcb203fb73175ed3, []int{112}
+}
+func (m *MsgUpdateSendRestrictionBypassesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateSendRestrictionBypassesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateSendRestrictionBypassesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgUpdateSendRestrictionBypassesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateSendRestrictionBypassesRequest.Merge(m, src)
+}
+func (m *MsgUpdateSendRestrictionBypassesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateSendRestrictionBypassesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateSendRestrictionBypassesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateSendRestrictionBypassesRequest proto.InternalMessageInfo
+
+func (m *MsgUpdateSendRestrictionBypassesRequest) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgUpdateRequiredAttributesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateSendRestrictionBypassesRequest) GetSet() []SendRestrictionBypass {
+	if m != nil {
+		return m.Set
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgUpdateRequiredAttributesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgUpdateSendRestrictionBypassesRequest) GetRemove() []string {
+	if m != nil {
+		return m.Remove
+	}
+	return nil
 }
 
-func (m *MsgUpdateRequiredAttributesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// MsgUpdateSendRestrictionBypassesResponse is a response message for the UpdateSendRestrictionBypasses endpoint.
+type MsgUpdateSendRestrictionBypassesResponse struct {
 }
 
-func (m *MsgUpdateForcedTransferRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateSendRestrictionBypassesResponse) Reset() {
+	*m = MsgUpdateSendRestrictionBypassesResponse{}
+}
+func (m *MsgUpdateSendRestrictionBypassesResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateSendRestrictionBypassesResponse) ProtoMessage()    {}
+func (*MsgUpdateSendRestrictionBypassesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcb203fb73175ed3, []int{113}
+}
+func (m *MsgUpdateSendRestrictionBypassesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateSendRestrictionBypassesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateSendRestrictionBypassesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgUpdateSendRestrictionBypassesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateSendRestrictionBypassesResponse.Merge(m, src)
+}
+func (m *MsgUpdateSendRestrictionBypassesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateSendRestrictionBypassesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateSendRestrictionBypassesResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateForcedTransferRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgUpdateSendRestrictionBypassesResponse proto.InternalMessageInfo
+
+// MsgChangeMarkerTypeProposalRequest defines the Msg/ChangeMarkerTypeProposal request type.
+// Changing a restricted marker to a coin marker removes the transfer and force transfer permissions from its access
+// grants, clears its required attributes and send deny list, and turns off forced transfers.
+type MsgChangeMarkerTypeProposalRequest struct {
+	// denom is the denomination of the marker to change.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// new_type is the type to change the marker to. It must be either MARKER_TYPE_COIN or MARKER_TYPE_RESTRICTED.
+	NewType MarkerType `protobuf:"varint,2,opt,name=new_type,json=newType,proto3,enum=provenance.marker.v1.MarkerType" json:"new_type,omitempty"`
+	// authority should be the governance module account address.
+	Authority string `protobuf:"bytes,3,opt,name=authority,proto3" json:"authority,omitempty"`
 }
 
-func (m *MsgUpdateForcedTransferRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.AllowForcedTransfer {
-		i--
-		if m.AllowForcedTransfer {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+func (m *MsgChangeMarkerTypeProposalRequest) Reset()         { *m = MsgChangeMarkerTypeProposalRequest{} }
+func (m *MsgChangeMarkerTypeProposalRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgChangeMarkerTypeProposalRequest) ProtoMessage()    {}
+func (*MsgChangeMarkerTypeProposalRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcb203fb73175ed3, []int{114}
+}
+func (m *MsgChangeMarkerTypeProposalRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgChangeMarkerTypeProposalRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgChangeMarkerTypeProposalRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgUpdateForcedTransferResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgChangeMarkerTypeProposalRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgChangeMarkerTypeProposalRequest.Merge(m, src)
+}
+func (m *MsgChangeMarkerTypeProposalRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgChangeMarkerTypeProposalRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgChangeMarkerTypeProposalRequest.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateForcedTransferResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgChangeMarkerTypeProposalRequest proto.InternalMessageInfo
+
+func (m *MsgChangeMarkerTypeProposalRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
 }
 
-func (m *MsgUpdateForcedTransferResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgChangeMarkerTypeProposalRequest) GetNewType() MarkerType {
+	if m != nil {
+		return m.NewType
+	}
+	return MarkerType_Unknown
 }
 
-func (m *MsgSetAccountDataRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgChangeMarkerTypeProposalRequest) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgSetAccountDataRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgChangeMarkerTypeProposalResponse defines the Msg/ChangeMarkerTypeProposal response type
+type MsgChangeMarkerTypeProposalResponse struct {
 }
 
-func (m *MsgSetAccountDataRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Value) > 0 {
-		i -= len(m.Value)
-		copy(dAtA[i:], m.Value)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Value)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *MsgChangeMarkerTypeProposalResponse) Reset()         { *m = MsgChangeMarkerTypeProposalResponse{} }
+func (m *MsgChangeMarkerTypeProposalResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgChangeMarkerTypeProposalResponse) ProtoMessage()    {}
+func (*MsgChangeMarkerTypeProposalResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcb203fb73175ed3, []int{115}
 }
-
-func (m *MsgSetAccountDataResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgChangeMarkerTypeProposalResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgChangeMarkerTypeProposalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgChangeMarkerTypeProposalResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgSetAccountDataResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgChangeMarkerTypeProposalResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgChangeMarkerTypeProposalResponse.Merge(m, src)
 }
-
-func (m *MsgSetAccountDataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgChangeMarkerTypeProposalResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgChangeMarkerTypeProposalResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgChangeMarkerTypeProposalResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateSendDenyListRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+var xxx_messageInfo_MsgChangeMarkerTypeProposalResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgGrantAllowanceRequest)(nil), "provenance.marker.v1.MsgGrantAllowanceRequest")
+	proto.RegisterType((*MsgGrantAllowanceResponse)(nil), "provenance.marker.v1.MsgGrantAllowanceResponse")
+	proto.RegisterType((*MsgAddMarkerRequest)(nil), "provenance.marker.v1.MsgAddMarkerRequest")
+	proto.RegisterType((*MsgAddMarkerResponse)(nil), "provenance.marker.v1.MsgAddMarkerResponse")
+	proto.RegisterType((*MsgAddAccessRequest)(nil), "provenance.marker.v1.MsgAddAccessRequest")
+	proto.RegisterType((*MsgAddAccessResponse)(nil), "provenance.marker.v1.MsgAddAccessResponse")
+	proto.RegisterType((*MsgDeleteAccessRequest)(nil), "provenance.marker.v1.MsgDeleteAccessRequest")
+	proto.RegisterType((*MsgDeleteAccessResponse)(nil), "provenance.marker.v1.MsgDeleteAccessResponse")
+	proto.RegisterType((*MsgFinalizeRequest)(nil), "provenance.marker.v1.MsgFinalizeRequest")
+	proto.RegisterType((*MsgFinalizeResponse)(nil), "provenance.marker.v1.MsgFinalizeResponse")
+	proto.RegisterType((*MsgActivateRequest)(nil), "provenance.marker.v1.MsgActivateRequest")
+	proto.RegisterType((*MsgActivateResponse)(nil), "provenance.marker.v1.MsgActivateResponse")
+	proto.RegisterType((*MsgCancelRequest)(nil), "provenance.marker.v1.MsgCancelRequest")
+	proto.RegisterType((*MsgCancelResponse)(nil), "provenance.marker.v1.MsgCancelResponse")
+	proto.RegisterType((*MsgDeleteRequest)(nil), "provenance.marker.v1.MsgDeleteRequest")
+	proto.RegisterType((*MsgDeleteResponse)(nil), "provenance.marker.v1.MsgDeleteResponse")
+	proto.RegisterType((*MsgMintRequest)(nil), "provenance.marker.v1.MsgMintRequest")
+	proto.RegisterType((*MsgMintResponse)(nil), "provenance.marker.v1.MsgMintResponse")
+	proto.RegisterType((*MsgBurnRequest)(nil), "provenance.marker.v1.MsgBurnRequest")
+	proto.RegisterType((*MsgBurnResponse)(nil), "provenance.marker.v1.MsgBurnResponse")
+	proto.RegisterType((*MsgWithdrawRequest)(nil), "provenance.marker.v1.MsgWithdrawRequest")
+	proto.RegisterType((*MsgWithdrawResponse)(nil), "provenance.marker.v1.MsgWithdrawResponse")
+	proto.RegisterType((*MsgTransferRequest)(nil), "provenance.marker.v1.MsgTransferRequest")
+	proto.RegisterType((*MsgTransferResponse)(nil), "provenance.marker.v1.MsgTransferResponse")
+	proto.RegisterType((*MsgIbcTransferRequest)(nil), "provenance.marker.v1.MsgIbcTransferRequest")
+	proto.RegisterType((*MsgIbcTransferResponse)(nil), "provenance.marker.v1.MsgIbcTransferResponse")
+	proto.RegisterType((*MsgSetDenomMetadataRequest)(nil), "provenance.marker.v1.MsgSetDenomMetadataRequest")
+	proto.RegisterType((*MsgSetDenomMetadataResponse)(nil), "provenance.marker.v1.MsgSetDenomMetadataResponse")
+	proto.RegisterType((*MsgAddFinalizeActivateMarkerRequest)(nil), "provenance.marker.v1.MsgAddFinalizeActivateMarkerRequest")
+	proto.RegisterType((*MsgAddFinalizeActivateMarkerResponse)(nil), "provenance.marker.v1.MsgAddFinalizeActivateMarkerResponse")
+	proto.RegisterType((*MsgSupplyIncreaseProposalRequest)(nil), "provenance.marker.v1.MsgSupplyIncreaseProposalRequest")
+	proto.RegisterType((*MsgSupplyIncreaseProposalResponse)(nil), "provenance.marker.v1.MsgSupplyIncreaseProposalResponse")
+	proto.RegisterType((*MsgSupplyDecreaseProposalRequest)(nil), "provenance.marker.v1.MsgSupplyDecreaseProposalRequest")
+	proto.RegisterType((*MsgSupplyDecreaseProposalResponse)(nil), "provenance.marker.v1.MsgSupplyDecreaseProposalResponse")
+	proto.RegisterType((*MsgUpdateRequiredAttributesRequest)(nil), "provenance.marker.v1.MsgUpdateRequiredAttributesRequest")
+	proto.RegisterType((*MsgUpdateRequiredAttributesResponse)(nil), "provenance.marker.v1.MsgUpdateRequiredAttributesResponse")
+	proto.RegisterType((*MsgUpdateForcedTransferRequest)(nil), "provenance.marker.v1.MsgUpdateForcedTransferRequest")
+	proto.RegisterType((*MsgUpdateForcedTransferResponse)(nil), "provenance.marker.v1.MsgUpdateForcedTransferResponse")
+	proto.RegisterType((*MsgSetAccountDataRequest)(nil), "provenance.marker.v1.MsgSetAccountDataRequest")
+	proto.RegisterType((*MsgSetAccountDataResponse)(nil), "provenance.marker.v1.MsgSetAccountDataResponse")
+	proto.RegisterType((*MsgUpdateSendDenyListRequest)(nil), "provenance.marker.v1.MsgUpdateSendDenyListRequest")
+	proto.RegisterType((*MsgUpdateSendDenyListResponse)(nil), "provenance.marker.v1.MsgUpdateSendDenyListResponse")
+	proto.RegisterType((*MsgUpdateDepositAllowListRequest)(nil), "provenance.marker.v1.MsgUpdateDepositAllowListRequest")
+	proto.RegisterType((*MsgUpdateDepositAllowListResponse)(nil), "provenance.marker.v1.MsgUpdateDepositAllowListResponse")
+	proto.RegisterType((*MsgSetFeeSponsorshipRequest)(nil), "provenance.marker.v1.MsgSetFeeSponsorshipRequest")
+	proto.RegisterType((*MsgSetFeeSponsorshipResponse)(nil), "provenance.marker.v1.MsgSetFeeSponsorshipResponse")
+	proto.RegisterType((*MsgRemoveFeeSponsorshipRequest)(nil), "provenance.marker.v1.MsgRemoveFeeSponsorshipRequest")
+	proto.RegisterType((*MsgRemoveFeeSponsorshipResponse)(nil), "provenance.marker.v1.MsgRemoveFeeSponsorshipResponse")
+	proto.RegisterType((*MsgClaimFeeSponsorshipRequest)(nil), "provenance.marker.v1.MsgClaimFeeSponsorshipRequest")
+	proto.RegisterType((*MsgClaimFeeSponsorshipResponse)(nil), "provenance.marker.v1.MsgClaimFeeSponsorshipResponse")
+	proto.RegisterType((*MsgReleaseFeeSponsorshipHoldRequest)(nil), "provenance.marker.v1.MsgReleaseFeeSponsorshipHoldRequest")
+	proto.RegisterType((*MsgReleaseFeeSponsorshipHoldResponse)(nil), "provenance.marker.v1.MsgReleaseFeeSponsorshipHoldResponse")
+	proto.RegisterType((*MsgSetBridgeInfoRequest)(nil), "provenance.marker.v1.MsgSetBridgeInfoRequest")
+	proto.RegisterType((*MsgSetBridgeInfoResponse)(nil), "provenance.marker.v1.MsgSetBridgeInfoResponse")
+	proto.RegisterType((*MsgAttestedMintRequest)(nil), "provenance.marker.v1.MsgAttestedMintRequest")
+	proto.RegisterType((*MsgAttestedMintResponse)(nil), "provenance.marker.v1.MsgAttestedMintResponse")
+	proto.RegisterType((*MsgSetBasketInfoRequest)(nil), "provenance.marker.v1.MsgSetBasketInfoRequest")
+	proto.RegisterType((*MsgSetBasketInfoResponse)(nil), "provenance.marker.v1.MsgSetBasketInfoResponse")
+	proto.RegisterType((*MsgSetIBCMemoActionConfigRequest)(nil), "provenance.marker.v1.MsgSetIBCMemoActionConfigRequest")
+	proto.RegisterType((*MsgSetIBCMemoActionConfigResponse)(nil), "provenance.marker.v1.MsgSetIBCMemoActionConfigResponse")
+	proto.RegisterType((*MsgBasketDepositRequest)(nil), "provenance.marker.v1.MsgBasketDepositRequest")
+	proto.RegisterType((*MsgBasketDepositResponse)(nil), "provenance.marker.v1.MsgBasketDepositResponse")
+	proto.RegisterType((*MsgBasketWithdrawRequest)(nil), "provenance.marker.v1.MsgBasketWithdrawRequest")
+	proto.RegisterType((*MsgBasketWithdrawResponse)(nil), "provenance.marker.v1.MsgBasketWithdrawResponse")
+	proto.RegisterType((*MsgAddNetAssetValuesRequest)(nil), "provenance.marker.v1.MsgAddNetAssetValuesRequest")
+	proto.RegisterType((*MsgAddNetAssetValuesResponse)(nil), "provenance.marker.v1.MsgAddNetAssetValuesResponse")
+	proto.RegisterType((*MsgBindMarkerNameRequest)(nil), "provenance.marker.v1.MsgBindMarkerNameRequest")
+	proto.RegisterType((*MsgBindMarkerNameResponse)(nil), "provenance.marker.v1.MsgBindMarkerNameResponse")
+	proto.RegisterType((*MsgDeleteMarkerNameRequest)(nil), "provenance.marker.v1.MsgDeleteMarkerNameRequest")
+	proto.RegisterType((*MsgDeleteMarkerNameResponse)(nil), "provenance.marker.v1.MsgDeleteMarkerNameResponse")
+	proto.RegisterType((*MsgDelegateEscrowRequest)(nil), "provenance.marker.v1.MsgDelegateEscrowRequest")
+	proto.RegisterType((*MsgDelegateEscrowResponse)(nil), "provenance.marker.v1.MsgDelegateEscrowResponse")
+	proto.RegisterType((*MsgUndelegateEscrowRequest)(nil), "provenance.marker.v1.MsgUndelegateEscrowRequest")
+	proto.RegisterType((*MsgUndelegateEscrowResponse)(nil), "provenance.marker.v1.MsgUndelegateEscrowResponse")
+	proto.RegisterType((*MsgCollectEscrowRewardsRequest)(nil), "provenance.marker.v1.MsgCollectEscrowRewardsRequest")
+	proto.RegisterType((*MsgCollectEscrowRewardsResponse)(nil), "provenance.marker.v1.MsgCollectEscrowRewardsResponse")
+	proto.RegisterType((*MsgSetMintAllowanceRequest)(nil), "provenance.marker.v1.MsgSetMintAllowanceRequest")
+	proto.RegisterType((*MsgSetMintAllowanceResponse)(nil), "provenance.marker.v1.MsgSetMintAllowanceResponse")
+	proto.RegisterType((*MsgMintFromAllowanceRequest)(nil), "provenance.marker.v1.MsgMintFromAllowanceRequest")
+	proto.RegisterType((*MsgMintFromAllowanceResponse)(nil), "provenance.marker.v1.MsgMintFromAllowanceResponse")
+	proto.RegisterType((*MsgScheduleDistributionRequest)(nil), "provenance.marker.v1.MsgScheduleDistributionRequest")
+	proto.RegisterType((*MsgScheduleDistributionResponse)(nil), "provenance.marker.v1.MsgScheduleDistributionResponse")
+	proto.RegisterType((*MsgCancelDistributionRequest)(nil), "provenance.marker.v1.MsgCancelDistributionRequest")
+	proto.RegisterType((*MsgCancelDistributionResponse)(nil), "provenance.marker.v1.MsgCancelDistributionResponse")
+	proto.RegisterType((*MsgClaimDistributionRequest)(nil), "provenance.marker.v1.MsgClaimDistributionRequest")
+	proto.RegisterType((*MsgClaimDistributionResponse)(nil), "provenance.marker.v1.MsgClaimDistributionResponse")
+	proto.RegisterType((*MsgAllocateEscrowRequest)(nil), "provenance.marker.v1.MsgAllocateEscrowRequest")
+	proto.RegisterType((*MsgAllocateEscrowResponse)(nil), "provenance.marker.v1.MsgAllocateEscrowResponse")
+	proto.RegisterType((*MsgReleaseEscrowRequest)(nil), "provenance.marker.v1.MsgReleaseEscrowRequest")
+	proto.RegisterType((*MsgReleaseEscrowResponse)(nil), "provenance.marker.v1.MsgReleaseEscrowResponse")
+	proto.RegisterType((*MsgSetEscrowWithdrawLimitRequest)(nil), "provenance.marker.v1.MsgSetEscrowWithdrawLimitRequest")
+	proto.RegisterType((*MsgSetEscrowWithdrawLimitResponse)(nil), "provenance.marker.v1.MsgSetEscrowWithdrawLimitResponse")
+	proto.RegisterType((*MsgWithdrawFromEscrowRequest)(nil), "provenance.marker.v1.MsgWithdrawFromEscrowRequest")
+	proto.RegisterType((*MsgWithdrawFromEscrowResponse)(nil), "provenance.marker.v1.MsgWithdrawFromEscrowResponse")
+	proto.RegisterType((*MsgScheduleBurnRequest)(nil), "provenance.marker.v1.MsgScheduleBurnRequest")
+	proto.RegisterType((*MsgScheduleBurnResponse)(nil), "provenance.marker.v1.MsgScheduleBurnResponse")
+	proto.RegisterType((*MsgCancelScheduledBurnRequest)(nil), "provenance.marker.v1.MsgCancelScheduledBurnRequest")
+	proto.RegisterType((*MsgCancelScheduledBurnResponse)(nil), "provenance.marker.v1.MsgCancelScheduledBurnResponse")
+	proto.RegisterType((*MsgExchangeRequest)(nil), "provenance.marker.v1.MsgExchangeRequest")
+	proto.RegisterType((*MsgExchangeResponse)(nil), "provenance.marker.v1.MsgExchangeResponse")
+	proto.RegisterType((*MsgSetAdministratorProposalRequest)(nil), "provenance.marker.v1.MsgSetAdministratorProposalRequest")
+	proto.RegisterType((*MsgSetAdministratorProposalResponse)(nil), "provenance.marker.v1.MsgSetAdministratorProposalResponse")
+	proto.RegisterType((*MsgRemoveAdministratorProposalRequest)(nil), "provenance.marker.v1.MsgRemoveAdministratorProposalRequest")
+	proto.RegisterType((*MsgRemoveAdministratorProposalResponse)(nil), "provenance.marker.v1.MsgRemoveAdministratorProposalResponse")
+	proto.RegisterType((*MsgChangeStatusProposalRequest)(nil), "provenance.marker.v1.MsgChangeStatusProposalRequest")
+	proto.RegisterType((*MsgChangeStatusProposalResponse)(nil), "provenance.marker.v1.MsgChangeStatusProposalResponse")
+	proto.RegisterType((*MsgWithdrawEscrowProposalRequest)(nil), "provenance.marker.v1.MsgWithdrawEscrowProposalRequest")
+	proto.RegisterType((*MsgWithdrawEscrowProposalResponse)(nil), "provenance.marker.v1.MsgWithdrawEscrowProposalResponse")
+	proto.RegisterType((*MsgSetDenomMetadataProposalRequest)(nil), "provenance.marker.v1.MsgSetDenomMetadataProposalRequest")
+	proto.RegisterType((*MsgSetDenomMetadataProposalResponse)(nil), "provenance.marker.v1.MsgSetDenomMetadataProposalResponse")
+	proto.RegisterType((*MsgUpdateParamsRequest)(nil), "provenance.marker.v1.MsgUpdateParamsRequest")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "provenance.marker.v1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgUpdateSendRestrictionBypassesRequest)(nil), "provenance.marker.v1.MsgUpdateSendRestrictionBypassesRequest")
+	proto.RegisterType((*MsgUpdateSendRestrictionBypassesResponse)(nil), "provenance.marker.v1.MsgUpdateSendRestrictionBypassesResponse")
+	proto.RegisterType((*MsgChangeMarkerTypeProposalRequest)(nil), "provenance.marker.v1.MsgChangeMarkerTypeProposalRequest")
+	proto.RegisterType((*MsgChangeMarkerTypeProposalResponse)(nil), "provenance.marker.v1.MsgChangeMarkerTypeProposalResponse")
 }
 
-func (m *MsgUpdateSendDenyListRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func init() { proto.RegisterFile("provenance/marker/v1/tx.proto", fileDescriptor_bcb203fb73175ed3) }
+
+var fileDescriptor_bcb203fb73175ed3 = []byte{
+	// 4226 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5c, 0x5f, 0x6c, 0x1c, 0xc7,
+	0x79, 0xf7, 0x1e, 0xc9, 0x13, 0xf9, 0x51, 0xa2, 0xcc, 0x15, 0x45, 0x9e, 0x56, 0x12, 0x49, 0x9d,
+	0x2c, 0x8b, 0x52, 0xc2, 0x3b, 0x91, 0xb6, 0xfe, 0x31, 0x6e, 0x8a, 0x23, 0x69, 0xd9, 0x6a, 0x4d,
+	0xc3, 0x38, 0x3a, 0x09, 0x5a, 0x14, 0x38, 0xec, 0xdd, 0x0e, 0x97, 0x0b, 0xdd, 0xed, 0x9e, 0x77,
+	0xf7, 0x48, 0x51, 0x40, 0x81, 0xa0, 0x79, 0x68, 0x92, 0x97, 0xb8, 0x46, 0x51, 0x04, 0x69, 0x90,
+	0xf6, 0x29, 0x28, 0x8a, 0x3e, 0x04, 0x85, 0xd1, 0xc7, 0x16, 0x68, 0x51, 0xd4, 0x6d, 0xd1, 0x22,
+	0x70, 0x51, 0xa0, 0xe8, 0x83, 0x53, 0xd8, 0x45, 0x13, 0xf4, 0xb9, 0xcf, 0x6d, 0x31, 0x7f, 0xf6,
+	0xff, 0xec, 0xec, 0xee, 0xf1, 0x4e, 0x6e, 0x01, 0xbf, 0x48, 0xb7, 0x33, 0xdf, 0x37, 0xf3, 0xfd,
+	0xbe, 0xef, 0x9b, 0x99, 0x6f, 0x66, 0xbe, 0x21, 0x5c, 0xed, 0xdb, 0xd6, 0x11, 0x32, 0x55, 0xb3,
+	0x83, 0xea, 0x3d, 0xd5, 0x7e, 0x82, 0xec, 0xfa, 0xd1, 0x46, 0xdd, 0x7d, 0x5a, 0xeb, 0xdb, 0x96,
+	0x6b, 0xc9, 0x0b, 0x41, 0x75, 0x8d, 0x56, 0xd7, 0x8e, 0x36, 0x94, 0x79, 0xb5, 0x67, 0x98, 0x56,
+	0x9d, 0xfc, 0x4b, 0x09, 0x95, 0x4b, 0xba, 0x65, 0xe9, 0x5d, 0x54, 0x27, 0x5f, 0xed, 0xc1, 0x41,
+	0x5d, 0x35, 0x4f, 0x58, 0xd5, 0x4a, 0xbc, 0xca, 0x35, 0x7a, 0xc8, 0x71, 0xd5, 0x5e, 0xdf, 0xe3,
+	0xed, 0x58, 0x4e, 0xcf, 0x72, 0x5a, 0xe4, 0xab, 0x4e, 0x3f, 0x58, 0xd5, 0x82, 0x6e, 0xe9, 0x16,
+	0x2d, 0xc7, 0xbf, 0x58, 0xe9, 0x32, 0xa5, 0xa9, 0xb7, 0x55, 0x07, 0xd5, 0x8f, 0x36, 0xda, 0xc8,
+	0x55, 0x37, 0xea, 0x1d, 0xcb, 0x30, 0x13, 0xf5, 0xe6, 0x13, 0xbf, 0x1e, 0x7f, 0xb0, 0xfa, 0x25,
+	0x56, 0xdf, 0x73, 0x74, 0x8c, 0xb6, 0xe7, 0xe8, 0xac, 0xe2, 0x86, 0xd1, 0xee, 0xd4, 0xd5, 0x7e,
+	0xbf, 0x6b, 0x74, 0x54, 0xd7, 0xb0, 0x4c, 0xa7, 0xee, 0xda, 0xaa, 0xe9, 0x1c, 0x44, 0xb5, 0xa2,
+	0x5c, 0xe3, 0x2a, 0x8d, 0xfe, 0x62, 0x24, 0x2f, 0x73, 0x49, 0xd4, 0x4e, 0x07, 0x39, 0x8e, 0x6e,
+	0xab, 0xa6, 0x4b, 0xe9, 0xaa, 0x7f, 0x2f, 0x41, 0x65, 0xcf, 0xd1, 0xdf, 0xc0, 0x45, 0x8d, 0x6e,
+	0xd7, 0x3a, 0xc6, 0x1c, 0x4d, 0xf4, 0xde, 0x00, 0x39, 0xae, 0xbc, 0x00, 0x53, 0x1a, 0x32, 0xad,
+	0x5e, 0x45, 0x5a, 0x95, 0xd6, 0x66, 0x9a, 0xf4, 0x43, 0x7e, 0x09, 0xce, 0xa9, 0x5a, 0xcf, 0x30,
+	0x0d, 0xc7, 0xb5, 0x55, 0xd7, 0xb2, 0x2b, 0x25, 0x52, 0x1b, 0x2d, 0x94, 0x2b, 0x70, 0x86, 0xf4,
+	0x83, 0x50, 0x65, 0x82, 0xd4, 0x7b, 0x9f, 0xf2, 0xeb, 0x30, 0xa3, 0x7a, 0x3d, 0x55, 0x26, 0x57,
+	0xa5, 0xb5, 0xd9, 0xcd, 0x85, 0x1a, 0xb5, 0x51, 0xcd, 0xb3, 0x51, 0xad, 0x61, 0x9e, 0x6c, 0xcf,
+	0xff, 0xdd, 0x87, 0xeb, 0xe7, 0x1e, 0x21, 0xe4, 0xcb, 0xf5, 0xb8, 0x19, 0x70, 0x6e, 0xc9, 0xbf,
+	0xf5, 0xf3, 0x9f, 0xdc, 0x8e, 0x76, 0x5a, 0xbd, 0x0c, 0x97, 0x38, 0x60, 0x9c, 0xbe, 0x65, 0x3a,
+	0xa8, 0xfa, 0x3f, 0x93, 0x70, 0x61, 0xcf, 0xd1, 0x1b, 0x9a, 0xb6, 0x47, 0x14, 0xe2, 0xa1, 0xbc,
+	0x0f, 0x65, 0xb5, 0x67, 0x0d, 0x4c, 0x97, 0xc0, 0x9c, 0xdd, 0xbc, 0x54, 0x63, 0x2e, 0x80, 0xcd,
+	0x5b, 0x63, 0xe6, 0xab, 0xed, 0x58, 0x86, 0xb9, 0x3d, 0xf9, 0xd1, 0x27, 0x2b, 0x2f, 0x34, 0x19,
+	0x39, 0x86, 0xd8, 0x53, 0x4d, 0x55, 0x47, 0xb6, 0x07, 0x91, 0x7d, 0xca, 0xd7, 0xe0, 0xec, 0x81,
+	0x6d, 0xf5, 0x5a, 0xaa, 0xa6, 0xd9, 0xc8, 0x71, 0x08, 0xca, 0x99, 0xe6, 0x2c, 0x2e, 0x6b, 0xd0,
+	0x22, 0x79, 0x0b, 0xca, 0x8e, 0xab, 0xba, 0x03, 0xa7, 0x32, 0xb5, 0x2a, 0xad, 0xcd, 0x6d, 0x56,
+	0x6b, 0x3c, 0x57, 0xaf, 0x51, 0x51, 0xf7, 0x09, 0x65, 0x93, 0x71, 0xc8, 0x0d, 0x98, 0xa5, 0x14,
+	0x2d, 0xf7, 0xa4, 0x8f, 0x2a, 0x65, 0xd2, 0xc0, 0xaa, 0xa8, 0x81, 0x77, 0x4f, 0xfa, 0xa8, 0x09,
+	0x3d, 0xff, 0xb7, 0xfc, 0x26, 0xcc, 0x52, 0x67, 0x68, 0x75, 0x0d, 0xc7, 0xad, 0x9c, 0x59, 0x9d,
+	0x58, 0x9b, 0xdd, 0xbc, 0xc6, 0x6f, 0xa2, 0x41, 0x08, 0x89, 0x56, 0x99, 0x06, 0x80, 0xf2, 0xbe,
+	0x65, 0x38, 0x2e, 0xc6, 0xea, 0x0c, 0xfa, 0xfd, 0xee, 0x49, 0xeb, 0xc0, 0x78, 0x8a, 0xb4, 0xca,
+	0xf4, 0xaa, 0xb4, 0x36, 0xdd, 0x9c, 0xa5, 0x65, 0x8f, 0x70, 0x91, 0xfc, 0x00, 0x2a, 0xc4, 0x6e,
+	0x2d, 0xdd, 0x3a, 0x42, 0x36, 0x69, 0xbe, 0xd5, 0xb1, 0x4c, 0xd7, 0xb6, 0xba, 0x95, 0x19, 0x42,
+	0xbe, 0x48, 0xea, 0xdf, 0xf0, 0xab, 0x77, 0x68, 0xad, 0xbc, 0x09, 0x17, 0x29, 0xe7, 0x81, 0x65,
+	0x77, 0x90, 0xd6, 0xf2, 0x86, 0x43, 0x05, 0x08, 0xdb, 0x05, 0x52, 0xf9, 0x88, 0xd4, 0xbd, 0xcb,
+	0xaa, 0xe4, 0x3a, 0x5c, 0xb0, 0xd1, 0x7b, 0x03, 0xc3, 0x46, 0x5a, 0x4b, 0x75, 0x5d, 0xdb, 0x68,
+	0x0f, 0x5c, 0xe4, 0x54, 0x66, 0x57, 0x27, 0xd6, 0x66, 0x9a, 0xb2, 0x57, 0xd5, 0xf0, 0x6b, 0xe4,
+	0x15, 0x98, 0x19, 0x38, 0x5a, 0xab, 0x83, 0x4c, 0xd7, 0xa9, 0x9c, 0x5d, 0x95, 0xd6, 0x26, 0xb7,
+	0x4b, 0x15, 0xa9, 0x39, 0x3d, 0x70, 0xb4, 0x1d, 0x5c, 0x26, 0x2f, 0x42, 0xf9, 0xc8, 0xea, 0x0e,
+	0x7a, 0xa8, 0x72, 0x0e, 0xd7, 0x36, 0xd9, 0x97, 0x7c, 0x99, 0x32, 0xf6, 0x8c, 0x6e, 0xd7, 0xa9,
+	0xcc, 0x91, 0x2a, 0xcc, 0xb4, 0x87, 0xbf, 0xb7, 0xe6, 0xb1, 0x7f, 0x46, 0xdc, 0xa0, 0xba, 0x08,
+	0x0b, 0x51, 0x07, 0x64, 0x9e, 0xf9, 0x63, 0xc9, 0xf3, 0x4c, 0xaa, 0xea, 0x51, 0x8c, 0xbf, 0x5f,
+	0x86, 0x32, 0x35, 0x52, 0x65, 0xa2, 0x98, 0x6d, 0x19, 0x1b, 0x77, 0x7c, 0xf9, 0x00, 0x3c, 0x39,
+	0x19, 0x80, 0xdf, 0x91, 0x60, 0x71, 0xcf, 0xd1, 0x77, 0x51, 0x17, 0xb9, 0x68, 0x74, 0x18, 0x6e,
+	0xc2, 0x79, 0x1b, 0xf5, 0xac, 0x23, 0xa4, 0x79, 0x2a, 0x64, 0x03, 0x6d, 0x8e, 0x15, 0xb3, 0xc1,
+	0xc4, 0x95, 0xf5, 0x12, 0x2c, 0x25, 0x44, 0x62, 0xe2, 0x6a, 0x20, 0xef, 0x39, 0xfa, 0x23, 0xc3,
+	0x54, 0xbb, 0xc6, 0xb3, 0x51, 0xcc, 0x76, 0x5c, 0x01, 0x2e, 0xc2, 0x85, 0x48, 0x2f, 0x91, 0xce,
+	0x1b, 0x1d, 0xd7, 0x38, 0x52, 0xdd, 0x31, 0x77, 0x1e, 0xf4, 0xc2, 0x3a, 0x6f, 0xc3, 0x8b, 0x7b,
+	0x8e, 0xbe, 0x83, 0x9d, 0xa0, 0x3b, 0xae, 0xae, 0x2f, 0xc0, 0x7c, 0xa8, 0x8f, 0x48, 0xc7, 0xd4,
+	0x1a, 0xe3, 0xed, 0xd8, 0xeb, 0x83, 0x75, 0xfc, 0x2d, 0x09, 0xe6, 0xf6, 0x1c, 0x7d, 0xcf, 0x30,
+	0xdd, 0x53, 0x4f, 0xf8, 0xc3, 0x8b, 0x36, 0x0f, 0xe7, 0x7d, 0x21, 0xa2, 0x82, 0x6d, 0x0f, 0x6c,
+	0xf3, 0x73, 0x17, 0x8c, 0x0a, 0xc1, 0x04, 0xfb, 0x6f, 0x89, 0x78, 0xe8, 0x37, 0x0c, 0xf7, 0x50,
+	0xb3, 0xd5, 0xe3, 0x51, 0x0c, 0xe4, 0xab, 0x00, 0xae, 0x15, 0x1b, 0xc3, 0x33, 0xae, 0xe5, 0xad,
+	0x85, 0x27, 0x3e, 0xee, 0xc9, 0xd5, 0x09, 0x31, 0xee, 0x47, 0x18, 0xf7, 0x1f, 0xff, 0x6c, 0x65,
+	0x4d, 0x37, 0xdc, 0xc3, 0x41, 0xbb, 0xd6, 0xb1, 0x7a, 0x2c, 0x62, 0x63, 0xff, 0xad, 0x3b, 0xda,
+	0x93, 0x3a, 0x5e, 0x16, 0x1d, 0xc2, 0xe0, 0xfc, 0x00, 0xcf, 0xc2, 0x5d, 0xa4, 0xab, 0x9d, 0x93,
+	0x16, 0x0e, 0xd1, 0x9c, 0x3f, 0xfa, 0xf9, 0x4f, 0x6e, 0x4b, 0x9e, 0xe6, 0x04, 0x63, 0x27, 0xc0,
+	0xcf, 0xf4, 0xf2, 0xb7, 0x54, 0x2f, 0xde, 0x3a, 0x33, 0x7a, 0xa3, 0x4d, 0xf0, 0x54, 0x97, 0x23,
+	0x94, 0x88, 0x6a, 0x77, 0x2a, 0xa6, 0x5d, 0x01, 0xc4, 0x00, 0x0a, 0x83, 0xf8, 0x1f, 0x12, 0x5c,
+	0xdc, 0x73, 0xf4, 0xc7, 0xed, 0x4e, 0x1c, 0xe5, 0x07, 0x12, 0x4c, 0xfb, 0x8b, 0x2f, 0x05, 0x7a,
+	0xab, 0x66, 0xb4, 0x3b, 0xb5, 0x70, 0xb4, 0x5a, 0xf3, 0x28, 0x48, 0xe0, 0x11, 0xb4, 0xbf, 0xfd,
+	0xab, 0x18, 0xf8, 0xbf, 0x7e, 0xb2, 0xb2, 0x93, 0xb4, 0x9a, 0xd1, 0xee, 0xac, 0xeb, 0x56, 0xfd,
+	0xe8, 0x41, 0xbd, 0x67, 0x69, 0x83, 0x2e, 0x72, 0x70, 0xfc, 0x1b, 0x8a, 0x7b, 0xa9, 0x29, 0xc3,
+	0xc2, 0xfa, 0x72, 0x9c, 0xc2, 0xed, 0x2b, 0xb0, 0x18, 0xc7, 0xc9, 0x54, 0xf0, 0x0f, 0x12, 0x28,
+	0x7b, 0x8e, 0xbe, 0x8f, 0xdc, 0x5d, 0xec, 0xe0, 0x7b, 0xc8, 0x55, 0x35, 0xd5, 0x55, 0x3d, 0x3d,
+	0x0c, 0x60, 0xba, 0xc7, 0x8a, 0x98, 0x1a, 0xae, 0x06, 0xf6, 0x36, 0x9f, 0xf8, 0xf6, 0xf6, 0xf8,
+	0xb6, 0xb7, 0x18, 0xf4, 0x4d, 0xa1, 0xc3, 0x3e, 0xa5, 0x7b, 0x05, 0x06, 0xd6, 0xeb, 0xd3, 0xef,
+	0xea, 0x14, 0x48, 0xaf, 0xc2, 0x65, 0x2e, 0x1c, 0x06, 0xf7, 0x9f, 0x26, 0xe1, 0x3a, 0x5d, 0xd2,
+	0xbd, 0x85, 0xca, 0x5b, 0x33, 0xfe, 0x2f, 0x04, 0xc9, 0xb1, 0x40, 0x77, 0xea, 0xf4, 0x81, 0x6e,
+	0x79, 0x74, 0x81, 0xee, 0x99, 0x62, 0x81, 0xee, 0xf4, 0x70, 0x81, 0xee, 0x4c, 0xe1, 0x40, 0x17,
+	0xf2, 0x05, 0xba, 0xb3, 0xc2, 0x40, 0xf7, 0x6c, 0x7a, 0xa0, 0x7b, 0x2e, 0x3b, 0xd0, 0x7d, 0x19,
+	0x5e, 0x12, 0x3b, 0x15, 0xf3, 0xbe, 0x7f, 0x94, 0x60, 0x15, 0x7b, 0x27, 0x51, 0xe1, 0x63, 0xb3,
+	0x63, 0x23, 0xd5, 0x41, 0xef, 0xd8, 0x56, 0xdf, 0x72, 0xd4, 0xee, 0xa9, 0x5d, 0xef, 0x06, 0xcc,
+	0xb9, 0xaa, 0xad, 0x23, 0xd7, 0x77, 0x31, 0x36, 0x6a, 0x68, 0xa9, 0xe7, 0x64, 0xf7, 0x60, 0x46,
+	0x1d, 0xb8, 0x87, 0x96, 0x6d, 0xb8, 0x27, 0xd4, 0x47, 0xb7, 0x2b, 0x1f, 0x7f, 0xb8, 0xbe, 0xc0,
+	0x7a, 0x61, 0x64, 0xfb, 0xae, 0x6d, 0x98, 0x7a, 0x33, 0x20, 0xdd, 0x92, 0x7f, 0xf1, 0x87, 0x2b,
+	0x12, 0xc6, 0x1e, 0x94, 0x55, 0xaf, 0xc3, 0x35, 0x01, 0x1e, 0x86, 0xfa, 0xe3, 0x30, 0xea, 0x5d,
+	0xc4, 0x47, 0xdd, 0xce, 0x8f, 0xba, 0xce, 0xa6, 0x98, 0x9b, 0x39, 0xd7, 0x44, 0x5f, 0x41, 0x11,
+	0xe4, 0xa5, 0xd1, 0x21, 0xdf, 0x45, 0x29, 0xc8, 0x7f, 0x3c, 0x01, 0xd5, 0x3d, 0x47, 0xff, 0x5a,
+	0x5f, 0x63, 0xa1, 0x6f, 0xd4, 0x41, 0xc5, 0xa1, 0xc6, 0x6b, 0xa0, 0xd0, 0xb0, 0xbf, 0xc5, 0xf3,
+	0xfa, 0x12, 0xf1, 0xfa, 0x0a, 0xa5, 0x48, 0x36, 0x2d, 0xdf, 0x83, 0x25, 0x55, 0xd3, 0xb8, 0xac,
+	0x13, 0x84, 0xf5, 0xa2, 0xaa, 0x69, 0x1c, 0xbe, 0x37, 0x40, 0xf6, 0xc6, 0x62, 0x2b, 0x50, 0xd6,
+	0x64, 0x86, 0xb2, 0xe6, 0x3d, 0x9e, 0x86, 0xc7, 0x22, 0x3f, 0x86, 0x6b, 0x71, 0xf1, 0x1d, 0x64,
+	0x6a, 0xb8, 0xd9, 0x40, 0x94, 0x29, 0x22, 0xca, 0x72, 0x14, 0xc5, 0x3e, 0x21, 0x0b, 0xc9, 0xb4,
+	0x03, 0xcb, 0x11, 0x2c, 0xc9, 0x76, 0xca, 0xa4, 0x9d, 0xcb, 0x21, 0x48, 0xf1, 0x46, 0xb6, 0x2e,
+	0x7b, 0x46, 0xe4, 0xe0, 0xab, 0xde, 0x80, 0xeb, 0x42, 0x3b, 0x31, 0x7b, 0xfe, 0x99, 0x04, 0xcb,
+	0x3e, 0x5d, 0x74, 0x76, 0x12, 0xdb, 0x32, 0x75, 0xba, 0x2b, 0xa5, 0x4f, 0x77, 0xa3, 0x1c, 0xa7,
+	0xd7, 0x60, 0x25, 0x55, 0x6e, 0x86, 0xed, 0x3b, 0xf4, 0x64, 0x6c, 0x1f, 0xb9, 0x8d, 0x4e, 0x07,
+	0x0f, 0x97, 0xdd, 0x50, 0x18, 0xc0, 0x47, 0xb5, 0x00, 0x53, 0x47, 0x6a, 0x77, 0x80, 0xd8, 0x3c,
+	0x43, 0x3f, 0xe4, 0x3b, 0x50, 0x76, 0x0c, 0xdd, 0x44, 0x76, 0xa6, 0xd0, 0x8c, 0x6e, 0xeb, 0xbc,
+	0x27, 0x31, 0x2b, 0x60, 0xe7, 0x5a, 0x71, 0x51, 0x98, 0xa0, 0xff, 0x29, 0xc1, 0x15, 0x1f, 0x0c,
+	0x36, 0xf3, 0x2e, 0x32, 0x4f, 0xf0, 0x8a, 0x25, 0x16, 0xf6, 0x1e, 0x2c, 0x31, 0x7f, 0xd4, 0x90,
+	0x69, 0x04, 0x5b, 0x6c, 0x7f, 0x2c, 0x5d, 0xa4, 0xd5, 0xbb, 0xa4, 0xb6, 0xe1, 0x55, 0xca, 0x77,
+	0x60, 0x01, 0x3b, 0x5f, 0x82, 0x89, 0x8e, 0x22, 0x59, 0xd5, 0xb4, 0x38, 0x47, 0xc4, 0x70, 0x93,
+	0xa7, 0x33, 0xdc, 0x0a, 0x5c, 0x4d, 0xc1, 0x1a, 0xec, 0x5e, 0x56, 0x7d, 0x8a, 0x5d, 0xd4, 0xb7,
+	0x1c, 0x83, 0x9e, 0x05, 0x66, 0x6b, 0xe4, 0x01, 0xb0, 0xe9, 0xa3, 0x45, 0xdc, 0x8f, 0xa3, 0x92,
+	0x45, 0x5a, 0xdf, 0xa0, 0xd5, 0x01, 0x42, 0xec, 0xce, 0x9a, 0xc6, 0x61, 0xa3, 0x4a, 0xb9, 0xa0,
+	0x6a, 0x5a, 0x82, 0xe7, 0xab, 0xf1, 0x90, 0x2e, 0x4b, 0x33, 0xb1, 0x60, 0x6f, 0xd1, 0xd3, 0x4e,
+	0x2c, 0xe0, 0xa3, 0x13, 0x71, 0x1a, 0x7e, 0xa6, 0xa5, 0xbf, 0x90, 0xbc, 0xb0, 0xf0, 0x11, 0x42,
+	0xfb, 0xb8, 0xcc, 0xb2, 0x9d, 0x43, 0xa3, 0xef, 0x29, 0xe8, 0x2d, 0x98, 0x75, 0x82, 0x52, 0xb6,
+	0x04, 0xbd, 0xc4, 0x8f, 0x9a, 0xa2, 0x2d, 0xb0, 0x35, 0x38, 0xcc, 0x9e, 0x84, 0x5a, 0x2a, 0x06,
+	0x95, 0x17, 0xd7, 0x2e, 0xc3, 0x15, 0x3e, 0x00, 0x86, 0xf0, 0xbb, 0x74, 0x6a, 0x6a, 0x12, 0x83,
+	0xf1, 0x41, 0xf2, 0xbd, 0x60, 0x1c, 0xc2, 0xd2, 0xe9, 0x86, 0x2f, 0x0b, 0x93, 0xd7, 0x25, 0x8e,
+	0xbd, 0xd3, 0x55, 0x8d, 0x5e, 0x11, 0x69, 0xef, 0x40, 0xf9, 0xd0, 0xea, 0x6a, 0x28, 0x5b, 0x4c,
+	0x46, 0xb7, 0x35, 0x4b, 0x26, 0x16, 0xfa, 0x51, 0x5d, 0x85, 0xe5, 0xb4, 0x5e, 0x99, 0x5c, 0xcf,
+	0xc8, 0x4a, 0xd0, 0x44, 0x5d, 0xa4, 0x3a, 0x31, 0xd9, 0xdf, 0xb4, 0xba, 0xda, 0x58, 0xa5, 0xa3,
+	0x61, 0xa4, 0xa0, 0xef, 0x60, 0x19, 0x5a, 0xa2, 0xce, 0xb0, 0x6d, 0x1b, 0x9a, 0x8e, 0x1e, 0x9b,
+	0x07, 0x96, 0x27, 0xd8, 0x1b, 0x30, 0xdb, 0x26, 0x85, 0x2d, 0xc3, 0x3c, 0xb0, 0x98, 0x27, 0xa7,
+	0x6c, 0x21, 0x02, 0x6e, 0x2f, 0xfc, 0x6f, 0xfb, 0x25, 0x63, 0xf1, 0x0b, 0xc5, 0x5b, 0x62, 0xc2,
+	0x72, 0x33, 0x50, 0x7f, 0x45, 0xcf, 0x54, 0x1b, 0xae, 0x8b, 0x1c, 0x17, 0x69, 0x23, 0x39, 0xc0,
+	0xba, 0x02, 0x33, 0x36, 0x3a, 0x40, 0x36, 0xc2, 0x57, 0x2f, 0x74, 0x91, 0x0a, 0x0a, 0x92, 0x08,
+	0x27, 0x4e, 0x8f, 0xf0, 0x2e, 0x2c, 0x25, 0x40, 0x50, 0x80, 0xb2, 0x02, 0xd3, 0x0e, 0x06, 0x84,
+	0x65, 0x91, 0xe8, 0x9e, 0xc2, 0xfb, 0x0e, 0x5b, 0x54, 0x75, 0x9e, 0x20, 0x37, 0x6e, 0x51, 0x52,
+	0x98, 0xc7, 0xa2, 0x3e, 0xb7, 0x6f, 0x51, 0xbf, 0x64, 0xcc, 0x16, 0x0d, 0xc9, 0x1d, 0x4c, 0xba,
+	0xab, 0xb4, 0xf2, 0xf1, 0xf6, 0xce, 0x1e, 0xea, 0x59, 0x78, 0x57, 0x64, 0x99, 0x3b, 0x96, 0x79,
+	0x60, 0xe8, 0x01, 0xba, 0x72, 0x87, 0x14, 0xf8, 0xa7, 0x2c, 0x5c, 0x60, 0x9c, 0x16, 0x3c, 0x5b,
+	0x53, 0xf6, 0xb1, 0xa0, 0x63, 0x41, 0x7e, 0x0a, 0x00, 0x06, 0xf3, 0x07, 0xd4, 0x76, 0x54, 0x01,
+	0x6c, 0x05, 0x3a, 0xb5, 0xe7, 0xde, 0x83, 0x19, 0x8d, 0x36, 0x95, 0x03, 0x49, 0x40, 0xba, 0x35,
+	0x47, 0xe2, 0x07, 0xff, 0xbb, 0xfa, 0xdb, 0x34, 0xaa, 0x8b, 0x09, 0xc7, 0x3c, 0xf2, 0x09, 0x40,
+	0xc7, 0xea, 0xf5, 0x2d, 0x93, 0x6c, 0x90, 0xa5, 0xac, 0xb3, 0xc8, 0x3b, 0x45, 0xcf, 0x22, 0x9b,
+	0xa1, 0xe6, 0xab, 0x1f, 0x84, 0x25, 0x89, 0x1f, 0xb6, 0x0e, 0xad, 0xa7, 0x53, 0xce, 0xb8, 0xdf,
+	0x96, 0xe0, 0x12, 0x47, 0xa8, 0xcf, 0x43, 0x3f, 0x7f, 0x49, 0x43, 0x94, 0x86, 0xa6, 0xbd, 0x8d,
+	0xdc, 0x86, 0xe3, 0x20, 0xf7, 0xeb, 0x38, 0x9c, 0x1e, 0xc9, 0xc5, 0xd2, 0x3e, 0xbc, 0x68, 0xe2,
+	0x63, 0x01, 0xdc, 0x6a, 0x8b, 0x44, 0xe9, 0xde, 0x35, 0xd9, 0x75, 0xfe, 0x70, 0x8b, 0x88, 0xc0,
+	0x54, 0x3e, 0x67, 0x46, 0xe4, 0x12, 0x44, 0x29, 0x1c, 0x0c, 0x6c, 0xac, 0x7c, 0xbb, 0x44, 0x9d,
+	0xc0, 0x30, 0xd9, 0x9d, 0xe0, 0xdb, 0x6a, 0x2f, 0xe3, 0x7e, 0x64, 0x11, 0xca, 0x7d, 0xd5, 0x46,
+	0xa6, 0xcb, 0xa0, 0xb1, 0x2f, 0x59, 0x86, 0x49, 0x53, 0xed, 0x79, 0xb7, 0xed, 0xe4, 0xb7, 0xbc,
+	0x09, 0x67, 0x22, 0xa7, 0x6b, 0x02, 0x77, 0xf0, 0x08, 0xe5, 0x65, 0x00, 0x1b, 0x39, 0xae, 0x6d,
+	0x74, 0x5c, 0xa4, 0x91, 0x23, 0xb7, 0xe9, 0x66, 0xa8, 0x24, 0x39, 0xaf, 0x94, 0x47, 0x13, 0xb7,
+	0xd2, 0x3d, 0x4e, 0x5c, 0x13, 0x4c, 0x4f, 0x3f, 0xa2, 0xa7, 0xb2, 0xf4, 0x6e, 0x27, 0xaf, 0xa6,
+	0x3c, 0x8d, 0x94, 0x42, 0x1a, 0x39, 0xed, 0x1a, 0x97, 0x26, 0x3d, 0x3d, 0x66, 0x4d, 0xca, 0xc7,
+	0xe4, 0xff, 0x3d, 0x6a, 0x67, 0x5c, 0xaf, 0xab, 0x2e, 0x7a, 0xdd, 0xe9, 0xd8, 0x56, 0xc6, 0xcd,
+	0xca, 0xdb, 0x30, 0x7f, 0xa4, 0x76, 0x0d, 0x0d, 0x37, 0x1f, 0x3d, 0xc0, 0xda, 0xbe, 0xf6, 0xf1,
+	0x87, 0xeb, 0x57, 0x99, 0xb4, 0x5f, 0xf7, 0x68, 0xa2, 0x62, 0xbf, 0x78, 0x14, 0x2b, 0x97, 0x5f,
+	0xf3, 0xa7, 0x94, 0x89, 0xac, 0x29, 0x65, 0x06, 0xfb, 0x77, 0xe4, 0x9e, 0x64, 0x6c, 0xbb, 0x15,
+	0x6a, 0xf5, 0xb8, 0x5e, 0x98, 0xd6, 0xbe, 0x5f, 0x22, 0x56, 0xff, 0x9a, 0xa9, 0x7d, 0xa1, 0xb7,
+	0x98, 0xde, 0xde, 0x83, 0xcb, 0x5c, 0xcd, 0xb0, 0x99, 0xba, 0x09, 0xe7, 0xf1, 0x54, 0xda, 0x45,
+	0x78, 0x7d, 0x6e, 0xb9, 0x46, 0x0f, 0xb1, 0x85, 0x44, 0x49, 0x64, 0xda, 0xbc, 0xeb, 0x65, 0x43,
+	0x6d, 0x9f, 0xc3, 0xe2, 0xbf, 0xff, 0xb3, 0x15, 0x89, 0x42, 0x98, 0x0b, 0x5a, 0xc0, 0x34, 0xd5,
+	0x4f, 0xe8, 0x8e, 0x6a, 0xc7, 0xea, 0x76, 0x51, 0xc7, 0xf5, 0x3a, 0x3c, 0x56, 0x6d, 0xcd, 0x79,
+	0xbe, 0x16, 0x19, 0xd7, 0x18, 0xfe, 0xa1, 0x04, 0x2b, 0xa9, 0x00, 0x99, 0x62, 0x4f, 0x42, 0x0b,
+	0xf3, 0xf3, 0xbd, 0xaa, 0xac, 0xfe, 0xbb, 0x7f, 0x33, 0x85, 0xc3, 0xe8, 0x44, 0xb2, 0xd6, 0x6b,
+	0xf9, 0x43, 0x06, 0x8e, 0x9f, 0xde, 0x81, 0x72, 0xcf, 0x30, 0xdd, 0x3c, 0x71, 0x03, 0xa5, 0x1b,
+	0xf3, 0x4c, 0x9a, 0x44, 0x19, 0xac, 0x04, 0x97, 0xd9, 0x55, 0xfa, 0x23, 0x7c, 0x53, 0xf4, 0x39,
+	0xab, 0x21, 0x74, 0x56, 0x47, 0x0b, 0xaa, 0x6d, 0xb8, 0xc2, 0x97, 0x8f, 0x79, 0xd0, 0x36, 0xde,
+	0x83, 0xf5, 0x54, 0xc3, 0x34, 0x4c, 0xbd, 0x90, 0x8c, 0x01, 0x5b, 0xf5, 0xa3, 0x12, 0x19, 0x8a,
+	0xfb, 0x9d, 0x43, 0x84, 0xef, 0x4c, 0x77, 0x0d, 0x87, 0x1e, 0xcd, 0x1a, 0x96, 0x99, 0x75, 0x86,
+	0xee, 0x69, 0xa7, 0x34, 0x84, 0x76, 0xf0, 0x0d, 0x98, 0xab, 0xda, 0x6e, 0xeb, 0x10, 0x19, 0xfa,
+	0x21, 0x9d, 0x10, 0x27, 0x9a, 0xb3, 0xa4, 0xec, 0x4d, 0x52, 0x84, 0xef, 0xa2, 0xdb, 0xaa, 0xdb,
+	0x39, 0x6c, 0x39, 0xc6, 0x33, 0x9a, 0xdd, 0x77, 0xae, 0x39, 0x43, 0x4a, 0xf6, 0x8d, 0x67, 0x48,
+	0x7e, 0x00, 0xd0, 0x33, 0xcc, 0x56, 0x5f, 0x3d, 0xb1, 0x06, 0x6e, 0x65, 0x2a, 0x43, 0x86, 0xe6,
+	0x4c, 0xcf, 0x30, 0xdf, 0x21, 0xb4, 0x63, 0x0b, 0x3b, 0x7e, 0x05, 0x56, 0x52, 0x35, 0xc9, 0x2c,
+	0x76, 0x13, 0xce, 0x6b, 0xa1, 0xf2, 0x96, 0xa1, 0xb1, 0xfd, 0xea, 0x5c, 0xb8, 0xf8, 0xb1, 0x56,
+	0xfd, 0x03, 0x7a, 0x12, 0x4b, 0x53, 0x5f, 0x78, 0x46, 0xc9, 0xdb, 0xd2, 0xa9, 0x37, 0x6f, 0x69,
+	0x68, 0xe9, 0xf1, 0x29, 0x4f, 0x40, 0x36, 0xbc, 0xbe, 0x47, 0x87, 0x17, 0x39, 0x11, 0x3a, 0x15,
+	0x82, 0x57, 0x61, 0xba, 0x83, 0x1b, 0x51, 0x4d, 0x37, 0x53, 0x78, 0x9f, 0x72, 0x6b, 0xde, 0x93,
+	0xdb, 0x2f, 0xaa, 0xfe, 0x06, 0xd5, 0x69, 0x52, 0x20, 0x66, 0x9d, 0x53, 0x0d, 0xf8, 0xea, 0xef,
+	0xd3, 0xc0, 0x0c, 0x0f, 0xd3, 0x4e, 0xce, 0x00, 0x63, 0x11, 0xca, 0x5d, 0xa4, 0xe9, 0xde, 0x1c,
+	0xd1, 0x64, 0x5f, 0xa1, 0xa5, 0x61, 0xe2, 0x39, 0x2f, 0x0d, 0x63, 0x8e, 0xce, 0xe2, 0xca, 0xf1,
+	0xf6, 0xf9, 0x25, 0x58, 0x0a, 0x8e, 0xe7, 0xbe, 0xd0, 0x1c, 0xe7, 0x1c, 0x28, 0xa6, 0x1b, 0xa6,
+	0xb8, 0x7f, 0x2e, 0x79, 0xe7, 0x40, 0xb4, 0xc2, 0xdb, 0x67, 0xbf, 0x65, 0xf4, 0x0c, 0x77, 0x38,
+	0x0d, 0x6e, 0xc6, 0xb2, 0xad, 0x45, 0x1b, 0x3d, 0x46, 0x28, 0x1f, 0xc3, 0x54, 0x17, 0xf7, 0xf8,
+	0xfc, 0x92, 0xae, 0x68, 0x7f, 0x49, 0x9d, 0x4f, 0x8d, 0xf2, 0xe6, 0x23, 0x4d, 0xad, 0x4c, 0xf9,
+	0x7f, 0x5e, 0x22, 0xf3, 0x89, 0x57, 0x89, 0xd7, 0xe8, 0xff, 0xa7, 0xae, 0x1b, 0x4d, 0xfb, 0x9a,
+	0x8c, 0x27, 0xd5, 0x8d, 0x4b, 0xcb, 0x7f, 0x22, 0xc1, 0xd5, 0x14, 0x05, 0xb2, 0x19, 0xf9, 0xbb,
+	0x12, 0x9c, 0xf7, 0x63, 0x95, 0x16, 0xf5, 0xb1, 0xe7, 0x16, 0x2d, 0xcf, 0xf9, 0x3d, 0x13, 0xbb,
+	0x57, 0xff, 0x8b, 0x1e, 0xa3, 0x7b, 0x0b, 0x7c, 0x38, 0xdd, 0xf2, 0x74, 0xa1, 0xe2, 0x0a, 0xcc,
+	0xb6, 0x07, 0xb6, 0xe9, 0xc5, 0x42, 0x25, 0x12, 0x0b, 0x01, 0x2e, 0x62, 0xa1, 0xd0, 0x75, 0x38,
+	0xd7, 0x21, 0x0b, 0x6d, 0xeb, 0xd8, 0x30, 0x35, 0xeb, 0x98, 0x8c, 0xcc, 0xc9, 0xe6, 0x59, 0x5a,
+	0xf8, 0x0d, 0x52, 0x36, 0xb6, 0xf9, 0x67, 0x13, 0x96, 0x12, 0xa8, 0x99, 0x79, 0x96, 0xe0, 0x0c,
+	0x11, 0xdc, 0x5f, 0xba, 0xcb, 0xf8, 0xf3, 0xb1, 0x56, 0x7d, 0x5f, 0x0a, 0x45, 0x07, 0x1e, 0xab,
+	0x16, 0xd6, 0x58, 0x1a, 0xeb, 0xd8, 0xe2, 0x15, 0x76, 0x3f, 0xc5, 0x93, 0x88, 0x8d, 0xe7, 0xf7,
+	0x4b, 0x24, 0x2b, 0xf3, 0xf5, 0xa7, 0x9d, 0x43, 0xd5, 0xd4, 0xfd, 0x6d, 0xc0, 0x06, 0x9c, 0xe9,
+	0xab, 0xb6, 0x7b, 0xd2, 0xa2, 0x69, 0x7a, 0xc2, 0x48, 0x9e, 0x10, 0x36, 0xe4, 0x2d, 0x98, 0xa6,
+	0xa6, 0x6d, 0xa9, 0xd9, 0xd1, 0x31, 0x3d, 0x02, 0x3c, 0x43, 0x19, 0x1a, 0x41, 0x77, 0xed, 0xec,
+	0x4b, 0x7e, 0x42, 0xb8, 0x1d, 0xea, 0xae, 0x5d, 0x99, 0x2c, 0xd4, 0xdd, 0xf6, 0xd6, 0x15, 0x4f,
+	0x5d, 0x1e, 0xc8, 0xd0, 0xef, 0x36, 0x4b, 0xee, 0x0c, 0x34, 0xc2, 0x34, 0xf5, 0x37, 0x12, 0x54,
+	0x59, 0x16, 0x41, 0x58, 0xc7, 0xf1, 0xc4, 0x23, 0xfe, 0xfc, 0x17, 0x3c, 0x27, 0x28, 0x0d, 0xf5,
+	0x9c, 0x60, 0xa4, 0xd9, 0x1b, 0x34, 0x3b, 0x25, 0x1d, 0x08, 0x03, 0xfc, 0xa7, 0x12, 0xdc, 0xf0,
+	0xaf, 0x5d, 0x87, 0xc0, 0xcc, 0x79, 0x7e, 0x40, 0xd3, 0x00, 0x62, 0xcf, 0x0f, 0x46, 0x8a, 0x6d,
+	0x0d, 0x5e, 0xce, 0x92, 0x99, 0xc1, 0xfb, 0x6b, 0x76, 0x1e, 0x43, 0xac, 0x4c, 0x5f, 0x08, 0xe5,
+	0xc3, 0xd5, 0x00, 0x30, 0xd1, 0x71, 0x8b, 0x3d, 0x3f, 0x2a, 0xe5, 0x7e, 0x7e, 0x34, 0x63, 0xa2,
+	0x63, 0xfa, 0x73, 0x0c, 0xb9, 0x38, 0x7c, 0x18, 0xc1, 0x20, 0x5f, 0x0d, 0xad, 0x39, 0x74, 0xbd,
+	0xc9, 0x07, 0xb6, 0x13, 0xda, 0xf1, 0x8e, 0xfc, 0xbe, 0x22, 0x3d, 0xd3, 0x70, 0x22, 0x33, 0xd3,
+	0x70, 0x72, 0x14, 0xf9, 0x76, 0x69, 0x1a, 0x61, 0x7a, 0xfb, 0xcc, 0x1f, 0xf2, 0x91, 0xec, 0xdf,
+	0xb8, 0xe6, 0x3e, 0xa7, 0xa4, 0xe6, 0x61, 0xd3, 0x0f, 0xe7, 0xd2, 0xa6, 0x83, 0x14, 0x90, 0x4c,
+	0x19, 0x3f, 0xa4, 0x91, 0x00, 0xcd, 0x8c, 0x79, 0x47, 0xb5, 0xd5, 0x9e, 0x7f, 0x6e, 0x19, 0x91,
+	0x44, 0xca, 0x2d, 0x09, 0x7e, 0xc4, 0xd7, 0x27, 0x0d, 0xb1, 0x05, 0xe3, 0x0a, 0x7f, 0x14, 0xd1,
+	0xce, 0xbc, 0x09, 0x91, 0x72, 0x24, 0x50, 0xd0, 0xf7, 0x4a, 0x51, 0xe9, 0x98, 0xe4, 0xbf, 0x90,
+	0xe0, 0x66, 0x24, 0xeb, 0xa9, 0xc9, 0xae, 0x63, 0x0c, 0xcb, 0xdc, 0x3e, 0xe9, 0xab, 0x8e, 0x83,
+	0x4e, 0x0d, 0x65, 0x07, 0x26, 0x1c, 0xe4, 0x0d, 0x92, 0x2f, 0xf1, 0x71, 0x70, 0xbb, 0x66, 0xb0,
+	0x30, 0x37, 0x3e, 0x3e, 0xa3, 0x53, 0x23, 0x4d, 0x7c, 0x12, 0xad, 0x82, 0x94, 0x2e, 0xa1, 0x85,
+	0xdb, 0xb0, 0x96, 0x8d, 0x34, 0xc8, 0x90, 0xa8, 0xfa, 0x33, 0x47, 0x90, 0xfe, 0x9d, 0x6f, 0x5e,
+	0xf8, 0x0a, 0x4c, 0xe3, 0x49, 0x90, 0xe4, 0x95, 0x97, 0x72, 0xe6, 0x95, 0x9f, 0x31, 0xd1, 0x31,
+	0xfe, 0x31, 0x86, 0xc5, 0x2c, 0x1d, 0x04, 0x05, 0xbb, 0xf9, 0xbb, 0xf7, 0x61, 0x62, 0xcf, 0xd1,
+	0xe5, 0x16, 0x4c, 0x7b, 0x49, 0xd5, 0xf2, 0x5a, 0x8a, 0xc4, 0x89, 0xb7, 0x6d, 0xca, 0xad, 0x1c,
+	0x94, 0x2c, 0x3c, 0x6c, 0xc1, 0xb4, 0x97, 0xad, 0x2d, 0xe8, 0x20, 0xf6, 0x7e, 0x4d, 0xb9, 0x95,
+	0x83, 0x92, 0x75, 0xf0, 0x6b, 0x50, 0xa6, 0x01, 0x9d, 0xfc, 0x72, 0x2a, 0x53, 0xe4, 0x85, 0x9a,
+	0x72, 0x33, 0x93, 0x2e, 0x68, 0x9a, 0x5e, 0xc1, 0x09, 0x9a, 0x8e, 0xbc, 0x41, 0x53, 0x6e, 0x66,
+	0xd2, 0xb1, 0xa6, 0xf7, 0x61, 0x12, 0x9f, 0xe9, 0xca, 0x2f, 0xa5, 0x32, 0x84, 0x32, 0x74, 0x94,
+	0x1b, 0x19, 0x54, 0x41, 0xa3, 0x38, 0x98, 0x15, 0x34, 0x1a, 0x8a, 0xbe, 0x95, 0x1b, 0x19, 0x54,
+	0xac, 0xd1, 0x36, 0xcc, 0xf8, 0x2f, 0x34, 0x65, 0x81, 0x5d, 0x62, 0xaf, 0x4d, 0x95, 0xdb, 0x79,
+	0x48, 0xfd, 0x4c, 0x80, 0xb3, 0xe1, 0x97, 0x95, 0xf2, 0x97, 0x33, 0xd4, 0x18, 0xed, 0x69, 0x3d,
+	0x27, 0x75, 0xe0, 0x91, 0xde, 0x3a, 0x27, 0xf0, 0xc8, 0x58, 0x0a, 0x85, 0x72, 0x2b, 0x07, 0x65,
+	0x44, 0x63, 0x74, 0xf0, 0x89, 0x35, 0x16, 0x79, 0x14, 0xa3, 0xdc, 0xce, 0x43, 0x1a, 0x80, 0xf0,
+	0x33, 0x99, 0xd3, 0x41, 0xc4, 0xb2, 0xa7, 0x95, 0x5b, 0x39, 0x28, 0x59, 0x07, 0x87, 0x30, 0x1b,
+	0x7a, 0xcf, 0x24, 0x7f, 0x29, 0x95, 0x33, 0xf9, 0xba, 0x4b, 0xf9, 0x72, 0x3e, 0x62, 0xd6, 0xd3,
+	0x31, 0xbc, 0x18, 0x5f, 0x6c, 0xe5, 0x3b, 0xa9, 0x2d, 0xa4, 0xbc, 0xa4, 0x52, 0x36, 0x0a, 0x70,
+	0xb0, 0x8e, 0xdf, 0x83, 0xb9, 0xe8, 0xdb, 0x7e, 0xb9, 0x96, 0xda, 0x08, 0xf7, 0x2f, 0x1a, 0x28,
+	0xf5, 0xdc, 0xf4, 0xac, 0xcb, 0x0f, 0x24, 0xb8, 0x94, 0xfa, 0x8e, 0x45, 0x7e, 0x28, 0x72, 0x00,
+	0xe1, 0x83, 0x2a, 0x65, 0x6b, 0x18, 0x56, 0x26, 0xd4, 0x77, 0x24, 0x58, 0xe4, 0xbf, 0x31, 0x91,
+	0xef, 0xa5, 0x6b, 0x55, 0xf4, 0xc8, 0x46, 0xb9, 0x5f, 0x98, 0x2f, 0x21, 0xcb, 0x2e, 0x2a, 0x28,
+	0xcb, 0x2e, 0x1a, 0x4e, 0x96, 0xb4, 0xe7, 0x25, 0xf2, 0xf7, 0x24, 0xa8, 0xa4, 0xbd, 0x59, 0x90,
+	0x1f, 0xa4, 0xb6, 0x9a, 0xf1, 0x1c, 0x45, 0x79, 0x38, 0x04, 0x27, 0x93, 0xe8, 0x5b, 0x12, 0x2c,
+	0xf0, 0x5e, 0x19, 0xc8, 0xaf, 0x66, 0xb4, 0xc9, 0x7d, 0x4c, 0xa1, 0xdc, 0x2d, 0xc8, 0x15, 0x8c,
+	0x9b, 0xe8, 0xdb, 0x01, 0xc1, 0xb8, 0xe1, 0xbe, 0x77, 0x50, 0xea, 0xb9, 0xe9, 0x59, 0x97, 0xbf,
+	0x09, 0x72, 0x32, 0x49, 0x5f, 0xde, 0xcc, 0x90, 0x9f, 0xf3, 0x7a, 0x41, 0x79, 0xa5, 0x10, 0x4f,
+	0xc8, 0x2b, 0xf9, 0x29, 0xf0, 0x02, 0xaf, 0x14, 0xbe, 0x19, 0x50, 0xee, 0x17, 0xe6, 0x63, 0xb2,
+	0x3c, 0x83, 0xf9, 0x44, 0x9a, 0xba, 0x2c, 0x9c, 0xfd, 0xb8, 0x09, 0xe0, 0xca, 0x66, 0x11, 0x96,
+	0x90, 0xff, 0xf1, 0xd2, 0xce, 0x05, 0xfe, 0x27, 0xc8, 0x98, 0x57, 0xee, 0x16, 0xe4, 0x62, 0x52,
+	0x7c, 0x53, 0x82, 0x0b, 0x9c, 0x1c, 0x73, 0x39, 0xdd, 0xb4, 0xe9, 0x79, 0xf0, 0xca, 0xab, 0xc5,
+	0x98, 0x42, 0xf3, 0x78, 0x6a, 0x22, 0xb9, 0x60, 0x1e, 0xcf, 0x4a, 0x7c, 0x57, 0xb6, 0x86, 0x61,
+	0x65, 0x42, 0x99, 0x70, 0x2e, 0x92, 0xfb, 0x2d, 0xaf, 0x8b, 0x4c, 0x9c, 0xc8, 0x6d, 0x57, 0x6a,
+	0x79, 0xc9, 0x83, 0xa8, 0x2d, 0x9c, 0x89, 0x2d, 0x88, 0xda, 0x38, 0x59, 0xe7, 0xca, 0x7a, 0x4e,
+	0xea, 0x28, 0xb8, 0x20, 0xdd, 0x5a, 0x0c, 0x2e, 0x9e, 0xe6, 0xad, 0xd4, 0xf2, 0x92, 0x87, 0x17,
+	0x22, 0x6e, 0x66, 0xb2, 0x68, 0x21, 0x12, 0xe5, 0x62, 0x2b, 0xf7, 0x0b, 0xf3, 0x05, 0xd8, 0x23,
+	0x19, 0xc6, 0x02, 0xec, 0xbc, 0x34, 0x69, 0xa5, 0x96, 0x97, 0x3c, 0x98, 0xe0, 0xa3, 0x29, 0xbb,
+	0x72, 0x56, 0x0b, 0xf1, 0x68, 0xb9, 0x9e, 0x9b, 0x3e, 0x98, 0xd5, 0x12, 0x69, 0xad, 0x82, 0x59,
+	0x2d, 0x2d, 0x8d, 0x57, 0xd9, 0x2c, 0xc2, 0x12, 0x82, 0x1b, 0xc9, 0x13, 0x15, 0xc1, 0xe5, 0xa5,
+	0xd6, 0x2a, 0xf5, 0xdc, 0xf4, 0x41, 0xcc, 0x1b, 0x4f, 0xee, 0x14, 0xc4, 0xbc, 0x29, 0x79, 0xaa,
+	0xca, 0x46, 0x01, 0x8e, 0x00, 0x6b, 0x34, 0x3b, 0x52, 0x80, 0x95, 0x9b, 0x5e, 0xaa, 0xd4, 0x73,
+	0xd3, 0x07, 0x58, 0xe3, 0x89, 0x85, 0x02, 0xac, 0x29, 0xd9, 0x99, 0xca, 0x46, 0x01, 0x8e, 0xd0,
+	0x6a, 0xc5, 0xcb, 0xbe, 0x13, 0xac, 0x56, 0x82, 0x6c, 0x44, 0xe5, 0x6e, 0x41, 0xae, 0xc8, 0xf6,
+	0x26, 0x92, 0x7d, 0x26, 0xde, 0xde, 0xf0, 0xd2, 0xf1, 0x94, 0x8d, 0x02, 0x1c, 0xc1, 0x90, 0x4a,
+	0xa4, 0x8d, 0x09, 0x86, 0x54, 0x5a, 0x0a, 0x9c, 0xb2, 0x59, 0x84, 0x25, 0xa4, 0x7a, 0x5e, 0x12,
+	0x94, 0x40, 0xf5, 0x82, 0xec, 0x33, 0xe5, 0x6e, 0x41, 0xae, 0x20, 0x6a, 0x4c, 0xe6, 0x26, 0x09,
+	0xa2, 0xc6, 0xd4, 0x4c, 0x2b, 0xe5, 0x95, 0x42, 0x3c, 0x81, 0x01, 0x12, 0x79, 0x46, 0x02, 0x03,
+	0xa4, 0x25, 0x49, 0x29, 0x9b, 0x45, 0x58, 0x82, 0x71, 0x1e, 0xcd, 0xb3, 0x11, 0x8c, 0x73, 0x6e,
+	0xb6, 0x92, 0x52, 0xcf, 0x4d, 0x1f, 0xac, 0x52, 0x91, 0x04, 0x15, 0xc1, 0x2a, 0xc5, 0x4b, 0xf2,
+	0x51, 0x6a, 0x79, 0xc9, 0xa3, 0x2b, 0x34, 0x27, 0x3b, 0x43, 0xbc, 0x42, 0xa7, 0x67, 0xc9, 0x28,
+	0xf7, 0x0b, 0xf3, 0x05, 0x9e, 0x96, 0xcc, 0x60, 0x10, 0x78, 0x5a, 0x6a, 0xbe, 0x88, 0xf2, 0x4a,
+	0x21, 0x9e, 0x20, 0x12, 0x0b, 0xdf, 0xcd, 0x0b, 0x22, 0x31, 0x4e, 0xe2, 0x82, 0xb2, 0x9e, 0x93,
+	0x3a, 0x1c, 0x7e, 0x27, 0xaf, 0xd0, 0xe5, 0xac, 0x31, 0xc2, 0x4b, 0x01, 0x50, 0x5e, 0x2d, 0xc6,
+	0x14, 0x9c, 0x7e, 0x79, 0xf7, 0xd1, 0x82, 0xd3, 0xaf, 0xd8, 0x25, 0xbe, 0x72, 0x2b, 0x07, 0x65,
+	0x68, 0xeb, 0x9f, 0x76, 0x21, 0x2c, 0xd8, 0xfa, 0x67, 0x5c, 0x86, 0x2b, 0x0f, 0x87, 0xe0, 0x64,
+	0x12, 0x7d, 0x5f, 0x82, 0xcb, 0x82, 0x6b, 0x5c, 0xf9, 0x2b, 0x19, 0x7b, 0x29, 0xa1, 0x5c, 0xaf,
+	0x0d, 0xc7, 0x1c, 0x5e, 0x67, 0x39, 0xf7, 0xad, 0xa2, 0x75, 0x36, 0xfd, 0x96, 0x59, 0xb9, 0x5b,
+	0x90, 0x2b, 0x34, 0x1d, 0xf0, 0xef, 0x2f, 0x05, 0xd3, 0x81, 0xf0, 0x0a, 0x58, 0xb9, 0x5f, 0x98,
+	0x2f, 0xea, 0x3e, 0xdc, 0x0b, 0x44, 0xb1, 0xfb, 0x88, 0x2e, 0x56, 0x95, 0x87, 0x43, 0x70, 0x06,
+	0x33, 0x44, 0xf8, 0x2e, 0x50, 0x30, 0x43, 0x70, 0x2e, 0x34, 0x95, 0xf5, 0x9c, 0xd4, 0xac, 0xb3,
+	0x1f, 0x49, 0x70, 0x55, 0x78, 0xe7, 0x26, 0xff, 0x52, 0x8e, 0x53, 0x98, 0xf4, 0x5b, 0x49, 0xe5,
+	0xab, 0xc3, 0xb2, 0x87, 0xec, 0x93, 0x76, 0x45, 0x26, 0xb0, 0x4f, 0xc6, 0xd5, 0xa0, 0xf2, 0x70,
+	0x08, 0x4e, 0x2a, 0x91, 0x32, 0xf5, 0x4d, 0x9c, 0x0d, 0xb6, 0xad, 0x7f, 0xf4, 0xe9, 0xb2, 0xf4,
+	0xd3, 0x4f, 0x97, 0xa5, 0x7f, 0xfb, 0x74, 0x59, 0x7a, 0xff, 0xb3, 0xe5, 0x17, 0x7e, 0xfa, 0xd9,
+	0xf2, 0x0b, 0xff, 0xf2, 0xd9, 0xf2, 0x0b, 0xb0, 0x64, 0x58, 0xdc, 0xd6, 0xdf, 0x91, 0x7e, 0x3d,
+	0x7c, 0x6d, 0x1e, 0x90, 0xac, 0x1b, 0x56, 0xe8, 0xab, 0xfe, 0xd4, 0xfb, 0xbb, 0xbd, 0xe4, 0xfe,
+	0xbc, 0x5d, 0x26, 0x0f, 0x76, 0x5e, 0xf9, 0xdf, 0x01, 0x00, 0xc9, 0xfc, 0x8b, 0xb4, 0x31, 0x59,
+	0x00, 0x00,
 }
 
-func (m *MsgUpdateSendDenyListRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0x22
+func (this *MsgSupplyIncreaseProposalRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	if len(m.AddDeniedAddresses) > 0 {
-		for iNdEx := len(m.AddDeniedAddresses) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.AddDeniedAddresses[iNdEx])
-			copy(dAtA[i:], m.AddDeniedAddresses[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.AddDeniedAddresses[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
+
+	that1, ok := that.(*MsgSupplyIncreaseProposalRequest)
+	if !ok {
+		that2, ok := that.(MsgSupplyIncreaseProposalRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
-	if len(m.RemoveDeniedAddresses) > 0 {
-		for iNdEx := len(m.RemoveDeniedAddresses) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.RemoveDeniedAddresses[iNdEx])
-			copy(dAtA[i:], m.RemoveDeniedAddresses[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.RemoveDeniedAddresses[iNdEx])))
-			i--
-			dAtA[i] = 0x12
-		}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
+	if !this.Amount.Equal(&that1.Amount) {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *MsgUpdateSendDenyListResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.TargetAddress != that1.TargetAddress {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgUpdateSendDenyListResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgUpdateSendDenyListResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
-}
-
-func (m *MsgAddNetAssetValuesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.Authority != that1.Authority {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgAddNetAssetValuesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return true
 }
+func (this *MsgSupplyDecreaseProposalRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *MsgAddNetAssetValuesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.NetAssetValues) > 0 {
-		for iNdEx := len(m.NetAssetValues) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.NetAssetValues[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
+	that1, ok := that.(*MsgSupplyDecreaseProposalRequest)
+	if !ok {
+		that2, ok := that.(MsgSupplyDecreaseProposalRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
-	if len(m.Administrator) > 0 {
-		i -= len(m.Administrator)
-		copy(dAtA[i:], m.Administrator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Administrator)))
-		i--
-		dAtA[i] = 0x12
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
+	if !this.Amount.Equal(that1.Amount) {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *MsgAddNetAssetValuesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.Authority != that1.Authority {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgAddNetAssetValuesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgAddNetAssetValuesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	return true
 }
-
-func (m *MsgSetAdministratorProposalRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *MsgUpdateRequiredAttributesRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgSetAdministratorProposalRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
 
-func (m *MsgSetAdministratorProposalRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0x1a
+	that1, ok := that.(*MsgUpdateRequiredAttributesRequest)
+	if !ok {
+		that2, ok := that.(MsgUpdateRequiredAttributesRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if len(m.Access) > 0 {
-		for iNdEx := len(m.Access) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Access[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Denom != that1.Denom {
+		return false
+	}
+	if len(this.RemoveRequiredAttributes) != len(that1.RemoveRequiredAttributes) {
+		return false
+	}
+	for i := range this.RemoveRequiredAttributes {
+		if this.RemoveRequiredAttributes[i] != that1.RemoveRequiredAttributes[i] {
+			return false
 		}
 	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
+	if len(this.AddRequiredAttributes) != len(that1.AddRequiredAttributes) {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *MsgSetAdministratorProposalResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	for i := range this.AddRequiredAttributes {
+		if this.AddRequiredAttributes[i] != that1.AddRequiredAttributes[i] {
+			return false
+		}
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgSetAdministratorProposalResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgSetAdministratorProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
-}
-
-func (m *MsgRemoveAdministratorProposalRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.TransferAuthority != that1.TransferAuthority {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgRemoveAdministratorProposalRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgRemoveAdministratorProposalRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0x1a
+	if len(this.RemoveRequiredSenderAttributes) != len(that1.RemoveRequiredSenderAttributes) {
+		return false
 	}
-	if len(m.RemovedAddress) > 0 {
-		for iNdEx := len(m.RemovedAddress) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.RemovedAddress[iNdEx])
-			copy(dAtA[i:], m.RemovedAddress[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.RemovedAddress[iNdEx])))
-			i--
-			dAtA[i] = 0x12
+	for i := range this.RemoveRequiredSenderAttributes {
+		if this.RemoveRequiredSenderAttributes[i] != that1.RemoveRequiredSenderAttributes[i] {
+			return false
 		}
 	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
+	if len(this.AddRequiredSenderAttributes) != len(that1.AddRequiredSenderAttributes) {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *MsgRemoveAdministratorProposalResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	for i := range this.AddRequiredSenderAttributes {
+		if this.AddRequiredSenderAttributes[i] != that1.AddRequiredSenderAttributes[i] {
+			return false
+		}
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgRemoveAdministratorProposalResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgRemoveAdministratorProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	return true
 }
-
-func (m *MsgChangeStatusProposalRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *MsgUpdateForcedTransferRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgChangeStatusProposalRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
 
-func (m *MsgChangeStatusProposalRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0x1a
+	that1, ok := that.(*MsgUpdateForcedTransferRequest)
+	if !ok {
+		that2, ok := that.(MsgUpdateForcedTransferRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if m.NewStatus != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.NewStatus))
-		i--
-		dAtA[i] = 0x10
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
+	if this.Denom != that1.Denom {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *MsgChangeStatusProposalResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.AllowForcedTransfer != that1.AllowForcedTransfer {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgChangeStatusProposalResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgChangeStatusProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
-}
-
-func (m *MsgWithdrawEscrowProposalRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.Authority != that1.Authority {
+		return false
 	}
-	return dAtA[:n], nil
+	return true
 }
+func (this *MsgSetAccountDataRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *MsgWithdrawEscrowProposalRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	that1, ok := that.(*MsgSetAccountDataRequest)
+	if !ok {
+		that2, ok := that.(MsgSetAccountDataRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Denom != that1.Denom {
+		return false
+	}
+	if this.Value != that1.Value {
+		return false
+	}
+	if this.Signer != that1.Signer {
+		return false
+	}
+	return true
 }
+func (this *MsgUpdateSendDenyListRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *MsgWithdrawEscrowProposalRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0x22
+	that1, ok := that.(*MsgUpdateSendDenyListRequest)
+	if !ok {
+		that2, ok := that.(MsgUpdateSendDenyListRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if len(m.TargetAddress) > 0 {
-		i -= len(m.TargetAddress)
-		copy(dAtA[i:], m.TargetAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.TargetAddress)))
-		i--
-		dAtA[i] = 0x1a
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if len(m.Amount) > 0 {
-		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
+	if this.Denom != that1.Denom {
+		return false
+	}
+	if len(this.RemoveDeniedAddresses) != len(that1.RemoveDeniedAddresses) {
+		return false
+	}
+	for i := range this.RemoveDeniedAddresses {
+		if this.RemoveDeniedAddresses[i] != that1.RemoveDeniedAddresses[i] {
+			return false
 		}
 	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
+	if len(this.AddDeniedAddresses) != len(that1.AddDeniedAddresses) {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *MsgWithdrawEscrowProposalResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	for i := range this.AddDeniedAddresses {
+		if this.AddDeniedAddresses[i] != that1.AddDeniedAddresses[i] {
+			return false
+		}
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgWithdrawEscrowProposalResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgWithdrawEscrowProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
-}
-
-func (m *MsgSetDenomMetadataProposalRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.Authority != that1.Authority {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgSetDenomMetadataProposalRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return true
 }
+func (this *MsgUpdateDepositAllowListRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *MsgSetDenomMetadataProposalRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0x12
+	that1, ok := that.(*MsgUpdateDepositAllowListRequest)
+	if !ok {
+		that2, ok := that.(MsgUpdateDepositAllowListRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	{
-		size := m.Metadata.Size()
-		i -= size
-		if _, err := m.Metadata.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Denom != that1.Denom {
+		return false
+	}
+	if len(this.RemoveAllowedAddresses) != len(that1.RemoveAllowedAddresses) {
+		return false
+	}
+	for i := range this.RemoveAllowedAddresses {
+		if this.RemoveAllowedAddresses[i] != that1.RemoveAllowedAddresses[i] {
+			return false
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	if len(this.AddAllowedAddresses) != len(that1.AddAllowedAddresses) {
+		return false
+	}
+	for i := range this.AddAllowedAddresses {
+		if this.AddAllowedAddresses[i] != that1.AddAllowedAddresses[i] {
+			return false
+		}
+	}
+	if this.Administrator != that1.Administrator {
+		return false
+	}
+	return true
 }
+func (this *MsgBindMarkerNameRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *MsgSetDenomMetadataProposalResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	that1, ok := that.(*MsgBindMarkerNameRequest)
+	if !ok {
+		that2, ok := that.(MsgBindMarkerNameRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return dAtA[:n], nil
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Denom != that1.Denom {
+		return false
+	}
+	if this.Parent != that1.Parent {
+		return false
+	}
+	if this.Name != that1.Name {
+		return false
+	}
+	if this.Address != that1.Address {
+		return false
+	}
+	if this.Restricted != that1.Restricted {
+		return false
+	}
+	if this.Administrator != that1.Administrator {
+		return false
+	}
+	return true
 }
+func (this *MsgDeleteMarkerNameRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *MsgSetDenomMetadataProposalResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgSetDenomMetadataProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
-}
-
-func (m *MsgUpdateParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	that1, ok := that.(*MsgDeleteMarkerNameRequest)
+	if !ok {
+		that2, ok := that.(MsgDeleteMarkerNameRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgUpdateParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Denom != that1.Denom {
+		return false
+	}
+	if this.Name != that1.Name {
+		return false
+	}
+	if this.Administrator != that1.Administrator {
+		return false
+	}
+	return true
 }
+func (this *MsgDelegateEscrowRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *MsgUpdateParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	that1, ok := that.(*MsgDelegateEscrowRequest)
+	if !ok {
+		that2, ok := that.(MsgDelegateEscrowRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.Denom != that1.Denom {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	if this.ValidatorAddress != that1.ValidatorAddress {
+		return false
+	}
+	if !this.Amount.Equal(&that1.Amount) {
+		return false
+	}
+	if this.Administrator != that1.Administrator {
+		return false
+	}
+	return true
 }
+func (this *MsgUndelegateEscrowRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	that1, ok := that.(*MsgUndelegateEscrowRequest)
+	if !ok {
+		that2, ok := that.(MsgUndelegateEscrowRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *MsgGrantAllowanceRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if this.Denom != that1.Denom {
+		return false
 	}
-	l = len(m.Administrator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if this.ValidatorAddress != that1.ValidatorAddress {
+		return false
 	}
-	l = len(m.Grantee)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if !this.Amount.Equal(&that1.Amount) {
+		return false
 	}
-	if m.Allowance != nil {
-		l = m.Allowance.Size()
-		n += 1 + l + sovTx(uint64(l))
+	if this.Administrator != that1.Administrator {
+		return false
 	}
-	return n
+	return true
 }
-
-func (m *MsgGrantAllowanceResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (this *MsgCollectEscrowRewardsRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	var l int
-	_ = l
-	return n
-}
 
-func (m *MsgAddMarkerRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	that1, ok := that.(*MsgCollectEscrowRewardsRequest)
+	if !ok {
+		that2, ok := that.(MsgCollectEscrowRewardsRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	var l int
-	_ = l
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.Manager)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	l = len(m.FromAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if this.Denom != that1.Denom {
+		return false
 	}
-	if m.Status != 0 {
-		n += 1 + sovTx(uint64(m.Status))
+	if this.ValidatorAddress != that1.ValidatorAddress {
+		return false
 	}
-	if m.MarkerType != 0 {
-		n += 1 + sovTx(uint64(m.MarkerType))
+	if this.Administrator != that1.Administrator {
+		return false
 	}
-	if len(m.AccessList) > 0 {
-		for _, e := range m.AccessList {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+	return true
+}
+func (this *MsgSetMintAllowanceRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgSetMintAllowanceRequest)
+	if !ok {
+		that2, ok := that.(MsgSetMintAllowanceRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
-	if m.SupplyFixed {
-		n += 2
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.AllowGovernanceControl {
-		n += 2
+	if !this.Amount.Equal(&that1.Amount) {
+		return false
 	}
-	if m.AllowForcedTransfer {
-		n += 2
+	if this.Minter != that1.Minter {
+		return false
 	}
-	if len(m.RequiredAttributes) > 0 {
-		for _, s := range m.RequiredAttributes {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
+	if this.Administrator != that1.Administrator {
+		return false
+	}
+	return true
+}
+func (this *MsgMintFromAllowanceRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgMintFromAllowanceRequest)
+	if !ok {
+		that2, ok := that.(MsgMintFromAllowanceRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
-	if m.UsdCents != 0 {
-		n += 1 + sovTx(uint64(m.UsdCents))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.Volume != 0 {
-		n += 1 + sovTx(uint64(m.Volume))
+	if !this.Amount.Equal(&that1.Amount) {
+		return false
 	}
-	if m.UsdMills != 0 {
-		n += 1 + sovTx(uint64(m.UsdMills))
+	if this.Minter != that1.Minter {
+		return false
 	}
-	return n
+	return true
 }
-
-func (m *MsgAddMarkerResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (this *MsgScheduleDistributionRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	var l int
-	_ = l
-	return n
-}
 
-func (m *MsgAddAccessRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	that1, ok := that.(*MsgScheduleDistributionRequest)
+	if !ok {
+		that2, ok := that.(MsgScheduleDistributionRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	l = len(m.Administrator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if len(m.Access) > 0 {
-		for _, e := range m.Access {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	if this.Denom != that1.Denom {
+		return false
 	}
-	return n
-}
-
-func (m *MsgAddAccessResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if !this.Amount.Equal(&that1.Amount) {
+		return false
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *MsgDeleteAccessRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if this.StartHeight != that1.StartHeight {
+		return false
 	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if this.BatchSize != that1.BatchSize {
+		return false
 	}
-	l = len(m.Administrator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if !this.MinPayout.Equal(that1.MinPayout) {
+		return false
 	}
-	l = len(m.RemovedAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if this.Administrator != that1.Administrator {
+		return false
 	}
-	return n
+	return true
 }
-
-func (m *MsgDeleteAccessResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (this *MsgCancelDistributionRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	var l int
-	_ = l
-	return n
-}
 
-func (m *MsgFinalizeRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	that1, ok := that.(*MsgCancelDistributionRequest)
+	if !ok {
+		that2, ok := that.(MsgCancelDistributionRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	l = len(m.Administrator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if this.DistributionId != that1.DistributionId {
+		return false
 	}
-	return n
-}
-
-func (m *MsgFinalizeResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if this.Administrator != that1.Administrator {
+		return false
 	}
-	var l int
-	_ = l
-	return n
+	return true
 }
+func (this *MsgClaimDistributionRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *MsgActivateRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	that1, ok := that.(*MsgClaimDistributionRequest)
+	if !ok {
+		that2, ok := that.(MsgClaimDistributionRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	l = len(m.Administrator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if this.DistributionId != that1.DistributionId {
+		return false
 	}
-	return n
-}
-
-func (m *MsgActivateResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if this.Claimant != that1.Claimant {
+		return false
 	}
-	var l int
-	_ = l
-	return n
+	return true
 }
+func (this *MsgAllocateEscrowRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *MsgCancelRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	that1, ok := that.(*MsgAllocateEscrowRequest)
+	if !ok {
+		that2, ok := that.(MsgAllocateEscrowRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	l = len(m.Administrator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if this.Denom != that1.Denom {
+		return false
 	}
-	return n
-}
-
-func (m *MsgCancelResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if this.Ledger != that1.Ledger {
+		return false
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *MsgDeleteRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(this.Amount) != len(that1.Amount) {
+		return false
 	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	for i := range this.Amount {
+		if !this.Amount[i].Equal(&that1.Amount[i]) {
+			return false
+		}
 	}
-	l = len(m.Administrator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if this.Administrator != that1.Administrator {
+		return false
 	}
-	return n
+	return true
 }
-
-func (m *MsgDeleteResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (this *MsgReleaseEscrowRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	var l int
-	_ = l
-	return n
-}
 
-func (m *MsgMintRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	that1, ok := that.(*MsgReleaseEscrowRequest)
+	if !ok {
+		that2, ok := that.(MsgReleaseEscrowRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	var l int
-	_ = l
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.Administrator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return n
-}
-
-func (m *MsgMintResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if this.Denom != that1.Denom {
+		return false
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *MsgBurnRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if this.Ledger != that1.Ledger {
+		return false
 	}
-	var l int
-	_ = l
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.Administrator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(this.Amount) != len(that1.Amount) {
+		return false
 	}
-	return n
-}
-
-func (m *MsgBurnResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	for i := range this.Amount {
+		if !this.Amount[i].Equal(&that1.Amount[i]) {
+			return false
+		}
 	}
-	var l int
-	_ = l
-	return n
+	if this.Administrator != that1.Administrator {
+		return false
+	}
+	return true
 }
+func (this *MsgSetEscrowWithdrawLimitRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *MsgWithdrawRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	that1, ok := that.(*MsgSetEscrowWithdrawLimitRequest)
+	if !ok {
+		that2, ok := that.(MsgSetEscrowWithdrawLimitRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	l = len(m.Administrator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if this.Denom != that1.Denom {
+		return false
 	}
-	l = len(m.ToAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if this.Ledger != that1.Ledger {
+		return false
 	}
-	if len(m.Amount) > 0 {
-		for _, e := range m.Amount {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+	if this.Grantee != that1.Grantee {
+		return false
+	}
+	if len(this.Limit) != len(that1.Limit) {
+		return false
+	}
+	for i := range this.Limit {
+		if !this.Limit[i].Equal(&that1.Limit[i]) {
+			return false
 		}
 	}
-	return n
-}
-
-func (m *MsgWithdrawResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if this.Administrator != that1.Administrator {
+		return false
 	}
-	var l int
-	_ = l
-	return n
+	return true
 }
+func (this *MsgWithdrawFromEscrowRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *MsgTransferRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	that1, ok := that.(*MsgWithdrawFromEscrowRequest)
+	if !ok {
+		that2, ok := that.(MsgWithdrawFromEscrowRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	var l int
-	_ = l
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.Administrator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	l = len(m.FromAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if this.Denom != that1.Denom {
+		return false
 	}
-	l = len(m.ToAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if this.Ledger != that1.Ledger {
+		return false
 	}
-	return n
-}
-
-func (m *MsgTransferResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(this.Amount) != len(that1.Amount) {
+		return false
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *MsgIbcTransferRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	for i := range this.Amount {
+		if !this.Amount[i].Equal(&that1.Amount[i]) {
+			return false
+		}
 	}
-	var l int
-	_ = l
-	l = m.Transfer.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.Administrator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if this.ToAddress != that1.ToAddress {
+		return false
 	}
-	return n
-}
-
-func (m *MsgIbcTransferResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if this.Administrator != that1.Administrator {
+		return false
 	}
-	var l int
-	_ = l
-	return n
+	return true
 }
+func (this *MsgScheduleBurnRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *MsgSetDenomMetadataRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	that1, ok := that.(*MsgScheduleBurnRequest)
+	if !ok {
+		that2, ok := that.(MsgScheduleBurnRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	var l int
-	_ = l
-	l = m.Metadata.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.Administrator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return n
-}
-
-func (m *MsgSetDenomMetadataResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if !this.Amount.Equal(&that1.Amount) {
+		return false
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *MsgAddFinalizeActivateMarkerRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if this.BurnHeight != that1.BurnHeight {
+		return false
 	}
-	var l int
-	_ = l
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.Manager)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if this.CancelWindow != that1.CancelWindow {
+		return false
 	}
-	l = len(m.FromAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if this.Administrator != that1.Administrator {
+		return false
 	}
-	if m.MarkerType != 0 {
-		n += 1 + sovTx(uint64(m.MarkerType))
+	return true
+}
+func (this *MsgCancelScheduledBurnRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	if len(m.AccessList) > 0 {
-		for _, e := range m.AccessList {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+
+	that1, ok := that.(*MsgCancelScheduledBurnRequest)
+	if !ok {
+		that2, ok := that.(MsgCancelScheduledBurnRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
-	if m.SupplyFixed {
-		n += 2
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.AllowGovernanceControl {
-		n += 2
+	if this.BurnId != that1.BurnId {
+		return false
 	}
-	if m.AllowForcedTransfer {
-		n += 2
+	if this.Administrator != that1.Administrator {
+		return false
 	}
-	if len(m.RequiredAttributes) > 0 {
-		for _, s := range m.RequiredAttributes {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
+	return true
+}
+func (this *MsgExchangeRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgExchangeRequest)
+	if !ok {
+		that2, ok := that.(MsgExchangeRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
-	if m.UsdCents != 0 {
-		n += 1 + sovTx(uint64(m.UsdCents))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.Volume != 0 {
-		n += 1 + sovTx(uint64(m.Volume))
+	if this.PartyA != that1.PartyA {
+		return false
 	}
-	if m.UsdMills != 0 {
-		n += 1 + sovTx(uint64(m.UsdMills))
+	if !this.AmountA.Equal(&that1.AmountA) {
+		return false
 	}
-	return n
-}
-
-func (m *MsgAddFinalizeActivateMarkerResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if this.PartyB != that1.PartyB {
+		return false
 	}
-	var l int
-	_ = l
-	return n
+	if !this.AmountB.Equal(&that1.AmountB) {
+		return false
+	}
+	return true
 }
+func (this *MsgSetAdministratorProposalRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *MsgSupplyIncreaseProposalRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	that1, ok := that.(*MsgSetAdministratorProposalRequest)
+	if !ok {
+		that2, ok := that.(MsgSetAdministratorProposalRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	var l int
-	_ = l
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.TargetAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if this.Denom != that1.Denom {
+		return false
 	}
-	return n
-}
-
-func (m *MsgSupplyIncreaseProposalResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(this.Access) != len(that1.Access) {
+		return false
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *MsgSupplyDecreaseProposalRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	for i := range this.Access {
+		if !this.Access[i].Equal(&that1.Access[i]) {
+			return false
+		}
 	}
-	var l int
-	_ = l
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if this.Authority != that1.Authority {
+		return false
 	}
-	return n
+	return true
 }
-
-func (m *MsgSupplyDecreaseProposalResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (this *MsgRemoveAdministratorProposalRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	var l int
-	_ = l
-	return n
-}
 
-func (m *MsgUpdateRequiredAttributesRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	that1, ok := that.(*MsgRemoveAdministratorProposalRequest)
+	if !ok {
+		that2, ok := that.(MsgRemoveAdministratorProposalRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if len(m.RemoveRequiredAttributes) > 0 {
-		for _, s := range m.RemoveRequiredAttributes {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
-		}
+	if this.Denom != that1.Denom {
+		return false
 	}
-	if len(m.AddRequiredAttributes) > 0 {
-		for _, s := range m.AddRequiredAttributes {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
+	if len(this.RemovedAddress) != len(that1.RemovedAddress) {
+		return false
+	}
+	for i := range this.RemovedAddress {
+		if this.RemovedAddress[i] != that1.RemovedAddress[i] {
+			return false
 		}
 	}
-	l = len(m.TransferAuthority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if this.Authority != that1.Authority {
+		return false
 	}
-	return n
+	return true
 }
-
-func (m *MsgUpdateRequiredAttributesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (this *MsgChangeStatusProposalRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	var l int
-	_ = l
-	return n
-}
 
-func (m *MsgUpdateForcedTransferRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	that1, ok := that.(*MsgChangeStatusProposalRequest)
+	if !ok {
+		that2, ok := that.(MsgChangeStatusProposalRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.AllowForcedTransfer {
-		n += 2
+	if this.Denom != that1.Denom {
+		return false
 	}
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if this.NewStatus != that1.NewStatus {
+		return false
 	}
-	return n
-}
-
-func (m *MsgUpdateForcedTransferResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if this.Authority != that1.Authority {
+		return false
 	}
-	var l int
-	_ = l
-	return n
+	return true
 }
-
-func (m *MsgSetAccountDataRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Value)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (this *MsgWithdrawEscrowProposalRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return n
-}
 
-func (m *MsgSetAccountDataResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	that1, ok := that.(*MsgWithdrawEscrowProposalRequest)
+	if !ok {
+		that2, ok := that.(MsgWithdrawEscrowProposalRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *MsgUpdateSendDenyListRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if this.Denom != that1.Denom {
+		return false
 	}
-	if len(m.RemoveDeniedAddresses) > 0 {
-		for _, s := range m.RemoveDeniedAddresses {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
-		}
+	if len(this.Amount) != len(that1.Amount) {
+		return false
 	}
-	if len(m.AddDeniedAddresses) > 0 {
-		for _, s := range m.AddDeniedAddresses {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
+	for i := range this.Amount {
+		if !this.Amount[i].Equal(&that1.Amount[i]) {
+			return false
 		}
 	}
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if this.TargetAddress != that1.TargetAddress {
+		return false
 	}
-	return n
+	if this.Authority != that1.Authority {
+		return false
+	}
+	return true
 }
-
-func (m *MsgUpdateSendDenyListResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (this *MsgChangeMarkerTypeProposalRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	var l int
-	_ = l
-	return n
-}
 
-func (m *MsgAddNetAssetValuesRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	that1, ok := that.(*MsgChangeMarkerTypeProposalRequest)
+	if !ok {
+		that2, ok := that.(MsgChangeMarkerTypeProposalRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	l = len(m.Administrator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if this.Denom != that1.Denom {
+		return false
 	}
-	if len(m.NetAssetValues) > 0 {
-		for _, e := range m.NetAssetValues {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	if this.NewType != that1.NewType {
+		return false
 	}
-	return n
+	if this.Authority != that1.Authority {
+		return false
+	}
+	return true
 }
 
-func (m *MsgAddNetAssetValuesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// Finalize
+	Finalize(ctx context.Context, in *MsgFinalizeRequest, opts ...grpc.CallOption) (*MsgFinalizeResponse, error)
+	// Activate
+	Activate(ctx context.Context, in *MsgActivateRequest, opts ...grpc.CallOption) (*MsgActivateResponse, error)
+	// Cancel
+	Cancel(ctx context.Context, in *MsgCancelRequest, opts ...grpc.CallOption) (*MsgCancelResponse, error)
+	// Delete
+	Delete(ctx context.Context, in *MsgDeleteRequest, opts ...grpc.CallOption) (*MsgDeleteResponse, error)
+	// Mint
+	Mint(ctx context.Context, in *MsgMintRequest, opts ...grpc.CallOption) (*MsgMintResponse, error)
+	// Burn
+	Burn(ctx context.Context, in *MsgBurnRequest, opts ...grpc.CallOption) (*MsgBurnResponse, error)
+	// AddAccess
+	AddAccess(ctx context.Context, in *MsgAddAccessRequest, opts ...grpc.CallOption) (*MsgAddAccessResponse, error)
+	// DeleteAccess
+	DeleteAccess(ctx context.Context, in *MsgDeleteAccessRequest, opts ...grpc.CallOption) (*MsgDeleteAccessResponse, error)
+	// Withdraw
+	Withdraw(ctx context.Context, in *MsgWithdrawRequest, opts ...grpc.CallOption) (*MsgWithdrawResponse, error)
+	// AddMarker
+	AddMarker(ctx context.Context, in *MsgAddMarkerRequest, opts ...grpc.CallOption) (*MsgAddMarkerResponse, error)
+	// Transfer marker denominated coin between accounts
+	Transfer(ctx context.Context, in *MsgTransferRequest, opts ...grpc.CallOption) (*MsgTransferResponse, error)
+	// Transfer over ibc any marker(including restricted markers) between ibc accounts.
+	// The relayer is still needed to accomplish ibc middleware relays.
+	IbcTransfer(ctx context.Context, in *MsgIbcTransferRequest, opts ...grpc.CallOption) (*MsgIbcTransferResponse, error)
+	// Allows Denom Metadata (see bank module) to be set for the Marker's Denom
+	SetDenomMetadata(ctx context.Context, in *MsgSetDenomMetadataRequest, opts ...grpc.CallOption) (*MsgSetDenomMetadataResponse, error)
+	// GrantAllowance grants fee allowance to the grantee on the granter's
+	// account with the provided expiration time.
+	GrantAllowance(ctx context.Context, in *MsgGrantAllowanceRequest, opts ...grpc.CallOption) (*MsgGrantAllowanceResponse, error)
+	// AddFinalizeActivateMarker
+	AddFinalizeActivateMarker(ctx context.Context, in *MsgAddFinalizeActivateMarkerRequest, opts ...grpc.CallOption) (*MsgAddFinalizeActivateMarkerResponse, error)
+	// SupplyIncreaseProposal can only be called via gov proposal
+	SupplyIncreaseProposal(ctx context.Context, in *MsgSupplyIncreaseProposalRequest, opts ...grpc.CallOption) (*MsgSupplyIncreaseProposalResponse, error)
+	// SupplyDecreaseProposal can only be called via gov proposal
+	SupplyDecreaseProposal(ctx context.Context, in *MsgSupplyDecreaseProposalRequest, opts ...grpc.CallOption) (*MsgSupplyDecreaseProposalResponse, error)
+	// UpdateRequiredAttributes will only succeed if signer has transfer authority
+	UpdateRequiredAttributes(ctx context.Context, in *MsgUpdateRequiredAttributesRequest, opts ...grpc.CallOption) (*MsgUpdateRequiredAttributesResponse, error)
+	// UpdateForcedTransfer updates the allow_forced_transfer field of a marker via governance proposal.
+	UpdateForcedTransfer(ctx context.Context, in *MsgUpdateForcedTransferRequest, opts ...grpc.CallOption) (*MsgUpdateForcedTransferResponse, error)
+	// SetAccountData sets the accountdata for a denom. Signer must have deposit authority.
+	SetAccountData(ctx context.Context, in *MsgSetAccountDataRequest, opts ...grpc.CallOption) (*MsgSetAccountDataResponse, error)
+	// UpdateSendDenyList will only succeed if signer has admin authority
+	UpdateSendDenyList(ctx context.Context, in *MsgUpdateSendDenyListRequest, opts ...grpc.CallOption) (*MsgUpdateSendDenyListResponse, error)
+	// UpdateDepositAllowList adds and removes accounts on the list of accounts allowed to deposit funds into a marker.
+	// Signer must have deposit authority.
+	UpdateDepositAllowList(ctx context.Context, in *MsgUpdateDepositAllowListRequest, opts ...grpc.CallOption) (*MsgUpdateDepositAllowListResponse, error)
+	// SetFeeSponsorship creates or updates the fee sponsorship of a marker. Signer must have admin authority.
+	SetFeeSponsorship(ctx context.Context, in *MsgSetFeeSponsorshipRequest, opts ...grpc.CallOption) (*MsgSetFeeSponsorshipResponse, error)
+	// RemoveFeeSponsorship removes the fee sponsorship of a marker. Signer must have admin authority.
+	RemoveFeeSponsorship(ctx context.Context, in *MsgRemoveFeeSponsorshipRequest, opts ...grpc.CallOption) (*MsgRemoveFeeSponsorshipResponse, error)
+	// ClaimFeeSponsorship grants the signer a fee allowance from a marker's account if they meet the marker's
+	// fee sponsorship criteria.
+	ClaimFeeSponsorship(ctx context.Context, in *MsgClaimFeeSponsorshipRequest, opts ...grpc.CallOption) (*MsgClaimFeeSponsorshipResponse, error)
+	// ReleaseFeeSponsorshipHold releases the funds put on hold when the holder claimed a fee grant from a marker's
+	// fee sponsorship. The fee grant must have been used up or have expired.
+	ReleaseFeeSponsorshipHold(ctx context.Context, in *MsgReleaseFeeSponsorshipHoldRequest, opts ...grpc.CallOption) (*MsgReleaseFeeSponsorshipHoldResponse, error)
+	// SetBridgeInfo creates or updates the bridge info of a wrapped-asset marker. Signer must have admin authority.
+	SetBridgeInfo(ctx context.Context, in *MsgSetBridgeInfoRequest, opts ...grpc.CallOption) (*MsgSetBridgeInfoResponse, error)
+	// AttestedMint mints coin of a marker that has bridge info and records a reference to the proof of its backing.
+	// Signer must have mint authority.
+	AttestedMint(ctx context.Context, in *MsgAttestedMintRequest, opts ...grpc.CallOption) (*MsgAttestedMintResponse, error)
+	// SetBasketInfo sets the components that back a basket marker's coin. Signer must have admin authority.
+	SetBasketInfo(ctx context.Context, in *MsgSetBasketInfoRequest, opts ...grpc.CallOption) (*MsgSetBasketInfoResponse, error)
+	// SetIBCMemoActionConfig sets the actions that ICS-20 memos are allowed to request when a marker's coin is received
+	// over IBC. Signer must have admin authority.
+	SetIBCMemoActionConfig(ctx context.Context, in *MsgSetIBCMemoActionConfigRequest, opts ...grpc.CallOption) (*MsgSetIBCMemoActionConfigResponse, error)
+	// BasketDeposit mints basket coin for the signer in exchange for a deposit of its components.
+	BasketDeposit(ctx context.Context, in *MsgBasketDepositRequest, opts ...grpc.CallOption) (*MsgBasketDepositResponse, error)
+	// BasketWithdraw burns the signer's basket coin in exchange for a withdrawal of its components.
+	BasketWithdraw(ctx context.Context, in *MsgBasketWithdrawRequest, opts ...grpc.CallOption) (*MsgBasketWithdrawResponse, error)
+	// AddNetAssetValues set the net asset value for a marker
+	AddNetAssetValues(ctx context.Context, in *MsgAddNetAssetValuesRequest, opts ...grpc.CallOption) (*MsgAddNetAssetValuesResponse, error)
+	// BindMarkerName binds a new name under a name owned by a marker. Signer must have admin authority.
+	BindMarkerName(ctx context.Context, in *MsgBindMarkerNameRequest, opts ...grpc.CallOption) (*MsgBindMarkerNameResponse, error)
+	// DeleteMarkerName deletes a name owned by a marker. Signer must have admin authority.
+	DeleteMarkerName(ctx context.Context, in *MsgDeleteMarkerNameRequest, opts ...grpc.CallOption) (*MsgDeleteMarkerNameResponse, error)
+	// DelegateEscrow stakes some of a marker's escrowed funds with a validator. Signer must have delegate authority.
+	DelegateEscrow(ctx context.Context, in *MsgDelegateEscrowRequest, opts ...grpc.CallOption) (*MsgDelegateEscrowResponse, error)
+	// UndelegateEscrow unstakes some of a marker's funds from a validator. Signer must have delegate authority.
+	UndelegateEscrow(ctx context.Context, in *MsgUndelegateEscrowRequest, opts ...grpc.CallOption) (*MsgUndelegateEscrowResponse, error)
+	// CollectEscrowRewards withdraws a marker's staking rewards from a validator into the marker's escrow.
+	// Signer must have delegate authority.
+	CollectEscrowRewards(ctx context.Context, in *MsgCollectEscrowRewardsRequest, opts ...grpc.CallOption) (*MsgCollectEscrowRewardsResponse, error)
+	// SetMintAllowance sets how much of a marker's coin an account is allowed to mint without having mint access.
+	// Signer must have mint authority.
+	SetMintAllowance(ctx context.Context, in *MsgSetMintAllowanceRequest, opts ...grpc.CallOption) (*MsgSetMintAllowanceResponse, error)
+	// MintFromAllowance mints coin of a marker, reducing the signer's mint allowance by the amount minted.
+	MintFromAllowance(ctx context.Context, in *MsgMintFromAllowanceRequest, opts ...grpc.CallOption) (*MsgMintFromAllowanceResponse, error)
+	// ScheduleDistribution schedules a pro-rata payout of funds to the holders of a marker's coin.
+	// Signer must have admin authority and provides the funds being distributed.
+	ScheduleDistribution(ctx context.Context, in *MsgScheduleDistributionRequest, opts ...grpc.CallOption) (*MsgScheduleDistributionResponse, error)
+	// CancelDistribution cancels a distribution that has not started yet, refunding its funds.
+	// Signer must have admin authority.
+	CancelDistribution(ctx context.Context, in *MsgCancelDistributionRequest, opts ...grpc.CallOption) (*MsgCancelDistributionResponse, error)
+	// ClaimDistribution sends the signer the funds recorded as a claim for them in a distribution.
+	ClaimDistribution(ctx context.Context, in *MsgClaimDistributionRequest, opts ...grpc.CallOption) (*MsgClaimDistributionResponse, error)
+	// AllocateEscrow earmarks some of a marker's escrowed funds for the purpose of one of its escrow ledgers.
+	// Signer must have admin authority.
+	AllocateEscrow(ctx context.Context, in *MsgAllocateEscrowRequest, opts ...grpc.CallOption) (*MsgAllocateEscrowResponse, error)
+	// ReleaseEscrow returns funds earmarked in one of a marker's escrow ledgers to the marker's unearmarked escrow.
+	// Signer must be the governance module account.
+	ReleaseEscrow(ctx context.Context, in *MsgReleaseEscrowRequest, opts ...grpc.CallOption) (*MsgReleaseEscrowResponse, error)
+	// SetEscrowWithdrawLimit sets how much an account is allowed to withdraw from one of a marker's escrow ledgers.
+	// Signer must be the governance module account.
+	SetEscrowWithdrawLimit(ctx context.Context, in *MsgSetEscrowWithdrawLimitRequest, opts ...grpc.CallOption) (*MsgSetEscrowWithdrawLimitResponse, error)
+	// WithdrawFromEscrow withdraws funds from one of a marker's escrow ledgers, reducing the signer's withdraw limit.
+	// Signer must have withdraw authority.
+	WithdrawFromEscrow(ctx context.Context, in *MsgWithdrawFromEscrowRequest, opts ...grpc.CallOption) (*MsgWithdrawFromEscrowResponse, error)
+	// ScheduleBurn schedules a burn of some of a marker's escrowed coin at the end of a future block.
+	// Signer must have burn authority.
+	ScheduleBurn(ctx context.Context, in *MsgScheduleBurnRequest, opts ...grpc.CallOption) (*MsgScheduleBurnResponse, error)
+	// CancelScheduledBurn cancels a scheduled burn whose cancel window is still open.
+	// Signer must have burn authority.
+	CancelScheduledBurn(ctx context.Context, in *MsgCancelScheduledBurnRequest, opts ...grpc.CallOption) (*MsgCancelScheduledBurnResponse, error)
+	// Exchange atomically swaps marker coins between two accounts.
+	// Both accounts must sign, and both sends are subject to all the usual send restrictions.
+	Exchange(ctx context.Context, in *MsgExchangeRequest, opts ...grpc.CallOption) (*MsgExchangeResponse, error)
+	// SetAdministratorProposal sets administrators with specific access on the marker
+	SetAdministratorProposal(ctx context.Context, in *MsgSetAdministratorProposalRequest, opts ...grpc.CallOption) (*MsgSetAdministratorProposalResponse, error)
+	// RemoveAdministratorProposal removes administrators with specific access on the marker
+	RemoveAdministratorProposal(ctx context.Context, in *MsgRemoveAdministratorProposalRequest, opts ...grpc.CallOption) (*MsgRemoveAdministratorProposalResponse, error)
+	// ChangeStatusProposal is a governance proposal change marker status
+	ChangeStatusProposal(ctx context.Context, in *MsgChangeStatusProposalRequest, opts ...grpc.CallOption) (*MsgChangeStatusProposalResponse, error)
+	// WithdrawEscrowProposal is a governance proposal to withdraw escrow coins from a marker
+	WithdrawEscrowProposal(ctx context.Context, in *MsgWithdrawEscrowProposalRequest, opts ...grpc.CallOption) (*MsgWithdrawEscrowProposalResponse, error)
+	// SetDenomMetadataProposal is a governance proposal to set marker metadata
+	SetDenomMetadataProposal(ctx context.Context, in *MsgSetDenomMetadataProposalRequest, opts ...grpc.CallOption) (*MsgSetDenomMetadataProposalResponse, error)
+	// UpdateParams is a governance proposal endpoint for updating the marker module's params.
+	UpdateParams(ctx context.Context, in *MsgUpdateParamsRequest, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// UpdateSendRestrictionBypasses is a governance proposal endpoint for adding, updating, and removing
+	// entries in the send restriction bypass registry.
+	UpdateSendRestrictionBypasses(ctx context.Context, in *MsgUpdateSendRestrictionBypassesRequest, opts ...grpc.CallOption) (*MsgUpdateSendRestrictionBypassesResponse, error)
+	// ChangeMarkerTypeProposal is a governance proposal endpoint for converting a marker between the COIN and
+	// RESTRICTED_COIN types.
+	ChangeMarkerTypeProposal(ctx context.Context, in *MsgChangeMarkerTypeProposalRequest, opts ...grpc.CallOption) (*MsgChangeMarkerTypeProposalResponse, error)
 }
 
-func (m *MsgSetAdministratorProposalRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.Access) > 0 {
-		for _, e := range m.Access {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
-	}
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+type msgClient struct {
+	cc grpc1.ClientConn
 }
 
-func (m *MsgSetAdministratorProposalResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
 }
 
-func (m *MsgRemoveAdministratorProposalRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.RemovedAddress) > 0 {
-		for _, s := range m.RemovedAddress {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
-		}
-	}
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (c *msgClient) Finalize(ctx context.Context, in *MsgFinalizeRequest, opts ...grpc.CallOption) (*MsgFinalizeResponse, error) {
+	out := new(MsgFinalizeResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/Finalize", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *MsgRemoveAdministratorProposalResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) Activate(ctx context.Context, in *MsgActivateRequest, opts ...grpc.CallOption) (*MsgActivateResponse, error) {
+	out := new(MsgActivateResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/Activate", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *MsgChangeStatusProposalRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) Cancel(ctx context.Context, in *MsgCancelRequest, opts ...grpc.CallOption) (*MsgCancelResponse, error) {
+	out := new(MsgCancelResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/Cancel", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) Delete(ctx context.Context, in *MsgDeleteRequest, opts ...grpc.CallOption) (*MsgDeleteResponse, error) {
+	out := new(MsgDeleteResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/Delete", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.NewStatus != 0 {
-		n += 1 + sovTx(uint64(m.NewStatus))
+	return out, nil
+}
+
+func (c *msgClient) Mint(ctx context.Context, in *MsgMintRequest, opts ...grpc.CallOption) (*MsgMintResponse, error) {
+	out := new(MsgMintResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/Mint", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) Burn(ctx context.Context, in *MsgBurnRequest, opts ...grpc.CallOption) (*MsgBurnResponse, error) {
+	out := new(MsgBurnResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/Burn", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *MsgChangeStatusProposalResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) AddAccess(ctx context.Context, in *MsgAddAccessRequest, opts ...grpc.CallOption) (*MsgAddAccessResponse, error) {
+	out := new(MsgAddAccessResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/AddAccess", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *MsgWithdrawEscrowProposalRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) DeleteAccess(ctx context.Context, in *MsgDeleteAccessRequest, opts ...grpc.CallOption) (*MsgDeleteAccessResponse, error) {
+	out := new(MsgDeleteAccessResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/DeleteAccess", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
+	return out, nil
+}
+
+func (c *msgClient) Withdraw(ctx context.Context, in *MsgWithdrawRequest, opts ...grpc.CallOption) (*MsgWithdrawResponse, error) {
+	out := new(MsgWithdrawResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/Withdraw", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AddMarker(ctx context.Context, in *MsgAddMarkerRequest, opts ...grpc.CallOption) (*MsgAddMarkerResponse, error) {
+	out := new(MsgAddMarkerResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/AddMarker", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) Transfer(ctx context.Context, in *MsgTransferRequest, opts ...grpc.CallOption) (*MsgTransferResponse, error) {
+	out := new(MsgTransferResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/Transfer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) IbcTransfer(ctx context.Context, in *MsgIbcTransferRequest, opts ...grpc.CallOption) (*MsgIbcTransferResponse, error) {
+	out := new(MsgIbcTransferResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/IbcTransfer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetDenomMetadata(ctx context.Context, in *MsgSetDenomMetadataRequest, opts ...grpc.CallOption) (*MsgSetDenomMetadataResponse, error) {
+	out := new(MsgSetDenomMetadataResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/SetDenomMetadata", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) GrantAllowance(ctx context.Context, in *MsgGrantAllowanceRequest, opts ...grpc.CallOption) (*MsgGrantAllowanceResponse, error) {
+	out := new(MsgGrantAllowanceResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/GrantAllowance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AddFinalizeActivateMarker(ctx context.Context, in *MsgAddFinalizeActivateMarkerRequest, opts ...grpc.CallOption) (*MsgAddFinalizeActivateMarkerResponse, error) {
+	out := new(MsgAddFinalizeActivateMarkerResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/AddFinalizeActivateMarker", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SupplyIncreaseProposal(ctx context.Context, in *MsgSupplyIncreaseProposalRequest, opts ...grpc.CallOption) (*MsgSupplyIncreaseProposalResponse, error) {
+	out := new(MsgSupplyIncreaseProposalResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/SupplyIncreaseProposal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SupplyDecreaseProposal(ctx context.Context, in *MsgSupplyDecreaseProposalRequest, opts ...grpc.CallOption) (*MsgSupplyDecreaseProposalResponse, error) {
+	out := new(MsgSupplyDecreaseProposalResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/SupplyDecreaseProposal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateRequiredAttributes(ctx context.Context, in *MsgUpdateRequiredAttributesRequest, opts ...grpc.CallOption) (*MsgUpdateRequiredAttributesResponse, error) {
+	out := new(MsgUpdateRequiredAttributesResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/UpdateRequiredAttributes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateForcedTransfer(ctx context.Context, in *MsgUpdateForcedTransferRequest, opts ...grpc.CallOption) (*MsgUpdateForcedTransferResponse, error) {
+	out := new(MsgUpdateForcedTransferResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/UpdateForcedTransfer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetAccountData(ctx context.Context, in *MsgSetAccountDataRequest, opts ...grpc.CallOption) (*MsgSetAccountDataResponse, error) {
+	out := new(MsgSetAccountDataResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/SetAccountData", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateSendDenyList(ctx context.Context, in *MsgUpdateSendDenyListRequest, opts ...grpc.CallOption) (*MsgUpdateSendDenyListResponse, error) {
+	out := new(MsgUpdateSendDenyListResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/UpdateSendDenyList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateDepositAllowList(ctx context.Context, in *MsgUpdateDepositAllowListRequest, opts ...grpc.CallOption) (*MsgUpdateDepositAllowListResponse, error) {
+	out := new(MsgUpdateDepositAllowListResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/UpdateDepositAllowList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetFeeSponsorship(ctx context.Context, in *MsgSetFeeSponsorshipRequest, opts ...grpc.CallOption) (*MsgSetFeeSponsorshipResponse, error) {
+	out := new(MsgSetFeeSponsorshipResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/SetFeeSponsorship", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveFeeSponsorship(ctx context.Context, in *MsgRemoveFeeSponsorshipRequest, opts ...grpc.CallOption) (*MsgRemoveFeeSponsorshipResponse, error) {
+	out := new(MsgRemoveFeeSponsorshipResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/RemoveFeeSponsorship", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ClaimFeeSponsorship(ctx context.Context, in *MsgClaimFeeSponsorshipRequest, opts ...grpc.CallOption) (*MsgClaimFeeSponsorshipResponse, error) {
+	out := new(MsgClaimFeeSponsorshipResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/ClaimFeeSponsorship", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ReleaseFeeSponsorshipHold(ctx context.Context, in *MsgReleaseFeeSponsorshipHoldRequest, opts ...grpc.CallOption) (*MsgReleaseFeeSponsorshipHoldResponse, error) {
+	out := new(MsgReleaseFeeSponsorshipHoldResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/ReleaseFeeSponsorshipHold", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetBridgeInfo(ctx context.Context, in *MsgSetBridgeInfoRequest, opts ...grpc.CallOption) (*MsgSetBridgeInfoResponse, error) {
+	out := new(MsgSetBridgeInfoResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/SetBridgeInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AttestedMint(ctx context.Context, in *MsgAttestedMintRequest, opts ...grpc.CallOption) (*MsgAttestedMintResponse, error) {
+	out := new(MsgAttestedMintResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/AttestedMint", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetBasketInfo(ctx context.Context, in *MsgSetBasketInfoRequest, opts ...grpc.CallOption) (*MsgSetBasketInfoResponse, error) {
+	out := new(MsgSetBasketInfoResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/SetBasketInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetIBCMemoActionConfig(ctx context.Context, in *MsgSetIBCMemoActionConfigRequest, opts ...grpc.CallOption) (*MsgSetIBCMemoActionConfigResponse, error) {
+	out := new(MsgSetIBCMemoActionConfigResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/SetIBCMemoActionConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) BasketDeposit(ctx context.Context, in *MsgBasketDepositRequest, opts ...grpc.CallOption) (*MsgBasketDepositResponse, error) {
+	out := new(MsgBasketDepositResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/BasketDeposit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) BasketWithdraw(ctx context.Context, in *MsgBasketWithdrawRequest, opts ...grpc.CallOption) (*MsgBasketWithdrawResponse, error) {
+	out := new(MsgBasketWithdrawResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/BasketWithdraw", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AddNetAssetValues(ctx context.Context, in *MsgAddNetAssetValuesRequest, opts ...grpc.CallOption) (*MsgAddNetAssetValuesResponse, error) {
+	out := new(MsgAddNetAssetValuesResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/AddNetAssetValues", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) BindMarkerName(ctx context.Context, in *MsgBindMarkerNameRequest, opts ...grpc.CallOption) (*MsgBindMarkerNameResponse, error) {
+	out := new(MsgBindMarkerNameResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/BindMarkerName", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) DeleteMarkerName(ctx context.Context, in *MsgDeleteMarkerNameRequest, opts ...grpc.CallOption) (*MsgDeleteMarkerNameResponse, error) {
+	out := new(MsgDeleteMarkerNameResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/DeleteMarkerName", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) DelegateEscrow(ctx context.Context, in *MsgDelegateEscrowRequest, opts ...grpc.CallOption) (*MsgDelegateEscrowResponse, error) {
+	out := new(MsgDelegateEscrowResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/DelegateEscrow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UndelegateEscrow(ctx context.Context, in *MsgUndelegateEscrowRequest, opts ...grpc.CallOption) (*MsgUndelegateEscrowResponse, error) {
+	out := new(MsgUndelegateEscrowResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/UndelegateEscrow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CollectEscrowRewards(ctx context.Context, in *MsgCollectEscrowRewardsRequest, opts ...grpc.CallOption) (*MsgCollectEscrowRewardsResponse, error) {
+	out := new(MsgCollectEscrowRewardsResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/CollectEscrowRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetMintAllowance(ctx context.Context, in *MsgSetMintAllowanceRequest, opts ...grpc.CallOption) (*MsgSetMintAllowanceResponse, error) {
+	out := new(MsgSetMintAllowanceResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/SetMintAllowance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) MintFromAllowance(ctx context.Context, in *MsgMintFromAllowanceRequest, opts ...grpc.CallOption) (*MsgMintFromAllowanceResponse, error) {
+	out := new(MsgMintFromAllowanceResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/MintFromAllowance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ScheduleDistribution(ctx context.Context, in *MsgScheduleDistributionRequest, opts ...grpc.CallOption) (*MsgScheduleDistributionResponse, error) {
+	out := new(MsgScheduleDistributionResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/ScheduleDistribution", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelDistribution(ctx context.Context, in *MsgCancelDistributionRequest, opts ...grpc.CallOption) (*MsgCancelDistributionResponse, error) {
+	out := new(MsgCancelDistributionResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/CancelDistribution", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ClaimDistribution(ctx context.Context, in *MsgClaimDistributionRequest, opts ...grpc.CallOption) (*MsgClaimDistributionResponse, error) {
+	out := new(MsgClaimDistributionResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/ClaimDistribution", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AllocateEscrow(ctx context.Context, in *MsgAllocateEscrowRequest, opts ...grpc.CallOption) (*MsgAllocateEscrowResponse, error) {
+	out := new(MsgAllocateEscrowResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/AllocateEscrow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ReleaseEscrow(ctx context.Context, in *MsgReleaseEscrowRequest, opts ...grpc.CallOption) (*MsgReleaseEscrowResponse, error) {
+	out := new(MsgReleaseEscrowResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/ReleaseEscrow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetEscrowWithdrawLimit(ctx context.Context, in *MsgSetEscrowWithdrawLimitRequest, opts ...grpc.CallOption) (*MsgSetEscrowWithdrawLimitResponse, error) {
+	out := new(MsgSetEscrowWithdrawLimitResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/SetEscrowWithdrawLimit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) WithdrawFromEscrow(ctx context.Context, in *MsgWithdrawFromEscrowRequest, opts ...grpc.CallOption) (*MsgWithdrawFromEscrowResponse, error) {
+	out := new(MsgWithdrawFromEscrowResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/WithdrawFromEscrow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ScheduleBurn(ctx context.Context, in *MsgScheduleBurnRequest, opts ...grpc.CallOption) (*MsgScheduleBurnResponse, error) {
+	out := new(MsgScheduleBurnResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/ScheduleBurn", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelScheduledBurn(ctx context.Context, in *MsgCancelScheduledBurnRequest, opts ...grpc.CallOption) (*MsgCancelScheduledBurnResponse, error) {
+	out := new(MsgCancelScheduledBurnResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/CancelScheduledBurn", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) Exchange(ctx context.Context, in *MsgExchangeRequest, opts ...grpc.CallOption) (*MsgExchangeResponse, error) {
+	out := new(MsgExchangeResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/Exchange", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetAdministratorProposal(ctx context.Context, in *MsgSetAdministratorProposalRequest, opts ...grpc.CallOption) (*MsgSetAdministratorProposalResponse, error) {
+	out := new(MsgSetAdministratorProposalResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/SetAdministratorProposal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveAdministratorProposal(ctx context.Context, in *MsgRemoveAdministratorProposalRequest, opts ...grpc.CallOption) (*MsgRemoveAdministratorProposalResponse, error) {
+	out := new(MsgRemoveAdministratorProposalResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/RemoveAdministratorProposal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ChangeStatusProposal(ctx context.Context, in *MsgChangeStatusProposalRequest, opts ...grpc.CallOption) (*MsgChangeStatusProposalResponse, error) {
+	out := new(MsgChangeStatusProposalResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/ChangeStatusProposal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) WithdrawEscrowProposal(ctx context.Context, in *MsgWithdrawEscrowProposalRequest, opts ...grpc.CallOption) (*MsgWithdrawEscrowProposalResponse, error) {
+	out := new(MsgWithdrawEscrowProposalResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/WithdrawEscrowProposal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetDenomMetadataProposal(ctx context.Context, in *MsgSetDenomMetadataProposalRequest, opts ...grpc.CallOption) (*MsgSetDenomMetadataProposalResponse, error) {
+	out := new(MsgSetDenomMetadataProposalResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/SetDenomMetadataProposal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParamsRequest, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateSendRestrictionBypasses(ctx context.Context, in *MsgUpdateSendRestrictionBypassesRequest, opts ...grpc.CallOption) (*MsgUpdateSendRestrictionBypassesResponse, error) {
+	out := new(MsgUpdateSendRestrictionBypassesResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/UpdateSendRestrictionBypasses", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ChangeMarkerTypeProposal(ctx context.Context, in *MsgChangeMarkerTypeProposalRequest, opts ...grpc.CallOption) (*MsgChangeMarkerTypeProposalResponse, error) {
+	out := new(MsgChangeMarkerTypeProposalResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/ChangeMarkerTypeProposal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// Finalize
+	Finalize(context.Context, *MsgFinalizeRequest) (*MsgFinalizeResponse, error)
+	// Activate
+	Activate(context.Context, *MsgActivateRequest) (*MsgActivateResponse, error)
+	// Cancel
+	Cancel(context.Context, *MsgCancelRequest) (*MsgCancelResponse, error)
+	// Delete
+	Delete(context.Context, *MsgDeleteRequest) (*MsgDeleteResponse, error)
+	// Mint
+	Mint(context.Context, *MsgMintRequest) (*MsgMintResponse, error)
+	// Burn
+	Burn(context.Context, *MsgBurnRequest) (*MsgBurnResponse, error)
+	// AddAccess
+	AddAccess(context.Context, *MsgAddAccessRequest) (*MsgAddAccessResponse, error)
+	// DeleteAccess
+	DeleteAccess(context.Context, *MsgDeleteAccessRequest) (*MsgDeleteAccessResponse, error)
+	// Withdraw
+	Withdraw(context.Context, *MsgWithdrawRequest) (*MsgWithdrawResponse, error)
+	// AddMarker
+	AddMarker(context.Context, *MsgAddMarkerRequest) (*MsgAddMarkerResponse, error)
+	// Transfer marker denominated coin between accounts
+	Transfer(context.Context, *MsgTransferRequest) (*MsgTransferResponse, error)
+	// Transfer over ibc any marker(including restricted markers) between ibc accounts.
+	// The relayer is still needed to accomplish ibc middleware relays.
+	IbcTransfer(context.Context, *MsgIbcTransferRequest) (*MsgIbcTransferResponse, error)
+	// Allows Denom Metadata (see bank module) to be set for the Marker's Denom
+	SetDenomMetadata(context.Context, *MsgSetDenomMetadataRequest) (*MsgSetDenomMetadataResponse, error)
+	// GrantAllowance grants fee allowance to the grantee on the granter's
+	// account with the provided expiration time.
+	GrantAllowance(context.Context, *MsgGrantAllowanceRequest) (*MsgGrantAllowanceResponse, error)
+	// AddFinalizeActivateMarker
+	AddFinalizeActivateMarker(context.Context, *MsgAddFinalizeActivateMarkerRequest) (*MsgAddFinalizeActivateMarkerResponse, error)
+	// SupplyIncreaseProposal can only be called via gov proposal
+	SupplyIncreaseProposal(context.Context, *MsgSupplyIncreaseProposalRequest) (*MsgSupplyIncreaseProposalResponse, error)
+	// SupplyDecreaseProposal can only be called via gov proposal
+	SupplyDecreaseProposal(context.Context, *MsgSupplyDecreaseProposalRequest) (*MsgSupplyDecreaseProposalResponse, error)
+	// UpdateRequiredAttributes will only succeed if signer has transfer authority
+	UpdateRequiredAttributes(context.Context, *MsgUpdateRequiredAttributesRequest) (*MsgUpdateRequiredAttributesResponse, error)
+	// UpdateForcedTransfer updates the allow_forced_transfer field of a marker via governance proposal.
+	UpdateForcedTransfer(context.Context, *MsgUpdateForcedTransferRequest) (*MsgUpdateForcedTransferResponse, error)
+	// SetAccountData sets the accountdata for a denom. Signer must have deposit authority.
+	SetAccountData(context.Context, *MsgSetAccountDataRequest) (*MsgSetAccountDataResponse, error)
+	// UpdateSendDenyList will only succeed if signer has admin authority
+	UpdateSendDenyList(context.Context, *MsgUpdateSendDenyListRequest) (*MsgUpdateSendDenyListResponse, error)
+	// UpdateDepositAllowList adds and removes accounts on the list of accounts allowed to deposit funds into a marker.
+	// Signer must have deposit authority.
+	UpdateDepositAllowList(context.Context, *MsgUpdateDepositAllowListRequest) (*MsgUpdateDepositAllowListResponse, error)
+	// SetFeeSponsorship creates or updates the fee sponsorship of a marker. Signer must have admin authority.
+	SetFeeSponsorship(context.Context, *MsgSetFeeSponsorshipRequest) (*MsgSetFeeSponsorshipResponse, error)
+	// RemoveFeeSponsorship removes the fee sponsorship of a marker. Signer must have admin authority.
+	RemoveFeeSponsorship(context.Context, *MsgRemoveFeeSponsorshipRequest) (*MsgRemoveFeeSponsorshipResponse, error)
+	// ClaimFeeSponsorship grants the signer a fee allowance from a marker's account if they meet the marker's
+	// fee sponsorship criteria.
+	ClaimFeeSponsorship(context.Context, *MsgClaimFeeSponsorshipRequest) (*MsgClaimFeeSponsorshipResponse, error)
+	// ReleaseFeeSponsorshipHold releases the funds put on hold when the holder claimed a fee grant from a marker's
+	// fee sponsorship. The fee grant must have been used up or have expired.
+	ReleaseFeeSponsorshipHold(context.Context, *MsgReleaseFeeSponsorshipHoldRequest) (*MsgReleaseFeeSponsorshipHoldResponse, error)
+	// SetBridgeInfo creates or updates the bridge info of a wrapped-asset marker. Signer must have admin authority.
+	SetBridgeInfo(context.Context, *MsgSetBridgeInfoRequest) (*MsgSetBridgeInfoResponse, error)
+	// AttestedMint mints coin of a marker that has bridge info and records a reference to the proof of its backing.
+	// Signer must have mint authority.
+	AttestedMint(context.Context, *MsgAttestedMintRequest) (*MsgAttestedMintResponse, error)
+	// SetBasketInfo sets the components that back a basket marker's coin. Signer must have admin authority.
+	SetBasketInfo(context.Context, *MsgSetBasketInfoRequest) (*MsgSetBasketInfoResponse, error)
+	// SetIBCMemoActionConfig sets the actions that ICS-20 memos are allowed to request when a marker's coin is received
+	// over IBC. Signer must have admin authority.
+	SetIBCMemoActionConfig(context.Context, *MsgSetIBCMemoActionConfigRequest) (*MsgSetIBCMemoActionConfigResponse, error)
+	// BasketDeposit mints basket coin for the signer in exchange for a deposit of its components.
+	BasketDeposit(context.Context, *MsgBasketDepositRequest) (*MsgBasketDepositResponse, error)
+	// BasketWithdraw burns the signer's basket coin in exchange for a withdrawal of its components.
+	BasketWithdraw(context.Context, *MsgBasketWithdrawRequest) (*MsgBasketWithdrawResponse, error)
+	// AddNetAssetValues set the net asset value for a marker
+	AddNetAssetValues(context.Context, *MsgAddNetAssetValuesRequest) (*MsgAddNetAssetValuesResponse, error)
+	// BindMarkerName binds a new name under a name owned by a marker. Signer must have admin authority.
+	BindMarkerName(context.Context, *MsgBindMarkerNameRequest) (*MsgBindMarkerNameResponse, error)
+	// DeleteMarkerName deletes a name owned by a marker. Signer must have admin authority.
+	DeleteMarkerName(context.Context, *MsgDeleteMarkerNameRequest) (*MsgDeleteMarkerNameResponse, error)
+	// DelegateEscrow stakes some of a marker's escrowed funds with a validator. Signer must have delegate authority.
+	DelegateEscrow(context.Context, *MsgDelegateEscrowRequest) (*MsgDelegateEscrowResponse, error)
+	// UndelegateEscrow unstakes some of a marker's funds from a validator. Signer must have delegate authority.
+	UndelegateEscrow(context.Context, *MsgUndelegateEscrowRequest) (*MsgUndelegateEscrowResponse, error)
+	// CollectEscrowRewards withdraws a marker's staking rewards from a validator into the marker's escrow.
+	// Signer must have delegate authority.
+	CollectEscrowRewards(context.Context, *MsgCollectEscrowRewardsRequest) (*MsgCollectEscrowRewardsResponse, error)
+	// SetMintAllowance sets how much of a marker's coin an account is allowed to mint without having mint access.
+	// Signer must have mint authority.
+	SetMintAllowance(context.Context, *MsgSetMintAllowanceRequest) (*MsgSetMintAllowanceResponse, error)
+	// MintFromAllowance mints coin of a marker, reducing the signer's mint allowance by the amount minted.
+	MintFromAllowance(context.Context, *MsgMintFromAllowanceRequest) (*MsgMintFromAllowanceResponse, error)
+	// ScheduleDistribution schedules a pro-rata payout of funds to the holders of a marker's coin.
+	// Signer must have admin authority and provides the funds being distributed.
+	ScheduleDistribution(context.Context, *MsgScheduleDistributionRequest) (*MsgScheduleDistributionResponse, error)
+	// CancelDistribution cancels a distribution that has not started yet, refunding its funds.
+	// Signer must have admin authority.
+	CancelDistribution(context.Context, *MsgCancelDistributionRequest) (*MsgCancelDistributionResponse, error)
+	// ClaimDistribution sends the signer the funds recorded as a claim for them in a distribution.
+	ClaimDistribution(context.Context, *MsgClaimDistributionRequest) (*MsgClaimDistributionResponse, error)
+	// AllocateEscrow earmarks some of a marker's escrowed funds for the purpose of one of its escrow ledgers.
+	// Signer must have admin authority.
+	AllocateEscrow(context.Context, *MsgAllocateEscrowRequest) (*MsgAllocateEscrowResponse, error)
+	// ReleaseEscrow returns funds earmarked in one of a marker's escrow ledgers to the marker's unearmarked escrow.
+	// Signer must be the governance module account.
+	ReleaseEscrow(context.Context, *MsgReleaseEscrowRequest) (*MsgReleaseEscrowResponse, error)
+	// SetEscrowWithdrawLimit sets how much an account is allowed to withdraw from one of a marker's escrow ledgers.
+	// Signer must be the governance module account.
+	SetEscrowWithdrawLimit(context.Context, *MsgSetEscrowWithdrawLimitRequest) (*MsgSetEscrowWithdrawLimitResponse, error)
+	// WithdrawFromEscrow withdraws funds from one of a marker's escrow ledgers, reducing the signer's withdraw limit.
+	// Signer must have withdraw authority.
+	WithdrawFromEscrow(context.Context, *MsgWithdrawFromEscrowRequest) (*MsgWithdrawFromEscrowResponse, error)
+	// ScheduleBurn schedules a burn of some of a marker's escrowed coin at the end of a future block.
+	// Signer must have burn authority.
+	ScheduleBurn(context.Context, *MsgScheduleBurnRequest) (*MsgScheduleBurnResponse, error)
+	// CancelScheduledBurn cancels a scheduled burn whose cancel window is still open.
+	// Signer must have burn authority.
+	CancelScheduledBurn(context.Context, *MsgCancelScheduledBurnRequest) (*MsgCancelScheduledBurnResponse, error)
+	// Exchange atomically swaps marker coins between two accounts.
+	// Both accounts must sign, and both sends are subject to all the usual send restrictions.
+	Exchange(context.Context, *MsgExchangeRequest) (*MsgExchangeResponse, error)
+	// SetAdministratorProposal sets administrators with specific access on the marker
+	SetAdministratorProposal(context.Context, *MsgSetAdministratorProposalRequest) (*MsgSetAdministratorProposalResponse, error)
+	// RemoveAdministratorProposal removes administrators with specific access on the marker
+	RemoveAdministratorProposal(context.Context, *MsgRemoveAdministratorProposalRequest) (*MsgRemoveAdministratorProposalResponse, error)
+	// ChangeStatusProposal is a governance proposal change marker status
+	ChangeStatusProposal(context.Context, *MsgChangeStatusProposalRequest) (*MsgChangeStatusProposalResponse, error)
+	// WithdrawEscrowProposal is a governance proposal to withdraw escrow coins from a marker
+	WithdrawEscrowProposal(context.Context, *MsgWithdrawEscrowProposalRequest) (*MsgWithdrawEscrowProposalResponse, error)
+	// SetDenomMetadataProposal is a governance proposal to set marker metadata
+	SetDenomMetadataProposal(context.Context, *MsgSetDenomMetadataProposalRequest) (*MsgSetDenomMetadataProposalResponse, error)
+	// UpdateParams is a governance proposal endpoint for updating the marker module's params.
+	UpdateParams(context.Context, *MsgUpdateParamsRequest) (*MsgUpdateParamsResponse, error)
+	// UpdateSendRestrictionBypasses is a governance proposal endpoint for adding, updating, and removing
+	// entries in the send restriction bypass registry.
+	UpdateSendRestrictionBypasses(context.Context, *MsgUpdateSendRestrictionBypassesRequest) (*MsgUpdateSendRestrictionBypassesResponse, error)
+	// ChangeMarkerTypeProposal is a governance proposal endpoint for converting a marker between the COIN and
+	// RESTRICTED_COIN types.
+	ChangeMarkerTypeProposal(context.Context, *MsgChangeMarkerTypeProposalRequest) (*MsgChangeMarkerTypeProposalResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) Finalize(ctx context.Context, req *MsgFinalizeRequest) (*MsgFinalizeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Finalize not implemented")
+}
+func (*UnimplementedMsgServer) Activate(ctx context.Context, req *MsgActivateRequest) (*MsgActivateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Activate not implemented")
+}
+func (*UnimplementedMsgServer) Cancel(ctx context.Context, req *MsgCancelRequest) (*MsgCancelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Cancel not implemented")
+}
+func (*UnimplementedMsgServer) Delete(ctx context.Context, req *MsgDeleteRequest) (*MsgDeleteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
+func (*UnimplementedMsgServer) Mint(ctx context.Context, req *MsgMintRequest) (*MsgMintResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Mint not implemented")
+}
+func (*UnimplementedMsgServer) Burn(ctx context.Context, req *MsgBurnRequest) (*MsgBurnResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Burn not implemented")
+}
+func (*UnimplementedMsgServer) AddAccess(ctx context.Context, req *MsgAddAccessRequest) (*MsgAddAccessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddAccess not implemented")
+}
+func (*UnimplementedMsgServer) DeleteAccess(ctx context.Context, req *MsgDeleteAccessRequest) (*MsgDeleteAccessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAccess not implemented")
+}
+func (*UnimplementedMsgServer) Withdraw(ctx context.Context, req *MsgWithdrawRequest) (*MsgWithdrawResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Withdraw not implemented")
+}
+func (*UnimplementedMsgServer) AddMarker(ctx context.Context, req *MsgAddMarkerRequest) (*MsgAddMarkerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddMarker not implemented")
+}
+func (*UnimplementedMsgServer) Transfer(ctx context.Context, req *MsgTransferRequest) (*MsgTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Transfer not implemented")
+}
+func (*UnimplementedMsgServer) IbcTransfer(ctx context.Context, req *MsgIbcTransferRequest) (*MsgIbcTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IbcTransfer not implemented")
+}
+func (*UnimplementedMsgServer) SetDenomMetadata(ctx context.Context, req *MsgSetDenomMetadataRequest) (*MsgSetDenomMetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDenomMetadata not implemented")
+}
+func (*UnimplementedMsgServer) GrantAllowance(ctx context.Context, req *MsgGrantAllowanceRequest) (*MsgGrantAllowanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GrantAllowance not implemented")
+}
+func (*UnimplementedMsgServer) AddFinalizeActivateMarker(ctx context.Context, req *MsgAddFinalizeActivateMarkerRequest) (*MsgAddFinalizeActivateMarkerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddFinalizeActivateMarker not implemented")
+}
+func (*UnimplementedMsgServer) SupplyIncreaseProposal(ctx context.Context, req *MsgSupplyIncreaseProposalRequest) (*MsgSupplyIncreaseProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SupplyIncreaseProposal not implemented")
+}
+func (*UnimplementedMsgServer) SupplyDecreaseProposal(ctx context.Context, req *MsgSupplyDecreaseProposalRequest) (*MsgSupplyDecreaseProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SupplyDecreaseProposal not implemented")
+}
+func (*UnimplementedMsgServer) UpdateRequiredAttributes(ctx context.Context, req *MsgUpdateRequiredAttributesRequest) (*MsgUpdateRequiredAttributesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateRequiredAttributes not implemented")
+}
+func (*UnimplementedMsgServer) UpdateForcedTransfer(ctx context.Context, req *MsgUpdateForcedTransferRequest) (*MsgUpdateForcedTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateForcedTransfer not implemented")
+}
+func (*UnimplementedMsgServer) SetAccountData(ctx context.Context, req *MsgSetAccountDataRequest) (*MsgSetAccountDataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAccountData not implemented")
+}
+func (*UnimplementedMsgServer) UpdateSendDenyList(ctx context.Context, req *MsgUpdateSendDenyListRequest) (*MsgUpdateSendDenyListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSendDenyList not implemented")
+}
+func (*UnimplementedMsgServer) UpdateDepositAllowList(ctx context.Context, req *MsgUpdateDepositAllowListRequest) (*MsgUpdateDepositAllowListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateDepositAllowList not implemented")
+}
+func (*UnimplementedMsgServer) SetFeeSponsorship(ctx context.Context, req *MsgSetFeeSponsorshipRequest) (*MsgSetFeeSponsorshipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetFeeSponsorship not implemented")
+}
+func (*UnimplementedMsgServer) RemoveFeeSponsorship(ctx context.Context, req *MsgRemoveFeeSponsorshipRequest) (*MsgRemoveFeeSponsorshipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveFeeSponsorship not implemented")
+}
+func (*UnimplementedMsgServer) ClaimFeeSponsorship(ctx context.Context, req *MsgClaimFeeSponsorshipRequest) (*MsgClaimFeeSponsorshipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimFeeSponsorship not implemented")
+}
+func (*UnimplementedMsgServer) ReleaseFeeSponsorshipHold(ctx context.Context, req *MsgReleaseFeeSponsorshipHoldRequest) (*MsgReleaseFeeSponsorshipHoldResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleaseFeeSponsorshipHold not implemented")
+}
+func (*UnimplementedMsgServer) SetBridgeInfo(ctx context.Context, req *MsgSetBridgeInfoRequest) (*MsgSetBridgeInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetBridgeInfo not implemented")
+}
+func (*UnimplementedMsgServer) AttestedMint(ctx context.Context, req *MsgAttestedMintRequest) (*MsgAttestedMintResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AttestedMint not implemented")
+}
+func (*UnimplementedMsgServer) SetBasketInfo(ctx context.Context, req *MsgSetBasketInfoRequest) (*MsgSetBasketInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetBasketInfo not implemented")
+}
+func (*UnimplementedMsgServer) SetIBCMemoActionConfig(ctx context.Context, req *MsgSetIBCMemoActionConfigRequest) (*MsgSetIBCMemoActionConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetIBCMemoActionConfig not implemented")
+}
+func (*UnimplementedMsgServer) BasketDeposit(ctx context.Context, req *MsgBasketDepositRequest) (*MsgBasketDepositResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BasketDeposit not implemented")
+}
+func (*UnimplementedMsgServer) BasketWithdraw(ctx context.Context, req *MsgBasketWithdrawRequest) (*MsgBasketWithdrawResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BasketWithdraw not implemented")
+}
+func (*UnimplementedMsgServer) AddNetAssetValues(ctx context.Context, req *MsgAddNetAssetValuesRequest) (*MsgAddNetAssetValuesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddNetAssetValues not implemented")
+}
+func (*UnimplementedMsgServer) BindMarkerName(ctx context.Context, req *MsgBindMarkerNameRequest) (*MsgBindMarkerNameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BindMarkerName not implemented")
+}
+func (*UnimplementedMsgServer) DeleteMarkerName(ctx context.Context, req *MsgDeleteMarkerNameRequest) (*MsgDeleteMarkerNameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteMarkerName not implemented")
+}
+func (*UnimplementedMsgServer) DelegateEscrow(ctx context.Context, req *MsgDelegateEscrowRequest) (*MsgDelegateEscrowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegateEscrow not implemented")
+}
+func (*UnimplementedMsgServer) UndelegateEscrow(ctx context.Context, req *MsgUndelegateEscrowRequest) (*MsgUndelegateEscrowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UndelegateEscrow not implemented")
+}
+func (*UnimplementedMsgServer) CollectEscrowRewards(ctx context.Context, req *MsgCollectEscrowRewardsRequest) (*MsgCollectEscrowRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CollectEscrowRewards not implemented")
+}
+func (*UnimplementedMsgServer) SetMintAllowance(ctx context.Context, req *MsgSetMintAllowanceRequest) (*MsgSetMintAllowanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMintAllowance not implemented")
+}
+func (*UnimplementedMsgServer) MintFromAllowance(ctx context.Context, req *MsgMintFromAllowanceRequest) (*MsgMintFromAllowanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MintFromAllowance not implemented")
+}
+func (*UnimplementedMsgServer) ScheduleDistribution(ctx context.Context, req *MsgScheduleDistributionRequest) (*MsgScheduleDistributionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleDistribution not implemented")
+}
+func (*UnimplementedMsgServer) CancelDistribution(ctx context.Context, req *MsgCancelDistributionRequest) (*MsgCancelDistributionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelDistribution not implemented")
+}
+func (*UnimplementedMsgServer) ClaimDistribution(ctx context.Context, req *MsgClaimDistributionRequest) (*MsgClaimDistributionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimDistribution not implemented")
+}
+func (*UnimplementedMsgServer) AllocateEscrow(ctx context.Context, req *MsgAllocateEscrowRequest) (*MsgAllocateEscrowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllocateEscrow not implemented")
+}
+func (*UnimplementedMsgServer) ReleaseEscrow(ctx context.Context, req *MsgReleaseEscrowRequest) (*MsgReleaseEscrowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleaseEscrow not implemented")
+}
+func (*UnimplementedMsgServer) SetEscrowWithdrawLimit(ctx context.Context, req *MsgSetEscrowWithdrawLimitRequest) (*MsgSetEscrowWithdrawLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetEscrowWithdrawLimit not implemented")
+}
+func (*UnimplementedMsgServer) WithdrawFromEscrow(ctx context.Context, req *MsgWithdrawFromEscrowRequest) (*MsgWithdrawFromEscrowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawFromEscrow not implemented")
+}
+func (*UnimplementedMsgServer) ScheduleBurn(ctx context.Context, req *MsgScheduleBurnRequest) (*MsgScheduleBurnResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleBurn not implemented")
+}
+func (*UnimplementedMsgServer) CancelScheduledBurn(ctx context.Context, req *MsgCancelScheduledBurnRequest) (*MsgCancelScheduledBurnResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelScheduledBurn not implemented")
+}
+func (*UnimplementedMsgServer) Exchange(ctx context.Context, req *MsgExchangeRequest) (*MsgExchangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Exchange not implemented")
+}
+func (*UnimplementedMsgServer) SetAdministratorProposal(ctx context.Context, req *MsgSetAdministratorProposalRequest) (*MsgSetAdministratorProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAdministratorProposal not implemented")
+}
+func (*UnimplementedMsgServer) RemoveAdministratorProposal(ctx context.Context, req *MsgRemoveAdministratorProposalRequest) (*MsgRemoveAdministratorProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveAdministratorProposal not implemented")
+}
+func (*UnimplementedMsgServer) ChangeStatusProposal(ctx context.Context, req *MsgChangeStatusProposalRequest) (*MsgChangeStatusProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangeStatusProposal not implemented")
+}
+func (*UnimplementedMsgServer) WithdrawEscrowProposal(ctx context.Context, req *MsgWithdrawEscrowProposalRequest) (*MsgWithdrawEscrowProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawEscrowProposal not implemented")
+}
+func (*UnimplementedMsgServer) SetDenomMetadataProposal(ctx context.Context, req *MsgSetDenomMetadataProposalRequest) (*MsgSetDenomMetadataProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDenomMetadataProposal not implemented")
+}
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParamsRequest) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+func (*UnimplementedMsgServer) UpdateSendRestrictionBypasses(ctx context.Context, req *MsgUpdateSendRestrictionBypassesRequest) (*MsgUpdateSendRestrictionBypassesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSendRestrictionBypasses not implemented")
+}
+func (*UnimplementedMsgServer) ChangeMarkerTypeProposal(ctx context.Context, req *MsgChangeMarkerTypeProposalRequest) (*MsgChangeMarkerTypeProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangeMarkerTypeProposal not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_Finalize_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFinalizeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Finalize(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/Finalize",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Finalize(ctx, req.(*MsgFinalizeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_Activate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgActivateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Activate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/Activate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Activate(ctx, req.(*MsgActivateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_Cancel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Cancel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/Cancel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Cancel(ctx, req.(*MsgCancelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/Delete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Delete(ctx, req.(*MsgDeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_Mint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgMintRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Mint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/Mint",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Mint(ctx, req.(*MsgMintRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_Burn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBurnRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Burn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/Burn",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Burn(ctx, req.(*MsgBurnRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AddAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddAccessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddAccess(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/AddAccess",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddAccess(ctx, req.(*MsgAddAccessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DeleteAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeleteAccessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DeleteAccess(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/DeleteAccess",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeleteAccess(ctx, req.(*MsgDeleteAccessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_Withdraw_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Withdraw(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/Withdraw",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Withdraw(ctx, req.(*MsgWithdrawRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AddMarker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddMarkerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddMarker(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/AddMarker",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddMarker(ctx, req.(*MsgAddMarkerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_Transfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Transfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/Transfer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Transfer(ctx, req.(*MsgTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_IbcTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgIbcTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).IbcTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/IbcTransfer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).IbcTransfer(ctx, req.(*MsgIbcTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetDenomMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetDenomMetadataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetDenomMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/SetDenomMetadata",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetDenomMetadata(ctx, req.(*MsgSetDenomMetadataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_GrantAllowance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgGrantAllowanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).GrantAllowance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/GrantAllowance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).GrantAllowance(ctx, req.(*MsgGrantAllowanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AddFinalizeActivateMarker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddFinalizeActivateMarkerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddFinalizeActivateMarker(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/AddFinalizeActivateMarker",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddFinalizeActivateMarker(ctx, req.(*MsgAddFinalizeActivateMarkerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SupplyIncreaseProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSupplyIncreaseProposalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SupplyIncreaseProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/SupplyIncreaseProposal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SupplyIncreaseProposal(ctx, req.(*MsgSupplyIncreaseProposalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SupplyDecreaseProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSupplyDecreaseProposalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SupplyDecreaseProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/SupplyDecreaseProposal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SupplyDecreaseProposal(ctx, req.(*MsgSupplyDecreaseProposalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateRequiredAttributes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateRequiredAttributesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateRequiredAttributes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/UpdateRequiredAttributes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateRequiredAttributes(ctx, req.(*MsgUpdateRequiredAttributesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateForcedTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateForcedTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateForcedTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/UpdateForcedTransfer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateForcedTransfer(ctx, req.(*MsgUpdateForcedTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetAccountData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetAccountDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetAccountData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/SetAccountData",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetAccountData(ctx, req.(*MsgSetAccountDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateSendDenyList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateSendDenyListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateSendDenyList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/UpdateSendDenyList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateSendDenyList(ctx, req.(*MsgUpdateSendDenyListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateDepositAllowList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateDepositAllowListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateDepositAllowList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/UpdateDepositAllowList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateDepositAllowList(ctx, req.(*MsgUpdateDepositAllowListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetFeeSponsorship_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetFeeSponsorshipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetFeeSponsorship(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/SetFeeSponsorship",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetFeeSponsorship(ctx, req.(*MsgSetFeeSponsorshipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveFeeSponsorship_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveFeeSponsorshipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveFeeSponsorship(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/RemoveFeeSponsorship",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveFeeSponsorship(ctx, req.(*MsgRemoveFeeSponsorshipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ClaimFeeSponsorship_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClaimFeeSponsorshipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClaimFeeSponsorship(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/ClaimFeeSponsorship",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClaimFeeSponsorship(ctx, req.(*MsgClaimFeeSponsorshipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ReleaseFeeSponsorshipHold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgReleaseFeeSponsorshipHoldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ReleaseFeeSponsorshipHold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/ReleaseFeeSponsorshipHold",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ReleaseFeeSponsorshipHold(ctx, req.(*MsgReleaseFeeSponsorshipHoldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetBridgeInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetBridgeInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetBridgeInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/SetBridgeInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetBridgeInfo(ctx, req.(*MsgSetBridgeInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AttestedMint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAttestedMintRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AttestedMint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/AttestedMint",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AttestedMint(ctx, req.(*MsgAttestedMintRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetBasketInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetBasketInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetBasketInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/SetBasketInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetBasketInfo(ctx, req.(*MsgSetBasketInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetIBCMemoActionConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetIBCMemoActionConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetIBCMemoActionConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/SetIBCMemoActionConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetIBCMemoActionConfig(ctx, req.(*MsgSetIBCMemoActionConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_BasketDeposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBasketDepositRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).BasketDeposit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/BasketDeposit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BasketDeposit(ctx, req.(*MsgBasketDepositRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_BasketWithdraw_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBasketWithdrawRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).BasketWithdraw(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/BasketWithdraw",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BasketWithdraw(ctx, req.(*MsgBasketWithdrawRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AddNetAssetValues_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddNetAssetValuesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddNetAssetValues(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/AddNetAssetValues",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddNetAssetValues(ctx, req.(*MsgAddNetAssetValuesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_BindMarkerName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBindMarkerNameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).BindMarkerName(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/BindMarkerName",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BindMarkerName(ctx, req.(*MsgBindMarkerNameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DeleteMarkerName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeleteMarkerNameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DeleteMarkerName(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/DeleteMarkerName",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeleteMarkerName(ctx, req.(*MsgDeleteMarkerNameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DelegateEscrow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDelegateEscrowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DelegateEscrow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/DelegateEscrow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DelegateEscrow(ctx, req.(*MsgDelegateEscrowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UndelegateEscrow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUndelegateEscrowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UndelegateEscrow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/UndelegateEscrow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UndelegateEscrow(ctx, req.(*MsgUndelegateEscrowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CollectEscrowRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCollectEscrowRewardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CollectEscrowRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/CollectEscrowRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CollectEscrowRewards(ctx, req.(*MsgCollectEscrowRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetMintAllowance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetMintAllowanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetMintAllowance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/SetMintAllowance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetMintAllowance(ctx, req.(*MsgSetMintAllowanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_MintFromAllowance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgMintFromAllowanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).MintFromAllowance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/MintFromAllowance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).MintFromAllowance(ctx, req.(*MsgMintFromAllowanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ScheduleDistribution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgScheduleDistributionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ScheduleDistribution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/ScheduleDistribution",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ScheduleDistribution(ctx, req.(*MsgScheduleDistributionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelDistribution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelDistributionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelDistribution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/CancelDistribution",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelDistribution(ctx, req.(*MsgCancelDistributionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ClaimDistribution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClaimDistributionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClaimDistribution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/ClaimDistribution",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClaimDistribution(ctx, req.(*MsgClaimDistributionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AllocateEscrow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAllocateEscrowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AllocateEscrow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/AllocateEscrow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AllocateEscrow(ctx, req.(*MsgAllocateEscrowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ReleaseEscrow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgReleaseEscrowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ReleaseEscrow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/ReleaseEscrow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ReleaseEscrow(ctx, req.(*MsgReleaseEscrowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetEscrowWithdrawLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetEscrowWithdrawLimitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetEscrowWithdrawLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/SetEscrowWithdrawLimit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetEscrowWithdrawLimit(ctx, req.(*MsgSetEscrowWithdrawLimitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_WithdrawFromEscrow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawFromEscrowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).WithdrawFromEscrow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/WithdrawFromEscrow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WithdrawFromEscrow(ctx, req.(*MsgWithdrawFromEscrowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ScheduleBurn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgScheduleBurnRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ScheduleBurn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/ScheduleBurn",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ScheduleBurn(ctx, req.(*MsgScheduleBurnRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelScheduledBurn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelScheduledBurnRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelScheduledBurn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/CancelScheduledBurn",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelScheduledBurn(ctx, req.(*MsgCancelScheduledBurnRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_Exchange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgExchangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Exchange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/Exchange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Exchange(ctx, req.(*MsgExchangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetAdministratorProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetAdministratorProposalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetAdministratorProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/SetAdministratorProposal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetAdministratorProposal(ctx, req.(*MsgSetAdministratorProposalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveAdministratorProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveAdministratorProposalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveAdministratorProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/RemoveAdministratorProposal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveAdministratorProposal(ctx, req.(*MsgRemoveAdministratorProposalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ChangeStatusProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgChangeStatusProposalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ChangeStatusProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/ChangeStatusProposal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ChangeStatusProposal(ctx, req.(*MsgChangeStatusProposalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_WithdrawEscrowProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawEscrowProposalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).WithdrawEscrowProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/WithdrawEscrowProposal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WithdrawEscrowProposal(ctx, req.(*MsgWithdrawEscrowProposalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetDenomMetadataProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetDenomMetadataProposalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetDenomMetadataProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/SetDenomMetadataProposal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetDenomMetadataProposal(ctx, req.(*MsgSetDenomMetadataProposalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateSendRestrictionBypasses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateSendRestrictionBypassesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateSendRestrictionBypasses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/UpdateSendRestrictionBypasses",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateSendRestrictionBypasses(ctx, req.(*MsgUpdateSendRestrictionBypassesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ChangeMarkerTypeProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgChangeMarkerTypeProposalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ChangeMarkerTypeProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/ChangeMarkerTypeProposal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ChangeMarkerTypeProposal(ctx, req.(*MsgChangeMarkerTypeProposalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Msg_serviceDesc = _Msg_serviceDesc
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "provenance.marker.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Finalize",
+			Handler:    _Msg_Finalize_Handler,
+		},
+		{
+			MethodName: "Activate",
+			Handler:    _Msg_Activate_Handler,
+		},
+		{
+			MethodName: "Cancel",
+			Handler:    _Msg_Cancel_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _Msg_Delete_Handler,
+		},
+		{
+			MethodName: "Mint",
+			Handler:    _Msg_Mint_Handler,
+		},
+		{
+			MethodName: "Burn",
+			Handler:    _Msg_Burn_Handler,
+		},
+		{
+			MethodName: "AddAccess",
+			Handler:    _Msg_AddAccess_Handler,
+		},
+		{
+			MethodName: "DeleteAccess",
+			Handler:    _Msg_DeleteAccess_Handler,
+		},
+		{
+			MethodName: "Withdraw",
+			Handler:    _Msg_Withdraw_Handler,
+		},
+		{
+			MethodName: "AddMarker",
+			Handler:    _Msg_AddMarker_Handler,
+		},
+		{
+			MethodName: "Transfer",
+			Handler:    _Msg_Transfer_Handler,
+		},
+		{
+			MethodName: "IbcTransfer",
+			Handler:    _Msg_IbcTransfer_Handler,
+		},
+		{
+			MethodName: "SetDenomMetadata",
+			Handler:    _Msg_SetDenomMetadata_Handler,
+		},
+		{
+			MethodName: "GrantAllowance",
+			Handler:    _Msg_GrantAllowance_Handler,
+		},
+		{
+			MethodName: "AddFinalizeActivateMarker",
+			Handler:    _Msg_AddFinalizeActivateMarker_Handler,
+		},
+		{
+			MethodName: "SupplyIncreaseProposal",
+			Handler:    _Msg_SupplyIncreaseProposal_Handler,
+		},
+		{
+			MethodName: "SupplyDecreaseProposal",
+			Handler:    _Msg_SupplyDecreaseProposal_Handler,
+		},
+		{
+			MethodName: "UpdateRequiredAttributes",
+			Handler:    _Msg_UpdateRequiredAttributes_Handler,
+		},
+		{
+			MethodName: "UpdateForcedTransfer",
+			Handler:    _Msg_UpdateForcedTransfer_Handler,
+		},
+		{
+			MethodName: "SetAccountData",
+			Handler:    _Msg_SetAccountData_Handler,
+		},
+		{
+			MethodName: "UpdateSendDenyList",
+			Handler:    _Msg_UpdateSendDenyList_Handler,
+		},
+		{
+			MethodName: "UpdateDepositAllowList",
+			Handler:    _Msg_UpdateDepositAllowList_Handler,
+		},
+		{
+			MethodName: "SetFeeSponsorship",
+			Handler:    _Msg_SetFeeSponsorship_Handler,
+		},
+		{
+			MethodName: "RemoveFeeSponsorship",
+			Handler:    _Msg_RemoveFeeSponsorship_Handler,
+		},
+		{
+			MethodName: "ClaimFeeSponsorship",
+			Handler:    _Msg_ClaimFeeSponsorship_Handler,
+		},
+		{
+			MethodName: "ReleaseFeeSponsorshipHold",
+			Handler:    _Msg_ReleaseFeeSponsorshipHold_Handler,
+		},
+		{
+			MethodName: "SetBridgeInfo",
+			Handler:    _Msg_SetBridgeInfo_Handler,
+		},
+		{
+			MethodName: "AttestedMint",
+			Handler:    _Msg_AttestedMint_Handler,
+		},
+		{
+			MethodName: "SetBasketInfo",
+			Handler:    _Msg_SetBasketInfo_Handler,
+		},
+		{
+			MethodName: "SetIBCMemoActionConfig",
+			Handler:    _Msg_SetIBCMemoActionConfig_Handler,
+		},
+		{
+			MethodName: "BasketDeposit",
+			Handler:    _Msg_BasketDeposit_Handler,
+		},
+		{
+			MethodName: "BasketWithdraw",
+			Handler:    _Msg_BasketWithdraw_Handler,
+		},
+		{
+			MethodName: "AddNetAssetValues",
+			Handler:    _Msg_AddNetAssetValues_Handler,
+		},
+		{
+			MethodName: "BindMarkerName",
+			Handler:    _Msg_BindMarkerName_Handler,
+		},
+		{
+			MethodName: "DeleteMarkerName",
+			Handler:    _Msg_DeleteMarkerName_Handler,
+		},
+		{
+			MethodName: "DelegateEscrow",
+			Handler:    _Msg_DelegateEscrow_Handler,
+		},
+		{
+			MethodName: "UndelegateEscrow",
+			Handler:    _Msg_UndelegateEscrow_Handler,
+		},
+		{
+			MethodName: "CollectEscrowRewards",
+			Handler:    _Msg_CollectEscrowRewards_Handler,
+		},
+		{
+			MethodName: "SetMintAllowance",
+			Handler:    _Msg_SetMintAllowance_Handler,
+		},
+		{
+			MethodName: "MintFromAllowance",
+			Handler:    _Msg_MintFromAllowance_Handler,
+		},
+		{
+			MethodName: "ScheduleDistribution",
+			Handler:    _Msg_ScheduleDistribution_Handler,
+		},
+		{
+			MethodName: "CancelDistribution",
+			Handler:    _Msg_CancelDistribution_Handler,
+		},
+		{
+			MethodName: "ClaimDistribution",
+			Handler:    _Msg_ClaimDistribution_Handler,
+		},
+		{
+			MethodName: "AllocateEscrow",
+			Handler:    _Msg_AllocateEscrow_Handler,
+		},
+		{
+			MethodName: "ReleaseEscrow",
+			Handler:    _Msg_ReleaseEscrow_Handler,
+		},
+		{
+			MethodName: "SetEscrowWithdrawLimit",
+			Handler:    _Msg_SetEscrowWithdrawLimit_Handler,
+		},
+		{
+			MethodName: "WithdrawFromEscrow",
+			Handler:    _Msg_WithdrawFromEscrow_Handler,
+		},
+		{
+			MethodName: "ScheduleBurn",
+			Handler:    _Msg_ScheduleBurn_Handler,
+		},
+		{
+			MethodName: "CancelScheduledBurn",
+			Handler:    _Msg_CancelScheduledBurn_Handler,
+		},
+		{
+			MethodName: "Exchange",
+			Handler:    _Msg_Exchange_Handler,
+		},
+		{
+			MethodName: "SetAdministratorProposal",
+			Handler:    _Msg_SetAdministratorProposal_Handler,
+		},
+		{
+			MethodName: "RemoveAdministratorProposal",
+			Handler:    _Msg_RemoveAdministratorProposal_Handler,
+		},
+		{
+			MethodName: "ChangeStatusProposal",
+			Handler:    _Msg_ChangeStatusProposal_Handler,
+		},
+		{
+			MethodName: "WithdrawEscrowProposal",
+			Handler:    _Msg_WithdrawEscrowProposal_Handler,
+		},
+		{
+			MethodName: "SetDenomMetadataProposal",
+			Handler:    _Msg_SetDenomMetadataProposal_Handler,
+		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+		{
+			MethodName: "UpdateSendRestrictionBypasses",
+			Handler:    _Msg_UpdateSendRestrictionBypasses_Handler,
+		},
+		{
+			MethodName: "ChangeMarkerTypeProposal",
+			Handler:    _Msg_ChangeMarkerTypeProposal_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "provenance/marker/v1/tx.proto",
+}
+
+func (m *MsgGrantAllowanceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgGrantAllowanceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgGrantAllowanceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Allowance != nil {
+		{
+			size, err := m.Allowance.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Grantee) > 0 {
+		i -= len(m.Grantee)
+		copy(dAtA[i:], m.Grantee)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Grantee)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgGrantAllowanceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgGrantAllowanceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgGrantAllowanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddMarkerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddMarkerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddMarkerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UsdMills != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.UsdMills))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.Volume != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Volume))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.UsdCents != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.UsdCents))
+		i--
+		dAtA[i] = 0x60
+	}
+	if len(m.RequiredAttributes) > 0 {
+		for iNdEx := len(m.RequiredAttributes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RequiredAttributes[iNdEx])
+			copy(dAtA[i:], m.RequiredAttributes[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.RequiredAttributes[iNdEx])))
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if m.AllowForcedTransfer {
+		i--
+		if m.AllowForcedTransfer {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.AllowGovernanceControl {
+		i--
+		if m.AllowGovernanceControl {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.SupplyFixed {
+		i--
+		if m.SupplyFixed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.AccessList) > 0 {
+		for iNdEx := len(m.AccessList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AccessList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.MarkerType != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MarkerType))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Status != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.FromAddress) > 0 {
+		i -= len(m.FromAddress)
+		copy(dAtA[i:], m.FromAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FromAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Manager) > 0 {
+		i -= len(m.Manager)
+		copy(dAtA[i:], m.Manager)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Manager)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddMarkerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddMarkerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddMarkerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddAccessRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddAccessRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddAccessRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Access) > 0 {
+		for iNdEx := len(m.Access) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Access[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddAccessResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddAccessResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddAccessResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeleteAccessRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteAccessRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteAccessRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RemovedAddress) > 0 {
+		i -= len(m.RemovedAddress)
+		copy(dAtA[i:], m.RemovedAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RemovedAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeleteAccessResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteAccessResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteAccessResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFinalizeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFinalizeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFinalizeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFinalizeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFinalizeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFinalizeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgActivateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgActivateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgActivateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgActivateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgActivateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgActivateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeleteRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeleteResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMintRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMintRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMintRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMintResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMintResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMintResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBurnRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBurnRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBurnRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBurnResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBurnResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBurnResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.ToAddress) > 0 {
+		i -= len(m.ToAddress)
+		copy(dAtA[i:], m.ToAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ToAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTransferRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ToAddress) > 0 {
+		i -= len(m.ToAddress)
+		copy(dAtA[i:], m.ToAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ToAddress)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.FromAddress) > 0 {
+		i -= len(m.FromAddress)
+		copy(dAtA[i:], m.FromAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FromAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTransferResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgIbcTransferRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgIbcTransferRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgIbcTransferRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size := m.Transfer.Size()
+		i -= size
+		if _, err := m.Transfer.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgIbcTransferResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgIbcTransferResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgIbcTransferResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetDenomMetadataRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetDenomMetadataRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetDenomMetadataRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size := m.Metadata.Size()
+		i -= size
+		if _, err := m.Metadata.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetDenomMetadataResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetDenomMetadataResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetDenomMetadataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddFinalizeActivateMarkerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddFinalizeActivateMarkerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddFinalizeActivateMarkerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UsdMills != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.UsdMills))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.Volume != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Volume))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.UsdCents != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.UsdCents))
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.RequiredAttributes) > 0 {
+		for iNdEx := len(m.RequiredAttributes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RequiredAttributes[iNdEx])
+			copy(dAtA[i:], m.RequiredAttributes[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.RequiredAttributes[iNdEx])))
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if m.AllowForcedTransfer {
+		i--
+		if m.AllowForcedTransfer {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.AllowGovernanceControl {
+		i--
+		if m.AllowGovernanceControl {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.SupplyFixed {
+		i--
+		if m.SupplyFixed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.AccessList) > 0 {
+		for iNdEx := len(m.AccessList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AccessList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.MarkerType != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MarkerType))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.FromAddress) > 0 {
+		i -= len(m.FromAddress)
+		copy(dAtA[i:], m.FromAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FromAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Manager) > 0 {
+		i -= len(m.Manager)
+		copy(dAtA[i:], m.Manager)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Manager)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddFinalizeActivateMarkerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddFinalizeActivateMarkerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddFinalizeActivateMarkerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSupplyIncreaseProposalRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSupplyIncreaseProposalRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSupplyIncreaseProposalRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.TargetAddress) > 0 {
+		i -= len(m.TargetAddress)
+		copy(dAtA[i:], m.TargetAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TargetAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSupplyIncreaseProposalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSupplyIncreaseProposalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSupplyIncreaseProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSupplyDecreaseProposalRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSupplyDecreaseProposalRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSupplyDecreaseProposalRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSupplyDecreaseProposalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSupplyDecreaseProposalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSupplyDecreaseProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateRequiredAttributesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateRequiredAttributesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateRequiredAttributesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AddRequiredSenderAttributes) > 0 {
+		for iNdEx := len(m.AddRequiredSenderAttributes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AddRequiredSenderAttributes[iNdEx])
+			copy(dAtA[i:], m.AddRequiredSenderAttributes[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.AddRequiredSenderAttributes[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.RemoveRequiredSenderAttributes) > 0 {
+		for iNdEx := len(m.RemoveRequiredSenderAttributes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RemoveRequiredSenderAttributes[iNdEx])
+			copy(dAtA[i:], m.RemoveRequiredSenderAttributes[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.RemoveRequiredSenderAttributes[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.TransferAuthority) > 0 {
+		i -= len(m.TransferAuthority)
+		copy(dAtA[i:], m.TransferAuthority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TransferAuthority)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.AddRequiredAttributes) > 0 {
+		for iNdEx := len(m.AddRequiredAttributes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AddRequiredAttributes[iNdEx])
+			copy(dAtA[i:], m.AddRequiredAttributes[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.AddRequiredAttributes[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.RemoveRequiredAttributes) > 0 {
+		for iNdEx := len(m.RemoveRequiredAttributes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RemoveRequiredAttributes[iNdEx])
+			copy(dAtA[i:], m.RemoveRequiredAttributes[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.RemoveRequiredAttributes[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateRequiredAttributesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateRequiredAttributesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateRequiredAttributesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateForcedTransferRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateForcedTransferRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateForcedTransferRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.AllowForcedTransfer {
+		i--
+		if m.AllowForcedTransfer {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateForcedTransferResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateForcedTransferResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateForcedTransferResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetAccountDataRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetAccountDataRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAccountDataRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetAccountDataResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetAccountDataResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAccountDataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateSendDenyListRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateSendDenyListRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateSendDenyListRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.AddDeniedAddresses) > 0 {
+		for iNdEx := len(m.AddDeniedAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AddDeniedAddresses[iNdEx])
+			copy(dAtA[i:], m.AddDeniedAddresses[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.AddDeniedAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.RemoveDeniedAddresses) > 0 {
+		for iNdEx := len(m.RemoveDeniedAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RemoveDeniedAddresses[iNdEx])
+			copy(dAtA[i:], m.RemoveDeniedAddresses[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.RemoveDeniedAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateSendDenyListResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateSendDenyListResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateSendDenyListResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateDepositAllowListRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateDepositAllowListRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateDepositAllowListRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.AddAllowedAddresses) > 0 {
+		for iNdEx := len(m.AddAllowedAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AddAllowedAddresses[iNdEx])
+			copy(dAtA[i:], m.AddAllowedAddresses[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.AddAllowedAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.RemoveAllowedAddresses) > 0 {
+		for iNdEx := len(m.RemoveAllowedAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RemoveAllowedAddresses[iNdEx])
+			copy(dAtA[i:], m.RemoveAllowedAddresses[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.RemoveAllowedAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateDepositAllowListResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateDepositAllowListResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateDepositAllowListResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetFeeSponsorshipRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetFeeSponsorshipRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetFeeSponsorshipRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Sponsorship.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetFeeSponsorshipResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetFeeSponsorshipResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetFeeSponsorshipResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveFeeSponsorshipRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveFeeSponsorshipRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveFeeSponsorshipRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveFeeSponsorshipResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveFeeSponsorshipResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveFeeSponsorshipResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimFeeSponsorshipRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimFeeSponsorshipRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimFeeSponsorshipRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Holder) > 0 {
+		i -= len(m.Holder)
+		copy(dAtA[i:], m.Holder)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Holder)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimFeeSponsorshipResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimFeeSponsorshipResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimFeeSponsorshipResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgReleaseFeeSponsorshipHoldRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReleaseFeeSponsorshipHoldRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReleaseFeeSponsorshipHoldRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Holder) > 0 {
+		i -= len(m.Holder)
+		copy(dAtA[i:], m.Holder)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Holder)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgReleaseFeeSponsorshipHoldResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReleaseFeeSponsorshipHoldResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReleaseFeeSponsorshipHoldResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetBridgeInfoRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetBridgeInfoRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetBridgeInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.BridgeInfo.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetBridgeInfoResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetBridgeInfoResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetBridgeInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAttestedMintRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAttestedMintRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAttestedMintRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Reference) > 0 {
+		i -= len(m.Reference)
+		copy(dAtA[i:], m.Reference)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reference)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAttestedMintResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAttestedMintResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAttestedMintResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetBasketInfoRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetBasketInfoRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetBasketInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.BasketInfo.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetBasketInfoResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetBasketInfoResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetBasketInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetIBCMemoActionConfigRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetIBCMemoActionConfigRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetIBCMemoActionConfigRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Config.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetIBCMemoActionConfigResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetIBCMemoActionConfigResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetIBCMemoActionConfigResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBasketDepositRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBasketDepositRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBasketDepositRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Depositor) > 0 {
+		i -= len(m.Depositor)
+		copy(dAtA[i:], m.Depositor)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Depositor)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBasketDepositResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBasketDepositResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBasketDepositResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Components) > 0 {
+		for iNdEx := len(m.Components) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Components[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBasketWithdrawRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBasketWithdrawRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBasketWithdrawRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Holder) > 0 {
+		i -= len(m.Holder)
+		copy(dAtA[i:], m.Holder)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Holder)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBasketWithdrawResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBasketWithdrawResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBasketWithdrawResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Components) > 0 {
+		for iNdEx := len(m.Components) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Components[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddNetAssetValuesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddNetAssetValuesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddNetAssetValuesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NetAssetValues) > 0 {
+		for iNdEx := len(m.NetAssetValues) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.NetAssetValues[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddNetAssetValuesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddNetAssetValuesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddNetAssetValuesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBindMarkerNameRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBindMarkerNameRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBindMarkerNameRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Restricted {
+		i--
+		if m.Restricted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Parent) > 0 {
+		i -= len(m.Parent)
+		copy(dAtA[i:], m.Parent)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Parent)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBindMarkerNameResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBindMarkerNameResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBindMarkerNameResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeleteMarkerNameRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteMarkerNameRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteMarkerNameRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeleteMarkerNameResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteMarkerNameResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteMarkerNameResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDelegateEscrowRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDelegateEscrowRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDelegateEscrowRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDelegateEscrowResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDelegateEscrowResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDelegateEscrowResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUndelegateEscrowRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUndelegateEscrowRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUndelegateEscrowRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUndelegateEscrowResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUndelegateEscrowResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUndelegateEscrowResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n20, err20 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CompletionTime):])
+	if err20 != nil {
+		return 0, err20
+	}
+	i -= n20
+	i = encodeVarintTx(dAtA, i, uint64(n20))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCollectEscrowRewardsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCollectEscrowRewardsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCollectEscrowRewardsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCollectEscrowRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCollectEscrowRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCollectEscrowRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetMintAllowanceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetMintAllowanceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetMintAllowanceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Minter) > 0 {
+		i -= len(m.Minter)
+		copy(dAtA[i:], m.Minter)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Minter)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetMintAllowanceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetMintAllowanceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetMintAllowanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMintFromAllowanceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMintFromAllowanceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMintFromAllowanceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Minter) > 0 {
+		i -= len(m.Minter)
+		copy(dAtA[i:], m.Minter)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Minter)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMintFromAllowanceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMintFromAllowanceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMintFromAllowanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Remaining.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgScheduleDistributionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgScheduleDistributionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgScheduleDistributionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.MinPayout != nil {
+		{
+			size, err := m.MinPayout.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.BatchSize != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.BatchSize))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgScheduleDistributionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgScheduleDistributionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgScheduleDistributionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DistributionId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.DistributionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelDistributionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelDistributionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelDistributionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.DistributionId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.DistributionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelDistributionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelDistributionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelDistributionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimDistributionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimDistributionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimDistributionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Claimant) > 0 {
+		i -= len(m.Claimant)
+		copy(dAtA[i:], m.Claimant)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Claimant)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.DistributionId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.DistributionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimDistributionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimDistributionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimDistributionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAllocateEscrowRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAllocateEscrowRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAllocateEscrowRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Ledger) > 0 {
+		i -= len(m.Ledger)
+		copy(dAtA[i:], m.Ledger)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Ledger)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAllocateEscrowResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAllocateEscrowResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAllocateEscrowResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgReleaseEscrowRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReleaseEscrowRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReleaseEscrowRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Ledger) > 0 {
+		i -= len(m.Ledger)
+		copy(dAtA[i:], m.Ledger)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Ledger)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgReleaseEscrowResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReleaseEscrowResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReleaseEscrowResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetEscrowWithdrawLimitRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetEscrowWithdrawLimitRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetEscrowWithdrawLimitRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Limit) > 0 {
+		for iNdEx := len(m.Limit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Limit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Grantee) > 0 {
+		i -= len(m.Grantee)
+		copy(dAtA[i:], m.Grantee)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Grantee)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Ledger) > 0 {
+		i -= len(m.Ledger)
+		copy(dAtA[i:], m.Ledger)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Ledger)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetEscrowWithdrawLimitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetEscrowWithdrawLimitResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetEscrowWithdrawLimitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawFromEscrowRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawFromEscrowRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawFromEscrowRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ToAddress) > 0 {
+		i -= len(m.ToAddress)
+		copy(dAtA[i:], m.ToAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ToAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Ledger) > 0 {
+		i -= len(m.Ledger)
+		copy(dAtA[i:], m.Ledger)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Ledger)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawFromEscrowResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawFromEscrowResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawFromEscrowResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RemainingLimit) > 0 {
+		for iNdEx := len(m.RemainingLimit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RemainingLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgScheduleBurnRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgScheduleBurnRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgScheduleBurnRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.CancelWindow != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CancelWindow))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.BurnHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.BurnHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgScheduleBurnResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgScheduleBurnResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgScheduleBurnResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BurnId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.BurnId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelScheduledBurnRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelScheduledBurnRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelScheduledBurnRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.BurnId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.BurnId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelScheduledBurnResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelScheduledBurnResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelScheduledBurnResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgExchangeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgExchangeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgExchangeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.AmountB.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.PartyB) > 0 {
+		i -= len(m.PartyB)
+		copy(dAtA[i:], m.PartyB)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PartyB)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.AmountA.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.PartyA) > 0 {
+		i -= len(m.PartyA)
+		copy(dAtA[i:], m.PartyA)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PartyA)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgExchangeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgExchangeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgExchangeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetAdministratorProposalRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetAdministratorProposalRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAdministratorProposalRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Access) > 0 {
+		for iNdEx := len(m.Access) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Access[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetAdministratorProposalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetAdministratorProposalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAdministratorProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveAdministratorProposalRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveAdministratorProposalRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveAdministratorProposalRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.RemovedAddress) > 0 {
+		for iNdEx := len(m.RemovedAddress) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RemovedAddress[iNdEx])
+			copy(dAtA[i:], m.RemovedAddress[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.RemovedAddress[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveAdministratorProposalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveAdministratorProposalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveAdministratorProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgChangeStatusProposalRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgChangeStatusProposalRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgChangeStatusProposalRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.NewStatus != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.NewStatus))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgChangeStatusProposalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgChangeStatusProposalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgChangeStatusProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawEscrowProposalRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawEscrowProposalRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawEscrowProposalRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.TargetAddress) > 0 {
+		i -= len(m.TargetAddress)
+		copy(dAtA[i:], m.TargetAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TargetAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawEscrowProposalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawEscrowProposalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawEscrowProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetDenomMetadataProposalRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetDenomMetadataProposalRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetDenomMetadataProposalRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size := m.Metadata.Size()
+		i -= size
+		if _, err := m.Metadata.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetDenomMetadataProposalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetDenomMetadataProposalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetDenomMetadataProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateSendRestrictionBypassesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateSendRestrictionBypassesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateSendRestrictionBypassesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Remove) > 0 {
+		for iNdEx := len(m.Remove) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Remove[iNdEx])
+			copy(dAtA[i:], m.Remove[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Remove[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Set) > 0 {
+		for iNdEx := len(m.Set) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Set[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateSendRestrictionBypassesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateSendRestrictionBypassesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateSendRestrictionBypassesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgChangeMarkerTypeProposalRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgChangeMarkerTypeProposalRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgChangeMarkerTypeProposalRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.NewType != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.NewType))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgChangeMarkerTypeProposalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgChangeMarkerTypeProposalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgChangeMarkerTypeProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgGrantAllowanceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Grantee)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Allowance != nil {
+		l = m.Allowance.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgGrantAllowanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAddMarkerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Manager)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.FromAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovTx(uint64(m.Status))
+	}
+	if m.MarkerType != 0 {
+		n += 1 + sovTx(uint64(m.MarkerType))
+	}
+	if len(m.AccessList) > 0 {
+		for _, e := range m.AccessList {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.SupplyFixed {
+		n += 2
+	}
+	if m.AllowGovernanceControl {
+		n += 2
+	}
+	if m.AllowForcedTransfer {
+		n += 2
+	}
+	if len(m.RequiredAttributes) > 0 {
+		for _, s := range m.RequiredAttributes {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.UsdCents != 0 {
+		n += 1 + sovTx(uint64(m.UsdCents))
+	}
+	if m.Volume != 0 {
+		n += 1 + sovTx(uint64(m.Volume))
+	}
+	if m.UsdMills != 0 {
+		n += 1 + sovTx(uint64(m.UsdMills))
+	}
+	return n
+}
+
+func (m *MsgAddMarkerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAddAccessRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Access) > 0 {
+		for _, e := range m.Access {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgAddAccessResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDeleteAccessRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.RemovedAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDeleteAccessResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgFinalizeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgFinalizeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgActivateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgActivateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCancelRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCancelResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDeleteRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDeleteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgMintRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgMintResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgBurnRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgBurnResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgWithdrawRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ToAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgWithdrawResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgTransferRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.FromAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ToAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgTransferResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgIbcTransferRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Transfer.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgIbcTransferResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetDenomMetadataRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Metadata.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetDenomMetadataResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAddFinalizeActivateMarkerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Manager)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.FromAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.MarkerType != 0 {
+		n += 1 + sovTx(uint64(m.MarkerType))
+	}
+	if len(m.AccessList) > 0 {
+		for _, e := range m.AccessList {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.SupplyFixed {
+		n += 2
+	}
+	if m.AllowGovernanceControl {
+		n += 2
+	}
+	if m.AllowForcedTransfer {
+		n += 2
+	}
+	if len(m.RequiredAttributes) > 0 {
+		for _, s := range m.RequiredAttributes {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.UsdCents != 0 {
+		n += 1 + sovTx(uint64(m.UsdCents))
+	}
+	if m.Volume != 0 {
+		n += 1 + sovTx(uint64(m.Volume))
+	}
+	if m.UsdMills != 0 {
+		n += 1 + sovTx(uint64(m.UsdMills))
+	}
+	return n
+}
+
+func (m *MsgAddFinalizeActivateMarkerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSupplyIncreaseProposalRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.TargetAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSupplyIncreaseProposalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSupplyDecreaseProposalRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSupplyDecreaseProposalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateRequiredAttributesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.RemoveRequiredAttributes) > 0 {
+		for _, s := range m.RemoveRequiredAttributes {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.AddRequiredAttributes) > 0 {
+		for _, s := range m.AddRequiredAttributes {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.TransferAuthority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.RemoveRequiredSenderAttributes) > 0 {
+		for _, s := range m.RemoveRequiredSenderAttributes {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.AddRequiredSenderAttributes) > 0 {
+		for _, s := range m.AddRequiredSenderAttributes {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgUpdateRequiredAttributesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateForcedTransferRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.AllowForcedTransfer {
+		n += 2
+	}
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateForcedTransferResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetAccountDataRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetAccountDataResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateSendDenyListRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.RemoveDeniedAddresses) > 0 {
+		for _, s := range m.RemoveDeniedAddresses {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.AddDeniedAddresses) > 0 {
+		for _, s := range m.AddDeniedAddresses {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateSendDenyListResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateDepositAllowListRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.RemoveAllowedAddresses) > 0 {
+		for _, s := range m.RemoveAllowedAddresses {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.AddAllowedAddresses) > 0 {
+		for _, s := range m.AddAllowedAddresses {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateDepositAllowListResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetFeeSponsorshipRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Sponsorship.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetFeeSponsorshipResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveFeeSponsorshipRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemoveFeeSponsorshipResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgClaimFeeSponsorshipRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Holder)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgClaimFeeSponsorshipResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgReleaseFeeSponsorshipHoldRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Holder)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgReleaseFeeSponsorshipHoldResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetBridgeInfoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.BridgeInfo.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetBridgeInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAttestedMintRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Reference)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAttestedMintResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		n += 1 + sovTx(uint64(m.Sequence))
+	}
+	return n
+}
+
+func (m *MsgSetBasketInfoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.BasketInfo.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetBasketInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetIBCMemoActionConfigRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Config.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetIBCMemoActionConfigResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgBasketDepositRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Depositor)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgBasketDepositResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Components) > 0 {
+		for _, e := range m.Components {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgBasketWithdrawRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Holder)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgBasketWithdrawResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Components) > 0 {
+		for _, e := range m.Components {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgAddNetAssetValuesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.NetAssetValues) > 0 {
+		for _, e := range m.NetAssetValues {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgAddNetAssetValuesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgBindMarkerNameRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Parent)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Restricted {
+		n += 2
+	}
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgBindMarkerNameResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDeleteMarkerNameRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDeleteMarkerNameResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDelegateEscrowRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDelegateEscrowResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUndelegateEscrowRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUndelegateEscrowResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CompletionTime)
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgCollectEscrowRewardsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCollectEscrowRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSetMintAllowanceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Minter)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetMintAllowanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgMintFromAllowanceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Minter)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgMintFromAllowanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Remaining.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgScheduleDistributionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.StartHeight != 0 {
+		n += 1 + sovTx(uint64(m.StartHeight))
+	}
+	if m.BatchSize != 0 {
+		n += 1 + sovTx(uint64(m.BatchSize))
+	}
+	if m.MinPayout != nil {
+		l = m.MinPayout.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgScheduleDistributionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DistributionId != 0 {
+		n += 1 + sovTx(uint64(m.DistributionId))
+	}
+	return n
+}
+
+func (m *MsgCancelDistributionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DistributionId != 0 {
+		n += 1 + sovTx(uint64(m.DistributionId))
+	}
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCancelDistributionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgClaimDistributionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DistributionId != 0 {
+		n += 1 + sovTx(uint64(m.DistributionId))
+	}
+	l = len(m.Claimant)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgClaimDistributionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgAllocateEscrowRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Ledger)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAllocateEscrowResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgReleaseEscrowRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Ledger)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgReleaseEscrowResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetEscrowWithdrawLimitRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Ledger)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Grantee)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Limit) > 0 {
+		for _, e := range m.Limit {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetEscrowWithdrawLimitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgWithdrawFromEscrowRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Ledger)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.ToAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgWithdrawFromEscrowResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RemainingLimit) > 0 {
+		for _, e := range m.RemainingLimit {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgScheduleBurnRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.BurnHeight != 0 {
+		n += 1 + sovTx(uint64(m.BurnHeight))
+	}
+	if m.CancelWindow != 0 {
+		n += 1 + sovTx(uint64(m.CancelWindow))
+	}
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgScheduleBurnResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BurnId != 0 {
+		n += 1 + sovTx(uint64(m.BurnId))
+	}
+	return n
+}
+
+func (m *MsgCancelScheduledBurnRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BurnId != 0 {
+		n += 1 + sovTx(uint64(m.BurnId))
+	}
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCancelScheduledBurnResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgExchangeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PartyA)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.AmountA.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.PartyB)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.AmountB.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgExchangeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetAdministratorProposalRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Access) > 0 {
+		for _, e := range m.Access {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetAdministratorProposalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveAdministratorProposalRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.RemovedAddress) > 0 {
+		for _, s := range m.RemovedAddress {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemoveAdministratorProposalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgChangeStatusProposalRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.NewStatus != 0 {
+		n += 1 + sovTx(uint64(m.NewStatus))
+	}
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgChangeStatusProposalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgWithdrawEscrowProposalRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.TargetAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgWithdrawEscrowProposalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetDenomMetadataProposalRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Metadata.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetDenomMetadataProposalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateSendRestrictionBypassesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Set) > 0 {
+		for _, e := range m.Set {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.Remove) > 0 {
+		for _, s := range m.Remove {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgUpdateSendRestrictionBypassesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgChangeMarkerTypeProposalRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
 	_ = l
 	l = len(m.Denom)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	if len(m.Amount) > 0 {
-		for _, e := range m.Amount {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+	if m.NewType != 0 {
+		n += 1 + sovTx(uint64(m.NewType))
+	}
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgChangeMarkerTypeProposalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgGrantAllowanceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgGrantAllowanceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgGrantAllowanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Administrator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grantee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Allowance == nil {
+				m.Allowance = &types.Any{}
+			}
+			if err := m.Allowance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgGrantAllowanceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgGrantAllowanceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgGrantAllowanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddMarkerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddMarkerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddMarkerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Manager", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Manager = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= MarkerStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarkerType", wireType)
+			}
+			m.MarkerType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarkerType |= MarkerType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccessList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccessList = append(m.AccessList, AccessGrant{})
+			if err := m.AccessList[len(m.AccessList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SupplyFixed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SupplyFixed = bool(v != 0)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowGovernanceControl", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllowGovernanceControl = bool(v != 0)
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowForcedTransfer", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllowForcedTransfer = bool(v != 0)
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredAttributes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequiredAttributes = append(m.RequiredAttributes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UsdCents", wireType)
+			}
+			m.UsdCents = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UsdCents |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Volume", wireType)
+			}
+			m.Volume = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Volume |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UsdMills", wireType)
+			}
+			m.UsdMills = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UsdMills |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddMarkerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddMarkerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddMarkerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddAccessRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddAccessRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddAccessRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Administrator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Access", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Access = append(m.Access, AccessGrant{})
+			if err := m.Access[len(m.Access)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddAccessResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddAccessResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddAccessResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeleteAccessRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteAccessRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteAccessRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Administrator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemovedAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemovedAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeleteAccessResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteAccessResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteAccessResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFinalizeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFinalizeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFinalizeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Administrator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFinalizeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFinalizeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFinalizeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgActivateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgActivateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgActivateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Administrator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgActivateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgActivateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgActivateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Administrator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeleteRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Administrator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeleteResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgMintRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMintRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMintRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Administrator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgMintResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMintResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMintResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBurnRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBurnRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBurnRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Administrator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBurnResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBurnResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBurnResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Administrator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types1.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTransferRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTransferRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTransferRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Administrator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTransferResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTransferResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTransferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgIbcTransferRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgIbcTransferRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgIbcTransferRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Transfer", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Transfer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Administrator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgIbcTransferResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgIbcTransferResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgIbcTransferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetDenomMetadataRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetDenomMetadataRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetDenomMetadataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Administrator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetDenomMetadataResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetDenomMetadataResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetDenomMetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddFinalizeActivateMarkerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddFinalizeActivateMarkerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddFinalizeActivateMarkerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Manager", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Manager = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarkerType", wireType)
+			}
+			m.MarkerType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarkerType |= MarkerType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccessList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccessList = append(m.AccessList, AccessGrant{})
+			if err := m.AccessList[len(m.AccessList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SupplyFixed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SupplyFixed = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowGovernanceControl", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllowGovernanceControl = bool(v != 0)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowForcedTransfer", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllowForcedTransfer = bool(v != 0)
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredAttributes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequiredAttributes = append(m.RequiredAttributes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UsdCents", wireType)
+			}
+			m.UsdCents = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UsdCents |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Volume", wireType)
+			}
+			m.Volume = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Volume |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UsdMills", wireType)
+			}
+			m.UsdMills = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UsdMills |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddFinalizeActivateMarkerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddFinalizeActivateMarkerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddFinalizeActivateMarkerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSupplyIncreaseProposalRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSupplyIncreaseProposalRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSupplyIncreaseProposalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TargetAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSupplyIncreaseProposalResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSupplyIncreaseProposalResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSupplyIncreaseProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSupplyDecreaseProposalRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSupplyDecreaseProposalRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSupplyDecreaseProposalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSupplyDecreaseProposalResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSupplyDecreaseProposalResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSupplyDecreaseProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateRequiredAttributesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateRequiredAttributesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateRequiredAttributesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoveRequiredAttributes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemoveRequiredAttributes = append(m.RemoveRequiredAttributes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddRequiredAttributes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AddRequiredAttributes = append(m.AddRequiredAttributes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferAuthority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TransferAuthority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoveRequiredSenderAttributes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemoveRequiredSenderAttributes = append(m.RemoveRequiredSenderAttributes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddRequiredSenderAttributes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AddRequiredSenderAttributes = append(m.AddRequiredSenderAttributes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateRequiredAttributesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateRequiredAttributesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateRequiredAttributesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateForcedTransferRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateForcedTransferRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateForcedTransferRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowForcedTransfer", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllowForcedTransfer = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateForcedTransferResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateForcedTransferResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateForcedTransferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetAccountDataRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetAccountDataRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetAccountDataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetAccountDataResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetAccountDataResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetAccountDataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateSendDenyListRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateSendDenyListRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateSendDenyListRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoveDeniedAddresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemoveDeniedAddresses = append(m.RemoveDeniedAddresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddDeniedAddresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AddDeniedAddresses = append(m.AddDeniedAddresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateSendDenyListResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateSendDenyListResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateSendDenyListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateDepositAllowListRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateDepositAllowListRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateDepositAllowListRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoveAllowedAddresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemoveAllowedAddresses = append(m.RemoveAllowedAddresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddAllowedAddresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AddAllowedAddresses = append(m.AddAllowedAddresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Administrator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateDepositAllowListResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateDepositAllowListResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateDepositAllowListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetFeeSponsorshipRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetFeeSponsorshipRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetFeeSponsorshipRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sponsorship", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Sponsorship.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Administrator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetFeeSponsorshipResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetFeeSponsorshipResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetFeeSponsorshipResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveFeeSponsorshipRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveFeeSponsorshipRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveFeeSponsorshipRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Administrator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveFeeSponsorshipResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveFeeSponsorshipResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveFeeSponsorshipResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaimFeeSponsorshipRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimFeeSponsorshipRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimFeeSponsorshipRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Holder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Holder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaimFeeSponsorshipResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimFeeSponsorshipResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimFeeSponsorshipResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgReleaseFeeSponsorshipHoldRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgReleaseFeeSponsorshipHoldRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgReleaseFeeSponsorshipHoldRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Holder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Holder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgReleaseFeeSponsorshipHoldResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgReleaseFeeSponsorshipHoldResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgReleaseFeeSponsorshipHoldResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetBridgeInfoRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetBridgeInfoRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetBridgeInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BridgeInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Administrator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetBridgeInfoResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetBridgeInfoResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetBridgeInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAttestedMintRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAttestedMintRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAttestedMintRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reference", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reference = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Administrator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	l = len(m.TargetAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
-}
-
-func (m *MsgWithdrawEscrowProposalResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *MsgSetDenomMetadataProposalRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Metadata.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
-}
 
-func (m *MsgSetDenomMetadataProposalResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
-
-func (m *MsgUpdateParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgAttestedMintResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAttestedMintResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAttestedMintResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgGrantAllowanceRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgSetBasketInfoRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7570,17 +21705,17 @@ func (m *MsgGrantAllowanceRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgGrantAllowanceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetBasketInfoRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgGrantAllowanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetBasketInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BasketInfo", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -7590,23 +21725,24 @@ func (m *MsgGrantAllowanceRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			if err := m.BasketInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -7635,16 +21771,116 @@ func (m *MsgGrantAllowanceRequest) Unmarshal(dAtA []byte) error {
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Administrator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetBasketInfoResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetBasketInfoResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetBasketInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetIBCMemoActionConfigRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Administrator = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetIBCMemoActionConfigRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetIBCMemoActionConfigRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -7654,29 +21890,30 @@ func (m *MsgGrantAllowanceRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Grantee = string(dAtA[iNdEx:postIndex])
+			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Allowance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -7686,27 +21923,23 @@ func (m *MsgGrantAllowanceRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Allowance == nil {
-				m.Allowance = &types.Any{}
-			}
-			if err := m.Allowance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Administrator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7729,7 +21962,7 @@ func (m *MsgGrantAllowanceRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgGrantAllowanceResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetIBCMemoActionConfigResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7752,10 +21985,10 @@ func (m *MsgGrantAllowanceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgGrantAllowanceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetIBCMemoActionConfigResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgGrantAllowanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetIBCMemoActionConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -7779,7 +22012,7 @@ func (m *MsgGrantAllowanceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddMarkerRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgBasketDepositRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7802,10 +22035,10 @@ func (m *MsgAddMarkerRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddMarkerRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgBasketDepositRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddMarkerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgBasketDepositRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7841,9 +22074,9 @@ func (m *MsgAddMarkerRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Manager", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7871,81 +22104,61 @@ func (m *MsgAddMarkerRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Manager = string(dAtA[iNdEx:postIndex])
+			m.Depositor = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FromAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
-			}
-			m.Status = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Status |= MarkerStatus(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBasketDepositResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarkerType", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			m.MarkerType = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MarkerType |= MarkerType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-		case 7:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBasketDepositResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBasketDepositResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccessList", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Components", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7972,56 +22185,66 @@ func (m *MsgAddMarkerRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AccessList = append(m.AccessList, AccessGrant{})
-			if err := m.AccessList[len(m.AccessList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Components = append(m.Components, types1.Coin{})
+			if err := m.Components[len(m.Components)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SupplyFixed", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
 			}
-			m.SupplyFixed = bool(v != 0)
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllowGovernanceControl", wireType)
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBasketWithdrawRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-			m.AllowGovernanceControl = bool(v != 0)
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllowForcedTransfer", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			var v int
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBasketWithdrawRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBasketWithdrawRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -8031,15 +22254,28 @@ func (m *MsgAddMarkerRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.AllowForcedTransfer = bool(v != 0)
-		case 11:
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequiredAttributes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Holder", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8067,65 +22303,8 @@ func (m *MsgAddMarkerRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RequiredAttributes = append(m.RequiredAttributes, string(dAtA[iNdEx:postIndex]))
+			m.Holder = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 12:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UsdCents", wireType)
-			}
-			m.UsdCents = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.UsdCents |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 13:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Volume", wireType)
-			}
-			m.Volume = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Volume |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 14:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UsdMills", wireType)
-			}
-			m.UsdMills = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.UsdMills |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -8147,7 +22326,7 @@ func (m *MsgAddMarkerRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddMarkerResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgBasketWithdrawResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8170,12 +22349,46 @@ func (m *MsgAddMarkerResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddMarkerResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgBasketWithdrawResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddMarkerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgBasketWithdrawResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Components", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Components = append(m.Components, types1.Coin{})
+			if err := m.Components[len(m.Components)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -8197,7 +22410,7 @@ func (m *MsgAddMarkerResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddAccessRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgAddNetAssetValuesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8220,10 +22433,10 @@ func (m *MsgAddAccessRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddAccessRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAddNetAssetValuesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddAccessRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAddNetAssetValuesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -8292,7 +22505,7 @@ func (m *MsgAddAccessRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Access", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NetAssetValues", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8319,8 +22532,8 @@ func (m *MsgAddAccessRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Access = append(m.Access, AccessGrant{})
-			if err := m.Access[len(m.Access)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.NetAssetValues = append(m.NetAssetValues, NetAssetValue{})
+			if err := m.NetAssetValues[len(m.NetAssetValues)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8345,7 +22558,7 @@ func (m *MsgAddAccessRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddAccessResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgAddNetAssetValuesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8368,10 +22581,10 @@ func (m *MsgAddAccessResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddAccessResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAddNetAssetValuesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddAccessResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAddNetAssetValuesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -8395,7 +22608,7 @@ func (m *MsgAddAccessResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteAccessRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgBindMarkerNameRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8418,10 +22631,10 @@ func (m *MsgDeleteAccessRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteAccessRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgBindMarkerNameRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteAccessRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgBindMarkerNameRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -8458,7 +22671,7 @@ func (m *MsgDeleteAccessRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Parent", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8486,11 +22699,11 @@ func (m *MsgDeleteAccessRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Administrator = string(dAtA[iNdEx:postIndex])
+			m.Parent = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RemovedAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8518,7 +22731,91 @@ func (m *MsgDeleteAccessRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RemovedAddress = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Restricted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Restricted = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Administrator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8541,7 +22838,7 @@ func (m *MsgDeleteAccessRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteAccessResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgBindMarkerNameResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8564,10 +22861,10 @@ func (m *MsgDeleteAccessResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteAccessResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgBindMarkerNameResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteAccessResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgBindMarkerNameResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -8591,7 +22888,7 @@ func (m *MsgDeleteAccessResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgFinalizeRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgDeleteMarkerNameRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8610,19 +22907,51 @@ func (m *MsgFinalizeRequest) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgFinalizeRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgFinalizeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteMarkerNameRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteMarkerNameRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8650,9 +22979,9 @@ func (m *MsgFinalizeRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
 			}
@@ -8705,7 +23034,7 @@ func (m *MsgFinalizeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgFinalizeResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgDeleteMarkerNameResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8728,10 +23057,10 @@ func (m *MsgFinalizeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgFinalizeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDeleteMarkerNameResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgFinalizeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDeleteMarkerNameResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -8755,7 +23084,7 @@ func (m *MsgFinalizeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgActivateRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgDelegateEscrowRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8778,10 +23107,10 @@ func (m *MsgActivateRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgActivateRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDelegateEscrowRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgActivateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDelegateEscrowRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -8817,6 +23146,71 @@ func (m *MsgActivateRequest) Unmarshal(dAtA []byte) error {
 			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
 			}
@@ -8869,7 +23263,7 @@ func (m *MsgActivateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgActivateResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgDelegateEscrowResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8892,10 +23286,10 @@ func (m *MsgActivateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgActivateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDelegateEscrowResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgActivateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDelegateEscrowResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -8919,7 +23313,7 @@ func (m *MsgActivateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCancelRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgUndelegateEscrowRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8942,10 +23336,10 @@ func (m *MsgCancelRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCancelRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUndelegateEscrowRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCancelRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUndelegateEscrowRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -8981,6 +23375,71 @@ func (m *MsgCancelRequest) Unmarshal(dAtA []byte) error {
 			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
 			}
@@ -9033,7 +23492,7 @@ func (m *MsgCancelRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCancelResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUndelegateEscrowResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9056,12 +23515,45 @@ func (m *MsgCancelResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCancelResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUndelegateEscrowResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCancelResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUndelegateEscrowResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -9083,7 +23575,7 @@ func (m *MsgCancelResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgCollectEscrowRewardsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9106,10 +23598,10 @@ func (m *MsgDeleteRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCollectEscrowRewardsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCollectEscrowRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9145,6 +23637,38 @@ func (m *MsgDeleteRequest) Unmarshal(dAtA []byte) error {
 			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
 			}
@@ -9197,7 +23721,7 @@ func (m *MsgDeleteRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCollectEscrowRewardsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9220,12 +23744,46 @@ func (m *MsgDeleteResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCollectEscrowRewardsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCollectEscrowRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types1.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -9247,7 +23805,7 @@ func (m *MsgDeleteResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgMintRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgSetMintAllowanceRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9270,10 +23828,10 @@ func (m *MsgMintRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgMintRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetMintAllowanceRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgMintRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetMintAllowanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9310,6 +23868,38 @@ func (m *MsgMintRequest) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Minter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Minter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
 			}
@@ -9362,7 +23952,7 @@ func (m *MsgMintRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgMintResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetMintAllowanceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9385,10 +23975,10 @@ func (m *MsgMintResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgMintResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetMintAllowanceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgMintResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetMintAllowanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -9412,7 +24002,7 @@ func (m *MsgMintResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgBurnRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgMintFromAllowanceRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9435,10 +24025,10 @@ func (m *MsgBurnRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBurnRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgMintFromAllowanceRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBurnRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgMintFromAllowanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9476,7 +24066,7 @@ func (m *MsgBurnRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Minter", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9504,7 +24094,7 @@ func (m *MsgBurnRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Administrator = string(dAtA[iNdEx:postIndex])
+			m.Minter = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9527,7 +24117,7 @@ func (m *MsgBurnRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgBurnResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgMintFromAllowanceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9550,12 +24140,45 @@ func (m *MsgBurnResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBurnResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgMintFromAllowanceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBurnResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgMintFromAllowanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Remaining", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Remaining.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -9577,7 +24200,7 @@ func (m *MsgBurnResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWithdrawRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgScheduleDistributionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9600,10 +24223,10 @@ func (m *MsgWithdrawRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWithdrawRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgScheduleDistributionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWithdrawRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgScheduleDistributionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9640,9 +24263,9 @@ func (m *MsgWithdrawRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -9652,29 +24275,30 @@ func (m *MsgWithdrawRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Administrator = string(dAtA[iNdEx:postIndex])
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ToAddress", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
 			}
-			var stringLen uint64
+			m.StartHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -9684,27 +24308,33 @@ func (m *MsgWithdrawRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.StartHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchSize", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.BatchSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BatchSize |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.ToAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinPayout", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9731,61 +24361,45 @@ func (m *MsgWithdrawRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = append(m.Amount, types1.Coin{})
-			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.MinPayout == nil {
+				m.MinPayout = &types1.Coin{}
+			}
+			if err := m.MinPayout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgWithdrawResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWithdrawResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWithdrawResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			m.Administrator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -9807,7 +24421,7 @@ func (m *MsgWithdrawResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgTransferRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgScheduleDistributionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9830,17 +24444,17 @@ func (m *MsgTransferRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgTransferRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgScheduleDistributionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgTransferRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgScheduleDistributionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributionId", wireType)
 			}
-			var msglen int
+			m.DistributionId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -9850,62 +24464,66 @@ func (m *MsgTransferRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.DistributionId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelDistributionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Administrator = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			var stringLen uint64
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelDistributionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelDistributionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributionId", wireType)
+			}
+			m.DistributionId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -9915,27 +24533,14 @@ func (m *MsgTransferRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.DistributionId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.FromAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ToAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9963,7 +24568,7 @@ func (m *MsgTransferRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ToAddress = string(dAtA[iNdEx:postIndex])
+			m.Administrator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9986,7 +24591,7 @@ func (m *MsgTransferRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgTransferResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelDistributionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10009,10 +24614,10 @@ func (m *MsgTransferResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgTransferResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelDistributionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgTransferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelDistributionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -10036,7 +24641,7 @@ func (m *MsgTransferResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgIbcTransferRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgClaimDistributionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10059,17 +24664,17 @@ func (m *MsgIbcTransferRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgIbcTransferRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgClaimDistributionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgIbcTransferRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgClaimDistributionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Transfer", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributionId", wireType)
 			}
-			var msglen int
+			m.DistributionId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -10079,28 +24684,14 @@ func (m *MsgIbcTransferRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.DistributionId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Transfer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Claimant", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10128,7 +24719,7 @@ func (m *MsgIbcTransferRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Administrator = string(dAtA[iNdEx:postIndex])
+			m.Claimant = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10151,7 +24742,7 @@ func (m *MsgIbcTransferRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgIbcTransferResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgClaimDistributionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10174,12 +24765,45 @@ func (m *MsgIbcTransferResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgIbcTransferResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgClaimDistributionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgIbcTransferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgClaimDistributionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -10201,7 +24825,7 @@ func (m *MsgIbcTransferResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetDenomMetadataRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgAllocateEscrowRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10224,15 +24848,79 @@ func (m *MsgSetDenomMetadataRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetDenomMetadataRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAllocateEscrowRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetDenomMetadataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAllocateEscrowRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ledger", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ledger = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10259,11 +24947,12 @@ func (m *MsgSetDenomMetadataRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Amount = append(m.Amount, types1.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
 			}
@@ -10316,7 +25005,7 @@ func (m *MsgSetDenomMetadataRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetDenomMetadataResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgAllocateEscrowResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10339,10 +25028,10 @@ func (m *MsgSetDenomMetadataResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetDenomMetadataResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAllocateEscrowResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetDenomMetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAllocateEscrowResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -10365,72 +25054,39 @@ func (m *MsgSetDenomMetadataResponse) Unmarshal(dAtA []byte) error {
 		return io.ErrUnexpectedEOF
 	}
 	return nil
-}
-func (m *MsgAddFinalizeActivateMarkerRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddFinalizeActivateMarkerRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddFinalizeActivateMarkerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+}
+func (m *MsgReleaseEscrowRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 3:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgReleaseEscrowRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgReleaseEscrowRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Manager", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10458,11 +25114,11 @@ func (m *MsgAddFinalizeActivateMarkerRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Manager = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Ledger", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10490,30 +25146,11 @@ func (m *MsgAddFinalizeActivateMarkerRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FromAddress = string(dAtA[iNdEx:postIndex])
+			m.Ledger = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarkerType", wireType)
-			}
-			m.MarkerType = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MarkerType |= MarkerType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 6:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccessList", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10540,74 +25177,14 @@ func (m *MsgAddFinalizeActivateMarkerRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AccessList = append(m.AccessList, AccessGrant{})
-			if err := m.AccessList[len(m.AccessList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Amount = append(m.Amount, types1.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SupplyFixed", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.SupplyFixed = bool(v != 0)
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllowGovernanceControl", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.AllowGovernanceControl = bool(v != 0)
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllowForcedTransfer", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.AllowForcedTransfer = bool(v != 0)
-		case 10:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequiredAttributes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10635,65 +25212,8 @@ func (m *MsgAddFinalizeActivateMarkerRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RequiredAttributes = append(m.RequiredAttributes, string(dAtA[iNdEx:postIndex]))
+			m.Administrator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UsdCents", wireType)
-			}
-			m.UsdCents = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.UsdCents |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 12:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Volume", wireType)
-			}
-			m.Volume = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Volume |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 13:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UsdMills", wireType)
-			}
-			m.UsdMills = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.UsdMills |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -10715,7 +25235,7 @@ func (m *MsgAddFinalizeActivateMarkerRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddFinalizeActivateMarkerResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgReleaseEscrowResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10738,10 +25258,10 @@ func (m *MsgAddFinalizeActivateMarkerResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddFinalizeActivateMarkerResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgReleaseEscrowResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddFinalizeActivateMarkerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgReleaseEscrowResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -10765,7 +25285,7 @@ func (m *MsgAddFinalizeActivateMarkerResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSupplyIncreaseProposalRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgSetEscrowWithdrawLimitRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10788,17 +25308,81 @@ func (m *MsgSupplyIncreaseProposalRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSupplyIncreaseProposalRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetEscrowWithdrawLimitRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSupplyIncreaseProposalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetEscrowWithdrawLimitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ledger", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ledger = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -10808,30 +25392,29 @@ func (m *MsgSupplyIncreaseProposalRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Grantee = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -10841,27 +25424,29 @@ func (m *MsgSupplyIncreaseProposalRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TargetAddress = string(dAtA[iNdEx:postIndex])
+			m.Limit = append(m.Limit, types1.Coin{})
+			if err := m.Limit[len(m.Limit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10889,7 +25474,7 @@ func (m *MsgSupplyIncreaseProposalRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.Administrator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10912,7 +25497,7 @@ func (m *MsgSupplyIncreaseProposalRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSupplyIncreaseProposalResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetEscrowWithdrawLimitResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10935,10 +25520,10 @@ func (m *MsgSupplyIncreaseProposalResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSupplyIncreaseProposalResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetEscrowWithdrawLimitResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSupplyIncreaseProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetEscrowWithdrawLimitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -10962,7 +25547,7 @@ func (m *MsgSupplyIncreaseProposalResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSupplyDecreaseProposalRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgWithdrawFromEscrowRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10985,13 +25570,77 @@ func (m *MsgSupplyDecreaseProposalRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSupplyDecreaseProposalRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWithdrawFromEscrowRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSupplyDecreaseProposalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWithdrawFromEscrowRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ledger", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ledger = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
@@ -11020,13 +25669,14 @@ func (m *MsgSupplyDecreaseProposalRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Amount = append(m.Amount, types1.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ToAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11054,7 +25704,39 @@ func (m *MsgSupplyDecreaseProposalRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.ToAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Administrator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -11077,7 +25759,7 @@ func (m *MsgSupplyDecreaseProposalRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSupplyDecreaseProposalResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgWithdrawFromEscrowResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11100,12 +25782,46 @@ func (m *MsgSupplyDecreaseProposalResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSupplyDecreaseProposalResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWithdrawFromEscrowResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSupplyDecreaseProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWithdrawFromEscrowResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemainingLimit = append(m.RemainingLimit, types1.Coin{})
+			if err := m.RemainingLimit[len(m.RemainingLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -11127,7 +25843,7 @@ func (m *MsgSupplyDecreaseProposalResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateRequiredAttributesRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgScheduleBurnRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11150,17 +25866,17 @@ func (m *MsgUpdateRequiredAttributesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateRequiredAttributesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgScheduleBurnRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateRequiredAttributesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgScheduleBurnRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -11170,29 +25886,30 @@ func (m *MsgUpdateRequiredAttributesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RemoveRequiredAttributes", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnHeight", wireType)
 			}
-			var stringLen uint64
+			m.BurnHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -11202,29 +25919,16 @@ func (m *MsgUpdateRequiredAttributesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.BurnHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.RemoveRequiredAttributes = append(m.RemoveRequiredAttributes, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AddRequiredAttributes", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CancelWindow", wireType)
 			}
-			var stringLen uint64
+			m.CancelWindow = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -11234,27 +25938,14 @@ func (m *MsgUpdateRequiredAttributesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.CancelWindow |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.AddRequiredAttributes = append(m.AddRequiredAttributes, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TransferAuthority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11282,7 +25973,7 @@ func (m *MsgUpdateRequiredAttributesRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TransferAuthority = string(dAtA[iNdEx:postIndex])
+			m.Administrator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -11305,7 +25996,7 @@ func (m *MsgUpdateRequiredAttributesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateRequiredAttributesResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgScheduleBurnResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11328,12 +26019,31 @@ func (m *MsgUpdateRequiredAttributesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateRequiredAttributesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgScheduleBurnResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateRequiredAttributesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgScheduleBurnResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnId", wireType)
+			}
+			m.BurnId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BurnId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -11355,7 +26065,7 @@ func (m *MsgUpdateRequiredAttributesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateForcedTransferRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelScheduledBurnRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11378,49 +26088,17 @@ func (m *MsgUpdateForcedTransferRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateForcedTransferRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelScheduledBurnRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateForcedTransferRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelScheduledBurnRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllowForcedTransfer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnId", wireType)
 			}
-			var v int
+			m.BurnId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -11430,15 +26108,14 @@ func (m *MsgUpdateForcedTransferRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.BurnId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.AllowForcedTransfer = bool(v != 0)
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11466,7 +26143,7 @@ func (m *MsgUpdateForcedTransferRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.Administrator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -11489,7 +26166,7 @@ func (m *MsgUpdateForcedTransferRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateForcedTransferResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelScheduledBurnResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11512,10 +26189,10 @@ func (m *MsgUpdateForcedTransferResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateForcedTransferResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelScheduledBurnResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateForcedTransferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelScheduledBurnResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -11539,7 +26216,7 @@ func (m *MsgUpdateForcedTransferResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetAccountDataRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgExchangeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11562,15 +26239,15 @@ func (m *MsgSetAccountDataRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetAccountDataRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgExchangeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetAccountDataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgExchangeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PartyA", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11598,13 +26275,13 @@ func (m *MsgSetAccountDataRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.PartyA = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AmountA", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -11614,27 +26291,28 @@ func (m *MsgSetAccountDataRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			if err := m.AmountA.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PartyB", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11662,7 +26340,40 @@ func (m *MsgSetAccountDataRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signer = string(dAtA[iNdEx:postIndex])
+			m.PartyB = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmountB", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AmountB.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -11685,7 +26396,7 @@ func (m *MsgSetAccountDataRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetAccountDataResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgExchangeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11708,10 +26419,10 @@ func (m *MsgSetAccountDataResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetAccountDataResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgExchangeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetAccountDataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgExchangeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -11735,7 +26446,7 @@ func (m *MsgSetAccountDataResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateSendDenyListRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgSetAdministratorProposalRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11758,10 +26469,10 @@ func (m *MsgUpdateSendDenyListRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateSendDenyListRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetAdministratorProposalRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateSendDenyListRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetAdministratorProposalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -11798,9 +26509,9 @@ func (m *MsgUpdateSendDenyListRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RemoveDeniedAddresses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Access", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -11810,57 +26521,27 @@ func (m *MsgUpdateSendDenyListRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RemoveDeniedAddresses = append(m.RemoveDeniedAddresses, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AddDeniedAddresses", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.Access = append(m.Access, AccessGrant{})
+			if err := m.Access[len(m.Access)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.AddDeniedAddresses = append(m.AddDeniedAddresses, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
@@ -11913,7 +26594,7 @@ func (m *MsgUpdateSendDenyListRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateSendDenyListResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetAdministratorProposalResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11936,10 +26617,10 @@ func (m *MsgUpdateSendDenyListResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateSendDenyListResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetAdministratorProposalResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateSendDenyListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetAdministratorProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -11963,7 +26644,7 @@ func (m *MsgUpdateSendDenyListResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddNetAssetValuesRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveAdministratorProposalRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11986,10 +26667,10 @@ func (m *MsgAddNetAssetValuesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddNetAssetValuesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveAdministratorProposalRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddNetAssetValuesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveAdministratorProposalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -12026,7 +26707,7 @@ func (m *MsgAddNetAssetValuesRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RemovedAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12054,13 +26735,13 @@ func (m *MsgAddNetAssetValuesRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Administrator = string(dAtA[iNdEx:postIndex])
+			m.RemovedAddress = append(m.RemovedAddress, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NetAssetValues", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -12070,25 +26751,23 @@ func (m *MsgAddNetAssetValuesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NetAssetValues = append(m.NetAssetValues, NetAssetValue{})
-			if err := m.NetAssetValues[len(m.NetAssetValues)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -12111,7 +26790,7 @@ func (m *MsgAddNetAssetValuesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddNetAssetValuesResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveAdministratorProposalResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12134,10 +26813,10 @@ func (m *MsgAddNetAssetValuesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddNetAssetValuesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveAdministratorProposalResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddNetAssetValuesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveAdministratorProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -12161,7 +26840,7 @@ func (m *MsgAddNetAssetValuesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetAdministratorProposalRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgChangeStatusProposalRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12184,10 +26863,10 @@ func (m *MsgSetAdministratorProposalRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetAdministratorProposalRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgChangeStatusProposalRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetAdministratorProposalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgChangeStatusProposalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -12223,10 +26902,10 @@ func (m *MsgSetAdministratorProposalRequest) Unmarshal(dAtA []byte) error {
 			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Access", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewStatus", wireType)
 			}
-			var msglen int
+			m.NewStatus = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -12236,26 +26915,11 @@ func (m *MsgSetAdministratorProposalRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.NewStatus |= MarkerStatus(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Access = append(m.Access, AccessGrant{})
-			if err := m.Access[len(m.Access)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
@@ -12309,7 +26973,7 @@ func (m *MsgSetAdministratorProposalRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetAdministratorProposalResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgChangeStatusProposalResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12332,10 +26996,10 @@ func (m *MsgSetAdministratorProposalResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetAdministratorProposalResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgChangeStatusProposalResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetAdministratorProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgChangeStatusProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -12359,7 +27023,7 @@ func (m *MsgSetAdministratorProposalResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRemoveAdministratorProposalRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgWithdrawEscrowProposalRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12382,17 +27046,49 @@ func (m *MsgRemoveAdministratorProposalRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemoveAdministratorProposalRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWithdrawEscrowProposalRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemoveAdministratorProposalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWithdrawEscrowProposalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -12402,27 +27098,29 @@ func (m *MsgRemoveAdministratorProposalRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.Amount = append(m.Amount, types1.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RemovedAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12450,9 +27148,9 @@ func (m *MsgRemoveAdministratorProposalRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RemovedAddress = append(m.RemovedAddress, string(dAtA[iNdEx:postIndex]))
+			m.TargetAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
@@ -12505,7 +27203,7 @@ func (m *MsgRemoveAdministratorProposalRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRemoveAdministratorProposalResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgWithdrawEscrowProposalResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12528,10 +27226,10 @@ func (m *MsgRemoveAdministratorProposalResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemoveAdministratorProposalResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWithdrawEscrowProposalResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemoveAdministratorProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWithdrawEscrowProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -12555,7 +27253,7 @@ func (m *MsgRemoveAdministratorProposalResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgChangeStatusProposalRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgSetDenomMetadataProposalRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12578,17 +27276,17 @@ func (m *MsgChangeStatusProposalRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgChangeStatusProposalRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetDenomMetadataProposalRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgChangeStatusProposalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetDenomMetadataProposalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -12598,44 +27296,26 @@ func (m *MsgChangeStatusProposalRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewStatus", wireType)
-			}
-			m.NewStatus = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.NewStatus |= MarkerStatus(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
@@ -12688,7 +27368,7 @@ func (m *MsgChangeStatusProposalRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgChangeStatusProposalResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetDenomMetadataProposalResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12711,10 +27391,10 @@ func (m *MsgChangeStatusProposalResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgChangeStatusProposalResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetDenomMetadataProposalResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgChangeStatusProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetDenomMetadataProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -12738,7 +27418,7 @@ func (m *MsgChangeStatusProposalResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWithdrawEscrowProposalRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateParamsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12761,15 +27441,15 @@ func (m *MsgWithdrawEscrowProposalRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWithdrawEscrowProposalRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateParamsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWithdrawEscrowProposalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12797,11 +27477,11 @@ func (m *MsgWithdrawEscrowProposalRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12828,75 +27508,10 @@ func (m *MsgWithdrawEscrowProposalRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = append(m.Amount, types1.Coin{})
-			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetAddress", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.TargetAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -12918,7 +27533,7 @@ func (m *MsgWithdrawEscrowProposalRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWithdrawEscrowProposalResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12941,10 +27556,10 @@ func (m *MsgWithdrawEscrowProposalResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWithdrawEscrowProposalResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWithdrawEscrowProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -12968,7 +27583,7 @@ func (m *MsgWithdrawEscrowProposalResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetDenomMetadataProposalRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateSendRestrictionBypassesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12991,15 +27606,47 @@ func (m *MsgSetDenomMetadataProposalRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetDenomMetadataProposalRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateSendRestrictionBypassesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetDenomMetadataProposalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateSendRestrictionBypassesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Set", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13026,13 +27673,14 @@ func (m *MsgSetDenomMetadataProposalRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Set = append(m.Set, SendRestrictionBypass{})
+			if err := m.Set[len(m.Set)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Remove", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13060,7 +27708,7 @@ func (m *MsgSetDenomMetadataProposalRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.Remove = append(m.Remove, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -13083,7 +27731,7 @@ func (m *MsgSetDenomMetadataProposalRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetDenomMetadataProposalResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateSendRestrictionBypassesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13106,10 +27754,10 @@ func (m *MsgSetDenomMetadataProposalResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetDenomMetadataProposalResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateSendRestrictionBypassesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetDenomMetadataProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateSendRestrictionBypassesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -13133,7 +27781,7 @@ func (m *MsgSetDenomMetadataProposalResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgChangeMarkerTypeProposalRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13156,15 +27804,15 @@ func (m *MsgUpdateParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgChangeMarkerTypeProposalRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgChangeMarkerTypeProposalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13192,13 +27840,32 @@ func (m *MsgUpdateParamsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewType", wireType)
+			}
+			m.NewType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NewType |= MarkerType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -13208,24 +27875,23 @@ func (m *MsgUpdateParamsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -13248,7 +27914,7 @@ func (m *MsgUpdateParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgChangeMarkerTypeProposalResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13271,10 +27937,10 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgChangeMarkerTypeProposalResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgChangeMarkerTypeProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -0,0 +1,65 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
+
+	"github.com/provenance-io/provenance/x/meta"
+)
+
+// QueryCmd returns the command for querying what the chain's provenance modules support.
+func QueryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        "meta",
+		Short:                      "Querying commands that describe the provenance modules",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		QueryCmdCapabilities(),
+	)
+
+	return cmd
+}
+
+// QueryCmdCapabilities returns the command for querying the capabilities of the provenance modules.
+func QueryCmdCapabilities() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "capabilities [module]",
+		Aliases: []string{"caps"},
+		Short:   "Get the consensus version, enabled features, and accepted messages of the provenance modules",
+		Example: fmt.Sprintf(`$ %[1]s query meta capabilities
+$ %[1]s query meta capabilities marker`, version.AppName),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			req := &meta.QueryCapabilitiesRequest{}
+			if len(args) > 0 {
+				req.Module = args[0]
+			}
+
+			queryClient := meta.NewQueryClient(clientCtx)
+			res, err := queryClient.Capabilities(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,17 @@
+package meta
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+)
+
+// MsgRouter is used to check which messages have a handler.
+type MsgRouter interface {
+	HandlerByTypeURL(typeURL string) baseapp.MsgServiceHandler
+}
+
+// CircuitKeeper is used to look up the messages that are disabled.
+type CircuitKeeper interface {
+	IsAllowed(ctx context.Context, msgURL string) (bool, error)
+}
@@ -0,0 +1,38 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/meta"
+)
+
+var _ meta.QueryServer = Keeper{}
+
+// Capabilities returns the consensus version, enabled features, and accepted messages of each provenance module.
+func (k Keeper) Capabilities(goCtx context.Context, req *meta.QueryCapabilitiesRequest) (*meta.QueryCapabilitiesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	resp := &meta.QueryCapabilitiesResponse{}
+	for _, info := range k.modules {
+		if len(req.Module) > 0 && info.name != req.Module {
+			continue
+		}
+		capabilities, err := k.getModuleCapabilities(ctx, info)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		resp.Modules = append(resp.Modules, capabilities)
+	}
+	if len(req.Module) > 0 && len(resp.Modules) == 0 {
+		return nil, status.Errorf(codes.NotFound, "unknown module %q", req.Module)
+	}
+	return resp, nil
+}
@@ -0,0 +1,98 @@
+package keeper
+
+import (
+	"sort"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+
+	"github.com/provenance-io/provenance/x/meta"
+)
+
+// Keeper provides the meta query service. It doesn't have any state of its own,
+// it describes the modules that it is created with.
+type Keeper struct {
+	modules       []moduleInfo
+	circuitKeeper meta.CircuitKeeper
+}
+
+// hasRegisterInterfaces is implemented by modules that register their messages with an interface registry.
+type hasRegisterInterfaces interface {
+	RegisterInterfaces(registry codectypes.InterfaceRegistry)
+}
+
+// moduleInfo is the information about a module that doesn't change while the chain is running.
+type moduleInfo struct {
+	name             string
+	consensusVersion uint64
+	msgTypes         []string
+	features         meta.HasFeatures
+}
+
+// NewKeeper creates a new Keeper that describes the provided modules (by name).
+// The messages a module accepts are those it registers that also have a handler in the router.
+func NewKeeper(modules map[string]interface{}, router meta.MsgRouter, circuitKeeper meta.CircuitKeeper) Keeper {
+	rv := Keeper{circuitKeeper: circuitKeeper}
+	for name, mod := range modules {
+		info := moduleInfo{name: name}
+		if hasVersion, ok := mod.(module.HasConsensusVersion); ok {
+			info.consensusVersion = hasVersion.ConsensusVersion()
+		}
+		if hasInterfaces, ok := mod.(hasRegisterInterfaces); ok {
+			info.msgTypes = getMsgTypes(hasInterfaces, router)
+		}
+		if hasFeatures, ok := mod.(meta.HasFeatures); ok {
+			info.features = hasFeatures
+		}
+		rv.modules = append(rv.modules, info)
+	}
+	sort.Slice(rv.modules, func(i, j int) bool {
+		return rv.modules[i].name < rv.modules[j].name
+	})
+	return rv
+}
+
+// getMsgTypes returns the sorted type urls of the messages that a module registers and that have a handler.
+func getMsgTypes(mod hasRegisterInterfaces, router meta.MsgRouter) []string {
+	registry := codectypes.NewInterfaceRegistry()
+	mod.RegisterInterfaces(registry)
+	var rv []string
+	for _, typeURL := range registry.ListImplementations(sdk.MsgInterfaceProtoName) {
+		if router.HandlerByTypeURL(typeURL) != nil {
+			rv = append(rv, typeURL)
+		}
+	}
+	sort.Strings(rv)
+	return rv
+}
+
+// getModuleCapabilities returns the capabilities of a module as they currently are.
+// Messages that the circuit breaker has disabled are left out.
+func (k Keeper) getModuleCapabilities(ctx sdk.Context, info moduleInfo) (meta.ModuleCapabilities, error) {
+	rv := meta.ModuleCapabilities{
+		Module:           info.name,
+		ConsensusVersion: info.consensusVersion,
+	}
+	for _, typeURL := range info.msgTypes {
+		allowed, err := k.isAllowed(ctx, typeURL)
+		if err != nil {
+			return rv, err
+		}
+		if allowed {
+			rv.MsgTypes = append(rv.MsgTypes, typeURL)
+		}
+	}
+	if info.features != nil {
+		rv.Features = info.features.Features(ctx)
+	}
+	return rv, nil
+}
+
+// isAllowed returns true if the circuit breaker hasn't disabled the given message type.
+func (k Keeper) isAllowed(ctx sdk.Context, typeURL string) (bool, error) {
+	if k.circuitKeeper == nil {
+		return true, nil
+	}
+	return k.circuitKeeper.IsAllowed(ctx, typeURL)
+}
@@ -0,0 +1,119 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/provenance-io/provenance/x/meta"
+	"github.com/provenance-io/provenance/x/meta/keeper"
+)
+
+// mockModule is a module with a consensus version, some messages, and (optionally) some features.
+type mockModule struct {
+	version uint64
+	msgs    []sdk.Msg
+}
+
+func (m mockModule) ConsensusVersion() uint64 { return m.version }
+
+func (m mockModule) RegisterInterfaces(registry codectypes.InterfaceRegistry) {
+	registry.RegisterImplementations((*sdk.Msg)(nil), m.msgs...)
+}
+
+// mockFeaturesModule is a mockModule that also has features.
+type mockFeaturesModule struct {
+	mockModule
+	features []string
+}
+
+func (m mockFeaturesModule) Features(_ sdk.Context) []string { return m.features }
+
+// mockRouter has a handler for each of its type urls.
+type mockRouter map[string]bool
+
+func (r mockRouter) HandlerByTypeURL(typeURL string) baseapp.MsgServiceHandler {
+	if !r[typeURL] {
+		return nil
+	}
+	return func(_ sdk.Context, _ sdk.Msg) (*sdk.Result, error) { return nil, nil }
+}
+
+// mockCircuitKeeper disallows each of its type urls.
+type mockCircuitKeeper map[string]bool
+
+func (k mockCircuitKeeper) IsAllowed(_ context.Context, msgURL string) (bool, error) {
+	return !k[msgURL], nil
+}
+
+func TestCapabilities(t *testing.T) {
+	sendURL := sdk.MsgTypeURL(&banktypes.MsgSend{})
+	multiSendURL := sdk.MsgTypeURL(&banktypes.MsgMultiSend{})
+
+	modules := map[string]interface{}{
+		"second": mockFeaturesModule{
+			mockModule: mockModule{version: 3, msgs: []sdk.Msg{&banktypes.MsgSend{}, &banktypes.MsgMultiSend{}, &banktypes.MsgUpdateParams{}}},
+			features:   []string{"feature-a"},
+		},
+		"first": mockModule{version: 1},
+	}
+	// MsgUpdateParams doesn't have a handler, and MsgMultiSend is disabled, so only MsgSend is accepted.
+	router := mockRouter{sendURL: true, multiSendURL: true}
+	k := keeper.NewKeeper(modules, router, mockCircuitKeeper{multiSendURL: true})
+	ctx := sdk.Context{}
+
+	expFirst := meta.ModuleCapabilities{Module: "first", ConsensusVersion: 1}
+	expSecond := meta.ModuleCapabilities{
+		Module:           "second",
+		ConsensusVersion: 3,
+		Features:         []string{"feature-a"},
+		MsgTypes:         []string{sendURL},
+	}
+
+	tests := []struct {
+		name   string
+		req    *meta.QueryCapabilitiesRequest
+		exp    []meta.ModuleCapabilities
+		expErr string
+	}{
+		{
+			name:   "nil request",
+			req:    nil,
+			expErr: "rpc error: code = InvalidArgument desc = empty request",
+		},
+		{
+			name: "all modules",
+			req:  &meta.QueryCapabilitiesRequest{},
+			exp:  []meta.ModuleCapabilities{expFirst, expSecond},
+		},
+		{
+			name: "one module",
+			req:  &meta.QueryCapabilitiesRequest{Module: "second"},
+			exp:  []meta.ModuleCapabilities{expSecond},
+		},
+		{
+			name:   "unknown module",
+			req:    &meta.QueryCapabilitiesRequest{Module: "third"},
+			expErr: `rpc error: code = NotFound desc = unknown module "third"`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			resp, err := k.Capabilities(ctx, tc.req)
+			if len(tc.expErr) > 0 {
+				require.EqualError(t, err, tc.expErr, "Capabilities error")
+				return
+			}
+			require.NoError(t, err, "Capabilities error")
+			assert.Equal(t, tc.exp, resp.Modules, "Capabilities modules")
+		})
+	}
+}
@@ -0,0 +1,11 @@
+package meta
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// HasFeatures is implemented by modules that have optional features that can be turned on and off using their params.
+type HasFeatures interface {
+	// Features returns the names of the module's optional features that are currently enabled.
+	Features(ctx sdk.Context) []string
+}
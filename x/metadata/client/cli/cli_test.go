@@ -332,7 +332,7 @@ value_owner_address: %s`,
 		s.user2AddrStr,
 	)
 
-	s.sessionAsJson = fmt.Sprintf("{\"session_id\":\"%s\",\"specification_id\":\"%s\",\"parties\":[{\"address\":\"%s\",\"role\":\"PARTY_TYPE_OWNER\",\"optional\":false}],\"name\":\"unit test session\",\"context\":null,\"audit\":{\"created_date\":\"0001-01-01T00:00:00Z\",\"created_by\":\"%s\",\"updated_date\":\"0001-01-01T00:00:00Z\",\"updated_by\":\"\",\"version\":0,\"message\":\"unit testing\"}}",
+	s.sessionAsJson = fmt.Sprintf("{\"session_id\":\"%s\",\"specification_id\":\"%s\",\"parties\":[{\"address\":\"%s\",\"role\":\"PARTY_TYPE_OWNER\",\"optional\":false}],\"name\":\"unit test session\",\"context\":null,\"status\":\"SESSION_STATUS_UNSPECIFIED\",\"audit\":{\"created_date\":\"0001-01-01T00:00:00Z\",\"created_by\":\"%s\",\"updated_date\":\"0001-01-01T00:00:00Z\",\"updated_by\":\"\",\"version\":0,\"message\":\"unit testing\"}}",
 		s.sessionID,
 		s.contractSpecID,
 		s.user1AddrStr,
@@ -400,17 +400,19 @@ specification_id: %s`,
 		s.scopeSpecID,
 	)
 
-	s.contractSpecAsJson = fmt.Sprintf("{\"specification_id\":\"%s\",\"description\":null,\"owner_addresses\":[\"%s\"],\"parties_involved\":[\"PARTY_TYPE_OWNER\"],\"hash\":\"notreallyasourcehash\",\"class_name\":\"contractclassname\"}",
+	s.contractSpecAsJson = fmt.Sprintf("{\"specification_id\":\"%s\",\"description\":null,\"owner_addresses\":[\"%s\"],\"parties_involved\":[\"PARTY_TYPE_OWNER\"],\"hash\":\"notreallyasourcehash\",\"class_name\":\"contractclassname\",\"deprecated\":false,\"replacement_id\":\"\"}",
 		s.contractSpecID,
 		s.user1AddrStr,
 	)
 	s.contractSpecAsText = fmt.Sprintf(`class_name: contractclassname
+deprecated: false
 description: null
 hash: notreallyasourcehash
 owner_addresses:
 - %s
 parties_involved:
 - PARTY_TYPE_OWNER
+replacement_id: ""
 specification_id: %s`,
 		s.user1AddrStr,
 		s.contractSpecID,
@@ -439,6 +441,7 @@ type_name: recordtypename`,
 			eKey = "\"\""
 		}
 		return fmt.Sprintf(`encryption_key: %s
+failover_uris: []
 locator_uri: %s
 owner: %s`,
 			eKey,
@@ -447,7 +450,7 @@ owner: %s`,
 		)
 	}
 	locAsJson := func(loc metadatatypes.ObjectStoreLocator) string {
-		return fmt.Sprintf("{\"owner\":\"%s\",\"locator_uri\":\"%s\",\"encryption_key\":\"%s\"}",
+		return fmt.Sprintf("{\"owner\":\"%s\",\"locator_uri\":\"%s\",\"encryption_key\":\"%s\",\"failover_uris\":[]}",
 			loc.Owner,
 			loc.LocatorUri,
 			loc.EncryptionKey,
@@ -1648,6 +1651,50 @@ func (s *IntegrationCLITestSuite) TestGetValueOwnershipCmd() {
 	runQueryCmdTestCases(s, cmd, testCases)
 }
 
+func (s *IntegrationCLITestSuite) TestGetSpecOwnershipCmd() {
+	cmd := func() *cobra.Command { return cli.GetSpecOwnershipCmd() }
+
+	testCases := []queryCmdTestCase{
+		{
+			name:   "scope specs as json",
+			args:   []string{"scopespec", s.user1AddrStr, s.asJson},
+			expOut: []string{s.scopeSpecAsJson},
+		},
+		{
+			name:   "scope specs as text",
+			args:   []string{"scopespec", s.user1AddrStr, s.asText},
+			expOut: []string{indent(s.scopeSpecAsText, 4)},
+		},
+		{
+			name:   "contract specs as json",
+			args:   []string{"contractspec", s.user1AddrStr, s.asJson},
+			expOut: []string{s.contractSpecAsJson},
+		},
+		{
+			name:   "record specs as json",
+			args:   []string{"recordspec", s.user1AddrStr, s.asJson},
+			expOut: []string{s.recordSpecAsJson},
+		},
+		{
+			name:   "no result",
+			args:   []string{"contractspec", s.user2AddrStr},
+			expOut: []string{"contract_specifications: []", "total: \"0\""},
+		},
+		{
+			name:   "unknown spec type",
+			args:   []string{"scope", s.user1AddrStr},
+			expErr: `unknown specification type "scope": must be one of scopespec, contractspec, or recordspec`,
+		},
+		{
+			name:   "one arg",
+			args:   []string{s.user1AddrStr},
+			expErr: "accepts 2 arg(s), received 1",
+		},
+	}
+
+	runQueryCmdTestCases(s, cmd, testCases)
+}
+
 func (s *IntegrationCLITestSuite) TestGetOSLocatorCmd() {
 	cmd := func() *cobra.Command { return cli.GetOSLocatorCmd() }
 
@@ -2743,6 +2790,31 @@ func (s *IntegrationCLITestSuite) TestContractSpecificationTxCommands() {
 			},
 			expectedCode: 0,
 		},
+		{
+			name: "should successfully deprecate contract specification",
+			cmd:  cli.DeprecateContractSpecificationCmd,
+			args: []string{
+				specificationID.String(),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.accountAddrStr),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewInt64Coin(s.cfg.BondDenom, 10)).String()),
+			},
+			expectedCode: 0,
+		},
+		{
+			name: "should fail to deprecate contract specification invalid replacement id",
+			cmd:  cli.DeprecateContractSpecificationCmd,
+			args: []string{
+				specificationID.String(),
+				"not-a-id",
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.accountAddrStr),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewInt64Coin(s.cfg.BondDenom, 10)).String()),
+			},
+			expectErrMsg: "decoding bech32 failed: invalid separator index -1",
+		},
 		{
 			name: "should successfully remove contract specification",
 			cmd:  removeCommand,
@@ -543,9 +543,10 @@ func TestParseRecordInputStatus(t *testing.T) {
 	unspecified := types.RecordInputStatus_Unknown
 	proposed := types.RecordInputStatus_Proposed
 	record := types.RecordInputStatus_Record
+	ibc := types.RecordInputStatus_IBC
 
 	// If this fails, add some unit tests for the new value(s), then update the expected length here.
-	assert.Len(t, types.RecordInputStatus_name, 3, "types.RecordInputStatus_name")
+	assert.Len(t, types.RecordInputStatus_name, 4, "types.RecordInputStatus_name")
 
 	tests := []struct {
 		input  string
@@ -558,6 +559,9 @@ func TestParseRecordInputStatus(t *testing.T) {
 		{input: "record", exp: record, expErr: ""},
 		{input: "RECORD", exp: record, expErr: ""},
 		{input: "Record", exp: record, expErr: ""},
+		{input: "ibc", exp: ibc, expErr: ""},
+		{input: "IBC", exp: ibc, expErr: ""},
+		{input: "Ibc", exp: ibc, expErr: ""},
 
 		{input: "record_input_status_proposed", exp: proposed, expErr: ""},
 		{input: "RECORD_INPUT_STATUS_PROPOSED", exp: proposed, expErr: ""},
@@ -565,6 +569,9 @@ func TestParseRecordInputStatus(t *testing.T) {
 		{input: "record_input_status_record", exp: record, expErr: ""},
 		{input: "RECORD_INPUT_STATUS_RECORD", exp: record, expErr: ""},
 		{input: "Record_Input_Status_Record", exp: record, expErr: ""},
+		{input: "record_input_status_ibc", exp: ibc, expErr: ""},
+		{input: "RECORD_INPUT_STATUS_IBC", exp: ibc, expErr: ""},
+		{input: "Record_Input_Status_Ibc", exp: ibc, expErr: ""},
 
 		{input: "unspecified", exp: unspecified, expErr: `unknown record input status: "unspecified"`},
 		{input: "UNSPECIFIED", exp: unspecified, expErr: `unknown record input status: "UNSPECIFIED"`},
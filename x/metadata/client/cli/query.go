@@ -15,6 +15,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/bech32"
 	"github.com/cosmos/cosmos-sdk/version"
 
+	"github.com/provenance-io/provenance/internal/provcli"
 	"github.com/provenance-io/provenance/x/metadata/types"
 )
 
@@ -31,6 +32,8 @@ var (
 
 	excludeIDInfo  bool
 	includeRequest bool
+
+	sessionStatus string
 )
 
 const all = "all"
@@ -52,11 +55,18 @@ func GetQueryCmd() *cobra.Command {
 		GetMetadataScopeCmd(),
 		GetMetadataSessionCmd(),
 		GetMetadataRecordCmd(),
+		GetProcessRecordsCmd(),
 		GetMetadataScopeSpecCmd(),
 		GetMetadataContractSpecCmd(),
 		GetMetadataRecordSpecCmd(),
 		GetOwnershipCmd(),
 		GetValueOwnershipCmd(),
+		GetScopeAccessChangesCmd(),
+		GetScopeSettlementCmd(),
+		GetScopeDiffCmd(),
+		GetSpecOwnershipCmd(),
+		GetSpecUsageCmd(),
+		GetSpecDependentsCmd(),
 		GetOSLocatorCmd(),
 		GetAccountDataCmd(),
 		GetCmdNetAssetValuesQuery(),
@@ -87,7 +97,7 @@ func GetMetadataParamsCmd() *cobra.Command {
 	}
 
 	addIncludeRequestFlag(cmd)
-	flags.AddQueryFlagsToCmd(cmd)
+	provcli.AddQueryFlagsToCmd(cmd)
 
 	return cmd
 }
@@ -118,7 +128,7 @@ func GetMetadataByIDCmd() *cobra.Command {
 		},
 	}
 
-	flags.AddQueryFlagsToCmd(cmd)
+	provcli.AddQueryFlagsToCmd(cmd)
 
 	return cmd
 }
@@ -178,7 +188,7 @@ func GetMetadataGetAllCmd() *cobra.Command {
 
 	addExcludeIDInfoFlag(cmd)
 	addIncludeRequestFlag(cmd)
-	flags.AddQueryFlagsToCmd(cmd)
+	provcli.AddQueryFlagsToCmd(cmd)
 	flags.AddPaginationFlagsToCmd(cmd, "entries")
 
 	return cmd
@@ -225,7 +235,7 @@ func GetMetadataScopeCmd() *cobra.Command {
 	addIncludeRecordsFlag(cmd)
 	addExcludeIDInfoFlag(cmd)
 	addIncludeRequestFlag(cmd)
-	flags.AddQueryFlagsToCmd(cmd)
+	provcli.AddQueryFlagsToCmd(cmd)
 	flags.AddPaginationFlagsToCmd(cmd, "scopes (all)")
 
 	return cmd
@@ -245,7 +255,9 @@ func GetMetadataSessionCmd() *cobra.Command {
 %[1]s session {scope_uuid} {session_uuid} - gets a session with the given scope uuid and session uuid.
 %[1]s session {scope_uuid} {record_name} - gets the session in the given scope containing the given record.
 %[1]s session {record_id} - gets the session containing the given record.
-%[1]s session all - gets all sessions.`, cmdStart),
+%[1]s session all - gets all sessions.
+
+Use the --status flag to only get sessions with a given status (open, completed, or aborted).`, cmdStart),
 		Args: cobra.RangeArgs(1, 2),
 		Example: fmt.Sprintf(`%[1]s session session1qxge0zaztu65tx5x5llv5xc9zts9sqlch3sxwn44j50jzgt8rshvqyfrjcr
 %[1]s session scope1qzge0zaztu65tx5x5llv5xc9ztsqxlkwel
@@ -255,7 +267,8 @@ func GetMetadataSessionCmd() *cobra.Command {
 %[1]s session 91978ba2-5f35-459a-86a7-feca1b0512e0 5803f8bc-6067-4eb5-951f-2121671c2ec0
 %[1]s session 91978ba2-5f35-459a-86a7-feca1b0512e0 recordname
 %[1]s session record1q2ge0zaztu65tx5x5llv5xc9ztsw42dq2jdvmdazuwzcaddhh8gmu3mcze3
-%[1]s session all`, cmdStart),
+%[1]s session all
+%[1]s session all --status completed`, cmdStart),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			arg0 := strings.TrimSpace(args[0])
 			if arg0 == all {
@@ -287,7 +300,8 @@ func GetMetadataSessionCmd() *cobra.Command {
 	addIncludeRecordsFlag(cmd)
 	addExcludeIDInfoFlag(cmd)
 	addIncludeRequestFlag(cmd)
-	flags.AddQueryFlagsToCmd(cmd)
+	addSessionStatusFlag(cmd)
+	provcli.AddQueryFlagsToCmd(cmd)
 	flags.AddPaginationFlagsToCmd(cmd, "sessions (all)")
 
 	return cmd
@@ -344,12 +358,40 @@ func GetMetadataRecordCmd() *cobra.Command {
 	addIncludeSessionsFlag(cmd)
 	addExcludeIDInfoFlag(cmd)
 	addIncludeRequestFlag(cmd)
-	flags.AddQueryFlagsToCmd(cmd)
+	provcli.AddQueryFlagsToCmd(cmd)
 	flags.AddPaginationFlagsToCmd(cmd, "records (all)")
 
 	return cmd
 }
 
+// GetProcessRecordsCmd returns the command handler for querying the records produced by a process.
+func GetProcessRecordsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "process-records {process_hash} [method]",
+		Aliases: []string{"pr", "processrecords"},
+		Short:   "Query the records produced by a process",
+		Long: fmt.Sprintf(`%[1]s process-records {process_hash} - gets the records produced by the process with the given hash.
+%[1]s process-records {process_hash} {method} - gets the records produced by the given method of the process.`, cmdStart),
+		Args: cobra.RangeArgs(1, 2),
+		Example: fmt.Sprintf(`%[1]s process-records HV5XHMJzRTEH8B5Wb2gh58Y9qXTqm7xnmDYyfwkGkCkU
+%[1]s process-records HV5XHMJzRTEH8B5Wb2gh58Y9qXTqm7xnmDYyfwkGkCkU myMethod`, cmdStart),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			method := ""
+			if len(args) > 1 {
+				method = strings.TrimSpace(args[1])
+			}
+			return outputProcessRecords(cmd, strings.TrimSpace(args[0]), method)
+		},
+	}
+
+	addExcludeIDInfoFlag(cmd)
+	addIncludeRequestFlag(cmd)
+	provcli.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "records")
+
+	return cmd
+}
+
 // GetMetadataScopeSpecCmd returns the command handler for metadata scope specification querying.
 func GetMetadataScopeSpecCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -376,7 +418,7 @@ func GetMetadataScopeSpecCmd() *cobra.Command {
 	addIncludeRecordSpecsFlag(cmd)
 	addExcludeIDInfoFlag(cmd)
 	addIncludeRequestFlag(cmd)
-	flags.AddQueryFlagsToCmd(cmd)
+	provcli.AddQueryFlagsToCmd(cmd)
 	flags.AddPaginationFlagsToCmd(cmd, "scope specifications (all)")
 
 	return cmd
@@ -409,7 +451,7 @@ func GetMetadataContractSpecCmd() *cobra.Command {
 	addIncludeRecordSpecsFlag(cmd)
 	addExcludeIDInfoFlag(cmd)
 	addIncludeRequestFlag(cmd)
-	flags.AddQueryFlagsToCmd(cmd)
+	provcli.AddQueryFlagsToCmd(cmd)
 	flags.AddPaginationFlagsToCmd(cmd, "contract specifications (all)")
 
 	return cmd
@@ -452,7 +494,7 @@ func GetMetadataRecordSpecCmd() *cobra.Command {
 
 	addExcludeIDInfoFlag(cmd)
 	addIncludeRequestFlag(cmd)
-	flags.AddQueryFlagsToCmd(cmd)
+	provcli.AddQueryFlagsToCmd(cmd)
 	flags.AddPaginationFlagsToCmd(cmd, "record specifications (all)")
 
 	return cmd
@@ -480,7 +522,7 @@ func GetOwnershipCmd() *cobra.Command {
 	}
 
 	addIncludeRequestFlag(cmd)
-	flags.AddQueryFlagsToCmd(cmd)
+	provcli.AddQueryFlagsToCmd(cmd)
 	flags.AddPaginationFlagsToCmd(cmd, "scopes")
 
 	return cmd
@@ -505,12 +547,187 @@ func GetValueOwnershipCmd() *cobra.Command {
 	}
 
 	addIncludeRequestFlag(cmd)
-	flags.AddQueryFlagsToCmd(cmd)
+	provcli.AddQueryFlagsToCmd(cmd)
 	flags.AddPaginationFlagsToCmd(cmd, "scopes")
 
 	return cmd
 }
 
+// GetScopeAccessChangesCmd returns the command handler for querying the recent scope access changes
+func GetScopeAccessChangesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "access-changes [scope_id]",
+		Aliases: []string{"ac", "accesschanges"},
+		Short:   "Query the recent changes to the data access lists and value owners of scopes",
+		Long: fmt.Sprintf(`%[1]s access-changes - gets the recent data access and value owner changes of all scopes.
+%[1]s access-changes {scope_id} - gets the recent data access and value owner changes of a scope.
+  The {scope_id} can either be a uuid or bech32 scope address.
+
+Only the most recent changes are kept, so older changes will not be returned.`, cmdStart),
+		Args: cobra.MaximumNArgs(1),
+		Example: fmt.Sprintf(`%[1]s access-changes
+%[1]s access-changes scope1qzge0zaztu65tx5x5llv5xc9ztsqxlkwel
+%[1]s access-changes 91978ba2-5f35-459a-86a7-feca1b0512e0`, cmdStart),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			scopeID := trimSpaceAndJoin(args, " ")
+			return outputScopeAccessChanges(cmd, scopeID)
+		},
+	}
+
+	addIncludeRequestFlag(cmd)
+	provcli.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "changes")
+
+	return cmd
+}
+
+// GetScopeSettlementCmd returns the command handler for querying the pending sale of a scope's value ownership
+func GetScopeSettlementCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "scope-settlement {scope_id}",
+		Aliases: []string{"settlement"},
+		Short:   "Query the pending sale of a scope's value ownership",
+		Long: fmt.Sprintf(`%[1]s scope-settlement {scope_id} - gets the pending sale of a scope's value ownership.
+  The {scope_id} can either be a uuid or bech32 scope address.`, cmdStart),
+		Args: cobra.ExactArgs(1),
+		Example: fmt.Sprintf(`%[1]s scope-settlement scope1qzge0zaztu65tx5x5llv5xc9ztsqxlkwel
+%[1]s scope-settlement 91978ba2-5f35-459a-86a7-feca1b0512e0`, cmdStart),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ScopeSettlement(
+				cmd.Context(),
+				&types.ScopeSettlementRequest{ScopeId: strings.TrimSpace(args[0])},
+			)
+			if err != nil {
+				return err
+			}
+
+			return provcli.PrintProto(clientCtx, res)
+		},
+	}
+
+	provcli.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetSpecOwnershipCmd returns the command handler for metadata specification querying by owner address
+func GetSpecOwnershipCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "specowner {scopespec|contractspec|recordspec} address",
+		Aliases: []string{"so", "specownership"},
+		Short:   "Query the current metadata for specifications owned by an address",
+		Long: fmt.Sprintf(`%[1]s specowner scopespec {address} - gets the scope specifications owned by the provided address.
+%[1]s specowner contractspec {address} - gets the contract specifications owned by the provided address.
+%[1]s specowner recordspec {address} - gets the record specifications of contract specifications owned by the provided address.`, cmdStart),
+		Args: cobra.ExactArgs(2),
+		Example: fmt.Sprintf(`%[1]s specowner scopespec pb1sh49f6ze3vn7cdl2amh2gnc70z5mten3dpvr42
+%[1]s specowner contractspec pb1sh49f6ze3vn7cdl2amh2gnc70z5mten3dpvr42
+%[1]s specowner recordspec pb1sh49f6ze3vn7cdl2amh2gnc70z5mten3dpvr42`, cmdStart),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			address := strings.TrimSpace(args[1])
+			if len(address) == 0 {
+				return fmt.Errorf("empty address")
+			}
+			return outputSpecOwnership(cmd, strings.ToLower(strings.TrimSpace(args[0])), address)
+		},
+	}
+
+	addExcludeIDInfoFlag(cmd)
+	addIncludeRequestFlag(cmd)
+	provcli.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "specifications")
+
+	return cmd
+}
+
+// GetSpecUsageCmd returns the command handler for querying how much a specification is used.
+func GetSpecUsageCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "spec-usage {specification_id}",
+		Aliases: []string{"su", "specusage"},
+		Short:   "Query how many things use a scope or contract specification",
+		Long: fmt.Sprintf(`%[1]s spec-usage {specification_id} - gets the usage counts of a specification and whether it can be deleted.
+  The {specification_id} must be a bech32 scope specification or contract specification address.`, cmdStart),
+		Args: cobra.ExactArgs(1),
+		Example: fmt.Sprintf(`%[1]s spec-usage scopespec1qnwg86nsatx5pl56muw0v9ytlz3qu3jx6m
+%[1]s spec-usage contractspec1q000d0q2e8w5say53afqdesxp2zqzkr4fn`, cmdStart),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.SpecificationUsage(
+				cmd.Context(),
+				&types.SpecificationUsageRequest{
+					SpecificationId: strings.TrimSpace(args[0]),
+					IncludeRequest:  includeRequest,
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return provcli.PrintProto(clientCtx, res)
+		},
+	}
+
+	addIncludeRequestFlag(cmd)
+	provcli.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetSpecDependentsCmd returns the command handler for querying the things that use a specification.
+func GetSpecDependentsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "spec-dependents {specification_id}",
+		Aliases: []string{"sd", "specdependents"},
+		Short:   "Query the things that use a scope or contract specification",
+		Long: fmt.Sprintf(`%[1]s spec-dependents {scope_spec_id} - gets the ids of the scopes that use a scope specification.
+%[1]s spec-dependents {contract_spec_id} - gets the ids of the scope specifications and sessions that use a contract specification.
+  A specification cannot be deleted while it has dependents.`, cmdStart),
+		Args: cobra.ExactArgs(1),
+		Example: fmt.Sprintf(`%[1]s spec-dependents scopespec1qnwg86nsatx5pl56muw0v9ytlz3qu3jx6m
+%[1]s spec-dependents contractspec1q000d0q2e8w5say53afqdesxp2zqzkr4fn`, cmdStart),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequestWithPageKeyDecoded(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.SpecificationDependents(
+				cmd.Context(),
+				&types.SpecificationDependentsRequest{
+					SpecificationId: strings.TrimSpace(args[0]),
+					IncludeRequest:  includeRequest,
+					Pagination:      pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return provcli.PrintProto(clientCtx, res)
+		},
+	}
+
+	addIncludeRequestFlag(cmd)
+	provcli.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "dependents")
+
+	return cmd
+}
+
 // GetOSLocatorCmd returns the command handler for metadata object store locator querying.
 func GetOSLocatorCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -561,7 +778,7 @@ func GetOSLocatorCmd() *cobra.Command {
 	}
 
 	addIncludeRequestFlag(cmd)
-	flags.AddQueryFlagsToCmd(cmd)
+	provcli.AddQueryFlagsToCmd(cmd)
 	flags.AddPaginationFlagsToCmd(cmd, "locators (all)")
 
 	return cmd
@@ -594,11 +811,11 @@ func GetAccountDataCmd() *cobra.Command {
 				return fmt.Errorf("failed to query account data for metadata address %q: %w", args[0], err)
 			}
 
-			return clientCtx.PrintProto(resp)
+			return provcli.PrintProto(clientCtx, resp)
 		},
 	}
 
-	flags.AddQueryFlagsToCmd(cmd)
+	provcli.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
 
@@ -617,7 +834,7 @@ func outputParams(cmd *cobra.Command) error {
 		return err
 	}
 
-	return clientCtx.PrintProto(res)
+	return provcli.PrintProto(clientCtx, res)
 }
 
 // outputGetByAddr calls the GetByAddr query and outputs the response.
@@ -635,7 +852,7 @@ func outputGetByAddr(cmd *cobra.Command, addrs []string) error {
 		return err
 	}
 
-	return clientCtx.PrintProto(res)
+	return provcli.PrintProto(clientCtx, res)
 }
 
 // outputScope calls the Scope query and outputs the response.
@@ -661,7 +878,7 @@ func outputScope(cmd *cobra.Command, scopeID string, sessionAddr string, recordA
 		return err
 	}
 
-	return clientCtx.PrintProto(res)
+	return provcli.PrintProto(clientCtx, res)
 }
 
 // outputScopesAll calls the ScopesAllRequest query and outputs the response.
@@ -687,7 +904,7 @@ func outputScopesAll(cmd *cobra.Command) error {
 		return err
 	}
 
-	return clientCtx.PrintProto(res)
+	return provcli.PrintProto(clientCtx, res)
 }
 
 // outputSessions calls the Sessions query and outputs the response.
@@ -697,11 +914,17 @@ func outputSessions(cmd *cobra.Command, scopeID, sessionID, recordID, recordName
 		return err
 	}
 
+	status, err := parseSessionStatusFlag()
+	if err != nil {
+		return err
+	}
+
 	req := types.SessionsRequest{
 		ScopeId:        scopeID,
 		SessionId:      sessionID,
 		RecordAddr:     recordID,
 		RecordName:     recordName,
+		Status:         status,
 		IncludeScope:   includeScope,
 		IncludeRecords: includeRecords,
 		ExcludeIdInfo:  excludeIDInfo,
@@ -717,7 +940,7 @@ func outputSessions(cmd *cobra.Command, scopeID, sessionID, recordID, recordName
 		return errors.New("no sessions found")
 	}
 
-	return clientCtx.PrintProto(res)
+	return provcli.PrintProto(clientCtx, res)
 }
 
 // outputSessionsAll calls the SessionsAll query and outputs the response.
@@ -730,10 +953,15 @@ func outputSessionsAll(cmd *cobra.Command) error {
 	if e != nil {
 		return e
 	}
+	status, err := parseSessionStatusFlag()
+	if err != nil {
+		return err
+	}
 	queryClient := types.NewQueryClient(clientCtx)
 	res, err := queryClient.SessionsAll(
 		cmd.Context(),
 		&types.SessionsAllRequest{
+			Status:         status,
 			ExcludeIdInfo:  excludeIDInfo,
 			IncludeRequest: includeRequest,
 			Pagination:     pageReq,
@@ -743,7 +971,7 @@ func outputSessionsAll(cmd *cobra.Command) error {
 		return err
 	}
 
-	return clientCtx.PrintProto(res)
+	return provcli.PrintProto(clientCtx, res)
 }
 
 // outputRecords calls the Records query and outputs the response.
@@ -770,7 +998,7 @@ func outputRecords(cmd *cobra.Command, recordAddr string, scopeID string, sessio
 		return err
 	}
 
-	return clientCtx.PrintProto(res)
+	return provcli.PrintProto(clientCtx, res)
 }
 
 // outputRecordsAll calls the RecordsAll query and outputs the response.
@@ -796,7 +1024,35 @@ func outputRecordsAll(cmd *cobra.Command) error {
 		return err
 	}
 
-	return clientCtx.PrintProto(res)
+	return provcli.PrintProto(clientCtx, res)
+}
+
+// outputProcessRecords calls the RecordsForProcess query and outputs the response.
+func outputProcessRecords(cmd *cobra.Command, processHash, method string) error {
+	clientCtx, err := client.GetClientQueryContext(cmd)
+	if err != nil {
+		return err
+	}
+	pageReq, e := client.ReadPageRequestWithPageKeyDecoded(cmd.Flags())
+	if e != nil {
+		return e
+	}
+	queryClient := types.NewQueryClient(clientCtx)
+	res, err := queryClient.RecordsForProcess(
+		cmd.Context(),
+		&types.RecordsForProcessRequest{
+			ProcessHash:    processHash,
+			Method:         method,
+			ExcludeIdInfo:  excludeIDInfo,
+			IncludeRequest: includeRequest,
+			Pagination:     pageReq,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	return provcli.PrintProto(clientCtx, res)
 }
 
 // outputOwnership calls the Ownership query and outputs the response.
@@ -818,7 +1074,7 @@ func outputOwnership(cmd *cobra.Command, address string) error {
 		return err
 	}
 
-	return clientCtx.PrintProto(res)
+	return provcli.PrintProto(clientCtx, res)
 }
 
 // outputValueOwnership calls the ValueOwnership query and outputs the response.
@@ -840,7 +1096,87 @@ func outputValueOwnership(cmd *cobra.Command, address string) error {
 		return err
 	}
 
-	return clientCtx.PrintProto(res)
+	return provcli.PrintProto(clientCtx, res)
+}
+
+// outputScopeAccessChanges calls the ScopeAccessChanges query and outputs the response.
+func outputScopeAccessChanges(cmd *cobra.Command, scopeID string) error {
+	clientCtx, err := client.GetClientQueryContext(cmd)
+	if err != nil {
+		return err
+	}
+	pageReq, e := client.ReadPageRequestWithPageKeyDecoded(cmd.Flags())
+	if e != nil {
+		return e
+	}
+	queryClient := types.NewQueryClient(clientCtx)
+	res, err := queryClient.ScopeAccessChanges(
+		cmd.Context(),
+		&types.ScopeAccessChangesRequest{ScopeId: scopeID, IncludeRequest: includeRequest, Pagination: pageReq},
+	)
+	if err != nil {
+		return err
+	}
+
+	return provcli.PrintProto(clientCtx, res)
+}
+
+// outputSpecOwnership calls the query for the specifications of the given type owned by an address and outputs the response.
+func outputSpecOwnership(cmd *cobra.Command, specType string, address string) error {
+	clientCtx, err := client.GetClientQueryContext(cmd)
+	if err != nil {
+		return err
+	}
+	pageReq, e := client.ReadPageRequestWithPageKeyDecoded(cmd.Flags())
+	if e != nil {
+		return e
+	}
+	queryClient := types.NewQueryClient(clientCtx)
+	switch specType {
+	case "scopespec", "scopespecs", "ss":
+		res, err := queryClient.ScopeSpecificationsForOwner(
+			cmd.Context(),
+			&types.ScopeSpecificationsForOwnerRequest{
+				Owner:          address,
+				ExcludeIdInfo:  excludeIDInfo,
+				IncludeRequest: includeRequest,
+				Pagination:     pageReq,
+			},
+		)
+		if err != nil {
+			return err
+		}
+		return provcli.PrintProto(clientCtx, res)
+	case "contractspec", "contractspecs", "cs":
+		res, err := queryClient.ContractSpecificationsForOwner(
+			cmd.Context(),
+			&types.ContractSpecificationsForOwnerRequest{
+				Owner:          address,
+				ExcludeIdInfo:  excludeIDInfo,
+				IncludeRequest: includeRequest,
+				Pagination:     pageReq,
+			},
+		)
+		if err != nil {
+			return err
+		}
+		return provcli.PrintProto(clientCtx, res)
+	case "recordspec", "recordspecs", "rs":
+		res, err := queryClient.RecordSpecificationsForOwner(
+			cmd.Context(),
+			&types.RecordSpecificationsForOwnerRequest{
+				Owner:          address,
+				ExcludeIdInfo:  excludeIDInfo,
+				IncludeRequest: includeRequest,
+				Pagination:     pageReq,
+			},
+		)
+		if err != nil {
+			return err
+		}
+		return provcli.PrintProto(clientCtx, res)
+	}
+	return fmt.Errorf("unknown specification type %q: must be one of scopespec, contractspec, or recordspec", specType)
 }
 
 // outputScopeSpec calls the ScopeSpecification query and outputs the response.
@@ -864,7 +1200,7 @@ func outputScopeSpec(cmd *cobra.Command, specificationID string) error {
 		return err
 	}
 
-	return clientCtx.PrintProto(res)
+	return provcli.PrintProto(clientCtx, res)
 }
 
 // outputScopeSpecsAll calls the ScopeSpecificationsAll query and outputs the response.
@@ -890,7 +1226,7 @@ func outputScopeSpecsAll(cmd *cobra.Command) error {
 		return err
 	}
 
-	return clientCtx.PrintProto(res)
+	return provcli.PrintProto(clientCtx, res)
 }
 
 // outputContractSpec calls the ContractSpecification query and outputs the response.
@@ -913,7 +1249,7 @@ func outputContractSpec(cmd *cobra.Command, specificationID string) error {
 		return err
 	}
 
-	return clientCtx.PrintProto(res)
+	return provcli.PrintProto(clientCtx, res)
 }
 
 // outputContractSpecsAll calls the ContractSpecificationsAll query and outputs the response.
@@ -939,7 +1275,7 @@ func outputContractSpecsAll(cmd *cobra.Command) error {
 		return err
 	}
 
-	return clientCtx.PrintProto(res)
+	return provcli.PrintProto(clientCtx, res)
 }
 
 // outputRecordSpec calls the RecordSpecification query and outputs the response.
@@ -962,7 +1298,7 @@ func outputRecordSpec(cmd *cobra.Command, specificationID string, name string) e
 		return err
 	}
 
-	return clientCtx.PrintProto(res)
+	return provcli.PrintProto(clientCtx, res)
 }
 
 // outputRecordSpecsForContractSpec calls the RecordSpecificationsForContractSpecification query and outputs the response.
@@ -984,7 +1320,7 @@ func outputRecordSpecsForContractSpec(cmd *cobra.Command, specificationID string
 		return err
 	}
 
-	return clientCtx.PrintProto(res)
+	return provcli.PrintProto(clientCtx, res)
 }
 
 // outputRecordSpecsAll calls the RecordSpecificationsAll query and outputs the response.
@@ -1010,7 +1346,7 @@ func outputRecordSpecsAll(cmd *cobra.Command) error {
 		return err
 	}
 
-	return clientCtx.PrintProto(res)
+	return provcli.PrintProto(clientCtx, res)
 }
 
 // outputOSLocatorParams calls the OSLocatorParams query and outputs the response.
@@ -1028,7 +1364,7 @@ func outputOSLocatorParams(cmd *cobra.Command) error {
 		return err
 	}
 
-	return clientCtx.PrintProto(res)
+	return provcli.PrintProto(clientCtx, res)
 }
 
 // outputOSLocator calls the OSLocator query and outputs the response.
@@ -1046,7 +1382,7 @@ func outputOSLocator(cmd *cobra.Command, owner string) error {
 		return err
 	}
 
-	return clientCtx.PrintProto(res)
+	return provcli.PrintProto(clientCtx, res)
 }
 
 // outputOSLocatorsByURI calls the OSLocatorsByURI query and outputs the response.
@@ -1068,7 +1404,7 @@ func outputOSLocatorsByURI(cmd *cobra.Command, uri string) error {
 		return err
 	}
 
-	return clientCtx.PrintProto(res)
+	return provcli.PrintProto(clientCtx, res)
 }
 
 // outputOSLocatorsByScope calls the OSLocatorsByScope query and outputs the response.
@@ -1086,7 +1422,7 @@ func outputOSLocatorsByScope(cmd *cobra.Command, scopeID string) error {
 		return err
 	}
 
-	return clientCtx.PrintProto(res)
+	return provcli.PrintProto(clientCtx, res)
 }
 
 // outputOSLocatorsAll calls the OSAllLocators query and outputs the response.
@@ -1108,7 +1444,7 @@ func outputOSLocatorsAll(cmd *cobra.Command) error {
 		return err
 	}
 
-	return clientCtx.PrintProto(res)
+	return provcli.PrintProto(clientCtx, res)
 }
 
 // GetCmdNetAssetValuesQuery is the CLI command for querying a scope's net asset values.
@@ -1139,10 +1475,10 @@ func GetCmdNetAssetValuesQuery() *cobra.Command {
 				fmt.Printf("failed to query scope %q net asset values details: %v\n", id, err)
 				return nil
 			}
-			return clientCtx.PrintProto(response)
+			return provcli.PrintProto(clientCtx, response)
 		},
 	}
-	flags.AddQueryFlagsToCmd(cmd)
+	provcli.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
 
@@ -1195,6 +1531,20 @@ func addExcludeIDInfoFlag(cmd *cobra.Command) {
 	cmd.Flags().BoolVar(&excludeIDInfo, "exclude-id-info", false, "include breakdown information about the ids")
 }
 
+// addSessionStatusFlag sets up a command to look for a --status flag.
+// The flag value is tied to the sessionStatus variable.
+func addSessionStatusFlag(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&sessionStatus, "status", "", "only include sessions with this status (open, completed, or aborted)")
+}
+
+// parseSessionStatusFlag converts the sessionStatus variable into a SessionStatus.
+func parseSessionStatusFlag() (types.SessionStatus, error) {
+	if len(strings.TrimSpace(sessionStatus)) == 0 {
+		return types.SessionStatus_Unspecified, nil
+	}
+	return types.SessionStatusFromString(sessionStatus)
+}
+
 // addIncludeRequestFlag sets up a command to look for an --include-request flag.
 // The flag value is tied to the includeRequest variable.
 func addIncludeRequestFlag(cmd *cobra.Command) {
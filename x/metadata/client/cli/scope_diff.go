@@ -0,0 +1,329 @@
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+
+	"github.com/provenance-io/provenance/internal/provcli"
+	"github.com/provenance-io/provenance/x/metadata/types"
+)
+
+// GetScopeDiffCmd returns the command handler for comparing a scope with another scope or a scope in a file.
+func GetScopeDiffCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "scope-diff {scope_id} {other_scope_id|file}",
+		Aliases: []string{"sdiff", "scopediff"},
+		Short:   "Compare a scope with another scope or a scope in a file",
+		Long: fmt.Sprintf(`%[1]s scope-diff {scope_id} {other_scope_id} - compares two scopes.
+%[1]s scope-diff {scope_id} {file} - compares a scope with the scope in a file.
+  The {scope_id} and {other_scope_id} can either be a uuid or bech32 scope address.
+  The {file} must contain the JSON output of the scope query, e.g. from a system of record,
+  or from: %[1]s scope {scope_id} --include-sessions --include-records --output json
+
+The scopes, their sessions (matched by session uuid), and their records (matched by name) are compared.
+Each difference is reported relative to the first scope:
+  "+" is only in the second, "-" is only in the first, and "~" is different in the second.
+Audit fields are not compared.`, cmdStart),
+		Args: cobra.ExactArgs(2),
+		Example: fmt.Sprintf(`%[1]s scope-diff scope1qzge0zaztu65tx5x5llv5xc9ztsqxlkwel scope1qrt7nqdxa2nz4rlrxdfl6s7xl98qjytgkn
+%[1]s scope-diff 91978ba2-5f35-459a-86a7-feca1b0512e0 system-of-record.json`, cmdStart),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			base, err := queryScopeForDiff(cmd, clientCtx, strings.TrimSpace(args[0]))
+			if err != nil {
+				return err
+			}
+			other, err := getScopeForDiff(cmd, clientCtx, strings.TrimSpace(args[1]))
+			if err != nil {
+				return err
+			}
+
+			report, err := diffScopes(base, other)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintString(strings.Join(report, "\n") + "\n")
+		},
+	}
+
+	provcli.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// getScopeForDiff reads the scope from the file with the provided name if there is one,
+// otherwise, it queries for the scope with the provided id.
+func getScopeForDiff(cmd *cobra.Command, clientCtx client.Context, arg string) (*types.ScopeResponse, error) {
+	info, err := os.Stat(arg)
+	if err != nil || info.IsDir() {
+		return queryScopeForDiff(cmd, clientCtx, arg)
+	}
+
+	bz, err := os.ReadFile(arg)
+	if err != nil {
+		return nil, err
+	}
+	var res types.ScopeResponse
+	if err = clientCtx.Codec.UnmarshalJSON(bz, &res); err != nil {
+		return nil, fmt.Errorf("could not read scope from %s: %w", arg, err)
+	}
+	if res.Scope == nil || res.Scope.Scope == nil {
+		return nil, fmt.Errorf("no scope found in %s", arg)
+	}
+	return &res, nil
+}
+
+// queryScopeForDiff queries for a scope along with its sessions and records.
+func queryScopeForDiff(cmd *cobra.Command, clientCtx client.Context, scopeID string) (*types.ScopeResponse, error) {
+	queryClient := types.NewQueryClient(clientCtx)
+	res, err := queryClient.Scope(cmd.Context(), &types.ScopeRequest{
+		ScopeId:         scopeID,
+		IncludeSessions: true,
+		IncludeRecords:  true,
+		ExcludeIdInfo:   true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if res.Scope == nil || res.Scope.Scope == nil {
+		return nil, fmt.Errorf("scope %s not found", scopeID)
+	}
+	return res, nil
+}
+
+// diffScopes returns the lines of a report of the differences between two scopes and their sessions and records.
+func diffScopes(base, other *types.ScopeResponse) ([]string, error) {
+	if base == nil || base.Scope == nil || base.Scope.Scope == nil ||
+		other == nil || other.Scope == nil || other.Scope.Scope == nil {
+		return nil, errors.New("cannot compare an empty scope")
+	}
+	baseScope, otherScope := base.Scope.Scope, other.Scope.Scope
+
+	var scopeLines []string
+	scopeLines = addChangedLine(scopeLines, "scope_id", baseScope.ScopeId.String(), otherScope.ScopeId.String())
+	scopeLines = addChangedLine(scopeLines, "specification_id", baseScope.SpecificationId.String(), otherScope.SpecificationId.String())
+	scopeLines = addChangedLine(scopeLines, "value_owner_address", baseScope.ValueOwnerAddress, otherScope.ValueOwnerAddress)
+	scopeLines = addChangedLine(scopeLines, "require_party_rollup",
+		fmt.Sprintf("%t", baseScope.RequirePartyRollup), fmt.Sprintf("%t", otherScope.RequirePartyRollup))
+	scopeLines = addSetLines(scopeLines, "owner", stringsOf(baseScope.Owners), stringsOf(otherScope.Owners))
+	scopeLines = addSetLines(scopeLines, "data_access", baseScope.DataAccess, otherScope.DataAccess)
+
+	sessionLines, err := diffSessions(base.Sessions, other.Sessions)
+	if err != nil {
+		return nil, err
+	}
+	recordLines, err := diffRecords(base.Records, other.Records)
+	if err != nil {
+		return nil, err
+	}
+
+	rv := []string{fmt.Sprintf("Comparing %s with %s", baseScope.ScopeId, otherScope.ScopeId)}
+	if len(scopeLines)+len(sessionLines)+len(recordLines) == 0 {
+		return append(rv, "No differences."), nil
+	}
+	for _, section := range []struct {
+		title string
+		lines []string
+	}{
+		{title: "Scope:", lines: scopeLines},
+		{title: "Sessions:", lines: sessionLines},
+		{title: "Records:", lines: recordLines},
+	} {
+		if len(section.lines) == 0 {
+			continue
+		}
+		rv = append(rv, section.title)
+		for _, line := range section.lines {
+			rv = append(rv, "  "+line)
+		}
+	}
+	return rv, nil
+}
+
+// diffSessions returns the report lines for the differences between two scopes' sessions, matched by session uuid.
+func diffSessions(baseWrappers, otherWrappers []*types.SessionWrapper) ([]string, error) {
+	baseSessions, err := sessionsByUUID(baseWrappers)
+	if err != nil {
+		return nil, err
+	}
+	otherSessions, err := sessionsByUUID(otherWrappers)
+	if err != nil {
+		return nil, err
+	}
+
+	var rv []string
+	for _, key := range unionKeys(baseSessions, otherSessions) {
+		baseSession, inBase := baseSessions[key]
+		otherSession, inOther := otherSessions[key]
+		switch {
+		case !inOther:
+			rv = append(rv, fmt.Sprintf("- %s (%s)", key, baseSession.Name))
+		case !inBase:
+			rv = append(rv, fmt.Sprintf("+ %s (%s)", key, otherSession.Name))
+		default:
+			var changes []string
+			changes = addChangedLine(changes, "name", baseSession.Name, otherSession.Name)
+			changes = addChangedLine(changes, "specification_id", baseSession.SpecificationId.String(), otherSession.SpecificationId.String())
+			changes = addChangedLine(changes, "status", baseSession.Status.String(), otherSession.Status.String())
+			changes = addSetLines(changes, "party", stringsOf(baseSession.Parties), stringsOf(otherSession.Parties))
+			if !bytes.Equal(baseSession.Context, otherSession.Context) {
+				changes = append(changes, "~ context")
+			}
+			rv = append(rv, nestChanges(key, changes)...)
+		}
+	}
+	return rv, nil
+}
+
+// diffRecords returns the report lines for the differences between two scopes' records, matched by name.
+func diffRecords(baseWrappers, otherWrappers []*types.RecordWrapper) ([]string, error) {
+	baseRecords := recordsByName(baseWrappers)
+	otherRecords := recordsByName(otherWrappers)
+
+	var rv []string
+	for _, name := range unionKeys(baseRecords, otherRecords) {
+		baseRecord, inBase := baseRecords[name]
+		otherRecord, inOther := otherRecords[name]
+		switch {
+		case !inOther:
+			rv = append(rv, "- "+name)
+		case !inBase:
+			rv = append(rv, "+ "+name)
+		default:
+			baseSession, err := sessionUUIDString(baseRecord.SessionId)
+			if err != nil {
+				return nil, err
+			}
+			otherSession, err := sessionUUIDString(otherRecord.SessionId)
+			if err != nil {
+				return nil, err
+			}
+			var changes []string
+			changes = addChangedLine(changes, "session", baseSession, otherSession)
+			changes = addChangedLine(changes, "specification_id", baseRecord.SpecificationId.String(), otherRecord.SpecificationId.String())
+			changes = addChangedLine(changes, "process", baseRecord.Process.String(), otherRecord.Process.String())
+			changes = addSetLines(changes, "input", stringsOf(baseRecord.Inputs), stringsOf(otherRecord.Inputs))
+			changes = addSetLines(changes, "output", stringsOf(baseRecord.Outputs), stringsOf(otherRecord.Outputs))
+			rv = append(rv, nestChanges(name, changes)...)
+		}
+	}
+	return rv, nil
+}
+
+// sessionsByUUID maps the provided sessions by the string version of their session uuid.
+func sessionsByUUID(wrappers []*types.SessionWrapper) (map[string]*types.Session, error) {
+	rv := make(map[string]*types.Session, len(wrappers))
+	for _, wrapper := range wrappers {
+		if wrapper == nil || wrapper.Session == nil {
+			continue
+		}
+		key, err := sessionUUIDString(wrapper.Session.SessionId)
+		if err != nil {
+			return nil, err
+		}
+		rv[key] = wrapper.Session
+	}
+	return rv, nil
+}
+
+// recordsByName maps the provided records by their name.
+func recordsByName(wrappers []*types.RecordWrapper) map[string]*types.Record {
+	rv := make(map[string]*types.Record, len(wrappers))
+	for _, wrapper := range wrappers {
+		if wrapper != nil && wrapper.Record != nil {
+			rv[wrapper.Record.Name] = wrapper.Record
+		}
+	}
+	return rv
+}
+
+// sessionUUIDString returns the string version of the session uuid in the provided session id.
+func sessionUUIDString(sessionID types.MetadataAddress) (string, error) {
+	sessionUUID, err := sessionID.SessionUUID()
+	if err != nil {
+		return "", fmt.Errorf("invalid session id %s: %w", sessionID, err)
+	}
+	return sessionUUID.String(), nil
+}
+
+// unionKeys returns the sorted keys that are in either of the provided maps.
+func unionKeys[V any](base, other map[string]V) []string {
+	keys := make([]string, 0, len(base)+len(other))
+	for key := range base {
+		keys = append(keys, key)
+	}
+	for key := range other {
+		if _, known := base[key]; !known {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// addChangedLine adds a "~" line to the provided lines if the base and other values are different.
+func addChangedLine(lines []string, field, base, other string) []string {
+	if base == other {
+		return lines
+	}
+	return append(lines, fmt.Sprintf("~ %s: %q -> %q", field, base, other))
+}
+
+// addSetLines adds a "-" line for each base value that isn't in other, and a "+" line for each other value
+// that isn't in base. The order of the values is not considered.
+func addSetLines(lines []string, field string, base, other []string) []string {
+	inBase := make(map[string]bool, len(base))
+	for _, value := range base {
+		inBase[value] = true
+	}
+	inOther := make(map[string]bool, len(other))
+	for _, value := range other {
+		inOther[value] = true
+	}
+	for _, value := range base {
+		if !inOther[value] {
+			lines = append(lines, fmt.Sprintf("- %s: %s", field, value))
+		}
+	}
+	for _, value := range other {
+		if !inBase[value] {
+			lines = append(lines, fmt.Sprintf("+ %s: %s", field, value))
+		}
+	}
+	return lines
+}
+
+// nestChanges returns a "~" line for the provided key followed by its indented changes.
+// Nothing is returned if there aren't any changes.
+func nestChanges(key string, changes []string) []string {
+	if len(changes) == 0 {
+		return nil
+	}
+	rv := make([]string, 0, len(changes)+1)
+	rv = append(rv, "~ "+key)
+	for _, change := range changes {
+		rv = append(rv, "    "+change)
+	}
+	return rv
+}
+
+// stringsOf returns the string version of each of the provided values.
+func stringsOf[S fmt.Stringer](values []S) []string {
+	rv := make([]string, len(values))
+	for i, value := range values {
+		rv[i] = value.String()
+	}
+	return rv
+}
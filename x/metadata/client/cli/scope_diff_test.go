@@ -0,0 +1,135 @@
+package cli
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/metadata/types"
+)
+
+func TestDiffScopes(t *testing.T) {
+	scopeUUID := uuid.MustParse("91978ba2-5f35-459a-86a7-feca1b0512e0")
+	sessionUUID1 := uuid.MustParse("5803f8bc-6067-4eb5-951f-2121671c2ec0")
+	sessionUUID2 := uuid.MustParse("9d4dd5c4-9ee4-4b5c-93a5-e2ecd4f4e3e2")
+	scopeID := types.ScopeMetadataAddress(scopeUUID)
+	sessionID1 := types.SessionMetadataAddress(scopeUUID, sessionUUID1)
+	sessionID2 := types.SessionMetadataAddress(scopeUUID, sessionUUID2)
+	owner := sdk.AccAddress("owner_______________").String()
+	other := sdk.AccAddress("other_______________").String()
+
+	newScope := func() *types.ScopeResponse {
+		return &types.ScopeResponse{
+			Scope: &types.ScopeWrapper{Scope: &types.Scope{
+				ScopeId:           scopeID,
+				Owners:            []types.Party{{Address: owner, Role: types.PartyType_PARTY_TYPE_OWNER}},
+				DataAccess:        []string{owner},
+				ValueOwnerAddress: owner,
+			}},
+			Sessions: []*types.SessionWrapper{
+				{Session: &types.Session{SessionId: sessionID1, Name: "first", Status: types.SessionStatus_Completed}},
+				{Session: &types.Session{SessionId: sessionID2, Name: "second", Status: types.SessionStatus_Open}},
+			},
+			Records: []*types.RecordWrapper{
+				{Record: &types.Record{
+					Name:      "loan",
+					SessionId: sessionID1,
+					Process:   types.Process{Name: "proc", Method: "create"},
+					Outputs:   []types.RecordOutput{{Hash: "abc", Status: types.ResultStatus_RESULT_STATUS_PASS}},
+				}},
+			},
+		}
+	}
+
+	tests := []struct {
+		name   string
+		modify func(res *types.ScopeResponse)
+		exp    []string
+		expErr string
+	}{
+		{
+			name:   "empty scope",
+			modify: func(res *types.ScopeResponse) { res.Scope = nil },
+			expErr: "cannot compare an empty scope",
+		},
+		{
+			name:   "no differences",
+			modify: func(res *types.ScopeResponse) {},
+			exp:    []string{"No differences."},
+		},
+		{
+			name: "sessions in a different order",
+			modify: func(res *types.ScopeResponse) {
+				res.Sessions[0], res.Sessions[1] = res.Sessions[1], res.Sessions[0]
+			},
+			exp: []string{"No differences."},
+		},
+		{
+			name: "scope changes",
+			modify: func(res *types.ScopeResponse) {
+				res.Scope.Scope.ValueOwnerAddress = other
+				res.Scope.Scope.RequirePartyRollup = true
+				res.Scope.Scope.Owners = []types.Party{{Address: other, Role: types.PartyType_PARTY_TYPE_OWNER}}
+				res.Scope.Scope.DataAccess = append(res.Scope.Scope.DataAccess, other)
+			},
+			exp: []string{
+				"Scope:",
+				`  ~ value_owner_address: "` + owner + `" -> "` + other + `"`,
+				`  ~ require_party_rollup: "false" -> "true"`,
+				"  - owner: " + owner + " - PARTY_TYPE_OWNER",
+				"  + owner: " + other + " - PARTY_TYPE_OWNER",
+				"  + data_access: " + other,
+			},
+		},
+		{
+			name: "session changes",
+			modify: func(res *types.ScopeResponse) {
+				res.Sessions[0].Session.Status = types.SessionStatus_Aborted
+				res.Sessions[0].Session.Context = []byte("ctx")
+				res.Sessions = res.Sessions[:1]
+			},
+			exp: []string{
+				"Sessions:",
+				"  ~ " + sessionUUID1.String(),
+				`      ~ status: "SESSION_STATUS_COMPLETED" -> "SESSION_STATUS_ABORTED"`,
+				"      ~ context",
+				"  - " + sessionUUID2.String() + " (second)",
+			},
+		},
+		{
+			name: "record changes",
+			modify: func(res *types.ScopeResponse) {
+				res.Records[0].Record.SessionId = sessionID2
+				res.Records[0].Record.Outputs = []types.RecordOutput{{Hash: "def", Status: types.ResultStatus_RESULT_STATUS_PASS}}
+				res.Records = append(res.Records, &types.RecordWrapper{Record: &types.Record{Name: "note", SessionId: sessionID2}})
+			},
+			exp: []string{
+				"Records:",
+				"  ~ loan",
+				`      ~ session: "` + sessionUUID1.String() + `" -> "` + sessionUUID2.String() + `"`,
+				"      - output: abc - RESULT_STATUS_PASS",
+				"      + output: def - RESULT_STATUS_PASS",
+				"  + note",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			otherRes := newScope()
+			tc.modify(otherRes)
+			report, err := diffScopes(newScope(), otherRes)
+			if len(tc.expErr) > 0 {
+				require.EqualError(t, err, tc.expErr, "diffScopes error")
+				return
+			}
+			require.NoError(t, err, "diffScopes error")
+			exp := append([]string{"Comparing " + scopeID.String() + " with " + scopeID.String()}, tc.exp...)
+			assert.Equal(t, exp, report, "diffScopes report")
+		})
+	}
+}
@@ -15,7 +15,9 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/version"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
 
+	"github.com/provenance-io/provenance/internal/provcli"
 	attrcli "github.com/provenance-io/provenance/x/attribute/client/cli"
 	"github.com/provenance-io/provenance/x/metadata/types"
 )
@@ -26,6 +28,7 @@ const (
 	AddSwitch              = "add"
 	RemoveSwitch           = "remove"
 	FlagUsdMills           = "usd-mills"
+	FlagBatchSize          = "batch-size"
 )
 
 // NewTxCmd is the top-level command for Metadata CLI transactions.
@@ -46,16 +49,26 @@ func NewTxCmd() *cobra.Command {
 		AddRemoveScopeOwnersCmd(),
 		UpdateValueOwnersCmd(),
 		MigrateValueOwnerCmd(),
+		SubstituteScopePartyCmd(),
+		OpenScopeSettlementCmd(),
+		FundScopeSettlementCmd(),
+		SettleScopeSettlementCmd(),
+		CancelScopeSettlementCmd(),
 
 		BindOsLocatorCmd(),
 		RemoveOsLocatorCmd(),
 		ModifyOsLocatorCmd(),
+		AddOsLocatorURICmd(),
+		RemoveOsLocatorURICmd(),
+		ReorderOsLocatorURIsCmd(),
 
 		WriteScopeSpecificationCmd(),
 		RemoveScopeSpecificationCmd(),
+		GovMigrateScopeSpecificationCmd(),
 
 		WriteContractSpecificationCmd(),
 		RemoveContractSpecificationCmd(),
+		DeprecateContractSpecificationCmd(),
 
 		AddContractSpecToScopeSpecCmd(),
 		RemoveContractSpecFromScopeSpecCmd(),
@@ -64,6 +77,8 @@ func NewTxCmd() *cobra.Command {
 		RemoveRecordSpecificationCmd(),
 
 		WriteSessionCmd(),
+		CompleteSessionCmd(),
+		AbortSessionCmd(),
 
 		WriteRecordCmd(),
 		RemoveRecordCmd(),
@@ -389,13 +404,202 @@ func MigrateValueOwnerCmd() *cobra.Command {
 	return cmd
 }
 
+// SubstituteScopePartyCmd creates a command for replacing one party with another in all of a scope's open sessions.
+func SubstituteScopePartyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "substitute-scope-party <scope id> <existing party> <replacement party>",
+		Aliases: []string{"ssp"},
+		Short:   "Replace one party with another in all of a scope's open sessions.",
+		Long: `Replace one party with another in all of a scope's open sessions, e.g. for a change of custodian.
+Each party keeps its role. The signers must be able to update the scope's owners.`,
+		Example: fmt.Sprintf(`$ %[1]s tx metadata substitute-scope-party scope1qzhpuff00wpy2yuf7xr0rp8aucqstsk0cn pb1sh49f6ze3vn7cdl2amh2gnc70z5mten3dpvr42 pb1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk`,
+			version.AppName),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			scopeID, err := parseScopeIDArg(args[0])
+			if err != nil {
+				return err
+			}
+			existing, err := validateAccAddress(args[1], "existing party")
+			if err != nil {
+				return err
+			}
+			replacement, err := validateAccAddress(args[2], "replacement party")
+			if err != nil {
+				return err
+			}
+			signers, err := parseSigners(cmd, &clientCtx)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSubstituteScopePartyRequest(scopeID, existing, replacement, signers)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	addSignersFlagToCmd(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// OpenScopeSettlementCmd creates a command for starting a sale of a scope's value ownership.
+func OpenScopeSettlementCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "open-scope-settlement <scope id> <buyer> <price>",
+		Short: "Start a sale of a scope's value ownership, putting the scope on hold until it's settled or cancelled.",
+		Long: `Start a sale of a scope's value ownership, putting the scope on hold until it's settled or cancelled.
+The --from account is the seller, and must be the scope's value owner.`,
+		Example: fmt.Sprintf(`$ %[1]s tx metadata open-scope-settlement scope1qzhpuff00wpy2yuf7xr0rp8aucqstsk0cn pb1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk 1000nhash`,
+			version.AppName),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			scopeID, err := parseScopeIDArg(args[0])
+			if err != nil {
+				return err
+			}
+			buyer, err := validateAccAddress(args[1], "buyer")
+			if err != nil {
+				return err
+			}
+			price, err := sdk.ParseCoinsNormalized(args[2])
+			if err != nil {
+				return fmt.Errorf("invalid price %q: %w", args[2], err)
+			}
+
+			msg := types.NewMsgOpenScopeSettlementRequest(scopeID, clientCtx.GetFromAddress().String(), buyer, price)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// FundScopeSettlementCmd creates a command for putting the price of a scope settlement on hold.
+func FundScopeSettlementCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fund-scope-settlement <scope id>",
+		Short: "Put the price of a scope settlement on hold until it's settled or cancelled.",
+		Long: `Put the price of a scope settlement on hold until it's settled or cancelled.
+The --from account must be the buyer in the scope's settlement.`,
+		Example: fmt.Sprintf(`$ %[1]s tx metadata fund-scope-settlement scope1qzhpuff00wpy2yuf7xr0rp8aucqstsk0cn`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			scopeID, err := parseScopeIDArg(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgFundScopeSettlementRequest(scopeID, clientCtx.GetFromAddress().String())
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// SettleScopeSettlementCmd creates a command for settling a funded scope settlement.
+func SettleScopeSettlementCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "settle-scope-settlement <scope id>",
+		Short: "Pay the seller and make the buyer the value owner of a scope with a funded settlement.",
+		Long: `Pay the seller and make the buyer the value owner of a scope with a funded settlement.
+The --from account must be either the seller or buyer in the scope's settlement.`,
+		Example: fmt.Sprintf(`$ %[1]s tx metadata settle-scope-settlement scope1qzhpuff00wpy2yuf7xr0rp8aucqstsk0cn`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			scopeID, err := parseScopeIDArg(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSettleScopeSettlementRequest(scopeID, clientCtx.GetFromAddress().String())
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CancelScopeSettlementCmd creates a command for cancelling a scope settlement.
+func CancelScopeSettlementCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-scope-settlement <scope id>",
+		Short: "Release the holds of a scope settlement without doing the sale.",
+		Long: `Release the holds of a scope settlement without doing the sale.
+The --from account must be either the seller or buyer in the scope's settlement.`,
+		Example: fmt.Sprintf(`$ %[1]s tx metadata cancel-scope-settlement scope1qzhpuff00wpy2yuf7xr0rp8aucqstsk0cn`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			scopeID, err := parseScopeIDArg(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCancelScopeSettlementRequest(scopeID, clientCtx.GetFromAddress().String())
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// parseScopeIDArg parses a scope id argument, e.g. of the scope settlement commands.
+func parseScopeIDArg(arg string) (types.MetadataAddress, error) {
+	scopeID, err := types.MetadataAddressFromBech32(arg)
+	if err != nil {
+		return nil, fmt.Errorf("invalid scope id %q: %w", arg, err)
+	}
+	if !scopeID.IsScopeAddress() {
+		return nil, fmt.Errorf("not a scope identifier: %q", arg)
+	}
+	return scopeID, nil
+}
+
 // BindOsLocatorCmd creates a command for binding an owner to uri in the object store.
 func BindOsLocatorCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:     "bind-locator [owner] [uri]",
-		Short:   "Bind a uri to an owner address on the provenance blockchain",
-		Example: fmt.Sprintf(`$ %[1]s tx metadata bind-locator pb1sh49f6ze3vn7cdl2amh2gnc70z5mten3dpvr42 "http://foo.com"`, version.AppName),
-		Args:    cobra.ExactArgs(2),
+		Use:   "bind-locator [owner] [uri] [failover-uri ...]",
+		Short: "Bind a uri to an owner address on the provenance blockchain",
+		Long: `Bind a uri to an owner address on the provenance blockchain.
+Any failover uris are used, in the order provided, when the uri's endpoint is unavailable.`,
+		Example: fmt.Sprintf(`$ %[1]s tx metadata bind-locator pb1sh49f6ze3vn7cdl2amh2gnc70z5mten3dpvr42 "http://foo.com"
+$ %[1]s tx metadata bind-locator pb1sh49f6ze3vn7cdl2amh2gnc70z5mten3dpvr42 "http://foo.com" "http://backup.foo.com"`, version.AppName),
+		Args: cobra.MinimumNArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -408,7 +612,7 @@ func BindOsLocatorCmd() *cobra.Command {
 			}
 
 			objectStoreLocator := types.ObjectStoreLocator{
-				LocatorUri: args[1], Owner: args[0],
+				LocatorUri: args[1], Owner: args[0], FailoverUris: args[2:],
 			}
 
 			addOSLocator := *types.NewMsgBindOSLocatorRequest(objectStoreLocator)
@@ -485,6 +689,89 @@ func ModifyOsLocatorCmd() *cobra.Command {
 	return cmd
 }
 
+// AddOsLocatorURICmd creates a command to add a failover uri to an owner's object store locator.
+func AddOsLocatorURICmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "add-locator-uri [owner] [uri]",
+		Short:   "Add a failover uri to the end of an owner's object store locator uris on the provenance blockchain",
+		Example: fmt.Sprintf(`$ %[1]s tx metadata add-locator-uri pb1sh49f6ze3vn7cdl2amh2gnc70z5mten3dpvr42 "http://backup.foo.com"`, version.AppName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			if _, errAddr := sdk.AccAddressFromBech32(args[0]); errAddr != nil {
+				return fmt.Errorf("invalid address: %w", errAddr)
+			}
+
+			msg := types.NewMsgAddOSLocatorURIRequest(args[0], args[1])
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// RemoveOsLocatorURICmd creates a command to remove a uri from an owner's object store locator.
+func RemoveOsLocatorURICmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "remove-locator-uri [owner] [uri]",
+		Short:   "Remove a uri from an owner's object store locator uris on the provenance blockchain",
+		Example: fmt.Sprintf(`$ %[1]s tx metadata remove-locator-uri pb1sh49f6ze3vn7cdl2amh2gnc70z5mten3dpvr42 "http://backup.foo.com"`, version.AppName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			if _, errAddr := sdk.AccAddressFromBech32(args[0]); errAddr != nil {
+				return fmt.Errorf("invalid address: %w", errAddr)
+			}
+
+			msg := types.NewMsgRemoveOSLocatorURIRequest(args[0], args[1])
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// ReorderOsLocatorURIsCmd creates a command to change the priority order of an owner's object store locator uris.
+func ReorderOsLocatorURIsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reorder-locator-uris [owner] [uri] [uri ...]",
+		Short: "Change the priority order of an owner's object store locator uris on the provenance blockchain",
+		Long: `Change the priority order of an owner's object store locator uris on the provenance blockchain.
+All of the locator's current uris must be provided, highest priority first.`,
+		Example: fmt.Sprintf(`$ %[1]s tx metadata reorder-locator-uris pb1sh49f6ze3vn7cdl2amh2gnc70z5mten3dpvr42 "http://backup.foo.com" "http://foo.com"`, version.AppName),
+		Args:    cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			if _, errAddr := sdk.AccAddressFromBech32(args[0]); errAddr != nil {
+				return fmt.Errorf("invalid address: %w", errAddr)
+			}
+
+			msg := types.NewMsgReorderOSLocatorURIsRequest(args[0], args[1:])
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // WriteScopeSpecificationCmd creates a command for adding scope specificiation
 func WriteScopeSpecificationCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -773,6 +1060,84 @@ ChFIRUxMTyBQUk9WRU5BTkNFIQ==`, version.AppName),
 	return cmd
 }
 
+// CompleteSessionCmd creates a command to mark a session as completed.
+func CompleteSessionCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "complete-session <session-id>",
+		Short: "Mark an open session as completed",
+		Long: `Mark an open session as completed so that its records can no longer be written.
+The signers must be the same as needed to update the session.`,
+		Example: fmt.Sprintf(`$ %[1]s tx metadata complete-session session1qxge0zaztu65tx5x5llv5xc9zts9sqlch3sxwn44j50jzgt8rshvqyfrjcr`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			sessionID, signers, err := parseSessionStatusArgs(cmd, &clientCtx, args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCompleteSessionRequest(sessionID, signers)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	addSignersFlagToCmd(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// AbortSessionCmd creates a command to mark a session as aborted.
+func AbortSessionCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "abort-session <session-id>",
+		Short: "Mark an open session as aborted",
+		Long: `Mark an open session as aborted so that its records can no longer be written.
+The signers must be the same as needed to update the session.`,
+		Example: fmt.Sprintf(`$ %[1]s tx metadata abort-session session1qxge0zaztu65tx5x5llv5xc9zts9sqlch3sxwn44j50jzgt8rshvqyfrjcr`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			sessionID, signers, err := parseSessionStatusArgs(cmd, &clientCtx, args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgAbortSessionRequest(sessionID, signers)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	addSignersFlagToCmd(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// parseSessionStatusArgs parses the session id argument and signers of the session status commands.
+func parseSessionStatusArgs(cmd *cobra.Command, clientCtx *client.Context, arg string) (types.MetadataAddress, []string, error) {
+	sessionID, err := types.MetadataAddressFromBech32(arg)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid session id %q: %w", arg, err)
+	}
+	if !sessionID.IsSessionAddress() {
+		return nil, nil, fmt.Errorf("not a session identifier: %q", arg)
+	}
+	signers, err := parseSigners(cmd, clientCtx)
+	if err != nil {
+		return nil, nil, err
+	}
+	return sessionID, signers, nil
+}
+
 // WriteRecordCmd creates a command to add/update records
 func WriteRecordCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -998,6 +1363,52 @@ func RemoveScopeSpecificationCmd() *cobra.Command {
 	return cmd
 }
 
+// GovMigrateScopeSpecificationCmd creates a command for submitting a governance proposal to re-point all scopes
+// from one scope specification to another.
+func GovMigrateScopeSpecificationCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "gov-migrate-scope-specification <from-specification-id> <to-specification-id> [--batch-size <size>]",
+		Short: "Submit a governance proposal to re-point all scopes from one scope specification to another",
+		Long: strings.TrimSpace(fmt.Sprintf(`Submit a governance proposal to re-point all scopes from one scope specification to another.
+Once the proposal passes, the scopes are updated at the end of each block, at most --batch-size per block (default %d).`,
+			types.DefaultScopeSpecMigrationBatchSize)),
+		Example: fmt.Sprintf(`$ %[1]s tx metadata gov-migrate-scope-specification scopespec1qnwg86nsatx5pl56muw0v9ytlz3qu3jx6m scopespec1qs30c9axgrw5669ft0kffe6h9gysfe58v3 --batch-size 500 --deposit 50000nhash`, version.AppName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			fromSpecID, err := types.MetadataAddressFromBech32(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid from specification id: %w", err)
+			}
+			toSpecID, err := types.MetadataAddressFromBech32(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid to specification id: %w", err)
+			}
+
+			flagSet := cmd.Flags()
+			batchSize, err := flagSet.GetUint32(FlagBatchSize)
+			if err != nil {
+				return err
+			}
+			authority := provcli.GetAuthority(flagSet)
+
+			msg := types.NewMsgMigrateScopeSpecificationRequest(authority, fromSpecID, toSpecID, batchSize)
+			return provcli.GenerateOrBroadcastTxCLIAsGovProp(clientCtx, flagSet, msg)
+		},
+	}
+
+	cmd.Flags().Uint32(FlagBatchSize, 0, "The maximum number of scopes to update in each block")
+	govcli.AddGovPropFlagsToCmd(cmd)
+	provcli.AddAuthorityFlagToCmd(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // RemoveContractSpecificationCmd creates a command to remove a contract specification
 func RemoveContractSpecificationCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -1033,6 +1444,49 @@ func RemoveContractSpecificationCmd() *cobra.Command {
 	return cmd
 }
 
+// DeprecateContractSpecificationCmd creates a command to deprecate a contract specification
+func DeprecateContractSpecificationCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deprecate-contract-specification [specification-id] [replacement-id]",
+		Short: "Marks a contract specification as deprecated on the provenance blockchain",
+		Long: strings.TrimSpace(`Marks a contract specification as deprecated, optionally with the id of the contract specification that replaces it.
+Sessions can still be written using a deprecated contract specification, but an event is emitted when a new one is.`),
+		Example: fmt.Sprintf(`$ %[1]s tx metadata deprecate-contract-specification contractspec1qdf82glpwpn5ldvyz3aj966jezvq7ws8fr contractspec1q04tavjwvp4yt0a48m6yw0m83nmqj75qxc --from=mykey`, version.AppName),
+		Args:    cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var specificationID, replacementID types.MetadataAddress
+			specificationID, err = types.MetadataAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			if len(args) > 1 {
+				replacementID, err = types.MetadataAddressFromBech32(args[1])
+				if err != nil {
+					return err
+				}
+			}
+
+			signers, err := parseSigners(cmd, &clientCtx)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgDeprecateContractSpecificationRequest(specificationID, replacementID, signers)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	addSignersFlagToCmd(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // RemoveContractSpecFromScopeSpecCmd removes a contract spec from scope spec command
 func RemoveContractSpecFromScopeSpecCmd() *cobra.Command {
 	cmd := &cobra.Command{
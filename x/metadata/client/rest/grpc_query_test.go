@@ -239,7 +239,7 @@ func (s *IntegrationGRPCTestSuite) TestGRPCQueries() {
 			name:     "Get os locator from owner address no req",
 			url:      fmt.Sprintf("%s/provenance/metadata/v1/locator/%s", baseURL, s.ownerAddr.String()),
 			respType: &types.OSLocatorResponse{},
-			expected: &types.OSLocatorResponse{Locator: &s.objectLocator},
+			expected: &types.OSLocatorResponse{Locator: &s.objectLocator, Uris: []string{s.uri}},
 		},
 		{
 			name:     "Get os locator from owner address with req",
@@ -247,6 +247,7 @@ func (s *IntegrationGRPCTestSuite) TestGRPCQueries() {
 			respType: &types.OSLocatorResponse{},
 			expected: &types.OSLocatorResponse{
 				Locator: &s.objectLocator,
+				Uris:    []string{s.uri},
 				Request: &types.OSLocatorRequest{
 					Owner:          s.ownerAddr.String(),
 					IncludeRequest: true,
@@ -81,7 +81,7 @@ func (k *MDBankKeeper) balanceValueOwnerTransformer(key collections.Pair[sdk.Acc
 	mdAddr, err := types.MetadataAddressFromDenom(denom)
 	if err != nil {
 		// MetadataAddressFromDenom always includes the denom in the error message, so we don't need it again here.
-		k.Logger().Error(fmt.Sprintf("invalid metadata balance entry for account %q: %v", accAddr.String(), err))
+		k.Logger().Error("invalid metadata balance entry", "account", accAddr.String(), "error", err)
 	}
 	return types.NewAccMDLink(accAddr, mdAddr)
 }
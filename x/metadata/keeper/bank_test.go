@@ -3,6 +3,7 @@ package keeper_test
 import (
 	"bytes"
 	"fmt"
+	"strconv"
 	"strings"
 	"testing"
 
@@ -243,8 +244,9 @@ func (s *BankTestSuite) TestGetScopesForValueOwner() {
 	)
 
 	logMsg := func(owner sdk.AccAddress, denom, err string) string {
-		return "ERR invalid metadata balance entry for account \"" + owner.String() + "\": " +
-			"invalid metadata address in denom \"" + denom + "\": " + err + " module=x/bank"
+		return "ERR invalid metadata balance entry " +
+			"error=" + strconv.Quote("invalid metadata address in denom \""+denom+"\": "+err) +
+			" account=" + owner.String() + " module=x/bank"
 	}
 	badChecksumErr := func(expected, actual string) string {
 		return "decoding bech32 failed: invalid checksum (expected " + expected + " got " + actual + ")"
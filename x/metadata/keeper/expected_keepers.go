@@ -4,10 +4,15 @@ import (
 	"context"
 	"time"
 
+	storetypes "cosmossdk.io/store/types"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/x/authz"
 
+	connectiontypes "github.com/cosmos/ibc-go/v8/modules/core/03-connection/types"
+	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
+
 	markertypes "github.com/provenance-io/provenance/x/marker/types"
 	"github.com/provenance-io/provenance/x/metadata/types"
 )
@@ -36,6 +41,24 @@ type MarkerKeeper interface {
 	IsMarkerAccount(ctx sdk.Context, addr sdk.AccAddress) bool
 }
 
+// HoldKeeper defines the hold functionality needed by the metadata module.
+type HoldKeeper interface {
+	AddHold(ctx sdk.Context, addr sdk.AccAddress, funds sdk.Coins, reason string) error
+	ReleaseHold(ctx sdk.Context, addr sdk.AccAddress, funds sdk.Coins) error
+}
+
+// IBCClientKeeper defines the IBC client functionality needed by the metadata module to verify ibc proofs.
+type IBCClientKeeper interface {
+	GetClientState(ctx sdk.Context, clientID string) (ibcexported.ClientState, bool)
+	GetClientStatus(ctx sdk.Context, clientState ibcexported.ClientState, clientID string) ibcexported.Status
+	ClientStore(ctx sdk.Context, clientID string) storetypes.KVStore
+}
+
+// IBCConnectionKeeper defines the IBC connection functionality needed by the metadata module to verify ibc proofs.
+type IBCConnectionKeeper interface {
+	GetConnection(ctx sdk.Context, connectionID string) (connectiontypes.ConnectionEnd, bool)
+}
+
 type BankKeeper interface {
 	BlockedAddr(addr sdk.AccAddress) bool
 	MintCoins(ctx context.Context, moduleName string, amt sdk.Coins) error
@@ -56,7 +56,7 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *types.GenesisState) {
 			if strings.TrimSpace(s.EncryptionKey) != "" {
 				encryptionKey, _ = sdk.AccAddressFromBech32(s.EncryptionKey)
 			}
-			err = k.ImportOSLocatorRecord(ctx, addr, encryptionKey, s.LocatorUri)
+			err = k.ImportOSLocatorRecord(ctx, addr, encryptionKey, s.LocatorUri, s.FailoverUris...)
 			if err != nil {
 				panic(err)
 			}
@@ -80,6 +80,24 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *types.GenesisState) {
 			}
 		}
 	}
+
+	for _, migration := range data.ScopeSpecMigrations {
+		if err := k.SetScopeSpecMigration(ctx, migration); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, change := range data.ScopeAccessChanges {
+		if err := k.SetScopeAccessChange(ctx, change); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, settlement := range data.ScopeSettlements {
+		if err := k.SetScopeSettlement(ctx, settlement); err != nil {
+			panic(err)
+		}
+	}
 }
 
 // ExportGenesis exports the current keeper state of the metadata module.ExportGenesis
@@ -168,5 +186,36 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) (data *types.GenesisState) {
 		markerNetAssetValues[i] = markerNavs
 	}
 
-	return types.NewGenesisState(types.Params{}, oslocatorparams, scopes, sessions, records, scopeSpecs, contractSpecs, recordSpecs, objectStoreLocators, markerNetAssetValues)
+	var scopeSpecMigrations []types.ScopeSpecMigration
+	err := k.IterateScopeSpecMigrations(ctx, func(migration types.ScopeSpecMigration) bool {
+		scopeSpecMigrations = append(scopeSpecMigrations, migration)
+		return false
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	var scopeAccessChanges []types.ScopeAccessChange
+	err = k.IterateScopeAccessChanges(ctx, func(change types.ScopeAccessChange) bool {
+		scopeAccessChanges = append(scopeAccessChanges, change)
+		return false
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	var scopeSettlements []types.ScopeSettlement
+	err = k.IterateScopeSettlements(ctx, func(settlement types.ScopeSettlement) bool {
+		scopeSettlements = append(scopeSettlements, settlement)
+		return false
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	genState := types.NewGenesisState(types.Params{}, oslocatorparams, scopes, sessions, records, scopeSpecs, contractSpecs, recordSpecs, objectStoreLocators, markerNetAssetValues)
+	genState.ScopeSpecMigrations = scopeSpecMigrations
+	genState.ScopeAccessChanges = scopeAccessChanges
+	genState.ScopeSettlements = scopeSettlements
+	return genState
 }
@@ -0,0 +1,58 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	connectiontypes "github.com/cosmos/ibc-go/v8/modules/core/03-connection/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v8/modules/core/23-commitment/types"
+	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
+
+	"github.com/provenance-io/provenance/x/metadata/types"
+)
+
+// VerifyIBCProof checks that the proof's value is committed at its key path in the state of the chain
+// tracked by the proof's client. The connection must be open and use the client, and the client must be active.
+func (k Keeper) VerifyIBCProof(ctx sdk.Context, proof *types.IBCProof) error {
+	if proof == nil {
+		return fmt.Errorf("ibc proof cannot be nil")
+	}
+	if k.ibcClientKeeper == nil || k.ibcConnectionKeeper == nil {
+		return fmt.Errorf("ibc proofs are not supported")
+	}
+
+	connection, found := k.ibcConnectionKeeper.GetConnection(ctx, proof.ConnectionId)
+	if !found {
+		return fmt.Errorf("connection %q not found", proof.ConnectionId)
+	}
+	if connection.State != connectiontypes.OPEN {
+		return fmt.Errorf("connection %q is not open: %s", proof.ConnectionId, connection.State)
+	}
+	if connection.ClientId != proof.ClientId {
+		return fmt.Errorf("connection %q uses client %q, not %q", proof.ConnectionId, connection.ClientId, proof.ClientId)
+	}
+
+	clientState, found := k.ibcClientKeeper.GetClientState(ctx, proof.ClientId)
+	if !found {
+		return fmt.Errorf("client %q not found", proof.ClientId)
+	}
+	if status := k.ibcClientKeeper.GetClientStatus(ctx, clientState, proof.ClientId); status != ibcexported.Active {
+		return fmt.Errorf("client %q is not active: %s", proof.ClientId, status)
+	}
+
+	keyPath := make([]string, len(proof.KeyPath))
+	for i, key := range proof.KeyPath {
+		keyPath[i] = string(key)
+	}
+	err := clientState.VerifyMembership(
+		ctx, k.ibcClientKeeper.ClientStore(ctx, proof.ClientId), k.cdc, proof.ProofHeight,
+		0, 0, // There's no packet involved, so delay periods don't apply.
+		proof.Proof, commitmenttypes.NewMerklePath(keyPath...), proof.Value,
+	)
+	if err != nil {
+		// The ibc errors include where they came from when formatted using %v or %w, so just the message is used.
+		return fmt.Errorf("could not verify value at height %s: %s", proof.ProofHeight, err.Error())
+	}
+	return nil
+}
@@ -0,0 +1,150 @@
+package keeper_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+
+	"cosmossdk.io/log"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+
+	"github.com/provenance-io/provenance/app"
+	"github.com/provenance-io/provenance/internal/pioconfig"
+	testutil "github.com/provenance-io/provenance/testutil/ibc"
+	"github.com/provenance-io/provenance/x/metadata/types"
+)
+
+type IBCProofTestSuite struct {
+	suite.Suite
+
+	coordinator *ibctesting.Coordinator
+	chainA      *testutil.TestChain
+	chainB      *testutil.TestChain
+	path        *ibctesting.Path
+}
+
+func TestIBCProofTestSuite(t *testing.T) {
+	suite.Run(t, new(IBCProofTestSuite))
+}
+
+func (s *IBCProofTestSuite) SetupTest() {
+	ibctesting.DefaultTestingAppInit = func() (ibctesting.TestingApp, map[string]json.RawMessage) {
+		pioconfig.SetProvenanceConfig(sdk.DefaultBondDenom, 0)
+		appOpts := simtestutil.AppOptionsMap{
+			flags.FlagHome:            s.T().TempDir(),
+			server.FlagInvCheckPeriod: 5,
+		}
+		provenanceApp := app.New(log.NewNopLogger(), dbm.NewMemDB(), nil, true, appOpts)
+		return provenanceApp, provenanceApp.DefaultGenesis()
+	}
+	s.coordinator = ibctesting.NewCoordinator(s.T(), 2)
+	s.chainA = &testutil.TestChain{TestChain: s.coordinator.GetChain(ibctesting.GetChainID(1))}
+	s.chainB = &testutil.TestChain{TestChain: s.coordinator.GetChain(ibctesting.GetChainID(2))}
+	s.path = ibctesting.NewPath(s.chainA.TestChain, s.chainB.TestChain)
+	s.coordinator.SetupConnections(s.path)
+}
+
+// scopeProof stores a scope on chain B and returns a proof of it that chain A can verify.
+func (s *IBCProofTestSuite) scopeProof() *types.IBCProof {
+	appB := s.chainB.GetProvenanceApp()
+	scopeID := types.ScopeMetadataAddress(uuid.New())
+	owner := s.chainB.SenderAccount.GetAddress().String()
+	scope := types.NewScope(scopeID, nil, ownerPartyList(owner), []string{}, owner, false)
+	appB.MetadataKeeper.SetScope(s.chainB.GetContext(), *scope)
+	value := s.chainB.GetContext().KVStore(appB.GetKey(types.StoreKey)).Get(scopeID)
+	s.Require().NotEmpty(value, "scope value stored on chain B")
+	s.coordinator.CommitBlock(s.chainB.TestChain)
+	s.Require().NoError(s.path.EndpointA.UpdateClient(), "UpdateClient")
+
+	proof, height := s.chainB.QueryProofForStore(types.StoreKey, scopeID, s.chainB.App.LastBlockHeight())
+	return &types.IBCProof{
+		ClientId:     s.path.EndpointA.ClientID,
+		ConnectionId: s.path.EndpointA.ConnectionID,
+		ProofHeight:  height,
+		KeyPath:      [][]byte{[]byte(types.StoreKey), scopeID},
+		Value:        value,
+		Proof:        proof,
+	}
+}
+
+func (s *IBCProofTestSuite) TestVerifyIBCProof() {
+	validProof := s.scopeProof()
+	modified := func(modify func(p *types.IBCProof)) *types.IBCProof {
+		rv := *validProof
+		modify(&rv)
+		return &rv
+	}
+
+	tests := []struct {
+		name     string
+		proof    *types.IBCProof
+		expErr   string
+		expInErr []string
+	}{
+		{
+			name:  "valid proof",
+			proof: validProof,
+		},
+		{
+			name:   "nil proof",
+			proof:  nil,
+			expErr: "ibc proof cannot be nil",
+		},
+		{
+			name:   "unknown connection",
+			proof:  modified(func(p *types.IBCProof) { p.ConnectionId = "connection-99" }),
+			expErr: `connection "connection-99" not found`,
+		},
+		{
+			name:  "connection uses another client",
+			proof: modified(func(p *types.IBCProof) { p.ClientId = "07-tendermint-99" }),
+			expErr: `connection "` + validProof.ConnectionId + `" uses client "` + validProof.ClientId +
+				`", not "07-tendermint-99"`,
+		},
+		{
+			name:     "wrong value",
+			proof:    modified(func(p *types.IBCProof) { p.Value = []byte("not the scope") }),
+			expInErr: []string{"could not verify value at height " + validProof.ProofHeight.String()},
+		},
+		{
+			name: "wrong key path",
+			proof: modified(func(p *types.IBCProof) {
+				p.KeyPath = [][]byte{[]byte(types.StoreKey), types.ScopeMetadataAddress(uuid.New())}
+			}),
+			expInErr: []string{"could not verify value at height " + validProof.ProofHeight.String()},
+		},
+		{
+			name: "height after the client's latest height",
+			proof: modified(func(p *types.IBCProof) {
+				p.ProofHeight = clienttypes.NewHeight(p.ProofHeight.RevisionNumber, p.ProofHeight.RevisionHeight+100)
+			}),
+			expInErr: []string{"could not verify value at height", "please ensure the client has been updated"},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			err := s.chainA.GetProvenanceApp().MetadataKeeper.VerifyIBCProof(s.chainA.GetContext(), tc.proof)
+			switch {
+			case len(tc.expErr) > 0:
+				s.Assert().EqualError(err, tc.expErr, "VerifyIBCProof")
+			case len(tc.expInErr) > 0:
+				for _, exp := range tc.expInErr {
+					s.Assert().ErrorContains(err, exp, "VerifyIBCProof")
+				}
+			default:
+				s.Assert().NoError(err, "VerifyIBCProof")
+			}
+		})
+	}
+}
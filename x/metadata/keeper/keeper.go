@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"net/url"
+	"strings"
 
 	"cosmossdk.io/log"
 	storetypes "cosmossdk.io/store/types"
@@ -10,8 +11,10 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/cosmos/gogoproto/proto"
 
+	"github.com/provenance-io/provenance/internal/provutils"
 	"github.com/provenance-io/provenance/x/metadata/types"
 )
 
@@ -37,13 +40,24 @@ type Keeper struct {
 
 	// For managing value owners
 	bankKeeper BankKeeper
+
+	// For holding scopes and funds during a scope settlement
+	holdKeeper HoldKeeper
+
+	// For verifying record inputs that come from other chains
+	ibcClientKeeper     IBCClientKeeper
+	ibcConnectionKeeper IBCConnectionKeeper
+
+	// the signing authority for the gov proposals
+	authority string
 }
 
 // NewKeeper creates new instances of the metadata Keeper.
 func NewKeeper(
 	cdc codec.BinaryCodec, key storetypes.StoreKey, authKeeper AuthKeeper,
 	authzKeeper AuthzKeeper, attrKeeper AttrKeeper, markerKeeper MarkerKeeper,
-	bankKeeper bankkeeper.BaseKeeper,
+	bankKeeper bankkeeper.BaseKeeper, holdKeeper HoldKeeper,
+	ibcClientKeeper IBCClientKeeper, ibcConnectionKeeper IBCConnectionKeeper,
 ) Keeper {
 	return Keeper{
 		storeKey:     key,
@@ -54,12 +68,31 @@ func NewKeeper(
 		attrKeeper:   attrKeeper,
 		markerKeeper: markerKeeper,
 		bankKeeper:   NewMDBankKeeper(bankKeeper),
+		holdKeeper:   holdKeeper,
+
+		ibcClientKeeper:     ibcClientKeeper,
+		ibcConnectionKeeper: ibcConnectionKeeper,
+
+		authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	}
 }
 
 // Logger returns a module-specific logger.
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
-	return ctx.Logger().With("module", "x/"+types.ModuleName)
+	return provutils.ModuleLogger(ctx, types.ModuleName)
+}
+
+// GetAuthority is signer of the proposal
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// ValidateAuthority returns an error if the provided address is not the authority.
+func (k Keeper) ValidateAuthority(addr string) error {
+	if !strings.EqualFold(k.authority, addr) {
+		return govtypes.ErrInvalidSigner.Wrapf("expected %q got %q", k.GetAuthority(), addr)
+	}
+	return nil
 }
 
 // VerifyCorrectOwner to determines whether the signer resolves to the owner of the OSLocator record.
@@ -74,7 +107,7 @@ func (k Keeper) VerifyCorrectOwner(ctx sdk.Context, ownerAddr sdk.AccAddress) bo
 func (k Keeper) EmitEvent(ctx sdk.Context, event proto.Message) {
 	err := ctx.EventManager().EmitTypedEvent(event)
 	if err != nil {
-		ctx.Logger().Error("unable to emit event", "error", err, "event", event)
+		k.Logger(ctx).Error("unable to emit event", "error", err, "event", event)
 	}
 }
 
@@ -223,6 +223,91 @@ func (s *KeeperTestSuite) TestModifyOSLocator() {
 	})
 }
 
+func (s *KeeperTestSuite) TestOSLocatorFailoverURIs() {
+	owner := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	s.app.AccountKeeper.SetAccount(s.ctx, s.app.AccountKeeper.NewAccountWithAddress(s.ctx, owner))
+	primary, backup1, backup2 := "https://os.example.com", "https://backup1.example.com", "https://backup2.example.com"
+
+	assertURIs := func(expected ...string) bool {
+		r, found := s.app.MetadataKeeper.GetOsLocatorRecord(s.ctx, owner)
+		return s.Assert().True(found, "GetOsLocatorRecord found") &&
+			s.Assert().Equal(expected, r.AllURIs(), "locator uris")
+	}
+
+	s.Run("bind with duplicate failover uri", func() {
+		err := s.app.MetadataKeeper.SetOSLocator(s.ctx, owner, s.encryptionKey, primary, backup1, primary)
+		s.Require().EqualError(err, "duplicate uri: "+primary)
+		s.Assert().False(s.app.MetadataKeeper.OSLocatorExists(s.ctx, owner), "OSLocatorExists")
+	})
+
+	s.Run("bind with failover uri", func() {
+		err := s.app.MetadataKeeper.SetOSLocator(s.ctx, owner, s.encryptionKey, primary, backup1)
+		s.Require().NoError(err, "SetOSLocator")
+		assertURIs(primary, backup1)
+	})
+
+	s.Run("add uri", func() {
+		locator, err := s.app.MetadataKeeper.AddOSLocatorURI(s.ctx, owner, backup2)
+		s.Require().NoError(err, "AddOSLocatorURI")
+		s.Assert().Equal([]string{primary, backup1, backup2}, locator.AllURIs(), "returned locator uris")
+		assertURIs(primary, backup1, backup2)
+	})
+
+	s.Run("add existing uri", func() {
+		_, err := s.app.MetadataKeeper.AddOSLocatorURI(s.ctx, owner, backup1)
+		s.Require().EqualError(err, "duplicate uri: "+backup1)
+	})
+
+	s.Run("add invalid uri", func() {
+		_, err := s.app.MetadataKeeper.AddOSLocatorURI(s.ctx, owner, "backup3.example.com")
+		s.Require().ErrorIs(err, types.ErrOSLocatorURIInvalid)
+	})
+
+	s.Run("add uri to unbound owner", func() {
+		_, err := s.app.MetadataKeeper.AddOSLocatorURI(s.ctx, s.user3Addr, backup1)
+		s.Require().ErrorIs(err, types.ErrAddressNotBound)
+	})
+
+	s.Run("reorder missing uri", func() {
+		_, err := s.app.MetadataKeeper.ReorderOSLocatorURIs(s.ctx, owner, []string{backup2, primary})
+		s.Require().EqualError(err, "expected 3 uris, got 2: all of the locator's uris must be provided")
+	})
+
+	s.Run("reorder unknown uri", func() {
+		_, err := s.app.MetadataKeeper.ReorderOSLocatorURIs(s.ctx, owner, []string{backup2, primary, "https://other.example.com"})
+		s.Require().EqualError(err, `uri "https://other.example.com" is not one of the locator's uris`)
+	})
+
+	s.Run("reorder", func() {
+		_, err := s.app.MetadataKeeper.ReorderOSLocatorURIs(s.ctx, owner, []string{backup2, primary, backup1})
+		s.Require().NoError(err, "ReorderOSLocatorURIs")
+		assertURIs(backup2, primary, backup1)
+	})
+
+	s.Run("modify keeps failover uris", func() {
+		err := s.app.MetadataKeeper.ModifyOSLocator(s.ctx, owner, s.encryptionKey, backup1)
+		s.Require().NoError(err, "ModifyOSLocator")
+		assertURIs(backup1, primary)
+	})
+
+	s.Run("remove locator uri", func() {
+		_, err := s.app.MetadataKeeper.RemoveOSLocatorURI(s.ctx, owner, backup1)
+		s.Require().NoError(err, "RemoveOSLocatorURI")
+		assertURIs(primary)
+	})
+
+	s.Run("remove unknown uri", func() {
+		_, err := s.app.MetadataKeeper.RemoveOSLocatorURI(s.ctx, owner, backup1)
+		s.Require().EqualError(err, `uri "https://backup1.example.com" is not one of the locator's uris`)
+	})
+
+	s.Run("remove only uri", func() {
+		_, err := s.app.MetadataKeeper.RemoveOSLocatorURI(s.ctx, owner, primary)
+		s.Require().EqualError(err, `cannot remove the locator's only uri "https://os.example.com"`)
+		assertURIs(primary)
+	})
+}
+
 func (s *KeeperTestSuite) TestDeleteOSLocator() {
 	s.Run("delete os locator", func() {
 		// modify os locator
@@ -111,7 +111,7 @@ func migrateValueOwners(ctx sdk.Context, kpr keeper3To4I) error {
 		var scope types.Scope
 		if err := kpr.Unmarshal(scopeBz, &scope); err != nil {
 			scopeID := types.MetadataAddress(it.Key())
-			logger.Error(fmt.Sprintf("[%d]: ScopeID=%q", scopeCount, scopeID), "bytes", scopeBz)
+			logger.Error("Could not read scope.", "index", scopeCount, "scope_id", scopeID.String(), "bytes", scopeBz)
 			return fmt.Errorf("error reading scope %s from state: %w", scopeID, err)
 		}
 
@@ -212,7 +212,7 @@ func TestMigrate3to4(t *testing.T) {
 			expLogs: []string{
 				"INF Starting migration of x/metadata from 3 to 4. module=x/metadata",
 				"INF Moving scope value owner data into x/bank ledger. module=x/metadata",
-				"ERR [1]: ScopeID=\"" + newScopeID(5000000000000000).String() + "\" bytes=\"\\x00\\x00\\x00\" module=x/metadata",
+				"ERR Could not read scope. bytes=\"\\x00\\x00\\x00\" index=1 module=x/metadata scope_id=" + newScopeID(5000000000000000).String(),
 				"ERR Error migrating scope value owners. error=\"error reading scope " +
 					newScopeID(5000000000000000).String() + " from state: proto: Scope: illegal tag 0 (wire type 0)\" " +
 					"module=x/metadata",
@@ -313,8 +313,8 @@ func TestMigrateValueOwners(t *testing.T) {
 			expErr:           "error reading scope " + newScopeID(123_456_789).String() + " from state: yoko was not wrong",
 			expLogs: []string{
 				"INF Moving scope value owner data into x/bank ledger.",
-				"ERR [1]: ScopeID=\"" + newScopeID(123_456_789).String() +
-					"\" bytes=\"\\n\\x11\\x00_______123456789\\x12\\x11\\x04___________30944*-" + newAddr("vo_addr1").String() + "\"",
+				"ERR Could not read scope. bytes=\"\\n\\x11\\x00_______123456789\\x12\\x11\\x04___________30944*-" + newAddr("vo_addr1").String() +
+					"\" index=1 scope_id=" + newScopeID(123_456_789).String(),
 			},
 		},
 		{
@@ -366,8 +366,8 @@ func TestMigrateValueOwners(t *testing.T) {
 			expErr:           "error reading scope " + newScopeID(6).String() + " from state: radiohead is only okay",
 			expLogs: []string{
 				"INF Moving scope value owner data into x/bank ledger.",
-				"ERR [2]: ScopeID=\"" + newScopeID(6).String() +
-					"\" bytes=\"\\n\\x11\\x00_______________6\\x12\\x11\\x04___________42954*-" + newAddr("addr2").String() + "\"",
+				"ERR Could not read scope. bytes=\"\\n\\x11\\x00_______________6\\x12\\x11\\x04___________42954*-" + newAddr("addr2").String() +
+					"\" index=2 scope_id=" + newScopeID(6).String(),
 			},
 			expSetCount: 1,
 			expDelCount: 1,
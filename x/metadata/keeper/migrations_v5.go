@@ -0,0 +1,29 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/metadata/types"
+)
+
+// Migrate4To5 will update the metadata store from version 4 to version 5.
+// It adds the owner index entries of all existing record specifications.
+func (m Migrator) Migrate4To5(ctx sdk.Context) error {
+	logger := m.keeper.Logger(ctx)
+	logger.Info("Starting migration of x/metadata from 4 to 5.")
+	store := ctx.KVStore(m.keeper.storeKey)
+	count := 0
+	err := m.keeper.IterateRecordSpecs(ctx, func(spec types.RecordSpecification) bool {
+		for _, indexKey := range m.keeper.getRecordSpecIndexKeys(ctx, spec.SpecificationId) {
+			store.Set(indexKey, []byte{0x01})
+		}
+		count++
+		return false
+	})
+	if err != nil {
+		logger.Error("Error indexing record specification owners.", "error", err)
+		return err
+	}
+	logger.Info("Done migrating x/metadata from 4 to 5.", "record specs indexed", count)
+	return nil
+}
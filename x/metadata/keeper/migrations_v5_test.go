@@ -0,0 +1,60 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	simapp "github.com/provenance-io/provenance/app"
+	"github.com/provenance-io/provenance/x/metadata/keeper"
+	"github.com/provenance-io/provenance/x/metadata/types"
+)
+
+func TestMigrate4to5(t *testing.T) {
+	app := simapp.Setup(t)
+	ctx := FreshCtx(app)
+	store := ctx.KVStore(app.GetKey(types.StoreKey))
+
+	owner1, owner2 := newAddr("one"), newAddr("two")
+	contractSpecID := types.ContractSpecMetadataAddress(uuid.New())
+	app.MetadataKeeper.SetContractSpecification(ctx, types.ContractSpecification{
+		SpecificationId: contractSpecID,
+		OwnerAddresses:  []string{owner1.String(), owner2.String()},
+	})
+	orphanSpecID := types.ContractSpecMetadataAddress(uuid.New()).MustGetAsRecordSpecAddress("orphan")
+
+	var recSpecIDs []types.MetadataAddress
+	for _, name := range []string{"first", "second"} {
+		recSpecIDs = append(recSpecIDs, contractSpecID.MustGetAsRecordSpecAddress(name))
+	}
+	for _, recSpecID := range append(recSpecIDs, orphanSpecID) {
+		app.MetadataKeeper.SetRecordSpecification(ctx, types.RecordSpecification{
+			SpecificationId: recSpecID,
+			Name:            "name",
+			TypeName:        "type",
+			ResultType:      types.DefinitionType_DEFINITION_TYPE_RECORD,
+		})
+	}
+
+	// Delete the index entries to simulate state from before they existed.
+	for _, owner := range []sdk.AccAddress{owner1, owner2} {
+		for _, recSpecID := range recSpecIDs {
+			key := types.GetAddressRecordSpecCacheKey(owner, recSpecID)
+			require.True(t, store.Has(key), "index entry for %s before deleting", recSpecID)
+			store.Delete(key)
+		}
+	}
+
+	migrator := keeper.NewMigrator(app.MetadataKeeper)
+	require.NoError(t, migrator.Migrate4To5(ctx), "Migrate4To5")
+
+	for _, recSpecID := range recSpecIDs {
+		assert.True(t, store.Has(types.GetAddressRecordSpecCacheKey(owner1, recSpecID)), "owner1 index entry for %s", recSpecID)
+		assert.True(t, store.Has(types.GetAddressRecordSpecCacheKey(owner2, recSpecID)), "owner2 index entry for %s", recSpecID)
+	}
+	assert.False(t, store.Has(types.GetAddressRecordSpecCacheKey(owner1, orphanSpecID)), "owner1 index entry for orphan record spec")
+}
@@ -0,0 +1,29 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/metadata/types"
+)
+
+// Migrate5To6 will update the metadata store from version 5 to version 6.
+// It adds the process index entries of all existing records.
+func (m Migrator) Migrate5To6(ctx sdk.Context) error {
+	logger := m.keeper.Logger(ctx)
+	logger.Info("Starting migration of x/metadata from 5 to 6.")
+	store := ctx.KVStore(m.keeper.storeKey)
+	count := 0
+	err := m.keeper.IterateRecords(ctx, types.MetadataAddress{}, func(record types.Record) bool {
+		if indexKey := getRecordProcessIndexKey(record, record.GetRecordAddress()); indexKey != nil {
+			store.Set(indexKey, []byte{0x01})
+			count++
+		}
+		return false
+	})
+	if err != nil {
+		logger.Error("Error indexing record processes.", "error", err)
+		return err
+	}
+	logger.Info("Done migrating x/metadata from 5 to 6.", "records indexed", count)
+	return nil
+}
@@ -0,0 +1,54 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	simapp "github.com/provenance-io/provenance/app"
+	"github.com/provenance-io/provenance/x/metadata/keeper"
+	"github.com/provenance-io/provenance/x/metadata/types"
+)
+
+func TestMigrate5to6(t *testing.T) {
+	app := simapp.Setup(t)
+	ctx := FreshCtx(app)
+	store := ctx.KVStore(app.GetKey(types.StoreKey))
+
+	scopeUUID := uuid.New()
+	sessionID := types.SessionMetadataAddress(scopeUUID, uuid.New())
+	recSpecID := types.RecordSpecMetadataAddress(uuid.New(), "name")
+
+	var keys [][]byte
+	for _, name := range []string{"first", "second"} {
+		process := types.NewProcess("processname", &types.Process_Hash{Hash: "HASH"}, name+"_method")
+		record := types.NewRecord(name, sessionID, *process, []types.RecordInput{}, []types.RecordOutput{}, recSpecID)
+		app.MetadataKeeper.SetRecord(ctx, *record)
+		keys = append(keys, types.GetProcessRecordCacheKey("HASH", name+"_method", record.GetRecordAddress()))
+	}
+	noHash := types.NewRecord("nohash", sessionID, *types.NewProcess("processname", &types.Process_Address{Address: newAddr("proc").String()}, "m"),
+		[]types.RecordInput{}, []types.RecordOutput{}, recSpecID)
+	app.MetadataKeeper.SetRecord(ctx, *noHash)
+
+	// Delete the index entries to simulate state from before they existed.
+	for _, key := range keys {
+		require.True(t, store.Has(key), "index entry %X before deleting", key)
+		store.Delete(key)
+	}
+
+	migrator := keeper.NewMigrator(app.MetadataKeeper)
+	require.NoError(t, migrator.Migrate5To6(ctx), "Migrate5To6")
+
+	for _, key := range keys {
+		assert.True(t, store.Has(key), "index entry %X after migration", key)
+	}
+	it := store.Iterator(types.ProcessRecordCacheKeyPrefix, []byte{types.ProcessRecordCacheKeyPrefix[0] + 1})
+	defer it.Close()
+	count := 0
+	for ; it.Valid(); it.Next() {
+		count++
+	}
+	assert.Equal(t, len(keys), count, "number of process index entries")
+}
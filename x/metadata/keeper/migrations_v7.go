@@ -0,0 +1,27 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/metadata/types"
+)
+
+// Migrate6To7 will update the metadata store from version 6 to version 7.
+// It adds the contract spec index entries of all existing sessions.
+func (m Migrator) Migrate6To7(ctx sdk.Context) error {
+	logger := m.keeper.Logger(ctx)
+	logger.Info("Starting migration of x/metadata from 6 to 7.")
+	store := ctx.KVStore(m.keeper.storeKey)
+	count := 0
+	err := m.keeper.IterateSessions(ctx, types.MetadataAddress{}, func(session types.Session) bool {
+		store.Set(types.GetContractSpecSessionCacheKey(session.SpecificationId, session.SessionId), []byte{0x01})
+		count++
+		return false
+	})
+	if err != nil {
+		logger.Error("Error indexing sessions.", "error", err)
+		return err
+	}
+	logger.Info("Done migrating x/metadata from 6 to 7.", "sessions indexed", count)
+	return nil
+}
@@ -0,0 +1,56 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	simapp "github.com/provenance-io/provenance/app"
+	"github.com/provenance-io/provenance/x/metadata/keeper"
+	"github.com/provenance-io/provenance/x/metadata/types"
+)
+
+func TestMigrate6to7(t *testing.T) {
+	app := simapp.Setup(t)
+	ctx := FreshCtx(app)
+	store := ctx.KVStore(app.GetKey(types.StoreKey))
+
+	scopeUUID := uuid.New()
+	contractSpecIDs := []types.MetadataAddress{
+		types.ContractSpecMetadataAddress(uuid.New()),
+		types.ContractSpecMetadataAddress(uuid.New()),
+	}
+
+	var keys [][]byte
+	for i, name := range []string{"first", "second", "third"} {
+		session := types.Session{
+			SessionId:       types.SessionMetadataAddress(scopeUUID, uuid.New()),
+			SpecificationId: contractSpecIDs[i%2],
+			Name:            name,
+		}
+		app.MetadataKeeper.SetSession(ctx, session)
+		keys = append(keys, types.GetContractSpecSessionCacheKey(session.SpecificationId, session.SessionId))
+	}
+
+	// Delete the index entries to simulate state from before they existed.
+	for _, key := range keys {
+		require.True(t, store.Has(key), "index entry %X before deleting", key)
+		store.Delete(key)
+	}
+
+	migrator := keeper.NewMigrator(app.MetadataKeeper)
+	require.NoError(t, migrator.Migrate6To7(ctx), "Migrate6To7")
+
+	for _, key := range keys {
+		assert.True(t, store.Has(key), "index entry %X after migration", key)
+	}
+	it := store.Iterator(types.ContractSpecSessionCacheKeyPrefix, []byte{types.ContractSpecSessionCacheKeyPrefix[0] + 1})
+	defer it.Close()
+	count := 0
+	for ; it.Valid(); it.Next() {
+		count++
+	}
+	assert.Equal(t, len(keys), count, "number of contract spec session index entries")
+}
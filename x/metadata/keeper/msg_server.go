@@ -55,12 +55,32 @@ func (k msgServer) WriteScope(
 		}
 	}
 
+	var oldDataAccess []string
+	if existing, found := k.GetScope(ctx, msg.Scope.ScopeId); found {
+		oldDataAccess = existing.DataAccess
+	}
+	oldValueOwner, err := k.getScopeValueOwnerStr(ctx, msg.Scope.ScopeId)
+	if err != nil {
+		return nil, fmt.Errorf("could not get value owner of scope %q: %w", msg.Scope.ScopeId, err)
+	}
+
 	err = k.SetScope(markertypes.WithTransferAgents(ctx, transferAgents...), msg.Scope)
 	if err != nil {
 		return nil, fmt.Errorf("could not write scope %q: %w", msg.Scope.ScopeId, err)
 	}
 
-	k.EmitEvent(ctx, types.NewEventTxCompleted(types.TxEndpoint_WriteScope, msg.GetSignerStrs()))
+	signers := msg.GetSignerStrs()
+	if err = k.recordScopeDataAccessChange(ctx, msg.Scope.ScopeId, oldDataAccess, msg.Scope.DataAccess, signers); err != nil {
+		return nil, err
+	}
+	// An empty value owner in the msg means the scope's value owner isn't being changed.
+	if len(msg.Scope.ValueOwnerAddress) > 0 {
+		if err = k.recordScopeValueOwnerChange(ctx, msg.Scope.ScopeId, oldValueOwner, msg.Scope.ValueOwnerAddress, signers); err != nil {
+			return nil, err
+		}
+	}
+
+	k.EmitEvent(ctx, types.NewEventTxCompleted(types.TxEndpoint_WriteScope, signers))
 	return types.NewMsgWriteScopeResponse(msg.Scope.ScopeId), nil
 }
 
@@ -77,6 +97,12 @@ func (k msgServer) DeleteScope(
 		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
 	}
 
+	existing, _ := k.GetScope(ctx, msg.ScopeId)
+	oldValueOwner, err := k.getScopeValueOwnerStr(ctx, msg.ScopeId)
+	if err != nil {
+		return nil, fmt.Errorf("could not get value owner of scope %q: %w", msg.ScopeId, err)
+	}
+
 	err = k.RemoveScope(markertypes.WithTransferAgents(ctx, transferAgents...), msg.ScopeId)
 	if err != nil {
 		return nil, fmt.Errorf("could not delete scope %q: %w", msg.ScopeId, err)
@@ -84,7 +110,15 @@ func (k msgServer) DeleteScope(
 
 	k.RemoveNetAssetValues(ctx, msg.ScopeId)
 
-	k.EmitEvent(ctx, types.NewEventTxCompleted(types.TxEndpoint_DeleteScope, msg.GetSignerStrs()))
+	signers := msg.GetSignerStrs()
+	if err = k.recordScopeDataAccessChange(ctx, msg.ScopeId, existing.DataAccess, nil, signers); err != nil {
+		return nil, err
+	}
+	if err = k.recordScopeValueOwnerChange(ctx, msg.ScopeId, oldValueOwner, "", signers); err != nil {
+		return nil, err
+	}
+
+	k.EmitEvent(ctx, types.NewEventTxCompleted(types.TxEndpoint_DeleteScope, signers))
 	return &types.MsgDeleteScopeResponse{}, nil
 }
 
@@ -105,6 +139,7 @@ func (k msgServer) AddScopeDataAccess(
 		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
 	}
 
+	oldDataAccess := existing.DataAccess
 	existing.AddDataAccess(msg.DataAccess)
 
 	err := k.SetScope(ctx, existing)
@@ -112,7 +147,12 @@ func (k msgServer) AddScopeDataAccess(
 		return nil, fmt.Errorf("could not update scope %q: %w", msg.ScopeId, err)
 	}
 
-	k.EmitEvent(ctx, types.NewEventTxCompleted(types.TxEndpoint_AddScopeDataAccess, msg.GetSignerStrs()))
+	signers := msg.GetSignerStrs()
+	if err = k.recordScopeDataAccessChange(ctx, msg.ScopeId, oldDataAccess, existing.DataAccess, signers); err != nil {
+		return nil, err
+	}
+
+	k.EmitEvent(ctx, types.NewEventTxCompleted(types.TxEndpoint_AddScopeDataAccess, signers))
 	return &types.MsgAddScopeDataAccessResponse{}, nil
 }
 
@@ -133,6 +173,7 @@ func (k msgServer) DeleteScopeDataAccess(
 		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
 	}
 
+	oldDataAccess := existing.DataAccess
 	existing.RemoveDataAccess(msg.DataAccess)
 
 	err := k.SetScope(ctx, existing)
@@ -140,7 +181,12 @@ func (k msgServer) DeleteScopeDataAccess(
 		return nil, fmt.Errorf("could not update scope %q: %w", msg.ScopeId, err)
 	}
 
-	k.EmitEvent(ctx, types.NewEventTxCompleted(types.TxEndpoint_DeleteScopeDataAccess, msg.GetSignerStrs()))
+	signers := msg.GetSignerStrs()
+	if err = k.recordScopeDataAccessChange(ctx, msg.ScopeId, oldDataAccess, existing.DataAccess, signers); err != nil {
+		return nil, err
+	}
+
+	k.EmitEvent(ctx, types.NewEventTxCompleted(types.TxEndpoint_DeleteScopeDataAccess, signers))
 	return &types.MsgDeleteScopeDataAccessResponse{}, nil
 }
 
@@ -239,6 +285,10 @@ func (k msgServer) UpdateValueOwners(
 		return nil, fmt.Errorf("failure setting scope value owners: %w", err)
 	}
 
+	if err = k.recordScopeValueOwnerChanges(ctx, links, msg.ValueOwnerAddress, msg.GetSignerStrs()); err != nil {
+		return nil, err
+	}
+
 	k.EmitEvent(ctx, types.NewEventTxCompleted(types.TxEndpoint_UpdateValueOwners, msg.GetSignerStrs()))
 	return &types.MsgUpdateValueOwnersResponse{}, nil
 }
@@ -274,10 +324,101 @@ func (k msgServer) MigrateValueOwner(
 		return nil, fmt.Errorf("failure setting scope value owners: %w", err)
 	}
 
+	if err = k.recordScopeValueOwnerChanges(ctx, links, msg.Proposed, msg.GetSignerStrs()); err != nil {
+		return nil, err
+	}
+
 	k.EmitEvent(ctx, types.NewEventTxCompleted(types.TxEndpoint_MigrateValueOwner, msg.GetSignerStrs()))
 	return &types.MsgMigrateValueOwnerResponse{}, nil
 }
 
+// SubstituteScopeParty replaces one party address with another in all of a scope's open sessions.
+func (k msgServer) SubstituteScopeParty(
+	goCtx context.Context,
+	msg *types.MsgSubstituteScopePartyRequest,
+) (*types.MsgSubstituteScopePartyResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), types.ModuleName, "tx", "SubstituteScopeParty")
+	ctx := UnwrapMetadataContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	sessionIDs, err := k.Keeper.SubstituteScopeParty(ctx, msg.ScopeId, msg.Existing, msg.Replacement, msg)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	k.EmitEvent(ctx, types.NewEventTxCompleted(types.TxEndpoint_SubstituteScopeParty, msg.GetSignerStrs()))
+	return &types.MsgSubstituteScopePartyResponse{SessionIds: sessionIDs}, nil
+}
+
+// OpenScopeSettlement starts a sale of a scope's value ownership, putting the scope on hold in the seller's account.
+func (k msgServer) OpenScopeSettlement(
+	goCtx context.Context,
+	msg *types.MsgOpenScopeSettlementRequest,
+) (*types.MsgOpenScopeSettlementResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), types.ModuleName, "tx", "OpenScopeSettlement")
+	ctx := UnwrapMetadataContext(goCtx)
+
+	settlement := types.NewScopeSettlement(msg.ScopeId, msg.Seller, msg.Buyer, msg.Price)
+	if err := k.Keeper.OpenScopeSettlement(ctx, settlement); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	k.EmitEvent(ctx, types.NewEventTxCompleted(types.TxEndpoint_OpenScopeSettlement, msg.GetSignerStrs()))
+	return &types.MsgOpenScopeSettlementResponse{}, nil
+}
+
+// FundScopeSettlement puts the price of a scope settlement on hold in the buyer's account.
+func (k msgServer) FundScopeSettlement(
+	goCtx context.Context,
+	msg *types.MsgFundScopeSettlementRequest,
+) (*types.MsgFundScopeSettlementResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), types.ModuleName, "tx", "FundScopeSettlement")
+	ctx := UnwrapMetadataContext(goCtx)
+
+	if err := k.Keeper.FundScopeSettlement(ctx, msg.ScopeId, msg.Buyer); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	k.EmitEvent(ctx, types.NewEventTxCompleted(types.TxEndpoint_FundScopeSettlement, msg.GetSignerStrs()))
+	return &types.MsgFundScopeSettlementResponse{}, nil
+}
+
+// SettleScopeSettlement releases the holds of a funded scope settlement, pays the seller, and makes the buyer the
+// scope's value owner.
+func (k msgServer) SettleScopeSettlement(
+	goCtx context.Context,
+	msg *types.MsgSettleScopeSettlementRequest,
+) (*types.MsgSettleScopeSettlementResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), types.ModuleName, "tx", "SettleScopeSettlement")
+	ctx := UnwrapMetadataContext(goCtx)
+
+	if err := k.Keeper.SettleScopeSettlement(ctx, msg.ScopeId, msg.Signer); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	k.EmitEvent(ctx, types.NewEventTxCompleted(types.TxEndpoint_SettleScopeSettlement, msg.GetSignerStrs()))
+	return &types.MsgSettleScopeSettlementResponse{}, nil
+}
+
+// CancelScopeSettlement releases the holds of a scope settlement without doing the sale.
+func (k msgServer) CancelScopeSettlement(
+	goCtx context.Context,
+	msg *types.MsgCancelScopeSettlementRequest,
+) (*types.MsgCancelScopeSettlementResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), types.ModuleName, "tx", "CancelScopeSettlement")
+	ctx := UnwrapMetadataContext(goCtx)
+
+	if err := k.Keeper.CancelScopeSettlement(ctx, msg.ScopeId, msg.Signer); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	k.EmitEvent(ctx, types.NewEventTxCompleted(types.TxEndpoint_CancelScopeSettlement, msg.GetSignerStrs()))
+	return &types.MsgCancelScopeSettlementResponse{}, nil
+}
+
 // WriteSession adds or updates a session context.
 func (k msgServer) WriteSession(
 	goCtx context.Context,
@@ -300,13 +441,52 @@ func (k msgServer) WriteSession(
 	}
 
 	msg.Session.Audit = existingAudit.UpdateAudit(ctx.BlockTime(), strings.Join(msg.Signers, ", "), "")
+	msg.Session.Status = types.SessionStatus_Open
 
 	k.SetSession(ctx, msg.Session)
 
+	if existing == nil {
+		if spec, found := k.GetContractSpecification(ctx, msg.Session.SpecificationId); found && spec.Deprecated {
+			k.EmitEvent(ctx, types.NewEventSessionUsesDeprecatedSpecification(msg.Session.SessionId, spec))
+		}
+	}
+
 	k.EmitEvent(ctx, types.NewEventTxCompleted(types.TxEndpoint_WriteSession, msg.GetSignerStrs()))
 	return types.NewMsgWriteSessionResponse(msg.Session.SessionId), nil
 }
 
+// CompleteSession marks an open session as completed so that its records can no longer be written.
+func (k msgServer) CompleteSession(
+	goCtx context.Context,
+	msg *types.MsgCompleteSessionRequest,
+) (*types.MsgCompleteSessionResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), types.ModuleName, "tx", "CompleteSession")
+	ctx := UnwrapMetadataContext(goCtx)
+
+	if err := k.UpdateSessionStatus(ctx, msg.SessionId, types.SessionStatus_Completed, msg); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	k.EmitEvent(ctx, types.NewEventTxCompleted(types.TxEndpoint_CompleteSession, msg.GetSignerStrs()))
+	return &types.MsgCompleteSessionResponse{}, nil
+}
+
+// AbortSession marks an open session as aborted so that its records can no longer be written.
+func (k msgServer) AbortSession(
+	goCtx context.Context,
+	msg *types.MsgAbortSessionRequest,
+) (*types.MsgAbortSessionResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), types.ModuleName, "tx", "AbortSession")
+	ctx := UnwrapMetadataContext(goCtx)
+
+	if err := k.UpdateSessionStatus(ctx, msg.SessionId, types.SessionStatus_Aborted, msg); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	k.EmitEvent(ctx, types.NewEventTxCompleted(types.TxEndpoint_AbortSession, msg.GetSignerStrs()))
+	return &types.MsgAbortSessionResponse{}, nil
+}
+
 // WriteRecord adds or updates a record.
 func (k msgServer) WriteRecord(
 	goCtx context.Context,
@@ -415,6 +595,25 @@ func (k msgServer) DeleteScopeSpecification(
 	return &types.MsgDeleteScopeSpecificationResponse{}, nil
 }
 
+// MigrateScopeSpecification is a governance endpoint that starts re-pointing all scopes using one scope spec to another.
+func (k msgServer) MigrateScopeSpecification(
+	goCtx context.Context,
+	msg *types.MsgMigrateScopeSpecificationRequest,
+) (*types.MsgMigrateScopeSpecificationResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), types.ModuleName, "tx", "MigrateScopeSpecification")
+	ctx := UnwrapMetadataContext(goCtx)
+
+	if err := k.ValidateAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+	if err := k.StartScopeSpecMigration(ctx, msg.FromSpecificationId, msg.ToSpecificationId, msg.BatchSize); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	k.EmitEvent(ctx, types.NewEventTxCompleted(types.TxEndpoint_MigrateScopeSpecification, msg.GetSignerStrs()))
+	return &types.MsgMigrateScopeSpecificationResponse{}, nil
+}
+
 // WriteContractSpecification adds or updates a contract specification.
 func (k msgServer) WriteContractSpecification(
 	goCtx context.Context,
@@ -426,12 +625,17 @@ func (k msgServer) WriteContractSpecification(
 	//nolint:errcheck // the error was checked when msg.ValidateBasic was called before getting here.
 	msg.ConvertOptionalFields()
 
+	// Deprecation can only be changed using DeprecateContractSpecification, so keep whatever's already there.
+	msg.Specification.Deprecated = false
+	msg.Specification.ReplacementId = nil
 	var existing *types.ContractSpecification
 	if e, found := k.GetContractSpecification(ctx, msg.Specification.SpecificationId); found {
 		existing = &e
 		if err := k.ValidateSignersWithoutParties(ctx, existing.OwnerAddresses, msg); err != nil {
 			return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
 		}
+		msg.Specification.Deprecated = existing.Deprecated
+		msg.Specification.ReplacementId = existing.ReplacementId
 	}
 	if err := k.ValidateWriteContractSpecification(ctx, existing, msg.Specification); err != nil {
 		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
@@ -492,6 +696,41 @@ func (k msgServer) DeleteContractSpecification(
 	return &types.MsgDeleteContractSpecificationResponse{}, nil
 }
 
+// DeprecateContractSpecification marks a contract specification as deprecated.
+func (k msgServer) DeprecateContractSpecification(
+	goCtx context.Context,
+	msg *types.MsgDeprecateContractSpecificationRequest,
+) (*types.MsgDeprecateContractSpecificationResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), types.ModuleName, "tx", "DeprecateContractSpecification")
+	ctx := UnwrapMetadataContext(goCtx)
+
+	spec, found := k.GetContractSpecification(ctx, msg.SpecificationId)
+	if !found {
+		return nil, sdkerrors.ErrNotFound.Wrapf("contract specification not found with id %s", msg.SpecificationId)
+	}
+	if err := k.ValidateSignersWithoutParties(ctx, spec.OwnerAddresses, msg); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	if !msg.ReplacementId.Empty() {
+		replacement, found := k.GetContractSpecification(ctx, msg.ReplacementId)
+		if !found {
+			return nil, sdkerrors.ErrNotFound.Wrapf("replacement contract specification not found with id %s", msg.ReplacementId)
+		}
+		if replacement.Deprecated {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf("replacement contract specification %s is deprecated", msg.ReplacementId)
+		}
+	}
+
+	spec.Deprecated = true
+	spec.ReplacementId = msg.ReplacementId
+	k.SetContractSpecification(ctx, spec)
+
+	k.EmitEvent(ctx, types.NewEventContractSpecificationDeprecated(spec.SpecificationId, spec.ReplacementId))
+	k.EmitEvent(ctx, types.NewEventTxCompleted(types.TxEndpoint_DeprecateContractSpecification, msg.GetSignerStrs()))
+	return &types.MsgDeprecateContractSpecificationResponse{}, nil
+}
+
 // AddContractSpecToScopeSpec adds contract specification to a scope specification.
 func (k msgServer) AddContractSpecToScopeSpec(
 	goCtx context.Context,
@@ -642,7 +881,7 @@ func (k msgServer) BindOSLocator(
 	ctx := UnwrapMetadataContext(goCtx)
 	// Validate
 	if err := msg.ValidateBasic(); err != nil {
-		ctx.Logger().Error("unable to validate message", "err", err)
+		k.Logger(ctx).Error("unable to validate message", "err", err)
 		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
 	}
 
@@ -653,13 +892,13 @@ func (k msgServer) BindOSLocator(
 		encryptionKey, _ = sdk.AccAddressFromBech32(msg.Locator.EncryptionKey)
 	}
 	if k.Keeper.OSLocatorExists(ctx, ownerAddress) {
-		ctx.Logger().Error("Address already bound to an URI", "owner", msg.Locator.Owner)
-		return nil, sdkerrors.ErrInvalidRequest.Wrap(types.ErrOSLocatorAlreadyBound.Error())
+		k.Logger(ctx).Error("Address already bound to an URI", "owner", msg.Locator.Owner)
+		return nil, types.ErrOSLocatorAlreadyBound.Wrapf("%q", msg.Locator.Owner)
 	}
 
 	// Bind owner to URI
-	if err := k.Keeper.SetOSLocator(ctx, ownerAddress, encryptionKey, msg.Locator.LocatorUri); err != nil {
-		ctx.Logger().Error("unable to bind name", "err", err)
+	if err := k.Keeper.SetOSLocator(ctx, ownerAddress, encryptionKey, msg.Locator.LocatorUri, msg.Locator.FailoverUris...); err != nil {
+		k.Logger(ctx).Error("unable to bind name", "owner", msg.Locator.Owner, "err", err)
 		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
 	}
 
@@ -676,7 +915,7 @@ func (k msgServer) DeleteOSLocator(
 	ctx := UnwrapMetadataContext(goCtx)
 	// Validate
 	if err := msg.ValidateBasic(); err != nil {
-		ctx.Logger().Error("unable to validate message", "err", err)
+		k.Logger(ctx).Error("unable to validate message", "err", err)
 		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
 	}
 
@@ -684,18 +923,18 @@ func (k msgServer) DeleteOSLocator(
 	ownerAddr, _ := sdk.AccAddressFromBech32(msg.Locator.Owner)
 
 	if !k.Keeper.OSLocatorExists(ctx, ownerAddr) {
-		ctx.Logger().Error("Address not already bound to an URI", "owner", msg.Locator.Owner)
-		return nil, sdkerrors.ErrInvalidRequest.Wrap(types.ErrOSLocatorAlreadyBound.Error())
+		k.Logger(ctx).Error("Address not already bound to an URI", "owner", msg.Locator.Owner)
+		return nil, types.ErrAddressNotBound.Wrapf("%q", msg.Locator.Owner)
 	}
 
 	if !k.Keeper.VerifyCorrectOwner(ctx, ownerAddr) {
-		ctx.Logger().Error("msg sender cannot delete os locator", "owner", ownerAddr)
+		k.Logger(ctx).Error("msg sender cannot delete os locator", "owner", ownerAddr)
 		return nil, sdkerrors.ErrUnauthorized.Wrap("msg sender cannot delete os locator.")
 	}
 
 	// Delete
 	if err := k.Keeper.RemoveOSLocator(ctx, ownerAddr); err != nil {
-		ctx.Logger().Error("error deleting name", "err", err)
+		k.Logger(ctx).Error("error deleting name", "owner", msg.Locator.Owner, "err", err)
 		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
 	}
 
@@ -712,7 +951,7 @@ func (k msgServer) ModifyOSLocator(
 	ctx := UnwrapMetadataContext(goCtx)
 	// Validate
 	if err := msg.ValidateBasic(); err != nil {
-		ctx.Logger().Error("unable to validate message", "err", err)
+		k.Logger(ctx).Error("unable to validate message", "err", err)
 		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
 	}
 
@@ -724,17 +963,17 @@ func (k msgServer) ModifyOSLocator(
 	}
 
 	if !k.Keeper.OSLocatorExists(ctx, ownerAddr) {
-		ctx.Logger().Error("Address not already bound to an URI", "owner", msg.Locator.Owner)
-		return nil, sdkerrors.ErrInvalidRequest.Wrap(types.ErrOSLocatorAlreadyBound.Error())
+		k.Logger(ctx).Error("Address not already bound to an URI", "owner", msg.Locator.Owner)
+		return nil, types.ErrAddressNotBound.Wrapf("%q", msg.Locator.Owner)
 	}
 
 	if !k.Keeper.VerifyCorrectOwner(ctx, ownerAddr) {
-		ctx.Logger().Error("msg sender cannot modify os locator", "owner", ownerAddr)
+		k.Logger(ctx).Error("msg sender cannot modify os locator", "owner", ownerAddr)
 		return nil, sdkerrors.ErrUnauthorized.Wrap("msg sender cannot delete os locator.")
 	}
 	// Modify
 	if err := k.Keeper.ModifyOSLocator(ctx, ownerAddr, encryptionKey, msg.Locator.LocatorUri); err != nil {
-		ctx.Logger().Error("error deleting name", "err", err)
+		k.Logger(ctx).Error("error deleting name", "owner", msg.Locator.Owner, "err", err)
 		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
 	}
 
@@ -742,6 +981,63 @@ func (k msgServer) ModifyOSLocator(
 	return &types.MsgModifyOSLocatorResponse{Locator: msg.Locator}, nil
 }
 
+// AddOSLocatorURI adds a failover uri to the end of an existing ObjectStoreLocator record's uris.
+func (k msgServer) AddOSLocatorURI(
+	goCtx context.Context,
+	msg *types.MsgAddOSLocatorURIRequest,
+) (*types.MsgAddOSLocatorURIResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), types.ModuleName, "tx", "AddOSLocatorURI")
+	ctx := UnwrapMetadataContext(goCtx)
+
+	// already valid address, checked in ValidateBasic
+	ownerAddr, _ := sdk.AccAddressFromBech32(msg.Owner)
+	locator, err := k.Keeper.AddOSLocatorURI(ctx, ownerAddr, msg.Uri)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	k.EmitEvent(ctx, types.NewEventTxCompleted(types.TxEndpoint_AddOSLocatorURI, msg.GetSignerStrs()))
+	return &types.MsgAddOSLocatorURIResponse{Locator: *locator}, nil
+}
+
+// RemoveOSLocatorURI removes a uri from an existing ObjectStoreLocator record.
+func (k msgServer) RemoveOSLocatorURI(
+	goCtx context.Context,
+	msg *types.MsgRemoveOSLocatorURIRequest,
+) (*types.MsgRemoveOSLocatorURIResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), types.ModuleName, "tx", "RemoveOSLocatorURI")
+	ctx := UnwrapMetadataContext(goCtx)
+
+	// already valid address, checked in ValidateBasic
+	ownerAddr, _ := sdk.AccAddressFromBech32(msg.Owner)
+	locator, err := k.Keeper.RemoveOSLocatorURI(ctx, ownerAddr, msg.Uri)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	k.EmitEvent(ctx, types.NewEventTxCompleted(types.TxEndpoint_RemoveOSLocatorURI, msg.GetSignerStrs()))
+	return &types.MsgRemoveOSLocatorURIResponse{Locator: *locator}, nil
+}
+
+// ReorderOSLocatorURIs changes the priority order of an existing ObjectStoreLocator record's uris.
+func (k msgServer) ReorderOSLocatorURIs(
+	goCtx context.Context,
+	msg *types.MsgReorderOSLocatorURIsRequest,
+) (*types.MsgReorderOSLocatorURIsResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), types.ModuleName, "tx", "ReorderOSLocatorURIs")
+	ctx := UnwrapMetadataContext(goCtx)
+
+	// already valid address, checked in ValidateBasic
+	ownerAddr, _ := sdk.AccAddressFromBech32(msg.Owner)
+	locator, err := k.Keeper.ReorderOSLocatorURIs(ctx, ownerAddr, msg.Uris)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	k.EmitEvent(ctx, types.NewEventTxCompleted(types.TxEndpoint_ReorderOSLocatorURIs, msg.GetSignerStrs()))
+	return &types.MsgReorderOSLocatorURIsResponse{Locator: *locator}, nil
+}
+
 // SetAccountData associates some basic data with a metadata address.
 // Currently, only scope ids are supported.
 func (k msgServer) SetAccountData(
@@ -319,6 +319,9 @@ func (s *MsgServerTestSuite) TestWriteScope() {
 		expEventsCreate bool
 		// expEventsCreate should be true if you expected a EventScopeUpdated to be emitted.
 		expEventsUpdate bool
+		// expDataAccessAdded are the addresses expected in an EventScopeDataAccessChanged.
+		// If empty, an EventScopeDataAccessChanged is not expected.
+		expDataAccessAdded []string
 	}{
 		{
 			name: "invalid scope",
@@ -388,8 +391,9 @@ func (s *MsgServerTestSuite) TestWriteScope() {
 				DataAccess:        []string{otherAddr3.String()},
 				ValueOwnerAddress: otherAddr2.String(),
 			},
-			expEventsNAV:    true,
-			expEventsUpdate: true,
+			expDataAccessAdded: []string{otherAddr3.String()},
+			expEventsNAV:       true,
+			expEventsUpdate:    true,
 		},
 		{
 			name:  "value owner change: empty to user",
@@ -723,6 +727,25 @@ func (s *MsgServerTestSuite) TestWriteScope() {
 				eventsBuilder.AddTypedEvent(types.NewEventScopeUpdated(scopeID))
 			}
 			if len(tc.expErr) == 0 {
+				if len(tc.expDataAccessAdded) > 0 {
+					eventsBuilder.AddTypedEvent(&types.EventScopeDataAccessChanged{
+						ScopeAddr: scopeID.String(),
+						Added:     tc.expDataAccessAdded,
+						Signers:   tc.msg.Signers,
+					})
+				}
+				if tc.expEventsMint || len(tc.expEventsTrans) > 0 {
+					var prevValueOwner string
+					if !tc.expEventsMint {
+						prevValueOwner = tc.expEventsTrans.String()
+					}
+					eventsBuilder.AddTypedEvent(&types.EventScopeValueOwnerChanged{
+						ScopeAddr:          scopeID.String(),
+						PreviousValueOwner: prevValueOwner,
+						NewValueOwner:      tc.expScope.ValueOwnerAddress,
+						Signers:            tc.msg.Signers,
+					})
+				}
 				eventsBuilder.AddTypedEvent(types.NewEventTxCompleted(types.TxEndpoint_WriteScope, tc.msg.Signers))
 			}
 			expEvents := eventsBuilder.Build()
@@ -849,6 +872,11 @@ func (s *MsgServerTestSuite) TestDeleteScope() {
 				AddSendCoins(otherAddr2, moduleAddr, s.scopeID(3).Coins()).
 				AddBurnCoinsStrs(moduleAddr.String(), s.scopeID(3).Coins().String()).
 				AddTypedEvent(types.NewEventScopeDeleted(s.scopeID(3))).
+				AddTypedEvent(&types.EventScopeValueOwnerChanged{
+					ScopeAddr:          s.scopeID(3).String(),
+					PreviousValueOwner: otherAddr2.String(),
+					Signers:            []string{scopeOwnerAddr.String(), otherAddr2.String()},
+				}).
 				Build(),
 		},
 		{
@@ -908,6 +936,11 @@ func (s *MsgServerTestSuite) TestDeleteScope() {
 				AddTypedEvent(types.NewEventRecordDeleted(recordID(4, "one"))).
 				AddTypedEvent(types.NewEventSessionDeleted(s.sessionID(4, 1))).
 				AddTypedEvent(types.NewEventScopeDeleted(s.scopeID(4))).
+				AddTypedEvent(&types.EventScopeValueOwnerChanged{
+					ScopeAddr:          s.scopeID(4).String(),
+					PreviousValueOwner: otherAddr1.String(),
+					Signers:            []string{scopeOwnerAddr.String(), otherAddr1.String()},
+				}).
 				Build(),
 		},
 		{
@@ -966,6 +999,11 @@ func (s *MsgServerTestSuite) TestDeleteScope() {
 				AddTypedEvent(types.NewEventRecordDeleted(recordID(5, "three"))).
 				AddTypedEvent(types.NewEventSessionDeleted(s.sessionID(5, 2))).
 				AddTypedEvent(types.NewEventScopeDeleted(s.scopeID(5))).
+				AddTypedEvent(&types.EventScopeValueOwnerChanged{
+					ScopeAddr:          s.scopeID(5).String(),
+					PreviousValueOwner: otherAddr2.String(),
+					Signers:            []string{scopeOwnerAddr.String(), otherAddr2.String()},
+				}).
 				Build(),
 		},
 		{
@@ -2179,6 +2217,203 @@ func (s *MsgServerTestSuite) TestDeleteContractSpecFromScopeSpec() {
 // TODO: DeleteOSLocator tests
 // TODO: ModifyOSLocator tests
 
+func (s *MsgServerTestSuite) TestDeprecateContractSpecification() {
+	newCSpec := func() types.ContractSpecification {
+		cSpec := types.ContractSpecification{
+			SpecificationId: types.ContractSpecMetadataAddress(uuid.New()),
+			Description:     nil,
+			OwnerAddresses:  []string{s.user1},
+			PartiesInvolved: []types.PartyType{types.PartyType_PARTY_TYPE_OWNER},
+			Source:          types.NewContractSpecificationSourceHash("somesource"),
+			ClassName:       "someclass",
+		}
+		s.app.MetadataKeeper.SetContractSpecification(s.ctx, cSpec)
+		return cSpec
+	}
+
+	cSpec := newCSpec()
+	replacement := newCSpec()
+	deprecated := newCSpec()
+	deprecated.Deprecated = true
+	s.app.MetadataKeeper.SetContractSpecification(s.ctx, deprecated)
+	unknownID := types.ContractSpecMetadataAddress(uuid.New())
+
+	cases := []struct {
+		name          string
+		specID        types.MetadataAddress
+		replacementID types.MetadataAddress
+		signers       []string
+		errorMsg      string
+	}{
+		{
+			name:     "contract spec not found",
+			specID:   unknownID,
+			signers:  []string{s.user1},
+			errorMsg: fmt.Sprintf("contract specification not found with id %s: not found", unknownID),
+		},
+		{
+			name:     "not signed by owner",
+			specID:   cSpec.SpecificationId,
+			signers:  []string{s.user2},
+			errorMsg: fmt.Sprintf("missing signature: %s: invalid request", s.user1),
+		},
+		{
+			name:          "replacement not found",
+			specID:        cSpec.SpecificationId,
+			replacementID: unknownID,
+			signers:       []string{s.user1},
+			errorMsg:      fmt.Sprintf("replacement contract specification not found with id %s: not found", unknownID),
+		},
+		{
+			name:          "replacement is deprecated",
+			specID:        cSpec.SpecificationId,
+			replacementID: deprecated.SpecificationId,
+			signers:       []string{s.user1},
+			errorMsg:      fmt.Sprintf("replacement contract specification %s is deprecated: invalid request", deprecated.SpecificationId),
+		},
+		{
+			name:          "deprecated with replacement",
+			specID:        cSpec.SpecificationId,
+			replacementID: replacement.SpecificationId,
+			signers:       []string{s.user1},
+		},
+		{
+			name:    "deprecated without replacement",
+			specID:  replacement.SpecificationId,
+			signers: []string{s.user1},
+		},
+	}
+
+	for _, tc := range cases {
+		s.Run(tc.name, func() {
+			msg := types.NewMsgDeprecateContractSpecificationRequest(tc.specID, tc.replacementID, tc.signers)
+			em := sdk.NewEventManager()
+			ctx := s.ctx.WithEventManager(em)
+			_, err := s.msgServer.DeprecateContractSpecification(ctx, msg)
+			if len(tc.errorMsg) > 0 {
+				s.Assert().EqualError(err, tc.errorMsg, "DeprecateContractSpecification error")
+				return
+			}
+			s.Require().NoError(err, "DeprecateContractSpecification error")
+
+			spec, found := s.app.MetadataKeeper.GetContractSpecification(s.ctx, tc.specID)
+			s.Require().True(found, "GetContractSpecification found")
+			s.Assert().True(spec.Deprecated, "Deprecated")
+			s.Assert().Equal(tc.replacementID.String(), spec.ReplacementId.String(), "ReplacementId")
+
+			expEvent := s.untypeEvent(types.NewEventContractSpecificationDeprecated(tc.specID, tc.replacementID))
+			s.Assert().Contains(em.Events(), expEvent, "emitted events")
+		})
+	}
+
+	s.Run("rewriting a deprecated spec keeps the deprecation", func() {
+		toWrite := cSpec
+		toWrite.ClassName = "newclass"
+		_, err := s.msgServer.WriteContractSpecification(s.ctx, types.NewMsgWriteContractSpecificationRequest(toWrite, []string{s.user1}))
+		s.Require().NoError(err, "WriteContractSpecification error")
+
+		spec, found := s.app.MetadataKeeper.GetContractSpecification(s.ctx, cSpec.SpecificationId)
+		s.Require().True(found, "GetContractSpecification found")
+		s.Assert().Equal("newclass", spec.ClassName, "ClassName")
+		s.Assert().True(spec.Deprecated, "Deprecated")
+		s.Assert().Equal(replacement.SpecificationId, spec.ReplacementId, "ReplacementId")
+	})
+
+	s.Run("new session using a deprecated spec", func() {
+		scopeUUID := uuid.New()
+		owners := []types.Party{{Address: s.user1, Role: types.PartyType_PARTY_TYPE_OWNER}}
+		sSpec := types.ScopeSpecification{
+			SpecificationId: types.ScopeSpecMetadataAddress(uuid.New()),
+			OwnerAddresses:  []string{s.user1},
+			PartiesInvolved: []types.PartyType{types.PartyType_PARTY_TYPE_OWNER},
+			ContractSpecIds: []types.MetadataAddress{cSpec.SpecificationId},
+		}
+		s.app.MetadataKeeper.SetScopeSpecification(s.ctx, sSpec)
+		s.app.MetadataKeeper.SetScope(s.ctx, types.Scope{
+			ScopeId:         types.ScopeMetadataAddress(scopeUUID),
+			SpecificationId: sSpec.SpecificationId,
+			Owners:          owners,
+		})
+
+		sessionID := types.SessionMetadataAddress(scopeUUID, uuid.New())
+		msg := &types.MsgWriteSessionRequest{
+			Session: types.Session{
+				SessionId:       sessionID,
+				SpecificationId: cSpec.SpecificationId,
+				Parties:         owners,
+				Name:            "someclass",
+			},
+			Signers: []string{s.user1},
+		}
+		em := sdk.NewEventManager()
+		_, err := s.msgServer.WriteSession(s.ctx.WithEventManager(em), msg)
+		s.Require().NoError(err, "WriteSession error")
+
+		spec, _ := s.app.MetadataKeeper.GetContractSpecification(s.ctx, cSpec.SpecificationId)
+		expEvent := s.untypeEvent(types.NewEventSessionUsesDeprecatedSpecification(sessionID, spec))
+		s.Assert().Contains(em.Events(), expEvent, "emitted events")
+	})
+}
+
+func (s *MsgServerTestSuite) TestMigrateScopeSpecification() {
+	authority := s.app.MetadataKeeper.GetAuthority()
+	fromSpecID := s.scopeSpecID(1)
+	toSpecID := s.scopeSpecID(2)
+	toSpec := types.NewScopeSpecification(toSpecID, nil, []string{s.user1}, []types.PartyType{types.PartyType_PARTY_TYPE_OWNER}, nil)
+	s.app.MetadataKeeper.SetScopeSpecification(s.ctx, *toSpec)
+
+	testCases := []struct {
+		name   string
+		msg    types.MsgMigrateScopeSpecificationRequest
+		expErr string
+	}{
+		{
+			name:   "not the authority",
+			msg:    *types.NewMsgMigrateScopeSpecificationRequest(s.user1, fromSpecID, toSpecID, 0),
+			expErr: fmt.Sprintf("expected %q got %q: expected gov account as only signer for proposal message", authority, s.user1),
+		},
+		{
+			name:   "unknown to spec",
+			msg:    *types.NewMsgMigrateScopeSpecificationRequest(authority, toSpecID, s.scopeSpecID(3), 0),
+			expErr: fmt.Sprintf("scope specification %s not found: invalid request", s.scopeSpecID(3)),
+		},
+		{
+			name: "migration started",
+			msg:  *types.NewMsgMigrateScopeSpecificationRequest(authority, fromSpecID, toSpecID, 5),
+		},
+		{
+			name:   "migration already started",
+			msg:    *types.NewMsgMigrateScopeSpecificationRequest(authority, fromSpecID, toSpecID, 5),
+			expErr: fmt.Sprintf("scope specification %s is already part of a migration: invalid request", fromSpecID),
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			em := sdk.NewEventManager()
+			res, err := s.msgServer.MigrateScopeSpecification(s.ctx.WithEventManager(em), &tc.msg)
+			if len(tc.expErr) > 0 {
+				s.Assert().Nil(res, "MigrateScopeSpecification response")
+				s.Assert().EqualError(err, tc.expErr, "MigrateScopeSpecification error")
+				return
+			}
+			s.Require().NoError(err, "MigrateScopeSpecification error")
+			s.Assert().Equal(&types.MsgMigrateScopeSpecificationResponse{}, res, "MigrateScopeSpecification response")
+
+			expMigration := types.NewScopeSpecMigration(tc.msg.FromSpecificationId, tc.msg.ToSpecificationId, tc.msg.BatchSize, s.ctx.BlockHeight())
+			migration, err := s.app.MetadataKeeper.GetScopeSpecMigration(s.ctx, tc.msg.FromSpecificationId)
+			s.Require().NoError(err, "GetScopeSpecMigration")
+			s.Assert().Equal(&expMigration, migration, "GetScopeSpecMigration")
+
+			expEvents := sdk.Events{
+				s.untypeEvent(types.NewEventScopeSpecMigrationStarted(expMigration)),
+				s.untypeEvent(types.NewEventTxCompleted(types.TxEndpoint_MigrateScopeSpecification, []string{authority})),
+			}
+			s.AssertEqualEvents(expEvents, em.Events(), "MigrateScopeSpecification events")
+		})
+	}
+}
+
 func (s *MsgServerTestSuite) TestSetAccountData() {
 	scopeSpec := types.ScopeSpecification{
 		SpecificationId: types.ScopeSpecMetadataAddress(uuid.New()),
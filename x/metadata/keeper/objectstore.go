@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"fmt"
+	"slices"
 
 	storetypes "cosmossdk.io/store/types"
 
@@ -20,7 +21,7 @@ func (k Keeper) GetOsLocatorRecord(ctx sdk.Context, ownerAddr sdk.AccAddress) (o
 	}
 	err := k.cdc.Unmarshal(b, &osLocator)
 	if err != nil {
-		ctx.Logger().Error("failed to unmarshal locator", "err", err)
+		k.Logger(ctx).Error("failed to unmarshal locator", "err", err)
 		return types.ObjectStoreLocator{}, false
 	}
 	return osLocator, true
@@ -34,13 +35,18 @@ func (k Keeper) OSLocatorExists(ctx sdk.Context, ownerAddr sdk.AccAddress) bool
 }
 
 // SetOSLocator binds an OS Locator to an address in the kvstore.
+// The failover uris are used, in the order provided, when the uri's endpoint is unavailable.
 // An error is returned if no account exists for the address.
 // An error is returned if an OS Locator already exists for the address.
-func (k Keeper) SetOSLocator(ctx sdk.Context, ownerAddr, encryptionKey sdk.AccAddress, uri string) error {
+func (k Keeper) SetOSLocator(ctx sdk.Context, ownerAddr, encryptionKey sdk.AccAddress, uri string, failoverURIs ...string) error {
 	urlToPersist, err := k.checkValidURI(uri, ctx)
 	if err != nil {
 		return err
 	}
+	failoversToPersist, err := k.checkValidFailoverURIs(ctx, urlToPersist.String(), failoverURIs)
+	if err != nil {
+		return err
+	}
 	if account := k.authKeeper.GetAccount(ctx, ownerAddr); account == nil {
 		return types.ErrInvalidAddress
 	}
@@ -51,6 +57,7 @@ func (k Keeper) SetOSLocator(ctx sdk.Context, ownerAddr, encryptionKey sdk.AccAd
 	}
 
 	record := types.NewOSLocatorRecord(ownerAddr, encryptionKey, urlToPersist.String())
+	record.FailoverUris = failoversToPersist
 	bz, err := k.cdc.Marshal(&record)
 	if err != nil {
 		return err
@@ -129,32 +136,147 @@ func (k Keeper) RemoveOSLocator(ctx sdk.Context, ownerAddr sdk.AccAddress) error
 }
 
 // ModifyOSLocator updates an existing os locator entry in the kvstore, returns an error if it doesn't exist.
+// The existing failover uris are kept, except for the new uri if it was one of them.
 func (k Keeper) ModifyOSLocator(ctx sdk.Context, ownerAddr, encryptionKey sdk.AccAddress, uri string) error {
 	urlToPersist, err := k.checkValidURI(uri, ctx)
 	if err != nil {
 		return err
 	}
-	key := types.GetOSLocatorKey(ownerAddr)
-	store := ctx.KVStore(k.storeKey)
-	if !store.Has(key) {
+	existing, found := k.GetOsLocatorRecord(ctx, ownerAddr)
+	if !found {
 		return types.ErrAddressNotBound
 	}
 
 	record := types.NewOSLocatorRecord(ownerAddr, encryptionKey, urlToPersist.String())
+	for _, failover := range existing.FailoverUris {
+		if failover != record.LocatorUri {
+			record.FailoverUris = append(record.FailoverUris, failover)
+		}
+	}
+	if err = k.setOSLocatorRecord(ctx, ownerAddr, record); err != nil {
+		return err
+	}
+	k.EmitEvent(ctx, types.NewEventOSLocatorUpdated(record.Owner))
+	return nil
+}
+
+// AddOSLocatorURI adds a uri to the end of an existing os locator entry's failover uris.
+func (k Keeper) AddOSLocatorURI(ctx sdk.Context, ownerAddr sdk.AccAddress, uri string) (*types.ObjectStoreLocator, error) {
+	urlToPersist, err := k.checkValidURI(uri, ctx)
+	if err != nil {
+		return nil, err
+	}
+	record, found := k.GetOsLocatorRecord(ctx, ownerAddr)
+	if !found {
+		return nil, types.ErrAddressNotBound
+	}
+
+	uris := append(record.AllURIs(), urlToPersist.String())
+	if err = types.ValidateOSLocatorURIs(uris); err != nil {
+		return nil, err
+	}
+	record.SetAllURIs(uris)
+	if err = k.setOSLocatorRecord(ctx, ownerAddr, record); err != nil {
+		return nil, err
+	}
+	k.EmitEvent(ctx, types.NewEventOSLocatorUpdated(record.Owner))
+	return &record, nil
+}
+
+// RemoveOSLocatorURI removes a uri from an existing os locator entry.
+// If it's the entry's locator uri, the first failover uri becomes the locator uri.
+// An entry's only uri cannot be removed; the entry should be deleted instead.
+func (k Keeper) RemoveOSLocatorURI(ctx sdk.Context, ownerAddr sdk.AccAddress, uri string) (*types.ObjectStoreLocator, error) {
+	record, found := k.GetOsLocatorRecord(ctx, ownerAddr)
+	if !found {
+		return nil, types.ErrAddressNotBound
+	}
+
+	existing := record.AllURIs()
+	uris := make([]string, 0, len(existing))
+	for _, cur := range existing {
+		if cur != uri {
+			uris = append(uris, cur)
+		}
+	}
+	if len(uris) == len(existing) {
+		return nil, fmt.Errorf("uri %q is not one of the locator's uris", uri)
+	}
+	if len(uris) == 0 {
+		return nil, fmt.Errorf("cannot remove the locator's only uri %q", uri)
+	}
+
+	record.SetAllURIs(uris)
+	if err := k.setOSLocatorRecord(ctx, ownerAddr, record); err != nil {
+		return nil, err
+	}
+	k.EmitEvent(ctx, types.NewEventOSLocatorUpdated(record.Owner))
+	return &record, nil
+}
+
+// ReorderOSLocatorURIs changes the priority order of an existing os locator entry's uris.
+// The provided uris must be the entry's current uris. The first one becomes the locator uri.
+func (k Keeper) ReorderOSLocatorURIs(ctx sdk.Context, ownerAddr sdk.AccAddress, uris []string) (*types.ObjectStoreLocator, error) {
+	if err := types.ValidateOSLocatorURIs(uris); err != nil {
+		return nil, err
+	}
+	record, found := k.GetOsLocatorRecord(ctx, ownerAddr)
+	if !found {
+		return nil, types.ErrAddressNotBound
+	}
+
+	existing := record.AllURIs()
+	if len(existing) != len(uris) {
+		return nil, fmt.Errorf("expected %d uris, got %d: all of the locator's uris must be provided", len(existing), len(uris))
+	}
+	for _, uri := range uris {
+		if !slices.Contains(existing, uri) {
+			return nil, fmt.Errorf("uri %q is not one of the locator's uris", uri)
+		}
+	}
+
+	record.SetAllURIs(uris)
+	if err := k.setOSLocatorRecord(ctx, ownerAddr, record); err != nil {
+		return nil, err
+	}
+	k.EmitEvent(ctx, types.NewEventOSLocatorUpdated(record.Owner))
+	return &record, nil
+}
+
+// setOSLocatorRecord writes an os locator record to the kvstore.
+func (k Keeper) setOSLocatorRecord(ctx sdk.Context, ownerAddr sdk.AccAddress, record types.ObjectStoreLocator) error {
 	bz, err := k.cdc.Marshal(&record)
 	if err != nil {
 		return err
 	}
-	store.Set(key, bz)
-	k.EmitEvent(ctx, types.NewEventOSLocatorUpdated(record.Owner))
+	ctx.KVStore(k.storeKey).Set(types.GetOSLocatorKey(ownerAddr), bz)
 	return nil
 }
 
+// checkValidFailoverURIs checks each of the failover uris and returns them as they should be persisted.
+func (k Keeper) checkValidFailoverURIs(ctx sdk.Context, uri string, failoverURIs []string) ([]string, error) {
+	if len(failoverURIs) == 0 {
+		return nil, nil
+	}
+	rv := make([]string, len(failoverURIs))
+	for i, failover := range failoverURIs {
+		urlToPersist, err := k.checkValidURI(failover, ctx)
+		if err != nil {
+			return nil, err
+		}
+		rv[i] = urlToPersist.String()
+	}
+	if err := types.ValidateOSLocatorURIs(append([]string{uri}, rv...)); err != nil {
+		return nil, err
+	}
+	return rv, nil
+}
+
 // ImportOSLocatorRecord binds a name to an address in the kvstore.
 // Different from SetOSLocator in that there is less validation here.
 // The uri format is not checked, and the owner address account is not looked up.
 // This also does not emit any events.
-func (k Keeper) ImportOSLocatorRecord(ctx sdk.Context, ownerAddr, encryptionKey sdk.AccAddress, uri string) error {
+func (k Keeper) ImportOSLocatorRecord(ctx sdk.Context, ownerAddr, encryptionKey sdk.AccAddress, uri string, failoverURIs ...string) error {
 	key := types.GetOSLocatorKey(ownerAddr)
 	store := ctx.KVStore(k.storeKey)
 	if store.Has(key) {
@@ -162,6 +284,7 @@ func (k Keeper) ImportOSLocatorRecord(ctx sdk.Context, ownerAddr, encryptionKey
 	}
 
 	record := types.NewOSLocatorRecord(ownerAddr, encryptionKey, uri)
+	record.FailoverUris = failoverURIs
 	bz, err := k.cdc.Marshal(&record)
 	if err != nil {
 		return err
@@ -3,12 +3,15 @@ package keeper
 import (
 	"context"
 	b64 "encoding/base64"
+	"errors"
 	"fmt"
 	"net/url"
+	"slices"
 
 	"github.com/google/uuid"
 
 	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
 
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -281,14 +284,17 @@ func (k Keeper) Sessions(c context.Context, req *types.SessionsRequest) (*types.
 	switch {
 	case !sessionAddr.Empty():
 		session, found := k.GetSession(ctx, sessionAddr)
-		if found {
-			retval.Sessions = append(retval.Sessions, types.WrapSession(&session, !req.ExcludeIdInfo))
-		} else {
+		switch {
+		case !found:
 			retval.Sessions = append(retval.Sessions, types.WrapSessionNotFound(sessionAddr))
+		case sessionHasStatus(session, req.Status):
+			retval.Sessions = append(retval.Sessions, types.WrapSession(&session, !req.ExcludeIdInfo))
 		}
 	case !scopeAddr.Empty():
 		itErr := k.IterateSessions(ctx, scopeAddr, func(s types.Session) (stop bool) {
-			retval.Sessions = append(retval.Sessions, types.WrapSession(&s, !req.ExcludeIdInfo))
+			if sessionHasStatus(s, req.Status) {
+				retval.Sessions = append(retval.Sessions, types.WrapSession(&s, !req.ExcludeIdInfo))
+			}
 			return false
 		})
 		if itErr != nil {
@@ -342,11 +348,13 @@ func (k Keeper) SessionsAll(c context.Context, req *types.SessionsAllRequest) (*
 	defer telemetry.MeasureSince(telemetry.Now(), types.ModuleName, "query", "SessionsAll")
 	retval := types.SessionsAllResponse{}
 	incInfo := false
+	status := types.SessionStatus_Unspecified
 	if req != nil {
 		if req.IncludeRequest {
 			retval.Request = req
 		}
 		incInfo = !req.ExcludeIdInfo
+		status = req.Status
 	}
 
 	pageRequest := getPageRequest(req)
@@ -355,12 +363,20 @@ func (k Keeper) SessionsAll(c context.Context, req *types.SessionsAllRequest) (*
 	kvStore := ctx.KVStore(k.storeKey)
 	prefixStore := prefix.NewStore(kvStore, types.SessionKeyPrefix)
 
-	pageRes, err := query.Paginate(prefixStore, pageRequest, func(key, value []byte) error {
+	pageRes, err := query.FilteredPaginate(prefixStore, pageRequest, func(key, value []byte, accumulate bool) (bool, error) {
 		var session types.Session
 		vErr := session.Unmarshal(value)
 		if vErr == nil {
-			retval.Sessions = append(retval.Sessions, types.WrapSession(&session, incInfo))
-			return nil
+			if !sessionHasStatus(session, status) {
+				return false, nil
+			}
+			if accumulate {
+				retval.Sessions = append(retval.Sessions, types.WrapSession(&session, incInfo))
+			}
+			return true, nil
+		}
+		if !accumulate {
+			return true, nil
 		}
 		// Something's wrong. Let's do what we can to give indications of it.
 		var addr types.MetadataAddress
@@ -374,7 +390,7 @@ func (k Keeper) SessionsAll(c context.Context, req *types.SessionsAllRequest) (*
 				"key error", kErr, "value error", vErr, "key (base64)", k64)
 			retval.Sessions = append(retval.Sessions, &types.SessionWrapper{})
 		}
-		return nil // Still want to move on to the next.
+		return true, nil // Still want to move on to the next.
 	})
 	if err != nil {
 		return &retval, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
@@ -383,6 +399,11 @@ func (k Keeper) SessionsAll(c context.Context, req *types.SessionsAllRequest) (*
 	return &retval, nil
 }
 
+// sessionHasStatus returns true if the status is unspecified, or the session has that (effective) status.
+func sessionHasStatus(session types.Session, status types.SessionStatus) bool {
+	return status == types.SessionStatus_Unspecified || session.EffectiveStatus() == status
+}
+
 // Records returns records based on the provided request.
 func (k Keeper) Records(c context.Context, req *types.RecordsRequest) (*types.RecordsResponse, error) {
 	defer telemetry.MeasureSince(telemetry.Now(), types.ModuleName, "query", "Records")
@@ -569,6 +590,88 @@ func (k Keeper) RecordsAll(c context.Context, req *types.RecordsAllRequest) (*ty
 	return &retval, nil
 }
 
+// RecordsForProcess returns the records produced by a process hash, optionally limited to a method (limited by pagination).
+func (k Keeper) RecordsForProcess(c context.Context, req *types.RecordsForProcessRequest) (*types.RecordsForProcessResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), types.ModuleName, "query", "RecordsForProcess")
+	if req == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("empty request")
+	}
+
+	retval := types.RecordsForProcessResponse{}
+	if req.IncludeRequest {
+		retval.Request = req
+	}
+
+	if len(req.ProcessHash) == 0 {
+		return &retval, sdkerrors.ErrInvalidRequest.Wrap("process hash cannot be empty")
+	}
+
+	// Without a method, the keys in the prefix store also start with the method hash.
+	keyPrefix := types.GetProcessRecordCacheIteratorPrefix(req.ProcessHash)
+	methodHashLen := 16
+	if len(req.Method) > 0 {
+		keyPrefix = types.GetProcessMethodRecordCacheIteratorPrefix(req.ProcessHash, req.Method)
+		methodHashLen = 0
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), keyPrefix)
+	pageRes, err := query.Paginate(store, getPageRequest(req), func(key, _ []byte) error {
+		if len(key) <= methodHashLen {
+			return fmt.Errorf("invalid process record index key %X", key)
+		}
+		recordID := types.MetadataAddress(key[methodHashLen:])
+		record, found := k.GetRecord(ctx, recordID)
+		if !found {
+			retval.Records = append(retval.Records, types.WrapRecordNotFound(recordID))
+			return nil
+		}
+		retval.Records = append(retval.Records, types.WrapRecord(&record, !req.ExcludeIdInfo))
+		return nil
+	})
+	if err != nil {
+		return &retval, sdkerrors.ErrInvalidRequest.Wrapf("paginate: %v", err)
+	}
+	retval.Pagination = pageRes
+
+	return &retval, nil
+}
+
+// WriteRecordViolations returns all the reasons that the provided WriteRecord msg would fail.
+func (k Keeper) WriteRecordViolations(c context.Context, req *types.WriteRecordViolationsRequest) (*types.WriteRecordViolationsResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), types.ModuleName, "query", "WriteRecordViolations")
+	if req == nil || req.Msg == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("empty request")
+	}
+
+	retval := types.WriteRecordViolationsResponse{}
+	if req.IncludeRequest {
+		retval.Request = req
+	}
+
+	// Work with a copy since the optional fields get converted and the record's specification id might get set.
+	msg := *req.Msg
+	if err := msg.ValidateBasic(); err != nil {
+		retval.Violations = []string{err.Error()}
+		return &retval, nil
+	}
+	//nolint:errcheck // the error was checked when msg.ValidateBasic was called above.
+	msg.ConvertOptionalFields()
+
+	ctx := UnwrapMetadataContext(c)
+	var existing *types.Record
+	if scopeUUID, err := msg.Record.SessionId.ScopeUUID(); err == nil {
+		if e, found := k.GetRecord(ctx, types.RecordMetadataAddress(scopeUUID, msg.Record.Name)); found {
+			existing = &e
+		}
+	}
+
+	for _, err := range k.GetWriteRecordViolations(ctx, existing, &msg) {
+		retval.Violations = append(retval.Violations, err.Error())
+	}
+	return &retval, nil
+}
+
 // Ownership returns a list of scope identifiers that list the given address as a data or value owner.
 func (k Keeper) Ownership(c context.Context, req *types.OwnershipRequest) (*types.OwnershipResponse, error) {
 	defer telemetry.MeasureSince(telemetry.Now(), types.ModuleName, "query", "Ownership")
@@ -647,6 +750,74 @@ func (k Keeper) ValueOwnership(c context.Context, req *types.ValueOwnershipReque
 	return &retval, nil
 }
 
+// ScopeAccessChanges returns the recent changes to the data access lists and value owners of scopes, oldest first.
+func (k Keeper) ScopeAccessChanges(c context.Context, req *types.ScopeAccessChangesRequest) (*types.ScopeAccessChangesResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), types.ModuleName, "query", "ScopeAccessChanges")
+	if req == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("empty request")
+	}
+
+	retval := types.ScopeAccessChangesResponse{}
+	if req.IncludeRequest {
+		retval.Request = req
+	}
+
+	var scopeID types.MetadataAddress
+	if len(req.ScopeId) > 0 {
+		var err error
+		scopeID, err = ParseScopeID(req.ScopeId)
+		if err != nil {
+			return &retval, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+		}
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	changeStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.ScopeAccessChangeKeyPrefix)
+	pageRes, err := query.FilteredPaginate(changeStore, getPageRequest(req), func(_ []byte, value []byte, accumulate bool) (bool, error) {
+		var change types.ScopeAccessChange
+		if err := k.cdc.Unmarshal(value, &change); err != nil {
+			return false, err
+		}
+		if !scopeID.Empty() && !scopeID.Equals(change.ScopeId) {
+			return false, nil
+		}
+		if accumulate {
+			retval.Changes = append(retval.Changes, change)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return &retval, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+	retval.Pagination = pageRes
+
+	return &retval, nil
+}
+
+// ScopeSettlement returns the pending sale of a scope's value ownership.
+func (k Keeper) ScopeSettlement(c context.Context, req *types.ScopeSettlementRequest) (*types.ScopeSettlementResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), types.ModuleName, "query", "ScopeSettlement")
+	if req == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("empty request")
+	}
+
+	scopeID, err := ParseScopeID(req.ScopeId)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	settlement, err := k.GetScopeSettlement(ctx, scopeID)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+	if settlement == nil {
+		return nil, sdkerrors.ErrNotFound.Wrapf("scope %s does not have a pending settlement", scopeID)
+	}
+
+	return &types.ScopeSettlementResponse{Settlement: *settlement}, nil
+}
+
 // ScopeSpecification returns a specific scope specification by id.
 func (k Keeper) ScopeSpecification(c context.Context, req *types.ScopeSpecificationRequest) (*types.ScopeSpecificationResponse, error) {
 	defer telemetry.MeasureSince(telemetry.Now(), types.ModuleName, "query", "ScopeSpecification")
@@ -962,6 +1133,231 @@ func (k Keeper) RecordSpecificationsAll(c context.Context, req *types.RecordSpec
 	return &retval, nil
 }
 
+// ScopeSpecificationsForOwner returns the scope specifications that list the given address as an owner (limited by pagination).
+func (k Keeper) ScopeSpecificationsForOwner(c context.Context, req *types.ScopeSpecificationsForOwnerRequest) (*types.ScopeSpecificationsForOwnerResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), types.ModuleName, "query", "ScopeSpecificationsForOwner")
+	if req == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("empty request")
+	}
+
+	retval := types.ScopeSpecificationsForOwnerResponse{}
+	if req.IncludeRequest {
+		retval.Request = req
+	}
+
+	if req.Owner == "" {
+		return &retval, sdkerrors.ErrInvalidRequest.Wrap("owner cannot be empty")
+	}
+	owner, err := sdk.AccAddressFromBech32(req.Owner)
+	if err != nil {
+		return &retval, sdkerrors.ErrInvalidRequest.Wrapf("invalid owner: %v", err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetAddressScopeSpecCacheIteratorPrefix(owner))
+	pageRes, err := query.Paginate(store, getPageRequest(req), func(key, _ []byte) error {
+		var specID types.MetadataAddress
+		if mErr := specID.Unmarshal(key); mErr != nil {
+			return mErr
+		}
+		spec, found := k.GetScopeSpecification(ctx, specID)
+		if !found {
+			retval.ScopeSpecifications = append(retval.ScopeSpecifications, types.WrapScopeSpecNotFound(specID))
+			return nil
+		}
+		retval.ScopeSpecifications = append(retval.ScopeSpecifications, types.WrapScopeSpec(&spec, !req.ExcludeIdInfo))
+		return nil
+	})
+	if err != nil {
+		return &retval, sdkerrors.ErrInvalidRequest.Wrapf("paginate: %v", err)
+	}
+	retval.Pagination = pageRes
+
+	return &retval, nil
+}
+
+// ContractSpecificationsForOwner returns the contract specifications that list the given address as an owner (limited by pagination).
+func (k Keeper) ContractSpecificationsForOwner(c context.Context, req *types.ContractSpecificationsForOwnerRequest) (*types.ContractSpecificationsForOwnerResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), types.ModuleName, "query", "ContractSpecificationsForOwner")
+	if req == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("empty request")
+	}
+
+	retval := types.ContractSpecificationsForOwnerResponse{}
+	if req.IncludeRequest {
+		retval.Request = req
+	}
+
+	if req.Owner == "" {
+		return &retval, sdkerrors.ErrInvalidRequest.Wrap("owner cannot be empty")
+	}
+	owner, err := sdk.AccAddressFromBech32(req.Owner)
+	if err != nil {
+		return &retval, sdkerrors.ErrInvalidRequest.Wrapf("invalid owner: %v", err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetAddressContractSpecCacheIteratorPrefix(owner))
+	pageRes, err := query.Paginate(store, getPageRequest(req), func(key, _ []byte) error {
+		var specID types.MetadataAddress
+		if mErr := specID.Unmarshal(key); mErr != nil {
+			return mErr
+		}
+		spec, found := k.GetContractSpecification(ctx, specID)
+		if !found {
+			retval.ContractSpecifications = append(retval.ContractSpecifications, types.WrapContractSpecNotFound(specID))
+			return nil
+		}
+		retval.ContractSpecifications = append(retval.ContractSpecifications, types.WrapContractSpec(&spec, !req.ExcludeIdInfo))
+		return nil
+	})
+	if err != nil {
+		return &retval, sdkerrors.ErrInvalidRequest.Wrapf("paginate: %v", err)
+	}
+	retval.Pagination = pageRes
+
+	return &retval, nil
+}
+
+// RecordSpecificationsForOwner returns the record specifications of the contract specifications that list the given address as an owner (limited by pagination).
+func (k Keeper) RecordSpecificationsForOwner(c context.Context, req *types.RecordSpecificationsForOwnerRequest) (*types.RecordSpecificationsForOwnerResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), types.ModuleName, "query", "RecordSpecificationsForOwner")
+	if req == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("empty request")
+	}
+
+	retval := types.RecordSpecificationsForOwnerResponse{}
+	if req.IncludeRequest {
+		retval.Request = req
+	}
+
+	if req.Owner == "" {
+		return &retval, sdkerrors.ErrInvalidRequest.Wrap("owner cannot be empty")
+	}
+	owner, err := sdk.AccAddressFromBech32(req.Owner)
+	if err != nil {
+		return &retval, sdkerrors.ErrInvalidRequest.Wrapf("invalid owner: %v", err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetAddressRecordSpecCacheIteratorPrefix(owner))
+	pageRes, err := query.Paginate(store, getPageRequest(req), func(key, _ []byte) error {
+		var specID types.MetadataAddress
+		if mErr := specID.Unmarshal(key); mErr != nil {
+			return mErr
+		}
+		spec, found := k.GetRecordSpecification(ctx, specID)
+		if !found {
+			retval.RecordSpecifications = append(retval.RecordSpecifications, types.WrapRecordSpecNotFound(specID))
+			return nil
+		}
+		retval.RecordSpecifications = append(retval.RecordSpecifications, types.WrapRecordSpec(&spec, !req.ExcludeIdInfo))
+		return nil
+	})
+	if err != nil {
+		return &retval, sdkerrors.ErrInvalidRequest.Wrapf("paginate: %v", err)
+	}
+	retval.Pagination = pageRes
+
+	return &retval, nil
+}
+
+// SpecificationUsage returns how many things use a scope or contract specification, and whether it can be deleted.
+func (k Keeper) SpecificationUsage(c context.Context, req *types.SpecificationUsageRequest) (*types.SpecificationUsageResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), types.ModuleName, "query", "SpecificationUsage")
+	if req == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("empty request")
+	}
+
+	retval := types.SpecificationUsageResponse{}
+	if req.IncludeRequest {
+		retval.Request = req
+	}
+
+	specID, err := parseUsedSpecID(req.SpecificationId)
+	if err != nil {
+		return &retval, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := ctx.KVStore(k.storeKey)
+	if specID.IsScopeSpecificationAddress() {
+		retval.ScopeCount = countKeysWithPrefix(store, types.GetScopeSpecScopeCacheIteratorPrefix(specID))
+		retval.InMigration = k.isScopeSpecInMigration(ctx, specID)
+		retval.InUseReason = k.getScopeSpecUse(ctx, specID)
+	} else {
+		retval.SessionCount = countKeysWithPrefix(store, types.GetContractSpecSessionCacheIteratorPrefix(specID))
+		retval.ScopeSpecificationCount = countKeysWithPrefix(store, types.GetContractSpecScopeSpecCacheIteratorPrefix(specID))
+		retval.InUseReason = k.getContractSpecUse(ctx, specID)
+	}
+	retval.InUse = len(retval.InUseReason) > 0
+
+	return &retval, nil
+}
+
+// SpecificationDependents returns the ids of the things that use a scope or contract specification (limited by pagination).
+func (k Keeper) SpecificationDependents(c context.Context, req *types.SpecificationDependentsRequest) (*types.SpecificationDependentsResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), types.ModuleName, "query", "SpecificationDependents")
+	if req == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("empty request")
+	}
+
+	retval := types.SpecificationDependentsResponse{}
+	if req.IncludeRequest {
+		retval.Request = req
+	}
+
+	specID, err := parseUsedSpecID(req.SpecificationId)
+	if err != nil {
+		return &retval, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	var ids *[]string
+	var keyPrefix []byte
+	if specID.IsScopeSpecificationAddress() {
+		ids = &retval.ScopeIds
+		keyPrefix = types.GetScopeSpecScopeCacheIteratorPrefix(specID)
+	} else {
+		ids = &retval.SessionIds
+		keyPrefix = types.GetContractSpecSessionCacheIteratorPrefix(specID)
+		err = k.IterateScopeSpecsForContractSpec(ctx, specID, func(scopeSpecID types.MetadataAddress) (stop bool) {
+			retval.ScopeSpecificationIds = append(retval.ScopeSpecificationIds, scopeSpecID.String())
+			return false
+		})
+		if err != nil {
+			return &retval, fmt.Errorf("error retrieving contract spec [%s] scope specs: %w", specID, err)
+		}
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), keyPrefix)
+	pageRes, err := query.Paginate(store, getPageRequest(req), func(key, _ []byte) error {
+		var id types.MetadataAddress
+		if mErr := id.Unmarshal(key); mErr != nil {
+			return mErr
+		}
+		*ids = append(*ids, id.String())
+		return nil
+	})
+	if err != nil {
+		return &retval, sdkerrors.ErrInvalidRequest.Wrapf("paginate: %v", err)
+	}
+	retval.Pagination = pageRes
+
+	return &retval, nil
+}
+
+// countKeysWithPrefix returns the number of entries in the store with keys that start with the provided prefix.
+func countKeysWithPrefix(store storetypes.KVStore, keyPrefix []byte) uint64 {
+	it := storetypes.KVStorePrefixIterator(store, keyPrefix)
+	defer it.Close()
+	var rv uint64
+	for ; it.Valid(); it.Next() {
+		rv++
+	}
+	return rv
+}
+
 // GetByAddr retrieves metadata given any address(es).
 func (k Keeper) GetByAddr(c context.Context, req *types.GetByAddrRequest) (*types.GetByAddrResponse, error) {
 	defer telemetry.MeasureSince(telemetry.Now(), types.ModuleName, "query", "GetByAddr")
@@ -1061,6 +1457,7 @@ func (k Keeper) OSLocator(c context.Context, request *types.OSLocatorRequest) (*
 		return &retval, types.ErrAddressNotBound
 	}
 	retval.Locator = &record
+	retval.Uris = record.AllURIs()
 
 	return &retval, nil
 }
@@ -1094,7 +1491,7 @@ func (k Keeper) OSLocatorsByURI(ctx context.Context, request *types.OSLocatorsBy
 		if rerr := k.cdc.Unmarshal(value, &record); rerr != nil {
 			return false, rerr
 		}
-		if record.LocatorUri != uriStr {
+		if !slices.Contains(record.AllURIs(), uriStr) {
 			return false, nil
 		}
 		if accumulate {
@@ -1267,6 +1664,22 @@ func ParseRecordAddr(recordAddr string) (types.MetadataAddress, error) {
 	return addr, nil
 }
 
+// parseUsedSpecID parses the provided input into either a scope spec or contract spec MetadataAddress.
+// The input must be a bech32 string. An error is returned if it's any other kind of address.
+func parseUsedSpecID(specID string) (types.MetadataAddress, error) {
+	if len(specID) == 0 {
+		return types.MetadataAddress{}, errors.New("specification id cannot be empty")
+	}
+	addr, err := types.MetadataAddressFromBech32(specID)
+	if err != nil {
+		return types.MetadataAddress{}, fmt.Errorf("invalid specification id [%s]: %w", specID, err)
+	}
+	if !addr.IsScopeSpecificationAddress() && !addr.IsContractSpecificationAddress() {
+		return types.MetadataAddress{}, fmt.Errorf("address [%s] is not a scope spec or contract spec address", specID)
+	}
+	return addr, nil
+}
+
 // ParseScopeSpecID parses the provided input into a scope spec MetadataAddress.
 // The input can either be a uuid string or scope spec address bech32 string.
 func ParseScopeSpecID(scopeSpecID string) (types.MetadataAddress, error) {
@@ -1769,6 +1769,108 @@ func (s *QueryServerTestSuite) TestRecordsQuery() {
 	s.Equal(recordNames[0], rsID.Records[0].Record.Name)
 }
 
+func (s *QueryServerTestSuite) TestWriteRecordViolations() {
+	app, ctx, queryClient := s.app, s.ctx, s.queryClient
+
+	scopeUUID := uuid.New()
+	scope := types.NewScope(types.ScopeMetadataAddress(scopeUUID), s.scopeSpecID, ownerPartyList(s.user1), []string{s.user1}, s.user1, false)
+	app.MetadataKeeper.SetScope(ctx, *scope)
+	sessionID := types.SessionMetadataAddress(scopeUUID, uuid.New())
+	session := types.NewSession("session", sessionID, s.cSpecID, ownerPartyList(s.user1), nil)
+	app.MetadataKeeper.SetSession(ctx, *session)
+	recSpec := types.NewRecordSpecification(
+		s.recSpecID,
+		s.recordName,
+		[]*types.InputSpecification{types.NewInputSpecification("input1", "input1type", types.NewInputSpecificationSourceHash("input1hash"))},
+		"recordtype",
+		types.DefinitionType_DEFINITION_TYPE_RECORD,
+		[]types.PartyType{types.PartyType_PARTY_TYPE_OWNER},
+	)
+	app.MetadataKeeper.SetRecordSpecification(ctx, *recSpec)
+
+	process := types.NewProcess("processname", &types.Process_Hash{Hash: "HASH"}, "process_method")
+	goodInput := types.NewRecordInput("input1", &types.RecordInput_Hash{Hash: "input1hash"}, "input1type", types.RecordInputStatus_Proposed)
+	badTypeInput := types.NewRecordInput("input1", &types.RecordInput_Hash{Hash: "input1hash"}, "othertype", types.RecordInputStatus_Proposed)
+	extraInput := types.NewRecordInput("extra", &types.RecordInput_Hash{Hash: "extrahash"}, "extratype", types.RecordInputStatus_Proposed)
+	output := types.RecordOutput{Hash: "output", Status: types.ResultStatus_RESULT_STATUS_PASS}
+	newMsg := func(sessionID types.MetadataAddress, inputs []types.RecordInput, outputs []types.RecordOutput, signers ...string) *types.MsgWriteRecordRequest {
+		record := types.NewRecord(s.recordName, sessionID, *process, inputs, outputs, s.recSpecID)
+		return &types.MsgWriteRecordRequest{Record: *record, Signers: signers}
+	}
+	unknownSessionID := types.SessionMetadataAddress(scopeUUID, uuid.New())
+
+	tests := []struct {
+		name    string
+		req     *types.WriteRecordViolationsRequest
+		expErr  string
+		expViol []string
+	}{
+		{
+			name:   "nil msg",
+			req:    &types.WriteRecordViolationsRequest{},
+			expErr: "empty request: invalid request",
+		},
+		{
+			name: "valid",
+			req: &types.WriteRecordViolationsRequest{
+				Msg: newMsg(sessionID, []types.RecordInput{*goodInput}, []types.RecordOutput{output}, s.user1),
+			},
+			expViol: nil,
+		},
+		{
+			name: "fails basic validation",
+			req: &types.WriteRecordViolationsRequest{
+				Msg: newMsg(sessionID, []types.RecordInput{*goodInput}, []types.RecordOutput{output}),
+			},
+			expViol: []string{"at least one signer is required"},
+		},
+		{
+			name: "session not found",
+			req: &types.WriteRecordViolationsRequest{
+				Msg: newMsg(unknownSessionID, []types.RecordInput{*goodInput}, []types.RecordOutput{output}, s.user1),
+			},
+			expViol: []string{fmt.Sprintf("session not found for session id %s", unknownSessionID)},
+		},
+		{
+			name: "several problems",
+			req: &types.WriteRecordViolationsRequest{
+				Msg: newMsg(sessionID, []types.RecordInput{*badTypeInput, *extraInput}, []types.RecordOutput{output, output}, s.user2),
+			},
+			expViol: []string{
+				fmt.Sprintf("missing signature: %s", s.user1),
+				"extra input [extra]",
+				"input input1 has TypeName othertype but spec calls for input1type",
+				"invalid output count (expected: 1, got: 2)",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			resp, err := queryClient.WriteRecordViolations(gocontext.Background(), tc.req)
+			if len(tc.expErr) > 0 {
+				s.Require().EqualError(err, tc.expErr, "WriteRecordViolations error")
+				return
+			}
+			s.Require().NoError(err, "WriteRecordViolations error")
+			s.Assert().Equal(tc.expViol, resp.Violations, "WriteRecordViolations violations")
+		})
+	}
+
+	s.Run("include request", func() {
+		req := &types.WriteRecordViolationsRequest{
+			Msg:            newMsg(sessionID, []types.RecordInput{*goodInput}, []types.RecordOutput{output}, s.user1),
+			IncludeRequest: true,
+		}
+		resp, err := queryClient.WriteRecordViolations(gocontext.Background(), req)
+		s.Require().NoError(err, "WriteRecordViolations error")
+		s.Assert().Equal(req, resp.Request, "WriteRecordViolations request")
+
+		_, found := app.MetadataKeeper.GetRecord(ctx, types.RecordMetadataAddress(scopeUUID, s.recordName))
+		s.Assert().False(found, "record found after WriteRecordViolations")
+	})
+}
+
 // TODO: RecordsAll tests
 // TODO: Ownership tests
 // TODO: ValueOwnership tests
@@ -1848,6 +1950,86 @@ func (s *QueryServerTestSuite) TestScopeSpecificationQuery() {
 	})
 }
 
+func (s *QueryServerTestSuite) TestSpecificationsForOwnerQueries() {
+	app, ctx, queryClient := s.app, s.ctx, s.queryClient
+
+	scopeSpec := types.NewScopeSpecification(
+		s.scopeSpecID,
+		types.NewDescription("test-scope-spec", "testing", "https://provenance.io", ""),
+		[]string{s.user1},
+		[]types.PartyType{types.PartyType_PARTY_TYPE_AFFILIATE},
+		[]types.MetadataAddress{s.cSpecID},
+	)
+	app.MetadataKeeper.SetScopeSpecification(ctx, *scopeSpec)
+
+	contractSpec := types.NewContractSpecification(
+		s.cSpecID,
+		types.NewDescription("test-contract-spec", "testing", "https://provenance.io", ""),
+		[]string{s.user1, s.user2},
+		[]types.PartyType{types.PartyType_PARTY_TYPE_AFFILIATE},
+		types.NewContractSpecificationSourceHash("hash"),
+		"",
+	)
+	app.MetadataKeeper.SetContractSpecification(ctx, *contractSpec)
+
+	var recSpecIDs []types.MetadataAddress
+	for _, name := range []string{"record-a", "record-b", "record-c"} {
+		recSpecID := s.cSpecID.MustGetAsRecordSpecAddress(name)
+		recSpecIDs = append(recSpecIDs, recSpecID)
+		app.MetadataKeeper.SetRecordSpecification(ctx, *types.NewRecordSpecification(
+			recSpecID, name, nil, "type-name", types.DefinitionType_DEFINITION_TYPE_RECORD,
+			[]types.PartyType{types.PartyType_PARTY_TYPE_AFFILIATE},
+		))
+	}
+
+	s.T().Run("scope specs for owner", func(t *testing.T) {
+		res, err := queryClient.ScopeSpecificationsForOwner(ctx, &types.ScopeSpecificationsForOwnerRequest{Owner: s.user1})
+		require.NoError(t, err, "ScopeSpecificationsForOwner")
+		require.Len(t, res.ScopeSpecifications, 1, "scope specifications")
+		assert.Equal(t, s.scopeSpecID, res.ScopeSpecifications[0].Specification.SpecificationId, "scope spec id")
+		assert.NotNil(t, res.ScopeSpecifications[0].ScopeSpecIdInfo, "scope spec id info")
+
+		res, err = queryClient.ScopeSpecificationsForOwner(ctx, &types.ScopeSpecificationsForOwnerRequest{Owner: s.user2})
+		require.NoError(t, err, "ScopeSpecificationsForOwner user2")
+		assert.Empty(t, res.ScopeSpecifications, "scope specifications of user2")
+	})
+
+	s.T().Run("contract specs for owner", func(t *testing.T) {
+		for _, owner := range []string{s.user1, s.user2} {
+			res, err := queryClient.ContractSpecificationsForOwner(ctx, &types.ContractSpecificationsForOwnerRequest{Owner: owner, ExcludeIdInfo: true})
+			require.NoError(t, err, "ContractSpecificationsForOwner(%s)", owner)
+			require.Len(t, res.ContractSpecifications, 1, "contract specifications of %s", owner)
+			assert.Equal(t, s.cSpecID, res.ContractSpecifications[0].Specification.SpecificationId, "contract spec id")
+			assert.Nil(t, res.ContractSpecifications[0].ContractSpecIdInfo, "contract spec id info")
+		}
+	})
+
+	s.T().Run("record specs for owner paginated", func(t *testing.T) {
+		var found []types.MetadataAddress
+		var nextKey []byte
+		for page := 0; page < 2; page++ {
+			res, err := queryClient.RecordSpecificationsForOwner(ctx, &types.RecordSpecificationsForOwnerRequest{
+				Owner:      s.user2,
+				Pagination: &query.PageRequest{Key: nextKey, Limit: 2},
+			})
+			require.NoError(t, err, "RecordSpecificationsForOwner page %d", page)
+			for _, spec := range res.RecordSpecifications {
+				found = append(found, spec.Specification.SpecificationId)
+			}
+			nextKey = res.Pagination.NextKey
+		}
+		assert.Nil(t, nextKey, "next key after last page")
+		assert.ElementsMatch(t, recSpecIDs, found, "record spec ids")
+	})
+
+	s.T().Run("invalid owner", func(t *testing.T) {
+		_, err := queryClient.RecordSpecificationsForOwner(ctx, &types.RecordSpecificationsForOwnerRequest{Owner: "invalid"})
+		assert.ErrorContains(t, err, "invalid owner", "RecordSpecificationsForOwner")
+		_, err = queryClient.ScopeSpecificationsForOwner(ctx, &types.ScopeSpecificationsForOwnerRequest{})
+		assert.ErrorContains(t, err, "owner cannot be empty", "ScopeSpecificationsForOwner")
+	})
+}
+
 // TODO: ScopeSpecificationsAll tests
 // TODO: ContractSpecification tests
 // TODO: ContractSpecificationsAll tests
@@ -1942,6 +2124,7 @@ func (s *QueryServerTestSuite) TestGetByAddr() {
 		PartiesInvolved: scopeSpec.PartiesInvolved,
 		Source:          &types.ContractSpecification_Hash{Hash: "1845ca13f061fc68f2343bba7f6be3be"}, // printf 'mycspec' | md5sum
 		ClassName:       "myclass",
+		ReplacementId:   types.MetadataAddress{}, // Non-nullable, so it comes back out of state as empty (rather than nil).
 	}
 	recSpec := types.RecordSpecification{
 		SpecificationId: recSpecID,
@@ -64,14 +64,30 @@ func (k Keeper) SetRecord(ctx sdk.Context, record types.Record) {
 	recordID := record.SessionId.MustGetAsRecordAddress(record.Name)
 
 	var event proto.Message = types.NewEventRecordCreated(recordID, record.SessionId)
-	if store.Has(recordID) {
+	if existing, found := k.GetRecord(ctx, recordID); found {
 		event = types.NewEventRecordUpdated(recordID, record.SessionId)
+		if indexKey := getRecordProcessIndexKey(existing, recordID); indexKey != nil {
+			store.Delete(indexKey)
+		}
 	}
 
 	store.Set(recordID, b)
+	if indexKey := getRecordProcessIndexKey(record, recordID); indexKey != nil {
+		store.Set(indexKey, []byte{0x01})
+	}
 	k.EmitEvent(ctx, event)
 }
 
+// getRecordProcessIndexKey gets the process index key of a record.
+// Only records with a process identified by a hash are indexed, so nil is returned for all others.
+func getRecordProcessIndexKey(record types.Record, recordID types.MetadataAddress) []byte {
+	processHash := record.Process.GetHash()
+	if len(processHash) == 0 {
+		return nil
+	}
+	return types.GetProcessRecordCacheKey(processHash, record.Process.Method, recordID)
+}
+
 // RemoveRecord removes a record from the module kv store.
 func (k Keeper) RemoveRecord(ctx sdk.Context, id types.MetadataAddress) {
 	if !id.IsRecordAddress() {
@@ -82,6 +98,9 @@ func (k Keeper) RemoveRecord(ctx sdk.Context, id types.MetadataAddress) {
 		return
 	}
 	store := ctx.KVStore(k.storeKey)
+	if indexKey := getRecordProcessIndexKey(record, id); indexKey != nil {
+		store.Delete(indexKey)
+	}
 	store.Delete(id)
 	k.EmitEvent(ctx, types.NewEventRecordDeleted(id))
 
@@ -120,15 +139,48 @@ func (k Keeper) ValidateWriteRecord(
 	existing *types.Record,
 	msg *types.MsgWriteRecordRequest,
 ) error {
+	if errs := k.validateWriteRecord(ctx, existing, msg, true); len(errs) > 0 {
+		return errs[0]
+	}
+	return nil
+}
+
+// GetWriteRecordViolations runs the same checks as ValidateWriteRecord, but returns everything that's wrong
+// instead of stopping at the first problem. Checks that depend on something already found to be wrong are skipped.
+func (k Keeper) GetWriteRecordViolations(
+	ctx sdk.Context,
+	existing *types.Record,
+	msg *types.MsgWriteRecordRequest,
+) []error {
+	return k.validateWriteRecord(ctx, existing, msg, false)
+}
+
+// validateWriteRecord does the work of ValidateWriteRecord and GetWriteRecordViolations.
+// If firstOnly is true, it stops after the first problem is found.
+func (k Keeper) validateWriteRecord(
+	ctx sdk.Context,
+	existing *types.Record,
+	msg *types.MsgWriteRecordRequest,
+	firstOnly bool,
+) []error {
+	var errs []error
+	// fail records the error and returns true if validation should stop.
+	fail := func(err error) bool {
+		errs = append(errs, err)
+		return firstOnly
+	}
+
 	proposed := &msg.Record
 	if err := proposed.ValidateBasic(); err != nil {
-		return err
+		return append(errs, err)
 	}
 
 	var oldSession *types.Session
 	if existing != nil {
 		if existing.Name != proposed.Name {
-			return fmt.Errorf("the Name field of records cannot be changed")
+			if fail(fmt.Errorf("the Name field of records cannot be changed")) {
+				return errs
+			}
 		}
 		if !existing.SessionId.Equals(proposed.SessionId) {
 			// If the session is changing, add the original session's parties to the required parties list.
@@ -142,81 +194,109 @@ func (k Keeper) ValidateWriteRecord(
 		// And for now, we'll allow the proposed specification id to be missing and set it appropriately below.
 		// But if we've got both, make sure they didn't change.
 		if !existing.SpecificationId.Empty() && !proposed.SpecificationId.Empty() && !existing.SpecificationId.Equals(proposed.SpecificationId) {
-			return fmt.Errorf("the SpecificationId of records cannot be changed")
+			if fail(fmt.Errorf("the SpecificationId of records cannot be changed")) {
+				return errs
+			}
 		}
 	}
 
 	scopeID, scopeIDErr := proposed.SessionId.AsScopeAddress()
 	if scopeIDErr != nil {
-		return scopeIDErr
+		return append(errs, scopeIDErr)
 	}
 
 	// Get the scope, session, and record spec.
-	scope, found := k.GetScope(ctx, scopeID)
-	if !found {
-		return fmt.Errorf("scope not found with id %s", scopeID)
+	scope, scopeFound := k.GetScope(ctx, scopeID)
+	if !scopeFound {
+		if fail(fmt.Errorf("scope not found with id %s", scopeID)) {
+			return errs
+		}
 	}
 	session, found := k.GetSession(ctx, proposed.SessionId)
 	if !found {
-		return fmt.Errorf("session not found for session id %s", proposed.SessionId)
+		return append(errs, fmt.Errorf("session not found for session id %s", proposed.SessionId))
+	}
+	if !session.IsOpen() {
+		if fail(fmt.Errorf("cannot write record to session %s: status is %s", session.SessionId, session.EffectiveStatus())) {
+			return errs
+		}
+	}
+	if oldSession != nil && !oldSession.IsOpen() {
+		if fail(fmt.Errorf("cannot move record out of session %s: status is %s", oldSession.SessionId, oldSession.EffectiveStatus())) {
+			return errs
+		}
 	}
 	recSpecID, err := session.SpecificationId.AsRecordSpecAddress(proposed.Name)
 	if err != nil {
-		return fmt.Errorf("could not create record specification id from contract spec id %s and record name %q",
-			session.SpecificationId, proposed.Name)
+		return append(errs, fmt.Errorf("could not create record specification id from contract spec id %s and record name %q",
+			session.SpecificationId, proposed.Name))
 	}
 	if proposed.SpecificationId.Empty() {
 		proposed.SpecificationId = recSpecID
 	} else if !proposed.SpecificationId.Equals(recSpecID) {
-		return fmt.Errorf("proposed specification id %s does not match expected specification id %s",
-			proposed.SpecificationId, recSpecID)
+		if fail(fmt.Errorf("proposed specification id %s does not match expected specification id %s",
+			proposed.SpecificationId, recSpecID)) {
+			return errs
+		}
 	}
 	recSpec, found := k.GetRecordSpecification(ctx, recSpecID)
 	if !found {
-		return fmt.Errorf("record specification not found for record specification id %s (contract spec id %s and record name %q)",
-			recSpecID, session.SpecificationId, proposed.Name)
+		return append(errs, fmt.Errorf("record specification not found for record specification id %s (contract spec id %s and record name %q)",
+			recSpecID, session.SpecificationId, proposed.Name))
 	}
 
 	// Make sure everyone has signed.
-	if !scope.RequirePartyRollup {
-		// Old:
-		//   - All roles required by the record spec must have a party in the session parties.
-		//   - All session parties must sign.
-		//   - If the record is changing to a new session, all previous session parties must sign.
-		if err = validateRolesPresent(session.Parties, recSpec.ResponsibleParties); err != nil {
-			return err
-		}
-		reqSigs := session.GetAllPartyAddresses()
-		if oldSession != nil {
-			reqSigs = append(reqSigs, oldSession.GetAllPartyAddresses()...)
-		}
-		if err = k.ValidateSignersWithoutParties(ctx, reqSigs, msg); err != nil {
-			return err
-		}
-	} else {
-		// New:
-		//   - All roles required by the record spec must have a signer and associated party in the session.
-		//   - All optional=false scope owners and session parties must be signers.
-		//   - If the record is changing sessions, all optional=false previous session parties must be signers.
-		var reqParties []types.Party
-		reqParties = append(reqParties, scope.Owners...)
-		reqParties = append(reqParties, session.Parties...)
-		if oldSession != nil {
-			reqParties = append(reqParties, oldSession.Parties...)
-		}
-		if err = k.ValidateSignersWithParties(ctx, reqParties, session.Parties, recSpec.ResponsibleParties, msg); err != nil {
-			return err
+	// Which rules apply depends on the scope, so this can only be checked if we've got one.
+	if scopeFound {
+		if !scope.RequirePartyRollup {
+			// Old:
+			//   - All roles required by the record spec must have a party in the session parties.
+			//   - All session parties must sign.
+			//   - If the record is changing to a new session, all previous session parties must sign.
+			if err = validateRolesPresent(session.Parties, recSpec.ResponsibleParties); err != nil {
+				if fail(err) {
+					return errs
+				}
+			}
+			reqSigs := session.GetAllPartyAddresses()
+			if oldSession != nil {
+				reqSigs = append(reqSigs, oldSession.GetAllPartyAddresses()...)
+			}
+			if err = k.ValidateSignersWithoutParties(ctx, reqSigs, msg); err != nil {
+				if fail(err) {
+					return errs
+				}
+			}
+		} else {
+			// New:
+			//   - All roles required by the record spec must have a signer and associated party in the session.
+			//   - All optional=false scope owners and session parties must be signers.
+			//   - If the record is changing sessions, all optional=false previous session parties must be signers.
+			var reqParties []types.Party
+			reqParties = append(reqParties, scope.Owners...)
+			reqParties = append(reqParties, session.Parties...)
+			if oldSession != nil {
+				reqParties = append(reqParties, oldSession.Parties...)
+			}
+			if err = k.ValidateSignersWithParties(ctx, reqParties, session.Parties, recSpec.ResponsibleParties, msg); err != nil {
+				if fail(err) {
+					return errs
+				}
+			}
 		}
 	}
 
 	// Make sure all input specs are present as inputs.
-	inputNames := make([]string, len(proposed.Inputs))
+	inputNames := make([]string, 0, len(proposed.Inputs))
 	inputMap := make(map[string]types.RecordInput)
-	for i, input := range proposed.Inputs {
+	for _, input := range proposed.Inputs {
 		if _, found := inputMap[input.Name]; found {
-			return fmt.Errorf("input name %s provided twice", input.Name)
+			if fail(fmt.Errorf("input name %s provided twice", input.Name)) {
+				return errs
+			}
+			continue
 		}
-		inputNames[i] = input.Name
+		inputNames = append(inputNames, input.Name)
 		inputMap[input.Name] = input
 	}
 	inputSpecNames := make([]string, len(recSpec.Inputs))
@@ -227,22 +307,32 @@ func (k Keeper) ValidateWriteRecord(
 	}
 	missingInputNames := provutils.FindMissing(inputSpecNames, inputNames)
 	if len(missingInputNames) > 0 {
-		return fmt.Errorf("missing input%s %v", provutils.PluralEnding(missingInputNames), missingInputNames)
+		if fail(fmt.Errorf("missing input%s %v", provutils.PluralEnding(missingInputNames), missingInputNames)) {
+			return errs
+		}
 	}
 	extraInputNames := provutils.FindMissing(inputNames, inputSpecNames)
 	if len(extraInputNames) > 0 {
-		return fmt.Errorf("extra input%s %v", provutils.PluralEnding(extraInputNames), extraInputNames)
+		if fail(fmt.Errorf("extra input%s %v", provutils.PluralEnding(extraInputNames), extraInputNames)) {
+			return errs
+		}
 	}
 
 	// Make sure all the inputs conform to their spec.
 	for _, name := range inputNames {
 		input := inputMap[name]
-		inputSpec := inputSpecMap[name]
+		inputSpec, found := inputSpecMap[name]
+		if !found {
+			// Already reported as an extra input.
+			continue
+		}
 
 		// Make sure the input TypeName is correct.
 		if inputSpec.TypeName != input.TypeName {
-			return fmt.Errorf("input %s has TypeName %s but spec calls for %s",
-				input.Name, input.TypeName, inputSpec.TypeName)
+			if fail(fmt.Errorf("input %s has TypeName %s but spec calls for %s",
+				input.Name, input.TypeName, inputSpec.TypeName)) {
+				return errs
+			}
 		}
 
 		// Get the input specification source type and value
@@ -256,7 +346,10 @@ func (k Keeper) ValidateWriteRecord(
 			inputSpecSourceType = sourceTypeHash
 			inputSpecSourceValue = source.Hash
 		default:
-			return fmt.Errorf("input spec %s has an unknown source type", inputSpec.Name)
+			if fail(fmt.Errorf("input spec %s has an unknown source type", inputSpec.Name)) {
+				return errs
+			}
+			continue
 		}
 
 		// Get the input source type and value
@@ -265,25 +358,42 @@ func (k Keeper) ValidateWriteRecord(
 		switch source := input.Source.(type) {
 		case *types.RecordInput_RecordId:
 			if _, found := k.GetRecord(ctx, source.RecordId); !found {
-				return fmt.Errorf("input %s source record id %s not found", input.Name, source.RecordId)
+				if fail(fmt.Errorf("input %s source record id %s not found", input.Name, source.RecordId)) {
+					return errs
+				}
 			}
 			inputSourceType = sourceTypeRecord
 			inputSourceValue = source.RecordId.String()
 		case *types.RecordInput_Hash:
 			inputSourceType = sourceTypeHash
 			inputSourceValue = source.Hash
+		case *types.RecordInput_IbcProof:
+			if err := k.VerifyIBCProof(ctx, source.IbcProof); err != nil {
+				if fail(fmt.Errorf("input %s source ibc proof could not be verified: %w", input.Name, err)) {
+					return errs
+				}
+			}
+			// Data from another chain isn't recorded on this one, so it satisfies a spec that calls for a hash.
+			inputSourceType = sourceTypeHash
+			inputSourceValue = source.IbcProof.String()
 		default:
-			return fmt.Errorf("input %s has an unknown source type", input.Name)
+			if fail(fmt.Errorf("input %s has an unknown source type", input.Name)) {
+				return errs
+			}
+			continue
 		}
 
 		// Make sure the input spec source type and value match the input source type and value
 		if inputSourceType != inputSpecSourceType {
-			return fmt.Errorf("input %s has source type %s but spec calls for %s",
-				input.Name, inputSourceType, inputSpecSourceType)
-		}
-		if inputSourceType == sourceTypeRecord && inputSourceValue != inputSpecSourceValue {
-			return fmt.Errorf("input %s has source value %s but spec calls for %s",
-				input.Name, inputSourceValue, inputSpecSourceValue)
+			if fail(fmt.Errorf("input %s has source type %s but spec calls for %s",
+				input.Name, inputSourceType, inputSpecSourceType)) {
+				return errs
+			}
+		} else if inputSourceType == sourceTypeRecord && inputSourceValue != inputSpecSourceValue {
+			if fail(fmt.Errorf("input %s has source value %s but spec calls for %s",
+				input.Name, inputSourceValue, inputSpecSourceValue)) {
+				return errs
+			}
 		}
 	}
 
@@ -291,17 +401,17 @@ func (k Keeper) ValidateWriteRecord(
 	switch recSpec.ResultType {
 	case types.DefinitionType_DEFINITION_TYPE_RECORD:
 		if len(proposed.Outputs) != 1 {
-			return fmt.Errorf("invalid output count (expected: 1, got: %d)", len(proposed.Outputs))
+			fail(fmt.Errorf("invalid output count (expected: 1, got: %d)", len(proposed.Outputs)))
 		}
 	case types.DefinitionType_DEFINITION_TYPE_RECORD_LIST:
 		if len(proposed.Outputs) == 0 {
-			return fmt.Errorf("invalid output count (expected > 0, got: 0)")
+			fail(fmt.Errorf("invalid output count (expected > 0, got: 0)"))
 		}
 	}
 	// case types.DefinitionType_DEFINITION_TYPE_PROPOSED: ignored
 	// case types.DefinitionType_DEFINITION_TYPE_UNSPECIFIED: ignored
 
-	return nil
+	return errs
 }
 
 // ValidateDeleteRecord checks the current record and the proposed removal scope to determine if the proposed remove is valid
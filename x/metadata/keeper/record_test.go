@@ -12,6 +12,9 @@ import (
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
 
 	"github.com/provenance-io/provenance/app"
 	simapp "github.com/provenance-io/provenance/app"
@@ -138,6 +141,92 @@ func (s *RecordKeeperTestSuite) TestMetadataRecordIterator() {
 
 }
 
+func (s *RecordKeeperTestSuite) TestRecordProcessIndex() {
+	ctx := s.FreshCtx()
+	store := ctx.KVStore(s.app.GetKey(types.StoreKey))
+
+	process := types.NewProcess("processname", &types.Process_Hash{Hash: "HASH1"}, "method1")
+	record := types.NewRecord(s.recordName, s.sessionID, *process, []types.RecordInput{}, []types.RecordOutput{}, s.recordSpecID)
+	s.app.MetadataKeeper.SetRecord(ctx, *record)
+	key1 := types.GetProcessRecordCacheKey("HASH1", "method1", s.recordID)
+	s.Assert().True(store.Has(key1), "index entry after SetRecord")
+
+	record.Process = *types.NewProcess("processname", &types.Process_Hash{Hash: "HASH2"}, "method2")
+	s.app.MetadataKeeper.SetRecord(ctx, *record)
+	key2 := types.GetProcessRecordCacheKey("HASH2", "method2", s.recordID)
+	s.Assert().False(store.Has(key1), "old index entry after updating the record's process")
+	s.Assert().True(store.Has(key2), "new index entry after updating the record's process")
+
+	s.app.MetadataKeeper.RemoveRecord(ctx, s.recordID)
+	s.Assert().False(store.Has(key2), "index entry after RemoveRecord")
+}
+
+func (s *RecordKeeperTestSuite) TestRecordsForProcessQuery() {
+	ctx := s.FreshCtx()
+	var method1IDs, method2IDs []types.MetadataAddress
+	for i := 1; i <= 6; i++ {
+		method := "method1"
+		if i%2 == 0 {
+			method = "method2"
+		}
+		process := types.NewProcess("processname", &types.Process_Hash{Hash: "HASH"}, method)
+		recordName := fmt.Sprintf("%s%v", s.recordName, i)
+		record := types.NewRecord(recordName, s.sessionID, *process, []types.RecordInput{}, []types.RecordOutput{}, s.recordSpecID)
+		s.app.MetadataKeeper.SetRecord(ctx, *record)
+		if i%2 == 0 {
+			method2IDs = append(method2IDs, record.GetRecordAddress())
+		} else {
+			method1IDs = append(method1IDs, record.GetRecordAddress())
+		}
+	}
+	other := types.NewRecord("other", s.sessionID, *types.NewProcess("processname", &types.Process_Hash{Hash: "OTHER"}, "method1"),
+		[]types.RecordInput{}, []types.RecordOutput{}, s.recordSpecID)
+	s.app.MetadataKeeper.SetRecord(ctx, *other)
+
+	recordIDs := func(wrappers []*types.RecordWrapper) []types.MetadataAddress {
+		var rv []types.MetadataAddress
+		for _, w := range wrappers {
+			s.Require().NotNil(w.Record, "record wrapper record")
+			rv = append(rv, w.Record.GetRecordAddress())
+		}
+		return rv
+	}
+
+	s.Run("empty process hash", func() {
+		_, err := s.queryClient.RecordsForProcess(ctx, &types.RecordsForProcessRequest{})
+		s.Require().ErrorContains(err, "process hash cannot be empty")
+	})
+
+	s.Run("all methods", func() {
+		res, err := s.queryClient.RecordsForProcess(ctx, &types.RecordsForProcessRequest{ProcessHash: "HASH"})
+		s.Require().NoError(err, "RecordsForProcess")
+		s.Assert().ElementsMatch(append(method1IDs, method2IDs...), recordIDs(res.Records), "records")
+	})
+
+	s.Run("one method", func() {
+		res, err := s.queryClient.RecordsForProcess(ctx, &types.RecordsForProcessRequest{ProcessHash: "HASH", Method: "method2"})
+		s.Require().NoError(err, "RecordsForProcess")
+		s.Assert().ElementsMatch(method2IDs, recordIDs(res.Records), "records")
+	})
+
+	s.Run("unknown method", func() {
+		res, err := s.queryClient.RecordsForProcess(ctx, &types.RecordsForProcessRequest{ProcessHash: "HASH", Method: "nope"})
+		s.Require().NoError(err, "RecordsForProcess")
+		s.Assert().Empty(res.Records, "records")
+	})
+
+	s.Run("paginated", func() {
+		res, err := s.queryClient.RecordsForProcess(ctx, &types.RecordsForProcessRequest{
+			ProcessHash: "HASH",
+			Pagination:  &query.PageRequest{Limit: 4, CountTotal: true},
+		})
+		s.Require().NoError(err, "RecordsForProcess")
+		s.Assert().Len(res.Records, 4, "records")
+		s.Require().NotNil(res.Pagination, "pagination")
+		s.Assert().Equal(6, int(res.Pagination.Total), "pagination total")
+	})
+}
+
 func (s *RecordKeeperTestSuite) TestValidateDeleteRecord() {
 	pt := func(addr string, role types.PartyType, opt bool) types.Party {
 		return types.Party{
@@ -666,6 +755,10 @@ func (s *RecordKeeperTestSuite) TestValidateWriteRecord() {
 		Audit:           session.Audit,
 	}
 	s.app.MetadataKeeper.SetSession(ctx, rollupSession2)
+	completedSession := *session
+	completedSession.SessionId = types.SessionMetadataAddress(scopeUUID, uuid.New())
+	completedSession.Status = types.SessionStatus_Completed
+	s.app.MetadataKeeper.SetSession(ctx, completedSession)
 
 	cases := map[string]struct {
 		existing         *types.Record
@@ -718,6 +811,21 @@ func (s *RecordKeeperTestSuite) TestValidateWriteRecord() {
 			partiesInvolved: ownerPartyList(s.user1),
 			errorMsg:        fmt.Sprintf("missing signature: %s", s.user1),
 		},
+		"session is completed": {
+			existing:        nil,
+			proposed:        types.NewRecord(s.recordName, completedSession.SessionId, *process, goodInputs, goodOutputs, s.recordSpecID),
+			signers:         []string{s.user1},
+			partiesInvolved: ownerPartyList(s.user1),
+			errorMsg:        fmt.Sprintf("cannot write record to session %s: status is SESSION_STATUS_COMPLETED", completedSession.SessionId),
+		},
+		"existing record in completed session": {
+			existing:         types.NewRecord(s.recordName, completedSession.SessionId, *process, goodInputs, goodOutputs, s.recordSpecID),
+			origOutputHashes: []string{goodOutputs[0].Hash},
+			proposed:         types.NewRecord(s.recordName, sessionID, *process, goodInputs, goodOutputs, s.recordSpecID),
+			signers:          []string{s.user1},
+			partiesInvolved:  ownerPartyList(s.user1),
+			errorMsg:         fmt.Sprintf("cannot move record out of session %s: status is SESSION_STATUS_COMPLETED", completedSession.SessionId),
+		},
 		"session not found": {
 			existing:        nil,
 			proposed:        types.NewRecord(s.recordName, randomInScopeSessionID, *process, []types.RecordInput{}, []types.RecordOutput{}, s.recordSpecID),
@@ -811,6 +919,32 @@ func (s *RecordKeeperTestSuite) TestValidateWriteRecord() {
 			errorMsg: fmt.Sprintf("input %s source record id %s not found",
 				goodInput.Name, missingRecordID),
 		},
+		"input source ibc proof connection does not exist": {
+			existing: nil,
+			proposed: types.NewRecord(
+				s.recordName, sessionID, *process,
+				[]types.RecordInput{
+					{
+						Name: goodInput.Name,
+						Source: &types.RecordInput_IbcProof{IbcProof: &types.IBCProof{
+							ClientId:     "07-tendermint-0",
+							ConnectionId: "connection-0",
+							ProofHeight:  clienttypes.NewHeight(1, 25),
+							KeyPath:      [][]byte{[]byte("metadata"), []byte("somekey")},
+							Value:        []byte("somevalue"),
+							Proof:        []byte("someproof"),
+						}},
+						TypeName: goodInput.TypeName,
+						Status:   types.RecordInputStatus_IBC,
+					},
+				},
+				goodOutputs,
+				s.recordSpecID),
+			signers:         []string{s.user1},
+			partiesInvolved: ownerPartyList(s.user1),
+			errorMsg: fmt.Sprintf("input %s source ibc proof could not be verified: connection %q not found",
+				goodInput.Name, "connection-0"),
+		},
 		"output count wrong - record - zero": {
 			existing: nil,
 			proposed: types.NewRecord(
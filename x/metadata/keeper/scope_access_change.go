@@ -0,0 +1,121 @@
+package keeper
+
+import (
+	"fmt"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/metadata/types"
+)
+
+// SetScopeAccessChange stores an entry in the index of recent scope access changes.
+func (k Keeper) SetScopeAccessChange(ctx sdk.Context, change types.ScopeAccessChange) error {
+	bz, err := k.cdc.Marshal(&change)
+	if err != nil {
+		return err
+	}
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetScopeAccessChangeKey(change.Sequence), bz)
+	return nil
+}
+
+// IterateScopeAccessChanges iterates over the index of recent scope access changes, oldest first.
+func (k Keeper) IterateScopeAccessChanges(ctx sdk.Context, handler func(change types.ScopeAccessChange) (stop bool)) error {
+	store := ctx.KVStore(k.storeKey)
+	it := storetypes.KVStorePrefixIterator(store, types.ScopeAccessChangeKeyPrefix)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		var change types.ScopeAccessChange
+		if err := k.cdc.Unmarshal(it.Value(), &change); err != nil {
+			return err
+		}
+		if handler(change) {
+			break
+		}
+	}
+	return nil
+}
+
+// getLastScopeAccessChangeSequence gets the sequence of the newest entry in the index of recent scope access changes,
+// or zero if it's empty.
+func (k Keeper) getLastScopeAccessChangeSequence(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	it := storetypes.KVStoreReversePrefixIterator(store, types.ScopeAccessChangeKeyPrefix)
+	defer it.Close()
+	if !it.Valid() {
+		return 0
+	}
+	return sdk.BigEndianToUint64(it.Key()[len(types.ScopeAccessChangeKeyPrefix):])
+}
+
+// addScopeAccessChange emits the event for a scope access change, then adds it to the index of recent changes,
+// removing the oldest entry once there are more than MaxScopeAccessChanges.
+func (k Keeper) addScopeAccessChange(ctx sdk.Context, change types.ScopeAccessChange) error {
+	change.Sequence = k.getLastScopeAccessChangeSequence(ctx) + 1
+	change.BlockHeight = ctx.BlockHeight()
+	change.BlockTime = ctx.BlockTime()
+
+	switch change.ChangeType {
+	case types.ScopeAccessChangeType_DataAccess:
+		k.EmitEvent(ctx, types.NewEventScopeDataAccessChanged(change))
+	case types.ScopeAccessChangeType_ValueOwner:
+		k.EmitEvent(ctx, types.NewEventScopeValueOwnerChanged(change))
+	}
+
+	if err := k.SetScopeAccessChange(ctx, change); err != nil {
+		return fmt.Errorf("could not record access change of scope %s: %w", change.ScopeId, err)
+	}
+
+	// Entries are only ever removed oldest first, so the sequences in the index are contiguous
+	// and there's at most one entry that's now too old.
+	if change.Sequence > types.MaxScopeAccessChanges {
+		store := ctx.KVStore(k.storeKey)
+		store.Delete(types.GetScopeAccessChangeKey(change.Sequence - types.MaxScopeAccessChanges))
+	}
+	return nil
+}
+
+// recordScopeDataAccessChange emits an event and adds an entry to the index of recent scope access changes
+// if the data access list of a scope is different between before and after.
+func (k Keeper) recordScopeDataAccessChange(ctx sdk.Context, scopeID types.MetadataAddress, before, after []string, signers []string) error {
+	added, removed := types.GetDataAccessChanges(before, after)
+	if len(added) == 0 && len(removed) == 0 {
+		return nil
+	}
+	return k.addScopeAccessChange(ctx, types.NewScopeDataAccessChange(scopeID, added, removed, signers))
+}
+
+// recordScopeValueOwnerChange emits an event and adds an entry to the index of recent scope access changes
+// if the value owner of a scope is different between before and after.
+func (k Keeper) recordScopeValueOwnerChange(ctx sdk.Context, scopeID types.MetadataAddress, before, after string, signers []string) error {
+	if before == after {
+		return nil
+	}
+	return k.addScopeAccessChange(ctx, types.NewScopeValueOwnerChange(scopeID, before, after, signers))
+}
+
+// recordScopeValueOwnerChanges records a value owner change for each of the scopes in the links that
+// had a value owner other than the new one.
+func (k Keeper) recordScopeValueOwnerChanges(ctx sdk.Context, links types.AccMDLinks, newValueOwner string, signers []string) error {
+	for _, link := range links {
+		var before string
+		if len(link.AccAddr) > 0 {
+			before = link.AccAddr.String()
+		}
+		if err := k.recordScopeValueOwnerChange(ctx, link.MDAddr, before, newValueOwner, signers); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// getScopeValueOwnerStr gets the bech32 address string of a scope's value owner, or an empty string if it doesn't have one.
+func (k Keeper) getScopeValueOwnerStr(ctx sdk.Context, scopeID types.MetadataAddress) (string, error) {
+	addr, err := k.GetScopeValueOwner(ctx, scopeID)
+	if err != nil || len(addr) == 0 {
+		return "", err
+	}
+	return addr.String(), nil
+}
@@ -0,0 +1,226 @@
+package keeper_test
+
+import (
+	"github.com/google/uuid"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/provenance-io/provenance/x/metadata/types"
+)
+
+func (s *MsgServerTestSuite) TestScopeAccessChanges() {
+	scopeSpecID := types.ScopeSpecMetadataAddress(uuid.New())
+	scopeSpec := types.NewScopeSpecification(scopeSpecID, nil, []string{s.user1}, []types.PartyType{types.PartyType_PARTY_TYPE_OWNER}, nil)
+	_, err := s.msgServer.WriteScopeSpecification(s.ctx, types.NewMsgWriteScopeSpecificationRequest(*scopeSpec, []string{s.user1}))
+	s.Require().NoError(err, "WriteScopeSpecification")
+
+	scopeID1 := types.ScopeMetadataAddress(uuid.New())
+	scopeUUID2 := uuid.New()
+	scopeID2 := types.ScopeMetadataAddress(scopeUUID2)
+	dataAccess1 := sdk.AccAddress("dataAccess1_________").String()
+	dataAccess2 := sdk.AccAddress("dataAccess2_________").String()
+	user1Only := []string{s.user1}
+	bothUsers := []string{s.user1, s.user2}
+
+	newChange := func(seq uint64, change types.ScopeAccessChange) types.ScopeAccessChange {
+		change.Sequence = seq
+		change.BlockHeight = s.ctx.BlockHeight()
+		change.BlockTime = s.ctx.BlockTime()
+		return change
+	}
+	expChanges := []types.ScopeAccessChange{
+		newChange(1, types.NewScopeDataAccessChange(scopeID1, []string{s.user1, dataAccess1}, nil, user1Only)),
+		newChange(2, types.NewScopeValueOwnerChange(scopeID1, "", s.user1, user1Only)),
+		newChange(3, types.NewScopeDataAccessChange(scopeID1, []string{dataAccess2}, nil, user1Only)),
+		newChange(4, types.NewScopeDataAccessChange(scopeID1, nil, []string{dataAccess1}, user1Only)),
+		newChange(5, types.NewScopeValueOwnerChange(scopeID2, "", s.user1, user1Only)),
+		newChange(6, types.NewScopeValueOwnerChange(scopeID1, s.user1, s.user2, user1Only)),
+		newChange(7, types.NewScopeValueOwnerChange(scopeID2, s.user1, s.user2, user1Only)),
+		newChange(8, types.NewScopeValueOwnerChange(scopeID2, s.user2, "", bothUsers)),
+	}
+
+	steps := []struct {
+		name       string
+		run        func(ctx sdk.Context) error
+		expChanges []types.ScopeAccessChange
+	}{
+		{
+			name: "write new scope with data access and value owner",
+			run: func(ctx sdk.Context) error {
+				scope := types.NewScope(scopeID1, scopeSpecID, ownerPartyList(s.user1), []string{s.user1, dataAccess1}, s.user1, false)
+				_, err := s.msgServer.WriteScope(ctx, types.NewMsgWriteScopeRequest(*scope, user1Only, 0))
+				return err
+			},
+			expChanges: expChanges[0:2],
+		},
+		{
+			name: "add data access",
+			run: func(ctx sdk.Context) error {
+				_, err := s.msgServer.AddScopeDataAccess(ctx, types.NewMsgAddScopeDataAccessRequest(scopeID1, []string{dataAccess2}, user1Only))
+				return err
+			},
+			expChanges: expChanges[2:3],
+		},
+		{
+			name: "delete data access",
+			run: func(ctx sdk.Context) error {
+				_, err := s.msgServer.DeleteScopeDataAccess(ctx, types.NewMsgDeleteScopeDataAccessRequest(scopeID1, []string{dataAccess1}, user1Only))
+				return err
+			},
+			expChanges: expChanges[3:4],
+		},
+		{
+			name: "write new scope with only a value owner",
+			run: func(ctx sdk.Context) error {
+				scope := types.NewScope(scopeID2, scopeSpecID, ownerPartyList(s.user1), nil, s.user1, false)
+				_, err := s.msgServer.WriteScope(ctx, types.NewMsgWriteScopeRequest(*scope, user1Only, 0))
+				return err
+			},
+			expChanges: expChanges[4:5],
+		},
+		{
+			name: "rewrite scope without changes",
+			run: func(ctx sdk.Context) error {
+				scope := types.NewScope(scopeID2, scopeSpecID, ownerPartyList(s.user1), nil, s.user1, false)
+				_, err := s.msgServer.WriteScope(ctx, types.NewMsgWriteScopeRequest(*scope, user1Only, 0))
+				return err
+			},
+		},
+		{
+			name: "update value owners",
+			run: func(ctx sdk.Context) error {
+				msg := types.NewMsgUpdateValueOwnersRequest([]types.MetadataAddress{scopeID1, scopeID2}, s.user2Addr, user1Only)
+				_, err := s.msgServer.UpdateValueOwners(ctx, msg)
+				return err
+			},
+			expChanges: expChanges[5:7],
+		},
+		{
+			name: "delete scope",
+			run: func(ctx sdk.Context) error {
+				_, err := s.msgServer.DeleteScope(ctx, types.NewMsgDeleteScopeRequest(scopeID2, bothUsers))
+				return err
+			},
+			expChanges: expChanges[7:8],
+		},
+	}
+
+	for _, step := range steps {
+		s.Run(step.name, func() {
+			em := sdk.NewEventManager()
+			err := step.run(s.ctx.WithEventManager(em))
+			s.Require().NoError(err, step.name)
+
+			var expEvents sdk.Events
+			for _, change := range step.expChanges {
+				var event proto.Message = types.NewEventScopeDataAccessChanged(change)
+				if change.ChangeType == types.ScopeAccessChangeType_ValueOwner {
+					event = types.NewEventScopeValueOwnerChanged(change)
+				}
+				expEvents = append(expEvents, s.untypeEvent(event))
+			}
+			var actEvents sdk.Events
+			for _, event := range em.Events() {
+				if event.Type == "provenance.metadata.v1.EventScopeDataAccessChanged" || event.Type == "provenance.metadata.v1.EventScopeValueOwnerChanged" {
+					actEvents = append(actEvents, event)
+				}
+			}
+			s.AssertEqualEvents(expEvents, actEvents, "access change events emitted during %s", step.name)
+		})
+	}
+
+	tests := []struct {
+		name       string
+		req        *types.ScopeAccessChangesRequest
+		expErr     string
+		expChanges []types.ScopeAccessChange
+		expTotal   uint64
+	}{
+		{
+			name:       "all changes",
+			req:        &types.ScopeAccessChangesRequest{},
+			expChanges: expChanges,
+		},
+		{
+			name:       "by scope address",
+			req:        &types.ScopeAccessChangesRequest{ScopeId: scopeID2.String()},
+			expChanges: []types.ScopeAccessChange{expChanges[4], expChanges[6], expChanges[7]},
+		},
+		{
+			name:       "by scope uuid",
+			req:        &types.ScopeAccessChangesRequest{ScopeId: scopeUUID2.String()},
+			expChanges: []types.ScopeAccessChange{expChanges[4], expChanges[6], expChanges[7]},
+		},
+		{
+			name:       "scope without changes",
+			req:        &types.ScopeAccessChangesRequest{ScopeId: types.ScopeMetadataAddress(uuid.New()).String()},
+			expChanges: nil,
+		},
+		{
+			name:       "with pagination",
+			req:        &types.ScopeAccessChangesRequest{ScopeId: scopeID1.String(), Pagination: &query.PageRequest{Limit: 2, CountTotal: true}},
+			expChanges: expChanges[0:2],
+			expTotal:   5,
+		},
+		{
+			name:   "invalid scope id",
+			req:    &types.ScopeAccessChangesRequest{ScopeId: scopeSpecID.String()},
+			expErr: "address [" + scopeSpecID.String() + "] is not a scope address: invalid request",
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			resp, err := s.app.MetadataKeeper.ScopeAccessChanges(s.ctx, tc.req)
+			s.AssertErrorValue(err, tc.expErr, "ScopeAccessChanges error")
+			if len(tc.expErr) > 0 {
+				return
+			}
+			s.Require().NotNil(resp, "ScopeAccessChanges response")
+			s.Assert().Equal(tc.expChanges, resp.Changes, "ScopeAccessChanges changes")
+			if tc.expTotal > 0 {
+				s.Assert().Equal(tc.expTotal, resp.Pagination.Total, "ScopeAccessChanges pagination total")
+			}
+		})
+	}
+}
+
+func (s *MsgServerTestSuite) TestScopeAccessChangesPruned() {
+	scopeID := types.ScopeMetadataAddress(uuid.New())
+	oldest := types.NewScopeDataAccessChange(scopeID, []string{s.user1}, nil, []string{s.user1})
+	oldest.Sequence = 1
+	newest := types.NewScopeValueOwnerChange(scopeID, "", s.user1, []string{s.user1})
+	newest.Sequence = types.MaxScopeAccessChanges
+	s.Require().NoError(s.app.MetadataKeeper.SetScopeAccessChange(s.ctx, oldest), "SetScopeAccessChange(oldest)")
+	s.Require().NoError(s.app.MetadataKeeper.SetScopeAccessChange(s.ctx, newest), "SetScopeAccessChange(newest)")
+
+	scope := types.Scope{
+		ScopeId:         scopeID,
+		SpecificationId: types.ScopeSpecMetadataAddress(uuid.New()),
+		Owners:          ownerPartyList(s.user1),
+		DataAccess:      []string{s.user1},
+	}
+	s.Require().NoError(s.app.MetadataKeeper.SetScope(s.ctx, scope), "SetScope")
+	_, err := s.msgServer.AddScopeDataAccess(s.ctx, types.NewMsgAddScopeDataAccessRequest(scopeID, []string{s.user2}, []string{s.user1}))
+	s.Require().NoError(err, "AddScopeDataAccess")
+
+	added := types.NewScopeDataAccessChange(scopeID, []string{s.user2}, nil, []string{s.user1})
+	added.Sequence = types.MaxScopeAccessChanges + 1
+	added.BlockHeight = s.ctx.BlockHeight()
+	added.BlockTime = s.ctx.BlockTime()
+	expChanges := []types.ScopeAccessChange{newest, added}
+
+	var actChanges []types.ScopeAccessChange
+	err = s.app.MetadataKeeper.IterateScopeAccessChanges(s.ctx, func(change types.ScopeAccessChange) bool {
+		actChanges = append(actChanges, change)
+		return false
+	})
+	s.Require().NoError(err, "IterateScopeAccessChanges")
+	s.Assert().Equal(expChanges, actChanges, "scope access changes after adding one more than the max")
+
+	genState := s.app.MetadataKeeper.ExportGenesis(s.ctx)
+	s.Assert().Equal(expChanges, genState.ScopeAccessChanges, "exported scope access changes")
+	s.Assert().NoError(genState.Validate(), "exported genesis state Validate()")
+}
@@ -0,0 +1,95 @@
+package keeper
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/metadata/types"
+)
+
+// SubstituteScopeParty replaces the existing party address with the replacement address in all of a scope's open
+// sessions, keeping each party's role. The signers of the msg must be able to update the scope's owners.
+// The ids of the updated sessions are returned.
+func (k Keeper) SubstituteScopeParty(
+	ctx sdk.Context,
+	scopeID types.MetadataAddress,
+	existing, replacement string,
+	msg types.MetadataMsg,
+) ([]types.MetadataAddress, error) {
+	scope, found := k.GetScope(ctx, scopeID)
+	if !found {
+		return nil, fmt.Errorf("scope not found with id %s", scopeID)
+	}
+
+	// Make sure everyone has signed.
+	if !scope.RequirePartyRollup {
+		// Old:
+		//   - All existing owners must sign.
+		if err := k.ValidateSignersWithoutParties(ctx, scope.GetAllOwnerAddresses(), msg); err != nil {
+			return nil, err
+		}
+	} else {
+		// New:
+		//   - All required=false existing owners must be signers.
+		//   - All roles required by the scope spec must have a signer and associated party from the existing scope.
+		scopeSpec, found := k.GetScopeSpecification(ctx, scope.SpecificationId)
+		if !found {
+			return nil, fmt.Errorf("scope specification %s not found", scope.SpecificationId)
+		}
+		if err := k.ValidateSignersWithParties(ctx, scope.Owners, scope.Owners, scopeSpec.PartiesInvolved, msg); err != nil {
+			return nil, err
+		}
+	}
+
+	var sessions []types.Session
+	var validationErr error
+	err := k.IterateSessions(ctx, scopeID, func(session types.Session) bool {
+		if !session.IsOpen() {
+			return false
+		}
+		parties, changed := types.SubstituteParty(session.Parties, existing, replacement)
+		if !changed {
+			return false
+		}
+		if validationErr = k.validateSubstitutedParties(ctx, scope, parties); validationErr != nil {
+			validationErr = fmt.Errorf("invalid parties for session %s: %w", session.SessionId, validationErr)
+			return true
+		}
+		session.Parties = parties
+		sessions = append(sessions, session)
+		return false
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not iterate sessions of scope %s: %w", scopeID, err)
+	}
+	if validationErr != nil {
+		return nil, validationErr
+	}
+	if len(sessions) == 0 {
+		return nil, fmt.Errorf("no open sessions of scope %s have party %s", scopeID, existing)
+	}
+
+	signers := msg.GetSignerStrs()
+	sessionIDs := make([]types.MetadataAddress, len(sessions))
+	for i, session := range sessions {
+		session.Audit = session.Audit.UpdateAudit(ctx.BlockTime(), strings.Join(signers, ", "), "")
+		k.SetSession(ctx, session)
+		sessionIDs[i] = session.SessionId
+	}
+
+	k.EmitEvent(ctx, types.NewEventScopePartySubstituted(scopeID, existing, replacement, sessionIDs, signers))
+	return sessionIDs, nil
+}
+
+// validateSubstitutedParties makes sure a session's parties are still valid for the scope after a substitution.
+// The roles do not change, so the contract spec's required roles are still present.
+func (k Keeper) validateSubstitutedParties(ctx sdk.Context, scope types.Scope, parties []types.Party) error {
+	if scope.RequirePartyRollup {
+		if err := validatePartiesArePresent(parties, scope.Owners); err != nil {
+			return fmt.Errorf("not all session parties in scope owners: %w", err)
+		}
+	}
+	return k.validateProvenanceRole(ctx, types.BuildPartyDetails(nil, parties))
+}
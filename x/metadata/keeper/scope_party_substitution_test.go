@@ -0,0 +1,82 @@
+package keeper_test
+
+import (
+	"github.com/google/uuid"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/metadata/types"
+)
+
+func (s *MsgServerTestSuite) TestSubstituteScopeParty() {
+	scopeUUID := uuid.New()
+	scopeID := types.ScopeMetadataAddress(scopeUUID)
+	scope := types.NewScope(scopeID, types.ScopeSpecMetadataAddress(uuid.New()), ownerPartyList(s.user1), nil, s.user1, false)
+	defer WriteTempScope(s.T(), s.app.MetadataKeeper, s.ctx, *scope)()
+
+	servicer := func(addr string) types.Party {
+		return types.Party{Address: addr, Role: types.PartyType_PARTY_TYPE_SERVICER}
+	}
+	newServicer := sdk.AccAddress("new_servicer________").String()
+	stranger := sdk.AccAddress("stranger____________").String()
+	cSpecID := types.ContractSpecMetadataAddress(uuid.New())
+	newSession := func(name string, status types.SessionStatus, parties ...types.Party) types.Session {
+		session := types.Session{
+			SessionId:       types.SessionMetadataAddress(scopeUUID, uuid.New()),
+			SpecificationId: cSpecID,
+			Parties:         parties,
+			Name:            name,
+			Status:          status,
+		}
+		s.app.MetadataKeeper.SetSession(s.ctx, session)
+		return session
+	}
+	openSession := newSession("open", types.SessionStatus_Open, servicer(s.user2), ownerPartyList(s.user1)[0])
+	completedSession := newSession("completed", types.SessionStatus_Completed, servicer(s.user2))
+	otherSession := newSession("other", types.SessionStatus_Unspecified, ownerPartyList(s.user1)...)
+	for _, session := range []types.Session{openSession, completedSession, otherSession} {
+		defer s.app.MetadataKeeper.RemoveSession(s.ctx, session.SessionId)
+	}
+
+	s.Run("unknown scope", func() {
+		unknownID := types.ScopeMetadataAddress(uuid.New())
+		msg := types.NewMsgSubstituteScopePartyRequest(unknownID, s.user2, newServicer, []string{s.user1})
+		_, err := s.msgServer.SubstituteScopeParty(s.ctx, msg)
+		s.Assert().EqualError(err, "scope not found with id "+unknownID.String()+": invalid request")
+	})
+
+	s.Run("not signed by the scope owner", func() {
+		msg := types.NewMsgSubstituteScopePartyRequest(scopeID, s.user2, newServicer, []string{s.user2})
+		_, err := s.msgServer.SubstituteScopeParty(s.ctx, msg)
+		s.Assert().ErrorContains(err, "missing signature: "+s.user1)
+	})
+
+	s.Run("party not in any open session", func() {
+		msg := types.NewMsgSubstituteScopePartyRequest(scopeID, stranger, newServicer, []string{s.user1})
+		_, err := s.msgServer.SubstituteScopeParty(s.ctx, msg)
+		s.Assert().EqualError(err, "no open sessions of scope "+scopeID.String()+" have party "+stranger+": invalid request")
+	})
+
+	s.Run("party substituted", func() {
+		em := sdk.NewEventManager()
+		ctx := s.ctx.WithEventManager(em)
+		msg := types.NewMsgSubstituteScopePartyRequest(scopeID, s.user2, newServicer, []string{s.user1})
+		resp, err := s.msgServer.SubstituteScopeParty(ctx, msg)
+		s.Require().NoError(err, "SubstituteScopeParty")
+		s.Assert().Equal([]types.MetadataAddress{openSession.SessionId}, resp.SessionIds, "session ids")
+
+		session, found := s.app.MetadataKeeper.GetSession(s.ctx, openSession.SessionId)
+		s.Require().True(found, "GetSession open")
+		s.Assert().Equal([]types.Party{servicer(newServicer), ownerPartyList(s.user1)[0]}, session.Parties, "open session parties")
+		if s.Assert().NotNil(session.Audit, "open session audit") {
+			s.Assert().Equal(s.user1, session.Audit.UpdatedBy, "open session updated by")
+		}
+
+		session, found = s.app.MetadataKeeper.GetSession(s.ctx, completedSession.SessionId)
+		s.Require().True(found, "GetSession completed")
+		s.Assert().Equal(completedSession.Parties, session.Parties, "completed session parties")
+
+		expEvent := types.NewEventScopePartySubstituted(scopeID, s.user2, newServicer, []types.MetadataAddress{openSession.SessionId}, []string{s.user1})
+		s.Assert().Contains(em.Events(), s.untypeEvent(expEvent), "events")
+	})
+}
@@ -0,0 +1,233 @@
+package keeper
+
+import (
+	"fmt"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/hold"
+	"github.com/provenance-io/provenance/x/metadata/types"
+)
+
+// GetScopeSettlement returns the pending sale of a scope's value ownership, or nil if there isn't one.
+func (k Keeper) GetScopeSettlement(ctx sdk.Context, scopeID types.MetadataAddress) (*types.ScopeSettlement, error) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetScopeSettlementKey(scopeID))
+	if len(bz) == 0 {
+		return nil, nil
+	}
+	var settlement types.ScopeSettlement
+	if err := k.cdc.Unmarshal(bz, &settlement); err != nil {
+		return nil, fmt.Errorf("could not read settlement of scope %s: %w", scopeID, err)
+	}
+	return &settlement, nil
+}
+
+// SetScopeSettlement stores a scope settlement.
+// It does not place or release any holds; use OpenScopeSettlement to start a sale.
+func (k Keeper) SetScopeSettlement(ctx sdk.Context, settlement types.ScopeSettlement) error {
+	bz, err := k.cdc.Marshal(&settlement)
+	if err != nil {
+		return err
+	}
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetScopeSettlementKey(settlement.ScopeId), bz)
+	return nil
+}
+
+// removeScopeSettlement deletes the pending sale of a scope's value ownership.
+func (k Keeper) removeScopeSettlement(ctx sdk.Context, scopeID types.MetadataAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetScopeSettlementKey(scopeID))
+}
+
+// IterateScopeSettlements iterates over all pending scope settlements.
+func (k Keeper) IterateScopeSettlements(ctx sdk.Context, handler func(settlement types.ScopeSettlement) (stop bool)) error {
+	store := ctx.KVStore(k.storeKey)
+	it := storetypes.KVStorePrefixIterator(store, types.ScopeSettlementKeyPrefix)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		var settlement types.ScopeSettlement
+		if err := k.cdc.Unmarshal(it.Value(), &settlement); err != nil {
+			return err
+		}
+		if handler(settlement) {
+			break
+		}
+	}
+	return nil
+}
+
+// scopeSettlementHoldReason returns the reason used for the holds placed for a scope settlement.
+func scopeSettlementHoldReason(scopeID types.MetadataAddress) string {
+	return fmt.Sprintf("x/metadata: settlement of %s", scopeID)
+}
+
+// GetHoldDetails gets the details of the holds placed on an account's funds for the scope settlements it's in.
+// The seller's scope is held until the settlement is settled or cancelled. The buyer's price is held once funded.
+// It satisfies the hold.HoldDetailsGetter type.
+func (k Keeper) GetHoldDetails(ctx sdk.Context, addr sdk.AccAddress) ([]hold.HoldDetail, error) {
+	var rv []hold.HoldDetail
+	addrStr := addr.String()
+	releaseConditions := "released when the settlement is settled or cancelled by the buyer or seller"
+	err := k.IterateScopeSettlements(ctx, func(settlement types.ScopeSettlement) bool {
+		reference := fmt.Sprintf("settlement of %s", settlement.ScopeId)
+		if settlement.Seller == addrStr {
+			rv = append(rv, hold.NewHoldDetail(types.HoldReasonCodeScopeSettlement, types.ModuleName,
+				settlement.Buyer, reference, sdk.Coins{settlement.ScopeId.Coin()}, releaseConditions))
+		}
+		if settlement.Buyer == addrStr && settlement.Funded {
+			rv = append(rv, hold.NewHoldDetail(types.HoldReasonCodeScopeSettlement, types.ModuleName,
+				settlement.Seller, reference, settlement.Price, releaseConditions))
+		}
+		return false
+	})
+	if err != nil {
+		return rv, fmt.Errorf("could not iterate scope settlements: %w", err)
+	}
+	return rv, nil
+}
+
+// OpenScopeSettlement starts a sale of a scope's value ownership by putting the scope's value owner coin
+// on hold in the seller's account. The seller must be the scope's current value owner.
+func (k Keeper) OpenScopeSettlement(ctx sdk.Context, settlement types.ScopeSettlement) error {
+	settlement.Funded = false
+	if err := settlement.Validate(); err != nil {
+		return err
+	}
+	if _, found := k.GetScope(ctx, settlement.ScopeId); !found {
+		return fmt.Errorf("scope not found with id %s", settlement.ScopeId)
+	}
+	existing, err := k.GetScopeSettlement(ctx, settlement.ScopeId)
+	if err != nil {
+		return err
+	}
+	if existing != nil {
+		return fmt.Errorf("scope %s already has a pending settlement", settlement.ScopeId)
+	}
+
+	valueOwner, err := k.GetScopeValueOwner(ctx, settlement.ScopeId)
+	if err != nil {
+		return fmt.Errorf("could not get value owner of scope %s: %w", settlement.ScopeId, err)
+	}
+	if valueOwner.String() != settlement.Seller {
+		return fmt.Errorf("seller %s is not the value owner of scope %s", settlement.Seller, settlement.ScopeId)
+	}
+
+	err = k.holdKeeper.AddHold(ctx, valueOwner, sdk.Coins{settlement.ScopeId.Coin()}, scopeSettlementHoldReason(settlement.ScopeId))
+	if err != nil {
+		return fmt.Errorf("could not place hold on scope %s: %w", settlement.ScopeId, err)
+	}
+	if err = k.SetScopeSettlement(ctx, settlement); err != nil {
+		return err
+	}
+
+	k.EmitEvent(ctx, types.NewEventScopeSettlementOpened(settlement))
+	return nil
+}
+
+// FundScopeSettlement puts the price of a scope settlement on hold in the buyer's account.
+func (k Keeper) FundScopeSettlement(ctx sdk.Context, scopeID types.MetadataAddress, buyer string) error {
+	settlement, err := k.getScopeSettlementForParty(ctx, scopeID, buyer)
+	if err != nil {
+		return err
+	}
+	if settlement.Buyer != buyer {
+		return fmt.Errorf("%s is not the buyer in the settlement of scope %s", buyer, scopeID)
+	}
+	if settlement.Funded {
+		return fmt.Errorf("settlement of scope %s has already been funded", scopeID)
+	}
+
+	buyerAddr := sdk.MustAccAddressFromBech32(settlement.Buyer)
+	if err = k.holdKeeper.AddHold(ctx, buyerAddr, settlement.Price, scopeSettlementHoldReason(scopeID)); err != nil {
+		return fmt.Errorf("could not place hold on price %s: %w", settlement.Price, err)
+	}
+	settlement.Funded = true
+	if err = k.SetScopeSettlement(ctx, *settlement); err != nil {
+		return err
+	}
+
+	k.EmitEvent(ctx, types.NewEventScopeSettlementFunded(*settlement))
+	return nil
+}
+
+// SettleScopeSettlement releases the holds of a funded scope settlement, sends the price from the buyer to the
+// seller, and makes the buyer the scope's value owner. Either the seller or buyer can settle it.
+func (k Keeper) SettleScopeSettlement(ctx sdk.Context, scopeID types.MetadataAddress, signer string) error {
+	settlement, err := k.getScopeSettlementForParty(ctx, scopeID, signer)
+	if err != nil {
+		return err
+	}
+	if !settlement.Funded {
+		return fmt.Errorf("settlement of scope %s has not yet been funded by the buyer", scopeID)
+	}
+
+	if err = k.releaseScopeSettlementHolds(ctx, *settlement); err != nil {
+		return err
+	}
+
+	sellerAddr := sdk.MustAccAddressFromBech32(settlement.Seller)
+	buyerAddr := sdk.MustAccAddressFromBech32(settlement.Buyer)
+	if err = k.bankKeeper.SendCoins(ctx, buyerAddr, sellerAddr, settlement.Price); err != nil {
+		return fmt.Errorf("could not pay %s from %s to %s: %w", settlement.Price, settlement.Buyer, settlement.Seller, err)
+	}
+	if err = k.SetScopeValueOwner(ctx, scopeID, settlement.Buyer); err != nil {
+		return err
+	}
+	if err = k.recordScopeValueOwnerChange(ctx, scopeID, settlement.Seller, settlement.Buyer, []string{signer}); err != nil {
+		return err
+	}
+	k.removeScopeSettlement(ctx, scopeID)
+
+	k.EmitEvent(ctx, types.NewEventScopeSettlementSettled(*settlement))
+	return nil
+}
+
+// CancelScopeSettlement releases the holds of a scope settlement and deletes it without doing the sale.
+// Either the seller or buyer can cancel it.
+func (k Keeper) CancelScopeSettlement(ctx sdk.Context, scopeID types.MetadataAddress, signer string) error {
+	settlement, err := k.getScopeSettlementForParty(ctx, scopeID, signer)
+	if err != nil {
+		return err
+	}
+	if err = k.releaseScopeSettlementHolds(ctx, *settlement); err != nil {
+		return err
+	}
+	k.removeScopeSettlement(ctx, scopeID)
+
+	k.EmitEvent(ctx, types.NewEventScopeSettlementCancelled(*settlement, signer))
+	return nil
+}
+
+// getScopeSettlementForParty gets the pending settlement of a scope, and makes sure the addr is either its seller or buyer.
+func (k Keeper) getScopeSettlementForParty(ctx sdk.Context, scopeID types.MetadataAddress, addr string) (*types.ScopeSettlement, error) {
+	settlement, err := k.GetScopeSettlement(ctx, scopeID)
+	if err != nil {
+		return nil, err
+	}
+	if settlement == nil {
+		return nil, fmt.Errorf("scope %s does not have a pending settlement", scopeID)
+	}
+	if addr != settlement.Seller && addr != settlement.Buyer {
+		return nil, fmt.Errorf("%s is neither the seller nor the buyer in the settlement of scope %s", addr, scopeID)
+	}
+	return settlement, nil
+}
+
+// releaseScopeSettlementHolds releases the hold on the scope and, if funded, the hold on the price.
+func (k Keeper) releaseScopeSettlementHolds(ctx sdk.Context, settlement types.ScopeSettlement) error {
+	sellerAddr := sdk.MustAccAddressFromBech32(settlement.Seller)
+	if err := k.holdKeeper.ReleaseHold(ctx, sellerAddr, sdk.Coins{settlement.ScopeId.Coin()}); err != nil {
+		return fmt.Errorf("could not release hold on scope %s: %w", settlement.ScopeId, err)
+	}
+	if settlement.Funded {
+		buyerAddr := sdk.MustAccAddressFromBech32(settlement.Buyer)
+		if err := k.holdKeeper.ReleaseHold(ctx, buyerAddr, settlement.Price); err != nil {
+			return fmt.Errorf("could not release hold on price %s: %w", settlement.Price, err)
+		}
+	}
+	return nil
+}
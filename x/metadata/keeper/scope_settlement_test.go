@@ -0,0 +1,134 @@
+package keeper_test
+
+import (
+	"github.com/google/uuid"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
+
+	"github.com/provenance-io/provenance/x/metadata/types"
+)
+
+func (s *MsgServerTestSuite) TestScopeSettlement() {
+	scopeSpecID := types.ScopeSpecMetadataAddress(uuid.New())
+	scopeSpec := types.NewScopeSpecification(scopeSpecID, nil, []string{s.user1}, []types.PartyType{types.PartyType_PARTY_TYPE_OWNER}, nil)
+	_, err := s.msgServer.WriteScopeSpecification(s.ctx, types.NewMsgWriteScopeSpecificationRequest(*scopeSpec, []string{s.user1}))
+	s.Require().NoError(err, "WriteScopeSpecification")
+
+	scopeID := types.ScopeMetadataAddress(uuid.New())
+	scope := types.NewScope(scopeID, scopeSpecID, ownerPartyList(s.user1), nil, s.user1, false)
+	_, err = s.msgServer.WriteScope(s.ctx, types.NewMsgWriteScopeRequest(*scope, []string{s.user1}, 0))
+	s.Require().NoError(err, "WriteScope")
+
+	price := sdk.NewCoins(sdk.NewInt64Coin("settlecoin", 100))
+	s.Require().NoError(testutil.FundAccount(s.ctx, s.app.BankKeeper, s.user2Addr, price), "FundAccount(user2)")
+	stranger := sdk.AccAddress("stranger____________").String()
+	scopeCoins := sdk.Coins{scopeID.Coin()}
+
+	assertHolds := func(addr sdk.AccAddress, expected sdk.Coins, name string) {
+		actual, err := s.app.HoldKeeper.GetHoldCoins(s.ctx, addr)
+		if s.Assert().NoError(err, "GetHoldCoins(%s)", name) {
+			s.Assert().Equal(expected.String(), actual.String(), "holds of %s", name)
+		}
+	}
+	assertValueOwner := func(expected string) {
+		actual, err := s.app.MetadataKeeper.GetScopeValueOwner(s.ctx, scopeID)
+		if s.Assert().NoError(err, "GetScopeValueOwner") {
+			s.Assert().Equal(expected, actual.String(), "scope value owner")
+		}
+	}
+
+	s.Run("fund without a settlement", func() {
+		_, err := s.msgServer.FundScopeSettlement(s.ctx, types.NewMsgFundScopeSettlementRequest(scopeID, s.user2))
+		s.Assert().EqualError(err, "scope "+scopeID.String()+" does not have a pending settlement: invalid request")
+	})
+
+	s.Run("open by someone other than the value owner", func() {
+		_, err := s.msgServer.OpenScopeSettlement(s.ctx, types.NewMsgOpenScopeSettlementRequest(scopeID, s.user2, s.user1, price))
+		s.Assert().EqualError(err, "seller "+s.user2+" is not the value owner of scope "+scopeID.String()+": invalid request")
+	})
+
+	s.Run("open unknown scope", func() {
+		unknownID := types.ScopeMetadataAddress(uuid.New())
+		_, err := s.msgServer.OpenScopeSettlement(s.ctx, types.NewMsgOpenScopeSettlementRequest(unknownID, s.user1, s.user2, price))
+		s.Assert().EqualError(err, "scope not found with id "+unknownID.String()+": invalid request")
+	})
+
+	s.Run("open", func() {
+		_, err := s.msgServer.OpenScopeSettlement(s.ctx, types.NewMsgOpenScopeSettlementRequest(scopeID, s.user1, s.user2, price))
+		s.Require().NoError(err, "OpenScopeSettlement")
+		assertHolds(s.user1Addr, scopeCoins, "seller")
+		assertHolds(s.user2Addr, nil, "buyer")
+
+		resp, err := s.app.MetadataKeeper.ScopeSettlement(s.ctx, &types.ScopeSettlementRequest{ScopeId: scopeID.String()})
+		s.Require().NoError(err, "ScopeSettlement query")
+		s.Assert().Equal(types.NewScopeSettlement(scopeID, s.user1, s.user2, price), resp.Settlement, "settlement")
+	})
+
+	s.Run("open again", func() {
+		_, err := s.msgServer.OpenScopeSettlement(s.ctx, types.NewMsgOpenScopeSettlementRequest(scopeID, s.user1, stranger, price))
+		s.Assert().EqualError(err, "scope "+scopeID.String()+" already has a pending settlement: invalid request")
+	})
+
+	s.Run("value owner cannot be changed while held", func() {
+		_, err := s.msgServer.UpdateValueOwners(s.ctx, types.NewMsgUpdateValueOwnersRequest([]types.MetadataAddress{scopeID}, sdk.MustAccAddressFromBech32(stranger), []string{s.user1}))
+		s.Assert().ErrorContains(err, "could not send scope coin", "UpdateValueOwners error")
+		assertValueOwner(s.user1)
+	})
+
+	s.Run("settle before funded", func() {
+		_, err := s.msgServer.SettleScopeSettlement(s.ctx, types.NewMsgSettleScopeSettlementRequest(scopeID, s.user1))
+		s.Assert().EqualError(err, "settlement of scope "+scopeID.String()+" has not yet been funded by the buyer: invalid request")
+	})
+
+	s.Run("fund by the seller", func() {
+		_, err := s.msgServer.FundScopeSettlement(s.ctx, types.NewMsgFundScopeSettlementRequest(scopeID, s.user1))
+		s.Assert().EqualError(err, s.user1+" is not the buyer in the settlement of scope "+scopeID.String()+": invalid request")
+	})
+
+	s.Run("fund", func() {
+		_, err := s.msgServer.FundScopeSettlement(s.ctx, types.NewMsgFundScopeSettlementRequest(scopeID, s.user2))
+		s.Require().NoError(err, "FundScopeSettlement")
+		assertHolds(s.user1Addr, scopeCoins, "seller")
+		assertHolds(s.user2Addr, price, "buyer")
+	})
+
+	s.Run("fund again", func() {
+		_, err := s.msgServer.FundScopeSettlement(s.ctx, types.NewMsgFundScopeSettlementRequest(scopeID, s.user2))
+		s.Assert().EqualError(err, "settlement of scope "+scopeID.String()+" has already been funded: invalid request")
+	})
+
+	s.Run("settle by a stranger", func() {
+		_, err := s.msgServer.SettleScopeSettlement(s.ctx, types.NewMsgSettleScopeSettlementRequest(scopeID, stranger))
+		s.Assert().EqualError(err, stranger+" is neither the seller nor the buyer in the settlement of scope "+scopeID.String()+": invalid request")
+	})
+
+	s.Run("settle", func() {
+		_, err := s.msgServer.SettleScopeSettlement(s.ctx, types.NewMsgSettleScopeSettlementRequest(scopeID, s.user1))
+		s.Require().NoError(err, "SettleScopeSettlement")
+		assertHolds(s.user1Addr, nil, "seller")
+		assertHolds(s.user2Addr, nil, "buyer")
+		assertValueOwner(s.user2)
+		s.Assert().Equal(price.String(), s.app.BankKeeper.GetAllBalances(s.ctx, s.user1Addr).String(), "seller balance")
+
+		settlement, err := s.app.MetadataKeeper.GetScopeSettlement(s.ctx, scopeID)
+		s.Assert().NoError(err, "GetScopeSettlement")
+		s.Assert().Nil(settlement, "settlement after settle")
+	})
+
+	s.Run("cancel a funded settlement", func() {
+		_, err := s.msgServer.OpenScopeSettlement(s.ctx, types.NewMsgOpenScopeSettlementRequest(scopeID, s.user2, s.user1, price))
+		s.Require().NoError(err, "OpenScopeSettlement")
+		_, err = s.msgServer.FundScopeSettlement(s.ctx, types.NewMsgFundScopeSettlementRequest(scopeID, s.user1))
+		s.Require().NoError(err, "FundScopeSettlement")
+
+		_, err = s.msgServer.CancelScopeSettlement(s.ctx, types.NewMsgCancelScopeSettlementRequest(scopeID, s.user1))
+		s.Require().NoError(err, "CancelScopeSettlement")
+		assertHolds(s.user1Addr, nil, "buyer")
+		assertHolds(s.user2Addr, nil, "seller")
+		assertValueOwner(s.user2)
+
+		_, err = s.app.MetadataKeeper.ScopeSettlement(s.ctx, &types.ScopeSettlementRequest{ScopeId: scopeID.String()})
+		s.Assert().EqualError(err, "scope "+scopeID.String()+" does not have a pending settlement: not found")
+	})
+}
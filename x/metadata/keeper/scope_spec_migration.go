@@ -0,0 +1,157 @@
+package keeper
+
+import (
+	"fmt"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/metadata/types"
+)
+
+// GetScopeSpecMigration returns the in-progress migration of scopes away from a scope spec, or nil if there isn't one.
+func (k Keeper) GetScopeSpecMigration(ctx sdk.Context, fromSpecID types.MetadataAddress) (*types.ScopeSpecMigration, error) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetScopeSpecMigrationKey(fromSpecID))
+	if len(bz) == 0 {
+		return nil, nil
+	}
+	var migration types.ScopeSpecMigration
+	if err := k.cdc.Unmarshal(bz, &migration); err != nil {
+		return nil, fmt.Errorf("could not read scope spec migration from %s: %w", fromSpecID, err)
+	}
+	return &migration, nil
+}
+
+// SetScopeSpecMigration stores a scope spec migration.
+func (k Keeper) SetScopeSpecMigration(ctx sdk.Context, migration types.ScopeSpecMigration) error {
+	bz, err := k.cdc.Marshal(&migration)
+	if err != nil {
+		return err
+	}
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetScopeSpecMigrationKey(migration.FromSpecificationId), bz)
+	return nil
+}
+
+// removeScopeSpecMigration deletes the migration of scopes away from a scope spec.
+func (k Keeper) removeScopeSpecMigration(ctx sdk.Context, fromSpecID types.MetadataAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetScopeSpecMigrationKey(fromSpecID))
+}
+
+// IterateScopeSpecMigrations iterates over all in-progress scope spec migrations.
+func (k Keeper) IterateScopeSpecMigrations(ctx sdk.Context, handler func(migration types.ScopeSpecMigration) (stop bool)) error {
+	store := ctx.KVStore(k.storeKey)
+	it := storetypes.KVStorePrefixIterator(store, types.ScopeSpecMigrationKeyPrefix)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		var migration types.ScopeSpecMigration
+		if err := k.cdc.Unmarshal(it.Value(), &migration); err != nil {
+			return err
+		}
+		if handler(migration) {
+			break
+		}
+	}
+	return nil
+}
+
+// isScopeSpecInMigration returns true if the scope spec is either being migrated away from or migrated to.
+func (k Keeper) isScopeSpecInMigration(ctx sdk.Context, scopeSpecID types.MetadataAddress) bool {
+	found := false
+	err := k.IterateScopeSpecMigrations(ctx, func(migration types.ScopeSpecMigration) bool {
+		found = migration.FromSpecificationId.Equals(scopeSpecID) || migration.ToSpecificationId.Equals(scopeSpecID)
+		return found
+	})
+	// If there was an error, to err on the side of caution, assume it's in a migration.
+	return err != nil || found
+}
+
+// StartScopeSpecMigration schedules all scopes that use one scope spec to be re-pointed to another.
+// The scopes are updated in batches (at most batchSize per block) by ProcessScopeSpecMigrations.
+// Neither spec can already be part of another migration, and the spec being migrated to must exist.
+func (k Keeper) StartScopeSpecMigration(ctx sdk.Context, fromSpecID, toSpecID types.MetadataAddress, batchSize uint32) error {
+	migration := types.NewScopeSpecMigration(fromSpecID, toSpecID, batchSize, ctx.BlockHeight())
+	if err := migration.Validate(); err != nil {
+		return err
+	}
+	if _, found := k.GetScopeSpecification(ctx, toSpecID); !found {
+		return fmt.Errorf("scope specification %s not found", toSpecID)
+	}
+	if k.isScopeSpecInMigration(ctx, fromSpecID) {
+		return fmt.Errorf("scope specification %s is already part of a migration", fromSpecID)
+	}
+	if k.isScopeSpecInMigration(ctx, toSpecID) {
+		return fmt.Errorf("scope specification %s is already part of a migration", toSpecID)
+	}
+
+	if err := k.SetScopeSpecMigration(ctx, migration); err != nil {
+		return err
+	}
+	return ctx.EventManager().EmitTypedEvent(types.NewEventScopeSpecMigrationStarted(migration))
+}
+
+// ProcessScopeSpecMigrations re-points the next batch of scopes for each in-progress scope spec migration.
+func (k Keeper) ProcessScopeSpecMigrations(ctx sdk.Context) {
+	var migrations []types.ScopeSpecMigration
+	err := k.IterateScopeSpecMigrations(ctx, func(migration types.ScopeSpecMigration) bool {
+		migrations = append(migrations, migration)
+		return false
+	})
+	if err != nil {
+		k.Logger(ctx).Error("could not get scope spec migrations", "err", err)
+		return
+	}
+
+	for _, migration := range migrations {
+		// A batch is either fully applied or not at all.
+		cacheCtx, writeCache := ctx.CacheContext()
+		if err = k.processScopeSpecMigration(cacheCtx, migration); err != nil {
+			k.Logger(ctx).Error("could not process scope spec migration",
+				"from", migration.FromSpecificationId.String(), "to", migration.ToSpecificationId.String(), "err", err)
+			continue
+		}
+		writeCache()
+	}
+}
+
+// processScopeSpecMigration re-points the next batch of scopes of a migration, completing it once there are none left.
+func (k Keeper) processScopeSpecMigration(ctx sdk.Context, migration types.ScopeSpecMigration) error {
+	// Re-pointing a scope removes it from the from spec's index, so the next batch is always at the start.
+	scopeIDs := make([]types.MetadataAddress, 0, migration.BatchSize)
+	err := k.IterateScopesForScopeSpec(ctx, migration.FromSpecificationId, func(scopeID types.MetadataAddress) bool {
+		scopeIDs = append(scopeIDs, scopeID)
+		return len(scopeIDs) >= int(migration.BatchSize)
+	})
+	if err != nil {
+		return fmt.Errorf("could not get scopes using %s: %w", migration.FromSpecificationId, err)
+	}
+
+	if len(scopeIDs) == 0 {
+		k.removeScopeSpecMigration(ctx, migration.FromSpecificationId)
+		return ctx.EventManager().EmitTypedEvent(types.NewEventScopeSpecMigrationCompleted(migration))
+	}
+
+	for _, scopeID := range scopeIDs {
+		scope, found := k.GetScope(ctx, scopeID)
+		if !found {
+			return fmt.Errorf("scope %s indexed under %s not found", scopeID, migration.FromSpecificationId)
+		}
+		scope.SpecificationId = migration.ToSpecificationId
+		k.writeScopeToState(ctx, scope)
+	}
+
+	migration.ScopesMigrated += uint64(len(scopeIDs))
+	if err = ctx.EventManager().EmitTypedEvent(types.NewEventScopeSpecMigrationProgress(migration, uint64(len(scopeIDs)))); err != nil {
+		return err
+	}
+
+	// A partial batch means there weren't any more scopes left to migrate.
+	if len(scopeIDs) < int(migration.BatchSize) {
+		k.removeScopeSpecMigration(ctx, migration.FromSpecificationId)
+		return ctx.EventManager().EmitTypedEvent(types.NewEventScopeSpecMigrationCompleted(migration))
+	}
+	return k.SetScopeSpecMigration(ctx, migration)
+}
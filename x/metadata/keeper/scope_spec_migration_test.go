@@ -0,0 +1,176 @@
+package keeper_test
+
+import (
+	"github.com/google/uuid"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/provenance-io/provenance/x/metadata/types"
+)
+
+// newMigrationScopeSpec stores a new scope spec owned by user1 and returns its id.
+func (s *SpecKeeperTestSuite) newMigrationScopeSpec(ctx sdk.Context) types.MetadataAddress {
+	specID := types.ScopeSpecMetadataAddress(uuid.New())
+	spec := types.NewScopeSpecification(specID, nil, []string{s.user1}, []types.PartyType{types.PartyType_PARTY_TYPE_OWNER}, nil)
+	s.app.MetadataKeeper.SetScopeSpecification(ctx, *spec)
+	return specID
+}
+
+// getScopeIDsForSpec returns the ids of all the scopes indexed under the provided scope spec.
+func (s *SpecKeeperTestSuite) getScopeIDsForSpec(ctx sdk.Context, specID types.MetadataAddress) []types.MetadataAddress {
+	var rv []types.MetadataAddress
+	err := s.app.MetadataKeeper.IterateScopesForScopeSpec(ctx, specID, func(scopeID types.MetadataAddress) bool {
+		rv = append(rv, scopeID)
+		return false
+	})
+	s.Require().NoError(err, "IterateScopesForScopeSpec(%s)", specID)
+	return rv
+}
+
+func (s *SpecKeeperTestSuite) TestStartScopeSpecMigration() {
+	ctx := s.FreshCtx()
+	specA := s.newMigrationScopeSpec(ctx)
+	specB := s.newMigrationScopeSpec(ctx)
+	specC := s.newMigrationScopeSpec(ctx)
+	unknownSpec := types.ScopeSpecMetadataAddress(uuid.New())
+	s.Require().NoError(s.app.MetadataKeeper.StartScopeSpecMigration(ctx, specA, specB, 0), "StartScopeSpecMigration(A, B) setup")
+
+	tests := []struct {
+		name   string
+		from   types.MetadataAddress
+		to     types.MetadataAddress
+		expErr string
+	}{
+		{
+			name: "unknown from spec",
+			from: unknownSpec,
+			to:   specC,
+		},
+		{
+			name:   "unknown to spec",
+			from:   specC,
+			to:     unknownSpec,
+			expErr: "scope specification " + unknownSpec.String() + " not found",
+		},
+		{
+			name:   "same spec",
+			from:   specC,
+			to:     specC,
+			expErr: "cannot migrate scopes from a scope specification to itself",
+		},
+		{
+			name:   "from is not a scope spec",
+			from:   s.contractSpecID1,
+			to:     specC,
+			expErr: "invalid from specification id: " + s.contractSpecID1.String() + " is not a scope specification id",
+		},
+		{
+			name:   "from is already being migrated",
+			from:   specA,
+			to:     specC,
+			expErr: "scope specification " + specA.String() + " is already part of a migration",
+		},
+		{
+			name:   "from is already being migrated to",
+			from:   specB,
+			to:     specC,
+			expErr: "scope specification " + specB.String() + " is already part of a migration",
+		},
+		{
+			name:   "to is already being migrated to",
+			from:   specC,
+			to:     specB,
+			expErr: "scope specification " + specB.String() + " is already part of a migration",
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			cacheCtx, _ := ctx.CacheContext()
+			err := s.app.MetadataKeeper.StartScopeSpecMigration(cacheCtx, tc.from, tc.to, 0)
+			if len(tc.expErr) > 0 {
+				s.Assert().EqualError(err, tc.expErr, "StartScopeSpecMigration")
+				return
+			}
+			s.Require().NoError(err, "StartScopeSpecMigration")
+			migration, err := s.app.MetadataKeeper.GetScopeSpecMigration(cacheCtx, tc.from)
+			s.Require().NoError(err, "GetScopeSpecMigration")
+			s.Assert().Equal(types.NewScopeSpecMigration(tc.from, tc.to, types.DefaultScopeSpecMigrationBatchSize, cacheCtx.BlockHeight()), *migration, "migration")
+		})
+	}
+
+	err := s.app.MetadataKeeper.RemoveScopeSpecification(ctx, specB)
+	s.Assert().EqualError(err, "scope specification with id "+specB.String()+" still in use: part of a scope specification migration", "RemoveScopeSpecification(B)")
+}
+
+func (s *SpecKeeperTestSuite) TestProcessScopeSpecMigrations() {
+	ctx := s.FreshCtx()
+	specA := s.newMigrationScopeSpec(ctx)
+	specB := s.newMigrationScopeSpec(ctx)
+	specOther := s.newMigrationScopeSpec(ctx)
+
+	var scopeIDs []types.MetadataAddress
+	for i := 0; i < 5; i++ {
+		scope := types.NewScope(types.ScopeMetadataAddress(uuid.New()), specA, []types.Party{{Address: s.user1, Role: types.PartyType_PARTY_TYPE_OWNER}}, nil, "", false)
+		s.Require().NoError(s.app.MetadataKeeper.SetScope(ctx, *scope), "SetScope[%d]", i)
+		scopeIDs = append(scopeIDs, scope.ScopeId)
+	}
+	otherScope := types.NewScope(types.ScopeMetadataAddress(uuid.New()), specOther, []types.Party{{Address: s.user1, Role: types.PartyType_PARTY_TYPE_OWNER}}, nil, "", false)
+	s.Require().NoError(s.app.MetadataKeeper.SetScope(ctx, *otherScope), "SetScope(other)")
+
+	s.Require().NoError(s.app.MetadataKeeper.StartScopeSpecMigration(ctx, specA, specB, 2), "StartScopeSpecMigration")
+
+	expProgress := []uint64{2, 4, 5}
+	for i, expMigrated := range expProgress {
+		em := sdk.NewEventManager()
+		s.app.MetadataKeeper.ProcessScopeSpecMigrations(ctx.WithEventManager(em))
+
+		s.Assert().Len(s.getScopeIDsForSpec(ctx, specA), 5-int(expMigrated), "[%d] scopes still using spec A", i)
+		s.Assert().Len(s.getScopeIDsForSpec(ctx, specB), int(expMigrated), "[%d] scopes using spec B", i)
+
+		progress := types.ScopeSpecMigration{FromSpecificationId: specA, ToSpecificationId: specB, BatchSize: 2, ScopesMigrated: expMigrated}
+		var batchCount uint64 = 2
+		if i == len(expProgress)-1 {
+			batchCount = 1
+		}
+		expEvents := []sdk.Event{s.untypeEvent(types.NewEventScopeSpecMigrationProgress(progress, batchCount))}
+		migration, err := s.app.MetadataKeeper.GetScopeSpecMigration(ctx, specA)
+		s.Require().NoError(err, "[%d] GetScopeSpecMigration", i)
+		if i == len(expProgress)-1 {
+			s.Assert().Nil(migration, "[%d] migration after it completed", i)
+			expEvents = append(expEvents, s.untypeEvent(types.NewEventScopeSpecMigrationCompleted(progress)))
+		} else if s.Assert().NotNil(migration, "[%d] migration", i) {
+			s.Assert().Equal(expMigrated, migration.ScopesMigrated, "[%d] migration scopes migrated", i)
+		}
+		s.Assert().Equal(expEvents, s.getMigrationEvents(em.Events()), "[%d] migration events", i)
+	}
+
+	for i, scopeID := range scopeIDs {
+		scope, found := s.app.MetadataKeeper.GetScope(ctx, scopeID)
+		s.Require().True(found, "GetScope[%d] found", i)
+		s.Assert().Equal(specB, scope.SpecificationId, "GetScope[%d] specification id", i)
+	}
+	s.Assert().Equal([]types.MetadataAddress{otherScope.ScopeId}, s.getScopeIDsForSpec(ctx, specOther), "scopes using the other spec")
+
+	s.Assert().NoError(s.app.MetadataKeeper.RemoveScopeSpecification(ctx, specA), "RemoveScopeSpecification(A) after migration")
+}
+
+// untypeEvent converts a typed event to an sdk.Event, requiring it to not error.
+func (s *SpecKeeperTestSuite) untypeEvent(event proto.Message) sdk.Event {
+	rv, err := sdk.TypedEventToEvent(event)
+	s.Require().NoError(err, "TypedEventToEvent(%T)", event)
+	return rv
+}
+
+// getMigrationEvents returns just the scope spec migration events from the provided events.
+func (s *SpecKeeperTestSuite) getMigrationEvents(events sdk.Events) []sdk.Event {
+	var rv []sdk.Event
+	for _, event := range events {
+		if event.Type == "provenance.metadata.v1.EventScopeSpecMigrationProgress" ||
+			event.Type == "provenance.metadata.v1.EventScopeSpecMigrationCompleted" {
+			rv = append(rv, event)
+		}
+	}
+	return rv
+}
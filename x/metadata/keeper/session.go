@@ -3,6 +3,7 @@ package keeper
 import (
 	"errors"
 	"fmt"
+	"strings"
 
 	storetypes "cosmossdk.io/store/types"
 
@@ -32,11 +33,13 @@ func (k Keeper) SetSession(ctx sdk.Context, session types.Session) {
 	b := k.cdc.MustMarshal(&session)
 
 	var event proto.Message = types.NewEventSessionCreated(session.SessionId)
-	if store.Has(session.SessionId) {
+	if existing, found := k.GetSession(ctx, session.SessionId); found {
 		event = types.NewEventSessionUpdated(session.SessionId)
+		store.Delete(types.GetContractSpecSessionCacheKey(existing.SpecificationId, existing.SessionId))
 	}
 
 	store.Set(session.SessionId, b)
+	store.Set(types.GetContractSpecSessionCacheKey(session.SpecificationId, session.SessionId), []byte{0x01})
 	k.EmitEvent(ctx, event)
 }
 
@@ -47,10 +50,12 @@ func (k Keeper) RemoveSession(ctx sdk.Context, id types.MetadataAddress) {
 	}
 	store := ctx.KVStore(k.storeKey)
 
-	if !store.Has(id) || k.sessionHasRecords(ctx, id) {
+	session, found := k.GetSession(ctx, id)
+	if !found || k.sessionHasRecords(ctx, id) {
 		return
 	}
 
+	store.Delete(types.GetContractSpecSessionCacheKey(session.SpecificationId, id))
 	store.Delete(id)
 	k.EmitEvent(ctx, types.NewEventSessionDeleted(id))
 }
@@ -100,6 +105,26 @@ func (k Keeper) IterateSessions(ctx sdk.Context, scopeID types.MetadataAddress,
 	return nil
 }
 
+// IterateSessionsForContractSpec processes all sessions that use a given contract spec using a given handler.
+func (k Keeper) IterateSessionsForContractSpec(ctx sdk.Context, contractSpecID types.MetadataAddress,
+	handler func(sessionID types.MetadataAddress) (stop bool),
+) error {
+	store := ctx.KVStore(k.storeKey)
+	prefix := types.GetContractSpecSessionCacheIteratorPrefix(contractSpecID)
+	it := storetypes.KVStorePrefixIterator(store, prefix)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		var sessionID types.MetadataAddress
+		if err := sessionID.Unmarshal(it.Key()[len(prefix):]); err != nil {
+			return err
+		}
+		if handler(sessionID) {
+			break
+		}
+	}
+	return nil
+}
+
 // ValidateWriteSession checks the current session and the proposed session to determine if the proposed changes are valid
 // based on the existing state
 func (k Keeper) ValidateWriteSession(ctx sdk.Context, existing *types.Session, msg *types.MsgWriteSessionRequest) error {
@@ -112,6 +137,9 @@ func (k Keeper) ValidateWriteSession(ctx sdk.Context, existing *types.Session, m
 		if !proposed.SessionId.Equals(existing.SessionId) {
 			return fmt.Errorf("cannot update session identifier. expected %s, got %s", existing.SessionId, proposed.SessionId)
 		}
+		if !existing.IsOpen() {
+			return fmt.Errorf("cannot update session %s: status is %s", existing.SessionId, existing.EffectiveStatus())
+		}
 		if !proposed.SpecificationId.Equals(existing.SpecificationId) {
 			return fmt.Errorf("cannot update specification identifier. expected %s, got %s", existing.SpecificationId, proposed.SpecificationId)
 		}
@@ -119,6 +147,9 @@ func (k Keeper) ValidateWriteSession(ctx sdk.Context, existing *types.Session, m
 			return errors.New("proposed name to existing session must not be empty")
 		}
 	}
+	if proposed.Status != types.SessionStatus_Unspecified && proposed.Status != types.SessionStatus_Open {
+		return fmt.Errorf("cannot write session with status %s: use CompleteSession or AbortSession instead", proposed.Status)
+	}
 
 	scopeUUID, err := proposed.SessionId.ScopeUUID()
 	if err != nil {
@@ -217,6 +248,59 @@ func (k Keeper) ValidateWriteSession(ctx sdk.Context, existing *types.Session, m
 	return nil
 }
 
+// UpdateSessionStatus moves an open session to the provided status (either completed or aborted).
+// The signers of the msg must be able to update the session.
+func (k Keeper) UpdateSessionStatus(ctx sdk.Context, sessionID types.MetadataAddress, status types.SessionStatus, msg types.MetadataMsg) error {
+	if status != types.SessionStatus_Completed && status != types.SessionStatus_Aborted {
+		return fmt.Errorf("cannot change session status to %s", status)
+	}
+	session, found := k.GetSession(ctx, sessionID)
+	if !found {
+		return fmt.Errorf("session not found with id %s", sessionID)
+	}
+	previous := session.EffectiveStatus()
+	if previous != types.SessionStatus_Open {
+		return fmt.Errorf("cannot change status of session %s to %s: status is already %s", sessionID, status, previous)
+	}
+	if err := k.validateSessionStatusSigners(ctx, session, msg); err != nil {
+		return err
+	}
+
+	session.Status = status
+	session.Audit = session.Audit.UpdateAudit(ctx.BlockTime(), strings.Join(msg.GetSignerStrs(), ", "), "")
+	k.SetSession(ctx, session)
+	k.EmitEvent(ctx, types.NewEventSessionStatusChanged(sessionID, previous, status))
+	return nil
+}
+
+// validateSessionStatusSigners makes sure the signers of the msg are allowed to change the status of the session.
+// The requirements are the same as for updating the session with a WriteSession.
+func (k Keeper) validateSessionStatusSigners(ctx sdk.Context, session types.Session, msg types.MetadataMsg) error {
+	scopeID := session.SessionId.MustGetAsScopeAddress()
+	scope, found := k.GetScope(ctx, scopeID)
+	if !found {
+		return fmt.Errorf("scope not found for scope id %s", scopeID)
+	}
+
+	if !scope.RequirePartyRollup {
+		// Old:
+		//   - All scope owners must sign.
+		return k.ValidateSignersWithoutParties(ctx, scope.GetAllOwnerAddresses(), msg)
+	}
+
+	// New:
+	//   - All roles required by the contract spec must have a signer and associated party in the session.
+	//   - All optional=false scope owners and session parties must be signers.
+	contractSpec, found := k.GetContractSpecification(ctx, session.SpecificationId)
+	if !found {
+		return fmt.Errorf("cannot find contract specification %s", session.SpecificationId)
+	}
+	var reqParties []types.Party
+	reqParties = append(reqParties, session.Parties...)
+	reqParties = append(reqParties, scope.Owners...)
+	return k.ValidateSignersWithParties(ctx, reqParties, session.Parties, contractSpec.PartiesInvolved, msg)
+}
+
 // ValidateAuditUpdate ensure that a given reference to audit fields represents no changes to
 // existing audit field data.  NOTE: A nil proposed is considered "no update" and not an attempt to unset.
 func (k Keeper) ValidateAuditUpdate(_ sdk.Context, existing, proposed *types.AuditFields) error {
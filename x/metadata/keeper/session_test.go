@@ -253,6 +253,12 @@ func (s *SessionKeeperTestSuite) TestValidateWriteSession() {
 		}
 	}
 
+	withSessionStatus := func(session *types.Session, status types.SessionStatus) *types.Session {
+		rv := *session
+		rv.Status = status
+		return &rv
+	}
+
 	cases := map[string]struct {
 		existing *types.Session
 		proposed *types.Session
@@ -488,6 +494,30 @@ func (s *SessionKeeperTestSuite) TestValidateWriteSession() {
 			signers:  []string{s.user1, s.user2},
 			errorMsg: `account "` + s.user1 + `" has role PROVENANCE but is not a smart contract`,
 		},
+		"existing session is open": {
+			existing: withSessionStatus(validSession, types.SessionStatus_Open),
+			proposed: withSessionStatus(validSession, types.SessionStatus_Open),
+			signers:  []string{s.user1},
+			errorMsg: "",
+		},
+		"existing session is completed": {
+			existing: withSessionStatus(validSession, types.SessionStatus_Completed),
+			proposed: validSession,
+			signers:  []string{s.user1},
+			errorMsg: "cannot update session " + s.sessionID.String() + ": status is SESSION_STATUS_COMPLETED",
+		},
+		"existing session is aborted": {
+			existing: withSessionStatus(validSession, types.SessionStatus_Aborted),
+			proposed: validSession,
+			signers:  []string{s.user1},
+			errorMsg: "cannot update session " + s.sessionID.String() + ": status is SESSION_STATUS_ABORTED",
+		},
+		"proposed session is completed": {
+			existing: nil,
+			proposed: withSessionStatus(validSession, types.SessionStatus_Completed),
+			signers:  []string{s.user1},
+			errorMsg: "cannot write session with status SESSION_STATUS_COMPLETED: use CompleteSession or AbortSession instead",
+		},
 	}
 
 	for name, tc := range cases {
@@ -506,4 +536,126 @@ func (s *SessionKeeperTestSuite) TestValidateWriteSession() {
 	}
 }
 
+func (s *SessionKeeperTestSuite) TestUpdateSessionStatus() {
+	ctx := s.FreshCtx()
+	scope := types.NewScope(s.scopeID, s.scopeSpecID, ownerPartyList(s.user1), []string{s.user1}, s.user1, false)
+	s.app.MetadataKeeper.SetScope(ctx, *scope)
+	partiesInvolved := []types.PartyType{types.PartyType_PARTY_TYPE_AFFILIATE}
+	contractSpec := types.NewContractSpecification(s.contractSpecID, types.NewDescription("name", "desc", "url", "icon"), []string{s.user1}, partiesInvolved, &types.ContractSpecification_Hash{"hash"}, "processname")
+	s.app.MetadataKeeper.SetContractSpecification(ctx, *contractSpec)
+
+	parties := []types.Party{{Address: s.user2, Role: types.PartyType_PARTY_TYPE_AFFILIATE}}
+	newSessionID := func() types.MetadataAddress {
+		return types.SessionMetadataAddress(s.scopeUUID, uuid.New())
+	}
+	setSession := func(sessionID types.MetadataAddress, status types.SessionStatus) {
+		audit := &types.AuditFields{CreatedBy: s.user1, CreatedDate: time.Unix(1, 0), Version: 1}
+		session := types.NewSession("processname", sessionID, s.contractSpecID, parties, audit)
+		session.Status = status
+		s.app.MetadataKeeper.SetSession(ctx, *session)
+	}
+
+	legacyID := newSessionID()
+	setSession(legacyID, types.SessionStatus_Unspecified)
+	openID := newSessionID()
+	setSession(openID, types.SessionStatus_Open)
+	completedID := newSessionID()
+	setSession(completedID, types.SessionStatus_Completed)
+	abortedID := newSessionID()
+	setSession(abortedID, types.SessionStatus_Aborted)
+	unknownID := newSessionID()
+
+	tests := []struct {
+		name      string
+		sessionID types.MetadataAddress
+		status    types.SessionStatus
+		signers   []string
+		expErr    string
+		expPrev   types.SessionStatus
+	}{
+		{
+			name:      "to open",
+			sessionID: openID,
+			status:    types.SessionStatus_Open,
+			signers:   []string{s.user1},
+			expErr:    "cannot change session status to SESSION_STATUS_OPEN",
+		},
+		{
+			name:      "session not found",
+			sessionID: unknownID,
+			status:    types.SessionStatus_Completed,
+			signers:   []string{s.user1},
+			expErr:    "session not found with id " + unknownID.String(),
+		},
+		{
+			name:      "missing signature",
+			sessionID: openID,
+			status:    types.SessionStatus_Completed,
+			signers:   []string{s.user2},
+			expErr:    "missing signature: " + s.user1,
+		},
+		{
+			name:      "complete open session",
+			sessionID: openID,
+			status:    types.SessionStatus_Completed,
+			signers:   []string{s.user1},
+			expPrev:   types.SessionStatus_Open,
+		},
+		{
+			name:      "abort legacy session",
+			sessionID: legacyID,
+			status:    types.SessionStatus_Aborted,
+			signers:   []string{s.user1},
+			expPrev:   types.SessionStatus_Open,
+		},
+		{
+			name:      "complete completed session",
+			sessionID: completedID,
+			status:    types.SessionStatus_Completed,
+			signers:   []string{s.user1},
+			expErr:    "cannot change status of session " + completedID.String() + " to SESSION_STATUS_COMPLETED: status is already SESSION_STATUS_COMPLETED",
+		},
+		{
+			name:      "abort completed session",
+			sessionID: completedID,
+			status:    types.SessionStatus_Aborted,
+			signers:   []string{s.user1},
+			expErr:    "cannot change status of session " + completedID.String() + " to SESSION_STATUS_ABORTED: status is already SESSION_STATUS_COMPLETED",
+		},
+		{
+			name:      "complete aborted session",
+			sessionID: abortedID,
+			status:    types.SessionStatus_Completed,
+			signers:   []string{s.user1},
+			expErr:    "cannot change status of session " + abortedID.String() + " to SESSION_STATUS_COMPLETED: status is already SESSION_STATUS_ABORTED",
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			before, _ := s.app.MetadataKeeper.GetSession(ctx, tc.sessionID)
+			msg := types.NewMsgCompleteSessionRequest(tc.sessionID, tc.signers)
+			ctx = ctx.WithEventManager(sdk.NewEventManager())
+			err := s.app.MetadataKeeper.UpdateSessionStatus(ctx, tc.sessionID, tc.status, msg)
+			after, _ := s.app.MetadataKeeper.GetSession(ctx, tc.sessionID)
+			if len(tc.expErr) > 0 {
+				s.Assert().EqualError(err, tc.expErr, "UpdateSessionStatus")
+				s.Assert().Equal(before, after, "session after failed UpdateSessionStatus")
+				s.Assert().Empty(ctx.EventManager().Events(), "events emitted during failed UpdateSessionStatus")
+				return
+			}
+			s.Require().NoError(err, "UpdateSessionStatus")
+			s.Assert().Equal(tc.status, after.Status, "session status after UpdateSessionStatus")
+			s.Assert().Equal(s.user1, after.Audit.GetUpdatedBy(), "session audit updated by")
+
+			expUpdated, err := sdk.TypedEventToEvent(types.NewEventSessionUpdated(tc.sessionID))
+			s.Require().NoError(err, "TypedEventToEvent NewEventSessionUpdated")
+			expChanged, err := sdk.TypedEventToEvent(types.NewEventSessionStatusChanged(tc.sessionID, tc.expPrev, tc.status))
+			s.Require().NoError(err, "TypedEventToEvent NewEventSessionStatusChanged")
+			expEvents := sdk.Events{expUpdated, expChanged}
+			s.Assert().Equal(expEvents, ctx.EventManager().Events(), "events emitted during UpdateSessionStatus")
+		})
+	}
+}
+
 // TODO: ValidateAuditUpdate tests
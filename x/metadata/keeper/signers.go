@@ -196,13 +196,14 @@ func getAuthzMessageTypeURLs(msgTypeURL string) []string {
 	}
 	switch msgTypeURL {
 	case types.TypeURLMsgAddScopeDataAccessRequest, types.TypeURLMsgDeleteScopeDataAccessRequest,
-		types.TypeURLMsgAddScopeOwnerRequest, types.TypeURLMsgDeleteScopeOwnerRequest:
+		types.TypeURLMsgAddScopeOwnerRequest, types.TypeURLMsgDeleteScopeOwnerRequest,
+		types.TypeURLMsgSubstituteScopePartyRequest:
 		urls = append(urls, types.TypeURLMsgWriteScopeRequest)
 	case types.TypeURLMsgWriteRecordRequest:
 		urls = append(urls, types.TypeURLMsgWriteSessionRequest)
 	case types.TypeURLMsgAddContractSpecToScopeSpecRequest, types.TypeURLMsgDeleteContractSpecFromScopeSpecRequest:
 		urls = append(urls, types.TypeURLMsgWriteScopeSpecificationRequest)
-	case types.TypeURLMsgWriteRecordSpecificationRequest:
+	case types.TypeURLMsgWriteRecordSpecificationRequest, types.TypeURLMsgDeprecateContractSpecificationRequest:
 		urls = append(urls, types.TypeURLMsgWriteContractSpecificationRequest)
 	case types.TypeURLMsgDeleteRecordSpecificationRequest:
 		urls = append(urls, types.TypeURLMsgDeleteContractSpecificationRequest)
@@ -2486,6 +2486,7 @@ func TestGetAuthzMessageTypeURLs(t *testing.T) {
 		newCase(types.TypeURLMsgDeleteScopeOwnerRequest, types.TypeURLMsgWriteScopeRequest),
 		newCase(types.TypeURLMsgUpdateValueOwnersRequest),
 		newCase(types.TypeURLMsgMigrateValueOwnerRequest),
+		newCase(types.TypeURLMsgSubstituteScopePartyRequest, types.TypeURLMsgWriteScopeRequest),
 		newCase(types.TypeURLMsgWriteSessionRequest),
 		newCase(types.TypeURLMsgWriteRecordRequest, types.TypeURLMsgWriteSessionRequest),
 		newCase(types.TypeURLMsgDeleteRecordRequest),
@@ -2493,6 +2494,7 @@ func TestGetAuthzMessageTypeURLs(t *testing.T) {
 		newCase(types.TypeURLMsgDeleteScopeSpecificationRequest),
 		newCase(types.TypeURLMsgWriteContractSpecificationRequest),
 		newCase(types.TypeURLMsgDeleteContractSpecificationRequest),
+		newCase(types.TypeURLMsgDeprecateContractSpecificationRequest, types.TypeURLMsgWriteContractSpecificationRequest),
 		newCase(types.TypeURLMsgAddContractSpecToScopeSpecRequest, types.TypeURLMsgWriteScopeSpecificationRequest),
 		newCase(types.TypeURLMsgDeleteContractSpecFromScopeSpecRequest, types.TypeURLMsgWriteScopeSpecificationRequest),
 		newCase(types.TypeURLMsgWriteRecordSpecificationRequest, types.TypeURLMsgWriteContractSpecificationRequest),
@@ -2500,6 +2502,9 @@ func TestGetAuthzMessageTypeURLs(t *testing.T) {
 		newCase(types.TypeURLMsgBindOSLocatorRequest),
 		newCase(types.TypeURLMsgDeleteOSLocatorRequest),
 		newCase(types.TypeURLMsgModifyOSLocatorRequest),
+		newCase(types.TypeURLMsgAddOSLocatorURIRequest),
+		newCase(types.TypeURLMsgRemoveOSLocatorURIRequest),
+		newCase(types.TypeURLMsgReorderOSLocatorURIsRequest),
 		newCase(types.TypeURLMsgSetAccountDataRequest),
 	}
 
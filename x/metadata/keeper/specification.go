@@ -82,7 +82,8 @@ func (k Keeper) GetRecordSpecificationsForContractSpecificationID(ctx sdk.Contex
 		if found {
 			retval = append(retval, &recordSpec)
 		} else {
-			k.Logger(ctx).Error(fmt.Sprintf("iterator found record spec id %s but no record spec was found with that id", recordSpecID))
+			k.Logger(ctx).Error("iterator found record spec id but no record spec was found with that id",
+				"record_spec_id", recordSpecID.String(), "contract_spec_id", contractSpecID.String())
 		}
 		return false
 	})
@@ -114,9 +115,41 @@ func (k Keeper) SetRecordSpecification(ctx sdk.Context, spec types.RecordSpecifi
 	}
 
 	store.Set(spec.SpecificationId, b)
+	for _, indexKey := range k.getRecordSpecIndexKeys(ctx, spec.SpecificationId) {
+		store.Set(indexKey, []byte{0x01})
+	}
 	k.EmitEvent(ctx, event)
 }
 
+// getRecordSpecIndexKeys gets the owner index keys of a record specification.
+// A record specification is owned by the owners of its contract specification.
+func (k Keeper) getRecordSpecIndexKeys(ctx sdk.Context, recordSpecID types.MetadataAddress) [][]byte {
+	contractSpecID, err := recordSpecID.AsContractSpecAddress()
+	if err != nil {
+		return nil
+	}
+	contractSpec, found := k.GetContractSpecification(ctx, contractSpecID)
+	if !found {
+		return nil
+	}
+	return getRecordSpecOwnerIndexKeys(contractSpec.OwnerAddresses, recordSpecID)
+}
+
+// getRecordSpecOwnerIndexKeys creates the index keys of the provided record specifications for each of the provided owners.
+func getRecordSpecOwnerIndexKeys(owners []string, recordSpecIDs ...types.MetadataAddress) [][]byte {
+	rv := make([][]byte, 0, len(owners)*len(recordSpecIDs))
+	for _, addrStr := range owners {
+		addr, err := sdk.AccAddressFromBech32(addrStr)
+		if err != nil {
+			continue
+		}
+		for _, recordSpecID := range recordSpecIDs {
+			rv = append(rv, types.GetAddressRecordSpecCacheKey(addr, recordSpecID))
+		}
+	}
+	return rv
+}
+
 // RemoveRecordSpecification removes a record specification from the module kv store.
 func (k Keeper) RemoveRecordSpecification(ctx sdk.Context, recordSpecID types.MetadataAddress) error {
 	if k.isRecordSpecUsed(ctx, recordSpecID) {
@@ -129,6 +162,9 @@ func (k Keeper) RemoveRecordSpecification(ctx sdk.Context, recordSpecID types.Me
 		return fmt.Errorf("record specification with id %s not found", recordSpecID)
 	}
 
+	for _, indexKey := range k.getRecordSpecIndexKeys(ctx, recordSpecID) {
+		store.Delete(indexKey)
+	}
 	store.Delete(recordSpecID)
 	k.EmitEvent(ctx, types.NewEventRecordSpecificationDeleted(recordSpecID))
 	return nil
@@ -233,8 +269,8 @@ func (k Keeper) SetContractSpecification(ctx sdk.Context, spec types.ContractSpe
 
 // RemoveContractSpecification removes a contract specification from the module kv store.
 func (k Keeper) RemoveContractSpecification(ctx sdk.Context, contractSpecID types.MetadataAddress) error {
-	if k.isContractSpecUsed(ctx, contractSpecID) {
-		return fmt.Errorf("contract specification with id %s still in use", contractSpecID)
+	if use := k.getContractSpecUse(ctx, contractSpecID); len(use) > 0 {
+		return fmt.Errorf("contract specification with id %s still in use: %s", contractSpecID, use)
 	}
 
 	store := ctx.KVStore(k.storeKey)
@@ -323,31 +359,78 @@ func (k Keeper) indexContractSpecification(ctx sdk.Context, newSpec, oldSpec *ty
 	for _, indexKey := range toRemove.IndexKeys() {
 		store.Delete(indexKey)
 	}
+
+	// The record specs of a contract spec are owned by the contract spec's owners, so their index entries change too.
+	if len(toAdd.OwnerAddresses) == 0 && len(toRemove.OwnerAddresses) == 0 {
+		return
+	}
+	specID := toAdd.SpecificationID
+	if specID.Empty() {
+		specID = toRemove.SpecificationID
+	}
+	var recordSpecIDs []types.MetadataAddress
+	err := k.IterateRecordSpecsForContractSpec(ctx, specID, func(recordSpecID types.MetadataAddress) bool {
+		recordSpecIDs = append(recordSpecIDs, recordSpecID)
+		return false
+	})
+	if err != nil {
+		k.Logger(ctx).Error("could not get record specs of contract spec", "err", err, "specId", specID.String())
+		return
+	}
+	for _, indexKey := range getRecordSpecOwnerIndexKeys(toAdd.OwnerAddresses, recordSpecIDs...) {
+		store.Set(indexKey, []byte{0x01})
+	}
+	for _, indexKey := range getRecordSpecOwnerIndexKeys(toRemove.OwnerAddresses, recordSpecIDs...) {
+		store.Delete(indexKey)
+	}
 }
 
-// isContractSpecUsed checks to see if a contract spec is referenced by anything else (e.g. scope spec or session)
-func (k Keeper) isContractSpecUsed(ctx sdk.Context, contractSpecID types.MetadataAddress) bool {
-	contractSpecReferenceFound := false
-	itScopeSpecErr := k.IterateScopeSpecsForContractSpec(ctx, contractSpecID, func(types.MetadataAddress) (stop bool) {
-		contractSpecReferenceFound = true
+// getContractSpecUse checks to see if a contract spec is referenced by anything else (e.g. scope spec or session).
+// If it is, a description of the first such reference is returned. If it isn't, an empty string is returned.
+func (k Keeper) getContractSpecUse(ctx sdk.Context, contractSpecID types.MetadataAddress) string {
+	var scopeSpecID types.MetadataAddress
+	err := k.IterateScopeSpecsForContractSpec(ctx, contractSpecID, func(id types.MetadataAddress) (stop bool) {
+		scopeSpecID = id
 		return true
 	})
 	// If there was an error, that indicates there was probably at least one entry to iterate over.
-	// So, to err on the side of caution, return true in that case.
-	if itScopeSpecErr != nil || contractSpecReferenceFound {
-		return true
+	// So, to err on the side of caution, treat it as used in that case.
+	if err != nil {
+		return fmt.Sprintf("could not check scope specifications: %v", err)
+	}
+	if len(scopeSpecID) > 0 {
+		return fmt.Sprintf("used by scope specification %s", scopeSpecID)
 	}
 
-	// TODO: Look for sessions used by this contractSpecID.
+	var sessionID types.MetadataAddress
+	err = k.IterateSessionsForContractSpec(ctx, contractSpecID, func(id types.MetadataAddress) (stop bool) {
+		sessionID = id
+		return true
+	})
+	if err != nil {
+		return fmt.Sprintf("could not check sessions: %v", err)
+	}
+	if len(sessionID) > 0 {
+		return fmt.Sprintf("used by session %s", sessionID)
+	}
 
 	// Look for a used record spec that is part of this contract spec
-	hasUsedRecordSpec := false
-	itRecSpecErr := k.IterateRecordSpecsForContractSpec(ctx, contractSpecID, func(recordSpecID types.MetadataAddress) bool {
-		hasUsedRecordSpec = k.isRecordSpecUsed(ctx, recordSpecID)
-		return hasUsedRecordSpec
+	var usedRecordSpecID types.MetadataAddress
+	err = k.IterateRecordSpecsForContractSpec(ctx, contractSpecID, func(recordSpecID types.MetadataAddress) bool {
+		if k.isRecordSpecUsed(ctx, recordSpecID) {
+			usedRecordSpecID = recordSpecID
+			return true
+		}
+		return false
 	})
+	if err != nil {
+		return fmt.Sprintf("could not check record specifications: %v", err)
+	}
+	if len(usedRecordSpecID) > 0 {
+		return fmt.Sprintf("record specification %s still in use", usedRecordSpecID)
+	}
 
-	return itRecSpecErr != nil || hasUsedRecordSpec
+	return ""
 }
 
 // ValidateWriteContractSpecification compare the proposed contract spec with the existing to make sure the proposed
@@ -455,8 +538,8 @@ func (k Keeper) SetScopeSpecification(ctx sdk.Context, spec types.ScopeSpecifica
 
 // RemoveScopeSpecification removes a scope specification from the module kv store.
 func (k Keeper) RemoveScopeSpecification(ctx sdk.Context, scopeSpecID types.MetadataAddress) error {
-	if k.isScopeSpecUsed(ctx, scopeSpecID) {
-		return fmt.Errorf("scope specification with id %s still in use", scopeSpecID)
+	if use := k.getScopeSpecUse(ctx, scopeSpecID); len(use) > 0 {
+		return fmt.Errorf("scope specification with id %s still in use: %s", scopeSpecID, use)
 	}
 
 	store := ctx.KVStore(k.storeKey)
@@ -572,16 +655,26 @@ func (k Keeper) indexScopeSpecification(ctx sdk.Context, newSpec, oldSpec *types
 	}
 }
 
-// isScopeSpecUsed checks to see if a scope exists that is defined by this scope spec.
-func (k Keeper) isScopeSpecUsed(ctx sdk.Context, scopeSpecID types.MetadataAddress) bool {
-	scopeSpecReferenceFound := false
-	err := k.IterateScopesForScopeSpec(ctx, scopeSpecID, func(types.MetadataAddress) (stop bool) {
-		scopeSpecReferenceFound = true
+// getScopeSpecUse checks to see if a scope exists that is defined by this scope spec, or if it's part of a migration.
+// If so, a description of that use is returned. If not, an empty string is returned.
+func (k Keeper) getScopeSpecUse(ctx sdk.Context, scopeSpecID types.MetadataAddress) string {
+	var scopeID types.MetadataAddress
+	err := k.IterateScopesForScopeSpec(ctx, scopeSpecID, func(id types.MetadataAddress) (stop bool) {
+		scopeID = id
 		return true
 	})
 	// If there was an error, that indicates there was probably at least one entry to iterate over.
-	// So, to err on the side of caution, return true in that case.
-	return err != nil || scopeSpecReferenceFound
+	// So, to err on the side of caution, treat it as used in that case.
+	if err != nil {
+		return fmt.Sprintf("could not check scopes: %v", err)
+	}
+	if len(scopeID) > 0 {
+		return fmt.Sprintf("used by scope %s", scopeID)
+	}
+	if k.isScopeSpecInMigration(ctx, scopeSpecID) {
+		return "part of a scope specification migration"
+	}
+	return ""
 }
 
 // ValidateWriteScopeSpecification compare the proposed scope spec with the existing to make sure the proposed
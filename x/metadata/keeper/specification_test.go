@@ -1283,6 +1283,57 @@ func (s *SpecKeeperTestSuite) TestContractSpecIndexing() {
 	})
 }
 
+func (s *SpecKeeperTestSuite) TestRecordSpecIndexing() {
+	contractSpecID := types.ContractSpecMetadataAddress(uuid.New())
+	recSpecID := asRecSpecAddrOrPanic(contractSpecID, "recspec")
+
+	ownerConstant := randomUser()
+	ownerToAdd := randomUser()
+	ownerToRemove := randomUser()
+
+	contractSpecV1 := types.ContractSpecification{
+		SpecificationId: contractSpecID,
+		OwnerAddresses:  []string{ownerConstant.Bech32, ownerToRemove.Bech32},
+	}
+	contractSpecV2 := types.ContractSpecification{
+		SpecificationId: contractSpecID,
+		OwnerAddresses:  []string{ownerConstant.Bech32, ownerToAdd.Bech32},
+	}
+	recSpec := types.RecordSpecification{
+		SpecificationId: recSpecID,
+		Name:            "recspec",
+		TypeName:        "typename",
+		ResultType:      types.DefinitionType_DEFINITION_TYPE_RECORD,
+	}
+
+	ctx := s.FreshCtx()
+	store := ctx.KVStore(s.app.GetKey(types.ModuleName))
+	s.app.MetadataKeeper.SetContractSpecification(ctx, contractSpecV1)
+
+	s.T().Run("1 write new record specification", func(t *testing.T) {
+		s.app.MetadataKeeper.SetRecordSpecification(ctx, recSpec)
+
+		assert.True(t, store.Has(types.GetAddressRecordSpecCacheKey(ownerConstant.Addr, recSpecID)), "ownerConstant address index")
+		assert.True(t, store.Has(types.GetAddressRecordSpecCacheKey(ownerToRemove.Addr, recSpecID)), "ownerToRemove address index")
+		assert.False(t, store.Has(types.GetAddressRecordSpecCacheKey(ownerToAdd.Addr, recSpecID)), "ownerToAdd address index")
+	})
+
+	s.T().Run("2 update contract specification owners", func(t *testing.T) {
+		s.app.MetadataKeeper.SetContractSpecification(ctx, contractSpecV2)
+
+		assert.True(t, store.Has(types.GetAddressRecordSpecCacheKey(ownerConstant.Addr, recSpecID)), "ownerConstant address index")
+		assert.True(t, store.Has(types.GetAddressRecordSpecCacheKey(ownerToAdd.Addr, recSpecID)), "ownerToAdd address index")
+		assert.False(t, store.Has(types.GetAddressRecordSpecCacheKey(ownerToRemove.Addr, recSpecID)), "ownerToRemove address index")
+	})
+
+	s.T().Run("3 delete record specification", func(t *testing.T) {
+		assert.NoError(t, s.app.MetadataKeeper.RemoveRecordSpecification(ctx, recSpecID), "removing record spec")
+
+		assert.False(t, store.Has(types.GetAddressRecordSpecCacheKey(ownerConstant.Addr, recSpecID)), "ownerConstant address index")
+		assert.False(t, store.Has(types.GetAddressRecordSpecCacheKey(ownerToAdd.Addr, recSpecID)), "ownerToAdd address index")
+	})
+}
+
 func (s *SpecKeeperTestSuite) TestGetSetRemoveScopeSpecification() {
 	ctx := s.FreshCtx()
 	newSpec := types.NewScopeSpecification(
@@ -2013,3 +2064,86 @@ func (s *SpecKeeperTestSuite) TestFindMissingMdAddr() {
 		})
 	}
 }
+
+func (s *SpecKeeperTestSuite) TestSpecificationUsageAndDependents() {
+	ctx := s.FreshCtx()
+	owners := []string{s.user1}
+	ownerRoles := []types.PartyType{types.PartyType_PARTY_TYPE_OWNER}
+	contractSpecID := types.ContractSpecMetadataAddress(uuid.New())
+	contractSpec := types.NewContractSpecification(contractSpecID, nil, owners, ownerRoles,
+		types.NewContractSpecificationSourceHash("somehash"), "someclass")
+	s.app.MetadataKeeper.SetContractSpecification(ctx, *contractSpec)
+	scopeSpecID := types.ScopeSpecMetadataAddress(uuid.New())
+	scopeSpec := types.NewScopeSpecification(scopeSpecID, nil, owners, ownerRoles, []types.MetadataAddress{contractSpecID})
+	s.app.MetadataKeeper.SetScopeSpecification(ctx, *scopeSpec)
+
+	scopeUUID := uuid.New()
+	scope := types.NewScope(types.ScopeMetadataAddress(scopeUUID), scopeSpecID, []types.Party{{Address: s.user1, Role: types.PartyType_PARTY_TYPE_OWNER}}, nil, "", false)
+	s.Require().NoError(s.app.MetadataKeeper.SetScope(ctx, *scope), "SetScope")
+	var sessionIDs []types.MetadataAddress
+	var sessionIDStrs []string
+	for _, name := range []string{"first", "second"} {
+		session := types.Session{
+			SessionId:       types.SessionMetadataAddress(scopeUUID, uuid.New()),
+			SpecificationId: contractSpecID,
+			Name:            name,
+		}
+		s.app.MetadataKeeper.SetSession(ctx, session)
+		sessionIDs = append(sessionIDs, session.SessionId)
+		sessionIDStrs = append(sessionIDStrs, session.SessionId.String())
+	}
+
+	s.Run("scope spec usage", func() {
+		resp, err := s.app.MetadataKeeper.SpecificationUsage(ctx, &types.SpecificationUsageRequest{SpecificationId: scopeSpecID.String()})
+		s.Require().NoError(err, "SpecificationUsage")
+		s.Assert().Equal(uint64(1), resp.ScopeCount, "ScopeCount")
+		s.Assert().Zero(resp.SessionCount, "SessionCount")
+		s.Assert().False(resp.InMigration, "InMigration")
+		s.Assert().True(resp.InUse, "InUse")
+		s.Assert().Equal("used by scope "+scope.ScopeId.String(), resp.InUseReason, "InUseReason")
+	})
+	s.Run("contract spec usage", func() {
+		resp, err := s.app.MetadataKeeper.SpecificationUsage(ctx, &types.SpecificationUsageRequest{SpecificationId: contractSpecID.String()})
+		s.Require().NoError(err, "SpecificationUsage")
+		s.Assert().Zero(resp.ScopeCount, "ScopeCount")
+		s.Assert().Equal(uint64(2), resp.SessionCount, "SessionCount")
+		s.Assert().Equal(uint64(1), resp.ScopeSpecificationCount, "ScopeSpecificationCount")
+		s.Assert().True(resp.InUse, "InUse")
+		s.Assert().Equal("used by scope specification "+scopeSpecID.String(), resp.InUseReason, "InUseReason")
+	})
+	s.Run("record spec usage", func() {
+		recSpecID := types.RecordSpecMetadataAddress(uuid.New(), "name")
+		_, err := s.app.MetadataKeeper.SpecificationUsage(ctx, &types.SpecificationUsageRequest{SpecificationId: recSpecID.String()})
+		s.Assert().EqualError(err, "address ["+recSpecID.String()+"] is not a scope spec or contract spec address: invalid request", "SpecificationUsage")
+	})
+	s.Run("scope spec dependents", func() {
+		resp, err := s.app.MetadataKeeper.SpecificationDependents(ctx, &types.SpecificationDependentsRequest{SpecificationId: scopeSpecID.String()})
+		s.Require().NoError(err, "SpecificationDependents")
+		s.Assert().Equal([]string{scope.ScopeId.String()}, resp.ScopeIds, "ScopeIds")
+		s.Assert().Empty(resp.SessionIds, "SessionIds")
+		s.Assert().Empty(resp.ScopeSpecificationIds, "ScopeSpecificationIds")
+	})
+	s.Run("contract spec dependents", func() {
+		resp, err := s.app.MetadataKeeper.SpecificationDependents(ctx, &types.SpecificationDependentsRequest{SpecificationId: contractSpecID.String()})
+		s.Require().NoError(err, "SpecificationDependents")
+		s.Assert().Empty(resp.ScopeIds, "ScopeIds")
+		s.Assert().ElementsMatch(sessionIDStrs, resp.SessionIds, "SessionIds")
+		s.Assert().Equal([]string{scopeSpecID.String()}, resp.ScopeSpecificationIds, "ScopeSpecificationIds")
+	})
+
+	// Once the scope spec no longer lists it, the sessions keep the contract spec from being deleted.
+	scopeSpec.ContractSpecIds = nil
+	s.app.MetadataKeeper.SetScopeSpecification(ctx, *scopeSpec)
+	err := s.app.MetadataKeeper.RemoveContractSpecification(ctx, contractSpecID)
+	s.Assert().ErrorContains(err, "contract specification with id "+contractSpecID.String()+" still in use: used by session ", "RemoveContractSpecification")
+
+	for _, sessionID := range sessionIDs {
+		s.app.MetadataKeeper.RemoveSession(ctx, sessionID)
+	}
+	resp, err := s.app.MetadataKeeper.SpecificationUsage(ctx, &types.SpecificationUsageRequest{SpecificationId: contractSpecID.String()})
+	s.Require().NoError(err, "SpecificationUsage after removing sessions")
+	s.Assert().Zero(resp.SessionCount, "SessionCount after removing sessions")
+	s.Assert().False(resp.InUse, "InUse after removing sessions")
+	s.Assert().Empty(resp.InUseReason, "InUseReason after removing sessions")
+	s.Assert().NoError(s.app.MetadataKeeper.RemoveContractSpecification(ctx, contractSpecID), "RemoveContractSpecification after removing sessions")
+}
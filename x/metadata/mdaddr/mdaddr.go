@@ -0,0 +1,260 @@
+// Package mdaddr contains the encoding rules for metadata addresses (scopes, sessions, records, and their specifications).
+//
+// This package intentionally has no dependencies on the Cosmos SDK or any keepers so that external Go
+// services can construct and parse metadata addresses without importing the rest of the provenance app.
+// The x/metadata/types.MetadataAddress type is built on top of this package.
+//
+// A metadata address is a type byte followed by a 16 byte primary UUID.
+// Sessions have a second 16 byte UUID (the session UUID) after the scope UUID.
+// Records and record specifications have the first 16 bytes of the sha256 hash of the (normalized) name
+// after the scope or contract specification UUID.
+package mdaddr
+
+import (
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/btcutil/bech32"
+	"github.com/google/uuid"
+)
+
+const (
+	// TypeScope is the type byte of a scope address.
+	TypeScope byte = 0x00
+	// TypeSession is the type byte of a session address.
+	TypeSession byte = 0x01
+	// TypeRecord is the type byte of a record address.
+	TypeRecord byte = 0x02
+	// TypeContractSpec is the type byte of a contract specification address.
+	TypeContractSpec byte = 0x03
+	// TypeScopeSpec is the type byte of a scope specification address.
+	TypeScopeSpec byte = 0x04
+	// TypeRecordSpec is the type byte of a record specification address.
+	TypeRecordSpec byte = 0x05
+)
+
+const (
+	// PrefixScope is the bech32 human readable prefix of scope addresses.
+	PrefixScope = "scope"
+	// PrefixSession is the bech32 human readable prefix of session addresses.
+	PrefixSession = "session"
+	// PrefixRecord is the bech32 human readable prefix of record addresses.
+	PrefixRecord = "record"
+	// PrefixScopeSpecification is the bech32 human readable prefix of scope specification addresses.
+	PrefixScopeSpecification = "scopespec"
+	// PrefixContractSpecification is the bech32 human readable prefix of contract specification addresses.
+	PrefixContractSpecification = "contractspec"
+	// PrefixRecordSpecification is the bech32 human readable prefix of record specification addresses.
+	PrefixRecordSpecification = "recspec"
+)
+
+const (
+	// UUIDLength is the number of bytes in each UUID portion of an address.
+	UUIDLength = 16
+	// NameHashLength is the number of bytes in the name hash portion of record and record specification addresses.
+	NameHashLength = 16
+
+	// ShortLength is the length of scope, contract specification, and scope specification addresses.
+	ShortLength = 1 + UUIDLength
+	// LongLength is the length of session, record, and record specification addresses.
+	LongLength = 1 + UUIDLength + UUIDLength
+)
+
+// bech32MaxLength is the maximum length of a bech32 string that Decode will accept.
+const bech32MaxLength = 1023
+
+// Verify checks that the provided bytes are a properly formatted metadata address,
+// returning the bech32 human readable prefix for its type.
+func Verify(bz []byte) (string, error) {
+	hrp := ""
+	if len(bz) == 0 {
+		return hrp, errors.New("address is empty")
+	}
+	var requiredLength int
+	checkSecondaryUUID := false
+	switch bz[0] {
+	case TypeScope:
+		hrp = PrefixScope
+		requiredLength = ShortLength
+	case TypeSession:
+		hrp = PrefixSession
+		requiredLength = LongLength
+		checkSecondaryUUID = true
+	case TypeRecord:
+		hrp = PrefixRecord
+		requiredLength = LongLength
+	case TypeScopeSpec:
+		hrp = PrefixScopeSpecification
+		requiredLength = ShortLength
+	case TypeContractSpec:
+		hrp = PrefixContractSpecification
+		requiredLength = ShortLength
+	case TypeRecordSpec:
+		hrp = PrefixRecordSpecification
+		requiredLength = LongLength
+	default:
+		return hrp, fmt.Errorf("invalid metadata address type: %d", bz[0])
+	}
+	if len(bz) != requiredLength {
+		return hrp, fmt.Errorf("incorrect address length (expected: %d, actual: %d)", requiredLength, len(bz))
+	}
+	// all valid metadata address have at least one uuid
+	if _, err := uuid.FromBytes(bz[1:ShortLength]); err != nil {
+		return hrp, fmt.Errorf("invalid address bytes of uuid, expected uuid compliant: %w", err)
+	}
+	if checkSecondaryUUID {
+		if _, err := uuid.FromBytes(bz[ShortLength:LongLength]); err != nil {
+			return hrp, fmt.Errorf("invalid address bytes of secondary uuid, expected uuid compliant: %w", err)
+		}
+	}
+	return hrp, nil
+}
+
+// TypeForPrefix returns the type byte associated with the provided bech32 human readable prefix.
+func TypeForPrefix(hrp string) (byte, error) {
+	switch hrp {
+	case PrefixScope:
+		return TypeScope, nil
+	case PrefixSession:
+		return TypeSession, nil
+	case PrefixRecord:
+		return TypeRecord, nil
+	case PrefixScopeSpecification:
+		return TypeScopeSpec, nil
+	case PrefixContractSpecification:
+		return TypeContractSpec, nil
+	case PrefixRecordSpecification:
+		return TypeRecordSpec, nil
+	}
+	return 0, fmt.Errorf("unknown metadata address prefix %q", hrp)
+}
+
+// Encode returns the bech32 string of the provided metadata address.
+// An error is returned if the bytes are not a properly formatted metadata address.
+func Encode(bz []byte) (string, error) {
+	hrp, err := Verify(bz)
+	if err != nil {
+		return "", err
+	}
+	converted, err := bech32.ConvertBits(bz, 8, 5, true)
+	if err != nil {
+		return "", fmt.Errorf("encoding bech32 failed: %w", err)
+	}
+	return bech32.Encode(hrp, converted)
+}
+
+// Decode parses the provided bech32 string into a metadata address, returning the address bytes and human readable prefix.
+// An error is returned if the result isn't a properly formatted metadata address,
+// or if the human readable prefix doesn't match the type of the address.
+func Decode(address string) ([]byte, string, error) {
+	if len(strings.TrimSpace(address)) == 0 {
+		return []byte{}, "", errors.New("empty address string is not allowed")
+	}
+
+	hrp, data, err := bech32.Decode(address, bech32MaxLength)
+	if err != nil {
+		return nil, "", fmt.Errorf("decoding bech32 failed: %w", err)
+	}
+	bz, err := bech32.ConvertBits(data, 5, 8, false)
+	if err != nil {
+		return nil, "", fmt.Errorf("decoding bech32 failed: %w", err)
+	}
+
+	expectedHrp, err := Verify(bz)
+	if err != nil {
+		return nil, "", err
+	}
+	if expectedHrp != hrp {
+		return []byte{}, "", fmt.Errorf("invalid bech32 prefix; expected %s, got %s", expectedHrp, hrp)
+	}
+	return bz, hrp, nil
+}
+
+// NameHash returns the name hash portion used in record and record specification addresses.
+// The name is lower-cased and trimmed before being hashed.
+// An error is returned if the name is empty after trimming.
+func NameHash(name string) ([]byte, error) {
+	name = strings.ToLower(strings.TrimSpace(name))
+	if len(name) == 0 {
+		return nil, errors.New("missing name value")
+	}
+	sum := sha256.Sum256([]byte(name))
+	return sum[:NameHashLength], nil
+}
+
+// Scope returns the address of the scope with the provided uuid.
+func Scope(scopeUUID uuid.UUID) []byte {
+	return short(TypeScope, scopeUUID)
+}
+
+// Session returns the address of a session with the provided uuid in the scope with the provided uuid.
+func Session(scopeUUID, sessionUUID uuid.UUID) []byte {
+	rv := make([]byte, 0, LongLength)
+	rv = append(rv, TypeSession)
+	rv = append(rv, scopeUUID[:]...)
+	return append(rv, sessionUUID[:]...)
+}
+
+// Record returns the address of the record with the provided name in the scope with the provided uuid.
+// An error is returned if the name is empty.
+func Record(scopeUUID uuid.UUID, name string) ([]byte, error) {
+	return named(TypeRecord, scopeUUID, name)
+}
+
+// ScopeSpec returns the address of the scope specification with the provided uuid.
+func ScopeSpec(specUUID uuid.UUID) []byte {
+	return short(TypeScopeSpec, specUUID)
+}
+
+// ContractSpec returns the address of the contract specification with the provided uuid.
+func ContractSpec(specUUID uuid.UUID) []byte {
+	return short(TypeContractSpec, specUUID)
+}
+
+// RecordSpec returns the address of the record specification with the provided name
+// in the contract specification with the provided uuid.
+// An error is returned if the name is empty.
+func RecordSpec(contractSpecUUID uuid.UUID, name string) ([]byte, error) {
+	return named(TypeRecordSpec, contractSpecUUID, name)
+}
+
+// PrimaryUUID returns the primary uuid of a properly formatted metadata address.
+// For sessions and records, that's the scope uuid. For record specifications, it's the contract specification uuid.
+func PrimaryUUID(bz []byte) (uuid.UUID, error) {
+	if _, err := Verify(bz); err != nil {
+		return uuid.UUID{}, err
+	}
+	return uuid.FromBytes(bz[1:ShortLength])
+}
+
+// SecondaryUUID returns the session uuid of a properly formatted session address.
+func SecondaryUUID(bz []byte) (uuid.UUID, error) {
+	if _, err := Verify(bz); err != nil {
+		return uuid.UUID{}, err
+	}
+	if bz[0] != TypeSession {
+		return uuid.UUID{}, fmt.Errorf("metadata address type %d does not have a secondary uuid", bz[0])
+	}
+	return uuid.FromBytes(bz[ShortLength:LongLength])
+}
+
+// short creates an address that is a type byte followed by a single uuid.
+func short(typeByte byte, id uuid.UUID) []byte {
+	rv := make([]byte, 0, ShortLength)
+	rv = append(rv, typeByte)
+	return append(rv, id[:]...)
+}
+
+// named creates an address that is a type byte followed by a uuid and a name hash.
+func named(typeByte byte, id uuid.UUID, name string) ([]byte, error) {
+	nameHash, err := NameHash(name)
+	if err != nil {
+		return nil, err
+	}
+	rv := make([]byte, 0, LongLength)
+	rv = append(rv, typeByte)
+	rv = append(rv, id[:]...)
+	return append(rv, nameHash...), nil
+}
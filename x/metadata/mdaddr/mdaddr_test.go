@@ -0,0 +1,228 @@
+package mdaddr_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/provenance-io/provenance/x/metadata/mdaddr"
+)
+
+var (
+	testScopeUUID   = uuid.MustParse("8d80b25a-c089-4446-956e-5d08cfe3e1a5")
+	testSessionUUID = uuid.MustParse("c25c7bd4-c639-4367-a842-f64fa5fccc19")
+)
+
+func TestConstructors(t *testing.T) {
+	record, err := mdaddr.Record(testScopeUUID, "test")
+	require.NoError(t, err, "Record")
+	recSpec, err := mdaddr.RecordSpec(testScopeUUID, "test")
+	require.NoError(t, err, "RecordSpec")
+
+	tests := []struct {
+		name    string
+		addr    []byte
+		expType byte
+		expHRP  string
+		expLen  int
+	}{
+		{name: "scope", addr: mdaddr.Scope(testScopeUUID), expType: mdaddr.TypeScope, expHRP: mdaddr.PrefixScope, expLen: mdaddr.ShortLength},
+		{name: "session", addr: mdaddr.Session(testScopeUUID, testSessionUUID), expType: mdaddr.TypeSession, expHRP: mdaddr.PrefixSession, expLen: mdaddr.LongLength},
+		{name: "record", addr: record, expType: mdaddr.TypeRecord, expHRP: mdaddr.PrefixRecord, expLen: mdaddr.LongLength},
+		{name: "scope spec", addr: mdaddr.ScopeSpec(testScopeUUID), expType: mdaddr.TypeScopeSpec, expHRP: mdaddr.PrefixScopeSpecification, expLen: mdaddr.ShortLength},
+		{name: "contract spec", addr: mdaddr.ContractSpec(testScopeUUID), expType: mdaddr.TypeContractSpec, expHRP: mdaddr.PrefixContractSpecification, expLen: mdaddr.ShortLength},
+		{name: "record spec", addr: recSpec, expType: mdaddr.TypeRecordSpec, expHRP: mdaddr.PrefixRecordSpecification, expLen: mdaddr.LongLength},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Len(t, tc.addr, tc.expLen, "address length")
+			assert.Equal(t, tc.expType, tc.addr[0], "type byte")
+
+			hrp, err := mdaddr.Verify(tc.addr)
+			require.NoError(t, err, "Verify")
+			assert.Equal(t, tc.expHRP, hrp, "Verify hrp")
+
+			typeByte, err := mdaddr.TypeForPrefix(hrp)
+			require.NoError(t, err, "TypeForPrefix")
+			assert.Equal(t, tc.expType, typeByte, "TypeForPrefix")
+
+			primary, err := mdaddr.PrimaryUUID(tc.addr)
+			require.NoError(t, err, "PrimaryUUID")
+			assert.Equal(t, testScopeUUID, primary, "PrimaryUUID")
+
+			bech, err := mdaddr.Encode(tc.addr)
+			require.NoError(t, err, "Encode")
+			decoded, decodedHRP, err := mdaddr.Decode(bech)
+			require.NoError(t, err, "Decode(%q)", bech)
+			assert.Equal(t, tc.addr, decoded, "Decode address")
+			assert.Equal(t, tc.expHRP, decodedHRP, "Decode hrp")
+		})
+	}
+}
+
+func TestKnownEncodings(t *testing.T) {
+	// These values must never change; they're the on-chain identifiers.
+	record, err := mdaddr.Record(testScopeUUID, "test")
+	require.NoError(t, err, "Record")
+
+	assert.Equal(t, "scope1qzxcpvj6czy5g354dews3nlruxjsahhnsp", mustEncode(t, mdaddr.Scope(testScopeUUID)), "scope")
+	assert.Equal(t, "session1qxxcpvj6czy5g354dews3nlruxjuyhrm6nrrjsm84pp0vna9lnxpjewp6kf",
+		mustEncode(t, mdaddr.Session(testScopeUUID, testSessionUUID)), "session")
+	assert.Equal(t, "record1q2xcpvj6czy5g354dews3nlruxjelpkssxyyclt9ngh74gx9ttgp27gt8kl", mustEncode(t, record), "record")
+}
+
+func TestNameHash(t *testing.T) {
+	base, err := mdaddr.NameHash("name")
+	require.NoError(t, err, "NameHash(name)")
+	assert.Len(t, base, mdaddr.NameHashLength, "NameHash length")
+
+	for _, name := range []string{"NAME", " name", "Name\t"} {
+		actual, err := mdaddr.NameHash(name)
+		if assert.NoError(t, err, "NameHash(%q)", name) {
+			assert.Equal(t, base, actual, "NameHash(%q)", name)
+		}
+	}
+
+	for _, name := range []string{"", " ", "\n\t"} {
+		_, err = mdaddr.NameHash(name)
+		assert.EqualError(t, err, "missing name value", "NameHash(%q)", name)
+		_, err = mdaddr.Record(testScopeUUID, name)
+		assert.EqualError(t, err, "missing name value", "Record(%q)", name)
+		_, err = mdaddr.RecordSpec(testScopeUUID, name)
+		assert.EqualError(t, err, "missing name value", "RecordSpec(%q)", name)
+	}
+}
+
+func TestVerifyErrors(t *testing.T) {
+	tests := []struct {
+		name   string
+		addr   []byte
+		expErr string
+	}{
+		{name: "nil", addr: nil, expErr: "address is empty"},
+		{name: "unknown type", addr: []byte{0x06, 0x01}, expErr: "invalid metadata address type: 6"},
+		{name: "scope too short", addr: mdaddr.Scope(testScopeUUID)[:16], expErr: "incorrect address length (expected: 17, actual: 16)"},
+		{name: "session too long", addr: append(mdaddr.Session(testScopeUUID, testSessionUUID), 0x00), expErr: "incorrect address length (expected: 33, actual: 34)"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := mdaddr.Verify(tc.addr)
+			assert.EqualError(t, err, tc.expErr, "Verify")
+			_, err = mdaddr.Encode(tc.addr)
+			assert.EqualError(t, err, tc.expErr, "Encode")
+		})
+	}
+}
+
+func TestDecodeErrors(t *testing.T) {
+	scopeBech := mustEncode(t, mdaddr.Scope(testScopeUUID))
+	wrongHRP := "session" + scopeBech[len("scope"):]
+
+	_, _, err := mdaddr.Decode("")
+	assert.EqualError(t, err, "empty address string is not allowed", "empty")
+	_, _, err = mdaddr.Decode("   ")
+	assert.EqualError(t, err, "empty address string is not allowed", "whitespace")
+	_, _, err = mdaddr.Decode(scopeBech[:len(scopeBech)-1] + "x")
+	assert.Error(t, err, "bad checksum")
+	_, _, err = mdaddr.Decode(wrongHRP)
+	assert.Error(t, err, "wrong hrp")
+}
+
+func TestSecondaryUUID(t *testing.T) {
+	actual, err := mdaddr.SecondaryUUID(mdaddr.Session(testScopeUUID, testSessionUUID))
+	require.NoError(t, err, "SecondaryUUID(session)")
+	assert.Equal(t, testSessionUUID, actual, "SecondaryUUID(session)")
+
+	_, err = mdaddr.SecondaryUUID(mdaddr.Scope(testScopeUUID))
+	assert.EqualError(t, err, "metadata address type 0 does not have a secondary uuid", "SecondaryUUID(scope)")
+}
+
+func mustEncode(t *testing.T, bz []byte) string {
+	rv, err := mdaddr.Encode(bz)
+	require.NoError(t, err, "Encode(%v)", bz)
+	return rv
+}
+
+func FuzzVerify(f *testing.F) {
+	f.Add(mdaddr.Scope(testScopeUUID))
+	f.Add(mdaddr.Session(testScopeUUID, testSessionUUID))
+	f.Add(mdaddr.ContractSpec(testScopeUUID))
+	f.Add([]byte{})
+	f.Add([]byte{0x07, 0x01, 0x02})
+	f.Fuzz(func(t *testing.T, bz []byte) {
+		hrp, err := mdaddr.Verify(bz)
+		if err != nil {
+			_, encErr := mdaddr.Encode(bz)
+			if encErr == nil {
+				t.Fatalf("Encode(%v) succeeded but Verify failed: %v", bz, err)
+			}
+			return
+		}
+		typeByte, err := mdaddr.TypeForPrefix(hrp)
+		if err != nil || typeByte != bz[0] {
+			t.Fatalf("TypeForPrefix(%q) = %d, %v; expected %d", hrp, typeByte, err, bz[0])
+		}
+		bech, err := mdaddr.Encode(bz)
+		if err != nil {
+			t.Fatalf("Encode(%v) error: %v", bz, err)
+		}
+		decoded, decodedHRP, err := mdaddr.Decode(bech)
+		if err != nil {
+			t.Fatalf("Decode(%q) error: %v", bech, err)
+		}
+		if !bytes.Equal(bz, decoded) || hrp != decodedHRP {
+			t.Fatalf("Decode(Encode(%v)) = %v, %q; expected %v, %q", bz, decoded, decodedHRP, bz, hrp)
+		}
+	})
+}
+
+func FuzzDecode(f *testing.F) {
+	f.Add("scope1qzxcpvj6czy5g354dews3nlruxjsahhnsp")
+	f.Add("session1qxxcpvj6czy5g354dews3nlruxjuyhrm6nrrjsm84pp0vna9lnxpjewp6kf")
+	f.Add("record1q2xcpvj6czy5g354dews3nlruxjelpkssxyyclt9ngh74gx9ttgp27gt8kl")
+	f.Add("scope1")
+	f.Add("")
+	f.Fuzz(func(t *testing.T, address string) {
+		bz, hrp, err := mdaddr.Decode(address)
+		if err != nil {
+			return
+		}
+		expHRP, err := mdaddr.Verify(bz)
+		if err != nil {
+			t.Fatalf("Decode(%q) returned an invalid address: %v", address, err)
+		}
+		if hrp != expHRP {
+			t.Fatalf("Decode(%q) hrp = %q, expected %q", address, hrp, expHRP)
+		}
+		if _, err = mdaddr.Encode(bz); err != nil {
+			t.Fatalf("Encode(Decode(%q)) error: %v", address, err)
+		}
+	})
+}
+
+func FuzzRecord(f *testing.F) {
+	f.Add(testScopeUUID[:], "name")
+	f.Add(testSessionUUID[:], " Mixed Case ")
+	f.Fuzz(func(t *testing.T, id []byte, name string) {
+		scopeUUID, err := uuid.FromBytes(id)
+		if err != nil {
+			return
+		}
+		addr, err := mdaddr.Record(scopeUUID, name)
+		if err != nil {
+			return
+		}
+		if _, err = mdaddr.Verify(addr); err != nil {
+			t.Fatalf("Record(%s, %q) is not valid: %v", scopeUUID, name, err)
+		}
+		primary, err := mdaddr.PrimaryUUID(addr)
+		if err != nil || primary != scopeUUID {
+			t.Fatalf("PrimaryUUID(Record(%s, %q)) = %s, %v", scopeUUID, name, primary, err)
+		}
+	})
+}
@@ -2,7 +2,6 @@ package types
 
 import (
 	"bytes"
-	"crypto/sha256"
 	"encoding/base64"
 	"encoding/hex"
 	"encoding/json"
@@ -14,23 +13,24 @@ import (
 	"sigs.k8s.io/yaml"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	"github.com/cosmos/cosmos-sdk/types/bech32"
+
+	"github.com/provenance-io/provenance/x/metadata/mdaddr"
 )
 
 const (
 	// PrefixScope is the address human readable prefix used with bech32 encoding of Scope IDs
-	PrefixScope = "scope"
+	PrefixScope = mdaddr.PrefixScope
 	// PrefixSession is the address human readable prefix used with bech32 encoding of Session IDs
-	PrefixSession = "session"
+	PrefixSession = mdaddr.PrefixSession
 	// PrefixRecord is the address human readable prefix used with bech32 encoding of Record IDs
-	PrefixRecord = "record"
+	PrefixRecord = mdaddr.PrefixRecord
 
 	// PrefixScopeSpecification is the address human readable prefix used with bech32 encoding of ScopeSpecification IDs
-	PrefixScopeSpecification = "scopespec"
+	PrefixScopeSpecification = mdaddr.PrefixScopeSpecification
 	// PrefixContractSpecification is the address human readable prefix used with bech32 encoding of ContractSpecification IDs
-	PrefixContractSpecification = "contractspec"
+	PrefixContractSpecification = mdaddr.PrefixContractSpecification
 	// PrefixRecordSpecification is the address human readable prefix used with bech32 encoding of RecordSpecification IDs
-	PrefixRecordSpecification = "recspec"
+	PrefixRecordSpecification = mdaddr.PrefixRecordSpecification
 
 	// DenomPrefix is the string prepended to a metadata address to create the denom for that metadata object.
 	DenomPrefix = "nft/"
@@ -47,50 +47,7 @@ type MetadataAddress []byte
 // VerifyMetadataAddressFormat checks a sequence of bytes for proper format as a MetadataAddress instance
 // returns the associated bech32 hrp/type name or any errors encountered during verification
 func VerifyMetadataAddressFormat(bz []byte) (string, error) {
-	hrp := ""
-	if len(bz) == 0 {
-		return hrp, errors.New("address is empty")
-	}
-	var requiredLength int
-	checkSecondaryUUID := false
-	switch bz[0] {
-	case ScopeKeyPrefix[0]:
-		hrp = PrefixScope
-		requiredLength = 1 + 16 // type byte plus size of one uuid
-	case SessionKeyPrefix[0]:
-		hrp = PrefixSession
-		requiredLength = 1 + 16 + 16 // type byte plus size of two uuids
-		checkSecondaryUUID = true
-	case RecordKeyPrefix[0]:
-		hrp = PrefixRecord
-		requiredLength = 1 + 16 + 16 // type byte plus size of one uuid and one half sha256 hash
-
-	case ScopeSpecificationKeyPrefix[0]:
-		hrp = PrefixScopeSpecification
-		requiredLength = 1 + 16 // type byte plus size of one uuid
-	case ContractSpecificationKeyPrefix[0]:
-		hrp = PrefixContractSpecification
-		requiredLength = 1 + 16 // type byte plus size of one uuid
-	case RecordSpecificationKeyPrefix[0]:
-		hrp = PrefixRecordSpecification
-		requiredLength = 1 + 16 + 16 // type byte plus size of one uuid plus one-half sha256 hash
-
-	default:
-		return hrp, fmt.Errorf("invalid metadata address type: %d", bz[0])
-	}
-	if len(bz) != requiredLength {
-		return hrp, fmt.Errorf("incorrect address length (expected: %d, actual: %d)", requiredLength, len(bz))
-	}
-	// all valid metadata address have at least one uuid
-	if _, err := uuid.FromBytes(bz[1:17]); err != nil {
-		return hrp, fmt.Errorf("invalid address bytes of uuid, expected uuid compliant: %w", err)
-	}
-	if checkSecondaryUUID {
-		if _, err := uuid.FromBytes(bz[17:33]); err != nil {
-			return hrp, fmt.Errorf("invalid address bytes of secondary uuid, expected uuid compliant: %w", err)
-		}
-	}
-	return hrp, nil
+	return mdaddr.Verify(bz)
 }
 
 // getNameForHRP returns the more formal name used for each metadata hrp.
@@ -175,23 +132,7 @@ func MetadataAddressFromHex(address string) (MetadataAddress, error) {
 //
 // If you don't need the HRP, use MetadataAddressFromBech32.
 func ParseMetadataAddressFromBech32(address string) (MetadataAddress, string, error) {
-	if len(strings.TrimSpace(address)) == 0 {
-		return MetadataAddress{}, "", errors.New("empty address string is not allowed")
-	}
-
-	hrp, bz, err := bech32.DecodeAndConvert(address)
-	if err != nil {
-		return nil, "", err
-	}
-	expectedHrp, err := VerifyMetadataAddressFormat(bz)
-	if err != nil {
-		return nil, "", err
-	}
-	if expectedHrp != hrp {
-		return MetadataAddress{}, "", fmt.Errorf("invalid bech32 prefix; expected %s, got %s", expectedHrp, hrp)
-	}
-
-	return bz, hrp, nil
+	return mdaddr.Decode(address)
 }
 
 // MetadataAddressFromBech32 creates a MetadataAddress from a Bech32 string.  The encoded data is checked against the
@@ -218,76 +159,40 @@ func MetadataAddressFromDenom(denom string) (MetadataAddress, error) {
 
 // ScopeMetadataAddress creates a MetadataAddress instance for the given scope by its uuid
 func ScopeMetadataAddress(scopeUUID uuid.UUID) MetadataAddress {
-	bz, err := scopeUUID.MarshalBinary()
-	if err != nil {
-		panic(err)
-	}
-	return append(ScopeKeyPrefix, bz...)
+	return mdaddr.Scope(scopeUUID)
 }
 
 // SessionMetadataAddress creates a MetadataAddress instance for a session within a scope by uuids
 func SessionMetadataAddress(scopeUUID uuid.UUID, sessionUUID uuid.UUID) MetadataAddress {
-	bz, err := scopeUUID.MarshalBinary()
-	if err != nil {
-		panic(err)
-	}
-	addr := SessionKeyPrefix
-	addr = append(addr, bz...)
-	bz, err = sessionUUID.MarshalBinary()
-	if err != nil {
-		panic(err)
-	}
-	return append(addr, bz...)
+	return mdaddr.Session(scopeUUID, sessionUUID)
 }
 
 // RecordMetadataAddress creates a MetadataAddress instance for a record within a scope by scope uuid/record name
 func RecordMetadataAddress(scopeUUID uuid.UUID, name string) MetadataAddress {
-	bz, err := scopeUUID.MarshalBinary()
+	addr, err := mdaddr.Record(scopeUUID, name)
 	if err != nil {
-		panic(err)
-	}
-	addr := RecordKeyPrefix
-	addr = append(addr, bz...)
-	name = strings.ToLower(strings.TrimSpace(name))
-	if len(name) < 1 {
 		panic("missing name value for record metadata address")
 	}
-	nameBytes := sha256.Sum256([]byte(name))
-	return append(addr, nameBytes[0:16]...)
+	return addr
 }
 
 // ScopeSpecMetadataAddress creates a MetadataAddress instance for a scope specification
 func ScopeSpecMetadataAddress(specUUID uuid.UUID) MetadataAddress {
-	bz, err := specUUID.MarshalBinary()
-	if err != nil {
-		panic(err)
-	}
-	return append(ScopeSpecificationKeyPrefix, bz...)
+	return mdaddr.ScopeSpec(specUUID)
 }
 
 // ContractSpecMetadataAddress creates a MetadataAddress instance for a contract specification
 func ContractSpecMetadataAddress(specUUID uuid.UUID) MetadataAddress {
-	bz, err := specUUID.MarshalBinary()
-	if err != nil {
-		panic(err)
-	}
-	return append(ContractSpecificationKeyPrefix, bz...)
+	return mdaddr.ContractSpec(specUUID)
 }
 
 // RecordSpecMetadataAddress creates a MetadataAddress instance for a record specification
 func RecordSpecMetadataAddress(contractSpecUUID uuid.UUID, name string) MetadataAddress {
-	bz, err := contractSpecUUID.MarshalBinary()
+	addr, err := mdaddr.RecordSpec(contractSpecUUID, name)
 	if err != nil {
-		panic(err)
-	}
-	addr := RecordSpecificationKeyPrefix
-	addr = append(addr, bz...)
-	name = strings.ToLower(strings.TrimSpace(name))
-	if len(name) < 1 {
 		panic("missing name value for record spec metadata address")
 	}
-	nameBytes := sha256.Sum256([]byte(name))
-	return append(addr, nameBytes[0:16]...)
+	return addr
 }
 
 // Equals determines if the current MetadataAddress is equal to another sdk.Address
@@ -394,14 +299,13 @@ func (ma MetadataAddress) String() string {
 		return ""
 	}
 
-	hrp, err := VerifyMetadataAddressFormat(ma)
-	if err != nil {
+	if _, err := VerifyMetadataAddressFormat(ma); err != nil {
 		// Be careful changing this. The %#v path in MetadataAddress.Format does NOT call this String method,
 		// but %v, %q and %s do. So there would be infinite recursion with some other formatter directives.
 		return fmt.Sprintf("%#v", ma)
 	}
 
-	bech32Addr, err := bech32.ConvertAndEncode(hrp, ma.Bytes())
+	bech32Addr, err := mdaddr.Encode(ma)
 	if err != nil {
 		panic(err)
 	}
@@ -18,17 +18,27 @@ const (
 	TxEndpoint_DeleteScopeOwner      TxEndpoint = "DeleteScopeOwner"
 	TxEndpoint_UpdateValueOwners     TxEndpoint = "UpdateValueOwners"
 	TxEndpoint_MigrateValueOwner     TxEndpoint = "MigrateValueOwner"
+	TxEndpoint_SubstituteScopeParty  TxEndpoint = "SubstituteScopeParty"
 
-	TxEndpoint_WriteSession TxEndpoint = "WriteSession"
+	TxEndpoint_OpenScopeSettlement   TxEndpoint = "OpenScopeSettlement"
+	TxEndpoint_FundScopeSettlement   TxEndpoint = "FundScopeSettlement"
+	TxEndpoint_SettleScopeSettlement TxEndpoint = "SettleScopeSettlement"
+	TxEndpoint_CancelScopeSettlement TxEndpoint = "CancelScopeSettlement"
+
+	TxEndpoint_WriteSession    TxEndpoint = "WriteSession"
+	TxEndpoint_CompleteSession TxEndpoint = "CompleteSession"
+	TxEndpoint_AbortSession    TxEndpoint = "AbortSession"
 
 	TxEndpoint_WriteRecord  TxEndpoint = "WriteRecord"
 	TxEndpoint_DeleteRecord TxEndpoint = "DeleteRecord"
 
-	TxEndpoint_WriteScopeSpecification  TxEndpoint = "WriteScopeSpecification"
-	TxEndpoint_DeleteScopeSpecification TxEndpoint = "DeleteScopeSpecification"
+	TxEndpoint_WriteScopeSpecification   TxEndpoint = "WriteScopeSpecification"
+	TxEndpoint_DeleteScopeSpecification  TxEndpoint = "DeleteScopeSpecification"
+	TxEndpoint_MigrateScopeSpecification TxEndpoint = "MigrateScopeSpecification"
 
-	TxEndpoint_WriteContractSpecification  TxEndpoint = "WriteContractSpecification"
-	TxEndpoint_DeleteContractSpecification TxEndpoint = "DeleteContractSpecification"
+	TxEndpoint_WriteContractSpecification     TxEndpoint = "WriteContractSpecification"
+	TxEndpoint_DeleteContractSpecification    TxEndpoint = "DeleteContractSpecification"
+	TxEndpoint_DeprecateContractSpecification TxEndpoint = "DeprecateContractSpecification"
 
 	TxEndpoint_AddContractSpecToScopeSpec      TxEndpoint = "AddContractSpecToScopeSpec"
 	TxEndpoint_DeleteContractSpecFromScopeSpec TxEndpoint = "DeleteContractSpecFromScopeSpec"
@@ -36,9 +46,12 @@ const (
 	TxEndpoint_WriteRecordSpecification  TxEndpoint = "WriteRecordSpecification"
 	TxEndpoint_DeleteRecordSpecification TxEndpoint = "DeleteRecordSpecification"
 
-	TxEndpoint_BindOSLocator   TxEndpoint = "BindOSLocator"
-	TxEndpoint_DeleteOSLocator TxEndpoint = "DeleteOSLocator"
-	TxEndpoint_ModifyOSLocator TxEndpoint = "ModifyOSLocator"
+	TxEndpoint_BindOSLocator        TxEndpoint = "BindOSLocator"
+	TxEndpoint_DeleteOSLocator      TxEndpoint = "DeleteOSLocator"
+	TxEndpoint_ModifyOSLocator      TxEndpoint = "ModifyOSLocator"
+	TxEndpoint_AddOSLocatorURI      TxEndpoint = "AddOSLocatorURI"
+	TxEndpoint_RemoveOSLocatorURI   TxEndpoint = "RemoveOSLocatorURI"
+	TxEndpoint_ReorderOSLocatorURIs TxEndpoint = "ReorderOSLocatorURIs"
 )
 
 func NewEventTxCompleted(endpoint TxEndpoint, signers []string) *EventTxCompleted {
@@ -67,6 +80,70 @@ func NewEventScopeDeleted(scopeID MetadataAddress) *EventScopeDeleted {
 	}
 }
 
+func NewEventScopeDataAccessChanged(change ScopeAccessChange) *EventScopeDataAccessChanged {
+	return &EventScopeDataAccessChanged{
+		ScopeAddr: change.ScopeId.String(),
+		Added:     change.Added,
+		Removed:   change.Removed,
+		Signers:   change.Signers,
+	}
+}
+
+func NewEventScopeValueOwnerChanged(change ScopeAccessChange) *EventScopeValueOwnerChanged {
+	return &EventScopeValueOwnerChanged{
+		ScopeAddr:          change.ScopeId.String(),
+		PreviousValueOwner: change.PreviousValueOwner,
+		NewValueOwner:      change.NewValueOwner,
+		Signers:            change.Signers,
+	}
+}
+
+func NewEventScopePartySubstituted(scopeID MetadataAddress, existing, replacement string, sessionIDs []MetadataAddress, signers []string) *EventScopePartySubstituted {
+	sessionAddrs := make([]string, len(sessionIDs))
+	for i, sessionID := range sessionIDs {
+		sessionAddrs[i] = sessionID.String()
+	}
+	return &EventScopePartySubstituted{
+		ScopeAddr:    scopeID.String(),
+		Existing:     existing,
+		Replacement:  replacement,
+		SessionAddrs: sessionAddrs,
+		Signers:      signers,
+	}
+}
+
+func NewEventScopeSettlementOpened(settlement ScopeSettlement) *EventScopeSettlementOpened {
+	return &EventScopeSettlementOpened{
+		ScopeAddr: settlement.ScopeId.String(),
+		Seller:    settlement.Seller,
+		Buyer:     settlement.Buyer,
+		Price:     settlement.Price.String(),
+	}
+}
+
+func NewEventScopeSettlementFunded(settlement ScopeSettlement) *EventScopeSettlementFunded {
+	return &EventScopeSettlementFunded{
+		ScopeAddr: settlement.ScopeId.String(),
+		Buyer:     settlement.Buyer,
+	}
+}
+
+func NewEventScopeSettlementSettled(settlement ScopeSettlement) *EventScopeSettlementSettled {
+	return &EventScopeSettlementSettled{
+		ScopeAddr: settlement.ScopeId.String(),
+		Seller:    settlement.Seller,
+		Buyer:     settlement.Buyer,
+		Price:     settlement.Price.String(),
+	}
+}
+
+func NewEventScopeSettlementCancelled(settlement ScopeSettlement, cancelledBy string) *EventScopeSettlementCancelled {
+	return &EventScopeSettlementCancelled{
+		ScopeAddr:   settlement.ScopeId.String(),
+		CancelledBy: cancelledBy,
+	}
+}
+
 func NewEventSessionCreated(sessionID MetadataAddress) *EventSessionCreated {
 	return &EventSessionCreated{
 		SessionAddr: sessionID.String(),
@@ -81,6 +158,15 @@ func NewEventSessionUpdated(sessionID MetadataAddress) *EventSessionUpdated {
 	}
 }
 
+func NewEventSessionStatusChanged(sessionID MetadataAddress, previous, status SessionStatus) *EventSessionStatusChanged {
+	return &EventSessionStatusChanged{
+		SessionAddr:    sessionID.String(),
+		ScopeAddr:      sessionID.MustGetAsScopeAddress().String(),
+		PreviousStatus: previous.String(),
+		NewStatus:      status.String(),
+	}
+}
+
 func NewEventSessionDeleted(sessionID MetadataAddress) *EventSessionDeleted {
 	return &EventSessionDeleted{
 		SessionAddr: sessionID.String(),
@@ -129,6 +215,31 @@ func NewEventScopeSpecificationDeleted(scopeSpecificationID MetadataAddress) *Ev
 	}
 }
 
+func NewEventScopeSpecMigrationStarted(migration ScopeSpecMigration) *EventScopeSpecMigrationStarted {
+	return &EventScopeSpecMigrationStarted{
+		FromSpecificationAddr: migration.FromSpecificationId.String(),
+		ToSpecificationAddr:   migration.ToSpecificationId.String(),
+		BatchSize:             migration.BatchSize,
+	}
+}
+
+func NewEventScopeSpecMigrationProgress(migration ScopeSpecMigration, batchCount uint64) *EventScopeSpecMigrationProgress {
+	return &EventScopeSpecMigrationProgress{
+		FromSpecificationAddr: migration.FromSpecificationId.String(),
+		ToSpecificationAddr:   migration.ToSpecificationId.String(),
+		BatchCount:            batchCount,
+		ScopesMigrated:        migration.ScopesMigrated,
+	}
+}
+
+func NewEventScopeSpecMigrationCompleted(migration ScopeSpecMigration) *EventScopeSpecMigrationCompleted {
+	return &EventScopeSpecMigrationCompleted{
+		FromSpecificationAddr: migration.FromSpecificationId.String(),
+		ToSpecificationAddr:   migration.ToSpecificationId.String(),
+		ScopesMigrated:        migration.ScopesMigrated,
+	}
+}
+
 func NewEventContractSpecificationCreated(contractSpecificationID MetadataAddress) *EventContractSpecificationCreated {
 	return &EventContractSpecificationCreated{
 		ContractSpecificationAddr: contractSpecificationID.String(),
@@ -147,6 +258,21 @@ func NewEventContractSpecificationDeleted(contractSpecificationID MetadataAddres
 	}
 }
 
+func NewEventContractSpecificationDeprecated(contractSpecificationID, replacementID MetadataAddress) *EventContractSpecificationDeprecated {
+	return &EventContractSpecificationDeprecated{
+		ContractSpecificationAddr:    contractSpecificationID.String(),
+		ReplacementSpecificationAddr: replacementID.String(),
+	}
+}
+
+func NewEventSessionUsesDeprecatedSpecification(sessionID MetadataAddress, spec ContractSpecification) *EventSessionUsesDeprecatedSpecification {
+	return &EventSessionUsesDeprecatedSpecification{
+		SessionAddr:                  sessionID.String(),
+		ContractSpecificationAddr:    spec.SpecificationId.String(),
+		ReplacementSpecificationAddr: spec.ReplacementId.String(),
+	}
+}
+
 func NewEventRecordSpecificationCreated(recordSpecificationID MetadataAddress) *EventRecordSpecificationCreated {
 	return &EventRecordSpecificationCreated{
 		RecordSpecificationAddr:   recordSpecificationID.String(),
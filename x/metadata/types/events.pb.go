@@ -224,26 +224,31 @@ func (m *EventScopeDeleted) GetScopeAddr() string {
 	return ""
 }
 
-// EventSessionCreated is an event message indicating a session has been created.
-type EventSessionCreated struct {
-	// session_addr is the bech32 address string of the session id that was created.
-	SessionAddr string `protobuf:"bytes,1,opt,name=session_addr,json=sessionAddr,proto3" json:"session_addr,omitempty"`
-	// scope_addr is the bech32 address string of the scope id this session belongs to.
-	ScopeAddr string `protobuf:"bytes,2,opt,name=scope_addr,json=scopeAddr,proto3" json:"scope_addr,omitempty"`
-}
-
-func (m *EventSessionCreated) Reset()         { *m = EventSessionCreated{} }
-func (m *EventSessionCreated) String() string { return proto.CompactTextString(m) }
-func (*EventSessionCreated) ProtoMessage()    {}
-func (*EventSessionCreated) Descriptor() ([]byte, []int) {
+// EventScopeDataAccessChanged is an event message indicating addresses have been added to or removed from the data
+// access list of a scope.
+type EventScopeDataAccessChanged struct {
+	// scope_addr is the bech32 address string of the scope id that was changed.
+	ScopeAddr string `protobuf:"bytes,1,opt,name=scope_addr,json=scopeAddr,proto3" json:"scope_addr,omitempty"`
+	// added are the bech32 address strings that were added to the data access list.
+	Added []string `protobuf:"bytes,2,rep,name=added,proto3" json:"added,omitempty"`
+	// removed are the bech32 address strings that were removed from the data access list.
+	Removed []string `protobuf:"bytes,3,rep,name=removed,proto3" json:"removed,omitempty"`
+	// signers are the bech32 address strings of the signers of the TX that made the change.
+	Signers []string `protobuf:"bytes,4,rep,name=signers,proto3" json:"signers,omitempty"`
+}
+
+func (m *EventScopeDataAccessChanged) Reset()         { *m = EventScopeDataAccessChanged{} }
+func (m *EventScopeDataAccessChanged) String() string { return proto.CompactTextString(m) }
+func (*EventScopeDataAccessChanged) ProtoMessage()    {}
+func (*EventScopeDataAccessChanged) Descriptor() ([]byte, []int) {
 	return fileDescriptor_476cf6cf9459cf25, []int{4}
 }
-func (m *EventSessionCreated) XXX_Unmarshal(b []byte) error {
+func (m *EventScopeDataAccessChanged) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventSessionCreated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EventScopeDataAccessChanged) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventSessionCreated.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EventScopeDataAccessChanged.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -253,52 +258,70 @@ func (m *EventSessionCreated) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *EventSessionCreated) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventSessionCreated.Merge(m, src)
+func (m *EventScopeDataAccessChanged) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventScopeDataAccessChanged.Merge(m, src)
 }
-func (m *EventSessionCreated) XXX_Size() int {
+func (m *EventScopeDataAccessChanged) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventSessionCreated) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventSessionCreated.DiscardUnknown(m)
+func (m *EventScopeDataAccessChanged) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventScopeDataAccessChanged.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventSessionCreated proto.InternalMessageInfo
+var xxx_messageInfo_EventScopeDataAccessChanged proto.InternalMessageInfo
 
-func (m *EventSessionCreated) GetSessionAddr() string {
+func (m *EventScopeDataAccessChanged) GetScopeAddr() string {
 	if m != nil {
-		return m.SessionAddr
+		return m.ScopeAddr
 	}
 	return ""
 }
 
-func (m *EventSessionCreated) GetScopeAddr() string {
+func (m *EventScopeDataAccessChanged) GetAdded() []string {
 	if m != nil {
-		return m.ScopeAddr
+		return m.Added
 	}
-	return ""
+	return nil
 }
 
-// EventSessionUpdated is an event message indicating a session has been updated.
-type EventSessionUpdated struct {
-	// session_addr is the bech32 address string of the session id that was updated.
-	SessionAddr string `protobuf:"bytes,1,opt,name=session_addr,json=sessionAddr,proto3" json:"session_addr,omitempty"`
-	// scope_addr is the bech32 address string of the scope id this session belongs to.
-	ScopeAddr string `protobuf:"bytes,2,opt,name=scope_addr,json=scopeAddr,proto3" json:"scope_addr,omitempty"`
+func (m *EventScopeDataAccessChanged) GetRemoved() []string {
+	if m != nil {
+		return m.Removed
+	}
+	return nil
 }
 
-func (m *EventSessionUpdated) Reset()         { *m = EventSessionUpdated{} }
-func (m *EventSessionUpdated) String() string { return proto.CompactTextString(m) }
-func (*EventSessionUpdated) ProtoMessage()    {}
-func (*EventSessionUpdated) Descriptor() ([]byte, []int) {
+func (m *EventScopeDataAccessChanged) GetSigners() []string {
+	if m != nil {
+		return m.Signers
+	}
+	return nil
+}
+
+// EventScopeValueOwnerChanged is an event message indicating the value owner of a scope has changed.
+type EventScopeValueOwnerChanged struct {
+	// scope_addr is the bech32 address string of the scope id that was changed.
+	ScopeAddr string `protobuf:"bytes,1,opt,name=scope_addr,json=scopeAddr,proto3" json:"scope_addr,omitempty"`
+	// previous_value_owner is the bech32 address string of the previous value owner. It is empty if there wasn't one.
+	PreviousValueOwner string `protobuf:"bytes,2,opt,name=previous_value_owner,json=previousValueOwner,proto3" json:"previous_value_owner,omitempty"`
+	// new_value_owner is the bech32 address string of the new value owner. It is empty if the scope was deleted.
+	NewValueOwner string `protobuf:"bytes,3,opt,name=new_value_owner,json=newValueOwner,proto3" json:"new_value_owner,omitempty"`
+	// signers are the bech32 address strings of the signers of the TX that made the change.
+	Signers []string `protobuf:"bytes,4,rep,name=signers,proto3" json:"signers,omitempty"`
+}
+
+func (m *EventScopeValueOwnerChanged) Reset()         { *m = EventScopeValueOwnerChanged{} }
+func (m *EventScopeValueOwnerChanged) String() string { return proto.CompactTextString(m) }
+func (*EventScopeValueOwnerChanged) ProtoMessage()    {}
+func (*EventScopeValueOwnerChanged) Descriptor() ([]byte, []int) {
 	return fileDescriptor_476cf6cf9459cf25, []int{5}
 }
-func (m *EventSessionUpdated) XXX_Unmarshal(b []byte) error {
+func (m *EventScopeValueOwnerChanged) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventSessionUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EventScopeValueOwnerChanged) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventSessionUpdated.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EventScopeValueOwnerChanged.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -308,52 +331,73 @@ func (m *EventSessionUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *EventSessionUpdated) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventSessionUpdated.Merge(m, src)
+func (m *EventScopeValueOwnerChanged) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventScopeValueOwnerChanged.Merge(m, src)
 }
-func (m *EventSessionUpdated) XXX_Size() int {
+func (m *EventScopeValueOwnerChanged) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventSessionUpdated) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventSessionUpdated.DiscardUnknown(m)
+func (m *EventScopeValueOwnerChanged) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventScopeValueOwnerChanged.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventSessionUpdated proto.InternalMessageInfo
+var xxx_messageInfo_EventScopeValueOwnerChanged proto.InternalMessageInfo
 
-func (m *EventSessionUpdated) GetSessionAddr() string {
+func (m *EventScopeValueOwnerChanged) GetScopeAddr() string {
 	if m != nil {
-		return m.SessionAddr
+		return m.ScopeAddr
 	}
 	return ""
 }
 
-func (m *EventSessionUpdated) GetScopeAddr() string {
+func (m *EventScopeValueOwnerChanged) GetPreviousValueOwner() string {
 	if m != nil {
-		return m.ScopeAddr
+		return m.PreviousValueOwner
 	}
 	return ""
 }
 
-// EventSessionDeleted is an event message indicating a session has been deleted.
-type EventSessionDeleted struct {
-	// session_addr is the bech32 address string of the session id that was deleted.
-	SessionAddr string `protobuf:"bytes,1,opt,name=session_addr,json=sessionAddr,proto3" json:"session_addr,omitempty"`
-	// scope_addr is the bech32 address string of the scope id this session belongs to.
-	ScopeAddr string `protobuf:"bytes,2,opt,name=scope_addr,json=scopeAddr,proto3" json:"scope_addr,omitempty"`
+func (m *EventScopeValueOwnerChanged) GetNewValueOwner() string {
+	if m != nil {
+		return m.NewValueOwner
+	}
+	return ""
 }
 
-func (m *EventSessionDeleted) Reset()         { *m = EventSessionDeleted{} }
-func (m *EventSessionDeleted) String() string { return proto.CompactTextString(m) }
-func (*EventSessionDeleted) ProtoMessage()    {}
-func (*EventSessionDeleted) Descriptor() ([]byte, []int) {
+func (m *EventScopeValueOwnerChanged) GetSigners() []string {
+	if m != nil {
+		return m.Signers
+	}
+	return nil
+}
+
+// EventScopePartySubstituted is an event message indicating a party address has been replaced with another in the
+// open sessions of a scope.
+type EventScopePartySubstituted struct {
+	// scope_addr is the bech32 address string of the scope id with the sessions that were changed.
+	ScopeAddr string `protobuf:"bytes,1,opt,name=scope_addr,json=scopeAddr,proto3" json:"scope_addr,omitempty"`
+	// existing is the bech32 address string of the party that was replaced.
+	Existing string `protobuf:"bytes,2,opt,name=existing,proto3" json:"existing,omitempty"`
+	// replacement is the bech32 address string of the party that took its place.
+	Replacement string `protobuf:"bytes,3,opt,name=replacement,proto3" json:"replacement,omitempty"`
+	// session_addrs are the bech32 address strings of the session ids that were changed.
+	SessionAddrs []string `protobuf:"bytes,4,rep,name=session_addrs,json=sessionAddrs,proto3" json:"session_addrs,omitempty"`
+	// signers are the bech32 address strings of the signers of the TX that made the change.
+	Signers []string `protobuf:"bytes,5,rep,name=signers,proto3" json:"signers,omitempty"`
+}
+
+func (m *EventScopePartySubstituted) Reset()         { *m = EventScopePartySubstituted{} }
+func (m *EventScopePartySubstituted) String() string { return proto.CompactTextString(m) }
+func (*EventScopePartySubstituted) ProtoMessage()    {}
+func (*EventScopePartySubstituted) Descriptor() ([]byte, []int) {
 	return fileDescriptor_476cf6cf9459cf25, []int{6}
 }
-func (m *EventSessionDeleted) XXX_Unmarshal(b []byte) error {
+func (m *EventScopePartySubstituted) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventSessionDeleted) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EventScopePartySubstituted) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventSessionDeleted.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EventScopePartySubstituted.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -363,54 +407,77 @@ func (m *EventSessionDeleted) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *EventSessionDeleted) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventSessionDeleted.Merge(m, src)
+func (m *EventScopePartySubstituted) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventScopePartySubstituted.Merge(m, src)
 }
-func (m *EventSessionDeleted) XXX_Size() int {
+func (m *EventScopePartySubstituted) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventSessionDeleted) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventSessionDeleted.DiscardUnknown(m)
+func (m *EventScopePartySubstituted) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventScopePartySubstituted.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventSessionDeleted proto.InternalMessageInfo
+var xxx_messageInfo_EventScopePartySubstituted proto.InternalMessageInfo
 
-func (m *EventSessionDeleted) GetSessionAddr() string {
+func (m *EventScopePartySubstituted) GetScopeAddr() string {
 	if m != nil {
-		return m.SessionAddr
+		return m.ScopeAddr
 	}
 	return ""
 }
 
-func (m *EventSessionDeleted) GetScopeAddr() string {
+func (m *EventScopePartySubstituted) GetExisting() string {
 	if m != nil {
-		return m.ScopeAddr
+		return m.Existing
 	}
 	return ""
 }
 
-// EventRecordCreated is an event message indicating a record has been created.
-type EventRecordCreated struct {
-	// record_addr is the bech32 address string of the record id that was created.
-	RecordAddr string `protobuf:"bytes,1,opt,name=record_addr,json=recordAddr,proto3" json:"record_addr,omitempty"`
-	// session_addr is the bech32 address string of the session id this record belongs to.
-	SessionAddr string `protobuf:"bytes,2,opt,name=session_addr,json=sessionAddr,proto3" json:"session_addr,omitempty"`
-	// scope_addr is the bech32 address string of the scope id this record belongs to.
-	ScopeAddr string `protobuf:"bytes,3,opt,name=scope_addr,json=scopeAddr,proto3" json:"scope_addr,omitempty"`
+func (m *EventScopePartySubstituted) GetReplacement() string {
+	if m != nil {
+		return m.Replacement
+	}
+	return ""
 }
 
-func (m *EventRecordCreated) Reset()         { *m = EventRecordCreated{} }
-func (m *EventRecordCreated) String() string { return proto.CompactTextString(m) }
-func (*EventRecordCreated) ProtoMessage()    {}
-func (*EventRecordCreated) Descriptor() ([]byte, []int) {
+func (m *EventScopePartySubstituted) GetSessionAddrs() []string {
+	if m != nil {
+		return m.SessionAddrs
+	}
+	return nil
+}
+
+func (m *EventScopePartySubstituted) GetSigners() []string {
+	if m != nil {
+		return m.Signers
+	}
+	return nil
+}
+
+// EventScopeSettlementOpened is an event message indicating a sale of a scope's value ownership has been started.
+type EventScopeSettlementOpened struct {
+	// scope_addr is the bech32 address string of the scope id being sold.
+	ScopeAddr string `protobuf:"bytes,1,opt,name=scope_addr,json=scopeAddr,proto3" json:"scope_addr,omitempty"`
+	// seller is the bech32 address string of the scope's value owner.
+	Seller string `protobuf:"bytes,2,opt,name=seller,proto3" json:"seller,omitempty"`
+	// buyer is the bech32 address string of the account that will become the scope's value owner.
+	Buyer string `protobuf:"bytes,3,opt,name=buyer,proto3" json:"buyer,omitempty"`
+	// price is the funds the buyer will pay the seller.
+	Price string `protobuf:"bytes,4,opt,name=price,proto3" json:"price,omitempty"`
+}
+
+func (m *EventScopeSettlementOpened) Reset()         { *m = EventScopeSettlementOpened{} }
+func (m *EventScopeSettlementOpened) String() string { return proto.CompactTextString(m) }
+func (*EventScopeSettlementOpened) ProtoMessage()    {}
+func (*EventScopeSettlementOpened) Descriptor() ([]byte, []int) {
 	return fileDescriptor_476cf6cf9459cf25, []int{7}
 }
-func (m *EventRecordCreated) XXX_Unmarshal(b []byte) error {
+func (m *EventScopeSettlementOpened) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventRecordCreated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EventScopeSettlementOpened) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventRecordCreated.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EventScopeSettlementOpened.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -420,61 +487,66 @@ func (m *EventRecordCreated) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *EventRecordCreated) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventRecordCreated.Merge(m, src)
+func (m *EventScopeSettlementOpened) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventScopeSettlementOpened.Merge(m, src)
 }
-func (m *EventRecordCreated) XXX_Size() int {
+func (m *EventScopeSettlementOpened) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventRecordCreated) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventRecordCreated.DiscardUnknown(m)
+func (m *EventScopeSettlementOpened) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventScopeSettlementOpened.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventRecordCreated proto.InternalMessageInfo
+var xxx_messageInfo_EventScopeSettlementOpened proto.InternalMessageInfo
 
-func (m *EventRecordCreated) GetRecordAddr() string {
+func (m *EventScopeSettlementOpened) GetScopeAddr() string {
 	if m != nil {
-		return m.RecordAddr
+		return m.ScopeAddr
 	}
 	return ""
 }
 
-func (m *EventRecordCreated) GetSessionAddr() string {
+func (m *EventScopeSettlementOpened) GetSeller() string {
 	if m != nil {
-		return m.SessionAddr
+		return m.Seller
 	}
 	return ""
 }
 
-func (m *EventRecordCreated) GetScopeAddr() string {
+func (m *EventScopeSettlementOpened) GetBuyer() string {
 	if m != nil {
-		return m.ScopeAddr
+		return m.Buyer
 	}
 	return ""
 }
 
-// EventRecordUpdated is an event message indicating a record has been updated.
-type EventRecordUpdated struct {
-	// record_addr is the bech32 address string of the record id that was updated.
-	RecordAddr string `protobuf:"bytes,1,opt,name=record_addr,json=recordAddr,proto3" json:"record_addr,omitempty"`
-	// session_addr is the bech32 address string of the session id this record belongs to.
-	SessionAddr string `protobuf:"bytes,2,opt,name=session_addr,json=sessionAddr,proto3" json:"session_addr,omitempty"`
-	// scope_addr is the bech32 address string of the scope id this record belongs to.
-	ScopeAddr string `protobuf:"bytes,3,opt,name=scope_addr,json=scopeAddr,proto3" json:"scope_addr,omitempty"`
+func (m *EventScopeSettlementOpened) GetPrice() string {
+	if m != nil {
+		return m.Price
+	}
+	return ""
 }
 
-func (m *EventRecordUpdated) Reset()         { *m = EventRecordUpdated{} }
-func (m *EventRecordUpdated) String() string { return proto.CompactTextString(m) }
-func (*EventRecordUpdated) ProtoMessage()    {}
-func (*EventRecordUpdated) Descriptor() ([]byte, []int) {
+// EventScopeSettlementFunded is an event message indicating the buyer has put the price of a scope settlement on hold.
+type EventScopeSettlementFunded struct {
+	// scope_addr is the bech32 address string of the scope id being sold.
+	ScopeAddr string `protobuf:"bytes,1,opt,name=scope_addr,json=scopeAddr,proto3" json:"scope_addr,omitempty"`
+	// buyer is the bech32 address string of the buyer.
+	Buyer string `protobuf:"bytes,2,opt,name=buyer,proto3" json:"buyer,omitempty"`
+}
+
+func (m *EventScopeSettlementFunded) Reset()         { *m = EventScopeSettlementFunded{} }
+func (m *EventScopeSettlementFunded) String() string { return proto.CompactTextString(m) }
+func (*EventScopeSettlementFunded) ProtoMessage()    {}
+func (*EventScopeSettlementFunded) Descriptor() ([]byte, []int) {
 	return fileDescriptor_476cf6cf9459cf25, []int{8}
 }
-func (m *EventRecordUpdated) XXX_Unmarshal(b []byte) error {
+func (m *EventScopeSettlementFunded) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventRecordUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EventScopeSettlementFunded) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventRecordUpdated.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EventScopeSettlementFunded.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -484,59 +556,56 @@ func (m *EventRecordUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *EventRecordUpdated) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventRecordUpdated.Merge(m, src)
+func (m *EventScopeSettlementFunded) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventScopeSettlementFunded.Merge(m, src)
 }
-func (m *EventRecordUpdated) XXX_Size() int {
+func (m *EventScopeSettlementFunded) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventRecordUpdated) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventRecordUpdated.DiscardUnknown(m)
+func (m *EventScopeSettlementFunded) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventScopeSettlementFunded.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventRecordUpdated proto.InternalMessageInfo
-
-func (m *EventRecordUpdated) GetRecordAddr() string {
-	if m != nil {
-		return m.RecordAddr
-	}
-	return ""
-}
+var xxx_messageInfo_EventScopeSettlementFunded proto.InternalMessageInfo
 
-func (m *EventRecordUpdated) GetSessionAddr() string {
+func (m *EventScopeSettlementFunded) GetScopeAddr() string {
 	if m != nil {
-		return m.SessionAddr
+		return m.ScopeAddr
 	}
 	return ""
 }
 
-func (m *EventRecordUpdated) GetScopeAddr() string {
+func (m *EventScopeSettlementFunded) GetBuyer() string {
 	if m != nil {
-		return m.ScopeAddr
+		return m.Buyer
 	}
 	return ""
 }
 
-// EventRecordDeleted is an event message indicating a record has been deleted.
-type EventRecordDeleted struct {
-	// record is the bech32 address string of the record id that was deleted.
-	RecordAddr string `protobuf:"bytes,1,opt,name=record_addr,json=recordAddr,proto3" json:"record_addr,omitempty"`
-	// scope_addr is the bech32 address string of the scope id this record belonged to.
-	ScopeAddr string `protobuf:"bytes,3,opt,name=scope_addr,json=scopeAddr,proto3" json:"scope_addr,omitempty"`
-}
-
-func (m *EventRecordDeleted) Reset()         { *m = EventRecordDeleted{} }
-func (m *EventRecordDeleted) String() string { return proto.CompactTextString(m) }
-func (*EventRecordDeleted) ProtoMessage()    {}
-func (*EventRecordDeleted) Descriptor() ([]byte, []int) {
+// EventScopeSettlementSettled is an event message indicating a scope's value ownership has been sold.
+type EventScopeSettlementSettled struct {
+	// scope_addr is the bech32 address string of the scope id that was sold.
+	ScopeAddr string `protobuf:"bytes,1,opt,name=scope_addr,json=scopeAddr,proto3" json:"scope_addr,omitempty"`
+	// seller is the bech32 address string of the previous value owner.
+	Seller string `protobuf:"bytes,2,opt,name=seller,proto3" json:"seller,omitempty"`
+	// buyer is the bech32 address string of the new value owner.
+	Buyer string `protobuf:"bytes,3,opt,name=buyer,proto3" json:"buyer,omitempty"`
+	// price is the funds the buyer paid the seller.
+	Price string `protobuf:"bytes,4,opt,name=price,proto3" json:"price,omitempty"`
+}
+
+func (m *EventScopeSettlementSettled) Reset()         { *m = EventScopeSettlementSettled{} }
+func (m *EventScopeSettlementSettled) String() string { return proto.CompactTextString(m) }
+func (*EventScopeSettlementSettled) ProtoMessage()    {}
+func (*EventScopeSettlementSettled) Descriptor() ([]byte, []int) {
 	return fileDescriptor_476cf6cf9459cf25, []int{9}
 }
-func (m *EventRecordDeleted) XXX_Unmarshal(b []byte) error {
+func (m *EventScopeSettlementSettled) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventRecordDeleted) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EventScopeSettlementSettled) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventRecordDeleted.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EventScopeSettlementSettled.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -546,51 +615,66 @@ func (m *EventRecordDeleted) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *EventRecordDeleted) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventRecordDeleted.Merge(m, src)
+func (m *EventScopeSettlementSettled) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventScopeSettlementSettled.Merge(m, src)
 }
-func (m *EventRecordDeleted) XXX_Size() int {
+func (m *EventScopeSettlementSettled) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventRecordDeleted) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventRecordDeleted.DiscardUnknown(m)
+func (m *EventScopeSettlementSettled) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventScopeSettlementSettled.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventRecordDeleted proto.InternalMessageInfo
+var xxx_messageInfo_EventScopeSettlementSettled proto.InternalMessageInfo
 
-func (m *EventRecordDeleted) GetRecordAddr() string {
+func (m *EventScopeSettlementSettled) GetScopeAddr() string {
 	if m != nil {
-		return m.RecordAddr
+		return m.ScopeAddr
 	}
 	return ""
 }
 
-func (m *EventRecordDeleted) GetScopeAddr() string {
+func (m *EventScopeSettlementSettled) GetSeller() string {
 	if m != nil {
-		return m.ScopeAddr
+		return m.Seller
 	}
 	return ""
 }
 
-// EventScopeSpecificationCreated is an event message indicating a scope specification has been created.
-type EventScopeSpecificationCreated struct {
-	// scope_specification_addr is the bech32 address string of the specification id of the scope specification that was
-	// created.
-	ScopeSpecificationAddr string `protobuf:"bytes,1,opt,name=scope_specification_addr,json=scopeSpecificationAddr,proto3" json:"scope_specification_addr,omitempty"`
+func (m *EventScopeSettlementSettled) GetBuyer() string {
+	if m != nil {
+		return m.Buyer
+	}
+	return ""
 }
 
-func (m *EventScopeSpecificationCreated) Reset()         { *m = EventScopeSpecificationCreated{} }
-func (m *EventScopeSpecificationCreated) String() string { return proto.CompactTextString(m) }
-func (*EventScopeSpecificationCreated) ProtoMessage()    {}
-func (*EventScopeSpecificationCreated) Descriptor() ([]byte, []int) {
+func (m *EventScopeSettlementSettled) GetPrice() string {
+	if m != nil {
+		return m.Price
+	}
+	return ""
+}
+
+// EventScopeSettlementCancelled is an event message indicating a sale of a scope's value ownership has been cancelled.
+type EventScopeSettlementCancelled struct {
+	// scope_addr is the bech32 address string of the scope id that was being sold.
+	ScopeAddr string `protobuf:"bytes,1,opt,name=scope_addr,json=scopeAddr,proto3" json:"scope_addr,omitempty"`
+	// cancelled_by is the bech32 address string of the account that cancelled it.
+	CancelledBy string `protobuf:"bytes,2,opt,name=cancelled_by,json=cancelledBy,proto3" json:"cancelled_by,omitempty"`
+}
+
+func (m *EventScopeSettlementCancelled) Reset()         { *m = EventScopeSettlementCancelled{} }
+func (m *EventScopeSettlementCancelled) String() string { return proto.CompactTextString(m) }
+func (*EventScopeSettlementCancelled) ProtoMessage()    {}
+func (*EventScopeSettlementCancelled) Descriptor() ([]byte, []int) {
 	return fileDescriptor_476cf6cf9459cf25, []int{10}
 }
-func (m *EventScopeSpecificationCreated) XXX_Unmarshal(b []byte) error {
+func (m *EventScopeSettlementCancelled) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventScopeSpecificationCreated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EventScopeSettlementCancelled) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventScopeSpecificationCreated.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EventScopeSettlementCancelled.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -600,44 +684,52 @@ func (m *EventScopeSpecificationCreated) XXX_Marshal(b []byte, deterministic boo
 		return b[:n], nil
 	}
 }
-func (m *EventScopeSpecificationCreated) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventScopeSpecificationCreated.Merge(m, src)
+func (m *EventScopeSettlementCancelled) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventScopeSettlementCancelled.Merge(m, src)
 }
-func (m *EventScopeSpecificationCreated) XXX_Size() int {
+func (m *EventScopeSettlementCancelled) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventScopeSpecificationCreated) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventScopeSpecificationCreated.DiscardUnknown(m)
+func (m *EventScopeSettlementCancelled) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventScopeSettlementCancelled.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventScopeSpecificationCreated proto.InternalMessageInfo
+var xxx_messageInfo_EventScopeSettlementCancelled proto.InternalMessageInfo
 
-func (m *EventScopeSpecificationCreated) GetScopeSpecificationAddr() string {
+func (m *EventScopeSettlementCancelled) GetScopeAddr() string {
 	if m != nil {
-		return m.ScopeSpecificationAddr
+		return m.ScopeAddr
 	}
 	return ""
 }
 
-// EventScopeSpecificationUpdated is an event message indicating a scope specification has been updated.
-type EventScopeSpecificationUpdated struct {
-	// scope_specification_addr is the bech32 address string of the specification id of the scope specification that was
-	// updated.
-	ScopeSpecificationAddr string `protobuf:"bytes,1,opt,name=scope_specification_addr,json=scopeSpecificationAddr,proto3" json:"scope_specification_addr,omitempty"`
+func (m *EventScopeSettlementCancelled) GetCancelledBy() string {
+	if m != nil {
+		return m.CancelledBy
+	}
+	return ""
 }
 
-func (m *EventScopeSpecificationUpdated) Reset()         { *m = EventScopeSpecificationUpdated{} }
-func (m *EventScopeSpecificationUpdated) String() string { return proto.CompactTextString(m) }
-func (*EventScopeSpecificationUpdated) ProtoMessage()    {}
-func (*EventScopeSpecificationUpdated) Descriptor() ([]byte, []int) {
-	return fileDescriptor_476cf6cf9459cf25, []int{11}
-}
-func (m *EventScopeSpecificationUpdated) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
+// EventSessionCreated is an event message indicating a session has been created.
+type EventSessionCreated struct {
+	// session_addr is the bech32 address string of the session id that was created.
+	SessionAddr string `protobuf:"bytes,1,opt,name=session_addr,json=sessionAddr,proto3" json:"session_addr,omitempty"`
+	// scope_addr is the bech32 address string of the scope id this session belongs to.
+	ScopeAddr string `protobuf:"bytes,2,opt,name=scope_addr,json=scopeAddr,proto3" json:"scope_addr,omitempty"`
 }
-func (m *EventScopeSpecificationUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+
+func (m *EventSessionCreated) Reset()         { *m = EventSessionCreated{} }
+func (m *EventSessionCreated) String() string { return proto.CompactTextString(m) }
+func (*EventSessionCreated) ProtoMessage()    {}
+func (*EventSessionCreated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_476cf6cf9459cf25, []int{11}
+}
+func (m *EventSessionCreated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventSessionCreated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventScopeSpecificationUpdated.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EventSessionCreated.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -647,44 +739,52 @@ func (m *EventScopeSpecificationUpdated) XXX_Marshal(b []byte, deterministic boo
 		return b[:n], nil
 	}
 }
-func (m *EventScopeSpecificationUpdated) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventScopeSpecificationUpdated.Merge(m, src)
+func (m *EventSessionCreated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventSessionCreated.Merge(m, src)
 }
-func (m *EventScopeSpecificationUpdated) XXX_Size() int {
+func (m *EventSessionCreated) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventScopeSpecificationUpdated) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventScopeSpecificationUpdated.DiscardUnknown(m)
+func (m *EventSessionCreated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventSessionCreated.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventScopeSpecificationUpdated proto.InternalMessageInfo
+var xxx_messageInfo_EventSessionCreated proto.InternalMessageInfo
 
-func (m *EventScopeSpecificationUpdated) GetScopeSpecificationAddr() string {
+func (m *EventSessionCreated) GetSessionAddr() string {
 	if m != nil {
-		return m.ScopeSpecificationAddr
+		return m.SessionAddr
 	}
 	return ""
 }
 
-// EventScopeSpecificationDeleted is an event message indicating a scope specification has been deleted.
-type EventScopeSpecificationDeleted struct {
-	// scope_specification_addr is the bech32 address string of the specification id of the scope specification that was
-	// deleted.
-	ScopeSpecificationAddr string `protobuf:"bytes,1,opt,name=scope_specification_addr,json=scopeSpecificationAddr,proto3" json:"scope_specification_addr,omitempty"`
+func (m *EventSessionCreated) GetScopeAddr() string {
+	if m != nil {
+		return m.ScopeAddr
+	}
+	return ""
 }
 
-func (m *EventScopeSpecificationDeleted) Reset()         { *m = EventScopeSpecificationDeleted{} }
-func (m *EventScopeSpecificationDeleted) String() string { return proto.CompactTextString(m) }
-func (*EventScopeSpecificationDeleted) ProtoMessage()    {}
-func (*EventScopeSpecificationDeleted) Descriptor() ([]byte, []int) {
+// EventSessionUpdated is an event message indicating a session has been updated.
+type EventSessionUpdated struct {
+	// session_addr is the bech32 address string of the session id that was updated.
+	SessionAddr string `protobuf:"bytes,1,opt,name=session_addr,json=sessionAddr,proto3" json:"session_addr,omitempty"`
+	// scope_addr is the bech32 address string of the scope id this session belongs to.
+	ScopeAddr string `protobuf:"bytes,2,opt,name=scope_addr,json=scopeAddr,proto3" json:"scope_addr,omitempty"`
+}
+
+func (m *EventSessionUpdated) Reset()         { *m = EventSessionUpdated{} }
+func (m *EventSessionUpdated) String() string { return proto.CompactTextString(m) }
+func (*EventSessionUpdated) ProtoMessage()    {}
+func (*EventSessionUpdated) Descriptor() ([]byte, []int) {
 	return fileDescriptor_476cf6cf9459cf25, []int{12}
 }
-func (m *EventScopeSpecificationDeleted) XXX_Unmarshal(b []byte) error {
+func (m *EventSessionUpdated) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventScopeSpecificationDeleted) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EventSessionUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventScopeSpecificationDeleted.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EventSessionUpdated.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -694,44 +794,56 @@ func (m *EventScopeSpecificationDeleted) XXX_Marshal(b []byte, deterministic boo
 		return b[:n], nil
 	}
 }
-func (m *EventScopeSpecificationDeleted) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventScopeSpecificationDeleted.Merge(m, src)
+func (m *EventSessionUpdated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventSessionUpdated.Merge(m, src)
 }
-func (m *EventScopeSpecificationDeleted) XXX_Size() int {
+func (m *EventSessionUpdated) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventScopeSpecificationDeleted) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventScopeSpecificationDeleted.DiscardUnknown(m)
+func (m *EventSessionUpdated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventSessionUpdated.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventScopeSpecificationDeleted proto.InternalMessageInfo
+var xxx_messageInfo_EventSessionUpdated proto.InternalMessageInfo
 
-func (m *EventScopeSpecificationDeleted) GetScopeSpecificationAddr() string {
+func (m *EventSessionUpdated) GetSessionAddr() string {
 	if m != nil {
-		return m.ScopeSpecificationAddr
+		return m.SessionAddr
 	}
 	return ""
 }
 
-// EventContractSpecificationCreated is an event message indicating a contract specification has been created.
-type EventContractSpecificationCreated struct {
-	// contract_specification_addr is the bech32 address string of the specification id of the contract specification that
-	// was created.
-	ContractSpecificationAddr string `protobuf:"bytes,1,opt,name=contract_specification_addr,json=contractSpecificationAddr,proto3" json:"contract_specification_addr,omitempty"`
+func (m *EventSessionUpdated) GetScopeAddr() string {
+	if m != nil {
+		return m.ScopeAddr
+	}
+	return ""
 }
 
-func (m *EventContractSpecificationCreated) Reset()         { *m = EventContractSpecificationCreated{} }
-func (m *EventContractSpecificationCreated) String() string { return proto.CompactTextString(m) }
-func (*EventContractSpecificationCreated) ProtoMessage()    {}
-func (*EventContractSpecificationCreated) Descriptor() ([]byte, []int) {
+// EventSessionStatusChanged is an event message indicating a session has been completed or aborted.
+type EventSessionStatusChanged struct {
+	// session_addr is the bech32 address string of the session id that was changed.
+	SessionAddr string `protobuf:"bytes,1,opt,name=session_addr,json=sessionAddr,proto3" json:"session_addr,omitempty"`
+	// scope_addr is the bech32 address string of the scope id this session belongs to.
+	ScopeAddr string `protobuf:"bytes,2,opt,name=scope_addr,json=scopeAddr,proto3" json:"scope_addr,omitempty"`
+	// previous_status is the name of the session's previous status.
+	PreviousStatus string `protobuf:"bytes,3,opt,name=previous_status,json=previousStatus,proto3" json:"previous_status,omitempty"`
+	// new_status is the name of the session's new status.
+	NewStatus string `protobuf:"bytes,4,opt,name=new_status,json=newStatus,proto3" json:"new_status,omitempty"`
+}
+
+func (m *EventSessionStatusChanged) Reset()         { *m = EventSessionStatusChanged{} }
+func (m *EventSessionStatusChanged) String() string { return proto.CompactTextString(m) }
+func (*EventSessionStatusChanged) ProtoMessage()    {}
+func (*EventSessionStatusChanged) Descriptor() ([]byte, []int) {
 	return fileDescriptor_476cf6cf9459cf25, []int{13}
 }
-func (m *EventContractSpecificationCreated) XXX_Unmarshal(b []byte) error {
+func (m *EventSessionStatusChanged) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventContractSpecificationCreated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EventSessionStatusChanged) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventContractSpecificationCreated.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EventSessionStatusChanged.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -741,91 +853,66 @@ func (m *EventContractSpecificationCreated) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *EventContractSpecificationCreated) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventContractSpecificationCreated.Merge(m, src)
+func (m *EventSessionStatusChanged) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventSessionStatusChanged.Merge(m, src)
 }
-func (m *EventContractSpecificationCreated) XXX_Size() int {
+func (m *EventSessionStatusChanged) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventContractSpecificationCreated) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventContractSpecificationCreated.DiscardUnknown(m)
+func (m *EventSessionStatusChanged) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventSessionStatusChanged.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventContractSpecificationCreated proto.InternalMessageInfo
+var xxx_messageInfo_EventSessionStatusChanged proto.InternalMessageInfo
 
-func (m *EventContractSpecificationCreated) GetContractSpecificationAddr() string {
+func (m *EventSessionStatusChanged) GetSessionAddr() string {
 	if m != nil {
-		return m.ContractSpecificationAddr
+		return m.SessionAddr
 	}
 	return ""
 }
 
-// EventContractSpecificationUpdated is an event message indicating a contract specification has been updated.
-type EventContractSpecificationUpdated struct {
-	// contract_specification_addr is the bech32 address string of the specification id of the contract specification that
-	// was updated.
-	ContractSpecificationAddr string `protobuf:"bytes,1,opt,name=contract_specification_addr,json=contractSpecificationAddr,proto3" json:"contract_specification_addr,omitempty"`
+func (m *EventSessionStatusChanged) GetScopeAddr() string {
+	if m != nil {
+		return m.ScopeAddr
+	}
+	return ""
 }
 
-func (m *EventContractSpecificationUpdated) Reset()         { *m = EventContractSpecificationUpdated{} }
-func (m *EventContractSpecificationUpdated) String() string { return proto.CompactTextString(m) }
-func (*EventContractSpecificationUpdated) ProtoMessage()    {}
-func (*EventContractSpecificationUpdated) Descriptor() ([]byte, []int) {
-	return fileDescriptor_476cf6cf9459cf25, []int{14}
-}
-func (m *EventContractSpecificationUpdated) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *EventContractSpecificationUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_EventContractSpecificationUpdated.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+func (m *EventSessionStatusChanged) GetPreviousStatus() string {
+	if m != nil {
+		return m.PreviousStatus
 	}
+	return ""
 }
-func (m *EventContractSpecificationUpdated) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventContractSpecificationUpdated.Merge(m, src)
-}
-func (m *EventContractSpecificationUpdated) XXX_Size() int {
-	return m.Size()
-}
-func (m *EventContractSpecificationUpdated) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventContractSpecificationUpdated.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_EventContractSpecificationUpdated proto.InternalMessageInfo
 
-func (m *EventContractSpecificationUpdated) GetContractSpecificationAddr() string {
+func (m *EventSessionStatusChanged) GetNewStatus() string {
 	if m != nil {
-		return m.ContractSpecificationAddr
+		return m.NewStatus
 	}
 	return ""
 }
 
-// EventContractSpecificationDeleted is an event message indicating a contract specification has been deleted.
-type EventContractSpecificationDeleted struct {
-	// contract_specification_addr is the bech32 address string of the specification id of the contract specification that
-	// was deleted.
-	ContractSpecificationAddr string `protobuf:"bytes,1,opt,name=contract_specification_addr,json=contractSpecificationAddr,proto3" json:"contract_specification_addr,omitempty"`
+// EventSessionDeleted is an event message indicating a session has been deleted.
+type EventSessionDeleted struct {
+	// session_addr is the bech32 address string of the session id that was deleted.
+	SessionAddr string `protobuf:"bytes,1,opt,name=session_addr,json=sessionAddr,proto3" json:"session_addr,omitempty"`
+	// scope_addr is the bech32 address string of the scope id this session belongs to.
+	ScopeAddr string `protobuf:"bytes,2,opt,name=scope_addr,json=scopeAddr,proto3" json:"scope_addr,omitempty"`
 }
 
-func (m *EventContractSpecificationDeleted) Reset()         { *m = EventContractSpecificationDeleted{} }
-func (m *EventContractSpecificationDeleted) String() string { return proto.CompactTextString(m) }
-func (*EventContractSpecificationDeleted) ProtoMessage()    {}
-func (*EventContractSpecificationDeleted) Descriptor() ([]byte, []int) {
-	return fileDescriptor_476cf6cf9459cf25, []int{15}
+func (m *EventSessionDeleted) Reset()         { *m = EventSessionDeleted{} }
+func (m *EventSessionDeleted) String() string { return proto.CompactTextString(m) }
+func (*EventSessionDeleted) ProtoMessage()    {}
+func (*EventSessionDeleted) Descriptor() ([]byte, []int) {
+	return fileDescriptor_476cf6cf9459cf25, []int{14}
 }
-func (m *EventContractSpecificationDeleted) XXX_Unmarshal(b []byte) error {
+func (m *EventSessionDeleted) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventContractSpecificationDeleted) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EventSessionDeleted) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventContractSpecificationDeleted.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EventSessionDeleted.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -835,47 +922,54 @@ func (m *EventContractSpecificationDeleted) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *EventContractSpecificationDeleted) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventContractSpecificationDeleted.Merge(m, src)
+func (m *EventSessionDeleted) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventSessionDeleted.Merge(m, src)
 }
-func (m *EventContractSpecificationDeleted) XXX_Size() int {
+func (m *EventSessionDeleted) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventContractSpecificationDeleted) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventContractSpecificationDeleted.DiscardUnknown(m)
+func (m *EventSessionDeleted) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventSessionDeleted.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventContractSpecificationDeleted proto.InternalMessageInfo
+var xxx_messageInfo_EventSessionDeleted proto.InternalMessageInfo
 
-func (m *EventContractSpecificationDeleted) GetContractSpecificationAddr() string {
+func (m *EventSessionDeleted) GetSessionAddr() string {
 	if m != nil {
-		return m.ContractSpecificationAddr
+		return m.SessionAddr
 	}
 	return ""
 }
 
-// EventRecordSpecificationCreated is an event message indicating a record specification has been created.
-type EventRecordSpecificationCreated struct {
-	// record_specification_addr is the bech32 address string of the specification id of the record specification that was
-	// created.
-	RecordSpecificationAddr string `protobuf:"bytes,1,opt,name=record_specification_addr,json=recordSpecificationAddr,proto3" json:"record_specification_addr,omitempty"`
-	// contract_specification_addr is the bech32 address string of the contract specification id this record specification
-	// belongs to.
-	ContractSpecificationAddr string `protobuf:"bytes,2,opt,name=contract_specification_addr,json=contractSpecificationAddr,proto3" json:"contract_specification_addr,omitempty"`
+func (m *EventSessionDeleted) GetScopeAddr() string {
+	if m != nil {
+		return m.ScopeAddr
+	}
+	return ""
 }
 
-func (m *EventRecordSpecificationCreated) Reset()         { *m = EventRecordSpecificationCreated{} }
-func (m *EventRecordSpecificationCreated) String() string { return proto.CompactTextString(m) }
-func (*EventRecordSpecificationCreated) ProtoMessage()    {}
-func (*EventRecordSpecificationCreated) Descriptor() ([]byte, []int) {
-	return fileDescriptor_476cf6cf9459cf25, []int{16}
+// EventRecordCreated is an event message indicating a record has been created.
+type EventRecordCreated struct {
+	// record_addr is the bech32 address string of the record id that was created.
+	RecordAddr string `protobuf:"bytes,1,opt,name=record_addr,json=recordAddr,proto3" json:"record_addr,omitempty"`
+	// session_addr is the bech32 address string of the session id this record belongs to.
+	SessionAddr string `protobuf:"bytes,2,opt,name=session_addr,json=sessionAddr,proto3" json:"session_addr,omitempty"`
+	// scope_addr is the bech32 address string of the scope id this record belongs to.
+	ScopeAddr string `protobuf:"bytes,3,opt,name=scope_addr,json=scopeAddr,proto3" json:"scope_addr,omitempty"`
 }
-func (m *EventRecordSpecificationCreated) XXX_Unmarshal(b []byte) error {
+
+func (m *EventRecordCreated) Reset()         { *m = EventRecordCreated{} }
+func (m *EventRecordCreated) String() string { return proto.CompactTextString(m) }
+func (*EventRecordCreated) ProtoMessage()    {}
+func (*EventRecordCreated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_476cf6cf9459cf25, []int{15}
+}
+func (m *EventRecordCreated) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventRecordSpecificationCreated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EventRecordCreated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventRecordSpecificationCreated.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EventRecordCreated.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -885,54 +979,61 @@ func (m *EventRecordSpecificationCreated) XXX_Marshal(b []byte, deterministic bo
 		return b[:n], nil
 	}
 }
-func (m *EventRecordSpecificationCreated) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventRecordSpecificationCreated.Merge(m, src)
+func (m *EventRecordCreated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventRecordCreated.Merge(m, src)
 }
-func (m *EventRecordSpecificationCreated) XXX_Size() int {
+func (m *EventRecordCreated) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventRecordSpecificationCreated) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventRecordSpecificationCreated.DiscardUnknown(m)
+func (m *EventRecordCreated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventRecordCreated.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventRecordSpecificationCreated proto.InternalMessageInfo
+var xxx_messageInfo_EventRecordCreated proto.InternalMessageInfo
 
-func (m *EventRecordSpecificationCreated) GetRecordSpecificationAddr() string {
+func (m *EventRecordCreated) GetRecordAddr() string {
 	if m != nil {
-		return m.RecordSpecificationAddr
+		return m.RecordAddr
 	}
 	return ""
 }
 
-func (m *EventRecordSpecificationCreated) GetContractSpecificationAddr() string {
+func (m *EventRecordCreated) GetSessionAddr() string {
 	if m != nil {
-		return m.ContractSpecificationAddr
+		return m.SessionAddr
 	}
 	return ""
 }
 
-// EventRecordSpecificationUpdated is an event message indicating a record specification has been updated.
-type EventRecordSpecificationUpdated struct {
-	// record_specification_addr is the bech32 address string of the specification id of the record specification that was
-	// updated.
-	RecordSpecificationAddr string `protobuf:"bytes,1,opt,name=record_specification_addr,json=recordSpecificationAddr,proto3" json:"record_specification_addr,omitempty"`
-	// contract_specification_addr is the bech32 address string of the contract specification id this record specification
-	// belongs to.
-	ContractSpecificationAddr string `protobuf:"bytes,2,opt,name=contract_specification_addr,json=contractSpecificationAddr,proto3" json:"contract_specification_addr,omitempty"`
+func (m *EventRecordCreated) GetScopeAddr() string {
+	if m != nil {
+		return m.ScopeAddr
+	}
+	return ""
 }
 
-func (m *EventRecordSpecificationUpdated) Reset()         { *m = EventRecordSpecificationUpdated{} }
-func (m *EventRecordSpecificationUpdated) String() string { return proto.CompactTextString(m) }
-func (*EventRecordSpecificationUpdated) ProtoMessage()    {}
-func (*EventRecordSpecificationUpdated) Descriptor() ([]byte, []int) {
-	return fileDescriptor_476cf6cf9459cf25, []int{17}
+// EventRecordUpdated is an event message indicating a record has been updated.
+type EventRecordUpdated struct {
+	// record_addr is the bech32 address string of the record id that was updated.
+	RecordAddr string `protobuf:"bytes,1,opt,name=record_addr,json=recordAddr,proto3" json:"record_addr,omitempty"`
+	// session_addr is the bech32 address string of the session id this record belongs to.
+	SessionAddr string `protobuf:"bytes,2,opt,name=session_addr,json=sessionAddr,proto3" json:"session_addr,omitempty"`
+	// scope_addr is the bech32 address string of the scope id this record belongs to.
+	ScopeAddr string `protobuf:"bytes,3,opt,name=scope_addr,json=scopeAddr,proto3" json:"scope_addr,omitempty"`
 }
-func (m *EventRecordSpecificationUpdated) XXX_Unmarshal(b []byte) error {
+
+func (m *EventRecordUpdated) Reset()         { *m = EventRecordUpdated{} }
+func (m *EventRecordUpdated) String() string { return proto.CompactTextString(m) }
+func (*EventRecordUpdated) ProtoMessage()    {}
+func (*EventRecordUpdated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_476cf6cf9459cf25, []int{16}
+}
+func (m *EventRecordUpdated) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventRecordSpecificationUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EventRecordUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventRecordSpecificationUpdated.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EventRecordUpdated.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -942,54 +1043,59 @@ func (m *EventRecordSpecificationUpdated) XXX_Marshal(b []byte, deterministic bo
 		return b[:n], nil
 	}
 }
-func (m *EventRecordSpecificationUpdated) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventRecordSpecificationUpdated.Merge(m, src)
+func (m *EventRecordUpdated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventRecordUpdated.Merge(m, src)
 }
-func (m *EventRecordSpecificationUpdated) XXX_Size() int {
+func (m *EventRecordUpdated) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventRecordSpecificationUpdated) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventRecordSpecificationUpdated.DiscardUnknown(m)
+func (m *EventRecordUpdated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventRecordUpdated.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventRecordSpecificationUpdated proto.InternalMessageInfo
+var xxx_messageInfo_EventRecordUpdated proto.InternalMessageInfo
 
-func (m *EventRecordSpecificationUpdated) GetRecordSpecificationAddr() string {
+func (m *EventRecordUpdated) GetRecordAddr() string {
 	if m != nil {
-		return m.RecordSpecificationAddr
+		return m.RecordAddr
 	}
 	return ""
 }
 
-func (m *EventRecordSpecificationUpdated) GetContractSpecificationAddr() string {
+func (m *EventRecordUpdated) GetSessionAddr() string {
 	if m != nil {
-		return m.ContractSpecificationAddr
+		return m.SessionAddr
 	}
 	return ""
 }
 
-// EventRecordSpecificationDeleted is an event message indicating a record specification has been deleted.
-type EventRecordSpecificationDeleted struct {
-	// record_specification_addr is the bech32 address string of the specification id of the record specification that was
-	// deleted.
-	RecordSpecificationAddr string `protobuf:"bytes,1,opt,name=record_specification_addr,json=recordSpecificationAddr,proto3" json:"record_specification_addr,omitempty"`
-	// contract_specification_addr is the bech32 address string of the contract specification id this record specification
-	// belongs to.
-	ContractSpecificationAddr string `protobuf:"bytes,2,opt,name=contract_specification_addr,json=contractSpecificationAddr,proto3" json:"contract_specification_addr,omitempty"`
+func (m *EventRecordUpdated) GetScopeAddr() string {
+	if m != nil {
+		return m.ScopeAddr
+	}
+	return ""
 }
 
-func (m *EventRecordSpecificationDeleted) Reset()         { *m = EventRecordSpecificationDeleted{} }
-func (m *EventRecordSpecificationDeleted) String() string { return proto.CompactTextString(m) }
-func (*EventRecordSpecificationDeleted) ProtoMessage()    {}
-func (*EventRecordSpecificationDeleted) Descriptor() ([]byte, []int) {
-	return fileDescriptor_476cf6cf9459cf25, []int{18}
+// EventRecordDeleted is an event message indicating a record has been deleted.
+type EventRecordDeleted struct {
+	// record is the bech32 address string of the record id that was deleted.
+	RecordAddr string `protobuf:"bytes,1,opt,name=record_addr,json=recordAddr,proto3" json:"record_addr,omitempty"`
+	// scope_addr is the bech32 address string of the scope id this record belonged to.
+	ScopeAddr string `protobuf:"bytes,3,opt,name=scope_addr,json=scopeAddr,proto3" json:"scope_addr,omitempty"`
 }
-func (m *EventRecordSpecificationDeleted) XXX_Unmarshal(b []byte) error {
+
+func (m *EventRecordDeleted) Reset()         { *m = EventRecordDeleted{} }
+func (m *EventRecordDeleted) String() string { return proto.CompactTextString(m) }
+func (*EventRecordDeleted) ProtoMessage()    {}
+func (*EventRecordDeleted) Descriptor() ([]byte, []int) {
+	return fileDescriptor_476cf6cf9459cf25, []int{17}
+}
+func (m *EventRecordDeleted) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventRecordSpecificationDeleted) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EventRecordDeleted) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventRecordSpecificationDeleted.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EventRecordDeleted.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -999,50 +1105,51 @@ func (m *EventRecordSpecificationDeleted) XXX_Marshal(b []byte, deterministic bo
 		return b[:n], nil
 	}
 }
-func (m *EventRecordSpecificationDeleted) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventRecordSpecificationDeleted.Merge(m, src)
+func (m *EventRecordDeleted) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventRecordDeleted.Merge(m, src)
 }
-func (m *EventRecordSpecificationDeleted) XXX_Size() int {
+func (m *EventRecordDeleted) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventRecordSpecificationDeleted) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventRecordSpecificationDeleted.DiscardUnknown(m)
+func (m *EventRecordDeleted) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventRecordDeleted.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventRecordSpecificationDeleted proto.InternalMessageInfo
+var xxx_messageInfo_EventRecordDeleted proto.InternalMessageInfo
 
-func (m *EventRecordSpecificationDeleted) GetRecordSpecificationAddr() string {
+func (m *EventRecordDeleted) GetRecordAddr() string {
 	if m != nil {
-		return m.RecordSpecificationAddr
+		return m.RecordAddr
 	}
 	return ""
 }
 
-func (m *EventRecordSpecificationDeleted) GetContractSpecificationAddr() string {
+func (m *EventRecordDeleted) GetScopeAddr() string {
 	if m != nil {
-		return m.ContractSpecificationAddr
+		return m.ScopeAddr
 	}
 	return ""
 }
 
-// EventOSLocatorCreated is an event message indicating an object store locator has been created.
-type EventOSLocatorCreated struct {
-	// owner is the owner in the object store locator that was created.
-	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+// EventScopeSpecificationCreated is an event message indicating a scope specification has been created.
+type EventScopeSpecificationCreated struct {
+	// scope_specification_addr is the bech32 address string of the specification id of the scope specification that was
+	// created.
+	ScopeSpecificationAddr string `protobuf:"bytes,1,opt,name=scope_specification_addr,json=scopeSpecificationAddr,proto3" json:"scope_specification_addr,omitempty"`
 }
 
-func (m *EventOSLocatorCreated) Reset()         { *m = EventOSLocatorCreated{} }
-func (m *EventOSLocatorCreated) String() string { return proto.CompactTextString(m) }
-func (*EventOSLocatorCreated) ProtoMessage()    {}
-func (*EventOSLocatorCreated) Descriptor() ([]byte, []int) {
-	return fileDescriptor_476cf6cf9459cf25, []int{19}
+func (m *EventScopeSpecificationCreated) Reset()         { *m = EventScopeSpecificationCreated{} }
+func (m *EventScopeSpecificationCreated) String() string { return proto.CompactTextString(m) }
+func (*EventScopeSpecificationCreated) ProtoMessage()    {}
+func (*EventScopeSpecificationCreated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_476cf6cf9459cf25, []int{18}
 }
-func (m *EventOSLocatorCreated) XXX_Unmarshal(b []byte) error {
+func (m *EventScopeSpecificationCreated) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventOSLocatorCreated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EventScopeSpecificationCreated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventOSLocatorCreated.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EventScopeSpecificationCreated.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1052,43 +1159,44 @@ func (m *EventOSLocatorCreated) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (m *EventOSLocatorCreated) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventOSLocatorCreated.Merge(m, src)
+func (m *EventScopeSpecificationCreated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventScopeSpecificationCreated.Merge(m, src)
 }
-func (m *EventOSLocatorCreated) XXX_Size() int {
+func (m *EventScopeSpecificationCreated) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventOSLocatorCreated) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventOSLocatorCreated.DiscardUnknown(m)
+func (m *EventScopeSpecificationCreated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventScopeSpecificationCreated.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventOSLocatorCreated proto.InternalMessageInfo
+var xxx_messageInfo_EventScopeSpecificationCreated proto.InternalMessageInfo
 
-func (m *EventOSLocatorCreated) GetOwner() string {
+func (m *EventScopeSpecificationCreated) GetScopeSpecificationAddr() string {
 	if m != nil {
-		return m.Owner
+		return m.ScopeSpecificationAddr
 	}
 	return ""
 }
 
-// EventOSLocatorUpdated is an event message indicating an object store locator has been updated.
-type EventOSLocatorUpdated struct {
-	// owner is the owner in the object store locator that was updated.
-	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+// EventScopeSpecificationUpdated is an event message indicating a scope specification has been updated.
+type EventScopeSpecificationUpdated struct {
+	// scope_specification_addr is the bech32 address string of the specification id of the scope specification that was
+	// updated.
+	ScopeSpecificationAddr string `protobuf:"bytes,1,opt,name=scope_specification_addr,json=scopeSpecificationAddr,proto3" json:"scope_specification_addr,omitempty"`
 }
 
-func (m *EventOSLocatorUpdated) Reset()         { *m = EventOSLocatorUpdated{} }
-func (m *EventOSLocatorUpdated) String() string { return proto.CompactTextString(m) }
-func (*EventOSLocatorUpdated) ProtoMessage()    {}
-func (*EventOSLocatorUpdated) Descriptor() ([]byte, []int) {
-	return fileDescriptor_476cf6cf9459cf25, []int{20}
+func (m *EventScopeSpecificationUpdated) Reset()         { *m = EventScopeSpecificationUpdated{} }
+func (m *EventScopeSpecificationUpdated) String() string { return proto.CompactTextString(m) }
+func (*EventScopeSpecificationUpdated) ProtoMessage()    {}
+func (*EventScopeSpecificationUpdated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_476cf6cf9459cf25, []int{19}
 }
-func (m *EventOSLocatorUpdated) XXX_Unmarshal(b []byte) error {
+func (m *EventScopeSpecificationUpdated) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventOSLocatorUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EventScopeSpecificationUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventOSLocatorUpdated.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EventScopeSpecificationUpdated.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1098,43 +1206,44 @@ func (m *EventOSLocatorUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (m *EventOSLocatorUpdated) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventOSLocatorUpdated.Merge(m, src)
+func (m *EventScopeSpecificationUpdated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventScopeSpecificationUpdated.Merge(m, src)
 }
-func (m *EventOSLocatorUpdated) XXX_Size() int {
+func (m *EventScopeSpecificationUpdated) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventOSLocatorUpdated) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventOSLocatorUpdated.DiscardUnknown(m)
+func (m *EventScopeSpecificationUpdated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventScopeSpecificationUpdated.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventOSLocatorUpdated proto.InternalMessageInfo
+var xxx_messageInfo_EventScopeSpecificationUpdated proto.InternalMessageInfo
 
-func (m *EventOSLocatorUpdated) GetOwner() string {
+func (m *EventScopeSpecificationUpdated) GetScopeSpecificationAddr() string {
 	if m != nil {
-		return m.Owner
+		return m.ScopeSpecificationAddr
 	}
 	return ""
 }
 
-// EventOSLocatorDeleted is an event message indicating an object store locator has been deleted.
-type EventOSLocatorDeleted struct {
-	// owner is the owner in the object store locator that was deleted.
-	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+// EventScopeSpecificationDeleted is an event message indicating a scope specification has been deleted.
+type EventScopeSpecificationDeleted struct {
+	// scope_specification_addr is the bech32 address string of the specification id of the scope specification that was
+	// deleted.
+	ScopeSpecificationAddr string `protobuf:"bytes,1,opt,name=scope_specification_addr,json=scopeSpecificationAddr,proto3" json:"scope_specification_addr,omitempty"`
 }
 
-func (m *EventOSLocatorDeleted) Reset()         { *m = EventOSLocatorDeleted{} }
-func (m *EventOSLocatorDeleted) String() string { return proto.CompactTextString(m) }
-func (*EventOSLocatorDeleted) ProtoMessage()    {}
-func (*EventOSLocatorDeleted) Descriptor() ([]byte, []int) {
-	return fileDescriptor_476cf6cf9459cf25, []int{21}
+func (m *EventScopeSpecificationDeleted) Reset()         { *m = EventScopeSpecificationDeleted{} }
+func (m *EventScopeSpecificationDeleted) String() string { return proto.CompactTextString(m) }
+func (*EventScopeSpecificationDeleted) ProtoMessage()    {}
+func (*EventScopeSpecificationDeleted) Descriptor() ([]byte, []int) {
+	return fileDescriptor_476cf6cf9459cf25, []int{20}
 }
-func (m *EventOSLocatorDeleted) XXX_Unmarshal(b []byte) error {
+func (m *EventScopeSpecificationDeleted) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventOSLocatorDeleted) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EventScopeSpecificationDeleted) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventOSLocatorDeleted.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EventScopeSpecificationDeleted.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1144,45 +1253,44 @@ func (m *EventOSLocatorDeleted) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (m *EventOSLocatorDeleted) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventOSLocatorDeleted.Merge(m, src)
+func (m *EventScopeSpecificationDeleted) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventScopeSpecificationDeleted.Merge(m, src)
 }
-func (m *EventOSLocatorDeleted) XXX_Size() int {
+func (m *EventScopeSpecificationDeleted) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventOSLocatorDeleted) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventOSLocatorDeleted.DiscardUnknown(m)
+func (m *EventScopeSpecificationDeleted) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventScopeSpecificationDeleted.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventOSLocatorDeleted proto.InternalMessageInfo
+var xxx_messageInfo_EventScopeSpecificationDeleted proto.InternalMessageInfo
 
-func (m *EventOSLocatorDeleted) GetOwner() string {
+func (m *EventScopeSpecificationDeleted) GetScopeSpecificationAddr() string {
 	if m != nil {
-		return m.Owner
+		return m.ScopeSpecificationAddr
 	}
 	return ""
 }
 
-// EventSetNetAssetValue event emitted when Net Asset Value for a scope is update or added
-type EventSetNetAssetValue struct {
-	ScopeId string `protobuf:"bytes,1,opt,name=scope_id,json=scopeId,proto3" json:"scope_id,omitempty"`
-	Price   string `protobuf:"bytes,2,opt,name=price,proto3" json:"price,omitempty"`
-	Source  string `protobuf:"bytes,3,opt,name=source,proto3" json:"source,omitempty"`
-	Volume  string `protobuf:"bytes,4,opt,name=volume,proto3" json:"volume,omitempty"`
+// EventContractSpecificationCreated is an event message indicating a contract specification has been created.
+type EventContractSpecificationCreated struct {
+	// contract_specification_addr is the bech32 address string of the specification id of the contract specification that
+	// was created.
+	ContractSpecificationAddr string `protobuf:"bytes,1,opt,name=contract_specification_addr,json=contractSpecificationAddr,proto3" json:"contract_specification_addr,omitempty"`
 }
 
-func (m *EventSetNetAssetValue) Reset()         { *m = EventSetNetAssetValue{} }
-func (m *EventSetNetAssetValue) String() string { return proto.CompactTextString(m) }
-func (*EventSetNetAssetValue) ProtoMessage()    {}
-func (*EventSetNetAssetValue) Descriptor() ([]byte, []int) {
-	return fileDescriptor_476cf6cf9459cf25, []int{22}
+func (m *EventContractSpecificationCreated) Reset()         { *m = EventContractSpecificationCreated{} }
+func (m *EventContractSpecificationCreated) String() string { return proto.CompactTextString(m) }
+func (*EventContractSpecificationCreated) ProtoMessage()    {}
+func (*EventContractSpecificationCreated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_476cf6cf9459cf25, []int{21}
 }
-func (m *EventSetNetAssetValue) XXX_Unmarshal(b []byte) error {
+func (m *EventContractSpecificationCreated) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventSetNetAssetValue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EventContractSpecificationCreated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventSetNetAssetValue.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EventContractSpecificationCreated.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1192,743 +1300,987 @@ func (m *EventSetNetAssetValue) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (m *EventSetNetAssetValue) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventSetNetAssetValue.Merge(m, src)
+func (m *EventContractSpecificationCreated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventContractSpecificationCreated.Merge(m, src)
 }
-func (m *EventSetNetAssetValue) XXX_Size() int {
+func (m *EventContractSpecificationCreated) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventSetNetAssetValue) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventSetNetAssetValue.DiscardUnknown(m)
+func (m *EventContractSpecificationCreated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventContractSpecificationCreated.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventSetNetAssetValue proto.InternalMessageInfo
+var xxx_messageInfo_EventContractSpecificationCreated proto.InternalMessageInfo
 
-func (m *EventSetNetAssetValue) GetScopeId() string {
+func (m *EventContractSpecificationCreated) GetContractSpecificationAddr() string {
 	if m != nil {
-		return m.ScopeId
+		return m.ContractSpecificationAddr
 	}
 	return ""
 }
 
-func (m *EventSetNetAssetValue) GetPrice() string {
-	if m != nil {
-		return m.Price
-	}
-	return ""
+// EventContractSpecificationUpdated is an event message indicating a contract specification has been updated.
+type EventContractSpecificationUpdated struct {
+	// contract_specification_addr is the bech32 address string of the specification id of the contract specification that
+	// was updated.
+	ContractSpecificationAddr string `protobuf:"bytes,1,opt,name=contract_specification_addr,json=contractSpecificationAddr,proto3" json:"contract_specification_addr,omitempty"`
 }
 
-func (m *EventSetNetAssetValue) GetSource() string {
-	if m != nil {
-		return m.Source
+func (m *EventContractSpecificationUpdated) Reset()         { *m = EventContractSpecificationUpdated{} }
+func (m *EventContractSpecificationUpdated) String() string { return proto.CompactTextString(m) }
+func (*EventContractSpecificationUpdated) ProtoMessage()    {}
+func (*EventContractSpecificationUpdated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_476cf6cf9459cf25, []int{22}
+}
+func (m *EventContractSpecificationUpdated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventContractSpecificationUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventContractSpecificationUpdated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return ""
+}
+func (m *EventContractSpecificationUpdated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventContractSpecificationUpdated.Merge(m, src)
+}
+func (m *EventContractSpecificationUpdated) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventContractSpecificationUpdated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventContractSpecificationUpdated.DiscardUnknown(m)
 }
 
-func (m *EventSetNetAssetValue) GetVolume() string {
+var xxx_messageInfo_EventContractSpecificationUpdated proto.InternalMessageInfo
+
+func (m *EventContractSpecificationUpdated) GetContractSpecificationAddr() string {
 	if m != nil {
-		return m.Volume
+		return m.ContractSpecificationAddr
 	}
 	return ""
 }
 
-func init() {
-	proto.RegisterType((*EventTxCompleted)(nil), "provenance.metadata.v1.EventTxCompleted")
-	proto.RegisterType((*EventScopeCreated)(nil), "provenance.metadata.v1.EventScopeCreated")
-	proto.RegisterType((*EventScopeUpdated)(nil), "provenance.metadata.v1.EventScopeUpdated")
-	proto.RegisterType((*EventScopeDeleted)(nil), "provenance.metadata.v1.EventScopeDeleted")
-	proto.RegisterType((*EventSessionCreated)(nil), "provenance.metadata.v1.EventSessionCreated")
-	proto.RegisterType((*EventSessionUpdated)(nil), "provenance.metadata.v1.EventSessionUpdated")
-	proto.RegisterType((*EventSessionDeleted)(nil), "provenance.metadata.v1.EventSessionDeleted")
-	proto.RegisterType((*EventRecordCreated)(nil), "provenance.metadata.v1.EventRecordCreated")
-	proto.RegisterType((*EventRecordUpdated)(nil), "provenance.metadata.v1.EventRecordUpdated")
-	proto.RegisterType((*EventRecordDeleted)(nil), "provenance.metadata.v1.EventRecordDeleted")
-	proto.RegisterType((*EventScopeSpecificationCreated)(nil), "provenance.metadata.v1.EventScopeSpecificationCreated")
-	proto.RegisterType((*EventScopeSpecificationUpdated)(nil), "provenance.metadata.v1.EventScopeSpecificationUpdated")
-	proto.RegisterType((*EventScopeSpecificationDeleted)(nil), "provenance.metadata.v1.EventScopeSpecificationDeleted")
-	proto.RegisterType((*EventContractSpecificationCreated)(nil), "provenance.metadata.v1.EventContractSpecificationCreated")
-	proto.RegisterType((*EventContractSpecificationUpdated)(nil), "provenance.metadata.v1.EventContractSpecificationUpdated")
-	proto.RegisterType((*EventContractSpecificationDeleted)(nil), "provenance.metadata.v1.EventContractSpecificationDeleted")
-	proto.RegisterType((*EventRecordSpecificationCreated)(nil), "provenance.metadata.v1.EventRecordSpecificationCreated")
-	proto.RegisterType((*EventRecordSpecificationUpdated)(nil), "provenance.metadata.v1.EventRecordSpecificationUpdated")
-	proto.RegisterType((*EventRecordSpecificationDeleted)(nil), "provenance.metadata.v1.EventRecordSpecificationDeleted")
-	proto.RegisterType((*EventOSLocatorCreated)(nil), "provenance.metadata.v1.EventOSLocatorCreated")
-	proto.RegisterType((*EventOSLocatorUpdated)(nil), "provenance.metadata.v1.EventOSLocatorUpdated")
-	proto.RegisterType((*EventOSLocatorDeleted)(nil), "provenance.metadata.v1.EventOSLocatorDeleted")
-	proto.RegisterType((*EventSetNetAssetValue)(nil), "provenance.metadata.v1.EventSetNetAssetValue")
+// EventContractSpecificationDeleted is an event message indicating a contract specification has been deleted.
+type EventContractSpecificationDeleted struct {
+	// contract_specification_addr is the bech32 address string of the specification id of the contract specification that
+	// was deleted.
+	ContractSpecificationAddr string `protobuf:"bytes,1,opt,name=contract_specification_addr,json=contractSpecificationAddr,proto3" json:"contract_specification_addr,omitempty"`
 }
 
-func init() {
-	proto.RegisterFile("provenance/metadata/v1/events.proto", fileDescriptor_476cf6cf9459cf25)
+func (m *EventContractSpecificationDeleted) Reset()         { *m = EventContractSpecificationDeleted{} }
+func (m *EventContractSpecificationDeleted) String() string { return proto.CompactTextString(m) }
+func (*EventContractSpecificationDeleted) ProtoMessage()    {}
+func (*EventContractSpecificationDeleted) Descriptor() ([]byte, []int) {
+	return fileDescriptor_476cf6cf9459cf25, []int{23}
 }
-
-var fileDescriptor_476cf6cf9459cf25 = []byte{
-	// 565 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x56, 0x4d, 0x6f, 0x13, 0x31,
-	0x10, 0xcd, 0x26, 0xd0, 0x36, 0x53, 0x0e, 0xb0, 0x40, 0xd8, 0x80, 0xd8, 0x7e, 0x70, 0xe9, 0xa5,
-	0x89, 0x0a, 0x1c, 0x10, 0x07, 0xa4, 0x12, 0x38, 0x20, 0x21, 0x40, 0x49, 0x01, 0xa9, 0x17, 0x70,
-	0xed, 0xa1, 0x58, 0x24, 0xeb, 0x95, 0xed, 0xa4, 0xe1, 0x5f, 0xf0, 0x07, 0xf8, 0x3f, 0x1c, 0x7b,
-	0xe4, 0x88, 0x92, 0x3f, 0x82, 0xd6, 0x1f, 0x64, 0xdb, 0xa4, 0x2c, 0x10, 0x0a, 0x3d, 0xbe, 0xf1,
-	0xbc, 0xf7, 0xc6, 0x6f, 0x27, 0x91, 0xe1, 0x56, 0x2a, 0xc5, 0x00, 0x13, 0x92, 0x50, 0x6c, 0xf6,
-	0x50, 0x13, 0x46, 0x34, 0x69, 0x0e, 0xb6, 0x9a, 0x38, 0xc0, 0x44, 0xab, 0x46, 0x2a, 0x85, 0x16,
-	0x61, 0x6d, 0xd2, 0xd4, 0xf0, 0x4d, 0x8d, 0xc1, 0xd6, 0xfa, 0x5b, 0xb8, 0xf8, 0x38, 0xeb, 0xdb,
-	0x19, 0xb6, 0x44, 0x2f, 0xed, 0xa2, 0x46, 0x16, 0xd6, 0x60, 0xa1, 0x27, 0x58, 0xbf, 0x8b, 0x51,
-	0xb0, 0x1a, 0x6c, 0x54, 0xdb, 0x0e, 0x85, 0xd7, 0x61, 0x09, 0x13, 0x96, 0x0a, 0x9e, 0xe8, 0xa8,
-	0x6c, 0x4e, 0x7e, 0xe0, 0x30, 0x82, 0x45, 0xc5, 0xf7, 0x13, 0x94, 0x2a, 0xaa, 0xac, 0x56, 0x36,
-	0xaa, 0x6d, 0x0f, 0xd7, 0x6f, 0xc3, 0x25, 0xe3, 0xd0, 0xa1, 0x22, 0xc5, 0x96, 0x44, 0x92, 0x59,
-	0xdc, 0x04, 0x50, 0x19, 0x7e, 0x43, 0x18, 0x93, 0xce, 0xa6, 0x6a, 0x2a, 0xdb, 0x8c, 0xc9, 0xa3,
-	0x9c, 0x97, 0x29, 0xfb, 0x6d, 0xce, 0x23, 0xb4, 0x57, 0x29, 0xe0, 0xbc, 0x86, 0xcb, 0x96, 0x83,
-	0x4a, 0x71, 0x91, 0xf8, 0xe9, 0xd6, 0xe0, 0x82, 0xb2, 0x95, 0x3c, 0x6f, 0xd9, 0xd5, 0x32, 0xe6,
-	0x31, 0xe1, 0x72, 0x81, 0xb0, 0xbf, 0xc2, 0x5f, 0x17, 0xf6, 0xf7, 0x9c, 0x5f, 0xf8, 0x00, 0x42,
-	0x23, 0xdc, 0x46, 0x2a, 0x24, 0xf3, 0x49, 0xac, 0xc0, 0xb2, 0x34, 0x85, 0xbc, 0x2c, 0xd8, 0x92,
-	0x51, 0x3d, 0x6e, 0x5c, 0x2e, 0x32, 0xae, 0xfc, 0xdc, 0xd8, 0x27, 0xf5, 0x0f, 0x8c, 0x77, 0x8e,
-	0x18, 0xfb, 0x24, 0x0b, 0x8d, 0x0b, 0x54, 0x77, 0x21, 0x9e, 0xac, 0x61, 0x27, 0x45, 0xca, 0xdf,
-	0x71, 0x4a, 0x74, 0x6e, 0xbb, 0xee, 0x41, 0x64, 0x05, 0x54, 0xfe, 0x34, 0x6f, 0x57, 0x53, 0x53,
-	0xe4, 0x02, 0x6d, 0x1f, 0xdb, 0x69, 0x68, 0xfb, 0x64, 0xfe, 0x5c, 0x9b, 0xc2, 0x9a, 0xd1, 0x6e,
-	0x89, 0x44, 0x4b, 0x42, 0xf5, 0xcc, 0x58, 0x1e, 0xc0, 0x0d, 0xea, 0xce, 0x4f, 0x76, 0xa8, 0xd3,
-	0x59, 0x12, 0xc5, 0x26, 0x3e, 0x9f, 0x53, 0x35, 0xf1, 0x41, 0xcd, 0x6b, 0xf2, 0x39, 0x80, 0x95,
-	0xdc, 0x66, 0xce, 0x4c, 0xeb, 0x3e, 0xd4, 0xdd, 0x9a, 0x9e, 0xe8, 0x70, 0x4d, 0x4e, 0xd3, 0xcd,
-	0x06, 0x17, 0xcc, 0x57, 0x9e, 0x67, 0x3e, 0x1f, 0xf4, 0x59, 0x9d, 0xcf, 0x7f, 0xa3, 0xff, 0x39,
-	0xdf, 0x26, 0x5c, 0x35, 0xe3, 0x3d, 0xef, 0x3c, 0x15, 0x94, 0x68, 0x21, 0xfd, 0x47, 0xbd, 0x02,
-	0xe7, 0xc5, 0x41, 0x82, 0x7e, 0x00, 0x0b, 0xa6, 0xdb, 0x7d, 0xc6, 0xbf, 0xd8, 0xee, 0xaf, 0x3c,
-	0xbb, 0x7d, 0xe8, 0xda, 0x3b, 0xa8, 0x9f, 0xa1, 0xde, 0x56, 0x0a, 0xf5, 0x2b, 0xd2, 0xed, 0x63,
-	0x58, 0x87, 0x25, 0xfb, 0x73, 0xe7, 0xcc, 0x31, 0x16, 0x0d, 0x7e, 0x62, 0x94, 0x52, 0xc9, 0x29,
-	0xba, 0xab, 0x5a, 0x90, 0x3d, 0x1b, 0x94, 0xe8, 0x4b, 0x8a, 0xee, 0x4f, 0xd1, 0xa1, 0xac, 0x3e,
-	0x10, 0xdd, 0x7e, 0x0f, 0xa3, 0x73, 0xb6, 0x6e, 0xd1, 0xc3, 0x0f, 0x5f, 0x46, 0x71, 0x70, 0x38,
-	0x8a, 0x83, 0x6f, 0xa3, 0x38, 0xf8, 0x34, 0x8e, 0x4b, 0x87, 0xe3, 0xb8, 0xf4, 0x75, 0x1c, 0x97,
-	0xa0, 0xce, 0x45, 0x63, 0xf6, 0x7b, 0xe5, 0x45, 0xb0, 0x7b, 0x77, 0x9f, 0xeb, 0xf7, 0xfd, 0xbd,
-	0x06, 0x15, 0xbd, 0xe6, 0xa4, 0x69, 0x93, 0x8b, 0x1c, 0x6a, 0x0e, 0x27, 0x2f, 0x21, 0xfd, 0x31,
-	0x45, 0xb5, 0xb7, 0x60, 0x9e, 0x41, 0x77, 0xbe, 0x07, 0x00, 0x00, 0xff, 0xff, 0x5f, 0xd9, 0xf3,
-	0xfa, 0x2d, 0x09, 0x00, 0x00,
+func (m *EventContractSpecificationDeleted) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventContractSpecificationDeleted) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventContractSpecificationDeleted.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventContractSpecificationDeleted) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventContractSpecificationDeleted.Merge(m, src)
+}
+func (m *EventContractSpecificationDeleted) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventContractSpecificationDeleted) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventContractSpecificationDeleted.DiscardUnknown(m)
 }
 
-func (m *EventTxCompleted) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_EventContractSpecificationDeleted proto.InternalMessageInfo
+
+func (m *EventContractSpecificationDeleted) GetContractSpecificationAddr() string {
+	if m != nil {
+		return m.ContractSpecificationAddr
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventTxCompleted) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventContractSpecificationDeprecated is an event message indicating a contract specification has been deprecated.
+type EventContractSpecificationDeprecated struct {
+	// contract_specification_addr is the bech32 address string of the specification id of the contract specification that
+	// was deprecated.
+	ContractSpecificationAddr string `protobuf:"bytes,1,opt,name=contract_specification_addr,json=contractSpecificationAddr,proto3" json:"contract_specification_addr,omitempty"`
+	// replacement_specification_addr is the bech32 address string of the specification id of the contract specification
+	// that should be used instead. It is empty if no replacement was provided.
+	ReplacementSpecificationAddr string `protobuf:"bytes,2,opt,name=replacement_specification_addr,json=replacementSpecificationAddr,proto3" json:"replacement_specification_addr,omitempty"`
 }
 
-func (m *EventTxCompleted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Signers) > 0 {
-		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Signers[iNdEx])
-			copy(dAtA[i:], m.Signers[iNdEx])
-			i = encodeVarintEvents(dAtA, i, uint64(len(m.Signers[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
+func (m *EventContractSpecificationDeprecated) Reset()         { *m = EventContractSpecificationDeprecated{} }
+func (m *EventContractSpecificationDeprecated) String() string { return proto.CompactTextString(m) }
+func (*EventContractSpecificationDeprecated) ProtoMessage()    {}
+func (*EventContractSpecificationDeprecated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_476cf6cf9459cf25, []int{24}
+}
+func (m *EventContractSpecificationDeprecated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventContractSpecificationDeprecated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventContractSpecificationDeprecated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Endpoint) > 0 {
-		i -= len(m.Endpoint)
-		copy(dAtA[i:], m.Endpoint)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Endpoint)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Module) > 0 {
-		i -= len(m.Module)
-		copy(dAtA[i:], m.Module)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Module)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *EventContractSpecificationDeprecated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventContractSpecificationDeprecated.Merge(m, src)
+}
+func (m *EventContractSpecificationDeprecated) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventContractSpecificationDeprecated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventContractSpecificationDeprecated.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventContractSpecificationDeprecated proto.InternalMessageInfo
+
+func (m *EventContractSpecificationDeprecated) GetContractSpecificationAddr() string {
+	if m != nil {
+		return m.ContractSpecificationAddr
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *EventScopeCreated) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventContractSpecificationDeprecated) GetReplacementSpecificationAddr() string {
+	if m != nil {
+		return m.ReplacementSpecificationAddr
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventScopeCreated) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventSessionUsesDeprecatedSpecification is an event message indicating a new session was written that uses a
+// deprecated contract specification.
+type EventSessionUsesDeprecatedSpecification struct {
+	// session_addr is the bech32 address string of the session id that was created.
+	SessionAddr string `protobuf:"bytes,1,opt,name=session_addr,json=sessionAddr,proto3" json:"session_addr,omitempty"`
+	// contract_specification_addr is the bech32 address string of the deprecated contract specification id.
+	ContractSpecificationAddr string `protobuf:"bytes,2,opt,name=contract_specification_addr,json=contractSpecificationAddr,proto3" json:"contract_specification_addr,omitempty"`
+	// replacement_specification_addr is the bech32 address string of the specification id of the contract specification
+	// that should be used instead. It is empty if no replacement was provided.
+	ReplacementSpecificationAddr string `protobuf:"bytes,3,opt,name=replacement_specification_addr,json=replacementSpecificationAddr,proto3" json:"replacement_specification_addr,omitempty"`
 }
 
-func (m *EventScopeCreated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ScopeAddr) > 0 {
-		i -= len(m.ScopeAddr)
-		copy(dAtA[i:], m.ScopeAddr)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.ScopeAddr)))
-		i--
-		dAtA[i] = 0xa
+func (m *EventSessionUsesDeprecatedSpecification) Reset() {
+	*m = EventSessionUsesDeprecatedSpecification{}
+}
+func (m *EventSessionUsesDeprecatedSpecification) String() string { return proto.CompactTextString(m) }
+func (*EventSessionUsesDeprecatedSpecification) ProtoMessage()    {}
+func (*EventSessionUsesDeprecatedSpecification) Descriptor() ([]byte, []int) {
+	return fileDescriptor_476cf6cf9459cf25, []int{25}
+}
+func (m *EventSessionUsesDeprecatedSpecification) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventSessionUsesDeprecatedSpecification) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventSessionUsesDeprecatedSpecification.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *EventSessionUsesDeprecatedSpecification) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventSessionUsesDeprecatedSpecification.Merge(m, src)
+}
+func (m *EventSessionUsesDeprecatedSpecification) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventSessionUsesDeprecatedSpecification) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventSessionUsesDeprecatedSpecification.DiscardUnknown(m)
 }
 
-func (m *EventScopeUpdated) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_EventSessionUsesDeprecatedSpecification proto.InternalMessageInfo
+
+func (m *EventSessionUsesDeprecatedSpecification) GetSessionAddr() string {
+	if m != nil {
+		return m.SessionAddr
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventScopeUpdated) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventSessionUsesDeprecatedSpecification) GetContractSpecificationAddr() string {
+	if m != nil {
+		return m.ContractSpecificationAddr
+	}
+	return ""
 }
 
-func (m *EventScopeUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ScopeAddr) > 0 {
-		i -= len(m.ScopeAddr)
-		copy(dAtA[i:], m.ScopeAddr)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.ScopeAddr)))
-		i--
-		dAtA[i] = 0xa
+func (m *EventSessionUsesDeprecatedSpecification) GetReplacementSpecificationAddr() string {
+	if m != nil {
+		return m.ReplacementSpecificationAddr
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *EventScopeDeleted) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// EventRecordSpecificationCreated is an event message indicating a record specification has been created.
+type EventRecordSpecificationCreated struct {
+	// record_specification_addr is the bech32 address string of the specification id of the record specification that was
+	// created.
+	RecordSpecificationAddr string `protobuf:"bytes,1,opt,name=record_specification_addr,json=recordSpecificationAddr,proto3" json:"record_specification_addr,omitempty"`
+	// contract_specification_addr is the bech32 address string of the contract specification id this record specification
+	// belongs to.
+	ContractSpecificationAddr string `protobuf:"bytes,2,opt,name=contract_specification_addr,json=contractSpecificationAddr,proto3" json:"contract_specification_addr,omitempty"`
 }
 
-func (m *EventScopeDeleted) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventRecordSpecificationCreated) Reset()         { *m = EventRecordSpecificationCreated{} }
+func (m *EventRecordSpecificationCreated) String() string { return proto.CompactTextString(m) }
+func (*EventRecordSpecificationCreated) ProtoMessage()    {}
+func (*EventRecordSpecificationCreated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_476cf6cf9459cf25, []int{26}
+}
+func (m *EventRecordSpecificationCreated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventRecordSpecificationCreated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventRecordSpecificationCreated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventRecordSpecificationCreated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventRecordSpecificationCreated.Merge(m, src)
+}
+func (m *EventRecordSpecificationCreated) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventRecordSpecificationCreated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventRecordSpecificationCreated.DiscardUnknown(m)
 }
 
-func (m *EventScopeDeleted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ScopeAddr) > 0 {
-		i -= len(m.ScopeAddr)
-		copy(dAtA[i:], m.ScopeAddr)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.ScopeAddr)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_EventRecordSpecificationCreated proto.InternalMessageInfo
+
+func (m *EventRecordSpecificationCreated) GetRecordSpecificationAddr() string {
+	if m != nil {
+		return m.RecordSpecificationAddr
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *EventSessionCreated) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventRecordSpecificationCreated) GetContractSpecificationAddr() string {
+	if m != nil {
+		return m.ContractSpecificationAddr
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventSessionCreated) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventRecordSpecificationUpdated is an event message indicating a record specification has been updated.
+type EventRecordSpecificationUpdated struct {
+	// record_specification_addr is the bech32 address string of the specification id of the record specification that was
+	// updated.
+	RecordSpecificationAddr string `protobuf:"bytes,1,opt,name=record_specification_addr,json=recordSpecificationAddr,proto3" json:"record_specification_addr,omitempty"`
+	// contract_specification_addr is the bech32 address string of the contract specification id this record specification
+	// belongs to.
+	ContractSpecificationAddr string `protobuf:"bytes,2,opt,name=contract_specification_addr,json=contractSpecificationAddr,proto3" json:"contract_specification_addr,omitempty"`
 }
 
-func (m *EventSessionCreated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ScopeAddr) > 0 {
-		i -= len(m.ScopeAddr)
-		copy(dAtA[i:], m.ScopeAddr)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.ScopeAddr)))
-		i--
-		dAtA[i] = 0x12
+func (m *EventRecordSpecificationUpdated) Reset()         { *m = EventRecordSpecificationUpdated{} }
+func (m *EventRecordSpecificationUpdated) String() string { return proto.CompactTextString(m) }
+func (*EventRecordSpecificationUpdated) ProtoMessage()    {}
+func (*EventRecordSpecificationUpdated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_476cf6cf9459cf25, []int{27}
+}
+func (m *EventRecordSpecificationUpdated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventRecordSpecificationUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventRecordSpecificationUpdated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.SessionAddr) > 0 {
-		i -= len(m.SessionAddr)
-		copy(dAtA[i:], m.SessionAddr)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.SessionAddr)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *EventRecordSpecificationUpdated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventRecordSpecificationUpdated.Merge(m, src)
+}
+func (m *EventRecordSpecificationUpdated) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventRecordSpecificationUpdated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventRecordSpecificationUpdated.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventRecordSpecificationUpdated proto.InternalMessageInfo
+
+func (m *EventRecordSpecificationUpdated) GetRecordSpecificationAddr() string {
+	if m != nil {
+		return m.RecordSpecificationAddr
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *EventSessionUpdated) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventRecordSpecificationUpdated) GetContractSpecificationAddr() string {
+	if m != nil {
+		return m.ContractSpecificationAddr
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventSessionUpdated) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventRecordSpecificationDeleted is an event message indicating a record specification has been deleted.
+type EventRecordSpecificationDeleted struct {
+	// record_specification_addr is the bech32 address string of the specification id of the record specification that was
+	// deleted.
+	RecordSpecificationAddr string `protobuf:"bytes,1,opt,name=record_specification_addr,json=recordSpecificationAddr,proto3" json:"record_specification_addr,omitempty"`
+	// contract_specification_addr is the bech32 address string of the contract specification id this record specification
+	// belongs to.
+	ContractSpecificationAddr string `protobuf:"bytes,2,opt,name=contract_specification_addr,json=contractSpecificationAddr,proto3" json:"contract_specification_addr,omitempty"`
 }
 
-func (m *EventSessionUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ScopeAddr) > 0 {
-		i -= len(m.ScopeAddr)
-		copy(dAtA[i:], m.ScopeAddr)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.ScopeAddr)))
-		i--
-		dAtA[i] = 0x12
+func (m *EventRecordSpecificationDeleted) Reset()         { *m = EventRecordSpecificationDeleted{} }
+func (m *EventRecordSpecificationDeleted) String() string { return proto.CompactTextString(m) }
+func (*EventRecordSpecificationDeleted) ProtoMessage()    {}
+func (*EventRecordSpecificationDeleted) Descriptor() ([]byte, []int) {
+	return fileDescriptor_476cf6cf9459cf25, []int{28}
+}
+func (m *EventRecordSpecificationDeleted) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventRecordSpecificationDeleted) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventRecordSpecificationDeleted.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.SessionAddr) > 0 {
-		i -= len(m.SessionAddr)
-		copy(dAtA[i:], m.SessionAddr)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.SessionAddr)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *EventRecordSpecificationDeleted) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventRecordSpecificationDeleted.Merge(m, src)
+}
+func (m *EventRecordSpecificationDeleted) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventRecordSpecificationDeleted) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventRecordSpecificationDeleted.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventRecordSpecificationDeleted proto.InternalMessageInfo
+
+func (m *EventRecordSpecificationDeleted) GetRecordSpecificationAddr() string {
+	if m != nil {
+		return m.RecordSpecificationAddr
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *EventSessionDeleted) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventRecordSpecificationDeleted) GetContractSpecificationAddr() string {
+	if m != nil {
+		return m.ContractSpecificationAddr
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventSessionDeleted) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventScopeSpecMigrationStarted is an event message indicating a migration of scopes from one scope specification to
+// another has been started.
+type EventScopeSpecMigrationStarted struct {
+	// from_specification_addr is the bech32 address string of the scope specification being migrated away from.
+	FromSpecificationAddr string `protobuf:"bytes,1,opt,name=from_specification_addr,json=fromSpecificationAddr,proto3" json:"from_specification_addr,omitempty"`
+	// to_specification_addr is the bech32 address string of the scope specification being migrated to.
+	ToSpecificationAddr string `protobuf:"bytes,2,opt,name=to_specification_addr,json=toSpecificationAddr,proto3" json:"to_specification_addr,omitempty"`
+	// batch_size is the maximum number of scopes updated in each block.
+	BatchSize uint32 `protobuf:"varint,3,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`
 }
 
-func (m *EventSessionDeleted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ScopeAddr) > 0 {
-		i -= len(m.ScopeAddr)
-		copy(dAtA[i:], m.ScopeAddr)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.ScopeAddr)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.SessionAddr) > 0 {
-		i -= len(m.SessionAddr)
-		copy(dAtA[i:], m.SessionAddr)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.SessionAddr)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *EventScopeSpecMigrationStarted) Reset()         { *m = EventScopeSpecMigrationStarted{} }
+func (m *EventScopeSpecMigrationStarted) String() string { return proto.CompactTextString(m) }
+func (*EventScopeSpecMigrationStarted) ProtoMessage()    {}
+func (*EventScopeSpecMigrationStarted) Descriptor() ([]byte, []int) {
+	return fileDescriptor_476cf6cf9459cf25, []int{29}
 }
-
-func (m *EventRecordCreated) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventScopeSpecMigrationStarted) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventScopeSpecMigrationStarted) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventScopeSpecMigrationStarted.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *EventRecordCreated) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventScopeSpecMigrationStarted) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventScopeSpecMigrationStarted.Merge(m, src)
+}
+func (m *EventScopeSpecMigrationStarted) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventScopeSpecMigrationStarted) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventScopeSpecMigrationStarted.DiscardUnknown(m)
 }
 
-func (m *EventRecordCreated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ScopeAddr) > 0 {
-		i -= len(m.ScopeAddr)
-		copy(dAtA[i:], m.ScopeAddr)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.ScopeAddr)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.SessionAddr) > 0 {
-		i -= len(m.SessionAddr)
-		copy(dAtA[i:], m.SessionAddr)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.SessionAddr)))
-		i--
-		dAtA[i] = 0x12
+var xxx_messageInfo_EventScopeSpecMigrationStarted proto.InternalMessageInfo
+
+func (m *EventScopeSpecMigrationStarted) GetFromSpecificationAddr() string {
+	if m != nil {
+		return m.FromSpecificationAddr
 	}
-	if len(m.RecordAddr) > 0 {
-		i -= len(m.RecordAddr)
-		copy(dAtA[i:], m.RecordAddr)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.RecordAddr)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *EventScopeSpecMigrationStarted) GetToSpecificationAddr() string {
+	if m != nil {
+		return m.ToSpecificationAddr
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *EventRecordUpdated) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventScopeSpecMigrationStarted) GetBatchSize() uint32 {
+	if m != nil {
+		return m.BatchSize
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *EventRecordUpdated) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventScopeSpecMigrationProgress is an event message indicating a batch of scopes has been migrated from one scope
+// specification to another.
+type EventScopeSpecMigrationProgress struct {
+	// from_specification_addr is the bech32 address string of the scope specification being migrated away from.
+	FromSpecificationAddr string `protobuf:"bytes,1,opt,name=from_specification_addr,json=fromSpecificationAddr,proto3" json:"from_specification_addr,omitempty"`
+	// to_specification_addr is the bech32 address string of the scope specification being migrated to.
+	ToSpecificationAddr string `protobuf:"bytes,2,opt,name=to_specification_addr,json=toSpecificationAddr,proto3" json:"to_specification_addr,omitempty"`
+	// batch_count is the number of scopes updated in this batch.
+	BatchCount uint64 `protobuf:"varint,3,opt,name=batch_count,json=batchCount,proto3" json:"batch_count,omitempty"`
+	// scopes_migrated is the total number of scopes updated so far.
+	ScopesMigrated uint64 `protobuf:"varint,4,opt,name=scopes_migrated,json=scopesMigrated,proto3" json:"scopes_migrated,omitempty"`
 }
 
-func (m *EventRecordUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ScopeAddr) > 0 {
-		i -= len(m.ScopeAddr)
-		copy(dAtA[i:], m.ScopeAddr)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.ScopeAddr)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.SessionAddr) > 0 {
-		i -= len(m.SessionAddr)
-		copy(dAtA[i:], m.SessionAddr)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.SessionAddr)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.RecordAddr) > 0 {
-		i -= len(m.RecordAddr)
-		copy(dAtA[i:], m.RecordAddr)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.RecordAddr)))
-		i--
-		dAtA[i] = 0xa
+func (m *EventScopeSpecMigrationProgress) Reset()         { *m = EventScopeSpecMigrationProgress{} }
+func (m *EventScopeSpecMigrationProgress) String() string { return proto.CompactTextString(m) }
+func (*EventScopeSpecMigrationProgress) ProtoMessage()    {}
+func (*EventScopeSpecMigrationProgress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_476cf6cf9459cf25, []int{30}
+}
+func (m *EventScopeSpecMigrationProgress) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventScopeSpecMigrationProgress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventScopeSpecMigrationProgress.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *EventScopeSpecMigrationProgress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventScopeSpecMigrationProgress.Merge(m, src)
+}
+func (m *EventScopeSpecMigrationProgress) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventScopeSpecMigrationProgress) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventScopeSpecMigrationProgress.DiscardUnknown(m)
 }
 
-func (m *EventRecordDeleted) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_EventScopeSpecMigrationProgress proto.InternalMessageInfo
+
+func (m *EventScopeSpecMigrationProgress) GetFromSpecificationAddr() string {
+	if m != nil {
+		return m.FromSpecificationAddr
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventRecordDeleted) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventScopeSpecMigrationProgress) GetToSpecificationAddr() string {
+	if m != nil {
+		return m.ToSpecificationAddr
+	}
+	return ""
 }
 
-func (m *EventRecordDeleted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ScopeAddr) > 0 {
-		i -= len(m.ScopeAddr)
-		copy(dAtA[i:], m.ScopeAddr)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.ScopeAddr)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.RecordAddr) > 0 {
-		i -= len(m.RecordAddr)
-		copy(dAtA[i:], m.RecordAddr)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.RecordAddr)))
-		i--
-		dAtA[i] = 0xa
+func (m *EventScopeSpecMigrationProgress) GetBatchCount() uint64 {
+	if m != nil {
+		return m.BatchCount
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *EventScopeSpecificationCreated) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventScopeSpecMigrationProgress) GetScopesMigrated() uint64 {
+	if m != nil {
+		return m.ScopesMigrated
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *EventScopeSpecificationCreated) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventScopeSpecMigrationCompleted is an event message indicating there are no more scopes to migrate from one scope
+// specification to another.
+type EventScopeSpecMigrationCompleted struct {
+	// from_specification_addr is the bech32 address string of the scope specification that was migrated away from.
+	FromSpecificationAddr string `protobuf:"bytes,1,opt,name=from_specification_addr,json=fromSpecificationAddr,proto3" json:"from_specification_addr,omitempty"`
+	// to_specification_addr is the bech32 address string of the scope specification that was migrated to.
+	ToSpecificationAddr string `protobuf:"bytes,2,opt,name=to_specification_addr,json=toSpecificationAddr,proto3" json:"to_specification_addr,omitempty"`
+	// scopes_migrated is the total number of scopes that were updated.
+	ScopesMigrated uint64 `protobuf:"varint,3,opt,name=scopes_migrated,json=scopesMigrated,proto3" json:"scopes_migrated,omitempty"`
 }
 
-func (m *EventScopeSpecificationCreated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ScopeSpecificationAddr) > 0 {
-		i -= len(m.ScopeSpecificationAddr)
-		copy(dAtA[i:], m.ScopeSpecificationAddr)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.ScopeSpecificationAddr)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *EventScopeSpecMigrationCompleted) Reset()         { *m = EventScopeSpecMigrationCompleted{} }
+func (m *EventScopeSpecMigrationCompleted) String() string { return proto.CompactTextString(m) }
+func (*EventScopeSpecMigrationCompleted) ProtoMessage()    {}
+func (*EventScopeSpecMigrationCompleted) Descriptor() ([]byte, []int) {
+	return fileDescriptor_476cf6cf9459cf25, []int{31}
 }
-
-func (m *EventScopeSpecificationUpdated) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventScopeSpecMigrationCompleted) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventScopeSpecMigrationCompleted) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventScopeSpecMigrationCompleted.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *EventScopeSpecificationUpdated) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventScopeSpecMigrationCompleted) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventScopeSpecMigrationCompleted.Merge(m, src)
+}
+func (m *EventScopeSpecMigrationCompleted) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventScopeSpecMigrationCompleted) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventScopeSpecMigrationCompleted.DiscardUnknown(m)
 }
 
-func (m *EventScopeSpecificationUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ScopeSpecificationAddr) > 0 {
-		i -= len(m.ScopeSpecificationAddr)
-		copy(dAtA[i:], m.ScopeSpecificationAddr)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.ScopeSpecificationAddr)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_EventScopeSpecMigrationCompleted proto.InternalMessageInfo
+
+func (m *EventScopeSpecMigrationCompleted) GetFromSpecificationAddr() string {
+	if m != nil {
+		return m.FromSpecificationAddr
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *EventScopeSpecificationDeleted) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventScopeSpecMigrationCompleted) GetToSpecificationAddr() string {
+	if m != nil {
+		return m.ToSpecificationAddr
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventScopeSpecificationDeleted) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventScopeSpecMigrationCompleted) GetScopesMigrated() uint64 {
+	if m != nil {
+		return m.ScopesMigrated
+	}
+	return 0
 }
 
-func (m *EventScopeSpecificationDeleted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ScopeSpecificationAddr) > 0 {
-		i -= len(m.ScopeSpecificationAddr)
-		copy(dAtA[i:], m.ScopeSpecificationAddr)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.ScopeSpecificationAddr)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+// EventOSLocatorCreated is an event message indicating an object store locator has been created.
+type EventOSLocatorCreated struct {
+	// owner is the owner in the object store locator that was created.
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
 }
 
-func (m *EventContractSpecificationCreated) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *EventOSLocatorCreated) Reset()         { *m = EventOSLocatorCreated{} }
+func (m *EventOSLocatorCreated) String() string { return proto.CompactTextString(m) }
+func (*EventOSLocatorCreated) ProtoMessage()    {}
+func (*EventOSLocatorCreated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_476cf6cf9459cf25, []int{32}
 }
-
-func (m *EventContractSpecificationCreated) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventOSLocatorCreated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *EventContractSpecificationCreated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ContractSpecificationAddr) > 0 {
-		i -= len(m.ContractSpecificationAddr)
-		copy(dAtA[i:], m.ContractSpecificationAddr)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.ContractSpecificationAddr)))
-		i--
-		dAtA[i] = 0xa
+func (m *EventOSLocatorCreated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventOSLocatorCreated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *EventOSLocatorCreated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventOSLocatorCreated.Merge(m, src)
+}
+func (m *EventOSLocatorCreated) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventOSLocatorCreated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventOSLocatorCreated.DiscardUnknown(m)
 }
 
-func (m *EventContractSpecificationUpdated) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_EventOSLocatorCreated proto.InternalMessageInfo
+
+func (m *EventOSLocatorCreated) GetOwner() string {
+	if m != nil {
+		return m.Owner
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventContractSpecificationUpdated) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventOSLocatorUpdated is an event message indicating an object store locator has been updated.
+type EventOSLocatorUpdated struct {
+	// owner is the owner in the object store locator that was updated.
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
 }
 
-func (m *EventContractSpecificationUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ContractSpecificationAddr) > 0 {
-		i -= len(m.ContractSpecificationAddr)
-		copy(dAtA[i:], m.ContractSpecificationAddr)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.ContractSpecificationAddr)))
-		i--
-		dAtA[i] = 0xa
+func (m *EventOSLocatorUpdated) Reset()         { *m = EventOSLocatorUpdated{} }
+func (m *EventOSLocatorUpdated) String() string { return proto.CompactTextString(m) }
+func (*EventOSLocatorUpdated) ProtoMessage()    {}
+func (*EventOSLocatorUpdated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_476cf6cf9459cf25, []int{33}
+}
+func (m *EventOSLocatorUpdated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventOSLocatorUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventOSLocatorUpdated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *EventOSLocatorUpdated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventOSLocatorUpdated.Merge(m, src)
+}
+func (m *EventOSLocatorUpdated) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventOSLocatorUpdated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventOSLocatorUpdated.DiscardUnknown(m)
 }
 
-func (m *EventContractSpecificationDeleted) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_EventOSLocatorUpdated proto.InternalMessageInfo
+
+func (m *EventOSLocatorUpdated) GetOwner() string {
+	if m != nil {
+		return m.Owner
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventContractSpecificationDeleted) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventOSLocatorDeleted is an event message indicating an object store locator has been deleted.
+type EventOSLocatorDeleted struct {
+	// owner is the owner in the object store locator that was deleted.
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
 }
 
-func (m *EventContractSpecificationDeleted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ContractSpecificationAddr) > 0 {
-		i -= len(m.ContractSpecificationAddr)
-		copy(dAtA[i:], m.ContractSpecificationAddr)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.ContractSpecificationAddr)))
-		i--
-		dAtA[i] = 0xa
+func (m *EventOSLocatorDeleted) Reset()         { *m = EventOSLocatorDeleted{} }
+func (m *EventOSLocatorDeleted) String() string { return proto.CompactTextString(m) }
+func (*EventOSLocatorDeleted) ProtoMessage()    {}
+func (*EventOSLocatorDeleted) Descriptor() ([]byte, []int) {
+	return fileDescriptor_476cf6cf9459cf25, []int{34}
+}
+func (m *EventOSLocatorDeleted) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventOSLocatorDeleted) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventOSLocatorDeleted.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *EventOSLocatorDeleted) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventOSLocatorDeleted.Merge(m, src)
+}
+func (m *EventOSLocatorDeleted) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventOSLocatorDeleted) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventOSLocatorDeleted.DiscardUnknown(m)
 }
 
-func (m *EventRecordSpecificationCreated) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_EventOSLocatorDeleted proto.InternalMessageInfo
+
+func (m *EventOSLocatorDeleted) GetOwner() string {
+	if m != nil {
+		return m.Owner
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventRecordSpecificationCreated) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventSetNetAssetValue event emitted when Net Asset Value for a scope is update or added
+type EventSetNetAssetValue struct {
+	ScopeId string `protobuf:"bytes,1,opt,name=scope_id,json=scopeId,proto3" json:"scope_id,omitempty"`
+	Price   string `protobuf:"bytes,2,opt,name=price,proto3" json:"price,omitempty"`
+	Source  string `protobuf:"bytes,3,opt,name=source,proto3" json:"source,omitempty"`
+	Volume  string `protobuf:"bytes,4,opt,name=volume,proto3" json:"volume,omitempty"`
 }
 
-func (m *EventRecordSpecificationCreated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ContractSpecificationAddr) > 0 {
-		i -= len(m.ContractSpecificationAddr)
-		copy(dAtA[i:], m.ContractSpecificationAddr)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.ContractSpecificationAddr)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.RecordSpecificationAddr) > 0 {
-		i -= len(m.RecordSpecificationAddr)
-		copy(dAtA[i:], m.RecordSpecificationAddr)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.RecordSpecificationAddr)))
-		i--
-		dAtA[i] = 0xa
+func (m *EventSetNetAssetValue) Reset()         { *m = EventSetNetAssetValue{} }
+func (m *EventSetNetAssetValue) String() string { return proto.CompactTextString(m) }
+func (*EventSetNetAssetValue) ProtoMessage()    {}
+func (*EventSetNetAssetValue) Descriptor() ([]byte, []int) {
+	return fileDescriptor_476cf6cf9459cf25, []int{35}
+}
+func (m *EventSetNetAssetValue) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventSetNetAssetValue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventSetNetAssetValue.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *EventSetNetAssetValue) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventSetNetAssetValue.Merge(m, src)
+}
+func (m *EventSetNetAssetValue) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventSetNetAssetValue) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventSetNetAssetValue.DiscardUnknown(m)
 }
 
-func (m *EventRecordSpecificationUpdated) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_EventSetNetAssetValue proto.InternalMessageInfo
+
+func (m *EventSetNetAssetValue) GetScopeId() string {
+	if m != nil {
+		return m.ScopeId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventRecordSpecificationUpdated) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventSetNetAssetValue) GetPrice() string {
+	if m != nil {
+		return m.Price
+	}
+	return ""
 }
 
-func (m *EventRecordSpecificationUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventSetNetAssetValue) GetSource() string {
+	if m != nil {
+		return m.Source
+	}
+	return ""
+}
+
+func (m *EventSetNetAssetValue) GetVolume() string {
+	if m != nil {
+		return m.Volume
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*EventTxCompleted)(nil), "provenance.metadata.v1.EventTxCompleted")
+	proto.RegisterType((*EventScopeCreated)(nil), "provenance.metadata.v1.EventScopeCreated")
+	proto.RegisterType((*EventScopeUpdated)(nil), "provenance.metadata.v1.EventScopeUpdated")
+	proto.RegisterType((*EventScopeDeleted)(nil), "provenance.metadata.v1.EventScopeDeleted")
+	proto.RegisterType((*EventScopeDataAccessChanged)(nil), "provenance.metadata.v1.EventScopeDataAccessChanged")
+	proto.RegisterType((*EventScopeValueOwnerChanged)(nil), "provenance.metadata.v1.EventScopeValueOwnerChanged")
+	proto.RegisterType((*EventScopePartySubstituted)(nil), "provenance.metadata.v1.EventScopePartySubstituted")
+	proto.RegisterType((*EventScopeSettlementOpened)(nil), "provenance.metadata.v1.EventScopeSettlementOpened")
+	proto.RegisterType((*EventScopeSettlementFunded)(nil), "provenance.metadata.v1.EventScopeSettlementFunded")
+	proto.RegisterType((*EventScopeSettlementSettled)(nil), "provenance.metadata.v1.EventScopeSettlementSettled")
+	proto.RegisterType((*EventScopeSettlementCancelled)(nil), "provenance.metadata.v1.EventScopeSettlementCancelled")
+	proto.RegisterType((*EventSessionCreated)(nil), "provenance.metadata.v1.EventSessionCreated")
+	proto.RegisterType((*EventSessionUpdated)(nil), "provenance.metadata.v1.EventSessionUpdated")
+	proto.RegisterType((*EventSessionStatusChanged)(nil), "provenance.metadata.v1.EventSessionStatusChanged")
+	proto.RegisterType((*EventSessionDeleted)(nil), "provenance.metadata.v1.EventSessionDeleted")
+	proto.RegisterType((*EventRecordCreated)(nil), "provenance.metadata.v1.EventRecordCreated")
+	proto.RegisterType((*EventRecordUpdated)(nil), "provenance.metadata.v1.EventRecordUpdated")
+	proto.RegisterType((*EventRecordDeleted)(nil), "provenance.metadata.v1.EventRecordDeleted")
+	proto.RegisterType((*EventScopeSpecificationCreated)(nil), "provenance.metadata.v1.EventScopeSpecificationCreated")
+	proto.RegisterType((*EventScopeSpecificationUpdated)(nil), "provenance.metadata.v1.EventScopeSpecificationUpdated")
+	proto.RegisterType((*EventScopeSpecificationDeleted)(nil), "provenance.metadata.v1.EventScopeSpecificationDeleted")
+	proto.RegisterType((*EventContractSpecificationCreated)(nil), "provenance.metadata.v1.EventContractSpecificationCreated")
+	proto.RegisterType((*EventContractSpecificationUpdated)(nil), "provenance.metadata.v1.EventContractSpecificationUpdated")
+	proto.RegisterType((*EventContractSpecificationDeleted)(nil), "provenance.metadata.v1.EventContractSpecificationDeleted")
+	proto.RegisterType((*EventContractSpecificationDeprecated)(nil), "provenance.metadata.v1.EventContractSpecificationDeprecated")
+	proto.RegisterType((*EventSessionUsesDeprecatedSpecification)(nil), "provenance.metadata.v1.EventSessionUsesDeprecatedSpecification")
+	proto.RegisterType((*EventRecordSpecificationCreated)(nil), "provenance.metadata.v1.EventRecordSpecificationCreated")
+	proto.RegisterType((*EventRecordSpecificationUpdated)(nil), "provenance.metadata.v1.EventRecordSpecificationUpdated")
+	proto.RegisterType((*EventRecordSpecificationDeleted)(nil), "provenance.metadata.v1.EventRecordSpecificationDeleted")
+	proto.RegisterType((*EventScopeSpecMigrationStarted)(nil), "provenance.metadata.v1.EventScopeSpecMigrationStarted")
+	proto.RegisterType((*EventScopeSpecMigrationProgress)(nil), "provenance.metadata.v1.EventScopeSpecMigrationProgress")
+	proto.RegisterType((*EventScopeSpecMigrationCompleted)(nil), "provenance.metadata.v1.EventScopeSpecMigrationCompleted")
+	proto.RegisterType((*EventOSLocatorCreated)(nil), "provenance.metadata.v1.EventOSLocatorCreated")
+	proto.RegisterType((*EventOSLocatorUpdated)(nil), "provenance.metadata.v1.EventOSLocatorUpdated")
+	proto.RegisterType((*EventOSLocatorDeleted)(nil), "provenance.metadata.v1.EventOSLocatorDeleted")
+	proto.RegisterType((*EventSetNetAssetValue)(nil), "provenance.metadata.v1.EventSetNetAssetValue")
+}
+
+func init() {
+	proto.RegisterFile("provenance/metadata/v1/events.proto", fileDescriptor_476cf6cf9459cf25)
+}
+
+var fileDescriptor_476cf6cf9459cf25 = []byte{
+	// 1058 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0x4f, 0x6f, 0x1b, 0x45,
+	0x14, 0xcf, 0xda, 0x49, 0xda, 0xbc, 0x24, 0x2d, 0x6c, 0x93, 0xd4, 0x69, 0x89, 0x93, 0xb8, 0x88,
+	0xf6, 0xd2, 0x84, 0x16, 0x84, 0x10, 0x07, 0xa4, 0xd4, 0x05, 0x09, 0x89, 0x92, 0x60, 0x17, 0x90,
+	0x7a, 0x31, 0xe3, 0xd9, 0x57, 0x67, 0xc5, 0x7a, 0x67, 0x35, 0x33, 0xeb, 0x24, 0xbd, 0xc0, 0x89,
+	0x33, 0x5f, 0x80, 0x23, 0x27, 0x38, 0x70, 0x83, 0x8f, 0xc0, 0xb1, 0xe2, 0x02, 0x47, 0x94, 0x7c,
+	0x11, 0xb4, 0xf3, 0xc7, 0x9e, 0x8d, 0xd7, 0xb1, 0x21, 0x6d, 0xe0, 0xe6, 0xf7, 0xe6, 0xbd, 0xdf,
+	0xef, 0x37, 0x6f, 0xde, 0x3c, 0x7b, 0x0c, 0xb7, 0x12, 0xce, 0x7a, 0x18, 0x93, 0x98, 0xe2, 0x76,
+	0x17, 0x25, 0x09, 0x88, 0x24, 0xdb, 0xbd, 0x7b, 0xdb, 0xd8, 0xc3, 0x58, 0x8a, 0xad, 0x84, 0x33,
+	0xc9, 0xfc, 0x95, 0x41, 0xd0, 0x96, 0x0d, 0xda, 0xea, 0xdd, 0xab, 0x7d, 0x09, 0xaf, 0x7c, 0x90,
+	0xc5, 0x3d, 0x3e, 0xac, 0xb3, 0x6e, 0x12, 0xa1, 0xc4, 0xc0, 0x5f, 0x81, 0xd9, 0x2e, 0x0b, 0xd2,
+	0x08, 0x2b, 0xde, 0x86, 0x77, 0x67, 0xae, 0x61, 0x2c, 0xff, 0x06, 0x5c, 0xc6, 0x38, 0x48, 0x58,
+	0x18, 0xcb, 0x4a, 0x49, 0xad, 0xf4, 0x6d, 0xbf, 0x02, 0x97, 0x44, 0xd8, 0x89, 0x91, 0x8b, 0x4a,
+	0x79, 0xa3, 0x7c, 0x67, 0xae, 0x61, 0xcd, 0xda, 0x7d, 0x78, 0x55, 0x31, 0x34, 0x29, 0x4b, 0xb0,
+	0xce, 0x91, 0x64, 0x14, 0x6b, 0x00, 0x22, 0xb3, 0x5b, 0x24, 0x08, 0xb8, 0xa1, 0x99, 0x53, 0x9e,
+	0x9d, 0x20, 0xe0, 0xf9, 0x9c, 0xcf, 0x92, 0xe0, 0x1f, 0xe7, 0x3c, 0xc4, 0x08, 0x27, 0xc8, 0xf9,
+	0xd6, 0x83, 0x9b, 0x4e, 0x12, 0x91, 0x64, 0x87, 0x52, 0x14, 0xa2, 0xbe, 0x4f, 0xe2, 0xce, 0xd8,
+	0x74, 0x7f, 0x09, 0x66, 0x48, 0x10, 0x60, 0x50, 0x29, 0xa9, 0x2d, 0x6b, 0x23, 0x2b, 0x05, 0xc7,
+	0x2e, 0xeb, 0x61, 0x60, 0x4b, 0x61, 0x4c, 0xb7, 0x48, 0xd3, 0xf9, 0x22, 0xfd, 0x9c, 0x13, 0xf2,
+	0x39, 0x89, 0x52, 0xdc, 0x3d, 0x88, 0x91, 0x4f, 0x28, 0xe4, 0x4d, 0x58, 0x4a, 0x38, 0xf6, 0x42,
+	0x96, 0x8a, 0x56, 0x2f, 0x4b, 0x6e, 0xb1, 0x2c, 0xdb, 0x9c, 0x92, 0x6f, 0xd7, 0x06, 0xb8, 0xfe,
+	0x1b, 0x70, 0x35, 0xc6, 0x83, 0x5c, 0x70, 0x59, 0x05, 0x2f, 0xc6, 0x78, 0xe0, 0xc4, 0x8d, 0x96,
+	0xfc, 0xab, 0x07, 0x37, 0x06, 0x92, 0xf7, 0x08, 0x97, 0x47, 0xcd, 0xb4, 0x2d, 0x64, 0x28, 0xd3,
+	0xf1, 0x95, 0x57, 0xbd, 0x74, 0x18, 0x0a, 0x19, 0xc6, 0x9d, 0x7e, 0x2f, 0x19, 0xdb, 0xdf, 0x80,
+	0x79, 0x8e, 0x49, 0x44, 0x28, 0x76, 0x31, 0x96, 0x46, 0x97, 0xeb, 0xf2, 0x6f, 0xc1, 0xa2, 0x40,
+	0x21, 0x42, 0x16, 0x2b, 0x78, 0xab, 0x6d, 0xc1, 0x38, 0x33, 0x06, 0xe1, 0x4a, 0x9f, 0xc9, 0x4b,
+	0xff, 0xda, 0x55, 0xde, 0x44, 0x29, 0x23, 0x05, 0xbb, 0x9b, 0x60, 0x3c, 0x5e, 0xf9, 0x0a, 0xcc,
+	0x0a, 0x8c, 0xa2, 0x7e, 0x75, 0x8d, 0x95, 0x35, 0x43, 0x3b, 0x3d, 0xea, 0xd7, 0x51, 0x1b, 0x99,
+	0x37, 0xe1, 0x21, 0xc5, 0xca, 0xb4, 0xf6, 0x2a, 0xa3, 0xf6, 0x69, 0xb1, 0x80, 0x0f, 0xd3, 0x38,
+	0x98, 0xa8, 0xeb, 0x34, 0x51, 0xc9, 0x21, 0xaa, 0x7d, 0x93, 0xeb, 0xa0, 0x01, 0xa6, 0xfe, 0x74,
+	0x21, 0xbb, 0x22, 0xb0, 0x56, 0xa4, 0xa0, 0x9e, 0x0d, 0x9d, 0x68, 0x02, 0x0d, 0x9b, 0xb0, 0x40,
+	0x6d, 0x6c, 0xab, 0x7d, 0x64, 0x94, 0xcc, 0xf7, 0x7d, 0x0f, 0x8e, 0x6a, 0x5f, 0xc0, 0x35, 0x4d,
+	0xa1, 0x0f, 0xda, 0x8e, 0x93, 0x4d, 0x58, 0x70, 0xfb, 0xc1, 0x40, 0xcf, 0x3b, 0xed, 0x70, 0x8a,
+	0xbb, 0x74, 0x7a, 0x12, 0x9c, 0x02, 0xb6, 0x33, 0xe7, 0xfc, 0xc0, 0x3f, 0x78, 0xb0, 0xea, 0x22,
+	0x37, 0x25, 0x91, 0x69, 0x7f, 0xc0, 0x9c, 0x1b, 0xdf, 0xbf, 0x0d, 0x57, 0xfb, 0x57, 0x5f, 0x28,
+	0x6c, 0x73, 0x54, 0x57, 0xac, 0x5b, 0x33, 0x66, 0x38, 0xd9, 0x8d, 0x37, 0x31, 0xfa, 0xe0, 0xe6,
+	0x62, 0x3c, 0xd0, 0xcb, 0xa7, 0x0b, 0x60, 0x07, 0xe8, 0xf9, 0x0b, 0x70, 0x00, 0xbe, 0x02, 0x6e,
+	0x20, 0x65, 0x3c, 0xb0, 0x27, 0xb6, 0x9e, 0xdd, 0xf1, 0xcc, 0xe1, 0xc2, 0x82, 0x76, 0xd9, 0x66,
+	0xc8, 0x11, 0x97, 0xc6, 0x11, 0x97, 0xcf, 0x26, 0xb6, 0x27, 0x7a, 0x01, 0xc4, 0x8f, 0x73, 0xc4,
+	0xb6, 0x92, 0x63, 0x89, 0xc7, 0xa0, 0x3e, 0x81, 0xaa, 0x73, 0xbb, 0x12, 0xa4, 0xe1, 0xd3, 0x90,
+	0x12, 0xe9, 0xdc, 0x82, 0x77, 0xa1, 0xa2, 0x01, 0x84, 0xbb, 0xea, 0xd2, 0xad, 0x88, 0xa1, 0xe4,
+	0x31, 0xd8, 0xb6, 0x6c, 0x2f, 0x03, 0xdb, 0x56, 0xe6, 0xdf, 0x63, 0x53, 0xd8, 0x54, 0xd8, 0x75,
+	0x16, 0x4b, 0x4e, 0xa8, 0x2c, 0x2c, 0xcb, 0xfb, 0x70, 0x93, 0x9a, 0xf5, 0xd1, 0x0c, 0xab, 0xb4,
+	0x08, 0x62, 0x3c, 0x89, 0xad, 0xcf, 0x4b, 0x25, 0xb1, 0x85, 0x3a, 0x2f, 0xc9, 0x4f, 0x1e, 0xbc,
+	0x7e, 0x16, 0x4b, 0xc2, 0x91, 0xbe, 0x88, 0xdd, 0xf8, 0x0f, 0xa1, 0xea, 0x7c, 0x5d, 0x17, 0x41,
+	0xe8, 0x5b, 0xf5, 0x9a, 0x13, 0x35, 0x2c, 0xf7, 0x77, 0x0f, 0x6e, 0xe7, 0x86, 0xb2, 0x40, 0x31,
+	0x10, 0x99, 0x8b, 0x9f, 0x64, 0x4e, 0x8d, 0xd9, 0x54, 0xe9, 0xfc, 0x9b, 0x2a, 0x4f, 0xb0, 0xa9,
+	0xef, 0x3d, 0x58, 0x77, 0xa6, 0x43, 0x61, 0xc7, 0xbe, 0x07, 0xab, 0x66, 0x54, 0x8c, 0x2c, 0xfe,
+	0x75, 0x3e, 0x9c, 0xfe, 0x22, 0x76, 0x79, 0xa6, 0x3e, 0xdb, 0xec, 0xff, 0x57, 0x7d, 0xf6, 0x9e,
+	0xfc, 0x97, 0xfa, 0x7e, 0xf4, 0x4e, 0xcf, 0xbb, 0x47, 0x61, 0x87, 0xab, 0xf5, 0xa6, 0x24, 0x3c,
+	0x93, 0xf7, 0x0e, 0x5c, 0x7f, 0xca, 0x59, 0x77, 0xb4, 0xb8, 0xe5, 0x6c, 0x79, 0x58, 0xda, 0x7d,
+	0x58, 0x96, 0x6c, 0xb4, 0xa8, 0x6b, 0x92, 0x0d, 0xe7, 0xac, 0x01, 0xb4, 0x89, 0xa4, 0xfb, 0x2d,
+	0x11, 0x3e, 0x43, 0xd5, 0xa0, 0x8b, 0x8d, 0x39, 0xe5, 0x69, 0x86, 0xcf, 0xb0, 0xf6, 0x87, 0xad,
+	0xe6, 0xb0, 0xda, 0x3d, 0xce, 0x3a, 0x1c, 0x85, 0xb8, 0x50, 0xb9, 0xeb, 0x30, 0xaf, 0xe5, 0x52,
+	0x96, 0x9a, 0x9f, 0xfe, 0xd3, 0x0d, 0xbd, 0x83, 0x7a, 0xe6, 0xc9, 0x7e, 0xee, 0xa8, 0xef, 0x02,
+	0xd1, 0xea, 0x2a, 0xa1, 0x18, 0xa8, 0x9f, 0x32, 0xd3, 0x8d, 0x2b, 0xda, 0xfd, 0xc8, 0x78, 0x6b,
+	0xbf, 0x78, 0xb0, 0x31, 0x62, 0x67, 0x83, 0x97, 0xee, 0x45, 0x6e, 0xad, 0x40, 0x79, 0xb9, 0x50,
+	0xf9, 0x5d, 0x58, 0x56, 0xc2, 0x77, 0x9b, 0x1f, 0x33, 0x4a, 0x24, 0xe3, 0x76, 0x2c, 0x2c, 0xc1,
+	0x8c, 0x7e, 0xa9, 0x69, 0x6d, 0xda, 0x18, 0x0e, 0xb7, 0xb7, 0x74, 0xc2, 0x70, 0x7b, 0x69, 0x8a,
+	0xc3, 0x0f, 0x4d, 0x78, 0x13, 0xe5, 0x27, 0x28, 0x77, 0x84, 0x40, 0xa9, 0x5e, 0x87, 0xfe, 0x2a,
+	0x5c, 0xd6, 0x5f, 0xda, 0x61, 0x60, 0x32, 0x2e, 0x29, 0xfb, 0xa3, 0x60, 0xf0, 0x3a, 0x28, 0x39,
+	0xaf, 0x03, 0xf5, 0xc2, 0x60, 0x29, 0xa7, 0x68, 0xc6, 0xa4, 0xb1, 0x32, 0x7f, 0x8f, 0x45, 0x69,
+	0xd7, 0x3e, 0x26, 0x8c, 0xf5, 0xe0, 0xab, 0xdf, 0x8e, 0xab, 0xde, 0xf3, 0xe3, 0xaa, 0xf7, 0xd7,
+	0x71, 0xd5, 0xfb, 0xee, 0xa4, 0x3a, 0xf5, 0xfc, 0xa4, 0x3a, 0xf5, 0xe7, 0x49, 0x75, 0x0a, 0x56,
+	0x43, 0xb6, 0x55, 0xfc, 0x77, 0xc6, 0x9e, 0xf7, 0xe4, 0xed, 0x4e, 0x28, 0xf7, 0xd3, 0xf6, 0x16,
+	0x65, 0xdd, 0xed, 0x41, 0xd0, 0xdd, 0x90, 0x39, 0xd6, 0xf6, 0xe1, 0xe0, 0x8f, 0x12, 0x79, 0x94,
+	0xa0, 0x68, 0xcf, 0xaa, 0x7f, 0x49, 0xde, 0xfa, 0x7b, 0x00, 0x6c, 0x6a, 0x8b, 0x1a, 0x4c, 0x11,
+	0x00, 0x00,
+}
+
+func (m *EventTxCompleted) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventTxCompleted) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventTxCompleted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.ContractSpecificationAddr) > 0 {
-		i -= len(m.ContractSpecificationAddr)
-		copy(dAtA[i:], m.ContractSpecificationAddr)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.ContractSpecificationAddr)))
+	if len(m.Signers) > 0 {
+		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Signers[iNdEx])
+			copy(dAtA[i:], m.Signers[iNdEx])
+			i = encodeVarintEvents(dAtA, i, uint64(len(m.Signers[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Endpoint) > 0 {
+		i -= len(m.Endpoint)
+		copy(dAtA[i:], m.Endpoint)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Endpoint)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.RecordSpecificationAddr) > 0 {
-		i -= len(m.RecordSpecificationAddr)
-		copy(dAtA[i:], m.RecordSpecificationAddr)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.RecordSpecificationAddr)))
+	if len(m.Module) > 0 {
+		i -= len(m.Module)
+		copy(dAtA[i:], m.Module)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Module)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *EventRecordSpecificationDeleted) Marshal() (dAtA []byte, err error) {
+func (m *EventScopeCreated) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1938,34 +2290,27 @@ func (m *EventRecordSpecificationDeleted) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventRecordSpecificationDeleted) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventScopeCreated) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventRecordSpecificationDeleted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventScopeCreated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.ContractSpecificationAddr) > 0 {
-		i -= len(m.ContractSpecificationAddr)
-		copy(dAtA[i:], m.ContractSpecificationAddr)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.ContractSpecificationAddr)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.RecordSpecificationAddr) > 0 {
-		i -= len(m.RecordSpecificationAddr)
-		copy(dAtA[i:], m.RecordSpecificationAddr)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.RecordSpecificationAddr)))
+	if len(m.ScopeAddr) > 0 {
+		i -= len(m.ScopeAddr)
+		copy(dAtA[i:], m.ScopeAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ScopeAddr)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *EventOSLocatorCreated) Marshal() (dAtA []byte, err error) {
+func (m *EventScopeUpdated) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1975,27 +2320,27 @@ func (m *EventOSLocatorCreated) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventOSLocatorCreated) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventScopeUpdated) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventOSLocatorCreated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventScopeUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Owner)))
+	if len(m.ScopeAddr) > 0 {
+		i -= len(m.ScopeAddr)
+		copy(dAtA[i:], m.ScopeAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ScopeAddr)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *EventOSLocatorUpdated) Marshal() (dAtA []byte, err error) {
+func (m *EventScopeDeleted) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2005,27 +2350,27 @@ func (m *EventOSLocatorUpdated) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventOSLocatorUpdated) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventScopeDeleted) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventOSLocatorUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventScopeDeleted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Owner)))
+	if len(m.ScopeAddr) > 0 {
+		i -= len(m.ScopeAddr)
+		copy(dAtA[i:], m.ScopeAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ScopeAddr)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *EventOSLocatorDeleted) Marshal() (dAtA []byte, err error) {
+func (m *EventScopeDataAccessChanged) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2035,27 +2380,54 @@ func (m *EventOSLocatorDeleted) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventOSLocatorDeleted) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventScopeDataAccessChanged) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventOSLocatorDeleted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventScopeDataAccessChanged) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Owner)))
+	if len(m.Signers) > 0 {
+		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Signers[iNdEx])
+			copy(dAtA[i:], m.Signers[iNdEx])
+			i = encodeVarintEvents(dAtA, i, uint64(len(m.Signers[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Removed) > 0 {
+		for iNdEx := len(m.Removed) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Removed[iNdEx])
+			copy(dAtA[i:], m.Removed[iNdEx])
+			i = encodeVarintEvents(dAtA, i, uint64(len(m.Removed[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Added) > 0 {
+		for iNdEx := len(m.Added) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Added[iNdEx])
+			copy(dAtA[i:], m.Added[iNdEx])
+			i = encodeVarintEvents(dAtA, i, uint64(len(m.Added[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ScopeAddr) > 0 {
+		i -= len(m.ScopeAddr)
+		copy(dAtA[i:], m.ScopeAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ScopeAddr)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *EventSetNetAssetValue) Marshal() (dAtA []byte, err error) {
+func (m *EventScopeValueOwnerChanged) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2065,430 +2437,3964 @@ func (m *EventSetNetAssetValue) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventSetNetAssetValue) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventScopeValueOwnerChanged) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventSetNetAssetValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventScopeValueOwnerChanged) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Volume) > 0 {
-		i -= len(m.Volume)
-		copy(dAtA[i:], m.Volume)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Volume)))
-		i--
-		dAtA[i] = 0x22
+	if len(m.Signers) > 0 {
+		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Signers[iNdEx])
+			copy(dAtA[i:], m.Signers[iNdEx])
+			i = encodeVarintEvents(dAtA, i, uint64(len(m.Signers[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
 	}
-	if len(m.Source) > 0 {
-		i -= len(m.Source)
-		copy(dAtA[i:], m.Source)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Source)))
+	if len(m.NewValueOwner) > 0 {
+		i -= len(m.NewValueOwner)
+		copy(dAtA[i:], m.NewValueOwner)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.NewValueOwner)))
 		i--
 		dAtA[i] = 0x1a
 	}
-	if len(m.Price) > 0 {
-		i -= len(m.Price)
-		copy(dAtA[i:], m.Price)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Price)))
+	if len(m.PreviousValueOwner) > 0 {
+		i -= len(m.PreviousValueOwner)
+		copy(dAtA[i:], m.PreviousValueOwner)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.PreviousValueOwner)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.ScopeId) > 0 {
-		i -= len(m.ScopeId)
-		copy(dAtA[i:], m.ScopeId)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.ScopeId)))
+	if len(m.ScopeAddr) > 0 {
+		i -= len(m.ScopeAddr)
+		copy(dAtA[i:], m.ScopeAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ScopeAddr)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
-	offset -= sovEvents(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *EventScopePartySubstituted) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *EventTxCompleted) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *EventScopePartySubstituted) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventScopePartySubstituted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Module)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.Endpoint)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
 	if len(m.Signers) > 0 {
-		for _, s := range m.Signers {
-			l = len(s)
-			n += 1 + l + sovEvents(uint64(l))
+		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Signers[iNdEx])
+			copy(dAtA[i:], m.Signers[iNdEx])
+			i = encodeVarintEvents(dAtA, i, uint64(len(m.Signers[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
 		}
 	}
-	return n
-}
-
-func (m *EventScopeCreated) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.SessionAddrs) > 0 {
+		for iNdEx := len(m.SessionAddrs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.SessionAddrs[iNdEx])
+			copy(dAtA[i:], m.SessionAddrs[iNdEx])
+			i = encodeVarintEvents(dAtA, i, uint64(len(m.SessionAddrs[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
 	}
-	var l int
-	_ = l
-	l = len(m.ScopeAddr)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.Replacement) > 0 {
+		i -= len(m.Replacement)
+		copy(dAtA[i:], m.Replacement)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Replacement)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	return n
-}
-
-func (m *EventScopeUpdated) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.Existing) > 0 {
+		i -= len(m.Existing)
+		copy(dAtA[i:], m.Existing)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Existing)))
+		i--
+		dAtA[i] = 0x12
 	}
-	var l int
-	_ = l
-	l = len(m.ScopeAddr)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.ScopeAddr) > 0 {
+		i -= len(m.ScopeAddr)
+		copy(dAtA[i:], m.ScopeAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ScopeAddr)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventScopeDeleted) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ScopeAddr)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+func (m *EventScopeSettlementOpened) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *EventSessionCreated) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.SessionAddr)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.ScopeAddr)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	return n
+func (m *EventScopeSettlementOpened) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventSessionUpdated) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *EventScopeSettlementOpened) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.SessionAddr)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.Price) > 0 {
+		i -= len(m.Price)
+		copy(dAtA[i:], m.Price)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Price)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.ScopeAddr)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.Buyer) > 0 {
+		i -= len(m.Buyer)
+		copy(dAtA[i:], m.Buyer)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Buyer)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	return n
+	if len(m.Seller) > 0 {
+		i -= len(m.Seller)
+		copy(dAtA[i:], m.Seller)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Seller)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ScopeAddr) > 0 {
+		i -= len(m.ScopeAddr)
+		copy(dAtA[i:], m.ScopeAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ScopeAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *EventSessionDeleted) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventScopeSettlementFunded) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventScopeSettlementFunded) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventScopeSettlementFunded) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.SessionAddr)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.Buyer) > 0 {
+		i -= len(m.Buyer)
+		copy(dAtA[i:], m.Buyer)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Buyer)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.ScopeAddr)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.ScopeAddr) > 0 {
+		i -= len(m.ScopeAddr)
+		copy(dAtA[i:], m.ScopeAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ScopeAddr)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventRecordCreated) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventScopeSettlementSettled) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventScopeSettlementSettled) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventScopeSettlementSettled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.RecordAddr)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.Price) > 0 {
+		i -= len(m.Price)
+		copy(dAtA[i:], m.Price)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Price)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.SessionAddr)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.Buyer) > 0 {
+		i -= len(m.Buyer)
+		copy(dAtA[i:], m.Buyer)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Buyer)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.ScopeAddr)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.Seller) > 0 {
+		i -= len(m.Seller)
+		copy(dAtA[i:], m.Seller)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Seller)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.ScopeAddr) > 0 {
+		i -= len(m.ScopeAddr)
+		copy(dAtA[i:], m.ScopeAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ScopeAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *EventRecordUpdated) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventScopeSettlementCancelled) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventScopeSettlementCancelled) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventScopeSettlementCancelled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.RecordAddr)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.SessionAddr)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.CancelledBy) > 0 {
+		i -= len(m.CancelledBy)
+		copy(dAtA[i:], m.CancelledBy)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.CancelledBy)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.ScopeAddr)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.ScopeAddr) > 0 {
+		i -= len(m.ScopeAddr)
+		copy(dAtA[i:], m.ScopeAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ScopeAddr)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventRecordDeleted) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventSessionCreated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSessionCreated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSessionCreated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.RecordAddr)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.ScopeAddr) > 0 {
+		i -= len(m.ScopeAddr)
+		copy(dAtA[i:], m.ScopeAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ScopeAddr)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.ScopeAddr)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.SessionAddr) > 0 {
+		i -= len(m.SessionAddr)
+		copy(dAtA[i:], m.SessionAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.SessionAddr)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventScopeSpecificationCreated) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ScopeSpecificationAddr)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+func (m *EventSessionUpdated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *EventScopeSpecificationUpdated) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ScopeSpecificationAddr)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	return n
+func (m *EventSessionUpdated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventScopeSpecificationDeleted) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *EventSessionUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ScopeSpecificationAddr)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.ScopeAddr) > 0 {
+		i -= len(m.ScopeAddr)
+		copy(dAtA[i:], m.ScopeAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ScopeAddr)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.SessionAddr) > 0 {
+		i -= len(m.SessionAddr)
+		copy(dAtA[i:], m.SessionAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.SessionAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *EventContractSpecificationCreated) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ContractSpecificationAddr)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+func (m *EventSessionStatusChanged) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *EventContractSpecificationUpdated) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ContractSpecificationAddr)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	return n
+func (m *EventSessionStatusChanged) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventContractSpecificationDeleted) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *EventSessionStatusChanged) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ContractSpecificationAddr)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.NewStatus) > 0 {
+		i -= len(m.NewStatus)
+		copy(dAtA[i:], m.NewStatus)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.NewStatus)))
+		i--
+		dAtA[i] = 0x22
 	}
-	return n
-}
-
-func (m *EventRecordSpecificationCreated) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.PreviousStatus) > 0 {
+		i -= len(m.PreviousStatus)
+		copy(dAtA[i:], m.PreviousStatus)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.PreviousStatus)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	var l int
-	_ = l
-	l = len(m.RecordSpecificationAddr)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.ScopeAddr) > 0 {
+		i -= len(m.ScopeAddr)
+		copy(dAtA[i:], m.ScopeAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ScopeAddr)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.ContractSpecificationAddr)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.SessionAddr) > 0 {
+		i -= len(m.SessionAddr)
+		copy(dAtA[i:], m.SessionAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.SessionAddr)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventRecordSpecificationUpdated) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventSessionDeleted) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSessionDeleted) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSessionDeleted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.RecordSpecificationAddr)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.ScopeAddr) > 0 {
+		i -= len(m.ScopeAddr)
+		copy(dAtA[i:], m.ScopeAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ScopeAddr)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.ContractSpecificationAddr)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.SessionAddr) > 0 {
+		i -= len(m.SessionAddr)
+		copy(dAtA[i:], m.SessionAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.SessionAddr)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventRecordSpecificationDeleted) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventRecordCreated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventRecordCreated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventRecordCreated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.RecordSpecificationAddr)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.ScopeAddr) > 0 {
+		i -= len(m.ScopeAddr)
+		copy(dAtA[i:], m.ScopeAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ScopeAddr)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.ContractSpecificationAddr)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.SessionAddr) > 0 {
+		i -= len(m.SessionAddr)
+		copy(dAtA[i:], m.SessionAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.SessionAddr)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.RecordAddr) > 0 {
+		i -= len(m.RecordAddr)
+		copy(dAtA[i:], m.RecordAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.RecordAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *EventOSLocatorCreated) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventRecordUpdated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventRecordUpdated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventRecordUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.ScopeAddr) > 0 {
+		i -= len(m.ScopeAddr)
+		copy(dAtA[i:], m.ScopeAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ScopeAddr)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	return n
+	if len(m.SessionAddr) > 0 {
+		i -= len(m.SessionAddr)
+		copy(dAtA[i:], m.SessionAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.SessionAddr)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RecordAddr) > 0 {
+		i -= len(m.RecordAddr)
+		copy(dAtA[i:], m.RecordAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.RecordAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *EventOSLocatorUpdated) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+func (m *EventRecordDeleted) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *EventOSLocatorDeleted) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventRecordDeleted) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventRecordDeleted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ScopeAddr) > 0 {
+		i -= len(m.ScopeAddr)
+		copy(dAtA[i:], m.ScopeAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ScopeAddr)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.RecordAddr) > 0 {
+		i -= len(m.RecordAddr)
+		copy(dAtA[i:], m.RecordAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.RecordAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventScopeSpecificationCreated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventScopeSpecificationCreated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventScopeSpecificationCreated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.ScopeSpecificationAddr) > 0 {
+		i -= len(m.ScopeSpecificationAddr)
+		copy(dAtA[i:], m.ScopeSpecificationAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ScopeSpecificationAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventScopeSpecificationUpdated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventScopeSpecificationUpdated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventScopeSpecificationUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ScopeSpecificationAddr) > 0 {
+		i -= len(m.ScopeSpecificationAddr)
+		copy(dAtA[i:], m.ScopeSpecificationAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ScopeSpecificationAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventScopeSpecificationDeleted) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventScopeSpecificationDeleted) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventScopeSpecificationDeleted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ScopeSpecificationAddr) > 0 {
+		i -= len(m.ScopeSpecificationAddr)
+		copy(dAtA[i:], m.ScopeSpecificationAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ScopeSpecificationAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventContractSpecificationCreated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventContractSpecificationCreated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventContractSpecificationCreated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ContractSpecificationAddr) > 0 {
+		i -= len(m.ContractSpecificationAddr)
+		copy(dAtA[i:], m.ContractSpecificationAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ContractSpecificationAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventContractSpecificationUpdated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventContractSpecificationUpdated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventContractSpecificationUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ContractSpecificationAddr) > 0 {
+		i -= len(m.ContractSpecificationAddr)
+		copy(dAtA[i:], m.ContractSpecificationAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ContractSpecificationAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventContractSpecificationDeleted) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventContractSpecificationDeleted) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventContractSpecificationDeleted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ContractSpecificationAddr) > 0 {
+		i -= len(m.ContractSpecificationAddr)
+		copy(dAtA[i:], m.ContractSpecificationAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ContractSpecificationAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventContractSpecificationDeprecated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventContractSpecificationDeprecated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventContractSpecificationDeprecated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ReplacementSpecificationAddr) > 0 {
+		i -= len(m.ReplacementSpecificationAddr)
+		copy(dAtA[i:], m.ReplacementSpecificationAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ReplacementSpecificationAddr)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ContractSpecificationAddr) > 0 {
+		i -= len(m.ContractSpecificationAddr)
+		copy(dAtA[i:], m.ContractSpecificationAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ContractSpecificationAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventSessionUsesDeprecatedSpecification) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSessionUsesDeprecatedSpecification) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSessionUsesDeprecatedSpecification) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ReplacementSpecificationAddr) > 0 {
+		i -= len(m.ReplacementSpecificationAddr)
+		copy(dAtA[i:], m.ReplacementSpecificationAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ReplacementSpecificationAddr)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ContractSpecificationAddr) > 0 {
+		i -= len(m.ContractSpecificationAddr)
+		copy(dAtA[i:], m.ContractSpecificationAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ContractSpecificationAddr)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SessionAddr) > 0 {
+		i -= len(m.SessionAddr)
+		copy(dAtA[i:], m.SessionAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.SessionAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventRecordSpecificationCreated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventRecordSpecificationCreated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventRecordSpecificationCreated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ContractSpecificationAddr) > 0 {
+		i -= len(m.ContractSpecificationAddr)
+		copy(dAtA[i:], m.ContractSpecificationAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ContractSpecificationAddr)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RecordSpecificationAddr) > 0 {
+		i -= len(m.RecordSpecificationAddr)
+		copy(dAtA[i:], m.RecordSpecificationAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.RecordSpecificationAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventRecordSpecificationUpdated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventRecordSpecificationUpdated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventRecordSpecificationUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ContractSpecificationAddr) > 0 {
+		i -= len(m.ContractSpecificationAddr)
+		copy(dAtA[i:], m.ContractSpecificationAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ContractSpecificationAddr)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RecordSpecificationAddr) > 0 {
+		i -= len(m.RecordSpecificationAddr)
+		copy(dAtA[i:], m.RecordSpecificationAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.RecordSpecificationAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventRecordSpecificationDeleted) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventRecordSpecificationDeleted) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventRecordSpecificationDeleted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ContractSpecificationAddr) > 0 {
+		i -= len(m.ContractSpecificationAddr)
+		copy(dAtA[i:], m.ContractSpecificationAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ContractSpecificationAddr)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RecordSpecificationAddr) > 0 {
+		i -= len(m.RecordSpecificationAddr)
+		copy(dAtA[i:], m.RecordSpecificationAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.RecordSpecificationAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventScopeSpecMigrationStarted) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventScopeSpecMigrationStarted) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventScopeSpecMigrationStarted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BatchSize != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.BatchSize))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ToSpecificationAddr) > 0 {
+		i -= len(m.ToSpecificationAddr)
+		copy(dAtA[i:], m.ToSpecificationAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ToSpecificationAddr)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FromSpecificationAddr) > 0 {
+		i -= len(m.FromSpecificationAddr)
+		copy(dAtA[i:], m.FromSpecificationAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.FromSpecificationAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventScopeSpecMigrationProgress) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventScopeSpecMigrationProgress) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventScopeSpecMigrationProgress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ScopesMigrated != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ScopesMigrated))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.BatchCount != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.BatchCount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ToSpecificationAddr) > 0 {
+		i -= len(m.ToSpecificationAddr)
+		copy(dAtA[i:], m.ToSpecificationAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ToSpecificationAddr)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FromSpecificationAddr) > 0 {
+		i -= len(m.FromSpecificationAddr)
+		copy(dAtA[i:], m.FromSpecificationAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.FromSpecificationAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventScopeSpecMigrationCompleted) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventScopeSpecMigrationCompleted) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventScopeSpecMigrationCompleted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ScopesMigrated != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ScopesMigrated))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ToSpecificationAddr) > 0 {
+		i -= len(m.ToSpecificationAddr)
+		copy(dAtA[i:], m.ToSpecificationAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ToSpecificationAddr)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FromSpecificationAddr) > 0 {
+		i -= len(m.FromSpecificationAddr)
+		copy(dAtA[i:], m.FromSpecificationAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.FromSpecificationAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventOSLocatorCreated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventOSLocatorCreated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventOSLocatorCreated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventOSLocatorUpdated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventOSLocatorUpdated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventOSLocatorUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventOSLocatorDeleted) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventOSLocatorDeleted) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventOSLocatorDeleted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventSetNetAssetValue) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSetNetAssetValue) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSetNetAssetValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Volume) > 0 {
+		i -= len(m.Volume)
+		copy(dAtA[i:], m.Volume)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Volume)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Price) > 0 {
+		i -= len(m.Price)
+		copy(dAtA[i:], m.Price)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Price)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ScopeId) > 0 {
+		i -= len(m.ScopeId)
+		copy(dAtA[i:], m.ScopeId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ScopeId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvents(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EventTxCompleted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Module)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Endpoint)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventScopeCreated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ScopeAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventScopeUpdated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ScopeAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventScopeDeleted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ScopeAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventScopeDataAccessChanged) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ScopeAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if len(m.Added) > 0 {
+		for _, s := range m.Added {
+			l = len(s)
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	if len(m.Removed) > 0 {
+		for _, s := range m.Removed {
+			l = len(s)
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventScopeValueOwnerChanged) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ScopeAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.PreviousValueOwner)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.NewValueOwner)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventScopePartySubstituted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ScopeAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Existing)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Replacement)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if len(m.SessionAddrs) > 0 {
+		for _, s := range m.SessionAddrs {
+			l = len(s)
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventScopeSettlementOpened) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ScopeAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Seller)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Buyer)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Price)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventScopeSettlementFunded) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ScopeAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Buyer)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventScopeSettlementSettled) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ScopeAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Seller)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Buyer)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Price)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventScopeSettlementCancelled) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ScopeAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.CancelledBy)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventSessionCreated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SessionAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ScopeAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventSessionUpdated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SessionAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ScopeAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventSessionStatusChanged) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SessionAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ScopeAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.PreviousStatus)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.NewStatus)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventSessionDeleted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SessionAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ScopeAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventRecordCreated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RecordAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.SessionAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ScopeAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventRecordUpdated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RecordAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.SessionAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ScopeAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventRecordDeleted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RecordAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ScopeAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventScopeSpecificationCreated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ScopeSpecificationAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventScopeSpecificationUpdated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ScopeSpecificationAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventScopeSpecificationDeleted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ScopeSpecificationAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventContractSpecificationCreated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractSpecificationAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventContractSpecificationUpdated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractSpecificationAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventContractSpecificationDeleted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractSpecificationAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventContractSpecificationDeprecated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractSpecificationAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ReplacementSpecificationAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventSessionUsesDeprecatedSpecification) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SessionAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ContractSpecificationAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ReplacementSpecificationAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventRecordSpecificationCreated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RecordSpecificationAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ContractSpecificationAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventRecordSpecificationUpdated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RecordSpecificationAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ContractSpecificationAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventRecordSpecificationDeleted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RecordSpecificationAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ContractSpecificationAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventScopeSpecMigrationStarted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FromSpecificationAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ToSpecificationAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.BatchSize != 0 {
+		n += 1 + sovEvents(uint64(m.BatchSize))
+	}
+	return n
+}
+
+func (m *EventScopeSpecMigrationProgress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FromSpecificationAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ToSpecificationAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.BatchCount != 0 {
+		n += 1 + sovEvents(uint64(m.BatchCount))
+	}
+	if m.ScopesMigrated != 0 {
+		n += 1 + sovEvents(uint64(m.ScopesMigrated))
+	}
+	return n
+}
+
+func (m *EventScopeSpecMigrationCompleted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FromSpecificationAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ToSpecificationAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.ScopesMigrated != 0 {
+		n += 1 + sovEvents(uint64(m.ScopesMigrated))
+	}
+	return n
+}
+
+func (m *EventOSLocatorCreated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventOSLocatorUpdated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventOSLocatorDeleted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventSetNetAssetValue) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ScopeId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Price)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Volume)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func sovEvents(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvents(x uint64) (n int) {
+	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EventTxCompleted) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventTxCompleted: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventTxCompleted: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Module", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Module = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Endpoint", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Endpoint = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signers = append(m.Signers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventScopeCreated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventScopeCreated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventScopeCreated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScopeAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventScopeUpdated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventScopeUpdated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventScopeUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScopeAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventScopeDeleted) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventScopeDeleted: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventScopeDeleted: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScopeAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventScopeDataAccessChanged) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventScopeDataAccessChanged: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventScopeDataAccessChanged: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScopeAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Added", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Added = append(m.Added, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Removed", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Removed = append(m.Removed, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signers = append(m.Signers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventScopeValueOwnerChanged) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventScopeValueOwnerChanged: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventScopeValueOwnerChanged: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScopeAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousValueOwner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreviousValueOwner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewValueOwner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewValueOwner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signers = append(m.Signers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventScopePartySubstituted) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventScopePartySubstituted: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventScopePartySubstituted: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScopeAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Existing", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Existing = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Replacement", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Replacement = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionAddrs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SessionAddrs = append(m.SessionAddrs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signers = append(m.Signers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventScopeSettlementOpened) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventScopeSettlementOpened: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventScopeSettlementOpened: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScopeAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seller", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Seller = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Buyer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Buyer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Price = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventScopeSettlementFunded) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventScopeSettlementFunded: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventScopeSettlementFunded: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScopeAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Buyer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Buyer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventScopeSettlementSettled) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventScopeSettlementSettled: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventScopeSettlementSettled: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScopeAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seller", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Seller = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Buyer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Buyer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Price = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventScopeSettlementCancelled) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventScopeSettlementCancelled: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventScopeSettlementCancelled: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScopeAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CancelledBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CancelledBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventSessionCreated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventSessionCreated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventSessionCreated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SessionAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScopeAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventSessionUpdated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventSessionUpdated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventSessionUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SessionAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScopeAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventSessionStatusChanged) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventSessionStatusChanged: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventSessionStatusChanged: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SessionAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScopeAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousStatus", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreviousStatus = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewStatus", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewStatus = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventSessionDeleted) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventSessionDeleted: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventSessionDeleted: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SessionAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScopeAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *EventSetNetAssetValue) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ScopeId)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.Price)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.Source)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.Volume)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovEvents(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozEvents(x uint64) (n int) {
-	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *EventTxCompleted) Unmarshal(dAtA []byte) error {
+func (m *EventRecordCreated) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2511,15 +6417,15 @@ func (m *EventTxCompleted) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventTxCompleted: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventRecordCreated: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventTxCompleted: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventRecordCreated: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Module", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordAddr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2547,11 +6453,11 @@ func (m *EventTxCompleted) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Module = string(dAtA[iNdEx:postIndex])
+			m.RecordAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Endpoint", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionAddr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2579,11 +6485,11 @@ func (m *EventTxCompleted) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Endpoint = string(dAtA[iNdEx:postIndex])
+			m.SessionAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeAddr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2611,7 +6517,7 @@ func (m *EventTxCompleted) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signers = append(m.Signers, string(dAtA[iNdEx:postIndex]))
+			m.ScopeAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2634,7 +6540,7 @@ func (m *EventTxCompleted) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventScopeCreated) Unmarshal(dAtA []byte) error {
+func (m *EventRecordUpdated) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2657,13 +6563,77 @@ func (m *EventScopeCreated) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventScopeCreated: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventRecordUpdated: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventScopeCreated: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventRecordUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecordAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SessionAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ScopeAddr", wireType)
 			}
@@ -2716,7 +6686,7 @@ func (m *EventScopeCreated) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventScopeUpdated) Unmarshal(dAtA []byte) error {
+func (m *EventRecordDeleted) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2739,13 +6709,45 @@ func (m *EventScopeUpdated) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventScopeUpdated: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventRecordDeleted: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventScopeUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventRecordDeleted: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecordAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ScopeAddr", wireType)
 			}
@@ -2798,7 +6800,7 @@ func (m *EventScopeUpdated) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventScopeDeleted) Unmarshal(dAtA []byte) error {
+func (m *EventScopeSpecificationCreated) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2821,15 +6823,15 @@ func (m *EventScopeDeleted) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventScopeDeleted: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventScopeSpecificationCreated: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventScopeDeleted: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventScopeSpecificationCreated: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScopeAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeSpecificationAddr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2857,7 +6859,7 @@ func (m *EventScopeDeleted) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ScopeAddr = string(dAtA[iNdEx:postIndex])
+			m.ScopeSpecificationAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2880,7 +6882,7 @@ func (m *EventScopeDeleted) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventSessionCreated) Unmarshal(dAtA []byte) error {
+func (m *EventScopeSpecificationUpdated) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2903,47 +6905,15 @@ func (m *EventSessionCreated) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventSessionCreated: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventScopeSpecificationUpdated: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventSessionCreated: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventScopeSpecificationUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SessionAddr", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.SessionAddr = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScopeAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeSpecificationAddr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2971,7 +6941,7 @@ func (m *EventSessionCreated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ScopeAddr = string(dAtA[iNdEx:postIndex])
+			m.ScopeSpecificationAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2994,7 +6964,7 @@ func (m *EventSessionCreated) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventSessionUpdated) Unmarshal(dAtA []byte) error {
+func (m *EventScopeSpecificationDeleted) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3011,53 +6981,21 @@ func (m *EventSessionUpdated) Unmarshal(dAtA []byte) error {
 			iNdEx++
 			wire |= uint64(b&0x7F) << shift
 			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EventSessionUpdated: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventSessionUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SessionAddr", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+				break
 			}
-			m.SessionAddr = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventScopeSpecificationDeleted: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventScopeSpecificationDeleted: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScopeAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeSpecificationAddr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3085,7 +7023,7 @@ func (m *EventSessionUpdated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ScopeAddr = string(dAtA[iNdEx:postIndex])
+			m.ScopeSpecificationAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3108,7 +7046,7 @@ func (m *EventSessionUpdated) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventSessionDeleted) Unmarshal(dAtA []byte) error {
+func (m *EventContractSpecificationCreated) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3131,47 +7069,15 @@ func (m *EventSessionDeleted) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventSessionDeleted: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventContractSpecificationCreated: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventSessionDeleted: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventContractSpecificationCreated: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SessionAddr", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.SessionAddr = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScopeAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractSpecificationAddr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3199,7 +7105,7 @@ func (m *EventSessionDeleted) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ScopeAddr = string(dAtA[iNdEx:postIndex])
+			m.ContractSpecificationAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3222,7 +7128,7 @@ func (m *EventSessionDeleted) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventRecordCreated) Unmarshal(dAtA []byte) error {
+func (m *EventContractSpecificationUpdated) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3245,15 +7151,15 @@ func (m *EventRecordCreated) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventRecordCreated: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventContractSpecificationUpdated: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventRecordCreated: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventContractSpecificationUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecordAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractSpecificationAddr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3281,43 +7187,61 @@ func (m *EventRecordCreated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RecordAddr = string(dAtA[iNdEx:postIndex])
+			m.ContractSpecificationAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SessionAddr", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventContractSpecificationDeleted) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SessionAddr = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventContractSpecificationDeleted: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventContractSpecificationDeleted: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScopeAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractSpecificationAddr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3345,7 +7269,7 @@ func (m *EventRecordCreated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ScopeAddr = string(dAtA[iNdEx:postIndex])
+			m.ContractSpecificationAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3368,7 +7292,7 @@ func (m *EventRecordCreated) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventRecordUpdated) Unmarshal(dAtA []byte) error {
+func (m *EventContractSpecificationDeprecated) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3391,15 +7315,15 @@ func (m *EventRecordUpdated) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventRecordUpdated: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventContractSpecificationDeprecated: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventRecordUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventContractSpecificationDeprecated: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecordAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractSpecificationAddr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3427,43 +7351,11 @@ func (m *EventRecordUpdated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RecordAddr = string(dAtA[iNdEx:postIndex])
+			m.ContractSpecificationAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SessionAddr", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.SessionAddr = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScopeAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplacementSpecificationAddr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3491,7 +7383,7 @@ func (m *EventRecordUpdated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ScopeAddr = string(dAtA[iNdEx:postIndex])
+			m.ReplacementSpecificationAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3514,7 +7406,7 @@ func (m *EventRecordUpdated) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventRecordDeleted) Unmarshal(dAtA []byte) error {
+func (m *EventSessionUsesDeprecatedSpecification) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3537,15 +7429,15 @@ func (m *EventRecordDeleted) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventRecordDeleted: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventSessionUsesDeprecatedSpecification: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventRecordDeleted: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventSessionUsesDeprecatedSpecification: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecordAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionAddr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3573,11 +7465,11 @@ func (m *EventRecordDeleted) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RecordAddr = string(dAtA[iNdEx:postIndex])
+			m.SessionAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScopeAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractSpecificationAddr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3601,65 +7493,15 @@ func (m *EventRecordDeleted) Unmarshal(dAtA []byte) error {
 			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ScopeAddr = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEvents(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *EventScopeSpecificationCreated) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowEvents
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EventScopeSpecificationCreated: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventScopeSpecificationCreated: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractSpecificationAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScopeSpecificationAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplacementSpecificationAddr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3687,7 +7529,7 @@ func (m *EventScopeSpecificationCreated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ScopeSpecificationAddr = string(dAtA[iNdEx:postIndex])
+			m.ReplacementSpecificationAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3710,7 +7552,7 @@ func (m *EventScopeSpecificationCreated) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventScopeSpecificationUpdated) Unmarshal(dAtA []byte) error {
+func (m *EventRecordSpecificationCreated) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3733,15 +7575,15 @@ func (m *EventScopeSpecificationUpdated) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventScopeSpecificationUpdated: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventRecordSpecificationCreated: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventScopeSpecificationUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventRecordSpecificationCreated: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScopeSpecificationAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordSpecificationAddr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3769,61 +7611,11 @@ func (m *EventScopeSpecificationUpdated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ScopeSpecificationAddr = string(dAtA[iNdEx:postIndex])
+			m.RecordSpecificationAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEvents(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *EventScopeSpecificationDeleted) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowEvents
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EventScopeSpecificationDeleted: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventScopeSpecificationDeleted: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScopeSpecificationAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractSpecificationAddr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3851,7 +7643,7 @@ func (m *EventScopeSpecificationDeleted) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ScopeSpecificationAddr = string(dAtA[iNdEx:postIndex])
+			m.ContractSpecificationAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3874,7 +7666,7 @@ func (m *EventScopeSpecificationDeleted) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventContractSpecificationCreated) Unmarshal(dAtA []byte) error {
+func (m *EventRecordSpecificationUpdated) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3897,15 +7689,15 @@ func (m *EventContractSpecificationCreated) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventContractSpecificationCreated: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventRecordSpecificationUpdated: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventContractSpecificationCreated: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventRecordSpecificationUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractSpecificationAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordSpecificationAddr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3933,59 +7725,9 @@ func (m *EventContractSpecificationCreated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContractSpecificationAddr = string(dAtA[iNdEx:postIndex])
+			m.RecordSpecificationAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEvents(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *EventContractSpecificationUpdated) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowEvents
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EventContractSpecificationUpdated: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventContractSpecificationUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ContractSpecificationAddr", wireType)
 			}
@@ -4038,7 +7780,7 @@ func (m *EventContractSpecificationUpdated) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventContractSpecificationDeleted) Unmarshal(dAtA []byte) error {
+func (m *EventRecordSpecificationDeleted) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4061,13 +7803,45 @@ func (m *EventContractSpecificationDeleted) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventContractSpecificationDeleted: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventRecordSpecificationDeleted: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventContractSpecificationDeleted: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventRecordSpecificationDeleted: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordSpecificationAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecordSpecificationAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ContractSpecificationAddr", wireType)
 			}
@@ -4120,7 +7894,7 @@ func (m *EventContractSpecificationDeleted) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventRecordSpecificationCreated) Unmarshal(dAtA []byte) error {
+func (m *EventScopeSpecMigrationStarted) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4143,15 +7917,15 @@ func (m *EventRecordSpecificationCreated) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventRecordSpecificationCreated: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventScopeSpecMigrationStarted: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventRecordSpecificationCreated: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventScopeSpecMigrationStarted: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecordSpecificationAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FromSpecificationAddr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4179,11 +7953,11 @@ func (m *EventRecordSpecificationCreated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RecordSpecificationAddr = string(dAtA[iNdEx:postIndex])
+			m.FromSpecificationAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractSpecificationAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ToSpecificationAddr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4211,8 +7985,27 @@ func (m *EventRecordSpecificationCreated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContractSpecificationAddr = string(dAtA[iNdEx:postIndex])
+			m.ToSpecificationAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchSize", wireType)
+			}
+			m.BatchSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BatchSize |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -4234,7 +8027,7 @@ func (m *EventRecordSpecificationCreated) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventRecordSpecificationUpdated) Unmarshal(dAtA []byte) error {
+func (m *EventScopeSpecMigrationProgress) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4257,15 +8050,15 @@ func (m *EventRecordSpecificationUpdated) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventRecordSpecificationUpdated: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventScopeSpecMigrationProgress: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventRecordSpecificationUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventScopeSpecMigrationProgress: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecordSpecificationAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FromSpecificationAddr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4293,11 +8086,11 @@ func (m *EventRecordSpecificationUpdated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RecordSpecificationAddr = string(dAtA[iNdEx:postIndex])
+			m.FromSpecificationAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractSpecificationAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ToSpecificationAddr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4325,8 +8118,46 @@ func (m *EventRecordSpecificationUpdated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContractSpecificationAddr = string(dAtA[iNdEx:postIndex])
+			m.ToSpecificationAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchCount", wireType)
+			}
+			m.BatchCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BatchCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopesMigrated", wireType)
+			}
+			m.ScopesMigrated = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ScopesMigrated |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -4348,7 +8179,7 @@ func (m *EventRecordSpecificationUpdated) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventRecordSpecificationDeleted) Unmarshal(dAtA []byte) error {
+func (m *EventScopeSpecMigrationCompleted) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4371,15 +8202,15 @@ func (m *EventRecordSpecificationDeleted) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventRecordSpecificationDeleted: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventScopeSpecMigrationCompleted: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventRecordSpecificationDeleted: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventScopeSpecMigrationCompleted: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecordSpecificationAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FromSpecificationAddr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4407,11 +8238,11 @@ func (m *EventRecordSpecificationDeleted) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RecordSpecificationAddr = string(dAtA[iNdEx:postIndex])
+			m.FromSpecificationAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractSpecificationAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ToSpecificationAddr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4439,8 +8270,27 @@ func (m *EventRecordSpecificationDeleted) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContractSpecificationAddr = string(dAtA[iNdEx:postIndex])
+			m.ToSpecificationAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopesMigrated", wireType)
+			}
+			m.ScopesMigrated = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ScopesMigrated |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -1,7 +1,34 @@
 package types
 
+import "fmt"
+
 // Validate ensures the genesis state is valid.
 func (state GenesisState) Validate() error {
+	for i, migration := range state.ScopeSpecMigrations {
+		if err := migration.Validate(); err != nil {
+			return fmt.Errorf("invalid scope spec migration[%d]: %w", i, err)
+		}
+	}
+	seen := make(map[uint64]bool, len(state.ScopeAccessChanges))
+	for i, change := range state.ScopeAccessChanges {
+		if err := change.Validate(); err != nil {
+			return fmt.Errorf("invalid scope access change[%d]: %w", i, err)
+		}
+		if seen[change.Sequence] {
+			return fmt.Errorf("invalid scope access change[%d]: duplicate sequence %d", i, change.Sequence)
+		}
+		seen[change.Sequence] = true
+	}
+	settled := make(map[string]bool, len(state.ScopeSettlements))
+	for i, settlement := range state.ScopeSettlements {
+		if err := settlement.Validate(); err != nil {
+			return fmt.Errorf("invalid scope settlement[%d]: %w", i, err)
+		}
+		if settled[string(settlement.ScopeId)] {
+			return fmt.Errorf("invalid scope settlement[%d]: duplicate scope %s", i, settlement.ScopeId)
+		}
+		settled[string(settlement.ScopeId)] = true
+	}
 	return nil
 }
 
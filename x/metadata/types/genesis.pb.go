@@ -38,6 +38,12 @@ type GenesisState struct {
 	ObjectStoreLocators    []ObjectStoreLocator    `protobuf:"bytes,9,rep,name=object_store_locators,json=objectStoreLocators,proto3" json:"object_store_locators"`
 	// Net asset values assigned to scopes
 	NetAssetValues []MarkerNetAssetValues `protobuf:"bytes,10,rep,name=net_asset_values,json=netAssetValues,proto3" json:"net_asset_values"`
+	// Scope specification migrations that are still in progress
+	ScopeSpecMigrations []ScopeSpecMigration `protobuf:"bytes,11,rep,name=scope_spec_migrations,json=scopeSpecMigrations,proto3" json:"scope_spec_migrations"`
+	// Recent changes to the data access lists and value owners of scopes
+	ScopeAccessChanges []ScopeAccessChange `protobuf:"bytes,12,rep,name=scope_access_changes,json=scopeAccessChanges,proto3" json:"scope_access_changes"`
+	// Pending sales of scope value ownership
+	ScopeSettlements []ScopeSettlement `protobuf:"bytes,13,rep,name=scope_settlements,json=scopeSettlements,proto3" json:"scope_settlements"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -124,42 +130,47 @@ func init() {
 }
 
 var fileDescriptor_a835c20198efc302 = []byte{
-	// 549 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x94, 0xc1, 0x6e, 0xd3, 0x40,
-	0x10, 0x86, 0x6d, 0x5a, 0x92, 0x74, 0x8b, 0x00, 0x2d, 0x69, 0x31, 0x95, 0x70, 0xaa, 0x88, 0x8a,
-	0xa8, 0x50, 0x5b, 0x2d, 0x9c, 0x00, 0x21, 0xb5, 0x1c, 0xb8, 0x00, 0xad, 0x12, 0xc1, 0xa1, 0x42,
-	0xb2, 0x36, 0x9b, 0x6d, 0x30, 0x4d, 0x3c, 0xd6, 0xce, 0x36, 0x82, 0x37, 0xe0, 0x08, 0x6f, 0xd0,
-	0xc7, 0xe9, 0xb1, 0x47, 0x4e, 0x08, 0x25, 0x17, 0xae, 0xbc, 0x01, 0xca, 0x7a, 0xdd, 0x34, 0x8d,
-	0x37, 0xdc, 0x6c, 0xcf, 0xff, 0xfd, 0xff, 0x8c, 0x3d, 0x5e, 0xf2, 0x20, 0x95, 0x30, 0x10, 0x09,
-	0x4b, 0xb8, 0x08, 0xfb, 0x42, 0xb1, 0x0e, 0x53, 0x2c, 0x1c, 0x6c, 0x87, 0x5d, 0x91, 0x08, 0x8c,
-	0x31, 0x48, 0x25, 0x28, 0xa0, 0xab, 0x13, 0x55, 0x90, 0xab, 0x82, 0xc1, 0xf6, 0x5a, 0xb5, 0x0b,
-	0x5d, 0xd0, 0x92, 0x70, 0x7c, 0x95, 0xa9, 0xd7, 0x36, 0x2c, 0x9e, 0x17, 0x64, 0x26, 0xab, 0x5b,
-	0x64, 0xc8, 0x21, 0x15, 0x46, 0xb3, 0x69, 0xd3, 0xa4, 0x82, 0xc7, 0x47, 0x31, 0x67, 0x2a, 0x86,
-	0xc4, 0x68, 0x1b, 0x16, 0x2d, 0xb4, 0x3f, 0x0b, 0xae, 0x50, 0x81, 0x34, 0xae, 0xf5, 0xbf, 0x25,
-	0x72, 0xe3, 0x75, 0x36, 0x60, 0x4b, 0x31, 0x25, 0xe8, 0x0b, 0x52, 0x4a, 0x99, 0x64, 0x7d, 0xf4,
-	0xdc, 0x75, 0xb7, 0xb1, 0xbc, 0xe3, 0x07, 0xc5, 0x03, 0x07, 0x07, 0x5a, 0xb5, 0xb7, 0x78, 0xf6,
-	0xab, 0xe6, 0x34, 0x0d, 0x43, 0x9f, 0x93, 0x92, 0xee, 0x19, 0xbd, 0x6b, 0xeb, 0x0b, 0x8d, 0xe5,
-	0x9d, 0xfb, 0x36, 0xba, 0x35, 0x56, 0xe5, 0x70, 0x86, 0xd0, 0x5d, 0x52, 0x41, 0x81, 0x18, 0x43,
-	0x82, 0xde, 0x82, 0xc6, 0x6b, 0x56, 0x3c, 0xd3, 0x19, 0x83, 0x0b, 0x8c, 0xbe, 0x24, 0x65, 0x29,
-	0x38, 0xc8, 0x0e, 0x7a, 0x8b, 0xda, 0xc1, 0xda, 0x7e, 0x53, 0xcb, 0x8c, 0x41, 0x0e, 0x51, 0x4e,
-	0xaa, 0xba, 0x99, 0x68, 0xea, 0xad, 0xa2, 0x77, 0x5d, 0x9b, 0x6d, 0xce, 0x9d, 0xa6, 0x75, 0x19,
-	0x31, 0xc6, 0x77, 0x70, 0xa6, 0x82, 0xb4, 0x47, 0xee, 0x72, 0x48, 0x94, 0x64, 0x5c, 0x5d, 0xcd,
-	0x29, 0xe9, 0x9c, 0x2d, 0x5b, 0xce, 0x2b, 0x83, 0x15, 0x45, 0xad, 0xf2, 0xa2, 0x22, 0xd2, 0x23,
-	0xb2, 0x92, 0x4d, 0x77, 0x35, 0xab, 0xac, 0xb3, 0x1e, 0xcd, 0x7f, 0x41, 0x45, 0x49, 0x55, 0x39,
-	0x5b, 0x42, 0x7a, 0x48, 0x28, 0x44, 0x18, 0xf5, 0x80, 0x33, 0x05, 0x32, 0x32, 0x4b, 0x54, 0xd1,
-	0x4b, 0xf4, 0xd0, 0x16, 0xb2, 0xdf, 0x7a, 0x93, 0xe9, 0xa7, 0xb6, 0xe9, 0x16, 0x4c, 0x3f, 0xa6,
-	0x1d, 0xb2, 0x92, 0xad, 0x6e, 0xa4, 0x77, 0x37, 0x0f, 0x41, 0x6f, 0x69, 0xfe, 0x77, 0xd9, 0xd7,
-	0x50, 0x6b, 0xcc, 0x18, 0xc3, 0xfc, 0xbb, 0xc0, 0x4c, 0x05, 0xe9, 0x47, 0x72, 0x3b, 0x11, 0x2a,
-	0x62, 0x88, 0x42, 0x45, 0x03, 0xd6, 0x3b, 0x11, 0xe8, 0x11, 0x1d, 0xf0, 0xd8, 0x16, 0xf0, 0x96,
-	0xc9, 0x63, 0x21, 0xdf, 0x09, 0xb5, 0x3b, 0x86, 0x3e, 0x68, 0xc6, 0x44, 0xdc, 0x4c, 0xa6, 0x9e,
-	0x3e, 0xab, 0x7c, 0x3b, 0xad, 0x39, 0x7f, 0x4e, 0x6b, 0x4e, 0xfd, 0x87, 0x4b, 0xaa, 0x45, 0x20,
-	0xf5, 0x48, 0x99, 0x75, 0x3a, 0x52, 0x60, 0xf6, 0xf3, 0x2d, 0x35, 0xf3, 0x5b, 0xfa, 0xbe, 0xa0,
-	0xb5, 0xec, 0x0f, 0xdb, 0xb0, 0xb5, 0x36, 0xe5, 0xfd, 0xbf, 0x9e, 0xf6, 0x8e, 0xcf, 0x86, 0xbe,
-	0x7b, 0x3e, 0xf4, 0xdd, 0xdf, 0x43, 0xdf, 0xfd, 0x3e, 0xf2, 0x9d, 0xf3, 0x91, 0xef, 0xfc, 0x1c,
-	0xf9, 0x0e, 0xb9, 0x17, 0x83, 0x25, 0xe2, 0xc0, 0x3d, 0x7c, 0xda, 0x8d, 0xd5, 0xa7, 0x93, 0x76,
-	0xc0, 0xa1, 0x1f, 0x4e, 0x44, 0x5b, 0x31, 0x5c, 0xba, 0x0b, 0xbf, 0x4c, 0xce, 0x20, 0xf5, 0x35,
-	0x15, 0xd8, 0x2e, 0xe9, 0xb3, 0xe7, 0xc9, 0xbf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xdb, 0x60, 0x12,
-	0x92, 0x72, 0x05, 0x00, 0x00,
+	// 629 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0xcf, 0x4f, 0xd4, 0x4e,
+	0x14, 0x6f, 0xbf, 0xf0, 0x5d, 0x60, 0x40, 0xc5, 0x71, 0xc1, 0x4a, 0x62, 0x97, 0x10, 0x09, 0x88,
+	0xd2, 0x06, 0xf4, 0xa4, 0xc6, 0x04, 0x38, 0x78, 0x11, 0x21, 0xbb, 0xd1, 0x03, 0x31, 0x69, 0x66,
+	0x67, 0x87, 0x52, 0xd9, 0xed, 0x34, 0xf3, 0x86, 0x8d, 0xfe, 0x07, 0x1e, 0xf5, 0xe2, 0x99, 0x3f,
+	0x87, 0x23, 0x47, 0x4f, 0xc6, 0xec, 0x5e, 0xfc, 0x33, 0x4c, 0xa7, 0xd3, 0x5d, 0xba, 0xdb, 0xd9,
+	0x78, 0x6b, 0xe7, 0x7d, 0x7e, 0xbc, 0x37, 0xef, 0x93, 0x41, 0x8f, 0x12, 0xc1, 0xbb, 0x2c, 0x26,
+	0x31, 0x65, 0x7e, 0x87, 0x49, 0xd2, 0x22, 0x92, 0xf8, 0xdd, 0x1d, 0x3f, 0x64, 0x31, 0x83, 0x08,
+	0xbc, 0x44, 0x70, 0xc9, 0xf1, 0xf2, 0x10, 0xe5, 0xe5, 0x28, 0xaf, 0xbb, 0xb3, 0x52, 0x0d, 0x79,
+	0xc8, 0x15, 0xc4, 0x4f, 0xbf, 0x32, 0xf4, 0xca, 0xba, 0x41, 0x73, 0xc0, 0xcc, 0x60, 0x6b, 0x06,
+	0x18, 0x50, 0x9e, 0x30, 0x8d, 0xd9, 0x32, 0x61, 0x12, 0x46, 0xa3, 0xd3, 0x88, 0x12, 0x19, 0xf1,
+	0x58, 0x63, 0x37, 0x0d, 0x58, 0xde, 0xfc, 0xc4, 0xa8, 0x04, 0xc9, 0x85, 0x56, 0x5d, 0xfb, 0x31,
+	0x87, 0x16, 0xde, 0x64, 0x03, 0x36, 0x24, 0x91, 0x0c, 0xbf, 0x42, 0x95, 0x84, 0x08, 0xd2, 0x01,
+	0xc7, 0x5e, 0xb5, 0x37, 0xe7, 0x77, 0x5d, 0xaf, 0x7c, 0x60, 0xef, 0x58, 0xa1, 0xf6, 0xa7, 0xaf,
+	0x7e, 0xd5, 0xac, 0xba, 0xe6, 0xe0, 0x97, 0xa8, 0xa2, 0x7a, 0x06, 0xe7, 0xbf, 0xd5, 0xa9, 0xcd,
+	0xf9, 0xdd, 0x87, 0x26, 0x76, 0x23, 0x45, 0xe5, 0xe4, 0x8c, 0x82, 0xf7, 0xd0, 0x2c, 0x30, 0x80,
+	0x88, 0xc7, 0xe0, 0x4c, 0x29, 0x7a, 0xcd, 0x48, 0xcf, 0x70, 0x5a, 0x60, 0x40, 0xc3, 0xaf, 0xd1,
+	0x8c, 0x60, 0x94, 0x8b, 0x16, 0x38, 0xd3, 0x4a, 0xc1, 0xd8, 0x7e, 0x5d, 0xc1, 0xb4, 0x40, 0x4e,
+	0xc2, 0x14, 0x55, 0x55, 0x33, 0x41, 0xe1, 0x56, 0xc1, 0xf9, 0x5f, 0x89, 0x6d, 0x4d, 0x9c, 0xa6,
+	0x71, 0x93, 0xa2, 0x85, 0xef, 0xc1, 0x58, 0x05, 0x70, 0x1b, 0xdd, 0xa7, 0x3c, 0x96, 0x82, 0x50,
+	0x39, 0xea, 0x53, 0x51, 0x3e, 0xdb, 0x26, 0x9f, 0x03, 0x4d, 0x2b, 0xb3, 0x5a, 0xa6, 0x65, 0x45,
+	0xc0, 0xa7, 0x68, 0x29, 0x9b, 0x6e, 0xd4, 0x6b, 0x46, 0x79, 0x3d, 0x99, 0x7c, 0x41, 0x65, 0x4e,
+	0x55, 0x31, 0x5e, 0x02, 0x7c, 0x82, 0x30, 0x0f, 0x20, 0x68, 0x73, 0x4a, 0x24, 0x17, 0x81, 0x0e,
+	0xd1, 0xac, 0x0a, 0xd1, 0x86, 0xc9, 0xe4, 0xa8, 0xf1, 0x36, 0xc3, 0x17, 0xd2, 0x74, 0x87, 0x17,
+	0x8f, 0x71, 0x0b, 0x2d, 0x65, 0xd1, 0x0d, 0x54, 0x76, 0x73, 0x13, 0x70, 0xe6, 0x26, 0xef, 0xe5,
+	0x48, 0x91, 0x1a, 0x29, 0x47, 0x0b, 0xe6, 0x7b, 0xe1, 0x63, 0x15, 0xc0, 0x1f, 0xd1, 0x62, 0xcc,
+	0x64, 0x40, 0x00, 0x98, 0x0c, 0xba, 0xa4, 0x7d, 0xc1, 0xc0, 0x41, 0xca, 0xe0, 0xa9, 0xc9, 0xe0,
+	0x90, 0x88, 0x73, 0x26, 0xde, 0x31, 0xb9, 0x97, 0x92, 0x3e, 0x28, 0x8e, 0xb6, 0xb8, 0x1d, 0x17,
+	0x4e, 0xd3, 0x19, 0x86, 0xd1, 0x0a, 0x3a, 0x51, 0x28, 0xf4, 0x1e, 0xe6, 0xff, 0x31, 0x5b, 0x87,
+	0x39, 0x65, 0x2c, 0x5b, 0x83, 0x0a, 0x60, 0x92, 0x07, 0x98, 0x50, 0xca, 0x00, 0x02, 0x7a, 0x46,
+	0xe2, 0x90, 0x81, 0xb3, 0xa0, 0x4c, 0x1e, 0x4f, 0x34, 0xd9, 0x53, 0x94, 0x03, 0xc5, 0xd0, 0x1e,
+	0x18, 0x46, 0x0b, 0xe9, 0xa2, 0xef, 0xea, 0x41, 0x98, 0x94, 0x6d, 0xd6, 0x61, 0xb1, 0x04, 0xe7,
+	0x96, 0xd2, 0xdf, 0x98, 0x3c, 0xc4, 0x00, 0xaf, 0xd5, 0x17, 0xa1, 0x78, 0x0c, 0x2f, 0x66, 0xbf,
+	0x5e, 0xd6, 0xac, 0x3f, 0x97, 0x35, 0x6b, 0xed, 0xbb, 0x8d, 0xaa, 0x65, 0xb7, 0x8b, 0x1d, 0x34,
+	0x43, 0x5a, 0x2d, 0xc1, 0x20, 0x7b, 0xa1, 0xe6, 0xea, 0xf9, 0x2f, 0x7e, 0x5f, 0xb2, 0xbf, 0xec,
+	0x19, 0x5a, 0x37, 0xf5, 0x55, 0xd0, 0x2e, 0x5f, 0xdc, 0xb0, 0xa7, 0xfd, 0xf3, 0xab, 0x9e, 0x6b,
+	0x5f, 0xf7, 0x5c, 0xfb, 0x77, 0xcf, 0xb5, 0xbf, 0xf5, 0x5d, 0xeb, 0xba, 0xef, 0x5a, 0x3f, 0xfb,
+	0xae, 0x85, 0x1e, 0x44, 0xdc, 0x60, 0x71, 0x6c, 0x9f, 0x3c, 0x0f, 0x23, 0x79, 0x76, 0xd1, 0xf4,
+	0x28, 0xef, 0xf8, 0x43, 0xd0, 0x76, 0xc4, 0x6f, 0xfc, 0xf9, 0x9f, 0x87, 0x0f, 0xb5, 0xfc, 0x92,
+	0x30, 0x68, 0x56, 0xd4, 0x03, 0xfd, 0xec, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x18, 0xe1, 0xc5,
+	0xbe, 0x97, 0x06, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -182,6 +193,48 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ScopeSettlements) > 0 {
+		for iNdEx := len(m.ScopeSettlements) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ScopeSettlements[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.ScopeAccessChanges) > 0 {
+		for iNdEx := len(m.ScopeAccessChanges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ScopeAccessChanges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.ScopeSpecMigrations) > 0 {
+		for iNdEx := len(m.ScopeSpecMigrations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ScopeSpecMigrations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
 	if len(m.NetAssetValues) > 0 {
 		for iNdEx := len(m.NetAssetValues) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -430,6 +483,24 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.ScopeSpecMigrations) > 0 {
+		for _, e := range m.ScopeSpecMigrations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ScopeAccessChanges) > 0 {
+		for _, e := range m.ScopeAccessChanges {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ScopeSettlements) > 0 {
+		for _, e := range m.ScopeSettlements {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -825,6 +896,108 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeSpecMigrations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScopeSpecMigrations = append(m.ScopeSpecMigrations, ScopeSpecMigration{})
+			if err := m.ScopeSpecMigrations[len(m.ScopeSpecMigrations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeAccessChanges", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScopeAccessChanges = append(m.ScopeAccessChanges, ScopeAccessChange{})
+			if err := m.ScopeAccessChanges[len(m.ScopeAccessChanges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeSettlements", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScopeSettlements = append(m.ScopeSettlements, ScopeSettlement{})
+			if err := m.ScopeSettlements[len(m.ScopeSettlements)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"crypto/sha256"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/address"
 )
@@ -52,6 +54,12 @@ const (
 // - 0x14<contract_spec_id><scope_spec_id>: 0x01
 //
 // - 0x20<owner_address><contract_spec_id>: 0x01
+//
+// - 0x24<owner_address><record_spec_id>: 0x01
+//
+// - 0x28<process_hash_hash><process_method_hash><record_id>: 0x01
+//
+// - 0x29<contract_spec_id><session_id>: 0x01
 var (
 	// ScopeKeyPrefix is the key for scope records in metadata store
 	ScopeKeyPrefix = []byte{0x00}
@@ -86,6 +94,24 @@ var (
 
 	// OSLocatorParamPrefix prefix for os locator params
 	OSLocatorParamPrefix = []byte{0x23}
+
+	// AddressRecordSpecCacheKeyPrefix for record spec lookup by the address of an owner of its contract spec
+	AddressRecordSpecCacheKeyPrefix = []byte{0x24}
+
+	// ScopeSpecMigrationKeyPrefix for in-progress scope spec migrations by the scope spec being migrated away from
+	ScopeSpecMigrationKeyPrefix = []byte{0x25}
+
+	// ScopeAccessChangeKeyPrefix for the index of recent scope data access and value owner changes by sequence
+	ScopeAccessChangeKeyPrefix = []byte{0x26}
+
+	// ScopeSettlementKeyPrefix for pending sales of scope value ownership by scope
+	ScopeSettlementKeyPrefix = []byte{0x27}
+
+	// ProcessRecordCacheKeyPrefix for record lookup by the hash and method of the process that produced it
+	ProcessRecordCacheKeyPrefix = []byte{0x28}
+
+	// ContractSpecSessionCacheKeyPrefix for session lookup by contract spec
+	ContractSpecSessionCacheKeyPrefix = []byte{0x29}
 )
 
 // GetAddressScopeCacheIteratorPrefix returns an iterator prefix for all scope cache entries assigned to a given address
@@ -128,6 +154,16 @@ func GetContractSpecScopeSpecCacheKey(contractSpecID MetadataAddress, scopeSpecI
 	return append(GetContractSpecScopeSpecCacheIteratorPrefix(contractSpecID), scopeSpecID.Bytes()...)
 }
 
+// GetContractSpecSessionCacheIteratorPrefix returns an iterator prefix for all session cache entries assigned to a given contract spec
+func GetContractSpecSessionCacheIteratorPrefix(contractSpecID MetadataAddress) []byte {
+	return append(append([]byte{}, ContractSpecSessionCacheKeyPrefix...), contractSpecID.Bytes()...)
+}
+
+// GetContractSpecSessionCacheKey returns the store key for a contract spec + session cache entry
+func GetContractSpecSessionCacheKey(contractSpecID MetadataAddress, sessionID MetadataAddress) []byte {
+	return append(GetContractSpecSessionCacheIteratorPrefix(contractSpecID), sessionID.Bytes()...)
+}
+
 // GetAddressContractSpecCacheIteratorPrefix returns an iterator prefix for all contract spec cache entries assigned to a given address
 func GetAddressContractSpecCacheIteratorPrefix(addr sdk.AccAddress) []byte {
 	return append(AddressContractSpecCacheKeyPrefix, address.MustLengthPrefix(addr.Bytes())...)
@@ -138,6 +174,52 @@ func GetAddressContractSpecCacheKey(addr sdk.AccAddress, contractSpecID Metadata
 	return append(GetAddressContractSpecCacheIteratorPrefix(addr), contractSpecID.Bytes()...)
 }
 
+// GetAddressRecordSpecCacheIteratorPrefix returns an iterator prefix for all record spec cache entries assigned to a given address
+func GetAddressRecordSpecCacheIteratorPrefix(addr sdk.AccAddress) []byte {
+	return append(AddressRecordSpecCacheKeyPrefix, address.MustLengthPrefix(addr.Bytes())...)
+}
+
+// GetAddressRecordSpecCacheKey returns the store key for an address + record spec cache entry
+func GetAddressRecordSpecCacheKey(addr sdk.AccAddress, recordSpecID MetadataAddress) []byte {
+	return append(GetAddressRecordSpecCacheIteratorPrefix(addr), recordSpecID.Bytes()...)
+}
+
+// GetProcessRecordCacheIteratorPrefix returns an iterator prefix for all record cache entries for a process hash
+func GetProcessRecordCacheIteratorPrefix(processHash string) []byte {
+	return append(append([]byte{}, ProcessRecordCacheKeyPrefix...), processKeyHash(processHash)...)
+}
+
+// GetProcessMethodRecordCacheIteratorPrefix returns an iterator prefix for all record cache entries for a process hash and method
+func GetProcessMethodRecordCacheIteratorPrefix(processHash, method string) []byte {
+	return append(GetProcessRecordCacheIteratorPrefix(processHash), processKeyHash(method)...)
+}
+
+// GetProcessRecordCacheKey returns the store key for a process hash + method + record cache entry
+func GetProcessRecordCacheKey(processHash, method string, recordID MetadataAddress) []byte {
+	return append(GetProcessMethodRecordCacheIteratorPrefix(processHash, method), recordID.Bytes()...)
+}
+
+// processKeyHash returns the first 16 bytes of the sha256 checksum of the provided process hash or method.
+func processKeyHash(str string) []byte {
+	sum := sha256.Sum256([]byte(str))
+	return sum[:16]
+}
+
+// GetScopeSpecMigrationKey returns the store key for the in-progress migration of scopes away from a scope spec
+func GetScopeSpecMigrationKey(fromSpecID MetadataAddress) []byte {
+	return append(append([]byte{}, ScopeSpecMigrationKeyPrefix...), fromSpecID.Bytes()...)
+}
+
+// GetScopeAccessChangeKey returns the store key for an entry in the index of recent scope access changes
+func GetScopeAccessChangeKey(sequence uint64) []byte {
+	return append(append([]byte{}, ScopeAccessChangeKeyPrefix...), sdk.Uint64ToBigEndian(sequence)...)
+}
+
+// GetScopeSettlementKey returns the store key for the pending sale of a scope's value ownership
+func GetScopeSettlementKey(scopeID MetadataAddress) []byte {
+	return append(append([]byte{}, ScopeSettlementKeyPrefix...), scopeID.Bytes()...)
+}
+
 // GetOSLocatorKey returns a store key for an object store locator entry
 func GetOSLocatorKey(addr sdk.AccAddress) []byte {
 	return append(OSLocatorAddressKeyPrefix, address.MustLengthPrefix(addr.Bytes())...)
@@ -30,3 +30,26 @@ func TestNetAssetValueKey(t *testing.T) {
 	assert.Equal(t, scopeAddr.Bytes(), navKey[2:denomArrLen+2], "should match denom key")
 	assert.Equal(t, "nhash", string(navKey[denomArrLen+2:]))
 }
+
+func TestProcessRecordCacheKey(t *testing.T) {
+	recordID := RecordMetadataAddress(uuid.New(), "recordname")
+	key := GetProcessRecordCacheKey("processhash", "processmethod", recordID)
+	assert.Equal(t, ProcessRecordCacheKeyPrefix[0], key[0], "prefix")
+	assert.Len(t, key, 1+16+16+len(recordID), "key length")
+	assert.Equal(t, GetProcessRecordCacheIteratorPrefix("processhash"), key[:17], "process hash prefix")
+	assert.Equal(t, GetProcessMethodRecordCacheIteratorPrefix("processhash", "processmethod"), key[:33], "process hash and method prefix")
+	assert.Equal(t, recordID.Bytes(), key[33:], "record id")
+
+	otherMethod := GetProcessRecordCacheKey("processhash", "othermethod", recordID)
+	assert.Equal(t, key[:17], otherMethod[:17], "process hash prefix with other method")
+	assert.NotEqual(t, key[17:33], otherMethod[17:33], "method hash with other method")
+}
+
+func TestContractSpecSessionCacheKey(t *testing.T) {
+	contractSpecID := ContractSpecMetadataAddress(uuid.New())
+	sessionID := SessionMetadataAddress(uuid.New(), uuid.New())
+	key := GetContractSpecSessionCacheKey(contractSpecID, sessionID)
+	assert.Equal(t, ContractSpecSessionCacheKeyPrefix[0], key[0], "prefix")
+	assert.Equal(t, GetContractSpecSessionCacheIteratorPrefix(contractSpecID), key[:1+len(contractSpecID)], "contract spec prefix")
+	assert.Equal(t, sessionID.Bytes(), key[1+len(contractSpecID):], "session id")
+}
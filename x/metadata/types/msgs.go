@@ -8,6 +8,8 @@ import (
 	"github.com/google/uuid"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/internal/bech32util"
 )
 
 // These TypeURL variables and values be generated by running unit test TestPrintMessageTypeStrings in msgs_test.go.
@@ -20,13 +22,22 @@ const (
 	TypeURLMsgDeleteScopeOwnerRequest                = "/provenance.metadata.v1.MsgDeleteScopeOwnerRequest"
 	TypeURLMsgUpdateValueOwnersRequest               = "/provenance.metadata.v1.MsgUpdateValueOwnersRequest"
 	TypeURLMsgMigrateValueOwnerRequest               = "/provenance.metadata.v1.MsgMigrateValueOwnerRequest"
+	TypeURLMsgSubstituteScopePartyRequest            = "/provenance.metadata.v1.MsgSubstituteScopePartyRequest"
+	TypeURLMsgOpenScopeSettlementRequest             = "/provenance.metadata.v1.MsgOpenScopeSettlementRequest"
+	TypeURLMsgFundScopeSettlementRequest             = "/provenance.metadata.v1.MsgFundScopeSettlementRequest"
+	TypeURLMsgSettleScopeSettlementRequest           = "/provenance.metadata.v1.MsgSettleScopeSettlementRequest"
+	TypeURLMsgCancelScopeSettlementRequest           = "/provenance.metadata.v1.MsgCancelScopeSettlementRequest"
 	TypeURLMsgWriteSessionRequest                    = "/provenance.metadata.v1.MsgWriteSessionRequest"
+	TypeURLMsgCompleteSessionRequest                 = "/provenance.metadata.v1.MsgCompleteSessionRequest"
+	TypeURLMsgAbortSessionRequest                    = "/provenance.metadata.v1.MsgAbortSessionRequest"
 	TypeURLMsgWriteRecordRequest                     = "/provenance.metadata.v1.MsgWriteRecordRequest"
 	TypeURLMsgDeleteRecordRequest                    = "/provenance.metadata.v1.MsgDeleteRecordRequest"
 	TypeURLMsgWriteScopeSpecificationRequest         = "/provenance.metadata.v1.MsgWriteScopeSpecificationRequest"
 	TypeURLMsgDeleteScopeSpecificationRequest        = "/provenance.metadata.v1.MsgDeleteScopeSpecificationRequest"
+	TypeURLMsgMigrateScopeSpecificationRequest       = "/provenance.metadata.v1.MsgMigrateScopeSpecificationRequest"
 	TypeURLMsgWriteContractSpecificationRequest      = "/provenance.metadata.v1.MsgWriteContractSpecificationRequest"
 	TypeURLMsgDeleteContractSpecificationRequest     = "/provenance.metadata.v1.MsgDeleteContractSpecificationRequest"
+	TypeURLMsgDeprecateContractSpecificationRequest  = "/provenance.metadata.v1.MsgDeprecateContractSpecificationRequest"
 	TypeURLMsgAddContractSpecToScopeSpecRequest      = "/provenance.metadata.v1.MsgAddContractSpecToScopeSpecRequest"
 	TypeURLMsgDeleteContractSpecFromScopeSpecRequest = "/provenance.metadata.v1.MsgDeleteContractSpecFromScopeSpecRequest"
 	TypeURLMsgWriteRecordSpecificationRequest        = "/provenance.metadata.v1.MsgWriteRecordSpecificationRequest"
@@ -34,6 +45,9 @@ const (
 	TypeURLMsgBindOSLocatorRequest                   = "/provenance.metadata.v1.MsgBindOSLocatorRequest"
 	TypeURLMsgDeleteOSLocatorRequest                 = "/provenance.metadata.v1.MsgDeleteOSLocatorRequest"
 	TypeURLMsgModifyOSLocatorRequest                 = "/provenance.metadata.v1.MsgModifyOSLocatorRequest"
+	TypeURLMsgAddOSLocatorURIRequest                 = "/provenance.metadata.v1.MsgAddOSLocatorURIRequest"
+	TypeURLMsgRemoveOSLocatorURIRequest              = "/provenance.metadata.v1.MsgRemoveOSLocatorURIRequest"
+	TypeURLMsgReorderOSLocatorURIsRequest            = "/provenance.metadata.v1.MsgReorderOSLocatorURIsRequest"
 	TypeURLMsgSetAccountDataRequest                  = "/provenance.metadata.v1.MsgSetAccountDataRequest"
 )
 
@@ -57,14 +71,23 @@ var AllRequestMsgs = []MetadataMsg{
 	(*MsgDeleteScopeOwnerRequest)(nil),
 	(*MsgUpdateValueOwnersRequest)(nil),
 	(*MsgMigrateValueOwnerRequest)(nil),
+	(*MsgSubstituteScopePartyRequest)(nil),
+	(*MsgOpenScopeSettlementRequest)(nil),
+	(*MsgFundScopeSettlementRequest)(nil),
+	(*MsgSettleScopeSettlementRequest)(nil),
+	(*MsgCancelScopeSettlementRequest)(nil),
 	(*MsgWriteSessionRequest)(nil),
+	(*MsgCompleteSessionRequest)(nil),
+	(*MsgAbortSessionRequest)(nil),
 	(*MsgWriteRecordRequest)(nil),
 	(*MsgDeleteRecordRequest)(nil),
 
 	(*MsgWriteScopeSpecificationRequest)(nil),
 	(*MsgDeleteScopeSpecificationRequest)(nil),
+	(*MsgMigrateScopeSpecificationRequest)(nil),
 	(*MsgWriteContractSpecificationRequest)(nil),
 	(*MsgDeleteContractSpecificationRequest)(nil),
+	(*MsgDeprecateContractSpecificationRequest)(nil),
 	(*MsgAddContractSpecToScopeSpecRequest)(nil),
 	(*MsgDeleteContractSpecFromScopeSpecRequest)(nil),
 	(*MsgWriteRecordSpecificationRequest)(nil),
@@ -76,6 +99,9 @@ var AllRequestMsgs = []MetadataMsg{
 	(*MsgBindOSLocatorRequest)(nil),
 	(*MsgDeleteOSLocatorRequest)(nil),
 	(*MsgModifyOSLocatorRequest)(nil),
+	(*MsgAddOSLocatorURIRequest)(nil),
+	(*MsgRemoveOSLocatorURIRequest)(nil),
+	(*MsgReorderOSLocatorURIsRequest)(nil),
 
 	(*MsgSetAccountDataRequest)(nil),
 
@@ -205,7 +231,7 @@ func (msg MsgAddScopeDataAccessRequest) ValidateBasic() error {
 		return fmt.Errorf("at least one data access address is required")
 	}
 	for _, da := range msg.DataAccess {
-		_, err := sdk.AccAddressFromBech32(da)
+		_, err := bech32util.ValidateAccAddress(da)
 		if err != nil {
 			return fmt.Errorf("data access address is invalid: %s", da)
 		}
@@ -241,7 +267,7 @@ func (msg MsgDeleteScopeDataAccessRequest) ValidateBasic() error {
 		return fmt.Errorf("at least one data access address is required")
 	}
 	for _, da := range msg.DataAccess {
-		_, err := sdk.AccAddressFromBech32(da)
+		_, err := bech32util.ValidateAccAddress(da)
 		if err != nil {
 			return fmt.Errorf("data access address is invalid: %s", da)
 		}
@@ -307,7 +333,7 @@ func (msg MsgDeleteScopeOwnerRequest) ValidateBasic() error {
 		return fmt.Errorf("at least one owner address is required")
 	}
 	for _, owner := range msg.Owners {
-		_, err := sdk.AccAddressFromBech32(owner)
+		_, err := bech32util.ValidateAccAddress(owner)
 		if err != nil {
 			return fmt.Errorf("owner address is invalid: %s", owner)
 		}
@@ -345,7 +371,7 @@ func (msg MsgUpdateValueOwnersRequest) ValidateBasic() error {
 		}
 	}
 
-	_, err := sdk.AccAddressFromBech32(msg.ValueOwnerAddress)
+	_, err := bech32util.ValidateAccAddress(msg.ValueOwnerAddress)
 	if err != nil {
 		return fmt.Errorf("invalid value owner address: %w", err)
 	}
@@ -375,11 +401,11 @@ func (msg MsgMigrateValueOwnerRequest) GetSignerStrs() []string {
 
 // ValidateBasic performs as much validation as possible without outside info. Implements sdk.Msg interface.
 func (msg MsgMigrateValueOwnerRequest) ValidateBasic() error {
-	_, err := sdk.AccAddressFromBech32(msg.Existing)
+	_, err := bech32util.ValidateAccAddress(msg.Existing)
 	if err != nil {
 		return fmt.Errorf("invalid existing value owner address: %w", err)
 	}
-	_, err = sdk.AccAddressFromBech32(msg.Proposed)
+	_, err = bech32util.ValidateAccAddress(msg.Proposed)
 	if err != nil {
 		return fmt.Errorf("invalid proposed value owner address: %w", err)
 	}
@@ -391,6 +417,127 @@ func (msg MsgMigrateValueOwnerRequest) ValidateBasic() error {
 	return nil
 }
 
+// ------------------  MsgSubstituteScopePartyRequest  ------------------
+
+// NewMsgSubstituteScopePartyRequest creates a new msg instance
+func NewMsgSubstituteScopePartyRequest(scopeID MetadataAddress, existing, replacement string, signers []string) *MsgSubstituteScopePartyRequest {
+	return &MsgSubstituteScopePartyRequest{
+		ScopeId:     scopeID,
+		Existing:    existing,
+		Replacement: replacement,
+		Signers:     signers,
+	}
+}
+
+// GetSignerStrs returns the bech32 address(es) that signed. Implements MetadataMsg interface.
+func (msg MsgSubstituteScopePartyRequest) GetSignerStrs() []string {
+	return msg.Signers
+}
+
+// ValidateBasic performs as much validation as possible without outside info. Implements sdk.Msg interface.
+func (msg MsgSubstituteScopePartyRequest) ValidateBasic() error {
+	if !msg.ScopeId.IsScopeAddress() {
+		return fmt.Errorf("address is not a scope id: %v", msg.ScopeId.String())
+	}
+	if _, err := bech32util.ValidateAccAddress(msg.Existing); err != nil {
+		return fmt.Errorf("invalid existing party address: %w", err)
+	}
+	if _, err := bech32util.ValidateAccAddress(msg.Replacement); err != nil {
+		return fmt.Errorf("invalid replacement party address: %w", err)
+	}
+	if msg.Existing == msg.Replacement {
+		return fmt.Errorf("existing and replacement party addresses cannot be the same: %s", msg.Existing)
+	}
+	if len(msg.Signers) == 0 {
+		return fmt.Errorf("at least one signer is required")
+	}
+	return nil
+}
+
+// ------------------  MsgOpenScopeSettlementRequest  ------------------
+
+// NewMsgOpenScopeSettlementRequest creates a new msg instance
+func NewMsgOpenScopeSettlementRequest(scopeID MetadataAddress, seller, buyer string, price sdk.Coins) *MsgOpenScopeSettlementRequest {
+	return &MsgOpenScopeSettlementRequest{
+		ScopeId: scopeID,
+		Seller:  seller,
+		Buyer:   buyer,
+		Price:   price,
+	}
+}
+
+// GetSignerStrs returns the bech32 address(es) that signed. Implements MetadataMsg interface.
+func (msg MsgOpenScopeSettlementRequest) GetSignerStrs() []string {
+	return []string{msg.Seller}
+}
+
+// ValidateBasic performs as much validation as possible without outside info. Implements sdk.Msg interface.
+func (msg MsgOpenScopeSettlementRequest) ValidateBasic() error {
+	return NewScopeSettlement(msg.ScopeId, msg.Seller, msg.Buyer, msg.Price).Validate()
+}
+
+// ------------------  MsgFundScopeSettlementRequest  ------------------
+
+// NewMsgFundScopeSettlementRequest creates a new msg instance
+func NewMsgFundScopeSettlementRequest(scopeID MetadataAddress, buyer string) *MsgFundScopeSettlementRequest {
+	return &MsgFundScopeSettlementRequest{ScopeId: scopeID, Buyer: buyer}
+}
+
+// GetSignerStrs returns the bech32 address(es) that signed. Implements MetadataMsg interface.
+func (msg MsgFundScopeSettlementRequest) GetSignerStrs() []string {
+	return []string{msg.Buyer}
+}
+
+// ValidateBasic performs as much validation as possible without outside info. Implements sdk.Msg interface.
+func (msg MsgFundScopeSettlementRequest) ValidateBasic() error {
+	return validateScopeSettlementParty(msg.ScopeId, "buyer", msg.Buyer)
+}
+
+// ------------------  MsgSettleScopeSettlementRequest  ------------------
+
+// NewMsgSettleScopeSettlementRequest creates a new msg instance
+func NewMsgSettleScopeSettlementRequest(scopeID MetadataAddress, signer string) *MsgSettleScopeSettlementRequest {
+	return &MsgSettleScopeSettlementRequest{ScopeId: scopeID, Signer: signer}
+}
+
+// GetSignerStrs returns the bech32 address(es) that signed. Implements MetadataMsg interface.
+func (msg MsgSettleScopeSettlementRequest) GetSignerStrs() []string {
+	return []string{msg.Signer}
+}
+
+// ValidateBasic performs as much validation as possible without outside info. Implements sdk.Msg interface.
+func (msg MsgSettleScopeSettlementRequest) ValidateBasic() error {
+	return validateScopeSettlementParty(msg.ScopeId, "signer", msg.Signer)
+}
+
+// ------------------  MsgCancelScopeSettlementRequest  ------------------
+
+// NewMsgCancelScopeSettlementRequest creates a new msg instance
+func NewMsgCancelScopeSettlementRequest(scopeID MetadataAddress, signer string) *MsgCancelScopeSettlementRequest {
+	return &MsgCancelScopeSettlementRequest{ScopeId: scopeID, Signer: signer}
+}
+
+// GetSignerStrs returns the bech32 address(es) that signed. Implements MetadataMsg interface.
+func (msg MsgCancelScopeSettlementRequest) GetSignerStrs() []string {
+	return []string{msg.Signer}
+}
+
+// ValidateBasic performs as much validation as possible without outside info. Implements sdk.Msg interface.
+func (msg MsgCancelScopeSettlementRequest) ValidateBasic() error {
+	return validateScopeSettlementParty(msg.ScopeId, "signer", msg.Signer)
+}
+
+// validateScopeSettlementParty returns an error if the scope id is not for a scope, or the party address is invalid.
+func validateScopeSettlementParty(scopeID MetadataAddress, field, addr string) error {
+	if err := scopeID.ValidateIsScopeAddress(); err != nil {
+		return fmt.Errorf("invalid scope id: %w", err)
+	}
+	if _, err := bech32util.ValidateAccAddress(addr); err != nil {
+		return fmt.Errorf("invalid %s %q: %w", field, addr, err)
+	}
+	return nil
+}
+
 // ------------------  MsgWriteSessionRequest  ------------------
 
 // NewMsgWriteSessionRequest creates a new msg instance
@@ -454,6 +601,51 @@ func NewMsgWriteSessionResponse(sessionID MetadataAddress) *MsgWriteSessionRespo
 	}
 }
 
+// ------------------  MsgCompleteSessionRequest  ------------------
+
+// NewMsgCompleteSessionRequest creates a new msg instance
+func NewMsgCompleteSessionRequest(sessionID MetadataAddress, signers []string) *MsgCompleteSessionRequest {
+	return &MsgCompleteSessionRequest{SessionId: sessionID, Signers: signers}
+}
+
+// GetSignerStrs returns the bech32 address(es) that signed. Implements MetadataMsg interface.
+func (msg MsgCompleteSessionRequest) GetSignerStrs() []string {
+	return msg.Signers
+}
+
+// ValidateBasic performs as much validation as possible without outside info. Implements sdk.Msg interface.
+func (msg MsgCompleteSessionRequest) ValidateBasic() error {
+	return validateSessionStatusChange(msg.SessionId, msg.Signers)
+}
+
+// ------------------  MsgAbortSessionRequest  ------------------
+
+// NewMsgAbortSessionRequest creates a new msg instance
+func NewMsgAbortSessionRequest(sessionID MetadataAddress, signers []string) *MsgAbortSessionRequest {
+	return &MsgAbortSessionRequest{SessionId: sessionID, Signers: signers}
+}
+
+// GetSignerStrs returns the bech32 address(es) that signed. Implements MetadataMsg interface.
+func (msg MsgAbortSessionRequest) GetSignerStrs() []string {
+	return msg.Signers
+}
+
+// ValidateBasic performs as much validation as possible without outside info. Implements sdk.Msg interface.
+func (msg MsgAbortSessionRequest) ValidateBasic() error {
+	return validateSessionStatusChange(msg.SessionId, msg.Signers)
+}
+
+// validateSessionStatusChange returns an error if the session id is not for a session, or there aren't any signers.
+func validateSessionStatusChange(sessionID MetadataAddress, signers []string) error {
+	if len(signers) < 1 {
+		return fmt.Errorf("at least one signer is required")
+	}
+	if !sessionID.IsSessionAddress() {
+		return fmt.Errorf("invalid session id %q: not a session address", sessionID)
+	}
+	return nil
+}
+
 // ------------------  MsgWriteRecordRequest  ------------------
 
 // NewMsgWriteRecordRequest creates a new msg instance
@@ -609,6 +801,31 @@ func (msg MsgDeleteScopeSpecificationRequest) ValidateBasic() error {
 	return nil
 }
 
+// ------------------  MsgMigrateScopeSpecificationRequest  ------------------
+
+// NewMsgMigrateScopeSpecificationRequest creates a new msg instance
+func NewMsgMigrateScopeSpecificationRequest(authority string, fromSpecID, toSpecID MetadataAddress, batchSize uint32) *MsgMigrateScopeSpecificationRequest {
+	return &MsgMigrateScopeSpecificationRequest{
+		Authority:           authority,
+		FromSpecificationId: fromSpecID,
+		ToSpecificationId:   toSpecID,
+		BatchSize:           batchSize,
+	}
+}
+
+// GetSignerStrs returns the bech32 address(es) that signed. Implements MetadataMsg interface.
+func (msg MsgMigrateScopeSpecificationRequest) GetSignerStrs() []string {
+	return []string{msg.Authority}
+}
+
+// ValidateBasic performs as much validation as possible without outside info. Implements sdk.Msg interface.
+func (msg MsgMigrateScopeSpecificationRequest) ValidateBasic() error {
+	if _, err := bech32util.ValidateAccAddress(msg.Authority); err != nil {
+		return fmt.Errorf("invalid authority: %w", err)
+	}
+	return validateScopeSpecMigrationIDs(msg.FromSpecificationId, msg.ToSpecificationId)
+}
+
 // ------------------  MsgWriteContractSpecificationRequest  ------------------
 
 // NewMsgWriteContractSpecificationRequest creates a new msg instance
@@ -678,6 +895,34 @@ func (msg MsgDeleteContractSpecificationRequest) ValidateBasic() error {
 	return nil
 }
 
+// ------------------  MsgDeprecateContractSpecificationRequest  ------------------
+
+// NewMsgDeprecateContractSpecificationRequest creates a new msg instance
+func NewMsgDeprecateContractSpecificationRequest(specificationID, replacementID MetadataAddress, signers []string) *MsgDeprecateContractSpecificationRequest {
+	return &MsgDeprecateContractSpecificationRequest{SpecificationId: specificationID, ReplacementId: replacementID, Signers: signers}
+}
+
+// GetSignerStrs returns the bech32 address(es) that signed. Implements MetadataMsg interface.
+func (msg MsgDeprecateContractSpecificationRequest) GetSignerStrs() []string {
+	return msg.Signers
+}
+
+// ValidateBasic performs as much validation as possible without outside info. Implements sdk.Msg interface.
+func (msg MsgDeprecateContractSpecificationRequest) ValidateBasic() error {
+	if !msg.SpecificationId.IsContractSpecificationAddress() {
+		return fmt.Errorf("address is not a contract specification id: %s", msg.SpecificationId.String())
+	}
+	if !msg.ReplacementId.Empty() {
+		if err := validateContractSpecReplacement(msg.SpecificationId, msg.ReplacementId); err != nil {
+			return err
+		}
+	}
+	if len(msg.Signers) < 1 {
+		return fmt.Errorf("at least one signer is required")
+	}
+	return nil
+}
+
 // ------------------  MsgAddContractSpecToScopeSpecRequest  ------------------
 
 // NewMsgAddContractSpecToScopeSpecRequest creates a new msg instance
@@ -883,6 +1128,78 @@ func (msg MsgModifyOSLocatorRequest) ValidateBasic() error {
 	return nil
 }
 
+// ------------------  MsgAddOSLocatorURIRequest  ------------------
+
+// NewMsgAddOSLocatorURIRequest creates a new msg instance
+func NewMsgAddOSLocatorURIRequest(owner, uri string) *MsgAddOSLocatorURIRequest {
+	return &MsgAddOSLocatorURIRequest{
+		Owner: owner,
+		Uri:   uri,
+	}
+}
+
+// GetSignerStrs returns the bech32 address(es) that signed. Implements MetadataMsg interface.
+func (msg MsgAddOSLocatorURIRequest) GetSignerStrs() []string {
+	return []string{msg.Owner}
+}
+
+// ValidateBasic performs as much validation as possible without outside info. Implements sdk.Msg interface.
+func (msg MsgAddOSLocatorURIRequest) ValidateBasic() error {
+	if _, err := bech32util.ValidateAccAddress(msg.Owner); err != nil {
+		return fmt.Errorf("invalid owner address %q: %w", msg.Owner, err)
+	}
+	return ValidateOSLocatorURIs([]string{msg.Uri})
+}
+
+// ------------------  MsgRemoveOSLocatorURIRequest  ------------------
+
+// NewMsgRemoveOSLocatorURIRequest creates a new msg instance
+func NewMsgRemoveOSLocatorURIRequest(owner, uri string) *MsgRemoveOSLocatorURIRequest {
+	return &MsgRemoveOSLocatorURIRequest{
+		Owner: owner,
+		Uri:   uri,
+	}
+}
+
+// GetSignerStrs returns the bech32 address(es) that signed. Implements MetadataMsg interface.
+func (msg MsgRemoveOSLocatorURIRequest) GetSignerStrs() []string {
+	return []string{msg.Owner}
+}
+
+// ValidateBasic performs as much validation as possible without outside info. Implements sdk.Msg interface.
+func (msg MsgRemoveOSLocatorURIRequest) ValidateBasic() error {
+	if _, err := bech32util.ValidateAccAddress(msg.Owner); err != nil {
+		return fmt.Errorf("invalid owner address %q: %w", msg.Owner, err)
+	}
+	if strings.TrimSpace(msg.Uri) == "" {
+		return fmt.Errorf("uri cannot be empty")
+	}
+	return nil
+}
+
+// ------------------  MsgReorderOSLocatorURIsRequest  ------------------
+
+// NewMsgReorderOSLocatorURIsRequest creates a new msg instance
+func NewMsgReorderOSLocatorURIsRequest(owner string, uris []string) *MsgReorderOSLocatorURIsRequest {
+	return &MsgReorderOSLocatorURIsRequest{
+		Owner: owner,
+		Uris:  uris,
+	}
+}
+
+// GetSignerStrs returns the bech32 address(es) that signed. Implements MetadataMsg interface.
+func (msg MsgReorderOSLocatorURIsRequest) GetSignerStrs() []string {
+	return []string{msg.Owner}
+}
+
+// ValidateBasic performs as much validation as possible without outside info. Implements sdk.Msg interface.
+func (msg MsgReorderOSLocatorURIsRequest) ValidateBasic() error {
+	if _, err := bech32util.ValidateAccAddress(msg.Owner); err != nil {
+		return fmt.Errorf("invalid owner address %q: %w", msg.Owner, err)
+	}
+	return ValidateOSLocatorURIs(msg.Uris)
+}
+
 // ------------------  MsgSetAccountDataRequest  ------------------
 
 // ValidateBasic performs as much validation as possible without outside info. Implements sdk.Msg interface.
@@ -951,7 +1268,7 @@ func (msg MsgAddNetAssetValuesRequest) ValidateBasic() error {
 	}
 
 	for _, signer := range msg.Signers {
-		_, err := sdk.AccAddressFromBech32(signer)
+		_, err := bech32util.ValidateAccAddress(signer)
 		if err != nil {
 			return err
 		}
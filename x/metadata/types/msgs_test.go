@@ -16,6 +16,7 @@ import (
 
 	"github.com/provenance-io/provenance/app"
 	"github.com/provenance-io/provenance/testutil"
+	"github.com/provenance-io/provenance/testutil/assertions"
 
 	. "github.com/provenance-io/provenance/x/metadata/types"
 )
@@ -54,6 +55,14 @@ func TestAllMsgsGetSigners(t *testing.T) {
 		func(signer string) sdk.Msg {
 			return &MsgModifyOSLocatorRequest{Locator: ObjectStoreLocator{Owner: signer}}
 		},
+		func(signer string) sdk.Msg { return &MsgAddOSLocatorURIRequest{Owner: signer} },
+		func(signer string) sdk.Msg { return &MsgRemoveOSLocatorURIRequest{Owner: signer} },
+		func(signer string) sdk.Msg { return &MsgReorderOSLocatorURIsRequest{Owner: signer} },
+		func(signer string) sdk.Msg { return &MsgMigrateScopeSpecificationRequest{Authority: signer} },
+		func(signer string) sdk.Msg { return &MsgOpenScopeSettlementRequest{Seller: signer} },
+		func(signer string) sdk.Msg { return &MsgFundScopeSettlementRequest{Buyer: signer} },
+		func(signer string) sdk.Msg { return &MsgSettleScopeSettlementRequest{Signer: signer} },
+		func(signer string) sdk.Msg { return &MsgCancelScopeSettlementRequest{Signer: signer} },
 	}
 
 	multiSignerMsgMakers := []testutil.MsgMakerMulti{
@@ -65,13 +74,17 @@ func TestAllMsgsGetSigners(t *testing.T) {
 		func(signers []string) sdk.Msg { return &MsgDeleteScopeOwnerRequest{Signers: signers} },
 		func(signers []string) sdk.Msg { return &MsgUpdateValueOwnersRequest{Signers: signers} },
 		func(signers []string) sdk.Msg { return &MsgMigrateValueOwnerRequest{Signers: signers} },
+		func(signers []string) sdk.Msg { return &MsgSubstituteScopePartyRequest{Signers: signers} },
 		func(signers []string) sdk.Msg { return &MsgWriteSessionRequest{Signers: signers} },
+		func(signers []string) sdk.Msg { return &MsgCompleteSessionRequest{Signers: signers} },
+		func(signers []string) sdk.Msg { return &MsgAbortSessionRequest{Signers: signers} },
 		func(signers []string) sdk.Msg { return &MsgWriteRecordRequest{Signers: signers} },
 		func(signers []string) sdk.Msg { return &MsgDeleteRecordRequest{Signers: signers} },
 		func(signers []string) sdk.Msg { return &MsgWriteScopeSpecificationRequest{Signers: signers} },
 		func(signers []string) sdk.Msg { return &MsgDeleteScopeSpecificationRequest{Signers: signers} },
 		func(signers []string) sdk.Msg { return &MsgWriteContractSpecificationRequest{Signers: signers} },
 		func(signers []string) sdk.Msg { return &MsgDeleteContractSpecificationRequest{Signers: signers} },
+		func(signers []string) sdk.Msg { return &MsgDeprecateContractSpecificationRequest{Signers: signers} },
 		func(signers []string) sdk.Msg { return &MsgAddContractSpecToScopeSpecRequest{Signers: signers} },
 		func(signers []string) sdk.Msg {
 			return &MsgDeleteContractSpecFromScopeSpecRequest{Signers: signers}
@@ -651,6 +664,165 @@ func TestMsgMigrateValueOwnerRequest_ValidateBasic(t *testing.T) {
 	}
 }
 
+func TestMsgSubstituteScopePartyRequest_ValidateBasic(t *testing.T) {
+	scopeID := ScopeMetadataAddress(uuid.New())
+	existing := sdk.AccAddress("existing_party______").String()
+	replacement := sdk.AccAddress("replacement_party___").String()
+
+	tests := []struct {
+		name string
+		msg  *MsgSubstituteScopePartyRequest
+		exp  string
+	}{
+		{
+			name: "control",
+			msg:  NewMsgSubstituteScopePartyRequest(scopeID, existing, replacement, []string{"signer1"}),
+		},
+		{
+			name: "not a scope id",
+			msg:  NewMsgSubstituteScopePartyRequest(ScopeSpecMetadataAddress(uuid.New()), existing, replacement, []string{"signer1"}),
+			exp:  "address is not a scope id",
+		},
+		{
+			name: "invalid existing",
+			msg:  NewMsgSubstituteScopePartyRequest(scopeID, "notanaddress", replacement, []string{"signer1"}),
+			exp:  "invalid existing party address: decoding bech32 failed: invalid separator index -1",
+		},
+		{
+			name: "empty replacement",
+			msg:  NewMsgSubstituteScopePartyRequest(scopeID, existing, "", []string{"signer1"}),
+			exp:  "invalid replacement party address: empty address string is not allowed",
+		},
+		{
+			name: "same addresses",
+			msg:  NewMsgSubstituteScopePartyRequest(scopeID, existing, existing, []string{"signer1"}),
+			exp:  "existing and replacement party addresses cannot be the same: " + existing,
+		},
+		{
+			name: "no signers",
+			msg:  NewMsgSubstituteScopePartyRequest(scopeID, existing, replacement, nil),
+			exp:  "at least one signer is required",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if len(tc.exp) > 0 {
+				assert.ErrorContains(t, err, tc.exp, "ValidateBasic")
+			} else {
+				assert.NoError(t, err, "ValidateBasic")
+			}
+		})
+	}
+}
+
+func TestMsgDeprecateContractSpecificationRequestValidateBasic(t *testing.T) {
+	contractSpecID := ContractSpecMetadataAddress(uuid.New())
+	replacementID := ContractSpecMetadataAddress(uuid.New())
+	scopeSpecID := ScopeSpecMetadataAddress(uuid.New())
+	signers := []string{"cosmos1sh49f6ze3vn7cdl2amh2gnc70z5mten3y08xck"}
+
+	cases := map[string]struct {
+		msg      *MsgDeprecateContractSpecificationRequest
+		wantErr  bool
+		errorMsg string
+	}{
+		"should fail to validate basic, incorrect contract spec id type": {
+			NewMsgDeprecateContractSpecificationRequest(scopeSpecID, nil, signers),
+			true,
+			fmt.Sprintf("address is not a contract specification id: %v", scopeSpecID.String()),
+		},
+		"should fail to validate basic, incorrect replacement id type": {
+			NewMsgDeprecateContractSpecificationRequest(contractSpecID, scopeSpecID, signers),
+			true,
+			fmt.Sprintf("invalid replacement id: %v is not a contract specification id", scopeSpecID.String()),
+		},
+		"should fail to validate basic, replacement is itself": {
+			NewMsgDeprecateContractSpecificationRequest(contractSpecID, contractSpecID, signers),
+			true,
+			"a contract specification cannot be its own replacement",
+		},
+		"should fail to validate basic, requires at least one signer": {
+			NewMsgDeprecateContractSpecificationRequest(contractSpecID, replacementID, []string{}),
+			true,
+			"at least one signer is required",
+		},
+		"should successfully validate basic without replacement": {
+			NewMsgDeprecateContractSpecificationRequest(contractSpecID, nil, signers),
+			false,
+			"",
+		},
+		"should successfully validate basic with replacement": {
+			NewMsgDeprecateContractSpecificationRequest(contractSpecID, replacementID, signers),
+			false,
+			"",
+		},
+	}
+
+	for n, tc := range cases {
+		tc := tc
+
+		t.Run(n, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.wantErr {
+				require.Error(t, err)
+				require.Equal(t, tc.errorMsg, err.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMsgMigrateScopeSpecificationRequestValidateBasic(t *testing.T) {
+	authority := "cosmos10d07y265gmmuvt4z0w9aw880jnsr700j6zn9kn"
+	fromSpecID := ScopeSpecMetadataAddress(uuid.New())
+	toSpecID := ScopeSpecMetadataAddress(uuid.New())
+	contractSpecID := ContractSpecMetadataAddress(uuid.New())
+
+	cases := map[string]struct {
+		msg      *MsgMigrateScopeSpecificationRequest
+		errorMsg string
+	}{
+		"should fail to validate basic, invalid authority": {
+			NewMsgMigrateScopeSpecificationRequest("", fromSpecID, toSpecID, 0),
+			"invalid authority: empty address string is not allowed",
+		},
+		"should fail to validate basic, incorrect from spec id type": {
+			NewMsgMigrateScopeSpecificationRequest(authority, contractSpecID, toSpecID, 0),
+			fmt.Sprintf("invalid from specification id: %s is not a scope specification id", contractSpecID),
+		},
+		"should fail to validate basic, incorrect to spec id type": {
+			NewMsgMigrateScopeSpecificationRequest(authority, fromSpecID, contractSpecID, 0),
+			fmt.Sprintf("invalid to specification id: %s is not a scope specification id", contractSpecID),
+		},
+		"should fail to validate basic, migrating to itself": {
+			NewMsgMigrateScopeSpecificationRequest(authority, fromSpecID, fromSpecID, 0),
+			"cannot migrate scopes from a scope specification to itself",
+		},
+		"should successfully validate basic without batch size": {
+			NewMsgMigrateScopeSpecificationRequest(authority, fromSpecID, toSpecID, 0),
+			"",
+		},
+		"should successfully validate basic with batch size": {
+			NewMsgMigrateScopeSpecificationRequest(authority, fromSpecID, toSpecID, 1000),
+			"",
+		},
+	}
+
+	for n, tc := range cases {
+		t.Run(n, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if len(tc.errorMsg) > 0 {
+				require.EqualError(t, err, tc.errorMsg)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
 func TestMsgAddContractSpecToScopeSpecRequestValidateBasic(t *testing.T) {
 	contractSpecID := ContractSpecMetadataAddress(uuid.New())
 	scopeSpecID := ScopeSpecMetadataAddress(uuid.New())
@@ -743,6 +915,36 @@ func TestMsgDeleteContractSpecFromScopeSpecRequestValidateBasic(t *testing.T) {
 	}
 }
 
+func TestSessionStatusMsgsValidateBasic(t *testing.T) {
+	scopeUUID := uuid.New()
+	sessionID := SessionMetadataAddress(scopeUUID, uuid.New())
+	scopeID := ScopeMetadataAddress(scopeUUID)
+	signers := []string{"cosmos1sh49f6ze3vn7cdl2amh2gnc70z5mten3y08xck"}
+
+	cases := []struct {
+		name      string
+		sessionID MetadataAddress
+		signers   []string
+		expErr    string
+	}{
+		{name: "valid", sessionID: sessionID, signers: signers},
+		{name: "no signers", sessionID: sessionID, signers: nil, expErr: "at least one signer is required"},
+		{name: "scope id", sessionID: scopeID, signers: signers, expErr: fmt.Sprintf("invalid session id %q: not a session address", scopeID)},
+		{name: "empty id", sessionID: nil, signers: signers, expErr: `invalid session id "": not a session address`},
+	}
+
+	for _, tc := range cases {
+		t.Run("complete "+tc.name, func(t *testing.T) {
+			err := NewMsgCompleteSessionRequest(tc.sessionID, tc.signers).ValidateBasic()
+			assertions.AssertErrorValue(t, err, tc.expErr, "MsgCompleteSessionRequest.ValidateBasic")
+		})
+		t.Run("abort "+tc.name, func(t *testing.T) {
+			err := NewMsgAbortSessionRequest(tc.sessionID, tc.signers).ValidateBasic()
+			assertions.AssertErrorValue(t, err, tc.expErr, "MsgAbortSessionRequest.ValidateBasic")
+		})
+	}
+}
+
 func TestBindOSLocator(t *testing.T) {
 	var bindRequestMsg = NewMsgBindOSLocatorRequest(ObjectStoreLocator{Owner: "cosmos1sh49f6ze3vn7cdl2amh2gnc70z5mten3y08xck", LocatorUri: "http://foo.com"})
 
@@ -752,7 +954,7 @@ func TestBindOSLocator(t *testing.T) {
 	require.Equal(t, "/provenance.metadata.v1.MsgBindOSLocatorRequest", sdk.MsgTypeURL(bindRequestMsg))
 
 	bz, _ := GetCdc(t).MarshalJSON(bindRequestMsg)
-	require.Equal(t, "{\"locator\":{\"owner\":\"cosmos1sh49f6ze3vn7cdl2amh2gnc70z5mten3y08xck\",\"locator_uri\":\"http://foo.com\",\"encryption_key\":\"\"}}", string(bz))
+	require.Equal(t, "{\"locator\":{\"owner\":\"cosmos1sh49f6ze3vn7cdl2amh2gnc70z5mten3y08xck\",\"locator_uri\":\"http://foo.com\",\"encryption_key\":\"\",\"failover_uris\":[]}}", string(bz))
 }
 
 func TestModifyOSLocator(t *testing.T) {
@@ -764,7 +966,7 @@ func TestModifyOSLocator(t *testing.T) {
 	require.Equal(t, "/provenance.metadata.v1.MsgModifyOSLocatorRequest", sdk.MsgTypeURL(modifyRequest))
 
 	bz, _ := GetCdc(t).MarshalJSON(modifyRequest)
-	require.Equal(t, "{\"locator\":{\"owner\":\"cosmos1sh49f6ze3vn7cdl2amh2gnc70z5mten3y08xck\",\"locator_uri\":\"http://foo.com\",\"encryption_key\":\"\"}}", string(bz))
+	require.Equal(t, "{\"locator\":{\"owner\":\"cosmos1sh49f6ze3vn7cdl2amh2gnc70z5mten3y08xck\",\"locator_uri\":\"http://foo.com\",\"encryption_key\":\"\",\"failover_uris\":[]}}", string(bz))
 }
 
 func TestDeleteOSLocator(t *testing.T) {
@@ -776,7 +978,7 @@ func TestDeleteOSLocator(t *testing.T) {
 	require.Equal(t, "/provenance.metadata.v1.MsgDeleteOSLocatorRequest", sdk.MsgTypeURL(deleteRequest))
 
 	bz, _ := GetCdc(t).MarshalJSON(deleteRequest)
-	require.Equal(t, "{\"locator\":{\"owner\":\"cosmos1sh49f6ze3vn7cdl2amh2gnc70z5mten3y08xck\",\"locator_uri\":\"http://foo.com\",\"encryption_key\":\"\"}}", string(bz))
+	require.Equal(t, "{\"locator\":{\"owner\":\"cosmos1sh49f6ze3vn7cdl2amh2gnc70z5mten3y08xck\",\"locator_uri\":\"http://foo.com\",\"encryption_key\":\"\",\"failover_uris\":[]}}", string(bz))
 }
 
 func TestBindOSLocatorInvalid(t *testing.T) {
@@ -800,6 +1002,79 @@ func TestBindOSLocatorInvalidURI(t *testing.T) {
 	require.Error(t, err)
 }
 
+func TestOSLocatorURIMsgsValidateBasic(t *testing.T) {
+	owner := sdk.AccAddress("owner_______________").String()
+	tooMany := make([]string, MaxOSLocatorURIs+1)
+	for i := range tooMany {
+		tooMany[i] = fmt.Sprintf("https://os%d.example.com", i)
+	}
+
+	tests := []struct {
+		name   string
+		msg    MetadataMsg
+		expErr string
+	}{
+		{
+			name: "add: okay",
+			msg:  NewMsgAddOSLocatorURIRequest(owner, "https://backup.example.com"),
+		},
+		{
+			name:   "add: bad owner",
+			msg:    NewMsgAddOSLocatorURIRequest("bad", "https://backup.example.com"),
+			expErr: `invalid owner address "bad": decoding bech32 failed: invalid bech32 string length 3`,
+		},
+		{
+			name:   "add: no uri",
+			msg:    NewMsgAddOSLocatorURIRequest(owner, " "),
+			expErr: "uri cannot be empty",
+		},
+		{
+			name: "remove: okay",
+			msg:  NewMsgRemoveOSLocatorURIRequest(owner, "https://backup.example.com"),
+		},
+		{
+			name:   "remove: no uri",
+			msg:    NewMsgRemoveOSLocatorURIRequest(owner, ""),
+			expErr: "uri cannot be empty",
+		},
+		{
+			name: "reorder: okay",
+			msg:  NewMsgReorderOSLocatorURIsRequest(owner, []string{"https://backup.example.com", "https://os.example.com"}),
+		},
+		{
+			name:   "reorder: bad owner",
+			msg:    NewMsgReorderOSLocatorURIsRequest("", []string{"https://os.example.com"}),
+			expErr: `invalid owner address "": empty address string is not allowed`,
+		},
+		{
+			name:   "reorder: no uris",
+			msg:    NewMsgReorderOSLocatorURIsRequest(owner, nil),
+			expErr: "at least one uri is required",
+		},
+		{
+			name:   "reorder: duplicate uri",
+			msg:    NewMsgReorderOSLocatorURIsRequest(owner, []string{"https://os.example.com", "https://os.example.com"}),
+			expErr: "duplicate uri: https://os.example.com",
+		},
+		{
+			name:   "reorder: too many uris",
+			msg:    NewMsgReorderOSLocatorURIsRequest(owner, tooMany),
+			expErr: fmt.Sprintf("cannot have more than %d uris", MaxOSLocatorURIs),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if len(tc.expErr) > 0 {
+				require.EqualError(t, err, tc.expErr, "ValidateBasic")
+			} else {
+				require.NoError(t, err, "ValidateBasic")
+			}
+		})
+	}
+}
+
 func TestMsgSetAccountDataRequest_ValidateBasic(t *testing.T) {
 	addr1 := sdk.AccAddress("addr1_______________").String()
 	addr2 := sdk.AccAddress("addr2_______________").String()
@@ -8,6 +8,9 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+// MaxOSLocatorURIs is the maximum number of uris (the locator uri and failover uris) an object store locator can have.
+const MaxOSLocatorURIs = 10
+
 // NewOSLocatorRecord creates a oslocator for a given address.
 func NewOSLocatorRecord(ownerAddr, encryptionKey sdk.AccAddress, uri string) ObjectStoreLocator {
 	return ObjectStoreLocator{
@@ -40,5 +43,54 @@ func (r ObjectStoreLocator) Validate() error {
 				r.Owner, r.EncryptionKey)
 		}
 	}
+
+	if err := ValidateOSLocatorURIs(r.AllURIs()); err != nil {
+		return fmt.Errorf("failed to add locator for a given owner address: %s, %w", r.Owner, err)
+	}
+	return nil
+}
+
+// AllURIs returns all of this locator's uris in priority order, i.e. the locator uri followed by the failover uris.
+func (r ObjectStoreLocator) AllURIs() []string {
+	rv := make([]string, 0, 1+len(r.FailoverUris))
+	rv = append(rv, r.LocatorUri)
+	rv = append(rv, r.FailoverUris...)
+	return rv
+}
+
+// SetAllURIs sets this locator's locator uri and failover uris from the provided uris (in priority order).
+func (r *ObjectStoreLocator) SetAllURIs(uris []string) {
+	r.LocatorUri = ""
+	r.FailoverUris = nil
+	if len(uris) > 0 {
+		r.LocatorUri = uris[0]
+	}
+	if len(uris) > 1 {
+		r.FailoverUris = append([]string{}, uris[1:]...)
+	}
+}
+
+// ValidateOSLocatorURIs makes sure that the provided list of object store locator uris is not empty,
+// not too long, and doesn't have any empty, invalid, or duplicate entries.
+func ValidateOSLocatorURIs(uris []string) error {
+	if len(uris) == 0 {
+		return fmt.Errorf("at least one uri is required")
+	}
+	if len(uris) > MaxOSLocatorURIs {
+		return fmt.Errorf("cannot have more than %d uris", MaxOSLocatorURIs)
+	}
+	seen := make(map[string]bool, len(uris))
+	for _, uri := range uris {
+		if strings.TrimSpace(uri) == "" {
+			return fmt.Errorf("uri cannot be empty")
+		}
+		if _, err := url.Parse(uri); err != nil {
+			return fmt.Errorf("invalid uri: %s", uri)
+		}
+		if seen[uri] {
+			return fmt.Errorf("duplicate uri: %s", uri)
+		}
+		seen[uri] = true
+	}
 	return nil
 }
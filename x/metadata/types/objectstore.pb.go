@@ -33,6 +33,8 @@ type ObjectStoreLocator struct {
 	LocatorUri string `protobuf:"bytes,2,opt,name=locator_uri,json=locatorUri,proto3" json:"locator_uri,omitempty"`
 	// owners encryption key address
 	EncryptionKey string `protobuf:"bytes,3,opt,name=encryption_key,json=encryptionKey,proto3" json:"encryption_key,omitempty"`
+	// additional locator endpoint uris, in priority order, to use when the locator_uri endpoint is unavailable.
+	FailoverUris []string `protobuf:"bytes,4,rep,name=failover_uris,json=failoverUris,proto3" json:"failover_uris,omitempty"`
 }
 
 func (m *ObjectStoreLocator) Reset()         { *m = ObjectStoreLocator{} }
@@ -89,6 +91,13 @@ func (m *ObjectStoreLocator) GetEncryptionKey() string {
 	return ""
 }
 
+func (m *ObjectStoreLocator) GetFailoverUris() []string {
+	if m != nil {
+		return m.FailoverUris
+	}
+	return nil
+}
+
 // Params defines the parameters for the metadata-locator module methods.
 type OSLocatorParams struct {
 	MaxUriLength uint32 `protobuf:"varint,1,opt,name=max_uri_length,json=maxUriLength,proto3,customtype=uint32" json:"max_uri_length"`
@@ -137,28 +146,30 @@ func init() {
 }
 
 var fileDescriptor_3d17fc5ccfa1c263 = []byte{
-	// 331 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x91, 0x31, 0x4f, 0x02, 0x31,
-	0x14, 0xc7, 0xef, 0x34, 0x92, 0x58, 0x05, 0x93, 0x0b, 0x51, 0x64, 0x38, 0x0c, 0x89, 0x09, 0x31,
-	0xf1, 0x1a, 0x84, 0xc9, 0x91, 0xc5, 0x41, 0x12, 0x08, 0x84, 0xc5, 0x85, 0x94, 0xb3, 0x29, 0x15,
-	0xda, 0x77, 0x69, 0x0b, 0x72, 0x8b, 0x83, 0x9f, 0xc0, 0x8f, 0xe2, 0xc7, 0x60, 0x64, 0x34, 0x0e,
-	0xc4, 0xc0, 0xe0, 0xd7, 0x30, 0xd7, 0xc3, 0x9c, 0x83, 0xdb, 0x7b, 0xff, 0xfe, 0xde, 0xff, 0xb5,
-	0xff, 0xa2, 0x5a, 0xa4, 0x60, 0x4e, 0x25, 0x91, 0x21, 0xc5, 0x82, 0x1a, 0xf2, 0x48, 0x0c, 0xc1,
-	0xf3, 0x3a, 0x86, 0xd1, 0x13, 0x0d, 0x8d, 0x36, 0xa0, 0x68, 0x10, 0x29, 0x30, 0xe0, 0x9d, 0x66,
-	0x64, 0xf0, 0x4b, 0x06, 0xf3, 0x7a, 0xf9, 0x2c, 0x04, 0x2d, 0x40, 0x63, 0xa1, 0x59, 0x32, 0x28,
-	0x34, 0x4b, 0x07, 0xca, 0x45, 0x06, 0x0c, 0x6c, 0x89, 0x93, 0x2a, 0x55, 0xab, 0x2f, 0xc8, 0xeb,
-	0x58, 0xef, 0x7e, 0xe2, 0xdd, 0x86, 0x90, 0x18, 0x50, 0x5e, 0x11, 0x1d, 0xc0, 0xb3, 0xa4, 0xaa,
-	0xe4, 0x5e, 0xb8, 0xb5, 0xc3, 0x5e, 0xda, 0x78, 0x15, 0x74, 0x34, 0x4d, 0x81, 0xe1, 0x4c, 0xf1,
-	0xd2, 0x9e, 0x3d, 0x43, 0x3b, 0x69, 0xa0, 0xb8, 0x77, 0x89, 0x0a, 0x54, 0x86, 0x2a, 0x8e, 0x0c,
-	0x07, 0x39, 0x9c, 0xd0, 0xb8, 0xb4, 0x6f, 0x99, 0x7c, 0xa6, 0xde, 0xd3, 0xf8, 0x16, 0xbd, 0x7e,
-	0xbf, 0x5f, 0xa5, 0x9e, 0xd5, 0x3b, 0x74, 0xd2, 0xe9, 0xef, 0xd6, 0x76, 0x89, 0x22, 0x42, 0x7b,
-	0x4d, 0x54, 0x10, 0x64, 0x91, 0xac, 0x18, 0x4e, 0xa9, 0x64, 0x66, 0x6c, 0x6f, 0x91, 0x6f, 0x15,
-	0x96, 0xeb, 0x8a, 0xf3, 0xb9, 0xae, 0xe4, 0x66, 0x5c, 0x9a, 0xc6, 0x4d, 0xef, 0x58, 0x90, 0xc5,
-	0x40, 0xf1, 0xb6, 0x65, 0x5a, 0x93, 0xe5, 0xc6, 0x77, 0x57, 0x1b, 0xdf, 0xfd, 0xda, 0xf8, 0xee,
-	0xdb, 0xd6, 0x77, 0x56, 0x5b, 0xdf, 0xf9, 0xd8, 0xfa, 0x0e, 0x3a, 0xe7, 0xf6, 0xb1, 0xff, 0x84,
-	0xd5, 0x75, 0x1f, 0x9a, 0x8c, 0x9b, 0xf1, 0x6c, 0x14, 0x84, 0x20, 0x70, 0x06, 0x5d, 0x73, 0xf8,
-	0xd3, 0xe1, 0x45, 0xf6, 0x17, 0x26, 0x8e, 0xa8, 0x1e, 0xe5, 0x6c, 0x78, 0x8d, 0x9f, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0x5f, 0x78, 0x72, 0x38, 0xaf, 0x01, 0x00, 0x00,
+	// 355 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x91, 0xb1, 0x4e, 0xc2, 0x40,
+	0x18, 0xc7, 0x5b, 0x51, 0x12, 0x4e, 0xc0, 0xa4, 0x21, 0x5a, 0x19, 0x0a, 0xc1, 0x98, 0x10, 0x13,
+	0xdb, 0x20, 0x4c, 0x8e, 0x2c, 0x0e, 0x92, 0x40, 0x20, 0x2c, 0x2e, 0xe4, 0xa8, 0x67, 0x39, 0xe1,
+	0xfa, 0x35, 0x77, 0x47, 0xa5, 0xab, 0x4f, 0xe0, 0x3b, 0xf8, 0x02, 0x3e, 0x06, 0x23, 0xa3, 0x71,
+	0x20, 0x06, 0x06, 0x5f, 0xc3, 0xf4, 0x0a, 0xa9, 0x83, 0xdb, 0xf7, 0xfd, 0xfb, 0xfb, 0xff, 0xfb,
+	0xe5, 0x7f, 0xa8, 0x1e, 0x70, 0x08, 0x89, 0x8f, 0x7d, 0x97, 0x38, 0x8c, 0x48, 0xfc, 0x88, 0x25,
+	0x76, 0xc2, 0x86, 0x03, 0xe3, 0x67, 0xe2, 0x4a, 0x21, 0x81, 0x13, 0x3b, 0xe0, 0x20, 0xc1, 0x38,
+	0x4d, 0x49, 0x7b, 0x4f, 0xda, 0x61, 0xa3, 0x7c, 0xe6, 0x82, 0x60, 0x20, 0x1c, 0x26, 0xbc, 0xd8,
+	0xc8, 0x84, 0x97, 0x18, 0xca, 0x25, 0x0f, 0x3c, 0x50, 0xa3, 0x13, 0x4f, 0x89, 0x5a, 0x7b, 0xd7,
+	0x91, 0xd1, 0x55, 0xe1, 0x83, 0x38, 0xbc, 0x03, 0x2e, 0x96, 0xc0, 0x8d, 0x12, 0x3a, 0x82, 0x17,
+	0x9f, 0x70, 0x53, 0xaf, 0xea, 0xf5, 0x5c, 0x3f, 0x59, 0x8c, 0x0a, 0x3a, 0x9e, 0x25, 0xc0, 0x68,
+	0xce, 0xa9, 0x79, 0xa0, 0xbe, 0xa1, 0x9d, 0x34, 0xe4, 0xd4, 0xb8, 0x44, 0x45, 0xe2, 0xbb, 0x3c,
+	0x0a, 0x24, 0x05, 0x7f, 0x34, 0x25, 0x91, 0x99, 0x51, 0x4c, 0x21, 0x55, 0xef, 0x49, 0x64, 0x5c,
+	0xa0, 0xc2, 0x13, 0xa6, 0x33, 0x08, 0x89, 0x0a, 0x12, 0xe6, 0x61, 0x35, 0x53, 0xcf, 0xf5, 0xf3,
+	0x7b, 0x71, 0xc8, 0xa9, 0xb8, 0x45, 0xaf, 0x3f, 0x1f, 0x57, 0xc9, 0x8f, 0x6b, 0x77, 0xe8, 0xa4,
+	0x3b, 0xd8, 0xdd, 0xd6, 0xc3, 0x1c, 0x33, 0x61, 0xb4, 0x50, 0x91, 0xe1, 0x45, 0x6c, 0x1f, 0xcd,
+	0x88, 0xef, 0xc9, 0x89, 0x3a, 0xb5, 0xd0, 0x2e, 0x2e, 0xd7, 0x15, 0xed, 0x6b, 0x5d, 0xc9, 0xce,
+	0xa9, 0x2f, 0x9b, 0x37, 0xfd, 0x3c, 0xc3, 0x8b, 0x21, 0xa7, 0x1d, 0xc5, 0xb4, 0xa7, 0xcb, 0x8d,
+	0xa5, 0xaf, 0x36, 0x96, 0xfe, 0xbd, 0xb1, 0xf4, 0xb7, 0xad, 0xa5, 0xad, 0xb6, 0x96, 0xf6, 0xb9,
+	0xb5, 0x34, 0x74, 0x4e, 0x55, 0x25, 0xff, 0x54, 0xda, 0xd3, 0x1f, 0x5a, 0x1e, 0x95, 0x93, 0xf9,
+	0xd8, 0x76, 0x81, 0x39, 0x29, 0x74, 0x4d, 0xe1, 0xcf, 0xe6, 0x2c, 0xd2, 0x17, 0x93, 0x51, 0x40,
+	0xc4, 0x38, 0xab, 0x2a, 0x6e, 0xfe, 0x06, 0x00, 0x00, 0xff, 0xff, 0x54, 0xa6, 0x91, 0xa4, 0xd5,
+	0x01, 0x00, 0x00,
 }
 
 func (m *ObjectStoreLocator) Marshal() (dAtA []byte, err error) {
@@ -181,6 +192,15 @@ func (m *ObjectStoreLocator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.FailoverUris) > 0 {
+		for iNdEx := len(m.FailoverUris) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.FailoverUris[iNdEx])
+			copy(dAtA[i:], m.FailoverUris[iNdEx])
+			i = encodeVarintObjectstore(dAtA, i, uint64(len(m.FailoverUris[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.EncryptionKey) > 0 {
 		i -= len(m.EncryptionKey)
 		copy(dAtA[i:], m.EncryptionKey)
@@ -262,6 +282,12 @@ func (m *ObjectStoreLocator) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovObjectstore(uint64(l))
 	}
+	if len(m.FailoverUris) > 0 {
+		for _, s := range m.FailoverUris {
+			l = len(s)
+			n += 1 + l + sovObjectstore(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -408,6 +434,38 @@ func (m *ObjectStoreLocator) Unmarshal(dAtA []byte) error {
 			}
 			m.EncryptionKey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailoverUris", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObjectstore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthObjectstore
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthObjectstore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FailoverUris = append(m.FailoverUris, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipObjectstore(dAtA[iNdEx:])
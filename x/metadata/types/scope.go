@@ -3,10 +3,14 @@ package types
 import (
 	"errors"
 	"fmt"
+	"slices"
+	"strings"
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+
 	"github.com/provenance-io/provenance/internal/provutils"
 )
 
@@ -14,6 +18,8 @@ const (
 	// A sane default for maximum length of an audit message string (memo)
 	maxAuditMessageLength = 200
 	UsdDenom              = "usd"
+	// MaxScopeAccessChanges is the number of recent scope access changes that are kept in the index.
+	MaxScopeAccessChanges = 1000
 )
 
 // NewScope creates a new instance.
@@ -223,6 +229,9 @@ func (s Session) ValidateBasic() error {
 		return fmt.Errorf("session audit message exceeds maximum length (expected < %d got: %d)",
 			maxAuditMessageLength, len(s.Audit.Message))
 	}
+	if err = s.Status.Validate(); err != nil {
+		return fmt.Errorf("invalid session status: %w", err)
+	}
 	return nil
 }
 
@@ -231,6 +240,40 @@ func (s Session) GetAllPartyAddresses() []string {
 	return GetPartyAddresses(s.Parties)
 }
 
+// EffectiveStatus returns this session's status, treating an unspecified status as open.
+func (s Session) EffectiveStatus() SessionStatus {
+	if s.Status == SessionStatus_Unspecified {
+		return SessionStatus_Open
+	}
+	return s.Status
+}
+
+// IsOpen returns true if records can still be written to this session.
+func (s Session) IsOpen() bool {
+	return s.EffectiveStatus() == SessionStatus_Open
+}
+
+// Validate returns an error if this is not a known session status.
+func (s SessionStatus) Validate() error {
+	if _, known := SessionStatus_name[int32(s)]; !known {
+		return fmt.Errorf("unknown session status %d", s)
+	}
+	return nil
+}
+
+// SessionStatusFromString returns the SessionStatus with the provided name.
+// The name is case-insensitive, and the "SESSION_STATUS_" prefix is optional.
+func SessionStatusFromString(str string) (SessionStatus, error) {
+	name := strings.ToUpper(strings.TrimSpace(str))
+	if !strings.HasPrefix(name, "SESSION_STATUS_") {
+		name = "SESSION_STATUS_" + name
+	}
+	if val, found := SessionStatus_value[name]; found {
+		return SessionStatus(val), nil
+	}
+	return SessionStatus_Unspecified, fmt.Errorf("unknown session status %q", str)
+}
+
 // NewRecord creates new instance of Record
 func NewRecord(
 	name string,
@@ -338,6 +381,16 @@ func (ri RecordInput) ValidateBasic() error {
 		if prefix != PrefixRecord {
 			return fmt.Errorf("invalid record id address (found %s, expected record)", prefix)
 		}
+	case *RecordInput_IbcProof:
+		if ri.Status != RecordInputStatus_IBC {
+			return fmt.Errorf("ibc proof must be used with IBC type inputs")
+		}
+		if source.IbcProof == nil {
+			return fmt.Errorf("missing required ibc proof")
+		}
+		if err := source.IbcProof.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid record input ibc proof: %w", err)
+		}
 	}
 	if len(ri.TypeName) < 1 {
 		return fmt.Errorf("missing type name")
@@ -353,10 +406,46 @@ func (ri RecordInput) String() string {
 		out += source.Hash
 	case *RecordInput_RecordId:
 		out += source.RecordId.String()
+	case *RecordInput_IbcProof:
+		out += source.IbcProof.String()
 	}
 	return out
 }
 
+// ValidateBasic performs a static check over the ibc proof format.
+func (p IBCProof) ValidateBasic() error {
+	// The ibc errors include where they came from when formatted using %v or %w, so just their messages are used.
+	if err := host.ClientIdentifierValidator(p.ClientId); err != nil {
+		return fmt.Errorf("invalid client id: %s", err.Error())
+	}
+	if err := host.ConnectionIdentifierValidator(p.ConnectionId); err != nil {
+		return fmt.Errorf("invalid connection id: %s", err.Error())
+	}
+	if p.ProofHeight.IsZero() {
+		return fmt.Errorf("proof height cannot be zero")
+	}
+	if len(p.KeyPath) == 0 {
+		return fmt.Errorf("key path cannot be empty")
+	}
+	for i, key := range p.KeyPath {
+		if len(key) == 0 {
+			return fmt.Errorf("key path entry %d cannot be empty", i)
+		}
+	}
+	if len(p.Value) == 0 {
+		return fmt.Errorf("value cannot be empty")
+	}
+	if len(p.Proof) == 0 {
+		return fmt.Errorf("proof cannot be empty")
+	}
+	return nil
+}
+
+// String implements stringer interface
+func (p IBCProof) String() string {
+	return fmt.Sprintf("ibc:%s/%s@%s", p.ClientId, p.ConnectionId, p.ProofHeight)
+}
+
 // NewRecordOutput creates a new instance of RecordOutput
 func NewRecordOutput(hash string, status ResultStatus) *RecordOutput {
 	return &RecordOutput{
@@ -562,6 +651,35 @@ func GetRequiredPartyAddresses(parties []Party) []string {
 	return GetPartyAddresses(req)
 }
 
+// SubstituteParty returns a copy of the parties with the existing address replaced by the replacement address.
+// Each party keeps its role. If the replacement already has the same role, the two are combined into one party that is
+// only optional if both were. The second return value is false if none of the parties have the existing address.
+func SubstituteParty(parties []Party, existing, replacement string) ([]Party, bool) {
+	found := false
+	rv := make([]Party, 0, len(parties))
+	for _, party := range parties {
+		if party.Address == existing {
+			found = true
+			party.Address = replacement
+		}
+		combined := false
+		for i := range rv {
+			if rv[i].IsSameAs(&party) {
+				rv[i].Optional = rv[i].Optional && party.Optional
+				combined = true
+				break
+			}
+		}
+		if !combined {
+			rv = append(rv, party)
+		}
+	}
+	if !found {
+		return parties, false
+	}
+	return rv, true
+}
+
 // equivalentDataAssessors returns true if all the entries in s1 are in s2, and vice versa.
 func equivalentDataAssessors(s1, s2 []string) bool {
 s1Loop:
@@ -597,3 +715,102 @@ func NewNetAssetValue(price sdk.Coin, volume uint64) NetAssetValue {
 func (mnav *NetAssetValue) Validate() error {
 	return mnav.Price.Validate()
 }
+
+// NewScopeDataAccessChange creates a new ScopeAccessChange for addresses added to or removed from a scope's data access list.
+// The sequence and block info are filled in when it's added to the index.
+func NewScopeDataAccessChange(scopeID MetadataAddress, added, removed, signers []string) ScopeAccessChange {
+	return ScopeAccessChange{
+		ScopeId:    scopeID,
+		ChangeType: ScopeAccessChangeType_DataAccess,
+		Added:      added,
+		Removed:    removed,
+		Signers:    signers,
+	}
+}
+
+// NewScopeValueOwnerChange creates a new ScopeAccessChange for a change to a scope's value owner.
+// The sequence and block info are filled in when it's added to the index.
+func NewScopeValueOwnerChange(scopeID MetadataAddress, previousValueOwner, newValueOwner string, signers []string) ScopeAccessChange {
+	return ScopeAccessChange{
+		ScopeId:            scopeID,
+		ChangeType:         ScopeAccessChangeType_ValueOwner,
+		PreviousValueOwner: previousValueOwner,
+		NewValueOwner:      newValueOwner,
+		Signers:            signers,
+	}
+}
+
+// Validate returns an error if this ScopeAccessChange is not in a valid state.
+func (c ScopeAccessChange) Validate() error {
+	if c.Sequence == 0 {
+		return errors.New("invalid scope access change: sequence cannot be zero")
+	}
+	if err := c.ScopeId.ValidateIsScopeAddress(); err != nil {
+		return fmt.Errorf("invalid scope access change %d: %w", c.Sequence, err)
+	}
+	switch c.ChangeType {
+	case ScopeAccessChangeType_DataAccess:
+		if len(c.Added) == 0 && len(c.Removed) == 0 {
+			return fmt.Errorf("invalid scope access change %d: no data access addresses added or removed", c.Sequence)
+		}
+	case ScopeAccessChangeType_ValueOwner:
+		if c.PreviousValueOwner == c.NewValueOwner {
+			return fmt.Errorf("invalid scope access change %d: previous and new value owner are both %q", c.Sequence, c.NewValueOwner)
+		}
+	default:
+		return fmt.Errorf("invalid scope access change %d: unknown change type %s", c.Sequence, c.ChangeType)
+	}
+	return nil
+}
+
+// HoldReasonCodeScopeSettlement is the hold reason code for the funds held for a scope settlement.
+const HoldReasonCodeScopeSettlement = "metadata_scope_settlement"
+
+// NewScopeSettlement creates a new, unfunded, scope settlement.
+func NewScopeSettlement(scopeID MetadataAddress, seller, buyer string, price sdk.Coins) ScopeSettlement {
+	return ScopeSettlement{
+		ScopeId: scopeID,
+		Seller:  seller,
+		Buyer:   buyer,
+		Price:   price,
+	}
+}
+
+// Validate returns an error if this scope settlement is invalid.
+func (s ScopeSettlement) Validate() error {
+	if err := s.ScopeId.ValidateIsScopeAddress(); err != nil {
+		return fmt.Errorf("invalid scope settlement scope id: %w", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(s.Seller); err != nil {
+		return fmt.Errorf("invalid scope settlement seller %q: %w", s.Seller, err)
+	}
+	if _, err := sdk.AccAddressFromBech32(s.Buyer); err != nil {
+		return fmt.Errorf("invalid scope settlement buyer %q: %w", s.Buyer, err)
+	}
+	if s.Seller == s.Buyer {
+		return fmt.Errorf("invalid scope settlement: seller and buyer are both %s", s.Seller)
+	}
+	if err := s.Price.Validate(); err != nil {
+		return fmt.Errorf("invalid scope settlement price %q: %w", s.Price, err)
+	}
+	if s.Price.IsZero() {
+		return errors.New("invalid scope settlement price: cannot be zero")
+	}
+	return nil
+}
+
+// GetDataAccessChanges returns the entries in after that aren't in before (added),
+// and the entries in before that aren't in after (removed).
+func GetDataAccessChanges(before, after []string) (added, removed []string) {
+	for _, addr := range after {
+		if !slices.Contains(before, addr) && !slices.Contains(added, addr) {
+			added = append(added, addr)
+		}
+	}
+	for _, addr := range before {
+		if !slices.Contains(after, addr) && !slices.Contains(removed, addr) {
+			removed = append(removed, addr)
+		}
+	}
+	return added, removed
+}
@@ -5,10 +5,12 @@ package types
 
 import (
 	fmt "fmt"
-	types "github.com/cosmos/cosmos-sdk/types"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types1 "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	types "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
 	_ "google.golang.org/protobuf/types/descriptorpb"
 	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
@@ -31,6 +33,43 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// SessionStatus is the life cycle status of a session.
+type SessionStatus int32
+
+const (
+	// SESSION_STATUS_UNSPECIFIED is the status of sessions written before session statuses existed. They are treated as
+	// open.
+	SessionStatus_Unspecified SessionStatus = 0
+	// SESSION_STATUS_OPEN indicates records can still be written to the session.
+	SessionStatus_Open SessionStatus = 1
+	// SESSION_STATUS_COMPLETED indicates the session finished successfully. Its records can no longer be written.
+	SessionStatus_Completed SessionStatus = 2
+	// SESSION_STATUS_ABORTED indicates the session was abandoned. Its records can no longer be written.
+	SessionStatus_Aborted SessionStatus = 3
+)
+
+var SessionStatus_name = map[int32]string{
+	0: "SESSION_STATUS_UNSPECIFIED",
+	1: "SESSION_STATUS_OPEN",
+	2: "SESSION_STATUS_COMPLETED",
+	3: "SESSION_STATUS_ABORTED",
+}
+
+var SessionStatus_value = map[string]int32{
+	"SESSION_STATUS_UNSPECIFIED": 0,
+	"SESSION_STATUS_OPEN":        1,
+	"SESSION_STATUS_COMPLETED":   2,
+	"SESSION_STATUS_ABORTED":     3,
+}
+
+func (x SessionStatus) String() string {
+	return proto.EnumName(SessionStatus_name, int32(x))
+}
+
+func (SessionStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_edeea634bfb18aba, []int{0}
+}
+
 // A set of types for inputs on a record (of fact)
 type RecordInputStatus int32
 
@@ -41,18 +80,22 @@ const (
 	RecordInputStatus_Proposed RecordInputStatus = 1
 	// RECORD_INPUT_STATUS_RECORD indicates this input is a reference to a previously recorded fact on blockchain
 	RecordInputStatus_Record RecordInputStatus = 2
+	// RECORD_INPUT_STATUS_IBC indicates this input is a piece of data committed on another chain, verified through IBC
+	RecordInputStatus_IBC RecordInputStatus = 3
 )
 
 var RecordInputStatus_name = map[int32]string{
 	0: "RECORD_INPUT_STATUS_UNSPECIFIED",
 	1: "RECORD_INPUT_STATUS_PROPOSED",
 	2: "RECORD_INPUT_STATUS_RECORD",
+	3: "RECORD_INPUT_STATUS_IBC",
 }
 
 var RecordInputStatus_value = map[string]int32{
 	"RECORD_INPUT_STATUS_UNSPECIFIED": 0,
 	"RECORD_INPUT_STATUS_PROPOSED":    1,
 	"RECORD_INPUT_STATUS_RECORD":      2,
+	"RECORD_INPUT_STATUS_IBC":         3,
 }
 
 func (x RecordInputStatus) String() string {
@@ -60,7 +103,7 @@ func (x RecordInputStatus) String() string {
 }
 
 func (RecordInputStatus) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_edeea634bfb18aba, []int{0}
+	return fileDescriptor_edeea634bfb18aba, []int{1}
 }
 
 // ResultStatus indicates the various states of execution of a record
@@ -96,7 +139,39 @@ func (x ResultStatus) String() string {
 }
 
 func (ResultStatus) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_edeea634bfb18aba, []int{1}
+	return fileDescriptor_edeea634bfb18aba, []int{2}
+}
+
+// ScopeAccessChangeType indicates which part of a scope a ScopeAccessChange is about.
+type ScopeAccessChangeType int32
+
+const (
+	// SCOPE_ACCESS_CHANGE_TYPE_UNSPECIFIED indicates an invalid/unknown change type.
+	ScopeAccessChangeType_Unspecified ScopeAccessChangeType = 0
+	// SCOPE_ACCESS_CHANGE_TYPE_DATA_ACCESS indicates addresses were added to or removed from a scope's data access list.
+	ScopeAccessChangeType_DataAccess ScopeAccessChangeType = 1
+	// SCOPE_ACCESS_CHANGE_TYPE_VALUE_OWNER indicates a scope's value owner changed.
+	ScopeAccessChangeType_ValueOwner ScopeAccessChangeType = 2
+)
+
+var ScopeAccessChangeType_name = map[int32]string{
+	0: "SCOPE_ACCESS_CHANGE_TYPE_UNSPECIFIED",
+	1: "SCOPE_ACCESS_CHANGE_TYPE_DATA_ACCESS",
+	2: "SCOPE_ACCESS_CHANGE_TYPE_VALUE_OWNER",
+}
+
+var ScopeAccessChangeType_value = map[string]int32{
+	"SCOPE_ACCESS_CHANGE_TYPE_UNSPECIFIED": 0,
+	"SCOPE_ACCESS_CHANGE_TYPE_DATA_ACCESS": 1,
+	"SCOPE_ACCESS_CHANGE_TYPE_VALUE_OWNER": 2,
+}
+
+func (x ScopeAccessChangeType) String() string {
+	return proto.EnumName(ScopeAccessChangeType_name, int32(x))
+}
+
+func (ScopeAccessChangeType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_edeea634bfb18aba, []int{3}
 }
 
 // Scope defines a root reference for a collection of records owned by one or more parties.
@@ -204,6 +279,9 @@ type Session struct {
 	Name string `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
 	// context is a field for storing client specific data associated with a session.
 	Context []byte `protobuf:"bytes,5,opt,name=context,proto3" json:"context,omitempty"`
+	// status is where this session is in its life cycle. Records can only be written to open sessions.
+	// Sessions written before this field existed have an unspecified status, and are treated as open.
+	Status SessionStatus `protobuf:"varint,6,opt,name=status,proto3,enum=provenance.metadata.v1.SessionStatus" json:"status,omitempty"`
 	// Created by, updated by, timestamps, version number, and related info.
 	Audit *AuditFields `protobuf:"bytes,99,opt,name=audit,proto3" json:"audit,omitempty"`
 }
@@ -261,6 +339,13 @@ func (m *Session) GetContext() []byte {
 	return nil
 }
 
+func (m *Session) GetStatus() SessionStatus {
+	if m != nil {
+		return m.Status
+	}
+	return SessionStatus_Unspecified
+}
+
 func (m *Session) GetAudit() *AuditFields {
 	if m != nil {
 		return m.Audit
@@ -458,6 +543,7 @@ type RecordInput struct {
 	// Types that are valid to be assigned to Source:
 	//	*RecordInput_RecordId
 	//	*RecordInput_Hash
+	//	*RecordInput_IbcProof
 	Source isRecordInput_Source `protobuf_oneof:"source"`
 	// from proposed fact structure to unmarshal
 	TypeName string `protobuf:"bytes,4,opt,name=type_name,json=typeName,proto3" json:"type_name,omitempty"`
@@ -509,9 +595,13 @@ type RecordInput_RecordId struct {
 type RecordInput_Hash struct {
 	Hash string `protobuf:"bytes,3,opt,name=hash,proto3,oneof" json:"hash,omitempty"`
 }
+type RecordInput_IbcProof struct {
+	IbcProof *IBCProof `protobuf:"bytes,6,opt,name=ibc_proof,json=ibcProof,proto3,oneof" json:"ibc_proof,omitempty"`
+}
 
 func (*RecordInput_RecordId) isRecordInput_Source() {}
 func (*RecordInput_Hash) isRecordInput_Source()     {}
+func (*RecordInput_IbcProof) isRecordInput_Source() {}
 
 func (m *RecordInput) GetSource() isRecordInput_Source {
 	if m != nil {
@@ -534,6 +624,13 @@ func (m *RecordInput) GetHash() string {
 	return ""
 }
 
+func (m *RecordInput) GetIbcProof() *IBCProof {
+	if x, ok := m.GetSource().(*RecordInput_IbcProof); ok {
+		return x.IbcProof
+	}
+	return nil
+}
+
 func (m *RecordInput) GetTypeName() string {
 	if m != nil {
 		return m.TypeName
@@ -553,7 +650,99 @@ func (*RecordInput) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
 		(*RecordInput_RecordId)(nil),
 		(*RecordInput_Hash)(nil),
+		(*RecordInput_IbcProof)(nil),
+	}
+}
+
+// IBCProof is a proof that a piece of data is committed in the state of another chain connected through IBC.
+// It is verified against the local IBC client of that chain when the record is written.
+type IBCProof struct {
+	// client_id is the local IBC client that tracks the other chain.
+	ClientId string `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// connection_id is the local IBC connection to the other chain. It must be open and use the client.
+	ConnectionId string `protobuf:"bytes,2,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	// proof_height is the height of the other chain that the proof is for.
+	ProofHeight types.Height `protobuf:"bytes,3,opt,name=proof_height,json=proofHeight,proto3" json:"proof_height"`
+	// key_path is the commitment path of the data in the other chain's state, starting with the store key.
+	KeyPath [][]byte `protobuf:"bytes,4,rep,name=key_path,json=keyPath,proto3" json:"key_path,omitempty"`
+	// value is the data committed at the key path.
+	Value []byte `protobuf:"bytes,5,opt,name=value,proto3" json:"value,omitempty"`
+	// proof is the merkle proof of the value at the key path.
+	Proof []byte `protobuf:"bytes,6,opt,name=proof,proto3" json:"proof,omitempty"`
+}
+
+func (m *IBCProof) Reset()      { *m = IBCProof{} }
+func (*IBCProof) ProtoMessage() {}
+func (*IBCProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_edeea634bfb18aba, []int{5}
+}
+func (m *IBCProof) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IBCProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IBCProof.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IBCProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IBCProof.Merge(m, src)
+}
+func (m *IBCProof) XXX_Size() int {
+	return m.Size()
+}
+func (m *IBCProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_IBCProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IBCProof proto.InternalMessageInfo
+
+func (m *IBCProof) GetClientId() string {
+	if m != nil {
+		return m.ClientId
+	}
+	return ""
+}
+
+func (m *IBCProof) GetConnectionId() string {
+	if m != nil {
+		return m.ConnectionId
+	}
+	return ""
+}
+
+func (m *IBCProof) GetProofHeight() types.Height {
+	if m != nil {
+		return m.ProofHeight
+	}
+	return types.Height{}
+}
+
+func (m *IBCProof) GetKeyPath() [][]byte {
+	if m != nil {
+		return m.KeyPath
+	}
+	return nil
+}
+
+func (m *IBCProof) GetValue() []byte {
+	if m != nil {
+		return m.Value
 	}
+	return nil
+}
+
+func (m *IBCProof) GetProof() []byte {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
 }
 
 // RecordOutput encapsulates the output of a process recorded on chain
@@ -567,7 +756,7 @@ type RecordOutput struct {
 func (m *RecordOutput) Reset()      { *m = RecordOutput{} }
 func (*RecordOutput) ProtoMessage() {}
 func (*RecordOutput) Descriptor() ([]byte, []int) {
-	return fileDescriptor_edeea634bfb18aba, []int{5}
+	return fileDescriptor_edeea634bfb18aba, []int{6}
 }
 func (m *RecordOutput) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -623,7 +812,7 @@ type Party struct {
 func (m *Party) Reset()      { *m = Party{} }
 func (*Party) ProtoMessage() {}
 func (*Party) Descriptor() ([]byte, []int) {
-	return fileDescriptor_edeea634bfb18aba, []int{6}
+	return fileDescriptor_edeea634bfb18aba, []int{7}
 }
 func (m *Party) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -693,7 +882,7 @@ func (m *AuditFields) Reset()         { *m = AuditFields{} }
 func (m *AuditFields) String() string { return proto.CompactTextString(m) }
 func (*AuditFields) ProtoMessage()    {}
 func (*AuditFields) Descriptor() ([]byte, []int) {
-	return fileDescriptor_edeea634bfb18aba, []int{7}
+	return fileDescriptor_edeea634bfb18aba, []int{8}
 }
 func (m *AuditFields) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -767,7 +956,7 @@ func (m *AuditFields) GetMessage() string {
 // NetAssetValue defines a scope's net asset value
 type NetAssetValue struct {
 	// price is the complete value of the asset's volume
-	Price types.Coin `protobuf:"bytes,1,opt,name=price,proto3" json:"price"`
+	Price types1.Coin `protobuf:"bytes,1,opt,name=price,proto3" json:"price"`
 	// updated_block_height is the block height of last update
 	UpdatedBlockHeight uint64 `protobuf:"varint,2,opt,name=updated_block_height,json=updatedBlockHeight,proto3" json:"updated_block_height,omitempty"`
 	// volume is the number of scope instances that were purchased for the price
@@ -780,7 +969,7 @@ func (m *NetAssetValue) Reset()         { *m = NetAssetValue{} }
 func (m *NetAssetValue) String() string { return proto.CompactTextString(m) }
 func (*NetAssetValue) ProtoMessage()    {}
 func (*NetAssetValue) Descriptor() ([]byte, []int) {
-	return fileDescriptor_edeea634bfb18aba, []int{8}
+	return fileDescriptor_edeea634bfb18aba, []int{9}
 }
 func (m *NetAssetValue) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -809,11 +998,11 @@ func (m *NetAssetValue) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_NetAssetValue proto.InternalMessageInfo
 
-func (m *NetAssetValue) GetPrice() types.Coin {
+func (m *NetAssetValue) GetPrice() types1.Coin {
 	if m != nil {
 		return m.Price
 	}
-	return types.Coin{}
+	return types1.Coin{}
 }
 
 func (m *NetAssetValue) GetUpdatedBlockHeight() uint64 {
@@ -830,18 +1019,220 @@ func (m *NetAssetValue) GetVolume() uint64 {
 	return 0
 }
 
+// ScopeAccessChange is an entry in the index of recent changes to the data access lists and value owners of scopes.
+type ScopeAccessChange struct {
+	// sequence is the position of this change in the index. It is one more than the sequence of the previous change.
+	Sequence uint64 `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// scope_id is the scope that was changed.
+	ScopeId MetadataAddress `protobuf:"bytes,2,opt,name=scope_id,json=scopeId,proto3,customtype=MetadataAddress" json:"scope_id"`
+	// change_type is the part of the scope that was changed.
+	ChangeType ScopeAccessChangeType `protobuf:"varint,3,opt,name=change_type,json=changeType,proto3,enum=provenance.metadata.v1.ScopeAccessChangeType" json:"change_type,omitempty"`
+	// added are the addresses that were added to the scope's data access list.
+	Added []string `protobuf:"bytes,4,rep,name=added,proto3" json:"added,omitempty"`
+	// removed are the addresses that were removed from the scope's data access list.
+	Removed []string `protobuf:"bytes,5,rep,name=removed,proto3" json:"removed,omitempty"`
+	// previous_value_owner is the value owner the scope had before the change. It is empty if there wasn't one.
+	PreviousValueOwner string `protobuf:"bytes,6,opt,name=previous_value_owner,json=previousValueOwner,proto3" json:"previous_value_owner,omitempty"`
+	// new_value_owner is the value owner the scope has after the change. It is empty if the scope was deleted.
+	NewValueOwner string `protobuf:"bytes,7,opt,name=new_value_owner,json=newValueOwner,proto3" json:"new_value_owner,omitempty"`
+	// signers are the bech32 address strings of the signers of the TX that made the change.
+	Signers []string `protobuf:"bytes,8,rep,name=signers,proto3" json:"signers,omitempty"`
+	// block_height is the height of the block in which the change was made.
+	BlockHeight int64 `protobuf:"varint,9,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// block_time is the time of the block in which the change was made.
+	BlockTime time.Time `protobuf:"bytes,10,opt,name=block_time,json=blockTime,proto3,stdtime" json:"block_time"`
+}
+
+func (m *ScopeAccessChange) Reset()         { *m = ScopeAccessChange{} }
+func (m *ScopeAccessChange) String() string { return proto.CompactTextString(m) }
+func (*ScopeAccessChange) ProtoMessage()    {}
+func (*ScopeAccessChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_edeea634bfb18aba, []int{10}
+}
+func (m *ScopeAccessChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScopeAccessChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScopeAccessChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScopeAccessChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScopeAccessChange.Merge(m, src)
+}
+func (m *ScopeAccessChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScopeAccessChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScopeAccessChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScopeAccessChange proto.InternalMessageInfo
+
+func (m *ScopeAccessChange) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *ScopeAccessChange) GetChangeType() ScopeAccessChangeType {
+	if m != nil {
+		return m.ChangeType
+	}
+	return ScopeAccessChangeType_Unspecified
+}
+
+func (m *ScopeAccessChange) GetAdded() []string {
+	if m != nil {
+		return m.Added
+	}
+	return nil
+}
+
+func (m *ScopeAccessChange) GetRemoved() []string {
+	if m != nil {
+		return m.Removed
+	}
+	return nil
+}
+
+func (m *ScopeAccessChange) GetPreviousValueOwner() string {
+	if m != nil {
+		return m.PreviousValueOwner
+	}
+	return ""
+}
+
+func (m *ScopeAccessChange) GetNewValueOwner() string {
+	if m != nil {
+		return m.NewValueOwner
+	}
+	return ""
+}
+
+func (m *ScopeAccessChange) GetSigners() []string {
+	if m != nil {
+		return m.Signers
+	}
+	return nil
+}
+
+func (m *ScopeAccessChange) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *ScopeAccessChange) GetBlockTime() time.Time {
+	if m != nil {
+		return m.BlockTime
+	}
+	return time.Time{}
+}
+
+// ScopeSettlement is a pending sale of a scope's value ownership.
+// While it exists, the scope's value owner coin is on hold in the seller's account.
+// Once the buyer funds it, the price is also on hold in the buyer's account, and either side can settle it.
+type ScopeSettlement struct {
+	// scope_id is the scope being sold.
+	ScopeId MetadataAddress `protobuf:"bytes,1,opt,name=scope_id,json=scopeId,proto3,customtype=MetadataAddress" json:"scope_id"`
+	// seller is the bech32 address string of the scope's value owner.
+	Seller string `protobuf:"bytes,2,opt,name=seller,proto3" json:"seller,omitempty"`
+	// buyer is the bech32 address string of the account that will become the scope's value owner.
+	Buyer string `protobuf:"bytes,3,opt,name=buyer,proto3" json:"buyer,omitempty"`
+	// price is the funds the buyer will pay the seller.
+	Price github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=price,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"price"`
+	// funded is true once the buyer has put the price on hold.
+	Funded bool `protobuf:"varint,5,opt,name=funded,proto3" json:"funded,omitempty"`
+}
+
+func (m *ScopeSettlement) Reset()         { *m = ScopeSettlement{} }
+func (m *ScopeSettlement) String() string { return proto.CompactTextString(m) }
+func (*ScopeSettlement) ProtoMessage()    {}
+func (*ScopeSettlement) Descriptor() ([]byte, []int) {
+	return fileDescriptor_edeea634bfb18aba, []int{11}
+}
+func (m *ScopeSettlement) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScopeSettlement) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScopeSettlement.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScopeSettlement) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScopeSettlement.Merge(m, src)
+}
+func (m *ScopeSettlement) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScopeSettlement) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScopeSettlement.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScopeSettlement proto.InternalMessageInfo
+
+func (m *ScopeSettlement) GetSeller() string {
+	if m != nil {
+		return m.Seller
+	}
+	return ""
+}
+
+func (m *ScopeSettlement) GetBuyer() string {
+	if m != nil {
+		return m.Buyer
+	}
+	return ""
+}
+
+func (m *ScopeSettlement) GetPrice() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Price
+	}
+	return nil
+}
+
+func (m *ScopeSettlement) GetFunded() bool {
+	if m != nil {
+		return m.Funded
+	}
+	return false
+}
+
 func init() {
+	proto.RegisterEnum("provenance.metadata.v1.SessionStatus", SessionStatus_name, SessionStatus_value)
 	proto.RegisterEnum("provenance.metadata.v1.RecordInputStatus", RecordInputStatus_name, RecordInputStatus_value)
 	proto.RegisterEnum("provenance.metadata.v1.ResultStatus", ResultStatus_name, ResultStatus_value)
+	proto.RegisterEnum("provenance.metadata.v1.ScopeAccessChangeType", ScopeAccessChangeType_name, ScopeAccessChangeType_value)
 	proto.RegisterType((*Scope)(nil), "provenance.metadata.v1.Scope")
 	proto.RegisterType((*Session)(nil), "provenance.metadata.v1.Session")
 	proto.RegisterType((*Record)(nil), "provenance.metadata.v1.Record")
 	proto.RegisterType((*Process)(nil), "provenance.metadata.v1.Process")
 	proto.RegisterType((*RecordInput)(nil), "provenance.metadata.v1.RecordInput")
+	proto.RegisterType((*IBCProof)(nil), "provenance.metadata.v1.IBCProof")
 	proto.RegisterType((*RecordOutput)(nil), "provenance.metadata.v1.RecordOutput")
 	proto.RegisterType((*Party)(nil), "provenance.metadata.v1.Party")
 	proto.RegisterType((*AuditFields)(nil), "provenance.metadata.v1.AuditFields")
 	proto.RegisterType((*NetAssetValue)(nil), "provenance.metadata.v1.NetAssetValue")
+	proto.RegisterType((*ScopeAccessChange)(nil), "provenance.metadata.v1.ScopeAccessChange")
+	proto.RegisterType((*ScopeSettlement)(nil), "provenance.metadata.v1.ScopeSettlement")
 }
 
 func init() {
@@ -849,79 +1240,116 @@ func init() {
 }
 
 var fileDescriptor_edeea634bfb18aba = []byte{
-	// 1138 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x4f, 0x6f, 0x1b, 0x45,
-	0x14, 0xf7, 0xfa, 0xbf, 0x9f, 0x5d, 0xea, 0x4e, 0xab, 0xe2, 0x1a, 0x6a, 0x1b, 0xc3, 0xc1, 0x44,
-	0x62, 0xdd, 0x18, 0x8a, 0x44, 0x01, 0x21, 0xbb, 0x49, 0xa9, 0x45, 0x49, 0xac, 0x71, 0xc2, 0x81,
-	0xcb, 0x6a, 0xbd, 0x3b, 0xb5, 0x57, 0xb1, 0x77, 0x96, 0x9d, 0x59, 0xb7, 0x86, 0x0b, 0xe7, 0x9c,
-	0xc2, 0x8d, 0x4b, 0x24, 0xf8, 0x14, 0x7c, 0x85, 0x72, 0xeb, 0x11, 0x01, 0x2a, 0x28, 0xb9, 0xf2,
-	0x21, 0xd0, 0xcc, 0xce, 0xfa, 0x0f, 0x71, 0xa2, 0x44, 0xe2, 0xb6, 0xef, 0xbd, 0xdf, 0x9b, 0xf7,
-	0xde, 0xef, 0xbd, 0x37, 0xb3, 0x50, 0xf7, 0x7c, 0x3a, 0x25, 0xae, 0xe9, 0x5a, 0xa4, 0x39, 0x21,
-	0xdc, 0xb4, 0x4d, 0x6e, 0x36, 0xa7, 0x9b, 0x4d, 0x66, 0x51, 0x8f, 0xe8, 0x9e, 0x4f, 0x39, 0x45,
-	0xb7, 0x17, 0x18, 0x3d, 0xc2, 0xe8, 0xd3, 0xcd, 0x72, 0xc5, 0xa2, 0x6c, 0x42, 0x59, 0x73, 0x60,
-	0x32, 0xd2, 0x9c, 0x6e, 0x0e, 0x08, 0x37, 0x37, 0x9b, 0x16, 0x75, 0xdc, 0xd0, 0xaf, 0x7c, 0x6b,
-	0x48, 0x87, 0x54, 0x7e, 0x36, 0xc5, 0x97, 0xd2, 0x56, 0x87, 0x94, 0x0e, 0xc7, 0xa4, 0x29, 0xa5,
-	0x41, 0xf0, 0xb4, 0xc9, 0x9d, 0x09, 0x61, 0xdc, 0x9c, 0x78, 0x0a, 0x50, 0xfb, 0x2f, 0xc0, 0x26,
-	0xcc, 0xf2, 0x1d, 0x8f, 0x53, 0x5f, 0x21, 0x36, 0xce, 0x4b, 0xda, 0x23, 0x96, 0xf3, 0xd4, 0xb1,
-	0x4c, 0xee, 0x50, 0x95, 0x44, 0xfd, 0xd7, 0x38, 0xa4, 0xfa, 0xa2, 0x18, 0xd4, 0x82, 0xac, 0xac,
-	0xca, 0x70, 0xec, 0x92, 0x56, 0xd3, 0x1a, 0x85, 0xce, 0xeb, 0x2f, 0x5e, 0x55, 0x63, 0xbf, 0xbf,
-	0xaa, 0x5e, 0xff, 0x52, 0x1d, 0xd2, 0xb6, 0x6d, 0x9f, 0x30, 0x86, 0x33, 0x12, 0xd8, 0xb5, 0x51,
-	0x07, 0x8a, 0x2b, 0x87, 0x0a, 0xdf, 0xf8, 0xc5, 0xbe, 0xd7, 0x57, 0x1c, 0xba, 0x36, 0xfa, 0x18,
-	0xd2, 0xf4, 0x99, 0x4b, 0x7c, 0x56, 0x4a, 0xd4, 0x12, 0x8d, 0x7c, 0xeb, 0xae, 0xbe, 0x9e, 0x4f,
-	0xbd, 0x67, 0xfa, 0x7c, 0xd6, 0x49, 0x8a, 0x83, 0xb1, 0x72, 0x41, 0x55, 0xc8, 0x0b, 0xb3, 0x61,
-	0x5a, 0x16, 0x61, 0xac, 0x94, 0xac, 0x25, 0x1a, 0x39, 0x0c, 0x32, 0x9e, 0xd4, 0x20, 0x1d, 0x6e,
-	0x4e, 0xcd, 0x71, 0x40, 0x0c, 0xe9, 0x60, 0x98, 0x61, 0x16, 0xa5, 0x54, 0x4d, 0x6b, 0xe4, 0xf0,
-	0x0d, 0x69, 0xda, 0x15, 0x16, 0x95, 0x1e, 0xba, 0x07, 0xb7, 0x7c, 0xf2, 0x4d, 0xe0, 0xf8, 0xc4,
-	0xf0, 0x44, 0x3c, 0xc3, 0xa7, 0xe3, 0x71, 0xe0, 0x95, 0xd2, 0x35, 0xad, 0x91, 0xc5, 0x48, 0xd9,
-	0x64, 0x2a, 0x58, 0x5a, 0x1e, 0x64, 0x7f, 0xfc, 0xa9, 0x1a, 0xfb, 0xfe, 0xcf, 0x9a, 0x56, 0xff,
-	0x25, 0x0e, 0x99, 0x3e, 0x61, 0xcc, 0xa1, 0x2e, 0xfa, 0x10, 0x80, 0x85, 0x9f, 0x97, 0xe0, 0x33,
-	0xa7, 0xa0, 0xff, 0x13, 0xa3, 0x9f, 0x42, 0x46, 0xe4, 0xee, 0x90, 0x2b, 0x51, 0x1a, 0xf9, 0x20,
-	0x04, 0x49, 0xd7, 0x9c, 0x90, 0x52, 0x52, 0x72, 0x24, 0xbf, 0x51, 0x09, 0x32, 0x16, 0x75, 0x39,
-	0x79, 0xce, 0x25, 0x75, 0x05, 0x1c, 0x89, 0xe8, 0x23, 0x48, 0x99, 0x81, 0xed, 0xf0, 0x92, 0x55,
-	0xd3, 0x1a, 0xf9, 0xd6, 0xdb, 0xe7, 0x85, 0x6a, 0x0b, 0xd0, 0x23, 0x87, 0x8c, 0x6d, 0x86, 0x43,
-	0x8f, 0x25, 0xe6, 0xfe, 0x89, 0x43, 0x1a, 0x13, 0x8b, 0xfa, 0xf6, 0x3c, 0xba, 0xb6, 0x14, 0x7d,
-	0x95, 0xcc, 0xf8, 0xa5, 0xc9, 0xfc, 0x0c, 0x32, 0x9e, 0x4f, 0xe5, 0x64, 0x24, 0x64, 0x76, 0xd5,
-	0x73, 0x89, 0x08, 0x61, 0x73, 0x2a, 0x42, 0x11, 0xb5, 0x21, 0xed, 0xb8, 0x5e, 0xc0, 0xc3, 0xc9,
-	0xba, 0xa0, 0xba, 0x30, 0xf9, 0xae, 0xc0, 0x46, 0x13, 0x1a, 0x3a, 0xa2, 0x2d, 0xc8, 0xd0, 0x80,
-	0xcb, 0x33, 0x52, 0xf2, 0x8c, 0x77, 0x2e, 0x3e, 0x63, 0x57, 0x82, 0xa3, 0x44, 0x94, 0xeb, 0xda,
-	0xb1, 0x48, 0x5f, 0x6d, 0x2c, 0x96, 0xe8, 0xfe, 0x0e, 0x32, 0xaa, 0x60, 0x54, 0x86, 0x4c, 0xb4,
-	0x13, 0x92, 0xf1, 0xc7, 0x31, 0x1c, 0x29, 0xd0, 0x2d, 0x48, 0x8e, 0x4c, 0x36, 0x92, 0x84, 0x0b,
-	0x83, 0x94, 0xe6, 0x0d, 0x4a, 0x2c, 0x35, 0xe8, 0x36, 0xa4, 0x27, 0x84, 0x8f, 0xa8, 0xad, 0x86,
-	0x46, 0x49, 0x0f, 0x92, 0x22, 0x64, 0xa7, 0x00, 0xa0, 0x08, 0x35, 0x1c, 0xbb, 0xfe, 0x87, 0x06,
-	0xf9, 0x25, 0xba, 0xd6, 0x36, 0xbc, 0x05, 0x39, 0x5f, 0x42, 0x16, 0xfd, 0xbe, 0xb9, 0xa6, 0xc6,
-	0xc7, 0x31, 0x9c, 0x0d, 0x71, 0x5d, 0x7b, 0x9e, 0x6d, 0x62, 0x25, 0xdb, 0x37, 0x20, 0xc7, 0x67,
-	0x1e, 0x31, 0x96, 0x26, 0x3a, 0x2b, 0x14, 0x3b, 0x22, 0x4c, 0x1b, 0xd2, 0x8c, 0x9b, 0x3c, 0x08,
-	0xef, 0x83, 0xd7, 0x5a, 0xef, 0x5e, 0xa2, 0xbd, 0x7d, 0xe9, 0x80, 0x95, 0xa3, 0xaa, 0x30, 0x0b,
-	0x69, 0x46, 0x03, 0xdf, 0x22, 0xf5, 0xa7, 0x50, 0x58, 0xee, 0xa3, 0xa8, 0x4e, 0x66, 0xa5, 0xaa,
-	0x93, 0x39, 0x7d, 0x32, 0x0f, 0x1b, 0x97, 0x61, 0x2f, 0x98, 0x08, 0x16, 0x8c, 0xd7, 0x46, 0xac,
-	0x7f, 0x0b, 0x29, 0xb9, 0xbc, 0x62, 0x33, 0x57, 0x1a, 0xb8, 0x68, 0xdf, 0x7d, 0x48, 0xfa, 0x74,
-	0x4c, 0x54, 0x90, 0xb7, 0x2e, 0xbc, 0x03, 0xf6, 0x66, 0x1e, 0xc1, 0x12, 0x8e, 0xca, 0x90, 0xa5,
-	0x9e, 0x18, 0x19, 0x73, 0x2c, 0xb9, 0xcc, 0xe2, 0xb9, 0xac, 0x62, 0xff, 0x10, 0x87, 0xfc, 0xd2,
-	0x3a, 0xa3, 0xcf, 0xa1, 0x60, 0xf9, 0xc4, 0xe4, 0xc4, 0x36, 0x6c, 0x93, 0x87, 0x9d, 0xcc, 0xb7,
-	0xca, 0x7a, 0xf8, 0x50, 0xe9, 0xd1, 0x43, 0xa5, 0xef, 0x45, 0x2f, 0x59, 0x27, 0x2b, 0x86, 0xf6,
-	0xe8, 0xaf, 0xaa, 0x86, 0xf3, 0xca, 0x73, 0xcb, 0xe4, 0x04, 0xdd, 0x05, 0x88, 0x0e, 0x1a, 0xcc,
-	0xc2, 0xb1, 0xc3, 0x39, 0xa5, 0xe9, 0xcc, 0x44, 0x9c, 0xc0, 0xb3, 0x17, 0x71, 0x12, 0x57, 0x89,
-	0xa3, 0x3c, 0xa3, 0x38, 0xd1, 0x41, 0x83, 0x99, 0x9a, 0x8a, 0x9c, 0xd2, 0x74, 0x24, 0xa5, 0x53,
-	0xe2, 0x8b, 0x3b, 0x44, 0xce, 0xc5, 0x35, 0x1c, 0x89, 0xc2, 0x32, 0x21, 0x8c, 0x99, 0x43, 0x22,
-	0xb7, 0x2f, 0x87, 0x23, 0xb1, 0x7e, 0xa4, 0xc1, 0xb5, 0x1d, 0xc2, 0xdb, 0x8c, 0x11, 0xfe, 0x95,
-	0x78, 0x55, 0xd0, 0x7d, 0x48, 0x79, 0xbe, 0x63, 0x45, 0x74, 0xdc, 0xd1, 0xc3, 0xdf, 0x01, 0x5d,
-	0xfc, 0x0e, 0xe8, 0xea, 0x77, 0x40, 0x7f, 0x48, 0x1d, 0x57, 0xed, 0x7a, 0x88, 0x16, 0x0f, 0xd0,
-	0x3c, 0xb7, 0x31, 0xb5, 0x0e, 0x8c, 0x11, 0x71, 0x86, 0x23, 0x2e, 0xd9, 0x48, 0x62, 0x14, 0x65,
-	0x29, 0x4c, 0x8f, 0xa5, 0x45, 0x2c, 0xdf, 0x94, 0x8e, 0x03, 0xb5, 0x92, 0x49, 0xac, 0xa4, 0x8d,
-	0x9f, 0x35, 0xb8, 0x71, 0x66, 0x70, 0xd1, 0x3d, 0xa8, 0xe2, 0xed, 0x87, 0xbb, 0x78, 0xcb, 0xe8,
-	0xee, 0xf4, 0xf6, 0xf7, 0x8c, 0xfe, 0x5e, 0x7b, 0x6f, 0xbf, 0x6f, 0xec, 0xef, 0xf4, 0x7b, 0xdb,
-	0x0f, 0xbb, 0x8f, 0xba, 0xdb, 0x5b, 0xc5, 0x58, 0x39, 0x7f, 0x78, 0x5c, 0xcb, 0xec, 0xbb, 0x07,
-	0x2e, 0x7d, 0xe6, 0x22, 0x1d, 0xde, 0x5c, 0xe7, 0xd1, 0xc3, 0xbb, 0xbd, 0xdd, 0xfe, 0xf6, 0x56,
-	0x51, 0x2b, 0x17, 0x0e, 0x8f, 0x6b, 0xd9, 0x9e, 0x4f, 0x3d, 0xca, 0x88, 0x8d, 0x36, 0xa0, 0xbc,
-	0x0e, 0x1f, 0xea, 0x8a, 0xf1, 0x32, 0x1c, 0x1e, 0xd7, 0xd4, 0x6d, 0xbf, 0x11, 0x88, 0x75, 0x59,
-	0x0c, 0x39, 0xba, 0x0b, 0x77, 0xf0, 0x76, 0x7f, 0xff, 0xc9, 0xfa, 0xbc, 0xd0, 0x6d, 0x40, 0xab,
-	0xe6, 0x5e, 0xbb, 0xdf, 0x2f, 0x6a, 0x67, 0xf5, 0xfd, 0x2f, 0xba, 0xbd, 0x62, 0xfc, 0xac, 0xfe,
-	0x51, 0xbb, 0xfb, 0xa4, 0x98, 0xe8, 0x1c, 0xbc, 0x38, 0xa9, 0x68, 0x2f, 0x4f, 0x2a, 0xda, 0xdf,
-	0x27, 0x15, 0xed, 0xe8, 0xb4, 0x12, 0x7b, 0x79, 0x5a, 0x89, 0xfd, 0x76, 0x5a, 0x89, 0xc1, 0x1d,
-	0x87, 0x9e, 0xb3, 0x28, 0x3d, 0xed, 0xeb, 0x0f, 0x86, 0x0e, 0x1f, 0x05, 0x03, 0xdd, 0xa2, 0x93,
-	0xe6, 0x02, 0xf4, 0x9e, 0x43, 0x97, 0xa4, 0xe6, 0xf3, 0xc5, 0x3f, 0x97, 0xb8, 0x68, 0xd8, 0x20,
-	0x2d, 0x07, 0xf3, 0xfd, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0x7d, 0x50, 0x80, 0x91, 0x4c, 0x0a,
-	0x00, 0x00,
+	// 1739 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0xcd, 0x73, 0x1b, 0x49,
+	0x15, 0xf7, 0x48, 0xb2, 0x3e, 0x9e, 0xe4, 0x58, 0xe9, 0x04, 0xaf, 0x22, 0x88, 0x35, 0xd1, 0x06,
+	0x30, 0xa6, 0x32, 0x8a, 0x0d, 0x4b, 0xb1, 0x0b, 0x5b, 0x5b, 0x92, 0xac, 0x6c, 0x54, 0x64, 0x25,
+	0xd5, 0x8c, 0x1d, 0x0a, 0x2e, 0x53, 0xa3, 0x99, 0xb6, 0x34, 0x65, 0x69, 0x7a, 0x76, 0xba, 0x47,
+	0x59, 0xc1, 0x85, 0xb3, 0x4f, 0xe1, 0xc6, 0x01, 0x55, 0x71, 0xe6, 0x3f, 0xe0, 0xc4, 0x0d, 0x96,
+	0xdb, 0x1e, 0x29, 0x8a, 0x4a, 0xa8, 0xe4, 0x4a, 0x71, 0xe4, 0x4c, 0x75, 0x4f, 0x8f, 0x3e, 0x1c,
+	0x59, 0x24, 0x14, 0x27, 0xcd, 0x7b, 0xfd, 0x7b, 0xfd, 0x5e, 0xff, 0xde, 0xeb, 0xd7, 0x4f, 0x50,
+	0xf5, 0x03, 0x32, 0xc1, 0x9e, 0xe5, 0xd9, 0xb8, 0x36, 0xc6, 0xcc, 0x72, 0x2c, 0x66, 0xd5, 0x26,
+	0x47, 0x35, 0x6a, 0x13, 0x1f, 0x6b, 0x7e, 0x40, 0x18, 0x41, 0x7b, 0x0b, 0x8c, 0x16, 0x63, 0xb4,
+	0xc9, 0x51, 0x79, 0xdf, 0x26, 0x74, 0x4c, 0x68, 0xad, 0x6f, 0x51, 0x5c, 0x9b, 0x1c, 0xf5, 0x31,
+	0xb3, 0x8e, 0x6a, 0x36, 0x71, 0xbd, 0xc8, 0xae, 0x7c, 0x7b, 0x40, 0x06, 0x44, 0x7c, 0xd6, 0xf8,
+	0x97, 0xd4, 0x56, 0x06, 0x84, 0x0c, 0x46, 0xb8, 0x26, 0xa4, 0x7e, 0x78, 0x5e, 0x63, 0xee, 0x18,
+	0x53, 0x66, 0x8d, 0x7d, 0x09, 0x50, 0xaf, 0x02, 0x1c, 0x4c, 0xed, 0xc0, 0xf5, 0x19, 0x09, 0xe2,
+	0x2d, 0xdc, 0xbe, 0x5d, 0xb3, 0x49, 0x80, 0x6b, 0xf6, 0xc8, 0xc5, 0x1e, 0xe3, 0x01, 0x47, 0x5f,
+	0x12, 0x70, 0x78, 0xdd, 0xa9, 0x7c, 0x6c, 0xbb, 0xe7, 0xae, 0x6d, 0x31, 0x97, 0xc8, 0x28, 0xab,
+	0x7f, 0x49, 0xc0, 0xb6, 0xc1, 0x4f, 0x8b, 0x8e, 0x21, 0x2b, 0x8e, 0x6d, 0xba, 0x4e, 0x49, 0x51,
+	0x95, 0x83, 0x42, 0xe3, 0xbd, 0x2f, 0x5f, 0x54, 0xb6, 0xfe, 0xf6, 0xa2, 0xb2, 0xfb, 0x99, 0xdc,
+	0xa4, 0xee, 0x38, 0x01, 0xa6, 0x54, 0xcf, 0x08, 0x60, 0xdb, 0x41, 0x0d, 0x28, 0xae, 0x6c, 0xca,
+	0x6d, 0x13, 0x9b, 0x6d, 0x77, 0x57, 0x0c, 0xda, 0x0e, 0xfa, 0x11, 0xa4, 0xc9, 0x33, 0x0f, 0x07,
+	0xb4, 0x94, 0x54, 0x93, 0x07, 0xf9, 0xe3, 0xbb, 0xda, 0x7a, 0xc2, 0xb5, 0x9e, 0x15, 0xb0, 0x69,
+	0x23, 0xc5, 0x37, 0xd6, 0xa5, 0x09, 0xaa, 0x40, 0x9e, 0x2f, 0x9b, 0x96, 0x6d, 0x63, 0x4a, 0x4b,
+	0x29, 0x35, 0x79, 0x90, 0xd3, 0x41, 0xf8, 0x13, 0x1a, 0xa4, 0xc1, 0xad, 0x89, 0x35, 0x0a, 0xb1,
+	0x29, 0x0c, 0x4c, 0x2b, 0x8a, 0xa2, 0xb4, 0xad, 0x2a, 0x07, 0x39, 0xfd, 0xa6, 0x58, 0xea, 0xf2,
+	0x15, 0x19, 0x1e, 0x7a, 0x08, 0xb7, 0x03, 0xfc, 0x79, 0xe8, 0x06, 0xd8, 0xf4, 0xb9, 0x3f, 0x33,
+	0x20, 0xa3, 0x51, 0xe8, 0x97, 0xd2, 0xaa, 0x72, 0x90, 0xd5, 0x91, 0x5c, 0x13, 0xa1, 0xe8, 0x62,
+	0xe5, 0xa3, 0xec, 0x6f, 0x7e, 0x57, 0xd9, 0xfa, 0xd5, 0xdf, 0x55, 0xa5, 0xfa, 0xef, 0x04, 0x64,
+	0x0c, 0x4c, 0xa9, 0x4b, 0x3c, 0xf4, 0x03, 0x00, 0x1a, 0x7d, 0xbe, 0x05, 0x9f, 0x39, 0x09, 0xfd,
+	0x3f, 0x31, 0xfa, 0x31, 0x64, 0x78, 0xec, 0x2e, 0x7e, 0x27, 0x4a, 0x63, 0x1b, 0x84, 0x20, 0xe5,
+	0x59, 0x63, 0x5c, 0x4a, 0x09, 0x8e, 0xc4, 0x37, 0x2a, 0x41, 0xc6, 0x26, 0x1e, 0xc3, 0x5f, 0x30,
+	0x41, 0x5d, 0x41, 0x8f, 0x45, 0xf4, 0x31, 0xa4, 0x29, 0xb3, 0x58, 0x48, 0x05, 0x45, 0x37, 0x8e,
+	0xbf, 0x79, 0x9d, 0x2f, 0xc9, 0x8c, 0x21, 0xc0, 0xba, 0x34, 0x42, 0x1f, 0xc2, 0xb6, 0x15, 0x3a,
+	0x2e, 0x2b, 0xd9, 0xaa, 0x72, 0x90, 0x3f, 0x7e, 0xff, 0x3a, 0xeb, 0x3a, 0x07, 0x3d, 0x72, 0xf1,
+	0xc8, 0xa1, 0x7a, 0x64, 0xb1, 0x44, 0xfc, 0x3f, 0x13, 0x90, 0xd6, 0xb1, 0x4d, 0x02, 0x67, 0x1e,
+	0xbc, 0xb2, 0x14, 0xfc, 0x6a, 0x2e, 0x12, 0x6f, 0x9d, 0x8b, 0x4f, 0x20, 0xe3, 0x07, 0x44, 0x14,
+	0x56, 0x52, 0x44, 0x57, 0xb9, 0x96, 0xc7, 0x08, 0x36, 0x67, 0x32, 0x12, 0x51, 0x1d, 0xd2, 0xae,
+	0xe7, 0x87, 0x2c, 0x2a, 0xcc, 0x0d, 0xa7, 0x8b, 0x82, 0x6f, 0x73, 0x6c, 0x5c, 0xe0, 0x91, 0x21,
+	0x3a, 0x81, 0x0c, 0x09, 0x99, 0xd8, 0x63, 0x5b, 0xec, 0x71, 0x7f, 0xf3, 0x1e, 0x5d, 0x01, 0x8e,
+	0x03, 0x91, 0xa6, 0x6b, 0xab, 0x2a, 0xfd, 0x6e, 0x55, 0xb5, 0x44, 0xf7, 0x2f, 0x21, 0x23, 0x0f,
+	0x8c, 0xca, 0x90, 0x89, 0xaf, 0x94, 0x60, 0xfc, 0xf1, 0x96, 0x1e, 0x2b, 0xd0, 0x6d, 0x48, 0x0d,
+	0x2d, 0x3a, 0x14, 0x84, 0xf3, 0x05, 0x21, 0xcd, 0x13, 0x94, 0x5c, 0x4a, 0xd0, 0x1e, 0xa4, 0xc7,
+	0x98, 0x0d, 0x89, 0x23, 0x6b, 0x4e, 0x4a, 0x1f, 0xa5, 0xb8, 0xcb, 0x46, 0x01, 0x40, 0x12, 0x6a,
+	0xba, 0x4e, 0xf5, 0xb7, 0x09, 0xc8, 0x2f, 0xd1, 0xb5, 0x36, 0xe1, 0xc7, 0x90, 0x0b, 0x04, 0x64,
+	0x91, 0xef, 0x5b, 0x6b, 0xce, 0xf8, 0x78, 0x4b, 0xcf, 0x46, 0xb8, 0xb6, 0x33, 0x8f, 0x36, 0xb9,
+	0x12, 0xed, 0x27, 0x90, 0x73, 0xfb, 0xb6, 0xe9, 0x07, 0x84, 0x9c, 0x0b, 0xc6, 0xf2, 0xc7, 0xea,
+	0x75, 0x09, 0x68, 0x37, 0x9a, 0x3d, 0x8e, 0xe3, 0xdb, 0xba, 0x7d, 0x5b, 0x7c, 0xa3, 0xaf, 0x43,
+	0x8e, 0x4d, 0x7d, 0x6c, 0x2e, 0xdd, 0xa8, 0x2c, 0x57, 0x74, 0x78, 0x9c, 0xf5, 0xf9, 0xdd, 0xd9,
+	0x16, 0x77, 0xe7, 0x3b, 0x6f, 0x51, 0x1f, 0xab, 0xf7, 0x47, 0x52, 0x94, 0x85, 0x34, 0x25, 0x61,
+	0x60, 0xe3, 0xea, 0x0b, 0x05, 0xb2, 0x71, 0x20, 0xdc, 0x79, 0xf4, 0x30, 0xc4, 0x3d, 0x28, 0xa7,
+	0x67, 0x23, 0x45, 0xdb, 0x41, 0xef, 0xc3, 0x8e, 0x4d, 0x3c, 0x0f, 0xdb, 0xcb, 0x6d, 0x26, 0xa7,
+	0x17, 0x16, 0xca, 0xb6, 0x83, 0x9a, 0x50, 0x10, 0x67, 0x37, 0x87, 0xd8, 0x1d, 0x0c, 0x99, 0xbc,
+	0x07, 0x65, 0xcd, 0xed, 0xdb, 0x1a, 0x7f, 0x82, 0x34, 0xf9, 0xf0, 0x4c, 0x8e, 0xb4, 0xc7, 0x02,
+	0x21, 0x2b, 0x2f, 0x2f, 0xac, 0x22, 0x15, 0xba, 0x03, 0xd9, 0x0b, 0x3c, 0x35, 0x7d, 0x8b, 0x0d,
+	0xc5, 0x45, 0x28, 0xe8, 0x99, 0x0b, 0x3c, 0xed, 0x59, 0x6c, 0x88, 0x6e, 0xc3, 0xb6, 0xe8, 0xc1,
+	0xb2, 0xab, 0x44, 0x02, 0xd7, 0x2e, 0x18, 0x2f, 0xe8, 0x91, 0x10, 0x1d, 0xb5, 0x7a, 0x0e, 0x85,
+	0xe5, 0x4a, 0xe7, 0xf9, 0x17, 0x79, 0x93, 0xf9, 0x17, 0x59, 0xfb, 0xf1, 0x9c, 0xd7, 0x84, 0xe0,
+	0x75, 0xc3, 0x9d, 0xa1, 0xe1, 0x68, 0x2d, 0xa5, 0xd5, 0x5f, 0xc0, 0xb6, 0xe8, 0x8e, 0xbc, 0xf5,
+	0xad, 0x94, 0xf8, 0xa2, 0xc0, 0x3f, 0x80, 0x54, 0x40, 0x46, 0x58, 0x3a, 0xb9, 0xb7, 0xb1, 0xc9,
+	0x9e, 0x4e, 0x7d, 0xac, 0x0b, 0x38, 0x2a, 0x43, 0x96, 0xf8, 0x9c, 0x5f, 0x6b, 0x24, 0xf8, 0xcc,
+	0xea, 0x73, 0x59, 0xfa, 0xfe, 0x75, 0x02, 0xf2, 0x4b, 0x0d, 0x0f, 0x7d, 0x0a, 0x05, 0x3b, 0xc0,
+	0x16, 0xc3, 0x8e, 0xe9, 0x58, 0x2c, 0xaa, 0x75, 0x9e, 0x85, 0x68, 0x54, 0xd0, 0xe2, 0x51, 0x41,
+	0x3b, 0x8d, 0x67, 0x89, 0x46, 0x96, 0x67, 0xe1, 0xf9, 0xcb, 0x8a, 0xa2, 0xe7, 0xa5, 0xe5, 0x89,
+	0xc5, 0x30, 0xba, 0x0b, 0x10, 0x6f, 0xd4, 0x9f, 0xca, 0x84, 0xe7, 0xa4, 0xa6, 0x31, 0xe5, 0x7e,
+	0x42, 0xdf, 0x59, 0xf8, 0x49, 0xbe, 0x8b, 0x1f, 0x69, 0x19, 0xfb, 0x89, 0x37, 0xea, 0x4f, 0x65,
+	0xd9, 0xe7, 0xa4, 0xa6, 0x21, 0x28, 0x9d, 0xe0, 0x80, 0x77, 0x59, 0x91, 0xf7, 0x1d, 0x3d, 0x16,
+	0xf9, 0xca, 0x18, 0x53, 0x6a, 0x0d, 0xb0, 0xc8, 0x7d, 0x4e, 0x8f, 0xc5, 0xea, 0x73, 0x05, 0x76,
+	0x3a, 0x98, 0xd5, 0x29, 0xc5, 0xec, 0xa9, 0xa8, 0x92, 0x0f, 0x78, 0x95, 0xb8, 0x76, 0x4c, 0xc7,
+	0x1d, 0x2d, 0x1a, 0xc8, 0x34, 0x3e, 0x90, 0x69, 0x72, 0x20, 0xd3, 0x9a, 0xc4, 0xf5, 0x64, 0x4d,
+	0x46, 0x68, 0xfe, 0xc2, 0xcf, 0x63, 0x1b, 0x11, 0xfb, 0x22, 0x2e, 0x6d, 0xce, 0x46, 0x4a, 0x47,
+	0x71, 0x94, 0x7c, 0x49, 0xd6, 0xef, 0x1e, 0xa4, 0x27, 0x64, 0x14, 0xca, 0xa6, 0x95, 0xd2, 0xa5,
+	0x54, 0xfd, 0x63, 0x12, 0x6e, 0x8a, 0xd9, 0x29, 0x9a, 0x35, 0x9a, 0x43, 0xcb, 0x1b, 0x88, 0xf4,
+	0x52, 0xfc, 0x79, 0x88, 0x3d, 0x19, 0x59, 0x4a, 0x9f, 0xcb, 0x2b, 0x33, 0x56, 0xe2, 0x2d, 0x67,
+	0xac, 0x0e, 0xe4, 0x6d, 0xb1, 0xb3, 0xc9, 0xfb, 0x86, 0x08, 0xe1, 0xc6, 0xf1, 0x83, 0x6b, 0x5f,
+	0xd9, 0xab, 0xf1, 0x88, 0xc2, 0x03, 0x7b, 0xfe, 0xcd, 0x2f, 0x97, 0xe5, 0x38, 0xd8, 0x91, 0xc3,
+	0x52, 0x24, 0x70, 0xe2, 0x03, 0x3c, 0x26, 0x13, 0xec, 0x88, 0x77, 0x26, 0xa7, 0xc7, 0x22, 0xe7,
+	0xcb, 0x0f, 0xf0, 0xc4, 0x25, 0x21, 0x35, 0x97, 0x46, 0x29, 0x99, 0x1f, 0x14, 0xaf, 0x3d, 0x9d,
+	0x8f, 0x52, 0xe8, 0x5b, 0xb0, 0xeb, 0xe1, 0x67, 0x2b, 0xe0, 0x8c, 0x00, 0xef, 0x78, 0xf8, 0xd9,
+	0x12, 0xae, 0x04, 0x19, 0xea, 0x0e, 0xc4, 0xe8, 0x97, 0x8d, 0x7c, 0x4a, 0x11, 0xdd, 0x83, 0xc2,
+	0x4a, 0x6e, 0x72, 0xaa, 0x72, 0x90, 0xd4, 0xf3, 0xfd, 0xa5, 0xa4, 0x34, 0x01, 0x22, 0x08, 0x1f,
+	0xa0, 0x4b, 0xf0, 0x0e, 0x95, 0x9a, 0x13, 0x76, 0x7c, 0xa5, 0xfa, 0x2f, 0x05, 0x76, 0x05, 0x63,
+	0x06, 0x66, 0x6c, 0x84, 0xc7, 0xd8, 0x63, 0xff, 0xd3, 0x1c, 0xbc, 0x07, 0x69, 0x8a, 0x47, 0x23,
+	0x1c, 0xc8, 0x3b, 0x25, 0x25, 0xce, 0x75, 0x3f, 0x9c, 0xe2, 0x40, 0xbe, 0x76, 0x91, 0x80, 0xac,
+	0xb8, 0x70, 0xa3, 0xa9, 0x60, 0x43, 0xe1, 0x3e, 0xe4, 0x9e, 0x7f, 0xff, 0xb2, 0x72, 0x30, 0x70,
+	0xd9, 0x30, 0xec, 0x6b, 0x36, 0x19, 0xd7, 0xe4, 0xdf, 0x8e, 0xe8, 0xe7, 0x01, 0x75, 0x2e, 0x6a,
+	0xbc, 0x2e, 0xa8, 0x30, 0xa0, 0x71, 0x91, 0xef, 0x41, 0xfa, 0x3c, 0xf4, 0x1c, 0x91, 0x4d, 0xde,
+	0x61, 0xa4, 0x74, 0xf8, 0x07, 0x05, 0x76, 0x56, 0x06, 0x31, 0x54, 0x83, 0xb2, 0xd1, 0x32, 0x8c,
+	0x76, 0xb7, 0x63, 0x1a, 0xa7, 0xf5, 0xd3, 0x33, 0xc3, 0x3c, 0xeb, 0x18, 0xbd, 0x56, 0xb3, 0xfd,
+	0xa8, 0xdd, 0x3a, 0x29, 0x6e, 0x95, 0x77, 0x2f, 0x67, 0x6a, 0xfe, 0xcc, 0x93, 0xd3, 0x00, 0x76,
+	0xd0, 0x3d, 0xb8, 0x75, 0xc5, 0xa0, 0xdb, 0x6b, 0x75, 0x8a, 0x4a, 0x39, 0x7b, 0x39, 0x53, 0x53,
+	0x5d, 0x1f, 0x7b, 0xe8, 0xbb, 0x50, 0xba, 0x02, 0x69, 0x76, 0x3f, 0xeb, 0x3d, 0x69, 0x9d, 0xb6,
+	0x4e, 0x8a, 0x89, 0xf2, 0xce, 0xe5, 0x4c, 0xcd, 0x35, 0xc9, 0xd8, 0x1f, 0x61, 0x86, 0x1d, 0xf4,
+	0x6d, 0xd8, 0xbb, 0x02, 0xae, 0x37, 0xba, 0x3a, 0x87, 0x26, 0xcb, 0xf9, 0xcb, 0x99, 0x9a, 0xa9,
+	0xf7, 0x49, 0xc0, 0xb0, 0x73, 0xf8, 0x67, 0x05, 0x6e, 0xbe, 0xf1, 0x10, 0xa2, 0x87, 0x50, 0xd1,
+	0x5b, 0xcd, 0xae, 0x7e, 0x62, 0xb6, 0x3b, 0xbd, 0xb3, 0xd3, 0xf5, 0x87, 0x10, 0xfb, 0x9c, 0x79,
+	0x17, 0x1e, 0x79, 0xe6, 0x21, 0x0d, 0xbe, 0xb1, 0xce, 0xa2, 0xa7, 0x77, 0x7b, 0x5d, 0xa3, 0x75,
+	0x52, 0x54, 0xca, 0x85, 0xcb, 0x99, 0x9a, 0xed, 0x05, 0xc4, 0x27, 0x14, 0x3b, 0xe8, 0x10, 0xca,
+	0xeb, 0xf0, 0x91, 0xae, 0x98, 0x28, 0xc3, 0xe5, 0x4c, 0x8d, 0xc7, 0xcf, 0xfb, 0xf0, 0xde, 0x3a,
+	0x6c, 0xbb, 0xd1, 0x2c, 0x26, 0xcb, 0x99, 0xcb, 0x99, 0x9a, 0x6c, 0x37, 0x9a, 0x87, 0x21, 0x7f,
+	0xc3, 0x16, 0x2f, 0x0f, 0xba, 0x0b, 0x77, 0xf4, 0x96, 0x71, 0xf6, 0x64, 0x7d, 0xf4, 0x68, 0x0f,
+	0xd0, 0xea, 0x72, 0xaf, 0x6e, 0x18, 0x45, 0xe5, 0x4d, 0xbd, 0xf1, 0x93, 0x76, 0xaf, 0x98, 0x78,
+	0x53, 0xff, 0xa8, 0xde, 0x7e, 0x52, 0x4c, 0x1e, 0xfe, 0x49, 0x81, 0xaf, 0xad, 0xed, 0x0f, 0xe8,
+	0x43, 0xb8, 0x6f, 0x34, 0xbb, 0xbd, 0x96, 0x59, 0x6f, 0x36, 0x5b, 0x86, 0x61, 0x36, 0x1f, 0xd7,
+	0x3b, 0x9f, 0xb6, 0xcc, 0xd3, 0x9f, 0xf5, 0x5a, 0xff, 0xad, 0x1c, 0x7e, 0xb8, 0xc1, 0xf4, 0xa4,
+	0x7e, 0x5a, 0x97, 0xfa, 0xa2, 0x52, 0xbe, 0x71, 0x39, 0x53, 0xe1, 0x64, 0xf1, 0xd7, 0x6c, 0x93,
+	0xe5, 0xd3, 0xfa, 0x93, 0xb3, 0x96, 0xd9, 0xfd, 0x69, 0xa7, 0xa5, 0x17, 0x13, 0x91, 0xe5, 0xa2,
+	0x71, 0x34, 0x2e, 0xbe, 0x7c, 0xb5, 0xaf, 0x7c, 0xf5, 0x6a, 0x5f, 0xf9, 0xc7, 0xab, 0x7d, 0xe5,
+	0xf9, 0xeb, 0xfd, 0xad, 0xaf, 0x5e, 0xef, 0x6f, 0xfd, 0xf5, 0xf5, 0xfe, 0x16, 0xdc, 0x71, 0xc9,
+	0x35, 0x9d, 0xb1, 0xa7, 0xfc, 0xfc, 0xfb, 0x4b, 0xb7, 0x68, 0x01, 0x7a, 0xe0, 0x92, 0x25, 0xa9,
+	0xf6, 0xc5, 0xe2, 0x2f, 0xb3, 0xb8, 0x57, 0xfd, 0xb4, 0x68, 0x26, 0xdf, 0xfb, 0x4f, 0x00, 0x00,
+	0x00, 0xff, 0xff, 0x3c, 0xaf, 0x51, 0xf6, 0x2c, 0x10, 0x00, 0x00,
 }
 
 func (m *Scope) Marshal() (dAtA []byte, err error) {
@@ -1041,6 +1469,11 @@ func (m *Session) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x9a
 	}
+	if m.Status != 0 {
+		i = encodeVarintScope(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x30
+	}
 	if len(m.Context) > 0 {
 		i -= len(m.Context)
 		copy(dAtA[i:], m.Context)
@@ -1274,6 +1707,15 @@ func (m *RecordInput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Source != nil {
+		{
+			size := m.Source.Size()
+			i -= size
+			if _, err := m.Source.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
 	if m.Status != 0 {
 		i = encodeVarintScope(dAtA, i, uint64(m.Status))
 		i--
@@ -1286,15 +1728,6 @@ func (m *RecordInput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x22
 	}
-	if m.Source != nil {
-		{
-			size := m.Source.Size()
-			i -= size
-			if _, err := m.Source.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-		}
-	}
 	if len(m.Name) > 0 {
 		i -= len(m.Name)
 		copy(dAtA[i:], m.Name)
@@ -1338,42 +1771,28 @@ func (m *RecordInput_Hash) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	dAtA[i] = 0x1a
 	return len(dAtA) - i, nil
 }
-func (m *RecordOutput) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *RecordOutput) MarshalTo(dAtA []byte) (int, error) {
+func (m *RecordInput_IbcProof) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RecordOutput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RecordInput_IbcProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Status != 0 {
-		i = encodeVarintScope(dAtA, i, uint64(m.Status))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Hash) > 0 {
-		i -= len(m.Hash)
-		copy(dAtA[i:], m.Hash)
-		i = encodeVarintScope(dAtA, i, uint64(len(m.Hash)))
+	if m.IbcProof != nil {
+		{
+			size, err := m.IbcProof.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintScope(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x32
 	}
 	return len(dAtA) - i, nil
 }
-
-func (m *Party) Marshal() (dAtA []byte, err error) {
+func (m *IBCProof) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1383,17 +1802,122 @@ func (m *Party) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Party) MarshalTo(dAtA []byte) (int, error) {
+func (m *IBCProof) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Party) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *IBCProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Optional {
+	if len(m.Proof) > 0 {
+		i -= len(m.Proof)
+		copy(dAtA[i:], m.Proof)
+		i = encodeVarintScope(dAtA, i, uint64(len(m.Proof)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintScope(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.KeyPath) > 0 {
+		for iNdEx := len(m.KeyPath) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.KeyPath[iNdEx])
+			copy(dAtA[i:], m.KeyPath[iNdEx])
+			i = encodeVarintScope(dAtA, i, uint64(len(m.KeyPath[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size, err := m.ProofHeight.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintScope(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintScope(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintScope(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RecordOutput) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RecordOutput) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RecordOutput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Status != 0 {
+		i = encodeVarintScope(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintScope(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Party) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Party) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Party) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Optional {
 		i--
 		if m.Optional {
 			dAtA[i] = 1
@@ -1457,12 +1981,12 @@ func (m *AuditFields) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x22
 	}
-	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.UpdatedDate, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.UpdatedDate):])
-	if err3 != nil {
-		return 0, err3
+	n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.UpdatedDate, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.UpdatedDate):])
+	if err5 != nil {
+		return 0, err5
 	}
-	i -= n3
-	i = encodeVarintScope(dAtA, i, uint64(n3))
+	i -= n5
+	i = encodeVarintScope(dAtA, i, uint64(n5))
 	i--
 	dAtA[i] = 0x1a
 	if len(m.CreatedBy) > 0 {
@@ -1472,12 +1996,12 @@ func (m *AuditFields) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x12
 	}
-	n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CreatedDate, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreatedDate):])
-	if err4 != nil {
-		return 0, err4
+	n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CreatedDate, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreatedDate):])
+	if err6 != nil {
+		return 0, err6
 	}
-	i -= n4
-	i = encodeVarintScope(dAtA, i, uint64(n4))
+	i -= n6
+	i = encodeVarintScope(dAtA, i, uint64(n6))
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
@@ -1526,6 +2050,174 @@ func (m *NetAssetValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ScopeAccessChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScopeAccessChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScopeAccessChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n8, err8 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.BlockTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.BlockTime):])
+	if err8 != nil {
+		return 0, err8
+	}
+	i -= n8
+	i = encodeVarintScope(dAtA, i, uint64(n8))
+	i--
+	dAtA[i] = 0x52
+	if m.BlockHeight != 0 {
+		i = encodeVarintScope(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.Signers) > 0 {
+		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Signers[iNdEx])
+			copy(dAtA[i:], m.Signers[iNdEx])
+			i = encodeVarintScope(dAtA, i, uint64(len(m.Signers[iNdEx])))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.NewValueOwner) > 0 {
+		i -= len(m.NewValueOwner)
+		copy(dAtA[i:], m.NewValueOwner)
+		i = encodeVarintScope(dAtA, i, uint64(len(m.NewValueOwner)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.PreviousValueOwner) > 0 {
+		i -= len(m.PreviousValueOwner)
+		copy(dAtA[i:], m.PreviousValueOwner)
+		i = encodeVarintScope(dAtA, i, uint64(len(m.PreviousValueOwner)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Removed) > 0 {
+		for iNdEx := len(m.Removed) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Removed[iNdEx])
+			copy(dAtA[i:], m.Removed[iNdEx])
+			i = encodeVarintScope(dAtA, i, uint64(len(m.Removed[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Added) > 0 {
+		for iNdEx := len(m.Added) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Added[iNdEx])
+			copy(dAtA[i:], m.Added[iNdEx])
+			i = encodeVarintScope(dAtA, i, uint64(len(m.Added[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.ChangeType != 0 {
+		i = encodeVarintScope(dAtA, i, uint64(m.ChangeType))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.ScopeId.Size()
+		i -= size
+		if _, err := m.ScopeId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintScope(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Sequence != 0 {
+		i = encodeVarintScope(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ScopeSettlement) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScopeSettlement) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScopeSettlement) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Funded {
+		i--
+		if m.Funded {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Price) > 0 {
+		for iNdEx := len(m.Price) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Price[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintScope(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Buyer) > 0 {
+		i -= len(m.Buyer)
+		copy(dAtA[i:], m.Buyer)
+		i = encodeVarintScope(dAtA, i, uint64(len(m.Buyer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Seller) > 0 {
+		i -= len(m.Seller)
+		copy(dAtA[i:], m.Seller)
+		i = encodeVarintScope(dAtA, i, uint64(len(m.Seller)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size := m.ScopeId.Size()
+		i -= size
+		if _, err := m.ScopeId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintScope(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintScope(dAtA []byte, offset int, v uint64) int {
 	offset -= sovScope(v)
 	base := offset
@@ -1593,6 +2285,9 @@ func (m *Session) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovScope(uint64(l))
 	}
+	if m.Status != 0 {
+		n += 1 + sovScope(uint64(m.Status))
+	}
 	if m.Audit != nil {
 		l = m.Audit.Size()
 		n += 2 + l + sovScope(uint64(l))
@@ -1714,6 +2409,51 @@ func (m *RecordInput_Hash) Size() (n int) {
 	n += 1 + l + sovScope(uint64(l))
 	return n
 }
+func (m *RecordInput_IbcProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.IbcProof != nil {
+		l = m.IbcProof.Size()
+		n += 1 + l + sovScope(uint64(l))
+	}
+	return n
+}
+func (m *IBCProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovScope(uint64(l))
+	}
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovScope(uint64(l))
+	}
+	l = m.ProofHeight.Size()
+	n += 1 + l + sovScope(uint64(l))
+	if len(m.KeyPath) > 0 {
+		for _, b := range m.KeyPath {
+			l = len(b)
+			n += 1 + l + sovScope(uint64(l))
+		}
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovScope(uint64(l))
+	}
+	l = len(m.Proof)
+	if l > 0 {
+		n += 1 + l + sovScope(uint64(l))
+	}
+	return n
+}
+
 func (m *RecordOutput) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1794,30 +2534,106 @@ func (m *NetAssetValue) Size() (n int) {
 	return n
 }
 
-func sovScope(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozScope(x uint64) (n int) {
-	return sovScope(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (this *Scope) String() string {
-	if this == nil {
-		return "nil"
+func (m *ScopeAccessChange) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	repeatedStringForOwners := "[]Party{"
-	for _, f := range this.Owners {
-		repeatedStringForOwners += fmt.Sprintf("%v", f) + ","
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		n += 1 + sovScope(uint64(m.Sequence))
 	}
-	repeatedStringForOwners += "}"
-	s := strings.Join([]string{`&Scope{`,
-		`ScopeId:` + fmt.Sprintf("%v", this.ScopeId) + `,`,
-		`SpecificationId:` + fmt.Sprintf("%v", this.SpecificationId) + `,`,
-		`Owners:` + repeatedStringForOwners + `,`,
-		`DataAccess:` + fmt.Sprintf("%v", this.DataAccess) + `,`,
-		`ValueOwnerAddress:` + fmt.Sprintf("%v", this.ValueOwnerAddress) + `,`,
-		`RequirePartyRollup:` + fmt.Sprintf("%v", this.RequirePartyRollup) + `,`,
-		`}`,
-	}, "")
+	l = m.ScopeId.Size()
+	n += 1 + l + sovScope(uint64(l))
+	if m.ChangeType != 0 {
+		n += 1 + sovScope(uint64(m.ChangeType))
+	}
+	if len(m.Added) > 0 {
+		for _, s := range m.Added {
+			l = len(s)
+			n += 1 + l + sovScope(uint64(l))
+		}
+	}
+	if len(m.Removed) > 0 {
+		for _, s := range m.Removed {
+			l = len(s)
+			n += 1 + l + sovScope(uint64(l))
+		}
+	}
+	l = len(m.PreviousValueOwner)
+	if l > 0 {
+		n += 1 + l + sovScope(uint64(l))
+	}
+	l = len(m.NewValueOwner)
+	if l > 0 {
+		n += 1 + l + sovScope(uint64(l))
+	}
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovScope(uint64(l))
+		}
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovScope(uint64(m.BlockHeight))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.BlockTime)
+	n += 1 + l + sovScope(uint64(l))
+	return n
+}
+
+func (m *ScopeSettlement) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ScopeId.Size()
+	n += 1 + l + sovScope(uint64(l))
+	l = len(m.Seller)
+	if l > 0 {
+		n += 1 + l + sovScope(uint64(l))
+	}
+	l = len(m.Buyer)
+	if l > 0 {
+		n += 1 + l + sovScope(uint64(l))
+	}
+	if len(m.Price) > 0 {
+		for _, e := range m.Price {
+			l = e.Size()
+			n += 1 + l + sovScope(uint64(l))
+		}
+	}
+	if m.Funded {
+		n += 2
+	}
+	return n
+}
+
+func sovScope(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozScope(x uint64) (n int) {
+	return sovScope(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *Scope) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForOwners := "[]Party{"
+	for _, f := range this.Owners {
+		repeatedStringForOwners += fmt.Sprintf("%v", f) + ","
+	}
+	repeatedStringForOwners += "}"
+	s := strings.Join([]string{`&Scope{`,
+		`ScopeId:` + fmt.Sprintf("%v", this.ScopeId) + `,`,
+		`SpecificationId:` + fmt.Sprintf("%v", this.SpecificationId) + `,`,
+		`Owners:` + repeatedStringForOwners + `,`,
+		`DataAccess:` + fmt.Sprintf("%v", this.DataAccess) + `,`,
+		`ValueOwnerAddress:` + fmt.Sprintf("%v", this.ValueOwnerAddress) + `,`,
+		`RequirePartyRollup:` + fmt.Sprintf("%v", this.RequirePartyRollup) + `,`,
+		`}`,
+	}, "")
 	return s
 }
 func (this *Session) String() string {
@@ -1835,6 +2651,7 @@ func (this *Session) String() string {
 		`Parties:` + repeatedStringForParties + `,`,
 		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
 		`Context:` + fmt.Sprintf("%v", this.Context) + `,`,
+		`Status:` + fmt.Sprintf("%v", this.Status) + `,`,
 		`Audit:` + strings.Replace(fmt.Sprintf("%v", this.Audit), "AuditFields", "AuditFields", 1) + `,`,
 		`}`,
 	}, "")
@@ -2302,6 +3119,25 @@ func (m *Session) Unmarshal(dAtA []byte) error {
 				m.Context = []byte{}
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= SessionStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		case 99:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Audit", wireType)
@@ -2966,6 +3802,41 @@ func (m *RecordInput) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IbcProof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthScope
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthScope
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &IBCProof{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Source = &RecordInput_IbcProof{v}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipScope(dAtA[iNdEx:])
@@ -2987,7 +3858,7 @@ func (m *RecordInput) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RecordOutput) Unmarshal(dAtA []byte) error {
+func (m *IBCProof) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3010,15 +3881,15 @@ func (m *RecordOutput) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RecordOutput: wiretype end group for non-group")
+			return fmt.Errorf("proto: IBCProof: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RecordOutput: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: IBCProof: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3046,13 +3917,13 @@ func (m *RecordOutput) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Hash = string(dAtA[iNdEx:postIndex])
+			m.ClientId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
 			}
-			m.Status = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowScope
@@ -3062,66 +3933,29 @@ func (m *RecordOutput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= ResultStatus(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipScope(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthScope
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Party) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowScope
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScope
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Party: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Party: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofHeight", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowScope
@@ -3131,29 +3965,30 @@ func (m *Party) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthScope
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthScope
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			if err := m.ProofHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyPath", wireType)
 			}
-			m.Role = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowScope
@@ -3163,16 +3998,29 @@ func (m *Party) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Role |= PartyType(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Optional", wireType)
+			if byteLen < 0 {
+				return ErrInvalidLengthScope
 			}
-			var v int
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScope
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyPath = append(m.KeyPath, make([]byte, postIndex-iNdEx))
+			copy(m.KeyPath[len(m.KeyPath)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowScope
@@ -3182,37 +4030,659 @@ func (m *Party) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Optional = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipScope(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			if byteLen < 0 {
+				return ErrInvalidLengthScope
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
 				return ErrInvalidLengthScope
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *AuditFields) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthScope
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScope
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proof = append(m.Proof[:0], dAtA[iNdEx:postIndex]...)
+			if m.Proof == nil {
+				m.Proof = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipScope(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthScope
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RecordOutput) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowScope
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RecordOutput: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RecordOutput: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthScope
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScope
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= ResultStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipScope(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthScope
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Party) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowScope
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Party: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Party: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthScope
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScope
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			m.Role = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Role |= PartyType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Optional", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Optional = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipScope(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthScope
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AuditFields) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowScope
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AuditFields: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AuditFields: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedDate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthScope
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthScope
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CreatedDate, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthScope
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScope
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreatedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedDate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthScope
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthScope
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.UpdatedDate, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthScope
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScope
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpdatedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthScope
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScope
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipScope(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthScope
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NetAssetValue) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowScope
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NetAssetValue: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NetAssetValue: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthScope
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthScope
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedBlockHeight", wireType)
+			}
+			m.UpdatedBlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpdatedBlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Volume", wireType)
+			}
+			m.Volume = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Volume |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipScope(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthScope
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScopeAccessChange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
 		preIndex := iNdEx
 		var wire uint64
 		for shift := uint(0); ; shift += 7 {
@@ -3232,17 +4702,36 @@ func (m *AuditFields) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AuditFields: wiretype end group for non-group")
+			return fmt.Errorf("proto: ScopeAccessChange: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AuditFields: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ScopeAccessChange: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatedDate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeId", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowScope
@@ -3252,28 +4741,47 @@ func (m *AuditFields) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthScope
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthScope
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CreatedDate, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ScopeId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChangeType", wireType)
+			}
+			m.ChangeType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChangeType |= ScopeAccessChangeType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatedBy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Added", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3301,13 +4809,13 @@ func (m *AuditFields) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CreatedBy = string(dAtA[iNdEx:postIndex])
+			m.Added = append(m.Added, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedDate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Removed", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowScope
@@ -3317,28 +4825,27 @@ func (m *AuditFields) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthScope
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthScope
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.UpdatedDate, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Removed = append(m.Removed, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 4:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedBy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousValueOwner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3366,13 +4873,13 @@ func (m *AuditFields) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UpdatedBy = string(dAtA[iNdEx:postIndex])
+			m.PreviousValueOwner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewValueOwner", wireType)
 			}
-			m.Version = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowScope
@@ -3382,14 +4889,27 @@ func (m *AuditFields) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Version |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthScope
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScope
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewValueOwner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3417,7 +4937,59 @@ func (m *AuditFields) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
+			m.Signers = append(m.Signers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthScope
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthScope
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.BlockTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3440,7 +5012,7 @@ func (m *AuditFields) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NetAssetValue) Unmarshal(dAtA []byte) error {
+func (m *ScopeSettlement) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3463,17 +5035,17 @@ func (m *NetAssetValue) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NetAssetValue: wiretype end group for non-group")
+			return fmt.Errorf("proto: ScopeSettlement: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NetAssetValue: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ScopeSettlement: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeId", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowScope
@@ -3483,30 +5055,30 @@ func (m *NetAssetValue) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthScope
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthScope
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ScopeId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedBlockHeight", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seller", wireType)
 			}
-			m.UpdatedBlockHeight = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowScope
@@ -3516,16 +5088,95 @@ func (m *NetAssetValue) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.UpdatedBlockHeight |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthScope
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScope
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Seller = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Buyer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthScope
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScope
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Buyer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthScope
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthScope
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Price = append(m.Price, types1.Coin{})
+			if err := m.Price[len(m.Price)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Volume", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Funded", wireType)
 			}
-			m.Volume = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowScope
@@ -3535,11 +5186,12 @@ func (m *NetAssetValue) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Volume |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.Funded = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipScope(dAtA[iNdEx:])
@@ -14,6 +14,8 @@ import (
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+
 	"github.com/provenance-io/provenance/testutil/assertions"
 )
 
@@ -256,6 +258,110 @@ func (s *ScopeTestSuite) TestScopeRemoveAccess() {
 	}
 }
 
+func (s *ScopeTestSuite) TestGetDataAccessChanges() {
+	tests := []struct {
+		name       string
+		before     []string
+		after      []string
+		expAdded   []string
+		expRemoved []string
+	}{
+		{name: "both empty"},
+		{name: "same entries", before: []string{"addr1", "addr2"}, after: []string{"addr2", "addr1"}},
+		{name: "all added", after: []string{"addr1", "addr2"}, expAdded: []string{"addr1", "addr2"}},
+		{name: "all removed", before: []string{"addr1", "addr2"}, expRemoved: []string{"addr1", "addr2"}},
+		{
+			name:       "some added and removed",
+			before:     []string{"addr1", "addr2", "addr3"},
+			after:      []string{"addr4", "addr2", "addr5"},
+			expAdded:   []string{"addr4", "addr5"},
+			expRemoved: []string{"addr1", "addr3"},
+		},
+		{
+			name:       "repeated entries",
+			before:     []string{"addr1", "addr1"},
+			after:      []string{"addr2", "addr2"},
+			expAdded:   []string{"addr2"},
+			expRemoved: []string{"addr1"},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			added, removed := GetDataAccessChanges(tc.before, tc.after)
+			s.Assert().Equal(tc.expAdded, added, "added")
+			s.Assert().Equal(tc.expRemoved, removed, "removed")
+		})
+	}
+}
+
+func (s *ScopeTestSuite) TestScopeAccessChangeValidate() {
+	scopeID := ScopeMetadataAddress(uuid.New())
+	withSeq := func(change ScopeAccessChange) ScopeAccessChange {
+		change.Sequence = 3
+		return change
+	}
+
+	tests := []struct {
+		name   string
+		change ScopeAccessChange
+		expErr string
+	}{
+		{
+			name:   "data access added",
+			change: withSeq(NewScopeDataAccessChange(scopeID, []string{s.Addr}, nil, []string{s.Addr})),
+		},
+		{
+			name:   "data access removed",
+			change: withSeq(NewScopeDataAccessChange(scopeID, nil, []string{s.Addr}, []string{s.Addr})),
+		},
+		{
+			name:   "value owner set",
+			change: withSeq(NewScopeValueOwnerChange(scopeID, "", s.Addr, []string{s.Addr})),
+		},
+		{
+			name:   "value owner removed",
+			change: withSeq(NewScopeValueOwnerChange(scopeID, s.Addr, "", []string{s.Addr})),
+		},
+		{
+			name:   "no sequence",
+			change: NewScopeDataAccessChange(scopeID, []string{s.Addr}, nil, nil),
+			expErr: "invalid scope access change: sequence cannot be zero",
+		},
+		{
+			name:   "not a scope id",
+			change: withSeq(NewScopeDataAccessChange(ScopeSpecMetadataAddress(uuid.New()), []string{s.Addr}, nil, nil)),
+			expErr: "invalid scope access change 3: ",
+		},
+		{
+			name:   "no data access changes",
+			change: withSeq(NewScopeDataAccessChange(scopeID, nil, nil, nil)),
+			expErr: "invalid scope access change 3: no data access addresses added or removed",
+		},
+		{
+			name:   "same value owner",
+			change: withSeq(NewScopeValueOwnerChange(scopeID, s.Addr, s.Addr, nil)),
+			expErr: "invalid scope access change 3: previous and new value owner are both \"" + s.Addr + "\"",
+		},
+		{
+			name:   "unspecified change type",
+			change: ScopeAccessChange{Sequence: 3, ScopeId: scopeID},
+			expErr: "invalid scope access change 3: unknown change type SCOPE_ACCESS_CHANGE_TYPE_UNSPECIFIED",
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			err := tc.change.Validate()
+			if len(tc.expErr) > 0 {
+				s.Assert().ErrorContains(err, tc.expErr, "Validate")
+			} else {
+				s.Assert().NoError(err, "Validate")
+			}
+		})
+	}
+}
+
 func (s *ScopeTestSuite) TestScopeAddOwners() {
 	ns := func(scopeID, scopeSpecification MetadataAddress, owners []Party, dataAccess []string, valueOwner string) *Scope {
 		return &Scope{
@@ -521,6 +627,25 @@ func (s *ScopeTestSuite) TestRecordValidateBasic() {
 	validRI := NewRecordInput("ri_name", &RecordInput_Hash{"hash"}, "ri_type", RecordInputStatus_Proposed)
 	validRO := NewRecordOutput("ro_hash", ResultStatus_RESULT_STATUS_PASS)
 	validPs := NewProcess("process_name", &Process_Hash{"address"}, "method")
+	ibcProof := func(modify func(p *IBCProof)) *RecordInput_IbcProof {
+		p := &IBCProof{
+			ClientId:     "07-tendermint-0",
+			ConnectionId: "connection-0",
+			ProofHeight:  clienttypes.NewHeight(1, 25),
+			KeyPath:      [][]byte{[]byte("metadata"), []byte("somekey")},
+			Value:        []byte("somevalue"),
+			Proof:        []byte("someproof"),
+		}
+		if modify != nil {
+			modify(p)
+		}
+		return &RecordInput_IbcProof{IbcProof: p}
+	}
+	ibcRecord := func(source isRecordInput_Source, status RecordInputStatus) *Record {
+		return NewRecord("name", sessionID, *validPs,
+			[]RecordInput{*NewRecordInput("name", source, "type_name", status)},
+			[]RecordOutput{*validRO}, nil)
+	}
 	tests := []struct {
 		name    string
 		record  *Record
@@ -651,6 +776,72 @@ func (s *ScopeTestSuite) TestRecordValidateBasic() {
 			"",
 			false,
 		},
+		{
+			"Valid record, record input ibc proof",
+			ibcRecord(ibcProof(nil), RecordInputStatus_IBC),
+			"",
+			false,
+		},
+		{
+			"Invalid record, ibc proof with proposed status",
+			ibcRecord(ibcProof(nil), RecordInputStatus_Proposed),
+			"invalid record input: ibc proof must be used with IBC type inputs",
+			true,
+		},
+		{
+			"Invalid record, hash with ibc status",
+			ibcRecord(&RecordInput_Hash{"hash"}, RecordInputStatus_IBC),
+			"invalid record input: hash specifier only applies to proposed inputs",
+			true,
+		},
+		{
+			"Invalid record, nil ibc proof",
+			ibcRecord(&RecordInput_IbcProof{}, RecordInputStatus_IBC),
+			"invalid record input: missing required ibc proof",
+			true,
+		},
+		{
+			"Invalid record, ibc proof without client id",
+			ibcRecord(ibcProof(func(p *IBCProof) { p.ClientId = "" }), RecordInputStatus_IBC),
+			"invalid record input: invalid record input ibc proof: invalid client id: identifier cannot be blank: invalid identifier",
+			true,
+		},
+		{
+			"Invalid record, ibc proof with bad connection id",
+			ibcRecord(ibcProof(func(p *IBCProof) { p.ConnectionId = "conn" }), RecordInputStatus_IBC),
+			"invalid record input: invalid record input ibc proof: invalid connection id: identifier conn has invalid length: 4, must be between 10-64 characters: invalid identifier",
+			true,
+		},
+		{
+			"Invalid record, ibc proof with zero height",
+			ibcRecord(ibcProof(func(p *IBCProof) { p.ProofHeight = clienttypes.ZeroHeight() }), RecordInputStatus_IBC),
+			"invalid record input: invalid record input ibc proof: proof height cannot be zero",
+			true,
+		},
+		{
+			"Invalid record, ibc proof without key path",
+			ibcRecord(ibcProof(func(p *IBCProof) { p.KeyPath = nil }), RecordInputStatus_IBC),
+			"invalid record input: invalid record input ibc proof: key path cannot be empty",
+			true,
+		},
+		{
+			"Invalid record, ibc proof with empty key path entry",
+			ibcRecord(ibcProof(func(p *IBCProof) { p.KeyPath = [][]byte{[]byte("metadata"), {}} }), RecordInputStatus_IBC),
+			"invalid record input: invalid record input ibc proof: key path entry 1 cannot be empty",
+			true,
+		},
+		{
+			"Invalid record, ibc proof without value",
+			ibcRecord(ibcProof(func(p *IBCProof) { p.Value = nil }), RecordInputStatus_IBC),
+			"invalid record input: invalid record input ibc proof: value cannot be empty",
+			true,
+		},
+		{
+			"Invalid record, ibc proof without proof",
+			ibcRecord(ibcProof(func(p *IBCProof) { p.Proof = nil }), RecordInputStatus_IBC),
+			"invalid record input: invalid record input ibc proof: proof cannot be empty",
+			true,
+		},
 		{
 			"Invalid record, incorrect result status for record output",
 			NewRecord("name", sessionID, *validPs,
@@ -759,6 +950,12 @@ func (s *ScopeTestSuite) TestSessionValidateBasic() {
 				}),
 			"session audit message exceeds maximum length (expected < 200 got: 202)",
 		},
+		{
+			"invalid session, unknown status",
+			&Session{SessionId: sessionID, SpecificationId: contractSpec, Name: "name", Status: 4, Parties: []Party{
+				{Address: "cosmos1sh49f6ze3vn7cdl2amh2gnc70z5mten3y08xck", Role: PartyType_PARTY_TYPE_AFFILIATE}}},
+			"invalid session status: unknown session status 4",
+		},
 	}
 
 	for _, tc := range tests {
@@ -774,6 +971,51 @@ func (s *ScopeTestSuite) TestSessionValidateBasic() {
 	}
 }
 
+func (s *ScopeTestSuite) TestSessionStatus() {
+	tests := []struct {
+		status       SessionStatus
+		expEffective SessionStatus
+		expOpen      bool
+	}{
+		{status: SessionStatus_Unspecified, expEffective: SessionStatus_Open, expOpen: true},
+		{status: SessionStatus_Open, expEffective: SessionStatus_Open, expOpen: true},
+		{status: SessionStatus_Completed, expEffective: SessionStatus_Completed, expOpen: false},
+		{status: SessionStatus_Aborted, expEffective: SessionStatus_Aborted, expOpen: false},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.status.String(), func() {
+			session := Session{Status: tc.status}
+			s.Assert().Equal(tc.expEffective, session.EffectiveStatus(), "EffectiveStatus")
+			s.Assert().Equal(tc.expOpen, session.IsOpen(), "IsOpen")
+		})
+	}
+}
+
+func (s *ScopeTestSuite) TestSessionStatusFromString() {
+	tests := []struct {
+		str    string
+		exp    SessionStatus
+		expErr string
+	}{
+		{str: "SESSION_STATUS_COMPLETED", exp: SessionStatus_Completed},
+		{str: "completed", exp: SessionStatus_Completed},
+		{str: " Aborted ", exp: SessionStatus_Aborted},
+		{str: "open", exp: SessionStatus_Open},
+		{str: "unspecified", exp: SessionStatus_Unspecified},
+		{str: "", expErr: `unknown session status ""`},
+		{str: "done", expErr: `unknown session status "done"`},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.str, func() {
+			actual, err := SessionStatusFromString(tc.str)
+			assertions.AssertErrorValue(s.T(), err, tc.expErr, "SessionStatusFromString(%q) error", tc.str)
+			s.Assert().Equal(tc.exp, actual, "SessionStatusFromString(%q) result", tc.str)
+		})
+	}
+}
+
 func (s *ScopeTestSuite) TestSessionString() {
 	scopeUUID := uuid.MustParse("382b6eed-e61e-469b-933e-e4d0210c77f6")
 	sessionUUID := uuid.MustParse("c120ba2a-b13d-4678-8e5a-7459d92c3e90")
@@ -803,6 +1045,7 @@ func (s *ScopeTestSuite) TestSessionString() {
 		"Parties:[]Party{cosmos1sh49f6ze3vn7cdl2amh2gnc70z5mten3y08xck - PARTY_TYPE_AFFILIATE,}," +
 		"Name:whatever," +
 		"Context:[109 111 114 101 100 97 116 97]," +
+		"Status:SESSION_STATUS_UNSPECIFIED," +
 		"Audit:created_date:<> created_by:\"cosmos1sh49f6ze3vn7cdl2amh2gnc70z5mten3y08xck\" updated_date:<> message:\"message\" ," +
 		"}"
 
@@ -1924,6 +2167,82 @@ func (s *ScopeTestSuite) TestEquivalentDataAssessors() {
 	}
 }
 
+func TestSubstituteParty(t *testing.T) {
+	pz := func(parties ...Party) []Party {
+		return parties
+	}
+
+	pOne3Req := Party{Address: "one", Role: 3, Optional: false}
+	pOne3Opt := Party{Address: "one", Role: 3, Optional: true}
+	pOne4Req := Party{Address: "one", Role: 4, Optional: false}
+	pTwo3Req := Party{Address: "two", Role: 3, Optional: false}
+	pTwo3Opt := Party{Address: "two", Role: 3, Optional: true}
+	pTwo4Req := Party{Address: "two", Role: 4, Optional: false}
+	pThree4Req := Party{Address: "three", Role: 4, Optional: false}
+
+	// Note: PartyType_PARTY_TYPE_INVESTOR = 3, PartyType_PARTY_TYPE_CUSTODIAN = 4
+
+	tests := []struct {
+		name     string
+		parties  []Party
+		expected []Party
+		found    bool
+	}{
+		{
+			name:     "nil",
+			parties:  nil,
+			expected: nil,
+			found:    false,
+		},
+		{
+			name:     "existing not a party",
+			parties:  pz(pTwo3Req, pThree4Req),
+			expected: pz(pTwo3Req, pThree4Req),
+			found:    false,
+		},
+		{
+			name:     "one role",
+			parties:  pz(pThree4Req, pOne3Opt),
+			expected: pz(pThree4Req, pTwo3Opt),
+			found:    true,
+		},
+		{
+			name:     "two roles",
+			parties:  pz(pOne3Req, pThree4Req, pOne4Req),
+			expected: pz(pTwo3Req, pThree4Req, pTwo4Req),
+			found:    true,
+		},
+		{
+			name:     "replacement already has role, both optional",
+			parties:  pz(pOne3Opt, pTwo3Opt),
+			expected: pz(pTwo3Opt),
+			found:    true,
+		},
+		{
+			name:     "replacement already has role, existing required",
+			parties:  pz(pOne3Req, pTwo3Opt),
+			expected: pz(pTwo3Req),
+			found:    true,
+		},
+		{
+			name:     "replacement already has role, replacement required",
+			parties:  pz(pTwo3Req, pOne3Opt, pOne4Req),
+			expected: pz(pTwo3Req, pTwo4Req),
+			found:    true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			orig := append([]Party(nil), tc.parties...)
+			actual, found := SubstituteParty(tc.parties, "one", "two")
+			assert.Equal(t, tc.expected, actual, "SubstituteParty parties")
+			assert.Equal(t, tc.found, found, "SubstituteParty found")
+			assert.Equal(t, orig, tc.parties, "parties provided to SubstituteParty")
+		})
+	}
+}
+
 func TestNetAssetValueValidate(t *testing.T) {
 	tests := []struct {
 		name   string
@@ -1967,3 +2286,62 @@ func TestNetAssetValueValidate(t *testing.T) {
 		})
 	}
 }
+
+func TestScopeSettlementValidate(t *testing.T) {
+	scopeID := ScopeMetadataAddress(uuid.MustParse("8d80b25a-c089-4446-956e-5d08cfe3e1a5"))
+	seller := sdk.AccAddress("seller______________").String()
+	buyer := sdk.AccAddress("buyer_______________").String()
+	price := sdk.NewCoins(sdk.NewInt64Coin("nhash", 100))
+
+	tests := []struct {
+		name       string
+		settlement ScopeSettlement
+		expErr     string
+	}{
+		{
+			name:       "valid",
+			settlement: NewScopeSettlement(scopeID, seller, buyer, price),
+		},
+		{
+			name:       "not a scope",
+			settlement: NewScopeSettlement(ScopeSpecMetadataAddress(uuid.New()), seller, buyer, price),
+			expErr:     "invalid scope settlement scope id: ",
+		},
+		{
+			name:       "invalid seller",
+			settlement: NewScopeSettlement(scopeID, "bad", buyer, price),
+			expErr:     "invalid scope settlement seller \"bad\": ",
+		},
+		{
+			name:       "invalid buyer",
+			settlement: NewScopeSettlement(scopeID, seller, "", price),
+			expErr:     "invalid scope settlement buyer \"\": ",
+		},
+		{
+			name:       "seller is buyer",
+			settlement: NewScopeSettlement(scopeID, seller, seller, price),
+			expErr:     "invalid scope settlement: seller and buyer are both " + seller,
+		},
+		{
+			name:       "invalid price",
+			settlement: NewScopeSettlement(scopeID, seller, buyer, sdk.Coins{sdk.Coin{Denom: "nhash", Amount: sdkmath.NewInt(-1)}}),
+			expErr:     "invalid scope settlement price \"-1nhash\": ",
+		},
+		{
+			name:       "no price",
+			settlement: NewScopeSettlement(scopeID, seller, buyer, nil),
+			expErr:     "invalid scope settlement price: cannot be zero",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.settlement.Validate()
+			if len(tc.expErr) > 0 {
+				assert.ErrorContains(t, err, tc.expErr, "Validate")
+			} else {
+				assert.NoError(t, err, "Validate")
+			}
+		})
+	}
+}
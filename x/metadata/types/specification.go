@@ -28,6 +28,9 @@ const (
 	maxInputSpecificationTypeNameLength = 1000
 	// Default max url length
 	maxURLLength = 2048
+
+	// DefaultScopeSpecMigrationBatchSize is the number of scopes migrated in each block when a batch size isn't provided.
+	DefaultScopeSpecMigrationBatchSize uint32 = 100
 )
 
 var (
@@ -172,6 +175,64 @@ func (s ContractSpecification) ValidateBasic() error {
 		return fmt.Errorf("class name exceeds maximum length (expected <= %d got: %d)",
 			maxContractSpecificationClassNameLength, len(s.ClassName))
 	}
+	if !s.ReplacementId.Empty() {
+		if !s.Deprecated {
+			return errors.New("replacement id can only be set on a deprecated contract specification")
+		}
+		if err = validateContractSpecReplacement(s.SpecificationId, s.ReplacementId); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validateContractSpecReplacement makes sure a (non-empty) replacement id is a different contract specification id.
+func validateContractSpecReplacement(specID, replacementID MetadataAddress) error {
+	if !replacementID.IsContractSpecificationAddress() {
+		return fmt.Errorf("invalid replacement id: %s is not a contract specification id", replacementID)
+	}
+	if replacementID.Equals(specID) {
+		return errors.New("a contract specification cannot be its own replacement")
+	}
+	return nil
+}
+
+// NewScopeSpecMigration creates a new ScopeSpecMigration instance.
+// A batchSize of zero is replaced with DefaultScopeSpecMigrationBatchSize.
+func NewScopeSpecMigration(fromSpecID, toSpecID MetadataAddress, batchSize uint32, startHeight int64) ScopeSpecMigration {
+	if batchSize == 0 {
+		batchSize = DefaultScopeSpecMigrationBatchSize
+	}
+	return ScopeSpecMigration{
+		FromSpecificationId: fromSpecID,
+		ToSpecificationId:   toSpecID,
+		BatchSize:           batchSize,
+		StartHeight:         startHeight,
+	}
+}
+
+// Validate performs basic format checking of data in a ScopeSpecMigration.
+func (m ScopeSpecMigration) Validate() error {
+	if err := validateScopeSpecMigrationIDs(m.FromSpecificationId, m.ToSpecificationId); err != nil {
+		return err
+	}
+	if m.BatchSize == 0 {
+		return errors.New("batch size cannot be zero")
+	}
+	return nil
+}
+
+// validateScopeSpecMigrationIDs makes sure the from and to ids are different scope specification ids.
+func validateScopeSpecMigrationIDs(fromSpecID, toSpecID MetadataAddress) error {
+	if !fromSpecID.IsScopeSpecificationAddress() {
+		return fmt.Errorf("invalid from specification id: %s is not a scope specification id", fromSpecID)
+	}
+	if !toSpecID.IsScopeSpecificationAddress() {
+		return fmt.Errorf("invalid to specification id: %s is not a scope specification id", toSpecID)
+	}
+	if fromSpecID.Equals(toSpecID) {
+		return errors.New("cannot migrate scopes from a scope specification to itself")
+	}
 	return nil
 }
 
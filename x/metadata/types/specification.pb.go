@@ -194,6 +194,75 @@ func (m *ScopeSpecification) GetPartiesInvolved() []PartyType {
 	return nil
 }
 
+// ScopeSpecMigration defines an in-progress re-pointing of all scopes that use one scope specification to another.
+// It is removed once there are no more scopes that use the from specification.
+type ScopeSpecMigration struct {
+	// from_specification_id is the scope specification being migrated away from.
+	FromSpecificationId MetadataAddress `protobuf:"bytes,1,opt,name=from_specification_id,json=fromSpecificationId,proto3,customtype=MetadataAddress" json:"from_specification_id"`
+	// to_specification_id is the scope specification the scopes are being migrated to.
+	ToSpecificationId MetadataAddress `protobuf:"bytes,2,opt,name=to_specification_id,json=toSpecificationId,proto3,customtype=MetadataAddress" json:"to_specification_id"`
+	// batch_size is the maximum number of scopes updated in each block.
+	BatchSize uint32 `protobuf:"varint,3,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`
+	// scopes_migrated is the number of scopes that have been updated so far.
+	ScopesMigrated uint64 `protobuf:"varint,4,opt,name=scopes_migrated,json=scopesMigrated,proto3" json:"scopes_migrated,omitempty"`
+	// start_height is the block height at which this migration was started.
+	StartHeight int64 `protobuf:"varint,5,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+}
+
+func (m *ScopeSpecMigration) Reset()         { *m = ScopeSpecMigration{} }
+func (m *ScopeSpecMigration) String() string { return proto.CompactTextString(m) }
+func (*ScopeSpecMigration) ProtoMessage()    {}
+func (*ScopeSpecMigration) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1e2d1042057ea889, []int{1}
+}
+func (m *ScopeSpecMigration) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScopeSpecMigration) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScopeSpecMigration.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScopeSpecMigration) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScopeSpecMigration.Merge(m, src)
+}
+func (m *ScopeSpecMigration) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScopeSpecMigration) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScopeSpecMigration.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScopeSpecMigration proto.InternalMessageInfo
+
+func (m *ScopeSpecMigration) GetBatchSize() uint32 {
+	if m != nil {
+		return m.BatchSize
+	}
+	return 0
+}
+
+func (m *ScopeSpecMigration) GetScopesMigrated() uint64 {
+	if m != nil {
+		return m.ScopesMigrated
+	}
+	return 0
+}
+
+func (m *ScopeSpecMigration) GetStartHeight() int64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
 // ContractSpecification defines the required parties, resources, conditions, and consideration outputs for a contract
 type ContractSpecification struct {
 	// unique identifier for this specification on chain
@@ -214,12 +283,17 @@ type ContractSpecification struct {
 	Source isContractSpecification_Source `protobuf_oneof:"source"`
 	// name of the class/type of this contract executable
 	ClassName string `protobuf:"bytes,7,opt,name=class_name,json=className,proto3" json:"class_name,omitempty"`
+	// deprecated indicates that this contract specification should no longer be used for new sessions.
+	// It can only be set using DeprecateContractSpecification.
+	Deprecated bool `protobuf:"varint,8,opt,name=deprecated,proto3" json:"deprecated,omitempty"`
+	// replacement_id is the id of the contract specification that should be used instead of this (deprecated) one.
+	ReplacementId MetadataAddress `protobuf:"bytes,9,opt,name=replacement_id,json=replacementId,proto3,customtype=MetadataAddress" json:"replacement_id"`
 }
 
 func (m *ContractSpecification) Reset()      { *m = ContractSpecification{} }
 func (*ContractSpecification) ProtoMessage() {}
 func (*ContractSpecification) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1e2d1042057ea889, []int{1}
+	return fileDescriptor_1e2d1042057ea889, []int{2}
 }
 func (m *ContractSpecification) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -313,6 +387,13 @@ func (m *ContractSpecification) GetClassName() string {
 	return ""
 }
 
+func (m *ContractSpecification) GetDeprecated() bool {
+	if m != nil {
+		return m.Deprecated
+	}
+	return false
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*ContractSpecification) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -340,7 +421,7 @@ type RecordSpecification struct {
 func (m *RecordSpecification) Reset()      { *m = RecordSpecification{} }
 func (*RecordSpecification) ProtoMessage() {}
 func (*RecordSpecification) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1e2d1042057ea889, []int{2}
+	return fileDescriptor_1e2d1042057ea889, []int{3}
 }
 func (m *RecordSpecification) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -423,7 +504,7 @@ type InputSpecification struct {
 func (m *InputSpecification) Reset()      { *m = InputSpecification{} }
 func (*InputSpecification) ProtoMessage() {}
 func (*InputSpecification) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1e2d1042057ea889, []int{3}
+	return fileDescriptor_1e2d1042057ea889, []int{4}
 }
 func (m *InputSpecification) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -520,7 +601,7 @@ func (m *Description) Reset()         { *m = Description{} }
 func (m *Description) String() string { return proto.CompactTextString(m) }
 func (*Description) ProtoMessage()    {}
 func (*Description) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1e2d1042057ea889, []int{4}
+	return fileDescriptor_1e2d1042057ea889, []int{5}
 }
 func (m *Description) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -581,6 +662,7 @@ func init() {
 	proto.RegisterEnum("provenance.metadata.v1.DefinitionType", DefinitionType_name, DefinitionType_value)
 	proto.RegisterEnum("provenance.metadata.v1.PartyType", PartyType_name, PartyType_value)
 	proto.RegisterType((*ScopeSpecification)(nil), "provenance.metadata.v1.ScopeSpecification")
+	proto.RegisterType((*ScopeSpecMigration)(nil), "provenance.metadata.v1.ScopeSpecMigration")
 	proto.RegisterType((*ContractSpecification)(nil), "provenance.metadata.v1.ContractSpecification")
 	proto.RegisterType((*RecordSpecification)(nil), "provenance.metadata.v1.RecordSpecification")
 	proto.RegisterType((*InputSpecification)(nil), "provenance.metadata.v1.InputSpecification")
@@ -592,63 +674,73 @@ func init() {
 }
 
 var fileDescriptor_1e2d1042057ea889 = []byte{
-	// 896 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x56, 0x41, 0x6f, 0xe3, 0x44,
-	0x14, 0x8e, 0x93, 0x34, 0x4d, 0x5e, 0x50, 0x6b, 0xa6, 0xdd, 0xae, 0xbb, 0x0b, 0x49, 0x28, 0x12,
-	0x44, 0x95, 0x9a, 0xa8, 0x81, 0x13, 0x37, 0x27, 0x71, 0xb7, 0x23, 0x65, 0xed, 0x68, 0xe2, 0x14,
-	0x2d, 0x17, 0xcb, 0xb5, 0x67, 0x5b, 0x6b, 0x13, 0x8f, 0xe5, 0x71, 0xb2, 0xf4, 0x04, 0x3f, 0x80,
-	0x03, 0x47, 0x8e, 0x48, 0x48, 0xfc, 0x06, 0x7e, 0xc2, 0x1e, 0xf7, 0x88, 0x10, 0xaa, 0x50, 0x2b,
-	0x7e, 0x02, 0x17, 0x4e, 0xc8, 0x63, 0xb7, 0x71, 0x42, 0x8a, 0x38, 0x70, 0xe4, 0xd4, 0x99, 0xf7,
-	0x7d, 0xef, 0xcd, 0x7b, 0xdf, 0x37, 0xe3, 0x06, 0x0e, 0x83, 0x90, 0xcd, 0xa9, 0x6f, 0xfb, 0x0e,
-	0x6d, 0x4f, 0x69, 0x64, 0xbb, 0x76, 0x64, 0xb7, 0xe7, 0xc7, 0x6d, 0x1e, 0x50, 0xc7, 0x7b, 0xe9,
-	0x39, 0x76, 0xe4, 0x31, 0xbf, 0x15, 0x84, 0x2c, 0x62, 0x68, 0x6f, 0xc1, 0x6d, 0xdd, 0x71, 0x5b,
-	0xf3, 0xe3, 0x27, 0xbb, 0x17, 0xec, 0x82, 0x09, 0x4a, 0x3b, 0x5e, 0x25, 0xec, 0x83, 0xdf, 0xf3,
-	0x80, 0x46, 0x0e, 0x0b, 0xe8, 0x28, 0x5b, 0x0a, 0x75, 0x41, 0x5e, 0xaa, 0x6d, 0x79, 0xae, 0x22,
-	0x35, 0xa4, 0xe6, 0x3b, 0xdd, 0xc7, 0x6f, 0xae, 0xeb, 0xb9, 0x5f, 0xae, 0xeb, 0xdb, 0xcf, 0xd3,
-	0xda, 0xaa, 0xeb, 0x86, 0x94, 0x73, 0xb2, 0xbd, 0x94, 0x80, 0x5d, 0xa4, 0x41, 0xd5, 0xa5, 0xdc,
-	0x09, 0xbd, 0x20, 0x0e, 0x28, 0xf9, 0x86, 0xd4, 0xac, 0x76, 0x3e, 0x6c, 0xad, 0x6f, 0xaf, 0xd5,
-	0x5f, 0x50, 0x49, 0x36, 0x0f, 0x7d, 0x0c, 0xdb, 0xec, 0xb5, 0x4f, 0x43, 0xcb, 0x4e, 0x0e, 0xa2,
-	0x5c, 0x29, 0x34, 0x0a, 0xcd, 0x0a, 0xd9, 0x12, 0x61, 0xf5, 0x2e, 0x8a, 0x06, 0x20, 0x07, 0x76,
-	0x18, 0x79, 0x94, 0x5b, 0x9e, 0x3f, 0x67, 0x93, 0x39, 0x75, 0x95, 0x62, 0xa3, 0xd0, 0xdc, 0xea,
-	0x7c, 0xf0, 0xd0, 0xa1, 0x43, 0x3b, 0x8c, 0xae, 0xcc, 0xab, 0x80, 0x92, 0xed, 0x34, 0x15, 0xa7,
-	0x99, 0xa8, 0x07, 0xef, 0x3a, 0xcc, 0x8f, 0x42, 0xdb, 0x89, 0xac, 0x78, 0x32, 0xcb, 0x73, 0xb9,
-	0xb2, 0xd1, 0x28, 0xfc, 0xa3, 0x04, 0x77, 0x19, 0xb1, 0x98, 0xd8, 0xe5, 0x9f, 0x95, 0xbf, 0xfb,
-	0xbe, 0x9e, 0xfb, 0xfa, 0xd7, 0x86, 0x74, 0xf0, 0x53, 0x01, 0x1e, 0xf5, 0x32, 0xe8, 0xff, 0x52,
-	0x2f, 0xa4, 0x36, 0xa1, 0x1a, 0x52, 0xce, 0x66, 0xa1, 0x43, 0xe3, 0xe1, 0x37, 0xc4, 0xf0, 0xc7,
-	0x7f, 0x5e, 0xd7, 0x8f, 0x2e, 0xbc, 0xe8, 0x72, 0x76, 0xde, 0x72, 0xd8, 0xb4, 0xed, 0x30, 0x3e,
-	0x65, 0x3c, 0xfd, 0x73, 0xc4, 0xdd, 0x57, 0xed, 0xe8, 0x2a, 0xa0, 0xbc, 0xa5, 0x3a, 0x4e, 0xda,
-	0xd7, 0x69, 0x8e, 0xc0, 0x5d, 0x1d, 0xec, 0xa2, 0x5d, 0x28, 0x5e, 0xda, 0xfc, 0x52, 0x29, 0x35,
-	0xa4, 0x66, 0xe5, 0x34, 0x47, 0xc4, 0x0e, 0xbd, 0x0f, 0xe0, 0x4c, 0x6c, 0xce, 0x2d, 0xdf, 0x9e,
-	0x52, 0x65, 0x33, 0xc6, 0x48, 0x45, 0x44, 0x74, 0x7b, 0x4a, 0x17, 0x86, 0x75, 0xcb, 0x50, 0x4a,
-	0x4a, 0x1d, 0xfc, 0x91, 0x87, 0x1d, 0x42, 0x1d, 0x16, 0xba, 0xff, 0xbd, 0x71, 0x08, 0x8a, 0xa2,
-	0x91, 0xbc, 0x68, 0x44, 0xac, 0x51, 0x17, 0x4a, 0x9e, 0x1f, 0xcc, 0xa2, 0x44, 0xfc, 0x6a, 0xe7,
-	0xf0, 0x21, 0x49, 0x71, 0xcc, 0x5a, 0xea, 0x89, 0xa4, 0x99, 0xe8, 0x29, 0x54, 0x62, 0x79, 0x92,
-	0x29, 0x8b, 0xa2, 0x78, 0x39, 0x0e, 0xc4, 0x43, 0xa2, 0x67, 0x42, 0xef, 0xd9, 0x24, 0xb2, 0xe2,
-	0x90, 0xd0, 0x7b, 0xab, 0xf3, 0xd1, 0xc3, 0xb7, 0xe5, 0xa5, 0xe7, 0x7b, 0x71, 0x75, 0xe1, 0x1e,
-	0x24, 0xa9, 0xf1, 0x1a, 0x11, 0xd8, 0x09, 0x29, 0x0f, 0x98, 0xcf, 0xbd, 0xf3, 0x09, 0xb5, 0x52,
-	0x5f, 0x95, 0xd2, 0xbf, 0xbd, 0x09, 0x28, 0x93, 0x3d, 0x4c, 0x92, 0x33, 0x4f, 0xe6, 0x07, 0x09,
-	0xd0, 0xdf, 0x47, 0xbc, 0x97, 0x4c, 0xca, 0x48, 0xb6, 0x34, 0x6e, 0x7e, 0x65, 0xdc, 0x0e, 0x54,
-	0x42, 0x61, 0x5f, 0x6c, 0x50, 0x41, 0x18, 0xb4, 0xb3, 0xc6, 0x9c, 0xd3, 0x1c, 0x29, 0x27, 0xbc,
-	0xcc, 0xe5, 0x29, 0x66, 0x2f, 0xcf, 0xda, 0xdb, 0xf1, 0x15, 0x54, 0x33, 0xef, 0x69, 0x6d, 0x77,
-	0x8d, 0xe5, 0xd7, 0x59, 0x10, 0xd0, 0xd2, 0xc3, 0xab, 0x43, 0xf5, 0x35, 0x3d, 0xe7, 0x5e, 0x44,
-	0xad, 0x59, 0x38, 0x49, 0x0d, 0x83, 0x34, 0x34, 0x0e, 0x27, 0x68, 0x1f, 0xca, 0x9e, 0xc3, 0x7c,
-	0x81, 0x6e, 0x08, 0x74, 0x33, 0xde, 0x8f, 0xc3, 0xc9, 0xe1, 0x37, 0x12, 0x6c, 0x2d, 0x7b, 0x84,
-	0xea, 0xf0, 0xb4, 0xaf, 0x9d, 0x60, 0x1d, 0x9b, 0xd8, 0xd0, 0x2d, 0xf3, 0xc5, 0x50, 0xb3, 0xc6,
-	0xfa, 0x68, 0xa8, 0xf5, 0xf0, 0x09, 0xd6, 0xfa, 0x72, 0x0e, 0xbd, 0x07, 0xca, 0x2a, 0x61, 0x48,
-	0x8c, 0xa1, 0x31, 0xd2, 0xfa, 0xb2, 0x84, 0x9e, 0xc0, 0xde, 0x2a, 0x4a, 0xb4, 0x9e, 0x41, 0xfa,
-	0x72, 0x7e, 0x5d, 0xe9, 0x04, 0xb3, 0x06, 0x78, 0x64, 0xca, 0x85, 0xc3, 0x1f, 0xf3, 0x50, 0xb9,
-	0x77, 0x38, 0x2e, 0x35, 0x54, 0x89, 0xf9, 0x62, 0x5d, 0x13, 0xfb, 0xf0, 0x28, 0x83, 0x19, 0x04,
-	0x3f, 0xc3, 0xba, 0x6a, 0x1a, 0x44, 0x96, 0xd0, 0x63, 0xd8, 0xc9, 0x40, 0x23, 0x8d, 0x9c, 0xe1,
-	0x9e, 0x46, 0xe4, 0xfc, 0x0a, 0x80, 0xf5, 0x33, 0x6d, 0x14, 0x67, 0x14, 0x90, 0x02, 0xbb, 0x19,
-	0xa0, 0x37, 0x1e, 0x99, 0x46, 0x1f, 0xab, 0xba, 0x5c, 0x44, 0xbb, 0x20, 0x67, 0x8f, 0xf9, 0x5c,
-	0xd7, 0x88, 0xbc, 0xb1, 0xc2, 0x57, 0x4f, 0x4e, 0xf0, 0x00, 0xab, 0xa6, 0x26, 0x97, 0xd0, 0x1e,
-	0xa0, 0x2c, 0xff, 0xb9, 0x8e, 0xbb, 0xe3, 0x91, 0xbc, 0xb9, 0xd2, 0xee, 0x90, 0x18, 0x67, 0x9a,
-	0xae, 0xea, 0x3d, 0x4d, 0x2e, 0xaf, 0x40, 0x3d, 0x43, 0x37, 0x89, 0x31, 0x18, 0x68, 0x44, 0x86,
-	0x95, 0x73, 0xce, 0xd4, 0x01, 0xee, 0x8b, 0x19, 0xab, 0xdd, 0x57, 0x6f, 0x6e, 0x6a, 0xd2, 0xdb,
-	0x9b, 0x9a, 0xf4, 0xdb, 0x4d, 0x4d, 0xfa, 0xf6, 0xb6, 0x96, 0x7b, 0x7b, 0x5b, 0xcb, 0xfd, 0x7c,
-	0x5b, 0xcb, 0xc1, 0xbe, 0xc7, 0x1e, 0x78, 0x3b, 0x43, 0xe9, 0x8b, 0x4f, 0x33, 0xdf, 0xc4, 0x05,
-	0xe9, 0xc8, 0x63, 0x99, 0x5d, 0xfb, 0xcb, 0xc5, 0xaf, 0x04, 0xf1, 0x95, 0x3c, 0x2f, 0x89, 0xff,
-	0xf6, 0x9f, 0xfc, 0x15, 0x00, 0x00, 0xff, 0xff, 0xf1, 0xe1, 0xcd, 0x6d, 0x49, 0x08, 0x00, 0x00,
+	// 1042 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x56, 0x4f, 0x6f, 0xdb, 0xc6,
+	0x13, 0x15, 0x25, 0x59, 0x96, 0x46, 0x89, 0xcc, 0xac, 0x6c, 0x87, 0x4e, 0x7e, 0x3f, 0x89, 0x71,
+	0x81, 0x56, 0x30, 0x60, 0x09, 0x76, 0x7b, 0xea, 0xa1, 0x80, 0xfe, 0xd0, 0x36, 0x51, 0x99, 0x12,
+	0x96, 0xb2, 0x8b, 0xf4, 0x42, 0xd0, 0xe4, 0xda, 0x22, 0x22, 0x71, 0x09, 0x2e, 0xed, 0xd4, 0xb9,
+	0xb4, 0x1f, 0xa0, 0x87, 0x5e, 0x0a, 0xe4, 0x58, 0xa0, 0x40, 0x3f, 0x4b, 0x8e, 0x39, 0x16, 0x45,
+	0x61, 0x14, 0x36, 0xfa, 0x11, 0x7a, 0xe9, 0xa9, 0xd8, 0xa5, 0x1c, 0x51, 0x8a, 0x6c, 0xb4, 0x40,
+	0x8f, 0x3d, 0x89, 0x7c, 0xf3, 0x66, 0x76, 0xe6, 0xbd, 0x59, 0x42, 0xb0, 0x15, 0x84, 0xf4, 0x82,
+	0xf8, 0xb6, 0xef, 0x90, 0xc6, 0x98, 0x44, 0xb6, 0x6b, 0x47, 0x76, 0xe3, 0x62, 0xa7, 0xc1, 0x02,
+	0xe2, 0x78, 0xa7, 0x9e, 0x63, 0x47, 0x1e, 0xf5, 0xeb, 0x41, 0x48, 0x23, 0x8a, 0xd6, 0xa7, 0xdc,
+	0xfa, 0x2d, 0xb7, 0x7e, 0xb1, 0xf3, 0x64, 0xf5, 0x8c, 0x9e, 0x51, 0x41, 0x69, 0xf0, 0xa7, 0x98,
+	0xbd, 0xf9, 0x7b, 0x1a, 0x90, 0xe9, 0xd0, 0x80, 0x98, 0xc9, 0x52, 0xa8, 0x05, 0xf2, 0x4c, 0x6d,
+	0xcb, 0x73, 0x15, 0x49, 0x95, 0x6a, 0x0f, 0x5a, 0x8f, 0xdf, 0x5c, 0x55, 0x53, 0xbf, 0x5c, 0x55,
+	0x57, 0x0e, 0x27, 0xb5, 0x9b, 0xae, 0x1b, 0x12, 0xc6, 0xf0, 0xca, 0x4c, 0x82, 0xee, 0x22, 0x0d,
+	0x8a, 0x2e, 0x61, 0x4e, 0xe8, 0x05, 0x1c, 0x50, 0xd2, 0xaa, 0x54, 0x2b, 0xee, 0x7e, 0x50, 0x5f,
+	0xdc, 0x5e, 0xbd, 0x33, 0xa5, 0xe2, 0x64, 0x1e, 0xfa, 0x08, 0x56, 0xe8, 0x4b, 0x9f, 0x84, 0x96,
+	0x1d, 0x1f, 0x44, 0x98, 0x92, 0x51, 0x33, 0xb5, 0x02, 0x2e, 0x09, 0xb8, 0x79, 0x8b, 0xa2, 0x2e,
+	0xc8, 0x81, 0x1d, 0x46, 0x1e, 0x61, 0x96, 0xe7, 0x5f, 0xd0, 0xd1, 0x05, 0x71, 0x95, 0xac, 0x9a,
+	0xa9, 0x95, 0x76, 0x9f, 0xdd, 0x75, 0x68, 0xdf, 0x0e, 0xa3, 0xcb, 0xc1, 0x65, 0x40, 0xf0, 0xca,
+	0x24, 0x55, 0x9f, 0x64, 0xa2, 0x36, 0x3c, 0x72, 0xa8, 0x1f, 0x85, 0xb6, 0x13, 0x59, 0x7c, 0x32,
+	0xcb, 0x73, 0x99, 0xb2, 0xa4, 0x66, 0xee, 0x95, 0xe0, 0x36, 0x83, 0x8b, 0xa9, 0xbb, 0xec, 0xd3,
+	0xfc, 0xeb, 0x1f, 0xaa, 0xa9, 0x6f, 0x7e, 0x55, 0xa5, 0xcd, 0xef, 0x93, 0x3a, 0x1f, 0x7a, 0x67,
+	0x61, 0xac, 0xf3, 0xe7, 0xb0, 0x76, 0x1a, 0xd2, 0xb1, 0xf5, 0x4f, 0xc5, 0x2e, 0xf3, 0x2c, 0x73,
+	0x4e, 0xf0, 0x7d, 0x28, 0x47, 0xf4, 0xfd, 0x52, 0xe9, 0xfb, 0x4b, 0x3d, 0x8a, 0xe8, 0x7c, 0xa1,
+	0xff, 0x03, 0x9c, 0xd8, 0x91, 0x33, 0xb4, 0x98, 0xf7, 0x8a, 0x28, 0x19, 0x55, 0xaa, 0x3d, 0xc4,
+	0x05, 0x81, 0x98, 0xde, 0x2b, 0xc2, 0x1d, 0x61, 0x7c, 0x14, 0x66, 0x8d, 0xc5, 0x20, 0x42, 0x67,
+	0xa9, 0x96, 0xc5, 0xa5, 0x18, 0x3e, 0x9c, 0xa0, 0xe8, 0x19, 0x3c, 0x60, 0x91, 0x1d, 0x46, 0xd6,
+	0x90, 0x78, 0x67, 0xc3, 0x48, 0x59, 0x52, 0xa5, 0x5a, 0x06, 0x17, 0x05, 0x76, 0x20, 0xa0, 0xcd,
+	0xd7, 0x59, 0x58, 0x6b, 0x27, 0x54, 0xfb, 0x6f, 0x05, 0xa7, 0x2b, 0x38, 0x80, 0x62, 0x48, 0x18,
+	0x3d, 0x0f, 0x1d, 0xc2, 0x87, 0x5f, 0x12, 0xc3, 0xef, 0xfc, 0x79, 0x55, 0xdd, 0x3e, 0xf3, 0xa2,
+	0xe1, 0xf9, 0x49, 0xdd, 0xa1, 0xe3, 0x86, 0x43, 0xd9, 0x98, 0xb2, 0xc9, 0xcf, 0x36, 0x73, 0x5f,
+	0x34, 0xa2, 0xcb, 0x80, 0xb0, 0x7a, 0xd3, 0x71, 0x26, 0x7d, 0x1d, 0xa4, 0x30, 0xdc, 0xd6, 0xd1,
+	0x5d, 0xb4, 0x0a, 0xd9, 0xa1, 0xcd, 0x86, 0x4a, 0x4e, 0x95, 0x6a, 0x85, 0x83, 0x14, 0x16, 0x6f,
+	0xdc, 0x72, 0x67, 0x64, 0x33, 0x66, 0xf9, 0xf6, 0x98, 0x28, 0xcb, 0x3c, 0x86, 0x0b, 0x02, 0x31,
+	0xec, 0x31, 0x41, 0x15, 0x00, 0x97, 0x04, 0x21, 0x71, 0x84, 0xdb, 0x79, 0x55, 0xaa, 0xe5, 0x71,
+	0x02, 0x41, 0x9f, 0x41, 0x29, 0x24, 0xc1, 0xc8, 0x76, 0xc8, 0x98, 0xf8, 0x11, 0xef, 0xb6, 0x70,
+	0xbf, 0x55, 0x0f, 0x13, 0x74, 0xdd, 0x9d, 0x5e, 0x94, 0x56, 0x1e, 0x72, 0x71, 0xab, 0x9b, 0x7f,
+	0xa4, 0xa1, 0x8c, 0x89, 0x43, 0x43, 0xf7, 0xdf, 0x5f, 0x0c, 0x04, 0x59, 0x31, 0x68, 0x5a, 0x0c,
+	0x2a, 0x9e, 0x51, 0x0b, 0x72, 0x9e, 0x1f, 0x9c, 0x47, 0xb1, 0xb9, 0xc5, 0xdd, 0xad, 0xbb, 0x2c,
+	0xd3, 0x39, 0x6b, 0xa6, 0x27, 0x3c, 0xc9, 0x44, 0x4f, 0xa1, 0xc0, 0xe5, 0x8f, 0x55, 0xcc, 0x8a,
+	0xe2, 0x79, 0x0e, 0x08, 0x11, 0xf7, 0x85, 0x9f, 0xe7, 0xa3, 0xc8, 0xe2, 0x90, 0xf0, 0xb3, 0xb4,
+	0xfb, 0xe1, 0xdd, 0xdb, 0x78, 0xea, 0xf9, 0x1e, 0xaf, 0x2e, 0xb6, 0x03, 0xe2, 0x54, 0xfe, 0x8c,
+	0x30, 0x94, 0x43, 0xc2, 0x02, 0xea, 0x33, 0xef, 0x64, 0x44, 0xac, 0xc9, 0xde, 0x28, 0xb9, 0xbf,
+	0xbb, 0x69, 0x28, 0x91, 0xdd, 0x8f, 0x93, 0x13, 0x9f, 0xaa, 0x1f, 0x25, 0x40, 0xef, 0x8f, 0xf8,
+	0x4e, 0x32, 0x29, 0x21, 0xd9, 0xcc, 0xb8, 0xe9, 0xb9, 0x71, 0x77, 0xa1, 0x10, 0x0a, 0xfb, 0xb8,
+	0x41, 0x19, 0x61, 0x50, 0x79, 0x81, 0x39, 0x07, 0x29, 0x9c, 0x8f, 0x79, 0x89, 0xe5, 0xcc, 0x26,
+	0x97, 0x73, 0xe1, 0x76, 0x7c, 0x0d, 0xc5, 0xc4, 0x7d, 0x5d, 0xd8, 0x9d, 0x3a, 0x7b, 0xfb, 0x33,
+	0x22, 0x34, 0x73, 0xb1, 0xab, 0x50, 0x7c, 0x49, 0x4e, 0x98, 0x17, 0x11, 0xeb, 0x3c, 0x1c, 0x4d,
+	0x0c, 0x83, 0x09, 0x74, 0x14, 0x8e, 0xd0, 0x06, 0xe4, 0x3d, 0x87, 0xfa, 0x22, 0xba, 0x24, 0xa2,
+	0xcb, 0xfc, 0xfd, 0x28, 0x1c, 0x6d, 0x7d, 0x2b, 0x41, 0x69, 0xd6, 0x23, 0x54, 0x85, 0xa7, 0x1d,
+	0x6d, 0x4f, 0x37, 0xf4, 0x81, 0xde, 0x33, 0xac, 0xc1, 0xf3, 0xbe, 0x66, 0x1d, 0x19, 0x66, 0x5f,
+	0x6b, 0xeb, 0x7b, 0xba, 0xd6, 0x91, 0x53, 0xe8, 0x7f, 0xa0, 0xcc, 0x13, 0xfa, 0xb8, 0xd7, 0xef,
+	0x99, 0x5a, 0x47, 0x96, 0xd0, 0x13, 0x58, 0x9f, 0x8f, 0x62, 0xad, 0xdd, 0xc3, 0x1d, 0x39, 0xbd,
+	0xa8, 0x74, 0x1c, 0xb3, 0xba, 0xba, 0x39, 0x90, 0x33, 0x5b, 0x3f, 0xa5, 0xa1, 0xf0, 0xce, 0x61,
+	0x5e, 0xaa, 0xdf, 0xc4, 0x83, 0xe7, 0x8b, 0x9a, 0xd8, 0x80, 0xb5, 0x44, 0xac, 0x87, 0xf5, 0x7d,
+	0xdd, 0x68, 0x0e, 0x7a, 0x58, 0x96, 0xd0, 0x63, 0x28, 0x27, 0x42, 0xa6, 0x86, 0x8f, 0xf5, 0xb6,
+	0x86, 0xe5, 0xf4, 0x5c, 0x40, 0x37, 0x8e, 0x35, 0x93, 0x67, 0x64, 0x90, 0x02, 0xab, 0x89, 0x40,
+	0xfb, 0xc8, 0x1c, 0xf4, 0x3a, 0x7a, 0xd3, 0x90, 0xb3, 0x68, 0x15, 0xe4, 0xe4, 0x31, 0x5f, 0x18,
+	0x1a, 0x96, 0x97, 0xe6, 0xf8, 0xcd, 0xbd, 0x3d, 0xbd, 0xab, 0x37, 0x07, 0x9a, 0x9c, 0x43, 0xeb,
+	0x80, 0x92, 0xfc, 0x43, 0x43, 0x6f, 0x1d, 0x99, 0xf2, 0xf2, 0x5c, 0xbb, 0x7d, 0xdc, 0x3b, 0xd6,
+	0x8c, 0xa6, 0xd1, 0xd6, 0xe4, 0xfc, 0x5c, 0xa8, 0xdd, 0x33, 0x06, 0xb8, 0xd7, 0xed, 0x6a, 0x58,
+	0x86, 0xb9, 0x73, 0x8e, 0x9b, 0x5d, 0xbd, 0x23, 0x66, 0x2c, 0xb6, 0x5e, 0xbc, 0xb9, 0xae, 0x48,
+	0x6f, 0xaf, 0x2b, 0xd2, 0x6f, 0xd7, 0x15, 0xe9, 0xbb, 0x9b, 0x4a, 0xea, 0xed, 0x4d, 0x25, 0xf5,
+	0xf3, 0x4d, 0x25, 0x05, 0x1b, 0x1e, 0xbd, 0xe3, 0xee, 0xf4, 0xa5, 0x2f, 0x3f, 0x49, 0x7c, 0x73,
+	0xa7, 0xa4, 0x6d, 0x8f, 0x26, 0xde, 0x1a, 0x5f, 0x4d, 0xff, 0x9d, 0x89, 0xaf, 0xf0, 0x49, 0x4e,
+	0xfc, 0xcb, 0xfa, 0xf8, 0xaf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xa8, 0xff, 0x9b, 0xc9, 0xc1, 0x09,
+	0x00, 0x00,
 }
 
 func (m *ScopeSpecification) Marshal() (dAtA []byte, err error) {
@@ -737,6 +829,64 @@ func (m *ScopeSpecification) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ScopeSpecMigration) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScopeSpecMigration) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScopeSpecMigration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.StartHeight != 0 {
+		i = encodeVarintSpecification(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ScopesMigrated != 0 {
+		i = encodeVarintSpecification(dAtA, i, uint64(m.ScopesMigrated))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.BatchSize != 0 {
+		i = encodeVarintSpecification(dAtA, i, uint64(m.BatchSize))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.ToSpecificationId.Size()
+		i -= size
+		if _, err := m.ToSpecificationId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSpecification(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.FromSpecificationId.Size()
+		i -= size
+		if _, err := m.FromSpecificationId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSpecification(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *ContractSpecification) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -757,6 +907,26 @@ func (m *ContractSpecification) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.ReplacementId.Size()
+		i -= size
+		if _, err := m.ReplacementId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSpecification(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if m.Deprecated {
+		i--
+		if m.Deprecated {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
 	if len(m.ClassName) > 0 {
 		i -= len(m.ClassName)
 		copy(dAtA[i:], m.ClassName)
@@ -1114,6 +1284,28 @@ func (m *ScopeSpecification) Size() (n int) {
 	return n
 }
 
+func (m *ScopeSpecMigration) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.FromSpecificationId.Size()
+	n += 1 + l + sovSpecification(uint64(l))
+	l = m.ToSpecificationId.Size()
+	n += 1 + l + sovSpecification(uint64(l))
+	if m.BatchSize != 0 {
+		n += 1 + sovSpecification(uint64(m.BatchSize))
+	}
+	if m.ScopesMigrated != 0 {
+		n += 1 + sovSpecification(uint64(m.ScopesMigrated))
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovSpecification(uint64(m.StartHeight))
+	}
+	return n
+}
+
 func (m *ContractSpecification) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1146,6 +1338,11 @@ func (m *ContractSpecification) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovSpecification(uint64(l))
 	}
+	if m.Deprecated {
+		n += 2
+	}
+	l = m.ReplacementId.Size()
+	n += 1 + l + sovSpecification(uint64(l))
 	return n
 }
 
@@ -1302,6 +1499,8 @@ func (this *ContractSpecification) String() string {
 		`PartiesInvolved:` + fmt.Sprintf("%v", this.PartiesInvolved) + `,`,
 		`Source:` + fmt.Sprintf("%v", this.Source) + `,`,
 		`ClassName:` + fmt.Sprintf("%v", this.ClassName) + `,`,
+		`Deprecated:` + fmt.Sprintf("%v", this.Deprecated) + `,`,
+		`ReplacementId:` + fmt.Sprintf("%v", this.ReplacementId) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -1641,6 +1840,179 @@ func (m *ScopeSpecification) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ScopeSpecMigration) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSpecification
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScopeSpecMigration: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScopeSpecMigration: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromSpecificationId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSpecification
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSpecification
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSpecification
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FromSpecificationId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToSpecificationId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSpecification
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSpecification
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSpecification
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ToSpecificationId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchSize", wireType)
+			}
+			m.BatchSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSpecification
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BatchSize |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopesMigrated", wireType)
+			}
+			m.ScopesMigrated = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSpecification
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ScopesMigrated |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSpecification
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSpecification(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSpecification
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *ContractSpecification) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1937,6 +2309,59 @@ func (m *ContractSpecification) Unmarshal(dAtA []byte) error {
 			}
 			m.ClassName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deprecated", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSpecification
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Deprecated = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplacementId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSpecification
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSpecification
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSpecification
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReplacementId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSpecification(dAtA[iNdEx:])
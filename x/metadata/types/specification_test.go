@@ -235,6 +235,20 @@ func (m *WeirdSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 
 func (s *SpecificationTestSuite) TestContractSpecValidateBasic() {
 	contractSpecUuid1 := uuid.New()
+	withReplacement := func(deprecated bool, replacementID MetadataAddress) *ContractSpecification {
+		spec := NewContractSpecification(
+			ContractSpecMetadataAddress(contractSpecUuid1),
+			nil,
+			[]string{specTestBech32},
+			[]PartyType{PartyType_PARTY_TYPE_OWNER},
+			NewContractSpecificationSourceHash("somehash"),
+			"someclass",
+		)
+		spec.Deprecated = deprecated
+		spec.ReplacementId = replacementID
+		return spec
+	}
+	scopeSpecID := ScopeSpecMetadataAddress(uuid.New())
 	tests := []struct {
 		name string
 		spec *ContractSpecification
@@ -423,6 +437,33 @@ func (s *SpecificationTestSuite) TestContractSpecValidateBasic() {
 			"",
 		},
 
+		// Deprecated and ReplacementId tests
+		{
+			"ReplacementId - not deprecated",
+			withReplacement(false, ContractSpecMetadataAddress(uuid.New())),
+			"replacement id can only be set on a deprecated contract specification",
+		},
+		{
+			"ReplacementId - not a contract spec id",
+			withReplacement(true, scopeSpecID),
+			fmt.Sprintf("invalid replacement id: %s is not a contract specification id", scopeSpecID),
+		},
+		{
+			"ReplacementId - same as specification id",
+			withReplacement(true, ContractSpecMetadataAddress(contractSpecUuid1)),
+			"a contract specification cannot be its own replacement",
+		},
+		{
+			"ReplacementId - valid",
+			withReplacement(true, ContractSpecMetadataAddress(uuid.New())),
+			"",
+		},
+		{
+			"Deprecated - without replacement",
+			withReplacement(true, nil),
+			"",
+		},
+
 		// A simple valid ContractSpecification
 		{
 			"simple valid test case",
@@ -1195,6 +1236,8 @@ func (s *SpecificationTestSuite) TestContractSpecString() {
 		"PartiesInvolved:[PARTY_TYPE_OWNER]," +
 		"Source:<nil>," +
 		"ClassName:CS 201: Intro to Blockchain," +
+		"Deprecated:false," +
+		"ReplacementId:," +
 		"}"
 	var actual string
 	testFunc := func() {
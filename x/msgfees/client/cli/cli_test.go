@@ -345,4 +345,67 @@ func (s *IntegrationTestSuite) TestUpdateConversionFeeDenomProposal() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestUpdateMsgPrioritiesProposal() {
+	testCases := []struct {
+		name         string
+		args         []string
+		expectErrMsg string
+		expectedCode uint32
+		expInRawLog  []string
+		signer       string
+	}{
+		{
+			name:         "success - set msg priorities",
+			args:         []string{"/cosmos.bank.v1beta1.MsgSend=100", "/cosmos.bank.v1beta1.MsgMultiSend=50"},
+			expectedCode: 0,
+			signer:       s.accountAddresses[0].String(),
+		},
+		{
+			name:         "success - remove all msg priorities",
+			args:         []string{},
+			expectedCode: 0,
+			signer:       s.accountAddresses[0].String(),
+		},
+		{
+			name:         "failure - no priority",
+			args:         []string{"/cosmos.bank.v1beta1.MsgSend"},
+			expectErrMsg: `invalid msg priority "/cosmos.bank.v1beta1.MsgSend": expected format <msg-type-url>=<priority>`,
+			signer:       s.accountAddresses[0].String(),
+		},
+		{
+			name:         "failure - invalid priority",
+			args:         []string{"/cosmos.bank.v1beta1.MsgSend=high"},
+			expectErrMsg: `invalid msg priority "/cosmos.bank.v1beta1.MsgSend=high": strconv.ParseInt: parsing "high": invalid syntax`,
+			signer:       s.accountAddresses[0].String(),
+		},
+		{
+			name:         "failure - zero priority",
+			args:         []string{"/cosmos.bank.v1beta1.MsgSend=0"},
+			expectedCode: 12,
+			expInRawLog:  []string{`invalid msg priority 0 for "/cosmos.bank.v1beta1.MsgSend": must be positive`},
+			signer:       s.accountAddresses[0].String(),
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			cmd := cli.GetUpdateMsgPrioritiesProposal()
+			tc.args = append(tc.args,
+				"--title", "Update msg priorities proposal", "--summary", "Updates the msg priorities.",
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, tc.signer),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewInt64Coin(s.cfg.BondDenom, 10)).String()),
+				fmt.Sprintf("--%s=json", cmtcli.OutputFlag),
+			)
+
+			testcli.NewTxExecutor(cmd, tc.args).
+				WithExpErrMsg(tc.expectErrMsg).
+				WithExpCode(tc.expectedCode).
+				WithExpInRawLog(tc.expInRawLog).
+				Execute(s.T(), s.testnet)
+		})
+	}
+}
+
 // TODO: Add query tests
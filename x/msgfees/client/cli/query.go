@@ -8,6 +8,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
 
 	"github.com/provenance-io/provenance/x/msgfees/types"
 )
@@ -24,6 +25,7 @@ func GetQueryCmd() *cobra.Command {
 	}
 	queryCmd.AddCommand(
 		AllMsgFeesCmd(),
+		MsgFeeScheduleCmd(),
 		ListParamsCmd(),
 	)
 	return queryCmd
@@ -65,6 +67,43 @@ func AllMsgFeesCmd() *cobra.Command {
 	return cmd
 }
 
+// MsgFeeScheduleCmd is the CLI command for listing the current msg fees along with the pending changes to them.
+func MsgFeeScheduleCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "schedule [<msg-type-url>]",
+		Aliases: []string{"s"},
+		Short:   "List the current msg fees and the pending changes to them on the Provenance Blockchain",
+		Long: `List the current msg fees and the pending changes to them on the Provenance Blockchain.
+Pending changes have been approved by governance and will take effect at their effective height or time.
+Provide a msg type url to only list the fees and changes for that msg type.`,
+		Example: fmt.Sprintf(`$ %[1]s query msgfees schedule
+$ %[1]s query msgfees schedule /provenance.metadata.v1.MsgWriteRecordRequest
+`, version.AppName),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryMsgFeeScheduleRequest{}
+			if len(args) > 0 {
+				req.MsgTypeUrl = args[0]
+			}
+
+			response, err := queryClient.MsgFeeSchedule(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(response)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 // ListParamsCmd is the CLI command for listing all params.
 func ListParamsCmd() *cobra.Command {
 	cmd := &cobra.Command{
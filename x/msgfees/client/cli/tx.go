@@ -4,8 +4,10 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -23,6 +25,10 @@ const (
 	FlagMsgType   = "msg-type"
 	FlagRecipient = "recipient"
 	FlagBips      = "bips"
+	FlagContract  = "contract"
+
+	FlagEffectiveHeight = "effective-height"
+	FlagEffectiveTime   = "effective-time"
 )
 
 func NewTxCmd() *cobra.Command {
@@ -39,6 +45,7 @@ func NewTxCmd() *cobra.Command {
 		GetCmdMsgFeesProposal(),
 		GetUpdateNhashPerUsdMilProposal(),
 		GetUpdateConversionFeeDenomProposal(),
+		GetUpdateMsgPrioritiesProposal(),
 	)
 
 	return txCmd
@@ -52,10 +59,15 @@ func GetCmdMsgFeesProposal() *cobra.Command {
 		Short:   "Submit a msg based fee proposal along with an initial deposit",
 		Long: strings.TrimSpace(`Submit a msg fees proposal along with an initial deposit.
 For add, update, and removal of msg fees amount and min fee and/or rate fee must be set.
+By default, the change takes effect as soon as the proposal passes. Provide either --effective-height or
+--effective-time (RFC 3339) to have it take effect later instead.
 `),
 		Example: fmt.Sprintf(`$ %[1]s tx msgfees add --msg-type=/provenance.metadata.v1.MsgWriteRecordRequest --additional-fee=612nhash --recipient=pb... --bips=5000 --deposit 1000000000nhash
 $ %[1]s tx msgfees update --msg-type=/provenance.metadata.v1.MsgWriteRecordRequest --additional-fee=612000nhash --recipient=pb... --bips=5000 --deposit 1000000000nhash
 $ %[1]s tx msgfees remove --msg-type=/provenance.metadata.v1.MsgWriteRecordRequest --deposit 1000000000nhash
+$ %[1]s tx msgfees add --msg-type=/cosmwasm.wasm.v1.MsgExecuteContract --contract=pb... --additional-fee=612nhash --deposit 1000000000nhash
+$ %[1]s tx msgfees update --msg-type=/provenance.metadata.v1.MsgWriteRecordRequest --additional-fee=700nhash --effective-height=1500000 --deposit 1000000000nhash
+$ %[1]s tx msgfees remove --msg-type=/provenance.metadata.v1.MsgWriteRecordRequest --effective-time=2026-01-01T00:00:00Z --deposit 1000000000nhash
 `, version.AppName),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
@@ -77,6 +89,14 @@ $ %[1]s tx msgfees remove --msg-type=/provenance.metadata.v1.MsgWriteRecordReque
 				return err
 			}
 
+			contract, err := flagSet.GetString(FlagContract)
+			if err != nil {
+				return err
+			}
+			if err = types.ValidateContractAddress(msgType, contract); err != nil {
+				return err
+			}
+
 			recipient, err := flagSet.GetString(FlagRecipient)
 			if err != nil {
 				return err
@@ -107,14 +127,32 @@ $ %[1]s tx msgfees remove --msg-type=/provenance.metadata.v1.MsgWriteRecordReque
 				}
 			}
 
+			effectiveHeight, err := flagSet.GetInt64(FlagEffectiveHeight)
+			if err != nil {
+				return err
+			}
+			effectiveTime, err := getEffectiveTime(flagSet)
+			if err != nil {
+				return err
+			}
+			if err = types.ValidateEffective(effectiveHeight, effectiveTime); err != nil {
+				return err
+			}
+
 			var msg sdk.Msg
 			switch args[0] {
 			case "add":
-				msg = types.NewMsgAddMsgFeeProposalRequest(msgType, addFee, recipient, bips, authority)
+				addMsg := types.NewMsgAddMsgFeeProposalRequest(msgType, contract, addFee, recipient, bips, authority)
+				addMsg.EffectiveHeight, addMsg.EffectiveTime = effectiveHeight, effectiveTime
+				msg = addMsg
 			case "update":
-				msg = types.NewMsgUpdateMsgFeeProposalRequest(msgType, addFee, recipient, bips, authority)
+				updateMsg := types.NewMsgUpdateMsgFeeProposalRequest(msgType, contract, addFee, recipient, bips, authority)
+				updateMsg.EffectiveHeight, updateMsg.EffectiveTime = effectiveHeight, effectiveTime
+				msg = updateMsg
 			case "remove":
-				msg = types.NewMsgRemoveMsgFeeProposalRequest(msgType, authority)
+				removeMsg := types.NewMsgRemoveMsgFeeProposalRequest(msgType, contract, authority)
+				removeMsg.EffectiveHeight, removeMsg.EffectiveTime = effectiveHeight, effectiveTime
+				msg = removeMsg
 			default:
 				return fmt.Errorf("unknown proposal type %q", args[0])
 			}
@@ -128,9 +166,25 @@ $ %[1]s tx msgfees remove --msg-type=/provenance.metadata.v1.MsgWriteRecordReque
 	cmd.Flags().String(FlagMinFee, "", "additional fee for msg based fee")
 	cmd.Flags().String(FlagRecipient, "", "optional recipient address for receiving partial fee based on basis points")
 	cmd.Flags().String(FlagBips, "", "basis fee points to distribute to recipient")
+	cmd.Flags().String(FlagContract, "", "optional wasm contract address to limit a MsgExecuteContract fee to that contract")
+	cmd.Flags().Int64(FlagEffectiveHeight, 0, "optional block height at which the change takes effect")
+	cmd.Flags().String(FlagEffectiveTime, "", "optional block time (RFC 3339) at or after which the change takes effect")
 	return cmd
 }
 
+// getEffectiveTime gets the effective time flag value, or nil if it wasn't provided.
+func getEffectiveTime(flagSet *pflag.FlagSet) (*time.Time, error) {
+	value, err := flagSet.GetString(FlagEffectiveTime)
+	if err != nil || len(value) == 0 {
+		return nil, err
+	}
+	rv, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid --%s %q: %w", FlagEffectiveTime, value, err)
+	}
+	return &rv, nil
+}
+
 func GetUpdateNhashPerUsdMilProposal() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:     "nhash-per-usd-mil <nhash-per-usd-mil>",
@@ -194,3 +248,55 @@ $ %[1]s tx msgfees cfd customcoin --deposit 1000000000nhash
 	provcli.AddAuthorityFlagToCmd(cmd)
 	return cmd
 }
+
+func GetUpdateMsgPrioritiesProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "msg-priorities [<msg-type-url>=<priority> ...]",
+		Aliases: []string{"mp", "m-p"},
+		Args:    cobra.ArbitraryArgs,
+		Short:   "Submit a proposal to update the mempool priorities of msg types along with an initial deposit",
+		Long: strings.TrimSpace(`Submit a proposal to update the mempool priorities of msg types along with an initial deposit.
+The provided priorities replace the current ones. Provide none of them to remove all priorities.
+Each priority must be a positive integer. Txs with a higher priority are included in blocks first.`),
+		Example: fmt.Sprintf(`$ %[1]s tx msgfees msg-priorities /provenance.marker.v1.MsgTransferRequest=100 --deposit 1000000000nhash
+$ %[1]s tx msgfees mp --deposit 1000000000nhash
+`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			flagSet := cmd.Flags()
+			authority := provcli.GetAuthority(flagSet)
+
+			msgPriorities, err := parseMsgPriorities(args)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUpdateMsgPrioritiesProposalRequest(msgPriorities, authority)
+			return provcli.GenerateOrBroadcastTxCLIAsGovProp(clientCtx, flagSet, msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	govcli.AddGovPropFlagsToCmd(cmd)
+	provcli.AddAuthorityFlagToCmd(cmd)
+	return cmd
+}
+
+// parseMsgPriorities parses <msg-type-url>=<priority> args into msg priorities.
+func parseMsgPriorities(args []string) ([]types.MsgPriority, error) {
+	var rv []types.MsgPriority
+	for _, arg := range args {
+		parts := strings.Split(arg, "=")
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid msg priority %q: expected format <msg-type-url>=<priority>", arg)
+		}
+		priority, err := strconv.ParseInt(parts[1], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid msg priority %q: %w", arg, err)
+		}
+		rv = append(rv, types.NewMsgPriority(parts[0], priority))
+	}
+	return rv, nil
+}
@@ -17,7 +17,11 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 	if err := k.IterateMsgFees(ctx, msgFeeRecords); err != nil {
 		panic(err)
 	}
-	return types.NewGenesisState(params, msgFees)
+	pendingChanges, err := k.getAllPendingMsgFeeChanges(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return types.NewGenesisState(params, msgFees, pendingChanges)
 }
 
 // InitGenesis new msgfees genesis
@@ -32,4 +36,5 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *types.GenesisState) {
 			panic(err)
 		}
 	}
+	k.initPendingMsgFeeChanges(ctx, data.PendingChanges)
 }
@@ -7,6 +7,8 @@ import (
 
 	"golang.org/x/exp/constraints"
 
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+
 	"cosmossdk.io/log"
 	sdkmath "cosmossdk.io/math"
 	storetypes "cosmossdk.io/store/types"
@@ -19,6 +21,7 @@ import (
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
+	"github.com/provenance-io/provenance/internal/provutils"
 	"github.com/provenance-io/provenance/x/msgfees/types"
 )
 
@@ -68,25 +71,50 @@ func (k Keeper) GetAuthority() string {
 
 // Logger returns a module-specific logger.
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
-	return ctx.Logger().With("module", "x/"+types.ModuleName)
+	return provutils.ModuleLogger(ctx, types.ModuleName)
 }
 
 func (k Keeper) GetFeeCollectorName() string {
 	return k.feeCollectorName
 }
 
+// msgFeeKey returns the store key of the MsgFee for the msg type and (optional) contract address.
+func msgFeeKey(msgType, contractAddress string) ([]byte, error) {
+	if len(contractAddress) == 0 {
+		return types.GetMsgFeeKey(msgType), nil
+	}
+	contract, err := sdk.AccAddressFromBech32(contractAddress)
+	if err != nil {
+		return nil, fmt.Errorf("invalid contract address %q: %w", contractAddress, err)
+	}
+	return types.GetContractMsgFeeKey(msgType, contract), nil
+}
+
 // SetMsgFee sets the additional fee schedule for a Msg
 func (k Keeper) SetMsgFee(ctx sdk.Context, msgFees types.MsgFee) error {
+	key, err := msgFeeKey(msgFees.MsgTypeUrl, msgFees.ContractAddress)
+	if err != nil {
+		return err
+	}
 	store := ctx.KVStore(k.storeKey)
 	bz := k.cdc.MustMarshal(&msgFees)
-	store.Set(types.GetMsgFeeKey(msgFees.MsgTypeUrl), bz)
+	store.Set(key, bz)
 	return nil
 }
 
 // GetMsgFee returns a MsgFee for the msg type if it exists nil if it does not
 func (k Keeper) GetMsgFee(ctx sdk.Context, msgType string) (*types.MsgFee, error) {
+	return k.GetContractMsgFee(ctx, msgType, "")
+}
+
+// GetContractMsgFee returns the MsgFee for the msg type that is specific to the given contract address,
+// or nil if there isn't one. If the contract address is empty, the fee for the msg type alone is returned.
+func (k Keeper) GetContractMsgFee(ctx sdk.Context, msgType, contractAddress string) (*types.MsgFee, error) {
+	key, err := msgFeeKey(msgType, contractAddress)
+	if err != nil {
+		return nil, err
+	}
 	store := ctx.KVStore(k.storeKey)
-	key := types.GetMsgFeeKey(msgType)
 	bz := store.Get(key)
 	if len(bz) == 0 {
 		return nil, nil
@@ -101,9 +129,12 @@ func (k Keeper) GetMsgFee(ctx sdk.Context, msgType string) (*types.MsgFee, error
 }
 
 // RemoveMsgFee removes MsgFee or returns an error if it does not exist
-func (k Keeper) RemoveMsgFee(ctx sdk.Context, msgType string) error {
+func (k Keeper) RemoveMsgFee(ctx sdk.Context, msgType, contractAddress string) error {
+	key, err := msgFeeKey(msgType, contractAddress)
+	if err != nil {
+		return err
+	}
 	store := ctx.KVStore(k.storeKey)
-	key := types.GetMsgFeeKey(msgType)
 	bz := store.Get(key)
 	if len(bz) == 0 {
 		return types.ErrMsgFeeDoesNotExist
@@ -201,7 +232,7 @@ func (k Keeper) CalculateAdditionalFeesToBePaid(ctx sdk.Context, msgs ...sdk.Msg
 	assessCustomMsgTypeURL := sdk.MsgTypeURL(&types.MsgAssessCustomMsgFeeRequest{})
 	for _, msg := range msgs {
 		typeURL := sdk.MsgTypeURL(msg)
-		msgFees, err := k.GetMsgFee(ctx, typeURL)
+		msgFees, err := k.getMsgFeeFor(ctx, typeURL, msg)
 		if err != nil {
 			return msgFeesDistribution, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
 		}
@@ -234,6 +265,21 @@ func (k Keeper) CalculateAdditionalFeesToBePaid(ctx sdk.Context, msgs ...sdk.Msg
 	return msgFeesDistribution, nil
 }
 
+// getMsgFeeFor returns the MsgFee that applies to the given msg. For a MsgExecuteContract,
+// a fee specific to the contract being executed is used in place of the msg type's fee.
+func (k Keeper) getMsgFeeFor(ctx sdk.Context, typeURL string, msg sdk.Msg) (*types.MsgFee, error) {
+	if execMsg, ok := msg.(*wasmtypes.MsgExecuteContract); ok && len(execMsg.Contract) > 0 {
+		// If the contract address is invalid, the msg will fail anyway, so just fall back to the msg type's fee.
+		if _, err := sdk.AccAddressFromBech32(execMsg.Contract); err == nil {
+			contractFee, err := k.GetContractMsgFee(ctx, typeURL, execMsg.Contract)
+			if err != nil || contractFee != nil {
+				return contractFee, err
+			}
+		}
+	}
+	return k.GetMsgFee(ctx, typeURL)
+}
+
 // sortedKeys gets the keys of a map, sorts them and returns them as a slice.
 func sortedKeys[K constraints.Ordered, V any](m map[K]V) []K {
 	keys := make([]K, 0, len(m))
@@ -247,12 +293,15 @@ func sortedKeys[K constraints.Ordered, V any](m map[K]V) []K {
 }
 
 // AddMsgFee adds a new msg fees
-func (k Keeper) AddMsgFee(ctx sdk.Context, msgTypeURL, recipient, basisPoints string, additionalFee sdk.Coin) error {
+func (k Keeper) AddMsgFee(ctx sdk.Context, msgTypeURL, contractAddress, recipient, basisPoints string, additionalFee sdk.Coin) error {
 	if msgTypeURL == "" {
 		return types.ErrEmptyMsgType
 	}
+	if err := types.ValidateContractAddress(msgTypeURL, contractAddress); err != nil {
+		return types.ErrInvalidFeeProposal.Wrap(err.Error())
+	}
 
-	existing, err := k.GetMsgFee(ctx, msgTypeURL)
+	existing, err := k.GetContractMsgFee(ctx, msgTypeURL, contractAddress)
 	if err != nil {
 		return err
 	}
@@ -264,7 +313,7 @@ func (k Keeper) AddMsgFee(ctx sdk.Context, msgTypeURL, recipient, basisPoints st
 		return err
 	}
 
-	msgFees := types.NewMsgFee(msgTypeURL, additionalFee, recipient, bips)
+	msgFees := types.NewContractMsgFee(msgTypeURL, contractAddress, additionalFee, recipient, bips)
 
 	err = k.SetMsgFee(ctx, msgFees)
 	if err != nil {
@@ -275,12 +324,15 @@ func (k Keeper) AddMsgFee(ctx sdk.Context, msgTypeURL, recipient, basisPoints st
 }
 
 // UpdateMsgFee updates  an existing msg fees
-func (k Keeper) UpdateMsgFee(ctx sdk.Context, msgTypeURL, recipient, basisPoints string, additionalFee sdk.Coin) error {
+func (k Keeper) UpdateMsgFee(ctx sdk.Context, msgTypeURL, contractAddress, recipient, basisPoints string, additionalFee sdk.Coin) error {
 	if msgTypeURL == "" {
 		return types.ErrEmptyMsgType
 	}
+	if err := types.ValidateContractAddress(msgTypeURL, contractAddress); err != nil {
+		return types.ErrInvalidFeeProposal.Wrap(err.Error())
+	}
 
-	existing, err := k.GetMsgFee(ctx, msgTypeURL)
+	existing, err := k.GetContractMsgFee(ctx, msgTypeURL, contractAddress)
 	if err != nil {
 		return err
 	}
@@ -292,7 +344,7 @@ func (k Keeper) UpdateMsgFee(ctx sdk.Context, msgTypeURL, recipient, basisPoints
 		return err
 	}
 
-	msgFees := types.NewMsgFee(msgTypeURL, additionalFee, recipient, bips)
+	msgFees := types.NewContractMsgFee(msgTypeURL, contractAddress, additionalFee, recipient, bips)
 
 	err = k.SetMsgFee(ctx, msgFees)
 	if err != nil {
@@ -18,6 +18,8 @@ import (
 	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	cmttime "github.com/cometbft/cometbft/types/time"
 
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+
 	simapp "github.com/provenance-io/provenance/app"
 	"github.com/provenance-io/provenance/internal/pioconfig"
 	msgfeeskeeper "github.com/provenance-io/provenance/x/msgfees/keeper"
@@ -71,13 +73,13 @@ func (s *TestSuite) TestKeeper() {
 	s.Require().Nil(err)
 	s.Require().Nil(msgFee)
 
-	err = app.MsgFeesKeeper.RemoveMsgFee(ctx, bankSendAuthMsgType)
+	err = app.MsgFeesKeeper.RemoveMsgFee(ctx, bankSendAuthMsgType, "")
 	s.Require().Nil(err)
 	msgFee, err = app.MsgFeesKeeper.GetMsgFee(ctx, bankSendAuthMsgType)
 	s.Require().Nil(msgFee)
 	s.Require().Nil(err)
 
-	err = app.MsgFeesKeeper.RemoveMsgFee(ctx, "does-not-exist")
+	err = app.MsgFeesKeeper.RemoveMsgFee(ctx, "does-not-exist", "")
 	s.Require().ErrorIs(err, types.ErrMsgFeeDoesNotExist)
 
 }
@@ -387,6 +389,64 @@ func (s *TestSuite) TestCalculateAdditionalFeesToBePaid() {
 	})
 }
 
+func (s *TestSuite) TestContractMsgFees() {
+	execTypeURL := sdk.MsgTypeURL(&wasmtypes.MsgExecuteContract{})
+	s.Require().Equal(types.ExecuteContractMsgTypeURL, execTypeURL, "ExecuteContractMsgTypeURL")
+
+	sender := s.addrs[0].String()
+	pricedContract := sdk.AccAddress("priced_contract_____").String()
+	otherContract := sdk.AccAddress("other_contract______").String()
+	typeFee := sdk.NewInt64Coin("nhash", 1000)
+	contractFee := sdk.NewInt64Coin("nhash", 5000)
+
+	s.Require().NoError(s.app.MsgFeesKeeper.AddMsgFee(s.ctx, execTypeURL, "", "", "", typeFee), "AddMsgFee(type)")
+	s.Require().NoError(s.app.MsgFeesKeeper.AddMsgFee(s.ctx, execTypeURL, pricedContract, "", "", contractFee), "AddMsgFee(contract)")
+
+	s.Run("contract fee cannot be added for another msg type", func() {
+		err := s.app.MsgFeesKeeper.AddMsgFee(s.ctx, bankSendAuthMsgType, pricedContract, "", "", contractFee)
+		s.Assert().EqualError(err, "contract address can only be provided with msg type "+execTypeURL+": invalid fee proposal")
+	})
+
+	s.Run("contract fee cannot be added twice", func() {
+		err := s.app.MsgFeesKeeper.AddMsgFee(s.ctx, execTypeURL, pricedContract, "", "", contractFee)
+		s.Assert().ErrorIs(err, types.ErrMsgFeeAlreadyExists)
+	})
+
+	s.Run("both fees are stored separately", func() {
+		msgFee, err := s.app.MsgFeesKeeper.GetMsgFee(s.ctx, execTypeURL)
+		s.Require().NoError(err, "GetMsgFee")
+		s.Assert().Equal(types.NewMsgFee(execTypeURL, typeFee, "", 0), *msgFee, "msg type fee")
+		msgFee, err = s.app.MsgFeesKeeper.GetContractMsgFee(s.ctx, execTypeURL, pricedContract)
+		s.Require().NoError(err, "GetContractMsgFee")
+		s.Assert().Equal(types.NewContractMsgFee(execTypeURL, pricedContract, contractFee, "", 0), *msgFee, "contract fee")
+
+		var count int
+		s.Require().NoError(s.app.MsgFeesKeeper.IterateMsgFees(s.ctx, func(msgFees types.MsgFee) bool {
+			if msgFees.MsgTypeUrl == execTypeURL {
+				count++
+			}
+			return false
+		}), "IterateMsgFees")
+		s.Assert().Equal(2, count, "number of MsgExecuteContract fees")
+	})
+
+	s.Run("contract fee replaces the msg type fee", func() {
+		pricedExec := &wasmtypes.MsgExecuteContract{Sender: sender, Contract: pricedContract}
+		otherExec := &wasmtypes.MsgExecuteContract{Sender: sender, Contract: otherContract}
+		actual, err := s.app.MsgFeesKeeper.CalculateAdditionalFeesToBePaid(s.ctx, pricedExec, otherExec)
+		s.Require().NoError(err, "CalculateAdditionalFeesToBePaid")
+		s.Assert().Equal(sdk.NewCoins(contractFee.Add(typeFee)).String(), actual.TotalAdditionalFees.String(), "TotalAdditionalFees")
+	})
+
+	s.Run("removing the contract fee", func() {
+		s.Require().NoError(s.app.MsgFeesKeeper.RemoveMsgFee(s.ctx, execTypeURL, pricedContract), "RemoveMsgFee")
+		pricedExec := &wasmtypes.MsgExecuteContract{Sender: sender, Contract: pricedContract}
+		actual, err := s.app.MsgFeesKeeper.CalculateAdditionalFeesToBePaid(s.ctx, pricedExec)
+		s.Require().NoError(err, "CalculateAdditionalFeesToBePaid")
+		s.Assert().Equal(sdk.NewCoins(typeFee).String(), actual.TotalAdditionalFees.String(), "TotalAdditionalFees")
+	})
+}
+
 func (s *TestSuite) TestAddMsgFee() {
 	testCases := []struct {
 		name          string
@@ -427,7 +487,7 @@ func (s *TestSuite) TestAddMsgFee() {
 
 	for _, tc := range testCases {
 		s.Run(tc.name, func() {
-			err := s.app.MsgFeesKeeper.AddMsgFee(s.ctx, tc.msgTypeURL, tc.recipient, tc.basisPoints, tc.additionalFee)
+			err := s.app.MsgFeesKeeper.AddMsgFee(s.ctx, tc.msgTypeURL, "", tc.recipient, tc.basisPoints, tc.additionalFee)
 			if tc.expectError {
 				s.Require().Error(err, "test was expected to fail")
 				s.Require().Contains(err.Error(), tc.errorMsg)
@@ -445,7 +505,7 @@ func (s *TestSuite) TestAddMsgFee() {
 }
 
 func (s *TestSuite) TestUpdateMsgFee() {
-	s.Require().NoError(s.app.MsgFeesKeeper.AddMsgFee(s.ctx, "updateTypeURL", "initialRecipient", "500", sdk.NewInt64Coin("nhash", 2000)), "AddMsgFee() failed test setup")
+	s.Require().NoError(s.app.MsgFeesKeeper.AddMsgFee(s.ctx, "updateTypeURL", "", "initialRecipient", "500", sdk.NewInt64Coin("nhash", 2000)), "AddMsgFee() failed test setup")
 
 	testCases := []struct {
 		name          string
@@ -486,7 +546,7 @@ func (s *TestSuite) TestUpdateMsgFee() {
 
 	for _, tc := range testCases {
 		s.Run(tc.name, func() {
-			err := s.app.MsgFeesKeeper.UpdateMsgFee(s.ctx, tc.msgTypeURL, tc.recipient, tc.basisPoints, tc.additionalFee)
+			err := s.app.MsgFeesKeeper.UpdateMsgFee(s.ctx, tc.msgTypeURL, "", tc.recipient, tc.basisPoints, tc.additionalFee)
 			if tc.expectError {
 				s.Require().Error(err, "test was expected to fail")
 				s.Require().Contains(err.Error(), tc.errorMsg)
@@ -0,0 +1,32 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1To2 will update the msgfees store from version 1 to version 2.
+// It writes the params back to state so that the ones added in version 2 (e.g. the msg priorities)
+// are stored with their defaults instead of being left out.
+func (m Migrator) Migrate1To2(ctx sdk.Context) error {
+	logger := m.keeper.Logger(ctx)
+	logger.Info("Starting migration of x/msgfees from 1 to 2.")
+	// GetParams starts from the defaults, so any params missing from the stored value get their default.
+	params := m.keeper.GetParams(ctx)
+	if err := params.Validate(); err != nil {
+		logger.Error("Invalid x/msgfees params.", "error", err)
+		return err
+	}
+	m.keeper.SetParams(ctx, params)
+	logger.Info("Done migrating x/msgfees from 1 to 2.", "msg_priorities", len(params.MsgPriorities))
+	return nil
+}
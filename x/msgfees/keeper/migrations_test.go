@@ -0,0 +1,30 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/msgfees/keeper"
+	"github.com/provenance-io/provenance/x/msgfees/types"
+)
+
+func (s *MsgFeesParamTestSuite) TestMigrate1To2() {
+	store := s.ctx.KVStore(s.app.GetKey(types.StoreKey))
+	migrator := keeper.NewMigrator(s.app.MsgFeesKeeper)
+
+	s.Run("no params in state", func() {
+		store.Delete(types.MsgFeesParamStoreKey)
+		err := migrator.Migrate1To2(s.ctx)
+		s.Require().NoError(err, "Migrate1To2")
+		s.Require().True(store.Has(types.MsgFeesParamStoreKey), "params are in state after migration")
+		s.Require().Equal(types.DefaultParams(), s.app.MsgFeesKeeper.GetParams(s.ctx), "params after migration")
+	})
+
+	s.Run("version 1 params in state", func() {
+		// Version 1 params don't have the msg priorities.
+		v1Params := types.NewParams(sdk.NewInt64Coin("nhash", 100), 25_000_000, "usd")
+		store.Set(types.MsgFeesParamStoreKey, s.app.AppCodec().MustMarshal(&v1Params))
+		err := migrator.Migrate1To2(s.ctx)
+		s.Require().NoError(err, "Migrate1To2")
+		s.Require().Equal(v1Params, s.app.MsgFeesKeeper.GetParams(s.ctx), "params after migration")
+	})
+}
@@ -3,6 +3,7 @@ package keeper
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"cosmossdk.io/errors"
 
@@ -50,7 +51,13 @@ func (m msgServer) AddMsgFeeProposal(goCtx context.Context, req *types.MsgAddMsg
 		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "expected %s got %s", m.GetAuthority(), req.Authority)
 	}
 
-	err := m.Keeper.AddMsgFee(sdk.UnwrapSDKContext(goCtx), req.MsgTypeUrl, req.Recipient, req.RecipientBasisPoints, req.AdditionalFee)
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	var err error
+	if isScheduled(req.EffectiveHeight, req.EffectiveTime) {
+		_, err = m.Keeper.ScheduleMsgFee(ctx, req.MsgTypeUrl, req.ContractAddress, req.Recipient, req.RecipientBasisPoints, req.AdditionalFee, req.EffectiveHeight, req.EffectiveTime)
+	} else {
+		err = m.Keeper.AddMsgFee(ctx, req.MsgTypeUrl, req.ContractAddress, req.Recipient, req.RecipientBasisPoints, req.AdditionalFee)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -63,7 +70,13 @@ func (m msgServer) UpdateMsgFeeProposal(goCtx context.Context, req *types.MsgUpd
 		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "expected %s got %s", m.GetAuthority(), req.Authority)
 	}
 
-	err := m.Keeper.UpdateMsgFee(sdk.UnwrapSDKContext(goCtx), req.MsgTypeUrl, req.Recipient, req.RecipientBasisPoints, req.AdditionalFee)
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	var err error
+	if isScheduled(req.EffectiveHeight, req.EffectiveTime) {
+		_, err = m.Keeper.ScheduleMsgFee(ctx, req.MsgTypeUrl, req.ContractAddress, req.Recipient, req.RecipientBasisPoints, req.AdditionalFee, req.EffectiveHeight, req.EffectiveTime)
+	} else {
+		err = m.Keeper.UpdateMsgFee(ctx, req.MsgTypeUrl, req.ContractAddress, req.Recipient, req.RecipientBasisPoints, req.AdditionalFee)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -76,7 +89,13 @@ func (m msgServer) RemoveMsgFeeProposal(goCtx context.Context, req *types.MsgRem
 		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "expected %s got %s", m.GetAuthority(), req.Authority)
 	}
 
-	err := m.Keeper.RemoveMsgFee(sdk.UnwrapSDKContext(goCtx), req.MsgTypeUrl)
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	var err error
+	if isScheduled(req.EffectiveHeight, req.EffectiveTime) {
+		_, err = m.Keeper.ScheduleMsgFeeRemoval(ctx, req.MsgTypeUrl, req.ContractAddress, req.EffectiveHeight, req.EffectiveTime)
+	} else {
+		err = m.Keeper.RemoveMsgFee(ctx, req.MsgTypeUrl, req.ContractAddress)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -84,6 +103,11 @@ func (m msgServer) RemoveMsgFeeProposal(goCtx context.Context, req *types.MsgRem
 	return &types.MsgRemoveMsgFeeProposalResponse{}, nil
 }
 
+// isScheduled returns true if a msg fee change has an effective height or time, i.e. it should take effect later.
+func isScheduled(effectiveHeight int64, effectiveTime *time.Time) bool {
+	return effectiveHeight != 0 || effectiveTime != nil
+}
+
 func (m msgServer) UpdateNhashPerUsdMilProposal(goCtx context.Context, req *types.MsgUpdateNhashPerUsdMilProposalRequest) (*types.MsgUpdateNhashPerUsdMilProposalResponse, error) {
 	if m.GetAuthority() != req.Authority {
 		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "expected %s got %s", m.GetAuthority(), req.Authority)
@@ -103,3 +127,16 @@ func (m msgServer) UpdateConversionFeeDenomProposal(goCtx context.Context, req *
 
 	return &types.MsgUpdateConversionFeeDenomProposalResponse{}, nil
 }
+
+func (m msgServer) UpdateMsgPrioritiesProposal(goCtx context.Context, req *types.MsgUpdateMsgPrioritiesProposalRequest) (*types.MsgUpdateMsgPrioritiesProposalResponse, error) {
+	if m.GetAuthority() != req.Authority {
+		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "expected %s got %s", m.GetAuthority(), req.Authority)
+	}
+
+	err := m.Keeper.UpdateMsgPrioritiesParam(sdk.UnwrapSDKContext(goCtx), req.MsgPriorities)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateMsgPrioritiesProposalResponse{}, nil
+}
@@ -176,6 +176,23 @@ func (s *MsgServerTestSuite) TestRemoveMsgFeeProposal() {
 			},
 			errorMsg: `expected cosmos10d07y265gmmuvt4z0w9aw880jnsr700j6zn9kn got : expected gov account as only signer for proposal message`,
 		},
+		{
+			name: "scheduled at a past height",
+			msg: types.MsgRemoveMsgFeeProposalRequest{
+				MsgTypeUrl:      typeUrl,
+				Authority:       "cosmos10d07y265gmmuvt4z0w9aw880jnsr700j6zn9kn",
+				EffectiveHeight: 1,
+			},
+			errorMsg: "effective height 1 must be after the current block height 1: invalid fee proposal",
+		},
+		{
+			name: "scheduled",
+			msg: types.MsgRemoveMsgFeeProposalRequest{
+				MsgTypeUrl:      typeUrl,
+				Authority:       "cosmos10d07y265gmmuvt4z0w9aw880jnsr700j6zn9kn",
+				EffectiveHeight: 5,
+			},
+		},
 		{
 			name: "successful",
 			msg: types.MsgRemoveMsgFeeProposalRequest{
@@ -270,3 +287,61 @@ func (s *MsgServerTestSuite) TestUpdateConversionFeeDenomProposal() {
 		})
 	}
 }
+
+func (s *MsgServerTestSuite) TestUpdateMsgPrioritiesProposal() {
+	authority := "cosmos10d07y265gmmuvt4z0w9aw880jnsr700j6zn9kn"
+	tests := []struct {
+		name          string
+		msg           types.MsgUpdateMsgPrioritiesProposalRequest
+		errorMsg      string
+		expPriorities []types.MsgPriority
+	}{
+		{
+			name:     "expected gov account for signer",
+			msg:      types.MsgUpdateMsgPrioritiesProposalRequest{Authority: ""},
+			errorMsg: `expected cosmos10d07y265gmmuvt4z0w9aw880jnsr700j6zn9kn got : expected gov account as only signer for proposal message`,
+		},
+		{
+			name: "unknown msg type",
+			msg: types.MsgUpdateMsgPrioritiesProposalRequest{
+				MsgPriorities: []types.MsgPriority{types.NewMsgPriority("/cosmos.bank.v1beta1.MsgNope", 10)},
+				Authority:     authority,
+			},
+			errorMsg: `unknown msg priority type url "/cosmos.bank.v1beta1.MsgNope": unable to resolve type URL /cosmos.bank.v1beta1.MsgNope`,
+		},
+		{
+			name: "invalid priority",
+			msg: types.MsgUpdateMsgPrioritiesProposalRequest{
+				MsgPriorities: []types.MsgPriority{types.NewMsgPriority("/cosmos.bank.v1beta1.MsgSend", -1)},
+				Authority:     authority,
+			},
+			errorMsg: `invalid msg priority -1 for "/cosmos.bank.v1beta1.MsgSend": must be positive`,
+		},
+		{
+			name: "successful",
+			msg: types.MsgUpdateMsgPrioritiesProposalRequest{
+				MsgPriorities: []types.MsgPriority{types.NewMsgPriority("/cosmos.bank.v1beta1.MsgSend", 10)},
+				Authority:     authority,
+			},
+			expPriorities: []types.MsgPriority{types.NewMsgPriority("/cosmos.bank.v1beta1.MsgSend", 10)},
+		},
+		{
+			name:          "successful: remove all",
+			msg:           types.MsgUpdateMsgPrioritiesProposalRequest{Authority: authority},
+			expPriorities: nil,
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			response, err := s.msgServer.UpdateMsgPrioritiesProposal(s.ctx, &tt.msg)
+			if len(tt.errorMsg) > 0 {
+				s.Assert().EqualError(err, tt.errorMsg)
+				s.Assert().Nil(response)
+			} else {
+				s.Assert().NoError(err)
+				s.Assert().NotNil(response)
+				s.Assert().Equal(tt.expPriorities, s.app.MsgFeesKeeper.GetMsgPriorities(s.ctx), "GetMsgPriorities")
+			}
+		})
+	}
+}
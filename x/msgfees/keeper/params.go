@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/provenance-io/provenance/x/msgfees/types"
@@ -64,3 +66,26 @@ func (k Keeper) UpdateNhashPerUsdMilParam(ctx sdk.Context, nhashPerUsdMil uint64
 	params.NhashPerUsdMil = nhashPerUsdMil
 	k.SetParams(ctx, params)
 }
+
+// GetMsgPriorities returns the mempool priorities of msg types.
+func (k Keeper) GetMsgPriorities(ctx sdk.Context) []types.MsgPriority {
+	params := k.GetParams(ctx)
+	return params.MsgPriorities
+}
+
+// UpdateMsgPrioritiesParam updates the msg priorities param.
+// An error is returned if any of them are not valid, or are not for known message types.
+func (k Keeper) UpdateMsgPrioritiesParam(ctx sdk.Context, msgPriorities []types.MsgPriority) error {
+	if err := types.ValidateMsgPriorities(msgPriorities); err != nil {
+		return err
+	}
+	for _, msgPriority := range msgPriorities {
+		if _, err := k.registry.Resolve(msgPriority.MsgTypeUrl); err != nil {
+			return fmt.Errorf("unknown msg priority type url %q: %w", msgPriority.MsgTypeUrl, err)
+		}
+	}
+	params := k.GetParams(ctx)
+	params.MsgPriorities = msgPriorities
+	k.SetParams(ctx, params)
+	return nil
+}
@@ -0,0 +1,168 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/msgfees/types"
+)
+
+// ScheduleMsgFee schedules the msg fee to be set (added or updated) at the given effective height or time.
+// Existence of the msg fee is not checked since it's the state at that later block that matters.
+func (k Keeper) ScheduleMsgFee(
+	ctx sdk.Context,
+	msgTypeURL, contractAddress, recipient, basisPoints string,
+	additionalFee sdk.Coin,
+	effectiveHeight int64,
+	effectiveTime *time.Time,
+) (uint64, error) {
+	if msgTypeURL == "" {
+		return 0, types.ErrEmptyMsgType
+	}
+	if err := types.ValidateContractAddress(msgTypeURL, contractAddress); err != nil {
+		return 0, types.ErrInvalidFeeProposal.Wrap(err.Error())
+	}
+	bips, err := DetermineBips(recipient, basisPoints)
+	if err != nil {
+		return 0, err
+	}
+
+	msgFee := types.NewContractMsgFee(msgTypeURL, contractAddress, additionalFee, recipient, bips)
+	return k.SchedulePendingMsgFeeChange(ctx, types.NewPendingMsgFeeChange(msgFee, false, effectiveHeight, effectiveTime))
+}
+
+// ScheduleMsgFeeRemoval schedules the msg fee to be removed at the given effective height or time.
+func (k Keeper) ScheduleMsgFeeRemoval(
+	ctx sdk.Context,
+	msgTypeURL, contractAddress string,
+	effectiveHeight int64,
+	effectiveTime *time.Time,
+) (uint64, error) {
+	msgFee := types.MsgFee{MsgTypeUrl: msgTypeURL, ContractAddress: contractAddress}
+	return k.SchedulePendingMsgFeeChange(ctx, types.NewPendingMsgFeeChange(msgFee, true, effectiveHeight, effectiveTime))
+}
+
+// SchedulePendingMsgFeeChange gives the change a new id and stores it to take effect later.
+// The change must take effect after the current block. The id is returned.
+func (k Keeper) SchedulePendingMsgFeeChange(ctx sdk.Context, change types.PendingMsgFeeChange) (uint64, error) {
+	if err := change.Validate(); err != nil {
+		return 0, types.ErrInvalidFeeProposal.Wrap(err.Error())
+	}
+	if change.EffectiveHeight != 0 && change.EffectiveHeight <= ctx.BlockHeight() {
+		return 0, types.ErrInvalidFeeProposal.Wrapf("effective height %d must be after the current block height %d",
+			change.EffectiveHeight, ctx.BlockHeight())
+	}
+	if change.EffectiveTime != nil && !change.EffectiveTime.After(ctx.BlockTime()) {
+		return 0, types.ErrInvalidFeeProposal.Wrapf("effective time %s must be after the current block time %s",
+			change.EffectiveTime.UTC().Format(time.RFC3339), ctx.BlockTime().UTC().Format(time.RFC3339))
+	}
+
+	change.Id = k.getNextPendingMsgFeeChangeID(ctx)
+	k.setNextPendingMsgFeeChangeID(ctx, change.Id+1)
+	k.SetPendingMsgFeeChange(ctx, change)
+	return change.Id, nil
+}
+
+// SetPendingMsgFeeChange stores a pending msg fee change under its id.
+func (k Keeper) SetPendingMsgFeeChange(ctx sdk.Context, change types.PendingMsgFeeChange) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetPendingMsgFeeChangeKey(change.Id), k.cdc.MustMarshal(&change))
+}
+
+// IteratePendingMsgFeeChanges iterates all pending msg fee changes in id order with the given handler function.
+func (k Keeper) IteratePendingMsgFeeChanges(ctx sdk.Context, handle func(change types.PendingMsgFeeChange) (stop bool)) error {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.PendingMsgFeeChangeKeyPrefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var change types.PendingMsgFeeChange
+		if err := k.cdc.Unmarshal(iterator.Value(), &change); err != nil {
+			return err
+		}
+		if handle(change) {
+			break
+		}
+	}
+	return nil
+}
+
+// ApplyPendingMsgFeeChanges applies (then deletes) all the pending msg fee changes that take effect in the current block.
+func (k Keeper) ApplyPendingMsgFeeChanges(ctx sdk.Context) {
+	var effective []types.PendingMsgFeeChange
+	err := k.IteratePendingMsgFeeChanges(ctx, func(change types.PendingMsgFeeChange) bool {
+		if change.IsEffective(ctx.BlockHeight(), ctx.BlockTime()) {
+			effective = append(effective, change)
+		}
+		return false
+	})
+	if err != nil {
+		k.Logger(ctx).Error("unable to iterate pending msg fee changes", "error", err)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	for _, change := range effective {
+		if err = k.applyPendingMsgFeeChange(ctx, change); err != nil {
+			k.Logger(ctx).Error("unable to apply pending msg fee change", "id", change.Id,
+				"msg_type_url", change.MsgFee.MsgTypeUrl, "error", err)
+		}
+		store.Delete(types.GetPendingMsgFeeChangeKey(change.Id))
+	}
+}
+
+// applyPendingMsgFeeChange sets or removes the change's msg fee.
+func (k Keeper) applyPendingMsgFeeChange(ctx sdk.Context, change types.PendingMsgFeeChange) error {
+	if !change.Remove {
+		return k.SetMsgFee(ctx, change.MsgFee)
+	}
+	err := k.RemoveMsgFee(ctx, change.MsgFee.MsgTypeUrl, change.MsgFee.ContractAddress)
+	if errors.Is(err, types.ErrMsgFeeDoesNotExist) {
+		// It's already gone, which is what was wanted.
+		return nil
+	}
+	return err
+}
+
+// getNextPendingMsgFeeChangeID gets the id to give the next pending msg fee change.
+func (k Keeper) getNextPendingMsgFeeChangeID(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.NextPendingMsgFeeChangeIDKey)
+	if len(bz) == 0 {
+		return 1
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// setNextPendingMsgFeeChangeID sets the id to give the next pending msg fee change.
+func (k Keeper) setNextPendingMsgFeeChangeID(ctx sdk.Context, id uint64) {
+	ctx.KVStore(k.storeKey).Set(types.NextPendingMsgFeeChangeIDKey, sdk.Uint64ToBigEndian(id))
+}
+
+// initPendingMsgFeeChanges stores the given pending changes as they are, and makes sure new ones get a higher id.
+func (k Keeper) initPendingMsgFeeChanges(ctx sdk.Context, changes []types.PendingMsgFeeChange) {
+	nextID := k.getNextPendingMsgFeeChangeID(ctx)
+	for _, change := range changes {
+		k.SetPendingMsgFeeChange(ctx, change)
+		if change.Id >= nextID {
+			nextID = change.Id + 1
+		}
+	}
+	k.setNextPendingMsgFeeChangeID(ctx, nextID)
+}
+
+// getAllPendingMsgFeeChanges gets all the pending msg fee changes, in id order.
+func (k Keeper) getAllPendingMsgFeeChanges(ctx sdk.Context) ([]types.PendingMsgFeeChange, error) {
+	var rv []types.PendingMsgFeeChange
+	err := k.IteratePendingMsgFeeChanges(ctx, func(change types.PendingMsgFeeChange) bool {
+		rv = append(rv, change)
+		return false
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not iterate pending msg fee changes: %w", err)
+	}
+	return rv, nil
+}
@@ -0,0 +1,76 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/msgfees/types"
+)
+
+func (s *TestSuite) TestPendingMsgFeeChanges() {
+	k := s.app.MsgFeesKeeper
+	ctx := s.ctx.WithBlockHeight(10)
+	sendType := bankSendAuthMsgType
+	otherType := "/provenance.metadata.v1.MsgWriteRecordRequest"
+	oldFee := types.NewMsgFee(sendType, sdk.NewInt64Coin("nhash", 100), "", 0)
+	s.Require().NoError(k.SetMsgFee(ctx, oldFee), "SetMsgFee")
+	newCoin := sdk.NewInt64Coin("nhash", 200)
+	effectiveTime := ctx.BlockTime().Add(time.Hour)
+
+	_, err := k.ScheduleMsgFee(ctx, sendType, "", "", "", newCoin, 10, nil)
+	s.Assert().EqualError(err, "effective height 10 must be after the current block height 10: invalid fee proposal", "ScheduleMsgFee at the current height")
+	now := ctx.BlockTime()
+	_, err = k.ScheduleMsgFeeRemoval(ctx, sendType, "", 0, &now)
+	s.Assert().ErrorContains(err, "must be after the current block time", "ScheduleMsgFeeRemoval at the current time")
+
+	updateID, err := k.ScheduleMsgFee(ctx, sendType, "", "", "", newCoin, 12, nil)
+	s.Require().NoError(err, "ScheduleMsgFee update")
+	addID, err := k.ScheduleMsgFee(ctx, otherType, "", "", "", newCoin, 15, nil)
+	s.Require().NoError(err, "ScheduleMsgFee add")
+	removeID, err := k.ScheduleMsgFeeRemoval(ctx, sendType, "", 0, &effectiveTime)
+	s.Require().NoError(err, "ScheduleMsgFeeRemoval")
+	s.Assert().Equal([]uint64{1, 2, 3}, []uint64{updateID, addID, removeID}, "ids")
+
+	newSendFee := types.NewMsgFee(sendType, newCoin, "", 0)
+	updateChange := types.PendingMsgFeeChange{Id: updateID, MsgFee: newSendFee, EffectiveHeight: 12}
+	addChange := types.PendingMsgFeeChange{Id: addID, MsgFee: types.NewMsgFee(otherType, newCoin, "", 0), EffectiveHeight: 15}
+	removeChange := types.PendingMsgFeeChange{Id: removeID, MsgFee: types.MsgFee{MsgTypeUrl: sendType}, Remove: true, EffectiveTime: &effectiveTime}
+
+	s.Run("schedule query", func() {
+		resp, err := s.queryClient.MsgFeeSchedule(ctx, &types.QueryMsgFeeScheduleRequest{MsgTypeUrl: sendType})
+		s.Require().NoError(err, "MsgFeeSchedule")
+		s.Assert().Equal([]types.MsgFee{oldFee}, resp.Current, "current")
+		s.Assert().Equal([]types.PendingMsgFeeChange{updateChange, removeChange}, resp.Pending, "pending")
+	})
+
+	s.Run("nothing effective yet", func() {
+		k.ApplyPendingMsgFeeChanges(ctx.WithBlockHeight(11))
+		fee, err := k.GetMsgFee(ctx, sendType)
+		s.Require().NoError(err, "GetMsgFee")
+		s.Assert().Equal(&oldFee, fee, "GetMsgFee")
+	})
+
+	s.Run("update at its height", func() {
+		k.ApplyPendingMsgFeeChanges(ctx.WithBlockHeight(12))
+		fee, err := k.GetMsgFee(ctx, sendType)
+		s.Require().NoError(err, "GetMsgFee")
+		s.Assert().Equal(&newSendFee, fee, "GetMsgFee")
+
+		genState := k.ExportGenesis(ctx)
+		s.Assert().Equal([]types.PendingMsgFeeChange{addChange, removeChange}, genState.PendingChanges, "pending changes")
+	})
+
+	s.Run("add and remove", func() {
+		k.ApplyPendingMsgFeeChanges(ctx.WithBlockHeight(20).WithBlockTime(effectiveTime))
+		fee, err := k.GetMsgFee(ctx, sendType)
+		s.Require().NoError(err, "GetMsgFee send")
+		s.Assert().Nil(fee, "GetMsgFee send")
+		fee, err = k.GetMsgFee(ctx, otherType)
+		s.Require().NoError(err, "GetMsgFee other")
+		s.Assert().Equal(&addChange.MsgFee, fee, "GetMsgFee other")
+
+		genState := k.ExportGenesis(ctx)
+		s.Assert().Empty(genState.PendingChanges, "pending changes")
+	})
+}
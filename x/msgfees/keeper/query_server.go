@@ -7,6 +7,7 @@ import (
 	"google.golang.org/grpc/status"
 
 	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -50,6 +51,42 @@ func (k Keeper) QueryAllMsgFees(c context.Context, req *types.QueryAllMsgFeesReq
 	return &types.QueryAllMsgFeesResponse{MsgFees: msgFees, Pagination: pageRes}, nil
 }
 
+// MsgFeeSchedule returns the current msg fees and the pending changes to them.
+func (k Keeper) MsgFeeSchedule(c context.Context, req *types.QueryMsgFeeScheduleRequest) (*types.QueryMsgFeeScheduleResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	resp := &types.QueryMsgFeeScheduleResponse{}
+	// Contract-specific fees are stored under their msg type's key, so a prefix iterator gets those too.
+	keyPrefix := types.MsgFeeKeyPrefix
+	if len(req.MsgTypeUrl) > 0 {
+		keyPrefix = types.GetMsgFeeKey(req.MsgTypeUrl)
+	}
+	iterator := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), keyPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var msgFee types.MsgFee
+		if err := k.cdc.Unmarshal(iterator.Value(), &msgFee); err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		resp.Current = append(resp.Current, msgFee)
+	}
+
+	err := k.IteratePendingMsgFeeChanges(ctx, func(change types.PendingMsgFeeChange) bool {
+		if len(req.MsgTypeUrl) == 0 || change.MsgFee.MsgTypeUrl == req.MsgTypeUrl {
+			resp.Pending = append(resp.Pending, change)
+		}
+		return false
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return resp, nil
+}
+
 func (k Keeper) CalculateTxFees(goCtx context.Context, request *types.CalculateTxFeesRequest) (*types.CalculateTxFeesResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
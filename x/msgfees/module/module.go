@@ -3,6 +3,7 @@ package msgfees
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"math/rand"
 
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
@@ -20,6 +21,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/module"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
 
+	"github.com/provenance-io/provenance/internal/antewrapper"
 	"github.com/provenance-io/provenance/x/msgfees/client/cli"
 	"github.com/provenance-io/provenance/x/msgfees/keeper"
 	"github.com/provenance-io/provenance/x/msgfees/simulation"
@@ -30,7 +32,10 @@ var (
 	_ module.AppModuleBasic      = (*AppModule)(nil)
 	_ module.AppModuleSimulation = (*AppModule)(nil)
 
-	_ appmodule.AppModule = (*AppModule)(nil)
+	_ appmodule.AppModule       = (*AppModule)(nil)
+	_ appmodule.HasBeginBlocker = (*AppModule)(nil)
+
+	_ antewrapper.HasAnteDecorators = (*AppModule)(nil)
 )
 
 // AppModuleBasic defines the basic application module used by the msgfee module.
@@ -43,11 +48,24 @@ func (AppModuleBasic) Name() string {
 	return types.ModuleName
 }
 
+// AnteDecorators returns the msg fees and msg priority decorators for the ante handler.
+func (am AppModule) AnteDecorators() []antewrapper.DecoratorRegistration {
+	return []antewrapper.DecoratorRegistration{
+		antewrapper.NewMsgFeesDecoratorRegistration(am.keeper),
+		antewrapper.NewMsgPriorityDecoratorRegistration(am.keeper),
+	}
+}
+
 // RegisterServices registers a gRPC query service to respond to the
 // module-specific gRPC queries.
 func (am AppModule) RegisterServices(cfg module.Configurator) {
 	types.RegisterMsgServer(cfg.MsgServer(), keeper.NewMsgServerImpl(am.keeper))
 	types.RegisterQueryServer(cfg.QueryServer(), am.keeper)
+
+	m := keeper.NewMigrator(am.keeper)
+	if err := cfg.RegisterMigration(types.ModuleName, 1, m.Migrate1To2); err != nil {
+		panic(fmt.Sprintf("failed to register x/msgfees migration from version 1 to 2: %v", err))
+	}
 }
 
 // RegisterLegacyAminoCodec registers the msgfee module's types for the given codec.
@@ -138,7 +156,18 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 }
 
 // ConsensusVersion implements AppModule/ConsensusVersion.
-func (AppModule) ConsensusVersion() uint64 { return 1 }
+func (AppModule) ConsensusVersion() uint64 { return 2 }
+
+// BeginBlock applies the pending msg fee changes that take effect in this block.
+func (am AppModule) BeginBlock(ctx context.Context) error {
+	am.keeper.ApplyPendingMsgFeeChanges(sdk.UnwrapSDKContext(ctx))
+	return nil
+}
+
+// Features returns the names of the module's optional features that are currently enabled.
+func (am AppModule) Features(ctx sdk.Context) []string {
+	return am.keeper.GetParams(ctx).Features()
+}
 
 // ____________________________________________________________________________
 
@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/kv"
 
 	"github.com/provenance-io/provenance/x/msgfees/types"
@@ -22,6 +23,15 @@ func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
 			cdc.MustUnmarshal(kvB.Value, &attribB)
 
 			return fmt.Sprintf("%v\n%v", attribA, attribB)
+		case bytes.Equal(kvA.Key[:1], types.PendingMsgFeeChangeKeyPrefix):
+			var changeA, changeB types.PendingMsgFeeChange
+
+			cdc.MustUnmarshal(kvA.Value, &changeA)
+			cdc.MustUnmarshal(kvB.Value, &changeB)
+
+			return fmt.Sprintf("%v\n%v", changeA, changeB)
+		case bytes.Equal(kvA.Key[:1], types.NextPendingMsgFeeChangeIDKey):
+			return fmt.Sprintf("%d\n%d", sdk.BigEndianToUint64(kvA.Value), sdk.BigEndianToUint64(kvB.Value))
 		default:
 			panic(fmt.Sprintf("unexpected %s key %X (%s)", types.ModuleName, kvA.Key, kvA.Key))
 		}
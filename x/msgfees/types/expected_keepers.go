@@ -26,6 +26,7 @@ type MsgFeesKeeper interface {
 	GetNhashPerUsdMil(ctx sdk.Context) uint64
 	ConvertDenomToHash(ctx sdk.Context, coin sdk.Coin) (sdk.Coin, error)
 	CalculateAdditionalFeesToBePaid(ctx sdk.Context, msgs ...sdk.Msg) (MsgFeesDistribution, error)
+	GetMsgPriorities(ctx sdk.Context) []MsgPriority
 }
 
 // FeegrantKeeper defines the expected feegrant keeper.
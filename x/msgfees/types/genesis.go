@@ -2,25 +2,40 @@ package types
 
 import (
 	"encoding/json"
+	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 )
 
 // NewGenesisState creates new GenesisState object
-func NewGenesisState(params Params, entries []MsgFee) *GenesisState {
+func NewGenesisState(params Params, entries []MsgFee, pendingChanges []PendingMsgFeeChange) *GenesisState {
 	return &GenesisState{
-		Params:  params,
-		MsgFees: entries,
+		Params:         params,
+		MsgFees:        entries,
+		PendingChanges: pendingChanges,
 	}
 }
 
 // Validate ensures all grants in the genesis state are valid
 func (state GenesisState) Validate() error {
+	if err := state.Params.Validate(); err != nil {
+		return err
+	}
 	for _, a := range state.MsgFees {
 		if err := a.Validate(); err != nil {
 			return err
 		}
 	}
+	ids := make(map[uint64]bool, len(state.PendingChanges))
+	for i, change := range state.PendingChanges {
+		if ids[change.Id] {
+			return fmt.Errorf("invalid pending change[%d]: duplicate id %d", i, change.Id)
+		}
+		ids[change.Id] = true
+		if err := change.Validate(); err != nil {
+			return fmt.Errorf("invalid pending change[%d]: %w", i, err)
+		}
+	}
 	return nil
 }
 
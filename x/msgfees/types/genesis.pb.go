@@ -29,6 +29,8 @@ type GenesisState struct {
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 	// msg_based_fees are the additional fees on specific tx msgs
 	MsgFees []MsgFee `protobuf:"bytes,2,rep,name=msg_fees,json=msgFees,proto3" json:"msg_fees"`
+	// pending_changes are the msg fee changes that have been approved but are not yet in effect.
+	PendingChanges []PendingMsgFeeChange `protobuf:"bytes,3,rep,name=pending_changes,json=pendingChanges,proto3" json:"pending_changes"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -78,6 +80,13 @@ func (m *GenesisState) GetMsgFees() []MsgFee {
 	return nil
 }
 
+func (m *GenesisState) GetPendingChanges() []PendingMsgFeeChange {
+	if m != nil {
+		return m.PendingChanges
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "provenance.msgfees.v1.GenesisState")
 }
@@ -87,22 +96,25 @@ func init() {
 }
 
 var fileDescriptor_34254b1b9555b95c = []byte{
-	// 238 bytes of a gzipped FileDescriptorProto
+	// 277 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x2e, 0x28, 0xca, 0x2f,
 	0x4b, 0xcd, 0x4b, 0xcc, 0x4b, 0x4e, 0xd5, 0xcf, 0x2d, 0x4e, 0x4f, 0x4b, 0x4d, 0x2d, 0xd6, 0x2f,
 	0x33, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17,
 	0x12, 0x45, 0x28, 0xd2, 0x83, 0x2a, 0xd2, 0x2b, 0x33, 0x94, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07,
-	0xab, 0xd0, 0x07, 0xb1, 0x20, 0x8a, 0xa5, 0x70, 0x98, 0x08, 0xd3, 0x07, 0x56, 0xa4, 0xd4, 0xcd,
-	0xc8, 0xc5, 0xe3, 0x0e, 0xb1, 0x23, 0xb8, 0x24, 0xb1, 0x24, 0x55, 0xc8, 0x9a, 0x8b, 0xad, 0x20,
-	0xb1, 0x28, 0x31, 0xb7, 0x58, 0x82, 0x51, 0x81, 0x51, 0x83, 0xdb, 0x48, 0x56, 0x0f, 0xab, 0x9d,
-	0x7a, 0x01, 0x60, 0x45, 0x4e, 0x2c, 0x27, 0xee, 0xc9, 0x33, 0x04, 0x41, 0xb5, 0x08, 0xd9, 0x71,
-	0x71, 0xe4, 0x16, 0xa7, 0xc7, 0x83, 0xd4, 0x48, 0x30, 0x29, 0x30, 0xe3, 0xd1, 0xee, 0x5b, 0x9c,
-	0xee, 0x96, 0x9a, 0x0a, 0xd5, 0xce, 0x9e, 0x0b, 0xe6, 0x15, 0x3b, 0x65, 0x9e, 0x78, 0x24, 0xc7,
-	0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c,
-	0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x03, 0x97, 0x44, 0x66, 0x3e, 0x76, 0x93, 0x02, 0x18, 0xa3, 0x8c,
-	0xd3, 0x33, 0x4b, 0x32, 0x4a, 0x93, 0xf4, 0x92, 0xf3, 0x73, 0xf5, 0x11, 0x6a, 0x74, 0x33, 0xf3,
-	0x91, 0x78, 0xfa, 0x15, 0xf0, 0x30, 0x28, 0xa9, 0x2c, 0x48, 0x2d, 0x4e, 0x62, 0x03, 0xfb, 0xdf,
-	0x18, 0x10, 0x00, 0x00, 0xff, 0xff, 0xad, 0x4f, 0x8e, 0x40, 0x78, 0x01, 0x00, 0x00,
+	0xab, 0xd0, 0x07, 0xb1, 0x20, 0x8a, 0xa5, 0x70, 0x98, 0x08, 0xd3, 0x07, 0x56, 0xa4, 0xf4, 0x8c,
+	0x91, 0x8b, 0xc7, 0x1d, 0x62, 0x47, 0x70, 0x49, 0x62, 0x49, 0xaa, 0x90, 0x35, 0x17, 0x5b, 0x41,
+	0x62, 0x51, 0x62, 0x6e, 0xb1, 0x04, 0xa3, 0x02, 0xa3, 0x06, 0xb7, 0x91, 0xac, 0x1e, 0x56, 0x3b,
+	0xf5, 0x02, 0xc0, 0x8a, 0x9c, 0x58, 0x4e, 0xdc, 0x93, 0x67, 0x08, 0x82, 0x6a, 0x11, 0xb2, 0xe3,
+	0xe2, 0xc8, 0x2d, 0x4e, 0x8f, 0x07, 0xa9, 0x91, 0x60, 0x52, 0x60, 0xc6, 0xa3, 0xdd, 0xb7, 0x38,
+	0xdd, 0x2d, 0x35, 0x15, 0xaa, 0x9d, 0x3d, 0x17, 0xcc, 0x2b, 0x16, 0x8a, 0xe4, 0xe2, 0x2f, 0x48,
+	0xcd, 0x4b, 0xc9, 0xcc, 0x4b, 0x8f, 0x4f, 0xce, 0x48, 0xcc, 0x4b, 0x4f, 0x2d, 0x96, 0x60, 0x06,
+	0x1b, 0xa3, 0x85, 0xcb, 0x15, 0x10, 0xd5, 0x10, 0xd3, 0x9c, 0xc1, 0x5a, 0xa0, 0x66, 0xf2, 0x41,
+	0x0d, 0x82, 0x08, 0x16, 0x3b, 0x65, 0x9e, 0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83,
+	0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x03,
+	0x97, 0x44, 0x66, 0x3e, 0x76, 0xd3, 0x03, 0x18, 0xa3, 0x8c, 0xd3, 0x33, 0x4b, 0x32, 0x4a, 0x93,
+	0xf4, 0x92, 0xf3, 0x73, 0xf5, 0x11, 0x6a, 0x74, 0x33, 0xf3, 0x91, 0x78, 0xfa, 0x15, 0xf0, 0xe0,
+	0x2d, 0xa9, 0x2c, 0x48, 0x2d, 0x4e, 0x62, 0x03, 0x07, 0xad, 0x31, 0x60, 0x00, 0x3a, 0x21, 0x11,
+	0xec, 0xd3, 0x01, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -125,6 +137,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PendingChanges) > 0 {
+		for iNdEx := len(m.PendingChanges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingChanges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.MsgFees) > 0 {
 		for iNdEx := len(m.MsgFees) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -177,6 +203,12 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.PendingChanges) > 0 {
+		for _, e := range m.PendingChanges {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -282,6 +314,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingChanges", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingChanges = append(m.PendingChanges, PendingMsgFeeChange{})
+			if err := m.PendingChanges[len(m.PendingChanges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
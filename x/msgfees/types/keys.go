@@ -4,6 +4,9 @@ import (
 	"crypto/sha256"
 	"fmt"
 	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
 )
 
 const (
@@ -29,11 +32,25 @@ func GetMsgFeeKey(msgType string) []byte {
 	return append(MsgFeeKeyPrefix, msgNameBytes[0:16]...)
 }
 
+// GetContractMsgFeeKey takes in msgType name and a contract address and returns the key of a contract-specific msg fee.
+func GetContractMsgFeeKey(msgType string, contract sdk.AccAddress) []byte {
+	return append(GetMsgFeeKey(msgType), address.MustLengthPrefix(contract)...)
+}
+
+// GetPendingMsgFeeChangeKey returns the key of the pending msg fee change with the given id.
+func GetPendingMsgFeeChangeKey(id uint64) []byte {
+	return append(PendingMsgFeeChangeKeyPrefix, sdk.Uint64ToBigEndian(id)...)
+}
+
 var (
 	// MsgFeeKeyPrefix prefix for msgfee entry
 	MsgFeeKeyPrefix = []byte{0x00}
 	// MsgFeesParamStoreKey key for msgfees module's params
 	MsgFeesParamStoreKey = []byte{0x01}
+	// PendingMsgFeeChangeKeyPrefix prefix for msg fee changes that have not yet taken effect
+	PendingMsgFeeChangeKeyPrefix = []byte{0x02}
+	// NextPendingMsgFeeChangeIDKey key for the id to give the next pending msg fee change
+	NextPendingMsgFeeChangeIDKey = []byte{0x03}
 )
 
 func GetCompositeKey(msgType string, recipient string) string {
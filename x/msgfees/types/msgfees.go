@@ -1,13 +1,17 @@
 package types
 
 import (
+	"errors"
 	"fmt"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 const (
 	DefaultMsgFeeBips = uint32(5_000)
+	// ExecuteContractMsgTypeURL is the type url of the only msg that can have contract-specific fees.
+	ExecuteContractMsgTypeURL = "/cosmwasm.wasm.v1.MsgExecuteContract"
 )
 
 func NewMsgFee(msgTypeURL string, additionalFee sdk.Coin, recipient string, recipientBasisPoints uint32) MsgFee {
@@ -19,6 +23,13 @@ func NewMsgFee(msgTypeURL string, additionalFee sdk.Coin, recipient string, reci
 	}
 }
 
+// NewContractMsgFee creates a MsgFee that only applies to executions of the given contract.
+func NewContractMsgFee(msgTypeURL string, contractAddress string, additionalFee sdk.Coin, recipient string, recipientBasisPoints uint32) MsgFee {
+	rv := NewMsgFee(msgTypeURL, additionalFee, recipient, recipientBasisPoints)
+	rv.ContractAddress = contractAddress
+	return rv
+}
+
 func (msg *MsgFee) Validate() error {
 	if msg == nil {
 		return ErrEmptyMsgType
@@ -43,6 +54,64 @@ func (msg *MsgFee) Validate() error {
 	if msg.RecipientBasisPoints > 10_000 {
 		return fmt.Errorf("recipient basis points can only be between 0 and 10,000 : %v", msg.RecipientBasisPoints)
 	}
+	if err := ValidateContractAddress(msg.MsgTypeUrl, msg.ContractAddress); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// NewPendingMsgFeeChange creates a PendingMsgFeeChange that sets (or removes) the msg fee at the given height or time.
+// The id is assigned when the change is scheduled.
+func NewPendingMsgFeeChange(msgFee MsgFee, remove bool, effectiveHeight int64, effectiveTime *time.Time) PendingMsgFeeChange {
+	return PendingMsgFeeChange{
+		MsgFee:          msgFee,
+		Remove:          remove,
+		EffectiveHeight: effectiveHeight,
+		EffectiveTime:   effectiveTime,
+	}
+}
 
+// Validate makes sure the PendingMsgFeeChange is valid.
+func (c PendingMsgFeeChange) Validate() error {
+	if c.Remove {
+		if len(c.MsgFee.MsgTypeUrl) == 0 {
+			return ErrEmptyMsgType
+		}
+		if err := ValidateContractAddress(c.MsgFee.MsgTypeUrl, c.MsgFee.ContractAddress); err != nil {
+			return err
+		}
+	} else if err := c.MsgFee.Validate(); err != nil {
+		return err
+	}
+	if err := ValidateEffective(c.EffectiveHeight, c.EffectiveTime); err != nil {
+		return err
+	}
+	if c.EffectiveHeight == 0 && c.EffectiveTime == nil {
+		return errors.New("an effective height or effective time is required")
+	}
+	return nil
+}
+
+// IsEffective returns true if this change should take effect in a block with the given height and time.
+func (c PendingMsgFeeChange) IsEffective(height int64, blockTime time.Time) bool {
+	if c.EffectiveTime != nil {
+		return !blockTime.Before(*c.EffectiveTime)
+	}
+	return height >= c.EffectiveHeight
+}
+
+// ValidateContractAddress makes sure that the contract address is either empty, or a valid
+// bech32 address provided with the MsgExecuteContract type url.
+func ValidateContractAddress(msgTypeURL, contractAddress string) error {
+	if len(contractAddress) == 0 {
+		return nil
+	}
+	if msgTypeURL != ExecuteContractMsgTypeURL {
+		return fmt.Errorf("contract address can only be provided with msg type %s", ExecuteContractMsgTypeURL)
+	}
+	if _, err := sdk.AccAddressFromBech32(contractAddress); err != nil {
+		return fmt.Errorf("invalid contract address %q: %w", contractAddress, err)
+	}
 	return nil
 }
@@ -8,15 +8,19 @@ import (
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -40,6 +44,9 @@ type Params struct {
 	NhashPerUsdMil uint64 `protobuf:"varint,3,opt,name=nhash_per_usd_mil,json=nhashPerUsdMil,proto3" json:"nhash_per_usd_mil,omitempty"`
 	// conversion_fee_denom is the denom usd is converted to.
 	ConversionFeeDenom string `protobuf:"bytes,4,opt,name=conversion_fee_denom,json=conversionFeeDenom,proto3" json:"conversion_fee_denom,omitempty"`
+	// msg_priorities are the mempool priorities given to txs with certain messages, so that time-critical messages
+	// (e.g. settlements) are not starved by bulk ones.
+	MsgPriorities []MsgPriority `protobuf:"bytes,5,rep,name=msg_priorities,json=msgPriorities,proto3" json:"msg_priorities"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -96,6 +103,69 @@ func (m *Params) GetConversionFeeDenom() string {
 	return ""
 }
 
+func (m *Params) GetMsgPriorities() []MsgPriority {
+	if m != nil {
+		return m.MsgPriorities
+	}
+	return nil
+}
+
+// MsgPriority is the mempool priority given to txs that contain a type of message.
+type MsgPriority struct {
+	// msg_type_url is the type-url of the message, e.g. "/provenance.marker.v1.MsgTransferRequest".
+	MsgTypeUrl string `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	// priority is the mempool priority of a tx with this message. Txs with a higher priority are included first.
+	// A tx with several prioritized messages gets the highest of their priorities.
+	Priority int64 `protobuf:"varint,2,opt,name=priority,proto3" json:"priority,omitempty"`
+}
+
+func (m *MsgPriority) Reset()         { *m = MsgPriority{} }
+func (m *MsgPriority) String() string { return proto.CompactTextString(m) }
+func (*MsgPriority) ProtoMessage()    {}
+func (*MsgPriority) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c6265859d114362, []int{1}
+}
+func (m *MsgPriority) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPriority) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPriority.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgPriority) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPriority.Merge(m, src)
+}
+func (m *MsgPriority) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPriority) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPriority.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPriority proto.InternalMessageInfo
+
+func (m *MsgPriority) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
+}
+
+func (m *MsgPriority) GetPriority() int64 {
+	if m != nil {
+		return m.Priority
+	}
+	return 0
+}
+
 // MsgFee is the core of what gets stored on the blockchain to define a msg-based fee.
 type MsgFee struct {
 	// msg_type_url is the type-url of the message with the added fee, e.g. "/cosmos.bank.v1beta1.MsgSend".
@@ -113,13 +183,17 @@ type MsgFee struct {
 	// The recipient will receive additional_fee * recipient_basis_points / 10,000.
 	// The fee collector will receive the rest, i.e. additional_fee * (10,000 - recipient_basis_points) / 10,000.
 	RecipientBasisPoints uint32 `protobuf:"varint,4,opt,name=recipient_basis_points,json=recipientBasisPoints,proto3" json:"recipient_basis_points,omitempty"`
+	// contract_address is an optional bech32 address of a wasm contract. It can only be provided when the msg_type_url is
+	// "/cosmwasm.wasm.v1.MsgExecuteContract". When provided, this fee is charged for executions of that contract in place
+	// of any fee defined for the msg type alone.
+	ContractAddress string `protobuf:"bytes,5,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
 }
 
 func (m *MsgFee) Reset()         { *m = MsgFee{} }
 func (m *MsgFee) String() string { return proto.CompactTextString(m) }
 func (*MsgFee) ProtoMessage()    {}
 func (*MsgFee) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0c6265859d114362, []int{1}
+	return fileDescriptor_0c6265859d114362, []int{2}
 }
 func (m *MsgFee) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -176,6 +250,97 @@ func (m *MsgFee) GetRecipientBasisPoints() uint32 {
 	return 0
 }
 
+func (m *MsgFee) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+// PendingMsgFeeChange is a msg fee change approved by governance that takes effect at a later block height or time.
+type PendingMsgFeeChange struct {
+	// id is the unique identifier of this pending change.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// msg_fee is the msg fee to set once this change takes effect.
+	// When remove is true, only its msg_type_url and contract_address are used.
+	MsgFee MsgFee `protobuf:"bytes,2,opt,name=msg_fee,json=msgFee,proto3" json:"msg_fee"`
+	// remove is true if this change removes the msg fee instead of setting it.
+	Remove bool `protobuf:"varint,3,opt,name=remove,proto3" json:"remove,omitempty"`
+	// effective_height is the block height at which this change takes effect. It is zero when effective_time is used.
+	EffectiveHeight int64 `protobuf:"varint,4,opt,name=effective_height,json=effectiveHeight,proto3" json:"effective_height,omitempty"`
+	// effective_time is the block time at or after which this change takes effect. It is not set when effective_height
+	// is used.
+	EffectiveTime *time.Time `protobuf:"bytes,5,opt,name=effective_time,json=effectiveTime,proto3,stdtime" json:"effective_time,omitempty"`
+}
+
+func (m *PendingMsgFeeChange) Reset()         { *m = PendingMsgFeeChange{} }
+func (m *PendingMsgFeeChange) String() string { return proto.CompactTextString(m) }
+func (*PendingMsgFeeChange) ProtoMessage()    {}
+func (*PendingMsgFeeChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c6265859d114362, []int{3}
+}
+func (m *PendingMsgFeeChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingMsgFeeChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingMsgFeeChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingMsgFeeChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingMsgFeeChange.Merge(m, src)
+}
+func (m *PendingMsgFeeChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingMsgFeeChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingMsgFeeChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingMsgFeeChange proto.InternalMessageInfo
+
+func (m *PendingMsgFeeChange) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *PendingMsgFeeChange) GetMsgFee() MsgFee {
+	if m != nil {
+		return m.MsgFee
+	}
+	return MsgFee{}
+}
+
+func (m *PendingMsgFeeChange) GetRemove() bool {
+	if m != nil {
+		return m.Remove
+	}
+	return false
+}
+
+func (m *PendingMsgFeeChange) GetEffectiveHeight() int64 {
+	if m != nil {
+		return m.EffectiveHeight
+	}
+	return 0
+}
+
+func (m *PendingMsgFeeChange) GetEffectiveTime() *time.Time {
+	if m != nil {
+		return m.EffectiveTime
+	}
+	return nil
+}
+
 // EventMsgFee final event property for msg fee on type
 type EventMsgFee struct {
 	MsgType   string `protobuf:"bytes,1,opt,name=msg_type,json=msgType,proto3" json:"msg_type,omitempty"`
@@ -188,7 +353,7 @@ func (m *EventMsgFee) Reset()         { *m = EventMsgFee{} }
 func (m *EventMsgFee) String() string { return proto.CompactTextString(m) }
 func (*EventMsgFee) ProtoMessage()    {}
 func (*EventMsgFee) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0c6265859d114362, []int{2}
+	return fileDescriptor_0c6265859d114362, []int{4}
 }
 func (m *EventMsgFee) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -254,7 +419,7 @@ func (m *EventMsgFees) Reset()         { *m = EventMsgFees{} }
 func (m *EventMsgFees) String() string { return proto.CompactTextString(m) }
 func (*EventMsgFees) ProtoMessage()    {}
 func (*EventMsgFees) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0c6265859d114362, []int{3}
+	return fileDescriptor_0c6265859d114362, []int{5}
 }
 func (m *EventMsgFees) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -292,7 +457,9 @@ func (m *EventMsgFees) GetMsgFees() []EventMsgFee {
 
 func init() {
 	proto.RegisterType((*Params)(nil), "provenance.msgfees.v1.Params")
+	proto.RegisterType((*MsgPriority)(nil), "provenance.msgfees.v1.MsgPriority")
 	proto.RegisterType((*MsgFee)(nil), "provenance.msgfees.v1.MsgFee")
+	proto.RegisterType((*PendingMsgFeeChange)(nil), "provenance.msgfees.v1.PendingMsgFeeChange")
 	proto.RegisterType((*EventMsgFee)(nil), "provenance.msgfees.v1.EventMsgFee")
 	proto.RegisterType((*EventMsgFees)(nil), "provenance.msgfees.v1.EventMsgFees")
 }
@@ -302,38 +469,51 @@ func init() {
 }
 
 var fileDescriptor_0c6265859d114362 = []byte{
-	// 495 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0xc1, 0x6e, 0xd3, 0x40,
-	0x10, 0xcd, 0xd2, 0x10, 0xc8, 0xa6, 0x2d, 0xc2, 0x0a, 0xc8, 0xad, 0x90, 0x1b, 0x85, 0x4b, 0x38,
-	0x60, 0x93, 0x96, 0x13, 0xc7, 0x14, 0xd2, 0x53, 0xa5, 0xc8, 0xd0, 0x0b, 0x97, 0xd5, 0xc6, 0x99,
-	0x38, 0x2b, 0xd9, 0x3b, 0xd6, 0xee, 0xc6, 0xa2, 0x7f, 0xc1, 0x27, 0xf0, 0x11, 0x7c, 0x03, 0xea,
-	0xb1, 0x47, 0x4e, 0x08, 0x25, 0x17, 0x3e, 0x03, 0xad, 0xed, 0xc4, 0x01, 0xf5, 0xd0, 0xdb, 0xce,
-	0xbe, 0x79, 0x6f, 0xde, 0x5b, 0xcd, 0xd2, 0x97, 0x99, 0xc2, 0x1c, 0x24, 0x97, 0x11, 0x04, 0xa9,
-	0x8e, 0xe7, 0x00, 0x3a, 0xc8, 0x87, 0x9b, 0xa3, 0x9f, 0x29, 0x34, 0xe8, 0x3c, 0xab, 0x9b, 0xfc,
-	0x0d, 0x92, 0x0f, 0x8f, 0xbb, 0x31, 0xc6, 0x58, 0x74, 0x04, 0xf6, 0x54, 0x36, 0x1f, 0x7b, 0x11,
-	0xea, 0x14, 0x75, 0x30, 0xe5, 0x1a, 0x82, 0x7c, 0x38, 0x05, 0xc3, 0x87, 0x41, 0x84, 0x42, 0x96,
-	0x78, 0xff, 0x3b, 0xa1, 0xad, 0x09, 0x57, 0x3c, 0xd5, 0xce, 0x05, 0x7d, 0x32, 0x4f, 0x10, 0x15,
-	0x8b, 0xb9, 0x66, 0x99, 0x12, 0x11, 0xb8, 0x0f, 0x7a, 0x64, 0xd0, 0x39, 0x3d, 0xf2, 0x4b, 0x11,
-	0xdf, 0x8a, 0xf8, 0x95, 0x88, 0x7f, 0x8e, 0x42, 0x8e, 0x9a, 0x37, 0xbf, 0x4e, 0x1a, 0xe1, 0x41,
-	0xc1, 0xbb, 0xe0, 0x7a, 0x62, 0x59, 0xce, 0x2b, 0xfa, 0x54, 0x2e, 0xb8, 0x5e, 0xb0, 0x0c, 0x14,
-	0x5b, 0xea, 0x19, 0x4b, 0x45, 0xe2, 0xee, 0xf5, 0xc8, 0xa0, 0x19, 0x1e, 0x16, 0xc0, 0x04, 0xd4,
-	0x95, 0x9e, 0x5d, 0x8a, 0xc4, 0x79, 0x43, 0xbb, 0x11, 0xca, 0x1c, 0x94, 0x16, 0x28, 0xd9, 0x1c,
-	0x80, 0xcd, 0x40, 0x62, 0xea, 0x36, 0x7b, 0x64, 0xd0, 0x0e, 0x9d, 0x1a, 0x1b, 0x03, 0xbc, 0xb7,
-	0xc8, 0xbb, 0xe6, 0x9f, 0x6f, 0x27, 0x8d, 0xfe, 0x0f, 0x42, 0x5b, 0x97, 0x3a, 0x1e, 0x03, 0x38,
-	0x3d, 0xba, 0x9f, 0xea, 0x98, 0x99, 0xeb, 0x0c, 0xd8, 0x52, 0x25, 0x2e, 0x29, 0xa8, 0x34, 0xd5,
-	0xf1, 0xa7, 0xeb, 0x0c, 0xae, 0x54, 0xe2, 0x8c, 0xe9, 0x21, 0x9f, 0xcd, 0x84, 0x11, 0x28, 0x79,
-	0x62, 0x87, 0xdc, 0x3b, 0x57, 0x4d, 0xb3, 0x93, 0x5e, 0xd0, 0xb6, 0x82, 0x48, 0x64, 0x02, 0xa4,
-	0x29, 0xf2, 0xb4, 0xc3, 0xfa, 0xc2, 0x79, 0x4b, 0x9f, 0x6f, 0x0b, 0x36, 0xe5, 0x5a, 0x68, 0x96,
-	0xa1, 0x90, 0x46, 0x17, 0x61, 0x0e, 0xc2, 0xee, 0x16, 0x1d, 0x59, 0x70, 0x52, 0x60, 0x7d, 0x45,
-	0x3b, 0x1f, 0x72, 0x90, 0xa6, 0x0a, 0x73, 0x44, 0x1f, 0x6f, 0xc2, 0x54, 0x41, 0x1e, 0x55, 0x41,
-	0x9c, 0x2e, 0x7d, 0x18, 0xe1, 0x52, 0x9a, 0xc2, 0x7c, 0x3b, 0x2c, 0x0b, 0x7b, 0x6b, 0xd0, 0xf0,
-	0xa4, 0xf2, 0x53, 0x16, 0xff, 0x3a, 0x6d, 0xfe, 0xe7, 0xb4, 0xff, 0x91, 0xee, 0xef, 0xcc, 0xd4,
-	0xce, 0x79, 0x39, 0xd4, 0x2e, 0x92, 0x4b, 0x7a, 0x7b, 0x83, 0xce, 0x69, 0xdf, 0xbf, 0x73, 0xc7,
-	0xfc, 0x1d, 0x5a, 0xf5, 0x44, 0xd6, 0x9e, 0x15, 0x19, 0x89, 0x9b, 0x95, 0x47, 0x6e, 0x57, 0x1e,
-	0xf9, 0xbd, 0xf2, 0xc8, 0xd7, 0xb5, 0xd7, 0xb8, 0x5d, 0x7b, 0x8d, 0x9f, 0x6b, 0xaf, 0x41, 0x5d,
-	0x81, 0x77, 0xcb, 0x4d, 0xc8, 0xe7, 0xb3, 0x58, 0x98, 0xc5, 0x72, 0xea, 0x47, 0x98, 0x06, 0x75,
-	0xcf, 0x6b, 0x81, 0x3b, 0x55, 0xf0, 0x65, 0xfb, 0x17, 0xec, 0xbb, 0xe8, 0x69, 0xab, 0x58, 0xdd,
-	0xb3, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0xf6, 0xf6, 0x22, 0x0d, 0x2e, 0x03, 0x00, 0x00,
+	// 701 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0xbd, 0x6e, 0xdb, 0x48,
+	0x10, 0x16, 0x25, 0x59, 0xb6, 0x56, 0xb6, 0x7c, 0xb7, 0xa7, 0x33, 0x64, 0xe1, 0x4e, 0x12, 0x74,
+	0x8d, 0x5c, 0x1c, 0x79, 0xb2, 0xaf, 0x0a, 0xd2, 0x44, 0x4e, 0xe4, 0x00, 0x81, 0x11, 0x81, 0xb1,
+	0x9b, 0x34, 0xc4, 0x8a, 0x1c, 0x51, 0x0b, 0x90, 0xbb, 0xc4, 0xee, 0x8a, 0x88, 0x1e, 0x20, 0xbd,
+	0x1f, 0x21, 0x8f, 0xe3, 0xd2, 0x65, 0xaa, 0x24, 0xb0, 0x9b, 0x74, 0x41, 0xde, 0x20, 0xd8, 0x25,
+	0xf5, 0x93, 0xc0, 0x09, 0xdc, 0x71, 0xe6, 0x9b, 0xd9, 0x99, 0xef, 0xfb, 0x30, 0x44, 0xff, 0x24,
+	0x82, 0xa7, 0xc0, 0x08, 0xf3, 0xc1, 0x89, 0x65, 0x38, 0x05, 0x90, 0x4e, 0x3a, 0x58, 0x7e, 0xda,
+	0x89, 0xe0, 0x8a, 0xe3, 0x3f, 0xd7, 0x45, 0xf6, 0x12, 0x49, 0x07, 0xad, 0x46, 0xc8, 0x43, 0x6e,
+	0x2a, 0x1c, 0xfd, 0x95, 0x15, 0xb7, 0xda, 0x3e, 0x97, 0x31, 0x97, 0xce, 0x84, 0x48, 0x70, 0xd2,
+	0xc1, 0x04, 0x14, 0x19, 0x38, 0x3e, 0xa7, 0x2c, 0xc7, 0x3b, 0x21, 0xe7, 0x61, 0x04, 0x8e, 0x89,
+	0x26, 0xf3, 0xa9, 0xa3, 0x68, 0x0c, 0x52, 0x91, 0x38, 0xc9, 0x0a, 0x7a, 0x6f, 0x8b, 0xa8, 0x32,
+	0x26, 0x82, 0xc4, 0x12, 0x9f, 0xa1, 0xfd, 0x69, 0xc4, 0xb9, 0xf0, 0x42, 0x22, 0xbd, 0x44, 0x50,
+	0x1f, 0x9a, 0xc5, 0xae, 0xd5, 0xaf, 0x1d, 0x1f, 0xda, 0xd9, 0x14, 0x5b, 0x4f, 0xb1, 0xf3, 0x29,
+	0xf6, 0x29, 0xa7, 0x6c, 0x58, 0xbe, 0xfe, 0xd0, 0x29, 0xb8, 0x7b, 0xa6, 0xef, 0x8c, 0xc8, 0xb1,
+	0xee, 0xc2, 0x47, 0xe8, 0x77, 0x36, 0x23, 0x72, 0xe6, 0x25, 0x20, 0xbc, 0xb9, 0x0c, 0xbc, 0x98,
+	0x46, 0xcd, 0x52, 0xd7, 0xea, 0x97, 0xdd, 0xba, 0x01, 0xc6, 0x20, 0x2e, 0x65, 0x70, 0x4e, 0x23,
+	0xfc, 0x1f, 0x6a, 0xf8, 0x9c, 0xa5, 0x20, 0x24, 0xe5, 0xcc, 0x9b, 0x02, 0x78, 0x01, 0x30, 0x1e,
+	0x37, 0xcb, 0x5d, 0xab, 0x5f, 0x75, 0xf1, 0x1a, 0x1b, 0x01, 0x3c, 0xd5, 0x08, 0x7e, 0x89, 0xea,
+	0xb1, 0x0c, 0xf5, 0x7e, 0x5c, 0x50, 0x45, 0x41, 0x36, 0xb7, 0xba, 0xa5, 0x7e, 0xed, 0xb8, 0x67,
+	0xdf, 0xab, 0x9b, 0x7d, 0x2e, 0xc3, 0x71, 0x56, 0xbb, 0x58, 0x6e, 0x1b, 0xaf, 0x52, 0x14, 0xe4,
+	0xa3, 0xf2, 0xe7, 0x77, 0x9d, 0x42, 0xef, 0x05, 0xaa, 0x6d, 0x54, 0xe2, 0x2e, 0xda, 0xd5, 0x53,
+	0xd4, 0x22, 0x01, 0x6f, 0x2e, 0xa2, 0xa6, 0x65, 0xf6, 0x41, 0xb1, 0x0c, 0x2f, 0x16, 0x09, 0x5c,
+	0x8a, 0x08, 0xb7, 0xd0, 0x4e, 0xbe, 0xc3, 0xc2, 0xc8, 0x54, 0x72, 0x57, 0x71, 0xef, 0xab, 0x85,
+	0x2a, 0xe7, 0x32, 0x1c, 0x01, 0x3c, 0xe0, 0xa1, 0x11, 0xaa, 0x93, 0x20, 0xa0, 0x8a, 0x72, 0x46,
+	0x22, 0x2d, 0xc1, 0x83, 0x55, 0x5f, 0xb7, 0xe9, 0x49, 0x7f, 0xa1, 0xaa, 0x00, 0x9f, 0x26, 0x14,
+	0x98, 0x32, 0x6a, 0x57, 0xdd, 0x75, 0x02, 0xff, 0x8f, 0x0e, 0x56, 0x81, 0x37, 0x21, 0x92, 0x4a,
+	0x2f, 0xe1, 0x94, 0x29, 0x69, 0xa4, 0xde, 0x73, 0x1b, 0x2b, 0x74, 0xa8, 0xc1, 0xb1, 0xc1, 0xf0,
+	0x11, 0xfa, 0xcd, 0xe7, 0x4c, 0x09, 0xe2, 0x2b, 0x8f, 0x04, 0x81, 0x00, 0xa9, 0xe5, 0xd6, 0x4f,
+	0xef, 0x2f, 0xf3, 0x4f, 0xb2, 0x74, 0xef, 0x8b, 0x85, 0xfe, 0x18, 0x03, 0x0b, 0x28, 0x0b, 0x33,
+	0xea, 0xa7, 0x33, 0xc2, 0x42, 0xc0, 0x75, 0x54, 0xa4, 0x81, 0xa1, 0x5d, 0x76, 0x8b, 0x34, 0xc0,
+	0x8f, 0xd1, 0xb6, 0x16, 0x64, 0xcd, 0xf3, 0xef, 0x9f, 0x1b, 0x37, 0x02, 0xc8, 0xb9, 0x56, 0xe2,
+	0x4c, 0xce, 0x03, 0x54, 0x11, 0x10, 0xf3, 0x14, 0x0c, 0xc3, 0x1d, 0x37, 0x8f, 0xf4, 0xa2, 0x30,
+	0x9d, 0x82, 0xaf, 0x68, 0x0a, 0xde, 0x0c, 0x68, 0x38, 0x53, 0x86, 0x58, 0xc9, 0xdd, 0x5f, 0xe5,
+	0x9f, 0x9b, 0x34, 0x3e, 0x43, 0xf5, 0x75, 0xa9, 0x3e, 0x07, 0xc3, 0xa8, 0x76, 0xdc, 0xb2, 0xb3,
+	0x5b, 0xb1, 0x97, 0xb7, 0x62, 0x5f, 0x2c, 0x6f, 0x65, 0x58, 0xbe, 0xfa, 0xd8, 0xb1, 0xdc, 0xbd,
+	0x55, 0x9f, 0x46, 0x7a, 0x02, 0xd5, 0x9e, 0xa5, 0xc0, 0x54, 0xee, 0xf4, 0x21, 0xda, 0x59, 0x3a,
+	0x9d, 0xbb, 0xbc, 0x9d, 0xbb, 0x8c, 0x1b, 0x68, 0xcb, 0xe7, 0x73, 0xa6, 0x0c, 0xe3, 0xaa, 0x9b,
+	0x05, 0x3a, 0xab, 0xb8, 0x22, 0x51, 0x6e, 0x56, 0x16, 0x7c, 0x6f, 0x63, 0xf9, 0x07, 0x1b, 0x7b,
+	0xaf, 0xd0, 0xee, 0xc6, 0x4c, 0x89, 0x4f, 0xb3, 0xa1, 0x5a, 0xb3, 0xa6, 0xf5, 0xcb, 0x3b, 0xd8,
+	0x68, 0xcb, 0x35, 0xdd, 0xce, 0x34, 0x95, 0x43, 0x7a, 0x7d, 0xdb, 0xb6, 0x6e, 0x6e, 0xdb, 0xd6,
+	0xa7, 0xdb, 0xb6, 0x75, 0x75, 0xd7, 0x2e, 0xdc, 0xdc, 0xb5, 0x0b, 0xef, 0xef, 0xda, 0x05, 0xd4,
+	0xa4, 0xfc, 0xfe, 0xe7, 0xc6, 0xd6, 0xeb, 0x93, 0x90, 0xaa, 0xd9, 0x7c, 0x62, 0xfb, 0x3c, 0x76,
+	0xd6, 0x35, 0xff, 0x52, 0xbe, 0x11, 0x39, 0x6f, 0x56, 0xff, 0x39, 0xad, 0x8b, 0x9c, 0x54, 0x8c,
+	0xb8, 0x27, 0xdf, 0x06, 0x00, 0x59, 0x78, 0x30, 0xcb, 0x0a, 0x05, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -356,6 +536,20 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.MsgPriorities) > 0 {
+		for iNdEx := len(m.MsgPriorities) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MsgPriorities[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMsgfees(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if len(m.ConversionFeeDenom) > 0 {
 		i -= len(m.ConversionFeeDenom)
 		copy(dAtA[i:], m.ConversionFeeDenom)
@@ -381,6 +575,41 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgPriority) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPriority) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPriority) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Priority != 0 {
+		i = encodeVarintMsgfees(dAtA, i, uint64(m.Priority))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintMsgfees(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *MsgFee) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -401,6 +630,13 @@ func (m *MsgFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintMsgfees(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if m.RecipientBasisPoints != 0 {
 		i = encodeVarintMsgfees(dAtA, i, uint64(m.RecipientBasisPoints))
 		i--
@@ -433,6 +669,69 @@ func (m *MsgFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PendingMsgFeeChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingMsgFeeChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingMsgFeeChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EffectiveTime != nil {
+		n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.EffectiveTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EffectiveTime):])
+		if err3 != nil {
+			return 0, err3
+		}
+		i -= n3
+		i = encodeVarintMsgfees(dAtA, i, uint64(n3))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.EffectiveHeight != 0 {
+		i = encodeVarintMsgfees(dAtA, i, uint64(m.EffectiveHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Remove {
+		i--
+		if m.Remove {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size, err := m.MsgFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMsgfees(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Id != 0 {
+		i = encodeVarintMsgfees(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *EventMsgFee) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -547,6 +846,28 @@ func (m *Params) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovMsgfees(uint64(l))
 	}
+	if len(m.MsgPriorities) > 0 {
+		for _, e := range m.MsgPriorities {
+			l = e.Size()
+			n += 1 + l + sovMsgfees(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgPriority) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovMsgfees(uint64(l))
+	}
+	if m.Priority != 0 {
+		n += 1 + sovMsgfees(uint64(m.Priority))
+	}
 	return n
 }
 
@@ -569,6 +890,34 @@ func (m *MsgFee) Size() (n int) {
 	if m.RecipientBasisPoints != 0 {
 		n += 1 + sovMsgfees(uint64(m.RecipientBasisPoints))
 	}
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovMsgfees(uint64(l))
+	}
+	return n
+}
+
+func (m *PendingMsgFeeChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovMsgfees(uint64(m.Id))
+	}
+	l = m.MsgFee.Size()
+	n += 1 + l + sovMsgfees(uint64(l))
+	if m.Remove {
+		n += 2
+	}
+	if m.EffectiveHeight != 0 {
+		n += 1 + sovMsgfees(uint64(m.EffectiveHeight))
+	}
+	if m.EffectiveTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EffectiveTime)
+		n += 1 + l + sovMsgfees(uint64(l))
+	}
 	return n
 }
 
@@ -731,6 +1080,40 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.ConversionFeeDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgPriorities", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgPriorities = append(m.MsgPriorities, MsgPriority{})
+			if err := m.MsgPriorities[len(m.MsgPriorities)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMsgfees(dAtA[iNdEx:])
@@ -752,7 +1135,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgFee) Unmarshal(dAtA []byte) error {
+func (m *MsgPriority) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -775,10 +1158,10 @@ func (m *MsgFee) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgFee: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgPriority: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgFee: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgPriority: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -814,10 +1197,10 @@ func (m *MsgFee) Unmarshal(dAtA []byte) error {
 			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AdditionalFee", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
 			}
-			var msglen int
+			m.Priority = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgfees
@@ -827,13 +1210,114 @@ func (m *MsgFee) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Priority |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthMsgfees
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgfees(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgfees
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdditionalFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgfees
 			}
 			postIndex := iNdEx + msglen
 			if postIndex < 0 {
@@ -897,6 +1381,215 @@ func (m *MsgFee) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgfees(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PendingMsgFeeChange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgfees
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingMsgFeeChange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingMsgFeeChange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MsgFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Remove", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Remove = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveHeight", wireType)
+			}
+			m.EffectiveHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EffectiveHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EffectiveTime == nil {
+				m.EffectiveTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.EffectiveTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMsgfees(dAtA[iNdEx:])
@@ -2,6 +2,7 @@ package types
 
 import (
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -45,6 +46,21 @@ func TestMsgFeeValidate(t *testing.T) {
 			NewMsgFee(sdk.MsgTypeURL(&MsgAssessCustomMsgFeeRequest{}), sdk.NewInt64Coin(sdk.DefaultBondDenom, 0), "", DefaultMsgFeeBips),
 			"invalid fee amount",
 		},
+		{
+			"should succeed to validate with a contract address",
+			NewContractMsgFee(ExecuteContractMsgTypeURL, validAddress, sdk.NewInt64Coin(sdk.DefaultBondDenom, 100), "", 0),
+			"",
+		},
+		{
+			"should fail to validate with a contract address on another msg type",
+			NewContractMsgFee(sdk.MsgTypeURL(&MsgAssessCustomMsgFeeRequest{}), validAddress, sdk.NewInt64Coin(sdk.DefaultBondDenom, 100), "", 0),
+			"contract address can only be provided with msg type /cosmwasm.wasm.v1.MsgExecuteContract",
+		},
+		{
+			"should fail to validate with an invalid contract address",
+			NewContractMsgFee(ExecuteContractMsgTypeURL, "invalid", sdk.NewInt64Coin(sdk.DefaultBondDenom, 100), "", 0),
+			"invalid contract address \"invalid\": decoding bech32 failed: invalid bech32 string length 7",
+		},
 	}
 
 	for _, tc := range cases {
@@ -60,3 +76,71 @@ func TestMsgFeeValidate(t *testing.T) {
 		})
 	}
 }
+
+func TestPendingMsgFeeChangeValidate(t *testing.T) {
+	msgType := sdk.MsgTypeURL(&MsgAssessCustomMsgFeeRequest{})
+	msgFee := NewMsgFee(msgType, sdk.NewInt64Coin(sdk.DefaultBondDenom, 100), "", 0)
+	effectiveTime := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	cases := []struct {
+		name     string
+		change   PendingMsgFeeChange
+		errorMsg string
+	}{
+		{
+			name:   "set at a height",
+			change: NewPendingMsgFeeChange(msgFee, false, 10, nil),
+		},
+		{
+			name:   "set at a time",
+			change: NewPendingMsgFeeChange(msgFee, false, 0, &effectiveTime),
+		},
+		{
+			name:   "remove without an additional fee",
+			change: NewPendingMsgFeeChange(MsgFee{MsgTypeUrl: msgType}, true, 10, nil),
+		},
+		{
+			name:     "remove without a msg type",
+			change:   NewPendingMsgFeeChange(MsgFee{}, true, 10, nil),
+			errorMsg: "msg type is empty",
+		},
+		{
+			name:     "set with an invalid msg fee",
+			change:   NewPendingMsgFeeChange(MsgFee{MsgTypeUrl: msgType}, false, 10, nil),
+			errorMsg: "invalid fee amount",
+		},
+		{
+			name:     "neither height nor time",
+			change:   NewPendingMsgFeeChange(msgFee, false, 0, nil),
+			errorMsg: "an effective height or effective time is required",
+		},
+		{
+			name:     "both height and time",
+			change:   NewPendingMsgFeeChange(msgFee, false, 10, &effectiveTime),
+			errorMsg: "only one of effective height and effective time can be provided",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.change.Validate()
+			if len(tc.errorMsg) > 0 {
+				require.EqualError(t, err, tc.errorMsg)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestPendingMsgFeeChangeIsEffective(t *testing.T) {
+	effectiveTime := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	atHeight := PendingMsgFeeChange{EffectiveHeight: 10}
+	atTime := PendingMsgFeeChange{EffectiveTime: &effectiveTime}
+
+	require.False(t, atHeight.IsEffective(9, effectiveTime), "at height: before the height")
+	require.True(t, atHeight.IsEffective(10, effectiveTime.Add(-time.Hour)), "at height: at the height")
+	require.True(t, atHeight.IsEffective(11, effectiveTime.Add(-time.Hour)), "at height: after the height")
+	require.False(t, atTime.IsEffective(100, effectiveTime.Add(-time.Second)), "at time: before the time")
+	require.True(t, atTime.IsEffective(1, effectiveTime), "at time: at the time")
+	require.True(t, atTime.IsEffective(1, effectiveTime.Add(time.Second)), "at time: after the time")
+}
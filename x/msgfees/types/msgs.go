@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"strconv"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -25,6 +26,7 @@ var AllRequestMsgs = []sdk.Msg{
 	(*MsgRemoveMsgFeeProposalRequest)(nil),
 	(*MsgUpdateConversionFeeDenomProposalRequest)(nil),
 	(*MsgUpdateNhashPerUsdMilProposalRequest)(nil),
+	(*MsgUpdateMsgPrioritiesProposalRequest)(nil),
 }
 
 func NewMsgAssessCustomMsgFeeRequest(
@@ -76,9 +78,10 @@ func (msg MsgAssessCustomMsgFeeRequest) GetBips() (uint32, error) {
 	return uint32(bips), err //nolint:gosec // G115: ParseUint bitsize is 32, so we know this is okay.
 }
 
-func NewMsgAddMsgFeeProposalRequest(msgTypeURL string, additionalFee sdk.Coin, recipient string, recipientBasisPoints string, authority string) *MsgAddMsgFeeProposalRequest {
+func NewMsgAddMsgFeeProposalRequest(msgTypeURL string, contractAddress string, additionalFee sdk.Coin, recipient string, recipientBasisPoints string, authority string) *MsgAddMsgFeeProposalRequest {
 	return &MsgAddMsgFeeProposalRequest{
 		MsgTypeUrl:           msgTypeURL,
+		ContractAddress:      contractAddress,
 		AdditionalFee:        additionalFee,
 		Recipient:            recipient,
 		RecipientBasisPoints: recipientBasisPoints,
@@ -91,6 +94,10 @@ func (msg *MsgAddMsgFeeProposalRequest) ValidateBasic() error {
 		return ErrEmptyMsgType
 	}
 
+	if err := ValidateContractAddress(msg.MsgTypeUrl, msg.ContractAddress); err != nil {
+		return err
+	}
+
 	if !msg.AdditionalFee.IsPositive() {
 		return ErrInvalidFee
 	}
@@ -103,6 +110,10 @@ func (msg *MsgAddMsgFeeProposalRequest) ValidateBasic() error {
 		return err
 	}
 
+	if err := ValidateEffective(msg.EffectiveHeight, msg.EffectiveTime); err != nil {
+		return err
+	}
+
 	_, err := sdk.AccAddressFromBech32(msg.Authority)
 	if err != nil {
 		return err
@@ -133,9 +144,25 @@ func ValidateBips(recipient, recipientBasisPoints string) error {
 	return nil
 }
 
-func NewMsgUpdateMsgFeeProposalRequest(msgTypeURL string, additionalFee sdk.Coin, recipient string, recipientBasisPoints string, authority string) *MsgUpdateMsgFeeProposalRequest {
+// ValidateEffective makes sure that at most one of the effective height and effective time are provided.
+// Neither being provided means the change takes effect immediately.
+func ValidateEffective(effectiveHeight int64, effectiveTime *time.Time) error {
+	if effectiveHeight < 0 {
+		return fmt.Errorf("effective height cannot be negative: %d", effectiveHeight)
+	}
+	if effectiveHeight != 0 && effectiveTime != nil {
+		return errors.New("only one of effective height and effective time can be provided")
+	}
+	if effectiveTime != nil && effectiveTime.IsZero() {
+		return errors.New("effective time cannot be zero")
+	}
+	return nil
+}
+
+func NewMsgUpdateMsgFeeProposalRequest(msgTypeURL string, contractAddress string, additionalFee sdk.Coin, recipient string, recipientBasisPoints string, authority string) *MsgUpdateMsgFeeProposalRequest {
 	return &MsgUpdateMsgFeeProposalRequest{
 		MsgTypeUrl:           msgTypeURL,
+		ContractAddress:      contractAddress,
 		AdditionalFee:        additionalFee,
 		Recipient:            recipient,
 		RecipientBasisPoints: recipientBasisPoints,
@@ -148,6 +175,10 @@ func (msg *MsgUpdateMsgFeeProposalRequest) ValidateBasic() error {
 		return ErrEmptyMsgType
 	}
 
+	if err := ValidateContractAddress(msg.MsgTypeUrl, msg.ContractAddress); err != nil {
+		return err
+	}
+
 	if !msg.AdditionalFee.IsPositive() {
 		return ErrInvalidFee
 	}
@@ -156,6 +187,10 @@ func (msg *MsgUpdateMsgFeeProposalRequest) ValidateBasic() error {
 		return err
 	}
 
+	if err := ValidateEffective(msg.EffectiveHeight, msg.EffectiveTime); err != nil {
+		return err
+	}
+
 	_, err := sdk.AccAddressFromBech32(msg.Authority)
 	if err != nil {
 		return err
@@ -164,10 +199,11 @@ func (msg *MsgUpdateMsgFeeProposalRequest) ValidateBasic() error {
 	return nil
 }
 
-func NewMsgRemoveMsgFeeProposalRequest(msgTypeURL string, authority string) *MsgRemoveMsgFeeProposalRequest {
+func NewMsgRemoveMsgFeeProposalRequest(msgTypeURL string, contractAddress string, authority string) *MsgRemoveMsgFeeProposalRequest {
 	return &MsgRemoveMsgFeeProposalRequest{
-		MsgTypeUrl: msgTypeURL,
-		Authority:  authority,
+		MsgTypeUrl:      msgTypeURL,
+		ContractAddress: contractAddress,
+		Authority:       authority,
 	}
 }
 
@@ -176,6 +212,14 @@ func (msg *MsgRemoveMsgFeeProposalRequest) ValidateBasic() error {
 		return ErrEmptyMsgType
 	}
 
+	if err := ValidateContractAddress(msg.MsgTypeUrl, msg.ContractAddress); err != nil {
+		return err
+	}
+
+	if err := ValidateEffective(msg.EffectiveHeight, msg.EffectiveTime); err != nil {
+		return err
+	}
+
 	_, err := sdk.AccAddressFromBech32(msg.Authority)
 	if err != nil {
 		return err
@@ -223,3 +267,23 @@ func (msg *MsgUpdateNhashPerUsdMilProposalRequest) ValidateBasic() error {
 
 	return nil
 }
+
+func NewMsgUpdateMsgPrioritiesProposalRequest(msgPriorities []MsgPriority, authority string) *MsgUpdateMsgPrioritiesProposalRequest {
+	return &MsgUpdateMsgPrioritiesProposalRequest{
+		MsgPriorities: msgPriorities,
+		Authority:     authority,
+	}
+}
+
+func (msg *MsgUpdateMsgPrioritiesProposalRequest) ValidateBasic() error {
+	if err := ValidateMsgPriorities(msg.MsgPriorities); err != nil {
+		return err
+	}
+
+	_, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
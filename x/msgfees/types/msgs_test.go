@@ -2,6 +2,7 @@ package types_test
 
 import (
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -21,6 +22,7 @@ func TestAllMsgsGetSigners(t *testing.T) {
 		func(signer string) sdk.Msg { return &MsgRemoveMsgFeeProposalRequest{Authority: signer} },
 		func(signer string) sdk.Msg { return &MsgUpdateConversionFeeDenomProposalRequest{Authority: signer} },
 		func(signer string) sdk.Msg { return &MsgUpdateNhashPerUsdMilProposalRequest{Authority: signer} },
+		func(signer string) sdk.Msg { return &MsgUpdateMsgPrioritiesProposalRequest{Authority: signer} },
 	}
 
 	testutil.RunGetSignersTests(t, AllRequestMsgs, msgMakers, nil)
@@ -376,6 +378,7 @@ func TestMsgRemoveMsgFeeProposalRequestValidateBasic(t *testing.T) {
 	msgType := sdk.MsgTypeURL(&metadatatypes.MsgWriteRecordRequest{})
 
 	authority := sdk.AccAddress("input111111111111111").String()
+	effectiveTime := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
 
 	cases := []struct {
 		name     string
@@ -406,6 +409,43 @@ func TestMsgRemoveMsgFeeProposalRequestValidateBasic(t *testing.T) {
 			},
 			errorMsg: "empty address string is not allowed",
 		},
+		{
+			name: "scheduled at a height",
+			msg: MsgRemoveMsgFeeProposalRequest{
+				MsgTypeUrl:      msgType,
+				Authority:       authority,
+				EffectiveHeight: 100,
+			},
+			errorMsg: "",
+		},
+		{
+			name: "scheduled at a time",
+			msg: MsgRemoveMsgFeeProposalRequest{
+				MsgTypeUrl:    msgType,
+				Authority:     authority,
+				EffectiveTime: &effectiveTime,
+			},
+			errorMsg: "",
+		},
+		{
+			name: "negative effective height",
+			msg: MsgRemoveMsgFeeProposalRequest{
+				MsgTypeUrl:      msgType,
+				Authority:       authority,
+				EffectiveHeight: -1,
+			},
+			errorMsg: "effective height cannot be negative: -1",
+		},
+		{
+			name: "both effective height and time",
+			msg: MsgRemoveMsgFeeProposalRequest{
+				MsgTypeUrl:      msgType,
+				Authority:       authority,
+				EffectiveHeight: 100,
+				EffectiveTime:   &effectiveTime,
+			},
+			errorMsg: "only one of effective height and effective time can be provided",
+		},
 	}
 
 	for _, tc := range cases {
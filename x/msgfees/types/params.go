@@ -1,6 +1,9 @@
 package types
 
 import (
+	"fmt"
+	"strings"
+
 	sdkmath "cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -40,3 +43,60 @@ func DefaultParams() Params {
 		pioconfig.GetProvenanceConfig().FeeDenom,
 	)
 }
+
+// Validate returns an error if any of the params are invalid.
+func (p Params) Validate() error {
+	return ValidateMsgPriorities(p.MsgPriorities)
+}
+
+// NewMsgPriority creates a new MsgPriority.
+func NewMsgPriority(msgTypeURL string, priority int64) MsgPriority {
+	return MsgPriority{
+		MsgTypeUrl: msgTypeURL,
+		Priority:   priority,
+	}
+}
+
+// Validate returns an error if this MsgPriority is invalid.
+func (p MsgPriority) Validate() error {
+	if len(p.MsgTypeUrl) == 0 {
+		return ErrEmptyMsgType
+	}
+	if !strings.HasPrefix(p.MsgTypeUrl, "/") {
+		return fmt.Errorf("invalid msg priority type url %q: must start with a /", p.MsgTypeUrl)
+	}
+	if p.Priority <= 0 {
+		return fmt.Errorf("invalid msg priority %d for %q: must be positive", p.Priority, p.MsgTypeUrl)
+	}
+	return nil
+}
+
+// ValidateMsgPriorities returns an error if any of the provided msg priorities are invalid,
+// or if a msg type has more than one priority.
+func ValidateMsgPriorities(msgPriorities []MsgPriority) error {
+	seen := make(map[string]bool, len(msgPriorities))
+	for _, msgPriority := range msgPriorities {
+		if err := msgPriority.Validate(); err != nil {
+			return err
+		}
+		if seen[msgPriority.MsgTypeUrl] {
+			return fmt.Errorf("duplicate msg priority type url %q", msgPriority.MsgTypeUrl)
+		}
+		seen[msgPriority.MsgTypeUrl] = true
+	}
+	return nil
+}
+
+const (
+	// FeatureMsgPriorities is the feature name used when some messages get a higher mempool priority.
+	FeatureMsgPriorities = "msg-priorities"
+)
+
+// Features returns the names of the optional msgfees features that are enabled by these params.
+func (p Params) Features() []string {
+	var rv []string
+	if len(p.MsgPriorities) > 0 {
+		rv = append(rv, FeatureMsgPriorities)
+	}
+	return rv
+}
@@ -31,3 +31,50 @@ func TestDefault(t *testing.T) {
 	assert.Equal(t, DefaultNhashPerUsdMil, msgFeeData.NhashPerUsdMil)
 	assert.Equal(t, pioconfig.GetProvenanceConfig().FeeDenom, msgFeeData.ConversionFeeDenom)
 }
+
+func TestValidateMsgPriorities(t *testing.T) {
+	tests := []struct {
+		name       string
+		priorities []MsgPriority
+		expErr     string
+	}{
+		{name: "nil", priorities: nil},
+		{
+			name: "valid",
+			priorities: []MsgPriority{
+				NewMsgPriority("/cosmos.bank.v1beta1.MsgSend", 100),
+				NewMsgPriority("/provenance.marker.v1.MsgTransferRequest", 1),
+			},
+		},
+		{name: "empty", priorities: []MsgPriority{NewMsgPriority("", 1)}, expErr: ErrEmptyMsgType.Error()},
+		{
+			name:       "no leading slash",
+			priorities: []MsgPriority{NewMsgPriority("cosmos.bank.v1beta1.MsgSend", 1)},
+			expErr:     `invalid msg priority type url "cosmos.bank.v1beta1.MsgSend": must start with a /`,
+		},
+		{
+			name:       "zero priority",
+			priorities: []MsgPriority{NewMsgPriority("/cosmos.bank.v1beta1.MsgSend", 0)},
+			expErr:     `invalid msg priority 0 for "/cosmos.bank.v1beta1.MsgSend": must be positive`,
+		},
+		{
+			name: "duplicate",
+			priorities: []MsgPriority{
+				NewMsgPriority("/cosmos.bank.v1beta1.MsgSend", 1),
+				NewMsgPriority("/cosmos.bank.v1beta1.MsgSend", 2),
+			},
+			expErr: `duplicate msg priority type url "/cosmos.bank.v1beta1.MsgSend"`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := ValidateMsgPriorities(tc.priorities)
+			if len(tc.expErr) > 0 {
+				assert.EqualError(t, err, tc.expErr, "ValidateMsgPriorities")
+			} else {
+				assert.NoError(t, err, "ValidateMsgPriorities")
+			}
+		})
+	}
+}
@@ -217,6 +217,107 @@ func (m *QueryAllMsgFeesResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QueryMsgFeeScheduleRequest is the request type for the Query/MsgFeeSchedule RPC method.
+type QueryMsgFeeScheduleRequest struct {
+	// msg_type_url is an optional type-url to limit the results to, e.g. "/cosmos.bank.v1beta1.MsgSend".
+	MsgTypeUrl string `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+}
+
+func (m *QueryMsgFeeScheduleRequest) Reset()         { *m = QueryMsgFeeScheduleRequest{} }
+func (m *QueryMsgFeeScheduleRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMsgFeeScheduleRequest) ProtoMessage()    {}
+func (*QueryMsgFeeScheduleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_73f2d53a5aebf81b, []int{4}
+}
+func (m *QueryMsgFeeScheduleRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMsgFeeScheduleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMsgFeeScheduleRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMsgFeeScheduleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMsgFeeScheduleRequest.Merge(m, src)
+}
+func (m *QueryMsgFeeScheduleRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMsgFeeScheduleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMsgFeeScheduleRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMsgFeeScheduleRequest proto.InternalMessageInfo
+
+func (m *QueryMsgFeeScheduleRequest) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
+}
+
+// QueryMsgFeeScheduleResponse is the response type for the Query/MsgFeeSchedule RPC method.
+type QueryMsgFeeScheduleResponse struct {
+	// current are the msg fees that are currently in effect.
+	Current []MsgFee `protobuf:"bytes,1,rep,name=current,proto3" json:"current"`
+	// pending are the msg fee changes that will take effect later, in the order they were approved.
+	Pending []PendingMsgFeeChange `protobuf:"bytes,2,rep,name=pending,proto3" json:"pending"`
+}
+
+func (m *QueryMsgFeeScheduleResponse) Reset()         { *m = QueryMsgFeeScheduleResponse{} }
+func (m *QueryMsgFeeScheduleResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMsgFeeScheduleResponse) ProtoMessage()    {}
+func (*QueryMsgFeeScheduleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_73f2d53a5aebf81b, []int{5}
+}
+func (m *QueryMsgFeeScheduleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMsgFeeScheduleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMsgFeeScheduleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMsgFeeScheduleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMsgFeeScheduleResponse.Merge(m, src)
+}
+func (m *QueryMsgFeeScheduleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMsgFeeScheduleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMsgFeeScheduleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMsgFeeScheduleResponse proto.InternalMessageInfo
+
+func (m *QueryMsgFeeScheduleResponse) GetCurrent() []MsgFee {
+	if m != nil {
+		return m.Current
+	}
+	return nil
+}
+
+func (m *QueryMsgFeeScheduleResponse) GetPending() []PendingMsgFeeChange {
+	if m != nil {
+		return m.Pending
+	}
+	return nil
+}
+
 // CalculateTxFeesRequest is the request type for the Query RPC method.
 type CalculateTxFeesRequest struct {
 	// tx_bytes is the transaction to simulate.
@@ -232,7 +333,7 @@ func (m *CalculateTxFeesRequest) Reset()         { *m = CalculateTxFeesRequest{}
 func (m *CalculateTxFeesRequest) String() string { return proto.CompactTextString(m) }
 func (*CalculateTxFeesRequest) ProtoMessage()    {}
 func (*CalculateTxFeesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_73f2d53a5aebf81b, []int{4}
+	return fileDescriptor_73f2d53a5aebf81b, []int{6}
 }
 func (m *CalculateTxFeesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -297,7 +398,7 @@ func (m *CalculateTxFeesResponse) Reset()         { *m = CalculateTxFeesResponse
 func (m *CalculateTxFeesResponse) String() string { return proto.CompactTextString(m) }
 func (*CalculateTxFeesResponse) ProtoMessage()    {}
 func (*CalculateTxFeesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_73f2d53a5aebf81b, []int{5}
+	return fileDescriptor_73f2d53a5aebf81b, []int{7}
 }
 func (m *CalculateTxFeesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -352,6 +453,8 @@ func init() {
 	proto.RegisterType((*QueryParamsResponse)(nil), "provenance.msgfees.v1.QueryParamsResponse")
 	proto.RegisterType((*QueryAllMsgFeesRequest)(nil), "provenance.msgfees.v1.QueryAllMsgFeesRequest")
 	proto.RegisterType((*QueryAllMsgFeesResponse)(nil), "provenance.msgfees.v1.QueryAllMsgFeesResponse")
+	proto.RegisterType((*QueryMsgFeeScheduleRequest)(nil), "provenance.msgfees.v1.QueryMsgFeeScheduleRequest")
+	proto.RegisterType((*QueryMsgFeeScheduleResponse)(nil), "provenance.msgfees.v1.QueryMsgFeeScheduleResponse")
 	proto.RegisterType((*CalculateTxFeesRequest)(nil), "provenance.msgfees.v1.CalculateTxFeesRequest")
 	proto.RegisterType((*CalculateTxFeesResponse)(nil), "provenance.msgfees.v1.CalculateTxFeesResponse")
 }
@@ -359,52 +462,60 @@ func init() {
 func init() { proto.RegisterFile("provenance/msgfees/v1/query.proto", fileDescriptor_73f2d53a5aebf81b) }
 
 var fileDescriptor_73f2d53a5aebf81b = []byte{
-	// 718 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x94, 0xcf, 0x4f, 0x13, 0x4d,
-	0x18, 0xc7, 0x3b, 0x85, 0x97, 0x1f, 0xf3, 0xf2, 0xe3, 0x7d, 0x47, 0x84, 0xd2, 0x40, 0xc1, 0x25,
-	0x28, 0x34, 0xb2, 0x9b, 0x82, 0x07, 0xa3, 0x27, 0x8a, 0x81, 0x93, 0x09, 0x6e, 0x3c, 0x79, 0x59,
-	0xa7, 0xbb, 0xc3, 0xb8, 0xba, 0xbb, 0xb3, 0x74, 0xa6, 0x4d, 0x7b, 0x33, 0x1e, 0x8c, 0xf1, 0x64,
-	0xa2, 0x27, 0xe3, 0xd9, 0x18, 0x4f, 0xfc, 0x19, 0x9c, 0x0c, 0x89, 0x17, 0x4f, 0x6a, 0xc0, 0x84,
-	0x7f, 0xc3, 0xcc, 0xcc, 0xb6, 0x5d, 0x68, 0x8b, 0x9c, 0xbc, 0xc0, 0xf6, 0x99, 0xe7, 0x99, 0xef,
-	0x67, 0x9e, 0xf9, 0x3e, 0x03, 0xaf, 0xc5, 0x55, 0x56, 0x27, 0x11, 0x8e, 0x5c, 0x62, 0x85, 0x9c,
-	0xee, 0x11, 0xc2, 0xad, 0x7a, 0xc9, 0xda, 0xaf, 0x91, 0x6a, 0xd3, 0x8c, 0xab, 0x4c, 0x30, 0x74,
-	0xb5, 0x93, 0x62, 0x26, 0x29, 0x66, 0xbd, 0x94, 0xff, 0x1f, 0x87, 0x7e, 0xc4, 0x2c, 0xf5, 0x57,
-	0x67, 0xe6, 0xa7, 0x28, 0xa3, 0x4c, 0x7d, 0x5a, 0xf2, 0x2b, 0x89, 0xce, 0x51, 0xc6, 0x68, 0x40,
-	0x2c, 0x1c, 0xfb, 0x16, 0x8e, 0x22, 0x26, 0xb0, 0xf0, 0x59, 0xc4, 0x93, 0xd5, 0xa5, 0xde, 0x00,
-	0x2d, 0x21, 0x9d, 0x54, 0x70, 0x19, 0x0f, 0x19, 0xb7, 0x2a, 0x98, 0x13, 0xab, 0x5e, 0xaa, 0x10,
-	0x81, 0x4b, 0x96, 0xcb, 0xfc, 0x28, 0x59, 0x2f, 0xa6, 0xd7, 0x15, 0x7b, 0x3b, 0x2b, 0xc6, 0xd4,
-	0x8f, 0x94, 0xa2, 0xce, 0x35, 0xa6, 0x20, 0x7a, 0x20, 0x33, 0x76, 0x71, 0x15, 0x87, 0xdc, 0x26,
-	0xfb, 0x35, 0xc2, 0x85, 0x61, 0xc3, 0x2b, 0x67, 0xa2, 0x3c, 0x66, 0x11, 0x27, 0xe8, 0x2e, 0x1c,
-	0x8a, 0x55, 0x24, 0x07, 0x16, 0xc1, 0xca, 0xbf, 0xeb, 0xf3, 0x66, 0xcf, 0x66, 0x98, 0xba, 0xac,
-	0x3c, 0x78, 0xf8, 0x7d, 0x21, 0x63, 0x27, 0x25, 0xc6, 0x63, 0x38, 0xad, 0xf6, 0xdc, 0x0c, 0x82,
-	0xfb, 0x9c, 0x6e, 0x13, 0xd2, 0x52, 0x43, 0xdb, 0x10, 0x76, 0xb8, 0x72, 0x59, 0xb5, 0xf5, 0x75,
-	0x53, 0x1f, 0xc2, 0x94, 0x87, 0x30, 0xf5, 0x05, 0x24, 0x87, 0x30, 0x77, 0x31, 0x25, 0x49, 0xad,
-	0x9d, 0xaa, 0x34, 0x3e, 0x00, 0x38, 0xd3, 0x25, 0x91, 0xa0, 0xdf, 0x86, 0x23, 0x21, 0xa7, 0x8e,
-	0x24, 0xcc, 0x81, 0xc5, 0x81, 0x0b, 0xe0, 0x75, 0xa5, 0x3d, 0x1c, 0xea, 0x1d, 0xd0, 0x4e, 0x0f,
-	0xba, 0x1b, 0x7f, 0xa4, 0xd3, 0xb2, 0x67, 0xf0, 0x5e, 0x01, 0x38, 0xbd, 0x85, 0x03, 0xb7, 0x16,
-	0x60, 0x41, 0x1e, 0x36, 0xd2, 0x1d, 0x98, 0x85, 0x23, 0xa2, 0xe1, 0x54, 0x9a, 0x82, 0xe8, 0xd6,
-	0x8e, 0xd9, 0xc3, 0xa2, 0x51, 0x96, 0x3f, 0xd1, 0x4d, 0x88, 0x3c, 0xb2, 0x87, 0x6b, 0x81, 0x70,
-	0xa4, 0x98, 0xe3, 0x91, 0x88, 0x85, 0x0a, 0x63, 0xd4, 0xfe, 0x2f, 0x59, 0x29, 0x63, 0x4e, 0xee,
-	0xc9, 0x38, 0x5a, 0x86, 0x13, 0x14, 0x73, 0x07, 0x7b, 0x4f, 0x6b, 0x5c, 0x84, 0x24, 0x12, 0xb9,
-	0x81, 0x45, 0xb0, 0x92, 0xb5, 0xc7, 0x29, 0xe6, 0x9b, 0xed, 0xa0, 0xf1, 0x25, 0x0b, 0x67, 0xba,
-	0x50, 0x92, 0x4e, 0xbd, 0x06, 0x70, 0x12, 0x7b, 0x9e, 0x2f, 0x99, 0x71, 0x90, 0xee, 0xd8, 0xec,
-	0x99, 0x53, 0xb7, 0xce, 0xbb, 0xc5, 0xfc, 0xa8, 0xbc, 0x2d, 0xaf, 0xfa, 0xf3, 0x8f, 0x85, 0x15,
-	0xea, 0x8b, 0x27, 0xb5, 0x8a, 0xe9, 0xb2, 0xd0, 0x4a, 0x5c, 0xa8, 0xff, 0xad, 0x71, 0xef, 0x99,
-	0x25, 0x9a, 0x31, 0xe1, 0xaa, 0x80, 0xbf, 0x3f, 0x3d, 0x28, 0x8e, 0x05, 0x84, 0x62, 0xb7, 0xe9,
-	0x48, 0xeb, 0xf2, 0x4f, 0xa7, 0x07, 0x45, 0x60, 0x4f, 0x74, 0x94, 0x55, 0xf3, 0x9f, 0x03, 0x08,
-	0x05, 0x13, 0x2d, 0x8e, 0xec, 0xdf, 0xe2, 0x18, 0x55, 0xa2, 0x0a, 0x61, 0x09, 0x8e, 0x13, 0x2e,
-	0xfc, 0x10, 0x0b, 0xe2, 0x39, 0x14, 0x73, 0xd5, 0xd1, 0x41, 0x7b, 0xac, 0x1d, 0xdc, 0xc1, 0x7c,
-	0xfd, 0x68, 0x00, 0xfe, 0xa3, 0xac, 0x87, 0x5e, 0x02, 0x38, 0xa4, 0xfd, 0x8f, 0x56, 0xfb, 0x38,
-	0xac, 0x7b, 0xe0, 0xf2, 0xc5, 0xcb, 0xa4, 0xea, 0x0b, 0x32, 0x96, 0x5f, 0x7c, 0xfd, 0xf5, 0x36,
-	0xbb, 0x80, 0xe6, 0xad, 0xde, 0x8f, 0x85, 0x9e, 0x37, 0xf4, 0x0e, 0xc0, 0xc9, 0x73, 0xd3, 0x80,
-	0xd6, 0x2e, 0x92, 0xe9, 0x1a, 0xcc, 0xbc, 0x79, 0xd9, 0xf4, 0x84, 0xcc, 0x50, 0x64, 0x73, 0x28,
-	0xdf, 0x87, 0x0c, 0x07, 0x01, 0xfa, 0x08, 0xe0, 0xe4, 0x39, 0xeb, 0xf5, 0xc5, 0xea, 0x3d, 0x2d,
-	0x7d, 0xb1, 0xfa, 0x38, 0xda, 0xb8, 0xa5, 0xb0, 0xcc, 0x3b, 0xa0, 0x68, 0xac, 0xa6, 0xc9, 0x44,
-	0x43, 0x42, 0xb9, 0xad, 0x2a, 0x47, 0x3e, 0x10, 0xd2, 0x52, 0x9e, 0x34, 0x5b, 0xd9, 0x3f, 0x3c,
-	0x2e, 0x80, 0xa3, 0xe3, 0x02, 0xf8, 0x79, 0x5c, 0x00, 0x6f, 0x4e, 0x0a, 0x99, 0xa3, 0x93, 0x42,
-	0xe6, 0xdb, 0x49, 0x21, 0x03, 0x73, 0x3e, 0xeb, 0x4d, 0xb0, 0x0b, 0x1e, 0x6d, 0xa4, 0x8c, 0xd7,
-	0xc9, 0x59, 0xf3, 0x59, 0x5a, 0xb8, 0xd1, 0x6e, 0x8a, 0x72, 0x62, 0x65, 0x48, 0xbd, 0xc5, 0x1b,
-	0xbf, 0x03, 0x00, 0x00, 0xff, 0xff, 0x36, 0xb4, 0xcd, 0xe3, 0x7f, 0x06, 0x00, 0x00,
+	// 844 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x95, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xc7, 0x33, 0x6e, 0x88, 0x9b, 0xc1, 0x4d, 0x60, 0x28, 0xad, 0xbb, 0xb4, 0xb6, 0xbb, 0x55,
+	0x69, 0x6a, 0x91, 0x5d, 0x39, 0xe5, 0x80, 0x40, 0x20, 0xd5, 0x41, 0xa9, 0x84, 0x84, 0x14, 0x96,
+	0x72, 0xe1, 0xb2, 0x8c, 0x77, 0xa7, 0x93, 0x85, 0xdd, 0x9d, 0xed, 0xce, 0xac, 0x65, 0xdf, 0x10,
+	0x07, 0x84, 0x38, 0x21, 0xc1, 0x09, 0x71, 0xe0, 0x84, 0x2a, 0x4e, 0xfd, 0x33, 0x7a, 0x42, 0x95,
+	0xb8, 0x70, 0x02, 0x94, 0x20, 0xf5, 0x0f, 0xe0, 0x1f, 0x40, 0xf3, 0x63, 0xfd, 0x23, 0x5e, 0x07,
+	0x73, 0xe1, 0x92, 0x6c, 0x66, 0xbe, 0xef, 0xbd, 0xcf, 0x7b, 0x6f, 0xde, 0x0b, 0xbc, 0x9e, 0xe5,
+	0x6c, 0x48, 0x52, 0x9c, 0x06, 0xc4, 0x4d, 0x38, 0x7d, 0x40, 0x08, 0x77, 0x87, 0x3d, 0xf7, 0x61,
+	0x41, 0xf2, 0xb1, 0x93, 0xe5, 0x4c, 0x30, 0xf4, 0xf2, 0x54, 0xe2, 0x18, 0x89, 0x33, 0xec, 0x59,
+	0x2f, 0xe2, 0x24, 0x4a, 0x99, 0xab, 0x7e, 0x6a, 0xa5, 0x75, 0x91, 0x32, 0xca, 0xd4, 0xa7, 0x2b,
+	0xbf, 0xcc, 0xe9, 0x55, 0xca, 0x18, 0x8d, 0x89, 0x8b, 0xb3, 0xc8, 0xc5, 0x69, 0xca, 0x04, 0x16,
+	0x11, 0x4b, 0xb9, 0xb9, 0xbd, 0x51, 0x0d, 0x50, 0x06, 0xd2, 0xa2, 0x56, 0xc0, 0x78, 0xc2, 0xb8,
+	0x3b, 0xc0, 0x9c, 0xb8, 0xc3, 0xde, 0x80, 0x08, 0xdc, 0x73, 0x03, 0x16, 0xa5, 0xe6, 0xbe, 0x3b,
+	0x7b, 0xaf, 0xd8, 0x27, 0xaa, 0x0c, 0xd3, 0x28, 0x55, 0x11, 0xb5, 0xd6, 0xbe, 0x08, 0xd1, 0x07,
+	0x52, 0x71, 0x88, 0x73, 0x9c, 0x70, 0x8f, 0x3c, 0x2c, 0x08, 0x17, 0xb6, 0x07, 0x5f, 0x9a, 0x3b,
+	0xe5, 0x19, 0x4b, 0x39, 0x41, 0x6f, 0xc1, 0x8d, 0x4c, 0x9d, 0x34, 0x41, 0x07, 0xec, 0x3c, 0xbf,
+	0x77, 0xcd, 0xa9, 0x2c, 0x86, 0xa3, 0xcd, 0xfa, 0xeb, 0x4f, 0x7e, 0x6f, 0xaf, 0x79, 0xc6, 0xc4,
+	0xfe, 0x04, 0x5e, 0x52, 0x3e, 0xef, 0xc6, 0xf1, 0xfb, 0x9c, 0x1e, 0x10, 0x52, 0x46, 0x43, 0x07,
+	0x10, 0x4e, 0xb9, 0x9a, 0x35, 0xe5, 0xfa, 0x55, 0x47, 0x27, 0xe1, 0xc8, 0x24, 0x1c, 0xdd, 0x00,
+	0x93, 0x84, 0x73, 0x88, 0x29, 0x31, 0xb6, 0xde, 0x8c, 0xa5, 0xfd, 0x03, 0x80, 0x97, 0x17, 0x42,
+	0x18, 0xf4, 0x37, 0xe0, 0xf9, 0x84, 0x53, 0x5f, 0x12, 0x36, 0x41, 0xe7, 0xdc, 0x19, 0xf0, 0xda,
+	0xd2, 0xab, 0x27, 0xda, 0x03, 0xba, 0x57, 0x41, 0x77, 0xeb, 0x5f, 0xe9, 0x74, 0xd8, 0x39, 0xbc,
+	0x77, 0xa0, 0xa5, 0xe8, 0x74, 0x80, 0x0f, 0x83, 0x23, 0x12, 0x16, 0x71, 0x99, 0x08, 0xea, 0xc0,
+	0x86, 0x04, 0x14, 0xe3, 0x8c, 0xf8, 0x45, 0x1e, 0xab, 0x0a, 0x6f, 0x7a, 0x30, 0xe1, 0xf4, 0xfe,
+	0x38, 0x23, 0x1f, 0xe5, 0xb1, 0xfd, 0x08, 0xc0, 0x57, 0x2a, 0x1d, 0x98, 0x14, 0xdf, 0x86, 0xf5,
+	0xa0, 0xc8, 0x73, 0x92, 0x8a, 0x95, 0x32, 0x34, 0xed, 0x29, 0x6d, 0xd0, 0x7b, 0xb0, 0x9e, 0x91,
+	0x34, 0x8c, 0x52, 0xda, 0xac, 0x29, 0xf3, 0xee, 0xb2, 0xee, 0x6a, 0x95, 0xf6, 0xb2, 0x7f, 0x84,
+	0x53, 0x3a, 0xf1, 0x65, 0x1c, 0xd8, 0x5f, 0x01, 0x78, 0x69, 0x1f, 0xc7, 0x41, 0x11, 0x63, 0x41,
+	0xee, 0x8f, 0x66, 0x9b, 0x7d, 0x05, 0x9e, 0x17, 0x23, 0x7f, 0x30, 0x16, 0x44, 0xbf, 0xa2, 0x86,
+	0x57, 0x17, 0xa3, 0xbe, 0xfc, 0x13, 0xbd, 0x06, 0x51, 0x48, 0x1e, 0xe0, 0x22, 0x16, 0xbe, 0xac,
+	0xab, 0x1f, 0x92, 0x94, 0x25, 0xaa, 0xe2, 0x9b, 0xde, 0x0b, 0xe6, 0xa6, 0x8f, 0x39, 0x79, 0x57,
+	0x9e, 0xa3, 0x9b, 0x70, 0x8b, 0x62, 0xee, 0xe3, 0xf0, 0xd3, 0x82, 0x8b, 0x44, 0x66, 0x7d, 0xae,
+	0x03, 0x76, 0x6a, 0xde, 0x05, 0x8a, 0xf9, 0xdd, 0xc9, 0xa1, 0xfd, 0x4b, 0x0d, 0x5e, 0x5e, 0x40,
+	0x31, 0x15, 0xfb, 0x1a, 0xc0, 0x6d, 0x1c, 0x86, 0x91, 0x6c, 0x0f, 0x8e, 0x67, 0x1f, 0xc7, 0x95,
+	0xb9, 0x06, 0x97, 0xad, 0xdd, 0x67, 0x51, 0xda, 0x3f, 0x90, 0xa9, 0xfe, 0xfc, 0x47, 0x7b, 0x87,
+	0x46, 0xe2, 0xa8, 0x18, 0x38, 0x01, 0x4b, 0x5c, 0x33, 0x70, 0xfa, 0xd7, 0x2e, 0x0f, 0x3f, 0x73,
+	0x65, 0x1f, 0xb9, 0x32, 0xe0, 0xdf, 0x3f, 0x7b, 0xdc, 0x6d, 0xc4, 0x84, 0xe2, 0x60, 0xec, 0xcb,
+	0x29, 0xe5, 0x8f, 0x9e, 0x3d, 0xee, 0x02, 0x6f, 0x6b, 0x1a, 0x59, 0xbd, 0xb3, 0xcf, 0x01, 0x84,
+	0x82, 0x89, 0x92, 0xa3, 0xf6, 0x7f, 0x71, 0x6c, 0xaa, 0xa0, 0x0a, 0xe1, 0x06, 0xbc, 0x40, 0xb8,
+	0x88, 0x12, 0x2c, 0x48, 0xe8, 0x53, 0xcc, 0x55, 0x45, 0xd7, 0xbd, 0xc6, 0xe4, 0xf0, 0x1e, 0xe6,
+	0x7b, 0x7f, 0xaf, 0xc3, 0xe7, 0xd4, 0x33, 0x44, 0x5f, 0x02, 0xb8, 0xa1, 0x47, 0x1d, 0xdd, 0x5e,
+	0xf2, 0x56, 0x16, 0x77, 0x8b, 0xd5, 0x5d, 0x45, 0xaa, 0x1b, 0x64, 0xdf, 0xfc, 0xe2, 0xd7, 0xbf,
+	0xbe, 0xad, 0xb5, 0xd1, 0x35, 0xb7, 0x7a, 0x2f, 0xea, 0xd5, 0x82, 0xbe, 0x03, 0x70, 0xfb, 0xd4,
+	0xe0, 0xa3, 0xdd, 0xb3, 0xc2, 0x2c, 0xec, 0x20, 0xcb, 0x59, 0x55, 0x6e, 0xc8, 0x6c, 0x45, 0x76,
+	0x15, 0x59, 0x4b, 0xc8, 0x70, 0x1c, 0xa3, 0x1f, 0x01, 0xdc, 0x9a, 0x9f, 0x55, 0xd4, 0x3b, 0x2b,
+	0x4c, 0xe5, 0x62, 0xb0, 0xf6, 0xfe, 0x8b, 0x89, 0xa1, 0xbb, 0xa5, 0xe8, 0xae, 0xa3, 0xf6, 0x12,
+	0x3a, 0x5e, 0xf2, 0xfc, 0x04, 0xe0, 0xf6, 0xa9, 0xe9, 0x58, 0x5a, 0xb9, 0xea, 0x81, 0xb6, 0x9c,
+	0x55, 0xe5, 0x86, 0xed, 0x75, 0xc5, 0xe6, 0xbc, 0x09, 0xba, 0xf6, 0xed, 0x59, 0x3c, 0x31, 0x92,
+	0x64, 0x41, 0x69, 0xe5, 0xcb, 0x6d, 0x28, 0x5f, 0x7d, 0x28, 0xe7, 0xa1, 0x1f, 0x3d, 0x39, 0x6e,
+	0x81, 0xa7, 0xc7, 0x2d, 0xf0, 0xe7, 0x71, 0x0b, 0x7c, 0x73, 0xd2, 0x5a, 0x7b, 0x7a, 0xd2, 0x5a,
+	0xfb, 0xed, 0xa4, 0xb5, 0x06, 0x9b, 0x11, 0xab, 0x26, 0x38, 0x04, 0x1f, 0xdf, 0x99, 0x99, 0x8d,
+	0xa9, 0x66, 0x37, 0x62, 0xb3, 0x81, 0x47, 0x93, 0xca, 0xa8, 0x61, 0x19, 0x6c, 0xa8, 0xff, 0x8c,
+	0x77, 0xfe, 0x19, 0x00, 0x38, 0xbb, 0x3f, 0xcc, 0x0d, 0x08, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -423,6 +534,9 @@ type QueryClient interface {
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 	// Query all Msgs which have fees associated with them.
 	QueryAllMsgFees(ctx context.Context, in *QueryAllMsgFeesRequest, opts ...grpc.CallOption) (*QueryAllMsgFeesResponse, error)
+	// MsgFeeSchedule returns the current msg fees and the changes to them that are pending, i.e. approved by governance
+	// but not yet in effect.
+	MsgFeeSchedule(ctx context.Context, in *QueryMsgFeeScheduleRequest, opts ...grpc.CallOption) (*QueryMsgFeeScheduleResponse, error)
 	// CalculateTxFees simulates executing a transaction for estimating gas usage and additional fees.
 	CalculateTxFees(ctx context.Context, in *CalculateTxFeesRequest, opts ...grpc.CallOption) (*CalculateTxFeesResponse, error)
 }
@@ -453,6 +567,15 @@ func (c *queryClient) QueryAllMsgFees(ctx context.Context, in *QueryAllMsgFeesRe
 	return out, nil
 }
 
+func (c *queryClient) MsgFeeSchedule(ctx context.Context, in *QueryMsgFeeScheduleRequest, opts ...grpc.CallOption) (*QueryMsgFeeScheduleResponse, error) {
+	out := new(QueryMsgFeeScheduleResponse)
+	err := c.cc.Invoke(ctx, "/provenance.msgfees.v1.Query/MsgFeeSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) CalculateTxFees(ctx context.Context, in *CalculateTxFeesRequest, opts ...grpc.CallOption) (*CalculateTxFeesResponse, error) {
 	out := new(CalculateTxFeesResponse)
 	err := c.cc.Invoke(ctx, "/provenance.msgfees.v1.Query/CalculateTxFees", in, out, opts...)
@@ -468,6 +591,9 @@ type QueryServer interface {
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 	// Query all Msgs which have fees associated with them.
 	QueryAllMsgFees(context.Context, *QueryAllMsgFeesRequest) (*QueryAllMsgFeesResponse, error)
+	// MsgFeeSchedule returns the current msg fees and the changes to them that are pending, i.e. approved by governance
+	// but not yet in effect.
+	MsgFeeSchedule(context.Context, *QueryMsgFeeScheduleRequest) (*QueryMsgFeeScheduleResponse, error)
 	// CalculateTxFees simulates executing a transaction for estimating gas usage and additional fees.
 	CalculateTxFees(context.Context, *CalculateTxFeesRequest) (*CalculateTxFeesResponse, error)
 }
@@ -482,6 +608,9 @@ func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsReq
 func (*UnimplementedQueryServer) QueryAllMsgFees(ctx context.Context, req *QueryAllMsgFeesRequest) (*QueryAllMsgFeesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method QueryAllMsgFees not implemented")
 }
+func (*UnimplementedQueryServer) MsgFeeSchedule(ctx context.Context, req *QueryMsgFeeScheduleRequest) (*QueryMsgFeeScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MsgFeeSchedule not implemented")
+}
 func (*UnimplementedQueryServer) CalculateTxFees(ctx context.Context, req *CalculateTxFeesRequest) (*CalculateTxFeesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CalculateTxFees not implemented")
 }
@@ -526,6 +655,24 @@ func _Query_QueryAllMsgFees_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_MsgFeeSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMsgFeeScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MsgFeeSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.msgfees.v1.Query/MsgFeeSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MsgFeeSchedule(ctx, req.(*QueryMsgFeeScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_CalculateTxFees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CalculateTxFeesRequest)
 	if err := dec(in); err != nil {
@@ -557,6 +704,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "QueryAllMsgFees",
 			Handler:    _Query_QueryAllMsgFees_Handler,
 		},
+		{
+			MethodName: "MsgFeeSchedule",
+			Handler:    _Query_MsgFeeSchedule_Handler,
+		},
 		{
 			MethodName: "CalculateTxFees",
 			Handler:    _Query_CalculateTxFees_Handler,
@@ -706,6 +857,87 @@ func (m *QueryAllMsgFeesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryMsgFeeScheduleRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMsgFeeScheduleRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMsgFeeScheduleRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMsgFeeScheduleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMsgFeeScheduleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMsgFeeScheduleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Pending) > 0 {
+		for iNdEx := len(m.Pending) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Pending[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Current) > 0 {
+		for iNdEx := len(m.Current) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Current[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *CalculateTxFeesRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -868,6 +1100,40 @@ func (m *QueryAllMsgFeesResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryMsgFeeScheduleRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMsgFeeScheduleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Current) > 0 {
+		for _, e := range m.Current {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Pending) > 0 {
+		for _, e := range m.Pending {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *CalculateTxFeesRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1257,6 +1523,206 @@ func (m *QueryAllMsgFeesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryMsgFeeScheduleRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMsgFeeScheduleRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMsgFeeScheduleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMsgFeeScheduleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMsgFeeScheduleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMsgFeeScheduleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Current", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Current = append(m.Current, MsgFee{})
+			if err := m.Current[len(m.Current)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pending", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pending = append(m.Pending, PendingMsgFeeChange{})
+			if err := m.Pending[len(m.Pending)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *CalculateTxFeesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -13,18 +13,22 @@ import (
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -123,6 +127,15 @@ type MsgAddMsgFeeProposalRequest struct {
 	RecipientBasisPoints string `protobuf:"bytes,4,opt,name=recipient_basis_points,json=recipientBasisPoints,proto3" json:"recipient_basis_points,omitempty"`
 	// the signing authority for the proposal
 	Authority string `protobuf:"bytes,5,opt,name=authority,proto3" json:"authority,omitempty"`
+	// optional wasm contract address to limit the fee to executions of that contract.
+	// Only allowed when the msg_type_url is "/cosmwasm.wasm.v1.MsgExecuteContract".
+	ContractAddress string `protobuf:"bytes,6,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// optional block height at which the change takes effect. If neither effective_height nor effective_time are
+	// provided, the change takes effect as soon as the proposal passes. Only one of them can be provided.
+	EffectiveHeight int64 `protobuf:"varint,7,opt,name=effective_height,json=effectiveHeight,proto3" json:"effective_height,omitempty"`
+	// optional block time at or after which the change takes effect. Only one of effective_height and effective_time
+	// can be provided.
+	EffectiveTime *time.Time `protobuf:"bytes,8,opt,name=effective_time,json=effectiveTime,proto3,stdtime" json:"effective_time,omitempty"`
 }
 
 func (m *MsgAddMsgFeeProposalRequest) Reset()         { *m = MsgAddMsgFeeProposalRequest{} }
@@ -193,6 +206,27 @@ func (m *MsgAddMsgFeeProposalRequest) GetAuthority() string {
 	return ""
 }
 
+func (m *MsgAddMsgFeeProposalRequest) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+func (m *MsgAddMsgFeeProposalRequest) GetEffectiveHeight() int64 {
+	if m != nil {
+		return m.EffectiveHeight
+	}
+	return 0
+}
+
+func (m *MsgAddMsgFeeProposalRequest) GetEffectiveTime() *time.Time {
+	if m != nil {
+		return m.EffectiveTime
+	}
+	return nil
+}
+
 // MsgAddMsgFeeProposalResponse defines the Msg/AddMsgFeeProposal response type
 type MsgAddMsgFeeProposalResponse struct {
 }
@@ -242,6 +276,15 @@ type MsgUpdateMsgFeeProposalRequest struct {
 	RecipientBasisPoints string `protobuf:"bytes,4,opt,name=recipient_basis_points,json=recipientBasisPoints,proto3" json:"recipient_basis_points,omitempty"`
 	// the signing authority for the proposal
 	Authority string `protobuf:"bytes,5,opt,name=authority,proto3" json:"authority,omitempty"`
+	// optional wasm contract address to limit the fee to executions of that contract.
+	// Only allowed when the msg_type_url is "/cosmwasm.wasm.v1.MsgExecuteContract".
+	ContractAddress string `protobuf:"bytes,6,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// optional block height at which the change takes effect. If neither effective_height nor effective_time are
+	// provided, the change takes effect as soon as the proposal passes. Only one of them can be provided.
+	EffectiveHeight int64 `protobuf:"varint,7,opt,name=effective_height,json=effectiveHeight,proto3" json:"effective_height,omitempty"`
+	// optional block time at or after which the change takes effect. Only one of effective_height and effective_time
+	// can be provided.
+	EffectiveTime *time.Time `protobuf:"bytes,8,opt,name=effective_time,json=effectiveTime,proto3,stdtime" json:"effective_time,omitempty"`
 }
 
 func (m *MsgUpdateMsgFeeProposalRequest) Reset()         { *m = MsgUpdateMsgFeeProposalRequest{} }
@@ -312,6 +355,27 @@ func (m *MsgUpdateMsgFeeProposalRequest) GetAuthority() string {
 	return ""
 }
 
+func (m *MsgUpdateMsgFeeProposalRequest) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+func (m *MsgUpdateMsgFeeProposalRequest) GetEffectiveHeight() int64 {
+	if m != nil {
+		return m.EffectiveHeight
+	}
+	return 0
+}
+
+func (m *MsgUpdateMsgFeeProposalRequest) GetEffectiveTime() *time.Time {
+	if m != nil {
+		return m.EffectiveTime
+	}
+	return nil
+}
+
 // MsgUpdateMsgFeeProposalResponse defines the Msg/RemoveMsgFeeProposal response type
 type MsgUpdateMsgFeeProposalResponse struct {
 }
@@ -355,6 +419,14 @@ type MsgRemoveMsgFeeProposalRequest struct {
 	MsgTypeUrl string `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
 	// the signing authority for the proposal
 	Authority string `protobuf:"bytes,2,opt,name=authority,proto3" json:"authority,omitempty"`
+	// optional wasm contract address of the contract-specific fee to remove
+	ContractAddress string `protobuf:"bytes,3,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// optional block height at which the change takes effect. If neither effective_height nor effective_time are
+	// provided, the change takes effect as soon as the proposal passes. Only one of them can be provided.
+	EffectiveHeight int64 `protobuf:"varint,4,opt,name=effective_height,json=effectiveHeight,proto3" json:"effective_height,omitempty"`
+	// optional block time at or after which the change takes effect. Only one of effective_height and effective_time
+	// can be provided.
+	EffectiveTime *time.Time `protobuf:"bytes,5,opt,name=effective_time,json=effectiveTime,proto3,stdtime" json:"effective_time,omitempty"`
 }
 
 func (m *MsgRemoveMsgFeeProposalRequest) Reset()         { *m = MsgRemoveMsgFeeProposalRequest{} }
@@ -404,6 +476,27 @@ func (m *MsgRemoveMsgFeeProposalRequest) GetAuthority() string {
 	return ""
 }
 
+func (m *MsgRemoveMsgFeeProposalRequest) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+func (m *MsgRemoveMsgFeeProposalRequest) GetEffectiveHeight() int64 {
+	if m != nil {
+		return m.EffectiveHeight
+	}
+	return 0
+}
+
+func (m *MsgRemoveMsgFeeProposalRequest) GetEffectiveTime() *time.Time {
+	if m != nil {
+		return m.EffectiveTime
+	}
+	return nil
+}
+
 // MsgRemoveMsgFeeProposalResponse defines the Msg/RemoveMsgFeeProposal response type
 type MsgRemoveMsgFeeProposalResponse struct {
 }
@@ -637,6 +730,101 @@ func (m *MsgUpdateConversionFeeDenomProposalResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateConversionFeeDenomProposalResponse proto.InternalMessageInfo
 
+// MsgUpdateMsgPrioritiesProposalRequest defines a governance proposal to update the mempool priorities of msg types
+type MsgUpdateMsgPrioritiesProposalRequest struct {
+	// msg_priorities are the mempool priorities of msg types. It replaces the existing list, so an empty list
+	// removes all priorities.
+	MsgPriorities []MsgPriority `protobuf:"bytes,1,rep,name=msg_priorities,json=msgPriorities,proto3" json:"msg_priorities"`
+	// the signing authority for the proposal
+	Authority string `protobuf:"bytes,2,opt,name=authority,proto3" json:"authority,omitempty"`
+}
+
+func (m *MsgUpdateMsgPrioritiesProposalRequest) Reset()         { *m = MsgUpdateMsgPrioritiesProposalRequest{} }
+func (m *MsgUpdateMsgPrioritiesProposalRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateMsgPrioritiesProposalRequest) ProtoMessage()    {}
+func (*MsgUpdateMsgPrioritiesProposalRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4c6bb65eaf858b5f, []int{12}
+}
+func (m *MsgUpdateMsgPrioritiesProposalRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateMsgPrioritiesProposalRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateMsgPrioritiesProposalRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateMsgPrioritiesProposalRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateMsgPrioritiesProposalRequest.Merge(m, src)
+}
+func (m *MsgUpdateMsgPrioritiesProposalRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateMsgPrioritiesProposalRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateMsgPrioritiesProposalRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateMsgPrioritiesProposalRequest proto.InternalMessageInfo
+
+func (m *MsgUpdateMsgPrioritiesProposalRequest) GetMsgPriorities() []MsgPriority {
+	if m != nil {
+		return m.MsgPriorities
+	}
+	return nil
+}
+
+func (m *MsgUpdateMsgPrioritiesProposalRequest) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+// MsgUpdateMsgPrioritiesProposalResponse defines the Msg/UpdateMsgPrioritiesProposal response type
+type MsgUpdateMsgPrioritiesProposalResponse struct {
+}
+
+func (m *MsgUpdateMsgPrioritiesProposalResponse) Reset() {
+	*m = MsgUpdateMsgPrioritiesProposalResponse{}
+}
+func (m *MsgUpdateMsgPrioritiesProposalResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateMsgPrioritiesProposalResponse) ProtoMessage()    {}
+func (*MsgUpdateMsgPrioritiesProposalResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4c6bb65eaf858b5f, []int{13}
+}
+func (m *MsgUpdateMsgPrioritiesProposalResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateMsgPrioritiesProposalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateMsgPrioritiesProposalResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateMsgPrioritiesProposalResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateMsgPrioritiesProposalResponse.Merge(m, src)
+}
+func (m *MsgUpdateMsgPrioritiesProposalResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateMsgPrioritiesProposalResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateMsgPrioritiesProposalResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateMsgPrioritiesProposalResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgAssessCustomMsgFeeRequest)(nil), "provenance.msgfees.v1.MsgAssessCustomMsgFeeRequest")
 	proto.RegisterType((*MsgAssessCustomMsgFeeResponse)(nil), "provenance.msgfees.v1.MsgAssessCustomMsgFeeResponse")
@@ -650,64 +838,79 @@ func init() {
 	proto.RegisterType((*MsgUpdateNhashPerUsdMilProposalResponse)(nil), "provenance.msgfees.v1.MsgUpdateNhashPerUsdMilProposalResponse")
 	proto.RegisterType((*MsgUpdateConversionFeeDenomProposalRequest)(nil), "provenance.msgfees.v1.MsgUpdateConversionFeeDenomProposalRequest")
 	proto.RegisterType((*MsgUpdateConversionFeeDenomProposalResponse)(nil), "provenance.msgfees.v1.MsgUpdateConversionFeeDenomProposalResponse")
+	proto.RegisterType((*MsgUpdateMsgPrioritiesProposalRequest)(nil), "provenance.msgfees.v1.MsgUpdateMsgPrioritiesProposalRequest")
+	proto.RegisterType((*MsgUpdateMsgPrioritiesProposalResponse)(nil), "provenance.msgfees.v1.MsgUpdateMsgPrioritiesProposalResponse")
 }
 
 func init() { proto.RegisterFile("provenance/msgfees/v1/tx.proto", fileDescriptor_4c6bb65eaf858b5f) }
 
 var fileDescriptor_4c6bb65eaf858b5f = []byte{
-	// 828 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x56, 0xcf, 0x6b, 0x3b, 0x45,
-	0x14, 0xcf, 0xe4, 0x9b, 0x16, 0x3a, 0xd6, 0x42, 0x86, 0xa8, 0xe9, 0x5a, 0x37, 0x31, 0x07, 0x6d,
-	0x23, 0xd9, 0x35, 0x4d, 0xad, 0x50, 0x50, 0x68, 0x2a, 0xb9, 0x45, 0x42, 0xb4, 0x17, 0x2f, 0xcb,
-	0x66, 0x77, 0xba, 0x19, 0xcc, 0xce, 0xac, 0xfb, 0x36, 0xa1, 0x01, 0x41, 0x11, 0x84, 0xe2, 0xc9,
-	0xb3, 0x22, 0xf4, 0x24, 0xea, 0xa9, 0x07, 0xff, 0x88, 0x1e, 0x8b, 0x27, 0x4f, 0x2a, 0x2d, 0x34,
-	0xfa, 0x5f, 0xc8, 0xee, 0x8e, 0x49, 0xdb, 0x24, 0x1b, 0xfb, 0xc3, 0x9b, 0x97, 0x64, 0x66, 0x3f,
-	0x9f, 0x79, 0xef, 0xf3, 0xde, 0xdb, 0x37, 0x6f, 0xb1, 0xea, 0xf9, 0x62, 0x40, 0xb9, 0xc9, 0x2d,
-	0xaa, 0xbb, 0xe0, 0x1c, 0x51, 0x0a, 0xfa, 0xa0, 0xaa, 0x07, 0xc7, 0x9a, 0xe7, 0x8b, 0x40, 0x90,
-	0x17, 0x26, 0xb8, 0x26, 0x71, 0x6d, 0x50, 0x55, 0xb2, 0xa6, 0xcb, 0xb8, 0xd0, 0xa3, 0xdf, 0x98,
-	0xa9, 0xe4, 0x1c, 0xe1, 0x88, 0x68, 0xa9, 0x87, 0x2b, 0xf9, 0x74, 0xdd, 0x12, 0xe0, 0x0a, 0x30,
-	0x62, 0x20, 0xde, 0x48, 0x48, 0x8d, 0x77, 0x7a, 0xc7, 0x04, 0xaa, 0x0f, 0xaa, 0x1d, 0x1a, 0x98,
-	0x55, 0xdd, 0x12, 0x8c, 0x4b, 0xfc, 0x25, 0x89, 0xbb, 0xe0, 0x84, 0x92, 0x5c, 0x70, 0x62, 0xa0,
-	0x74, 0x8d, 0xf0, 0x46, 0x13, 0x9c, 0x7d, 0x00, 0x0a, 0x70, 0xd0, 0x87, 0x40, 0xb8, 0x4d, 0x70,
-	0x1a, 0x94, 0xb6, 0xe9, 0x27, 0x7d, 0x0a, 0x01, 0x21, 0x38, 0xc3, 0x4d, 0x97, 0xe6, 0x51, 0x11,
-	0x6d, 0xae, 0xb4, 0xa3, 0x35, 0x79, 0x1b, 0x2f, 0x9b, 0xae, 0xe8, 0xf3, 0x20, 0x9f, 0x2e, 0xa2,
-	0xcd, 0xe7, 0xb6, 0xd7, 0x35, 0x29, 0x26, 0x74, 0xaf, 0x49, 0xf7, 0xda, 0x81, 0x60, 0xbc, 0x9e,
-	0x39, 0xff, 0xad, 0x90, 0x6a, 0x4b, 0x3a, 0xd9, 0xc0, 0x2b, 0x3e, 0xb5, 0x98, 0xc7, 0x28, 0x0f,
-	0xf2, 0xcf, 0x22, 0x8b, 0x93, 0x07, 0xa1, 0xab, 0x23, 0x5f, 0xb8, 0xf9, 0x4c, 0xec, 0x2a, 0x5c,
-	0x93, 0x1d, 0xfc, 0xe2, 0x98, 0x60, 0x74, 0x4c, 0x60, 0x60, 0x78, 0x82, 0xf1, 0x00, 0xf2, 0x4b,
-	0x11, 0x2b, 0x37, 0x46, 0xeb, 0x21, 0xd8, 0x8a, 0xb0, 0xbd, 0xec, 0xc9, 0x69, 0x21, 0xf5, 0xe7,
-	0x69, 0x21, 0xf5, 0xc5, 0xe8, 0xac, 0x1c, 0x19, 0x2a, 0x15, 0xf0, 0x2b, 0x73, 0xe2, 0x04, 0x4f,
-	0x70, 0xa0, 0xa5, 0xeb, 0x34, 0x7e, 0x39, 0x64, 0xd8, 0x76, 0x0c, 0xb4, 0x7c, 0xe1, 0x09, 0x30,
-	0x7b, 0xff, 0x24, 0xa2, 0x88, 0x57, 0x5d, 0x70, 0x8c, 0x60, 0xe8, 0x51, 0xa3, 0xef, 0xf7, 0x64,
-	0x42, 0xb0, 0x0b, 0xce, 0x87, 0x43, 0x8f, 0x1e, 0xfa, 0x3d, 0x72, 0x82, 0xf0, 0x9a, 0x69, 0xdb,
-	0x2c, 0x60, 0x82, 0x9b, 0x3d, 0xe3, 0x88, 0xd2, 0xc5, 0xf9, 0x69, 0x84, 0xf9, 0xf9, 0xe9, 0xf7,
-	0xc2, 0xa6, 0xc3, 0x82, 0x6e, 0xbf, 0xa3, 0x59, 0xc2, 0x95, 0x95, 0x95, 0x7f, 0x15, 0xb0, 0x3f,
-	0xd6, 0x43, 0xa7, 0x10, 0x1d, 0x80, 0x6f, 0x46, 0x67, 0xe5, 0xd5, 0x1e, 0x75, 0x4c, 0x6b, 0x68,
-	0x84, 0x05, 0x86, 0x1f, 0x46, 0x67, 0x65, 0xd4, 0x7e, 0x7e, 0xe2, 0xb8, 0x41, 0xe9, 0x82, 0x44,
-	0xcf, 0x4f, 0x6a, 0x66, 0x7e, 0x52, 0xc9, 0x2e, 0x5e, 0x31, 0xfb, 0x41, 0x57, 0xf8, 0x2c, 0x18,
-	0xc6, 0xd9, 0xaf, 0xe7, 0x7f, 0xf9, 0xb9, 0x92, 0x93, 0xb1, 0xed, 0xdb, 0xb6, 0x4f, 0x01, 0x3e,
-	0x08, 0x7c, 0xc6, 0x9d, 0xf6, 0x84, 0xba, 0xb7, 0x16, 0x16, 0x61, 0xb2, 0x2f, 0xa9, 0xf1, 0x1b,
-	0x37, 0x9d, 0x67, 0x59, 0x88, 0xbf, 0xd2, 0x58, 0x6d, 0x82, 0x73, 0xe8, 0xd9, 0x66, 0x40, 0xff,
-	0xaf, 0xc5, 0x7f, 0x5a, 0x8b, 0x57, 0x71, 0x61, 0x6e, 0xaa, 0x65, 0x39, 0xbe, 0x42, 0x51, 0x39,
-	0xda, 0xd4, 0x15, 0x83, 0x07, 0x97, 0xe3, 0x96, 0xde, 0xf4, 0x63, 0xf5, 0xce, 0xd6, 0x22, 0xf5,
-	0x7e, 0x8b, 0xf0, 0x6b, 0xe3, 0x98, 0xde, 0xef, 0x9a, 0xd0, 0x6d, 0x51, 0xff, 0x10, 0xec, 0x26,
-	0xeb, 0xdd, 0xd5, 0xbd, 0x85, 0xb3, 0x3c, 0x24, 0x18, 0x1e, 0xf5, 0x8d, 0x3e, 0xd8, 0x86, 0xcb,
-	0x62, 0xf1, 0x99, 0xf6, 0x1a, 0xbf, 0x75, 0xf2, 0xc9, 0x02, 0xd8, 0xc2, 0xaf, 0x2f, 0x14, 0x27,
-	0x03, 0xf9, 0x1e, 0xe1, 0xf2, 0x98, 0x7b, 0x20, 0xf8, 0x80, 0xfa, 0xc0, 0x04, 0x6f, 0x50, 0xfa,
-	0x1e, 0xe5, 0xc2, 0xbd, 0x1b, 0xcc, 0x9b, 0x38, 0x67, 0x8d, 0x49, 0xe1, 0x0b, 0x6f, 0xd8, 0x21,
-	0x4d, 0x16, 0x83, 0x58, 0x53, 0x06, 0x9e, 0x2c, 0xa6, 0x0a, 0x7e, 0xe3, 0x5f, 0xe9, 0x8c, 0xe3,
-	0xda, 0x1e, 0x2d, 0xe3, 0x67, 0x4d, 0x70, 0xc8, 0x67, 0x98, 0x4c, 0x5f, 0xc7, 0xa4, 0xa6, 0xcd,
-	0x9c, 0x92, 0x5a, 0xd2, 0x90, 0x52, 0x76, 0xee, 0x77, 0x28, 0x16, 0x42, 0x3e, 0xc5, 0xd9, 0xa9,
-	0x5b, 0x88, 0x6c, 0x27, 0x98, 0x9a, 0x33, 0x1a, 0x94, 0xda, 0xbd, 0xce, 0x48, 0xef, 0x5f, 0x22,
-	0x9c, 0x9b, 0xd5, 0x78, 0xe4, 0xad, 0xf9, 0xd6, 0x12, 0xee, 0x44, 0x65, 0xf7, 0xbe, 0xc7, 0x6e,
-	0xe8, 0x98, 0xd5, 0x50, 0x49, 0x3a, 0x12, 0x2e, 0x83, 0x24, 0x1d, 0x49, 0x7d, 0x4b, 0xbe, 0x43,
-	0x78, 0x23, 0xa9, 0x2f, 0xc8, 0x3b, 0x8b, 0x02, 0x4c, 0x6c, 0x76, 0xe5, 0xdd, 0x87, 0x1e, 0x97,
-	0xfa, 0x7e, 0x44, 0xb8, 0xb8, 0xe8, 0x1d, 0x27, 0xfb, 0x8b, 0x9c, 0x2c, 0xec, 0x63, 0xa5, 0xfe,
-	0x18, 0x13, 0xb1, 0x56, 0x65, 0xe9, 0xf3, 0x70, 0x10, 0xd5, 0xd9, 0xf9, 0xa5, 0x8a, 0x2e, 0x2e,
-	0x55, 0xf4, 0xc7, 0xa5, 0x8a, 0xbe, 0xbe, 0x52, 0x53, 0x17, 0x57, 0x6a, 0xea, 0xd7, 0x2b, 0x35,
-	0x85, 0xf3, 0x4c, 0xcc, 0x76, 0xd3, 0x42, 0x1f, 0xd5, 0x6e, 0x8c, 0xbf, 0x09, 0xa7, 0xc2, 0xc4,
-	0x8d, 0x9d, 0x7e, 0x3c, 0xfe, 0xc2, 0x8d, 0xe6, 0x61, 0x67, 0x39, 0xfa, 0x9c, 0xac, 0xfd, 0x1d,
-	0x00, 0x00, 0xff, 0xff, 0x92, 0xa9, 0x86, 0xe4, 0x04, 0x0b, 0x00, 0x00,
+	// 1026 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x57, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xf6, 0xc4, 0x4e, 0x68, 0xa6, 0x8d, 0x4b, 0x46, 0x06, 0xb6, 0xdb, 0xb0, 0x36, 0x46, 0x80,
+	0x13, 0x94, 0x5d, 0x92, 0x94, 0x20, 0x55, 0x14, 0x29, 0x0e, 0x0a, 0x5c, 0x0c, 0x96, 0x69, 0x2e,
+	0x5c, 0x56, 0xeb, 0xdd, 0xf1, 0x7a, 0x84, 0x77, 0x67, 0xd9, 0x19, 0x5b, 0xb5, 0x84, 0x04, 0x42,
+	0x54, 0xca, 0xb1, 0xe7, 0x22, 0xa4, 0x9e, 0x10, 0xe5, 0x94, 0x03, 0x7f, 0x00, 0xc7, 0x1e, 0x2b,
+	0x4e, 0x9c, 0x28, 0x4a, 0x24, 0xc2, 0x9f, 0x81, 0x66, 0x77, 0xbc, 0x76, 0x13, 0xaf, 0x9d, 0x5f,
+	0x37, 0x7a, 0x49, 0x76, 0xe7, 0x7d, 0xef, 0xcd, 0xfb, 0x3e, 0x7d, 0x33, 0xfb, 0x0c, 0xb5, 0x20,
+	0xa4, 0x3d, 0xec, 0x5b, 0xbe, 0x8d, 0x0d, 0x8f, 0xb9, 0x2d, 0x8c, 0x99, 0xd1, 0x5b, 0x33, 0xf8,
+	0x3d, 0x3d, 0x08, 0x29, 0xa7, 0xe8, 0x95, 0x61, 0x5c, 0x97, 0x71, 0xbd, 0xb7, 0xa6, 0x2e, 0x5a,
+	0x1e, 0xf1, 0xa9, 0x11, 0xfd, 0x8d, 0x91, 0x6a, 0xc1, 0xa5, 0x2e, 0x8d, 0x1e, 0x0d, 0xf1, 0x24,
+	0x57, 0x6f, 0xd8, 0x94, 0x79, 0x94, 0x99, 0x71, 0x20, 0x7e, 0x91, 0x21, 0x2d, 0x7e, 0x33, 0x9a,
+	0x16, 0xc3, 0x46, 0x6f, 0xad, 0x89, 0xb9, 0xb5, 0x66, 0xd8, 0x94, 0xf8, 0x32, 0xfe, 0x9a, 0x8c,
+	0x7b, 0xcc, 0x15, 0x2d, 0x79, 0xcc, 0x95, 0x81, 0xa2, 0x4b, 0xa9, 0xdb, 0xc1, 0x46, 0xf4, 0xd6,
+	0xec, 0xb6, 0x0c, 0x4e, 0x3c, 0xcc, 0xb8, 0xe5, 0x05, 0x12, 0xf0, 0xe6, 0x78, 0x52, 0x83, 0xfe,
+	0x23, 0x50, 0xf9, 0x1f, 0x00, 0x97, 0x6a, 0xcc, 0xdd, 0x62, 0x0c, 0x33, 0xb6, 0xdd, 0x65, 0x9c,
+	0x7a, 0x35, 0xe6, 0xee, 0x60, 0xdc, 0xc0, 0x5f, 0x77, 0x31, 0xe3, 0x08, 0xc1, 0x9c, 0x6f, 0x79,
+	0x58, 0x01, 0x25, 0x50, 0x99, 0x6f, 0x44, 0xcf, 0xe8, 0x03, 0x38, 0x67, 0x79, 0xb4, 0xeb, 0x73,
+	0x65, 0xa6, 0x04, 0x2a, 0x57, 0xd7, 0x6f, 0xe8, 0x92, 0x92, 0x20, 0xa1, 0x4b, 0x12, 0xfa, 0x36,
+	0x25, 0x7e, 0x35, 0xf7, 0xe4, 0xaf, 0x62, 0xa6, 0x21, 0xe1, 0x68, 0x09, 0xce, 0x87, 0xd8, 0x26,
+	0x01, 0xc1, 0x3e, 0x57, 0xb2, 0x51, 0xc5, 0xe1, 0x82, 0xd8, 0xaa, 0x15, 0x52, 0x4f, 0xc9, 0xc5,
+	0x5b, 0x89, 0x67, 0x74, 0x0b, 0xbe, 0x9a, 0x00, 0xcc, 0xa6, 0xc5, 0x08, 0x33, 0x03, 0x4a, 0x7c,
+	0xce, 0x94, 0xd9, 0x08, 0x55, 0x48, 0xa2, 0x55, 0x11, 0xac, 0x47, 0xb1, 0xdb, 0x8b, 0x7b, 0x8f,
+	0x8a, 0x99, 0x7f, 0x1f, 0x15, 0x33, 0xdf, 0x1f, 0xed, 0xaf, 0x44, 0x85, 0xca, 0x45, 0xf8, 0x7a,
+	0x0a, 0x4f, 0x16, 0x50, 0x9f, 0xe1, 0xf2, 0xfd, 0x1c, 0xbc, 0x29, 0x10, 0x8e, 0x13, 0x07, 0xea,
+	0x21, 0x0d, 0x28, 0xb3, 0x3a, 0x03, 0x21, 0x4a, 0xf0, 0x9a, 0xc7, 0x5c, 0x93, 0xf7, 0x03, 0x6c,
+	0x76, 0xc3, 0x8e, 0x14, 0x04, 0x7a, 0xcc, 0xbd, 0xdb, 0x0f, 0xf0, 0x6e, 0xd8, 0x41, 0x7b, 0x00,
+	0xe6, 0x2d, 0xc7, 0x21, 0x9c, 0x50, 0xdf, 0xea, 0x98, 0x2d, 0x8c, 0xa7, 0xeb, 0xb3, 0x23, 0xf4,
+	0xf9, 0xf5, 0x59, 0xb1, 0xe2, 0x12, 0xde, 0xee, 0x36, 0x75, 0x9b, 0x7a, 0xd2, 0x1f, 0xf2, 0xdf,
+	0x2a, 0x73, 0xbe, 0x32, 0xc4, 0xa6, 0x2c, 0x4a, 0x60, 0x0f, 0x8f, 0xf6, 0x57, 0xae, 0x75, 0xb0,
+	0x6b, 0xd9, 0x7d, 0x53, 0xd8, 0x84, 0xfd, 0x72, 0xb4, 0xbf, 0x02, 0x1a, 0x0b, 0xc3, 0x8d, 0x77,
+	0x30, 0x9e, 0x22, 0x74, 0xba, 0xa8, 0xb9, 0x74, 0x51, 0xd1, 0x26, 0x9c, 0xb7, 0xba, 0xbc, 0x4d,
+	0x43, 0xc2, 0xfb, 0xb1, 0xfa, 0x55, 0xe5, 0x8f, 0xdf, 0x56, 0x0b, 0x92, 0xdb, 0x96, 0xe3, 0x84,
+	0x98, 0xb1, 0x2f, 0x78, 0x48, 0x7c, 0xb7, 0x31, 0x84, 0xa2, 0x65, 0xf8, 0xb2, 0x4d, 0x7d, 0x1e,
+	0x5a, 0x36, 0x37, 0xad, 0x18, 0xa4, 0xcc, 0x45, 0xfb, 0x5c, 0x1f, 0xac, 0xcb, 0x5c, 0x01, 0xc5,
+	0xad, 0x16, 0xb6, 0x39, 0xe9, 0x61, 0xb3, 0x8d, 0x89, 0xdb, 0xe6, 0xca, 0x4b, 0x25, 0x50, 0xc9,
+	0x36, 0xae, 0x27, 0xeb, 0x9f, 0x46, 0xcb, 0xe8, 0x13, 0x98, 0x1f, 0x42, 0x85, 0xf5, 0x95, 0x2b,
+	0x91, 0xd6, 0xaa, 0x1e, 0x9f, 0x0b, 0x7d, 0x70, 0x2e, 0xf4, 0xbb, 0x83, 0x73, 0x51, 0xcd, 0x3d,
+	0x78, 0x56, 0x04, 0x8d, 0x85, 0x24, 0x4f, 0x44, 0x6e, 0xe7, 0x85, 0x47, 0x86, 0xed, 0x96, 0x35,
+	0xb8, 0x34, 0xde, 0x06, 0xd2, 0x27, 0x7b, 0x39, 0xa8, 0xd5, 0x98, 0xbb, 0x1b, 0x38, 0x16, 0xc7,
+	0x2f, 0xac, 0xf2, 0x7f, 0xb6, 0xca, 0x1b, 0xb0, 0x98, 0xea, 0x04, 0xe9, 0x96, 0xc7, 0x33, 0x91,
+	0x5b, 0x1a, 0xd8, 0xa3, 0xbd, 0x73, 0xbb, 0xe5, 0x39, 0x39, 0x67, 0x2e, 0x26, 0x67, 0xf6, 0xf4,
+	0x72, 0xe6, 0x4e, 0x2b, 0xe7, 0xec, 0x65, 0xca, 0x39, 0x5e, 0x2a, 0x29, 0xe7, 0x8f, 0x00, 0xbe,
+	0x9d, 0x48, 0xfe, 0x59, 0xdb, 0x62, 0xed, 0x3a, 0x0e, 0x77, 0x99, 0x53, 0x23, 0x9d, 0xe3, 0xb2,
+	0x2e, 0xc3, 0x45, 0x5f, 0x00, 0xcc, 0x00, 0x87, 0x66, 0x97, 0x39, 0xa6, 0x47, 0x62, 0x6d, 0x73,
+	0x8d, 0xbc, 0xff, 0x5c, 0xe6, 0x79, 0xf5, 0x3d, 0x41, 0x60, 0x19, 0xbe, 0x33, 0xb5, 0x39, 0x49,
+	0xe4, 0x67, 0x00, 0x57, 0x12, 0xec, 0x36, 0xf5, 0x7b, 0x38, 0x64, 0x84, 0xfa, 0x3b, 0x18, 0x7f,
+	0x8c, 0x7d, 0xea, 0x1d, 0x27, 0xf3, 0x1e, 0x2c, 0xd8, 0x09, 0x48, 0x5c, 0x17, 0xa6, 0x23, 0x60,
+	0xd2, 0x2b, 0xc8, 0x3e, 0x51, 0xe0, 0xd2, 0x38, 0xad, 0xc2, 0x77, 0x4f, 0xd5, 0xa7, 0xe4, 0xf5,
+	0x3b, 0x80, 0x6f, 0x8d, 0x9e, 0x89, 0x7a, 0x48, 0x44, 0x1d, 0x82, 0xd9, 0x71, 0x4a, 0x9f, 0xc3,
+	0xbc, 0xb0, 0x7d, 0x90, 0x00, 0x14, 0x50, 0xca, 0x56, 0xae, 0xae, 0x97, 0xf5, 0xb1, 0xc3, 0x96,
+	0x3e, 0x2c, 0xd6, 0x97, 0x53, 0xc5, 0x82, 0x37, 0x5a, 0xff, 0xd2, 0x18, 0x57, 0x46, 0x2c, 0x96,
+	0xc2, 0x20, 0x26, 0xbb, 0xfe, 0xc3, 0x15, 0x98, 0xad, 0x31, 0x17, 0x7d, 0x0b, 0xd1, 0xc9, 0xc1,
+	0x02, 0x6d, 0xa4, 0x13, 0x49, 0x1d, 0xb7, 0xd4, 0x5b, 0x67, 0x4b, 0x8a, 0x1b, 0x41, 0xdf, 0xc0,
+	0xc5, 0x13, 0x1f, 0x2c, 0xb4, 0x3e, 0xa1, 0x54, 0xca, 0x90, 0xa3, 0x6e, 0x9c, 0x29, 0x47, 0xee,
+	0x7e, 0x1f, 0xc0, 0xc2, 0xb8, 0x4b, 0x10, 0xbd, 0x9f, 0x5e, 0x6d, 0xc2, 0xe7, 0x53, 0xdd, 0x3c,
+	0x6b, 0xda, 0x48, 0x1f, 0xe3, 0x6e, 0x8f, 0x49, 0x7d, 0x4c, 0xb8, 0x98, 0xd5, 0xcd, 0xb3, 0xa6,
+	0xc9, 0x3e, 0x7e, 0x02, 0x70, 0x69, 0xd2, 0x25, 0x80, 0xee, 0x4c, 0x23, 0x38, 0xf1, 0x66, 0x53,
+	0x3f, 0x3a, 0x6f, 0xba, 0xec, 0xef, 0x31, 0x80, 0xa5, 0x69, 0x07, 0x1a, 0x6d, 0x4d, 0xdb, 0x64,
+	0xea, 0xa5, 0xa5, 0x56, 0x2f, 0x52, 0x42, 0xf6, 0xfa, 0x10, 0xc0, 0x9b, 0x13, 0x8e, 0x22, 0xfa,
+	0xf0, 0x14, 0x5e, 0x49, 0xbd, 0x83, 0xd4, 0x3b, 0xe7, 0xcc, 0x8e, 0x9b, 0x53, 0x67, 0xbf, 0x13,
+	0x03, 0x55, 0x95, 0x3c, 0x39, 0xd0, 0xc0, 0xd3, 0x03, 0x0d, 0xfc, 0x7d, 0xa0, 0x81, 0x07, 0x87,
+	0x5a, 0xe6, 0xe9, 0xa1, 0x96, 0xf9, 0xf3, 0x50, 0xcb, 0x40, 0x85, 0xd0, 0xf1, 0x3b, 0xd4, 0xc1,
+	0x97, 0x1b, 0x23, 0x63, 0xdc, 0x10, 0xb3, 0x4a, 0xe8, 0xc8, 0x9b, 0x71, 0x2f, 0xf9, 0xe5, 0x16,
+	0xcd, 0x75, 0xcd, 0xb9, 0xe8, 0xdb, 0xba, 0xf1, 0xdf, 0x00, 0xfa, 0x1a, 0x2b, 0x44, 0xb1, 0x0e,
+	0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -737,6 +940,8 @@ type MsgClient interface {
 	UpdateNhashPerUsdMilProposal(ctx context.Context, in *MsgUpdateNhashPerUsdMilProposalRequest, opts ...grpc.CallOption) (*MsgUpdateNhashPerUsdMilProposalResponse, error)
 	// UpdateConversionFeeDenomProposal defines a governance proposal to update the msg fee conversion denom
 	UpdateConversionFeeDenomProposal(ctx context.Context, in *MsgUpdateConversionFeeDenomProposalRequest, opts ...grpc.CallOption) (*MsgUpdateConversionFeeDenomProposalResponse, error)
+	// UpdateMsgPrioritiesProposal defines a governance proposal to update the mempool priorities of msg types
+	UpdateMsgPrioritiesProposal(ctx context.Context, in *MsgUpdateMsgPrioritiesProposalRequest, opts ...grpc.CallOption) (*MsgUpdateMsgPrioritiesProposalResponse, error)
 }
 
 type msgClient struct {
@@ -801,6 +1006,15 @@ func (c *msgClient) UpdateConversionFeeDenomProposal(ctx context.Context, in *Ms
 	return out, nil
 }
 
+func (c *msgClient) UpdateMsgPrioritiesProposal(ctx context.Context, in *MsgUpdateMsgPrioritiesProposalRequest, opts ...grpc.CallOption) (*MsgUpdateMsgPrioritiesProposalResponse, error) {
+	out := new(MsgUpdateMsgPrioritiesProposalResponse)
+	err := c.cc.Invoke(ctx, "/provenance.msgfees.v1.Msg/UpdateMsgPrioritiesProposal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// AssessCustomMsgFee endpoint executes the additional fee charges.
@@ -818,6 +1032,8 @@ type MsgServer interface {
 	UpdateNhashPerUsdMilProposal(context.Context, *MsgUpdateNhashPerUsdMilProposalRequest) (*MsgUpdateNhashPerUsdMilProposalResponse, error)
 	// UpdateConversionFeeDenomProposal defines a governance proposal to update the msg fee conversion denom
 	UpdateConversionFeeDenomProposal(context.Context, *MsgUpdateConversionFeeDenomProposalRequest) (*MsgUpdateConversionFeeDenomProposalResponse, error)
+	// UpdateMsgPrioritiesProposal defines a governance proposal to update the mempool priorities of msg types
+	UpdateMsgPrioritiesProposal(context.Context, *MsgUpdateMsgPrioritiesProposalRequest) (*MsgUpdateMsgPrioritiesProposalResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -842,6 +1058,9 @@ func (*UnimplementedMsgServer) UpdateNhashPerUsdMilProposal(ctx context.Context,
 func (*UnimplementedMsgServer) UpdateConversionFeeDenomProposal(ctx context.Context, req *MsgUpdateConversionFeeDenomProposalRequest) (*MsgUpdateConversionFeeDenomProposalResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateConversionFeeDenomProposal not implemented")
 }
+func (*UnimplementedMsgServer) UpdateMsgPrioritiesProposal(ctx context.Context, req *MsgUpdateMsgPrioritiesProposalRequest) (*MsgUpdateMsgPrioritiesProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateMsgPrioritiesProposal not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -955,6 +1174,24 @@ func _Msg_UpdateConversionFeeDenomProposal_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateMsgPrioritiesProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateMsgPrioritiesProposalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateMsgPrioritiesProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.msgfees.v1.Msg/UpdateMsgPrioritiesProposal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateMsgPrioritiesProposal(ctx, req.(*MsgUpdateMsgPrioritiesProposalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Msg_serviceDesc = _Msg_serviceDesc
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "provenance.msgfees.v1.Msg",
@@ -984,6 +1221,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateConversionFeeDenomProposal",
 			Handler:    _Msg_UpdateConversionFeeDenomProposal_Handler,
 		},
+		{
+			MethodName: "UpdateMsgPrioritiesProposal",
+			Handler:    _Msg_UpdateMsgPrioritiesProposal_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "provenance/msgfees/v1/tx.proto",
@@ -1093,6 +1334,28 @@ func (m *MsgAddMsgFeeProposalRequest) MarshalToSizedBuffer(dAtA []byte) (int, er
 	_ = i
 	var l int
 	_ = l
+	if m.EffectiveTime != nil {
+		n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.EffectiveTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EffectiveTime):])
+		if err2 != nil {
+			return 0, err2
+		}
+		i -= n2
+		i = encodeVarintTx(dAtA, i, uint64(n2))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.EffectiveHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.EffectiveHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0x32
+	}
 	if len(m.Authority) > 0 {
 		i -= len(m.Authority)
 		copy(dAtA[i:], m.Authority)
@@ -1177,6 +1440,28 @@ func (m *MsgUpdateMsgFeeProposalRequest) MarshalToSizedBuffer(dAtA []byte) (int,
 	_ = i
 	var l int
 	_ = l
+	if m.EffectiveTime != nil {
+		n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.EffectiveTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EffectiveTime):])
+		if err4 != nil {
+			return 0, err4
+		}
+		i -= n4
+		i = encodeVarintTx(dAtA, i, uint64(n4))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.EffectiveHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.EffectiveHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0x32
+	}
 	if len(m.Authority) > 0 {
 		i -= len(m.Authority)
 		copy(dAtA[i:], m.Authority)
@@ -1261,6 +1546,28 @@ func (m *MsgRemoveMsgFeeProposalRequest) MarshalToSizedBuffer(dAtA []byte) (int,
 	_ = i
 	var l int
 	_ = l
+	if m.EffectiveTime != nil {
+		n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.EffectiveTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EffectiveTime):])
+		if err6 != nil {
+			return 0, err6
+		}
+		i -= n6
+		i = encodeVarintTx(dAtA, i, uint64(n6))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.EffectiveHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.EffectiveHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.Authority) > 0 {
 		i -= len(m.Authority)
 		copy(dAtA[i:], m.Authority)
@@ -1419,6 +1726,73 @@ func (m *MsgUpdateConversionFeeDenomProposalResponse) MarshalToSizedBuffer(dAtA
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgUpdateMsgPrioritiesProposalRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateMsgPrioritiesProposalRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateMsgPrioritiesProposalRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MsgPriorities) > 0 {
+		for iNdEx := len(m.MsgPriorities) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MsgPriorities[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateMsgPrioritiesProposalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateMsgPrioritiesProposalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateMsgPrioritiesProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -1490,6 +1864,17 @@ func (m *MsgAddMsgFeeProposalRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.EffectiveHeight != 0 {
+		n += 1 + sovTx(uint64(m.EffectiveHeight))
+	}
+	if m.EffectiveTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EffectiveTime)
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -1526,6 +1911,17 @@ func (m *MsgUpdateMsgFeeProposalRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.EffectiveHeight != 0 {
+		n += 1 + sovTx(uint64(m.EffectiveHeight))
+	}
+	if m.EffectiveTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EffectiveTime)
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -1552,6 +1948,17 @@ func (m *MsgRemoveMsgFeeProposalRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.EffectiveHeight != 0 {
+		n += 1 + sovTx(uint64(m.EffectiveHeight))
+	}
+	if m.EffectiveTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EffectiveTime)
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -1615,6 +2022,34 @@ func (m *MsgUpdateConversionFeeDenomProposalResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgUpdateMsgPrioritiesProposalRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MsgPriorities) > 0 {
+		for _, e := range m.MsgPriorities {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateMsgPrioritiesProposalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2072,25 +2507,112 @@ func (m *MsgAddMsgFeeProposalRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveHeight", wireType)
+			}
+			m.EffectiveHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EffectiveHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EffectiveTime == nil {
+				m.EffectiveTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.EffectiveTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
 	return nil
 }
 func (m *MsgAddMsgFeeProposalResponse) Unmarshal(dAtA []byte) error {
@@ -2333,6 +2855,93 @@ func (m *MsgUpdateMsgFeeProposalRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveHeight", wireType)
+			}
+			m.EffectiveHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EffectiveHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EffectiveTime == nil {
+				m.EffectiveTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.EffectiveTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2497,6 +3106,93 @@ func (m *MsgRemoveMsgFeeProposalRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveHeight", wireType)
+			}
+			m.EffectiveHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EffectiveHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EffectiveTime == nil {
+				m.EffectiveTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.EffectiveTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2883,6 +3579,172 @@ func (m *MsgUpdateConversionFeeDenomProposalResponse) Unmarshal(dAtA []byte) err
 	}
 	return nil
 }
+func (m *MsgUpdateMsgPrioritiesProposalRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateMsgPrioritiesProposalRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateMsgPrioritiesProposalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgPriorities", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgPriorities = append(m.MsgPriorities, MsgPriority{})
+			if err := m.MsgPriorities[len(m.MsgPriorities)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateMsgPrioritiesProposalResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateMsgPrioritiesProposalResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateMsgPrioritiesProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,18 @@
+package name
+
+import (
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/name/keeper"
+	"github.com/provenance-io/provenance/x/name/types"
+)
+
+// EndBlocker returns the end blocker for the name module.
+func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, telemetry.Now(), telemetry.MetricKeyEndBlocker)
+	// Remove the names whose dispute window has passed.
+	k.RemoveDuePendingDeletions(ctx)
+	// Keep the index of recently bound names within its retention window.
+	k.PruneNameCreationIndex(ctx)
+}
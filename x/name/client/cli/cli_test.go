@@ -3,6 +3,8 @@ package cli_test
 import (
 	"encoding/base64"
 	"fmt"
+	"os"
+	"path/filepath"
 	"sort"
 	"strings"
 	"testing"
@@ -77,8 +79,10 @@ func (s *IntegrationTestSuite) SetupSuite() {
 	nameData.Params.MaxNameLevels = 2
 	nameData.Params.MaxSegmentLength = 32
 	nameData.Params.MinSegmentLength = 1
+	nameData.Params.MaxDeletions = 10
 	nameData.Bindings = append(nameData.Bindings, nametypes.NewNameRecord("attribute", s.accountAddr, false))
 	nameData.Bindings = append(nameData.Bindings, nametypes.NewNameRecord("example.attribute", s.accountAddr, false))
+	nameData.Bindings = append(nameData.Bindings, nametypes.NewNameRecord(testUUID+".attribute", sdk.AccAddress("uuid_name_owner_____"), false))
 	for i := 0; i < s.acc2NameCount; i++ {
 		nameData.Bindings = append(nameData.Bindings, nametypes.NewNameRecord(toWritten(i), s.account2Addr, false))
 	}
@@ -103,6 +107,9 @@ func (s *IntegrationTestSuite) TearDownSuite() {
 	testutil.Cleanup(s.testnet, s.T())
 }
 
+// testUUID is a UUID segment used in one of the genesis names.
+const testUUID = "91978ba2-5f35-459a-86a7-feca1b0512e0"
+
 // toWritten converts an integer to a written string version.
 // Originally, this was the full written string, e.g. 38 => "thirtyEight" but that ended up being too long for
 // an attribute name segment, so it got trimmed down, e.g. 115 => "onehun15".
@@ -202,15 +209,33 @@ func (s *IntegrationTestSuite) TestGetNameParamsCmd() {
 		{
 			"json output",
 			[]string{fmt.Sprintf("--%s=json", cmtcli.OutputFlag)},
-			"{\"max_segment_length\":32,\"min_segment_length\":1,\"max_name_levels\":2,\"allow_unrestricted_names\":true}",
+			"{\"max_segment_length\":32,\"min_segment_length\":1,\"max_name_levels\":2,\"allow_unrestricted_names\":true,\"max_deletions\":10,\"contract_migrated_name_policy\":\"CONTRACT_NAME_POLICY_UNSPECIFIED\",\"contract_admin_cleared_name_policy\":\"CONTRACT_NAME_POLICY_UNSPECIFIED\",\"delete_delay_blocks\":0,\"resolve_pending_deletions\":false,\"max_query_results\":0,\"max_query_response_bytes\":\"0\",\"restrict_new_names\":false,\"bind_name_fee\":[],\"bind_name_fee_recipient\":\"\",\"root_name_params\":[],\"max_uuid_segments\":0,\"created_names_retention_blocks\":0}",
+		},
+		{
+			"proto-json output",
+			[]string{fmt.Sprintf("--%s=proto-json", cmtcli.OutputFlag)},
+			"{\"maxSegmentLength\":32,\"minSegmentLength\":1,\"maxNameLevels\":2,\"allowUnrestrictedNames\":true,\"maxDeletions\":10}",
 		},
 		{
 			"text output",
 			[]string{fmt.Sprintf("--%s=text", cmtcli.OutputFlag)},
 			`allow_unrestricted_names: true
+bind_name_fee: []
+bind_name_fee_recipient: ""
+contract_admin_cleared_name_policy: CONTRACT_NAME_POLICY_UNSPECIFIED
+contract_migrated_name_policy: CONTRACT_NAME_POLICY_UNSPECIFIED
+created_names_retention_blocks: 0
+delete_delay_blocks: 0
+max_deletions: 10
 max_name_levels: 2
+max_query_response_bytes: "0"
+max_query_results: 0
 max_segment_length: 32
-min_segment_length: 1`,
+max_uuid_segments: 0
+min_segment_length: 1
+resolve_pending_deletions: false
+restrict_new_names: false
+root_name_params: []`,
 		},
 	}
 
@@ -237,6 +262,11 @@ func (s *IntegrationTestSuite) TestResolveNameCommand() {
 			[]string{"attribute", fmt.Sprintf("--%s=json", cmtcli.OutputFlag)},
 			fmt.Sprintf("{\"address\":\"%s\",\"restricted\":false}", s.accountAddr.String()),
 		},
+		{
+			"query name, proto-json output",
+			[]string{"attribute", fmt.Sprintf("--%s=proto-json", cmtcli.OutputFlag)},
+			fmt.Sprintf("{\"address\":\"%s\"}", s.accountAddr.String()),
+		},
 		{
 			"query name, text output",
 			[]string{"attribute", fmt.Sprintf("--%s=text", cmtcli.OutputFlag)},
@@ -261,6 +291,39 @@ func (s *IntegrationTestSuite) TestResolveNameCommand() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestResolveManyCommand() {
+	testCases := []struct {
+		name           string
+		args           []string
+		expectedOutput string
+	}{
+		{
+			"query names, json output",
+			[]string{"attribute", "doesnotexist", fmt.Sprintf("--%s=json", cmtcli.OutputFlag)},
+			fmt.Sprintf("{\"results\":[{\"name\":\"attribute\",\"address\":\"%s\",\"restricted\":false,\"error\":\"\"},"+
+				"{\"name\":\"doesnotexist\",\"address\":\"\",\"restricted\":false,\"error\":\"no address bound to name\"}]}",
+				s.accountAddr.String()),
+		},
+		{
+			"query names, text output",
+			[]string{"example.attribute", "attribute", fmt.Sprintf("--%s=text", cmtcli.OutputFlag)},
+			fmt.Sprintf("results:\n- address: %[1]s\n  error: \"\"\n  name: example.attribute\n  restricted: false\n"+
+				"- address: %[1]s\n  error: \"\"\n  name: attribute\n  restricted: false", s.accountAddr.String()),
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			cmd := namecli.ResolveManyCommand()
+			clientCtx := s.testnet.Validators[0].ClientCtx
+
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expectedOutput, strings.TrimSpace(out.String()))
+		})
+	}
+}
+
 func (s *IntegrationTestSuite) TestReverseLookupCommand() {
 	accountKey := secp256k1.GenPrivKeyFromSecret([]byte("nobindinginthisaccount"))
 	addr, _ := sdk.AccAddressFromHexUnsafe(accountKey.PubKey().Address().String())
@@ -272,22 +335,22 @@ func (s *IntegrationTestSuite) TestReverseLookupCommand() {
 		{
 			"query name, json output",
 			[]string{s.accountAddr.String(), fmt.Sprintf("--%s=json", cmtcli.OutputFlag)},
-			"{\"name\":[\"example.attribute\",\"attribute\"],\"pagination\":{\"next_key\":null,\"total\":\"0\"}}",
+			"{\"name\":[\"example.attribute\",\"attribute\"],\"pagination\":{\"next_key\":null,\"total\":\"0\"},\"truncated\":false}",
 		},
 		{
 			"query name, text output",
 			[]string{s.accountAddr.String(), fmt.Sprintf("--%s=text", cmtcli.OutputFlag)},
-			"name:\n- example.attribute\n- attribute\npagination:\n  next_key: null\n  total: \"0\"",
+			"name:\n- example.attribute\n- attribute\npagination:\n  next_key: null\n  total: \"0\"\ntruncated: false",
 		},
 		{
 			"query name that does not exist, text output",
 			[]string{addr.String(), fmt.Sprintf("--%s=text", cmtcli.OutputFlag)},
-			"name: []\npagination:\n  next_key: null\n  total: \"0\"",
+			"name: []\npagination:\n  next_key: null\n  total: \"0\"\ntruncated: false",
 		},
 		{
 			"query name that does not exist, json output",
 			[]string{addr.String(), fmt.Sprintf("--%s=json", cmtcli.OutputFlag)},
-			"{\"name\":[],\"pagination\":{\"next_key\":null,\"total\":\"0\"}}",
+			"{\"name\":[],\"pagination\":{\"next_key\":null,\"total\":\"0\"},\"truncated\":false}",
 		},
 	}
 
@@ -303,6 +366,236 @@ func (s *IntegrationTestSuite) TestReverseLookupCommand() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestNamesByUUIDCommand() {
+	testCases := []struct {
+		name           string
+		args           []string
+		expectedErr    string
+		expectedOutput string
+	}{
+		{
+			name:           "names with uuid, json output",
+			args:           []string{testUUID, fmt.Sprintf("--%s=json", cmtcli.OutputFlag)},
+			expectedOutput: `{"names":["` + testUUID + `.attribute"],"pagination":{"next_key":null,"total":"0"},"truncated":false}`,
+		},
+		{
+			name:           "names with upper case uuid, text output",
+			args:           []string{strings.ToUpper(testUUID), fmt.Sprintf("--%s=text", cmtcli.OutputFlag)},
+			expectedOutput: "names:\n- " + testUUID + ".attribute\npagination:\n  next_key: null\n  total: \"0\"\ntruncated: false",
+		},
+		{
+			name:           "unused uuid",
+			args:           []string{"00000000-0000-0000-0000-000000000000", fmt.Sprintf("--%s=json", cmtcli.OutputFlag)},
+			expectedOutput: `{"names":[],"pagination":{"next_key":null,"total":"0"},"truncated":false}`,
+		},
+		{
+			name:        "not a uuid",
+			args:        []string{"attribute"},
+			expectedErr: `invalid uuid "attribute"`,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			cmd := namecli.NamesByUUIDCommand()
+			clientCtx := s.testnet.Validators[0].ClientCtx
+
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+			if len(tc.expectedErr) > 0 {
+				s.Require().EqualError(err, tc.expectedErr)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expectedOutput, strings.TrimSpace(out.String()))
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestZoneFileCommand() {
+	// Other tests bind names under example.attribute, so only the start of the zone file is checked.
+	testCases := []struct {
+		name        string
+		args        []string
+		expectedErr string
+		expContains []string
+	}{
+		{
+			name: "raw with domain and ttl",
+			args: []string{"example.attribute", "--domain", "names.example.com", "--ttl", "300", "--raw"},
+			expContains: []string{
+				"$ORIGIN example.attribute.names.example.com.\n$TTL 300\n" +
+					"@\tIN\tTXT\t\"address=" + s.accountAddr.String() + "\" \"restricted=false\"\n",
+			},
+		},
+		{
+			name:        "json output",
+			args:        []string{"attribute", fmt.Sprintf("--%s=json", cmtcli.OutputFlag)},
+			expContains: []string{`$ORIGIN attribute.\n$TTL 3600\n`, `"truncated":false`},
+		},
+		{
+			name:        "invalid domain",
+			args:        []string{"attribute", "--domain", "bad..domain"},
+			expectedErr: `invalid domain "bad..domain": empty label`,
+		},
+		{
+			name:        "name not bound",
+			args:        []string{"nope.attribute"},
+			expectedErr: "rpc error: code = Unknown desc = no address bound to name",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			cmd := namecli.ZoneFileCommand()
+			clientCtx := s.testnet.Validators[0].ClientCtx
+
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+			if len(tc.expectedErr) > 0 {
+				s.Require().ErrorContains(err, tc.expectedErr)
+				return
+			}
+			s.Require().NoError(err)
+			for _, exp := range tc.expContains {
+				s.Assert().Contains(out.String(), exp)
+			}
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestNormalizeCommand() {
+	testCases := []struct {
+		name        string
+		args        []string
+		expectedErr string
+		expOut      string
+	}{
+		{
+			name:   "valid name",
+			args:   []string{" Some.Name ", fmt.Sprintf("--%s=json", cmtcli.OutputFlag)},
+			expOut: `{"normalized":"some.name","valid":true,"violations":[]}`,
+		},
+		{
+			name: "invalid character",
+			args: []string{"some.na*me", fmt.Sprintf("--%s=json", cmtcli.OutputFlag)},
+			expOut: `{"normalized":"some.na*me","valid":false,"violations":[` +
+				`{"type":"NAME_VIOLATION_TYPE_INVALID_CHARACTER","segment":1,"position":7,` +
+				`"message":"illegal character \"*\" at position 7 in segment 1 \"na*me\""}]}`,
+		},
+		{
+			name:        "no name",
+			args:        []string{},
+			expectedErr: "accepts 1 arg(s), received 0",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			cmd := namecli.NormalizeCommand()
+			clientCtx := s.testnet.Validators[0].ClientCtx
+
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+			if len(tc.expectedErr) > 0 {
+				s.Require().ErrorContains(err, tc.expectedErr)
+				return
+			}
+			s.Require().NoError(err)
+			s.Assert().Equal(tc.expOut, strings.TrimSpace(out.String()))
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestNameProofCommand() {
+	// Proofs are of the state before the latest block, so there needs to be at least two.
+	s.Require().NoError(s.testnet.WaitForNextBlock(), "WaitForNextBlock")
+
+	testCases := []struct {
+		name      string
+		args      []string
+		expName   string
+		expRecord *nametypes.NameRecord
+	}{
+		{
+			name:      "bound name",
+			args:      []string{"example.attribute"},
+			expName:   "example.attribute",
+			expRecord: &nametypes.NameRecord{Name: "example.attribute", Address: s.accountAddr.String()},
+		},
+		{
+			name:      "bound name is normalized",
+			args:      []string{" Example.Attribute "},
+			expName:   "example.attribute",
+			expRecord: &nametypes.NameRecord{Name: "example.attribute", Address: s.accountAddr.String()},
+		},
+		{
+			name:    "unbound name",
+			args:    []string{"nope.attribute"},
+			expName: "nope.attribute",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			cmd := namecli.NameProofCommand()
+			clientCtx := s.testnet.Validators[0].ClientCtx
+
+			args := append(tc.args, fmt.Sprintf("--%s=json", cmtcli.OutputFlag))
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, args)
+			s.Require().NoError(err)
+
+			var proof nametypes.NameRecordProof
+			s.Require().NoError(s.cfg.Codec.UnmarshalJSON(out.Bytes(), &proof), "UnmarshalJSON")
+			s.Assert().NoError(proof.Verify(), "Verify")
+			s.Assert().Equal(tc.expName, proof.Name, "name")
+			s.Assert().Equal(tc.expRecord, proof.Record, "record")
+			s.Assert().Positive(proof.Height, "height")
+			s.Require().NotNil(proof.SignedHeader, "signed header")
+			s.Require().NotNil(proof.SignedHeader.Header, "signed header header")
+			s.Assert().Equal(proof.Height+1, proof.SignedHeader.Header.Height, "signed header height")
+
+			proof.Height++
+			s.Assert().ErrorContains(proof.Verify(), "does not commit to the state at height", "Verify with a different height")
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestOwnershipChallengeCommands() {
+	clientCtx := s.testnet.Validators[0].ClientCtx
+
+	out, err := clitestutil.ExecTestCLICmd(clientCtx, namecli.OwnershipChallengeCommand(),
+		[]string{"example.attribute", "--nonce", "abc", fmt.Sprintf("--%s=json", cmtcli.OutputFlag)})
+	s.Require().NoError(err, "ownership-challenge")
+	var resp nametypes.QueryOwnershipChallengeResponse
+	s.Require().NoError(s.cfg.Codec.UnmarshalJSON(out.Bytes(), &resp), "UnmarshalJSON")
+	s.Assert().Equal("example.attribute", resp.Challenge.Name, "challenge name")
+	s.Assert().Equal(s.accountAddr.String(), resp.Challenge.Address, "challenge address")
+	s.Assert().Equal(s.cfg.ChainID, resp.Challenge.ChainId, "challenge chain id")
+	s.Assert().Positive(resp.Challenge.Height, "challenge height")
+	s.Assert().Equal("abc", resp.Challenge.Nonce, "challenge nonce")
+	s.Assert().Equal(resp.Challenge.GetSignBytes(), resp.SignBytes, "sign bytes")
+
+	writeProof := func(key *secp256k1.PrivKey) string {
+		sig, sErr := key.Sign(resp.SignBytes)
+		s.Require().NoError(sErr, "Sign")
+		proof, pErr := nametypes.NewNameOwnershipProof(resp.Challenge, key.PubKey(), sig)
+		s.Require().NoError(pErr, "NewNameOwnershipProof")
+		bz, jErr := s.cfg.Codec.MarshalJSON(proof)
+		s.Require().NoError(jErr, "MarshalJSON")
+		file := filepath.Join(s.T().TempDir(), "proof.json")
+		s.Require().NoError(os.WriteFile(file, bz, 0o644), "WriteFile")
+		return file
+	}
+
+	s.Run("valid proof", func() {
+		out, err = clitestutil.ExecTestCLICmd(clientCtx, namecli.VerifyOwnershipProofCommand(), []string{writeProof(s.accountKey)})
+		s.Require().NoError(err, "verify-ownership-proof")
+		s.Assert().Equal(fmt.Sprintf("name \"example.attribute\" is owned by %s", s.accountAddr), strings.TrimSpace(out.String()))
+	})
+	s.Run("proof signed by another key", func() {
+		_, err = clitestutil.ExecTestCLICmd(clientCtx, namecli.VerifyOwnershipProofCommand(), []string{writeProof(s.account2Key)})
+		s.Assert().ErrorContains(err, "is not the address "+s.accountAddr.String(), "verify-ownership-proof")
+	})
+}
+
 func (s *IntegrationTestSuite) TestGetBindNameCommand() {
 	testCases := []struct {
 		name         string
@@ -343,7 +636,7 @@ func (s *IntegrationTestSuite) TestGetBindNameCommand() {
 				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
 				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewInt64Coin(s.cfg.BondDenom, 10)).String()),
 			},
-			false, &sdk.TxResponse{}, 18,
+			false, &sdk.TxResponse{}, 2,
 		},
 	}
 
@@ -397,7 +690,7 @@ func (s *IntegrationTestSuite) TestGetDeleteNameCmd() {
 				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
 				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewInt64Coin(s.cfg.BondDenom, 10)).String()),
 			},
-			false, &sdk.TxResponse{}, 18,
+			false, &sdk.TxResponse{}, 2,
 		},
 		{
 			"should fail to delete name, not authorized",
@@ -422,6 +715,102 @@ func (s *IntegrationTestSuite) TestGetDeleteNameCmd() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestGetDeleteNamesCmd() {
+	testCases := []struct {
+		name         string
+		cmd          *cobra.Command
+		args         []string
+		expectErr    bool
+		respType     proto.Message
+		expectedCode uint32
+	}{
+		{
+			"bind name for deletion",
+			namecli.GetBindNameCmd(),
+			[]string{"tree", s.testnet.Validators[0].Address.String(), "attribute",
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.testnet.Validators[0].Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewInt64Coin(s.cfg.BondDenom, 10)).String()),
+			},
+			false, &sdk.TxResponse{}, 0,
+		},
+		{
+			"should delete name recursively",
+			namecli.GetDeleteNamesCmd(),
+			[]string{"tree.attribute",
+				fmt.Sprintf("--%s", namecli.FlagRecursive),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.testnet.Validators[0].Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewInt64Coin(s.cfg.BondDenom, 10)).String()),
+			},
+			false, &sdk.TxResponse{}, 0,
+		},
+		{
+			"should fail to delete name, not authorized",
+			namecli.GetDeleteNamesCmd(),
+			[]string{"example.attribute",
+				fmt.Sprintf("--%s", namecli.FlagRecursive),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.testnet.Validators[0].Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewInt64Coin(s.cfg.BondDenom, 10)).String()),
+			},
+			false, &sdk.TxResponse{}, 4,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			testcli.NewTxExecutor(tc.cmd, tc.args).
+				WithExpErr(tc.expectErr).
+				WithExpCode(tc.expectedCode).
+				Execute(s.T(), s.testnet)
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestGetSendByNameCmd() {
+	testCases := []struct {
+		name         string
+		args         []string
+		expectErr    bool
+		expectedCode uint32
+	}{
+		{
+			"should send to the address the name resolves to",
+			[]string{"example.attribute", "10" + s.cfg.BondDenom},
+			false, 0,
+		},
+		{
+			"should fail to send, name not bound",
+			[]string{"unknown.attribute", "10" + s.cfg.BondDenom},
+			false, 2,
+		},
+		{
+			"should fail to send, invalid amount",
+			[]string{"example.attribute", "notanamount"},
+			true, 0,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			args := append(tc.args,
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.testnet.Validators[0].Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewInt64Coin(s.cfg.BondDenom, 10)).String()),
+			)
+			testcli.NewTxExecutor(namecli.GetSendByNameCmd(), args).
+				WithExpErr(tc.expectErr).
+				WithExpCode(tc.expectedCode).
+				Execute(s.T(), s.testnet)
+		})
+	}
+}
+
 func (s *IntegrationTestSuite) TestGetModifyNameCmd() {
 	testCases := []struct {
 		name         string
@@ -714,6 +1103,63 @@ func (s *IntegrationTestSuite) TestGovRootNameCmd() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestGovRemoveNameCmd() {
+	testCases := []struct {
+		name         string
+		args         []string
+		expectErr    bool
+		expectedCode uint32
+		errorMessage string
+	}{
+		{
+			name: "should create a remove name proposal",
+			args: []string{"example.attribute", "--recursive",
+				fmt.Sprintf("--%s=%s", govcli.FlagTitle, "title"),
+				fmt.Sprintf("--%s=%s", govcli.FlagSummary, "description"),
+				fmt.Sprintf("--%s=%s%s", govcli.FlagDeposit, "10", s.cfg.BondDenom),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.testnet.Validators[0].Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewInt64Coin(s.cfg.BondDenom, 10)).String()),
+			},
+			expectedCode: 0,
+		},
+		{
+			name: "should fail for missing arg",
+			args: []string{
+				fmt.Sprintf("--%s=%s", govcli.FlagTitle, "title"),
+				fmt.Sprintf("--%s=%s", govcli.FlagSummary, "description"),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.testnet.Validators[0].Address.String()),
+			},
+			expectErr:    true,
+			errorMessage: "accepts 1 arg(s), received 0",
+		},
+		{
+			name: "should fail for bad deposit",
+			args: []string{"example.attribute",
+				fmt.Sprintf("--%s=%s", govcli.FlagTitle, "title"),
+				fmt.Sprintf("--%s=%s", govcli.FlagSummary, "description"),
+				fmt.Sprintf("--%s=%s", govcli.FlagDeposit, "10"),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.testnet.Validators[0].Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewInt64Coin(s.cfg.BondDenom, 10)).String()),
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			testcli.NewTxExecutor(namecli.GetGovRemoveNameCmd(), tc.args).
+				WithExpErr(tc.expectErr).
+				WithExpCode(tc.expectedCode).
+				WithExpErrMsg(tc.errorMessage).
+				Execute(s.T(), s.testnet)
+		})
+	}
+}
+
 func (s *IntegrationTestSuite) TestUpdateNameParamsCmd() {
 	testCases := []struct {
 		name         string
@@ -730,6 +1176,7 @@ func (s *IntegrationTestSuite) TestUpdateNameParamsCmd() {
 				"2",
 				"5",
 				"true",
+				"100",
 			},
 			expectedCode: 0,
 		},
@@ -741,6 +1188,7 @@ func (s *IntegrationTestSuite) TestUpdateNameParamsCmd() {
 				"2",
 				"5",
 				"true",
+				"100",
 			},
 			expectErr: `invalid max segment length: strconv.ParseUint: parsing "invalid": invalid syntax`,
 		},
@@ -752,6 +1200,7 @@ func (s *IntegrationTestSuite) TestUpdateNameParamsCmd() {
 				"invalid",
 				"5",
 				"true",
+				"100",
 			},
 			expectErr: `invalid min segment length: strconv.ParseUint: parsing "invalid": invalid syntax`,
 		},
@@ -763,6 +1212,7 @@ func (s *IntegrationTestSuite) TestUpdateNameParamsCmd() {
 				"2",
 				"invalid",
 				"true",
+				"100",
 			},
 			expectErr: `invalid max name levels: strconv.ParseUint: parsing "invalid": invalid syntax`,
 		},
@@ -774,9 +1224,50 @@ func (s *IntegrationTestSuite) TestUpdateNameParamsCmd() {
 				"2",
 				"5",
 				"invalid",
+				"100",
 			},
 			expectErr: `invalid allow unrestricted names flag: strconv.ParseBool: parsing "invalid": invalid syntax`,
 		},
+		{
+			name: "update name params, should fail incorrect max deletions",
+			cmd:  namecli.GetUpdateNameParamsCmd(),
+			args: []string{
+				"16",
+				"2",
+				"5",
+				"true",
+				"invalid",
+			},
+			expectErr: `invalid max deletions: strconv.ParseUint: parsing "invalid": invalid syntax`,
+		},
+		{
+			name: "update name params with binding params, should succeed",
+			cmd:  namecli.GetUpdateNameParamsCmd(),
+			args: []string{
+				"16",
+				"2",
+				"5",
+				"true",
+				"100",
+				"--" + namecli.FlagRestrictNewNames,
+				"--" + namecli.FlagBindNameFee, "10stake",
+				"--" + namecli.FlagRootNameParams, "pb;true;5stake",
+			},
+			expectedCode: 0,
+		},
+		{
+			name: "update name params, should fail incorrect root name params",
+			cmd:  namecli.GetUpdateNameParamsCmd(),
+			args: []string{
+				"16",
+				"2",
+				"5",
+				"true",
+				"100",
+				"--" + namecli.FlagRootNameParams, "pb",
+			},
+			expectErr: `invalid --root-name-params: expected format <root>;<restrict new names>[;<bind name fee>[;<fee recipient>]], got "pb"`,
+		},
 	}
 
 	for _, tc := range testCases {
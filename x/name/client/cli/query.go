@@ -3,6 +3,8 @@ package cli
 import (
 	"context"
 	"fmt"
+	"os"
+	"strconv"
 	"strings"
 
 	"github.com/spf13/cobra"
@@ -12,6 +14,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/version"
 
+	"github.com/provenance-io/provenance/internal/provcli"
 	"github.com/provenance-io/provenance/x/name/types"
 )
 
@@ -28,7 +31,18 @@ func GetQueryCmd() *cobra.Command {
 	queryCmd.AddCommand(
 		QueryParamsCmd(),
 		ResolveNameCommand(),
+		ResolveManyCommand(),
 		ReverseLookupCommand(),
+		NameStatsCommand(),
+		ChildQuotaCommand(),
+		PendingDeletionsCommand(),
+		NamesByUUIDCommand(),
+		ZoneFileCommand(),
+		NormalizeCommand(),
+		NamesCreatedCommand(),
+		NameProofCommand(),
+		OwnershipChallengeCommand(),
+		VerifyOwnershipProofCommand(),
 	)
 
 	return queryCmd
@@ -53,11 +67,11 @@ func QueryParamsCmd() *cobra.Command {
 				return err
 			}
 
-			return clientCtx.PrintProto(&res.Params)
+			return provcli.PrintProto(clientCtx, &res.Params)
 		},
 	}
 
-	flags.AddQueryFlagsToCmd(cmd)
+	provcli.AddQueryFlagsToCmd(cmd)
 
 	return cmd
 }
@@ -86,11 +100,43 @@ func ResolveNameCommand() *cobra.Command {
 				fmt.Printf("failed to query name \"%s\" for address: %v\n", name, err)
 				return nil
 			}
-			return clientCtx.PrintProto(response)
+			return provcli.PrintProto(clientCtx, response)
 		},
 	}
 
-	flags.AddQueryFlagsToCmd(cmd)
+	provcli.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// ResolveManyCommand returns the command handler for resolving the addresses for several names.
+func ResolveManyCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "resolve-many <name> [<name> ...]",
+		Short:   "Resolve the addresses for several names",
+		Example: fmt.Sprintf(`$ %s query name resolve-many attrib.name other.name`, version.AppName),
+		Args:    cobra.RangeArgs(1, types.MaxResolveManyNames),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			names := make([]string, len(args))
+			for i, arg := range args {
+				names[i] = strings.ToLower(strings.TrimSpace(arg))
+			}
+
+			res, err := queryClient.ResolveMany(context.Background(), &types.QueryResolveManyRequest{Names: names})
+			if err != nil {
+				return err
+			}
+			return provcli.PrintProto(clientCtx, res)
+		},
+	}
+
+	provcli.AddQueryFlagsToCmd(cmd)
 
 	return cmd
 }
@@ -129,12 +175,438 @@ $ %[1]s query name lookup pb1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk --page=2 --l
 				fmt.Printf("failed to query reverse lookup against \"%s\": %v\n", address, err)
 				return nil
 			}
-			return clientCtx.PrintProto(response)
+			return provcli.PrintProto(clientCtx, response)
 		},
 	}
 
 	flags.AddPaginationFlagsToCmd(cmd, "get")
-	flags.AddQueryFlagsToCmd(cmd)
+	provcli.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NameStatsCommand returns the command handler for querying the number of bound names.
+func NameStatsCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "stats",
+		Short:   "Query the number of bound names in total, by restriction, and under each root name",
+		Args:    cobra.NoArgs,
+		Example: fmt.Sprintf(`$ %s query name stats`, version.AppName),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.NameStats(context.Background(), &types.QueryNameStatsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return provcli.PrintProto(clientCtx, res)
+		},
+	}
+
+	provcli.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// PendingDeletionsCommand returns the command handler for querying the names that are pending deletion.
+func PendingDeletionsCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pending-deletions",
+		Short: "Query the names that have been deleted, but have not yet been removed",
+		Args:  cobra.NoArgs,
+		Example: fmt.Sprintf(`$ %[1]s query name pending-deletions
+$ %[1]s query name pending-deletions --page=2 --limit=100`, version.AppName),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequestWithPageKeyDecoded(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.PendingDeletions(context.Background(), &types.QueryPendingDeletionsRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return provcli.PrintProto(clientCtx, res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "pending deletions")
+	provcli.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NamesByUUIDCommand returns the command handler for querying the names that contain a UUID segment.
+func NamesByUUIDCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "uuid <uuid>",
+		Short: "Query the names that contain a given UUID as one of their segments",
+		Args:  cobra.ExactArgs(1),
+		Example: fmt.Sprintf(`$ %[1]s query name uuid 91978ba2-5f35-459a-86a7-feca1b0512e0
+$ %[1]s query name uuid 91978ba2-5f35-459a-86a7-feca1b0512e0 --page=2 --limit=100`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			if !types.IsValidUUID(args[0]) {
+				return fmt.Errorf("invalid uuid %q", args[0])
+			}
+
+			pageReq, err := client.ReadPageRequestWithPageKeyDecoded(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.NamesByUUID(context.Background(), &types.QueryNamesByUUIDRequest{Uuid: args[0], Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return provcli.PrintProto(clientCtx, res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "names")
+	provcli.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NamesCreatedCommand returns the command handler for querying the names bound within a range of block heights.
+func NamesCreatedCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "names-created <start height> [end height]",
+		Aliases: []string{"created"},
+		Short:   "Query the names that were bound within a range of block heights",
+		Long: `Query the names that were bound within a range of block heights (inclusive).
+If no end height is provided, all names bound at or after the start height are returned.
+Only names bound within the last created_names_retention_blocks blocks (a name param) can be found.`,
+		Args: cobra.RangeArgs(1, 2),
+		Example: fmt.Sprintf(`$ %[1]s query name names-created 1000
+$ %[1]s query name names-created 1000 2000 --reverse --limit=10`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryNamesCreatedRequest{}
+			req.StartHeight, err = strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid start height %q: %w", args[0], err)
+			}
+			if len(args) > 1 {
+				req.EndHeight, err = strconv.ParseInt(args[1], 10, 64)
+				if err != nil {
+					return fmt.Errorf("invalid end height %q: %w", args[1], err)
+				}
+			}
+
+			req.Pagination, err = client.ReadPageRequestWithPageKeyDecoded(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.NamesCreated(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return provcli.PrintProto(clientCtx, res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "names")
+	provcli.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// ZoneFileCommand returns the command handler for exporting a name and the names under it as a DNS zone file.
+func ZoneFileCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "zone-file <name>",
+		Short: "Export a name and the names under it as an RFC 1035 zone file",
+		Long: `Export a name and the names under it as an RFC 1035 zone file.
+Each name is a subdomain of the zone's origin, with a TXT record containing the address it resolves to.
+The origin is the name followed by the --domain (if provided).
+
+No SOA or NS records are included, so the output is meant to be $INCLUDEd in your own zone.
+Use --raw to output just the zone file contents, e.g. to redirect them to a file.`,
+		Example: fmt.Sprintf(`$ %[1]s query name zone-file pb
+$ %[1]s query name zone-file example.pb --domain names.example.com --ttl 300 --raw > example.pb.zone`, version.AppName),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			flagSet := cmd.Flags()
+			req := &types.QueryZoneFileRequest{Name: args[0]}
+			if req.Domain, err = flagSet.GetString(FlagDomain); err != nil {
+				return err
+			}
+			if err = types.ValidateZoneDomain(req.Domain); err != nil {
+				return err
+			}
+			if req.Ttl, err = flagSet.GetUint32(FlagTTL); err != nil {
+				return err
+			}
+			raw, err := flagSet.GetBool(FlagRaw)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ZoneFile(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			if raw {
+				return clientCtx.PrintString(res.ZoneFile)
+			}
+			return provcli.PrintProto(clientCtx, res)
+		},
+	}
+
+	cmd.Flags().String(FlagDomain, "", "The DNS domain to put the names under")
+	cmd.Flags().Uint32(FlagTTL, 0, fmt.Sprintf("The TTL (in seconds) of the records (default %d)", types.DefaultZoneFileTTL))
+	cmd.Flags().Bool(FlagRaw, false, "Output just the zone file contents")
+	provcli.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NormalizeCommand returns the command handler for checking a candidate name without binding it.
+func NormalizeCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "normalize <name>",
+		Short: "Get the normalized form of a name and the naming rules that it violates",
+		Long: `Get the normalized form of a name and the naming rules that it violates.
+The name does not need to be bound. This does the same validation that is done when binding a name,
+except that it doesn't check whether the name is already bound or whether its parent is restricted.`,
+		Example: fmt.Sprintf(`$ %[1]s query name normalize "My-Name.pb"`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.Normalize(context.Background(), &types.QueryNormalizeRequest{Name: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return provcli.PrintProto(clientCtx, res)
+		},
+	}
+
+	provcli.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// ChildQuotaCommand returns the command handler for getting the child quota of a name and how much of it is used.
+func ChildQuotaCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "child-quota <name>",
+		Short: "Get the child quota of a name and how much of it is used",
+		Long: `Get the child quota of a name and how much of it is used.
+The result has the maximum number of names that can be bound directly under the name (0 = no limit),
+the number of names currently bound directly under it, and how many more can be bound.`,
+		Example: fmt.Sprintf(`$ %[1]s query name child-quota "example.pb"`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ChildQuota(context.Background(), &types.QueryChildQuotaRequest{Name: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return provcli.PrintProto(clientCtx, res)
+		},
+	}
+
+	provcli.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NameProofCommand returns the command handler for getting a name record along with the proof of it.
+func NameProofCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "proof <name>",
+		Short: "Get a name record along with the merkle proof of it and the signed header that commits to it",
+		Long: `Get a name record along with the merkle proof of it and the signed header that commits to it.
+If the name is not bound, the proof is of its absence.
+
+The proof is of the state at the requested --height, or the height before the latest block if not provided.
+The signed header is of the block after that height since that's the one whose app hash commits to the state.
+The proof is verified against the app hash before being output, but the header's commit signatures are not checked.`,
+		Example: fmt.Sprintf(`$ %[1]s query name proof example.pb
+$ %[1]s query name proof example.pb --height=1000`, version.AppName),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proof, err := QueryNameRecordProof(clientCtx, args[0])
+			if err != nil {
+				return err
+			}
+			if err = proof.Verify(); err != nil {
+				return fmt.Errorf("could not verify proof: %w", err)
+			}
+
+			return provcli.PrintProto(clientCtx, proof)
+		},
+	}
+
+	provcli.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// QueryNameRecordProof gets a name record along with the proof of it (or of its absence) and the signed header that commits to it.
+func QueryNameRecordProof(clientCtx client.Context, name string) (*types.NameRecordProof, error) {
+	name = types.NormalizeName(name)
+	key, err := types.GetNameKeyPrefix(name)
+	if err != nil {
+		return nil, err
+	}
+
+	height, err := provcli.GetProofHeight(clientCtx)
+	if err != nil {
+		return nil, err
+	}
+	rv := &types.NameRecordProof{Height: height, Name: name, Key: key}
+	rv.Value, rv.Proof, err = provcli.QueryStoreProof(clientCtx, types.StoreKey, key, height)
+	if err != nil {
+		return nil, err
+	}
+	if len(rv.Value) > 0 {
+		rv.Record = &types.NameRecord{}
+		if err = clientCtx.Codec.Unmarshal(rv.Value, rv.Record); err != nil {
+			return nil, fmt.Errorf("could not decode name %q record: %w", name, err)
+		}
+	}
+	rv.SignedHeader, err = provcli.QueryCommittingHeader(clientCtx, height)
+	if err != nil {
+		return nil, err
+	}
+	return rv, nil
+}
+
+// OwnershipChallengeCommand returns the command handler for getting the payload that a name's owner signs to prove ownership.
+func OwnershipChallengeCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "ownership-challenge <name>",
+		Short: "Get the challenge that a name's owner signs to prove to an off-chain service that they own it",
+		Long: `Get the challenge that a name's owner signs to prove to an off-chain service that they own it.
+The challenge has the name, the address it resolves to, the chain id, the current block height, and the --nonce (if provided).
+The owner signs it using tx name sign-ownership-challenge, and the service checks the result using verify-ownership-proof.`,
+		Example: fmt.Sprintf(`$ %[1]s query name ownership-challenge example.pb
+$ %[1]s query name ownership-challenge example.pb --nonce 4f2c9a`, version.AppName),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryOwnershipChallengeRequest{Name: args[0]}
+			if req.Nonce, err = cmd.Flags().GetString(FlagNonce); err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.OwnershipChallenge(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return provcli.PrintProto(clientCtx, res)
+		},
+	}
+
+	cmd.Flags().String(FlagNonce, "", "A value chosen by the challenger to include in the challenge")
+	provcli.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// VerifyOwnershipProofCommand returns the command handler for checking a signed name ownership challenge.
+func VerifyOwnershipProofCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "verify-ownership-proof <proof-file>",
+		Short: "Check that a name ownership proof was signed by the name's owner and that the name still resolves to them",
+		Long: `Check that a name ownership proof was signed by the name's owner and that the name still resolves to them.
+The proof file has the json proof output by tx name sign-ownership-challenge.
+Checking the challenge's height and nonce is left to the challenger.`,
+		Example: fmt.Sprintf(`$ %[1]s query name verify-ownership-proof proof.json`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			bz, err := os.ReadFile(args[0])
+			if err != nil {
+				return fmt.Errorf("could not read name ownership proof: %w", err)
+			}
+			var proof types.NameOwnershipProof
+			if err = clientCtx.Codec.UnmarshalJSON(bz, &proof); err != nil {
+				return fmt.Errorf("could not parse name ownership proof: %w", err)
+			}
+			if err = proof.Verify(); err != nil {
+				return err
+			}
+			if len(clientCtx.ChainID) > 0 && clientCtx.ChainID != proof.Challenge.ChainId {
+				return fmt.Errorf("name ownership proof is for chain %q, not %q", proof.Challenge.ChainId, clientCtx.ChainID)
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.Resolve(context.Background(), &types.QueryResolveRequest{Name: proof.Challenge.Name})
+			if err != nil {
+				return err
+			}
+			if res.Address != proof.Challenge.Address {
+				return fmt.Errorf("name %q no longer resolves to %s", proof.Challenge.Name, proof.Challenge.Address)
+			}
+
+			return clientCtx.PrintString(fmt.Sprintf("name %q is owned by %s\n", proof.Challenge.Name, proof.Challenge.Address))
+		},
+	}
+
+	provcli.AddQueryFlagsToCmd(cmd)
 
 	return cmd
 }
@@ -2,8 +2,10 @@ package cli
 
 import (
 	"fmt"
+	"os"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
@@ -13,6 +15,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 	"github.com/cosmos/cosmos-sdk/version"
 	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
 
@@ -29,6 +32,49 @@ const (
 
 	// FlagUnrestricted is the flag for creating unrestricted names
 	FlagUnrestricted = "unrestrict"
+
+	// FlagRecursive is the flag for also deleting all names under a name
+	FlagRecursive = "recursive"
+
+	// FlagContractMigratedNamePolicy is the flag for the policy applied to a contract's names when it is migrated
+	FlagContractMigratedNamePolicy = "contract-migrated-name-policy"
+
+	// FlagContractAdminClearedNamePolicy is the flag for the policy applied to a contract's names when its admin is cleared
+	FlagContractAdminClearedNamePolicy = "contract-admin-cleared-name-policy"
+
+	// FlagDeleteDelayBlocks is the flag for the number of blocks a deleted name is pending deletion
+	FlagDeleteDelayBlocks = "delete-delay-blocks"
+
+	// FlagResolvePendingDeletions is the flag for allowing names that are pending deletion to be resolved
+	FlagResolvePendingDeletions = "resolve-pending-deletions"
+
+	// FlagMaxQueryResults is the flag for the maximum number of results in a page of a reverse lookup query
+	FlagMaxQueryResults = "max-query-results"
+
+	// FlagMaxQueryResponseBytes is the flag for the maximum size of a reverse lookup query response
+	FlagMaxQueryResponseBytes = "max-query-response-bytes"
+	// FlagRestrictNewNames is the flag for always restricting names bound using MsgBindNameRequest
+	FlagRestrictNewNames = "restrict-new-names"
+	// FlagBindNameFee is the flag for the fee paid to bind a name using MsgBindNameRequest
+	FlagBindNameFee = "bind-name-fee"
+	// FlagBindNameFeeRecipient is the flag for the address that receives the bind name fees
+	FlagBindNameFeeRecipient = "bind-name-fee-recipient"
+	// FlagRootNameParams is the flag for the binding params of names under a specific root name
+	FlagRootNameParams = "root-name-params"
+	// FlagMaxUUIDSegments is the flag for the maximum number of UUID segments a name can have
+	FlagMaxUUIDSegments = "max-uuid-segments"
+	// FlagCreatedNamesRetentionBlocks is the flag for the number of blocks new names are kept in the name creation index
+	FlagCreatedNamesRetentionBlocks = "created-names-retention-blocks"
+	// FlagDomain is the flag for the DNS domain that exported names are placed under
+	FlagDomain = "domain"
+	// FlagTTL is the flag for the TTL of the records in an exported zone file
+	FlagTTL = "ttl"
+	// FlagRaw is the flag for outputting just the zone file contents
+	FlagRaw = "raw"
+	// FlagParentApproval is the flag for the file with a parent approval to bind a name under a restricted parent
+	FlagParentApproval = "parent-approval"
+	// FlagNonce is the flag for the challenger's nonce to include in a name ownership challenge
+	FlagNonce = "nonce"
 )
 
 // NewTxCmd is the top-level command for name CLI transactions.
@@ -43,8 +89,17 @@ func NewTxCmd() *cobra.Command {
 	txCmd.AddCommand(
 		GetBindNameCmd(),
 		GetDeleteNameCmd(),
+		GetDeleteNamesCmd(),
 		GetModifyNameCmd(),
 		GetGovRootNameCmd(),
+		GetGovRemoveNameCmd(),
+		GetSendByNameCmd(),
+		GetApproveAddressRotationCmd(),
+		GetRotateAddressCmd(),
+		GetSetChildQuotaCmd(),
+		GetCreateNamedAccountCmd(),
+		GetSignParentApprovalCmd(),
+		GetSignOwnershipChallengeCmd(),
 	)
 	return txCmd
 }
@@ -77,10 +132,25 @@ func GetBindNameCmd() *cobra.Command {
 					false,
 				),
 			)
+			approvalFile, err := cmd.Flags().GetString(FlagParentApproval)
+			if err != nil {
+				return err
+			}
+			if len(approvalFile) > 0 {
+				bz, rerr := os.ReadFile(approvalFile)
+				if rerr != nil {
+					return fmt.Errorf("could not read parent approval: %w", rerr)
+				}
+				msg.ParentApproval = &types.ParentApproval{}
+				if err = clientCtx.Codec.UnmarshalJSON(bz, msg.ParentApproval); err != nil {
+					return fmt.Errorf("could not parse parent approval: %w", err)
+				}
+			}
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 	cmd.Flags().BoolP(FlagUnrestricted, "u", false, "Allow child name creation by everyone")
+	cmd.Flags().String(FlagParentApproval, "", "A json file with the parent approval (from sign-parent-approval) to bind under a restricted root")
 
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
@@ -112,6 +182,260 @@ func GetDeleteNameCmd() *cobra.Command {
 	return cmd
 }
 
+// GetDeleteNamesCmd is the CLI command for deleting a bound name, optionally along with all names under it.
+func GetDeleteNamesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delete-names <name> [--recursive]",
+		Short: "Delete a bound name, and optionally all names under it, from the provenance blockchain",
+		Long: strings.TrimSpace(`Delete a bound name, and optionally all names under it, from the provenance blockchain.
+Without the --recursive flag, the name cannot have any names bound under it.
+With the --recursive flag, all names under the name are deleted too, regardless of who they are bound to.`),
+		Example: fmt.Sprintf(`$ %s tx name delete-names sample.example --recursive`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			recursive, err := cmd.Flags().GetBool(FlagRecursive)
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgDeleteNamesRequest(
+				clientCtx.GetFromAddress().String(),
+				strings.TrimSpace(strings.ToLower(args[0])),
+				recursive,
+			)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().Bool(FlagRecursive, false, "Also delete all names under the name")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetSendByNameCmd is the CLI command for sending coins to the address that a name resolves to.
+func GetSendByNameCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "send <name> <amount>",
+		Short: "Send coins to the address that a name resolves to",
+		Long: strings.TrimSpace(`Send coins to the address that a name resolves to.
+The name is resolved when the transaction is executed, so the coins go to whatever address the name is bound to then.
+The transaction fails if the name is not bound.`),
+		Example: fmt.Sprintf(`$ %s tx name send treasury.example 1000nhash --from mykey`, version.AppName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			amount, err := sdk.ParseCoinsNormalized(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid amount %q: %w", args[1], err)
+			}
+			msg := types.NewMsgSendByNameRequest(
+				clientCtx.GetFromAddress().String(),
+				strings.TrimSpace(strings.ToLower(args[0])),
+				amount,
+			)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetApproveAddressRotationCmd is the CLI command for approving the rotation of an old address to the signer.
+func GetApproveAddressRotationCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "approve-address-rotation <old address>",
+		Short: "Approve the rotation of an old address to the signer",
+		Long: strings.TrimSpace(`Approve the rotation of an old address to the signer (the new address).
+Once approved, the old address can rotate to the signer using rotate-address.
+The approval is used up by that rotation.`),
+		Example: fmt.Sprintf(`$ %s tx name approve-address-rotation pb1oldaddress... --from newkey`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgApproveAddressRotationRequest(clientCtx.GetFromAddress().String(), args[0])
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetRotateAddressCmd is the CLI command for rotating the signer's names, attributes, and marker access to a new address.
+func GetRotateAddressCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rotate-address <new address>",
+		Short: "Move the signer's names, attributes, and marker access to a new address",
+		Long: strings.TrimSpace(`Move the signer's names, attributes, and marker access to a new address.
+All names bound to the signer are re-bound to the new address, the attributes on the signer's account are moved
+to the new address's account, and the signer's marker access grants are given to the new address.
+The new address must first approve the rotation using approve-address-rotation.`),
+		Example: fmt.Sprintf(`$ %s tx name rotate-address pb1newaddress... --from oldkey`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgRotateAddressRequest(clientCtx.GetFromAddress().String(), args[0])
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetSetChildQuotaCmd is the CLI command for limiting the number of names that can be bound directly under a name.
+func GetSetChildQuotaCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-child-quota <name> <max children>",
+		Short: "Limit the number of names that can be bound directly under a name you own",
+		Long: strings.TrimSpace(`Limit the number of names that can be bound directly under a name you own.
+Once the limit is reached, no more names can be bound under it (by anyone) until some are deleted.
+Lowering the limit below the current number of child names does not remove any names.
+A max children of 0 removes the limit.`),
+		Example: fmt.Sprintf(`$ %[1]s tx name set-child-quota "example.pb" 1000 --from mykey
+$ %[1]s tx name set-child-quota "example.pb" 0 --from mykey`, version.AppName),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			maxChildren, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid max children %q: %w", args[1], err)
+			}
+			msg := types.NewMsgSetChildQuotaRequest(args[0], maxChildren, clientCtx.GetFromAddress().String())
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCreateNamedAccountCmd is the CLI command for binding a name to a new account with an address derived from the name.
+func GetCreateNamedAccountCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-named-account <name>",
+		Short: "Bind a name to a new account whose address is derived from the name",
+		Long: strings.TrimSpace(`Bind a name to a new account whose address is derived from the name.
+The address is derived from the name module's address using the name, so it is the same for anyone that derives it,
+and no one has a private key for it. The name's parent must be bound, and if it's restricted, it must resolve to you.`),
+		Example: fmt.Sprintf(`$ %s tx name create-named-account "treasury.acme.pb" --from mykey`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			unrestricted, err := cmd.Flags().GetBool(FlagUnrestricted)
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgCreateNamedAccountRequest(args[0], !unrestricted, clientCtx.GetFromAddress().String())
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().BoolP(FlagUnrestricted, "u", false, "Allow child name creation by everyone")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetSignParentApprovalCmd is the CLI command for signing an approval to bind a name under a restricted parent name.
+func GetSignParentApprovalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sign-parent-approval <name> <requestor> <expiration>",
+		Short: "Sign an approval for a requestor to bind a name under a restricted parent name owned by the signer",
+		Long: strings.TrimSpace(`Sign an approval for a requestor to bind a name under a restricted parent name owned by the signer.
+The name is the full name being bound (including the parent name), and the expiration is an RFC 3339 timestamp.
+Nothing is broadcast; the approval is output as json for the requestor to provide using bind --parent-approval.
+The approval can only be used on the chain with the provided --chain-id.`),
+		Example: fmt.Sprintf(`$ %s tx name sign-parent-approval sample.root.example pb1requestor... 2030-01-01T00:00:00Z --from parentkey --chain-id pio-mainnet-1`, version.AppName),
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			if len(clientCtx.ChainID) == 0 {
+				return fmt.Errorf("a --%s is required", flags.FlagChainID)
+			}
+			if _, err = sdk.AccAddressFromBech32(args[1]); err != nil {
+				return fmt.Errorf("invalid requestor: %w", err)
+			}
+			expiration, err := time.Parse(time.RFC3339, args[2])
+			if err != nil {
+				return fmt.Errorf("invalid expiration: %w", err)
+			}
+			signDoc := types.NewParentApprovalSignDoc(clientCtx.ChainID, types.NormalizeName(args[0]), args[1], expiration)
+			signature, pubKey, err := clientCtx.Keyring.Sign(clientCtx.GetFromName(), signDoc.GetSignBytes(), signing.SignMode_SIGN_MODE_DIRECT)
+			if err != nil {
+				return fmt.Errorf("could not sign parent approval: %w", err)
+			}
+			approval, err := types.NewParentApproval(pubKey, expiration, signature)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(approval)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetSignOwnershipChallengeCmd is the CLI command for signing a name ownership challenge to prove ownership of a name off-chain.
+func GetSignOwnershipChallengeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sign-ownership-challenge <challenge-file>",
+		Short: "Sign a name ownership challenge to prove to an off-chain service that the signer owns a name",
+		Long: strings.TrimSpace(`Sign a name ownership challenge to prove to an off-chain service that the signer owns a name.
+The challenge file has the json challenge (e.g. from query name ownership-challenge), usually provided by the service.
+The signer must be the address in the challenge.
+Nothing is broadcast; the proof is output as json to provide to the service (which can check it using verify-ownership-proof).`),
+		Example: fmt.Sprintf(`$ %s tx name sign-ownership-challenge challenge.json --from mykey`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			bz, err := os.ReadFile(args[0])
+			if err != nil {
+				return fmt.Errorf("could not read name ownership challenge: %w", err)
+			}
+			var challenge types.NameOwnershipChallenge
+			if err = clientCtx.Codec.UnmarshalJSON(bz, &challenge); err != nil {
+				return fmt.Errorf("could not parse name ownership challenge: %w", err)
+			}
+			if err = challenge.ValidateBasic(); err != nil {
+				return fmt.Errorf("invalid name ownership challenge: %w", err)
+			}
+			if challenge.Address != clientCtx.GetFromAddress().String() {
+				return fmt.Errorf("signer %s is not the challenge address %s", clientCtx.GetFromAddress(), challenge.Address)
+			}
+			signature, pubKey, err := clientCtx.Keyring.Sign(clientCtx.GetFromName(), challenge.GetSignBytes(), signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON)
+			if err != nil {
+				return fmt.Errorf("could not sign name ownership challenge: %w", err)
+			}
+			proof, err := types.NewNameOwnershipProof(challenge, pubKey, signature)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(proof)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
 func GetModifyNameCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "modify-name [name] [new_owner] (--unrestrict) [flags]",
@@ -216,6 +540,42 @@ $ %s tx name gov-root-name \
 	return cmd
 }
 
+// GetGovRemoveNameCmd returns a command for submitting a governance proposal to forcibly remove a name.
+func GetGovRemoveNameCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "gov-remove-name <name> [--recursive]",
+		Short: "Submit a governance proposal to remove a name regardless of who it is bound to",
+		Long: strings.TrimSpace(`Submit a governance proposal to remove a name regardless of who it is bound to or whether it is restricted.
+Without the --recursive flag, the name cannot have any names bound under it.
+With the --recursive flag, all names under the name are removed too.`),
+		Example: fmt.Sprintf(`$ %s tx name gov-remove-name fraudulent.example --recursive --deposit 50000nhash`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			flagSet := cmd.Flags()
+			recursive, err := flagSet.GetBool(FlagRecursive)
+			if err != nil {
+				return err
+			}
+			authority := provcli.GetAuthority(flagSet)
+			name := strings.TrimSpace(strings.ToLower(args[0]))
+			msg := types.NewMsgRemoveNameRequest(authority, name, recursive)
+
+			return provcli.GenerateOrBroadcastTxCLIAsGovProp(clientCtx, flagSet, msg)
+		},
+	}
+
+	cmd.Flags().Bool(FlagRecursive, false, "Also remove all names under the name")
+	govcli.AddGovPropFlagsToCmd(cmd)
+	provcli.AddAuthorityFlagToCmd(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
 // owner returns the proposal owner
 func owner(ctx client.Context, flags *pflag.FlagSet) (string, error) {
 	proposalOwner, err := flags.GetString(FlagOwner)
@@ -236,11 +596,11 @@ func owner(ctx client.Context, flags *pflag.FlagSet) (string, error) {
 // GetUpdateNameParamsCmd creates a command to update the name module's params via governance proposal.
 func GetUpdateNameParamsCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:     "update-name-params <max-segment-length> <min-segment-length> <max-name-levels> <allow-unrestricted-names>",
+		Use:     "update-name-params <max-segment-length> <min-segment-length> <max-name-levels> <allow-unrestricted-names> <max-deletions>",
 		Short:   "Update the name module's params via governance proposal",
 		Long:    "Submit an update name params via governance proposal along with an initial deposit.",
-		Args:    cobra.ExactArgs(4),
-		Example: fmt.Sprintf(`%[1]s tx name update-name-params 16 2 5 true --deposit 50000nhash`, version.AppName),
+		Args:    cobra.ExactArgs(5),
+		Example: fmt.Sprintf(`%[1]s tx name update-name-params 16 2 5 true 100 --deposit 50000nhash`, version.AppName),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -270,20 +630,135 @@ func GetUpdateNameParamsCmd() *cobra.Command {
 				return fmt.Errorf("invalid allow unrestricted names flag: %w", err)
 			}
 
+			maxDeletions, err := strconv.ParseUint(args[4], 10, 32)
+			if err != nil {
+				return fmt.Errorf("invalid max deletions: %w", err)
+			}
+
 			msg := types.NewMsgUpdateParamsRequest(
 				uint32(maxSegmentLength), //nolint:gosec // G115: ParseUint bitsize is 32, so we know this is okay.
 				uint32(minSegmentLength), //nolint:gosec // G115: ParseUint bitsize is 32, so we know this is okay.
 				uint32(maxNameLevels),    //nolint:gosec // G115: ParseUint bitsize is 32, so we know this is okay.
 				allowUnrestrictedNames,
+				uint32(maxDeletions), //nolint:gosec // G115: ParseUint bitsize is 32, so we know this is okay.
 				authority,
 			)
+
+			migratedPolicy, err := flagSet.GetString(FlagContractMigratedNamePolicy)
+			if err != nil {
+				return err
+			}
+			msg.Params.ContractMigratedNamePolicy, err = types.ParseContractNamePolicy(migratedPolicy)
+			if err != nil {
+				return fmt.Errorf("invalid --%s: %w", FlagContractMigratedNamePolicy, err)
+			}
+
+			adminClearedPolicy, err := flagSet.GetString(FlagContractAdminClearedNamePolicy)
+			if err != nil {
+				return err
+			}
+			msg.Params.ContractAdminClearedNamePolicy, err = types.ParseContractNamePolicy(adminClearedPolicy)
+			if err != nil {
+				return fmt.Errorf("invalid --%s: %w", FlagContractAdminClearedNamePolicy, err)
+			}
+
+			msg.Params.DeleteDelayBlocks, err = flagSet.GetUint32(FlagDeleteDelayBlocks)
+			if err != nil {
+				return err
+			}
+			msg.Params.ResolvePendingDeletions, err = flagSet.GetBool(FlagResolvePendingDeletions)
+			if err != nil {
+				return err
+			}
+			msg.Params.MaxQueryResults, err = flagSet.GetUint32(FlagMaxQueryResults)
+			if err != nil {
+				return err
+			}
+			msg.Params.MaxQueryResponseBytes, err = flagSet.GetUint64(FlagMaxQueryResponseBytes)
+			if err != nil {
+				return err
+			}
+			msg.Params.RestrictNewNames, err = flagSet.GetBool(FlagRestrictNewNames)
+			if err != nil {
+				return err
+			}
+			bindNameFee, err := flagSet.GetString(FlagBindNameFee)
+			if err != nil {
+				return err
+			}
+			msg.Params.BindNameFee, err = sdk.ParseCoinsNormalized(bindNameFee)
+			if err != nil {
+				return fmt.Errorf("invalid --%s: %w", FlagBindNameFee, err)
+			}
+			msg.Params.BindNameFeeRecipient, err = flagSet.GetString(FlagBindNameFeeRecipient)
+			if err != nil {
+				return err
+			}
+			rootNameParams, err := flagSet.GetStringArray(FlagRootNameParams)
+			if err != nil {
+				return err
+			}
+			for _, val := range rootNameParams {
+				rootParams, parseErr := ParseRootNameParams(val)
+				if parseErr != nil {
+					return fmt.Errorf("invalid --%s: %w", FlagRootNameParams, parseErr)
+				}
+				msg.Params.RootNameParams = append(msg.Params.RootNameParams, rootParams)
+			}
+			msg.Params.MaxUuidSegments, err = flagSet.GetUint32(FlagMaxUUIDSegments)
+			if err != nil {
+				return err
+			}
+			msg.Params.CreatedNamesRetentionBlocks, err = flagSet.GetUint32(FlagCreatedNamesRetentionBlocks)
+			if err != nil {
+				return err
+			}
+
 			return provcli.GenerateOrBroadcastTxCLIAsGovProp(clientCtx, flagSet, msg)
 		},
 	}
 
+	cmd.Flags().String(FlagContractMigratedNamePolicy, "keep", "What happens to a contract's names when it is migrated: keep, reassign-to-admin, or delete")
+	cmd.Flags().String(FlagContractAdminClearedNamePolicy, "keep", "What happens to a contract's names when its admin is cleared: keep, reassign-to-admin, or delete")
+	cmd.Flags().Uint32(FlagDeleteDelayBlocks, 0, "The number of blocks a deleted name is pending deletion before it is removed (0 = removed immediately)")
+	cmd.Flags().Bool(FlagResolvePendingDeletions, false, "Allow names that are pending deletion to still be resolved")
+	cmd.Flags().Uint32(FlagMaxQueryResults, 0, "The maximum number of results in a page of a reverse lookup query (0 = no limit)")
+	cmd.Flags().Uint64(FlagMaxQueryResponseBytes, 0, "The maximum size (in bytes) of a reverse lookup query response (0 = no limit)")
+	cmd.Flags().Bool(FlagRestrictNewNames, false, "Always restrict the names bound using MsgBindNameRequest")
+	cmd.Flags().String(FlagBindNameFee, "", "The fee paid by the parent address to bind a name using MsgBindNameRequest")
+	cmd.Flags().String(FlagBindNameFeeRecipient, "", "The address that receives the bind name fees (default is the fee collector)")
+	cmd.Flags().StringArray(FlagRootNameParams, nil,
+		"The binding params of names under a root name, formatted as <root>;<restrict new names>[;<bind name fee>[;<fee recipient>]] (can be repeated)")
+	cmd.Flags().Uint32(FlagMaxUUIDSegments, 0, "The maximum number of UUID segments a name can have (0 = no limit)")
+	cmd.Flags().Uint32(FlagCreatedNamesRetentionBlocks, 0, "The number of blocks new names can be found using the names-created query (0 = not indexed)")
 	govcli.AddGovPropFlagsToCmd(cmd)
 	provcli.AddAuthorityFlagToCmd(cmd)
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
 }
+
+// ParseRootNameParams parses a string formatted as <root>;<restrict new names>[;<bind name fee>[;<fee recipient>]]
+// into RootNameParams.
+func ParseRootNameParams(val string) (types.RootNameParams, error) {
+	parts := strings.Split(val, ";")
+	if len(parts) < 2 || len(parts) > 4 {
+		return types.RootNameParams{}, fmt.Errorf("expected format <root>;<restrict new names>[;<bind name fee>[;<fee recipient>]], got %q", val)
+	}
+	rv := types.RootNameParams{Root: strings.TrimSpace(parts[0])}
+	var err error
+	rv.RestrictNewNames, err = strconv.ParseBool(strings.TrimSpace(parts[1]))
+	if err != nil {
+		return types.RootNameParams{}, fmt.Errorf("invalid restrict new names %q for root %q: %w", parts[1], rv.Root, err)
+	}
+	if len(parts) > 2 {
+		rv.BindNameFee, err = sdk.ParseCoinsNormalized(strings.TrimSpace(parts[2]))
+		if err != nil {
+			return types.RootNameParams{}, fmt.Errorf("invalid bind name fee %q for root %q: %w", parts[2], rv.Root, err)
+		}
+	}
+	if len(parts) > 3 {
+		rv.BindNameFeeRecipient = strings.TrimSpace(parts[3])
+	}
+	return rv, nil
+}
@@ -0,0 +1,281 @@
+package rest_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdktestutil "github.com/cosmos/cosmos-sdk/testutil"
+	testnet "github.com/cosmos/cosmos-sdk/testutil/network"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/provenance-io/provenance/internal/pioconfig"
+	"github.com/provenance-io/provenance/testutil"
+	nametypes "github.com/provenance-io/provenance/x/name/types"
+)
+
+type IntegrationTestSuite struct {
+	suite.Suite
+
+	cfg     testnet.Config
+	testnet *testnet.Network
+
+	accountAddr sdk.AccAddress
+	accountKey  *secp256k1.PrivKey
+	accountStr  string
+
+	uuidName string
+	uuidAddr string
+
+	params nametypes.Params
+}
+
+func (s *IntegrationTestSuite) SetupSuite() {
+	s.accountKey = secp256k1.GenPrivKeyFromSecret([]byte("namerest"))
+	addr, err := sdk.AccAddressFromHexUnsafe(s.accountKey.PubKey().Address().String())
+	s.Require().NoError(err)
+	s.accountAddr = addr
+	s.accountStr = s.accountAddr.String()
+	s.T().Log("setting up integration test suite")
+	pioconfig.SetProvenanceConfig("", 0)
+
+	cfg := testutil.DefaultTestNetworkConfig()
+
+	genesisState := cfg.GenesisState
+	cfg.NumValidators = 1
+
+	s.params = nametypes.NewParams(16, 2, 4, true, 100)
+
+	var nameData nametypes.GenesisState
+	nameData.Params = s.params
+	nameData.Bindings = append(nameData.Bindings, nametypes.NewNameRecord("rest", s.accountAddr, true))
+	nameData.Bindings = append(nameData.Bindings, nametypes.NewNameRecord("example.rest", s.accountAddr, false))
+	// The uuid name is bound to a different address so that the reverse lookup of the account stays the same.
+	s.uuidName = "9e9e9c57-3b4a-4d3e-8b0e-4c5a1c1b2f3d.rest"
+	uuidAddr := sdk.AccAddress("uuid_name_address___")
+	s.uuidAddr = uuidAddr.String()
+	nameData.Bindings = append(nameData.Bindings, nametypes.NewNameRecord(s.uuidName, uuidAddr, false))
+	nameDataBz, err := cfg.Codec.MarshalJSON(&nameData)
+	s.Require().NoError(err)
+	genesisState[nametypes.ModuleName] = nameDataBz
+
+	cfg.GenesisState = genesisState
+
+	s.cfg = cfg
+	s.testnet, err = testnet.New(s.T(), s.T().TempDir(), cfg)
+	s.Require().NoError(err, "creating testnet")
+
+	_, err = testutil.WaitForHeight(s.testnet, 1)
+	s.Require().NoError(err, "waiting for height 1")
+}
+
+func (s *IntegrationTestSuite) TearDownSuite() {
+	testutil.Cleanup(s.testnet, s.T())
+}
+
+func (s *IntegrationTestSuite) TestGRPCQueries() {
+	val := s.testnet.Validators[0]
+	baseURL := val.APIAddress
+	unknownAddr := sdk.AccAddress("unknown_address_____").String()
+
+	testCases := []struct {
+		name     string
+		url      string
+		headers  map[string]string
+		expErr   bool
+		respType proto.Message
+		expected proto.Message
+	}{
+		{
+			name:     "get name params",
+			url:      fmt.Sprintf("%s/provenance/name/v1/params", baseURL),
+			headers:  map[string]string{grpctypes.GRPCBlockHeightHeader: "1"},
+			respType: &nametypes.QueryParamsResponse{},
+			expected: &nametypes.QueryParamsResponse{Params: s.params},
+		},
+		{
+			name:     "resolve restricted root name",
+			url:      fmt.Sprintf("%s/provenance/name/v1/resolve/%s", baseURL, "rest"),
+			headers:  map[string]string{},
+			respType: &nametypes.QueryResolveResponse{},
+			expected: &nametypes.QueryResolveResponse{Address: s.accountStr, Restricted: true},
+		},
+		{
+			name:     "resolve child name",
+			url:      fmt.Sprintf("%s/provenance/name/v1/resolve/%s", baseURL, "example.rest"),
+			headers:  map[string]string{},
+			respType: &nametypes.QueryResolveResponse{},
+			expected: &nametypes.QueryResolveResponse{Address: s.accountStr, Restricted: false},
+		},
+		{
+			name:     "resolve unknown name",
+			url:      fmt.Sprintf("%s/provenance/name/v1/resolve/%s", baseURL, "nope.rest"),
+			headers:  map[string]string{},
+			expErr:   true,
+			respType: &nametypes.QueryResolveResponse{},
+		},
+		{
+			name:     "reverse lookup",
+			url:      fmt.Sprintf("%s/provenance/name/v1/lookup/%s", baseURL, s.accountStr),
+			headers:  map[string]string{},
+			respType: &nametypes.QueryReverseLookupResponse{},
+			expected: &nametypes.QueryReverseLookupResponse{
+				Name:       []string{"example.rest", "rest"},
+				Pagination: &query.PageResponse{NextKey: nil, Total: 2},
+			},
+		},
+		{
+			name:     "reverse lookup unknown address",
+			url:      fmt.Sprintf("%s/provenance/name/v1/lookup/%s", baseURL, unknownAddr),
+			headers:  map[string]string{},
+			respType: &nametypes.QueryReverseLookupResponse{},
+			expected: &nametypes.QueryReverseLookupResponse{
+				Name:       []string{},
+				Pagination: &query.PageResponse{},
+			},
+		},
+		{
+			name:     "resolve many names",
+			url:      fmt.Sprintf("%s/provenance/name/v1/resolve_many?names=%s&names=%s", baseURL, "rest", "nope.rest"),
+			headers:  map[string]string{},
+			respType: &nametypes.QueryResolveManyResponse{},
+			expected: &nametypes.QueryResolveManyResponse{
+				Results: []nametypes.ResolveResult{
+					{Name: "rest", Address: s.accountStr, Restricted: true},
+					{Name: "nope.rest", Error: nametypes.ErrNameNotBound.Error()},
+				},
+			},
+		},
+		{
+			name:     "resolve many without names",
+			url:      fmt.Sprintf("%s/provenance/name/v1/resolve_many", baseURL),
+			headers:  map[string]string{},
+			expErr:   true,
+			respType: &nametypes.QueryResolveManyResponse{},
+		},
+		{
+			name:     "name stats",
+			url:      fmt.Sprintf("%s/provenance/name/v1/stats", baseURL),
+			headers:  map[string]string{},
+			respType: &nametypes.QueryNameStatsResponse{},
+			// The attribute module binds the restricted accountdata name during genesis.
+			expected: &nametypes.QueryNameStatsResponse{
+				Total:        4,
+				Restricted:   2,
+				Unrestricted: 2,
+				Roots: []nametypes.RootNameCount{
+					{Root: "accountdata", Count: 1},
+					{Root: "rest", Count: 3},
+				},
+			},
+		},
+		{
+			name:     "names by uuid",
+			url:      fmt.Sprintf("%s/provenance/name/v1/uuid/%s", baseURL, "9e9e9c57-3b4a-4d3e-8b0e-4c5a1c1b2f3d"),
+			headers:  map[string]string{},
+			respType: &nametypes.QueryNamesByUUIDResponse{},
+			expected: &nametypes.QueryNamesByUUIDResponse{
+				Names:      []string{s.uuidName},
+				Pagination: &query.PageResponse{NextKey: nil, Total: 1},
+			},
+		},
+		{
+			name:     "names by unknown uuid",
+			url:      fmt.Sprintf("%s/provenance/name/v1/uuid/%s", baseURL, "00000000-0000-4000-8000-000000000000"),
+			headers:  map[string]string{},
+			respType: &nametypes.QueryNamesByUUIDResponse{},
+			expected: &nametypes.QueryNamesByUUIDResponse{
+				Names:      []string{},
+				Pagination: &query.PageResponse{},
+			},
+		},
+		{
+			name:     "names by invalid uuid",
+			url:      fmt.Sprintf("%s/provenance/name/v1/uuid/%s", baseURL, "not-a-uuid"),
+			headers:  map[string]string{},
+			expErr:   true,
+			respType: &nametypes.QueryNamesByUUIDResponse{},
+		},
+		{
+			name:     "normalize valid name",
+			url:      fmt.Sprintf("%s/provenance/name/v1/normalize/%s", baseURL, "Example.REST"),
+			headers:  map[string]string{},
+			respType: &nametypes.QueryNormalizeResponse{},
+			expected: &nametypes.QueryNormalizeResponse{Normalized: "example.rest", Valid: true},
+		},
+		{
+			name:     "normalize name with short segment",
+			url:      fmt.Sprintf("%s/provenance/name/v1/normalize/%s", baseURL, "x.rest"),
+			headers:  map[string]string{},
+			respType: &nametypes.QueryNormalizeResponse{},
+			expected: &nametypes.QueryNormalizeResponse{
+				Normalized: "x.rest",
+				Valid:      false,
+				Violations: []nametypes.NameViolation{
+					{
+						Type:    nametypes.NameViolationSegmentTooShort,
+						Segment: 0,
+						Message: `segment 0 "x" is too short: 1 < 2`,
+					},
+				},
+			},
+		},
+		{
+			name:     "names created after genesis",
+			url:      fmt.Sprintf("%s/provenance/name/v1/names_created?start_height=%d", baseURL, 1000),
+			headers:  map[string]string{},
+			respType: &nametypes.QueryNamesCreatedResponse{},
+			expected: &nametypes.QueryNamesCreatedResponse{
+				Names:      []nametypes.CreatedName{},
+				Pagination: &query.PageResponse{},
+			},
+		},
+		{
+			name:     "names created with end before start",
+			url:      fmt.Sprintf("%s/provenance/name/v1/names_created?start_height=%d&end_height=%d", baseURL, 10, 5),
+			headers:  map[string]string{},
+			expErr:   true,
+			respType: &nametypes.QueryNamesCreatedResponse{},
+		},
+		{
+			// The challenge has the chain id and current block height, so only the unmarshal is checked here.
+			name:     "ownership challenge",
+			url:      fmt.Sprintf("%s/provenance/name/v1/ownership_challenge/%s?nonce=%s", baseURL, "rest", "restnonce"),
+			headers:  map[string]string{},
+			respType: &nametypes.QueryOwnershipChallengeResponse{},
+		},
+		{
+			name:     "ownership challenge of unknown name",
+			url:      fmt.Sprintf("%s/provenance/name/v1/ownership_challenge/%s", baseURL, "nope.rest"),
+			headers:  map[string]string{},
+			expErr:   true,
+			respType: &nametypes.QueryOwnershipChallengeResponse{},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			resp, err := sdktestutil.GetRequestWithHeaders(tc.url, tc.headers)
+			s.Require().NoError(err)
+			err = val.ClientCtx.Codec.UnmarshalJSON(resp, tc.respType)
+
+			if tc.expErr {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+			if tc.expected != nil {
+				s.Require().Equal(tc.expected.String(), tc.respType.String())
+			}
+		})
+	}
+}
+
+func TestIntegrationTestSuite(t *testing.T) {
+	suite.Run(t, new(IntegrationTestSuite))
+}
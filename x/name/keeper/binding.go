@@ -0,0 +1,38 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/provenance-io/provenance/x/name/types"
+)
+
+// GetBindingParams returns the params used when binding the provided (normalized) name using MsgBindNameRequest.
+func (k Keeper) GetBindingParams(ctx sdk.Context, name string) types.RootNameParams {
+	return k.GetParams(ctx).GetBindingParams(types.GetRootName(name))
+}
+
+// ChargeBindNameFee sends the bind name fee of the provided binding params from the payer to the fee recipient,
+// or to the fee collector if there isn't a recipient.
+func (k Keeper) ChargeBindNameFee(ctx sdk.Context, bindingParams types.RootNameParams, payer sdk.AccAddress) error {
+	fee := bindingParams.BindNameFee
+	if fee.IsZero() {
+		return nil
+	}
+	if len(bindingParams.BindNameFeeRecipient) == 0 {
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, payer, authtypes.FeeCollectorName, fee); err != nil {
+			return fmt.Errorf("could not pay bind name fee %s: %w", fee, err)
+		}
+		return nil
+	}
+	recipient, err := sdk.AccAddressFromBech32(bindingParams.BindNameFeeRecipient)
+	if err != nil {
+		return fmt.Errorf("invalid bind name fee recipient: %w", err)
+	}
+	if err = k.bankKeeper.SendCoins(ctx, payer, recipient, fee); err != nil {
+		return fmt.Errorf("could not pay bind name fee %s: %w", fee, err)
+	}
+	return nil
+}
@@ -0,0 +1,149 @@
+package keeper
+
+import (
+	"fmt"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/provenance-io/provenance/x/name/types"
+)
+
+// GetChildNameCount returns the number of names bound directly under the provided name.
+func (k Keeper) GetChildNameCount(ctx sdk.Context, name string) (uint64, error) {
+	key, err := types.GetChildNameCountKey(name)
+	if err != nil {
+		return 0, err
+	}
+	return getCount(ctx.KVStore(k.storeKey), key), nil
+}
+
+// addToChildNameCount adds the delta to the number of names bound directly under the parent of the provided name.
+// Root names don't have a parent, so nothing is counted for them.
+func addToChildNameCount(store storetypes.KVStore, name string, delta int64) {
+	parent, ok := types.GetParentName(name)
+	if !ok {
+		return
+	}
+	key, err := types.GetChildNameCountKey(parent)
+	if err != nil {
+		// This can't happen since the name (and therefore its parent) has already been validated.
+		return
+	}
+	addToCount(store, key, delta)
+}
+
+// GetChildQuota returns the maximum number of names that can be bound directly under the provided name.
+// Zero is returned if the name does not have a child quota.
+func (k Keeper) GetChildQuota(ctx sdk.Context, name string) (uint64, error) {
+	key, err := types.GetChildQuotaKey(name)
+	if err != nil {
+		return 0, err
+	}
+	bz := ctx.KVStore(k.storeKey).Get(key)
+	if len(bz) == 0 {
+		return 0, nil
+	}
+	var quota types.ChildQuota
+	if err = k.cdc.Unmarshal(bz, &quota); err != nil {
+		return 0, fmt.Errorf("could not read child quota of %q: %w", name, err)
+	}
+	return quota.MaxChildren, nil
+}
+
+// SetChildQuota sets the maximum number of names that can be bound directly under a name. A max of zero
+// removes the name's quota. The name must resolve to the owner. Lowering the quota below the current number
+// of child names is allowed; no names are removed, but no more can be bound until enough have been deleted.
+func (k Keeper) SetChildQuota(ctx sdk.Context, name string, maxChildren uint64, owner sdk.AccAddress) error {
+	var err error
+	if name, err = k.Normalize(ctx, name); err != nil {
+		return err
+	}
+	if !k.ResolvesTo(ctx, name, owner) {
+		return sdkerrors.ErrUnauthorized.Wrapf("%q does not resolve to %s", name, owner)
+	}
+	if err = k.setChildQuota(ctx.KVStore(k.storeKey), types.NewChildQuota(name, maxChildren)); err != nil {
+		return err
+	}
+	return ctx.EventManager().EmitTypedEvent(types.NewEventNameChildQuotaUpdated(name, maxChildren, owner.String()))
+}
+
+// setChildQuota writes a child quota to state without any checks. A quota with a max of zero is deleted.
+func (k Keeper) setChildQuota(store storetypes.KVStore, quota types.ChildQuota) error {
+	key, err := types.GetChildQuotaKey(quota.Name)
+	if err != nil {
+		return err
+	}
+	if quota.MaxChildren == 0 {
+		store.Delete(key)
+		return nil
+	}
+	bz, err := k.cdc.Marshal(&quota)
+	if err != nil {
+		return err
+	}
+	store.Set(key, bz)
+	return nil
+}
+
+// deleteChildQuota removes the child quota of the provided name (if it has one).
+func deleteChildQuota(store storetypes.KVStore, name string) error {
+	key, err := types.GetChildQuotaKey(name)
+	if err != nil {
+		return err
+	}
+	store.Delete(key)
+	return nil
+}
+
+// ValidateChildQuota returns an error if the parent of the provided (normalized) name already has
+// as many child names as its child quota allows.
+func (k Keeper) ValidateChildQuota(ctx sdk.Context, name string) error {
+	parent, ok := types.GetParentName(name)
+	if !ok {
+		return nil
+	}
+	quota, err := k.GetChildQuota(ctx, parent)
+	if err != nil || quota == 0 {
+		return err
+	}
+	count, err := k.GetChildNameCount(ctx, parent)
+	if err != nil {
+		return err
+	}
+	if count >= quota {
+		return types.ErrChildQuotaExceeded.Wrapf("%q already has %d of %d child names", parent, count, quota)
+	}
+	return nil
+}
+
+// IterateChildQuotas calls handle with each child quota until handle returns true.
+func (k Keeper) IterateChildQuotas(ctx sdk.Context, handle func(quota types.ChildQuota) (stop bool)) error {
+	iterator := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.ChildQuotaKeyPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var quota types.ChildQuota
+		if err := k.cdc.Unmarshal(iterator.Value(), &quota); err != nil {
+			return fmt.Errorf("invalid child quota %X: %w", iterator.Key(), err)
+		}
+		if handle(quota) {
+			break
+		}
+	}
+	return nil
+}
+
+// getAllChildQuotas returns all of the child quotas, ordered by name key.
+func (k Keeper) getAllChildQuotas(ctx sdk.Context) []types.ChildQuota {
+	quotas := []types.ChildQuota{}
+	err := k.IterateChildQuotas(ctx, func(quota types.ChildQuota) bool {
+		quotas = append(quotas, quota)
+		return false
+	})
+	if err != nil {
+		panic(err)
+	}
+	return quotas
+}
@@ -0,0 +1,88 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	namekeeper "github.com/provenance-io/provenance/x/name/keeper"
+	nametypes "github.com/provenance-io/provenance/x/name/types"
+)
+
+func (s *KeeperTestSuite) TestChildQuota() {
+	ctx, _ := s.ctx.CacheContext()
+	queryQuota := func() *nametypes.QueryChildQuotaResponse {
+		resp, err := s.app.NameKeeper.ChildQuota(ctx, &nametypes.QueryChildQuotaRequest{Name: "Example.Name"})
+		s.Require().NoError(err, "ChildQuota query")
+		return resp
+	}
+	bind := func(name string) error {
+		return s.app.NameKeeper.BindNameRecord(ctx, name, s.user2Addr, false, s.user2Addr)
+	}
+
+	s.Run("no quota", func() {
+		s.Require().NoError(bind("one.example.name"), "BindNameRecord(one.example.name)")
+		exp := &nametypes.QueryChildQuotaResponse{Name: "example.name", ChildCount: 1}
+		s.Assert().Equal(exp, queryQuota(), "ChildQuota query")
+	})
+
+	s.Run("not the owner", func() {
+		err := s.app.NameKeeper.SetChildQuota(ctx, "example.name", 2, s.user2Addr)
+		s.Require().EqualError(err, `"example.name" does not resolve to `+s.user2+": unauthorized", "SetChildQuota")
+	})
+
+	s.Run("quota enforced", func() {
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+		s.Require().NoError(s.app.NameKeeper.SetChildQuota(ctx, "example.name", 2, s.user1Addr), "SetChildQuota")
+		events := ctx.EventManager().Events()
+		s.Require().Len(events, 1, "events emitted by SetChildQuota")
+		s.Assert().Equal("provenance.name.v1.EventNameChildQuotaUpdated", events[0].Type, "event type")
+		s.Assert().Equal(&nametypes.QueryChildQuotaResponse{Name: "example.name", MaxChildren: 2, ChildCount: 1, Remaining: 1}, queryQuota(), "ChildQuota query")
+
+		s.Require().NoError(bind("two.example.name"), "BindNameRecord(two.example.name)")
+		err := bind("three.example.name")
+		s.Require().EqualError(err, `"example.name" already has 2 of 2 child names: parent name child quota exceeded`, "BindNameRecord(three.example.name)")
+		s.Require().NoError(bind("deeper.one.example.name"), "BindNameRecord(deeper.one.example.name)")
+		s.Assert().Equal(&nametypes.QueryChildQuotaResponse{Name: "example.name", MaxChildren: 2, ChildCount: 2}, queryQuota(), "ChildQuota query")
+	})
+
+	s.Run("room after delete", func() {
+		s.Require().NoError(s.app.NameKeeper.DeleteRecord(ctx, "two.example.name"), "DeleteRecord(two.example.name)")
+		s.Require().NoError(bind("three.example.name"), "BindNameRecord(three.example.name)")
+	})
+
+	s.Run("governance bypasses quota", func() {
+		s.Require().NoError(s.app.NameKeeper.SetNameRecord(ctx, "four.example.name", s.user1Addr, false), "SetNameRecord(four.example.name)")
+		s.Assert().Equal(&nametypes.QueryChildQuotaResponse{Name: "example.name", MaxChildren: 2, ChildCount: 3}, queryQuota(), "ChildQuota query")
+	})
+
+	s.Run("quota removed", func() {
+		s.Require().NoError(s.app.NameKeeper.SetChildQuota(ctx, "example.name", 0, s.user1Addr), "SetChildQuota")
+		s.Require().NoError(bind("five.example.name"), "BindNameRecord(five.example.name)")
+		s.Assert().Equal(&nametypes.QueryChildQuotaResponse{Name: "example.name", ChildCount: 4}, queryQuota(), "ChildQuota query")
+	})
+
+	s.Run("genesis", func() {
+		s.Require().NoError(s.app.NameKeeper.SetChildQuota(ctx, "example.name", 7, s.user1Addr), "SetChildQuota")
+		genState := s.app.NameKeeper.ExportGenesis(ctx)
+		s.Assert().Equal([]nametypes.ChildQuota{{Name: "example.name", MaxChildren: 7}}, genState.ChildQuotas, "exported child quotas")
+		s.Require().NoError(s.app.NameKeeper.VerifyGenesisRoundTrip(ctx, s.cdc), "VerifyGenesisRoundTrip")
+	})
+
+	s.Run("migration rebuilds counts", func() {
+		store := ctx.KVStore(s.app.GetKey(nametypes.StoreKey))
+		key, err := nametypes.GetChildNameCountKey("example.name")
+		s.Require().NoError(err, "GetChildNameCountKey")
+		store.Delete(key)
+		s.Assert().Equal(uint64(0), queryQuota().ChildCount, "child count after removing it")
+
+		s.Require().NoError(namekeeper.NewMigrator(s.app.NameKeeper).Migrate5To6(ctx), "Migrate5To6")
+		s.Assert().Equal(uint64(4), queryQuota().ChildCount, "child count after Migrate5To6")
+	})
+
+	s.Run("quota removed with name", func() {
+		_, err := s.app.NameKeeper.DeleteNames(ctx, "example.name", s.user1Addr, true)
+		s.Require().NoError(err, "DeleteNames(example.name)")
+		quota, err := s.app.NameKeeper.GetChildQuota(ctx, "example.name")
+		s.Require().NoError(err, "GetChildQuota")
+		s.Assert().Zero(quota, "child quota after name deleted")
+	})
+}
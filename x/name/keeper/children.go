@@ -0,0 +1,138 @@
+package keeper
+
+import (
+	"fmt"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/name/types"
+)
+
+// GetChildNames returns the names bound directly under the provided name.
+func (k Keeper) GetChildNames(ctx sdk.Context, name string) ([]string, error) {
+	var rv []string
+	err := k.iterateChildNames(ctx, name, func(child string) bool {
+		rv = append(rv, child)
+		return false
+	})
+	return rv, err
+}
+
+// iterateChildNames calls handle with each name bound directly under the provided name until handle returns true.
+func (k Keeper) iterateChildNames(ctx sdk.Context, name string, handle func(child string) (stop bool)) error {
+	keyPrefix, err := types.GetChildNameKeyPrefix(name)
+	if err != nil {
+		return err
+	}
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, keyPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		nameKey := append(append([]byte{}, types.NameKeyPrefix...), iterator.Key()[len(keyPrefix):]...)
+		record, err := getNameRecord(ctx, k, nameKey)
+		if err != nil {
+			return fmt.Errorf("could not get child name record of %q: %w", name, err)
+		}
+		if handle(record.Name) {
+			break
+		}
+	}
+	return nil
+}
+
+// DeleteNames unbinds a name and removes all attributes with that name.
+// If recursive is true, all names under it are unbound too (along with their attributes), regardless
+// of who they resolve to. If recursive is false, the name cannot have any child names.
+// The names are deleted deepest first, and the deleted names are returned in that order.
+// The owner is only used for removing attributes; it is up to the caller to check that it may delete the name.
+func (k Keeper) DeleteNames(ctx sdk.Context, name string, owner sdk.AccAddress, recursive bool) ([]string, error) {
+	if !k.NameExists(ctx, name) {
+		return nil, types.ErrNameNotBound
+	}
+
+	maxDeletions := int(k.GetParams(ctx).MaxDeletions)
+	toDelete := []string{name}
+	for i := 0; i < len(toDelete); i++ {
+		var childErr error
+		err := k.iterateChildNames(ctx, toDelete[i], func(child string) bool {
+			if !recursive {
+				childErr = fmt.Errorf("name %q has child names, e.g. %q", name, child)
+				return true
+			}
+			toDelete = append(toDelete, child)
+			return len(toDelete) > maxDeletions
+		})
+		if err != nil {
+			return nil, err
+		}
+		if childErr != nil {
+			return nil, childErr
+		}
+		if recursive && len(toDelete) > maxDeletions {
+			return nil, fmt.Errorf("cannot delete more than %d names at once", maxDeletions)
+		}
+	}
+
+	deleted := make([]string, 0, len(toDelete))
+	for i := len(toDelete) - 1; i >= 0; i-- {
+		if err := k.DeleteRecord(ctx, toDelete[i]); err != nil {
+			return nil, fmt.Errorf("could not delete name %q: %w", toDelete[i], err)
+		}
+		if err := k.attrKeeper.PurgeAttribute(ctx, toDelete[i], owner); err != nil {
+			return nil, fmt.Errorf("could not delete %q attributes: %w", toDelete[i], err)
+		}
+		deleted = append(deleted, toDelete[i])
+	}
+	return deleted, nil
+}
+
+// RebuildChildNameIndex recreates the parent name -> child name index from the name records in state.
+func (k Keeper) RebuildChildNameIndex(ctx sdk.Context) error {
+	store := ctx.KVStore(k.storeKey)
+
+	var oldKeys [][]byte
+	iterator := storetypes.KVStorePrefixIterator(store, types.ChildNameKeyPrefix)
+	for ; iterator.Valid(); iterator.Next() {
+		oldKeys = append(oldKeys, iterator.Key())
+	}
+	iterator.Close()
+	for _, key := range oldKeys {
+		store.Delete(key)
+	}
+
+	err := k.IterateRecords(ctx, types.NameKeyPrefix, func(record types.NameRecord) error {
+		return setChildNameIndex(store, record.Name)
+	})
+	if err != nil {
+		return fmt.Errorf("could not rebuild child name index: %w", err)
+	}
+	return nil
+}
+
+// setChildNameIndex adds the index entry for a name under its parent. Root names are not indexed.
+func setChildNameIndex(store storetypes.KVStore, name string) error {
+	if _, ok := types.GetParentName(name); !ok {
+		return nil
+	}
+	key, err := types.GetChildNameKey(name)
+	if err != nil {
+		return err
+	}
+	store.Set(key, []byte{})
+	return nil
+}
+
+// deleteChildNameIndex removes the index entry for a name under its parent. Root names are not indexed.
+func deleteChildNameIndex(store storetypes.KVStore, name string) error {
+	if _, ok := types.GetParentName(name); !ok {
+		return nil
+	}
+	key, err := types.GetChildNameKey(name)
+	if err != nil {
+		return err
+	}
+	store.Delete(key)
+	return nil
+}
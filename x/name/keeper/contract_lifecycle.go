@@ -0,0 +1,88 @@
+package keeper
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/name/types"
+)
+
+// ContractLifecycleHooks is a wrapper around the name keeper that applies the contract name policies
+// when a wasm contract is migrated or has its admin cleared.
+type ContractLifecycleHooks struct {
+	k Keeper
+}
+
+// ContractLifecycleHooks returns the contract lifecycle hooks needed by the name keeper.
+func (k Keeper) ContractLifecycleHooks() ContractLifecycleHooks {
+	return ContractLifecycleHooks{k: k}
+}
+
+// AfterContractMigrated applies the contract migrated name policy to the names bound to the contract.
+func (h ContractLifecycleHooks) AfterContractMigrated(ctx sdk.Context, contract, admin sdk.AccAddress) error {
+	return h.k.ApplyContractNamePolicy(ctx, contract, admin, h.k.GetParams(ctx).ContractMigratedNamePolicy)
+}
+
+// AfterContractAdminCleared applies the contract admin cleared name policy to the names bound to the contract.
+func (h ContractLifecycleHooks) AfterContractAdminCleared(ctx sdk.Context, contract, admin sdk.AccAddress) error {
+	return h.k.ApplyContractNamePolicy(ctx, contract, admin, h.k.GetParams(ctx).ContractAdminClearedNamePolicy)
+}
+
+// ApplyContractNamePolicy reassigns or deletes all the names bound to a contract according to the provided policy.
+// The admin is the account that migrated the contract or cleared its admin; it receives the names when reassigning.
+// When deleting, all names under the contract's names are deleted too (regardless of who they resolve to),
+// along with the attributes of those names.
+func (k Keeper) ApplyContractNamePolicy(ctx sdk.Context, contract, admin sdk.AccAddress, policy types.ContractNamePolicy) error {
+	if policy == types.ContractNamePolicyKeep {
+		return nil
+	}
+
+	records, err := k.GetRecordsByAddress(ctx, contract)
+	if err != nil {
+		return fmt.Errorf("could not get names bound to contract %s: %w", contract, err)
+	}
+	if len(records) == 0 {
+		return nil
+	}
+	// Handle the shallowest names first so that, when deleting, a name's children are deleted along with it.
+	sort.Slice(records, func(i, j int) bool {
+		iLvls, jLvls := strings.Count(records[i].Name, "."), strings.Count(records[j].Name, ".")
+		if iLvls != jLvls {
+			return iLvls < jLvls
+		}
+		return records[i].Name < records[j].Name
+	})
+
+	var names []string
+	switch policy {
+	case types.ContractNamePolicyReassignToAdmin:
+		if err = types.ValidateAddress(admin); err != nil {
+			return fmt.Errorf("cannot reassign names of contract %s: invalid admin: %w", contract, err)
+		}
+		for _, record := range records {
+			if err = k.UpdateNameRecord(ctx, record.Name, admin, record.Restricted, contract); err != nil {
+				return fmt.Errorf("could not reassign name %q from contract %s to %s: %w", record.Name, contract, admin, err)
+			}
+			names = append(names, record.Name)
+		}
+	case types.ContractNamePolicyDelete:
+		for _, record := range records {
+			// A name might have already been deleted because it's under another one of the contract's names.
+			if !k.NameExists(ctx, record.Name) {
+				continue
+			}
+			deleted, delErr := k.DeleteNames(ctx, record.Name, contract, true)
+			if delErr != nil {
+				return fmt.Errorf("could not delete name %q of contract %s: %w", record.Name, contract, delErr)
+			}
+			names = append(names, deleted...)
+		}
+	default:
+		return fmt.Errorf("unknown contract name policy %d", policy)
+	}
+
+	return ctx.EventManager().EmitTypedEvent(types.NewEventContractNamePolicyApplied(contract.String(), admin.String(), policy, names))
+}
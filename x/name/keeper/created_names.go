@@ -0,0 +1,96 @@
+package keeper
+
+import (
+	"fmt"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/name/types"
+)
+
+// setNameCreationIndex records the current block height as the height that the provided name was bound at.
+// Nothing is recorded if the created_names_retention_blocks param is zero.
+func (k Keeper) setNameCreationIndex(ctx sdk.Context, name string) error {
+	if k.GetParams(ctx).CreatedNamesRetentionBlocks == 0 {
+		return nil
+	}
+	key, err := types.GetNameCreationKey(name)
+	if err != nil {
+		return err
+	}
+	height := ctx.BlockHeight()
+	heightKey, err := types.GetNameCreationHeightKey(height, name)
+	if err != nil {
+		return err
+	}
+	store := ctx.KVStore(k.storeKey)
+	store.Set(key, sdk.Uint64ToBigEndian(uint64(height)))
+	store.Set(heightKey, []byte{})
+	return nil
+}
+
+// deleteNameCreationIndex removes the provided name from the name creation index (if it's in there).
+func deleteNameCreationIndex(store storetypes.KVStore, name string) error {
+	key, err := types.GetNameCreationKey(name)
+	if err != nil {
+		return err
+	}
+	bz := store.Get(key)
+	if len(bz) == 0 {
+		return nil
+	}
+	heightKey, err := types.GetNameCreationHeightKey(int64(sdk.BigEndianToUint64(bz)), name)
+	if err != nil {
+		return err
+	}
+	store.Delete(key)
+	store.Delete(heightKey)
+	return nil
+}
+
+// deleteNameCreationHeightKey removes an entry from the name creation index using its height key.
+func deleteNameCreationHeightKey(store storetypes.KVStore, heightKey []byte) error {
+	store.Delete(heightKey)
+	_, nameKey, err := types.ParseNameCreationHeightKey(heightKey)
+	if err != nil {
+		return err
+	}
+	key := make([]byte, 0, len(types.NameCreationKeyPrefix)+len(nameKey)-len(types.NameKeyPrefix))
+	key = append(key, types.NameCreationKeyPrefix...)
+	store.Delete(append(key, nameKey[len(types.NameKeyPrefix):]...))
+	return nil
+}
+
+// PruneNameCreationIndex removes the names bound more than created_names_retention_blocks blocks ago
+// from the name creation index. If that param is zero, the whole index is removed.
+func (k Keeper) PruneNameCreationIndex(ctx sdk.Context) {
+	cutoff := ctx.BlockHeight() - int64(k.GetParams(ctx).CreatedNamesRetentionBlocks)
+	if cutoff < 0 {
+		return
+	}
+	k.deleteNameCreationIndexBefore(ctx, types.GetNameCreationHeightKeyPrefix(cutoff+1))
+}
+
+// clearNameCreationIndex removes all entries from the name creation index.
+func (k Keeper) clearNameCreationIndex(ctx sdk.Context) {
+	k.deleteNameCreationIndexBefore(ctx, storetypes.PrefixEndBytes(types.NameCreationHeightKeyPrefix))
+}
+
+// deleteNameCreationIndexBefore removes the entries of the name creation index with a height key before the one provided.
+func (k Keeper) deleteNameCreationIndexBefore(ctx sdk.Context, end []byte) {
+	store := ctx.KVStore(k.storeKey)
+	var heightKeys [][]byte
+	iterator := store.Iterator(types.NameCreationHeightKeyPrefix, end)
+	for ; iterator.Valid(); iterator.Next() {
+		heightKeys = append(heightKeys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, heightKey := range heightKeys {
+		if err := deleteNameCreationHeightKey(store, heightKey); err != nil {
+			k.Logger(ctx).Error("could not remove name creation index entry", "key", fmt.Sprintf("%X", heightKey), "error", err)
+		}
+	}
+}
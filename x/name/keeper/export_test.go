@@ -9,3 +9,8 @@ import sdk "github.com/cosmos/cosmos-sdk/types"
 func (k Keeper) AddRecord(ctx sdk.Context, name string, addr sdk.AccAddress, restrict, isModifiable bool) error {
 	return k.addRecord(ctx, name, addr, restrict, isModifiable)
 }
+
+// MigrateAddressIndex is a TEST ONLY exposure of migrateAddressIndex.
+func (k Keeper) MigrateAddressIndex(ctx sdk.Context, batchSize int) error {
+	return k.migrateAddressIndex(ctx, batchSize)
+}
@@ -1,26 +1,79 @@
 package keeper
 
 import (
+	"bytes"
+	"crypto/sha256"
+	"fmt"
+	"io"
+
+	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
 
+	"github.com/provenance-io/provenance/internal/provutils"
 	types "github.com/provenance-io/provenance/x/name/types"
 )
 
 // InitGenesis creates the initial genesis state for the name module.
 func (k Keeper) InitGenesis(ctx sdk.Context, data types.GenesisState) {
+	if err := k.importGenesis(ctx, data); err != nil {
+		panic(err)
+	}
+}
+
+// importGenesis writes the provided genesis state to the store.
+// The bindings and pending deletions are written in the same order they are exported in (by store key),
+// so the resulting state (and the hooks called along the way) don't depend on the order they're listed in.
+func (k Keeper) importGenesis(ctx sdk.Context, data types.GenesisState) error {
+	bindings, err := types.NameRecords(data.Bindings).SortByNameKey()
+	if err != nil {
+		return err
+	}
+	pendingDeletions, err := types.SortPendingDeletions(data.PendingDeletions)
+	if err != nil {
+		return err
+	}
+
 	k.SetParams(ctx, data.Params)
-	for _, record := range data.Bindings {
+	for _, record := range bindings {
 		addr, err := sdk.AccAddressFromBech32(record.Address)
 		if err != nil {
-			panic(err)
+			return err
+		}
+		if err = k.SetNameRecord(ctx, record.Name, addr, record.Restricted); err != nil {
+			return err
+		}
+	}
+	for _, pending := range pendingDeletions {
+		if err = k.SetPendingDeletion(ctx, pending); err != nil {
+			return err
+		}
+	}
+	// The names in genesis weren't newly bound, so they shouldn't show up as recently created.
+	k.clearNameCreationIndex(ctx)
+	store := ctx.KVStore(k.storeKey)
+	for _, approval := range data.AddressRotationApprovals {
+		oldAddr, err := sdk.AccAddressFromBech32(approval.OldAddress)
+		if err != nil {
+			return fmt.Errorf("invalid address rotation approval old address: %w", err)
+		}
+		newAddr, err := sdk.AccAddressFromBech32(approval.NewAddress)
+		if err != nil {
+			return fmt.Errorf("invalid address rotation approval new address: %w", err)
 		}
-		if err := k.SetNameRecord(ctx, record.Name, addr, record.Restricted); err != nil {
-			panic(err)
+		store.Set(types.GetAddressRotationApprovalKey(oldAddr, newAddr), []byte{})
+	}
+	for _, quota := range data.ChildQuotas {
+		if err = k.setChildQuota(store, types.NewChildQuota(quota.Name, quota.MaxChildren)); err != nil {
+			return fmt.Errorf("invalid child quota: %w", err)
 		}
 	}
+	return nil
 }
 
 // ExportGenesis exports the current keeper state of the name module.
+// The bindings are ordered by name key, the pending deletions by delete height then name key,
+// the address rotation approvals by old address, and the child quotas by name key.
 func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 	params := k.GetParams(ctx)
 	// Genesis state data structure.
@@ -34,5 +87,91 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 	if err := k.IterateRecords(ctx, types.NameKeyPrefix, appendToRecords); err != nil {
 		panic(err)
 	}
-	return types.NewGenesisState(params, records)
+	genState := types.NewGenesisState(params, records)
+	genState.PendingDeletions = k.getAllPendingDeletions(ctx)
+	genState.AddressRotationApprovals = k.getAllAddressRotationApprovals(ctx)
+	genState.ChildQuotas = k.getAllChildQuotas(ctx)
+	return genState
+}
+
+// ExportGenesisTo writes the current keeper state of the name module to the writer as genesis JSON.
+// The bindings are encoded as they're iterated over, so they're never all decoded into a genesis state at once.
+// Whatever the writer does with the JSON is up to it, e.g. a bytes.Buffer will still hold all of it.
+func (k Keeper) ExportGenesisTo(ctx sdk.Context, cdc codec.JSONCodec, w io.Writer) error {
+	params := k.GetParams(ctx)
+	pendingDeletions := provutils.GenesisListField{Name: "pending_deletions"}
+	for _, pending := range k.getAllPendingDeletions(ctx) {
+		pendingDeletions.Entries = append(pendingDeletions.Entries, &pending)
+	}
+	approvals := provutils.GenesisListField{Name: "address_rotation_approvals"}
+	for _, approval := range k.getAllAddressRotationApprovals(ctx) {
+		approvals.Entries = append(approvals.Entries, &approval)
+	}
+	childQuotas := provutils.GenesisListField{Name: "child_quotas"}
+	for _, quota := range k.getAllChildQuotas(ctx) {
+		childQuotas.Entries = append(childQuotas.Entries, &quota)
+	}
+	return provutils.StreamGenesisJSON(w, cdc, &params, "bindings", func(emit func(entry proto.Message) error) error {
+		return k.IterateRecords(ctx, types.NameKeyPrefix, func(record types.NameRecord) error {
+			return emit(&record)
+		})
+	}, pendingDeletions, approvals, childQuotas)
+}
+
+// VerifyGenesisRoundTrip exports the name module's state, imports that export into an empty name store,
+// then exports it again. An error is returned if the SHA-256 hashes of the two exports are different.
+// The import is done in a cache context that is never written, so the state in ctx is not changed.
+func (k Keeper) VerifyGenesisRoundTrip(ctx sdk.Context, cdc codec.JSONCodec) error {
+	var exported bytes.Buffer
+	if err := k.ExportGenesisTo(ctx, cdc, &exported); err != nil {
+		return fmt.Errorf("could not export name genesis: %w", err)
+	}
+	var genState types.GenesisState
+	if err := cdc.UnmarshalJSON(exported.Bytes(), &genState); err != nil {
+		return fmt.Errorf("could not read exported name genesis: %w", err)
+	}
+
+	cacheCtx, _ := ctx.CacheContext()
+	cacheCtx = cacheCtx.WithEventManager(sdk.NewEventManager())
+	k.clearStore(cacheCtx)
+	if err := k.importGenesis(cacheCtx, genState); err != nil {
+		return fmt.Errorf("could not import exported name genesis: %w", err)
+	}
+	var reexported bytes.Buffer
+	if err := k.ExportGenesisTo(cacheCtx, cdc, &reexported); err != nil {
+		return fmt.Errorf("could not export re-imported name genesis: %w", err)
+	}
+
+	expHash, actHash := sha256.Sum256(exported.Bytes()), sha256.Sum256(reexported.Bytes())
+	if expHash != actHash {
+		return fmt.Errorf("name genesis export hash %X does not match the hash %X after re-importing it", expHash, actHash)
+	}
+	return nil
+}
+
+// clearStore deletes everything in the name module's store.
+func (k Keeper) clearStore(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	var keys [][]byte
+	it := store.Iterator(nil, nil)
+	for ; it.Valid(); it.Next() {
+		keys = append(keys, it.Key())
+	}
+	it.Close()
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// getAllPendingDeletions returns all of the pending deletions, ordered by delete height.
+func (k Keeper) getAllPendingDeletions(ctx sdk.Context) []types.PendingNameDeletion {
+	pendingDeletions := []types.PendingNameDeletion{}
+	err := k.IteratePendingDeletions(ctx, func(pending types.PendingNameDeletion) bool {
+		pendingDeletions = append(pendingDeletions, pending)
+		return false
+	})
+	if err != nil {
+		panic(err)
+	}
+	return pendingDeletions
 }
@@ -0,0 +1,89 @@
+package keeper
+
+import (
+	"fmt"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/name/types"
+)
+
+// The name of the address index invariant.
+const addressIndexInvariantName = "address-index"
+
+// RegisterInvariants registers the name module invariants.
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, addressIndexInvariantName, AddressIndexInvariant(k))
+}
+
+// AddressIndexInvariant checks that each name record has exactly one address index entry, for the address it is
+// bound to, and that the index entries have empty values.
+func AddressIndexInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var problems []string
+		addProblem := func(format string, args ...interface{}) {
+			// Cap the number of problems reported so a badly broken store doesn't produce a huge message.
+			if len(problems) < 10 {
+				problems = append(problems, fmt.Sprintf(format, args...))
+			}
+		}
+
+		store := ctx.KVStore(k.storeKey)
+		indexed := make(map[string]bool)
+		indexCount := 0
+		iter := storetypes.KVStorePrefixIterator(store, types.AddressKeyPrefix)
+		for ; iter.Valid(); iter.Next() {
+			indexCount++
+			key := iter.Key()
+			addr, nameKey, err := types.ParseAddressKey(key)
+			if err != nil {
+				addProblem("%v", err)
+				continue
+			}
+			if len(iter.Value()) != 0 {
+				addProblem("address index entry %X has a value", key)
+			}
+			bz := store.Get(nameKey)
+			if bz == nil {
+				addProblem("address index entry %X is for an unbound name", key)
+				continue
+			}
+			var record types.NameRecord
+			if err = k.cdc.Unmarshal(bz, &record); err != nil {
+				addProblem("could not read name record for address index entry %X: %v", key, err)
+				continue
+			}
+			if record.Address != addr.String() {
+				addProblem("address index entry for %q has address %s but the name is bound to %s", record.Name, addr, record.Address)
+				continue
+			}
+			indexed[string(nameKey)] = true
+		}
+		iter.Close()
+
+		recordCount := 0
+		err := k.IterateRecords(ctx, types.NameKeyPrefix, func(record types.NameRecord) error {
+			recordCount++
+			nameKey, err := types.GetNameKeyPrefix(record.Name)
+			if err != nil {
+				return err
+			}
+			if !indexed[string(nameKey)] {
+				addProblem("name %q does not have an address index entry for %s", record.Name, record.Address)
+			}
+			return nil
+		})
+		if err != nil {
+			addProblem("could not iterate name records: %v", err)
+		}
+
+		broken := len(problems) > 0 || indexCount != recordCount
+		msg := fmt.Sprintf("%d name records and %d address index entries", recordCount, indexCount)
+		for _, problem := range problems {
+			msg += "\n" + problem
+		}
+		return sdk.FormatInvariant(types.ModuleName, addressIndexInvariantName, msg), broken
+	}
+}
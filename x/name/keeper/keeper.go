@@ -1,8 +1,6 @@
 package keeper
 
 import (
-	"bytes"
-	"fmt"
 	"strings"
 
 	"cosmossdk.io/log"
@@ -13,6 +11,7 @@ import (
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
+	"github.com/provenance-io/provenance/internal/provutils"
 	"github.com/provenance-io/provenance/x/name/types"
 )
 
@@ -28,6 +27,12 @@ type Keeper struct {
 	authority string
 
 	attrKeeper types.AttributeKeeper
+	authKeeper types.AccountKeeper
+	bankKeeper types.BankKeeper
+
+	hooks types.NameHooks
+
+	rotators []types.AddressRotator
 }
 
 // NewKeeper returns a name keeper. It handles:
@@ -38,17 +43,23 @@ type Keeper struct {
 func NewKeeper(
 	cdc codec.BinaryCodec,
 	key storetypes.StoreKey,
+	authKeeper types.AccountKeeper,
+	bankKeeper types.BankKeeper,
 ) Keeper {
-	return Keeper{
-		storeKey:  key,
-		cdc:       cdc,
-		authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
-	}
+	rv := Keeper{
+		storeKey:   key,
+		cdc:        cdc,
+		authority:  authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		authKeeper: authKeeper,
+		bankKeeper: bankKeeper,
+	}
+	bankKeeper.AppendSendRestriction(rv.SendRestrictionFn)
+	return rv
 }
 
 // Logger returns a module-specific logger.
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
-	return ctx.Logger().With("module", "x/"+types.ModuleName)
+	return provutils.ModuleLogger(ctx, types.ModuleName)
 }
 
 // GetAuthority is signer of the proposal
@@ -77,6 +88,14 @@ func (k *Keeper) SetAttributeKeeper(ak types.AttributeKeeper) {
 	k.attrKeeper = ak
 }
 
+// SetHooks sets the hooks that get called when name records change.
+func (k *Keeper) SetHooks(nh types.NameHooks) {
+	if k.hooks != nil && nh != nil {
+		panic("the name hooks have already been set")
+	}
+	k.hooks = nh
+}
+
 // ResolvesTo to determines whether a name resolves to a given address.
 func (k Keeper) ResolvesTo(ctx sdk.Context, name string, addr sdk.AccAddress) bool {
 	stored, err := k.GetRecordByName(ctx, name)
@@ -87,26 +106,86 @@ func (k Keeper) ResolvesTo(ctx sdk.Context, name string, addr sdk.AccAddress) bo
 }
 
 // SetNameRecord binds a name to an address.
+// The parents of the name are not checked, so this should only be used for genesis and governance actions.
+// Use BindNameRecord when binding a name on behalf of someone.
 func (k Keeper) SetNameRecord(ctx sdk.Context, name string, addr sdk.AccAddress, restrict bool) error {
 	var err error
 	if name, err = k.Normalize(ctx, name); err != nil {
 		return err
 	}
-	if err = types.ValidateAddress(addr); err != nil {
+	return k.setNameRecord(ctx, name, addr, restrict)
+}
+
+// setNameRecord binds an already normalized name to an address.
+func (k Keeper) setNameRecord(ctx sdk.Context, name string, addr sdk.AccAddress, restrict bool) error {
+	if err := types.ValidateAddress(addr); err != nil {
 		return types.ErrInvalidAddress.Wrap(err.Error())
 	}
 
-	if err = k.addRecord(ctx, name, addr, restrict, false); err != nil {
+	if err := k.addRecord(ctx, name, addr, restrict, false); err != nil {
+		return err
+	}
+	if err := k.setNameCreationIndex(ctx, name); err != nil {
 		return err
 	}
+	if k.hooks != nil {
+		k.hooks.AfterNameBound(ctx, name, addr)
+	}
 
 	nameBoundEvent := types.NewEventNameBound(addr.String(), name, restrict)
 
 	return ctx.EventManager().EmitTypedEvent(nameBoundEvent)
 }
 
+// BindNameRecord binds a name to an address on behalf of the signer.
+// Unlike SetNameRecord, it requires that every parent of the name is bound, that the name's direct parent
+// is either unrestricted or resolves to the signer, and that the parent's child quota (if any) has room for it.
+// Root names cannot be bound this way.
+func (k Keeper) BindNameRecord(ctx sdk.Context, name string, addr sdk.AccAddress, restrict bool, signer sdk.AccAddress) error {
+	var err error
+	if name, err = k.Normalize(ctx, name); err != nil {
+		return err
+	}
+	if err = k.ValidateParentNames(ctx, name, signer); err != nil {
+		return err
+	}
+	if err = k.ValidateChildQuota(ctx, name); err != nil {
+		return err
+	}
+	return k.setNameRecord(ctx, name, addr, restrict)
+}
+
+// ValidateParentNames returns an error if any parent of the provided (normalized) name is not bound,
+// or if the name's direct parent is restricted and does not resolve to the signer.
+// Restrictions on names further up only apply to the binding of their own direct children.
+func (k Keeper) ValidateParentNames(ctx sdk.Context, name string, signer sdk.AccAddress) error {
+	parentName, hasParent := types.GetParentName(name)
+	if !hasParent {
+		return types.ErrNameInvalid.Wrapf("cannot bind root name %q", name)
+	}
+	parent, err := k.GetRecordByName(ctx, parentName)
+	if err != nil {
+		return types.ErrParentNameNotBound.Wrapf("%q", parentName)
+	}
+	if parent.Restricted && parent.Address != signer.String() {
+		return types.ErrParentNameRestricted.Wrapf("%q does not resolve to %s", parentName, signer)
+	}
+	if k.IsPendingDeletion(ctx, parentName) {
+		return types.ErrNamePendingDeletion.Wrapf("parent name %q", parentName)
+	}
+	for ancestor, ok := types.GetParentName(parentName); ok; ancestor, ok = types.GetParentName(ancestor) {
+		if !k.NameExists(ctx, ancestor) {
+			return types.ErrParentNameNotBound.Wrapf("%q", ancestor)
+		}
+	}
+	return nil
+}
+
 // UpdateNameRecord updates the owner address and restricted flag on a name.
-func (k Keeper) UpdateNameRecord(ctx sdk.Context, name string, addr sdk.AccAddress, restrict bool) error {
+// If an expectedCurrentAddr is provided, the name must currently be bound to it, otherwise an error is returned
+// and nothing is changed. This allows callers to safely update a name that they looked up earlier in the tx.
+// If expectedCurrentAddr is empty, the record is updated (or created) regardless of its current binding.
+func (k Keeper) UpdateNameRecord(ctx sdk.Context, name string, addr sdk.AccAddress, restrict bool, expectedCurrentAddr sdk.AccAddress) error {
 	var err error
 	if name, err = k.Normalize(ctx, name); err != nil {
 		return err
@@ -120,8 +199,16 @@ func (k Keeper) UpdateNameRecord(ctx sdk.Context, name string, addr sdk.AccAddre
 	// it, we don't really care; either it doesn't exist or the same error will
 	// come up again later (when we add the new record).
 	existing, _ := k.GetRecordByName(ctx, name)
+	if len(expectedCurrentAddr) > 0 {
+		if existing == nil {
+			return types.ErrNameNotBound.Wrapf("%q", name)
+		}
+		if existing.Address != expectedCurrentAddr.String() {
+			return types.ErrNameBindingMismatch.Wrapf("%q is bound to %s, expected %s", name, existing.Address, expectedCurrentAddr)
+		}
+	}
+	oldAddr := addr
 	if existing != nil && existing.Address != addr.String() {
-		var oldAddr sdk.AccAddress
 		var oldNameKeyPre, oldAddrKey []byte
 		oldAddr, err = sdk.AccAddressFromBech32(existing.Address)
 		if err != nil {
@@ -143,6 +230,18 @@ func (k Keeper) UpdateNameRecord(ctx sdk.Context, name string, addr sdk.AccAddre
 	if err = k.addRecord(ctx, name, addr, restrict, true); err != nil {
 		return err
 	}
+	if existing == nil {
+		if err = k.setNameCreationIndex(ctx, name); err != nil {
+			return err
+		}
+	}
+	if k.hooks != nil {
+		if existing != nil {
+			k.hooks.AfterNameUpdated(ctx, name, oldAddr, addr)
+		} else {
+			k.hooks.AfterNameBound(ctx, name, addr)
+		}
+	}
 
 	nameUpdateEvent := types.NewEventNameUpdate(addr.String(), name, restrict)
 
@@ -183,21 +282,27 @@ func (k Keeper) NameExists(ctx sdk.Context, name string) bool {
 func (k Keeper) GetRecordsByAddress(ctx sdk.Context, address sdk.AccAddress) (types.NameRecords, error) {
 	// Return value data structure.
 	records := types.NameRecords{}
-	// Handler that adds records if account address matches.
-	appendToRecords := func(record types.NameRecord) error {
-		if record.Address == address.String() {
-			records = append(records, record)
-		}
-		return nil
-	}
 	// Calculate address prefix
 	addrPrefix, err := types.GetAddressKeyPrefix(address)
 	if err != nil {
 		return nil, err
 	}
-	// Collect and return all names that match.
-	if err := k.IterateRecords(ctx, addrPrefix, appendToRecords); err != nil {
-		return records, err
+	// The address index keys end with the name record keys; the records are looked up using them.
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, addrPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		bz := store.Get(iterator.Key()[len(addrPrefix):])
+		if bz == nil {
+			continue
+		}
+		record := types.NameRecord{}
+		if err = k.cdc.Unmarshal(bz, &record); err != nil {
+			return records, err
+		}
+		if record.Address == address.String() {
+			records = append(records, record)
+		}
 	}
 	return records, nil
 }
@@ -220,6 +325,22 @@ func (k Keeper) DeleteRecord(ctx sdk.Context, name string) error {
 	}
 	store := ctx.KVStore(k.storeKey)
 	store.Delete(key)
+	decrementNameStats(store, record.Name, record.Restricted)
+	if err = deleteChildNameIndex(store, record.Name); err != nil {
+		return err
+	}
+	if err = deleteUUIDNameIndex(store, record.Name); err != nil {
+		return err
+	}
+	if err = k.deletePendingDeletion(store, record.Name); err != nil {
+		return err
+	}
+	if err = deleteNameCreationIndex(store, record.Name); err != nil {
+		return err
+	}
+	if err = deleteChildQuota(store, record.Name); err != nil {
+		return err
+	}
 	// Delete the address index record
 	addrPrefix, err := types.GetAddressKeyPrefix(address)
 	if err != nil {
@@ -229,6 +350,9 @@ func (k Keeper) DeleteRecord(ctx sdk.Context, name string) error {
 	if store.Has(addrPrefix) {
 		store.Delete(addrPrefix)
 	}
+	if k.hooks != nil {
+		k.hooks.AfterNameDeleted(ctx, name, address)
+	}
 
 	nameUnboundEvent := types.NewEventNameUnbound(record.Address, name, record.Restricted)
 
@@ -236,10 +360,27 @@ func (k Keeper) DeleteRecord(ctx sdk.Context, name string) error {
 }
 
 // IterateRecords iterates over all the stored name records and passes them to a callback function.
+// Records are provided in ascending order of their store keys.
 func (k Keeper) IterateRecords(ctx sdk.Context, prefix []byte, handle func(record types.NameRecord) error) error {
+	return k.iterateRecords(ctx, prefix, false, handle)
+}
+
+// IterateRecordsReverse is the same as IterateRecords except records are provided in descending order of their store keys.
+func (k Keeper) IterateRecordsReverse(ctx sdk.Context, prefix []byte, handle func(record types.NameRecord) error) error {
+	return k.iterateRecords(ctx, prefix, true, handle)
+}
+
+// iterateRecords iterates over the stored name records with the given prefix, in key order (or reverse key order),
+// and passes them to a callback function.
+func (k Keeper) iterateRecords(ctx sdk.Context, prefix []byte, reverse bool, handle func(record types.NameRecord) error) error {
 	// Init a name record iterator
 	store := ctx.KVStore(k.storeKey)
-	iterator := storetypes.KVStorePrefixIterator(store, prefix)
+	var iterator storetypes.Iterator
+	if reverse {
+		iterator = storetypes.KVStoreReversePrefixIterator(store, prefix)
+	} else {
+		iterator = storetypes.KVStorePrefixIterator(store, prefix)
+	}
 	defer iterator.Close()
 	// Iterate over records, processing callbacks.
 	for ; iterator.Valid(); iterator.Next() {
@@ -255,25 +396,15 @@ func (k Keeper) IterateRecords(ctx sdk.Context, prefix []byte, handle func(recor
 }
 
 // Normalize returns a name is storage format.
+// Gas is consumed proportional to the length and number of segments of the provided name.
 func (k Keeper) Normalize(ctx sdk.Context, name string) (string, error) {
+	ctx.GasMeter().ConsumeGas(types.NormalizeNameGas(name), "name normalization")
 	normalized := types.NormalizeName(name)
 	if !types.IsValidName(normalized) {
 		return "", types.ErrNameInvalid
 	}
-	segCount := uint32(0)
-	for _, segment := range strings.Split(normalized, ".") {
-		segCount++
-		segLen := len(segment)
-		isUUID := types.IsValidUUID(segment)
-		if segLen < int(k.GetMinSegmentLength(ctx)) {
-			return "", types.ErrNameSegmentTooShort
-		}
-		if segLen > int(k.GetMaxSegmentLength(ctx)) && !isUUID {
-			return "", types.ErrNameSegmentTooLong
-		}
-	}
-	if segCount > k.GetMaxNameLevels(ctx) {
-		return "", types.ErrNameHasTooManySegments
+	if err := k.GetParams(ctx).ValidateName(normalized); err != nil {
+		return "", err
 	}
 	return normalized, nil
 }
@@ -285,8 +416,15 @@ func (k Keeper) addRecord(ctx sdk.Context, name string, addr sdk.AccAddress, res
 	}
 
 	store := ctx.KVStore(k.storeKey)
-	if store.Has(key) && !isModifiable {
-		return types.ErrNameAlreadyBound
+	var existing *types.NameRecord
+	if bz := store.Get(key); bz != nil {
+		if !isModifiable {
+			return types.ErrNameAlreadyBound
+		}
+		existing = &types.NameRecord{}
+		if err = k.cdc.Unmarshal(bz, existing); err != nil {
+			return err
+		}
 	}
 
 	record := types.NewNameRecord(name, addr, restrict)
@@ -303,14 +441,27 @@ func (k Keeper) addRecord(ctx sdk.Context, name string, addr sdk.AccAddress, res
 	if err != nil {
 		return err
 	}
-	addrPrefix = append(addrPrefix, key...) // [0x04] :: [addr-bytes] :: [name-key-bytes]
-	store.Set(addrPrefix, bz)
+	addrPrefix = append(addrPrefix, key...) // [0x05] :: [addr-bytes] :: [name-key-bytes]
+	store.Set(addrPrefix, []byte{})
+
+	// Keep the name counts and the child and uuid indexes up to date.
+	if existing == nil {
+		incrementNameStats(store, name, restrict)
+		if err = setChildNameIndex(store, name); err != nil {
+			return err
+		}
+		if err = setUUIDNameIndex(store, name); err != nil {
+			return err
+		}
+	} else {
+		updateRestrictedNameStats(store, existing.Restricted, restrict)
+	}
 
 	return nil
 }
 
-// DeleteInvalidAddressIndexEntries is only for the rust upgrade. It goes over all the address -> name entries and
-// deletes any that are no longer accurate.
+// DeleteInvalidAddressIndexEntries goes over all the address -> name entries and deletes any that are no longer
+// accurate, i.e. the name isn't bound, or is bound to a different address. The values of the entries are not checked.
 func (k Keeper) DeleteInvalidAddressIndexEntries(ctx sdk.Context) {
 	logger := k.Logger(ctx)
 	logger.Info("Checking address -> name index entries.")
@@ -318,15 +469,6 @@ func (k Keeper) DeleteInvalidAddressIndexEntries(ctx sdk.Context) {
 	keepCount := 0
 	var toDelete [][]byte
 
-	extractNameKey := func(key []byte) []byte {
-		// byte 1 is the type byte (0x05), it's ignored here.
-		// The 2nd byte is the length of the address that immediately follows it.
-		// The name key starts directly after the address, and is the rest of the key.
-		addrLen := int(key[1])
-		nameKeyStart := addrLen + 2
-		return key[nameKeyStart:]
-	}
-
 	store := ctx.KVStore(k.storeKey)
 	iter := storetypes.KVStorePrefixIterator(store, types.AddressKeyPrefix)
 	defer func() {
@@ -336,22 +478,18 @@ func (k Keeper) DeleteInvalidAddressIndexEntries(ctx sdk.Context) {
 	}()
 
 	for ; iter.Valid(); iter.Next() {
-		// If the key points to a non-existent name, delete it.
 		key := iter.Key()
-		nameKey := extractNameKey(key)
-		if !store.Has(nameKey) {
-			toDelete = append(toDelete, key)
+		keep, err := k.isValidAddressIndexEntry(store, key)
+		if err != nil {
+			// If the name record can't be read, there's no way to tell if the entry is accurate, so it's left alone.
+			logger.Error("Could not check address -> name index entry.", "error", err)
+			keepCount++
 			continue
 		}
-
-		// If the index value and main value are different, delete the index.
-		indValBz := iter.Value()
-		mainValBz := store.Get(nameKey)
-		if !bytes.Equal(indValBz, mainValBz) {
+		if !keep {
 			toDelete = append(toDelete, key)
 			continue
 		}
-
 		keepCount++
 	}
 
@@ -359,19 +497,21 @@ func (k Keeper) DeleteInvalidAddressIndexEntries(ctx sdk.Context) {
 	iter = nil
 
 	if len(toDelete) == 0 {
-		logger.Info(fmt.Sprintf("Done checking address -> name index entries. All %d entries are valid", keepCount))
+		logger.Info("Done checking address -> name index entries. All entries are valid.", "count", keepCount)
 		return
 	}
 
-	logger.Info(fmt.Sprintf("Found %d invalid address -> name index entries. Deleting them now.", len(toDelete)))
+	logger.Info("Found invalid address -> name index entries. Deleting them now.", "count", len(toDelete))
 
 	for _, key := range toDelete {
 		store.Delete(key)
 	}
 
-	logger.Info(fmt.Sprintf("Done checking address -> name index entries. Deleted %d invalid entries and kept %d valid entries.", len(toDelete), keepCount))
+	logger.Info("Done checking address -> name index entries.", "deleted", len(toDelete), "kept", keepCount)
 }
 
+// CreateRootName binds a name (and any of its parents that don't exist yet) to the owner.
+// It returns ErrNameAlreadyBound if the name already exists.
 func (k Keeper) CreateRootName(ctx sdk.Context, name, owner string, restricted bool) error {
 	// err is suppressed because it returns an error on not found.  TODO - Remove use of error for not found
 	existing, _ := k.GetRecordByName(ctx, name)
@@ -397,11 +537,65 @@ func (k Keeper) CreateRootName(ctx sdk.Context, name, owner string, restricted b
 			if err = k.SetNameRecord(ctx, n, addr, restricted); err != nil {
 				return err
 			}
-			logger.Info(fmt.Sprintf("create root name proposal: created %s and set the owner as %s", n, owner))
+			logger.Info("create root name proposal: created name", "name", n, "owner", owner)
 		} else {
-			logger.Info(fmt.Sprintf("create root name proposal: intermediate domain %s exists, skipping", n))
+			logger.Info("create root name proposal: intermediate domain exists, skipping", "name", n)
+		}
+	}
+
+	return nil
+}
+
+// EnsureName makes sure that a name is bound to the owner with the provided restricted flag. Any of the name's
+// parents that don't exist yet are also bound to the owner; parents that already exist are left as they are.
+//
+// It is meant for use by other modules during their InitGenesis or an upgrade, to create the names that they
+// depend on. It can safely be called multiple times: nothing is changed if the name is already as needed.
+// If the name exists but is restricted differently, that is updated. An ErrNameBindingMismatch is returned
+// if the name is bound to a different address, so that one module can't take a name away from another account.
+func (k Keeper) EnsureName(ctx sdk.Context, name string, owner sdk.AccAddress, restricted bool) error {
+	var err error
+	if name, err = k.Normalize(ctx, name); err != nil {
+		return err
+	}
+	if err = types.ValidateAddress(owner); err != nil {
+		return types.ErrInvalidAddress.Wrap(err.Error())
+	}
+
+	logger := k.Logger(ctx)
+	segments := strings.Split(name, ".")
+	for i := len(segments) - 1; i > 0; i-- {
+		parent := strings.Join(segments[i:], ".")
+		if k.NameExists(ctx, parent) {
+			continue
 		}
+		if err = k.setNameRecord(ctx, parent, owner, restricted); err != nil {
+			return err
+		}
+		logger.Info("ensure name: created parent name", "name", parent, "owner", owner.String())
+	}
+
+	if !k.NameExists(ctx, name) {
+		if err = k.setNameRecord(ctx, name, owner, restricted); err != nil {
+			return err
+		}
+		logger.Info("ensure name: created name", "name", name, "owner", owner.String())
+		return nil
 	}
 
+	existing, err := k.GetRecordByName(ctx, name)
+	if err != nil {
+		return err
+	}
+	if existing.Address != owner.String() {
+		return types.ErrNameBindingMismatch.Wrapf("%q is bound to %s, expected %s", name, existing.Address, owner)
+	}
+	if existing.Restricted == restricted {
+		return nil
+	}
+	if err = k.UpdateNameRecord(ctx, name, owner, restricted, owner); err != nil {
+		return err
+	}
+	logger.Info("ensure name: updated name restriction", "name", name, "restricted", restricted)
 	return nil
 }
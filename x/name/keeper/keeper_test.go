@@ -13,11 +13,13 @@ import (
 	"sigs.k8s.io/yaml"
 
 	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
 	"github.com/provenance-io/provenance/app"
@@ -91,7 +93,8 @@ func (s *KeeperTestSuite) TestSetup() {
 		s.Require().Equal(uint32(16), p.MaxSegmentLength)
 	})
 
-	expOut := fmt.Sprintf(`bindings:
+	expOut := fmt.Sprintf(`address_rotation_approvals: []
+bindings:
 - address: %[1]s
   name: test.root
   restricted: false
@@ -106,9 +109,23 @@ func (s *KeeperTestSuite) TestSetup() {
   restricted: true
 params:
   allow_unrestricted_names: false
+  bind_name_fee: []
+  bind_name_fee_recipient: ""
+  contract_admin_cleared_name_policy: CONTRACT_NAME_POLICY_UNSPECIFIED
+  contract_migrated_name_policy: CONTRACT_NAME_POLICY_UNSPECIFIED
+  created_names_retention_blocks: 0
+  delete_delay_blocks: 0
+  max_deletions: 0
   max_name_levels: 16
+  max_query_response_bytes: "0"
+  max_query_results: 0
   max_segment_length: 16
+  max_uuid_segments: 0
   min_segment_length: 2
+  resolve_pending_deletions: false
+  restrict_new_names: false
+  root_name_params: []
+pending_deletions: []
 `,
 		s.user1Addr.String(), attrtypes.AccountDataName, authtypes.NewModuleAddress(attrtypes.ModuleName).String())
 
@@ -120,6 +137,98 @@ params:
 	s.Require().Equal(expOut, string(genYAML))
 }
 
+func (s *KeeperTestSuite) TestExportGenesisTo() {
+	var buf bytes.Buffer
+	err := s.app.NameKeeper.ExportGenesisTo(s.ctx, s.cdc, &buf)
+	s.Require().NoError(err, "ExportGenesisTo")
+
+	expJSON, err := s.cdc.MarshalJSON(s.app.NameKeeper.ExportGenesis(s.ctx))
+	s.Require().NoError(err, "MarshalJSON name genesis")
+	s.Assert().Equal(string(expJSON), buf.String(), "streamed genesis JSON")
+}
+
+func (s *KeeperTestSuite) TestInitGenesisOrder() {
+	bindings := nametypes.NameRecords{
+		nametypes.NewNameRecord("zeta", s.user1Addr, true),
+		nametypes.NewNameRecord("alpha.zeta", s.user2Addr, false),
+		nametypes.NewNameRecord("beta.zeta", s.user1Addr, false),
+		nametypes.NewNameRecord("gamma", s.user2Addr, false),
+	}
+	pendings := []nametypes.PendingNameDeletion{
+		nametypes.NewPendingNameDeletion("gamma", s.user2, 20),
+		nametypes.NewPendingNameDeletion("beta.zeta", s.user1, 10),
+		nametypes.NewPendingNameDeletion("alpha.zeta", s.user2, 10),
+	}
+	reversed := func(records nametypes.NameRecords) nametypes.NameRecords {
+		rv := make(nametypes.NameRecords, 0, len(records))
+		for i := len(records) - 1; i >= 0; i-- {
+			rv = append(rv, records[i])
+		}
+		return rv
+	}
+
+	exportAfterInit := func(genState nametypes.GenesisState) []byte {
+		ctx, _ := s.ctx.CacheContext()
+		s.Require().NotPanics(func() { s.app.NameKeeper.InitGenesis(ctx, genState) }, "InitGenesis")
+		var buf bytes.Buffer
+		s.Require().NoError(s.app.NameKeeper.ExportGenesisTo(ctx, s.cdc, &buf), "ExportGenesisTo")
+		return buf.Bytes()
+	}
+
+	approvals := []nametypes.AddressRotationApproval{
+		{OldAddress: s.user1, NewAddress: s.user2},
+		{OldAddress: s.user2, NewAddress: s.user1},
+	}
+
+	params := s.app.NameKeeper.GetParams(s.ctx)
+	genState1 := nametypes.GenesisState{Params: params, Bindings: bindings, PendingDeletions: pendings, AddressRotationApprovals: approvals}
+	genState2 := nametypes.GenesisState{
+		Params:                   params,
+		Bindings:                 reversed(bindings),
+		PendingDeletions:         []nametypes.PendingNameDeletion{pendings[2], pendings[0], pendings[1]},
+		AddressRotationApprovals: []nametypes.AddressRotationApproval{approvals[1], approvals[0]},
+	}
+	export1 := exportAfterInit(genState1)
+	export2 := exportAfterInit(genState2)
+	s.Assert().Equal(string(export1), string(export2), "exported genesis after importing the same bindings in a different order")
+
+	var exported nametypes.GenesisState
+	s.Require().NoError(s.cdc.UnmarshalJSON(export1, &exported), "UnmarshalJSON exported genesis")
+	sortedBindings, err := nametypes.NameRecords(exported.Bindings).SortByNameKey()
+	s.Require().NoError(err, "SortByNameKey")
+	s.Assert().Equal(sortedBindings, nametypes.NameRecords(exported.Bindings), "exported bindings")
+	sortedPendings, err := nametypes.SortPendingDeletions(exported.PendingDeletions)
+	s.Require().NoError(err, "SortPendingDeletions")
+	s.Assert().Equal(sortedPendings, exported.PendingDeletions, "exported pending deletions")
+	s.Assert().Equal([]int64{10, 10, 20}, []int64{
+		exported.PendingDeletions[0].DeleteHeight, exported.PendingDeletions[1].DeleteHeight, exported.PendingDeletions[2].DeleteHeight,
+	}, "exported pending deletion heights")
+	s.Assert().ElementsMatch(approvals, exported.AddressRotationApprovals, "exported address rotation approvals")
+
+	_, err = nametypes.NameRecords{{Name: "", Address: s.user1}}.SortByNameKey()
+	s.Assert().EqualError(err, `invalid name record "": name can not be empty: value provided for name is invalid`, "SortByNameKey with an empty name")
+}
+
+func (s *KeeperTestSuite) TestVerifyGenesisRoundTrip() {
+	s.Run("valid state", func() {
+		before := s.app.NameKeeper.ExportGenesis(s.ctx)
+		err := s.app.NameKeeper.VerifyGenesisRoundTrip(s.ctx, s.cdc)
+		s.Require().NoError(err, "VerifyGenesisRoundTrip")
+		s.Assert().Equal(before, s.app.NameKeeper.ExportGenesis(s.ctx), "genesis state after VerifyGenesisRoundTrip")
+	})
+
+	s.Run("record that is not normalized", func() {
+		ctx, _ := s.ctx.CacheContext()
+		key, err := nametypes.GetNameKeyPrefix("unnormalized.name")
+		s.Require().NoError(err, "GetNameKeyPrefix")
+		record := nametypes.NewNameRecord("Unnormalized.Name", s.user1Addr, false)
+		ctx.KVStore(s.app.GetKey(nametypes.StoreKey)).Set(key, s.cdc.MustMarshal(&record))
+
+		err = s.app.NameKeeper.VerifyGenesisRoundTrip(ctx, s.cdc)
+		s.Assert().ErrorContains(err, "after re-importing it", "VerifyGenesisRoundTrip")
+	})
+}
+
 func (s *KeeperTestSuite) TestNameNormalization() {
 	type args struct {
 		name string
@@ -174,6 +283,56 @@ func (s *KeeperTestSuite) TestNameNormalization() {
 	}
 }
 
+func (s *KeeperTestSuite) TestNameNormalizationMaxUUIDSegments() {
+	const id1 = "6443a1e8-ec9b-4ff1-b200-d639424bcba4"
+	const id2 = "91978ba2-5f35-459a-86a7-feca1b0512e0"
+	params := s.app.NameKeeper.GetParams(s.ctx)
+	orig := params.MaxUuidSegments
+	params.MaxUuidSegments = 1
+	s.app.NameKeeper.SetParams(s.ctx, params)
+	defer func() {
+		params.MaxUuidSegments = orig
+		s.app.NameKeeper.SetParams(s.ctx, params)
+	}()
+
+	got, err := s.app.NameKeeper.Normalize(s.ctx, strings.ToUpper(id1)+".service.pb")
+	s.Assert().NoError(err, "Normalize with one uuid segment")
+	s.Assert().Equal(id1+".service.pb", got, "Normalize with one uuid segment")
+
+	_, err = s.app.NameKeeper.Normalize(s.ctx, id1+"."+id2+".service.pb")
+	s.Assert().ErrorIs(err, nametypes.ErrNameHasTooManyUUIDSegments, "Normalize with two uuid segments")
+
+	err = s.app.NameKeeper.SetNameRecord(s.ctx, id2+"."+id1+".service.pb", s.user1Addr, false)
+	s.Assert().ErrorIs(err, nametypes.ErrNameHasTooManyUUIDSegments, "SetNameRecord with two uuid segments")
+}
+
+func (s *KeeperTestSuite) TestNameNormalizationGas() {
+	normalizeGas := func(name string) uint64 {
+		ctx := s.ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+		_, _ = s.app.NameKeeper.Normalize(ctx, name)
+		return ctx.GasMeter().GasConsumed()
+	}
+
+	s.Run("invalid name", func() {
+		name := "fail&normalize.pio"
+		s.Assert().Equal(nametypes.NormalizeNameGas(name), normalizeGas(name), "gas consumed by Normalize(%q)", name)
+	})
+
+	s.Run("longer name costs more", func() {
+		short, long := "pio", "test.normalize.pio"
+		expDiff := nametypes.NormalizeNameGas(long) - nametypes.NormalizeNameGas(short)
+		s.Assert().Equal(expDiff, normalizeGas(long)-normalizeGas(short), "gas difference between %q and %q", long, short)
+	})
+
+	s.Run("out of gas", func() {
+		ctx := s.ctx.WithGasMeter(storetypes.NewGasMeter(100))
+		testFunc := func() {
+			_, _ = s.app.NameKeeper.Normalize(ctx, strings.Repeat("a", 100))
+		}
+		s.Assert().PanicsWithValue(storetypes.ErrorOutOfGas{Descriptor: "name normalization"}, testFunc, "Normalize with a long name")
+	})
+}
+
 func (s *KeeperTestSuite) TestSetName() {
 	cases := map[string]struct {
 		recordName     string
@@ -226,6 +385,71 @@ func (s *KeeperTestSuite) TestSetName() {
 	}
 }
 
+func (s *KeeperTestSuite) TestBindNameRecord() {
+	s.Require().NoError(s.app.NameKeeper.SetNameRecord(s.ctx, "locked.name", s.user1Addr, true), "SetNameRecord(locked.name)")
+
+	tests := []struct {
+		name     string
+		bindName string
+		signer   sdk.AccAddress
+		expErr   string
+	}{
+		{
+			name:     "unrestricted parent",
+			bindName: "kid.example.name",
+			signer:   s.user2Addr,
+		},
+		{
+			name:     "restricted parent owned by signer",
+			bindName: "kid.locked.name",
+			signer:   s.user1Addr,
+		},
+		{
+			name:     "restricted parent not owned by signer",
+			bindName: "other.locked.name",
+			signer:   s.user2Addr,
+			expErr:   fmt.Sprintf(`"locked.name" does not resolve to %s: parent name is restricted`, s.user2),
+		},
+		{
+			name:     "parent not bound",
+			bindName: "kid.missing.name",
+			signer:   s.user1Addr,
+			expErr:   `"missing.name": parent name is not bound to an address`,
+		},
+		{
+			name:     "grandparent not bound",
+			bindName: "kid.test.root",
+			signer:   s.user1Addr,
+			expErr:   `"root": parent name is not bound to an address`,
+		},
+		{
+			name:     "root name",
+			bindName: "newroot",
+			signer:   s.user1Addr,
+			expErr:   `cannot bind root name "newroot": value provided for name is invalid`,
+		},
+		{
+			name:     "invalid name",
+			bindName: "fail!!.name",
+			signer:   s.user1Addr,
+			expErr:   "value provided for name is invalid",
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			err := s.app.NameKeeper.BindNameRecord(s.ctx, tc.bindName, s.user2Addr, false, tc.signer)
+			if len(tc.expErr) > 0 {
+				s.Require().EqualError(err, tc.expErr, "BindNameRecord")
+				s.Assert().False(s.app.NameKeeper.NameExists(s.ctx, tc.bindName), "NameExists(%q)", tc.bindName)
+				return
+			}
+			s.Require().NoError(err, "BindNameRecord")
+			s.Assert().True(s.app.NameKeeper.ResolvesTo(s.ctx, tc.bindName, s.user2Addr), "ResolvesTo(%q)", tc.bindName)
+		})
+	}
+}
+
 func (s *KeeperTestSuite) TestGetName() {
 	s.Run("get valid root name", func() {
 		r, err := s.app.NameKeeper.GetRecordByName(s.ctx, "name")
@@ -288,7 +512,7 @@ func (s *KeeperTestSuite) TestDeleteRecord() {
 func (s *KeeperTestSuite) TestModifyRecord() {
 	jackthecat := "jackthecat"
 	s.Run("update adds new name", func() {
-		err := s.app.NameKeeper.UpdateNameRecord(s.ctx, jackthecat, s.user2Addr, true)
+		err := s.app.NameKeeper.UpdateNameRecord(s.ctx, jackthecat, s.user2Addr, true, nil)
 		s.Require().NoError(err, "UpdateNameRecord(%q, user2)", jackthecat)
 		isUser2 := s.app.NameKeeper.ResolvesTo(s.ctx, jackthecat, s.user2Addr)
 		s.Assert().True(isUser2, "ResolvesTo(%q, user2)", jackthecat)
@@ -302,7 +526,7 @@ func (s *KeeperTestSuite) TestModifyRecord() {
 
 	})
 	s.Run("update to new owner", func() {
-		err := s.app.NameKeeper.UpdateNameRecord(s.ctx, jackthecat, s.user1Addr, true)
+		err := s.app.NameKeeper.UpdateNameRecord(s.ctx, jackthecat, s.user1Addr, true, nil)
 		s.Require().NoError(err, "UpdateNameRecord(%q, user1)", jackthecat)
 		isUser1 := s.app.NameKeeper.ResolvesTo(s.ctx, jackthecat, s.user1Addr)
 		s.Assert().True(isUser1, "ResolvesTo(%q, user1)", jackthecat)
@@ -325,12 +549,12 @@ func (s *KeeperTestSuite) TestModifyRecord() {
 		s.Assert().Equal(expUser2Recs, addr2Recs, "GetRecordsByAddress(user2)")
 	})
 	s.Run("update has invalid address", func() {
-		err := s.app.NameKeeper.UpdateNameRecord(s.ctx, "jackthecat", sdk.AccAddress{}, true)
+		err := s.app.NameKeeper.UpdateNameRecord(s.ctx, "jackthecat", sdk.AccAddress{}, true, nil)
 		s.Require().Error(err)
 		s.Require().Equal("addresses cannot be empty: unknown address: invalid account address", err.Error())
 	})
 	s.Run("update valid root name", func() {
-		err := s.app.NameKeeper.UpdateNameRecord(s.ctx, "name", s.user2Addr, true)
+		err := s.app.NameKeeper.UpdateNameRecord(s.ctx, "name", s.user2Addr, true, nil)
 		s.Require().NoError(err)
 		record, err := s.app.NameKeeper.GetRecordByName(s.ctx, "name")
 		s.Require().NoError(err)
@@ -339,7 +563,7 @@ func (s *KeeperTestSuite) TestModifyRecord() {
 		s.Require().Equal(true, record.Restricted)
 	})
 	s.Run("update valid root sub name", func() {
-		err := s.app.NameKeeper.UpdateNameRecord(s.ctx, "example.name", s.user2Addr, true)
+		err := s.app.NameKeeper.UpdateNameRecord(s.ctx, "example.name", s.user2Addr, true, nil)
 		s.Require().NoError(err)
 		record, err := s.app.NameKeeper.GetRecordByName(s.ctx, "example.name")
 		s.Require().NoError(err)
@@ -347,6 +571,598 @@ func (s *KeeperTestSuite) TestModifyRecord() {
 		s.Require().Equal(s.user2Addr.String(), record.GetAddress())
 		s.Require().Equal(true, record.Restricted)
 	})
+	s.Run("update with expected address not bound", func() {
+		err := s.app.NameKeeper.UpdateNameRecord(s.ctx, "notbound", s.user1Addr, true, s.user2Addr)
+		s.Require().EqualError(err, "\"notbound\": no address bound to name", "UpdateNameRecord error")
+		s.Assert().False(s.app.NameKeeper.NameExists(s.ctx, "notbound"), "NameExists(notbound)")
+	})
+	s.Run("update with wrong expected address", func() {
+		err := s.app.NameKeeper.UpdateNameRecord(s.ctx, "example.name", s.user1Addr, false, s.user1Addr)
+		expErr := fmt.Sprintf("%q is bound to %s, expected %s: name is not bound to the expected address", "example.name", s.user2Addr, s.user1Addr)
+		s.Require().EqualError(err, expErr, "UpdateNameRecord error")
+		record, err := s.app.NameKeeper.GetRecordByName(s.ctx, "example.name")
+		s.Require().NoError(err, "GetRecordByName(example.name)")
+		s.Assert().Equal(s.user2Addr.String(), record.GetAddress(), "example.name address")
+		s.Assert().True(record.Restricted, "example.name restricted")
+	})
+	s.Run("update with correct expected address", func() {
+		err := s.app.NameKeeper.UpdateNameRecord(s.ctx, "example.name", s.user1Addr, false, s.user2Addr)
+		s.Require().NoError(err, "UpdateNameRecord")
+		record, err := s.app.NameKeeper.GetRecordByName(s.ctx, "example.name")
+		s.Require().NoError(err, "GetRecordByName(example.name)")
+		s.Assert().Equal(s.user1Addr.String(), record.GetAddress(), "example.name address")
+		s.Assert().False(record.Restricted, "example.name restricted")
+	})
+}
+
+func (s *KeeperTestSuite) TestNameStats() {
+	nameStats := func() *nametypes.QueryNameStatsResponse {
+		resp, err := s.app.NameKeeper.NameStats(s.ctx, &nametypes.QueryNameStatsRequest{})
+		s.Require().NoError(err, "NameStats")
+		return resp
+	}
+
+	s.Run("from genesis", func() {
+		exp := &nametypes.QueryNameStatsResponse{
+			Total:        4,
+			Restricted:   1,
+			Unrestricted: 3,
+			Roots: []nametypes.RootNameCount{
+				{Root: attrtypes.AccountDataName, Count: 1},
+				{Root: "name", Count: 2},
+				{Root: "root", Count: 1},
+			},
+		}
+		s.Assert().Equal(exp, nameStats(), "NameStats")
+	})
+
+	s.Run("after bind", func() {
+		s.Require().NoError(s.app.NameKeeper.SetNameRecord(s.ctx, "new.name", s.user2Addr, true), "SetNameRecord(new.name)")
+		s.Require().NoError(s.app.NameKeeper.SetNameRecord(s.ctx, "zzz", s.user2Addr, false), "SetNameRecord(zzz)")
+		exp := &nametypes.QueryNameStatsResponse{
+			Total:        6,
+			Restricted:   2,
+			Unrestricted: 4,
+			Roots: []nametypes.RootNameCount{
+				{Root: attrtypes.AccountDataName, Count: 1},
+				{Root: "name", Count: 3},
+				{Root: "root", Count: 1},
+				{Root: "zzz", Count: 1},
+			},
+		}
+		s.Assert().Equal(exp, nameStats(), "NameStats")
+	})
+
+	s.Run("after failed bind", func() {
+		s.Require().Error(s.app.NameKeeper.SetNameRecord(s.ctx, "new.name", s.user1Addr, false), "SetNameRecord(new.name) again")
+		s.Assert().Equal(uint64(6), s.app.NameKeeper.GetNameCount(s.ctx), "GetNameCount")
+		s.Assert().Equal(uint64(2), s.app.NameKeeper.GetRestrictedNameCount(s.ctx), "GetRestrictedNameCount")
+	})
+
+	s.Run("after update", func() {
+		s.Require().NoError(s.app.NameKeeper.UpdateNameRecord(s.ctx, "new.name", s.user1Addr, false, nil), "UpdateNameRecord(new.name)")
+		s.Require().NoError(s.app.NameKeeper.UpdateNameRecord(s.ctx, "name", s.user1Addr, true, nil), "UpdateNameRecord(name)")
+		s.Assert().Equal(uint64(6), s.app.NameKeeper.GetNameCount(s.ctx), "GetNameCount")
+		s.Assert().Equal(uint64(2), s.app.NameKeeper.GetRestrictedNameCount(s.ctx), "GetRestrictedNameCount")
+		s.Assert().Equal(uint64(3), s.app.NameKeeper.GetRootNameCount(s.ctx, "name"), "GetRootNameCount(name)")
+	})
+
+	s.Run("after delete", func() {
+		s.Require().NoError(s.app.NameKeeper.DeleteRecord(s.ctx, "zzz"), "DeleteRecord(zzz)")
+		s.Require().NoError(s.app.NameKeeper.DeleteRecord(s.ctx, "name"), "DeleteRecord(name)")
+		exp := &nametypes.QueryNameStatsResponse{
+			Total:        4,
+			Restricted:   1,
+			Unrestricted: 3,
+			Roots: []nametypes.RootNameCount{
+				{Root: attrtypes.AccountDataName, Count: 1},
+				{Root: "name", Count: 2},
+				{Root: "root", Count: 1},
+			},
+		}
+		s.Assert().Equal(exp, nameStats(), "NameStats")
+		s.Assert().Equal(uint64(0), s.app.NameKeeper.GetRootNameCount(s.ctx, "zzz"), "GetRootNameCount(zzz)")
+	})
+
+	s.Run("rebuild", func() {
+		before := nameStats()
+		store := s.ctx.KVStore(s.app.GetKey(nametypes.StoreKey))
+		store.Set(nametypes.NameCountKey, sdk.Uint64ToBigEndian(99))
+		store.Set(nametypes.GetRootNameCountKey("bogus"), sdk.Uint64ToBigEndian(3))
+		store.Delete(nametypes.RestrictedNameCountKey)
+
+		migrator := namekeeper.NewMigrator(s.app.NameKeeper)
+		s.Require().NoError(migrator.Migrate2To3(s.ctx), "Migrate2To3")
+		s.Assert().Equal(before, nameStats(), "NameStats after Migrate2To3")
+	})
+}
+
+func (s *KeeperTestSuite) TestResolveMany() {
+	s.Run("no names", func() {
+		_, err := s.app.NameKeeper.ResolveMany(s.ctx, &nametypes.QueryResolveManyRequest{})
+		s.Assert().ErrorContains(err, "at least one name is required", "ResolveMany error")
+	})
+
+	s.Run("too many names", func() {
+		names := make([]string, nametypes.MaxResolveManyNames+1)
+		for i := range names {
+			names[i] = "name"
+		}
+		_, err := s.app.NameKeeper.ResolveMany(s.ctx, &nametypes.QueryResolveManyRequest{Names: names})
+		s.Assert().ErrorContains(err, "cannot resolve more than 100 names at once, got 101", "ResolveMany error")
+	})
+
+	s.Run("mixed results", func() {
+		req := &nametypes.QueryResolveManyRequest{Names: []string{"example.name", " Test.Root ", "unknown.name", "x", "name"}}
+		exp := &nametypes.QueryResolveManyResponse{
+			Results: []nametypes.ResolveResult{
+				{Name: "example.name", Address: s.user1},
+				{Name: " Test.Root ", Address: s.user1},
+				{Name: "unknown.name", Error: nametypes.ErrNameNotBound.Error()},
+				{Name: "x", Error: "segment of name is too short"},
+				{Name: "name", Address: s.user1},
+			},
+		}
+		resp, err := s.app.NameKeeper.ResolveMany(s.ctx, req)
+		s.Require().NoError(err, "ResolveMany error")
+		s.Assert().Equal(exp, resp, "ResolveMany response")
+	})
+}
+
+func (s *KeeperTestSuite) TestChildNames() {
+	childNames := func(name string) []string {
+		children, err := s.app.NameKeeper.GetChildNames(s.ctx, name)
+		s.Require().NoError(err, "GetChildNames(%q)", name)
+		return children
+	}
+
+	s.Require().NoError(s.app.NameKeeper.SetNameRecord(s.ctx, "kid.example.name", s.user2Addr, false), "SetNameRecord(kid.example.name)")
+	s.Require().NoError(s.app.NameKeeper.UpdateNameRecord(s.ctx, "other.example.name", s.user1Addr, false, nil), "UpdateNameRecord(other.example.name)")
+
+	s.Run("after bind", func() {
+		s.Assert().ElementsMatch([]string{"example.name"}, childNames("name"), "child names of name")
+		s.Assert().ElementsMatch([]string{"kid.example.name", "other.example.name"}, childNames("example.name"), "child names of example.name")
+		s.Assert().Empty(childNames("kid.example.name"), "child names of kid.example.name")
+	})
+
+	s.Run("after update", func() {
+		s.Require().NoError(s.app.NameKeeper.UpdateNameRecord(s.ctx, "kid.example.name", s.user1Addr, true, nil), "UpdateNameRecord(kid.example.name)")
+		s.Assert().ElementsMatch([]string{"kid.example.name", "other.example.name"}, childNames("example.name"), "child names of example.name")
+	})
+
+	s.Run("after delete", func() {
+		s.Require().NoError(s.app.NameKeeper.DeleteRecord(s.ctx, "other.example.name"), "DeleteRecord(other.example.name)")
+		s.Assert().ElementsMatch([]string{"kid.example.name"}, childNames("example.name"), "child names of example.name")
+	})
+
+	s.Run("rebuild", func() {
+		store := s.ctx.KVStore(s.app.GetKey(nametypes.StoreKey))
+		key, err := nametypes.GetChildNameKey("kid.example.name")
+		s.Require().NoError(err, "GetChildNameKey(kid.example.name)")
+		store.Delete(key)
+		s.Assert().Empty(childNames("example.name"), "child names of example.name after removing index entry")
+
+		params := s.app.NameKeeper.GetParams(s.ctx)
+		params.MaxDeletions = 0
+		s.app.NameKeeper.SetParams(s.ctx, params)
+
+		migrator := namekeeper.NewMigrator(s.app.NameKeeper)
+		s.Require().NoError(migrator.Migrate3To4(s.ctx), "Migrate3To4")
+		s.Assert().ElementsMatch([]string{"kid.example.name"}, childNames("example.name"), "child names of example.name after Migrate3To4")
+		s.Assert().ElementsMatch([]string{"example.name"}, childNames("name"), "child names of name after Migrate3To4")
+		s.Assert().Equal(nametypes.DefaultMaxDeletions, s.app.NameKeeper.GetParams(s.ctx).MaxDeletions, "MaxDeletions after Migrate3To4")
+	})
+}
+
+func (s *KeeperTestSuite) TestNamesByUUID() {
+	const id = "91978ba2-5f35-459a-86a7-feca1b0512e0"
+	const otherID = "2f1b4e8c-3d6a-4b7e-9c0f-1a2b3c4d5e6f"
+	namesByUUID := func(uuid string) []string {
+		names, err := s.app.NameKeeper.GetNamesByUUID(s.ctx, uuid)
+		s.Require().NoError(err, "GetNamesByUUID(%q)", uuid)
+		return names
+	}
+
+	s.Require().NoError(s.app.NameKeeper.SetNameRecord(s.ctx, id+".example.name", s.user1Addr, false), "SetNameRecord(id.example.name)")
+	s.Require().NoError(s.app.NameKeeper.SetNameRecord(s.ctx, "kid."+id+".example.name", s.user2Addr, false), "SetNameRecord(kid.id.example.name)")
+	s.Require().NoError(s.app.NameKeeper.SetNameRecord(s.ctx, id+".test.root", s.user2Addr, false), "SetNameRecord(id.test.root)")
+	s.Require().NoError(s.app.NameKeeper.SetNameRecord(s.ctx, otherID+".test.root", s.user2Addr, false), "SetNameRecord(otherID.test.root)")
+
+	s.Run("after bind", func() {
+		s.Assert().ElementsMatch([]string{id + ".example.name", "kid." + id + ".example.name", id + ".test.root"}, namesByUUID(id), "names with id")
+		s.Assert().ElementsMatch([]string{otherID + ".test.root"}, namesByUUID(otherID), "names with otherID")
+		s.Assert().ElementsMatch(namesByUUID(id), namesByUUID(strings.ToUpper(id)), "names with upper case id")
+		s.Assert().Empty(namesByUUID("00000000-0000-0000-0000-000000000000"), "names with unused uuid")
+	})
+
+	s.Run("not a uuid", func() {
+		_, err := s.app.NameKeeper.GetNamesByUUID(s.ctx, "example")
+		s.Assert().EqualError(err, `invalid uuid "example": invalid UUID length: 7`, "GetNamesByUUID error")
+		_, err = s.app.NameKeeper.NamesByUUID(s.ctx, &nametypes.QueryNamesByUUIDRequest{Uuid: "example"})
+		s.Assert().EqualError(err, `rpc error: code = InvalidArgument desc = invalid uuid "example": invalid UUID length: 7`, "NamesByUUID error")
+	})
+
+	s.Run("query with pagination", func() {
+		resp, err := s.app.NameKeeper.NamesByUUID(s.ctx, &nametypes.QueryNamesByUUIDRequest{Uuid: id, Pagination: &query.PageRequest{Limit: 2}})
+		s.Require().NoError(err, "NamesByUUID page 1")
+		s.Assert().Len(resp.Names, 2, "page 1 names")
+		s.Require().NotNil(resp.Pagination, "page 1 pagination")
+		s.Require().NotEmpty(resp.Pagination.NextKey, "page 1 next key")
+		names := resp.Names
+
+		resp, err = s.app.NameKeeper.NamesByUUID(s.ctx, &nametypes.QueryNamesByUUIDRequest{Uuid: id, Pagination: &query.PageRequest{Key: resp.Pagination.NextKey}})
+		s.Require().NoError(err, "NamesByUUID page 2")
+		s.Assert().Len(resp.Names, 1, "page 2 names")
+		s.Assert().ElementsMatch(namesByUUID(id), append(names, resp.Names...), "names from both pages")
+	})
+
+	s.Run("after delete", func() {
+		s.Require().NoError(s.app.NameKeeper.DeleteRecord(s.ctx, id+".test.root"), "DeleteRecord(id.test.root)")
+		s.Assert().ElementsMatch([]string{id + ".example.name", "kid." + id + ".example.name"}, namesByUUID(id), "names with id")
+	})
+
+	s.Run("rebuild", func() {
+		store := s.ctx.KVStore(s.app.GetKey(nametypes.StoreKey))
+		key, err := nametypes.GetUUIDNameKey(otherID, otherID+".test.root")
+		s.Require().NoError(err, "GetUUIDNameKey(otherID.test.root)")
+		store.Delete(key)
+		s.Assert().Empty(namesByUUID(otherID), "names with otherID after removing index entry")
+
+		migrator := namekeeper.NewMigrator(s.app.NameKeeper)
+		s.Require().NoError(migrator.Migrate4To5(s.ctx), "Migrate4To5")
+		s.Assert().ElementsMatch([]string{otherID + ".test.root"}, namesByUUID(otherID), "names with otherID after Migrate4To5")
+		s.Assert().ElementsMatch([]string{id + ".example.name", "kid." + id + ".example.name"}, namesByUUID(id), "names with id after Migrate4To5")
+	})
+}
+
+func (s *KeeperTestSuite) TestNamesCreated() {
+	params := s.app.NameKeeper.GetParams(s.ctx)
+	params.CreatedNamesRetentionBlocks = 10
+	s.app.NameKeeper.SetParams(s.ctx, params)
+
+	namesCreated := func(start, end int64) []nametypes.CreatedName {
+		resp, err := s.app.NameKeeper.NamesCreated(s.ctx, &nametypes.QueryNamesCreatedRequest{StartHeight: start, EndHeight: end})
+		s.Require().NoError(err, "NamesCreated(%d, %d)", start, end)
+		return resp.Names
+	}
+	bindAt := func(height int64, name string, addr sdk.AccAddress) {
+		s.Require().NoError(s.app.NameKeeper.SetNameRecord(s.ctx.WithBlockHeight(height), name, addr, false), "SetNameRecord(%q) at %d", name, height)
+	}
+
+	bindAt(5, "five.example.name", s.user1Addr)
+	bindAt(6, "six.example.name", s.user2Addr)
+	bindAt(6, "six.test.root", s.user1Addr)
+	bindAt(8, "eight.test.root", s.user2Addr)
+	five := nametypes.CreatedName{Name: "five.example.name", Address: s.user1, Height: 5}
+	sixA := nametypes.CreatedName{Name: "six.example.name", Address: s.user2, Height: 6}
+	sixB := nametypes.CreatedName{Name: "six.test.root", Address: s.user1, Height: 6}
+	eight := nametypes.CreatedName{Name: "eight.test.root", Address: s.user2, Height: 8}
+
+	s.Run("ranges", func() {
+		s.Assert().ElementsMatch([]nametypes.CreatedName{five, sixA, sixB, eight}, namesCreated(0, 0), "all heights")
+		s.Assert().ElementsMatch([]nametypes.CreatedName{sixA, sixB}, namesCreated(6, 6), "height 6")
+		s.Assert().ElementsMatch([]nametypes.CreatedName{sixA, sixB, eight}, namesCreated(6, 0), "height 6 and later")
+		s.Assert().ElementsMatch([]nametypes.CreatedName{five, sixA, sixB}, namesCreated(0, 7), "height 7 and earlier")
+		s.Assert().Empty(namesCreated(9, 20), "heights 9 to 20")
+	})
+
+	s.Run("invalid ranges", func() {
+		_, err := s.app.NameKeeper.NamesCreated(s.ctx, &nametypes.QueryNamesCreatedRequest{StartHeight: 7, EndHeight: 6})
+		s.Assert().EqualError(err, "rpc error: code = InvalidArgument desc = end height 6 cannot be less than start height 7", "NamesCreated(7, 6)")
+		_, err = s.app.NameKeeper.NamesCreated(s.ctx, &nametypes.QueryNamesCreatedRequest{StartHeight: -1})
+		s.Assert().EqualError(err, "rpc error: code = InvalidArgument desc = heights cannot be negative", "NamesCreated(-1, 0)")
+	})
+
+	s.Run("reverse pagination", func() {
+		req := &nametypes.QueryNamesCreatedRequest{Pagination: &query.PageRequest{Limit: 3, Reverse: true}}
+		resp, err := s.app.NameKeeper.NamesCreated(s.ctx, req)
+		s.Require().NoError(err, "NamesCreated page 1")
+		s.Require().Len(resp.Names, 3, "page 1 names")
+		s.Assert().Equal(eight, resp.Names[0], "newest name")
+		s.Require().NotNil(resp.Pagination, "page 1 pagination")
+		s.Require().NotEmpty(resp.Pagination.NextKey, "page 1 next key")
+
+		req.Pagination = &query.PageRequest{Key: resp.Pagination.NextKey, Reverse: true}
+		resp, err = s.app.NameKeeper.NamesCreated(s.ctx, req)
+		s.Require().NoError(err, "NamesCreated page 2")
+		s.Assert().Equal([]nametypes.CreatedName{five}, resp.Names, "page 2 names")
+	})
+
+	s.Run("created by update", func() {
+		ctx := s.ctx.WithBlockHeight(9)
+		s.Require().NoError(s.app.NameKeeper.UpdateNameRecord(ctx, "nine.test.root", s.user1Addr, false, nil), "UpdateNameRecord(nine.test.root)")
+		nine := nametypes.CreatedName{Name: "nine.test.root", Address: s.user1, Height: 9}
+		s.Assert().Equal([]nametypes.CreatedName{nine}, namesCreated(9, 9), "names created at 9")
+
+		// Updating an existing name doesn't change when it was created.
+		s.Require().NoError(s.app.NameKeeper.UpdateNameRecord(ctx.WithBlockHeight(10), "eight.test.root", s.user1Addr, false, nil), "UpdateNameRecord(eight.test.root)")
+		s.Assert().Empty(namesCreated(10, 10), "names created at 10")
+
+		s.Require().NoError(s.app.NameKeeper.DeleteRecord(s.ctx, "nine.test.root"), "DeleteRecord(nine.test.root)")
+		s.Require().NoError(s.app.NameKeeper.UpdateNameRecord(ctx.WithBlockHeight(10), "eight.test.root", s.user2Addr, false, nil), "UpdateNameRecord(eight.test.root) back")
+	})
+
+	s.Run("after delete", func() {
+		s.Require().NoError(s.app.NameKeeper.DeleteRecord(s.ctx, "six.test.root"), "DeleteRecord(six.test.root)")
+		s.Assert().Equal([]nametypes.CreatedName{five, sixA, eight}, namesCreated(0, 0), "names created")
+	})
+
+	s.Run("prune", func() {
+		s.app.NameKeeper.PruneNameCreationIndex(s.ctx.WithBlockHeight(15))
+		s.Assert().Equal([]nametypes.CreatedName{sixA, eight}, namesCreated(0, 0), "names created after pruning at 15")
+		s.app.NameKeeper.PruneNameCreationIndex(s.ctx.WithBlockHeight(16))
+		s.Assert().Equal([]nametypes.CreatedName{eight}, namesCreated(0, 0), "names created after pruning at 16")
+		s.Assert().True(s.app.NameKeeper.NameExists(s.ctx, "six.example.name"), "pruned name still exists")
+	})
+
+	s.Run("not indexed when disabled", func() {
+		params.CreatedNamesRetentionBlocks = 0
+		s.app.NameKeeper.SetParams(s.ctx, params)
+		bindAt(17, "seventeen.test.root", s.user1Addr)
+		s.Assert().Equal([]nametypes.CreatedName{eight}, namesCreated(0, 0), "names created after binding while disabled")
+		s.app.NameKeeper.PruneNameCreationIndex(s.ctx.WithBlockHeight(17))
+		s.Assert().Empty(namesCreated(0, 0), "names created after pruning while disabled")
+	})
+}
+
+func (s *KeeperTestSuite) TestZoneFile() {
+	s.Require().NoError(s.app.NameKeeper.SetNameRecord(s.ctx, "zone", s.user1Addr, true), "SetNameRecord(zone)")
+	s.Require().NoError(s.app.NameKeeper.SetNameRecord(s.ctx, "aa.zone", s.user2Addr, false), "SetNameRecord(aa.zone)")
+	s.Require().NoError(s.app.NameKeeper.SetNameRecord(s.ctx, "bb.zone", s.user1Addr, true), "SetNameRecord(bb.zone)")
+	s.Require().NoError(s.app.NameKeeper.SetNameRecord(s.ctx, "café.aa.zone", s.user2Addr, false), "SetNameRecord(café.aa.zone)")
+	ctx := s.ctx.WithBlockHeight(12)
+
+	record := func(owner string, addr sdk.AccAddress, restricted bool) string {
+		return fmt.Sprintf("%s\tIN\tTXT\t\"address=%s\" \"restricted=%t\"\n", owner, addr, restricted)
+	}
+	header := "; Provenance names under \"zone\" exported at block height 12.\n"
+
+	tests := []struct {
+		name     string
+		req      *nametypes.QueryZoneFileRequest
+		maxNames uint32
+		expResp  *nametypes.QueryZoneFileResponse
+		expErr   string
+	}{
+		{
+			name:   "nil request",
+			req:    nil,
+			expErr: "rpc error: code = InvalidArgument desc = empty request",
+		},
+		{
+			name:   "name not bound",
+			req:    &nametypes.QueryZoneFileRequest{Name: "cc.zone"},
+			expErr: nametypes.ErrNameNotBound.Error(),
+		},
+		{
+			name:   "invalid domain",
+			req:    &nametypes.QueryZoneFileRequest{Name: "zone", Domain: "bad..domain"},
+			expErr: `rpc error: code = InvalidArgument desc = invalid domain "bad..domain": empty label`,
+		},
+		{
+			name: "whole zone",
+			req:  &nametypes.QueryZoneFileRequest{Name: "zone"},
+			expResp: &nametypes.QueryZoneFileResponse{
+				ZoneFile: header + "$ORIGIN zone.\n$TTL 3600\n" +
+					record("@", s.user1Addr, true) +
+					record("aa", s.user2Addr, false) +
+					record("bb", s.user1Addr, true) +
+					record("caf\\195\\169.aa", s.user2Addr, false),
+				RecordCount: 4,
+			},
+		},
+		{
+			name: "sub-tree with domain and ttl",
+			req:  &nametypes.QueryZoneFileRequest{Name: "AA.zone", Domain: "names.example.com.", Ttl: 300},
+			expResp: &nametypes.QueryZoneFileResponse{
+				ZoneFile: "; Provenance names under \"aa.zone\" exported at block height 12.\n" +
+					"$ORIGIN aa.zone.names.example.com.\n$TTL 300\n" +
+					record("@", s.user2Addr, false) +
+					record("caf\\195\\169", s.user2Addr, false),
+				RecordCount: 2,
+			},
+		},
+		{
+			name:     "truncated",
+			req:      &nametypes.QueryZoneFileRequest{Name: "zone"},
+			maxNames: 2,
+			expResp: &nametypes.QueryZoneFileResponse{
+				ZoneFile: header + "; Truncated to the first 2 names.\n$ORIGIN zone.\n$TTL 3600\n" +
+					record("@", s.user1Addr, true) +
+					record("aa", s.user2Addr, false),
+				RecordCount: 2,
+				Truncated:   true,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			params := s.app.NameKeeper.GetParams(ctx)
+			orig := params.MaxQueryResults
+			params.MaxQueryResults = tc.maxNames
+			s.app.NameKeeper.SetParams(ctx, params)
+			defer func() {
+				params.MaxQueryResults = orig
+				s.app.NameKeeper.SetParams(ctx, params)
+			}()
+
+			resp, err := s.app.NameKeeper.ZoneFile(ctx, tc.req)
+			if len(tc.expErr) > 0 {
+				s.Assert().EqualError(err, tc.expErr, "ZoneFile error")
+			} else {
+				s.Assert().NoError(err, "ZoneFile error")
+			}
+			s.Assert().Equal(tc.expResp, resp, "ZoneFile response")
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestNormalizeQuery() {
+	queryServer := namekeeper.NewQueryServerImpl(s.app.NameKeeper)
+
+	tests := []struct {
+		name    string
+		req     *nametypes.QueryNormalizeRequest
+		expResp *nametypes.QueryNormalizeResponse
+		expErr  string
+	}{
+		{
+			name:   "nil request",
+			req:    nil,
+			expErr: "rpc error: code = InvalidArgument desc = empty request",
+		},
+		{
+			name:    "valid name",
+			req:     &nametypes.QueryNormalizeRequest{Name: " Example . PB "},
+			expResp: &nametypes.QueryNormalizeResponse{Normalized: "example.pb", Valid: true},
+		},
+		{
+			name:    "valid name that is not bound",
+			req:     &nametypes.QueryNormalizeRequest{Name: "new.name"},
+			expResp: &nametypes.QueryNormalizeResponse{Normalized: "new.name", Valid: true},
+		},
+		{
+			name: "several violations",
+			req:  &nametypes.QueryNormalizeRequest{Name: "X.Bad_Name.pb"},
+			expResp: &nametypes.QueryNormalizeResponse{
+				Normalized: "x.bad_name.pb",
+				Violations: []nametypes.NameViolation{
+					{
+						Type:    nametypes.NameViolationSegmentTooShort,
+						Segment: 0,
+						Message: `segment 0 "x" is too short: 1 < 2`,
+					},
+					{
+						Type:     nametypes.NameViolationInvalidCharacter,
+						Segment:  1,
+						Position: 5,
+						Message:  `illegal character "_" at position 5 in segment 1 "bad_name"`,
+					},
+				},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			resp, err := queryServer.Normalize(s.ctx, tc.req)
+			if len(tc.expErr) > 0 {
+				s.Assert().EqualError(err, tc.expErr, "Normalize error")
+			} else {
+				s.Assert().NoError(err, "Normalize error")
+			}
+			s.Assert().Equal(tc.expResp, resp, "Normalize response")
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestOwnershipChallenge() {
+	ctx := s.ctx.WithChainID("test-chain").WithBlockHeight(12)
+
+	s.Run("nil request", func() {
+		_, err := s.app.NameKeeper.OwnershipChallenge(ctx, nil)
+		s.Assert().EqualError(err, "rpc error: code = InvalidArgument desc = empty request", "OwnershipChallenge")
+	})
+	s.Run("nonce too long", func() {
+		req := &nametypes.QueryOwnershipChallengeRequest{Name: "example.name", Nonce: strings.Repeat("n", 257)}
+		_, err := s.app.NameKeeper.OwnershipChallenge(ctx, req)
+		s.Assert().EqualError(err, "rpc error: code = InvalidArgument desc = nonce length 257 exceeds maximum length of 256", "OwnershipChallenge")
+	})
+	s.Run("name not bound", func() {
+		_, err := s.app.NameKeeper.OwnershipChallenge(ctx, &nametypes.QueryOwnershipChallengeRequest{Name: "nope.name"})
+		s.Assert().ErrorIs(err, nametypes.ErrNameNotBound, "OwnershipChallenge")
+	})
+	s.Run("bound name", func() {
+		req := &nametypes.QueryOwnershipChallengeRequest{Name: " Example.Name ", Nonce: "abc"}
+		resp, err := s.app.NameKeeper.OwnershipChallenge(ctx, req)
+		s.Require().NoError(err, "OwnershipChallenge")
+		expChallenge := nametypes.NewNameOwnershipChallenge("example.name", s.user1, "test-chain", 12, "abc")
+		s.Assert().Equal(expChallenge, resp.Challenge, "challenge")
+		s.Assert().Equal(expChallenge.GetSignBytes(), resp.SignBytes, "sign bytes")
+	})
+}
+
+func (s *KeeperTestSuite) TestContractLifecycleHooks() {
+	contract := sdk.AccAddress("contract____________")
+	s.app.AccountKeeper.SetAccount(s.ctx, s.app.AccountKeeper.NewAccountWithAddress(s.ctx, contract))
+	hooks := s.app.NameKeeper.ContractLifecycleHooks()
+
+	setPolicies := func(migrated, adminCleared nametypes.ContractNamePolicy) {
+		params := s.app.NameKeeper.GetParams(s.ctx)
+		params.MaxDeletions = nametypes.DefaultMaxDeletions
+		params.ContractMigratedNamePolicy = migrated
+		params.ContractAdminClearedNamePolicy = adminCleared
+		s.app.NameKeeper.SetParams(s.ctx, params)
+	}
+	bindContractNames := func() {
+		s.Require().NoError(s.app.NameKeeper.SetNameRecord(s.ctx, "contract.name", contract, true), "SetNameRecord(contract.name)")
+		s.Require().NoError(s.app.NameKeeper.SetNameRecord(s.ctx, "sub.contract.name", contract, false), "SetNameRecord(sub.contract.name)")
+		s.Require().NoError(s.app.NameKeeper.SetNameRecord(s.ctx, "other.contract.name", s.user2Addr, false), "SetNameRecord(other.contract.name)")
+	}
+	contractNames := func() []string {
+		records, err := s.app.NameKeeper.GetRecordsByAddress(s.ctx, contract)
+		s.Require().NoError(err, "GetRecordsByAddress(contract)")
+		var rv []string
+		for _, record := range records {
+			rv = append(rv, record.Name)
+		}
+		return rv
+	}
+
+	s.Run("keep", func() {
+		bindContractNames()
+		setPolicies(nametypes.ContractNamePolicyKeep, nametypes.ContractNamePolicyKeep)
+		s.Require().NoError(hooks.AfterContractMigrated(s.ctx, contract, s.user1Addr), "AfterContractMigrated")
+		s.Require().NoError(hooks.AfterContractAdminCleared(s.ctx, contract, s.user1Addr), "AfterContractAdminCleared")
+		s.Assert().ElementsMatch([]string{"contract.name", "sub.contract.name"}, contractNames(), "contract names")
+	})
+
+	s.Run("reassign to admin on migrate", func() {
+		setPolicies(nametypes.ContractNamePolicyReassignToAdmin, nametypes.ContractNamePolicyKeep)
+		s.Require().NoError(hooks.AfterContractAdminCleared(s.ctx, contract, s.user1Addr), "AfterContractAdminCleared")
+		s.Assert().Len(contractNames(), 2, "contract names after AfterContractAdminCleared")
+
+		s.ctx = s.ctx.WithEventManager(sdk.NewEventManager())
+		s.Require().NoError(hooks.AfterContractMigrated(s.ctx, contract, s.user1Addr), "AfterContractMigrated")
+		s.Assert().Empty(contractNames(), "contract names")
+		record, err := s.app.NameKeeper.GetRecordByName(s.ctx, "contract.name")
+		s.Require().NoError(err, "GetRecordByName(contract.name)")
+		s.Assert().Equal(s.user1, record.Address, "contract.name address")
+		s.Assert().True(record.Restricted, "contract.name restricted")
+		record, err = s.app.NameKeeper.GetRecordByName(s.ctx, "other.contract.name")
+		s.Require().NoError(err, "GetRecordByName(other.contract.name)")
+		s.Assert().Equal(s.user2, record.Address, "other.contract.name address")
+
+		expEvent, err := sdk.TypedEventToEvent(nametypes.NewEventContractNamePolicyApplied(contract.String(), s.user1,
+			nametypes.ContractNamePolicyReassignToAdmin, []string{"contract.name", "sub.contract.name"}))
+		s.Require().NoError(err, "TypedEventToEvent")
+		s.Assert().Contains(s.ctx.EventManager().Events(), expEvent, "emitted events")
+
+		_, err = s.app.NameKeeper.DeleteNames(s.ctx, "contract.name", s.user1Addr, true)
+		s.Require().NoError(err, "DeleteNames(contract.name)")
+	})
+
+	s.Run("delete on admin cleared", func() {
+		bindContractNames()
+		setPolicies(nametypes.ContractNamePolicyKeep, nametypes.ContractNamePolicyDelete)
+		s.Require().NoError(hooks.AfterContractAdminCleared(s.ctx, contract, s.user1Addr), "AfterContractAdminCleared")
+		s.Assert().Empty(contractNames(), "contract names")
+		for _, name := range []string{"contract.name", "sub.contract.name", "other.contract.name"} {
+			s.Assert().False(s.app.NameKeeper.NameExists(s.ctx, name), "NameExists(%q)", name)
+		}
+		s.Assert().True(s.app.NameKeeper.NameExists(s.ctx, "name"), "NameExists(name)")
+	})
+
+	s.Run("delete too many", func() {
+		bindContractNames()
+		setPolicies(nametypes.ContractNamePolicyDelete, nametypes.ContractNamePolicyKeep)
+		params := s.app.NameKeeper.GetParams(s.ctx)
+		params.MaxDeletions = 2
+		s.app.NameKeeper.SetParams(s.ctx, params)
+		err := hooks.AfterContractMigrated(s.ctx, contract, s.user1Addr)
+		s.Assert().EqualError(err, `could not delete name "contract.name" of contract `+contract.String()+`: cannot delete more than 2 names at once`, "AfterContractMigrated")
+	})
 }
 
 func (s *KeeperTestSuite) TestGetAuthority() {
@@ -374,8 +1190,62 @@ func (s *KeeperTestSuite) TestIterateRecord() {
 		err := s.app.NameKeeper.IterateRecords(s.ctx, nametypes.NameKeyPrefix, appendToRecords)
 		s.Require().NoError(err, "IterateRecords error")
 		s.Require().Equal(expRecords, records, "records iterated over")
+
+		records = nametypes.NameRecords{}
+		err = s.app.NameKeeper.IterateRecordsReverse(s.ctx, nametypes.NameKeyPrefix, appendToRecords)
+		s.Require().NoError(err, "IterateRecordsReverse error")
+		for i, j := 0, len(expRecords)-1; i < j; i, j = i+1, j-1 {
+			expRecords[i], expRecords[j] = expRecords[j], expRecords[i]
+		}
+		s.Require().Equal(expRecords, records, "records iterated over in reverse")
+	})
+	s.Run("records are in name key order", func() {
+		var keys [][]byte
+		err := s.app.NameKeeper.IterateRecords(s.ctx, nametypes.NameKeyPrefix, func(record nametypes.NameRecord) error {
+			key, err := nametypes.GetNameKeyPrefix(record.Name)
+			keys = append(keys, key)
+			return err
+		})
+		s.Require().NoError(err, "IterateRecords error")
+		s.Require().NotEmpty(keys, "keys")
+		for i := 1; i < len(keys); i++ {
+			s.Assert().Equal(-1, bytes.Compare(keys[i-1], keys[i]), "key %d (%X) compared to key %d (%X)", i-1, keys[i-1], i, keys[i])
+		}
 	})
+}
 
+func (s *KeeperTestSuite) TestReverseLookupReversePagination() {
+	var expNames []string
+	err := s.app.NameKeeper.IterateRecords(s.ctx, nametypes.NameKeyPrefix, func(record nametypes.NameRecord) error {
+		if record.Address == s.user1 {
+			expNames = append(expNames, record.Name)
+		}
+		return nil
+	})
+	s.Require().NoError(err, "IterateRecords")
+	s.Require().Len(expNames, 3, "names bound to user1")
+
+	resp, err := s.app.NameKeeper.ReverseLookup(s.ctx, &nametypes.QueryReverseLookupRequest{Address: s.user1})
+	s.Require().NoError(err, "ReverseLookup forward")
+	forward := resp.Name
+	s.Assert().ElementsMatch(expNames, forward, "ReverseLookup forward names")
+
+	var reversed []string
+	var nextKey []byte
+	for i := 0; i < len(forward); i++ {
+		resp, err = s.app.NameKeeper.ReverseLookup(s.ctx, &nametypes.QueryReverseLookupRequest{
+			Address:    s.user1,
+			Pagination: &query.PageRequest{Key: nextKey, Limit: 1, Reverse: true},
+		})
+		s.Require().NoError(err, "ReverseLookup reverse page %d", i+1)
+		s.Require().Len(resp.Name, 1, "ReverseLookup reverse page %d names", i+1)
+		reversed = append(reversed, resp.Name...)
+		nextKey = resp.Pagination.NextKey
+	}
+	s.Assert().Empty(nextKey, "next key after last reverse page")
+	for i := range forward {
+		s.Assert().Equal(forward[len(forward)-1-i], reversed[i], "reversed name %d", i)
+	}
 }
 
 func (s *KeeperTestSuite) TestSecp256r1KeyAlgo() {
@@ -413,6 +1283,110 @@ func (s *KeeperTestSuite) TestCreateRootName() {
 	})
 }
 
+func (s *KeeperTestSuite) TestEnsureName() {
+	expRecord := func(name string, addr sdk.AccAddress, restricted bool) *nametypes.NameRecord {
+		rv := nametypes.NewNameRecord(name, addr, restricted)
+		return &rv
+	}
+
+	tests := []struct {
+		name       string
+		ensureName string
+		owner      sdk.AccAddress
+		restricted bool
+		expErr     string
+		expRecords []*nametypes.NameRecord
+	}{
+		{
+			name:       "new root name",
+			ensureName: "ensured",
+			owner:      s.user1Addr,
+			restricted: true,
+			expRecords: []*nametypes.NameRecord{expRecord("ensured", s.user1Addr, true)},
+		},
+		{
+			name:       "same root name again",
+			ensureName: "ensured",
+			owner:      s.user1Addr,
+			restricted: true,
+			expRecords: []*nametypes.NameRecord{expRecord("ensured", s.user1Addr, true)},
+		},
+		{
+			name:       "same root name with different case and spaces",
+			ensureName: " Ensured ",
+			owner:      s.user1Addr,
+			restricted: true,
+			expRecords: []*nametypes.NameRecord{expRecord("ensured", s.user1Addr, true)},
+		},
+		{
+			name:       "same root name but unrestricted",
+			ensureName: "ensured",
+			owner:      s.user1Addr,
+			restricted: false,
+			expRecords: []*nametypes.NameRecord{expRecord("ensured", s.user1Addr, false)},
+		},
+		{
+			name:       "same root name owned by someone else",
+			ensureName: "ensured",
+			owner:      s.user2Addr,
+			restricted: false,
+			expErr:     fmt.Sprintf("\"ensured\" is bound to %s, expected %s: name is not bound to the expected address", s.user1, s.user2),
+			expRecords: []*nametypes.NameRecord{expRecord("ensured", s.user1Addr, false)},
+		},
+		{
+			name:       "new name with new parents",
+			ensureName: "child.parent.grandparent",
+			owner:      s.user2Addr,
+			restricted: true,
+			expRecords: []*nametypes.NameRecord{
+				expRecord("grandparent", s.user2Addr, true),
+				expRecord("parent.grandparent", s.user2Addr, true),
+				expRecord("child.parent.grandparent", s.user2Addr, true),
+			},
+		},
+		{
+			name:       "new name with existing parent owned by someone else",
+			ensureName: "other.example.name",
+			owner:      s.user2Addr,
+			restricted: false,
+			expRecords: []*nametypes.NameRecord{
+				expRecord("name", s.user1Addr, false),
+				expRecord("example.name", s.user1Addr, false),
+				expRecord("other.example.name", s.user2Addr, false),
+			},
+		},
+		{
+			name:       "invalid name",
+			ensureName: "x",
+			owner:      s.user1Addr,
+			expErr:     "segment of name is too short",
+		},
+		{
+			name:       "no owner",
+			ensureName: "ownerless",
+			owner:      nil,
+			expErr:     "addresses cannot be empty: unknown address: invalid account address",
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			err := s.app.NameKeeper.EnsureName(s.ctx, tc.ensureName, tc.owner, tc.restricted)
+			if len(tc.expErr) > 0 {
+				s.Assert().EqualError(err, tc.expErr, "EnsureName(%q) error", tc.ensureName)
+			} else {
+				s.Assert().NoError(err, "EnsureName(%q) error", tc.ensureName)
+			}
+			for _, exp := range tc.expRecords {
+				record, err := s.app.NameKeeper.GetRecordByName(s.ctx, exp.Name)
+				if s.Assert().NoError(err, "GetRecordByName(%q)", exp.Name) {
+					s.Assert().Equal(exp, record, "GetRecordByName(%q) result", exp.Name)
+				}
+			}
+		})
+	}
+}
+
 func TestDeleteInvalidAddressIndexEntries(t *testing.T) {
 	// Not using the suite here because:
 	// a) this is only going to be around for a couple versions.
@@ -421,10 +1395,20 @@ func TestDeleteInvalidAddressIndexEntries(t *testing.T) {
 	provApp := app.Setup(t)
 	ctx := provApp.NewContext(false)
 
-	getRecordNames := func(records nametypes.NameRecords) []string {
-		rv := make([]string, len(records))
-		for i, record := range records {
-			rv[i] = record.Name
+	// GetRecordsByAddress ignores index entries for names bound to other addresses,
+	// so the names are read from the index entries directly.
+	store := ctx.KVStore(provApp.GetKey(nametypes.StoreKey))
+	getIndexedNames := func(addr sdk.AccAddress) []string {
+		addrPrefix, err := nametypes.GetAddressKeyPrefix(addr)
+		require.NoError(t, err, "GetAddressKeyPrefix(%s)", addr)
+		var rv []string
+		iter := storetypes.KVStorePrefixIterator(store, addrPrefix)
+		defer iter.Close()
+		for ; iter.Valid(); iter.Next() {
+			var record nametypes.NameRecord
+			bz := store.Get(iter.Key()[len(addrPrefix):])
+			require.NoError(t, provApp.AppCodec().Unmarshal(bz, &record), "Unmarshal name record for index entry %X", iter.Key())
+			rv = append(rv, record.Name)
 		}
 		return rv
 	}
@@ -485,8 +1469,8 @@ func TestDeleteInvalidAddressIndexEntries(t *testing.T) {
 			name: "first run - deletes one",
 			expLog: []string{
 				"Checking address -> name index entries.",
-				"Found 1 invalid address -> name index entries. Deleting them now.",
-				fmt.Sprintf("Done checking address -> name index entries. Deleted 1 invalid entries and kept %d valid entries.", len(expNameRecords)),
+				"Found invalid address -> name index entries. Deleting them now. count=1",
+				fmt.Sprintf("Done checking address -> name index entries. deleted=1 kept=%d", len(expNameRecords)),
 			},
 			expAddr1Names: addr1ExpNames,
 			expAddr2Names: addr2ExpNames,
@@ -497,7 +1481,7 @@ func TestDeleteInvalidAddressIndexEntries(t *testing.T) {
 			name: "second run - all ok already",
 			expLog: []string{
 				"Checking address -> name index entries.",
-				fmt.Sprintf("Done checking address -> name index entries. All %d entries are valid", len(expNameRecords)),
+				fmt.Sprintf("Done checking address -> name index entries. All entries are valid. count=%d", len(expNameRecords)),
 			},
 			expAddr1Names: addr1ExpNames,
 			expAddr2Names: addr2ExpNames,
@@ -557,16 +1541,12 @@ func TestDeleteInvalidAddressIndexEntries(t *testing.T) {
 			require.NoError(t, err, "IterateRecords by name")
 			assert.ElementsMatch(t, expNameRecords, allRecords, "name records: expected (A) vs actual (B)")
 
-			// Get all the addr1 name records and make sure they're as expected.
-			addr1ActRecords, err := provApp.NameKeeper.GetRecordsByAddress(ctx, addr1)
-			require.NoError(t, err, "GetRecordsByAddress(addr1)")
-			addr1ActNames := getRecordNames(addr1ActRecords)
+			// Get all the names indexed for addr1 and make sure they're as expected.
+			addr1ActNames := getIndexedNames(addr1)
 			require.ElementsMatch(t, tc.expAddr1Names, addr1ActNames, "addr1 names: expected (A) vs actual (B)")
 
-			// Get all the addr2 name records and make sure they're as expected.
-			addr2ActRecords, err := provApp.NameKeeper.GetRecordsByAddress(ctx, addr2)
-			require.NoError(t, err, "GetRecordsByAddress(addr2)")
-			addr2ActNames := getRecordNames(addr2ActRecords)
+			// Get all the names indexed for addr2 and make sure they're as expected.
+			addr2ActNames := getIndexedNames(addr2)
 			require.ElementsMatch(t, tc.expAddr2Names, addr2ActNames, "addr2 names: expected (A) vs actual (B)")
 		})
 	}
@@ -657,3 +1637,58 @@ func (s *KeeperTestSuite) TestCreateRootNameProposals() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestReverseLookupQueryLimits() {
+	origParams := s.app.NameKeeper.GetParams(s.ctx)
+	defer s.app.NameKeeper.SetParams(s.ctx, origParams)
+
+	setLimits := func(maxResults uint32, maxBytes uint64) {
+		params := origParams
+		params.MaxQueryResults = maxResults
+		params.MaxQueryResponseBytes = maxBytes
+		s.app.NameKeeper.SetParams(s.ctx, params)
+	}
+
+	s.Run("no limits", func() {
+		setLimits(0, 0)
+		resp, err := s.app.NameKeeper.ReverseLookup(s.ctx, &nametypes.QueryReverseLookupRequest{Address: s.user1})
+		s.Require().NoError(err, "ReverseLookup")
+		s.Assert().Len(resp.Name, 3, "names")
+		s.Assert().False(resp.Truncated, "truncated")
+	})
+
+	s.Run("results limited, no page request", func() {
+		setLimits(2, 0)
+		resp, err := s.app.NameKeeper.ReverseLookup(s.ctx, &nametypes.QueryReverseLookupRequest{Address: s.user1})
+		s.Require().NoError(err, "ReverseLookup")
+		s.Assert().Len(resp.Name, 2, "names")
+		s.Assert().True(resp.Truncated, "truncated")
+		s.Require().NotNil(resp.Pagination, "pagination")
+		s.Assert().NotEmpty(resp.Pagination.NextKey, "next key")
+
+		resp, err = s.app.NameKeeper.ReverseLookup(s.ctx, &nametypes.QueryReverseLookupRequest{
+			Address:    s.user1,
+			Pagination: &query.PageRequest{Key: resp.Pagination.NextKey},
+		})
+		s.Require().NoError(err, "ReverseLookup page 2")
+		s.Assert().Len(resp.Name, 1, "page 2 names")
+		s.Assert().True(resp.Truncated, "page 2 truncated")
+	})
+
+	s.Run("results limited, page limit within max", func() {
+		setLimits(2, 0)
+		resp, err := s.app.NameKeeper.ReverseLookup(s.ctx, &nametypes.QueryReverseLookupRequest{
+			Address:    s.user1,
+			Pagination: &query.PageRequest{Limit: 2},
+		})
+		s.Require().NoError(err, "ReverseLookup")
+		s.Assert().Len(resp.Name, 2, "names")
+		s.Assert().False(resp.Truncated, "truncated")
+	})
+
+	s.Run("response too large", func() {
+		setLimits(0, 10)
+		_, err := s.app.NameKeeper.ReverseLookup(s.ctx, &nametypes.QueryReverseLookupRequest{Address: s.user1})
+		s.Assert().ErrorContains(err, "exceeds the maximum of 10 bytes", "ReverseLookup")
+	})
+}
@@ -0,0 +1,18 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Migrate2To3 will update the name store from version 2 to version 3.
+// It populates the name counts from the name records that are already in state.
+func (m Migrator) Migrate2To3(ctx sdk.Context) error {
+	logger := m.keeper.Logger(ctx)
+	logger.Info("Starting migration of x/name from 2 to 3.")
+	if err := m.keeper.RebuildNameStats(ctx); err != nil {
+		logger.Error("Error building name stats.", "error", err)
+		return err
+	}
+	logger.Info("Done migrating x/name from 2 to 3.", "names", m.keeper.GetNameCount(ctx))
+	return nil
+}
@@ -0,0 +1,25 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/name/types"
+)
+
+// Migrate3To4 will update the name store from version 3 to version 4.
+// It sets the new max deletions param and populates the child name index from the name records that are already in state.
+func (m Migrator) Migrate3To4(ctx sdk.Context) error {
+	logger := m.keeper.Logger(ctx)
+	logger.Info("Starting migration of x/name from 3 to 4.")
+	params := m.keeper.GetParams(ctx)
+	if params.MaxDeletions == 0 {
+		params.MaxDeletions = types.DefaultMaxDeletions
+		m.keeper.SetParams(ctx, params)
+	}
+	if err := m.keeper.RebuildChildNameIndex(ctx); err != nil {
+		logger.Error("Error building child name index.", "error", err)
+		return err
+	}
+	logger.Info("Done migrating x/name from 3 to 4.")
+	return nil
+}
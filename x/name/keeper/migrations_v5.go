@@ -0,0 +1,18 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Migrate4To5 will update the name store from version 4 to version 5.
+// It populates the uuid name index from the name records that are already in state.
+func (m Migrator) Migrate4To5(ctx sdk.Context) error {
+	logger := m.keeper.Logger(ctx)
+	logger.Info("Starting migration of x/name from 4 to 5.")
+	if err := m.keeper.RebuildUUIDNameIndex(ctx); err != nil {
+		logger.Error("Error building uuid name index.", "error", err)
+		return err
+	}
+	logger.Info("Done migrating x/name from 4 to 5.")
+	return nil
+}
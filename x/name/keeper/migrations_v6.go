@@ -0,0 +1,18 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Migrate5To6 will update the name store from version 5 to version 6.
+// It rebuilds the name counts so that the number of names under each parent name is populated.
+func (m Migrator) Migrate5To6(ctx sdk.Context) error {
+	logger := m.keeper.Logger(ctx)
+	logger.Info("Starting migration of x/name from 5 to 6.")
+	if err := m.keeper.RebuildNameStats(ctx); err != nil {
+		logger.Error("Error rebuilding name stats.", "error", err)
+		return err
+	}
+	logger.Info("Done migrating x/name from 5 to 6.")
+	return nil
+}
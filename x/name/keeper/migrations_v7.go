@@ -0,0 +1,97 @@
+package keeper
+
+import (
+	"bytes"
+	"fmt"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/name/types"
+)
+
+// addressIndexMigrationBatchSize is the number of address index entries processed between progress reports.
+const addressIndexMigrationBatchSize = 10_000
+
+// Migrate6To7 will update the name store from version 6 to version 7.
+// The address index entries used to hold a copy of their name record. They're rewritten to have empty values since
+// the key of the name record is already at the end of each index key. Entries that don't match their name record are
+// deleted. Once done, the address index is verified against the name records.
+func (m Migrator) Migrate6To7(ctx sdk.Context) error {
+	logger := m.keeper.Logger(ctx)
+	logger.Info("Starting migration of x/name from 6 to 7.")
+	if err := m.keeper.migrateAddressIndex(ctx, addressIndexMigrationBatchSize); err != nil {
+		logger.Error("Error migrating address index.", "error", err)
+		return err
+	}
+	if msg, broken := AddressIndexInvariant(m.keeper)(ctx); broken {
+		logger.Error("Address index is invalid after migration.", "invariant", msg)
+		return fmt.Errorf("address index is invalid after migration: %s", msg)
+	}
+	logger.Info("Done migrating x/name from 6 to 7.")
+	return nil
+}
+
+// migrateAddressIndex removes the name record copies from the address index entries, and deletes the entries that don't
+// match their name records. The entries are processed in batches, and the progress is logged after each batch.
+func (k Keeper) migrateAddressIndex(ctx sdk.Context, batchSize int) error {
+	logger := k.Logger(ctx)
+	store := ctx.KVStore(k.storeKey)
+	end := storetypes.PrefixEndBytes(types.AddressKeyPrefix)
+	start := types.AddressKeyPrefix
+	var rewritten, deleted, unchanged int
+	for batch := 1; ; batch++ {
+		// The keys are collected before changing anything so that the store isn't written to while iterating it.
+		var keys [][]byte
+		var hasValue []bool
+		iter := store.Iterator(start, end)
+		for ; iter.Valid() && len(keys) < batchSize; iter.Next() {
+			keys = append(keys, bytes.Clone(iter.Key()))
+			hasValue = append(hasValue, len(iter.Value()) > 0)
+		}
+		iter.Close()
+
+		for i, key := range keys {
+			keep, err := k.isValidAddressIndexEntry(store, key)
+			if err != nil {
+				return err
+			}
+			switch {
+			case !keep:
+				store.Delete(key)
+				deleted++
+			case hasValue[i]:
+				store.Set(key, []byte{})
+				rewritten++
+			default:
+				unchanged++
+			}
+		}
+
+		logger.Info("Migrated address -> name index entries.", "batch", batch,
+			"rewritten", rewritten, "deleted", deleted, "unchanged", unchanged)
+		if len(keys) < batchSize {
+			return nil
+		}
+		// Start the next batch just after the last key of this one.
+		start = append(keys[len(keys)-1], 0x00)
+	}
+}
+
+// isValidAddressIndexEntry returns true if the address index key is for a bound name that's bound to the key's address.
+func (k Keeper) isValidAddressIndexEntry(store storetypes.KVStore, key []byte) (bool, error) {
+	addr, nameKey, err := types.ParseAddressKey(key)
+	if err != nil {
+		return false, nil
+	}
+	bz := store.Get(nameKey)
+	if bz == nil {
+		return false, nil
+	}
+	var record types.NameRecord
+	if err = k.cdc.Unmarshal(bz, &record); err != nil {
+		return false, fmt.Errorf("could not read name record for address index entry %X: %w", key, err)
+	}
+	return record.Address == addr.String(), nil
+}
@@ -0,0 +1,107 @@
+package keeper_test
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/app"
+	"github.com/provenance-io/provenance/x/name/keeper"
+	nametypes "github.com/provenance-io/provenance/x/name/types"
+)
+
+func TestMigrate6To7(t *testing.T) {
+	provApp := app.Setup(t)
+	ctx := provApp.NewContext(false)
+	store := ctx.KVStore(provApp.GetKey(nametypes.StoreKey))
+
+	addr1 := sdk.AccAddress("addr1_______________")
+	addr2 := sdk.AccAddress("addr2_______________")
+
+	// "two" is bound to addr1 and then to addr2 using the private addRecord, which leaves a stale addr1 index entry.
+	for _, name := range []string{"one", "sub.one", "two"} {
+		require.NoError(t, provApp.NameKeeper.AddRecord(ctx, name, addr1, false, true), "addRecord(%q, addr1)", name)
+	}
+	for _, name := range []string{"two", "sub.two"} {
+		require.NoError(t, provApp.NameKeeper.AddRecord(ctx, name, addr2, false, true), "addRecord(%q, addr2)", name)
+	}
+	// An index entry for a name that isn't bound.
+	addr2Prefix, err := nametypes.GetAddressKeyPrefix(addr2)
+	require.NoError(t, err, "GetAddressKeyPrefix(addr2)")
+	unboundKey, err := nametypes.GetNameKeyPrefix("unbound")
+	require.NoError(t, err, "GetNameKeyPrefix(unbound)")
+	store.Set(append(addr2Prefix, unboundKey...), []byte{})
+
+	// Put copies of the name records back into the index entries, like they used to be stored.
+	var indexKeys [][]byte
+	iter := storetypes.KVStorePrefixIterator(store, nametypes.AddressKeyPrefix)
+	for ; iter.Valid(); iter.Next() {
+		indexKeys = append(indexKeys, bytes.Clone(iter.Key()))
+	}
+	iter.Close()
+	for _, key := range indexKeys {
+		_, nameKey, err := nametypes.ParseAddressKey(key)
+		require.NoError(t, err, "ParseAddressKey(%X)", key)
+		if bz := store.Get(nameKey); bz != nil {
+			store.Set(key, bz)
+		}
+	}
+
+	_, broken := keeper.AddressIndexInvariant(provApp.NameKeeper)(ctx)
+	require.True(t, broken, "address index invariant broken before migration")
+
+	var logBuffer bytes.Buffer
+	lw := zerolog.ConsoleWriter{Out: &logBuffer, NoColor: true, PartsExclude: []string{"time"}}
+	mctx := ctx.WithLogger(log.NewCustomLogger(zerolog.New(lw).Level(zerolog.InfoLevel)))
+
+	t.Run("in small batches", func(t *testing.T) {
+		cctx, _ := mctx.CacheContext()
+		require.NoError(t, provApp.NameKeeper.MigrateAddressIndex(cctx, 2), "MigrateAddressIndex")
+		msg, broken := keeper.AddressIndexInvariant(provApp.NameKeeper)(cctx)
+		assert.False(t, broken, "address index invariant broken after migration:\n%s", msg)
+
+		logLines := strings.Split(strings.TrimSpace(logBuffer.String()), "\n")
+		logBuffer.Reset()
+		// There's a progress line for each batch, plus one for the last partial (or empty) batch.
+		expLast := fmt.Sprintf("INF Migrated address -> name index entries. batch=%d deleted=2 module=x/name rewritten=%d unchanged=0",
+			len(indexKeys)/2+1, len(indexKeys)-2)
+		require.Len(t, logLines, len(indexKeys)/2+1, "logged lines:\n%s", strings.Join(logLines, "\n"))
+		assert.Equal(t, expLast, logLines[len(logLines)-1], "last log line")
+	})
+
+	require.NoError(t, keeper.NewMigrator(provApp.NameKeeper).Migrate6To7(mctx), "Migrate6To7")
+	msg, broken := keeper.AddressIndexInvariant(provApp.NameKeeper)(ctx)
+	assert.False(t, broken, "address index invariant broken after migration:\n%s", msg)
+
+	iter = storetypes.KVStorePrefixIterator(store, nametypes.AddressKeyPrefix)
+	for ; iter.Valid(); iter.Next() {
+		assert.Empty(t, iter.Value(), "value of address index entry %X", iter.Key())
+	}
+	iter.Close()
+
+	getNames := func(addr sdk.AccAddress) []string {
+		records, err := provApp.NameKeeper.GetRecordsByAddress(ctx, addr)
+		require.NoError(t, err, "GetRecordsByAddress(%s)", string(addr))
+		var rv []string
+		for _, record := range records {
+			rv = append(rv, record.Name)
+		}
+		return rv
+	}
+	assert.ElementsMatch(t, []string{"one", "sub.one"}, getNames(addr1), "addr1 names")
+	assert.ElementsMatch(t, []string{"two", "sub.two"}, getNames(addr2), "addr2 names")
+
+	resp, err := provApp.NameKeeper.ReverseLookup(ctx, &nametypes.QueryReverseLookupRequest{Address: addr2.String()})
+	require.NoError(t, err, "ReverseLookup(addr2)")
+	assert.ElementsMatch(t, []string{"two", "sub.two"}, resp.Name, "ReverseLookup(addr2) names")
+}
@@ -28,51 +28,87 @@ func NewMsgServerImpl(keeper Keeper) types.MsgServer {
 
 var _ types.MsgServer = msgServer{}
 
+// invalidRequest returns the error unchanged if it is one of this module's registered errors so that its code is kept.
+// Any other error is wrapped as an invalid request.
+func invalidRequest(err error) error {
+	if codespace, _, _ := errors.ABCIInfo(err, false); codespace == types.ModuleName {
+		return err
+	}
+	return sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+}
+
 // BindName binds a name to an address
 func (s msgServer) BindName(goCtx context.Context, msg *types.MsgBindNameRequest) (*types.MsgBindNameResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	// Validate
 	if err := msg.ValidateBasic(); err != nil {
-		ctx.Logger().Error("unable to validate message", "err", err)
-		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+		s.Logger(ctx).Error("unable to validate message", "err", err)
+		return nil, invalidRequest(err)
 	}
 	// Fetch the parent name record from the keeper.
 	record, err := s.Keeper.GetRecordByName(ctx, msg.Parent.Name)
 	if err != nil {
-		ctx.Logger().Error("unable to find parent name record", "err", err)
-		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+		s.Logger(ctx).Error("unable to find parent name record", "name", msg.Parent.Name, "err", err)
+		return nil, invalidRequest(err)
 	}
-	// Ensure that if the parent name is restricted, it resolves to the given parent address (message signer).
+	// Ensure that if the parent name is restricted, it resolves to the given parent address (message signer),
+	// or that the owner of the parent name has approved the binding.
+	needsApproval := false
 	if record.Restricted {
 		parentAddress, addrErr := sdk.AccAddressFromBech32(msg.Parent.Address)
 		if addrErr != nil {
-			ctx.Logger().Error("unable to parse parent address", "err", addrErr)
+			s.Logger(ctx).Error("unable to parse parent address", "name", msg.Parent.Name, "err", addrErr)
 			return nil, sdkerrors.ErrInvalidRequest.Wrap(addrErr.Error())
 		}
 		if !s.Keeper.ResolvesTo(ctx, msg.Parent.Name, parentAddress) {
-			return nil, sdkerrors.ErrInvalidRequest.Wrapf("parent name %q is restricted and does not resolve to the provided parent address", record.Name)
+			if msg.ParentApproval == nil {
+				return nil, sdkerrors.ErrInvalidRequest.Wrapf("parent name %q is restricted and does not resolve to the provided parent address", record.Name)
+			}
+			needsApproval = true
 		}
 	}
 	// Combine names, normalize, and check for existing record
 	n := fmt.Sprintf("%s.%s", msg.Record.Name, msg.Parent.Name)
 	name, err := s.Keeper.Normalize(ctx, n)
 	if err != nil {
-		ctx.Logger().Error("invalid name", "name", name)
-		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+		s.Logger(ctx).Error("invalid name", "name", n, "err", err)
+		return nil, invalidRequest(err)
+	}
+	// The approval is for the full name, so it can only be checked once the name is known.
+	if needsApproval {
+		if err = s.Keeper.VerifyParentApproval(ctx, *record, name, sdk.MustAccAddressFromBech32(msg.Parent.Address), msg.ParentApproval); err != nil {
+			s.Logger(ctx).Error("invalid parent approval", "name", name, "err", err)
+			return nil, sdkerrors.ErrUnauthorized.Wrapf("parent name %q is restricted: %s", record.Name, err)
+		}
 	}
 	if s.Keeper.NameExists(ctx, name) {
-		ctx.Logger().Error("name already bound", "name", name)
-		return nil, sdkerrors.ErrInvalidRequest.Wrap(types.ErrNameAlreadyBound.Error())
+		s.Logger(ctx).Error("name already bound", "name", name)
+		return nil, types.ErrNameAlreadyBound.Wrapf("%q", name)
 	}
 	// Bind name to address
 	address, err := sdk.AccAddressFromBech32(msg.Record.Address)
 	if err != nil {
-		ctx.Logger().Error("invalid address", "err", err)
-		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+		s.Logger(ctx).Error("invalid address", "name", name, "err", err)
+		return nil, invalidRequest(err)
+	}
+	signer, err := sdk.AccAddressFromBech32(msg.Parent.Address)
+	if err != nil {
+		s.Logger(ctx).Error("unable to parse parent address", "name", name, "err", err)
+		return nil, invalidRequest(err)
+	}
+	// An approved binding is made with the parent owner's authority; the fee is still paid by the requestor.
+	authority := signer
+	if needsApproval {
+		authority = sdk.MustAccAddressFromBech32(record.Address)
+	}
+	bindingParams := s.Keeper.GetBindingParams(ctx, name)
+	if err := s.Keeper.BindNameRecord(ctx, name, address, msg.Record.Restricted || bindingParams.RestrictNewNames, authority); err != nil {
+		s.Logger(ctx).Error("unable to bind name", "name", name, "err", err)
+		return nil, invalidRequest(err)
 	}
-	if err := s.Keeper.SetNameRecord(ctx, name, address, msg.Record.Restricted); err != nil {
-		ctx.Logger().Error("unable to bind name", "err", err)
-		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	if err := s.Keeper.ChargeBindNameFee(ctx, bindingParams, signer); err != nil {
+		s.Logger(ctx).Error("unable to charge bind name fee", "name", name, "err", err)
+		return nil, sdkerrors.ErrInsufficientFunds.Wrap(err.Error())
 	}
 
 	// key: modulename+name+bind
@@ -100,36 +136,43 @@ func (s msgServer) DeleteName(goCtx context.Context, msg *types.MsgDeleteNameReq
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	// Validate
 	if err := msg.ValidateBasic(); err != nil {
-		ctx.Logger().Error("unable to validate message", "err", err)
-		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+		s.Logger(ctx).Error("unable to validate message", "err", err)
+		return nil, invalidRequest(err)
 	}
 	// Normalize
 	name, err := s.Keeper.Normalize(ctx, msg.Record.Name)
 	if err != nil {
-		ctx.Logger().Error("invalid name", "err", err)
-		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+		s.Logger(ctx).Error("invalid name", "name", msg.Record.Name, "err", err)
+		return nil, invalidRequest(err)
 	}
 	// Parse address
 	address, err := sdk.AccAddressFromBech32(msg.Record.Address)
 	if err != nil {
-		ctx.Logger().Error("invalid address", "err", err)
-		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+		s.Logger(ctx).Error("invalid address", "name", name, "err", err)
+		return nil, invalidRequest(err)
 	}
 	// Ensure the name exists
 	if !s.Keeper.NameExists(ctx, name) {
-		ctx.Logger().Error("invalid name", "name", name)
-		return nil, sdkerrors.ErrInvalidRequest.Wrap("name does not exist")
+		s.Logger(ctx).Error("invalid name", "name", name)
+		return nil, types.ErrNameNotBound.Wrapf("%q", name)
 	}
 	// Ensure permission
 	if !s.Keeper.ResolvesTo(ctx, name, address) {
-		ctx.Logger().Error("msg sender cannot delete name", "name", name)
+		s.Logger(ctx).Error("msg sender cannot delete name", "name", name)
 		return nil, sdkerrors.ErrUnauthorized.Wrap("msg sender cannot delete name")
 	}
+	// If there's a delete delay, the name is only marked for deletion, and is removed by the EndBlocker later.
+	if delay := s.Keeper.GetParams(ctx).DeleteDelayBlocks; delay > 0 {
+		if err = s.Keeper.AddPendingDeletion(ctx, name, address, ctx.BlockHeight()+int64(delay)); err != nil {
+			return nil, invalidRequest(err)
+		}
+		return &types.MsgDeleteNameResponse{}, nil
+	}
 	// Delete
 	err = s.Keeper.DeleteRecord(ctx, name)
 	if err != nil {
-		ctx.Logger().Error("error deleting name", "err", err)
-		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+		s.Logger(ctx).Error("error deleting name", "name", name, "err", err)
+		return nil, invalidRequest(err)
 	}
 
 	// Remove all attributes from assigned accounts
@@ -158,13 +201,50 @@ func (s msgServer) DeleteName(goCtx context.Context, msg *types.MsgDeleteNameReq
 	return &types.MsgDeleteNameResponse{}, nil
 }
 
+// DeleteNames unbinds a name from an address, optionally along with all of the names under it.
+func (s msgServer) DeleteNames(goCtx context.Context, msg *types.MsgDeleteNamesRequest) (*types.MsgDeleteNamesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, invalidRequest(err)
+	}
+	name, err := s.Keeper.Normalize(ctx, msg.Name)
+	if err != nil {
+		return nil, invalidRequest(err)
+	}
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, invalidRequest(err)
+	}
+	if !s.Keeper.NameExists(ctx, name) {
+		return nil, types.ErrNameNotBound.Wrapf("%q", name)
+	}
+	if !s.Keeper.ResolvesTo(ctx, name, owner) {
+		return nil, sdkerrors.ErrUnauthorized.Wrap("msg sender cannot delete name")
+	}
+
+	deleted, err := s.Keeper.DeleteNames(ctx, name, owner, msg.Recursive)
+	if err != nil {
+		return nil, invalidRequest(err)
+	}
+
+	defer func() {
+		telemetry.IncrCounterWithLabels(
+			[]string{types.ModuleName, "name", "unbind"},
+			float32(len(deleted)),
+			[]metrics.Label{telemetry.NewLabel("name", name), telemetry.NewLabel("address", msg.Owner)},
+		)
+	}()
+
+	return &types.MsgDeleteNamesResponse{DeletedNames: deleted}, nil
+}
+
 // ModifyName updates an existing name
 func (s msgServer) ModifyName(goCtx context.Context, msg *types.MsgModifyNameRequest) (*types.MsgModifyNameResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
 	existing, _ := s.Keeper.GetRecordByName(ctx, msg.GetRecord().Name)
 	if existing == nil {
-		return nil, sdkerrors.ErrInvalidRequest.Wrap(types.ErrNameNotBound.Error())
+		return nil, types.ErrNameNotBound.Wrapf("%q", msg.GetRecord().Name)
 	}
 
 	if msg.GetAuthority() != s.Keeper.GetAuthority() && msg.GetAuthority() != existing.Address {
@@ -173,11 +253,11 @@ func (s msgServer) ModifyName(goCtx context.Context, msg *types.MsgModifyNameReq
 
 	addr, err := sdk.AccAddressFromBech32(msg.GetRecord().Address)
 	if err != nil {
-		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+		return nil, invalidRequest(err)
 	}
 
-	if err := s.Keeper.UpdateNameRecord(ctx, msg.GetRecord().Name, addr, msg.GetRecord().Restricted); err != nil {
-		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	if err := s.Keeper.UpdateNameRecord(ctx, msg.GetRecord().Name, addr, msg.GetRecord().Restricted, nil); err != nil {
+		return nil, invalidRequest(err)
 	}
 
 	return &types.MsgModifyNameResponse{}, nil
@@ -199,6 +279,46 @@ func (s msgServer) CreateRootName(goCtx context.Context, msg *types.MsgCreateRoo
 	return &types.MsgCreateRootNameResponse{}, nil
 }
 
+// RemoveName is a governance proposal endpoint for forcibly unbinding a name, even if it is restricted.
+func (s msgServer) RemoveName(goCtx context.Context, msg *types.MsgRemoveNameRequest) (*types.MsgRemoveNameResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := s.ValidateAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+	name, err := s.Keeper.Normalize(ctx, msg.Name)
+	if err != nil {
+		return nil, invalidRequest(err)
+	}
+	record, err := s.Keeper.GetRecordByName(ctx, name)
+	if err != nil {
+		return nil, invalidRequest(err)
+	}
+	owner, err := sdk.AccAddressFromBech32(record.Address)
+	if err != nil {
+		return nil, invalidRequest(err)
+	}
+
+	removed, err := s.Keeper.DeleteNames(ctx, name, owner, msg.Recursive)
+	if err != nil {
+		return nil, invalidRequest(err)
+	}
+
+	defer func() {
+		telemetry.IncrCounterWithLabels(
+			[]string{types.ModuleName, "name", "unbind"},
+			float32(len(removed)),
+			[]metrics.Label{telemetry.NewLabel("name", name), telemetry.NewLabel("address", record.Address)},
+		)
+	}()
+
+	if err = ctx.EventManager().EmitTypedEvent(types.NewEventNameRemoved(name, record.Address, removed)); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRemoveNameResponse{RemovedNames: removed}, nil
+}
+
 // UpdateParams is a governance proposal endpoint for updating the name module's params.
 func (s msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParamsRequest) (*types.MsgUpdateParamsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
@@ -206,15 +326,149 @@ func (s msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParam
 	if err := s.ValidateAuthority(msg.Authority); err != nil {
 		return nil, err
 	}
+	if err := s.ValidateParamsChange(ctx, msg.Params); err != nil {
+		return nil, invalidRequest(err)
+	}
 
 	s.SetParams(ctx, msg.Params)
 	if err := ctx.EventManager().EmitTypedEvent(types.NewEventNameParamsUpdated(
 		msg.Params.AllowUnrestrictedNames,
 		msg.Params.MaxNameLevels,
 		msg.Params.MaxSegmentLength,
-		msg.Params.MinSegmentLength)); err != nil {
+		msg.Params.MinSegmentLength,
+		msg.Params.MaxDeletions,
+		msg.Params.ContractMigratedNamePolicy,
+		msg.Params.ContractAdminClearedNamePolicy,
+		msg.Params.DeleteDelayBlocks,
+		msg.Params.ResolvePendingDeletions,
+		msg.Params.MaxQueryResults,
+		msg.Params.MaxQueryResponseBytes,
+		msg.Params.RestrictNewNames,
+		msg.Params.BindNameFee,
+		msg.Params.BindNameFeeRecipient,
+		msg.Params.RootNameParams)); err != nil {
 		return nil, err
 	}
 
 	return &types.MsgUpdateParamsResponse{}, nil
 }
+
+// SendByName sends coins to the address that a name currently resolves to.
+func (s msgServer) SendByName(goCtx context.Context, msg *types.MsgSendByNameRequest) (*types.MsgSendByNameResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, invalidRequest(err)
+	}
+	from, err := sdk.AccAddressFromBech32(msg.FromAddress)
+	if err != nil {
+		return nil, invalidRequest(err)
+	}
+
+	record, err := s.Keeper.resolveName(ctx, msg.ToName)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not resolve %q", msg.ToName)
+	}
+	to, err := sdk.AccAddressFromBech32(record.Address)
+	if err != nil {
+		return nil, invalidRequest(err)
+	}
+
+	if err = s.bankKeeper.IsSendEnabledCoins(ctx, msg.Amount...); err != nil {
+		return nil, err
+	}
+	if s.bankKeeper.BlockedAddr(to) {
+		return nil, sdkerrors.ErrUnauthorized.Wrapf("%s (bound to %q) is not allowed to receive funds", to, record.Name)
+	}
+	if err = s.bankKeeper.SendCoins(ctx, from, to, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	if err = ctx.EventManager().EmitTypedEvent(types.NewEventSendByName(msg.FromAddress, record.Name, record.Address, msg.Amount)); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSendByNameResponse{ToAddress: record.Address}, nil
+}
+
+// ApproveAddressRotation records the new address's approval to have the old address rotated to it.
+func (s msgServer) ApproveAddressRotation(goCtx context.Context, msg *types.MsgApproveAddressRotationRequest) (*types.MsgApproveAddressRotationResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, invalidRequest(err)
+	}
+	oldAddr, err := sdk.AccAddressFromBech32(msg.OldAddress)
+	if err != nil {
+		return nil, invalidRequest(err)
+	}
+	newAddr, err := sdk.AccAddressFromBech32(msg.NewAddress)
+	if err != nil {
+		return nil, invalidRequest(err)
+	}
+
+	if err = s.Keeper.ApproveAddressRotation(ctx, oldAddr, newAddr); err != nil {
+		return nil, invalidRequest(err)
+	}
+
+	return &types.MsgApproveAddressRotationResponse{}, nil
+}
+
+// RotateAddress moves the names, attributes, and marker access of the old address to the new address.
+func (s msgServer) RotateAddress(goCtx context.Context, msg *types.MsgRotateAddressRequest) (*types.MsgRotateAddressResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, invalidRequest(err)
+	}
+	oldAddr, err := sdk.AccAddressFromBech32(msg.OldAddress)
+	if err != nil {
+		return nil, invalidRequest(err)
+	}
+	newAddr, err := sdk.AccAddressFromBech32(msg.NewAddress)
+	if err != nil {
+		return nil, invalidRequest(err)
+	}
+
+	names, err := s.Keeper.RotateAddress(ctx, oldAddr, newAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRotateAddressResponse{Names: names}, nil
+}
+
+// SetChildQuota sets (or removes) the limit on the number of names that can be bound directly under a name.
+func (s msgServer) SetChildQuota(goCtx context.Context, msg *types.MsgSetChildQuotaRequest) (*types.MsgSetChildQuotaResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, invalidRequest(err)
+	}
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, invalidRequest(err)
+	}
+
+	if err = s.Keeper.SetChildQuota(ctx, msg.Name, msg.MaxChildren, owner); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetChildQuotaResponse{}, nil
+}
+
+// CreateNamedAccount binds a name to a new account whose address is derived from the name.
+func (s msgServer) CreateNamedAccount(goCtx context.Context, msg *types.MsgCreateNamedAccountRequest) (*types.MsgCreateNamedAccountResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, invalidRequest(err)
+	}
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, invalidRequest(err)
+	}
+
+	addr, err := s.Keeper.CreateNamedAccount(ctx, msg.Name, msg.Restricted, owner)
+	if err != nil {
+		s.Logger(ctx).Error("unable to create named account", "name", msg.Name, "err", err)
+		return nil, invalidRequest(err)
+	}
+
+	return &types.MsgCreateNamedAccountResponse{Address: addr.String()}, nil
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/binary"
 	"fmt"
 	"testing"
+	"time"
 
 	"cosmossdk.io/errors"
 	abci "github.com/cometbft/cometbft/abci/types"
@@ -16,11 +17,13 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
 	simapp "github.com/provenance-io/provenance/app"
 	attrtypes "github.com/provenance-io/provenance/x/attribute/types"
+	markertypes "github.com/provenance-io/provenance/x/marker/types"
 	"github.com/provenance-io/provenance/x/name/keeper"
 	"github.com/provenance-io/provenance/x/name/types"
 	nametypes "github.com/provenance-io/provenance/x/name/types"
@@ -110,7 +113,7 @@ func (s *MsgServerTestSuite) TestDeleteNameRequest() {
 		{
 			name:     "Should fail to normalize name",
 			msg:      *types.NewMsgDeleteNameRequest(types.NewNameRecord("i", s.owner1Addr, false)),
-			errorMsg: "segment of name is too short: invalid request",
+			errorMsg: "segment of name is too short",
 		},
 		{
 			name:     "Should fail to parse address",
@@ -120,7 +123,7 @@ func (s *MsgServerTestSuite) TestDeleteNameRequest() {
 		{
 			name:     "Should fail to name does not exist",
 			msg:      *types.NewMsgDeleteNameRequest(types.NewNameRecord("provenance.io", s.owner1Addr, false)),
-			errorMsg: "name does not exist: invalid request",
+			errorMsg: `"provenance.io": no address bound to name`,
 		},
 		{
 			name:     "Should fail name does not resolve to owner",
@@ -184,8 +187,82 @@ func (s *MsgServerTestSuite) TestDeleteNameRemovingAttributeAccounts() {
 	}
 }
 
+func (s *MsgServerTestSuite) TestDeleteNameWithDelay() {
+	params := s.app.NameKeeper.GetParams(s.ctx)
+	params.DeleteDelayBlocks = 10
+	s.app.NameKeeper.SetParams(s.ctx, params)
+	s.ctx = s.ctx.WithBlockHeight(100)
+
+	name := "disputed.name"
+	s.Require().NoError(s.app.NameKeeper.SetNameRecord(s.ctx, name, s.owner1Addr, false))
+	attrAcct := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	s.Require().NoError(s.app.AttributeKeeper.SetAttribute(s.ctx, attrtypes.NewAttribute(name, attrAcct.String(), attrtypes.AttributeType_String, []byte("value"), nil), s.owner1Addr))
+	expPending := types.NewPendingNameDeletion(name, s.owner1, 110)
+
+	s.Run("delete marks the name pending deletion", func() {
+		s.ctx = s.ctx.WithEventManager(sdk.NewEventManager())
+		_, err := s.msgServer.DeleteName(s.ctx, types.NewMsgDeleteNameRequest(types.NewNameRecord(name, s.owner1Addr, false)))
+		s.Require().NoError(err, "DeleteName error")
+		s.Assert().True(s.app.NameKeeper.NameExists(s.ctx, name), "NameExists")
+		s.Assert().True(s.containsMessage(s.ctx.EventManager().ABCIEvents(), types.NewEventNamePendingDeletion(expPending)), "pending deletion event")
+
+		resp, err := s.app.NameKeeper.PendingDeletions(s.ctx, &types.QueryPendingDeletionsRequest{})
+		s.Require().NoError(err, "PendingDeletions error")
+		s.Assert().Equal([]types.PendingNameDeletion{expPending}, resp.PendingDeletions, "PendingDeletions")
+	})
+
+	s.Run("delete again", func() {
+		_, err := s.msgServer.DeleteName(s.ctx, types.NewMsgDeleteNameRequest(types.NewNameRecord(name, s.owner1Addr, false)))
+		s.Assert().EqualError(err, `"disputed.name": name is pending deletion`, "DeleteName error")
+	})
+
+	s.Run("resolve", func() {
+		_, err := s.app.NameKeeper.Resolve(s.ctx, &types.QueryResolveRequest{Name: name})
+		s.Assert().EqualError(err, `"disputed.name": name is pending deletion`, "Resolve error")
+
+		params.ResolvePendingDeletions = true
+		s.app.NameKeeper.SetParams(s.ctx, params)
+		resp, err := s.app.NameKeeper.Resolve(s.ctx, &types.QueryResolveRequest{Name: name})
+		s.Require().NoError(err, "Resolve error")
+		s.Assert().Equal(s.owner1, resp.Address, "Resolve address")
+	})
+
+	s.Run("bind child name", func() {
+		_, err := s.msgServer.BindName(s.ctx, types.NewMsgBindNameRequest(types.NewNameRecord("child", s.owner1Addr, false), types.NewNameRecord(name, s.owner1Addr, false)))
+		s.Assert().ErrorContains(err, `parent name "disputed.name": name is pending deletion`, "BindName error")
+	})
+
+	s.Run("genesis", func() {
+		genState := s.app.NameKeeper.ExportGenesis(s.ctx)
+		s.Assert().Equal([]types.PendingNameDeletion{expPending}, genState.PendingDeletions, "PendingDeletions")
+		s.Assert().NoError(genState.Validate(), "genesis Validate")
+	})
+
+	s.Run("not removed before delete height", func() {
+		s.app.NameKeeper.RemoveDuePendingDeletions(s.ctx.WithBlockHeight(109))
+		s.Assert().True(s.app.NameKeeper.NameExists(s.ctx, name), "NameExists")
+		s.Assert().True(s.app.NameKeeper.IsPendingDeletion(s.ctx, name), "IsPendingDeletion")
+	})
+
+	s.Run("removed at delete height", func() {
+		s.ctx = s.ctx.WithBlockHeight(110).WithEventManager(sdk.NewEventManager())
+		s.app.NameKeeper.RemoveDuePendingDeletions(s.ctx)
+		s.Assert().False(s.app.NameKeeper.NameExists(s.ctx, name), "NameExists")
+		s.Assert().False(s.app.NameKeeper.IsPendingDeletion(s.ctx, name), "IsPendingDeletion")
+		s.Assert().True(s.containsMessage(s.ctx.EventManager().ABCIEvents(), types.NewEventNameUnbound(s.owner1, name, false)), "unbound event")
+		attrs, err := s.app.AttributeKeeper.GetAllAttributes(s.ctx, attrAcct.String())
+		s.Require().NoError(err, "GetAllAttributes")
+		s.Assert().Empty(attrs, "attributes of attribute account")
+
+		resp, err := s.app.NameKeeper.PendingDeletions(s.ctx, &types.QueryPendingDeletionsRequest{})
+		s.Require().NoError(err, "PendingDeletions error")
+		s.Assert().Empty(resp.PendingDeletions, "PendingDeletions")
+	})
+}
+
 // create name record
 func (s *MsgServerTestSuite) TestCreateName() {
+	s.Require().NoError(s.app.NameKeeper.SetNameRecord(s.ctx, "orphan.gone", s.owner1Addr, false), "SetNameRecord(orphan.gone)")
 	tests := []struct {
 		name          string
 		expectedError error
@@ -201,7 +278,12 @@ func (s *MsgServerTestSuite) TestCreateName() {
 		{
 			name:          "create bad name record",
 			msg:           types.NewMsgBindNameRequest(types.NewNameRecord("new", s.owner2Addr, false), types.NewNameRecord("foo.name", s.owner1Addr, false)),
-			expectedError: sdkerrors.ErrInvalidRequest.Wrap(types.ErrNameNotBound.Error()),
+			expectedError: types.ErrNameNotBound,
+		},
+		{
+			name:          "create name record under parent without a parent",
+			msg:           types.NewMsgBindNameRequest(types.NewNameRecord("new", s.owner2Addr, false), types.NewNameRecord("orphan.gone", s.owner1Addr, false)),
+			expectedError: types.ErrParentNameNotBound.Wrapf("%q", "gone"),
 		},
 	}
 
@@ -222,7 +304,153 @@ func (s *MsgServerTestSuite) TestCreateName() {
 	}
 }
 
+func (s *MsgServerTestSuite) TestBindNameBindingParams() {
+	s.Require().NoError(s.app.NameKeeper.SetNameRecord(s.ctx, "other", s.owner1Addr, false), "SetNameRecord(other)")
+	params := s.app.NameKeeper.GetParams(s.ctx)
+	params.BindNameFee = sdk.NewCoins(sdk.NewInt64Coin("nhash", 10))
+	params.RootNameParams = []types.RootNameParams{{
+		Root:                 "other",
+		RestrictNewNames:     true,
+		BindNameFee:          sdk.NewCoins(sdk.NewInt64Coin("nhash", 3)),
+		BindNameFeeRecipient: s.owner2,
+	}}
+	s.app.NameKeeper.SetParams(s.ctx, params)
+	s.Require().NoError(testutil.FundAccount(s.ctx, s.app.BankKeeper, s.owner1Addr, sdk.NewCoins(sdk.NewInt64Coin("nhash", 13))), "FundAccount")
+	feeCollector := authtypes.NewModuleAddress(authtypes.FeeCollectorName)
+
+	tests := []struct {
+		name          string
+		msg           *types.MsgBindNameRequest
+		expErr        string
+		expRestricted bool
+		expFeeCol     int64
+		expOwner2     int64
+	}{
+		{
+			name:      "module-wide fee paid to fee collector",
+			msg:       types.NewMsgBindNameRequest(types.NewNameRecord("aa", s.owner1Addr, false), types.NewNameRecord("example.name", s.owner1Addr, false)),
+			expFeeCol: 10,
+		},
+		{
+			name:          "root fee paid to recipient and name restricted",
+			msg:           types.NewMsgBindNameRequest(types.NewNameRecord("bb", s.owner1Addr, false), types.NewNameRecord("other", s.owner1Addr, false)),
+			expRestricted: true,
+			expFeeCol:     10,
+			expOwner2:     3,
+		},
+		{
+			name:   "insufficient funds for fee",
+			msg:    types.NewMsgBindNameRequest(types.NewNameRecord("cc", s.owner1Addr, false), types.NewNameRecord("example.name", s.owner1Addr, false)),
+			expErr: "could not pay bind name fee 10nhash",
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			_, err := s.msgServer.BindName(s.ctx, tc.msg)
+			if len(tc.expErr) > 0 {
+				s.Require().ErrorContains(err, tc.expErr, "BindName error")
+				s.Assert().ErrorIs(err, sdkerrors.ErrInsufficientFunds, "BindName error")
+				return
+			}
+			s.Require().NoError(err, "BindName error")
+			record, err := s.app.NameKeeper.GetRecordByName(s.ctx, tc.msg.Record.Name+"."+tc.msg.Parent.Name)
+			s.Require().NoError(err, "GetRecordByName")
+			s.Assert().Equal(tc.expRestricted, record.Restricted, "record restricted")
+			s.Assert().Equal(tc.expFeeCol, s.app.BankKeeper.GetBalance(s.ctx, feeCollector, "nhash").Amount.Int64(), "fee collector balance")
+			s.Assert().Equal(tc.expOwner2, s.app.BankKeeper.GetBalance(s.ctx, s.owner2Addr, "nhash").Amount.Int64(), "owner2 balance")
+		})
+	}
+}
+
 // delete name record
+func (s *MsgServerTestSuite) TestBindNameWithParentApproval() {
+	s.Require().NoError(s.app.NameKeeper.SetNameRecord(s.ctx, "restricted.name", s.owner1Addr, true), "SetNameRecord")
+	ctx := s.ctx.WithChainID("approval-chain").WithBlockTime(time.Date(2030, 1, 2, 3, 4, 5, 0, time.UTC))
+	expiration := ctx.BlockTime().Add(time.Hour)
+	approve := func(privKey cryptotypes.PrivKey, chainID, name, requestor string, expiration time.Time) *types.ParentApproval {
+		signature, err := privKey.Sign(types.NewParentApprovalSignDoc(chainID, name, requestor, expiration).GetSignBytes())
+		s.Require().NoError(err, "Sign")
+		approval, err := types.NewParentApproval(privKey.PubKey(), expiration, signature)
+		s.Require().NoError(err, "NewParentApproval")
+		return approval
+	}
+	bindMsg := func(child string, approval *types.ParentApproval) *types.MsgBindNameRequest {
+		msg := types.NewMsgBindNameRequest(types.NewNameRecord(child, s.owner2Addr, false), types.NewNameRecord("restricted.name", s.owner2Addr, false))
+		msg.ParentApproval = approval
+		return msg
+	}
+
+	tests := []struct {
+		name    string
+		msg     *types.MsgBindNameRequest
+		expErr  string
+		expBind string
+	}{
+		{
+			name:   "no approval",
+			msg:    bindMsg("child", nil),
+			expErr: `parent name "restricted.name" is restricted and does not resolve to the provided parent address: invalid request`,
+		},
+		{
+			name: "approval signed by another account",
+			msg:  bindMsg("child", approve(s.privkey2, "approval-chain", "child.restricted.name", s.owner2, expiration)),
+			expErr: `parent name "restricted.name" is restricted: parent approval signer ` + s.owner2 +
+				` is not the owner of parent name "restricted.name": unauthorized`,
+		},
+		{
+			name: "approval for another name",
+			msg:  bindMsg("child", approve(s.privkey1, "approval-chain", "other.restricted.name", s.owner2, expiration)),
+			expErr: `parent name "restricted.name" is restricted: parent approval signature is not valid for binding ` +
+				`"child.restricted.name" for ` + s.owner2 + `: unauthorized`,
+		},
+		{
+			name: "approval for another requestor",
+			msg:  bindMsg("child", approve(s.privkey1, "approval-chain", "child.restricted.name", s.owner1, expiration)),
+			expErr: `parent name "restricted.name" is restricted: parent approval signature is not valid for binding ` +
+				`"child.restricted.name" for ` + s.owner2 + `: unauthorized`,
+		},
+		{
+			name: "approval for another chain",
+			msg:  bindMsg("child", approve(s.privkey1, "other-chain", "child.restricted.name", s.owner2, expiration)),
+			expErr: `parent name "restricted.name" is restricted: parent approval signature is not valid for binding ` +
+				`"child.restricted.name" for ` + s.owner2 + `: unauthorized`,
+		},
+		{
+			name:   "expired approval",
+			msg:    bindMsg("child", approve(s.privkey1, "approval-chain", "child.restricted.name", s.owner2, ctx.BlockTime())),
+			expErr: `parent name "restricted.name" is restricted: parent approval expired at 2030-01-02 03:04:05 +0000 UTC: unauthorized`,
+		},
+		{
+			name:   "approval without signature",
+			msg:    bindMsg("child", &types.ParentApproval{PubKey: approve(s.privkey1, "approval-chain", "child.restricted.name", s.owner2, expiration).PubKey, Expiration: expiration}),
+			expErr: "invalid parent approval: signature cannot be empty: invalid request",
+		},
+		{
+			name:    "valid approval",
+			msg:     bindMsg("child", approve(s.privkey1, "approval-chain", "child.restricted.name", s.owner2, expiration)),
+			expBind: "child.restricted.name",
+		},
+		{
+			name:    "valid approval with name that needs normalizing",
+			msg:     bindMsg(" Other ", approve(s.privkey1, "approval-chain", "other.restricted.name", s.owner2, expiration)),
+			expBind: "other.restricted.name",
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			_, err := s.msgServer.BindName(ctx, tc.msg)
+			if len(tc.expErr) > 0 {
+				s.Assert().EqualError(err, tc.expErr, "BindName error")
+				return
+			}
+			s.Require().NoError(err, "BindName error")
+			s.Assert().True(s.app.NameKeeper.ResolvesTo(ctx, tc.expBind, s.owner2Addr), "%q resolves to requestor", tc.expBind)
+		})
+	}
+}
+
 func (s *MsgServerTestSuite) TestDeleteName() {
 	tests := []struct {
 		name          string
@@ -239,7 +467,7 @@ func (s *MsgServerTestSuite) TestDeleteName() {
 		{
 			name:          "create bad name record",
 			msg:           types.NewMsgDeleteNameRequest(types.NewNameRecord("example.name", s.owner1Addr, false)),
-			expectedError: sdkerrors.ErrInvalidRequest.Wrap("name does not exist"),
+			expectedError: types.ErrNameNotBound.Wrapf("%q", "example.name"),
 		},
 	}
 
@@ -260,6 +488,111 @@ func (s *MsgServerTestSuite) TestDeleteName() {
 	}
 }
 
+func (s *MsgServerTestSuite) TestDeleteNames() {
+	params := s.app.NameKeeper.GetParams(s.ctx)
+	params.MaxDeletions = 3
+	s.app.NameKeeper.SetParams(s.ctx, params)
+
+	s.Require().NoError(s.app.NameKeeper.SetNameRecord(s.ctx, "aa.example.name", s.owner2Addr, false))
+	s.Require().NoError(s.app.NameKeeper.SetNameRecord(s.ctx, "bb.example.name", s.owner1Addr, false))
+	s.Require().NoError(s.app.NameKeeper.SetNameRecord(s.ctx, "cc.aa.example.name", s.owner2Addr, true))
+	s.Require().NoError(s.app.NameKeeper.SetNameRecord(s.ctx, "leaf.name", s.owner1Addr, false))
+	attrAcct := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	s.Require().NoError(s.app.AttributeKeeper.SetAttribute(s.ctx, attrtypes.NewAttribute("cc.aa.example.name", attrAcct.String(), attrtypes.AttributeType_String, []byte("value"), nil), s.owner2Addr))
+
+	tests := []struct {
+		name       string
+		msg        *types.MsgDeleteNamesRequest
+		expErr     string
+		expDeleted []string
+	}{
+		{
+			name:   "empty name",
+			msg:    types.NewMsgDeleteNamesRequest(s.owner1, "", true),
+			expErr: "name cannot be empty: invalid request",
+		},
+		{
+			name:   "name does not exist",
+			msg:    types.NewMsgDeleteNamesRequest(s.owner1, "unknown.name", true),
+			expErr: `"unknown.name": no address bound to name`,
+		},
+		{
+			name:   "name does not resolve to owner",
+			msg:    types.NewMsgDeleteNamesRequest(s.owner2, "example.name", true),
+			expErr: "msg sender cannot delete name: unauthorized",
+		},
+		{
+			name:   "not recursive with child names",
+			msg:    types.NewMsgDeleteNamesRequest(s.owner1, "example.name", false),
+			expErr: `name "example.name" has child names, e.g. `,
+		},
+		{
+			name:   "too many names",
+			msg:    types.NewMsgDeleteNamesRequest(s.owner1, "example.name", true),
+			expErr: "cannot delete more than 3 names at once: invalid request",
+		},
+		{
+			name:       "not recursive without child names",
+			msg:        types.NewMsgDeleteNamesRequest(s.owner1, "leaf.name", false),
+			expDeleted: []string{"leaf.name"},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			resp, err := s.msgServer.DeleteNames(s.ctx, tc.msg)
+			if len(tc.expErr) > 0 {
+				s.Require().ErrorContains(err, tc.expErr, "DeleteNames error")
+				s.Assert().Nil(resp, "DeleteNames response")
+				return
+			}
+			s.Require().NoError(err, "DeleteNames error")
+			s.Assert().Equal(tc.expDeleted, resp.DeletedNames, "DeleteNames deleted names")
+		})
+	}
+
+	s.Run("recursive", func() {
+		params.MaxDeletions = 4
+		s.app.NameKeeper.SetParams(s.ctx, params)
+		s.ctx = s.ctx.WithEventManager(sdk.NewEventManager())
+
+		resp, err := s.msgServer.DeleteNames(s.ctx, types.NewMsgDeleteNamesRequest(s.owner1, "example.name", true))
+		s.Require().NoError(err, "DeleteNames error")
+		deleted := resp.DeletedNames
+		s.Require().ElementsMatch([]string{"example.name", "aa.example.name", "bb.example.name", "cc.aa.example.name"}, deleted, "deleted names")
+		s.Assert().Equal("example.name", deleted[len(deleted)-1], "last deleted name")
+		s.Assert().Less(indexOf(deleted, "cc.aa.example.name"), indexOf(deleted, "aa.example.name"), "index of cc.aa.example.name")
+
+		expEvents := []proto.Message{
+			types.NewEventNameUnbound(s.owner1, "example.name", false),
+			types.NewEventNameUnbound(s.owner2, "aa.example.name", false),
+			types.NewEventNameUnbound(s.owner1, "bb.example.name", false),
+			types.NewEventNameUnbound(s.owner2, "cc.aa.example.name", true),
+		}
+		for _, expEvent := range expEvents {
+			s.Assert().True(s.containsMessage(s.ctx.EventManager().ABCIEvents(), expEvent), "expected event: %v", expEvent)
+		}
+		for _, name := range deleted {
+			s.Assert().False(s.app.NameKeeper.NameExists(s.ctx, name), "NameExists(%q)", name)
+		}
+		children, err := s.app.NameKeeper.GetChildNames(s.ctx, "name")
+		s.Require().NoError(err, "GetChildNames(name)")
+		s.Assert().Empty(children, "GetChildNames(name)")
+		attrs, err := s.app.AttributeKeeper.GetAllAttributes(s.ctx, attrAcct.String())
+		s.Require().NoError(err, "GetAllAttributes")
+		s.Assert().Empty(attrs, "attributes of attribute account")
+	})
+}
+
+func indexOf(vals []string, val string) int {
+	for i, v := range vals {
+		if v == val {
+			return i
+		}
+	}
+	return -1
+}
+
 func (s *MsgServerTestSuite) TestModifyName() {
 	authority := s.app.NameKeeper.GetAuthority()
 
@@ -296,13 +629,13 @@ func (s *MsgServerTestSuite) TestModifyName() {
 		{
 			name:          "modify name - fails with non existent root record",
 			msg:           types.NewMsgModifyNameRequest(authority, "jackthecat", s.owner1Addr, true),
-			expectedError: sdkerrors.ErrInvalidRequest.Wrap(types.ErrNameNotBound.Error()),
+			expectedError: types.ErrNameNotBound.Wrapf("%q", "jackthecat"),
 			expectedEvent: nil,
 		},
 		{
 			name:          "modify name - fails with non existent subdomain record",
 			msg:           types.NewMsgModifyNameRequest(authority, "jackthecat.name", s.owner1Addr, true),
-			expectedError: sdkerrors.ErrInvalidRequest.Wrap(types.ErrNameNotBound.Error()),
+			expectedError: types.ErrNameNotBound.Wrapf("%q", "jackthecat.name"),
 			expectedEvent: nil,
 		},
 		{
@@ -377,6 +710,69 @@ func (s *MsgServerTestSuite) TestCreateRootName() {
 	}
 }
 
+func (s *MsgServerTestSuite) TestRemoveName() {
+	authority := s.app.NameKeeper.GetAuthority()
+	params := s.app.NameKeeper.GetParams(s.ctx)
+	params.MaxDeletions = 2
+	s.app.NameKeeper.SetParams(s.ctx, params)
+
+	s.Require().NoError(s.app.NameKeeper.SetNameRecord(s.ctx, "fraud.name", s.owner2Addr, true))
+	s.Require().NoError(s.app.NameKeeper.SetNameRecord(s.ctx, "sub.fraud.name", s.owner2Addr, true))
+	attrAcct := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	s.Require().NoError(s.app.AttributeKeeper.SetAttribute(s.ctx, attrtypes.NewAttribute("fraud.name", attrAcct.String(), attrtypes.AttributeType_String, []byte("value"), nil), s.owner2Addr))
+
+	tests := []struct {
+		name       string
+		msg        *types.MsgRemoveNameRequest
+		expErr     string
+		expRemoved []string
+	}{
+		{
+			name:   "invalid authority",
+			msg:    types.NewMsgRemoveNameRequest(s.owner2, "fraud.name", true),
+			expErr: fmt.Sprintf("expected %q got %q: expected gov account as only signer for proposal message", authority, s.owner2),
+		},
+		{
+			name:   "name does not exist",
+			msg:    types.NewMsgRemoveNameRequest(authority, "unknown.name", true),
+			expErr: "no address bound to name",
+		},
+		{
+			name:   "not recursive with child names",
+			msg:    types.NewMsgRemoveNameRequest(authority, "fraud.name", false),
+			expErr: `name "fraud.name" has child names, e.g. "sub.fraud.name": invalid request`,
+		},
+		{
+			name:       "recursive restricted name",
+			msg:        types.NewMsgRemoveNameRequest(authority, "fraud.name", true),
+			expRemoved: []string{"sub.fraud.name", "fraud.name"},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.ctx = s.ctx.WithEventManager(sdk.NewEventManager())
+			resp, err := s.msgServer.RemoveName(s.ctx, tc.msg)
+			if len(tc.expErr) > 0 {
+				s.Require().EqualError(err, tc.expErr, "RemoveName error")
+				s.Assert().Nil(resp, "RemoveName response")
+				return
+			}
+			s.Require().NoError(err, "RemoveName error")
+			s.Assert().Equal(tc.expRemoved, resp.RemovedNames, "RemoveName removed names")
+			expEvent := types.NewEventNameRemoved(tc.msg.Name, s.owner2, tc.expRemoved)
+			s.Assert().True(s.containsMessage(s.ctx.EventManager().ABCIEvents(), expEvent), "expected event: %v", expEvent)
+			for _, name := range tc.expRemoved {
+				s.Assert().False(s.app.NameKeeper.NameExists(s.ctx, name), "NameExists(%q)", name)
+			}
+		})
+	}
+
+	attrs, err := s.app.AttributeKeeper.GetAllAttributes(s.ctx, attrAcct.String())
+	s.Require().NoError(err, "GetAllAttributes")
+	s.Assert().Empty(attrs, "attributes of attribute account")
+}
+
 func (s *MsgServerTestSuite) TestUpdateParams() {
 	authority := s.app.NameKeeper.GetAuthority()
 
@@ -393,6 +789,7 @@ func (s *MsgServerTestSuite) TestUpdateParams() {
 				3,
 				10,
 				true,
+				25,
 				authority,
 			),
 			expectedEvent: types.NewEventNameParamsUpdated(
@@ -400,8 +797,158 @@ func (s *MsgServerTestSuite) TestUpdateParams() {
 				10,
 				100,
 				3,
+				25,
+				types.ContractNamePolicyKeep,
+				types.ContractNamePolicyKeep,
+				0,
+				false,
+				0,
+				0,
+				false,
+				nil,
+				"",
+				nil,
 			),
 		},
+		{
+			name: "valid authority with contract name policies",
+			msg: func() *types.MsgUpdateParamsRequest {
+				msg := types.NewMsgUpdateParamsRequest(100, 3, 10, true, 25, authority)
+				msg.Params.ContractMigratedNamePolicy = types.ContractNamePolicyReassignToAdmin
+				msg.Params.ContractAdminClearedNamePolicy = types.ContractNamePolicyDelete
+				return msg
+			}(),
+			expectedEvent: types.NewEventNameParamsUpdated(
+				true,
+				10,
+				100,
+				3,
+				25,
+				types.ContractNamePolicyReassignToAdmin,
+				types.ContractNamePolicyDelete,
+				0,
+				false,
+				0,
+				0,
+				false,
+				nil,
+				"",
+				nil,
+			),
+		},
+		{
+			name: "valid authority with delete delay",
+			msg: func() *types.MsgUpdateParamsRequest {
+				msg := types.NewMsgUpdateParamsRequest(100, 3, 10, true, 25, authority)
+				msg.Params.DeleteDelayBlocks = 50
+				msg.Params.ResolvePendingDeletions = true
+				return msg
+			}(),
+			expectedEvent: types.NewEventNameParamsUpdated(
+				true,
+				10,
+				100,
+				3,
+				25,
+				types.ContractNamePolicyKeep,
+				types.ContractNamePolicyKeep,
+				50,
+				true,
+				0,
+				0,
+				false,
+				nil,
+				"",
+				nil,
+			),
+		},
+		{
+			name: "valid authority with query limits",
+			msg: func() *types.MsgUpdateParamsRequest {
+				msg := types.NewMsgUpdateParamsRequest(100, 3, 10, true, 25, authority)
+				msg.Params.MaxQueryResults = 20
+				msg.Params.MaxQueryResponseBytes = 4096
+				return msg
+			}(),
+			expectedEvent: types.NewEventNameParamsUpdated(
+				true,
+				10,
+				100,
+				3,
+				25,
+				types.ContractNamePolicyKeep,
+				types.ContractNamePolicyKeep,
+				0,
+				false,
+				20,
+				4096,
+				false,
+				nil,
+				"",
+				nil,
+			),
+		},
+		{
+			name: "valid authority with binding params",
+			msg: func() *types.MsgUpdateParamsRequest {
+				msg := types.NewMsgUpdateParamsRequest(100, 3, 10, true, 25, authority)
+				msg.Params.RestrictNewNames = true
+				msg.Params.BindNameFee = sdk.NewCoins(sdk.NewInt64Coin("nhash", 100))
+				msg.Params.BindNameFeeRecipient = s.owner1
+				msg.Params.RootNameParams = []types.RootNameParams{
+					{Root: "pb", BindNameFee: sdk.NewCoins(sdk.NewInt64Coin("nhash", 5))},
+					{Root: "io", RestrictNewNames: true},
+				}
+				return msg
+			}(),
+			expectedEvent: types.NewEventNameParamsUpdated(
+				true,
+				10,
+				100,
+				3,
+				25,
+				types.ContractNamePolicyKeep,
+				types.ContractNamePolicyKeep,
+				0,
+				false,
+				0,
+				0,
+				true,
+				sdk.NewCoins(sdk.NewInt64Coin("nhash", 100)),
+				s.owner1,
+				[]types.RootNameParams{{Root: "pb"}, {Root: "io"}},
+			),
+		},
+		{
+			name: "duplicate root name params",
+			msg: func() *types.MsgUpdateParamsRequest {
+				msg := types.NewMsgUpdateParamsRequest(100, 3, 10, true, 25, authority)
+				msg.Params.RootNameParams = []types.RootNameParams{{Root: "pb"}, {Root: "pb", RestrictNewNames: true}}
+				return msg
+			}(),
+			expErr: `duplicate root name params for "pb": invalid request`,
+		},
+		{
+			name: "invalid bind name fee recipient",
+			msg: func() *types.MsgUpdateParamsRequest {
+				msg := types.NewMsgUpdateParamsRequest(100, 3, 10, true, 25, authority)
+				msg.Params.BindNameFeeRecipient = "notanaddress"
+				return msg
+			}(),
+			expErr: `invalid bind name fee recipient for root "": decoding bech32 failed: invalid separator index -1: invalid request`,
+		},
+		{
+			name: "params that make existing names invalid",
+			msg: types.NewMsgUpdateParamsRequest(
+				100,
+				3,
+				1,
+				true,
+				25,
+				authority,
+			),
+			expErr: `1 existing name(s) would be invalid with the new params, including: "example.name" (name has too many segments): invalid request`,
+		},
 		{
 			name: "invalid authority",
 			msg: types.NewMsgUpdateParamsRequest(
@@ -409,6 +956,7 @@ func (s *MsgServerTestSuite) TestUpdateParams() {
 				3,
 				10,
 				true,
+				25,
 				"invalid-authority",
 			),
 			expErr: `expected "cosmos10d07y265gmmuvt4z0w9aw880jnsr700j6zn9kn" got "invalid-authority": expected gov account as only signer for proposal message`,
@@ -431,3 +979,152 @@ func (s *MsgServerTestSuite) TestUpdateParams() {
 		})
 	}
 }
+
+func (s *MsgServerTestSuite) TestSendByName() {
+	amount := sdk.NewCoins(sdk.NewInt64Coin("nhash", 100))
+	s.Require().NoError(testutil.FundAccount(s.ctx, s.app.BankKeeper, s.owner2Addr, sdk.NewCoins(sdk.NewInt64Coin("nhash", 1000))), "FundAccount")
+	govAddr := authtypes.NewModuleAddress(govtypes.ModuleName)
+	s.Require().NoError(s.app.NameKeeper.SetNameRecord(s.ctx, "gov.name", govAddr, false), "SetNameRecord(gov.name)")
+	s.Require().NoError(s.app.NameKeeper.SetNameRecord(s.ctx, "pending.name", s.owner1Addr, false), "SetNameRecord(pending.name)")
+	s.Require().NoError(s.app.NameKeeper.AddPendingDeletion(s.ctx, "pending.name", s.owner1Addr, s.ctx.BlockHeight()+10), "AddPendingDeletion")
+
+	tests := []struct {
+		name   string
+		msg    *types.MsgSendByNameRequest
+		expErr string
+	}{
+		{
+			name:   "invalid msg",
+			msg:    types.NewMsgSendByNameRequest(s.owner2, "", amount),
+			expErr: "name cannot be empty: invalid request",
+		},
+		{
+			name:   "name not bound",
+			msg:    types.NewMsgSendByNameRequest(s.owner2, "unknown.name", amount),
+			expErr: `could not resolve "unknown.name": no address bound to name`,
+		},
+		{
+			name:   "name pending deletion",
+			msg:    types.NewMsgSendByNameRequest(s.owner2, "pending.name", amount),
+			expErr: `could not resolve "pending.name": "pending.name": name is pending deletion`,
+		},
+		{
+			name:   "name bound to blocked address",
+			msg:    types.NewMsgSendByNameRequest(s.owner2, "gov.name", amount),
+			expErr: govAddr.String() + ` (bound to "gov.name") is not allowed to receive funds: unauthorized`,
+		},
+		{
+			name:   "insufficient funds",
+			msg:    types.NewMsgSendByNameRequest(s.owner2, "example.name", sdk.NewCoins(sdk.NewInt64Coin("nhash", 5000))),
+			expErr: "spendable balance 1000nhash is smaller than 5000nhash: insufficient funds",
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			resp, err := s.msgServer.SendByName(s.ctx, tc.msg)
+			s.Require().EqualError(err, tc.expErr, "SendByName error")
+			s.Assert().Nil(resp, "SendByName response")
+		})
+	}
+
+	s.Run("name is resolved when executed", func() {
+		s.ctx = s.ctx.WithEventManager(sdk.NewEventManager())
+		resp, err := s.msgServer.SendByName(s.ctx, types.NewMsgSendByNameRequest(s.owner2, " Example.Name ", amount))
+		s.Require().NoError(err, "SendByName to owner1")
+		s.Assert().Equal(s.owner1, resp.ToAddress, "SendByName to owner1 response address")
+		s.Assert().Equal(amount, s.app.BankKeeper.GetAllBalances(s.ctx, s.owner1Addr), "owner1 balance")
+		expEvent := types.NewEventSendByName(s.owner2, "example.name", s.owner1, amount)
+		s.Assert().True(s.containsMessage(s.ctx.EventManager().ABCIEvents(), expEvent), "expected event: %v", expEvent)
+
+		newAddr := sdk.AccAddress("new_treasury_addr___")
+		s.Require().NoError(s.app.NameKeeper.UpdateNameRecord(s.ctx, "example.name", newAddr, false, nil), "UpdateNameRecord")
+		resp, err = s.msgServer.SendByName(s.ctx, types.NewMsgSendByNameRequest(s.owner2, "example.name", amount))
+		s.Require().NoError(err, "SendByName after rebinding")
+		s.Assert().Equal(newAddr.String(), resp.ToAddress, "SendByName after rebinding response address")
+		s.Assert().Equal(amount, s.app.BankKeeper.GetAllBalances(s.ctx, newAddr), "new address balance")
+		s.Assert().Equal(amount, s.app.BankKeeper.GetAllBalances(s.ctx, s.owner1Addr), "owner1 balance after rebinding")
+	})
+}
+
+func (s *MsgServerTestSuite) TestRotateAddress() {
+	newAddr := sdk.AccAddress("new_owner1_address__")
+	newOwner := newAddr.String()
+	s.app.AccountKeeper.SetAccount(s.ctx, s.app.AccountKeeper.NewAccountWithAddress(s.ctx, newAddr))
+
+	s.Require().NoError(s.app.NameKeeper.SetNameRecord(s.ctx, "attr.name", s.owner2Addr, false), "SetNameRecord(attr.name)")
+	attested := attrtypes.NewAttribute("attr.name", s.owner1, attrtypes.AttributeType_String, []byte("owner2 value"), nil)
+	s.Require().NoError(s.app.AttributeKeeper.SetAttribute(s.ctx, attested, s.owner2Addr), "SetAttribute(attested)")
+	owned := attrtypes.NewAttribute("example.name", s.owner1, attrtypes.AttributeType_String, []byte("owner1 value"), nil)
+	s.Require().NoError(s.app.AttributeKeeper.SetAttribute(s.ctx, owned, s.owner1Addr), "SetAttribute(owned)")
+
+	marker := markertypes.NewEmptyMarkerAccount("rotatecoin", s.owner1, []markertypes.AccessGrant{
+		*markertypes.NewAccessGrant(s.owner1Addr, markertypes.AccessList{markertypes.Access_Mint, markertypes.Access_Admin}),
+		*markertypes.NewAccessGrant(s.owner2Addr, markertypes.AccessList{markertypes.Access_Burn}),
+	})
+	s.Require().NoError(s.app.MarkerKeeper.AddMarkerAccount(s.ctx, marker), "AddMarkerAccount")
+
+	s.Run("not approved", func() {
+		resp, err := s.msgServer.RotateAddress(s.ctx, types.NewMsgRotateAddressRequest(s.owner1, newOwner))
+		s.Require().EqualError(err, newOwner+" has not approved the rotation of "+s.owner1+" to it: unauthorized", "RotateAddress error")
+		s.Assert().Nil(resp, "RotateAddress response")
+	})
+
+	s.Run("invalid approval", func() {
+		resp, err := s.msgServer.ApproveAddressRotation(s.ctx, types.NewMsgApproveAddressRotationRequest(s.owner1, s.owner1))
+		s.Require().EqualError(err, "old address and new address cannot be the same: "+s.owner1+": invalid request", "ApproveAddressRotation error")
+		s.Assert().Nil(resp, "ApproveAddressRotation response")
+	})
+
+	s.Run("approved", func() {
+		s.ctx = s.ctx.WithEventManager(sdk.NewEventManager())
+		_, err := s.msgServer.ApproveAddressRotation(s.ctx, types.NewMsgApproveAddressRotationRequest(newOwner, s.owner1))
+		s.Require().NoError(err, "ApproveAddressRotation")
+		s.Assert().True(s.app.NameKeeper.HasAddressRotationApproval(s.ctx, s.owner1Addr, newAddr), "HasAddressRotationApproval")
+		expEvent := types.NewEventAddressRotationApproved(s.owner1, newOwner)
+		s.Assert().True(s.containsMessage(s.ctx.EventManager().ABCIEvents(), expEvent), "expected event: %v", expEvent)
+	})
+
+	s.Run("rotated", func() {
+		s.ctx = s.ctx.WithEventManager(sdk.NewEventManager())
+		resp, err := s.msgServer.RotateAddress(s.ctx, types.NewMsgRotateAddressRequest(s.owner1, newOwner))
+		s.Require().NoError(err, "RotateAddress")
+		s.Assert().ElementsMatch([]string{"name", "example.name"}, resp.Names, "RotateAddress response names")
+		s.Assert().False(s.app.NameKeeper.HasAddressRotationApproval(s.ctx, s.owner1Addr, newAddr), "HasAddressRotationApproval after rotation")
+
+		for _, name := range []string{"name", "example.name"} {
+			s.Assert().True(s.app.NameKeeper.ResolvesTo(s.ctx, name, newAddr), "%q resolves to the new address", name)
+		}
+		s.Assert().True(s.app.NameKeeper.ResolvesTo(s.ctx, "attr.name", s.owner2Addr), "attr.name still resolves to owner2")
+
+		// The attestation by owner2 stays on the old account; only the old account's own attribute is moved.
+		oldAttrs, err := s.app.AttributeKeeper.GetAllAttributes(s.ctx, s.owner1)
+		s.Require().NoError(err, "GetAllAttributes(owner1)")
+		if s.Assert().Len(oldAttrs, 1, "attributes on old account") {
+			s.Assert().Equal("attr.name", oldAttrs[0].Name, "old account attribute name")
+			s.Assert().Equal([]byte("owner2 value"), oldAttrs[0].Value, "old account attribute value")
+		}
+		newAttrs, err := s.app.AttributeKeeper.GetAllAttributes(s.ctx, newOwner)
+		s.Require().NoError(err, "GetAllAttributes(new)")
+		if s.Assert().Len(newAttrs, 1, "attributes on new account") {
+			s.Assert().Equal("example.name", newAttrs[0].Name, "new account attribute name")
+			s.Assert().Equal([]byte("owner1 value"), newAttrs[0].Value, "new account attribute value")
+		}
+
+		m, err := s.app.MarkerKeeper.GetMarkerByDenom(s.ctx, "rotatecoin")
+		s.Require().NoError(err, "GetMarkerByDenom")
+		s.Assert().Equal(newAddr, m.GetManager(), "marker manager")
+		s.Assert().False(m.AddressHasAccess(s.owner1Addr, markertypes.Access_Admin), "old address has admin")
+		s.Assert().True(m.AddressHasAccess(newAddr, markertypes.Access_Admin), "new address has admin")
+		s.Assert().True(m.AddressHasAccess(newAddr, markertypes.Access_Mint), "new address has mint")
+		s.Assert().True(m.AddressHasAccess(s.owner2Addr, markertypes.Access_Burn), "owner2 still has burn")
+
+		expEvent := types.NewEventAddressRotated(s.owner1, newOwner, resp.Names)
+		s.Assert().True(s.containsMessage(s.ctx.EventManager().ABCIEvents(), expEvent), "expected event: %v", expEvent)
+	})
+
+	s.Run("approval is used up", func() {
+		_, err := s.msgServer.RotateAddress(s.ctx, types.NewMsgRotateAddressRequest(s.owner1, newOwner))
+		s.Require().EqualError(err, newOwner+" has not approved the rotation of "+s.owner1+" to it: unauthorized", "second RotateAddress error")
+	})
+}
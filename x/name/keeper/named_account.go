@@ -0,0 +1,45 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/provenance-io/provenance/x/name/types"
+)
+
+// CreateNamedAccount binds a name to an account whose address is derived from the name, creating the account if needed.
+// The name is bound on behalf of the owner, so the parent name rules of BindNameRecord apply, and the owner pays any bind
+// name fee. The derived address is returned.
+func (k Keeper) CreateNamedAccount(ctx sdk.Context, name string, restrict bool, owner sdk.AccAddress) (sdk.AccAddress, error) {
+	var err error
+	if name, err = k.Normalize(ctx, name); err != nil {
+		return nil, err
+	}
+	if k.NameExists(ctx, name) {
+		return nil, types.ErrNameAlreadyBound.Wrapf("%q", name)
+	}
+
+	addr := types.GetNamedAccountAddress(name)
+	// The account might already exist if funds were sent to the address before the name was bound, or if the name
+	// was bound before and then deleted. That's okay as long as it's a plain account that no one has a key for.
+	if acc := k.authKeeper.GetAccount(ctx, addr); acc != nil {
+		if _, isBase := acc.(*authtypes.BaseAccount); !isBase || acc.GetPubKey() != nil {
+			return nil, types.ErrNamedAccountExists.Wrapf("%s for %q", addr, name)
+		}
+	} else {
+		k.authKeeper.SetAccount(ctx, k.authKeeper.NewAccountWithAddress(ctx, addr))
+	}
+
+	bindingParams := k.GetBindingParams(ctx, name)
+	if err = k.BindNameRecord(ctx, name, addr, restrict || bindingParams.RestrictNewNames, owner); err != nil {
+		return nil, err
+	}
+	if err = k.ChargeBindNameFee(ctx, bindingParams, owner); err != nil {
+		return nil, err
+	}
+
+	if err = ctx.EventManager().EmitTypedEvent(types.NewEventNamedAccountCreated(name, addr.String(), owner.String())); err != nil {
+		return nil, err
+	}
+	return addr, nil
+}
@@ -0,0 +1,72 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
+
+	namekeeper "github.com/provenance-io/provenance/x/name/keeper"
+	nametypes "github.com/provenance-io/provenance/x/name/types"
+)
+
+func (s *KeeperTestSuite) TestCreateNamedAccount() {
+	ctx, _ := s.ctx.CacheContext()
+	msgServer := namekeeper.NewMsgServerImpl(s.app.NameKeeper)
+	create := func(name string, owner string) (*nametypes.MsgCreateNamedAccountResponse, error) {
+		return msgServer.CreateNamedAccount(ctx, nametypes.NewMsgCreateNamedAccountRequest(name, true, owner))
+	}
+	expAddr := nametypes.GetNamedAccountAddress("treasury.example.name")
+
+	s.Run("created", func() {
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+		resp, err := create("Treasury.Example.Name", s.user2)
+		s.Require().NoError(err, "CreateNamedAccount")
+		s.Assert().Equal(expAddr.String(), resp.Address, "CreateNamedAccount address")
+
+		record, err := s.app.NameKeeper.GetRecordByName(ctx, "treasury.example.name")
+		s.Require().NoError(err, "GetRecordByName")
+		s.Assert().Equal(nametypes.NewNameRecord("treasury.example.name", expAddr, true), *record, "name record")
+		s.Assert().NotNil(s.app.AccountKeeper.GetAccount(ctx, expAddr), "named account")
+
+		expEvent, err := sdk.TypedEventToEvent(nametypes.NewEventNamedAccountCreated("treasury.example.name", expAddr.String(), s.user2))
+		s.Require().NoError(err, "TypedEventToEvent")
+		s.Assert().Contains(ctx.EventManager().Events(), expEvent, "events")
+	})
+
+	s.Run("name already bound", func() {
+		_, err := create("treasury.example.name", s.user2)
+		s.Assert().ErrorIs(err, nametypes.ErrNameAlreadyBound, "CreateNamedAccount")
+	})
+
+	s.Run("deleted and recreated", func() {
+		s.Require().NoError(s.app.NameKeeper.DeleteRecord(ctx, "treasury.example.name"), "DeleteRecord")
+		resp, err := create("treasury.example.name", s.user2)
+		s.Require().NoError(err, "CreateNamedAccount")
+		s.Assert().Equal(expAddr.String(), resp.Address, "CreateNamedAccount address")
+	})
+
+	s.Run("funds sent to named account", func() {
+		coins := sdk.NewCoins(sdk.NewInt64Coin("nhash", 1))
+		s.Require().NoError(testutil.FundAccount(ctx, s.app.BankKeeper, s.user1Addr, coins), "FundAccount")
+		err := s.app.BankKeeper.SendCoins(ctx, s.user1Addr, expAddr, coins)
+		s.Assert().ErrorIs(err, nametypes.ErrNamedAccountSend, "SendCoins to named account")
+		s.Assert().ErrorContains(err, `it is the named account of "treasury.example.name"`, "SendCoins to named account")
+	})
+
+	s.Run("restricted parent", func() {
+		s.Require().NoError(s.app.NameKeeper.SetNameRecord(ctx, "locked.name", s.user1Addr, true), "SetNameRecord(locked.name)")
+		_, err := create("svc.locked.name", s.user2)
+		s.Assert().ErrorIs(err, nametypes.ErrParentNameRestricted, "CreateNamedAccount by someone else")
+		_, err = create("svc.locked.name", s.user1)
+		s.Assert().NoError(err, "CreateNamedAccount by the parent's owner")
+	})
+
+	s.Run("address used by another kind of account", func() {
+		addr := nametypes.GetNamedAccountAddress("taken.example.name")
+		modAcc := authtypes.NewModuleAccount(authtypes.NewBaseAccountWithAddress(addr), "taken")
+		s.app.AccountKeeper.SetAccount(ctx, s.app.AccountKeeper.NewAccount(ctx, modAcc))
+		_, err := create("taken.example.name", s.user2)
+		s.Assert().ErrorIs(err, nametypes.ErrNamedAccountExists, "CreateNamedAccount")
+		s.Assert().False(s.app.NameKeeper.NameExists(ctx, "taken.example.name"), "NameExists")
+	})
+}
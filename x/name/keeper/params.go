@@ -1,6 +1,9 @@
 package keeper
 
 import (
+	"fmt"
+	"strings"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/provenance-io/provenance/x/name/types"
@@ -44,3 +47,35 @@ func (k Keeper) GetMinSegmentLength(ctx sdk.Context) uint32 {
 func (k Keeper) GetAllowUnrestrictedNames(ctx sdk.Context) bool {
 	return k.GetParams(ctx).AllowUnrestrictedNames
 }
+
+// maxInvalidNamesListed is the maximum number of names listed in the error from ValidateParamsChange.
+const maxInvalidNamesListed = 10
+
+// ValidateParamsChange returns an error if any of the names in state would not be valid under the provided params.
+// It also returns an error if either of the contract name policies is unknown, or the binding params are invalid.
+func (k Keeper) ValidateParamsChange(ctx sdk.Context, params types.Params) error {
+	if err := params.ValidateContractNamePolicies(); err != nil {
+		return err
+	}
+	if err := params.ValidateBindingParams(); err != nil {
+		return err
+	}
+	var invalid []string
+	count := 0
+	err := k.IterateRecords(ctx, types.NameKeyPrefix, func(record types.NameRecord) error {
+		if nameErr := params.ValidateName(record.Name); nameErr != nil {
+			count++
+			if len(invalid) < maxInvalidNamesListed {
+				invalid = append(invalid, fmt.Sprintf("%q (%v)", record.Name, nameErr))
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("could not check existing names: %w", err)
+	}
+	if count > 0 {
+		return fmt.Errorf("%d existing name(s) would be invalid with the new params, including: %s", count, strings.Join(invalid, ", "))
+	}
+	return nil
+}
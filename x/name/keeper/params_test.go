@@ -55,3 +55,63 @@ func (s *NameParamTestSuite) TestGetSetParams() {
 	s.Require().Equal(newMinSegmentLength, updatedParams.MinSegmentLength, "Updated MinSegmentLength should match")
 	s.Require().Equal(newAllowUnrestrictedNames, updatedParams.AllowUnrestrictedNames, "Updated AllowUnrestrictedNames should match")
 }
+
+func (s *NameParamTestSuite) TestValidateParamsChange() {
+	addr := sdk.AccAddress("addr________________")
+	s.Require().NoError(s.app.NameKeeper.SetNameRecord(s.ctx, "pb", addr, false), "SetNameRecord(pb)")
+	s.Require().NoError(s.app.NameKeeper.SetNameRecord(s.ctx, "longersegment.pb", addr, false), "SetNameRecord(longersegment.pb)")
+	s.Require().NoError(s.app.NameKeeper.SetNameRecord(s.ctx, "aa.bb.cc.pb", addr, false), "SetNameRecord(aa.bb.cc.pb)")
+	s.Require().NoError(s.app.NameKeeper.SetNameRecord(s.ctx, "6b4d3f6b-a7c5-4bfc-8a64-7e8a5b6f6c31.pb", addr, false), "SetNameRecord(uuid.pb)")
+	s.Require().NoError(s.app.NameKeeper.SetNameRecord(s.ctx, "91978ba2-5f35-459a-86a7-feca1b0512e0.6b4d3f6b-a7c5-4bfc-8a64-7e8a5b6f6c31.pb", addr, false), "SetNameRecord(uuid.uuid.pb)")
+
+	params := s.app.NameKeeper.GetParams(s.ctx)
+	s.Assert().NoError(s.app.NameKeeper.ValidateParamsChange(s.ctx, params), "ValidateParamsChange current params")
+
+	tests := []struct {
+		name   string
+		modify func(p *types.Params)
+		expErr string
+	}{
+		{
+			name:   "allow unrestricted names off",
+			modify: func(p *types.Params) { p.AllowUnrestrictedNames = false },
+		},
+		{
+			name:   "max segment length lowered below uuid length",
+			modify: func(p *types.Params) { p.MaxSegmentLength = 13 },
+		},
+		{
+			name:   "max segment length too low",
+			modify: func(p *types.Params) { p.MaxSegmentLength = 12 },
+			expErr: `1 existing name(s) would be invalid with the new params, including: "longersegment.pb" (segment of name is too long)`,
+		},
+		{
+			name:   "max name levels too low",
+			modify: func(p *types.Params) { p.MaxNameLevels = 3 },
+			expErr: `1 existing name(s) would be invalid with the new params, including: "aa.bb.cc.pb" (name has too many segments)`,
+		},
+		{
+			name:   "max uuid segments high enough",
+			modify: func(p *types.Params) { p.MaxUuidSegments = 2 },
+		},
+		{
+			name:   "max uuid segments too low",
+			modify: func(p *types.Params) { p.MaxUuidSegments = 1 },
+			expErr: `1 existing name(s) would be invalid with the new params, including: ` +
+				`"91978ba2-5f35-459a-86a7-feca1b0512e0.6b4d3f6b-a7c5-4bfc-8a64-7e8a5b6f6c31.pb" (name has too many uuid segments)`,
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			newParams := params
+			tc.modify(&newParams)
+			err := s.app.NameKeeper.ValidateParamsChange(s.ctx, newParams)
+			if len(tc.expErr) > 0 {
+				s.Assert().EqualError(err, tc.expErr, "ValidateParamsChange")
+			} else {
+				s.Assert().NoError(err, "ValidateParamsChange")
+			}
+		})
+	}
+}
@@ -0,0 +1,38 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/name/types"
+)
+
+// VerifyParentApproval checks that the approval was signed by the owner of the parent record for binding the (full,
+// normalized) name for the requestor on this chain, and that it has not expired.
+func (k Keeper) VerifyParentApproval(
+	ctx sdk.Context,
+	parent types.NameRecord,
+	name string,
+	requestor sdk.AccAddress,
+	approval *types.ParentApproval,
+) error {
+	if approval == nil {
+		return fmt.Errorf("parent approval cannot be nil")
+	}
+	if !ctx.BlockTime().Before(approval.Expiration) {
+		return fmt.Errorf("parent approval expired at %s", approval.Expiration.UTC())
+	}
+	pubKey, err := approval.UnpackPubKey()
+	if err != nil {
+		return fmt.Errorf("invalid parent approval: %w", err)
+	}
+	if signer := sdk.AccAddress(pubKey.Address()); signer.String() != parent.Address {
+		return fmt.Errorf("parent approval signer %s is not the owner of parent name %q", signer, parent.Name)
+	}
+	signDoc := types.NewParentApprovalSignDoc(ctx.ChainID(), name, requestor.String(), approval.Expiration)
+	if !pubKey.VerifySignature(signDoc.GetSignBytes(), approval.Signature) {
+		return fmt.Errorf("parent approval signature is not valid for binding %q for %s", name, requestor)
+	}
+	return nil
+}
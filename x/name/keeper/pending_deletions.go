@@ -0,0 +1,194 @@
+package keeper
+
+import (
+	"fmt"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/name/types"
+)
+
+// GetPendingDeletion returns the pending deletion of the provided name, or nil if the name is not pending deletion.
+func (k Keeper) GetPendingDeletion(ctx sdk.Context, name string) (*types.PendingNameDeletion, error) {
+	key, err := types.GetPendingDeletionKey(name)
+	if err != nil {
+		return nil, err
+	}
+	return k.getPendingDeletion(ctx.KVStore(k.storeKey), key)
+}
+
+// getPendingDeletion reads the pending deletion with the provided key, returning nil if it doesn't exist.
+func (k Keeper) getPendingDeletion(store storetypes.KVStore, key []byte) (*types.PendingNameDeletion, error) {
+	bz := store.Get(key)
+	if len(bz) == 0 {
+		return nil, nil
+	}
+	var pending types.PendingNameDeletion
+	if err := k.cdc.Unmarshal(bz, &pending); err != nil {
+		return nil, err
+	}
+	return &pending, nil
+}
+
+// IsPendingDeletion returns true if the provided name has been deleted but not yet removed.
+func (k Keeper) IsPendingDeletion(ctx sdk.Context, name string) bool {
+	key, err := types.GetPendingDeletionKey(name)
+	if err != nil {
+		return false
+	}
+	return ctx.KVStore(k.storeKey).Has(key)
+}
+
+// AddPendingDeletion marks a bound name to be removed at the end of the provided delete height.
+func (k Keeper) AddPendingDeletion(ctx sdk.Context, name string, address sdk.AccAddress, deleteHeight int64) error {
+	if !k.NameExists(ctx, name) {
+		return types.ErrNameNotBound.Wrapf("%q", name)
+	}
+	if k.IsPendingDeletion(ctx, name) {
+		return types.ErrNamePendingDeletion.Wrapf("%q", name)
+	}
+	pending := types.NewPendingNameDeletion(name, address.String(), deleteHeight)
+	if err := k.SetPendingDeletion(ctx, pending); err != nil {
+		return err
+	}
+	return ctx.EventManager().EmitTypedEvent(types.NewEventNamePendingDeletion(pending))
+}
+
+// SetPendingDeletion stores a pending deletion and indexes it by its delete height.
+func (k Keeper) SetPendingDeletion(ctx sdk.Context, pending types.PendingNameDeletion) error {
+	if err := pending.Validate(); err != nil {
+		return err
+	}
+	key, err := types.GetPendingDeletionKey(pending.Name)
+	if err != nil {
+		return err
+	}
+	heightKey, err := types.GetPendingDeletionHeightKey(pending.DeleteHeight, pending.Name)
+	if err != nil {
+		return err
+	}
+	bz, err := k.cdc.Marshal(&pending)
+	if err != nil {
+		return err
+	}
+	store := ctx.KVStore(k.storeKey)
+	store.Set(key, bz)
+	store.Set(heightKey, []byte{})
+	return nil
+}
+
+// deletePendingDeletion removes the pending deletion of the provided name (if there is one).
+func (k Keeper) deletePendingDeletion(store storetypes.KVStore, name string) error {
+	key, err := types.GetPendingDeletionKey(name)
+	if err != nil {
+		return err
+	}
+	pending, err := k.getPendingDeletion(store, key)
+	if err != nil || pending == nil {
+		return err
+	}
+	heightKey, err := types.GetPendingDeletionHeightKey(pending.DeleteHeight, name)
+	if err != nil {
+		return err
+	}
+	store.Delete(key)
+	store.Delete(heightKey)
+	return nil
+}
+
+// IteratePendingDeletions calls handle with each pending deletion, ordered by delete height, until handle returns true.
+func (k Keeper) IteratePendingDeletions(ctx sdk.Context, handle func(pending types.PendingNameDeletion) (stop bool)) error {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.PendingDeletionHeightKeyPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		pending, err := k.getPendingDeletionFromHeightKey(store, iterator.Key())
+		if err != nil {
+			return err
+		}
+		if handle(*pending) {
+			break
+		}
+	}
+	return nil
+}
+
+// getPendingDeletionFromHeightKey reads the pending deletion referenced by the provided pending deletion height key.
+func (k Keeper) getPendingDeletionFromHeightKey(store storetypes.KVStore, heightKey []byte) (*types.PendingNameDeletion, error) {
+	key, err := types.ParsePendingDeletionHeightKey(heightKey)
+	if err != nil {
+		return nil, err
+	}
+	pending, err := k.getPendingDeletion(store, key)
+	if err != nil {
+		return nil, err
+	}
+	if pending == nil {
+		return nil, fmt.Errorf("no pending deletion found for height key %X", heightKey)
+	}
+	return pending, nil
+}
+
+// RemoveDuePendingDeletions removes the names (and their attributes) that are pending deletion with a delete height
+// at or before the current block height.
+func (k Keeper) RemoveDuePendingDeletions(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	var due []types.PendingNameDeletion
+	iterator := store.Iterator(types.PendingDeletionHeightKeyPrefix, types.GetPendingDeletionHeightKeyPrefix(ctx.BlockHeight()+1))
+	for ; iterator.Valid(); iterator.Next() {
+		pending, err := k.getPendingDeletionFromHeightKey(store, iterator.Key())
+		if err != nil {
+			k.Logger(ctx).Error("could not read pending name deletion", "key", fmt.Sprintf("%X", iterator.Key()), "error", err)
+			continue
+		}
+		due = append(due, *pending)
+	}
+	iterator.Close()
+
+	for _, pending := range due {
+		if err := k.removePendingDeletion(ctx, pending); err != nil {
+			k.Logger(ctx).Error("could not remove name pending deletion", "name", pending.Name, "error", err)
+			// Don't try again next block.
+			if delErr := k.deletePendingDeletion(store, pending.Name); delErr != nil {
+				k.Logger(ctx).Error("could not clear pending name deletion", "name", pending.Name, "error", delErr)
+			}
+		}
+	}
+}
+
+// removePendingDeletion removes a name that is pending deletion, and all attributes with that name.
+func (k Keeper) removePendingDeletion(ctx sdk.Context, pending types.PendingNameDeletion) error {
+	record, err := k.GetRecordByName(ctx, pending.Name)
+	if err != nil {
+		return err
+	}
+	owner, err := sdk.AccAddressFromBech32(record.Address)
+	if err != nil {
+		return err
+	}
+	// Use a cache context so that the name isn't removed unless its attributes are too.
+	cacheCtx, writeCache := ctx.CacheContext()
+	if err = k.DeleteRecord(cacheCtx, pending.Name); err != nil {
+		return err
+	}
+	if err = k.attrKeeper.PurgeAttribute(cacheCtx, pending.Name, owner); err != nil {
+		return fmt.Errorf("could not delete %q attributes: %w", pending.Name, err)
+	}
+	writeCache()
+	return nil
+}
+
+// ResolveRecord returns the record bound to the provided name.
+// Names that are pending deletion can only be resolved if allowed by the resolve_pending_deletions param.
+func (k Keeper) ResolveRecord(ctx sdk.Context, name string) (*types.NameRecord, error) {
+	record, err := k.GetRecordByName(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	if k.IsPendingDeletion(ctx, record.Name) && !k.GetParams(ctx).ResolvePendingDeletions {
+		return nil, types.ErrNamePendingDeletion.Wrapf("%q", record.Name)
+	}
+	return record, nil
+}
@@ -2,16 +2,32 @@ package keeper
 
 import (
 	"context"
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"cosmossdk.io/store/prefix"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
 
+	"github.com/provenance-io/provenance/internal/provutils"
 	"github.com/provenance-io/provenance/x/name/types"
 )
 
-var _ types.QueryServer = Keeper{}
+// queryServer is the name module's QueryServer.
+// Most queries are defined directly on the Keeper, but some (e.g. Normalize) conflict with Keeper methods.
+type queryServer struct {
+	Keeper
+}
+
+// NewQueryServerImpl returns an implementation of the name QueryServer interface for the provided Keeper.
+func NewQueryServerImpl(keeper Keeper) types.QueryServer {
+	return &queryServer{Keeper: keeper}
+}
+
+var _ types.QueryServer = queryServer{}
 
 // Params queries params of distribution module
 func (k Keeper) Params(c context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
@@ -22,18 +38,53 @@ func (k Keeper) Params(c context.Context, _ *types.QueryParamsRequest) (*types.Q
 // Resolve returns the address a name resolves to or an error.
 func (k Keeper) Resolve(c context.Context, request *types.QueryResolveRequest) (*types.QueryResolveResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
-	name, err := k.Normalize(ctx, request.Name)
+	record, err := k.resolveName(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryResolveResponse{Address: record.Address, Restricted: record.Restricted}, nil
+}
+
+// ResolveMany returns the addresses that several names resolve to.
+// A name that cannot be resolved has its error in its result instead of failing the whole query.
+func (k Keeper) ResolveMany(c context.Context, request *types.QueryResolveManyRequest) (*types.QueryResolveManyResponse, error) {
+	if request == nil || len(request.Names) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "at least one name is required")
+	}
+	if len(request.Names) > types.MaxResolveManyNames {
+		return nil, status.Errorf(codes.InvalidArgument, "cannot resolve more than %d names at once, got %d",
+			types.MaxResolveManyNames, len(request.Names))
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	resp := &types.QueryResolveManyResponse{Results: make([]types.ResolveResult, len(request.Names))}
+	for i, name := range request.Names {
+		resp.Results[i].Name = name
+		record, err := k.resolveName(ctx, name)
+		if err != nil {
+			resp.Results[i].Error = err.Error()
+			continue
+		}
+		resp.Results[i].Address = record.Address
+		resp.Results[i].Restricted = record.Restricted
+	}
+	return resp, nil
+}
+
+// resolveName normalizes the provided name and returns the record it is bound to.
+func (k Keeper) resolveName(ctx sdk.Context, name string) (*types.NameRecord, error) {
+	name, err := k.Normalize(ctx, name)
 	if err != nil {
 		return nil, err
 	}
-	record, err := k.GetRecordByName(ctx, name)
+	record, err := k.ResolveRecord(ctx, name)
 	if err != nil {
 		return nil, err
 	}
 	if record == nil {
 		return nil, types.ErrNameNotBound
 	}
-	return &types.QueryResolveResponse{Address: record.Address, Restricted: record.Restricted}, nil
+	return record, nil
 }
 
 // ReverseLookup gets all names bound to an address.
@@ -49,10 +100,17 @@ func (k Keeper) ReverseLookup(c context.Context, request *types.QueryReverseLook
 	if err != nil {
 		return nil, types.ErrInvalidAddress
 	}
+	params := k.GetParams(ctx)
+	pageReq, truncated := provutils.LimitPageRequest(request.Pagination, params.MaxQueryResults)
 	nameStore := prefix.NewStore(store, key)
-	pageRes, err := query.FilteredPaginate(nameStore, request.Pagination, func(_ []byte, value []byte, accumulate bool) (bool, error) {
+	// The rest of each address index key is the key of the name record.
+	pageRes, err := query.FilteredPaginate(nameStore, pageReq, func(nameKey []byte, _ []byte, accumulate bool) (bool, error) {
+		bz := store.Get(nameKey)
+		if bz == nil {
+			return false, nil
+		}
 		var record types.NameRecord
-		err = k.cdc.Unmarshal(value, &record)
+		err = k.cdc.Unmarshal(bz, &record)
 		if err != nil {
 			return false, err
 		}
@@ -69,5 +127,207 @@ func (k Keeper) ReverseLookup(c context.Context, request *types.QueryReverseLook
 		return nil, err
 	}
 
-	return &types.QueryReverseLookupResponse{Name: names, Pagination: pageRes}, nil
+	resp := &types.QueryReverseLookupResponse{Name: names, Pagination: pageRes, Truncated: truncated}
+	if err = provutils.ValidateQueryResponseSize(resp, params.MaxQueryResponseBytes); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// NameStats returns the number of bound names, in total, by restriction, and under each root name.
+func (k Keeper) NameStats(c context.Context, _ *types.QueryNameStatsRequest) (*types.QueryNameStatsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	resp := &types.QueryNameStatsResponse{
+		Total:      k.GetNameCount(ctx),
+		Restricted: k.GetRestrictedNameCount(ctx),
+	}
+	resp.Unrestricted = resp.Total - resp.Restricted
+	k.IterateRootNameCounts(ctx, func(root string, count uint64) bool {
+		resp.Roots = append(resp.Roots, types.RootNameCount{Root: root, Count: count})
+		return false
+	})
+	return resp, nil
+}
+
+// PendingDeletions returns the names that have been deleted, but have not yet been removed.
+func (k Keeper) PendingDeletions(c context.Context, request *types.QueryPendingDeletionsRequest) (*types.QueryPendingDeletionsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	var pagination *query.PageRequest
+	if request != nil {
+		pagination = request.Pagination
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	heightStore := prefix.NewStore(store, types.PendingDeletionHeightKeyPrefix)
+	resp := &types.QueryPendingDeletionsResponse{}
+	var err error
+	resp.Pagination, err = query.Paginate(heightStore, pagination, func(key []byte, _ []byte) error {
+		heightKey := append(append([]byte{}, types.PendingDeletionHeightKeyPrefix...), key...)
+		pending, pErr := k.getPendingDeletionFromHeightKey(store, heightKey)
+		if pErr != nil {
+			return pErr
+		}
+		resp.PendingDeletions = append(resp.PendingDeletions, *pending)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return resp, nil
+}
+
+// NamesByUUID returns the names that contain a UUID as one of their segments.
+func (k Keeper) NamesByUUID(c context.Context, request *types.QueryNamesByUUIDRequest) (*types.QueryNamesByUUIDResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	keyPrefix, err := types.GetUUIDNameKeyPrefix(request.Uuid)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	params := k.GetParams(ctx)
+	pageReq, truncated := provutils.LimitPageRequest(request.Pagination, params.MaxQueryResults)
+	uuidStore := prefix.NewStore(ctx.KVStore(k.storeKey), keyPrefix)
+	resp := &types.QueryNamesByUUIDResponse{Truncated: truncated}
+	resp.Pagination, err = query.Paginate(uuidStore, pageReq, func(key []byte, _ []byte) error {
+		record, rErr := k.getUUIDNameRecord(ctx, key)
+		if rErr != nil {
+			return rErr
+		}
+		resp.Names = append(resp.Names, record.Name)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	if err = provutils.ValidateQueryResponseSize(resp, params.MaxQueryResponseBytes); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// ZoneFile renders a name and the names under it as an RFC 1035 zone file.
+func (k Keeper) ZoneFile(c context.Context, request *types.QueryZoneFileRequest) (*types.QueryZoneFileResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if err := types.ValidateZoneDomain(request.Domain); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	resp, err := k.ExportZoneFile(ctx, request.Name, request.Domain, request.Ttl)
+	if err != nil {
+		return nil, err
+	}
+	if err = provutils.ValidateQueryResponseSize(resp, k.GetParams(ctx).MaxQueryResponseBytes); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// Normalize returns the normalized form of a name and all the naming rules it violates.
+func (qs queryServer) Normalize(c context.Context, request *types.QueryNormalizeRequest) (*types.QueryNormalizeResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	ctx.GasMeter().ConsumeGas(types.NormalizeNameGas(request.Name), "name normalization")
+	resp := &types.QueryNormalizeResponse{Normalized: types.NormalizeName(request.Name)}
+	resp.Violations = qs.GetParams(ctx).NameViolations(resp.Normalized)
+	resp.Valid = len(resp.Violations) == 0
+	return resp, nil
+}
+
+// NamesCreated returns the names that were bound within a range of block heights.
+func (k Keeper) NamesCreated(c context.Context, request *types.QueryNamesCreatedRequest) (*types.QueryNamesCreatedResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if request.StartHeight < 0 || request.EndHeight < 0 {
+		return nil, status.Error(codes.InvalidArgument, "heights cannot be negative")
+	}
+	if request.EndHeight != 0 && request.EndHeight < request.StartHeight {
+		return nil, status.Errorf(codes.InvalidArgument, "end height %d cannot be less than start height %d", request.EndHeight, request.StartHeight)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	params := k.GetParams(ctx)
+	pageReq, truncated := provutils.LimitPageRequest(request.Pagination, params.MaxQueryResults)
+	heightStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.NameCreationHeightKeyPrefix)
+	resp := &types.QueryNamesCreatedResponse{Truncated: truncated}
+	var err error
+	// The index only has the names bound within the retention window, so it's small enough to filter instead of seek.
+	resp.Pagination, err = query.FilteredPaginate(heightStore, pageReq, func(key []byte, _ []byte, accumulate bool) (bool, error) {
+		heightKey := append(append([]byte{}, types.NameCreationHeightKeyPrefix...), key...)
+		height, nameKey, pErr := types.ParseNameCreationHeightKey(heightKey)
+		if pErr != nil {
+			return false, pErr
+		}
+		if height < request.StartHeight || (request.EndHeight != 0 && height > request.EndHeight) {
+			return false, nil
+		}
+		if accumulate {
+			record, rErr := getNameRecord(ctx, k, nameKey)
+			if rErr != nil {
+				return false, fmt.Errorf("could not get name record indexed by creation height: %w", rErr)
+			}
+			resp.Names = append(resp.Names, types.CreatedName{Name: record.Name, Address: record.Address, Height: height})
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	if err = provutils.ValidateQueryResponseSize(resp, params.MaxQueryResponseBytes); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// OwnershipChallenge returns the payload that a name's owner signs to prove (off-chain) that they own it.
+func (k Keeper) OwnershipChallenge(c context.Context, request *types.QueryOwnershipChallengeRequest) (*types.QueryOwnershipChallengeResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if err := types.ValidateOwnershipChallengeNonce(request.Nonce); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	record, err := k.resolveName(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+	challenge := types.NewNameOwnershipChallenge(record.Name, record.Address, ctx.ChainID(), ctx.BlockHeight(), request.Nonce)
+	return &types.QueryOwnershipChallengeResponse{Challenge: challenge, SignBytes: challenge.GetSignBytes()}, nil
+}
+
+// ChildQuota returns the child quota of a name and how much of it is used.
+func (k Keeper) ChildQuota(c context.Context, request *types.QueryChildQuotaRequest) (*types.QueryChildQuotaResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	record, err := k.resolveName(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+	resp := &types.QueryChildQuotaResponse{Name: record.Name}
+	if resp.MaxChildren, err = k.GetChildQuota(ctx, record.Name); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if resp.ChildCount, err = k.GetChildNameCount(ctx, record.Name); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if resp.MaxChildren > resp.ChildCount {
+		resp.Remaining = resp.MaxChildren - resp.ChildCount
+	}
+	return resp, nil
 }
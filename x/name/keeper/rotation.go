@@ -0,0 +1,109 @@
+package keeper
+
+import (
+	"fmt"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/provenance-io/provenance/x/name/types"
+)
+
+// SetAddressRotators sets the modules that get to move their state during an address rotation.
+// They are called in the order provided, after the names have been re-bound.
+func (k *Keeper) SetAddressRotators(rotators ...types.AddressRotator) {
+	if k.rotators != nil && rotators != nil {
+		panic("the address rotators have already been set")
+	}
+	k.rotators = rotators
+}
+
+// HasAddressRotationApproval returns true if the new address has approved the rotation of the old address to it.
+func (k Keeper) HasAddressRotationApproval(ctx sdk.Context, oldAddr, newAddr sdk.AccAddress) bool {
+	return ctx.KVStore(k.storeKey).Has(types.GetAddressRotationApprovalKey(oldAddr, newAddr))
+}
+
+// ApproveAddressRotation records the new address's approval to rotate the old address to it.
+func (k Keeper) ApproveAddressRotation(ctx sdk.Context, oldAddr, newAddr sdk.AccAddress) error {
+	if oldAddr.Equals(newAddr) {
+		return fmt.Errorf("old address and new address cannot be the same: %s", oldAddr)
+	}
+	ctx.KVStore(k.storeKey).Set(types.GetAddressRotationApprovalKey(oldAddr, newAddr), []byte{})
+	return ctx.EventManager().EmitTypedEvent(types.NewEventAddressRotationApproved(oldAddr.String(), newAddr.String()))
+}
+
+// IterateAddressRotationApprovals calls handle with each address rotation approval until handle returns true.
+func (k Keeper) IterateAddressRotationApprovals(ctx sdk.Context, handle func(approval types.AddressRotationApproval) (stop bool)) error {
+	iterator := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.AddressRotationApprovalKeyPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		oldAddr, newAddr, err := types.ParseAddressRotationApprovalKey(iterator.Key())
+		if err != nil {
+			return err
+		}
+		if handle(types.AddressRotationApproval{OldAddress: oldAddr.String(), NewAddress: newAddr.String()}) {
+			break
+		}
+	}
+	return nil
+}
+
+// getAllAddressRotationApprovals returns all of the address rotation approvals, ordered by old address.
+func (k Keeper) getAllAddressRotationApprovals(ctx sdk.Context) []types.AddressRotationApproval {
+	approvals := []types.AddressRotationApproval{}
+	err := k.IterateAddressRotationApprovals(ctx, func(approval types.AddressRotationApproval) bool {
+		approvals = append(approvals, approval)
+		return false
+	})
+	if err != nil {
+		panic(err)
+	}
+	return approvals
+}
+
+// RotateAddress moves everything tied to the old address to the new address.
+// The new address must have approved the rotation (see ApproveAddressRotation); that approval is used up.
+// All names bound to the old address are re-bound to the new address, keeping their restricted flags.
+// Names that are pending deletion are left alone. Then each of the address rotators is given
+// a chance to move its state. If any part fails, an error is returned and the rotation should be abandoned.
+// The names that were re-bound are returned.
+func (k Keeper) RotateAddress(ctx sdk.Context, oldAddr, newAddr sdk.AccAddress) ([]string, error) {
+	if oldAddr.Equals(newAddr) {
+		return nil, fmt.Errorf("old address and new address cannot be the same: %s", oldAddr)
+	}
+	approvalKey := types.GetAddressRotationApprovalKey(oldAddr, newAddr)
+	store := ctx.KVStore(k.storeKey)
+	if !store.Has(approvalKey) {
+		return nil, sdkerrors.ErrUnauthorized.Wrapf("%s has not approved the rotation of %s to it", newAddr, oldAddr)
+	}
+	if k.bankKeeper.BlockedAddr(newAddr) {
+		return nil, sdkerrors.ErrUnauthorized.Wrapf("cannot rotate %s to blocked address %s", oldAddr, newAddr)
+	}
+	store.Delete(approvalKey)
+
+	records, err := k.GetRecordsByAddress(ctx, oldAddr)
+	if err != nil {
+		return nil, fmt.Errorf("could not get names bound to %s: %w", oldAddr, err)
+	}
+	names := make([]string, 0, len(records))
+	for _, record := range records {
+		if k.IsPendingDeletion(ctx, record.Name) {
+			continue
+		}
+		if err = k.UpdateNameRecord(ctx, record.Name, newAddr, record.Restricted, oldAddr); err != nil {
+			return nil, fmt.Errorf("could not re-bind name %q: %w", record.Name, err)
+		}
+		names = append(names, record.Name)
+	}
+
+	for _, rotator := range k.rotators {
+		if err = rotator.RotateAddress(ctx, oldAddr, newAddr); err != nil {
+			return nil, err
+		}
+	}
+
+	k.Logger(ctx).Info("rotated address", "old_address", oldAddr.String(), "new_address", newAddr.String(), "names", len(names))
+	return names, ctx.EventManager().EmitTypedEvent(types.NewEventAddressRotated(oldAddr.String(), newAddr.String(), names))
+}
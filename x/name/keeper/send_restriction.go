@@ -0,0 +1,45 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/provenance-io/provenance/x/name/types"
+)
+
+var _ banktypes.SendRestrictionFn = Keeper{}.SendRestrictionFn
+
+// SendRestrictionFn rejects funds being sent to a named account. No one has a key for a named account,
+// and there isn't a way for the name's owner to move funds out of one, so they would be stuck there.
+func (k Keeper) SendRestrictionFn(goCtx context.Context, _, toAddr sdk.AccAddress, _ sdk.Coins) (sdk.AccAddress, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if name, ok := k.getNamedAccountName(ctx, toAddr); ok {
+		return nil, types.ErrNamedAccountSend.Wrapf("cannot send to %s: it is the named account of %q", toAddr, name)
+	}
+	return toAddr, nil
+}
+
+// getNamedAccountName returns the name that the address is the named account of, and whether there is one.
+func (k Keeper) getNamedAccountName(ctx sdk.Context, addr sdk.AccAddress) (string, bool) {
+	// Named accounts are always plain accounts without a public key, so only those need their names checked.
+	acc := k.authKeeper.GetAccount(ctx, addr)
+	if acc == nil || acc.GetPubKey() != nil {
+		return "", false
+	}
+	if _, isBase := acc.(*authtypes.BaseAccount); !isBase {
+		return "", false
+	}
+	records, err := k.GetRecordsByAddress(ctx, addr)
+	if err != nil {
+		return "", false
+	}
+	for _, record := range records {
+		if types.GetNamedAccountAddress(record.Name).Equals(addr) {
+			return record.Name, true
+		}
+	}
+	return "", false
+}
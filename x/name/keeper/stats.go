@@ -0,0 +1,118 @@
+package keeper
+
+import (
+	"fmt"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/name/types"
+)
+
+// GetNameCount returns the total number of bound names.
+func (k Keeper) GetNameCount(ctx sdk.Context) uint64 {
+	return getCount(ctx.KVStore(k.storeKey), types.NameCountKey)
+}
+
+// GetRestrictedNameCount returns the number of bound names that are restricted.
+func (k Keeper) GetRestrictedNameCount(ctx sdk.Context) uint64 {
+	return getCount(ctx.KVStore(k.storeKey), types.RestrictedNameCountKey)
+}
+
+// GetRootNameCount returns the number of bound names under the provided root name.
+func (k Keeper) GetRootNameCount(ctx sdk.Context, root string) uint64 {
+	return getCount(ctx.KVStore(k.storeKey), types.GetRootNameCountKey(root))
+}
+
+// IterateRootNameCounts iterates over the number of bound names under each root name, ordered by root name.
+// The callback should return true to stop iterating.
+func (k Keeper) IterateRootNameCounts(ctx sdk.Context, handle func(root string, count uint64) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.RootNameCountKeyPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		root := string(iterator.Key()[len(types.RootNameCountKeyPrefix):])
+		if handle(root, sdk.BigEndianToUint64(iterator.Value())) {
+			break
+		}
+	}
+}
+
+// RebuildNameStats recalculates all of the name counts (including the child name counts) from the name records in state.
+func (k Keeper) RebuildNameStats(ctx sdk.Context) error {
+	store := ctx.KVStore(k.storeKey)
+
+	var countKeys [][]byte
+	for _, keyPrefix := range [][]byte{types.RootNameCountKeyPrefix, types.ChildNameCountKeyPrefix} {
+		iterator := storetypes.KVStorePrefixIterator(store, keyPrefix)
+		for ; iterator.Valid(); iterator.Next() {
+			countKeys = append(countKeys, iterator.Key())
+		}
+		iterator.Close()
+	}
+	for _, key := range countKeys {
+		store.Delete(key)
+	}
+	store.Delete(types.NameCountKey)
+	store.Delete(types.RestrictedNameCountKey)
+
+	err := k.IterateRecords(ctx, types.NameKeyPrefix, func(record types.NameRecord) error {
+		incrementNameStats(store, record.Name, record.Restricted)
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("could not rebuild name stats: %w", err)
+	}
+	return nil
+}
+
+// incrementNameStats updates the name counts for the addition of a name.
+func incrementNameStats(store storetypes.KVStore, name string, restricted bool) {
+	addToCount(store, types.NameCountKey, 1)
+	addToCount(store, types.GetRootNameCountKey(types.GetRootName(name)), 1)
+	addToChildNameCount(store, name, 1)
+	if restricted {
+		addToCount(store, types.RestrictedNameCountKey, 1)
+	}
+}
+
+// decrementNameStats updates the name counts for the removal of a name.
+func decrementNameStats(store storetypes.KVStore, name string, restricted bool) {
+	addToCount(store, types.NameCountKey, -1)
+	addToCount(store, types.GetRootNameCountKey(types.GetRootName(name)), -1)
+	addToChildNameCount(store, name, -1)
+	if restricted {
+		addToCount(store, types.RestrictedNameCountKey, -1)
+	}
+}
+
+// updateRestrictedNameStats updates the name counts for a change in a name's restricted flag.
+func updateRestrictedNameStats(store storetypes.KVStore, wasRestricted, isRestricted bool) {
+	switch {
+	case !wasRestricted && isRestricted:
+		addToCount(store, types.RestrictedNameCountKey, 1)
+	case wasRestricted && !isRestricted:
+		addToCount(store, types.RestrictedNameCountKey, -1)
+	}
+}
+
+// getCount reads the count stored at the provided key, or 0 if there isn't one.
+func getCount(store storetypes.KVStore, key []byte) uint64 {
+	bz := store.Get(key)
+	if len(bz) == 0 {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// addToCount adds the delta to the count stored at the provided key.
+// A count that would drop to (or below) zero is deleted.
+func addToCount(store storetypes.KVStore, key []byte, delta int64) {
+	count := getCount(store, key)
+	if delta < 0 && uint64(-delta) >= count {
+		store.Delete(key)
+		return
+	}
+	store.Set(key, sdk.Uint64ToBigEndian(uint64(int64(count)+delta)))
+}
@@ -0,0 +1,88 @@
+package keeper
+
+import (
+	"fmt"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/name/types"
+)
+
+// GetNamesByUUID returns the names that contain the provided UUID as one of their segments.
+func (k Keeper) GetNamesByUUID(ctx sdk.Context, uuid string) ([]string, error) {
+	keyPrefix, err := types.GetUUIDNameKeyPrefix(uuid)
+	if err != nil {
+		return nil, err
+	}
+	var rv []string
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, keyPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		record, err := k.getUUIDNameRecord(ctx, iterator.Key()[len(keyPrefix):])
+		if err != nil {
+			return nil, err
+		}
+		rv = append(rv, record.Name)
+	}
+	return rv, nil
+}
+
+// getUUIDNameRecord returns the name record with the provided name hash, taken from the end of a uuid name index key.
+func (k Keeper) getUUIDNameRecord(ctx sdk.Context, nameHash []byte) (*types.NameRecord, error) {
+	nameKey := append(append([]byte{}, types.NameKeyPrefix...), nameHash...)
+	record, err := getNameRecord(ctx, k, nameKey)
+	if err != nil {
+		return nil, fmt.Errorf("could not get name record indexed by uuid: %w", err)
+	}
+	return record, nil
+}
+
+// RebuildUUIDNameIndex recreates the uuid segment -> name index from the name records in state.
+func (k Keeper) RebuildUUIDNameIndex(ctx sdk.Context) error {
+	store := ctx.KVStore(k.storeKey)
+
+	var oldKeys [][]byte
+	iterator := storetypes.KVStorePrefixIterator(store, types.UUIDNameKeyPrefix)
+	for ; iterator.Valid(); iterator.Next() {
+		oldKeys = append(oldKeys, iterator.Key())
+	}
+	iterator.Close()
+	for _, key := range oldKeys {
+		store.Delete(key)
+	}
+
+	err := k.IterateRecords(ctx, types.NameKeyPrefix, func(record types.NameRecord) error {
+		return setUUIDNameIndex(store, record.Name)
+	})
+	if err != nil {
+		return fmt.Errorf("could not rebuild uuid name index: %w", err)
+	}
+	return nil
+}
+
+// setUUIDNameIndex adds the index entries for a name under each of its uuid segments.
+func setUUIDNameIndex(store storetypes.KVStore, name string) error {
+	for _, uuid := range types.GetUUIDSegments(name) {
+		key, err := types.GetUUIDNameKey(uuid, name)
+		if err != nil {
+			return err
+		}
+		store.Set(key, []byte{})
+	}
+	return nil
+}
+
+// deleteUUIDNameIndex removes the index entries for a name under each of its uuid segments.
+func deleteUUIDNameIndex(store storetypes.KVStore, name string) error {
+	for _, uuid := range types.GetUUIDSegments(name) {
+		key, err := types.GetUUIDNameKey(uuid, name)
+		if err != nil {
+			return err
+		}
+		store.Delete(key)
+	}
+	return nil
+}
@@ -0,0 +1,85 @@
+package keeper
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/name/types"
+)
+
+// ExportZoneFile renders a name and all the names under it as an RFC 1035 zone file.
+// The zone's origin is the name followed by the (optional) domain, and each name under it is a subdomain
+// with a TXT record containing the address it resolves to and whether it's restricted.
+// Names are listed breadth first (siblings in order), so a name always comes before the names under it.
+// At most max_query_results names are included; if there are more, truncated is true in the response.
+// Names that can't be represented in DNS (e.g. a segment too long for a label) are listed as comments.
+// No SOA or NS records are included, so the result is meant to be $INCLUDEd in an operator's own zone.
+func (k Keeper) ExportZoneFile(ctx sdk.Context, name, domain string, ttl uint32) (*types.QueryZoneFileResponse, error) {
+	record, err := k.resolveName(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	name = record.Name
+	origin, err := types.ZoneFileOrigin(name, domain)
+	if err != nil {
+		return nil, err
+	}
+	if ttl == 0 {
+		ttl = types.DefaultZoneFileTTL
+	}
+
+	maxNames := int(k.GetParams(ctx).MaxQueryResults)
+	resp := &types.QueryZoneFileResponse{}
+	toExport := []string{name}
+	for i := 0; i < len(toExport) && !resp.Truncated; i++ {
+		children, cErr := k.GetChildNames(ctx, toExport[i])
+		if cErr != nil {
+			return nil, cErr
+		}
+		sort.Strings(children)
+		if maxNames > 0 && len(toExport)+len(children) > maxNames {
+			children = children[:maxNames-len(toExport)]
+			resp.Truncated = true
+		}
+		toExport = append(toExport, children...)
+	}
+
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("; Provenance names under %q exported at block height %d.\n", name, ctx.BlockHeight()))
+	if resp.Truncated {
+		sb.WriteString(fmt.Sprintf("; Truncated to the first %d names.\n", maxNames))
+	}
+	sb.WriteString(fmt.Sprintf("$ORIGIN %s\n$TTL %d\n", origin, ttl))
+	for _, toAdd := range toExport {
+		if toAdd != name {
+			record, err = k.GetRecordByName(ctx, toAdd)
+			if err != nil {
+				return nil, fmt.Errorf("could not get name record of %q: %w", toAdd, err)
+			}
+		}
+		owner, oErr := zoneFileRelativeOwner(name, domain, toAdd)
+		if oErr != nil {
+			sb.WriteString(fmt.Sprintf("; skipped %q: %v\n", toAdd, oErr))
+			continue
+		}
+		sb.WriteString(types.ZoneFileTXTRecord(owner, *record) + "\n")
+		resp.RecordCount++
+	}
+	resp.ZoneFile = sb.String()
+	return resp, nil
+}
+
+// zoneFileRelativeOwner returns the owner name, relative to the zone's origin, to use for a name under the top name.
+// The top name itself is "@".
+func zoneFileRelativeOwner(top, domain, name string) (string, error) {
+	if name == top {
+		return "@", nil
+	}
+	if err := types.ValidateZoneFileNameLength(name, domain); err != nil {
+		return "", err
+	}
+	return types.ZoneFileOwnerName(strings.TrimSuffix(name, "."+top))
+}
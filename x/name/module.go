@@ -1,9 +1,11 @@
 package name
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"math/rand"
 
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
@@ -22,6 +24,7 @@ import (
 	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 
+	"github.com/provenance-io/provenance/internal/antewrapper"
 	"github.com/provenance-io/provenance/x/name/client/cli"
 	"github.com/provenance-io/provenance/x/name/keeper"
 	"github.com/provenance-io/provenance/x/name/simulation"
@@ -33,7 +36,10 @@ var (
 	_ module.AppModuleBasic      = (*AppModule)(nil)
 	_ module.AppModuleSimulation = (*AppModule)(nil)
 
-	_ appmodule.AppModule = (*AppModule)(nil)
+	_ appmodule.AppModule     = (*AppModule)(nil)
+	_ appmodule.HasEndBlocker = (*AppModule)(nil)
+
+	_ antewrapper.HasAnteDecorators = (*AppModule)(nil)
 )
 
 // AppModuleBasic contains non-dependent elements for the name module.
@@ -118,13 +124,53 @@ func (AppModule) Name() string {
 	return types.ModuleName
 }
 
-// RegisterInvariants registers the distribution module invariants.
-func (am AppModule) RegisterInvariants(_ sdk.InvariantRegistry) {}
+// RegisterInvariants registers the name module invariants.
+func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
+	keeper.RegisterInvariants(ir, am.keeper)
+}
+
+// AnteDecorators returns the name bind filter and name alias decorators for the ante handler.
+func (am AppModule) AnteDecorators() []antewrapper.DecoratorRegistration {
+	return []antewrapper.DecoratorRegistration{
+		antewrapper.NewNameBindFilterDecoratorRegistration(am.keeper),
+		antewrapper.NewNameAliasDecoratorRegistration(am.keeper),
+	}
+}
+
+// ExtensionOptions returns the tx extension options provided by the name module.
+func (am AppModule) ExtensionOptions() []antewrapper.ExtensionOptionRegistration {
+	return []antewrapper.ExtensionOptionRegistration{
+		antewrapper.NewResolveNamesExtensionOptionRegistration(),
+	}
+}
 
 // RegisterServices registers module services.
 func (am AppModule) RegisterServices(cfg module.Configurator) {
 	types.RegisterMsgServer(cfg.MsgServer(), keeper.NewMsgServerImpl(am.keeper))
-	types.RegisterQueryServer(cfg.QueryServer(), am.keeper)
+	types.RegisterQueryServer(cfg.QueryServer(), keeper.NewQueryServerImpl(am.keeper))
+
+	m := keeper.NewMigrator(am.keeper)
+	if err := cfg.RegisterMigration(types.ModuleName, 2, m.Migrate2To3); err != nil {
+		panic(fmt.Sprintf("failed to register x/name migration from version 2 to 3: %v", err))
+	}
+	if err := cfg.RegisterMigration(types.ModuleName, 3, m.Migrate3To4); err != nil {
+		panic(fmt.Sprintf("failed to register x/name migration from version 3 to 4: %v", err))
+	}
+	if err := cfg.RegisterMigration(types.ModuleName, 4, m.Migrate4To5); err != nil {
+		panic(fmt.Sprintf("failed to register x/name migration from version 4 to 5: %v", err))
+	}
+	if err := cfg.RegisterMigration(types.ModuleName, 5, m.Migrate5To6); err != nil {
+		panic(fmt.Sprintf("failed to register x/name migration from version 5 to 6: %v", err))
+	}
+	if err := cfg.RegisterMigration(types.ModuleName, 6, m.Migrate6To7); err != nil {
+		panic(fmt.Sprintf("failed to register x/name migration from version 6 to 7: %v", err))
+	}
+}
+
+// EndBlock returns the end blocker for the name module.
+func (am AppModule) EndBlock(ctx context.Context) error {
+	EndBlocker(sdk.UnwrapSDKContext(ctx), am.keeper)
+	return nil
 }
 
 // InitGenesis performs genesis initialization for the name module. It returns
@@ -137,10 +183,20 @@ func (am AppModule) InitGenesis(ctx sdk.Context, cdc codec.JSONCodec, data json.
 }
 
 // ExportGenesis returns the exported genesis state as raw bytes for the name
-// module.
+// module. The full JSON is returned, so it is all in memory at once. The export
+// command uses ExportGenesisTo instead.
 func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.RawMessage {
-	gs := am.keeper.ExportGenesis(ctx)
-	return cdc.MustMarshalJSON(gs)
+	var buf bytes.Buffer
+	if err := am.ExportGenesisTo(ctx, cdc, &buf); err != nil {
+		panic(err)
+	}
+	return buf.Bytes()
+}
+
+// ExportGenesisTo writes the exported genesis state of the name module to the
+// writer as it's read from state.
+func (am AppModule) ExportGenesisTo(ctx sdk.Context, cdc codec.JSONCodec, w io.Writer) error {
+	return am.keeper.ExportGenesisTo(ctx, cdc, w)
 }
 
 // ____________________________________________________________________________
@@ -168,4 +224,9 @@ func (am AppModule) WeightedOperations(simState module.SimulationState) []simtyp
 }
 
 // ConsensusVersion implements AppModule/ConsensusVersion.
-func (AppModule) ConsensusVersion() uint64 { return 2 }
+func (AppModule) ConsensusVersion() uint64 { return 7 }
+
+// Features returns the names of the module's optional features that are currently enabled.
+func (am AppModule) Features(ctx sdk.Context) []string {
+	return am.keeper.GetParams(ctx).Features()
+}
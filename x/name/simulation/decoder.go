@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/kv"
 
 	"github.com/provenance-io/provenance/x/name/types"
@@ -23,12 +24,12 @@ func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
 
 			return fmt.Sprintf("Name: A:[%v], B:[%v]\n", nameA, nameB)
 		case bytes.HasPrefix(kvA.Key, types.AddressKeyPrefix):
-			var nameA, nameB types.NameRecord
-
-			cdc.MustUnmarshal(kvA.Value, &nameA)
-			cdc.MustUnmarshal(kvB.Value, &nameB)
-
-			return fmt.Sprintf("Addr: A:[%v], B:[%v]\n", nameA, nameB)
+			// The address index values are empty; the name record key is at the end of the index key.
+			return fmt.Sprintf("Addr: A:[%X], B:[%X]\n", kvA.Value, kvB.Value)
+		case bytes.Equal(kvA.Key, types.NameCountKey),
+			bytes.Equal(kvA.Key, types.RestrictedNameCountKey),
+			bytes.HasPrefix(kvA.Key, types.RootNameCountKeyPrefix):
+			return fmt.Sprintf("Count: A:[%d], B:[%d]\n", sdk.BigEndianToUint64(kvA.Value), sdk.BigEndianToUint64(kvB.Value))
 		default:
 			panic(fmt.Sprintf("unexpected %s key %X (%s)", types.ModuleName, kvA.Key, kvA.Key))
 		}
@@ -23,7 +23,9 @@ func TestDecodeStore(t *testing.T) {
 	kvPairs := kv.Pairs{
 		Pairs: []kv.Pair{
 			{Key: types.NameKeyPrefix, Value: cdc.MustMarshal(&testNameRecord)},
-			{Key: types.AddressKeyPrefix, Value: cdc.MustMarshal(&testNameRecord)},
+			{Key: types.AddressKeyPrefix, Value: []byte{}},
+			{Key: types.NameCountKey, Value: sdk.Uint64ToBigEndian(5)},
+			{Key: types.GetRootNameCountKey("pb"), Value: sdk.Uint64ToBigEndian(3)},
 			{Key: []byte{0x99}, Value: []byte{0x99}},
 		},
 	}
@@ -33,7 +35,9 @@ func TestDecodeStore(t *testing.T) {
 		expectedLog string
 	}{
 		{"Name Record", fmt.Sprintf("Name: A:[%v], B:[%v]\n", testNameRecord, testNameRecord)},
-		{"Address Cache", fmt.Sprintf("Addr: A:[%v], B:[%v]\n", testNameRecord, testNameRecord)},
+		{"Address Index", "Addr: A:[], B:[]\n"},
+		{"Name Count", "Count: A:[5], B:[5]\n"},
+		{"Root Name Count", "Count: A:[3], B:[3]\n"},
 		{"other", ""},
 	}
 
@@ -20,6 +20,7 @@ const (
 	MinSegmentLength       = "min_segment_length"
 	MaxNameLevels          = "max_namne_levels"
 	AllowUnrestrictedNames = "allow_unrestricted_names"
+	MaxDeletions           = "max_deletions"
 	RootNameSegment        = "root_name_segment"
 	ModifyName             = "jackthecat"
 )
@@ -45,6 +46,11 @@ func GenAllowUnrestrictedNames(r *rand.Rand) bool {
 	return r.Int63n(101) <= 50 // 50% chance of unrestricted names being enabled
 }
 
+// GenMaxDeletions randomized maximum number of names deleted by a single delete names request
+func GenMaxDeletions(r *rand.Rand) uint32 {
+	return uint32(r.Intn(100) + 1) //nolint:gosec // G115: Max is 100, which fits in a uint32 just fine.
+}
+
 // GenRootNameSegment returns a randomized String to use for the root name binding
 func GenRootNameSegment(r *rand.Rand, minSegmentLength uint32) string {
 	return strings.ToLower(simtypes.RandStringOfLength(r, int(minSegmentLength)))
@@ -82,12 +88,19 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { rootNameSegment = GenRootNameSegment(r, minValueLength) },
 	)
 
+	var maxDeletions uint32
+	simState.AppParams.GetOrGenerate(
+		MaxDeletions, &maxDeletions, simState.Rand,
+		func(r *rand.Rand) { maxDeletions = GenMaxDeletions(r) },
+	)
+
 	accountGenesis := types.GenesisState{
 		Params: types.Params{
 			MaxSegmentLength:       maxValueLength,
 			MaxNameLevels:          maxNameLevels,
 			MinSegmentLength:       minValueLength,
 			AllowUnrestrictedNames: allowUnrestrictedNames,
+			MaxDeletions:           maxDeletions,
 		},
 		Bindings: []types.NameRecord{
 			types.NewNameRecord(rootNameSegment, simState.Accounts[0].Address, false),
@@ -0,0 +1,225 @@
+// Package testutil provides a test fixture with a fully wired Provenance app that has names, attributes,
+// and markers already in state. It's meant to be imported by tests in this repo and in downstream projects
+// (e.g. wasm contract tests), so that they don't each need their own copy of the app setup boilerplate.
+package testutil
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
+
+	"github.com/provenance-io/provenance/app"
+	attrtypes "github.com/provenance-io/provenance/x/attribute/types"
+	markertypes "github.com/provenance-io/provenance/x/marker/types"
+)
+
+// AccountAddress returns the address of the i-th fixture account.
+// The addresses are deterministic, so they can be used when seeding names, attributes, and markers.
+func AccountAddress(i int) sdk.AccAddress {
+	return sdk.AccAddress(fmt.Sprintf("fixture_account_%04d", i))
+}
+
+// NameSeed is a name to bind when building a Fixture.
+type NameSeed struct {
+	// Name is the full name to bind. Its parent names do not need to exist.
+	Name string
+	// Owner is the address the name resolves to.
+	Owner sdk.AccAddress
+	// Restricted is whether child names can only be bound by the owner.
+	Restricted bool
+}
+
+// AttributeSeed is an attribute to add to an account when building a Fixture.
+type AttributeSeed struct {
+	// Account is the account that gets the attribute.
+	Account sdk.AccAddress
+	// Name is the attribute name. It must be one of the seeded names (or otherwise already be bound).
+	Name string
+	// Value is the attribute value.
+	Value []byte
+	// Type is the type of the attribute value.
+	Type attrtypes.AttributeType
+}
+
+// MarkerSeed is a marker to create, finalize, and activate when building a Fixture.
+type MarkerSeed struct {
+	// Supply is the denom and total supply of the marker. The supply is held by the marker account.
+	Supply sdk.Coin
+	// Manager is given all permissions on the marker (transfer is only given on restricted markers).
+	Manager sdk.AccAddress
+	// MarkerType is the type of marker to create.
+	MarkerType markertypes.MarkerType
+	// RequiredAttributes are the attributes needed to receive a restricted marker's funds.
+	RequiredAttributes []string
+}
+
+// FixtureBuilder collects everything that should be in state, then builds a Fixture with it.
+type FixtureBuilder struct {
+	t *testing.T
+
+	accountCount   int
+	accountBalance sdk.Coins
+	names          []NameSeed
+	attributes     []AttributeSeed
+	markers        []MarkerSeed
+}
+
+// NewFixtureBuilder creates a new FixtureBuilder that, by default, will create 3 accounts,
+// each funded with 1,000,000,000 of the bond denom.
+func NewFixtureBuilder(t *testing.T) *FixtureBuilder {
+	return &FixtureBuilder{
+		t:              t,
+		accountCount:   3,
+		accountBalance: sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1_000_000_000)),
+	}
+}
+
+// WithAccounts sets the number of fixture accounts to create, and the balance of each.
+func (b *FixtureBuilder) WithAccounts(count int, balance sdk.Coins) *FixtureBuilder {
+	b.accountCount = count
+	b.accountBalance = balance
+	return b
+}
+
+// WithName adds a name to bind to the owner.
+func (b *FixtureBuilder) WithName(name string, owner sdk.AccAddress, restricted bool) *FixtureBuilder {
+	b.names = append(b.names, NameSeed{Name: name, Owner: owner, Restricted: restricted})
+	return b
+}
+
+// WithAttribute adds an attribute to put on an account. The attribute is set by the owner of the name.
+func (b *FixtureBuilder) WithAttribute(account sdk.AccAddress, name string, value []byte, attrType attrtypes.AttributeType) *FixtureBuilder {
+	b.attributes = append(b.attributes, AttributeSeed{Account: account, Name: name, Value: value, Type: attrType})
+	return b
+}
+
+// WithStringAttribute adds a string attribute to put on an account. The attribute is set by the owner of the name.
+func (b *FixtureBuilder) WithStringAttribute(account sdk.AccAddress, name, value string) *FixtureBuilder {
+	return b.WithAttribute(account, name, []byte(value), attrtypes.AttributeType_String)
+}
+
+// WithMarker adds an active coin marker with all of the supply in the marker account.
+func (b *FixtureBuilder) WithMarker(supply sdk.Coin, manager sdk.AccAddress) *FixtureBuilder {
+	b.markers = append(b.markers, MarkerSeed{Supply: supply, Manager: manager, MarkerType: markertypes.MarkerType_Coin})
+	return b
+}
+
+// WithRestrictedMarker adds an active restricted marker with all of the supply in the marker account.
+func (b *FixtureBuilder) WithRestrictedMarker(supply sdk.Coin, manager sdk.AccAddress, requiredAttributes ...string) *FixtureBuilder {
+	b.markers = append(b.markers, MarkerSeed{
+		Supply:             supply,
+		Manager:            manager,
+		MarkerType:         markertypes.MarkerType_RestrictedCoin,
+		RequiredAttributes: requiredAttributes,
+	})
+	return b
+}
+
+// Build creates a new app and puts all of the seeded accounts, names, attributes, and markers in its state.
+// The test fails if any of them cannot be added.
+func (b *FixtureBuilder) Build() *Fixture {
+	b.t.Helper()
+	f := &Fixture{t: b.t, App: app.Setup(b.t)}
+	f.Ctx = f.App.BaseApp.NewContext(false)
+
+	for i := 0; i < b.accountCount; i++ {
+		addr := AccountAddress(i)
+		f.ensureAccount(addr)
+		if !b.accountBalance.IsZero() {
+			f.FundAccount(addr, b.accountBalance)
+		}
+		f.Accounts = append(f.Accounts, addr)
+	}
+
+	for _, seed := range b.names {
+		err := f.App.NameKeeper.SetNameRecord(f.Ctx, seed.Name, seed.Owner, seed.Restricted)
+		require.NoError(b.t, err, "SetNameRecord(%q, %s)", seed.Name, seed.Owner)
+	}
+
+	for _, seed := range b.attributes {
+		record, err := f.App.NameKeeper.GetRecordByName(f.Ctx, seed.Name)
+		require.NoError(b.t, err, "GetRecordByName(%q) for attribute", seed.Name)
+		owner := sdk.MustAccAddressFromBech32(record.Address)
+		f.ensureAccount(owner)
+		attr := attrtypes.NewAttribute(seed.Name, seed.Account.String(), seed.Type, seed.Value, nil)
+		err = f.App.AttributeKeeper.SetAttribute(f.Ctx, attr, owner)
+		require.NoError(b.t, err, "SetAttribute(%q) on %s", seed.Name, seed.Account)
+	}
+
+	for _, seed := range b.markers {
+		perms := markertypes.AccessList{
+			markertypes.Access_Mint, markertypes.Access_Burn, markertypes.Access_Deposit,
+			markertypes.Access_Withdraw, markertypes.Access_Delete, markertypes.Access_Admin,
+		}
+		if seed.MarkerType == markertypes.MarkerType_RestrictedCoin {
+			perms = append(perms, markertypes.Access_Transfer)
+		}
+		grants := []markertypes.AccessGrant{*markertypes.NewAccessGrant(seed.Manager, perms)}
+		marker := markertypes.NewMarkerAccount(
+			authtypes.NewBaseAccountWithAddress(markertypes.MustGetMarkerAddress(seed.Supply.Denom)),
+			seed.Supply, seed.Manager, grants, markertypes.StatusProposed, seed.MarkerType,
+			true, true, false, seed.RequiredAttributes,
+		)
+		err := f.App.MarkerKeeper.AddFinalizeAndActivateMarker(f.Ctx, marker)
+		require.NoError(b.t, err, "AddFinalizeAndActivateMarker(%q)", seed.Supply.Denom)
+	}
+
+	return f
+}
+
+// Fixture is a fully wired app with some state already in it.
+type Fixture struct {
+	t *testing.T
+
+	// App is the app with all of the seeded state.
+	App *app.App
+	// Ctx is a (deliver tx) context for the app.
+	Ctx sdk.Context
+	// Accounts are the fixture accounts that were created and funded.
+	Accounts []sdk.AccAddress
+}
+
+// NewFixture builds a Fixture with the default accounts and nothing else seeded.
+func NewFixture(t *testing.T) *Fixture {
+	return NewFixtureBuilder(t).Build()
+}
+
+// FundAccount mints the provided coins and gives them to the provided address.
+func (f *Fixture) FundAccount(addr sdk.AccAddress, coins sdk.Coins) {
+	f.t.Helper()
+	err := banktestutil.FundAccount(f.Ctx, f.App.BankKeeper, addr, coins)
+	require.NoError(f.t, err, "FundAccount(%s, %q)", addr, coins)
+}
+
+// WithdrawMarkerCoins moves some of a marker's supply out of the marker account and into the provided address.
+// The withdrawal is done on behalf of the first address with withdraw access on the marker.
+func (f *Fixture) WithdrawMarkerCoins(to sdk.AccAddress, coin sdk.Coin) {
+	f.t.Helper()
+	marker, err := f.App.MarkerKeeper.GetMarkerByDenom(f.Ctx, coin.Denom)
+	require.NoError(f.t, err, "GetMarkerByDenom(%q)", coin.Denom)
+	withdrawers := marker.AddressListForPermission(markertypes.Access_Withdraw)
+	require.NotEmpty(f.t, withdrawers, "addresses with withdraw access on %q", coin.Denom)
+	err = f.App.MarkerKeeper.WithdrawCoins(f.Ctx, withdrawers[0], to, coin.Denom, sdk.NewCoins(coin))
+	require.NoError(f.t, err, "WithdrawCoins(%s, %q)", to, coin)
+}
+
+// BondDenom returns the bond denom of the app.
+func (f *Fixture) BondDenom() string {
+	f.t.Helper()
+	rv, err := f.App.StakingKeeper.BondDenom(f.Ctx)
+	require.NoError(f.t, err, "BondDenom")
+	return rv
+}
+
+// ensureAccount makes sure there's an account with the provided address.
+func (f *Fixture) ensureAccount(addr sdk.AccAddress) {
+	if f.App.AccountKeeper.HasAccount(f.Ctx, addr) {
+		return
+	}
+	f.App.AccountKeeper.SetAccount(f.Ctx, f.App.AccountKeeper.NewAccountWithAddress(f.Ctx, addr))
+}
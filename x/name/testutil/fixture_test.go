@@ -0,0 +1,82 @@
+package testutil_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	attrtypes "github.com/provenance-io/provenance/x/attribute/types"
+	markertypes "github.com/provenance-io/provenance/x/marker/types"
+	"github.com/provenance-io/provenance/x/name/testutil"
+)
+
+func TestAccountAddress(t *testing.T) {
+	assert.Equal(t, sdk.AccAddress("fixture_account_0000"), testutil.AccountAddress(0), "AccountAddress(0)")
+	assert.Equal(t, sdk.AccAddress("fixture_account_0012"), testutil.AccountAddress(12), "AccountAddress(12)")
+	assert.NoError(t, sdk.VerifyAddressFormat(testutil.AccountAddress(3)), "VerifyAddressFormat(AccountAddress(3))")
+}
+
+func TestNewFixture(t *testing.T) {
+	f := testutil.NewFixture(t)
+	require.Len(t, f.Accounts, 3, "Accounts")
+	expBal := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1_000_000_000))
+	for i, addr := range f.Accounts {
+		assert.Equal(t, testutil.AccountAddress(i), addr, "Accounts[%d]", i)
+		assert.True(t, f.App.AccountKeeper.HasAccount(f.Ctx, addr), "HasAccount(Accounts[%d])", i)
+		assert.Equal(t, expBal, f.App.BankKeeper.GetAllBalances(f.Ctx, addr), "GetAllBalances(Accounts[%d])", i)
+	}
+	assert.Equal(t, sdk.DefaultBondDenom, f.BondDenom(), "BondDenom")
+}
+
+func TestFixtureBuilder(t *testing.T) {
+	owner := testutil.AccountAddress(0)
+	holder := testutil.AccountAddress(1)
+	nameOnlyOwner := sdk.AccAddress("name_only_owner_____")
+
+	f := testutil.NewFixtureBuilder(t).
+		WithAccounts(2, sdk.NewCoins(sdk.NewInt64Coin("fixturecoin", 500))).
+		WithName("fixture.pb", owner, true).
+		WithName("kyc.fixture.pb", owner, true).
+		WithName("other.pb", nameOnlyOwner, false).
+		WithStringAttribute(holder, "kyc.fixture.pb", "passed").
+		WithAttribute(holder, "other.pb", []byte("12"), attrtypes.AttributeType_Int).
+		WithMarker(sdk.NewInt64Coin("fixturehash", 1000), owner).
+		WithRestrictedMarker(sdk.NewInt64Coin("fixturesecurity", 100), owner, "kyc.fixture.pb").
+		Build()
+
+	require.Len(t, f.Accounts, 2, "Accounts")
+	assert.Equal(t, "500fixturecoin", f.App.BankKeeper.GetAllBalances(f.Ctx, holder).String(), "balance of holder")
+
+	t.Run("names", func(t *testing.T) {
+		for _, name := range []string{"fixture.pb", "kyc.fixture.pb"} {
+			record, err := f.App.NameKeeper.GetRecordByName(f.Ctx, name)
+			if assert.NoError(t, err, "GetRecordByName(%q)", name) {
+				assert.Equal(t, owner.String(), record.Address, "GetRecordByName(%q) address", name)
+				assert.True(t, record.Restricted, "GetRecordByName(%q) restricted", name)
+			}
+		}
+		assert.True(t, f.App.NameKeeper.ResolvesTo(f.Ctx, "other.pb", nameOnlyOwner), "ResolvesTo(other.pb)")
+	})
+
+	t.Run("attributes", func(t *testing.T) {
+		attrs, err := f.App.AttributeKeeper.GetAllAttributes(f.Ctx, holder.String())
+		require.NoError(t, err, "GetAllAttributes(holder)")
+		require.Len(t, attrs, 2, "GetAllAttributes(holder)")
+		assert.True(t, f.App.AccountKeeper.HasAccount(f.Ctx, nameOnlyOwner), "HasAccount(nameOnlyOwner)")
+	})
+
+	t.Run("markers", func(t *testing.T) {
+		marker, err := f.App.MarkerKeeper.GetMarkerByDenom(f.Ctx, "fixturesecurity")
+		require.NoError(t, err, "GetMarkerByDenom(fixturesecurity)")
+		assert.Equal(t, markertypes.StatusActive, marker.GetStatus(), "fixturesecurity status")
+		assert.Equal(t, markertypes.MarkerType_RestrictedCoin, marker.GetMarkerType(), "fixturesecurity type")
+		assert.Equal(t, []string{"kyc.fixture.pb"}, marker.GetRequiredAttributes(), "fixturesecurity required attributes")
+
+		f.WithdrawMarkerCoins(holder, sdk.NewInt64Coin("fixturehash", 25))
+		f.WithdrawMarkerCoins(holder, sdk.NewInt64Coin("fixturesecurity", 10))
+		assert.Equal(t, "500fixturecoin,25fixturehash,10fixturesecurity", f.App.BankKeeper.GetAllBalances(f.Ctx, holder).String(), "balance of holder after withdrawals")
+	})
+}
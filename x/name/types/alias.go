@@ -0,0 +1,147 @@
+package types
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	cosmos_proto "github.com/cosmos/cosmos-proto"
+	"github.com/cosmos/gogoproto/proto"
+	protov2 "google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const (
+	// NameAliasPrefix is the prefix that identifies an address field value as a name to resolve.
+	NameAliasPrefix = "name:"
+
+	// addressScalar is the cosmos_proto.scalar value used on address fields.
+	addressScalar = "cosmos.AddressString"
+)
+
+// ParseNameAlias returns the name in a "name:" prefixed value, and whether the value has that prefix.
+func ParseNameAlias(value string) (string, bool) {
+	if !strings.HasPrefix(value, NameAliasPrefix) {
+		return "", false
+	}
+	return strings.TrimPrefix(value, NameAliasPrefix), true
+}
+
+// ResolveNameAliases replaces each "name:" prefixed value in the msg's address fields with the result of calling
+// resolve with the name (without the prefix). The msg is updated in place.
+//
+// Address fields are the string fields annotated with the cosmos.AddressString scalar.
+// Fields of nested messages are included, but messages packed in an Any are not.
+func ResolveNameAliases(msg proto.Message, resolve func(name string) (string, error)) error {
+	desc, err := proto.HybridResolver.FindDescriptorByName(protoreflect.FullName(proto.MessageName(msg)))
+	if err != nil {
+		return fmt.Errorf("could not find descriptor for %T: %w", msg, err)
+	}
+	md, ok := desc.(protoreflect.MessageDescriptor)
+	if !ok {
+		return fmt.Errorf("descriptor for %T is not a message descriptor", msg)
+	}
+	v := reflect.ValueOf(msg)
+	if v.Kind() != reflect.Pointer || v.IsNil() || v.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("cannot resolve name aliases in %T", msg)
+	}
+	return resolveStructAliases(v.Elem(), md, resolve)
+}
+
+// resolveStructAliases resolves the aliases in the address fields of a message struct.
+func resolveStructAliases(v reflect.Value, md protoreflect.MessageDescriptor, resolve func(name string) (string, error)) error {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		fd := md.Fields().ByName(protoreflect.Name(protoTagName(t.Field(i).Tag.Get("protobuf"))))
+		if fd == nil || fd.IsMap() {
+			continue
+		}
+		fv := v.Field(i)
+
+		switch fd.Kind() {
+		case protoreflect.StringKind:
+			if !isAddressField(fd) {
+				continue
+			}
+			if fd.IsList() && fv.Kind() == reflect.Slice && fv.Type().Elem().Kind() == reflect.String {
+				for j := 0; j < fv.Len(); j++ {
+					if err := resolveAlias(fv.Index(j), fd, resolve); err != nil {
+						return err
+					}
+				}
+			} else if fv.Kind() == reflect.String {
+				if err := resolveAlias(fv, fd, resolve); err != nil {
+					return err
+				}
+			}
+		case protoreflect.MessageKind:
+			if fd.Message().FullName() == "google.protobuf.Any" {
+				continue
+			}
+			if fd.IsList() && fv.Kind() == reflect.Slice {
+				for j := 0; j < fv.Len(); j++ {
+					if err := resolveNestedAliases(fv.Index(j), fd.Message(), resolve); err != nil {
+						return err
+					}
+				}
+			} else {
+				if err := resolveNestedAliases(fv, fd.Message(), resolve); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// resolveNestedAliases resolves the aliases in a nested message that is either a struct or a pointer to one.
+func resolveNestedAliases(v reflect.Value, md protoreflect.MessageDescriptor, resolve func(name string) (string, error)) error {
+	if v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return nil
+		}
+		v = v.Elem()
+	}
+	// Custom types (e.g. math.Int) aren't messages, so there's nothing in them to resolve.
+	if v.Kind() != reflect.Struct || !v.CanAddr() {
+		return nil
+	}
+	if _, isMsg := v.Addr().Interface().(proto.Message); !isMsg {
+		return nil
+	}
+	return resolveStructAliases(v, md, resolve)
+}
+
+// resolveAlias replaces the settable string value with its resolved address if it has the alias prefix.
+func resolveAlias(v reflect.Value, fd protoreflect.FieldDescriptor, resolve func(name string) (string, error)) error {
+	name, isAlias := ParseNameAlias(v.String())
+	if !isAlias || !v.CanSet() {
+		return nil
+	}
+	addr, err := resolve(name)
+	if err != nil {
+		return fmt.Errorf("could not resolve %s %q: %w", fd.Name(), v.String(), err)
+	}
+	v.SetString(addr)
+	return nil
+}
+
+// isAddressField returns true if the field is annotated as being an address.
+func isAddressField(fd protoreflect.FieldDescriptor) bool {
+	opts := fd.Options()
+	if opts == nil || !protov2.HasExtension(opts, cosmos_proto.E_Scalar) {
+		return false
+	}
+	scalar, ok := protov2.GetExtension(opts, cosmos_proto.E_Scalar).(string)
+	return ok && scalar == addressScalar
+}
+
+// protoTagName gets the proto field name out of a gogoproto struct tag, e.g. "bytes,1,opt,name=to_address,...".
+func protoTagName(tag string) string {
+	for _, part := range strings.Split(tag, ",") {
+		if name, found := strings.CutPrefix(part, "name="); found {
+			return name
+		}
+	}
+	return ""
+}
@@ -0,0 +1,85 @@
+package types_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	. "github.com/provenance-io/provenance/x/name/types"
+)
+
+func TestParseNameAlias(t *testing.T) {
+	tests := []struct {
+		value    string
+		expName  string
+		expAlias bool
+	}{
+		{value: "", expName: "", expAlias: false},
+		{value: "treasury.pb", expName: "", expAlias: false},
+		{value: "name:", expName: "", expAlias: true},
+		{value: "name:treasury.pb", expName: "treasury.pb", expAlias: true},
+		{value: "NAME:treasury.pb", expName: "", expAlias: false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.value, func(t *testing.T) {
+			name, isAlias := ParseNameAlias(tc.value)
+			assert.Equal(t, tc.expName, name, "name")
+			assert.Equal(t, tc.expAlias, isAlias, "is alias")
+		})
+	}
+}
+
+func TestResolveNameAliases(t *testing.T) {
+	addr := sdk.AccAddress("resolved____________").String()
+	resolve := func(name string) (string, error) {
+		if name == "bad" {
+			return "", errors.New("bad name")
+		}
+		return addr, nil
+	}
+
+	tests := []struct {
+		name   string
+		msg    sdk.Msg
+		expMsg sdk.Msg
+		expErr string
+	}{
+		{
+			name:   "no aliases",
+			msg:    &MsgModifyNameRequest{Authority: addr, Record: NameRecord{Name: "name:x", Address: addr}},
+			expMsg: &MsgModifyNameRequest{Authority: addr, Record: NameRecord{Name: "name:x", Address: addr}},
+		},
+		{
+			name:   "alias in field",
+			msg:    &MsgModifyNameRequest{Authority: "name:gov"},
+			expMsg: &MsgModifyNameRequest{Authority: addr},
+		},
+		{
+			name:   "alias in nested field, non-address field left alone",
+			msg:    &MsgBindNameRequest{Parent: NameRecord{Name: "name:x", Address: "name:parent"}},
+			expMsg: &MsgBindNameRequest{Parent: NameRecord{Name: "name:x", Address: addr}},
+		},
+		{
+			name:   "resolve error",
+			msg:    &MsgDeleteNameRequest{Record: NameRecord{Address: "name:bad"}},
+			expErr: `could not resolve address "name:bad": bad name`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := ResolveNameAliases(tc.msg, resolve)
+			if len(tc.expErr) > 0 {
+				require.EqualError(t, err, tc.expErr, "ResolveNameAliases")
+				return
+			}
+			require.NoError(t, err, "ResolveNameAliases")
+			assert.Equal(t, tc.expMsg, tc.msg, "msg after ResolveNameAliases")
+		})
+	}
+}
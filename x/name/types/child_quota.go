@@ -0,0 +1,25 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+)
+
+// NewChildQuota creates a new ChildQuota for the provided name.
+func NewChildQuota(name string, maxChildren uint64) ChildQuota {
+	return ChildQuota{
+		Name:        strings.ToLower(strings.TrimSpace(name)),
+		MaxChildren: maxChildren,
+	}
+}
+
+// Validate returns an error if this child quota does not have a name or a limit.
+func (q ChildQuota) Validate() error {
+	if strings.TrimSpace(q.Name) == "" {
+		return fmt.Errorf("child quota name cannot be empty")
+	}
+	if q.MaxChildren == 0 {
+		return fmt.Errorf("child quota of %q must have a max children greater than zero", q.Name)
+	}
+	return nil
+}
@@ -22,4 +22,20 @@ var (
 	ErrInvalidAddress = cerrs.Register(ModuleName, 8, "invalid account address")
 	// ErrNameContainsSegments indicates a multi-segment name in a single segment context.
 	ErrNameContainsSegments = cerrs.Register(ModuleName, 9, "invalid name: \".\" is reserved")
+	// ErrParentNameNotBound occurs when a name is being bound but one of its parent names is not bound.
+	ErrParentNameNotBound = cerrs.Register(ModuleName, 10, "parent name is not bound to an address")
+	// ErrParentNameRestricted occurs when a name is being bound under a restricted parent by someone other than its owner.
+	ErrParentNameRestricted = cerrs.Register(ModuleName, 11, "parent name is restricted")
+	// ErrNamePendingDeletion occurs when a name has been deleted, but has not been removed yet.
+	ErrNamePendingDeletion = cerrs.Register(ModuleName, 12, "name is pending deletion")
+	// ErrNameBindingMismatch occurs when a name is not bound to the address it was expected to be bound to.
+	ErrNameBindingMismatch = cerrs.Register(ModuleName, 13, "name is not bound to the expected address")
+	// ErrNameHasTooManyUUIDSegments occurs when a name has more UUID segments than allowed.
+	ErrNameHasTooManyUUIDSegments = cerrs.Register(ModuleName, 14, "name has too many uuid segments")
+	// ErrChildQuotaExceeded occurs when a name is being bound under a parent that already has as many children as its quota allows.
+	ErrChildQuotaExceeded = cerrs.Register(ModuleName, 15, "parent name child quota exceeded")
+	// ErrNamedAccountExists occurs when the account derived from a name already exists and cannot be used for the name.
+	ErrNamedAccountExists = cerrs.Register(ModuleName, 16, "named account already exists")
+	// ErrNamedAccountSend occurs when funds are sent to a named account, which they could not be moved out of.
+	ErrNamedAccountSend = cerrs.Register(ModuleName, 17, "cannot send funds to a named account")
 )
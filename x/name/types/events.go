@@ -1,6 +1,11 @@
 package types
 
-import "strconv"
+import (
+	"strconv"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
 
 const (
 	// EventTypeNameBound is the type of event generated when a name is bound to an address.
@@ -39,11 +44,106 @@ func NewEventNameUpdate(address string, name string, restricted bool) *EventName
 }
 
 // NewEventNameParamsUpdated returns a new instance of EventNameParamsUpdated
-func NewEventNameParamsUpdated(allowUnrestrictedNames bool, maxNameLevels, minSegmentLength, maxSegmentLength uint32) *EventNameParamsUpdated {
+func NewEventNameParamsUpdated(
+	allowUnrestrictedNames bool,
+	maxNameLevels, minSegmentLength, maxSegmentLength, maxDeletions uint32,
+	contractMigratedNamePolicy, contractAdminClearedNamePolicy ContractNamePolicy,
+	deleteDelayBlocks uint32, resolvePendingDeletions bool,
+	maxQueryResults uint32, maxQueryResponseBytes uint64,
+	restrictNewNames bool, bindNameFee sdk.Coins, bindNameFeeRecipient string, rootNameParams []RootNameParams,
+) *EventNameParamsUpdated {
+	roots := make([]string, len(rootNameParams))
+	for i, rootParams := range rootNameParams {
+		roots[i] = rootParams.Root
+	}
 	return &EventNameParamsUpdated{
-		AllowUnrestrictedNames: strconv.FormatBool(allowUnrestrictedNames),
-		MaxNameLevels:          strconv.FormatUint(uint64(maxNameLevels), 10),
-		MinSegmentLength:       strconv.FormatUint(uint64(minSegmentLength), 10),
-		MaxSegmentLength:       strconv.FormatUint(uint64(maxSegmentLength), 10),
+		AllowUnrestrictedNames:         strconv.FormatBool(allowUnrestrictedNames),
+		MaxNameLevels:                  strconv.FormatUint(uint64(maxNameLevels), 10),
+		MinSegmentLength:               strconv.FormatUint(uint64(minSegmentLength), 10),
+		MaxSegmentLength:               strconv.FormatUint(uint64(maxSegmentLength), 10),
+		MaxDeletions:                   strconv.FormatUint(uint64(maxDeletions), 10),
+		ContractMigratedNamePolicy:     contractMigratedNamePolicy.String(),
+		ContractAdminClearedNamePolicy: contractAdminClearedNamePolicy.String(),
+		DeleteDelayBlocks:              strconv.FormatUint(uint64(deleteDelayBlocks), 10),
+		ResolvePendingDeletions:        strconv.FormatBool(resolvePendingDeletions),
+		MaxQueryResults:                strconv.FormatUint(uint64(maxQueryResults), 10),
+		MaxQueryResponseBytes:          strconv.FormatUint(maxQueryResponseBytes, 10),
+		RestrictNewNames:               strconv.FormatBool(restrictNewNames),
+		BindNameFee:                    bindNameFee.String(),
+		BindNameFeeRecipient:           bindNameFeeRecipient,
+		RootNameParams:                 strings.Join(roots, ","),
+	}
+}
+
+// NewEventContractNamePolicyApplied returns a new instance of EventContractNamePolicyApplied
+func NewEventContractNamePolicyApplied(contract, admin string, policy ContractNamePolicy, names []string) *EventContractNamePolicyApplied {
+	return &EventContractNamePolicyApplied{
+		Contract: contract,
+		Admin:    admin,
+		Policy:   policy.String(),
+		Names:    names,
+	}
+}
+
+// NewEventNameRemoved returns a new instance of EventNameRemoved
+func NewEventNameRemoved(name, address string, removedNames []string) *EventNameRemoved {
+	return &EventNameRemoved{
+		Name:         name,
+		Address:      address,
+		RemovedNames: removedNames,
+	}
+}
+
+// NewEventNamePendingDeletion returns a new instance of EventNamePendingDeletion
+func NewEventNamePendingDeletion(pending PendingNameDeletion) *EventNamePendingDeletion {
+	return &EventNamePendingDeletion{
+		Name:         pending.Name,
+		Address:      pending.Address,
+		DeleteHeight: strconv.FormatInt(pending.DeleteHeight, 10),
+	}
+}
+
+// NewEventSendByName returns a new instance of EventSendByName
+func NewEventSendByName(fromAddress, name, toAddress string, amount sdk.Coins) *EventSendByName {
+	return &EventSendByName{
+		FromAddress: fromAddress,
+		Name:        name,
+		ToAddress:   toAddress,
+		Amount:      amount.String(),
+	}
+}
+
+// NewEventAddressRotationApproved returns a new instance of EventAddressRotationApproved
+func NewEventAddressRotationApproved(oldAddress, newAddress string) *EventAddressRotationApproved {
+	return &EventAddressRotationApproved{
+		OldAddress: oldAddress,
+		NewAddress: newAddress,
+	}
+}
+
+// NewEventAddressRotated returns a new instance of EventAddressRotated
+func NewEventAddressRotated(oldAddress, newAddress string, names []string) *EventAddressRotated {
+	return &EventAddressRotated{
+		OldAddress: oldAddress,
+		NewAddress: newAddress,
+		Names:      names,
+	}
+}
+
+// NewEventNameChildQuotaUpdated returns a new instance of EventNameChildQuotaUpdated
+func NewEventNameChildQuotaUpdated(name string, maxChildren uint64, owner string) *EventNameChildQuotaUpdated {
+	return &EventNameChildQuotaUpdated{
+		Name:        name,
+		MaxChildren: strconv.FormatUint(maxChildren, 10),
+		Owner:       owner,
+	}
+}
+
+// NewEventNamedAccountCreated returns a new instance of EventNamedAccountCreated
+func NewEventNamedAccountCreated(name, address, owner string) *EventNamedAccountCreated {
+	return &EventNamedAccountCreated{
+		Name:    name,
+		Address: address,
+		Owner:   owner,
 	}
 }
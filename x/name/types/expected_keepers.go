@@ -1,7 +1,10 @@
 package types
 
 import (
+	"context"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
 // ParamSubspace defines the expected Subspace interface for parameters (noalias)
@@ -10,8 +13,24 @@ type ParamSubspace interface {
 	Set(ctx sdk.Context, key []byte, param interface{})
 }
 
+// AccountKeeper defines the expected account keeper interface (noalias)
+type AccountKeeper interface {
+	GetAccount(ctx context.Context, addr sdk.AccAddress) sdk.AccountI
+	NewAccountWithAddress(ctx context.Context, addr sdk.AccAddress) sdk.AccountI
+	SetAccount(ctx context.Context, acc sdk.AccountI)
+}
+
 // AttributeKeeper defines the expected attribute keeper interface (noalias)
 type AttributeKeeper interface {
 	PurgeAttribute(ctx sdk.Context, name string, owner sdk.AccAddress) error
 	AccountsByAttribute(ctx sdk.Context, name string) (addresses []sdk.AccAddress, err error)
 }
+
+// BankKeeper defines the expected bank keeper interface (noalias)
+type BankKeeper interface {
+	SendCoins(ctx context.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error
+	SendCoinsFromAccountToModule(ctx context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+	IsSendEnabledCoins(ctx context.Context, coins ...sdk.Coin) error
+	BlockedAddr(addr sdk.AccAddress) bool
+	AppendSendRestriction(restriction banktypes.SendRestrictionFn)
+}
@@ -0,0 +1,22 @@
+package types
+
+import "strings"
+
+// Gas costs charged when normalizing and validating a name.
+// They're charged before any of the work is done, so that a long name runs out of gas instead of
+// getting a disproportionate amount of computation.
+const (
+	// GasNormalizeNameFlat is the gas charged for every name normalization.
+	GasNormalizeNameFlat uint64 = 10
+	// GasNormalizeNamePerByte is the gas charged for each byte of the name being normalized.
+	GasNormalizeNamePerByte uint64 = 2
+	// GasNormalizeNamePerSegment is the gas charged for each segment of the name being normalized.
+	// It covers the validation of the segment, including the check of whether it's a UUID.
+	GasNormalizeNamePerSegment uint64 = 20
+)
+
+// NormalizeNameGas returns the amount of gas to charge for normalizing and validating the provided name.
+func NormalizeNameGas(name string) uint64 {
+	segments := uint64(strings.Count(name, ".") + 1)
+	return GasNormalizeNameFlat + GasNormalizeNamePerByte*uint64(len(name)) + GasNormalizeNamePerSegment*segments
+}
@@ -0,0 +1,28 @@
+package types_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	. "github.com/provenance-io/provenance/x/name/types"
+)
+
+func TestNormalizeNameGas(t *testing.T) {
+	tests := []struct {
+		name string
+		exp  uint64
+	}{
+		{name: "", exp: 10 + 20},
+		{name: "pio", exp: 10 + 2*3 + 20},
+		{name: "test.normalize.pio", exp: 10 + 2*18 + 20*3},
+		{name: strings.Repeat("ab.", 20) + "pio", exp: 10 + 2*63 + 20*21},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.exp, NormalizeNameGas(tc.name), "NormalizeNameGas(%q)", tc.name)
+		})
+	}
+}
@@ -1,8 +1,10 @@
 package types
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
+	"slices"
 	"strings"
 
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -30,6 +32,43 @@ func (nrs NameRecords) Contains(name string) bool {
 	return false
 }
 
+// SortByNameKey returns a copy of these name records ordered by their name store keys.
+// That is the order they are stored and exported in, so a genesis state sorted this way is imported the same
+// regardless of the order its bindings were originally listed in.
+func (nrs NameRecords) SortByNameKey() (NameRecords, error) {
+	keys := make(map[string][]byte, len(nrs))
+	for _, record := range nrs {
+		key, err := GetNameKeyPrefix(record.Name)
+		if err != nil {
+			return nil, fmt.Errorf("invalid name record %q: %w", record.Name, err)
+		}
+		keys[record.Name] = key
+	}
+	rv := slices.Clone(nrs)
+	slices.SortStableFunc(rv, func(a, b NameRecord) int {
+		return bytes.Compare(keys[a.Name], keys[b.Name])
+	})
+	return rv, nil
+}
+
+// SortPendingDeletions returns a copy of the provided pending deletions ordered by their delete height,
+// then by their name store keys. That is the order they are stored and exported in.
+func SortPendingDeletions(pendings []PendingNameDeletion) ([]PendingNameDeletion, error) {
+	keys := make(map[string][]byte, len(pendings))
+	for _, pending := range pendings {
+		key, err := GetPendingDeletionHeightKey(pending.DeleteHeight, pending.Name)
+		if err != nil {
+			return nil, fmt.Errorf("invalid pending deletion %q: %w", pending.Name, err)
+		}
+		keys[pending.Name] = key
+	}
+	rv := slices.Clone(pendings)
+	slices.SortStableFunc(rv, func(a, b PendingNameDeletion) int {
+		return bytes.Compare(keys[a.Name], keys[b.Name])
+	})
+	return rv, nil
+}
+
 // GetGenesisStateFromAppState returns x/name GenesisState given raw application genesis state.
 func GetGenesisStateFromAppState(cdc codec.Codec, appState map[string]json.RawMessage) *GenesisState {
 	var genesisState GenesisState
@@ -50,13 +89,35 @@ func (state GenesisState) Validate() error {
 			return fmt.Errorf("address cannot be empty")
 		}
 	}
+	for _, pending := range state.PendingDeletions {
+		if err := pending.Validate(); err != nil {
+			return err
+		}
+		if !NameRecords(state.Bindings).Contains(pending.Name) {
+			return fmt.Errorf("pending deletion name %q is not bound", pending.Name)
+		}
+	}
+	for _, approval := range state.AddressRotationApprovals {
+		if err := approval.Validate(); err != nil {
+			return fmt.Errorf("invalid address rotation approval: %w", err)
+		}
+	}
+	for _, quota := range state.ChildQuotas {
+		if err := quota.Validate(); err != nil {
+			return err
+		}
+		if !NameRecords(state.Bindings).Contains(quota.Name) {
+			return fmt.Errorf("child quota name %q is not bound", quota.Name)
+		}
+	}
 	return nil
 }
 
 // DefaultGenesisState returns the initial set of name -> address bindings.
 func DefaultGenesisState() *GenesisState {
 	return &GenesisState{
-		Params:   DefaultParams(),
-		Bindings: NameRecords{},
+		Params:           DefaultParams(),
+		Bindings:         NameRecords{},
+		PendingDeletions: []PendingNameDeletion{},
 	}
 }
@@ -29,6 +29,12 @@ type GenesisState struct {
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 	// bindings defines all the name records present at genesis
 	Bindings []NameRecord `protobuf:"bytes,2,rep,name=bindings,proto3" json:"bindings"`
+	// pending_deletions defines all the names that are pending deletion at genesis
+	PendingDeletions []PendingNameDeletion `protobuf:"bytes,3,rep,name=pending_deletions,json=pendingDeletions,proto3" json:"pending_deletions"`
+	// address_rotation_approvals defines all the address rotation approvals present at genesis
+	AddressRotationApprovals []AddressRotationApproval `protobuf:"bytes,4,rep,name=address_rotation_approvals,json=addressRotationApprovals,proto3" json:"address_rotation_approvals"`
+	// child_quotas defines all the child quotas that name owners have set on their names at genesis
+	ChildQuotas []ChildQuota `protobuf:"bytes,5,rep,name=child_quotas,json=childQuotas,proto3" json:"child_quotas"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -71,23 +77,30 @@ func init() {
 func init() { proto.RegisterFile("provenance/name/v1/genesis.proto", fileDescriptor_dba8546991615694) }
 
 var fileDescriptor_dba8546991615694 = []byte{
-	// 254 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x28, 0x28, 0xca, 0x2f,
-	0x4b, 0xcd, 0x4b, 0xcc, 0x4b, 0x4e, 0xd5, 0xcf, 0x4b, 0xcc, 0x4d, 0xd5, 0x2f, 0x33, 0xd4, 0x4f,
-	0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x42, 0xa8,
-	0xd0, 0x03, 0xa9, 0xd0, 0x2b, 0x33, 0x94, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0x4b, 0xeb, 0x83,
-	0x58, 0x10, 0x95, 0x52, 0xb2, 0x58, 0xcc, 0x02, 0xeb, 0x00, 0x4b, 0x2b, 0x4d, 0x61, 0xe4, 0xe2,
-	0x71, 0x87, 0x18, 0x1d, 0x5c, 0x92, 0x58, 0x92, 0x2a, 0x64, 0xc1, 0xc5, 0x56, 0x90, 0x58, 0x94,
-	0x98, 0x5b, 0x2c, 0xc1, 0xa8, 0xc0, 0xa8, 0xc1, 0x6d, 0x24, 0xa5, 0x87, 0x69, 0x95, 0x5e, 0x00,
-	0x58, 0x85, 0x13, 0xcb, 0x89, 0x7b, 0xf2, 0x0c, 0x41, 0x50, 0xf5, 0x42, 0x0e, 0x5c, 0x1c, 0x49,
-	0x99, 0x79, 0x29, 0x99, 0x79, 0xe9, 0xc5, 0x12, 0x4c, 0x0a, 0xcc, 0x1a, 0xdc, 0x46, 0x72, 0xd8,
-	0xf4, 0xfa, 0x25, 0xe6, 0xa6, 0x06, 0xa5, 0x26, 0xe7, 0x17, 0xa5, 0x40, 0xf5, 0xc3, 0x75, 0x59,
-	0x71, 0x74, 0x2c, 0x90, 0x67, 0x78, 0xb1, 0x40, 0x9e, 0xc1, 0x29, 0xf9, 0xc4, 0x23, 0x39, 0xc6,
-	0x0b, 0x8f, 0xe4, 0x18, 0x1f, 0x3c, 0x92, 0x63, 0x9c, 0xf0, 0x58, 0x8e, 0xe1, 0xc2, 0x63, 0x39,
-	0x86, 0x1b, 0x8f, 0xe5, 0x18, 0xb8, 0x44, 0x33, 0xf3, 0xb1, 0x98, 0x1a, 0xc0, 0x18, 0x65, 0x90,
-	0x9e, 0x59, 0x92, 0x51, 0x9a, 0xa4, 0x97, 0x9c, 0x9f, 0xab, 0x8f, 0x50, 0xa0, 0x9b, 0x99, 0x8f,
-	0xc4, 0xd3, 0xaf, 0x80, 0x84, 0x41, 0x49, 0x65, 0x41, 0x6a, 0x71, 0x12, 0x1b, 0x38, 0x08, 0x8c,
-	0x01, 0x01, 0x00, 0x00, 0xff, 0xff, 0xcb, 0x53, 0xef, 0x56, 0x6f, 0x01, 0x00, 0x00,
+	// 367 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0x41, 0x4f, 0xf2, 0x30,
+	0x18, 0xc7, 0xb7, 0x17, 0x5e, 0x42, 0x0a, 0x87, 0xf7, 0x6d, 0x34, 0x59, 0x96, 0x58, 0x88, 0x17,
+	0x49, 0x8c, 0x9b, 0xe0, 0xc5, 0x78, 0x12, 0x34, 0xe1, 0x66, 0x10, 0x6f, 0x5c, 0x96, 0xb2, 0x35,
+	0xa3, 0x09, 0x6b, 0xe7, 0x5a, 0x88, 0x7e, 0x03, 0x8f, 0x7e, 0x04, 0xce, 0x7e, 0x12, 0x8e, 0x1c,
+	0x3d, 0x19, 0x03, 0x17, 0x3f, 0x86, 0x69, 0x57, 0xc1, 0xc4, 0x79, 0xeb, 0x9e, 0xe7, 0xf7, 0xff,
+	0xfd, 0x97, 0xa6, 0xa0, 0x99, 0x66, 0x7c, 0x4e, 0x18, 0x66, 0x21, 0xf1, 0x19, 0x4e, 0x88, 0x3f,
+	0x6f, 0xfb, 0x31, 0x61, 0x44, 0x50, 0xe1, 0xa5, 0x19, 0x97, 0x1c, 0xc2, 0x1d, 0xe1, 0x29, 0xc2,
+	0x9b, 0xb7, 0xdd, 0xbd, 0x98, 0xc7, 0x5c, 0xaf, 0x7d, 0x75, 0xca, 0x49, 0xf7, 0xa0, 0xc0, 0xa5,
+	0x13, 0x7a, 0x7d, 0xf8, 0x52, 0x02, 0xf5, 0x7e, 0xae, 0xbe, 0x93, 0x58, 0x12, 0x78, 0x0e, 0x2a,
+	0x29, 0xce, 0x70, 0x22, 0x1c, 0xbb, 0x69, 0xb7, 0x6a, 0x1d, 0xd7, 0xfb, 0x59, 0xe5, 0x0d, 0x34,
+	0xd1, 0x2b, 0x2f, 0xdf, 0x1a, 0xd6, 0xd0, 0xf0, 0xf0, 0x12, 0x54, 0xc7, 0x94, 0x45, 0x94, 0xc5,
+	0xc2, 0xf9, 0xd3, 0x2c, 0xb5, 0x6a, 0x1d, 0x54, 0x94, 0xbd, 0xc1, 0x09, 0x19, 0x92, 0x90, 0x67,
+	0x91, 0xc9, 0x6f, 0x53, 0x70, 0x04, 0xfe, 0xa7, 0x44, 0x9f, 0x83, 0x88, 0x4c, 0x89, 0xa4, 0x9c,
+	0x09, 0xa7, 0xa4, 0x55, 0x47, 0x85, 0xbf, 0x91, 0xc3, 0xca, 0x78, 0x6d, 0x78, 0xe3, 0xfc, 0x67,
+	0x3c, 0x5f, 0x63, 0x01, 0x39, 0x70, 0x71, 0x14, 0x65, 0x44, 0x88, 0x20, 0xe3, 0x12, 0xab, 0x61,
+	0x80, 0x53, 0x25, 0xc5, 0x53, 0xe1, 0x94, 0x75, 0xc9, 0x71, 0x51, 0x49, 0x37, 0x4f, 0x0d, 0x4d,
+	0xa8, 0x6b, 0x32, 0xa6, 0xc8, 0xc1, 0xc5, 0x6b, 0x01, 0xfb, 0xa0, 0x1e, 0x4e, 0xe8, 0x34, 0x0a,
+	0xee, 0x67, 0x5c, 0x62, 0xe1, 0xfc, 0xfd, 0xfd, 0x4a, 0xae, 0x14, 0x77, 0xab, 0x30, 0x63, 0xad,
+	0x85, 0xdb, 0x89, 0xb8, 0xa8, 0x3e, 0x2d, 0x1a, 0xd6, 0xc7, 0xa2, 0x61, 0xf5, 0xc2, 0xe5, 0x1a,
+	0xd9, 0xab, 0x35, 0xb2, 0xdf, 0xd7, 0xc8, 0x7e, 0xde, 0x20, 0x6b, 0xb5, 0x41, 0xd6, 0xeb, 0x06,
+	0x59, 0x60, 0x9f, 0xf2, 0x02, 0xf1, 0xc0, 0x1e, 0x9d, 0xc6, 0x54, 0x4e, 0x66, 0x63, 0x2f, 0xe4,
+	0x89, 0xbf, 0x03, 0x4e, 0x28, 0xff, 0xf6, 0xe5, 0x3f, 0xe4, 0x2f, 0x43, 0x3e, 0xa6, 0x44, 0x8c,
+	0x2b, 0xfa, 0x61, 0x9c, 0x7d, 0x0e, 0x00, 0x02, 0xfe, 0xa0, 0xf6, 0x85, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -110,6 +123,48 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ChildQuotas) > 0 {
+		for iNdEx := len(m.ChildQuotas) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ChildQuotas[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.AddressRotationApprovals) > 0 {
+		for iNdEx := len(m.AddressRotationApprovals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AddressRotationApprovals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.PendingDeletions) > 0 {
+		for iNdEx := len(m.PendingDeletions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingDeletions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.Bindings) > 0 {
 		for iNdEx := len(m.Bindings) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -162,6 +217,24 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.PendingDeletions) > 0 {
+		for _, e := range m.PendingDeletions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AddressRotationApprovals) > 0 {
+		for _, e := range m.AddressRotationApprovals {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ChildQuotas) > 0 {
+		for _, e := range m.ChildQuotas {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -267,6 +340,108 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingDeletions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingDeletions = append(m.PendingDeletions, PendingNameDeletion{})
+			if err := m.PendingDeletions[len(m.PendingDeletions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddressRotationApprovals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AddressRotationApprovals = append(m.AddressRotationApprovals, AddressRotationApproval{})
+			if err := m.AddressRotationApprovals[len(m.AddressRotationApprovals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChildQuotas", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChildQuotas = append(m.ChildQuotas, ChildQuota{})
+			if err := m.ChildQuotas[len(m.ChildQuotas)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
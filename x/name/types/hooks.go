@@ -0,0 +1,48 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NameHooks defines the functions that get called after a name record is changed.
+// Implementations must not fail; they are notified of changes that have already been made.
+type NameHooks interface {
+	// AfterNameBound is called after a new name record is stored.
+	AfterNameBound(ctx sdk.Context, name string, addr sdk.AccAddress)
+	// AfterNameUpdated is called after an existing name record is changed.
+	// The oldAddr is that of the record before the change (it might equal the newAddr).
+	AfterNameUpdated(ctx sdk.Context, name string, oldAddr, newAddr sdk.AccAddress)
+	// AfterNameDeleted is called after a name record is removed.
+	AfterNameDeleted(ctx sdk.Context, name string, addr sdk.AccAddress)
+}
+
+// MultiNameHooks combines multiple name hooks. All hooks are run in the order provided.
+type MultiNameHooks []NameHooks
+
+var _ NameHooks = MultiNameHooks{}
+
+// NewMultiNameHooks creates a new MultiNameHooks containing the provided hooks.
+func NewMultiNameHooks(hooks ...NameHooks) MultiNameHooks {
+	return hooks
+}
+
+// AfterNameBound calls AfterNameBound on each of the hooks.
+func (h MultiNameHooks) AfterNameBound(ctx sdk.Context, name string, addr sdk.AccAddress) {
+	for _, hook := range h {
+		hook.AfterNameBound(ctx, name, addr)
+	}
+}
+
+// AfterNameUpdated calls AfterNameUpdated on each of the hooks.
+func (h MultiNameHooks) AfterNameUpdated(ctx sdk.Context, name string, oldAddr, newAddr sdk.AccAddress) {
+	for _, hook := range h {
+		hook.AfterNameUpdated(ctx, name, oldAddr, newAddr)
+	}
+}
+
+// AfterNameDeleted calls AfterNameDeleted on each of the hooks.
+func (h MultiNameHooks) AfterNameDeleted(ctx sdk.Context, name string, addr sdk.AccAddress) {
+	for _, hook := range h {
+		hook.AfterNameDeleted(ctx, name, addr)
+	}
+}
@@ -2,9 +2,12 @@ package types
 
 import (
 	"crypto/sha256"
+	"encoding/binary"
 	"fmt"
 	"strings"
 
+	"github.com/google/uuid"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/address"
 )
@@ -18,6 +21,9 @@ const (
 
 	// RouterKey is the message route for distribution
 	RouterKey = ModuleName
+
+	// MaxResolveManyNames is the maximum number of names that can be resolved in a single ResolveMany query.
+	MaxResolveManyNames = 100
 )
 
 var (
@@ -27,6 +33,30 @@ var (
 	AddressKeyPrefix = []byte{0x05}
 	// NameParamStoreKey key for marker module's params
 	NameParamStoreKey = []byte{0x06}
+	// NameCountKey is the key for the total number of bound names.
+	NameCountKey = []byte{0x07}
+	// RestrictedNameCountKey is the key for the number of bound names that are restricted.
+	RestrictedNameCountKey = []byte{0x08}
+	// RootNameCountKeyPrefix is a prefix added to keys for the number of bound names under each root name.
+	RootNameCountKeyPrefix = []byte{0x09}
+	// ChildNameKeyPrefix is a prefix added to keys for indexing name records by their parent name.
+	ChildNameKeyPrefix = []byte{0x0A}
+	// PendingDeletionKeyPrefix is a prefix added to keys for the names that are pending deletion.
+	PendingDeletionKeyPrefix = []byte{0x0B}
+	// PendingDeletionHeightKeyPrefix is a prefix added to keys for indexing pending deletions by their delete height.
+	PendingDeletionHeightKeyPrefix = []byte{0x0C}
+	// UUIDNameKeyPrefix is a prefix added to keys for indexing name records by the UUID segments they contain.
+	UUIDNameKeyPrefix = []byte{0x0D}
+	// AddressRotationApprovalKeyPrefix is a prefix added to keys for the approvals to rotate an old address to a new one.
+	AddressRotationApprovalKeyPrefix = []byte{0x0E}
+	// NameCreationKeyPrefix is a prefix added to keys for the block height that recently bound names were bound at.
+	NameCreationKeyPrefix = []byte{0x0F}
+	// NameCreationHeightKeyPrefix is a prefix added to keys for indexing recently bound names by the height they were bound at.
+	NameCreationHeightKeyPrefix = []byte{0x10}
+	// ChildNameCountKeyPrefix is a prefix added to keys for the number of names bound directly under each name.
+	ChildNameCountKeyPrefix = []byte{0x11}
+	// ChildQuotaKeyPrefix is a prefix added to keys for the child quotas that name owners have set on their names.
+	ChildQuotaKeyPrefix = []byte{0x12}
 )
 
 // GetNameKeyPrefix converts a name into key format.
@@ -69,6 +99,231 @@ func GetAddressKeyPrefix(addr sdk.AccAddress) (key []byte, err error) {
 	return
 }
 
+// ParseAddressKey returns the address and name record key from an address index key.
+// The key is [0x05][address length][address][name key].
+func ParseAddressKey(key []byte) (sdk.AccAddress, []byte, error) {
+	if len(key) < 2 || key[0] != AddressKeyPrefix[0] {
+		return nil, nil, fmt.Errorf("invalid address index key %X: missing prefix", key)
+	}
+	addrLen := int(key[1])
+	if len(key) <= 2+addrLen {
+		return nil, nil, fmt.Errorf("invalid address index key %X: too short", key)
+	}
+	return sdk.AccAddress(key[2 : 2+addrLen]), key[2+addrLen:], nil
+}
+
+// GetChildNameKeyPrefix returns the store key prefix for the names directly under the provided parent name.
+func GetChildNameKeyPrefix(parent string) ([]byte, error) {
+	parentKey, err := GetNameKeyPrefix(parent)
+	if err != nil {
+		return nil, err
+	}
+	key := make([]byte, 0, len(ChildNameKeyPrefix)+len(parentKey)-len(NameKeyPrefix))
+	key = append(key, ChildNameKeyPrefix...)
+	return append(key, parentKey[len(NameKeyPrefix):]...), nil
+}
+
+// GetChildNameKey returns the store key indexing the provided name under its parent name.
+// The key is [0x0A][parent name hash][name hash], and the name must have a parent.
+func GetChildNameKey(name string) ([]byte, error) {
+	parent, ok := GetParentName(name)
+	if !ok {
+		return nil, fmt.Errorf("name %q does not have a parent: %w", name, ErrNameInvalid)
+	}
+	key, err := GetChildNameKeyPrefix(parent)
+	if err != nil {
+		return nil, err
+	}
+	nameKey, err := GetNameKeyPrefix(name)
+	if err != nil {
+		return nil, err
+	}
+	return append(key, nameKey[len(NameKeyPrefix):]...), nil
+}
+
+// GetChildNameCountKey returns the store key for the number of names bound directly under the provided parent name.
+// The key is [0x11][parent name hash].
+func GetChildNameCountKey(parent string) ([]byte, error) {
+	return getNamePrefixByType(parent, ChildNameCountKeyPrefix)
+}
+
+// GetChildQuotaKey returns the store key for the child quota of the provided name.
+// The key is [0x12][name hash].
+func GetChildQuotaKey(name string) ([]byte, error) {
+	return getNamePrefixByType(name, ChildQuotaKeyPrefix)
+}
+
+// GetParentName returns the parent of the provided name, i.e. everything after its first segment.
+// Returns false if the name is a root name.
+func GetParentName(name string) (string, bool) {
+	i := strings.Index(name, ".")
+	if i < 0 {
+		return "", false
+	}
+	return name[i+1:], true
+}
+
+// GetPendingDeletionKey returns the store key for the pending deletion of the provided name.
+// The key is [0x0B][name hash].
+func GetPendingDeletionKey(name string) ([]byte, error) {
+	nameKey, err := GetNameKeyPrefix(name)
+	if err != nil {
+		return nil, err
+	}
+	key := make([]byte, 0, len(PendingDeletionKeyPrefix)+len(nameKey)-len(NameKeyPrefix))
+	key = append(key, PendingDeletionKeyPrefix...)
+	return append(key, nameKey[len(NameKeyPrefix):]...), nil
+}
+
+// GetPendingDeletionHeightKeyPrefix returns the store key prefix for the pending deletions with the provided delete height.
+// The key is [0x0C][height], where the height is 8 big-endian bytes.
+func GetPendingDeletionHeightKeyPrefix(height int64) []byte {
+	key := make([]byte, len(PendingDeletionHeightKeyPrefix), len(PendingDeletionHeightKeyPrefix)+8)
+	copy(key, PendingDeletionHeightKeyPrefix)
+	return binary.BigEndian.AppendUint64(key, uint64(height))
+}
+
+// GetPendingDeletionHeightKey returns the store key indexing the pending deletion of the provided name by its delete height.
+// The key is [0x0C][height][name hash], where the height is 8 big-endian bytes.
+func GetPendingDeletionHeightKey(height int64, name string) ([]byte, error) {
+	nameKey, err := GetNameKeyPrefix(name)
+	if err != nil {
+		return nil, err
+	}
+	return append(GetPendingDeletionHeightKeyPrefix(height), nameKey[len(NameKeyPrefix):]...), nil
+}
+
+// ParsePendingDeletionHeightKey returns the pending deletion key referenced by the provided pending deletion height key.
+func ParsePendingDeletionHeightKey(key []byte) ([]byte, error) {
+	hashStart := len(PendingDeletionHeightKeyPrefix) + 8
+	if len(key) <= hashStart {
+		return nil, fmt.Errorf("invalid pending deletion height key %X: too short", key)
+	}
+	rv := make([]byte, 0, len(PendingDeletionKeyPrefix)+len(key)-hashStart)
+	rv = append(rv, PendingDeletionKeyPrefix...)
+	return append(rv, key[hashStart:]...), nil
+}
+
+// GetNameCreationKey returns the store key for the block height that the provided name was bound at.
+// The key is [0x0F][name hash].
+func GetNameCreationKey(name string) ([]byte, error) {
+	nameKey, err := GetNameKeyPrefix(name)
+	if err != nil {
+		return nil, err
+	}
+	key := make([]byte, 0, len(NameCreationKeyPrefix)+len(nameKey)-len(NameKeyPrefix))
+	key = append(key, NameCreationKeyPrefix...)
+	return append(key, nameKey[len(NameKeyPrefix):]...), nil
+}
+
+// GetNameCreationHeightKeyPrefix returns the store key prefix for the names bound at the provided height.
+// The key is [0x10][height], where the height is 8 big-endian bytes.
+func GetNameCreationHeightKeyPrefix(height int64) []byte {
+	key := make([]byte, len(NameCreationHeightKeyPrefix), len(NameCreationHeightKeyPrefix)+8)
+	copy(key, NameCreationHeightKeyPrefix)
+	return binary.BigEndian.AppendUint64(key, uint64(height))
+}
+
+// GetNameCreationHeightKey returns the store key indexing the provided name by the height it was bound at.
+// The key is [0x10][height][name hash], where the height is 8 big-endian bytes.
+func GetNameCreationHeightKey(height int64, name string) ([]byte, error) {
+	nameKey, err := GetNameKeyPrefix(name)
+	if err != nil {
+		return nil, err
+	}
+	return append(GetNameCreationHeightKeyPrefix(height), nameKey[len(NameKeyPrefix):]...), nil
+}
+
+// ParseNameCreationHeightKey returns the height and name key referenced by the provided name creation height key.
+func ParseNameCreationHeightKey(key []byte) (int64, []byte, error) {
+	hashStart := len(NameCreationHeightKeyPrefix) + 8
+	if len(key) <= hashStart {
+		return 0, nil, fmt.Errorf("invalid name creation height key %X: too short", key)
+	}
+	height := int64(binary.BigEndian.Uint64(key[len(NameCreationHeightKeyPrefix):hashStart]))
+	nameKey := make([]byte, 0, len(NameKeyPrefix)+len(key)-hashStart)
+	nameKey = append(nameKey, NameKeyPrefix...)
+	return height, append(nameKey, key[hashStart:]...), nil
+}
+
+// GetUUIDNameKeyPrefix returns the store key prefix for the names that contain the provided UUID as a segment.
+// The key is [0x0D][uuid], where the uuid is its 16 bytes, so any textual form of the UUID gives the same key.
+func GetUUIDNameKeyPrefix(uuidStr string) ([]byte, error) {
+	id, err := uuid.Parse(uuidStr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid uuid %q: %w", uuidStr, err)
+	}
+	key := make([]byte, 0, len(UUIDNameKeyPrefix)+len(id))
+	key = append(key, UUIDNameKeyPrefix...)
+	return append(key, id[:]...), nil
+}
+
+// GetUUIDNameKey returns the store key indexing the provided name under a UUID segment that it contains.
+// The key is [0x0D][uuid][name hash].
+func GetUUIDNameKey(uuidStr string, name string) ([]byte, error) {
+	key, err := GetUUIDNameKeyPrefix(uuidStr)
+	if err != nil {
+		return nil, err
+	}
+	nameKey, err := GetNameKeyPrefix(name)
+	if err != nil {
+		return nil, err
+	}
+	return append(key, nameKey[len(NameKeyPrefix):]...), nil
+}
+
+// GetUUIDSegments returns the segments of the provided name that are UUIDs, without duplicates.
+func GetUUIDSegments(name string) []string {
+	var rv []string
+	seen := make(map[uuid.UUID]bool)
+	for _, segment := range strings.Split(name, ".") {
+		id, err := uuid.Parse(segment)
+		if err != nil || seen[id] {
+			continue
+		}
+		seen[id] = true
+		rv = append(rv, segment)
+	}
+	return rv
+}
+
+// GetAddressRotationApprovalKey returns the store key for the approval to rotate the old address to the new address.
+// The key is [0x0E][len(old)][old][new].
+func GetAddressRotationApprovalKey(oldAddr, newAddr sdk.AccAddress) []byte {
+	oldBz := address.MustLengthPrefix(oldAddr)
+	key := make([]byte, 0, len(AddressRotationApprovalKeyPrefix)+len(oldBz)+len(newAddr))
+	key = append(key, AddressRotationApprovalKeyPrefix...)
+	key = append(key, oldBz...)
+	return append(key, newAddr...)
+}
+
+// ParseAddressRotationApprovalKey returns the old and new addresses of the provided address rotation approval key.
+func ParseAddressRotationApprovalKey(key []byte) (oldAddr, newAddr sdk.AccAddress, err error) {
+	if len(key) <= len(AddressRotationApprovalKeyPrefix) {
+		return nil, nil, fmt.Errorf("invalid address rotation approval key %X: too short", key)
+	}
+	rest := key[len(AddressRotationApprovalKeyPrefix):]
+	oldLen := int(rest[0])
+	if len(rest) <= 1+oldLen {
+		return nil, nil, fmt.Errorf("invalid address rotation approval key %X: too short for old address length %d", key, oldLen)
+	}
+	oldAddr = sdk.AccAddress(rest[1 : 1+oldLen])
+	newAddr = sdk.AccAddress(rest[1+oldLen:])
+	return oldAddr, newAddr, nil
+}
+
+// GetRootNameCountKey returns the store key for the number of bound names under the provided root name.
+func GetRootNameCountKey(root string) []byte {
+	key := make([]byte, 0, len(RootNameCountKeyPrefix)+len(root))
+	key = append(key, RootNameCountKeyPrefix...)
+	return append(key, root...)
+}
+
+// GetRootName returns the root of the provided name, i.e. its last segment.
+func GetRootName(name string) string {
+	return name[strings.LastIndex(name, ".")+1:]
+}
+
 func ValidateAddress(address sdk.AccAddress) error {
 	return sdk.VerifyAddressFormat(address)
 }
@@ -109,6 +109,206 @@ func (s *NameKeyTestSuite) TestAddressKeyPrefix() {
 	s.Assert().Equal(AddressKeyPrefix, key[0:1])
 }
 
+func (s *NameKeyTestSuite) TestParseAddressKey() {
+	prefix, err := GetAddressKeyPrefix(s.addr1)
+	s.Require().NoError(err, "GetAddressKeyPrefix")
+	nameKey, err := GetNameKeyPrefix("foo.bar")
+	s.Require().NoError(err, "GetNameKeyPrefix")
+
+	addr, actNameKey, err := ParseAddressKey(append(prefix, nameKey...))
+	s.Require().NoError(err, "ParseAddressKey")
+	s.Assert().Equal(s.addr1, addr, "address")
+	s.Assert().Equal(nameKey, actNameKey, "name key")
+
+	_, _, err = ParseAddressKey(prefix)
+	s.Assert().ErrorContains(err, "too short", "ParseAddressKey without a name key")
+	_, _, err = ParseAddressKey(nameKey)
+	s.Assert().ErrorContains(err, "missing prefix", "ParseAddressKey of a name key")
+}
+
+func (s *NameKeyTestSuite) TestRootNameCountKey() {
+	key := GetRootNameCountKey("pb")
+	s.Assert().Equal("09", hex.EncodeToString(key[0:1]), "key type byte")
+	s.Assert().Equal("pb", string(key[1:]), "key root name")
+	s.Assert().Equal(RootNameCountKeyPrefix, GetRootNameCountKey(""), "key for empty root")
+}
+
+func (s *NameKeyTestSuite) TestGetRootName() {
+	tests := []struct {
+		name string
+		exp  string
+	}{
+		{name: "", exp: ""},
+		{name: "pb", exp: "pb"},
+		{name: "name.pb", exp: "pb"},
+		{name: "first.second.third", exp: "third"},
+	}
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.Assert().Equal(tc.exp, GetRootName(tc.name), "GetRootName(%q)", tc.name)
+		})
+	}
+}
+
+func (s *NameKeyTestSuite) TestGetParentName() {
+	tests := []struct {
+		name  string
+		exp   string
+		expOk bool
+	}{
+		{name: "", exp: "", expOk: false},
+		{name: "pb", exp: "", expOk: false},
+		{name: "name.pb", exp: "pb", expOk: true},
+		{name: "first.second.third", exp: "second.third", expOk: true},
+	}
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			parent, ok := GetParentName(tc.name)
+			s.Assert().Equal(tc.exp, parent, "GetParentName(%q) parent", tc.name)
+			s.Assert().Equal(tc.expOk, ok, "GetParentName(%q) ok", tc.name)
+		})
+	}
+}
+
+func (s *NameKeyTestSuite) TestChildNameKey() {
+	parentKey, err := GetNameKeyPrefix("example.pb")
+	s.Require().NoError(err, "GetNameKeyPrefix parent")
+	nameKey, err := GetNameKeyPrefix("name.example.pb")
+	s.Require().NoError(err, "GetNameKeyPrefix name")
+
+	prefix, err := GetChildNameKeyPrefix("example.pb")
+	s.Require().NoError(err, "GetChildNameKeyPrefix")
+	s.Assert().Equal("0a", hex.EncodeToString(prefix[0:1]), "prefix type byte")
+	s.Assert().Equal(parentKey[1:], prefix[1:], "prefix parent hash")
+
+	key, err := GetChildNameKey("name.example.pb")
+	s.Require().NoError(err, "GetChildNameKey")
+	s.Assert().Equal(prefix, key[:len(prefix)], "key prefix")
+	s.Assert().Equal(nameKey[1:], key[len(prefix):], "key name hash")
+
+	_, err = GetChildNameKey("pb")
+	s.Assert().EqualError(err, `name "pb" does not have a parent: value provided for name is invalid`, "GetChildNameKey root name")
+	_, err = GetChildNameKeyPrefix("")
+	s.Assert().Error(err, "GetChildNameKeyPrefix empty name")
+}
+
+func (s *NameKeyTestSuite) TestPendingDeletionKeys() {
+	nameKey, err := GetNameKeyPrefix("name.example.pb")
+	s.Require().NoError(err, "GetNameKeyPrefix")
+
+	key, err := GetPendingDeletionKey("name.example.pb")
+	s.Require().NoError(err, "GetPendingDeletionKey")
+	s.Assert().Equal("0b", hex.EncodeToString(key[0:1]), "key type byte")
+	s.Assert().Equal(nameKey[1:], key[1:], "key name hash")
+
+	prefix := GetPendingDeletionHeightKeyPrefix(100)
+	s.Assert().Equal("0c0000000000000064", hex.EncodeToString(prefix), "height key prefix")
+
+	heightKey, err := GetPendingDeletionHeightKey(100, "name.example.pb")
+	s.Require().NoError(err, "GetPendingDeletionHeightKey")
+	s.Assert().Equal(prefix, heightKey[:len(prefix)], "height key prefix")
+	s.Assert().Equal(nameKey[1:], heightKey[len(prefix):], "height key name hash")
+
+	parsed, err := ParsePendingDeletionHeightKey(heightKey)
+	s.Require().NoError(err, "ParsePendingDeletionHeightKey")
+	s.Assert().Equal(key, parsed, "ParsePendingDeletionHeightKey result")
+
+	_, err = ParsePendingDeletionHeightKey(prefix)
+	s.Assert().Error(err, "ParsePendingDeletionHeightKey without name hash")
+	_, err = GetPendingDeletionKey("")
+	s.Assert().Error(err, "GetPendingDeletionKey empty name")
+}
+
+func (s *NameKeyTestSuite) TestNameCreationKeys() {
+	nameKey, err := GetNameKeyPrefix("name.example.pb")
+	s.Require().NoError(err, "GetNameKeyPrefix")
+
+	key, err := GetNameCreationKey("name.example.pb")
+	s.Require().NoError(err, "GetNameCreationKey")
+	s.Assert().Equal(NameCreationKeyPrefix, key[:1], "key prefix")
+	s.Assert().Equal(nameKey[1:], key[1:], "key name hash")
+
+	prefix := GetNameCreationHeightKeyPrefix(100)
+	s.Assert().Equal("100000000000000064", hex.EncodeToString(prefix), "height key prefix")
+
+	heightKey, err := GetNameCreationHeightKey(100, "name.example.pb")
+	s.Require().NoError(err, "GetNameCreationHeightKey")
+	s.Assert().Equal(prefix, heightKey[:len(prefix)], "height key prefix")
+	s.Assert().Equal(nameKey[1:], heightKey[len(prefix):], "height key name hash")
+
+	height, parsed, err := ParseNameCreationHeightKey(heightKey)
+	s.Require().NoError(err, "ParseNameCreationHeightKey")
+	s.Assert().Equal(int64(100), height, "ParseNameCreationHeightKey height")
+	s.Assert().Equal(nameKey, parsed, "ParseNameCreationHeightKey name key")
+
+	_, _, err = ParseNameCreationHeightKey(prefix)
+	s.Assert().Error(err, "ParseNameCreationHeightKey without name hash")
+	_, err = GetNameCreationKey("")
+	s.Assert().Error(err, "GetNameCreationKey empty name")
+}
+
+func (s *NameKeyTestSuite) TestUUIDNameKeys() {
+	const id = "0a1b2c3d-4e5f-6071-8293-a4b5c6d7e8f9"
+	nameKey, err := GetNameKeyPrefix(id + ".example.pb")
+	s.Require().NoError(err, "GetNameKeyPrefix")
+
+	prefix, err := GetUUIDNameKeyPrefix(id)
+	s.Require().NoError(err, "GetUUIDNameKeyPrefix")
+	s.Assert().Equal("0d0a1b2c3d4e5f60718293a4b5c6d7e8f9", hex.EncodeToString(prefix), "prefix")
+	upper, err := GetUUIDNameKeyPrefix("0A1B2C3D-4E5F-6071-8293-A4B5C6D7E8F9")
+	s.Require().NoError(err, "GetUUIDNameKeyPrefix upper case")
+	s.Assert().Equal(prefix, upper, "prefix from upper case uuid")
+
+	key, err := GetUUIDNameKey(id, id+".example.pb")
+	s.Require().NoError(err, "GetUUIDNameKey")
+	s.Assert().Equal(prefix, key[:len(prefix)], "key prefix")
+	s.Assert().Equal(nameKey[1:], key[len(prefix):], "key name hash")
+
+	_, err = GetUUIDNameKeyPrefix("example")
+	s.Assert().EqualError(err, `invalid uuid "example": invalid UUID length: 7`, "GetUUIDNameKeyPrefix not a uuid")
+	_, err = GetUUIDNameKey(id, "")
+	s.Assert().Error(err, "GetUUIDNameKey empty name")
+}
+
+func (s *NameKeyTestSuite) TestGetUUIDSegments() {
+	const id1 = "0a1b2c3d-4e5f-6071-8293-a4b5c6d7e8f9"
+	const id2 = "f9e8d7c6-b5a4-9382-7160-5f4e3d2c1b0a"
+	tests := []struct {
+		name string
+		exp  []string
+	}{
+		{name: "", exp: nil},
+		{name: "example.pb", exp: nil},
+		{name: id1, exp: []string{id1}},
+		{name: id1 + ".example.pb", exp: []string{id1}},
+		{name: id2 + "." + id1 + ".pb", exp: []string{id2, id1}},
+		{name: id1 + ".example." + id1 + ".pb", exp: []string{id1}},
+	}
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.Assert().Equal(tc.exp, GetUUIDSegments(tc.name), "GetUUIDSegments(%q)", tc.name)
+		})
+	}
+}
+
+func (s *NameKeyTestSuite) TestAddressRotationApprovalKey() {
+	key := GetAddressRotationApprovalKey(s.addr1, s.addr2)
+	s.Assert().Equal(AddressRotationApprovalKeyPrefix, key[0:1], "key prefix")
+	s.Assert().Equal(byte(len(s.addr1)), key[1], "old address length byte")
+	s.Assert().Equal([]byte(s.addr1), key[2:2+len(s.addr1)], "old address bytes")
+	s.Assert().Equal([]byte(s.addr2), key[2+len(s.addr1):], "new address bytes")
+
+	oldAddr, newAddr, err := ParseAddressRotationApprovalKey(key)
+	s.Require().NoError(err, "ParseAddressRotationApprovalKey")
+	s.Assert().Equal(s.addr1, oldAddr, "parsed old address")
+	s.Assert().Equal(s.addr2, newAddr, "parsed new address")
+
+	_, _, err = ParseAddressRotationApprovalKey(AddressRotationApprovalKeyPrefix)
+	s.Assert().EqualError(err, "invalid address rotation approval key 0E: too short", "ParseAddressRotationApprovalKey(prefix)")
+	_, _, err = ParseAddressRotationApprovalKey(key[:2+len(s.addr1)])
+	s.Assert().ErrorContains(err, "too short for old address length", "ParseAddressRotationApprovalKey(no new address)")
+}
+
 func mustHexDecode(h string) []byte {
 	var err error
 	var result []byte
@@ -4,17 +4,29 @@ import (
 	"fmt"
 	"strings"
 
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/provenance-io/provenance/internal/bech32util"
 )
 
+var _ cdctypes.UnpackInterfacesMessage = (*MsgBindNameRequest)(nil)
+
 // AllRequestMsgs defines all the Msg*Request messages.
 var AllRequestMsgs = []sdk.Msg{
 	(*MsgBindNameRequest)(nil),
 	(*MsgDeleteNameRequest)(nil),
+	(*MsgDeleteNamesRequest)(nil),
 	(*MsgModifyNameRequest)(nil),
 	(*MsgCreateRootNameRequest)(nil),
 	(*MsgUpdateParamsRequest)(nil),
+	(*MsgRemoveNameRequest)(nil),
+	(*MsgSendByNameRequest)(nil),
+	(*MsgApproveAddressRotationRequest)(nil),
+	(*MsgRotateAddressRequest)(nil),
+	(*MsgSetChildQuotaRequest)(nil),
+	(*MsgCreateNamedAccountRequest)(nil),
 }
 
 func NewMsgBindNameRequest(record, parent NameRecord) *MsgBindNameRequest {
@@ -40,9 +52,22 @@ func (msg MsgBindNameRequest) ValidateBasic() error {
 	if strings.TrimSpace(msg.Record.Address) == "" {
 		return fmt.Errorf("address cannot be empty")
 	}
+	if msg.ParentApproval != nil {
+		if err := msg.ParentApproval.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid parent approval: %w", err)
+		}
+	}
 	return nil
 }
 
+// UnpackInterfaces implements cdctypes.UnpackInterfacesMessage
+func (msg MsgBindNameRequest) UnpackInterfaces(unpacker cdctypes.AnyUnpacker) error {
+	if msg.ParentApproval == nil {
+		return nil
+	}
+	return msg.ParentApproval.UnpackInterfaces(unpacker)
+}
+
 func NewMsgDeleteNameRequest(record NameRecord) *MsgDeleteNameRequest {
 	return &MsgDeleteNameRequest{
 		Record: record,
@@ -59,6 +84,24 @@ func (msg MsgDeleteNameRequest) ValidateBasic() error {
 	return nil
 }
 
+func NewMsgDeleteNamesRequest(owner, name string, recursive bool) *MsgDeleteNamesRequest {
+	return &MsgDeleteNamesRequest{
+		Owner:     owner,
+		Name:      name,
+		Recursive: recursive,
+	}
+}
+
+func (msg MsgDeleteNamesRequest) ValidateBasic() error {
+	if _, err := bech32util.ValidateAccAddress(msg.Owner); err != nil {
+		return fmt.Errorf("invalid owner address: %w", err)
+	}
+	if strings.TrimSpace(msg.Name) == "" {
+		return fmt.Errorf("name cannot be empty")
+	}
+	return nil
+}
+
 func NewMsgModifyNameRequest(authority string, name string, owner sdk.AccAddress, restricted bool) *MsgModifyNameRequest {
 	return &MsgModifyNameRequest{
 		Authority: authority,
@@ -70,7 +113,7 @@ func (msg MsgModifyNameRequest) ValidateBasic() error {
 	if strings.TrimSpace(msg.Record.Name) == "" {
 		return fmt.Errorf("name cannot be empty")
 	}
-	if _, err := sdk.AccAddressFromBech32(msg.Record.Address); err != nil {
+	if _, err := bech32util.ValidateAccAddress(msg.Record.Address); err != nil {
 		return fmt.Errorf("invalid record address: %w", err)
 	}
 	if strings.TrimSpace(msg.GetAuthority()) == "" {
@@ -91,7 +134,7 @@ func NewMsgCreateRootNameRequest(authority string, name string, address string,
 }
 
 func (msg MsgCreateRootNameRequest) ValidateBasic() error {
-	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+	if _, err := bech32util.ValidateAccAddress(msg.Authority); err != nil {
 		return ErrInvalidAddress
 	}
 
@@ -103,11 +146,30 @@ func (msg MsgCreateRootNameRequest) ValidateBasic() error {
 	return nil
 }
 
+func NewMsgRemoveNameRequest(authority, name string, recursive bool) *MsgRemoveNameRequest {
+	return &MsgRemoveNameRequest{
+		Authority: authority,
+		Name:      name,
+		Recursive: recursive,
+	}
+}
+
+func (msg MsgRemoveNameRequest) ValidateBasic() error {
+	if _, err := bech32util.ValidateAccAddress(msg.Authority); err != nil {
+		return fmt.Errorf("invalid authority: %w", err)
+	}
+	if strings.TrimSpace(msg.Name) == "" {
+		return fmt.Errorf("name cannot be empty")
+	}
+	return nil
+}
+
 func NewMsgUpdateParamsRequest(
 	maxSegmentLength uint32,
 	minSegmentLength uint32,
 	maxNameLevels uint32,
 	allowUnrestrictedNames bool,
+	maxDeletions uint32,
 	authority string,
 ) *MsgUpdateParamsRequest {
 	return &MsgUpdateParamsRequest{
@@ -117,11 +179,99 @@ func NewMsgUpdateParamsRequest(
 			minSegmentLength,
 			maxNameLevels,
 			allowUnrestrictedNames,
+			maxDeletions,
 		),
 	}
 }
 
 func (msg MsgUpdateParamsRequest) ValidateBasic() error {
-	_, err := sdk.AccAddressFromBech32(msg.Authority)
-	return err
+	if _, err := bech32util.ValidateAccAddress(msg.Authority); err != nil {
+		return err
+	}
+	return msg.Params.ValidateContractNamePolicies()
+}
+
+func NewMsgSendByNameRequest(fromAddress, toName string, amount sdk.Coins) *MsgSendByNameRequest {
+	return &MsgSendByNameRequest{
+		FromAddress: fromAddress,
+		ToName:      toName,
+		Amount:      amount,
+	}
+}
+
+func (msg MsgSendByNameRequest) ValidateBasic() error {
+	if _, err := bech32util.ValidateAccAddress(msg.FromAddress); err != nil {
+		return fmt.Errorf("invalid from address: %w", err)
+	}
+	if strings.TrimSpace(msg.ToName) == "" {
+		return fmt.Errorf("name cannot be empty")
+	}
+	if err := msg.Amount.Validate(); err != nil {
+		return fmt.Errorf("invalid amount: %w", err)
+	}
+	if msg.Amount.IsZero() {
+		return fmt.Errorf("invalid amount: cannot be zero")
+	}
+	return nil
+}
+
+func NewMsgApproveAddressRotationRequest(newAddress, oldAddress string) *MsgApproveAddressRotationRequest {
+	return &MsgApproveAddressRotationRequest{
+		NewAddress: newAddress,
+		OldAddress: oldAddress,
+	}
+}
+
+func (msg MsgApproveAddressRotationRequest) ValidateBasic() error {
+	return ValidateAddressRotation(msg.OldAddress, msg.NewAddress)
+}
+
+func NewMsgRotateAddressRequest(oldAddress, newAddress string) *MsgRotateAddressRequest {
+	return &MsgRotateAddressRequest{
+		OldAddress: oldAddress,
+		NewAddress: newAddress,
+	}
+}
+
+func (msg MsgRotateAddressRequest) ValidateBasic() error {
+	return ValidateAddressRotation(msg.OldAddress, msg.NewAddress)
+}
+
+func NewMsgSetChildQuotaRequest(name string, maxChildren uint64, owner string) *MsgSetChildQuotaRequest {
+	return &MsgSetChildQuotaRequest{
+		Name:        strings.ToLower(strings.TrimSpace(name)),
+		MaxChildren: maxChildren,
+		Owner:       owner,
+	}
+}
+
+func (msg MsgSetChildQuotaRequest) ValidateBasic() error {
+	if strings.TrimSpace(msg.Name) == "" {
+		return fmt.Errorf("name cannot be empty")
+	}
+	if _, err := bech32util.ValidateAccAddress(msg.Owner); err != nil {
+		return fmt.Errorf("invalid owner: %w", err)
+	}
+	return nil
+}
+
+func NewMsgCreateNamedAccountRequest(name string, restricted bool, owner string) *MsgCreateNamedAccountRequest {
+	return &MsgCreateNamedAccountRequest{
+		Name:       strings.ToLower(strings.TrimSpace(name)),
+		Restricted: restricted,
+		Owner:      owner,
+	}
+}
+
+func (msg MsgCreateNamedAccountRequest) ValidateBasic() error {
+	if strings.TrimSpace(msg.Name) == "" {
+		return fmt.Errorf("name cannot be empty")
+	}
+	if _, hasParent := GetParentName(strings.TrimSpace(msg.Name)); !hasParent {
+		return fmt.Errorf("name %q must have a parent", msg.Name)
+	}
+	if _, err := bech32util.ValidateAccAddress(msg.Owner); err != nil {
+		return fmt.Errorf("invalid owner: %w", err)
+	}
+	return nil
 }
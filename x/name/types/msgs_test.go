@@ -1,10 +1,13 @@
 package types_test
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
 
+	sdkmath "cosmossdk.io/math"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/provenance-io/provenance/testutil"
@@ -16,9 +19,16 @@ func TestAllMsgsGetSigners(t *testing.T) {
 	msgMakers := []testutil.MsgMaker{
 		func(signer string) sdk.Msg { return &MsgBindNameRequest{Parent: NameRecord{Address: signer}} },
 		func(signer string) sdk.Msg { return &MsgDeleteNameRequest{Record: NameRecord{Address: signer}} },
+		func(signer string) sdk.Msg { return &MsgDeleteNamesRequest{Owner: signer} },
 		func(signer string) sdk.Msg { return &MsgModifyNameRequest{Authority: signer} },
 		func(signer string) sdk.Msg { return &MsgCreateRootNameRequest{Authority: signer} },
 		func(signer string) sdk.Msg { return &MsgUpdateParamsRequest{Authority: signer} },
+		func(signer string) sdk.Msg { return &MsgRemoveNameRequest{Authority: signer} },
+		func(signer string) sdk.Msg { return &MsgSendByNameRequest{FromAddress: signer} },
+		func(signer string) sdk.Msg { return &MsgApproveAddressRotationRequest{NewAddress: signer} },
+		func(signer string) sdk.Msg { return &MsgRotateAddressRequest{OldAddress: signer} },
+		func(signer string) sdk.Msg { return &MsgSetChildQuotaRequest{Owner: signer} },
+		func(signer string) sdk.Msg { return &MsgCreateNamedAccountRequest{Owner: signer} },
 	}
 
 	testutil.RunGetSignersTests(t, AllRequestMsgs, msgMakers, nil)
@@ -71,6 +81,101 @@ func TestMsgCreateRootNameRequestValidateBasic(t *testing.T) {
 	}
 }
 
+func TestMsgDeleteNamesRequestValidateBasic(t *testing.T) {
+	owner := sdk.AccAddress("input111111111111111").String()
+
+	testCases := []struct {
+		name   string
+		msg    *MsgDeleteNamesRequest
+		expErr string
+	}{
+		{
+			name: "valid",
+			msg:  NewMsgDeleteNamesRequest(owner, "example.name", false),
+		},
+		{
+			name: "valid recursive",
+			msg:  NewMsgDeleteNamesRequest(owner, "example.name", true),
+		},
+		{
+			name:   "empty owner",
+			msg:    NewMsgDeleteNamesRequest("", "example.name", true),
+			expErr: "invalid owner address: empty address string is not allowed",
+		},
+		{
+			name:   "invalid owner",
+			msg:    NewMsgDeleteNamesRequest("blah", "example.name", true),
+			expErr: "invalid owner address: decoding bech32 failed: invalid bech32 string length 4",
+		},
+		{
+			name:   "uppercase owner",
+			msg:    NewMsgDeleteNamesRequest(strings.ToUpper(owner), "example.name", true),
+			expErr: "invalid owner address: address \"" + strings.ToUpper(owner) + "\" is not in canonical form, expected \"" + owner + "\"",
+		},
+		{
+			name:   "empty name",
+			msg:    NewMsgDeleteNamesRequest(owner, " ", true),
+			expErr: "name cannot be empty",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if len(tc.expErr) > 0 {
+				require.EqualError(t, err, tc.expErr, "ValidateBasic")
+			} else {
+				require.NoError(t, err, "ValidateBasic")
+			}
+		})
+	}
+}
+
+func TestMsgRemoveNameRequestValidateBasic(t *testing.T) {
+	authority := sdk.AccAddress("input111111111111111").String()
+
+	testCases := []struct {
+		name   string
+		msg    *MsgRemoveNameRequest
+		expErr string
+	}{
+		{
+			name: "valid",
+			msg:  NewMsgRemoveNameRequest(authority, "example.name", false),
+		},
+		{
+			name: "valid recursive",
+			msg:  NewMsgRemoveNameRequest(authority, "example.name", true),
+		},
+		{
+			name:   "empty authority",
+			msg:    NewMsgRemoveNameRequest("", "example.name", true),
+			expErr: "invalid authority: empty address string is not allowed",
+		},
+		{
+			name:   "invalid authority",
+			msg:    NewMsgRemoveNameRequest("blah", "example.name", true),
+			expErr: "invalid authority: decoding bech32 failed: invalid bech32 string length 4",
+		},
+		{
+			name:   "empty name",
+			msg:    NewMsgRemoveNameRequest(authority, " ", true),
+			expErr: "name cannot be empty",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if len(tc.expErr) > 0 {
+				require.EqualError(t, err, tc.expErr, "ValidateBasic")
+			} else {
+				require.NoError(t, err, "ValidateBasic")
+			}
+		})
+	}
+}
+
 func TestMsgUpdateParamsRequestValidateBasic(t *testing.T) {
 	authority := sdk.AccAddress("input111111111111111").String()
 
@@ -107,7 +212,7 @@ func TestMsgUpdateParamsRequestValidateBasic(t *testing.T) {
 	}
 
 	for _, tc := range testCases {
-		msg := NewMsgUpdateParamsRequest(tc.maxSegmentLength, tc.minSegmentLength, tc.maxNameLevels, tc.allowUnrestrictedNames, tc.authority)
+		msg := NewMsgUpdateParamsRequest(tc.maxSegmentLength, tc.minSegmentLength, tc.maxNameLevels, tc.allowUnrestrictedNames, DefaultMaxDeletions, tc.authority)
 		err := msg.ValidateBasic()
 		if tc.shouldFail {
 			require.EqualError(t, err, tc.expectedErr, "expected error for case: %s", tc.name)
@@ -116,3 +221,187 @@ func TestMsgUpdateParamsRequestValidateBasic(t *testing.T) {
 		}
 	}
 }
+
+func TestMsgSendByNameRequestValidateBasic(t *testing.T) {
+	from := sdk.AccAddress("input111111111111111").String()
+	amount := sdk.NewCoins(sdk.NewInt64Coin("nhash", 100))
+
+	testCases := []struct {
+		name   string
+		msg    *MsgSendByNameRequest
+		expErr string
+	}{
+		{
+			name: "valid",
+			msg:  NewMsgSendByNameRequest(from, "treasury.example", amount),
+		},
+		{
+			name:   "empty from address",
+			msg:    NewMsgSendByNameRequest("", "treasury.example", amount),
+			expErr: "invalid from address: empty address string is not allowed",
+		},
+		{
+			name:   "invalid from address",
+			msg:    NewMsgSendByNameRequest("blah", "treasury.example", amount),
+			expErr: "invalid from address: decoding bech32 failed: invalid bech32 string length 4",
+		},
+		{
+			name:   "empty name",
+			msg:    NewMsgSendByNameRequest(from, " ", amount),
+			expErr: "name cannot be empty",
+		},
+		{
+			name:   "no amount",
+			msg:    NewMsgSendByNameRequest(from, "treasury.example", nil),
+			expErr: "invalid amount: cannot be zero",
+		},
+		{
+			name:   "invalid amount",
+			msg:    NewMsgSendByNameRequest(from, "treasury.example", sdk.Coins{sdk.Coin{Denom: "nhash", Amount: sdkmath.NewInt(-1)}}),
+			expErr: "invalid amount: coin -1nhash amount is not positive",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if len(tc.expErr) > 0 {
+				require.EqualError(t, err, tc.expErr, "ValidateBasic")
+			} else {
+				require.NoError(t, err, "ValidateBasic")
+			}
+		})
+	}
+}
+
+func TestAddressRotationMsgsValidateBasic(t *testing.T) {
+	oldAddr := sdk.AccAddress("old_address_________").String()
+	newAddr := sdk.AccAddress("new_address_________").String()
+
+	testCases := []struct {
+		name       string
+		oldAddress string
+		newAddress string
+		expErr     string
+	}{
+		{
+			name:       "valid",
+			oldAddress: oldAddr,
+			newAddress: newAddr,
+		},
+		{
+			name:       "empty old address",
+			oldAddress: "",
+			newAddress: newAddr,
+			expErr:     "invalid old address: empty address string is not allowed",
+		},
+		{
+			name:       "invalid new address",
+			oldAddress: oldAddr,
+			newAddress: "blah",
+			expErr:     "invalid new address: decoding bech32 failed: invalid bech32 string length 4",
+		},
+		{
+			name:       "same address",
+			oldAddress: oldAddr,
+			newAddress: oldAddr,
+			expErr:     "old address and new address cannot be the same: " + oldAddr,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			msgs := []sdk.HasValidateBasic{
+				NewMsgApproveAddressRotationRequest(tc.newAddress, tc.oldAddress),
+				NewMsgRotateAddressRequest(tc.oldAddress, tc.newAddress),
+			}
+			for _, msg := range msgs {
+				err := msg.ValidateBasic()
+				if len(tc.expErr) > 0 {
+					require.EqualError(t, err, tc.expErr, "%T ValidateBasic", msg)
+				} else {
+					require.NoError(t, err, "%T ValidateBasic", msg)
+				}
+			}
+		})
+	}
+}
+
+func TestMsgSetChildQuotaRequestValidateBasic(t *testing.T) {
+	owner := sdk.AccAddress("childQuotaOwner").String()
+	testCases := []struct {
+		name   string
+		msg    *MsgSetChildQuotaRequest
+		expErr string
+	}{
+		{
+			name: "set quota",
+			msg:  NewMsgSetChildQuotaRequest("example.pb", 10, owner),
+		},
+		{
+			name: "remove quota",
+			msg:  NewMsgSetChildQuotaRequest("example.pb", 0, owner),
+		},
+		{
+			name:   "empty name",
+			msg:    NewMsgSetChildQuotaRequest(" ", 10, owner),
+			expErr: "name cannot be empty",
+		},
+		{
+			name:   "invalid owner",
+			msg:    NewMsgSetChildQuotaRequest("example.pb", 10, "blah"),
+			expErr: "invalid owner: decoding bech32 failed: invalid bech32 string length 4",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if len(tc.expErr) > 0 {
+				require.EqualError(t, err, tc.expErr, "ValidateBasic")
+			} else {
+				require.NoError(t, err, "ValidateBasic")
+			}
+		})
+	}
+}
+
+func TestMsgCreateNamedAccountRequestValidateBasic(t *testing.T) {
+	owner := sdk.AccAddress("namedAccountOwner").String()
+	testCases := []struct {
+		name   string
+		msg    *MsgCreateNamedAccountRequest
+		expErr string
+	}{
+		{
+			name: "valid",
+			msg:  NewMsgCreateNamedAccountRequest("Treasury.acme.pb ", true, owner),
+		},
+		{
+			name:   "empty name",
+			msg:    NewMsgCreateNamedAccountRequest(" ", true, owner),
+			expErr: "name cannot be empty",
+		},
+		{
+			name:   "root name",
+			msg:    NewMsgCreateNamedAccountRequest("pb", true, owner),
+			expErr: `name "pb" must have a parent`,
+		},
+		{
+			name:   "invalid owner",
+			msg:    NewMsgCreateNamedAccountRequest("treasury.acme.pb", true, "blah"),
+			expErr: "invalid owner: decoding bech32 failed: invalid bech32 string length 4",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if len(tc.expErr) > 0 {
+				require.EqualError(t, err, tc.expErr, "ValidateBasic")
+			} else {
+				require.NoError(t, err, "ValidateBasic")
+			}
+		})
+	}
+}
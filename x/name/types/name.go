@@ -38,6 +38,29 @@ func (nr NameRecord) Validate() error {
 	return nil
 }
 
+// NewPendingNameDeletion creates a new pending deletion of a name.
+func NewPendingNameDeletion(name, address string, deleteHeight int64) PendingNameDeletion {
+	return PendingNameDeletion{
+		Name:         name,
+		Address:      address,
+		DeleteHeight: deleteHeight,
+	}
+}
+
+// Validate performs basic stateless validity checks.
+func (p PendingNameDeletion) Validate() error {
+	if strings.TrimSpace(p.Name) == "" {
+		return fmt.Errorf("pending deletion name cannot be empty")
+	}
+	if _, err := sdk.AccAddressFromBech32(p.Address); err != nil {
+		return fmt.Errorf("invalid pending deletion %q address: %w", p.Name, err)
+	}
+	if p.DeleteHeight <= 0 {
+		return fmt.Errorf("invalid pending deletion %q delete height %d: must be positive", p.Name, p.DeleteHeight)
+	}
+	return nil
+}
+
 // NormalizeName lower-cases and strips out spaces around each segment in the provided string.
 func NormalizeName(name string) string {
 	nameSegments := strings.Split(name, ".")
@@ -90,13 +113,18 @@ func ValidateNameSegment(segment string) error {
 	}
 	// Only allow dashes, lowercase characters and digits.
 	for _, c := range segment {
-		if c != '-' && !unicode.IsLower(c) && !unicode.IsDigit(c) {
+		if !isValidNameSegmentChar(c) {
 			return fmt.Errorf("illegal character %q in name segment %q", string(c), segment)
 		}
 	}
 	return nil
 }
 
+// isValidNameSegmentChar returns true if the provided character is allowed in a (non-uuid) name segment.
+func isValidNameSegmentChar(c rune) bool {
+	return c == '-' || unicode.IsLower(c) || unicode.IsDigit(c)
+}
+
 // IsValidUUID returns true if the provided string is a valid UUID string.
 func IsValidUUID(str string) bool {
 	if _, err := uuid.Parse(str); err == nil {
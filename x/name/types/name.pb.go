@@ -6,18 +6,25 @@ package types
 import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	types1 "github.com/cosmos/cosmos-sdk/codec/types"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -25,6 +32,39 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// ContractNamePolicy defines what happens to the names owned by a wasm contract during a contract lifecycle change.
+type ContractNamePolicy int32
+
+const (
+	// CONTRACT_NAME_POLICY_UNSPECIFIED - The names are left bound to the contract.
+	ContractNamePolicyKeep ContractNamePolicy = 0
+	// CONTRACT_NAME_POLICY_REASSIGN_TO_ADMIN - The names are bound to the admin that migrated the contract or cleared
+	// its admin.
+	ContractNamePolicyReassignToAdmin ContractNamePolicy = 1
+	// CONTRACT_NAME_POLICY_DELETE - The names, all names under them, and their attributes are deleted.
+	ContractNamePolicyDelete ContractNamePolicy = 2
+)
+
+var ContractNamePolicy_name = map[int32]string{
+	0: "CONTRACT_NAME_POLICY_UNSPECIFIED",
+	1: "CONTRACT_NAME_POLICY_REASSIGN_TO_ADMIN",
+	2: "CONTRACT_NAME_POLICY_DELETE",
+}
+
+var ContractNamePolicy_value = map[string]int32{
+	"CONTRACT_NAME_POLICY_UNSPECIFIED":       0,
+	"CONTRACT_NAME_POLICY_REASSIGN_TO_ADMIN": 1,
+	"CONTRACT_NAME_POLICY_DELETE":            2,
+}
+
+func (x ContractNamePolicy) String() string {
+	return proto.EnumName(ContractNamePolicy_name, int32(x))
+}
+
+func (ContractNamePolicy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_a314256905bb00ec, []int{0}
+}
+
 // Params defines the set of params for the name module.
 type Params struct {
 	// maximum length of name segment to allow
@@ -35,6 +75,38 @@ type Params struct {
 	MaxNameLevels uint32 `protobuf:"varint,3,opt,name=max_name_levels,json=maxNameLevels,proto3" json:"max_name_levels,omitempty"`
 	// determines if unrestricted name keys are allowed or not
 	AllowUnrestrictedNames bool `protobuf:"varint,4,opt,name=allow_unrestricted_names,json=allowUnrestrictedNames,proto3" json:"allow_unrestricted_names,omitempty"`
+	// maximum number of names that can be deleted by a single recursive delete names request.
+	MaxDeletions uint32 `protobuf:"varint,5,opt,name=max_deletions,json=maxDeletions,proto3" json:"max_deletions,omitempty"`
+	// what happens to the names owned by a wasm contract when that contract is migrated.
+	ContractMigratedNamePolicy ContractNamePolicy `protobuf:"varint,6,opt,name=contract_migrated_name_policy,json=contractMigratedNamePolicy,proto3,enum=provenance.name.v1.ContractNamePolicy" json:"contract_migrated_name_policy,omitempty"`
+	// what happens to the names owned by a wasm contract when that contract's admin is cleared.
+	ContractAdminClearedNamePolicy ContractNamePolicy `protobuf:"varint,7,opt,name=contract_admin_cleared_name_policy,json=contractAdminClearedNamePolicy,proto3,enum=provenance.name.v1.ContractNamePolicy" json:"contract_admin_cleared_name_policy,omitempty"`
+	// the number of blocks that a name deleted using MsgDeleteNameRequest is pending deletion before it is removed.
+	// Zero means names are removed immediately.
+	DeleteDelayBlocks uint32 `protobuf:"varint,8,opt,name=delete_delay_blocks,json=deleteDelayBlocks,proto3" json:"delete_delay_blocks,omitempty"`
+	// whether names that are pending deletion can still be resolved.
+	ResolvePendingDeletions bool `protobuf:"varint,9,opt,name=resolve_pending_deletions,json=resolvePendingDeletions,proto3" json:"resolve_pending_deletions,omitempty"`
+	// the maximum number of results a single page of the ReverseLookup query can return. Requests for more are truncated.
+	// Zero means no limit.
+	MaxQueryResults uint32 `protobuf:"varint,10,opt,name=max_query_results,json=maxQueryResults,proto3" json:"max_query_results,omitempty"`
+	// the maximum size (in bytes) of a ReverseLookup query response. Larger responses are rejected.
+	// Zero means no limit.
+	MaxQueryResponseBytes uint64 `protobuf:"varint,11,opt,name=max_query_response_bytes,json=maxQueryResponseBytes,proto3" json:"max_query_response_bytes,omitempty"`
+	// whether names bound using MsgBindNameRequest are always restricted, regardless of the requested restriction.
+	// The owner of a new name can still make it unrestricted using MsgModifyNameRequest.
+	RestrictNewNames bool `protobuf:"varint,12,opt,name=restrict_new_names,json=restrictNewNames,proto3" json:"restrict_new_names,omitempty"`
+	// the fee paid by the parent address to bind a name using MsgBindNameRequest.
+	BindNameFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,13,rep,name=bind_name_fee,json=bindNameFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"bind_name_fee"`
+	// the address that receives the bind name fees. Empty means the fee collector.
+	BindNameFeeRecipient string `protobuf:"bytes,14,opt,name=bind_name_fee_recipient,json=bindNameFeeRecipient,proto3" json:"bind_name_fee_recipient,omitempty"`
+	// the binding params used for names under specific root names instead of the ones above.
+	RootNameParams []RootNameParams `protobuf:"bytes,15,rep,name=root_name_params,json=rootNameParams,proto3" json:"root_name_params"`
+	// the maximum number of UUID segments a single name can have.
+	// Zero means no limit.
+	MaxUuidSegments uint32 `protobuf:"varint,16,opt,name=max_uuid_segments,json=maxUuidSegments,proto3" json:"max_uuid_segments,omitempty"`
+	// the number of blocks that newly bound names are kept in the index used by the NamesCreated query.
+	// Zero means new names are not indexed.
+	CreatedNamesRetentionBlocks uint32 `protobuf:"varint,17,opt,name=created_names_retention_blocks,json=createdNamesRetentionBlocks,proto3" json:"created_names_retention_blocks,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -98,6 +170,170 @@ func (m *Params) GetAllowUnrestrictedNames() bool {
 	return false
 }
 
+func (m *Params) GetMaxDeletions() uint32 {
+	if m != nil {
+		return m.MaxDeletions
+	}
+	return 0
+}
+
+func (m *Params) GetContractMigratedNamePolicy() ContractNamePolicy {
+	if m != nil {
+		return m.ContractMigratedNamePolicy
+	}
+	return ContractNamePolicyKeep
+}
+
+func (m *Params) GetContractAdminClearedNamePolicy() ContractNamePolicy {
+	if m != nil {
+		return m.ContractAdminClearedNamePolicy
+	}
+	return ContractNamePolicyKeep
+}
+
+func (m *Params) GetDeleteDelayBlocks() uint32 {
+	if m != nil {
+		return m.DeleteDelayBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetResolvePendingDeletions() bool {
+	if m != nil {
+		return m.ResolvePendingDeletions
+	}
+	return false
+}
+
+func (m *Params) GetMaxQueryResults() uint32 {
+	if m != nil {
+		return m.MaxQueryResults
+	}
+	return 0
+}
+
+func (m *Params) GetMaxQueryResponseBytes() uint64 {
+	if m != nil {
+		return m.MaxQueryResponseBytes
+	}
+	return 0
+}
+
+func (m *Params) GetRestrictNewNames() bool {
+	if m != nil {
+		return m.RestrictNewNames
+	}
+	return false
+}
+
+func (m *Params) GetBindNameFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.BindNameFee
+	}
+	return nil
+}
+
+func (m *Params) GetBindNameFeeRecipient() string {
+	if m != nil {
+		return m.BindNameFeeRecipient
+	}
+	return ""
+}
+
+func (m *Params) GetRootNameParams() []RootNameParams {
+	if m != nil {
+		return m.RootNameParams
+	}
+	return nil
+}
+
+func (m *Params) GetMaxUuidSegments() uint32 {
+	if m != nil {
+		return m.MaxUuidSegments
+	}
+	return 0
+}
+
+func (m *Params) GetCreatedNamesRetentionBlocks() uint32 {
+	if m != nil {
+		return m.CreatedNamesRetentionBlocks
+	}
+	return 0
+}
+
+// RootNameParams defines the binding params used for names bound under a specific root name.
+type RootNameParams struct {
+	// the root name (a single segment) that these params apply to.
+	Root string `protobuf:"bytes,1,opt,name=root,proto3" json:"root,omitempty"`
+	// whether names bound under the root using MsgBindNameRequest are always restricted.
+	RestrictNewNames bool `protobuf:"varint,2,opt,name=restrict_new_names,json=restrictNewNames,proto3" json:"restrict_new_names,omitempty"`
+	// the fee paid by the parent address to bind a name under the root using MsgBindNameRequest.
+	BindNameFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=bind_name_fee,json=bindNameFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"bind_name_fee"`
+	// the address that receives the fees for binding names under the root. Empty means the fee collector.
+	BindNameFeeRecipient string `protobuf:"bytes,4,opt,name=bind_name_fee_recipient,json=bindNameFeeRecipient,proto3" json:"bind_name_fee_recipient,omitempty"`
+}
+
+func (m *RootNameParams) Reset()         { *m = RootNameParams{} }
+func (m *RootNameParams) String() string { return proto.CompactTextString(m) }
+func (*RootNameParams) ProtoMessage()    {}
+func (*RootNameParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a314256905bb00ec, []int{1}
+}
+func (m *RootNameParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RootNameParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RootNameParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RootNameParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RootNameParams.Merge(m, src)
+}
+func (m *RootNameParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *RootNameParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_RootNameParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RootNameParams proto.InternalMessageInfo
+
+func (m *RootNameParams) GetRoot() string {
+	if m != nil {
+		return m.Root
+	}
+	return ""
+}
+
+func (m *RootNameParams) GetRestrictNewNames() bool {
+	if m != nil {
+		return m.RestrictNewNames
+	}
+	return false
+}
+
+func (m *RootNameParams) GetBindNameFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.BindNameFee
+	}
+	return nil
+}
+
+func (m *RootNameParams) GetBindNameFeeRecipient() string {
+	if m != nil {
+		return m.BindNameFeeRecipient
+	}
+	return ""
+}
+
 // NameRecord is a structure used to bind ownership of a name hierarchy to a collection of addresses
 type NameRecord struct {
 	// the bound name
@@ -111,7 +347,7 @@ type NameRecord struct {
 func (m *NameRecord) Reset()      { *m = NameRecord{} }
 func (*NameRecord) ProtoMessage() {}
 func (*NameRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a314256905bb00ec, []int{1}
+	return fileDescriptor_a314256905bb00ec, []int{2}
 }
 func (m *NameRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -161,37 +397,28 @@ func (m *NameRecord) GetRestricted() bool {
 	return false
 }
 
-// CreateRootNameProposal details a proposal to create a new root name
-// that is controlled by a given owner and optionally restricted to the owner
-// for the sole creation of sub names.
-// Deprecated: This legacy proposal is deprecated in favor of Msg-based gov
-// proposals, see MsgCreateRootNameRequest.
-//
-// Deprecated: Do not use.
-type CreateRootNameProposal struct {
-	// proposal title
-	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
-	// proposal description
-	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
-	// the bound name
-	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	// the address the name will resolve to
-	Owner string `protobuf:"bytes,4,opt,name=owner,proto3" json:"owner,omitempty"`
-	// a flag that indicates if an owner signature is required to add sub-names
-	Restricted bool `protobuf:"varint,5,opt,name=restricted,proto3" json:"restricted,omitempty"`
+// PendingNameDeletion is a name that has been deleted, but will not be removed until the delete height.
+type PendingNameDeletion struct {
+	// name is the name that is pending deletion.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// address is the address the name was bound to when it was deleted.
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// delete_height is the block height at the end of which the name will be removed.
+	DeleteHeight int64 `protobuf:"varint,3,opt,name=delete_height,json=deleteHeight,proto3" json:"delete_height,omitempty"`
 }
 
-func (m *CreateRootNameProposal) Reset()      { *m = CreateRootNameProposal{} }
-func (*CreateRootNameProposal) ProtoMessage() {}
-func (*CreateRootNameProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a314256905bb00ec, []int{2}
+func (m *PendingNameDeletion) Reset()         { *m = PendingNameDeletion{} }
+func (m *PendingNameDeletion) String() string { return proto.CompactTextString(m) }
+func (*PendingNameDeletion) ProtoMessage()    {}
+func (*PendingNameDeletion) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a314256905bb00ec, []int{3}
 }
-func (m *CreateRootNameProposal) XXX_Unmarshal(b []byte) error {
+func (m *PendingNameDeletion) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *CreateRootNameProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *PendingNameDeletion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_CreateRootNameProposal.Marshal(b, m, deterministic)
+		return xxx_messageInfo_PendingNameDeletion.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -201,37 +428,59 @@ func (m *CreateRootNameProposal) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return b[:n], nil
 	}
 }
-func (m *CreateRootNameProposal) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CreateRootNameProposal.Merge(m, src)
+func (m *PendingNameDeletion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingNameDeletion.Merge(m, src)
 }
-func (m *CreateRootNameProposal) XXX_Size() int {
+func (m *PendingNameDeletion) XXX_Size() int {
 	return m.Size()
 }
-func (m *CreateRootNameProposal) XXX_DiscardUnknown() {
-	xxx_messageInfo_CreateRootNameProposal.DiscardUnknown(m)
+func (m *PendingNameDeletion) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingNameDeletion.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CreateRootNameProposal proto.InternalMessageInfo
+var xxx_messageInfo_PendingNameDeletion proto.InternalMessageInfo
 
-// Event emitted when name is bound.
-type EventNameBound struct {
-	Address    string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	Name       string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Restricted bool   `protobuf:"varint,3,opt,name=restricted,proto3" json:"restricted,omitempty"`
+func (m *PendingNameDeletion) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
 }
 
-func (m *EventNameBound) Reset()         { *m = EventNameBound{} }
-func (m *EventNameBound) String() string { return proto.CompactTextString(m) }
-func (*EventNameBound) ProtoMessage()    {}
-func (*EventNameBound) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a314256905bb00ec, []int{3}
+func (m *PendingNameDeletion) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
 }
-func (m *EventNameBound) XXX_Unmarshal(b []byte) error {
+
+func (m *PendingNameDeletion) GetDeleteHeight() int64 {
+	if m != nil {
+		return m.DeleteHeight
+	}
+	return 0
+}
+
+// AddressRotationApproval is an approval, given by the new address, to rotate an old address to it.
+type AddressRotationApproval struct {
+	// old_address is the address being rotated away from.
+	OldAddress string `protobuf:"bytes,1,opt,name=old_address,json=oldAddress,proto3" json:"old_address,omitempty"`
+	// new_address is the address being rotated to. It is the one that gave the approval.
+	NewAddress string `protobuf:"bytes,2,opt,name=new_address,json=newAddress,proto3" json:"new_address,omitempty"`
+}
+
+func (m *AddressRotationApproval) Reset()         { *m = AddressRotationApproval{} }
+func (m *AddressRotationApproval) String() string { return proto.CompactTextString(m) }
+func (*AddressRotationApproval) ProtoMessage()    {}
+func (*AddressRotationApproval) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a314256905bb00ec, []int{4}
+}
+func (m *AddressRotationApproval) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventNameBound) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *AddressRotationApproval) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventNameBound.Marshal(b, m, deterministic)
+		return xxx_messageInfo_AddressRotationApproval.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -241,58 +490,52 @@ func (m *EventNameBound) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 		return b[:n], nil
 	}
 }
-func (m *EventNameBound) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventNameBound.Merge(m, src)
+func (m *AddressRotationApproval) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddressRotationApproval.Merge(m, src)
 }
-func (m *EventNameBound) XXX_Size() int {
+func (m *AddressRotationApproval) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventNameBound) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventNameBound.DiscardUnknown(m)
+func (m *AddressRotationApproval) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddressRotationApproval.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventNameBound proto.InternalMessageInfo
+var xxx_messageInfo_AddressRotationApproval proto.InternalMessageInfo
 
-func (m *EventNameBound) GetAddress() string {
+func (m *AddressRotationApproval) GetOldAddress() string {
 	if m != nil {
-		return m.Address
+		return m.OldAddress
 	}
 	return ""
 }
 
-func (m *EventNameBound) GetName() string {
+func (m *AddressRotationApproval) GetNewAddress() string {
 	if m != nil {
-		return m.Name
+		return m.NewAddress
 	}
 	return ""
 }
 
-func (m *EventNameBound) GetRestricted() bool {
-	if m != nil {
-		return m.Restricted
-	}
-	return false
-}
-
-// Event emitted when name is unbound.
-type EventNameUnbound struct {
-	Address    string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	Name       string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Restricted bool   `protobuf:"varint,3,opt,name=restricted,proto3" json:"restricted,omitempty"`
+// ChildQuota is a limit, set by the owner of a name, on the number of names that can be bound directly under it.
+type ChildQuota struct {
+	// name is the parent name that the quota applies to.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// max_children is the maximum number of names that can be bound directly under the name.
+	MaxChildren uint64 `protobuf:"varint,2,opt,name=max_children,json=maxChildren,proto3" json:"max_children,omitempty"`
 }
 
-func (m *EventNameUnbound) Reset()         { *m = EventNameUnbound{} }
-func (m *EventNameUnbound) String() string { return proto.CompactTextString(m) }
-func (*EventNameUnbound) ProtoMessage()    {}
-func (*EventNameUnbound) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a314256905bb00ec, []int{4}
+func (m *ChildQuota) Reset()         { *m = ChildQuota{} }
+func (m *ChildQuota) String() string { return proto.CompactTextString(m) }
+func (*ChildQuota) ProtoMessage()    {}
+func (*ChildQuota) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a314256905bb00ec, []int{5}
 }
-func (m *EventNameUnbound) XXX_Unmarshal(b []byte) error {
+func (m *ChildQuota) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventNameUnbound) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ChildQuota) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventNameUnbound.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ChildQuota.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -302,58 +545,54 @@ func (m *EventNameUnbound) XXX_Marshal(b []byte, deterministic bool) ([]byte, er
 		return b[:n], nil
 	}
 }
-func (m *EventNameUnbound) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventNameUnbound.Merge(m, src)
+func (m *ChildQuota) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChildQuota.Merge(m, src)
 }
-func (m *EventNameUnbound) XXX_Size() int {
+func (m *ChildQuota) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventNameUnbound) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventNameUnbound.DiscardUnknown(m)
+func (m *ChildQuota) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChildQuota.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventNameUnbound proto.InternalMessageInfo
-
-func (m *EventNameUnbound) GetAddress() string {
-	if m != nil {
-		return m.Address
-	}
-	return ""
-}
+var xxx_messageInfo_ChildQuota proto.InternalMessageInfo
 
-func (m *EventNameUnbound) GetName() string {
+func (m *ChildQuota) GetName() string {
 	if m != nil {
 		return m.Name
 	}
 	return ""
 }
 
-func (m *EventNameUnbound) GetRestricted() bool {
+func (m *ChildQuota) GetMaxChildren() uint64 {
 	if m != nil {
-		return m.Restricted
+		return m.MaxChildren
 	}
-	return false
+	return 0
 }
 
-// Event emitted when name is updated.
-type EventNameUpdate struct {
-	Address    string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	Name       string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Restricted bool   `protobuf:"varint,3,opt,name=restricted,proto3" json:"restricted,omitempty"`
+// ParentApproval is an offline-signed approval, given by the owner of a restricted parent name, to bind a name under it.
+type ParentApproval struct {
+	// pub_key is the public key of the parent name's owner.
+	PubKey *types1.Any `protobuf:"bytes,1,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
+	// expiration is the time at which the approval can no longer be used.
+	Expiration time.Time `protobuf:"bytes,2,opt,name=expiration,proto3,stdtime" json:"expiration"`
+	// signature is the parent name owner's signature of the ParentApprovalSignDoc for the binding.
+	Signature []byte `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature,omitempty"`
 }
 
-func (m *EventNameUpdate) Reset()         { *m = EventNameUpdate{} }
-func (m *EventNameUpdate) String() string { return proto.CompactTextString(m) }
-func (*EventNameUpdate) ProtoMessage()    {}
-func (*EventNameUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a314256905bb00ec, []int{5}
+func (m *ParentApproval) Reset()         { *m = ParentApproval{} }
+func (m *ParentApproval) String() string { return proto.CompactTextString(m) }
+func (*ParentApproval) ProtoMessage()    {}
+func (*ParentApproval) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a314256905bb00ec, []int{6}
 }
-func (m *EventNameUpdate) XXX_Unmarshal(b []byte) error {
+func (m *ParentApproval) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventNameUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ParentApproval) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventNameUpdate.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ParentApproval.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -363,59 +602,63 @@ func (m *EventNameUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return b[:n], nil
 	}
 }
-func (m *EventNameUpdate) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventNameUpdate.Merge(m, src)
+func (m *ParentApproval) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ParentApproval.Merge(m, src)
 }
-func (m *EventNameUpdate) XXX_Size() int {
+func (m *ParentApproval) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventNameUpdate) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventNameUpdate.DiscardUnknown(m)
+func (m *ParentApproval) XXX_DiscardUnknown() {
+	xxx_messageInfo_ParentApproval.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventNameUpdate proto.InternalMessageInfo
+var xxx_messageInfo_ParentApproval proto.InternalMessageInfo
 
-func (m *EventNameUpdate) GetAddress() string {
+func (m *ParentApproval) GetPubKey() *types1.Any {
 	if m != nil {
-		return m.Address
+		return m.PubKey
 	}
-	return ""
+	return nil
 }
 
-func (m *EventNameUpdate) GetName() string {
+func (m *ParentApproval) GetExpiration() time.Time {
 	if m != nil {
-		return m.Name
+		return m.Expiration
 	}
-	return ""
+	return time.Time{}
 }
 
-func (m *EventNameUpdate) GetRestricted() bool {
+func (m *ParentApproval) GetSignature() []byte {
 	if m != nil {
-		return m.Restricted
+		return m.Signature
 	}
-	return false
+	return nil
 }
 
-// EventNameParamsUpdated event emitted when name params are updated.
-type EventNameParamsUpdated struct {
-	AllowUnrestrictedNames string `protobuf:"bytes,1,opt,name=allow_unrestricted_names,json=allowUnrestrictedNames,proto3" json:"allow_unrestricted_names,omitempty"`
-	MaxNameLevels          string `protobuf:"bytes,2,opt,name=max_name_levels,json=maxNameLevels,proto3" json:"max_name_levels,omitempty"`
-	MinSegmentLength       string `protobuf:"bytes,3,opt,name=min_segment_length,json=minSegmentLength,proto3" json:"min_segment_length,omitempty"`
-	MaxSegmentLength       string `protobuf:"bytes,4,opt,name=max_segment_length,json=maxSegmentLength,proto3" json:"max_segment_length,omitempty"`
+// ParentApprovalSignDoc is the document that is signed by the owner of a restricted parent name to create a ParentApproval.
+type ParentApprovalSignDoc struct {
+	// chain_id is the id of the chain that the approval can be used on.
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// name is the full (normalized) name being bound, including the parent name.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// requestor is the address that can use the approval to bind the name, i.e. the tx signer.
+	Requestor string `protobuf:"bytes,3,opt,name=requestor,proto3" json:"requestor,omitempty"`
+	// expiration is the time at which the approval can no longer be used.
+	Expiration time.Time `protobuf:"bytes,4,opt,name=expiration,proto3,stdtime" json:"expiration"`
 }
 
-func (m *EventNameParamsUpdated) Reset()         { *m = EventNameParamsUpdated{} }
-func (m *EventNameParamsUpdated) String() string { return proto.CompactTextString(m) }
-func (*EventNameParamsUpdated) ProtoMessage()    {}
-func (*EventNameParamsUpdated) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a314256905bb00ec, []int{6}
+func (m *ParentApprovalSignDoc) Reset()         { *m = ParentApprovalSignDoc{} }
+func (m *ParentApprovalSignDoc) String() string { return proto.CompactTextString(m) }
+func (*ParentApprovalSignDoc) ProtoMessage()    {}
+func (*ParentApprovalSignDoc) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a314256905bb00ec, []int{7}
 }
-func (m *EventNameParamsUpdated) XXX_Unmarshal(b []byte) error {
+func (m *ParentApprovalSignDoc) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventNameParamsUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ParentApprovalSignDoc) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventNameParamsUpdated.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ParentApprovalSignDoc.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -425,618 +668,5576 @@ func (m *EventNameParamsUpdated) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return b[:n], nil
 	}
 }
-func (m *EventNameParamsUpdated) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventNameParamsUpdated.Merge(m, src)
+func (m *ParentApprovalSignDoc) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ParentApprovalSignDoc.Merge(m, src)
 }
-func (m *EventNameParamsUpdated) XXX_Size() int {
+func (m *ParentApprovalSignDoc) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventNameParamsUpdated) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventNameParamsUpdated.DiscardUnknown(m)
+func (m *ParentApprovalSignDoc) XXX_DiscardUnknown() {
+	xxx_messageInfo_ParentApprovalSignDoc.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventNameParamsUpdated proto.InternalMessageInfo
+var xxx_messageInfo_ParentApprovalSignDoc proto.InternalMessageInfo
 
-func (m *EventNameParamsUpdated) GetAllowUnrestrictedNames() string {
+func (m *ParentApprovalSignDoc) GetChainId() string {
 	if m != nil {
-		return m.AllowUnrestrictedNames
+		return m.ChainId
 	}
 	return ""
 }
 
-func (m *EventNameParamsUpdated) GetMaxNameLevels() string {
+func (m *ParentApprovalSignDoc) GetName() string {
 	if m != nil {
-		return m.MaxNameLevels
+		return m.Name
 	}
 	return ""
 }
 
-func (m *EventNameParamsUpdated) GetMinSegmentLength() string {
+func (m *ParentApprovalSignDoc) GetRequestor() string {
 	if m != nil {
-		return m.MinSegmentLength
+		return m.Requestor
 	}
 	return ""
 }
 
-func (m *EventNameParamsUpdated) GetMaxSegmentLength() string {
+func (m *ParentApprovalSignDoc) GetExpiration() time.Time {
 	if m != nil {
-		return m.MaxSegmentLength
+		return m.Expiration
 	}
-	return ""
+	return time.Time{}
 }
 
-func init() {
-	proto.RegisterType((*Params)(nil), "provenance.name.v1.Params")
-	proto.RegisterType((*NameRecord)(nil), "provenance.name.v1.NameRecord")
-	proto.RegisterType((*CreateRootNameProposal)(nil), "provenance.name.v1.CreateRootNameProposal")
-	proto.RegisterType((*EventNameBound)(nil), "provenance.name.v1.EventNameBound")
-	proto.RegisterType((*EventNameUnbound)(nil), "provenance.name.v1.EventNameUnbound")
-	proto.RegisterType((*EventNameUpdate)(nil), "provenance.name.v1.EventNameUpdate")
-	proto.RegisterType((*EventNameParamsUpdated)(nil), "provenance.name.v1.EventNameParamsUpdated")
+// CreateRootNameProposal details a proposal to create a new root name
+// that is controlled by a given owner and optionally restricted to the owner
+// for the sole creation of sub names.
+// Deprecated: This legacy proposal is deprecated in favor of Msg-based gov
+// proposals, see MsgCreateRootNameRequest.
+//
+// Deprecated: Do not use.
+type CreateRootNameProposal struct {
+	// proposal title
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	// proposal description
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// the bound name
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// the address the name will resolve to
+	Owner string `protobuf:"bytes,4,opt,name=owner,proto3" json:"owner,omitempty"`
+	// a flag that indicates if an owner signature is required to add sub-names
+	Restricted bool `protobuf:"varint,5,opt,name=restricted,proto3" json:"restricted,omitempty"`
 }
 
-func init() { proto.RegisterFile("provenance/name/v1/name.proto", fileDescriptor_a314256905bb00ec) }
-
-var fileDescriptor_a314256905bb00ec = []byte{
-	// 563 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x94, 0x3d, 0x6f, 0x13, 0x4d,
-	0x10, 0xc7, 0x6f, 0x13, 0x3b, 0x4f, 0xbc, 0x8f, 0x92, 0x58, 0x2b, 0x63, 0x8e, 0x48, 0x9c, 0x2d,
-	0x17, 0x28, 0x42, 0xc4, 0x47, 0xa0, 0x41, 0x74, 0x18, 0xd1, 0x45, 0xc8, 0xba, 0x28, 0x0d, 0x05,
-	0xc7, 0xfa, 0x6e, 0x75, 0x59, 0xe9, 0x76, 0xf7, 0xb4, 0xbb, 0x76, 0x4c, 0x4b, 0x81, 0x28, 0x29,
-	0x29, 0x5d, 0x53, 0x51, 0xf0, 0x19, 0x10, 0x65, 0x44, 0x81, 0x28, 0x91, 0x5d, 0xc0, 0xc7, 0x40,
-	0xb7, 0xeb, 0x97, 0xe3, 0x62, 0xa0, 0x81, 0xea, 0x76, 0x66, 0xfe, 0x73, 0xf3, 0xdb, 0xd1, 0xce,
-	0xc0, 0xeb, 0x99, 0x14, 0x23, 0xc2, 0x31, 0x8f, 0x88, 0xcf, 0x31, 0x23, 0xfe, 0xe8, 0xc8, 0x7c,
-	0xbb, 0x99, 0x14, 0x5a, 0x20, 0xb4, 0x0a, 0x77, 0x8d, 0x7b, 0x74, 0xb4, 0x7f, 0x35, 0x12, 0x8a,
-	0x09, 0xe5, 0x33, 0x95, 0xe4, 0x6a, 0xa6, 0x12, 0x2b, 0xde, 0xbf, 0x66, 0x03, 0xa1, 0xb1, 0x7c,
-	0x6b, 0xcc, 0x43, 0x8d, 0x44, 0x24, 0xc2, 0xfa, 0xf3, 0x93, 0xf5, 0x76, 0x3e, 0x00, 0xb8, 0xd5,
-	0xc7, 0x12, 0x33, 0x85, 0x6e, 0x41, 0xc4, 0xf0, 0x38, 0x54, 0x24, 0x61, 0x84, 0xeb, 0x30, 0x25,
-	0x3c, 0xd1, 0x67, 0x2e, 0x68, 0x83, 0x83, 0x9d, 0xa0, 0xce, 0xf0, 0xf8, 0xc4, 0x06, 0x8e, 0x8d,
-	0xdf, 0xa8, 0x29, 0x2f, 0xab, 0x37, 0xe6, 0x6a, 0xca, 0x7f, 0x56, 0xdf, 0x80, 0x7b, 0xf9, 0xbf,
-	0x73, 0xfe, 0x30, 0x25, 0x23, 0x92, 0x2a, 0x77, 0xd3, 0x48, 0x77, 0x18, 0x1e, 0x3f, 0xc6, 0x8c,
-	0x1c, 0x1b, 0x27, 0xba, 0x07, 0x5d, 0x9c, 0xa6, 0xe2, 0x3c, 0x1c, 0x72, 0x49, 0x94, 0x96, 0x34,
-	0xd2, 0x24, 0x36, 0x69, 0xca, 0xad, 0xb4, 0xc1, 0xc1, 0x76, 0xd0, 0x34, 0xf1, 0xd3, 0x42, 0x38,
-	0x4f, 0x57, 0x9d, 0x97, 0x00, 0xc2, 0xfc, 0x14, 0x90, 0x48, 0xc8, 0x18, 0x21, 0x58, 0xc9, 0xb3,
-	0x0c, 0x7e, 0x2d, 0x30, 0x67, 0x74, 0x07, 0xfe, 0x87, 0xe3, 0x58, 0x12, 0xa5, 0x0c, 0x67, 0xad,
-	0xe7, 0x7e, 0x7a, 0x7f, 0xd8, 0x98, 0x37, 0xe9, 0x81, 0x8d, 0x9c, 0x68, 0x49, 0x79, 0x12, 0x2c,
-	0x84, 0xc8, 0x83, 0x70, 0x55, 0xc9, 0x30, 0x6f, 0x07, 0x05, 0xcf, 0xfd, 0xfa, 0x9b, 0x49, 0xcb,
-	0x79, 0xf1, 0xed, 0xdd, 0xcd, 0x45, 0x46, 0xe7, 0x2d, 0x80, 0xcd, 0x87, 0x92, 0x60, 0x4d, 0x02,
-	0x21, 0x74, 0x8e, 0xd4, 0x97, 0x22, 0x13, 0x0a, 0xa7, 0xa8, 0x01, 0xab, 0x9a, 0xea, 0x74, 0x41,
-	0x65, 0x0d, 0xd4, 0x86, 0xff, 0xc7, 0x44, 0x45, 0x92, 0x66, 0x9a, 0x0a, 0x6e, 0xd1, 0x82, 0xa2,
-	0x6b, 0x79, 0x99, 0xcd, 0xc2, 0x65, 0x1a, 0xb0, 0x2a, 0xce, 0x39, 0x91, 0xa6, 0x2d, 0xb5, 0xc0,
-	0x1a, 0x25, 0xdc, 0xea, 0x25, 0xdc, 0xdd, 0x57, 0x93, 0x96, 0x93, 0x23, 0x7f, 0x9f, 0xb4, 0x1c,
-	0x17, 0x74, 0x9e, 0xc2, 0xdd, 0x47, 0x23, 0xc2, 0x0d, 0x66, 0x4f, 0x0c, 0x79, 0x8c, 0xdc, 0x55,
-	0x93, 0x2c, 0xe5, 0xb2, 0x15, 0x0b, 0x8a, 0x8d, 0x02, 0xc5, 0x1f, 0xda, 0xd3, 0x79, 0x06, 0xeb,
-	0xcb, 0xff, 0x9f, 0xf2, 0xc1, 0x3f, 0xa8, 0x10, 0xc2, 0xbd, 0x55, 0x85, 0x2c, 0xc6, 0x9a, 0xfc,
-	0xe5, 0x02, 0x9f, 0x01, 0x6c, 0x2e, 0x2b, 0xd8, 0x51, 0xb1, 0x75, 0xe2, 0xdf, 0xbe, 0x56, 0x5b,
-	0xf9, 0x17, 0xaf, 0x75, 0xdd, 0x3c, 0x58, 0xa6, 0xd2, 0x3c, 0xac, 0x9f, 0x32, 0xfb, 0x0e, 0x2e,
-	0x4f, 0xd9, 0xfa, 0x09, 0xae, 0xcc, 0xd5, 0xa5, 0x09, 0xee, 0x45, 0x1f, 0xa7, 0x1e, 0xb8, 0x98,
-	0x7a, 0xe0, 0xeb, 0xd4, 0x03, 0xaf, 0x67, 0x9e, 0x73, 0x31, 0xf3, 0x9c, 0x2f, 0x33, 0xcf, 0x81,
-	0x57, 0xa8, 0x59, 0x0f, 0xa5, 0xad, 0xd3, 0x07, 0x4f, 0x6e, 0x27, 0x54, 0x9f, 0x0d, 0x07, 0xdd,
-	0x48, 0x30, 0x7f, 0x25, 0x38, 0xa4, 0xa2, 0x60, 0xf9, 0x63, 0xbb, 0xc5, 0xf4, 0xf3, 0x8c, 0xa8,
-	0xc1, 0x96, 0x59, 0x33, 0x77, 0x7f, 0x04, 0x00, 0x00, 0xff, 0xff, 0xfb, 0xa1, 0x20, 0x2f, 0xe5,
-	0x04, 0x00, 0x00,
+func (m *CreateRootNameProposal) Reset()      { *m = CreateRootNameProposal{} }
+func (*CreateRootNameProposal) ProtoMessage() {}
+func (*CreateRootNameProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a314256905bb00ec, []int{8}
 }
-
-func (m *Params) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *CreateRootNameProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CreateRootNameProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CreateRootNameProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *CreateRootNameProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateRootNameProposal.Merge(m, src)
+}
+func (m *CreateRootNameProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *CreateRootNameProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateRootNameProposal.DiscardUnknown(m)
 }
 
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_CreateRootNameProposal proto.InternalMessageInfo
+
+// Event emitted when name is bound.
+type EventNameBound struct {
+	Address    string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Name       string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Restricted bool   `protobuf:"varint,3,opt,name=restricted,proto3" json:"restricted,omitempty"`
 }
 
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.AllowUnrestrictedNames {
-		i--
-		if m.AllowUnrestrictedNames {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+func (m *EventNameBound) Reset()         { *m = EventNameBound{} }
+func (m *EventNameBound) String() string { return proto.CompactTextString(m) }
+func (*EventNameBound) ProtoMessage()    {}
+func (*EventNameBound) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a314256905bb00ec, []int{9}
+}
+func (m *EventNameBound) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventNameBound) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventNameBound.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.MaxNameLevels != 0 {
-		i = encodeVarintName(dAtA, i, uint64(m.MaxNameLevels))
-		i--
-		dAtA[i] = 0x18
+		return b[:n], nil
 	}
-	if m.MinSegmentLength != 0 {
-		i = encodeVarintName(dAtA, i, uint64(m.MinSegmentLength))
-		i--
-		dAtA[i] = 0x10
+}
+func (m *EventNameBound) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventNameBound.Merge(m, src)
+}
+func (m *EventNameBound) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventNameBound) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventNameBound.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventNameBound proto.InternalMessageInfo
+
+func (m *EventNameBound) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	if m.MaxSegmentLength != 0 {
-		i = encodeVarintName(dAtA, i, uint64(m.MaxSegmentLength))
-		i--
-		dAtA[i] = 0x8
+	return ""
+}
+
+func (m *EventNameBound) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *NameRecord) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventNameBound) GetRestricted() bool {
+	if m != nil {
+		return m.Restricted
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *NameRecord) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// Event emitted when name is unbound.
+type EventNameUnbound struct {
+	Address    string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Name       string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Restricted bool   `protobuf:"varint,3,opt,name=restricted,proto3" json:"restricted,omitempty"`
 }
 
-func (m *NameRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Restricted {
-		i--
-		if m.Restricted {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+func (m *EventNameUnbound) Reset()         { *m = EventNameUnbound{} }
+func (m *EventNameUnbound) String() string { return proto.CompactTextString(m) }
+func (*EventNameUnbound) ProtoMessage()    {}
+func (*EventNameUnbound) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a314256905bb00ec, []int{10}
+}
+func (m *EventNameUnbound) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventNameUnbound) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventNameUnbound.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintName(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintName(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *CreateRootNameProposal) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *EventNameUnbound) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventNameUnbound.Merge(m, src)
 }
-
-func (m *CreateRootNameProposal) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventNameUnbound) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *CreateRootNameProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Restricted {
-		i--
-		if m.Restricted {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x28
-	}
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintName(dAtA, i, uint64(len(m.Owner)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintName(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintName(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x12
+func (m *EventNameUnbound) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventNameUnbound.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventNameUnbound proto.InternalMessageInfo
+
+func (m *EventNameUnbound) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	if len(m.Title) > 0 {
-		i -= len(m.Title)
-		copy(dAtA[i:], m.Title)
-		i = encodeVarintName(dAtA, i, uint64(len(m.Title)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *EventNameUnbound) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *EventNameBound) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventNameUnbound) GetRestricted() bool {
+	if m != nil {
+		return m.Restricted
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *EventNameBound) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// Event emitted when name is updated.
+type EventNameUpdate struct {
+	Address    string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Name       string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Restricted bool   `protobuf:"varint,3,opt,name=restricted,proto3" json:"restricted,omitempty"`
 }
 
-func (m *EventNameBound) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Restricted {
-		i--
-		if m.Restricted {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+func (m *EventNameUpdate) Reset()         { *m = EventNameUpdate{} }
+func (m *EventNameUpdate) String() string { return proto.CompactTextString(m) }
+func (*EventNameUpdate) ProtoMessage()    {}
+func (*EventNameUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a314256905bb00ec, []int{11}
+}
+func (m *EventNameUpdate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventNameUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventNameUpdate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x18
+		return b[:n], nil
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintName(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *EventNameUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventNameUpdate.Merge(m, src)
+}
+func (m *EventNameUpdate) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventNameUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventNameUpdate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventNameUpdate proto.InternalMessageInfo
+
+func (m *EventNameUpdate) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintName(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *EventNameUpdate) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *EventNameUnbound) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventNameUpdate) GetRestricted() bool {
+	if m != nil {
+		return m.Restricted
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *EventNameUnbound) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventNameParamsUpdated event emitted when name params are updated.
+type EventNameParamsUpdated struct {
+	AllowUnrestrictedNames         string `protobuf:"bytes,1,opt,name=allow_unrestricted_names,json=allowUnrestrictedNames,proto3" json:"allow_unrestricted_names,omitempty"`
+	MaxNameLevels                  string `protobuf:"bytes,2,opt,name=max_name_levels,json=maxNameLevels,proto3" json:"max_name_levels,omitempty"`
+	MinSegmentLength               string `protobuf:"bytes,3,opt,name=min_segment_length,json=minSegmentLength,proto3" json:"min_segment_length,omitempty"`
+	MaxSegmentLength               string `protobuf:"bytes,4,opt,name=max_segment_length,json=maxSegmentLength,proto3" json:"max_segment_length,omitempty"`
+	MaxDeletions                   string `protobuf:"bytes,5,opt,name=max_deletions,json=maxDeletions,proto3" json:"max_deletions,omitempty"`
+	ContractMigratedNamePolicy     string `protobuf:"bytes,6,opt,name=contract_migrated_name_policy,json=contractMigratedNamePolicy,proto3" json:"contract_migrated_name_policy,omitempty"`
+	ContractAdminClearedNamePolicy string `protobuf:"bytes,7,opt,name=contract_admin_cleared_name_policy,json=contractAdminClearedNamePolicy,proto3" json:"contract_admin_cleared_name_policy,omitempty"`
+	DeleteDelayBlocks              string `protobuf:"bytes,8,opt,name=delete_delay_blocks,json=deleteDelayBlocks,proto3" json:"delete_delay_blocks,omitempty"`
+	ResolvePendingDeletions        string `protobuf:"bytes,9,opt,name=resolve_pending_deletions,json=resolvePendingDeletions,proto3" json:"resolve_pending_deletions,omitempty"`
+	MaxQueryResults                string `protobuf:"bytes,10,opt,name=max_query_results,json=maxQueryResults,proto3" json:"max_query_results,omitempty"`
+	MaxQueryResponseBytes          string `protobuf:"bytes,11,opt,name=max_query_response_bytes,json=maxQueryResponseBytes,proto3" json:"max_query_response_bytes,omitempty"`
+	RestrictNewNames               string `protobuf:"bytes,12,opt,name=restrict_new_names,json=restrictNewNames,proto3" json:"restrict_new_names,omitempty"`
+	BindNameFee                    string `protobuf:"bytes,13,opt,name=bind_name_fee,json=bindNameFee,proto3" json:"bind_name_fee,omitempty"`
+	BindNameFeeRecipient           string `protobuf:"bytes,14,opt,name=bind_name_fee_recipient,json=bindNameFeeRecipient,proto3" json:"bind_name_fee_recipient,omitempty"`
+	// root_name_params is a comma separated list of the root names that have their own binding params.
+	RootNameParams string `protobuf:"bytes,15,opt,name=root_name_params,json=rootNameParams,proto3" json:"root_name_params,omitempty"`
 }
 
-func (m *EventNameUnbound) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Restricted {
-		i--
-		if m.Restricted {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+func (m *EventNameParamsUpdated) Reset()         { *m = EventNameParamsUpdated{} }
+func (m *EventNameParamsUpdated) String() string { return proto.CompactTextString(m) }
+func (*EventNameParamsUpdated) ProtoMessage()    {}
+func (*EventNameParamsUpdated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a314256905bb00ec, []int{12}
+}
+func (m *EventNameParamsUpdated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventNameParamsUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventNameParamsUpdated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintName(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintName(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *EventNameParamsUpdated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventNameParamsUpdated.Merge(m, src)
+}
+func (m *EventNameParamsUpdated) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventNameParamsUpdated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventNameParamsUpdated.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventNameParamsUpdated proto.InternalMessageInfo
+
+func (m *EventNameParamsUpdated) GetAllowUnrestrictedNames() string {
+	if m != nil {
+		return m.AllowUnrestrictedNames
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *EventNameUpdate) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventNameParamsUpdated) GetMaxNameLevels() string {
+	if m != nil {
+		return m.MaxNameLevels
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventNameUpdate) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventNameParamsUpdated) GetMinSegmentLength() string {
+	if m != nil {
+		return m.MinSegmentLength
+	}
+	return ""
 }
 
-func (m *EventNameUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Restricted {
-		i--
-		if m.Restricted {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x18
+func (m *EventNameParamsUpdated) GetMaxSegmentLength() string {
+	if m != nil {
+		return m.MaxSegmentLength
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintName(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintName(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *EventNameParamsUpdated) GetMaxDeletions() string {
+	if m != nil {
+		return m.MaxDeletions
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *EventNameParamsUpdated) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventNameParamsUpdated) GetContractMigratedNamePolicy() string {
+	if m != nil {
+		return m.ContractMigratedNamePolicy
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventNameParamsUpdated) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventNameParamsUpdated) GetContractAdminClearedNamePolicy() string {
+	if m != nil {
+		return m.ContractAdminClearedNamePolicy
+	}
+	return ""
 }
 
-func (m *EventNameParamsUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.MaxSegmentLength) > 0 {
-		i -= len(m.MaxSegmentLength)
-		copy(dAtA[i:], m.MaxSegmentLength)
-		i = encodeVarintName(dAtA, i, uint64(len(m.MaxSegmentLength)))
-		i--
-		dAtA[i] = 0x22
+func (m *EventNameParamsUpdated) GetDeleteDelayBlocks() string {
+	if m != nil {
+		return m.DeleteDelayBlocks
 	}
-	if len(m.MinSegmentLength) > 0 {
-		i -= len(m.MinSegmentLength)
-		copy(dAtA[i:], m.MinSegmentLength)
-		i = encodeVarintName(dAtA, i, uint64(len(m.MinSegmentLength)))
-		i--
-		dAtA[i] = 0x1a
+	return ""
+}
+
+func (m *EventNameParamsUpdated) GetResolvePendingDeletions() string {
+	if m != nil {
+		return m.ResolvePendingDeletions
 	}
-	if len(m.MaxNameLevels) > 0 {
-		i -= len(m.MaxNameLevels)
-		copy(dAtA[i:], m.MaxNameLevels)
-		i = encodeVarintName(dAtA, i, uint64(len(m.MaxNameLevels)))
-		i--
-		dAtA[i] = 0x12
+	return ""
+}
+
+func (m *EventNameParamsUpdated) GetMaxQueryResults() string {
+	if m != nil {
+		return m.MaxQueryResults
 	}
-	if len(m.AllowUnrestrictedNames) > 0 {
-		i -= len(m.AllowUnrestrictedNames)
-		copy(dAtA[i:], m.AllowUnrestrictedNames)
-		i = encodeVarintName(dAtA, i, uint64(len(m.AllowUnrestrictedNames)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *EventNameParamsUpdated) GetMaxQueryResponseBytes() string {
+	if m != nil {
+		return m.MaxQueryResponseBytes
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func encodeVarintName(dAtA []byte, offset int, v uint64) int {
-	offset -= sovName(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *EventNameParamsUpdated) GetRestrictNewNames() string {
+	if m != nil {
+		return m.RestrictNewNames
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return ""
 }
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *EventNameParamsUpdated) GetBindNameFee() string {
+	if m != nil {
+		return m.BindNameFee
 	}
-	var l int
-	_ = l
-	if m.MaxSegmentLength != 0 {
-		n += 1 + sovName(uint64(m.MaxSegmentLength))
+	return ""
+}
+
+func (m *EventNameParamsUpdated) GetBindNameFeeRecipient() string {
+	if m != nil {
+		return m.BindNameFeeRecipient
 	}
-	if m.MinSegmentLength != 0 {
-		n += 1 + sovName(uint64(m.MinSegmentLength))
+	return ""
+}
+
+func (m *EventNameParamsUpdated) GetRootNameParams() string {
+	if m != nil {
+		return m.RootNameParams
 	}
-	if m.MaxNameLevels != 0 {
-		n += 1 + sovName(uint64(m.MaxNameLevels))
+	return ""
+}
+
+// EventNamePendingDeletion event emitted when a name is deleted, but will not be removed until a later block.
+type EventNamePendingDeletion struct {
+	// name is the name that is pending deletion.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// address is the address the name is bound to.
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// delete_height is the block height at the end of which the name will be removed.
+	DeleteHeight string `protobuf:"bytes,3,opt,name=delete_height,json=deleteHeight,proto3" json:"delete_height,omitempty"`
+}
+
+func (m *EventNamePendingDeletion) Reset()         { *m = EventNamePendingDeletion{} }
+func (m *EventNamePendingDeletion) String() string { return proto.CompactTextString(m) }
+func (*EventNamePendingDeletion) ProtoMessage()    {}
+func (*EventNamePendingDeletion) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a314256905bb00ec, []int{13}
+}
+func (m *EventNamePendingDeletion) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventNamePendingDeletion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventNamePendingDeletion.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.AllowUnrestrictedNames {
-		n += 2
+}
+func (m *EventNamePendingDeletion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventNamePendingDeletion.Merge(m, src)
+}
+func (m *EventNamePendingDeletion) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventNamePendingDeletion) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventNamePendingDeletion.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventNamePendingDeletion proto.InternalMessageInfo
+
+func (m *EventNamePendingDeletion) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	return n
+	return ""
 }
 
-func (m *NameRecord) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventNamePendingDeletion) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	var l int
-	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovName(uint64(l))
+	return ""
+}
+
+func (m *EventNamePendingDeletion) GetDeleteHeight() string {
+	if m != nil {
+		return m.DeleteHeight
 	}
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovName(uint64(l))
+	return ""
+}
+
+// EventContractNamePolicyApplied event emitted when a contract name policy is applied to the names of a wasm contract.
+type EventContractNamePolicyApplied struct {
+	// contract is the address of the contract that owned the names.
+	Contract string `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+	// admin is the address of the admin that migrated the contract or cleared its admin.
+	Admin string `protobuf:"bytes,2,opt,name=admin,proto3" json:"admin,omitempty"`
+	// policy is the ContractNamePolicy that was applied.
+	Policy string `protobuf:"bytes,3,opt,name=policy,proto3" json:"policy,omitempty"`
+	// names are the names that were reassigned or deleted.
+	Names []string `protobuf:"bytes,4,rep,name=names,proto3" json:"names,omitempty"`
+}
+
+func (m *EventContractNamePolicyApplied) Reset()         { *m = EventContractNamePolicyApplied{} }
+func (m *EventContractNamePolicyApplied) String() string { return proto.CompactTextString(m) }
+func (*EventContractNamePolicyApplied) ProtoMessage()    {}
+func (*EventContractNamePolicyApplied) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a314256905bb00ec, []int{14}
+}
+func (m *EventContractNamePolicyApplied) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventContractNamePolicyApplied) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventContractNamePolicyApplied.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.Restricted {
-		n += 2
+}
+func (m *EventContractNamePolicyApplied) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventContractNamePolicyApplied.Merge(m, src)
+}
+func (m *EventContractNamePolicyApplied) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventContractNamePolicyApplied) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventContractNamePolicyApplied.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventContractNamePolicyApplied proto.InternalMessageInfo
+
+func (m *EventContractNamePolicyApplied) GetContract() string {
+	if m != nil {
+		return m.Contract
 	}
-	return n
+	return ""
 }
 
-func (m *CreateRootNameProposal) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventContractNamePolicyApplied) GetAdmin() string {
+	if m != nil {
+		return m.Admin
 	}
-	var l int
-	_ = l
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovName(uint64(l))
+	return ""
+}
+
+func (m *EventContractNamePolicyApplied) GetPolicy() string {
+	if m != nil {
+		return m.Policy
 	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovName(uint64(l))
+	return ""
+}
+
+func (m *EventContractNamePolicyApplied) GetNames() []string {
+	if m != nil {
+		return m.Names
 	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovName(uint64(l))
+	return nil
+}
+
+// EventNameRemoved event emitted when a name is forcibly removed via governance.
+type EventNameRemoved struct {
+	// name is the name that was removed.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// address is the address the name was bound to.
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// removed_names are all of the names that were removed, including any names under the name.
+	RemovedNames []string `protobuf:"bytes,3,rep,name=removed_names,json=removedNames,proto3" json:"removed_names,omitempty"`
+}
+
+func (m *EventNameRemoved) Reset()         { *m = EventNameRemoved{} }
+func (m *EventNameRemoved) String() string { return proto.CompactTextString(m) }
+func (*EventNameRemoved) ProtoMessage()    {}
+func (*EventNameRemoved) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a314256905bb00ec, []int{15}
+}
+func (m *EventNameRemoved) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventNameRemoved) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventNameRemoved.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovName(uint64(l))
+}
+func (m *EventNameRemoved) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventNameRemoved.Merge(m, src)
+}
+func (m *EventNameRemoved) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventNameRemoved) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventNameRemoved.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventNameRemoved proto.InternalMessageInfo
+
+func (m *EventNameRemoved) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	if m.Restricted {
-		n += 2
+	return ""
+}
+
+func (m *EventNameRemoved) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return n
+	return ""
 }
 
-func (m *EventNameBound) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventNameRemoved) GetRemovedNames() []string {
+	if m != nil {
+		return m.RemovedNames
 	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovName(uint64(l))
+	return nil
+}
+
+// EventSendByName is emitted when coins are sent to the address that a name resolves to.
+type EventSendByName struct {
+	// from_address is the address that sent the coins.
+	FromAddress string `protobuf:"bytes,1,opt,name=from_address,json=fromAddress,proto3" json:"from_address,omitempty"`
+	// name is the name that the coins were sent to.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// to_address is the address that the name resolved to, and that received the coins.
+	ToAddress string `protobuf:"bytes,3,opt,name=to_address,json=toAddress,proto3" json:"to_address,omitempty"`
+	// amount is the coins that were sent.
+	Amount string `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (m *EventSendByName) Reset()         { *m = EventSendByName{} }
+func (m *EventSendByName) String() string { return proto.CompactTextString(m) }
+func (*EventSendByName) ProtoMessage()    {}
+func (*EventSendByName) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a314256905bb00ec, []int{16}
+}
+func (m *EventSendByName) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventSendByName) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventSendByName.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovName(uint64(l))
+}
+func (m *EventSendByName) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventSendByName.Merge(m, src)
+}
+func (m *EventSendByName) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventSendByName) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventSendByName.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventSendByName proto.InternalMessageInfo
+
+func (m *EventSendByName) GetFromAddress() string {
+	if m != nil {
+		return m.FromAddress
 	}
-	if m.Restricted {
-		n += 2
+	return ""
+}
+
+func (m *EventSendByName) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *EventSendByName) GetToAddress() string {
+	if m != nil {
+		return m.ToAddress
+	}
+	return ""
+}
+
+func (m *EventSendByName) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
+// EventAddressRotationApproved is emitted when a new address approves the rotation of an old address to it.
+type EventAddressRotationApproved struct {
+	// old_address is the address that can now be rotated to the new address.
+	OldAddress string `protobuf:"bytes,1,opt,name=old_address,json=oldAddress,proto3" json:"old_address,omitempty"`
+	// new_address is the address that approved the rotation.
+	NewAddress string `protobuf:"bytes,2,opt,name=new_address,json=newAddress,proto3" json:"new_address,omitempty"`
+}
+
+func (m *EventAddressRotationApproved) Reset()         { *m = EventAddressRotationApproved{} }
+func (m *EventAddressRotationApproved) String() string { return proto.CompactTextString(m) }
+func (*EventAddressRotationApproved) ProtoMessage()    {}
+func (*EventAddressRotationApproved) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a314256905bb00ec, []int{17}
+}
+func (m *EventAddressRotationApproved) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventAddressRotationApproved) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventAddressRotationApproved.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventAddressRotationApproved) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventAddressRotationApproved.Merge(m, src)
+}
+func (m *EventAddressRotationApproved) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventAddressRotationApproved) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventAddressRotationApproved.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventAddressRotationApproved proto.InternalMessageInfo
+
+func (m *EventAddressRotationApproved) GetOldAddress() string {
+	if m != nil {
+		return m.OldAddress
+	}
+	return ""
+}
+
+func (m *EventAddressRotationApproved) GetNewAddress() string {
+	if m != nil {
+		return m.NewAddress
+	}
+	return ""
+}
+
+// EventAddressRotated is emitted when an old address is rotated to a new one.
+// The individual changes are recorded in the events emitted by each module (e.g. EventNameUpdate).
+type EventAddressRotated struct {
+	// old_address is the address that was rotated away from.
+	OldAddress string `protobuf:"bytes,1,opt,name=old_address,json=oldAddress,proto3" json:"old_address,omitempty"`
+	// new_address is the address that was rotated to.
+	NewAddress string `protobuf:"bytes,2,opt,name=new_address,json=newAddress,proto3" json:"new_address,omitempty"`
+	// names are the names that were re-bound from the old address to the new one.
+	Names []string `protobuf:"bytes,3,rep,name=names,proto3" json:"names,omitempty"`
+}
+
+func (m *EventAddressRotated) Reset()         { *m = EventAddressRotated{} }
+func (m *EventAddressRotated) String() string { return proto.CompactTextString(m) }
+func (*EventAddressRotated) ProtoMessage()    {}
+func (*EventAddressRotated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a314256905bb00ec, []int{18}
+}
+func (m *EventAddressRotated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventAddressRotated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventAddressRotated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventAddressRotated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventAddressRotated.Merge(m, src)
+}
+func (m *EventAddressRotated) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventAddressRotated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventAddressRotated.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventAddressRotated proto.InternalMessageInfo
+
+func (m *EventAddressRotated) GetOldAddress() string {
+	if m != nil {
+		return m.OldAddress
+	}
+	return ""
+}
+
+func (m *EventAddressRotated) GetNewAddress() string {
+	if m != nil {
+		return m.NewAddress
+	}
+	return ""
+}
+
+func (m *EventAddressRotated) GetNames() []string {
+	if m != nil {
+		return m.Names
+	}
+	return nil
+}
+
+// EventNameChildQuotaUpdated is emitted when the owner of a name sets or removes its child quota.
+type EventNameChildQuotaUpdated struct {
+	// name is the parent name that the quota applies to.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// max_children is the new maximum number of names that can be bound directly under the name. Zero means no limit.
+	MaxChildren string `protobuf:"bytes,2,opt,name=max_children,json=maxChildren,proto3" json:"max_children,omitempty"`
+	// owner is the address of the name's owner that set the quota.
+	Owner string `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty"`
+}
+
+func (m *EventNameChildQuotaUpdated) Reset()         { *m = EventNameChildQuotaUpdated{} }
+func (m *EventNameChildQuotaUpdated) String() string { return proto.CompactTextString(m) }
+func (*EventNameChildQuotaUpdated) ProtoMessage()    {}
+func (*EventNameChildQuotaUpdated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a314256905bb00ec, []int{19}
+}
+func (m *EventNameChildQuotaUpdated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventNameChildQuotaUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventNameChildQuotaUpdated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventNameChildQuotaUpdated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventNameChildQuotaUpdated.Merge(m, src)
+}
+func (m *EventNameChildQuotaUpdated) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventNameChildQuotaUpdated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventNameChildQuotaUpdated.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventNameChildQuotaUpdated proto.InternalMessageInfo
+
+func (m *EventNameChildQuotaUpdated) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *EventNameChildQuotaUpdated) GetMaxChildren() string {
+	if m != nil {
+		return m.MaxChildren
+	}
+	return ""
+}
+
+func (m *EventNameChildQuotaUpdated) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+// EventNamedAccountCreated is emitted when a name is bound to a new account whose address is derived from the name.
+type EventNamedAccountCreated struct {
+	// name is the name the account was created for.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// address is the address derived from the name.
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// owner is the address that requested the account.
+	Owner string `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty"`
+}
+
+func (m *EventNamedAccountCreated) Reset()         { *m = EventNamedAccountCreated{} }
+func (m *EventNamedAccountCreated) String() string { return proto.CompactTextString(m) }
+func (*EventNamedAccountCreated) ProtoMessage()    {}
+func (*EventNamedAccountCreated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a314256905bb00ec, []int{20}
+}
+func (m *EventNamedAccountCreated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventNamedAccountCreated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventNamedAccountCreated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventNamedAccountCreated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventNamedAccountCreated.Merge(m, src)
+}
+func (m *EventNamedAccountCreated) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventNamedAccountCreated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventNamedAccountCreated.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventNamedAccountCreated proto.InternalMessageInfo
+
+func (m *EventNamedAccountCreated) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *EventNamedAccountCreated) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *EventNamedAccountCreated) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+// ExtensionOptionResolveNames is a tx extension option that opts the tx into name alias resolution.
+// When present, each message address field whose value has the "name:" prefix is replaced, before execution,
+// with the address that the rest of the value resolves to, e.g. "name:treasury.acme.pb".
+type ExtensionOptionResolveNames struct {
+}
+
+func (m *ExtensionOptionResolveNames) Reset()         { *m = ExtensionOptionResolveNames{} }
+func (m *ExtensionOptionResolveNames) String() string { return proto.CompactTextString(m) }
+func (*ExtensionOptionResolveNames) ProtoMessage()    {}
+func (*ExtensionOptionResolveNames) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a314256905bb00ec, []int{21}
+}
+func (m *ExtensionOptionResolveNames) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExtensionOptionResolveNames) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExtensionOptionResolveNames.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExtensionOptionResolveNames) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExtensionOptionResolveNames.Merge(m, src)
+}
+func (m *ExtensionOptionResolveNames) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExtensionOptionResolveNames) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExtensionOptionResolveNames.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExtensionOptionResolveNames proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterEnum("provenance.name.v1.ContractNamePolicy", ContractNamePolicy_name, ContractNamePolicy_value)
+	proto.RegisterType((*Params)(nil), "provenance.name.v1.Params")
+	proto.RegisterType((*RootNameParams)(nil), "provenance.name.v1.RootNameParams")
+	proto.RegisterType((*NameRecord)(nil), "provenance.name.v1.NameRecord")
+	proto.RegisterType((*PendingNameDeletion)(nil), "provenance.name.v1.PendingNameDeletion")
+	proto.RegisterType((*AddressRotationApproval)(nil), "provenance.name.v1.AddressRotationApproval")
+	proto.RegisterType((*ChildQuota)(nil), "provenance.name.v1.ChildQuota")
+	proto.RegisterType((*ParentApproval)(nil), "provenance.name.v1.ParentApproval")
+	proto.RegisterType((*ParentApprovalSignDoc)(nil), "provenance.name.v1.ParentApprovalSignDoc")
+	proto.RegisterType((*CreateRootNameProposal)(nil), "provenance.name.v1.CreateRootNameProposal")
+	proto.RegisterType((*EventNameBound)(nil), "provenance.name.v1.EventNameBound")
+	proto.RegisterType((*EventNameUnbound)(nil), "provenance.name.v1.EventNameUnbound")
+	proto.RegisterType((*EventNameUpdate)(nil), "provenance.name.v1.EventNameUpdate")
+	proto.RegisterType((*EventNameParamsUpdated)(nil), "provenance.name.v1.EventNameParamsUpdated")
+	proto.RegisterType((*EventNamePendingDeletion)(nil), "provenance.name.v1.EventNamePendingDeletion")
+	proto.RegisterType((*EventContractNamePolicyApplied)(nil), "provenance.name.v1.EventContractNamePolicyApplied")
+	proto.RegisterType((*EventNameRemoved)(nil), "provenance.name.v1.EventNameRemoved")
+	proto.RegisterType((*EventSendByName)(nil), "provenance.name.v1.EventSendByName")
+	proto.RegisterType((*EventAddressRotationApproved)(nil), "provenance.name.v1.EventAddressRotationApproved")
+	proto.RegisterType((*EventAddressRotated)(nil), "provenance.name.v1.EventAddressRotated")
+	proto.RegisterType((*EventNameChildQuotaUpdated)(nil), "provenance.name.v1.EventNameChildQuotaUpdated")
+	proto.RegisterType((*EventNamedAccountCreated)(nil), "provenance.name.v1.EventNamedAccountCreated")
+	proto.RegisterType((*ExtensionOptionResolveNames)(nil), "provenance.name.v1.ExtensionOptionResolveNames")
+}
+
+func init() { proto.RegisterFile("provenance/name/v1/name.proto", fileDescriptor_a314256905bb00ec) }
+
+var fileDescriptor_a314256905bb00ec = []byte{
+	// 1723 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0xbb, 0x6f, 0x1b, 0xc9,
+	0x19, 0xe7, 0x8a, 0xb4, 0xac, 0xfd, 0x28, 0xc9, 0xf4, 0x5a, 0x96, 0xd7, 0xb4, 0x4d, 0xf2, 0xf6,
+	0x10, 0x43, 0x30, 0x62, 0xf2, 0xac, 0x20, 0xaf, 0x03, 0x02, 0x84, 0xa4, 0x78, 0x17, 0xe5, 0x6c,
+	0x8a, 0x5e, 0xc9, 0x45, 0x52, 0xdc, 0xde, 0x72, 0x77, 0x4c, 0x2d, 0xbc, 0x3b, 0xb3, 0xb7, 0x33,
+	0x94, 0xc8, 0x2a, 0xc1, 0x15, 0xc1, 0xc1, 0x45, 0x72, 0x45, 0x8a, 0x34, 0x06, 0x0c, 0xa4, 0xbb,
+	0x2a, 0x45, 0xda, 0x74, 0x29, 0x0e, 0xa9, 0x8c, 0x54, 0xa9, 0xe2, 0xc0, 0x2e, 0x92, 0x2a, 0x7f,
+	0x43, 0x30, 0xb3, 0xb3, 0xe4, 0x92, 0x5c, 0x5b, 0x8f, 0x38, 0x48, 0x25, 0xce, 0xf7, 0x9e, 0xef,
+	0x35, 0xbf, 0x15, 0xdc, 0x0a, 0x23, 0x72, 0x84, 0xb0, 0x8d, 0x1d, 0xd4, 0xc0, 0x76, 0x80, 0x1a,
+	0x47, 0xf7, 0xc4, 0xdf, 0x7a, 0x18, 0x11, 0x46, 0x34, 0x6d, 0xca, 0xae, 0x0b, 0xf2, 0xd1, 0xbd,
+	0x72, 0xc5, 0x21, 0x34, 0x20, 0xb4, 0xd1, 0xb7, 0x29, 0x17, 0xef, 0x23, 0x66, 0xdf, 0x6b, 0x38,
+	0xc4, 0xc3, 0xb1, 0x4e, 0xf9, 0x9a, 0xe4, 0x07, 0x74, 0xc0, 0xad, 0x05, 0x74, 0x20, 0x19, 0xd7,
+	0x63, 0x86, 0x25, 0x4e, 0x8d, 0xf8, 0x20, 0x59, 0x1b, 0x03, 0x32, 0x20, 0x31, 0x9d, 0xff, 0x4a,
+	0x14, 0x06, 0x84, 0x0c, 0x7c, 0xd4, 0x10, 0xa7, 0xfe, 0xf0, 0x71, 0xc3, 0xc6, 0x63, 0xc9, 0xaa,
+	0xce, 0xb3, 0x98, 0x17, 0x20, 0xca, 0xec, 0x20, 0x8c, 0x05, 0x8c, 0x17, 0x2b, 0xb0, 0xdc, 0xb3,
+	0x23, 0x3b, 0xa0, 0xda, 0xb7, 0x41, 0x0b, 0xec, 0x91, 0x45, 0xd1, 0x20, 0x40, 0x98, 0x59, 0x3e,
+	0xc2, 0x03, 0x76, 0xa8, 0x2b, 0x35, 0x65, 0x6b, 0xcd, 0x2c, 0x05, 0xf6, 0x68, 0x3f, 0x66, 0xdc,
+	0x17, 0x74, 0x21, 0xed, 0xe1, 0x79, 0xe9, 0x25, 0x29, 0xed, 0xe1, 0x59, 0xe9, 0xdb, 0x70, 0x89,
+	0xdb, 0xe6, 0xb9, 0xb1, 0x7c, 0x74, 0x84, 0x7c, 0xaa, 0xe7, 0x85, 0xe8, 0x5a, 0x60, 0x8f, 0xba,
+	0x76, 0x80, 0xee, 0x0b, 0xa2, 0xf6, 0x03, 0xd0, 0x6d, 0xdf, 0x27, 0xc7, 0xd6, 0x10, 0x47, 0x88,
+	0xb2, 0xc8, 0x73, 0x18, 0x72, 0x85, 0x1a, 0xd5, 0x0b, 0x35, 0x65, 0x6b, 0xc5, 0xdc, 0x14, 0xfc,
+	0x47, 0x29, 0x36, 0x57, 0xa7, 0xda, 0xfb, 0xc0, 0x4d, 0x59, 0x2e, 0xf2, 0x11, 0xf3, 0x08, 0xa6,
+	0xfa, 0x05, 0x61, 0x7f, 0x35, 0xb0, 0x47, 0x3b, 0x09, 0x4d, 0xf3, 0xe0, 0x96, 0x43, 0x30, 0x8b,
+	0x6c, 0x87, 0x59, 0x81, 0x37, 0x88, 0xec, 0xc4, 0xba, 0x15, 0x12, 0xdf, 0x73, 0xc6, 0xfa, 0x72,
+	0x4d, 0xd9, 0x5a, 0xdf, 0xbe, 0x5d, 0x5f, 0xac, 0x67, 0xbd, 0x2d, 0x15, 0xb9, 0xbb, 0x9e, 0x90,
+	0x36, 0xcb, 0x89, 0xb1, 0x07, 0xd2, 0xd6, 0x94, 0xa7, 0x45, 0x60, 0x4c, 0x5c, 0xd9, 0x2e, 0x4f,
+	0x95, 0xe3, 0x23, 0x3b, 0x9a, 0xf3, 0x77, 0xf1, 0x4c, 0xfe, 0x2a, 0x89, 0xc5, 0x26, 0x37, 0xd8,
+	0x8e, 0xed, 0xa5, 0x7c, 0xd6, 0xe1, 0x8a, 0xb8, 0x3f, 0xe2, 0x69, 0xb0, 0xc7, 0x56, 0xdf, 0x27,
+	0xce, 0x13, 0xaa, 0xaf, 0x88, 0x4c, 0x5c, 0x8e, 0x59, 0x3b, 0x9c, 0xd3, 0x12, 0x0c, 0xed, 0x43,
+	0xb8, 0x1e, 0x21, 0x4a, 0xfc, 0x23, 0x64, 0x85, 0x08, 0xbb, 0x1e, 0x1e, 0xa4, 0xf2, 0xa7, 0x8a,
+	0x74, 0x5f, 0x93, 0x02, 0xbd, 0x98, 0x3f, 0x4d, 0xe5, 0x1d, 0xb8, 0xcc, 0xf3, 0xfd, 0xf9, 0x10,
+	0x45, 0x63, 0x2b, 0x42, 0x74, 0xe8, 0x33, 0xaa, 0x83, 0xf0, 0xc4, 0x4b, 0xfd, 0x90, 0xd3, 0xcd,
+	0x98, 0xac, 0x7d, 0x1f, 0xf4, 0x19, 0xd9, 0x90, 0x60, 0x8a, 0xac, 0xfe, 0x98, 0x21, 0xaa, 0x17,
+	0x6b, 0xca, 0x56, 0xc1, 0xbc, 0x9a, 0x52, 0x11, 0xdc, 0x16, 0x67, 0xf2, 0x26, 0x4b, 0xea, 0x6c,
+	0x61, 0x74, 0x2c, 0x1b, 0x61, 0x55, 0x44, 0x56, 0x4a, 0x38, 0x5d, 0x74, 0x1c, 0xb7, 0x00, 0x81,
+	0xb5, 0xbe, 0x87, 0x65, 0x82, 0x1f, 0x23, 0xa4, 0xaf, 0xd5, 0xf2, 0x5b, 0xc5, 0xed, 0xeb, 0x75,
+	0x39, 0x43, 0x7c, 0x12, 0xeb, 0x72, 0x12, 0xeb, 0x6d, 0xe2, 0xe1, 0xd6, 0x07, 0xdf, 0xfc, 0xbd,
+	0x9a, 0xfb, 0xfa, 0x65, 0x75, 0x6b, 0xe0, 0xb1, 0xc3, 0x61, 0xbf, 0xee, 0x90, 0x40, 0x0e, 0x9c,
+	0xfc, 0x73, 0x97, 0xba, 0x4f, 0x1a, 0x6c, 0x1c, 0x22, 0x2a, 0x14, 0xa8, 0x59, 0xe4, 0x1e, 0xb8,
+	0xbb, 0x8f, 0x10, 0xd2, 0xf6, 0xe0, 0xda, 0x8c, 0x43, 0x2b, 0x42, 0x8e, 0x17, 0x7a, 0x08, 0x33,
+	0x7d, 0xbd, 0xa6, 0x6c, 0xa9, 0x2d, 0xfd, 0xaf, 0x7f, 0xbc, 0xbb, 0x21, 0xbd, 0x37, 0x5d, 0x37,
+	0x42, 0x94, 0xee, 0xb3, 0xc8, 0xc3, 0x03, 0x73, 0x23, 0x65, 0xc7, 0x4c, 0xb4, 0x34, 0x13, 0x4a,
+	0x11, 0x21, 0x4c, 0xb6, 0x88, 0x18, 0x4b, 0xfd, 0x92, 0xb8, 0x84, 0x91, 0xd5, 0x22, 0x26, 0x21,
+	0x71, 0x7b, 0x08, 0xc9, 0x56, 0x81, 0xdf, 0xc6, 0x5c, 0x8f, 0x66, 0xa8, 0x49, 0xa1, 0x86, 0x43,
+	0xcf, 0x4d, 0xa6, 0x95, 0xea, 0xa5, 0x49, 0xa1, 0x1e, 0x0d, 0x3d, 0x57, 0xce, 0x2a, 0xd5, 0xda,
+	0x50, 0x71, 0x22, 0x34, 0x99, 0x0a, 0x6a, 0x45, 0x88, 0x21, 0xcc, 0x0b, 0x9e, 0xf4, 0xd2, 0x65,
+	0xa1, 0x78, 0x43, 0x4a, 0x89, 0xbc, 0x9b, 0x89, 0x4c, 0xdc, 0x55, 0xc6, 0x6f, 0x97, 0x60, 0x7d,
+	0x36, 0x32, 0x4d, 0x83, 0x02, 0x8f, 0x4a, 0x2c, 0x13, 0xd5, 0x14, 0xbf, 0xdf, 0x50, 0xdb, 0xa5,
+	0xd3, 0xd6, 0x36, 0xff, 0xff, 0xab, 0x6d, 0xe1, 0x3c, 0xb5, 0x35, 0x7e, 0xa5, 0x00, 0x70, 0xa2,
+	0x89, 0x1c, 0x12, 0xb9, 0x3c, 0x25, 0xdc, 0x74, 0x92, 0x12, 0xfe, 0x5b, 0xdb, 0x86, 0x8b, 0x76,
+	0x6c, 0x49, 0x5f, 0x3a, 0xc1, 0x47, 0x22, 0xa8, 0x55, 0x00, 0xa6, 0xab, 0x50, 0x2c, 0xd5, 0x15,
+	0x33, 0x45, 0xf9, 0xb0, 0xf4, 0xbb, 0xe7, 0xd5, 0xdc, 0x17, 0xff, 0xfc, 0xc3, 0x9d, 0x44, 0xc3,
+	0xf8, 0x42, 0x81, 0x2b, 0x72, 0x9c, 0x79, 0x3c, 0xc9, 0x48, 0xbf, 0xb3, 0x88, 0xde, 0x87, 0x35,
+	0xb9, 0x85, 0x0e, 0x91, 0x37, 0x38, 0x64, 0x22, 0xa8, 0xbc, 0xb9, 0x1a, 0x13, 0x7f, 0x22, 0x68,
+	0xc6, 0x6f, 0x14, 0xb8, 0x26, 0xf5, 0x4d, 0xc2, 0x6c, 0x1e, 0x40, 0x33, 0xe4, 0x2d, 0x6e, 0xfb,
+	0xda, 0x0f, 0xa1, 0x48, 0x7c, 0xd7, 0x4a, 0x1c, 0x2b, 0x27, 0x38, 0x06, 0xe2, 0xbb, 0x92, 0xc2,
+	0x55, 0x79, 0x2f, 0x9d, 0x36, 0x66, 0xc0, 0xe8, 0x58, 0x52, 0x8c, 0x36, 0x40, 0xfb, 0xd0, 0xf3,
+	0xdd, 0x87, 0x43, 0xc2, 0xec, 0xcc, 0x64, 0xbc, 0x07, 0xfc, 0x35, 0xb1, 0x1c, 0x2e, 0x15, 0x21,
+	0x2c, 0xac, 0x17, 0xcc, 0x62, 0x60, 0x8f, 0xda, 0x92, 0x64, 0xfc, 0x49, 0x81, 0xf5, 0x9e, 0x1d,
+	0x21, 0xcc, 0x26, 0xb7, 0xf9, 0x18, 0x2e, 0x86, 0xc3, 0xbe, 0xf5, 0x04, 0x8d, 0x85, 0xb1, 0xe2,
+	0xf6, 0x46, 0x3d, 0x7e, 0x94, 0xeb, 0xc9, 0xa3, 0x5c, 0x6f, 0xe2, 0x71, 0x4b, 0xff, 0xcb, 0x34,
+	0x48, 0x27, 0x1a, 0x87, 0x8c, 0xd4, 0x7b, 0xc3, 0xfe, 0x27, 0x68, 0x6c, 0x2e, 0x87, 0xe2, 0xaf,
+	0xb6, 0x03, 0x80, 0x46, 0xa1, 0x17, 0x89, 0x64, 0x09, 0xe7, 0xc5, 0xed, 0xf2, 0x82, 0xad, 0x83,
+	0xe4, 0x81, 0x6f, 0xad, 0xf0, 0x01, 0xf8, 0xea, 0x65, 0x55, 0x31, 0x53, 0x7a, 0xda, 0x4d, 0x50,
+	0xa9, 0x37, 0xc0, 0x36, 0x1b, 0x46, 0x48, 0x54, 0x66, 0xd5, 0x9c, 0x12, 0x8c, 0x3f, 0x2b, 0x70,
+	0x75, 0x36, 0xfe, 0x7d, 0x6f, 0x80, 0x77, 0x88, 0xa3, 0x5d, 0x87, 0x15, 0xe7, 0xd0, 0xf6, 0xb0,
+	0xe5, 0xb9, 0x32, 0x29, 0x17, 0xc5, 0x79, 0x77, 0xda, 0xca, 0x4b, 0xa9, 0x5c, 0x7d, 0x0f, 0xd4,
+	0x08, 0x7d, 0x3e, 0x44, 0x94, 0x91, 0x48, 0xcf, 0x9f, 0x50, 0x86, 0xa9, 0xe8, 0xdc, 0x25, 0x0b,
+	0xe7, 0xbb, 0xa4, 0xf1, 0xb5, 0x02, 0x9b, 0x6d, 0xb1, 0xa2, 0x26, 0x8b, 0x28, 0x22, 0x21, 0xa1,
+	0xb6, 0xaf, 0x6d, 0xc0, 0x05, 0xe6, 0x31, 0x3f, 0xa9, 0x6c, 0x7c, 0xd0, 0x6a, 0x50, 0x74, 0x11,
+	0x75, 0x22, 0x2f, 0x9c, 0x24, 0x57, 0x35, 0xd3, 0xa4, 0xc9, 0x25, 0xf3, 0xa9, 0x4b, 0x6e, 0xc0,
+	0x05, 0x72, 0x8c, 0x51, 0x14, 0x6f, 0x04, 0x33, 0x3e, 0xcc, 0x4d, 0xe4, 0x85, 0x85, 0x89, 0x5c,
+	0xff, 0xf2, 0x79, 0x35, 0xc7, 0xa7, 0xf2, 0x5f, 0xcf, 0xab, 0x39, 0x5d, 0x31, 0x3e, 0x85, 0xf5,
+	0xce, 0x11, 0xc2, 0x22, 0xcc, 0x16, 0x19, 0x62, 0x57, 0xd3, 0xa7, 0x53, 0x27, 0x53, 0x2d, 0x8f,
+	0x99, 0xa9, 0x3e, 0x61, 0x03, 0x18, 0x9f, 0x41, 0x69, 0x62, 0xff, 0x11, 0xee, 0xff, 0x0f, 0x3c,
+	0x58, 0x70, 0x69, 0xea, 0x21, 0x74, 0x6d, 0x86, 0xde, 0xb1, 0x83, 0x5f, 0x2f, 0xc3, 0xe6, 0xc4,
+	0x43, 0xfc, 0xa6, 0xc4, 0x7e, 0xdc, 0xb7, 0x22, 0xc6, 0xd8, 0xf3, 0x9b, 0x10, 0x63, 0x06, 0x26,
+	0x8d, 0x63, 0x9a, 0xc3, 0xa4, 0xd9, 0x48, 0x37, 0xee, 0x83, 0x45, 0xa4, 0x9b, 0x8d, 0xa2, 0x0b,
+	0x52, 0x7a, 0x1e, 0x45, 0x67, 0xa2, 0x56, 0x75, 0x0e, 0xb5, 0x36, 0x4f, 0x83, 0x5a, 0xd5, 0xb7,
+	0xa2, 0xd1, 0x9f, 0x9e, 0x1a, 0x8d, 0xaa, 0xff, 0x0d, 0xca, 0x54, 0xcf, 0x85, 0x32, 0xd5, 0x73,
+	0xa0, 0x4c, 0xf5, 0xec, 0x28, 0x53, 0x3d, 0x3b, 0xca, 0x54, 0x33, 0x90, 0x88, 0xb1, 0x88, 0x32,
+	0xc5, 0xb2, 0x48, 0x83, 0x87, 0xef, 0x9e, 0x00, 0x0c, 0xdf, 0x00, 0xff, 0xb6, 0x32, 0xe1, 0x1f,
+	0x97, 0x9f, 0x03, 0x75, 0x46, 0x00, 0xfa, 0x74, 0x1e, 0x66, 0x93, 0x96, 0xf9, 0x74, 0xe9, 0x73,
+	0xef, 0xf8, 0x09, 0xaf, 0xb5, 0x3a, 0xf7, 0x5a, 0xff, 0x52, 0x81, 0x8a, 0xf0, 0xb7, 0xf8, 0x51,
+	0xd2, 0x0c, 0x43, 0xdf, 0x43, 0xae, 0x56, 0x86, 0x95, 0xa4, 0x6f, 0xa4, 0xe7, 0xc9, 0x99, 0xef,
+	0x49, 0xd1, 0x74, 0xd2, 0x77, 0x7c, 0xd0, 0x36, 0x61, 0x59, 0xf6, 0x5d, 0xec, 0x52, 0x9e, 0xb8,
+	0x74, 0xf2, 0xc1, 0x97, 0xe7, 0xd2, 0xe2, 0x60, 0xa0, 0xd4, 0x16, 0x33, 0x51, 0x40, 0x8e, 0x90,
+	0x7b, 0xf6, 0x9b, 0x46, 0xb1, 0xa2, 0xac, 0x70, 0x5e, 0xd8, 0x5f, 0x95, 0x44, 0x51, 0x5d, 0xe3,
+	0x17, 0x72, 0x95, 0xed, 0x23, 0xec, 0xb6, 0xc6, 0x5d, 0xf9, 0xec, 0x3f, 0x8e, 0x48, 0x30, 0x8b,
+	0x47, 0xcc, 0x22, 0xa7, 0x35, 0xdf, 0xb2, 0xd3, 0x6e, 0x01, 0x30, 0x32, 0x51, 0x8a, 0xaf, 0xa8,
+	0x32, 0x92, 0xa8, 0x6c, 0xc2, 0xb2, 0x1d, 0x90, 0x61, 0x02, 0x27, 0x4d, 0x79, 0x32, 0x3e, 0x83,
+	0x9b, 0x22, 0x80, 0x4c, 0x70, 0x84, 0x5c, 0xad, 0x9a, 0x01, 0x8e, 0x66, 0x20, 0x50, 0x35, 0x03,
+	0x02, 0xcd, 0x00, 0x9d, 0x00, 0xae, 0x2c, 0x78, 0x78, 0x17, 0x86, 0xa7, 0x85, 0xcb, 0xa7, 0x0b,
+	0xe7, 0x41, 0x79, 0x52, 0xb8, 0x29, 0xc0, 0x4a, 0xd6, 0xf7, 0x69, 0x71, 0x96, 0x3a, 0x83, 0xb3,
+	0xa6, 0x2f, 0x6f, 0x3e, 0xf5, 0xf2, 0x1a, 0x9f, 0xa6, 0xa6, 0xc2, 0x6d, 0x3a, 0x0e, 0x4f, 0x68,
+	0x0c, 0x03, 0xce, 0xda, 0x2b, 0xd9, 0xf6, 0x6f, 0xc1, 0x8d, 0xce, 0x88, 0x21, 0x4c, 0x3d, 0x82,
+	0xf7, 0x04, 0x2c, 0x30, 0xe3, 0xbd, 0x25, 0x7a, 0xe7, 0xce, 0xbf, 0x15, 0xd0, 0x16, 0x07, 0x44,
+	0xfb, 0x31, 0xd4, 0xda, 0x7b, 0xdd, 0x03, 0xb3, 0xd9, 0x3e, 0xb0, 0xba, 0xcd, 0x07, 0x1d, 0xab,
+	0xb7, 0x77, 0x7f, 0xb7, 0xfd, 0x33, 0xeb, 0x51, 0x77, 0xbf, 0xd7, 0x69, 0xef, 0x7e, 0xb4, 0xdb,
+	0xd9, 0x29, 0xe5, 0xca, 0xe5, 0xa7, 0xcf, 0x6a, 0x9b, 0x8b, 0xda, 0x9f, 0x20, 0x14, 0x6a, 0x0f,
+	0xe1, 0x76, 0xa6, 0x05, 0xb3, 0xd3, 0xdc, 0xdf, 0xdf, 0xfd, 0xb8, 0x6b, 0x1d, 0xec, 0x59, 0xcd,
+	0x9d, 0x07, 0xbb, 0xdd, 0x92, 0x52, 0xfe, 0xd6, 0xd3, 0x67, 0xb5, 0xf7, 0x16, 0xed, 0x98, 0xc8,
+	0xa6, 0x1c, 0xe6, 0x1d, 0x10, 0xb1, 0xd5, 0xb5, 0x1f, 0xc1, 0x8d, 0x4c, 0x93, 0x3b, 0x9d, 0xfb,
+	0x9d, 0x83, 0x4e, 0x69, 0xa9, 0x7c, 0xf3, 0xe9, 0xb3, 0x9a, 0xbe, 0x68, 0x47, 0x6c, 0x19, 0x54,
+	0x2e, 0x7c, 0xf9, 0xfb, 0x4a, 0xae, 0xe5, 0x7c, 0xf3, 0xaa, 0xa2, 0xbc, 0x78, 0x55, 0x51, 0xfe,
+	0xf1, 0xaa, 0xa2, 0x7c, 0xf5, 0xba, 0x92, 0x7b, 0xf1, 0xba, 0x92, 0xfb, 0xdb, 0xeb, 0x4a, 0x0e,
+	0xae, 0x7a, 0x24, 0xe3, 0x83, 0xb5, 0xa7, 0xfc, 0xfc, 0x83, 0xd4, 0xd7, 0xd8, 0x54, 0xe0, 0xae,
+	0x47, 0x52, 0xa7, 0xc6, 0x28, 0xfe, 0x1f, 0x9b, 0xf8, 0x36, 0xeb, 0x2f, 0x0b, 0xd4, 0xf7, 0x9d,
+	0xff, 0x0c, 0x00, 0x93, 0x46, 0xc7, 0x70, 0x83, 0x13, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CreatedNamesRetentionBlocks != 0 {
+		i = encodeVarintName(dAtA, i, uint64(m.CreatedNamesRetentionBlocks))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if m.MaxUuidSegments != 0 {
+		i = encodeVarintName(dAtA, i, uint64(m.MaxUuidSegments))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if len(m.RootNameParams) > 0 {
+		for iNdEx := len(m.RootNameParams) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RootNameParams[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintName(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if len(m.BindNameFeeRecipient) > 0 {
+		i -= len(m.BindNameFeeRecipient)
+		copy(dAtA[i:], m.BindNameFeeRecipient)
+		i = encodeVarintName(dAtA, i, uint64(len(m.BindNameFeeRecipient)))
+		i--
+		dAtA[i] = 0x72
+	}
+	if len(m.BindNameFee) > 0 {
+		for iNdEx := len(m.BindNameFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BindNameFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintName(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if m.RestrictNewNames {
+		i--
+		if m.RestrictNewNames {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.MaxQueryResponseBytes != 0 {
+		i = encodeVarintName(dAtA, i, uint64(m.MaxQueryResponseBytes))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.MaxQueryResults != 0 {
+		i = encodeVarintName(dAtA, i, uint64(m.MaxQueryResults))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.ResolvePendingDeletions {
+		i--
+		if m.ResolvePendingDeletions {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.DeleteDelayBlocks != 0 {
+		i = encodeVarintName(dAtA, i, uint64(m.DeleteDelayBlocks))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.ContractAdminClearedNamePolicy != 0 {
+		i = encodeVarintName(dAtA, i, uint64(m.ContractAdminClearedNamePolicy))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.ContractMigratedNamePolicy != 0 {
+		i = encodeVarintName(dAtA, i, uint64(m.ContractMigratedNamePolicy))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.MaxDeletions != 0 {
+		i = encodeVarintName(dAtA, i, uint64(m.MaxDeletions))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.AllowUnrestrictedNames {
+		i--
+		if m.AllowUnrestrictedNames {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MaxNameLevels != 0 {
+		i = encodeVarintName(dAtA, i, uint64(m.MaxNameLevels))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MinSegmentLength != 0 {
+		i = encodeVarintName(dAtA, i, uint64(m.MinSegmentLength))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MaxSegmentLength != 0 {
+		i = encodeVarintName(dAtA, i, uint64(m.MaxSegmentLength))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RootNameParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RootNameParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RootNameParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BindNameFeeRecipient) > 0 {
+		i -= len(m.BindNameFeeRecipient)
+		copy(dAtA[i:], m.BindNameFeeRecipient)
+		i = encodeVarintName(dAtA, i, uint64(len(m.BindNameFeeRecipient)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.BindNameFee) > 0 {
+		for iNdEx := len(m.BindNameFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BindNameFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintName(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.RestrictNewNames {
+		i--
+		if m.RestrictNewNames {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Root) > 0 {
+		i -= len(m.Root)
+		copy(dAtA[i:], m.Root)
+		i = encodeVarintName(dAtA, i, uint64(len(m.Root)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *NameRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NameRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NameRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Restricted {
+		i--
+		if m.Restricted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintName(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintName(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PendingNameDeletion) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingNameDeletion) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingNameDeletion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DeleteHeight != 0 {
+		i = encodeVarintName(dAtA, i, uint64(m.DeleteHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintName(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintName(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AddressRotationApproval) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AddressRotationApproval) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AddressRotationApproval) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NewAddress) > 0 {
+		i -= len(m.NewAddress)
+		copy(dAtA[i:], m.NewAddress)
+		i = encodeVarintName(dAtA, i, uint64(len(m.NewAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OldAddress) > 0 {
+		i -= len(m.OldAddress)
+		copy(dAtA[i:], m.OldAddress)
+		i = encodeVarintName(dAtA, i, uint64(len(m.OldAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ChildQuota) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChildQuota) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChildQuota) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxChildren != 0 {
+		i = encodeVarintName(dAtA, i, uint64(m.MaxChildren))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintName(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ParentApproval) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ParentApproval) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ParentApproval) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintName(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Expiration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Expiration):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintName(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x12
+	if m.PubKey != nil {
+		{
+			size, err := m.PubKey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintName(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ParentApprovalSignDoc) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ParentApprovalSignDoc) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ParentApprovalSignDoc) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Expiration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Expiration):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintName(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Requestor) > 0 {
+		i -= len(m.Requestor)
+		copy(dAtA[i:], m.Requestor)
+		i = encodeVarintName(dAtA, i, uint64(len(m.Requestor)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintName(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintName(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CreateRootNameProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CreateRootNameProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CreateRootNameProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Restricted {
+		i--
+		if m.Restricted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintName(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintName(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintName(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintName(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventNameBound) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventNameBound) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventNameBound) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Restricted {
+		i--
+		if m.Restricted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintName(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintName(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventNameUnbound) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventNameUnbound) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventNameUnbound) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Restricted {
+		i--
+		if m.Restricted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintName(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintName(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventNameUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventNameUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventNameUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Restricted {
+		i--
+		if m.Restricted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintName(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintName(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventNameParamsUpdated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventNameParamsUpdated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventNameParamsUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RootNameParams) > 0 {
+		i -= len(m.RootNameParams)
+		copy(dAtA[i:], m.RootNameParams)
+		i = encodeVarintName(dAtA, i, uint64(len(m.RootNameParams)))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if len(m.BindNameFeeRecipient) > 0 {
+		i -= len(m.BindNameFeeRecipient)
+		copy(dAtA[i:], m.BindNameFeeRecipient)
+		i = encodeVarintName(dAtA, i, uint64(len(m.BindNameFeeRecipient)))
+		i--
+		dAtA[i] = 0x72
+	}
+	if len(m.BindNameFee) > 0 {
+		i -= len(m.BindNameFee)
+		copy(dAtA[i:], m.BindNameFee)
+		i = encodeVarintName(dAtA, i, uint64(len(m.BindNameFee)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if len(m.RestrictNewNames) > 0 {
+		i -= len(m.RestrictNewNames)
+		copy(dAtA[i:], m.RestrictNewNames)
+		i = encodeVarintName(dAtA, i, uint64(len(m.RestrictNewNames)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.MaxQueryResponseBytes) > 0 {
+		i -= len(m.MaxQueryResponseBytes)
+		copy(dAtA[i:], m.MaxQueryResponseBytes)
+		i = encodeVarintName(dAtA, i, uint64(len(m.MaxQueryResponseBytes)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.MaxQueryResults) > 0 {
+		i -= len(m.MaxQueryResults)
+		copy(dAtA[i:], m.MaxQueryResults)
+		i = encodeVarintName(dAtA, i, uint64(len(m.MaxQueryResults)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.ResolvePendingDeletions) > 0 {
+		i -= len(m.ResolvePendingDeletions)
+		copy(dAtA[i:], m.ResolvePendingDeletions)
+		i = encodeVarintName(dAtA, i, uint64(len(m.ResolvePendingDeletions)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.DeleteDelayBlocks) > 0 {
+		i -= len(m.DeleteDelayBlocks)
+		copy(dAtA[i:], m.DeleteDelayBlocks)
+		i = encodeVarintName(dAtA, i, uint64(len(m.DeleteDelayBlocks)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.ContractAdminClearedNamePolicy) > 0 {
+		i -= len(m.ContractAdminClearedNamePolicy)
+		copy(dAtA[i:], m.ContractAdminClearedNamePolicy)
+		i = encodeVarintName(dAtA, i, uint64(len(m.ContractAdminClearedNamePolicy)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.ContractMigratedNamePolicy) > 0 {
+		i -= len(m.ContractMigratedNamePolicy)
+		copy(dAtA[i:], m.ContractMigratedNamePolicy)
+		i = encodeVarintName(dAtA, i, uint64(len(m.ContractMigratedNamePolicy)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.MaxDeletions) > 0 {
+		i -= len(m.MaxDeletions)
+		copy(dAtA[i:], m.MaxDeletions)
+		i = encodeVarintName(dAtA, i, uint64(len(m.MaxDeletions)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.MaxSegmentLength) > 0 {
+		i -= len(m.MaxSegmentLength)
+		copy(dAtA[i:], m.MaxSegmentLength)
+		i = encodeVarintName(dAtA, i, uint64(len(m.MaxSegmentLength)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.MinSegmentLength) > 0 {
+		i -= len(m.MinSegmentLength)
+		copy(dAtA[i:], m.MinSegmentLength)
+		i = encodeVarintName(dAtA, i, uint64(len(m.MinSegmentLength)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.MaxNameLevels) > 0 {
+		i -= len(m.MaxNameLevels)
+		copy(dAtA[i:], m.MaxNameLevels)
+		i = encodeVarintName(dAtA, i, uint64(len(m.MaxNameLevels)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.AllowUnrestrictedNames) > 0 {
+		i -= len(m.AllowUnrestrictedNames)
+		copy(dAtA[i:], m.AllowUnrestrictedNames)
+		i = encodeVarintName(dAtA, i, uint64(len(m.AllowUnrestrictedNames)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventNamePendingDeletion) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventNamePendingDeletion) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventNamePendingDeletion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DeleteHeight) > 0 {
+		i -= len(m.DeleteHeight)
+		copy(dAtA[i:], m.DeleteHeight)
+		i = encodeVarintName(dAtA, i, uint64(len(m.DeleteHeight)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintName(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintName(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventContractNamePolicyApplied) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventContractNamePolicyApplied) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventContractNamePolicyApplied) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Names) > 0 {
+		for iNdEx := len(m.Names) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Names[iNdEx])
+			copy(dAtA[i:], m.Names[iNdEx])
+			i = encodeVarintName(dAtA, i, uint64(len(m.Names[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Policy) > 0 {
+		i -= len(m.Policy)
+		copy(dAtA[i:], m.Policy)
+		i = encodeVarintName(dAtA, i, uint64(len(m.Policy)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintName(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintName(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventNameRemoved) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventNameRemoved) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventNameRemoved) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RemovedNames) > 0 {
+		for iNdEx := len(m.RemovedNames) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RemovedNames[iNdEx])
+			copy(dAtA[i:], m.RemovedNames[iNdEx])
+			i = encodeVarintName(dAtA, i, uint64(len(m.RemovedNames[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintName(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintName(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventSendByName) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSendByName) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSendByName) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintName(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ToAddress) > 0 {
+		i -= len(m.ToAddress)
+		copy(dAtA[i:], m.ToAddress)
+		i = encodeVarintName(dAtA, i, uint64(len(m.ToAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintName(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FromAddress) > 0 {
+		i -= len(m.FromAddress)
+		copy(dAtA[i:], m.FromAddress)
+		i = encodeVarintName(dAtA, i, uint64(len(m.FromAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventAddressRotationApproved) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventAddressRotationApproved) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventAddressRotationApproved) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NewAddress) > 0 {
+		i -= len(m.NewAddress)
+		copy(dAtA[i:], m.NewAddress)
+		i = encodeVarintName(dAtA, i, uint64(len(m.NewAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OldAddress) > 0 {
+		i -= len(m.OldAddress)
+		copy(dAtA[i:], m.OldAddress)
+		i = encodeVarintName(dAtA, i, uint64(len(m.OldAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventAddressRotated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventAddressRotated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventAddressRotated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Names) > 0 {
+		for iNdEx := len(m.Names) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Names[iNdEx])
+			copy(dAtA[i:], m.Names[iNdEx])
+			i = encodeVarintName(dAtA, i, uint64(len(m.Names[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.NewAddress) > 0 {
+		i -= len(m.NewAddress)
+		copy(dAtA[i:], m.NewAddress)
+		i = encodeVarintName(dAtA, i, uint64(len(m.NewAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OldAddress) > 0 {
+		i -= len(m.OldAddress)
+		copy(dAtA[i:], m.OldAddress)
+		i = encodeVarintName(dAtA, i, uint64(len(m.OldAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventNameChildQuotaUpdated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventNameChildQuotaUpdated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventNameChildQuotaUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintName(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.MaxChildren) > 0 {
+		i -= len(m.MaxChildren)
+		copy(dAtA[i:], m.MaxChildren)
+		i = encodeVarintName(dAtA, i, uint64(len(m.MaxChildren)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintName(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventNamedAccountCreated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventNamedAccountCreated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventNamedAccountCreated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintName(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintName(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintName(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExtensionOptionResolveNames) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExtensionOptionResolveNames) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExtensionOptionResolveNames) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintName(dAtA []byte, offset int, v uint64) int {
+	offset -= sovName(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxSegmentLength != 0 {
+		n += 1 + sovName(uint64(m.MaxSegmentLength))
+	}
+	if m.MinSegmentLength != 0 {
+		n += 1 + sovName(uint64(m.MinSegmentLength))
+	}
+	if m.MaxNameLevels != 0 {
+		n += 1 + sovName(uint64(m.MaxNameLevels))
+	}
+	if m.AllowUnrestrictedNames {
+		n += 2
+	}
+	if m.MaxDeletions != 0 {
+		n += 1 + sovName(uint64(m.MaxDeletions))
+	}
+	if m.ContractMigratedNamePolicy != 0 {
+		n += 1 + sovName(uint64(m.ContractMigratedNamePolicy))
+	}
+	if m.ContractAdminClearedNamePolicy != 0 {
+		n += 1 + sovName(uint64(m.ContractAdminClearedNamePolicy))
+	}
+	if m.DeleteDelayBlocks != 0 {
+		n += 1 + sovName(uint64(m.DeleteDelayBlocks))
+	}
+	if m.ResolvePendingDeletions {
+		n += 2
+	}
+	if m.MaxQueryResults != 0 {
+		n += 1 + sovName(uint64(m.MaxQueryResults))
+	}
+	if m.MaxQueryResponseBytes != 0 {
+		n += 1 + sovName(uint64(m.MaxQueryResponseBytes))
+	}
+	if m.RestrictNewNames {
+		n += 2
+	}
+	if len(m.BindNameFee) > 0 {
+		for _, e := range m.BindNameFee {
+			l = e.Size()
+			n += 1 + l + sovName(uint64(l))
+		}
+	}
+	l = len(m.BindNameFeeRecipient)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	if len(m.RootNameParams) > 0 {
+		for _, e := range m.RootNameParams {
+			l = e.Size()
+			n += 1 + l + sovName(uint64(l))
+		}
+	}
+	if m.MaxUuidSegments != 0 {
+		n += 2 + sovName(uint64(m.MaxUuidSegments))
+	}
+	if m.CreatedNamesRetentionBlocks != 0 {
+		n += 2 + sovName(uint64(m.CreatedNamesRetentionBlocks))
+	}
+	return n
+}
+
+func (m *RootNameParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Root)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	if m.RestrictNewNames {
+		n += 2
+	}
+	if len(m.BindNameFee) > 0 {
+		for _, e := range m.BindNameFee {
+			l = e.Size()
+			n += 1 + l + sovName(uint64(l))
+		}
+	}
+	l = len(m.BindNameFeeRecipient)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	return n
+}
+
+func (m *NameRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	if m.Restricted {
+		n += 2
+	}
+	return n
+}
+
+func (m *PendingNameDeletion) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	if m.DeleteHeight != 0 {
+		n += 1 + sovName(uint64(m.DeleteHeight))
+	}
+	return n
+}
+
+func (m *AddressRotationApproval) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OldAddress)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	l = len(m.NewAddress)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	return n
+}
+
+func (m *ChildQuota) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	if m.MaxChildren != 0 {
+		n += 1 + sovName(uint64(m.MaxChildren))
+	}
+	return n
+}
+
+func (m *ParentApproval) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PubKey != nil {
+		l = m.PubKey.Size()
+		n += 1 + l + sovName(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Expiration)
+	n += 1 + l + sovName(uint64(l))
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	return n
+}
+
+func (m *ParentApprovalSignDoc) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	l = len(m.Requestor)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Expiration)
+	n += 1 + l + sovName(uint64(l))
+	return n
+}
+
+func (m *CreateRootNameProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	if m.Restricted {
+		n += 2
+	}
+	return n
+}
+
+func (m *EventNameBound) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	if m.Restricted {
+		n += 2
+	}
+	return n
+}
+
+func (m *EventNameUnbound) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	if m.Restricted {
+		n += 2
+	}
+	return n
+}
+
+func (m *EventNameUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	if m.Restricted {
+		n += 2
+	}
+	return n
+}
+
+func (m *EventNameParamsUpdated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AllowUnrestrictedNames)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	l = len(m.MaxNameLevels)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	l = len(m.MinSegmentLength)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	l = len(m.MaxSegmentLength)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	l = len(m.MaxDeletions)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	l = len(m.ContractMigratedNamePolicy)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	l = len(m.ContractAdminClearedNamePolicy)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	l = len(m.DeleteDelayBlocks)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	l = len(m.ResolvePendingDeletions)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	l = len(m.MaxQueryResults)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	l = len(m.MaxQueryResponseBytes)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	l = len(m.RestrictNewNames)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	l = len(m.BindNameFee)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	l = len(m.BindNameFeeRecipient)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	l = len(m.RootNameParams)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	return n
+}
+
+func (m *EventNamePendingDeletion) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	l = len(m.DeleteHeight)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	return n
+}
+
+func (m *EventContractNamePolicyApplied) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	l = len(m.Policy)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	if len(m.Names) > 0 {
+		for _, s := range m.Names {
+			l = len(s)
+			n += 1 + l + sovName(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventNameRemoved) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	if len(m.RemovedNames) > 0 {
+		for _, s := range m.RemovedNames {
+			l = len(s)
+			n += 1 + l + sovName(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventSendByName) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FromAddress)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	l = len(m.ToAddress)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	return n
+}
+
+func (m *EventAddressRotationApproved) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OldAddress)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	l = len(m.NewAddress)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	return n
+}
+
+func (m *EventAddressRotated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OldAddress)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	l = len(m.NewAddress)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	if len(m.Names) > 0 {
+		for _, s := range m.Names {
+			l = len(s)
+			n += 1 + l + sovName(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventNameChildQuotaUpdated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	l = len(m.MaxChildren)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	return n
+}
+
+func (m *EventNamedAccountCreated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	return n
+}
+
+func (m *ExtensionOptionResolveNames) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovName(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozName(x uint64) (n int) {
+	return sovName(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowName
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSegmentLength", wireType)
+			}
+			m.MaxSegmentLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxSegmentLength |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSegmentLength", wireType)
+			}
+			m.MinSegmentLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinSegmentLength |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxNameLevels", wireType)
+			}
+			m.MaxNameLevels = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxNameLevels |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowUnrestrictedNames", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllowUnrestrictedNames = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDeletions", wireType)
+			}
+			m.MaxDeletions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxDeletions |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractMigratedNamePolicy", wireType)
+			}
+			m.ContractMigratedNamePolicy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ContractMigratedNamePolicy |= ContractNamePolicy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAdminClearedNamePolicy", wireType)
+			}
+			m.ContractAdminClearedNamePolicy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ContractAdminClearedNamePolicy |= ContractNamePolicy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeleteDelayBlocks", wireType)
+			}
+			m.DeleteDelayBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DeleteDelayBlocks |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResolvePendingDeletions", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ResolvePendingDeletions = bool(v != 0)
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxQueryResults", wireType)
+			}
+			m.MaxQueryResults = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxQueryResults |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxQueryResponseBytes", wireType)
+			}
+			m.MaxQueryResponseBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxQueryResponseBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RestrictNewNames", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RestrictNewNames = bool(v != 0)
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BindNameFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BindNameFee = append(m.BindNameFee, types.Coin{})
+			if err := m.BindNameFee[len(m.BindNameFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BindNameFeeRecipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BindNameFeeRecipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RootNameParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RootNameParams = append(m.RootNameParams, RootNameParams{})
+			if err := m.RootNameParams[len(m.RootNameParams)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxUuidSegments", wireType)
+			}
+			m.MaxUuidSegments = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxUuidSegments |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedNamesRetentionBlocks", wireType)
+			}
+			m.CreatedNamesRetentionBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedNamesRetentionBlocks |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipName(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthName
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RootNameParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowName
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RootNameParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RootNameParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Root", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Root = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RestrictNewNames", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RestrictNewNames = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BindNameFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BindNameFee = append(m.BindNameFee, types.Coin{})
+			if err := m.BindNameFee[len(m.BindNameFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BindNameFeeRecipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BindNameFeeRecipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipName(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthName
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NameRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowName
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NameRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NameRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Restricted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Restricted = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipName(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthName
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PendingNameDeletion) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowName
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingNameDeletion: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingNameDeletion: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeleteHeight", wireType)
+			}
+			m.DeleteHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DeleteHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipName(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthName
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AddressRotationApproval) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowName
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AddressRotationApproval: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AddressRotationApproval: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OldAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipName(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthName
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChildQuota) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowName
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChildQuota: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChildQuota: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxChildren", wireType)
+			}
+			m.MaxChildren = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxChildren |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipName(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthName
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ParentApproval) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowName
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ParentApproval: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ParentApproval: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PubKey == nil {
+				m.PubKey = &types1.Any{}
+			}
+			if err := m.PubKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Expiration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signature == nil {
+				m.Signature = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipName(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthName
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ParentApprovalSignDoc) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowName
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ParentApprovalSignDoc: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ParentApprovalSignDoc: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Requestor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Requestor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Expiration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipName(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthName
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CreateRootNameProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowName
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreateRootNameProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreateRootNameProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Restricted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Restricted = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipName(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthName
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventNameBound) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowName
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventNameBound: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventNameBound: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Restricted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Restricted = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipName(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthName
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventNameUnbound) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowName
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventNameUnbound: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventNameUnbound: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Restricted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Restricted = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipName(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthName
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventNameUpdate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowName
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventNameUpdate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventNameUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Restricted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Restricted = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipName(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthName
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventNameParamsUpdated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowName
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventNameParamsUpdated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventNameParamsUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowUnrestrictedNames", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowUnrestrictedNames = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxNameLevels", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxNameLevels = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSegmentLength", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinSegmentLength = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSegmentLength", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxSegmentLength = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDeletions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxDeletions = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractMigratedNamePolicy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractMigratedNamePolicy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAdminClearedNamePolicy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAdminClearedNamePolicy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeleteDelayBlocks", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeleteDelayBlocks = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResolvePendingDeletions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ResolvePendingDeletions = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxQueryResults", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxQueryResults = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxQueryResponseBytes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxQueryResponseBytes = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RestrictNewNames", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RestrictNewNames = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BindNameFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BindNameFee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BindNameFeeRecipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BindNameFeeRecipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RootNameParams", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RootNameParams = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipName(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthName
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *EventNameUnbound) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovName(uint64(l))
-	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovName(uint64(l))
-	}
-	if m.Restricted {
-		n += 2
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
-
-func (m *EventNameUpdate) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovName(uint64(l))
-	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovName(uint64(l))
-	}
-	if m.Restricted {
-		n += 2
+func (m *EventNamePendingDeletion) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowName
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventNamePendingDeletion: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventNamePendingDeletion: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeleteHeight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeleteHeight = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipName(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthName
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *EventNameParamsUpdated) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.AllowUnrestrictedNames)
-	if l > 0 {
-		n += 1 + l + sovName(uint64(l))
-	}
-	l = len(m.MaxNameLevels)
-	if l > 0 {
-		n += 1 + l + sovName(uint64(l))
-	}
-	l = len(m.MinSegmentLength)
-	if l > 0 {
-		n += 1 + l + sovName(uint64(l))
-	}
-	l = len(m.MaxSegmentLength)
-	if l > 0 {
-		n += 1 + l + sovName(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovName(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozName(x uint64) (n int) {
-	return sovName(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *EventContractNamePolicyApplied) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1059,17 +6260,17 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventContractNamePolicyApplied: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventContractNamePolicyApplied: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxSegmentLength", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
 			}
-			m.MaxSegmentLength = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowName
@@ -1079,16 +6280,29 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxSegmentLength |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinSegmentLength", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
 			}
-			m.MinSegmentLength = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowName
@@ -1098,16 +6312,29 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MinSegmentLength |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxNameLevels", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
 			}
-			m.MaxNameLevels = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowName
@@ -1117,16 +6344,29 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxNameLevels |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Policy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllowUnrestrictedNames", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Names", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowName
@@ -1136,12 +6376,24 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.AllowUnrestrictedNames = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Names = append(m.Names, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipName(dAtA[iNdEx:])
@@ -1163,7 +6415,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NameRecord) Unmarshal(dAtA []byte) error {
+func (m *EventNameRemoved) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1186,10 +6438,10 @@ func (m *NameRecord) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NameRecord: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventNameRemoved: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NameRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventNameRemoved: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1257,10 +6509,10 @@ func (m *NameRecord) Unmarshal(dAtA []byte) error {
 			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Restricted", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemovedNames", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowName
@@ -1270,12 +6522,24 @@ func (m *NameRecord) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Restricted = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemovedNames = append(m.RemovedNames, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipName(dAtA[iNdEx:])
@@ -1297,7 +6561,7 @@ func (m *NameRecord) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CreateRootNameProposal) Unmarshal(dAtA []byte) error {
+func (m *EventSendByName) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1320,15 +6584,15 @@ func (m *CreateRootNameProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CreateRootNameProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventSendByName: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CreateRootNameProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventSendByName: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1356,11 +6620,11 @@ func (m *CreateRootNameProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Title = string(dAtA[iNdEx:postIndex])
+			m.FromAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1388,11 +6652,11 @@ func (m *CreateRootNameProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ToAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1420,11 +6684,11 @@ func (m *CreateRootNameProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.ToAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1452,28 +6716,8 @@ func (m *CreateRootNameProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			m.Amount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Restricted", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowName
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Restricted = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipName(dAtA[iNdEx:])
@@ -1495,7 +6739,7 @@ func (m *CreateRootNameProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventNameBound) Unmarshal(dAtA []byte) error {
+func (m *EventAddressRotationApproved) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1518,15 +6762,15 @@ func (m *EventNameBound) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventNameBound: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventAddressRotationApproved: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventNameBound: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventAddressRotationApproved: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OldAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1554,11 +6798,11 @@ func (m *EventNameBound) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.OldAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1586,28 +6830,8 @@ func (m *EventNameBound) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.NewAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Restricted", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowName
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Restricted = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipName(dAtA[iNdEx:])
@@ -1629,7 +6853,7 @@ func (m *EventNameBound) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventNameUnbound) Unmarshal(dAtA []byte) error {
+func (m *EventAddressRotated) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1652,15 +6876,15 @@ func (m *EventNameUnbound) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventNameUnbound: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventAddressRotated: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventNameUnbound: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventAddressRotated: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OldAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1688,11 +6912,11 @@ func (m *EventNameUnbound) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.OldAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1720,13 +6944,13 @@ func (m *EventNameUnbound) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.NewAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Restricted", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Names", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowName
@@ -1736,12 +6960,24 @@ func (m *EventNameUnbound) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Restricted = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Names = append(m.Names, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipName(dAtA[iNdEx:])
@@ -1763,7 +6999,7 @@ func (m *EventNameUnbound) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventNameUpdate) Unmarshal(dAtA []byte) error {
+func (m *EventNameChildQuotaUpdated) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1786,15 +7022,15 @@ func (m *EventNameUpdate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventNameUpdate: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventNameChildQuotaUpdated: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventNameUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventNameChildQuotaUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1822,11 +7058,11 @@ func (m *EventNameUpdate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxChildren", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1854,13 +7090,13 @@ func (m *EventNameUpdate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.MaxChildren = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Restricted", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowName
@@ -1870,12 +7106,24 @@ func (m *EventNameUpdate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Restricted = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipName(dAtA[iNdEx:])
@@ -1897,7 +7145,7 @@ func (m *EventNameUpdate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventNameParamsUpdated) Unmarshal(dAtA []byte) error {
+func (m *EventNamedAccountCreated) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1920,15 +7168,15 @@ func (m *EventNameParamsUpdated) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventNameParamsUpdated: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventNamedAccountCreated: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventNameParamsUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventNamedAccountCreated: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllowUnrestrictedNames", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1956,11 +7204,11 @@ func (m *EventNameParamsUpdated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AllowUnrestrictedNames = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxNameLevels", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1988,11 +7236,11 @@ func (m *EventNameParamsUpdated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MaxNameLevels = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinSegmentLength", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2020,40 +7268,58 @@ func (m *EventNameParamsUpdated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MinSegmentLength = string(dAtA[iNdEx:postIndex])
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxSegmentLength", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowName
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipName(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthName
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthName
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExtensionOptionResolveNames) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowName
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MaxSegmentLength = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExtensionOptionResolveNames: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExtensionOptionResolveNames: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipName(dAtA[iNdEx:])
@@ -0,0 +1,13 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+// GetNamedAccountAddress returns the address of the named account for the provided (normalized) name.
+// It is derived from the name module's address using the name as the derivation key, so anyone can
+// verify that the address belongs to the name, and no one has a private key for it.
+func GetNamedAccountAddress(name string) sdk.AccAddress {
+	return address.Module(ModuleName, []byte(name))
+}
@@ -0,0 +1,161 @@
+package types
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxOwnershipChallengeNonceLength is the maximum number of characters allowed in an ownership challenge nonce.
+const MaxOwnershipChallengeNonceLength = 256
+
+var _ cdctypes.UnpackInterfacesMessage = (*NameOwnershipProof)(nil)
+
+// NewNameOwnershipChallenge creates a new NameOwnershipChallenge for the name resolving to the address at the height.
+func NewNameOwnershipChallenge(name, address, chainID string, height int64, nonce string) NameOwnershipChallenge {
+	return NameOwnershipChallenge{
+		Name:    name,
+		Address: address,
+		ChainId: chainID,
+		Height:  height,
+		Nonce:   nonce,
+	}
+}
+
+// ValidateBasic checks that this challenge has all of its required fields.
+func (c NameOwnershipChallenge) ValidateBasic() error {
+	if len(c.Name) == 0 {
+		return errors.New("name cannot be empty")
+	}
+	if _, err := sdk.AccAddressFromBech32(c.Address); err != nil {
+		return fmt.Errorf("invalid address: %w", err)
+	}
+	if len(c.ChainId) == 0 {
+		return errors.New("chain id cannot be empty")
+	}
+	if c.Height <= 0 {
+		return fmt.Errorf("invalid height %d: must be positive", c.Height)
+	}
+	return ValidateOwnershipChallengeNonce(c.Nonce)
+}
+
+// ValidateOwnershipChallengeNonce returns an error if the provided nonce is too long.
+func ValidateOwnershipChallengeNonce(nonce string) error {
+	if len(nonce) > MaxOwnershipChallengeNonceLength {
+		return fmt.Errorf("nonce length %d exceeds maximum length of %d", len(nonce), MaxOwnershipChallengeNonceLength)
+	}
+	return nil
+}
+
+// adr036SignDoc is the legacy amino json sign doc of an ADR-036 MsgSignData with the fields in sorted order.
+// ADR-036 sign docs have an empty chain id, zero account number and sequence, and no fee, so they can't be a tx.
+type adr036SignDoc struct {
+	AccountNumber string           `json:"account_number"`
+	ChainID       string           `json:"chain_id"`
+	Fee           adr036Fee        `json:"fee"`
+	Memo          string           `json:"memo"`
+	Msgs          []adr036SignData `json:"msgs"`
+	Sequence      string           `json:"sequence"`
+}
+
+// adr036Fee is the empty fee of an ADR-036 sign doc.
+type adr036Fee struct {
+	Amount []struct{} `json:"amount"`
+	Gas    string     `json:"gas"`
+}
+
+// adr036SignData is the amino json of an ADR-036 MsgSignData.
+type adr036SignData struct {
+	Type  string `json:"type"`
+	Value struct {
+		Data   []byte `json:"data"`
+		Signer string `json:"signer"`
+	} `json:"value"`
+}
+
+// GetSignBytes returns the bytes that the name's owner signs to prove ownership.
+// They are an ADR-036 (arbitrary message) sign doc, signed using SIGN_MODE_LEGACY_AMINO_JSON, whose data is the
+// protobuf encoding of the challenge. That way a signature over them can never be used as a tx signature.
+func (c NameOwnershipChallenge) GetSignBytes() []byte {
+	bz, err := c.Marshal()
+	if err != nil {
+		panic(fmt.Errorf("could not marshal name ownership challenge: %w", err))
+	}
+	msg := adr036SignData{Type: "sign/MsgSignData"}
+	msg.Value.Data = bz
+	msg.Value.Signer = c.Address
+	doc := adr036SignDoc{
+		AccountNumber: "0",
+		Fee:           adr036Fee{Amount: []struct{}{}, Gas: "0"},
+		Msgs:          []adr036SignData{msg},
+		Sequence:      "0",
+	}
+	rv, err := json.Marshal(doc)
+	if err != nil {
+		panic(fmt.Errorf("could not marshal name ownership challenge sign doc: %w", err))
+	}
+	return rv
+}
+
+// VerifyNameOwnershipSignature checks that the signature is of the challenge's sign bytes and was made by the key of
+// the challenge's address. It does not check that the name still resolves to that address.
+func VerifyNameOwnershipSignature(challenge NameOwnershipChallenge, pubKey cryptotypes.PubKey, signature []byte) error {
+	if err := challenge.ValidateBasic(); err != nil {
+		return fmt.Errorf("invalid name ownership challenge: %w", err)
+	}
+	if pubKey == nil {
+		return errors.New("public key cannot be empty")
+	}
+	if signer := sdk.AccAddress(pubKey.Address()); signer.String() != challenge.Address {
+		return fmt.Errorf("name ownership signer %s is not the address %s of name %q", signer, challenge.Address, challenge.Name)
+	}
+	if !pubKey.VerifySignature(challenge.GetSignBytes(), signature) {
+		return fmt.Errorf("name ownership signature is not valid for name %q", challenge.Name)
+	}
+	return nil
+}
+
+// NewNameOwnershipProof creates a new NameOwnershipProof of the challenge with the owner's public key and signature.
+func NewNameOwnershipProof(challenge NameOwnershipChallenge, pubKey cryptotypes.PubKey, signature []byte) (*NameOwnershipProof, error) {
+	pkAny, err := cdctypes.NewAnyWithValue(pubKey)
+	if err != nil {
+		return nil, err
+	}
+	return &NameOwnershipProof{
+		Challenge: challenge,
+		PubKey:    pkAny,
+		Signature: signature,
+	}, nil
+}
+
+// UnpackPubKey returns the name owner's public key, or an error if it isn't one.
+func (p NameOwnershipProof) UnpackPubKey() (cryptotypes.PubKey, error) {
+	if p.PubKey == nil {
+		return nil, errors.New("public key cannot be empty")
+	}
+	pubKey, ok := p.PubKey.GetCachedValue().(cryptotypes.PubKey)
+	if !ok {
+		return nil, fmt.Errorf("unsupported public key type %q", p.PubKey.TypeUrl)
+	}
+	return pubKey, nil
+}
+
+// Verify checks that this proof's signature is of its challenge and was made by the key of the challenge's address.
+// It does not check that the name still resolves to that address.
+func (p NameOwnershipProof) Verify() error {
+	pubKey, err := p.UnpackPubKey()
+	if err != nil {
+		return err
+	}
+	return VerifyNameOwnershipSignature(p.Challenge, pubKey, p.Signature)
+}
+
+// UnpackInterfaces implements cdctypes.UnpackInterfacesMessage
+func (p NameOwnershipProof) UnpackInterfaces(unpacker cdctypes.AnyUnpacker) error {
+	var pubKey cryptotypes.PubKey
+	return unpacker.UnpackAny(p.PubKey, &pubKey)
+}
@@ -0,0 +1,137 @@
+package types
+
+import (
+	"encoding/base64"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestNameOwnershipChallengeValidateBasic(t *testing.T) {
+	addr := sdk.AccAddress("owner_______________").String()
+	tests := []struct {
+		name      string
+		challenge NameOwnershipChallenge
+		expErr    string
+	}{
+		{
+			name:      "valid",
+			challenge: NewNameOwnershipChallenge("example.pb", addr, "test-chain", 5, "abc"),
+		},
+		{
+			name:      "no name",
+			challenge: NewNameOwnershipChallenge("", addr, "test-chain", 5, ""),
+			expErr:    "name cannot be empty",
+		},
+		{
+			name:      "bad address",
+			challenge: NewNameOwnershipChallenge("example.pb", "bad", "test-chain", 5, ""),
+			expErr:    "invalid address: decoding bech32 failed: invalid bech32 string length 3",
+		},
+		{
+			name:      "no chain id",
+			challenge: NewNameOwnershipChallenge("example.pb", addr, "", 5, ""),
+			expErr:    "chain id cannot be empty",
+		},
+		{
+			name:      "zero height",
+			challenge: NewNameOwnershipChallenge("example.pb", addr, "test-chain", 0, ""),
+			expErr:    "invalid height 0: must be positive",
+		},
+		{
+			name:      "nonce too long",
+			challenge: NewNameOwnershipChallenge("example.pb", addr, "test-chain", 5, strings.Repeat("n", MaxOwnershipChallengeNonceLength+1)),
+			expErr:    "nonce length 257 exceeds maximum length of 256",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.challenge.ValidateBasic()
+			if len(tc.expErr) > 0 {
+				assert.EqualError(t, err, tc.expErr, "ValidateBasic")
+			} else {
+				assert.NoError(t, err, "ValidateBasic")
+			}
+		})
+	}
+}
+
+func TestNameOwnershipChallengeGetSignBytes(t *testing.T) {
+	addr := sdk.AccAddress("addr________________").String()
+	challenge := NewNameOwnershipChallenge("example.pb", addr, "test-chain", 5, "abc")
+	bz, err := challenge.Marshal()
+	require.NoError(t, err, "Marshal")
+
+	exp := `{"account_number":"0","chain_id":"","fee":{"amount":[],"gas":"0"},"memo":"",` +
+		`"msgs":[{"type":"sign/MsgSignData","value":{"data":"` + base64.StdEncoding.EncodeToString(bz) + `","signer":"` + addr + `"}}],` +
+		`"sequence":"0"}`
+	assert.Equal(t, exp, string(challenge.GetSignBytes()), "GetSignBytes")
+}
+
+func TestNameOwnershipProofVerify(t *testing.T) {
+	ownerKey := secp256k1.GenPrivKey()
+	owner := sdk.AccAddress(ownerKey.PubKey().Address()).String()
+	otherKey := secp256k1.GenPrivKey()
+	challenge := NewNameOwnershipChallenge("example.pb", owner, "test-chain", 5, "abc")
+
+	sign := func(t *testing.T, c NameOwnershipChallenge) []byte {
+		sig, err := ownerKey.Sign(c.GetSignBytes())
+		require.NoError(t, err, "Sign")
+		return sig
+	}
+
+	t.Run("valid", func(t *testing.T) {
+		proof, err := NewNameOwnershipProof(challenge, ownerKey.PubKey(), sign(t, challenge))
+		require.NoError(t, err, "NewNameOwnershipProof")
+		assert.NoError(t, proof.Verify(), "Verify")
+	})
+
+	t.Run("signed by a different key", func(t *testing.T) {
+		sig, err := otherKey.Sign(challenge.GetSignBytes())
+		require.NoError(t, err, "Sign")
+		err = VerifyNameOwnershipSignature(challenge, otherKey.PubKey(), sig)
+		assert.EqualError(t, err, "name ownership signer "+sdk.AccAddress(otherKey.PubKey().Address()).String()+
+			" is not the address "+owner+` of name "example.pb"`, "VerifyNameOwnershipSignature")
+	})
+
+	t.Run("signature of a different challenge", func(t *testing.T) {
+		other := challenge
+		other.Height = 6
+		err := VerifyNameOwnershipSignature(challenge, ownerKey.PubKey(), sign(t, other))
+		assert.EqualError(t, err, `name ownership signature is not valid for name "example.pb"`, "VerifyNameOwnershipSignature")
+	})
+
+	t.Run("different chain", func(t *testing.T) {
+		other := challenge
+		other.ChainId = "other-chain"
+		err := VerifyNameOwnershipSignature(other, ownerKey.PubKey(), sign(t, challenge))
+		assert.EqualError(t, err, `name ownership signature is not valid for name "example.pb"`, "VerifyNameOwnershipSignature")
+	})
+
+	t.Run("no public key", func(t *testing.T) {
+		proof := NameOwnershipProof{Challenge: challenge, Signature: sign(t, challenge)}
+		assert.EqualError(t, proof.Verify(), "public key cannot be empty", "Verify")
+	})
+
+	t.Run("signature of the raw challenge", func(t *testing.T) {
+		bz, err := challenge.Marshal()
+		require.NoError(t, err, "Marshal")
+		sig, err := ownerKey.Sign(bz)
+		require.NoError(t, err, "Sign")
+		err = VerifyNameOwnershipSignature(challenge, ownerKey.PubKey(), sig)
+		assert.EqualError(t, err, `name ownership signature is not valid for name "example.pb"`, "VerifyNameOwnershipSignature")
+	})
+
+	t.Run("invalid challenge", func(t *testing.T) {
+		other := challenge
+		other.Name = ""
+		err := VerifyNameOwnershipSignature(other, ownerKey.PubKey(), sign(t, other))
+		assert.EqualError(t, err, "invalid name ownership challenge: name cannot be empty", "VerifyNameOwnershipSignature")
+	})
+}
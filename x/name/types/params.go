@@ -1,10 +1,18 @@
 package types
 
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 const (
 	DefaultMinSegmentLength       = uint32(2)
 	DefaultMaxSegmentLength       = uint32(32)
 	DefaultMaxNameLevels          = uint32(16)
 	DefaultAllowUnrestrictedNames = true
+	DefaultMaxDeletions           = uint32(100)
 )
 
 // NewParams creates a new parameter object
@@ -13,12 +21,14 @@ func NewParams(
 	minSegmentLength uint32,
 	maxNameLevels uint32,
 	allowUnrestrictedNames bool,
+	maxDeletions uint32,
 ) Params {
 	return Params{
 		MaxSegmentLength:       maxSegmentLength,
 		MinSegmentLength:       minSegmentLength,
 		MaxNameLevels:          maxNameLevels,
 		AllowUnrestrictedNames: allowUnrestrictedNames,
+		MaxDeletions:           maxDeletions,
 	}
 }
 
@@ -29,9 +39,193 @@ func DefaultParams() Params {
 		DefaultMinSegmentLength,
 		DefaultMaxNameLevels,
 		DefaultAllowUnrestrictedNames,
+		DefaultMaxDeletions,
 	)
 }
 
+// ValidateName returns an error if the segments of the provided normalized name do not satisfy these params.
+func (p Params) ValidateName(name string) error {
+	segCount := uint32(0)
+	uuidCount := uint32(0)
+	for _, segment := range strings.Split(name, ".") {
+		segCount++
+		segLen := len(segment)
+		isUUID := IsValidUUID(segment)
+		if isUUID {
+			uuidCount++
+		}
+		if segLen < int(p.MinSegmentLength) {
+			return ErrNameSegmentTooShort
+		}
+		if segLen > int(p.MaxSegmentLength) && !isUUID {
+			return ErrNameSegmentTooLong
+		}
+	}
+	if segCount > p.MaxNameLevels {
+		return ErrNameHasTooManySegments
+	}
+	if p.MaxUuidSegments > 0 && uuidCount > p.MaxUuidSegments {
+		return ErrNameHasTooManyUUIDSegments
+	}
+	return nil
+}
+
+// NameViolations returns all the naming rules that the provided normalized name does not satisfy.
+// Unlike ValidateName, it considers the allowed characters too, and doesn't stop at the first problem.
+// An empty result means the name is valid.
+func (p Params) NameViolations(name string) []NameViolation {
+	var rv []NameViolation
+	segments := strings.Split(name, ".")
+	uuidCount := uint32(0)
+	pos := uint32(0)
+	for i, segment := range segments {
+		segI := uint32(i)
+		isUUID := IsValidUUID(segment)
+		if isUUID {
+			uuidCount++
+		}
+		segLen := len(segment)
+		if segLen < int(p.MinSegmentLength) {
+			rv = append(rv, NameViolation{
+				Type:    NameViolationSegmentTooShort,
+				Segment: segI,
+				Message: fmt.Sprintf("segment %d %q is too short: %d < %d", i, segment, segLen, p.MinSegmentLength),
+			})
+		}
+		if segLen > int(p.MaxSegmentLength) && !isUUID {
+			rv = append(rv, NameViolation{
+				Type:    NameViolationSegmentTooLong,
+				Segment: segI,
+				Message: fmt.Sprintf("segment %d %q is too long: %d > %d", i, segment, segLen, p.MaxSegmentLength),
+			})
+		}
+		if !isUUID {
+			if dashes := strings.Count(segment, "-"); dashes > 1 {
+				rv = append(rv, NameViolation{
+					Type:    NameViolationTooManyDashes,
+					Segment: segI,
+					Message: fmt.Sprintf("segment %d %q has too many dashes: %d > 1", i, segment, dashes),
+				})
+			}
+		}
+		for _, c := range segment {
+			if !isUUID && !isValidNameSegmentChar(c) {
+				rv = append(rv, NameViolation{
+					Type:     NameViolationInvalidCharacter,
+					Segment:  segI,
+					Position: pos,
+					Message:  fmt.Sprintf("illegal character %q at position %d in segment %d %q", string(c), pos, i, segment),
+				})
+			}
+			pos++
+		}
+		pos++ // For the "." after this segment.
+	}
+	if segCount := uint32(len(segments)); segCount > p.MaxNameLevels {
+		rv = append(rv, NameViolation{
+			Type:    NameViolationTooManyLevels,
+			Message: fmt.Sprintf("name has too many segments: %d > %d", segCount, p.MaxNameLevels),
+		})
+	}
+	if p.MaxUuidSegments > 0 && uuidCount > p.MaxUuidSegments {
+		rv = append(rv, NameViolation{
+			Type:    NameViolationTooManyUUIDSegments,
+			Message: fmt.Sprintf("name has too many uuid segments: %d > %d", uuidCount, p.MaxUuidSegments),
+		})
+	}
+	return rv
+}
+
+// ValidateContractNamePolicies returns an error if either of the contract name policies is unknown.
+func (p Params) ValidateContractNamePolicies() error {
+	if err := p.ContractMigratedNamePolicy.Validate(); err != nil {
+		return fmt.Errorf("invalid contract migrated name policy: %w", err)
+	}
+	if err := p.ContractAdminClearedNamePolicy.Validate(); err != nil {
+		return fmt.Errorf("invalid contract admin cleared name policy: %w", err)
+	}
+	return nil
+}
+
+// ValidateBindingParams returns an error if the bind name fees, their recipients, or the root name params are invalid.
+func (p Params) ValidateBindingParams() error {
+	if err := p.GetBindingParams("").Validate(); err != nil {
+		return err
+	}
+	seen := make(map[string]bool, len(p.RootNameParams))
+	for _, rootParams := range p.RootNameParams {
+		if len(rootParams.Root) == 0 {
+			return fmt.Errorf("root name params root cannot be empty")
+		}
+		if err := rootParams.Validate(); err != nil {
+			return err
+		}
+		if seen[rootParams.Root] {
+			return fmt.Errorf("duplicate root name params for %q", rootParams.Root)
+		}
+		seen[rootParams.Root] = true
+	}
+	return nil
+}
+
+// GetBindingParams returns the binding params for names under the provided root name.
+// If the root doesn't have its own params, the module-wide values are returned.
+func (p Params) GetBindingParams(root string) RootNameParams {
+	for _, rootParams := range p.RootNameParams {
+		if rootParams.Root == root {
+			return rootParams
+		}
+	}
+	return RootNameParams{
+		Root:                 root,
+		RestrictNewNames:     p.RestrictNewNames,
+		BindNameFee:          p.BindNameFee,
+		BindNameFeeRecipient: p.BindNameFeeRecipient,
+	}
+}
+
+// Validate returns an error if the root name params are invalid.
+// An empty root is allowed so that the module-wide binding params can be validated too.
+func (p RootNameParams) Validate() error {
+	if p.Root != strings.ToLower(strings.TrimSpace(p.Root)) || strings.Contains(p.Root, ".") {
+		return fmt.Errorf("invalid root name params root %q: must be a single normalized name segment", p.Root)
+	}
+	if err := p.BindNameFee.Validate(); err != nil {
+		return fmt.Errorf("invalid bind name fee for root %q: %w", p.Root, err)
+	}
+	if len(p.BindNameFeeRecipient) > 0 {
+		if _, err := sdk.AccAddressFromBech32(p.BindNameFeeRecipient); err != nil {
+			return fmt.Errorf("invalid bind name fee recipient for root %q: %w", p.Root, err)
+		}
+	}
+	return nil
+}
+
+// Validate returns an error if this is not a known ContractNamePolicy.
+func (p ContractNamePolicy) Validate() error {
+	if _, ok := ContractNamePolicy_name[int32(p)]; !ok {
+		return fmt.Errorf("unknown contract name policy %d", p)
+	}
+	return nil
+}
+
+// ParseContractNamePolicy converts a string into a ContractNamePolicy.
+// Both the full enum name (e.g. "CONTRACT_NAME_POLICY_DELETE") and the short form (e.g. "delete") are accepted.
+// The short form of CONTRACT_NAME_POLICY_UNSPECIFIED is "keep".
+func ParseContractNamePolicy(str string) (ContractNamePolicy, error) {
+	val := strings.ToUpper(strings.ReplaceAll(strings.TrimSpace(str), "-", "_"))
+	if val == "KEEP" {
+		return ContractNamePolicyKeep, nil
+	}
+	if !strings.HasPrefix(val, "CONTRACT_NAME_POLICY_") {
+		val = "CONTRACT_NAME_POLICY_" + val
+	}
+	if policy, ok := ContractNamePolicy_value[val]; ok {
+		return ContractNamePolicy(policy), nil
+	}
+	return ContractNamePolicyKeep, fmt.Errorf("unknown contract name policy %q", str)
+}
+
 // Equal returns true if the given value is equivalent to the current instance of params
 func (p *Params) Equal(that interface{}) bool {
 	if that == nil {
@@ -64,6 +258,92 @@ func (p *Params) Equal(that interface{}) bool {
 	if p.MinSegmentLength != that1.MinSegmentLength {
 		return false
 	}
+	if p.MaxDeletions != that1.MaxDeletions {
+		return false
+	}
+	if p.ContractMigratedNamePolicy != that1.ContractMigratedNamePolicy {
+		return false
+	}
+	if p.ContractAdminClearedNamePolicy != that1.ContractAdminClearedNamePolicy {
+		return false
+	}
+	if p.DeleteDelayBlocks != that1.DeleteDelayBlocks {
+		return false
+	}
+	if p.ResolvePendingDeletions != that1.ResolvePendingDeletions {
+		return false
+	}
+	if p.MaxQueryResults != that1.MaxQueryResults {
+		return false
+	}
+	if p.MaxQueryResponseBytes != that1.MaxQueryResponseBytes {
+		return false
+	}
+	if p.RestrictNewNames != that1.RestrictNewNames {
+		return false
+	}
+	if !p.BindNameFee.Equal(that1.BindNameFee) {
+		return false
+	}
+	if p.BindNameFeeRecipient != that1.BindNameFeeRecipient {
+		return false
+	}
+	if len(p.RootNameParams) != len(that1.RootNameParams) {
+		return false
+	}
+	for i := range p.RootNameParams {
+		if !p.RootNameParams[i].Equal(that1.RootNameParams[i]) {
+			return false
+		}
+	}
+	if p.MaxUuidSegments != that1.MaxUuidSegments {
+		return false
+	}
+	if p.CreatedNamesRetentionBlocks != that1.CreatedNamesRetentionBlocks {
+		return false
+	}
 
 	return true
 }
+
+// Equal returns true if the given root name params are equivalent to these.
+func (p RootNameParams) Equal(that RootNameParams) bool {
+	return p.Root == that.Root &&
+		p.RestrictNewNames == that.RestrictNewNames &&
+		p.BindNameFee.Equal(that.BindNameFee) &&
+		p.BindNameFeeRecipient == that.BindNameFeeRecipient
+}
+
+const (
+	// FeatureUnrestrictedNames is the feature name used when unrestricted names are allowed.
+	FeatureUnrestrictedNames = "unrestricted-names"
+	// FeatureRestrictedNewNames is the feature name used when newly bound names are always restricted.
+	FeatureRestrictedNewNames = "restricted-new-names"
+	// FeatureDelayedDeletions is the feature name used when name deletions are delayed.
+	FeatureDelayedDeletions = "delayed-deletions"
+	// FeatureBindNameFee is the feature name used when a fee is charged to bind a name.
+	FeatureBindNameFee = "bind-name-fee"
+	// FeatureCreatedNamesIndex is the feature name used when recently bound names are indexed.
+	FeatureCreatedNamesIndex = "created-names-index"
+)
+
+// Features returns the names of the optional name features that are enabled by these params.
+func (p Params) Features() []string {
+	var rv []string
+	if p.AllowUnrestrictedNames {
+		rv = append(rv, FeatureUnrestrictedNames)
+	}
+	if p.RestrictNewNames {
+		rv = append(rv, FeatureRestrictedNewNames)
+	}
+	if p.DeleteDelayBlocks > 0 {
+		rv = append(rv, FeatureDelayedDeletions)
+	}
+	if !p.BindNameFee.IsZero() {
+		rv = append(rv, FeatureBindNameFee)
+	}
+	if p.CreatedNamesRetentionBlocks > 0 {
+		rv = append(rv, FeatureCreatedNamesIndex)
+	}
+	return rv
+}
@@ -15,11 +15,20 @@ func TestDefaultParams(t *testing.T) {
 	require.Equal(t, DefaultMaxNameLevels, p.MaxNameLevels)
 	require.Equal(t, DefaultAllowUnrestrictedNames, p.AllowUnrestrictedNames)
 
-	require.True(t, p.Equal(NewParams(DefaultMaxSegmentLength, DefaultMinSegmentLength, DefaultMaxNameLevels, DefaultAllowUnrestrictedNames)))
-	require.False(t, p.Equal(NewParams(1, DefaultMinSegmentLength, DefaultMaxNameLevels, DefaultAllowUnrestrictedNames)))
-	require.False(t, p.Equal(NewParams(DefaultMaxSegmentLength, 1, DefaultMaxNameLevels, DefaultAllowUnrestrictedNames)))
-	require.False(t, p.Equal(NewParams(DefaultMaxSegmentLength, DefaultMinSegmentLength, 1, DefaultAllowUnrestrictedNames)))
-	require.False(t, p.Equal(NewParams(DefaultMaxSegmentLength, DefaultMinSegmentLength, DefaultMaxNameLevels, false)))
+	require.True(t, p.Equal(NewParams(DefaultMaxSegmentLength, DefaultMinSegmentLength, DefaultMaxNameLevels, DefaultAllowUnrestrictedNames, DefaultMaxDeletions)))
+	require.False(t, p.Equal(NewParams(1, DefaultMinSegmentLength, DefaultMaxNameLevels, DefaultAllowUnrestrictedNames, DefaultMaxDeletions)))
+	require.False(t, p.Equal(NewParams(DefaultMaxSegmentLength, 1, DefaultMaxNameLevels, DefaultAllowUnrestrictedNames, DefaultMaxDeletions)))
+	require.False(t, p.Equal(NewParams(DefaultMaxSegmentLength, DefaultMinSegmentLength, 1, DefaultAllowUnrestrictedNames, DefaultMaxDeletions)))
+	require.False(t, p.Equal(NewParams(DefaultMaxSegmentLength, DefaultMinSegmentLength, DefaultMaxNameLevels, false, DefaultMaxDeletions)))
+	p3 := DefaultParams()
+	p3.ContractMigratedNamePolicy = ContractNamePolicyDelete
+	require.False(t, p.Equal(p3))
+	p3 = DefaultParams()
+	p3.ContractAdminClearedNamePolicy = ContractNamePolicyReassignToAdmin
+	require.False(t, p.Equal(p3))
+	p3 = DefaultParams()
+	p3.MaxUuidSegments = 1
+	require.False(t, p.Equal(p3))
 
 	var p2 *Params
 	require.True(t, p2.Equal(nil))
@@ -28,5 +37,188 @@ func TestDefaultParams(t *testing.T) {
 
 func TestParamString(t *testing.T) {
 	p := DefaultParams()
-	require.Equal(t, `max_segment_length:32 min_segment_length:2 max_name_levels:16 allow_unrestricted_names:true `, p.String())
+	require.Equal(t, `max_segment_length:32 min_segment_length:2 max_name_levels:16 allow_unrestricted_names:true max_deletions:100 `, p.String())
+}
+
+func TestParamsValidateName(t *testing.T) {
+	p := NewParams(5, 2, 3, true, DefaultMaxDeletions)
+	tests := []struct {
+		name   string
+		expErr error
+	}{
+		{name: "ab"},
+		{name: "abcde.ab.pb"},
+		{name: "6b4d3f6b-a7c5-4bfc-8a64-7e8a5b6f6c31.pb"},
+		{name: "a.pb", expErr: ErrNameSegmentTooShort},
+		{name: "abcdef.pb", expErr: ErrNameSegmentTooLong},
+		{name: "ab.cd.ef.pb", expErr: ErrNameHasTooManySegments},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := p.ValidateName(tc.name)
+			require.ErrorIs(t, err, tc.expErr, "ValidateName(%q)", tc.name)
+		})
+	}
+}
+
+func TestParamsValidateNameMaxUUIDSegments(t *testing.T) {
+	const id1 = "6b4d3f6b-a7c5-4bfc-8a64-7e8a5b6f6c31"
+	const id2 = "91978ba2-5f35-459a-86a7-feca1b0512e0"
+	const id3 = "2f1b4e8c-3d6a-4b7e-9c0f-1a2b3c4d5e6f"
+	tests := []struct {
+		name     string
+		maxUUIDs uint32
+		expErr   error
+	}{
+		{name: id1 + "." + id2 + "." + id3 + ".pb", maxUUIDs: 0},
+		{name: "ab.pb", maxUUIDs: 1},
+		{name: id1 + ".pb", maxUUIDs: 1},
+		{name: id1 + ".ab." + id2 + ".pb", maxUUIDs: 1, expErr: ErrNameHasTooManyUUIDSegments},
+		{name: id1 + "." + id2 + ".pb", maxUUIDs: 2},
+		{name: id1 + "." + id2 + "." + id3 + ".pb", maxUUIDs: 2, expErr: ErrNameHasTooManyUUIDSegments},
+		{name: id1 + "." + id1 + "." + id1 + ".pb", maxUUIDs: 2, expErr: ErrNameHasTooManyUUIDSegments},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			p := NewParams(5, 2, 16, true, DefaultMaxDeletions)
+			p.MaxUuidSegments = tc.maxUUIDs
+			err := p.ValidateName(tc.name)
+			require.ErrorIs(t, err, tc.expErr, "ValidateName(%q) with MaxUuidSegments = %d", tc.name, tc.maxUUIDs)
+		})
+	}
+}
+
+func TestParamsNameViolations(t *testing.T) {
+	p := NewParams(5, 2, 3, true, DefaultMaxDeletions)
+	p.MaxUuidSegments = 1
+	uuid1 := "6b4d3f6b-a7c5-4bfc-8a64-7e8a5b6f6c31"
+	uuid2 := "91978ba2-5f35-459a-86a7-feca1b0512e0"
+	tests := []struct {
+		name string
+		exp  []NameViolation
+	}{
+		{name: "ab"},
+		{name: "abcde.a-b.pb"},
+		{name: uuid1 + ".pb"},
+		{
+			name: "a.pb",
+			exp: []NameViolation{{
+				Type:    NameViolationSegmentTooShort,
+				Segment: 0,
+				Message: `segment 0 "a" is too short: 1 < 2`,
+			}},
+		},
+		{
+			name: "ab.abcdef",
+			exp: []NameViolation{{
+				Type:    NameViolationSegmentTooLong,
+				Segment: 1,
+				Message: `segment 1 "abcdef" is too long: 6 > 5`,
+			}},
+		},
+		{
+			name: "ab.a-b-c",
+			exp: []NameViolation{{
+				Type:    NameViolationTooManyDashes,
+				Segment: 1,
+				Message: `segment 1 "a-b-c" has too many dashes: 2 > 1`,
+			}},
+		},
+		{
+			name: "ab.c_d!",
+			exp: []NameViolation{
+				{
+					Type:     NameViolationInvalidCharacter,
+					Segment:  1,
+					Position: 4,
+					Message:  `illegal character "_" at position 4 in segment 1 "c_d!"`,
+				},
+				{
+					Type:     NameViolationInvalidCharacter,
+					Segment:  1,
+					Position: 6,
+					Message:  `illegal character "!" at position 6 in segment 1 "c_d!"`,
+				},
+			},
+		},
+		{
+			name: "ab.cd.ef.pb",
+			exp: []NameViolation{{
+				Type:    NameViolationTooManyLevels,
+				Message: "name has too many segments: 4 > 3",
+			}},
+		},
+		{
+			name: uuid1 + "." + uuid2,
+			exp: []NameViolation{{
+				Type:    NameViolationTooManyUUIDSegments,
+				Message: "name has too many uuid segments: 2 > 1",
+			}},
+		},
+		{
+			name: "x..ab.c$-d-e.pb",
+			exp: []NameViolation{
+				{Type: NameViolationSegmentTooShort, Segment: 0, Message: `segment 0 "x" is too short: 1 < 2`},
+				{Type: NameViolationSegmentTooShort, Segment: 1, Message: `segment 1 "" is too short: 0 < 2`},
+				{Type: NameViolationSegmentTooLong, Segment: 3, Message: `segment 3 "c$-d-e" is too long: 6 > 5`},
+				{Type: NameViolationTooManyDashes, Segment: 3, Message: `segment 3 "c$-d-e" has too many dashes: 2 > 1`},
+				{Type: NameViolationInvalidCharacter, Segment: 3, Position: 7, Message: `illegal character "$" at position 7 in segment 3 "c$-d-e"`},
+				{Type: NameViolationTooManyLevels, Message: "name has too many segments: 5 > 3"},
+			},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			actual := p.NameViolations(tc.name)
+			if len(tc.exp) == 0 {
+				require.Empty(t, actual, "NameViolations(%q)", tc.name)
+				return
+			}
+			require.Equal(t, tc.exp, actual, "NameViolations(%q)", tc.name)
+		})
+	}
+}
+
+func TestParseContractNamePolicy(t *testing.T) {
+	tests := []struct {
+		str    string
+		exp    ContractNamePolicy
+		expErr string
+	}{
+		{str: "keep", exp: ContractNamePolicyKeep},
+		{str: "CONTRACT_NAME_POLICY_UNSPECIFIED", exp: ContractNamePolicyKeep},
+		{str: "reassign-to-admin", exp: ContractNamePolicyReassignToAdmin},
+		{str: "REASSIGN_TO_ADMIN", exp: ContractNamePolicyReassignToAdmin},
+		{str: " Delete ", exp: ContractNamePolicyDelete},
+		{str: "CONTRACT_NAME_POLICY_DELETE", exp: ContractNamePolicyDelete},
+		{str: "", expErr: `unknown contract name policy ""`},
+		{str: "burn", expErr: `unknown contract name policy "burn"`},
+	}
+	for _, tc := range tests {
+		t.Run(tc.str, func(t *testing.T) {
+			policy, err := ParseContractNamePolicy(tc.str)
+			if len(tc.expErr) > 0 {
+				require.EqualError(t, err, tc.expErr, "ParseContractNamePolicy(%q) error", tc.str)
+			} else {
+				require.NoError(t, err, "ParseContractNamePolicy(%q) error", tc.str)
+			}
+			require.Equal(t, tc.exp, policy, "ParseContractNamePolicy(%q) result", tc.str)
+		})
+	}
+}
+
+func TestParamsValidateContractNamePolicies(t *testing.T) {
+	p := DefaultParams()
+	require.NoError(t, p.ValidateContractNamePolicies(), "default params")
+
+	p.ContractMigratedNamePolicy = ContractNamePolicyDelete
+	p.ContractAdminClearedNamePolicy = ContractNamePolicyReassignToAdmin
+	require.NoError(t, p.ValidateContractNamePolicies(), "known policies")
+
+	p.ContractMigratedNamePolicy = 5
+	require.EqualError(t, p.ValidateContractNamePolicies(), "invalid contract migrated name policy: unknown contract name policy 5")
+
+	p.ContractMigratedNamePolicy = ContractNamePolicyKeep
+	p.ContractAdminClearedNamePolicy = -1
+	require.EqualError(t, p.ValidateContractNamePolicies(), "invalid contract admin cleared name policy: unknown contract name policy -1")
 }
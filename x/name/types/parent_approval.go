@@ -0,0 +1,76 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+)
+
+var _ cdctypes.UnpackInterfacesMessage = (*ParentApproval)(nil)
+
+// NewParentApprovalSignDoc creates a new ParentApprovalSignDoc for binding the (full) name for the requestor.
+func NewParentApprovalSignDoc(chainID, name, requestor string, expiration time.Time) *ParentApprovalSignDoc {
+	return &ParentApprovalSignDoc{
+		ChainId:    chainID,
+		Name:       name,
+		Requestor:  requestor,
+		Expiration: expiration,
+	}
+}
+
+// GetSignBytes returns the bytes that the parent name's owner signs to approve the binding.
+func (d ParentApprovalSignDoc) GetSignBytes() []byte {
+	bz, err := d.Marshal()
+	if err != nil {
+		panic(fmt.Errorf("could not marshal parent approval sign doc: %w", err))
+	}
+	return bz
+}
+
+// NewParentApproval creates a new ParentApproval with the provided parent owner's public key and signature.
+func NewParentApproval(pubKey cryptotypes.PubKey, expiration time.Time, signature []byte) (*ParentApproval, error) {
+	pkAny, err := cdctypes.NewAnyWithValue(pubKey)
+	if err != nil {
+		return nil, err
+	}
+	return &ParentApproval{
+		PubKey:     pkAny,
+		Expiration: expiration,
+		Signature:  signature,
+	}, nil
+}
+
+// ValidateBasic checks that this parent approval has all of its fields.
+func (a ParentApproval) ValidateBasic() error {
+	if a.PubKey == nil {
+		return errors.New("public key cannot be empty")
+	}
+	if a.Expiration.IsZero() {
+		return errors.New("expiration cannot be empty")
+	}
+	if len(a.Signature) == 0 {
+		return errors.New("signature cannot be empty")
+	}
+	return nil
+}
+
+// UnpackPubKey returns the parent name owner's public key, or an error if it isn't one.
+func (a ParentApproval) UnpackPubKey() (cryptotypes.PubKey, error) {
+	if a.PubKey == nil {
+		return nil, errors.New("public key cannot be empty")
+	}
+	pubKey, ok := a.PubKey.GetCachedValue().(cryptotypes.PubKey)
+	if !ok {
+		return nil, fmt.Errorf("unsupported public key type %q", a.PubKey.TypeUrl)
+	}
+	return pubKey, nil
+}
+
+// UnpackInterfaces implements cdctypes.UnpackInterfacesMessage
+func (a ParentApproval) UnpackInterfaces(unpacker cdctypes.AnyUnpacker) error {
+	var pubKey cryptotypes.PubKey
+	return unpacker.UnpackAny(a.PubKey, &pubKey)
+}
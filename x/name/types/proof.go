@@ -0,0 +1,30 @@
+package types
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/provenance-io/provenance/internal/provutils"
+)
+
+// Verify checks that the proof shows the name record (or its absence) in the state committed to by the signed header.
+// It does not verify the signed header's commit signatures; a light client must check those against a trusted validator set.
+func (p NameRecordProof) Verify() error {
+	if p.Record == nil {
+		if len(p.Value) != 0 {
+			return fmt.Errorf("name %q value must be empty when there is no record", p.Name)
+		}
+	} else {
+		if p.Record.Name != p.Name {
+			return fmt.Errorf("name %q record has a different name: %q", p.Name, p.Record.Name)
+		}
+		bz, err := p.Record.Marshal()
+		if err != nil {
+			return fmt.Errorf("could not encode name %q record: %w", p.Name, err)
+		}
+		if !bytes.Equal(bz, p.Value) {
+			return fmt.Errorf("name %q record does not match its value", p.Name)
+		}
+	}
+	return provutils.VerifyStoreProofInHeader(p.SignedHeader, p.Height, p.Proof, StoreKey, p.Key, p.Value)
+}
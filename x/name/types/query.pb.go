@@ -30,6 +30,54 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// NameViolationType is the kind of naming rule that a name violates.
+type NameViolationType int32
+
+const (
+	// NAME_VIOLATION_TYPE_UNSPECIFIED is an invalid value.
+	NameViolationUnspecified NameViolationType = 0
+	// NAME_VIOLATION_TYPE_SEGMENT_TOO_SHORT is for a segment shorter than the min_segment_length param.
+	NameViolationSegmentTooShort NameViolationType = 1
+	// NAME_VIOLATION_TYPE_SEGMENT_TOO_LONG is for a (non-uuid) segment longer than the max_segment_length param.
+	NameViolationSegmentTooLong NameViolationType = 2
+	// NAME_VIOLATION_TYPE_INVALID_CHARACTER is for a character other than a dash, digit, or lower-case letter.
+	NameViolationInvalidCharacter NameViolationType = 3
+	// NAME_VIOLATION_TYPE_TOO_MANY_DASHES is for a (non-uuid) segment with more than one dash.
+	NameViolationTooManyDashes NameViolationType = 4
+	// NAME_VIOLATION_TYPE_TOO_MANY_LEVELS is for a name with more segments than the max_name_levels param.
+	NameViolationTooManyLevels NameViolationType = 5
+	// NAME_VIOLATION_TYPE_TOO_MANY_UUID_SEGMENTS is for a name with more uuid segments than the max_uuid_segments param.
+	NameViolationTooManyUUIDSegments NameViolationType = 6
+)
+
+var NameViolationType_name = map[int32]string{
+	0: "NAME_VIOLATION_TYPE_UNSPECIFIED",
+	1: "NAME_VIOLATION_TYPE_SEGMENT_TOO_SHORT",
+	2: "NAME_VIOLATION_TYPE_SEGMENT_TOO_LONG",
+	3: "NAME_VIOLATION_TYPE_INVALID_CHARACTER",
+	4: "NAME_VIOLATION_TYPE_TOO_MANY_DASHES",
+	5: "NAME_VIOLATION_TYPE_TOO_MANY_LEVELS",
+	6: "NAME_VIOLATION_TYPE_TOO_MANY_UUID_SEGMENTS",
+}
+
+var NameViolationType_value = map[string]int32{
+	"NAME_VIOLATION_TYPE_UNSPECIFIED":            0,
+	"NAME_VIOLATION_TYPE_SEGMENT_TOO_SHORT":      1,
+	"NAME_VIOLATION_TYPE_SEGMENT_TOO_LONG":       2,
+	"NAME_VIOLATION_TYPE_INVALID_CHARACTER":      3,
+	"NAME_VIOLATION_TYPE_TOO_MANY_DASHES":        4,
+	"NAME_VIOLATION_TYPE_TOO_MANY_LEVELS":        5,
+	"NAME_VIOLATION_TYPE_TOO_MANY_UUID_SEGMENTS": 6,
+}
+
+func (x NameViolationType) String() string {
+	return proto.EnumName(NameViolationType_name, int32(x))
+}
+
+func (NameViolationType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_4e9b0d5536fc961a, []int{0}
+}
+
 // QueryParamsRequest is the request type for the Query/Params RPC method.
 type QueryParamsRequest struct {
 }
@@ -207,6 +255,164 @@ func (m *QueryResolveResponse) GetRestricted() bool {
 	return false
 }
 
+// QueryResolveManyRequest is the request type for the Query/ResolveMany method.
+type QueryResolveManyRequest struct {
+	// names to resolve the addresses for (at most 100).
+	Names []string `protobuf:"bytes,1,rep,name=names,proto3" json:"names,omitempty"`
+}
+
+func (m *QueryResolveManyRequest) Reset()         { *m = QueryResolveManyRequest{} }
+func (m *QueryResolveManyRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryResolveManyRequest) ProtoMessage()    {}
+func (*QueryResolveManyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4e9b0d5536fc961a, []int{4}
+}
+func (m *QueryResolveManyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryResolveManyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryResolveManyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryResolveManyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryResolveManyRequest.Merge(m, src)
+}
+func (m *QueryResolveManyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryResolveManyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryResolveManyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryResolveManyRequest proto.InternalMessageInfo
+
+// QueryResolveManyResponse is the response type for the Query/ResolveMany method.
+type QueryResolveManyResponse struct {
+	// results contains one entry for each requested name, in the same order as the request.
+	Results []ResolveResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results"`
+}
+
+func (m *QueryResolveManyResponse) Reset()         { *m = QueryResolveManyResponse{} }
+func (m *QueryResolveManyResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryResolveManyResponse) ProtoMessage()    {}
+func (*QueryResolveManyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4e9b0d5536fc961a, []int{5}
+}
+func (m *QueryResolveManyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryResolveManyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryResolveManyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryResolveManyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryResolveManyResponse.Merge(m, src)
+}
+func (m *QueryResolveManyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryResolveManyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryResolveManyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryResolveManyResponse proto.InternalMessageInfo
+
+func (m *QueryResolveManyResponse) GetResults() []ResolveResult {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+// ResolveResult is the result of resolving a single name in a Query/ResolveMany request.
+type ResolveResult struct {
+	// name is the requested name, as provided in the request.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// a string containing the address the name resolves to. Empty if the name could not be resolved.
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// Whether owner signature is required to add sub-names.
+	Restricted bool `protobuf:"varint,3,opt,name=restricted,proto3" json:"restricted,omitempty"`
+	// error is the reason the name could not be resolved. Empty if the name was resolved.
+	Error string `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *ResolveResult) Reset()         { *m = ResolveResult{} }
+func (m *ResolveResult) String() string { return proto.CompactTextString(m) }
+func (*ResolveResult) ProtoMessage()    {}
+func (*ResolveResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4e9b0d5536fc961a, []int{6}
+}
+func (m *ResolveResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ResolveResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ResolveResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ResolveResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResolveResult.Merge(m, src)
+}
+func (m *ResolveResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *ResolveResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResolveResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResolveResult proto.InternalMessageInfo
+
+func (m *ResolveResult) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ResolveResult) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *ResolveResult) GetRestricted() bool {
+	if m != nil {
+		return m.Restricted
+	}
+	return false
+}
+
+func (m *ResolveResult) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
 // QueryReverseLookupRequest is the request type for the Query/ReverseLookup method.
 type QueryReverseLookupRequest struct {
 	// address to find name records for
@@ -219,7 +425,7 @@ func (m *QueryReverseLookupRequest) Reset()         { *m = QueryReverseLookupReq
 func (m *QueryReverseLookupRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryReverseLookupRequest) ProtoMessage()    {}
 func (*QueryReverseLookupRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4e9b0d5536fc961a, []int{4}
+	return fileDescriptor_4e9b0d5536fc961a, []int{7}
 }
 func (m *QueryReverseLookupRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -254,13 +460,15 @@ type QueryReverseLookupResponse struct {
 	Name []string `protobuf:"bytes,1,rep,name=name,proto3" json:"name,omitempty"`
 	// pagination defines an optional pagination for the request.
 	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// truncated is true if the requested page limit was reduced to the max_query_results param.
+	Truncated bool `protobuf:"varint,3,opt,name=truncated,proto3" json:"truncated,omitempty"`
 }
 
 func (m *QueryReverseLookupResponse) Reset()         { *m = QueryReverseLookupResponse{} }
 func (m *QueryReverseLookupResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryReverseLookupResponse) ProtoMessage()    {}
 func (*QueryReverseLookupResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4e9b0d5536fc961a, []int{5}
+	return fileDescriptor_4e9b0d5536fc961a, []int{8}
 }
 func (m *QueryReverseLookupResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -289,557 +497,5955 @@ func (m *QueryReverseLookupResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryReverseLookupResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "provenance.name.v1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "provenance.name.v1.QueryParamsResponse")
-	proto.RegisterType((*QueryResolveRequest)(nil), "provenance.name.v1.QueryResolveRequest")
-	proto.RegisterType((*QueryResolveResponse)(nil), "provenance.name.v1.QueryResolveResponse")
-	proto.RegisterType((*QueryReverseLookupRequest)(nil), "provenance.name.v1.QueryReverseLookupRequest")
-	proto.RegisterType((*QueryReverseLookupResponse)(nil), "provenance.name.v1.QueryReverseLookupResponse")
+// QueryNameStatsRequest is the request type for the Query/NameStats method.
+type QueryNameStatsRequest struct {
 }
 
-func init() { proto.RegisterFile("provenance/name/v1/query.proto", fileDescriptor_4e9b0d5536fc961a) }
-
-var fileDescriptor_4e9b0d5536fc961a = []byte{
-	// 533 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0x3f, 0x6f, 0xd3, 0x40,
-	0x18, 0xc6, 0x7d, 0x6d, 0x49, 0xcb, 0x55, 0x2c, 0x47, 0x90, 0x82, 0x55, 0x9c, 0xca, 0xaa, 0xd2,
-	0xa8, 0xa2, 0x77, 0x24, 0x5d, 0x10, 0x63, 0x07, 0x58, 0x90, 0x08, 0x1e, 0xd9, 0x2e, 0xce, 0xc9,
-	0x58, 0x24, 0x7e, 0x5d, 0x9f, 0x63, 0x51, 0x55, 0x59, 0x60, 0xa0, 0x23, 0x12, 0x2b, 0x43, 0x3f,
-	0x02, 0x1f, 0xa3, 0x63, 0x25, 0x16, 0x26, 0x84, 0x12, 0x06, 0x3e, 0x06, 0xf2, 0xdd, 0x45, 0x71,
-	0x94, 0x2b, 0x61, 0xbb, 0xbc, 0xff, 0x9e, 0xdf, 0xeb, 0xe7, 0x55, 0xb0, 0x97, 0x66, 0x50, 0x88,
-	0x84, 0x27, 0xa1, 0x60, 0x09, 0x1f, 0x09, 0x56, 0x74, 0xd8, 0xd9, 0x58, 0x64, 0xe7, 0x34, 0xcd,
-	0x20, 0x07, 0x42, 0x16, 0x79, 0x5a, 0xe6, 0x69, 0xd1, 0x71, 0x8f, 0x42, 0x90, 0x23, 0x90, 0xac,
-	0xcf, 0xa5, 0xd0, 0xc5, 0xac, 0xe8, 0xf4, 0x45, 0xce, 0x3b, 0x2c, 0xe5, 0x51, 0x9c, 0xf0, 0x3c,
-	0x86, 0x44, 0xf7, 0xbb, 0xf5, 0x08, 0x22, 0x50, 0x4f, 0x56, 0xbe, 0x4c, 0x74, 0x2f, 0x02, 0x88,
-	0x86, 0x82, 0xf1, 0x34, 0x66, 0x3c, 0x49, 0x20, 0x57, 0x2d, 0xd2, 0x64, 0x1f, 0x59, 0x98, 0x94,
-	0xb6, 0x4a, 0xfb, 0x75, 0x4c, 0x5e, 0x97, 0xa2, 0x3d, 0x9e, 0xf1, 0x91, 0x0c, 0xc4, 0xd9, 0x58,
-	0xc8, 0xdc, 0x7f, 0x85, 0xef, 0x2f, 0x45, 0x65, 0x0a, 0x89, 0x14, 0xe4, 0x29, 0xae, 0xa5, 0x2a,
-	0xd2, 0x40, 0xfb, 0xa8, 0xbd, 0xdb, 0x75, 0xe9, 0xea, 0x42, 0x54, 0xf7, 0x9c, 0x6e, 0x5d, 0xff,
-	0x6c, 0x3a, 0x81, 0xa9, 0xf7, 0x4f, 0xcc, 0xc0, 0x40, 0x48, 0x18, 0x16, 0xc2, 0xe8, 0x10, 0x82,
-	0xb7, 0xca, 0x36, 0x35, 0xee, 0x6e, 0xa0, 0xde, 0xcf, 0x76, 0x2e, 0xaf, 0x9a, 0xce, 0x9f, 0xab,
-	0xa6, 0xe3, 0xf7, 0x70, 0x7d, 0xb9, 0xc9, 0x60, 0x34, 0xf0, 0x36, 0x1f, 0x0c, 0x32, 0x21, 0xa5,
-	0x69, 0x9c, 0xff, 0x24, 0x1e, 0xc6, 0x99, 0x90, 0x79, 0x16, 0x87, 0xb9, 0x18, 0x34, 0x36, 0xf6,
-	0x51, 0x7b, 0x27, 0xa8, 0x44, 0xfc, 0x4f, 0x08, 0x3f, 0x34, 0x23, 0x0b, 0x91, 0x49, 0xf1, 0x12,
-	0xe0, 0xdd, 0x38, 0x9d, 0xd3, 0xdc, 0x3e, 0xf7, 0x39, 0xc6, 0x0b, 0x33, 0xd4, 0xdc, 0xdd, 0x6e,
-	0x8b, 0x6a, 0xe7, 0x68, 0xe9, 0x1c, 0xd5, 0x36, 0x1b, 0xe7, 0x68, 0x8f, 0x47, 0xf3, 0x1d, 0x83,
-	0x4a, 0x67, 0x65, 0xb7, 0x8f, 0x08, 0xbb, 0x36, 0x12, 0xb3, 0xe2, 0xe2, 0xc3, 0x6c, 0xce, 0x3f,
-	0x0c, 0x79, 0x61, 0x81, 0x38, 0x5c, 0x0b, 0xa1, 0x07, 0xda, 0x29, 0xba, 0xdf, 0x36, 0xf1, 0x1d,
-	0x45, 0x41, 0x26, 0xb8, 0xa6, 0x8d, 0x23, 0x2d, 0x9b, 0xa9, 0xab, 0x37, 0xe2, 0x1e, 0xae, 0xad,
-	0xd3, 0xd2, 0xbe, 0xff, 0xe1, 0xfb, 0xef, 0x2f, 0x1b, 0x7b, 0xc4, 0x65, 0x96, 0x53, 0xd4, 0xf7,
-	0x41, 0x2e, 0x11, 0xde, 0x36, 0x36, 0x93, 0xdb, 0x07, 0x2f, 0x5f, 0x8f, 0xdb, 0x5e, 0x5f, 0x68,
-	0x10, 0x8e, 0x14, 0xc2, 0x01, 0xf1, 0x6d, 0x08, 0x99, 0x2e, 0x66, 0x17, 0x65, 0x60, 0x42, 0xbe,
-	0x22, 0x7c, 0x6f, 0xc9, 0x14, 0x72, 0xfc, 0x0f, 0x9d, 0xd5, 0x33, 0x72, 0xe9, 0xff, 0x96, 0x1b,
-	0xb8, 0xc7, 0x0a, 0xae, 0x45, 0x0e, 0x6c, 0x70, 0x43, 0x55, 0xcb, 0x2e, 0xcc, 0x25, 0x4e, 0x4e,
-	0xc3, 0xeb, 0xa9, 0x87, 0x6e, 0xa6, 0x1e, 0xfa, 0x35, 0xf5, 0xd0, 0xe7, 0x99, 0xe7, 0xdc, 0xcc,
-	0x3c, 0xe7, 0xc7, 0xcc, 0x73, 0xf0, 0x83, 0x18, 0x2c, 0xca, 0x3d, 0xf4, 0xe6, 0x49, 0x14, 0xe7,
-	0x6f, 0xc7, 0x7d, 0x1a, 0xc2, 0xa8, 0x22, 0x71, 0x1c, 0x43, 0x55, 0xf0, 0xbd, 0x96, 0xcc, 0xcf,
-	0x53, 0x21, 0xfb, 0x35, 0xf5, 0xe7, 0x70, 0xf2, 0x37, 0x00, 0x00, 0xff, 0xff, 0xb6, 0x9d, 0xe6,
-	0x24, 0xd1, 0x04, 0x00, 0x00,
+func (m *QueryNameStatsRequest) Reset()         { *m = QueryNameStatsRequest{} }
+func (m *QueryNameStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryNameStatsRequest) ProtoMessage()    {}
+func (*QueryNameStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4e9b0d5536fc961a, []int{9}
+}
+func (m *QueryNameStatsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryNameStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNameStatsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryNameStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNameStatsRequest.Merge(m, src)
+}
+func (m *QueryNameStatsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNameStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNameStatsRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryNameStatsRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Params queries params of the name module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// Resolve queries for the address associated with a given name
-	Resolve(ctx context.Context, in *QueryResolveRequest, opts ...grpc.CallOption) (*QueryResolveResponse, error)
-	// ReverseLookup queries for all names bound against a given address
-	ReverseLookup(ctx context.Context, in *QueryReverseLookupRequest, opts ...grpc.CallOption) (*QueryReverseLookupResponse, error)
+// QueryNameStatsResponse is the response type for the Query/NameStats method.
+type QueryNameStatsResponse struct {
+	// total is the number of bound names.
+	Total uint64 `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	// restricted is the number of bound names that require the owner's signature to add sub-names.
+	Restricted uint64 `protobuf:"varint,2,opt,name=restricted,proto3" json:"restricted,omitempty"`
+	// unrestricted is the number of bound names that do not require the owner's signature to add sub-names.
+	Unrestricted uint64 `protobuf:"varint,3,opt,name=unrestricted,proto3" json:"unrestricted,omitempty"`
+	// roots contains the number of bound names under each root name, ordered by root name.
+	Roots []RootNameCount `protobuf:"bytes,4,rep,name=roots,proto3" json:"roots"`
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryNameStatsResponse) Reset()         { *m = QueryNameStatsResponse{} }
+func (m *QueryNameStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryNameStatsResponse) ProtoMessage()    {}
+func (*QueryNameStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4e9b0d5536fc961a, []int{10}
 }
-
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryNameStatsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryNameStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNameStatsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryNameStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNameStatsResponse.Merge(m, src)
+}
+func (m *QueryNameStatsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNameStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNameStatsResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/provenance.name.v1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryNameStatsResponse proto.InternalMessageInfo
+
+func (m *QueryNameStatsResponse) GetTotal() uint64 {
+	if m != nil {
+		return m.Total
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) Resolve(ctx context.Context, in *QueryResolveRequest, opts ...grpc.CallOption) (*QueryResolveResponse, error) {
-	out := new(QueryResolveResponse)
-	err := c.cc.Invoke(ctx, "/provenance.name.v1.Query/Resolve", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryNameStatsResponse) GetRestricted() uint64 {
+	if m != nil {
+		return m.Restricted
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) ReverseLookup(ctx context.Context, in *QueryReverseLookupRequest, opts ...grpc.CallOption) (*QueryReverseLookupResponse, error) {
-	out := new(QueryReverseLookupResponse)
-	err := c.cc.Invoke(ctx, "/provenance.name.v1.Query/ReverseLookup", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryNameStatsResponse) GetUnrestricted() uint64 {
+	if m != nil {
+		return m.Unrestricted
 	}
-	return out, nil
+	return 0
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Params queries params of the name module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// Resolve queries for the address associated with a given name
-	Resolve(context.Context, *QueryResolveRequest) (*QueryResolveResponse, error)
-	// ReverseLookup queries for all names bound against a given address
-	ReverseLookup(context.Context, *QueryReverseLookupRequest) (*QueryReverseLookupResponse, error)
+func (m *QueryNameStatsResponse) GetRoots() []RootNameCount {
+	if m != nil {
+		return m.Roots
+	}
+	return nil
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+// RootNameCount is the number of bound names under a root name.
+type RootNameCount struct {
+	// root is the root name, i.e. the last segment of the names being counted.
+	Root string `protobuf:"bytes,1,opt,name=root,proto3" json:"root,omitempty"`
+	// count is the number of bound names with this root, including the root name itself (if bound).
+	Count uint64 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
 }
 
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *RootNameCount) Reset()         { *m = RootNameCount{} }
+func (m *RootNameCount) String() string { return proto.CompactTextString(m) }
+func (*RootNameCount) ProtoMessage()    {}
+func (*RootNameCount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4e9b0d5536fc961a, []int{11}
 }
-func (*UnimplementedQueryServer) Resolve(ctx context.Context, req *QueryResolveRequest) (*QueryResolveResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Resolve not implemented")
+func (m *RootNameCount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) ReverseLookup(ctx context.Context, req *QueryReverseLookupRequest) (*QueryReverseLookupResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ReverseLookup not implemented")
+func (m *RootNameCount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RootNameCount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *RootNameCount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RootNameCount.Merge(m, src)
+}
+func (m *RootNameCount) XXX_Size() int {
+	return m.Size()
+}
+func (m *RootNameCount) XXX_DiscardUnknown() {
+	xxx_messageInfo_RootNameCount.DiscardUnknown(m)
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.name.v1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+var xxx_messageInfo_RootNameCount proto.InternalMessageInfo
+
+func (m *RootNameCount) GetRoot() string {
+	if m != nil {
+		return m.Root
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_Resolve_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryResolveRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Resolve(ctx, in)
+func (m *RootNameCount) GetCount() uint64 {
+	if m != nil {
+		return m.Count
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.name.v1.Query/Resolve",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Resolve(ctx, req.(*QueryResolveRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_ReverseLookup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryReverseLookupRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ReverseLookup(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.name.v1.Query/ReverseLookup",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ReverseLookup(ctx, req.(*QueryReverseLookupRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryPendingDeletionsRequest is the request type for the Query/PendingDeletions method.
+type QueryPendingDeletionsRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-var Query_serviceDesc = _Query_serviceDesc
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "provenance.name.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "Resolve",
-			Handler:    _Query_Resolve_Handler,
-		},
-		{
-			MethodName: "ReverseLookup",
-			Handler:    _Query_ReverseLookup_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "provenance/name/v1/query.proto",
+func (m *QueryPendingDeletionsRequest) Reset()         { *m = QueryPendingDeletionsRequest{} }
+func (m *QueryPendingDeletionsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingDeletionsRequest) ProtoMessage()    {}
+func (*QueryPendingDeletionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4e9b0d5536fc961a, []int{12}
 }
-
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryPendingDeletionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingDeletionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingDeletionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryPendingDeletionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingDeletionsRequest.Merge(m, src)
 }
-
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryPendingDeletionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingDeletionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingDeletionsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryPendingDeletionsRequest proto.InternalMessageInfo
+
+func (m *QueryPendingDeletionsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryPendingDeletionsResponse is the response type for the Query/PendingDeletions method.
+type QueryPendingDeletionsResponse struct {
+	// pending_deletions are the names that are pending deletion, ordered by delete height.
+	PendingDeletions []PendingNameDeletion `protobuf:"bytes,1,rep,name=pending_deletions,json=pendingDeletions,proto3" json:"pending_deletions"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryPendingDeletionsResponse) Reset()         { *m = QueryPendingDeletionsResponse{} }
+func (m *QueryPendingDeletionsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingDeletionsResponse) ProtoMessage()    {}
+func (*QueryPendingDeletionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4e9b0d5536fc961a, []int{13}
+}
+func (m *QueryPendingDeletionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingDeletionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingDeletionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryResolveRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryPendingDeletionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingDeletionsResponse.Merge(m, src)
 }
-
-func (m *QueryResolveRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryPendingDeletionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingDeletionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingDeletionsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryResolveRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QueryPendingDeletionsResponse proto.InternalMessageInfo
+
+func (m *QueryPendingDeletionsResponse) GetPendingDeletions() []PendingNameDeletion {
+	if m != nil {
+		return m.PendingDeletions
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryResolveResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryPendingDeletionsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryResolveResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryNamesByUUIDRequest is the request type for the Query/NamesByUUID method.
+type QueryNamesByUUIDRequest struct {
+	// uuid is the UUID segment to find names for. Any textual form of the UUID can be used.
+	Uuid string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryResolveResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Restricted {
-		i--
-		if m.Restricted {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+func (m *QueryNamesByUUIDRequest) Reset()         { *m = QueryNamesByUUIDRequest{} }
+func (m *QueryNamesByUUIDRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryNamesByUUIDRequest) ProtoMessage()    {}
+func (*QueryNamesByUUIDRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4e9b0d5536fc961a, []int{14}
+}
+func (m *QueryNamesByUUIDRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryNamesByUUIDRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNamesByUUIDRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x10
+		return b[:n], nil
 	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *QueryNamesByUUIDRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNamesByUUIDRequest.Merge(m, src)
+}
+func (m *QueryNamesByUUIDRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNamesByUUIDRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNamesByUUIDRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryNamesByUUIDRequest proto.InternalMessageInfo
+
+func (m *QueryNamesByUUIDRequest) GetUuid() string {
+	if m != nil {
+		return m.Uuid
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryReverseLookupRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryNamesByUUIDRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryReverseLookupRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryNamesByUUIDResponse is the response type for the Query/NamesByUUID method.
+type QueryNamesByUUIDResponse struct {
+	// names are the bound names that contain the requested UUID as one of their segments.
+	Names []string `protobuf:"bytes,1,rep,name=names,proto3" json:"names,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// truncated is true if the requested page limit was reduced to the max_query_results param.
+	Truncated bool `protobuf:"varint,3,opt,name=truncated,proto3" json:"truncated,omitempty"`
 }
 
-func (m *QueryReverseLookupRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryNamesByUUIDResponse) Reset()         { *m = QueryNamesByUUIDResponse{} }
+func (m *QueryNamesByUUIDResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryNamesByUUIDResponse) ProtoMessage()    {}
+func (*QueryNamesByUUIDResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4e9b0d5536fc961a, []int{15}
+}
+func (m *QueryNamesByUUIDResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryNamesByUUIDResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNamesByUUIDResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryNamesByUUIDResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNamesByUUIDResponse.Merge(m, src)
+}
+func (m *QueryNamesByUUIDResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNamesByUUIDResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNamesByUUIDResponse.DiscardUnknown(m)
 }
 
-func (m *QueryReverseLookupResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryNamesByUUIDResponse proto.InternalMessageInfo
+
+func (m *QueryNamesByUUIDResponse) GetNames() []string {
+	if m != nil {
+		return m.Names
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryReverseLookupResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryNamesByUUIDResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryReverseLookupResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func (m *QueryNamesByUUIDResponse) GetTruncated() bool {
+	if m != nil {
+		return m.Truncated
 	}
-	if len(m.Name) > 0 {
-		for iNdEx := len(m.Name) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Name[iNdEx])
-			copy(dAtA[i:], m.Name[iNdEx])
-			i = encodeVarintQuery(dAtA, i, uint64(len(m.Name[iNdEx])))
-			i--
-			dAtA[i] = 0xa
+	return false
+}
+
+// QueryZoneFileRequest is the request type for the Query/ZoneFile method.
+type QueryZoneFileRequest struct {
+	// name is the top of the name sub-tree to export.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// domain is an optional DNS domain that the names are placed under, e.g. "names.example.com".
+	// The zone's origin is the name followed by this domain. If empty, the origin is just the name.
+	Domain string `protobuf:"bytes,2,opt,name=domain,proto3" json:"domain,omitempty"`
+	// ttl is the default TTL (in seconds) of the records. If zero, 3600 is used.
+	Ttl uint32 `protobuf:"varint,3,opt,name=ttl,proto3" json:"ttl,omitempty"`
+}
+
+func (m *QueryZoneFileRequest) Reset()         { *m = QueryZoneFileRequest{} }
+func (m *QueryZoneFileRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryZoneFileRequest) ProtoMessage()    {}
+func (*QueryZoneFileRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4e9b0d5536fc961a, []int{16}
+}
+func (m *QueryZoneFileRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryZoneFileRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryZoneFileRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryZoneFileRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryZoneFileRequest.Merge(m, src)
+}
+func (m *QueryZoneFileRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryZoneFileRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryZoneFileRequest.DiscardUnknown(m)
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_QueryZoneFileRequest proto.InternalMessageInfo
+
+func (m *QueryZoneFileRequest) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return ""
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *QueryZoneFileRequest) GetDomain() string {
+	if m != nil {
+		return m.Domain
 	}
-	var l int
-	_ = l
-	return n
+	return ""
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryZoneFileRequest) GetTtl() uint32 {
+	if m != nil {
+		return m.Ttl
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return 0
 }
 
-func (m *QueryResolveRequest) Size() (n int) {
-	if m == nil {
-		return 0
+// QueryZoneFileResponse is the response type for the Query/ZoneFile method.
+type QueryZoneFileResponse struct {
+	// zone_file is the contents of the zone file.
+	ZoneFile string `protobuf:"bytes,1,opt,name=zone_file,json=zoneFile,proto3" json:"zone_file,omitempty"`
+	// record_count is the number of records in the zone file.
+	RecordCount uint64 `protobuf:"varint,2,opt,name=record_count,json=recordCount,proto3" json:"record_count,omitempty"`
+	// truncated is true if the sub-tree has more names than the max_query_results param, and not all were included.
+	Truncated bool `protobuf:"varint,3,opt,name=truncated,proto3" json:"truncated,omitempty"`
+}
+
+func (m *QueryZoneFileResponse) Reset()         { *m = QueryZoneFileResponse{} }
+func (m *QueryZoneFileResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryZoneFileResponse) ProtoMessage()    {}
+func (*QueryZoneFileResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4e9b0d5536fc961a, []int{17}
+}
+func (m *QueryZoneFileResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryZoneFileResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryZoneFileResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+}
+func (m *QueryZoneFileResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryZoneFileResponse.Merge(m, src)
+}
+func (m *QueryZoneFileResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryZoneFileResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryZoneFileResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryZoneFileResponse proto.InternalMessageInfo
+
+func (m *QueryZoneFileResponse) GetZoneFile() string {
+	if m != nil {
+		return m.ZoneFile
 	}
-	return n
+	return ""
 }
 
-func (m *QueryResolveResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryZoneFileResponse) GetRecordCount() uint64 {
+	if m != nil {
+		return m.RecordCount
 	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return 0
+}
+
+func (m *QueryZoneFileResponse) GetTruncated() bool {
+	if m != nil {
+		return m.Truncated
 	}
-	if m.Restricted {
-		n += 2
+	return false
+}
+
+// QueryNormalizeRequest is the request type for the Query/Normalize method.
+type QueryNormalizeRequest struct {
+	// name is the candidate name to normalize and check.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (m *QueryNormalizeRequest) Reset()         { *m = QueryNormalizeRequest{} }
+func (m *QueryNormalizeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryNormalizeRequest) ProtoMessage()    {}
+func (*QueryNormalizeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4e9b0d5536fc961a, []int{18}
+}
+func (m *QueryNormalizeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryNormalizeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNormalizeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QueryNormalizeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNormalizeRequest.Merge(m, src)
+}
+func (m *QueryNormalizeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNormalizeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNormalizeRequest.DiscardUnknown(m)
 }
 
-func (m *QueryReverseLookupRequest) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryNormalizeRequest proto.InternalMessageInfo
+
+func (m *QueryNormalizeRequest) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return ""
+}
+
+// QueryNormalizeResponse is the response type for the Query/Normalize method.
+type QueryNormalizeResponse struct {
+	// normalized is the name in the form it would be stored in (lower-cased, with spaces trimmed around each segment).
+	Normalized string `protobuf:"bytes,1,opt,name=normalized,proto3" json:"normalized,omitempty"`
+	// valid is true if the normalized name does not violate any of the naming rules.
+	Valid bool `protobuf:"varint,2,opt,name=valid,proto3" json:"valid,omitempty"`
+	// violations are the naming rules that the normalized name does not satisfy.
+	Violations []NameViolation `protobuf:"bytes,3,rep,name=violations,proto3" json:"violations"`
+}
+
+func (m *QueryNormalizeResponse) Reset()         { *m = QueryNormalizeResponse{} }
+func (m *QueryNormalizeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryNormalizeResponse) ProtoMessage()    {}
+func (*QueryNormalizeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4e9b0d5536fc961a, []int{19}
+}
+func (m *QueryNormalizeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryNormalizeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNormalizeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+}
+func (m *QueryNormalizeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNormalizeResponse.Merge(m, src)
+}
+func (m *QueryNormalizeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNormalizeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNormalizeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryNormalizeResponse proto.InternalMessageInfo
+
+func (m *QueryNormalizeResponse) GetNormalized() string {
+	if m != nil {
+		return m.Normalized
 	}
-	return n
+	return ""
 }
 
-func (m *QueryReverseLookupResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryNormalizeResponse) GetValid() bool {
+	if m != nil {
+		return m.Valid
 	}
-	var l int
-	_ = l
-	if len(m.Name) > 0 {
-		for _, s := range m.Name {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
+	return false
+}
+
+func (m *QueryNormalizeResponse) GetViolations() []NameViolation {
+	if m != nil {
+		return m.Violations
+	}
+	return nil
+}
+
+// NameViolation describes one naming rule that a name does not satisfy.
+type NameViolation struct {
+	// type is the kind of rule that is violated.
+	Type NameViolationType `protobuf:"varint,1,opt,name=type,proto3,enum=provenance.name.v1.NameViolationType" json:"type,omitempty"`
+	// segment is the (zero-based) index of the segment with the violation.
+	// It is zero for violations of the name as a whole (i.e. too many levels or uuid segments).
+	Segment uint32 `protobuf:"varint,2,opt,name=segment,proto3" json:"segment,omitempty"`
+	// position is the (zero-based) character index in the normalized name of an invalid character.
+	// It is only set for invalid character violations.
+	Position uint32 `protobuf:"varint,3,opt,name=position,proto3" json:"position,omitempty"`
+	// message is a human-readable description of the violation.
+	Message string `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (m *NameViolation) Reset()         { *m = NameViolation{} }
+func (m *NameViolation) String() string { return proto.CompactTextString(m) }
+func (*NameViolation) ProtoMessage()    {}
+func (*NameViolation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4e9b0d5536fc961a, []int{20}
+}
+func (m *NameViolation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NameViolation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NameViolation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+}
+func (m *NameViolation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NameViolation.Merge(m, src)
+}
+func (m *NameViolation) XXX_Size() int {
+	return m.Size()
+}
+func (m *NameViolation) XXX_DiscardUnknown() {
+	xxx_messageInfo_NameViolation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NameViolation proto.InternalMessageInfo
+
+func (m *NameViolation) GetType() NameViolationType {
+	if m != nil {
+		return m.Type
 	}
-	return n
+	return NameViolationUnspecified
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *NameViolation) GetSegment() uint32 {
+	if m != nil {
+		return m.Segment
+	}
+	return 0
 }
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *NameViolation) GetPosition() uint32 {
+	if m != nil {
+		return m.Position
+	}
+	return 0
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+
+func (m *NameViolation) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+// QueryNamesCreatedRequest is the request type for the Query/NamesCreated method.
+type QueryNamesCreatedRequest struct {
+	// start_height is the first block height (inclusive) to get the names bound in. Zero means no lower limit.
+	StartHeight int64 `protobuf:"varint,1,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// end_height is the last block height (inclusive) to get the names bound in. Zero means no upper limit.
+	EndHeight int64 `protobuf:"varint,2,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryNamesCreatedRequest) Reset()         { *m = QueryNamesCreatedRequest{} }
+func (m *QueryNamesCreatedRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryNamesCreatedRequest) ProtoMessage()    {}
+func (*QueryNamesCreatedRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4e9b0d5536fc961a, []int{21}
+}
+func (m *QueryNamesCreatedRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryNamesCreatedRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNamesCreatedRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryNamesCreatedRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNamesCreatedRequest.Merge(m, src)
+}
+func (m *QueryNamesCreatedRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNamesCreatedRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNamesCreatedRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryNamesCreatedRequest proto.InternalMessageInfo
+
+func (m *QueryNamesCreatedRequest) GetStartHeight() int64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *QueryNamesCreatedRequest) GetEndHeight() int64 {
+	if m != nil {
+		return m.EndHeight
+	}
+	return 0
+}
+
+func (m *QueryNamesCreatedRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryNamesCreatedResponse is the response type for the Query/NamesCreated method.
+type QueryNamesCreatedResponse struct {
+	// names are the names bound within the requested block heights, ordered by the height they were bound at.
+	Names []CreatedName `protobuf:"bytes,1,rep,name=names,proto3" json:"names"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// truncated is true if the requested page limit was reduced to the max_query_results param.
+	Truncated bool `protobuf:"varint,3,opt,name=truncated,proto3" json:"truncated,omitempty"`
+}
+
+func (m *QueryNamesCreatedResponse) Reset()         { *m = QueryNamesCreatedResponse{} }
+func (m *QueryNamesCreatedResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryNamesCreatedResponse) ProtoMessage()    {}
+func (*QueryNamesCreatedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4e9b0d5536fc961a, []int{22}
+}
+func (m *QueryNamesCreatedResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryNamesCreatedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNamesCreatedResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryNamesCreatedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNamesCreatedResponse.Merge(m, src)
+}
+func (m *QueryNamesCreatedResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNamesCreatedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNamesCreatedResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryNamesCreatedResponse proto.InternalMessageInfo
+
+func (m *QueryNamesCreatedResponse) GetNames() []CreatedName {
+	if m != nil {
+		return m.Names
+	}
+	return nil
+}
+
+func (m *QueryNamesCreatedResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func (m *QueryNamesCreatedResponse) GetTruncated() bool {
+	if m != nil {
+		return m.Truncated
+	}
+	return false
+}
+
+// CreatedName is a name and the block height it was bound at.
+type CreatedName struct {
+	// name is the bound name.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// address is the address the name currently resolves to.
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// height is the block height that the name was bound at.
+	Height int64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *CreatedName) Reset()         { *m = CreatedName{} }
+func (m *CreatedName) String() string { return proto.CompactTextString(m) }
+func (*CreatedName) ProtoMessage()    {}
+func (*CreatedName) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4e9b0d5536fc961a, []int{23}
+}
+func (m *CreatedName) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CreatedName) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CreatedName.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CreatedName) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreatedName.Merge(m, src)
+}
+func (m *CreatedName) XXX_Size() int {
+	return m.Size()
+}
+func (m *CreatedName) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreatedName.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreatedName proto.InternalMessageInfo
+
+func (m *CreatedName) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *CreatedName) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *CreatedName) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// QueryOwnershipChallengeRequest is the request type for the Query/OwnershipChallenge method.
+type QueryOwnershipChallengeRequest struct {
+	// name is the name to create the challenge for. It must be bound.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// nonce is an optional value chosen by the challenger to include in the challenge.
+	Nonce string `protobuf:"bytes,2,opt,name=nonce,proto3" json:"nonce,omitempty"`
+}
+
+func (m *QueryOwnershipChallengeRequest) Reset()         { *m = QueryOwnershipChallengeRequest{} }
+func (m *QueryOwnershipChallengeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryOwnershipChallengeRequest) ProtoMessage()    {}
+func (*QueryOwnershipChallengeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4e9b0d5536fc961a, []int{24}
+}
+func (m *QueryOwnershipChallengeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOwnershipChallengeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOwnershipChallengeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryOwnershipChallengeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOwnershipChallengeRequest.Merge(m, src)
+}
+func (m *QueryOwnershipChallengeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOwnershipChallengeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOwnershipChallengeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryOwnershipChallengeRequest proto.InternalMessageInfo
+
+func (m *QueryOwnershipChallengeRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *QueryOwnershipChallengeRequest) GetNonce() string {
+	if m != nil {
+		return m.Nonce
+	}
+	return ""
+}
+
+// QueryOwnershipChallengeResponse is the response type for the Query/OwnershipChallenge method.
+type QueryOwnershipChallengeResponse struct {
+	// challenge is the ownership challenge for the name.
+	Challenge NameOwnershipChallenge `protobuf:"bytes,1,opt,name=challenge,proto3" json:"challenge"`
+	// sign_bytes are the bytes that the owner must sign: an ADR-036 sign doc whose data is the encoded challenge.
+	SignBytes []byte `protobuf:"bytes,2,opt,name=sign_bytes,json=signBytes,proto3" json:"sign_bytes,omitempty"`
+}
+
+func (m *QueryOwnershipChallengeResponse) Reset()         { *m = QueryOwnershipChallengeResponse{} }
+func (m *QueryOwnershipChallengeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryOwnershipChallengeResponse) ProtoMessage()    {}
+func (*QueryOwnershipChallengeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4e9b0d5536fc961a, []int{25}
+}
+func (m *QueryOwnershipChallengeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOwnershipChallengeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOwnershipChallengeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryOwnershipChallengeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOwnershipChallengeResponse.Merge(m, src)
+}
+func (m *QueryOwnershipChallengeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOwnershipChallengeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOwnershipChallengeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryOwnershipChallengeResponse proto.InternalMessageInfo
+
+func (m *QueryOwnershipChallengeResponse) GetChallenge() NameOwnershipChallenge {
+	if m != nil {
+		return m.Challenge
+	}
+	return NameOwnershipChallenge{}
+}
+
+func (m *QueryOwnershipChallengeResponse) GetSignBytes() []byte {
+	if m != nil {
+		return m.SignBytes
+	}
+	return nil
+}
+
+// QueryChildQuotaRequest is the request type for the Query/ChildQuota method.
+type QueryChildQuotaRequest struct {
+	// name is the parent name to look up. It must be bound.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (m *QueryChildQuotaRequest) Reset()         { *m = QueryChildQuotaRequest{} }
+func (m *QueryChildQuotaRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryChildQuotaRequest) ProtoMessage()    {}
+func (*QueryChildQuotaRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4e9b0d5536fc961a, []int{26}
+}
+func (m *QueryChildQuotaRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryChildQuotaRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryChildQuotaRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryChildQuotaRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryChildQuotaRequest.Merge(m, src)
+}
+func (m *QueryChildQuotaRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryChildQuotaRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryChildQuotaRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryChildQuotaRequest proto.InternalMessageInfo
+
+func (m *QueryChildQuotaRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+// QueryChildQuotaResponse is the response type for the Query/ChildQuota method.
+type QueryChildQuotaResponse struct {
+	// name is the normalized parent name.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// max_children is the maximum number of names that can be bound directly under the name. Zero means no limit.
+	MaxChildren uint64 `protobuf:"varint,2,opt,name=max_children,json=maxChildren,proto3" json:"max_children,omitempty"`
+	// child_count is the number of names currently bound directly under the name.
+	ChildCount uint64 `protobuf:"varint,3,opt,name=child_count,json=childCount,proto3" json:"child_count,omitempty"`
+	// remaining is the number of names that can still be bound directly under the name.
+	// It is zero if there is no quota, or if the quota has been used up.
+	Remaining uint64 `protobuf:"varint,4,opt,name=remaining,proto3" json:"remaining,omitempty"`
+}
+
+func (m *QueryChildQuotaResponse) Reset()         { *m = QueryChildQuotaResponse{} }
+func (m *QueryChildQuotaResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryChildQuotaResponse) ProtoMessage()    {}
+func (*QueryChildQuotaResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4e9b0d5536fc961a, []int{27}
+}
+func (m *QueryChildQuotaResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryChildQuotaResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryChildQuotaResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryChildQuotaResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryChildQuotaResponse.Merge(m, src)
+}
+func (m *QueryChildQuotaResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryChildQuotaResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryChildQuotaResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryChildQuotaResponse proto.InternalMessageInfo
+
+func (m *QueryChildQuotaResponse) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *QueryChildQuotaResponse) GetMaxChildren() uint64 {
+	if m != nil {
+		return m.MaxChildren
+	}
+	return 0
+}
+
+func (m *QueryChildQuotaResponse) GetChildCount() uint64 {
+	if m != nil {
+		return m.ChildCount
+	}
+	return 0
+}
+
+func (m *QueryChildQuotaResponse) GetRemaining() uint64 {
+	if m != nil {
+		return m.Remaining
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("provenance.name.v1.NameViolationType", NameViolationType_name, NameViolationType_value)
+	proto.RegisterType((*QueryParamsRequest)(nil), "provenance.name.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "provenance.name.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryResolveRequest)(nil), "provenance.name.v1.QueryResolveRequest")
+	proto.RegisterType((*QueryResolveResponse)(nil), "provenance.name.v1.QueryResolveResponse")
+	proto.RegisterType((*QueryResolveManyRequest)(nil), "provenance.name.v1.QueryResolveManyRequest")
+	proto.RegisterType((*QueryResolveManyResponse)(nil), "provenance.name.v1.QueryResolveManyResponse")
+	proto.RegisterType((*ResolveResult)(nil), "provenance.name.v1.ResolveResult")
+	proto.RegisterType((*QueryReverseLookupRequest)(nil), "provenance.name.v1.QueryReverseLookupRequest")
+	proto.RegisterType((*QueryReverseLookupResponse)(nil), "provenance.name.v1.QueryReverseLookupResponse")
+	proto.RegisterType((*QueryNameStatsRequest)(nil), "provenance.name.v1.QueryNameStatsRequest")
+	proto.RegisterType((*QueryNameStatsResponse)(nil), "provenance.name.v1.QueryNameStatsResponse")
+	proto.RegisterType((*RootNameCount)(nil), "provenance.name.v1.RootNameCount")
+	proto.RegisterType((*QueryPendingDeletionsRequest)(nil), "provenance.name.v1.QueryPendingDeletionsRequest")
+	proto.RegisterType((*QueryPendingDeletionsResponse)(nil), "provenance.name.v1.QueryPendingDeletionsResponse")
+	proto.RegisterType((*QueryNamesByUUIDRequest)(nil), "provenance.name.v1.QueryNamesByUUIDRequest")
+	proto.RegisterType((*QueryNamesByUUIDResponse)(nil), "provenance.name.v1.QueryNamesByUUIDResponse")
+	proto.RegisterType((*QueryZoneFileRequest)(nil), "provenance.name.v1.QueryZoneFileRequest")
+	proto.RegisterType((*QueryZoneFileResponse)(nil), "provenance.name.v1.QueryZoneFileResponse")
+	proto.RegisterType((*QueryNormalizeRequest)(nil), "provenance.name.v1.QueryNormalizeRequest")
+	proto.RegisterType((*QueryNormalizeResponse)(nil), "provenance.name.v1.QueryNormalizeResponse")
+	proto.RegisterType((*NameViolation)(nil), "provenance.name.v1.NameViolation")
+	proto.RegisterType((*QueryNamesCreatedRequest)(nil), "provenance.name.v1.QueryNamesCreatedRequest")
+	proto.RegisterType((*QueryNamesCreatedResponse)(nil), "provenance.name.v1.QueryNamesCreatedResponse")
+	proto.RegisterType((*CreatedName)(nil), "provenance.name.v1.CreatedName")
+	proto.RegisterType((*QueryOwnershipChallengeRequest)(nil), "provenance.name.v1.QueryOwnershipChallengeRequest")
+	proto.RegisterType((*QueryOwnershipChallengeResponse)(nil), "provenance.name.v1.QueryOwnershipChallengeResponse")
+	proto.RegisterType((*QueryChildQuotaRequest)(nil), "provenance.name.v1.QueryChildQuotaRequest")
+	proto.RegisterType((*QueryChildQuotaResponse)(nil), "provenance.name.v1.QueryChildQuotaResponse")
+}
+
+func init() { proto.RegisterFile("provenance/name/v1/query.proto", fileDescriptor_4e9b0d5536fc961a) }
+
+var fileDescriptor_4e9b0d5536fc961a = []byte{
+	// 1818 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0xcd, 0x6f, 0x1b, 0x5b,
+	0x15, 0xcf, 0xc4, 0x4e, 0x9a, 0x1c, 0x27, 0x4f, 0x7e, 0x97, 0xb4, 0xf5, 0x9b, 0xa6, 0xb6, 0x33,
+	0xaf, 0x6d, 0xd2, 0xb4, 0xb5, 0x9b, 0x54, 0x42, 0x14, 0xc4, 0xc2, 0x75, 0xdc, 0xd6, 0xe0, 0x3a,
+	0x79, 0x63, 0xb7, 0xd2, 0xab, 0x84, 0x46, 0x13, 0xfb, 0xd6, 0x1e, 0x31, 0x9e, 0xeb, 0xce, 0x8c,
+	0x4d, 0xd3, 0xaa, 0x1b, 0x24, 0x44, 0x15, 0x09, 0x51, 0x40, 0xbc, 0x27, 0x21, 0x22, 0x3d, 0x04,
+	0x62, 0xc1, 0x8e, 0x05, 0x6b, 0x36, 0x2c, 0xde, 0xf2, 0x49, 0x6c, 0x58, 0x21, 0xd4, 0xb2, 0xe0,
+	0xcf, 0x40, 0xf7, 0xcb, 0x9e, 0xb1, 0xc7, 0x8e, 0xfb, 0xf4, 0xd4, 0x8d, 0x35, 0xf7, 0xdc, 0xf3,
+	0xf1, 0xbb, 0xe7, 0x9e, 0x73, 0xee, 0x39, 0x86, 0x74, 0xd7, 0x25, 0x7d, 0xec, 0x98, 0x4e, 0x03,
+	0xe7, 0x1d, 0xb3, 0x83, 0xf3, 0xfd, 0x9d, 0xfc, 0xd3, 0x1e, 0x76, 0x8f, 0x72, 0x5d, 0x97, 0xf8,
+	0x04, 0xa1, 0xe1, 0x7e, 0x8e, 0xee, 0xe7, 0xfa, 0x3b, 0xea, 0x76, 0x83, 0x78, 0x1d, 0xe2, 0xe5,
+	0x0f, 0x4d, 0x0f, 0x73, 0xe6, 0x7c, 0x7f, 0xe7, 0x10, 0xfb, 0xe6, 0x4e, 0xbe, 0x6b, 0xb6, 0x2c,
+	0xc7, 0xf4, 0x2d, 0xe2, 0x70, 0x79, 0x75, 0xad, 0x45, 0x5a, 0x84, 0x7d, 0xe6, 0xe9, 0x97, 0xa0,
+	0xae, 0xb7, 0x08, 0x69, 0xd9, 0x38, 0x6f, 0x76, 0xad, 0xbc, 0xe9, 0x38, 0xc4, 0x67, 0x22, 0x9e,
+	0xd8, 0xbd, 0x18, 0x81, 0x89, 0xd9, 0xe6, 0xdb, 0x51, 0x90, 0xbb, 0x2e, 0x21, 0x4f, 0xf8, 0xbe,
+	0xb6, 0x06, 0xe8, 0x13, 0x0a, 0xea, 0xc0, 0x74, 0xcd, 0x8e, 0xa7, 0xe3, 0xa7, 0x3d, 0xec, 0xf9,
+	0xda, 0x3e, 0x7c, 0x2b, 0x44, 0xf5, 0xba, 0xc4, 0xf1, 0x30, 0xfa, 0x0e, 0x2c, 0x76, 0x19, 0x25,
+	0xa5, 0x64, 0x95, 0xad, 0xc4, 0xae, 0x9a, 0x1b, 0x3f, 0x70, 0x8e, 0xcb, 0xdc, 0x89, 0x7f, 0xf9,
+	0xef, 0xcc, 0x9c, 0x2e, 0xf8, 0xb5, 0x5b, 0x42, 0xa1, 0x8e, 0x3d, 0x62, 0xf7, 0xb1, 0xb0, 0x83,
+	0x10, 0xc4, 0xa9, 0x18, 0x53, 0xb7, 0xac, 0xb3, 0xef, 0xef, 0x2e, 0xbd, 0xfa, 0x22, 0x33, 0xf7,
+	0xbf, 0x2f, 0x32, 0x73, 0xda, 0x01, 0xac, 0x85, 0x85, 0x04, 0x8c, 0x14, 0x9c, 0x31, 0x9b, 0x4d,
+	0x17, 0x7b, 0x9e, 0x10, 0x94, 0x4b, 0x94, 0x06, 0x70, 0xb1, 0xe7, 0xbb, 0x56, 0xc3, 0xc7, 0xcd,
+	0xd4, 0x7c, 0x56, 0xd9, 0x5a, 0xd2, 0x03, 0x14, 0xed, 0x36, 0x9c, 0x0f, 0x6a, 0x7c, 0x60, 0x3a,
+	0x47, 0x12, 0xca, 0x1a, 0x2c, 0x50, 0xf3, 0x54, 0x65, 0x6c, 0x6b, 0x59, 0xe7, 0x8b, 0x00, 0x98,
+	0x1f, 0x41, 0x6a, 0x5c, 0x54, 0x00, 0x2a, 0xc0, 0x19, 0x17, 0x7b, 0x3d, 0xdb, 0xe7, 0xd2, 0x89,
+	0xdd, 0x8d, 0x28, 0xc7, 0x0c, 0x8f, 0xd1, 0xb3, 0x7d, 0xe1, 0x1f, 0x29, 0xa7, 0x79, 0xb0, 0x1a,
+	0xda, 0x8f, 0x72, 0x4d, 0xf0, 0xe0, 0xf3, 0xd3, 0x0e, 0x1e, 0x1b, 0x3d, 0x38, 0x3d, 0x1d, 0x76,
+	0x5d, 0xe2, 0xa6, 0xe2, 0x4c, 0x8e, 0x2f, 0xb4, 0x9f, 0x2b, 0xf0, 0x91, 0x38, 0x54, 0x1f, 0xbb,
+	0x1e, 0xae, 0x10, 0xf2, 0xe3, 0x5e, 0x57, 0x7a, 0x64, 0xb2, 0x9b, 0xef, 0x02, 0x0c, 0x63, 0x97,
+	0x41, 0x49, 0xec, 0x5e, 0xc9, 0xf1, 0x40, 0xcf, 0xd1, 0x40, 0xcf, 0xf1, 0xac, 0x10, 0x81, 0x9e,
+	0x3b, 0x30, 0x5b, 0xf2, 0xca, 0xf5, 0x80, 0x64, 0xc0, 0xbb, 0x7f, 0x50, 0x40, 0x8d, 0x42, 0x22,
+	0x1c, 0x3c, 0x74, 0x46, 0x6c, 0xe0, 0x8c, 0x7b, 0x11, 0x20, 0x36, 0x4f, 0x05, 0xc1, 0x15, 0x06,
+	0x51, 0xa0, 0x75, 0x58, 0xf6, 0xdd, 0x9e, 0xd3, 0x30, 0x87, 0xae, 0x1b, 0x12, 0x02, 0x18, 0xcf,
+	0xc3, 0x59, 0x06, 0xb1, 0x6a, 0x76, 0x70, 0xcd, 0x37, 0xfd, 0x41, 0xb6, 0xfc, 0x55, 0x81, 0x73,
+	0xa3, 0x3b, 0x02, 0xf8, 0x1a, 0x2c, 0xf8, 0xc4, 0x37, 0x6d, 0xe6, 0xc1, 0xb8, 0xce, 0x17, 0x11,
+	0x61, 0x1a, 0x0f, 0xdd, 0x96, 0x06, 0x2b, 0x3d, 0x67, 0xe4, 0x3e, 0xe3, 0x7a, 0x88, 0x86, 0xbe,
+	0x0f, 0x0b, 0x2e, 0x21, 0xbe, 0x97, 0x8a, 0x4f, 0x89, 0x38, 0x42, 0x7c, 0x8a, 0xa9, 0x48, 0x7a,
+	0x8e, 0x8c, 0x38, 0x2e, 0xa5, 0xdd, 0x86, 0xd5, 0xd0, 0x2e, 0x75, 0x31, 0xdd, 0x91, 0xf1, 0x46,
+	0xbf, 0x29, 0xfa, 0x06, 0xdd, 0x14, 0x10, 0xf9, 0x42, 0x7b, 0x02, 0xeb, 0xbc, 0x38, 0x60, 0xa7,
+	0x69, 0x39, 0xad, 0x3d, 0x6c, 0x63, 0x56, 0x90, 0x64, 0xdc, 0x84, 0xa3, 0x43, 0xf9, 0xba, 0xd1,
+	0xa1, 0xfd, 0x43, 0x81, 0x8b, 0x13, 0x0c, 0x09, 0xef, 0x3e, 0x86, 0x0f, 0xbb, 0x7c, 0xcf, 0x68,
+	0xca, 0x4d, 0x91, 0x81, 0x9b, 0x91, 0xa5, 0x89, 0x33, 0xd3, 0x43, 0x4b, 0x65, 0xc2, 0x2b, 0xc9,
+	0xee, 0x88, 0x8d, 0x6f, 0x2c, 0xbc, 0xb4, 0x9e, 0xa8, 0x39, 0xd4, 0xaa, 0x77, 0xe7, 0xe8, 0xe1,
+	0xc3, 0xf2, 0x5e, 0xa0, 0xfc, 0xf5, 0x7a, 0x56, 0x53, 0xfa, 0x9c, 0x7e, 0x7f, 0x53, 0xb9, 0xa5,
+	0x7d, 0xae, 0x40, 0x6a, 0xdc, 0xee, 0x30, 0x2c, 0xc7, 0x8b, 0xdd, 0x7b, 0xca, 0x28, 0xad, 0x2e,
+	0xca, 0xfa, 0x63, 0xe2, 0xe0, 0xbb, 0x96, 0x3d, 0xed, 0x31, 0x40, 0xe7, 0x60, 0xb1, 0x49, 0x3a,
+	0xa6, 0xe5, 0x88, 0x82, 0x27, 0x56, 0x28, 0x09, 0x31, 0xdf, 0xb7, 0x99, 0xee, 0x55, 0x9d, 0x7e,
+	0x6a, 0x3d, 0x38, 0x3b, 0xa2, 0x55, 0x9c, 0xf5, 0x02, 0x2c, 0x3f, 0x27, 0x0e, 0x36, 0x9e, 0x58,
+	0xb6, 0xd4, 0xbd, 0xf4, 0x5c, 0x30, 0xa1, 0x0d, 0x58, 0x71, 0x71, 0x83, 0xb8, 0x4d, 0x23, 0x18,
+	0xe8, 0x09, 0x4e, 0xe3, 0x89, 0x31, 0xfd, 0x30, 0xd7, 0x64, 0x51, 0x20, 0x6e, 0xc7, 0xb4, 0xad,
+	0xe7, 0xd3, 0x4e, 0xa3, 0x7d, 0x3e, 0x28, 0x14, 0x43, 0x6e, 0x81, 0x32, 0x0d, 0xe0, 0x48, 0xa2,
+	0x0c, 0x88, 0x00, 0x85, 0xde, 0x58, 0xdf, 0xb4, 0x2d, 0xf9, 0xa8, 0xf1, 0x05, 0xbd, 0xb1, 0xbe,
+	0x45, 0x6c, 0xde, 0x10, 0xa4, 0x62, 0x93, 0x2b, 0x01, 0x0d, 0x82, 0x47, 0x92, 0x53, 0xc4, 0x7c,
+	0x40, 0x54, 0xfb, 0x9d, 0x02, 0xab, 0x21, 0x1e, 0x74, 0x1b, 0xe2, 0xfe, 0x51, 0x97, 0xe3, 0xff,
+	0x60, 0xf7, 0xf2, 0xa9, 0x4a, 0xeb, 0x47, 0x5d, 0xac, 0x33, 0x11, 0xfa, 0x70, 0x78, 0xb8, 0xd5,
+	0xc1, 0xc2, 0x9f, 0xab, 0xba, 0x5c, 0x22, 0x15, 0x96, 0xba, 0xc4, 0xb3, 0x58, 0x7c, 0xf1, 0xbb,
+	0x1b, 0xac, 0xa9, 0x54, 0x07, 0x7b, 0x9e, 0xd9, 0xc2, 0xe2, 0x91, 0x92, 0x4b, 0xed, 0x4f, 0xa1,
+	0x50, 0x2e, 0xba, 0x98, 0x7a, 0x5e, 0xfa, 0x79, 0x03, 0x56, 0x3c, 0xdf, 0x74, 0x7d, 0xa3, 0x8d,
+	0xad, 0x56, 0x9b, 0xd7, 0xaf, 0x98, 0x9e, 0x60, 0xb4, 0xfb, 0x8c, 0x84, 0x2e, 0x02, 0x60, 0xa7,
+	0x29, 0x19, 0xe6, 0x19, 0xc3, 0x32, 0x76, 0x9a, 0x62, 0x3b, 0x9c, 0x71, 0xb1, 0xaf, 0x9d, 0x71,
+	0x7f, 0x97, 0xaf, 0x69, 0x18, 0xa6, 0xb8, 0xe0, 0xef, 0x05, 0x53, 0x2e, 0xb1, 0x9b, 0x89, 0x72,
+	0xa8, 0x90, 0xa1, 0xf2, 0xb2, 0x5a, 0xbf, 0xd7, 0xcc, 0xac, 0x41, 0x22, 0x00, 0xe1, 0x1d, 0x5b,
+	0x90, 0x73, 0xb0, 0x28, 0x3c, 0x1c, 0x63, 0x1e, 0x16, 0x2b, 0xed, 0x07, 0x90, 0x66, 0x5e, 0xd9,
+	0xff, 0x89, 0x83, 0x5d, 0xaf, 0x6d, 0x75, 0x8b, 0x6d, 0xd3, 0xb6, 0xb1, 0xd3, 0x9a, 0x9a, 0xf8,
+	0xb4, 0x42, 0x11, 0xa7, 0x81, 0x85, 0x15, 0xbe, 0xd0, 0x5e, 0x2b, 0x90, 0x99, 0xa8, 0x4c, 0x38,
+	0xba, 0x0a, 0xcb, 0x0d, 0x49, 0x14, 0xaf, 0xcf, 0xf6, 0xa4, 0xe8, 0x1d, 0x57, 0x23, 0xfc, 0x3e,
+	0x54, 0x41, 0xa3, 0xc7, 0xb3, 0x5a, 0x8e, 0x71, 0x78, 0xe4, 0x63, 0x7e, 0xe8, 0x15, 0x7d, 0x99,
+	0x52, 0xee, 0x50, 0x82, 0x76, 0x5d, 0xa4, 0x74, 0xb1, 0x6d, 0xd9, 0xcd, 0x4f, 0x7a, 0xc4, 0x37,
+	0xa7, 0x55, 0x80, 0x5f, 0x2a, 0x70, 0x7e, 0x8c, 0x7d, 0xac, 0xc9, 0x19, 0xba, 0x61, 0x03, 0x56,
+	0x3a, 0xe6, 0x33, 0xa3, 0x41, 0xb9, 0x5d, 0xec, 0xc8, 0xfa, 0xd4, 0x31, 0x9f, 0x15, 0x05, 0x09,
+	0x65, 0x20, 0xc1, 0xb6, 0x45, 0x05, 0xe3, 0xbd, 0x02, 0x30, 0xd2, 0xa0, 0x80, 0xb9, 0x98, 0x56,
+	0x4d, 0xcb, 0x69, 0xb1, 0xd4, 0x8a, 0xeb, 0x43, 0xc2, 0xf6, 0x5f, 0xe2, 0xf0, 0xe1, 0x58, 0x22,
+	0xa3, 0x02, 0x64, 0xaa, 0x85, 0x07, 0x25, 0xe3, 0x51, 0x79, 0xbf, 0x52, 0xa8, 0x97, 0xf7, 0xab,
+	0x46, 0xfd, 0xd3, 0x83, 0x92, 0xf1, 0xb0, 0x5a, 0x3b, 0x28, 0x15, 0xcb, 0x77, 0xcb, 0xa5, 0xbd,
+	0xe4, 0x9c, 0xba, 0x7e, 0x7c, 0x92, 0x4d, 0x85, 0x64, 0x1f, 0x3a, 0x5e, 0x17, 0x37, 0xac, 0x27,
+	0x16, 0x6e, 0xa2, 0x1f, 0xc2, 0xe5, 0x28, 0x15, 0xb5, 0xd2, 0xbd, 0x07, 0xa5, 0x6a, 0xdd, 0xa8,
+	0xef, 0xef, 0x1b, 0xb5, 0xfb, 0xfb, 0x7a, 0x3d, 0xa9, 0xa8, 0xd9, 0xe3, 0x93, 0xec, 0x7a, 0x48,
+	0x51, 0x8d, 0x17, 0x8c, 0x3a, 0x21, 0xb5, 0x36, 0x71, 0x7d, 0x54, 0x86, 0x4b, 0xa7, 0x29, 0xab,
+	0xec, 0x57, 0xef, 0x25, 0xe7, 0xd5, 0xcc, 0xf1, 0x49, 0xf6, 0xc2, 0x04, 0x5d, 0x15, 0xe2, 0xb4,
+	0x50, 0x25, 0x1a, 0x57, 0xb9, 0xfa, 0xa8, 0x50, 0x29, 0xef, 0x19, 0xc5, 0xfb, 0x05, 0xbd, 0x50,
+	0xac, 0x97, 0xf4, 0x64, 0x4c, 0xdd, 0x38, 0x3e, 0xc9, 0x5e, 0x0c, 0xe9, 0x2a, 0x3b, 0xac, 0xf0,
+	0x16, 0xdb, 0xa6, 0x6b, 0x36, 0x7c, 0xec, 0xa2, 0x7b, 0xf0, 0x71, 0x94, 0x36, 0x0a, 0xe8, 0x41,
+	0xa1, 0xfa, 0xa9, 0xb1, 0x57, 0xa8, 0xdd, 0x2f, 0xd5, 0x92, 0x71, 0x35, 0x7d, 0x7c, 0x92, 0x55,
+	0xc3, 0x8e, 0x26, 0x84, 0x4e, 0x11, 0x7b, 0xa6, 0xd7, 0xc6, 0xde, 0xa9, 0x8a, 0x2a, 0xa5, 0x47,
+	0xa5, 0x4a, 0x2d, 0xb9, 0x30, 0x59, 0x51, 0x05, 0xf7, 0xb1, 0xed, 0xa1, 0x3a, 0x6c, 0x4f, 0x55,
+	0x44, 0x1b, 0x01, 0xe9, 0xb8, 0x5a, 0x72, 0x51, 0xbd, 0x74, 0x7c, 0x92, 0xcd, 0x46, 0xe9, 0xa3,
+	0x8c, 0xc2, 0x77, 0x9e, 0x1a, 0x7f, 0xf5, 0xc7, 0xf4, 0xdc, 0xee, 0x67, 0x1f, 0xc0, 0x02, 0x0b,
+	0x5f, 0xf4, 0x12, 0x16, 0xf9, 0xa0, 0x87, 0xae, 0x44, 0x25, 0xd7, 0xf8, 0x4c, 0xa9, 0x6e, 0x9e,
+	0xca, 0xc7, 0xf3, 0x40, 0xd3, 0x7e, 0xfa, 0xcf, 0xff, 0xfe, 0x66, 0x7e, 0x1d, 0xa9, 0xf9, 0xa8,
+	0xd9, 0x95, 0x1b, 0x7d, 0xa5, 0xc0, 0x19, 0x31, 0x2f, 0xa1, 0xc9, 0x8a, 0xc3, 0xd3, 0xa6, 0xba,
+	0x75, 0x3a, 0xa3, 0x80, 0xb0, 0xcd, 0x20, 0x5c, 0x42, 0x5a, 0x14, 0x04, 0x97, 0x33, 0xe7, 0x5f,
+	0x50, 0xc2, 0x4b, 0xf4, 0x6b, 0x05, 0x12, 0x81, 0xa1, 0x10, 0x5d, 0x3b, 0xcd, 0x4a, 0x60, 0xea,
+	0x54, 0xaf, 0xcf, 0xc6, 0x2c, 0x60, 0x6d, 0x31, 0x58, 0x1a, 0xca, 0x4e, 0x81, 0x65, 0x74, 0x28,
+	0x88, 0xdf, 0x2b, 0xb0, 0x1a, 0x1a, 0xa5, 0xd0, 0x8d, 0x29, 0x96, 0xc6, 0x87, 0x3f, 0x35, 0x37,
+	0x2b, 0xbb, 0x80, 0x76, 0x9d, 0x41, 0xbb, 0x82, 0x2e, 0x45, 0x41, 0xb3, 0x19, 0x6f, 0xfe, 0x85,
+	0x78, 0x2a, 0x5e, 0xa2, 0x9f, 0x29, 0xb0, 0x3c, 0x18, 0x96, 0xd0, 0xd5, 0x89, 0xb6, 0x46, 0x47,
+	0x2d, 0x75, 0x7b, 0x16, 0x56, 0x01, 0x69, 0x83, 0x41, 0xba, 0x80, 0x3e, 0x8a, 0x82, 0xe4, 0x31,
+	0xcb, 0x7f, 0x56, 0x20, 0x39, 0x3a, 0x5d, 0xa0, 0x9b, 0x93, 0x03, 0x35, 0x7a, 0xe2, 0x51, 0x77,
+	0xde, 0x41, 0x42, 0x80, 0xbb, 0xc1, 0xc0, 0x6d, 0xa2, 0xcb, 0x91, 0x41, 0x3e, 0x3a, 0xd4, 0xa0,
+	0x5f, 0x29, 0x90, 0x08, 0x34, 0xf2, 0x53, 0x82, 0x6c, 0x7c, 0xcc, 0x50, 0xaf, 0xcf, 0xc6, 0x2c,
+	0x90, 0x6d, 0x32, 0x64, 0x1b, 0x28, 0x13, 0x85, 0x8c, 0x8e, 0x28, 0xf9, 0x17, 0xf4, 0xf7, 0x25,
+	0xfa, 0x85, 0x02, 0x4b, 0xb2, 0xdb, 0x46, 0x93, 0x73, 0x6b, 0xa4, 0xcd, 0x57, 0xaf, 0xce, 0xc0,
+	0x39, 0x4b, 0x50, 0x0d, 0x9a, 0x7a, 0x99, 0x88, 0xaf, 0x69, 0x50, 0xc9, 0x8e, 0x79, 0x5a, 0x50,
+	0x8d, 0xb4, 0xea, 0xea, 0xf6, 0x2c, 0xac, 0xb3, 0x40, 0x1a, 0xf4, 0xeb, 0x12, 0xd2, 0x67, 0x0a,
+	0xac, 0x04, 0xbb, 0x41, 0x74, 0xca, 0x55, 0x84, 0x7b, 0x5b, 0xf5, 0xc6, 0x8c, 0xdc, 0x02, 0xdb,
+	0x55, 0x86, 0xed, 0x63, 0xb4, 0x91, 0x9f, 0xf0, 0x9f, 0xa0, 0x67, 0x34, 0x04, 0x8e, 0xbf, 0x29,
+	0x80, 0xc6, 0x9b, 0x1f, 0xb4, 0x3b, 0xd1, 0xe0, 0xc4, 0xee, 0x4d, 0xbd, 0xf5, 0x4e, 0x32, 0x02,
+	0xea, 0xb7, 0x19, 0xd4, 0x9b, 0x28, 0x17, 0x05, 0x95, 0x48, 0x39, 0x63, 0xd0, 0x85, 0x49, 0x87,
+	0xfe, 0x56, 0x01, 0x18, 0xb6, 0x4e, 0x68, 0xf2, 0xcd, 0x8d, 0xb5, 0x63, 0xea, 0xb5, 0x99, 0x78,
+	0x05, 0xbe, 0x1c, 0xc3, 0xb7, 0x85, 0xae, 0x44, 0xe1, 0xe3, 0xed, 0xd6, 0x53, 0x2a, 0x20, 0x70,
+	0xdd, 0x69, 0x7c, 0xf9, 0x26, 0xad, 0x7c, 0xf5, 0x26, 0xad, 0xfc, 0xe7, 0x4d, 0x5a, 0x79, 0xfd,
+	0x36, 0x3d, 0xf7, 0xd5, 0xdb, 0xf4, 0xdc, 0xbf, 0xde, 0xa6, 0xe7, 0xe0, 0xac, 0x45, 0x22, 0x0c,
+	0x1f, 0x28, 0x8f, 0x6f, 0xb6, 0x2c, 0xbf, 0xdd, 0x3b, 0xcc, 0x35, 0x48, 0x27, 0x60, 0xe4, 0x86,
+	0x45, 0x82, 0x26, 0x9f, 0x71, 0xa3, 0x74, 0xac, 0xf2, 0x0e, 0x17, 0xd9, 0x5f, 0xb6, 0xb7, 0xfe,
+	0x3f, 0x00, 0x64, 0xf2, 0x4e, 0xaa, 0x87, 0x16, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Params queries params of the name module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Resolve queries for the address associated with a given name
+	Resolve(ctx context.Context, in *QueryResolveRequest, opts ...grpc.CallOption) (*QueryResolveResponse, error)
+	// ResolveMany queries for the addresses associated with several names at once.
+	ResolveMany(ctx context.Context, in *QueryResolveManyRequest, opts ...grpc.CallOption) (*QueryResolveManyResponse, error)
+	// ReverseLookup queries for all names bound against a given address
+	ReverseLookup(ctx context.Context, in *QueryReverseLookupRequest, opts ...grpc.CallOption) (*QueryReverseLookupResponse, error)
+	// NameStats queries for the number of bound names, in total, by restriction, and under each root name.
+	NameStats(ctx context.Context, in *QueryNameStatsRequest, opts ...grpc.CallOption) (*QueryNameStatsResponse, error)
+	// PendingDeletions queries for the names that have been deleted, but have not yet been removed.
+	PendingDeletions(ctx context.Context, in *QueryPendingDeletionsRequest, opts ...grpc.CallOption) (*QueryPendingDeletionsResponse, error)
+	// NamesByUUID queries for the names that contain a given UUID as one of their segments.
+	NamesByUUID(ctx context.Context, in *QueryNamesByUUIDRequest, opts ...grpc.CallOption) (*QueryNamesByUUIDResponse, error)
+	// ZoneFile renders a name and the names under it as an RFC 1035 zone file.
+	// Each name is a subdomain of the zone's origin and has a TXT record with the address it resolves to.
+	ZoneFile(ctx context.Context, in *QueryZoneFileRequest, opts ...grpc.CallOption) (*QueryZoneFileResponse, error)
+	// Normalize returns the normalized form of a candidate name and every naming rule that it violates.
+	// It's a dry run of the validation done when binding a name, so names can be checked before submitting a tx.
+	// The name does not need to be bound (or bindable), and no state is changed.
+	Normalize(ctx context.Context, in *QueryNormalizeRequest, opts ...grpc.CallOption) (*QueryNormalizeResponse, error)
+	// NamesCreated queries for the names that were bound within a range of block heights.
+	// Only names bound within the last created_names_retention_blocks blocks (a name param) are available.
+	NamesCreated(ctx context.Context, in *QueryNamesCreatedRequest, opts ...grpc.CallOption) (*QueryNamesCreatedResponse, error)
+	// OwnershipChallenge returns the canonical payload that a name's owner signs to prove (off-chain) that they own it.
+	// The payload has the name, the address it resolves to, the chain id, and the current block height.
+	OwnershipChallenge(ctx context.Context, in *QueryOwnershipChallengeRequest, opts ...grpc.CallOption) (*QueryOwnershipChallengeResponse, error)
+	// ChildQuota queries for the child quota of a name and how much of it is used.
+	ChildQuota(ctx context.Context, in *QueryChildQuotaRequest, opts ...grpc.CallOption) (*QueryChildQuotaResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/provenance.name.v1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Resolve(ctx context.Context, in *QueryResolveRequest, opts ...grpc.CallOption) (*QueryResolveResponse, error) {
+	out := new(QueryResolveResponse)
+	err := c.cc.Invoke(ctx, "/provenance.name.v1.Query/Resolve", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ResolveMany(ctx context.Context, in *QueryResolveManyRequest, opts ...grpc.CallOption) (*QueryResolveManyResponse, error) {
+	out := new(QueryResolveManyResponse)
+	err := c.cc.Invoke(ctx, "/provenance.name.v1.Query/ResolveMany", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ReverseLookup(ctx context.Context, in *QueryReverseLookupRequest, opts ...grpc.CallOption) (*QueryReverseLookupResponse, error) {
+	out := new(QueryReverseLookupResponse)
+	err := c.cc.Invoke(ctx, "/provenance.name.v1.Query/ReverseLookup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) NameStats(ctx context.Context, in *QueryNameStatsRequest, opts ...grpc.CallOption) (*QueryNameStatsResponse, error) {
+	out := new(QueryNameStatsResponse)
+	err := c.cc.Invoke(ctx, "/provenance.name.v1.Query/NameStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PendingDeletions(ctx context.Context, in *QueryPendingDeletionsRequest, opts ...grpc.CallOption) (*QueryPendingDeletionsResponse, error) {
+	out := new(QueryPendingDeletionsResponse)
+	err := c.cc.Invoke(ctx, "/provenance.name.v1.Query/PendingDeletions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) NamesByUUID(ctx context.Context, in *QueryNamesByUUIDRequest, opts ...grpc.CallOption) (*QueryNamesByUUIDResponse, error) {
+	out := new(QueryNamesByUUIDResponse)
+	err := c.cc.Invoke(ctx, "/provenance.name.v1.Query/NamesByUUID", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ZoneFile(ctx context.Context, in *QueryZoneFileRequest, opts ...grpc.CallOption) (*QueryZoneFileResponse, error) {
+	out := new(QueryZoneFileResponse)
+	err := c.cc.Invoke(ctx, "/provenance.name.v1.Query/ZoneFile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Normalize(ctx context.Context, in *QueryNormalizeRequest, opts ...grpc.CallOption) (*QueryNormalizeResponse, error) {
+	out := new(QueryNormalizeResponse)
+	err := c.cc.Invoke(ctx, "/provenance.name.v1.Query/Normalize", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) NamesCreated(ctx context.Context, in *QueryNamesCreatedRequest, opts ...grpc.CallOption) (*QueryNamesCreatedResponse, error) {
+	out := new(QueryNamesCreatedResponse)
+	err := c.cc.Invoke(ctx, "/provenance.name.v1.Query/NamesCreated", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) OwnershipChallenge(ctx context.Context, in *QueryOwnershipChallengeRequest, opts ...grpc.CallOption) (*QueryOwnershipChallengeResponse, error) {
+	out := new(QueryOwnershipChallengeResponse)
+	err := c.cc.Invoke(ctx, "/provenance.name.v1.Query/OwnershipChallenge", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ChildQuota(ctx context.Context, in *QueryChildQuotaRequest, opts ...grpc.CallOption) (*QueryChildQuotaResponse, error) {
+	out := new(QueryChildQuotaResponse)
+	err := c.cc.Invoke(ctx, "/provenance.name.v1.Query/ChildQuota", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Params queries params of the name module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Resolve queries for the address associated with a given name
+	Resolve(context.Context, *QueryResolveRequest) (*QueryResolveResponse, error)
+	// ResolveMany queries for the addresses associated with several names at once.
+	ResolveMany(context.Context, *QueryResolveManyRequest) (*QueryResolveManyResponse, error)
+	// ReverseLookup queries for all names bound against a given address
+	ReverseLookup(context.Context, *QueryReverseLookupRequest) (*QueryReverseLookupResponse, error)
+	// NameStats queries for the number of bound names, in total, by restriction, and under each root name.
+	NameStats(context.Context, *QueryNameStatsRequest) (*QueryNameStatsResponse, error)
+	// PendingDeletions queries for the names that have been deleted, but have not yet been removed.
+	PendingDeletions(context.Context, *QueryPendingDeletionsRequest) (*QueryPendingDeletionsResponse, error)
+	// NamesByUUID queries for the names that contain a given UUID as one of their segments.
+	NamesByUUID(context.Context, *QueryNamesByUUIDRequest) (*QueryNamesByUUIDResponse, error)
+	// ZoneFile renders a name and the names under it as an RFC 1035 zone file.
+	// Each name is a subdomain of the zone's origin and has a TXT record with the address it resolves to.
+	ZoneFile(context.Context, *QueryZoneFileRequest) (*QueryZoneFileResponse, error)
+	// Normalize returns the normalized form of a candidate name and every naming rule that it violates.
+	// It's a dry run of the validation done when binding a name, so names can be checked before submitting a tx.
+	// The name does not need to be bound (or bindable), and no state is changed.
+	Normalize(context.Context, *QueryNormalizeRequest) (*QueryNormalizeResponse, error)
+	// NamesCreated queries for the names that were bound within a range of block heights.
+	// Only names bound within the last created_names_retention_blocks blocks (a name param) are available.
+	NamesCreated(context.Context, *QueryNamesCreatedRequest) (*QueryNamesCreatedResponse, error)
+	// OwnershipChallenge returns the canonical payload that a name's owner signs to prove (off-chain) that they own it.
+	// The payload has the name, the address it resolves to, the chain id, and the current block height.
+	OwnershipChallenge(context.Context, *QueryOwnershipChallengeRequest) (*QueryOwnershipChallengeResponse, error)
+	// ChildQuota queries for the child quota of a name and how much of it is used.
+	ChildQuota(context.Context, *QueryChildQuotaRequest) (*QueryChildQuotaResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) Resolve(ctx context.Context, req *QueryResolveRequest) (*QueryResolveResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Resolve not implemented")
+}
+func (*UnimplementedQueryServer) ResolveMany(ctx context.Context, req *QueryResolveManyRequest) (*QueryResolveManyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveMany not implemented")
+}
+func (*UnimplementedQueryServer) ReverseLookup(ctx context.Context, req *QueryReverseLookupRequest) (*QueryReverseLookupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReverseLookup not implemented")
+}
+func (*UnimplementedQueryServer) NameStats(ctx context.Context, req *QueryNameStatsRequest) (*QueryNameStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NameStats not implemented")
+}
+func (*UnimplementedQueryServer) PendingDeletions(ctx context.Context, req *QueryPendingDeletionsRequest) (*QueryPendingDeletionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingDeletions not implemented")
+}
+func (*UnimplementedQueryServer) NamesByUUID(ctx context.Context, req *QueryNamesByUUIDRequest) (*QueryNamesByUUIDResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NamesByUUID not implemented")
+}
+func (*UnimplementedQueryServer) ZoneFile(ctx context.Context, req *QueryZoneFileRequest) (*QueryZoneFileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ZoneFile not implemented")
+}
+func (*UnimplementedQueryServer) Normalize(ctx context.Context, req *QueryNormalizeRequest) (*QueryNormalizeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Normalize not implemented")
+}
+func (*UnimplementedQueryServer) NamesCreated(ctx context.Context, req *QueryNamesCreatedRequest) (*QueryNamesCreatedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NamesCreated not implemented")
+}
+func (*UnimplementedQueryServer) OwnershipChallenge(ctx context.Context, req *QueryOwnershipChallengeRequest) (*QueryOwnershipChallengeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OwnershipChallenge not implemented")
+}
+func (*UnimplementedQueryServer) ChildQuota(ctx context.Context, req *QueryChildQuotaRequest) (*QueryChildQuotaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChildQuota not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.name.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Resolve_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryResolveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Resolve(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.name.v1.Query/Resolve",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Resolve(ctx, req.(*QueryResolveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ResolveMany_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryResolveManyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ResolveMany(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.name.v1.Query/ResolveMany",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ResolveMany(ctx, req.(*QueryResolveManyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ReverseLookup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryReverseLookupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ReverseLookup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.name.v1.Query/ReverseLookup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ReverseLookup(ctx, req.(*QueryReverseLookupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_NameStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNameStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).NameStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.name.v1.Query/NameStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).NameStats(ctx, req.(*QueryNameStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PendingDeletions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingDeletionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingDeletions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.name.v1.Query/PendingDeletions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingDeletions(ctx, req.(*QueryPendingDeletionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_NamesByUUID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNamesByUUIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).NamesByUUID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.name.v1.Query/NamesByUUID",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).NamesByUUID(ctx, req.(*QueryNamesByUUIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ZoneFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryZoneFileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ZoneFile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.name.v1.Query/ZoneFile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ZoneFile(ctx, req.(*QueryZoneFileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Normalize_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNormalizeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Normalize(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.name.v1.Query/Normalize",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Normalize(ctx, req.(*QueryNormalizeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_NamesCreated_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNamesCreatedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).NamesCreated(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.name.v1.Query/NamesCreated",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).NamesCreated(ctx, req.(*QueryNamesCreatedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_OwnershipChallenge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOwnershipChallengeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).OwnershipChallenge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.name.v1.Query/OwnershipChallenge",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OwnershipChallenge(ctx, req.(*QueryOwnershipChallengeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ChildQuota_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryChildQuotaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ChildQuota(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.name.v1.Query/ChildQuota",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ChildQuota(ctx, req.(*QueryChildQuotaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "provenance.name.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "Resolve",
+			Handler:    _Query_Resolve_Handler,
+		},
+		{
+			MethodName: "ResolveMany",
+			Handler:    _Query_ResolveMany_Handler,
+		},
+		{
+			MethodName: "ReverseLookup",
+			Handler:    _Query_ReverseLookup_Handler,
+		},
+		{
+			MethodName: "NameStats",
+			Handler:    _Query_NameStats_Handler,
+		},
+		{
+			MethodName: "PendingDeletions",
+			Handler:    _Query_PendingDeletions_Handler,
+		},
+		{
+			MethodName: "NamesByUUID",
+			Handler:    _Query_NamesByUUID_Handler,
+		},
+		{
+			MethodName: "ZoneFile",
+			Handler:    _Query_ZoneFile_Handler,
+		},
+		{
+			MethodName: "Normalize",
+			Handler:    _Query_Normalize_Handler,
+		},
+		{
+			MethodName: "NamesCreated",
+			Handler:    _Query_NamesCreated_Handler,
+		},
+		{
+			MethodName: "OwnershipChallenge",
+			Handler:    _Query_OwnershipChallenge_Handler,
+		},
+		{
+			MethodName: "ChildQuota",
+			Handler:    _Query_ChildQuota_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "provenance/name/v1/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryResolveRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryResolveRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryResolveRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryResolveResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryResolveResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryResolveResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Restricted {
+		i--
+		if m.Restricted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryResolveManyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryResolveManyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryResolveManyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Names) > 0 {
+		for iNdEx := len(m.Names) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Names[iNdEx])
+			copy(dAtA[i:], m.Names[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Names[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryResolveManyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryResolveManyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryResolveManyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Results[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ResolveResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResolveResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResolveResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Restricted {
+		i--
+		if m.Restricted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryReverseLookupRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryReverseLookupRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryReverseLookupRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryReverseLookupResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryReverseLookupResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryReverseLookupResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Truncated {
+		i--
+		if m.Truncated {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		for iNdEx := len(m.Name) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Name[iNdEx])
+			copy(dAtA[i:], m.Name[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Name[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNameStatsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNameStatsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNameStatsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNameStatsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNameStatsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNameStatsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Roots) > 0 {
+		for iNdEx := len(m.Roots) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Roots[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Unrestricted != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Unrestricted))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Restricted != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Restricted))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Total != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Total))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RootNameCount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RootNameCount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RootNameCount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Count != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Root) > 0 {
+		i -= len(m.Root)
+		copy(dAtA[i:], m.Root)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Root)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingDeletionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingDeletionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingDeletionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingDeletionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingDeletionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingDeletionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PendingDeletions) > 0 {
+		for iNdEx := len(m.PendingDeletions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingDeletions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNamesByUUIDRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNamesByUUIDRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNamesByUUIDRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Uuid) > 0 {
+		i -= len(m.Uuid)
+		copy(dAtA[i:], m.Uuid)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Uuid)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNamesByUUIDResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNamesByUUIDResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNamesByUUIDResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Truncated {
+		i--
+		if m.Truncated {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Names) > 0 {
+		for iNdEx := len(m.Names) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Names[iNdEx])
+			copy(dAtA[i:], m.Names[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Names[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryZoneFileRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryZoneFileRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryZoneFileRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Ttl != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Ttl))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Domain) > 0 {
+		i -= len(m.Domain)
+		copy(dAtA[i:], m.Domain)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Domain)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryZoneFileResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryZoneFileResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryZoneFileResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Truncated {
+		i--
+		if m.Truncated {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.RecordCount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.RecordCount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ZoneFile) > 0 {
+		i -= len(m.ZoneFile)
+		copy(dAtA[i:], m.ZoneFile)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ZoneFile)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNormalizeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNormalizeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNormalizeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNormalizeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNormalizeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNormalizeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Violations) > 0 {
+		for iNdEx := len(m.Violations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Violations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Valid {
+		i--
+		if m.Valid {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Normalized) > 0 {
+		i -= len(m.Normalized)
+		copy(dAtA[i:], m.Normalized)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Normalized)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *NameViolation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NameViolation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NameViolation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Message) > 0 {
+		i -= len(m.Message)
+		copy(dAtA[i:], m.Message)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Message)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Position != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Position))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Segment != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Segment))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Type != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNamesCreatedRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNamesCreatedRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNamesCreatedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.EndHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EndHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNamesCreatedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNamesCreatedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNamesCreatedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Truncated {
+		i--
+		if m.Truncated {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Names) > 0 {
+		for iNdEx := len(m.Names) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Names[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CreatedName) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CreatedName) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CreatedName) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOwnershipChallengeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOwnershipChallengeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOwnershipChallengeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Nonce) > 0 {
+		i -= len(m.Nonce)
+		copy(dAtA[i:], m.Nonce)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Nonce)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOwnershipChallengeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOwnershipChallengeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOwnershipChallengeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SignBytes) > 0 {
+		i -= len(m.SignBytes)
+		copy(dAtA[i:], m.SignBytes)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SignBytes)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Challenge.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryChildQuotaRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryChildQuotaRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryChildQuotaRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryChildQuotaResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryChildQuotaResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryChildQuotaResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Remaining != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Remaining))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ChildCount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ChildCount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MaxChildren != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaxChildren))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryResolveRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryResolveResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Restricted {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryResolveManyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Names) > 0 {
+		for _, s := range m.Names {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryResolveManyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for _, e := range m.Results {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ResolveResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Restricted {
+		n += 2
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryReverseLookupRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryReverseLookupResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		for _, s := range m.Name {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Truncated {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryNameStatsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryNameStatsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Total != 0 {
+		n += 1 + sovQuery(uint64(m.Total))
+	}
+	if m.Restricted != 0 {
+		n += 1 + sovQuery(uint64(m.Restricted))
+	}
+	if m.Unrestricted != 0 {
+		n += 1 + sovQuery(uint64(m.Unrestricted))
+	}
+	if len(m.Roots) > 0 {
+		for _, e := range m.Roots {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RootNameCount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Root)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Count != 0 {
+		n += 1 + sovQuery(uint64(m.Count))
+	}
+	return n
+}
+
+func (m *QueryPendingDeletionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPendingDeletionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PendingDeletions) > 0 {
+		for _, e := range m.PendingDeletions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryNamesByUUIDRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Uuid)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryNamesByUUIDResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Names) > 0 {
+		for _, s := range m.Names {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Truncated {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryZoneFileRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Domain)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Ttl != 0 {
+		n += 1 + sovQuery(uint64(m.Ttl))
+	}
+	return n
+}
+
+func (m *QueryZoneFileResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ZoneFile)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.RecordCount != 0 {
+		n += 1 + sovQuery(uint64(m.RecordCount))
+	}
+	if m.Truncated {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryNormalizeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryNormalizeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Normalized)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Valid {
+		n += 2
+	}
+	if len(m.Violations) > 0 {
+		for _, e := range m.Violations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *NameViolation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovQuery(uint64(m.Type))
+	}
+	if m.Segment != 0 {
+		n += 1 + sovQuery(uint64(m.Segment))
+	}
+	if m.Position != 0 {
+		n += 1 + sovQuery(uint64(m.Position))
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryNamesCreatedRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StartHeight != 0 {
+		n += 1 + sovQuery(uint64(m.StartHeight))
+	}
+	if m.EndHeight != 0 {
+		n += 1 + sovQuery(uint64(m.EndHeight))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryNamesCreatedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Names) > 0 {
+		for _, e := range m.Names {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Truncated {
+		n += 2
+	}
+	return n
+}
+
+func (m *CreatedName) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *QueryOwnershipChallengeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Nonce)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOwnershipChallengeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Challenge.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.SignBytes)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryChildQuotaRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryChildQuotaResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.MaxChildren != 0 {
+		n += 1 + sovQuery(uint64(m.MaxChildren))
+	}
+	if m.ChildCount != 0 {
+		n += 1 + sovQuery(uint64(m.ChildCount))
+	}
+	if m.Remaining != 0 {
+		n += 1 + sovQuery(uint64(m.Remaining))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryResolveRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryResolveRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryResolveRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryResolveResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryResolveResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryResolveResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Restricted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Restricted = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryResolveManyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryResolveManyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryResolveManyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Names", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Names = append(m.Names, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryResolveManyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryResolveManyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryResolveManyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Results = append(m.Results, ResolveResult{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResolveResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResolveResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResolveResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Restricted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Restricted = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryReverseLookupRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryReverseLookupRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryReverseLookupRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryReverseLookupResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryReverseLookupResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryReverseLookupResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = append(m.Name, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Truncated", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Truncated = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryNameStatsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNameStatsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNameStatsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryNameStatsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNameStatsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNameStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			}
+			m.Total = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Total |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Restricted", wireType)
+			}
+			m.Restricted = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Restricted |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unrestricted", wireType)
+			}
+			m.Unrestricted = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Unrestricted |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Roots", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Roots = append(m.Roots, RootNameCount{})
+			if err := m.Roots[len(m.Roots)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RootNameCount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RootNameCount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RootNameCount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Root", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Root = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingDeletionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingDeletionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingDeletionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingDeletionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingDeletionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingDeletionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingDeletions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingDeletions = append(m.PendingDeletions, PendingNameDeletion{})
+			if err := m.PendingDeletions[len(m.PendingDeletions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryNamesByUUIDRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNamesByUUIDRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNamesByUUIDRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uuid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Uuid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryNamesByUUIDResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNamesByUUIDResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNamesByUUIDResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Names", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Names = append(m.Names, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Truncated", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Truncated = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryZoneFileRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryZoneFileRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryZoneFileRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Domain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ttl", wireType)
+			}
+			m.Ttl = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Ttl |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryZoneFileResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryZoneFileResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryZoneFileResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ZoneFile", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ZoneFile = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordCount", wireType)
+			}
+			m.RecordCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RecordCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Truncated", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Truncated = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryNormalizeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNormalizeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNormalizeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryNormalizeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNormalizeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNormalizeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Normalized", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Normalized = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Valid", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Valid = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Violations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Violations = append(m.Violations, NameViolation{})
+			if err := m.Violations[len(m.Violations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NameViolation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NameViolation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NameViolation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= NameViolationType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Segment", wireType)
+			}
+			m.Segment = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Segment |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Position", wireType)
+			}
+			m.Position = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Position |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryNamesCreatedRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNamesCreatedRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNamesCreatedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+			}
+			m.EndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryNamesCreatedResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNamesCreatedResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNamesCreatedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Names", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Names = append(m.Names, CreatedName{})
+			if err := m.Names[len(m.Names)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Truncated", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Truncated = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -861,7 +6467,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *CreatedName) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -884,17 +6490,17 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: CreatedName: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CreatedName: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -904,25 +6510,75 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -944,7 +6600,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryResolveRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryOwnershipChallengeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -967,10 +6623,10 @@ func (m *QueryResolveRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryResolveRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryOwnershipChallengeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryResolveRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryOwnershipChallengeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1005,6 +6661,38 @@ func (m *QueryResolveRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Nonce = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1026,7 +6714,7 @@ func (m *QueryResolveRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryResolveResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryOwnershipChallengeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1049,17 +6737,17 @@ func (m *QueryResolveResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryResolveResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryOwnershipChallengeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryResolveResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryOwnershipChallengeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Challenge", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1069,29 +6757,30 @@ func (m *QueryResolveResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			if err := m.Challenge.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Restricted", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignBytes", wireType)
 			}
-			var v int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1101,12 +6790,26 @@ func (m *QueryResolveResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Restricted = bool(v != 0)
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SignBytes = append(m.SignBytes[:0], dAtA[iNdEx:postIndex]...)
+			if m.SignBytes == nil {
+				m.SignBytes = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1128,7 +6831,7 @@ func (m *QueryResolveResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryReverseLookupRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryChildQuotaRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1151,15 +6854,15 @@ func (m *QueryReverseLookupRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryReverseLookupRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryChildQuotaRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryReverseLookupRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryChildQuotaRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1187,43 +6890,7 @@ func (m *QueryReverseLookupRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1246,7 +6913,7 @@ func (m *QueryReverseLookupRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryReverseLookupResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryChildQuotaResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1269,10 +6936,10 @@ func (m *QueryReverseLookupResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryReverseLookupResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryChildQuotaResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryReverseLookupResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryChildQuotaResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1305,13 +6972,13 @@ func (m *QueryReverseLookupResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = append(m.Name, string(dAtA[iNdEx:postIndex]))
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxChildren", wireType)
 			}
-			var msglen int
+			m.MaxChildren = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1321,28 +6988,49 @@ func (m *QueryReverseLookupResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.MaxChildren |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChildCount", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.ChildCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChildCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Remaining", wireType)
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Remaining = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Remaining |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -0,0 +1,37 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// AddressRotator is implemented by modules that have state tied to an address that should
+// be moved to a new address when the address is rotated (see MsgRotateAddressRequest).
+type AddressRotator interface {
+	// RotateAddress moves the module's state tied to the old address to the new address.
+	// It is called after the names of the old address have been re-bound to the new address.
+	// If an error is returned, the whole rotation fails.
+	RotateAddress(ctx sdk.Context, oldAddr, newAddr sdk.AccAddress) error
+}
+
+// ValidateAddressRotation returns an error if the provided addresses cannot be used in an address rotation.
+func ValidateAddressRotation(oldAddress, newAddress string) error {
+	oldAddr, err := sdk.AccAddressFromBech32(oldAddress)
+	if err != nil {
+		return fmt.Errorf("invalid old address: %w", err)
+	}
+	newAddr, err := sdk.AccAddressFromBech32(newAddress)
+	if err != nil {
+		return fmt.Errorf("invalid new address: %w", err)
+	}
+	if oldAddr.Equals(newAddr) {
+		return fmt.Errorf("old address and new address cannot be the same: %s", oldAddress)
+	}
+	return nil
+}
+
+// Validate returns an error if this approval has invalid addresses.
+func (a AddressRotationApproval) Validate() error {
+	return ValidateAddressRotation(a.OldAddress, a.NewAddress)
+}
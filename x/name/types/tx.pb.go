@@ -7,7 +7,10 @@ import (
 	context "context"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -32,12 +35,16 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // MsgBindNameRequest defines an sdk.Msg type that is used to add an address/name binding under an optional parent name.
 // The record may optionally be restricted to prevent additional names from being added under this one without the
-// owner signing the request.
+// owner signing the request (or providing a parent approval).
 type MsgBindNameRequest struct {
 	// The parent record to bind this name under.
 	Parent NameRecord `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent"`
 	// The name record to bind under the parent
 	Record NameRecord `protobuf:"bytes,2,opt,name=record,proto3" json:"record"`
+	// parent_approval is an approval from the owner of a restricted parent name to bind the record under it.
+	// It allows a name to be bound under a restricted parent without the parent's owner signing the tx.
+	// The parent address must be the requestor the approval was signed for.
+	ParentApproval *ParentApproval `protobuf:"bytes,3,opt,name=parent_approval,json=parentApproval,proto3" json:"parent_approval,omitempty"`
 }
 
 func (m *MsgBindNameRequest) Reset()         { *m = MsgBindNameRequest{} }
@@ -188,6 +195,120 @@ func (m *MsgDeleteNameResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgDeleteNameResponse proto.InternalMessageInfo
 
+// MsgDeleteNamesRequest defines an sdk.Msg type that is used to remove an existing address/name binding.
+// If recursive is true, all names under the name are removed too, regardless of who they are bound to.
+// If recursive is false, the name may not have any child names currently bound.
+// All associated attributes on account addresses will be deleted.
+type MsgDeleteNamesRequest struct {
+	// owner is the address the name is bound to.
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// name is the name to remove.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// recursive is whether to also remove all of the names under the name.
+	// The total number of names removed cannot exceed the max_deletions param.
+	Recursive bool `protobuf:"varint,3,opt,name=recursive,proto3" json:"recursive,omitempty"`
+}
+
+func (m *MsgDeleteNamesRequest) Reset()         { *m = MsgDeleteNamesRequest{} }
+func (m *MsgDeleteNamesRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgDeleteNamesRequest) ProtoMessage()    {}
+func (*MsgDeleteNamesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eacf6cd967218635, []int{4}
+}
+func (m *MsgDeleteNamesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDeleteNamesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDeleteNamesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDeleteNamesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeleteNamesRequest.Merge(m, src)
+}
+func (m *MsgDeleteNamesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDeleteNamesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeleteNamesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDeleteNamesRequest proto.InternalMessageInfo
+
+func (m *MsgDeleteNamesRequest) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *MsgDeleteNamesRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *MsgDeleteNamesRequest) GetRecursive() bool {
+	if m != nil {
+		return m.Recursive
+	}
+	return false
+}
+
+// MsgDeleteNamesResponse defines the Msg/DeleteNames response type.
+type MsgDeleteNamesResponse struct {
+	// deleted_names are the names that were removed.
+	DeletedNames []string `protobuf:"bytes,1,rep,name=deleted_names,json=deletedNames,proto3" json:"deleted_names,omitempty"`
+}
+
+func (m *MsgDeleteNamesResponse) Reset()         { *m = MsgDeleteNamesResponse{} }
+func (m *MsgDeleteNamesResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDeleteNamesResponse) ProtoMessage()    {}
+func (*MsgDeleteNamesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eacf6cd967218635, []int{5}
+}
+func (m *MsgDeleteNamesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDeleteNamesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDeleteNamesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDeleteNamesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeleteNamesResponse.Merge(m, src)
+}
+func (m *MsgDeleteNamesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDeleteNamesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeleteNamesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDeleteNamesResponse proto.InternalMessageInfo
+
+func (m *MsgDeleteNamesResponse) GetDeletedNames() []string {
+	if m != nil {
+		return m.DeletedNames
+	}
+	return nil
+}
+
 // MsgCreateRootNameRequest defines an sdk.Msg type to create a new root name
 // that is controlled by a given owner and optionally restricted to the owner
 // for the sole creation of sub names.
@@ -202,7 +323,7 @@ func (m *MsgCreateRootNameRequest) Reset()         { *m = MsgCreateRootNameReque
 func (m *MsgCreateRootNameRequest) String() string { return proto.CompactTextString(m) }
 func (*MsgCreateRootNameRequest) ProtoMessage()    {}
 func (*MsgCreateRootNameRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eacf6cd967218635, []int{4}
+	return fileDescriptor_eacf6cd967218635, []int{6}
 }
 func (m *MsgCreateRootNameRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -253,7 +374,7 @@ func (m *MsgCreateRootNameResponse) Reset()         { *m = MsgCreateRootNameResp
 func (m *MsgCreateRootNameResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgCreateRootNameResponse) ProtoMessage()    {}
 func (*MsgCreateRootNameResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eacf6cd967218635, []int{5}
+	return fileDescriptor_eacf6cd967218635, []int{7}
 }
 func (m *MsgCreateRootNameResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -282,6 +403,121 @@ func (m *MsgCreateRootNameResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgCreateRootNameResponse proto.InternalMessageInfo
 
+// MsgRemoveNameRequest defines a governance method that is used to remove an existing address/name binding regardless
+// of who the name is bound to or whether it (or its parent) is restricted.
+// If recursive is true, all names under the name are removed too.
+// If recursive is false, the name may not have any child names currently bound.
+// All associated attributes on account addresses will be deleted.
+type MsgRemoveNameRequest struct {
+	// authority should be the governance module account address.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// name is the name to remove.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// recursive is whether to also remove all of the names under the name.
+	// The total number of names removed cannot exceed the max_deletions param.
+	Recursive bool `protobuf:"varint,3,opt,name=recursive,proto3" json:"recursive,omitempty"`
+}
+
+func (m *MsgRemoveNameRequest) Reset()         { *m = MsgRemoveNameRequest{} }
+func (m *MsgRemoveNameRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveNameRequest) ProtoMessage()    {}
+func (*MsgRemoveNameRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eacf6cd967218635, []int{8}
+}
+func (m *MsgRemoveNameRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveNameRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveNameRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveNameRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveNameRequest.Merge(m, src)
+}
+func (m *MsgRemoveNameRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveNameRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveNameRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveNameRequest proto.InternalMessageInfo
+
+func (m *MsgRemoveNameRequest) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgRemoveNameRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *MsgRemoveNameRequest) GetRecursive() bool {
+	if m != nil {
+		return m.Recursive
+	}
+	return false
+}
+
+// MsgRemoveNameResponse defines the Msg/RemoveName response type.
+type MsgRemoveNameResponse struct {
+	// removed_names are the names that were removed.
+	RemovedNames []string `protobuf:"bytes,1,rep,name=removed_names,json=removedNames,proto3" json:"removed_names,omitempty"`
+}
+
+func (m *MsgRemoveNameResponse) Reset()         { *m = MsgRemoveNameResponse{} }
+func (m *MsgRemoveNameResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveNameResponse) ProtoMessage()    {}
+func (*MsgRemoveNameResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eacf6cd967218635, []int{9}
+}
+func (m *MsgRemoveNameResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveNameResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveNameResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveNameResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveNameResponse.Merge(m, src)
+}
+func (m *MsgRemoveNameResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveNameResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveNameResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveNameResponse proto.InternalMessageInfo
+
+func (m *MsgRemoveNameResponse) GetRemovedNames() []string {
+	if m != nil {
+		return m.RemovedNames
+	}
+	return nil
+}
+
 // MsgModifyNameRequest defines a governance method that is used to update an existing address/name binding.
 type MsgModifyNameRequest struct {
 	// The address signing the message
@@ -294,7 +530,7 @@ func (m *MsgModifyNameRequest) Reset()         { *m = MsgModifyNameRequest{} }
 func (m *MsgModifyNameRequest) String() string { return proto.CompactTextString(m) }
 func (*MsgModifyNameRequest) ProtoMessage()    {}
 func (*MsgModifyNameRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eacf6cd967218635, []int{6}
+	return fileDescriptor_eacf6cd967218635, []int{10}
 }
 func (m *MsgModifyNameRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -345,7 +581,7 @@ func (m *MsgModifyNameResponse) Reset()         { *m = MsgModifyNameResponse{} }
 func (m *MsgModifyNameResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgModifyNameResponse) ProtoMessage()    {}
 func (*MsgModifyNameResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eacf6cd967218635, []int{7}
+	return fileDescriptor_eacf6cd967218635, []int{11}
 }
 func (m *MsgModifyNameResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -386,7 +622,7 @@ func (m *MsgUpdateParamsRequest) Reset()         { *m = MsgUpdateParamsRequest{}
 func (m *MsgUpdateParamsRequest) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParamsRequest) ProtoMessage()    {}
 func (*MsgUpdateParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eacf6cd967218635, []int{8}
+	return fileDescriptor_eacf6cd967218635, []int{12}
 }
 func (m *MsgUpdateParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -437,7 +673,7 @@ func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse
 func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParamsResponse) ProtoMessage()    {}
 func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eacf6cd967218635, []int{9}
+	return fileDescriptor_eacf6cd967218635, []int{13}
 }
 func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -466,742 +702,3607 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgBindNameRequest)(nil), "provenance.name.v1.MsgBindNameRequest")
-	proto.RegisterType((*MsgBindNameResponse)(nil), "provenance.name.v1.MsgBindNameResponse")
-	proto.RegisterType((*MsgDeleteNameRequest)(nil), "provenance.name.v1.MsgDeleteNameRequest")
-	proto.RegisterType((*MsgDeleteNameResponse)(nil), "provenance.name.v1.MsgDeleteNameResponse")
-	proto.RegisterType((*MsgCreateRootNameRequest)(nil), "provenance.name.v1.MsgCreateRootNameRequest")
-	proto.RegisterType((*MsgCreateRootNameResponse)(nil), "provenance.name.v1.MsgCreateRootNameResponse")
-	proto.RegisterType((*MsgModifyNameRequest)(nil), "provenance.name.v1.MsgModifyNameRequest")
-	proto.RegisterType((*MsgModifyNameResponse)(nil), "provenance.name.v1.MsgModifyNameResponse")
-	proto.RegisterType((*MsgUpdateParamsRequest)(nil), "provenance.name.v1.MsgUpdateParamsRequest")
-	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "provenance.name.v1.MsgUpdateParamsResponse")
+// MsgSendByNameRequest defines an sdk.Msg type that is used to send coins to whatever address a name is bound to.
+// The name is resolved when the message is executed (not when it is signed), so the coins always go to the current
+// binding, e.g. a treasury address that is rotated by re-binding its name.
+type MsgSendByNameRequest struct {
+	// from_address is the address sending the coins.
+	FromAddress string `protobuf:"bytes,1,opt,name=from_address,json=fromAddress,proto3" json:"from_address,omitempty"`
+	// to_name is the name that resolves to the address receiving the coins.
+	ToName string `protobuf:"bytes,2,opt,name=to_name,json=toName,proto3" json:"to_name,omitempty"`
+	// amount is the coins to send.
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
 }
 
-func init() { proto.RegisterFile("provenance/name/v1/tx.proto", fileDescriptor_eacf6cd967218635) }
-
-var fileDescriptor_eacf6cd967218635 = []byte{
-	// 558 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0x31, 0x6f, 0xd3, 0x40,
-	0x14, 0xc7, 0x7d, 0x50, 0x2a, 0x7a, 0xa0, 0x0e, 0xd7, 0x84, 0x24, 0xae, 0x70, 0x50, 0x06, 0x28,
-	0x81, 0xd8, 0xb4, 0x48, 0x15, 0x42, 0x2c, 0x04, 0x56, 0xa3, 0x2a, 0x88, 0x05, 0x06, 0x74, 0x8d,
-	0x8f, 0xab, 0x25, 0xce, 0x67, 0xee, 0x2e, 0x51, 0xb3, 0x21, 0x16, 0x18, 0x99, 0x11, 0x43, 0x17,
-	0xf6, 0x0e, 0x7c, 0x88, 0x8e, 0x15, 0x13, 0x13, 0x42, 0xc9, 0x50, 0x3e, 0x06, 0x8a, 0xef, 0xc0,
-	0x6e, 0x6c, 0x8b, 0x54, 0x61, 0xb3, 0xef, 0xbd, 0xff, 0xfb, 0xff, 0xf4, 0xde, 0xbb, 0x83, 0xeb,
-	0xb1, 0xe0, 0x43, 0x12, 0xe1, 0xa8, 0x4f, 0xbc, 0x08, 0x33, 0xe2, 0x0d, 0x37, 0x3d, 0xb5, 0xef,
-	0xc6, 0x82, 0x2b, 0x8e, 0x50, 0x1a, 0x74, 0xa7, 0x41, 0x77, 0xb8, 0x69, 0x57, 0x28, 0xa7, 0x3c,
-	0x09, 0x7b, 0xd3, 0x2f, 0x9d, 0x69, 0xd7, 0xfa, 0x5c, 0x32, 0x2e, 0x3d, 0x26, 0xe9, 0xb4, 0x02,
-	0x93, 0xd4, 0x04, 0x1a, 0x3a, 0xf0, 0x52, 0x2b, 0xf4, 0x8f, 0x09, 0x5d, 0x2d, 0xb0, 0x4e, 0x5c,
-	0x92, 0x70, 0xeb, 0x0b, 0x80, 0xc8, 0x97, 0xb4, 0x1b, 0x46, 0xc1, 0x13, 0xcc, 0x48, 0x8f, 0xbc,
-	0x19, 0x10, 0xa9, 0xd0, 0x03, 0xb8, 0x1c, 0x63, 0x41, 0x22, 0x55, 0x07, 0xd7, 0xc0, 0xc6, 0xa5,
-	0x2d, 0xc7, 0xcd, 0x43, 0xba, 0x5a, 0xd0, 0xe7, 0x22, 0xe8, 0x2e, 0x1d, 0xfd, 0x68, 0x5a, 0x3d,
-	0xa3, 0x99, 0xaa, 0x45, 0x72, 0x5e, 0x3f, 0x77, 0x16, 0xb5, 0xd6, 0xdc, 0x5f, 0xfb, 0x70, 0xd0,
-	0xb4, 0x7e, 0x1d, 0x34, 0xad, 0x77, 0x27, 0x87, 0x6d, 0x53, 0xb2, 0x55, 0x85, 0x6b, 0xa7, 0x30,
-	0x65, 0xcc, 0x23, 0x49, 0x5a, 0x21, 0xac, 0xf8, 0x92, 0x3e, 0x26, 0xaf, 0x89, 0x22, 0x33, 0xfc,
-	0x86, 0x00, 0x2c, 0x4c, 0xa0, 0x0f, 0x5b, 0x35, 0x58, 0x9d, 0xb1, 0x32, 0x0c, 0x9f, 0x00, 0xac,
-	0xfb, 0x92, 0x3e, 0x12, 0x04, 0x2b, 0xd2, 0xe3, 0x5c, 0x65, 0x41, 0xb6, 0xe1, 0x0a, 0x1e, 0xa8,
-	0x3d, 0x2e, 0x42, 0x35, 0x4a, 0x58, 0x56, 0xba, 0xf5, 0x6f, 0x5f, 0x3b, 0x15, 0x33, 0xa3, 0x87,
-	0x41, 0x20, 0x88, 0x94, 0x4f, 0x95, 0x08, 0x23, 0xda, 0x4b, 0x53, 0xd1, 0xf6, 0xd9, 0x5a, 0xf8,
-	0x17, 0x7d, 0x75, 0x8a, 0x9c, 0xd6, 0x69, 0xad, 0xc3, 0x46, 0x01, 0x9b, 0x21, 0xff, 0x0c, 0x92,
-	0xf6, 0xf9, 0x3c, 0x08, 0x5f, 0x8d, 0xfe, 0x07, 0xf5, 0x62, 0x83, 0x9f, 0x65, 0xd7, 0x1d, 0xcf,
-	0xd2, 0xa5, 0x1d, 0xbf, 0xe2, 0x4b, 0xfa, 0x2c, 0x0e, 0xb0, 0x22, 0x3b, 0x58, 0x60, 0x26, 0x17,
-	0x25, 0xbf, 0x97, 0x2c, 0x3c, 0x66, 0xd2, 0x90, 0xdb, 0x45, 0xe4, 0xda, 0x2a, 0xb3, 0xec, 0x98,
-	0xc9, 0x1c, 0x75, 0x03, 0xd6, 0x72, 0x6c, 0x9a, 0x7b, 0xeb, 0xfd, 0x12, 0x3c, 0xef, 0x4b, 0x8a,
-	0x5e, 0xc0, 0x8b, 0x7f, 0x36, 0x19, 0x5d, 0x2f, 0x32, 0xca, 0xdf, 0x48, 0xfb, 0xc6, 0x3f, 0xf3,
-	0xb4, 0x09, 0xc2, 0x10, 0xa6, 0x4b, 0x8a, 0x36, 0x4a, 0x64, 0xb9, 0x2b, 0x63, 0xdf, 0x9c, 0x23,
-	0x33, 0xb5, 0x48, 0xa7, 0x52, 0x6a, 0x91, 0x5b, 0xab, 0x52, 0x8b, 0xfc, 0x88, 0x11, 0x83, 0xab,
-	0xa7, 0x97, 0x16, 0xdd, 0x2e, 0x11, 0x17, 0xde, 0x3b, 0xbb, 0x33, 0x67, 0xb6, 0xb1, 0xa3, 0xf0,
-	0x72, 0x76, 0x62, 0xa8, 0x5d, 0x22, 0x2f, 0x58, 0x39, 0xfb, 0xd6, 0x5c, 0xb9, 0xda, 0xc8, 0xbe,
-	0xf0, 0xf6, 0xe4, 0xb0, 0x0d, 0xba, 0xfd, 0xa3, 0xb1, 0x03, 0x8e, 0xc7, 0x0e, 0xf8, 0x39, 0x76,
-	0xc0, 0xc7, 0x89, 0x63, 0x1d, 0x4f, 0x1c, 0xeb, 0xfb, 0xc4, 0xb1, 0x60, 0x35, 0xe4, 0x05, 0xf5,
-	0x76, 0xc0, 0xf3, 0x3b, 0x34, 0x54, 0x7b, 0x83, 0x5d, 0xb7, 0xcf, 0x99, 0x97, 0x26, 0x74, 0x42,
-	0x9e, 0xf9, 0xf3, 0xf6, 0xf5, 0x1b, 0xaf, 0x46, 0x31, 0x91, 0xbb, 0xcb, 0xc9, 0x13, 0x7f, 0xf7,
-	0x77, 0x00, 0x00, 0x00, 0xff, 0xff, 0x27, 0xc8, 0xa2, 0x05, 0x7e, 0x06, 0x00, 0x00,
+func (m *MsgSendByNameRequest) Reset()         { *m = MsgSendByNameRequest{} }
+func (m *MsgSendByNameRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgSendByNameRequest) ProtoMessage()    {}
+func (*MsgSendByNameRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eacf6cd967218635, []int{14}
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// BindName binds a name to an address under a root name.
-	BindName(ctx context.Context, in *MsgBindNameRequest, opts ...grpc.CallOption) (*MsgBindNameResponse, error)
-	// DeleteName defines a method to verify a particular invariance.
-	DeleteName(ctx context.Context, in *MsgDeleteNameRequest, opts ...grpc.CallOption) (*MsgDeleteNameResponse, error)
-	// ModifyName defines a method to modify the attributes of an existing name.
-	ModifyName(ctx context.Context, in *MsgModifyNameRequest, opts ...grpc.CallOption) (*MsgModifyNameResponse, error)
-	// CreateRootName defines a governance method for creating a root name.
-	CreateRootName(ctx context.Context, in *MsgCreateRootNameRequest, opts ...grpc.CallOption) (*MsgCreateRootNameResponse, error)
-	// UpdateParams is a governance proposal endpoint for updating the name module's params.
-	UpdateParams(ctx context.Context, in *MsgUpdateParamsRequest, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+func (m *MsgSendByNameRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgSendByNameRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSendByNameRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgSendByNameRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSendByNameRequest.Merge(m, src)
 }
-
-func (c *msgClient) BindName(ctx context.Context, in *MsgBindNameRequest, opts ...grpc.CallOption) (*MsgBindNameResponse, error) {
-	out := new(MsgBindNameResponse)
-	err := c.cc.Invoke(ctx, "/provenance.name.v1.Msg/BindName", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgSendByNameRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func (c *msgClient) DeleteName(ctx context.Context, in *MsgDeleteNameRequest, opts ...grpc.CallOption) (*MsgDeleteNameResponse, error) {
-	out := new(MsgDeleteNameResponse)
-	err := c.cc.Invoke(ctx, "/provenance.name.v1.Msg/DeleteName", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgSendByNameRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSendByNameRequest.DiscardUnknown(m)
 }
 
-func (c *msgClient) ModifyName(ctx context.Context, in *MsgModifyNameRequest, opts ...grpc.CallOption) (*MsgModifyNameResponse, error) {
-	out := new(MsgModifyNameResponse)
-	err := c.cc.Invoke(ctx, "/provenance.name.v1.Msg/ModifyName", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
+var xxx_messageInfo_MsgSendByNameRequest proto.InternalMessageInfo
 
-func (c *msgClient) CreateRootName(ctx context.Context, in *MsgCreateRootNameRequest, opts ...grpc.CallOption) (*MsgCreateRootNameResponse, error) {
-	out := new(MsgCreateRootNameResponse)
-	err := c.cc.Invoke(ctx, "/provenance.name.v1.Msg/CreateRootName", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgSendByNameRequest) GetFromAddress() string {
+	if m != nil {
+		return m.FromAddress
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParamsRequest, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
-	out := new(MsgUpdateParamsResponse)
-	err := c.cc.Invoke(ctx, "/provenance.name.v1.Msg/UpdateParams", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgSendByNameRequest) GetToName() string {
+	if m != nil {
+		return m.ToName
 	}
-	return out, nil
+	return ""
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// BindName binds a name to an address under a root name.
-	BindName(context.Context, *MsgBindNameRequest) (*MsgBindNameResponse, error)
-	// DeleteName defines a method to verify a particular invariance.
-	DeleteName(context.Context, *MsgDeleteNameRequest) (*MsgDeleteNameResponse, error)
-	// ModifyName defines a method to modify the attributes of an existing name.
-	ModifyName(context.Context, *MsgModifyNameRequest) (*MsgModifyNameResponse, error)
-	// CreateRootName defines a governance method for creating a root name.
-	CreateRootName(context.Context, *MsgCreateRootNameRequest) (*MsgCreateRootNameResponse, error)
-	// UpdateParams is a governance proposal endpoint for updating the name module's params.
-	UpdateParams(context.Context, *MsgUpdateParamsRequest) (*MsgUpdateParamsResponse, error)
+func (m *MsgSendByNameRequest) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+// MsgSendByNameResponse defines the Msg/SendByName response type.
+type MsgSendByNameResponse struct {
+	// to_address is the address that the name resolved to, and that the coins were sent to.
+	ToAddress string `protobuf:"bytes,1,opt,name=to_address,json=toAddress,proto3" json:"to_address,omitempty"`
 }
 
-func (*UnimplementedMsgServer) BindName(ctx context.Context, req *MsgBindNameRequest) (*MsgBindNameResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BindName not implemented")
-}
-func (*UnimplementedMsgServer) DeleteName(ctx context.Context, req *MsgDeleteNameRequest) (*MsgDeleteNameResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteName not implemented")
+func (m *MsgSendByNameResponse) Reset()         { *m = MsgSendByNameResponse{} }
+func (m *MsgSendByNameResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSendByNameResponse) ProtoMessage()    {}
+func (*MsgSendByNameResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eacf6cd967218635, []int{15}
 }
-func (*UnimplementedMsgServer) ModifyName(ctx context.Context, req *MsgModifyNameRequest) (*MsgModifyNameResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ModifyName not implemented")
+func (m *MsgSendByNameResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) CreateRootName(ctx context.Context, req *MsgCreateRootNameRequest) (*MsgCreateRootNameResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateRootName not implemented")
+func (m *MsgSendByNameResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSendByNameResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParamsRequest) (*MsgUpdateParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+func (m *MsgSendByNameResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSendByNameResponse.Merge(m, src)
 }
-
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+func (m *MsgSendByNameResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSendByNameResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSendByNameResponse.DiscardUnknown(m)
 }
 
-func _Msg_BindName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgBindNameRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).BindName(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.name.v1.Msg/BindName",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).BindName(ctx, req.(*MsgBindNameRequest))
+var xxx_messageInfo_MsgSendByNameResponse proto.InternalMessageInfo
+
+func (m *MsgSendByNameResponse) GetToAddress() string {
+	if m != nil {
+		return m.ToAddress
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_DeleteName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDeleteNameRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).DeleteName(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.name.v1.Msg/DeleteName",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).DeleteName(ctx, req.(*MsgDeleteNameRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// MsgApproveAddressRotationRequest defines an sdk.Msg type that is used by a new address to approve the rotation
+// of an old address to it. The approval is used up by the MsgRotateAddressRequest that does the rotation.
+type MsgApproveAddressRotationRequest struct {
+	// new_address is the address approving the rotation, i.e. the one that will receive everything.
+	NewAddress string `protobuf:"bytes,1,opt,name=new_address,json=newAddress,proto3" json:"new_address,omitempty"`
+	// old_address is the address that can be rotated to the new address.
+	OldAddress string `protobuf:"bytes,2,opt,name=old_address,json=oldAddress,proto3" json:"old_address,omitempty"`
 }
 
-func _Msg_ModifyName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgModifyNameRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).ModifyName(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.name.v1.Msg/ModifyName",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ModifyName(ctx, req.(*MsgModifyNameRequest))
+func (m *MsgApproveAddressRotationRequest) Reset()         { *m = MsgApproveAddressRotationRequest{} }
+func (m *MsgApproveAddressRotationRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgApproveAddressRotationRequest) ProtoMessage()    {}
+func (*MsgApproveAddressRotationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eacf6cd967218635, []int{16}
+}
+func (m *MsgApproveAddressRotationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgApproveAddressRotationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgApproveAddressRotationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgApproveAddressRotationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgApproveAddressRotationRequest.Merge(m, src)
+}
+func (m *MsgApproveAddressRotationRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgApproveAddressRotationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgApproveAddressRotationRequest.DiscardUnknown(m)
 }
 
-func _Msg_CreateRootName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCreateRootNameRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CreateRootName(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.name.v1.Msg/CreateRootName",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CreateRootName(ctx, req.(*MsgCreateRootNameRequest))
+var xxx_messageInfo_MsgApproveAddressRotationRequest proto.InternalMessageInfo
+
+func (m *MsgApproveAddressRotationRequest) GetNewAddress() string {
+	if m != nil {
+		return m.NewAddress
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateParams(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.name.v1.Msg/UpdateParams",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParamsRequest))
+func (m *MsgApproveAddressRotationRequest) GetOldAddress() string {
+	if m != nil {
+		return m.OldAddress
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-var Msg_serviceDesc = _Msg_serviceDesc
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "provenance.name.v1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "BindName",
-			Handler:    _Msg_BindName_Handler,
-		},
-		{
-			MethodName: "DeleteName",
-			Handler:    _Msg_DeleteName_Handler,
-		},
-		{
-			MethodName: "ModifyName",
-			Handler:    _Msg_ModifyName_Handler,
-		},
-		{
-			MethodName: "CreateRootName",
-			Handler:    _Msg_CreateRootName_Handler,
-		},
-		{
-			MethodName: "UpdateParams",
-			Handler:    _Msg_UpdateParams_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "provenance/name/v1/tx.proto",
+// MsgApproveAddressRotationResponse defines the Msg/ApproveAddressRotation response type.
+type MsgApproveAddressRotationResponse struct {
 }
 
-func (m *MsgBindNameRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgApproveAddressRotationResponse) Reset()         { *m = MsgApproveAddressRotationResponse{} }
+func (m *MsgApproveAddressRotationResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgApproveAddressRotationResponse) ProtoMessage()    {}
+func (*MsgApproveAddressRotationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eacf6cd967218635, []int{17}
 }
-
-func (m *MsgBindNameRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgApproveAddressRotationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *MsgBindNameRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Record.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgApproveAddressRotationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgApproveAddressRotationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size, err := m.Parent.MarshalToSizedBuffer(dAtA[:i])
+}
+func (m *MsgApproveAddressRotationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgApproveAddressRotationResponse.Merge(m, src)
+}
+func (m *MsgApproveAddressRotationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgApproveAddressRotationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgApproveAddressRotationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgApproveAddressRotationResponse proto.InternalMessageInfo
+
+// MsgRotateAddressRequest defines an sdk.Msg type that is used to move everything tied to an old address to a
+// new address in a single step. The following are moved:
+//   - All names bound to the old address are re-bound to the new address (keeping their restricted flag).
+//   - Unexpired attributes on the old account with names owned by the old or new address are moved to the new account.
+//   - Marker access grants (and marker manager roles) of the old address are given to the new address.
+//
+// The new address must have first approved the rotation using a MsgApproveAddressRotationRequest.
+type MsgRotateAddressRequest struct {
+	// old_address is the address being rotated away from.
+	OldAddress string `protobuf:"bytes,1,opt,name=old_address,json=oldAddress,proto3" json:"old_address,omitempty"`
+	// new_address is the address being rotated to.
+	NewAddress string `protobuf:"bytes,2,opt,name=new_address,json=newAddress,proto3" json:"new_address,omitempty"`
+}
+
+func (m *MsgRotateAddressRequest) Reset()         { *m = MsgRotateAddressRequest{} }
+func (m *MsgRotateAddressRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgRotateAddressRequest) ProtoMessage()    {}
+func (*MsgRotateAddressRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eacf6cd967218635, []int{18}
+}
+func (m *MsgRotateAddressRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRotateAddressRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRotateAddressRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *MsgRotateAddressRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRotateAddressRequest.Merge(m, src)
+}
+func (m *MsgRotateAddressRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRotateAddressRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRotateAddressRequest.DiscardUnknown(m)
 }
 
-func (m *MsgBindNameResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgRotateAddressRequest proto.InternalMessageInfo
+
+func (m *MsgRotateAddressRequest) GetOldAddress() string {
+	if m != nil {
+		return m.OldAddress
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgBindNameResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgRotateAddressRequest) GetNewAddress() string {
+	if m != nil {
+		return m.NewAddress
+	}
+	return ""
 }
 
-func (m *MsgBindNameResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// MsgRotateAddressResponse defines the Msg/RotateAddress response type.
+type MsgRotateAddressResponse struct {
+	// names are the names that were re-bound to the new address.
+	Names []string `protobuf:"bytes,1,rep,name=names,proto3" json:"names,omitempty"`
 }
 
-func (m *MsgDeleteNameRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRotateAddressResponse) Reset()         { *m = MsgRotateAddressResponse{} }
+func (m *MsgRotateAddressResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRotateAddressResponse) ProtoMessage()    {}
+func (*MsgRotateAddressResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eacf6cd967218635, []int{19}
+}
+func (m *MsgRotateAddressResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRotateAddressResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRotateAddressResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgDeleteNameRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgRotateAddressResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRotateAddressResponse.Merge(m, src)
+}
+func (m *MsgRotateAddressResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRotateAddressResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRotateAddressResponse.DiscardUnknown(m)
 }
 
-func (m *MsgDeleteNameRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Record.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
+var xxx_messageInfo_MsgRotateAddressResponse proto.InternalMessageInfo
 
-func (m *MsgDeleteNameResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRotateAddressResponse) GetNames() []string {
+	if m != nil {
+		return m.Names
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgDeleteNameResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgSetChildQuotaRequest defines an sdk.Msg type that is used by the owner of a name to limit the number of names
+// that can be bound directly under it. This lets an unrestricted name cap its growth without becoming restricted.
+type MsgSetChildQuotaRequest struct {
+	// name is the parent name to set the quota on. It must resolve to the owner.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// max_children is the maximum number of names that can be bound directly under the name. Zero removes the quota.
+	MaxChildren uint64 `protobuf:"varint,2,opt,name=max_children,json=maxChildren,proto3" json:"max_children,omitempty"`
+	// owner is the address that the name must resolve to.
+	Owner string `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty"`
 }
 
-func (m *MsgDeleteNameResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgSetChildQuotaRequest) Reset()         { *m = MsgSetChildQuotaRequest{} }
+func (m *MsgSetChildQuotaRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgSetChildQuotaRequest) ProtoMessage()    {}
+func (*MsgSetChildQuotaRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eacf6cd967218635, []int{20}
 }
-
-func (m *MsgCreateRootNameRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSetChildQuotaRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetChildQuotaRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetChildQuotaRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgCreateRootNameRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSetChildQuotaRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetChildQuotaRequest.Merge(m, src)
+}
+func (m *MsgSetChildQuotaRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetChildQuotaRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetChildQuotaRequest.DiscardUnknown(m)
 }
 
-func (m *MsgCreateRootNameRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Record != nil {
-		{
-			size, err := m.Record.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_MsgSetChildQuotaRequest proto.InternalMessageInfo
+
+func (m *MsgSetChildQuotaRequest) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgCreateRootNameResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSetChildQuotaRequest) GetMaxChildren() uint64 {
+	if m != nil {
+		return m.MaxChildren
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgCreateRootNameResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSetChildQuotaRequest) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
 }
 
-func (m *MsgCreateRootNameResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// MsgSetChildQuotaResponse defines the Msg/SetChildQuota response type.
+type MsgSetChildQuotaResponse struct {
 }
 
-func (m *MsgModifyNameRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSetChildQuotaResponse) Reset()         { *m = MsgSetChildQuotaResponse{} }
+func (m *MsgSetChildQuotaResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetChildQuotaResponse) ProtoMessage()    {}
+func (*MsgSetChildQuotaResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eacf6cd967218635, []int{21}
+}
+func (m *MsgSetChildQuotaResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetChildQuotaResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetChildQuotaResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgSetChildQuotaResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetChildQuotaResponse.Merge(m, src)
+}
+func (m *MsgSetChildQuotaResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetChildQuotaResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetChildQuotaResponse.DiscardUnknown(m)
 }
 
-func (m *MsgModifyNameRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgSetChildQuotaResponse proto.InternalMessageInfo
+
+// MsgCreateNamedAccountRequest defines an sdk.Msg type that is used to bind a name to a new account whose address is
+// derived from the name. This lets a service reserve an address that is provably derived from its namespace instead of
+// a random key. The same rules as binding a name apply to the name's parent, and the owner pays any bind name fee.
+type MsgCreateNamedAccountRequest struct {
+	// name is the full name to bind, e.g. "treasury.acme.pb". Its parent must be bound.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// restricted is whether the new name should be restricted.
+	Restricted bool `protobuf:"varint,2,opt,name=restricted,proto3" json:"restricted,omitempty"`
+	// owner is the address requesting the account. It must own the parent name if the parent is restricted.
+	Owner string `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty"`
 }
 
-func (m *MsgModifyNameRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Record.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgCreateNamedAccountRequest) Reset()         { *m = MsgCreateNamedAccountRequest{} }
+func (m *MsgCreateNamedAccountRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateNamedAccountRequest) ProtoMessage()    {}
+func (*MsgCreateNamedAccountRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eacf6cd967218635, []int{22}
+}
+func (m *MsgCreateNamedAccountRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateNamedAccountRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateNamedAccountRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgModifyNameResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgCreateNamedAccountRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateNamedAccountRequest.Merge(m, src)
+}
+func (m *MsgCreateNamedAccountRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateNamedAccountRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateNamedAccountRequest.DiscardUnknown(m)
 }
 
-func (m *MsgModifyNameResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgCreateNamedAccountRequest proto.InternalMessageInfo
+
+func (m *MsgCreateNamedAccountRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
 }
 
-func (m *MsgModifyNameResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgCreateNamedAccountRequest) GetRestricted() bool {
+	if m != nil {
+		return m.Restricted
+	}
+	return false
 }
 
-func (m *MsgUpdateParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgCreateNamedAccountRequest) GetOwner() string {
+	if m != nil {
+		return m.Owner
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgUpdateParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgCreateNamedAccountResponse defines the Msg/CreateNamedAccount response type.
+type MsgCreateNamedAccountResponse struct {
+	// address is the address derived from the name that the name is now bound to.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func (m *MsgUpdateParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgCreateNamedAccountResponse) Reset()         { *m = MsgCreateNamedAccountResponse{} }
+func (m *MsgCreateNamedAccountResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateNamedAccountResponse) ProtoMessage()    {}
+func (*MsgCreateNamedAccountResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eacf6cd967218635, []int{23}
+}
+func (m *MsgCreateNamedAccountResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateNamedAccountResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateNamedAccountResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgCreateNamedAccountResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateNamedAccountResponse.Merge(m, src)
 }
-
-func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgCreateNamedAccountResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateNamedAccountResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateNamedAccountResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_MsgCreateNamedAccountResponse proto.InternalMessageInfo
+
+func (m *MsgCreateNamedAccountResponse) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func init() {
+	proto.RegisterType((*MsgBindNameRequest)(nil), "provenance.name.v1.MsgBindNameRequest")
+	proto.RegisterType((*MsgBindNameResponse)(nil), "provenance.name.v1.MsgBindNameResponse")
+	proto.RegisterType((*MsgDeleteNameRequest)(nil), "provenance.name.v1.MsgDeleteNameRequest")
+	proto.RegisterType((*MsgDeleteNameResponse)(nil), "provenance.name.v1.MsgDeleteNameResponse")
+	proto.RegisterType((*MsgDeleteNamesRequest)(nil), "provenance.name.v1.MsgDeleteNamesRequest")
+	proto.RegisterType((*MsgDeleteNamesResponse)(nil), "provenance.name.v1.MsgDeleteNamesResponse")
+	proto.RegisterType((*MsgCreateRootNameRequest)(nil), "provenance.name.v1.MsgCreateRootNameRequest")
+	proto.RegisterType((*MsgCreateRootNameResponse)(nil), "provenance.name.v1.MsgCreateRootNameResponse")
+	proto.RegisterType((*MsgRemoveNameRequest)(nil), "provenance.name.v1.MsgRemoveNameRequest")
+	proto.RegisterType((*MsgRemoveNameResponse)(nil), "provenance.name.v1.MsgRemoveNameResponse")
+	proto.RegisterType((*MsgModifyNameRequest)(nil), "provenance.name.v1.MsgModifyNameRequest")
+	proto.RegisterType((*MsgModifyNameResponse)(nil), "provenance.name.v1.MsgModifyNameResponse")
+	proto.RegisterType((*MsgUpdateParamsRequest)(nil), "provenance.name.v1.MsgUpdateParamsRequest")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "provenance.name.v1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgSendByNameRequest)(nil), "provenance.name.v1.MsgSendByNameRequest")
+	proto.RegisterType((*MsgSendByNameResponse)(nil), "provenance.name.v1.MsgSendByNameResponse")
+	proto.RegisterType((*MsgApproveAddressRotationRequest)(nil), "provenance.name.v1.MsgApproveAddressRotationRequest")
+	proto.RegisterType((*MsgApproveAddressRotationResponse)(nil), "provenance.name.v1.MsgApproveAddressRotationResponse")
+	proto.RegisterType((*MsgRotateAddressRequest)(nil), "provenance.name.v1.MsgRotateAddressRequest")
+	proto.RegisterType((*MsgRotateAddressResponse)(nil), "provenance.name.v1.MsgRotateAddressResponse")
+	proto.RegisterType((*MsgSetChildQuotaRequest)(nil), "provenance.name.v1.MsgSetChildQuotaRequest")
+	proto.RegisterType((*MsgSetChildQuotaResponse)(nil), "provenance.name.v1.MsgSetChildQuotaResponse")
+	proto.RegisterType((*MsgCreateNamedAccountRequest)(nil), "provenance.name.v1.MsgCreateNamedAccountRequest")
+	proto.RegisterType((*MsgCreateNamedAccountResponse)(nil), "provenance.name.v1.MsgCreateNamedAccountResponse")
+}
+
+func init() { proto.RegisterFile("provenance/name/v1/tx.proto", fileDescriptor_eacf6cd967218635) }
+
+var fileDescriptor_eacf6cd967218635 = []byte{
+	// 1188 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0x41, 0x4f, 0x1b, 0x47,
+	0x14, 0xf6, 0x62, 0x20, 0xf8, 0x99, 0xd0, 0x66, 0x02, 0xc1, 0x6c, 0x82, 0x21, 0x1b, 0xa9, 0x05,
+	0x0a, 0x36, 0xd0, 0x16, 0x35, 0x34, 0x3d, 0x60, 0xaa, 0x5e, 0x2a, 0x57, 0x74, 0x51, 0x2f, 0xed,
+	0xc1, 0x1a, 0x76, 0x27, 0xcb, 0xb6, 0xde, 0x1d, 0x77, 0x67, 0x0c, 0xf8, 0x56, 0x55, 0x8a, 0xd4,
+	0x43, 0x15, 0xe5, 0x8c, 0x7a, 0x48, 0x6f, 0x55, 0x4e, 0x1c, 0xfa, 0x23, 0x72, 0x8c, 0x7a, 0xea,
+	0xa9, 0xad, 0xe0, 0x40, 0xcf, 0x3d, 0xf6, 0x14, 0xed, 0xcc, 0x98, 0x5d, 0x7b, 0x6d, 0x58, 0x8b,
+	0x5c, 0x60, 0xf7, 0xcd, 0xf7, 0xe6, 0x7d, 0xdf, 0x9b, 0xb7, 0xef, 0x8d, 0xe1, 0x6e, 0x23, 0xa0,
+	0x07, 0xc4, 0xc7, 0xbe, 0x45, 0xca, 0x3e, 0xf6, 0x48, 0xf9, 0x60, 0xad, 0xcc, 0x8f, 0x4a, 0x8d,
+	0x80, 0x72, 0x8a, 0x50, 0xb4, 0x58, 0x0a, 0x17, 0x4b, 0x07, 0x6b, 0xfa, 0x2d, 0xec, 0xb9, 0x3e,
+	0x2d, 0x8b, 0xbf, 0x12, 0xa6, 0x4f, 0x3a, 0xd4, 0xa1, 0xe2, 0xb1, 0x1c, 0x3e, 0x29, 0xeb, 0xb4,
+	0x45, 0x99, 0x47, 0x59, 0xd9, 0x63, 0x4e, 0xb8, 0xa9, 0xc7, 0x1c, 0xb5, 0x30, 0x23, 0x17, 0x6a,
+	0xd2, 0x43, 0xbe, 0xa8, 0xa5, 0xa2, 0xf2, 0xd9, 0xc3, 0x2c, 0x64, 0xb2, 0x47, 0x38, 0x5e, 0x2b,
+	0x5b, 0xd4, 0xf5, 0xd5, 0xfa, 0x6c, 0x0f, 0xb6, 0x82, 0x98, 0x58, 0x36, 0xfe, 0xd3, 0x00, 0x55,
+	0x99, 0x53, 0x71, 0x7d, 0xfb, 0x0b, 0xec, 0x11, 0x93, 0x7c, 0xdf, 0x24, 0x8c, 0xa3, 0x47, 0x30,
+	0xda, 0xc0, 0x01, 0xf1, 0x79, 0x41, 0x9b, 0xd7, 0x16, 0xf2, 0xeb, 0xc5, 0x52, 0x52, 0x57, 0x49,
+	0x3a, 0x58, 0x34, 0xb0, 0x2b, 0xc3, 0x2f, 0xff, 0x9a, 0xcb, 0x98, 0xca, 0x27, 0xf4, 0x0e, 0x84,
+	0xbd, 0x30, 0x34, 0x88, 0xb7, 0xf4, 0x41, 0x9f, 0xc3, 0x5b, 0x72, 0x9f, 0x1a, 0x6e, 0x84, 0x7e,
+	0xb8, 0x5e, 0xc8, 0x8a, 0x6d, 0x8c, 0x5e, 0xdb, 0xec, 0x08, 0xe8, 0x96, 0x42, 0x9a, 0x13, 0x8d,
+	0x8e, 0xf7, 0xcd, 0xdb, 0x3f, 0x3d, 0x9f, 0xcb, 0xfc, 0xfb, 0x7c, 0x2e, 0xf3, 0xe3, 0xf9, 0xc9,
+	0x92, 0xe2, 0x67, 0x4c, 0xc1, 0xed, 0x0e, 0xcd, 0xac, 0x41, 0x7d, 0x46, 0x0c, 0x17, 0x26, 0xab,
+	0xcc, 0xf9, 0x94, 0xd4, 0x09, 0x27, 0x5d, 0xc9, 0x50, 0x72, 0xb4, 0xc1, 0xe5, 0x74, 0x31, 0x90,
+	0x46, 0x63, 0x1a, 0xa6, 0xba, 0x42, 0x29, 0x0e, 0x4f, 0xb4, 0xae, 0x15, 0xd6, 0x66, 0x51, 0x82,
+	0x11, 0x7a, 0xe8, 0x93, 0x40, 0x90, 0xc8, 0x55, 0x0a, 0x7f, 0xfc, 0xbe, 0x32, 0xa9, 0x2a, 0x61,
+	0xcb, 0xb6, 0x03, 0xc2, 0xd8, 0x2e, 0x0f, 0x5c, 0xdf, 0x31, 0x25, 0x0c, 0x21, 0x18, 0x0e, 0xb9,
+	0x89, 0x23, 0xc8, 0x99, 0xe2, 0x19, 0xdd, 0x83, 0x5c, 0x40, 0xac, 0x66, 0xc0, 0xdc, 0x03, 0x22,
+	0x92, 0x3a, 0x66, 0x46, 0x86, 0x4d, 0x08, 0x19, 0x4a, 0x6f, 0xe3, 0x13, 0xb8, 0xd3, 0x4d, 0x43,
+	0x32, 0x44, 0x0f, 0xe0, 0xa6, 0x2d, 0xcc, 0x76, 0x2d, 0xdc, 0x93, 0x15, 0xb4, 0xf9, 0xec, 0x42,
+	0xce, 0x1c, 0x57, 0x46, 0x01, 0x36, 0x8e, 0x35, 0x28, 0x54, 0x99, 0xb3, 0x1d, 0x10, 0xcc, 0x89,
+	0x49, 0x29, 0x8f, 0xe7, 0x73, 0x03, 0x72, 0xb8, 0xc9, 0xf7, 0x69, 0xe0, 0xf2, 0xd6, 0x95, 0x6a,
+	0x22, 0x28, 0xda, 0x18, 0xac, 0xac, 0x2e, 0x4e, 0x60, 0x22, 0xd4, 0x15, 0xed, 0x63, 0xdc, 0x85,
+	0x99, 0x1e, 0xdc, 0xd4, 0x01, 0x3c, 0xd3, 0x44, 0x15, 0x98, 0xc4, 0xa3, 0x07, 0xe4, 0x4d, 0xb0,
+	0x1e, 0xfc, 0x1c, 0xba, 0xf9, 0x3e, 0x82, 0xa9, 0x2e, 0x46, 0xd1, 0x51, 0x04, 0xc2, 0xda, 0x75,
+	0x14, 0xca, 0x28, 0x8f, 0xe2, 0x17, 0x29, 0xa8, 0x4a, 0x6d, 0xf7, 0x71, 0xeb, 0x4d, 0x08, 0xba,
+	0xd6, 0xd7, 0x9d, 0x10, 0x27, 0xbf, 0x84, 0x38, 0x3b, 0x75, 0x10, 0xc7, 0x9a, 0x28, 0xc1, 0xaf,
+	0x1a, 0x36, 0xe6, 0x64, 0x07, 0x07, 0xd8, 0x63, 0xd7, 0x65, 0xfe, 0x91, 0xe8, 0x6a, 0xd8, 0x63,
+	0x8a, 0xb9, 0xde, 0xa7, 0xa1, 0x60, 0x8f, 0xc5, 0x3a, 0x1a, 0xf6, 0x58, 0x82, 0xf5, 0x0c, 0x4c,
+	0x27, 0xb8, 0x29, 0xde, 0xff, 0xcb, 0x7c, 0xef, 0x12, 0xdf, 0xae, 0x74, 0xe4, 0xfb, 0x63, 0x18,
+	0x7f, 0x1c, 0x50, 0xaf, 0x86, 0x25, 0xbd, 0x2b, 0x89, 0xe7, 0x43, 0xb4, 0x32, 0xa1, 0x69, 0xb8,
+	0xc1, 0x69, 0x2d, 0x56, 0x48, 0xa3, 0x9c, 0x86, 0x9b, 0xa3, 0x16, 0x8c, 0x62, 0x8f, 0x36, 0x7d,
+	0x5e, 0xc8, 0xce, 0x67, 0x17, 0xf2, 0xeb, 0x33, 0x25, 0xb5, 0x59, 0x38, 0x10, 0x4a, 0x6a, 0x20,
+	0x94, 0xb6, 0xa9, 0xeb, 0x57, 0x3e, 0x0b, 0x25, 0xbd, 0xf8, 0x7b, 0x6e, 0xc1, 0x71, 0xf9, 0x7e,
+	0x73, 0xaf, 0x64, 0x51, 0x4f, 0xcd, 0x12, 0xf5, 0x6f, 0x85, 0xd9, 0xdf, 0x95, 0x79, 0xab, 0x41,
+	0x98, 0x70, 0x60, 0xc7, 0xe7, 0x27, 0x4b, 0xe3, 0x75, 0xe2, 0x60, 0xab, 0x55, 0x0b, 0x47, 0x0a,
+	0xfb, 0xed, 0xfc, 0x64, 0x49, 0x33, 0x55, 0xc0, 0xcd, 0x5b, 0x61, 0x52, 0x3a, 0x34, 0x19, 0x1b,
+	0x30, 0xd5, 0xa5, 0x5d, 0x95, 0xea, 0x2c, 0x00, 0xa7, 0x9d, 0xd2, 0xcd, 0x1c, 0xa7, 0x4a, 0x9e,
+	0xf1, 0x42, 0x83, 0xf9, 0x2a, 0x73, 0x64, 0xdb, 0x26, 0xca, 0x6a, 0x52, 0x8e, 0xb9, 0x4b, 0xfd,
+	0x76, 0x02, 0x1f, 0x42, 0xde, 0x27, 0x87, 0xa9, 0xf3, 0x07, 0x3e, 0x39, 0x6c, 0xa7, 0xef, 0x21,
+	0xe4, 0x69, 0xdd, 0xbe, 0x70, 0x1d, 0xba, 0xca, 0x95, 0xd6, 0x6d, 0x65, 0xd9, 0x7c, 0x3b, 0x54,
+	0x19, 0x0f, 0x6c, 0x3c, 0x80, 0xfb, 0x97, 0x70, 0x55, 0x65, 0xf0, 0xab, 0x26, 0x4a, 0x44, 0xd8,
+	0x2f, 0x40, 0x91, 0x90, 0x38, 0x1b, 0x2d, 0x3d, 0x9b, 0xee, 0x1c, 0x0c, 0xa5, 0xcf, 0x81, 0x12,
+	0x12, 0x0b, 0x6c, 0xac, 0x42, 0x21, 0x49, 0x51, 0x1d, 0xd8, 0x24, 0x8c, 0xc4, 0x7b, 0x8a, 0x7c,
+	0x31, 0x7e, 0x96, 0xaa, 0x76, 0x09, 0xdf, 0xde, 0x77, 0xeb, 0xf6, 0x97, 0x4d, 0xca, 0x71, 0x5b,
+	0x55, 0xbb, 0xd1, 0x69, 0xb1, 0x46, 0x77, 0x1f, 0xc6, 0x3d, 0x7c, 0x54, 0xb3, 0x42, 0x70, 0x40,
+	0x7c, 0xc1, 0x77, 0xd8, 0xcc, 0x7b, 0xf8, 0x68, 0x5b, 0x99, 0xa2, 0xb9, 0x96, 0x4d, 0x35, 0xd7,
+	0x3a, 0xa6, 0x94, 0x0e, 0x85, 0x24, 0x1b, 0x75, 0x00, 0x4f, 0x35, 0xb8, 0x77, 0xd1, 0xe6, 0xc3,
+	0x5a, 0xb4, 0xb7, 0x2c, 0x2b, 0xac, 0xdb, 0xcb, 0xf8, 0x16, 0x01, 0x02, 0xc2, 0x78, 0xe0, 0x5a,
+	0x9c, 0xc8, 0xfe, 0x36, 0x66, 0xc6, 0x2c, 0xd7, 0x22, 0xbb, 0x0b, 0xb3, 0x7d, 0xf8, 0xa8, 0x94,
+	0xaf, 0xc3, 0x8d, 0xb4, 0x25, 0xd1, 0x06, 0xae, 0x3f, 0x05, 0xc8, 0x56, 0x99, 0x83, 0xbe, 0x81,
+	0xb1, 0xf6, 0x7d, 0x06, 0xbd, 0xd3, 0xab, 0xad, 0x25, 0x2f, 0x79, 0xfa, 0xbb, 0x57, 0xe2, 0x14,
+	0x31, 0x0c, 0x10, 0xdd, 0x04, 0xd0, 0x42, 0x1f, 0xb7, 0xc4, 0xc5, 0x49, 0x5f, 0x4c, 0x81, 0x54,
+	0x21, 0x6c, 0xc8, 0x47, 0x56, 0x86, 0xae, 0xf6, 0x6c, 0x7f, 0x4c, 0xfa, 0x52, 0x1a, 0x68, 0x24,
+	0x24, 0x9a, 0x34, 0x7d, 0x85, 0x24, 0x46, 0xa5, 0xbe, 0x98, 0x02, 0xa9, 0x42, 0x78, 0x30, 0xd1,
+	0x79, 0xb3, 0x40, 0xcb, 0x7d, 0x9c, 0x7b, 0x5e, 0x8e, 0xf4, 0x95, 0x94, 0xe8, 0x48, 0x51, 0x74,
+	0x31, 0xe8, 0xab, 0x28, 0x71, 0x9b, 0xd1, 0x17, 0x53, 0x20, 0x55, 0x08, 0x07, 0xc6, 0xe3, 0x83,
+	0x0e, 0xf5, 0x4b, 0x78, 0x8f, 0x49, 0xad, 0xbf, 0x97, 0x0a, 0x1b, 0x69, 0x89, 0x26, 0x47, 0x5f,
+	0x2d, 0x89, 0xc1, 0xaa, 0x2f, 0xa6, 0x40, 0xaa, 0x10, 0x4f, 0x34, 0xb8, 0xd3, 0xbb, 0x71, 0xa3,
+	0x0f, 0xfa, 0xec, 0x72, 0xe9, 0x4c, 0xd2, 0x3f, 0x1c, 0xd0, 0x4b, 0xf1, 0xf8, 0x16, 0x6e, 0x76,
+	0xb4, 0x5d, 0xd4, 0x2f, 0x51, 0xbd, 0xe6, 0x87, 0xbe, 0x9c, 0x0e, 0x1c, 0xc5, 0xea, 0xe8, 0x90,
+	0x7d, 0x63, 0xf5, 0xea, 0xea, 0xfa, 0x72, 0x3a, 0xb0, 0x8a, 0xd5, 0x02, 0x94, 0x6c, 0x70, 0x68,
+	0xf5, 0xd2, 0x9a, 0xee, 0xd1, 0x9b, 0xf5, 0xb5, 0x01, 0x3c, 0x64, 0x68, 0x7d, 0xe4, 0x87, 0xf0,
+	0x72, 0x52, 0xb1, 0x5e, 0x9e, 0x16, 0xb5, 0x57, 0xa7, 0x45, 0xed, 0x9f, 0xd3, 0xa2, 0xf6, 0xec,
+	0xac, 0x98, 0x79, 0x75, 0x56, 0xcc, 0xfc, 0x79, 0x56, 0xcc, 0xc0, 0x94, 0x4b, 0x7b, 0xec, 0xba,
+	0xa3, 0x7d, 0xbd, 0x1a, 0xbb, 0x0f, 0x45, 0x80, 0x15, 0x97, 0xc6, 0xde, 0xca, 0x47, 0xf2, 0xd7,
+	0xb3, 0xb8, 0x1d, 0xed, 0x8d, 0x8a, 0x1f, 0xcf, 0xef, 0xbf, 0x1e, 0x00, 0x16, 0x84, 0xed, 0x10,
+	0x0b, 0x10, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// BindName binds a name to an address under a root name.
+	BindName(ctx context.Context, in *MsgBindNameRequest, opts ...grpc.CallOption) (*MsgBindNameResponse, error)
+	// DeleteName defines a method to verify a particular invariance.
+	DeleteName(ctx context.Context, in *MsgDeleteNameRequest, opts ...grpc.CallOption) (*MsgDeleteNameResponse, error)
+	// DeleteNames defines a method to remove a name, optionally along with all of the names under it.
+	DeleteNames(ctx context.Context, in *MsgDeleteNamesRequest, opts ...grpc.CallOption) (*MsgDeleteNamesResponse, error)
+	// ModifyName defines a method to modify the attributes of an existing name.
+	ModifyName(ctx context.Context, in *MsgModifyNameRequest, opts ...grpc.CallOption) (*MsgModifyNameResponse, error)
+	// CreateRootName defines a governance method for creating a root name.
+	CreateRootName(ctx context.Context, in *MsgCreateRootNameRequest, opts ...grpc.CallOption) (*MsgCreateRootNameResponse, error)
+	// RemoveName defines a governance method for forcibly removing a name, even if it is restricted.
+	RemoveName(ctx context.Context, in *MsgRemoveNameRequest, opts ...grpc.CallOption) (*MsgRemoveNameResponse, error)
+	// UpdateParams is a governance proposal endpoint for updating the name module's params.
+	UpdateParams(ctx context.Context, in *MsgUpdateParamsRequest, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// SendByName sends coins to the address that a name resolves to when the message is executed.
+	SendByName(ctx context.Context, in *MsgSendByNameRequest, opts ...grpc.CallOption) (*MsgSendByNameResponse, error)
+	// ApproveAddressRotation records the new address's approval to have an old address rotated to it.
+	ApproveAddressRotation(ctx context.Context, in *MsgApproveAddressRotationRequest, opts ...grpc.CallOption) (*MsgApproveAddressRotationResponse, error)
+	// RotateAddress moves the names, owned attributes, and marker access grants of an old address to a new address.
+	// The new address must have first approved the rotation using ApproveAddressRotation.
+	RotateAddress(ctx context.Context, in *MsgRotateAddressRequest, opts ...grpc.CallOption) (*MsgRotateAddressResponse, error)
+	// SetChildQuota sets (or removes) the limit on the number of names that can be bound directly under a name.
+	SetChildQuota(ctx context.Context, in *MsgSetChildQuotaRequest, opts ...grpc.CallOption) (*MsgSetChildQuotaResponse, error)
+	// CreateNamedAccount binds a name to a new account whose address is derived from the name.
+	CreateNamedAccount(ctx context.Context, in *MsgCreateNamedAccountRequest, opts ...grpc.CallOption) (*MsgCreateNamedAccountResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) BindName(ctx context.Context, in *MsgBindNameRequest, opts ...grpc.CallOption) (*MsgBindNameResponse, error) {
+	out := new(MsgBindNameResponse)
+	err := c.cc.Invoke(ctx, "/provenance.name.v1.Msg/BindName", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return out, nil
 }
-func (m *MsgBindNameRequest) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (c *msgClient) DeleteName(ctx context.Context, in *MsgDeleteNameRequest, opts ...grpc.CallOption) (*MsgDeleteNameResponse, error) {
+	out := new(MsgDeleteNameResponse)
+	err := c.cc.Invoke(ctx, "/provenance.name.v1.Msg/DeleteName", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Parent.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.Record.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return out, nil
 }
 
-func (m *MsgBindNameResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) DeleteNames(ctx context.Context, in *MsgDeleteNamesRequest, opts ...grpc.CallOption) (*MsgDeleteNamesResponse, error) {
+	out := new(MsgDeleteNamesResponse)
+	err := c.cc.Invoke(ctx, "/provenance.name.v1.Msg/DeleteNames", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *MsgDeleteNameRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) ModifyName(ctx context.Context, in *MsgModifyNameRequest, opts ...grpc.CallOption) (*MsgModifyNameResponse, error) {
+	out := new(MsgModifyNameResponse)
+	err := c.cc.Invoke(ctx, "/provenance.name.v1.Msg/ModifyName", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Record.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return out, nil
 }
 
-func (m *MsgDeleteNameResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) CreateRootName(ctx context.Context, in *MsgCreateRootNameRequest, opts ...grpc.CallOption) (*MsgCreateRootNameResponse, error) {
+	out := new(MsgCreateRootNameResponse)
+	err := c.cc.Invoke(ctx, "/provenance.name.v1.Msg/CreateRootName", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *MsgCreateRootNameRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) RemoveName(ctx context.Context, in *MsgRemoveNameRequest, opts ...grpc.CallOption) (*MsgRemoveNameResponse, error) {
+	out := new(MsgRemoveNameResponse)
+	err := c.cc.Invoke(ctx, "/provenance.name.v1.Msg/RemoveName", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParamsRequest, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/provenance.name.v1.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.Record != nil {
-		l = m.Record.Size()
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) SendByName(ctx context.Context, in *MsgSendByNameRequest, opts ...grpc.CallOption) (*MsgSendByNameResponse, error) {
+	out := new(MsgSendByNameResponse)
+	err := c.cc.Invoke(ctx, "/provenance.name.v1.Msg/SendByName", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ApproveAddressRotation(ctx context.Context, in *MsgApproveAddressRotationRequest, opts ...grpc.CallOption) (*MsgApproveAddressRotationResponse, error) {
+	out := new(MsgApproveAddressRotationResponse)
+	err := c.cc.Invoke(ctx, "/provenance.name.v1.Msg/ApproveAddressRotation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RotateAddress(ctx context.Context, in *MsgRotateAddressRequest, opts ...grpc.CallOption) (*MsgRotateAddressResponse, error) {
+	out := new(MsgRotateAddressResponse)
+	err := c.cc.Invoke(ctx, "/provenance.name.v1.Msg/RotateAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetChildQuota(ctx context.Context, in *MsgSetChildQuotaRequest, opts ...grpc.CallOption) (*MsgSetChildQuotaResponse, error) {
+	out := new(MsgSetChildQuotaResponse)
+	err := c.cc.Invoke(ctx, "/provenance.name.v1.Msg/SetChildQuota", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CreateNamedAccount(ctx context.Context, in *MsgCreateNamedAccountRequest, opts ...grpc.CallOption) (*MsgCreateNamedAccountResponse, error) {
+	out := new(MsgCreateNamedAccountResponse)
+	err := c.cc.Invoke(ctx, "/provenance.name.v1.Msg/CreateNamedAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// BindName binds a name to an address under a root name.
+	BindName(context.Context, *MsgBindNameRequest) (*MsgBindNameResponse, error)
+	// DeleteName defines a method to verify a particular invariance.
+	DeleteName(context.Context, *MsgDeleteNameRequest) (*MsgDeleteNameResponse, error)
+	// DeleteNames defines a method to remove a name, optionally along with all of the names under it.
+	DeleteNames(context.Context, *MsgDeleteNamesRequest) (*MsgDeleteNamesResponse, error)
+	// ModifyName defines a method to modify the attributes of an existing name.
+	ModifyName(context.Context, *MsgModifyNameRequest) (*MsgModifyNameResponse, error)
+	// CreateRootName defines a governance method for creating a root name.
+	CreateRootName(context.Context, *MsgCreateRootNameRequest) (*MsgCreateRootNameResponse, error)
+	// RemoveName defines a governance method for forcibly removing a name, even if it is restricted.
+	RemoveName(context.Context, *MsgRemoveNameRequest) (*MsgRemoveNameResponse, error)
+	// UpdateParams is a governance proposal endpoint for updating the name module's params.
+	UpdateParams(context.Context, *MsgUpdateParamsRequest) (*MsgUpdateParamsResponse, error)
+	// SendByName sends coins to the address that a name resolves to when the message is executed.
+	SendByName(context.Context, *MsgSendByNameRequest) (*MsgSendByNameResponse, error)
+	// ApproveAddressRotation records the new address's approval to have an old address rotated to it.
+	ApproveAddressRotation(context.Context, *MsgApproveAddressRotationRequest) (*MsgApproveAddressRotationResponse, error)
+	// RotateAddress moves the names, owned attributes, and marker access grants of an old address to a new address.
+	// The new address must have first approved the rotation using ApproveAddressRotation.
+	RotateAddress(context.Context, *MsgRotateAddressRequest) (*MsgRotateAddressResponse, error)
+	// SetChildQuota sets (or removes) the limit on the number of names that can be bound directly under a name.
+	SetChildQuota(context.Context, *MsgSetChildQuotaRequest) (*MsgSetChildQuotaResponse, error)
+	// CreateNamedAccount binds a name to a new account whose address is derived from the name.
+	CreateNamedAccount(context.Context, *MsgCreateNamedAccountRequest) (*MsgCreateNamedAccountResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) BindName(ctx context.Context, req *MsgBindNameRequest) (*MsgBindNameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BindName not implemented")
+}
+func (*UnimplementedMsgServer) DeleteName(ctx context.Context, req *MsgDeleteNameRequest) (*MsgDeleteNameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteName not implemented")
+}
+func (*UnimplementedMsgServer) DeleteNames(ctx context.Context, req *MsgDeleteNamesRequest) (*MsgDeleteNamesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteNames not implemented")
+}
+func (*UnimplementedMsgServer) ModifyName(ctx context.Context, req *MsgModifyNameRequest) (*MsgModifyNameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ModifyName not implemented")
+}
+func (*UnimplementedMsgServer) CreateRootName(ctx context.Context, req *MsgCreateRootNameRequest) (*MsgCreateRootNameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRootName not implemented")
+}
+func (*UnimplementedMsgServer) RemoveName(ctx context.Context, req *MsgRemoveNameRequest) (*MsgRemoveNameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveName not implemented")
+}
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParamsRequest) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+func (*UnimplementedMsgServer) SendByName(ctx context.Context, req *MsgSendByNameRequest) (*MsgSendByNameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendByName not implemented")
+}
+func (*UnimplementedMsgServer) ApproveAddressRotation(ctx context.Context, req *MsgApproveAddressRotationRequest) (*MsgApproveAddressRotationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveAddressRotation not implemented")
+}
+func (*UnimplementedMsgServer) RotateAddress(ctx context.Context, req *MsgRotateAddressRequest) (*MsgRotateAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateAddress not implemented")
+}
+func (*UnimplementedMsgServer) SetChildQuota(ctx context.Context, req *MsgSetChildQuotaRequest) (*MsgSetChildQuotaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetChildQuota not implemented")
+}
+func (*UnimplementedMsgServer) CreateNamedAccount(ctx context.Context, req *MsgCreateNamedAccountRequest) (*MsgCreateNamedAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateNamedAccount not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_BindName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBindNameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).BindName(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.name.v1.Msg/BindName",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BindName(ctx, req.(*MsgBindNameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DeleteName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeleteNameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DeleteName(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.name.v1.Msg/DeleteName",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeleteName(ctx, req.(*MsgDeleteNameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DeleteNames_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeleteNamesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DeleteNames(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.name.v1.Msg/DeleteNames",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeleteNames(ctx, req.(*MsgDeleteNamesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ModifyName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgModifyNameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ModifyName(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.name.v1.Msg/ModifyName",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ModifyName(ctx, req.(*MsgModifyNameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateRootName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateRootNameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateRootName(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.name.v1.Msg/CreateRootName",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateRootName(ctx, req.(*MsgCreateRootNameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveNameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveName(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.name.v1.Msg/RemoveName",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveName(ctx, req.(*MsgRemoveNameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.name.v1.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SendByName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSendByNameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SendByName(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.name.v1.Msg/SendByName",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SendByName(ctx, req.(*MsgSendByNameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ApproveAddressRotation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgApproveAddressRotationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ApproveAddressRotation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.name.v1.Msg/ApproveAddressRotation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ApproveAddressRotation(ctx, req.(*MsgApproveAddressRotationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RotateAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRotateAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RotateAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.name.v1.Msg/RotateAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RotateAddress(ctx, req.(*MsgRotateAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetChildQuota_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetChildQuotaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetChildQuota(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.name.v1.Msg/SetChildQuota",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetChildQuota(ctx, req.(*MsgSetChildQuotaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateNamedAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateNamedAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateNamedAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.name.v1.Msg/CreateNamedAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateNamedAccount(ctx, req.(*MsgCreateNamedAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Msg_serviceDesc = _Msg_serviceDesc
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "provenance.name.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "BindName",
+			Handler:    _Msg_BindName_Handler,
+		},
+		{
+			MethodName: "DeleteName",
+			Handler:    _Msg_DeleteName_Handler,
+		},
+		{
+			MethodName: "DeleteNames",
+			Handler:    _Msg_DeleteNames_Handler,
+		},
+		{
+			MethodName: "ModifyName",
+			Handler:    _Msg_ModifyName_Handler,
+		},
+		{
+			MethodName: "CreateRootName",
+			Handler:    _Msg_CreateRootName_Handler,
+		},
+		{
+			MethodName: "RemoveName",
+			Handler:    _Msg_RemoveName_Handler,
+		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+		{
+			MethodName: "SendByName",
+			Handler:    _Msg_SendByName_Handler,
+		},
+		{
+			MethodName: "ApproveAddressRotation",
+			Handler:    _Msg_ApproveAddressRotation_Handler,
+		},
+		{
+			MethodName: "RotateAddress",
+			Handler:    _Msg_RotateAddress_Handler,
+		},
+		{
+			MethodName: "SetChildQuota",
+			Handler:    _Msg_SetChildQuota_Handler,
+		},
+		{
+			MethodName: "CreateNamedAccount",
+			Handler:    _Msg_CreateNamedAccount_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "provenance/name/v1/tx.proto",
+}
+
+func (m *MsgBindNameRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBindNameRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBindNameRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ParentApproval != nil {
+		{
+			size, err := m.ParentApproval.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.Record.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Parent.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBindNameResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBindNameResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBindNameResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeleteNameRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteNameRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteNameRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Record.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeleteNameResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteNameResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteNameResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeleteNamesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteNamesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteNamesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Recursive {
+		i--
+		if m.Recursive {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeleteNamesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteNamesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteNamesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DeletedNames) > 0 {
+		for iNdEx := len(m.DeletedNames) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DeletedNames[iNdEx])
+			copy(dAtA[i:], m.DeletedNames[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.DeletedNames[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateRootNameRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateRootNameRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateRootNameRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Record != nil {
+		{
+			size, err := m.Record.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateRootNameResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateRootNameResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateRootNameResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveNameRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveNameRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveNameRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Recursive {
+		i--
+		if m.Recursive {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveNameResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveNameResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveNameResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RemovedNames) > 0 {
+		for iNdEx := len(m.RemovedNames) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RemovedNames[iNdEx])
+			copy(dAtA[i:], m.RemovedNames[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.RemovedNames[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgModifyNameRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgModifyNameRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgModifyNameRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Record.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgModifyNameResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgModifyNameResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgModifyNameResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSendByNameRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSendByNameRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSendByNameRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.ToName) > 0 {
+		i -= len(m.ToName)
+		copy(dAtA[i:], m.ToName)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ToName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FromAddress) > 0 {
+		i -= len(m.FromAddress)
+		copy(dAtA[i:], m.FromAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FromAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSendByNameResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSendByNameResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSendByNameResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ToAddress) > 0 {
+		i -= len(m.ToAddress)
+		copy(dAtA[i:], m.ToAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ToAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgApproveAddressRotationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgApproveAddressRotationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgApproveAddressRotationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OldAddress) > 0 {
+		i -= len(m.OldAddress)
+		copy(dAtA[i:], m.OldAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OldAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.NewAddress) > 0 {
+		i -= len(m.NewAddress)
+		copy(dAtA[i:], m.NewAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgApproveAddressRotationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgApproveAddressRotationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgApproveAddressRotationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRotateAddressRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRotateAddressRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRotateAddressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NewAddress) > 0 {
+		i -= len(m.NewAddress)
+		copy(dAtA[i:], m.NewAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OldAddress) > 0 {
+		i -= len(m.OldAddress)
+		copy(dAtA[i:], m.OldAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OldAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRotateAddressResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRotateAddressResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRotateAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Names) > 0 {
+		for iNdEx := len(m.Names) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Names[iNdEx])
+			copy(dAtA[i:], m.Names[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Names[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetChildQuotaRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetChildQuotaRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetChildQuotaRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.MaxChildren != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MaxChildren))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetChildQuotaResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetChildQuotaResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetChildQuotaResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateNamedAccountRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateNamedAccountRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateNamedAccountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Restricted {
+		i--
+		if m.Restricted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateNamedAccountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateNamedAccountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateNamedAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgBindNameRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Parent.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.Record.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.ParentApproval != nil {
+		l = m.ParentApproval.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgBindNameResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDeleteNameRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Record.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgDeleteNameResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDeleteNamesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Recursive {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgDeleteNamesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.DeletedNames) > 0 {
+		for _, s := range m.DeletedNames {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgCreateRootNameRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Record != nil {
+		l = m.Record.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCreateRootNameResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveNameRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Recursive {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgRemoveNameResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RemovedNames) > 0 {
+		for _, s := range m.RemovedNames {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgModifyNameRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Record.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgModifyNameResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSendByNameRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FromAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ToName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSendByNameResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ToAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgApproveAddressRotationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NewAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.OldAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgApproveAddressRotationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRotateAddressRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OldAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.NewAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRotateAddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Names) > 0 {
+		for _, s := range m.Names {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSetChildQuotaRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.MaxChildren != 0 {
+		n += 1 + sovTx(uint64(m.MaxChildren))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetChildQuotaResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCreateNamedAccountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Restricted {
+		n += 2
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCreateNamedAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgBindNameRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBindNameRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBindNameRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Parent", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Parent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Record", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Record.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParentApproval", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ParentApproval == nil {
+				m.ParentApproval = &ParentApproval{}
+			}
+			if err := m.ParentApproval.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBindNameResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBindNameResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBindNameResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeleteNameRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteNameRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteNameRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Record", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Record.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeleteNameResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteNameResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteNameResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeleteNamesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteNamesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteNamesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recursive", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Recursive = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeleteNamesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteNamesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteNamesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeletedNames", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeletedNames = append(m.DeletedNames, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateRootNameRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateRootNameRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateRootNameRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Record", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Record == nil {
+				m.Record = &NameRecord{}
+			}
+			if err := m.Record.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateRootNameResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateRootNameResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateRootNameResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveNameRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveNameRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveNameRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recursive", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Recursive = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveNameResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveNameResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveNameResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemovedNames", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemovedNames = append(m.RemovedNames, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgModifyNameRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgModifyNameRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgModifyNameRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Record", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Record.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgModifyNameResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgModifyNameResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgModifyNameResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *MsgCreateRootNameResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
-
-func (m *MsgModifyNameRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgUpdateParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	l = m.Record.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
 
-func (m *MsgModifyNameResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
-
-func (m *MsgUpdateParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgBindNameRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgSendByNameRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1224,17 +4325,49 @@ func (m *MsgBindNameRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBindNameRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSendByNameRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBindNameRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSendByNameRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Parent", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1244,28 +4377,27 @@ func (m *MsgBindNameRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Parent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ToName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Record", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1292,7 +4424,8 @@ func (m *MsgBindNameRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Record.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1317,7 +4450,7 @@ func (m *MsgBindNameRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgBindNameResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSendByNameResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1340,12 +4473,44 @@ func (m *MsgBindNameResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBindNameResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSendByNameResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBindNameResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSendByNameResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1367,7 +4532,7 @@ func (m *MsgBindNameResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteNameRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgApproveAddressRotationRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1390,17 +4555,17 @@ func (m *MsgDeleteNameRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteNameRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgApproveAddressRotationRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteNameRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgApproveAddressRotationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Record", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1410,24 +4575,55 @@ func (m *MsgDeleteNameRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Record.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.NewAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.OldAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1450,7 +4646,7 @@ func (m *MsgDeleteNameRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteNameResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgApproveAddressRotationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1473,10 +4669,10 @@ func (m *MsgDeleteNameResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteNameResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgApproveAddressRotationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteNameResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgApproveAddressRotationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1500,7 +4696,7 @@ func (m *MsgDeleteNameResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateRootNameRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgRotateAddressRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1523,15 +4719,15 @@ func (m *MsgCreateRootNameRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateRootNameRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRotateAddressRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateRootNameRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRotateAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OldAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1559,13 +4755,13 @@ func (m *MsgCreateRootNameRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.OldAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Record", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1575,27 +4771,23 @@ func (m *MsgCreateRootNameRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Record == nil {
-				m.Record = &NameRecord{}
-			}
-			if err := m.Record.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.NewAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1618,7 +4810,7 @@ func (m *MsgCreateRootNameRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateRootNameResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRotateAddressResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1641,12 +4833,44 @@ func (m *MsgCreateRootNameResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateRootNameResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRotateAddressResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateRootNameResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRotateAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Names", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Names = append(m.Names, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1668,7 +4892,7 @@ func (m *MsgCreateRootNameResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgModifyNameRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgSetChildQuotaRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1691,15 +4915,15 @@ func (m *MsgModifyNameRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgModifyNameRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetChildQuotaRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgModifyNameRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetChildQuotaRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1727,13 +4951,32 @@ func (m *MsgModifyNameRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxChildren", wireType)
+			}
+			m.MaxChildren = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxChildren |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Record", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1743,24 +4986,23 @@ func (m *MsgModifyNameRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Record.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1783,7 +5025,7 @@ func (m *MsgModifyNameRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgModifyNameResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetChildQuotaResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1806,10 +5048,10 @@ func (m *MsgModifyNameResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgModifyNameResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetChildQuotaResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgModifyNameResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetChildQuotaResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1833,7 +5075,7 @@ func (m *MsgModifyNameResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgCreateNamedAccountRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1856,15 +5098,15 @@ func (m *MsgUpdateParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreateNamedAccountRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreateNamedAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1892,13 +5134,33 @@ func (m *MsgUpdateParamsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Restricted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Restricted = bool(v != 0)
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1908,24 +5170,23 @@ func (m *MsgUpdateParamsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1948,7 +5209,7 @@ func (m *MsgUpdateParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCreateNamedAccountResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1971,12 +5232,44 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreateNamedAccountResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreateNamedAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
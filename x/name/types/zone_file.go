@@ -0,0 +1,117 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+const (
+	// DefaultZoneFileTTL is the record TTL (in seconds) used in a zone file when one isn't requested.
+	DefaultZoneFileTTL uint32 = 3600
+	// MaxDNSLabelLength is the maximum number of octets in a single DNS label (RFC 1035 section 2.3.4).
+	MaxDNSLabelLength = 63
+	// MaxDNSNameLength is the maximum number of octets in a full DNS name (RFC 1035 section 2.3.4).
+	MaxDNSNameLength = 255
+)
+
+// ValidateZoneDomain returns an error if the provided domain cannot be used as the suffix of a zone file's origin.
+// An empty domain is valid. A single trailing dot is allowed.
+func ValidateZoneDomain(domain string) error {
+	domain = strings.TrimSuffix(domain, ".")
+	if len(domain) == 0 {
+		return nil
+	}
+	if len(domain) >= MaxDNSNameLength {
+		return fmt.Errorf("invalid domain %q: cannot be longer than %d characters", domain, MaxDNSNameLength-1)
+	}
+	for _, label := range strings.Split(domain, ".") {
+		if len(label) == 0 {
+			return fmt.Errorf("invalid domain %q: empty label", domain)
+		}
+		if len(label) > MaxDNSLabelLength {
+			return fmt.Errorf("invalid domain %q: label %q is longer than %d characters", domain, label, MaxDNSLabelLength)
+		}
+		for _, c := range label {
+			if !isDNSLabelChar(c) && c != '_' && (c < 'A' || c > 'Z') {
+				return fmt.Errorf("invalid domain %q: illegal character %q in label %q", domain, string(c), label)
+			}
+		}
+	}
+	return nil
+}
+
+// ZoneFileOrigin returns the (absolute) origin of a zone file for the provided name and domain.
+func ZoneFileOrigin(name, domain string) (string, error) {
+	if err := ValidateZoneDomain(domain); err != nil {
+		return "", err
+	}
+	if err := ValidateZoneFileNameLength(name, domain); err != nil {
+		return "", err
+	}
+	origin, err := ZoneFileOwnerName(name)
+	if err != nil {
+		return "", err
+	}
+	if domain = strings.TrimSuffix(domain, "."); len(domain) > 0 {
+		origin += "." + domain
+	}
+	return origin + ".", nil
+}
+
+// ValidateZoneFileNameLength returns an error if the provided name, under the provided domain,
+// is too long to be a DNS name. Length is measured in octets, not in escaped characters.
+func ValidateZoneFileNameLength(name, domain string) error {
+	l := len(name)
+	if domain = strings.TrimSuffix(domain, "."); len(domain) > 0 {
+		l += 1 + len(domain)
+	}
+	if l >= MaxDNSNameLength {
+		return fmt.Errorf("name %q under domain %q is longer than %d octets", name, domain, MaxDNSNameLength-1)
+	}
+	return nil
+}
+
+// ZoneFileOwnerName converts a (normalized) name into the format used for owner names in a zone file.
+// Each segment becomes a label. Characters that aren't allowed in a DNS label are written
+// as \DDD escapes (RFC 1035 section 5.1), one for each of their octets.
+// An error is returned if a segment is longer than a DNS label can be.
+func ZoneFileOwnerName(name string) (string, error) {
+	if len(name) == 0 {
+		return "", errors.New("name cannot be empty")
+	}
+	segments := strings.Split(name, ".")
+	labels := make([]string, len(segments))
+	for i, segment := range segments {
+		if len(segment) > MaxDNSLabelLength {
+			return "", fmt.Errorf("segment %q of name %q is longer than %d octets", segment, name, MaxDNSLabelLength)
+		}
+		labels[i] = escapeDNSLabel(segment)
+	}
+	return strings.Join(labels, "."), nil
+}
+
+// escapeDNSLabel returns the provided label with all non-label characters written as \DDD escapes.
+func escapeDNSLabel(label string) string {
+	var sb strings.Builder
+	for i := 0; i < len(label); i++ {
+		c := label[i]
+		if isDNSLabelChar(rune(c)) {
+			sb.WriteByte(c)
+			continue
+		}
+		sb.WriteString(fmt.Sprintf("\\%03d", c))
+	}
+	return sb.String()
+}
+
+// isDNSLabelChar returns true if the provided character can be used as-is in a lower-case DNS label.
+func isDNSLabelChar(c rune) bool {
+	return (c >= 'a' && c <= 'z') || (c >= '0' && c <= '9') || c == '-'
+}
+
+// ZoneFileTXTRecord returns the zone file line with the TXT record for the provided name record.
+// The owner name is relative to the zone's origin, or "@" for the origin itself.
+func ZoneFileTXTRecord(owner string, record NameRecord) string {
+	return fmt.Sprintf("%s\tIN\tTXT\t\"address=%s\" \"restricted=%t\"", owner, record.Address, record.Restricted)
+}
@@ -0,0 +1,114 @@
+package types_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/provenance-io/provenance/testutil/assertions"
+	. "github.com/provenance-io/provenance/x/name/types"
+)
+
+func TestValidateZoneDomain(t *testing.T) {
+	tests := []struct {
+		name   string
+		domain string
+		expErr string
+	}{
+		{name: "empty", domain: ""},
+		{name: "just a dot", domain: "."},
+		{name: "one label", domain: "example"},
+		{name: "multiple labels", domain: "names.example.com"},
+		{name: "trailing dot", domain: "names.example.com."},
+		{name: "upper case and underscore", domain: "_names.Example.COM"},
+		{name: "label at max length", domain: strings.Repeat("a", 63) + ".com"},
+		{
+			name:   "label too long",
+			domain: strings.Repeat("a", 64) + ".com",
+			expErr: `invalid domain "` + strings.Repeat("a", 64) + `.com": label "` + strings.Repeat("a", 64) + `" is longer than 63 characters`,
+		},
+		{
+			name:   "domain too long",
+			domain: strings.Repeat("abc.", 63) + "com",
+			expErr: `invalid domain "` + strings.Repeat("abc.", 63) + `com": cannot be longer than 254 characters`,
+		},
+		{name: "empty label", domain: "names..com", expErr: `invalid domain "names..com": empty label`},
+		{name: "leading dot", domain: ".names.com", expErr: `invalid domain ".names.com": empty label`},
+		{name: "space", domain: "my names.com", expErr: `invalid domain "my names.com": illegal character " " in label "my names"`},
+		{name: "non-ascii", domain: "café.com", expErr: `invalid domain "café.com": illegal character "é" in label "café"`},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := ValidateZoneDomain(tc.domain)
+			assertions.AssertErrorValue(t, err, tc.expErr, "ValidateZoneDomain(%q)", tc.domain)
+		})
+	}
+}
+
+func TestZoneFileOrigin(t *testing.T) {
+	tests := []struct {
+		name    string
+		pbName  string
+		domain  string
+		expOrig string
+		expErr  string
+	}{
+		{name: "no domain", pbName: "example.pb", expOrig: "example.pb."},
+		{name: "with domain", pbName: "example.pb", domain: "names.example.com", expOrig: "example.pb.names.example.com."},
+		{name: "domain with trailing dot", pbName: "pb", domain: "example.com.", expOrig: "pb.example.com."},
+		{name: "escaped name", pbName: "café.pb", expOrig: "caf\\195\\169.pb."},
+		{name: "empty name", pbName: "", expErr: "name cannot be empty"},
+		{name: "bad domain", pbName: "pb", domain: "a..b", expErr: `invalid domain "a..b": empty label`},
+		{
+			name:   "too long",
+			pbName: strings.Repeat("a", 60) + ".pb",
+			domain: strings.Repeat("abc.", 48) + "com",
+			expErr: `name "` + strings.Repeat("a", 60) + `.pb" under domain "` + strings.Repeat("abc.", 48) + `com" is longer than 254 octets`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			origin, err := ZoneFileOrigin(tc.pbName, tc.domain)
+			assertions.AssertErrorValue(t, err, tc.expErr, "ZoneFileOrigin(%q, %q) error", tc.pbName, tc.domain)
+			assert.Equal(t, tc.expOrig, origin, "ZoneFileOrigin(%q, %q) result", tc.pbName, tc.domain)
+		})
+	}
+}
+
+func TestZoneFileOwnerName(t *testing.T) {
+	tests := []struct {
+		name   string
+		pbName string
+		exp    string
+		expErr string
+	}{
+		{name: "empty", pbName: "", expErr: "name cannot be empty"},
+		{name: "one segment", pbName: "pb", exp: "pb"},
+		{name: "multiple segments", pbName: "my-name.example.pb", exp: "my-name.example.pb"},
+		{name: "uuid", pbName: "91978ba2-5f35-459a-86a7-feca1b0512e0.pb", exp: "91978ba2-5f35-459a-86a7-feca1b0512e0.pb"},
+		{name: "non-ascii", pbName: "ünï.pb", exp: "\\195\\188n\\195\\175.pb"},
+		{name: "segment at max length", pbName: strings.Repeat("a", 63) + ".pb", exp: strings.Repeat("a", 63) + ".pb"},
+		{
+			name:   "segment too long",
+			pbName: strings.Repeat("a", 64) + ".pb",
+			expErr: `segment "` + strings.Repeat("a", 64) + `" of name "` + strings.Repeat("a", 64) + `.pb" is longer than 63 octets`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			owner, err := ZoneFileOwnerName(tc.pbName)
+			assertions.AssertErrorValue(t, err, tc.expErr, "ZoneFileOwnerName(%q) error", tc.pbName)
+			assert.Equal(t, tc.exp, owner, "ZoneFileOwnerName(%q) result", tc.pbName)
+		})
+	}
+}
+
+func TestZoneFileTXTRecord(t *testing.T) {
+	record := NameRecord{Name: "example.pb", Address: "pb1example", Restricted: true}
+	exp := "example\tIN\tTXT\t\"address=pb1example\" \"restricted=true\""
+	assert.Equal(t, exp, ZoneFileTXTRecord("example", record), "ZoneFileTXTRecord")
+}
@@ -24,6 +24,8 @@ func GetQueryCmd() *cobra.Command {
 	}
 	queryCmd.AddCommand(
 		GetQueryOracleAddressCmd(),
+		GetQueryPriceCmd(),
+		GetQueryPricesCmd(),
 	)
 	return queryCmd
 }
@@ -59,3 +61,72 @@ func GetQueryOracleAddressCmd() *cobra.Command {
 
 	return cmd
 }
+
+// GetQueryPriceCmd queries for the price committed for a pair
+func GetQueryPriceCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "price <pair>",
+		Short:   "Returns the price most recently committed for a pair",
+		Args:    cobra.ExactArgs(1),
+		Aliases: []string{"p"},
+		Example: fmt.Sprintf(`%[1]s q oracle price nhash/usd`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryPriceRequest{Pair: args[0]}
+
+			res, err := queryClient.Price(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetQueryPricesCmd queries for the prices committed for all pairs
+func GetQueryPricesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "prices",
+		Short:   "Returns the prices most recently committed for all pairs",
+		Args:    cobra.ExactArgs(0),
+		Example: fmt.Sprintf(`%[1]s q oracle prices`, version.AppName),
+		RunE: func(cmd *cobra.Command, _ []string) (err error) {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequestWithPageKeyDecoded(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryPricesRequest{Pagination: pageReq}
+
+			res, err := queryClient.Prices(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "prices")
+
+	return cmd
+}
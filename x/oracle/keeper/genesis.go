@@ -9,9 +9,14 @@ import (
 // ExportGenesis returns a GenesisState for a given context.
 func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 	oracle, _ := k.GetOracle(ctx)
+	prices, err := k.GetAllPrices(ctx)
+	if err != nil {
+		panic(err)
+	}
 	return &types.GenesisState{
 		PortId: k.GetPort(ctx),
 		Oracle: oracle.String(),
+		Prices: prices,
 	}
 }
 
@@ -36,4 +41,8 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState *types.GenesisState) {
 		oracle = sdk.MustAccAddressFromBech32(genState.Oracle)
 	}
 	k.SetOracle(ctx, oracle)
+
+	for _, price := range genState.Prices {
+		k.SetPrice(ctx, price)
+	}
 }
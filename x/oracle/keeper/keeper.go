@@ -13,6 +13,7 @@ import (
 	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
 	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
 
+	"github.com/provenance-io/provenance/internal/provutils"
 	"github.com/provenance-io/provenance/x/oracle/types"
 )
 
@@ -57,7 +58,7 @@ func NewKeeper(
 
 // Logger returns the correctly named logger for the module
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
-	return ctx.Logger().With("module", "x/"+types.ModuleName)
+	return provutils.ModuleLogger(ctx, types.ModuleName)
 }
 
 // BindPort stores the provided portID and binds to it
@@ -0,0 +1,59 @@
+package keeper
+
+import (
+	"fmt"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/oracle/types"
+)
+
+// GetPrice returns the price most recently committed for a pair, or nil if there isn't one.
+func (k Keeper) GetPrice(ctx sdk.Context, pair string) (*types.PriceCommitment, error) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetPriceKey(pair))
+	if len(bz) == 0 {
+		return nil, nil
+	}
+	var price types.PriceCommitment
+	if err := k.cdc.Unmarshal(bz, &price); err != nil {
+		return nil, err
+	}
+	return &price, nil
+}
+
+// SetPrice stores a committed price, replacing any previous price for the pair.
+func (k Keeper) SetPrice(ctx sdk.Context, price types.PriceCommitment) {
+	bz := k.cdc.MustMarshal(&price)
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetPriceKey(price.Pair), bz)
+}
+
+// IteratePrices iterates over all the committed prices.
+func (k Keeper) IteratePrices(ctx sdk.Context, handler func(price types.PriceCommitment) (stop bool)) error {
+	store := ctx.KVStore(k.storeKey)
+	it := storetypes.KVStorePrefixIterator(store, types.PriceKeyPrefix)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		var price types.PriceCommitment
+		if err := k.cdc.Unmarshal(it.Value(), &price); err != nil {
+			return fmt.Errorf("invalid price commitment %X: %w", it.Key(), err)
+		}
+		if handler(price) {
+			break
+		}
+	}
+	return nil
+}
+
+// GetAllPrices returns all the committed prices.
+func (k Keeper) GetAllPrices(ctx sdk.Context) ([]types.PriceCommitment, error) {
+	var rv []types.PriceCommitment
+	err := k.IteratePrices(ctx, func(price types.PriceCommitment) bool {
+		rv = append(rv, price)
+		return false
+	})
+	return rv, err
+}
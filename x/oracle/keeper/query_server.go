@@ -7,7 +7,10 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"cosmossdk.io/store/prefix"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/provenance-io/provenance/x/oracle/types"
 )
@@ -45,3 +48,46 @@ func (k Keeper) Oracle(goCtx context.Context, req *types.QueryOracleRequest) (*t
 	}
 	return &types.QueryOracleResponse{Data: resp.Data}, nil
 }
+
+// Price returns the price most recently committed for a pair
+func (k Keeper) Price(goCtx context.Context, req *types.QueryPriceRequest) (*types.QueryPriceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if err := types.ValidatePricePair(req.Pair); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	price, err := k.GetPrice(ctx, req.Pair)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if price == nil {
+		return nil, status.Errorf(codes.NotFound, "no price committed for pair %q", req.Pair)
+	}
+	return &types.QueryPriceResponse{Price: *price}, nil
+}
+
+// Prices returns the prices most recently committed for all pairs
+func (k Keeper) Prices(goCtx context.Context, req *types.QueryPricesRequest) (*types.QueryPricesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	var prices []types.PriceCommitment
+	priceStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.PriceKeyPrefix)
+	pageRes, err := query.Paginate(priceStore, req.Pagination, func(_ []byte, value []byte) error {
+		var price types.PriceCommitment
+		if err := k.cdc.Unmarshal(value, &price); err != nil {
+			return status.Error(codes.Internal, err.Error())
+		}
+		prices = append(prices, price)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryPricesResponse{Prices: prices, Pagination: pageRes}, nil
+}
@@ -0,0 +1,134 @@
+package keeper
+
+import (
+	"fmt"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/oracle/types"
+)
+
+// ExtendVoteHandler returns the handler that attaches this validator's price observations to its votes.
+// If the source is nil, or fails to provide valid prices, the vote is extended with nothing.
+func (k Keeper) ExtendVoteHandler(source types.PriceSource) sdk.ExtendVoteHandler {
+	return func(ctx sdk.Context, req *abci.RequestExtendVote) (*abci.ResponseExtendVote, error) {
+		if source == nil {
+			return &abci.ResponseExtendVote{}, nil
+		}
+
+		prices, err := source.GetPrices(ctx)
+		if err != nil {
+			k.Logger(ctx).Error("could not get prices for vote extension", "height", req.Height, "error", err)
+			return &abci.ResponseExtendVote{}, nil
+		}
+		ext := types.PriceVoteExtension{Height: req.Height, Prices: prices}
+		if err = ext.Validate(); err != nil {
+			k.Logger(ctx).Error("invalid prices for vote extension", "height", req.Height, "error", err)
+			return &abci.ResponseExtendVote{}, nil
+		}
+
+		bz, err := ext.Marshal()
+		if err != nil {
+			return nil, err
+		}
+		return &abci.ResponseExtendVote{VoteExtension: bz}, nil
+	}
+}
+
+// VerifyVoteExtensionHandler returns the handler that rejects votes with invalid price vote extensions.
+// Votes without an extension are accepted.
+func (k Keeper) VerifyVoteExtensionHandler() sdk.VerifyVoteExtensionHandler {
+	return func(ctx sdk.Context, req *abci.RequestVerifyVoteExtension) (*abci.ResponseVerifyVoteExtension, error) {
+		if len(req.VoteExtension) == 0 {
+			return &abci.ResponseVerifyVoteExtension{Status: abci.ResponseVerifyVoteExtension_ACCEPT}, nil
+		}
+		if _, err := types.ParsePriceVoteExtension(req.VoteExtension, req.Height); err != nil {
+			k.Logger(ctx).Error("rejecting vote extension", "height", req.Height,
+				"validator", fmt.Sprintf("%X", req.ValidatorAddress), "error", err)
+			return &abci.ResponseVerifyVoteExtension{Status: abci.ResponseVerifyVoteExtension_REJECT}, nil
+		}
+		return &abci.ResponseVerifyVoteExtension{Status: abci.ResponseVerifyVoteExtension_ACCEPT}, nil
+	}
+}
+
+// PrepareProposalHandler returns a handler that injects the vote extensions of the previous block's votes
+// into the proposal as its first tx, then lets the provided handler fill the rest of the proposal.
+func (k Keeper) PrepareProposalHandler(valStore baseapp.ValidatorStore, next sdk.PrepareProposalHandler) sdk.PrepareProposalHandler {
+	return func(ctx sdk.Context, req *abci.RequestPrepareProposal) (*abci.ResponsePrepareProposal, error) {
+		if !types.VoteExtensionsAvailable(ctx, req.Height) {
+			return next(ctx, req)
+		}
+
+		if err := baseapp.ValidateVoteExtensions(ctx, valStore, req.Height, ctx.ChainID(), req.LocalLastCommit); err != nil {
+			return nil, err
+		}
+		injected, err := req.LocalLastCommit.Marshal()
+		if err != nil {
+			return nil, err
+		}
+
+		nextReq := *req
+		nextReq.MaxTxBytes -= int64(len(injected))
+		resp, err := next(ctx, &nextReq)
+		if err != nil {
+			return nil, err
+		}
+		resp.Txs = append([][]byte{injected}, resp.Txs...)
+		return resp, nil
+	}
+}
+
+// ProcessProposalHandler returns a handler that rejects proposals that don't start with valid injected
+// vote extensions, then lets the provided handler process the rest of the proposal.
+func (k Keeper) ProcessProposalHandler(valStore baseapp.ValidatorStore, next sdk.ProcessProposalHandler) sdk.ProcessProposalHandler {
+	return func(ctx sdk.Context, req *abci.RequestProcessProposal) (*abci.ResponseProcessProposal, error) {
+		if !types.VoteExtensionsAvailable(ctx, req.Height) {
+			return next(ctx, req)
+		}
+
+		reject := &abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_REJECT}
+		if len(req.Txs) == 0 {
+			k.Logger(ctx).Error("rejecting proposal without injected vote extensions", "height", req.Height)
+			return reject, nil
+		}
+		var extCommit abci.ExtendedCommitInfo
+		if err := extCommit.Unmarshal(req.Txs[0]); err != nil {
+			k.Logger(ctx).Error("rejecting proposal with invalid injected vote extensions", "height", req.Height, "error", err)
+			return reject, nil
+		}
+		if err := baseapp.ValidateVoteExtensions(ctx, valStore, req.Height, ctx.ChainID(), extCommit); err != nil {
+			k.Logger(ctx).Error("rejecting proposal with invalid injected vote extensions", "height", req.Height, "error", err)
+			return reject, nil
+		}
+
+		nextReq := *req
+		nextReq.Txs = req.Txs[1:]
+		return next(ctx, &nextReq)
+	}
+}
+
+// CommitVoteExtensionPrices aggregates the price observations injected into a block and stores the resulting prices.
+// It is called during pre-block, so problems with the injected vote extensions are logged instead of returned.
+func (k Keeper) CommitVoteExtensionPrices(ctx sdk.Context, req *abci.RequestFinalizeBlock) error {
+	if req == nil || !types.VoteExtensionsAvailable(ctx, req.Height) || len(req.Txs) == 0 {
+		return nil
+	}
+
+	var extCommit abci.ExtendedCommitInfo
+	if err := extCommit.Unmarshal(req.Txs[0]); err != nil {
+		k.Logger(ctx).Error("could not read injected vote extensions", "height", req.Height, "error", err)
+		return nil
+	}
+
+	for _, price := range types.AggregatePrices(extCommit, req.Height-1) {
+		price.Height = req.Height
+		k.SetPrice(ctx, price)
+		if err := ctx.EventManager().EmitTypedEvent(types.NewEventPriceCommitted(price)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,148 @@
+package keeper_test
+
+import (
+	"context"
+	"errors"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/oracle/types"
+)
+
+// mockPriceSource is a PriceSource that returns set prices or an error.
+type mockPriceSource struct {
+	prices []types.PriceObservation
+	err    error
+}
+
+func (m mockPriceSource) GetPrices(_ context.Context) ([]types.PriceObservation, error) {
+	return m.prices, m.err
+}
+
+func (s *KeeperTestSuite) newPriceVoteExtension(height int64, pair, price string) []byte {
+	ext := types.PriceVoteExtension{
+		Height: height,
+		Prices: []types.PriceObservation{{Pair: pair, Price: sdkmath.LegacyMustNewDecFromStr(price)}},
+	}
+	bz, err := ext.Marshal()
+	s.Require().NoError(err, "Marshal")
+	return bz
+}
+
+func (s *KeeperTestSuite) TestExtendVoteHandler() {
+	req := &abci.RequestExtendVote{Height: 10}
+	usd := types.PriceObservation{Pair: "nhash/usd", Price: sdkmath.LegacyMustNewDecFromStr("0.025")}
+
+	tests := []struct {
+		name   string
+		source types.PriceSource
+		expExt []byte
+	}{
+		{name: "no source"},
+		{name: "source error", source: mockPriceSource{err: errors.New("no feed")}},
+		{name: "invalid price", source: mockPriceSource{prices: []types.PriceObservation{{Pair: "nhash", Price: sdkmath.LegacyOneDec()}}}},
+		{name: "valid prices", source: mockPriceSource{prices: []types.PriceObservation{usd}}, expExt: s.newPriceVoteExtension(10, "nhash/usd", "0.025")},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			resp, err := s.app.OracleKeeper.ExtendVoteHandler(tc.source)(s.ctx, req)
+			s.Require().NoError(err, "ExtendVoteHandler")
+			s.Assert().Equal(tc.expExt, resp.VoteExtension, "vote extension")
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestVerifyVoteExtensionHandler() {
+	accept := abci.ResponseVerifyVoteExtension_ACCEPT
+	reject := abci.ResponseVerifyVoteExtension_REJECT
+
+	tests := []struct {
+		name string
+		ext  []byte
+		exp  abci.ResponseVerifyVoteExtension_VerifyStatus
+	}{
+		{name: "empty", ext: nil, exp: accept},
+		{name: "valid", ext: s.newPriceVoteExtension(10, "nhash/usd", "0.025"), exp: accept},
+		{name: "wrong height", ext: s.newPriceVoteExtension(9, "nhash/usd", "0.025"), exp: reject},
+		{name: "invalid price", ext: s.newPriceVoteExtension(10, "nhash/usd", "-1"), exp: reject},
+		{name: "not an extension", ext: []byte{0xff, 0xff}, exp: reject},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			req := &abci.RequestVerifyVoteExtension{Height: 10, VoteExtension: tc.ext}
+			resp, err := s.app.OracleKeeper.VerifyVoteExtensionHandler()(s.ctx, req)
+			s.Require().NoError(err, "VerifyVoteExtensionHandler")
+			s.Assert().Equal(tc.exp, resp.Status, "status")
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestCommitVoteExtensionPrices() {
+	extCommit := abci.ExtendedCommitInfo{Votes: []abci.ExtendedVoteInfo{
+		{Validator: abci.Validator{Power: 60}, BlockIdFlag: cmtproto.BlockIDFlagCommit, VoteExtension: s.newPriceVoteExtension(9, "nhash/usd", "0.025")},
+		{Validator: abci.Validator{Power: 40}, BlockIdFlag: cmtproto.BlockIDFlagCommit},
+	}}
+	injected, err := extCommit.Marshal()
+	s.Require().NoError(err, "Marshal")
+	req := &abci.RequestFinalizeBlock{Height: 10, Txs: [][]byte{injected}}
+
+	s.Run("vote extensions not enabled", func() {
+		ctx, _ := s.ctx.CacheContext()
+		s.Require().NoError(s.app.OracleKeeper.CommitVoteExtensionPrices(ctx, req), "CommitVoteExtensionPrices")
+		prices, err := s.app.OracleKeeper.GetAllPrices(ctx)
+		s.Require().NoError(err, "GetAllPrices")
+		s.Assert().Empty(prices, "GetAllPrices")
+	})
+
+	s.Run("prices committed", func() {
+		ctx, _ := s.ctx.CacheContext()
+		ctx = ctx.WithEventManager(sdk.NewEventManager()).
+			WithConsensusParams(cmtproto.ConsensusParams{Abci: &cmtproto.ABCIParams{VoteExtensionsEnableHeight: 2}})
+		s.Require().NoError(s.app.OracleKeeper.CommitVoteExtensionPrices(ctx, req), "CommitVoteExtensionPrices")
+
+		expected := types.PriceCommitment{Pair: "nhash/usd", Price: sdkmath.LegacyMustNewDecFromStr("0.025"), Height: 10, VotingPower: 60}
+		price, err := s.app.OracleKeeper.GetPrice(ctx, "nhash/usd")
+		s.Require().NoError(err, "GetPrice")
+		s.Assert().Equal(&expected, price, "GetPrice")
+
+		event, err := sdk.TypedEventToEvent(types.NewEventPriceCommitted(expected))
+		s.Require().NoError(err, "TypedEventToEvent")
+		s.Assert().Equal(sdk.Events{event}, ctx.EventManager().Events(), "events")
+	})
+
+	s.Run("invalid injected vote extensions", func() {
+		ctx, _ := s.ctx.CacheContext()
+		ctx = ctx.WithConsensusParams(cmtproto.ConsensusParams{Abci: &cmtproto.ABCIParams{VoteExtensionsEnableHeight: 2}})
+		badReq := &abci.RequestFinalizeBlock{Height: 10, Txs: [][]byte{{0xff, 0xff}}}
+		s.Require().NoError(s.app.OracleKeeper.CommitVoteExtensionPrices(ctx, badReq), "CommitVoteExtensionPrices")
+		price, err := s.app.OracleKeeper.GetPrice(ctx, "nhash/usd")
+		s.Require().NoError(err, "GetPrice")
+		s.Assert().Nil(price, "GetPrice")
+	})
+}
+
+func (s *KeeperTestSuite) TestQueryPrices() {
+	price := types.PriceCommitment{Pair: "nhash/usd", Price: sdkmath.LegacyMustNewDecFromStr("0.025"), Height: 10, VotingPower: 60}
+	s.app.OracleKeeper.SetPrice(s.ctx, price)
+
+	res, err := s.queryClient.Price(s.ctx, &types.QueryPriceRequest{Pair: "nhash/usd"})
+	s.Require().NoError(err, "Price")
+	s.Assert().Equal(price, res.Price, "Price")
+
+	_, err = s.queryClient.Price(s.ctx, &types.QueryPriceRequest{Pair: "nhash/eur"})
+	s.Assert().ErrorContains(err, `no price committed for pair "nhash/eur"`, "Price for unknown pair")
+
+	_, err = s.queryClient.Price(s.ctx, &types.QueryPriceRequest{Pair: "nhash"})
+	s.Assert().ErrorContains(err, `pair "nhash" must be in the form {base}/{quote}`, "Price for invalid pair")
+
+	all, err := s.queryClient.Prices(s.ctx, &types.QueryPricesRequest{})
+	s.Require().NoError(err, "Prices")
+	s.Assert().Equal([]types.PriceCommitment{price}, all.Prices, "Prices")
+}
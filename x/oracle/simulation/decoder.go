@@ -13,7 +13,7 @@ import (
 
 // NewDecodeStore returns a decoder function closure that unmarshalls the KVPair's
 // Value
-func NewDecodeStore(_ codec.Codec) func(kvA, kvB kv.Pair) string {
+func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
 	return func(kvA, kvB kv.Pair) string {
 		switch {
 		case bytes.Equal(kvA.Key[:1], types.OracleStoreKey):
@@ -24,6 +24,11 @@ func NewDecodeStore(_ codec.Codec) func(kvA, kvB kv.Pair) string {
 			attribB := string(kvB.Value)
 
 			return fmt.Sprintf("Port: A:[%v] B:[%v]\n", attribA, attribB)
+		case bytes.Equal(kvA.Key[:1], types.PriceKeyPrefix):
+			var priceA, priceB types.PriceCommitment
+			cdc.MustUnmarshal(kvA.Value, &priceA)
+			cdc.MustUnmarshal(kvB.Value, &priceB)
+			return fmt.Sprintf("Price: A:[%v] B:[%v]\n", priceA, priceB)
 		default:
 			panic(fmt.Sprintf("unexpected %s key %X (%s)", types.ModuleName, kvA.Key, kvA.Key))
 		}
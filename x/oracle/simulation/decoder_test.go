@@ -1,10 +1,13 @@
 package simulation_test
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 
+	sdkmath "cosmossdk.io/math"
+
 	"github.com/cosmos/cosmos-sdk/types/kv"
 
 	"github.com/provenance-io/provenance/app"
@@ -16,6 +19,8 @@ import (
 func TestDecodeStore(t *testing.T) {
 	cdc := app.MakeTestEncodingConfig(t).Marshaler
 	dec := simulation.NewDecodeStore(cdc)
+	priceA := types.PriceCommitment{Pair: "nhash/usd", Price: sdkmath.LegacyMustNewDecFromStr("0.025"), Height: 5, VotingPower: 10}
+	priceB := types.PriceCommitment{Pair: "nhash/usd", Price: sdkmath.LegacyMustNewDecFromStr("0.03"), Height: 6, VotingPower: 12}
 
 	tests := []struct {
 		name     string
@@ -42,6 +47,12 @@ func TestDecodeStore(t *testing.T) {
 			kvB:  kv.Pair{Key: types.GetPortStoreKey(), Value: []byte("88")},
 			exp:  "Port: A:[99] B:[88]\n",
 		},
+		{
+			name: "success - PriceKey",
+			kvA:  kv.Pair{Key: types.GetPriceKey(priceA.Pair), Value: cdc.MustMarshal(&priceA)},
+			kvB:  kv.Pair{Key: types.GetPriceKey(priceB.Pair), Value: cdc.MustMarshal(&priceB)},
+			exp:  fmt.Sprintf("Price: A:[%v] B:[%v]\n", priceA, priceB),
+		},
 	}
 
 	for _, tc := range tests {
@@ -4,6 +4,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+
+	sdkmath "cosmossdk.io/math"
 )
 
 func TestNewGenesisState(t *testing.T) {
@@ -23,6 +25,13 @@ func TestDefaultGenesis(t *testing.T) {
 }
 
 func TestGenesisValidate(t *testing.T) {
+	withPrices := func(prices ...PriceCommitment) *GenesisState {
+		genesis := NewGenesisState(PortID, "")
+		genesis.Prices = prices
+		return genesis
+	}
+	price := PriceCommitment{Pair: "nhash/usd", Price: sdkmath.LegacyMustNewDecFromStr("0.025"), Height: 5, VotingPower: 10}
+
 	tests := []struct {
 		name  string
 		state *GenesisState
@@ -46,6 +55,20 @@ func TestGenesisValidate(t *testing.T) {
 			state: NewGenesisState(PortID, "abc"),
 			err:   "decoding bech32 failed: invalid bech32 string length 3",
 		},
+		{
+			name:  "success - prices are valid",
+			state: withPrices(price, PriceCommitment{Pair: "nhash/eur", Price: sdkmath.LegacyOneDec(), VotingPower: 1}),
+		},
+		{
+			name:  "failure - price is invalid",
+			state: withPrices(price, PriceCommitment{Pair: "nhash", Price: sdkmath.LegacyOneDec(), VotingPower: 1}),
+			err:   `invalid prices[1]: pair "nhash" must be in the form {base}/{quote}: invalid price`,
+		},
+		{
+			name:  "failure - duplicate pair",
+			state: withPrices(price, price),
+			err:   `invalid prices[1]: duplicate pair "nhash/usd"`,
+		},
 	}
 
 	for _, tc := range tests {
@@ -31,11 +31,18 @@ const (
 //	PortStoreKey
 //	- 0x02: string
 //	  | 1 |
+//
+//
+//	PriceKeyPrefix
+//	- 0x03<pair>: PriceCommitment
+//	  | 1 | pair |
 var (
 	// OracleStoreKey is the key for the module's oracle address
 	OracleStoreKey = []byte{0x01}
 	// PortStoreKey defines the key to store the port ID in store
 	PortStoreKey = []byte{0x02}
+	// PriceKeyPrefix is the prefix for the keys of committed prices
+	PriceKeyPrefix = []byte{0x03}
 )
 
 // GetOracleStoreKey is a function to get the key for the oracle's address in store
@@ -47,3 +54,8 @@ func GetOracleStoreKey() []byte {
 func GetPortStoreKey() []byte {
 	return PortStoreKey
 }
+
+// GetPriceKey is a function to get the key for a pair's committed price in store
+func GetPriceKey(pair string) []byte {
+	return append([]byte{PriceKeyPrefix[0]}, pair...)
+}
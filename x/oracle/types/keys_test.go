@@ -15,3 +15,9 @@ func TestGetPortStoreKey(t *testing.T) {
 	key := GetPortStoreKey()
 	assert.EqualValues(t, PortStoreKey, key[0:1], "must return correct port key")
 }
+
+func TestGetPriceKey(t *testing.T) {
+	key := GetPriceKey("nhash/usd")
+	assert.EqualValues(t, PriceKeyPrefix, key[0:1], "must return correct price key prefix")
+	assert.Equal(t, "nhash/usd", string(key[1:]), "must return the pair after the prefix")
+}
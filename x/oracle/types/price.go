@@ -0,0 +1,233 @@
+package types
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	cerrs "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// MaxPricePairLength is the maximum length of a price pair.
+	MaxPricePairLength = 128
+	// MaxPriceObservations is the maximum number of price observations a validator can attach to a vote.
+	MaxPriceObservations = 50
+	// MaxPriceFileAge is the maximum amount of time since a price file was last written for its prices to be used.
+	MaxPriceFileAge = time.Minute
+
+	// FlagPriceFile is the app config option with the path to the price file read by a validator when voting.
+	FlagPriceFile = "oracle.price-file"
+)
+
+// ValidatePricePair returns an error if the provided pair is not in the form {base}/{quote}.
+// The base can contain slashes (e.g. an ibc denom), but the quote cannot.
+func ValidatePricePair(pair string) error {
+	if len(pair) == 0 {
+		return cerrs.Wrap(ErrInvalidPrice, "pair cannot be empty")
+	}
+	if len(pair) > MaxPricePairLength {
+		return cerrs.Wrapf(ErrInvalidPrice, "pair %q exceeds max length %d", pair, MaxPricePairLength)
+	}
+	i := strings.LastIndex(pair, "/")
+	if i < 0 {
+		return cerrs.Wrapf(ErrInvalidPrice, "pair %q must be in the form {base}/{quote}", pair)
+	}
+	if err := sdk.ValidateDenom(pair[:i]); err != nil {
+		return cerrs.Wrapf(ErrInvalidPrice, "pair %q base: %v", pair, err)
+	}
+	if err := sdk.ValidateDenom(pair[i+1:]); err != nil {
+		return cerrs.Wrapf(ErrInvalidPrice, "pair %q quote: %v", pair, err)
+	}
+	return nil
+}
+
+// validatePairPrice returns an error if the pair or price are not valid.
+func validatePairPrice(pair string, price sdkmath.LegacyDec) error {
+	if err := ValidatePricePair(pair); err != nil {
+		return err
+	}
+	if price.IsNil() || !price.IsPositive() {
+		return cerrs.Wrapf(ErrInvalidPrice, "pair %q price must be positive", pair)
+	}
+	return nil
+}
+
+// Validate returns an error if this price observation is not valid.
+func (o PriceObservation) Validate() error {
+	return validatePairPrice(o.Pair, o.Price)
+}
+
+// Validate returns an error if this price vote extension is not valid.
+func (e PriceVoteExtension) Validate() error {
+	if e.Height <= 0 {
+		return cerrs.Wrapf(ErrInvalidPrice, "vote extension height %d must be positive", e.Height)
+	}
+	if len(e.Prices) > MaxPriceObservations {
+		return cerrs.Wrapf(ErrInvalidPrice, "vote extension has %d prices, max is %d", len(e.Prices), MaxPriceObservations)
+	}
+	seen := make(map[string]bool, len(e.Prices))
+	for _, observation := range e.Prices {
+		if err := observation.Validate(); err != nil {
+			return err
+		}
+		if seen[observation.Pair] {
+			return cerrs.Wrapf(ErrInvalidPrice, "vote extension has multiple prices for pair %q", observation.Pair)
+		}
+		seen[observation.Pair] = true
+	}
+	return nil
+}
+
+// ParsePriceVoteExtension unmarshals and validates a price vote extension for the provided height.
+func ParsePriceVoteExtension(bz []byte, height int64) (*PriceVoteExtension, error) {
+	var ext PriceVoteExtension
+	if err := ext.Unmarshal(bz); err != nil {
+		return nil, cerrs.Wrapf(ErrInvalidPrice, "could not unmarshal vote extension: %v", err)
+	}
+	if ext.Height != height {
+		return nil, cerrs.Wrapf(ErrInvalidPrice, "vote extension height %d does not equal vote height %d", ext.Height, height)
+	}
+	if err := ext.Validate(); err != nil {
+		return nil, err
+	}
+	return &ext, nil
+}
+
+// Validate returns an error if this price commitment is not valid.
+func (c PriceCommitment) Validate() error {
+	if err := validatePairPrice(c.Pair, c.Price); err != nil {
+		return err
+	}
+	if c.Height < 0 {
+		return cerrs.Wrapf(ErrInvalidPrice, "pair %q height %d cannot be negative", c.Pair, c.Height)
+	}
+	if c.VotingPower <= 0 {
+		return cerrs.Wrapf(ErrInvalidPrice, "pair %q voting power %d must be positive", c.Pair, c.VotingPower)
+	}
+	return nil
+}
+
+// NewEventPriceCommitted creates a new EventPriceCommitted for a price commitment.
+func NewEventPriceCommitted(price PriceCommitment) *EventPriceCommitted {
+	return &EventPriceCommitted{
+		Pair:        price.Pair,
+		Price:       price.Price.String(),
+		VotingPower: fmt.Sprintf("%d", price.VotingPower),
+	}
+}
+
+// VoteExtensionsAvailable returns true if the votes of the block before the provided height have vote extensions.
+func VoteExtensionsAvailable(ctx sdk.Context, height int64) bool {
+	cp := ctx.ConsensusParams()
+	return cp.Abci != nil && cp.Abci.VoteExtensionsEnableHeight > 0 && height > cp.Abci.VoteExtensionsEnableHeight
+}
+
+// weightedPrice is a price observed by a validator along with the validator's voting power.
+type weightedPrice struct {
+	price sdkmath.LegacyDec
+	power int64
+}
+
+// AggregatePrices returns the prices to commit from the vote extensions in the provided extended commit.
+// The extensions must be for votes at the provided height; invalid extensions are ignored.
+// A pair's price is only committed if the validators that observed it have more than half of the
+// voting power in the commit. The committed price is the voting power weighted median of the observations.
+func AggregatePrices(extCommit abci.ExtendedCommitInfo, height int64) []PriceCommitment {
+	var totalPower int64
+	observed := make(map[string][]weightedPrice)
+	for _, vote := range extCommit.Votes {
+		totalPower += vote.Validator.Power
+		if vote.BlockIdFlag != cmtproto.BlockIDFlagCommit || len(vote.VoteExtension) == 0 {
+			continue
+		}
+		ext, err := ParsePriceVoteExtension(vote.VoteExtension, height)
+		if err != nil {
+			continue
+		}
+		for _, observation := range ext.Prices {
+			observed[observation.Pair] = append(observed[observation.Pair], weightedPrice{price: observation.Price, power: vote.Validator.Power})
+		}
+	}
+
+	pairs := make([]string, 0, len(observed))
+	for pair := range observed {
+		pairs = append(pairs, pair)
+	}
+	sort.Strings(pairs)
+
+	var rv []PriceCommitment
+	for _, pair := range pairs {
+		prices := observed[pair]
+		var power int64
+		for _, wp := range prices {
+			power += wp.power
+		}
+		if power <= 0 || power*2 <= totalPower {
+			continue
+		}
+		rv = append(rv, PriceCommitment{Pair: pair, Price: weightedMedian(prices, power), VotingPower: power})
+	}
+	return rv
+}
+
+// weightedMedian returns the price at which the cumulative power of the sorted prices reaches half of the total power.
+func weightedMedian(prices []weightedPrice, totalPower int64) sdkmath.LegacyDec {
+	sort.SliceStable(prices, func(i, j int) bool {
+		return prices[i].price.LT(prices[j].price)
+	})
+	var cumulative int64
+	for _, wp := range prices {
+		cumulative += wp.power
+		if cumulative*2 >= totalPower {
+			return wp.price
+		}
+	}
+	return prices[len(prices)-1].price
+}
+
+// PriceSource provides the price observations that a validator attaches to its votes.
+type PriceSource interface {
+	GetPrices(ctx context.Context) ([]PriceObservation, error)
+}
+
+// FilePriceSource is a PriceSource that reads prices from a JSON file written by a price feeder, e.g.
+// {"prices":[{"pair":"nhash/usd","price":"0.025"}]}.
+type FilePriceSource struct {
+	// Path is the path to the price file.
+	Path string
+}
+
+var _ PriceSource = FilePriceSource{}
+
+// GetPrices reads the prices from the file. An error is returned if the file has not been written recently.
+func (s FilePriceSource) GetPrices(_ context.Context) ([]PriceObservation, error) {
+	info, err := os.Stat(s.Path)
+	if err != nil {
+		return nil, err
+	}
+	if age := time.Since(info.ModTime()); age > MaxPriceFileAge {
+		return nil, fmt.Errorf("price file %s is stale: last written %s ago", s.Path, age.Round(time.Second))
+	}
+	bz, err := os.ReadFile(s.Path)
+	if err != nil {
+		return nil, err
+	}
+	var contents struct {
+		Prices []PriceObservation `json:"prices"`
+	}
+	if err = json.Unmarshal(bz, &contents); err != nil {
+		return nil, fmt.Errorf("could not read price file %s: %w", s.Path, err)
+	}
+	return contents.Prices, nil
+}
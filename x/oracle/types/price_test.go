@@ -0,0 +1,157 @@
+package types
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	sdkmath "cosmossdk.io/math"
+)
+
+func TestValidatePricePair(t *testing.T) {
+	tests := []struct {
+		name string
+		pair string
+		err  string
+	}{
+		{name: "valid", pair: "nhash/usd"},
+		{name: "valid ibc base", pair: "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2/usd"},
+		{name: "empty", pair: "", err: "pair cannot be empty: invalid price"},
+		{name: "no slash", pair: "nhash", err: `pair "nhash" must be in the form {base}/{quote}: invalid price`},
+		{name: "invalid base", pair: "/usd", err: `pair "/usd" base: invalid denom: : invalid price`},
+		{name: "invalid quote", pair: "nhash/", err: `pair "nhash/" quote: invalid denom: : invalid price`},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := ValidatePricePair(tc.pair)
+			if len(tc.err) > 0 {
+				assert.EqualError(t, err, tc.err, "ValidatePricePair")
+			} else {
+				assert.NoError(t, err, "ValidatePricePair")
+			}
+		})
+	}
+}
+
+func TestParsePriceVoteExtension(t *testing.T) {
+	observation := func(pair, price string) PriceObservation {
+		return PriceObservation{Pair: pair, Price: sdkmath.LegacyMustNewDecFromStr(price)}
+	}
+	tests := []struct {
+		name   string
+		ext    PriceVoteExtension
+		height int64
+		err    string
+	}{
+		{
+			name:   "valid",
+			ext:    PriceVoteExtension{Height: 5, Prices: []PriceObservation{observation("nhash/usd", "0.025"), observation("nhash/eur", "0.02")}},
+			height: 5,
+		},
+		{
+			name:   "no prices",
+			ext:    PriceVoteExtension{Height: 5},
+			height: 5,
+		},
+		{
+			name:   "wrong height",
+			ext:    PriceVoteExtension{Height: 4},
+			height: 5,
+			err:    "vote extension height 4 does not equal vote height 5: invalid price",
+		},
+		{
+			name:   "negative price",
+			ext:    PriceVoteExtension{Height: 5, Prices: []PriceObservation{observation("nhash/usd", "-1")}},
+			height: 5,
+			err:    `pair "nhash/usd" price must be positive: invalid price`,
+		},
+		{
+			name:   "duplicate pair",
+			ext:    PriceVoteExtension{Height: 5, Prices: []PriceObservation{observation("nhash/usd", "1"), observation("nhash/usd", "2")}},
+			height: 5,
+			err:    `vote extension has multiple prices for pair "nhash/usd": invalid price`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			bz, err := tc.ext.Marshal()
+			require.NoError(t, err, "Marshal")
+			ext, err := ParsePriceVoteExtension(bz, tc.height)
+			if len(tc.err) > 0 {
+				assert.EqualError(t, err, tc.err, "ParsePriceVoteExtension")
+				return
+			}
+			require.NoError(t, err, "ParsePriceVoteExtension")
+			assert.Equal(t, len(tc.ext.Prices), len(ext.Prices), "number of prices")
+		})
+	}
+
+	t.Run("not a vote extension", func(t *testing.T) {
+		_, err := ParsePriceVoteExtension([]byte{0xff, 0xff}, 5)
+		assert.ErrorContains(t, err, "could not unmarshal vote extension", "ParsePriceVoteExtension")
+	})
+}
+
+func TestAggregatePrices(t *testing.T) {
+	vote := func(power int64, flag cmtproto.BlockIDFlag, prices ...string) abci.ExtendedVoteInfo {
+		rv := abci.ExtendedVoteInfo{Validator: abci.Validator{Power: power}, BlockIdFlag: flag}
+		if len(prices) > 0 {
+			ext := PriceVoteExtension{Height: 10}
+			for i := 0; i < len(prices); i += 2 {
+				ext.Prices = append(ext.Prices, PriceObservation{Pair: prices[i], Price: sdkmath.LegacyMustNewDecFromStr(prices[i+1])})
+			}
+			bz, err := ext.Marshal()
+			require.NoError(t, err, "Marshal")
+			rv.VoteExtension = bz
+		}
+		return rv
+	}
+	commit := cmtproto.BlockIDFlagCommit
+
+	extCommit := abci.ExtendedCommitInfo{Votes: []abci.ExtendedVoteInfo{
+		vote(30, commit, "nhash/usd", "0.03", "nhash/eur", "0.02"),
+		vote(25, commit, "nhash/usd", "0.01"),
+		vote(20, commit, "nhash/usd", "0.02"),
+		vote(15, commit),
+		vote(10, cmtproto.BlockIDFlagAbsent),
+	}}
+	// An invalid extension is ignored.
+	extCommit.Votes[3].VoteExtension = []byte{0xff, 0xff}
+
+	prices := AggregatePrices(extCommit, 10)
+	// nhash/eur was only observed by 30 of the 100 voting power, so it isn't committed.
+	expected := []PriceCommitment{
+		{Pair: "nhash/usd", Price: sdkmath.LegacyMustNewDecFromStr("0.02"), VotingPower: 75},
+	}
+	assert.Equal(t, expected, prices, "AggregatePrices")
+
+	assert.Empty(t, AggregatePrices(extCommit, 11), "AggregatePrices with the wrong height")
+}
+
+func TestFilePriceSource(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "prices.json")
+	source := FilePriceSource{Path: path}
+
+	_, err := source.GetPrices(context.Background())
+	assert.Error(t, err, "GetPrices without a file")
+
+	require.NoError(t, os.WriteFile(path, []byte(`{"prices":[{"pair":"nhash/usd","price":"0.025"}]}`), 0o600), "WriteFile")
+	prices, err := source.GetPrices(context.Background())
+	require.NoError(t, err, "GetPrices")
+	assert.Equal(t, []PriceObservation{{Pair: "nhash/usd", Price: sdkmath.LegacyMustNewDecFromStr("0.025")}}, prices, "GetPrices")
+
+	old := time.Now().Add(-2 * MaxPriceFileAge)
+	require.NoError(t, os.Chtimes(path, old, old), "Chtimes")
+	_, err = source.GetPrices(context.Background())
+	assert.ErrorContains(t, err, "is stale", "GetPrices with an old file")
+}
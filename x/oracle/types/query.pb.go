@@ -8,6 +8,7 @@ import (
 	fmt "fmt"
 	github_com_CosmWasm_wasmd_x_wasm_types "github.com/CosmWasm/wasmd/x/wasm/types"
 	_ "github.com/cosmos/cosmos-proto"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -206,44 +207,253 @@ func (m *QueryOracleResponse) GetData() github_com_CosmWasm_wasmd_x_wasm_types.R
 	return nil
 }
 
+// QueryPriceRequest queries for the price of a pair.
+type QueryPriceRequest struct {
+	// pair identifies what is being priced, e.g. "nhash/usd".
+	Pair string `protobuf:"bytes,1,opt,name=pair,proto3" json:"pair,omitempty"`
+}
+
+func (m *QueryPriceRequest) Reset()         { *m = QueryPriceRequest{} }
+func (m *QueryPriceRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPriceRequest) ProtoMessage()    {}
+func (*QueryPriceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_169907f611744c57, []int{4}
+}
+func (m *QueryPriceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPriceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPriceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPriceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPriceRequest.Merge(m, src)
+}
+func (m *QueryPriceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPriceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPriceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPriceRequest proto.InternalMessageInfo
+
+func (m *QueryPriceRequest) GetPair() string {
+	if m != nil {
+		return m.Pair
+	}
+	return ""
+}
+
+// QueryPriceResponse contains the price of a pair.
+type QueryPriceResponse struct {
+	// price is the price most recently committed for the pair.
+	Price PriceCommitment `protobuf:"bytes,1,opt,name=price,proto3" json:"price"`
+}
+
+func (m *QueryPriceResponse) Reset()         { *m = QueryPriceResponse{} }
+func (m *QueryPriceResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPriceResponse) ProtoMessage()    {}
+func (*QueryPriceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_169907f611744c57, []int{5}
+}
+func (m *QueryPriceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPriceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPriceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPriceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPriceResponse.Merge(m, src)
+}
+func (m *QueryPriceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPriceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPriceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPriceResponse proto.InternalMessageInfo
+
+func (m *QueryPriceResponse) GetPrice() PriceCommitment {
+	if m != nil {
+		return m.Price
+	}
+	return PriceCommitment{}
+}
+
+// QueryPricesRequest queries for the prices of all pairs.
+type QueryPricesRequest struct {
+	// pagination defines optional pagination parameters for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,99,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPricesRequest) Reset()         { *m = QueryPricesRequest{} }
+func (m *QueryPricesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPricesRequest) ProtoMessage()    {}
+func (*QueryPricesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_169907f611744c57, []int{6}
+}
+func (m *QueryPricesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPricesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPricesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPricesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPricesRequest.Merge(m, src)
+}
+func (m *QueryPricesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPricesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPricesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPricesRequest proto.InternalMessageInfo
+
+func (m *QueryPricesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryPricesResponse contains the prices of all pairs.
+type QueryPricesResponse struct {
+	// prices are the prices most recently committed for each pair.
+	Prices []PriceCommitment `protobuf:"bytes,1,rep,name=prices,proto3" json:"prices"`
+	// pagination defines an optional pagination for the response.
+	Pagination *query.PageResponse `protobuf:"bytes,99,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPricesResponse) Reset()         { *m = QueryPricesResponse{} }
+func (m *QueryPricesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPricesResponse) ProtoMessage()    {}
+func (*QueryPricesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_169907f611744c57, []int{7}
+}
+func (m *QueryPricesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPricesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPricesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPricesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPricesResponse.Merge(m, src)
+}
+func (m *QueryPricesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPricesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPricesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPricesResponse proto.InternalMessageInfo
+
+func (m *QueryPricesResponse) GetPrices() []PriceCommitment {
+	if m != nil {
+		return m.Prices
+	}
+	return nil
+}
+
+func (m *QueryPricesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryOracleAddressRequest)(nil), "provenance.oracle.v1.QueryOracleAddressRequest")
 	proto.RegisterType((*QueryOracleAddressResponse)(nil), "provenance.oracle.v1.QueryOracleAddressResponse")
 	proto.RegisterType((*QueryOracleRequest)(nil), "provenance.oracle.v1.QueryOracleRequest")
 	proto.RegisterType((*QueryOracleResponse)(nil), "provenance.oracle.v1.QueryOracleResponse")
+	proto.RegisterType((*QueryPriceRequest)(nil), "provenance.oracle.v1.QueryPriceRequest")
+	proto.RegisterType((*QueryPriceResponse)(nil), "provenance.oracle.v1.QueryPriceResponse")
+	proto.RegisterType((*QueryPricesRequest)(nil), "provenance.oracle.v1.QueryPricesRequest")
+	proto.RegisterType((*QueryPricesResponse)(nil), "provenance.oracle.v1.QueryPricesResponse")
 }
 
 func init() { proto.RegisterFile("provenance/oracle/v1/query.proto", fileDescriptor_169907f611744c57) }
 
 var fileDescriptor_169907f611744c57 = []byte{
-	// 422 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x92, 0xbf, 0xae, 0xd3, 0x30,
-	0x14, 0xc6, 0xeb, 0x2b, 0xee, 0x45, 0x58, 0xb0, 0x98, 0x4a, 0xdc, 0x1b, 0xaa, 0x50, 0x45, 0x15,
-	0x2a, 0x12, 0x8d, 0x69, 0x99, 0x18, 0x18, 0x68, 0x67, 0x44, 0x9b, 0x0e, 0x48, 0x2c, 0x95, 0x9b,
-	0x58, 0x6e, 0xa4, 0xc6, 0x27, 0x8d, 0xdd, 0x7f, 0x2b, 0xbc, 0x00, 0x12, 0x2f, 0xc0, 0x23, 0x30,
-	0xf0, 0x10, 0x8c, 0x15, 0x2c, 0x4c, 0x08, 0xb5, 0x3c, 0x05, 0x13, 0xaa, 0x9d, 0xaa, 0xad, 0x14,
-	0xa0, 0xc3, 0x9d, 0xe2, 0xf8, 0x7c, 0xfe, 0x7e, 0x9f, 0x7d, 0x0e, 0xae, 0xa6, 0x19, 0xcc, 0xb8,
-	0x64, 0x32, 0xe4, 0x14, 0x32, 0x16, 0x8e, 0x39, 0x9d, 0x35, 0xe9, 0x64, 0xca, 0xb3, 0xa5, 0x9f,
-	0x66, 0xa0, 0x81, 0x94, 0xf7, 0x0a, 0xdf, 0x2a, 0xfc, 0x59, 0xd3, 0x29, 0x0b, 0x10, 0x60, 0x04,
-	0x74, 0xbb, 0xb2, 0x5a, 0xa7, 0x22, 0x00, 0xc4, 0x98, 0x53, 0x96, 0xc6, 0x94, 0x49, 0x09, 0x9a,
-	0xe9, 0x18, 0xa4, 0xca, 0xab, 0x57, 0x21, 0xa8, 0x04, 0xd4, 0xc0, 0x1e, 0xb3, 0x3f, 0xb6, 0xe4,
-	0xdd, 0xc7, 0x57, 0xbd, 0x2d, 0xf3, 0x95, 0x01, 0xbc, 0x88, 0xa2, 0x8c, 0x2b, 0x15, 0xf0, 0xc9,
-	0x94, 0x2b, 0xed, 0x75, 0xb1, 0x53, 0x54, 0x54, 0x29, 0x48, 0xc5, 0x49, 0x0b, 0xdf, 0x64, 0x76,
-	0xeb, 0x12, 0x55, 0x51, 0xfd, 0x56, 0xfb, 0xf2, 0xeb, 0xe7, 0x46, 0x39, 0x77, 0xcf, 0xc5, 0x7d,
-	0x9d, 0xc5, 0x52, 0x04, 0x3b, 0xa1, 0x17, 0x63, 0x72, 0xe0, 0x98, 0x73, 0x48, 0x1f, 0x9f, 0x9b,
-	0x8b, 0x1b, 0x9f, 0xdb, 0xed, 0xe7, 0xbf, 0x7f, 0x3c, 0x78, 0x26, 0x62, 0x3d, 0x9a, 0x0e, 0xfd,
-	0x10, 0x12, 0xda, 0x01, 0x95, 0xbc, 0x66, 0x2a, 0xa1, 0x73, 0xa6, 0x92, 0x88, 0x2e, 0xcc, 0x97,
-	0xea, 0x65, 0xca, 0x95, 0x1f, 0xb0, 0x79, 0x07, 0xa4, 0xce, 0x58, 0xa8, 0x5f, 0x72, 0xa5, 0x98,
-	0xe0, 0x81, 0xf5, 0xf2, 0x46, 0xf8, 0xee, 0x11, 0x2a, 0x4f, 0xdd, 0xc3, 0x37, 0x22, 0xa6, 0xd9,
-	0xf5, 0xa0, 0x8c, 0x55, 0xeb, 0xd3, 0x19, 0x3e, 0x37, 0x28, 0xf2, 0x11, 0xe1, 0x3b, 0x47, 0x8f,
-	0x45, 0xa8, 0x5f, 0xd4, 0x45, 0xff, 0xaf, 0x6f, 0xee, 0x3c, 0x39, 0xfd, 0x80, 0xbd, 0x91, 0xf7,
-	0xf8, 0xed, 0xb7, 0x5f, 0x1f, 0xce, 0x1e, 0x92, 0x1a, 0x2d, 0x1c, 0x29, 0xbb, 0x1a, 0xe4, 0x1d,
-	0x20, 0xef, 0x10, 0xbe, 0xb0, 0x3e, 0xa4, 0xfe, 0x5f, 0xd4, 0x2e, 0xd4, 0xa3, 0x13, 0x94, 0x79,
-	0x9a, 0x9a, 0x49, 0xe3, 0x92, 0xca, 0xbf, 0xd2, 0xb4, 0xc5, 0x97, 0xb5, 0x8b, 0x56, 0x6b, 0x17,
-	0xfd, 0x5c, 0xbb, 0xe8, 0xfd, 0xc6, 0x2d, 0xad, 0x36, 0x6e, 0xe9, 0xfb, 0xc6, 0x2d, 0xe1, 0x7b,
-	0x31, 0x14, 0xc2, 0xba, 0xe8, 0x4d, 0xeb, 0xa0, 0x51, 0x7b, 0x49, 0x23, 0x86, 0x43, 0xd4, 0x62,
-	0x07, 0x33, 0x4d, 0x1b, 0x5e, 0x98, 0x31, 0x7f, 0xfa, 0x27, 0x00, 0x00, 0xff, 0xff, 0x4f, 0x78,
-	0xa0, 0x70, 0x6f, 0x03, 0x00, 0x00,
+	// 620 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0x41, 0x6b, 0x13, 0x4d,
+	0x18, 0xce, 0x7e, 0x5f, 0x12, 0x71, 0xd4, 0x83, 0xd3, 0x80, 0x69, 0x5a, 0xb7, 0x65, 0xad, 0x4d,
+	0x14, 0xbb, 0x63, 0xe2, 0xc9, 0x83, 0x87, 0x26, 0xa0, 0x27, 0x31, 0xdd, 0x1e, 0x0a, 0x22, 0x94,
+	0xc9, 0x66, 0xd8, 0x0e, 0x74, 0x77, 0xb6, 0x3b, 0x93, 0xb4, 0x3d, 0x09, 0xfa, 0x07, 0x04, 0xff,
+	0x80, 0xe0, 0x5f, 0x10, 0xfc, 0x0b, 0x3d, 0x16, 0xbd, 0x78, 0x2a, 0x92, 0xf8, 0x2b, 0x3c, 0xc9,
+	0xbe, 0x33, 0x21, 0x1b, 0xd8, 0x36, 0x11, 0x3c, 0x65, 0x36, 0xf3, 0xbc, 0xcf, 0xf3, 0xcc, 0xfb,
+	0x3e, 0x33, 0x68, 0x3d, 0x4e, 0xc4, 0x90, 0x45, 0x34, 0xf2, 0x19, 0x11, 0x09, 0xf5, 0x0f, 0x19,
+	0x19, 0x36, 0xc9, 0xd1, 0x80, 0x25, 0xa7, 0x6e, 0x9c, 0x08, 0x25, 0x70, 0x65, 0x8a, 0x70, 0x35,
+	0xc2, 0x1d, 0x36, 0x6b, 0x95, 0x40, 0x04, 0x02, 0x00, 0x24, 0x5d, 0x69, 0x6c, 0x6d, 0x35, 0x10,
+	0x22, 0x38, 0x64, 0x84, 0xc6, 0x9c, 0xd0, 0x28, 0x12, 0x8a, 0x2a, 0x2e, 0x22, 0x69, 0x76, 0x97,
+	0x7d, 0x21, 0x43, 0x21, 0xf7, 0x75, 0x99, 0xfe, 0x30, 0x5b, 0x0f, 0xf5, 0x17, 0xe9, 0x51, 0xc9,
+	0xb4, 0x3a, 0x19, 0x36, 0x7b, 0x4c, 0xd1, 0x26, 0x89, 0x69, 0xc0, 0x23, 0xe0, 0x31, 0xd8, 0x7c,
+	0xcb, 0x71, 0xc2, 0x7d, 0xa6, 0x11, 0xce, 0x0a, 0x5a, 0xde, 0x49, 0x39, 0x5e, 0xc1, 0xee, 0x76,
+	0xbf, 0x9f, 0x30, 0x29, 0x3d, 0x76, 0x34, 0x60, 0x52, 0x39, 0x5d, 0x54, 0xcb, 0xdb, 0x94, 0xb1,
+	0x88, 0x24, 0xc3, 0x2d, 0x74, 0x8d, 0xea, 0xbf, 0xaa, 0xd6, 0xba, 0xd5, 0xb8, 0xde, 0xae, 0x7e,
+	0xfb, 0xb2, 0x55, 0x31, 0x5e, 0x0d, 0x78, 0x57, 0x25, 0x3c, 0x0a, 0xbc, 0x09, 0xd0, 0xe1, 0x08,
+	0x67, 0x18, 0x8d, 0x0e, 0xde, 0x45, 0x25, 0x38, 0x08, 0xf0, 0xdc, 0x6c, 0x3f, 0xfb, 0x7d, 0xb1,
+	0xf6, 0x34, 0xe0, 0xea, 0x60, 0xd0, 0x73, 0x7d, 0x11, 0x92, 0x8e, 0x90, 0xe1, 0x1e, 0x95, 0x21,
+	0x39, 0xa6, 0x32, 0xec, 0x93, 0x13, 0xf8, 0x25, 0xea, 0x34, 0x66, 0xd2, 0xf5, 0xe8, 0x71, 0x47,
+	0x44, 0x2a, 0xa1, 0xbe, 0x7a, 0xc9, 0xa4, 0xa4, 0x01, 0xf3, 0x34, 0x97, 0x73, 0x80, 0x96, 0x66,
+	0xa4, 0x8c, 0xeb, 0x1d, 0x54, 0xec, 0x53, 0x45, 0xff, 0x8d, 0x14, 0x50, 0x39, 0x75, 0x74, 0x1b,
+	0x94, 0xba, 0x69, 0x5f, 0x27, 0x67, 0xc2, 0xa8, 0x18, 0x53, 0x9e, 0xe8, 0xd6, 0x78, 0xb0, 0x76,
+	0xf6, 0x10, 0xce, 0x02, 0x8d, 0xa3, 0x6d, 0x54, 0x82, 0x89, 0x00, 0xf4, 0x46, 0xeb, 0xbe, 0x9b,
+	0x97, 0x22, 0x17, 0x6a, 0x3a, 0x22, 0x0c, 0xb9, 0x0a, 0x59, 0xa4, 0xda, 0xc5, 0xb3, 0x8b, 0xb5,
+	0x82, 0xa7, 0x2b, 0x9d, 0x37, 0x59, 0xe2, 0xc9, 0xf8, 0xf0, 0x73, 0x84, 0xa6, 0x89, 0xa8, 0xfa,
+	0xc0, 0xbe, 0xe9, 0x9a, 0x01, 0xa5, 0xf1, 0x71, 0x75, 0x78, 0x4d, 0x7c, 0xdc, 0x6e, 0x7a, 0x24,
+	0x5d, 0xeb, 0x65, 0x2a, 0x9d, 0xcf, 0x16, 0x5a, 0x9a, 0xa1, 0x37, 0xc6, 0x3b, 0xa8, 0x0c, 0xf2,
+	0xe9, 0xfc, 0xff, 0xff, 0x5b, 0xe7, 0xa6, 0x14, 0xbf, 0xc8, 0x31, 0x59, 0x9f, 0x6b, 0x52, 0x3b,
+	0xc8, 0xba, 0x6c, 0x7d, 0x2d, 0xa2, 0x12, 0xb8, 0xc4, 0x9f, 0x2c, 0x74, 0x6b, 0x26, 0xb2, 0x98,
+	0xe4, 0x3b, 0xbb, 0x34, 0xf9, 0xb5, 0xc7, 0x8b, 0x17, 0x68, 0x2b, 0xce, 0xa3, 0x77, 0xdf, 0x7f,
+	0x7d, 0xfc, 0x6f, 0x13, 0x6f, 0x90, 0xdc, 0x3b, 0xa7, 0x57, 0xfb, 0xe6, 0x1e, 0xe0, 0xf7, 0x16,
+	0x2a, 0x6b, 0x1e, 0xdc, 0x98, 0x2b, 0x35, 0x31, 0xf5, 0x60, 0x01, 0xa4, 0x71, 0xb3, 0x01, 0x6e,
+	0x6c, 0xbc, 0x7a, 0x95, 0x1b, 0xfc, 0x16, 0x95, 0x60, 0x38, 0xb8, 0x7e, 0x05, 0x73, 0x36, 0xd5,
+	0xb5, 0xc6, 0x7c, 0xa0, 0x71, 0x70, 0x0f, 0x1c, 0xdc, 0xc5, 0x2b, 0xe4, 0xf2, 0x37, 0x08, 0xda,
+	0xd0, 0xd5, 0x39, 0x98, 0xcb, 0x2c, 0x17, 0x69, 0xc3, 0x6c, 0x42, 0xe7, 0xb5, 0x41, 0x47, 0xb0,
+	0x1d, 0x9c, 0x8d, 0x6c, 0xeb, 0x7c, 0x64, 0x5b, 0x3f, 0x47, 0xb6, 0xf5, 0x61, 0x6c, 0x17, 0xce,
+	0xc7, 0x76, 0xe1, 0xc7, 0xd8, 0x2e, 0xa0, 0x3b, 0x5c, 0xe4, 0x8a, 0x75, 0xad, 0xd7, 0xad, 0xcc,
+	0xab, 0x31, 0x85, 0x6c, 0x71, 0x91, 0x95, 0x3a, 0x99, 0x88, 0xc1, 0x0b, 0xd2, 0x2b, 0xc3, 0x9b,
+	0xfb, 0xe4, 0xcf, 0x00, 0x6b, 0x7b, 0x41, 0x8a, 0x4a, 0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -262,6 +472,10 @@ type QueryClient interface {
 	OracleAddress(ctx context.Context, in *QueryOracleAddressRequest, opts ...grpc.CallOption) (*QueryOracleAddressResponse, error)
 	// Oracle forwards a query to the module's oracle
 	Oracle(ctx context.Context, in *QueryOracleRequest, opts ...grpc.CallOption) (*QueryOracleResponse, error)
+	// Price returns the price most recently committed for a pair
+	Price(ctx context.Context, in *QueryPriceRequest, opts ...grpc.CallOption) (*QueryPriceResponse, error)
+	// Prices returns the prices most recently committed for all pairs
+	Prices(ctx context.Context, in *QueryPricesRequest, opts ...grpc.CallOption) (*QueryPricesResponse, error)
 }
 
 type queryClient struct {
@@ -290,12 +504,34 @@ func (c *queryClient) Oracle(ctx context.Context, in *QueryOracleRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) Price(ctx context.Context, in *QueryPriceRequest, opts ...grpc.CallOption) (*QueryPriceResponse, error) {
+	out := new(QueryPriceResponse)
+	err := c.cc.Invoke(ctx, "/provenance.oracle.v1.Query/Price", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Prices(ctx context.Context, in *QueryPricesRequest, opts ...grpc.CallOption) (*QueryPricesResponse, error) {
+	out := new(QueryPricesResponse)
+	err := c.cc.Invoke(ctx, "/provenance.oracle.v1.Query/Prices", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// OracleAddress returns the address of the oracle
 	OracleAddress(context.Context, *QueryOracleAddressRequest) (*QueryOracleAddressResponse, error)
 	// Oracle forwards a query to the module's oracle
 	Oracle(context.Context, *QueryOracleRequest) (*QueryOracleResponse, error)
+	// Price returns the price most recently committed for a pair
+	Price(context.Context, *QueryPriceRequest) (*QueryPriceResponse, error)
+	// Prices returns the prices most recently committed for all pairs
+	Prices(context.Context, *QueryPricesRequest) (*QueryPricesResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -308,6 +544,12 @@ func (*UnimplementedQueryServer) OracleAddress(ctx context.Context, req *QueryOr
 func (*UnimplementedQueryServer) Oracle(ctx context.Context, req *QueryOracleRequest) (*QueryOracleResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Oracle not implemented")
 }
+func (*UnimplementedQueryServer) Price(ctx context.Context, req *QueryPriceRequest) (*QueryPriceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Price not implemented")
+}
+func (*UnimplementedQueryServer) Prices(ctx context.Context, req *QueryPricesRequest) (*QueryPricesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Prices not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -349,6 +591,42 @@ func _Query_Oracle_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_Price_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPriceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Price(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.oracle.v1.Query/Price",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Price(ctx, req.(*QueryPriceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Prices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPricesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Prices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.oracle.v1.Query/Prices",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Prices(ctx, req.(*QueryPricesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "provenance.oracle.v1.Query",
@@ -362,6 +640,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Oracle",
 			Handler:    _Query_Oracle_Handler,
 		},
+		{
+			MethodName: "Price",
+			Handler:    _Query_Price_Handler,
+		},
+		{
+			MethodName: "Prices",
+			Handler:    _Query_Prices_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "provenance/oracle/v1/query.proto",
@@ -480,6 +766,157 @@ func (m *QueryOracleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryPriceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPriceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPriceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Pair) > 0 {
+		i -= len(m.Pair)
+		copy(dAtA[i:], m.Pair)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Pair)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPriceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPriceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPriceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Price.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPricesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPricesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPricesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPricesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPricesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPricesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
+	}
+	if len(m.Prices) > 0 {
+		for iNdEx := len(m.Prices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Prices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -539,6 +976,62 @@ func (m *QueryOracleResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryPriceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Pair)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPriceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Price.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryPricesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPricesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Prices) > 0 {
+		for _, e := range m.Prices {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -845,6 +1338,377 @@ func (m *QueryOracleResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryPriceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPriceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPriceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pair = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPriceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPriceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPricesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPricesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPricesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 99:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPricesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPricesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPricesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Prices = append(m.Prices, PriceCommitment{})
+			if err := m.Prices[len(m.Prices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 99:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,82 @@
+package stakingvesting
+
+import (
+	"errors"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestexported "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+)
+
+var (
+	_ vestexported.VestingAccount = (*StakingVestingAccount)(nil)
+	_ authtypes.GenesisAccount    = (*StakingVestingAccount)(nil)
+)
+
+// NewStakingVestingAccount creates a new StakingVestingAccount that vests the originalVesting
+// coins continuously from the startTime to the endTime (both unix timestamps in seconds).
+func NewStakingVestingAccount(baseAcc *authtypes.BaseAccount, originalVesting sdk.Coins, startTime, endTime int64) (*StakingVestingAccount, error) {
+	acct := &StakingVestingAccount{
+		BaseVestingAccount: &vestingtypes.BaseVestingAccount{
+			BaseAccount:     baseAcc,
+			OriginalVesting: originalVesting,
+			EndTime:         endTime,
+		},
+		StartTime: startTime,
+	}
+	return acct, acct.Validate()
+}
+
+// GetVestedCoins returns the total number of vested coins. If no coins are vested, nil is returned.
+func (a StakingVestingAccount) GetVestedCoins(blockTime time.Time) sdk.Coins {
+	if blockTime.Unix() <= a.StartTime {
+		return nil
+	}
+	if blockTime.Unix() >= a.EndTime {
+		return a.OriginalVesting
+	}
+
+	portion := sdkmath.LegacyNewDec(blockTime.Unix() - a.StartTime).QuoInt64(a.EndTime - a.StartTime)
+	var rv sdk.Coins
+	for _, coin := range a.OriginalVesting {
+		rv = append(rv, sdk.NewCoin(coin.Denom, sdkmath.LegacyNewDecFromInt(coin.Amount).Mul(portion).RoundInt()))
+	}
+	return rv
+}
+
+// GetVestingCoins returns the total number of coins that have not vested yet. If no coins are vesting, nil is returned.
+func (a StakingVestingAccount) GetVestingCoins(blockTime time.Time) sdk.Coins {
+	return a.OriginalVesting.Sub(a.GetVestedCoins(blockTime)...)
+}
+
+// LockedCoins returns the coins that cannot be transferred out of this account. That's
+// all the coins that have not vested yet, except for the ones that are delegated.
+func (a StakingVestingAccount) LockedCoins(blockTime time.Time) sdk.Coins {
+	return a.BaseVestingAccount.LockedCoinsFromVesting(a.GetVestingCoins(blockTime))
+}
+
+// TrackDelegation records a delegation from this account, splitting it between the
+// coins that have not vested yet (delegated vesting) and the rest (delegated free).
+func (a *StakingVestingAccount) TrackDelegation(blockTime time.Time, balance, amount sdk.Coins) {
+	a.BaseVestingAccount.TrackDelegation(balance, a.GetVestingCoins(blockTime), amount)
+}
+
+// GetStartTime returns the unix timestamp (in seconds) of when vesting starts.
+func (a StakingVestingAccount) GetStartTime() int64 {
+	return a.StartTime
+}
+
+// Validate returns an error if this account is not valid.
+func (a StakingVestingAccount) Validate() error {
+	if a.BaseVestingAccount == nil {
+		return errors.New("base vesting account cannot be nil")
+	}
+	if a.StartTime >= a.EndTime {
+		return errors.New("vesting start time must be before the end time")
+	}
+	return a.BaseVestingAccount.Validate()
+}
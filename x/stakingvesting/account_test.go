@@ -0,0 +1,117 @@
+package stakingvesting_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	. "github.com/provenance-io/provenance/x/stakingvesting"
+)
+
+// newTestAccount creates a new StakingVestingAccount, requiring it to not error.
+func newTestAccount(t *testing.T, originalVesting sdk.Coins, startTime, endTime int64) *StakingVestingAccount {
+	t.Helper()
+	baseAcc := authtypes.NewBaseAccountWithAddress(sdk.AccAddress("vesting_address_____"))
+	rv, err := NewStakingVestingAccount(baseAcc, originalVesting, startTime, endTime)
+	require.NoError(t, err, "NewStakingVestingAccount")
+	return rv
+}
+
+func TestNewStakingVestingAccount(t *testing.T) {
+	baseAcc := authtypes.NewBaseAccountWithAddress(sdk.AccAddress("vesting_address_____"))
+	amount := sdk.NewCoins(sdk.NewInt64Coin("nhash", 1000))
+
+	tests := []struct {
+		name      string
+		startTime int64
+		endTime   int64
+		expErr    string
+	}{
+		{name: "valid", startTime: 100, endTime: 200},
+		{name: "start equals end", startTime: 200, endTime: 200, expErr: "vesting start time must be before the end time"},
+		{name: "start after end", startTime: 300, endTime: 200, expErr: "vesting start time must be before the end time"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			acct, err := NewStakingVestingAccount(baseAcc, amount, tc.startTime, tc.endTime)
+			if len(tc.expErr) > 0 {
+				assert.EqualError(t, err, tc.expErr, "NewStakingVestingAccount")
+				return
+			}
+			require.NoError(t, err, "NewStakingVestingAccount")
+			assert.Equal(t, tc.startTime, acct.GetStartTime(), "GetStartTime")
+			assert.Equal(t, tc.endTime, acct.GetEndTime(), "GetEndTime")
+			assert.Equal(t, amount, acct.GetOriginalVesting(), "GetOriginalVesting")
+		})
+	}
+
+	t.Run("nil base vesting account", func(t *testing.T) {
+		err := StakingVestingAccount{StartTime: 1}.Validate()
+		assert.EqualError(t, err, "base vesting account cannot be nil", "Validate")
+	})
+}
+
+func TestStakingVestingAccount_Vesting(t *testing.T) {
+	start := time.Unix(1_000_000, 0)
+	end := start.Add(100 * time.Second)
+	acct := newTestAccount(t, sdk.NewCoins(sdk.NewInt64Coin("nhash", 1000), sdk.NewInt64Coin("stake", 100)), start.Unix(), end.Unix())
+
+	tests := []struct {
+		name       string
+		blockTime  time.Time
+		expVested  string
+		expVesting string
+	}{
+		{name: "before start", blockTime: start.Add(-time.Second), expVested: "", expVesting: "1000nhash,100stake"},
+		{name: "at start", blockTime: start, expVested: "", expVesting: "1000nhash,100stake"},
+		{name: "quarter way", blockTime: start.Add(25 * time.Second), expVested: "250nhash,25stake", expVesting: "750nhash,75stake"},
+		{name: "half way", blockTime: start.Add(50 * time.Second), expVested: "500nhash,50stake", expVesting: "500nhash,50stake"},
+		{name: "at end", blockTime: end, expVested: "1000nhash,100stake", expVesting: ""},
+		{name: "after end", blockTime: end.Add(time.Hour), expVested: "1000nhash,100stake", expVesting: ""},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expVested, acct.GetVestedCoins(tc.blockTime).String(), "GetVestedCoins")
+			assert.Equal(t, tc.expVesting, acct.GetVestingCoins(tc.blockTime).String(), "GetVestingCoins")
+			assert.Equal(t, tc.expVesting, acct.LockedCoins(tc.blockTime).String(), "LockedCoins")
+		})
+	}
+}
+
+func TestStakingVestingAccount_TrackDelegation(t *testing.T) {
+	start := time.Unix(1_000_000, 0)
+	end := start.Add(100 * time.Second)
+	original := sdk.NewCoins(sdk.NewInt64Coin("nhash", 1000))
+	halfWay := start.Add(50 * time.Second)
+
+	t.Run("delegate everything before vesting starts", func(t *testing.T) {
+		acct := newTestAccount(t, original, start.Unix(), end.Unix())
+		acct.TrackDelegation(start, original, original)
+		assert.Equal(t, "1000nhash", acct.GetDelegatedVesting().String(), "GetDelegatedVesting")
+		assert.Equal(t, "", acct.GetDelegatedFree().String(), "GetDelegatedFree")
+		assert.Equal(t, "", acct.LockedCoins(start).String(), "LockedCoins")
+	})
+
+	t.Run("delegate more than vesting half way", func(t *testing.T) {
+		acct := newTestAccount(t, original, start.Unix(), end.Unix())
+		acct.TrackDelegation(halfWay, original, sdk.NewCoins(sdk.NewInt64Coin("nhash", 700)))
+		assert.Equal(t, "500nhash", acct.GetDelegatedVesting().String(), "GetDelegatedVesting")
+		assert.Equal(t, "200nhash", acct.GetDelegatedFree().String(), "GetDelegatedFree")
+		assert.Equal(t, "", acct.LockedCoins(halfWay).String(), "LockedCoins")
+	})
+
+	t.Run("delegate less than vesting half way", func(t *testing.T) {
+		acct := newTestAccount(t, original, start.Unix(), end.Unix())
+		acct.TrackDelegation(halfWay, original, sdk.NewCoins(sdk.NewInt64Coin("nhash", 300)))
+		assert.Equal(t, "300nhash", acct.GetDelegatedVesting().String(), "GetDelegatedVesting")
+		assert.Equal(t, "", acct.GetDelegatedFree().String(), "GetDelegatedFree")
+		assert.Equal(t, "200nhash", acct.LockedCoins(halfWay).String(), "LockedCoins")
+	})
+}
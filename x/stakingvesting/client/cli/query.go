@@ -0,0 +1,65 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/version"
+
+	"github.com/provenance-io/provenance/x/stakingvesting"
+)
+
+// QueryCmd returns the top-level command for stakingvesting CLI queries.
+func QueryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        stakingvesting.ModuleName,
+		Short:                      "Querying commands for the stakingvesting module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		QueryCmdVestingStatus(),
+	)
+
+	return cmd
+}
+
+// QueryCmdVestingStatus returns the command for querying the vesting status of a vesting account.
+func QueryCmdVestingStatus() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "status <address>",
+		Aliases: []string{"vesting-status"},
+		Short:   "Get the current vesting status of a vesting account",
+		Example: fmt.Sprintf("$ %s query %s status %s", version.AppName, stakingvesting.ModuleName, sdk.AccAddress("exampleQueryAddr1___")),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			if _, err = sdk.AccAddressFromBech32(args[0]); err != nil {
+				return sdkerrors.ErrInvalidAddress.Wrap(err.Error())
+			}
+
+			queryClient := stakingvesting.NewQueryClient(clientCtx)
+			res, err := queryClient.VestingStatus(cmd.Context(), &stakingvesting.QueryVestingStatusRequest{Address: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,84 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+
+	"github.com/provenance-io/provenance/x/stakingvesting"
+)
+
+// FlagStartTime is the flag for the vesting start time of a new staking vesting account.
+const FlagStartTime = "start-time"
+
+// TxCmd returns the top-level command for stakingvesting CLI transactions.
+func TxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        stakingvesting.ModuleName,
+		Short:                      "Transaction commands for the stakingvesting module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		NewCmdCreateStakingVestingAccount(),
+	)
+
+	return cmd
+}
+
+// NewCmdCreateStakingVestingAccount returns the command for creating a new staking vesting account.
+func NewCmdCreateStakingVestingAccount() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "create-account <to_address> <amount> <end_time>",
+		Aliases: []string{"create"},
+		Short:   "Create a new staking vesting account funded with an amount of coins",
+		Long: `Create a new staking vesting account funded with an amount of coins.
+All of the amount vests continuously from the start time until the end time (both unix timestamps in seconds).
+Until they vest, the coins can be delegated, but cannot be transferred out of the account.
+If no --start-time is provided, vesting starts at the block time of the transaction.`,
+		Example: fmt.Sprintf(`$ %[1]s tx %[2]s create-account %[3]s 1000000nhash 1767225600 --from mykey
+$ %[1]s tx %[2]s create-account %[3]s 1000000nhash 1767225600 --%[4]s 1735689600 --from mykey`,
+			version.AppName, stakingvesting.ModuleName, sdk.AccAddress("exampleTxAddr1______"), FlagStartTime),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			toAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid to address %q: %w", args[0], err)
+			}
+			amount, err := sdk.ParseCoinsNormalized(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid amount %q: %w", args[1], err)
+			}
+			endTime, err := strconv.ParseInt(args[2], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid end time %q: %w", args[2], err)
+			}
+			startTime, err := cmd.Flags().GetInt64(FlagStartTime)
+			if err != nil {
+				return err
+			}
+
+			msg := stakingvesting.NewMsgCreateStakingVestingAccountRequest(clientCtx.GetFromAddress(), toAddr, amount, startTime, endTime)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Int64(FlagStartTime, 0, "The vesting start time, as a unix timestamp in seconds (default is the block time)")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,23 @@
+package stakingvesting
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestexported "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
+	"github.com/cosmos/gogoproto/proto"
+)
+
+// RegisterInterfaces registers concrete implementations for this module.
+func RegisterInterfaces(registry types.InterfaceRegistry) {
+	messages := make([]proto.Message, len(AllRequestMsgs))
+	copy(messages, AllRequestMsgs)
+	registry.RegisterImplementations((*sdk.Msg)(nil), messages...)
+
+	registry.RegisterImplementations((*vestexported.VestingAccount)(nil), &StakingVestingAccount{})
+	registry.RegisterImplementations((*sdk.AccountI)(nil), &StakingVestingAccount{})
+	registry.RegisterImplementations((*authtypes.GenesisAccount)(nil), &StakingVestingAccount{})
+
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+}
@@ -0,0 +1,15 @@
+package stakingvesting
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewEventStakingVestingAccountCreated returns a new EventStakingVestingAccountCreated for the provided account.
+func NewEventStakingVestingAccountCreated(addr sdk.AccAddress, amount sdk.Coins, startTime, endTime int64) *EventStakingVestingAccountCreated {
+	return &EventStakingVestingAccountCreated{
+		Address:   addr.String(),
+		Amount:    amount.String(),
+		StartTime: startTime,
+		EndTime:   endTime,
+	}
+}
@@ -0,0 +1,448 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: provenance/stakingvesting/v1/events.proto
+
+package stakingvesting
+
+import (
+	fmt "fmt"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// EventStakingVestingAccountCreated is an event emitted when a staking vesting account is created.
+type EventStakingVestingAccountCreated struct {
+	// address is the bech32 address string of the new account.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// amount is the coins (as a string) that are vesting.
+	Amount string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	// start_time is the vesting start time, as unix timestamp (in seconds).
+	StartTime int64 `protobuf:"varint,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// end_time is the vesting end time, as unix timestamp (in seconds).
+	EndTime int64 `protobuf:"varint,4,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+}
+
+func (m *EventStakingVestingAccountCreated) Reset()         { *m = EventStakingVestingAccountCreated{} }
+func (m *EventStakingVestingAccountCreated) String() string { return proto.CompactTextString(m) }
+func (*EventStakingVestingAccountCreated) ProtoMessage()    {}
+func (*EventStakingVestingAccountCreated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1a0c5508b515aac3, []int{0}
+}
+func (m *EventStakingVestingAccountCreated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventStakingVestingAccountCreated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventStakingVestingAccountCreated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventStakingVestingAccountCreated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventStakingVestingAccountCreated.Merge(m, src)
+}
+func (m *EventStakingVestingAccountCreated) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventStakingVestingAccountCreated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventStakingVestingAccountCreated.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventStakingVestingAccountCreated proto.InternalMessageInfo
+
+func (m *EventStakingVestingAccountCreated) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *EventStakingVestingAccountCreated) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
+func (m *EventStakingVestingAccountCreated) GetStartTime() int64 {
+	if m != nil {
+		return m.StartTime
+	}
+	return 0
+}
+
+func (m *EventStakingVestingAccountCreated) GetEndTime() int64 {
+	if m != nil {
+		return m.EndTime
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*EventStakingVestingAccountCreated)(nil), "provenance.stakingvesting.v1.EventStakingVestingAccountCreated")
+}
+
+func init() {
+	proto.RegisterFile("provenance/stakingvesting/v1/events.proto", fileDescriptor_1a0c5508b515aac3)
+}
+
+var fileDescriptor_1a0c5508b515aac3 = []byte{
+	// 248 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x90, 0x31, 0x4e, 0xc3, 0x30,
+	0x14, 0x86, 0x63, 0x8a, 0x5a, 0xea, 0xd1, 0x03, 0x0a, 0x12, 0x98, 0xc2, 0x54, 0x06, 0x6c, 0x55,
+	0x70, 0x01, 0x40, 0xec, 0xa8, 0x20, 0x06, 0x16, 0xe4, 0xc6, 0x4f, 0xc1, 0x42, 0xb1, 0x8b, 0xfd,
+	0x6a, 0x71, 0x0b, 0x38, 0x16, 0x63, 0x47, 0x46, 0x94, 0x5c, 0x04, 0xc5, 0x01, 0x05, 0x18, 0x18,
+	0xbf, 0xf7, 0x7d, 0xcb, 0xfb, 0xe9, 0xd1, 0xd2, 0xbb, 0x08, 0x56, 0xd9, 0x02, 0x64, 0x40, 0xf5,
+	0x68, 0x6c, 0x19, 0x21, 0xa0, 0xb1, 0xa5, 0x8c, 0x33, 0x09, 0x11, 0x2c, 0x06, 0xb1, 0xf4, 0x0e,
+	0x1d, 0xdb, 0xed, 0x53, 0xf1, 0x3b, 0x15, 0x71, 0x76, 0xf8, 0x42, 0xe8, 0xc1, 0x65, 0x9b, 0x5f,
+	0x77, 0xea, 0xb6, 0x53, 0x67, 0x45, 0xe1, 0x56, 0x16, 0x2f, 0x3c, 0x28, 0x04, 0xcd, 0x72, 0x3a,
+	0x52, 0x5a, 0x7b, 0x08, 0x21, 0x27, 0x13, 0x32, 0x1d, 0xcf, 0xbf, 0x91, 0x6d, 0xd3, 0xa1, 0xaa,
+	0xda, 0x34, 0xdf, 0x48, 0xe2, 0x8b, 0xd8, 0x1e, 0xa5, 0x01, 0x95, 0xc7, 0x7b, 0x34, 0x15, 0xe4,
+	0x83, 0x09, 0x99, 0x0e, 0xe6, 0xe3, 0x74, 0xb9, 0x31, 0x15, 0xb0, 0x1d, 0xba, 0x05, 0x56, 0x77,
+	0x72, 0x33, 0xc9, 0x11, 0x58, 0xdd, 0xaa, 0xf3, 0xa7, 0xb7, 0x9a, 0x93, 0x75, 0xcd, 0xc9, 0x47,
+	0xcd, 0xc9, 0x6b, 0xc3, 0xb3, 0x75, 0xc3, 0xb3, 0xf7, 0x86, 0x67, 0x74, 0xdf, 0x38, 0xf1, 0xdf,
+	0x33, 0x57, 0xe4, 0xee, 0xb4, 0x34, 0xf8, 0xb0, 0x5a, 0x88, 0xc2, 0x55, 0xb2, 0x4f, 0x8f, 0x8d,
+	0xfb, 0x41, 0xf2, 0xf9, 0xcf, 0x64, 0x8b, 0x61, 0x5a, 0xea, 0xe4, 0x33, 0x00, 0x00, 0xff, 0xff,
+	0xbc, 0x23, 0xa5, 0x9e, 0x56, 0x01, 0x00, 0x00,
+}
+
+func (m *EventStakingVestingAccountCreated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventStakingVestingAccountCreated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventStakingVestingAccountCreated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EndTime != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.EndTime))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.StartTime != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.StartTime))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvents(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EventStakingVestingAccountCreated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.StartTime != 0 {
+		n += 1 + sovEvents(uint64(m.StartTime))
+	}
+	if m.EndTime != 0 {
+		n += 1 + sovEvents(uint64(m.EndTime))
+	}
+	return n
+}
+
+func sovEvents(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvents(x uint64) (n int) {
+	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EventStakingVestingAccountCreated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventStakingVestingAccountCreated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventStakingVestingAccountCreated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			m.StartTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			m.EndTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipEvents(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthEvents
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupEvents
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthEvents
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthEvents        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowEvents          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupEvents = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,54 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	vestexported "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
+
+	"github.com/provenance-io/provenance/x/stakingvesting"
+)
+
+var _ stakingvesting.QueryServer = Keeper{}
+
+// VestingStatus returns the current vesting status of a vesting account.
+func (k Keeper) VestingStatus(goCtx context.Context, req *stakingvesting.QueryVestingStatusRequest) (*stakingvesting.QueryVestingStatusResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if len(req.Address) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "address cannot be empty")
+	}
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %s", err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	acct := k.accountKeeper.GetAccount(ctx, addr)
+	if acct == nil {
+		return nil, status.Errorf(codes.NotFound, "account %s not found", req.Address)
+	}
+	vacct, ok := acct.(vestexported.VestingAccount)
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "account %s is not a vesting account", req.Address)
+	}
+
+	blockTime := ctx.BlockTime()
+	return &stakingvesting.QueryVestingStatusResponse{
+		Address:          req.Address,
+		AccountType:      sdk.MsgTypeURL(vacct),
+		StartTime:        vacct.GetStartTime(),
+		EndTime:          vacct.GetEndTime(),
+		OriginalVesting:  vacct.GetOriginalVesting(),
+		Vested:           vacct.GetVestedCoins(blockTime),
+		Vesting:          vacct.GetVestingCoins(blockTime),
+		DelegatedFree:    vacct.GetDelegatedFree(),
+		DelegatedVesting: vacct.GetDelegatedVesting(),
+		Locked:           vacct.LockedCoins(blockTime),
+		Spendable:        k.bankKeeper.SpendableCoins(ctx, addr),
+	}, nil
+}
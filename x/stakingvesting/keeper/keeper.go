@@ -0,0 +1,64 @@
+package keeper
+
+import (
+	"fmt"
+
+	"cosmossdk.io/log"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/provenance-io/provenance/internal/provutils"
+	"github.com/provenance-io/provenance/x/stakingvesting"
+)
+
+// Keeper handles the creation and inspection of staking vesting accounts.
+// The accounts themselves are stored by the auth module, so this keeper has no store of its own.
+type Keeper struct {
+	accountKeeper stakingvesting.AccountKeeper
+	bankKeeper    stakingvesting.BankKeeper
+}
+
+// NewKeeper creates a new stakingvesting Keeper.
+func NewKeeper(accountKeeper stakingvesting.AccountKeeper, bankKeeper stakingvesting.BankKeeper) Keeper {
+	return Keeper{
+		accountKeeper: accountKeeper,
+		bankKeeper:    bankKeeper,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return provutils.ModuleLogger(ctx, stakingvesting.ModuleName)
+}
+
+// CreateStakingVestingAccount creates a new staking vesting account at the to address and sends it the amount from
+// the from address. All of the amount vests continuously from the start time to the end time (unix timestamps in
+// seconds). If the start time is zero, the current block time is used. The to address cannot already have an account.
+func (k Keeper) CreateStakingVestingAccount(ctx sdk.Context, from, to sdk.AccAddress, amount sdk.Coins, startTime, endTime int64) error {
+	if err := k.bankKeeper.IsSendEnabledCoins(ctx, amount...); err != nil {
+		return err
+	}
+	if k.bankKeeper.BlockedAddr(to) {
+		return fmt.Errorf("%s is not allowed to receive funds", to)
+	}
+	if acc := k.accountKeeper.GetAccount(ctx, to); acc != nil {
+		return fmt.Errorf("account %s already exists", to)
+	}
+
+	if startTime == 0 {
+		startTime = ctx.BlockTime().Unix()
+	}
+	baseAcc := k.accountKeeper.NewAccount(ctx, authtypes.NewBaseAccountWithAddress(to)).(*authtypes.BaseAccount)
+	acct, err := stakingvesting.NewStakingVestingAccount(baseAcc, amount.Sort(), startTime, endTime)
+	if err != nil {
+		return err
+	}
+	k.accountKeeper.SetAccount(ctx, acct)
+
+	if err = k.bankKeeper.SendCoins(ctx, from, to, amount); err != nil {
+		return err
+	}
+
+	return ctx.EventManager().EmitTypedEvent(stakingvesting.NewEventStakingVestingAccountCreated(to, amount, startTime, endTime))
+}
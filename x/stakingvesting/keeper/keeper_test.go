@@ -0,0 +1,279 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/provenance-io/provenance/app"
+	"github.com/provenance-io/provenance/x/stakingvesting"
+	"github.com/provenance-io/provenance/x/stakingvesting/keeper"
+)
+
+type TestSuite struct {
+	suite.Suite
+
+	app *app.App
+	ctx sdk.Context
+
+	bondDenom string
+	funder    sdk.AccAddress
+	other     sdk.AccAddress
+	startTime time.Time
+}
+
+func (s *TestSuite) SetupTest() {
+	s.app = app.Setup(s.T())
+	s.startTime = time.Unix(1_700_000_000, 0).UTC()
+	s.ctx = s.app.BaseApp.NewContext(false).WithBlockTime(s.startTime)
+
+	var err error
+	s.bondDenom, err = s.app.StakingKeeper.BondDenom(s.ctx)
+	s.Require().NoError(err, "BondDenom")
+
+	addrs := app.AddTestAddrsIncremental(s.app, s.ctx, 2, sdkmath.NewInt(1_000_000_000))
+	s.funder = addrs[0]
+	s.other = addrs[1]
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(TestSuite))
+}
+
+// bondCoins returns the provided amount of the bond denom as an sdk.Coins.
+func (s *TestSuite) bondCoins(amount int64) sdk.Coins {
+	return sdk.NewCoins(sdk.NewInt64Coin(s.bondDenom, amount))
+}
+
+// getStakingVestingAccount gets the account with the provided address, requiring it to be a StakingVestingAccount.
+func (s *TestSuite) getStakingVestingAccount(ctx sdk.Context, addr sdk.AccAddress) *stakingvesting.StakingVestingAccount {
+	acc := s.app.AccountKeeper.GetAccount(ctx, addr)
+	s.Require().NotNil(acc, "GetAccount(%s)", addr)
+	rv, ok := acc.(*stakingvesting.StakingVestingAccount)
+	s.Require().True(ok, "GetAccount(%s) type: %T", addr, acc)
+	return rv
+}
+
+func (s *TestSuite) TestCreateStakingVestingAccount() {
+	endTime := s.startTime.Add(100 * time.Second).Unix()
+	newAddr := sdk.AccAddress("new_vesting_account_")
+
+	tests := []struct {
+		name      string
+		from      sdk.AccAddress
+		to        sdk.AccAddress
+		amount    sdk.Coins
+		startTime int64
+		expStart  int64
+		expErr    string
+	}{
+		{
+			name:     "without start time",
+			from:     s.funder,
+			to:       newAddr,
+			amount:   s.bondCoins(1000),
+			expStart: s.startTime.Unix(),
+		},
+		{
+			name:      "with start time",
+			from:      s.funder,
+			to:        newAddr,
+			amount:    s.bondCoins(1000),
+			startTime: s.startTime.Unix() + 10,
+			expStart:  s.startTime.Unix() + 10,
+		},
+		{
+			name:   "account already exists",
+			from:   s.funder,
+			to:     s.other,
+			amount: s.bondCoins(1000),
+			expErr: "account " + s.other.String() + " already exists",
+		},
+		{
+			name:   "blocked address",
+			from:   s.funder,
+			to:     s.app.AccountKeeper.GetModuleAddress(stakingtypes.BondedPoolName),
+			amount: s.bondCoins(1000),
+			expErr: s.app.AccountKeeper.GetModuleAddress(stakingtypes.BondedPoolName).String() + " is not allowed to receive funds",
+		},
+		{
+			name:      "start time after end time",
+			from:      s.funder,
+			to:        newAddr,
+			amount:    s.bondCoins(1000),
+			startTime: endTime + 1,
+			expErr:    "vesting start time must be before the end time",
+		},
+		{
+			name:   "insufficient funds",
+			from:   s.funder,
+			to:     newAddr,
+			amount: s.bondCoins(5_000_000_000),
+			expErr: "spendable balance 1000000000" + s.bondDenom + " is smaller than 5000000000" + s.bondDenom + ": insufficient funds",
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			em := sdk.NewEventManager()
+			ctx, _ := s.ctx.WithEventManager(em).CacheContext()
+			err := s.app.StakingVestingKeeper.CreateStakingVestingAccount(ctx, tc.from, tc.to, tc.amount, tc.startTime, endTime)
+			if len(tc.expErr) > 0 {
+				s.Assert().EqualError(err, tc.expErr, "CreateStakingVestingAccount")
+				return
+			}
+			s.Require().NoError(err, "CreateStakingVestingAccount")
+
+			acct := s.getStakingVestingAccount(ctx, tc.to)
+			s.Assert().Equal(tc.expStart, acct.StartTime, "StartTime")
+			s.Assert().Equal(endTime, acct.EndTime, "EndTime")
+			s.Assert().Equal(tc.amount, acct.OriginalVesting, "OriginalVesting")
+			s.Assert().Equal(tc.amount, s.app.BankKeeper.GetAllBalances(ctx, tc.to), "balance of the new account")
+			s.Assert().Empty(s.app.BankKeeper.SpendableCoins(ctx, tc.to), "spendable coins of the new account")
+
+			expEvent, err := sdk.TypedEventToEvent(stakingvesting.NewEventStakingVestingAccountCreated(tc.to, tc.amount, tc.expStart, endTime))
+			s.Require().NoError(err, "TypedEventToEvent")
+			s.Assert().Contains(ctx.EventManager().Events(), expEvent, "events emitted during CreateStakingVestingAccount")
+		})
+	}
+}
+
+func (s *TestSuite) TestDelegateButNotTransfer() {
+	vestAddr := sdk.AccAddress("staking_vesting_acct")
+	endTime := s.startTime.Add(100 * time.Second).Unix()
+	err := s.app.StakingVestingKeeper.CreateStakingVestingAccount(s.ctx, s.funder, vestAddr, s.bondCoins(1000), 0, endTime)
+	s.Require().NoError(err, "CreateStakingVestingAccount")
+
+	err = s.app.BankKeeper.SendCoins(s.ctx, vestAddr, s.other, s.bondCoins(1))
+	s.Assert().ErrorContains(err, "insufficient funds", "SendCoins of unvested coins")
+
+	validators, err := s.app.StakingKeeper.GetAllValidators(s.ctx)
+	s.Require().NoError(err, "GetAllValidators")
+	s.Require().NotEmpty(validators, "GetAllValidators")
+	_, err = s.app.StakingKeeper.Delegate(s.ctx, vestAddr, sdkmath.NewInt(600), stakingtypes.Unbonded, validators[0], true)
+	s.Require().NoError(err, "Delegate of unvested coins")
+
+	acct := s.getStakingVestingAccount(s.ctx, vestAddr)
+	s.Assert().Equal(s.bondCoins(600), acct.DelegatedVesting, "DelegatedVesting")
+	s.Assert().Empty(acct.DelegatedFree, "DelegatedFree")
+
+	// Half way through, 500 are still vesting, but those are all covered by the
+	// delegation, so the rest of the balance (400) can be transferred.
+	ctx := s.ctx.WithBlockTime(s.startTime.Add(50 * time.Second))
+	s.Assert().Equal(s.bondCoins(400), s.app.BankKeeper.SpendableCoins(ctx, vestAddr), "SpendableCoins half way")
+	err = s.app.BankKeeper.SendCoins(ctx, vestAddr, s.other, s.bondCoins(400))
+	s.Assert().NoError(err, "SendCoins of vested coins")
+}
+
+func (s *TestSuite) TestVestingStatus() {
+	vestAddr := sdk.AccAddress("staking_vesting_acct")
+	endTime := s.startTime.Add(100 * time.Second).Unix()
+	s.Require().NoError(s.app.StakingVestingKeeper.CreateStakingVestingAccount(s.ctx, s.funder, vestAddr, s.bondCoins(1000), 0, endTime), "CreateStakingVestingAccount")
+
+	contAddr := sdk.AccAddress("continuous_vesting__")
+	contAcct, err := vestingtypes.NewContinuousVestingAccount(s.app.AccountKeeper.NewAccountWithAddress(s.ctx, contAddr).(*authtypes.BaseAccount), s.bondCoins(200), s.startTime.Unix(), endTime)
+	s.Require().NoError(err, "NewContinuousVestingAccount")
+	s.app.AccountKeeper.SetAccount(s.ctx, contAcct)
+	s.Require().NoError(s.app.BankKeeper.SendCoins(s.ctx, s.funder, contAddr, s.bondCoins(200)), "SendCoins to continuous vesting account")
+
+	ctx := s.ctx.WithBlockTime(s.startTime.Add(25 * time.Second))
+	queryServer := s.app.StakingVestingKeeper
+
+	tests := []struct {
+		name   string
+		req    *stakingvesting.QueryVestingStatusRequest
+		expRes *stakingvesting.QueryVestingStatusResponse
+		expErr string
+	}{
+		{
+			name:   "nil request",
+			req:    nil,
+			expErr: "rpc error: code = InvalidArgument desc = empty request",
+		},
+		{
+			name:   "empty address",
+			req:    &stakingvesting.QueryVestingStatusRequest{},
+			expErr: "rpc error: code = InvalidArgument desc = address cannot be empty",
+		},
+		{
+			name:   "invalid address",
+			req:    &stakingvesting.QueryVestingStatusRequest{Address: "bad"},
+			expErr: "rpc error: code = InvalidArgument desc = invalid address: decoding bech32 failed: invalid bech32 string length 3",
+		},
+		{
+			name:   "unknown account",
+			req:    &stakingvesting.QueryVestingStatusRequest{Address: sdk.AccAddress("unknown_account_____").String()},
+			expErr: "rpc error: code = NotFound desc = account " + sdk.AccAddress("unknown_account_____").String() + " not found",
+		},
+		{
+			name:   "not a vesting account",
+			req:    &stakingvesting.QueryVestingStatusRequest{Address: s.other.String()},
+			expErr: "rpc error: code = InvalidArgument desc = account " + s.other.String() + " is not a vesting account",
+		},
+		{
+			name: "staking vesting account",
+			req:  &stakingvesting.QueryVestingStatusRequest{Address: vestAddr.String()},
+			expRes: &stakingvesting.QueryVestingStatusResponse{
+				Address:         vestAddr.String(),
+				AccountType:     "/provenance.stakingvesting.v1.StakingVestingAccount",
+				StartTime:       s.startTime.Unix(),
+				EndTime:         endTime,
+				OriginalVesting: s.bondCoins(1000),
+				Vested:          s.bondCoins(250),
+				Vesting:         s.bondCoins(750),
+				Locked:          s.bondCoins(750),
+				Spendable:       s.bondCoins(250),
+			},
+		},
+		{
+			name: "continuous vesting account",
+			req:  &stakingvesting.QueryVestingStatusRequest{Address: contAddr.String()},
+			expRes: &stakingvesting.QueryVestingStatusResponse{
+				Address:         contAddr.String(),
+				AccountType:     "/cosmos.vesting.v1beta1.ContinuousVestingAccount",
+				StartTime:       s.startTime.Unix(),
+				EndTime:         endTime,
+				OriginalVesting: s.bondCoins(200),
+				Vested:          s.bondCoins(50),
+				Vesting:         s.bondCoins(150),
+				Locked:          s.bondCoins(150),
+				Spendable:       s.bondCoins(50),
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			res, err := queryServer.VestingStatus(ctx, tc.req)
+			if len(tc.expErr) > 0 {
+				s.Assert().EqualError(err, tc.expErr, "VestingStatus")
+				s.Assert().Nil(res, "VestingStatus response")
+				return
+			}
+			s.Require().NoError(err, "VestingStatus")
+			s.Assert().Equal(tc.expRes, res, "VestingStatus response")
+		})
+	}
+}
+
+func (s *TestSuite) TestMsgServerCreateStakingVestingAccount() {
+	msgServer := keeper.NewMsgServer(s.app.StakingVestingKeeper)
+	vestAddr := sdk.AccAddress("msg_vesting_account_")
+	endTime := s.startTime.Add(100 * time.Second).Unix()
+
+	msg := stakingvesting.NewMsgCreateStakingVestingAccountRequest(s.funder, vestAddr, s.bondCoins(1000), 0, endTime)
+	_, err := msgServer.CreateStakingVestingAccount(s.ctx, msg)
+	s.Require().NoError(err, "CreateStakingVestingAccount")
+	s.getStakingVestingAccount(s.ctx, vestAddr)
+
+	_, err = msgServer.CreateStakingVestingAccount(s.ctx, msg)
+	s.Assert().EqualError(err, "account "+vestAddr.String()+" already exists: invalid request", "CreateStakingVestingAccount again")
+}
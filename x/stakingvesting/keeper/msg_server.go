@@ -0,0 +1,42 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/provenance-io/provenance/x/stakingvesting"
+)
+
+// MsgServer is an alias for a Keeper that implements the stakingvesting.MsgServer interface.
+type MsgServer struct {
+	Keeper
+}
+
+func NewMsgServer(k Keeper) stakingvesting.MsgServer {
+	return MsgServer{
+		Keeper: k,
+	}
+}
+
+var _ stakingvesting.MsgServer = MsgServer{}
+
+// CreateStakingVestingAccount creates a new staking vesting account and funds it.
+func (k MsgServer) CreateStakingVestingAccount(goCtx context.Context, msg *stakingvesting.MsgCreateStakingVestingAccountRequest) (*stakingvesting.MsgCreateStakingVestingAccountResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	from, err := sdk.AccAddressFromBech32(msg.FromAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid from address: %s", err)
+	}
+	to, err := sdk.AccAddressFromBech32(msg.ToAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid to address: %s", err)
+	}
+	if err = k.Keeper.CreateStakingVestingAccount(ctx, from, to, msg.Amount, msg.StartTime, msg.EndTime); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	return &stakingvesting.MsgCreateStakingVestingAccountResponse{}, nil
+}
@@ -0,0 +1,6 @@
+package stakingvesting
+
+const (
+	// ModuleName is the name of the stakingvesting module.
+	ModuleName = "stakingvesting"
+)
@@ -0,0 +1,91 @@
+package module
+
+import (
+	"context"
+
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"github.com/spf13/cobra"
+
+	"cosmossdk.io/core/appmodule"
+
+	sdkclient "github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+
+	"github.com/provenance-io/provenance/x/stakingvesting"
+	"github.com/provenance-io/provenance/x/stakingvesting/client/cli"
+	"github.com/provenance-io/provenance/x/stakingvesting/keeper"
+)
+
+var (
+	_ module.AppModuleBasic = (*AppModule)(nil)
+	_ module.HasServices    = (*AppModule)(nil)
+
+	_ appmodule.AppModule = (*AppModule)(nil)
+)
+
+// AppModule implements the stakingvesting module.
+// The module has no state of its own (the accounts are stored by the auth module), so it has no genesis.
+type AppModule struct {
+	AppModuleBasic
+	keeper keeper.Keeper
+}
+
+// NewAppModule creates a new stakingvesting AppModule.
+func NewAppModule(cdc codec.Codec, stakingVestingKeeper keeper.Keeper) AppModule {
+	return AppModule{
+		AppModuleBasic: AppModuleBasic{cdc: cdc},
+		keeper:         stakingVestingKeeper,
+	}
+}
+
+// IsOnePerModuleType is a dummy function that satisfies the OnePerModuleType interface (needed by AppModule).
+func (AppModule) IsOnePerModuleType() {}
+
+// IsAppModule is a dummy function that satisfies the AppModule interface.
+func (AppModule) IsAppModule() {}
+
+// AppModuleBasic defines the basic application module used by the stakingvesting module.
+type AppModuleBasic struct {
+	cdc codec.Codec
+}
+
+// Name returns the stakingvesting module's name.
+func (AppModuleBasic) Name() string {
+	return stakingvesting.ModuleName
+}
+
+// GetQueryCmd returns the cli query commands for the stakingvesting module.
+func (AppModuleBasic) GetQueryCmd() *cobra.Command {
+	return cli.QueryCmd()
+}
+
+// GetTxCmd returns the transaction commands for the stakingvesting module.
+func (AppModuleBasic) GetTxCmd() *cobra.Command {
+	return cli.TxCmd()
+}
+
+// RegisterGRPCGatewayRoutes registers the gRPC Gateway routes for the stakingvesting module.
+func (AppModuleBasic) RegisterGRPCGatewayRoutes(clientCtx sdkclient.Context, mux *runtime.ServeMux) {
+	if err := stakingvesting.RegisterQueryHandlerClient(context.Background(), mux, stakingvesting.NewQueryClient(clientCtx)); err != nil {
+		panic(err)
+	}
+}
+
+// RegisterInterfaces registers the stakingvesting module's interface types.
+func (AppModuleBasic) RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
+	stakingvesting.RegisterInterfaces(registry)
+}
+
+// RegisterLegacyAminoCodec registers the stakingvesting module's types for the given codec.
+func (AppModuleBasic) RegisterLegacyAminoCodec(_ *codec.LegacyAmino) {}
+
+// RegisterServices registers the stakingvesting module's gRPC message and query services.
+func (am AppModule) RegisterServices(cfg module.Configurator) {
+	stakingvesting.RegisterMsgServer(cfg.MsgServer(), keeper.NewMsgServer(am.keeper))
+	stakingvesting.RegisterQueryServer(cfg.QueryServer(), am.keeper)
+}
+
+// ConsensusVersion implements AppModule/ConsensusVersion.
+func (AppModule) ConsensusVersion() uint64 { return 1 }
@@ -0,0 +1,49 @@
+package stakingvesting
+
+import (
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// AllRequestMsgs defines all the Msg*Request messages.
+var AllRequestMsgs = []sdk.Msg{
+	(*MsgCreateStakingVestingAccountRequest)(nil),
+}
+
+// NewMsgCreateStakingVestingAccountRequest creates a new CreateStakingVestingAccount request message.
+func NewMsgCreateStakingVestingAccountRequest(from, to sdk.AccAddress, amount sdk.Coins, startTime, endTime int64) *MsgCreateStakingVestingAccountRequest {
+	return &MsgCreateStakingVestingAccountRequest{
+		FromAddress: from.String(),
+		ToAddress:   to.String(),
+		Amount:      amount,
+		StartTime:   startTime,
+		EndTime:     endTime,
+	}
+}
+
+// ValidateBasic runs stateless validation checks on the message.
+func (m MsgCreateStakingVestingAccountRequest) ValidateBasic() error {
+	var errs []error
+	if _, err := sdk.AccAddressFromBech32(m.FromAddress); err != nil {
+		errs = append(errs, fmt.Errorf("invalid from address: %w", err))
+	}
+	if _, err := sdk.AccAddressFromBech32(m.ToAddress); err != nil {
+		errs = append(errs, fmt.Errorf("invalid to address: %w", err))
+	}
+	if err := m.Amount.Validate(); err != nil {
+		errs = append(errs, fmt.Errorf("invalid amount: %w", err))
+	} else if m.Amount.IsZero() {
+		errs = append(errs, errors.New("invalid amount: cannot be zero"))
+	}
+	if m.StartTime < 0 {
+		errs = append(errs, fmt.Errorf("invalid start time %d: cannot be negative", m.StartTime))
+	}
+	if m.EndTime <= 0 {
+		errs = append(errs, fmt.Errorf("invalid end time %d: must be positive", m.EndTime))
+	} else if m.StartTime >= m.EndTime {
+		errs = append(errs, fmt.Errorf("invalid end time %d: must be after the start time %d", m.EndTime, m.StartTime))
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,119 @@
+package stakingvesting_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/testutil"
+
+	. "github.com/provenance-io/provenance/x/stakingvesting"
+)
+
+func TestAllMsgsGetSigners(t *testing.T) {
+	msgMakers := []testutil.MsgMaker{
+		func(signer string) sdk.Msg { return &MsgCreateStakingVestingAccountRequest{FromAddress: signer} },
+	}
+
+	testutil.RunGetSignersTests(t, AllRequestMsgs, msgMakers, nil)
+}
+
+func TestMsgCreateStakingVestingAccountRequest_ValidateBasic(t *testing.T) {
+	from := sdk.AccAddress("from_address________")
+	to := sdk.AccAddress("to_address__________")
+	amount := sdk.NewCoins(sdk.NewInt64Coin("nhash", 1000))
+
+	tests := []struct {
+		name   string
+		msg    *MsgCreateStakingVestingAccountRequest
+		expErr []string
+	}{
+		{
+			name: "valid",
+			msg:  NewMsgCreateStakingVestingAccountRequest(from, to, amount, 100, 200),
+		},
+		{
+			name: "valid without start time",
+			msg:  NewMsgCreateStakingVestingAccountRequest(from, to, amount, 0, 200),
+		},
+		{
+			name: "bad from address",
+			msg: &MsgCreateStakingVestingAccountRequest{
+				FromAddress: "bad", ToAddress: to.String(), Amount: amount, EndTime: 200,
+			},
+			expErr: []string{"invalid from address: decoding bech32 failed: invalid bech32 string length 3"},
+		},
+		{
+			name: "empty to address",
+			msg: &MsgCreateStakingVestingAccountRequest{
+				FromAddress: from.String(), Amount: amount, EndTime: 200,
+			},
+			expErr: []string{"invalid to address: empty address string is not allowed"},
+		},
+		{
+			name:   "no amount",
+			msg:    NewMsgCreateStakingVestingAccountRequest(from, to, nil, 100, 200),
+			expErr: []string{"invalid amount: cannot be zero"},
+		},
+		{
+			name:   "invalid amount",
+			msg:    NewMsgCreateStakingVestingAccountRequest(from, to, sdk.Coins{sdk.Coin{Denom: "nhash", Amount: sdkmath.NewInt(-1)}}, 100, 200),
+			expErr: []string{"invalid amount: coin -1nhash amount is not positive"},
+		},
+		{
+			name:   "negative start time",
+			msg:    NewMsgCreateStakingVestingAccountRequest(from, to, amount, -1, 200),
+			expErr: []string{"invalid start time -1: cannot be negative"},
+		},
+		{
+			name:   "no end time",
+			msg:    NewMsgCreateStakingVestingAccountRequest(from, to, amount, 0, 0),
+			expErr: []string{"invalid end time 0: must be positive"},
+		},
+		{
+			name:   "end time equals start time",
+			msg:    NewMsgCreateStakingVestingAccountRequest(from, to, amount, 200, 200),
+			expErr: []string{"invalid end time 200: must be after the start time 200"},
+		},
+		{
+			name:   "end time before start time",
+			msg:    NewMsgCreateStakingVestingAccountRequest(from, to, amount, 300, 200),
+			expErr: []string{"invalid end time 200: must be after the start time 300"},
+		},
+		{
+			name: "multiple errors",
+			msg:  &MsgCreateStakingVestingAccountRequest{StartTime: -1, EndTime: -2},
+			expErr: []string{
+				"invalid from address: empty address string is not allowed",
+				"invalid to address: empty address string is not allowed",
+				"invalid amount: cannot be zero",
+				"invalid start time -1: cannot be negative",
+				"invalid end time -2: must be positive",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var err error
+			testFunc := func() {
+				err = tc.msg.ValidateBasic()
+			}
+			require.NotPanics(t, testFunc, "ValidateBasic")
+			if len(tc.expErr) == 0 {
+				assert.NoError(t, err, "ValidateBasic")
+				return
+			}
+			if assert.Error(t, err, "ValidateBasic") {
+				for _, exp := range tc.expErr {
+					assert.ErrorContains(t, err, exp, "ValidateBasic")
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,742 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: provenance/stakingvesting/v1/tx.proto
+
+package stakingvesting
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MsgCreateStakingVestingAccountRequest is a request message for the CreateStakingVestingAccount endpoint.
+type MsgCreateStakingVestingAccountRequest struct {
+	// from_address is the account that is funding the new account.
+	FromAddress string `protobuf:"bytes,1,opt,name=from_address,json=fromAddress,proto3" json:"from_address,omitempty"`
+	// to_address is the address of the new account. It cannot already have an account.
+	ToAddress string `protobuf:"bytes,2,opt,name=to_address,json=toAddress,proto3" json:"to_address,omitempty"`
+	// amount is the funds to send to the new account. All of it will be vesting.
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	// start_time is the vesting start time, as unix timestamp (in seconds).
+	// If zero, the block time of the creation is used.
+	StartTime int64 `protobuf:"varint,4,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// end_time is the vesting end time, as unix timestamp (in seconds).
+	EndTime int64 `protobuf:"varint,5,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+}
+
+func (m *MsgCreateStakingVestingAccountRequest) Reset()         { *m = MsgCreateStakingVestingAccountRequest{} }
+func (m *MsgCreateStakingVestingAccountRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateStakingVestingAccountRequest) ProtoMessage()    {}
+func (*MsgCreateStakingVestingAccountRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ace0c3b831930bd7, []int{0}
+}
+func (m *MsgCreateStakingVestingAccountRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateStakingVestingAccountRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateStakingVestingAccountRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreateStakingVestingAccountRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateStakingVestingAccountRequest.Merge(m, src)
+}
+func (m *MsgCreateStakingVestingAccountRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateStakingVestingAccountRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateStakingVestingAccountRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateStakingVestingAccountRequest proto.InternalMessageInfo
+
+func (m *MsgCreateStakingVestingAccountRequest) GetFromAddress() string {
+	if m != nil {
+		return m.FromAddress
+	}
+	return ""
+}
+
+func (m *MsgCreateStakingVestingAccountRequest) GetToAddress() string {
+	if m != nil {
+		return m.ToAddress
+	}
+	return ""
+}
+
+func (m *MsgCreateStakingVestingAccountRequest) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func (m *MsgCreateStakingVestingAccountRequest) GetStartTime() int64 {
+	if m != nil {
+		return m.StartTime
+	}
+	return 0
+}
+
+func (m *MsgCreateStakingVestingAccountRequest) GetEndTime() int64 {
+	if m != nil {
+		return m.EndTime
+	}
+	return 0
+}
+
+// MsgCreateStakingVestingAccountResponse is a response message for the CreateStakingVestingAccount endpoint.
+type MsgCreateStakingVestingAccountResponse struct {
+}
+
+func (m *MsgCreateStakingVestingAccountResponse) Reset() {
+	*m = MsgCreateStakingVestingAccountResponse{}
+}
+func (m *MsgCreateStakingVestingAccountResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateStakingVestingAccountResponse) ProtoMessage()    {}
+func (*MsgCreateStakingVestingAccountResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ace0c3b831930bd7, []int{1}
+}
+func (m *MsgCreateStakingVestingAccountResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateStakingVestingAccountResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateStakingVestingAccountResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreateStakingVestingAccountResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateStakingVestingAccountResponse.Merge(m, src)
+}
+func (m *MsgCreateStakingVestingAccountResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateStakingVestingAccountResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateStakingVestingAccountResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateStakingVestingAccountResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgCreateStakingVestingAccountRequest)(nil), "provenance.stakingvesting.v1.MsgCreateStakingVestingAccountRequest")
+	proto.RegisterType((*MsgCreateStakingVestingAccountResponse)(nil), "provenance.stakingvesting.v1.MsgCreateStakingVestingAccountResponse")
+}
+
+func init() {
+	proto.RegisterFile("provenance/stakingvesting/v1/tx.proto", fileDescriptor_ace0c3b831930bd7)
+}
+
+var fileDescriptor_ace0c3b831930bd7 = []byte{
+	// 480 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x93, 0x41, 0x6b, 0x13, 0x41,
+	0x14, 0xc7, 0x33, 0x8d, 0xad, 0x76, 0xda, 0x4b, 0x97, 0x82, 0x49, 0xd4, 0x4d, 0x28, 0x54, 0x96,
+	0x42, 0x76, 0x48, 0x15, 0x04, 0x3d, 0x35, 0x11, 0x6f, 0x05, 0x49, 0xc5, 0x83, 0x97, 0x30, 0xd9,
+	0x1d, 0xc7, 0xa1, 0xce, 0xbc, 0x74, 0xdf, 0x64, 0x69, 0x6e, 0xe2, 0x27, 0xf0, 0xec, 0x27, 0x28,
+	0x9e, 0x7a, 0x10, 0xfc, 0x0a, 0x3d, 0x16, 0x4f, 0x9e, 0x54, 0x12, 0xa4, 0x5f, 0x43, 0x76, 0x67,
+	0xb4, 0xd1, 0x43, 0x2a, 0x78, 0xd9, 0xdd, 0x79, 0xbf, 0xff, 0x7f, 0xde, 0xe3, 0x3f, 0xb3, 0x74,
+	0x7b, 0x94, 0x41, 0x2e, 0x0c, 0x37, 0x89, 0x60, 0x68, 0xf9, 0xa1, 0x32, 0x32, 0x17, 0x68, 0x95,
+	0x91, 0x2c, 0xef, 0x30, 0x7b, 0x1c, 0x8f, 0x32, 0xb0, 0x10, 0xdc, 0xbe, 0x94, 0xc5, 0x7f, 0xca,
+	0xe2, 0xbc, 0xd3, 0xd8, 0xe0, 0x5a, 0x19, 0x60, 0xe5, 0xd3, 0x19, 0x1a, 0x61, 0x02, 0xa8, 0x01,
+	0xd9, 0x90, 0xa3, 0x60, 0x79, 0x67, 0x28, 0x2c, 0xef, 0xb0, 0x04, 0x94, 0xf1, 0xfc, 0xa6, 0xe7,
+	0x1a, 0xcb, 0x46, 0x1a, 0xa5, 0x07, 0x75, 0x07, 0x06, 0xe5, 0x8a, 0xb9, 0x85, 0x47, 0x9b, 0x12,
+	0x24, 0xb8, 0x7a, 0xf1, 0xe5, 0xaa, 0x5b, 0x3f, 0x96, 0xe8, 0xf6, 0x3e, 0xca, 0x5e, 0x26, 0xb8,
+	0x15, 0x07, 0x6e, 0xb6, 0xe7, 0x6e, 0xb6, 0xbd, 0x24, 0x81, 0xb1, 0xb1, 0x7d, 0x71, 0x34, 0x16,
+	0x68, 0x83, 0x47, 0x74, 0xfd, 0x65, 0x06, 0x7a, 0xc0, 0xd3, 0x34, 0x13, 0x88, 0x35, 0xd2, 0x22,
+	0xd1, 0x6a, 0xb7, 0xf6, 0xf9, 0x63, 0x7b, 0xd3, 0xf7, 0xd9, 0x73, 0xe4, 0xc0, 0x66, 0xca, 0xc8,
+	0xfe, 0x5a, 0xa1, 0xf6, 0xa5, 0xe0, 0x01, 0xa5, 0x16, 0x7e, 0x5b, 0x97, 0xae, 0xb0, 0xae, 0x5a,
+	0xf8, 0x65, 0x9c, 0xd0, 0x15, 0xae, 0x8b, 0x31, 0x6a, 0xd5, 0x56, 0x35, 0x5a, 0xdb, 0xad, 0xc7,
+	0xde, 0x51, 0x44, 0x13, 0xfb, 0x68, 0xe2, 0x1e, 0x28, 0xd3, 0x7d, 0x72, 0xf6, 0xb5, 0x59, 0xf9,
+	0xf0, 0xad, 0x19, 0x49, 0x65, 0x5f, 0x8d, 0x87, 0x71, 0x02, 0xda, 0x27, 0xe0, 0x5f, 0x6d, 0x4c,
+	0x0f, 0x99, 0x9d, 0x8c, 0x04, 0x96, 0x06, 0x7c, 0x7f, 0x71, 0xba, 0xb3, 0xfe, 0x5a, 0x48, 0x9e,
+	0x4c, 0x06, 0x45, 0xb8, 0x78, 0x72, 0x71, 0xba, 0x43, 0xfa, 0xbe, 0x61, 0x70, 0x87, 0x52, 0xb4,
+	0x3c, 0xb3, 0x03, 0xab, 0xb4, 0xa8, 0x5d, 0x6b, 0x91, 0xa8, 0xda, 0x5f, 0x2d, 0x2b, 0xcf, 0x94,
+	0x16, 0x41, 0x9d, 0xde, 0x10, 0x26, 0x75, 0x70, 0xb9, 0x84, 0xd7, 0x85, 0x49, 0x0b, 0xf4, 0x70,
+	0xe3, 0x6d, 0xb1, 0xf3, 0x7c, 0x5a, 0x5b, 0x11, 0xbd, 0x7b, 0x55, 0xcc, 0x38, 0x02, 0x83, 0x62,
+	0xf7, 0x13, 0xa1, 0xd5, 0x7d, 0x94, 0xc1, 0x09, 0xa1, 0xb7, 0x16, 0xe8, 0x83, 0x5e, 0xbc, 0xe8,
+	0x56, 0xc5, 0xff, 0x74, 0xa8, 0x8d, 0xc7, 0xff, 0xb7, 0x89, 0x1b, 0xb9, 0xb1, 0xfc, 0xa6, 0x08,
+	0xae, 0x7b, 0x74, 0x36, 0x0d, 0xc9, 0xf9, 0x34, 0x24, 0xdf, 0xa7, 0x21, 0x79, 0x37, 0x0b, 0x2b,
+	0xe7, 0xb3, 0xb0, 0xf2, 0x65, 0x16, 0x56, 0x68, 0x53, 0xc1, 0xc2, 0x46, 0x4f, 0xc9, 0x8b, 0xfb,
+	0x73, 0xa7, 0x76, 0x29, 0x6d, 0x2b, 0x98, 0x5b, 0xb1, 0xe3, 0xbf, 0xfe, 0xb2, 0xe1, 0x4a, 0x79,
+	0x8b, 0xef, 0xfd, 0x0c, 0x00, 0x00, 0xff, 0xff, 0x4b, 0xf1, 0x0c, 0x92, 0x89, 0x03, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// CreateStakingVestingAccount creates a new staking vesting account and funds it.
+	CreateStakingVestingAccount(ctx context.Context, in *MsgCreateStakingVestingAccountRequest, opts ...grpc.CallOption) (*MsgCreateStakingVestingAccountResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) CreateStakingVestingAccount(ctx context.Context, in *MsgCreateStakingVestingAccountRequest, opts ...grpc.CallOption) (*MsgCreateStakingVestingAccountResponse, error) {
+	out := new(MsgCreateStakingVestingAccountResponse)
+	err := c.cc.Invoke(ctx, "/provenance.stakingvesting.v1.Msg/CreateStakingVestingAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// CreateStakingVestingAccount creates a new staking vesting account and funds it.
+	CreateStakingVestingAccount(context.Context, *MsgCreateStakingVestingAccountRequest) (*MsgCreateStakingVestingAccountResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) CreateStakingVestingAccount(ctx context.Context, req *MsgCreateStakingVestingAccountRequest) (*MsgCreateStakingVestingAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateStakingVestingAccount not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_CreateStakingVestingAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateStakingVestingAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateStakingVestingAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.stakingvesting.v1.Msg/CreateStakingVestingAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateStakingVestingAccount(ctx, req.(*MsgCreateStakingVestingAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Msg_serviceDesc = _Msg_serviceDesc
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "provenance.stakingvesting.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateStakingVestingAccount",
+			Handler:    _Msg_CreateStakingVestingAccount_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "provenance/stakingvesting/v1/tx.proto",
+}
+
+func (m *MsgCreateStakingVestingAccountRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateStakingVestingAccountRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateStakingVestingAccountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EndTime != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.EndTime))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.StartTime != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.StartTime))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.ToAddress) > 0 {
+		i -= len(m.ToAddress)
+		copy(dAtA[i:], m.ToAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ToAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FromAddress) > 0 {
+		i -= len(m.FromAddress)
+		copy(dAtA[i:], m.FromAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FromAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateStakingVestingAccountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateStakingVestingAccountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateStakingVestingAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgCreateStakingVestingAccountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FromAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ToAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.StartTime != 0 {
+		n += 1 + sovTx(uint64(m.StartTime))
+	}
+	if m.EndTime != 0 {
+		n += 1 + sovTx(uint64(m.EndTime))
+	}
+	return n
+}
+
+func (m *MsgCreateStakingVestingAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgCreateStakingVestingAccountRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateStakingVestingAccountRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateStakingVestingAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			m.StartTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			m.EndTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateStakingVestingAccountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateStakingVestingAccountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateStakingVestingAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTx(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTx
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTx
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTx
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTx        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTx          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTx = fmt.Errorf("proto: unexpected end of group")
+)
@@ -15,7 +15,7 @@ func (k Keeper) DetectBlockEvents(ctx sdk.Context) {
 	triggers = append(triggers, k.detectTimeEvents(ctx)...)
 
 	for _, trigger := range triggers {
-		k.Logger(ctx).Debug(fmt.Sprintf("Trigger %d added to queue", trigger.Id))
+		k.Logger(ctx).Debug("trigger added to queue", "trigger_id", trigger.Id, "owner", trigger.Owner)
 		k.emitTriggerDetected(ctx, trigger)
 		k.UnregisterTrigger(ctx, trigger)
 		k.QueueTrigger(ctx, trigger)
@@ -86,7 +86,7 @@ func (k Keeper) getMatchingTriggersUntil(ctx sdk.Context, prefix string, match f
 	err := k.IterateEventListeners(ctx, prefix, func(trigger types.Trigger) (stop bool, err error) {
 		event, _ := trigger.GetTriggerEventI()
 		if match(trigger, event) {
-			k.Logger(ctx).Debug(fmt.Sprintf("Event detected for trigger %d", trigger.Id))
+			k.Logger(ctx).Debug("event detected for trigger", "trigger_id", trigger.Id, "owner", trigger.Owner)
 			triggers = append(triggers, trigger)
 		}
 		return terminator(trigger, event), nil
@@ -103,6 +103,6 @@ func (k Keeper) emitTriggerDetected(ctx sdk.Context, trigger types.Trigger) {
 		TriggerId: fmt.Sprintf("%d", trigger.GetId()),
 	})
 	if err != nil {
-		ctx.Logger().Error("unable to emit EventTriggerDetected", "err", err)
+		k.Logger(ctx).Error("unable to emit EventTriggerDetected", "err", err)
 	}
 }
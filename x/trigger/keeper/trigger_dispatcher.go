@@ -26,11 +26,13 @@ func (k Keeper) ProcessTriggers(ctx sdk.Context) {
 	for !k.QueueIsEmpty(ctx) && actionsProcessed < MaximumActions {
 		item := k.QueuePeek(ctx)
 		triggerID := item.GetTrigger().Id
+		// Everything logged while running this trigger's actions should identify the trigger.
+		triggerCtx := ctx.WithLogger(k.Logger(ctx).With("trigger_id", triggerID, "owner", item.GetTrigger().Owner))
 		gasLimit := k.GetGasLimit(ctx, triggerID)
-		k.Logger(ctx).Debug(fmt.Sprintf("Processing trigger %d with gas limit %d", triggerID, gasLimit))
+		k.Logger(triggerCtx).Debug("processing trigger", "gas_limit", gasLimit)
 
 		if gasLimit+gasConsumed > MaximumQueueGas {
-			k.Logger(ctx).Debug(fmt.Sprintf("Exceeded MaximumQueueGas %d/%d skipping...", gasLimit+gasConsumed, MaximumQueueGas))
+			k.Logger(triggerCtx).Debug("exceeded maximum queue gas, skipping", "queue_gas", gasLimit+gasConsumed, "max_queue_gas", MaximumQueueGas)
 			return
 		}
 		actionsProcessed++
@@ -40,8 +42,8 @@ func (k Keeper) ProcessTriggers(ctx sdk.Context) {
 		k.RemoveGasLimit(ctx, triggerID)
 
 		actions := item.GetTrigger().Actions
-		err := k.runActions(ctx, gasLimit, actions)
-		k.emitTriggerExecuted(ctx, item.GetTrigger(), err == nil)
+		err := k.runActions(triggerCtx, gasLimit, actions)
+		k.emitTriggerExecuted(triggerCtx, item.GetTrigger(), err == nil)
 	}
 }
 
@@ -87,7 +89,7 @@ func (k Keeper) handleMsgs(ctx sdk.Context, msgs []sdk.Msg) ([]sdk.Result, error
 		if handler == nil {
 			return nil, fmt.Errorf("no message handler found for message %s at position %d", sdk.MsgTypeURL(msg), i)
 		}
-		k.Logger(ctx).Debug(fmt.Sprintf("Executing %s at position %d", sdk.MsgTypeURL(msg), i))
+		k.Logger(ctx).Debug("executing trigger action", "action_msg_type", sdk.MsgTypeURL(msg), "position", i)
 		r, err := k.safeHandle(ctx, msg, handler)
 		if err != nil {
 			return nil, fmt.Errorf("error processing message %s at position %d: %w", sdk.MsgTypeURL(msg), i, err)
@@ -96,7 +98,7 @@ func (k Keeper) handleMsgs(ctx sdk.Context, msgs []sdk.Msg) ([]sdk.Result, error
 		if r == nil {
 			return nil, fmt.Errorf("got nil sdk.Result for message %s at position %d", sdk.MsgTypeURL(msg), i)
 		}
-		k.Logger(ctx).Debug(fmt.Sprintf("Successfully executed %s at position %d", sdk.MsgTypeURL(msg), i))
+		k.Logger(ctx).Debug("successfully executed trigger action", "action_msg_type", sdk.MsgTypeURL(msg), "position", i)
 
 		results[i] = *r
 	}
@@ -135,6 +137,6 @@ func (k Keeper) emitTriggerExecuted(ctx sdk.Context, trigger types.Trigger, succ
 		Success:   success,
 	})
 	if eventErr != nil {
-		ctx.Logger().Error("unable to emit EventTriggerExecuted", "err", eventErr)
+		k.Logger(ctx).Error("unable to emit EventTriggerExecuted", "err", eventErr)
 	}
 }
@@ -0,0 +1,94 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+
+	"github.com/provenance-io/provenance/internal/provcli"
+	"github.com/provenance-io/provenance/x/wasm/types"
+)
+
+const (
+	FlagAllowedQueries       = "allowed-queries"
+	FlagAllowedMsgs          = "allowed-msgs"
+	FlagMaxQueryRequestSize  = "max-query-request-size"
+	FlagMaxQueryResponseSize = "max-query-response-size"
+	FlagMaxMsgSize           = "max-msg-size"
+)
+
+// NewTxCmd is the top-level command for provwasm CLI transactions.
+func NewTxCmd() *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Transaction commands for the provwasm module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	txCmd.AddCommand(
+		GetCmdParamsUpdate(),
+	)
+
+	return txCmd
+}
+
+// GetCmdParamsUpdate is a command to update the allow-lists and size limits of the provwasm module.
+func GetCmdParamsUpdate() *cobra.Command {
+	defaults := types.DefaultParams()
+	cmd := &cobra.Command{
+		Use:   "update-params",
+		Short: "Update the module's params",
+		Long: `Submit an update params via governance proposal along with an initial deposit.
+Any param not provided is set to its default value.
+An allow-list entry ending in * allows everything that starts with the rest of the entry.`,
+		Args:    cobra.NoArgs,
+		Aliases: []string{"u"},
+		Example: fmt.Sprintf(`%[1]s tx provwasm update-params --allowed-queries '/provenance.*,/cosmos.bank.v1beta1.Query/Balance' --max-msg-size 65536 --deposit 50000nhash`, version.AppName),
+		RunE: func(cmd *cobra.Command, _ []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			flagSet := cmd.Flags()
+			params := types.Params{}
+			if params.AllowedQueries, err = flagSet.GetStringSlice(FlagAllowedQueries); err != nil {
+				return err
+			}
+			if params.AllowedMsgs, err = flagSet.GetStringSlice(FlagAllowedMsgs); err != nil {
+				return err
+			}
+			if params.MaxQueryRequestSize, err = flagSet.GetUint32(FlagMaxQueryRequestSize); err != nil {
+				return err
+			}
+			if params.MaxQueryResponseSize, err = flagSet.GetUint32(FlagMaxQueryResponseSize); err != nil {
+				return err
+			}
+			if params.MaxMsgSize, err = flagSet.GetUint32(FlagMaxMsgSize); err != nil {
+				return err
+			}
+
+			authority := provcli.GetAuthority(flagSet)
+			msg := types.NewMsgUpdateParamsRequest(authority, params)
+			return provcli.GenerateOrBroadcastTxCLIAsGovProp(clientCtx, flagSet, msg)
+		},
+	}
+
+	cmd.Flags().StringSlice(FlagAllowedQueries, defaults.AllowedQueries, "The query paths that contracts can use")
+	cmd.Flags().StringSlice(FlagAllowedMsgs, defaults.AllowedMsgs, "The msg type urls that contracts can send")
+	cmd.Flags().Uint32(FlagMaxQueryRequestSize, defaults.MaxQueryRequestSize, "The max number of bytes in a query request, 0 = no limit")
+	cmd.Flags().Uint32(FlagMaxQueryResponseSize, defaults.MaxQueryResponseSize, "The max number of bytes in a query response, 0 = no limit")
+	cmd.Flags().Uint32(FlagMaxMsgSize, defaults.MaxMsgSize, "The max number of bytes in a msg, 0 = no limit")
+	govcli.AddGovPropFlagsToCmd(cmd)
+	provcli.AddAuthorityFlagToCmd(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,16 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/wasm/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// Params returns the params used by the module
+func (k Keeper) Params(ctx context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	return &types.QueryParamsResponse{Params: k.GetParams(sdk.UnwrapSDKContext(ctx))}, nil
+}
@@ -0,0 +1,75 @@
+package keeper
+
+import (
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/provenance-io/provenance/internal/provutils"
+	"github.com/provenance-io/provenance/x/wasm/types"
+)
+
+// Keeper for the provwasm module
+type Keeper struct {
+	storeKey  storetypes.StoreKey
+	cdc       codec.BinaryCodec
+	authority string
+}
+
+// NewKeeper Creates a new Keeper for the module.
+func NewKeeper(cdc codec.BinaryCodec, key storetypes.StoreKey) Keeper {
+	return Keeper{
+		storeKey:  key,
+		cdc:       cdc,
+		authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	}
+}
+
+// Logger Creates a new logger for the module.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return provutils.ModuleLogger(ctx, types.ModuleName)
+}
+
+// GetParams Gets the params for the module.
+// If the params have not been set, the default params are returned.
+func (k Keeper) GetParams(ctx sdk.Context) types.Params {
+	bz := ctx.KVStore(k.storeKey).Get(types.ParamsKey)
+	if len(bz) == 0 {
+		return types.DefaultParams()
+	}
+	var params types.Params
+	k.cdc.MustUnmarshal(bz, &params)
+	return params
+}
+
+// SetParams Sets the params for the module.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	bz := k.cdc.MustMarshal(&params)
+	ctx.KVStore(k.storeKey).Set(types.ParamsKey, bz)
+}
+
+// GetAuthority gets the authority account address.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// ValidateAuthority returns an error if the provided address is not the authority.
+func (k Keeper) ValidateAuthority(addr string) error {
+	if k.authority != addr {
+		return govtypes.ErrInvalidSigner.Wrapf("expected %q got %q", k.authority, addr)
+	}
+	return nil
+}
+
+// emitEvent emits the provided event and writes any error to the error log.
+func (k Keeper) emitEvent(ctx sdk.Context, event proto.Message) {
+	err := ctx.EventManager().EmitTypedEvent(event)
+	if err != nil {
+		k.Logger(ctx).Error("error emitting event", "event", event, "error", err)
+	}
+}
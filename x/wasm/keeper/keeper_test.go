@@ -0,0 +1,100 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/app"
+	"github.com/provenance-io/provenance/x/wasm/keeper"
+	"github.com/provenance-io/provenance/x/wasm/types"
+)
+
+type TestSuite struct {
+	suite.Suite
+
+	app *app.App
+	ctx sdk.Context
+
+	queryClient types.QueryClient
+	msgServer   types.MsgServer
+}
+
+func (s *TestSuite) SetupTest() {
+	s.app = app.Setup(s.T())
+	s.ctx = s.app.BaseApp.NewContext(false)
+
+	s.msgServer = keeper.NewMsgServer(s.app.ProvWasmKeeper)
+	queryHelper := baseapp.NewQueryServerTestHelper(s.ctx, s.app.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, s.app.ProvWasmKeeper)
+	s.queryClient = types.NewQueryClient(queryHelper)
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(TestSuite))
+}
+
+func (s *TestSuite) TestGetSetParams() {
+	s.Assert().Equal(types.DefaultParams(), s.app.ProvWasmKeeper.GetParams(s.ctx), "GetParams from genesis")
+
+	params := types.NewParams([]string{"/provenance.name.v1.Query/Resolve"}, nil, 1, 2, 3)
+	s.app.ProvWasmKeeper.SetParams(s.ctx, params)
+	s.Assert().Equal(params, s.app.ProvWasmKeeper.GetParams(s.ctx), "GetParams after SetParams")
+
+	res, err := s.queryClient.Params(s.ctx, &types.QueryParamsRequest{})
+	s.Require().NoError(err, "Params query")
+	s.Assert().Equal(params, res.Params, "Params query result")
+
+	s.Assert().Equal(types.NewGenesisState(params), s.app.ProvWasmKeeper.ExportGenesis(s.ctx), "ExportGenesis")
+}
+
+func (s *TestSuite) TestUpdateParams() {
+	authority := s.app.ProvWasmKeeper.GetAuthority()
+	newParams := types.NewParams([]string{"/provenance.*", "/cosmos.bank.v1beta1.Query/Balance"}, []string{"/provenance.*"}, 100, 200, 300)
+
+	tests := []struct {
+		name  string
+		req   *types.MsgUpdateParamsRequest
+		event *sdk.Event
+		err   string
+	}{
+		{
+			name: "authority does not match module authority",
+			req:  types.NewMsgUpdateParamsRequest("cosmos1w6t0l7z0yerj49ehnqwqaayxqpe3u7e23edgma", newParams),
+			err:  fmt.Sprintf("expected %q got \"cosmos1w6t0l7z0yerj49ehnqwqaayxqpe3u7e23edgma\": expected gov account as only signer for proposal message", authority),
+		},
+		{
+			name:  "params are updated",
+			req:   types.NewMsgUpdateParamsRequest(authority, newParams),
+			event: typedEventToEvent(types.NewEventParamsUpdated()),
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			ctx := s.ctx.WithEventManager(sdk.NewEventManager())
+			res, err := s.msgServer.UpdateParams(ctx, tc.req)
+			events := ctx.EventManager().Events()
+
+			if len(tc.err) > 0 {
+				s.Assert().Nil(res, "response")
+				s.Assert().EqualError(err, tc.err, "error")
+				s.Assert().Empty(events, "events")
+				return
+			}
+			s.Assert().NoError(err, "error")
+			s.Assert().Equal(&types.MsgUpdateParamsResponse{}, res, "response")
+			s.Assert().Equal(sdk.Events{*tc.event}, events, "events")
+			s.Assert().Equal(tc.req.Params, s.app.ProvWasmKeeper.GetParams(ctx), "params after update")
+		})
+	}
+}
+
+func typedEventToEvent(tev *types.EventParamsUpdated) *sdk.Event {
+	event, _ := sdk.TypedEventToEvent(tev)
+	return &event
+}
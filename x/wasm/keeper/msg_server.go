@@ -0,0 +1,35 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/wasm/types"
+)
+
+// MsgServer is an alias for a Keeper that implements the types.MsgServer interface.
+type MsgServer struct {
+	Keeper
+}
+
+func NewMsgServer(k Keeper) types.MsgServer {
+	return MsgServer{
+		Keeper: k,
+	}
+}
+
+var _ types.MsgServer = MsgServer{}
+
+// UpdateParams is a governance proposal endpoint for updating the provwasm module's params.
+func (k MsgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParamsRequest) (*types.MsgUpdateParamsResponse, error) {
+	if err := k.ValidateAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	k.SetParams(ctx, msg.Params)
+	k.emitEvent(ctx, types.NewEventParamsUpdated())
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
@@ -0,0 +1,17 @@
+package types
+
+import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	"github.com/cosmos/gogoproto/proto"
+)
+
+// RegisterInterfaces registers concrete implementations for this module.
+func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
+	messages := make([]proto.Message, len(AllRequestMsgs))
+	copy(messages, AllRequestMsgs)
+	registry.RegisterImplementations((*sdk.Msg)(nil), messages...)
+
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+}
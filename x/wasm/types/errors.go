@@ -0,0 +1,10 @@
+package types
+
+import (
+	cerrs "cosmossdk.io/errors"
+)
+
+var (
+	ErrMsgNotAllowed = cerrs.Register(ModuleName, 2, "msg not allowed from a contract")
+	ErrMsgTooLarge   = cerrs.Register(ModuleName, 3, "msg too large")
+)
@@ -0,0 +1,6 @@
+package types
+
+// NewEventParamsUpdated returns a new EventParamsUpdated.
+func NewEventParamsUpdated() *EventParamsUpdated {
+	return &EventParamsUpdated{}
+}
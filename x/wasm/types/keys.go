@@ -0,0 +1,15 @@
+package types
+
+const (
+	// ModuleName defines the module name.
+	// It differs from the wasmd module's name so that the two don't collide.
+	ModuleName = "provwasm"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+)
+
+var (
+	// ParamsKey is the key to obtain the module's params.
+	ParamsKey = []byte{0x01}
+)
@@ -0,0 +1,28 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// AllRequestMsgs defines all the Msg*Request messages.
+var AllRequestMsgs = []sdk.Msg{
+	(*MsgUpdateParamsRequest)(nil),
+}
+
+// NewMsgUpdateParamsRequest creates a new UpdateParams message.
+func NewMsgUpdateParamsRequest(authority string, params Params) *MsgUpdateParamsRequest {
+	return &MsgUpdateParamsRequest{
+		Authority: authority,
+		Params:    params,
+	}
+}
+
+// ValidateBasic runs stateless validation checks on the message.
+func (m MsgUpdateParamsRequest) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return fmt.Errorf("invalid authority: %w", err)
+	}
+	return m.Params.Validate()
+}
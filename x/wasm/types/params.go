@@ -0,0 +1,120 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+const (
+	// DefaultMaxQueryRequestSize is the default max_query_request_size param value.
+	DefaultMaxQueryRequestSize = 16 * 1024
+	// DefaultMaxQueryResponseSize is the default max_query_response_size param value.
+	DefaultMaxQueryResponseSize = 1024 * 1024
+	// DefaultMaxMsgSize is the default max_msg_size param value.
+	DefaultMaxMsgSize = 256 * 1024
+)
+
+// NewParams creates a new Params object.
+func NewParams(allowedQueries, allowedMsgs []string, maxQueryRequestSize, maxQueryResponseSize, maxMsgSize uint32) Params {
+	return Params{
+		AllowedQueries:       allowedQueries,
+		AllowedMsgs:          allowedMsgs,
+		MaxQueryRequestSize:  maxQueryRequestSize,
+		MaxQueryResponseSize: maxQueryResponseSize,
+		MaxMsgSize:           maxMsgSize,
+	}
+}
+
+// DefaultParams creates default provwasm module parameters.
+// By default, contracts can use all the provenance-specific queries, and send msgs from any of the
+// cosmos, cosmwasm, ibc, and provenance protos.
+func DefaultParams() Params {
+	return NewParams(
+		[]string{"/provenance.*"},
+		[]string{"/cosmos.*", "/cosmwasm.*", "/ibc.*", "/provenance.*"},
+		DefaultMaxQueryRequestSize,
+		DefaultMaxQueryResponseSize,
+		DefaultMaxMsgSize,
+	)
+}
+
+// Validate verifies all params are correct
+func (p Params) Validate() error {
+	return errors.Join(
+		validateAllowList("allowed queries", p.AllowedQueries),
+		validateAllowList("allowed msgs", p.AllowedMsgs),
+	)
+}
+
+// validateAllowList returns an error if any of the entries are invalid or duplicated.
+func validateAllowList(field string, entries []string) error {
+	known := make(map[string]bool, len(entries))
+	var errs []error
+	for i, entry := range entries {
+		switch {
+		case !strings.HasPrefix(entry, "/"):
+			errs = append(errs, fmt.Errorf("invalid %s[%d]: %q must start with a /", field, i, entry))
+		case strings.Contains(strings.TrimSuffix(entry, "*"), "*"):
+			errs = append(errs, fmt.Errorf("invalid %s[%d]: %q can only have a * at the end", field, i, entry))
+		case strings.ContainsAny(entry, " \t\r\n"):
+			errs = append(errs, fmt.Errorf("invalid %s[%d]: %q cannot contain whitespace", field, i, entry))
+		case known[entry]:
+			errs = append(errs, fmt.Errorf("invalid %s[%d]: duplicate entry %q", field, i, entry))
+		}
+		known[entry] = true
+	}
+	return errors.Join(errs...)
+}
+
+// IsQueryAllowed returns true if the provided query path is in the allowed queries.
+func (p Params) IsQueryAllowed(path string) bool {
+	return isAllowed(p.AllowedQueries, path)
+}
+
+// IsMsgAllowed returns true if the provided msg type url is in the allowed msgs.
+func (p Params) IsMsgAllowed(typeURL string) bool {
+	return isAllowed(p.AllowedMsgs, typeURL)
+}
+
+// isAllowed returns true if the value equals one of the entries, or starts with an entry that ends in a *.
+func isAllowed(entries []string, value string) bool {
+	for _, entry := range entries {
+		if prefix, isWildcard := strings.CutSuffix(entry, "*"); isWildcard {
+			if strings.HasPrefix(value, prefix) {
+				return true
+			}
+			continue
+		}
+		if entry == value {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidateQueryRequestSize returns an error if the provided size is larger than allowed.
+func (p Params) ValidateQueryRequestSize(size int) error {
+	return validateSize("query request", size, p.MaxQueryRequestSize)
+}
+
+// ValidateQueryResponseSize returns an error if the provided size is larger than allowed.
+func (p Params) ValidateQueryResponseSize(size int) error {
+	return validateSize("query response", size, p.MaxQueryResponseSize)
+}
+
+// ValidateMsgSize returns an error if the provided size is larger than allowed.
+func (p Params) ValidateMsgSize(size int) error {
+	if err := validateSize("msg", size, p.MaxMsgSize); err != nil {
+		return ErrMsgTooLarge.Wrap(err.Error())
+	}
+	return nil
+}
+
+// validateSize returns an error if the max is not zero and the size is larger than it.
+func validateSize(what string, size int, maxSize uint32) error {
+	if maxSize != 0 && size > int(maxSize) {
+		return fmt.Errorf("%s size %d exceeds the maximum of %d bytes", what, size, maxSize)
+	}
+	return nil
+}
@@ -0,0 +1,495 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: provenance/wasm/v1/params.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Params defines the governance-managed allow-lists and size limits for the stargate (and grpc) queries
+// and any (stargate) messages that smart contracts can use.
+// An allow-list entry ending in "*" allows everything that starts with the rest of the entry.
+type Params struct {
+	// allowed_queries are the query paths (e.g. "/provenance.name.v1.Query/Resolve") that contracts can use,
+	// in addition to the queries that are always allowed by the chain.
+	AllowedQueries []string `protobuf:"bytes,1,rep,name=allowed_queries,json=allowedQueries,proto3" json:"allowed_queries,omitempty"`
+	// allowed_msgs are the msg type urls (e.g. "/provenance.name.v1.MsgBindNameRequest") that contracts can send.
+	AllowedMsgs []string `protobuf:"bytes,2,rep,name=allowed_msgs,json=allowedMsgs,proto3" json:"allowed_msgs,omitempty"`
+	// max_query_request_size is the maximum number of bytes in the data of a query request. Zero means no limit.
+	MaxQueryRequestSize uint32 `protobuf:"varint,3,opt,name=max_query_request_size,json=maxQueryRequestSize,proto3" json:"max_query_request_size,omitempty"`
+	// max_query_response_size is the maximum number of bytes in the value of a query response. Zero means no limit.
+	MaxQueryResponseSize uint32 `protobuf:"varint,4,opt,name=max_query_response_size,json=maxQueryResponseSize,proto3" json:"max_query_response_size,omitempty"`
+	// max_msg_size is the maximum number of bytes in the value of a msg. Zero means no limit.
+	MaxMsgSize uint32 `protobuf:"varint,5,opt,name=max_msg_size,json=maxMsgSize,proto3" json:"max_msg_size,omitempty"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d77ba8d6875ac39d, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetAllowedQueries() []string {
+	if m != nil {
+		return m.AllowedQueries
+	}
+	return nil
+}
+
+func (m *Params) GetAllowedMsgs() []string {
+	if m != nil {
+		return m.AllowedMsgs
+	}
+	return nil
+}
+
+func (m *Params) GetMaxQueryRequestSize() uint32 {
+	if m != nil {
+		return m.MaxQueryRequestSize
+	}
+	return 0
+}
+
+func (m *Params) GetMaxQueryResponseSize() uint32 {
+	if m != nil {
+		return m.MaxQueryResponseSize
+	}
+	return 0
+}
+
+func (m *Params) GetMaxMsgSize() uint32 {
+	if m != nil {
+		return m.MaxMsgSize
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "provenance.wasm.v1.Params")
+}
+
+func init() { proto.RegisterFile("provenance/wasm/v1/params.proto", fileDescriptor_d77ba8d6875ac39d) }
+
+var fileDescriptor_d77ba8d6875ac39d = []byte{
+	// 283 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x90, 0xbf, 0x4e, 0xf3, 0x30,
+	0x14, 0xc5, 0xeb, 0xaf, 0x1f, 0x95, 0x30, 0x05, 0x24, 0xf3, 0xaf, 0x93, 0x09, 0x2c, 0x74, 0x21,
+	0xa1, 0xaa, 0x78, 0x01, 0xf6, 0x4a, 0x25, 0x6c, 0x2c, 0x91, 0x1b, 0xae, 0x82, 0xa5, 0x3a, 0x4e,
+	0x73, 0x93, 0x34, 0xe9, 0x53, 0xf0, 0x58, 0x8c, 0x1d, 0x19, 0x51, 0xf2, 0x22, 0x28, 0x76, 0x51,
+	0x2a, 0x31, 0xde, 0x73, 0x7f, 0xbf, 0x33, 0x1c, 0x7a, 0x9d, 0xa4, 0xba, 0x80, 0x58, 0xc4, 0x21,
+	0x78, 0x6b, 0x81, 0xca, 0x2b, 0x26, 0x5e, 0x22, 0x52, 0xa1, 0xd0, 0x4d, 0x52, 0x9d, 0x69, 0xc6,
+	0x3a, 0xc0, 0x6d, 0x01, 0xb7, 0x98, 0xdc, 0xd6, 0x84, 0x0e, 0xe6, 0x06, 0x62, 0x77, 0xf4, 0x54,
+	0x2c, 0x97, 0x7a, 0x0d, 0x6f, 0xc1, 0x2a, 0x87, 0x54, 0x02, 0x8e, 0x88, 0xd3, 0x1f, 0x1f, 0xfa,
+	0x27, 0xbb, 0xf8, 0xd9, 0xa6, 0xec, 0x86, 0x0e, 0x7f, 0x41, 0x85, 0x11, 0x8e, 0xfe, 0x19, 0xea,
+	0x68, 0x97, 0xcd, 0x30, 0x42, 0x36, 0xa5, 0x97, 0x4a, 0x94, 0xa6, 0xa7, 0x0a, 0x52, 0x58, 0xe5,
+	0x80, 0x59, 0x80, 0x72, 0x03, 0xa3, 0xbe, 0x43, 0xc6, 0xc7, 0xfe, 0x99, 0x12, 0x65, 0x5b, 0x57,
+	0xf9, 0xf6, 0xf7, 0x22, 0x37, 0xc0, 0x1e, 0xe9, 0xd5, 0xbe, 0x84, 0x89, 0x8e, 0x11, 0xac, 0xf5,
+	0xdf, 0x58, 0xe7, 0x9d, 0x65, 0x9f, 0x46, 0x73, 0xe8, 0xb0, 0xd5, 0x14, 0x46, 0x96, 0x3d, 0x30,
+	0x2c, 0x55, 0xa2, 0x9c, 0x61, 0xd4, 0x12, 0x4f, 0xe1, 0x67, 0xcd, 0xc9, 0xb6, 0xe6, 0xe4, 0xbb,
+	0xe6, 0xe4, 0xa3, 0xe1, 0xbd, 0x6d, 0xc3, 0x7b, 0x5f, 0x0d, 0xef, 0xd1, 0x0b, 0xa9, 0xdd, 0xbf,
+	0xab, 0xcc, 0xc9, 0xeb, 0x43, 0x24, 0xb3, 0xf7, 0x7c, 0xe1, 0x86, 0x5a, 0x79, 0x1d, 0x70, 0x2f,
+	0xf5, 0xde, 0xe5, 0x95, 0x76, 0xe7, 0xac, 0x4a, 0x00, 0x17, 0x03, 0x33, 0xf2, 0xf4, 0x27, 0x00,
+	0x00, 0xff, 0xff, 0x74, 0x27, 0xce, 0xe5, 0x87, 0x01, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxMsgSize != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxMsgSize))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.MaxQueryResponseSize != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxQueryResponseSize))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MaxQueryRequestSize != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxQueryRequestSize))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.AllowedMsgs) > 0 {
+		for iNdEx := len(m.AllowedMsgs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedMsgs[iNdEx])
+			copy(dAtA[i:], m.AllowedMsgs[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.AllowedMsgs[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.AllowedQueries) > 0 {
+		for iNdEx := len(m.AllowedQueries) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedQueries[iNdEx])
+			copy(dAtA[i:], m.AllowedQueries[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.AllowedQueries[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParams(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AllowedQueries) > 0 {
+		for _, s := range m.AllowedQueries {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if len(m.AllowedMsgs) > 0 {
+		for _, s := range m.AllowedMsgs {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if m.MaxQueryRequestSize != 0 {
+		n += 1 + sovParams(uint64(m.MaxQueryRequestSize))
+	}
+	if m.MaxQueryResponseSize != 0 {
+		n += 1 + sovParams(uint64(m.MaxQueryResponseSize))
+	}
+	if m.MaxMsgSize != 0 {
+		n += 1 + sovParams(uint64(m.MaxMsgSize))
+	}
+	return n
+}
+
+func sovParams(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozParams(x uint64) (n int) {
+	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedQueries", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedQueries = append(m.AllowedQueries, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedMsgs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedMsgs = append(m.AllowedMsgs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxQueryRequestSize", wireType)
+			}
+			m.MaxQueryRequestSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxQueryRequestSize |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxQueryResponseSize", wireType)
+			}
+			m.MaxQueryResponseSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxQueryResponseSize |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMsgSize", wireType)
+			}
+			m.MaxMsgSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxMsgSize |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipParams(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthParams
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupParams
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthParams
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthParams        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowParams          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupParams = fmt.Errorf("proto: unexpected end of group")
+)
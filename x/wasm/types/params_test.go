@@ -0,0 +1,95 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParamsValidate(t *testing.T) {
+	tests := []struct {
+		name   string
+		params Params
+		expErr string
+	}{
+		{name: "default", params: DefaultParams()},
+		{name: "empty", params: Params{}},
+		{
+			name:   "query without leading slash",
+			params: NewParams([]string{"provenance.*"}, nil, 0, 0, 0),
+			expErr: `invalid allowed queries[0]: "provenance.*" must start with a /`,
+		},
+		{
+			name:   "msg with wildcard in the middle",
+			params: NewParams(nil, []string{"/cosmos.bank.v1beta1.MsgSend", "/provenance.*.MsgBindNameRequest"}, 0, 0, 0),
+			expErr: `invalid allowed msgs[1]: "/provenance.*.MsgBindNameRequest" can only have a * at the end`,
+		},
+		{
+			name:   "entry with whitespace",
+			params: NewParams([]string{"/provenance.name.v1.Query/ Resolve"}, nil, 0, 0, 0),
+			expErr: `invalid allowed queries[0]: "/provenance.name.v1.Query/ Resolve" cannot contain whitespace`,
+		},
+		{
+			name:   "duplicate entries",
+			params: NewParams([]string{"/provenance.*", "/cosmos.*", "/provenance.*"}, []string{"/a", "/a"}, 0, 0, 0),
+			expErr: `invalid allowed queries[2]: duplicate entry "/provenance.*"` + "\n" +
+				`invalid allowed msgs[1]: duplicate entry "/a"`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.params.Validate()
+			if len(tc.expErr) > 0 {
+				assert.EqualError(t, err, tc.expErr, "Validate")
+			} else {
+				assert.NoError(t, err, "Validate")
+			}
+		})
+	}
+}
+
+func TestParamsIsAllowed(t *testing.T) {
+	params := NewParams(
+		[]string{"/provenance.name.v1.Query/Resolve", "/cosmos.bank.*"},
+		[]string{"/provenance.*", "/cosmos.bank.v1beta1.MsgSend"},
+		0, 0, 0,
+	)
+
+	tests := []struct {
+		value    string
+		expQuery bool
+		expMsg   bool
+	}{
+		{value: "/provenance.name.v1.Query/Resolve", expQuery: true, expMsg: true},
+		{value: "/provenance.name.v1.Query/ReverseLookup", expQuery: false, expMsg: true},
+		{value: "/provenance.name.v1.MsgBindNameRequest", expQuery: false, expMsg: true},
+		{value: "/cosmos.bank.v1beta1.Query/Balance", expQuery: true, expMsg: false},
+		{value: "/cosmos.bank.v1beta1.MsgSend", expQuery: true, expMsg: true},
+		{value: "/cosmos.bank.v1beta1.MsgSendMore", expQuery: true, expMsg: false},
+		{value: "/cosmos.staking.v1beta1.MsgDelegate", expQuery: false, expMsg: false},
+		{value: "", expQuery: false, expMsg: false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.value, func(t *testing.T) {
+			assert.Equal(t, tc.expQuery, params.IsQueryAllowed(tc.value), "IsQueryAllowed")
+			assert.Equal(t, tc.expMsg, params.IsMsgAllowed(tc.value), "IsMsgAllowed")
+		})
+	}
+}
+
+func TestParamsValidateSizes(t *testing.T) {
+	params := NewParams(nil, nil, 10, 20, 30)
+	assert.NoError(t, params.ValidateQueryRequestSize(10), "ValidateQueryRequestSize(10)")
+	assert.EqualError(t, params.ValidateQueryRequestSize(11), "query request size 11 exceeds the maximum of 10 bytes", "ValidateQueryRequestSize(11)")
+	assert.NoError(t, params.ValidateQueryResponseSize(20), "ValidateQueryResponseSize(20)")
+	assert.EqualError(t, params.ValidateQueryResponseSize(21), "query response size 21 exceeds the maximum of 20 bytes", "ValidateQueryResponseSize(21)")
+	assert.NoError(t, params.ValidateMsgSize(30), "ValidateMsgSize(30)")
+	assert.EqualError(t, params.ValidateMsgSize(31), "msg size 31 exceeds the maximum of 30 bytes: msg too large", "ValidateMsgSize(31)")
+
+	noLimits := NewParams(nil, nil, 0, 0, 0)
+	assert.NoError(t, noLimits.ValidateQueryRequestSize(1_000_000), "ValidateQueryRequestSize without limit")
+	assert.NoError(t, noLimits.ValidateQueryResponseSize(1_000_000), "ValidateQueryResponseSize without limit")
+	assert.NoError(t, noLimits.ValidateMsgSize(1_000_000), "ValidateMsgSize without limit")
+}